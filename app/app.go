@@ -1,12 +1,15 @@
 package app
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"io/fs"
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/CosmWasm/wasmd/x/wasm"
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
@@ -57,8 +60,8 @@ import (
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/std"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/module"
-	sigtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
@@ -105,7 +108,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/cosmos/gogoproto/proto"
+	packetforward "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward"
+	packetforwardkeeper "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/keeper"
+	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
 	icq "github.com/cosmos/ibc-apps/modules/async-icq/v8"
 	icqkeeper "github.com/cosmos/ibc-apps/modules/async-icq/v8/keeper"
 	icqtypes "github.com/cosmos/ibc-apps/modules/async-icq/v8/types"
@@ -117,6 +124,9 @@ import (
 	icahostkeeper "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/keeper"
 	icahosttypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	ibcfee "github.com/cosmos/ibc-go/v8/modules/apps/29-fee"
+	ibcfeekeeper "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/keeper"
+	ibcfeetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
 	ibctransfer "github.com/cosmos/ibc-go/v8/modules/apps/transfer"
 	ibctransferkeeper "github.com/cosmos/ibc-go/v8/modules/apps/transfer/keeper"
 	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
@@ -130,27 +140,44 @@ import (
 	simappparams "github.com/provenance-io/provenance/app/params"
 	"github.com/provenance-io/provenance/client/docs"
 	"github.com/provenance-io/provenance/internal/antewrapper"
+	"github.com/provenance-io/provenance/internal/eventexport"
 	piohandlers "github.com/provenance-io/provenance/internal/handlers"
 	"github.com/provenance-io/provenance/internal/pioconfig"
 	"github.com/provenance-io/provenance/internal/provwasm"
+	"github.com/provenance-io/provenance/internal/querygate"
+	"github.com/provenance-io/provenance/internal/snapshots"
+	"github.com/provenance-io/provenance/internal/streaming"
+	provtextual "github.com/provenance-io/provenance/internal/textual"
+	"github.com/provenance-io/provenance/internal/txsummary"
+	"github.com/provenance-io/provenance/internal/unordered"
 	"github.com/provenance-io/provenance/x/attribute"
 	attributekeeper "github.com/provenance-io/provenance/x/attribute/keeper"
 	attributetypes "github.com/provenance-io/provenance/x/attribute/types"
+	attributewasm "github.com/provenance-io/provenance/x/attribute/wasm"
 	"github.com/provenance-io/provenance/x/exchange"
 	exchangekeeper "github.com/provenance-io/provenance/x/exchange/keeper"
 	exchangemodule "github.com/provenance-io/provenance/x/exchange/module"
+	exchangewasm "github.com/provenance-io/provenance/x/exchange/wasm"
 	"github.com/provenance-io/provenance/x/hold"
 	holdkeeper "github.com/provenance-io/provenance/x/hold/keeper"
 	holdmodule "github.com/provenance-io/provenance/x/hold/module"
 	"github.com/provenance-io/provenance/x/ibchooks"
 	ibchookskeeper "github.com/provenance-io/provenance/x/ibchooks/keeper"
 	ibchookstypes "github.com/provenance-io/provenance/x/ibchooks/types"
+	"github.com/provenance-io/provenance/x/ibchost"
+	ibchostkeeper "github.com/provenance-io/provenance/x/ibchost/keeper"
+	ibchostmodule "github.com/provenance-io/provenance/x/ibchost/module"
+	ibcmetadatakeeper "github.com/provenance-io/provenance/x/ibcmetadata/keeper"
+	ibcmetadatamodule "github.com/provenance-io/provenance/x/ibcmetadata/module"
+	ibcmetadatatypes "github.com/provenance-io/provenance/x/ibcmetadata/types"
 	"github.com/provenance-io/provenance/x/ibcratelimit"
 	ibcratelimitkeeper "github.com/provenance-io/provenance/x/ibcratelimit/keeper"
 	ibcratelimitmodule "github.com/provenance-io/provenance/x/ibcratelimit/module"
 	"github.com/provenance-io/provenance/x/marker"
 	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
 	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	markervoteext "github.com/provenance-io/provenance/x/marker/voteext"
+	markerwasm "github.com/provenance-io/provenance/x/marker/wasm"
 	"github.com/provenance-io/provenance/x/metadata"
 	metadatakeeper "github.com/provenance-io/provenance/x/metadata/keeper"
 	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
@@ -191,6 +218,7 @@ var (
 		govtypes.ModuleName:            {authtypes.Burner},
 
 		icatypes.ModuleName:         nil,
+		ibcfeetypes.ModuleName:      nil,
 		ibctransfertypes.ModuleName: {authtypes.Minter, authtypes.Burner},
 		ibchookstypes.ModuleName:    nil,
 
@@ -201,6 +229,24 @@ var (
 		oracletypes.ModuleName:    nil,
 		metadatatypes.ModuleName:  {authtypes.Minter, authtypes.Burner},
 	}
+
+	// provSnapshotStoreNames are the stores that are verified after being restored from a state-sync snapshot.
+	provSnapshotStoreNames = []string{
+		attributetypes.StoreKey,
+		exchange.StoreKey,
+		hold.StoreKey,
+		ibchookstypes.StoreKey,
+		ibcmetadatatypes.StoreKey,
+		ibcratelimit.StoreKey,
+		markertypes.StoreKey,
+		metadatatypes.StoreKey,
+		msgfeestypes.StoreKey,
+		nametypes.StoreKey,
+		oracletypes.StoreKey,
+		quarantine.StoreKey,
+		sanction.StoreKey,
+		triggertypes.StoreKey,
+	}
 )
 
 var (
@@ -258,12 +304,16 @@ type App struct {
 	OracleKeeper          oraclekeeper.Keeper
 	ConsensusParamsKeeper consensusparamkeeper.Keeper
 
-	IBCKeeper          *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
-	IBCHooksKeeper     *ibchookskeeper.Keeper
-	ICAHostKeeper      *icahostkeeper.Keeper
-	TransferKeeper     *ibctransferkeeper.Keeper
-	ICQKeeper          icqkeeper.Keeper
-	RateLimitingKeeper *ibcratelimitkeeper.Keeper
+	IBCKeeper           *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
+	IBCHooksKeeper      *ibchookskeeper.Keeper
+	ICAHostKeeper       *icahostkeeper.Keeper
+	IBCFeeKeeper        ibcfeekeeper.Keeper
+	TransferKeeper      *ibctransferkeeper.Keeper
+	ICQKeeper           icqkeeper.Keeper
+	RateLimitingKeeper  *ibcratelimitkeeper.Keeper
+	PacketForwardKeeper *packetforwardkeeper.Keeper
+	IBCHostKeeper       ibchostkeeper.Keeper
+	IBCMetadataKeeper   ibcmetadatakeeper.Keeper
 
 	MarkerKeeper    markerkeeper.Keeper
 	MetadataKeeper  metadatakeeper.Keeper
@@ -275,11 +325,12 @@ type App struct {
 	ContractKeeper  *wasmkeeper.PermissionedKeeper
 
 	// make scoped keepers public for test purposes
-	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
-	ScopedTransferKeeper capabilitykeeper.ScopedKeeper
-	ScopedICAHostKeeper  capabilitykeeper.ScopedKeeper
-	ScopedICQKeeper      capabilitykeeper.ScopedKeeper
-	ScopedOracleKeeper   capabilitykeeper.ScopedKeeper
+	ScopedIBCKeeper         capabilitykeeper.ScopedKeeper
+	ScopedTransferKeeper    capabilitykeeper.ScopedKeeper
+	ScopedICAHostKeeper     capabilitykeeper.ScopedKeeper
+	ScopedICQKeeper         capabilitykeeper.ScopedKeeper
+	ScopedOracleKeeper      capabilitykeeper.ScopedKeeper
+	ScopedIBCMetadataKeeper capabilitykeeper.ScopedKeeper
 
 	TransferStack       *ibchooks.IBCMiddleware
 	Ics20WasmHooks      *ibchooks.WasmHooks
@@ -288,6 +339,17 @@ type App struct {
 	HooksICS4Wrapper    ibchooks.ICS4Middleware
 	RateLimitMiddleware porttypes.Middleware
 
+	NAVVoteExtHandler *markervoteext.Handler
+
+	// UnorderedTxKeeper keeps track of the unordered txs that have been included so they can't be included again.
+	UnorderedTxKeeper unordered.Keeper
+
+	// StateStreamServer provides the state changes to subscribers when state streaming uses the grpc sink.
+	StateStreamServer *streaming.Server
+
+	// QueryGate disables or rate-limits expensive queries on this node (nil if none are).
+	QueryGate *querygate.Gate
+
 	// the module manager
 	mm                 *module.Manager
 	BasicModuleManager module.BasicManager
@@ -362,8 +424,11 @@ func New(
 		ibctransfertypes.StoreKey,
 		icahosttypes.StoreKey,
 		icqtypes.StoreKey,
+		ibcfeetypes.StoreKey,
 		ibchookstypes.StoreKey,
 		ibcratelimit.StoreKey,
+		packetforwardtypes.StoreKey,
+		ibcmetadatatypes.StoreKey,
 
 		metadatatypes.StoreKey,
 		markertypes.StoreKey,
@@ -377,6 +442,7 @@ func New(
 		oracletypes.StoreKey,
 		hold.StoreKey,
 		exchange.StoreKey,
+		unordered.StoreKey,
 	)
 	tkeys := storetypes.NewTransientStoreKeys()
 	memKeys := storetypes.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -398,6 +464,22 @@ func New(
 		app.Logger().Error("failed to register streaming plugin", "error", err)
 		os.Exit(1)
 	}
+	var streamingManager storetypes.StreamingManager
+	if err := app.registerStateStreaming(appOpts, &streamingManager); err != nil {
+		app.Logger().Error("failed to register state streaming", "error", err)
+		os.Exit(1)
+	}
+	if err := app.registerEventExport(appOpts, &streamingManager); err != nil {
+		app.Logger().Error("failed to register event export", "error", err)
+		os.Exit(1)
+	}
+	if len(streamingManager.ABCIListeners) > 0 {
+		app.SetStreamingManager(streamingManager)
+	}
+	if err := app.setQueryGate(appOpts); err != nil {
+		app.Logger().Error("failed to set up query gate", "error", err)
+		os.Exit(1)
+	}
 
 	// set the BaseApp's parameter store
 
@@ -419,6 +501,7 @@ func New(
 	app.ScopedICAHostKeeper = app.CapabilityKeeper.ScopeToModule(icahosttypes.SubModuleName)
 	app.ScopedICQKeeper = app.CapabilityKeeper.ScopeToModule(icqtypes.ModuleName)
 	scopedOracleKeeper := app.CapabilityKeeper.ScopeToModule(oracletypes.ModuleName)
+	app.ScopedIBCMetadataKeeper = app.CapabilityKeeper.ScopeToModule(ibcmetadatatypes.ModuleName)
 
 	// capability keeper must be sealed after scope to module registrations are completed.
 	app.CapabilityKeeper.Seal()
@@ -435,9 +518,13 @@ func New(
 		logger,
 	)
 
-	// optional: enable sign mode textual by overwriting the default tx config (after setting the bank keeper)
-	txConfigOpts.EnabledSignModes = append(txConfigOpts.EnabledSignModes, sigtypes.SignMode_SIGN_MODE_TEXTUAL)
-	txConfigOpts.TextualCoinMetadataQueryFn = txmodule.NewBankKeeperCoinMetadataQueryFn(app.BankKeeper)
+	// Enable sign mode textual by overwriting the default tx config (after setting the bank keeper).
+	// It's added as a custom sign mode so that provenance msgs are rendered with a summary.
+	textualHandler, err := provtextual.NewSignModeHandler(txmodule.NewBankKeeperCoinMetadataQueryFn(app.BankKeeper), interfaceRegistry)
+	if err != nil {
+		panic(err)
+	}
+	txConfigOpts.CustomSignModes = []signing.SignModeHandler{textualHandler}
 	txConfig, err = authtx.NewTxConfigWithOptions(appCodec, txConfigOpts)
 	if err != nil {
 		panic(err)
@@ -452,7 +539,7 @@ func New(
 	)
 
 	app.CircuitKeeper = circuitkeeper.NewKeeper(appCodec, runtime.NewKVStoreService(keys[circuittypes.StoreKey]), govAuthority, app.AccountKeeper.AddressCodec())
-	app.BaseApp.SetCircuitBreaker(&app.CircuitKeeper)
+	app.BaseApp.SetCircuitBreaker(piohandlers.NewPioCircuitBreaker(&app.CircuitKeeper))
 
 	app.MintKeeper = mintkeeper.NewKeeper(appCodec, runtime.NewKVStoreService(keys[minttypes.StoreKey]), app.StakingKeeper, app.AccountKeeper, app.BankKeeper, authtypes.FeeCollectorName, govAuthority)
 
@@ -543,8 +630,26 @@ func New(
 		govAuthority,
 	)
 	app.TransferKeeper = &transferKeeper
+
+	// The packet forward middleware sends its packets through the rate limiter and hooks too.
+	app.PacketForwardKeeper = packetforwardkeeper.NewKeeper(
+		appCodec,
+		keys[packetforwardtypes.StoreKey],
+		app.TransferKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		app.BankKeeper,
+		&rateLimitingTransferModule,
+		govAuthority,
+	)
+
 	transferModule := ibctransfer.NewIBCModule(*app.TransferKeeper)
-	app.RateLimitMiddleware = rateLimitingTransferModule.WithIBCModule(transferModule)
+	packetForwardModule := packetforward.NewIBCMiddleware(
+		transferModule,
+		app.PacketForwardKeeper,
+		0, // Don't retry forwarded packets that time out.
+		packetforwardkeeper.DefaultForwardTransferPacketTimeoutTimestamp,
+	)
+	app.RateLimitMiddleware = rateLimitingTransferModule.WithIBCModule(packetForwardModule)
 	hooksTransferModule := ibchooks.NewIBCMiddleware(app.RateLimitMiddleware, &app.HooksICS4Wrapper)
 	app.TransferStack = &hooksTransferModule
 
@@ -561,6 +666,8 @@ func New(
 		authtypes.NewModuleAddress(distrtypes.ModuleName),          // Allow fee denoms to be restricted coins.
 		authtypes.NewModuleAddress(stakingtypes.BondedPoolName),    // Allow bond denom to be a restricted coin.
 		authtypes.NewModuleAddress(stakingtypes.NotBondedPoolName), // Allow bond denom to be a restricted coin.
+		exchange.GetPaymentEscrowAddress(),                         // Allow restricted coins in escrowed payments.
+		exchange.GetCommitmentRewardsAddress(),                     // Allow restricted coins in commitment reward pools.
 	}
 
 	app.MarkerKeeper = markerkeeper.NewKeeper(
@@ -574,10 +681,18 @@ func New(
 		appCodec, keys[metadatatypes.StoreKey], app.AccountKeeper, app.AuthzKeeper, app.AttributeKeeper, app.MarkerKeeper, app.BankKeeper,
 	)
 
+	app.IBCMetadataKeeper = ibcmetadatakeeper.NewKeeper(
+		appCodec, keys[ibcmetadatatypes.StoreKey], app.IBCKeeper.ChannelKeeper, app.IBCKeeper.PortKeeper,
+		app.ScopedIBCMetadataKeeper, app.MetadataKeeper,
+	)
+	ibcMetadataModule := ibcmetadatamodule.NewAppModule(appCodec, app.IBCMetadataKeeper)
+
 	app.HoldKeeper = holdkeeper.NewKeeper(
 		appCodec, keys[hold.StoreKey], app.BankKeeper,
 	)
 
+	app.UnorderedTxKeeper = unordered.NewKeeper(keys[unordered.StoreKey])
+
 	app.ExchangeKeeper = exchangekeeper.NewKeeper(
 		appCodec, keys[exchange.StoreKey], authtypes.FeeCollectorName,
 		app.AccountKeeper, app.AttributeKeeper, app.BankKeeper, app.HoldKeeper, app.MarkerKeeper,
@@ -588,23 +703,32 @@ func New(
 		return pioMsgFeesRouter.Handler(msg)
 	})
 	app.TriggerKeeper = triggerkeeper.NewKeeper(appCodec, keys[triggertypes.StoreKey], app.MsgServiceRouter())
+	app.IBCFeeKeeper = ibcfeekeeper.NewKeeper(
+		appCodec, keys[ibcfeetypes.StoreKey],
+		app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ChannelKeeper, app.IBCKeeper.PortKeeper,
+		app.AccountKeeper, app.BankKeeper,
+	)
+
 	icaHostKeeper := icahostkeeper.NewKeeper(
 		appCodec, keys[icahosttypes.StoreKey], nil,
-		app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ChannelKeeper, app.IBCKeeper.PortKeeper,
+		app.IBCFeeKeeper, app.IBCKeeper.ChannelKeeper, app.IBCKeeper.PortKeeper,
 		app.AccountKeeper, app.ScopedICAHostKeeper, pioMessageRouter, govAuthority,
 	)
 	app.ICAHostKeeper = &icaHostKeeper
 	app.ICAHostKeeper.WithQueryRouter(app.GRPCQueryRouter())
 	icaModule := ica.NewAppModule(nil, app.ICAHostKeeper)
 	icaHostIBCModule := icahost.NewIBCModule(*app.ICAHostKeeper)
+	icaHostStack := ibcfee.NewIBCMiddleware(icaHostIBCModule, app.IBCFeeKeeper)
 
 	app.ICQKeeper = icqkeeper.NewKeeper(
 		appCodec, keys[icqtypes.StoreKey],
-		app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ChannelKeeper, app.IBCKeeper.PortKeeper,
+		app.IBCFeeKeeper, app.IBCKeeper.ChannelKeeper, app.IBCKeeper.PortKeeper,
 		app.ScopedICQKeeper, app.BaseApp.GRPCQueryRouter(), govAuthority,
 	)
 	icqModule := icq.NewAppModule(app.ICQKeeper, nil)
 	icqIBCModule := icq.NewIBCModule(app.ICQKeeper)
+	icqHostStack := ibcfee.NewIBCMiddleware(icqIBCModule, app.IBCFeeKeeper)
+	app.IBCHostKeeper = ibchostkeeper.NewKeeper(app.ICAHostKeeper, app.ICQKeeper, app.MsgServiceRouter(), app.GRPCQueryRouter())
 
 	// Init CosmWasm module
 	wasmDir := filepath.Join(homePath, "data", "wasm")
@@ -620,6 +744,19 @@ func New(
 	// Capabilities defined here: https://github.com/CosmWasm/cosmwasm/blob/main/docs/CAPABILITIES-BUILT-IN.md
 	supportedFeatures := []string{"staking", "provenance", "stargate", "iterator", "cosmwasm_1_1", "cosmwasm_1_2", "cosmwasm_1_3", "cosmwasm_1_4", "cosmwasm_2_0", "cosmwasm_2_1"}
 
+	// Register the custom message encoders and queriers that let smart contracts use provenance modules.
+	encoderRegistry := provwasm.NewEncoderRegistry()
+	encoderRegistry.RegisterEncoder(markertypes.RouterKey, markerwasm.Encoder)
+	encoderRegistry.RegisterEncoder(attributetypes.RouterKey, attributewasm.Encoder)
+	encoderRegistry.RegisterEncoder(exchange.ModuleName, exchangewasm.Encoder)
+
+	querierRegistry := provwasm.NewQuerierRegistry()
+	querierRegistry.RegisterQuerier(markertypes.RouterKey, markerwasm.Querier(app.MarkerKeeper))
+	querierRegistry.RegisterQuerier(attributetypes.RouterKey, attributewasm.Querier(app.AttributeKeeper))
+
+	// Msgs and queries with an entry in the msgfees wasm gas costs param are charged that fixed cost when invoked by a contract.
+	wasmGasCostGetter := provwasm.GasCostGetter(app.MsgFeesKeeper.GetWasmGasCost)
+
 	// The last arguments contain custom message handlers, and custom query handlers,
 	// to allow smart contracts to use provenance modules.
 	wasmKeeperInstance := wasmkeeper.NewKeeper(
@@ -634,13 +771,14 @@ func New(
 		app.IBCKeeper.PortKeeper,
 		scopedWasmKeeper,
 		app.TransferKeeper,
-		pioMessageRouter,
+		provwasm.NewGasCostMessageRouter(pioMessageRouter, wasmGasCostGetter),
 		app.GRPCQueryRouter(),
 		wasmDir,
 		wasmConfig,
 		supportedFeatures,
 		govAuthority,
-		wasmkeeper.WithQueryPlugins(provwasm.QueryPlugins(*app.GRPCQueryRouter(), appCodec)),
+		wasmkeeper.WithQueryPlugins(provwasm.QueryPlugins(querierRegistry, *app.GRPCQueryRouter(), appCodec, wasmGasCostGetter)),
+		wasmkeeper.WithMessageEncoders(provwasm.MessageEncoders(encoderRegistry)),
 	)
 	app.WasmKeeper = &wasmKeeperInstance
 
@@ -650,6 +788,7 @@ func New(
 	app.IBCHooksKeeper.ContractKeeper = app.ContractKeeper
 	app.Ics20MarkerHooks.MarkerKeeper = &app.MarkerKeeper
 	app.RateLimitingKeeper.PermissionedKeeper = app.ContractKeeper
+	app.RateLimitingKeeper.ContractViewer = app.WasmKeeper
 
 	app.IbcHooks.SendPacketPreProcessors = []ibchookstypes.PreSendPacketDataProcessingFn{app.Ics20MarkerHooks.SetupMarkerMemoFn, app.Ics20WasmHooks.GetWasmSendPacketPreProcessor}
 
@@ -658,13 +797,15 @@ func New(
 		appCodec,
 		keys[oracletypes.StoreKey],
 		keys[oracletypes.MemStoreKey],
-		app.IBCKeeper.ChannelKeeper,
+		app.IBCFeeKeeper,
 		app.IBCKeeper.ChannelKeeper,
 		app.IBCKeeper.PortKeeper,
 		scopedOracleKeeper,
 		wasmkeeper.Querier(app.WasmKeeper),
 	)
+	app.ExchangeKeeper.SetOracleKeeper(app.OracleKeeper)
 	oracleModule := oraclemodule.NewAppModule(appCodec, app.OracleKeeper, app.AccountKeeper, app.BankKeeper, app.IBCKeeper.ChannelKeeper)
+	oracleStack := ibcfee.NewIBCMiddleware(oracleModule, app.IBCFeeKeeper)
 
 	unsanctionableAddrs := make([]sdk.AccAddress, 0, len(maccPerms)+1)
 	for mName := range maccPerms {
@@ -693,9 +834,10 @@ func New(
 	ibcRouter.
 		AddRoute(ibctransfertypes.ModuleName, app.TransferStack).
 		AddRoute(wasmtypes.ModuleName, wasm.NewIBCHandler(app.WasmKeeper, app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ChannelKeeper)).
-		AddRoute(icahosttypes.SubModuleName, icaHostIBCModule).
-		AddRoute(icqtypes.ModuleName, icqIBCModule).
-		AddRoute(oracletypes.ModuleName, oracleModule)
+		AddRoute(icahosttypes.SubModuleName, icaHostStack).
+		AddRoute(icqtypes.ModuleName, icqHostStack).
+		AddRoute(oracletypes.ModuleName, oracleStack).
+		AddRoute(ibcmetadatatypes.ModuleName, ibcMetadataModule)
 	app.IBCKeeper.SetRouter(ibcRouter)
 
 	// Create evidence Keeper for to register the IBC light client misbehavior evidence route
@@ -758,9 +900,13 @@ func New(
 		ibc.NewAppModule(app.IBCKeeper),
 		ibcratelimitmodule.NewAppModule(appCodec, *app.RateLimitingKeeper),
 		ibchooks.NewAppModule(app.AccountKeeper, *app.IBCHooksKeeper),
+		ibchostmodule.NewAppModule(appCodec, app.IBCHostKeeper),
+		ibcMetadataModule,
 		ibctransfer.NewAppModule(*app.TransferKeeper),
+		packetforward.NewAppModule(app.PacketForwardKeeper, nil),
 		icqModule,
 		icaModule,
+		ibcfee.NewAppModule(app.IBCFeeKeeper),
 		ibctm.AppModule{},
 	)
 
@@ -784,6 +930,9 @@ func New(
 	paramprops.RegisterLegacyAminoCodec(legacyAmino)
 	paramprops.RegisterInterfaces(interfaceRegistry)
 
+	// Unordered txs are identified using a tx extension option.
+	unordered.RegisterInterfaces(interfaceRegistry)
+
 	// NOTE: upgrade module is required to be prioritized
 	app.mm.SetOrderPreBlockers(
 		upgradetypes.ModuleName,
@@ -814,6 +963,7 @@ func New(
 		feegrant.ModuleName,
 		group.ModuleName,
 		triggertypes.ModuleName,
+		exchange.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -857,13 +1007,16 @@ func New(
 
 		ibcexported.ModuleName,
 		ibctransfertypes.ModuleName,
+		packetforwardtypes.ModuleName,
 		icqtypes.ModuleName,
 		icatypes.ModuleName,
+		ibcfeetypes.ModuleName,
 		ibcratelimit.ModuleName,
 		ibchookstypes.ModuleName,
 		wasmtypes.ModuleName, // must be after ibctransfer.
 		triggertypes.ModuleName,
 		oracletypes.ModuleName,
+		ibcmetadatatypes.ModuleName, // must be after ibc and metadata.
 	}
 	app.mm.SetOrderInitGenesis(moduleGenesisOrder...)
 	app.mm.SetOrderExportGenesis(moduleGenesisOrder...)
@@ -896,8 +1049,12 @@ func New(
 
 		ibcratelimit.ModuleName,
 		ibchookstypes.ModuleName,
+		packetforwardtypes.ModuleName,
 		icatypes.ModuleName,
 		icqtypes.ModuleName,
+		ibcfeetypes.ModuleName,
+		ibchost.ModuleName,
+		ibcmetadatatypes.ModuleName,
 		wasmtypes.ModuleName,
 
 		attributetypes.ModuleName,
@@ -945,10 +1102,12 @@ func New(
 	app.SetPreBlocker(app.PreBlocker)
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
+	app.setVoteExtensionHandlers(appOpts)
 	app.setAnteHandler()
 	app.setPostHandler()
 	app.setFeeHandler()
 	app.SetAggregateEventsFunc(piohandlers.AggregateEvents)
+	app.registerSnapshotExtensions()
 
 	// Register upgrade handlers and set the store loader.
 	// This must be done after the module manager, configurator, and pre-blocker are set,
@@ -968,13 +1127,16 @@ func New(
 func (app *App) setAnteHandler() {
 	anteHandler, err := antewrapper.NewAnteHandler(
 		antewrapper.HandlerOptions{
-			AccountKeeper:       app.AccountKeeper,
-			BankKeeper:          app.BankKeeper,
-			TxSigningHandlerMap: app.txConfig.SignModeHandler(),
-			FeegrantKeeper:      app.FeeGrantKeeper,
-			MsgFeesKeeper:       app.MsgFeesKeeper,
-			CircuitKeeper:       &app.CircuitKeeper,
-			SigGasConsumer:      ante.DefaultSigVerificationGasConsumer,
+			AccountKeeper:          app.AccountKeeper,
+			BankKeeper:             app.BankKeeper,
+			TxSigningHandlerMap:    app.txConfig.SignModeHandler(),
+			FeegrantKeeper:         app.FeeGrantKeeper,
+			MsgFeesKeeper:          app.MsgFeesKeeper,
+			MarkerKeeper:           app.MarkerKeeper,
+			CircuitKeeper:          piohandlers.NewPioCircuitBreaker(&app.CircuitKeeper),
+			UnorderedTxKeeper:      app.UnorderedTxKeeper,
+			ExtensionOptionChecker: unordered.IsExtensionOption,
+			SigGasConsumer:         ante.DefaultSigVerificationGasConsumer,
 		})
 	if err != nil {
 		panic(err)
@@ -1010,6 +1172,114 @@ func (app *App) setFeeHandler() {
 	app.SetFeeHandler(msgFeeHandler)
 }
 
+// setVoteExtensionHandlers sets up the ABCI++ handlers used for the marker net asset value attestations.
+func (app *App) setVoteExtensionHandlers(appOpts servertypes.AppOptions) {
+	priceProvider := markervoteext.NewPriceProviderFromAppOpts(app.appCodec, appOpts)
+	app.NAVVoteExtHandler = markervoteext.NewHandler(app.MarkerKeeper, app.StakingKeeper, priceProvider)
+	app.SetExtendVoteHandler(app.NAVVoteExtHandler.ExtendVoteHandler())
+	app.SetVerifyVoteExtensionHandler(app.NAVVoteExtHandler.VerifyVoteExtensionHandler())
+
+	proposalHandler := baseapp.NewDefaultProposalHandler(app.Mempool(), app.BaseApp)
+	app.SetPrepareProposal(app.NAVVoteExtHandler.PrepareProposalHandler(proposalHandler.PrepareProposalHandler()))
+	app.SetProcessProposal(app.NAVVoteExtHandler.ProcessProposalHandler(proposalHandler.ProcessProposalHandler()))
+}
+
+// registerStateStreaming sets up the listener that streams the state changes of the stores selected in the app options.
+// The listener is added to the provided streaming manager.
+func (app *App) registerStateStreaming(appOpts servertypes.AppOptions, manager *storetypes.StreamingManager) error {
+	cfg := streaming.ConfigFromAppOpts(appOpts)
+	if !cfg.IsEnabled() {
+		return nil
+	}
+	if err := cfg.Validate(app.keys); err != nil {
+		return err
+	}
+	pluginKey := fmt.Sprintf("%s.%s.%s", baseapp.StreamingTomlKey, baseapp.StreamingABCITomlKey, baseapp.StreamingABCIPluginTomlKey)
+	if len(strings.TrimSpace(cast.ToString(appOpts.Get(pluginKey)))) > 0 {
+		return fmt.Errorf("%s cannot be used with %s", streaming.FlagKeys, pluginKey)
+	}
+
+	var sink streaming.Sink
+	switch cfg.Sink {
+	case streaming.SinkGRPC:
+		app.StateStreamServer = streaming.NewServer()
+		sink = app.StateStreamServer
+	case streaming.SinkFile:
+		fileSink, err := streaming.NewFileSink(app.appCodec, cfg.FileDir)
+		if err != nil {
+			return err
+		}
+		sink = fileSink
+	}
+
+	app.CommitMultiStore().AddListeners(cfg.GetStoreKeys(app.keys))
+	manager.ABCIListeners = append(manager.ABCIListeners, streaming.NewListener(app.appCodec, sink, app.Logger()))
+	manager.StopNodeOnErr = manager.StopNodeOnErr || cfg.StopNodeOnErr
+	return nil
+}
+
+// registerEventExport sets up the exporter that sends the events of each block to the sink selected in the app options.
+// The exporter is added to the provided streaming manager.
+func (app *App) registerEventExport(appOpts servertypes.AppOptions, manager *storetypes.StreamingManager) error {
+	cfg := eventexport.ConfigFromAppOpts(appOpts)
+	if !cfg.IsEnabled() {
+		return nil
+	}
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	pluginKey := fmt.Sprintf("%s.%s.%s", baseapp.StreamingTomlKey, baseapp.StreamingABCITomlKey, baseapp.StreamingABCIPluginTomlKey)
+	if len(strings.TrimSpace(cast.ToString(appOpts.Get(pluginKey)))) > 0 {
+		return fmt.Errorf("%s cannot be used with %s", eventexport.FlagSink, pluginKey)
+	}
+
+	sink, err := cfg.NewSink()
+	if err != nil {
+		return err
+	}
+	exporter, err := eventexport.NewExporter(sink, cfg.OutboxDir, cfg.EventTypes, app.Logger())
+	if err != nil {
+		return err
+	}
+	exporter.Start(context.Background())
+
+	manager.ABCIListeners = append(manager.ABCIListeners, exporter)
+	manager.StopNodeOnErr = manager.StopNodeOnErr || cfg.StopNodeOnErr
+	return nil
+}
+
+// setQueryGate sets up the gate that disables or rate-limits the expensive queries selected in the app options.
+func (app *App) setQueryGate(appOpts servertypes.AppOptions) error {
+	cfg := querygate.ConfigFromAppOpts(appOpts)
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	app.QueryGate = querygate.NewGate(cfg)
+	return nil
+}
+
+// registerSnapshotExtensions adds the wasm and provenance extensions to the state-sync snapshot manager (if there is one).
+func (app *App) registerSnapshotExtensions() {
+	manager := app.SnapshotManager()
+	if manager == nil {
+		return
+	}
+
+	// The provenance extension verifies the stores of the provenance modules after they're restored.
+	storeKeys := make([]storetypes.StoreKey, 0, len(provSnapshotStoreNames))
+	for _, name := range provSnapshotStoreNames {
+		storeKeys = append(storeKeys, app.keys[name])
+	}
+
+	err := manager.RegisterExtensions(
+		wasmkeeper.NewWasmSnapshotter(app.CommitMultiStore(), app.WasmKeeper),
+		snapshots.NewStoreDigestSnapshotter(app.CommitMultiStore(), storeKeys),
+	)
+	if err != nil {
+		panic(fmt.Errorf("failed to register snapshot extensions: %w", err))
+	}
+}
+
 func (app *App) registerUpgradeHandlers() {
 	// Add the upgrade handlers for each release.
 	InstallCustomUpgradeHandlers(app)
@@ -1075,8 +1345,14 @@ func (app *App) GetTxConfig() client.TxConfig {
 func (app *App) Name() string { return app.BaseApp.Name() }
 
 // PreBlocker application updates every pre block
-func (app *App) PreBlocker(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
-	return app.mm.PreBlock(ctx)
+func (app *App) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+	resp, err := app.mm.PreBlock(ctx)
+	if err != nil {
+		return resp, err
+	}
+	app.NAVVoteExtHandler.PreBlocker(ctx, req)
+	app.UnorderedTxKeeper.RemoveExpiredNonces(ctx)
+	return resp, nil
 }
 
 // BeginBlocker application updates every begin block
@@ -1230,15 +1506,40 @@ func (app *App) RegisterAPIRoutes(apiSvr *api.Server, apiConfig serverconfig.API
 	// Register grpc-gateway routes for all modules.
 	app.BasicModuleManager.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
+	// Register grpc-gateway routes for the tx summary service.
+	if err := txsummary.RegisterQueryHandlerClient(context.Background(), apiSvr.GRPCGatewayRouter, txsummary.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+
 	// Register swagger API
 	if err := RegisterSwaggerAPI(apiSvr.ClientCtx, apiSvr.Router, apiConfig.Swagger); err != nil {
 		panic(err)
 	}
 }
 
+// RegisterGRPCServer registers the app's gRPC services with the provided gRPC server.
+func (app *App) RegisterGRPCServer(server gogogrpc.Server) {
+	app.BaseApp.RegisterGRPCServer(querygate.NewGatedServer(server, app.QueryGate))
+	if app.StateStreamServer != nil {
+		streaming.RegisterStateStreamServiceServer(server, app.StateStreamServer)
+	}
+}
+
+// Query implements the ABCI Query method, returning an error for any expensive queries not allowed by the query gate.
+func (app *App) Query(ctx context.Context, req *abci.RequestQuery) (*abci.ResponseQuery, error) {
+	if err := app.QueryGate.Check(req.Path); err != nil {
+		return sdkerrors.QueryResult(err, false), nil
+	}
+	return app.BaseApp.Query(ctx, req)
+}
+
 // RegisterTxService implements the Application.RegisterTxService method.
 func (app *App) RegisterTxService(clientCtx client.Context) {
 	authtx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.BaseApp.Simulate, app.interfaceRegistry)
+	txsummary.RegisterQueryServer(app.BaseApp.GRPCQueryRouter(), txsummary.NewServer(
+		txsummary.NewSummarizer(app.appCodec, app.txConfig.TxDecoder(), app.BankKeeper, app.NameKeeper, app.MarkerKeeper),
+		txsummary.NewNodeTxFetcher(clientCtx),
+	))
 }
 
 // RegisterTendermintService implements the Application.RegisterTendermintService method.
@@ -14,6 +14,8 @@ import (
 
 	"cosmossdk.io/log"
 	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
 
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -28,11 +30,16 @@ import (
 	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	paramprops "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 	"github.com/cosmos/gogoproto/proto"
+	icqtypes "github.com/cosmos/ibc-apps/modules/async-icq/v8/types"
+	icahosttypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
+	ibcfee "github.com/cosmos/ibc-go/v8/modules/apps/29-fee"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 
 	"github.com/provenance-io/provenance/internal/pioconfig"
 	"github.com/provenance-io/provenance/testutil/assertions"
 	markermodule "github.com/provenance-io/provenance/x/marker"
 	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	oracletypes "github.com/provenance-io/provenance/x/oracle/types"
 )
 
 func TestSimAppExportAndBlockedAddrs(t *testing.T) {
@@ -74,6 +81,52 @@ func TestSimAppExportAndBlockedAddrs(t *testing.T) {
 	require.NoError(t, err, "ExportAppStateAndValidators at zero height")
 }
 
+func TestIBCFeeMiddlewareRoutes(t *testing.T) {
+	app := Setup(t)
+
+	tests := []struct {
+		port   string
+		expFee bool
+	}{
+		{port: icahosttypes.SubModuleName, expFee: true},
+		{port: icqtypes.ModuleName, expFee: true},
+		{port: oracletypes.ModuleName, expFee: true},
+		{port: ibctransfertypes.ModuleName, expFee: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.port, func(t *testing.T) {
+			route, found := app.IBCKeeper.PortKeeper.Router.GetRoute(tc.port)
+			require.True(t, found, "GetRoute(%q) found", tc.port)
+			_, isFee := route.(ibcfee.IBCMiddleware)
+			assert.Equal(t, tc.expFee, isFee, "whether the %q route is wrapped in the fee middleware", tc.port)
+		})
+	}
+}
+
+func TestRegisterSnapshotExtensions(t *testing.T) {
+	snapshotDB, err := dbm.NewDB("metadata", dbm.MemDBBackend, "")
+	require.NoError(t, err, "dbm.NewDB")
+	snapshotStore, err := snapshots.NewStore(snapshotDB, t.TempDir())
+	require.NoError(t, err, "snapshots.NewStore")
+
+	baseAppOpts := []func(*baseapp.BaseApp){
+		fauxMerkleModeOpt,
+		baseapp.SetChainID(pioconfig.SimAppChainID),
+		baseapp.SetSnapshot(snapshotStore, snapshottypes.NewSnapshotOptions(1000, 2)),
+	}
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, newSimAppOpts(t), baseAppOpts...)
+	require.NotNil(t, app.SnapshotManager(), "SnapshotManager()")
+
+	for _, name := range provSnapshotStoreNames {
+		assert.NotNil(t, app.keys[name], "store key %q", name)
+	}
+
+	// The extensions were registered when the app was created, so registering them again should fail.
+	assert.PanicsWithError(t, "failed to register snapshot extensions: duplicated snapshotter name: wasm",
+		app.registerSnapshotExtensions, "registerSnapshotExtensions")
+}
+
 func TestExportAppStateAndValidators(t *testing.T) {
 	opts := SetupOptions{
 		Logger:  log.NewTestLogger(t),
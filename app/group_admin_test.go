@@ -0,0 +1,158 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/gogoproto/proto"
+
+	attributetypes "github.com/provenance-io/provenance/x/attribute/types"
+	"github.com/provenance-io/provenance/x/exchange"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
+)
+
+// groupAdminTestSetup creates a new app and context, and a group (with a single member) that administers itself
+// through a group policy with a threshold of 1. It returns the group policy address and the member's address.
+func groupAdminTestSetup(t *testing.T) (*App, sdk.Context, sdk.AccAddress, sdk.AccAddress) {
+	t.Helper()
+	app := Setup(t)
+	ctx := app.BaseApp.NewContext(false).WithBlockTime(time.Now().UTC())
+
+	member := sdk.AccAddress("group_member________")
+	policy := group.NewThresholdDecisionPolicy("1", time.Hour, 0)
+	msg, err := group.NewMsgCreateGroupWithPolicy(member.String(),
+		[]group.MemberRequest{{Address: member.String(), Weight: "1"}}, "", "", true, policy)
+	require.NoError(t, err, "NewMsgCreateGroupWithPolicy")
+	res, err := app.GroupKeeper.CreateGroupWithPolicy(ctx, msg)
+	require.NoError(t, err, "CreateGroupWithPolicy")
+	policyAddr, err := sdk.AccAddressFromBech32(res.GroupPolicyAddress)
+	require.NoError(t, err, "AccAddressFromBech32(GroupPolicyAddress)")
+	require.NotNil(t, app.AccountKeeper.GetAccount(ctx, policyAddr), "group policy account")
+
+	return app, ctx, policyAddr, member
+}
+
+// execAsGroup submits a group proposal containing the provided msgs and tries to execute it immediately.
+// It returns the result of the execution along with any logs from it.
+func execAsGroup(t *testing.T, app *App, ctx sdk.Context, policyAddr, member sdk.AccAddress, msgs ...sdk.Msg) (group.ProposalExecutorResult, string) {
+	t.Helper()
+	prop, err := group.NewMsgSubmitProposal(policyAddr.String(), []string{member.String()}, msgs, "", group.Exec_EXEC_TRY, "test", "test")
+	require.NoError(t, err, "NewMsgSubmitProposal")
+
+	em := sdk.NewEventManager()
+	_, err = app.GroupKeeper.SubmitProposal(ctx.WithEventManager(em), prop)
+	require.NoError(t, err, "SubmitProposal")
+
+	for _, event := range em.Events() {
+		if event.Type != proto.MessageName(&group.EventExec{}) {
+			continue
+		}
+		tev, perr := sdk.ParseTypedEvent(abci.Event(event))
+		require.NoError(t, perr, "ParseTypedEvent(%s)", event.Type)
+		exec, ok := tev.(*group.EventExec)
+		require.True(t, ok, "event is a %T, expected %T", tev, exec)
+		return exec.Result, exec.Logs
+	}
+	t.Fatalf("no %s event emitted by SubmitProposal", proto.MessageName(&group.EventExec{}))
+	return group.PROPOSAL_EXECUTOR_RESULT_UNSPECIFIED, ""
+}
+
+func TestGroupExecutedMarketUpdate(t *testing.T) {
+	app, ctx, policyAddr, member := groupAdminTestSetup(t)
+
+	marketID, err := app.ExchangeKeeper.CreateMarket(ctx, exchange.Market{
+		MarketDetails: exchange.MarketDetails{Name: "Group Market"},
+		AccessGrants: []exchange.AccessGrant{
+			{Address: policyAddr.String(), Permissions: []exchange.Permission{exchange.Permission_update}},
+		},
+	})
+	require.NoError(t, err, "CreateMarket")
+
+	msg := &exchange.MsgMarketUpdateDetailsRequest{
+		Admin:         policyAddr.String(),
+		MarketId:      marketID,
+		MarketDetails: exchange.MarketDetails{Name: "Updated By Group"},
+	}
+	result, logs := execAsGroup(t, app, ctx, policyAddr, member, msg)
+	require.Equal(t, group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, result, "proposal result, logs: %s", logs)
+
+	market := app.ExchangeKeeper.GetMarket(ctx, marketID)
+	require.NotNil(t, market, "GetMarket(%d)", marketID)
+	assert.Equal(t, "Updated By Group", market.MarketDetails.Name, "market name")
+
+	// A group without permissions in the market should not be able to update it.
+	app2, ctx2, policyAddr2, member2 := groupAdminTestSetup(t)
+	marketID2, err := app2.ExchangeKeeper.CreateMarket(ctx2, exchange.Market{MarketDetails: exchange.MarketDetails{Name: "Not The Group's"}})
+	require.NoError(t, err, "CreateMarket without group permissions")
+	msg.Admin = policyAddr2.String()
+	msg.MarketId = marketID2
+	result, _ = execAsGroup(t, app2, ctx2, policyAddr2, member2, msg)
+	assert.Equal(t, group.PROPOSAL_EXECUTOR_RESULT_FAILURE, result, "proposal result without permission")
+	market2 := app2.ExchangeKeeper.GetMarket(ctx2, marketID2)
+	require.NotNil(t, market2, "GetMarket(%d) without group permissions", marketID2)
+	assert.Equal(t, "Not The Group's", market2.MarketDetails.Name, "market name without group permissions")
+}
+
+func TestGroupExecutedNameBind(t *testing.T) {
+	app, ctx, policyAddr, member := groupAdminTestSetup(t)
+	other := sdk.AccAddress("other_address_______")
+
+	require.NoError(t, app.NameKeeper.SetNameRecord(ctx, "grouproot", policyAddr, true), "SetNameRecord(grouproot)")
+
+	msg := nametypes.NewMsgBindNameRequest(
+		nametypes.NewNameRecord("sub", other, false),
+		nametypes.NewNameRecord("grouproot", policyAddr, true),
+	)
+	result, logs := execAsGroup(t, app, ctx, policyAddr, member, msg)
+	require.Equal(t, group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, result, "proposal result, logs: %s", logs)
+	assert.True(t, app.NameKeeper.ResolvesTo(ctx, "sub.grouproot", other), "sub.grouproot resolves to other")
+}
+
+func TestGroupExecutedAttributeManagement(t *testing.T) {
+	app, ctx, policyAddr, member := groupAdminTestSetup(t)
+	target := sdk.AccAddress("attribute_target____")
+
+	require.NoError(t, app.NameKeeper.SetNameRecord(ctx, "groupattr", policyAddr, true), "SetNameRecord(groupattr)")
+
+	add := attributetypes.NewMsgAddAttributeRequest(target.String(), policyAddr, "groupattr",
+		attributetypes.AttributeType_String, []byte("first"))
+	result, logs := execAsGroup(t, app, ctx, policyAddr, member, add)
+	require.Equal(t, group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, result, "add proposal result, logs: %s", logs)
+	attrs, err := app.AttributeKeeper.GetAttributes(ctx, target.String(), "groupattr")
+	require.NoError(t, err, "GetAttributes after add")
+	require.Len(t, attrs, 1, "attributes after add")
+	assert.Equal(t, "first", string(attrs[0].Value), "attribute value after add")
+
+	del := attributetypes.NewMsgDeleteAttributeRequest(target.String(), policyAddr, "groupattr")
+	result, logs = execAsGroup(t, app, ctx, policyAddr, member, del)
+	require.Equal(t, group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, result, "delete proposal result, logs: %s", logs)
+	attrs, err = app.AttributeKeeper.GetAttributes(ctx, target.String(), "groupattr")
+	require.NoError(t, err, "GetAttributes after delete")
+	assert.Empty(t, attrs, "attributes after delete")
+}
+
+func TestGroupExecutedMarkerMint(t *testing.T) {
+	app, ctx, policyAddr, member := groupAdminTestSetup(t)
+	denom := "groupcoin"
+
+	marker := markertypes.NewEmptyMarkerAccount(denom, policyAddr.String(), []markertypes.AccessGrant{
+		{Address: policyAddr.String(), Permissions: markertypes.AccessList{markertypes.Access_Mint, markertypes.Access_Admin}},
+	})
+	marker.Supply = sdkmath.NewInt(1000)
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker")
+
+	msg := markertypes.NewMsgMintRequest(policyAddr, sdk.NewInt64Coin(denom, 500), nil)
+	result, logs := execAsGroup(t, app, ctx, policyAddr, member, msg)
+	require.Equal(t, group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, result, "proposal result, logs: %s", logs)
+	assert.Equal(t, sdk.NewInt64Coin(denom, 1500).String(), app.BankKeeper.GetSupply(ctx, denom).String(), "supply after mint")
+}
@@ -0,0 +1,95 @@
+package app
+
+import (
+	"fmt"
+	"runtime/debug"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InvariantResult is the outcome of running a single registered invariant.
+type InvariantResult struct {
+	// Module is the name of the module that registered the invariant.
+	Module string
+	// Route is the name of the invariant.
+	Route string
+	// Msg is the message returned by the invariant.
+	Msg string
+	// Broken is whether the invariant reported that it was broken.
+	Broken bool
+	// Duration is how long the invariant took to run.
+	Duration time.Duration
+	// Err is the panic of the invariant (if it panicked). An invariant that panics is also considered broken.
+	Err error
+}
+
+// FullRoute returns the "<module>/<route>" name of the invariant.
+func (r InvariantResult) FullRoute() string {
+	return r.Module + "/" + r.Route
+}
+
+// GetInvariantModules returns the names of all the modules that have registered invariants, sorted alphabetically.
+func (app *App) GetInvariantModules() []string {
+	seen := make(map[string]bool)
+	var rv []string
+	for _, route := range app.CrisisKeeper.Routes() {
+		if !seen[route.ModuleName] {
+			seen[route.ModuleName] = true
+			rv = append(rv, route.ModuleName)
+		}
+	}
+	sort.Strings(rv)
+	return rv
+}
+
+// RunInvariants runs the registered invariants against the latest loaded state without committing anything.
+// If modules are provided, only the invariants registered by those modules are run.
+// An error is only returned if the invariants could not be run. Broken invariants are in the results.
+func (app *App) RunInvariants(modules ...string) ([]InvariantResult, error) {
+	known := app.GetInvariantModules()
+	for _, module := range modules {
+		if !slices.Contains(known, module) {
+			return nil, fmt.Errorf("no invariants registered for module %q, modules with invariants: %s",
+				module, strings.Join(known, ", "))
+		}
+	}
+
+	ctx := app.NewUncachedContext(false, cmtproto.Header{
+		ChainID: app.ChainID(),
+		Height:  app.LastBlockHeight(),
+	}).WithMultiStore(app.CommitMultiStore().CacheMultiStore())
+
+	var rv []InvariantResult
+	for _, route := range app.CrisisKeeper.Routes() {
+		if len(modules) > 0 && !slices.Contains(modules, route.ModuleName) {
+			continue
+		}
+		result := InvariantResult{Module: route.ModuleName, Route: route.Route}
+		invCtx, _ := ctx.CacheContext()
+		start := time.Now()
+		result.Msg, result.Broken, result.Err = runInvariantSafely(invCtx, route.Invar)
+		result.Duration = time.Since(start)
+		if result.Err != nil {
+			result.Broken = true
+		}
+		rv = append(rv, result)
+	}
+	return rv, nil
+}
+
+// runInvariantSafely calls the provided invariant, converting a panic into an error.
+func runInvariantSafely(ctx sdk.Context, invar sdk.Invariant) (msg string, broken bool, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("invariant panicked: %v\n%s", r, debug.Stack())
+		}
+	}()
+	msg, broken = invar(ctx)
+	return msg, broken, nil
+}
@@ -0,0 +1,71 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+	"github.com/provenance-io/provenance/x/exchange"
+	exchangekeeper "github.com/provenance-io/provenance/x/exchange/keeper"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+)
+
+func TestRunInvariants(t *testing.T) {
+	app := setupDryRunApp(t, nil)
+
+	modules := app.GetInvariantModules()
+	for _, exp := range []string{"bank", "exchange", "hold", "marker", "metadata"} {
+		assert.Contains(t, modules, exp, "GetInvariantModules()")
+	}
+
+	t.Run("unknown module", func(t *testing.T) {
+		results, err := app.RunInvariants("nope")
+		assertions.AssertErrorContents(t, err, []string{"no invariants registered for module \"nope\"", "exchange"}, "RunInvariants error")
+		assert.Nil(t, results, "RunInvariants results")
+	})
+
+	t.Run("all ok", func(t *testing.T) {
+		results, err := app.RunInvariants()
+		require.NoError(t, err, "RunInvariants error")
+		assert.Len(t, results, len(app.CrisisKeeper.Routes()), "RunInvariants results")
+		for _, result := range results {
+			assert.False(t, result.Broken, "%s broken: %s", result.FullRoute(), result.Msg)
+			assert.NoError(t, result.Err, "%s err", result.FullRoute())
+		}
+	})
+
+	t.Run("only some modules", func(t *testing.T) {
+		results, err := app.RunInvariants(exchange.ModuleName, metadatatypes.ModuleName)
+		require.NoError(t, err, "RunInvariants error")
+		var routes []string
+		for _, result := range results {
+			routes = append(routes, result.FullRoute())
+		}
+		expRoutes := []string{
+			"exchange/Order-Indexes", "exchange/Required-Holds", "exchange/Orphaned-Holds",
+			"metadata/Referential-Integrity",
+		}
+		assert.ElementsMatch(t, expRoutes, routes, "routes run")
+	})
+
+	t.Run("broken exchange index", func(t *testing.T) {
+		store := app.CommitMultiStore().GetKVStore(app.keys[exchange.StoreKey])
+		key := exchangekeeper.MakeIndexKeyMarketToOrder(1, 8)
+		store.Set(key, []byte{exchangekeeper.OrderKeyTypeAsk})
+		defer store.Delete(key)
+
+		results, err := app.RunInvariants(exchange.ModuleName)
+		require.NoError(t, err, "RunInvariants error")
+		require.Len(t, results, 3, "RunInvariants results")
+		for _, result := range results {
+			if result.Route != "Order-Indexes" {
+				assert.False(t, result.Broken, "%s broken: %s", result.FullRoute(), result.Msg)
+				continue
+			}
+			assert.True(t, result.Broken, "Broken")
+			assert.Contains(t, result.Msg, "market to order index has an entry for order 8, which does not exist", "Msg")
+		}
+	})
+}
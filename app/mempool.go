@@ -0,0 +1,63 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/provenance-io/provenance/internal/mempool"
+	"github.com/provenance-io/provenance/x/exchange"
+	oracletypes "github.com/provenance-io/provenance/x/oracle/types"
+)
+
+const (
+	// SettlementLaneName is the name of the mempool lane for market settlements.
+	SettlementLaneName = "settlement"
+	// OracleLaneName is the name of the mempool lane for oracle packets.
+	OracleLaneName = "oracle"
+	// GovLaneName is the name of the mempool lane for gov msgs.
+	GovLaneName = "gov"
+)
+
+// NewMempool creates the app-side mempool. Market settlements, oracle packets, and gov msgs each get their own
+// lane that is included in blocks ahead of all other txs. Each lane can hold up to maxTxs txs (unlimited if zero).
+// A negative maxTxs (the SDK default) means there is no app-side mempool, so the SDK's no-op mempool is returned.
+func NewMempool(maxTxs int) sdkmempool.Mempool {
+	if maxTxs < 0 {
+		return sdkmempool.NoOpMempool{}
+	}
+	return mempool.NewLaneMempool(maxTxs,
+		mempool.NewLane(SettlementLaneName, mempool.MsgTypeURLs(sdk.MsgTypeURL(&exchange.MsgMarketSettleRequest{}))),
+		mempool.Lane{Name: OracleLaneName, Match: isOracleTx},
+		mempool.NewLane(GovLaneName, mempool.MsgTypeURLPrefix("/cosmos.gov.")),
+	)
+}
+
+// isOracleTx returns true if the tx has at least one oracle packet msg and all the other msgs are
+// either oracle packet msgs or client updates (which relayers usually include with their packets).
+func isOracleTx(tx sdk.Tx) bool {
+	hasPacket := false
+	for _, msg := range tx.GetMsgs() {
+		switch m := msg.(type) {
+		case *clienttypes.MsgUpdateClient:
+			continue
+		case *channeltypes.MsgRecvPacket:
+			if m.Packet.DestinationPort != oracletypes.PortID {
+				return false
+			}
+		case *channeltypes.MsgAcknowledgement:
+			if m.Packet.SourcePort != oracletypes.PortID {
+				return false
+			}
+		case *channeltypes.MsgTimeout:
+			if m.Packet.SourcePort != oracletypes.PortID {
+				return false
+			}
+		default:
+			return false
+		}
+		hasPacket = true
+	}
+	return hasPacket
+}
@@ -0,0 +1,156 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/provenance-io/provenance/internal/mempool"
+	"github.com/provenance-io/provenance/x/exchange"
+	oracletypes "github.com/provenance-io/provenance/x/oracle/types"
+)
+
+func TestNewMempool(t *testing.T) {
+	tests := []struct {
+		name   string
+		maxTxs int
+		exp    sdkmempool.Mempool
+	}{
+		{
+			name:   "sdk default",
+			maxTxs: serverconfig.DefaultConfig().Mempool.MaxTxs,
+			exp:    sdkmempool.NoOpMempool{},
+		},
+		{
+			name:   "negative one",
+			maxTxs: -1,
+			exp:    sdkmempool.NoOpMempool{},
+		},
+		{
+			name:   "zero",
+			maxTxs: 0,
+			exp:    &mempool.LaneMempool{},
+		},
+		{
+			name:   "positive",
+			maxTxs: 5000,
+			exp:    &mempool.LaneMempool{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			act := NewMempool(tc.maxTxs)
+			assert.IsType(t, tc.exp, act, "NewMempool(%d)", tc.maxTxs)
+		})
+	}
+}
+
+func TestNewMempoolLanes(t *testing.T) {
+	txConfig := MakeTestEncodingConfig(t).TxConfig
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubKey.Address())
+	newTx := func(msgs ...sdk.Msg) sdk.Tx {
+		builder := txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msgs...), "SetMsgs")
+		sig := signingtypes.SignatureV2{
+			PubKey: pubKey,
+			Data:   &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_DIRECT},
+		}
+		require.NoError(t, builder.SetSignatures(sig), "SetSignatures")
+		return builder.GetTx()
+	}
+
+	settle := &exchange.MsgMarketSettleRequest{Admin: addr.String(), MarketId: 1}
+	send := banktypes.NewMsgSend(addr, sdk.AccAddress("to__________________"), sdk.NewCoins(sdk.NewInt64Coin("nhash", 1)))
+	oraclePacket := channeltypes.Packet{SourcePort: oracletypes.PortID, DestinationPort: oracletypes.PortID}
+	transferPacket := channeltypes.Packet{SourcePort: transfertypes.PortID, DestinationPort: transfertypes.PortID}
+	updateClient := &clienttypes.MsgUpdateClient{ClientId: "07-tendermint-0", Signer: addr.String()}
+
+	tests := []struct {
+		name string
+		msgs []sdk.Msg
+		exp  string
+	}{
+		{
+			name: "market settle",
+			msgs: []sdk.Msg{settle},
+			exp:  SettlementLaneName,
+		},
+		{
+			name: "market settle with a send",
+			msgs: []sdk.Msg{settle, send},
+			exp:  mempool.DefaultLaneName,
+		},
+		{
+			name: "oracle recv packet",
+			msgs: []sdk.Msg{&channeltypes.MsgRecvPacket{Packet: oraclePacket, Signer: addr.String()}},
+			exp:  OracleLaneName,
+		},
+		{
+			name: "client update with oracle acknowledgement",
+			msgs: []sdk.Msg{updateClient, &channeltypes.MsgAcknowledgement{Packet: oraclePacket, Signer: addr.String()}},
+			exp:  OracleLaneName,
+		},
+		{
+			name: "oracle timeout",
+			msgs: []sdk.Msg{&channeltypes.MsgTimeout{Packet: oraclePacket, Signer: addr.String()}},
+			exp:  OracleLaneName,
+		},
+		{
+			name: "client update only",
+			msgs: []sdk.Msg{updateClient},
+			exp:  mempool.DefaultLaneName,
+		},
+		{
+			name: "transfer recv packet",
+			msgs: []sdk.Msg{&channeltypes.MsgRecvPacket{Packet: transferPacket, Signer: addr.String()}},
+			exp:  mempool.DefaultLaneName,
+		},
+		{
+			name: "oracle and transfer recv packets",
+			msgs: []sdk.Msg{
+				&channeltypes.MsgRecvPacket{Packet: oraclePacket, Signer: addr.String()},
+				&channeltypes.MsgRecvPacket{Packet: transferPacket, Signer: addr.String()},
+			},
+			exp: mempool.DefaultLaneName,
+		},
+		{
+			name: "gov vote",
+			msgs: []sdk.Msg{govv1.NewMsgVote(addr, 1, govv1.OptionYes, "")},
+			exp:  GovLaneName,
+		},
+		{
+			name: "legacy gov vote",
+			msgs: []sdk.Msg{govv1beta1.NewMsgVote(addr, 1, govv1beta1.OptionYes)},
+			exp:  GovLaneName,
+		},
+		{
+			name: "send",
+			msgs: []sdk.Msg{send},
+			exp:  mempool.DefaultLaneName,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mp, ok := NewMempool(0).(*mempool.LaneMempool)
+			require.True(t, ok, "NewMempool(0) returned a %T, expected a %T", mp, &mempool.LaneMempool{})
+			act := mp.GetLaneName(newTx(tc.msgs...))
+			assert.Equal(t, tc.exp, act, "GetLaneName")
+		})
+	}
+}
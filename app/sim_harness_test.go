@@ -0,0 +1,374 @@
+package app
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	simcli "github.com/cosmos/cosmos-sdk/x/simulation/client/cli"
+
+	"github.com/provenance-io/provenance/internal/pioconfig"
+)
+
+var (
+	// FlagSimConfigFileValue is the path to a sim harness config file (YAML or JSON) with weights and scenario params.
+	FlagSimConfigFileValue string
+	// FlagSimRecordFileValue is the path to write a record of a sim harness run to (so that it can be replayed).
+	FlagSimRecordFileValue string
+	// FlagSimReplayFileValue is the path to a sim harness record file to replay.
+	FlagSimReplayFileValue string
+)
+
+func init() {
+	flag.StringVar(&FlagSimConfigFileValue, "SimConfigFile", "", "sim harness config file with op weights and scenario params")
+	flag.StringVar(&FlagSimRecordFileValue, "SimRecordFile", "", "file to record the sim harness seed, params, and app hash to")
+	flag.StringVar(&FlagSimReplayFileValue, "SimReplayFile", "", "sim harness record file to replay")
+}
+
+// simHarnessConfig is the contents of a sim harness config file.
+// Zero values are ignored, leaving the value provided by the standard sim flags.
+type simHarnessConfig struct {
+	// Seed is the seed to use for the simulation.
+	Seed int64 `json:"seed,omitempty"`
+	// NumBlocks is the number of blocks to simulate.
+	NumBlocks int `json:"num_blocks,omitempty"`
+	// BlockSize is the number of operations to run in each block.
+	BlockSize int `json:"block_size,omitempty"`
+	// Weights are the operation weights to use, keyed by the module's op weight key, e.g. "op_weight_msg_add_marker".
+	Weights map[string]int `json:"weights,omitempty"`
+	// Params are other simulation app params (e.g. randomized genesis values) keyed by their param name.
+	Params map[string]json.RawMessage `json:"params,omitempty"`
+}
+
+// simHarnessRecord is a record of a sim harness run that can be used to replay it.
+type simHarnessRecord struct {
+	// Seed is the seed used for the simulation.
+	Seed int64 `json:"seed"`
+	// NumBlocks is the number of blocks that were simulated.
+	NumBlocks int `json:"num_blocks"`
+	// BlockSize is the number of operations run in each block.
+	BlockSize int `json:"block_size"`
+	// AppParams are all the simulation app params provided to the simulation (including op weights).
+	AppParams simtypes.AppParams `json:"app_params"`
+	// AppHash is the hex app hash after the last block, or empty if the simulation failed.
+	AppHash string `json:"app_hash,omitempty"`
+	// Error is the error returned by the simulation, if there was one.
+	Error string `json:"error,omitempty"`
+}
+
+// loadSimHarnessConfig reads a sim harness config file.
+func loadSimHarnessConfig(filename string) (*simHarnessConfig, error) {
+	bz, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("could not read sim config file: %w", err)
+	}
+	jsonBz, err := yaml.YAMLToJSON(bz)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse sim config file %q: %w", filename, err)
+	}
+	rv := &simHarnessConfig{}
+	if err = json.Unmarshal(jsonBz, rv); err != nil {
+		return nil, fmt.Errorf("could not parse sim config file %q: %w", filename, err)
+	}
+	return rv, nil
+}
+
+// loadSimHarnessRecord reads a sim harness record file.
+func loadSimHarnessRecord(filename string) (*simHarnessRecord, error) {
+	bz, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("could not read sim record file: %w", err)
+	}
+	rv := &simHarnessRecord{}
+	if err = json.Unmarshal(bz, rv); err != nil {
+		return nil, fmt.Errorf("could not parse sim record file %q: %w", filename, err)
+	}
+	return rv, nil
+}
+
+// readAppParams reads the app params from the config's params file (if there is one).
+func readAppParams(config simtypes.Config) (simtypes.AppParams, error) {
+	rv := make(simtypes.AppParams)
+	if len(config.ParamsFile) == 0 {
+		return rv, nil
+	}
+	bz, err := os.ReadFile(config.ParamsFile)
+	if err != nil {
+		return nil, fmt.Errorf("could not read sim params file: %w", err)
+	}
+	if err = json.Unmarshal(bz, &rv); err != nil {
+		return nil, fmt.Errorf("could not parse sim params file %q: %w", config.ParamsFile, err)
+	}
+	return rv, nil
+}
+
+// applyTo updates the provided config using the values in this harness config and
+// returns the resulting app params (the params file contents, overwritten by the config file's params and weights).
+func (c simHarnessConfig) applyTo(config *simtypes.Config) (simtypes.AppParams, error) {
+	if c.Seed != 0 {
+		config.Seed = c.Seed
+	}
+	if c.NumBlocks != 0 {
+		config.NumBlocks = c.NumBlocks
+	}
+	if c.BlockSize != 0 {
+		config.BlockSize = c.BlockSize
+	}
+
+	rv, err := readAppParams(*config)
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range c.Params {
+		rv[key] = value
+	}
+	for key, weight := range c.Weights {
+		if weight < 0 {
+			return nil, fmt.Errorf("invalid weight %d for %q: cannot be negative", weight, key)
+		}
+		rv[key] = json.RawMessage(fmt.Sprintf("%d", weight))
+	}
+	return rv, nil
+}
+
+// applyTo updates the provided config to match this record and returns the recorded app params.
+func (r simHarnessRecord) applyTo(config *simtypes.Config) simtypes.AppParams {
+	config.Seed = r.Seed
+	config.NumBlocks = r.NumBlocks
+	config.BlockSize = r.BlockSize
+	if r.AppParams == nil {
+		return make(simtypes.AppParams)
+	}
+	return r.AppParams
+}
+
+// writeAppParamsFile writes the app params to a new file in the provided dir and updates the config to use it.
+func writeAppParamsFile(config *simtypes.Config, dir string, appParams simtypes.AppParams) error {
+	bz, err := json.Marshal(appParams)
+	if err != nil {
+		return fmt.Errorf("could not marshal sim app params: %w", err)
+	}
+	filename := filepath.Join(dir, "sim_harness_params.json")
+	if err = os.WriteFile(filename, bz, 0o644); err != nil {
+		return fmt.Errorf("could not write sim app params file: %w", err)
+	}
+	config.ParamsFile = filename
+	return nil
+}
+
+// writeSimHarnessRecord writes the provided record to the given file.
+func writeSimHarnessRecord(filename string, record simHarnessRecord) error {
+	bz, err := json.MarshalIndent(record, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not marshal sim record: %w", err)
+	}
+	if err = os.WriteFile(filename, bz, 0o644); err != nil {
+		return fmt.Errorf("could not write sim record file: %w", err)
+	}
+	return nil
+}
+
+// runSimHarnessOnce runs a single simulation with the provided config, returning the app it used.
+func runSimHarnessOnce(t *testing.T, config simtypes.Config) (*App, error) {
+	var logger log.Logger
+	if simcli.FlagVerboseValue {
+		logger = log.NewTestLogger(t)
+	} else {
+		logger = log.NewNopLogger()
+	}
+
+	app := New(logger, dbm.NewMemDB(), nil, true, newSimAppOpts(t), interBlockCacheOpt(), baseapp.SetChainID(config.ChainID))
+	if !simcli.FlagSigverifyTxValue {
+		app.SetNotSigverifyTx()
+	}
+
+	_, _, err := simulation.SimulateFromSeed(
+		t,
+		os.Stdout,
+		app.BaseApp,
+		provAppStateFn(app.AppCodec(), app.SimulationManager(), app.DefaultGenesis()),
+		simtypes.RandomAccounts,
+		simtestutil.SimulationOperations(app, app.AppCodec(), config),
+		app.ModuleAccountAddrs(),
+		config,
+		app.AppCodec(),
+	)
+	return app, err
+}
+
+// diffSimApps compares the kv stores of the two apps, returning a description of each store that differs.
+func diffSimApps(appA, appB *App) []string {
+	ctxA := appA.NewContextLegacy(true, cmtproto.Header{Height: appA.LastBlockHeight()})
+	ctxB := appB.NewContextLegacy(true, cmtproto.Header{Height: appB.LastBlockHeight()})
+
+	var rv []string
+	for _, keyA := range appA.GetStoreKeys() {
+		if _, ok := keyA.(*storetypes.KVStoreKey); !ok {
+			continue
+		}
+		keyName := keyA.Name()
+		failedKVAs, failedKVBs := simtestutil.DiffKVStores(ctxA.KVStore(keyA), ctxB.KVStore(appB.GetKey(keyName)), nil)
+		if len(failedKVAs) == 0 && len(failedKVBs) == 0 {
+			continue
+		}
+
+		// Make the lists the same length because GetSimulationLog assumes they're that way.
+		for len(failedKVBs) < len(failedKVAs) {
+			failedKVBs = append(failedKVBs, kv.Pair{Key: []byte{}, Value: []byte{}})
+		}
+		for len(failedKVBs) > len(failedKVAs) {
+			failedKVAs = append(failedKVAs, kv.Pair{Key: []byte{}, Value: []byte{}})
+		}
+		rv = append(rv, fmt.Sprintf("store %q has %d differing entries:\n%s", keyName, len(failedKVAs),
+			simtestutil.GetSimulationLog(keyName, appA.SimulationManager().StoreDecoders, failedKVAs, failedKVBs)))
+	}
+	return rv
+}
+
+// TestSimHarness runs a simulation twice with the same seed and params, failing with state diffs if they diverge.
+// Use -SimConfigFile to provide op weights and scenario params, -SimRecordFile to record the run,
+// and -SimReplayFile to replay a previously recorded run (making sure it ends with the same app hash).
+func TestSimHarness(t *testing.T) {
+	if !simcli.FlagEnabledValue {
+		t.Skip("skipping application simulation")
+	}
+
+	config := simcli.NewConfigFromFlags()
+	config.InitialBlockHeight = 1
+	config.ExportParamsPath = ""
+	config.ExportStatePath = ""
+	config.ExportStatsPath = ""
+	config.OnOperation = false
+	config.AllInvariants = false
+	config.ChainID = pioconfig.SimAppChainID
+	config.DBBackend = "memdb"
+	config.Commit = true
+
+	var replay *simHarnessRecord
+	var appParams simtypes.AppParams
+	var err error
+	switch {
+	case len(FlagSimReplayFileValue) > 0:
+		replay, err = loadSimHarnessRecord(FlagSimReplayFileValue)
+		require.NoError(t, err, "loadSimHarnessRecord")
+		appParams = replay.applyTo(&config)
+	case len(FlagSimConfigFileValue) > 0:
+		var harnessConfig *simHarnessConfig
+		harnessConfig, err = loadSimHarnessConfig(FlagSimConfigFileValue)
+		require.NoError(t, err, "loadSimHarnessConfig")
+		appParams, err = harnessConfig.applyTo(&config)
+		require.NoError(t, err, "harnessConfig.applyTo")
+	default:
+		appParams, err = readAppParams(config)
+		require.NoError(t, err, "readAppParams")
+	}
+	require.NoError(t, writeAppParamsFile(&config, t.TempDir(), appParams), "writeAppParamsFile")
+	printConfig(config)
+
+	record := simHarnessRecord{
+		Seed:      config.Seed,
+		NumBlocks: config.NumBlocks,
+		BlockSize: config.BlockSize,
+		AppParams: appParams,
+	}
+
+	fmt.Printf("running provenance sim harness; seed %d: attempt 1/2\n", config.Seed)
+	appA, errA := runSimHarnessOnce(t, config)
+	if errA != nil {
+		record.Error = errA.Error()
+	} else {
+		record.AppHash = hex.EncodeToString(appA.LastCommitID().Hash)
+	}
+	if len(FlagSimRecordFileValue) > 0 {
+		require.NoError(t, writeSimHarnessRecord(FlagSimRecordFileValue, record), "writeSimHarnessRecord")
+		fmt.Printf("sim harness record written to %s\n", FlagSimRecordFileValue)
+	}
+	require.NoError(t, errA, "SimulateFromSeed (attempt 1), seed %d", config.Seed)
+
+	if replay != nil && len(replay.AppHash) > 0 {
+		assert.Equal(t, replay.AppHash, record.AppHash, "app hash compared to recorded app hash, seed %d", config.Seed)
+	}
+
+	fmt.Printf("running provenance sim harness; seed %d: attempt 2/2\n", config.Seed)
+	appB, errB := runSimHarnessOnce(t, config)
+	require.NoError(t, errB, "SimulateFromSeed (attempt 2), seed %d", config.Seed)
+
+	hashB := hex.EncodeToString(appB.LastCommitID().Hash)
+	if record.AppHash != hashB {
+		diffs := diffSimApps(appA, appB)
+		t.Fatalf("non-determinism in seed %d: app hash %s != %s\n%s", config.Seed, record.AppHash, hashB, strings.Join(diffs, "\n"))
+	}
+}
+
+func TestSimHarnessConfigApplyTo(t *testing.T) {
+	harnessConfig, err := loadSimHarnessConfig(filepath.Join("testdata", "sim_harness_config.yaml"))
+	require.NoError(t, err, "loadSimHarnessConfig")
+
+	paramsFile := filepath.Join(t.TempDir(), "params.json")
+	require.NoError(t, os.WriteFile(paramsFile, []byte(`{"op_weight_msg_add_marker":5,"other_param":"keep"}`), 0o644), "WriteFile")
+
+	config := simtypes.Config{Seed: 1, NumBlocks: 2, BlockSize: 3, ParamsFile: paramsFile}
+	appParams, err := harnessConfig.applyTo(&config)
+	require.NoError(t, err, "applyTo")
+
+	assert.Equal(t, int64(42), config.Seed, "Seed")
+	assert.Equal(t, 20, config.NumBlocks, "NumBlocks")
+	assert.Equal(t, 3, config.BlockSize, "BlockSize")
+	assert.Equal(t, "100", string(appParams["op_weight_msg_add_marker"]), "op_weight_msg_add_marker")
+	assert.Equal(t, "0", string(appParams["op_weight_msg_set_account_data"]), "op_weight_msg_set_account_data")
+	assert.Equal(t, `"keep"`, string(appParams["other_param"]), "other_param")
+	assert.Equal(t, "10", string(appParams["max_memo_characters"]), "max_memo_characters")
+
+	dir := t.TempDir()
+	require.NoError(t, writeAppParamsFile(&config, dir, appParams), "writeAppParamsFile")
+	reread, err := readAppParams(config)
+	require.NoError(t, err, "readAppParams")
+	assert.Equal(t, appParams, reread, "app params after writing and reading them")
+
+	harnessConfig.Weights["bad"] = -1
+	_, err = harnessConfig.applyTo(&config)
+	assert.EqualError(t, err, `invalid weight -1 for "bad": cannot be negative`, "applyTo with a negative weight")
+}
+
+func TestSimHarnessRecordRoundTrip(t *testing.T) {
+	record := simHarnessRecord{
+		Seed:      7,
+		NumBlocks: 10,
+		BlockSize: 20,
+		AppParams: simtypes.AppParams{"op_weight_msg_add_marker": json.RawMessage("3")},
+		AppHash:   "0a0b",
+	}
+	filename := filepath.Join(t.TempDir(), "record.json")
+	require.NoError(t, writeSimHarnessRecord(filename, record), "writeSimHarnessRecord")
+
+	loaded, err := loadSimHarnessRecord(filename)
+	require.NoError(t, err, "loadSimHarnessRecord")
+	assert.Equal(t, record, *loaded, "loaded record")
+
+	var config simtypes.Config
+	appParams := loaded.applyTo(&config)
+	assert.Equal(t, int64(7), config.Seed, "Seed")
+	assert.Equal(t, 10, config.NumBlocks, "NumBlocks")
+	assert.Equal(t, 20, config.BlockSize, "BlockSize")
+	assert.Equal(t, record.AppParams, appParams, "app params")
+}
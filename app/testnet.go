@@ -0,0 +1,280 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+// testnetValidatorTokens is the number of tokens given to the local validator of an in-place testnet.
+var testnetValidatorTokens = sdkmath.NewInt(900_000_000_000_000)
+
+// TestnetValidator is a validator to add when turning a node's state into a testnet.
+type TestnetValidator struct {
+	// OperatorAddress is the bech32 account address of the validator's operator.
+	OperatorAddress string
+	// ConsensusPubKey is the validator's consensus public key.
+	ConsensusPubKey cryptotypes.PubKey
+	// Moniker is the validator's moniker.
+	Moniker string
+	// Tokens is the amount of the bond denom to give this validator.
+	Tokens sdkmath.Int
+}
+
+// TestnetOptions are the things used to turn a node's state into a testnet.
+type TestnetOptions struct {
+	// NewValAddr is the address of the local validator (from its private validator key).
+	NewValAddr cmtbytes.HexBytes
+	// NewValPubKey is the public key of the local validator (from its private validator key).
+	NewValPubKey cmtcrypto.PubKey
+	// NewOperatorAddress is the bech32 account address of the local validator's operator.
+	NewOperatorAddress string
+	// UpgradeToTrigger is the name of an upgrade to schedule (if not empty).
+	UpgradeToTrigger string
+	// VotingPeriod is the new governance voting period (if not zero).
+	VotingPeriod time.Duration
+	// Validators are validators to add alongside the local validator. All existing validators are always removed.
+	// These start unbonded and are bonded by the staking module as if they'd just been created.
+	Validators []TestnetValidator
+	// FundAccounts are account balances to mint and give to accounts.
+	FundAccounts []banktypes.Balance
+	// FastForwardTo, if not zero, is a time to process time-based state up to, i.e. attribute
+	// expirations are applied and time-based triggers are queued as if it were this time.
+	FastForwardTo time.Time
+}
+
+// InitAppForTestnet modifies the app's state so that it can be run as a testnet, e.g. the in-place-testnet command.
+// It replaces the validator set, and optionally updates gov params, funds accounts, fast-forwards
+// time-based state, and schedules an upgrade.
+func InitAppForTestnet(app *App, opts TestnetOptions) (*App, error) {
+	ctx := app.NewUncachedContext(true, cmtproto.Header{})
+
+	if err := app.testnetReplaceValidators(ctx, opts); err != nil {
+		return nil, fmt.Errorf("could not replace validators: %w", err)
+	}
+
+	if opts.VotingPeriod != 0 {
+		if err := app.testnetSetVotingPeriod(ctx, opts.VotingPeriod); err != nil {
+			return nil, fmt.Errorf("could not set voting period: %w", err)
+		}
+	}
+
+	if err := app.testnetFundAccounts(ctx, opts.FundAccounts); err != nil {
+		return nil, fmt.Errorf("could not fund accounts: %w", err)
+	}
+
+	if !opts.FastForwardTo.IsZero() {
+		expired := app.AttributeKeeper.DeleteExpiredAttributes(ctx.WithBlockTime(opts.FastForwardTo), 0)
+		queued := app.TriggerKeeper.QueueTimeTriggersUntil(ctx, opts.FastForwardTo)
+		app.Logger().Info("Fast-forwarded time-based state.", "to", opts.FastForwardTo,
+			"expired attributes", expired, "queued triggers", queued)
+	}
+
+	if len(opts.UpgradeToTrigger) > 0 {
+		plan := upgradetypes.Plan{
+			Name:   opts.UpgradeToTrigger,
+			Height: app.LastBlockHeight() + 10,
+		}
+		if err := app.UpgradeKeeper.ScheduleUpgrade(ctx, plan); err != nil {
+			return nil, fmt.Errorf("could not schedule upgrade %q: %w", opts.UpgradeToTrigger, err)
+		}
+	}
+
+	return app, nil
+}
+
+// testnetReplaceValidators removes all existing validators and creates the local validator and any others requested.
+func (app *App) testnetReplaceValidators(ctx sdk.Context, opts TestnetOptions) error {
+	stakingStore := ctx.KVStore(app.GetKey(stakingtypes.StoreKey))
+
+	var toDelete [][]byte
+	powerIter, err := app.StakingKeeper.ValidatorsPowerStoreIterator(ctx)
+	if err != nil {
+		return err
+	}
+	for ; powerIter.Valid(); powerIter.Next() {
+		toDelete = append(toDelete, powerIter.Key())
+	}
+	powerIter.Close()
+
+	lastIter, err := app.StakingKeeper.LastValidatorsIterator(ctx)
+	if err != nil {
+		return err
+	}
+	for ; lastIter.Valid(); lastIter.Next() {
+		toDelete = append(toDelete, lastIter.Key())
+	}
+	lastIter.Close()
+
+	for _, prefix := range [][]byte{stakingtypes.ValidatorsKey, stakingtypes.ValidatorQueueKey} {
+		iter := storetypes.KVStorePrefixIterator(stakingStore, prefix)
+		for ; iter.Valid(); iter.Next() {
+			toDelete = append(toDelete, iter.Key())
+		}
+		iter.Close()
+	}
+
+	for _, key := range toDelete {
+		stakingStore.Delete(key)
+	}
+
+	localVal := TestnetValidator{
+		OperatorAddress: opts.NewOperatorAddress,
+		ConsensusPubKey: &ed25519.PubKey{Key: opts.NewValPubKey.Bytes()},
+		Moniker:         "Testnet Validator",
+		Tokens:          testnetValidatorTokens,
+	}
+	if err = app.testnetCreateValidator(ctx, localVal, stakingtypes.Bonded); err != nil {
+		return fmt.Errorf("could not create local validator: %w", err)
+	}
+
+	newConsAddr := sdk.ConsAddress(opts.NewValAddr.Bytes())
+	signingInfo := slashingtypes.NewValidatorSigningInfo(newConsAddr, app.LastBlockHeight()-1, 0, time.Unix(0, 0), false, 0)
+	if err = app.SlashingKeeper.SetValidatorSigningInfo(ctx, newConsAddr, signingInfo); err != nil {
+		return fmt.Errorf("could not set local validator signing info: %w", err)
+	}
+
+	for i, val := range opts.Validators {
+		if err = app.testnetCreateValidator(ctx, val, stakingtypes.Unbonded); err != nil {
+			return fmt.Errorf("could not create validator[%d] %q: %w", i, val.OperatorAddress, err)
+		}
+	}
+
+	return nil
+}
+
+// testnetCreateValidator creates the provided validator with the provided status,
+// and mints the validator's tokens into the applicable staking pool.
+func (app *App) testnetCreateValidator(ctx sdk.Context, val TestnetValidator, status stakingtypes.BondStatus) error {
+	if val.ConsensusPubKey == nil {
+		return errors.New("no consensus pubkey provided")
+	}
+	if val.Tokens.IsNil() || !val.Tokens.IsPositive() {
+		return fmt.Errorf("invalid tokens %q: must be positive", val.Tokens)
+	}
+
+	operator, err := sdk.AccAddressFromBech32(val.OperatorAddress)
+	if err != nil {
+		return fmt.Errorf("invalid operator address %q: %w", val.OperatorAddress, err)
+	}
+	valAddr := sdk.ValAddress(operator)
+
+	pubKeyAny, err := codectypes.NewAnyWithValue(val.ConsensusPubKey)
+	if err != nil {
+		return err
+	}
+
+	newVal := stakingtypes.Validator{
+		OperatorAddress: valAddr.String(),
+		ConsensusPubkey: pubKeyAny,
+		Jailed:          false,
+		Status:          status,
+		Tokens:          val.Tokens,
+		DelegatorShares: sdkmath.LegacyNewDecFromInt(val.Tokens),
+		Description:     stakingtypes.Description{Moniker: val.Moniker},
+		Commission: stakingtypes.NewCommission(
+			sdkmath.LegacyMustNewDecFromStr("0.05"),
+			sdkmath.LegacyMustNewDecFromStr("0.1"),
+			sdkmath.LegacyMustNewDecFromStr("0.05"),
+		),
+		MinSelfDelegation: sdkmath.OneInt(),
+	}
+
+	if err = app.StakingKeeper.SetValidator(ctx, newVal); err != nil {
+		return err
+	}
+	if err = app.StakingKeeper.SetValidatorByConsAddr(ctx, newVal); err != nil {
+		return err
+	}
+	if err = app.StakingKeeper.SetValidatorByPowerIndex(ctx, newVal); err != nil {
+		return err
+	}
+	if status == stakingtypes.Bonded {
+		// A last power of zero means the staking module will send the validator's actual power to comet in the next EndBlock.
+		if err = app.StakingKeeper.SetLastValidatorPower(ctx, valAddr, 0); err != nil {
+			return err
+		}
+	}
+	if err = app.StakingKeeper.Hooks().AfterValidatorCreated(ctx, valAddr); err != nil {
+		return err
+	}
+
+	bondDenom, err := app.StakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+	pool := stakingtypes.NotBondedPoolName
+	if status == stakingtypes.Bonded {
+		pool = stakingtypes.BondedPoolName
+	}
+	return app.testnetMint(ctx, pool, sdk.NewCoins(sdk.NewCoin(bondDenom, val.Tokens)))
+}
+
+// testnetSetVotingPeriod updates the gov params to have the provided voting period.
+// If the expedited voting period isn't less than the new voting period, it's set to half of it.
+func (app *App) testnetSetVotingPeriod(ctx sdk.Context, votingPeriod time.Duration) error {
+	params, err := app.GovKeeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	params.VotingPeriod = &votingPeriod
+	if params.ExpeditedVotingPeriod == nil || *params.ExpeditedVotingPeriod >= votingPeriod {
+		expedited := votingPeriod / 2
+		params.ExpeditedVotingPeriod = &expedited
+	}
+	if err = params.ValidateBasic(); err != nil {
+		return err
+	}
+	return app.GovKeeper.Params.Set(ctx, params)
+}
+
+// testnetFundAccounts mints the requested funds and gives them to each account.
+func (app *App) testnetFundAccounts(ctx sdk.Context, balances []banktypes.Balance) error {
+	ctx = markertypes.WithBypass(ctx)
+	for _, bal := range balances {
+		addr, err := sdk.AccAddressFromBech32(bal.Address)
+		if err != nil {
+			return fmt.Errorf("invalid address %q: %w", bal.Address, err)
+		}
+		if err = app.testnetMint(ctx, minttypes.ModuleName, bal.Coins); err != nil {
+			return err
+		}
+		if err = app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr, bal.Coins); err != nil {
+			return fmt.Errorf("could not send %s to %s: %w", bal.Coins, bal.Address, err)
+		}
+	}
+	return nil
+}
+
+// testnetMint mints the provided coins into the provided module account.
+func (app *App) testnetMint(ctx sdk.Context, moduleName string, coins sdk.Coins) error {
+	if err := app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins); err != nil {
+		return fmt.Errorf("could not mint %s: %w", coins, err)
+	}
+	if moduleName == minttypes.ModuleName {
+		return nil
+	}
+	if err := app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, moduleName, coins); err != nil {
+		return fmt.Errorf("could not send %s to %s: %w", coins, moduleName, err)
+	}
+	return nil
+}
@@ -0,0 +1,215 @@
+package app
+
+import (
+	"fmt"
+	"runtime/debug"
+	"sort"
+	"strings"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// UpgradeDryRunStep is a log message emitted while running an upgrade during a dry run.
+type UpgradeDryRunStep struct {
+	// Elapsed is the amount of time since the start of the upgrade when this message was logged.
+	Elapsed time.Duration
+	// Level is the log level of this message, e.g. "INF".
+	Level string
+	// Msg is the log message.
+	Msg string
+}
+
+// StoreSizeDelta is the size of a store before and after an upgrade is run.
+type StoreSizeDelta struct {
+	// Store is the name of the store.
+	Store string
+	// EntriesBefore is the number of entries in the store before the upgrade.
+	EntriesBefore int64
+	// EntriesAfter is the number of entries in the store after the upgrade.
+	EntriesAfter int64
+	// BytesBefore is the total size of the keys and values in the store before the upgrade.
+	BytesBefore int64
+	// BytesAfter is the total size of the keys and values in the store after the upgrade.
+	BytesAfter int64
+}
+
+// UpgradeDryRunResult is the outcome of running an upgrade without committing anything.
+type UpgradeDryRunResult struct {
+	// Name is the name of the upgrade.
+	Name string
+	// Height is the height the upgrade was run at.
+	Height int64
+	// Duration is how long the upgrade took to run.
+	Duration time.Duration
+	// Steps are the messages logged during the upgrade, in the order they were logged.
+	Steps []UpgradeDryRunStep
+	// FromVersions is the module version map before the upgrade.
+	FromVersions module.VersionMap
+	// ToVersions is the module version map after the upgrade. It's nil if the upgrade failed.
+	ToVersions module.VersionMap
+	// StoreDeltas has an entry for each store that was changed by the upgrade, ordered by store name.
+	StoreDeltas []StoreSizeDelta
+	// Err is the error returned by (or panic of) the upgrade, if there was one.
+	Err error
+}
+
+// GetUpgradeNames returns the names of all the upgrades defined in this app, sorted alphabetically.
+func GetUpgradeNames() []string {
+	rv := make([]string, 0, len(upgrades))
+	for name := range upgrades {
+		rv = append(rv, name)
+	}
+	sort.Strings(rv)
+	return rv
+}
+
+// GetUpgradeDryRunStoreLoader returns a StoreLoader to use when loading an existing node's data for an
+// upgrade dry run. Only the stores added by the upgrade are mounted; stores deleted or renamed by the
+// upgrade are left alone since those changes are written to the database when the stores are loaded.
+func GetUpgradeDryRunStoreLoader(name string) (baseapp.StoreLoader, error) {
+	upgrade, found := upgrades[name]
+	if !found {
+		return nil, fmt.Errorf("unknown upgrade %q, known upgrades: %s", name, strings.Join(GetUpgradeNames(), ", "))
+	}
+	if len(upgrade.Added) == 0 {
+		return baseapp.DefaultStoreLoader, nil
+	}
+	return func(ms storetypes.CommitMultiStore) error {
+		return ms.LoadLatestVersionAndUpgrade(&storetypes.StoreUpgrades{Added: upgrade.Added})
+	}, nil
+}
+
+// DryRunUpgrade runs the named upgrade on top of the latest committed state without committing anything.
+// An error is only returned if the upgrade could not be run. Errors from the upgrade itself are in the result.
+func (app *App) DryRunUpgrade(name string, blockTime time.Time) (*UpgradeDryRunResult, error) {
+	if _, found := upgrades[name]; !found {
+		return nil, fmt.Errorf("unknown upgrade %q, known upgrades: %s", name, strings.Join(GetUpgradeNames(), ", "))
+	}
+
+	rv := &UpgradeDryRunResult{
+		Name:   name,
+		Height: app.LastBlockHeight() + 1,
+	}
+
+	cms := app.CommitMultiStore().CacheMultiStore()
+	ctx := app.NewUncachedContext(false, cmtproto.Header{
+		ChainID: app.ChainID(),
+		Height:  rv.Height,
+		Time:    blockTime,
+	}).WithMultiStore(cms)
+
+	var err error
+	rv.FromVersions, err = app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get module version map: %w", err)
+	}
+
+	before := make(map[string][2]int64, len(app.keys))
+	for storeName, key := range app.keys {
+		before[storeName] = storeSize(cms.GetKVStore(key))
+	}
+
+	plan := upgradetypes.Plan{Name: name, Height: rv.Height}
+	logger := newDryRunLogger(app.Logger(), &rv.Steps)
+	ctx = ctx.WithLogger(logger)
+	rv.Err = applyUpgradeSafely(func() error {
+		return app.UpgradeKeeper.ApplyUpgrade(ctx, plan)
+	})
+	rv.Duration = time.Since(logger.start)
+
+	if rv.Err == nil {
+		rv.ToVersions, err = app.UpgradeKeeper.GetModuleVersionMap(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get module version map after upgrade: %w", err)
+		}
+	}
+
+	for storeName, key := range app.keys {
+		after := storeSize(cms.GetKVStore(key))
+		if after != before[storeName] {
+			rv.StoreDeltas = append(rv.StoreDeltas, StoreSizeDelta{
+				Store:         storeName,
+				EntriesBefore: before[storeName][0],
+				EntriesAfter:  after[0],
+				BytesBefore:   before[storeName][1],
+				BytesAfter:    after[1],
+			})
+		}
+	}
+	sort.Slice(rv.StoreDeltas, func(i, j int) bool {
+		return rv.StoreDeltas[i].Store < rv.StoreDeltas[j].Store
+	})
+
+	return rv, nil
+}
+
+// applyUpgradeSafely calls the provided apply func, converting a panic into an error.
+func applyUpgradeSafely(apply func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("upgrade panicked: %v\n%s", r, debug.Stack())
+		}
+	}()
+	return apply()
+}
+
+// storeSize returns the number of entries and the total size of the keys and values in the provided store.
+func storeSize(store storetypes.KVStore) [2]int64 {
+	var rv [2]int64
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		rv[0]++
+		rv[1] += int64(len(iter.Key()) + len(iter.Value()))
+	}
+	return rv
+}
+
+// dryRunLogger is a log.Logger that records each message along with how long after the start it was logged.
+type dryRunLogger struct {
+	log.Logger
+	start time.Time
+	steps *[]UpgradeDryRunStep
+}
+
+var _ log.Logger = (*dryRunLogger)(nil)
+
+// newDryRunLogger creates a new dryRunLogger that records messages in steps before passing them on to the provided logger.
+func newDryRunLogger(logger log.Logger, steps *[]UpgradeDryRunStep) *dryRunLogger {
+	return &dryRunLogger{Logger: logger, start: time.Now(), steps: steps}
+}
+
+func (l *dryRunLogger) record(level, msg string) {
+	*l.steps = append(*l.steps, UpgradeDryRunStep{Elapsed: time.Since(l.start), Level: level, Msg: msg})
+}
+
+func (l *dryRunLogger) Info(msg string, keyVals ...any) {
+	l.record("INF", msg)
+	l.Logger.Info(msg, keyVals...)
+}
+
+func (l *dryRunLogger) Warn(msg string, keyVals ...any) {
+	l.record("WRN", msg)
+	l.Logger.Warn(msg, keyVals...)
+}
+
+func (l *dryRunLogger) Error(msg string, keyVals ...any) {
+	l.record("ERR", msg)
+	l.Logger.Error(msg, keyVals...)
+}
+
+func (l *dryRunLogger) Debug(msg string, keyVals ...any) {
+	l.Logger.Debug(msg, keyVals...)
+}
+
+func (l *dryRunLogger) With(keyVals ...any) log.Logger {
+	return &dryRunLogger{Logger: l.Logger.With(keyVals...), start: l.start, steps: l.steps}
+}
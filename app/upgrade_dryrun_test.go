@@ -0,0 +1,147 @@
+package app
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+// setupDryRunApp adds the provided test upgrades to the upgrades map, then creates a new app with a committed first block.
+func setupDryRunApp(t *testing.T, testUpgrades map[string]appUpgrade) *App {
+	for name, upgrade := range testUpgrades {
+		upgrades[name] = upgrade
+	}
+	t.Cleanup(func() {
+		for name := range testUpgrades {
+			delete(upgrades, name)
+		}
+	})
+
+	app := Setup(t)
+	_, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, Time: time.Now()})
+	require.NoError(t, err, "FinalizeBlock")
+	_, err = app.Commit()
+	require.NoError(t, err, "Commit")
+	return app
+}
+
+func TestDryRunUpgrade(t *testing.T) {
+	dryRunKey := []byte("dryrunkey")
+	app := setupDryRunApp(t, map[string]appUpgrade{
+		"dryrun-good": {
+			Handler: func(ctx sdk.Context, app *App, vm module.VersionMap) (module.VersionMap, error) {
+				ctx.Logger().Info("Setting the thing.")
+				ctx.KVStore(app.keys[markertypes.StoreKey]).Set(dryRunKey, []byte("value"))
+				ctx.Logger().Info("Done setting the thing.")
+				return vm, nil
+			},
+		},
+		"dryrun-error": {
+			Handler: func(ctx sdk.Context, _ *App, _ module.VersionMap) (module.VersionMap, error) {
+				ctx.Logger().Error("Something is wrong.")
+				return nil, errors.New("oops: this upgrade is broken")
+			},
+		},
+		"dryrun-panic": {
+			Handler: func(_ sdk.Context, _ *App, _ module.VersionMap) (module.VersionMap, error) {
+				panic("this upgrade is really broken")
+			},
+		},
+	})
+	blockTime := time.Now()
+
+	t.Run("unknown upgrade", func(t *testing.T) {
+		result, err := app.DryRunUpgrade("nope", blockTime)
+		assertions.AssertErrorContents(t, err, []string{"unknown upgrade \"nope\"", "dryrun-good", "yellow"}, "DryRunUpgrade error")
+		assert.Nil(t, result, "DryRunUpgrade result")
+	})
+
+	t.Run("success", func(t *testing.T) {
+		result, err := app.DryRunUpgrade("dryrun-good", blockTime)
+		require.NoError(t, err, "DryRunUpgrade error")
+		require.NotNil(t, result, "DryRunUpgrade result")
+		assert.NoError(t, result.Err, "result.Err")
+		assert.Equal(t, "dryrun-good", result.Name, "result.Name")
+		assert.Equal(t, int64(2), result.Height, "result.Height")
+		assert.NotEmpty(t, result.FromVersions, "result.FromVersions")
+		assert.Equal(t, result.FromVersions, result.ToVersions, "result.ToVersions")
+
+		var msgs []string
+		for _, step := range result.Steps {
+			msgs = append(msgs, step.Level+" "+step.Msg)
+		}
+		assert.Equal(t, []string{
+			`INF Starting upgrade to "dryrun-good"`,
+			"INF Setting the thing.",
+			"INF Done setting the thing.",
+			`INF Successfully upgraded to "dryrun-good"`,
+		}, msgs, "result.Steps messages")
+
+		var markerDelta *StoreSizeDelta
+		for i, delta := range result.StoreDeltas {
+			if delta.Store == markertypes.StoreKey {
+				markerDelta = &result.StoreDeltas[i]
+			}
+		}
+		if assert.NotNil(t, markerDelta, "marker store delta") {
+			assert.Equal(t, int64(1), markerDelta.EntriesAfter-markerDelta.EntriesBefore, "marker store entries delta")
+			assert.Equal(t, int64(len(dryRunKey)+len("value")), markerDelta.BytesAfter-markerDelta.BytesBefore, "marker store bytes delta")
+		}
+
+		ctx := app.NewUncachedContext(false, cmtproto.Header{})
+		assert.Nil(t, ctx.KVStore(app.keys[markertypes.StoreKey]).Get(dryRunKey), "marker store value after dry run")
+		assert.False(t, isUpgradeDone(t, app, ctx, "dryrun-good"), "upgrade done after dry run")
+		assert.Equal(t, int64(1), app.LastBlockHeight(), "LastBlockHeight after dry run")
+	})
+
+	t.Run("error", func(t *testing.T) {
+		result, err := app.DryRunUpgrade("dryrun-error", blockTime)
+		require.NoError(t, err, "DryRunUpgrade error")
+		require.NotNil(t, result, "DryRunUpgrade result")
+		assertions.AssertErrorValue(t, result.Err, "oops: this upgrade is broken", "result.Err")
+		assert.Nil(t, result.ToVersions, "result.ToVersions")
+		assert.Empty(t, result.StoreDeltas, "result.StoreDeltas")
+		if assert.NotEmpty(t, result.Steps, "result.Steps") {
+			assert.Equal(t, "ERR", result.Steps[len(result.Steps)-1].Level, "last step level")
+		}
+	})
+
+	t.Run("panic", func(t *testing.T) {
+		var result *UpgradeDryRunResult
+		var err error
+		require.NotPanics(t, func() {
+			result, err = app.DryRunUpgrade("dryrun-panic", blockTime)
+		}, "DryRunUpgrade")
+		require.NoError(t, err, "DryRunUpgrade error")
+		require.NotNil(t, result, "DryRunUpgrade result")
+		assertions.AssertErrorContents(t, result.Err, []string{"upgrade panicked: this upgrade is really broken"}, "result.Err")
+	})
+}
+
+// isUpgradeDone returns true if the upgrade keeper has the provided upgrade as done.
+func isUpgradeDone(t *testing.T, app *App, ctx sdk.Context, name string) bool {
+	height, err := app.UpgradeKeeper.GetDoneHeight(ctx, name)
+	require.NoError(t, err, "GetDoneHeight(%q)", name)
+	return height != 0
+}
+
+func TestGetUpgradeDryRunStoreLoader(t *testing.T) {
+	loader, err := GetUpgradeDryRunStoreLoader("yellow")
+	require.NoError(t, err, "GetUpgradeDryRunStoreLoader(yellow)")
+	assert.NotNil(t, loader, "GetUpgradeDryRunStoreLoader(yellow)")
+
+	loader, err = GetUpgradeDryRunStoreLoader("nope")
+	assertions.AssertErrorContents(t, err, []string{"unknown upgrade \"nope\"", "yellow-rc1", "yellow"}, "GetUpgradeDryRunStoreLoader(nope)")
+	assert.Nil(t, loader, "GetUpgradeDryRunStoreLoader(nope)")
+}
@@ -19,11 +19,17 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	ibctmmigrations "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint/migrations"
 
+	"github.com/provenance-io/provenance/internal/unordered"
 	"github.com/provenance-io/provenance/x/exchange"
+	ibchookstypes "github.com/provenance-io/provenance/x/ibchooks/types"
+	ibcmetadatatypes "github.com/provenance-io/provenance/x/ibcmetadata/types"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
 )
 
 // appUpgrade is an internal structure for defining all things for an upgrade.
@@ -51,8 +57,13 @@ type appUpgrade struct {
 // I.e. Brand-new colors should be added to the bottom with the rcs first, then the non-rc.
 var upgrades = map[string]appUpgrade{
 	"yellow-rc1": { // Upgrade for v1.23.0-rc1.
+		Added: []string{packetforwardtypes.StoreKey, ibcmetadatatypes.StoreKey, ibcfeetypes.StoreKey, unordered.StoreKey},
 		Handler: func(ctx sdk.Context, app *App, vm module.VersionMap) (module.VersionMap, error) {
 			var err error
+			vm, err = runModuleMigrations(ctx, app, vm)
+			if err != nil {
+				return nil, err
+			}
 			if err = pruneIBCExpiredConsensusStates(ctx, app); err != nil {
 				return nil, err
 			}
@@ -61,12 +72,28 @@ var upgrades = map[string]appUpgrade{
 				return nil, err
 			}
 			convertAcctsToVesting(ctx, app, testnetAcctFilter)
+			enablePacketForwarding(ctx, app)
+			enableIBCMarkerAutoRegistration(ctx, app)
+			setNAVAttestationMaxDeviation(ctx, app)
+			if err = allowICQHostQueries(ctx, app); err != nil {
+				return nil, err
+			}
+			indexExchangeOrderPrices(ctx, app)
+			setExchangeSettlementHistoryBlocks(ctx, app)
+			indexExchangePaymentFilters(ctx, app)
+			setExchangeNAVHistoryParams(ctx, app)
+			indexExchangeOrderExternalIDs(ctx, app)
 			return vm, nil
 		},
 	},
 	"yellow": { // Upgrade for v1.23.0.
+		Added: []string{packetforwardtypes.StoreKey, ibcmetadatatypes.StoreKey, ibcfeetypes.StoreKey, unordered.StoreKey},
 		Handler: func(ctx sdk.Context, app *App, vm module.VersionMap) (module.VersionMap, error) {
 			var err error
+			vm, err = runModuleMigrations(ctx, app, vm)
+			if err != nil {
+				return nil, err
+			}
 			if err = pruneIBCExpiredConsensusStates(ctx, app); err != nil {
 				return nil, err
 			}
@@ -75,6 +102,17 @@ var upgrades = map[string]appUpgrade{
 				return nil, err
 			}
 			convertAcctsToVesting(ctx, app, mainnetAcctFilter)
+			enablePacketForwarding(ctx, app)
+			enableIBCMarkerAutoRegistration(ctx, app)
+			setNAVAttestationMaxDeviation(ctx, app)
+			if err = allowICQHostQueries(ctx, app); err != nil {
+				return nil, err
+			}
+			indexExchangeOrderPrices(ctx, app)
+			setExchangeSettlementHistoryBlocks(ctx, app)
+			indexExchangePaymentFilters(ctx, app)
+			setExchangeNAVHistoryParams(ctx, app)
+			indexExchangeOrderExternalIDs(ctx, app)
 			return vm, nil
 		},
 	},
@@ -232,6 +270,132 @@ func pruneIBCExpiredConsensusStates(ctx sdk.Context, app *App) error {
 	return nil
 }
 
+// enablePacketForwarding sets the ibchooks packet forwarding params to their defaults.
+// Params stored before the packet-forward-middleware was added will have forwarding disabled.
+// TODO: Remove with the yellow upgrades.
+func enablePacketForwarding(ctx sdk.Context, app *App) {
+	ctx.Logger().Info("Enabling packet forwarding.")
+	params := app.IBCHooksKeeper.GetParams(ctx)
+	params.PacketForwardingEnabled = ibchookstypes.DefaultPacketForwardingEnabled
+	params.MaxForwardHops = ibchookstypes.DefaultMaxForwardHops
+	app.IBCHooksKeeper.SetParams(ctx, params)
+	ctx.Logger().Info("Done enabling packet forwarding.")
+}
+
+// icqHostQueryPaths are the query paths that partner chains need to be able to query using interchain queries.
+// TODO: Remove with the yellow upgrades.
+var icqHostQueryPaths = []string{
+	"/provenance.attribute.v1.Query/Attribute",
+	"/provenance.marker.v1.Query/NetAssetValues",
+	"/provenance.metadata.v1.Query/Scope",
+	"/provenance.metadata.v1.Query/ScopeNetAssetValues",
+	"/provenance.metadata.v1.Query/ValueOwnership",
+	"/provenance.oracle.v1.Query/Price",
+}
+
+// enableIBCMarkerAutoRegistration turns on the ibchooks auto_register_ibc_markers param.
+// Markers were always created for new ibc denoms before the param was added, so this keeps that behavior.
+// TODO: Remove with the yellow upgrades.
+func enableIBCMarkerAutoRegistration(ctx sdk.Context, app *App) {
+	ctx.Logger().Info("Enabling ibc marker auto-registration.")
+	params := app.IBCHooksKeeper.GetParams(ctx)
+	params.AutoRegisterIbcMarkers = true
+	app.IBCHooksKeeper.SetParams(ctx, params)
+	ctx.Logger().Info("Done enabling ibc marker auto-registration.")
+}
+
+// setNAVAttestationMaxDeviation sets the marker nav_attestation_max_deviation_bps param to its default.
+// Nav attestations are left disabled; this just makes sure outliers are discarded once they're turned on.
+// TODO: Remove with the yellow upgrades.
+func setNAVAttestationMaxDeviation(ctx sdk.Context, app *App) {
+	ctx.Logger().Info("Setting marker nav attestation max deviation.")
+	params := app.MarkerKeeper.GetParams(ctx)
+	params.NavAttestationMaxDeviationBps = markertypes.DefaultNAVAttestationMaxDeviationBps
+	app.MarkerKeeper.SetParams(ctx, params)
+	ctx.Logger().Info("Done setting marker nav attestation max deviation.")
+}
+
+// allowICQHostQueries adds the icqHostQueryPaths to the interchain queries host allowlist.
+// TODO: Remove with the yellow upgrades.
+func allowICQHostQueries(ctx sdk.Context, app *App) error {
+	ctx.Logger().Info("Adding query paths to the interchain queries host allowlist.")
+	allowed := app.IBCHostKeeper.GetICQHostParams(ctx).AllowQueries
+	var toAdd []string
+	for _, path := range icqHostQueryPaths {
+		if !slices.Contains(allowed, path) {
+			toAdd = append(toAdd, path)
+		}
+	}
+	if len(toAdd) > 0 {
+		if err := app.IBCHostKeeper.UpdateICQHostAllowlist(ctx, toAdd, nil); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("Unable to update the interchain queries host allowlist, error: %s.", err))
+			return err
+		}
+	}
+	ctx.Logger().Info(fmt.Sprintf("Done adding %d query paths to the interchain queries host allowlist.", len(toAdd)))
+	return nil
+}
+
+// indexExchangeOrderPrices adds all existing exchange orders to the index used for getting the best prices.
+// TODO: Remove with the yellow upgrades.
+func indexExchangeOrderPrices(ctx sdk.Context, app *App) {
+	ctx.Logger().Info("Indexing exchange order prices.")
+	count, err := app.ExchangeKeeper.IndexOrderPrices(ctx)
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("Error reading exchange orders: %v.", err))
+	}
+	ctx.Logger().Info(fmt.Sprintf("Done indexing %d exchange order prices.", count))
+}
+
+// indexExchangeOrderExternalIDs adds all existing exchange orders with external ids to the index
+// used to check their uniqueness for each account.
+// TODO: Remove with the yellow upgrades.
+func indexExchangeOrderExternalIDs(ctx sdk.Context, app *App) {
+	ctx.Logger().Info("Indexing exchange order external ids.")
+	count, err := app.ExchangeKeeper.IndexOrderExternalIDs(ctx)
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("Error reading exchange orders: %v.", err))
+	}
+	ctx.Logger().Info(fmt.Sprintf("Done indexing %d exchange order external ids.", count))
+}
+
+// indexExchangePaymentFilters adds all existing exchange payments to the indexes used to filter payments.
+// TODO: Remove with the yellow upgrades.
+func indexExchangePaymentFilters(ctx sdk.Context, app *App) {
+	ctx.Logger().Info("Indexing exchange payment filters.")
+	count := app.ExchangeKeeper.IndexPaymentFilters(ctx)
+	ctx.Logger().Info(fmt.Sprintf("Done indexing %d exchange payment filters.", count))
+}
+
+// setExchangeSettlementHistoryBlocks sets the exchange settlement_history_blocks param to its default
+// so that settlement records are kept.
+// TODO: Remove with the yellow upgrades.
+func setExchangeSettlementHistoryBlocks(ctx sdk.Context, app *App) {
+	ctx.Logger().Info("Setting exchange settlement history blocks.")
+	params := app.ExchangeKeeper.GetParamsOrDefaults(ctx)
+	if params.SettlementHistoryBlocks == 0 {
+		params.SettlementHistoryBlocks = exchange.DefaultSettlementHistoryBlocks
+	}
+	app.ExchangeKeeper.SetParams(ctx, params)
+	ctx.Logger().Info("Done setting exchange settlement history blocks.")
+}
+
+// setExchangeNAVHistoryParams sets the exchange nav_history_blocks and nav_history_max_records params
+// to their defaults so that NAV records are kept.
+// TODO: Remove with the yellow upgrades.
+func setExchangeNAVHistoryParams(ctx sdk.Context, app *App) {
+	ctx.Logger().Info("Setting exchange nav history params.")
+	params := app.ExchangeKeeper.GetParamsOrDefaults(ctx)
+	if params.NavHistoryBlocks == 0 {
+		params.NavHistoryBlocks = exchange.DefaultNAVHistoryBlocks
+	}
+	if params.NavHistoryMaxRecords == 0 {
+		params.NavHistoryMaxRecords = exchange.DefaultNAVHistoryMaxRecords
+	}
+	app.ExchangeKeeper.SetParams(ctx, params)
+	ctx.Logger().Info("Done setting exchange nav history params.")
+}
+
 // convertFinishedVestingAccountsToBase will turn completed vesting accounts into regular BaseAccounts.
 // This should be applied in most upgrades.
 func convertFinishedVestingAccountsToBase(ctx sdk.Context, app *App) error {
@@ -18,10 +18,14 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	icqtypes "github.com/cosmos/ibc-apps/modules/async-icq/v8/types"
 
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	internalsdk "github.com/provenance-io/provenance/internal/sdk"
+	"github.com/provenance-io/provenance/x/exchange"
+	ibchookstypes "github.com/provenance-io/provenance/x/ibchooks/types"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
 )
 
 type UpgradeTestSuite struct {
@@ -588,22 +592,280 @@ func (s *UpgradeTestSuite) TestRemoveInactiveValidatorDelegations() {
 
 // TODO: func (s *UpgradeTestSuite) TestConvertFinishedVestingAccountsToBase()
 
+func (s *UpgradeTestSuite) TestEnablePacketForwarding() {
+	origParams := s.app.IBCHooksKeeper.GetParams(s.ctx)
+	defer s.app.IBCHooksKeeper.SetParams(s.ctx, origParams)
+
+	contracts := []string{"contract1"}
+	s.app.IBCHooksKeeper.SetParams(s.ctx, ibchookstypes.NewParams(contracts, false, 0, false))
+
+	runner := func() {
+		enablePacketForwarding(s.ctx, s.app)
+	}
+	expLogLines := []string{
+		"INF Enabling packet forwarding.",
+		"INF Done enabling packet forwarding.",
+	}
+	s.ExecuteAndAssertLogs(runner, expLogLines, nil, true, "enablePacketForwarding")
+
+	expParams := ibchookstypes.NewParams(contracts, ibchookstypes.DefaultPacketForwardingEnabled, ibchookstypes.DefaultMaxForwardHops, false)
+	actParams := s.app.IBCHooksKeeper.GetParams(s.ctx)
+	s.Assert().Equal(expParams, actParams, "ibchooks params after enablePacketForwarding")
+}
+
+func (s *UpgradeTestSuite) TestEnableIBCMarkerAutoRegistration() {
+	origParams := s.app.IBCHooksKeeper.GetParams(s.ctx)
+	defer s.app.IBCHooksKeeper.SetParams(s.ctx, origParams)
+
+	contracts := []string{"contract1"}
+	s.app.IBCHooksKeeper.SetParams(s.ctx, ibchookstypes.NewParams(contracts, true, 3, false))
+
+	runner := func() {
+		enableIBCMarkerAutoRegistration(s.ctx, s.app)
+	}
+	expLogLines := []string{
+		"INF Enabling ibc marker auto-registration.",
+		"INF Done enabling ibc marker auto-registration.",
+	}
+	s.ExecuteAndAssertLogs(runner, expLogLines, nil, true, "enableIBCMarkerAutoRegistration")
+
+	expParams := ibchookstypes.NewParams(contracts, true, 3, true)
+	actParams := s.app.IBCHooksKeeper.GetParams(s.ctx)
+	s.Assert().Equal(expParams, actParams, "ibchooks params after enableIBCMarkerAutoRegistration")
+}
+
+func (s *UpgradeTestSuite) TestSetNAVAttestationMaxDeviation() {
+	origParams := s.app.MarkerKeeper.GetParams(s.ctx)
+	defer s.app.MarkerKeeper.SetParams(s.ctx, origParams)
+
+	params := markertypes.NewParams(true, "[a-z]{3,64}", sdkmath.NewInt(1000), false, 0)
+	s.app.MarkerKeeper.SetParams(s.ctx, params)
+
+	runner := func() {
+		setNAVAttestationMaxDeviation(s.ctx, s.app)
+	}
+	expLogLines := []string{
+		"INF Setting marker nav attestation max deviation.",
+		"INF Done setting marker nav attestation max deviation.",
+	}
+	s.ExecuteAndAssertLogs(runner, expLogLines, nil, true, "setNAVAttestationMaxDeviation")
+
+	expParams := markertypes.NewParams(true, "[a-z]{3,64}", sdkmath.NewInt(1000), false, markertypes.DefaultNAVAttestationMaxDeviationBps)
+	actParams := s.app.MarkerKeeper.GetParams(s.ctx)
+	s.Assert().Equal(expParams, actParams, "marker params after setNAVAttestationMaxDeviation")
+}
+
+func (s *UpgradeTestSuite) TestAllowICQHostQueries() {
+	origParams := s.app.ICQKeeper.GetParams(s.ctx)
+	defer func() {
+		s.Require().NoError(s.app.ICQKeeper.SetParams(s.ctx, origParams), "ICQKeeper.SetParams(origParams)")
+	}()
+
+	existing := []string{"/cosmos.bank.v1beta1.Query/Balance", "/provenance.marker.v1.Query/NetAssetValues"}
+	s.Require().NoError(s.app.ICQKeeper.SetParams(s.ctx, icqtypes.NewParams(true, existing)), "ICQKeeper.SetParams")
+
+	runner := func() {
+		err := allowICQHostQueries(s.ctx, s.app)
+		s.Assert().NoError(err, "allowICQHostQueries")
+	}
+	expLogLines := []string{
+		"INF Adding query paths to the interchain queries host allowlist.",
+		"INF Done adding 5 query paths to the interchain queries host allowlist.",
+	}
+	s.ExecuteAndAssertLogs(runner, expLogLines, nil, true, "allowICQHostQueries")
+
+	expAllow := []string{
+		"/cosmos.bank.v1beta1.Query/Balance",
+		"/provenance.marker.v1.Query/NetAssetValues",
+		"/provenance.attribute.v1.Query/Attribute",
+		"/provenance.metadata.v1.Query/Scope",
+		"/provenance.metadata.v1.Query/ScopeNetAssetValues",
+		"/provenance.metadata.v1.Query/ValueOwnership",
+		"/provenance.oracle.v1.Query/Price",
+	}
+	actParams := s.app.ICQKeeper.GetParams(s.ctx)
+	s.Assert().Equal(expAllow, actParams.AllowQueries, "icq host allow queries after allowICQHostQueries")
+	s.Assert().True(actParams.HostEnabled, "icq host enabled after allowICQHostQueries")
+
+	s.Run("run again", func() {
+		expLogLines = []string{
+			"INF Adding query paths to the interchain queries host allowlist.",
+			"INF Done adding 0 query paths to the interchain queries host allowlist.",
+		}
+		s.ExecuteAndAssertLogs(runner, expLogLines, nil, true, "allowICQHostQueries")
+		s.Assert().Equal(expAllow, s.app.ICQKeeper.GetParams(s.ctx).AllowQueries, "icq host allow queries after second run")
+	})
+}
+
+func (s *UpgradeTestSuite) TestIndexExchangeOrderPrices() {
+	// The details of the indexing are tested in the exchange keeper. This just makes sure it's called and logged.
+	runner := func() {
+		indexExchangeOrderPrices(s.ctx, s.app)
+	}
+	expLogLines := []string{
+		"INF Indexing exchange order prices.",
+		"INF Done indexing 0 exchange order prices.",
+	}
+	s.ExecuteAndAssertLogs(runner, expLogLines, nil, true, "indexExchangeOrderPrices")
+}
+
+func (s *UpgradeTestSuite) TestIndexExchangeOrderExternalIDs() {
+	// The details of the indexing are tested in the exchange keeper. This just makes sure it's called and logged.
+	runner := func() {
+		indexExchangeOrderExternalIDs(s.ctx, s.app)
+	}
+	expLogLines := []string{
+		"INF Indexing exchange order external ids.",
+		"INF Done indexing 0 exchange order external ids.",
+	}
+	s.ExecuteAndAssertLogs(runner, expLogLines, nil, true, "indexExchangeOrderExternalIDs")
+}
+
+func (s *UpgradeTestSuite) TestIndexExchangePaymentFilters() {
+	// The details of the indexing are tested in the exchange keeper. This just makes sure it's called and logged.
+	runner := func() {
+		indexExchangePaymentFilters(s.ctx, s.app)
+	}
+	expLogLines := []string{
+		"INF Indexing exchange payment filters.",
+		"INF Done indexing 0 exchange payment filters.",
+	}
+	s.ExecuteAndAssertLogs(runner, expLogLines, nil, true, "indexExchangePaymentFilters")
+}
+
+func (s *UpgradeTestSuite) TestSetExchangeSettlementHistoryBlocks() {
+	origParams := s.app.ExchangeKeeper.GetParams(s.ctx)
+	defer s.app.ExchangeKeeper.SetParams(s.ctx, origParams)
+
+	params := &exchange.Params{
+		DefaultSplit: 300,
+		DenomSplits:  []exchange.DenomSplit{{Denom: "nhash", Split: 250}},
+	}
+	s.app.ExchangeKeeper.SetParams(s.ctx, params)
+
+	runner := func() {
+		setExchangeSettlementHistoryBlocks(s.ctx, s.app)
+	}
+	expLogLines := []string{
+		"INF Setting exchange settlement history blocks.",
+		"INF Done setting exchange settlement history blocks.",
+	}
+	s.ExecuteAndAssertLogs(runner, expLogLines, nil, true, "setExchangeSettlementHistoryBlocks")
+
+	expParams := &exchange.Params{
+		DefaultSplit:            300,
+		DenomSplits:             []exchange.DenomSplit{{Denom: "nhash", Split: 250}},
+		SettlementHistoryBlocks: exchange.DefaultSettlementHistoryBlocks,
+	}
+	actParams := s.app.ExchangeKeeper.GetParams(s.ctx)
+	s.Assert().Equal(expParams, actParams, "exchange params after setExchangeSettlementHistoryBlocks")
+
+	s.Run("already set", func() {
+		params.SettlementHistoryBlocks = 25
+		s.app.ExchangeKeeper.SetParams(s.ctx, params)
+		s.ExecuteAndAssertLogs(runner, expLogLines, nil, true, "setExchangeSettlementHistoryBlocks")
+		actParams = s.app.ExchangeKeeper.GetParams(s.ctx)
+		s.Assert().Equal(uint64(25), actParams.SettlementHistoryBlocks, "settlement history blocks after second run")
+	})
+}
+
+func (s *UpgradeTestSuite) TestSetExchangeNAVHistoryParams() {
+	origParams := s.app.ExchangeKeeper.GetParams(s.ctx)
+	defer s.app.ExchangeKeeper.SetParams(s.ctx, origParams)
+
+	params := &exchange.Params{
+		DefaultSplit:            300,
+		DenomSplits:             []exchange.DenomSplit{{Denom: "nhash", Split: 250}},
+		SettlementHistoryBlocks: 55,
+	}
+	s.app.ExchangeKeeper.SetParams(s.ctx, params)
+
+	runner := func() {
+		setExchangeNAVHistoryParams(s.ctx, s.app)
+	}
+	expLogLines := []string{
+		"INF Setting exchange nav history params.",
+		"INF Done setting exchange nav history params.",
+	}
+	s.ExecuteAndAssertLogs(runner, expLogLines, nil, true, "setExchangeNAVHistoryParams")
+
+	expParams := &exchange.Params{
+		DefaultSplit:            300,
+		DenomSplits:             []exchange.DenomSplit{{Denom: "nhash", Split: 250}},
+		SettlementHistoryBlocks: 55,
+		NavHistoryBlocks:        exchange.DefaultNAVHistoryBlocks,
+		NavHistoryMaxRecords:    exchange.DefaultNAVHistoryMaxRecords,
+	}
+	actParams := s.app.ExchangeKeeper.GetParams(s.ctx)
+	s.Assert().Equal(expParams, actParams, "exchange params after setExchangeNAVHistoryParams")
+
+	s.Run("already set", func() {
+		params.NavHistoryBlocks = 25
+		params.NavHistoryMaxRecords = 3
+		s.app.ExchangeKeeper.SetParams(s.ctx, params)
+		s.ExecuteAndAssertLogs(runner, expLogLines, nil, true, "setExchangeNAVHistoryParams")
+		actParams = s.app.ExchangeKeeper.GetParams(s.ctx)
+		s.Assert().Equal(uint64(25), actParams.NavHistoryBlocks, "nav history blocks after second run")
+		s.Assert().Equal(uint32(3), actParams.NavHistoryMaxRecords, "nav history max records after second run")
+	})
+}
+
 func (s *UpgradeTestSuite) TestYellowRC1() {
 	expInLog := []string{
+		"INF Starting module migrations. This may take a significant amount of time to complete. Do not restart node.",
+		"INF Module migrations completed.",
 		"INF Pruning expired consensus states for IBC.",
 		"INF Removing inactive validator delegations.",
 		"INF Converting completed vesting accounts into base accounts.",
 		"INF Converting accounts to vesting accounts.",
+		"INF Enabling packet forwarding.",
+		"INF Done enabling packet forwarding.",
+		"INF Enabling ibc marker auto-registration.",
+		"INF Done enabling ibc marker auto-registration.",
+		"INF Setting marker nav attestation max deviation.",
+		"INF Done setting marker nav attestation max deviation.",
+		"INF Adding query paths to the interchain queries host allowlist.",
+		"INF Done adding 6 query paths to the interchain queries host allowlist.",
+		"INF Indexing exchange order prices.",
+		"INF Done indexing 0 exchange order prices.",
+		"INF Setting exchange settlement history blocks.",
+		"INF Done setting exchange settlement history blocks.",
+		"INF Indexing exchange payment filters.",
+		"INF Done indexing 0 exchange payment filters.",
+		"INF Setting exchange nav history params.",
+		"INF Done setting exchange nav history params.",
+		"INF Indexing exchange order external ids.",
+		"INF Done indexing 0 exchange order external ids.",
 	}
 	s.AssertUpgradeHandlerLogs("yellow-rc1", expInLog, nil)
 }
 
 func (s *UpgradeTestSuite) TestYellow() {
 	expInLog := []string{
+		"INF Starting module migrations. This may take a significant amount of time to complete. Do not restart node.",
+		"INF Module migrations completed.",
 		"INF Pruning expired consensus states for IBC.",
 		"INF Removing inactive validator delegations.",
 		"INF Converting completed vesting accounts into base accounts.",
 		"INF Converting accounts to vesting accounts.",
+		"INF Enabling packet forwarding.",
+		"INF Done enabling packet forwarding.",
+		"INF Enabling ibc marker auto-registration.",
+		"INF Done enabling ibc marker auto-registration.",
+		"INF Setting marker nav attestation max deviation.",
+		"INF Done setting marker nav attestation max deviation.",
+		"INF Adding query paths to the interchain queries host allowlist.",
+		"INF Done adding 6 query paths to the interchain queries host allowlist.",
+		"INF Indexing exchange order prices.",
+		"INF Done indexing 0 exchange order prices.",
+		"INF Setting exchange settlement history blocks.",
+		"INF Done setting exchange settlement history blocks.",
+		"INF Indexing exchange payment filters.",
+		"INF Done indexing 0 exchange payment filters.",
+		"INF Setting exchange nav history params.",
+		"INF Done setting exchange nav history params.",
+		"INF Indexing exchange order external ids.",
+		"INF Done indexing 0 exchange order external ids.",
 	}
 	s.AssertUpgradeHandlerLogs("yellow", expInLog, nil)
 }
@@ -0,0 +1,203 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ComposeFile is the structure of a file provided to the tx compose command.
+type ComposeFile struct {
+	// Messages are the messages to include in the tx. Each must have an "@type" field with the msg type url.
+	Messages []json.RawMessage `json:"messages"`
+}
+
+// ComposedMsg is a message read from a compose file along with the result of validating it.
+type ComposedMsg struct {
+	// Index is the position of this message in the compose file.
+	Index int
+	// TypeURL is the type url of this message.
+	TypeURL string
+	// Msg is the decoded message. It will be nil if the message could not be decoded.
+	Msg sdk.Msg
+	// Err is the error encountered while decoding or validating the message.
+	Err error
+}
+
+// String returns a one-line description of this composed message and its validation result.
+func (m ComposedMsg) String() string {
+	result := "ok"
+	if m.Err != nil {
+		result = m.Err.Error()
+	}
+	return fmt.Sprintf("[%d] %s: %s", m.Index, m.TypeURL, result)
+}
+
+// GetCmdComposeTx returns the command that assembles messages from a YAML (or JSON) file into a single tx.
+func GetCmdComposeTx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "compose <file>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Assemble messages from multiple modules into a single atomic tx",
+		Long: `Assemble messages from multiple modules into a single atomic tx.
+
+The file must be YAML (or JSON) with a "messages" list. Each entry must have an "@type" field with the msg type url
+and the rest of the fields of that msg (using the same JSON field names that the msg has in a generated tx).
+
+Each message is validated and the results are printed before the tx is generated or broadcast.
+If any message fails validation, no tx is generated or broadcast.
+All signers of all messages must be the --from account.
+
+Use --dry-run to validate the messages and simulate the tx without broadcasting it.
+Use --generate-only to validate the messages and output the unsigned tx.
+
+Example file:
+
+messages:
+  - "@type": /provenance.marker.v1.MsgAddMarkerRequest
+    amount: {denom: mycoin, amount: "1000"}
+    manager: pb1...
+    from_address: pb1...
+    status: MARKER_STATUS_PROPOSED
+    marker_type: MARKER_TYPE_COIN
+  - "@type": /provenance.name.v1.MsgBindNameRequest
+    parent: {name: pb, address: pb1..., restricted: false}
+    record: {name: mycoin, address: pb1..., restricted: false}
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			composed, err := ReadComposeFile(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+			ValidateComposedMsgs(clientCtx.Codec, composed, clientCtx.GetFromAddress())
+
+			var errs []error
+			msgs := make([]sdk.Msg, 0, len(composed))
+			for _, m := range composed {
+				cmd.PrintErrln(m.String())
+				if m.Err != nil {
+					errs = append(errs, fmt.Errorf("message %d: %w", m.Index, m.Err))
+				}
+				msgs = append(msgs, m.Msg)
+			}
+			if len(errs) > 0 {
+				return errors.Join(errs...)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// ReadComposeFile reads the provided compose file and decodes each of its messages.
+// An error is returned if the file cannot be read or parsed, or if it has no messages.
+// Problems decoding individual messages are recorded in each entry's Err field.
+func ReadComposeFile(cdc codec.Codec, filename string) ([]ComposedMsg, error) {
+	contents, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("could not read compose file %q: %w", filename, err)
+	}
+	return ParseComposeFile(cdc, contents)
+}
+
+// ParseComposeFile decodes each of the messages in the provided compose file contents.
+// An error is returned if the contents cannot be parsed, or if there are no messages.
+// Problems decoding individual messages are recorded in each entry's Err field.
+func ParseComposeFile(cdc codec.Codec, contents []byte) ([]ComposedMsg, error) {
+	jsonBz, err := yaml.YAMLToJSON(contents)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse compose file: %w", err)
+	}
+
+	var file ComposeFile
+	dec := json.NewDecoder(bytes.NewReader(jsonBz))
+	dec.DisallowUnknownFields()
+	if err = dec.Decode(&file); err != nil {
+		return nil, fmt.Errorf("could not parse compose file: %w", err)
+	}
+	if len(file.Messages) == 0 {
+		return nil, errors.New("compose file does not have any messages")
+	}
+
+	rv := make([]ComposedMsg, len(file.Messages))
+	for i, raw := range file.Messages {
+		rv[i] = decodeComposedMsg(cdc, i, raw)
+	}
+	return rv, nil
+}
+
+// decodeComposedMsg decodes a single message from a compose file.
+func decodeComposedMsg(cdc codec.Codec, index int, raw json.RawMessage) ComposedMsg {
+	rv := ComposedMsg{Index: index}
+
+	var typed struct {
+		TypeURL string `json:"@type"`
+	}
+	if err := json.Unmarshal(raw, &typed); err != nil {
+		rv.Err = fmt.Errorf("invalid message: %w", err)
+		return rv
+	}
+	rv.TypeURL = strings.TrimSpace(typed.TypeURL)
+	if len(rv.TypeURL) == 0 {
+		rv.Err = errors.New("message does not have an @type")
+		return rv
+	}
+
+	var msg sdk.Msg
+	if err := cdc.UnmarshalInterfaceJSON(raw, &msg); err != nil {
+		rv.Err = fmt.Errorf("could not decode message: %w", err)
+		return rv
+	}
+	rv.Msg = msg
+	return rv
+}
+
+// ValidateComposedMsgs runs ValidateBasic on each decoded message and makes sure its signers are all the from address.
+// The signer check is skipped if the from address is empty. The results are recorded in each entry's Err field.
+func ValidateComposedMsgs(cdc codec.Codec, msgs []ComposedMsg, from sdk.AccAddress) {
+	for i := range msgs {
+		if msgs[i].Err != nil || msgs[i].Msg == nil {
+			continue
+		}
+		if vmsg, ok := msgs[i].Msg.(sdk.HasValidateBasic); ok {
+			if err := vmsg.ValidateBasic(); err != nil {
+				msgs[i].Err = err
+				continue
+			}
+		}
+		if len(from) == 0 {
+			continue
+		}
+		signers, _, err := cdc.GetMsgV1Signers(msgs[i].Msg)
+		if err != nil {
+			msgs[i].Err = fmt.Errorf("could not get signers: %w", err)
+			continue
+		}
+		for _, signer := range signers {
+			if !from.Equals(sdk.AccAddress(signer)) {
+				msgs[i].Err = fmt.Errorf("signer %s is not the --from account %s", sdk.AccAddress(signer), from)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package cmd_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/app"
+	provenancecmd "github.com/provenance-io/provenance/cmd/provenanced/cmd"
+	"github.com/provenance-io/provenance/testutil/assertions"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
+)
+
+func TestParseAndValidateComposeFile(t *testing.T) {
+	origCache := sdk.IsAddrCacheEnabled()
+	defer sdk.SetAddrCacheEnabled(origCache)
+	sdk.SetAddrCacheEnabled(false)
+
+	cdc := app.MakeTestEncodingConfig(t).Marshaler
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+
+	sendMsg := func(from, to sdk.AccAddress, amount string) string {
+		return fmt.Sprintf(`  - "@type": /cosmos.bank.v1beta1.MsgSend
+    from_address: %s
+    to_address: %s
+    amount: [{denom: nhash, amount: "%s"}]
+`, from, to, amount)
+	}
+	bindMsg := func(parent sdk.AccAddress, name string) string {
+		return fmt.Sprintf(`  - "@type": /provenance.name.v1.MsgBindNameRequest
+    parent: {name: pb, address: %s, restricted: false}
+    record: {name: %s, address: %s, restricted: false}
+`, parent, name, parent)
+	}
+
+	tests := []struct {
+		name     string
+		contents string
+		from     sdk.AccAddress
+		expErr   []string
+		expMsgs  []sdk.Msg
+		expRes   []string
+	}{
+		{
+			name:     "not yaml",
+			contents: "messages: [",
+			expErr:   []string{"could not parse compose file"},
+		},
+		{
+			name:     "unknown field",
+			contents: "msgs: []",
+			expErr:   []string{"could not parse compose file: json: unknown field \"msgs\""},
+		},
+		{
+			name:     "no messages",
+			contents: "messages: []",
+			expErr:   []string{"compose file does not have any messages"},
+		},
+		{
+			name:     "two good messages",
+			contents: "messages:\n" + sendMsg(addr1, addr2, "5") + bindMsg(addr1, "mycoin"),
+			from:     addr1,
+			expMsgs: []sdk.Msg{
+				banktypes.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("nhash", 5))),
+				nametypes.NewMsgBindNameRequest(
+					nametypes.NewNameRecord("mycoin", addr1, false),
+					nametypes.NewNameRecord("pb", addr1, false),
+				),
+			},
+			expRes: []string{
+				"[0] /cosmos.bank.v1beta1.MsgSend: ok",
+				"[1] /provenance.name.v1.MsgBindNameRequest: ok",
+			},
+		},
+		{
+			name:     "no from address skips signer check",
+			contents: "messages:\n" + sendMsg(addr2, addr1, "5"),
+			expMsgs:  []sdk.Msg{banktypes.NewMsgSend(addr2, addr1, sdk.NewCoins(sdk.NewInt64Coin("nhash", 5)))},
+			expRes:   []string{"[0] /cosmos.bank.v1beta1.MsgSend: ok"},
+		},
+		{
+			name:     "problems",
+			contents: "messages:\n  - amount: 3\n  - \"@type\": /not.a.Msg\n" + sendMsg(addr2, addr1, "5") + bindMsg(addr1, "my.coin"),
+			from:     addr1,
+			expMsgs:  []sdk.Msg{nil, nil, banktypes.NewMsgSend(addr2, addr1, sdk.NewCoins(sdk.NewInt64Coin("nhash", 5))), nil},
+			expRes: []string{
+				"[0] : message does not have an @type",
+				"[1] /not.a.Msg: could not decode message: ",
+				fmt.Sprintf("[2] /cosmos.bank.v1beta1.MsgSend: signer %s is not the --from account %s", addr2, addr1),
+				"[3] /provenance.name.v1.MsgBindNameRequest: invalid name: \".\" is reserved",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			filename := filepath.Join(t.TempDir(), "compose.yaml")
+			require.NoError(t, os.WriteFile(filename, []byte(tc.contents), 0o644), "WriteFile")
+
+			composed, err := provenancecmd.ReadComposeFile(cdc, filename)
+			assertions.AssertErrorContents(t, err, tc.expErr, "ReadComposeFile error")
+			if len(tc.expErr) > 0 {
+				return
+			}
+
+			provenancecmd.ValidateComposedMsgs(cdc, composed, tc.from)
+			require.Len(t, composed, len(tc.expRes), "composed msgs")
+			for i, m := range composed {
+				assert.Equal(t, i, m.Index, "[%d].Index", i)
+				assert.Contains(t, m.String(), tc.expRes[i], "[%d].String()", i)
+				if tc.expMsgs[i] == nil {
+					continue
+				}
+				if assert.NotNil(t, m.Msg, "[%d].Msg", i) {
+					assert.Equal(t, sdk.MsgTypeURL(tc.expMsgs[i]), sdk.MsgTypeURL(m.Msg), "[%d].Msg type", i)
+					assert.Equal(t, fmt.Sprintf("%v", tc.expMsgs[i]), fmt.Sprintf("%v", m.Msg), "[%d].Msg", i)
+				}
+			}
+		})
+	}
+}
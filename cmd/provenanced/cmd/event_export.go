@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+
+	"github.com/provenance-io/provenance/internal/eventexport"
+)
+
+// EventExportCmd returns the command with the event export sub-commands.
+func EventExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "event-export",
+		Short:                      "Event export subcommands",
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(EventExportReplayCmd())
+	return cmd
+}
+
+// EventExportReplayCmd returns the command that sends the events of past blocks to the configured event export sink.
+func EventExportReplayCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay <from height> [<to height>]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Send the events of past blocks to the configured event export sink",
+		Long: `Send the events of past blocks to the configured event export sink.
+
+The blocks and their results are requested from the node (--node), and their events are sent to the sink configured
+in the [event-export] section of app.toml. This can be used to populate a new sink or to fill in a gap.
+The node must still have the blocks and their results (i.e. they haven't been pruned).
+
+If no <to height> is provided, blocks are replayed up to the node's latest height.
+Events that the sink already has are not duplicated in postgres. Kafka consumers should use the record keys to ignore duplicates.`,
+		Example: `$ provenanced event-export replay 1000
+$ provenanced event-export replay 1000 2000 --node tcp://localhost:26657`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fromHeight, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil || fromHeight < 1 {
+				return fmt.Errorf("invalid from height %q: must be a positive integer", args[0])
+			}
+
+			cfg := eventexport.ConfigFromAppOpts(server.GetServerContextFromCmd(cmd).Viper)
+			if !cfg.IsEnabled() {
+				return fmt.Errorf("no event export sink configured: %s is empty", eventexport.FlagSink)
+			}
+			if err = cfg.Validate(); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			node, err := clientCtx.GetNode()
+			if err != nil {
+				return err
+			}
+
+			ctx := cmd.Context()
+			var toHeight int64
+			if len(args) > 1 {
+				toHeight, err = strconv.ParseInt(args[1], 10, 64)
+				if err != nil || toHeight < fromHeight {
+					return fmt.Errorf("invalid to height %q: must be an integer at least %d", args[1], fromHeight)
+				}
+			} else {
+				status, serr := node.Status(ctx)
+				if serr != nil {
+					return fmt.Errorf("could not get node status: %w", serr)
+				}
+				toHeight = status.SyncInfo.LatestBlockHeight
+			}
+
+			sink, err := cfg.NewSink()
+			if err != nil {
+				return err
+			}
+			if closer, ok := sink.(io.Closer); ok {
+				defer closer.Close()
+			}
+
+			total := 0
+			for height := fromHeight; height <= toHeight; height++ {
+				block, ferr := eventexport.FetchBlock(ctx, node, height, cfg.EventTypes)
+				if ferr != nil {
+					return ferr
+				}
+				if err = sink.Send(ctx, block); err != nil {
+					return fmt.Errorf("could not send events of block %d: %w", height, err)
+				}
+				total += len(block.Events)
+			}
+			cmd.Printf("Sent %d events from blocks %d to %d.\n", total, fromHeight, toHeight)
+			return nil
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -71,6 +71,8 @@ func GenesisCmd(txConfig client.TxConfig, moduleBasics module.BasicManager, defa
 		AddGenesisCustomFloorPriceDenomCmd(defaultNodeHome),
 		AddGenesisDefaultMarketCmd(defaultNodeHome),
 		AddGenesisCustomMarketCmd(defaultNodeHome),
+		GenesisExportCmd(createAppAndExport, defaultNodeHome),
+		GenesisTransformCmd(),
 	)
 
 	return cmd
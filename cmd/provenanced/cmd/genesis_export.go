@@ -0,0 +1,395 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+	"github.com/provenance-io/provenance/x/hold"
+)
+
+const (
+	flagModules    = "modules"
+	flagDust       = "dust"
+	flagFromPrefix = "from-prefix"
+	flagToPrefix   = "to-prefix"
+)
+
+// bech32HRPSuffixes are the suffixes that are added to the main bech32 prefix to get the other prefixes.
+var bech32HRPSuffixes = []string{"", "pub", "valoper", "valoperpub", "valcons", "valconspub"}
+
+// GenesisExportCmd returns the genesis export command. It's the same as the SDK's export command,
+// but also allows --modules as an alias for --modules-to-export.
+func GenesisExportCmd(appExporter servertypes.AppExporter, defaultNodeHome string) *cobra.Command {
+	cmd := server.ExportCmd(appExporter, defaultNodeHome)
+	cmd.Example = fmt.Sprintf(`$ %[1]s export --modules exchange,marker --height 12345 --output-document exported.json`, genCmdStart)
+	cmd.Flags().SetNormalizeFunc(func(_ *pflag.FlagSet, name string) pflag.NormalizedName {
+		if name == flagModules {
+			name = server.FlagModulesToExport
+		}
+		return pflag.NormalizedName(name)
+	})
+	return cmd
+}
+
+// GenesisTransformCmd returns the genesis transform command with sub-commands for
+// changing a genesis file (e.g. an export from mainnet) to make it usable for a testnet.
+func GenesisTransformCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "transform",
+		Short:                      "Transform a genesis file, e.g. to create a testnet genesis file from an export",
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GenesisStripOrdersCmd(),
+		GenesisZeroDustCmd(),
+		GenesisRebech32Cmd(),
+	)
+
+	return cmd
+}
+
+// GenesisStripOrdersCmd returns the genesis transform strip-orders command.
+func GenesisStripOrdersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "strip-orders <genesis file>",
+		Short: "Remove all exchange orders and release the funds they have on hold",
+		Long: `Remove all exchange orders and release the funds they have on hold.
+The last order id is left alone so that new orders do not reuse ids.
+The genesis file is updated in place unless --output-document is provided.`,
+		Example: fmt.Sprintf(`$ %[1]s transform strip-orders exported.json`, genCmdStart),
+		Args:    cobra.ExactArgs(1),
+		RunE:    transformAppStateRunE(StripOrders),
+	}
+	cmd.Flags().String(flags.FlagOutputDocument, "", "Write the transformed genesis to this file instead of the provided one")
+	return cmd
+}
+
+// GenesisZeroDustCmd returns the genesis transform zero-dust command.
+func GenesisZeroDustCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "zero-dust <genesis file> --dust <coins>",
+		Short: "Remove account balances that are below a dust amount",
+		Long: `Remove account balances that are below a dust amount.
+For each coin in --dust, any account balance of that denom that is less than the dust amount is removed.
+The bank supply is reduced accordingly. Balances with funds on hold in that denom are left alone.
+The genesis file is updated in place unless --output-document is provided.`,
+		Example: fmt.Sprintf(`$ %[1]s transform zero-dust exported.json --dust 1000nhash,10usd.local`, genCmdStart),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dustStr, err := cmd.Flags().GetString(flagDust)
+			if err != nil {
+				return err
+			}
+			dust, err := sdk.ParseCoinsNormalized(dustStr)
+			if err != nil {
+				return fmt.Errorf("invalid --%s %q: %w", flagDust, dustStr, err)
+			}
+			if dust.IsZero() {
+				return fmt.Errorf("a --%s amount is required", flagDust)
+			}
+			return transformAppStateRunE(func(cdc codec.JSONCodec, appState map[string]json.RawMessage) error {
+				return ZeroDust(cdc, appState, dust)
+			})(cmd, args)
+		},
+	}
+	cmd.Flags().String(flagDust, "", "The dust amounts, e.g. 1000nhash,10usd.local")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "Write the transformed genesis to this file instead of the provided one")
+	return cmd
+}
+
+// GenesisRebech32Cmd returns the genesis transform rebech32 command.
+func GenesisRebech32Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rebech32 <genesis file> --from-prefix <prefix> --to-prefix <prefix>",
+		Short: "Change the bech32 prefix of all addresses",
+		Long: fmt.Sprintf(`Change the bech32 prefix of all addresses.
+Every string in the app state that is a bech32 address with the --from-prefix followed by one of
+%q is re-encoded using the --to-prefix followed by the same suffix.
+The genesis file is updated in place unless --output-document is provided.`, bech32HRPSuffixes),
+		Example: fmt.Sprintf(`$ %[1]s transform rebech32 exported.json --from-prefix pb --to-prefix tp`, genCmdStart),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fromPrefix, err := cmd.Flags().GetString(flagFromPrefix)
+			if err != nil {
+				return err
+			}
+			toPrefix, err := cmd.Flags().GetString(flagToPrefix)
+			if err != nil {
+				return err
+			}
+			if len(fromPrefix) == 0 || len(toPrefix) == 0 {
+				return fmt.Errorf("both --%s and --%s are required", flagFromPrefix, flagToPrefix)
+			}
+			return transformAppStateRunE(func(_ codec.JSONCodec, appState map[string]json.RawMessage) error {
+				return Rebech32(appState, fromPrefix, toPrefix)
+			})(cmd, args)
+		},
+	}
+	cmd.Flags().String(flagFromPrefix, "", "The current bech32 prefix, e.g. pb")
+	cmd.Flags().String(flagToPrefix, "", "The desired bech32 prefix, e.g. tp")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "Write the transformed genesis to this file instead of the provided one")
+	return cmd
+}
+
+// appStateTransformer is a function that makes changes to an app-state.
+type appStateTransformer func(cdc codec.JSONCodec, appState map[string]json.RawMessage) error
+
+// transformAppStateRunE returns a cobra.Command.RunE function that reads the genesis file in args[0],
+// runs the app-state through the provided transformer, then saves the result.
+func transformAppStateRunE(transformer appStateTransformer) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		clientCtx := client.GetClientContextFromCmd(cmd)
+		outFile, err := cmd.Flags().GetString(flags.FlagOutputDocument)
+		if err != nil {
+			return err
+		}
+		if len(outFile) == 0 {
+			outFile = args[0]
+		}
+
+		cmd.SilenceUsage = true
+		appGenesis, err := genutiltypes.AppGenesisFromFile(args[0])
+		if err != nil {
+			return fmt.Errorf("failed to read genesis file: %w", err)
+		}
+
+		var appState map[string]json.RawMessage
+		if err = json.Unmarshal(appGenesis.AppState, &appState); err != nil {
+			return fmt.Errorf("failed to unmarshal app state: %w", err)
+		}
+
+		if err = transformer(clientCtx.Codec, appState); err != nil {
+			return err
+		}
+
+		appGenesis.AppState, err = json.Marshal(appState)
+		if err != nil {
+			return fmt.Errorf("failed to marshal app state: %w", err)
+		}
+		return appGenesis.SaveAs(outFile)
+	}
+}
+
+// StripOrders removes all orders from the exchange genesis state and releases the holds they had.
+func StripOrders(cdc codec.JSONCodec, appState map[string]json.RawMessage) error {
+	var exGenState exchange.GenesisState
+	if err := readModuleGenState(cdc, appState, exchange.ModuleName, &exGenState); err != nil {
+		return err
+	}
+	if len(exGenState.Orders) == 0 {
+		return nil
+	}
+
+	var holdGenState hold.GenesisState
+	if err := readModuleGenState(cdc, appState, hold.ModuleName, &holdGenState); err != nil {
+		return err
+	}
+
+	toRelease := make(map[string]sdk.Coins)
+	for _, order := range exGenState.Orders {
+		owner := order.GetOwner()
+		toRelease[owner] = toRelease[owner].Add(order.GetHoldAmount()...)
+	}
+
+	holds := make([]*hold.AccountHold, 0, len(holdGenState.Holds))
+	for _, ah := range holdGenState.Holds {
+		release, ok := toRelease[ah.Address]
+		if ok {
+			ah.Amount = subtractToZero(ah.Amount, release)
+		}
+		if !ah.Amount.IsZero() {
+			holds = append(holds, ah)
+		}
+	}
+	holdGenState.Holds = holds
+	exGenState.Orders = nil
+
+	if err := writeModuleGenState(cdc, appState, hold.ModuleName, &holdGenState); err != nil {
+		return err
+	}
+	return writeModuleGenState(cdc, appState, exchange.ModuleName, &exGenState)
+}
+
+// ZeroDust removes account balances that are less than the dust amount of their denom and reduces the supply to match.
+// Balances with funds on hold in a denom are not changed for that denom.
+func ZeroDust(cdc codec.JSONCodec, appState map[string]json.RawMessage, dust sdk.Coins) error {
+	var bankGenState banktypes.GenesisState
+	if err := readModuleGenState(cdc, appState, banktypes.ModuleName, &bankGenState); err != nil {
+		return err
+	}
+	var holdGenState hold.GenesisState
+	if err := readModuleGenState(cdc, appState, hold.ModuleName, &holdGenState); err != nil {
+		return err
+	}
+
+	onHold := make(map[string]sdk.Coins, len(holdGenState.Holds))
+	for _, ah := range holdGenState.Holds {
+		onHold[ah.Address] = ah.Amount
+	}
+
+	var removed sdk.Coins
+	balances := make([]banktypes.Balance, 0, len(bankGenState.Balances))
+	for _, bal := range bankGenState.Balances {
+		held := onHold[bal.Address]
+		var keep sdk.Coins
+		for _, coin := range bal.Coins {
+			dustAmt := dust.AmountOf(coin.Denom)
+			if dustAmt.IsZero() || coin.Amount.GTE(dustAmt) || !held.AmountOf(coin.Denom).IsZero() {
+				keep = append(keep, coin)
+				continue
+			}
+			removed = removed.Add(coin)
+		}
+		if !keep.IsZero() {
+			bal.Coins = keep
+			balances = append(balances, bal)
+		}
+	}
+
+	if removed.IsZero() {
+		return nil
+	}
+	supply, hasNeg := bankGenState.Supply.SafeSub(removed...)
+	if hasNeg {
+		return fmt.Errorf("cannot remove %s from supply %s", removed, bankGenState.Supply)
+	}
+	bankGenState.Balances = balances
+	bankGenState.Supply = supply
+	return writeModuleGenState(cdc, appState, banktypes.ModuleName, &bankGenState)
+}
+
+// Rebech32 changes the bech32 prefix of every address in the app state from fromPrefix to toPrefix.
+func Rebech32(appState map[string]json.RawMessage, fromPrefix, toPrefix string) error {
+	hrpMap := make(map[string]string, len(bech32HRPSuffixes))
+	for _, suffix := range bech32HRPSuffixes {
+		hrpMap[fromPrefix+suffix] = toPrefix + suffix
+	}
+
+	for moduleName, raw := range appState {
+		dec := json.NewDecoder(bytes.NewReader(raw))
+		dec.UseNumber()
+		var val interface{}
+		if err := dec.Decode(&val); err != nil {
+			return fmt.Errorf("could not decode %s genesis state: %w", moduleName, err)
+		}
+		newVal, err := rebech32Value(val, hrpMap)
+		if err != nil {
+			return fmt.Errorf("could not convert %s genesis state: %w", moduleName, err)
+		}
+		appState[moduleName], err = json.Marshal(newVal)
+		if err != nil {
+			return fmt.Errorf("could not encode %s genesis state: %w", moduleName, err)
+		}
+	}
+	return nil
+}
+
+// rebech32Value converts any bech32 strings in the provided value (and its children) using the provided hrp map.
+func rebech32Value(val interface{}, hrpMap map[string]string) (interface{}, error) {
+	switch v := val.(type) {
+	case string:
+		return rebech32String(v, hrpMap)
+	case []interface{}:
+		for i := range v {
+			newEntry, err := rebech32Value(v[i], hrpMap)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = newEntry
+		}
+		return v, nil
+	case map[string]interface{}:
+		rv := make(map[string]interface{}, len(v))
+		for key, entry := range v {
+			newKey, err := rebech32String(key, hrpMap)
+			if err != nil {
+				return nil, err
+			}
+			newEntry, err := rebech32Value(entry, hrpMap)
+			if err != nil {
+				return nil, err
+			}
+			rv[newKey] = newEntry
+		}
+		return rv, nil
+	}
+	return val, nil
+}
+
+// rebech32String converts the provided string to use the new hrp if it's a bech32 string with one of the old hrps.
+// If it's not a bech32 string, or has a different hrp, it's returned unchanged.
+func rebech32String(str string, hrpMap map[string]string) (string, error) {
+	sep := strings.LastIndex(str, "1")
+	if sep < 1 {
+		return str, nil
+	}
+	newHRP, ok := hrpMap[str[:sep]]
+	if !ok {
+		return str, nil
+	}
+	_, bz, err := bech32.DecodeAndConvert(str)
+	if err != nil {
+		// It's not actually a bech32 string, it just looks like one.
+		return str, nil
+	}
+	rv, err := bech32.ConvertAndEncode(newHRP, bz)
+	if err != nil {
+		return "", fmt.Errorf("could not encode %q with prefix %q: %w", str, newHRP, err)
+	}
+	return rv, nil
+}
+
+// subtractToZero subtracts the toSub coins from the base coins, with a minimum of zero for each denom.
+func subtractToZero(base, toSub sdk.Coins) sdk.Coins {
+	var rv sdk.Coins
+	for _, coin := range base {
+		amt := coin.Amount.Sub(toSub.AmountOf(coin.Denom))
+		if amt.IsPositive() {
+			rv = append(rv, sdk.NewCoin(coin.Denom, amt))
+		}
+	}
+	return rv
+}
+
+// readModuleGenState reads the provided module's genesis state from the app state into the provided target.
+// If the module isn't in the app state, the target is left unchanged.
+func readModuleGenState(cdc codec.JSONCodec, appState map[string]json.RawMessage, moduleName string, target proto.Message) error {
+	raw, ok := appState[moduleName]
+	if !ok || len(raw) == 0 {
+		return nil
+	}
+	if err := cdc.UnmarshalJSON(raw, target); err != nil {
+		return fmt.Errorf("could not unmarshal %s genesis state: %w", moduleName, err)
+	}
+	return nil
+}
+
+// writeModuleGenState writes the provided module genesis state into the app state.
+func writeModuleGenState(cdc codec.JSONCodec, appState map[string]json.RawMessage, moduleName string, genState proto.Message) error {
+	raw, err := cdc.MarshalJSON(genState)
+	if err != nil {
+		return fmt.Errorf("could not marshal %s genesis state: %w", moduleName, err)
+	}
+	appState[moduleName] = raw
+	return nil
+}
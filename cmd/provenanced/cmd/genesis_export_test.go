@@ -0,0 +1,126 @@
+package cmd_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/app"
+	provenancecmd "github.com/provenance-io/provenance/cmd/provenanced/cmd"
+	"github.com/provenance-io/provenance/testutil/fixtures"
+	"github.com/provenance-io/provenance/x/exchange"
+	"github.com/provenance-io/provenance/x/hold"
+)
+
+func TestStripOrders(t *testing.T) {
+	origCache := sdk.IsAddrCacheEnabled()
+	defer sdk.SetAddrCacheEnabled(origCache)
+	sdk.SetAddrCacheEnabled(false)
+
+	cdc := app.MakeTestEncodingConfig(t).Marshaler
+	seller := sdk.AccAddress("seller______________")
+	buyer := sdk.AccAddress("buyer_______________")
+
+	exGenState := &exchange.GenesisState{
+		Params:      exchange.DefaultParams(),
+		Markets:     []exchange.Market{fixtures.NewMarket(1, "One", seller)},
+		LastOrderId: 5,
+		Orders: []exchange.Order{
+			*fixtures.NewAskOrder(4, 1, seller, "10apple", "5plum"),
+			*fixtures.NewBidOrder(5, 1, buyer, "10apple", "5plum"),
+		},
+	}
+	holdGenState := &hold.GenesisState{
+		Holds: []*hold.AccountHold{
+			{Address: seller.String(), Amount: fixtures.Coins("15apple")},
+			{Address: buyer.String(), Amount: fixtures.Coins("5plum")},
+		},
+	}
+	appState := map[string]json.RawMessage{
+		exchange.ModuleName: cdc.MustMarshalJSON(exGenState),
+		hold.ModuleName:     cdc.MustMarshalJSON(holdGenState),
+	}
+
+	err := provenancecmd.StripOrders(cdc, appState)
+	require.NoError(t, err, "StripOrders")
+
+	var actEx exchange.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(appState[exchange.ModuleName], &actEx), "UnmarshalJSON exchange")
+	assert.Empty(t, actEx.Orders, "Orders")
+	assert.Equal(t, uint64(5), actEx.LastOrderId, "LastOrderId")
+	assert.Len(t, actEx.Markets, 1, "Markets")
+
+	var actHold hold.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(appState[hold.ModuleName], &actHold), "UnmarshalJSON hold")
+	expHolds := []*hold.AccountHold{{Address: seller.String(), Amount: fixtures.Coins("5apple")}}
+	assert.Equal(t, expHolds, actHold.Holds, "Holds")
+}
+
+func TestZeroDust(t *testing.T) {
+	origCache := sdk.IsAddrCacheEnabled()
+	defer sdk.SetAddrCacheEnabled(origCache)
+	sdk.SetAddrCacheEnabled(false)
+
+	cdc := app.MakeTestEncodingConfig(t).Marshaler
+	addr1 := sdk.AccAddress("addr1_______________").String()
+	addr2 := sdk.AccAddress("addr2_______________").String()
+	addr3 := sdk.AccAddress("addr3_______________").String()
+
+	bankGenState := &banktypes.GenesisState{
+		Params: banktypes.DefaultParams(),
+		Balances: []banktypes.Balance{
+			{Address: addr1, Coins: fixtures.Coins("5apple,999nhash")},
+			{Address: addr2, Coins: fixtures.Coins("3apple,1000nhash")},
+			{Address: addr3, Coins: fixtures.Coins("1nhash")},
+		},
+		Supply: fixtures.Coins("8apple,2000nhash"),
+	}
+	holdGenState := &hold.GenesisState{
+		Holds: []*hold.AccountHold{{Address: addr3, Amount: fixtures.Coins("1nhash")}},
+	}
+	appState := map[string]json.RawMessage{
+		banktypes.ModuleName: cdc.MustMarshalJSON(bankGenState),
+		hold.ModuleName:      cdc.MustMarshalJSON(holdGenState),
+	}
+
+	err := provenancecmd.ZeroDust(cdc, appState, fixtures.Coins("1000nhash"))
+	require.NoError(t, err, "ZeroDust")
+
+	var actBank banktypes.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(appState[banktypes.ModuleName], &actBank), "UnmarshalJSON bank")
+	expBalances := []banktypes.Balance{
+		{Address: addr1, Coins: fixtures.Coins("5apple")},
+		{Address: addr2, Coins: fixtures.Coins("3apple,1000nhash")},
+		{Address: addr3, Coins: fixtures.Coins("1nhash")},
+	}
+	assert.Equal(t, expBalances, actBank.Balances, "Balances")
+	assert.Equal(t, "8apple,1001nhash", actBank.Supply.String(), "Supply")
+}
+
+func TestRebech32(t *testing.T) {
+	addrBz := []byte("some_address________")
+	mustEncode := func(hrp string) string {
+		rv, err := bech32.ConvertAndEncode(hrp, addrBz)
+		require.NoError(t, err, "ConvertAndEncode(%q)", hrp)
+		return rv
+	}
+
+	appState := map[string]json.RawMessage{
+		"mod1": json.RawMessage(`{"addr":"` + mustEncode("pb") + `","val":"` + mustEncode("pbvaloper") + `","num":"12345678901234567890"}`),
+		"mod2": json.RawMessage(`{"` + mustEncode("pb") + `":[{"other":"` + mustEncode("cosmos") + `","count":3}],"word":"pb1notreal"}`),
+	}
+
+	err := provenancecmd.Rebech32(appState, "pb", "tp")
+	require.NoError(t, err, "Rebech32")
+
+	expMod1 := `{"addr":"` + mustEncode("tp") + `","num":"12345678901234567890","val":"` + mustEncode("tpvaloper") + `"}`
+	expMod2 := `{"` + mustEncode("tp") + `":[{"count":3,"other":"` + mustEncode("cosmos") + `"}],"word":"pb1notreal"}`
+	assert.Equal(t, expMod1, string(appState["mod1"]), "mod1")
+	assert.Equal(t, expMod2, string(appState["mod2"]), "mod2")
+}
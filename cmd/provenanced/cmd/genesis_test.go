@@ -158,6 +158,18 @@ func fixEmptiesInExchangeGenState(exGenState *exchange.GenesisState) {
 		if market.FeeSellerSettlementRatios == nil {
 			exGenState.Markets[i].FeeSellerSettlementRatios = make([]exchange.FeeRatio, 0)
 		}
+		if market.FeeSellerSettlementTakerRatios == nil {
+			exGenState.Markets[i].FeeSellerSettlementTakerRatios = make([]exchange.FeeRatio, 0)
+		}
+		if market.FeeSellerSettlementRebateRatios == nil {
+			exGenState.Markets[i].FeeSellerSettlementRebateRatios = make([]exchange.FeeRatio, 0)
+		}
+		if market.RebateAddresses == nil {
+			exGenState.Markets[i].RebateAddresses = make([]string, 0)
+		}
+		if market.ReferralCap == nil {
+			exGenState.Markets[i].ReferralCap = make([]sdk.Coin, 0)
+		}
 		if market.FeeBuyerSettlementFlat == nil {
 			exGenState.Markets[i].FeeBuyerSettlementFlat = make([]sdk.Coin, 0)
 		}
@@ -173,6 +185,15 @@ func fixEmptiesInExchangeGenState(exGenState *exchange.GenesisState) {
 		if market.ReqAttrCreateCommitment == nil {
 			exGenState.Markets[i].ReqAttrCreateCommitment = make([]string, 0)
 		}
+		if market.AcceptedAssetDenoms == nil {
+			exGenState.Markets[i].AcceptedAssetDenoms = make([]string, 0)
+		}
+		if market.AcceptedPriceDenoms == nil {
+			exGenState.Markets[i].AcceptedPriceDenoms = make([]string, 0)
+		}
+		if market.ExchangeSplits == nil {
+			exGenState.Markets[i].ExchangeSplits = make([]exchange.DenomSplit, 0)
+		}
 		if market.AccessGrants == nil {
 			exGenState.Markets[i].AccessGrants = make([]exchange.AccessGrant, 0)
 		}
@@ -190,8 +211,16 @@ func fixEmptiesInExchangeGenState(exGenState *exchange.GenesisState) {
 		exGenState.Orders = make([]exchange.Order, 0)
 	}
 	for _, order := range exGenState.Orders {
-		if bid := order.GetBidOrder(); bid != nil && bid.BuyerSettlementFees == nil {
-			bid.BuyerSettlementFees = make(sdk.Coins, 0)
+		if ask := order.GetAskOrder(); ask != nil && ask.BasketAssets == nil {
+			ask.BasketAssets = make(sdk.Coins, 0)
+		}
+		if bid := order.GetBidOrder(); bid != nil {
+			if bid.BuyerSettlementFees == nil {
+				bid.BuyerSettlementFees = make(sdk.Coins, 0)
+			}
+			if bid.BasketAssets == nil {
+				bid.BasketAssets = make(sdk.Coins, 0)
+			}
 		}
 	}
 
@@ -206,6 +235,56 @@ func fixEmptiesInExchangeGenState(exGenState *exchange.GenesisState) {
 			exGenState.Payments[i].TargetAmount = make([]sdk.Coin, 0)
 		}
 	}
+
+	if exGenState.TriggerOrders == nil {
+		exGenState.TriggerOrders = make([]exchange.TriggerOrder, 0)
+	}
+
+	if exGenState.OrderLinks == nil {
+		exGenState.OrderLinks = make([]exchange.OrderLink, 0)
+	}
+	if exGenState.SettlementPrices == nil {
+		exGenState.SettlementPrices = make([]exchange.SettlementPrice, 0)
+	}
+	if exGenState.SettlementRecords == nil {
+		exGenState.SettlementRecords = make([]exchange.SettlementRecord, 0)
+	}
+	if exGenState.NavRecords == nil {
+		exGenState.NavRecords = make([]exchange.NAVRecord, 0)
+	}
+	if exGenState.RewardPools == nil {
+		exGenState.RewardPools = make([]exchange.MarketAmount, 0)
+	}
+	if exGenState.CommitmentRewards == nil {
+		exGenState.CommitmentRewards = make([]exchange.CommitmentReward, 0)
+	}
+	if exGenState.ScheduledFeeChanges == nil {
+		exGenState.ScheduledFeeChanges = make([]exchange.MsgGovManageFeesRequest, 0)
+	}
+	if exGenState.RecurringPayments == nil {
+		exGenState.RecurringPayments = make([]exchange.RecurringPayment, 0)
+	}
+	if exGenState.MultiPartyPayments == nil {
+		exGenState.MultiPartyPayments = make([]exchange.MultiPartyPayment, 0)
+	}
+	if exGenState.EscrowedPayments == nil {
+		exGenState.EscrowedPayments = make([]exchange.EscrowedPayment, 0)
+	}
+	if exGenState.AuthorityTransfers == nil {
+		exGenState.AuthorityTransfers = make([]exchange.MarketAuthorityTransfer, 0)
+	}
+	if exGenState.IdempotencyKeys == nil {
+		exGenState.IdempotencyKeys = make([]exchange.IdempotencyKeyRecord, 0)
+	}
+	if exGenState.SettlementReceipts == nil {
+		exGenState.SettlementReceipts = make([]exchange.SettlementReceipt, 0)
+	}
+	if exGenState.DeadManSwitches == nil {
+		exGenState.DeadManSwitches = make([]exchange.DeadManSwitch, 0)
+	}
+	if exGenState.CommitmentAccruals == nil {
+		exGenState.CommitmentAccruals = make([]exchange.CommitmentAccrual, 0)
+	}
 }
 
 func TestAddGenesisDefaultMarketCmd(t *testing.T) {
@@ -0,0 +1,281 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+
+	"github.com/provenance-io/provenance/internal/loadgen"
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+const (
+	flagLoadGenRate          = "rate"
+	flagLoadGenDuration      = "duration"
+	flagLoadGenCount         = "count"
+	flagLoadGenMix           = "mix"
+	flagLoadGenSeed          = "seed"
+	flagLoadGenWaitForCommit = "wait-for-commit"
+	flagLoadGenCommitTimeout = "commit-timeout"
+	flagLoadGenMarketID      = "market-id"
+	flagLoadGenOrderAssets   = "order-assets"
+	flagLoadGenOrderPrice    = "order-price"
+	flagLoadGenMintAmount    = "mint-amount"
+	flagLoadGenAttrName      = "attribute-name"
+)
+
+// LoadGenCmd returns the command that sends a configurable mix of txs to a chain and reports their latencies.
+func LoadGenCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "loadgen",
+		Args:  cobra.NoArgs,
+		Short: "Send a configurable mix of txs to a chain at a controlled rate and report latencies",
+		Long: fmt.Sprintf(`Send a configurable mix of txs to a chain at a controlled rate and report latencies.
+
+All txs are signed by the --from account and sent to the --node, one at a time, at the --%[1]s (in txs per second).
+Sending stops after the --%[2]s has passed or --%[3]s txs have been picked (whichever comes first), or on Ctrl-C.
+A report is then printed with the broadcast (and, with --%[4]s, commit) latencies of each kind of tx.
+
+The --%[5]s is a comma-separated list of <kind>=<weight> entries. The kinds are:
+  %[6]s: create an ask and a matching bid order in the --%[7]s using --%[8]s and --%[9]s.
+  %[10]s: settle the oldest ask and bid orders (from the --from account) in the --%[7]s.
+  %[11]s: mint --%[12]s of a marker.
+  %[13]s: add an attribute named --%[14]s (with a unique string value) to the --from account.
+
+The --from account must be able to create orders in the market (without creation fees), have the settle
+permission in the market, have the mint permission on the marker, and own the attribute name, as needed for the mix.`,
+			flagLoadGenRate, flagLoadGenDuration, flagLoadGenCount, flagLoadGenWaitForCommit, flagLoadGenMix,
+			loadgen.KindOrder, flagLoadGenMarketID, flagLoadGenOrderAssets, flagLoadGenOrderPrice,
+			loadgen.KindSettle, loadgen.KindMint, flagLoadGenMintAmount, loadgen.KindAttribute, flagLoadGenAttrName),
+		Example: `$ provenanced testnet loadgen --from loadtester --chain-id testing --fees 400000000nhash \
+    --market-id 1 --order-assets 1loadcoin --order-price 10nhash --mint-amount 100loadcoin \
+    --attribute-name load.test.pb --rate 20 --duration 5m --wait-for-commit`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			if len(clientCtx.GetFromAddress()) == 0 {
+				return fmt.Errorf("a --%s account is required", flags.FlagFrom)
+			}
+
+			mixStr, _ := cmd.Flags().GetString(flagLoadGenMix)
+			mix, err := loadgen.ParseMix(mixStr)
+			if err != nil {
+				return err
+			}
+
+			cfg, err := getLoadGenConfig(cmd)
+			if err != nil {
+				return err
+			}
+
+			builder, err := getLoadGenMsgBuilder(cmd, clientCtx, mix)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf, err = txf.Prepare(clientCtx)
+			if err != nil {
+				return err
+			}
+			commitTimeout, _ := cmd.Flags().GetDuration(flagLoadGenCommitTimeout)
+			sender := &loadGenSender{clientCtx: clientCtx, txf: txf, commitTimeout: commitTimeout}
+
+			runner, err := loadgen.NewRunner(cfg, mix, builder, sender)
+			if err != nil {
+				return err
+			}
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+			defer stop()
+
+			cmd.PrintErrf("Sending txs (mix: %s) from %s at %v tx/s.\n", mix, clientCtx.GetFromAddress(), cfg.Rate)
+			elapsed := runner.Run(ctx)
+			cmd.Print(runner.Stats().Report(elapsed))
+			return nil
+		},
+	}
+
+	cmd.Flags().Float64(flagLoadGenRate, 10, "The number of txs to send per second")
+	cmd.Flags().Duration(flagLoadGenDuration, time.Minute, "How long to send txs for (0 = no limit)")
+	cmd.Flags().Uint64(flagLoadGenCount, 0, "The maximum number of txs to send (0 = no limit)")
+	cmd.Flags().String(flagLoadGenMix, loadgen.DefaultMix, "The weighted mix of tx kinds to send")
+	cmd.Flags().Int64(flagLoadGenSeed, 0, "The seed used to pick tx kinds (0 = use the current time)")
+	cmd.Flags().Bool(flagLoadGenWaitForCommit, false, "Wait for each tx to be in a block and report commit latencies")
+	cmd.Flags().Duration(flagLoadGenCommitTimeout, 30*time.Second, "How long to wait for a tx to be in a block")
+	cmd.Flags().Uint32(flagLoadGenMarketID, 0, "The market to create and settle orders in")
+	cmd.Flags().String(flagLoadGenOrderAssets, "", "The assets of each order, e.g. 1loadcoin")
+	cmd.Flags().String(flagLoadGenOrderPrice, "", "The price of each order, e.g. 10nhash")
+	cmd.Flags().String(flagLoadGenMintAmount, "", "The amount to mint in each mint tx, e.g. 100loadcoin")
+	cmd.Flags().String(flagLoadGenAttrName, "", "The name of the attribute to add in each attribute tx")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getLoadGenConfig gets the loadgen runner config from the command's flags.
+func getLoadGenConfig(cmd *cobra.Command) (loadgen.Config, error) {
+	var cfg loadgen.Config
+	cfg.Rate, _ = cmd.Flags().GetFloat64(flagLoadGenRate)
+	cfg.Duration, _ = cmd.Flags().GetDuration(flagLoadGenDuration)
+	cfg.Count, _ = cmd.Flags().GetUint64(flagLoadGenCount)
+	cfg.WaitForCommit, _ = cmd.Flags().GetBool(flagLoadGenWaitForCommit)
+	cfg.Seed, _ = cmd.Flags().GetInt64(flagLoadGenSeed)
+	if cfg.Seed == 0 {
+		cfg.Seed = time.Now().UnixNano()
+	}
+	return cfg, cfg.Validate()
+}
+
+// getLoadGenMsgBuilder gets the loadgen msg builder from the command's flags, and makes sure it has what's
+// needed for the provided mix.
+func getLoadGenMsgBuilder(cmd *cobra.Command, clientCtx client.Context, mix *loadgen.Mix) (*loadgen.ProvenanceMsgs, error) {
+	rv := &loadgen.ProvenanceMsgs{
+		Account: clientCtx.GetFromAddress(),
+		RunID:   strconv.FormatInt(time.Now().Unix(), 36),
+	}
+	rv.MarketID, _ = cmd.Flags().GetUint32(flagLoadGenMarketID)
+	rv.AttributeName, _ = cmd.Flags().GetString(flagLoadGenAttrName)
+
+	var errs []error
+	for _, cf := range []struct {
+		flag string
+		coin *sdk.Coin
+	}{
+		{flag: flagLoadGenOrderAssets, coin: &rv.OrderAssets},
+		{flag: flagLoadGenOrderPrice, coin: &rv.OrderPrice},
+		{flag: flagLoadGenMintAmount, coin: &rv.MintAmount},
+	} {
+		str, _ := cmd.Flags().GetString(cf.flag)
+		if len(strings.TrimSpace(str)) == 0 {
+			continue
+		}
+		coin, err := sdk.ParseCoinNormalized(str)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid --%s %q: %w", cf.flag, str, err))
+			continue
+		}
+		*cf.coin = coin
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	queryClient := exchange.NewQueryClient(clientCtx)
+	rv.ListOrders = func(ctx context.Context, owner string) ([]*exchange.Order, error) {
+		resp, err := queryClient.GetOwnerOrders(ctx, &exchange.QueryGetOwnerOrdersRequest{
+			Owner:      owner,
+			Pagination: &query.PageRequest{Limit: 100},
+		})
+		if err != nil {
+			return nil, err
+		}
+		return resp.Orders, nil
+	}
+
+	return rv, rv.Validate(mix)
+}
+
+// loadGenSender is a loadgen.Sender that signs txs with the --from key and broadcasts them to the --node.
+// It keeps track of the account's sequence so that txs can be sent without waiting for the previous ones to be in a block.
+type loadGenSender struct {
+	clientCtx     client.Context
+	txf           tx.Factory
+	commitTimeout time.Duration
+}
+
+var _ loadgen.Sender = (*loadGenSender)(nil)
+
+// Send signs and broadcasts (sync) a tx with the provided msgs.
+func (s *loadGenSender) Send(ctx context.Context, msgs []sdk.Msg) (loadgen.WaitFunc, error) {
+	txf := s.txf
+	if txf.SimulateAndExecute() {
+		_, gas, err := tx.CalculateGas(s.clientCtx, txf, msgs...)
+		if err != nil {
+			return nil, s.checkSequence(err)
+		}
+		txf = txf.WithGas(gas)
+	}
+
+	txBuilder, err := txf.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return nil, err
+	}
+	if err = tx.Sign(ctx, txf, s.clientCtx.GetFromName(), txBuilder, true); err != nil {
+		return nil, err
+	}
+	txBz, err := s.clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.clientCtx.BroadcastTxSync(txBz)
+	if err != nil {
+		return nil, err
+	}
+	if res.Code != 0 {
+		return nil, s.checkSequence(fmt.Errorf("tx rejected with code %d (%s): %s", res.Code, res.Codespace, res.RawLog))
+	}
+
+	s.txf = s.txf.WithSequence(s.txf.Sequence() + 1)
+	return s.waitFunc(res.TxHash), nil
+}
+
+// checkSequence re-reads the account's sequence from the chain if the provided error is due to a wrong sequence.
+// The provided error is returned.
+func (s *loadGenSender) checkSequence(err error) error {
+	if !strings.Contains(err.Error(), sdkerrors.ErrWrongSequence.Error()) {
+		return err
+	}
+	_, seq, serr := s.clientCtx.AccountRetriever.GetAccountNumberSequence(s.clientCtx, s.clientCtx.GetFromAddress())
+	if serr == nil {
+		s.txf = s.txf.WithSequence(seq)
+	}
+	return sdkerrors.ErrWrongSequence
+}
+
+// waitFunc returns a function that waits for the tx with the provided hash to be in a block.
+func (s *loadGenSender) waitFunc(hash string) loadgen.WaitFunc {
+	return func(ctx context.Context) error {
+		ctx, cancel := context.WithTimeout(ctx, s.commitTimeout)
+		defer cancel()
+		ticker := time.NewTicker(250 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("tx not in a block after %s", s.commitTimeout)
+			case <-ticker.C:
+			}
+			res, err := authtx.QueryTx(s.clientCtx, hash)
+			if err != nil {
+				// Most likely, it's just not in a block yet.
+				continue
+			}
+			if res.Code != 0 {
+				return fmt.Errorf("tx failed with code %d (%s)", res.Code, res.Codespace)
+			}
+			return nil
+		}
+	}
+}
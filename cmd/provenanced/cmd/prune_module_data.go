@@ -0,0 +1,212 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/iavl"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+
+	attributetypes "github.com/provenance-io/provenance/x/attribute/types"
+	"github.com/provenance-io/provenance/x/exchange"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+)
+
+const (
+	FlagPruneModules    = "modules"
+	FlagPruneKeepRecent = "keep-recent"
+	FlagPruneRetain     = "retain"
+	FlagPruneDryRun     = "dry-run"
+	FlagAppDBBackend    = "app-db-backend"
+)
+
+// DefaultPruneModuleKeepRecent is the default number of recent heights of module data to keep.
+// It's the same as the SDK's default pruning keep-recent.
+const DefaultPruneModuleKeepRecent uint64 = 362880
+
+// DefaultPruneModules are the stores pruned by default by the prune-module-data command.
+var DefaultPruneModules = []string{attributetypes.StoreKey, exchange.StoreKey, markertypes.StoreKey, metadatatypes.StoreKey}
+
+// PruneModuleDataCmd returns the command that removes old heights of specific module stores.
+func PruneModuleDataCmd(appCreator servertypes.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune-module-data",
+		Args:  cobra.NoArgs,
+		Short: "Remove old heights of specific module stores from the node's database",
+		Long: `Remove old heights of specific module stores from the node's database.
+
+This lets an archive node drop the history of module data that's no longer useful (e.g. old exchange settlements,
+replaced net asset values, expired attributes and finished payments) while keeping the full history of the other stores.
+Only old heights are removed; the current state (and the app hash) is not changed.
+Queries of a pruned store at a removed height will fail.
+
+By default, the attribute, exchange, marker, and metadata stores are pruned, keeping the most recent 362880 heights.
+Use --modules to choose the stores, --keep-recent to change how many heights are kept,
+and --retain <store>=<heights> to use a different number of heights for a specific store.
+
+The node must not be running.`,
+		Example: `$ provenanced prune-module-data
+$ provenanced prune-module-data --modules exchange,marker --keep-recent 100000
+$ provenanced prune-module-data --retain exchange=50000 --retain attribute=1000000 --dry-run`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			vp := server.GetServerContextFromCmd(cmd).Viper
+			if err := vp.BindPFlags(cmd.Flags()); err != nil {
+				return err
+			}
+
+			modules, err := cmd.Flags().GetStringSlice(FlagPruneModules)
+			if err != nil {
+				return err
+			}
+			keepRecent, err := cmd.Flags().GetUint64(FlagPruneKeepRecent)
+			if err != nil {
+				return err
+			}
+			retain, err := cmd.Flags().GetStringArray(FlagPruneRetain)
+			if err != nil {
+				return err
+			}
+			dryRun, err := cmd.Flags().GetBool(FlagPruneDryRun)
+			if err != nil {
+				return err
+			}
+
+			retention, err := ParseModuleRetention(modules, keepRecent, retain)
+			if err != nil {
+				return err
+			}
+
+			home := vp.GetString(flags.FlagHome)
+			if len(home) == 0 {
+				home = defaultNodeHome
+			}
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(vp), filepath.Join(home, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			logger := log.NewLogger(cmd.OutOrStdout())
+			app := appCreator(logger, db, nil, vp)
+			rms, ok := app.CommitMultiStore().(*rootmulti.Store)
+			if !ok {
+				return fmt.Errorf("cannot prune module data from a %T, only a %T", app.CommitMultiStore(), &rootmulti.Store{})
+			}
+
+			return PruneModuleData(rms, rootmulti.GetLatestVersion(db), retention, dryRun, cmd.OutOrStdout())
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(FlagAppDBBackend, "", "The type of database for the application database")
+	cmd.Flags().StringSlice(FlagPruneModules, DefaultPruneModules, "The stores to prune")
+	cmd.Flags().Uint64(FlagPruneKeepRecent, DefaultPruneModuleKeepRecent, "The number of recent heights to keep")
+	cmd.Flags().StringArray(FlagPruneRetain, nil, "The number of heights to keep for a specific store, as <store>=<heights> (repeatable)")
+	cmd.Flags().Bool(FlagPruneDryRun, false, "Only output what would be pruned")
+	return cmd
+}
+
+// ParseModuleRetention gets the number of heights to keep for each store.
+// Each of the modules will keep keepRecent heights unless it has a <store>=<heights> entry in retain.
+// Stores with an entry in retain are pruned even if they aren't in modules.
+func ParseModuleRetention(modules []string, keepRecent uint64, retain []string) (map[string]uint64, error) {
+	if keepRecent == 0 {
+		return nil, fmt.Errorf("invalid --%s: must be at least 1", FlagPruneKeepRecent)
+	}
+
+	rv := make(map[string]uint64, len(modules)+len(retain))
+	for _, module := range modules {
+		module = strings.TrimSpace(module)
+		if len(module) == 0 {
+			return nil, fmt.Errorf("invalid --%s: store names cannot be empty", FlagPruneModules)
+		}
+		rv[module] = keepRecent
+	}
+
+	for _, entry := range retain {
+		module, heightsStr, found := strings.Cut(entry, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid --%s %q: expected format <store>=<heights>", FlagPruneRetain, entry)
+		}
+		module = strings.TrimSpace(module)
+		if len(module) == 0 {
+			return nil, fmt.Errorf("invalid --%s %q: store name cannot be empty", FlagPruneRetain, entry)
+		}
+		heights, err := strconv.ParseUint(strings.TrimSpace(heightsStr), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --%s %q: invalid heights: %w", FlagPruneRetain, entry, err)
+		}
+		if heights == 0 {
+			return nil, fmt.Errorf("invalid --%s %q: heights must be at least 1", FlagPruneRetain, entry)
+		}
+		rv[module] = heights
+	}
+
+	if len(rv) == 0 {
+		return nil, errors.New("no stores to prune")
+	}
+	return rv, nil
+}
+
+// PruneModuleData deletes the old heights of each of the stores in the retention map, keeping the number
+// of recent heights it maps to (including the latest height). All stores are checked before any are pruned.
+func PruneModuleData(rms *rootmulti.Store, latest int64, retention map[string]uint64, dryRun bool, out io.Writer) error {
+	if latest <= 0 {
+		return fmt.Errorf("the database has no heights to prune, latest height: %d", latest)
+	}
+
+	names := make([]string, 0, len(retention))
+	for name := range retention {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	keys := rms.StoreKeysByName()
+	for _, name := range names {
+		if _, found := keys[name]; !found {
+			return fmt.Errorf("unknown store %q", name)
+		}
+		if rms.GetStoreByName(name).GetStoreType() != storetypes.StoreTypeIAVL {
+			return fmt.Errorf("store %q cannot be pruned: it is not an IAVL store", name)
+		}
+	}
+
+	for _, name := range names {
+		keep := retention[name]
+		if keep >= uint64(latest) {
+			fmt.Fprintf(out, "%s: nothing to prune, keeping the most recent %d heights of %d\n", name, keep, latest)
+			continue
+		}
+		pruneTo := latest - int64(keep)
+		if dryRun {
+			fmt.Fprintf(out, "%s: would prune heights up to %d\n", name, pruneTo)
+			continue
+		}
+
+		store, ok := rms.GetCommitKVStore(keys[name]).(*iavl.Store)
+		if !ok {
+			return fmt.Errorf("store %q cannot be pruned: it is a %T", name, rms.GetCommitKVStore(keys[name]))
+		}
+		if err := store.DeleteVersionsTo(pruneTo); err != nil {
+			return fmt.Errorf("could not prune store %q up to height %d: %w", name, pruneTo, err)
+		}
+		fmt.Fprintf(out, "%s: pruned heights up to %d\n", name, pruneTo)
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package cmd_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/iavl"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	provenancecmd "github.com/provenance-io/provenance/cmd/provenanced/cmd"
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+func TestParseModuleRetention(t *testing.T) {
+	tests := []struct {
+		name       string
+		modules    []string
+		keepRecent uint64
+		retain     []string
+		exp        map[string]uint64
+		expErr     []string
+	}{
+		{
+			name:       "modules only",
+			modules:    []string{"exchange", " marker "},
+			keepRecent: 100,
+			exp:        map[string]uint64{"exchange": 100, "marker": 100},
+		},
+		{
+			name:       "retain overrides and adds",
+			modules:    []string{"exchange", "marker"},
+			keepRecent: 100,
+			retain:     []string{"marker=5", "attribute = 7"},
+			exp:        map[string]uint64{"exchange": 100, "marker": 5, "attribute": 7},
+		},
+		{
+			name:       "zero keep recent",
+			modules:    []string{"exchange"},
+			keepRecent: 0,
+			expErr:     []string{"invalid --keep-recent: must be at least 1"},
+		},
+		{
+			name:       "empty module",
+			modules:    []string{""},
+			keepRecent: 100,
+			expErr:     []string{"invalid --modules: store names cannot be empty"},
+		},
+		{
+			name:       "retain without equals",
+			keepRecent: 100,
+			retain:     []string{"marker"},
+			expErr:     []string{"invalid --retain \"marker\": expected format <store>=<heights>"},
+		},
+		{
+			name:       "retain without store",
+			keepRecent: 100,
+			retain:     []string{"=5"},
+			expErr:     []string{"invalid --retain \"=5\": store name cannot be empty"},
+		},
+		{
+			name:       "retain bad heights",
+			keepRecent: 100,
+			retain:     []string{"marker=five"},
+			expErr:     []string{"invalid --retain \"marker=five\": invalid heights"},
+		},
+		{
+			name:       "retain zero heights",
+			keepRecent: 100,
+			retain:     []string{"marker=0"},
+			expErr:     []string{"invalid --retain \"marker=0\": heights must be at least 1"},
+		},
+		{
+			name:       "nothing",
+			keepRecent: 100,
+			expErr:     []string{"no stores to prune"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := provenancecmd.ParseModuleRetention(tc.modules, tc.keepRecent, tc.retain)
+			assertions.AssertErrorContents(t, err, tc.expErr, "ParseModuleRetention error")
+			assert.Equal(t, tc.exp, actual, "ParseModuleRetention result")
+		})
+	}
+}
+
+// newPruneTestStore creates a multi-store with "exchange", "marker", and "bank" stores that has 10 heights committed.
+func newPruneTestStore(t *testing.T) (*rootmulti.Store, map[string]storetypes.StoreKey) {
+	rms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	keys := storetypes.NewKVStoreKeys("exchange", "marker", "bank")
+	for _, key := range keys {
+		rms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, rms.LoadLatestVersion(), "LoadLatestVersion")
+
+	for i := byte(1); i <= 10; i++ {
+		for _, key := range keys {
+			rms.GetKVStore(key).Set([]byte{i}, []byte{i})
+		}
+		rms.Commit()
+	}
+	return rms, map[string]storetypes.StoreKey{"exchange": keys["exchange"], "marker": keys["marker"], "bank": keys["bank"]}
+}
+
+func TestPruneModuleData(t *testing.T) {
+	versionExists := func(rms *rootmulti.Store, key storetypes.StoreKey, version int64) bool {
+		return rms.GetCommitKVStore(key).(*iavl.Store).VersionExists(version)
+	}
+
+	t.Run("prunes only the requested stores", func(t *testing.T) {
+		rms, keys := newPruneTestStore(t)
+		var out bytes.Buffer
+		err := provenancecmd.PruneModuleData(rms, 10, map[string]uint64{"exchange": 3, "marker": 20}, false, &out)
+		require.NoError(t, err, "PruneModuleData")
+		assert.Equal(t, "exchange: pruned heights up to 7\nmarker: nothing to prune, keeping the most recent 20 heights of 10\n", out.String(), "output")
+
+		for v := int64(1); v <= 10; v++ {
+			assert.Equal(t, v > 7, versionExists(rms, keys["exchange"], v), "exchange version %d exists", v)
+			assert.True(t, versionExists(rms, keys["marker"], v), "marker version %d exists", v)
+			assert.True(t, versionExists(rms, keys["bank"], v), "bank version %d exists", v)
+		}
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		rms, keys := newPruneTestStore(t)
+		var out bytes.Buffer
+		err := provenancecmd.PruneModuleData(rms, 10, map[string]uint64{"exchange": 3}, true, &out)
+		require.NoError(t, err, "PruneModuleData")
+		assert.Equal(t, "exchange: would prune heights up to 7\n", out.String(), "output")
+		assert.True(t, versionExists(rms, keys["exchange"], 1), "exchange version 1 exists")
+	})
+
+	t.Run("unknown store", func(t *testing.T) {
+		rms, keys := newPruneTestStore(t)
+		var out bytes.Buffer
+		err := provenancecmd.PruneModuleData(rms, 10, map[string]uint64{"exchange": 3, "nope": 3}, false, &out)
+		assertions.AssertErrorValue(t, err, "unknown store \"nope\"", "PruneModuleData error")
+		assert.Empty(t, out.String(), "output")
+		assert.True(t, versionExists(rms, keys["exchange"], 1), "exchange version 1 exists")
+	})
+
+	t.Run("no heights", func(t *testing.T) {
+		rms, _ := newPruneTestStore(t)
+		err := provenancecmd.PruneModuleData(rms, 0, map[string]uint64{"exchange": 3}, false, &bytes.Buffer{})
+		assertions.AssertErrorValue(t, err, "the database has no heights to prune, latest height: 0", "PruneModuleData error")
+	})
+}
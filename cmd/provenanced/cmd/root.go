@@ -27,7 +27,6 @@ import (
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
-	"github.com/cosmos/cosmos-sdk/client/debug"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/keys"
 	"github.com/cosmos/cosmos-sdk/client/pruning"
@@ -49,6 +48,7 @@ import (
 	"github.com/provenance-io/provenance/app/params"
 	"github.com/provenance-io/provenance/cmd/provenanced/config"
 	"github.com/provenance-io/provenance/internal/pioconfig"
+	markervoteext "github.com/provenance-io/provenance/x/marker/voteext"
 )
 
 // NewRootCmd creates a new root command for provenanced. It is called once in the main function.
@@ -152,6 +152,7 @@ func Execute(rootCmd *cobra.Command) error {
 	rootCmd.PersistentFlags().BoolP(config.EnvTypeFlag, "t", false, "Indicates this command should use the testnet configuration (default: false [mainnet])")
 	rootCmd.PersistentFlags().String(flags.FlagLogLevel, zerolog.InfoLevel.String(), "The logging level (trace|debug|info|warn|error|fatal|panic)")
 	rootCmd.PersistentFlags().String(flags.FlagLogFormat, cmtconfig.LogFormatPlain, "The logging format (json|plain)")
+	rootCmd.PersistentFlags().String(config.LogModuleLevelsFlag, "", "Log levels for specific modules, e.g. exchange=debug,marker=info (overrides the log_level for those modules)")
 
 	// Custom denom flag added to root command
 	rootCmd.PersistentFlags().String(config.CustomDenomFlag, "", "Indicates if a custom denom is to be used, and the name of it (default nhash)")
@@ -167,7 +168,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig, b
 		InitCmd(basicManager),
 		GenesisCmd(encodingConfig.TxConfig, basicManager, app.DefaultNodeHome),
 		testnetCmd(basicManager, banktypes.GenesisBalancesIterator{}),
-		debug.Cmd(),
+		DebugCmd(),
 		ConfigCmd(),
 		AddMetaAddressCmd(),
 		snapshot.Cmd(newApp),
@@ -175,6 +176,11 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig, b
 		GetDocGenCmd(),
 		GetTreeCmd(),
 		pruning.Cmd(newApp, app.DefaultNodeHome),
+		PruneModuleDataCmd(newApp, app.DefaultNodeHome),
+		VerifyStorePrefixCmd(newApp, app.DefaultNodeHome),
+		UpgradeDryRunCmd(),
+		InPlaceTestnetCmd(),
+		EventExportCmd(),
 	)
 
 	fixDebugPubkeyRawTypeFlag(rootCmd)
@@ -199,6 +205,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig, b
 
 func addModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
+	markervoteext.AddModuleInitFlags(startCmd)
 }
 
 func queryCommand() *cobra.Command {
@@ -219,6 +226,7 @@ func queryCommand() *cobra.Command {
 		server.QueryBlocksCmd(),
 		authcmd.QueryTxCmd(),
 		server.QueryBlockResultsCmd(),
+		GetCmdSummarizeTx(),
 	)
 
 	cmd.PersistentFlags().String(flags.FlagChainID, "", "The network chain ID")
@@ -245,6 +253,7 @@ func txCommand() *cobra.Command {
 		authcmd.GetEncodeCommand(),
 		authcmd.GetDecodeCommand(),
 		GetCmdPioSimulateTx(),
+		GetCmdComposeTx(),
 	)
 
 	cmd.PersistentFlags().String(flags.FlagChainID, "", "The network chain ID")
@@ -320,6 +329,7 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts serverty
 		baseapp.SetIAVLCacheSize(getIAVLCacheSize(appOpts)),
 		baseapp.SetIAVLDisableFastNode(cast.ToBool(appOpts.Get(server.FlagDisableIAVLFastNode))),
 		baseapp.SetChainID(chainID),
+		baseapp.SetMempool(app.NewMempool(cast.ToInt(appOpts.Get(server.FlagMempoolMaxTxs)))),
 	)
 }
 
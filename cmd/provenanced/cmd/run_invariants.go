@@ -0,0 +1,178 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/debug"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+
+	"github.com/provenance-io/provenance/app"
+)
+
+const (
+	FlagInvariantHeight = "height"
+	FlagInvariantModule = "module"
+)
+
+// DebugCmd returns the SDK's debug command with our extra debug commands added to it.
+func DebugCmd() *cobra.Command {
+	cmd := debug.Cmd()
+	cmd.AddCommand(RunInvariantsCmd())
+	return cmd
+}
+
+// RunInvariantsCmd returns the command that runs all registered invariants against existing state.
+func RunInvariantsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "run-invariants",
+		Args:  cobra.NoArgs,
+		Short: "Run the registered module invariants against existing state",
+		Long: `Run the registered module invariants against existing state without committing anything.
+
+The invariants (e.g. marker supply, holds, exchange indexes, metadata referential integrity) are run against
+either the node's database (the default) or an exported genesis file (when --genesis is provided).
+Nothing is ever committed or written to the node's database.
+
+The output has a line for each invariant with whether it passed and how long it took, followed by the
+messages of any that are broken. An error is returned if any invariant is broken.
+
+When using a node's database:
+  The node must not be running.
+  By default, the latest height is used. Use --height to check an earlier (unpruned) height.
+
+When using a genesis file:
+  The genesis file is loaded into an in-memory database first, so it can take a while for large files.`,
+		Example: `$ provenanced debug run-invariants
+$ provenanced debug run-invariants --home /path/to/node/home --height 12345
+$ provenanced debug run-invariants --module exchange --module metadata
+$ provenanced debug run-invariants --genesis exported-genesis.json`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			vp := server.GetServerContextFromCmd(cmd).Viper
+			if err := vp.BindPFlags(cmd.Flags()); err != nil {
+				return err
+			}
+
+			genFile, err := cmd.Flags().GetString(FlagGenesisFile)
+			if err != nil {
+				return err
+			}
+			height, err := cmd.Flags().GetInt64(FlagInvariantHeight)
+			if err != nil {
+				return err
+			}
+			modules, err := cmd.Flags().GetStringSlice(FlagInvariantModule)
+			if err != nil {
+				return err
+			}
+			if len(genFile) > 0 && height != 0 {
+				return fmt.Errorf("cannot provide both --%s and --%s", FlagGenesisFile, FlagInvariantHeight)
+			}
+			if height < 0 {
+				return fmt.Errorf("invalid --%s %d: cannot be negative", FlagInvariantHeight, height)
+			}
+
+			// We want to report broken invariants, not have the crisis module halt when loading the genesis file.
+			vp.Set(crisis.FlagSkipGenesisInvariants, true)
+			logger := log.NewNopLogger()
+			var invApp *app.App
+			if len(genFile) > 0 {
+				tmpHome, err := os.MkdirTemp("", "run-invariants-")
+				if err != nil {
+					return err
+				}
+				defer os.RemoveAll(tmpHome)
+
+				invApp, err = NewUpgradeDryRunAppFromGenesis(logger, genFile, tmpHome, vp)
+				if err != nil {
+					return err
+				}
+			} else {
+				home := vp.GetString(flags.FlagHome)
+				db, err := dbm.NewDB("application", server.GetAppDBBackend(vp), filepath.Join(home, "data"))
+				if err != nil {
+					return err
+				}
+				defer db.Close()
+
+				var opts []func(*baseapp.BaseApp)
+				if chainID := getChainIDFromHome(home); len(chainID) > 0 {
+					opts = append(opts, baseapp.SetChainID(chainID))
+				}
+				invApp = app.New(logger, db, nil, false, vp, opts...)
+				if height == 0 {
+					err = invApp.LoadLatestVersion()
+				} else {
+					err = invApp.LoadVersion(height)
+				}
+				if err != nil {
+					return fmt.Errorf("could not load height %d: %w", height, err)
+				}
+			}
+
+			results, err := invApp.RunInvariants(modules...)
+			if err != nil {
+				return err
+			}
+			WriteInvariantsReport(cmd.OutOrStdout(), invApp.LastBlockHeight(), results)
+
+			var broken []string
+			for _, result := range results {
+				if result.Broken {
+					broken = append(broken, result.FullRoute())
+				}
+			}
+			if len(broken) > 0 {
+				cmd.SilenceUsage = true
+				return fmt.Errorf("%d of %d invariants broken: %s", len(broken), len(results), strings.Join(broken, ", "))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, app.DefaultNodeHome, "The application home directory")
+	cmd.Flags().String(FlagAppDBBackend, "", "The type of database for the application database")
+	cmd.Flags().String(FlagGenesisFile, "", "An exported genesis file to use instead of the node's database")
+	cmd.Flags().Int64(FlagInvariantHeight, 0, "The height of the node's database to check (default: latest)")
+	cmd.Flags().StringSlice(FlagInvariantModule, nil, "Only run the invariants of these modules (repeatable)")
+	return cmd
+}
+
+// WriteInvariantsReport writes a human-readable report of the provided invariant results.
+func WriteInvariantsReport(out io.Writer, height int64, results []app.InvariantResult) {
+	brokenCount := 0
+	fmt.Fprintf(out, "Height: %d\n\n", height)
+	for _, result := range results {
+		status := "ok"
+		if result.Broken {
+			status = "BROKEN"
+			brokenCount++
+		}
+		fmt.Fprintf(out, "%-6s %-50s %s\n", status, result.FullRoute(), result.Duration)
+	}
+	fmt.Fprintf(out, "\n%d invariants run, %d broken.\n", len(results), brokenCount)
+
+	for _, result := range results {
+		if !result.Broken {
+			continue
+		}
+		fmt.Fprintf(out, "\n%s:\n", result.FullRoute())
+		if result.Err != nil {
+			fmt.Fprintf(out, "%v\n", result.Err)
+		} else {
+			fmt.Fprintf(out, "%s", result.Msg)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package cmd_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/provenance-io/provenance/app"
+	provenancecmd "github.com/provenance-io/provenance/cmd/provenanced/cmd"
+)
+
+func TestWriteInvariantsReport(t *testing.T) {
+	tests := []struct {
+		name    string
+		height  int64
+		results []app.InvariantResult
+		exp     string
+	}{
+		{
+			name:   "none",
+			height: 3,
+			exp: `Height: 3
+
+
+0 invariants run, 0 broken.
+`,
+		},
+		{
+			name:   "all ok",
+			height: 55,
+			results: []app.InvariantResult{
+				{Module: "bank", Route: "total-supply", Msg: "bank: total-supply invariant\n", Duration: 2 * time.Second},
+				{Module: "hold", Route: "Hold-Account-Balances", Msg: "hold: Hold-Account-Balances invariant\n", Duration: time.Second},
+			},
+			exp: `Height: 55
+
+ok     bank/total-supply                                  2s
+ok     hold/Hold-Account-Balances                         1s
+
+2 invariants run, 0 broken.
+`,
+		},
+		{
+			name:   "some broken",
+			height: 7,
+			results: []app.InvariantResult{
+				{Module: "bank", Route: "total-supply", Duration: time.Second},
+				{Module: "exchange", Route: "Order-Indexes", Broken: true, Duration: time.Second,
+					Msg: "exchange: Order-Indexes invariant\n1 order checked. 1 problem detected: oops\n"},
+				{Module: "marker", Route: "supply", Broken: true, Duration: time.Second, Err: errors.New("invariant panicked: bad")},
+			},
+			exp: `Height: 7
+
+ok     bank/total-supply                                  1s
+BROKEN exchange/Order-Indexes                             1s
+BROKEN marker/supply                                      1s
+
+3 invariants run, 2 broken.
+
+exchange/Order-Indexes:
+exchange: Order-Indexes invariant
+1 order checked. 1 problem detected: oops
+
+marker/supply:
+invariant panicked: bad
+`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var out bytes.Buffer
+			provenancecmd.WriteInvariantsReport(&out, tc.height, tc.results)
+			assert.Equal(t, tc.exp, out.String(), "WriteInvariantsReport output")
+		})
+	}
+}
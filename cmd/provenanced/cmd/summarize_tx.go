@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+
+	"github.com/provenance-io/provenance/internal/txsummary"
+)
+
+// FlagTxFile is the flag for providing a file with a tx to summarize.
+const FlagTxFile = "tx-file"
+
+// GetCmdSummarizeTx returns the command that gets a human-readable summary of a tx.
+func GetCmdSummarizeTx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "summarize-tx {<hash>|--tx-file <file>}",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Get a human-readable summary of a tx",
+		Long: `Get a human-readable summary of a tx.
+
+The tx can either be one that's already in a block (identified by its hash),
+or a tx in a JSON file, e.g. one generated using --generate-only.
+
+The summary describes each message and includes the names bound to the addresses involved,
+the display metadata of the denoms involved, and the net asset values of any markers involved.`,
+		Example: `$ provenanced query summarize-tx 0E1A2B...
+$ provenanced query summarize-tx --tx-file unsigned.json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req, err := makeSummarizeTxRequest(clientCtx, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			queryClient := txsummary.NewQueryClient(clientCtx)
+			res, err := queryClient.SummarizeTx(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagTxFile, "", "A JSON file with the tx to summarize")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// makeSummarizeTxRequest creates the request for the summarize-tx command from its args and flags.
+func makeSummarizeTxRequest(clientCtx client.Context, cmd *cobra.Command, args []string) (*txsummary.SummarizeTxRequest, error) {
+	txFile, err := cmd.Flags().GetString(FlagTxFile)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case len(args) > 0 && len(txFile) > 0:
+		return nil, fmt.Errorf("cannot provide both a hash and --%s", FlagTxFile)
+	case len(args) > 0:
+		return &txsummary.SummarizeTxRequest{Hash: args[0]}, nil
+	case len(txFile) == 0:
+		return nil, fmt.Errorf("either a hash or --%s must be provided", FlagTxFile)
+	}
+
+	tx, err := authclient.ReadTxFromFile(clientCtx, txFile)
+	if err != nil {
+		return nil, fmt.Errorf("could not read tx from %s: %w", txFile, err)
+	}
+	txBz, err := clientCtx.TxConfig.TxEncoder()(tx)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode tx: %w", err)
+	}
+	return &txsummary.SummarizeTxRequest{TxBytes: txBz}, nil
+}
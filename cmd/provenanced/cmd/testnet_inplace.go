@@ -0,0 +1,229 @@
+package cmd
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/app"
+)
+
+const (
+	FlagVotingPeriod   = "voting-period"
+	FlagValidatorsFile = "validators-file"
+	FlagFundAccount    = "fund-account"
+	FlagFastForward    = "fast-forward"
+)
+
+// InPlaceTestnetCmd returns the in-place-testnet command. It's the SDK's command,
+// but with some extra options for making state changes that are handy in a testnet.
+func InPlaceTestnetCmd() *cobra.Command {
+	cmd := server.InPlaceTestnetCreator(newTestnetApp)
+	addModuleInitFlags(cmd)
+	cmd.Example = `$ provenanced in-place-testnet testing pb1...
+$ provenanced in-place-testnet testing pb1... --voting-period 5m --fast-forward 72h
+$ provenanced in-place-testnet testing pb1... --validators-file vals.json --fund-account pb1...=1000000000nhash`
+	cmd.Long += `
+Additional state changes can be made using these flags:
+  --voting-period    Sets the gov voting period (and expedited voting period, if needed).
+  --validators-file  Adds the validators in the file alongside the local validator.
+                     The file must contain a JSON list of objects with these fields:
+                       operator_address: The bech32 account address of the validator's operator.
+                       consensus_pubkey: The base64 encoded ed25519 consensus public key.
+                       moniker:          (optional) The validator's moniker.
+                       tokens:           (optional) The amount of the bond denom to give it (default: 1 power).
+  --fund-account     Mints and sends funds to an account. Format: <address>=<coins>. Can be provided multiple times.
+                     Note: No marker checks are done, so funding with a marker's denom makes its supply inaccurate.
+  --fast-forward     Expires attributes and queues time-based triggers as if it were this much later than now.
+`
+	cmd.Flags().Duration(FlagVotingPeriod, 0, "The new gov voting period, e.g. 5m")
+	cmd.Flags().String(FlagValidatorsFile, "", "A JSON file with validators to add alongside the local validator")
+	cmd.Flags().StringArray(FlagFundAccount, nil, "An account to fund, as <address>=<coins>, e.g. pb1...=1000nhash (repeatable)")
+	cmd.Flags().Duration(FlagFastForward, 0, "How far past now to fast-forward time-based state, e.g. 72h")
+	return cmd
+}
+
+// newTestnetApp creates a new app and updates its state based on the in-place-testnet command's options.
+func newTestnetApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts servertypes.AppOptions) servertypes.Application {
+	baseApp := newApp(logger, db, traceStore, appOpts)
+	testApp, ok := baseApp.(*app.App)
+	if !ok {
+		panic(fmt.Errorf("app created for testnet is %T, expected %T", baseApp, &app.App{}))
+	}
+
+	opts, err := getTestnetOptions(appOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	rv, err := app.InitAppForTestnet(testApp, *opts)
+	if err != nil {
+		panic(fmt.Errorf("could not initialize app for testnet: %w", err))
+	}
+	return rv
+}
+
+// getTestnetOptions gets the options for the in-place-testnet from the provided app options.
+func getTestnetOptions(appOpts servertypes.AppOptions) (*app.TestnetOptions, error) {
+	opts := &app.TestnetOptions{}
+	var ok bool
+
+	opts.NewValAddr, ok = appOpts.Get(server.KeyNewValAddr).(cmtbytes.HexBytes)
+	if !ok {
+		return nil, errors.New("new validator address not set")
+	}
+	opts.NewValPubKey, ok = appOpts.Get(server.KeyUserPubKey).(cmtcrypto.PubKey)
+	if !ok {
+		return nil, errors.New("new validator pubkey not set")
+	}
+	opts.NewOperatorAddress, ok = appOpts.Get(server.KeyNewOpAddr).(string)
+	if !ok {
+		return nil, errors.New("new operator address not set")
+	}
+	opts.UpgradeToTrigger = cast.ToString(appOpts.Get(server.KeyTriggerTestnetUpgrade))
+
+	var err error
+	opts.VotingPeriod, err = cast.ToDurationE(appOpts.Get(FlagVotingPeriod))
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s: %w", FlagVotingPeriod, err)
+	}
+	if opts.VotingPeriod < 0 {
+		return nil, fmt.Errorf("invalid --%s %s: cannot be negative", FlagVotingPeriod, opts.VotingPeriod)
+	}
+
+	if valFile := cast.ToString(appOpts.Get(FlagValidatorsFile)); len(valFile) > 0 {
+		opts.Validators, err = ReadTestnetValidatorsFile(valFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opts.FundAccounts, err = ParseFundAccounts(cast.ToStringSlice(appOpts.Get(FlagFundAccount)))
+	if err != nil {
+		return nil, err
+	}
+
+	fastForward, err := cast.ToDurationE(appOpts.Get(FlagFastForward))
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s: %w", FlagFastForward, err)
+	}
+	if fastForward < 0 {
+		return nil, fmt.Errorf("invalid --%s %s: cannot be negative", FlagFastForward, fastForward)
+	}
+	if fastForward > 0 {
+		opts.FastForwardTo = time.Now().Add(fastForward)
+	}
+
+	return opts, nil
+}
+
+// testnetValidatorEntry is the structure of an entry in a testnet validators file.
+type testnetValidatorEntry struct {
+	OperatorAddress string `json:"operator_address"`
+	ConsensusPubKey string `json:"consensus_pubkey"`
+	Moniker         string `json:"moniker,omitempty"`
+	Tokens          string `json:"tokens,omitempty"`
+}
+
+// ReadTestnetValidatorsFile reads the provided file and converts the validators in it.
+func ReadTestnetValidatorsFile(filename string) ([]app.TestnetValidator, error) {
+	bz, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("could not read validators file: %w", err)
+	}
+	return ParseTestnetValidators(bz)
+}
+
+// ParseTestnetValidators parses the contents of a testnet validators file.
+func ParseTestnetValidators(bz []byte) ([]app.TestnetValidator, error) {
+	var entries []testnetValidatorEntry
+	if err := json.Unmarshal(bz, &entries); err != nil {
+		return nil, fmt.Errorf("could not parse validators file: %w", err)
+	}
+
+	rv := make([]app.TestnetValidator, len(entries))
+	for i, entry := range entries {
+		if _, err := sdk.AccAddressFromBech32(entry.OperatorAddress); err != nil {
+			return nil, fmt.Errorf("validator[%d]: invalid operator_address %q: %w", i, entry.OperatorAddress, err)
+		}
+
+		keyBz, err := base64.StdEncoding.DecodeString(entry.ConsensusPubKey)
+		if err != nil {
+			return nil, fmt.Errorf("validator[%d]: invalid consensus_pubkey %q: %w", i, entry.ConsensusPubKey, err)
+		}
+		if len(keyBz) != ed25519.PubKeySize {
+			return nil, fmt.Errorf("validator[%d]: invalid consensus_pubkey %q: expected %d bytes, got %d",
+				i, entry.ConsensusPubKey, ed25519.PubKeySize, len(keyBz))
+		}
+
+		tokens := app.DefaultPowerReduction
+		if len(entry.Tokens) > 0 {
+			var ok bool
+			tokens, ok = sdkmath.NewIntFromString(entry.Tokens)
+			if !ok || !tokens.IsPositive() {
+				return nil, fmt.Errorf("validator[%d]: invalid tokens %q: must be a positive integer", i, entry.Tokens)
+			}
+		}
+
+		moniker := entry.Moniker
+		if len(moniker) == 0 {
+			moniker = fmt.Sprintf("Testnet Validator %d", i+1)
+		}
+
+		rv[i] = app.TestnetValidator{
+			OperatorAddress: entry.OperatorAddress,
+			ConsensusPubKey: &ed25519.PubKey{Key: keyBz},
+			Moniker:         moniker,
+			Tokens:          tokens,
+		}
+	}
+	return rv, nil
+}
+
+// ParseFundAccounts parses each of the provided <address>=<coins> entries into a balance.
+func ParseFundAccounts(entries []string) ([]banktypes.Balance, error) {
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	rv := make([]banktypes.Balance, len(entries))
+	for i, entry := range entries {
+		addr, coinsStr, found := strings.Cut(entry, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid --%s %q: expected format <address>=<coins>", FlagFundAccount, entry)
+		}
+		addr = strings.TrimSpace(addr)
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return nil, fmt.Errorf("invalid --%s %q: invalid address: %w", FlagFundAccount, entry, err)
+		}
+		coins, err := sdk.ParseCoinsNormalized(coinsStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --%s %q: invalid coins: %w", FlagFundAccount, entry, err)
+		}
+		if coins.IsZero() {
+			return nil, fmt.Errorf("invalid --%s %q: no coins provided", FlagFundAccount, entry)
+		}
+		rv[i] = banktypes.Balance{Address: addr, Coins: coins}
+	}
+	return rv, nil
+}
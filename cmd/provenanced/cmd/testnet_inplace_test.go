@@ -0,0 +1,148 @@
+package cmd_test
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/app"
+	provenancecmd "github.com/provenance-io/provenance/cmd/provenanced/cmd"
+	"github.com/provenance-io/provenance/testutil/assertions"
+	"github.com/provenance-io/provenance/testutil/fixtures"
+)
+
+func TestParseFundAccounts(t *testing.T) {
+	origCache := sdk.IsAddrCacheEnabled()
+	defer sdk.SetAddrCacheEnabled(origCache)
+	sdk.SetAddrCacheEnabled(false)
+
+	addr1 := sdk.AccAddress("addr1_______________").String()
+	addr2 := sdk.AccAddress("addr2_______________").String()
+
+	tests := []struct {
+		name    string
+		entries []string
+		exp     []banktypes.Balance
+		expErr  []string
+	}{
+		{
+			name:    "nil",
+			entries: nil,
+			exp:     nil,
+		},
+		{
+			name:    "two entries",
+			entries: []string{addr1 + "=10nhash,5apple", addr2 + "=3plum"},
+			exp: []banktypes.Balance{
+				{Address: addr1, Coins: fixtures.Coins("5apple,10nhash")},
+				{Address: addr2, Coins: fixtures.Coins("3plum")},
+			},
+		},
+		{
+			name:    "no equals",
+			entries: []string{addr1},
+			expErr:  []string{"invalid --fund-account \"" + addr1 + "\": expected format <address>=<coins>"},
+		},
+		{
+			name:    "bad address",
+			entries: []string{"notanaddr=10nhash"},
+			expErr:  []string{"invalid --fund-account \"notanaddr=10nhash\": invalid address"},
+		},
+		{
+			name:    "bad coins",
+			entries: []string{addr1 + "=nhash"},
+			expErr:  []string{"invalid coins"},
+		},
+		{
+			name:    "no coins",
+			entries: []string{addr1 + "="},
+			expErr:  []string{"no coins provided"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := provenancecmd.ParseFundAccounts(tc.entries)
+			assertions.AssertErrorContents(t, err, tc.expErr, "ParseFundAccounts error")
+			assert.Equal(t, tc.exp, actual, "ParseFundAccounts result")
+		})
+	}
+}
+
+func TestParseTestnetValidators(t *testing.T) {
+	operator := sdk.AccAddress("operator____________").String()
+	pubKey := ed25519.GenPrivKey().PubKey().(*ed25519.PubKey)
+	pubKeyStr := base64.StdEncoding.EncodeToString(pubKey.Key)
+
+	tests := []struct {
+		name   string
+		json   string
+		exp    []app.TestnetValidator
+		expErr []string
+	}{
+		{
+			name: "defaults",
+			json: `[{"operator_address":"` + operator + `","consensus_pubkey":"` + pubKeyStr + `"}]`,
+			exp: []app.TestnetValidator{{
+				OperatorAddress: operator,
+				ConsensusPubKey: pubKey,
+				Moniker:         "Testnet Validator 1",
+				Tokens:          app.DefaultPowerReduction,
+			}},
+		},
+		{
+			name: "all fields",
+			json: `[{"operator_address":"` + operator + `","consensus_pubkey":"` + pubKeyStr + `","moniker":"other","tokens":"5000000000"}]`,
+			exp: []app.TestnetValidator{{
+				OperatorAddress: operator,
+				ConsensusPubKey: pubKey,
+				Moniker:         "other",
+				Tokens:          sdkmath.NewInt(5_000_000_000),
+			}},
+		},
+		{
+			name:   "not json",
+			json:   `not json`,
+			expErr: []string{"could not parse validators file"},
+		},
+		{
+			name:   "bad operator",
+			json:   `[{"operator_address":"bad","consensus_pubkey":"` + pubKeyStr + `"}]`,
+			expErr: []string{"validator[0]: invalid operator_address \"bad\""},
+		},
+		{
+			name:   "pubkey wrong length",
+			json:   `[{"operator_address":"` + operator + `","consensus_pubkey":"AAAA"}]`,
+			expErr: []string{"validator[0]: invalid consensus_pubkey \"AAAA\": expected 32 bytes, got 3"},
+		},
+		{
+			name:   "zero tokens",
+			json:   `[{"operator_address":"` + operator + `","consensus_pubkey":"` + pubKeyStr + `","tokens":"0"}]`,
+			expErr: []string{"validator[0]: invalid tokens \"0\": must be a positive integer"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := provenancecmd.ParseTestnetValidators([]byte(tc.json))
+			assertions.AssertErrorContents(t, err, tc.expErr, "ParseTestnetValidators error")
+			if len(tc.expErr) == 0 {
+				require.Len(t, actual, len(tc.exp), "ParseTestnetValidators result")
+				for i := range tc.exp {
+					assert.Equal(t, tc.exp[i].OperatorAddress, actual[i].OperatorAddress, "[%d].OperatorAddress", i)
+					assert.True(t, tc.exp[i].ConsensusPubKey.Equals(actual[i].ConsensusPubKey), "[%d].ConsensusPubKey", i)
+					assert.Equal(t, tc.exp[i].Moniker, actual[i].Moniker, "[%d].Moniker", i)
+					assert.Equal(t, tc.exp[i].Tokens.String(), actual[i].Tokens.String(), "[%d].Tokens", i)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,252 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"cosmossdk.io/log"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"github.com/provenance-io/provenance/app"
+)
+
+const (
+	FlagGenesisFile = "genesis"
+	FlagBlockTime   = "block-time"
+)
+
+// UpgradeDryRunCmd returns the command that runs an upgrade against existing state without committing anything.
+func UpgradeDryRunCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade-dry-run <upgrade name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Run an upgrade against existing state without committing anything",
+		Long: `Run an upgrade against existing state without committing anything.
+
+The named upgrade's handler (including its module migrations) is run on top of the latest state of either
+the node's database (the default) or an exported genesis file (when --genesis is provided).
+Nothing is ever committed or written to the node's database.
+
+The output contains how long the upgrade took, the timing of each message it logged, the module versions
+that changed, how the size of each store changed, and the error (if any) that the upgrade returned.
+
+When using a node's database:
+  The node must not be running.
+  Stores added by the upgrade are mounted, but stores it deletes or renames are not changed.
+
+When using a genesis file:
+  The genesis file is loaded into an in-memory database first, so it can take a while for large files.
+  All modules are initialized with their current versions, so module migrations will not have anything to do.`,
+		Example: `$ provenanced upgrade-dry-run yellow
+$ provenanced upgrade-dry-run yellow --home /path/to/node/home
+$ provenanced upgrade-dry-run yellow --genesis exported-genesis.json --block-time 2025-01-01T00:00:00Z`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			vp := server.GetServerContextFromCmd(cmd).Viper
+			if err := vp.BindPFlags(cmd.Flags()); err != nil {
+				return err
+			}
+
+			name := args[0]
+			genFile, err := cmd.Flags().GetString(FlagGenesisFile)
+			if err != nil {
+				return err
+			}
+			blockTimeStr, err := cmd.Flags().GetString(FlagBlockTime)
+			if err != nil {
+				return err
+			}
+			blockTime := time.Now().UTC()
+			if len(blockTimeStr) > 0 {
+				blockTime, err = time.Parse(time.RFC3339Nano, blockTimeStr)
+				if err != nil {
+					return fmt.Errorf("invalid --%s %q: %w", FlagBlockTime, blockTimeStr, err)
+				}
+			}
+
+			logger := log.NewNopLogger()
+			var dryRunApp *app.App
+			if len(genFile) > 0 {
+				tmpHome, err := os.MkdirTemp("", "upgrade-dry-run-")
+				if err != nil {
+					return err
+				}
+				defer os.RemoveAll(tmpHome)
+
+				dryRunApp, err = NewUpgradeDryRunAppFromGenesis(logger, genFile, tmpHome, vp)
+				if err != nil {
+					return err
+				}
+			} else {
+				loader, err := app.GetUpgradeDryRunStoreLoader(name)
+				if err != nil {
+					return err
+				}
+
+				home := vp.GetString(flags.FlagHome)
+				db, err := dbm.NewDB("application", server.GetAppDBBackend(vp), filepath.Join(home, "data"))
+				if err != nil {
+					return err
+				}
+				defer db.Close()
+
+				var opts []func(*baseapp.BaseApp)
+				if chainID := getChainIDFromHome(home); len(chainID) > 0 {
+					opts = append(opts, baseapp.SetChainID(chainID))
+				}
+				dryRunApp = app.New(logger, db, nil, false, vp, opts...)
+				dryRunApp.SetStoreLoader(loader)
+				if err = dryRunApp.LoadLatestVersion(); err != nil {
+					return fmt.Errorf("could not load latest version: %w", err)
+				}
+			}
+
+			result, err := dryRunApp.DryRunUpgrade(name, blockTime)
+			if err != nil {
+				return err
+			}
+			WriteUpgradeDryRunReport(cmd.OutOrStdout(), result)
+			if result.Err != nil {
+				cmd.SilenceUsage = true
+				return fmt.Errorf("upgrade %q failed: %w", name, result.Err)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, app.DefaultNodeHome, "The application home directory")
+	cmd.Flags().String(FlagAppDBBackend, "", "The type of database for the application database")
+	cmd.Flags().String(FlagGenesisFile, "", "An exported genesis file to use instead of the node's database")
+	cmd.Flags().String(FlagBlockTime, "", "The block time (RFC3339) to run the upgrade with (default: now)")
+	return cmd
+}
+
+// getChainIDFromHome gets the chain id from the home's genesis file, or returns an empty string if it can't.
+func getChainIDFromHome(home string) string {
+	reader, err := os.Open(filepath.Join(home, "config", "genesis.json"))
+	if err != nil {
+		return ""
+	}
+	defer reader.Close()
+	chainID, err := genutiltypes.ParseChainIDFromGenesis(reader)
+	if err != nil {
+		return ""
+	}
+	return chainID
+}
+
+// NewUpgradeDryRunAppFromGenesis creates an app with an in-memory database that has the state of the provided
+// genesis file committed as its first block. The provided home is used for the app's data files (e.g. wasm).
+func NewUpgradeDryRunAppFromGenesis(logger log.Logger, genFile, home string, vp *viper.Viper) (*app.App, error) {
+	appGen, err := genutiltypes.AppGenesisFromFile(genFile)
+	if err != nil {
+		return nil, fmt.Errorf("could not read genesis file: %w", err)
+	}
+
+	vp.Set(flags.FlagHome, home)
+	rv := app.New(logger, dbm.NewMemDB(), nil, true, vp, baseapp.SetChainID(appGen.ChainID))
+
+	var consParams *cmtproto.ConsensusParams
+	if appGen.Consensus != nil && appGen.Consensus.Params != nil {
+		params := appGen.Consensus.Params.ToProto()
+		consParams = &params
+	}
+	height := appGen.InitialHeight
+	if height < 1 {
+		height = 1
+	}
+
+	_, err = rv.InitChain(&abci.RequestInitChain{
+		Time:            appGen.GenesisTime,
+		ChainId:         appGen.ChainID,
+		ConsensusParams: consParams,
+		AppStateBytes:   appGen.AppState,
+		InitialHeight:   height,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not initialize chain from genesis: %w", err)
+	}
+	_, err = rv.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height, Time: appGen.GenesisTime})
+	if err != nil {
+		return nil, fmt.Errorf("could not finalize genesis block: %w", err)
+	}
+	if _, err = rv.Commit(); err != nil {
+		return nil, fmt.Errorf("could not commit genesis block: %w", err)
+	}
+	return rv, nil
+}
+
+// WriteUpgradeDryRunReport writes a human-readable report of the provided upgrade dry run result.
+func WriteUpgradeDryRunReport(out io.Writer, result *app.UpgradeDryRunResult) {
+	fmt.Fprintf(out, "Upgrade:  %s\n", result.Name)
+	fmt.Fprintf(out, "Height:   %d\n", result.Height)
+	fmt.Fprintf(out, "Duration: %s\n", result.Duration)
+	if result.Err != nil {
+		fmt.Fprintf(out, "Result:   FAILED: %v\n", result.Err)
+	} else {
+		fmt.Fprintf(out, "Result:   success\n")
+	}
+
+	fmt.Fprintf(out, "\nSteps:\n")
+	if len(result.Steps) == 0 {
+		fmt.Fprintf(out, "  (none)\n")
+	}
+	for _, step := range result.Steps {
+		fmt.Fprintf(out, "  %12s %s %s\n", "+"+step.Elapsed.String(), step.Level, step.Msg)
+	}
+
+	fmt.Fprintf(out, "\nModule versions:\n")
+	changed := 0
+	if result.ToVersions != nil {
+		modules := make([]string, 0, len(result.ToVersions))
+		for module := range result.ToVersions {
+			modules = append(modules, module)
+		}
+		sort.Strings(modules)
+		for _, module := range modules {
+			from, found := result.FromVersions[module]
+			to := result.ToVersions[module]
+			switch {
+			case !found:
+				fmt.Fprintf(out, "  %s: (new) -> %d\n", module, to)
+			case from != to:
+				fmt.Fprintf(out, "  %s: %d -> %d\n", module, from, to)
+			default:
+				continue
+			}
+			changed++
+		}
+	}
+	if changed == 0 {
+		fmt.Fprintf(out, "  (no changes)\n")
+	}
+
+	fmt.Fprintf(out, "\nStore sizes:\n")
+	if len(result.StoreDeltas) == 0 {
+		fmt.Fprintf(out, "  (no changes)\n")
+		return
+	}
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintf(w, "store\tentries before\tentries after\tentries delta\tbytes before\tbytes after\tbytes delta\t\n")
+	for _, delta := range result.StoreDeltas {
+		fmt.Fprintf(w, "%s\t%d\t%d\t%+d\t%d\t%d\t%+d\t\n", delta.Store,
+			delta.EntriesBefore, delta.EntriesAfter, delta.EntriesAfter-delta.EntriesBefore,
+			delta.BytesBefore, delta.BytesAfter, delta.BytesAfter-delta.BytesBefore)
+	}
+	w.Flush()
+}
@@ -0,0 +1,92 @@
+package cmd_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/provenance-io/provenance/app"
+	provenancecmd "github.com/provenance-io/provenance/cmd/provenanced/cmd"
+)
+
+func TestWriteUpgradeDryRunReport(t *testing.T) {
+	tests := []struct {
+		name   string
+		result *app.UpgradeDryRunResult
+		exp    string
+	}{
+		{
+			name: "success",
+			result: &app.UpgradeDryRunResult{
+				Name:     "yellow",
+				Height:   55,
+				Duration: 3 * time.Second,
+				Steps: []app.UpgradeDryRunStep{
+					{Elapsed: 0, Level: "INF", Msg: "Starting module migrations."},
+					{Elapsed: 2500 * time.Millisecond, Level: "INF", Msg: "Module migrations completed."},
+				},
+				FromVersions: module.VersionMap{"bank": 4, "marker": 1, "name": 3},
+				ToVersions:   module.VersionMap{"bank": 4, "marker": 2, "name": 3, "packetforward": 1},
+				StoreDeltas: []app.StoreSizeDelta{
+					{Store: "marker", EntriesBefore: 10, EntriesAfter: 12, BytesBefore: 500, BytesAfter: 620},
+					{Store: "staking", EntriesBefore: 100, EntriesAfter: 90, BytesBefore: 10000, BytesAfter: 9000},
+				},
+			},
+			exp: `Upgrade:  yellow
+Height:   55
+Duration: 3s
+Result:   success
+
+Steps:
+           +0s INF Starting module migrations.
+         +2.5s INF Module migrations completed.
+
+Module versions:
+  marker: 1 -> 2
+  packetforward: (new) -> 1
+
+Store sizes:
+    store  entries before  entries after  entries delta  bytes before  bytes after  bytes delta
+   marker              10             12             +2           500          620         +120
+  staking             100             90            -10         10000         9000        -1000
+`,
+		},
+		{
+			name: "failed",
+			result: &app.UpgradeDryRunResult{
+				Name:         "yellow",
+				Height:       55,
+				Duration:     time.Second,
+				FromVersions: module.VersionMap{"bank": 4},
+				Err:          errors.New("the upgrade is broken"),
+			},
+			exp: `Upgrade:  yellow
+Height:   55
+Duration: 1s
+Result:   FAILED: the upgrade is broken
+
+Steps:
+  (none)
+
+Module versions:
+  (no changes)
+
+Store sizes:
+  (no changes)
+`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var out bytes.Buffer
+			provenancecmd.WriteUpgradeDryRunReport(&out, tc.result)
+			assert.Equal(t, tc.exp, out.String(), "WriteUpgradeDryRunReport output")
+		})
+	}
+}
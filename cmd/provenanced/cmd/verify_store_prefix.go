@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/rootmulti"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+
+	"github.com/provenance-io/provenance/internal/storemigrate"
+)
+
+// VerifyStorePrefixCmd returns the command that outputs the verification hash of a store prefix.
+func VerifyStorePrefixCmd(appCreator servertypes.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-store-prefix <store> [<prefix>] [<expected hash>]",
+		Args:  cobra.RangeArgs(1, 3),
+		Short: "Output the verification hash of the entries under a prefix of a store in the node's database",
+		Long: `Output the verification hash of the entries under a prefix of a store in the node's database.
+
+This is the same hash that's logged when a store migration (e.g. during an upgrade) moves entries to a new store or prefix.
+The hash only uses the part of each key after the prefix, so it can be compared before and after a move.
+
+The <prefix> is hex encoded. If it's omitted (or empty), the whole store is hashed.
+If an <expected hash> is provided, an error is returned if it doesn't match.
+
+The node must not be running.`,
+		Example: `$ provenanced verify-store-prefix exchange
+$ provenanced verify-store-prefix newmodule 02
+$ provenanced verify-store-prefix newmodule 02 3b5d...e1f0`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			vp := server.GetServerContextFromCmd(cmd).Viper
+			if err := vp.BindPFlags(cmd.Flags()); err != nil {
+				return err
+			}
+
+			var prefix []byte
+			if len(args) > 1 {
+				var err error
+				prefix, err = hex.DecodeString(args[1])
+				if err != nil {
+					return fmt.Errorf("invalid prefix %q: %w", args[1], err)
+				}
+			}
+			expHash := ""
+			if len(args) > 2 {
+				expHash = args[2]
+			}
+
+			home := vp.GetString(flags.FlagHome)
+			if len(home) == 0 {
+				home = defaultNodeHome
+			}
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(vp), filepath.Join(home, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			logger := log.NewLogger(cmd.OutOrStdout())
+			app := appCreator(logger, db, nil, vp)
+			rms, ok := app.CommitMultiStore().(*rootmulti.Store)
+			if !ok {
+				return fmt.Errorf("cannot verify store prefix of a %T, only a %T", app.CommitMultiStore(), &rootmulti.Store{})
+			}
+
+			return VerifyStorePrefix(rms, args[0], prefix, expHash, cmd.OutOrStdout())
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(FlagAppDBBackend, "", "The type of database for the application database")
+	return cmd
+}
+
+// VerifyStorePrefix outputs the verification hash and number of entries under a prefix of the named store.
+// If an expected hash is provided, an error is returned if the hash is different.
+func VerifyStorePrefix(rms *rootmulti.Store, name string, prefix []byte, expHash string, out io.Writer) error {
+	key, found := rms.StoreKeysByName()[name]
+	if !found {
+		return fmt.Errorf("unknown store %q", name)
+	}
+
+	hash, count := storemigrate.HashPrefix(rms.GetKVStore(key), prefix)
+	fmt.Fprintf(out, "%s/%X: %d entries, hash: %s\n", name, prefix, count, hash)
+
+	if len(expHash) > 0 && !strings.EqualFold(expHash, hash) {
+		return fmt.Errorf("hash of %s/%X does not match: expected %s, actual %s", name, prefix, expHash, hash)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package cmd_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	provenancecmd "github.com/provenance-io/provenance/cmd/provenanced/cmd"
+	"github.com/provenance-io/provenance/internal/storemigrate"
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+func TestVerifyStorePrefix(t *testing.T) {
+	rms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	keys := storetypes.NewKVStoreKeys("old", "new")
+	for _, key := range keys {
+		rms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, rms.LoadLatestVersion(), "LoadLatestVersion")
+
+	rms.GetKVStore(keys["old"]).Set([]byte{1, 'a'}, []byte("one"))
+	rms.GetKVStore(keys["old"]).Set([]byte{1, 'b'}, []byte("two"))
+	rms.GetKVStore(keys["new"]).Set([]byte{2, 'a'}, []byte("one"))
+	rms.GetKVStore(keys["new"]).Set([]byte{2, 'b'}, []byte("two"))
+	rms.Commit()
+
+	expHash, _ := storemigrate.HashPrefix(rms.GetKVStore(keys["old"]), []byte{1})
+
+	var out bytes.Buffer
+	err := provenancecmd.VerifyStorePrefix(rms, "new", []byte{2}, expHash, &out)
+	require.NoError(t, err, "VerifyStorePrefix with matching hash")
+	assert.Equal(t, "new/02: 2 entries, hash: "+expHash+"\n", out.String(), "output")
+
+	out.Reset()
+	err = provenancecmd.VerifyStorePrefix(rms, "new", nil, expHash, &out)
+	assertions.AssertErrorContents(t, err, []string{"hash of new/ does not match: expected " + expHash}, "VerifyStorePrefix with different hash")
+	assert.Contains(t, out.String(), "new/: 2 entries, hash: ", "output")
+
+	err = provenancecmd.VerifyStorePrefix(rms, "nope", nil, "", &bytes.Buffer{})
+	assertions.AssertErrorValue(t, err, `unknown store "nope"`, "VerifyStorePrefix with unknown store")
+}
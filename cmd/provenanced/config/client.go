@@ -4,10 +4,13 @@ import (
 	"errors"
 	"fmt"
 
+	txsigning "cosmossdk.io/x/tx/signing"
+
 	"github.com/cosmos/cosmos-sdk/client"
-	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/tx"
 	txmodule "github.com/cosmos/cosmos-sdk/x/auth/tx/config"
+
+	"github.com/provenance-io/provenance/internal/textual"
 )
 
 // This is similar to the content in the SDK's client/config/config.go file.
@@ -115,12 +118,16 @@ func ApplyClientConfigToContext(ctx client.Context, config *ClientConfig) (clien
 	// This needs to go after the client has been set in the context.
 	// That client is needed for SIGN_MODE_TEXTUAL.
 	// This sign mode is only available if the client is online.
+	// It is added as a custom sign mode so that provenance msgs are rendered the same way the chain renders them.
 	if !ctx.Offline {
-		enabledSignModes := tx.DefaultSignModes
-		enabledSignModes = append(enabledSignModes, signing.SignMode_SIGN_MODE_TEXTUAL)
+		var textualHandler txsigning.SignModeHandler
+		textualHandler, err = textual.NewSignModeHandler(txmodule.NewGRPCCoinMetadataQueryFn(ctx), ctx.InterfaceRegistry)
+		if err != nil {
+			return ctx, err
+		}
 		txConfigOpts := tx.ConfigOptions{
-			EnabledSignModes:           enabledSignModes,
-			TextualCoinMetadataQueryFn: txmodule.NewGRPCCoinMetadataQueryFn(ctx),
+			EnabledSignModes: tx.DefaultSignModes,
+			CustomSignModes:  []txsigning.SignModeHandler{textualHandler},
 		}
 
 		var txConfig client.TxConfig
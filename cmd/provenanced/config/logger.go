@@ -0,0 +1,118 @@
+package config
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/rs/zerolog"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+// LogModuleLevelsFlag is the flag (and config key) for setting the log level of specific modules.
+// The value is a comma separated list of <module>=<level> entries, e.g. "exchange=debug,marker=info".
+const LogModuleLevelsFlag = "log_module_levels"
+
+// defaultLogFilterKey is the key used in a log level filter for the level of everything not specifically listed.
+const defaultLogFilterKey = "*"
+
+// ParseLogModuleLevels parses a <module>=<level> list into a map of logger module key to level.
+// A module name without a "/" is assumed to be one of our modules and is given the "x/" prefix used by the keepers.
+func ParseLogModuleLevels(value string) (map[string]zerolog.Level, error) {
+	rv := make(map[string]zerolog.Level)
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if len(entry) == 0 {
+			continue
+		}
+		parts := strings.Split(entry, "=")
+		if len(parts) != 2 || len(strings.TrimSpace(parts[0])) == 0 || len(strings.TrimSpace(parts[1])) == 0 {
+			return nil, fmt.Errorf("invalid %s entry %q: expected format <module>=<level>", LogModuleLevelsFlag, entry)
+		}
+		module := strings.TrimSpace(parts[0])
+		if !strings.Contains(module, "/") {
+			module = "x/" + module
+		}
+		if _, known := rv[module]; known {
+			return nil, fmt.Errorf("invalid %s entry %q: duplicate module %q", LogModuleLevelsFlag, entry, module)
+		}
+		lvl, err := zerolog.ParseLevel(strings.TrimSpace(parts[1]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s entry %q: %w", LogModuleLevelsFlag, entry, err)
+		}
+		rv[module] = lvl
+	}
+	return rv, nil
+}
+
+// BuildLogLevelFilter combines the log_level and log_module_levels values into a single filter string
+// that can be provided to log.ParseLogLevel, e.g. "x/exchange:debug,*:info".
+// If the log level is already a filter (e.g. "consensus:debug,*:error"), the module levels are added to it.
+// A module cannot be given a level in both.
+func BuildLogLevelFilter(logLevel, moduleLevels string) (string, error) {
+	levels, err := ParseLogModuleLevels(moduleLevels)
+	if err != nil {
+		return "", err
+	}
+
+	var entries []string
+	switch {
+	case len(logLevel) == 0:
+		entries = append(entries, defaultLogFilterKey+":"+zerolog.InfoLevel.String())
+	case !strings.Contains(logLevel, ":"):
+		entries = append(entries, defaultLogFilterKey+":"+logLevel)
+	default:
+		for _, entry := range strings.Split(logLevel, ",") {
+			module, _, _ := strings.Cut(entry, ":")
+			if _, dup := levels[module]; dup {
+				return "", fmt.Errorf("module %q has a level in both %s and %s", module, flags.FlagLogLevel, LogModuleLevelsFlag)
+			}
+			entries = append(entries, entry)
+		}
+	}
+
+	modules := make([]string, 0, len(levels))
+	for module := range levels {
+		modules = append(modules, module)
+	}
+	sort.Strings(modules)
+	for _, module := range modules {
+		entries = append(entries, module+":"+levels[module].String())
+	}
+
+	return strings.Join(entries, ","), nil
+}
+
+// CreateLogger creates the logger for the provided server context.
+// It's the same as the SDK's server.CreateSDKLogger except it also applies any log_module_levels.
+func CreateLogger(serverCtx *server.Context, out io.Writer) (log.Logger, error) {
+	moduleLevels := serverCtx.Viper.GetString(LogModuleLevelsFlag)
+	if len(strings.TrimSpace(moduleLevels)) == 0 {
+		return server.CreateSDKLogger(serverCtx, out)
+	}
+
+	filterStr, err := BuildLogLevelFilter(serverCtx.Viper.GetString(flags.FlagLogLevel), moduleLevels)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := log.ParseLogLevel(filterStr)
+	if err != nil {
+		return nil, err
+	}
+
+	var opts []log.Option
+	if serverCtx.Viper.GetString(flags.FlagLogFormat) == flags.OutputFormatJSON {
+		opts = append(opts, log.OutputJSONOption())
+	}
+	opts = append(opts,
+		log.ColorOption(!serverCtx.Viper.GetBool(flags.FlagLogNoColor)),
+		log.TraceOption(serverCtx.Viper.GetBool(server.FlagTrace)),
+		log.FilterOption(filter),
+	)
+	return log.NewLogger(out, opts...), nil
+}
@@ -0,0 +1,160 @@
+package config
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+func TestParseLogModuleLevels(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  string
+		exp    map[string]zerolog.Level
+		expErr string
+	}{
+		{name: "empty", value: "", exp: map[string]zerolog.Level{}},
+		{name: "only commas", value: " , ,", exp: map[string]zerolog.Level{}},
+		{name: "one entry", value: "exchange=debug", exp: map[string]zerolog.Level{"x/exchange": zerolog.DebugLevel}},
+		{
+			name:  "multiple entries with spaces",
+			value: " exchange = debug , marker=info,",
+			exp:   map[string]zerolog.Level{"x/exchange": zerolog.DebugLevel, "x/marker": zerolog.InfoLevel},
+		},
+		{
+			name:  "module with a slash",
+			value: "x/trigger=error,ibc/transfer=warn",
+			exp:   map[string]zerolog.Level{"x/trigger": zerolog.ErrorLevel, "ibc/transfer": zerolog.WarnLevel},
+		},
+		{
+			name:   "no level",
+			value:  "exchange",
+			expErr: `invalid log_module_levels entry "exchange": expected format <module>=<level>`,
+		},
+		{
+			name:   "empty module",
+			value:  "=debug",
+			expErr: `invalid log_module_levels entry "=debug": expected format <module>=<level>`,
+		},
+		{
+			name:   "too many equals",
+			value:  "exchange=debug=info",
+			expErr: `invalid log_module_levels entry "exchange=debug=info": expected format <module>=<level>`,
+		},
+		{
+			name:   "unknown level",
+			value:  "exchange=loud",
+			expErr: `invalid log_module_levels entry "exchange=loud": Unknown Level String: 'loud', defaulting to NoLevel`,
+		},
+		{
+			name:   "duplicate module",
+			value:  "exchange=debug,x/exchange=info",
+			expErr: `invalid log_module_levels entry "x/exchange=info": duplicate module "x/exchange"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := ParseLogModuleLevels(tc.value)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseLogModuleLevels error")
+			assert.Equal(t, tc.exp, actual, "ParseLogModuleLevels result")
+		})
+	}
+}
+
+func TestBuildLogLevelFilter(t *testing.T) {
+	tests := []struct {
+		name         string
+		logLevel     string
+		moduleLevels string
+		exp          string
+		expErr       string
+	}{
+		{name: "no log level", moduleLevels: "exchange=debug", exp: "*:info,x/exchange:debug"},
+		{name: "simple log level", logLevel: "error", moduleLevels: "exchange=debug", exp: "*:error,x/exchange:debug"},
+		{
+			name:         "modules are sorted",
+			logLevel:     "warn",
+			moduleLevels: "marker=info,exchange=debug",
+			exp:          "*:warn,x/exchange:debug,x/marker:info",
+		},
+		{
+			name:         "log level is a filter",
+			logLevel:     "consensus:debug,*:error",
+			moduleLevels: "exchange=debug",
+			exp:          "consensus:debug,*:error,x/exchange:debug",
+		},
+		{
+			name:         "module in both",
+			logLevel:     "x/exchange:info,*:error",
+			moduleLevels: "exchange=debug",
+			expErr:       `module "x/exchange" has a level in both log_level and log_module_levels`,
+		},
+		{
+			name:         "invalid module levels",
+			logLevel:     "info",
+			moduleLevels: "exchange",
+			expErr:       `invalid log_module_levels entry "exchange": expected format <module>=<level>`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := BuildLogLevelFilter(tc.logLevel, tc.moduleLevels)
+			assertions.AssertErrorValue(t, err, tc.expErr, "BuildLogLevelFilter error")
+			assert.Equal(t, tc.exp, actual, "BuildLogLevelFilter result")
+		})
+	}
+}
+
+func TestCreateLogger(t *testing.T) {
+	newServerCtx := func(logLevel, moduleLevels string) *server.Context {
+		vpr := viper.New()
+		vpr.Set(flags.FlagLogLevel, logLevel)
+		vpr.Set(flags.FlagLogNoColor, true)
+		vpr.Set(LogModuleLevelsFlag, moduleLevels)
+		return server.NewContext(vpr, DefaultCmtConfig(), nil)
+	}
+
+	t.Run("without module levels", func(t *testing.T) {
+		var buffer bytes.Buffer
+		logger, err := CreateLogger(newServerCtx("info", ""), &buffer)
+		require.NoError(t, err, "CreateLogger")
+		logger.With("module", "x/exchange").Debug("exchange debug")
+		logger.With("module", "x/exchange").Info("exchange info")
+		logged := buffer.String()
+		assert.NotContains(t, logged, "exchange debug", "log output")
+		assert.Contains(t, logged, "exchange info", "log output")
+	})
+
+	t.Run("with module levels", func(t *testing.T) {
+		var buffer bytes.Buffer
+		logger, err := CreateLogger(newServerCtx("warn", "exchange=debug"), &buffer)
+		require.NoError(t, err, "CreateLogger")
+		logger.With("module", "x/exchange").Debug("exchange debug")
+		logger.With("module", "x/marker").Info("marker info")
+		logger.With("module", "x/marker").Warn("marker warn")
+		logger.Info("no module info")
+		logged := buffer.String()
+		assert.Contains(t, logged, "exchange debug", "log output")
+		assert.NotContains(t, logged, "marker info", "log output")
+		assert.Contains(t, logged, "marker warn", "log output")
+		assert.NotContains(t, logged, "no module info", "log output")
+	})
+
+	t.Run("invalid module levels", func(t *testing.T) {
+		var buffer bytes.Buffer
+		logger, err := CreateLogger(newServerCtx("info", "exchange:debug"), &buffer)
+		assert.EqualError(t, err, `invalid log_module_levels entry "exchange:debug": expected format <module>=<level>`, "CreateLogger error")
+		assert.Nil(t, logger, "CreateLogger logger")
+	})
+}
@@ -605,7 +605,7 @@ func applyConfigsToContexts(cmd *cobra.Command) error {
 	serverCtx.Config.SetRoot(clientCtx.HomeDir)
 
 	// Set the server context's logger using what Viper has now.
-	serverCtx.Logger, err = server.CreateSDKLogger(serverCtx, cmd.ErrOrStderr())
+	serverCtx.Logger, err = CreateLogger(serverCtx, cmd.ErrOrStderr())
 	if err != nil {
 		return fmt.Errorf("error creating logger: %w", err)
 	}
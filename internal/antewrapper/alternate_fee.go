@@ -0,0 +1,103 @@
+package antewrapper
+
+import (
+	"slices"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	msgfeestypes "github.com/provenance-io/provenance/x/msgfees/types"
+)
+
+// ConvertBaseFee returns the base fee in the denom that it will be paid in.
+//
+// If the fee includes any of the base fee's denom, the base fee is returned unchanged.
+// Otherwise, the first coin in the fee that has an approved alternate fee denom is used,
+// and the base fee is converted to that denom using the marker's net asset value.
+// The returned event is only non-nil when the base fee was converted.
+//
+// A net asset value priced in the base fee's denom is used if there is one.
+// Otherwise, a net asset value priced in usd (mils) is used, and converted using the nhash per usd mil param.
+func ConvertBaseFee(
+	ctx sdk.Context,
+	msgFeeKeeper msgfeestypes.MsgFeesKeeper,
+	markerKeeper msgfeestypes.MarkerKeeper,
+	fee sdk.Coins,
+	baseFee sdk.Coins,
+) (sdk.Coins, *msgfeestypes.EventBaseFeeConverted, error) {
+	if markerKeeper == nil || len(baseFee) != 1 || !fee.AmountOf(baseFee[0].Denom).IsZero() {
+		return baseFee, nil, nil
+	}
+
+	approved := msgFeeKeeper.GetAlternateFeeDenoms(ctx)
+	if len(approved) == 0 {
+		return baseFee, nil, nil
+	}
+
+	for _, coin := range fee {
+		if !slices.Contains(approved, coin.Denom) {
+			continue
+		}
+		converted, price, volume, err := convertToAlternateDenom(ctx, msgFeeKeeper, markerKeeper, baseFee[0], coin.Denom)
+		if err != nil {
+			return nil, nil, err
+		}
+		event := &msgfeestypes.EventBaseFeeConverted{
+			BaseFee:      baseFee.String(),
+			ConvertedFee: converted.String(),
+			Price:        price.String(),
+			Volume:       volume.String(),
+		}
+		return sdk.NewCoins(converted), event, nil
+	}
+
+	return baseFee, nil, nil
+}
+
+// convertToAlternateDenom converts the base fee to the provided alternate fee denom, rounding up.
+// It also returns the price (in the base fee's denom) and volume that were used for the conversion.
+func convertToAlternateDenom(
+	ctx sdk.Context,
+	msgFeeKeeper msgfeestypes.MsgFeesKeeper,
+	markerKeeper msgfeestypes.MarkerKeeper,
+	baseFee sdk.Coin,
+	denom string,
+) (sdk.Coin, sdk.Coin, sdkmath.Int, error) {
+	nav, err := markerKeeper.GetNetAssetValue(ctx, denom, baseFee.Denom)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdkmath.Int{}, sdkerrors.ErrInvalidCoins.Wrapf("could not get net asset value of alternate fee denom %q: %v", denom, err)
+	}
+
+	price := sdk.Coin{}
+	if nav != nil {
+		price = nav.Price
+	} else {
+		nav, err = markerKeeper.GetNetAssetValue(ctx, denom, msgfeestypes.UsdDenom)
+		if err != nil {
+			return sdk.Coin{}, sdk.Coin{}, sdkmath.Int{}, sdkerrors.ErrInvalidCoins.Wrapf("could not get net asset value of alternate fee denom %q: %v", denom, err)
+		}
+		if nav == nil {
+			return sdk.Coin{}, sdk.Coin{}, sdkmath.Int{}, sdkerrors.ErrInvalidCoins.Wrapf("alternate fee denom %q does not have a net asset value in %s or %s",
+				denom, baseFee.Denom, msgfeestypes.UsdDenom)
+		}
+		price, err = msgFeeKeeper.ConvertDenomToHash(ctx, nav.Price)
+		if err != nil {
+			return sdk.Coin{}, sdk.Coin{}, sdkmath.Int{}, sdkerrors.ErrInvalidCoins.Wrapf("could not convert net asset value of alternate fee denom %q: %v", denom, err)
+		}
+		if price.Denom != baseFee.Denom {
+			return sdk.Coin{}, sdk.Coin{}, sdkmath.Int{}, sdkerrors.ErrInvalidCoins.Wrapf("alternate fee denom %q net asset value converted to %q instead of %q",
+				denom, price.Denom, baseFee.Denom)
+		}
+	}
+
+	volume := sdkmath.NewIntFromUint64(nav.Volume)
+	if !price.Amount.IsPositive() || !volume.IsPositive() {
+		return sdk.Coin{}, sdk.Coin{}, sdkmath.Int{}, sdkerrors.ErrInvalidCoins.Wrapf("alternate fee denom %q has a zero net asset value", denom)
+	}
+
+	// amount = ceil(base fee * volume / price)
+	amount := baseFee.Amount.Mul(volume).Add(price.Amount).SubRaw(1).Quo(price.Amount)
+	return sdk.NewCoin(denom, amount), price, volume, nil
+}
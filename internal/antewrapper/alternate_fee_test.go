@@ -0,0 +1,174 @@
+package antewrapper_test
+
+import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	pioante "github.com/provenance-io/provenance/internal/antewrapper"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	msgfeestypes "github.com/provenance-io/provenance/x/msgfees/types"
+)
+
+// These tests are kicked off by TestAnteTestSuite in testutil_test.go
+
+// setupAlternateFeeDenoms sets the msgfees params so that the floor gas price is 10nhash, uusdc is worth
+// 1nhash (using a usd net asset value), and uylds is worth 1.5nhash (using a nhash net asset value).
+// The uabc denom is also an alternate fee denom, but does not have a net asset value.
+func (s *AnteTestSuite) setupAlternateFeeDenoms() {
+	params := s.app.MsgFeesKeeper.GetParams(s.ctx)
+	params.FloorGasPrice = sdk.NewInt64Coin("nhash", 10)
+	params.NhashPerUsdMil = 1_000
+	params.ConversionFeeDenom = "nhash"
+	params.AlternateFeeDenoms = []string{"uabc", "uusdc", "uylds"}
+	s.app.MsgFeesKeeper.SetParams(s.ctx, params)
+
+	navs := []struct {
+		denom string
+		nav   markertypes.NetAssetValue
+	}{
+		{denom: "uusdc", nav: markertypes.NewNetAssetValue(sdk.NewInt64Coin(markertypes.UsdDenom, 1_000), 1_000_000)},
+		{denom: "uylds", nav: markertypes.NewNetAssetValue(sdk.NewInt64Coin("nhash", 3), 2)},
+	}
+	for _, n := range navs {
+		marker := markertypes.NewEmptyMarkerAccount(n.denom, "", nil)
+		s.Require().NoError(s.app.MarkerKeeper.SetNetAssetValue(s.ctx, marker, n.nav, "test"), "SetNetAssetValue(%s)", n.denom)
+	}
+}
+
+func (s *AnteTestSuite) TestConvertBaseFee() {
+	s.SetupTest(false)
+	s.setupAlternateFeeDenoms()
+
+	baseFee := sdk.NewCoins(sdk.NewInt64Coin("nhash", 1_000))
+	tests := []struct {
+		name     string
+		fee      sdk.Coins
+		baseFee  sdk.Coins
+		noMarker bool
+		expFee   sdk.Coins
+		expEvent *msgfeestypes.EventBaseFeeConverted
+		expErr   string
+	}{
+		{
+			name:    "fee includes base fee denom",
+			fee:     sdk.NewCoins(sdk.NewInt64Coin("nhash", 5), sdk.NewInt64Coin("uusdc", 2_000)),
+			baseFee: baseFee,
+			expFee:  baseFee,
+		},
+		{
+			name:    "fee not in an alternate fee denom",
+			fee:     sdk.NewCoins(sdk.NewInt64Coin("atom", 2_000)),
+			baseFee: baseFee,
+			expFee:  baseFee,
+		},
+		{
+			name:    "zero base fee",
+			fee:     sdk.NewCoins(sdk.NewInt64Coin("uusdc", 2_000)),
+			baseFee: sdk.NewCoins(),
+			expFee:  sdk.NewCoins(),
+		},
+		{
+			name:     "no marker keeper",
+			fee:      sdk.NewCoins(sdk.NewInt64Coin("uusdc", 2_000)),
+			baseFee:  baseFee,
+			noMarker: true,
+			expFee:   baseFee,
+		},
+		{
+			name:    "usd net asset value",
+			fee:     sdk.NewCoins(sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("uusdc", 2_000)),
+			baseFee: baseFee,
+			expFee:  sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000)),
+			expEvent: &msgfeestypes.EventBaseFeeConverted{
+				BaseFee: "1000nhash", ConvertedFee: "1000uusdc", Price: "1000000nhash", Volume: "1000000",
+			},
+		},
+		{
+			name:    "nhash net asset value rounds up",
+			fee:     sdk.NewCoins(sdk.NewInt64Coin("uylds", 2_000)),
+			baseFee: baseFee,
+			expFee:  sdk.NewCoins(sdk.NewInt64Coin("uylds", 667)),
+			expEvent: &msgfeestypes.EventBaseFeeConverted{
+				BaseFee: "1000nhash", ConvertedFee: "667uylds", Price: "3nhash", Volume: "2",
+			},
+		},
+		{
+			name:    "first alternate fee denom in the fee is used",
+			fee:     sdk.NewCoins(sdk.NewInt64Coin("uylds", 2_000), sdk.NewInt64Coin("uusdc", 2_000)),
+			baseFee: baseFee,
+			expFee:  sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000)),
+			expEvent: &msgfeestypes.EventBaseFeeConverted{
+				BaseFee: "1000nhash", ConvertedFee: "1000uusdc", Price: "1000000nhash", Volume: "1000000",
+			},
+		},
+		{
+			name:    "alternate fee denom without a net asset value",
+			fee:     sdk.NewCoins(sdk.NewInt64Coin("uabc", 2_000)),
+			baseFee: baseFee,
+			expErr:  `alternate fee denom "uabc" does not have a net asset value in nhash or usd: invalid coins`,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			var markerKeeper msgfeestypes.MarkerKeeper = s.app.MarkerKeeper
+			if tc.noMarker {
+				markerKeeper = nil
+			}
+			fee, event, err := pioante.ConvertBaseFee(s.ctx, s.app.MsgFeesKeeper, markerKeeper, tc.fee, tc.baseFee)
+			if len(tc.expErr) > 0 {
+				s.Require().EqualError(err, tc.expErr, "ConvertBaseFee error")
+				return
+			}
+			s.Require().NoError(err, "ConvertBaseFee error")
+			s.Assert().Equal(tc.expFee.String(), fee.String(), "ConvertBaseFee fee")
+			s.Assert().Equal(tc.expEvent, event, "ConvertBaseFee event")
+		})
+	}
+}
+
+func (s *AnteTestSuite) TestProvenanceDeductFeeDecoratorAlternateFeeDenom() {
+	s.SetupTest(false)
+	s.ctx = s.ctx.WithChainID("pio-testnet-1")
+	s.setupAlternateFeeDenoms()
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	s.Require().NoError(s.txBuilder.SetMsgs(testdata.NewTestMsg(addr1)), "SetMsgs")
+	s.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_500)))
+	s.txBuilder.SetGasLimit(100)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := s.CreateTestTx(privs, accNums, accSeqs, s.ctx.ChainID())
+	s.Require().NoError(err, "CreateTestTx")
+
+	acc := s.app.AccountKeeper.NewAccountWithAddress(s.ctx, addr1)
+	s.app.AccountKeeper.SetAccount(s.ctx, acc)
+	s.Require().NoError(testutil.FundAccount(s.ctx, s.app.BankKeeper, addr1, sdk.NewCoins(sdk.NewInt64Coin("uusdc", 5_000))), "FundAccount")
+
+	decorators := []sdk.AnteDecorator{
+		pioante.NewFeeMeterContextDecorator(),
+		pioante.NewProvenanceDeductFeeDecorator(s.app.AccountKeeper, s.app.BankKeeper, nil, s.app.MsgFeesKeeper, s.app.MarkerKeeper),
+	}
+	antehandler := sdk.ChainAnteDecorators(decorators...)
+
+	newCtx, err := antehandler(s.ctx, tx, false)
+	s.Require().NoError(err, "antehandler")
+
+	// The base fee is 100 gas * 10nhash = 1000nhash = 1000uusdc.
+	expBaseFee := sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000))
+	s.Assert().Equal(sdk.NewCoins(sdk.NewInt64Coin("uusdc", 4_000)).String(),
+		s.app.BankKeeper.GetAllBalances(s.ctx, addr1).String(), "balance after antehandler")
+
+	feeGasMeter, err := pioante.GetFeeGasMeter(newCtx)
+	s.Require().NoError(err, "GetFeeGasMeter")
+	s.Assert().Equal(expBaseFee.String(), feeGasMeter.BaseFeeConsumed().String(), "BaseFeeConsumed")
+
+	expEvent, err := sdk.TypedEventToEvent(&msgfeestypes.EventBaseFeeConverted{
+		BaseFee: "1000nhash", ConvertedFee: "1000uusdc", Price: "1000000nhash", Volume: "1000000", FeePayer: addr1.String(),
+	})
+	s.Require().NoError(err, "TypedEventToEvent")
+	s.Assert().Contains(newCtx.EventManager().Events(), expEvent, "antehandler events")
+}
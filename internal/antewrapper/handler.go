@@ -22,7 +22,9 @@ type HandlerOptions struct {
 	ExtensionOptionChecker cosmosante.ExtensionOptionChecker
 	FeegrantKeeper         msgfeestypes.FeegrantKeeper
 	MsgFeesKeeper          msgfeestypes.MsgFeesKeeper
+	MarkerKeeper           msgfeestypes.MarkerKeeper
 	CircuitKeeper          circuitante.CircuitBreaker
+	UnorderedTxKeeper      UnorderedTxKeeper
 	TxSigningHandlerMap    *txsigning.HandlerMap
 	SigGasConsumer         func(meter storetypes.GasMeter, sig signing.SignatureV2, params types.Params) error
 }
@@ -51,18 +53,19 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		NewFeeMeterContextDecorator(), // NOTE : fee gas meter also has the functionality of GasTracerContextDecorator in previous versions
 		NewTxGasLimitDecorator(),
 		NewMinGasPricesDecorator(),
-		NewMsgFeesDecorator(options.MsgFeesKeeper),
+		NewMsgFeesDecorator(options.MsgFeesKeeper, options.MarkerKeeper),
 		cosmosante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
 		cosmosante.NewValidateBasicDecorator(),
 		cosmosante.NewTxTimeoutHeightDecorator(),
+		NewUnorderedTxDecorator(options.UnorderedTxKeeper),
 		cosmosante.NewValidateMemoDecorator(options.AccountKeeper),
 		cosmosante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		NewProvenanceDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.MsgFeesKeeper),
+		NewProvenanceDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.MsgFeesKeeper, options.MarkerKeeper),
 		cosmosante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		cosmosante.NewValidateSigCountDecorator(options.AccountKeeper),
 		cosmosante.NewSigGasConsumeDecorator(options.AccountKeeper, sigGasConsumer),
-		cosmosante.NewSigVerificationDecorator(options.AccountKeeper, options.TxSigningHandlerMap),
-		cosmosante.NewIncrementSequenceDecorator(options.AccountKeeper),
+		NewUnorderedSigVerificationDecorator(options.AccountKeeper, options.TxSigningHandlerMap),
+		NewUnorderedIncrementSequenceDecorator(options.AccountKeeper),
 	}
 
 	return sdk.ChainAnteDecorators(decorators...), nil
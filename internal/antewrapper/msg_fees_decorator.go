@@ -3,8 +3,6 @@ package antewrapper
 import (
 	"strings"
 
-	sdkmath "cosmossdk.io/math"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -24,11 +22,13 @@ const (
 // CONTRACT: Tx must implement FeeTx to use MsgFeesDecorator
 type MsgFeesDecorator struct {
 	msgFeeKeeper msgfeestypes.MsgFeesKeeper
+	markerKeeper msgfeestypes.MarkerKeeper
 }
 
-func NewMsgFeesDecorator(msgFeeKeeper msgfeestypes.MsgFeesKeeper) MsgFeesDecorator {
+func NewMsgFeesDecorator(msgFeeKeeper msgfeestypes.MsgFeesKeeper, markerKeeper msgfeestypes.MarkerKeeper) MsgFeesDecorator {
 	return MsgFeesDecorator{
 		msgFeeKeeper: msgFeeKeeper,
+		markerKeeper: markerKeeper,
 	}
 }
 
@@ -54,21 +54,24 @@ func (mfd MsgFeesDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool
 	}
 
 	// Make sure there are enough fees to cover base fee + additional fees.
-	// base fee = floor gas price * gas wanted
+	// base fee = floor gas price * gas wanted (possibly converted to an alternate fee denom)
 	// additional fees = sum of message based fees
 	if ctx.IsCheckTx() {
 		feeCoins := feeTx.GetFee()
-		gas := feeTx.GetGas()
-		floorGasPrice := mfd.msgFeeKeeper.GetFloorGasPrice(ctx)
 		msgs := feeTx.GetMsgs()
 
+		baseFee, _, convErr := ConvertBaseFee(ctx, mfd.msgFeeKeeper, mfd.markerKeeper, feeCoins, CalculateBaseFee(ctx, feeTx, mfd.msgFeeKeeper))
+		if convErr != nil && !simulate {
+			return ctx, sdkerrors.ErrInsufficientFee.Wrap(convErr.Error())
+		}
+
 		// Compute msg all additional fees
 		msgFeesDistribution, calcErr := mfd.msgFeeKeeper.CalculateAdditionalFeesToBePaid(ctx, msgs...)
 		if calcErr != nil && !simulate {
 			return ctx, sdkerrors.ErrInsufficientFee.Wrap(calcErr.Error())
 		}
 
-		mpErr := EnsureSufficientFloorAndMsgFees(ctx, feeCoins, floorGasPrice, gas, msgFeesDistribution.TotalAdditionalFees)
+		mpErr := EnsureSufficientFloorAndMsgFees(ctx, feeCoins, baseFee, msgFeesDistribution.TotalAdditionalFees)
 		if mpErr != nil && !simulate {
 			return ctx, sdkerrors.ErrInsufficientFee.Wrap(mpErr.Error())
 		}
@@ -85,20 +88,14 @@ func isTestContext(ctx sdk.Context) bool {
 }
 
 // EnsureSufficientFloorAndMsgFees verifies that the given transaction has supplied
-// enough fees(gas + additional fees) to cover x/msgfees costs.
-//
-// Contract: This should only be called during CheckTx as it cannot be part of
-// consensus.
-func EnsureSufficientFloorAndMsgFees(ctx sdk.Context, feeCoins sdk.Coins, floorGasPrice sdk.Coin, gas uint64, additionalFees sdk.Coins) error {
+// enough fees(base fee + additional fees) to cover x/msgfees costs.
+// The base fee should be in the denom it will be paid in (see ConvertBaseFee).
+func EnsureSufficientFloorAndMsgFees(ctx sdk.Context, feeCoins sdk.Coins, baseFee sdk.Coins, additionalFees sdk.Coins) error {
 	// the isTestContext is exclusively for not breaking all existing sim tests which freak out when denom is anything other than stake.
 	if isTestContext(ctx) {
 		return nil
 	}
 
-	var baseFee sdk.Coins
-	if !floorGasPrice.IsZero() {
-		baseFee = baseFee.Add(sdk.NewCoin(floorGasPrice.Denom, floorGasPrice.Amount.Mul(sdkmath.NewIntFromUint64(gas))))
-	}
 	reqTotal := baseFee.Add(additionalFees...)
 
 	if reqTotal.IsZero() {
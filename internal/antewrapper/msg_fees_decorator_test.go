@@ -167,7 +167,7 @@ func setUpApp(s *AnteTestSuite, checkTx bool, additionalFeeCoinDenom string, add
 		s.Require().NoError(err, "CreateMsgFee")
 	}
 	// setup NewMsgFeesDecorator
-	mfd := antewrapper.NewMsgFeesDecorator(s.app.MsgFeesKeeper)
+	mfd := antewrapper.NewMsgFeesDecorator(s.app.MsgFeesKeeper, s.app.MarkerKeeper)
 	antehandler := sdk.ChainAnteDecorators(mfd)
 	return antehandler
 }
@@ -37,7 +37,7 @@ func (s *AnteTestSuite) TestProvenanceDeductFeeDecoratorChecksFunds() {
 	s.Require().NoError(err, "funding account with %s", coins)
 	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)), s.app.BankKeeper.GetAllBalances(s.ctx, addr1), "should have the new balance after funding account")
 
-	decorators := []sdk.AnteDecorator{pioante.NewFeeMeterContextDecorator(), pioante.NewProvenanceDeductFeeDecorator(s.app.AccountKeeper, s.app.BankKeeper, nil, s.app.MsgFeesKeeper)}
+	decorators := []sdk.AnteDecorator{pioante.NewFeeMeterContextDecorator(), pioante.NewProvenanceDeductFeeDecorator(s.app.AccountKeeper, s.app.BankKeeper, nil, s.app.MsgFeesKeeper, s.app.MarkerKeeper)}
 	antehandler := sdk.ChainAnteDecorators(decorators...)
 
 	_, err = antehandler(s.ctx, tx, false)
@@ -94,7 +94,7 @@ func (s *AnteTestSuite) TestProvenanceDeductFeeDecoratorAdditionalFees() {
 	err = testutil.FundAccount(s.ctx, s.app.BankKeeper, addr1, coins)
 	s.Require().NoError(err, "funding account with 10stake")
 
-	decorators := []sdk.AnteDecorator{pioante.NewFeeMeterContextDecorator(), pioante.NewProvenanceDeductFeeDecorator(s.app.AccountKeeper, s.app.BankKeeper, nil, s.app.MsgFeesKeeper)}
+	decorators := []sdk.AnteDecorator{pioante.NewFeeMeterContextDecorator(), pioante.NewProvenanceDeductFeeDecorator(s.app.AccountKeeper, s.app.BankKeeper, nil, s.app.MsgFeesKeeper, s.app.MarkerKeeper)}
 	antehandler := sdk.ChainAnteDecorators(decorators...)
 
 	s.Run("insufficient funds for both base and additional fees", func() {
@@ -38,7 +38,7 @@ func (s *AnteTestSuite) TestDeductFeesNoDelegation() {
 
 	protoTxCfg := tx.NewTxConfig(codec.NewProtoCodec(app.InterfaceRegistry()), tx.DefaultSignModes)
 
-	dfd := pioante.NewProvenanceDeductFeeDecorator(app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.MsgFeesKeeper)
+	dfd := pioante.NewProvenanceDeductFeeDecorator(app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.MsgFeesKeeper, app.MarkerKeeper)
 
 	// this just tests our handler
 	decorators := []sdk.AnteDecorator{pioante.NewFeeMeterContextDecorator(), dfd}
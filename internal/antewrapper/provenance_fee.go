@@ -19,6 +19,7 @@ import (
 // ProvenanceDeductFeeDecorator identifies the payer (using feegrant funds if appropriate),
 // makes sure the payer has enough funds to cover the fees, and deducts the base fee from
 // the payer's account. The base fee is the floor gas price * gas.
+// If the fee is in an approved alternate fee denom, the base fee is converted to that denom (see ConvertBaseFee).
 // If the first signer does not have the funds to pay for the fees, return with InsufficientFunds error
 // Call next AnteHandler if fees successfully deducted.
 // CONTRACT: In order to use ProvenanceDeductFeeDecorator:
@@ -29,6 +30,7 @@ type ProvenanceDeductFeeDecorator struct {
 	bankKeeper     bankkeeper.Keeper
 	feegrantKeeper msgfeestypes.FeegrantKeeper
 	msgFeeKeeper   msgfeestypes.MsgFeesKeeper
+	markerKeeper   msgfeestypes.MarkerKeeper
 }
 
 const (
@@ -42,12 +44,14 @@ func NewProvenanceDeductFeeDecorator(
 	bankKeeper bankkeeper.Keeper,
 	feegrantKeeper msgfeestypes.FeegrantKeeper,
 	msgfeesKeeper msgfeestypes.MsgFeesKeeper,
+	markerKeeper msgfeestypes.MarkerKeeper,
 ) ProvenanceDeductFeeDecorator {
 	return ProvenanceDeductFeeDecorator{
 		ak:             accountKeeper,
 		bankKeeper:     bankKeeper,
 		feegrantKeeper: feegrantKeeper,
 		msgFeeKeeper:   msgfeesKeeper,
+		markerKeeper:   markerKeeper,
 	}
 }
 
@@ -73,6 +77,7 @@ func (dfd ProvenanceDeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, s
 //  2. Makes sure the payer has enough funds to cover the base fee + additional fees.
 //  3. Deducts the base fee from the payer.
 //  4. Emits Tx events: 1. with the full fee and payer, 2. with base fee.
+//     If the base fee was converted to an alternate fee denom, an EventBaseFeeConverted is also emitted.
 func (dfd ProvenanceDeductFeeDecorator) checkDeductBaseFee(ctx sdk.Context, feeTx sdk.FeeTx, simulate bool) error {
 	if addr := dfd.ak.GetModuleAddress(types.FeeCollectorName); addr == nil {
 		return sdkerrors.ErrLogic.Wrapf("%s module account has not been set", types.FeeCollectorName)
@@ -86,7 +91,10 @@ func (dfd ProvenanceDeductFeeDecorator) checkDeductBaseFee(ctx sdk.Context, feeT
 	// Calculate the base and required fees.
 	// Note: The MsgFeesDecorator only checks stuff during IsCheckTx, so we need to do it here too.
 	msgs := feeTx.GetMsgs()
-	baseFeeToConsume := CalculateBaseFee(ctx, feeTx, dfd.msgFeeKeeper)
+	baseFeeToConsume, conversion, err := ConvertBaseFee(ctx, dfd.msgFeeKeeper, dfd.markerKeeper, feeTx.GetFee(), CalculateBaseFee(ctx, feeTx, dfd.msgFeeKeeper))
+	if err != nil {
+		return sdkerrors.ErrInsufficientFee.Wrap(err.Error())
+	}
 	feeDist, err := dfd.msgFeeKeeper.CalculateAdditionalFeesToBePaid(ctx, msgs...)
 	if err != nil {
 		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
@@ -153,6 +161,13 @@ func (dfd ProvenanceDeductFeeDecorator) checkDeductBaseFee(ctx sdk.Context, feeT
 		),
 	})
 
+	if conversion != nil {
+		conversion.FeePayer = deductFeesFrom.String()
+		if err = ctx.EventManager().EmitTypedEvent(conversion); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
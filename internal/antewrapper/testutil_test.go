@@ -100,13 +100,11 @@ func (s *AnteTestSuite) CreateTestAccounts(numAccs int) []TestAccount {
 	for i := 0; i < numAccs; i++ {
 		priv, _, addr := testdata.KeyTestPubAddr()
 		acc := s.app.AccountKeeper.NewAccountWithAddress(s.ctx, addr)
-		err := acc.SetAccountNumber(uint64(i))
-		s.Require().NoError(err)
 		s.app.AccountKeeper.SetAccount(s.ctx, acc)
 		someCoins := sdk.Coins{
 			sdk.NewInt64Coin("atom", 10000000),
 		}
-		err = s.app.BankKeeper.MintCoins(s.ctx, minttypes.ModuleName, someCoins)
+		err := s.app.BankKeeper.MintCoins(s.ctx, minttypes.ModuleName, someCoins)
 		s.Require().NoError(err)
 
 		err = s.app.BankKeeper.SendCoinsFromModuleToAccount(s.ctx, minttypes.ModuleName, addr, someCoins)
@@ -0,0 +1,174 @@
+package antewrapper
+
+import (
+	"context"
+	"time"
+
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	cosmosante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	"github.com/provenance-io/provenance/internal/unordered"
+)
+
+// UnorderedTxKeeper defines the functionality needed to keep track of the unordered txs that have been included.
+type UnorderedTxKeeper interface {
+	HasNonce(ctx sdk.Context, signer sdk.AccAddress, timeout time.Time) bool
+	AddNonce(ctx sdk.Context, signer sdk.AccAddress, timeout time.Time)
+}
+
+// UnorderedTxDecorator validates unordered txs and prevents them from being included more than once.
+//
+// An unordered tx must have a timeout after the block time, but not more than unordered.MaxTimeoutDuration after it.
+// Each of its signers must not have already used that timeout for another unordered tx.
+// Txs that are not unordered are passed along unchanged.
+//
+// CONTRACT: Tx must implement SigVerifiableTx to use UnorderedTxDecorator
+type UnorderedTxDecorator struct {
+	keeper UnorderedTxKeeper
+}
+
+// NewUnorderedTxDecorator creates a new UnorderedTxDecorator. If the keeper is nil, all unordered txs are rejected.
+func NewUnorderedTxDecorator(keeper UnorderedTxKeeper) UnorderedTxDecorator {
+	return UnorderedTxDecorator{keeper: keeper}
+}
+
+func (d UnorderedTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	opt, err := unordered.GetExtensionOption(tx)
+	if err != nil {
+		return ctx, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	if opt == nil {
+		return next(ctx, tx, simulate)
+	}
+	if d.keeper == nil {
+		return ctx, sdkerrors.ErrInvalidRequest.Wrap("unordered txs are not supported")
+	}
+
+	timeout := opt.TimeoutTimestamp
+	blockTime := ctx.BlockTime()
+	if !timeout.After(blockTime) {
+		return ctx, sdkerrors.ErrInvalidRequest.Wrapf("unordered tx timeout %s must be after the block time %s",
+			timeout.UTC().Format(time.RFC3339Nano), blockTime.UTC().Format(time.RFC3339Nano))
+	}
+	if maxTimeout := blockTime.Add(unordered.MaxTimeoutDuration); timeout.After(maxTimeout) {
+		return ctx, sdkerrors.ErrInvalidRequest.Wrapf("unordered tx timeout %s cannot be after %s (%s after the block time)",
+			timeout.UTC().Format(time.RFC3339Nano), maxTimeout.UTC().Format(time.RFC3339Nano), unordered.MaxTimeoutDuration)
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, sdkerrors.ErrTxDecode.Wrap("invalid transaction type")
+	}
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+	// Legacy amino json sign docs do not include the extension options, so the timeout wouldn't be signed.
+	for _, sig := range sigs {
+		if usesLegacyAminoJSON(sig.Data) {
+			return ctx, sdkerrors.ErrInvalidRequest.Wrapf("unordered txs cannot be signed using %s", signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+		}
+	}
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return ctx, err
+	}
+
+	for _, signer := range signers {
+		if d.keeper.HasNonce(ctx, signer, timeout) {
+			return ctx, sdkerrors.ErrInvalidRequest.Wrapf("signer %s has already used unordered tx timeout %s",
+				sdk.AccAddress(signer), timeout.UTC().Format(time.RFC3339Nano))
+		}
+	}
+	if !simulate {
+		for _, signer := range signers {
+			d.keeper.AddNonce(ctx, signer, timeout)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// usesLegacyAminoJSON returns true if the provided signature data (or any of its sub-signatures) uses SIGN_MODE_LEGACY_AMINO_JSON.
+func usesLegacyAminoJSON(sigData signing.SignatureData) bool {
+	switch v := sigData.(type) {
+	case *signing.SingleSignatureData:
+		return v.SignMode == signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+	case *signing.MultiSignatureData:
+		for _, s := range v.Signatures {
+			if usesLegacyAminoJSON(s) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// UnorderedSigVerificationDecorator is the SDK's SigVerificationDecorator, except unordered txs
+// are verified as if the sequence of each of their signers is zero.
+type UnorderedSigVerificationDecorator struct {
+	ordered   sdk.AnteDecorator
+	unordered sdk.AnteDecorator
+}
+
+// NewUnorderedSigVerificationDecorator creates a new UnorderedSigVerificationDecorator.
+func NewUnorderedSigVerificationDecorator(ak cosmosante.AccountKeeper, signModeHandler *txsigning.HandlerMap) UnorderedSigVerificationDecorator {
+	return UnorderedSigVerificationDecorator{
+		ordered:   cosmosante.NewSigVerificationDecorator(ak, signModeHandler),
+		unordered: cosmosante.NewSigVerificationDecorator(zeroSequenceAccountKeeper{AccountKeeper: ak}, signModeHandler),
+	}
+}
+
+func (d UnorderedSigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if unordered.IsUnorderedTx(tx) {
+		return d.unordered.AnteHandle(ctx, tx, simulate, next)
+	}
+	return d.ordered.AnteHandle(ctx, tx, simulate, next)
+}
+
+// UnorderedIncrementSequenceDecorator is the SDK's IncrementSequenceDecorator, except the sequences
+// of the signers of unordered txs are not incremented.
+type UnorderedIncrementSequenceDecorator struct {
+	ordered sdk.AnteDecorator
+}
+
+// NewUnorderedIncrementSequenceDecorator creates a new UnorderedIncrementSequenceDecorator.
+func NewUnorderedIncrementSequenceDecorator(ak cosmosante.AccountKeeper) UnorderedIncrementSequenceDecorator {
+	return UnorderedIncrementSequenceDecorator{ordered: cosmosante.NewIncrementSequenceDecorator(ak)}
+}
+
+func (d UnorderedIncrementSequenceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if unordered.IsUnorderedTx(tx) {
+		return next(ctx, tx, simulate)
+	}
+	return d.ordered.AnteHandle(ctx, tx, simulate, next)
+}
+
+// zeroSequenceAccountKeeper is an AccountKeeper that provides accounts that always have a sequence of zero.
+type zeroSequenceAccountKeeper struct {
+	cosmosante.AccountKeeper
+}
+
+// GetAccount returns the requested account, but with a sequence of zero.
+func (k zeroSequenceAccountKeeper) GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI {
+	acc := k.AccountKeeper.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil
+	}
+	return zeroSequenceAccount{AccountI: acc}
+}
+
+// zeroSequenceAccount is an account that always has a sequence of zero.
+type zeroSequenceAccount struct {
+	sdk.AccountI
+}
+
+// GetSequence always returns zero.
+func (zeroSequenceAccount) GetSequence() uint64 {
+	return 0
+}
@@ -0,0 +1,120 @@
+package antewrapper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmosante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	pioante "github.com/provenance-io/provenance/internal/antewrapper"
+	"github.com/provenance-io/provenance/internal/unordered"
+)
+
+// These tests are kicked off by TestAnteTestSuite in testutil_test.go
+
+// createUnorderedTestTx creates a tx signed by the provided account with the provided sequence.
+// If timeout is not zero, the tx is unordered with that timeout.
+func (s *AnteTestSuite) createUnorderedTestTx(acct TestAccount, seq uint64, timeout time.Time) authsigning.Tx {
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+	s.Require().NoError(s.txBuilder.SetMsgs(testdata.NewTestMsg(acct.acc.GetAddress())), "SetMsgs")
+	s.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	if !timeout.IsZero() {
+		opt, err := unordered.NewExtensionOption(timeout)
+		s.Require().NoError(err, "NewExtensionOption")
+		s.txBuilder.(client.ExtendedTxBuilder).SetExtensionOptions(opt)
+	}
+	tx, err := s.CreateTestTx([]cryptotypes.PrivKey{acct.priv}, []uint64{acct.acc.GetAccountNumber()}, []uint64{seq}, s.ctx.ChainID())
+	s.Require().NoError(err, "CreateTestTx")
+	return tx
+}
+
+func (s *AnteTestSuite) TestUnorderedTxs() {
+	s.SetupTest(false)
+	blockTime := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+	s.ctx = s.ctx.WithBlockTime(blockTime)
+
+	acct := s.CreateTestAccounts(1)[0]
+	addr := acct.acc.GetAddress()
+	s.Require().NoError(acct.acc.SetSequence(5), "SetSequence(5)")
+	s.app.AccountKeeper.SetAccount(s.ctx, acct.acc)
+
+	decorators := []sdk.AnteDecorator{
+		pioante.NewUnorderedTxDecorator(s.app.UnorderedTxKeeper),
+		cosmosante.NewSetPubKeyDecorator(s.app.AccountKeeper),
+		pioante.NewUnorderedSigVerificationDecorator(s.app.AccountKeeper, s.encodingConfig.TxConfig.SignModeHandler()),
+		pioante.NewUnorderedIncrementSequenceDecorator(s.app.AccountKeeper),
+	}
+	anteHandler := sdk.ChainAnteDecorators(decorators...)
+
+	getSeq := func() uint64 {
+		return s.app.AccountKeeper.GetAccount(s.ctx, addr).GetSequence()
+	}
+
+	s.Run("unordered tx with a sequence of zero", func() {
+		timeout := blockTime.Add(time.Minute)
+		tx := s.createUnorderedTestTx(acct, 0, timeout)
+		_, err := anteHandler(s.ctx, tx, false)
+		s.Require().NoError(err, "anteHandler")
+		s.Assert().Equal(5, int(getSeq()), "sequence after unordered tx")
+		s.Assert().True(s.app.UnorderedTxKeeper.HasNonce(s.ctx, addr, timeout), "HasNonce after unordered tx")
+
+		_, err = anteHandler(s.ctx, tx, false)
+		s.Assert().ErrorContains(err, "signer "+addr.String()+" has already used unordered tx timeout 2025-05-01T12:01:00Z", "anteHandler again")
+	})
+
+	s.Run("another unordered tx with a different timeout", func() {
+		tx := s.createUnorderedTestTx(acct, 0, blockTime.Add(time.Minute+time.Nanosecond))
+		_, err := anteHandler(s.ctx, tx, false)
+		s.Require().NoError(err, "anteHandler")
+		s.Assert().Equal(5, int(getSeq()), "sequence after unordered tx")
+	})
+
+	s.Run("unordered tx with the account sequence", func() {
+		tx := s.createUnorderedTestTx(acct, 5, blockTime.Add(2*time.Minute))
+		_, err := anteHandler(s.ctx, tx, false)
+		s.Assert().ErrorContains(err, "account sequence mismatch, expected 0, got 5", "anteHandler")
+	})
+
+	s.Run("unordered tx simulation does not use the nonce", func() {
+		timeout := blockTime.Add(3 * time.Minute)
+		tx := s.createUnorderedTestTx(acct, 0, timeout)
+		_, err := anteHandler(s.ctx, tx, true)
+		s.Require().NoError(err, "anteHandler")
+		s.Assert().False(s.app.UnorderedTxKeeper.HasNonce(s.ctx, addr, timeout), "HasNonce after simulation")
+	})
+
+	s.Run("unordered tx that has timed out", func() {
+		tx := s.createUnorderedTestTx(acct, 0, blockTime)
+		_, err := anteHandler(s.ctx, tx, false)
+		s.Assert().ErrorContains(err, "unordered tx timeout 2025-05-01T12:00:00Z must be after the block time 2025-05-01T12:00:00Z", "anteHandler")
+	})
+
+	s.Run("unordered tx with a timeout too far in the future", func() {
+		tx := s.createUnorderedTestTx(acct, 0, blockTime.Add(unordered.MaxTimeoutDuration+time.Second))
+		_, err := anteHandler(s.ctx, tx, false)
+		s.Assert().ErrorContains(err, "unordered tx timeout 2025-05-01T12:10:01Z cannot be after 2025-05-01T12:10:00Z (10m0s after the block time)", "anteHandler")
+	})
+
+	s.Run("ordered tx with a sequence of zero", func() {
+		tx := s.createUnorderedTestTx(acct, 0, time.Time{})
+		_, err := anteHandler(s.ctx, tx, false)
+		s.Assert().ErrorContains(err, "account sequence mismatch, expected 5, got 0", "anteHandler")
+	})
+
+	s.Run("ordered tx with the account sequence", func() {
+		tx := s.createUnorderedTestTx(acct, 5, time.Time{})
+		_, err := anteHandler(s.ctx, tx, false)
+		s.Require().NoError(err, "anteHandler")
+		s.Assert().Equal(6, int(getSeq()), "sequence after ordered tx")
+	})
+
+	s.Run("unordered txs not supported", func() {
+		tx := s.createUnorderedTestTx(acct, 0, blockTime.Add(4*time.Minute))
+		_, err := pioante.NewUnorderedTxDecorator(nil).AnteHandle(s.ctx, tx, false, nil)
+		s.Assert().ErrorContains(err, "unordered txs are not supported", "AnteHandle")
+	})
+}
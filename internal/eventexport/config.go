@@ -0,0 +1,148 @@
+// Package eventexport exports the typed events emitted by provenance modules to an external sink
+// (a Kafka topic or a Postgres schema) with at-least-once delivery.
+package eventexport
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cast"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const (
+	// FlagSink is the app option with where to export events, either "kafka" or "postgres". Event export is disabled if empty.
+	FlagSink = "event-export.sink"
+	// FlagEventTypes is the app option with the prefixes of the event types to export.
+	FlagEventTypes = "event-export.event-types"
+	// FlagOutboxDir is the app option with the directory that blocks are kept in until they've been delivered.
+	FlagOutboxDir = "event-export.outbox-dir"
+	// FlagStopNodeOnErr is the app option with whether to halt the node if a block can't be added to the outbox.
+	FlagStopNodeOnErr = "event-export.stop-node-on-err"
+	// FlagKafkaRESTURL is the app option with the base url of the Kafka REST proxy to send events to.
+	FlagKafkaRESTURL = "event-export.kafka.rest-url"
+	// FlagKafkaTopic is the app option with the Kafka topic to send events to.
+	FlagKafkaTopic = "event-export.kafka.topic"
+	// FlagPostgresConn is the app option with the Postgres connection string.
+	FlagPostgresConn = "event-export.postgres.conn"
+	// FlagPostgresSchema is the app option with the Postgres schema to write events to.
+	FlagPostgresSchema = "event-export.postgres.schema"
+
+	// SinkKafka is the sink that sends events to a Kafka topic (through a Kafka REST proxy).
+	SinkKafka = "kafka"
+	// SinkPostgres is the sink that writes events to a table in a Postgres schema.
+	SinkPostgres = "postgres"
+
+	// DefaultOutboxDir is the directory (relative to the node's home) that the outbox uses by default.
+	DefaultOutboxDir = "data/event-export"
+	// DefaultPostgresSchema is the Postgres schema that events are written to by default.
+	DefaultPostgresSchema = "provenance_events"
+)
+
+// DefaultEventTypes are the prefixes of the event types exported by default: all typed events from provenance modules.
+var DefaultEventTypes = []string{"provenance."}
+
+// postgresSchemaRx is the format that a Postgres schema name must have.
+var postgresSchemaRx = regexp.MustCompile(`^[a-z_][a-z0-9_]{0,62}$`)
+
+// Config is the configuration of the event exporter.
+type Config struct {
+	// Sink is where to export events.
+	Sink string
+	// EventTypes are the prefixes of the event types to export.
+	EventTypes []string
+	// OutboxDir is the directory that blocks are kept in until they've been delivered.
+	OutboxDir string
+	// StopNodeOnErr is whether to halt the node if a block can't be added to the outbox.
+	StopNodeOnErr bool
+	// KafkaRESTURL is the base url of the Kafka REST proxy.
+	KafkaRESTURL string
+	// KafkaTopic is the Kafka topic to send events to.
+	KafkaTopic string
+	// PostgresConn is the Postgres connection string.
+	PostgresConn string
+	// PostgresSchema is the Postgres schema to write events to.
+	PostgresSchema string
+}
+
+// ConfigFromAppOpts reads the event exporter configuration from the provided app options.
+func ConfigFromAppOpts(appOpts servertypes.AppOptions) Config {
+	rv := Config{
+		Sink:           strings.TrimSpace(cast.ToString(appOpts.Get(FlagSink))),
+		EventTypes:     cast.ToStringSlice(appOpts.Get(FlagEventTypes)),
+		OutboxDir:      strings.TrimSpace(cast.ToString(appOpts.Get(FlagOutboxDir))),
+		StopNodeOnErr:  cast.ToBool(appOpts.Get(FlagStopNodeOnErr)),
+		KafkaRESTURL:   strings.TrimSpace(cast.ToString(appOpts.Get(FlagKafkaRESTURL))),
+		KafkaTopic:     strings.TrimSpace(cast.ToString(appOpts.Get(FlagKafkaTopic))),
+		PostgresConn:   strings.TrimSpace(cast.ToString(appOpts.Get(FlagPostgresConn))),
+		PostgresSchema: strings.TrimSpace(cast.ToString(appOpts.Get(FlagPostgresSchema))),
+	}
+	if len(rv.EventTypes) == 0 {
+		rv.EventTypes = DefaultEventTypes
+	}
+	if len(rv.OutboxDir) == 0 {
+		rv.OutboxDir = DefaultOutboxDir
+	}
+	if !filepath.IsAbs(rv.OutboxDir) {
+		rv.OutboxDir = filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), rv.OutboxDir)
+	}
+	if len(rv.PostgresSchema) == 0 {
+		rv.PostgresSchema = DefaultPostgresSchema
+	}
+	return rv
+}
+
+// IsEnabled returns true if there's a sink to export events to.
+func (c Config) IsEnabled() bool {
+	return len(c.Sink) > 0
+}
+
+// Validate returns an error if this config is invalid.
+func (c Config) Validate() error {
+	var errs []error
+	switch c.Sink {
+	case SinkKafka:
+		if len(c.KafkaRESTURL) == 0 {
+			errs = append(errs, fmt.Errorf("%s is required when %s is %q", FlagKafkaRESTURL, FlagSink, SinkKafka))
+		} else if u, err := url.Parse(c.KafkaRESTURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+			errs = append(errs, fmt.Errorf("invalid %s %q: must be an http or https url", FlagKafkaRESTURL, c.KafkaRESTURL))
+		}
+		if len(c.KafkaTopic) == 0 {
+			errs = append(errs, fmt.Errorf("%s is required when %s is %q", FlagKafkaTopic, FlagSink, SinkKafka))
+		}
+	case SinkPostgres:
+		if len(c.PostgresConn) == 0 {
+			errs = append(errs, fmt.Errorf("%s is required when %s is %q", FlagPostgresConn, FlagSink, SinkPostgres))
+		}
+		if !postgresSchemaRx.MatchString(c.PostgresSchema) {
+			errs = append(errs, fmt.Errorf("invalid %s %q: must match %s", FlagPostgresSchema, c.PostgresSchema, postgresSchemaRx))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("invalid %s %q: must be %q or %q", FlagSink, c.Sink, SinkKafka, SinkPostgres))
+	}
+	for _, eventType := range c.EventTypes {
+		if len(strings.TrimSpace(eventType)) == 0 {
+			errs = append(errs, fmt.Errorf("invalid %s: cannot contain an empty entry", FlagEventTypes))
+			break
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// NewSink creates the sink defined by this config.
+func (c Config) NewSink() (Sink, error) {
+	switch c.Sink {
+	case SinkKafka:
+		return NewKafkaRESTSink(c.KafkaRESTURL, c.KafkaTopic), nil
+	case SinkPostgres:
+		return NewPostgresSink(c.PostgresConn, c.PostgresSchema)
+	default:
+		return nil, fmt.Errorf("unknown %s %q", FlagSink, c.Sink)
+	}
+}
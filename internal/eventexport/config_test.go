@@ -0,0 +1,140 @@
+package eventexport_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+
+	"github.com/provenance-io/provenance/internal/eventexport"
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+func TestConfigFromAppOpts(t *testing.T) {
+	tests := []struct {
+		name    string
+		appOpts simtestutil.AppOptionsMap
+		exp     eventexport.Config
+	}{
+		{
+			name:    "defaults",
+			appOpts: simtestutil.AppOptionsMap{flags.FlagHome: "/pio"},
+			exp: eventexport.Config{
+				EventTypes:     eventexport.DefaultEventTypes,
+				OutboxDir:      "/pio/data/event-export",
+				PostgresSchema: eventexport.DefaultPostgresSchema,
+			},
+		},
+		{
+			name: "everything set",
+			appOpts: simtestutil.AppOptionsMap{
+				flags.FlagHome:                 "/pio",
+				eventexport.FlagSink:           "postgres",
+				eventexport.FlagEventTypes:     []interface{}{"provenance.exchange.", "provenance.marker."},
+				eventexport.FlagOutboxDir:      "/var/outbox",
+				eventexport.FlagStopNodeOnErr:  true,
+				eventexport.FlagKafkaRESTURL:   "http://localhost:8082",
+				eventexport.FlagKafkaTopic:     "events",
+				eventexport.FlagPostgresConn:   "postgres://localhost/pio",
+				eventexport.FlagPostgresSchema: "chain_events",
+			},
+			exp: eventexport.Config{
+				Sink:           eventexport.SinkPostgres,
+				EventTypes:     []string{"provenance.exchange.", "provenance.marker."},
+				OutboxDir:      "/var/outbox",
+				StopNodeOnErr:  true,
+				KafkaRESTURL:   "http://localhost:8082",
+				KafkaTopic:     "events",
+				PostgresConn:   "postgres://localhost/pio",
+				PostgresSchema: "chain_events",
+			},
+		},
+		{
+			name:    "relative outbox dir",
+			appOpts: simtestutil.AppOptionsMap{flags.FlagHome: "/pio", eventexport.FlagOutboxDir: "outbox"},
+			exp: eventexport.Config{
+				EventTypes:     eventexport.DefaultEventTypes,
+				OutboxDir:      "/pio/outbox",
+				PostgresSchema: eventexport.DefaultPostgresSchema,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := eventexport.ConfigFromAppOpts(tc.appOpts)
+			assert.Equal(t, tc.exp, cfg, "ConfigFromAppOpts result")
+		})
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		cfg    eventexport.Config
+		expErr []string
+	}{
+		{
+			name: "kafka",
+			cfg:  eventexport.Config{Sink: "kafka", EventTypes: eventexport.DefaultEventTypes, KafkaRESTURL: "https://kafka:8082", KafkaTopic: "events"},
+		},
+		{
+			name: "postgres",
+			cfg:  eventexport.Config{Sink: "postgres", EventTypes: eventexport.DefaultEventTypes, PostgresConn: "postgres://db/pio", PostgresSchema: "provenance_events"},
+		},
+		{
+			name:   "unknown sink",
+			cfg:    eventexport.Config{Sink: "file"},
+			expErr: []string{`invalid event-export.sink "file": must be "kafka" or "postgres"`},
+		},
+		{
+			name: "kafka without url or topic",
+			cfg:  eventexport.Config{Sink: "kafka"},
+			expErr: []string{
+				`event-export.kafka.rest-url is required when event-export.sink is "kafka"`,
+				`event-export.kafka.topic is required when event-export.sink is "kafka"`,
+			},
+		},
+		{
+			name:   "kafka with bad url",
+			cfg:    eventexport.Config{Sink: "kafka", KafkaRESTURL: "kafka:9092", KafkaTopic: "events"},
+			expErr: []string{`invalid event-export.kafka.rest-url "kafka:9092": must be an http or https url`},
+		},
+		{
+			name: "postgres without conn and bad schema",
+			cfg:  eventexport.Config{Sink: "postgres", PostgresSchema: "events; DROP TABLE x"},
+			expErr: []string{
+				`event-export.postgres.conn is required when event-export.sink is "postgres"`,
+				`invalid event-export.postgres.schema "events; DROP TABLE x"`,
+			},
+		},
+		{
+			name:   "empty event type",
+			cfg:    eventexport.Config{Sink: "kafka", KafkaRESTURL: "http://kafka", KafkaTopic: "events", EventTypes: []string{"provenance.", " "}},
+			expErr: []string{"invalid event-export.event-types: cannot contain an empty entry"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.cfg.Validate()
+			assertions.AssertErrorContents(t, err, tc.expErr, "Validate")
+		})
+	}
+}
+
+func TestConfigNewSink(t *testing.T) {
+	sink, err := eventexport.Config{Sink: "kafka", KafkaRESTURL: "http://kafka", KafkaTopic: "events"}.NewSink()
+	require.NoError(t, err, "NewSink kafka")
+	assert.IsType(t, &eventexport.KafkaRESTSink{}, sink, "NewSink kafka")
+
+	sink, err = eventexport.Config{Sink: "postgres", PostgresConn: "postgres://db/pio", PostgresSchema: "events"}.NewSink()
+	require.NoError(t, err, "NewSink postgres")
+	assert.IsType(t, &eventexport.PostgresSink{}, sink, "NewSink postgres")
+
+	_, err = eventexport.Config{Sink: "other"}.NewSink()
+	assert.EqualError(t, err, `unknown event-export.sink "other"`, "NewSink other")
+}
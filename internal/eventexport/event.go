@@ -0,0 +1,110 @@
+package eventexport
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// BlockEventsTxIndex is the TxIndex of events that weren't emitted by a tx (e.g. from a begin or end blocker).
+const BlockEventsTxIndex = -1
+
+// Event is a typed event emitted during a block.
+type Event struct {
+	// Height is the height of the block that the event was emitted in.
+	Height int64 `json:"height"`
+	// BlockTime is the time of the block that the event was emitted in.
+	BlockTime time.Time `json:"block_time"`
+	// TxIndex is the index of the tx in the block that emitted the event, or BlockEventsTxIndex.
+	TxIndex int `json:"tx_index"`
+	// TxHash is the hash of the tx that emitted the event (empty for block events).
+	TxHash string `json:"tx_hash,omitempty"`
+	// EventIndex is the index of the event among all the events emitted by the tx (or the block).
+	EventIndex int `json:"event_index"`
+	// Type is the event type, e.g. "provenance.exchange.v1.EventOrderCreated".
+	Type string `json:"type"`
+	// Attributes are the event's attributes. Values that aren't json (e.g. from non-typed events) are provided as strings.
+	Attributes map[string]json.RawMessage `json:"attributes"`
+}
+
+// Key returns a string that uniquely identifies this event, e.g. "1234/0/5".
+// Since delivery is at-least-once, consumers can use this to ignore duplicates.
+func (e Event) Key() string {
+	return fmt.Sprintf("%d/%d/%d", e.Height, e.TxIndex, e.EventIndex)
+}
+
+// Block is the exported events of a block.
+type Block struct {
+	// Height is the height of the block.
+	Height int64 `json:"height"`
+	// BlockTime is the time of the block.
+	BlockTime time.Time `json:"block_time"`
+	// Events are the exported events emitted during the block.
+	Events []Event `json:"events"`
+}
+
+// NewBlock creates the Block of the events emitted during a block that have one of the provided type prefixes.
+// The txs and txResults must be in the same order (as they are in a block and its results).
+func NewBlock(height int64, blockTime time.Time, txs [][]byte, txResults []*abci.ExecTxResult, blockEvents []abci.Event, typePrefixes []string) *Block {
+	rv := &Block{Height: height, BlockTime: blockTime.UTC()}
+	for i, res := range txResults {
+		if res == nil {
+			continue
+		}
+		var txHash string
+		if i < len(txs) {
+			txHash = fmt.Sprintf("%X", cmttypes.Tx(txs[i]).Hash())
+		}
+		rv.addEvents(i, txHash, res.Events, typePrefixes)
+	}
+	rv.addEvents(BlockEventsTxIndex, "", blockEvents, typePrefixes)
+	return rv
+}
+
+// addEvents adds the provided events that have one of the provided type prefixes to this block.
+func (b *Block) addEvents(txIndex int, txHash string, events []abci.Event, typePrefixes []string) {
+	for i, event := range events {
+		if !hasAnyPrefix(event.Type, typePrefixes) {
+			continue
+		}
+		b.Events = append(b.Events, Event{
+			Height:     b.Height,
+			BlockTime:  b.BlockTime,
+			TxIndex:    txIndex,
+			TxHash:     txHash,
+			EventIndex: i,
+			Type:       event.Type,
+			Attributes: attributesToJSON(event.Attributes),
+		})
+	}
+}
+
+// hasAnyPrefix returns true if the provided str starts with any of the provided prefixes.
+func hasAnyPrefix(str string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(str, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// attributesToJSON converts the provided event attributes into a map of key to json value.
+// Typed event attribute values are already json. Any other values are converted to json strings.
+func attributesToJSON(attrs []abci.EventAttribute) map[string]json.RawMessage {
+	rv := make(map[string]json.RawMessage, len(attrs))
+	for _, attr := range attrs {
+		if json.Valid([]byte(attr.Value)) {
+			rv[attr.Key] = json.RawMessage(attr.Value)
+			continue
+		}
+		// Marshaling a string can't fail.
+		bz, _ := json.Marshal(attr.Value)
+		rv[attr.Key] = bz
+	}
+	return rv
+}
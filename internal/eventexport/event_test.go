@@ -0,0 +1,80 @@
+package eventexport_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/provenance-io/provenance/internal/eventexport"
+)
+
+func newEvent(eventType string, attrs ...string) abci.Event {
+	rv := abci.Event{Type: eventType}
+	for i := 0; i+1 < len(attrs); i += 2 {
+		rv.Attributes = append(rv.Attributes, abci.EventAttribute{Key: attrs[i], Value: attrs[i+1]})
+	}
+	return rv
+}
+
+func TestNewBlock(t *testing.T) {
+	blockTime := time.Date(2025, 3, 4, 5, 6, 7, 0, time.UTC)
+	txs := [][]byte{[]byte("tx zero"), []byte("tx one")}
+	txResults := []*abci.ExecTxResult{
+		{Events: []abci.Event{
+			newEvent("message", "action", "/provenance.exchange.v1.MsgCreateAskRequest"),
+			newEvent("provenance.exchange.v1.EventOrderCreated", "order_id", `"5"`, "order_type", `"ask"`, "msg_index", "0"),
+		}},
+		{Events: []abci.Event{
+			newEvent("provenance.marker.v1.EventMarkerMint", "amount", `"100"`, "denom", `"mycoin"`),
+			newEvent("transfer", "amount", "100mycoin"),
+		}},
+	}
+	blockEvents := []abci.Event{
+		newEvent("provenance.exchange.v1.EventOrderExpired", "order_id", `"3"`),
+		newEvent("coinbase", "amount", "1nhash"),
+	}
+	hash := func(tx []byte) string {
+		return fmt.Sprintf("%X", cmttypes.Tx(tx).Hash())
+	}
+
+	exp := &eventexport.Block{
+		Height:    12,
+		BlockTime: blockTime,
+		Events: []eventexport.Event{
+			{
+				Height: 12, BlockTime: blockTime, TxIndex: 0, TxHash: hash(txs[0]), EventIndex: 1,
+				Type: "provenance.exchange.v1.EventOrderCreated",
+				Attributes: map[string]json.RawMessage{
+					"order_id": json.RawMessage(`"5"`), "order_type": json.RawMessage(`"ask"`), "msg_index": json.RawMessage("0"),
+				},
+			},
+			{
+				Height: 12, BlockTime: blockTime, TxIndex: 1, TxHash: hash(txs[1]), EventIndex: 0,
+				Type:       "provenance.marker.v1.EventMarkerMint",
+				Attributes: map[string]json.RawMessage{"amount": json.RawMessage(`"100"`), "denom": json.RawMessage(`"mycoin"`)},
+			},
+			{
+				Height: 12, BlockTime: blockTime, TxIndex: eventexport.BlockEventsTxIndex, EventIndex: 0,
+				Type:       "provenance.exchange.v1.EventOrderExpired",
+				Attributes: map[string]json.RawMessage{"order_id": json.RawMessage(`"3"`)},
+			},
+		},
+	}
+
+	block := eventexport.NewBlock(12, blockTime, txs, txResults, blockEvents, eventexport.DefaultEventTypes)
+	assert.Equal(t, exp, block, "NewBlock with default event types")
+
+	block = eventexport.NewBlock(12, blockTime, txs, txResults, blockEvents, []string{"provenance.marker.", "transfer"})
+	if assert.Len(t, block.Events, 2, "NewBlock with marker and transfer event types") {
+		assert.Equal(t, "provenance.marker.v1.EventMarkerMint", block.Events[0].Type, "first event type")
+		assert.Equal(t, "transfer", block.Events[1].Type, "second event type")
+		assert.Equal(t, json.RawMessage(`"100mycoin"`), block.Events[1].Attributes["amount"], "non-json attribute value")
+		assert.Equal(t, "12/1/1", block.Events[1].Key(), "second event key")
+	}
+}
@@ -0,0 +1,200 @@
+package eventexport
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// RetryInterval is how long the exporter waits before trying to deliver blocks again after a failure.
+const RetryInterval = 5 * time.Second
+
+// Sink receives the exported events of each block.
+// Blocks are provided in height order, but a block can be provided more than once (e.g. after a restart).
+type Sink interface {
+	// Send delivers the events of a block to this sink. It must not return until they're durably stored.
+	Send(ctx context.Context, block *Block) error
+}
+
+// Exporter is an ABCIListener that exports the events of each committed block to a sink.
+//
+// Each committed block with events is first written to an outbox directory. A background worker then delivers
+// the blocks in the outbox to the sink (in height order), removing each one once it's been delivered. A block
+// that can't be delivered stays in the outbox to be retried, even across restarts, so delivery is at-least-once.
+type Exporter struct {
+	sink         Sink
+	dir          string
+	typePrefixes []string
+	logger       log.Logger
+
+	// pending is the block being finalized; it's added to the outbox once committed.
+	pending *Block
+	// notify is used to tell the worker that there's a new block in the outbox.
+	notify chan struct{}
+	// flushMtx makes sure only one flush happens at a time.
+	flushMtx sync.Mutex
+}
+
+var _ storetypes.ABCIListener = (*Exporter)(nil)
+
+// NewExporter creates a new Exporter that uses the provided outbox directory, creating it if needed.
+func NewExporter(sink Sink, dir string, typePrefixes []string, logger log.Logger) (*Exporter, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("could not create event export outbox directory: %w", err)
+	}
+	return &Exporter{
+		sink:         sink,
+		dir:          dir,
+		typePrefixes: typePrefixes,
+		logger:       logger.With("module", "event-export"),
+		notify:       make(chan struct{}, 1),
+	}, nil
+}
+
+// ListenFinalizeBlock collects the events of the block being finalized.
+func (e *Exporter) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	e.pending = NewBlock(req.Height, req.Time, req.Txs, res.TxResults, res.Events, e.typePrefixes)
+	return nil
+}
+
+// ListenCommit adds the events of the committed block to the outbox and lets the worker know about them.
+func (e *Exporter) ListenCommit(_ context.Context, _ abci.ResponseCommit, _ []*storetypes.StoreKVPair) error {
+	block := e.pending
+	e.pending = nil
+	if block == nil || len(block.Events) == 0 {
+		return nil
+	}
+	if err := e.writeToOutbox(block); err != nil {
+		return fmt.Errorf("could not add block %d to the event export outbox: %w", block.Height, err)
+	}
+	select {
+	case e.notify <- struct{}{}:
+	default:
+		// The worker already has a notification waiting.
+	}
+	return nil
+}
+
+// Start starts the worker that delivers the blocks in the outbox until the provided context is done.
+// Any blocks left in the outbox (e.g. from before a restart) are delivered first.
+func (e *Exporter) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(RetryInterval)
+		defer ticker.Stop()
+		for {
+			if err := e.Flush(ctx); err != nil {
+				e.logger.Error("Could not deliver exported events, will try again.", "error", err)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-e.notify:
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Flush delivers the blocks in the outbox to the sink in height order.
+// It stops at the first block that can't be delivered so that later blocks aren't delivered before it.
+func (e *Exporter) Flush(ctx context.Context) error {
+	e.flushMtx.Lock()
+	defer e.flushMtx.Unlock()
+
+	heights, err := e.getOutboxHeights()
+	if err != nil {
+		return err
+	}
+	for _, height := range heights {
+		block, err := e.readFromOutbox(height)
+		if err != nil {
+			return err
+		}
+		if err = e.sink.Send(ctx, block); err != nil {
+			return fmt.Errorf("could not send events of block %d: %w", height, err)
+		}
+		if err = os.Remove(e.getOutboxPath(height)); err != nil {
+			return fmt.Errorf("could not remove block %d from the event export outbox: %w", height, err)
+		}
+	}
+	return nil
+}
+
+// getOutboxPath returns the path to the outbox file for the provided block height.
+func (e *Exporter) getOutboxPath(height int64) string {
+	return filepath.Join(e.dir, fmt.Sprintf("block-%d.json", height))
+}
+
+// writeToOutbox writes a block to the outbox. The file is written under a temporary name
+// and then renamed so that a crash can't leave a partial block in the outbox.
+func (e *Exporter) writeToOutbox(block *Block) error {
+	bz, err := json.Marshal(block)
+	if err != nil {
+		return err
+	}
+	path := e.getOutboxPath(block.Height)
+	tmpPath := path + ".tmp"
+	f, err := os.Create(tmpPath)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(bz); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err = f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
+
+// readFromOutbox reads the block with the provided height from the outbox.
+func (e *Exporter) readFromOutbox(height int64) (*Block, error) {
+	bz, err := os.ReadFile(e.getOutboxPath(height))
+	if err != nil {
+		return nil, fmt.Errorf("could not read block %d from the event export outbox: %w", height, err)
+	}
+	var block Block
+	if err = json.Unmarshal(bz, &block); err != nil {
+		return nil, fmt.Errorf("could not parse block %d from the event export outbox: %w", height, err)
+	}
+	return &block, nil
+}
+
+// getOutboxHeights returns the heights of the blocks in the outbox, in ascending order.
+func (e *Exporter) getOutboxHeights() ([]int64, error) {
+	entries, err := os.ReadDir(e.dir)
+	if err != nil {
+		return nil, fmt.Errorf("could not read event export outbox: %w", err)
+	}
+	var rv []int64
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, "block-") || !strings.HasSuffix(name, ".json") {
+			continue
+		}
+		height, err := strconv.ParseInt(strings.TrimSuffix(strings.TrimPrefix(name, "block-"), ".json"), 10, 64)
+		if err != nil {
+			continue
+		}
+		rv = append(rv, height)
+	}
+	sort.Slice(rv, func(i, j int) bool { return rv[i] < rv[j] })
+	return rv, nil
+}
@@ -0,0 +1,101 @@
+package eventexport_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/provenance-io/provenance/internal/eventexport"
+)
+
+// testSink is a Sink that records the blocks it's given and can be made to fail.
+type testSink struct {
+	mtx     sync.Mutex
+	heights []int64
+	err     error
+}
+
+func (s *testSink) Send(_ context.Context, block *eventexport.Block) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.err != nil {
+		return s.err
+	}
+	s.heights = append(s.heights, block.Height)
+	return nil
+}
+
+func (s *testSink) getHeights() []int64 {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return append([]int64{}, s.heights...)
+}
+
+// finalizeAndCommit runs the exporter's listener methods for a block with the provided number of provenance events.
+func finalizeAndCommit(t *testing.T, exporter *eventexport.Exporter, height int64, numEvents int) {
+	t.Helper()
+	req := abci.RequestFinalizeBlock{Height: height, Time: time.Unix(1700000000+height, 0), Txs: [][]byte{[]byte("tx")}}
+	res := abci.ResponseFinalizeBlock{TxResults: []*abci.ExecTxResult{{}}}
+	for i := 0; i < numEvents; i++ {
+		res.TxResults[0].Events = append(res.TxResults[0].Events, newEvent("provenance.test.v1.EventTest", "i", `"x"`))
+	}
+	require.NoError(t, exporter.ListenFinalizeBlock(context.Background(), req, res), "ListenFinalizeBlock(%d)", height)
+	require.NoError(t, exporter.ListenCommit(context.Background(), abci.ResponseCommit{}, nil), "ListenCommit(%d)", height)
+}
+
+func TestExporter(t *testing.T) {
+	dir := t.TempDir()
+	sink := &testSink{err: errors.New("sink is down")}
+	exporter, err := eventexport.NewExporter(sink, dir, eventexport.DefaultEventTypes, log.NewNopLogger())
+	require.NoError(t, err, "NewExporter")
+
+	finalizeAndCommit(t, exporter, 5, 2)
+	finalizeAndCommit(t, exporter, 6, 0)
+	finalizeAndCommit(t, exporter, 7, 1)
+	assert.FileExists(t, filepath.Join(dir, "block-5.json"), "outbox file for block 5")
+	assert.NoFileExists(t, filepath.Join(dir, "block-6.json"), "outbox file for block 6 (no events)")
+	assert.FileExists(t, filepath.Join(dir, "block-7.json"), "outbox file for block 7")
+
+	err = exporter.Flush(context.Background())
+	assert.EqualError(t, err, "could not send events of block 5: sink is down", "Flush while sink is down")
+	assert.Empty(t, sink.heights, "heights sent while sink is down")
+	assert.FileExists(t, filepath.Join(dir, "block-5.json"), "outbox file for block 5 after failed flush")
+
+	// A new exporter using the same outbox (e.g. after a restart) should pick up where the first one left off.
+	sink.err = nil
+	exporter2, err := eventexport.NewExporter(sink, dir, eventexport.DefaultEventTypes, log.NewNopLogger())
+	require.NoError(t, err, "NewExporter after restart")
+	finalizeAndCommit(t, exporter2, 8, 3)
+	require.NoError(t, exporter2.Flush(context.Background()), "Flush after sink is back")
+	assert.Equal(t, []int64{5, 7, 8}, sink.heights, "heights sent after sink is back")
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err, "ReadDir(outbox)")
+	assert.Empty(t, entries, "outbox entries after successful flush")
+}
+
+func TestExporterStart(t *testing.T) {
+	sink := &testSink{}
+	exporter, err := eventexport.NewExporter(sink, t.TempDir(), eventexport.DefaultEventTypes, log.NewNopLogger())
+	require.NoError(t, err, "NewExporter")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	exporter.Start(ctx)
+	finalizeAndCommit(t, exporter, 3, 1)
+
+	assert.Eventually(t, func() bool {
+		return len(sink.getHeights()) == 1
+	}, 5*time.Second, 10*time.Millisecond, "block delivered by the worker")
+}
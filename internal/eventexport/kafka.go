@@ -0,0 +1,97 @@
+package eventexport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// KafkaRESTContentType is the content type used when producing json records through a Kafka REST proxy (v2 API).
+	KafkaRESTContentType = "application/vnd.kafka.json.v2+json"
+	// KafkaRESTTimeout is how long to wait for a Kafka REST proxy to accept a block's events.
+	KafkaRESTTimeout = 30 * time.Second
+)
+
+// KafkaRESTSink is a Sink that produces each event as a record on a Kafka topic using a Kafka REST proxy.
+// Each record's key is the event's Key so that consumers can ignore duplicates.
+type KafkaRESTSink struct {
+	client *http.Client
+	url    string
+}
+
+var _ Sink = (*KafkaRESTSink)(nil)
+
+// kafkaRecord is a record to produce through a Kafka REST proxy.
+type kafkaRecord struct {
+	Key   string `json:"key"`
+	Value Event  `json:"value"`
+}
+
+// kafkaRecords is the body of a request to produce records through a Kafka REST proxy.
+type kafkaRecords struct {
+	Records []kafkaRecord `json:"records"`
+}
+
+// kafkaOffsets is the body of a response from a Kafka REST proxy after producing records.
+// Each record gets an entry which has an error if that record wasn't produced.
+type kafkaOffsets struct {
+	Offsets []struct {
+		Error *string `json:"error"`
+	} `json:"offsets"`
+}
+
+// NewKafkaRESTSink creates a new KafkaRESTSink that sends events to the provided topic through the proxy at the provided url.
+func NewKafkaRESTSink(restURL, topic string) *KafkaRESTSink {
+	return &KafkaRESTSink{
+		client: &http.Client{Timeout: KafkaRESTTimeout},
+		url:    strings.TrimSuffix(restURL, "/") + "/topics/" + url.PathEscape(topic),
+	}
+}
+
+// Send produces the events of a block to the Kafka topic.
+func (s *KafkaRESTSink) Send(ctx context.Context, block *Block) error {
+	if len(block.Events) == 0 {
+		return nil
+	}
+	body := kafkaRecords{Records: make([]kafkaRecord, len(block.Events))}
+	for i, event := range block.Events {
+		body.Records[i] = kafkaRecord{Key: event.Key(), Value: event}
+	}
+	bz, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(bz))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", KafkaRESTContentType)
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("kafka rest proxy returned %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+
+	var offsets kafkaOffsets
+	if err = json.NewDecoder(resp.Body).Decode(&offsets); err != nil {
+		return fmt.Errorf("could not parse kafka rest proxy response: %w", err)
+	}
+	for i, offset := range offsets.Offsets {
+		if offset.Error != nil && len(*offset.Error) > 0 {
+			return fmt.Errorf("kafka rest proxy could not produce record %d of block %d: %s", i, block.Height, *offset.Error)
+		}
+	}
+	return nil
+}
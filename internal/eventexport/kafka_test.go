@@ -0,0 +1,68 @@
+package eventexport_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/provenance-io/provenance/internal/eventexport"
+)
+
+func TestKafkaRESTSink(t *testing.T) {
+	blockTime := time.Date(2025, 3, 4, 5, 6, 7, 0, time.UTC)
+	block := &eventexport.Block{
+		Height:    9,
+		BlockTime: blockTime,
+		Events: []eventexport.Event{
+			{
+				Height: 9, BlockTime: blockTime, TxIndex: 0, TxHash: "ABCD", EventIndex: 2,
+				Type:       "provenance.marker.v1.EventMarkerMint",
+				Attributes: map[string]json.RawMessage{"denom": json.RawMessage(`"mycoin"`)},
+			},
+		},
+	}
+
+	var gotPath, gotContentType, gotBody string
+	respStatus := http.StatusOK
+	respBody := `{"offsets":[{"partition":0,"offset":12,"error_code":null,"error":null}]}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotContentType = r.Header.Get("Content-Type")
+		bz, _ := io.ReadAll(r.Body)
+		gotBody = string(bz)
+		w.WriteHeader(respStatus)
+		_, _ = w.Write([]byte(respBody))
+	}))
+	defer server.Close()
+
+	sink := eventexport.NewKafkaRESTSink(server.URL+"/", "pio-events")
+
+	err := sink.Send(context.Background(), block)
+	require.NoError(t, err, "Send")
+	assert.Equal(t, "/topics/pio-events", gotPath, "request path")
+	assert.Equal(t, eventexport.KafkaRESTContentType, gotContentType, "request content type")
+	expBody := `{"records":[{"key":"9/0/2","value":{"height":9,"block_time":"2025-03-04T05:06:07Z","tx_index":0,` +
+		`"tx_hash":"ABCD","event_index":2,"type":"provenance.marker.v1.EventMarkerMint","attributes":{"denom":"mycoin"}}}]}`
+	assert.JSONEq(t, expBody, gotBody, "request body")
+
+	respBody = `{"offsets":[{"partition":null,"offset":null,"error_code":50002,"error":"topic is read only"}]}`
+	err = sink.Send(context.Background(), block)
+	assert.EqualError(t, err, "kafka rest proxy could not produce record 0 of block 9: topic is read only", "Send with record error")
+
+	respStatus = http.StatusNotFound
+	respBody = `{"error_code":40401,"message":"Topic not found."}`
+	err = sink.Send(context.Background(), block)
+	assert.EqualError(t, err, `kafka rest proxy returned 404 Not Found: {"error_code":40401,"message":"Topic not found."}`, "Send with error status")
+
+	gotPath = ""
+	err = sink.Send(context.Background(), &eventexport.Block{Height: 10})
+	assert.NoError(t, err, "Send without events")
+	assert.Empty(t, gotPath, "request path after Send without events")
+}
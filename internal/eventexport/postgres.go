@@ -0,0 +1,124 @@
+package eventexport
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/lib/pq"
+)
+
+// PostgresEventsTable is the name of the table (in the configured schema) that events are written to.
+const PostgresEventsTable = "events"
+
+// PostgresSink is a Sink that writes each event as a row in a table in a Postgres schema.
+// The schema and table are created if they don't exist yet.
+// Events are keyed on height, tx_index, and event_index, so a block that's sent again doesn't create duplicate rows.
+// It is not safe for concurrent use.
+type PostgresSink struct {
+	db     *sql.DB
+	schema string
+	// isSetup is whether the schema and table have been created.
+	isSetup bool
+}
+
+var _ Sink = (*PostgresSink)(nil)
+
+// NewPostgresSink creates a new PostgresSink that writes to the provided schema of the database with the provided connection string.
+// The connection isn't used until the first block is sent.
+func NewPostgresSink(conn, schema string) (*PostgresSink, error) {
+	db, err := sql.Open("postgres", conn)
+	if err != nil {
+		return nil, fmt.Errorf("could not open postgres connection: %w", err)
+	}
+	return &PostgresSink{db: db, schema: schema}, nil
+}
+
+// tableName returns the quoted name of the events table.
+func (s *PostgresSink) tableName() string {
+	return pq.QuoteIdentifier(s.schema) + "." + pq.QuoteIdentifier(PostgresEventsTable)
+}
+
+// setupStatements returns the statements that create the schema and events table.
+func (s *PostgresSink) setupStatements() []string {
+	return []string{
+		"CREATE SCHEMA IF NOT EXISTS " + pq.QuoteIdentifier(s.schema),
+		"CREATE TABLE IF NOT EXISTS " + s.tableName() + ` (
+	height      BIGINT      NOT NULL,
+	tx_index    INTEGER     NOT NULL,
+	event_index INTEGER     NOT NULL,
+	block_time  TIMESTAMPTZ NOT NULL,
+	tx_hash     TEXT        NOT NULL,
+	type        TEXT        NOT NULL,
+	attributes  JSONB       NOT NULL,
+	PRIMARY KEY (height, tx_index, event_index)
+)`,
+		"CREATE INDEX IF NOT EXISTS " + pq.QuoteIdentifier(PostgresEventsTable+"_type_height") +
+			" ON " + s.tableName() + " (type, height)",
+	}
+}
+
+// insertStatement returns the statement that adds an event to the events table.
+func (s *PostgresSink) insertStatement() string {
+	return "INSERT INTO " + s.tableName() +
+		" (height, tx_index, event_index, block_time, tx_hash, type, attributes) VALUES ($1, $2, $3, $4, $5, $6, $7)" +
+		" ON CONFLICT (height, tx_index, event_index) DO NOTHING"
+}
+
+// setup creates the schema and events table if they don't exist yet.
+func (s *PostgresSink) setup(ctx context.Context) error {
+	if s.isSetup {
+		return nil
+	}
+	for _, stmt := range s.setupStatements() {
+		if _, err := s.db.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("could not set up postgres schema %q: %w", s.schema, err)
+		}
+	}
+	s.isSetup = true
+	return nil
+}
+
+// Send writes the events of a block to the events table in a single transaction.
+func (s *PostgresSink) Send(ctx context.Context, block *Block) (err error) {
+	if len(block.Events) == 0 {
+		return nil
+	}
+	if err = s.setup(ctx); err != nil {
+		return err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	stmt, err := tx.PrepareContext(ctx, s.insertStatement())
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, event := range block.Events {
+		attrs, merr := json.Marshal(event.Attributes)
+		if merr != nil {
+			return merr
+		}
+		if _, err = stmt.ExecContext(ctx, event.Height, event.TxIndex, event.EventIndex, event.BlockTime,
+			event.TxHash, event.Type, string(attrs)); err != nil {
+			return fmt.Errorf("could not insert event %s: %w", event.Key(), err)
+		}
+	}
+	return tx.Commit()
+}
+
+// Close closes the database connection.
+func (s *PostgresSink) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,36 @@
+package eventexport
+
+import (
+	"context"
+	"fmt"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+)
+
+// BlockFetcher is the part of a CometBFT RPC client needed to replay the events of past blocks.
+type BlockFetcher interface {
+	Block(ctx context.Context, height *int64) (*coretypes.ResultBlock, error)
+	BlockResults(ctx context.Context, height *int64) (*coretypes.ResultBlockResults, error)
+}
+
+// FetchBlock gets the events of a past block that have one of the provided type prefixes.
+// The node being queried must still have the block and its results.
+func FetchBlock(ctx context.Context, fetcher BlockFetcher, height int64, typePrefixes []string) (*Block, error) {
+	block, err := fetcher.Block(ctx, &height)
+	if err != nil {
+		return nil, fmt.Errorf("could not get block %d: %w", height, err)
+	}
+	if block == nil || block.Block == nil {
+		return nil, fmt.Errorf("block %d not found", height)
+	}
+	results, err := fetcher.BlockResults(ctx, &height)
+	if err != nil {
+		return nil, fmt.Errorf("could not get results of block %d: %w", height, err)
+	}
+
+	txs := make([][]byte, len(block.Block.Data.Txs))
+	for i, tx := range block.Block.Data.Txs {
+		txs[i] = tx
+	}
+	return NewBlock(height, block.Block.Header.Time, txs, results.TxsResults, results.FinalizeBlockEvents, typePrefixes), nil
+}
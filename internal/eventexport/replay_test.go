@@ -0,0 +1,59 @@
+package eventexport_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/provenance-io/provenance/internal/eventexport"
+)
+
+// testFetcher is a BlockFetcher with a single block.
+type testFetcher struct {
+	block   *coretypes.ResultBlock
+	results *coretypes.ResultBlockResults
+	err     error
+}
+
+func (f testFetcher) Block(_ context.Context, _ *int64) (*coretypes.ResultBlock, error) {
+	return f.block, f.err
+}
+
+func (f testFetcher) BlockResults(_ context.Context, _ *int64) (*coretypes.ResultBlockResults, error) {
+	return f.results, f.err
+}
+
+func TestFetchBlock(t *testing.T) {
+	blockTime := time.Date(2025, 3, 4, 5, 6, 7, 0, time.UTC)
+	txs := [][]byte{[]byte("tx zero")}
+	txResults := []*abci.ExecTxResult{{Events: []abci.Event{newEvent("provenance.exchange.v1.EventOrderCreated", "order_id", `"1"`)}}}
+	blockEvents := []abci.Event{newEvent("provenance.exchange.v1.EventOrderExpired", "order_id", `"2"`)}
+
+	fetcher := testFetcher{
+		block: &coretypes.ResultBlock{Block: &cmttypes.Block{
+			Header: cmttypes.Header{Height: 4, Time: blockTime},
+			Data:   cmttypes.Data{Txs: cmttypes.Txs{txs[0]}},
+		}},
+		results: &coretypes.ResultBlockResults{Height: 4, TxsResults: txResults, FinalizeBlockEvents: blockEvents},
+	}
+
+	block, err := eventexport.FetchBlock(context.Background(), fetcher, 4, eventexport.DefaultEventTypes)
+	require.NoError(t, err, "FetchBlock")
+	exp := eventexport.NewBlock(4, blockTime, txs, txResults, blockEvents, eventexport.DefaultEventTypes)
+	assert.Equal(t, exp, block, "FetchBlock result")
+	assert.Len(t, block.Events, 2, "FetchBlock result events")
+
+	_, err = eventexport.FetchBlock(context.Background(), testFetcher{err: errors.New("pruned")}, 4, eventexport.DefaultEventTypes)
+	assert.EqualError(t, err, "could not get block 4: pruned", "FetchBlock with error")
+
+	_, err = eventexport.FetchBlock(context.Background(), testFetcher{block: &coretypes.ResultBlock{}}, 4, eventexport.DefaultEventTypes)
+	assert.EqualError(t, err, "block 4 not found", "FetchBlock without a block")
+}
@@ -0,0 +1,233 @@
+package fieldmask
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/cosmos/gogoproto/proto"
+	gogotypes "github.com/cosmos/gogoproto/types"
+)
+
+// Mask is a field mask that has been validated against a message type and can be applied to messages of that type.
+//
+// The paths of a field mask use the proto field names (e.g. "market_details.name"). A path can only traverse
+// message fields; repeated and map fields can only be the last part of a path. Members of a oneof are
+// identified by their own field names (not the name of the oneof).
+//
+// A nil *Mask keeps all fields.
+type Mask struct {
+	msgType reflect.Type
+	root    tree
+}
+
+// tree is a node of a parsed field mask. A nil tree means the whole field is kept.
+type tree map[string]tree
+
+// add adds the provided path (split on the dots) to this tree.
+func (t tree) add(parts []string) {
+	node := t
+	for i, part := range parts {
+		sub, known := node[part]
+		if known && sub == nil {
+			// The whole field is already being kept, so this path is redundant.
+			return
+		}
+		if i == len(parts)-1 {
+			node[part] = nil
+			return
+		}
+		if !known {
+			sub = tree{}
+			node[part] = sub
+		}
+		node = sub
+	}
+}
+
+// Parse validates the provided field mask against the type of the template message and returns a Mask for it.
+// If the field mask is nil or has no paths, nil is returned (without an error), which keeps all fields.
+func Parse(fieldMask *gogotypes.FieldMask, template proto.Message) (*Mask, error) {
+	if fieldMask == nil || len(fieldMask.Paths) == 0 {
+		return nil, nil
+	}
+
+	msgType := reflect.TypeOf(template)
+	if msgType == nil || msgType.Kind() != reflect.Pointer || msgType.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("cannot apply a field mask to a %T", template)
+	}
+
+	root := tree{}
+	for _, path := range fieldMask.Paths {
+		if len(path) == 0 {
+			return nil, errors.New("field mask paths cannot be empty")
+		}
+		parts := strings.Split(path, ".")
+		for _, part := range parts {
+			if len(part) == 0 {
+				return nil, fmt.Errorf("invalid field mask path %q", path)
+			}
+		}
+		root.add(parts)
+	}
+
+	if err := validate(root, msgType.Elem(), ""); err != nil {
+		return nil, err
+	}
+	return &Mask{msgType: msgType, root: root}, nil
+}
+
+// validate returns an error if the provided tree has a field that isn't in the struct type or
+// traverses a field that isn't a message.
+func validate(t tree, structType reflect.Type, pathPrefix string) error {
+	fields := getFields(structType)
+	for name, sub := range t {
+		f, ok := fields[name]
+		if !ok {
+			return fmt.Errorf("unknown field %q", pathPrefix+name)
+		}
+		if sub == nil {
+			continue
+		}
+		fieldType := f.valueType(structType)
+		if fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		if fieldType.Kind() != reflect.Struct {
+			return fmt.Errorf("field %q is not a message and cannot have sub-fields", pathPrefix+name)
+		}
+		if err := validate(sub, fieldType, pathPrefix+name+"."); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Apply clears all the fields of the provided message that are not in this mask.
+// The message must have the same type as the template that was provided to Parse.
+// If this mask is nil, the message is left unchanged.
+func (m *Mask) Apply(msg proto.Message) {
+	if m == nil {
+		return
+	}
+	v := reflect.ValueOf(msg)
+	if v.Type() != m.msgType {
+		panic(fmt.Errorf("cannot apply field mask for %s to a %T", m.msgType, msg))
+	}
+	if v.IsNil() {
+		return
+	}
+	apply(m.root, v.Elem())
+}
+
+// apply clears all the fields of the provided struct value that are not in the provided tree.
+func apply(t tree, v reflect.Value) {
+	for name, f := range getFields(v.Type()) {
+		sub, keep := t[name]
+		fv := v.Field(f.index)
+
+		if f.wrapper != nil {
+			// It's a oneof member. Only do something if it's the one that's set.
+			if fv.IsNil() || fv.Elem().Type() != f.wrapper {
+				continue
+			}
+			if !keep {
+				fv.SetZero()
+				continue
+			}
+			if sub != nil {
+				applyNested(sub, fv.Elem().Elem().Field(0))
+			}
+			continue
+		}
+
+		if !keep {
+			fv.SetZero()
+			continue
+		}
+		if sub != nil {
+			applyNested(sub, fv)
+		}
+	}
+}
+
+// applyNested applies the provided tree to the provided message field value.
+func applyNested(t tree, fv reflect.Value) {
+	if fv.Kind() == reflect.Pointer {
+		if fv.IsNil() {
+			return
+		}
+		fv = fv.Elem()
+	}
+	apply(t, fv)
+}
+
+// field has information about a proto field of a struct.
+type field struct {
+	// index is the index of the struct field that holds the value.
+	index int
+	// wrapper is the oneof wrapper type (a pointer) if this field is a oneof member, or nil otherwise.
+	wrapper reflect.Type
+}
+
+// valueType returns the type of this field's value in the provided struct type.
+func (f field) valueType(structType reflect.Type) reflect.Type {
+	if f.wrapper != nil {
+		return f.wrapper.Elem().Field(0).Type
+	}
+	return structType.Field(f.index).Type
+}
+
+// oneofWrappersMsg is implemented by generated messages that have a oneof.
+type oneofWrappersMsg interface {
+	XXX_OneofWrappers() []interface{}
+}
+
+// getFields gets the proto fields of the provided struct type, keyed by their proto field names.
+func getFields(structType reflect.Type) map[string]field {
+	rv := make(map[string]field)
+	var oneofs []int
+	for i := 0; i < structType.NumField(); i++ {
+		sf := structType.Field(i)
+		if _, isOneof := sf.Tag.Lookup("protobuf_oneof"); isOneof {
+			oneofs = append(oneofs, i)
+			continue
+		}
+		if name := getProtoName(sf.Tag); len(name) > 0 {
+			rv[name] = field{index: i}
+		}
+	}
+
+	if len(oneofs) == 0 {
+		return rv
+	}
+	msg, ok := reflect.New(structType).Interface().(oneofWrappersMsg)
+	if !ok {
+		return rv
+	}
+	for _, wrapper := range msg.XXX_OneofWrappers() {
+		wrapperType := reflect.TypeOf(wrapper)
+		name := getProtoName(wrapperType.Elem().Field(0).Tag)
+		if len(name) == 0 {
+			continue
+		}
+		for _, i := range oneofs {
+			if wrapperType.Implements(structType.Field(i).Type) {
+				rv[name] = field{index: i, wrapper: wrapperType}
+				break
+			}
+		}
+	}
+	return rv
+}
+
+// getProtoName gets the proto field name from the provided struct tag, e.g. `protobuf:"bytes,1,opt,name=foo"` => "foo".
+func getProtoName(tag reflect.StructTag) string {
+	for _, part := range strings.Split(tag.Get("protobuf"), ",") {
+		if name, found := strings.CutPrefix(part, "name="); found {
+			return name
+		}
+	}
+	return ""
+}
@@ -0,0 +1,163 @@
+package fieldmask_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gogotypes "github.com/cosmos/gogoproto/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/internal/fieldmask"
+	"github.com/provenance-io/provenance/testutil/assertions"
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name   string
+		paths  []string
+		expNil bool
+		expErr string
+	}{
+		{name: "nil paths", paths: nil, expNil: true},
+		{name: "empty paths", paths: []string{}, expNil: true},
+		{name: "top-level field", paths: []string{"order_id"}},
+		{name: "oneof member", paths: []string{"ask_order"}},
+		{name: "field in oneof member", paths: []string{"bid_order.buyer", "ask_order.seller"}},
+		{name: "nested non-nullable field", paths: []string{"ask_order.assets.denom"}},
+		{name: "repeated field", paths: []string{"bid_order.buyer_settlement_fees"}},
+		{name: "empty path", paths: []string{"order_id", ""}, expErr: "field mask paths cannot be empty"},
+		{name: "empty part", paths: []string{"ask_order..seller"}, expErr: `invalid field mask path "ask_order..seller"`},
+		{name: "unknown field", paths: []string{"nope"}, expErr: `unknown field "nope"`},
+		{name: "oneof name", paths: []string{"order"}, expErr: `unknown field "order"`},
+		{name: "unknown nested field", paths: []string{"ask_order.nope"}, expErr: `unknown field "ask_order.nope"`},
+		{
+			name:   "traverses a scalar",
+			paths:  []string{"ask_order.seller.x"},
+			expErr: `field "ask_order.seller" is not a message and cannot have sub-fields`,
+		},
+		{
+			name:   "traverses a repeated field",
+			paths:  []string{"bid_order.buyer_settlement_fees.denom"},
+			expErr: `field "bid_order.buyer_settlement_fees" is not a message and cannot have sub-fields`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var mask *gogotypes.FieldMask
+			if tc.paths != nil {
+				mask = &gogotypes.FieldMask{Paths: tc.paths}
+			}
+			actual, err := fieldmask.Parse(mask, &exchange.Order{})
+			assertions.AssertErrorValue(t, err, tc.expErr, "Parse error")
+			if tc.expNil || len(tc.expErr) > 0 {
+				assert.Nil(t, actual, "Parse result")
+			} else {
+				assert.NotNil(t, actual, "Parse result")
+			}
+		})
+	}
+}
+
+func TestMaskApply(t *testing.T) {
+	coin := func(amount int64, denom string) sdk.Coin {
+		return sdk.Coin{Denom: denom, Amount: sdkmath.NewInt(amount)}
+	}
+	newAsk := func() *exchange.Order {
+		return exchange.NewOrder(3).WithAsk(&exchange.AskOrder{
+			MarketId:                5,
+			Seller:                  "seller",
+			Assets:                  coin(10, "apple"),
+			Price:                   coin(20, "plum"),
+			SellerSettlementFlatFee: &sdk.Coin{Denom: "fig", Amount: sdkmath.NewInt(1)},
+			AllowPartial:            true,
+			ExternalId:              "ext",
+		})
+	}
+	newBid := func() *exchange.Order {
+		return exchange.NewOrder(4).WithBid(&exchange.BidOrder{
+			MarketId:            6,
+			Buyer:               "buyer",
+			Assets:              coin(11, "apple"),
+			Price:               coin(21, "plum"),
+			BuyerSettlementFees: sdk.Coins{coin(2, "fig")},
+			AllowPartial:        true,
+			ExternalId:          "ext",
+		})
+	}
+
+	tests := []struct {
+		name  string
+		paths []string
+		order *exchange.Order
+		exp   *exchange.Order
+	}{
+		{
+			name:  "no paths",
+			order: newAsk(),
+			exp:   newAsk(),
+		},
+		{
+			name:  "just the order id",
+			paths: []string{"order_id"},
+			order: newAsk(),
+			exp:   &exchange.Order{OrderId: 3},
+		},
+		{
+			name:  "whole oneof member that is set",
+			paths: []string{"ask_order"},
+			order: newAsk(),
+			exp: func() *exchange.Order {
+				rv := newAsk()
+				rv.OrderId = 0
+				return rv
+			}(),
+		},
+		{
+			name:  "whole oneof member that is not set",
+			paths: []string{"ask_order", "order_id"},
+			order: newBid(),
+			exp:   &exchange.Order{OrderId: 4},
+		},
+		{
+			name:  "fields of both oneof members",
+			paths: []string{"ask_order.seller", "bid_order.buyer", "bid_order.assets.denom"},
+			order: newBid(),
+			exp:   exchange.NewOrder(0).WithBid(&exchange.BidOrder{Buyer: "buyer", Assets: sdk.Coin{Denom: "apple"}}),
+		},
+		{
+			name:  "nil pointer field",
+			paths: []string{"ask_order.seller_settlement_flat_fee.denom"},
+			order: exchange.NewOrder(3).WithAsk(&exchange.AskOrder{Seller: "seller"}),
+			exp:   exchange.NewOrder(0).WithAsk(&exchange.AskOrder{}),
+		},
+		{
+			name:  "sub-field and whole field",
+			paths: []string{"bid_order.buyer", "bid_order", "order_id"},
+			order: newBid(),
+			exp:   newBid(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mask, err := fieldmask.Parse(&gogotypes.FieldMask{Paths: tc.paths}, &exchange.Order{})
+			require.NoError(t, err, "Parse")
+			require.NotPanics(t, func() { mask.Apply(tc.order) }, "Apply")
+			assert.Equal(t, tc.exp, tc.order, "order after Apply")
+		})
+	}
+}
+
+func TestMaskApplyWrongType(t *testing.T) {
+	mask, err := fieldmask.Parse(&gogotypes.FieldMask{Paths: []string{"order_id"}}, &exchange.Order{})
+	require.NoError(t, err, "Parse")
+	assert.PanicsWithError(t, "cannot apply field mask for *exchange.Order to a *exchange.Market",
+		func() { mask.Apply(&exchange.Market{}) }, "Apply")
+}
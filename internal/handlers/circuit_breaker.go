@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+)
+
+// PioCircuitBreaker is a baseapp.CircuitBreaker that treats a msg type url as disabled if it has been
+// disabled either with or without its leading slash.
+//
+// The circuit module stores the msg type urls exactly as provided when the breaker is tripped, but the
+// lookup always uses the leading slash (e.g. "/provenance.marker.v1.MsgTransferRequest"). Without this,
+// tripping "provenance.marker.v1.MsgTransferRequest" (as the circuit CLI examples do) has no effect.
+type PioCircuitBreaker struct {
+	breaker baseapp.CircuitBreaker
+}
+
+var _ baseapp.CircuitBreaker = PioCircuitBreaker{}
+
+// NewPioCircuitBreaker wraps the provided circuit breaker so that msg type urls are checked with and without a leading slash.
+func NewPioCircuitBreaker(breaker baseapp.CircuitBreaker) PioCircuitBreaker {
+	return PioCircuitBreaker{breaker: breaker}
+}
+
+// IsAllowed returns true if the provided msg type url has not been disabled (with or without its leading slash).
+func (cb PioCircuitBreaker) IsAllowed(ctx context.Context, typeURL string) (bool, error) {
+	allowed, err := cb.breaker.IsAllowed(ctx, typeURL)
+	if err != nil || !allowed {
+		return false, err
+	}
+
+	alt := "/" + typeURL
+	if strings.HasPrefix(typeURL, "/") {
+		alt = typeURL[1:]
+	}
+	return cb.breaker.IsAllowed(ctx, alt)
+}
@@ -0,0 +1,108 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	piosimapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/internal/handlers"
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+// mockCircuitBreaker is a baseapp.CircuitBreaker with a set of disabled msg type urls.
+type mockCircuitBreaker struct {
+	disabled map[string]bool
+	err      error
+}
+
+func (cb mockCircuitBreaker) IsAllowed(_ context.Context, typeURL string) (bool, error) {
+	if cb.err != nil {
+		return false, cb.err
+	}
+	return !cb.disabled[typeURL], nil
+}
+
+func TestPioCircuitBreakerIsAllowed(t *testing.T) {
+	transferURL := "/provenance.marker.v1.MsgTransferRequest"
+
+	tests := []struct {
+		name     string
+		breaker  mockCircuitBreaker
+		typeURL  string
+		expected bool
+		expErr   []string
+	}{
+		{
+			name:     "nothing disabled",
+			breaker:  mockCircuitBreaker{},
+			typeURL:  transferURL,
+			expected: true,
+		},
+		{
+			name:     "other msg disabled",
+			breaker:  mockCircuitBreaker{disabled: map[string]bool{"/cosmos.bank.v1beta1.MsgSend": true}},
+			typeURL:  transferURL,
+			expected: true,
+		},
+		{
+			name:     "disabled with slash",
+			breaker:  mockCircuitBreaker{disabled: map[string]bool{transferURL: true}},
+			typeURL:  transferURL,
+			expected: false,
+		},
+		{
+			name:     "disabled without slash",
+			breaker:  mockCircuitBreaker{disabled: map[string]bool{transferURL[1:]: true}},
+			typeURL:  transferURL,
+			expected: false,
+		},
+		{
+			name:     "checked without slash: disabled with slash",
+			breaker:  mockCircuitBreaker{disabled: map[string]bool{transferURL: true}},
+			typeURL:  transferURL[1:],
+			expected: false,
+		},
+		{
+			name:    "error",
+			breaker: mockCircuitBreaker{err: errors.New("store is sad")},
+			typeURL: transferURL,
+			expErr:  []string{"store is sad"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cb := handlers.NewPioCircuitBreaker(tc.breaker)
+			actual, err := cb.IsAllowed(context.Background(), tc.typeURL)
+			assertions.AssertErrorContents(t, err, tc.expErr, "IsAllowed error")
+			assert.Equal(t, tc.expected, actual, "IsAllowed result")
+		})
+	}
+}
+
+func TestMsgServiceRouterCircuitBreaker(t *testing.T) {
+	encCfg := piosimapp.MakeTestEncodingConfig(t)
+	testdata.RegisterInterfaces(encCfg.InterfaceRegistry)
+	router := handlers.NewPioMsgServiceRouter(encCfg.TxConfig.TxDecoder())
+	router.SetInterfaceRegistry(encCfg.InterfaceRegistry)
+	router.SetCircuit(handlers.NewPioCircuitBreaker(mockCircuitBreaker{disabled: map[string]bool{"testpb.MsgCreateDog": true}}))
+	testdata.RegisterMsgServer(router, testdata.MsgServerImpl{})
+
+	msg := &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}}
+	handler := router.Handler(msg)
+	require.NotNil(t, handler, "Handler(MsgCreateDog)")
+
+	// The msg fees keeper isn't set, so this would panic if the circuit breaker weren't checked first.
+	var err error
+	require.NotPanics(t, func() {
+		_, err = handler(sdk.Context{}, msg)
+	}, "handler(MsgCreateDog)")
+	assertions.AssertErrorValue(t, err, "circuit breaker disables execution of this message: /testpb.MsgCreateDog", "handler(MsgCreateDog)")
+}
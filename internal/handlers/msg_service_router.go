@@ -156,6 +156,19 @@ func (msr *PioMsgServiceRouter) registerMsgServiceHandler(sd *grpc.ServiceDesc,
 	}
 
 	msr.routes[requestTypeName] = func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error) {
+		// Check the circuit breaker first so that a disabled msg fails with that reason instead of a fee problem.
+		if msr.circuitBreaker != nil {
+			msgURL := sdk.MsgTypeURL(req)
+			isAllowed, err := msr.circuitBreaker.IsAllowed(ctx, msgURL)
+			if err != nil {
+				return nil, err
+			}
+
+			if !isAllowed {
+				return nil, fmt.Errorf("circuit breaker disables execution of this message: %s", msgURL)
+			}
+		}
+
 		// provenance specific modification to msg service router that handles x/msgfee distribution
 		err := msr.consumeMsgFees(ctx, req)
 		if err != nil {
@@ -173,20 +186,6 @@ func (msr *PioMsgServiceRouter) registerMsgServiceHandler(sd *grpc.ServiceDesc,
 			return nil, err
 		}
 
-		if msr.circuitBreaker != nil {
-			msgURL := sdk.MsgTypeURL(req)
-
-			var isAllowed bool
-			isAllowed, err = msr.circuitBreaker.IsAllowed(ctx, msgURL)
-			if err != nil {
-				return nil, err
-			}
-
-			if !isAllowed {
-				return nil, fmt.Errorf("circuit breaker disables execution of this message: %s", msgURL)
-			}
-		}
-
 		// Call the method handler from the service description with the handler object.
 		// We don't do any decoding here because the decoding was already done.
 		res, err := methodHandler(handler, ctx, noopDecoder, interceptor)
@@ -264,8 +263,8 @@ func (msr *PioMsgServiceRouter) consumeMsgFees(ctx sdk.Context, req sdk.Msg) err
 	if !feeDist.TotalAdditionalFees.IsZero() {
 		if !feeGasMeter.IsSimulate() {
 			err = antewrapper.EnsureSufficientFloorAndMsgFees(ctx,
-				feeTx.GetFee(), msr.msgFeesKeeper.GetFloorGasPrice(ctx),
-				ctx.GasMeter().Limit(), feeGasMeter.FeeConsumed().Add(feeDist.TotalAdditionalFees...))
+				feeTx.GetFee(), feeGasMeter.BaseFeeConsumed(),
+				feeGasMeter.FeeConsumed().Add(feeDist.TotalAdditionalFees...))
 			if err != nil {
 				return err
 			}
@@ -0,0 +1,116 @@
+// Package loadgen drives a configurable mix of provenance txs against a chain at a controlled rate
+// and reports the latencies seen. It's used by the testnet loadgen command for capacity planning.
+package loadgen
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// KindOrder creates an ask order and a matching bid order in the configured market.
+	KindOrder = "order"
+	// KindSettle settles the oldest ask and bid orders (in the configured market) owned by the load account.
+	KindSettle = "settle"
+	// KindMint mints some of the configured marker denom.
+	KindMint = "mint"
+	// KindAttribute writes an attribute (with a unique value) to the load account.
+	KindAttribute = "attribute"
+)
+
+// AllKinds are all of the known kinds of txs that can be generated.
+var AllKinds = []string{KindOrder, KindSettle, KindMint, KindAttribute}
+
+// DefaultMix is the mix of txs used when none is provided.
+const DefaultMix = "order=5,settle=1,mint=2,attribute=2"
+
+// Mix is a weighted set of tx kinds.
+type Mix struct {
+	kinds   []string
+	weights []uint32
+	total   uint32
+}
+
+// ParseMix parses a mix string of the form "<kind>=<weight>[,<kind>=<weight>...]".
+// A kind without a weight (e.g. "mint") has a weight of 1. Entries with a weight of zero are ignored.
+func ParseMix(str string) (*Mix, error) {
+	rv := &Mix{}
+	seen := make(map[string]bool)
+	for _, entry := range strings.Split(str, ",") {
+		entry = strings.TrimSpace(entry)
+		if len(entry) == 0 {
+			continue
+		}
+		kind, weightStr, hasWeight := strings.Cut(entry, "=")
+		kind = strings.ToLower(strings.TrimSpace(kind))
+		if !isKnownKind(kind) {
+			return nil, fmt.Errorf("unknown tx kind %q: must be one of %s", kind, strings.Join(AllKinds, ", "))
+		}
+		if seen[kind] {
+			return nil, fmt.Errorf("duplicate tx kind %q", kind)
+		}
+		seen[kind] = true
+
+		weight := uint64(1)
+		if hasWeight {
+			var err error
+			weight, err = strconv.ParseUint(strings.TrimSpace(weightStr), 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("invalid weight %q for tx kind %q: %w", weightStr, kind, err)
+			}
+		}
+		if weight == 0 {
+			continue
+		}
+		rv.kinds = append(rv.kinds, kind)
+		rv.weights = append(rv.weights, uint32(weight))
+		rv.total += uint32(weight)
+	}
+	if rv.total == 0 {
+		return nil, errors.New("tx mix must have at least one kind with a positive weight")
+	}
+	return rv, nil
+}
+
+// Kinds returns the kinds in this mix, sorted.
+func (m *Mix) Kinds() []string {
+	rv := make([]string, len(m.kinds))
+	copy(rv, m.kinds)
+	sort.Strings(rv)
+	return rv
+}
+
+// Pick randomly chooses a kind from this mix, proportionally to the kinds' weights.
+func (m *Mix) Pick(r *rand.Rand) string {
+	n := uint32(r.Int63n(int64(m.total)))
+	for i, weight := range m.weights {
+		if n < weight {
+			return m.kinds[i]
+		}
+		n -= weight
+	}
+	return m.kinds[len(m.kinds)-1]
+}
+
+// String returns the string form of this mix (as accepted by ParseMix).
+func (m *Mix) String() string {
+	parts := make([]string, len(m.kinds))
+	for i, kind := range m.kinds {
+		parts[i] = fmt.Sprintf("%s=%d", kind, m.weights[i])
+	}
+	return strings.Join(parts, ",")
+}
+
+// isKnownKind returns true if the provided kind is one of AllKinds.
+func isKnownKind(kind string) bool {
+	for _, known := range AllKinds {
+		if kind == known {
+			return true
+		}
+	}
+	return false
+}
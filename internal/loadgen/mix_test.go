@@ -0,0 +1,66 @@
+package loadgen
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMix(t *testing.T) {
+	tests := []struct {
+		name     string
+		str      string
+		expKinds []string
+		expStr   string
+		expErr   string
+	}{
+		{name: "default", str: DefaultMix, expKinds: []string{"attribute", "mint", "order", "settle"}, expStr: DefaultMix},
+		{name: "no weight", str: "mint", expKinds: []string{"mint"}, expStr: "mint=1"},
+		{name: "spaces and case", str: " Mint = 3 , ORDER=2 ,", expKinds: []string{"mint", "order"}, expStr: "mint=3,order=2"},
+		{name: "zero weight ignored", str: "mint=0,order=2", expKinds: []string{"order"}, expStr: "order=2"},
+		{name: "empty", str: "", expErr: "tx mix must have at least one kind with a positive weight"},
+		{name: "all zero", str: "mint=0", expErr: "tx mix must have at least one kind with a positive weight"},
+		{name: "unknown kind", str: "burn=1", expErr: "unknown tx kind \"burn\": must be one of order, settle, mint, attribute"},
+		{name: "duplicate kind", str: "mint=1,mint=2", expErr: "duplicate tx kind \"mint\""},
+		{name: "bad weight", str: "mint=x", expErr: "invalid weight \"x\" for tx kind \"mint\": strconv.ParseUint: parsing \"x\": invalid syntax"},
+		{name: "negative weight", str: "mint=-1", expErr: "invalid weight \"-1\" for tx kind \"mint\": strconv.ParseUint: parsing \"-1\": invalid syntax"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mix, err := ParseMix(tc.str)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ParseMix(%q) error", tc.str)
+				assert.Nil(t, mix, "ParseMix(%q) result", tc.str)
+				return
+			}
+			require.NoError(t, err, "ParseMix(%q) error", tc.str)
+			assert.Equal(t, tc.expKinds, mix.Kinds(), "Kinds()")
+			assert.Equal(t, tc.expStr, mix.String(), "String()")
+		})
+	}
+}
+
+func TestMixPick(t *testing.T) {
+	mix, err := ParseMix("order=6,mint=3,attribute=1")
+	require.NoError(t, err, "ParseMix")
+
+	counts := make(map[string]int)
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10000; i++ {
+		counts[mix.Pick(r)]++
+	}
+	assert.Len(t, counts, 3, "number of kinds picked")
+	assert.InDelta(t, 6000, counts[KindOrder], 300, "order count")
+	assert.InDelta(t, 3000, counts[KindMint], 300, "mint count")
+	assert.InDelta(t, 1000, counts[KindAttribute], 300, "attribute count")
+
+	// The same seed should give the same picks.
+	r1 := rand.New(rand.NewSource(5))
+	r2 := rand.New(rand.NewSource(5))
+	for i := 0; i < 100; i++ {
+		assert.Equal(t, mix.Pick(r1), mix.Pick(r2), "pick %d", i)
+	}
+}
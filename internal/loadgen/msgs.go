@@ -0,0 +1,154 @@
+package loadgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
+	"github.com/provenance-io/provenance/x/exchange"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+// OrderLister returns the orders owned by the provided address.
+type OrderLister func(ctx context.Context, owner string) ([]*exchange.Order, error)
+
+// ProvenanceMsgs is a MsgBuilder that creates exchange, marker, and attribute msgs, all signed by a single account.
+//
+// The account must:
+//   - be able to create orders in the market (the market should not require order creation fees),
+//   - have the settle permission in the market,
+//   - have the mint permission on the marker, and
+//   - own the attribute name.
+type ProvenanceMsgs struct {
+	// Account is the address that signs all the msgs.
+	Account sdk.AccAddress
+	// MarketID is the market to create and settle orders in.
+	MarketID uint32
+	// OrderAssets is the assets of each ask and bid order.
+	OrderAssets sdk.Coin
+	// OrderPrice is the price of each ask and bid order.
+	OrderPrice sdk.Coin
+	// MintAmount is the amount to mint in each mint tx.
+	MintAmount sdk.Coin
+	// AttributeName is the name of the attributes to write.
+	AttributeName string
+	// RunID is included in attribute values to make them unique across runs.
+	RunID string
+	// ListOrders is used to find orders to settle.
+	ListOrders OrderLister
+
+	mu      sync.Mutex
+	settled map[uint64]bool
+}
+
+var _ MsgBuilder = (*ProvenanceMsgs)(nil)
+
+// Validate returns an error if any of the fields needed for the kinds in the provided mix are missing.
+func (p *ProvenanceMsgs) Validate(mix *Mix) error {
+	var errs []error
+	if len(p.Account) == 0 {
+		errs = append(errs, errors.New("no account provided"))
+	}
+	for _, kind := range mix.Kinds() {
+		switch kind {
+		case KindOrder, KindSettle:
+			if p.MarketID == 0 {
+				errs = append(errs, fmt.Errorf("a market id is required for %s txs", kind))
+			}
+			if kind == KindSettle && p.ListOrders == nil {
+				errs = append(errs, fmt.Errorf("an order lister is required for %s txs", kind))
+			}
+			if kind == KindOrder && (p.OrderAssets.IsNil() || p.OrderPrice.IsNil() || !p.OrderAssets.IsPositive() || !p.OrderPrice.IsPositive()) {
+				errs = append(errs, fmt.Errorf("positive order assets and price are required for %s txs", kind))
+			}
+		case KindMint:
+			if p.MintAmount.IsNil() || !p.MintAmount.IsPositive() {
+				errs = append(errs, fmt.Errorf("a positive mint amount is required for %s txs", kind))
+			}
+		case KindAttribute:
+			if len(p.AttributeName) == 0 {
+				errs = append(errs, fmt.Errorf("an attribute name is required for %s txs", kind))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// BuildMsgs returns the msgs for a tx of the provided kind.
+func (p *ProvenanceMsgs) BuildMsgs(ctx context.Context, kind string, n uint64) ([]sdk.Msg, error) {
+	switch kind {
+	case KindOrder:
+		return p.orderMsgs(), nil
+	case KindSettle:
+		return p.settleMsgs(ctx)
+	case KindMint:
+		return []sdk.Msg{markertypes.NewMsgMintRequest(p.Account, p.MintAmount, nil)}, nil
+	case KindAttribute:
+		value := fmt.Sprintf("loadgen-%s-%d", p.RunID, n)
+		return []sdk.Msg{attrtypes.NewMsgAddAttributeRequest(p.Account.String(), p.Account, p.AttributeName,
+			attrtypes.AttributeType_String, []byte(value))}, nil
+	}
+	return nil, fmt.Errorf("unknown tx kind %q", kind)
+}
+
+// orderMsgs returns msgs that create an ask order and a bid order that can be settled with each other.
+func (p *ProvenanceMsgs) orderMsgs() []sdk.Msg {
+	return []sdk.Msg{
+		&exchange.MsgCreateAskRequest{AskOrder: exchange.AskOrder{
+			MarketId: p.MarketID,
+			Seller:   p.Account.String(),
+			Assets:   p.OrderAssets,
+			Price:    p.OrderPrice,
+		}},
+		&exchange.MsgCreateBidRequest{BidOrder: exchange.BidOrder{
+			MarketId: p.MarketID,
+			Buyer:    p.Account.String(),
+			Assets:   p.OrderAssets,
+			Price:    p.OrderPrice,
+		}},
+	}
+}
+
+// settleMsgs returns a msg that settles the oldest ask and bid orders (from this account, in the market)
+// that haven't already been settled during this run. If there isn't such a pair, nil is returned.
+func (p *ProvenanceMsgs) settleMsgs(ctx context.Context) ([]sdk.Msg, error) {
+	orders, err := p.ListOrders(ctx, p.Account.String())
+	if err != nil {
+		return nil, fmt.Errorf("could not list orders: %w", err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.settled == nil {
+		p.settled = make(map[uint64]bool)
+	}
+
+	var askID, bidID uint64
+	for _, order := range orders {
+		if order == nil || order.GetMarketID() != p.MarketID || p.settled[order.OrderId] {
+			continue
+		}
+		switch {
+		case askID == 0 && order.IsAskOrder():
+			askID = order.OrderId
+		case bidID == 0 && order.IsBidOrder():
+			bidID = order.OrderId
+		}
+	}
+	if askID == 0 || bidID == 0 {
+		return nil, nil
+	}
+
+	p.settled[askID] = true
+	p.settled[bidID] = true
+	return []sdk.Msg{&exchange.MsgMarketSettleRequest{
+		Admin:       p.Account.String(),
+		MarketId:    p.MarketID,
+		AskOrderIds: []uint64{askID},
+		BidOrderIds: []uint64{bidID},
+	}}, nil
+}
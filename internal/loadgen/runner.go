@@ -0,0 +1,163 @@
+package loadgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// WaitFunc blocks until a sent tx is in a block, returning an error if it fails or can't be found in time.
+type WaitFunc func(ctx context.Context) error
+
+// Sender signs and broadcasts txs.
+type Sender interface {
+	// Send signs and broadcasts a tx with the provided msgs and returns once the node has accepted or rejected it.
+	// If accepted, the returned WaitFunc can be used to wait for the tx to be in a block.
+	Send(ctx context.Context, msgs []sdk.Msg) (WaitFunc, error)
+}
+
+// MsgBuilder creates the msgs for the kinds of txs in a mix.
+type MsgBuilder interface {
+	// BuildMsgs returns the msgs for a tx of the provided kind. The n is the number of txs picked so far in
+	// this run and can be used to make each tx unique. If there's nothing to do for the kind right now,
+	// no msgs and no error are returned, and the tx is skipped.
+	BuildMsgs(ctx context.Context, kind string, n uint64) ([]sdk.Msg, error)
+}
+
+// Config is the configuration of a load run.
+type Config struct {
+	// Rate is the number of txs to send per second.
+	Rate float64
+	// Duration is how long to send txs for. Zero means no time limit.
+	Duration time.Duration
+	// Count is the maximum number of txs to pick. Zero means no limit.
+	Count uint64
+	// WaitForCommit is whether to wait for each tx to be in a block and record the commit latency.
+	WaitForCommit bool
+	// Seed is the seed used to pick the kind of each tx.
+	Seed int64
+}
+
+// Validate returns an error if this config is invalid.
+func (c Config) Validate() error {
+	var errs []error
+	if c.Rate <= 0 {
+		errs = append(errs, fmt.Errorf("invalid rate %v: must be positive", c.Rate))
+	}
+	if c.Duration < 0 {
+		errs = append(errs, fmt.Errorf("invalid duration %s: cannot be negative", c.Duration))
+	}
+	if c.Duration == 0 && c.Count == 0 {
+		errs = append(errs, errors.New("either a duration or a count is required"))
+	}
+	return errors.Join(errs...)
+}
+
+// Runner sends a mix of txs at a controlled rate and collects their latencies.
+type Runner struct {
+	cfg     Config
+	mix     *Mix
+	builder MsgBuilder
+	sender  Sender
+	stats   *Stats
+	now     func() time.Time
+}
+
+// NewRunner creates a new Runner.
+func NewRunner(cfg Config, mix *Mix, builder MsgBuilder, sender Sender) (*Runner, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	if mix == nil || builder == nil || sender == nil {
+		return nil, errors.New("a mix, msg builder, and sender are all required")
+	}
+	return &Runner{
+		cfg:     cfg,
+		mix:     mix,
+		builder: builder,
+		sender:  sender,
+		stats:   NewStats(),
+		now:     time.Now,
+	}, nil
+}
+
+// Stats returns the stats being collected by this runner.
+func (r *Runner) Stats() *Stats {
+	return r.stats
+}
+
+// Run sends txs until the duration has passed, the count is reached, or the context is done.
+// Txs are sent one at a time (so that the account sequence stays in order). If a tx takes longer than
+// the rate allows, the next one is sent right away, so the achieved rate can be lower than requested.
+// It returns how long the run took (not including the wait for outstanding commits).
+func (r *Runner) Run(ctx context.Context) time.Duration {
+	if r.cfg.Duration > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.cfg.Duration)
+		defer cancel()
+	}
+
+	picker := rand.New(rand.NewSource(r.cfg.Seed)) //nolint:gosec // Only used to pick tx kinds, not for security.
+	ticker := time.NewTicker(time.Duration(float64(time.Second) / r.cfg.Rate))
+	defer ticker.Stop()
+
+	var wg sync.WaitGroup
+	start := r.now()
+	var n uint64
+	for r.cfg.Count == 0 || n < r.cfg.Count {
+		kind := r.mix.Pick(picker)
+		n++
+		r.sendOne(ctx, &wg, kind, n)
+
+		select {
+		case <-ctx.Done():
+			wg.Wait()
+			return r.now().Sub(start)
+		case <-ticker.C:
+		}
+	}
+	elapsed := r.now().Sub(start)
+	wg.Wait()
+	return elapsed
+}
+
+// sendOne builds and sends a single tx of the provided kind, recording the results.
+// If waiting for commits, a goroutine is started (and added to the wait group) to wait for it.
+func (r *Runner) sendOne(ctx context.Context, wg *sync.WaitGroup, kind string, n uint64) {
+	msgs, err := r.builder.BuildMsgs(ctx, kind, n)
+	if err != nil {
+		r.stats.RecordFailure(kind, err)
+		return
+	}
+	if len(msgs) == 0 {
+		r.stats.RecordSkip(kind)
+		return
+	}
+
+	sent := r.now()
+	wait, err := r.sender.Send(ctx, msgs)
+	if err != nil {
+		r.stats.RecordFailure(kind, err)
+		return
+	}
+	r.stats.RecordBroadcast(kind, r.now().Sub(sent))
+
+	if !r.cfg.WaitForCommit || wait == nil {
+		return
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		// Use a context that isn't canceled when the run ends so outstanding txs are still waited for.
+		if werr := wait(context.WithoutCancel(ctx)); werr != nil {
+			r.stats.RecordFailure(kind, werr)
+			return
+		}
+		r.stats.RecordCommit(kind, r.now().Sub(sent))
+	}()
+}
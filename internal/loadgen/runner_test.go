@@ -0,0 +1,168 @@
+package loadgen
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+// mockSender is a Sender that records the msgs it's given.
+type mockSender struct {
+	mu      sync.Mutex
+	sent    [][]sdk.Msg
+	sendErr error
+	waitErr error
+}
+
+func (s *mockSender) Send(_ context.Context, msgs []sdk.Msg) (WaitFunc, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.sendErr != nil {
+		return nil, s.sendErr
+	}
+	s.sent = append(s.sent, msgs)
+	return func(context.Context) error { return s.waitErr }, nil
+}
+
+func TestConfigValidate(t *testing.T) {
+	assert.NoError(t, Config{Rate: 1, Count: 1}.Validate(), "count only")
+	assert.NoError(t, Config{Rate: 0.5, Duration: time.Second}.Validate(), "duration only")
+	assert.EqualError(t, Config{Rate: 0, Count: 1}.Validate(), "invalid rate 0: must be positive", "zero rate")
+	assert.EqualError(t, Config{Rate: 1}.Validate(), "either a duration or a count is required", "no limit")
+	assert.EqualError(t, Config{Rate: 1, Duration: -time.Second}.Validate(),
+		"invalid duration -1s: cannot be negative", "negative duration")
+}
+
+func TestRunner(t *testing.T) {
+	addr := sdk.AccAddress("loadgen_____________")
+	var orders []*exchange.Order
+	builder := &ProvenanceMsgs{
+		Account:       addr,
+		MarketID:      3,
+		OrderAssets:   sdk.NewInt64Coin("apple", 1),
+		OrderPrice:    sdk.NewInt64Coin("nhash", 10),
+		MintAmount:    sdk.NewCoin("apple", sdkmath.NewInt(100)),
+		AttributeName: "load.test.pb",
+		RunID:         "abc",
+		ListOrders: func(context.Context, string) ([]*exchange.Order, error) {
+			return orders, nil
+		},
+	}
+
+	t.Run("count limited", func(t *testing.T) {
+		mix, err := ParseMix("mint=1,attribute=1")
+		require.NoError(t, err, "ParseMix")
+		require.NoError(t, builder.Validate(mix), "Validate")
+		sender := &mockSender{}
+		runner, err := NewRunner(Config{Rate: 1000, Count: 20, WaitForCommit: true, Seed: 1}, mix, builder, sender)
+		require.NoError(t, err, "NewRunner")
+
+		elapsed := runner.Run(context.Background())
+		assert.Positive(t, elapsed, "elapsed")
+		assert.Len(t, sender.sent, 20, "number of txs sent")
+		assert.Equal(t, 20, runner.Stats().Sent(), "Sent()")
+		for i, msgs := range sender.sent {
+			if assert.Len(t, msgs, 1, "tx %d msgs", i) && sdk.MsgTypeURL(msgs[0]) == sdk.MsgTypeURL(&markertypes.MsgMintRequest{}) {
+				assert.Equal(t, addr.String(), msgs[0].(*markertypes.MsgMintRequest).Administrator, "tx %d administrator", i)
+			}
+		}
+		report := runner.Stats().Report(elapsed)
+		assert.Contains(t, report, "\nmint ", "report")
+		assert.Contains(t, report, "\nattribute ", "report")
+		assert.Contains(t, report, "\ntotal           20       0       0  ", "report")
+	})
+
+	t.Run("failures and skips", func(t *testing.T) {
+		mix, err := ParseMix("settle=1,order=1")
+		require.NoError(t, err, "ParseMix")
+		sender := &mockSender{sendErr: errors.New("node is down")}
+		runner, err := NewRunner(Config{Rate: 1000, Count: 10, Seed: 2}, mix, builder, sender)
+		require.NoError(t, err, "NewRunner")
+
+		runner.Run(context.Background())
+		assert.Empty(t, sender.sent, "txs sent")
+		report := runner.Stats().Report(0)
+		assert.Contains(t, report, "error (order, ", "report")
+		assert.Contains(t, report, "x): node is down\n", "report")
+		assert.NotContains(t, report, "error (settle", "report")
+	})
+
+	t.Run("duration limited", func(t *testing.T) {
+		mix, err := ParseMix("mint")
+		require.NoError(t, err, "ParseMix")
+		sender := &mockSender{waitErr: errors.New("tx failed with code 5 (sdk)")}
+		runner, err := NewRunner(Config{Rate: 50, Duration: 200 * time.Millisecond, WaitForCommit: true}, mix, builder, sender)
+		require.NoError(t, err, "NewRunner")
+
+		runner.Run(context.Background())
+		assert.NotEmpty(t, sender.sent, "txs sent")
+		assert.Less(t, len(sender.sent), 20, "txs sent")
+		assert.Contains(t, runner.Stats().Report(0), "x): tx failed with code 5 (sdk)\n", "report")
+	})
+}
+
+func TestProvenanceMsgsSettle(t *testing.T) {
+	addr := sdk.AccAddress("loadgen_____________")
+	ask := func(id uint64, marketID uint32) *exchange.Order {
+		return exchange.NewOrder(id).WithAsk(&exchange.AskOrder{MarketId: marketID, Seller: addr.String()})
+	}
+	bid := func(id uint64, marketID uint32) *exchange.Order {
+		return exchange.NewOrder(id).WithBid(&exchange.BidOrder{MarketId: marketID, Buyer: addr.String()})
+	}
+	orders := []*exchange.Order{ask(1, 2), ask(2, 3), bid(3, 3), ask(4, 3), bid(5, 3)}
+	builder := &ProvenanceMsgs{
+		Account:  addr,
+		MarketID: 3,
+		ListOrders: func(_ context.Context, owner string) ([]*exchange.Order, error) {
+			assert.Equal(t, addr.String(), owner, "ListOrders owner")
+			return orders, nil
+		},
+	}
+
+	expMsg := func(askID, bidID uint64) []sdk.Msg {
+		return []sdk.Msg{&exchange.MsgMarketSettleRequest{
+			Admin:       addr.String(),
+			MarketId:    3,
+			AskOrderIds: []uint64{askID},
+			BidOrderIds: []uint64{bidID},
+		}}
+	}
+
+	msgs, err := builder.BuildMsgs(context.Background(), KindSettle, 1)
+	require.NoError(t, err, "first settle")
+	assert.Equal(t, expMsg(2, 3), msgs, "first settle msgs")
+
+	msgs, err = builder.BuildMsgs(context.Background(), KindSettle, 2)
+	require.NoError(t, err, "second settle")
+	assert.Equal(t, expMsg(4, 5), msgs, "second settle msgs")
+
+	msgs, err = builder.BuildMsgs(context.Background(), KindSettle, 3)
+	require.NoError(t, err, "third settle")
+	assert.Empty(t, msgs, "third settle msgs")
+}
+
+func TestProvenanceMsgsValidate(t *testing.T) {
+	mix, err := ParseMix(DefaultMix)
+	require.NoError(t, err, "ParseMix")
+
+	err = (&ProvenanceMsgs{}).Validate(mix)
+	assert.EqualError(t, err, "no account provided\n"+
+		"an attribute name is required for attribute txs\n"+
+		"a positive mint amount is required for mint txs\n"+
+		"a market id is required for order txs\n"+
+		"positive order assets and price are required for order txs\n"+
+		"a market id is required for settle txs\n"+
+		"an order lister is required for settle txs", "Validate with nothing")
+}
@@ -0,0 +1,147 @@
+package loadgen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Stats collects the results of the txs sent during a load run. It is safe for concurrent use.
+type Stats struct {
+	mu      sync.Mutex
+	results map[string]*kindResults
+}
+
+// kindResults are the results recorded for a single kind of tx.
+type kindResults struct {
+	broadcast []time.Duration
+	commit    []time.Duration
+	failed    int
+	skipped   int
+	errs      map[string]int
+}
+
+// NewStats creates a new, empty, Stats.
+func NewStats() *Stats {
+	return &Stats{results: make(map[string]*kindResults)}
+}
+
+// get returns the results for the provided kind, creating them if needed. The lock must be held.
+func (s *Stats) get(kind string) *kindResults {
+	rv, ok := s.results[kind]
+	if !ok {
+		rv = &kindResults{errs: make(map[string]int)}
+		s.results[kind] = rv
+	}
+	return rv
+}
+
+// RecordBroadcast records how long it took a tx of the provided kind to be accepted by the node.
+func (s *Stats) RecordBroadcast(kind string, latency time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r := s.get(kind)
+	r.broadcast = append(r.broadcast, latency)
+}
+
+// RecordCommit records how long it took a tx of the provided kind to be included in a block.
+func (s *Stats) RecordCommit(kind string, latency time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r := s.get(kind)
+	r.commit = append(r.commit, latency)
+}
+
+// RecordFailure records that a tx of the provided kind failed.
+func (s *Stats) RecordFailure(kind string, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r := s.get(kind)
+	r.failed++
+	r.errs[err.Error()]++
+}
+
+// RecordSkip records that a tx of the provided kind was skipped because there was nothing for it to do.
+func (s *Stats) RecordSkip(kind string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.get(kind).skipped++
+}
+
+// Sent returns the number of txs that have been accepted by the node.
+func (s *Stats) Sent() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rv := 0
+	for _, r := range s.results {
+		rv += len(r.broadcast)
+	}
+	return rv
+}
+
+// Report returns a human-readable summary of the results, given how long the run took.
+func (s *Stats) Report(elapsed time.Duration) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	kinds := make([]string, 0, len(s.results))
+	for kind := range s.results {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+
+	var sb strings.Builder
+	var allBroadcast, allCommit []time.Duration
+	failed, skipped := 0, 0
+	fmt.Fprintf(&sb, "%-10s %7s %7s %7s  %-32s  %-32s\n", "kind", "sent", "failed", "skipped",
+		"broadcast p50/p90/p99/max", "commit p50/p90/p99/max")
+	for _, kind := range kinds {
+		r := s.results[kind]
+		fmt.Fprintf(&sb, "%-10s %7d %7d %7d  %-32s  %-32s\n", kind, len(r.broadcast), r.failed, r.skipped,
+			percentiles(r.broadcast), percentiles(r.commit))
+		allBroadcast = append(allBroadcast, r.broadcast...)
+		allCommit = append(allCommit, r.commit...)
+		failed += r.failed
+		skipped += r.skipped
+	}
+	fmt.Fprintf(&sb, "%-10s %7d %7d %7d  %-32s  %-32s\n", "total", len(allBroadcast), failed, skipped,
+		percentiles(allBroadcast), percentiles(allCommit))
+
+	if elapsed > 0 {
+		fmt.Fprintf(&sb, "elapsed: %s, achieved rate: %.2f tx/s\n", elapsed.Round(time.Millisecond),
+			float64(len(allBroadcast))/elapsed.Seconds())
+	}
+
+	for _, kind := range kinds {
+		r := s.results[kind]
+		errs := make([]string, 0, len(r.errs))
+		for err := range r.errs {
+			errs = append(errs, err)
+		}
+		sort.Strings(errs)
+		for _, err := range errs {
+			fmt.Fprintf(&sb, "error (%s, %dx): %s\n", kind, r.errs[err], err)
+		}
+	}
+	return sb.String()
+}
+
+// percentiles returns a string with the p50, p90, p99, and max of the provided durations.
+func percentiles(durs []time.Duration) string {
+	if len(durs) == 0 {
+		return "-"
+	}
+	sorted := make([]time.Duration, len(durs))
+	copy(sorted, durs)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	at := func(p int) time.Duration {
+		i := (len(sorted)*p+99)/100 - 1
+		if i < 0 {
+			i = 0
+		}
+		return sorted[i].Round(time.Millisecond)
+	}
+	return fmt.Sprintf("%s/%s/%s/%s", at(50), at(90), at(99), sorted[len(sorted)-1].Round(time.Millisecond))
+}
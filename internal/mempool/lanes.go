@@ -0,0 +1,328 @@
+package mempool
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	"github.com/provenance-io/provenance/internal/unordered"
+)
+
+// DefaultLaneName is the name of the lane that holds all txs that do not match any other lane.
+const DefaultLaneName = "default"
+
+// Lane defines a section of the mempool that is reserved for specific types of txs.
+type Lane struct {
+	// Name is a short description of the lane, e.g. "settlement".
+	Name string
+	// Match returns true if the provided tx belongs in this lane.
+	Match func(tx sdk.Tx) bool
+}
+
+// NewLane creates a new lane for txs where all the msgs satisfy the provided msg matcher.
+func NewLane(name string, matchMsg func(msg sdk.Msg) bool) Lane {
+	return Lane{Name: name, Match: MatchAllMsgs(matchMsg)}
+}
+
+// MatchAllMsgs creates a tx matcher that returns true if the tx has at least one msg and they all satisfy matchMsg.
+func MatchAllMsgs(matchMsg func(msg sdk.Msg) bool) func(tx sdk.Tx) bool {
+	return func(tx sdk.Tx) bool {
+		msgs := tx.GetMsgs()
+		if len(msgs) == 0 {
+			return false
+		}
+		for _, msg := range msgs {
+			if !matchMsg(msg) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// MsgTypeURLs creates a msg matcher that returns true if the msg has one of the provided type URLs.
+func MsgTypeURLs(typeURLs ...string) func(msg sdk.Msg) bool {
+	return func(msg sdk.Msg) bool {
+		typeURL := sdk.MsgTypeURL(msg)
+		for _, url := range typeURLs {
+			if typeURL == url {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// MsgTypeURLPrefix creates a msg matcher that returns true if the msg's type URL starts with the provided prefix.
+func MsgTypeURLPrefix(prefix string) func(msg sdk.Msg) bool {
+	return func(msg sdk.Msg) bool {
+		return strings.HasPrefix(sdk.MsgTypeURL(msg), prefix)
+	}
+}
+
+// senderNonce identifies a tx the same way as the SDK's sender-nonce mempool: by its first signer and sequence.
+type senderNonce struct {
+	sender string
+	nonce  uint64
+}
+
+// LaneMempool is an app-side mempool that divides txs into lanes.
+//
+// Each lane has its own capacity, so a flood of ordinary txs cannot prevent txs from being added to the other lanes.
+// When txs are selected for a block proposal, all the txs in the first lane are provided, then the txs in the second
+// lane, and so on. Txs that don't match any lane go in the default lane, which is always last.
+//
+// A sender's txs must be included in nonce order, so if a sender already has txs waiting in a later lane,
+// their new txs are put in that later lane too.
+type LaneMempool struct {
+	mtx sync.Mutex
+
+	names []string
+	lanes []Lane
+	pools []sdkmempool.Mempool
+
+	// txLanes is the index of the lane that each tx is in, by sender then nonce.
+	txLanes map[string]map[uint64]int
+
+	signerExtractor sdkmempool.SignerExtractionAdapter
+}
+
+var _ sdkmempool.ExtMempool = (*LaneMempool)(nil)
+
+// NewLaneMempool creates a new mempool with the provided lanes (in priority order) followed by the default lane.
+// Each lane can hold up to maxTxs txs. If maxTxs is zero, the lanes are not limited.
+// A negative maxTxs is handled the same way as the SDK's sender-nonce mempool: txs are not kept.
+func NewLaneMempool(maxTxs int, lanes ...Lane) *LaneMempool {
+	rv := &LaneMempool{
+		lanes:           lanes,
+		txLanes:         make(map[string]map[uint64]int),
+		signerExtractor: sdkmempool.NewDefaultSignerExtractionAdapter(),
+	}
+	for _, lane := range lanes {
+		rv.names = append(rv.names, lane.Name)
+	}
+	rv.names = append(rv.names, DefaultLaneName)
+	for range rv.names {
+		rv.pools = append(rv.pools, sdkmempool.NewSenderNonceMempool(sdkmempool.SenderNonceMaxTxOpt(maxTxs)))
+	}
+	return rv
+}
+
+// getSenderNonce gets the sender and nonce of the provided tx.
+// The nonce of an unordered tx is its timeout (as unix nanoseconds) since its sequence is always zero.
+func (mp *LaneMempool) getSenderNonce(tx sdk.Tx) (senderNonce, error) {
+	sigs, err := mp.signerExtractor.GetSigners(tx)
+	if err != nil {
+		return senderNonce{}, err
+	}
+	if len(sigs) == 0 {
+		return senderNonce{}, fmt.Errorf("tx must have at least one signer")
+	}
+	rv := senderNonce{sender: sigs[0].Signer.String(), nonce: sigs[0].Sequence}
+	if opt, err := unordered.GetExtensionOption(tx); err == nil && opt != nil {
+		rv.nonce = uint64(opt.TimeoutTimestamp.UnixNano())
+	}
+	return rv, nil
+}
+
+// unorderedTx is an unordered tx with the sequences of its signatures replaced by its nonce.
+// The lanes are SDK sender-nonce mempools, which identify txs by their first signature's sequence. Since all
+// unordered txs have a sequence of zero, they're wrapped in this before being provided to the lanes.
+type unorderedTx struct {
+	authsigning.Tx
+	nonce uint64
+}
+
+// GetSignaturesV2 returns the tx's signatures, but with the nonce as the sequence.
+func (tx unorderedTx) GetSignaturesV2() ([]signingtypes.SignatureV2, error) {
+	sigs, err := tx.Tx.GetSignaturesV2()
+	if err != nil {
+		return nil, err
+	}
+	rv := make([]signingtypes.SignatureV2, len(sigs))
+	for i, sig := range sigs {
+		rv[i] = sig
+		rv[i].Sequence = tx.nonce
+	}
+	return rv, nil
+}
+
+// wrapTx returns the tx to provide to the lanes for the provided tx.
+func wrapTx(tx sdk.Tx, key senderNonce) sdk.Tx {
+	sigTx, ok := tx.(authsigning.Tx)
+	if !ok || !unordered.IsUnorderedTx(tx) {
+		return tx
+	}
+	return unorderedTx{Tx: sigTx, nonce: key.nonce}
+}
+
+// unwrapTx returns the original tx of one that came from the lanes.
+func unwrapTx(tx sdk.Tx) sdk.Tx {
+	if utx, ok := tx.(unorderedTx); ok {
+		return utx.Tx
+	}
+	return tx
+}
+
+// GetLaneName returns the name of the lane that the provided tx is in (or would be put in).
+func (mp *LaneMempool) GetLaneName(tx sdk.Tx) string {
+	key, err := mp.getSenderNonce(tx)
+	if err != nil {
+		return mp.names[mp.matchLane(tx)]
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	return mp.names[mp.chooseLane(tx, key)]
+}
+
+// matchLane returns the index of the first lane that the provided tx matches.
+func (mp *LaneMempool) matchLane(tx sdk.Tx) int {
+	for i, lane := range mp.lanes {
+		if lane.Match != nil && lane.Match(tx) {
+			return i
+		}
+	}
+	return len(mp.lanes)
+}
+
+// chooseLane returns the index of the lane that the provided tx should be in.
+// The mutex must be locked before calling this.
+func (mp *LaneMempool) chooseLane(tx sdk.Tx, key senderNonce) int {
+	nonces := mp.txLanes[key.sender]
+	if i, found := nonces[key.nonce]; found {
+		// This tx will replace one already in the mempool, so it has to go in the same lane.
+		return i
+	}
+
+	rv := mp.matchLane(tx)
+	for _, i := range nonces {
+		if i > rv {
+			rv = i
+		}
+	}
+	return rv
+}
+
+// Insert adds the provided tx to the appropriate lane.
+func (mp *LaneMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	key, err := mp.getSenderNonce(tx)
+	if err != nil {
+		return err
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	i := mp.chooseLane(tx, key)
+	if err = mp.pools[i].Insert(ctx, wrapTx(tx, key)); err != nil {
+		return fmt.Errorf("could not insert tx into %s lane: %w", mp.names[i], err)
+	}
+
+	if mp.txLanes[key.sender] == nil {
+		mp.txLanes[key.sender] = make(map[uint64]int)
+	}
+	mp.txLanes[key.sender][key.nonce] = i
+	return nil
+}
+
+// Select returns an iterator over all the txs in the mempool, one lane after another.
+func (mp *LaneMempool) Select(ctx context.Context, txs [][]byte) sdkmempool.Iterator {
+	var iters []sdkmempool.Iterator
+	for _, pool := range mp.pools {
+		if iter := pool.Select(ctx, txs); iter != nil {
+			iters = append(iters, iter)
+		}
+	}
+	if len(iters) == 0 {
+		return nil
+	}
+	return &laneIterator{iters: iters}
+}
+
+// SelectBy calls the provided callback with each tx in the mempool, one lane after another, until it returns false.
+func (mp *LaneMempool) SelectBy(ctx context.Context, txs [][]byte, callback func(sdk.Tx) bool) {
+	keepGoing := true
+	for _, pool := range mp.pools {
+		sdkmempool.SelectBy(ctx, pool, txs, func(tx sdk.Tx) bool {
+			keepGoing = callback(unwrapTx(tx))
+			return keepGoing
+		})
+		if !keepGoing {
+			return
+		}
+	}
+}
+
+// CountTx returns the number of txs in all the lanes.
+func (mp *LaneMempool) CountTx() int {
+	rv := 0
+	for _, pool := range mp.pools {
+		rv += pool.CountTx()
+	}
+	return rv
+}
+
+// CountLaneTxs returns the number of txs in each lane, by lane name.
+func (mp *LaneMempool) CountLaneTxs() map[string]int {
+	rv := make(map[string]int, len(mp.names))
+	for i, pool := range mp.pools {
+		rv[mp.names[i]] = pool.CountTx()
+	}
+	return rv
+}
+
+// Remove removes the provided tx from whichever lane it is in.
+func (mp *LaneMempool) Remove(tx sdk.Tx) error {
+	key, err := mp.getSenderNonce(tx)
+	if err != nil {
+		return err
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	i, found := mp.txLanes[key.sender][key.nonce]
+	if !found {
+		return sdkmempool.ErrTxNotFound
+	}
+	if err = mp.pools[i].Remove(wrapTx(tx, key)); err != nil {
+		return err
+	}
+
+	delete(mp.txLanes[key.sender], key.nonce)
+	if len(mp.txLanes[key.sender]) == 0 {
+		delete(mp.txLanes, key.sender)
+	}
+	return nil
+}
+
+// laneIterator iterates over the txs of several lanes, one after the other.
+type laneIterator struct {
+	iters []sdkmempool.Iterator
+}
+
+var _ sdkmempool.Iterator = (*laneIterator)(nil)
+
+// Next returns the iterator for the next tx, or nil if there are no more.
+func (i *laneIterator) Next() sdkmempool.Iterator {
+	i.iters[0] = i.iters[0].Next()
+	if i.iters[0] == nil {
+		i.iters = i.iters[1:]
+	}
+	if len(i.iters) == 0 {
+		return nil
+	}
+	return i
+}
+
+// Tx returns the current tx.
+func (i *laneIterator) Tx() sdk.Tx {
+	return unwrapTx(i.iters[0].Tx())
+}
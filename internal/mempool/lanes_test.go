@@ -0,0 +1,224 @@
+package mempool_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/internal/mempool"
+	"github.com/provenance-io/provenance/internal/unordered"
+)
+
+// txMaker makes txs that are signed by a specific key.
+type txMaker struct {
+	t        *testing.T
+	txConfig client.TxConfig
+	pubKey   cryptotypes.PubKey
+}
+
+// newTxMaker creates a new txMaker with a new key.
+func newTxMaker(t *testing.T) txMaker {
+	return txMaker{
+		t:        t,
+		txConfig: app.MakeTestEncodingConfig(t).TxConfig,
+		pubKey:   secp256k1.GenPrivKey().PubKey(),
+	}
+}
+
+// addr returns the address of this tx maker's key.
+func (m txMaker) addr() sdk.AccAddress {
+	return sdk.AccAddress(m.pubKey.Address())
+}
+
+// tx creates a tx with the provided sequence and msgs.
+func (m txMaker) tx(seq uint64, msgs ...sdk.Msg) sdk.Tx {
+	builder := m.txConfig.NewTxBuilder()
+	require.NoError(m.t, builder.SetMsgs(msgs...), "SetMsgs")
+	sig := signingtypes.SignatureV2{
+		PubKey:   m.pubKey,
+		Data:     &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_DIRECT},
+		Sequence: seq,
+	}
+	require.NoError(m.t, builder.SetSignatures(sig), "SetSignatures")
+	return builder.GetTx()
+}
+
+// send creates a tx with a MsgSend (which goes in the default lane).
+func (m txMaker) send(seq uint64) sdk.Tx {
+	return m.tx(seq, banktypes.NewMsgSend(m.addr(), sdk.AccAddress("to__________________"), sdk.NewCoins(sdk.NewInt64Coin("nhash", 1))))
+}
+
+// vote creates a tx with a MsgVote (which goes in the first lane).
+func (m txMaker) vote(seq uint64) sdk.Tx {
+	return m.tx(seq, govv1.NewMsgVote(m.addr(), 1, govv1.OptionYes, ""))
+}
+
+// delegate creates a tx with a MsgDelegate (which goes in the second lane).
+func (m txMaker) delegate(seq uint64) sdk.Tx {
+	return m.tx(seq, stakingtypes.NewMsgDelegate(m.addr().String(), "validator", sdk.NewCoin("nhash", sdkmath.NewInt(1))))
+}
+
+// unorderedSend creates an unordered tx with a MsgSend and the provided timeout.
+func (m txMaker) unorderedSend(timeout time.Time) sdk.Tx {
+	builder := m.txConfig.NewTxBuilder()
+	require.NoError(m.t, builder.SetMsgs(banktypes.NewMsgSend(m.addr(), sdk.AccAddress("to__________________"), sdk.NewCoins(sdk.NewInt64Coin("nhash", 1)))), "SetMsgs")
+	opt, err := unordered.NewExtensionOption(timeout)
+	require.NoError(m.t, err, "NewExtensionOption")
+	builder.(client.ExtendedTxBuilder).SetExtensionOptions(opt)
+	sig := signingtypes.SignatureV2{
+		PubKey: m.pubKey,
+		Data:   &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_DIRECT},
+	}
+	require.NoError(m.t, builder.SetSignatures(sig), "SetSignatures")
+	return builder.GetTx()
+}
+
+// newTestMempool creates a new lane mempool with a "first" lane for votes and a "second" lane for delegations.
+func newTestMempool(maxTxs int) *mempool.LaneMempool {
+	return mempool.NewLaneMempool(maxTxs,
+		mempool.NewLane("first", mempool.MsgTypeURLs(sdk.MsgTypeURL(&govv1.MsgVote{}))),
+		mempool.NewLane("second", mempool.MsgTypeURLPrefix("/cosmos.staking.")),
+	)
+}
+
+// selectAll gets all the txs from the mempool's Select iterator.
+func selectAll(mp sdkmempool.Mempool) []sdk.Tx {
+	var rv []sdk.Tx
+	for iter := mp.Select(context.Background(), nil); iter != nil; iter = iter.Next() {
+		rv = append(rv, iter.Tx())
+	}
+	return rv
+}
+
+func TestLaneMempoolLaneAssignment(t *testing.T) {
+	alice := newTxMaker(t)
+	bob := newTxMaker(t)
+	mp := newTestMempool(0)
+
+	assert.Equal(t, "first", mp.GetLaneName(alice.vote(0)), "lane of a vote")
+	assert.Equal(t, "second", mp.GetLaneName(alice.delegate(0)), "lane of a delegation")
+	assert.Equal(t, mempool.DefaultLaneName, mp.GetLaneName(alice.send(0)), "lane of a send")
+	mixed := alice.tx(0, govv1.NewMsgVote(alice.addr(), 1, govv1.OptionYes, ""),
+		banktypes.NewMsgSend(alice.addr(), bob.addr(), sdk.NewCoins(sdk.NewInt64Coin("nhash", 1))))
+	assert.Equal(t, mempool.DefaultLaneName, mp.GetLaneName(mixed), "lane of a vote with a send")
+
+	require.NoError(t, mp.Insert(context.Background(), alice.delegate(0)), "Insert(alice delegate 0)")
+	assert.Equal(t, "second", mp.GetLaneName(alice.vote(1)), "lane of alice's vote after her delegation")
+	assert.Equal(t, "first", mp.GetLaneName(bob.vote(0)), "lane of bob's vote after alice's delegation")
+	require.NoError(t, mp.Insert(context.Background(), alice.vote(1)), "Insert(alice vote 1)")
+
+	require.NoError(t, mp.Remove(alice.delegate(0)), "Remove(alice delegate 0)")
+	assert.Equal(t, "second", mp.GetLaneName(alice.vote(1)), "lane of alice's vote already in the mempool")
+	assert.Equal(t, "second", mp.GetLaneName(alice.vote(2)), "lane of alice's next vote")
+	require.NoError(t, mp.Remove(alice.vote(1)), "Remove(alice vote 1)")
+	assert.Equal(t, "first", mp.GetLaneName(alice.vote(2)), "lane of alice's vote after her other txs are removed")
+}
+
+func TestLaneMempoolSelect(t *testing.T) {
+	alice := newTxMaker(t)
+	bob := newTxMaker(t)
+	carl := newTxMaker(t)
+	mp := newTestMempool(0)
+
+	assert.Nil(t, mp.Select(context.Background(), nil), "Select on an empty mempool")
+
+	txs := []sdk.Tx{alice.send(0), alice.send(1), bob.delegate(0), carl.vote(0), bob.delegate(1), carl.vote(1)}
+	for i, tx := range txs {
+		require.NoError(t, mp.Insert(context.Background(), tx), "Insert(txs[%d])", i)
+	}
+	assert.Equal(t, len(txs), mp.CountTx(), "CountTx")
+	expCounts := map[string]int{"first": 2, "second": 2, mempool.DefaultLaneName: 2}
+	assert.Equal(t, expCounts, mp.CountLaneTxs(), "CountLaneTxs")
+
+	exp := []sdk.Tx{carl.vote(0), carl.vote(1), bob.delegate(0), bob.delegate(1), alice.send(0), alice.send(1)}
+	assert.Equal(t, exp, selectAll(mp), "Select results")
+
+	t.Run("SelectBy all", func(t *testing.T) {
+		var act []sdk.Tx
+		mp.SelectBy(context.Background(), nil, func(tx sdk.Tx) bool {
+			act = append(act, tx)
+			return true
+		})
+		assert.Equal(t, exp, act, "SelectBy results")
+	})
+
+	t.Run("SelectBy stopping early", func(t *testing.T) {
+		var act []sdk.Tx
+		mp.SelectBy(context.Background(), nil, func(tx sdk.Tx) bool {
+			act = append(act, tx)
+			return len(act) < 3
+		})
+		assert.Equal(t, exp[:3], act, "SelectBy results")
+	})
+}
+
+func TestLaneMempoolCapacity(t *testing.T) {
+	alice := newTxMaker(t)
+	bob := newTxMaker(t)
+	mp := newTestMempool(1)
+
+	require.NoError(t, mp.Insert(context.Background(), alice.send(0)), "Insert(alice send 0)")
+	err := mp.Insert(context.Background(), bob.send(0))
+	require.EqualError(t, err, "could not insert tx into default lane: pool reached max tx capacity", "Insert(bob send 0)")
+	require.ErrorIs(t, err, sdkmempool.ErrMempoolTxMaxCapacity, "Insert(bob send 0)")
+
+	require.NoError(t, mp.Insert(context.Background(), bob.vote(0)), "Insert(bob vote 0)")
+	assert.Equal(t, map[string]int{"first": 1, "second": 0, mempool.DefaultLaneName: 1}, mp.CountLaneTxs(), "CountLaneTxs")
+}
+
+func TestLaneMempoolRemove(t *testing.T) {
+	alice := newTxMaker(t)
+	mp := newTestMempool(0)
+
+	require.NoError(t, mp.Insert(context.Background(), alice.vote(0)), "Insert(alice vote 0)")
+	require.NoError(t, mp.Insert(context.Background(), alice.send(1)), "Insert(alice send 1)")
+
+	assert.ErrorIs(t, mp.Remove(alice.send(2)), sdkmempool.ErrTxNotFound, "Remove(alice send 2)")
+	require.NoError(t, mp.Remove(alice.vote(0)), "Remove(alice vote 0)")
+	assert.ErrorIs(t, mp.Remove(alice.vote(0)), sdkmempool.ErrTxNotFound, "Remove(alice vote 0) again")
+	assert.Equal(t, []sdk.Tx{alice.send(1)}, selectAll(mp), "Select results after removal")
+}
+
+func TestLaneMempoolUnorderedTxs(t *testing.T) {
+	alice := newTxMaker(t)
+	mp := newTestMempool(0)
+	t1 := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+	t2 := t1.Add(time.Nanosecond)
+
+	// Unordered txs all have a sequence of zero, so make sure they don't replace each other or alice's ordered txs.
+	txs := []sdk.Tx{alice.unorderedSend(t2), alice.send(0), alice.unorderedSend(t1), alice.send(1)}
+	for i, tx := range txs {
+		require.NoError(t, mp.Insert(context.Background(), tx), "Insert(txs[%d])", i)
+	}
+	assert.Equal(t, len(txs), mp.CountTx(), "CountTx")
+
+	exp := []sdk.Tx{alice.send(0), alice.send(1), alice.unorderedSend(t1), alice.unorderedSend(t2)}
+	assert.Equal(t, exp, selectAll(mp), "Select results")
+
+	var act []sdk.Tx
+	mp.SelectBy(context.Background(), nil, func(tx sdk.Tx) bool {
+		act = append(act, tx)
+		return true
+	})
+	assert.Equal(t, exp, act, "SelectBy results")
+
+	require.NoError(t, mp.Remove(alice.unorderedSend(t1)), "Remove(alice unordered t1)")
+	assert.ErrorIs(t, mp.Remove(alice.unorderedSend(t1)), sdkmempool.ErrTxNotFound, "Remove(alice unordered t1) again")
+	assert.Equal(t, []sdk.Tx{alice.send(0), alice.send(1), alice.unorderedSend(t2)}, selectAll(mp), "Select results after removal")
+}
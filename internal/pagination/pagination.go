@@ -0,0 +1,89 @@
+package pagination
+
+import (
+	"bytes"
+	"errors"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// DefaultLimit is the number of results returned when a page request does not have a limit.
+const DefaultLimit = query.DefaultLimit
+
+// ValidatePageRequest returns an error if the provided page request uses anything other than a key and limit.
+func ValidatePageRequest(pageReq *query.PageRequest) error {
+	if pageReq == nil {
+		return nil
+	}
+	if pageReq.Offset != 0 {
+		return errors.New("offset is not supported, use the next_key of the previous page instead")
+	}
+	if pageReq.CountTotal {
+		return errors.New("count_total is not supported")
+	}
+	if pageReq.Reverse {
+		return errors.New("reverse is not supported, use the order field instead")
+	}
+	return nil
+}
+
+// Paginate iterates over the provided store starting at the page request's key, calling onResult for each entry.
+// If descending is true, the entries are iterated from the largest key to the smallest.
+//
+// The onResult function should return whether the entry was included in the results. Entries that are not
+// included do not count toward the limit. Once the limit has been reached, the key of the next entry is
+// returned as the next key. The next key is only empty if there are no more entries.
+//
+// An error is returned if the page request has an offset, count_total or reverse, or if onResult returns one.
+func Paginate(
+	store storetypes.KVStore,
+	pageReq *query.PageRequest,
+	descending bool,
+	onResult func(key, value []byte) (bool, error),
+) (*query.PageResponse, error) {
+	if err := ValidatePageRequest(pageReq); err != nil {
+		return nil, err
+	}
+
+	var key []byte
+	limit := uint64(DefaultLimit)
+	if pageReq != nil {
+		if len(pageReq.Key) > 0 {
+			key = pageReq.Key
+		}
+		if pageReq.Limit > 0 {
+			limit = pageReq.Limit
+		}
+	}
+
+	var iter storetypes.Iterator
+	if descending {
+		// The end of a reverse iterator is exclusive, but the key should be included.
+		var end []byte
+		if key != nil {
+			end = append(bytes.Clone(key), 0x00)
+		}
+		iter = store.ReverseIterator(nil, end)
+	} else {
+		iter = store.Iterator(key, nil)
+	}
+	defer iter.Close()
+
+	var count uint64
+	for ; iter.Valid(); iter.Next() {
+		if count == limit {
+			return &query.PageResponse{NextKey: iter.Key()}, nil
+		}
+		included, err := onResult(iter.Key(), iter.Value())
+		if err != nil {
+			return nil, err
+		}
+		if included {
+			count++
+		}
+	}
+
+	return &query.PageResponse{}, nil
+}
@@ -0,0 +1,185 @@
+package pagination_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/store/dbadapter"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/provenance-io/provenance/internal/pagination"
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+func TestValidatePageRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		pageReq *query.PageRequest
+		expErr  string
+	}{
+		{name: "nil", pageReq: nil},
+		{name: "empty", pageReq: &query.PageRequest{}},
+		{name: "key and limit", pageReq: &query.PageRequest{Key: []byte("a"), Limit: 3}},
+		{
+			name:    "offset",
+			pageReq: &query.PageRequest{Offset: 1},
+			expErr:  "offset is not supported, use the next_key of the previous page instead",
+		},
+		{
+			name:    "count total",
+			pageReq: &query.PageRequest{CountTotal: true},
+			expErr:  "count_total is not supported",
+		},
+		{
+			name:    "reverse",
+			pageReq: &query.PageRequest{Reverse: true},
+			expErr:  "reverse is not supported, use the order field instead",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := pagination.ValidatePageRequest(tc.pageReq)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ValidatePageRequest")
+		})
+	}
+}
+
+// newStore creates a new store with an entry for each of the provided keys (the value is the same as the key).
+func newStore(keys ...string) storetypes.KVStore {
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+	for _, key := range keys {
+		store.Set([]byte(key), []byte(key))
+	}
+	return store
+}
+
+func TestPaginate(t *testing.T) {
+	// "c" is filtered out by the onResult func used for these tests.
+	allKeys := []string{"a", "b", "c", "d", "e"}
+
+	tests := []struct {
+		name       string
+		pageReq    *query.PageRequest
+		descending bool
+		resultErr  string
+		expKeys    []string
+		expNextKey string
+		expErr     string
+	}{
+		{
+			name:    "nil page request",
+			expKeys: []string{"a", "b", "d", "e"},
+		},
+		{
+			name:       "nil page request: descending",
+			descending: true,
+			expKeys:    []string{"e", "d", "b", "a"},
+		},
+		{
+			name:       "limit 2",
+			pageReq:    &query.PageRequest{Limit: 2},
+			expKeys:    []string{"a", "b"},
+			expNextKey: "c",
+		},
+		{
+			name:       "limit 2: second page",
+			pageReq:    &query.PageRequest{Key: []byte("c"), Limit: 2},
+			expKeys:    []string{"d", "e"},
+			expNextKey: "",
+		},
+		{
+			name:       "limit 2: descending",
+			pageReq:    &query.PageRequest{Limit: 2},
+			descending: true,
+			expKeys:    []string{"e", "d"},
+			expNextKey: "c",
+		},
+		{
+			name:       "limit 2: descending: second page",
+			pageReq:    &query.PageRequest{Key: []byte("c"), Limit: 2},
+			descending: true,
+			expKeys:    []string{"b", "a"},
+		},
+		{
+			name:       "key not in store: descending",
+			pageReq:    &query.PageRequest{Key: []byte("bb")},
+			descending: true,
+			expKeys:    []string{"b", "a"},
+		},
+		{
+			name:    "empty key",
+			pageReq: &query.PageRequest{Key: []byte{}},
+			expKeys: []string{"a", "b", "d", "e"},
+		},
+		{
+			name:       "empty key: descending",
+			pageReq:    &query.PageRequest{Key: []byte{}},
+			descending: true,
+			expKeys:    []string{"e", "d", "b", "a"},
+		},
+		{
+			name:    "invalid page request",
+			pageReq: &query.PageRequest{Offset: 1},
+			expErr:  "offset is not supported, use the next_key of the previous page instead",
+		},
+		{
+			name:      "error from onResult",
+			resultErr: "d",
+			expErr:    "error for d",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			store := newStore(allKeys...)
+			var keys []string
+			onResult := func(key, value []byte) (bool, error) {
+				assert.Equal(t, string(key), string(value), "value for key %q", string(key))
+				if string(key) == tc.resultErr {
+					return false, errors.New("error for " + string(key))
+				}
+				if string(key) == "c" {
+					return false, nil
+				}
+				keys = append(keys, string(key))
+				return true, nil
+			}
+
+			pageResp, err := pagination.Paginate(store, tc.pageReq, tc.descending, onResult)
+			assertions.AssertErrorValue(t, err, tc.expErr, "Paginate error")
+			if len(tc.expErr) > 0 {
+				assert.Nil(t, pageResp, "Paginate page response")
+				return
+			}
+			assert.Equal(t, tc.expKeys, keys, "keys provided to onResult")
+			require.NotNil(t, pageResp, "Paginate page response")
+			assert.Equal(t, tc.expNextKey, string(pageResp.NextKey), "NextKey")
+			assert.Zero(t, pageResp.Total, "Total")
+		})
+	}
+}
+
+func TestPaginateDefaultLimit(t *testing.T) {
+	keys := make([]string, pagination.DefaultLimit+1)
+	for i := range keys {
+		keys[i] = string([]byte{byte(i / 256), byte(i % 256)})
+	}
+	store := newStore(keys...)
+
+	count := 0
+	pageResp, err := pagination.Paginate(store, nil, false, func(_, _ []byte) (bool, error) {
+		count++
+		return true, nil
+	})
+	require.NoError(t, err, "Paginate")
+	assert.Equal(t, pagination.DefaultLimit, count, "number of results")
+	assert.Equal(t, keys[pagination.DefaultLimit], string(pageResp.NextKey), "NextKey")
+}
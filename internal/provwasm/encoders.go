@@ -0,0 +1,73 @@
+package provwasm
+
+import (
+	"encoding/json"
+	"fmt"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// WasmMsg is the top-level custom message sent from a provenance smart contract.
+type WasmMsg struct {
+	// Route is the name of the module that handles the message, e.g. "marker".
+	Route string `json:"route"`
+	// Params are the module specific message parameters.
+	Params json.RawMessage `json:"params"`
+	// Version is the version of the params being used.
+	Version string `json:"version,omitempty"`
+}
+
+// Encoder describes behavior for provenance smart contract message encoding.
+// The contract is the address of the contract sending the message.
+type Encoder func(contract sdk.AccAddress, msg json.RawMessage, version string) ([]sdk.Msg, error)
+
+// EncoderRegistry maps routes to encoders.
+type EncoderRegistry struct {
+	encoders map[string]Encoder
+}
+
+// NewEncoderRegistry creates a new registry for message encoders.
+func NewEncoderRegistry() *EncoderRegistry {
+	return &EncoderRegistry{
+		encoders: make(map[string]Encoder),
+	}
+}
+
+// RegisterEncoder adds a message encoder for the given route.
+func (r *EncoderRegistry) RegisterEncoder(route string, encoder Encoder) {
+	if _, exists := r.encoders[route]; exists {
+		panic(fmt.Sprintf("wasm: encoder already registered for route: %s", route))
+	}
+	r.encoders[route] = encoder
+}
+
+// MessageEncoders provides provenance message encoding support for smart contracts.
+func MessageEncoders(registry *EncoderRegistry) *wasmkeeper.MessageEncoders {
+	return &wasmkeeper.MessageEncoders{
+		Custom: customEncoder(registry),
+	}
+}
+
+// customEncoder dispatches custom contract messages to the encoder registered for their route.
+func customEncoder(registry *EncoderRegistry) wasmkeeper.CustomEncoder {
+	return func(contract sdk.AccAddress, msg json.RawMessage) ([]sdk.Msg, error) {
+		var wasmMsg WasmMsg
+		if err := json.Unmarshal(msg, &wasmMsg); err != nil {
+			return nil, wasmvmtypes.InvalidRequest{Err: fmt.Sprintf("invalid custom message: %v", err), Request: msg}
+		}
+		encoder, exists := registry.encoders[wasmMsg.Route]
+		if !exists {
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("no encoder registered for route %q", wasmMsg.Route)}
+		}
+		return encoder(contract, wasmMsg.Params, wasmMsg.Version)
+	}
+}
+
+// ConsumeGas consumes a fixed amount of gas for a contract operation. Fixed costs keep contract
+// operations deterministic regardless of how the underlying data is stored.
+func ConsumeGas(ctx sdk.Context, amount uint64, descriptor string) {
+	ctx.GasMeter().ConsumeGas(amount, "provwasm: "+descriptor)
+}
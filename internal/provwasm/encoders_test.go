@@ -0,0 +1,66 @@
+package provwasm
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+func TestCustomEncoder(t *testing.T) {
+	contract := sdk.AccAddress("contract____________")
+	expMsg := &banktypes.MsgSend{FromAddress: contract.String()}
+
+	var gotParams json.RawMessage
+	var gotVersion string
+	registry := NewEncoderRegistry()
+	registry.RegisterEncoder("test", func(c sdk.AccAddress, msg json.RawMessage, version string) ([]sdk.Msg, error) {
+		gotParams, gotVersion = msg, version
+		if c.Equals(contract) {
+			return []sdk.Msg{expMsg}, nil
+		}
+		return nil, errors.New("wrong contract")
+	})
+	encoder := MessageEncoders(registry).Custom
+
+	msgs, err := encoder(contract, json.RawMessage(`{"route":"test","params":{"a":1},"version":"2.0.0"}`))
+	require.NoError(t, err, "encoder known route")
+	assert.Equal(t, []sdk.Msg{expMsg}, msgs, "encoder known route msgs")
+	assert.JSONEq(t, `{"a":1}`, string(gotParams), "params given to route encoder")
+	assert.Equal(t, "2.0.0", gotVersion, "version given to route encoder")
+
+	_, err = encoder(contract, json.RawMessage(`{"route":"other","params":{}}`))
+	assertions.AssertErrorContents(t, err, []string{`no encoder registered for route "other"`}, "encoder unknown route")
+
+	_, err = encoder(contract, json.RawMessage(`not json`))
+	assertions.AssertErrorContents(t, err, []string{"invalid custom message"}, "encoder bad json")
+
+	assert.Panics(t, func() {
+		registry.RegisterEncoder("test", nil)
+	}, "registering a second encoder for a route")
+}
+
+func TestCustomQuerier(t *testing.T) {
+	registry := NewQuerierRegistry()
+	registry.RegisterQuerier("test", func(_ sdk.Context, query json.RawMessage, version string) ([]byte, error) {
+		return []byte(`{"query":` + string(query) + `,"version":"` + version + `"}`), nil
+	})
+	querier := CustomQuerier(registry, nil)
+
+	bz, err := querier(sdk.Context{}, json.RawMessage(`{"route":"test","params":{"b":2},"version":"1"}`))
+	require.NoError(t, err, "querier known route")
+	assert.JSONEq(t, `{"query":{"b":2},"version":"1"}`, string(bz), "querier known route result")
+
+	_, err = querier(sdk.Context{}, json.RawMessage(`{"route":"other","params":{}}`))
+	assertions.AssertErrorContents(t, err, []string{`no querier registered for route "other"`}, "querier unknown route")
+
+	_, err = querier(sdk.Context{}, json.RawMessage(`[]`))
+	assertions.AssertErrorContents(t, err, []string{"invalid custom query"}, "querier bad json")
+}
@@ -0,0 +1,83 @@
+package provwasm
+
+import (
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	msgfeestypes "github.com/provenance-io/provenance/x/msgfees/types"
+)
+
+// GasCostGetter returns the fixed gas cost for an operation invoked by a smart contract, and whether there is one.
+// Operations are identified by msg type url, query path, or "custom/<route>" for custom provwasm queries.
+type GasCostGetter func(ctx sdk.Context, operation string) (uint64, bool)
+
+// CustomQueryOperation returns the operation name used to look up the gas cost of a custom provwasm query route.
+func CustomQueryOperation(route string) string {
+	return msgfeestypes.WasmGasCostCustomPrefix + route
+}
+
+// runWithGasCost runs the provided function. If the operation has a fixed gas cost, that cost is consumed from the
+// context's gas meter first, then the function is run with its own gas meter limited to the fixed cost. If the function
+// needs more gas than that, an out-of-gas error is returned. Otherwise, the function is run using the context's gas meter as-is.
+func runWithGasCost[R any](ctx sdk.Context, getGasCost GasCostGetter, operation string, run func(ctx sdk.Context) (R, error)) (R, error) {
+	if getGasCost == nil {
+		return run(ctx)
+	}
+	gas, ok := getGasCost(ctx, operation)
+	if !ok {
+		return run(ctx)
+	}
+
+	ConsumeGas(ctx, gas, operation)
+	return runWithGasLimit(ctx.WithGasMeter(storetypes.NewGasMeter(gas)), operation, run)
+}
+
+// runWithGasLimit runs the provided function, converting an out-of-gas panic from the context's gas meter into an error.
+func runWithGasLimit[R any](ctx sdk.Context, operation string, run func(ctx sdk.Context) (R, error)) (rv R, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			oog, isOOG := r.(storetypes.ErrorOutOfGas)
+			if !isOOG {
+				panic(r)
+			}
+			var zero R
+			rv = zero
+			err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "%s needs more than its fixed gas cost of %d: %s",
+				operation, ctx.GasMeter().Limit(), oog.Descriptor)
+		}
+	}()
+	return run(ctx)
+}
+
+// gasCostMessageRouter is a wasmkeeper.MessageRouter that charges fixed gas costs for msgs that have one.
+type gasCostMessageRouter struct {
+	router     wasmkeeper.MessageRouter
+	getGasCost GasCostGetter
+}
+
+var _ wasmkeeper.MessageRouter = gasCostMessageRouter{}
+
+// NewGasCostMessageRouter wraps the provided router so that msgs sent by smart contracts are charged
+// their fixed gas cost (if they have one) instead of the gas they actually use, failing if they need more.
+func NewGasCostMessageRouter(router wasmkeeper.MessageRouter, getGasCost GasCostGetter) wasmkeeper.MessageRouter {
+	return gasCostMessageRouter{router: router, getGasCost: getGasCost}
+}
+
+// Handler returns the handler for the provided msg, wrapped to charge the msg's fixed gas cost.
+func (r gasCostMessageRouter) Handler(msg sdk.Msg) baseapp.MsgServiceHandler {
+	handler := r.router.Handler(msg)
+	if handler == nil {
+		return nil
+	}
+	return func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error) {
+		return runWithGasCost(ctx, r.getGasCost, sdk.MsgTypeURL(req), func(ctx sdk.Context) (*sdk.Result, error) {
+			return handler(ctx, req)
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package provwasm
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// testRouter is a wasmkeeper.MessageRouter that uses the same handler for every msg.
+type testRouter struct {
+	handler baseapp.MsgServiceHandler
+}
+
+func (r testRouter) Handler(_ sdk.Msg) baseapp.MsgServiceHandler {
+	return r.handler
+}
+
+func TestRunWithGasCost(t *testing.T) {
+	costs := map[string]uint64{"fixed": 500}
+	getGasCost := func(_ sdk.Context, operation string) (uint64, bool) {
+		gas, ok := costs[operation]
+		return gas, ok
+	}
+	useGas := func(amount uint64, err error) func(ctx sdk.Context) (string, error) {
+		return func(ctx sdk.Context) (string, error) {
+			ctx.GasMeter().ConsumeGas(amount, "test")
+			return "result", err
+		}
+	}
+
+	tests := []struct {
+		name       string
+		getGasCost GasCostGetter
+		operation  string
+		used       uint64
+		err        error
+		expErr     string
+		expResult  string
+		expGas     uint64
+	}{
+		{name: "nil getter", getGasCost: nil, operation: "fixed", used: 123, expResult: "result", expGas: 123},
+		{name: "no fixed cost", getGasCost: getGasCost, operation: "other", used: 123, expResult: "result", expGas: 123},
+		{name: "fixed cost more than used", getGasCost: getGasCost, operation: "fixed", used: 123, expResult: "result", expGas: 500},
+		{name: "fixed cost equal to used", getGasCost: getGasCost, operation: "fixed", used: 500, expResult: "result", expGas: 500},
+		{
+			name:       "fixed cost less than used",
+			getGasCost: getGasCost,
+			operation:  "fixed",
+			used:       5_000,
+			expErr:     "fixed needs more than its fixed gas cost of 500: test: out of gas",
+			expGas:     500,
+		},
+		{
+			name:       "fixed cost with error",
+			getGasCost: getGasCost,
+			operation:  "fixed",
+			used:       123,
+			err:        errors.New("oops"),
+			expErr:     "oops",
+			expResult:  "result",
+			expGas:     500,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithGasMeter(storetypes.NewGasMeter(10_000))
+			rv, err := runWithGasCost(ctx, tc.getGasCost, tc.operation, useGas(tc.used, tc.err))
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "runWithGasCost error")
+			} else {
+				assert.NoError(t, err, "runWithGasCost error")
+			}
+			assert.Equal(t, tc.expResult, rv, "runWithGasCost result")
+			assert.Equal(t, tc.expGas, ctx.GasMeter().GasConsumed(), "gas consumed")
+		})
+	}
+
+	t.Run("fixed cost more than remaining", func(t *testing.T) {
+		ctx := sdk.Context{}.WithGasMeter(storetypes.NewGasMeter(400))
+		called := false
+		assert.PanicsWithValue(t, storetypes.ErrorOutOfGas{Descriptor: "provwasm: fixed"}, func() {
+			_, _ = runWithGasCost(ctx, getGasCost, "fixed", func(_ sdk.Context) (string, error) {
+				called = true
+				return "result", nil
+			})
+		}, "runWithGasCost with a fixed cost more than the contract has left")
+		assert.False(t, called, "operation was run")
+	})
+
+	t.Run("other panics are not recovered", func(t *testing.T) {
+		ctx := sdk.Context{}.WithGasMeter(storetypes.NewGasMeter(10_000))
+		assert.PanicsWithValue(t, "boom", func() {
+			_, _ = runWithGasCost(ctx, getGasCost, "fixed", func(_ sdk.Context) (string, error) {
+				panic("boom")
+			})
+		}, "runWithGasCost with an operation that panics")
+	})
+}
+
+func TestGasCostMessageRouter(t *testing.T) {
+	msg := &banktypes.MsgSend{}
+	getGasCost := func(_ sdk.Context, operation string) (uint64, bool) {
+		return 3_000, operation == sdk.MsgTypeURL(msg)
+	}
+	handler := func(ctx sdk.Context, _ sdk.Msg) (*sdk.Result, error) {
+		ctx.GasMeter().ConsumeGas(2_000, "test")
+		return &sdk.Result{}, nil
+	}
+
+	router := NewGasCostMessageRouter(testRouter{handler: handler}, getGasCost)
+	ctx := sdk.Context{}.WithGasMeter(storetypes.NewGasMeter(10_000))
+	res, err := router.Handler(msg)(ctx, msg)
+	require.NoError(t, err, "handler with fixed cost")
+	assert.NotNil(t, res, "handler with fixed cost result")
+	assert.Equal(t, uint64(3_000), ctx.GasMeter().GasConsumed(), "gas consumed by msg with fixed cost")
+
+	ctx = sdk.Context{}.WithGasMeter(storetypes.NewGasMeter(10_000))
+	other := &banktypes.MsgMultiSend{}
+	_, err = router.Handler(other)(ctx, other)
+	require.NoError(t, err, "handler without fixed cost")
+	assert.Equal(t, uint64(2_000), ctx.GasMeter().GasConsumed(), "gas consumed by msg without fixed cost")
+
+	assert.Nil(t, NewGasCostMessageRouter(testRouter{}, getGasCost).Handler(msg), "handler for unknown msg")
+}
+
+func TestCustomQuerierGasCost(t *testing.T) {
+	registry := NewQuerierRegistry()
+	registry.RegisterQuerier("test", func(ctx sdk.Context, _ json.RawMessage, _ string) ([]byte, error) {
+		ctx.GasMeter().ConsumeGas(1_000, "test")
+		return []byte(`{}`), nil
+	})
+	var gotOperation string
+	querier := CustomQuerier(registry, func(_ sdk.Context, operation string) (uint64, bool) {
+		gotOperation = operation
+		return 2_500, true
+	})
+
+	ctx := sdk.Context{}.WithGasMeter(storetypes.NewGasMeter(10_000))
+	_, err := querier(ctx, json.RawMessage(`{"route":"test","params":{}}`))
+	require.NoError(t, err, "querier")
+	assert.Equal(t, "custom/test", gotOperation, "operation given to gas cost getter")
+	assert.Equal(t, uint64(2_500), ctx.GasMeter().GasConsumed(), "gas consumed by query")
+}
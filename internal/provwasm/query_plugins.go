@@ -21,6 +21,16 @@ import (
 // Querier describes behavior for provenance smart contract query support.
 type Querier func(ctx sdk.Context, query json.RawMessage, version string) ([]byte, error)
 
+// WasmQuery is the top-level custom query sent from a provenance smart contract.
+type WasmQuery struct {
+	// Route is the name of the module that handles the query, e.g. "marker".
+	Route string `json:"route"`
+	// Params are the module specific query parameters.
+	Params json.RawMessage `json:"params"`
+	// Version is the version of the params being used.
+	Version string `json:"version,omitempty"`
+}
+
 // QuerierRegistry maps routes to queriers.
 type QuerierRegistry struct {
 	queriers map[string]Querier
@@ -42,7 +52,8 @@ func (qr *QuerierRegistry) RegisterQuerier(route string, querier Querier) {
 }
 
 // QueryPlugins provides provenance query support for smart contracts.
-func QueryPlugins(queryRouter baseapp.GRPCQueryRouter, cdc codec.Codec) *wasmkeeper.QueryPlugins {
+// Queries with a fixed gas cost (according to getGasCost) are only charged that cost.
+func QueryPlugins(registry *QuerierRegistry, queryRouter baseapp.GRPCQueryRouter, cdc codec.Codec, getGasCost GasCostGetter) *wasmkeeper.QueryPlugins {
 	protoCdc, ok := cdc.(*codec.ProtoCodec)
 	if !ok {
 		panic(fmt.Errorf("codec must be *codec.ProtoCodec type: actual: %T", cdc))
@@ -51,13 +62,31 @@ func QueryPlugins(queryRouter baseapp.GRPCQueryRouter, cdc codec.Codec) *wasmkee
 	stargateCdc := codec.NewProtoCodec(provwasmtypes.NewWasmInterfaceRegistry(protoCdc.InterfaceRegistry()))
 
 	return &wasmkeeper.QueryPlugins{
-		Stargate: StargateQuerier(queryRouter, stargateCdc),
-		Grpc:     GrpcQuerier(queryRouter),
+		Custom:   CustomQuerier(registry, getGasCost),
+		Stargate: StargateQuerier(queryRouter, stargateCdc, getGasCost),
+		Grpc:     GrpcQuerier(queryRouter, getGasCost),
+	}
+}
+
+// CustomQuerier dispatches custom contract queries to the querier registered for their route.
+func CustomQuerier(registry *QuerierRegistry, getGasCost GasCostGetter) wasmkeeper.CustomQuerier {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var query WasmQuery
+		if err := json.Unmarshal(request, &query); err != nil {
+			return nil, wasmvmtypes.InvalidRequest{Err: fmt.Sprintf("invalid custom query: %v", err), Request: request}
+		}
+		querier, exists := registry.queriers[query.Route]
+		if !exists {
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("no querier registered for route %q", query.Route)}
+		}
+		return runWithGasCost(ctx, getGasCost, CustomQueryOperation(query.Route), func(ctx sdk.Context) ([]byte, error) {
+			return querier(ctx, query.Params, query.Version)
+		})
 	}
 }
 
 // StargateQuerier dispatches whitelisted stargate queries
-func StargateQuerier(queryRouter baseapp.GRPCQueryRouter, cdc codec.Codec) func(ctx sdk.Context, request *wasmvmtypes.StargateQuery) ([]byte, error) {
+func StargateQuerier(queryRouter baseapp.GRPCQueryRouter, cdc codec.Codec, getGasCost GasCostGetter) func(ctx sdk.Context, request *wasmvmtypes.StargateQuery) ([]byte, error) {
 	return func(ctx sdk.Context, request *wasmvmtypes.StargateQuery) ([]byte, error) {
 		protoResponseType, err := GetWhitelistedQuery(request.Path)
 		if err != nil {
@@ -69,9 +98,11 @@ func StargateQuerier(queryRouter baseapp.GRPCQueryRouter, cdc codec.Codec) func(
 			return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("No route to query '%s'", request.Path)}
 		}
 
-		res, err := route(ctx, &abci.RequestQuery{
-			Data: request.Data,
-			Path: request.Path,
+		res, err := runWithGasCost(ctx, getGasCost, request.Path, func(ctx sdk.Context) (*abci.ResponseQuery, error) {
+			return route(ctx, &abci.RequestQuery{
+				Data: request.Data,
+				Path: request.Path,
+			})
 		})
 		if err != nil {
 			return nil, err
@@ -87,7 +118,7 @@ func StargateQuerier(queryRouter baseapp.GRPCQueryRouter, cdc codec.Codec) func(
 }
 
 // GrpcQuerier dispatches whitelisted queries and returns protobuf encoded responses
-func GrpcQuerier(queryRouter baseapp.GRPCQueryRouter) func(ctx sdk.Context, request *wasmvmtypes.GrpcQuery) (proto.Message, error) {
+func GrpcQuerier(queryRouter baseapp.GRPCQueryRouter, getGasCost GasCostGetter) func(ctx sdk.Context, request *wasmvmtypes.GrpcQuery) (proto.Message, error) {
 	return func(ctx sdk.Context, request *wasmvmtypes.GrpcQuery) (proto.Message, error) {
 		_, err := GetWhitelistedQuery(request.Path)
 		if err != nil {
@@ -99,9 +130,11 @@ func GrpcQuerier(queryRouter baseapp.GRPCQueryRouter) func(ctx sdk.Context, requ
 			return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("No route to query '%s'", request.Path)}
 		}
 
-		res, err := route(ctx, &abci.RequestQuery{
-			Data: request.Data,
-			Path: request.Path,
+		res, err := runWithGasCost(ctx, getGasCost, request.Path, func(ctx sdk.Context) (*abci.ResponseQuery, error) {
+			return route(ctx, &abci.RequestQuery{
+				Data: request.Data,
+				Path: request.Path,
+			})
 		})
 		if err != nil {
 			return nil, err
@@ -213,14 +213,29 @@ func init() {
 	setWhitelistedQuery("/provenance.exchange.v1.Query/OrderFeeCalc", &exchange.QueryOrderFeeCalcResponse{})
 	setWhitelistedQuery("/provenance.exchange.v1.Query/GetOrder", &exchange.QueryGetOrderResponse{})
 	setWhitelistedQuery("/provenance.exchange.v1.Query/GetOrderByExternalID", &exchange.QueryGetOrderByExternalIDResponse{})
+	setWhitelistedQuery("/provenance.exchange.v1.Query/GetOrdersByExternalIDPrefix", &exchange.QueryGetOrdersByExternalIDPrefixResponse{})
 	setWhitelistedQuery("/provenance.exchange.v1.Query/GetMarketOrders", &exchange.QueryGetMarketOrdersResponse{})
 	setWhitelistedQuery("/provenance.exchange.v1.Query/GetOwnerOrders", &exchange.QueryGetOwnerOrdersResponse{})
 	setWhitelistedQuery("/provenance.exchange.v1.Query/GetAssetOrders", &exchange.QueryGetAssetOrdersResponse{})
 	setWhitelistedQuery("/provenance.exchange.v1.Query/GetAllOrders", &exchange.QueryGetAllOrdersResponse{})
+	setWhitelistedQuery("/provenance.exchange.v1.Query/GetTriggerOrder", &exchange.QueryGetTriggerOrderResponse{})
+	setWhitelistedQuery("/provenance.exchange.v1.Query/GetMarketTriggerOrders", &exchange.QueryGetMarketTriggerOrdersResponse{})
+	setWhitelistedQuery("/provenance.exchange.v1.Query/GetOrderLink", &exchange.QueryGetOrderLinkResponse{})
+	setWhitelistedQuery("/provenance.exchange.v1.Query/GetMarketOrderLinks", &exchange.QueryGetMarketOrderLinksResponse{})
+	setWhitelistedQuery("/provenance.exchange.v1.Query/OrderBookDepth", &exchange.QueryOrderBookDepthResponse{})
+	setWhitelistedQuery("/provenance.exchange.v1.Query/TopOfBook", &exchange.QueryTopOfBookResponse{})
+	setWhitelistedQuery("/provenance.exchange.v1.Query/PriceAverages", &exchange.QueryPriceAveragesResponse{})
+	setWhitelistedQuery("/provenance.exchange.v1.Query/GetMarketSettlements", &exchange.QueryGetMarketSettlementsResponse{})
+	setWhitelistedQuery("/provenance.exchange.v1.Query/GetAllSettlements", &exchange.QueryGetAllSettlementsResponse{})
+	setWhitelistedQuery("/provenance.exchange.v1.Query/GetLatestNAV", &exchange.QueryGetLatestNAVResponse{})
+	setWhitelistedQuery("/provenance.exchange.v1.Query/GetNAVHistory", &exchange.QueryGetNAVHistoryResponse{})
+	setWhitelistedQuery("/provenance.exchange.v1.Query/SimulateSettlement", &exchange.QuerySimulateSettlementResponse{})
 	setWhitelistedQuery("/provenance.exchange.v1.Query/GetCommitment", &exchange.QueryGetCommitmentResponse{})
 	setWhitelistedQuery("/provenance.exchange.v1.Query/GetAccountCommitments", &exchange.QueryGetAccountCommitmentsResponse{})
 	setWhitelistedQuery("/provenance.exchange.v1.Query/GetMarketCommitments", &exchange.QueryGetMarketCommitmentsResponse{})
 	setWhitelistedQuery("/provenance.exchange.v1.Query/GetAllCommitments", &exchange.QueryGetAllCommitmentsResponse{})
+	setWhitelistedQuery("/provenance.exchange.v1.Query/GetCommitmentRewardPool", &exchange.QueryGetCommitmentRewardPoolResponse{})
+	setWhitelistedQuery("/provenance.exchange.v1.Query/GetAccountCommitmentRewards", &exchange.QueryGetAccountCommitmentRewardsResponse{})
 	setWhitelistedQuery("/provenance.exchange.v1.Query/GetMarket", &exchange.QueryGetMarketResponse{})
 	setWhitelistedQuery("/provenance.exchange.v1.Query/GetAllMarkets", &exchange.QueryGetAllMarketsResponse{})
 	setWhitelistedQuery("/provenance.exchange.v1.Query/Params", &exchange.QueryParamsResponse{})
@@ -233,6 +248,7 @@ func init() {
 	setWhitelistedQuery("/provenance.exchange.v1.Query/GetPaymentsWithTarget", &exchange.QueryGetPaymentsWithTargetResponse{})
 	setWhitelistedQuery("/provenance.exchange.v1.Query/GetAllPayments", &exchange.QueryGetAllPaymentsResponse{})
 	setWhitelistedQuery("/provenance.exchange.v1.Query/PaymentFeeCalc", &exchange.QueryPaymentFeeCalcResponse{})
+	setWhitelistedQuery("/provenance.exchange.v1.Query/GetAccountActivitySummary", &exchange.QueryGetAccountActivitySummaryResponse{})
 
 	// hold
 	setWhitelistedQuery("/provenance.hold.v1.Query/GetHolds", &hold.GetHoldsResponse{})
@@ -295,6 +311,8 @@ func init() {
 	// oracle
 	setWhitelistedQuery("/provenance.oracle.v1.Query/OracleAddress", &oracletypes.QueryOracleAddressResponse{})
 	setWhitelistedQuery("/provenance.oracle.v1.Query/Oracle", &oracletypes.QueryOracleResponse{})
+	setWhitelistedQuery("/provenance.oracle.v1.Query/Price", &oracletypes.QueryPriceResponse{})
+	setWhitelistedQuery("/provenance.oracle.v1.Query/Prices", &oracletypes.QueryPricesResponse{})
 
 	// quarantine
 	setWhitelistedQuery("/cosmos.quarantine.v1beta1.Query/IsQuarantined", &quarantine.QueryIsQuarantinedResponse{})
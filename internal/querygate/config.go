@@ -0,0 +1,89 @@
+// Package querygate lets a node disable or rate-limit expensive queries (e.g. full order dumps) so that
+// public endpoints can't be knocked over by unbounded list queries, while archive and indexer nodes keep them.
+package querygate
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const (
+	// FlagQueries is the app option with the full gRPC method names of the queries that are gated.
+	// If not provided, the DefaultQueries are gated.
+	FlagQueries = "query-gate.queries"
+	// FlagDisabled is the app option with whether the gated queries are disabled on this node.
+	FlagDisabled = "query-gate.disabled"
+	// FlagRateLimit is the app option with the number of gated queries per second this node allows (0 = unlimited).
+	FlagRateLimit = "query-gate.rate-limit"
+	// FlagBurst is the app option with the number of gated queries that can be run at once before the rate limit applies.
+	FlagBurst = "query-gate.burst"
+)
+
+// DefaultQueries are the queries that are gated if none are configured.
+// Each of these can iterate over an entire (unbounded) collection of state.
+var DefaultQueries = []string{
+	"/provenance.exchange.v1.Query/GetAllOrders",
+	"/provenance.exchange.v1.Query/GetMarketOrders",
+	"/provenance.marker.v1.Query/AllMarkers",
+	"/provenance.metadata.v1.Query/ScopesAll",
+	"/provenance.metadata.v1.Query/SessionsAll",
+	"/provenance.metadata.v1.Query/RecordsAll",
+}
+
+// Config is the configuration of the expensive query gate.
+type Config struct {
+	// Queries are the full gRPC method names of the gated queries.
+	Queries []string
+	// Disabled is whether the gated queries are disabled.
+	Disabled bool
+	// RateLimit is the number of gated queries allowed per second (across all of them). Zero means unlimited.
+	RateLimit float64
+	// Burst is the number of gated queries that can be run at once before the rate limit applies.
+	Burst int
+}
+
+// ConfigFromAppOpts reads the query gate configuration from the provided app options.
+func ConfigFromAppOpts(appOpts servertypes.AppOptions) Config {
+	rv := Config{
+		Queries:   cast.ToStringSlice(appOpts.Get(FlagQueries)),
+		Disabled:  cast.ToBool(appOpts.Get(FlagDisabled)),
+		RateLimit: cast.ToFloat64(appOpts.Get(FlagRateLimit)),
+		Burst:     cast.ToInt(appOpts.Get(FlagBurst)),
+	}
+	if len(rv.Queries) == 0 {
+		rv.Queries = DefaultQueries
+	}
+	if rv.RateLimit > 0 && rv.Burst == 0 {
+		rv.Burst = int(math.Max(1, math.Ceil(rv.RateLimit)))
+	}
+	return rv
+}
+
+// IsEnabled returns true if the gated queries are either disabled or rate-limited.
+func (c Config) IsEnabled() bool {
+	return c.Disabled || c.RateLimit > 0
+}
+
+// Validate returns an error if this config is invalid.
+func (c Config) Validate() error {
+	var errs []error
+	for _, query := range c.Queries {
+		service, method, ok := strings.Cut(strings.TrimPrefix(query, "/"), "/")
+		if !strings.HasPrefix(query, "/") || !ok || len(service) == 0 || len(method) == 0 || strings.Contains(method, "/") {
+			errs = append(errs, fmt.Errorf("invalid %s entry %q: expected format /<service>/<method>", FlagQueries, query))
+		}
+	}
+	if c.RateLimit < 0 {
+		errs = append(errs, fmt.Errorf("invalid %s %v: cannot be negative", FlagRateLimit, c.RateLimit))
+	}
+	if c.Burst < 0 {
+		errs = append(errs, fmt.Errorf("invalid %s %d: cannot be negative", FlagBurst, c.Burst))
+	}
+	return errors.Join(errs...)
+}
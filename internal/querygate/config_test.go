@@ -0,0 +1,101 @@
+package querygate_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+
+	"github.com/provenance-io/provenance/internal/querygate"
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+func TestConfigFromAppOpts(t *testing.T) {
+	tests := []struct {
+		name    string
+		appOpts simtestutil.AppOptionsMap
+		exp     querygate.Config
+	}{
+		{
+			name:    "defaults",
+			appOpts: simtestutil.AppOptionsMap{},
+			exp:     querygate.Config{Queries: querygate.DefaultQueries},
+		},
+		{
+			name: "everything set",
+			appOpts: simtestutil.AppOptionsMap{
+				querygate.FlagQueries:   []interface{}{"/provenance.exchange.v1.Query/GetAllOrders"},
+				querygate.FlagDisabled:  true,
+				querygate.FlagRateLimit: 2.5,
+				querygate.FlagBurst:     10,
+			},
+			exp: querygate.Config{
+				Queries:   []string{"/provenance.exchange.v1.Query/GetAllOrders"},
+				Disabled:  true,
+				RateLimit: 2.5,
+				Burst:     10,
+			},
+		},
+		{
+			name:    "rate limit without burst",
+			appOpts: simtestutil.AppOptionsMap{querygate.FlagRateLimit: "2.5"},
+			exp:     querygate.Config{Queries: querygate.DefaultQueries, RateLimit: 2.5, Burst: 3},
+		},
+		{
+			name:    "fractional rate limit without burst",
+			appOpts: simtestutil.AppOptionsMap{querygate.FlagRateLimit: 0.1},
+			exp:     querygate.Config{Queries: querygate.DefaultQueries, RateLimit: 0.1, Burst: 1},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := querygate.ConfigFromAppOpts(tc.appOpts)
+			assert.Equal(t, tc.exp, cfg, "ConfigFromAppOpts result")
+		})
+	}
+}
+
+func TestConfigIsEnabled(t *testing.T) {
+	assert.False(t, querygate.Config{Queries: querygate.DefaultQueries}.IsEnabled(), "IsEnabled with nothing disabled or limited")
+	assert.True(t, querygate.Config{Disabled: true}.IsEnabled(), "IsEnabled when disabled")
+	assert.True(t, querygate.Config{RateLimit: 1}.IsEnabled(), "IsEnabled when rate limited")
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		cfg    querygate.Config
+		expErr []string
+	}{
+		{
+			name: "default queries",
+			cfg:  querygate.Config{Queries: querygate.DefaultQueries, RateLimit: 1, Burst: 1},
+		},
+		{
+			name: "bad queries",
+			cfg:  querygate.Config{Queries: []string{"provenance.exchange.v1.Query/GetAllOrders", "/provenance.exchange.v1.Query", "/a/b/c"}},
+			expErr: []string{
+				`invalid query-gate.queries entry "provenance.exchange.v1.Query/GetAllOrders": expected format /<service>/<method>`,
+				`invalid query-gate.queries entry "/provenance.exchange.v1.Query": expected format /<service>/<method>`,
+				`invalid query-gate.queries entry "/a/b/c": expected format /<service>/<method>`,
+			},
+		},
+		{
+			name: "negative numbers",
+			cfg:  querygate.Config{RateLimit: -1, Burst: -2},
+			expErr: []string{
+				"invalid query-gate.rate-limit -1: cannot be negative",
+				"invalid query-gate.burst -2: cannot be negative",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.cfg.Validate()
+			assertions.AssertErrorContents(t, err, tc.expErr, "Validate")
+		})
+	}
+}
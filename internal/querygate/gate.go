@@ -0,0 +1,98 @@
+package querygate
+
+import (
+	"context"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	cerrs "cosmossdk.io/errors"
+)
+
+// Codespace is the codespace of the query gate errors.
+const Codespace = "querygate"
+
+var (
+	// ErrQueryDisabled is returned when a gated query is disabled on this node.
+	ErrQueryDisabled = cerrs.RegisterWithGRPCCode(Codespace, 2, codes.Unavailable, "query disabled")
+	// ErrQueryRateLimited is returned when a gated query has been run too many times recently on this node.
+	ErrQueryRateLimited = cerrs.RegisterWithGRPCCode(Codespace, 3, codes.ResourceExhausted, "query rate limited")
+)
+
+// Gate decides whether a query is allowed to run on this node.
+// A nil Gate allows all queries.
+type Gate struct {
+	queries  map[string]bool
+	disabled bool
+	limiter  *rate.Limiter
+}
+
+// NewGate creates a new Gate from the provided config.
+// Returns nil if the config doesn't disable or rate-limit anything.
+func NewGate(cfg Config) *Gate {
+	if !cfg.IsEnabled() {
+		return nil
+	}
+	rv := &Gate{
+		queries:  make(map[string]bool, len(cfg.Queries)),
+		disabled: cfg.Disabled,
+	}
+	for _, query := range cfg.Queries {
+		rv.queries[query] = true
+	}
+	if !cfg.Disabled && cfg.RateLimit > 0 {
+		rv.limiter = rate.NewLimiter(rate.Limit(cfg.RateLimit), cfg.Burst)
+	}
+	return rv
+}
+
+// Check returns an error if the query with the provided full gRPC method name (or ABCI query path) is not allowed to run right now.
+func (g *Gate) Check(query string) error {
+	if g == nil || !g.queries[query] {
+		return nil
+	}
+	if g.disabled {
+		return ErrQueryDisabled.Wrapf("%s is disabled on this node, use an archive or indexer node for it", query)
+	}
+	if g.limiter != nil && !g.limiter.Allow() {
+		return ErrQueryRateLimited.Wrapf("%s is rate limited on this node, try again later or use an archive or indexer node for it", query)
+	}
+	return nil
+}
+
+// gatedServer is a gRPC server that checks the gate before running any of its registered unary methods.
+type gatedServer struct {
+	gogogrpc.Server
+	gate *Gate
+}
+
+// NewGatedServer wraps the provided gRPC server so that the services registered with it are subject to the provided gate.
+// If the gate is nil, the server is returned as-is.
+func NewGatedServer(server gogogrpc.Server, gate *Gate) gogogrpc.Server {
+	if gate == nil {
+		return server
+	}
+	return gatedServer{Server: server, gate: gate}
+}
+
+// RegisterService registers the provided service with each of its methods wrapped to check the gate first.
+func (s gatedServer) RegisterService(sd *grpc.ServiceDesc, ss interface{}) {
+	desc := *sd
+	desc.Methods = make([]grpc.MethodDesc, len(sd.Methods))
+	for i, method := range sd.Methods {
+		fullMethod := "/" + sd.ServiceName + "/" + method.MethodName
+		handler := method.Handler
+		desc.Methods[i] = grpc.MethodDesc{
+			MethodName: method.MethodName,
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				if err := s.gate.Check(fullMethod); err != nil {
+					return nil, err
+				}
+				return handler(srv, ctx, dec, interceptor)
+			},
+		}
+	}
+	s.Server.RegisterService(&desc, ss)
+}
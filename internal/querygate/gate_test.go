@@ -0,0 +1,99 @@
+package querygate_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/provenance-io/provenance/internal/querygate"
+)
+
+const (
+	allOrders  = "/provenance.exchange.v1.Query/GetAllOrders"
+	allMarkers = "/provenance.marker.v1.Query/AllMarkers"
+	oneOrder   = "/provenance.exchange.v1.Query/GetOrder"
+)
+
+func TestNewGate(t *testing.T) {
+	assert.Nil(t, querygate.NewGate(querygate.Config{Queries: querygate.DefaultQueries}), "NewGate with nothing disabled or limited")
+	assert.NotNil(t, querygate.NewGate(querygate.Config{Queries: querygate.DefaultQueries, Disabled: true}), "NewGate when disabled")
+	assert.NotNil(t, querygate.NewGate(querygate.Config{Queries: querygate.DefaultQueries, RateLimit: 1, Burst: 1}), "NewGate when rate limited")
+}
+
+func TestGateCheck(t *testing.T) {
+	t.Run("nil gate", func(t *testing.T) {
+		var gate *querygate.Gate
+		assert.NoError(t, gate.Check(allOrders), "Check(%s)", allOrders)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		gate := querygate.NewGate(querygate.Config{Queries: []string{allOrders, allMarkers}, Disabled: true})
+		err := gate.Check(allOrders)
+		assert.ErrorIs(t, err, querygate.ErrQueryDisabled, "Check(%s)", allOrders)
+		assert.EqualError(t, err, allOrders+" is disabled on this node, use an archive or indexer node for it: query disabled", "Check(%s)", allOrders)
+		assert.ErrorIs(t, gate.Check(allMarkers), querygate.ErrQueryDisabled, "Check(%s)", allMarkers)
+		assert.NoError(t, gate.Check(oneOrder), "Check(%s)", oneOrder)
+	})
+
+	t.Run("rate limited", func(t *testing.T) {
+		// A rate so low that a new token won't show up during the test.
+		gate := querygate.NewGate(querygate.Config{Queries: []string{allOrders, allMarkers}, RateLimit: 0.001, Burst: 2})
+		assert.NoError(t, gate.Check(allOrders), "first Check(%s)", allOrders)
+		assert.NoError(t, gate.Check(allMarkers), "first Check(%s)", allMarkers)
+		err := gate.Check(allOrders)
+		assert.ErrorIs(t, err, querygate.ErrQueryRateLimited, "second Check(%s)", allOrders)
+		assert.EqualError(t, err, allOrders+" is rate limited on this node, try again later or use an archive or indexer node for it: query rate limited", "second Check(%s)", allOrders)
+		for i := 0; i < 5; i++ {
+			assert.NoError(t, gate.Check(oneOrder), "Check(%s) %d", oneOrder, i)
+		}
+	})
+}
+
+// testServer is a gogogrpc.Server that just records the services registered with it.
+type testServer struct {
+	descs []*grpc.ServiceDesc
+}
+
+func (s *testServer) RegisterService(sd *grpc.ServiceDesc, _ interface{}) {
+	s.descs = append(s.descs, sd)
+}
+
+func TestNewGatedServer(t *testing.T) {
+	server := &testServer{}
+	assert.Same(t, server, querygate.NewGatedServer(server, nil), "NewGatedServer with a nil gate")
+
+	called := 0
+	handler := func(_ interface{}, _ context.Context, _ func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+		called++
+		return "response", nil
+	}
+	desc := &grpc.ServiceDesc{
+		ServiceName: "provenance.exchange.v1.Query",
+		Methods: []grpc.MethodDesc{
+			{MethodName: "GetAllOrders", Handler: handler},
+			{MethodName: "GetOrder", Handler: handler},
+		},
+	}
+
+	gate := querygate.NewGate(querygate.Config{Queries: []string{allOrders}, Disabled: true})
+	querygate.NewGatedServer(server, gate).RegisterService(desc, nil)
+	require.Len(t, server.descs, 1, "registered services")
+	gated := server.descs[0]
+	assert.Equal(t, desc.ServiceName, gated.ServiceName, "registered service name")
+	require.Len(t, gated.Methods, 2, "registered methods")
+
+	_, err := gated.Methods[0].Handler(nil, context.Background(), nil, nil)
+	assert.ErrorIs(t, err, querygate.ErrQueryDisabled, "GetAllOrders error")
+	assert.Equal(t, codes.Unavailable, status.Code(err), "GetAllOrders gRPC status code")
+	assert.Equal(t, 0, called, "times handler called after GetAllOrders")
+
+	resp, err := gated.Methods[1].Handler(nil, context.Background(), nil, nil)
+	assert.NoError(t, err, "GetOrder error")
+	assert.Equal(t, "response", resp, "GetOrder response")
+	assert.Equal(t, 1, called, "times handler called after GetOrder")
+}
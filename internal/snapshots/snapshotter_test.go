@@ -0,0 +1,132 @@
+package snapshots_test
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	snapshot "cosmossdk.io/store/snapshots/types"
+	storetypes "cosmossdk.io/store/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"github.com/provenance-io/provenance/internal/snapshots"
+)
+
+// newTestStore creates a multistore with "exchange" and "marker" stores that has one committed version.
+func newTestStore(t *testing.T) (*rootmulti.Store, map[string]*storetypes.KVStoreKey) {
+	keys := storetypes.NewKVStoreKeys("exchange", "marker")
+	cms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	for _, key := range keys {
+		cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, cms.LoadLatestVersion(), "LoadLatestVersion")
+
+	cms.GetKVStore(keys["exchange"]).Set([]byte{0x01, 1}, []byte("order one"))
+	cms.GetKVStore(keys["exchange"]).Set([]byte{0x01, 2}, []byte("order two"))
+	cms.GetKVStore(keys["marker"]).Set([]byte{0x02, 3}, []byte("marker"))
+	cms.Commit()
+	return cms, keys
+}
+
+// takeSnapshot gets the payloads written by SnapshotExtension at the provided height.
+func takeSnapshot(t *testing.T, snapshotter *snapshots.StoreDigestSnapshotter, height uint64) [][]byte {
+	var payloads [][]byte
+	err := snapshotter.SnapshotExtension(height, func(payload []byte) error {
+		payloads = append(payloads, payload)
+		return nil
+	})
+	require.NoError(t, err, "SnapshotExtension(%d)", height)
+	return payloads
+}
+
+// newPayloadReader creates a payload reader that provides the given payloads followed by io.EOF.
+func newPayloadReader(payloads [][]byte) snapshot.ExtensionPayloadReader {
+	return func() ([]byte, error) {
+		if len(payloads) == 0 {
+			return nil, io.EOF
+		}
+		rv := payloads[0]
+		payloads = payloads[1:]
+		return rv, nil
+	}
+}
+
+func TestStoreDigestSnapshotter(t *testing.T) {
+	cms, keys := newTestStore(t)
+	snapshotter := snapshots.NewStoreDigestSnapshotter(cms, []storetypes.StoreKey{keys["marker"], keys["exchange"]})
+	assert.Equal(t, snapshots.SnapshotName, snapshotter.SnapshotName(), "SnapshotName")
+	assert.Equal(t, uint32(snapshots.SnapshotFormat), snapshotter.SnapshotFormat(), "SnapshotFormat")
+	assert.Equal(t, []uint32{snapshots.SnapshotFormat}, snapshotter.SupportedFormats(), "SupportedFormats")
+
+	payloads := takeSnapshot(t, snapshotter, 1)
+	require.Len(t, payloads, 2, "payloads")
+	var digests []snapshots.StoreDigest
+	for i, payload := range payloads {
+		var digest snapshots.StoreDigest
+		require.NoError(t, digest.Unmarshal(payload), "Unmarshal(payloads[%d])", i)
+		digests = append(digests, digest)
+	}
+	assert.Equal(t, "exchange", digests[0].StoreKey, "digests[0].StoreKey")
+	assert.Equal(t, uint64(2), digests[0].Entries, "digests[0].Entries")
+	assert.Equal(t, "marker", digests[1].StoreKey, "digests[1].StoreKey")
+	assert.Equal(t, uint64(1), digests[1].Entries, "digests[1].Entries")
+
+	t.Run("restore matching stores", func(t *testing.T) {
+		err := snapshotter.RestoreExtension(1, snapshots.SnapshotFormat, newPayloadReader(payloads))
+		assert.NoError(t, err, "RestoreExtension")
+	})
+
+	t.Run("restore unknown format", func(t *testing.T) {
+		err := snapshotter.RestoreExtension(1, 2, newPayloadReader(payloads))
+		assert.ErrorIs(t, err, snapshot.ErrUnknownFormat, "RestoreExtension")
+	})
+
+	t.Run("restore with reader error", func(t *testing.T) {
+		reader := func() ([]byte, error) {
+			return nil, errors.New("injected error")
+		}
+		err := snapshotter.RestoreExtension(1, snapshots.SnapshotFormat, reader)
+		assert.EqualError(t, err, "could not read store digest: injected error", "RestoreExtension")
+	})
+
+	t.Run("restore unknown store", func(t *testing.T) {
+		exchangeOnly := snapshots.NewStoreDigestSnapshotter(cms, []storetypes.StoreKey{keys["exchange"]})
+		err := exchangeOnly.RestoreExtension(1, snapshots.SnapshotFormat, newPayloadReader(payloads))
+		assert.EqualError(t, err, `snapshot has digest of unknown store "marker"`, "RestoreExtension")
+	})
+
+	t.Run("restore mismatched store", func(t *testing.T) {
+		cms.GetKVStore(keys["marker"]).Set([]byte{0x02, 4}, []byte("another marker"))
+		cms.Commit()
+
+		err := snapshotter.RestoreExtension(2, snapshots.SnapshotFormat, newPayloadReader(payloads))
+		require.Error(t, err, "RestoreExtension")
+		assert.Contains(t, err.Error(), "restored marker store does not match snapshot at height 2: expected 1 entries with hash ", "RestoreExtension error")
+		assert.NotContains(t, err.Error(), "exchange", "RestoreExtension error")
+	})
+}
+
+func TestGetStoreDigest(t *testing.T) {
+	cms, keys := newTestStore(t)
+	store := cms.GetKVStore(keys["exchange"])
+
+	orig := snapshots.GetStoreDigest(store, "exchange")
+	assert.Equal(t, "exchange", orig.StoreKey, "StoreKey")
+	assert.Equal(t, uint64(2), orig.Entries, "Entries")
+	assert.Len(t, orig.Hash, 32, "Hash")
+	assert.Equal(t, orig, snapshots.GetStoreDigest(store, "exchange"), "second GetStoreDigest")
+
+	// Moving a byte from a value to its key should change the digest even though the concatenated bytes are the same.
+	store.Delete([]byte{0x01, 2})
+	store.Set([]byte{0x01, 2, 'o'}, []byte("rder two"))
+	moved := snapshots.GetStoreDigest(store, "exchange")
+	assert.Equal(t, orig.Entries, moved.Entries, "Entries after moving byte")
+	assert.NotEqual(t, orig.Hash, moved.Hash, "Hash after moving byte")
+}
@@ -0,0 +1,259 @@
+package storemigrate
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"strings"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultBatchSize is the default number of entries moved between checkpoints.
+const DefaultBatchSize uint64 = 10_000
+
+// CheckpointPrefix is the prefix of the key that a move's checkpoint is stored under.
+const CheckpointPrefix = "storemigrate/checkpoint/"
+
+// Move identifies the entries to move from one store prefix to another.
+// Each entry under FromPrefix in the From store is moved to the To store with FromPrefix replaced by ToPrefix.
+type Move struct {
+	// Name identifies the move in checkpoints and logs.
+	Name string
+	// From is the store that the entries are moved out of.
+	From storetypes.StoreKey
+	// FromPrefix is the prefix of the entries to move. An empty prefix moves the whole store.
+	FromPrefix []byte
+	// To is the store that the entries are moved into.
+	To storetypes.StoreKey
+	// ToPrefix is the prefix that replaces FromPrefix in each moved entry's key.
+	ToPrefix []byte
+}
+
+// Validate returns an error if this move is missing something or would move entries onto themselves.
+func (m Move) Validate() error {
+	var errs []error
+	if len(strings.TrimSpace(m.Name)) == 0 {
+		errs = append(errs, errors.New("move name cannot be empty"))
+	}
+	if m.From == nil {
+		errs = append(errs, errors.New("move from store cannot be nil"))
+	}
+	if m.To == nil {
+		errs = append(errs, errors.New("move to store cannot be nil"))
+	}
+	if m.From != nil && m.To != nil && m.From.Name() == m.To.Name() &&
+		(bytes.HasPrefix(m.FromPrefix, m.ToPrefix) || bytes.HasPrefix(m.ToPrefix, m.FromPrefix)) {
+		errs = append(errs, fmt.Errorf("cannot move prefix %X to overlapping prefix %X in the same store %q", m.FromPrefix, m.ToPrefix, m.From.Name()))
+	}
+	return errors.Join(errs...)
+}
+
+// String returns a description of this move, e.g. "oldmodule/01 -> newmodule/02".
+func (m Move) String() string {
+	name := func(key storetypes.StoreKey) string {
+		if key == nil {
+			return "<nil>"
+		}
+		return key.Name()
+	}
+	return fmt.Sprintf("%s/%X -> %s/%X", name(m.From), m.FromPrefix, name(m.To), m.ToPrefix)
+}
+
+// Progress is the checkpoint of a move.
+type Progress struct {
+	// Moved is the number of entries moved so far.
+	Moved uint64 `json:"moved"`
+	// HashState is the state of the hash of the entries moved so far.
+	HashState []byte `json:"hash_state"`
+	// Done is whether all the entries have been moved and the destination verified.
+	Done bool `json:"done"`
+	// Hash is the verification hash of the moved entries. It is only set once Done.
+	Hash string `json:"hash,omitempty"`
+}
+
+// CheckpointKey returns the key that the checkpoint of the named move is stored under.
+func CheckpointKey(name string) []byte {
+	return []byte(CheckpointPrefix + name)
+}
+
+// Run moves the entries of the provided move in batches of batchSize entries (0 = DefaultBatchSize).
+//
+// After each batch, a checkpoint is written to the checkpoints store, and progress is logged.
+// At most limit entries are moved per call (0 = no limit). If the limit is reached before all entries
+// have been moved, the returned progress is not Done, and calling Run again continues from the checkpoint.
+// This allows a large move to be spread across several blocks.
+//
+// Once all entries have been moved, the destination prefix is verified by comparing its hash with the
+// hash of the entries that were moved, and the checkpoint is deleted. The hash can also be checked
+// on a stopped node using the verify-store-prefix command.
+//
+// The destination prefix must be empty when the move starts.
+// The checkpoints store must not have other entries that start with CheckpointPrefix,
+// and if it's the source or destination store, CheckpointPrefix cannot be under that store's prefix.
+func Run(ctx sdk.Context, move Move, checkpoints storetypes.KVStore, batchSize, limit uint64) (*Progress, error) {
+	if err := move.Validate(); err != nil {
+		return nil, err
+	}
+	if batchSize == 0 {
+		batchSize = DefaultBatchSize
+	}
+	logger := ctx.Logger().With("module", "storemigrate", "move", move.Name)
+
+	progress, err := getCheckpoint(checkpoints, move.Name)
+	if err != nil {
+		return nil, err
+	}
+	if progress.Done {
+		return progress, nil
+	}
+
+	from := ctx.KVStore(move.From)
+	to := ctx.KVStore(move.To)
+	if progress.Moved == 0 && !isPrefixEmpty(to, move.ToPrefix) {
+		return nil, fmt.Errorf("cannot run move %q (%s): destination prefix is not empty", move.Name, move)
+	}
+
+	hasher := sha256.New()
+	if len(progress.HashState) > 0 {
+		if err = hasher.(encoding.BinaryUnmarshaler).UnmarshalBinary(progress.HashState); err != nil {
+			return nil, fmt.Errorf("could not read hash state of move %q checkpoint: %w", move.Name, err)
+		}
+	}
+
+	if progress.Moved == 0 {
+		logger.Info("Starting store migration.", "from", move.String())
+	}
+
+	var movedThisRun uint64
+	for limit == 0 || movedThisRun < limit {
+		count := batchSize
+		if limit != 0 && limit-movedThisRun < count {
+			count = limit - movedThisRun
+		}
+		moved := moveBatch(from, move.FromPrefix, to, move.ToPrefix, hasher, count)
+		movedThisRun += moved
+		progress.Moved += moved
+
+		if moved < count {
+			break
+		}
+		if err = setCheckpoint(checkpoints, move.Name, progress, hasher); err != nil {
+			return nil, err
+		}
+		logger.Info("Store migration progress.", "moved", progress.Moved)
+	}
+
+	if !isPrefixEmpty(from, move.FromPrefix) {
+		if err = setCheckpoint(checkpoints, move.Name, progress, hasher); err != nil {
+			return nil, err
+		}
+		logger.Info("Store migration paused.", "moved", progress.Moved)
+		return progress, nil
+	}
+
+	expHash := hex.EncodeToString(hasher.Sum(nil))
+	actHash, actCount := HashPrefix(to, move.ToPrefix)
+	if actHash != expHash || actCount != progress.Moved {
+		return nil, fmt.Errorf("verification of move %q (%s) failed: moved %d entries with hash %s, but destination has %d entries with hash %s",
+			move.Name, move, progress.Moved, expHash, actCount, actHash)
+	}
+
+	checkpoints.Delete(CheckpointKey(move.Name))
+	progress.HashState = nil
+	progress.Done = true
+	progress.Hash = expHash
+	logger.Info("Store migration complete.", "moved", progress.Moved, "hash", progress.Hash)
+	return progress, nil
+}
+
+// moveBatch moves up to count entries from one prefix to another, adding each to the hasher.
+// Returns the number of entries moved.
+func moveBatch(from storetypes.KVStore, fromPrefix []byte, to storetypes.KVStore, toPrefix []byte, hasher hash.Hash, count uint64) uint64 {
+	type entry struct {
+		key   []byte
+		value []byte
+	}
+	entries := make([]entry, 0, count)
+
+	iter := storetypes.KVStorePrefixIterator(from, fromPrefix)
+	for ; iter.Valid() && uint64(len(entries)) < count; iter.Next() {
+		entries = append(entries, entry{key: bytes.Clone(iter.Key()), value: bytes.Clone(iter.Value())})
+	}
+	iter.Close()
+
+	// The source entries are deleted after iterating since we can't write to a store while iterating over it.
+	for _, e := range entries {
+		relKey := e.key[len(fromPrefix):]
+		writeEntryHash(hasher, relKey, e.value)
+		to.Set(append(append([]byte{}, toPrefix...), relKey...), e.value)
+		from.Delete(e.key)
+	}
+	return uint64(len(entries))
+}
+
+// HashPrefix returns the verification hash (as hex) of all the entries under a prefix in a store,
+// and the number of entries. The hash only uses the part of each key after the prefix, so an
+// entry has the same hash before and after it's moved.
+func HashPrefix(store storetypes.KVStore, prefix []byte) (string, uint64) {
+	hasher := sha256.New()
+	var count uint64
+	iter := storetypes.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		writeEntryHash(hasher, iter.Key()[len(prefix):], iter.Value())
+		count++
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), count
+}
+
+// writeEntryHash adds a key and value to a hasher, each preceded by its length.
+func writeEntryHash(hasher hash.Hash, key, value []byte) {
+	hasher.Write(binary.AppendUvarint(nil, uint64(len(key))))
+	hasher.Write(key)
+	hasher.Write(binary.AppendUvarint(nil, uint64(len(value))))
+	hasher.Write(value)
+}
+
+// isPrefixEmpty returns true if the store has no entries under the prefix.
+func isPrefixEmpty(store storetypes.KVStore, prefix []byte) bool {
+	iter := storetypes.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	return !iter.Valid()
+}
+
+// getCheckpoint reads the checkpoint of the named move. If there isn't one, an empty progress is returned.
+func getCheckpoint(checkpoints storetypes.KVStore, name string) (*Progress, error) {
+	rv := &Progress{}
+	bz := checkpoints.Get(CheckpointKey(name))
+	if len(bz) == 0 {
+		return rv, nil
+	}
+	if err := json.Unmarshal(bz, rv); err != nil {
+		return nil, fmt.Errorf("could not read move %q checkpoint: %w", name, err)
+	}
+	return rv, nil
+}
+
+// setCheckpoint records the progress (and hash state) of the named move.
+func setCheckpoint(checkpoints storetypes.KVStore, name string, progress *Progress, hasher hash.Hash) error {
+	var err error
+	progress.HashState, err = hasher.(encoding.BinaryMarshaler).MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("could not record hash state of move %q: %w", name, err)
+	}
+	bz, err := json.Marshal(progress)
+	if err != nil {
+		return fmt.Errorf("could not record move %q checkpoint: %w", name, err)
+	}
+	checkpoints.Set(CheckpointKey(name), bz)
+	return nil
+}
@@ -0,0 +1,138 @@
+package storemigrate_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/internal/storemigrate"
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+// newTestContext creates a context with an "old" and a "new" store.
+// The old store has numEntries entries under prefix 0x01, and one entry under prefix 0x02.
+func newTestContext(t *testing.T, numEntries int) (sdk.Context, *storetypes.KVStoreKey, *storetypes.KVStoreKey) {
+	t.Helper()
+	oldKey := storetypes.NewKVStoreKey("old")
+	newKey := storetypes.NewKVStoreKey("new")
+	ctx := testutil.DefaultContextWithKeys(map[string]*storetypes.KVStoreKey{"old": oldKey, "new": newKey}, nil, nil)
+	store := ctx.KVStore(oldKey)
+	for i := 0; i < numEntries; i++ {
+		store.Set([]byte(fmt.Sprintf("\x01key%03d", i)), []byte(fmt.Sprintf("value%d", i)))
+	}
+	store.Set([]byte("\x02other"), []byte("stays"))
+	return ctx, oldKey, newKey
+}
+
+func TestMoveValidate(t *testing.T) {
+	oldKey := storetypes.NewKVStoreKey("old")
+	newKey := storetypes.NewKVStoreKey("new")
+
+	tests := []struct {
+		name   string
+		move   storemigrate.Move
+		expErr []string
+	}{
+		{
+			name: "to another store",
+			move: storemigrate.Move{Name: "split", From: oldKey, FromPrefix: []byte{1}, To: newKey},
+		},
+		{
+			name: "within the same store",
+			move: storemigrate.Move{Name: "rekey", From: oldKey, FromPrefix: []byte{1}, To: oldKey, ToPrefix: []byte{2}},
+		},
+		{
+			name:   "nothing set",
+			move:   storemigrate.Move{},
+			expErr: []string{"move name cannot be empty", "move from store cannot be nil", "move to store cannot be nil"},
+		},
+		{
+			name:   "overlapping prefixes",
+			move:   storemigrate.Move{Name: "bad", From: oldKey, FromPrefix: []byte{1}, To: oldKey, ToPrefix: []byte{1, 2}},
+			expErr: []string{`cannot move prefix 01 to overlapping prefix 0102 in the same store "old"`},
+		},
+		{
+			name:   "whole store onto itself",
+			move:   storemigrate.Move{Name: "bad", From: oldKey, To: oldKey, ToPrefix: []byte{3}},
+			expErr: []string{`cannot move prefix  to overlapping prefix 03 in the same store "old"`},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.move.Validate()
+			assertions.AssertErrorContents(t, err, tc.expErr, "Validate")
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	ctx, oldKey, newKey := newTestContext(t, 25)
+	move := storemigrate.Move{Name: "split", From: oldKey, FromPrefix: []byte{1}, To: newKey, ToPrefix: []byte{9}}
+	expHash, expCount := storemigrate.HashPrefix(ctx.KVStore(oldKey), []byte{1})
+	require.Equal(t, uint64(25), expCount, "HashPrefix count before move")
+
+	checkpoints := ctx.KVStore(newKey)
+	progress, err := storemigrate.Run(ctx, move, checkpoints, 10, 0)
+	require.NoError(t, err, "Run")
+	assert.True(t, progress.Done, "Done")
+	assert.Equal(t, uint64(25), progress.Moved, "Moved")
+	assert.Equal(t, expHash, progress.Hash, "Hash")
+	assert.Nil(t, checkpoints.Get(storemigrate.CheckpointKey("split")), "checkpoint after move")
+
+	oldStore, newStore := ctx.KVStore(oldKey), ctx.KVStore(newKey)
+	assert.Nil(t, oldStore.Get([]byte("\x01key007")), "old entry after move")
+	assert.Equal(t, []byte("value7"), newStore.Get([]byte("\x09key007")), "new entry after move")
+	assert.Equal(t, []byte("stays"), oldStore.Get([]byte("\x02other")), "entry with another prefix after move")
+	actHash, actCount := storemigrate.HashPrefix(newStore, []byte{9})
+	assert.Equal(t, expHash, actHash, "HashPrefix of destination")
+	assert.Equal(t, uint64(25), actCount, "HashPrefix count of destination")
+
+	_, err = storemigrate.Run(ctx, storemigrate.Move{Name: "again", From: oldKey, FromPrefix: []byte{2}, To: newKey, ToPrefix: []byte{9}}, checkpoints, 10, 0)
+	assert.EqualError(t, err, `cannot run move "again" (old/02 -> new/09): destination prefix is not empty`, "Run with non-empty destination")
+}
+
+func TestRunWithLimit(t *testing.T) {
+	ctx, oldKey, newKey := newTestContext(t, 25)
+	move := storemigrate.Move{Name: "split", From: oldKey, FromPrefix: []byte{1}, To: newKey, ToPrefix: []byte{9}}
+	expHash, _ := storemigrate.HashPrefix(ctx.KVStore(oldKey), []byte{1})
+	checkpoints := ctx.KVStore(oldKey)
+
+	// Each call to Run is like a separate block, so the hash state has to survive in the checkpoint.
+	for i, expMoved := range []uint64{12, 24} {
+		progress, err := storemigrate.Run(ctx, move, checkpoints, 5, 12)
+		require.NoError(t, err, "Run %d", i)
+		assert.False(t, progress.Done, "Run %d Done", i)
+		assert.Equal(t, expMoved, progress.Moved, "Run %d Moved", i)
+		assert.NotNil(t, checkpoints.Get(storemigrate.CheckpointKey("split")), "Run %d checkpoint", i)
+	}
+
+	progress, err := storemigrate.Run(ctx, move, checkpoints, 5, 12)
+	require.NoError(t, err, "final Run")
+	assert.True(t, progress.Done, "final Run Done")
+	assert.Equal(t, uint64(25), progress.Moved, "final Run Moved")
+	assert.Equal(t, expHash, progress.Hash, "final Run Hash")
+	assert.Nil(t, checkpoints.Get(storemigrate.CheckpointKey("split")), "checkpoint after final Run")
+}
+
+func TestRunVerificationFailure(t *testing.T) {
+	ctx, oldKey, newKey := newTestContext(t, 10)
+	move := storemigrate.Move{Name: "split", From: oldKey, FromPrefix: []byte{1}, To: newKey, ToPrefix: []byte{9}}
+	checkpoints := ctx.KVStore(oldKey)
+
+	_, err := storemigrate.Run(ctx, move, checkpoints, 5, 5)
+	require.NoError(t, err, "first Run")
+
+	// Something else writing to the destination during the move should be caught.
+	ctx.KVStore(newKey).Set([]byte("\x09zzz"), []byte("unexpected"))
+	_, err = storemigrate.Run(ctx, move, checkpoints, 5, 0)
+	assert.ErrorContains(t, err, `verification of move "split" (old/01 -> new/09) failed: moved 10 entries with hash `, "second Run")
+	assert.ErrorContains(t, err, "but destination has 11 entries", "second Run")
+}
@@ -0,0 +1,103 @@
+package streaming
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cast"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const (
+	// FlagKeys is the app option with the names of the stores to stream. Use "*" for all stores.
+	FlagKeys = "streaming.provenance.keys"
+	// FlagSink is the app option with where to send the state changes, either "grpc" or "file".
+	FlagSink = "streaming.provenance.sink"
+	// FlagFileDir is the app option with the directory to write state change files to when using the file sink.
+	FlagFileDir = "streaming.provenance.file-dir"
+	// FlagStopNodeOnErr is the app option with whether to halt the node if streaming fails.
+	FlagStopNodeOnErr = "streaming.provenance.stop-node-on-err"
+
+	// SinkGRPC is the sink that provides the state changes to subscribers of the StateStreamService.
+	SinkGRPC = "grpc"
+	// SinkFile is the sink that writes the state changes of each block to a file.
+	SinkFile = "file"
+
+	// DefaultFileDir is the directory (relative to the node's home) that the file sink writes to by default.
+	DefaultFileDir = "data/streaming"
+)
+
+// Config is the configuration of the state streaming listener.
+type Config struct {
+	// Keys are the names of the stores to stream.
+	Keys []string
+	// Sink is where to send the state changes.
+	Sink string
+	// FileDir is the directory that the file sink writes to.
+	FileDir string
+	// StopNodeOnErr is whether to halt the node if streaming fails.
+	StopNodeOnErr bool
+}
+
+// ConfigFromAppOpts reads the state streaming configuration from the provided app options.
+func ConfigFromAppOpts(appOpts servertypes.AppOptions) Config {
+	rv := Config{
+		Keys:          cast.ToStringSlice(appOpts.Get(FlagKeys)),
+		Sink:          strings.TrimSpace(cast.ToString(appOpts.Get(FlagSink))),
+		FileDir:       strings.TrimSpace(cast.ToString(appOpts.Get(FlagFileDir))),
+		StopNodeOnErr: cast.ToBool(appOpts.Get(FlagStopNodeOnErr)),
+	}
+	if len(rv.Sink) == 0 {
+		rv.Sink = SinkGRPC
+	}
+	if len(rv.FileDir) == 0 {
+		rv.FileDir = DefaultFileDir
+	}
+	if !filepath.IsAbs(rv.FileDir) {
+		rv.FileDir = filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), rv.FileDir)
+	}
+	return rv
+}
+
+// IsEnabled returns true if there are stores to stream.
+func (c Config) IsEnabled() bool {
+	return len(c.Keys) > 0
+}
+
+// Validate returns an error if this config is invalid for the provided stores.
+func (c Config) Validate(keys map[string]*storetypes.KVStoreKey) error {
+	var errs []error
+	if c.Sink != SinkGRPC && c.Sink != SinkFile {
+		errs = append(errs, fmt.Errorf("invalid %s %q: must be %q or %q", FlagSink, c.Sink, SinkGRPC, SinkFile))
+	}
+	for _, key := range c.Keys {
+		if _, known := keys[key]; !known && key != "*" {
+			errs = append(errs, fmt.Errorf("invalid %s entry %q: unknown store", FlagKeys, key))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// GetStoreKeys returns the store keys (sorted by name) to stream.
+func (c Config) GetStoreKeys(keys map[string]*storetypes.KVStoreKey) []storetypes.StoreKey {
+	var rv []storetypes.StoreKey
+	for name, key := range keys {
+		for _, toStream := range c.Keys {
+			if toStream == "*" || toStream == name {
+				rv = append(rv, key)
+				break
+			}
+		}
+	}
+	sort.Slice(rv, func(i, j int) bool {
+		return rv[i].Name() < rv[j].Name()
+	})
+	return rv
+}
@@ -0,0 +1,107 @@
+package streaming_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+
+	"github.com/provenance-io/provenance/internal/streaming"
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+func TestConfigFromAppOpts(t *testing.T) {
+	tests := []struct {
+		name    string
+		appOpts simtestutil.AppOptionsMap
+		exp     streaming.Config
+	}{
+		{
+			name:    "defaults",
+			appOpts: simtestutil.AppOptionsMap{flags.FlagHome: "/pio"},
+			exp:     streaming.Config{Sink: streaming.SinkGRPC, FileDir: "/pio/data/streaming"},
+		},
+		{
+			name: "everything set",
+			appOpts: simtestutil.AppOptionsMap{
+				flags.FlagHome:              "/pio",
+				streaming.FlagKeys:          []interface{}{"exchange", "marker"},
+				streaming.FlagSink:          "file",
+				streaming.FlagFileDir:       "/var/streaming",
+				streaming.FlagStopNodeOnErr: true,
+			},
+			exp: streaming.Config{
+				Keys:          []string{"exchange", "marker"},
+				Sink:          streaming.SinkFile,
+				FileDir:       "/var/streaming",
+				StopNodeOnErr: true,
+			},
+		},
+		{
+			name:    "relative file dir",
+			appOpts: simtestutil.AppOptionsMap{flags.FlagHome: "/pio", streaming.FlagFileDir: "stream"},
+			exp:     streaming.Config{Sink: streaming.SinkGRPC, FileDir: "/pio/stream"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			act := streaming.ConfigFromAppOpts(tc.appOpts)
+			assert.Equal(t, tc.exp, act, "ConfigFromAppOpts")
+		})
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	keys := storetypes.NewKVStoreKeys("exchange", "marker", "metadata")
+
+	tests := []struct {
+		name   string
+		cfg    streaming.Config
+		expErr string
+	}{
+		{
+			name: "grpc sink",
+			cfg:  streaming.Config{Keys: []string{"exchange", "marker"}, Sink: streaming.SinkGRPC},
+		},
+		{
+			name: "file sink with all stores",
+			cfg:  streaming.Config{Keys: []string{"*"}, Sink: streaming.SinkFile},
+		},
+		{
+			name: "unknown sink and stores",
+			cfg:  streaming.Config{Keys: []string{"bank", "marker", "nope"}, Sink: "kafka"},
+			expErr: `invalid streaming.provenance.sink "kafka": must be "grpc" or "file"` + "\n" +
+				`invalid streaming.provenance.keys entry "bank": unknown store` + "\n" +
+				`invalid streaming.provenance.keys entry "nope": unknown store`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.cfg.Validate(keys)
+			assertions.AssertErrorValue(t, err, tc.expErr, "Validate")
+		})
+	}
+}
+
+func TestConfigGetStoreKeys(t *testing.T) {
+	keys := storetypes.NewKVStoreKeys("exchange", "marker", "metadata")
+	names := func(storeKeys []storetypes.StoreKey) []string {
+		var rv []string
+		for _, key := range storeKeys {
+			rv = append(rv, key.Name())
+		}
+		return rv
+	}
+
+	cfg := streaming.Config{Keys: []string{"metadata", "exchange"}}
+	assert.Equal(t, []string{"exchange", "metadata"}, names(cfg.GetStoreKeys(keys)), "GetStoreKeys(metadata, exchange)")
+
+	cfg = streaming.Config{Keys: []string{"*"}}
+	assert.Equal(t, []string{"exchange", "marker", "metadata"}, names(cfg.GetStoreKeys(keys)), "GetStoreKeys(*)")
+}
@@ -0,0 +1,153 @@
+package streaming
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/provenance-io/provenance/x/exchange"
+	exchangekeeper "github.com/provenance-io/provenance/x/exchange/keeper"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// ValueDecoder converts a store entry into a proto message.
+type ValueDecoder func(cdc codec.Codec, key, value []byte) (proto.Message, error)
+
+// KeyPrefix describes the entries in a store that start with a specific prefix.
+type KeyPrefix struct {
+	// Prefix is the leading bytes of the keys.
+	Prefix []byte
+	// KeyType is the name used for these entries.
+	KeyType string
+	// Decode converts a value into a proto message. It is nil if the values cannot be decoded.
+	Decode ValueDecoder
+}
+
+// protoDecoder creates a ValueDecoder that unmarshals values into new instances of T.
+func protoDecoder[T any, PT interface {
+	*T
+	proto.Message
+}]() ValueDecoder {
+	return func(cdc codec.Codec, _, value []byte) (proto.Message, error) {
+		var rv PT = new(T)
+		if err := cdc.Unmarshal(value, rv); err != nil {
+			return nil, err
+		}
+		return rv, nil
+	}
+}
+
+// decodeExchangeOrder converts an exchange order store entry into an exchange.Order.
+func decodeExchangeOrder(cdc codec.Codec, key, value []byte) (proto.Message, error) {
+	orderID, ok := exchangekeeper.ParseIndexKeySuffixOrderID(key)
+	if !ok {
+		return nil, fmt.Errorf("invalid order key %v", key)
+	}
+	if len(value) == 0 {
+		return nil, fmt.Errorf("empty order value")
+	}
+	typeByte, data := value[0], value[1:]
+	switch typeByte {
+	case exchangekeeper.OrderKeyTypeAsk:
+		var ask exchange.AskOrder
+		if err := cdc.Unmarshal(data, &ask); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal ask order: %w", err)
+		}
+		return exchange.NewOrder(orderID).WithAsk(&ask), nil
+	case exchangekeeper.OrderKeyTypeBid:
+		var bid exchange.BidOrder
+		if err := cdc.Unmarshal(data, &bid); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal bid order: %w", err)
+		}
+		return exchange.NewOrder(orderID).WithBid(&bid), nil
+	default:
+		return nil, fmt.Errorf("unknown order type byte %#x", typeByte)
+	}
+}
+
+// KnownKeyPrefixes are the key prefixes of each store that can be identified, by store key.
+var KnownKeyPrefixes = map[string][]KeyPrefix{
+	exchange.StoreKey: {
+		{Prefix: []byte{exchangekeeper.KeyTypeParams}, KeyType: "params"},
+		{Prefix: []byte{exchangekeeper.KeyTypeMarket}, KeyType: "market"},
+		{Prefix: []byte{exchangekeeper.KeyTypeOrder}, KeyType: "order", Decode: decodeExchangeOrder},
+		{Prefix: []byte{exchangekeeper.KeyTypeMarketToOrderIndex}, KeyType: "market_to_order_index"},
+		{Prefix: []byte{exchangekeeper.KeyTypeAddressToOrderIndex}, KeyType: "address_to_order_index"},
+		{Prefix: []byte{exchangekeeper.KeyTypeAssetToOrderIndex}, KeyType: "asset_to_order_index"},
+		{Prefix: []byte{exchangekeeper.KeyTypeLastMarketID}, KeyType: "last_market_id"},
+		{Prefix: []byte{exchangekeeper.KeyTypeKnownMarketID}, KeyType: "known_market_id"},
+		{Prefix: []byte{exchangekeeper.KeyTypeLastOrderID}, KeyType: "last_order_id"},
+		{Prefix: []byte{exchangekeeper.KeyTypeMarketExternalIDToOrderIndex}, KeyType: "market_external_id_to_order_index"},
+		{Prefix: []byte{exchangekeeper.KeyTypeCommitment}, KeyType: "commitment"},
+		{Prefix: []byte{exchangekeeper.KeyTypePayment}, KeyType: "payment", Decode: protoDecoder[exchange.Payment]()},
+		{Prefix: []byte{exchangekeeper.KeyTypeTargetToPaymentIndex}, KeyType: "target_to_payment_index"},
+	},
+	markertypes.StoreKey: {
+		{Prefix: markertypes.MarkerStoreKeyPrefix, KeyType: "marker_address"},
+		{Prefix: markertypes.DenySendKeyPrefix, KeyType: "deny_send"},
+		{Prefix: markertypes.NetAssetValuePrefix, KeyType: "net_asset_value", Decode: protoDecoder[markertypes.NetAssetValue]()},
+		{Prefix: markertypes.MarkerParamStoreKey, KeyType: "params", Decode: protoDecoder[markertypes.Params]()},
+	},
+	metadatatypes.StoreKey: {
+		{Prefix: metadatatypes.ScopeKeyPrefix, KeyType: "scope", Decode: protoDecoder[metadatatypes.Scope]()},
+		{Prefix: metadatatypes.SessionKeyPrefix, KeyType: "session", Decode: protoDecoder[metadatatypes.Session]()},
+		{Prefix: metadatatypes.RecordKeyPrefix, KeyType: "record", Decode: protoDecoder[metadatatypes.Record]()},
+		{Prefix: metadatatypes.ContractSpecificationKeyPrefix, KeyType: "contract_specification", Decode: protoDecoder[metadatatypes.ContractSpecification]()},
+		{Prefix: metadatatypes.ScopeSpecificationKeyPrefix, KeyType: "scope_specification", Decode: protoDecoder[metadatatypes.ScopeSpecification]()},
+		{Prefix: metadatatypes.RecordSpecificationKeyPrefix, KeyType: "record_specification", Decode: protoDecoder[metadatatypes.RecordSpecification]()},
+		{Prefix: metadatatypes.AddressScopeCacheKeyPrefix, KeyType: "address_scope_cache"},
+		{Prefix: metadatatypes.ScopeSpecScopeCacheKeyPrefix, KeyType: "scope_spec_scope_cache"},
+		{Prefix: metadatatypes.AddressScopeSpecCacheKeyPrefix, KeyType: "address_scope_spec_cache"},
+		{Prefix: metadatatypes.ContractSpecScopeSpecCacheKeyPrefix, KeyType: "contract_spec_scope_spec_cache"},
+		{Prefix: metadatatypes.AddressContractSpecCacheKeyPrefix, KeyType: "address_contract_spec_cache"},
+		{Prefix: metadatatypes.OSLocatorAddressKeyPrefix, KeyType: "os_locator", Decode: protoDecoder[metadatatypes.ObjectStoreLocator]()},
+		{Prefix: metadatatypes.NetAssetValuePrefix, KeyType: "net_asset_value", Decode: protoDecoder[metadatatypes.NetAssetValue]()},
+		{Prefix: metadatatypes.OSLocatorParamPrefix, KeyType: "os_locator_params", Decode: protoDecoder[metadatatypes.OSLocatorParams]()},
+	},
+}
+
+// findKeyPrefix returns the known key prefix that the provided key has in the provided store.
+func findKeyPrefix(storeKey string, key []byte) (KeyPrefix, bool) {
+	for _, prefix := range KnownKeyPrefixes[storeKey] {
+		if bytes.HasPrefix(key, prefix.Prefix) {
+			return prefix, true
+		}
+	}
+	return KeyPrefix{}, false
+}
+
+// NewStateChange creates a new StateChange, identifying and decoding the entry if possible.
+// If the value cannot be decoded, it is still included, and just the error is returned with it.
+func NewStateChange(cdc codec.Codec, storeKey string, key, value []byte, deleted bool) (StateChange, error) {
+	rv := StateChange{
+		StoreKey: storeKey,
+		Key:      key,
+		Value:    value,
+		Delete:   deleted,
+	}
+
+	prefix, found := findKeyPrefix(storeKey, key)
+	if !found {
+		return rv, nil
+	}
+	rv.KeyType = prefix.KeyType
+	if prefix.Decode == nil || deleted {
+		return rv, nil
+	}
+
+	msg, err := prefix.Decode(cdc, key, value)
+	if err != nil {
+		return rv, fmt.Errorf("could not decode %s %s entry %X: %w", storeKey, prefix.KeyType, key, err)
+	}
+	valueJSON, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		return rv, fmt.Errorf("could not convert %s %s entry %X to json: %w", storeKey, prefix.KeyType, key, err)
+	}
+	rv.ValueType = proto.MessageName(msg)
+	rv.ValueJson = string(valueJSON)
+	return rv, nil
+}
@@ -0,0 +1,149 @@
+package streaming_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/internal/streaming"
+	"github.com/provenance-io/provenance/testutil/assertions"
+	"github.com/provenance-io/provenance/x/exchange"
+	exchangekeeper "github.com/provenance-io/provenance/x/exchange/keeper"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+)
+
+func TestNewStateChange(t *testing.T) {
+	cdc := app.MakeTestEncodingConfig(t).Marshaler
+	seller := sdk.AccAddress("seller______________")
+
+	ask := &exchange.AskOrder{
+		MarketId: 3,
+		Seller:   seller.String(),
+		Assets:   sdk.NewInt64Coin("apple", 5),
+		Price:    sdk.NewInt64Coin("nhash", 100),
+	}
+	askBz, err := cdc.Marshal(ask)
+	require.NoError(t, err, "Marshal(ask)")
+	askValue := append([]byte{exchangekeeper.OrderKeyTypeAsk}, askBz...)
+	askJSON, err := cdc.MarshalJSON(exchange.NewOrder(12).WithAsk(ask))
+	require.NoError(t, err, "MarshalJSON(order)")
+
+	nav := markertypes.NewNetAssetValue(sdk.NewInt64Coin(markertypes.UsdDenom, 1250), 1)
+	navBz, err := cdc.Marshal(&nav)
+	require.NoError(t, err, "Marshal(nav)")
+	navJSON, err := cdc.MarshalJSON(&nav)
+	require.NoError(t, err, "MarshalJSON(nav)")
+	navKey := markertypes.NetAssetValueKey(markertypes.MustGetMarkerAddress("navcoin"), markertypes.UsdDenom)
+
+	scopeKey := []byte(metadatatypes.ScopeMetadataAddress(uuid.MustParse("91978ba2-5f35-459a-86a7-feca1b0512e0")))
+
+	tests := []struct {
+		name     string
+		storeKey string
+		key      []byte
+		value    []byte
+		deleted  bool
+		exp      streaming.StateChange
+		expErr   string
+	}{
+		{
+			name:     "exchange order",
+			storeKey: exchange.StoreKey,
+			key:      exchangekeeper.MakeKeyOrder(12),
+			value:    askValue,
+			exp: streaming.StateChange{
+				StoreKey:  exchange.StoreKey,
+				Key:       exchangekeeper.MakeKeyOrder(12),
+				Value:     askValue,
+				KeyType:   "order",
+				ValueType: "provenance.exchange.v1.Order",
+				ValueJson: string(askJSON),
+			},
+		},
+		{
+			name:     "deleted exchange order",
+			storeKey: exchange.StoreKey,
+			key:      exchangekeeper.MakeKeyOrder(12),
+			deleted:  true,
+			exp: streaming.StateChange{
+				StoreKey: exchange.StoreKey,
+				Key:      exchangekeeper.MakeKeyOrder(12),
+				Delete:   true,
+				KeyType:  "order",
+			},
+		},
+		{
+			name:     "exchange index entry",
+			storeKey: exchange.StoreKey,
+			key:      []byte{exchangekeeper.KeyTypeAssetToOrderIndex, 'a'},
+			value:    []byte{exchangekeeper.OrderKeyTypeAsk},
+			exp: streaming.StateChange{
+				StoreKey: exchange.StoreKey,
+				Key:      []byte{exchangekeeper.KeyTypeAssetToOrderIndex, 'a'},
+				Value:    []byte{exchangekeeper.OrderKeyTypeAsk},
+				KeyType:  "asset_to_order_index",
+			},
+		},
+		{
+			name:     "marker net asset value",
+			storeKey: markertypes.StoreKey,
+			key:      navKey,
+			value:    navBz,
+			exp: streaming.StateChange{
+				StoreKey:  markertypes.StoreKey,
+				Key:       navKey,
+				Value:     navBz,
+				KeyType:   "net_asset_value",
+				ValueType: "provenance.marker.v1.NetAssetValue",
+				ValueJson: string(navJSON),
+			},
+		},
+		{
+			name:     "invalid metadata scope",
+			storeKey: metadatatypes.StoreKey,
+			key:      scopeKey,
+			value:    []byte("not a scope"),
+			exp: streaming.StateChange{
+				StoreKey: metadatatypes.StoreKey,
+				Key:      scopeKey,
+				Value:    []byte("not a scope"),
+				KeyType:  "scope",
+			},
+			expErr: fmt.Sprintf("could not decode metadata scope entry %X", scopeKey),
+		},
+		{
+			name:     "unknown store",
+			storeKey: "bank",
+			key:      []byte{0x02, 'k'},
+			value:    []byte("v"),
+			exp: streaming.StateChange{
+				StoreKey: "bank",
+				Key:      []byte{0x02, 'k'},
+				Value:    []byte("v"),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			act, err := streaming.NewStateChange(cdc, tc.storeKey, tc.key, tc.value, tc.deleted)
+			assertions.AssertErrorContents(t, err, errContents(tc.expErr), "NewStateChange error")
+			assert.Equal(t, tc.exp, act, "NewStateChange result")
+		})
+	}
+}
+
+// errContents converts the provided expected error string into the contents expected by AssertErrorContents.
+func errContents(expErr string) []string {
+	if len(expErr) == 0 {
+		return nil
+	}
+	return []string{expErr}
+}
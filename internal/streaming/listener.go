@@ -0,0 +1,98 @@
+package streaming
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Sink receives the state changes of each block.
+type Sink interface {
+	// Send provides the state changes of a block to this sink.
+	Send(block *BlockStateChanges) error
+}
+
+// Listener is an ABCIListener that provides the state changes of each block to a sink.
+type Listener struct {
+	cdc    codec.Codec
+	sink   Sink
+	logger log.Logger
+}
+
+var _ storetypes.ABCIListener = (*Listener)(nil)
+
+// NewListener creates a new Listener that provides state changes to the provided sink.
+func NewListener(cdc codec.Codec, sink Sink, logger log.Logger) *Listener {
+	return &Listener{
+		cdc:    cdc,
+		sink:   sink,
+		logger: logger.With("module", "streaming"),
+	}
+}
+
+// ListenFinalizeBlock is a no-op since only the committed state changes are streamed.
+func (l *Listener) ListenFinalizeBlock(_ context.Context, _ abci.RequestFinalizeBlock, _ abci.ResponseFinalizeBlock) error {
+	return nil
+}
+
+// ListenCommit provides the state changes of the committed block to the sink.
+func (l *Listener) ListenCommit(ctx context.Context, _ abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	block := &BlockStateChanges{
+		Height:    sdkCtx.BlockHeight(),
+		BlockTime: sdkCtx.BlockTime(),
+		Changes:   make([]StateChange, 0, len(changeSet)),
+	}
+	for _, pair := range changeSet {
+		change, err := NewStateChange(l.cdc, pair.StoreKey, pair.Key, pair.Value, pair.Delete)
+		if err != nil {
+			// The raw entry is still streamed, so just log the problem and move on.
+			l.logger.Error("Could not decode state change.", "height", block.Height, "error", err)
+		}
+		block.Changes = append(block.Changes, change)
+	}
+
+	if err := l.sink.Send(block); err != nil {
+		return fmt.Errorf("could not stream state changes of block %d: %w", block.Height, err)
+	}
+	return nil
+}
+
+// FileSink is a Sink that writes the state changes of each block as json to a file in a directory.
+type FileSink struct {
+	cdc codec.Codec
+	dir string
+}
+
+var _ Sink = (*FileSink)(nil)
+
+// NewFileSink creates a new FileSink that writes to the provided directory, creating it if needed.
+func NewFileSink(cdc codec.Codec, dir string) (*FileSink, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("could not create streaming directory: %w", err)
+	}
+	return &FileSink{cdc: cdc, dir: dir}, nil
+}
+
+// GetFilePath returns the path to the file with the state changes of the provided block height.
+func (s *FileSink) GetFilePath(height int64) string {
+	return filepath.Join(s.dir, fmt.Sprintf("block-%d.json", height))
+}
+
+// Send writes the state changes of a block to its own file.
+func (s *FileSink) Send(block *BlockStateChanges) error {
+	bz, err := s.cdc.MarshalJSON(block)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(s.GetFilePath(block.Height), bz, 0o644)
+}
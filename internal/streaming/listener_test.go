@@ -0,0 +1,94 @@
+package streaming_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/internal/streaming"
+	exchangekeeper "github.com/provenance-io/provenance/x/exchange/keeper"
+)
+
+// mockSink is a Sink that records the blocks it's given.
+type mockSink struct {
+	blocks []*streaming.BlockStateChanges
+	err    error
+}
+
+func (s *mockSink) Send(block *streaming.BlockStateChanges) error {
+	s.blocks = append(s.blocks, block)
+	return s.err
+}
+
+// newCommitContext creates a context for a block at the provided height and time.
+func newCommitContext(height int64, blockTime time.Time) context.Context {
+	return sdk.NewContext(nil, cmtproto.Header{Height: height, Time: blockTime}, false, log.NewNopLogger())
+}
+
+func TestListenerListenCommit(t *testing.T) {
+	cdc := app.MakeTestEncodingConfig(t).Marshaler
+	blockTime := time.Date(2024, 3, 14, 15, 9, 26, 0, time.UTC)
+	changeSet := []*storetypes.StoreKVPair{
+		{StoreKey: "exchange", Key: exchangekeeper.MakeKeyOrder(4), Delete: true},
+		{StoreKey: "bank", Key: []byte("key"), Value: []byte("value")},
+	}
+
+	t.Run("changes sent to sink", func(t *testing.T) {
+		sink := &mockSink{}
+		listener := streaming.NewListener(cdc, sink, log.NewNopLogger())
+		err := listener.ListenCommit(newCommitContext(5, blockTime), abci.ResponseCommit{}, changeSet)
+		require.NoError(t, err, "ListenCommit")
+
+		exp := []*streaming.BlockStateChanges{{
+			Height:    5,
+			BlockTime: blockTime,
+			Changes: []streaming.StateChange{
+				{StoreKey: "exchange", Key: exchangekeeper.MakeKeyOrder(4), Delete: true, KeyType: "order"},
+				{StoreKey: "bank", Key: []byte("key"), Value: []byte("value")},
+			},
+		}}
+		assert.Equal(t, exp, sink.blocks, "blocks sent to sink")
+	})
+
+	t.Run("sink error", func(t *testing.T) {
+		sink := &mockSink{err: errors.New("injected error")}
+		listener := streaming.NewListener(cdc, sink, log.NewNopLogger())
+		err := listener.ListenCommit(newCommitContext(6, blockTime), abci.ResponseCommit{}, changeSet)
+		assert.EqualError(t, err, "could not stream state changes of block 6: injected error", "ListenCommit")
+	})
+}
+
+func TestFileSink(t *testing.T) {
+	cdc := app.MakeTestEncodingConfig(t).Marshaler
+	dir := t.TempDir() + "/streaming"
+	sink, err := streaming.NewFileSink(cdc, dir)
+	require.NoError(t, err, "NewFileSink")
+
+	block := &streaming.BlockStateChanges{
+		Height:    7,
+		BlockTime: time.Date(2024, 3, 14, 15, 9, 26, 0, time.UTC),
+		Changes:   []streaming.StateChange{{StoreKey: "bank", Key: []byte{1}, Value: []byte{2}}},
+	}
+	require.NoError(t, sink.Send(block), "Send")
+
+	assert.Equal(t, dir+"/block-7.json", sink.GetFilePath(7), "GetFilePath(7)")
+	bz, err := os.ReadFile(sink.GetFilePath(7))
+	require.NoError(t, err, "ReadFile")
+	exp := `{"height":"7","block_time":"2024-03-14T15:09:26Z","changes":[{"store_key":"bank","key":"AQ==","value":"Ag==",` +
+		`"delete":false,"key_type":"","value_type":"","value_json":""}]}`
+	assert.Equal(t, exp, string(bz), "file contents")
+}
@@ -0,0 +1,122 @@
+package streaming
+
+import (
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SubscriberBufferSize is the number of blocks that can be waiting to be sent to a subscriber.
+// If a subscriber falls further behind than this, it is dropped so that it can't slow down the node.
+const SubscriberBufferSize = 100
+
+// subscriber is a single subscription to the state changes.
+type subscriber struct {
+	storeKeys map[string]bool
+	blocks    chan *BlockStateChanges
+}
+
+// newSubscriber creates a new subscriber that gets changes for the provided stores (or all stores if none provided).
+func newSubscriber(storeKeys []string) *subscriber {
+	rv := &subscriber{blocks: make(chan *BlockStateChanges, SubscriberBufferSize)}
+	if len(storeKeys) > 0 {
+		rv.storeKeys = make(map[string]bool, len(storeKeys))
+		for _, key := range storeKeys {
+			rv.storeKeys[key] = true
+		}
+	}
+	return rv
+}
+
+// filter returns the block with only the changes that this subscriber wants.
+func (s *subscriber) filter(block *BlockStateChanges) *BlockStateChanges {
+	if s.storeKeys == nil {
+		return block
+	}
+	rv := &BlockStateChanges{Height: block.Height, BlockTime: block.BlockTime}
+	for _, change := range block.Changes {
+		if s.storeKeys[change.StoreKey] {
+			rv.Changes = append(rv.Changes, change)
+		}
+	}
+	return rv
+}
+
+// Server is a Sink that provides the state changes to subscribers of the StateStreamService.
+type Server struct {
+	mtx         sync.Mutex
+	subscribers map[*subscriber]bool
+}
+
+var (
+	_ Sink                     = (*Server)(nil)
+	_ StateStreamServiceServer = (*Server)(nil)
+)
+
+// NewServer creates a new Server without any subscribers.
+func NewServer() *Server {
+	return &Server{subscribers: make(map[*subscriber]bool)}
+}
+
+// Send provides the state changes of a block to each subscriber.
+// Subscribers that have fallen too far behind are dropped.
+func (s *Server) Send(block *BlockStateChanges) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	for sub := range s.subscribers {
+		select {
+		case sub.blocks <- sub.filter(block):
+		default:
+			delete(s.subscribers, sub)
+			close(sub.blocks)
+		}
+	}
+	return nil
+}
+
+// SubscriberCount returns the number of current subscribers.
+func (s *Server) SubscriberCount() int {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return len(s.subscribers)
+}
+
+// addSubscriber adds a subscriber so that it gets the changes of future blocks.
+func (s *Server) addSubscriber(sub *subscriber) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.subscribers[sub] = true
+}
+
+// removeSubscriber removes a subscriber so that it stops getting the changes of future blocks.
+func (s *Server) removeSubscriber(sub *subscriber) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	delete(s.subscribers, sub)
+}
+
+// Subscribe streams the state changes of each block as the block is committed.
+func (s *Server) Subscribe(req *SubscribeRequest, stream StateStreamService_SubscribeServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sub := newSubscriber(req.StoreKeys)
+	s.addSubscriber(sub)
+	defer s.removeSubscriber(sub)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case block, ok := <-sub.blocks:
+			if !ok {
+				return status.Errorf(codes.ResourceExhausted, "subscriber fell more than %d blocks behind", SubscriberBufferSize)
+			}
+			if err := stream.Send(block); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package streaming_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/provenance-io/provenance/internal/streaming"
+)
+
+// mockSubscribeStream is a StateStreamService_SubscribeServer that provides the blocks it's sent on a channel.
+type mockSubscribeStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	blocks chan *streaming.BlockStateChanges
+}
+
+func (s *mockSubscribeStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *mockSubscribeStream) Send(block *streaming.BlockStateChanges) error {
+	s.blocks <- block
+	return nil
+}
+
+// subscribe starts a subscription in the background, returning the stream, a channel with the
+// result of Subscribe, and a func that ends the subscription.
+func subscribe(t *testing.T, server *streaming.Server, storeKeys ...string) (*mockSubscribeStream, chan error, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &mockSubscribeStream{ctx: ctx, blocks: make(chan *streaming.BlockStateChanges, 10)}
+	result := make(chan error, 1)
+	subCount := server.SubscriberCount()
+	go func() {
+		result <- server.Subscribe(&streaming.SubscribeRequest{StoreKeys: storeKeys}, stream)
+	}()
+	require.Eventually(t, func() bool {
+		return server.SubscriberCount() > subCount
+	}, time.Second, time.Millisecond, "subscriber added")
+	return stream, result, cancel
+}
+
+// receive gets the next block sent to the provided stream.
+func receive(t *testing.T, stream *mockSubscribeStream) *streaming.BlockStateChanges {
+	select {
+	case block := <-stream.blocks:
+		return block
+	case <-time.After(time.Second):
+		t.Fatalf("no block received")
+		return nil
+	}
+}
+
+func TestServerSubscribe(t *testing.T) {
+	server := streaming.NewServer()
+	all, allResult, cancelAll := subscribe(t, server)
+	exchangeOnly, exchangeResult, cancelExchange := subscribe(t, server, "exchange")
+
+	block := &streaming.BlockStateChanges{
+		Height: 3,
+		Changes: []streaming.StateChange{
+			{StoreKey: "marker", Key: []byte{1}},
+			{StoreKey: "exchange", Key: []byte{2}},
+		},
+	}
+	require.NoError(t, server.Send(block), "Send")
+
+	assert.Equal(t, block, receive(t, all), "block sent to subscriber of all stores")
+	expExchange := &streaming.BlockStateChanges{Height: 3, Changes: []streaming.StateChange{{StoreKey: "exchange", Key: []byte{2}}}}
+	assert.Equal(t, expExchange, receive(t, exchangeOnly), "block sent to subscriber of exchange store")
+
+	cancelAll()
+	assert.NoError(t, <-allResult, "Subscribe result after cancel")
+	cancelExchange()
+	assert.NoError(t, <-exchangeResult, "Subscribe result after cancel")
+	assert.Equal(t, 0, server.SubscriberCount(), "SubscriberCount after cancels")
+}
+
+func TestServerSlowSubscriber(t *testing.T) {
+	server := streaming.NewServer()
+	stream, result, cancel := subscribe(t, server)
+	defer cancel()
+
+	// Block the stream so that nothing is read off the subscriber's buffer.
+	stream.blocks = make(chan *streaming.BlockStateChanges)
+	for i := 0; i <= streaming.SubscriberBufferSize+1; i++ {
+		require.NoError(t, server.Send(&streaming.BlockStateChanges{Height: int64(i)}), "Send(%d)", i)
+	}
+	go func() {
+		for range stream.blocks {
+		}
+	}()
+
+	select {
+	case err := <-result:
+		assert.EqualError(t, err, "rpc error: code = ResourceExhausted desc = subscriber fell more than 100 blocks behind", "Subscribe result")
+	case <-time.After(5 * time.Second):
+		t.Fatalf("subscriber was not dropped")
+	}
+	assert.Equal(t, 0, server.SubscriberCount(), "SubscriberCount")
+}
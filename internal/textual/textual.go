@@ -0,0 +1,290 @@
+// Package textual provides SIGN_MODE_TEXTUAL rendering for provenance msgs so that
+// hardware wallet users see a meaningful description of what they are signing.
+package textual
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"cosmossdk.io/x/tx/signing"
+	sdktextual "cosmossdk.io/x/tx/signing/textual"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/provenance-io/provenance/x/exchange"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// SummaryTitle is the title of the screen that summarizes a provenance msg.
+const SummaryTitle = "Summary"
+
+// summarizer returns a one-line description of a msg.
+type summarizer func(ctx context.Context, h *sdktextual.SignModeHandler, msg protoreflect.Message) (string, error)
+
+// summarizers are the msgs that get a summary screen, keyed by their proto message name.
+var summarizers = map[string]summarizer{
+	proto.MessageName(&markertypes.MsgAddMarkerRequest{}):     summarizeAddMarker,
+	proto.MessageName(&markertypes.MsgMintRequest{}):          summarizeMint,
+	proto.MessageName(&markertypes.MsgBurnRequest{}):          summarizeBurn,
+	proto.MessageName(&markertypes.MsgTransferRequest{}):      summarizeTransfer,
+	proto.MessageName(&exchange.MsgCreateAskRequest{}):        summarizeCreateAsk,
+	proto.MessageName(&exchange.MsgCreateBidRequest{}):        summarizeCreateBid,
+	proto.MessageName(&metadatatypes.MsgWriteScopeRequest{}):  summarizeWriteScope,
+	proto.MessageName(&metadatatypes.MsgDeleteScopeRequest{}): summarizeDeleteScope,
+}
+
+// NewSignModeHandler creates a SIGN_MODE_TEXTUAL handler that renders summaries of provenance msgs.
+// The same handler must be used by both the chain and clients so that they generate the same sign bytes.
+// If no file resolver is provided, the gogoproto hybrid resolver is used.
+func NewSignModeHandler(coinMetadataQueryFn sdktextual.CoinMetadataQueryFn, fileResolver signing.ProtoFileResolver) (*sdktextual.SignModeHandler, error) {
+	if fileResolver == nil {
+		fileResolver = proto.HybridResolver
+	}
+	handler, err := sdktextual.NewSignModeHandler(sdktextual.SignModeOptions{
+		CoinMetadataQuerier: coinMetadataQueryFn,
+		FileResolver:        fileResolver,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = DefineMsgRenderers(handler, fileResolver); err != nil {
+		return nil, err
+	}
+	return handler, nil
+}
+
+// DefineMsgRenderers adds the provenance msg renderers to the provided handler.
+func DefineMsgRenderers(handler *sdktextual.SignModeHandler, fileResolver signing.ProtoFileResolver) error {
+	for name, summarize := range summarizers {
+		desc, err := fileResolver.FindDescriptorByName(protoreflect.FullName(name))
+		if err != nil {
+			return fmt.Errorf("could not find descriptor for %s: %w", name, err)
+		}
+		md, ok := desc.(protoreflect.MessageDescriptor)
+		if !ok {
+			return fmt.Errorf("descriptor for %s is a %T, expected a message descriptor", name, desc)
+		}
+		handler.DefineMessageRenderer(md.FullName(), NewSummaryValueRenderer(handler, md, summarize))
+	}
+	return nil
+}
+
+// summaryValueRenderer renders a msg the same way as the default message renderer,
+// but with a summary screen immediately after the header.
+type summaryValueRenderer struct {
+	handler   *sdktextual.SignModeHandler
+	base      sdktextual.ValueRenderer
+	summarize summarizer
+}
+
+var _ sdktextual.ValueRenderer = summaryValueRenderer{}
+
+// NewSummaryValueRenderer creates a ValueRenderer for a msg that includes a summary screen.
+func NewSummaryValueRenderer(handler *sdktextual.SignModeHandler, md protoreflect.MessageDescriptor, summarize summarizer) sdktextual.ValueRenderer {
+	return summaryValueRenderer{
+		handler:   handler,
+		base:      sdktextual.NewMessageValueRenderer(handler, md),
+		summarize: summarize,
+	}
+}
+
+// Format renders the msg's header, then its summary, then the rest of its fields.
+func (r summaryValueRenderer) Format(ctx context.Context, v protoreflect.Value) ([]sdktextual.Screen, error) {
+	screens, err := r.base.Format(ctx, v)
+	if err != nil {
+		return nil, err
+	}
+	summary, err := r.summarize(ctx, r.handler, v.Message())
+	if err != nil {
+		return nil, fmt.Errorf("could not summarize %s: %w", v.Message().Descriptor().FullName(), err)
+	}
+
+	rv := make([]sdktextual.Screen, 0, len(screens)+1)
+	rv = append(rv, screens[0])
+	rv = append(rv, sdktextual.Screen{Title: SummaryTitle, Content: summary, Indent: screens[0].Indent + 1})
+	rv = append(rv, screens[1:]...)
+	return rv, nil
+}
+
+// Parse removes the summary screen and parses the rest using the default message renderer.
+func (r summaryValueRenderer) Parse(ctx context.Context, screens []sdktextual.Screen) (protoreflect.Value, error) {
+	if len(screens) < 2 || screens[1].Title != SummaryTitle {
+		return protoreflect.Value{}, fmt.Errorf("expected a %q screen after the header", SummaryTitle)
+	}
+	rest := make([]sdktextual.Screen, 0, len(screens)-1)
+	rest = append(rest, screens[0])
+	rest = append(rest, screens[2:]...)
+	return r.base.Parse(ctx, rest)
+}
+
+// getField returns the value of the named field of the provided msg.
+func getField(msg protoreflect.Message, name protoreflect.Name) (protoreflect.FieldDescriptor, protoreflect.Value, error) {
+	fd := msg.Descriptor().Fields().ByName(name)
+	if fd == nil {
+		return nil, protoreflect.Value{}, fmt.Errorf("%s does not have a %s field", msg.Descriptor().FullName(), name)
+	}
+	return fd, msg.Get(fd), nil
+}
+
+// getString returns the value of the named string field of the provided msg.
+func getString(msg protoreflect.Message, name protoreflect.Name) (string, error) {
+	_, val, err := getField(msg, name)
+	if err != nil {
+		return "", err
+	}
+	return val.String(), nil
+}
+
+// getMessage returns the value of the named message field of the provided msg.
+func getMessage(msg protoreflect.Message, name protoreflect.Name) (protoreflect.Message, error) {
+	_, val, err := getField(msg, name)
+	if err != nil {
+		return nil, err
+	}
+	return val.Message(), nil
+}
+
+// formatCoin renders the named coin field of the provided msg the same way it appears in its own screen.
+func formatCoin(ctx context.Context, h *sdktextual.SignModeHandler, msg protoreflect.Message, name protoreflect.Name) (string, error) {
+	fd, val, err := getField(msg, name)
+	if err != nil {
+		return "", err
+	}
+	vr, err := h.GetFieldValueRenderer(fd)
+	if err != nil {
+		return "", err
+	}
+	screens, err := vr.Format(ctx, val)
+	if err != nil {
+		return "", err
+	}
+	if len(screens) != 1 {
+		return "", fmt.Errorf("expected 1 screen for %s, got %d", name, len(screens))
+	}
+	return screens[0].Content, nil
+}
+
+// formatMetadataAddress renders the named MetadataAddress field of the provided msg as a bech32 string.
+func formatMetadataAddress(msg protoreflect.Message, name protoreflect.Name) (string, error) {
+	_, val, err := getField(msg, name)
+	if err != nil {
+		return "", err
+	}
+	return metadatatypes.MetadataAddress(val.Bytes()).String(), nil
+}
+
+func summarizeAddMarker(ctx context.Context, h *sdktextual.SignModeHandler, msg protoreflect.Message) (string, error) {
+	amount, err := formatCoin(ctx, h, msg, "amount")
+	if err != nil {
+		return "", err
+	}
+	fd, val, err := getField(msg, "marker_type")
+	if err != nil {
+		return "", err
+	}
+	markerType := "unspecified"
+	if ev := fd.Enum().Values().ByNumber(val.Enum()); ev != nil {
+		markerType = strings.ToLower(strings.TrimPrefix(string(ev.Name()), "MARKER_TYPE_"))
+	}
+	return fmt.Sprintf("Create %s marker with %s", markerType, amount), nil
+}
+
+func summarizeMint(ctx context.Context, h *sdktextual.SignModeHandler, msg protoreflect.Message) (string, error) {
+	amount, err := formatCoin(ctx, h, msg, "amount")
+	if err != nil {
+		return "", err
+	}
+	recipient, err := getString(msg, "recipient")
+	if err != nil {
+		return "", err
+	}
+	if len(recipient) > 0 {
+		return fmt.Sprintf("Mint %s to %s", amount, recipient), nil
+	}
+	return fmt.Sprintf("Mint %s", amount), nil
+}
+
+func summarizeBurn(ctx context.Context, h *sdktextual.SignModeHandler, msg protoreflect.Message) (string, error) {
+	amount, err := formatCoin(ctx, h, msg, "amount")
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Burn %s", amount), nil
+}
+
+func summarizeTransfer(ctx context.Context, h *sdktextual.SignModeHandler, msg protoreflect.Message) (string, error) {
+	amount, err := formatCoin(ctx, h, msg, "amount")
+	if err != nil {
+		return "", err
+	}
+	from, err := getString(msg, "from_address")
+	if err != nil {
+		return "", err
+	}
+	to, err := getString(msg, "to_address")
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Transfer %s from %s to %s", amount, from, to), nil
+}
+
+// summarizeOrder returns a description of an ask or bid order using the provided verb.
+func summarizeOrder(ctx context.Context, h *sdktextual.SignModeHandler, order protoreflect.Message, verb string) (string, error) {
+	assets, err := formatCoin(ctx, h, order, "assets")
+	if err != nil {
+		return "", err
+	}
+	price, err := formatCoin(ctx, h, order, "price")
+	if err != nil {
+		return "", err
+	}
+	_, marketID, err := getField(order, "market_id")
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s %s for %s in market %d", verb, assets, price, marketID.Uint()), nil
+}
+
+func summarizeCreateAsk(ctx context.Context, h *sdktextual.SignModeHandler, msg protoreflect.Message) (string, error) {
+	order, err := getMessage(msg, "ask_order")
+	if err != nil {
+		return "", err
+	}
+	return summarizeOrder(ctx, h, order, "Sell")
+}
+
+func summarizeCreateBid(ctx context.Context, h *sdktextual.SignModeHandler, msg protoreflect.Message) (string, error) {
+	order, err := getMessage(msg, "bid_order")
+	if err != nil {
+		return "", err
+	}
+	return summarizeOrder(ctx, h, order, "Buy")
+}
+
+func summarizeWriteScope(_ context.Context, _ *sdktextual.SignModeHandler, msg protoreflect.Message) (string, error) {
+	scope, err := getMessage(msg, "scope")
+	if err != nil {
+		return "", err
+	}
+	scopeID, err := formatMetadataAddress(scope, "scope_id")
+	if err != nil {
+		return "", err
+	}
+	_, owners, err := getField(scope, "owners")
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Write scope %s with %d owner(s)", scopeID, owners.List().Len()), nil
+}
+
+func summarizeDeleteScope(_ context.Context, _ *sdktextual.SignModeHandler, msg protoreflect.Message) (string, error) {
+	scopeID, err := formatMetadataAddress(msg, "scope_id")
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Delete scope %s", scopeID), nil
+}
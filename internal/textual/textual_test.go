@@ -0,0 +1,224 @@
+package textual
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	sdktextual "cosmossdk.io/x/tx/signing/textual"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/provenance-io/provenance/x/exchange"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// noMetadata is a CoinMetadataQueryFn that never finds any metadata.
+func noMetadata(_ context.Context, _ string) (*bankv1beta1.Metadata, error) {
+	return nil, nil
+}
+
+// newTestFiles returns the fully-resolved proto file registry, like the app's interface registry uses.
+// The proto.HybridResolver is not used because it leaves some cross-file enum references as placeholders.
+func newTestFiles(t *testing.T) *protoregistry.Files {
+	files, err := proto.MergedRegistry()
+	require.NoError(t, err, "MergedRegistry")
+	return files
+}
+
+// registerDynamicTypes registers a dynamic type for each message in the provided proto packages that doesn't
+// already have a registered go type. The sdk's message renderer looks up types in the global registry while
+// parsing, and our gogoproto msgs aren't in there, so this is needed for Parse to reverse Format in these tests.
+func registerDynamicTypes(t *testing.T, files *protoregistry.Files, packages ...protoreflect.FullName) {
+	var register func(msgs protoreflect.MessageDescriptors)
+	register = func(msgs protoreflect.MessageDescriptors) {
+		for i := 0; i < msgs.Len(); i++ {
+			md := msgs.Get(i)
+			if md.IsMapEntry() {
+				continue
+			}
+			if _, err := protoregistry.GlobalTypes.FindMessageByName(md.FullName()); err != nil {
+				require.NoError(t, protoregistry.GlobalTypes.RegisterMessage(dynamicpb.NewMessageType(md)), "RegisterMessage(%s)", md.FullName())
+			}
+			register(md.Messages())
+		}
+	}
+	for _, pkg := range packages {
+		files.RangeFilesByPackage(pkg, func(fd protoreflect.FileDescriptor) bool {
+			register(fd.Messages())
+			return true
+		})
+	}
+}
+
+// toDynamic converts a gogoproto msg into a dynamic protoreflect message, like textual does with our msgs.
+func toDynamic(t *testing.T, files *protoregistry.Files, msg proto.Message) protoreflect.Message {
+	desc, err := files.FindDescriptorByName(protoreflect.FullName(proto.MessageName(msg)))
+	require.NoError(t, err, "FindDescriptorByName(%s)", proto.MessageName(msg))
+	bz, err := proto.Marshal(msg)
+	require.NoError(t, err, "Marshal(%s)", proto.MessageName(msg))
+	rv := dynamicpb.NewMessage(desc.(protoreflect.MessageDescriptor))
+	require.NoError(t, protov2.Unmarshal(bz, rv), "Unmarshal(%s)", proto.MessageName(msg))
+	return rv
+}
+
+func TestSummaryValueRenderers(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________").String()
+	addr2 := sdk.AccAddress("addr2_______________").String()
+	scopeID := metadatatypes.ScopeMetadataAddress(uuid.MustParse("3a4f3a2c-6b1e-4f6f-8f1a-2a3b4c5d6e7f"))
+
+	tests := []struct {
+		name       string
+		msg        proto.Message
+		expSummary string
+	}{
+		{
+			name: "add restricted marker",
+			msg: &markertypes.MsgAddMarkerRequest{
+				Amount:      sdk.NewInt64Coin("mycoin", 500),
+				Manager:     addr1,
+				FromAddress: addr1,
+				Status:      markertypes.StatusProposed,
+				MarkerType:  markertypes.MarkerType_RestrictedCoin,
+			},
+			expSummary: "Create restricted marker with 500 mycoin",
+		},
+		{
+			name: "add coin marker",
+			msg: &markertypes.MsgAddMarkerRequest{
+				Amount:      sdk.NewInt64Coin("mycoin", 5),
+				FromAddress: addr1,
+				MarkerType:  markertypes.MarkerType_Coin,
+			},
+			expSummary: "Create coin marker with 5 mycoin",
+		},
+		{
+			name:       "mint without recipient",
+			msg:        &markertypes.MsgMintRequest{Amount: sdk.NewInt64Coin("mycoin", 12), Administrator: addr1},
+			expSummary: "Mint 12 mycoin",
+		},
+		{
+			name:       "mint with recipient",
+			msg:        &markertypes.MsgMintRequest{Amount: sdk.NewInt64Coin("mycoin", 12), Administrator: addr1, Recipient: addr2},
+			expSummary: "Mint 12 mycoin to " + addr2,
+		},
+		{
+			name:       "burn",
+			msg:        &markertypes.MsgBurnRequest{Amount: sdk.NewInt64Coin("mycoin", 3), Administrator: addr1},
+			expSummary: "Burn 3 mycoin",
+		},
+		{
+			name: "transfer",
+			msg: &markertypes.MsgTransferRequest{
+				Amount:        sdk.NewInt64Coin("mycoin", 7),
+				Administrator: addr1,
+				FromAddress:   addr1,
+				ToAddress:     addr2,
+			},
+			expSummary: "Transfer 7 mycoin from " + addr1 + " to " + addr2,
+		},
+		{
+			name: "create ask",
+			msg: &exchange.MsgCreateAskRequest{
+				AskOrder: exchange.AskOrder{
+					MarketId: 3,
+					Seller:   addr1,
+					Assets:   sdk.NewInt64Coin("apple", 10),
+					Price:    sdk.NewInt64Coin("plum", 55),
+				},
+			},
+			expSummary: "Sell 10 apple for 55 plum in market 3",
+		},
+		{
+			name: "create bid",
+			msg: &exchange.MsgCreateBidRequest{
+				BidOrder: exchange.BidOrder{
+					MarketId: 8,
+					Buyer:    addr2,
+					Assets:   sdk.NewInt64Coin("apple", 4),
+					Price:    sdk.NewInt64Coin("plum", 20),
+				},
+			},
+			expSummary: "Buy 4 apple for 20 plum in market 8",
+		},
+		{
+			name: "write scope",
+			msg: &metadatatypes.MsgWriteScopeRequest{
+				Scope: metadatatypes.Scope{
+					ScopeId: scopeID,
+					Owners: []metadatatypes.Party{
+						{Address: addr1, Role: metadatatypes.PartyType_PARTY_TYPE_OWNER},
+						{Address: addr2, Role: metadatatypes.PartyType_PARTY_TYPE_OWNER},
+					},
+					ValueOwnerAddress: addr1,
+				},
+				Signers: []string{addr1, addr2},
+			},
+			expSummary: "Write scope " + scopeID.String() + " with 2 owner(s)",
+		},
+		{
+			name:       "delete scope",
+			msg:        &metadatatypes.MsgDeleteScopeRequest{ScopeId: scopeID, Signers: []string{addr1}},
+			expSummary: "Delete scope " + scopeID.String(),
+		},
+	}
+
+	files := newTestFiles(t)
+	registerDynamicTypes(t, files, "provenance.marker.v1", "provenance.exchange.v1", "provenance.metadata.v1")
+	handler, err := NewSignModeHandler(noMetadata, files)
+	require.NoError(t, err, "NewSignModeHandler")
+	ctx := context.Background()
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := toDynamic(t, files, tc.msg)
+			vr, err := handler.GetMessageValueRenderer(msg.Descriptor())
+			require.NoError(t, err, "GetMessageValueRenderer")
+			require.IsType(t, summaryValueRenderer{}, vr, "GetMessageValueRenderer result")
+
+			screens, err := vr.Format(ctx, protoreflect.ValueOfMessage(msg))
+			require.NoError(t, err, "Format")
+			require.GreaterOrEqual(t, len(screens), 2, "number of screens")
+			expSummary := sdktextual.Screen{Title: SummaryTitle, Content: tc.expSummary, Indent: 1}
+			assert.Equal(t, expSummary, screens[1], "summary screen")
+
+			baseScreens, err := sdktextual.NewMessageValueRenderer(handler, msg.Descriptor()).Format(ctx, protoreflect.ValueOfMessage(msg))
+			require.NoError(t, err, "default renderer Format")
+			assert.Equal(t, baseScreens[0], screens[0], "header screen")
+			assert.Equal(t, baseScreens[1:], screens[2:], "field screens")
+
+			parsed, err := vr.Parse(ctx, screens)
+			require.NoError(t, err, "Parse")
+			marshal := protov2.MarshalOptions{Deterministic: true}
+			expBz, err := marshal.Marshal(msg.Interface())
+			require.NoError(t, err, "Marshal original")
+			actBz, err := marshal.Marshal(parsed.Message().Interface())
+			require.NoError(t, err, "Marshal parsed")
+			assert.Equal(t, expBz, actBz, "parsed msg bytes")
+		})
+	}
+}
+
+func TestSummaryValueRendererParseWithoutSummary(t *testing.T) {
+	files := newTestFiles(t)
+	handler, err := NewSignModeHandler(noMetadata, files)
+	require.NoError(t, err, "NewSignModeHandler")
+	msg := toDynamic(t, files, &markertypes.MsgBurnRequest{Amount: sdk.NewInt64Coin("mycoin", 3)})
+	vr, err := handler.GetMessageValueRenderer(msg.Descriptor())
+	require.NoError(t, err, "GetMessageValueRenderer")
+
+	baseScreens, err := sdktextual.NewMessageValueRenderer(handler, msg.Descriptor()).Format(context.Background(), protoreflect.ValueOfMessage(msg))
+	require.NoError(t, err, "default renderer Format")
+	_, err = vr.Parse(context.Background(), baseScreens)
+	assert.EqualError(t, err, `expected a "Summary" screen after the header`, "Parse without a summary screen")
+}
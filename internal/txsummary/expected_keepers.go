@@ -0,0 +1,28 @@
+package txsummary
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
+)
+
+// BankKeeper defines the bank functionality needed to summarize a tx.
+type BankKeeper interface {
+	GetDenomMetaData(ctx context.Context, denom string) (banktypes.Metadata, bool)
+}
+
+// NameKeeper defines the name functionality needed to summarize a tx.
+type NameKeeper interface {
+	GetRecordsByAddress(ctx sdk.Context, address sdk.AccAddress) (nametypes.NameRecords, error)
+}
+
+// MarkerKeeper defines the marker functionality needed to summarize a tx.
+type MarkerKeeper interface {
+	GetMarker(ctx sdk.Context, address sdk.AccAddress) (markertypes.MarkerAccountI, error)
+	GetMarkerByDenom(ctx sdk.Context, denom string) (markertypes.MarkerAccountI, error)
+	IterateNetAssetValues(ctx sdk.Context, markerAddr sdk.AccAddress, handler func(state markertypes.NetAssetValue) (stop bool)) error
+}
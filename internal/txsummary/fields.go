@@ -0,0 +1,153 @@
+package txsummary
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// flatField is a single flattened field of a message's JSON.
+type flatField struct {
+	// Name is the path to the field.
+	Name string
+	// Value is the field's value as a string.
+	Value string
+	// Coin is the coin that this field represents (if it's a coin).
+	Coin *sdk.Coin
+}
+
+// jsonEntry is a single key/value entry in a JSON object.
+type jsonEntry struct {
+	Key   string
+	Value interface{}
+}
+
+// jsonObject is a JSON object with its entries in the order they were provided.
+type jsonObject []jsonEntry
+
+// flattenJSON converts a JSON object into a list of fields, keeping the order of the fields in the JSON.
+// Nested fields are named using dots and list indexes, e.g. "amount[0].denom".
+// Null, empty, and false values are skipped, and objects that look like coins are kept together as a single field.
+func flattenJSON(bz []byte) ([]flatField, error) {
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+	val, err := readJSONValue(dec)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse json: %w", err)
+	}
+	var rv []flatField
+	flattenValue("", val, &rv)
+	return rv, nil
+}
+
+// readJSONValue reads the next value from the decoder, keeping the order of any object entries.
+func readJSONValue(dec *json.Decoder) (interface{}, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	delim, isDelim := tok.(json.Delim)
+	if !isDelim {
+		return tok, nil
+	}
+
+	switch delim {
+	case '{':
+		obj := jsonObject{}
+		for dec.More() {
+			keyTok, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			key, ok := keyTok.(string)
+			if !ok {
+				return nil, fmt.Errorf("unexpected object key %v", keyTok)
+			}
+			val, err := readJSONValue(dec)
+			if err != nil {
+				return nil, err
+			}
+			obj = append(obj, jsonEntry{Key: key, Value: val})
+		}
+		_, err = dec.Token()
+		return obj, err
+	case '[':
+		list := []interface{}{}
+		for dec.More() {
+			val, err := readJSONValue(dec)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, val)
+		}
+		_, err = dec.Token()
+		return list, err
+	}
+	return nil, errors.New("unexpected delimiter " + delim.String())
+}
+
+// flattenValue adds the field(s) for the provided value to the list.
+func flattenValue(name string, val interface{}, fields *[]flatField) {
+	switch v := val.(type) {
+	case jsonObject:
+		if coin := asCoin(v); coin != nil {
+			*fields = append(*fields, flatField{Name: name, Value: coin.String(), Coin: coin})
+			return
+		}
+		for _, entry := range v {
+			key := entry.Key
+			if len(name) > 0 {
+				key = name + "." + key
+			}
+			flattenValue(key, entry.Value, fields)
+		}
+	case []interface{}:
+		for i, entry := range v {
+			flattenValue(name+"["+strconv.Itoa(i)+"]", entry, fields)
+		}
+	case string:
+		if len(v) > 0 {
+			*fields = append(*fields, flatField{Name: name, Value: v})
+		}
+	case json.Number:
+		*fields = append(*fields, flatField{Name: name, Value: v.String()})
+	case bool:
+		if v {
+			*fields = append(*fields, flatField{Name: name, Value: strconv.FormatBool(v)})
+		}
+	}
+}
+
+// asCoin returns the coin represented by the provided object, or nil if it's not a coin.
+func asCoin(obj jsonObject) *sdk.Coin {
+	if len(obj) != 2 {
+		return nil
+	}
+	var denom, amount string
+	for _, entry := range obj {
+		str, ok := entry.Value.(string)
+		if !ok {
+			return nil
+		}
+		switch entry.Key {
+		case "denom":
+			denom = str
+		case "amount":
+			amount = str
+		default:
+			return nil
+		}
+	}
+	amt, ok := sdkmath.NewIntFromString(amount)
+	if !ok || len(denom) == 0 {
+		return nil
+	}
+	return &sdk.Coin{Denom: denom, Amount: amt}
+}
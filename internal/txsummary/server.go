@@ -0,0 +1,81 @@
+package txsummary
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TxFetcher looks up the bytes of a tx that's already in a block using its hash.
+type TxFetcher func(ctx context.Context, hash []byte) ([]byte, error)
+
+// NewNodeTxFetcher returns a TxFetcher that gets txs from the node that the client context is connected to.
+func NewNodeTxFetcher(clientCtx client.Context) TxFetcher {
+	return func(ctx context.Context, hash []byte) ([]byte, error) {
+		node, err := clientCtx.GetNode()
+		if err != nil {
+			return nil, err
+		}
+		res, err := node.Tx(ctx, hash, false)
+		if err != nil {
+			return nil, err
+		}
+		return res.Tx, nil
+	}
+}
+
+// Server is the QueryServer for tx summaries.
+type Server struct {
+	summarizer *Summarizer
+	fetchTx    TxFetcher
+}
+
+var _ QueryServer = (*Server)(nil)
+
+// NewServer creates a new Server. The fetchTx func can be nil, in which case txs can't be looked up by hash.
+func NewServer(summarizer *Summarizer, fetchTx TxFetcher) *Server {
+	return &Server{summarizer: summarizer, fetchTx: fetchTx}
+}
+
+// SummarizeTx decodes a transaction and describes each of its messages in human-readable terms.
+func (s *Server) SummarizeTx(goCtx context.Context, req *SummarizeTxRequest) (*SummarizeTxResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	txBz := req.TxBytes
+	switch {
+	case len(req.TxBytes) > 0 && len(req.Hash) > 0:
+		return nil, status.Error(codes.InvalidArgument, "only one of tx_bytes or hash can be provided")
+	case len(req.Hash) > 0:
+		hash, err := hex.DecodeString(req.Hash)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid hash %q: %v", req.Hash, err)
+		}
+		if s.fetchTx == nil {
+			return nil, status.Error(codes.Unavailable, "tx lookup by hash is not available")
+		}
+		txBz, err = s.fetchTx(goCtx, hash)
+		if err != nil {
+			if strings.Contains(err.Error(), "not found") {
+				return nil, status.Errorf(codes.NotFound, "tx not found: %s", req.Hash)
+			}
+			return nil, status.Errorf(codes.Internal, "could not get tx %s: %v", req.Hash, err)
+		}
+	case len(req.TxBytes) == 0:
+		return nil, status.Error(codes.InvalidArgument, "either tx_bytes or hash must be provided")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	summary, err := s.summarizer.Summarize(ctx, txBz)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "could not summarize tx: %v", err)
+	}
+	return &SummarizeTxResponse{Summary: summary}, nil
+}
@@ -0,0 +1,119 @@
+package txsummary_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/internal/txsummary"
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+func TestServerSummarizeTx(t *testing.T) {
+	summarizer, txConfig := newTestSummarizer(t)
+	alice := sdk.AccAddress("alice_______________").String()
+
+	txBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(&banktypes.MsgSend{
+		FromAddress: alice,
+		ToAddress:   alice,
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("nhash", 5_000_000_000)),
+	}), "SetMsgs")
+	txBz, err := txConfig.TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err, "encoding tx")
+
+	fetchTx := func(_ context.Context, hash []byte) ([]byte, error) {
+		switch string(hash) {
+		case "\x0a\x0b":
+			return txBz, nil
+		case "\x0c\x0d":
+			return nil, errors.New("tx (0C0D) not found")
+		}
+		return nil, errors.New("node is down")
+	}
+
+	tests := []struct {
+		name    string
+		fetchTx txsummary.TxFetcher
+		req     *txsummary.SummarizeTxRequest
+		expErr  []string
+	}{
+		{
+			name:   "nil request",
+			req:    nil,
+			expErr: []string{"empty request"},
+		},
+		{
+			name:   "nothing provided",
+			req:    &txsummary.SummarizeTxRequest{},
+			expErr: []string{"either tx_bytes or hash must be provided"},
+		},
+		{
+			name:   "both provided",
+			req:    &txsummary.SummarizeTxRequest{TxBytes: txBz, Hash: "0A0B"},
+			expErr: []string{"only one of tx_bytes or hash can be provided"},
+		},
+		{
+			name:   "tx bytes",
+			req:    &txsummary.SummarizeTxRequest{TxBytes: txBz},
+			expErr: nil,
+		},
+		{
+			name:   "invalid tx bytes",
+			req:    &txsummary.SummarizeTxRequest{TxBytes: []byte("not a tx")},
+			expErr: []string{"could not summarize tx: could not decode tx"},
+		},
+		{
+			name:    "hash",
+			fetchTx: fetchTx,
+			req:     &txsummary.SummarizeTxRequest{Hash: "0A0B"},
+			expErr:  nil,
+		},
+		{
+			name:    "invalid hash",
+			fetchTx: fetchTx,
+			req:     &txsummary.SummarizeTxRequest{Hash: "nope"},
+			expErr:  []string{"invalid hash \"nope\""},
+		},
+		{
+			name:   "hash without fetcher",
+			req:    &txsummary.SummarizeTxRequest{Hash: "0A0B"},
+			expErr: []string{"tx lookup by hash is not available"},
+		},
+		{
+			name:    "hash not found",
+			fetchTx: fetchTx,
+			req:     &txsummary.SummarizeTxRequest{Hash: "0C0D"},
+			expErr:  []string{"tx not found: 0C0D"},
+		},
+		{
+			name:    "fetch error",
+			fetchTx: fetchTx,
+			req:     &txsummary.SummarizeTxRequest{Hash: "0E0F"},
+			expErr:  []string{"could not get tx 0E0F: node is down"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := txsummary.NewServer(summarizer, tc.fetchTx)
+			ctx := sdk.Context{}.WithContext(context.Background())
+			resp, err := server.SummarizeTx(ctx, tc.req)
+			assertions.AssertErrorContents(t, err, tc.expErr, "SummarizeTx error")
+			if len(tc.expErr) > 0 {
+				assert.Nil(t, resp, "SummarizeTx response")
+				return
+			}
+			require.NotNil(t, resp, "SummarizeTx response")
+			require.NotNil(t, resp.Summary, "SummarizeTx response summary")
+			require.Len(t, resp.Summary.Msgs, 1, "SummarizeTx response summary msgs")
+			assert.Equal(t, "Send 5 hash from alice.pb to alice.pb", resp.Summary.Msgs[0].Description, "msg description")
+		})
+	}
+}
@@ -0,0 +1,300 @@
+package txsummary
+
+import (
+	"fmt"
+	"strings"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	attributetypes "github.com/provenance-io/provenance/x/attribute/types"
+	"github.com/provenance-io/provenance/x/exchange"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
+)
+
+// Summarizer creates human-readable summaries of transactions.
+type Summarizer struct {
+	cdc          codec.Codec
+	txDecoder    sdk.TxDecoder
+	bankKeeper   BankKeeper
+	nameKeeper   NameKeeper
+	markerKeeper MarkerKeeper
+}
+
+// NewSummarizer creates a new Summarizer.
+func NewSummarizer(cdc codec.Codec, txDecoder sdk.TxDecoder, bankKeeper BankKeeper, nameKeeper NameKeeper, markerKeeper MarkerKeeper) *Summarizer {
+	return &Summarizer{
+		cdc:          cdc,
+		txDecoder:    txDecoder,
+		bankKeeper:   bankKeeper,
+		nameKeeper:   nameKeeper,
+		markerKeeper: markerKeeper,
+	}
+}
+
+// Summarize decodes the provided tx bytes and creates a human-readable summary of it using the current state.
+func (s *Summarizer) Summarize(ctx sdk.Context, txBz []byte) (*TxSummary, error) {
+	tx, err := s.txDecoder(txBz)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode tx: %w", err)
+	}
+
+	b := newSummaryBuilder(ctx, s)
+	rv := &TxSummary{Hash: fmt.Sprintf("%X", cmttypes.Tx(txBz).Hash())}
+
+	if memoTx, ok := tx.(sdk.TxWithMemo); ok {
+		rv.Memo = memoTx.GetMemo()
+	}
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		rv.Fee = feeTx.GetFee()
+		rv.FeeDisplay = b.coinsDisplay(rv.Fee)
+		rv.GasLimit = feeTx.GetGas()
+	}
+
+	for i, msg := range tx.GetMsgs() {
+		msgSum, err := b.summarizeMsg(msg)
+		if err != nil {
+			return nil, fmt.Errorf("could not summarize msg %d: %w", i, err)
+		}
+		rv.Msgs = append(rv.Msgs, *msgSum)
+	}
+
+	for _, addr := range b.addrOrder {
+		rv.Addresses = append(rv.Addresses, *b.addrs[addr])
+	}
+	for _, denom := range b.denomOrder {
+		rv.Denoms = append(rv.Denoms, *b.denoms[denom])
+	}
+	return rv, nil
+}
+
+// summaryBuilder holds the state info looked up while summarizing a single tx.
+type summaryBuilder struct {
+	ctx sdk.Context
+	s   *Summarizer
+
+	addrs      map[string]*AddressInfo
+	addrOrder  []string
+	denoms     map[string]*DenomInfo
+	denomOrder []string
+}
+
+// newSummaryBuilder creates a new summaryBuilder without any known addresses or denoms.
+func newSummaryBuilder(ctx sdk.Context, s *Summarizer) *summaryBuilder {
+	return &summaryBuilder{
+		ctx:    ctx,
+		s:      s,
+		addrs:  make(map[string]*AddressInfo),
+		denoms: make(map[string]*DenomInfo),
+	}
+}
+
+// summarizeMsg creates the summary of a single message.
+func (b *summaryBuilder) summarizeMsg(msg sdk.Msg) (*MsgSummary, error) {
+	bz, err := b.s.cdc.MarshalJSON(msg)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert %T to json: %w", msg, err)
+	}
+	flat, err := flattenJSON(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	rv := &MsgSummary{TypeUrl: sdk.MsgTypeURL(msg)}
+	for _, field := range flat {
+		rv.Fields = append(rv.Fields, b.newField(field))
+	}
+	rv.Description = b.describe(msg)
+	return rv, nil
+}
+
+// newField converts a flattened field into a Field, looking up info about any address or denom in it.
+func (b *summaryBuilder) newField(field flatField) Field {
+	rv := Field{Name: field.Name, Value: field.Value}
+	switch {
+	case field.Coin != nil:
+		rv.Display = b.coinDisplay(*field.Coin)
+	case isDenomField(field.Name):
+		if info := b.denom(field.Value); len(info.Display) > 0 {
+			rv.Display = info.Display
+		}
+	default:
+		if display := b.addrDisplay(field.Value); display != field.Value {
+			rv.Display = display
+		}
+	}
+	return rv
+}
+
+// isDenomField returns true if the field with the provided name contains a denom.
+func isDenomField(name string) bool {
+	if i := strings.LastIndexAny(name, ".]"); i >= 0 {
+		name = name[i+1:]
+	}
+	return name == "denom" || strings.HasSuffix(name, "_denom")
+}
+
+// address gets the info about the provided address, looking it up if it hasn't been yet.
+// Returns nil if the provided string is not an account address.
+func (b *summaryBuilder) address(addr string) *AddressInfo {
+	if info, known := b.addrs[addr]; known {
+		return info
+	}
+	accAddr, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return nil
+	}
+
+	info := &AddressInfo{Address: addr}
+	records, err := b.s.nameKeeper.GetRecordsByAddress(b.ctx, accAddr)
+	if err == nil {
+		for _, record := range records {
+			info.Names = append(info.Names, record.Name)
+		}
+	}
+	if marker, err := b.s.markerKeeper.GetMarker(b.ctx, accAddr); err == nil && marker != nil {
+		info.MarkerDenom = marker.GetDenom()
+	}
+
+	b.addrs[addr] = info
+	b.addrOrder = append(b.addrOrder, addr)
+	return info
+}
+
+// denom gets the info about the provided denom, looking it up if it hasn't been yet.
+func (b *summaryBuilder) denom(denom string) *DenomInfo {
+	if info, known := b.denoms[denom]; known {
+		return info
+	}
+
+	info := &DenomInfo{Denom: denom}
+	b.denoms[denom] = info
+	b.denomOrder = append(b.denomOrder, denom)
+
+	if md, found := b.s.bankKeeper.GetDenomMetaData(b.ctx, denom); found {
+		info.Symbol = md.Symbol
+		info.Description = md.Description
+		for _, unit := range md.DenomUnits {
+			if unit != nil && unit.Denom == md.Display {
+				info.Display = md.Display
+				info.Exponent = unit.Exponent
+				break
+			}
+		}
+	}
+	if marker, err := b.s.markerKeeper.GetMarkerByDenom(b.ctx, denom); err == nil && marker != nil {
+		info.IsMarker = true
+		err = b.s.markerKeeper.IterateNetAssetValues(b.ctx, marker.GetAddress(), func(nav markertypes.NetAssetValue) bool {
+			info.NetAssetValues = append(info.NetAssetValues, nav)
+			return false
+		})
+		if err != nil {
+			info.NetAssetValues = nil
+		}
+		for _, nav := range info.NetAssetValues {
+			b.denom(nav.Price.Denom)
+		}
+	}
+	return info
+}
+
+// addrDisplay returns the first name bound to the provided address, or the marker it's for.
+// If neither are known, the address is returned.
+func (b *summaryBuilder) addrDisplay(addr string) string {
+	info := b.address(addr)
+	switch {
+	case info == nil:
+		return addr
+	case len(info.Names) > 0:
+		return info.Names[0]
+	case len(info.MarkerDenom) > 0:
+		return "the " + info.MarkerDenom + " marker"
+	}
+	return addr
+}
+
+// coinDisplay returns a human-readable version of the provided coin, using the denom's display unit when known.
+func (b *summaryBuilder) coinDisplay(coin sdk.Coin) string {
+	info := b.denom(coin.Denom)
+	if len(info.Display) == 0 || info.Display == coin.Denom || coin.Amount.IsNil() {
+		return coin.String()
+	}
+	return shiftDecimal(coin.Amount.String(), info.Exponent) + " " + info.Display
+}
+
+// coinsDisplay returns a human-readable version of the provided coins, using each denom's display unit when known.
+func (b *summaryBuilder) coinsDisplay(coins sdk.Coins) string {
+	parts := make([]string, len(coins))
+	for i, coin := range coins {
+		parts[i] = b.coinDisplay(coin)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// shiftDecimal moves the decimal point of the provided integer string to the left exp places,
+// dropping any trailing zeros after the decimal point, e.g. shiftDecimal("1500", 3) = "1.5".
+func shiftDecimal(amount string, exp uint32) string {
+	if exp == 0 {
+		return amount
+	}
+	neg := strings.HasPrefix(amount, "-")
+	amount = strings.TrimPrefix(amount, "-")
+	if pad := int(exp) + 1 - len(amount); pad > 0 {
+		amount = strings.Repeat("0", pad) + amount
+	}
+	whole, frac := amount[:len(amount)-int(exp)], strings.TrimRight(amount[len(amount)-int(exp):], "0")
+	rv := whole
+	if len(frac) > 0 {
+		rv += "." + frac
+	}
+	if neg {
+		rv = "-" + rv
+	}
+	return rv
+}
+
+// describe returns a one-line human-readable description of what the provided message does.
+func (b *summaryBuilder) describe(msg sdk.Msg) string {
+	switch m := msg.(type) {
+	case *banktypes.MsgSend:
+		return fmt.Sprintf("Send %s from %s to %s", b.coinsDisplay(m.Amount), b.addrDisplay(m.FromAddress), b.addrDisplay(m.ToAddress))
+	case *markertypes.MsgMintRequest:
+		if len(m.Recipient) > 0 {
+			return fmt.Sprintf("Mint %s and send it to %s", b.coinDisplay(m.Amount), b.addrDisplay(m.Recipient))
+		}
+		return fmt.Sprintf("Mint %s into its marker", b.coinDisplay(m.Amount))
+	case *markertypes.MsgBurnRequest:
+		return fmt.Sprintf("Burn %s from its marker", b.coinDisplay(m.Amount))
+	case *markertypes.MsgWithdrawRequest:
+		return fmt.Sprintf("Withdraw %s from the %s marker to %s", b.coinsDisplay(m.Amount), m.Denom, b.addrDisplay(m.ToAddress))
+	case *markertypes.MsgTransferRequest:
+		return fmt.Sprintf("Transfer %s from %s to %s", b.coinDisplay(m.Amount), b.addrDisplay(m.FromAddress), b.addrDisplay(m.ToAddress))
+	case *markertypes.MsgAddNetAssetValuesRequest:
+		navs := make([]string, len(m.NetAssetValues))
+		for i, nav := range m.NetAssetValues {
+			navs[i] = fmt.Sprintf("%s = %s", b.coinDisplay(sdk.Coin{Denom: m.Denom, Amount: sdkmath.NewIntFromUint64(nav.Volume)}), b.coinDisplay(nav.Price))
+		}
+		return fmt.Sprintf("Set the net asset value of %s: %s", m.Denom, strings.Join(navs, "; "))
+	case *nametypes.MsgBindNameRequest:
+		name := m.Record.Name
+		if len(m.Parent.Name) > 0 {
+			name += "." + m.Parent.Name
+		}
+		return fmt.Sprintf("Bind the name %s to %s", name, b.addrDisplay(m.Record.Address))
+	case *attributetypes.MsgAddAttributeRequest:
+		return fmt.Sprintf("Add the %s attribute to %s", m.Name, b.addrDisplay(m.Account))
+	case *exchange.MsgCreateAskRequest:
+		return fmt.Sprintf("Offer to sell %s for %s in market %d", b.coinDisplay(m.AskOrder.Assets), b.coinDisplay(m.AskOrder.Price), m.AskOrder.MarketId)
+	case *exchange.MsgCreateBidRequest:
+		return fmt.Sprintf("Offer to buy %s for %s in market %d", b.coinDisplay(m.BidOrder.Assets), b.coinDisplay(m.BidOrder.Price), m.BidOrder.MarketId)
+	}
+
+	typeURL := sdk.MsgTypeURL(msg)
+	return "Execute " + typeURL[strings.LastIndex(typeURL, ".")+1:]
+}
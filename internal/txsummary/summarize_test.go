@@ -0,0 +1,181 @@
+package txsummary_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/internal/txsummary"
+	"github.com/provenance-io/provenance/testutil/assertions"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
+)
+
+// mockBankKeeper is a BankKeeper with denom metadata from a map.
+type mockBankKeeper map[string]banktypes.Metadata
+
+func (k mockBankKeeper) GetDenomMetaData(_ context.Context, denom string) (banktypes.Metadata, bool) {
+	md, found := k[denom]
+	return md, found
+}
+
+// mockNameKeeper is a NameKeeper with names from a map of address to names.
+type mockNameKeeper map[string][]string
+
+func (k mockNameKeeper) GetRecordsByAddress(_ sdk.Context, address sdk.AccAddress) (nametypes.NameRecords, error) {
+	var rv nametypes.NameRecords
+	for _, name := range k[address.String()] {
+		rv = append(rv, nametypes.NewNameRecord(name, address, false))
+	}
+	return rv, nil
+}
+
+// mockMarkerKeeper is a MarkerKeeper with markers and net asset values from maps (keyed by denom).
+type mockMarkerKeeper struct {
+	navs map[string][]markertypes.NetAssetValue
+}
+
+func (k mockMarkerKeeper) GetMarker(_ sdk.Context, address sdk.AccAddress) (markertypes.MarkerAccountI, error) {
+	for denom := range k.navs {
+		if markertypes.MustGetMarkerAddress(denom).Equals(address) {
+			return markertypes.NewEmptyMarkerAccount(denom, "", nil), nil
+		}
+	}
+	return nil, nil
+}
+
+func (k mockMarkerKeeper) GetMarkerByDenom(_ sdk.Context, denom string) (markertypes.MarkerAccountI, error) {
+	if _, found := k.navs[denom]; !found {
+		return nil, errors.New("marker " + denom + " not found")
+	}
+	return markertypes.NewEmptyMarkerAccount(denom, "", nil), nil
+}
+
+func (k mockMarkerKeeper) IterateNetAssetValues(_ sdk.Context, markerAddr sdk.AccAddress, handler func(state markertypes.NetAssetValue) (stop bool)) error {
+	for denom, navs := range k.navs {
+		if !markertypes.MustGetMarkerAddress(denom).Equals(markerAddr) {
+			continue
+		}
+		for _, nav := range navs {
+			if handler(nav) {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+func newTestSummarizer(t *testing.T) (*txsummary.Summarizer, client.TxConfig) {
+	encCfg := app.MakeTestEncodingConfig(t)
+	bankKeeper := mockBankKeeper{
+		"nhash": {
+			Base:       "nhash",
+			Display:    "hash",
+			Symbol:     "HASH",
+			DenomUnits: []*banktypes.DenomUnit{{Denom: "nhash"}, {Denom: "hash", Exponent: 9}},
+		},
+		"usd": {Base: "usd", Display: "usd", DenomUnits: []*banktypes.DenomUnit{{Denom: "usd"}}},
+	}
+	nameKeeper := mockNameKeeper{
+		sdk.AccAddress("alice_______________").String(): {"alice.pb", "alice.sc.pb"},
+	}
+	markerKeeper := mockMarkerKeeper{navs: map[string][]markertypes.NetAssetValue{
+		"apple": {markertypes.NewNetAssetValue(sdk.NewInt64Coin("usd", 250), 10)},
+	}}
+	return txsummary.NewSummarizer(encCfg.Marshaler, encCfg.TxConfig.TxDecoder(), bankKeeper, nameKeeper, markerKeeper),
+		encCfg.TxConfig
+}
+
+func TestSummarize(t *testing.T) {
+	summarizer, txConfig := newTestSummarizer(t)
+	alice := sdk.AccAddress("alice_______________").String()
+	bob := sdk.AccAddress("bob_________________").String()
+	appleMarker := markertypes.MustGetMarkerAddress("apple").String()
+
+	txBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(
+		&banktypes.MsgSend{FromAddress: alice, ToAddress: bob, Amount: sdk.NewCoins(sdk.NewInt64Coin("nhash", 1_500_000_000))},
+		&markertypes.MsgWithdrawRequest{Denom: "apple", Administrator: bob, ToAddress: alice, Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 3))},
+		&markertypes.MsgTransferRequest{Amount: sdk.NewInt64Coin("apple", 2), Administrator: bob, FromAddress: appleMarker, ToAddress: bob},
+		&nametypes.MsgBindNameRequest{
+			Parent: nametypes.NameRecord{Name: "pb", Address: alice},
+			Record: nametypes.NameRecord{Name: "bob", Address: bob},
+		},
+		&markertypes.MsgActivateRequest{Denom: "apple", Administrator: bob},
+	), "SetMsgs")
+	txBuilder.SetMemo("a memo")
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("nhash", 20_000_000_000)))
+	txBuilder.SetGasLimit(100_000)
+	txBz, err := txConfig.TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err, "encoding tx")
+
+	summary, err := summarizer.Summarize(sdk.Context{}, txBz)
+	require.NoError(t, err, "Summarize")
+	require.NotNil(t, summary, "Summarize result")
+
+	assert.Len(t, summary.Hash, 64, "Hash")
+	assert.Equal(t, "a memo", summary.Memo, "Memo")
+	assert.Equal(t, "20000000000nhash", summary.Fee.String(), "Fee")
+	assert.Equal(t, "20 hash", summary.FeeDisplay, "FeeDisplay")
+	assert.Equal(t, uint64(100_000), summary.GasLimit, "GasLimit")
+
+	expDescs := []string{
+		"Send 1.5 hash from alice.pb to " + bob,
+		"Withdraw 3apple from the apple marker to alice.pb",
+		"Transfer 2apple from the apple marker to " + bob,
+		"Bind the name bob.pb to " + bob,
+		"Execute MsgActivateRequest",
+	}
+	require.Len(t, summary.Msgs, len(expDescs), "Msgs")
+	for i, exp := range expDescs {
+		assert.Equal(t, exp, summary.Msgs[i].Description, "Msgs[%d].Description", i)
+	}
+
+	expSendFields := []txsummary.Field{
+		{Name: "from_address", Value: alice, Display: "alice.pb"},
+		{Name: "to_address", Value: bob},
+		{Name: "amount[0]", Value: "1500000000nhash", Display: "1.5 hash"},
+	}
+	assert.Equal(t, "/cosmos.bank.v1beta1.MsgSend", summary.Msgs[0].TypeUrl, "Msgs[0].TypeUrl")
+	assert.Equal(t, expSendFields, summary.Msgs[0].Fields, "Msgs[0].Fields")
+
+	expBindFields := []txsummary.Field{
+		{Name: "parent.name", Value: "pb"},
+		{Name: "parent.address", Value: alice, Display: "alice.pb"},
+		{Name: "record.name", Value: "bob"},
+		{Name: "record.address", Value: bob},
+	}
+	assert.Equal(t, expBindFields, summary.Msgs[3].Fields, "Msgs[3].Fields")
+
+	expAddrs := []txsummary.AddressInfo{
+		{Address: alice, Names: []string{"alice.pb", "alice.sc.pb"}},
+		{Address: bob},
+		{Address: appleMarker, MarkerDenom: "apple"},
+	}
+	assert.Equal(t, expAddrs, summary.Addresses, "Addresses")
+
+	expDenoms := []txsummary.DenomInfo{
+		{Denom: "nhash", Display: "hash", Exponent: 9, Symbol: "HASH"},
+		{
+			Denom:          "apple",
+			IsMarker:       true,
+			NetAssetValues: []markertypes.NetAssetValue{markertypes.NewNetAssetValue(sdk.NewInt64Coin("usd", 250), 10)},
+		},
+		{Denom: "usd", Display: "usd"},
+	}
+	assert.Equal(t, expDenoms, summary.Denoms, "Denoms")
+}
+
+func TestSummarizeInvalidTx(t *testing.T) {
+	summarizer, _ := newTestSummarizer(t)
+	_, err := summarizer.Summarize(sdk.Context{}, []byte("not a tx"))
+	assertions.AssertErrorContents(t, err, []string{"could not decode tx"}, "Summarize error")
+}
@@ -0,0 +1,66 @@
+package unordered
+
+import (
+	"encoding/binary"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// NonceKeyPrefix is the prefix of the keys of the nonces of unordered txs.
+// Key format: 0x01 | <timeout unix nanos (8 bytes)> | <length-prefixed signer address> => <empty>
+var NonceKeyPrefix = []byte{0x01}
+
+// MakeNonceKey creates the key for the nonce of an unordered tx with the provided signer and timeout.
+func MakeNonceKey(signer sdk.AccAddress, timeout time.Time) []byte {
+	lpAddr := address.MustLengthPrefix(signer)
+	rv := make([]byte, 0, len(NonceKeyPrefix)+8+len(lpAddr))
+	rv = append(rv, NonceKeyPrefix...)
+	rv = binary.BigEndian.AppendUint64(rv, uint64(timeout.UnixNano()))
+	rv = append(rv, lpAddr...)
+	return rv
+}
+
+// Keeper keeps track of the unordered txs that have been included so that they can't be included again.
+//
+// Each signer of an unordered tx gets a nonce for the tx's timeout. A signer can only have one nonce for a given
+// timeout, so it cannot sign a second unordered tx with the same timeout until the first one has expired.
+type Keeper struct {
+	storeKey storetypes.StoreKey
+}
+
+// NewKeeper creates a new unordered tx Keeper.
+func NewKeeper(storeKey storetypes.StoreKey) Keeper {
+	return Keeper{storeKey: storeKey}
+}
+
+// HasNonce returns true if the provided signer has already used the provided timeout for an unordered tx.
+func (k Keeper) HasNonce(ctx sdk.Context, signer sdk.AccAddress, timeout time.Time) bool {
+	return ctx.KVStore(k.storeKey).Has(MakeNonceKey(signer, timeout))
+}
+
+// AddNonce records that the provided signer has used the provided timeout for an unordered tx.
+func (k Keeper) AddNonce(ctx sdk.Context, signer sdk.AccAddress, timeout time.Time) {
+	ctx.KVStore(k.storeKey).Set(MakeNonceKey(signer, timeout), []byte{})
+}
+
+// RemoveExpiredNonces deletes all the nonces with a timeout before the block time and returns how many were deleted.
+func (k Keeper) RemoveExpiredNonces(ctx sdk.Context) int {
+	store := ctx.KVStore(k.storeKey)
+	end := binary.BigEndian.AppendUint64(append([]byte{}, NonceKeyPrefix...), uint64(ctx.BlockTime().UnixNano()))
+
+	var keys [][]byte
+	iter := store.Iterator(NonceKeyPrefix, end)
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return len(keys)
+}
@@ -0,0 +1,46 @@
+package unordered_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/internal/unordered"
+)
+
+func TestKeeperNonces(t *testing.T) {
+	key := storetypes.NewKVStoreKey(unordered.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+	k := unordered.NewKeeper(key)
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	now := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+	t1 := now.Add(time.Minute)
+	t2 := now.Add(2 * time.Minute)
+
+	assert.False(t, k.HasNonce(ctx, addr1, t1), "HasNonce(addr1, t1) before adding")
+	k.AddNonce(ctx, addr1, t1)
+	k.AddNonce(ctx, addr2, t1)
+	k.AddNonce(ctx, addr1, t2)
+	assert.True(t, k.HasNonce(ctx, addr1, t1), "HasNonce(addr1, t1)")
+	assert.True(t, k.HasNonce(ctx, addr2, t1), "HasNonce(addr2, t1)")
+	assert.True(t, k.HasNonce(ctx, addr1, t2), "HasNonce(addr1, t2)")
+	assert.False(t, k.HasNonce(ctx, addr2, t2), "HasNonce(addr2, t2)")
+	assert.False(t, k.HasNonce(ctx, addr1, t1.Add(time.Nanosecond)), "HasNonce(addr1, t1+1ns)")
+
+	assert.Equal(t, 0, k.RemoveExpiredNonces(ctx.WithBlockTime(now)), "RemoveExpiredNonces(now)")
+	assert.Equal(t, 0, k.RemoveExpiredNonces(ctx.WithBlockTime(t1)), "RemoveExpiredNonces(t1)")
+	assert.True(t, k.HasNonce(ctx, addr1, t1), "HasNonce(addr1, t1) after removing expired at t1")
+
+	assert.Equal(t, 2, k.RemoveExpiredNonces(ctx.WithBlockTime(t1.Add(time.Nanosecond))), "RemoveExpiredNonces(t1+1ns)")
+	assert.False(t, k.HasNonce(ctx, addr1, t1), "HasNonce(addr1, t1) after removing expired")
+	assert.False(t, k.HasNonce(ctx, addr2, t1), "HasNonce(addr2, t1) after removing expired")
+	assert.True(t, k.HasNonce(ctx, addr1, t2), "HasNonce(addr1, t2) after removing expired")
+}
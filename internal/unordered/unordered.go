@@ -0,0 +1,80 @@
+package unordered
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// StoreKey is the name of the store that holds the nonces of unordered txs.
+const StoreKey = "unordered"
+
+// MaxTimeoutDuration is the farthest in the future (from the block time) that an unordered tx's timeout can be.
+// It limits how long the nonce of each unordered tx needs to be kept in state.
+const MaxTimeoutDuration = 10 * time.Minute
+
+// RegisterInterfaces registers the unordered extension option as a tx extension option.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*tx.TxExtensionOptionI)(nil), &ExtensionOptionUnordered{})
+}
+
+// IsExtensionOption returns true if the provided extension option is an ExtensionOptionUnordered.
+// It can be used as the ante handler's ExtensionOptionChecker.
+func IsExtensionOption(opt *codectypes.Any) bool {
+	// The type url is looked up here (instead of in a package var) because the type
+	// isn't registered until the init() in unordered.pb.go has run.
+	return opt != nil && opt.TypeUrl == sdk.MsgTypeURL(&ExtensionOptionUnordered{})
+}
+
+// hasExtensionOptionsTx is a tx that has extension options. It's the same as the SDK's ante.HasExtensionOptionsTx.
+type hasExtensionOptionsTx interface {
+	GetExtensionOptions() []*codectypes.Any
+}
+
+// NewExtensionOption creates a new extension option that marks a tx as unordered with the provided timeout.
+// It can be provided to a tx builder's SetExtensionOptions.
+func NewExtensionOption(timeout time.Time) (*codectypes.Any, error) {
+	return codectypes.NewAnyWithValue(&ExtensionOptionUnordered{TimeoutTimestamp: timeout})
+}
+
+// GetExtensionOption returns the unordered extension option of the provided tx.
+// If the tx is not unordered, nil is returned (without an error).
+// An error is returned if the tx has more than one unordered extension option, or it cannot be unpacked.
+func GetExtensionOption(sdkTx sdk.Tx) (*ExtensionOptionUnordered, error) {
+	extTx, ok := sdkTx.(hasExtensionOptionsTx)
+	if !ok {
+		return nil, nil
+	}
+
+	var rv *ExtensionOptionUnordered
+	for _, opt := range extTx.GetExtensionOptions() {
+		if !IsExtensionOption(opt) {
+			continue
+		}
+		if rv != nil {
+			return nil, errors.New("a tx cannot have more than one unordered extension option")
+		}
+
+		if cached, isUnordered := opt.GetCachedValue().(*ExtensionOptionUnordered); isUnordered {
+			rv = cached
+			continue
+		}
+		rv = &ExtensionOptionUnordered{}
+		if err := proto.Unmarshal(opt.Value, rv); err != nil {
+			return nil, fmt.Errorf("could not unpack unordered extension option: %w", err)
+		}
+	}
+	return rv, nil
+}
+
+// IsUnorderedTx returns true if the provided tx has an unordered extension option.
+func IsUnorderedTx(sdkTx sdk.Tx) bool {
+	opt, err := GetExtensionOption(sdkTx)
+	return err == nil && opt != nil
+}
@@ -0,0 +1,82 @@
+package unordered_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/internal/unordered"
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+func TestGetExtensionOption(t *testing.T) {
+	txConfig := app.MakeTestEncodingConfig(t).TxConfig
+	timeout := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	newOpt := func(timeout time.Time) *codectypes.Any {
+		rv, err := unordered.NewExtensionOption(timeout)
+		require.NoError(t, err, "NewExtensionOption(%s)", timeout)
+		return rv
+	}
+	otherOpt, err := codectypes.NewAnyWithValue(&testdata.Dog{Name: "Spot"})
+	require.NoError(t, err, "NewAnyWithValue(Dog)")
+
+	tests := []struct {
+		name   string
+		opts   []*codectypes.Any
+		exp    *unordered.ExtensionOptionUnordered
+		expErr []string
+	}{
+		{
+			name: "no options",
+		},
+		{
+			name: "other option",
+			opts: []*codectypes.Any{otherOpt},
+		},
+		{
+			name: "unordered option",
+			opts: []*codectypes.Any{otherOpt, newOpt(timeout)},
+			exp:  &unordered.ExtensionOptionUnordered{TimeoutTimestamp: timeout},
+		},
+		{
+			name: "unpacked unordered option",
+			opts: []*codectypes.Any{{TypeUrl: newOpt(timeout).TypeUrl, Value: newOpt(timeout).Value}},
+			exp:  &unordered.ExtensionOptionUnordered{TimeoutTimestamp: timeout},
+		},
+		{
+			name:   "two unordered options",
+			opts:   []*codectypes.Any{newOpt(timeout), newOpt(timeout.Add(time.Second))},
+			expErr: []string{"a tx cannot have more than one unordered extension option"},
+		},
+		{
+			name:   "invalid unordered option",
+			opts:   []*codectypes.Any{{TypeUrl: newOpt(timeout).TypeUrl, Value: []byte{0xff}}},
+			expErr: []string{"could not unpack unordered extension option"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			builder := txConfig.NewTxBuilder()
+			builder.(client.ExtendedTxBuilder).SetExtensionOptions(tc.opts...)
+			tx := builder.GetTx()
+
+			actual, err := unordered.GetExtensionOption(tx)
+			assertions.AssertErrorContents(t, err, tc.expErr, "GetExtensionOption error")
+			if tc.exp == nil {
+				assert.Nil(t, actual, "GetExtensionOption result")
+			} else if assert.NotNil(t, actual, "GetExtensionOption result") {
+				assert.Equal(t, tc.exp.TimeoutTimestamp.UTC(), actual.TimeoutTimestamp.UTC(), "GetExtensionOption result timeout")
+			}
+			assert.Equal(t, len(tc.expErr) == 0 && tc.exp != nil, unordered.IsUnorderedTx(tx), "IsUnorderedTx")
+		})
+	}
+}
@@ -15,6 +15,11 @@
 ###   SIM_NUM_BLOCKS: The number of blocks to use for test-sim-benchmark or test-sim-profile. Default is 500.
 ###   SIM_BLOCK_SIZE: The size of blocks to use for test-sim-benchmark or test-sim-profile. Default is 200.
 ###   SIM_COMMIT:     Whether to commit during  test-sim-benchmark or test-sim-profile. Default is true.
+###   SIM_CONFIG:     Path to a YAML/JSON file with op weights and scenario params for test-sim-harness.
+###                   See app/testdata/sim_harness_config.yaml for an example.
+###   SIM_RECORD:     Path to write the test-sim-harness record (seed, params, app hash) to.
+###                   Default is $(BUILDDIR)/sim-harness-record.json.
+###   SIM_REPLAY:     Path to a test-sim-harness record file to replay.
 
 SIMAPP = ./app
 DB_BACKEND ?= goleveldb
@@ -90,7 +95,20 @@ test-sim-profile:
 	$(GO) test -mod=readonly -benchmem -run=^$$ $(SIMAPP) -bench ^BenchmarkFullAppSimulation$$ \
 		-Enabled=true -NumBlocks=$(SIM_NUM_BLOCKS) -BlockSize=$(SIM_BLOCK_SIZE) -Commit=$(SIM_COMMIT) -timeout 24h -cpuprofile cpu.out -memprofile mem.out
 
+SIM_CONFIG ?=
+SIM_RECORD ?= $(BUILDDIR)/sim-harness-record.json
+SIM_REPLAY ?=
+
+# Runs the sim twice with the same seed and params, failing with store diffs if they diverge.
+# The seed, params, and resulting app hash are written to SIM_RECORD so the run can be replayed with SIM_REPLAY.
+test-sim-harness:
+	@echo "Running deterministic simulation harness..."
+	@mkdir -p $(dir $(SIM_RECORD))
+	$(GO) test -mod=readonly $(SIMAPP) -run ^TestSimHarness$$ -Enabled=true -NumBlocks=50 -BlockSize=100 -Period=0 \
+		-SimConfigFile='$(SIM_CONFIG)' -SimRecordFile='$(SIM_RECORD)' -SimReplayFile='$(SIM_REPLAY)' -v -timeout 24h
+
 .PHONY: \
+test-sim-harness \
 test-sim-nondeterminism \
 test-sim-custom-genesis-fast \
 test-sim-simple \
@@ -0,0 +1,660 @@
+package fixtures
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+// Ratio creates a FeeRatio from a "<price>:<fee>" string, panicking on error.
+func Ratio(ratioStr string) exchange.FeeRatio {
+	rv, err := exchange.ParseFeeRatio(ratioStr)
+	if err != nil {
+		panic(err)
+	}
+	return *rv
+}
+
+// Ratios creates a slice of FeeRatios from a comma delimited list of "<price>:<fee>" entries, panicking on error.
+func Ratios(ratiosStr string) []exchange.FeeRatio {
+	if len(ratiosStr) == 0 {
+		return nil
+	}
+	ratios := strings.Split(ratiosStr, ",")
+	rv := make([]exchange.FeeRatio, len(ratios))
+	for i, r := range ratios {
+		rv[i] = Ratio(r)
+	}
+	return rv
+}
+
+// AgCanOnly creates an AccessGrant for the given address with only the provided permission.
+func AgCanOnly(addr sdk.AccAddress, perm exchange.Permission) exchange.AccessGrant {
+	return exchange.AccessGrant{
+		Address:     addr.String(),
+		Permissions: []exchange.Permission{perm},
+	}
+}
+
+// AgCanAllBut creates an AccessGrant for the given address with all permissions except the provided one.
+func AgCanAllBut(addr sdk.AccAddress, perm exchange.Permission) exchange.AccessGrant {
+	rv := exchange.AccessGrant{
+		Address: addr.String(),
+	}
+	for _, p := range exchange.AllPermissions() {
+		if p != perm {
+			rv.Permissions = append(rv.Permissions, p)
+		}
+	}
+	return rv
+}
+
+// AgCanEverything creates an AccessGrant for the given address with all permissions available.
+func AgCanEverything(addr sdk.AccAddress) exchange.AccessGrant {
+	return exchange.AccessGrant{
+		Address:     addr.String(),
+		Permissions: exchange.AllPermissions(),
+	}
+}
+
+// NewMarket creates a market with the provided id and name that is accepting orders and commitments,
+// allows user settlement, and gives the admin all permissions. It has no fees or required attributes.
+func NewMarket(marketID uint32, name string, admin sdk.AccAddress) exchange.Market {
+	return exchange.Market{
+		MarketId:             marketID,
+		MarketDetails:        exchange.MarketDetails{Name: name},
+		AcceptingOrders:      true,
+		AllowUserSettlement:  true,
+		AccessGrants:         []exchange.AccessGrant{AgCanEverything(admin)},
+		AcceptingCommitments: true,
+	}
+}
+
+// NewAskOrder creates an ask order with the provided id, market, seller, assets, and price (e.g. "10apple", "5plum").
+func NewAskOrder(orderID uint64, marketID uint32, seller sdk.AccAddress, assets, price string) *exchange.Order {
+	return exchange.NewOrder(orderID).WithAsk(&exchange.AskOrder{
+		MarketId: marketID,
+		Seller:   seller.String(),
+		Assets:   Coin(assets),
+		Price:    Coin(price),
+	})
+}
+
+// NewBidOrder creates a bid order with the provided id, market, buyer, assets, and price (e.g. "10apple", "5plum").
+func NewBidOrder(orderID uint64, marketID uint32, buyer sdk.AccAddress, assets, price string) *exchange.Order {
+	return exchange.NewOrder(orderID).WithBid(&exchange.BidOrder{
+		MarketId: marketID,
+		Buyer:    buyer.String(),
+		Assets:   Coin(assets),
+		Price:    Coin(price),
+	})
+}
+
+// NewCommitment creates a commitment of the provided amount (e.g. "10apple,5plum") from an account to a market.
+func NewCommitment(account sdk.AccAddress, marketID uint32, amount string) exchange.Commitment {
+	return exchange.Commitment{
+		Account:  account.String(),
+		MarketId: marketID,
+		Amount:   Coins(amount),
+	}
+}
+
+// NewPayment creates a payment with the provided source, target, amounts (e.g. "10apple,5plum"), and external id.
+func NewPayment(source sdk.AccAddress, sourceAmount string, target sdk.AccAddress, targetAmount string, externalID string) *exchange.Payment {
+	rv := &exchange.Payment{
+		Source:       source.String(),
+		SourceAmount: Coins(sourceAmount),
+		TargetAmount: Coins(targetAmount),
+		ExternalId:   externalID,
+	}
+	if len(target) > 0 {
+		rv.Target = target.String()
+	}
+	return rv
+}
+
+// CopyRatio creates a copy of a FeeRatio.
+func CopyRatio(orig exchange.FeeRatio) exchange.FeeRatio {
+	return exchange.FeeRatio{
+		Price: CopyCoin(orig.Price),
+		Fee:   CopyCoin(orig.Fee),
+	}
+}
+
+// CopyRatios creates a copy of a slice of FeeRatios.
+func CopyRatios(orig []exchange.FeeRatio) []exchange.FeeRatio {
+	return CopySlice(orig, CopyRatio)
+}
+
+// CopyAccessGrant creates a copy of an AccessGrant.
+func CopyAccessGrant(orig exchange.AccessGrant) exchange.AccessGrant {
+	return exchange.AccessGrant{
+		Address:     orig.Address,
+		Permissions: CopySlice(orig.Permissions, NoOpCopier[exchange.Permission]),
+		Expiration:  CopyTimeP(orig.Expiration),
+	}
+}
+
+// CopyAccessGrants creates a copy of a slice of AccessGrants.
+func CopyAccessGrants(orig []exchange.AccessGrant) []exchange.AccessGrant {
+	return CopySlice(orig, CopyAccessGrant)
+}
+
+// CopyOrderRateLimit creates a copy of an OrderRateLimit.
+func CopyOrderRateLimit(orig *exchange.OrderRateLimit) *exchange.OrderRateLimit {
+	if orig == nil {
+		return nil
+	}
+	return &exchange.OrderRateLimit{
+		MaxOrders:    orig.MaxOrders,
+		WindowBlocks: orig.WindowBlocks,
+	}
+}
+
+// CopyMarket creates a deep copy of a market.
+func CopyMarket(orig exchange.Market) exchange.Market {
+	return exchange.Market{
+		MarketId: orig.MarketId,
+		MarketDetails: exchange.MarketDetails{
+			Name:        orig.MarketDetails.Name,
+			Description: orig.MarketDetails.Description,
+			WebsiteUrl:  orig.MarketDetails.WebsiteUrl,
+			IconUri:     orig.MarketDetails.IconUri,
+		},
+		FeeCreateAskFlat:                CopyCoins(orig.FeeCreateAskFlat),
+		FeeCreateBidFlat:                CopyCoins(orig.FeeCreateBidFlat),
+		FeeSellerSettlementFlat:         CopyCoins(orig.FeeSellerSettlementFlat),
+		FeeSellerSettlementRatios:       CopyRatios(orig.FeeSellerSettlementRatios),
+		FeeBuyerSettlementFlat:          CopyCoins(orig.FeeBuyerSettlementFlat),
+		FeeBuyerSettlementRatios:        CopyRatios(orig.FeeBuyerSettlementRatios),
+		AcceptingOrders:                 orig.AcceptingOrders,
+		AllowUserSettlement:             orig.AllowUserSettlement,
+		AccessGrants:                    CopyAccessGrants(orig.AccessGrants),
+		ReqAttrCreateAsk:                CopyStrings(orig.ReqAttrCreateAsk),
+		ReqAttrCreateBid:                CopyStrings(orig.ReqAttrCreateBid),
+		AcceptingCommitments:            orig.AcceptingCommitments,
+		FeeCreateCommitmentFlat:         CopyCoins(orig.FeeCreateCommitmentFlat),
+		CommitmentSettlementBips:        orig.CommitmentSettlementBips,
+		IntermediaryDenom:               orig.IntermediaryDenom,
+		ReqAttrCreateCommitment:         CopyStrings(orig.ReqAttrCreateCommitment),
+		ContinuousMatching:              orig.ContinuousMatching,
+		BatchAuctionInterval:            orig.BatchAuctionInterval,
+		SelfTradePrevention:             orig.SelfTradePrevention,
+		AcceptedAssetDenoms:             CopyStrings(orig.AcceptedAssetDenoms),
+		AcceptedPriceDenoms:             CopyStrings(orig.AcceptedPriceDenoms),
+		FeeSellerSettlementTakerRatios:  CopyRatios(orig.FeeSellerSettlementTakerRatios),
+		FeeSellerSettlementRebateRatios: CopyRatios(orig.FeeSellerSettlementRebateRatios),
+		RebateAddresses:                 CopyStrings(orig.RebateAddresses),
+		ReferralBips:                    orig.ReferralBips,
+		ReferralCap:                     CopyCoins(orig.ReferralCap),
+		PublishPrices:                   orig.PublishPrices,
+		ExternalIdScope:                 orig.ExternalIdScope,
+		OrderRateLimit:                  CopyOrderRateLimit(orig.OrderRateLimit),
+		MaxExposure:                     CopyCoinP(orig.MaxExposure),
+		FillAlgorithm:                   orig.FillAlgorithm,
+		ExchangeSplits:                  CopyDenomSplits(orig.ExchangeSplits),
+	}
+}
+
+// CopyMarkets creates a copy of a slice of markets.
+func CopyMarkets(orig []exchange.Market) []exchange.Market {
+	return CopySlice(orig, CopyMarket)
+}
+
+// CopyOrder creates a copy of an order.
+func CopyOrder(orig exchange.Order) exchange.Order {
+	rv := exchange.NewOrder(orig.OrderId)
+	switch {
+	case orig.IsAskOrder():
+		rv.WithAsk(CopyAskOrder(orig.GetAskOrder()))
+	case orig.IsBidOrder():
+		rv.WithBid(CopyBidOrder(orig.GetBidOrder()))
+	default:
+		rv.Order = orig.Order
+	}
+	return *rv
+}
+
+// CopyOrders creates a copy of a slice of orders.
+func CopyOrders(orig []exchange.Order) []exchange.Order {
+	return CopySlice(orig, CopyOrder)
+}
+
+// CopyTriggerOrder creates a copy of a trigger order.
+func CopyTriggerOrder(orig exchange.TriggerOrder) exchange.TriggerOrder {
+	return exchange.TriggerOrder{
+		Order:        CopyOrder(orig.Order),
+		TriggerPrice: CopyCoin(orig.TriggerPrice),
+	}
+}
+
+// CopyTriggerOrders creates a copy of a slice of trigger orders.
+func CopyTriggerOrders(orig []exchange.TriggerOrder) []exchange.TriggerOrder {
+	return CopySlice(orig, CopyTriggerOrder)
+}
+
+// CopyOrderLink creates a copy of an order link.
+func CopyOrderLink(orig exchange.OrderLink) exchange.OrderLink {
+	return exchange.OrderLink{
+		MarketId:      orig.MarketId,
+		OrderId:       orig.OrderId,
+		LinkedOrderId: orig.LinkedOrderId,
+	}
+}
+
+// CopyOrderLinks creates a copy of a slice of order links.
+func CopyOrderLinks(orig []exchange.OrderLink) []exchange.OrderLink {
+	return CopySlice(orig, CopyOrderLink)
+}
+
+// CopySettlementPrice creates a copy of a settlement price.
+func CopySettlementPrice(orig exchange.SettlementPrice) exchange.SettlementPrice {
+	return exchange.SettlementPrice{
+		MarketId: orig.MarketId,
+		Time:     orig.Time,
+		Assets:   CopyCoin(orig.Assets),
+		Price:    CopyCoin(orig.Price),
+	}
+}
+
+// CopySettlementPrices creates a copy of a slice of settlement prices.
+func CopySettlementPrices(orig []exchange.SettlementPrice) []exchange.SettlementPrice {
+	return CopySlice(orig, CopySettlementPrice)
+}
+
+// CopySettlementFill creates a copy of a settlement fill.
+func CopySettlementFill(orig exchange.SettlementFill) exchange.SettlementFill {
+	return exchange.SettlementFill{
+		OrderId:    orig.OrderId,
+		OrderType:  orig.OrderType,
+		Owner:      orig.Owner,
+		Assets:     CopyCoin(orig.Assets),
+		Price:      CopyCoin(orig.Price),
+		Fees:       CopyCoins(orig.Fees),
+		Partial:    orig.Partial,
+		ExternalId: orig.ExternalId,
+	}
+}
+
+// CopySettlementFills creates a copy of a slice of settlement fills.
+func CopySettlementFills(orig []exchange.SettlementFill) []exchange.SettlementFill {
+	return CopySlice(orig, CopySettlementFill)
+}
+
+// CopySettlementRecord creates a copy of a settlement record.
+func CopySettlementRecord(orig exchange.SettlementRecord) exchange.SettlementRecord {
+	return exchange.SettlementRecord{
+		MarketId: orig.MarketId,
+		Height:   orig.Height,
+		Sequence: orig.Sequence,
+		Time:     orig.Time,
+		Fills:    CopySettlementFills(orig.Fills),
+	}
+}
+
+// CopySettlementRecords creates a copy of a slice of settlement records.
+func CopySettlementRecords(orig []exchange.SettlementRecord) []exchange.SettlementRecord {
+	return CopySlice(orig, CopySettlementRecord)
+}
+
+// CopyNAVRecord creates a copy of a NAV record.
+func CopyNAVRecord(orig exchange.NAVRecord) exchange.NAVRecord {
+	return exchange.NAVRecord{
+		Assets:   CopyCoin(orig.Assets),
+		Price:    CopyCoin(orig.Price),
+		Height:   orig.Height,
+		MarketId: orig.MarketId,
+	}
+}
+
+// CopyNAVRecords creates a copy of a slice of NAV records.
+func CopyNAVRecords(orig []exchange.NAVRecord) []exchange.NAVRecord {
+	return CopySlice(orig, CopyNAVRecord)
+}
+
+// CopyMsgGovManageFeesRequest creates a copy of a MsgGovManageFeesRequest.
+func CopyMsgGovManageFeesRequest(orig exchange.MsgGovManageFeesRequest) exchange.MsgGovManageFeesRequest {
+	return exchange.MsgGovManageFeesRequest{
+		Authority:                             orig.Authority,
+		MarketId:                              orig.MarketId,
+		AddFeeCreateAskFlat:                   CopyCoins(orig.AddFeeCreateAskFlat),
+		RemoveFeeCreateAskFlat:                CopyCoins(orig.RemoveFeeCreateAskFlat),
+		AddFeeCreateBidFlat:                   CopyCoins(orig.AddFeeCreateBidFlat),
+		RemoveFeeCreateBidFlat:                CopyCoins(orig.RemoveFeeCreateBidFlat),
+		AddFeeSellerSettlementFlat:            CopyCoins(orig.AddFeeSellerSettlementFlat),
+		RemoveFeeSellerSettlementFlat:         CopyCoins(orig.RemoveFeeSellerSettlementFlat),
+		AddFeeSellerSettlementRatios:          CopyRatios(orig.AddFeeSellerSettlementRatios),
+		RemoveFeeSellerSettlementRatios:       CopyRatios(orig.RemoveFeeSellerSettlementRatios),
+		AddFeeBuyerSettlementFlat:             CopyCoins(orig.AddFeeBuyerSettlementFlat),
+		RemoveFeeBuyerSettlementFlat:          CopyCoins(orig.RemoveFeeBuyerSettlementFlat),
+		AddFeeBuyerSettlementRatios:           CopyRatios(orig.AddFeeBuyerSettlementRatios),
+		RemoveFeeBuyerSettlementRatios:        CopyRatios(orig.RemoveFeeBuyerSettlementRatios),
+		AddFeeCreateCommitmentFlat:            CopyCoins(orig.AddFeeCreateCommitmentFlat),
+		RemoveFeeCreateCommitmentFlat:         CopyCoins(orig.RemoveFeeCreateCommitmentFlat),
+		SetFeeCommitmentSettlementBips:        orig.SetFeeCommitmentSettlementBips,
+		UnsetFeeCommitmentSettlementBips:      orig.UnsetFeeCommitmentSettlementBips,
+		EffectiveHeight:                       orig.EffectiveHeight,
+		EffectiveTime:                         CopyTimeP(orig.EffectiveTime),
+		AddFeeSellerSettlementTakerRatios:     CopyRatios(orig.AddFeeSellerSettlementTakerRatios),
+		RemoveFeeSellerSettlementTakerRatios:  CopyRatios(orig.RemoveFeeSellerSettlementTakerRatios),
+		AddFeeSellerSettlementRebateRatios:    CopyRatios(orig.AddFeeSellerSettlementRebateRatios),
+		RemoveFeeSellerSettlementRebateRatios: CopyRatios(orig.RemoveFeeSellerSettlementRebateRatios),
+		AddRebateAddresses:                    CopyStrings(orig.AddRebateAddresses),
+		RemoveRebateAddresses:                 CopyStrings(orig.RemoveRebateAddresses),
+		SetReferralBips:                       orig.SetReferralBips,
+		UnsetReferralBips:                     orig.UnsetReferralBips,
+		AddReferralCap:                        CopyCoins(orig.AddReferralCap),
+		RemoveReferralCap:                     CopyCoins(orig.RemoveReferralCap),
+	}
+}
+
+// CopyMsgGovManageFeesRequests creates a copy of a slice of MsgGovManageFeesRequests.
+func CopyMsgGovManageFeesRequests(orig []exchange.MsgGovManageFeesRequest) []exchange.MsgGovManageFeesRequest {
+	return CopySlice(orig, CopyMsgGovManageFeesRequest)
+}
+
+// CopyMarketAuthorityTransfer creates a copy of a MarketAuthorityTransfer.
+func CopyMarketAuthorityTransfer(orig exchange.MarketAuthorityTransfer) exchange.MarketAuthorityTransfer {
+	return exchange.MarketAuthorityTransfer{
+		MarketId:     orig.MarketId,
+		ProposedBy:   orig.ProposedBy,
+		NewAuthority: orig.NewAuthority,
+		AccessGrants: CopyAccessGrants(orig.AccessGrants),
+	}
+}
+
+// CopyMarketAuthorityTransfers creates a copy of a slice of MarketAuthorityTransfers.
+func CopyMarketAuthorityTransfers(orig []exchange.MarketAuthorityTransfer) []exchange.MarketAuthorityTransfer {
+	return CopySlice(orig, CopyMarketAuthorityTransfer)
+}
+
+// CopyIdempotencyKeyRecord creates a copy of an IdempotencyKeyRecord.
+func CopyIdempotencyKeyRecord(orig exchange.IdempotencyKeyRecord) exchange.IdempotencyKeyRecord {
+	return exchange.IdempotencyKeyRecord{
+		Account:        orig.Account,
+		IdempotencyKey: orig.IdempotencyKey,
+		OrderId:        orig.OrderId,
+		Height:         orig.Height,
+	}
+}
+
+// CopyIdempotencyKeyRecords creates a copy of a slice of IdempotencyKeyRecords.
+func CopyIdempotencyKeyRecords(orig []exchange.IdempotencyKeyRecord) []exchange.IdempotencyKeyRecord {
+	return CopySlice(orig, CopyIdempotencyKeyRecord)
+}
+
+// CopyAccountAmount creates a copy of an AccountAmount.
+func CopyAccountAmount(orig exchange.AccountAmount) exchange.AccountAmount {
+	return exchange.AccountAmount{
+		Account: orig.Account,
+		Amount:  CopyCoins(orig.Amount),
+	}
+}
+
+// CopyAccountAmounts creates a copy of a slice of AccountAmounts.
+func CopyAccountAmounts(orig []exchange.AccountAmount) []exchange.AccountAmount {
+	return CopySlice(orig, CopyAccountAmount)
+}
+
+// CopyNetAssetPrice creates a copy of a NetAssetPrice.
+func CopyNetAssetPrice(orig exchange.NetAssetPrice) exchange.NetAssetPrice {
+	return exchange.NetAssetPrice{
+		Assets: CopyCoin(orig.Assets),
+		Price:  CopyCoin(orig.Price),
+	}
+}
+
+// CopyNetAssetPrices creates a copy of a slice of NetAssetPrices.
+func CopyNetAssetPrices(orig []exchange.NetAssetPrice) []exchange.NetAssetPrice {
+	return CopySlice(orig, CopyNetAssetPrice)
+}
+
+// CopySettlementTransfer creates a copy of a SettlementTransfer.
+func CopySettlementTransfer(orig exchange.SettlementTransfer) exchange.SettlementTransfer {
+	return exchange.SettlementTransfer{
+		Inputs:  CopyAccountAmounts(orig.Inputs),
+		Outputs: CopyAccountAmounts(orig.Outputs),
+	}
+}
+
+// CopySettlementTransfers creates a copy of a slice of SettlementTransfers.
+func CopySettlementTransfers(orig []exchange.SettlementTransfer) []exchange.SettlementTransfer {
+	return CopySlice(orig, CopySettlementTransfer)
+}
+
+// CopySettlementReceipt creates a copy of a SettlementReceipt.
+func CopySettlementReceipt(orig exchange.SettlementReceipt) exchange.SettlementReceipt {
+	return exchange.SettlementReceipt{
+		SettlementId: orig.SettlementId,
+		MarketId:     orig.MarketId,
+		Height:       orig.Height,
+		Time:         orig.Time,
+		Fills:        CopySettlementFills(orig.Fills),
+		Transfers:    CopySettlementTransfers(orig.Transfers),
+		FeeInputs:    CopyAccountAmounts(orig.FeeInputs),
+		Navs:         CopyNetAssetPrices(orig.Navs),
+	}
+}
+
+// CopySettlementReceipts creates a copy of a slice of SettlementReceipts.
+func CopySettlementReceipts(orig []exchange.SettlementReceipt) []exchange.SettlementReceipt {
+	return CopySlice(orig, CopySettlementReceipt)
+}
+
+// CopyDeadManSwitch creates a copy of a DeadManSwitch.
+func CopyDeadManSwitch(orig exchange.DeadManSwitch) exchange.DeadManSwitch {
+	return exchange.DeadManSwitch{
+		Account:         orig.Account,
+		IntervalSeconds: orig.IntervalSeconds,
+		Deadline:        orig.Deadline,
+	}
+}
+
+// CopyDeadManSwitches creates a copy of a slice of DeadManSwitches.
+func CopyDeadManSwitches(orig []exchange.DeadManSwitch) []exchange.DeadManSwitch {
+	return CopySlice(orig, CopyDeadManSwitch)
+}
+
+// CopyAskOrder creates a copy of an AskOrder.
+func CopyAskOrder(orig *exchange.AskOrder) *exchange.AskOrder {
+	if orig == nil {
+		return nil
+	}
+	return &exchange.AskOrder{
+		MarketId:                orig.MarketId,
+		Seller:                  orig.Seller,
+		Assets:                  CopyCoin(orig.Assets),
+		Price:                   CopyCoin(orig.Price),
+		SellerSettlementFlatFee: CopyCoinP(orig.SellerSettlementFlatFee),
+		AllowPartial:            orig.AllowPartial,
+		ExternalId:              orig.ExternalId,
+		Expiration:              CopyTimeP(orig.Expiration),
+		DisplayAssets:           CopyCoinP(orig.DisplayAssets),
+		Referrer:                orig.Referrer,
+		BasketAssets:            CopyCoins(orig.BasketAssets),
+	}
+}
+
+// CopyBidOrder creates a copy of a BidOrder.
+func CopyBidOrder(orig *exchange.BidOrder) *exchange.BidOrder {
+	if orig == nil {
+		return nil
+	}
+	return &exchange.BidOrder{
+		MarketId:            orig.MarketId,
+		Buyer:               orig.Buyer,
+		Assets:              CopyCoin(orig.Assets),
+		Price:               CopyCoin(orig.Price),
+		BuyerSettlementFees: CopyCoins(orig.BuyerSettlementFees),
+		AllowPartial:        orig.AllowPartial,
+		ExternalId:          orig.ExternalId,
+		Expiration:          CopyTimeP(orig.Expiration),
+		DisplayAssets:       CopyCoinP(orig.DisplayAssets),
+		Referrer:            orig.Referrer,
+		BasketAssets:        CopyCoins(orig.BasketAssets),
+	}
+}
+
+// CopyCommitment creates a copy of a commitment.
+func CopyCommitment(orig exchange.Commitment) exchange.Commitment {
+	return exchange.Commitment{
+		Account:    orig.Account,
+		MarketId:   orig.MarketId,
+		Amount:     CopyCoins(orig.Amount),
+		Expiration: CopyTimeP(orig.Expiration),
+	}
+}
+
+// CopyCommitments creates a copy of a slice of commitments.
+func CopyCommitments(orig []exchange.Commitment) []exchange.Commitment {
+	return CopySlice(orig, CopyCommitment)
+}
+
+// CopyMarketAmount creates a copy of a market amount.
+func CopyMarketAmount(orig exchange.MarketAmount) exchange.MarketAmount {
+	return exchange.MarketAmount{
+		MarketId: orig.MarketId,
+		Amount:   CopyCoins(orig.Amount),
+	}
+}
+
+// CopyMarketAmounts creates a copy of a slice of market amounts.
+func CopyMarketAmounts(orig []exchange.MarketAmount) []exchange.MarketAmount {
+	return CopySlice(orig, CopyMarketAmount)
+}
+
+// CopyCommitmentReward creates a copy of a commitment reward.
+func CopyCommitmentReward(orig exchange.CommitmentReward) exchange.CommitmentReward {
+	return exchange.CommitmentReward{
+		Account:  orig.Account,
+		MarketId: orig.MarketId,
+		Amount:   CopyCoins(orig.Amount),
+	}
+}
+
+// CopyCommitmentRewards creates a copy of a slice of commitment rewards.
+func CopyCommitmentRewards(orig []exchange.CommitmentReward) []exchange.CommitmentReward {
+	return CopySlice(orig, CopyCommitmentReward)
+}
+
+// CopyCommitmentAccrual creates a copy of a commitment accrual.
+func CopyCommitmentAccrual(orig exchange.CommitmentAccrual) exchange.CommitmentAccrual {
+	return exchange.CommitmentAccrual{
+		Account:     orig.Account,
+		MarketId:    orig.MarketId,
+		Accrued:     CopyCoins(orig.Accrued),
+		LastAccrued: orig.LastAccrued,
+	}
+}
+
+// CopyCommitmentAccruals creates a copy of a slice of commitment accruals.
+func CopyCommitmentAccruals(orig []exchange.CommitmentAccrual) []exchange.CommitmentAccrual {
+	return CopySlice(orig, CopyCommitmentAccrual)
+}
+
+// CopyPayment creates a copy of a payment.
+func CopyPayment(orig exchange.Payment) exchange.Payment {
+	return exchange.Payment{
+		Source:       orig.Source,
+		SourceAmount: CopyCoins(orig.SourceAmount),
+		Target:       orig.Target,
+		TargetAmount: CopyCoins(orig.TargetAmount),
+		ExternalId:   orig.ExternalId,
+		Expiration:   CopyTimeP(orig.Expiration),
+
+		DisputeWindowSeconds: orig.DisputeWindowSeconds,
+	}
+}
+
+// CopyPayments creates a copy of a slice of payments.
+func CopyPayments(orig []exchange.Payment) []exchange.Payment {
+	return CopySlice(orig, CopyPayment)
+}
+
+// CopyEscrowedPayment creates a copy of an escrowed payment.
+func CopyEscrowedPayment(orig exchange.EscrowedPayment) exchange.EscrowedPayment {
+	return exchange.EscrowedPayment{
+		Payment:     CopyPayment(orig.Payment),
+		ReleaseTime: orig.ReleaseTime,
+	}
+}
+
+// CopyEscrowedPayments creates a copy of a slice of escrowed payments.
+func CopyEscrowedPayments(orig []exchange.EscrowedPayment) []exchange.EscrowedPayment {
+	return CopySlice(orig, CopyEscrowedPayment)
+}
+
+// CopyRecurringPayment creates a copy of a recurring payment.
+func CopyRecurringPayment(orig exchange.RecurringPayment) exchange.RecurringPayment {
+	return exchange.RecurringPayment{
+		Source:             orig.Source,
+		Target:             orig.Target,
+		Amount:             CopyCoins(orig.Amount),
+		ExternalId:         orig.ExternalId,
+		IntervalSeconds:    orig.IntervalSeconds,
+		TransfersRemaining: orig.TransfersRemaining,
+		NextTransferTime:   orig.NextTransferTime,
+		Failures:           orig.Failures,
+	}
+}
+
+// CopyRecurringPayments creates a copy of a slice of recurring payments.
+func CopyRecurringPayments(orig []exchange.RecurringPayment) []exchange.RecurringPayment {
+	return CopySlice(orig, CopyRecurringPayment)
+}
+
+// CopyPaymentParty creates a copy of a PaymentParty.
+func CopyPaymentParty(orig exchange.PaymentParty) exchange.PaymentParty {
+	return exchange.PaymentParty{
+		Address:       orig.Address,
+		SendAmount:    CopyCoins(orig.SendAmount),
+		ReceiveAmount: CopyCoins(orig.ReceiveAmount),
+		Accepted:      orig.Accepted,
+	}
+}
+
+// CopyMultiPartyPayment creates a copy of a MultiPartyPayment.
+func CopyMultiPartyPayment(orig exchange.MultiPartyPayment) exchange.MultiPartyPayment {
+	return exchange.MultiPartyPayment{
+		Creator:    orig.Creator,
+		ExternalId: orig.ExternalId,
+		Parties:    CopySlice(orig.Parties, CopyPaymentParty),
+	}
+}
+
+// CopyMultiPartyPayments creates a copy of a slice of multi-party payments.
+func CopyMultiPartyPayments(orig []exchange.MultiPartyPayment) []exchange.MultiPartyPayment {
+	return CopySlice(orig, CopyMultiPartyPayment)
+}
+
+// CopyDenomSplit creates a copy of a DenomSplit.
+func CopyDenomSplit(orig exchange.DenomSplit) exchange.DenomSplit {
+	return exchange.DenomSplit{
+		Denom: orig.Denom,
+		Split: orig.Split,
+	}
+}
+
+// CopyDenomSplits creates a copy of a slice of DenomSplits.
+func CopyDenomSplits(orig []exchange.DenomSplit) []exchange.DenomSplit {
+	return CopySlice(orig, CopyDenomSplit)
+}
+
+// CopyParams creates a copy of exchange Params.
+func CopyParams(orig *exchange.Params) *exchange.Params {
+	if orig == nil {
+		return nil
+	}
+	return &exchange.Params{
+		DefaultSplit:         orig.DefaultSplit,
+		DenomSplits:          CopyDenomSplits(orig.DenomSplits),
+		FeeCreatePaymentFlat: CopyCoins(orig.FeeCreatePaymentFlat),
+		FeeAcceptPaymentFlat: CopyCoins(orig.FeeAcceptPaymentFlat),
+
+		SettlementHistoryBlocks: orig.SettlementHistoryBlocks,
+		NavHistoryBlocks:        orig.NavHistoryBlocks,
+		NavHistoryMaxRecords:    orig.NavHistoryMaxRecords,
+	}
+}
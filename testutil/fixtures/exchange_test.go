@@ -0,0 +1,203 @@
+package fixtures
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+func TestCopyMarket(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________")
+	orig := NewMarket(3, "Three", admin)
+	orig.FeeCreateAskFlat = Coins("10nhash")
+	orig.FeeSellerSettlementRatios = Ratios("100nhash:1nhash,50apple:1apple")
+	orig.ReqAttrCreateBid = []string{"kyc.pb"}
+	orig.ExchangeSplits = []exchange.DenomSplit{{Denom: "nhash", Split: 250}}
+
+	cp := CopyMarket(orig)
+	require.Equal(t, orig, cp, "CopyMarket result")
+
+	cp.FeeCreateAskFlat[0].Amount = sdkmath.NewInt(99)
+	cp.FeeSellerSettlementRatios[1].Fee.Denom = "plum"
+	cp.AccessGrants[0].Permissions[0] = exchange.Permission_unspecified
+	cp.ReqAttrCreateBid[0] = "changed"
+	cp.ExchangeSplits[0].Split = 1
+
+	assert.Equal(t, "10nhash", sdk.Coins(orig.FeeCreateAskFlat).String(), "orig.FeeCreateAskFlat")
+	assert.Equal(t, "apple", orig.FeeSellerSettlementRatios[1].Fee.Denom, "orig.FeeSellerSettlementRatios[1].Fee.Denom")
+	assert.Equal(t, exchange.AllPermissions(), orig.AccessGrants[0].Permissions, "orig.AccessGrants[0].Permissions")
+	assert.Equal(t, []string{"kyc.pb"}, orig.ReqAttrCreateBid, "orig.ReqAttrCreateBid")
+	assert.Equal(t, uint32(250), orig.ExchangeSplits[0].Split, "orig.ExchangeSplits[0].Split")
+}
+
+func TestCopyParams(t *testing.T) {
+	assert.Nil(t, CopyParams(nil), "CopyParams(nil)")
+
+	orig := &exchange.Params{
+		DefaultSplit:            500,
+		DenomSplits:             []exchange.DenomSplit{{Denom: "nhash", Split: 250}},
+		FeeCreatePaymentFlat:    Coins("10nhash"),
+		FeeAcceptPaymentFlat:    Coins("8nhash"),
+		SettlementHistoryBlocks: 100,
+		NavHistoryBlocks:        200,
+		NavHistoryMaxRecords:    30,
+	}
+
+	cp := CopyParams(orig)
+	require.Equal(t, orig, cp, "CopyParams result")
+
+	cp.DenomSplits[0].Split = 1
+	cp.FeeCreatePaymentFlat[0].Amount = sdkmath.NewInt(99)
+	cp.FeeAcceptPaymentFlat[0].Denom = "plum"
+
+	assert.Equal(t, uint32(250), orig.DenomSplits[0].Split, "orig.DenomSplits[0].Split")
+	assert.Equal(t, "10nhash", sdk.Coins(orig.FeeCreatePaymentFlat).String(), "orig.FeeCreatePaymentFlat")
+	assert.Equal(t, "8nhash", sdk.Coins(orig.FeeAcceptPaymentFlat).String(), "orig.FeeAcceptPaymentFlat")
+}
+
+func TestNewMarket(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________")
+	market := NewMarket(7, "Seven", admin)
+	assert.NoError(t, market.Validate(), "market.Validate()")
+	assert.Equal(t, uint32(7), market.MarketId, "MarketId")
+	assert.Equal(t, "Seven", market.MarketDetails.Name, "MarketDetails.Name")
+	assert.True(t, market.AcceptingOrders, "AcceptingOrders")
+	assert.True(t, market.AcceptingCommitments, "AcceptingCommitments")
+	assert.Equal(t, []exchange.AccessGrant{AgCanEverything(admin)}, market.AccessGrants, "AccessGrants")
+}
+
+func TestOrderBuilders(t *testing.T) {
+	seller := sdk.AccAddress("seller______________")
+	buyer := sdk.AccAddress("buyer_______________")
+
+	ask := NewAskOrder(1, 3, seller, "10apple", "5plum")
+	require.True(t, ask.IsAskOrder(), "ask.IsAskOrder()")
+	assert.NoError(t, ask.Validate(), "ask.Validate()")
+	assert.Equal(t, seller.String(), ask.GetOwner(), "ask.GetOwner()")
+
+	bid := NewBidOrder(2, 3, buyer, "10apple", "5plum")
+	require.True(t, bid.IsBidOrder(), "bid.IsBidOrder()")
+	assert.NoError(t, bid.Validate(), "bid.Validate()")
+	assert.Equal(t, buyer.String(), bid.GetOwner(), "bid.GetOwner()")
+
+	cp := CopyOrder(*ask)
+	assert.Equal(t, *ask, cp, "CopyOrder(ask)")
+	cp.GetAskOrder().Assets.Denom = "pear"
+	assert.Equal(t, "apple", ask.GetAskOrder().Assets.Denom, "ask assets denom after changing copy")
+
+	expiration := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	ask.GetAskOrder().Expiration = &expiration
+	bid.GetBidOrder().Expiration = &expiration
+	askCp := CopyAskOrder(ask.GetAskOrder())
+	assert.Equal(t, ask.GetAskOrder(), askCp, "CopyAskOrder with expiration")
+	bidCp := CopyBidOrder(bid.GetBidOrder())
+	assert.Equal(t, bid.GetBidOrder(), bidCp, "CopyBidOrder with expiration")
+	*askCp.Expiration = askCp.Expiration.Add(time.Hour)
+	*bidCp.Expiration = bidCp.Expiration.Add(time.Hour)
+	assert.Equal(t, expiration, *ask.GetAskOrder().Expiration, "ask expiration after changing copy")
+	assert.Equal(t, expiration, *bid.GetBidOrder().Expiration, "bid expiration after changing copy")
+
+	ask.GetAskOrder().BasketAssets = Coins("3pear")
+	bid.GetBidOrder().BasketAssets = Coins("4pear")
+	askCp = CopyAskOrder(ask.GetAskOrder())
+	assert.Equal(t, ask.GetAskOrder(), askCp, "CopyAskOrder with basket assets")
+	bidCp = CopyBidOrder(bid.GetBidOrder())
+	assert.Equal(t, bid.GetBidOrder(), bidCp, "CopyBidOrder with basket assets")
+	askCp.BasketAssets[0].Denom = "plum"
+	bidCp.BasketAssets[0].Denom = "plum"
+	assert.Equal(t, "3pear", ask.GetAskOrder().BasketAssets.String(), "ask basket assets after changing copy")
+	assert.Equal(t, "4pear", bid.GetBidOrder().BasketAssets.String(), "bid basket assets after changing copy")
+}
+
+func TestCommitmentAndPaymentBuilders(t *testing.T) {
+	source := sdk.AccAddress("source______________")
+	target := sdk.AccAddress("target______________")
+
+	com := NewCommitment(source, 4, "10apple,5plum")
+	assert.NoError(t, com.Validate(), "com.Validate()")
+	assert.Equal(t, com, CopyCommitment(com), "CopyCommitment")
+	expiration := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	com.Expiration = &expiration
+	comCp := CopyCommitment(com)
+	assert.Equal(t, com, comCp, "CopyCommitment with expiration")
+	assert.NotSame(t, com.Expiration, comCp.Expiration, "CopyCommitment expiration reference")
+
+	payment := NewPayment(source, "10apple", target, "5plum", "ext-id")
+	assert.NoError(t, payment.Validate(), "payment.Validate()")
+	assert.Equal(t, target.String(), payment.Target, "payment.Target")
+	assert.Equal(t, *payment, CopyPayment(*payment), "CopyPayment")
+	payment.Expiration = &expiration
+	paymentCp := CopyPayment(*payment)
+	assert.Equal(t, *payment, paymentCp, "CopyPayment with expiration")
+	assert.NotSame(t, payment.Expiration, paymentCp.Expiration, "CopyPayment expiration reference")
+	payment.DisputeWindowSeconds = 3600
+	assert.Equal(t, *payment, CopyPayment(*payment), "CopyPayment with dispute window")
+
+	ep := exchange.EscrowedPayment{Payment: *payment, ReleaseTime: expiration}
+	assert.NoError(t, ep.Validate(), "ep.Validate()")
+	epCp := CopyEscrowedPayment(ep)
+	assert.Equal(t, ep, epCp, "CopyEscrowedPayment")
+	assert.NotSame(t, ep.Payment.Expiration, epCp.Payment.Expiration, "CopyEscrowedPayment payment expiration reference")
+	assert.Equal(t, []exchange.EscrowedPayment{ep}, CopyEscrowedPayments([]exchange.EscrowedPayment{ep}), "CopyEscrowedPayments")
+
+	rp := exchange.RecurringPayment{
+		Source:             source.String(),
+		Target:             target.String(),
+		Amount:             sdk.NewCoins(sdk.NewInt64Coin("plum", 3)),
+		ExternalId:         "rent",
+		IntervalSeconds:    86400,
+		TransfersRemaining: 12,
+		NextTransferTime:   expiration,
+		Failures:           1,
+	}
+	assert.NoError(t, rp.Validate(), "rp.Validate()")
+	assert.Equal(t, rp, CopyRecurringPayment(rp), "CopyRecurringPayment")
+
+	mpp := exchange.MultiPartyPayment{
+		Creator:    source.String(),
+		ExternalId: "otc",
+		Parties: []exchange.PaymentParty{
+			{Address: source.String(), SendAmount: sdk.NewCoins(sdk.NewInt64Coin("plum", 3)), Accepted: true},
+			{Address: target.String(), ReceiveAmount: sdk.NewCoins(sdk.NewInt64Coin("plum", 3))},
+		},
+	}
+	assert.NoError(t, mpp.Validate(), "mpp.Validate()")
+	mppCp := CopyMultiPartyPayment(mpp)
+	assert.Equal(t, mpp, mppCp, "CopyMultiPartyPayment")
+	mppCp.Parties[0].Accepted = false
+	assert.True(t, mpp.Parties[0].Accepted, "original Parties[0].Accepted after changing copy")
+	assert.Equal(t, []exchange.MultiPartyPayment{mpp}, CopyMultiPartyPayments([]exchange.MultiPartyPayment{mpp}), "CopyMultiPartyPayments")
+
+	noTarget := NewPayment(source, "10apple", nil, "", "")
+	assert.Empty(t, noTarget.Target, "noTarget.Target")
+}
+
+func TestAccessGrantBuilders(t *testing.T) {
+	addr := sdk.AccAddress("addr________________")
+
+	only := AgCanOnly(addr, exchange.Permission_settle)
+	assert.Equal(t, []exchange.Permission{exchange.Permission_settle}, only.Permissions, "AgCanOnly permissions")
+
+	allBut := AgCanAllBut(addr, exchange.Permission_settle)
+	assert.Len(t, allBut.Permissions, len(exchange.AllPermissions())-1, "AgCanAllBut permissions")
+	assert.NotContains(t, allBut.Permissions, exchange.Permission_settle, "AgCanAllBut permissions")
+
+	everything := AgCanEverything(addr)
+	assert.Equal(t, exchange.AllPermissions(), everything.Permissions, "AgCanEverything permissions")
+	assert.Equal(t, addr.String(), everything.Address, "AgCanEverything address")
+
+	expiration := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	everything.Expiration = &expiration
+	cp := CopyAccessGrant(everything)
+	assert.Equal(t, everything, cp, "CopyAccessGrant with expiration")
+	*cp.Expiration = cp.Expiration.Add(time.Hour)
+	assert.Equal(t, expiration, *everything.Expiration, "expiration after changing copy")
+}
@@ -0,0 +1,87 @@
+// Package fixtures contains builders and copiers for provenance module objects that are handy in unit tests.
+//
+// None of these use a *testing.T; functions that can fail on bad input panic
+// (like the Must* functions in the SDK) since they are only meant to be used with hard-coded test values.
+package fixtures
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CopySlice returns a copy of a slice using the provided copier for each value.
+// A nil slice results in nil (as opposed to an empty slice).
+func CopySlice[T any](vals []T, copier func(T) T) []T {
+	if vals == nil {
+		return nil
+	}
+	rv := make([]T, len(vals))
+	for i, v := range vals {
+		rv[i] = copier(v)
+	}
+	return rv
+}
+
+// NoOpCopier is a passthrough "copier" function that just returns the exact same thing that was provided.
+func NoOpCopier[T any](val T) T {
+	return val
+}
+
+// Coin parses the provided string into a coin, panicking on error.
+func Coin(coin string) sdk.Coin {
+	rv, err := sdk.ParseCoinNormalized(coin)
+	if err != nil {
+		panic(fmt.Errorf("ParseCoinNormalized(%q): %w", coin, err))
+	}
+	return rv
+}
+
+// CoinP parses the provided string into a reference to a coin, panicking on error.
+func CoinP(coin string) *sdk.Coin {
+	rv := Coin(coin)
+	return &rv
+}
+
+// Coins parses the provided string into coins, panicking on error.
+func Coins(coins string) sdk.Coins {
+	rv, err := sdk.ParseCoinsNormalized(coins)
+	if err != nil {
+		panic(fmt.Errorf("ParseCoinsNormalized(%q): %w", coins, err))
+	}
+	return rv
+}
+
+// CopyCoin creates a copy of a coin (as best as possible).
+func CopyCoin(orig sdk.Coin) sdk.Coin {
+	return sdk.NewCoin(orig.Denom, orig.Amount.AddRaw(0))
+}
+
+// CopyCoinP copies a coin that's a reference.
+func CopyCoinP(orig *sdk.Coin) *sdk.Coin {
+	if orig == nil {
+		return nil
+	}
+	rv := CopyCoin(*orig)
+	return &rv
+}
+
+// CopyTimeP copies a time that's a reference.
+func CopyTimeP(orig *time.Time) *time.Time {
+	if orig == nil {
+		return nil
+	}
+	rv := *orig
+	return &rv
+}
+
+// CopyCoins creates a copy of coins (as best as possible).
+func CopyCoins(orig []sdk.Coin) []sdk.Coin {
+	return CopySlice(orig, CopyCoin)
+}
+
+// CopyStrings creates a copy of a slice of strings.
+func CopyStrings(orig []string) []string {
+	return CopySlice(orig, NoOpCopier[string])
+}
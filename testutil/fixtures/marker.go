@@ -0,0 +1,32 @@
+package fixtures
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+// MarkerAddr gets the address of a marker account for the given denom, panicking on error.
+func MarkerAddr(denom string) sdk.AccAddress {
+	rv, err := markertypes.MarkerAddress(denom)
+	if err != nil {
+		panic(fmt.Errorf("MarkerAddress(%q): %w", denom, err))
+	}
+	return rv
+}
+
+// NewMarkerAccount returns a new active restricted marker account with the given fixed supply (e.g. "1000apple").
+func NewMarkerAccount(supplyCoinStr string) *markertypes.MarkerAccount {
+	supply := Coin(supplyCoinStr)
+	return &markertypes.MarkerAccount{
+		BaseAccount: &authtypes.BaseAccount{Address: MarkerAddr(supply.Denom).String()},
+		Status:      markertypes.StatusActive,
+		Denom:       supply.Denom,
+		Supply:      supply.Amount,
+		MarkerType:  markertypes.MarkerType_RestrictedCoin,
+		SupplyFixed: true,
+	}
+}
@@ -0,0 +1,23 @@
+package fixtures
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// ScopeID creates a scope id from the provided base string (max 16 characters), panicking on error.
+// The base is padded with underscores to 16 bytes and used as the scope's uuid.
+func ScopeID(base string) metadatatypes.MetadataAddress {
+	if len(base) > 16 {
+		panic(fmt.Errorf("ScopeID(%q): arg can only be 16 chars max", base))
+	}
+	bz := []byte(base + "________________")[:16]
+	uid, err := uuid.FromBytes(bz)
+	if err != nil {
+		panic(fmt.Errorf("uuid.FromBytes(%q): %w", string(bz), err))
+	}
+	return metadatatypes.ScopeMetadataAddress(uid)
+}
@@ -0,0 +1,46 @@
+package fixtures
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// ExchangeNAVSource returns the source string that the exchange module uses when recording a NAV for a market.
+func ExchangeNAVSource(marketID uint32) string {
+	return fmt.Sprintf("x/exchange market %d", marketID)
+}
+
+// MarkerNAVSetEvent returns a new marker module EventSetNetAssetValue converted to sdk.Event.
+// The assetsStr is the volume and denom, e.g. "10apple", and the priceStr is a coin string, e.g. "5plum".
+func MarkerNAVSetEvent(assetsStr, priceStr, source string) sdk.Event {
+	assets := Coin(assetsStr)
+	return untypeEvent(&markertypes.EventSetNetAssetValue{
+		Denom:  assets.Denom,
+		Price:  priceStr,
+		Volume: assets.Amount.String(),
+		Source: source,
+	})
+}
+
+// MetadataNAVSetEvent returns a new metadata module EventSetNetAssetValue converted to sdk.Event.
+func MetadataNAVSetEvent(scopeID, priceStr, source string) sdk.Event {
+	return untypeEvent(&metadatatypes.EventSetNetAssetValue{
+		ScopeId: scopeID,
+		Price:   priceStr,
+		Source:  source,
+	})
+}
+
+// untypeEvent converts the provided typed event into an sdk.Event, panicking on error.
+func untypeEvent(tev proto.Message) sdk.Event {
+	rv, err := sdk.TypedEventToEvent(tev)
+	if err != nil {
+		panic(fmt.Errorf("TypedEventToEvent(%T): %w", tev, err))
+	}
+	return rv
+}
@@ -0,0 +1,47 @@
+package fixtures
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestNewMarkerAccount(t *testing.T) {
+	acct := NewMarkerAccount("1000apple")
+	assert.NoError(t, acct.Validate(), "acct.Validate()")
+	assert.Equal(t, MarkerAddr("apple"), acct.GetAddress(), "GetAddress()")
+	assert.Equal(t, "1000apple", acct.GetSupply().String(), "GetSupply()")
+	assert.Equal(t, markertypes.StatusActive, acct.GetStatus(), "GetStatus()")
+	assert.Equal(t, markertypes.MarkerType_RestrictedCoin, acct.GetMarkerType(), "GetMarkerType()")
+}
+
+func TestNAVSetEvents(t *testing.T) {
+	source := ExchangeNAVSource(3)
+	assert.Equal(t, "x/exchange market 3", source, "ExchangeNAVSource(3)")
+
+	event := MarkerNAVSetEvent("10apple", "5plum", source)
+	expMarker, err := sdk.TypedEventToEvent(&markertypes.EventSetNetAssetValue{
+		Denom:  "apple",
+		Price:  "5plum",
+		Volume: "10",
+		Source: source,
+	})
+	require.NoError(t, err, "TypedEventToEvent marker event")
+	assert.Equal(t, expMarker, event, "MarkerNAVSetEvent")
+
+	scopeID := ScopeID("navscope").String()
+	event = MetadataNAVSetEvent(scopeID, "5plum", source)
+	assert.Equal(t, "provenance.metadata.v1.EventSetNetAssetValue", event.Type, "MetadataNAVSetEvent type")
+}
+
+func TestScopeID(t *testing.T) {
+	id := ScopeID("myscope")
+	assert.True(t, id.IsScopeAddress(), "IsScopeAddress()")
+	assert.Equal(t, id, ScopeID("myscope"), "second ScopeID(\"myscope\")")
+	assert.Panics(t, func() { ScopeID("this is way too long") }, "ScopeID with a long base")
+}
@@ -0,0 +1,96 @@
+package testutil
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-metrics"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// EnableTelemetry turns on telemetry for the rest of the test, sending all metrics to the returned in-memory sink.
+// Telemetry is turned off again when the test is done.
+func EnableTelemetry(t *testing.T) *metrics.InmemSink {
+	t.Helper()
+	_, err := telemetry.New(telemetry.Config{Enabled: true})
+	require.NoError(t, err, "telemetry.New")
+
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	cfg := metrics.DefaultConfig("")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err = metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err, "metrics.NewGlobal")
+
+	t.Cleanup(func() {
+		_, _ = telemetry.New(telemetry.Config{Enabled: false})
+		_, _ = metrics.NewGlobal(metrics.DefaultConfig(""), &metrics.BlackholeSink{})
+	})
+	return sink
+}
+
+// GetCounterSum gets the total of the counter with the provided keys and labels (in the provided order).
+// Zero is returned if the counter has not been incremented.
+func GetCounterSum(sink *metrics.InmemSink, keys []string, labels ...metrics.Label) float64 {
+	return getSampledSum(sink, keys, labels, func(interval *metrics.IntervalMetrics) map[string]metrics.SampledValue {
+		return interval.Counters
+	})
+}
+
+// GetSampleSum gets the total of the samples (e.g. from telemetry.MeasureSince) with the provided keys and labels.
+// Zero is returned if there are no such samples.
+func GetSampleSum(sink *metrics.InmemSink, keys []string, labels ...metrics.Label) float64 {
+	return getSampledSum(sink, keys, labels, func(interval *metrics.IntervalMetrics) map[string]metrics.SampledValue {
+		return interval.Samples
+	})
+}
+
+// GetGaugeValue gets the most recent value of the gauge with the provided keys and labels (in the provided order).
+// The second return value is false if the gauge has not been set.
+func GetGaugeValue(sink *metrics.InmemSink, keys []string, labels ...metrics.Label) (float32, bool) {
+	name := strings.Join(keys, ".")
+	var rv float32
+	var found bool
+	for _, interval := range sink.Data() {
+		interval.RLock()
+		for _, gauge := range interval.Gauges {
+			if gauge.Name == name && labelsEqual(gauge.Labels, labels) {
+				rv, found = gauge.Value, true
+			}
+		}
+		interval.RUnlock()
+	}
+	return rv, found
+}
+
+// getSampledSum gets the sum of all the sampled values with the provided keys and labels in each interval.
+func getSampledSum(sink *metrics.InmemSink, keys []string, labels []metrics.Label, getter func(interval *metrics.IntervalMetrics) map[string]metrics.SampledValue) float64 {
+	name := strings.Join(keys, ".")
+	var rv float64
+	for _, interval := range sink.Data() {
+		interval.RLock()
+		for _, value := range getter(interval) {
+			if value.Name == name && labelsEqual(value.Labels, labels) {
+				rv += value.Sum
+			}
+		}
+		interval.RUnlock()
+	}
+	return rv
+}
+
+// labelsEqual returns true if both provided label slices have the same entries in the same order.
+func labelsEqual(a, b []metrics.Label) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
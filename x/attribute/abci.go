@@ -3,6 +3,7 @@ package attribute
 import (
 	"strconv"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/x/attribute/keeper"
@@ -13,8 +14,11 @@ const MaxExpiredAttributionCount = 100_000
 
 // BeginBlocker is called at the beginning of every block
 func BeginBlocker(ctx sdk.Context, keeper keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, telemetry.Now(), telemetry.MetricKeyBeginBlocker)
+
 	deleted := keeper.DeleteExpiredAttributes(ctx, MaxExpiredAttributionCount)
 	if deleted > 0 {
+		telemetry.IncrCounter(float32(deleted), types.ModuleName, types.EventTelemetryKeyExpired)
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				"beginblock",
@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simapp "github.com/provenance-io/provenance/app"
+	provtestutil "github.com/provenance-io/provenance/testutil"
 	"github.com/provenance-io/provenance/x/attribute"
 	"github.com/provenance-io/provenance/x/attribute/types"
 )
@@ -45,6 +46,7 @@ func TestBeginBlockDeletionOfExpired(t *testing.T) {
 	attr2.ExpirationDate = &past
 	require.NoError(t, app.AttributeKeeper.SetAttribute(ctx, attr2, user1Addr))
 
+	sink := provtestutil.EnableTelemetry(t)
 	ctx = ctx.WithBlockTime(now)
 	ctx = ctx.WithEventManager(sdk.NewEventManager())
 	attribute.BeginBlocker(ctx, app.AttributeKeeper)
@@ -58,4 +60,6 @@ func TestBeginBlockDeletionOfExpired(t *testing.T) {
 	assert.Equal(t, types.AttributeKeyTotalExpired, events[2].Attributes[2].Key)
 	assert.Equal(t, "2", events[2].Attributes[2].Value)
 
+	expired := provtestutil.GetCounterSum(sink, []string{types.ModuleName, types.EventTelemetryKeyExpired})
+	assert.Equal(t, float64(2), expired, "expired attributes counter")
 }
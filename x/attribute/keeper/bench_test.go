@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/attribute/types"
+)
+
+// BenchmarkAttributeLookup measures the attribute lookups used for required-attribute checks
+// (e.g. by the marker send restriction) for accounts with different numbers of attributes.
+// Run with: go test -run='^$' -bench=BenchmarkAttributeLookup -benchmem ./x/attribute/keeper
+func BenchmarkAttributeLookup(b *testing.B) {
+	app := simapp.Setup(b)
+	ctx := app.BaseApp.NewContext(false)
+
+	owner := sdk.AccAddress("owner_address_______")
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, owner))
+	const reqAttr = "required.kyc.pb"
+	require.NoError(b, app.NameKeeper.SetNameRecord(ctx, reqAttr, owner, false), "SetNameRecord %s", reqAttr)
+
+	for _, numOther := range []int{0, 10, 100} {
+		addr := sdk.AccAddress(fmt.Sprintf("bench_addr_%09d", numOther))
+		setAttr := func(name string) {
+			attr := types.NewAttribute(name, addr.String(), types.AttributeType_String, []byte("value"), nil)
+			require.NoError(b, app.AttributeKeeper.SetAttribute(ctx, attr, owner), "SetAttribute %s", name)
+		}
+		for i := 0; i < numOther; i++ {
+			name := fmt.Sprintf("other%d.kyc.pb", i)
+			if !app.NameKeeper.NameExists(ctx, name) {
+				require.NoError(b, app.NameKeeper.SetNameRecord(ctx, name, owner, false), "SetNameRecord %s", name)
+			}
+			setAttr(name)
+		}
+		setAttr(reqAttr)
+
+		b.Run(fmt.Sprintf("GetAllAttributesAddr with %d other attributes", numOther), func(b *testing.B) {
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_, _ = app.AttributeKeeper.GetAllAttributesAddr(ctx, addr)
+			}
+		})
+
+		b.Run(fmt.Sprintf("GetAttributes with %d other attributes", numOther), func(b *testing.B) {
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_, _ = app.AttributeKeeper.GetAttributes(ctx, addr.String(), reqAttr)
+			}
+		})
+	}
+}
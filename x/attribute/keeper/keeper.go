@@ -346,7 +346,7 @@ func (k Keeper) UpdateAttributeExpiration(ctx sdk.Context, updateAttribute types
 		}
 	} else {
 		errorMessage := "no attributes updated"
-		ctx.Logger().Error(errorMessage, "name", updateAttribute.Name, "value", string(updateAttribute.Value))
+		k.Logger(ctx).Error(errorMessage, "name", updateAttribute.Name, "value", string(updateAttribute.Value))
 		return fmt.Errorf("%s with name %q : value %q : type: %s", errorMessage, updateAttribute.Name, string(updateAttribute.Value), updateAttribute.AttributeType.String())
 	}
 
@@ -553,11 +553,11 @@ func (k Keeper) DeleteExpiredAttributes(ctx sdk.Context, limit int) int {
 
 				deleteExpirationEvent := types.NewEventAttributeExpired(attribute)
 				if err = ctx.EventManager().EmitTypedEvent(deleteExpirationEvent); err != nil {
-					ctx.Logger().Error(fmt.Sprintf("failed to emit typed event %v", err))
+					k.Logger(ctx).Error("failed to emit typed event", "event", deleteExpirationEvent, "error", err)
 				}
 				count++
 			} else {
-				ctx.Logger().Error(fmt.Sprintf("unable to unmarshal attribute to delete key: %v error: %v", attrKey, err))
+				k.Logger(ctx).Error("unable to unmarshal attribute to delete", "key", attrKey, "error", err)
 			}
 		}
 
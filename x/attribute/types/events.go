@@ -33,6 +33,8 @@ const (
 	EventTelemetryKeyDelete string = "delete"
 	// EventTelemetryKeyDistinctDelete delete telemetry metrics key
 	EventTelemetryKeyDistinctDelete string = "distinct_delete"
+	// EventTelemetryKeyExpired expired telemetry metrics key
+	EventTelemetryKeyExpired string = "expired"
 	// EventTelemetryLabelName name telemetry metrics label
 	EventTelemetryLabelName string = "name"
 	// EventTelemetryLabelName name telemetry metrics label
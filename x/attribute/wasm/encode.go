@@ -0,0 +1,92 @@
+// Package wasm supports smart contract integration with the provenance attribute module.
+package wasm
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/internal/provwasm"
+	"github.com/provenance-io/provenance/x/attribute/types"
+)
+
+// AttributeMsgParams are the params for attribute module messages sent from a smart contract.
+// Exactly one field must be set.
+type AttributeMsgParams struct {
+	// Add an attribute to an account.
+	AddAttribute *AddAttributeParams `json:"add_attribute,omitempty"`
+	// Delete attributes from an account.
+	DeleteAttributes *DeleteAttributesParams `json:"delete_attributes,omitempty"`
+}
+
+// AddAttributeParams are the params for adding an attribute to an account.
+// The contract must own the attribute name.
+type AddAttributeParams struct {
+	// Address is the bech32 address of the account to add the attribute to.
+	Address string `json:"address"`
+	// Name is the name of the attribute.
+	Name string `json:"name"`
+	// Value is the attribute value (base64 encoded in JSON).
+	Value []byte `json:"value"`
+	// ValueType is the type of the value, e.g. "string" or "json".
+	ValueType string `json:"value_type"`
+	// ExpirationDate is an optional time at which the attribute expires.
+	ExpirationDate *time.Time `json:"expiration_date,omitempty"`
+}
+
+// DeleteAttributesParams are the params for deleting all attributes with a name from an account.
+// The contract must own the attribute name.
+type DeleteAttributesParams struct {
+	// Address is the bech32 address of the account to delete the attributes from.
+	Address string `json:"address"`
+	// Name is the name of the attributes to delete.
+	Name string `json:"name"`
+}
+
+// Encoder returns a smart contract message encoder for the attribute module.
+func Encoder(contract sdk.AccAddress, msg json.RawMessage, _ string) ([]sdk.Msg, error) {
+	var params AttributeMsgParams
+	if err := json.Unmarshal(msg, &params); err != nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: fmt.Sprintf("invalid attribute msg params: %v", err), Request: msg}
+	}
+
+	var encoded sdk.Msg
+	switch {
+	case params.AddAttribute != nil && params.DeleteAttributes != nil:
+		return nil, wasmvmtypes.InvalidRequest{Err: "invalid attribute msg params: only one message can be provided", Request: msg}
+	case params.AddAttribute != nil:
+		attrType, err := types.AttributeTypeFromString(params.AddAttribute.ValueType)
+		if err != nil {
+			return nil, wasmvmtypes.InvalidRequest{Err: fmt.Sprintf("invalid attribute value type: %v", err), Request: msg}
+		}
+		encoded = &types.MsgAddAttributeRequest{
+			Name:           params.AddAttribute.Name,
+			Value:          params.AddAttribute.Value,
+			AttributeType:  attrType,
+			Account:        params.AddAttribute.Address,
+			Owner:          contract.String(),
+			ExpirationDate: params.AddAttribute.ExpirationDate,
+		}
+	case params.DeleteAttributes != nil:
+		encoded = &types.MsgDeleteAttributeRequest{
+			Name:    params.DeleteAttributes.Name,
+			Account: params.DeleteAttributes.Address,
+			Owner:   contract.String(),
+		}
+	default:
+		return nil, wasmvmtypes.InvalidRequest{Err: "invalid attribute msg params: no message provided", Request: msg}
+	}
+
+	if vb, ok := encoded.(interface{ ValidateBasic() error }); ok {
+		if err := vb.ValidateBasic(); err != nil {
+			return nil, wasmvmtypes.InvalidRequest{Err: fmt.Sprintf("invalid attribute msg: %v", err), Request: msg}
+		}
+	}
+	return []sdk.Msg{encoded}, nil
+}
+
+var _ provwasm.Encoder = Encoder
@@ -0,0 +1,82 @@
+package wasm
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+	"github.com/provenance-io/provenance/x/attribute/types"
+)
+
+func TestEncoder(t *testing.T) {
+	contract := sdk.AccAddress("contract____________")
+	addr := sdk.AccAddress("addr________________").String()
+	expiration := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		msg    string
+		exp    []sdk.Msg
+		expErr []string
+	}{
+		{
+			name: "add attribute",
+			msg:  `{"add_attribute":{"address":"` + addr + `","name":"test.contract.pb","value":"aGVsbG8=","value_type":"string","expiration_date":"2030-01-02T03:04:05Z"}}`,
+			exp: []sdk.Msg{&types.MsgAddAttributeRequest{
+				Name:           "test.contract.pb",
+				Value:          []byte("hello"),
+				AttributeType:  types.AttributeType_String,
+				Account:        addr,
+				Owner:          contract.String(),
+				ExpirationDate: &expiration,
+			}},
+		},
+		{
+			name: "delete attributes",
+			msg:  `{"delete_attributes":{"address":"` + addr + `","name":"test.contract.pb"}}`,
+			exp: []sdk.Msg{&types.MsgDeleteAttributeRequest{
+				Name:    "test.contract.pb",
+				Account: addr,
+				Owner:   contract.String(),
+			}},
+		},
+		{
+			name:   "bad value type",
+			msg:    `{"add_attribute":{"address":"` + addr + `","name":"test.contract.pb","value":"aGVsbG8=","value_type":"nope"}}`,
+			expErr: []string{"invalid attribute value type"},
+		},
+		{
+			name:   "bad address",
+			msg:    `{"delete_attributes":{"address":"bad","name":"test.contract.pb"}}`,
+			expErr: []string{"invalid attribute msg"},
+		},
+		{
+			name:   "empty",
+			msg:    `{}`,
+			expErr: []string{"no message provided"},
+		},
+		{
+			name:   "two messages",
+			msg:    `{"add_attribute":{},"delete_attributes":{}}`,
+			expErr: []string{"only one message can be provided"},
+		},
+		{
+			name:   "not json",
+			msg:    `[`,
+			expErr: []string{"invalid attribute msg params"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msgs, err := Encoder(contract, json.RawMessage(tc.msg), "")
+			assertions.AssertErrorContents(t, err, tc.expErr, "Encoder error")
+			assert.Equal(t, tc.exp, msgs, "Encoder msgs")
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package wasm
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/internal/provwasm"
+	"github.com/provenance-io/provenance/x/attribute/keeper"
+	"github.com/provenance-io/provenance/x/attribute/types"
+)
+
+const (
+	// GasCostGetAttributes is the fixed amount of gas consumed by a smart contract attribute query.
+	GasCostGetAttributes uint64 = 10_000
+	// GasCostPerAttribute is the fixed amount of gas consumed for each attribute returned by a smart contract attribute query.
+	GasCostPerAttribute uint64 = 1_000
+)
+
+// AttributeQueryParams are the params for attribute module queries sent from a smart contract.
+// Exactly one field must be set.
+type AttributeQueryParams struct {
+	// Get the attributes with a name on an account.
+	GetAttributes *GetAttributesParams `json:"get_attributes,omitempty"`
+	// Get all the attributes on an account.
+	GetAllAttributes *GetAllAttributesParams `json:"get_all_attributes,omitempty"`
+}
+
+// GetAttributesParams are the params for getting the attributes with a name on an account.
+type GetAttributesParams struct {
+	// Address is the bech32 address of the account.
+	Address string `json:"address"`
+	// Name is the name of the attributes to get.
+	Name string `json:"name"`
+}
+
+// GetAllAttributesParams are the params for getting all the attributes on an account.
+type GetAllAttributesParams struct {
+	// Address is the bech32 address of the account.
+	Address string `json:"address"`
+}
+
+// AttributeResponse is the attribute info returned to smart contracts.
+type AttributeResponse struct {
+	Address    string      `json:"address"`
+	Attributes []Attribute `json:"attributes"`
+}
+
+// Attribute is a single attribute returned to smart contracts.
+type Attribute struct {
+	Name           string     `json:"name"`
+	Value          []byte     `json:"value"`
+	ValueType      string     `json:"value_type"`
+	ExpirationDate *time.Time `json:"expiration_date,omitempty"`
+}
+
+// Querier returns a smart contract querier for the attribute module.
+func Querier(k keeper.Keeper) provwasm.Querier {
+	return func(ctx sdk.Context, query json.RawMessage, _ string) ([]byte, error) {
+		var params AttributeQueryParams
+		if err := json.Unmarshal(query, &params); err != nil {
+			return nil, wasmvmtypes.InvalidRequest{Err: fmt.Sprintf("invalid attribute query params: %v", err), Request: query}
+		}
+
+		provwasm.ConsumeGas(ctx, GasCostGetAttributes, "attribute query")
+
+		var addr string
+		var attrs []types.Attribute
+		var err error
+		switch {
+		case params.GetAttributes != nil && params.GetAllAttributes != nil:
+			return nil, wasmvmtypes.InvalidRequest{Err: "invalid attribute query params: only one query can be provided", Request: query}
+		case params.GetAttributes != nil:
+			addr = params.GetAttributes.Address
+			if _, err = sdk.AccAddressFromBech32(addr); err != nil {
+				return nil, wasmvmtypes.InvalidRequest{Err: fmt.Sprintf("invalid address: %v", err), Request: query}
+			}
+			attrs, err = k.GetAttributes(ctx, addr, params.GetAttributes.Name)
+		case params.GetAllAttributes != nil:
+			addr = params.GetAllAttributes.Address
+			if _, err = sdk.AccAddressFromBech32(addr); err != nil {
+				return nil, wasmvmtypes.InvalidRequest{Err: fmt.Sprintf("invalid address: %v", err), Request: query}
+			}
+			attrs, err = k.GetAllAttributes(ctx, addr)
+		default:
+			return nil, wasmvmtypes.InvalidRequest{Err: "invalid attribute query params: no query provided", Request: query}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not get attributes: %w", err)
+		}
+
+		provwasm.ConsumeGas(ctx, GasCostPerAttribute*uint64(len(attrs)), "attribute query results")
+		return json.Marshal(NewAttributeResponse(addr, attrs))
+	}
+}
+
+// NewAttributeResponse converts the provided attributes into the info returned to smart contracts.
+func NewAttributeResponse(addr string, attrs []types.Attribute) AttributeResponse {
+	rv := AttributeResponse{
+		Address:    addr,
+		Attributes: make([]Attribute, len(attrs)),
+	}
+	for i, attr := range attrs {
+		rv.Attributes[i] = Attribute{
+			Name:           attr.Name,
+			Value:          attr.Value,
+			ValueType:      attr.AttributeType.String(),
+			ExpirationDate: attr.ExpirationDate,
+		}
+	}
+	return rv
+}
@@ -0,0 +1,25 @@
+package exchange
+
+// AddOrder adds the displayed assets and price of the provided order to this summary.
+// The order should be in this summary's market.
+func (s *MarketOrdersSummary) AddOrder(order *Order) {
+	shown := order.WithoutHiddenAssets()
+	assets := shown.GetAssets()
+	price := shown.GetPrice()
+	if order.IsAskOrder() {
+		s.AskOrderCount++
+		s.AskAssets = s.AskAssets.Add(assets)
+		s.AskPrice = s.AskPrice.Add(price)
+		return
+	}
+	s.BidOrderCount++
+	s.BidAssets = s.BidAssets.Add(assets)
+	s.BidPrice = s.BidPrice.Add(price)
+}
+
+// AddPayment adds the source and target amounts of the provided payment to this summary.
+func (s *PaymentsSummary) AddPayment(payment *Payment) {
+	s.PaymentCount++
+	s.SourceAmount = s.SourceAmount.Add(payment.SourceAmount...)
+	s.TargetAmount = s.TargetAmount.Add(payment.TargetAmount...)
+}
@@ -0,0 +1,79 @@
+package exchange
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMarketOrdersSummary_AddOrder(t *testing.T) {
+	summary := &MarketOrdersSummary{MarketId: 3}
+	orders := []*Order{
+		NewOrder(1).WithAsk(&AskOrder{
+			MarketId: 3,
+			Assets:   sdk.NewInt64Coin("apple", 10),
+			Price:    sdk.NewInt64Coin("plum", 50),
+		}),
+		NewOrder(2).WithBid(&BidOrder{
+			MarketId:            3,
+			Assets:              sdk.NewInt64Coin("apple", 4),
+			Price:               sdk.NewInt64Coin("plum", 20),
+			BuyerSettlementFees: sdk.NewCoins(sdk.NewInt64Coin("plum", 1)),
+		}),
+		NewOrder(3).WithAsk(&AskOrder{
+			MarketId:      3,
+			Assets:        sdk.NewInt64Coin("apple", 8),
+			Price:         sdk.NewInt64Coin("plum", 40),
+			DisplayAssets: &sdk.Coin{Denom: "apple", Amount: sdkmath.NewInt(2)},
+		}),
+		NewOrder(4).WithBid(&BidOrder{
+			MarketId: 3,
+			Assets:   sdk.NewInt64Coin("banana", 6),
+			Price:    sdk.NewInt64Coin("plum", 12),
+		}),
+	}
+
+	for _, order := range orders {
+		testFunc := func() {
+			summary.AddOrder(order)
+		}
+		require.NotPanics(t, testFunc, "AddOrder(%d)", order.OrderId)
+	}
+
+	assert.Equal(t, 3, int(summary.MarketId), "MarketId")
+	assert.Equal(t, 2, int(summary.AskOrderCount), "AskOrderCount")
+	assert.Equal(t, "12apple", summary.AskAssets.String(), "AskAssets")
+	assert.Equal(t, "60plum", summary.AskPrice.String(), "AskPrice")
+	assert.Equal(t, 2, int(summary.BidOrderCount), "BidOrderCount")
+	assert.Equal(t, "4apple,6banana", summary.BidAssets.String(), "BidAssets")
+	assert.Equal(t, "32plum", summary.BidPrice.String(), "BidPrice")
+}
+
+func TestPaymentsSummary_AddPayment(t *testing.T) {
+	summary := &PaymentsSummary{}
+	payments := []*Payment{
+		{SourceAmount: sdk.NewCoins(sdk.NewInt64Coin("apple", 5)), ExternalId: "one"},
+		{TargetAmount: sdk.NewCoins(sdk.NewInt64Coin("plum", 3)), ExternalId: "two"},
+		{
+			SourceAmount: sdk.NewCoins(sdk.NewInt64Coin("apple", 2), sdk.NewInt64Coin("banana", 7)),
+			TargetAmount: sdk.NewCoins(sdk.NewInt64Coin("plum", 4)),
+			ExternalId:   "three",
+		},
+	}
+
+	for _, payment := range payments {
+		testFunc := func() {
+			summary.AddPayment(payment)
+		}
+		require.NotPanics(t, testFunc, "AddPayment(%q)", payment.ExternalId)
+	}
+
+	assert.Equal(t, 3, int(summary.PaymentCount), "PaymentCount")
+	assert.Equal(t, "7apple,7banana", summary.SourceAmount.String(), "SourceAmount")
+	assert.Equal(t, "7plum", summary.TargetAmount.String(), "TargetAmount")
+}
@@ -0,0 +1,210 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var (
+	_ authz.Authorization = (*CreateAskAuthorization)(nil)
+	_ authz.Authorization = (*CreateBidAuthorization)(nil)
+	_ authz.Authorization = (*MarketSettleAuthorization)(nil)
+)
+
+// NewCreateAskAuthorization creates a new CreateAskAuthorization.
+func NewCreateAskAuthorization(assetLimit sdk.Coins, marketIDs ...uint32) *CreateAskAuthorization {
+	return &CreateAskAuthorization{
+		MarketIds:  marketIDs,
+		AssetLimit: assetLimit,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a CreateAskAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgCreateAskRequest{})
+}
+
+// Accept implements Authorization.Accept.
+func (a CreateAskAuthorization) Accept(_ context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	req, ok := msg.(*MsgCreateAskRequest)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	order := req.AskOrder
+	if err := validateAuthzMarketID(a.MarketIds, order.MarketId); err != nil {
+		return authz.AcceptResponse{}, err
+	}
+
+	limitLeft, err := decreaseAuthzLimit(a.AssetLimit, order.Assets, "asset")
+	if err != nil {
+		return authz.AcceptResponse{}, err
+	}
+
+	return authz.AcceptResponse{
+		Accept:  true,
+		Delete:  limitLeft.IsZero(),
+		Updated: &CreateAskAuthorization{MarketIds: a.MarketIds, AssetLimit: limitLeft},
+	}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a CreateAskAuthorization) ValidateBasic() error {
+	return errors.Join(
+		validateAuthzMarketIDs(a.MarketIds),
+		validateAuthzLimit(a.AssetLimit, "asset"),
+	)
+}
+
+// NewCreateBidAuthorization creates a new CreateBidAuthorization.
+func NewCreateBidAuthorization(priceLimit sdk.Coins, marketIDs ...uint32) *CreateBidAuthorization {
+	return &CreateBidAuthorization{
+		MarketIds:  marketIDs,
+		PriceLimit: priceLimit,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a CreateBidAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgCreateBidRequest{})
+}
+
+// Accept implements Authorization.Accept.
+func (a CreateBidAuthorization) Accept(_ context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	req, ok := msg.(*MsgCreateBidRequest)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	order := req.BidOrder
+	if err := validateAuthzMarketID(a.MarketIds, order.MarketId); err != nil {
+		return authz.AcceptResponse{}, err
+	}
+
+	limitLeft, err := decreaseAuthzLimit(a.PriceLimit, order.Price, "price")
+	if err != nil {
+		return authz.AcceptResponse{}, err
+	}
+
+	return authz.AcceptResponse{
+		Accept:  true,
+		Delete:  limitLeft.IsZero(),
+		Updated: &CreateBidAuthorization{MarketIds: a.MarketIds, PriceLimit: limitLeft},
+	}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a CreateBidAuthorization) ValidateBasic() error {
+	return errors.Join(
+		validateAuthzMarketIDs(a.MarketIds),
+		validateAuthzLimit(a.PriceLimit, "price"),
+	)
+}
+
+// NewMarketSettleAuthorization creates a new MarketSettleAuthorization.
+func NewMarketSettleAuthorization(settlementLimit uint32, marketIDs ...uint32) *MarketSettleAuthorization {
+	return &MarketSettleAuthorization{
+		MarketIds:       marketIDs,
+		SettlementLimit: settlementLimit,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a MarketSettleAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgMarketSettleRequest{})
+}
+
+// Accept implements Authorization.Accept.
+func (a MarketSettleAuthorization) Accept(_ context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	req, ok := msg.(*MsgMarketSettleRequest)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	if err := validateAuthzMarketID(a.MarketIds, req.MarketId); err != nil {
+		return authz.AcceptResponse{}, err
+	}
+
+	if a.SettlementLimit == 0 {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("settlement limit reached")
+	}
+
+	limitLeft := a.SettlementLimit - 1
+	return authz.AcceptResponse{
+		Accept:  true,
+		Delete:  limitLeft == 0,
+		Updated: &MarketSettleAuthorization{MarketIds: a.MarketIds, SettlementLimit: limitLeft},
+	}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a MarketSettleAuthorization) ValidateBasic() error {
+	var errs []error
+	if err := validateAuthzMarketIDs(a.MarketIds); err != nil {
+		errs = append(errs, err)
+	}
+	if a.SettlementLimit == 0 {
+		errs = append(errs, errors.New("invalid settlement limit: cannot be zero"))
+	}
+	return errors.Join(errs...)
+}
+
+// validateAuthzMarketIDs returns an error if any of the provided market ids are zero or duplicated.
+func validateAuthzMarketIDs(marketIDs []uint32) error {
+	var errs []error
+	seen := make(map[uint32]bool, len(marketIDs))
+	for _, marketID := range marketIDs {
+		if marketID == 0 {
+			errs = append(errs, errors.New("invalid market ids: cannot contain zero"))
+			continue
+		}
+		if seen[marketID] {
+			errs = append(errs, fmt.Errorf("invalid market ids: duplicate entry %d", marketID))
+		}
+		seen[marketID] = true
+	}
+	return errors.Join(errs...)
+}
+
+// validateAuthzMarketID returns an error if marketIDs is not empty and does not contain the provided marketID.
+func validateAuthzMarketID(marketIDs []uint32, marketID uint32) error {
+	if len(marketIDs) == 0 {
+		return nil
+	}
+	for _, id := range marketIDs {
+		if id == marketID {
+			return nil
+		}
+	}
+	return sdkerrors.ErrUnauthorized.Wrapf("market %d is not allowed", marketID)
+}
+
+// validateAuthzLimit returns an error if the provided limit is invalid or zero.
+func validateAuthzLimit(limit sdk.Coins, name string) error {
+	if err := limit.Validate(); err != nil {
+		return fmt.Errorf("invalid %s limit: %w", name, err)
+	}
+	if limit.IsZero() {
+		return fmt.Errorf("invalid %s limit: cannot be zero", name)
+	}
+	return nil
+}
+
+// decreaseAuthzLimit subtracts the provided amount from the limit, returning an error
+// if that amount's denom is not in the limit or there isn't enough left.
+func decreaseAuthzLimit(limit sdk.Coins, amount sdk.Coin, name string) (sdk.Coins, error) {
+	if found, _ := limit.Find(amount.Denom); !found {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("%s denom %q is not allowed", name, amount.Denom)
+	}
+	limitLeft, isNeg := limit.SafeSub(amount)
+	if isNeg {
+		return nil, sdkerrors.ErrInsufficientFunds.Wrapf("%s %s is more than the remaining %s limit %s",
+			name, amount, name, limit)
+	}
+	return limitLeft, nil
+}
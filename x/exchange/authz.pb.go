@@ -0,0 +1,1024 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/exchange/v1/authz.proto
+
+package exchange
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CreateAskAuthorization allows the grantee to create ask orders on behalf of the granter.
+type CreateAskAuthorization struct {
+	// market_ids are the markets that the grantee can create ask orders in.
+	// If empty, the grantee can create ask orders in any market.
+	MarketIds []uint32 `protobuf:"varint,1,rep,packed,name=market_ids,json=marketIds,proto3" json:"market_ids,omitempty"`
+	// asset_limit is the total amount of assets that the grantee can list for sale.
+	// Each ask order's assets are deducted from this limit; only these denoms can be listed.
+	AssetLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=asset_limit,json=assetLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"asset_limit"`
+}
+
+func (m *CreateAskAuthorization) Reset()         { *m = CreateAskAuthorization{} }
+func (m *CreateAskAuthorization) String() string { return proto.CompactTextString(m) }
+func (*CreateAskAuthorization) ProtoMessage()    {}
+func (*CreateAskAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6282187844c8a0e0, []int{0}
+}
+func (m *CreateAskAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateAskAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateAskAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateAskAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAskAuthorization.Merge(m, src)
+}
+func (m *CreateAskAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateAskAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAskAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAskAuthorization proto.InternalMessageInfo
+
+func (m *CreateAskAuthorization) GetMarketIds() []uint32 {
+	if m != nil {
+		return m.MarketIds
+	}
+	return nil
+}
+
+func (m *CreateAskAuthorization) GetAssetLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AssetLimit
+	}
+	return nil
+}
+
+// CreateBidAuthorization allows the grantee to create bid orders on behalf of the granter.
+type CreateBidAuthorization struct {
+	// market_ids are the markets that the grantee can create bid orders in.
+	// If empty, the grantee can create bid orders in any market.
+	MarketIds []uint32 `protobuf:"varint,1,rep,packed,name=market_ids,json=marketIds,proto3" json:"market_ids,omitempty"`
+	// price_limit is the total amount that the grantee can bid.
+	// Each bid order's price is deducted from this limit; only these denoms can be bid.
+	PriceLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=price_limit,json=priceLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"price_limit"`
+}
+
+func (m *CreateBidAuthorization) Reset()         { *m = CreateBidAuthorization{} }
+func (m *CreateBidAuthorization) String() string { return proto.CompactTextString(m) }
+func (*CreateBidAuthorization) ProtoMessage()    {}
+func (*CreateBidAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6282187844c8a0e0, []int{1}
+}
+func (m *CreateBidAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateBidAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateBidAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateBidAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateBidAuthorization.Merge(m, src)
+}
+func (m *CreateBidAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateBidAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateBidAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateBidAuthorization proto.InternalMessageInfo
+
+func (m *CreateBidAuthorization) GetMarketIds() []uint32 {
+	if m != nil {
+		return m.MarketIds
+	}
+	return nil
+}
+
+func (m *CreateBidAuthorization) GetPriceLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PriceLimit
+	}
+	return nil
+}
+
+// MarketSettleAuthorization allows the grantee to settle orders on behalf of a granter with the settle permission.
+type MarketSettleAuthorization struct {
+	// market_ids are the markets that the grantee can settle orders in.
+	// If empty, the grantee can settle orders in any market that the granter can.
+	MarketIds []uint32 `protobuf:"varint,1,rep,packed,name=market_ids,json=marketIds,proto3" json:"market_ids,omitempty"`
+	// settlement_limit is the number of settlements the grantee can still execute.
+	SettlementLimit uint32 `protobuf:"varint,2,opt,name=settlement_limit,json=settlementLimit,proto3" json:"settlement_limit,omitempty"`
+}
+
+func (m *MarketSettleAuthorization) Reset()         { *m = MarketSettleAuthorization{} }
+func (m *MarketSettleAuthorization) String() string { return proto.CompactTextString(m) }
+func (*MarketSettleAuthorization) ProtoMessage()    {}
+func (*MarketSettleAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6282187844c8a0e0, []int{2}
+}
+func (m *MarketSettleAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketSettleAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketSettleAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketSettleAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketSettleAuthorization.Merge(m, src)
+}
+func (m *MarketSettleAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketSettleAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketSettleAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketSettleAuthorization proto.InternalMessageInfo
+
+func (m *MarketSettleAuthorization) GetMarketIds() []uint32 {
+	if m != nil {
+		return m.MarketIds
+	}
+	return nil
+}
+
+func (m *MarketSettleAuthorization) GetSettlementLimit() uint32 {
+	if m != nil {
+		return m.SettlementLimit
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*CreateAskAuthorization)(nil), "provenance.exchange.v1.CreateAskAuthorization")
+	proto.RegisterType((*CreateBidAuthorization)(nil), "provenance.exchange.v1.CreateBidAuthorization")
+	proto.RegisterType((*MarketSettleAuthorization)(nil), "provenance.exchange.v1.MarketSettleAuthorization")
+}
+
+func init() {
+	proto.RegisterFile("provenance/exchange/v1/authz.proto", fileDescriptor_6282187844c8a0e0)
+}
+
+var fileDescriptor_6282187844c8a0e0 = []byte{
+	// 403 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x53, 0xc1, 0xaa, 0xd3, 0x40,
+	0x14, 0xcd, 0xf8, 0x40, 0x70, 0x9e, 0x45, 0x5f, 0x91, 0xd2, 0x14, 0x4c, 0x4b, 0x57, 0xb1, 0xd0,
+	0x19, 0xaa, 0x3b, 0x77, 0x6d, 0x41, 0x10, 0x14, 0xa4, 0xee, 0xdc, 0x84, 0x49, 0x72, 0x49, 0x86,
+	0x36, 0x33, 0x21, 0x33, 0x2d, 0x6d, 0x97, 0x7e, 0x81, 0x6b, 0xbf, 0x40, 0x5c, 0x75, 0xe1, 0x47,
+	0x14, 0x37, 0x76, 0xe9, 0x4a, 0xa5, 0x5d, 0xf4, 0x37, 0x24, 0x93, 0x68, 0x2a, 0xd8, 0x45, 0x37,
+	0x6f, 0x93, 0xe4, 0x9e, 0x7b, 0x6e, 0xce, 0x39, 0xc9, 0x1d, 0xdc, 0x4d, 0x33, 0xb9, 0x00, 0xc1,
+	0x44, 0x00, 0x14, 0x96, 0x41, 0xcc, 0x44, 0x04, 0x74, 0x31, 0xa0, 0x6c, 0xae, 0xe3, 0x35, 0x49,
+	0x33, 0xa9, 0x65, 0xbd, 0x51, 0x71, 0xc8, 0x1f, 0x0e, 0x59, 0x0c, 0x5a, 0x37, 0x2c, 0xe1, 0x42,
+	0x52, 0x73, 0x2d, 0xa8, 0x2d, 0x27, 0x90, 0x2a, 0x91, 0x8a, 0xfa, 0x4c, 0xe5, 0xaf, 0xf1, 0x41,
+	0xb3, 0x01, 0x0d, 0x24, 0x17, 0x65, 0xdf, 0x2e, 0xfa, 0x9e, 0xa9, 0x68, 0x51, 0x94, 0xad, 0x47,
+	0x91, 0x8c, 0x64, 0x81, 0xe7, 0x4f, 0x05, 0xda, 0xfd, 0x86, 0x70, 0x63, 0x9c, 0x01, 0xd3, 0x30,
+	0x54, 0xd3, 0xe1, 0x5c, 0xc7, 0x32, 0xe3, 0x6b, 0xa6, 0xb9, 0x14, 0xf5, 0xc7, 0x18, 0x27, 0x2c,
+	0x9b, 0x82, 0xf6, 0x78, 0xa8, 0x9a, 0xa8, 0x73, 0xe5, 0xd6, 0x26, 0xf7, 0x0a, 0xe4, 0x65, 0xa8,
+	0xea, 0xef, 0x11, 0xbe, 0x66, 0x4a, 0x81, 0xf6, 0x66, 0x3c, 0xe1, 0xba, 0x79, 0xa7, 0x73, 0xe5,
+	0x5e, 0x3f, 0xb5, 0x49, 0x29, 0x9a, 0x3b, 0x24, 0xa5, 0x43, 0x32, 0x96, 0x5c, 0x8c, 0x5e, 0x6c,
+	0x7f, 0xb4, 0xad, 0xcf, 0x3f, 0xdb, 0x6e, 0xc4, 0x75, 0x3c, 0xf7, 0x49, 0x20, 0x93, 0xd2, 0x61,
+	0x79, 0xeb, 0xab, 0x70, 0x4a, 0xf5, 0x2a, 0x05, 0x65, 0x06, 0xd4, 0xc7, 0xe3, 0xa6, 0x77, 0x7f,
+	0x06, 0x11, 0x0b, 0x56, 0x5e, 0x9e, 0x51, 0x7d, 0x3a, 0x6e, 0x7a, 0x68, 0x82, 0x8d, 0xea, 0xab,
+	0x5c, 0xf4, 0xf9, 0xcd, 0xd7, 0x2f, 0xfd, 0xda, 0x3f, 0xb6, 0x4f, 0x12, 0x8d, 0x78, 0x78, 0x71,
+	0xa2, 0x34, 0xe3, 0x01, 0xdc, 0x7a, 0x22, 0xa3, 0x7a, 0x36, 0xd1, 0x12, 0xdb, 0xaf, 0x8d, 0xc9,
+	0xb7, 0xa0, 0xf5, 0x0c, 0x2e, 0xca, 0xf4, 0x04, 0x3f, 0x54, 0x66, 0x2a, 0x01, 0x51, 0xfd, 0x29,
+	0xe4, 0xd6, 0x26, 0x0f, 0x2a, 0xfc, 0x9c, 0xf2, 0x08, 0xb6, 0x7b, 0x07, 0xed, 0xf6, 0x0e, 0xfa,
+	0xb5, 0x77, 0xd0, 0x87, 0x83, 0x63, 0xed, 0x0e, 0x8e, 0xf5, 0xfd, 0xe0, 0x58, 0xd8, 0xe6, 0x92,
+	0xfc, 0x7f, 0x6d, 0xdf, 0xa0, 0x77, 0xe4, 0xe4, 0x7b, 0x54, 0xa4, 0x3e, 0x97, 0x27, 0x15, 0x5d,
+	0xfe, 0x3d, 0x0f, 0xfe, 0x5d, 0xb3, 0x8b, 0xcf, 0x7e, 0x0f, 0x00, 0xde, 0x83, 0xe2, 0xcd, 0x2d,
+	0x03, 0x00, 0x00,
+}
+
+func (m *CreateAskAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateAskAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateAskAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AssetLimit) > 0 {
+		for iNdEx := len(m.AssetLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssetLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MarketIds) > 0 {
+		dAtA2 := make([]byte, len(m.MarketIds)*10)
+		var j1 int
+		for _, num := range m.MarketIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintAuthz(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateBidAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateBidAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateBidAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PriceLimit) > 0 {
+		for iNdEx := len(m.PriceLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MarketIds) > 0 {
+		dAtA4 := make([]byte, len(m.MarketIds)*10)
+		var j3 int
+		for _, num := range m.MarketIds {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintAuthz(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketSettleAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketSettleAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketSettleAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SettlementLimit != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.SettlementLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MarketIds) > 0 {
+		dAtA6 := make([]byte, len(m.MarketIds)*10)
+		var j5 int
+		for _, num := range m.MarketIds {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintAuthz(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CreateAskAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MarketIds) > 0 {
+		l = 0
+		for _, e := range m.MarketIds {
+			l += sovAuthz(uint64(e))
+		}
+		n += 1 + sovAuthz(uint64(l)) + l
+	}
+	if len(m.AssetLimit) > 0 {
+		for _, e := range m.AssetLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CreateBidAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MarketIds) > 0 {
+		l = 0
+		for _, e := range m.MarketIds {
+			l += sovAuthz(uint64(e))
+		}
+		n += 1 + sovAuthz(uint64(l)) + l
+	}
+	if len(m.PriceLimit) > 0 {
+		for _, e := range m.PriceLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MarketSettleAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MarketIds) > 0 {
+		l = 0
+		for _, e := range m.MarketIds {
+			l += sovAuthz(uint64(e))
+		}
+		n += 1 + sovAuthz(uint64(l)) + l
+	}
+	if m.SettlementLimit != 0 {
+		n += 1 + sovAuthz(uint64(m.SettlementLimit))
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CreateAskAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateAskAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateAskAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthz
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MarketIds = append(m.MarketIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthz
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAuthz
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAuthz
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MarketIds) == 0 {
+					m.MarketIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuthz
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MarketIds = append(m.MarketIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketIds", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetLimit = append(m.AssetLimit, types.Coin{})
+			if err := m.AssetLimit[len(m.AssetLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateBidAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateBidAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateBidAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthz
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MarketIds = append(m.MarketIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthz
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAuthz
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAuthz
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MarketIds) == 0 {
+					m.MarketIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuthz
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MarketIds = append(m.MarketIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketIds", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceLimit = append(m.PriceLimit, types.Coin{})
+			if err := m.PriceLimit[len(m.PriceLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketSettleAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketSettleAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketSettleAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthz
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MarketIds = append(m.MarketIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthz
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAuthz
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAuthz
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MarketIds) == 0 {
+					m.MarketIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuthz
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MarketIds = append(m.MarketIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketIds", wireType)
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementLimit", wireType)
+			}
+			m.SettlementLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementLimit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,342 @@
+package exchange
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+func TestCreateAskAuthorization_MsgTypeURL(t *testing.T) {
+	assert.Equal(t, "/provenance.exchange.v1.MsgCreateAskRequest", CreateAskAuthorization{}.MsgTypeURL())
+}
+
+func TestCreateAskAuthorization_Accept(t *testing.T) {
+	seller := sdk.AccAddress("seller______________").String()
+	askMsg := func(marketID uint32, assets sdk.Coin) *MsgCreateAskRequest {
+		return &MsgCreateAskRequest{AskOrder: AskOrder{
+			MarketId: marketID,
+			Seller:   seller,
+			Assets:   assets,
+			Price:    sdk.NewInt64Coin("plum", 5),
+		}}
+	}
+
+	tests := []struct {
+		name   string
+		auth   *CreateAskAuthorization
+		msg    sdk.Msg
+		expErr string
+		exp    authz.AcceptResponse
+	}{
+		{
+			name:   "wrong msg type",
+			auth:   NewCreateAskAuthorization(sdk.NewCoins(sdk.NewInt64Coin("apple", 10))),
+			msg:    &MsgCreateBidRequest{},
+			expErr: "type mismatch: invalid type",
+		},
+		{
+			name:   "market not allowed",
+			auth:   NewCreateAskAuthorization(sdk.NewCoins(sdk.NewInt64Coin("apple", 10)), 1, 2),
+			msg:    askMsg(3, sdk.NewInt64Coin("apple", 5)),
+			expErr: "market 3 is not allowed: unauthorized",
+		},
+		{
+			name:   "denom not allowed",
+			auth:   NewCreateAskAuthorization(sdk.NewCoins(sdk.NewInt64Coin("apple", 10))),
+			msg:    askMsg(3, sdk.NewInt64Coin("banana", 5)),
+			expErr: "asset denom \"banana\" is not allowed: unauthorized",
+		},
+		{
+			name:   "more than limit",
+			auth:   NewCreateAskAuthorization(sdk.NewCoins(sdk.NewInt64Coin("apple", 10))),
+			msg:    askMsg(3, sdk.NewInt64Coin("apple", 11)),
+			expErr: "asset 11apple is more than the remaining asset limit 10apple: insufficient funds",
+		},
+		{
+			name: "some of limit",
+			auth: NewCreateAskAuthorization(sdk.NewCoins(sdk.NewInt64Coin("apple", 10), sdk.NewInt64Coin("banana", 3)), 2, 3),
+			msg:  askMsg(3, sdk.NewInt64Coin("apple", 4)),
+			exp: authz.AcceptResponse{
+				Accept:  true,
+				Updated: NewCreateAskAuthorization(sdk.NewCoins(sdk.NewInt64Coin("apple", 6), sdk.NewInt64Coin("banana", 3)), 2, 3),
+			},
+		},
+		{
+			name: "all of limit",
+			auth: NewCreateAskAuthorization(sdk.NewCoins(sdk.NewInt64Coin("apple", 10))),
+			msg:  askMsg(3, sdk.NewInt64Coin("apple", 10)),
+			exp: authz.AcceptResponse{
+				Accept:  true,
+				Delete:  true,
+				Updated: &CreateAskAuthorization{AssetLimit: sdk.Coins{}},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var resp authz.AcceptResponse
+			var err error
+			testFunc := func() {
+				resp, err = tc.auth.Accept(context.Background(), tc.msg)
+			}
+			require.NotPanics(t, testFunc, "Accept")
+			assertions.AssertErrorValue(t, err, tc.expErr, "Accept error")
+			assert.Equal(t, tc.exp, resp, "Accept response")
+		})
+	}
+}
+
+func TestCreateAskAuthorization_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		auth CreateAskAuthorization
+		exp  string
+	}{
+		{
+			name: "control",
+			auth: *NewCreateAskAuthorization(sdk.NewCoins(sdk.NewInt64Coin("apple", 10)), 1, 2),
+		},
+		{
+			name: "no limit",
+			auth: CreateAskAuthorization{},
+			exp:  "invalid asset limit: cannot be zero",
+		},
+		{
+			name: "invalid limit",
+			auth: CreateAskAuthorization{AssetLimit: sdk.Coins{sdk.Coin{Denom: "x", Amount: sdkmath.NewInt(1)}}},
+			exp:  "invalid asset limit: invalid denom: x",
+		},
+		{
+			name: "zero market id and duplicate market id",
+			auth: *NewCreateAskAuthorization(sdk.NewCoins(sdk.NewInt64Coin("apple", 10)), 1, 0, 1),
+			exp: "invalid market ids: cannot contain zero\n" +
+				"invalid market ids: duplicate entry 1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.auth.ValidateBasic()
+			assertions.AssertErrorValue(t, err, tc.exp, "ValidateBasic")
+		})
+	}
+}
+
+func TestCreateBidAuthorization_MsgTypeURL(t *testing.T) {
+	assert.Equal(t, "/provenance.exchange.v1.MsgCreateBidRequest", CreateBidAuthorization{}.MsgTypeURL())
+}
+
+func TestCreateBidAuthorization_Accept(t *testing.T) {
+	buyer := sdk.AccAddress("buyer_______________").String()
+	bidMsg := func(marketID uint32, price sdk.Coin) *MsgCreateBidRequest {
+		return &MsgCreateBidRequest{BidOrder: BidOrder{
+			MarketId: marketID,
+			Buyer:    buyer,
+			Assets:   sdk.NewInt64Coin("apple", 5),
+			Price:    price,
+		}}
+	}
+
+	tests := []struct {
+		name   string
+		auth   *CreateBidAuthorization
+		msg    sdk.Msg
+		expErr string
+		exp    authz.AcceptResponse
+	}{
+		{
+			name:   "wrong msg type",
+			auth:   NewCreateBidAuthorization(sdk.NewCoins(sdk.NewInt64Coin("plum", 10))),
+			msg:    &MsgCreateAskRequest{},
+			expErr: "type mismatch: invalid type",
+		},
+		{
+			name:   "market not allowed",
+			auth:   NewCreateBidAuthorization(sdk.NewCoins(sdk.NewInt64Coin("plum", 10)), 4),
+			msg:    bidMsg(3, sdk.NewInt64Coin("plum", 5)),
+			expErr: "market 3 is not allowed: unauthorized",
+		},
+		{
+			name:   "denom not allowed",
+			auth:   NewCreateBidAuthorization(sdk.NewCoins(sdk.NewInt64Coin("plum", 10))),
+			msg:    bidMsg(3, sdk.NewInt64Coin("prune", 5)),
+			expErr: "price denom \"prune\" is not allowed: unauthorized",
+		},
+		{
+			name:   "more than limit",
+			auth:   NewCreateBidAuthorization(sdk.NewCoins(sdk.NewInt64Coin("plum", 10))),
+			msg:    bidMsg(3, sdk.NewInt64Coin("plum", 11)),
+			expErr: "price 11plum is more than the remaining price limit 10plum: insufficient funds",
+		},
+		{
+			name: "some of limit",
+			auth: NewCreateBidAuthorization(sdk.NewCoins(sdk.NewInt64Coin("plum", 10)), 3),
+			msg:  bidMsg(3, sdk.NewInt64Coin("plum", 1)),
+			exp: authz.AcceptResponse{
+				Accept:  true,
+				Updated: NewCreateBidAuthorization(sdk.NewCoins(sdk.NewInt64Coin("plum", 9)), 3),
+			},
+		},
+		{
+			name: "all of limit",
+			auth: NewCreateBidAuthorization(sdk.NewCoins(sdk.NewInt64Coin("plum", 10)), 3),
+			msg:  bidMsg(3, sdk.NewInt64Coin("plum", 10)),
+			exp: authz.AcceptResponse{
+				Accept:  true,
+				Delete:  true,
+				Updated: &CreateBidAuthorization{MarketIds: []uint32{3}, PriceLimit: sdk.Coins{}},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var resp authz.AcceptResponse
+			var err error
+			testFunc := func() {
+				resp, err = tc.auth.Accept(context.Background(), tc.msg)
+			}
+			require.NotPanics(t, testFunc, "Accept")
+			assertions.AssertErrorValue(t, err, tc.expErr, "Accept error")
+			assert.Equal(t, tc.exp, resp, "Accept response")
+		})
+	}
+}
+
+func TestCreateBidAuthorization_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		auth CreateBidAuthorization
+		exp  string
+	}{
+		{
+			name: "control",
+			auth: *NewCreateBidAuthorization(sdk.NewCoins(sdk.NewInt64Coin("plum", 10))),
+		},
+		{
+			name: "no limit",
+			auth: CreateBidAuthorization{MarketIds: []uint32{1}},
+			exp:  "invalid price limit: cannot be zero",
+		},
+		{
+			name: "zero market id",
+			auth: *NewCreateBidAuthorization(sdk.NewCoins(sdk.NewInt64Coin("plum", 10)), 0),
+			exp:  "invalid market ids: cannot contain zero",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.auth.ValidateBasic()
+			assertions.AssertErrorValue(t, err, tc.exp, "ValidateBasic")
+		})
+	}
+}
+
+func TestMarketSettleAuthorization_MsgTypeURL(t *testing.T) {
+	assert.Equal(t, "/provenance.exchange.v1.MsgMarketSettleRequest", MarketSettleAuthorization{}.MsgTypeURL())
+}
+
+func TestMarketSettleAuthorization_Accept(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________").String()
+
+	tests := []struct {
+		name   string
+		auth   *MarketSettleAuthorization
+		msg    sdk.Msg
+		expErr string
+		exp    authz.AcceptResponse
+	}{
+		{
+			name:   "wrong msg type",
+			auth:   NewMarketSettleAuthorization(3),
+			msg:    &MsgMarketCommitmentSettleRequest{Admin: admin, MarketId: 1},
+			expErr: "type mismatch: invalid type",
+		},
+		{
+			name:   "market not allowed",
+			auth:   NewMarketSettleAuthorization(3, 2),
+			msg:    &MsgMarketSettleRequest{Admin: admin, MarketId: 1},
+			expErr: "market 1 is not allowed: unauthorized",
+		},
+		{
+			name:   "no settlements left",
+			auth:   NewMarketSettleAuthorization(0, 1),
+			msg:    &MsgMarketSettleRequest{Admin: admin, MarketId: 1},
+			expErr: "settlement limit reached: unauthorized",
+		},
+		{
+			name: "some settlements left",
+			auth: NewMarketSettleAuthorization(3, 1),
+			msg:  &MsgMarketSettleRequest{Admin: admin, MarketId: 1},
+			exp: authz.AcceptResponse{
+				Accept:  true,
+				Updated: NewMarketSettleAuthorization(2, 1),
+			},
+		},
+		{
+			name: "last settlement",
+			auth: NewMarketSettleAuthorization(1),
+			msg:  &MsgMarketSettleRequest{Admin: admin, MarketId: 7},
+			exp: authz.AcceptResponse{
+				Accept:  true,
+				Delete:  true,
+				Updated: NewMarketSettleAuthorization(0),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var resp authz.AcceptResponse
+			var err error
+			testFunc := func() {
+				resp, err = tc.auth.Accept(context.Background(), tc.msg)
+			}
+			require.NotPanics(t, testFunc, "Accept")
+			assertions.AssertErrorValue(t, err, tc.expErr, "Accept error")
+			assert.Equal(t, tc.exp, resp, "Accept response")
+		})
+	}
+}
+
+func TestMarketSettleAuthorization_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		auth MarketSettleAuthorization
+		exp  string
+	}{
+		{
+			name: "control",
+			auth: *NewMarketSettleAuthorization(5, 1, 2, 3),
+		},
+		{
+			name: "zero limit",
+			auth: *NewMarketSettleAuthorization(0, 1),
+			exp:  "invalid settlement limit: cannot be zero",
+		},
+		{
+			name: "duplicate market id and zero limit",
+			auth: *NewMarketSettleAuthorization(0, 2, 2),
+			exp: "invalid market ids: duplicate entry 2\n" +
+				"invalid settlement limit: cannot be zero",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.auth.ValidateBasic()
+			assertions.AssertErrorValue(t, err, tc.exp, "ValidateBasic")
+		})
+	}
+}
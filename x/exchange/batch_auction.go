@@ -0,0 +1,228 @@
+package exchange
+
+import (
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BatchAuction contains the orders that a batch auction will settle, all repriced to its single clearing price.
+type BatchAuction struct {
+	// ClearingPrice is the price of one of the assets that all the orders are settled at.
+	ClearingPrice sdk.DecCoin
+	// AskOrders are the ask orders to settle. Each has the assets being filled and a price at the clearing price.
+	AskOrders []*Order
+	// BidOrders are the bid orders to settle. Each has the assets being filled and a price at the clearing price.
+	BidOrders []*Order
+	// PartialOrderLeft is what's left of the one order being partially filled (if there is one).
+	// The filled portion of that order is in either AskOrders or BidOrders.
+	PartialOrderLeft *Order
+}
+
+// auctionFill is an order selected to be filled by a batch auction and the amount of its assets to fill.
+type auctionFill struct {
+	order *Order
+	amt   sdkmath.Int
+}
+
+// MatchBatchAuction identifies the single clearing price that maximizes the volume of assets traded
+// between the provided orders, and the orders that can be settled at that price.
+// All orders must have the same assets and price denoms. If none of the orders cross, nil, nil is returned.
+//
+// The clearing price is the unit price of one of the orders. Ties on volume are broken by the smallest
+// difference between the assets offered and wanted at that price, then by the lowest price.
+// Orders are filled by price-time priority, and at most one order is partially filled.
+func MatchBatchAuction(askOrders, bidOrders []*Order) (*BatchAuction, error) {
+	if len(askOrders) == 0 || len(bidOrders) == 0 {
+		return nil, nil
+	}
+	if err := validateCanSettle(askOrders, bidOrders); err != nil {
+		return nil, err
+	}
+
+	clearing := findClearingOrder(askOrders, bidOrders)
+	if clearing == nil {
+		return nil, nil
+	}
+
+	var asks, bids []*Order
+	for _, askOrder := range askOrders {
+		if CompareUnitPrices(askOrder, clearing) <= 0 {
+			asks = append(asks, askOrder)
+		}
+	}
+	for _, bidOrder := range bidOrders {
+		if CompareUnitPrices(bidOrder, clearing) >= 0 {
+			bids = append(bids, bidOrder)
+		}
+	}
+	// Price-time priority: lowest asks and highest bids first, then the oldest orders.
+	sort.SliceStable(asks, func(i, j int) bool {
+		if cmp := CompareUnitPrices(asks[i], asks[j]); cmp != 0 {
+			return cmp < 0
+		}
+		return asks[i].OrderId < asks[j].OrderId
+	})
+	sort.SliceStable(bids, func(i, j int) bool {
+		if cmp := CompareUnitPrices(bids[i], bids[j]); cmp != 0 {
+			return cmp > 0
+		}
+		return bids[i].OrderId < bids[j].OrderId
+	})
+
+	askFills, bidFills := selectAuctionFills(asks, bids)
+	if len(askFills) == 0 || len(bidFills) == 0 {
+		return nil, nil
+	}
+
+	clearingPrice := clearing.GetPrice()
+	clearingAssets := clearing.GetAssets()
+	rv := &BatchAuction{
+		ClearingPrice: sdk.NewDecCoinFromDec(clearingPrice.Denom, clearingPrice.Amount.ToLegacyDec().QuoInt(clearingAssets.Amount)),
+	}
+	var err error
+	if rv.AskOrders, err = repriceAuctionFills(askFills, clearingPrice, clearingAssets.Amount, rv); err != nil {
+		return nil, err
+	}
+	if rv.BidOrders, err = repriceAuctionFills(bidFills, clearingPrice, clearingAssets.Amount, rv); err != nil {
+		return nil, err
+	}
+	return rv, nil
+}
+
+// findClearingOrder returns the order whose unit price maximizes the volume of assets that can be traded.
+// If no assets can be traded, nil is returned.
+func findClearingOrder(askOrders, bidOrders []*Order) *Order {
+	var best *Order
+	var bestVol, bestImbalance sdkmath.Int
+	for _, candidates := range [][]*Order{askOrders, bidOrders} {
+		for _, candidate := range candidates {
+			supply, demand := sdkmath.ZeroInt(), sdkmath.ZeroInt()
+			for _, askOrder := range askOrders {
+				if CompareUnitPrices(askOrder, candidate) <= 0 {
+					supply = supply.Add(askOrder.GetAssets().Amount)
+				}
+			}
+			for _, bidOrder := range bidOrders {
+				if CompareUnitPrices(bidOrder, candidate) >= 0 {
+					demand = demand.Add(bidOrder.GetAssets().Amount)
+				}
+			}
+
+			vol := MinSDKInt(supply, demand)
+			if !vol.IsPositive() {
+				continue
+			}
+			imbalance := supply.Sub(demand).Abs()
+			if best == nil || vol.GT(bestVol) ||
+				(vol.Equal(bestVol) && (imbalance.LT(bestImbalance) ||
+					(imbalance.Equal(bestImbalance) && CompareUnitPrices(candidate, best) < 0))) {
+				best, bestVol, bestImbalance = candidate, vol, imbalance
+			}
+		}
+	}
+	return best
+}
+
+// selectAuctionFills picks which of the (priority sorted) orders to fill, and by how much, so that the
+// amount of assets sold equals the amount bought. Orders on the side with fewer assets are only filled
+// in full. On the other side, the last order filled can be partially filled.
+func selectAuctionFills(asks, bids []*Order) (askFills, bidFills []*auctionFill) {
+	supply, demand := sdkmath.ZeroInt(), sdkmath.ZeroInt()
+	for _, askOrder := range asks {
+		supply = supply.Add(askOrder.GetAssets().Amount)
+	}
+	for _, bidOrder := range bids {
+		demand = demand.Add(bidOrder.GetAssets().Amount)
+	}
+
+	short, long := asks, bids
+	if supply.GT(demand) {
+		short, long = bids, asks
+	}
+
+	target := MinSDKInt(supply, demand)
+	var shortFills, longFills []*auctionFill
+	for target.IsPositive() {
+		var shortAmt, longAmt sdkmath.Int
+		shortFills, shortAmt = selectFills(short, target, false)
+		longFills, longAmt = selectFills(long, shortAmt, true)
+		if longAmt.Equal(shortAmt) {
+			break
+		}
+		target = longAmt
+	}
+	if !target.IsPositive() {
+		return nil, nil
+	}
+
+	if supply.GT(demand) {
+		return longFills, shortFills
+	}
+	return shortFills, longFills
+}
+
+// selectFills picks the orders (in the order provided) that fill as much as possible of the target amount.
+// Orders are taken in full while they fit. If allowPartial is true, the first order that does not fit
+// is partially filled (if it can be), and is the last one taken. Orders that cannot be taken are skipped.
+func selectFills(orders []*Order, target sdkmath.Int, allowPartial bool) ([]*auctionFill, sdkmath.Int) {
+	var rv []*auctionFill
+	total := sdkmath.ZeroInt()
+	for _, order := range orders {
+		left := target.Sub(total)
+		if !left.IsPositive() {
+			break
+		}
+		amt := order.GetAssets().Amount
+		if amt.GT(left) {
+			if !allowPartial {
+				continue
+			}
+			if _, _, err := order.Split(left); err != nil {
+				continue
+			}
+			rv = append(rv, &auctionFill{order: order, amt: left})
+			total = total.Add(left)
+			break
+		}
+		rv = append(rv, &auctionFill{order: order, amt: amt})
+		total = total.Add(amt)
+	}
+	return rv, total
+}
+
+// repriceAuctionFills creates orders for the filled portions of the provided fills, each with a price at the
+// clearing price. Ask prices are rounded down, and bid prices are rounded up, so that each order gets a price
+// at least as good as what it asked for. If one of the fills is partial, rv.PartialOrderLeft is set.
+func repriceAuctionFills(fills []*auctionFill, clearingPrice sdk.Coin, clearingAssetsAmt sdkmath.Int, rv *BatchAuction) ([]*Order, error) {
+	orders := make([]*Order, len(fills))
+	for i, fill := range fills {
+		filled := fill.order
+		if !fill.amt.Equal(fill.order.GetAssets().Amount) {
+			var unfilled *Order
+			var err error
+			filled, unfilled, err = fill.order.Split(fill.amt)
+			if err != nil {
+				return nil, err
+			}
+			rv.PartialOrderLeft = unfilled
+		}
+
+		priceAmt := clearingPrice.Amount.Mul(fill.amt)
+		newOrder := NewOrder(filled.OrderId)
+		switch {
+		case filled.IsAskOrder():
+			askOrder := filled.GetAskOrder()
+			newPrice := sdk.NewCoin(clearingPrice.Denom, priceAmt.Quo(clearingAssetsAmt))
+			newOrder.WithAsk(askOrder.CopyChange(askOrder.Assets, newPrice, askOrder.SellerSettlementFlatFee))
+		default:
+			bidOrder := filled.GetBidOrder()
+			newPrice := sdk.NewCoin(clearingPrice.Denom, QuoIntRoundUp(priceAmt, clearingAssetsAmt))
+			newOrder.WithBid(bidOrder.CopyChange(bidOrder.Assets, newPrice, bidOrder.BuyerSettlementFees))
+		}
+		orders[i] = newOrder
+	}
+	return orders, nil
+}
@@ -0,0 +1,182 @@
+package exchange
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+func TestMatchBatchAuction(t *testing.T) {
+	coin := func(coinStr string) sdk.Coin {
+		rv, err := ParseCoin(coinStr)
+		require.NoError(t, err, "ParseCoin(%q)", coinStr)
+		return rv
+	}
+	askOrder := func(orderID uint64, assets, price string, allowPartial bool) *Order {
+		return NewOrder(orderID).WithAsk(&AskOrder{
+			MarketId:     1,
+			Seller:       "seller",
+			Assets:       coin(assets),
+			Price:        coin(price),
+			AllowPartial: allowPartial,
+		})
+	}
+	bidOrder := func(orderID uint64, assets, price string, allowPartial bool) *Order {
+		return NewOrder(orderID).WithBid(&BidOrder{
+			MarketId:     1,
+			Buyer:        "buyer",
+			Assets:       coin(assets),
+			Price:        coin(price),
+			AllowPartial: allowPartial,
+		})
+	}
+	orderString := func(order *Order) string {
+		if order == nil {
+			return "nil"
+		}
+		return fmt.Sprintf("%s order %d: %s at %s", order.GetOrderType(), order.OrderId, order.GetAssets(), order.GetPrice())
+	}
+
+	tests := []struct {
+		name       string
+		askOrders  []*Order
+		bidOrders  []*Order
+		expPrice   string
+		expAsks    []*Order
+		expBids    []*Order
+		expPartial *Order
+		expErr     string
+	}{
+		{
+			name:      "no ask orders",
+			bidOrders: []*Order{bidOrder(1, "10apple", "20plum", false)},
+		},
+		{
+			name:      "no bid orders",
+			askOrders: []*Order{askOrder(1, "10apple", "20plum", false)},
+		},
+		{
+			name:      "different asset denoms",
+			askOrders: []*Order{askOrder(1, "10apple", "20plum", false)},
+			bidOrders: []*Order{bidOrder(2, "10acorn", "20plum", false)},
+			expErr:    `cannot settle different ask "10apple" and bid "10acorn" asset denoms`,
+		},
+		{
+			name:      "orders do not cross",
+			askOrders: []*Order{askOrder(1, "10apple", "30plum", true)},
+			bidOrders: []*Order{bidOrder(2, "10apple", "20plum", true)},
+		},
+		{
+			name:      "long side cannot be partially filled",
+			askOrders: []*Order{askOrder(1, "10apple", "10plum", false)},
+			bidOrders: []*Order{bidOrder(2, "5apple", "10plum", true)},
+		},
+		{
+			name:      "one ask, one bid: same assets",
+			askOrders: []*Order{askOrder(1, "10apple", "20plum", false)},
+			bidOrders: []*Order{bidOrder(2, "10apple", "30plum", false)},
+			expPrice:  "2.000000000000000000plum",
+			expAsks:   []*Order{askOrder(1, "10apple", "20plum", false)},
+			expBids:   []*Order{bidOrder(2, "10apple", "20plum", false)},
+		},
+		{
+			name: "three asks, three bids: highest volume",
+			askOrders: []*Order{
+				askOrder(1, "10apple", "10plum", false),
+				askOrder(2, "10apple", "30plum", false),
+				askOrder(3, "10apple", "50plum", false),
+			},
+			bidOrders: []*Order{
+				bidOrder(4, "10apple", "60plum", false),
+				bidOrder(5, "10apple", "40plum", false),
+				bidOrder(6, "10apple", "20plum", false),
+			},
+			expPrice: "3.000000000000000000plum",
+			expAsks: []*Order{
+				askOrder(1, "10apple", "30plum", false),
+				askOrder(2, "10apple", "30plum", false),
+			},
+			expBids: []*Order{
+				bidOrder(4, "10apple", "30plum", false),
+				bidOrder(5, "10apple", "30plum", false),
+			},
+		},
+		{
+			name:      "partial ask",
+			askOrders: []*Order{askOrder(1, "10apple", "10plum", true)},
+			bidOrders: []*Order{
+				bidOrder(2, "4apple", "20plum", false),
+				bidOrder(3, "4apple", "16plum", false),
+			},
+			expPrice:   "1.000000000000000000plum",
+			expAsks:    []*Order{askOrder(1, "8apple", "8plum", true)},
+			expBids:    []*Order{bidOrder(2, "4apple", "4plum", false), bidOrder(3, "4apple", "4plum", false)},
+			expPartial: askOrder(1, "2apple", "2plum", true),
+		},
+		{
+			name: "partial bid",
+			askOrders: []*Order{
+				askOrder(1, "3apple", "6plum", false),
+				askOrder(2, "2apple", "2plum", false),
+			},
+			bidOrders:  []*Order{bidOrder(3, "10apple", "30plum", true)},
+			expPrice:   "2.000000000000000000plum",
+			expAsks:    []*Order{askOrder(2, "2apple", "4plum", false), askOrder(1, "3apple", "6plum", false)},
+			expBids:    []*Order{bidOrder(3, "5apple", "10plum", true)},
+			expPartial: bidOrder(3, "5apple", "15plum", true),
+		},
+		{
+			name: "prices rounded in favor of each order",
+			askOrders: []*Order{
+				askOrder(1, "2apple", "5plum", false),
+				askOrder(2, "1apple", "2plum", false),
+			},
+			bidOrders: []*Order{bidOrder(3, "3apple", "9plum", false)},
+			expPrice:  "2.500000000000000000plum",
+			expAsks:   []*Order{askOrder(2, "1apple", "2plum", false), askOrder(1, "2apple", "5plum", false)},
+			expBids:   []*Order{bidOrder(3, "3apple", "8plum", false)},
+		},
+		{
+			name: "order too large to fill is skipped",
+			askOrders: []*Order{
+				askOrder(1, "6apple", "6plum", false),
+				askOrder(2, "4apple", "4plum", true),
+			},
+			bidOrders: []*Order{
+				bidOrder(3, "3apple", "9plum", false),
+				bidOrder(4, "2apple", "6plum", false),
+			},
+			expPrice:   "1.000000000000000000plum",
+			expAsks:    []*Order{askOrder(2, "3apple", "3plum", true)},
+			expBids:    []*Order{bidOrder(3, "3apple", "3plum", false)},
+			expPartial: askOrder(2, "1apple", "1plum", true),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual *BatchAuction
+			var err error
+			testFunc := func() {
+				actual, err = MatchBatchAuction(tc.askOrders, tc.bidOrders)
+			}
+			require.NotPanics(t, testFunc, "MatchBatchAuction")
+			assertions.AssertErrorValue(t, err, tc.expErr, "MatchBatchAuction error")
+			if len(tc.expAsks) == 0 || len(tc.expBids) == 0 {
+				assert.Nil(t, actual, "MatchBatchAuction result")
+				return
+			}
+			require.NotNil(t, actual, "MatchBatchAuction result")
+			assert.Equal(t, tc.expPrice, actual.ClearingPrice.String(), "ClearingPrice")
+			assertEqualSlice(t, tc.expAsks, actual.AskOrders, orderString, "AskOrders")
+			assertEqualSlice(t, tc.expBids, actual.BidOrders, orderString, "BidOrders")
+			assert.Equal(t, orderString(tc.expPartial), orderString(actual.PartialOrderLeft), "PartialOrderLeft")
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -19,6 +20,7 @@ import (
 	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 
 	"github.com/provenance-io/provenance/x/exchange"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
 )
 
 const (
@@ -32,13 +34,25 @@ const (
 	FlagAmount               = "amount"
 	FlagAsk                  = "ask"
 	FlagAskAdd               = "ask-add"
+	FlagAskCreationFee       = "ask-creation-fee"
+	FlagAskOrder             = "ask-order"
 	FlagAskRemove            = "ask-remove"
+	FlagAskSettlementFee     = "ask-settlement-fee"
 	FlagAsks                 = "asks"
+	FlagAssetDenoms          = "asset-denoms"
+	FlagAssetDenomsAdd       = "asset-denoms-add"
+	FlagAssetDenomsRemove    = "asset-denoms-remove"
 	FlagAssets               = "assets"
 	FlagAuthority            = "authority"
+	FlagBasisDenom           = "basis-denom"
+	FlagBasket               = "basket"
+	FlagBatchAuctionInterval = "batch-auction-interval"
 	FlagBid                  = "bid"
 	FlagBidAdd               = "bid-add"
+	FlagBidCreationFee       = "bid-creation-fee"
+	FlagBidOrder             = "bid-order"
 	FlagBidRemove            = "bid-remove"
+	FlagBidSettlementFee     = "bid-settlement-fee"
 	FlagBids                 = "bids"
 	FlagBips                 = "bips"
 	FlagBuyer                = "buyer"
@@ -50,35 +64,78 @@ const (
 	FlagBuyerRatiosRemove    = "buyer-ratios-remove"
 	FlagCommitmentAdd        = "commitment-add"
 	FlagCommitmentRemove     = "commitment-remove"
+	FlagContinuousMatching   = "continuous-matching"
 	FlagCreateAsk            = "create-ask"
 	FlagCreateBid            = "create-bid"
 	FlagCreateCommitment     = "create-commitment"
 	FlagCreationFee          = "creation-fee"
+	FlagCreator              = "creator"
 	FlagCurrentMarket        = "current-market"
 	FlagDefault              = "default"
+	FlagDeleteMarket         = "delete-market"
 	FlagDenom                = "denom"
+	FlagDepth                = "depth"
 	FlagDescription          = "description"
 	FlagDetails              = "details"
 	FlagDisable              = "disable"
+	FlagDisplay              = "display"
+	FlagDisputeWindow        = "dispute-window"
+	FlagEffectiveHeight      = "effective-height"
+	FlagEffectiveTime        = "effective-time"
 	FlagEnable               = "enable"
 	FlagEmptyExternalID      = "empty-external-id"
 	FlagExternalID           = "external-id"
+	FlagExpiration           = "expiration"
 	FlagExternalIDs          = "external-ids"
+	FlagExternalIDScope      = "external-id-scope"
 	FlagFile                 = "file"
+	FlagFillAlgorithm        = "fill-algorithm"
 	FlagGrant                = "grant"
 	FlagIcon                 = "icon"
+	FlagIdempotencyKey       = "idempotency-key"
 	FlagInputs               = "inputs"
+	FlagInterval             = "interval"
+	FlagLinkedOrder          = "linked-order"
 	FlagMarket               = "market"
+	FlagMax                  = "max"
+	FlagMaxAmount            = "max-amount"
+	FlagMaxExposure          = "max-exposure"
+	FlagMaxHeight            = "max-height"
+	FlagMaxOrders            = "max-orders"
+	FlagMaxTargetAmount      = "max-target-amount"
+	FlagMinAmount            = "min-amount"
+	FlagMinHeight            = "min-height"
 	FlagName                 = "name"
+	FlagNAVHistory           = "nav-history"
+	FlagNAVHistoryMax        = "nav-history-max"
 	FlagNavs                 = "navs"
+	FlagNewAuthority         = "new-authority"
 	FlagNewMarket            = "new-market"
 	FlagNewTarget            = "new-target"
 	FlagOrder                = "order"
 	FlagOutputs              = "outputs"
 	FlagOwner                = "owner"
 	FlagPartial              = "partial"
+	FlagParty                = "party"
+	FlagPaymentIDs           = "payment-ids"
+	FlagPrefix               = "prefix"
 	FlagPrice                = "price"
+	FlagPriceDenoms          = "price-denoms"
+	FlagPriceDenomsAdd       = "price-denoms-add"
+	FlagPriceDenomsRemove    = "price-denoms-remove"
 	FlagProposal             = "proposal"
+	FlagPublishPrices        = "publish-prices"
+	FlagRebateAddrs          = "rebate-addrs"
+	FlagRebateAddrsAdd       = "rebate-addrs-add"
+	FlagRebateAddrsRemove    = "rebate-addrs-remove"
+	FlagRebateRatios         = "rebate-ratios"
+	FlagRebateRatiosAdd      = "rebate-ratios-add"
+	FlagRebateRatiosRemove   = "rebate-ratios-remove"
+	FlagReferralBips         = "referral-bips"
+	FlagReferralCap          = "referral-cap"
+	FlagReferralCapAdd       = "referral-cap-add"
+	FlagReferralCapRemove    = "referral-cap-remove"
+	FlagReferrer             = "referrer"
 	FlagRelease              = "release"
 	FlagReleaseAll           = "release-all"
 	FlagReqAttrAsk           = "req-attr-ask"
@@ -93,19 +150,34 @@ const (
 	FlagSellerRatios         = "seller-ratios"
 	FlagSellerRatiosAdd      = "seller-ratios-add"
 	FlagSellerRatiosRemove   = "seller-ratios-remove"
+	FlagSelfTradePrevention  = "self-trade-prevention"
+	FlagSettlement           = "settlement"
 	FlagSettlementFee        = "settlement-fee"
 	FlagSettlementFees       = "settlement-fees"
+	FlagSettlementHistory    = "settlement-history"
 	FlagSigner               = "signer"
 	FlagSource               = "source"
 	FlagSources              = "sources"
 	FlagSourceAmount         = "source-amount"
 	FlagSplit                = "split"
+	FlagSplitRemove          = "split-remove"
+	FlagStartTime            = "start-time"
 	FlagTag                  = "tag"
+	FlagTakerRatios          = "taker-ratios"
+	FlagTakerRatiosAdd       = "taker-ratios-add"
+	FlagTakerRatiosRemove    = "taker-ratios-remove"
 	FlagTarget               = "target"
 	FlagTargetAmount         = "target-amount"
 	FlagTo                   = "to"
+	FlagTransfers            = "transfers"
+	FlagTriggerPrice         = "trigger-price"
 	FlagUnsetBips            = "unset-bips"
+	FlagUnsetReferralBips    = "unset-referral-bips"
 	FlagURL                  = "url"
+	FlagWindow               = "window"
+	FlagWindowBlocks         = "window-blocks"
+	FlagWithTargetAmount     = "with-target-amount"
+	FlagWithoutTargetAmount  = "without-target-amount"
 )
 
 // MarkFlagsRequired marks the provided flags as required and panics if there's a problem.
@@ -136,6 +208,20 @@ func AddFlagsAdminOpt(cmd *cobra.Command) {
 	cmd.MarkFlagsMutuallyExclusive(FlagAdmin, FlagAuthority)
 }
 
+// AddFlagsPaymentFilter adds the --denom, --min-amount, --max-amount, --with-target-amount,
+// and --without-target-amount flags to a command.
+//
+// Use ReadFlagsPaymentFilter to read these flags.
+func AddFlagsPaymentFilter(cmd *cobra.Command) {
+	cmd.Flags().String(FlagDenom, "", "Only include payments with this denom in their source amount")
+	cmd.Flags().String(FlagMinAmount, "", "Only include payments with at least this much of the --denom in their source amount")
+	cmd.Flags().String(FlagMaxAmount, "", "Only include payments with at most this much of the --denom in their source amount")
+	cmd.Flags().Bool(FlagWithTargetAmount, false, "Only include payments that have a target amount")
+	cmd.Flags().Bool(FlagWithoutTargetAmount, false, "Only include payments that do not have a target amount")
+
+	cmd.MarkFlagsMutuallyExclusive(FlagWithTargetAmount, FlagWithoutTargetAmount)
+}
+
 // ReadFlagsAdminOrFrom reads the --admin flag if provided.
 // If not, but the --authority flag was provided, the gov module account address is returned.
 // If no --admin or --authority flag was provided, returns the --from address.
@@ -347,6 +433,32 @@ func ReadFlagMarketOrArg(flagSet *pflag.FlagSet, args []string) (uint32, error)
 	return marketID, nil
 }
 
+// ReadFlagSettlementOrArg gets a required settlement id from either the --settlement flag or the first provided arg.
+// This assumes that the flag was defined with a default of 0.
+func ReadFlagSettlementOrArg(flagSet *pflag.FlagSet, args []string) (uint64, error) {
+	settlementID, err := flagSet.GetUint64(FlagSettlement)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(args) > 0 && len(args[0]) > 0 {
+		if settlementID != 0 {
+			return 0, fmt.Errorf("cannot provide <settlement id> as both an arg (%q) and flag (--%s %d)", args[0], FlagSettlement, settlementID)
+		}
+
+		settlementID, err = strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("could not convert <settlement id> arg: %w", err)
+		}
+	}
+
+	if settlementID == 0 {
+		return 0, errors.New("no <settlement id> provided")
+	}
+
+	return settlementID, nil
+}
+
 // ReadCoinsFlag reads a string flag and converts it into sdk.Coins.
 // If the flag wasn't provided, this returns nil, nil.
 //
@@ -435,6 +547,39 @@ func ReadReqCoinFlag(flagSet *pflag.FlagSet, name string) (sdk.Coin, error) {
 	return *rv, nil
 }
 
+// ReadReqAssetsFlag reads a string flag and converts it into the sdk.Coin for an order's assets.
+// The value can either be a coin string (e.g. 10nhash) or a scope id (e.g. scope1...).
+// A scope id is converted into that scope's coin (i.e. 1nft/<scope id>).
+// Returns an error if not provided.
+func ReadReqAssetsFlag(flagSet *pflag.FlagSet, name string) (sdk.Coin, error) {
+	value, err := flagSet.GetString(name)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if !strings.HasPrefix(value, metadatatypes.PrefixScope+"1") {
+		return ReadReqCoinFlag(flagSet, name)
+	}
+	scopeID, err := metadatatypes.MetadataAddressFromBech32(value)
+	if err != nil {
+		return sdk.Coin{}, fmt.Errorf("error parsing --%s as a scope id: %w", name, err)
+	}
+	return scopeID.Coin(), nil
+}
+
+// ReadTimeFlag reads a string flag and converts it into a *time.Time using the RFC3339 format.
+// If the flag wasn't provided, this returns nil, nil.
+func ReadTimeFlag(flagSet *pflag.FlagSet, name string) (*time.Time, error) {
+	value, err := flagSet.GetString(name)
+	if len(value) == 0 || err != nil {
+		return nil, err
+	}
+	rv, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing --%s as an RFC3339 time: %w", name, err)
+	}
+	return &rv, nil
+}
+
 // ReadOrderIDsFlag reads a UintSlice flag and converts it into a []uint64.
 func ReadOrderIDsFlag(flagSet *pflag.FlagSet, name string) ([]uint64, error) {
 	ids, err := flagSet.GetUintSlice(name)
@@ -461,11 +606,12 @@ func ReadAccessGrantsFlag(flagSet *pflag.FlagSet, name string, def []exchange.Ac
 // permSepRx is a regexp that matches characters that can be used to separate permissions.
 var permSepRx = regexp.MustCompile(`[ +.]`)
 
-// ParseAccessGrant parses an AccessGrant from a string with the format "<address>:<perm 1>[+<perm 2>...]".
+// ParseAccessGrant parses an AccessGrant from a string with the format "<address>:<perm 1>[+<perm 2>...][:<expiration>]".
+// The optional <expiration> is an RFC3339 time.
 func ParseAccessGrant(val string) (*exchange.AccessGrant, error) {
-	parts := strings.Split(val, ":")
-	if len(parts) != 2 {
-		return nil, fmt.Errorf("could not parse %q as an <access grant>: expected format <address>:<permissions>", val)
+	parts := strings.SplitN(val, ":", 3)
+	if len(parts) < 2 {
+		return nil, fmt.Errorf("could not parse %q as an <access grant>: expected format <address>:<permissions>[:<expiration>]", val)
 	}
 
 	addr := strings.TrimSpace(parts[0])
@@ -476,6 +622,14 @@ func ParseAccessGrant(val string) (*exchange.AccessGrant, error) {
 
 	rv := &exchange.AccessGrant{Address: addr}
 
+	if len(parts) == 3 {
+		exp, err := time.Parse(time.RFC3339, strings.TrimSpace(parts[2]))
+		if err != nil {
+			return nil, fmt.Errorf("could not parse expiration for %q from %q: %w", rv.Address, parts[2], err)
+		}
+		rv.Expiration = &exp
+	}
+
 	if perms == "all" {
 		rv.Permissions = exchange.AllPermissions()
 		return rv, nil
@@ -851,6 +1005,36 @@ func ReadFlagUint32OrDefault(flagSet *pflag.FlagSet, name string, def uint32) (u
 	return rv, nil
 }
 
+// ReadFlagInt64OrDefault gets an int64 flag or returns the provided default.
+// This assumes that the flag was defined with a default of 0.
+func ReadFlagInt64OrDefault(flagSet *pflag.FlagSet, name string, def int64) (int64, error) {
+	rv, err := flagSet.GetInt64(name)
+	if rv == 0 || err != nil {
+		return def, err
+	}
+	return rv, nil
+}
+
+// ReadFlagUint64OrDefault gets a uint64 flag or returns the provided default.
+// This assumes that the flag was defined with a default of 0.
+func ReadFlagUint64OrDefault(flagSet *pflag.FlagSet, name string, def uint64) (uint64, error) {
+	rv, err := flagSet.GetUint64(name)
+	if rv == 0 || err != nil {
+		return def, err
+	}
+	return rv, nil
+}
+
+// ReadFlagTimeOrDefault reads a string flag as an RFC3339 time (see ReadTimeFlag) or returns the provided default.
+// This assumes that the flag was defined with a default of "".
+func ReadFlagTimeOrDefault(flagSet *pflag.FlagSet, name string, def *time.Time) (*time.Time, error) {
+	rv, err := ReadTimeFlag(flagSet, name)
+	if rv == nil || err != nil {
+		return def, err
+	}
+	return rv, nil
+}
+
 // ReadFlagBoolOrDefault gets a bool flag or returns the provided default.
 // This assumes that the flag was defined with a default of false (it actually just ignores that default).
 func ReadFlagBoolOrDefault(flagSet *pflag.FlagSet, name string, def bool) (bool, error) {
@@ -894,6 +1078,117 @@ func ReadFlagStringOrDefault(flagSet *pflag.FlagSet, name string, def string) (s
 	return rv, nil
 }
 
+// ReadFlagSelfTradePreventionOrDefault gets a self-trade prevention flag or returns the provided default.
+// This assumes that the flag was defined as a string with a default of "".
+func ReadFlagSelfTradePreventionOrDefault(flagSet *pflag.FlagSet, name string, def exchange.SelfTradePrevention) (exchange.SelfTradePrevention, error) {
+	str, err := flagSet.GetString(name)
+	if len(str) == 0 || err != nil {
+		return def, err
+	}
+	rv, err := exchange.ParseSelfTradePrevention(str)
+	if err != nil {
+		return def, err
+	}
+	return rv, nil
+}
+
+// ReadFlagExternalIDScopeOrDefault gets an external id scope flag or returns the provided default.
+// This assumes that the flag was defined as a string with a default of "".
+func ReadFlagExternalIDScopeOrDefault(flagSet *pflag.FlagSet, name string, def exchange.ExternalIDScope) (exchange.ExternalIDScope, error) {
+	str, err := flagSet.GetString(name)
+	if len(str) == 0 || err != nil {
+		return def, err
+	}
+	rv, err := exchange.ParseExternalIDScope(str)
+	if err != nil {
+		return def, err
+	}
+	return rv, nil
+}
+
+// ReadFlagsOrderRateLimitOrDefault reads the --max-orders and --window-blocks flags and creates an OrderRateLimit.
+// If neither flag was provided, the default is returned. If the max orders is zero, nil is returned.
+// This assumes that both flags were defined as uint32s.
+func ReadFlagsOrderRateLimitOrDefault(flagSet *pflag.FlagSet, def *exchange.OrderRateLimit) (*exchange.OrderRateLimit, error) {
+	if !flagSet.Changed(FlagMaxOrders) && !flagSet.Changed(FlagWindowBlocks) {
+		return def, nil
+	}
+
+	maxOrders, err := flagSet.GetUint32(FlagMaxOrders)
+	if err != nil {
+		return def, err
+	}
+	windowBlocks, err := flagSet.GetUint32(FlagWindowBlocks)
+	if err != nil {
+		return def, err
+	}
+	if maxOrders == 0 {
+		return nil, nil
+	}
+	return &exchange.OrderRateLimit{MaxOrders: maxOrders, WindowBlocks: windowBlocks}, nil
+}
+
+// ReadFlagMaxExposureOrDefault reads the --max-exposure flag as a coin.
+// If the flag was not provided, the default is returned. If it has a zero amount, nil is returned.
+func ReadFlagMaxExposureOrDefault(flagSet *pflag.FlagSet, def *sdk.Coin) (*sdk.Coin, error) {
+	if !flagSet.Changed(FlagMaxExposure) {
+		return def, nil
+	}
+	rv, err := ReadCoinFlag(flagSet, FlagMaxExposure)
+	if err != nil {
+		return def, err
+	}
+	if rv != nil && rv.IsZero() {
+		return nil, nil
+	}
+	return rv, nil
+}
+
+// ReadFlagFillAlgorithmOrDefault gets a fill algorithm flag or returns the provided default.
+// This assumes that the flag was defined as a string with a default of "".
+func ReadFlagFillAlgorithmOrDefault(flagSet *pflag.FlagSet, name string, def exchange.FillAlgorithm) (exchange.FillAlgorithm, error) {
+	str, err := flagSet.GetString(name)
+	if len(str) == 0 || err != nil {
+		return def, err
+	}
+	rv, err := exchange.ParseFillAlgorithm(str)
+	if err != nil {
+		return def, err
+	}
+	return rv, nil
+}
+
+// ReadFlagsPaymentFilter reads the flags added by AddFlagsPaymentFilter and creates a PaymentFilter.
+// Returns nil if none of those flags were provided.
+func ReadFlagsPaymentFilter(flagSet *pflag.FlagSet) (*exchange.PaymentFilter, error) {
+	rv := &exchange.PaymentFilter{}
+
+	errs := make([]error, 5, 6)
+	var withTgt, withoutTgt bool
+	rv.Denom, errs[0] = flagSet.GetString(FlagDenom)
+	rv.MinAmount, errs[1] = flagSet.GetString(FlagMinAmount)
+	rv.MaxAmount, errs[2] = flagSet.GetString(FlagMaxAmount)
+	withTgt, errs[3] = flagSet.GetBool(FlagWithTargetAmount)
+	withoutTgt, errs[4] = flagSet.GetBool(FlagWithoutTargetAmount)
+
+	switch {
+	case withTgt && withoutTgt:
+		errs = append(errs, fmt.Errorf("cannot provide both --%s and --%s", FlagWithTargetAmount, FlagWithoutTargetAmount))
+	case withTgt:
+		rv.TargetAmount = exchange.TargetAmountFilter_present
+	case withoutTgt:
+		rv.TargetAmount = exchange.TargetAmountFilter_absent
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	if rv.IsEmpty() {
+		return nil, nil
+	}
+	return rv, nil
+}
+
 // ParseAccountAmount parses an AccountAmount from the provided string with the format "<account>:<amount>".
 func ParseAccountAmount(val string) (*exchange.AccountAmount, error) {
 	parts := strings.Split(val, ":")
@@ -961,6 +1256,75 @@ func ReadFlagAccountAmountsOrDefault(flagSet *pflag.FlagSet, name string, def []
 	return rv, nil
 }
 
+// ReadFlagsPaymentParties reads the StringSlice flags with the provided names and converts them into payment parties.
+// Each sendsName entry is an account-amount that the account will send, and each receivesName entry is an
+// account-amount that the account will receive. The parties are in the order their accounts are first seen.
+// This assumes that the flags were defined with a default of nil or []string{}.
+func ReadFlagsPaymentParties(flagSet *pflag.FlagSet, sendsName, receivesName string) ([]exchange.PaymentParty, error) {
+	sends, err := ReadFlagAccountAmounts(flagSet, sendsName)
+	if err != nil {
+		return nil, err
+	}
+	receives, err := ReadFlagAccountAmounts(flagSet, receivesName)
+	if err != nil {
+		return nil, err
+	}
+
+	var rv []exchange.PaymentParty
+	getParty := func(addr string) *exchange.PaymentParty {
+		for i := range rv {
+			if rv[i].Address == addr {
+				return &rv[i]
+			}
+		}
+		rv = append(rv, exchange.PaymentParty{Address: addr})
+		return &rv[len(rv)-1]
+	}
+	for _, send := range sends {
+		party := getParty(send.Account)
+		party.SendAmount = party.SendAmount.Add(send.Amount...)
+	}
+	for _, receive := range receives {
+		party := getParty(receive.Account)
+		party.ReceiveAmount = party.ReceiveAmount.Add(receive.Amount...)
+	}
+
+	return rv, nil
+}
+
+// ParsePaymentID parses a PaymentID from a string with the format <source>:<external id>.
+// The external id is everything after the first colon, and is empty if there is no colon.
+func ParsePaymentID(val string) (*exchange.PaymentID, error) {
+	source, externalID, _ := strings.Cut(val, ":")
+	source = strings.TrimSpace(source)
+	if len(source) == 0 {
+		return nil, fmt.Errorf("invalid payment id %q: expected format <source>:<external id>", val)
+	}
+	return &exchange.PaymentID{Source: source, ExternalId: externalID}, nil
+}
+
+// ReadFlagPaymentIDs reads a StringArray flag and converts it into a slice of exchange.PaymentID.
+// A StringArray is used (instead of a StringSlice) so that external ids can contain commas.
+// This assumes that the flag was defined with a default of nil or []string{}.
+func ReadFlagPaymentIDs(flagSet *pflag.FlagSet, name string) ([]exchange.PaymentID, error) {
+	vals, err := flagSet.GetStringArray(name)
+	if len(vals) == 0 || err != nil {
+		return nil, err
+	}
+
+	var errs []error
+	rv := make([]exchange.PaymentID, 0, len(vals))
+	for _, val := range vals {
+		entry, err := ParsePaymentID(val)
+		if err != nil {
+			errs = append(errs, err)
+		} else {
+			rv = append(rv, *entry)
+		}
+	}
+	return rv, errors.Join(errs...)
+}
+
 // ReadFlagAccountsWithoutAmounts reads a StringSlice flag and converts it into a slice of exchange.AccountAmount
 // with only the Account field populated using the values provided with the flag.
 // This assumes that the flag was defined with a default of nil or []string{}.
@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -987,6 +988,97 @@ func TestReadFlagMarketOrArg(t *testing.T) {
 	}
 }
 
+func TestReadFlagSettlementOrArg(t *testing.T) {
+	theFlag := cli.FlagSettlement
+	goodFlagSet := func() *pflag.FlagSet {
+		flagSet := pflag.NewFlagSet("", pflag.ContinueOnError)
+		flagSet.Uint64(theFlag, 0, "The id")
+		return flagSet
+	}
+	badFlagSet := func() *pflag.FlagSet {
+		flagSet := pflag.NewFlagSet("", pflag.ContinueOnError)
+		flagSet.String(theFlag, "", "The id")
+		return flagSet
+	}
+
+	tests := []struct {
+		name    string
+		flags   []string
+		flagSet *pflag.FlagSet
+		args    []string
+		expID   uint64
+		expErr  string
+	}{
+		{
+			name:    "unknown flag",
+			flagSet: pflag.NewFlagSet("", pflag.ContinueOnError),
+			expErr:  "flag accessed but not defined: " + theFlag,
+		},
+		{
+			name:    "wrong flag type",
+			flagSet: badFlagSet(),
+			expErr:  "trying to get uint64 value of flag of type string",
+		},
+		{
+			name:    "both flag and arg",
+			flags:   []string{"--" + theFlag, "8"},
+			flagSet: goodFlagSet(),
+			args:    []string{"8"},
+			expErr:  "cannot provide <settlement id> as both an arg (\"8\") and flag (--settlement 8)",
+		},
+		{
+			name:    "just flag",
+			flags:   []string{"--" + theFlag, "8"},
+			flagSet: goodFlagSet(),
+			expID:   8,
+		},
+		{
+			name:    "just flag zero",
+			flags:   []string{"--" + theFlag, "0"},
+			flagSet: goodFlagSet(),
+			expErr:  "no <settlement id> provided",
+		},
+		{
+			name:    "just arg, bad",
+			flagSet: goodFlagSet(),
+			args:    []string{"8v8"},
+			expErr:  "could not convert <settlement id> arg: strconv.ParseUint: parsing \"8v8\": invalid syntax",
+		},
+		{
+			name:    "just arg, zero",
+			flagSet: goodFlagSet(),
+			args:    []string{"0"},
+			expErr:  "no <settlement id> provided",
+		},
+		{
+			name:    "just arg, good",
+			flagSet: goodFlagSet(),
+			args:    []string{"987"},
+			expID:   987,
+		},
+		{
+			name:    "neither flag nor arg",
+			flagSet: goodFlagSet(),
+			expErr:  "no <settlement id> provided",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.flagSet.Parse(tc.flags)
+			require.NoError(t, err, "flagSet.Parse(%q)", tc.flags)
+
+			var id uint64
+			testFunc := func() {
+				id, err = cli.ReadFlagSettlementOrArg(tc.flagSet, tc.args)
+			}
+			require.NotPanics(t, testFunc, "ReadFlagSettlementOrArg")
+			assertions.AssertErrorValue(t, err, tc.expErr, "ReadFlagSettlementOrArg error")
+			assert.Equal(t, int(tc.expID), int(id), "ReadFlagSettlementOrArg id")
+		})
+	}
+}
+
 func TestReadCoinsFlag(t *testing.T) {
 	tests := []struct {
 		testName string
@@ -1418,6 +1510,150 @@ func TestReadReqCoinFlag(t *testing.T) {
 	}
 }
 
+func TestReadReqAssetsFlag(t *testing.T) {
+	tests := []struct {
+		testName string
+		flags    []string
+		name     string
+		expCoin  sdk.Coin
+		expErr   string
+	}{
+		{
+			testName: "unknown flag",
+			name:     "unknown",
+			expErr:   "flag accessed but not defined: unknown",
+		},
+		{
+			testName: "wrong flag type",
+			name:     flagInt,
+			expErr:   "trying to get string value of flag of type int",
+		},
+		{
+			testName: "nothing provided",
+			name:     flagString,
+			expErr:   "missing required --" + flagString + " flag",
+		},
+		{
+			testName: "invalid coin",
+			flags:    []string{"--" + flagString, "nopecoin"},
+			name:     flagString,
+			expErr:   "error parsing --" + flagString + " as a coin: invalid coin expression: \"nopecoin\"",
+		},
+		{
+			testName: "normal coin",
+			flags:    []string{"--" + flagString, "99banana"},
+			name:     flagString,
+			expCoin:  sdk.Coin{Denom: "banana", Amount: sdkmath.NewInt(99)},
+		},
+		{
+			testName: "scope coin",
+			flags:    []string{"--" + flagString, "1nft/scope1qzxcpvj6czy5g354dews3nlruxjsahhnsp"},
+			name:     flagString,
+			expCoin:  sdk.Coin{Denom: "nft/scope1qzxcpvj6czy5g354dews3nlruxjsahhnsp", Amount: sdkmath.NewInt(1)},
+		},
+		{
+			testName: "invalid scope id",
+			flags:    []string{"--" + flagString, "scope1qzxcpvj6czy5g354dews3nlruxjsahh"},
+			name:     flagString,
+			expErr:   "error parsing --" + flagString + " as a scope id: decoding bech32 failed: invalid checksum (expected 57e9fl got xjsahh)",
+		},
+		{
+			testName: "scope id",
+			flags:    []string{"--" + flagString, "scope1qzxcpvj6czy5g354dews3nlruxjsahhnsp"},
+			name:     flagString,
+			expCoin:  sdk.Coin{Denom: "nft/scope1qzxcpvj6czy5g354dews3nlruxjsahhnsp", Amount: sdkmath.NewInt(1)},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.testName, func(t *testing.T) {
+			flagSet := pflag.NewFlagSet("", pflag.ContinueOnError)
+			flagSet.String(flagString, "", "A string")
+			flagSet.Int(flagInt, 0, "An int")
+			err := flagSet.Parse(tc.flags)
+			require.NoError(t, err, "flagSet.Parse(%q)", tc.flags)
+
+			var coin sdk.Coin
+			testFunc := func() {
+				coin, err = cli.ReadReqAssetsFlag(flagSet, tc.name)
+			}
+			require.NotPanics(t, testFunc, "ReadReqAssetsFlag(%q)", tc.name)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ReadReqAssetsFlag(%q) error", tc.name)
+			assert.Equal(t, tc.expCoin.String(), coin.String(), "ReadReqAssetsFlag(%q)", tc.name)
+		})
+	}
+}
+
+func TestReadTimeFlag(t *testing.T) {
+	utcTime := time.Date(2030, 1, 2, 15, 4, 5, 0, time.UTC)
+	offsetTime := time.Date(2030, 1, 2, 21, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		testName string
+		flags    []string
+		name     string
+		expTime  *time.Time
+		expErr   string
+	}{
+		{
+			testName: "unknown flag",
+			name:     "unknown",
+			expErr:   "flag accessed but not defined: unknown",
+		},
+		{
+			testName: "wrong flag type",
+			name:     flagInt,
+			expErr:   "trying to get string value of flag of type int",
+		},
+		{
+			testName: "nothing provided",
+			name:     flagString,
+			expErr:   "",
+		},
+		{
+			testName: "invalid time",
+			flags:    []string{"--" + flagString, "2030-01-02"},
+			name:     flagString,
+			expErr: "error parsing --" + flagString + " as an RFC3339 time: " +
+				"parsing time \"2030-01-02\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"\" as \"T\"",
+		},
+		{
+			testName: "utc time",
+			flags:    []string{"--" + flagString, "2030-01-02T15:04:05Z"},
+			name:     flagString,
+			expTime:  &utcTime,
+		},
+		{
+			testName: "time with offset",
+			flags:    []string{"--" + flagString, "2030-01-02T15:04:05-06:00"},
+			name:     flagString,
+			expTime:  &offsetTime,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.testName, func(t *testing.T) {
+			flagSet := pflag.NewFlagSet("", pflag.ContinueOnError)
+			flagSet.String(flagString, "", "A string")
+			flagSet.Int(flagInt, 0, "An int")
+			err := flagSet.Parse(tc.flags)
+			require.NoError(t, err, "flagSet.Parse(%q)", tc.flags)
+
+			var actual *time.Time
+			testFunc := func() {
+				actual, err = cli.ReadTimeFlag(flagSet, tc.name)
+			}
+			require.NotPanics(t, testFunc, "ReadTimeFlag(%q)", tc.name)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ReadTimeFlag(%q) error", tc.name)
+			if tc.expTime == nil {
+				assert.Nil(t, actual, "ReadTimeFlag(%q)", tc.name)
+			} else if assert.NotNil(t, actual, "ReadTimeFlag(%q)", tc.name) {
+				assert.Equal(t, tc.expTime.UTC(), actual.UTC(), "ReadTimeFlag(%q)", tc.name)
+			}
+		})
+	}
+}
+
 func TestReadOrderIDsFlag(t *testing.T) {
 	tests := []struct {
 		testName string
@@ -1526,7 +1762,7 @@ func TestReadAccessGrantsFlag(t *testing.T) {
 					Permissions: []exchange.Permission{exchange.Permission_set_ids, exchange.Permission_update},
 				},
 			},
-			expErr: "could not parse \"withdraw\" as an <access grant>: expected format <address>:<permissions>",
+			expErr: "could not parse \"withdraw\" as an <access grant>: expected format <address>:<permissions>[:<expiration>]",
 		},
 	}
 
@@ -1551,6 +1787,7 @@ func TestReadAccessGrantsFlag(t *testing.T) {
 
 func TestParseAccessGrant(t *testing.T) {
 	addr := "pb1v9jxgujlta047h6lta047h6lta047h6l5rpeqp" // = sdk.AccAddress("addr________________")
+	expiration := time.Date(2030, 1, 2, 15, 4, 5, 0, time.UTC)
 
 	tests := []struct {
 		name   string
@@ -1562,17 +1799,17 @@ func TestParseAccessGrant(t *testing.T) {
 			name:   "empty string",
 			val:    "",
 			expAG:  nil,
-			expErr: "could not parse \"\" as an <access grant>: expected format <address>:<permissions>",
+			expErr: "could not parse \"\" as an <access grant>: expected format <address>:<permissions>[:<expiration>]",
 		},
 		{
 			name:   "zero colons",
 			val:    "something",
-			expErr: "could not parse \"something\" as an <access grant>: expected format <address>:<permissions>",
+			expErr: "could not parse \"something\" as an <access grant>: expected format <address>:<permissions>[:<expiration>]",
 		},
 		{
-			name:   "two colons",
+			name:   "bad expiration",
 			val:    "part0:part1:part2",
-			expErr: "could not parse \"part0:part1:part2\" as an <access grant>: expected format <address>:<permissions>",
+			expErr: "could not parse expiration for \"part0\" from \"part2\": parsing time \"part2\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"part2\" as \"2006\"",
 		},
 		{
 			name:   "empty address",
@@ -1634,6 +1871,15 @@ func TestParseAccessGrant(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "with expiration",
+			val:  addr + ":settle+cancel:2030-01-02T15:04:05Z",
+			expAG: &exchange.AccessGrant{
+				Address:     addr,
+				Permissions: []exchange.Permission{exchange.Permission_settle, exchange.Permission_cancel},
+				Expiration:  &expiration,
+			},
+		},
 		{
 			name: "multiple perms, space delim",
 			val:  addr + ":Set_Ids update settle permissions",
@@ -1687,7 +1933,7 @@ func TestParseAccessGrants(t *testing.T) {
 		{
 			name:   "one, bad",
 			vals:   []string{"not good"},
-			expErr: "could not parse \"not good\" as an <access grant>: expected format <address>:<permissions>",
+			expErr: "could not parse \"not good\" as an <access grant>: expected format <address>:<permissions>[:<expiration>]",
 		},
 		{
 			name: "one, good",
@@ -3447,50 +3693,228 @@ func TestReadFlagUint32OrDefault(t *testing.T) {
 	}
 }
 
-func TestReadFlagBoolOrDefault(t *testing.T) {
+func TestReadFlagInt64OrDefault(t *testing.T) {
+	flagInt64 := "int64"
 	tests := []struct {
 		testName string
 		flags    []string
-		name     string // defaults to flagBool.
-		def      bool
-		exp      bool
+		name     string // defaults to flagInt64.
+		def      int64
+		exp      int64
 		expErr   string
 	}{
 		{
-			testName: "error getting flag, true default",
+			testName: "error getting flag",
 			flags:    []string{"--" + flagString, "what"},
 			name:     flagString,
-			def:      true,
-			exp:      true,
-			expErr:   "trying to get bool value of flag of type string",
+			def:      3,
+			exp:      3,
+			expErr:   "trying to get int64 value of flag of type string",
 		},
 		{
-			testName: "error getting flag, false default",
-			flags:    []string{"--" + flagString, "what"},
-			name:     flagString,
-			def:      false,
-			exp:      false,
-			expErr:   "trying to get bool value of flag of type string",
+			testName: "not provided, 0 default",
+			def:      0,
+			exp:      0,
 		},
 		{
-			testName: "not provided, false default",
-			def:      false,
-			exp:      false,
+			testName: "not provided, other default",
+			def:      18,
+			exp:      18,
 		},
 		{
-			testName: "not provided, true default",
-			def:      true,
-			exp:      true,
+			testName: "provided",
+			flags:    []string{"--" + flagInt64, "43"},
+			def:      100,
+			exp:      43,
 		},
 		{
-			testName: "provided false, true default",
-			flags:    []string{"--" + flagBool + "=false"},
-			def:      true,
-			exp:      false,
+			testName: "provided negative",
+			flags:    []string{"--" + flagInt64, "-7"},
+			def:      100,
+			exp:      -7,
 		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.testName, func(t *testing.T) {
+			if len(tc.name) == 0 {
+				tc.name = flagInt64
+			}
+
+			flagSet := pflag.NewFlagSet("", pflag.ContinueOnError)
+			flagSet.Int64(flagInt64, 0, "An int64")
+			flagSet.String(flagString, "", "A string")
+			err := flagSet.Parse(tc.flags)
+			require.NoError(t, err, "flagSet.Parse(%q)", tc.flags)
+
+			var act int64
+			testFunc := func() {
+				act, err = cli.ReadFlagInt64OrDefault(flagSet, tc.name, tc.def)
+			}
+			require.NotPanics(t, testFunc, "ReadFlagInt64OrDefault")
+			assertions.AssertErrorValue(t, err, tc.expErr, "ReadFlagInt64OrDefault error")
+			assert.Equal(t, tc.exp, act, "ReadFlagInt64OrDefault result")
+		})
+	}
+}
+
+func TestReadFlagUint64OrDefault(t *testing.T) {
+	flagUint64 := "uint64"
+	tests := []struct {
+		testName string
+		flags    []string
+		name     string // defaults to flagUint64.
+		def      uint64
+		exp      uint64
+		expErr   string
+	}{
 		{
-			testName: "provided false, false default",
-			flags:    []string{"--" + flagBool + "=false"},
+			testName: "error getting flag",
+			flags:    []string{"--" + flagString, "what"},
+			name:     flagString,
+			def:      3,
+			exp:      3,
+			expErr:   "trying to get uint64 value of flag of type string",
+		},
+		{
+			testName: "not provided, 0 default",
+			def:      0,
+			exp:      0,
+		},
+		{
+			testName: "not provided, other default",
+			def:      18,
+			exp:      18,
+		},
+		{
+			testName: "provided",
+			flags:    []string{"--" + flagUint64, "43"},
+			def:      100,
+			exp:      43,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.testName, func(t *testing.T) {
+			if len(tc.name) == 0 {
+				tc.name = flagUint64
+			}
+
+			flagSet := pflag.NewFlagSet("", pflag.ContinueOnError)
+			flagSet.Uint64(flagUint64, 0, "A uint64")
+			flagSet.String(flagString, "", "A string")
+			err := flagSet.Parse(tc.flags)
+			require.NoError(t, err, "flagSet.Parse(%q)", tc.flags)
+
+			var act uint64
+			testFunc := func() {
+				act, err = cli.ReadFlagUint64OrDefault(flagSet, tc.name, tc.def)
+			}
+			require.NotPanics(t, testFunc, "ReadFlagUint64OrDefault")
+			assertions.AssertErrorValue(t, err, tc.expErr, "ReadFlagUint64OrDefault error")
+			assert.Equal(t, tc.exp, act, "ReadFlagUint64OrDefault result")
+		})
+	}
+}
+
+func TestReadFlagTimeOrDefault(t *testing.T) {
+	defTime := time.Date(2029, 5, 6, 7, 8, 9, 0, time.UTC)
+	flagTime := time.Date(2030, 1, 2, 15, 4, 5, 0, time.UTC)
+	tests := []struct {
+		testName string
+		flags    []string
+		def      *time.Time
+		exp      *time.Time
+		expErr   string
+	}{
+		{
+			testName: "invalid value",
+			flags:    []string{"--" + flagString, "what"},
+			def:      &defTime,
+			exp:      &defTime,
+			expErr: "error parsing --" + flagString + " as an RFC3339 time: " +
+				"parsing time \"what\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"what\" as \"2006\"",
+		},
+		{
+			testName: "not provided, nil default",
+			def:      nil,
+			exp:      nil,
+		},
+		{
+			testName: "not provided, other default",
+			def:      &defTime,
+			exp:      &defTime,
+		},
+		{
+			testName: "provided",
+			flags:    []string{"--" + flagString, "2030-01-02T15:04:05Z"},
+			def:      &defTime,
+			exp:      &flagTime,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.testName, func(t *testing.T) {
+			flagSet := pflag.NewFlagSet("", pflag.ContinueOnError)
+			flagSet.String(flagString, "", "A string")
+			err := flagSet.Parse(tc.flags)
+			require.NoError(t, err, "flagSet.Parse(%q)", tc.flags)
+
+			var act *time.Time
+			testFunc := func() {
+				act, err = cli.ReadFlagTimeOrDefault(flagSet, flagString, tc.def)
+			}
+			require.NotPanics(t, testFunc, "ReadFlagTimeOrDefault")
+			assertions.AssertErrorValue(t, err, tc.expErr, "ReadFlagTimeOrDefault error")
+			assert.Equal(t, tc.exp, act, "ReadFlagTimeOrDefault result")
+		})
+	}
+}
+
+func TestReadFlagBoolOrDefault(t *testing.T) {
+	tests := []struct {
+		testName string
+		flags    []string
+		name     string // defaults to flagBool.
+		def      bool
+		exp      bool
+		expErr   string
+	}{
+		{
+			testName: "error getting flag, true default",
+			flags:    []string{"--" + flagString, "what"},
+			name:     flagString,
+			def:      true,
+			exp:      true,
+			expErr:   "trying to get bool value of flag of type string",
+		},
+		{
+			testName: "error getting flag, false default",
+			flags:    []string{"--" + flagString, "what"},
+			name:     flagString,
+			def:      false,
+			exp:      false,
+			expErr:   "trying to get bool value of flag of type string",
+		},
+		{
+			testName: "not provided, false default",
+			def:      false,
+			exp:      false,
+		},
+		{
+			testName: "not provided, true default",
+			def:      true,
+			exp:      true,
+		},
+		{
+			testName: "provided false, true default",
+			flags:    []string{"--" + flagBool + "=false"},
+			def:      true,
+			exp:      false,
+		},
+		{
+			testName: "provided false, false default",
+			flags:    []string{"--" + flagBool + "=false"},
 			def:      false,
 			exp:      false,
 		},
@@ -3664,6 +4088,286 @@ func TestReadFlagStringOrDefault(t *testing.T) {
 	}
 }
 
+func TestReadFlagSelfTradePreventionOrDefault(t *testing.T) {
+	tests := []struct {
+		testName string
+		flags    []string
+		name     string // defaults to flagString.
+		def      exchange.SelfTradePrevention
+		exp      exchange.SelfTradePrevention
+		expErr   string
+	}{
+		{
+			testName: "error getting flag",
+			flags:    []string{"--" + flagInt, "7"},
+			name:     flagInt,
+			def:      exchange.SelfTradePrevention_cancel_oldest,
+			exp:      exchange.SelfTradePrevention_cancel_oldest,
+			expErr:   "trying to get string value of flag of type int",
+		},
+		{
+			testName: "not provided, unspecified default",
+			def:      exchange.SelfTradePrevention_unspecified,
+			exp:      exchange.SelfTradePrevention_unspecified,
+		},
+		{
+			testName: "not provided, other default",
+			def:      exchange.SelfTradePrevention_decrement_both,
+			exp:      exchange.SelfTradePrevention_decrement_both,
+		},
+		{
+			testName: "provided, invalid",
+			flags:    []string{"--" + flagString, "cancel-all"},
+			def:      exchange.SelfTradePrevention_cancel_newest,
+			exp:      exchange.SelfTradePrevention_cancel_newest,
+			expErr:   "invalid self-trade prevention: \"cancel-all\"",
+		},
+		{
+			testName: "provided, simple",
+			flags:    []string{"--" + flagString, "cancel-newest"},
+			def:      exchange.SelfTradePrevention_decrement_both,
+			exp:      exchange.SelfTradePrevention_cancel_newest,
+		},
+		{
+			testName: "provided, none",
+			flags:    []string{"--" + flagString, "none"},
+			def:      exchange.SelfTradePrevention_decrement_both,
+			exp:      exchange.SelfTradePrevention_unspecified,
+		},
+		{
+			testName: "provided, full enum name",
+			flags:    []string{"--" + flagString, "SELF_TRADE_PREVENTION_DECREMENT_BOTH"},
+			def:      exchange.SelfTradePrevention_unspecified,
+			exp:      exchange.SelfTradePrevention_decrement_both,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.testName, func(t *testing.T) {
+			if len(tc.name) == 0 {
+				tc.name = flagString
+			}
+
+			flagSet := pflag.NewFlagSet("", pflag.ContinueOnError)
+			flagSet.String(flagString, "", "A string")
+			flagSet.Int(flagInt, 0, "A uint32")
+			err := flagSet.Parse(tc.flags)
+			require.NoError(t, err, "flagSet.Parse(%q)", tc.flags)
+
+			var act exchange.SelfTradePrevention
+			testFunc := func() {
+				act, err = cli.ReadFlagSelfTradePreventionOrDefault(flagSet, tc.name, tc.def)
+			}
+			require.NotPanics(t, testFunc, "ReadFlagSelfTradePreventionOrDefault")
+			assertions.AssertErrorValue(t, err, tc.expErr, "ReadFlagSelfTradePreventionOrDefault error")
+			assert.Equal(t, tc.exp.String(), act.String(), "ReadFlagSelfTradePreventionOrDefault result")
+		})
+	}
+}
+
+func TestReadFlagFillAlgorithmOrDefault(t *testing.T) {
+	tests := []struct {
+		testName string
+		flags    []string
+		name     string // defaults to flagString.
+		def      exchange.FillAlgorithm
+		exp      exchange.FillAlgorithm
+		expErr   string
+	}{
+		{
+			testName: "error getting flag",
+			flags:    []string{"--" + flagInt, "7"},
+			name:     flagInt,
+			def:      exchange.FillAlgorithm_pro_rata,
+			exp:      exchange.FillAlgorithm_pro_rata,
+			expErr:   "trying to get string value of flag of type int",
+		},
+		{
+			testName: "not provided, unspecified default",
+			def:      exchange.FillAlgorithm_unspecified,
+			exp:      exchange.FillAlgorithm_unspecified,
+		},
+		{
+			testName: "not provided, other default",
+			def:      exchange.FillAlgorithm_price_time,
+			exp:      exchange.FillAlgorithm_price_time,
+		},
+		{
+			testName: "provided, invalid",
+			flags:    []string{"--" + flagString, "fifo"},
+			def:      exchange.FillAlgorithm_price_time,
+			exp:      exchange.FillAlgorithm_price_time,
+			expErr:   "invalid fill algorithm: \"fifo\"",
+		},
+		{
+			testName: "provided, simple",
+			flags:    []string{"--" + flagString, "pro-rata"},
+			def:      exchange.FillAlgorithm_price_time,
+			exp:      exchange.FillAlgorithm_pro_rata,
+		},
+		{
+			testName: "provided, unspecified",
+			flags:    []string{"--" + flagString, "unspecified"},
+			def:      exchange.FillAlgorithm_pro_rata,
+			exp:      exchange.FillAlgorithm_unspecified,
+		},
+		{
+			testName: "provided, full enum name",
+			flags:    []string{"--" + flagString, "FILL_ALGORITHM_PRICE_TIME"},
+			def:      exchange.FillAlgorithm_unspecified,
+			exp:      exchange.FillAlgorithm_price_time,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.testName, func(t *testing.T) {
+			if len(tc.name) == 0 {
+				tc.name = flagString
+			}
+
+			flagSet := pflag.NewFlagSet("", pflag.ContinueOnError)
+			flagSet.String(flagString, "", "A string")
+			flagSet.Int(flagInt, 0, "A uint32")
+			err := flagSet.Parse(tc.flags)
+			require.NoError(t, err, "flagSet.Parse(%q)", tc.flags)
+
+			var act exchange.FillAlgorithm
+			testFunc := func() {
+				act, err = cli.ReadFlagFillAlgorithmOrDefault(flagSet, tc.name, tc.def)
+			}
+			require.NotPanics(t, testFunc, "ReadFlagFillAlgorithmOrDefault")
+			assertions.AssertErrorValue(t, err, tc.expErr, "ReadFlagFillAlgorithmOrDefault error")
+			assert.Equal(t, tc.exp.String(), act.String(), "ReadFlagFillAlgorithmOrDefault result")
+		})
+	}
+}
+
+func TestReadFlagsOrderRateLimitOrDefault(t *testing.T) {
+	tests := []struct {
+		name   string
+		flags  []string
+		def    *exchange.OrderRateLimit
+		exp    *exchange.OrderRateLimit
+		expErr string
+	}{
+		{
+			name: "not provided, nil default",
+			def:  nil,
+			exp:  nil,
+		},
+		{
+			name: "not provided, other default",
+			def:  &exchange.OrderRateLimit{MaxOrders: 3, WindowBlocks: 8},
+			exp:  &exchange.OrderRateLimit{MaxOrders: 3, WindowBlocks: 8},
+		},
+		{
+			name:  "only max orders",
+			flags: []string{"--" + cli.FlagMaxOrders, "12"},
+			def:   &exchange.OrderRateLimit{MaxOrders: 3, WindowBlocks: 8},
+			exp:   &exchange.OrderRateLimit{MaxOrders: 12, WindowBlocks: 1},
+		},
+		{
+			name:  "only window blocks",
+			flags: []string{"--" + cli.FlagWindowBlocks, "12"},
+			def:   &exchange.OrderRateLimit{MaxOrders: 3, WindowBlocks: 8},
+			exp:   nil,
+		},
+		{
+			name:  "max orders zero",
+			flags: []string{"--" + cli.FlagMaxOrders, "0", "--" + cli.FlagWindowBlocks, "5"},
+			def:   &exchange.OrderRateLimit{MaxOrders: 3, WindowBlocks: 8},
+			exp:   nil,
+		},
+		{
+			name:  "both provided",
+			flags: []string{"--" + cli.FlagWindowBlocks, "50", "--" + cli.FlagMaxOrders, "7"},
+			def:   nil,
+			exp:   &exchange.OrderRateLimit{MaxOrders: 7, WindowBlocks: 50},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			flagSet := pflag.NewFlagSet("", pflag.ContinueOnError)
+			flagSet.Uint32(cli.FlagMaxOrders, 0, "The max orders")
+			flagSet.Uint32(cli.FlagWindowBlocks, 1, "The window blocks")
+			err := flagSet.Parse(tc.flags)
+			require.NoError(t, err, "flagSet.Parse(%q)", tc.flags)
+
+			var act *exchange.OrderRateLimit
+			testFunc := func() {
+				act, err = cli.ReadFlagsOrderRateLimitOrDefault(flagSet, tc.def)
+			}
+			require.NotPanics(t, testFunc, "ReadFlagsOrderRateLimitOrDefault")
+			assertions.AssertErrorValue(t, err, tc.expErr, "ReadFlagsOrderRateLimitOrDefault error")
+			assert.Equal(t, tc.exp, act, "ReadFlagsOrderRateLimitOrDefault result")
+		})
+	}
+}
+
+func TestReadFlagMaxExposureOrDefault(t *testing.T) {
+	coinP := func(coin string) *sdk.Coin {
+		rv, err := sdk.ParseCoinNormalized(coin)
+		require.NoError(t, err, "ParseCoinNormalized(%q)", coin)
+		return &rv
+	}
+
+	tests := []struct {
+		name   string
+		flags  []string
+		def    *sdk.Coin
+		exp    *sdk.Coin
+		expErr string
+	}{
+		{
+			name: "not provided, nil default",
+			def:  nil,
+			exp:  nil,
+		},
+		{
+			name: "not provided, other default",
+			def:  coinP("15cherry"),
+			exp:  coinP("15cherry"),
+		},
+		{
+			name:  "provided",
+			flags: []string{"--" + cli.FlagMaxExposure, "500plum"},
+			def:   coinP("15cherry"),
+			exp:   coinP("500plum"),
+		},
+		{
+			name:  "zero amount",
+			flags: []string{"--" + cli.FlagMaxExposure, "0plum"},
+			def:   coinP("15cherry"),
+			exp:   nil,
+		},
+		{
+			name:   "invalid coin",
+			flags:  []string{"--" + cli.FlagMaxExposure, "plum"},
+			def:    coinP("15cherry"),
+			exp:    coinP("15cherry"),
+			expErr: "error parsing --" + cli.FlagMaxExposure + " as a coin: invalid coin expression: \"plum\"",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			flagSet := pflag.NewFlagSet("", pflag.ContinueOnError)
+			flagSet.String(cli.FlagMaxExposure, "", "The max exposure")
+			err := flagSet.Parse(tc.flags)
+			require.NoError(t, err, "flagSet.Parse(%q)", tc.flags)
+
+			var act *sdk.Coin
+			testFunc := func() {
+				act, err = cli.ReadFlagMaxExposureOrDefault(flagSet, tc.def)
+			}
+			require.NotPanics(t, testFunc, "ReadFlagMaxExposureOrDefault")
+			assertions.AssertErrorValue(t, err, tc.expErr, "ReadFlagMaxExposureOrDefault error")
+			assert.Equal(t, tc.exp, act, "ReadFlagMaxExposureOrDefault result")
+		})
+	}
+}
+
 func TestParseAccountAmount(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -3884,6 +4588,227 @@ func TestReadFlagAccountAmounts(t *testing.T) {
 	}
 }
 
+func TestReadFlagsPaymentParties(t *testing.T) {
+	flagSends := "sends"
+	flagReceives := "receives"
+	plum := func(amt int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin("plum", amt))
+	}
+
+	tests := []struct {
+		name   string
+		flags  []string
+		exp    []exchange.PaymentParty
+		expErr string
+	}{
+		{
+			name: "nothing provided",
+		},
+		{
+			name:   "bad sends",
+			flags:  []string{"--" + flagSends, "one", "--" + flagReceives, "two:5plum"},
+			expErr: "invalid account-amount \"one\": expected format <account>:<amount>",
+		},
+		{
+			name:   "bad receives",
+			flags:  []string{"--" + flagSends, "one:5plum", "--" + flagReceives, "two:5"},
+			expErr: "could not parse \"two:5\" amount: invalid coin expression: \"5\"",
+		},
+		{
+			name: "parties are merged",
+			flags: []string{
+				"--" + flagSends, "one:5plum,two:2plum", "--" + flagSends, "one:1plum",
+				"--" + flagReceives, "three:4plum,two:3plum,one:1plum",
+			},
+			exp: []exchange.PaymentParty{
+				{Address: "one", SendAmount: plum(6), ReceiveAmount: plum(1)},
+				{Address: "two", SendAmount: plum(2), ReceiveAmount: plum(3)},
+				{Address: "three", ReceiveAmount: plum(4)},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			flagSet := pflag.NewFlagSet("", pflag.ContinueOnError)
+			flagSet.StringSlice(flagSends, nil, "The sends")
+			flagSet.StringSlice(flagReceives, nil, "The receives")
+			err := flagSet.Parse(tc.flags)
+			require.NoError(t, err, "flagSet.Parse(%q)", tc.flags)
+
+			var actual []exchange.PaymentParty
+			testFunc := func() {
+				actual, err = cli.ReadFlagsPaymentParties(flagSet, flagSends, flagReceives)
+			}
+			require.NotPanics(t, testFunc, "ReadFlagsPaymentParties")
+			assertions.AssertErrorValue(t, err, tc.expErr, "ReadFlagsPaymentParties error")
+			assert.Equal(t, tc.exp, actual, "ReadFlagsPaymentParties result")
+		})
+	}
+}
+
+func TestParsePaymentID(t *testing.T) {
+	tests := []struct {
+		name   string
+		val    string
+		exp    *exchange.PaymentID
+		expErr string
+	}{
+		{
+			name:   "empty",
+			val:    "",
+			expErr: "invalid payment id \"\": expected format <source>:<external id>",
+		},
+		{
+			name:   "empty source",
+			val:    " :abc",
+			expErr: "invalid payment id \" :abc\": expected format <source>:<external id>",
+		},
+		{
+			name: "no colon",
+			val:  "banana",
+			exp:  &exchange.PaymentID{Source: "banana", ExternalId: ""},
+		},
+		{
+			name: "empty external id",
+			val:  "banana:",
+			exp:  &exchange.PaymentID{Source: "banana", ExternalId: ""},
+		},
+		{
+			name: "source and external id",
+			val:  "banana:split",
+			exp:  &exchange.PaymentID{Source: "banana", ExternalId: "split"},
+		},
+		{
+			name: "external id with colons and spaces",
+			val:  " banana :a: b ",
+			exp:  &exchange.PaymentID{Source: "banana", ExternalId: "a: b "},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual *exchange.PaymentID
+			var err error
+			testFunc := func() {
+				actual, err = cli.ParsePaymentID(tc.val)
+			}
+			require.NotPanics(t, testFunc, "ParsePaymentID(%q)", tc.val)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParsePaymentID(%q) error", tc.val)
+			assert.Equal(t, tc.exp, actual, "ParsePaymentID(%q) result", tc.val)
+		})
+	}
+}
+
+func TestReadFlagPaymentIDs(t *testing.T) {
+	flagName := "payment-ids"
+
+	tests := []struct {
+		name   string
+		flags  []string
+		exp    []exchange.PaymentID
+		expErr string
+	}{
+		{
+			name: "nothing provided",
+		},
+		{
+			name:  "one, with a comma",
+			flags: []string{"--" + flagName, "one:a,b"},
+			exp:   []exchange.PaymentID{{Source: "one", ExternalId: "a,b"}},
+		},
+		{
+			name:  "two invalid",
+			flags: []string{"--" + flagName, ":a", "--" + flagName, "two:b", "--" + flagName, ""},
+			exp:   []exchange.PaymentID{{Source: "two", ExternalId: "b"}},
+			expErr: "invalid payment id \":a\": expected format <source>:<external id>\n" +
+				"invalid payment id \"\": expected format <source>:<external id>",
+		},
+		{
+			name:  "three",
+			flags: []string{"--" + flagName, "one:a", "--" + flagName, "two", "--" + flagName, "three:c"},
+			exp: []exchange.PaymentID{
+				{Source: "one", ExternalId: "a"},
+				{Source: "two", ExternalId: ""},
+				{Source: "three", ExternalId: "c"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			flagSet := pflag.NewFlagSet("", pflag.ContinueOnError)
+			flagSet.StringArray(flagName, nil, "The payment ids")
+			err := flagSet.Parse(tc.flags)
+			require.NoError(t, err, "flagSet.Parse(%q)", tc.flags)
+
+			var actual []exchange.PaymentID
+			testFunc := func() {
+				actual, err = cli.ReadFlagPaymentIDs(flagSet, flagName)
+			}
+			require.NotPanics(t, testFunc, "ReadFlagPaymentIDs")
+			assertions.AssertErrorValue(t, err, tc.expErr, "ReadFlagPaymentIDs error")
+			assert.Equal(t, tc.exp, actual, "ReadFlagPaymentIDs result")
+		})
+	}
+}
+
+func TestReadFlagsPaymentFilter(t *testing.T) {
+	tests := []struct {
+		name   string
+		flags  []string
+		exp    *exchange.PaymentFilter
+		expErr string
+	}{
+		{
+			name: "nothing provided",
+		},
+		{
+			name:  "just denom",
+			flags: []string{"--" + cli.FlagDenom, "nhash"},
+			exp:   &exchange.PaymentFilter{Denom: "nhash"},
+		},
+		{
+			name:  "denom and amounts",
+			flags: []string{"--" + cli.FlagMaxAmount, "50", "--" + cli.FlagDenom, "nhash", "--" + cli.FlagMinAmount, "3"},
+			exp:   &exchange.PaymentFilter{Denom: "nhash", MinAmount: "3", MaxAmount: "50"},
+		},
+		{
+			name:  "with target amount",
+			flags: []string{"--" + cli.FlagWithTargetAmount},
+			exp:   &exchange.PaymentFilter{TargetAmount: exchange.TargetAmountFilter_present},
+		},
+		{
+			name:  "without target amount",
+			flags: []string{"--" + cli.FlagWithoutTargetAmount},
+			exp:   &exchange.PaymentFilter{TargetAmount: exchange.TargetAmountFilter_absent},
+		},
+		{
+			name:   "with and without target amount",
+			flags:  []string{"--" + cli.FlagWithTargetAmount, "--" + cli.FlagWithoutTargetAmount},
+			expErr: "cannot provide both --with-target-amount and --without-target-amount",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := &cobra.Command{Use: "testing"}
+			cli.AddFlagsPaymentFilter(cmd)
+			flagSet := cmd.Flags()
+			err := flagSet.Parse(tc.flags)
+			require.NoError(t, err, "flagSet.Parse(%q)", tc.flags)
+
+			var actual *exchange.PaymentFilter
+			testFunc := func() {
+				actual, err = cli.ReadFlagsPaymentFilter(flagSet)
+			}
+			require.NotPanics(t, testFunc, "ReadFlagsPaymentFilter")
+			assertions.AssertErrorValue(t, err, tc.expErr, "ReadFlagsPaymentFilter error")
+			assert.Equal(t, tc.exp, actual, "ReadFlagsPaymentFilter result")
+		})
+	}
+}
+
 func TestReadFlagAccountAmountsOrDefault(t *testing.T) {
 	tests := []struct {
 		testName string
@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -122,6 +123,46 @@ func genericQueryRunE[R any, S proto.Message](reqMaker queryReqMaker[R], endpoin
 	}
 }
 
+// documentQueryRunE returns a cobra.Command.RunE function that works like genericQueryRunE, except that
+// the response is written as JSON to the file named by the --output-document flag (if provided).
+//
+// R is the type of request message.
+// S is the type of response message.
+func documentQueryRunE[R any, S proto.Message](reqMaker queryReqMaker[R], endpoint queryEndpoint[R, S]) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		clientCtx, err := client.GetClientQueryContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		req, err := reqMaker(clientCtx, cmd.Flags(), args)
+		if err != nil {
+			return err
+		}
+
+		cmd.SilenceUsage = true
+		queryClient := exchange.NewQueryClient(clientCtx)
+		res, err := endpoint(queryClient, cmd.Context(), req)
+		if err != nil {
+			return err
+		}
+
+		outputDoc, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+		if len(outputDoc) == 0 {
+			return clientCtx.PrintProto(res)
+		}
+
+		bz, err := clientCtx.Codec.MarshalJSON(res)
+		if err != nil {
+			return err
+		}
+		if err = os.WriteFile(outputDoc, append(bz, '\n'), 0o644); err != nil {
+			return fmt.Errorf("could not write %q: %w", outputDoc, err)
+		}
+		return nil
+	}
+}
+
 // AddUseArgs adds the given strings to the cmd's Use, separated by a space.
 func AddUseArgs(cmd *cobra.Command, args ...string) {
 	cmd.Use = cmd.Use + " " + strings.Join(args, " ")
@@ -263,11 +304,13 @@ An <admin> is required.`,
 	ReqEnableDisableDesc = fmt.Sprintf("One of --%s or --%s must be provided, but not both.", FlagEnable, FlagDisable)
 
 	// AccessGrantsDesc is a description of the <asset grant> format.
-	AccessGrantsDesc = fmt.Sprintf(`An <access grant> has the format "<address>:<permissions>"
+	AccessGrantsDesc = fmt.Sprintf(`An <access grant> has the format "<address>:<permissions>[:<expiration>]"
 In <permissions>, separate each permission with a + (plus) or . (period).
 An <access grant> of "<address>:all" will have all of the permissions.
+The optional <expiration> is an RFC3339 time after which the <address> no longer has the permissions.
 
 Example <access grant>: %s:settle+update
+Example <access grant> with an expiration: %[1]s:cancel:2030-01-02T15:04:05Z
 
 Valid permissions entries: %s
 The full Permission enum names are also valid.`,
@@ -284,6 +327,45 @@ Example <fee ratio>: 100nhash:1nhash`
 	// AuthorityDesc is a description of the authority flag.
 	AuthorityDesc = fmt.Sprintf("If --%s <authority> is not provided, the governance module account is used as the <authority>.", FlagAuthority)
 
+	// SelfTradePreventionDesc is a description of the self-trade prevention <mode> values.
+	SelfTradePreventionDesc = `A self-trade prevention <mode> is one of: none, cancel-newest, cancel-oldest, decrement-both.
+It controls what happens when an order would be matched with one from the same owner.
+The full SelfTradePrevention enum names are also valid.`
+
+	// ExternalIDScopeDesc is a description of the external id <scope> values.
+	ExternalIDScopeDesc = `An external id <scope> is either market or account.
+With market, an order's external id only needs to be unique among the orders in its market.
+With account, it must also be unique among all of the owner's orders and payments.
+The full ExternalIDScope enum names are also valid.`
+
+	// OrderRateLimitDesc is a description of the --max-orders and --window-blocks flags.
+	OrderRateLimitDesc = `An account can create at most --max-orders orders in the market during any --window-blocks blocks.
+A --window-blocks of 1 limits the number of orders an account can create in a single block.
+A --max-orders of 0 means there is no limit.`
+
+	// MaxExposureDesc is a description of the --max-exposure flag.
+	MaxExposureDesc = `An account's exposure in a market is the price of each of its orders (including trigger orders)
+plus the funds it has committed to the market, all converted to the --max-exposure denom using recorded NAVs.
+An order or commitment cannot be created if it would put the account's exposure over the --max-exposure amount.
+A --max-exposure with a zero amount (e.g. 0nhash) means there is no limit.`
+
+	// FillAlgorithmDesc is a description of the fill <algorithm> values.
+	FillAlgorithmDesc = `A fill <algorithm> is one of unspecified, price-time, or pro-rata.
+It controls which resting orders are used to fill a newly booked order when continuous matching is enabled.
+With unspecified, orders are taken in price-time priority, skipping ones that are too large and can't be partially filled.
+With price-time, orders are taken in strict price-time priority, stopping at the first one that can't be filled.
+With pro-rata, the orders at the last price level needed share the fill in proportion to their assets.
+The full FillAlgorithm enum names are also valid.`
+
+	// AcceptedDenomsDesc is a description of a market's accepted asset and price denoms.
+	AcceptedDenomsDesc = `A market with no accepted asset denoms allows orders to use any asset denom.
+Likewise, a market with no accepted price denoms allows orders to use any price denom.`
+
+	// ScheduledFeesDesc is a description of the --effective-height and --effective-time flags.
+	ScheduledFeesDesc = fmt.Sprintf(`At most one of --%[1]s or --%[2]s can be provided.
+If either is provided, the fee changes are applied once that block height or time is reached.
+Otherwise, the fee changes are applied as soon as the proposal passes.`, FlagEffectiveHeight, FlagEffectiveTime)
+
 	// ReqAskBidUse is a use string of the --ask and --bid flags when one is required.
 	ReqAskBidUse = fmt.Sprintf("{--%s|--%s}", FlagAsk, FlagBid)
 
@@ -296,6 +378,16 @@ Example <fee ratio>: 100nhash:1nhash`
 	// OptAsksBidsDesc is a description of the --asks and --bids flags when they're optional.
 	OptAsksBidsDesc = fmt.Sprintf("At most one of --%s or --%s can be provided.", FlagAsks, FlagBids)
 
+	// OptPaymentFilterUse is a use string of the optional payment filter flags.
+	OptPaymentFilterUse = fmt.Sprintf("[--%s <denom> [--%s <amount>] [--%s <amount>]] [--%s|--%s]",
+		FlagDenom, FlagMinAmount, FlagMaxAmount, FlagWithTargetAmount, FlagWithoutTargetAmount)
+
+	// PaymentFilterDesc is a description of the optional payment filter flags.
+	PaymentFilterDesc = fmt.Sprintf(`The --%[1]s, --%[2]s, and --%[3]s flags apply to the source amount of the payments.
+The --%[2]s and --%[3]s flags are integers, and require a --%[1]s.
+At most one of --%[4]s or --%[5]s can be provided.`,
+		FlagDenom, FlagMinAmount, FlagMaxAmount, FlagWithTargetAmount, FlagWithoutTargetAmount)
+
 	AccountAmountDesc = `An <account-amount> has the format "<account>:<amount>".
 The <account> should be a bech32 address string.
 The <amount> should be a coins string with the format <amount><denom>[,<amount><denom> ...]
@@ -307,5 +399,21 @@ Both <assets coin> and <price coin> have the format "<amount><denom>".
 
 Example <nav>: 1cherry:10nhash`
 
+	BatchOrderDesc = fmt.Sprintf(`An <order> has the format "<assets coin>:<price coin>".
+Both <assets coin> and <price coin> have the format "<amount><denom>".
+At least one --%s or --%s must be provided.
+The other order flags (e.g. --%s) are applied to every order of the applicable type.
+
+Example <order>: 10cherry:100nhash`,
+		FlagAskOrder, FlagBidOrder, FlagPartial,
+	)
+
+	MultiPartyPaymentDesc = fmt.Sprintf(`The parties are defined using --%[1]s and --%[2]s.
+Each --%[1]s <account-amount> is funds that the account will send as part of the payment.
+Each --%[2]s <account-amount> is funds that the account will receive as part of the payment.
+The total of all --%[1]s must equal the total of all --%[2]s.`,
+		FlagInputs, FlagOutputs,
+	)
+
 	PageFlagsUse = "[pagination flags]"
 )
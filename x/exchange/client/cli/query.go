@@ -23,15 +23,37 @@ func CmdQuery() *cobra.Command {
 		CmdQueryOrderFeeCalc(),
 		CmdQueryGetOrder(),
 		CmdQueryGetOrderByExternalID(),
+		CmdQueryGetOrdersByExternalIDPrefix(),
 		CmdQueryGetMarketOrders(),
 		CmdQueryGetOwnerOrders(),
 		CmdQueryGetAssetOrders(),
 		CmdQueryGetAllOrders(),
+		CmdQueryGetTriggerOrder(),
+		CmdQueryGetMarketTriggerOrders(),
+		CmdQueryGetOrderLink(),
+		CmdQueryGetMarketOrderLinks(),
+		CmdQueryGetDeadManSwitch(),
+		CmdQueryOrderBookDepth(),
+		CmdQueryTopOfBook(),
+		CmdQueryPriceAverages(),
+		CmdQueryGetMarketSettlements(),
+		CmdQueryGetAllSettlements(),
+		CmdQueryGetSettlementReceipt(),
+		CmdQueryGetLatestNAV(),
+		CmdQueryGetNAVHistory(),
+		CmdQuerySimulateMarketSettle(),
+		CmdQuerySimulateFillBids(),
+		CmdQuerySimulateFillAsks(),
 		CmdQueryGetCommitment(),
 		CmdQueryGetAccountCommitments(),
 		CmdQueryGetMarketCommitments(),
 		CmdQueryGetAllCommitments(),
+		CmdQueryGetCommitmentRewardPool(),
+		CmdQueryGetAccountCommitmentRewards(),
 		CmdQueryGetMarket(),
+		CmdQueryGetScheduledFeeChanges(),
+		CmdQueryGetMarketAuthorityTransfer(),
+		CmdQueryExportMarket(),
 		CmdQueryGetAllMarkets(),
 		CmdQueryParams(),
 		CmdQueryCommitmentSettlementFeeCalc(),
@@ -43,6 +65,7 @@ func CmdQuery() *cobra.Command {
 		CmdQueryGetPaymentsWithTarget(),
 		CmdQueryGetAllPayments(),
 		CmdQueryPaymentFeeCalc(),
+		CmdQueryGetAccountActivitySummary(),
 	)
 
 	return cmd
@@ -90,6 +113,20 @@ func CmdQueryGetOrderByExternalID() *cobra.Command {
 	return cmd
 }
 
+// CmdQueryGetOrdersByExternalIDPrefix creates the orders-by-external-id-prefix sub-command for the exchange query command.
+func CmdQueryGetOrdersByExternalIDPrefix() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "orders-by-external-id-prefix",
+		Aliases: []string{"get-orders-by-external-id-prefix", "external-id-prefix"},
+		Short:   "Look up orders in a market with an external id that starts with a prefix",
+		RunE:    genericQueryRunE(MakeQueryGetOrdersByExternalIDPrefix, exchange.QueryClient.GetOrdersByExternalIDPrefix),
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	SetupCmdQueryGetOrdersByExternalIDPrefix(cmd)
+	return cmd
+}
+
 // CmdQueryGetMarketOrders creates the market-orders sub-command for the exchange query command.
 func CmdQueryGetMarketOrders() *cobra.Command {
 	cmd := &cobra.Command{
@@ -146,6 +183,230 @@ func CmdQueryGetAllOrders() *cobra.Command {
 	return cmd
 }
 
+// CmdQueryGetTriggerOrder creates the trigger-order sub-command for the exchange query command.
+func CmdQueryGetTriggerOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "trigger-order",
+		Aliases: []string{"get-trigger-order"},
+		Short:   "Get a trigger order by id",
+		RunE:    genericQueryRunE(MakeQueryGetTriggerOrder, exchange.QueryClient.GetTriggerOrder),
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	SetupCmdQueryGetTriggerOrder(cmd)
+	return cmd
+}
+
+// CmdQueryGetMarketTriggerOrders creates the market-trigger-orders sub-command for the exchange query command.
+func CmdQueryGetMarketTriggerOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "market-trigger-orders",
+		Aliases: []string{"get-market-trigger-orders"},
+		Short:   "Look up trigger orders for a market",
+		RunE:    genericQueryRunE(MakeQueryGetMarketTriggerOrders, exchange.QueryClient.GetMarketTriggerOrders),
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	SetupCmdQueryGetMarketTriggerOrders(cmd)
+	return cmd
+}
+
+// CmdQueryGetOrderLink creates the order-link sub-command for the exchange query command.
+func CmdQueryGetOrderLink() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "order-link",
+		Aliases: []string{"get-order-link"},
+		Short:   "Get the order that an order is linked to",
+		RunE:    genericQueryRunE(MakeQueryGetOrderLink, exchange.QueryClient.GetOrderLink),
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	SetupCmdQueryGetOrderLink(cmd)
+	return cmd
+}
+
+// CmdQueryGetMarketOrderLinks creates the market-order-links sub-command for the exchange query command.
+func CmdQueryGetMarketOrderLinks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "market-order-links",
+		Aliases: []string{"get-market-order-links"},
+		Short:   "Look up linked orders in a market",
+		RunE:    genericQueryRunE(MakeQueryGetMarketOrderLinks, exchange.QueryClient.GetMarketOrderLinks),
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	SetupCmdQueryGetMarketOrderLinks(cmd)
+	return cmd
+}
+
+// CmdQueryGetDeadManSwitch creates the dead-man-switch sub-command for the exchange query command.
+func CmdQueryGetDeadManSwitch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "dead-man-switch",
+		Aliases: []string{"get-dead-man-switch", "dms"},
+		Short:   "Get an account's dead-man switch",
+		RunE:    genericQueryRunE(MakeQueryGetDeadManSwitch, exchange.QueryClient.GetDeadManSwitch),
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	SetupCmdQueryGetDeadManSwitch(cmd)
+	return cmd
+}
+
+// CmdQueryOrderBookDepth creates the order-book-depth sub-command for the exchange query command.
+func CmdQueryOrderBookDepth() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "order-book-depth",
+		Aliases: []string{"book-depth", "depth"},
+		Short:   "Get the orders in a market aggregated by price",
+		RunE:    genericQueryRunE(MakeQueryOrderBookDepth, exchange.QueryClient.OrderBookDepth),
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	SetupCmdQueryOrderBookDepth(cmd)
+	return cmd
+}
+
+// CmdQueryTopOfBook creates the top-of-book sub-command for the exchange query command.
+func CmdQueryTopOfBook() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "top-of-book",
+		Aliases: []string{"best-prices", "top"},
+		Short:   "Get the best bid and ask prices in a market",
+		RunE:    genericQueryRunE(MakeQueryTopOfBook, exchange.QueryClient.TopOfBook),
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	SetupCmdQueryTopOfBook(cmd)
+	return cmd
+}
+
+// CmdQueryPriceAverages creates the price-averages sub-command for the exchange query command.
+func CmdQueryPriceAverages() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "price-averages",
+		Aliases: []string{"averages", "twap", "vwap"},
+		Short:   "Get the time-weighted and volume-weighted average prices of recent settlements in a market",
+		RunE:    genericQueryRunE(MakeQueryPriceAverages, exchange.QueryClient.PriceAverages),
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	SetupCmdQueryPriceAverages(cmd)
+	return cmd
+}
+
+// CmdQueryGetMarketSettlements creates the market-settlements sub-command for the exchange query command.
+func CmdQueryGetMarketSettlements() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "market-settlements",
+		Aliases: []string{"get-market-settlements"},
+		Short:   "Look up the settlement history of a market",
+		RunE:    genericQueryRunE(MakeQueryGetMarketSettlements, exchange.QueryClient.GetMarketSettlements),
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	SetupCmdQueryGetMarketSettlements(cmd)
+	return cmd
+}
+
+// CmdQueryGetAllSettlements creates the all-settlements sub-command for the exchange query command.
+func CmdQueryGetAllSettlements() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "all-settlements",
+		Aliases: []string{"get-all-settlements"},
+		Short:   "Get the settlement history of all markets",
+		RunE:    genericQueryRunE(MakeQueryGetAllSettlements, exchange.QueryClient.GetAllSettlements),
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	SetupCmdQueryGetAllSettlements(cmd)
+	return cmd
+}
+
+// CmdQueryGetSettlementReceipt creates the settlement-receipt sub-command for the exchange query command.
+func CmdQueryGetSettlementReceipt() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "settlement-receipt",
+		Aliases: []string{"get-settlement-receipt", "receipt"},
+		Short:   "Get the receipt of a settlement by id",
+		RunE:    genericQueryRunE(MakeQueryGetSettlementReceipt, exchange.QueryClient.GetSettlementReceipt),
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	SetupCmdQueryGetSettlementReceipt(cmd)
+	return cmd
+}
+
+// CmdQueryGetLatestNAV creates the latest-nav sub-command for the exchange query command.
+func CmdQueryGetLatestNAV() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "latest-nav",
+		Aliases: []string{"get-latest-nav"},
+		Short:   "Get the most recently recorded NAV of an asset and price denom pair",
+		RunE:    genericQueryRunE(MakeQueryGetLatestNAV, exchange.QueryClient.GetLatestNAV),
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	SetupCmdQueryGetLatestNAV(cmd)
+	return cmd
+}
+
+// CmdQueryGetNAVHistory creates the nav-history sub-command for the exchange query command.
+func CmdQueryGetNAVHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "nav-history",
+		Aliases: []string{"get-nav-history", "navs"},
+		Short:   "Look up the recorded NAVs of an asset and price denom pair",
+		RunE:    genericQueryRunE(MakeQueryGetNAVHistory, exchange.QueryClient.GetNAVHistory),
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	SetupCmdQueryGetNAVHistory(cmd)
+	return cmd
+}
+
+// CmdQuerySimulateMarketSettle creates the simulate-market-settle sub-command for the exchange query command.
+func CmdQuerySimulateMarketSettle() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "simulate-market-settle",
+		Aliases: []string{"market-settle-simulate", "simulate-settle"},
+		Short:   "Simulate a market settlement without committing it",
+		RunE:    genericQueryRunE(MakeQuerySimulateMarketSettle, exchange.QueryClient.SimulateSettlement),
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	SetupCmdQuerySimulateMarketSettle(cmd)
+	return cmd
+}
+
+// CmdQuerySimulateFillBids creates the simulate-fill-bids sub-command for the exchange query command.
+func CmdQuerySimulateFillBids() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "simulate-fill-bids",
+		Aliases: []string{"fill-bids-simulate"},
+		Short:   "Simulate filling bids without committing it",
+		RunE:    genericQueryRunE(MakeQuerySimulateFillBids, exchange.QueryClient.SimulateSettlement),
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	SetupCmdQuerySimulateFillBids(cmd)
+	return cmd
+}
+
+// CmdQuerySimulateFillAsks creates the simulate-fill-asks sub-command for the exchange query command.
+func CmdQuerySimulateFillAsks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "simulate-fill-asks",
+		Aliases: []string{"fill-asks-simulate"},
+		Short:   "Simulate filling asks without committing it",
+		RunE:    genericQueryRunE(MakeQuerySimulateFillAsks, exchange.QueryClient.SimulateSettlement),
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	SetupCmdQuerySimulateFillAsks(cmd)
+	return cmd
+}
+
 // CmdQueryGetCommitment creates the commitment sub-command for the exchange query command.
 func CmdQueryGetCommitment() *cobra.Command {
 	cmd := &cobra.Command{
@@ -202,6 +463,34 @@ func CmdQueryGetAllCommitments() *cobra.Command {
 	return cmd
 }
 
+// CmdQueryGetCommitmentRewardPool creates the commitment-reward-pool sub-command for the exchange query command.
+func CmdQueryGetCommitmentRewardPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "commitment-reward-pool",
+		Aliases: []string{"get-commitment-reward-pool", "reward-pool"},
+		Short:   "Get the undistributed funds in a market's commitment reward pool",
+		RunE:    genericQueryRunE(MakeQueryGetCommitmentRewardPool, exchange.QueryClient.GetCommitmentRewardPool),
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	SetupCmdQueryGetCommitmentRewardPool(cmd)
+	return cmd
+}
+
+// CmdQueryGetAccountCommitmentRewards creates the account-commitment-rewards sub-command for the exchange query command.
+func CmdQueryGetAccountCommitmentRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "account-commitment-rewards",
+		Aliases: []string{"get-account-commitment-rewards", "commitment-rewards"},
+		Short:   "Get the commitment rewards a specific account can claim from any market",
+		RunE:    genericQueryRunE(MakeQueryGetAccountCommitmentRewards, exchange.QueryClient.GetAccountCommitmentRewards),
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	SetupCmdQueryGetAccountCommitmentRewards(cmd)
+	return cmd
+}
+
 // CmdQueryGetMarket creates the market sub-command for the exchange query command.
 func CmdQueryGetMarket() *cobra.Command {
 	cmd := &cobra.Command{
@@ -216,6 +505,48 @@ func CmdQueryGetMarket() *cobra.Command {
 	return cmd
 }
 
+// CmdQueryGetScheduledFeeChanges creates the scheduled-fees sub-command for the exchange query command.
+func CmdQueryGetScheduledFeeChanges() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "scheduled-fees",
+		Aliases: []string{"get-scheduled-fees", "scheduled-fee-changes"},
+		Short:   "Get the fee changes waiting to be applied to a market",
+		RunE:    genericQueryRunE(MakeQueryGetScheduledFeeChanges, exchange.QueryClient.GetScheduledFeeChanges),
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	SetupCmdQueryGetScheduledFeeChanges(cmd)
+	return cmd
+}
+
+// CmdQueryGetMarketAuthorityTransfer creates the authority-transfer sub-command for the exchange query command.
+func CmdQueryGetMarketAuthorityTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "authority-transfer",
+		Aliases: []string{"get-authority-transfer", "market-authority-transfer"},
+		Short:   "Get the authority transfer waiting to be accepted for a market",
+		RunE:    genericQueryRunE(MakeQueryGetMarketAuthorityTransfer, exchange.QueryClient.GetMarketAuthorityTransfer),
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	SetupCmdQueryGetMarketAuthorityTransfer(cmd)
+	return cmd
+}
+
+// CmdQueryExportMarket creates the export-market sub-command for the exchange query command.
+func CmdQueryExportMarket() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "export-market",
+		Aliases: []string{"market-export", "market-snapshot"},
+		Short:   "Get a snapshot of a market's setup, fees, orders, and commitments",
+		RunE:    documentQueryRunE(MakeQueryExportMarket, exchange.QueryClient.ExportMarket),
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	SetupCmdQueryExportMarket(cmd)
+	return cmd
+}
+
 // CmdQueryGetAllMarkets creates the all-markets sub-command for the exchange query command.
 func CmdQueryGetAllMarkets() *cobra.Command {
 	cmd := &cobra.Command{
@@ -368,3 +699,17 @@ func CmdQueryPaymentFeeCalc() *cobra.Command {
 	SetupCmdQueryPaymentFeeCalc(cmd)
 	return cmd
 }
+
+// CmdQueryGetAccountActivitySummary creates the account-summary sub-command for the exchange query command.
+func CmdQueryGetAccountActivitySummary() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "account-summary",
+		Aliases: []string{"account-activity-summary", "get-account-activity-summary", "account-activity"},
+		Short:   "Get the totals of an account's orders, commitments, and payments",
+		RunE:    genericQueryRunE(MakeQueryGetAccountActivitySummary, exchange.QueryClient.GetAccountActivitySummary),
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	SetupCmdQueryGetAccountActivitySummary(cmd)
+	return cmd
+}
@@ -3,6 +3,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -152,6 +153,40 @@ func MakeQueryGetOrderByExternalID(_ client.Context, flagSet *pflag.FlagSet, _ [
 	return req, errors.Join(errs...)
 }
 
+// SetupCmdQueryGetOrdersByExternalIDPrefix adds all the flags needed for MakeQueryGetOrdersByExternalIDPrefix.
+func SetupCmdQueryGetOrdersByExternalIDPrefix(cmd *cobra.Command) {
+	flags.AddPaginationFlagsToCmd(cmd, "orders")
+
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id")
+	cmd.Flags().String(FlagPrefix, "", "The external id prefix (required)")
+
+	MarkFlagsRequired(cmd, FlagPrefix)
+
+	AddUseArgs(cmd,
+		fmt.Sprintf("{<market id>|--%s <market id>}", FlagMarket),
+		ReqFlagUse(FlagPrefix, "external id prefix"),
+		PageFlagsUse,
+	)
+	AddUseDetails(cmd, "A <market id> is required as either an arg or flag, but not both.")
+	AddQueryExample(cmd, "3", "--"+FlagPrefix, "acme-2024-")
+	AddQueryExample(cmd, "--"+FlagMarket, "1", "--"+FlagPrefix, "acme-", "--"+flags.FlagLimit, "10")
+
+	cmd.Args = cobra.MaximumNArgs(1)
+}
+
+// MakeQueryGetOrdersByExternalIDPrefix reads all the SetupCmdQueryGetOrdersByExternalIDPrefix flags and creates the desired request.
+// Satisfies the queryReqMaker type.
+func MakeQueryGetOrdersByExternalIDPrefix(_ client.Context, flagSet *pflag.FlagSet, args []string) (*exchange.QueryGetOrdersByExternalIDPrefixRequest, error) {
+	req := &exchange.QueryGetOrdersByExternalIDPrefixRequest{}
+
+	errs := make([]error, 3)
+	req.MarketId, errs[0] = ReadFlagMarketOrArg(flagSet, args)
+	req.ExternalIdPrefix, errs[1] = flagSet.GetString(FlagPrefix)
+	req.Pagination, errs[2] = client.ReadPageRequestWithPageKeyDecoded(flagSet)
+
+	return req, errors.Join(errs...)
+}
+
 // SetupCmdQueryGetMarketOrders adds all the flags needed for MakeQueryGetMarketOrders.
 func SetupCmdQueryGetMarketOrders(cmd *cobra.Command) {
 	flags.AddPaginationFlagsToCmd(cmd, "orders")
@@ -159,19 +194,25 @@ func SetupCmdQueryGetMarketOrders(cmd *cobra.Command) {
 	cmd.Flags().Uint32(FlagMarket, 0, "The market id (required)")
 	AddFlagsAsksBidsBools(cmd)
 	cmd.Flags().Uint64(FlagAfter, 0, "Limit results to only orders with ids larger than this")
+	cmd.Flags().String(FlagAssets, "", "Limit results to only orders with this asset denom, ordered by price")
+	cmd.Flags().String(FlagPrice, "", "Limit results to only orders with this price denom, ordered by price")
 
 	AddUseArgs(cmd,
 		fmt.Sprintf("{<market id>|--%s <market id>}", FlagMarket),
 		OptAsksBidsUse,
 		OptFlagUse(FlagAfter, "after order id"),
+		fmt.Sprintf("[--%s <asset denom> --%s <price denom>]", FlagAssets, FlagPrice),
 		PageFlagsUse,
 	)
 	AddUseDetails(cmd,
 		"A <market id> is required as either an arg or flag, but not both.",
 		OptAsksBidsDesc,
+		fmt.Sprintf(`The --%s and --%s flags must be provided together.
+When they are, results are ordered by unit price (lowest first) instead of order id.`, FlagAssets, FlagPrice),
 	)
 	AddQueryExample(cmd, "3", "--"+FlagAsks)
 	AddQueryExample(cmd, "--"+FlagMarket, "1", "--"+FlagAfter, "15", "--"+flags.FlagLimit, "10")
+	AddQueryExample(cmd, "3", "--"+FlagBids, "--"+FlagAssets, "apple", "--"+FlagPrice, "nhash", "--"+flags.FlagReverse)
 
 	cmd.Args = cobra.MaximumNArgs(1)
 }
@@ -181,11 +222,13 @@ func SetupCmdQueryGetMarketOrders(cmd *cobra.Command) {
 func MakeQueryGetMarketOrders(_ client.Context, flagSet *pflag.FlagSet, args []string) (*exchange.QueryGetMarketOrdersRequest, error) {
 	req := &exchange.QueryGetMarketOrdersRequest{}
 
-	errs := make([]error, 4)
+	errs := make([]error, 6)
 	req.MarketId, errs[0] = ReadFlagMarketOrArg(flagSet, args)
 	req.OrderType, errs[1] = ReadFlagsAsksBidsOpt(flagSet)
 	req.AfterOrderId, errs[2] = flagSet.GetUint64(FlagAfter)
-	req.Pagination, errs[3] = client.ReadPageRequestWithPageKeyDecoded(flagSet)
+	req.AssetDenom, errs[3] = flagSet.GetString(FlagAssets)
+	req.PriceDenom, errs[4] = flagSet.GetString(FlagPrice)
+	req.Pagination, errs[5] = client.ReadPageRequestWithPageKeyDecoded(flagSet)
 
 	return req, errors.Join(errs...)
 }
@@ -289,6 +332,382 @@ func MakeQueryGetAllOrders(_ client.Context, flagSet *pflag.FlagSet, _ []string)
 	return req, err
 }
 
+// SetupCmdQueryGetTriggerOrder adds all the flags needed for MakeQueryGetTriggerOrder.
+func SetupCmdQueryGetTriggerOrder(cmd *cobra.Command) {
+	cmd.Flags().Uint64(FlagOrder, 0, "The order id")
+
+	AddUseArgs(cmd,
+		fmt.Sprintf("{<order id>|--%s <order id>}", FlagOrder),
+	)
+	AddUseDetails(cmd, "An <order id> is required as either an arg or flag, but not both.")
+	AddQueryExample(cmd, "8")
+	AddQueryExample(cmd, "--"+FlagOrder, "8")
+
+	cmd.Args = cobra.MaximumNArgs(1)
+}
+
+// MakeQueryGetTriggerOrder reads all the SetupCmdQueryGetTriggerOrder flags and creates the desired request.
+// Satisfies the queryReqMaker type.
+func MakeQueryGetTriggerOrder(_ client.Context, flagSet *pflag.FlagSet, args []string) (*exchange.QueryGetTriggerOrderRequest, error) {
+	req := &exchange.QueryGetTriggerOrderRequest{}
+
+	var err error
+	req.OrderId, err = ReadFlagOrderOrArg(flagSet, args)
+
+	return req, err
+}
+
+// SetupCmdQueryGetMarketTriggerOrders adds all the flags needed for MakeQueryGetMarketTriggerOrders.
+func SetupCmdQueryGetMarketTriggerOrders(cmd *cobra.Command) {
+	flags.AddPaginationFlagsToCmd(cmd, "trigger orders")
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id")
+
+	AddUseArgs(cmd,
+		fmt.Sprintf("{<market id>|--%s <market id>}", FlagMarket),
+		PageFlagsUse,
+	)
+	AddUseDetails(cmd, "A <market id> is required as either an arg or flag, but not both.")
+	AddQueryExample(cmd, "3")
+	AddQueryExample(cmd, "--"+FlagMarket, "1", "--limit", "10")
+
+	cmd.Args = cobra.MaximumNArgs(1)
+}
+
+// MakeQueryGetMarketTriggerOrders reads all the SetupCmdQueryGetMarketTriggerOrders flags and creates the desired request.
+// Satisfies the queryReqMaker type.
+func MakeQueryGetMarketTriggerOrders(_ client.Context, flagSet *pflag.FlagSet, args []string) (*exchange.QueryGetMarketTriggerOrdersRequest, error) {
+	req := &exchange.QueryGetMarketTriggerOrdersRequest{}
+
+	errs := make([]error, 2)
+	req.MarketId, errs[0] = ReadFlagMarketOrArg(flagSet, args)
+	req.Pagination, errs[1] = client.ReadPageRequestWithPageKeyDecoded(flagSet)
+
+	return req, errors.Join(errs...)
+}
+
+// SetupCmdQueryGetOrderLink adds all the flags needed for MakeQueryGetOrderLink.
+func SetupCmdQueryGetOrderLink(cmd *cobra.Command) {
+	cmd.Flags().Uint64(FlagOrder, 0, "The order id")
+
+	AddUseArgs(cmd,
+		fmt.Sprintf("{<order id>|--%s <order id>}", FlagOrder),
+	)
+	AddUseDetails(cmd, "An <order id> is required as either an arg or flag, but not both.")
+	AddQueryExample(cmd, "8")
+	AddQueryExample(cmd, "--"+FlagOrder, "8")
+
+	cmd.Args = cobra.MaximumNArgs(1)
+}
+
+// MakeQueryGetOrderLink reads all the SetupCmdQueryGetOrderLink flags and creates the desired request.
+// Satisfies the queryReqMaker type.
+func MakeQueryGetOrderLink(_ client.Context, flagSet *pflag.FlagSet, args []string) (*exchange.QueryGetOrderLinkRequest, error) {
+	req := &exchange.QueryGetOrderLinkRequest{}
+
+	var err error
+	req.OrderId, err = ReadFlagOrderOrArg(flagSet, args)
+
+	return req, err
+}
+
+// SetupCmdQueryGetMarketOrderLinks adds all the flags needed for MakeQueryGetMarketOrderLinks.
+func SetupCmdQueryGetMarketOrderLinks(cmd *cobra.Command) {
+	flags.AddPaginationFlagsToCmd(cmd, "order links")
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id")
+
+	AddUseArgs(cmd,
+		fmt.Sprintf("{<market id>|--%s <market id>}", FlagMarket),
+		PageFlagsUse,
+	)
+	AddUseDetails(cmd, "A <market id> is required as either an arg or flag, but not both.")
+	AddQueryExample(cmd, "3")
+	AddQueryExample(cmd, "--"+FlagMarket, "1", "--limit", "10")
+
+	cmd.Args = cobra.MaximumNArgs(1)
+}
+
+// MakeQueryGetMarketOrderLinks reads all the SetupCmdQueryGetMarketOrderLinks flags and creates the desired request.
+// Satisfies the queryReqMaker type.
+func MakeQueryGetMarketOrderLinks(_ client.Context, flagSet *pflag.FlagSet, args []string) (*exchange.QueryGetMarketOrderLinksRequest, error) {
+	req := &exchange.QueryGetMarketOrderLinksRequest{}
+
+	errs := make([]error, 2)
+	req.MarketId, errs[0] = ReadFlagMarketOrArg(flagSet, args)
+	req.Pagination, errs[1] = client.ReadPageRequestWithPageKeyDecoded(flagSet)
+
+	return req, errors.Join(errs...)
+}
+
+// SetupCmdQueryGetDeadManSwitch adds all the flags needed for MakeQueryGetDeadManSwitch.
+func SetupCmdQueryGetDeadManSwitch(cmd *cobra.Command) {
+	cmd.Flags().String(FlagAccount, "", "The account's address")
+
+	AddUseArgs(cmd,
+		fmt.Sprintf("{<account>|--%s <account>}", FlagAccount),
+	)
+	AddUseDetails(cmd, "An <account> is required as either an arg or flag, but not both.")
+	AddQueryExample(cmd, ExampleAddr)
+	AddQueryExample(cmd, "--"+FlagAccount, ExampleAddr)
+
+	cmd.Args = cobra.MaximumNArgs(1)
+}
+
+// MakeQueryGetDeadManSwitch reads all the SetupCmdQueryGetDeadManSwitch flags and creates the desired request.
+// Satisfies the queryReqMaker type.
+func MakeQueryGetDeadManSwitch(_ client.Context, flagSet *pflag.FlagSet, args []string) (*exchange.QueryGetDeadManSwitchRequest, error) {
+	req := &exchange.QueryGetDeadManSwitchRequest{}
+
+	var err error
+	req.Account, err = ReadStringFlagOrArg(flagSet, args, FlagAccount, "account")
+
+	return req, err
+}
+
+// SetupCmdQueryOrderBookDepth adds all the flags needed for MakeQueryOrderBookDepth.
+func SetupCmdQueryOrderBookDepth(cmd *cobra.Command) {
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id")
+	cmd.Flags().String(FlagAssets, "", "The asset denom (required)")
+	cmd.Flags().String(FlagPrice, "", "The price denom (required)")
+	cmd.Flags().Uint32(FlagDepth, 0, "The maximum number of price levels to get for each side (default is all of them)")
+
+	MarkFlagsRequired(cmd, FlagAssets, FlagPrice)
+
+	AddUseArgs(cmd,
+		fmt.Sprintf("{<market id>|--%s <market id>}", FlagMarket),
+		ReqFlagUse(FlagAssets, "asset denom"),
+		ReqFlagUse(FlagPrice, "price denom"),
+		OptFlagUse(FlagDepth, "depth"),
+	)
+	AddUseDetails(cmd, "A <market id> is required as either an arg or flag, but not both.")
+	AddQueryExample(cmd, "3", "--"+FlagAssets, "apple", "--"+FlagPrice, "nhash")
+	AddQueryExample(cmd, "--"+FlagMarket, "1", "--"+FlagAssets, "apple", "--"+FlagPrice, "nhash", "--"+FlagDepth, "10")
+
+	cmd.Args = cobra.MaximumNArgs(1)
+}
+
+// MakeQueryOrderBookDepth reads all the SetupCmdQueryOrderBookDepth flags and creates the desired request.
+// Satisfies the queryReqMaker type.
+func MakeQueryOrderBookDepth(_ client.Context, flagSet *pflag.FlagSet, args []string) (*exchange.QueryOrderBookDepthRequest, error) {
+	req := &exchange.QueryOrderBookDepthRequest{}
+
+	errs := make([]error, 4)
+	req.MarketId, errs[0] = ReadFlagMarketOrArg(flagSet, args)
+	req.AssetDenom, errs[1] = flagSet.GetString(FlagAssets)
+	req.PriceDenom, errs[2] = flagSet.GetString(FlagPrice)
+	req.Depth, errs[3] = flagSet.GetUint32(FlagDepth)
+
+	return req, errors.Join(errs...)
+}
+
+// SetupCmdQueryTopOfBook adds all the flags needed for MakeQueryTopOfBook.
+func SetupCmdQueryTopOfBook(cmd *cobra.Command) {
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id")
+	cmd.Flags().String(FlagAssets, "", "The asset denom (required)")
+	cmd.Flags().String(FlagPrice, "", "The price denom (required)")
+
+	MarkFlagsRequired(cmd, FlagAssets, FlagPrice)
+
+	AddUseArgs(cmd,
+		fmt.Sprintf("{<market id>|--%s <market id>}", FlagMarket),
+		ReqFlagUse(FlagAssets, "asset denom"),
+		ReqFlagUse(FlagPrice, "price denom"),
+	)
+	AddUseDetails(cmd, "A <market id> is required as either an arg or flag, but not both.")
+	AddQueryExample(cmd, "3", "--"+FlagAssets, "apple", "--"+FlagPrice, "nhash")
+	AddQueryExample(cmd, "--"+FlagMarket, "1", "--"+FlagAssets, "apple", "--"+FlagPrice, "nhash")
+
+	cmd.Args = cobra.MaximumNArgs(1)
+}
+
+// MakeQueryTopOfBook reads all the SetupCmdQueryTopOfBook flags and creates the desired request.
+// Satisfies the queryReqMaker type.
+func MakeQueryTopOfBook(_ client.Context, flagSet *pflag.FlagSet, args []string) (*exchange.QueryTopOfBookRequest, error) {
+	req := &exchange.QueryTopOfBookRequest{}
+
+	errs := make([]error, 3)
+	req.MarketId, errs[0] = ReadFlagMarketOrArg(flagSet, args)
+	req.AssetDenom, errs[1] = flagSet.GetString(FlagAssets)
+	req.PriceDenom, errs[2] = flagSet.GetString(FlagPrice)
+
+	return req, errors.Join(errs...)
+}
+
+// SetupCmdQueryPriceAverages adds all the flags needed for MakeQueryPriceAverages.
+func SetupCmdQueryPriceAverages(cmd *cobra.Command) {
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id")
+	cmd.Flags().String(FlagAssets, "", "The asset denom (required)")
+	cmd.Flags().String(FlagPrice, "", "The price denom (required)")
+	cmd.Flags().Duration(FlagWindow, 0, "The amount of time (up to now) to get the averages over, e.g. 24h (required)")
+
+	MarkFlagsRequired(cmd, FlagAssets, FlagPrice, FlagWindow)
+
+	AddUseArgs(cmd,
+		fmt.Sprintf("{<market id>|--%s <market id>}", FlagMarket),
+		ReqFlagUse(FlagAssets, "asset denom"),
+		ReqFlagUse(FlagPrice, "price denom"),
+		ReqFlagUse(FlagWindow, "duration"),
+	)
+	AddUseDetails(cmd,
+		"A <market id> is required as either an arg or flag, but not both.",
+		"The <duration> is truncated to the second and can be at most 720h (30 days).",
+	)
+	AddQueryExample(cmd, "3", "--"+FlagAssets, "apple", "--"+FlagPrice, "nhash", "--"+FlagWindow, "24h")
+	AddQueryExample(cmd, "--"+FlagMarket, "1", "--"+FlagAssets, "apple", "--"+FlagPrice, "nhash", "--"+FlagWindow, "15m")
+
+	cmd.Args = cobra.MaximumNArgs(1)
+}
+
+// MakeQueryPriceAverages reads all the SetupCmdQueryPriceAverages flags and creates the desired request.
+// Satisfies the queryReqMaker type.
+func MakeQueryPriceAverages(_ client.Context, flagSet *pflag.FlagSet, args []string) (*exchange.QueryPriceAveragesRequest, error) {
+	req := &exchange.QueryPriceAveragesRequest{}
+
+	errs := make([]error, 4)
+	req.MarketId, errs[0] = ReadFlagMarketOrArg(flagSet, args)
+	req.AssetDenom, errs[1] = flagSet.GetString(FlagAssets)
+	req.PriceDenom, errs[2] = flagSet.GetString(FlagPrice)
+	var window time.Duration
+	window, errs[3] = flagSet.GetDuration(FlagWindow)
+	if window > 0 {
+		req.WindowSeconds = uint64(window / time.Second)
+	}
+
+	return req, errors.Join(errs...)
+}
+
+// SetupCmdQueryGetMarketSettlements adds all the flags needed for MakeQueryGetMarketSettlements.
+func SetupCmdQueryGetMarketSettlements(cmd *cobra.Command) {
+	flags.AddPaginationFlagsToCmd(cmd, "settlements")
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id")
+	cmd.Flags().Int64(FlagAfter, 0, "Limit results to only settlements at block heights larger than this")
+
+	AddUseArgs(cmd,
+		fmt.Sprintf("{<market id>|--%s <market id>}", FlagMarket),
+		OptFlagUse(FlagAfter, "after height"),
+		PageFlagsUse,
+	)
+	AddUseDetails(cmd, "A <market id> is required as either an arg or flag, but not both.")
+	AddQueryExample(cmd, "3")
+	AddQueryExample(cmd, "--"+FlagMarket, "1", "--"+FlagAfter, "12345", "--"+flags.FlagLimit, "10")
+
+	cmd.Args = cobra.MaximumNArgs(1)
+}
+
+// MakeQueryGetMarketSettlements reads all the SetupCmdQueryGetMarketSettlements flags and creates the desired request.
+// Satisfies the queryReqMaker type.
+func MakeQueryGetMarketSettlements(_ client.Context, flagSet *pflag.FlagSet, args []string) (*exchange.QueryGetMarketSettlementsRequest, error) {
+	req := &exchange.QueryGetMarketSettlementsRequest{}
+
+	errs := make([]error, 3)
+	req.MarketId, errs[0] = ReadFlagMarketOrArg(flagSet, args)
+	req.AfterHeight, errs[1] = flagSet.GetInt64(FlagAfter)
+	req.Pagination, errs[2] = client.ReadPageRequestWithPageKeyDecoded(flagSet)
+
+	return req, errors.Join(errs...)
+}
+
+// SetupCmdQueryGetAllSettlements adds all the flags needed for MakeQueryGetAllSettlements.
+func SetupCmdQueryGetAllSettlements(cmd *cobra.Command) {
+	flags.AddPaginationFlagsToCmd(cmd, "settlements")
+
+	AddUseArgs(cmd, PageFlagsUse)
+	AddUseDetails(cmd)
+	AddQueryExample(cmd, "--"+flags.FlagLimit, "10")
+	AddQueryExample(cmd, "--"+flags.FlagReverse)
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeQueryGetAllSettlements reads all the SetupCmdQueryGetAllSettlements flags and creates the desired request.
+// Satisfies the queryReqMaker type.
+func MakeQueryGetAllSettlements(_ client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.QueryGetAllSettlementsRequest, error) {
+	req := &exchange.QueryGetAllSettlementsRequest{}
+
+	var err error
+	req.Pagination, err = client.ReadPageRequestWithPageKeyDecoded(flagSet)
+
+	return req, err
+}
+
+// SetupCmdQueryGetSettlementReceipt adds all the flags needed for MakeQueryGetSettlementReceipt.
+func SetupCmdQueryGetSettlementReceipt(cmd *cobra.Command) {
+	cmd.Flags().Uint64(FlagSettlement, 0, "The settlement id")
+
+	AddUseArgs(cmd,
+		fmt.Sprintf("{<settlement id>|--%s <settlement id>}", FlagSettlement),
+	)
+	AddUseDetails(cmd, "A <settlement id> is required as either an arg or flag, but not both.")
+	AddQueryExample(cmd, "8")
+	AddQueryExample(cmd, "--"+FlagSettlement, "8")
+
+	cmd.Args = cobra.MaximumNArgs(1)
+}
+
+// MakeQueryGetSettlementReceipt reads all the SetupCmdQueryGetSettlementReceipt flags and creates the desired request.
+// Satisfies the queryReqMaker type.
+func MakeQueryGetSettlementReceipt(_ client.Context, flagSet *pflag.FlagSet, args []string) (*exchange.QueryGetSettlementReceiptRequest, error) {
+	req := &exchange.QueryGetSettlementReceiptRequest{}
+
+	var err error
+	req.SettlementId, err = ReadFlagSettlementOrArg(flagSet, args)
+
+	return req, err
+}
+
+// SetupCmdQuerySimulateMarketSettle adds all the flags needed for MakeQuerySimulateMarketSettle.
+func SetupCmdQuerySimulateMarketSettle(cmd *cobra.Command) {
+	cmd.Flags().String(flags.FlagFrom, "", "The from address")
+	SetupCmdTxMarketSettle(cmd)
+}
+
+// MakeQuerySimulateMarketSettle reads all the SetupCmdQuerySimulateMarketSettle flags and creates the desired request.
+// Satisfies the queryReqMaker type.
+func MakeQuerySimulateMarketSettle(clientCtx client.Context, flagSet *pflag.FlagSet, args []string) (*exchange.QuerySimulateSettlementRequest, error) {
+	req := &exchange.QuerySimulateSettlementRequest{}
+
+	errs := make([]error, 2)
+	clientCtx, errs[0] = readQueryFromFlag(clientCtx, flagSet)
+	req.MarketSettleRequest, errs[1] = MakeMsgMarketSettle(clientCtx, flagSet, args)
+
+	return req, errors.Join(errs...)
+}
+
+// SetupCmdQuerySimulateFillBids adds all the flags needed for MakeQuerySimulateFillBids.
+func SetupCmdQuerySimulateFillBids(cmd *cobra.Command) {
+	cmd.Flags().String(flags.FlagFrom, "", "The from address")
+	SetupCmdTxFillBids(cmd)
+}
+
+// MakeQuerySimulateFillBids reads all the SetupCmdQuerySimulateFillBids flags and creates the desired request.
+// Satisfies the queryReqMaker type.
+func MakeQuerySimulateFillBids(clientCtx client.Context, flagSet *pflag.FlagSet, args []string) (*exchange.QuerySimulateSettlementRequest, error) {
+	req := &exchange.QuerySimulateSettlementRequest{}
+
+	errs := make([]error, 2)
+	clientCtx, errs[0] = readQueryFromFlag(clientCtx, flagSet)
+	req.FillBidsRequest, errs[1] = MakeMsgFillBids(clientCtx, flagSet, args)
+
+	return req, errors.Join(errs...)
+}
+
+// SetupCmdQuerySimulateFillAsks adds all the flags needed for MakeQuerySimulateFillAsks.
+func SetupCmdQuerySimulateFillAsks(cmd *cobra.Command) {
+	cmd.Flags().String(flags.FlagFrom, "", "The from address")
+	SetupCmdTxFillAsks(cmd)
+}
+
+// MakeQuerySimulateFillAsks reads all the SetupCmdQuerySimulateFillAsks flags and creates the desired request.
+// Satisfies the queryReqMaker type.
+func MakeQuerySimulateFillAsks(clientCtx client.Context, flagSet *pflag.FlagSet, args []string) (*exchange.QuerySimulateSettlementRequest, error) {
+	req := &exchange.QuerySimulateSettlementRequest{}
+
+	errs := make([]error, 2)
+	clientCtx, errs[0] = readQueryFromFlag(clientCtx, flagSet)
+	req.FillAsksRequest, errs[1] = MakeMsgFillAsks(clientCtx, flagSet, args)
+
+	return req, errors.Join(errs...)
+}
+
 // SetupCmdQueryGetCommitment adds all the flags needed for MakeQueryGetCommitment.
 func SetupCmdQueryGetCommitment(cmd *cobra.Command) {
 	cmd.Flags().String(FlagAccount, "", "The account's address")
@@ -399,6 +818,126 @@ func MakeQueryGetAllCommitments(_ client.Context, flagSet *pflag.FlagSet, _ []st
 	return req, err
 }
 
+// SetupCmdQueryGetLatestNAV adds all the flags needed for MakeQueryGetLatestNAV.
+func SetupCmdQueryGetLatestNAV(cmd *cobra.Command) {
+	cmd.Flags().String(FlagAssets, "", "The asset denom (required)")
+	cmd.Flags().String(FlagPrice, "", "The price denom (required)")
+
+	MarkFlagsRequired(cmd, FlagAssets, FlagPrice)
+
+	AddUseArgs(cmd,
+		ReqFlagUse(FlagAssets, "asset denom"),
+		ReqFlagUse(FlagPrice, "price denom"),
+	)
+	AddUseDetails(cmd)
+	AddQueryExample(cmd, "--"+FlagAssets, "apple", "--"+FlagPrice, "nhash")
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeQueryGetLatestNAV reads all the SetupCmdQueryGetLatestNAV flags and creates the desired request.
+// Satisfies the queryReqMaker type.
+func MakeQueryGetLatestNAV(_ client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.QueryGetLatestNAVRequest, error) {
+	req := &exchange.QueryGetLatestNAVRequest{}
+
+	errs := make([]error, 2)
+	req.AssetDenom, errs[0] = flagSet.GetString(FlagAssets)
+	req.PriceDenom, errs[1] = flagSet.GetString(FlagPrice)
+
+	return req, errors.Join(errs...)
+}
+
+// SetupCmdQueryGetNAVHistory adds all the flags needed for MakeQueryGetNAVHistory.
+func SetupCmdQueryGetNAVHistory(cmd *cobra.Command) {
+	flags.AddPaginationFlagsToCmd(cmd, "navs")
+	cmd.Flags().String(FlagAssets, "", "The asset denom (required)")
+	cmd.Flags().String(FlagPrice, "", "The price denom (required)")
+	cmd.Flags().Int64(FlagMinHeight, 0, "Limit results to only NAVs recorded at or after this block height")
+	cmd.Flags().Int64(FlagMaxHeight, 0, "Limit results to only NAVs recorded at or before this block height")
+
+	MarkFlagsRequired(cmd, FlagAssets, FlagPrice)
+
+	AddUseArgs(cmd,
+		ReqFlagUse(FlagAssets, "asset denom"),
+		ReqFlagUse(FlagPrice, "price denom"),
+		OptFlagUse(FlagMinHeight, "min height"),
+		OptFlagUse(FlagMaxHeight, "max height"),
+		PageFlagsUse,
+	)
+	AddUseDetails(cmd, "Results are ordered from oldest to newest.")
+	AddQueryExample(cmd, "--"+FlagAssets, "apple", "--"+FlagPrice, "nhash")
+	AddQueryExample(cmd, "--"+FlagAssets, "apple", "--"+FlagPrice, "nhash", "--"+FlagMinHeight, "12345", "--"+flags.FlagReverse)
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeQueryGetNAVHistory reads all the SetupCmdQueryGetNAVHistory flags and creates the desired request.
+// Satisfies the queryReqMaker type.
+func MakeQueryGetNAVHistory(_ client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.QueryGetNAVHistoryRequest, error) {
+	req := &exchange.QueryGetNAVHistoryRequest{}
+
+	errs := make([]error, 5)
+	req.AssetDenom, errs[0] = flagSet.GetString(FlagAssets)
+	req.PriceDenom, errs[1] = flagSet.GetString(FlagPrice)
+	req.MinHeight, errs[2] = flagSet.GetInt64(FlagMinHeight)
+	req.MaxHeight, errs[3] = flagSet.GetInt64(FlagMaxHeight)
+	req.Pagination, errs[4] = client.ReadPageRequestWithPageKeyDecoded(flagSet)
+
+	return req, errors.Join(errs...)
+}
+
+// SetupCmdQueryGetCommitmentRewardPool adds all the flags needed for MakeQueryGetCommitmentRewardPool.
+func SetupCmdQueryGetCommitmentRewardPool(cmd *cobra.Command) {
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id")
+
+	AddUseArgs(cmd,
+		fmt.Sprintf("{<market id>|--%s <market id>}", FlagMarket),
+	)
+	AddUseDetails(cmd, "A <market id> is required as either an arg or flag, but not both.")
+	AddQueryExample(cmd, "3")
+	AddQueryExample(cmd, "--"+FlagMarket, "1")
+
+	cmd.Args = cobra.MaximumNArgs(1)
+}
+
+// MakeQueryGetCommitmentRewardPool reads all the SetupCmdQueryGetCommitmentRewardPool flags and creates the desired request.
+// Satisfies the queryReqMaker type.
+func MakeQueryGetCommitmentRewardPool(_ client.Context, flagSet *pflag.FlagSet, args []string) (*exchange.QueryGetCommitmentRewardPoolRequest, error) {
+	req := &exchange.QueryGetCommitmentRewardPoolRequest{}
+
+	var err error
+	req.MarketId, err = ReadFlagMarketOrArg(flagSet, args)
+
+	return req, err
+}
+
+// SetupCmdQueryGetAccountCommitmentRewards adds all the flags needed for MakeQueryGetAccountCommitmentRewards.
+func SetupCmdQueryGetAccountCommitmentRewards(cmd *cobra.Command) {
+	cmd.Flags().String(FlagAccount, "", "The account's address")
+
+	AddUseArgs(cmd,
+		fmt.Sprintf("{<account>|--%s <account>}", FlagAccount),
+	)
+	AddUseDetails(cmd,
+		"An <account> is required as either an arg or flag, but not both.",
+	)
+	AddQueryExample(cmd, ExampleAddr)
+	AddQueryExample(cmd, "--"+FlagAccount, ExampleAddr)
+
+	cmd.Args = cobra.MaximumNArgs(1)
+}
+
+// MakeQueryGetAccountCommitmentRewards reads all the SetupCmdQueryGetAccountCommitmentRewards flags and creates the desired request.
+// Satisfies the queryReqMaker type.
+func MakeQueryGetAccountCommitmentRewards(_ client.Context, flagSet *pflag.FlagSet, args []string) (*exchange.QueryGetAccountCommitmentRewardsRequest, error) {
+	req := &exchange.QueryGetAccountCommitmentRewardsRequest{}
+
+	var err error
+	req.Account, err = ReadStringFlagOrArg(flagSet, args, FlagAccount, "account")
+
+	return req, err
+}
+
 // SetupCmdQueryGetMarket adds all the flags needed for MakeQueryGetMarket.
 func SetupCmdQueryGetMarket(cmd *cobra.Command) {
 	cmd.Flags().Uint32(FlagMarket, 0, "The market id")
@@ -424,6 +963,88 @@ func MakeQueryGetMarket(_ client.Context, flagSet *pflag.FlagSet, args []string)
 	return req, err
 }
 
+// SetupCmdQueryGetScheduledFeeChanges adds all the flags needed for MakeQueryGetScheduledFeeChanges.
+func SetupCmdQueryGetScheduledFeeChanges(cmd *cobra.Command) {
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id")
+
+	AddUseArgs(cmd,
+		fmt.Sprintf("{<market id>|--%s <market id>}", FlagMarket),
+	)
+	AddUseDetails(cmd, "A <market id> is required as either an arg or flag, but not both.")
+	AddQueryExample(cmd, "3")
+	AddQueryExample(cmd, "--"+FlagMarket, "1")
+
+	cmd.Args = cobra.MaximumNArgs(1)
+}
+
+// MakeQueryGetScheduledFeeChanges reads all the SetupCmdQueryGetScheduledFeeChanges flags and creates the desired request.
+// Satisfies the queryReqMaker type.
+func MakeQueryGetScheduledFeeChanges(_ client.Context, flagSet *pflag.FlagSet, args []string) (*exchange.QueryGetScheduledFeeChangesRequest, error) {
+	req := &exchange.QueryGetScheduledFeeChangesRequest{}
+
+	var err error
+	req.MarketId, err = ReadFlagMarketOrArg(flagSet, args)
+
+	return req, err
+}
+
+// SetupCmdQueryGetMarketAuthorityTransfer adds all the flags needed for MakeQueryGetMarketAuthorityTransfer.
+func SetupCmdQueryGetMarketAuthorityTransfer(cmd *cobra.Command) {
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id")
+
+	AddUseArgs(cmd,
+		fmt.Sprintf("{<market id>|--%s <market id>}", FlagMarket),
+	)
+	AddUseDetails(cmd, "A <market id> is required as either an arg or flag, but not both.")
+	AddQueryExample(cmd, "3")
+	AddQueryExample(cmd, "--"+FlagMarket, "1")
+
+	cmd.Args = cobra.MaximumNArgs(1)
+}
+
+// MakeQueryGetMarketAuthorityTransfer reads all the SetupCmdQueryGetMarketAuthorityTransfer flags and creates the desired request.
+// Satisfies the queryReqMaker type.
+func MakeQueryGetMarketAuthorityTransfer(_ client.Context, flagSet *pflag.FlagSet, args []string) (*exchange.QueryGetMarketAuthorityTransferRequest, error) {
+	req := &exchange.QueryGetMarketAuthorityTransferRequest{}
+
+	var err error
+	req.MarketId, err = ReadFlagMarketOrArg(flagSet, args)
+
+	return req, err
+}
+
+// SetupCmdQueryExportMarket adds all the flags needed for MakeQueryExportMarket.
+func SetupCmdQueryExportMarket(cmd *cobra.Command) {
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The snapshot is written as JSON to the given file instead of STDOUT")
+
+	AddUseArgs(cmd,
+		fmt.Sprintf("{<market id>|--%s <market id>}", FlagMarket),
+		OptFlagUse(flags.FlagOutputDocument, "file"),
+		OptFlagUse(flags.FlagHeight, "height"),
+	)
+	AddUseDetails(cmd,
+		"A <market id> is required as either an arg or flag, but not both.",
+		fmt.Sprintf("The snapshot is taken from the state at the provided --%s, or the latest state if not provided.", flags.FlagHeight),
+	)
+	AddQueryExample(cmd, "3")
+	AddQueryExample(cmd, "3", "--"+flags.FlagOutputDocument, "market-3.json")
+	AddQueryExample(cmd, "--"+FlagMarket, "1", "--"+flags.FlagHeight, "12345", "--"+flags.FlagOutputDocument, "market-1.json")
+
+	cmd.Args = cobra.MaximumNArgs(1)
+}
+
+// MakeQueryExportMarket reads all the SetupCmdQueryExportMarket flags and creates the desired request.
+// Satisfies the queryReqMaker type.
+func MakeQueryExportMarket(_ client.Context, flagSet *pflag.FlagSet, args []string) (*exchange.QueryExportMarketRequest, error) {
+	req := &exchange.QueryExportMarketRequest{}
+
+	var err error
+	req.MarketId, err = ReadFlagMarketOrArg(flagSet, args)
+
+	return req, err
+}
+
 // SetupCmdQueryGetAllMarkets adds all the flags needed for MakeQueryGetAllMarkets.
 func SetupCmdQueryGetAllMarkets(cmd *cobra.Command) {
 	flags.AddPaginationFlagsToCmd(cmd, "markets")
@@ -474,21 +1095,30 @@ func SetupCmdQueryCommitmentSettlementFeeCalc(cmd *cobra.Command) {
 func MakeQueryCommitmentSettlementFeeCalc(clientCtx client.Context, flagSet *pflag.FlagSet, args []string) (*exchange.QueryCommitmentSettlementFeeCalcRequest, error) {
 	rv := &exchange.QueryCommitmentSettlementFeeCalcRequest{}
 
-	errs := make([]error, 4)
-	clientCtx.From, errs[0] = flagSet.GetString(flags.FlagFrom)
-	if len(clientCtx.From) > 0 {
-		if addr, err := sdk.AccAddressFromBech32(clientCtx.From); err == nil {
-			clientCtx.FromAddress = addr
-		} else {
-			clientCtx.FromAddress, clientCtx.From, _, errs[1] = client.GetFromFields(clientCtx, clientCtx.Keyring, clientCtx.From)
-		}
-	}
-	rv.Settlement, errs[2] = MakeMsgMarketCommitmentSettle(clientCtx, flagSet, args)
-	rv.IncludeBreakdownFields, errs[3] = flagSet.GetBool(FlagDetails)
+	errs := make([]error, 3)
+	clientCtx, errs[0] = readQueryFromFlag(clientCtx, flagSet)
+	rv.Settlement, errs[1] = MakeMsgMarketCommitmentSettle(clientCtx, flagSet, args)
+	rv.IncludeBreakdownFields, errs[2] = flagSet.GetBool(FlagDetails)
 
 	return rv, errors.Join(errs...)
 }
 
+// readQueryFromFlag reads the --from flag (if provided) and sets the from fields of the client context from it.
+// The --from flag can be either a bech32 address or the name of a key in the keyring.
+func readQueryFromFlag(clientCtx client.Context, flagSet *pflag.FlagSet) (client.Context, error) {
+	var err error
+	clientCtx.From, err = flagSet.GetString(flags.FlagFrom)
+	if err != nil || len(clientCtx.From) == 0 {
+		return clientCtx, err
+	}
+	if addr, aerr := sdk.AccAddressFromBech32(clientCtx.From); aerr == nil {
+		clientCtx.FromAddress = addr
+		return clientCtx, nil
+	}
+	clientCtx.FromAddress, clientCtx.From, _, err = client.GetFromFields(clientCtx, clientCtx.Keyring, clientCtx.From)
+	return clientCtx, err
+}
+
 // SetupCmdQueryValidateCreateMarket adds all the flags needed for MakeQueryValidateCreateMarket.
 func SetupCmdQueryValidateCreateMarket(cmd *cobra.Command) {
 	SetupCmdTxGovCreateMarket(cmd)
@@ -585,16 +1215,21 @@ func MakeQueryGetPayment(_ client.Context, flagSet *pflag.FlagSet, args []string
 func SetupCmdQueryGetPaymentsWithSource(cmd *cobra.Command) {
 	flags.AddPaginationFlagsToCmd(cmd, "payments")
 	cmd.Flags().String(FlagSource, "", "The source account of the payments")
+	AddFlagsPaymentFilter(cmd)
 
 	AddUseArgs(cmd,
 		fmt.Sprintf("{<source>|--%s <source>}", FlagSource),
+		OptPaymentFilterUse,
 		PageFlagsUse,
 	)
 	AddUseDetails(cmd,
 		"A <source> is required as either an arg or a flag, but not both.",
+		PaymentFilterDesc,
 	)
 	AddQueryExample(cmd, ExampleAddr)
 	AddQueryExample(cmd, "--"+FlagSource, ExampleAddr)
+	AddQueryExample(cmd, ExampleAddr, "--"+FlagDenom, "nhash", "--"+FlagMinAmount, "1000")
+	AddQueryExample(cmd, ExampleAddr, "--"+FlagWithoutTargetAmount)
 
 	cmd.Args = cobra.MaximumNArgs(1)
 }
@@ -604,9 +1239,10 @@ func SetupCmdQueryGetPaymentsWithSource(cmd *cobra.Command) {
 func MakeQueryGetPaymentsWithSource(_ client.Context, flagSet *pflag.FlagSet, args []string) (*exchange.QueryGetPaymentsWithSourceRequest, error) {
 	req := &exchange.QueryGetPaymentsWithSourceRequest{}
 
-	errs := make([]error, 2)
+	errs := make([]error, 3)
 	req.Source, errs[0] = ReadStringFlagOrArg(flagSet, args, FlagSource, "source")
-	req.Pagination, errs[1] = client.ReadPageRequestWithPageKeyDecoded(flagSet)
+	req.Filter, errs[1] = ReadFlagsPaymentFilter(flagSet)
+	req.Pagination, errs[2] = client.ReadPageRequestWithPageKeyDecoded(flagSet)
 
 	return req, errors.Join(errs...)
 }
@@ -615,16 +1251,21 @@ func MakeQueryGetPaymentsWithSource(_ client.Context, flagSet *pflag.FlagSet, ar
 func SetupCmdQueryGetPaymentsWithTarget(cmd *cobra.Command) {
 	flags.AddPaginationFlagsToCmd(cmd, "payments")
 	cmd.Flags().String(FlagTarget, "", "The target account of the payments")
+	AddFlagsPaymentFilter(cmd)
 
 	AddUseArgs(cmd,
 		fmt.Sprintf("{<target>|--%s <target>}", FlagTarget),
+		OptPaymentFilterUse,
 		PageFlagsUse,
 	)
 	AddUseDetails(cmd,
 		"A <target> is required as either an arg or a flag, but not both.",
+		PaymentFilterDesc,
 	)
 	AddQueryExample(cmd, ExampleAddr)
 	AddQueryExample(cmd, "--"+FlagTarget, ExampleAddr)
+	AddQueryExample(cmd, ExampleAddr, "--"+FlagDenom, "nhash", "--"+FlagMinAmount, "1000")
+	AddQueryExample(cmd, ExampleAddr, "--"+FlagWithoutTargetAmount)
 
 	cmd.Args = cobra.MaximumNArgs(1)
 }
@@ -634,9 +1275,10 @@ func SetupCmdQueryGetPaymentsWithTarget(cmd *cobra.Command) {
 func MakeQueryGetPaymentsWithTarget(_ client.Context, flagSet *pflag.FlagSet, args []string) (*exchange.QueryGetPaymentsWithTargetRequest, error) {
 	req := &exchange.QueryGetPaymentsWithTargetRequest{}
 
-	errs := make([]error, 2)
+	errs := make([]error, 3)
 	req.Target, errs[0] = ReadStringFlagOrArg(flagSet, args, FlagTarget, "target")
-	req.Pagination, errs[1] = client.ReadPageRequestWithPageKeyDecoded(flagSet)
+	req.Filter, errs[1] = ReadFlagsPaymentFilter(flagSet)
+	req.Pagination, errs[2] = client.ReadPageRequestWithPageKeyDecoded(flagSet)
 
 	return req, errors.Join(errs...)
 }
@@ -706,3 +1348,29 @@ func MakeQueryPaymentFeeCalc(clientCtx client.Context, flagSet *pflag.FlagSet, _
 
 	return req, errors.Join(errs...)
 }
+
+// SetupCmdQueryGetAccountActivitySummary adds all the flags needed for MakeQueryGetAccountActivitySummary.
+func SetupCmdQueryGetAccountActivitySummary(cmd *cobra.Command) {
+	cmd.Flags().String(FlagAccount, "", "The account's address")
+
+	AddUseArgs(cmd,
+		fmt.Sprintf("{<account>|--%s <account>}", FlagAccount),
+	)
+	AddUseDetails(cmd,
+		"An <account> is required as either an arg or flag, but not both.",
+	)
+	AddQueryExample(cmd, ExampleAddr)
+	AddQueryExample(cmd, "--"+FlagAccount, ExampleAddr)
+
+	cmd.Args = cobra.MaximumNArgs(1)
+}
+
+// MakeQueryGetAccountActivitySummary reads all the SetupCmdQueryGetAccountActivitySummary flags and creates the desired request.
+// Satisfies the queryReqMaker type.
+func MakeQueryGetAccountActivitySummary(_ client.Context, flagSet *pflag.FlagSet, args []string) (*exchange.QueryGetAccountActivitySummaryRequest, error) {
+	rv := &exchange.QueryGetAccountActivitySummaryRequest{}
+
+	var err error
+	rv.Account, err = ReadStringFlagOrArg(flagSet, args, FlagAccount, "account")
+	return rv, err
+}
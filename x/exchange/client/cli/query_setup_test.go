@@ -331,6 +331,96 @@ func TestMakeQueryGetOrderByExternalID(t *testing.T) {
 	}
 }
 
+func TestSetupCmdQueryGetOrdersByExternalIDPrefix(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdQueryGetOrdersByExternalIDPrefix",
+		setup: cli.SetupCmdQueryGetOrdersByExternalIDPrefix,
+		expFlags: []string{
+			flags.FlagPage, flags.FlagPageKey, flags.FlagOffset,
+			flags.FlagLimit, flags.FlagCountTotal, flags.FlagReverse,
+			cli.FlagMarket, cli.FlagPrefix,
+		},
+		expAnnotations: map[string]map[string][]string{
+			cli.FlagPrefix: {required: {"true"}},
+		},
+		expInUse: []string{
+			"{<market id>|--market <market id>}", "--prefix <external id prefix>", cli.PageFlagsUse,
+			"A <market id> is required as either an arg or flag, but not both.",
+		},
+		expExamples: []string{
+			exampleStart + " 3 --prefix acme-2024-",
+			exampleStart + " --market 1 --prefix acme- --limit 10",
+		},
+	})
+}
+
+func TestMakeQueryGetOrdersByExternalIDPrefix(t *testing.T) {
+	td := queryMakerTestDef[exchange.QueryGetOrdersByExternalIDPrefixRequest]{
+		makerName: "MakeQueryGetOrdersByExternalIDPrefix",
+		maker:     cli.MakeQueryGetOrdersByExternalIDPrefix,
+		setup:     cli.SetupCmdQueryGetOrdersByExternalIDPrefix,
+	}
+
+	defaultPageReq := &query.PageRequest{
+		Key:   []byte{},
+		Limit: 100,
+	}
+	tests := []queryMakerTestCase[exchange.QueryGetOrdersByExternalIDPrefixRequest]{
+		{
+			name:  "no market id",
+			flags: []string{"--prefix", "acme"},
+			expReq: &exchange.QueryGetOrdersByExternalIDPrefixRequest{
+				ExternalIdPrefix: "acme",
+				Pagination:       defaultPageReq,
+			},
+			expErr: "no <market id> provided",
+		},
+		{
+			name:  "market id flag",
+			flags: []string{"--market", "2", "--prefix", "acme"},
+			expReq: &exchange.QueryGetOrdersByExternalIDPrefixRequest{
+				MarketId:         2,
+				ExternalIdPrefix: "acme",
+				Pagination:       defaultPageReq,
+			},
+		},
+		{
+			name:  "both market id flag and arg",
+			flags: []string{"--market", "2", "--prefix", "acme"},
+			args:  []string{"2"},
+			expReq: &exchange.QueryGetOrdersByExternalIDPrefixRequest{
+				ExternalIdPrefix: "acme",
+				Pagination:       defaultPageReq,
+			},
+			expErr: "cannot provide <market id> as both an arg (\"2\") and flag (--market 2)",
+		},
+		{
+			name: "all opts",
+			flags: []string{
+				"--prefix", "acme-2024-", "--limit", "25", "--page-key", "MDAx",
+				"--reverse", "--count-total",
+			},
+			args: []string{"7"},
+			expReq: &exchange.QueryGetOrdersByExternalIDPrefixRequest{
+				MarketId:         7,
+				ExternalIdPrefix: "acme-2024-",
+				Pagination: &query.PageRequest{
+					Key:        []byte("001"),
+					Limit:      25,
+					CountTotal: true,
+					Reverse:    true,
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runQueryMakerTest(t, td, tc)
+		})
+	}
+}
+
 func TestSetupCmdQueryGetMarketOrders(t *testing.T) {
 	runSetupTestCase(t, setupTestCase{
 		name:  "SetupCmdQueryGetMarketOrders",
@@ -339,6 +429,7 @@ func TestSetupCmdQueryGetMarketOrders(t *testing.T) {
 			flags.FlagPage, flags.FlagPageKey, flags.FlagOffset,
 			flags.FlagLimit, flags.FlagCountTotal, flags.FlagReverse,
 			cli.FlagMarket, cli.FlagAsks, cli.FlagBids, cli.FlagAfter,
+			cli.FlagAssets, cli.FlagPrice,
 		},
 		expAnnotations: map[string]map[string][]string{
 			cli.FlagAsks: {mutExc: {cli.FlagAsks + " " + cli.FlagBids}},
@@ -346,13 +437,16 @@ func TestSetupCmdQueryGetMarketOrders(t *testing.T) {
 		},
 		expInUse: []string{
 			"{<market id>|--market <market id>}", cli.OptAsksBidsUse,
-			"[--after <after order id>", cli.PageFlagsUse,
+			"[--after <after order id>", "[--assets <asset denom> --price <price denom>]", cli.PageFlagsUse,
 			"A <market id> is required as either an arg or flag, but not both.",
 			cli.OptAsksBidsDesc,
+			"The --assets and --price flags must be provided together.",
+			"When they are, results are ordered by unit price (lowest first) instead of order id.",
 		},
 		expExamples: []string{
 			exampleStart + " 3 --asks",
 			exampleStart + " --market 1 --after 15 --limit 10",
+			exampleStart + " 3 --bids --assets apple --price nhash --reverse",
 		},
 	})
 }
@@ -437,6 +531,18 @@ func TestMakeQueryGetMarketOrders(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:  "by price",
+			flags: []string{"--assets", "apple", "--price", "nhash", "--asks"},
+			args:  []string{"5"},
+			expReq: &exchange.QueryGetMarketOrdersRequest{
+				MarketId:   5,
+				OrderType:  "ask",
+				AssetDenom: "apple",
+				PriceDenom: "nhash",
+				Pagination: defaultPageReq,
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -729,45 +835,121 @@ func TestMakeQueryGetAllOrders(t *testing.T) {
 	}
 }
 
-func TestSetupCmdQueryGetCommitment(t *testing.T) {
+func TestSetupCmdQueryGetTriggerOrder(t *testing.T) {
 	runSetupTestCase(t, setupTestCase{
-		name:  "SetupCmdQueryGetCommitment",
-		setup: cli.SetupCmdQueryGetCommitment,
-		expFlags: []string{
-			cli.FlagAccount, cli.FlagMarket,
+		name:     "SetupCmdQueryGetTriggerOrder",
+		setup:    cli.SetupCmdQueryGetTriggerOrder,
+		expFlags: []string{cli.FlagOrder},
+		expInUse: []string{
+			"{<order id>|--order <order id>}",
+			"An <order id> is required as either an arg or flag, but not both.",
 		},
-		expAnnotations: map[string]map[string][]string{
-			cli.FlagAccount: {required: {"true"}},
-			cli.FlagMarket:  {required: {"true"}},
+		expExamples: []string{
+			exampleStart + " 8",
+			exampleStart + " --order 8",
+		},
+	})
+}
+
+func TestMakeQueryGetTriggerOrder(t *testing.T) {
+	td := queryMakerTestDef[exchange.QueryGetTriggerOrderRequest]{
+		makerName: "MakeQueryGetTriggerOrder",
+		maker:     cli.MakeQueryGetTriggerOrder,
+		setup:     cli.SetupCmdQueryGetTriggerOrder,
+	}
+
+	tests := []queryMakerTestCase[exchange.QueryGetTriggerOrderRequest]{
+		{
+			name:   "no order id",
+			expReq: &exchange.QueryGetTriggerOrderRequest{},
+			expErr: "no <order id> provided",
+		},
+		{
+			name:   "just order flag",
+			flags:  []string{"--order", "15"},
+			expReq: &exchange.QueryGetTriggerOrderRequest{OrderId: 15},
+		},
+		{
+			name:   "just order id arg",
+			args:   []string{"83"},
+			expReq: &exchange.QueryGetTriggerOrderRequest{OrderId: 83},
+		},
+		{
+			name:   "both order flag and arg",
+			flags:  []string{"--order", "15"},
+			args:   []string{"83"},
+			expReq: &exchange.QueryGetTriggerOrderRequest{},
+			expErr: "cannot provide <order id> as both an arg (\"83\") and flag (--order 15)",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runQueryMakerTest(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdQueryGetMarketTriggerOrders(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdQueryGetMarketTriggerOrders",
+		setup: cli.SetupCmdQueryGetMarketTriggerOrders,
+		expFlags: []string{
+			flags.FlagPage, flags.FlagPageKey, flags.FlagOffset,
+			flags.FlagLimit, flags.FlagCountTotal, flags.FlagReverse,
+			cli.FlagMarket,
 		},
 		expInUse: []string{
-			"--account <account>",
-			"--market <market id>",
+			"{<market id>|--market <market id>}",
+			cli.PageFlagsUse,
+			"A <market id> is required as either an arg or flag, but not both.",
 		},
 		expExamples: []string{
-			exampleStart + " --account " + cli.ExampleAddr + " --market 3",
+			exampleStart + " 3",
+			exampleStart + " --market 1 --limit 10",
 		},
 	})
 }
 
-func TestMakeQueryGetCommitment(t *testing.T) {
-	td := queryMakerTestDef[exchange.QueryGetCommitmentRequest]{
-		makerName: "MakeQueryGetCommitment",
-		maker:     cli.MakeQueryGetCommitment,
-		setup:     cli.SetupCmdQueryGetCommitment,
+func TestMakeQueryGetMarketTriggerOrders(t *testing.T) {
+	td := queryMakerTestDef[exchange.QueryGetMarketTriggerOrdersRequest]{
+		makerName: "MakeQueryGetMarketTriggerOrders",
+		maker:     cli.MakeQueryGetMarketTriggerOrders,
+		setup:     cli.SetupCmdQueryGetMarketTriggerOrders,
 	}
 
-	tests := []queryMakerTestCase[exchange.QueryGetCommitmentRequest]{
+	defaultPageReq := &query.PageRequest{
+		Key:   []byte{},
+		Limit: 100,
+	}
+	tests := []queryMakerTestCase[exchange.QueryGetMarketTriggerOrdersRequest]{
 		{
-			name:   "no flags",
-			expReq: &exchange.QueryGetCommitmentRequest{},
+			name:   "no market id",
+			expReq: &exchange.QueryGetMarketTriggerOrdersRequest{Pagination: defaultPageReq},
+			expErr: "no <market id> provided",
 		},
 		{
-			name:  "all flags",
-			flags: []string{"--account", cli.ExampleAddr, "--market", "3"},
-			expReq: &exchange.QueryGetCommitmentRequest{
-				Account:  cli.ExampleAddr,
-				MarketId: 3,
+			name:  "just market id flag",
+			flags: []string{"--market", "1"},
+			expReq: &exchange.QueryGetMarketTriggerOrdersRequest{
+				MarketId:   1,
+				Pagination: defaultPageReq,
+			},
+		},
+		{
+			name: "just market id arg",
+			args: []string{"1"},
+			expReq: &exchange.QueryGetMarketTriggerOrdersRequest{
+				MarketId:   1,
+				Pagination: defaultPageReq,
+			},
+		},
+		{
+			name:  "with some pagination fields",
+			flags: []string{"--market", "8", "--limit", "10", "--reverse"},
+			expReq: &exchange.QueryGetMarketTriggerOrdersRequest{
+				MarketId:   8,
+				Pagination: &query.PageRequest{Limit: 10, Reverse: true, Key: []byte{}},
 			},
 		},
 	}
@@ -779,75 +961,1348 @@ func TestMakeQueryGetCommitment(t *testing.T) {
 	}
 }
 
-func TestSetupCmdQueryGetAccountCommitments(t *testing.T) {
+func TestSetupCmdQueryGetOrderLink(t *testing.T) {
 	runSetupTestCase(t, setupTestCase{
-		name:  "SetupCmdQueryGetAccountCommitments",
-		setup: cli.SetupCmdQueryGetAccountCommitments,
+		name:     "SetupCmdQueryGetOrderLink",
+		setup:    cli.SetupCmdQueryGetOrderLink,
+		expFlags: []string{cli.FlagOrder},
+		expInUse: []string{
+			"{<order id>|--order <order id>}",
+			"An <order id> is required as either an arg or flag, but not both.",
+		},
+		expExamples: []string{
+			exampleStart + " 8",
+			exampleStart + " --order 8",
+		},
+	})
+}
+
+func TestMakeQueryGetOrderLink(t *testing.T) {
+	td := queryMakerTestDef[exchange.QueryGetOrderLinkRequest]{
+		makerName: "MakeQueryGetOrderLink",
+		maker:     cli.MakeQueryGetOrderLink,
+		setup:     cli.SetupCmdQueryGetOrderLink,
+	}
+
+	tests := []queryMakerTestCase[exchange.QueryGetOrderLinkRequest]{
+		{
+			name:   "no order id",
+			expReq: &exchange.QueryGetOrderLinkRequest{},
+			expErr: "no <order id> provided",
+		},
+		{
+			name:   "just order flag",
+			flags:  []string{"--order", "15"},
+			expReq: &exchange.QueryGetOrderLinkRequest{OrderId: 15},
+		},
+		{
+			name:   "just order id arg",
+			args:   []string{"83"},
+			expReq: &exchange.QueryGetOrderLinkRequest{OrderId: 83},
+		},
+		{
+			name:   "both order flag and arg",
+			flags:  []string{"--order", "15"},
+			args:   []string{"83"},
+			expReq: &exchange.QueryGetOrderLinkRequest{},
+			expErr: "cannot provide <order id> as both an arg (\"83\") and flag (--order 15)",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runQueryMakerTest(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdQueryGetMarketOrderLinks(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdQueryGetMarketOrderLinks",
+		setup: cli.SetupCmdQueryGetMarketOrderLinks,
 		expFlags: []string{
-			cli.FlagAccount,
+			flags.FlagPage, flags.FlagPageKey, flags.FlagOffset,
+			flags.FlagLimit, flags.FlagCountTotal, flags.FlagReverse,
+			cli.FlagMarket,
 		},
 		expInUse: []string{
-			"{<account>|--account <account>}",
-			"An <account> is required as either an arg or flag, but not both.",
+			"{<market id>|--market <market id>}",
+			cli.PageFlagsUse,
+			"A <market id> is required as either an arg or flag, but not both.",
 		},
 		expExamples: []string{
-			exampleStart + " " + cli.ExampleAddr,
-			exampleStart + " --account " + cli.ExampleAddr,
-			exampleStart + " --account " + cli.ExampleAddr + " --denom nhash",
+			exampleStart + " 3",
+			exampleStart + " --market 1 --limit 10",
 		},
 	})
 }
 
-func TestMakeQueryGetAccountCommitments(t *testing.T) {
-	td := queryMakerTestDef[exchange.QueryGetAccountCommitmentsRequest]{
-		makerName: "MakeQueryGetAccountCommitments",
-		maker:     cli.MakeQueryGetAccountCommitments,
-		setup:     cli.SetupCmdQueryGetAccountCommitments,
+func TestMakeQueryGetMarketOrderLinks(t *testing.T) {
+	td := queryMakerTestDef[exchange.QueryGetMarketOrderLinksRequest]{
+		makerName: "MakeQueryGetMarketOrderLinks",
+		maker:     cli.MakeQueryGetMarketOrderLinks,
+		setup:     cli.SetupCmdQueryGetMarketOrderLinks,
 	}
 
-	tests := []queryMakerTestCase[exchange.QueryGetAccountCommitmentsRequest]{
+	defaultPageReq := &query.PageRequest{
+		Key:   []byte{},
+		Limit: 100,
+	}
+	tests := []queryMakerTestCase[exchange.QueryGetMarketOrderLinksRequest]{
 		{
-			name:   "no account",
-			expReq: &exchange.QueryGetAccountCommitmentsRequest{},
-			expErr: "no <account> provided",
+			name:   "no market id",
+			expReq: &exchange.QueryGetMarketOrderLinksRequest{Pagination: defaultPageReq},
+			expErr: "no <market id> provided",
 		},
 		{
-			name:  "account as flag",
-			flags: []string{"--account", "someaddr"},
-			expReq: &exchange.QueryGetAccountCommitmentsRequest{
-				Account: "someaddr",
+			name:  "just market id flag",
+			flags: []string{"--market", "1"},
+			expReq: &exchange.QueryGetMarketOrderLinksRequest{
+				MarketId:   1,
+				Pagination: defaultPageReq,
+			},
+		},
+		{
+			name: "just market id arg",
+			args: []string{"1"},
+			expReq: &exchange.QueryGetMarketOrderLinksRequest{
+				MarketId:   1,
+				Pagination: defaultPageReq,
+			},
+		},
+		{
+			name:  "with some pagination fields",
+			flags: []string{"--market", "8", "--limit", "10", "--reverse"},
+			expReq: &exchange.QueryGetMarketOrderLinksRequest{
+				MarketId:   8,
+				Pagination: &query.PageRequest{Limit: 10, Reverse: true, Key: []byte{}},
 			},
 		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runQueryMakerTest(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdQueryGetDeadManSwitch(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:     "SetupCmdQueryGetDeadManSwitch",
+		setup:    cli.SetupCmdQueryGetDeadManSwitch,
+		expFlags: []string{cli.FlagAccount},
+		expInUse: []string{
+			"{<account>|--account <account>}",
+			"An <account> is required as either an arg or flag, but not both.",
+		},
+		expExamples: []string{
+			exampleStart + " " + cli.ExampleAddr,
+			exampleStart + " --account " + cli.ExampleAddr,
+		},
+	})
+}
+
+func TestMakeQueryGetDeadManSwitch(t *testing.T) {
+	td := queryMakerTestDef[exchange.QueryGetDeadManSwitchRequest]{
+		makerName: "MakeQueryGetDeadManSwitch",
+		maker:     cli.MakeQueryGetDeadManSwitch,
+		setup:     cli.SetupCmdQueryGetDeadManSwitch,
+	}
+
+	tests := []queryMakerTestCase[exchange.QueryGetDeadManSwitchRequest]{
+		{
+			name:   "no account",
+			expReq: &exchange.QueryGetDeadManSwitchRequest{},
+			expErr: "no <account> provided",
+		},
+		{
+			name:   "account as flag",
+			flags:  []string{"--account", "someaddr"},
+			expReq: &exchange.QueryGetDeadManSwitchRequest{Account: "someaddr"},
+		},
+		{
+			name:   "account as arg",
+			args:   []string{"otheraddr"},
+			expReq: &exchange.QueryGetDeadManSwitchRequest{Account: "otheraddr"},
+		},
+		{
+			name:   "account as flag and arg",
+			flags:  []string{"--account", "someaddr"},
+			args:   []string{"otheraddr"},
+			expReq: &exchange.QueryGetDeadManSwitchRequest{},
+			expErr: "cannot provide <account> as both an arg (\"otheraddr\") and flag (--account \"someaddr\")",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runQueryMakerTest(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdQueryOrderBookDepth(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdQueryOrderBookDepth",
+		setup: cli.SetupCmdQueryOrderBookDepth,
+		expFlags: []string{
+			cli.FlagMarket, cli.FlagAssets, cli.FlagPrice, cli.FlagDepth,
+		},
+		expAnnotations: map[string]map[string][]string{
+			cli.FlagAssets: {required: {"true"}},
+			cli.FlagPrice:  {required: {"true"}},
+		},
+		expInUse: []string{
+			"{<market id>|--market <market id>}",
+			"--assets <asset denom>", "--price <price denom>", "[--depth <depth>]",
+			"A <market id> is required as either an arg or flag, but not both.",
+		},
+		expExamples: []string{
+			exampleStart + " 3 --assets apple --price nhash",
+			exampleStart + " --market 1 --assets apple --price nhash --depth 10",
+		},
+	})
+}
+
+func TestMakeQueryOrderBookDepth(t *testing.T) {
+	td := queryMakerTestDef[exchange.QueryOrderBookDepthRequest]{
+		makerName: "MakeQueryOrderBookDepth",
+		maker:     cli.MakeQueryOrderBookDepth,
+		setup:     cli.SetupCmdQueryOrderBookDepth,
+	}
+
+	tests := []queryMakerTestCase[exchange.QueryOrderBookDepthRequest]{
+		{
+			name:   "no market id",
+			flags:  []string{"--assets", "apple", "--price", "plum"},
+			expReq: &exchange.QueryOrderBookDepthRequest{AssetDenom: "apple", PriceDenom: "plum"},
+			expErr: "no <market id> provided",
+		},
+		{
+			name:  "market id flag",
+			flags: []string{"--market", "1", "--assets", "apple", "--price", "plum"},
+			expReq: &exchange.QueryOrderBookDepthRequest{
+				MarketId:   1,
+				AssetDenom: "apple",
+				PriceDenom: "plum",
+			},
+		},
+		{
+			name:  "market id arg and depth",
+			args:  []string{"5"},
+			flags: []string{"--price", "plum", "--depth", "12", "--assets", "apple"},
+			expReq: &exchange.QueryOrderBookDepthRequest{
+				MarketId:   5,
+				AssetDenom: "apple",
+				PriceDenom: "plum",
+				Depth:      12,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runQueryMakerTest(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdQueryTopOfBook(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdQueryTopOfBook",
+		setup: cli.SetupCmdQueryTopOfBook,
+		expFlags: []string{
+			cli.FlagMarket, cli.FlagAssets, cli.FlagPrice,
+		},
+		expAnnotations: map[string]map[string][]string{
+			cli.FlagAssets: {required: {"true"}},
+			cli.FlagPrice:  {required: {"true"}},
+		},
+		expInUse: []string{
+			"{<market id>|--market <market id>}",
+			"--assets <asset denom>", "--price <price denom>",
+			"A <market id> is required as either an arg or flag, but not both.",
+		},
+		expExamples: []string{
+			exampleStart + " 3 --assets apple --price nhash",
+			exampleStart + " --market 1 --assets apple --price nhash",
+		},
+	})
+}
+
+func TestMakeQueryTopOfBook(t *testing.T) {
+	td := queryMakerTestDef[exchange.QueryTopOfBookRequest]{
+		makerName: "MakeQueryTopOfBook",
+		maker:     cli.MakeQueryTopOfBook,
+		setup:     cli.SetupCmdQueryTopOfBook,
+	}
+
+	tests := []queryMakerTestCase[exchange.QueryTopOfBookRequest]{
+		{
+			name:   "no market id",
+			flags:  []string{"--assets", "apple", "--price", "plum"},
+			expReq: &exchange.QueryTopOfBookRequest{AssetDenom: "apple", PriceDenom: "plum"},
+			expErr: "no <market id> provided",
+		},
+		{
+			name:  "market id flag",
+			flags: []string{"--market", "1", "--assets", "apple", "--price", "plum"},
+			expReq: &exchange.QueryTopOfBookRequest{
+				MarketId:   1,
+				AssetDenom: "apple",
+				PriceDenom: "plum",
+			},
+		},
+		{
+			name:  "market id arg",
+			args:  []string{"5"},
+			flags: []string{"--price", "plum", "--assets", "apple"},
+			expReq: &exchange.QueryTopOfBookRequest{
+				MarketId:   5,
+				AssetDenom: "apple",
+				PriceDenom: "plum",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runQueryMakerTest(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdQueryPriceAverages(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdQueryPriceAverages",
+		setup: cli.SetupCmdQueryPriceAverages,
+		expFlags: []string{
+			cli.FlagMarket, cli.FlagAssets, cli.FlagPrice, cli.FlagWindow,
+		},
+		expAnnotations: map[string]map[string][]string{
+			cli.FlagAssets: {required: {"true"}},
+			cli.FlagPrice:  {required: {"true"}},
+			cli.FlagWindow: {required: {"true"}},
+		},
+		expInUse: []string{
+			"{<market id>|--market <market id>}",
+			"--assets <asset denom>", "--price <price denom>", "--window <duration>",
+			"A <market id> is required as either an arg or flag, but not both.",
+			"The <duration> is truncated to the second and can be at most 720h (30 days).",
+		},
+		expExamples: []string{
+			exampleStart + " 3 --assets apple --price nhash --window 24h",
+			exampleStart + " --market 1 --assets apple --price nhash --window 15m",
+		},
+	})
+}
+
+func TestMakeQueryPriceAverages(t *testing.T) {
+	td := queryMakerTestDef[exchange.QueryPriceAveragesRequest]{
+		makerName: "MakeQueryPriceAverages",
+		maker:     cli.MakeQueryPriceAverages,
+		setup:     cli.SetupCmdQueryPriceAverages,
+	}
+
+	tests := []queryMakerTestCase[exchange.QueryPriceAveragesRequest]{
+		{
+			name:   "no market id",
+			flags:  []string{"--assets", "apple", "--price", "plum", "--window", "1h"},
+			expReq: &exchange.QueryPriceAveragesRequest{AssetDenom: "apple", PriceDenom: "plum", WindowSeconds: 3600},
+			expErr: "no <market id> provided",
+		},
+		{
+			name:  "market id flag",
+			flags: []string{"--market", "1", "--assets", "apple", "--price", "plum", "--window", "24h"},
+			expReq: &exchange.QueryPriceAveragesRequest{
+				MarketId:      1,
+				AssetDenom:    "apple",
+				PriceDenom:    "plum",
+				WindowSeconds: 86400,
+			},
+		},
+		{
+			name:  "market id arg",
+			args:  []string{"5"},
+			flags: []string{"--window", "15m", "--price", "plum", "--assets", "apple"},
+			expReq: &exchange.QueryPriceAveragesRequest{
+				MarketId:      5,
+				AssetDenom:    "apple",
+				PriceDenom:    "plum",
+				WindowSeconds: 900,
+			},
+		},
+		{
+			name:  "window with fractional seconds",
+			args:  []string{"2"},
+			flags: []string{"--assets", "apple", "--price", "plum", "--window", "1m30.9s"},
+			expReq: &exchange.QueryPriceAveragesRequest{
+				MarketId:      2,
+				AssetDenom:    "apple",
+				PriceDenom:    "plum",
+				WindowSeconds: 90,
+			},
+		},
+		{
+			name:  "negative window",
+			args:  []string{"2"},
+			flags: []string{"--assets", "apple", "--price", "plum", "--window", "-5m"},
+			expReq: &exchange.QueryPriceAveragesRequest{
+				MarketId:   2,
+				AssetDenom: "apple",
+				PriceDenom: "plum",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runQueryMakerTest(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdQueryGetMarketSettlements(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdQueryGetMarketSettlements",
+		setup: cli.SetupCmdQueryGetMarketSettlements,
+		expFlags: []string{
+			flags.FlagPage, flags.FlagPageKey, flags.FlagOffset,
+			flags.FlagLimit, flags.FlagCountTotal, flags.FlagReverse,
+			cli.FlagMarket, cli.FlagAfter,
+		},
+		expInUse: []string{
+			"{<market id>|--market <market id>}",
+			"[--after <after height>]",
+			cli.PageFlagsUse,
+			"A <market id> is required as either an arg or flag, but not both.",
+		},
+		expExamples: []string{
+			exampleStart + " 3",
+			exampleStart + " --market 1 --after 12345 --limit 10",
+		},
+	})
+}
+
+func TestMakeQueryGetMarketSettlements(t *testing.T) {
+	td := queryMakerTestDef[exchange.QueryGetMarketSettlementsRequest]{
+		makerName: "MakeQueryGetMarketSettlements",
+		maker:     cli.MakeQueryGetMarketSettlements,
+		setup:     cli.SetupCmdQueryGetMarketSettlements,
+	}
+
+	defaultPageReq := &query.PageRequest{
+		Key:   []byte{},
+		Limit: 100,
+	}
+	tests := []queryMakerTestCase[exchange.QueryGetMarketSettlementsRequest]{
+		{
+			name:   "no market id",
+			expReq: &exchange.QueryGetMarketSettlementsRequest{Pagination: defaultPageReq},
+			expErr: "no <market id> provided",
+		},
+		{
+			name:  "just market id flag",
+			flags: []string{"--market", "1"},
+			expReq: &exchange.QueryGetMarketSettlementsRequest{
+				MarketId:   1,
+				Pagination: defaultPageReq,
+			},
+		},
+		{
+			name: "just market id arg",
+			args: []string{"1"},
+			expReq: &exchange.QueryGetMarketSettlementsRequest{
+				MarketId:   1,
+				Pagination: defaultPageReq,
+			},
+		},
+		{
+			name:  "after height and some pagination fields",
+			flags: []string{"--after", "5000", "--limit", "10", "--reverse"},
+			args:  []string{"8"},
+			expReq: &exchange.QueryGetMarketSettlementsRequest{
+				MarketId:    8,
+				AfterHeight: 5000,
+				Pagination:  &query.PageRequest{Limit: 10, Reverse: true, Key: []byte{}},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runQueryMakerTest(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdQueryGetAllSettlements(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdQueryGetAllSettlements",
+		setup: cli.SetupCmdQueryGetAllSettlements,
+		expFlags: []string{
+			flags.FlagPage, flags.FlagPageKey, flags.FlagOffset,
+			flags.FlagLimit, flags.FlagCountTotal, flags.FlagReverse,
+		},
+		expInUse: []string{cli.PageFlagsUse},
+		expExamples: []string{
+			exampleStart + " --limit 10",
+			exampleStart + " --reverse",
+		},
+	})
+}
+
+func TestMakeQueryGetAllSettlements(t *testing.T) {
+	td := queryMakerTestDef[exchange.QueryGetAllSettlementsRequest]{
+		makerName: "MakeQueryGetAllSettlements",
+		maker:     cli.MakeQueryGetAllSettlements,
+		setup:     cli.SetupCmdQueryGetAllSettlements,
+	}
+
+	tests := []queryMakerTestCase[exchange.QueryGetAllSettlementsRequest]{
+		{
+			name: "no flags",
+			expReq: &exchange.QueryGetAllSettlementsRequest{
+				Pagination: &query.PageRequest{
+					Key:   []byte{},
+					Limit: 100,
+				},
+			},
+		},
+		{
+			name:  "some pagination flags",
+			flags: []string{"--limit", "5", "--reverse", "--offset", "3"},
+			expReq: &exchange.QueryGetAllSettlementsRequest{
+				Pagination: &query.PageRequest{
+					Key:     []byte{},
+					Offset:  3,
+					Limit:   5,
+					Reverse: true,
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runQueryMakerTest(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdQueryGetSettlementReceipt(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:     "SetupCmdQueryGetSettlementReceipt",
+		setup:    cli.SetupCmdQueryGetSettlementReceipt,
+		expFlags: []string{cli.FlagSettlement},
+		expInUse: []string{
+			"{<settlement id>|--settlement <settlement id>}",
+			"A <settlement id> is required as either an arg or flag, but not both.",
+		},
+		expExamples: []string{
+			exampleStart + " 8",
+			exampleStart + " --settlement 8",
+		},
+	})
+}
+
+func TestMakeQueryGetSettlementReceipt(t *testing.T) {
+	td := queryMakerTestDef[exchange.QueryGetSettlementReceiptRequest]{
+		makerName: "MakeQueryGetSettlementReceipt",
+		maker:     cli.MakeQueryGetSettlementReceipt,
+		setup:     cli.SetupCmdQueryGetSettlementReceipt,
+	}
+
+	tests := []queryMakerTestCase[exchange.QueryGetSettlementReceiptRequest]{
+		{
+			name:   "no settlement id",
+			expReq: &exchange.QueryGetSettlementReceiptRequest{},
+			expErr: "no <settlement id> provided",
+		},
+		{
+			name:   "just settlement flag",
+			flags:  []string{"--settlement", "15"},
+			expReq: &exchange.QueryGetSettlementReceiptRequest{SettlementId: 15},
+		},
+		{
+			name:   "just settlement id arg",
+			args:   []string{"83"},
+			expReq: &exchange.QueryGetSettlementReceiptRequest{SettlementId: 83},
+		},
+		{
+			name:   "both settlement flag and arg",
+			flags:  []string{"--settlement", "15"},
+			args:   []string{"83"},
+			expReq: &exchange.QueryGetSettlementReceiptRequest{},
+			expErr: "cannot provide <settlement id> as both an arg (\"83\") and flag (--settlement 15)",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runQueryMakerTest(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdQueryGetLatestNAV(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:     "SetupCmdQueryGetLatestNAV",
+		setup:    cli.SetupCmdQueryGetLatestNAV,
+		expFlags: []string{cli.FlagAssets, cli.FlagPrice},
+		expAnnotations: map[string]map[string][]string{
+			cli.FlagAssets: {required: {"true"}},
+			cli.FlagPrice:  {required: {"true"}},
+		},
+		expInUse: []string{"--assets <asset denom>", "--price <price denom>"},
+		expExamples: []string{
+			exampleStart + " --assets apple --price nhash",
+		},
+	})
+}
+
+func TestMakeQueryGetLatestNAV(t *testing.T) {
+	td := queryMakerTestDef[exchange.QueryGetLatestNAVRequest]{
+		makerName: "MakeQueryGetLatestNAV",
+		maker:     cli.MakeQueryGetLatestNAV,
+		setup:     cli.SetupCmdQueryGetLatestNAV,
+	}
+
+	tests := []queryMakerTestCase[exchange.QueryGetLatestNAVRequest]{
+		{
+			name:   "no flags",
+			expReq: &exchange.QueryGetLatestNAVRequest{},
+		},
+		{
+			name:   "both denoms",
+			flags:  []string{"--price", "plum", "--assets", "apple"},
+			expReq: &exchange.QueryGetLatestNAVRequest{AssetDenom: "apple", PriceDenom: "plum"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runQueryMakerTest(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdQueryGetNAVHistory(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdQueryGetNAVHistory",
+		setup: cli.SetupCmdQueryGetNAVHistory,
+		expFlags: []string{
+			flags.FlagPage, flags.FlagPageKey, flags.FlagOffset,
+			flags.FlagLimit, flags.FlagCountTotal, flags.FlagReverse,
+			cli.FlagAssets, cli.FlagPrice, cli.FlagMinHeight, cli.FlagMaxHeight,
+		},
+		expAnnotations: map[string]map[string][]string{
+			cli.FlagAssets: {required: {"true"}},
+			cli.FlagPrice:  {required: {"true"}},
+		},
+		expInUse: []string{
+			"--assets <asset denom>", "--price <price denom>",
+			"[--min-height <min height>]", "[--max-height <max height>]",
+			cli.PageFlagsUse,
+			"Results are ordered from oldest to newest.",
+		},
+		expExamples: []string{
+			exampleStart + " --assets apple --price nhash",
+			exampleStart + " --assets apple --price nhash --min-height 12345 --reverse",
+		},
+	})
+}
+
+func TestMakeQueryGetNAVHistory(t *testing.T) {
+	td := queryMakerTestDef[exchange.QueryGetNAVHistoryRequest]{
+		makerName: "MakeQueryGetNAVHistory",
+		maker:     cli.MakeQueryGetNAVHistory,
+		setup:     cli.SetupCmdQueryGetNAVHistory,
+	}
+
+	defaultPageReq := &query.PageRequest{
+		Key:   []byte{},
+		Limit: 100,
+	}
+	tests := []queryMakerTestCase[exchange.QueryGetNAVHistoryRequest]{
+		{
+			name:   "no flags",
+			expReq: &exchange.QueryGetNAVHistoryRequest{Pagination: defaultPageReq},
+		},
+		{
+			name:  "just denoms",
+			flags: []string{"--assets", "apple", "--price", "plum"},
+			expReq: &exchange.QueryGetNAVHistoryRequest{
+				AssetDenom: "apple",
+				PriceDenom: "plum",
+				Pagination: defaultPageReq,
+			},
+		},
+		{
+			name: "heights and some pagination fields",
+			flags: []string{
+				"--assets", "apple", "--price", "plum", "--min-height", "100", "--max-height", "5000",
+				"--limit", "10", "--reverse",
+			},
+			expReq: &exchange.QueryGetNAVHistoryRequest{
+				AssetDenom: "apple",
+				PriceDenom: "plum",
+				MinHeight:  100,
+				MaxHeight:  5000,
+				Pagination: &query.PageRequest{Limit: 10, Reverse: true, Key: []byte{}},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runQueryMakerTest(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdQuerySimulateMarketSettle(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdQuerySimulateMarketSettle",
+		setup: cli.SetupCmdQuerySimulateMarketSettle,
+		expFlags: []string{
+			flags.FlagFrom, cli.FlagAdmin, cli.FlagAuthority,
+			cli.FlagMarket, cli.FlagAsks, cli.FlagBids, cli.FlagPartial,
+		},
+		expAnnotations: map[string]map[string][]string{
+			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
+			cli.FlagAdmin: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagAuthority: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagMarket: {required: {"true"}},
+			cli.FlagAsks:   {required: {"true"}},
+			cli.FlagBids:   {required: {"true"}},
+		},
+		expInUse: []string{
+			cli.ReqAdminUse, "--market <market id>",
+			"--asks <ask order ids>", "--bids <bid order ids>",
+			"[--partial]",
+			cli.ReqAdminDesc, cli.RepeatableDesc,
+		},
+		skipAddingFromFlag: true,
+	})
+}
+
+func TestMakeQuerySimulateMarketSettle(t *testing.T) {
+	td := queryMakerTestDef[exchange.QuerySimulateSettlementRequest]{
+		makerName: "MakeQuerySimulateMarketSettle",
+		maker:     cli.MakeQuerySimulateMarketSettle,
+		setup:     cli.SetupCmdQuerySimulateMarketSettle,
+	}
+
+	tests := []queryMakerTestCase[exchange.QuerySimulateSettlementRequest]{
+		{
+			name: "no flags",
+			expReq: &exchange.QuerySimulateSettlementRequest{
+				MarketSettleRequest: &exchange.MsgMarketSettleRequest{},
+			},
+			expErr: "no <admin> provided",
+		},
+		{
+			name:  "admin from keyring",
+			flags: []string{"--from", keyringName, "--market", "3", "--asks", "1,5", "--bids", "2"},
+			expReq: &exchange.QuerySimulateSettlementRequest{
+				MarketSettleRequest: &exchange.MsgMarketSettleRequest{
+					Admin: keyringAddr, MarketId: 3, AskOrderIds: []uint64{1, 5}, BidOrderIds: []uint64{2},
+				},
+			},
+		},
+		{
+			name:  "from flag is unknown name",
+			flags: []string{"--from", "notknown", "--market", "3"},
+			expReq: &exchange.QuerySimulateSettlementRequest{
+				MarketSettleRequest: &exchange.MsgMarketSettleRequest{MarketId: 3},
+			},
+			expErr: joinErrs("notknown.info: key not found", "no <admin> provided"),
+		},
+		{
+			name: "all fields",
+			flags: []string{
+				"--admin", "someaddr", "--market", "8",
+				"--asks", "4", "--bids", "7,8", "--asks", "3", "--partial",
+			},
+			expReq: &exchange.QuerySimulateSettlementRequest{
+				MarketSettleRequest: &exchange.MsgMarketSettleRequest{
+					Admin: "someaddr", MarketId: 8, AskOrderIds: []uint64{4, 3}, BidOrderIds: []uint64{7, 8},
+					ExpectPartial: true,
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runQueryMakerTest(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdQuerySimulateFillBids(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdQuerySimulateFillBids",
+		setup: cli.SetupCmdQuerySimulateFillBids,
+		expFlags: []string{
+			flags.FlagFrom, cli.FlagSeller, cli.FlagMarket, cli.FlagAssets,
+			cli.FlagBids, cli.FlagSettlementFee, cli.FlagCreationFee,
+		},
+		expAnnotations: map[string]map[string][]string{
+			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagSeller}},
+			cli.FlagSeller: {oneReq: {flags.FlagFrom + " " + cli.FlagSeller}},
+			cli.FlagMarket: {required: {"true"}},
+			cli.FlagAssets: {required: {"true"}},
+			cli.FlagBids:   {required: {"true"}},
+		},
+		expInUse: []string{
+			"{--from|--seller} <seller>", "--market <market id>", "--assets <total assets>",
+			"--bids <bid order ids>", "[--settlement-fee <seller settlement flat fee>]",
+			"[--creation-fee <ask order creation fee>]",
+			cli.ReqSignerDesc(cli.FlagSeller),
+			cli.RepeatableDesc,
+		},
+		skipAddingFromFlag: true,
+	})
+}
+
+func TestMakeQuerySimulateFillBids(t *testing.T) {
+	td := queryMakerTestDef[exchange.QuerySimulateSettlementRequest]{
+		makerName: "MakeQuerySimulateFillBids",
+		maker:     cli.MakeQuerySimulateFillBids,
+		setup:     cli.SetupCmdQuerySimulateFillBids,
+	}
+
+	tests := []queryMakerTestCase[exchange.QuerySimulateSettlementRequest]{
+		{
+			name: "no flags",
+			expReq: &exchange.QuerySimulateSettlementRequest{
+				FillBidsRequest: &exchange.MsgFillBidsRequest{},
+			},
+			expErr: "no <seller> provided",
+		},
+		{
+			name:  "seller from from",
+			flags: []string{"--from", sdk.AccAddress("FromAddress_________").String(), "--bids", "4"},
+			expReq: &exchange.QuerySimulateSettlementRequest{
+				FillBidsRequest: &exchange.MsgFillBidsRequest{
+					Seller: sdk.AccAddress("FromAddress_________").String(), BidOrderIds: []uint64{4},
+				},
+			},
+		},
+		{
+			name: "all fields",
+			flags: []string{
+				"--seller", "someaddr", "--assets", "10apple", "--market", "4", "--creation-fee", "5fig",
+				"--bids", "8,3", "--settlement-fee", "1plum",
+			},
+			expReq: &exchange.QuerySimulateSettlementRequest{
+				FillBidsRequest: &exchange.MsgFillBidsRequest{
+					Seller:                  "someaddr",
+					MarketId:                4,
+					TotalAssets:             sdk.NewCoins(sdk.NewInt64Coin("apple", 10)),
+					BidOrderIds:             []uint64{8, 3},
+					SellerSettlementFlatFee: &sdk.Coin{Denom: "plum", Amount: sdkmath.NewInt(1)},
+					AskOrderCreationFee:     &sdk.Coin{Denom: "fig", Amount: sdkmath.NewInt(5)},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runQueryMakerTest(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdQuerySimulateFillAsks(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdQuerySimulateFillAsks",
+		setup: cli.SetupCmdQuerySimulateFillAsks,
+		expFlags: []string{
+			flags.FlagFrom, cli.FlagBuyer, cli.FlagMarket, cli.FlagPrice,
+			cli.FlagAsks, cli.FlagSettlementFee, cli.FlagCreationFee,
+		},
+		expAnnotations: map[string]map[string][]string{
+			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagBuyer}},
+			cli.FlagBuyer:  {oneReq: {flags.FlagFrom + " " + cli.FlagBuyer}},
+			cli.FlagMarket: {required: {"true"}},
+			cli.FlagPrice:  {required: {"true"}},
+			cli.FlagAsks:   {required: {"true"}},
+		},
+		expInUse: []string{
+			"{--from|--buyer} <buyer>", "--market <market id>", "--price <total price>",
+			"--asks <ask order ids>", "[--settlement-fee <buyer settlement fees>]",
+			"[--creation-fee <bid order creation fee>]",
+			cli.ReqSignerDesc(cli.FlagBuyer),
+			cli.RepeatableDesc,
+		},
+		skipAddingFromFlag: true,
+	})
+}
+
+func TestMakeQuerySimulateFillAsks(t *testing.T) {
+	td := queryMakerTestDef[exchange.QuerySimulateSettlementRequest]{
+		makerName: "MakeQuerySimulateFillAsks",
+		maker:     cli.MakeQuerySimulateFillAsks,
+		setup:     cli.SetupCmdQuerySimulateFillAsks,
+	}
+
+	tests := []queryMakerTestCase[exchange.QuerySimulateSettlementRequest]{
+		{
+			name: "no flags",
+			expReq: &exchange.QuerySimulateSettlementRequest{
+				FillAsksRequest: &exchange.MsgFillAsksRequest{},
+			},
+			expErr: joinErrs("no <buyer> provided", "missing required --price flag"),
+		},
+		{
+			name:  "buyer from keyring",
+			flags: []string{"--from", keyringName, "--price", "10plum"},
+			expReq: &exchange.QuerySimulateSettlementRequest{
+				FillAsksRequest: &exchange.MsgFillAsksRequest{
+					Buyer: keyringAddr, TotalPrice: sdk.NewInt64Coin("plum", 10),
+				},
+			},
+		},
+		{
+			name: "all fields",
+			flags: []string{
+				"--buyer", "someaddr", "--price", "10plum", "--market", "4", "--creation-fee", "5fig",
+				"--asks", "8,3", "--settlement-fee", "1plum,2fig",
+			},
+			expReq: &exchange.QuerySimulateSettlementRequest{
+				FillAsksRequest: &exchange.MsgFillAsksRequest{
+					Buyer:               "someaddr",
+					MarketId:            4,
+					TotalPrice:          sdk.NewInt64Coin("plum", 10),
+					AskOrderIds:         []uint64{8, 3},
+					BuyerSettlementFees: sdk.NewCoins(sdk.NewInt64Coin("fig", 2), sdk.NewInt64Coin("plum", 1)),
+					BidOrderCreationFee: &sdk.Coin{Denom: "fig", Amount: sdkmath.NewInt(5)},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runQueryMakerTest(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdQueryGetCommitment(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdQueryGetCommitment",
+		setup: cli.SetupCmdQueryGetCommitment,
+		expFlags: []string{
+			cli.FlagAccount, cli.FlagMarket,
+		},
+		expAnnotations: map[string]map[string][]string{
+			cli.FlagAccount: {required: {"true"}},
+			cli.FlagMarket:  {required: {"true"}},
+		},
+		expInUse: []string{
+			"--account <account>",
+			"--market <market id>",
+		},
+		expExamples: []string{
+			exampleStart + " --account " + cli.ExampleAddr + " --market 3",
+		},
+	})
+}
+
+func TestMakeQueryGetCommitment(t *testing.T) {
+	td := queryMakerTestDef[exchange.QueryGetCommitmentRequest]{
+		makerName: "MakeQueryGetCommitment",
+		maker:     cli.MakeQueryGetCommitment,
+		setup:     cli.SetupCmdQueryGetCommitment,
+	}
+
+	tests := []queryMakerTestCase[exchange.QueryGetCommitmentRequest]{
+		{
+			name:   "no flags",
+			expReq: &exchange.QueryGetCommitmentRequest{},
+		},
+		{
+			name:  "all flags",
+			flags: []string{"--account", cli.ExampleAddr, "--market", "3"},
+			expReq: &exchange.QueryGetCommitmentRequest{
+				Account:  cli.ExampleAddr,
+				MarketId: 3,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runQueryMakerTest(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdQueryGetAccountCommitments(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdQueryGetAccountCommitments",
+		setup: cli.SetupCmdQueryGetAccountCommitments,
+		expFlags: []string{
+			cli.FlagAccount,
+		},
+		expInUse: []string{
+			"{<account>|--account <account>}",
+			"An <account> is required as either an arg or flag, but not both.",
+		},
+		expExamples: []string{
+			exampleStart + " " + cli.ExampleAddr,
+			exampleStart + " --account " + cli.ExampleAddr,
+			exampleStart + " --account " + cli.ExampleAddr + " --denom nhash",
+		},
+	})
+}
+
+func TestMakeQueryGetAccountCommitments(t *testing.T) {
+	td := queryMakerTestDef[exchange.QueryGetAccountCommitmentsRequest]{
+		makerName: "MakeQueryGetAccountCommitments",
+		maker:     cli.MakeQueryGetAccountCommitments,
+		setup:     cli.SetupCmdQueryGetAccountCommitments,
+	}
+
+	tests := []queryMakerTestCase[exchange.QueryGetAccountCommitmentsRequest]{
+		{
+			name:   "no account",
+			expReq: &exchange.QueryGetAccountCommitmentsRequest{},
+			expErr: "no <account> provided",
+		},
+		{
+			name:  "account as flag",
+			flags: []string{"--account", "someaddr"},
+			expReq: &exchange.QueryGetAccountCommitmentsRequest{
+				Account: "someaddr",
+			},
+		},
+		{
+			name: "account as arg",
+			args: []string{"otheraddr"},
+			expReq: &exchange.QueryGetAccountCommitmentsRequest{
+				Account: "otheraddr",
+			},
+		},
+		{
+			name:   "account as flag and arg",
+			flags:  []string{"--account", "someaddr"},
+			args:   []string{"otheraddr"},
+			expReq: &exchange.QueryGetAccountCommitmentsRequest{},
+			expErr: "cannot provide <account> as both an arg (\"otheraddr\") and flag (--account \"someaddr\")",
+		},
+		{
+			name:  "account with denom flags",
+			flags: []string{"--account", "addr1", "--denom", "nhash"},
+			expReq: &exchange.QueryGetAccountCommitmentsRequest{
+				Account: "addr1",
+				Denom:   "nhash",
+			},
+		},
+		{
+			name:  "missing account",
+			flags: []string{"--denom", "nhash"},
+			expReq: &exchange.QueryGetAccountCommitmentsRequest{
+				Account: "",
+				Denom:   "nhash",
+			},
+			expErr: "no <account> provided",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runQueryMakerTest(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdQueryGetMarketCommitments(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdQueryGetMarketCommitments",
+		setup: cli.SetupCmdQueryGetMarketCommitments,
+		expFlags: []string{
+			flags.FlagPage, flags.FlagPageKey, flags.FlagOffset,
+			flags.FlagLimit, flags.FlagCountTotal, flags.FlagReverse,
+			cli.FlagMarket,
+		},
+		expInUse: []string{
+			"{<market id>|--market <market id>}",
+			cli.PageFlagsUse,
+			"A <market id> is required as either an arg or flag, but not both.",
+		},
+		expExamples: []string{
+			exampleStart + " 3",
+			exampleStart + " --market 1 --limit 10",
+		},
+	})
+}
+
+func TestMakeQueryGetMarketCommitments(t *testing.T) {
+	td := queryMakerTestDef[exchange.QueryGetMarketCommitmentsRequest]{
+		makerName: "MakeQueryGetMarketCommitments",
+		maker:     cli.MakeQueryGetMarketCommitments,
+		setup:     cli.SetupCmdQueryGetMarketCommitments,
+	}
+
+	defaultPageReq := &query.PageRequest{
+		Key:   []byte{},
+		Limit: 100,
+	}
+	tests := []queryMakerTestCase[exchange.QueryGetMarketCommitmentsRequest]{
+		{
+			name:   "no market id",
+			expReq: &exchange.QueryGetMarketCommitmentsRequest{Pagination: defaultPageReq},
+			expErr: "no <market id> provided",
+		},
+		{
+			name:  "just market id flag",
+			flags: []string{"--market", "1"},
+			expReq: &exchange.QueryGetMarketCommitmentsRequest{
+				MarketId:   1,
+				Pagination: defaultPageReq,
+			},
+		},
+		{
+			name: "just market id arg",
+			args: []string{"1"},
+			expReq: &exchange.QueryGetMarketCommitmentsRequest{
+				MarketId:   1,
+				Pagination: defaultPageReq,
+			},
+		},
+		{
+			name:  "both market id flag and arg",
+			flags: []string{"--market", "1"},
+			args:  []string{"1"},
+			expReq: &exchange.QueryGetMarketCommitmentsRequest{
+				Pagination: defaultPageReq,
+			},
+			expErr: "cannot provide <market id> as both an arg (\"1\") and flag (--market 1)",
+		},
+		{
+			name:  "with some pagination fields",
+			flags: []string{"--market", "8", "--limit", "10", "--reverse"},
+			expReq: &exchange.QueryGetMarketCommitmentsRequest{
+				MarketId:   8,
+				Pagination: &query.PageRequest{Limit: 10, Reverse: true, Key: []byte{}},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runQueryMakerTest(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdQueryGetAllCommitments(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdQueryGetAllCommitments",
+		setup: cli.SetupCmdQueryGetAllCommitments,
+		expFlags: []string{
+			flags.FlagPage, flags.FlagPageKey, flags.FlagOffset,
+			flags.FlagLimit, flags.FlagCountTotal, flags.FlagReverse,
+		},
+		expInUse: []string{cli.PageFlagsUse},
+		expExamples: []string{
+			exampleStart + " --limit 10",
+			exampleStart + " --reverse",
+		},
+	})
+}
+
+func TestMakeQueryGetAllCommitments(t *testing.T) {
+	td := queryMakerTestDef[exchange.QueryGetAllCommitmentsRequest]{
+		makerName: "MakeQueryGetAllCommitments",
+		maker:     cli.MakeQueryGetAllCommitments,
+		setup:     cli.SetupCmdQueryGetAllCommitments,
+	}
+
+	tests := []queryMakerTestCase[exchange.QueryGetAllCommitmentsRequest]{
+		{
+			name: "no flags",
+			expReq: &exchange.QueryGetAllCommitmentsRequest{
+				Pagination: &query.PageRequest{
+					Key:   []byte{},
+					Limit: 100,
+				},
+			},
+		},
+		{
+			name:  "some pagination flags",
+			flags: []string{"--limit", "5", "--reverse", "--page-key", "AAAAAAAAAKA="},
+			expReq: &exchange.QueryGetAllCommitmentsRequest{
+				Pagination: &query.PageRequest{
+					Key:     []byte{0, 0, 0, 0, 0, 0, 0, 160},
+					Limit:   5,
+					Reverse: true,
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runQueryMakerTest(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdQueryGetCommitmentRewardPool(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:     "SetupCmdQueryGetCommitmentRewardPool",
+		setup:    cli.SetupCmdQueryGetCommitmentRewardPool,
+		expFlags: []string{cli.FlagMarket},
+		expInUse: []string{
+			"{<market id>|--market <market id>}",
+			"A <market id> is required as either an arg or flag, but not both.",
+		},
+		expExamples: []string{
+			exampleStart + " 3",
+			exampleStart + " --market 1",
+		},
+	})
+}
+
+func TestMakeQueryGetCommitmentRewardPool(t *testing.T) {
+	td := queryMakerTestDef[exchange.QueryGetCommitmentRewardPoolRequest]{
+		makerName: "MakeQueryGetCommitmentRewardPool",
+		maker:     cli.MakeQueryGetCommitmentRewardPool,
+		setup:     cli.SetupCmdQueryGetCommitmentRewardPool,
+	}
+
+	tests := []queryMakerTestCase[exchange.QueryGetCommitmentRewardPoolRequest]{
+		{
+			name:   "no market",
+			expReq: &exchange.QueryGetCommitmentRewardPoolRequest{},
+			expErr: "no <market id> provided",
+		},
+		{
+			name:   "just flag",
+			flags:  []string{"--market", "2"},
+			expReq: &exchange.QueryGetCommitmentRewardPoolRequest{MarketId: 2},
+		},
+		{
+			name:   "just arg",
+			args:   []string{"1000"},
+			expReq: &exchange.QueryGetCommitmentRewardPoolRequest{MarketId: 1000},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runQueryMakerTest(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdQueryGetAccountCommitmentRewards(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:     "SetupCmdQueryGetAccountCommitmentRewards",
+		setup:    cli.SetupCmdQueryGetAccountCommitmentRewards,
+		expFlags: []string{cli.FlagAccount},
+		expInUse: []string{
+			"{<account>|--account <account>}",
+			"An <account> is required as either an arg or flag, but not both.",
+		},
+		expExamples: []string{
+			exampleStart + " " + cli.ExampleAddr,
+			exampleStart + " --account " + cli.ExampleAddr,
+		},
+	})
+}
+
+func TestMakeQueryGetAccountCommitmentRewards(t *testing.T) {
+	td := queryMakerTestDef[exchange.QueryGetAccountCommitmentRewardsRequest]{
+		makerName: "MakeQueryGetAccountCommitmentRewards",
+		maker:     cli.MakeQueryGetAccountCommitmentRewards,
+		setup:     cli.SetupCmdQueryGetAccountCommitmentRewards,
+	}
+
+	tests := []queryMakerTestCase[exchange.QueryGetAccountCommitmentRewardsRequest]{
+		{
+			name:   "no account",
+			expReq: &exchange.QueryGetAccountCommitmentRewardsRequest{},
+			expErr: "no <account> provided",
+		},
+		{
+			name:   "account as flag",
+			flags:  []string{"--account", "someaddr"},
+			expReq: &exchange.QueryGetAccountCommitmentRewardsRequest{Account: "someaddr"},
+		},
+		{
+			name:   "account as arg",
+			args:   []string{"otheraddr"},
+			expReq: &exchange.QueryGetAccountCommitmentRewardsRequest{Account: "otheraddr"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runQueryMakerTest(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdQueryGetMarket(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:     "SetupCmdQueryGetMarket",
+		setup:    cli.SetupCmdQueryGetMarket,
+		expFlags: []string{cli.FlagMarket},
+		expInUse: []string{
+			"{<market id>|--market <market id>}",
+			"A <market id> is required as either an arg or flag, but not both.",
+		},
+		expExamples: []string{
+			exampleStart + " 3",
+			exampleStart + " --market 1",
+		},
+	})
+}
+
+func TestMakeQueryGetMarket(t *testing.T) {
+	td := queryMakerTestDef[exchange.QueryGetMarketRequest]{
+		makerName: "MakeQueryGetMarket",
+		maker:     cli.MakeQueryGetMarket,
+		setup:     cli.SetupCmdQueryGetMarket,
+	}
+
+	tests := []queryMakerTestCase[exchange.QueryGetMarketRequest]{
 		{
-			name: "account as arg",
-			args: []string{"otheraddr"},
-			expReq: &exchange.QueryGetAccountCommitmentsRequest{
-				Account: "otheraddr",
-			},
+			name:   "no market",
+			expReq: &exchange.QueryGetMarketRequest{},
+			expErr: "no <market id> provided",
 		},
 		{
-			name:   "account as flag and arg",
-			flags:  []string{"--account", "someaddr"},
-			args:   []string{"otheraddr"},
-			expReq: &exchange.QueryGetAccountCommitmentsRequest{},
-			expErr: "cannot provide <account> as both an arg (\"otheraddr\") and flag (--account \"someaddr\")",
+			name:   "just flag",
+			flags:  []string{"--market", "2"},
+			expReq: &exchange.QueryGetMarketRequest{MarketId: 2},
 		},
 		{
-			name:  "account with denom flags",
-			flags: []string{"--account", "addr1", "--denom", "nhash"},
-			expReq: &exchange.QueryGetAccountCommitmentsRequest{
-				Account: "addr1",
-				Denom:   "nhash",
-			},
+			name:   "just arg",
+			args:   []string{"1000"},
+			expReq: &exchange.QueryGetMarketRequest{MarketId: 1000},
 		},
 		{
-			name:  "missing account",
-			flags: []string{"--denom", "nhash"},
-			expReq: &exchange.QueryGetAccountCommitmentsRequest{
-				Account: "",
-				Denom:   "nhash",
-			},
-			expErr: "no <account> provided",
+			name:   "both arg and flag",
+			flags:  []string{"--market", "2"},
+			args:   []string{"1000"},
+			expReq: &exchange.QueryGetMarketRequest{},
+			expErr: "cannot provide <market id> as both an arg (\"1000\") and flag (--market 2)",
 		},
 	}
 
@@ -858,76 +2313,51 @@ func TestMakeQueryGetAccountCommitments(t *testing.T) {
 	}
 }
 
-func TestSetupCmdQueryGetMarketCommitments(t *testing.T) {
+func TestSetupCmdQueryGetScheduledFeeChanges(t *testing.T) {
 	runSetupTestCase(t, setupTestCase{
-		name:  "SetupCmdQueryGetMarketCommitments",
-		setup: cli.SetupCmdQueryGetMarketCommitments,
-		expFlags: []string{
-			flags.FlagPage, flags.FlagPageKey, flags.FlagOffset,
-			flags.FlagLimit, flags.FlagCountTotal, flags.FlagReverse,
-			cli.FlagMarket,
-		},
+		name:     "SetupCmdQueryGetScheduledFeeChanges",
+		setup:    cli.SetupCmdQueryGetScheduledFeeChanges,
+		expFlags: []string{cli.FlagMarket},
 		expInUse: []string{
 			"{<market id>|--market <market id>}",
-			cli.PageFlagsUse,
 			"A <market id> is required as either an arg or flag, but not both.",
 		},
 		expExamples: []string{
 			exampleStart + " 3",
-			exampleStart + " --market 1 --limit 10",
+			exampleStart + " --market 1",
 		},
 	})
 }
 
-func TestMakeQueryGetMarketCommitments(t *testing.T) {
-	td := queryMakerTestDef[exchange.QueryGetMarketCommitmentsRequest]{
-		makerName: "MakeQueryGetMarketCommitments",
-		maker:     cli.MakeQueryGetMarketCommitments,
-		setup:     cli.SetupCmdQueryGetMarketCommitments,
+func TestMakeQueryGetScheduledFeeChanges(t *testing.T) {
+	td := queryMakerTestDef[exchange.QueryGetScheduledFeeChangesRequest]{
+		makerName: "MakeQueryGetScheduledFeeChanges",
+		maker:     cli.MakeQueryGetScheduledFeeChanges,
+		setup:     cli.SetupCmdQueryGetScheduledFeeChanges,
 	}
 
-	defaultPageReq := &query.PageRequest{
-		Key:   []byte{},
-		Limit: 100,
-	}
-	tests := []queryMakerTestCase[exchange.QueryGetMarketCommitmentsRequest]{
+	tests := []queryMakerTestCase[exchange.QueryGetScheduledFeeChangesRequest]{
 		{
-			name:   "no market id",
-			expReq: &exchange.QueryGetMarketCommitmentsRequest{Pagination: defaultPageReq},
+			name:   "no market",
+			expReq: &exchange.QueryGetScheduledFeeChangesRequest{},
 			expErr: "no <market id> provided",
 		},
 		{
-			name:  "just market id flag",
-			flags: []string{"--market", "1"},
-			expReq: &exchange.QueryGetMarketCommitmentsRequest{
-				MarketId:   1,
-				Pagination: defaultPageReq,
-			},
-		},
-		{
-			name: "just market id arg",
-			args: []string{"1"},
-			expReq: &exchange.QueryGetMarketCommitmentsRequest{
-				MarketId:   1,
-				Pagination: defaultPageReq,
-			},
+			name:   "just flag",
+			flags:  []string{"--market", "2"},
+			expReq: &exchange.QueryGetScheduledFeeChangesRequest{MarketId: 2},
 		},
 		{
-			name:  "both market id flag and arg",
-			flags: []string{"--market", "1"},
-			args:  []string{"1"},
-			expReq: &exchange.QueryGetMarketCommitmentsRequest{
-				Pagination: defaultPageReq,
-			},
-			expErr: "cannot provide <market id> as both an arg (\"1\") and flag (--market 1)",
+			name:   "just arg",
+			args:   []string{"1000"},
+			expReq: &exchange.QueryGetScheduledFeeChangesRequest{MarketId: 1000},
 		},
 		{
-			name:  "with some pagination fields",
-			flags: []string{"--market", "8", "--limit", "10", "--reverse"},
-			expReq: &exchange.QueryGetMarketCommitmentsRequest{
-				MarketId:   8,
-				Pagination: &query.PageRequest{Limit: 10, Reverse: true, Key: []byte{}},
-			},
+			name:   "both arg and flag",
+			flags:  []string{"--market", "2"},
+			args:   []string{"1000"},
+			expReq: &exchange.QueryGetScheduledFeeChangesRequest{},
+			expErr: "cannot provide <market id> as both an arg (\"1000\") and flag (--market 2)",
 		},
 	}
 
@@ -938,49 +2368,51 @@ func TestMakeQueryGetMarketCommitments(t *testing.T) {
 	}
 }
 
-func TestSetupCmdQueryGetAllCommitments(t *testing.T) {
+func TestSetupCmdQueryGetMarketAuthorityTransfer(t *testing.T) {
 	runSetupTestCase(t, setupTestCase{
-		name:  "SetupCmdQueryGetAllCommitments",
-		setup: cli.SetupCmdQueryGetAllCommitments,
-		expFlags: []string{
-			flags.FlagPage, flags.FlagPageKey, flags.FlagOffset,
-			flags.FlagLimit, flags.FlagCountTotal, flags.FlagReverse,
+		name:     "SetupCmdQueryGetMarketAuthorityTransfer",
+		setup:    cli.SetupCmdQueryGetMarketAuthorityTransfer,
+		expFlags: []string{cli.FlagMarket},
+		expInUse: []string{
+			"{<market id>|--market <market id>}",
+			"A <market id> is required as either an arg or flag, but not both.",
 		},
-		expInUse: []string{cli.PageFlagsUse},
 		expExamples: []string{
-			exampleStart + " --limit 10",
-			exampleStart + " --reverse",
+			exampleStart + " 3",
+			exampleStart + " --market 1",
 		},
 	})
 }
 
-func TestMakeQueryGetAllCommitments(t *testing.T) {
-	td := queryMakerTestDef[exchange.QueryGetAllCommitmentsRequest]{
-		makerName: "MakeQueryGetAllCommitments",
-		maker:     cli.MakeQueryGetAllCommitments,
-		setup:     cli.SetupCmdQueryGetAllCommitments,
+func TestMakeQueryGetMarketAuthorityTransfer(t *testing.T) {
+	td := queryMakerTestDef[exchange.QueryGetMarketAuthorityTransferRequest]{
+		makerName: "MakeQueryGetMarketAuthorityTransfer",
+		maker:     cli.MakeQueryGetMarketAuthorityTransfer,
+		setup:     cli.SetupCmdQueryGetMarketAuthorityTransfer,
 	}
 
-	tests := []queryMakerTestCase[exchange.QueryGetAllCommitmentsRequest]{
+	tests := []queryMakerTestCase[exchange.QueryGetMarketAuthorityTransferRequest]{
 		{
-			name: "no flags",
-			expReq: &exchange.QueryGetAllCommitmentsRequest{
-				Pagination: &query.PageRequest{
-					Key:   []byte{},
-					Limit: 100,
-				},
-			},
+			name:   "no market",
+			expReq: &exchange.QueryGetMarketAuthorityTransferRequest{},
+			expErr: "no <market id> provided",
 		},
 		{
-			name:  "some pagination flags",
-			flags: []string{"--limit", "5", "--reverse", "--page-key", "AAAAAAAAAKA="},
-			expReq: &exchange.QueryGetAllCommitmentsRequest{
-				Pagination: &query.PageRequest{
-					Key:     []byte{0, 0, 0, 0, 0, 0, 0, 160},
-					Limit:   5,
-					Reverse: true,
-				},
-			},
+			name:   "just flag",
+			flags:  []string{"--market", "2"},
+			expReq: &exchange.QueryGetMarketAuthorityTransferRequest{MarketId: 2},
+		},
+		{
+			name:   "just arg",
+			args:   []string{"1000"},
+			expReq: &exchange.QueryGetMarketAuthorityTransferRequest{MarketId: 1000},
+		},
+		{
+			name:   "both arg and flag",
+			flags:  []string{"--market", "2"},
+			args:   []string{"1000"},
+			expReq: &exchange.QueryGetMarketAuthorityTransferRequest{},
+			expErr: "cannot provide <market id> as both an arg (\"1000\") and flag (--market 2)",
 		},
 	}
 
@@ -991,50 +2423,59 @@ func TestMakeQueryGetAllCommitments(t *testing.T) {
 	}
 }
 
-func TestSetupCmdQueryGetMarket(t *testing.T) {
+func TestSetupCmdQueryExportMarket(t *testing.T) {
 	runSetupTestCase(t, setupTestCase{
-		name:     "SetupCmdQueryGetMarket",
-		setup:    cli.SetupCmdQueryGetMarket,
-		expFlags: []string{cli.FlagMarket},
+		name:     "SetupCmdQueryExportMarket",
+		setup:    cli.SetupCmdQueryExportMarket,
+		expFlags: []string{cli.FlagMarket, flags.FlagOutputDocument},
 		expInUse: []string{
 			"{<market id>|--market <market id>}",
+			"[--output-document <file>]", "[--height <height>]",
 			"A <market id> is required as either an arg or flag, but not both.",
+			"The snapshot is taken from the state at the provided --height, or the latest state if not provided.",
 		},
 		expExamples: []string{
 			exampleStart + " 3",
-			exampleStart + " --market 1",
+			exampleStart + " 3 --output-document market-3.json",
+			exampleStart + " --market 1 --height 12345 --output-document market-1.json",
 		},
 	})
 }
 
-func TestMakeQueryGetMarket(t *testing.T) {
-	td := queryMakerTestDef[exchange.QueryGetMarketRequest]{
-		makerName: "MakeQueryGetMarket",
-		maker:     cli.MakeQueryGetMarket,
-		setup:     cli.SetupCmdQueryGetMarket,
+func TestMakeQueryExportMarket(t *testing.T) {
+	td := queryMakerTestDef[exchange.QueryExportMarketRequest]{
+		makerName: "MakeQueryExportMarket",
+		maker:     cli.MakeQueryExportMarket,
+		setup:     cli.SetupCmdQueryExportMarket,
 	}
 
-	tests := []queryMakerTestCase[exchange.QueryGetMarketRequest]{
+	tests := []queryMakerTestCase[exchange.QueryExportMarketRequest]{
 		{
 			name:   "no market",
-			expReq: &exchange.QueryGetMarketRequest{},
+			expReq: &exchange.QueryExportMarketRequest{},
 			expErr: "no <market id> provided",
 		},
 		{
 			name:   "just flag",
 			flags:  []string{"--market", "2"},
-			expReq: &exchange.QueryGetMarketRequest{MarketId: 2},
+			expReq: &exchange.QueryExportMarketRequest{MarketId: 2},
 		},
 		{
 			name:   "just arg",
 			args:   []string{"1000"},
-			expReq: &exchange.QueryGetMarketRequest{MarketId: 1000},
+			expReq: &exchange.QueryExportMarketRequest{MarketId: 1000},
+		},
+		{
+			name:   "arg and output document",
+			flags:  []string{"--output-document", "snapshot.json"},
+			args:   []string{"7"},
+			expReq: &exchange.QueryExportMarketRequest{MarketId: 7},
 		},
 		{
 			name:   "both arg and flag",
 			flags:  []string{"--market", "2"},
 			args:   []string{"1000"},
-			expReq: &exchange.QueryGetMarketRequest{},
+			expReq: &exchange.QueryExportMarketRequest{},
 			expErr: "cannot provide <market id> as both an arg (\"1000\") and flag (--market 2)",
 		},
 	}
@@ -1340,9 +2781,15 @@ func TestSetupCmdQueryValidateCreateMarket(t *testing.T) {
 			cli.FlagAuthority,
 			cli.FlagMarket, cli.FlagName, cli.FlagDescription, cli.FlagURL, cli.FlagIcon,
 			cli.FlagCreateAsk, cli.FlagCreateBid, cli.FlagCreateCommitment,
-			cli.FlagSellerFlat, cli.FlagSellerRatios, cli.FlagBuyerFlat, cli.FlagBuyerRatios,
-			cli.FlagAcceptingOrders, cli.FlagAllowUserSettle, cli.FlagAcceptingCommitments, cli.FlagAccessGrants,
+			cli.FlagSellerFlat, cli.FlagSellerRatios, cli.FlagTakerRatios, cli.FlagBuyerFlat, cli.FlagBuyerRatios,
+			cli.FlagRebateRatios, cli.FlagRebateAddrs, cli.FlagReferralBips, cli.FlagReferralCap,
+			cli.FlagAcceptingOrders, cli.FlagAllowUserSettle, cli.FlagAcceptingCommitments, cli.FlagContinuousMatching,
+			cli.FlagBatchAuctionInterval, cli.FlagSelfTradePrevention, cli.FlagPublishPrices, cli.FlagExternalIDScope,
+			cli.FlagFillAlgorithm,
+			cli.FlagMaxOrders, cli.FlagWindowBlocks, cli.FlagMaxExposure,
+			cli.FlagAccessGrants,
 			cli.FlagReqAttrAsk, cli.FlagReqAttrBid, cli.FlagReqAttrCommitment,
+			cli.FlagAssetDenoms, cli.FlagPriceDenoms,
 			cli.FlagBips, cli.FlagDenom,
 			cli.FlagProposal,
 		},
@@ -1350,14 +2797,21 @@ func TestSetupCmdQueryValidateCreateMarket(t *testing.T) {
 			"[--authority <authority>]", "[--market <market id>]",
 			"[--name <name>]", "[--description <description>]", "[--url <website url>]", "[--icon <icon uri>]",
 			"[--create-ask <coins>]", "[--create-bid <coins>]", "[--create-commitment <coins>]",
-			"[--seller-flat <coins>]", "[--seller-ratios <fee ratios>]",
+			"[--seller-flat <coins>]", "[--seller-ratios <fee ratios>]", "[--taker-ratios <fee ratios>]",
 			"[--buyer-flat <coins>]", "[--buyer-ratios <fee ratios>]",
-			"[--accepting-orders]", "[--allow-user-settle]", "[--accepting-commitments]",
+			"[--rebate-ratios <fee ratios>]", "[--rebate-addrs <addresses>]",
+			"[--referral-bips <bips>]", "[--referral-cap <coins>]",
+			"[--accepting-orders]", "[--allow-user-settle]", "[--accepting-commitments]", "[--continuous-matching]",
+			"[--batch-auction-interval <blocks>]", "[--self-trade-prevention <mode>]", "[--publish-prices]",
+			"[--external-id-scope <scope>]", "[--fill-algorithm <algorithm>]",
+			"[--max-orders <count>]", "[--window-blocks <blocks>]", "[--max-exposure <coin>]",
 			"[--access-grants <access grants>]",
 			"[--req-attr-ask <attrs>]", "[--req-attr-bid <attrs>]", "[--req-attr-commitment <attrs>]",
+			"[--asset-denoms <denoms>]", "[--price-denoms <denoms>]",
 			"[--bips <bips>]", "[--denom <denom>]",
 			"[--proposal <json filename>",
-			cli.AuthorityDesc, cli.RepeatableDesc, cli.AccessGrantsDesc, cli.FeeRatioDesc,
+			cli.AuthorityDesc, cli.RepeatableDesc, cli.AccessGrantsDesc, cli.FeeRatioDesc, cli.SelfTradePreventionDesc, cli.OrderRateLimitDesc,
+			cli.FillAlgorithmDesc, cli.MaxExposureDesc, cli.AcceptedDenomsDesc,
 			cli.ProposalFileDesc(&exchange.MsgGovCreateMarketRequest{}),
 		},
 	}
@@ -1365,9 +2819,15 @@ func TestSetupCmdQueryValidateCreateMarket(t *testing.T) {
 	oneReqFlags := []string{
 		cli.FlagMarket, cli.FlagName, cli.FlagDescription, cli.FlagURL, cli.FlagIcon,
 		cli.FlagCreateAsk, cli.FlagCreateBid, cli.FlagCreateCommitment,
-		cli.FlagSellerFlat, cli.FlagSellerRatios, cli.FlagBuyerFlat, cli.FlagBuyerRatios,
-		cli.FlagAcceptingOrders, cli.FlagAllowUserSettle, cli.FlagAcceptingCommitments, cli.FlagAccessGrants,
+		cli.FlagSellerFlat, cli.FlagSellerRatios, cli.FlagTakerRatios, cli.FlagBuyerFlat, cli.FlagBuyerRatios,
+		cli.FlagRebateRatios, cli.FlagRebateAddrs, cli.FlagReferralBips, cli.FlagReferralCap,
+		cli.FlagAcceptingOrders, cli.FlagAllowUserSettle, cli.FlagAcceptingCommitments, cli.FlagContinuousMatching,
+		cli.FlagBatchAuctionInterval, cli.FlagSelfTradePrevention, cli.FlagPublishPrices, cli.FlagExternalIDScope,
+		cli.FlagFillAlgorithm,
+		cli.FlagMaxOrders, cli.FlagWindowBlocks, cli.FlagMaxExposure,
+		cli.FlagAccessGrants,
 		cli.FlagReqAttrAsk, cli.FlagReqAttrBid, cli.FlagReqAttrCommitment,
+		cli.FlagAssetDenoms, cli.FlagPriceDenoms,
 		cli.FlagBips, cli.FlagDenom,
 		cli.FlagProposal,
 	}
@@ -1459,7 +2919,7 @@ func TestMakeQueryValidateCreateMarket(t *testing.T) {
 			flags: []string{
 				"--authority", "otherauth", "--market", "18",
 				"--create-ask", "10fig", "--create-bid", "5grape",
-				"--seller-flat", "12fig", "--seller-ratios", "100prune:1prune",
+				"--seller-flat", "12fig", "--seller-ratios", "100prune:1prune", "--taker-ratios", "100prune:3prune",
 				"--buyer-flat", "17fig", "--buyer-ratios", "88plum:3plum",
 				"--accepting-orders", "--allow-user-settle",
 				"--access-grants", "addr1:settle+cancel", "--access-grants", "addr2:update+permissions",
@@ -1485,6 +2945,9 @@ func TestMakeQueryValidateCreateMarket(t *testing.T) {
 						FeeSellerSettlementRatios: []exchange.FeeRatio{
 							{Price: sdk.NewInt64Coin("prune", 100), Fee: sdk.NewInt64Coin("prune", 1)},
 						},
+						FeeSellerSettlementTakerRatios: []exchange.FeeRatio{
+							{Price: sdk.NewInt64Coin("prune", 100), Fee: sdk.NewInt64Coin("prune", 3)},
+						},
 						FeeBuyerSettlementFlat: []sdk.Coin{sdk.NewInt64Coin("fig", 17)},
 						FeeBuyerSettlementRatios: []exchange.FeeRatio{
 							{Price: sdk.NewInt64Coin("plum", 88), Fee: sdk.NewInt64Coin("plum", 3)},
@@ -1590,12 +3053,17 @@ func TestSetupCmdQueryValidateManageFees(t *testing.T) {
 			cli.FlagAuthority, cli.FlagMarket,
 			cli.FlagAskAdd, cli.FlagAskRemove, cli.FlagBidAdd, cli.FlagBidRemove,
 			cli.FlagSellerFlatAdd, cli.FlagSellerFlatRemove, cli.FlagSellerRatiosAdd, cli.FlagSellerRatiosRemove,
+			cli.FlagTakerRatiosAdd, cli.FlagTakerRatiosRemove,
+			cli.FlagRebateRatiosAdd, cli.FlagRebateRatiosRemove, cli.FlagRebateAddrsAdd, cli.FlagRebateAddrsRemove,
+			cli.FlagReferralBips, cli.FlagUnsetReferralBips, cli.FlagReferralCapAdd, cli.FlagReferralCapRemove,
 			cli.FlagBuyerFlatAdd, cli.FlagBuyerFlatRemove, cli.FlagBuyerRatiosAdd, cli.FlagBuyerRatiosRemove,
 			cli.FlagCommitmentAdd, cli.FlagCommitmentRemove, cli.FlagBips, cli.FlagUnsetBips,
-			cli.FlagProposal,
+			cli.FlagEffectiveHeight, cli.FlagEffectiveTime, cli.FlagProposal,
 		},
 		expAnnotations: map[string]map[string][]string{
-			cli.FlagMarket: {required: {"true"}},
+			cli.FlagMarket:          {required: {"true"}},
+			cli.FlagEffectiveHeight: {mutExc: {cli.FlagEffectiveHeight + " " + cli.FlagEffectiveTime}},
+			cli.FlagEffectiveTime:   {mutExc: {cli.FlagEffectiveHeight + " " + cli.FlagEffectiveTime}},
 		},
 		expInUse: []string{
 			"--market <market id>", "[--authority <authority>]",
@@ -1604,11 +3072,17 @@ func TestSetupCmdQueryValidateManageFees(t *testing.T) {
 			"[--commitment-add <coins>]", "[--commitment-remove <coins>]",
 			"[--seller-flat-add <coins>]", "[--seller-flat-remove <coins>]",
 			"[--seller-ratios-add <fee ratios>]", "[--seller-ratios-remove <fee ratios>]",
+			"[--taker-ratios-add <fee ratios>]", "[--taker-ratios-remove <fee ratios>]",
+			"[--rebate-ratios-add <fee ratios>]", "[--rebate-ratios-remove <fee ratios>]",
+			"[--rebate-addrs-add <addresses>]", "[--rebate-addrs-remove <addresses>]",
+			"[--referral-bips <bips>]", "[--unset-referral-bips]",
+			"[--referral-cap-add <coins>]", "[--referral-cap-remove <coins>]",
 			"[--buyer-flat-add <coins>]", "[--buyer-flat-remove <coins>]",
 			"[--buyer-ratios-add <fee ratios>]", "[--buyer-ratios-remove <fee ratios>]",
 			"[--bips <bips>]", "[--unset-bips]",
+			"[--effective-height <height>]", "[--effective-time <time>]",
 			"[--proposal <json filename>",
-			cli.AuthorityDesc, cli.RepeatableDesc, cli.FeeRatioDesc,
+			cli.AuthorityDesc, cli.RepeatableDesc, cli.FeeRatioDesc, cli.ScheduledFeesDesc,
 			cli.ProposalFileDesc(&exchange.MsgGovManageFeesRequest{}),
 		},
 	}
@@ -1616,6 +3090,9 @@ func TestSetupCmdQueryValidateManageFees(t *testing.T) {
 	oneReqFlags := []string{
 		cli.FlagAskAdd, cli.FlagAskRemove, cli.FlagBidAdd, cli.FlagBidRemove,
 		cli.FlagSellerFlatAdd, cli.FlagSellerFlatRemove, cli.FlagSellerRatiosAdd, cli.FlagSellerRatiosRemove,
+		cli.FlagTakerRatiosAdd, cli.FlagTakerRatiosRemove,
+		cli.FlagRebateRatiosAdd, cli.FlagRebateRatiosRemove, cli.FlagRebateAddrsAdd, cli.FlagRebateAddrsRemove,
+		cli.FlagReferralBips, cli.FlagUnsetReferralBips, cli.FlagReferralCapAdd, cli.FlagReferralCapRemove,
 		cli.FlagBuyerFlatAdd, cli.FlagBuyerFlatRemove, cli.FlagBuyerRatiosAdd, cli.FlagBuyerRatiosRemove,
 		cli.FlagCommitmentAdd, cli.FlagCommitmentRemove, cli.FlagBips, cli.FlagUnsetBips,
 		cli.FlagProposal,
@@ -1697,6 +3174,7 @@ func TestMakeQueryValidateManageFees(t *testing.T) {
 				"--bid-add", "17fig", "--bid-remove", "14fig",
 				"--seller-flat-add", "55prune", "--seller-flat-remove", "54prune",
 				"--seller-ratios-add", "101prune:7prune", "--seller-ratios-remove", "101prune:3prune",
+				"--taker-ratios-add", "101prune:9prune", "--taker-ratios-remove", "101prune:5prune",
 				"--buyer-flat-add", "59prune", "--buyer-flat-remove", "57prune",
 				"--buyer-ratios-add", "107prune:1prune", "--buyer-ratios-remove", "43prune:2prune",
 			},
@@ -1716,6 +3194,12 @@ func TestMakeQueryValidateManageFees(t *testing.T) {
 					RemoveFeeSellerSettlementRatios: []exchange.FeeRatio{
 						{Price: sdk.NewInt64Coin("prune", 101), Fee: sdk.NewInt64Coin("prune", 3)},
 					},
+					AddFeeSellerSettlementTakerRatios: []exchange.FeeRatio{
+						{Price: sdk.NewInt64Coin("prune", 101), Fee: sdk.NewInt64Coin("prune", 9)},
+					},
+					RemoveFeeSellerSettlementTakerRatios: []exchange.FeeRatio{
+						{Price: sdk.NewInt64Coin("prune", 101), Fee: sdk.NewInt64Coin("prune", 5)},
+					},
 					AddFeeBuyerSettlementFlat:    []sdk.Coin{sdk.NewInt64Coin("prune", 59)},
 					RemoveFeeBuyerSettlementFlat: []sdk.Coin{sdk.NewInt64Coin("prune", 57)},
 					AddFeeBuyerSettlementRatios: []exchange.FeeRatio{
@@ -1840,15 +3324,23 @@ func TestSetupCmdQueryGetPaymentsWithSource(t *testing.T) {
 		expFlags: []string{
 			flags.FlagPage, flags.FlagPageKey, flags.FlagOffset,
 			flags.FlagLimit, flags.FlagCountTotal, flags.FlagReverse,
-			cli.FlagSource,
+			cli.FlagSource, cli.FlagDenom, cli.FlagMinAmount, cli.FlagMaxAmount,
+			cli.FlagWithTargetAmount, cli.FlagWithoutTargetAmount,
 		},
 		expInUse: []string{
-			"{<source>|--source <source>}", cli.PageFlagsUse,
+			"{<source>|--source <source>}", cli.OptPaymentFilterUse, cli.PageFlagsUse,
 			"A <source> is required as either an arg or a flag, but not both.",
+			cli.PaymentFilterDesc,
+		},
+		expAnnotations: map[string]map[string][]string{
+			cli.FlagWithTargetAmount:    {mutExc: {cli.FlagWithTargetAmount + " " + cli.FlagWithoutTargetAmount}},
+			cli.FlagWithoutTargetAmount: {mutExc: {cli.FlagWithTargetAmount + " " + cli.FlagWithoutTargetAmount}},
 		},
 		expExamples: []string{
 			exampleStart + " " + cli.ExampleAddr,
 			exampleStart + " --source " + cli.ExampleAddr,
+			exampleStart + " " + cli.ExampleAddr + " --denom nhash --min-amount 1000",
+			exampleStart + " " + cli.ExampleAddr + " --without-target-amount",
 		},
 	}
 	runSetupTestCase(t, tc)
@@ -1896,6 +3388,36 @@ func TestMakeQueryGetPaymentsWithSource(t *testing.T) {
 				Pagination: &query.PageRequest{Offset: 11, Limit: 100, Key: []byte{}},
 			},
 		},
+		{
+			name:  "denom and amounts",
+			args:  []string{"alf"},
+			flags: []string{"--denom", "nhash", "--min-amount", "5", "--max-amount", "100"},
+			expReq: &exchange.QueryGetPaymentsWithSourceRequest{
+				Source:     "alf",
+				Filter:     &exchange.PaymentFilter{Denom: "nhash", MinAmount: "5", MaxAmount: "100"},
+				Pagination: defaultPageReq,
+			},
+		},
+		{
+			name:  "with target amount",
+			args:  []string{"alf"},
+			flags: []string{"--with-target-amount"},
+			expReq: &exchange.QueryGetPaymentsWithSourceRequest{
+				Source:     "alf",
+				Filter:     &exchange.PaymentFilter{TargetAmount: exchange.TargetAmountFilter_present},
+				Pagination: defaultPageReq,
+			},
+		},
+		{
+			name:  "without target amount",
+			args:  []string{"alf"},
+			flags: []string{"--without-target-amount", "--denom", "banana"},
+			expReq: &exchange.QueryGetPaymentsWithSourceRequest{
+				Source:     "alf",
+				Filter:     &exchange.PaymentFilter{Denom: "banana", TargetAmount: exchange.TargetAmountFilter_absent},
+				Pagination: defaultPageReq,
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -1912,15 +3434,23 @@ func TestSetupCmdQueryGetPaymentsWithTarget(t *testing.T) {
 		expFlags: []string{
 			flags.FlagPage, flags.FlagPageKey, flags.FlagOffset,
 			flags.FlagLimit, flags.FlagCountTotal, flags.FlagReverse,
-			cli.FlagTarget,
+			cli.FlagTarget, cli.FlagDenom, cli.FlagMinAmount, cli.FlagMaxAmount,
+			cli.FlagWithTargetAmount, cli.FlagWithoutTargetAmount,
 		},
 		expInUse: []string{
-			"{<target>|--target <target>}", cli.PageFlagsUse,
+			"{<target>|--target <target>}", cli.OptPaymentFilterUse, cli.PageFlagsUse,
 			"A <target> is required as either an arg or a flag, but not both.",
+			cli.PaymentFilterDesc,
+		},
+		expAnnotations: map[string]map[string][]string{
+			cli.FlagWithTargetAmount:    {mutExc: {cli.FlagWithTargetAmount + " " + cli.FlagWithoutTargetAmount}},
+			cli.FlagWithoutTargetAmount: {mutExc: {cli.FlagWithTargetAmount + " " + cli.FlagWithoutTargetAmount}},
 		},
 		expExamples: []string{
 			exampleStart + " " + cli.ExampleAddr,
 			exampleStart + " --target " + cli.ExampleAddr,
+			exampleStart + " " + cli.ExampleAddr + " --denom nhash --min-amount 1000",
+			exampleStart + " " + cli.ExampleAddr + " --without-target-amount",
 		},
 	}
 	runSetupTestCase(t, tc)
@@ -1968,6 +3498,36 @@ func TestMakeQueryGetPaymentsWithTarget(t *testing.T) {
 				Pagination: &query.PageRequest{Offset: 11, Limit: 100, Key: []byte{}},
 			},
 		},
+		{
+			name:  "denom and amounts",
+			args:  []string{"gandalf"},
+			flags: []string{"--denom", "nhash", "--min-amount", "5", "--max-amount", "100"},
+			expReq: &exchange.QueryGetPaymentsWithTargetRequest{
+				Target:     "gandalf",
+				Filter:     &exchange.PaymentFilter{Denom: "nhash", MinAmount: "5", MaxAmount: "100"},
+				Pagination: defaultPageReq,
+			},
+		},
+		{
+			name:  "with target amount",
+			args:  []string{"gandalf"},
+			flags: []string{"--with-target-amount"},
+			expReq: &exchange.QueryGetPaymentsWithTargetRequest{
+				Target:     "gandalf",
+				Filter:     &exchange.PaymentFilter{TargetAmount: exchange.TargetAmountFilter_present},
+				Pagination: defaultPageReq,
+			},
+		},
+		{
+			name:  "without target amount",
+			args:  []string{"gandalf"},
+			flags: []string{"--without-target-amount", "--denom", "banana"},
+			expReq: &exchange.QueryGetPaymentsWithTargetRequest{
+				Target:     "gandalf",
+				Filter:     &exchange.PaymentFilter{Denom: "banana", TargetAmount: exchange.TargetAmountFilter_absent},
+				Pagination: defaultPageReq,
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -2135,3 +3695,60 @@ func TestMakeQueryPaymentFeeCalc(t *testing.T) {
 		})
 	}
 }
+
+func TestSetupCmdQueryGetAccountActivitySummary(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdQueryGetAccountActivitySummary",
+		setup: cli.SetupCmdQueryGetAccountActivitySummary,
+		expFlags: []string{
+			cli.FlagAccount,
+		},
+		expInUse: []string{
+			"{<account>|--account <account>}",
+			"An <account> is required as either an arg or flag, but not both.",
+		},
+		expExamples: []string{
+			exampleStart + " " + cli.ExampleAddr,
+			exampleStart + " --account " + cli.ExampleAddr,
+		},
+	})
+}
+
+func TestMakeQueryGetAccountActivitySummary(t *testing.T) {
+	td := queryMakerTestDef[exchange.QueryGetAccountActivitySummaryRequest]{
+		makerName: "MakeQueryGetAccountActivitySummary",
+		maker:     cli.MakeQueryGetAccountActivitySummary,
+		setup:     cli.SetupCmdQueryGetAccountActivitySummary,
+	}
+
+	tests := []queryMakerTestCase[exchange.QueryGetAccountActivitySummaryRequest]{
+		{
+			name:   "no account",
+			expReq: &exchange.QueryGetAccountActivitySummaryRequest{},
+			expErr: "no <account> provided",
+		},
+		{
+			name:   "account as flag",
+			flags:  []string{"--account", "someaddr"},
+			expReq: &exchange.QueryGetAccountActivitySummaryRequest{Account: "someaddr"},
+		},
+		{
+			name:   "account as arg",
+			args:   []string{"otheraddr"},
+			expReq: &exchange.QueryGetAccountActivitySummaryRequest{Account: "otheraddr"},
+		},
+		{
+			name:   "account as flag and arg",
+			flags:  []string{"--account", "someaddr"},
+			args:   []string{"otheraddr"},
+			expReq: &exchange.QueryGetAccountActivitySummaryRequest{},
+			expErr: "cannot provide <account> as both an arg (\"otheraddr\") and flag (--account \"someaddr\")",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runQueryMakerTest(t, td, tc)
+		})
+	}
+}
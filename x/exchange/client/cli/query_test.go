@@ -2,6 +2,7 @@ package cli_test
 
 import (
 	"fmt"
+	"os"
 	"path/filepath"
 	"strings"
 
@@ -32,6 +33,9 @@ settlement_flat_fee_options:
 - amount: "50"
   denom: peach
 settlement_ratio_fee_options:
+- amount: "10"
+  denom: peach
+settlement_taker_ratio_fee_options:
 - amount: "10"
   denom: peach
 `,
@@ -42,6 +46,7 @@ settlement_ratio_fee_options:
 			expOut: `creation_fee_options: []
 settlement_flat_fee_options: []
 settlement_ratio_fee_options: []
+settlement_taker_ratio_fee_options: []
 `,
 		},
 		{
@@ -50,6 +55,7 @@ settlement_ratio_fee_options: []
 			expOut: `creation_fee_options: []
 settlement_flat_fee_options: []
 settlement_ratio_fee_options: []
+settlement_taker_ratio_fee_options: []
 `,
 		},
 		{
@@ -68,6 +74,7 @@ settlement_ratio_fee_options: []
 				`"creation_fee_options":[{"denom":"peach","amount":"20"}]`,
 				`"settlement_flat_fee_options":[{"denom":"peach","amount":"100"}]`,
 				`"settlement_ratio_fee_options":[{"denom":"peach","amount":"14"}]`,
+				`"settlement_taker_ratio_fee_options":[{"denom":"peach","amount":"14"}]`,
 			},
 		},
 		{
@@ -84,6 +91,7 @@ settlement_ratio_fee_options:
   denom: peach
 - amount: "60"
   denom: stake
+settlement_taker_ratio_fee_options: []
 `,
 		},
 	}
@@ -116,11 +124,15 @@ func (s *CmdTestSuite) TestCmdQueryGetOrder() {
     assets:
       amount: "4200"
       denom: acorn
+    basket_assets: []
+    display_assets: null
+    expiration: null
     external_id: my-id-42
     market_id: 420
     price:
       amount: "17640"
       denom: peach
+    referrer: ""
     seller: ` + s.accountAddrs[2].String() + `
     seller_settlement_flat_fee: null
   order_id: "42"
@@ -188,6 +200,41 @@ func (s *CmdTestSuite) TestCmdQueryGetOrderByExternalID() {
 	}
 }
 
+func (s *CmdTestSuite) TestCmdQueryGetOrdersByExternalIDPrefix() {
+	tests := []queryCmdTestCase{
+		{
+			name:     "cmd error",
+			args:     []string{"orders-by-external-id-prefix", "420"},
+			expInErr: []string{"required flag(s) \"prefix\" not set"},
+		},
+		{
+			name: "no orders",
+			args: []string{"get-orders-by-external-id-prefix", "420", "--prefix", "not-my-id"},
+			expOut: `orders: []
+pagination:
+  next_key: null
+  total: "0"
+`,
+		},
+		{
+			name: "several orders",
+			args: []string{"external-id-prefix", "--market", "420", "--prefix", "my-id-3", "--limit", "4", "--output", "json"},
+			expInOut: []string{
+				`"market_id":420,`,
+				`"order_id":"3"`, `"order_id":"30"`, `"order_id":"31"`, `"order_id":"32"`,
+				`"external_id":"my-id-3"`, `"external_id":"my-id-30"`,
+				`"external_id":"my-id-31"`, `"external_id":"my-id-32"`,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runQueryCmdTestCase(tc)
+		})
+	}
+}
+
 func (s *CmdTestSuite) TestCmdQueryGetMarketOrders() {
 	tests := []queryCmdTestCase{
 		{
@@ -217,6 +264,20 @@ pagination:
 				`"total":"15"`,
 			},
 		},
+		{
+			name:     "by price, only asset denom",
+			args:     []string{"market-orders", "420", "--assets", "acorn"},
+			expInErr: []string{"asset denom and price denom must either both be provided or both be empty"},
+		},
+		{
+			name: "by price",
+			args: []string{"market-orders", "420", "--assets", "acorn", "--price", "peach", "--asks",
+				"--reverse", "--limit", "2", "--output", "json"},
+			expInOut: []string{
+				`"market_id":420,`, `"order_id":"58"`, `"order_id":"49"`,
+				`"assets":{"denom":"acorn","amount":"5800"}`, `"price":{"denom":"peach","amount":"33640"}`,
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -319,6 +380,419 @@ func (s *CmdTestSuite) TestCmdQueryGetAllOrders() {
 	}
 }
 
+func (s *CmdTestSuite) TestCmdQueryGetOrderLink() {
+	tests := []queryCmdTestCase{
+		{
+			name:     "no order id",
+			args:     []string{"order-link"},
+			expInErr: []string{"no <order id> provided"},
+		},
+		{
+			name:     "order not linked",
+			args:     []string{"order-link", "--order", "42"},
+			expInErr: []string{"order 42 is not linked", "invalid request", "InvalidArgument"},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runQueryCmdTestCase(tc)
+		})
+	}
+}
+
+func (s *CmdTestSuite) TestCmdQueryGetMarketOrderLinks() {
+	tests := []queryCmdTestCase{
+		{
+			name:     "no market id",
+			args:     []string{"market-order-links"},
+			expInErr: []string{"no <market id> provided"},
+		},
+		{
+			name: "no links",
+			args: []string{"market-order-links", "420"},
+			expOut: `order_links: []
+pagination:
+  next_key: null
+  total: "0"
+`,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runQueryCmdTestCase(tc)
+		})
+	}
+}
+
+func (s *CmdTestSuite) TestCmdQueryGetDeadManSwitch() {
+	tests := []queryCmdTestCase{
+		{
+			name:     "no account",
+			args:     []string{"dead-man-switch"},
+			expInErr: []string{"no <account> provided"},
+		},
+		{
+			name:     "no dead-man switch",
+			args:     []string{"get-dead-man-switch", s.addr1.String()},
+			expInErr: []string{"no dead-man switch found for " + s.addr1.String()},
+		},
+		{
+			name:     "by arg",
+			args:     []string{"dms", s.addr9.String()},
+			expInOut: []string{`account: ` + s.addr9.String(), `interval_seconds: "1000000000"`, `deadline: "20`},
+		},
+		{
+			name:     "by flag",
+			args:     []string{"dead-man-switch", "--account", s.addr9.String(), "--output", "json"},
+			expInOut: []string{`"dead_man_switch":{"account":"` + s.addr9.String() + `","interval_seconds":"1000000000"`},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runQueryCmdTestCase(tc)
+		})
+	}
+}
+
+func (s *CmdTestSuite) TestCmdQueryOrderBookDepth() {
+	tests := []queryCmdTestCase{
+		{
+			name:     "no denoms",
+			args:     []string{"order-book-depth", "420"},
+			expInErr: []string{"required flag(s) \"assets\", \"price\" not set"},
+		},
+		{
+			name:     "unknown market",
+			args:     []string{"depth", "419", "--assets", "apple", "--price", "peach"},
+			expInErr: []string{"market 419 does not exist"},
+		},
+		{
+			name:   "no orders for denoms",
+			args:   []string{"order-book-depth", "--market", "420", "--assets", "apple", "--price", "plum"},
+			expOut: "asks: []\nbids: []\n",
+		},
+		{
+			name:     "orders for denoms",
+			args:     []string{"book-depth", "420", "--assets", "apple", "--price", "peach", "--depth", "1"},
+			expInOut: []string{"asks:", "bids:", "denom: apple", "denom: peach", `order_count: 1`},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runQueryCmdTestCase(tc)
+		})
+	}
+}
+
+func (s *CmdTestSuite) TestCmdQueryTopOfBook() {
+	tests := []queryCmdTestCase{
+		{
+			name:     "no denoms",
+			args:     []string{"top-of-book", "420"},
+			expInErr: []string{"required flag(s) \"assets\", \"price\" not set"},
+		},
+		{
+			name:     "unknown market",
+			args:     []string{"top", "419", "--assets", "apple", "--price", "peach"},
+			expInErr: []string{"market 419 does not exist"},
+		},
+		{
+			name:   "no orders for denoms",
+			args:   []string{"top-of-book", "--market", "420", "--assets", "apple", "--price", "plum"},
+			expOut: "best_ask: null\nbest_bid: null\nspread: \"\"\n",
+		},
+		{
+			name:     "orders for denoms",
+			args:     []string{"best-prices", "420", "--assets", "apple", "--price", "peach"},
+			expInOut: []string{"best_ask:", "best_bid:", "denom: apple", "denom: peach", `order_count:`, "spread:"},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runQueryCmdTestCase(tc)
+		})
+	}
+}
+
+func (s *CmdTestSuite) TestCmdQueryPriceAverages() {
+	tests := []queryCmdTestCase{
+		{
+			name:     "no denoms or window",
+			args:     []string{"price-averages", "420"},
+			expInErr: []string{"required flag(s) \"assets\", \"price\", \"window\" not set"},
+		},
+		{
+			name:     "window too large",
+			args:     []string{"averages", "420", "--assets", "apple", "--price", "peach", "--window", "721h"},
+			expInErr: []string{"invalid window 2595600: must be from 1 to 2592000 seconds"},
+		},
+		{
+			name:     "unknown market",
+			args:     []string{"twap", "419", "--assets", "apple", "--price", "peach", "--window", "1h"},
+			expInErr: []string{"market 419 does not exist"},
+		},
+		{
+			name: "no settlements for denoms",
+			args: []string{"price-averages", "--market", "420", "--assets", "acorn", "--price", "plum", "--window", "1h"},
+			expOut: `total_price:
+  amount: "0"
+  denom: plum
+twap: ""
+volume:
+  amount: "0"
+  denom: acorn
+vwap: ""
+`,
+		},
+		{
+			name:     "settlements for denoms",
+			args:     []string{"vwap", "420", "--assets", "apple", "--price", "peach", "--window", "24h"},
+			expInOut: []string{"total_price:", "twap:", "volume:", "vwap:", "denom: apple", "denom: peach"},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runQueryCmdTestCase(tc)
+		})
+	}
+}
+
+func (s *CmdTestSuite) TestCmdQueryGetMarketSettlements() {
+	tests := []queryCmdTestCase{
+		{
+			name:     "no market",
+			args:     []string{"market-settlements"},
+			expInErr: []string{"no <market id> provided"},
+		},
+		{
+			name:   "no settlements",
+			args:   []string{"get-market-settlements", "419", "--output", "json"},
+			expOut: `{"settlements":[],"pagination":{"next_key":null,"total":"0"}}` + "\n",
+		},
+		{
+			name: "two settlements",
+			args: []string{"market-settlements", "--market", "420"},
+			expInOut: []string{
+				`market_id: 420`, `height: "1"`, `height: "2"`,
+				`order_id: "1"`, `order_id: "2"`, `owner: ` + s.addr1.String(),
+			},
+		},
+		{
+			name:     "after height",
+			args:     []string{"market-settlements", "420", "--after", "1", "--output", "json"},
+			expInOut: []string{`"settlements":[{"market_id":420,"height":"2"`, `"fills":[{"order_id":"2"`},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runQueryCmdTestCase(tc)
+		})
+	}
+}
+
+func (s *CmdTestSuite) TestCmdQueryGetAllSettlements() {
+	tests := []queryCmdTestCase{
+		{
+			name:     "cmd error",
+			args:     []string{"all-settlements", "extraarg"},
+			expInErr: []string{"unknown command \"extraarg\" for \"exchange all-settlements\""},
+		},
+		{
+			name:     "some settlements",
+			args:     []string{"get-all-settlements", "--output", "json"},
+			expInOut: []string{`"settlements":[{"market_id":420,"height":"1"`, `"order_type":"ask"`},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runQueryCmdTestCase(tc)
+		})
+	}
+}
+
+func (s *CmdTestSuite) TestCmdQueryGetSettlementReceipt() {
+	tests := []queryCmdTestCase{
+		{
+			name:     "no settlement id",
+			args:     []string{"settlement-receipt"},
+			expInErr: []string{"no <settlement id> provided"},
+		},
+		{
+			name:     "unknown settlement",
+			args:     []string{"get-settlement-receipt", "99"},
+			expInErr: []string{"settlement 99 receipt not found"},
+		},
+		{
+			name: "by arg",
+			args: []string{"receipt", "2"},
+			expInOut: []string{
+				`settlement_id: "2"`, `market_id: 420`, `height: "2"`,
+				`order_id: "2"`, `owner: ` + s.addr1.String(),
+			},
+		},
+		{
+			name:     "by flag",
+			args:     []string{"settlement-receipt", "--settlement", "1", "--output", "json"},
+			expInOut: []string{`"receipt":{"settlement_id":"1","market_id":420,"height":"1"`, `"order_type":"ask"`},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runQueryCmdTestCase(tc)
+		})
+	}
+}
+
+func (s *CmdTestSuite) TestCmdQuerySimulateMarketSettle() {
+	tests := []queryCmdTestCase{
+		{
+			name:     "cmd error",
+			args:     []string{"simulate-market-settle", "--admin", s.addr1.String(), "--market", "420", "--asks", "4"},
+			expInErr: []string{"required flag(s) \"bids\" not set"},
+		},
+		{
+			name: "no permission",
+			args: []string{"simulate-settle", "--admin", s.addr2.String(), "--market", "420",
+				"--asks", "4,13", "--bids", "17"},
+			expOut: "error: 'account " + s.addr2.String() + " does not have permission\n" +
+				"  to settle orders for market 420: invalid request'\n" +
+				"fee_inputs: []\nfills: []\nnavs: []\ntransfers: []\n",
+		},
+		{
+			name: "two asks and a bid",
+			args: []string{"market-settle-simulate", "--admin", s.addr1.String(), "--market", "420",
+				"--asks", "4,13", "--bids", "17", "--output", "json"},
+			expInOut: []string{
+				`"error":""`,
+				`"fills":[{"order_id":"4","order_type":"ask"`,
+				`"navs":[{"assets":{"denom":"apple","amount":"1700"},"price":{"denom":"peach","amount":"2890"}}]`,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runQueryCmdTestCase(tc)
+		})
+	}
+}
+
+func (s *CmdTestSuite) TestCmdQuerySimulateFillBids() {
+	tests := []queryCmdTestCase{
+		{
+			name:     "cmd error",
+			args:     []string{"simulate-fill-bids", "--seller", s.addr1.String(), "--market", "420", "--assets", "1700apple"},
+			expInErr: []string{"required flag(s) \"bids\" not set"},
+		},
+		{
+			name: "seller does not have required attributes",
+			args: []string{"fill-bids-simulate", "--seller", s.addr1.String(), "--market", "420",
+				"--assets", "1700apple", "--bids", "17", "--creation-fee", "20peach"},
+			expOut: "error: account " + s.addr1.String() + " is not allowed to create\n" +
+				"  ask orders in market 420\n" +
+				"fee_inputs: []\nfills: []\nnavs: []\ntransfers: []\n",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runQueryCmdTestCase(tc)
+		})
+	}
+}
+
+func (s *CmdTestSuite) TestCmdQuerySimulateFillAsks() {
+	tests := []queryCmdTestCase{
+		{
+			name:     "cmd error",
+			args:     []string{"simulate-fill-asks", "--buyer", s.addr1.String(), "--market", "420", "--price", "1850peach"},
+			expInErr: []string{"required flag(s) \"asks\" not set"},
+		},
+		{
+			name: "buyer does not have required attributes",
+			args: []string{"fill-asks-simulate", "--buyer", s.addr1.String(), "--market", "420",
+				"--price", "1850peach", "--asks", "4,13", "--creation-fee", "25peach"},
+			expOut: "error: account " + s.addr1.String() + " is not allowed to create\n" +
+				"  bid orders in market 420\n" +
+				"fee_inputs: []\nfills: []\nnavs: []\ntransfers: []\n",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runQueryCmdTestCase(tc)
+		})
+	}
+}
+
+func (s *CmdTestSuite) TestCmdQueryGetLatestNAV() {
+	tests := []queryCmdTestCase{
+		{
+			name:     "no price denom",
+			args:     []string{"latest-nav", "--assets", "apple"},
+			expInErr: []string{`required flag(s) "price" not set`},
+		},
+		{
+			name:     "no navs for pair",
+			args:     []string{"latest-nav", "--assets", "peach", "--price", "apple"},
+			expInErr: []string{"no nav records found for \"peach\" priced in \"apple\""},
+		},
+		{
+			name:   "latest nav",
+			args:   []string{"get-latest-nav", "--assets", "apple", "--price", "peach", "--output", "json"},
+			expOut: `{"nav":{"assets":{"denom":"apple","amount":"50"},"price":{"denom":"peach","amount":"80"},"height":"2","market_id":420}}` + "\n",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runQueryCmdTestCase(tc)
+		})
+	}
+}
+
+func (s *CmdTestSuite) TestCmdQueryGetNAVHistory() {
+	tests := []queryCmdTestCase{
+		{
+			name:     "no asset denom",
+			args:     []string{"nav-history", "--price", "peach"},
+			expInErr: []string{`required flag(s) "assets" not set`},
+		},
+		{
+			name:   "no navs for pair",
+			args:   []string{"nav-history", "--assets", "peach", "--price", "apple", "--output", "json"},
+			expOut: `{"navs":[],"pagination":{"next_key":null,"total":"0"}}` + "\n",
+		},
+		{
+			name: "two navs",
+			args: []string{"get-nav-history", "--assets", "apple", "--price", "peach"},
+			expInOut: []string{
+				`height: "1"`, `height: "2"`, `amount: "25"`, `amount: "80"`, `market_id: 420`,
+			},
+		},
+		{
+			name:     "min height",
+			args:     []string{"navs", "--assets", "apple", "--price", "peach", "--min-height", "2", "--output", "json"},
+			expInOut: []string{`"navs":[{"assets":{"denom":"apple","amount":"50"}`, `"height":"2"`},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runQueryCmdTestCase(tc)
+		})
+	}
+}
+
 func (s *CmdTestSuite) TestCmdQueryGetCommitment() {
 	tests := []queryCmdTestCase{
 		{
@@ -505,7 +979,7 @@ func (s *CmdTestSuite) TestCmdQueryGetAllCommitments() {
 		return "[" + strings.Join(strs, ",") + "]"
 	}
 	comJSON := func(addr sdk.AccAddress, marketID uint32, coins ...sdk.Coin) string {
-		return fmt.Sprintf(`{"account":"%s","market_id":%d,"amount":%s}`,
+		return fmt.Sprintf(`{"account":"%s","market_id":%d,"amount":%s,"expiration":null}`,
 			addr.String(), marketID, coinsJSON(sdk.NewCoins(coins...)))
 	}
 
@@ -572,10 +1046,13 @@ func (s *CmdTestSuite) TestCmdQueryGetMarket() {
 			args: []string{"market", "420"},
 			expOut: `address: cosmos1dmk5hcws5xfue8rd6pl5lu6uh8jyt9fpqs0kf6
 market:
+  accepted_asset_denoms: []
+  accepted_price_denoms: []
   accepting_commitments: true
   accepting_orders: true
   access_grants:
   - address: ` + s.addr1.String() + `
+    expiration: null
     permissions:
     - PERMISSION_SETTLE
     - PERMISSION_SET_IDS
@@ -585,7 +1062,11 @@ market:
     - PERMISSION_PERMISSIONS
     - PERMISSION_ATTRIBUTES
   allow_user_settlement: true
+  batch_auction_interval: 0
   commitment_settlement_bips: 50
+  continuous_matching: false
+  exchange_splits: []
+  external_id_scope: EXTERNAL_ID_SCOPE_UNSPECIFIED
   fee_buyer_settlement_flat:
   - amount: "105"
     denom: peach
@@ -621,6 +1102,9 @@ market:
     price:
       amount: "75"
       denom: peach
+  fee_seller_settlement_rebate_ratios: []
+  fee_seller_settlement_taker_ratios: []
+  fill_algorithm: FILL_ALGORITHM_UNSPECIFIED
   intermediary_denom: cherry
   market_details:
     description: It's coming; you know it. It has all the fees.
@@ -628,12 +1112,19 @@ market:
     name: THE Market
     website_url: ""
   market_id: 420
+  max_exposure: null
+  order_rate_limit: null
+  publish_prices: false
+  rebate_addresses: []
+  referral_bips: 0
+  referral_cap: []
   req_attr_create_ask:
   - seller.kyc
   req_attr_create_bid:
   - buyer.kyc
   req_attr_create_commitment:
   - committer.kyc
+  self_trade_prevention: SELF_TRADE_PREVENTION_UNSPECIFIED
 `,
 		},
 	}
@@ -645,6 +1136,51 @@ market:
 	}
 }
 
+func (s *CmdTestSuite) TestCmdQueryExportMarket() {
+	outputDoc := filepath.Join(s.T().TempDir(), "market-420.json")
+
+	tests := []queryCmdTestCase{
+		{
+			name:     "no market id",
+			args:     []string{"export-market"},
+			expInErr: []string{"no <market id> provided"},
+		},
+		{
+			name:     "market does not exist",
+			args:     []string{"market-export", "419"},
+			expInErr: []string{"market 419 not found", "invalid request", "InvalidArgument"},
+		},
+		{
+			name: "market exists",
+			args: []string{"export-market", "420"},
+			expInOut: []string{
+				"address: cosmos1dmk5hcws5xfue8rd6pl5lu6uh8jyt9fpqs0kf6",
+				"market_id: 420",
+				"name: THE Market",
+				"height: ",
+			},
+		},
+		{
+			name: "to output document",
+			args: []string{"market-snapshot", "--market", "420", "--output-document", outputDoc},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runQueryCmdTestCase(tc)
+		})
+	}
+
+	s.Run("output document contents", func() {
+		bz, err := os.ReadFile(outputDoc)
+		s.Require().NoError(err, "ReadFile(%q)", outputDoc)
+		contents := string(bz)
+		s.Assert().Contains(contents, `"address":"cosmos1dmk5hcws5xfue8rd6pl5lu6uh8jyt9fpqs0kf6"`, "output document contents")
+		s.Assert().Contains(contents, `"market_id":420`, "output document contents")
+	})
+}
+
 func (s *CmdTestSuite) TestCmdQueryGetAllMarkets() {
 	tests := []queryCmdTestCase{
 		{
@@ -692,6 +1228,9 @@ func (s *CmdTestSuite) TestCmdQueryParams() {
   fee_create_payment_flat:
   - amount: "10000000000"
     denom: nhash
+  nav_history_blocks: "500000"
+  nav_history_max_records: 1000
+  settlement_history_blocks: "500000"
 `,
 		},
 		{
@@ -701,6 +1240,8 @@ func (s *CmdTestSuite) TestCmdQueryParams() {
 				`{"params":{`, `"default_split":500`, `"denom_splits":[]`,
 				`"fee_create_payment_flat":[{"denom":"nhash","amount":"10000000000"}]`,
 				`"fee_accept_payment_flat":[{"denom":"nhash","amount":"8000000000"}]`,
+				`"settlement_history_blocks":"500000"`,
+				`"nav_history_blocks":"500000"`, `"nav_history_max_records":1000`,
 			},
 		},
 	}
@@ -925,6 +1466,8 @@ func (s *CmdTestSuite) TestCmdQueryGetPayment() {
 			name: "payment exists: yaml",
 			args: []string{"payment", expPmt.Source, expPmt.ExternalId, "--output", "text"},
 			expOut: `payment:
+  dispute_window_seconds: "0"
+  expiration: null
   external_id: initial-payment-05-03
   source: ` + expPmt.Source + `
   source_amount:
@@ -1116,3 +1659,39 @@ fee_create:
 		})
 	}
 }
+
+func (s *CmdTestSuite) TestCmdQueryGetAccountActivitySummary() {
+	tests := []queryCmdTestCase{
+		{
+			name:     "no account",
+			args:     []string{"account-summary"},
+			expInErr: []string{"no <account> provided"},
+		},
+		{
+			name: "unknown account",
+			args: []string{"account-activity-summary", sdk.AccAddress("unknown_account_____").String(), "--output", "json"},
+			expOut: `{"orders":[],"commitments":[],` +
+				`"outgoing_payments":{"payment_count":0,"source_amount":[],"target_amount":[]},` +
+				`"incoming_payments":{"payment_count":0,"source_amount":[],"target_amount":[]},` +
+				`"on_hold":[]}` + "\n",
+		},
+		{
+			name: "account with activity",
+			args: []string{"get-account-activity-summary", "--account", s.addr1.String(), "--output", "json"},
+			expInOut: []string{
+				`{"orders":[{"market_id":420,"ask_order_count":`,
+				`"commitments":[{"market_id":420,"amount":[{"denom":"acorn","amount":"10100"}]},` +
+					`{"market_id":421,"amount":[{"denom":"apple","amount":"4210"},{"denom":"peach","amount":"421"}]}]`,
+				`"outgoing_payments":{"payment_count":`,
+				`"incoming_payments":{"payment_count":`,
+				`"on_hold":[{"denom":"acorn","amount":"`,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runQueryCmdTestCase(tc)
+		})
+	}
+}
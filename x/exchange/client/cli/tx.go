@@ -26,32 +26,64 @@ func CmdTx() *cobra.Command {
 	cmd.AddCommand(
 		CmdTxCreateAsk(),
 		CmdTxCreateBid(),
+		CmdTxCreateTriggerAsk(),
+		CmdTxCreateTriggerBid(),
+		CmdTxCreateOrdersBatch(),
 		CmdTxCommitFunds(),
+		CmdTxTransferCommitment(),
+		CmdTxFundCommitmentRewards(),
+		CmdTxClaimCommitmentRewards(),
 		CmdTxCancelOrder(),
+		CmdTxAmendOrder(),
+		CmdTxLinkOrders(),
+		CmdTxSetDeadManSwitch(),
+		CmdTxHeartbeat(),
 		CmdTxFillBids(),
 		CmdTxFillAsks(),
 		CmdTxMarketSettle(),
 		CmdTxMarketCommitmentSettle(),
 		CmdTxMarketReleaseCommitments(),
 		CmdTxMarketTransferCommitment(),
+		CmdTxMarketDistributeCommitmentRewards(),
+		CmdTxMarketBulkCancel(),
 		CmdTxMarketSetOrderExternalID(),
 		CmdTxMarketWithdraw(),
 		CmdTxMarketUpdateDetails(),
 		CmdTxMarketUpdateAcceptingOrders(),
 		CmdTxMarketUpdateUserSettle(),
 		CmdTxMarketUpdateAcceptingCommitments(),
+		CmdTxMarketUpdateContinuousMatching(),
+		CmdTxMarketUpdateBatchAuction(),
+		CmdTxMarketUpdateSelfTradePrevention(),
+		CmdTxMarketUpdatePublishPrices(),
+		CmdTxMarketUpdateExternalIDScope(),
+		CmdTxMarketUpdateOrderRateLimit(),
+		CmdTxMarketUpdateMaxExposure(),
+		CmdTxMarketUpdateFillAlgorithm(),
 		CmdTxMarketUpdateIntermediaryDenom(),
 		CmdTxMarketManagePermissions(),
+		CmdTxMarketTransferAuthority(),
+		CmdTxMarketAcceptAuthority(),
 		CmdTxMarketManageReqAttrs(),
+		CmdTxMarketManageAcceptedDenoms(),
 		CmdTxCreatePayment(),
 		CmdTxAcceptPayment(),
+		CmdTxAcceptPayments(),
 		CmdTxRejectPayment(),
 		CmdTxRejectPayments(),
+		CmdTxDisputePayment(),
 		CmdTxCancelPayments(),
 		CmdTxChangePaymentTarget(),
+		CmdTxCreateRecurringPayment(),
+		CmdTxCancelRecurringPayments(),
+		CmdTxCreateMultiPartyPayment(),
+		CmdTxAcceptMultiPartyPayment(),
+		CmdTxCancelMultiPartyPayment(),
 		CmdTxGovCreateMarket(),
 		CmdTxGovManageFees(),
+		CmdTxGovManageExchangeSplits(),
 		CmdTxGovCloseMarket(),
+		CmdTxGovWindDownMarket(),
 		CmdTxUpdateParams(),
 	)
 
@@ -86,6 +118,48 @@ func CmdTxCreateBid() *cobra.Command {
 	return cmd
 }
 
+// CmdTxCreateTriggerAsk creates the create-trigger-ask sub-command for the exchange tx command.
+func CmdTxCreateTriggerAsk() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "create-trigger-ask",
+		Aliases: []string{"trigger-ask", "stop-ask", "create-stop-ask"},
+		Short:   "Create an ask order that is activated once a settlement price is at or below a trigger price",
+		RunE:    genericTxRunE(MakeMsgCreateTriggerAsk),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxCreateTriggerAsk(cmd)
+	return cmd
+}
+
+// CmdTxCreateTriggerBid creates the create-trigger-bid sub-command for the exchange tx command.
+func CmdTxCreateTriggerBid() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "create-trigger-bid",
+		Aliases: []string{"trigger-bid", "stop-bid", "create-stop-bid"},
+		Short:   "Create a bid order that is activated once a settlement price is at or above a trigger price",
+		RunE:    genericTxRunE(MakeMsgCreateTriggerBid),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxCreateTriggerBid(cmd)
+	return cmd
+}
+
+// CmdTxCreateOrdersBatch creates the create-orders-batch sub-command for the exchange tx command.
+func CmdTxCreateOrdersBatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "create-orders-batch",
+		Aliases: []string{"orders-batch", "batch-orders", "create-orders"},
+		Short:   "Create several ask and bid orders in a market at once",
+		RunE:    genericTxRunE(MakeMsgCreateOrdersBatch),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxCreateOrdersBatch(cmd)
+	return cmd
+}
+
 // CmdTxCommitFunds creates the commit-funds sub-command for the exchange tx command.
 func CmdTxCommitFunds() *cobra.Command {
 	cmd := &cobra.Command{
@@ -100,6 +174,48 @@ func CmdTxCommitFunds() *cobra.Command {
 	return cmd
 }
 
+// CmdTxTransferCommitment creates the move-commitment sub-command for the exchange tx command.
+func CmdTxTransferCommitment() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "move-commitment",
+		Aliases: []string{"move-commitments"},
+		Short:   "Move your committed funds from one market to another market",
+		RunE:    genericTxRunE(MakeMsgTransferCommitment),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxTransferCommitment(cmd)
+	return cmd
+}
+
+// CmdTxFundCommitmentRewards creates the fund-commitment-rewards sub-command for the exchange tx command.
+func CmdTxFundCommitmentRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fund-commitment-rewards",
+		Aliases: []string{"fund-rewards"},
+		Short:   "Add funds to a market's commitment reward pool",
+		RunE:    genericTxRunE(MakeMsgFundCommitmentRewards),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxFundCommitmentRewards(cmd)
+	return cmd
+}
+
+// CmdTxClaimCommitmentRewards creates the claim-commitment-rewards sub-command for the exchange tx command.
+func CmdTxClaimCommitmentRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "claim-commitment-rewards",
+		Aliases: []string{"claim-rewards"},
+		Short:   "Claim the commitment rewards you have accrued in a market",
+		RunE:    genericTxRunE(MakeMsgClaimCommitmentRewards),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxClaimCommitmentRewards(cmd)
+	return cmd
+}
+
 // CmdTxCancelOrder creates the cancel-order sub-command for the exchange tx command.
 func CmdTxCancelOrder() *cobra.Command {
 	cmd := &cobra.Command{
@@ -114,6 +230,61 @@ func CmdTxCancelOrder() *cobra.Command {
 	return cmd
 }
 
+// CmdTxAmendOrder creates the amend-order sub-command for the exchange tx command.
+func CmdTxAmendOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "amend-order",
+		Aliases: []string{"amend"},
+		Short:   "Change the assets, price, or other terms of an order",
+		RunE:    genericTxRunE(MakeMsgAmendOrder),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxAmendOrder(cmd)
+	return cmd
+}
+
+// CmdTxLinkOrders creates the link-orders sub-command for the exchange tx command.
+func CmdTxLinkOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "link-orders",
+		Aliases: []string{"link"},
+		Short:   "Link two orders so that filling or cancelling one cancels the other",
+		RunE:    genericTxRunE(MakeMsgLinkOrders),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxLinkOrders(cmd)
+	return cmd
+}
+
+// CmdTxSetDeadManSwitch creates the set-dead-man-switch sub-command for the exchange tx command.
+func CmdTxSetDeadManSwitch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-dead-man-switch",
+		Aliases: []string{"dead-man-switch", "dms"},
+		Short:   "Set or remove a dead-man switch that cancels all of an account's orders if heartbeats stop",
+		RunE:    genericTxRunE(MakeMsgSetDeadManSwitch),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxSetDeadManSwitch(cmd)
+	return cmd
+}
+
+// CmdTxHeartbeat creates the heartbeat sub-command for the exchange tx command.
+func CmdTxHeartbeat() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "heartbeat",
+		Short: "Reset the deadline of an account's dead-man switch",
+		RunE:  genericTxRunE(MakeMsgHeartbeat),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxHeartbeat(cmd)
+	return cmd
+}
+
 // CmdTxFillBids creates the fill-bids sub-command for the exchange tx command.
 func CmdTxFillBids() *cobra.Command {
 	cmd := &cobra.Command{
@@ -196,6 +367,34 @@ func CmdTxMarketTransferCommitment() *cobra.Command {
 	return cmd
 }
 
+// CmdTxMarketDistributeCommitmentRewards creates the market-distribute-commitment-rewards sub-command for the exchange tx command.
+func CmdTxMarketDistributeCommitmentRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "market-distribute-commitment-rewards",
+		Aliases: []string{"distribute-commitment-rewards", "distribute-rewards"},
+		Short:   "Distribute funds from a market's commitment reward pool to the accounts with commitments in it",
+		RunE:    genericTxRunE(MakeMsgMarketDistributeCommitmentRewards),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxMarketDistributeCommitmentRewards(cmd)
+	return cmd
+}
+
+// CmdTxMarketBulkCancel creates the market-bulk-cancel sub-command for the exchange tx command.
+func CmdTxMarketBulkCancel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "market-bulk-cancel",
+		Aliases: []string{"bulk-cancel"},
+		Short:   "Cancel many (or all) of the orders in a market",
+		RunE:    genericTxRunE(MakeMsgMarketBulkCancel),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxMarketBulkCancel(cmd)
+	return cmd
+}
+
 // CmdTxMarketSetOrderExternalID creates the market-set-external-id sub-command for the exchange tx command.
 func CmdTxMarketSetOrderExternalID() *cobra.Command {
 	cmd := &cobra.Command{
@@ -280,6 +479,118 @@ func CmdTxMarketUpdateAcceptingCommitments() *cobra.Command {
 	return cmd
 }
 
+// CmdTxMarketUpdateContinuousMatching creates the market-continuous-matching sub-command for the exchange tx command.
+func CmdTxMarketUpdateContinuousMatching() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "market-continuous-matching",
+		Aliases: []string{"market-update-continuous-matching", "update-market-continuous-matching", "update-continuous-matching"},
+		Short:   "Change whether a market automatically matches new orders",
+		RunE:    genericTxRunE(MakeMsgMarketUpdateContinuousMatching),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxMarketUpdateContinuousMatching(cmd)
+	return cmd
+}
+
+// CmdTxMarketUpdateBatchAuction creates the market-batch-auction sub-command for the exchange tx command.
+func CmdTxMarketUpdateBatchAuction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "market-batch-auction",
+		Aliases: []string{"market-update-batch-auction", "update-market-batch-auction", "update-batch-auction"},
+		Short:   "Change how often a market runs batch auctions",
+		RunE:    genericTxRunE(MakeMsgMarketUpdateBatchAuction),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxMarketUpdateBatchAuction(cmd)
+	return cmd
+}
+
+// CmdTxMarketUpdateSelfTradePrevention creates the market-self-trade-prevention sub-command for the exchange tx command.
+func CmdTxMarketUpdateSelfTradePrevention() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "market-self-trade-prevention",
+		Aliases: []string{"market-update-self-trade-prevention", "update-market-self-trade-prevention", "update-self-trade-prevention", "market-stp"},
+		Short:   "Change how a market handles orders from the same owner that would match each other",
+		RunE:    genericTxRunE(MakeMsgMarketUpdateSelfTradePrevention),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxMarketUpdateSelfTradePrevention(cmd)
+	return cmd
+}
+
+// CmdTxMarketUpdatePublishPrices creates the market-publish-prices sub-command for the exchange tx command.
+func CmdTxMarketUpdatePublishPrices() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "market-publish-prices",
+		Aliases: []string{"market-update-publish-prices", "update-market-publish-prices", "update-publish-prices"},
+		Short:   "Change whether a market publishes its settlement prices to the oracle module's price feed",
+		RunE:    genericTxRunE(MakeMsgMarketUpdatePublishPrices),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxMarketUpdatePublishPrices(cmd)
+	return cmd
+}
+
+// CmdTxMarketUpdateExternalIDScope creates the market-external-id-scope sub-command for the exchange tx command.
+func CmdTxMarketUpdateExternalIDScope() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "market-external-id-scope",
+		Aliases: []string{"market-update-external-id-scope", "update-market-external-id-scope", "update-external-id-scope"},
+		Short:   "Change how unique the external ids of a market's orders must be",
+		RunE:    genericTxRunE(MakeMsgMarketUpdateExternalIDScope),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxMarketUpdateExternalIDScope(cmd)
+	return cmd
+}
+
+// CmdTxMarketUpdateOrderRateLimit creates the market-order-rate-limit sub-command for the exchange tx command.
+func CmdTxMarketUpdateOrderRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "market-order-rate-limit",
+		Aliases: []string{"market-update-order-rate-limit", "update-market-order-rate-limit", "update-order-rate-limit"},
+		Short:   "Change how many orders an account can create in a market",
+		RunE:    genericTxRunE(MakeMsgMarketUpdateOrderRateLimit),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxMarketUpdateOrderRateLimit(cmd)
+	return cmd
+}
+
+// CmdTxMarketUpdateMaxExposure creates the market-max-exposure sub-command for the exchange tx command.
+func CmdTxMarketUpdateMaxExposure() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "market-max-exposure",
+		Aliases: []string{"market-update-max-exposure", "update-market-max-exposure", "update-max-exposure"},
+		Short:   "Change the most an account can have in a market's orders and commitments",
+		RunE:    genericTxRunE(MakeMsgMarketUpdateMaxExposure),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxMarketUpdateMaxExposure(cmd)
+	return cmd
+}
+
+// CmdTxMarketUpdateFillAlgorithm creates the market-fill-algorithm sub-command for the exchange tx command.
+func CmdTxMarketUpdateFillAlgorithm() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "market-fill-algorithm",
+		Aliases: []string{"market-update-fill-algorithm", "update-market-fill-algorithm", "update-fill-algorithm"},
+		Short:   "Change how a market's resting orders are chosen to fill a new order",
+		RunE:    genericTxRunE(MakeMsgMarketUpdateFillAlgorithm),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxMarketUpdateFillAlgorithm(cmd)
+	return cmd
+}
+
 // CmdTxMarketUpdateIntermediaryDenom creates the market-intermediary-denom sub-command for the exchange tx command.
 func CmdTxMarketUpdateIntermediaryDenom() *cobra.Command {
 	cmd := &cobra.Command{
@@ -308,6 +619,34 @@ func CmdTxMarketManagePermissions() *cobra.Command {
 	return cmd
 }
 
+// CmdTxMarketTransferAuthority creates the market-transfer-authority sub-command for the exchange tx command.
+func CmdTxMarketTransferAuthority() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "market-transfer-authority",
+		Aliases: []string{"transfer-market-authority", "transfer-authority"},
+		Short:   "Propose a transfer of a market's authority to a new account",
+		RunE:    genericTxRunE(MakeMsgMarketTransferAuthority),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxMarketTransferAuthority(cmd)
+	return cmd
+}
+
+// CmdTxMarketAcceptAuthority creates the market-accept-authority sub-command for the exchange tx command.
+func CmdTxMarketAcceptAuthority() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "market-accept-authority",
+		Aliases: []string{"accept-market-authority", "accept-authority"},
+		Short:   "Accept a pending transfer of a market's authority",
+		RunE:    genericTxRunE(MakeMsgMarketAcceptAuthority),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxMarketAcceptAuthority(cmd)
+	return cmd
+}
+
 // CmdTxMarketManageReqAttrs creates the market-req-attrs sub-command for the exchange tx command.
 func CmdTxMarketManageReqAttrs() *cobra.Command {
 	cmd := &cobra.Command{
@@ -329,6 +668,20 @@ func CmdTxMarketManageReqAttrs() *cobra.Command {
 	return cmd
 }
 
+// CmdTxMarketManageAcceptedDenoms creates the market-accepted-denoms sub-command for the exchange tx command.
+func CmdTxMarketManageAcceptedDenoms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "market-accepted-denoms",
+		Aliases: []string{"market-manage-accepted-denoms", "manage-market-accepted-denoms", "manage-accepted-denoms"},
+		Short:   "Manage the asset and price denoms that orders in a market can use",
+		RunE:    genericTxRunE(MakeMsgMarketManageAcceptedDenoms),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxMarketManageAcceptedDenoms(cmd)
+	return cmd
+}
+
 // CmdTxCreatePayment creates the create-payment sub-command for the exchange tx command.
 func CmdTxCreatePayment() *cobra.Command {
 	cmd := &cobra.Command{
@@ -355,6 +708,19 @@ func CmdTxAcceptPayment() *cobra.Command {
 	return cmd
 }
 
+// CmdTxAcceptPayments creates the accept-payments sub-command for the exchange tx command.
+func CmdTxAcceptPayments() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-payments",
+		Short: "Accept multiple payments",
+		RunE:  genericTxRunE(MakeMsgAcceptPayments),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxAcceptPayments(cmd)
+	return cmd
+}
+
 // CmdTxRejectPayment creates the reject-payment sub-command for the exchange tx command.
 func CmdTxRejectPayment() *cobra.Command {
 	cmd := &cobra.Command{
@@ -381,6 +747,19 @@ func CmdTxRejectPayments() *cobra.Command {
 	return cmd
 }
 
+// CmdTxDisputePayment creates the dispute-payment sub-command for the exchange tx command.
+func CmdTxDisputePayment() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dispute-payment",
+		Short: "Dispute an accepted payment during its dispute window",
+		RunE:  genericTxRunE(MakeMsgDisputePayment),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxDisputePayment(cmd)
+	return cmd
+}
+
 // CmdTxCancelPayments creates the cancel-payments sub-command for the exchange tx command.
 func CmdTxCancelPayments() *cobra.Command {
 	cmd := &cobra.Command{
@@ -407,6 +786,71 @@ func CmdTxChangePaymentTarget() *cobra.Command {
 	return cmd
 }
 
+// CmdTxCreateRecurringPayment creates the create-recurring-payment sub-command for the exchange tx command.
+func CmdTxCreateRecurringPayment() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-recurring-payment",
+		Short: "Create a recurring payment",
+		RunE:  genericTxRunE(MakeMsgCreateRecurringPayment),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxCreateRecurringPayment(cmd)
+	return cmd
+}
+
+// CmdTxCancelRecurringPayments creates the cancel-recurring-payments sub-command for the exchange tx command.
+func CmdTxCancelRecurringPayments() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-recurring-payments",
+		Short: "Cancel multiple recurring payments",
+		RunE:  genericTxRunE(MakeMsgCancelRecurringPayments),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxCancelRecurringPayments(cmd)
+	return cmd
+}
+
+// CmdTxCreateMultiPartyPayment creates the create-multi-party-payment sub-command for the exchange tx command.
+func CmdTxCreateMultiPartyPayment() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-multi-party-payment",
+		Short: "Create a payment between several parties",
+		RunE:  genericTxRunE(MakeMsgCreateMultiPartyPayment),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxCreateMultiPartyPayment(cmd)
+	return cmd
+}
+
+// CmdTxAcceptMultiPartyPayment creates the accept-multi-party-payment sub-command for the exchange tx command.
+func CmdTxAcceptMultiPartyPayment() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-multi-party-payment",
+		Short: "Accept a multi-party payment",
+		RunE:  genericTxRunE(MakeMsgAcceptMultiPartyPayment),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxAcceptMultiPartyPayment(cmd)
+	return cmd
+}
+
+// CmdTxCancelMultiPartyPayment creates the cancel-multi-party-payment sub-command for the exchange tx command.
+func CmdTxCancelMultiPartyPayment() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-multi-party-payment",
+		Short: "Cancel a multi-party payment",
+		RunE:  genericTxRunE(MakeMsgCancelMultiPartyPayment),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxCancelMultiPartyPayment(cmd)
+	return cmd
+}
+
 // CmdTxGovCreateMarket creates the gov-create-market sub-command for the exchange tx command.
 func CmdTxGovCreateMarket() *cobra.Command {
 	cmd := &cobra.Command{
@@ -437,6 +881,21 @@ func CmdTxGovManageFees() *cobra.Command {
 	return cmd
 }
 
+// CmdTxGovManageExchangeSplits creates the gov-manage-exchange-splits sub-command for the exchange tx command.
+func CmdTxGovManageExchangeSplits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "gov-manage-exchange-splits",
+		Aliases: []string{"manage-exchange-splits", "gov-update-exchange-splits", "update-exchange-splits"},
+		Short:   "Submit a governance proposal to change a market's exchange split overrides",
+		RunE:    govTxRunE(MakeMsgGovManageExchangeSplits),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	SetupCmdTxGovManageExchangeSplits(cmd)
+	return cmd
+}
+
 // CmdTxGovCloseMarket creates the gov-close-market sub-command for the exchange tx command.
 func CmdTxGovCloseMarket() *cobra.Command {
 	cmd := &cobra.Command{
@@ -452,6 +911,21 @@ func CmdTxGovCloseMarket() *cobra.Command {
 	return cmd
 }
 
+// CmdTxGovWindDownMarket creates the gov-wind-down-market sub-command for the exchange tx command.
+func CmdTxGovWindDownMarket() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "gov-wind-down-market",
+		Aliases: []string{"wind-down-market"},
+		Short:   "Submit a governance proposal to wind down a market",
+		RunE:    govTxRunE(MakeMsgGovWindDownMarket),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	SetupCmdTxGovWindDownMarket(cmd)
+	return cmd
+}
+
 // CmdTxUpdateParams creates the gov-update-params sub-command for the exchange tx command.
 func CmdTxUpdateParams() *cobra.Command {
 	cmd := &cobra.Command{
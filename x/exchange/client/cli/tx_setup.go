@@ -3,12 +3,14 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/x/exchange"
 )
@@ -17,12 +19,17 @@ import (
 func SetupCmdTxCreateAsk(cmd *cobra.Command) {
 	cmd.Flags().String(FlagSeller, "", "The seller (defaults to --from account)")
 	cmd.Flags().Uint32(FlagMarket, 0, "The market id (required)")
-	cmd.Flags().String(FlagAssets, "", "The assets for this order, e.g. 10nhash (required)")
+	cmd.Flags().String(FlagAssets, "", "The assets for this order, e.g. 10nhash or a scope id (required)")
 	cmd.Flags().String(FlagPrice, "", "The price for this order, e.g. 10nhash (required)")
 	cmd.Flags().String(FlagSettlementFee, "", "The settlement fee Coin string for this order, e.g. 10nhash")
 	cmd.Flags().Bool(FlagPartial, false, "Allow this order to be partially filled")
 	cmd.Flags().String(FlagExternalID, "", "The external id for this order")
+	cmd.Flags().String(FlagExpiration, "", "The RFC3339 time after which this order is cancelled, e.g. 2030-01-02T15:04:05Z")
+	cmd.Flags().String(FlagDisplay, "", "The amount of the assets to show in order queries (making this an iceberg order), e.g. 10nhash")
+	cmd.Flags().String(FlagReferrer, "", "The account that referred this order to the market")
 	cmd.Flags().String(FlagCreationFee, "", "The ask order creation fee, e.g. 10nhash")
+	cmd.Flags().String(FlagBasket, "", "Other assets sold in full with the assets (making this a basket order), e.g. 5apple,3banana")
+	cmd.Flags().String(FlagIdempotencyKey, "", "A key that, if already used by the seller, returns the existing order instead of creating a new one")
 
 	cmd.MarkFlagsOneRequired(flags.FlagFrom, FlagSeller)
 	MarkFlagsRequired(cmd, FlagMarket, FlagAssets, FlagPrice)
@@ -36,7 +43,12 @@ func SetupCmdTxCreateAsk(cmd *cobra.Command) {
 		OptFlagUse(FlagSettlementFee, "seller settlement flat fee"),
 		OptFlagUse(FlagPartial, ""),
 		OptFlagUse(FlagExternalID, "external id"),
+		OptFlagUse(FlagExpiration, "expiration"),
+		OptFlagUse(FlagDisplay, "display assets"),
+		OptFlagUse(FlagReferrer, "referrer"),
 		OptFlagUse(FlagCreationFee, "creation fee"),
+		OptFlagUse(FlagBasket, "basket assets"),
+		OptFlagUse(FlagIdempotencyKey, "idempotency key"),
 	)
 	AddUseDetails(cmd, ReqSignerDesc(FlagSeller))
 
@@ -48,15 +60,20 @@ func SetupCmdTxCreateAsk(cmd *cobra.Command) {
 func MakeMsgCreateAsk(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgCreateAskRequest, error) {
 	msg := &exchange.MsgCreateAskRequest{}
 
-	errs := make([]error, 8)
+	errs := make([]error, 13)
 	msg.AskOrder.Seller, errs[0] = ReadAddrFlagOrFrom(clientCtx, flagSet, FlagSeller)
 	msg.AskOrder.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
-	msg.AskOrder.Assets, errs[2] = ReadReqCoinFlag(flagSet, FlagAssets)
+	msg.AskOrder.Assets, errs[2] = ReadReqAssetsFlag(flagSet, FlagAssets)
 	msg.AskOrder.Price, errs[3] = ReadReqCoinFlag(flagSet, FlagPrice)
 	msg.AskOrder.SellerSettlementFlatFee, errs[4] = ReadCoinFlag(flagSet, FlagSettlementFee)
 	msg.AskOrder.AllowPartial, errs[5] = flagSet.GetBool(FlagPartial)
 	msg.AskOrder.ExternalId, errs[6] = flagSet.GetString(FlagExternalID)
-	msg.OrderCreationFee, errs[7] = ReadCoinFlag(flagSet, FlagCreationFee)
+	msg.AskOrder.Expiration, errs[7] = ReadTimeFlag(flagSet, FlagExpiration)
+	msg.AskOrder.DisplayAssets, errs[8] = ReadCoinFlag(flagSet, FlagDisplay)
+	msg.AskOrder.Referrer, errs[9] = flagSet.GetString(FlagReferrer)
+	msg.OrderCreationFee, errs[10] = ReadCoinFlag(flagSet, FlagCreationFee)
+	msg.AskOrder.BasketAssets, errs[11] = ReadCoinsFlag(flagSet, FlagBasket)
+	msg.IdempotencyKey, errs[12] = flagSet.GetString(FlagIdempotencyKey)
 
 	return msg, errors.Join(errs...)
 }
@@ -65,12 +82,17 @@ func MakeMsgCreateAsk(clientCtx client.Context, flagSet *pflag.FlagSet, _ []stri
 func SetupCmdTxCreateBid(cmd *cobra.Command) {
 	cmd.Flags().String(FlagBuyer, "", "The buyer (defaults to --from account)")
 	cmd.Flags().Uint32(FlagMarket, 0, "The market id (required)")
-	cmd.Flags().String(FlagAssets, "", "The assets for this order, e.g. 10nhash (required)")
+	cmd.Flags().String(FlagAssets, "", "The assets for this order, e.g. 10nhash or a scope id (required)")
 	cmd.Flags().String(FlagPrice, "", "The price for this order, e.g. 10nhash (required)")
 	cmd.Flags().String(FlagSettlementFee, "", "The settlement fee Coin string for this order, e.g. 10nhash")
 	cmd.Flags().Bool(FlagPartial, false, "Allow this order to be partially filled")
 	cmd.Flags().String(FlagExternalID, "", "The external id for this order")
+	cmd.Flags().String(FlagExpiration, "", "The RFC3339 time after which this order is cancelled, e.g. 2030-01-02T15:04:05Z")
+	cmd.Flags().String(FlagDisplay, "", "The amount of the assets to show in order queries (making this an iceberg order), e.g. 10nhash")
+	cmd.Flags().String(FlagReferrer, "", "The account that referred this order to the market")
 	cmd.Flags().String(FlagCreationFee, "", "The bid order creation fee, e.g. 10nhash")
+	cmd.Flags().String(FlagBasket, "", "Other assets bought in full with the assets (making this a basket order), e.g. 5apple,3banana")
+	cmd.Flags().String(FlagIdempotencyKey, "", "A key that, if already used by the buyer, returns the existing order instead of creating a new one")
 
 	cmd.MarkFlagsOneRequired(flags.FlagFrom, FlagBuyer)
 	MarkFlagsRequired(cmd, FlagMarket, FlagAssets, FlagPrice)
@@ -84,7 +106,12 @@ func SetupCmdTxCreateBid(cmd *cobra.Command) {
 		OptFlagUse(FlagSettlementFee, "seller settlement flat fee"),
 		OptFlagUse(FlagPartial, ""),
 		OptFlagUse(FlagExternalID, "external id"),
+		OptFlagUse(FlagExpiration, "expiration"),
+		OptFlagUse(FlagDisplay, "display assets"),
+		OptFlagUse(FlagReferrer, "referrer"),
 		OptFlagUse(FlagCreationFee, "creation fee"),
+		OptFlagUse(FlagBasket, "basket assets"),
+		OptFlagUse(FlagIdempotencyKey, "idempotency key"),
 	)
 	AddUseDetails(cmd, ReqSignerDesc(FlagBuyer))
 
@@ -96,15 +123,226 @@ func SetupCmdTxCreateBid(cmd *cobra.Command) {
 func MakeMsgCreateBid(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgCreateBidRequest, error) {
 	msg := &exchange.MsgCreateBidRequest{}
 
-	errs := make([]error, 8)
+	errs := make([]error, 13)
 	msg.BidOrder.Buyer, errs[0] = ReadAddrFlagOrFrom(clientCtx, flagSet, FlagBuyer)
 	msg.BidOrder.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
-	msg.BidOrder.Assets, errs[2] = ReadReqCoinFlag(flagSet, FlagAssets)
+	msg.BidOrder.Assets, errs[2] = ReadReqAssetsFlag(flagSet, FlagAssets)
 	msg.BidOrder.Price, errs[3] = ReadReqCoinFlag(flagSet, FlagPrice)
 	msg.BidOrder.BuyerSettlementFees, errs[4] = ReadCoinsFlag(flagSet, FlagSettlementFee)
 	msg.BidOrder.AllowPartial, errs[5] = flagSet.GetBool(FlagPartial)
 	msg.BidOrder.ExternalId, errs[6] = flagSet.GetString(FlagExternalID)
-	msg.OrderCreationFee, errs[7] = ReadCoinFlag(flagSet, FlagCreationFee)
+	msg.BidOrder.Expiration, errs[7] = ReadTimeFlag(flagSet, FlagExpiration)
+	msg.BidOrder.DisplayAssets, errs[8] = ReadCoinFlag(flagSet, FlagDisplay)
+	msg.BidOrder.Referrer, errs[9] = flagSet.GetString(FlagReferrer)
+	msg.OrderCreationFee, errs[10] = ReadCoinFlag(flagSet, FlagCreationFee)
+	msg.BidOrder.BasketAssets, errs[11] = ReadCoinsFlag(flagSet, FlagBasket)
+	msg.IdempotencyKey, errs[12] = flagSet.GetString(FlagIdempotencyKey)
+
+	return msg, errors.Join(errs...)
+}
+
+// SetupCmdTxCreateTriggerAsk adds all the flags needed for MakeMsgCreateTriggerAsk.
+func SetupCmdTxCreateTriggerAsk(cmd *cobra.Command) {
+	cmd.Flags().String(FlagSeller, "", "The seller (defaults to --from account)")
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id (required)")
+	cmd.Flags().String(FlagAssets, "", "The assets for this order, e.g. 10nhash or a scope id (required)")
+	cmd.Flags().String(FlagPrice, "", "The price for this order, e.g. 10nhash (required)")
+	cmd.Flags().String(FlagTriggerPrice, "", "The settlement price at or below which this order is activated, e.g. 10nhash (required)")
+	cmd.Flags().String(FlagSettlementFee, "", "The settlement fee Coin string for this order, e.g. 10nhash")
+	cmd.Flags().Bool(FlagPartial, false, "Allow this order to be partially filled")
+	cmd.Flags().String(FlagExternalID, "", "The external id for this order")
+	cmd.Flags().String(FlagExpiration, "", "The RFC3339 time after which this order is cancelled, e.g. 2030-01-02T15:04:05Z")
+	cmd.Flags().String(FlagDisplay, "", "The amount of the assets to show in order queries (making this an iceberg order), e.g. 10nhash")
+	cmd.Flags().String(FlagReferrer, "", "The account that referred this order to the market")
+	cmd.Flags().String(FlagCreationFee, "", "The ask order creation fee, e.g. 10nhash")
+
+	cmd.MarkFlagsOneRequired(flags.FlagFrom, FlagSeller)
+	MarkFlagsRequired(cmd, FlagMarket, FlagAssets, FlagPrice, FlagTriggerPrice)
+
+	AddUseArgs(cmd,
+		ReqSignerUse(FlagSeller),
+		ReqFlagUse(FlagMarket, "market id"),
+		ReqFlagUse(FlagAssets, "assets"),
+		ReqFlagUse(FlagPrice, "price"),
+		ReqFlagUse(FlagTriggerPrice, "trigger price"),
+		UseFlagsBreak,
+		OptFlagUse(FlagSettlementFee, "seller settlement flat fee"),
+		OptFlagUse(FlagPartial, ""),
+		OptFlagUse(FlagExternalID, "external id"),
+		OptFlagUse(FlagExpiration, "expiration"),
+		OptFlagUse(FlagDisplay, "display assets"),
+		OptFlagUse(FlagReferrer, "referrer"),
+		OptFlagUse(FlagCreationFee, "creation fee"),
+	)
+	AddUseDetails(cmd, ReqSignerDesc(FlagSeller))
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgCreateTriggerAsk reads all the SetupCmdTxCreateTriggerAsk flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgCreateTriggerAsk(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgCreateTriggerAskRequest, error) {
+	msg := &exchange.MsgCreateTriggerAskRequest{}
+
+	errs := make([]error, 12)
+	msg.AskOrder.Seller, errs[0] = ReadAddrFlagOrFrom(clientCtx, flagSet, FlagSeller)
+	msg.AskOrder.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
+	msg.AskOrder.Assets, errs[2] = ReadReqAssetsFlag(flagSet, FlagAssets)
+	msg.AskOrder.Price, errs[3] = ReadReqCoinFlag(flagSet, FlagPrice)
+	msg.TriggerPrice, errs[4] = ReadReqCoinFlag(flagSet, FlagTriggerPrice)
+	msg.AskOrder.SellerSettlementFlatFee, errs[5] = ReadCoinFlag(flagSet, FlagSettlementFee)
+	msg.AskOrder.AllowPartial, errs[6] = flagSet.GetBool(FlagPartial)
+	msg.AskOrder.ExternalId, errs[7] = flagSet.GetString(FlagExternalID)
+	msg.AskOrder.Expiration, errs[8] = ReadTimeFlag(flagSet, FlagExpiration)
+	msg.AskOrder.DisplayAssets, errs[9] = ReadCoinFlag(flagSet, FlagDisplay)
+	msg.AskOrder.Referrer, errs[10] = flagSet.GetString(FlagReferrer)
+	msg.OrderCreationFee, errs[11] = ReadCoinFlag(flagSet, FlagCreationFee)
+
+	return msg, errors.Join(errs...)
+}
+
+// SetupCmdTxCreateTriggerBid adds all the flags needed for MakeMsgCreateTriggerBid.
+func SetupCmdTxCreateTriggerBid(cmd *cobra.Command) {
+	cmd.Flags().String(FlagBuyer, "", "The buyer (defaults to --from account)")
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id (required)")
+	cmd.Flags().String(FlagAssets, "", "The assets for this order, e.g. 10nhash or a scope id (required)")
+	cmd.Flags().String(FlagPrice, "", "The price for this order, e.g. 10nhash (required)")
+	cmd.Flags().String(FlagTriggerPrice, "", "The settlement price at or above which this order is activated, e.g. 10nhash (required)")
+	cmd.Flags().String(FlagSettlementFee, "", "The settlement fee Coin string for this order, e.g. 10nhash")
+	cmd.Flags().Bool(FlagPartial, false, "Allow this order to be partially filled")
+	cmd.Flags().String(FlagExternalID, "", "The external id for this order")
+	cmd.Flags().String(FlagExpiration, "", "The RFC3339 time after which this order is cancelled, e.g. 2030-01-02T15:04:05Z")
+	cmd.Flags().String(FlagDisplay, "", "The amount of the assets to show in order queries (making this an iceberg order), e.g. 10nhash")
+	cmd.Flags().String(FlagReferrer, "", "The account that referred this order to the market")
+	cmd.Flags().String(FlagCreationFee, "", "The bid order creation fee, e.g. 10nhash")
+
+	cmd.MarkFlagsOneRequired(flags.FlagFrom, FlagBuyer)
+	MarkFlagsRequired(cmd, FlagMarket, FlagAssets, FlagPrice, FlagTriggerPrice)
+
+	AddUseArgs(cmd,
+		ReqSignerUse(FlagBuyer),
+		ReqFlagUse(FlagMarket, "market id"),
+		ReqFlagUse(FlagAssets, "assets"),
+		ReqFlagUse(FlagPrice, "price"),
+		ReqFlagUse(FlagTriggerPrice, "trigger price"),
+		UseFlagsBreak,
+		OptFlagUse(FlagSettlementFee, "seller settlement flat fee"),
+		OptFlagUse(FlagPartial, ""),
+		OptFlagUse(FlagExternalID, "external id"),
+		OptFlagUse(FlagExpiration, "expiration"),
+		OptFlagUse(FlagDisplay, "display assets"),
+		OptFlagUse(FlagReferrer, "referrer"),
+		OptFlagUse(FlagCreationFee, "creation fee"),
+	)
+	AddUseDetails(cmd, ReqSignerDesc(FlagBuyer))
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgCreateTriggerBid reads all the SetupCmdTxCreateTriggerBid flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgCreateTriggerBid(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgCreateTriggerBidRequest, error) {
+	msg := &exchange.MsgCreateTriggerBidRequest{}
+
+	errs := make([]error, 12)
+	msg.BidOrder.Buyer, errs[0] = ReadAddrFlagOrFrom(clientCtx, flagSet, FlagBuyer)
+	msg.BidOrder.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
+	msg.BidOrder.Assets, errs[2] = ReadReqAssetsFlag(flagSet, FlagAssets)
+	msg.BidOrder.Price, errs[3] = ReadReqCoinFlag(flagSet, FlagPrice)
+	msg.TriggerPrice, errs[4] = ReadReqCoinFlag(flagSet, FlagTriggerPrice)
+	msg.BidOrder.BuyerSettlementFees, errs[5] = ReadCoinsFlag(flagSet, FlagSettlementFee)
+	msg.BidOrder.AllowPartial, errs[6] = flagSet.GetBool(FlagPartial)
+	msg.BidOrder.ExternalId, errs[7] = flagSet.GetString(FlagExternalID)
+	msg.BidOrder.Expiration, errs[8] = ReadTimeFlag(flagSet, FlagExpiration)
+	msg.BidOrder.DisplayAssets, errs[9] = ReadCoinFlag(flagSet, FlagDisplay)
+	msg.BidOrder.Referrer, errs[10] = flagSet.GetString(FlagReferrer)
+	msg.OrderCreationFee, errs[11] = ReadCoinFlag(flagSet, FlagCreationFee)
+
+	return msg, errors.Join(errs...)
+}
+
+// SetupCmdTxCreateOrdersBatch adds all the flags needed for MakeMsgCreateOrdersBatch.
+func SetupCmdTxCreateOrdersBatch(cmd *cobra.Command) {
+	cmd.Flags().String(FlagOwner, "", "The owner of the orders (defaults to --from account)")
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id (required)")
+	cmd.Flags().StringSlice(FlagAskOrder, nil, "The ask orders to create (repeatable)")
+	cmd.Flags().StringSlice(FlagBidOrder, nil, "The bid orders to create (repeatable)")
+	cmd.Flags().String(FlagAskSettlementFee, "", "The settlement fee Coin string for each ask order, e.g. 10nhash")
+	cmd.Flags().String(FlagBidSettlementFee, "", "The settlement fee Coin string for each bid order, e.g. 10nhash")
+	cmd.Flags().Bool(FlagPartial, false, "Allow these orders to be partially filled")
+	cmd.Flags().String(FlagExpiration, "", "The RFC3339 time after which these orders are cancelled, e.g. 2030-01-02T15:04:05Z")
+	cmd.Flags().String(FlagAskCreationFee, "", "The creation fee for each ask order, e.g. 10nhash")
+	cmd.Flags().String(FlagBidCreationFee, "", "The creation fee for each bid order, e.g. 10nhash")
+
+	cmd.MarkFlagsOneRequired(flags.FlagFrom, FlagOwner)
+	cmd.MarkFlagsOneRequired(FlagAskOrder, FlagBidOrder)
+	MarkFlagsRequired(cmd, FlagMarket)
+
+	AddUseArgs(cmd,
+		ReqSignerUse(FlagOwner),
+		ReqFlagUse(FlagMarket, "market id"),
+		UseFlagsBreak,
+		OptFlagUse(FlagAskOrder, "order"),
+		OptFlagUse(FlagBidOrder, "order"),
+		UseFlagsBreak,
+		OptFlagUse(FlagAskSettlementFee, "seller settlement flat fee"),
+		OptFlagUse(FlagBidSettlementFee, "buyer settlement fees"),
+		OptFlagUse(FlagPartial, ""),
+		OptFlagUse(FlagExpiration, "expiration"),
+		UseFlagsBreak,
+		OptFlagUse(FlagAskCreationFee, "ask creation fee"),
+		OptFlagUse(FlagBidCreationFee, "bid creation fee"),
+	)
+	AddUseDetails(cmd, ReqSignerDesc(FlagOwner), RepeatableDesc, BatchOrderDesc)
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgCreateOrdersBatch reads all the SetupCmdTxCreateOrdersBatch flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgCreateOrdersBatch(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgCreateOrdersBatchRequest, error) {
+	msg := &exchange.MsgCreateOrdersBatchRequest{}
+
+	var asks, bids []exchange.NetAssetPrice
+	var sellerFee *sdk.Coin
+	var buyerFees sdk.Coins
+	var allowPartial bool
+	var expiration *time.Time
+
+	errs := make([]error, 10)
+	msg.Owner, errs[0] = ReadAddrFlagOrFrom(clientCtx, flagSet, FlagOwner)
+	msg.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
+	asks, errs[2] = ReadFlagNetAssetPrices(flagSet, FlagAskOrder)
+	bids, errs[3] = ReadFlagNetAssetPrices(flagSet, FlagBidOrder)
+	sellerFee, errs[4] = ReadCoinFlag(flagSet, FlagAskSettlementFee)
+	buyerFees, errs[5] = ReadCoinsFlag(flagSet, FlagBidSettlementFee)
+	allowPartial, errs[6] = flagSet.GetBool(FlagPartial)
+	expiration, errs[7] = ReadTimeFlag(flagSet, FlagExpiration)
+	msg.AskOrderCreationFee, errs[8] = ReadCoinFlag(flagSet, FlagAskCreationFee)
+	msg.BidOrderCreationFee, errs[9] = ReadCoinFlag(flagSet, FlagBidCreationFee)
+
+	for _, ask := range asks {
+		msg.AskOrders = append(msg.AskOrders, exchange.AskOrder{
+			MarketId:                msg.MarketId,
+			Seller:                  msg.Owner,
+			Assets:                  ask.Assets,
+			Price:                   ask.Price,
+			SellerSettlementFlatFee: sellerFee,
+			AllowPartial:            allowPartial,
+			Expiration:              expiration,
+		})
+	}
+	for _, bid := range bids {
+		msg.BidOrders = append(msg.BidOrders, exchange.BidOrder{
+			MarketId:            msg.MarketId,
+			Buyer:               msg.Owner,
+			Assets:              bid.Assets,
+			Price:               bid.Price,
+			BuyerSettlementFees: buyerFees,
+			AllowPartial:        allowPartial,
+			Expiration:          expiration,
+		})
+	}
 
 	return msg, errors.Join(errs...)
 }
@@ -116,6 +354,7 @@ func SetupCmdTxCommitFunds(cmd *cobra.Command) {
 	cmd.Flags().String(FlagAmount, "", "The amount to commit, e.g. 10nhash (required)")
 	cmd.Flags().String(FlagCreationFee, "", "The commitment creation fee, e.g. 10nhash")
 	cmd.Flags().String(FlagTag, "", "The event tag to include in the events with this commitment")
+	cmd.Flags().String(FlagExpiration, "", "The RFC3339 time after which the commitment is released, e.g. 2030-01-02T15:04:05Z")
 
 	cmd.MarkFlagsOneRequired(flags.FlagFrom, FlagAccount)
 	MarkFlagsRequired(cmd, FlagMarket, FlagAmount)
@@ -127,6 +366,7 @@ func SetupCmdTxCommitFunds(cmd *cobra.Command) {
 		UseFlagsBreak,
 		OptFlagUse(FlagCreationFee, "creation fee"),
 		OptFlagUse(FlagTag, "event tag"),
+		OptFlagUse(FlagExpiration, "expiration"),
 	)
 	AddUseDetails(cmd, ReqSignerDesc(FlagAccount))
 
@@ -138,12 +378,113 @@ func SetupCmdTxCommitFunds(cmd *cobra.Command) {
 func MakeMsgCommitFunds(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgCommitFundsRequest, error) {
 	msg := &exchange.MsgCommitFundsRequest{}
 
-	errs := make([]error, 5)
+	errs := make([]error, 6)
 	msg.Account, errs[0] = ReadAddrFlagOrFrom(clientCtx, flagSet, FlagAccount)
 	msg.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
 	msg.Amount, errs[2] = ReadReqCoinsFlag(flagSet, FlagAmount)
 	msg.CreationFee, errs[3] = ReadCoinFlag(flagSet, FlagCreationFee)
 	msg.EventTag, errs[4] = flagSet.GetString(FlagTag)
+	msg.Expiration, errs[5] = ReadTimeFlag(flagSet, FlagExpiration)
+
+	return msg, errors.Join(errs...)
+}
+
+// SetupCmdTxTransferCommitment adds all the flags needed for the MakeMsgTransferCommitment.
+func SetupCmdTxTransferCommitment(cmd *cobra.Command) {
+	cmd.Flags().String(FlagAccount, "", "The account with the committed funds (defaults to --from account)")
+	cmd.Flags().String(FlagAmount, "", "The amount to move, e.g. 10nhash (required)")
+	cmd.Flags().Uint32(FlagCurrentMarket, 0, "The current market id (required)")
+	cmd.Flags().Uint32(FlagNewMarket, 0, "The new market id (required)")
+	cmd.Flags().String(FlagTag, "", "The tag to include in the events emitted as part of this transfer")
+
+	cmd.MarkFlagsOneRequired(flags.FlagFrom, FlagAccount)
+	MarkFlagsRequired(cmd, FlagAmount, FlagCurrentMarket, FlagNewMarket)
+
+	AddUseArgs(cmd,
+		ReqSignerUse(FlagAccount),
+		ReqFlagUse(FlagAmount, "amount"),
+		ReqFlagUse(FlagCurrentMarket, "current market id"),
+		ReqFlagUse(FlagNewMarket, "new market id"),
+		UseFlagsBreak,
+		OptFlagUse(FlagTag, "event tag"),
+	)
+	AddUseDetails(cmd, ReqSignerDesc(FlagAccount))
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgTransferCommitment reads all the SetupCmdTxTransferCommitment flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgTransferCommitment(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgTransferCommitmentRequest, error) {
+	msg := &exchange.MsgTransferCommitmentRequest{}
+
+	errs := make([]error, 5)
+	msg.Account, errs[0] = ReadAddrFlagOrFrom(clientCtx, flagSet, FlagAccount)
+	msg.Amount, errs[1] = ReadReqCoinsFlag(flagSet, FlagAmount)
+	msg.CurrentMarketId, errs[2] = flagSet.GetUint32(FlagCurrentMarket)
+	msg.NewMarketId, errs[3] = flagSet.GetUint32(FlagNewMarket)
+	msg.EventTag, errs[4] = flagSet.GetString(FlagTag)
+
+	return msg, errors.Join(errs...)
+}
+
+// SetupCmdTxFundCommitmentRewards adds all the flags needed for the MakeMsgFundCommitmentRewards.
+func SetupCmdTxFundCommitmentRewards(cmd *cobra.Command) {
+	cmd.Flags().String(FlagSigner, "", "The account providing the funds (defaults to --from account)")
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id (required)")
+	cmd.Flags().String(FlagAmount, "", "The amount to add to the reward pool, e.g. 10nhash (required)")
+
+	cmd.MarkFlagsOneRequired(flags.FlagFrom, FlagSigner)
+	MarkFlagsRequired(cmd, FlagMarket, FlagAmount)
+
+	AddUseArgs(cmd,
+		ReqSignerUse(FlagSigner),
+		ReqFlagUse(FlagMarket, "market id"),
+		ReqFlagUse(FlagAmount, "amount"),
+	)
+	AddUseDetails(cmd, ReqSignerDesc(FlagSigner))
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgFundCommitmentRewards reads all the SetupCmdTxFundCommitmentRewards flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgFundCommitmentRewards(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgFundCommitmentRewardsRequest, error) {
+	msg := &exchange.MsgFundCommitmentRewardsRequest{}
+
+	errs := make([]error, 3)
+	msg.Funder, errs[0] = ReadAddrFlagOrFrom(clientCtx, flagSet, FlagSigner)
+	msg.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
+	msg.Amount, errs[2] = ReadReqCoinsFlag(flagSet, FlagAmount)
+
+	return msg, errors.Join(errs...)
+}
+
+// SetupCmdTxClaimCommitmentRewards adds all the flags needed for the MakeMsgClaimCommitmentRewards.
+func SetupCmdTxClaimCommitmentRewards(cmd *cobra.Command) {
+	cmd.Flags().String(FlagAccount, "", "The account claiming its rewards (defaults to --from account)")
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id (required)")
+
+	cmd.MarkFlagsOneRequired(flags.FlagFrom, FlagAccount)
+	MarkFlagsRequired(cmd, FlagMarket)
+
+	AddUseArgs(cmd,
+		ReqSignerUse(FlagAccount),
+		ReqFlagUse(FlagMarket, "market id"),
+	)
+	AddUseDetails(cmd, ReqSignerDesc(FlagAccount))
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgClaimCommitmentRewards reads all the SetupCmdTxClaimCommitmentRewards flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgClaimCommitmentRewards(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgClaimCommitmentRewardsRequest, error) {
+	msg := &exchange.MsgClaimCommitmentRewardsRequest{}
+
+	errs := make([]error, 2)
+	msg.Account, errs[0] = ReadAddrFlagOrFrom(clientCtx, flagSet, FlagAccount)
+	msg.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
 
 	return msg, errors.Join(errs...)
 }
@@ -179,6 +520,159 @@ func MakeMsgCancelOrder(clientCtx client.Context, flagSet *pflag.FlagSet, args [
 	return msg, errors.Join(errs...)
 }
 
+// SetupCmdTxAmendOrder adds all the flags needed for MakeMsgAmendOrder.
+func SetupCmdTxAmendOrder(cmd *cobra.Command) {
+	cmd.Flags().String(FlagOwner, "", "The owner of the order (defaults to --from account)")
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id (required)")
+	cmd.Flags().Uint64(FlagOrder, 0, "The order id")
+	cmd.Flags().String(FlagAssets, "", "The new assets for this order, e.g. 10nhash (required)")
+	cmd.Flags().String(FlagPrice, "", "The new price for this order, e.g. 10nhash (required)")
+	cmd.Flags().String(FlagAskSettlementFee, "", "The new settlement fee Coin string for an ask order, e.g. 10nhash")
+	cmd.Flags().String(FlagBidSettlementFee, "", "The new settlement fee Coins string for a bid order, e.g. 10nhash")
+	cmd.Flags().Bool(FlagPartial, false, "Allow this order to be partially filled")
+
+	cmd.MarkFlagsOneRequired(flags.FlagFrom, FlagOwner)
+	cmd.MarkFlagsMutuallyExclusive(FlagAskSettlementFee, FlagBidSettlementFee)
+	MarkFlagsRequired(cmd, FlagMarket, FlagAssets, FlagPrice)
+
+	AddUseArgs(cmd,
+		fmt.Sprintf("{<order id>|--%s <order id>}", FlagOrder),
+		ReqSignerUse(FlagOwner),
+		ReqFlagUse(FlagMarket, "market id"),
+		ReqFlagUse(FlagAssets, "assets"),
+		ReqFlagUse(FlagPrice, "price"),
+		UseFlagsBreak,
+		OptFlagUse(FlagAskSettlementFee, "seller settlement flat fee"),
+		OptFlagUse(FlagBidSettlementFee, "buyer settlement fees"),
+		OptFlagUse(FlagPartial, ""),
+	)
+	AddUseDetails(cmd,
+		ReqSignerDesc(FlagOwner),
+		"The <order id> must be provided either as the first argument or using the --order flag, but not both.",
+		fmt.Sprintf(`The order's assets, price, partial-fill flag, and settlement fees are all replaced by the provided values.
+The --%s can only be used with ask orders, and the --%s can only be used with bid orders.`,
+			FlagAskSettlementFee, FlagBidSettlementFee),
+	)
+
+	cmd.Args = cobra.MaximumNArgs(1)
+}
+
+// MakeMsgAmendOrder reads all the SetupCmdTxAmendOrder flags and the provided args and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgAmendOrder(clientCtx client.Context, flagSet *pflag.FlagSet, args []string) (*exchange.MsgAmendOrderRequest, error) {
+	msg := &exchange.MsgAmendOrderRequest{}
+
+	errs := make([]error, 8)
+	msg.Owner, errs[0] = ReadAddrFlagOrFrom(clientCtx, flagSet, FlagOwner)
+	msg.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
+	msg.OrderId, errs[2] = ReadFlagOrderOrArg(flagSet, args)
+	msg.Assets, errs[3] = ReadReqCoinFlag(flagSet, FlagAssets)
+	msg.Price, errs[4] = ReadReqCoinFlag(flagSet, FlagPrice)
+	msg.SellerSettlementFlatFee, errs[5] = ReadCoinFlag(flagSet, FlagAskSettlementFee)
+	msg.BuyerSettlementFees, errs[6] = ReadCoinsFlag(flagSet, FlagBidSettlementFee)
+	msg.AllowPartial, errs[7] = flagSet.GetBool(FlagPartial)
+
+	return msg, errors.Join(errs...)
+}
+
+// SetupCmdTxLinkOrders adds all the flags needed for MakeMsgLinkOrders.
+func SetupCmdTxLinkOrders(cmd *cobra.Command) {
+	cmd.Flags().String(FlagOwner, "", "The owner of the orders (defaults to --from account)")
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id (required)")
+	cmd.Flags().Uint64(FlagOrder, 0, "The order id (required)")
+	cmd.Flags().Uint64(FlagLinkedOrder, 0, "The id of the order to link it to (required)")
+
+	cmd.MarkFlagsOneRequired(flags.FlagFrom, FlagOwner)
+	MarkFlagsRequired(cmd, FlagMarket, FlagOrder, FlagLinkedOrder)
+
+	AddUseArgs(cmd,
+		ReqSignerUse(FlagOwner),
+		ReqFlagUse(FlagMarket, "market id"),
+		ReqFlagUse(FlagOrder, "order id"),
+		ReqFlagUse(FlagLinkedOrder, "linked order id"),
+	)
+	AddUseDetails(cmd,
+		ReqSignerDesc(FlagOwner),
+		`Once linked, when either order is filled (fully or partially) or cancelled, the other is cancelled.
+Both orders must be in the market, be owned by the --owner, and not already be linked to another order.`,
+	)
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgLinkOrders reads all the SetupCmdTxLinkOrders flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgLinkOrders(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgLinkOrdersRequest, error) {
+	msg := &exchange.MsgLinkOrdersRequest{}
+
+	errs := make([]error, 4)
+	msg.Owner, errs[0] = ReadAddrFlagOrFrom(clientCtx, flagSet, FlagOwner)
+	msg.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
+	msg.OrderId, errs[2] = flagSet.GetUint64(FlagOrder)
+	msg.LinkedOrderId, errs[3] = flagSet.GetUint64(FlagLinkedOrder)
+
+	return msg, errors.Join(errs...)
+}
+
+// SetupCmdTxSetDeadManSwitch adds all the flags needed for MakeMsgSetDeadManSwitch.
+func SetupCmdTxSetDeadManSwitch(cmd *cobra.Command) {
+	cmd.Flags().String(FlagAccount, "", "The account (defaults to --from account)")
+	cmd.Flags().Uint64(FlagInterval, 0, "The number of seconds allowed between heartbeats, 0 = remove (required)")
+
+	cmd.MarkFlagsOneRequired(flags.FlagFrom, FlagAccount)
+	MarkFlagsRequired(cmd, FlagInterval)
+
+	AddUseArgs(cmd,
+		ReqSignerUse(FlagAccount),
+		ReqFlagUse(FlagInterval, "seconds"),
+	)
+	AddUseDetails(cmd,
+		ReqSignerDesc(FlagAccount),
+		`If no heartbeat is received within the interval, all of the account's orders are cancelled.
+Setting a dead-man switch replaces any existing one. Use an --interval of 0 to remove the account's dead-man switch.`,
+	)
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgSetDeadManSwitch reads all the SetupCmdTxSetDeadManSwitch flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgSetDeadManSwitch(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgSetDeadManSwitchRequest, error) {
+	msg := &exchange.MsgSetDeadManSwitchRequest{}
+
+	errs := make([]error, 2)
+	msg.Account, errs[0] = ReadAddrFlagOrFrom(clientCtx, flagSet, FlagAccount)
+	msg.IntervalSeconds, errs[1] = flagSet.GetUint64(FlagInterval)
+
+	return msg, errors.Join(errs...)
+}
+
+// SetupCmdTxHeartbeat adds all the flags needed for MakeMsgHeartbeat.
+func SetupCmdTxHeartbeat(cmd *cobra.Command) {
+	cmd.Flags().String(FlagAccount, "", "The account (defaults to --from account)")
+
+	cmd.MarkFlagsOneRequired(flags.FlagFrom, FlagAccount)
+
+	AddUseArgs(cmd, ReqSignerUse(FlagAccount))
+	AddUseDetails(cmd,
+		ReqSignerDesc(FlagAccount),
+		"The account's dead-man switch deadline is reset to one interval after the current block time.",
+	)
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgHeartbeat reads all the SetupCmdTxHeartbeat flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgHeartbeat(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgHeartbeatRequest, error) {
+	msg := &exchange.MsgHeartbeatRequest{}
+
+	var err error
+	msg.Account, err = ReadAddrFlagOrFrom(clientCtx, flagSet, FlagAccount)
+
+	return msg, err
+}
+
 // SetupCmdTxFillBids adds all the flags needed for MakeMsgFillBids.
 func SetupCmdTxFillBids(cmd *cobra.Command) {
 	cmd.Flags().String(FlagSeller, "", "The seller (defaults to --from account)")
@@ -390,50 +884,129 @@ func MakeMsgMarketReleaseCommitments(clientCtx client.Context, flagSet *pflag.Fl
 	errs := make([]error, 5)
 	msg.Admin, errs[0] = ReadFlagsAdminOrFrom(clientCtx, flagSet)
 	msg.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
-	msg.ToRelease, errs[2] = ReadFlagAccountAmounts(flagSet, FlagRelease)
-	releaseAll, errs[3] = ReadFlagAccountsWithoutAmounts(flagSet, FlagReleaseAll)
-	msg.ToRelease = append(msg.ToRelease, releaseAll...)
-	msg.EventTag, errs[4] = flagSet.GetString(FlagTag)
-
+	msg.ToRelease, errs[2] = ReadFlagAccountAmounts(flagSet, FlagRelease)
+	releaseAll, errs[3] = ReadFlagAccountsWithoutAmounts(flagSet, FlagReleaseAll)
+	msg.ToRelease = append(msg.ToRelease, releaseAll...)
+	msg.EventTag, errs[4] = flagSet.GetString(FlagTag)
+
+	return msg, errors.Join(errs...)
+}
+
+// SetupCmdTxMarketTransferCommitment adds all the flags needed for MakeMsgMarketTransferCommitment.
+func SetupCmdTxMarketTransferCommitment(cmd *cobra.Command) {
+	AddFlagsAdmin(cmd)
+	cmd.Flags().String(FlagAccount, "", "The account that will receive the funds (required)")
+	cmd.Flags().String(FlagAmount, "", "The amount to withdraw (required)")
+	cmd.Flags().Uint32(FlagCurrentMarket, 0, "The current market id (required)")
+	cmd.Flags().Uint32(FlagNewMarket, 0, "The new market id (required)")
+	cmd.Flags().String(FlagTag, "", "The tag to include in the events emitted as part of these transfer")
+
+	MarkFlagsRequired(cmd, FlagAccount, FlagAmount, FlagCurrentMarket, FlagNewMarket)
+
+	AddUseArgs(cmd,
+		ReqAdminUse,
+		ReqFlagUse(FlagAccount, "account"),
+		ReqFlagUse(FlagAmount, "amount"),
+		ReqFlagUse(FlagCurrentMarket, "current market id"),
+		ReqFlagUse(FlagNewMarket, "new market id"),
+		OptFlagUse(FlagTag, "event tag"),
+	)
+	AddUseDetails(cmd, ReqAdminDesc)
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgMarketTransferCommitment reads all the SetupCmdTxMarketTransferCommitment flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgMarketTransferCommitment(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgMarketTransferCommitmentRequest, error) {
+	msg := &exchange.MsgMarketTransferCommitmentRequest{}
+
+	errs := make([]error, 6)
+	msg.Admin, errs[0] = ReadFlagsAdminOrFrom(clientCtx, flagSet)
+	msg.Account, errs[1] = flagSet.GetString(FlagAccount)
+	msg.Amount, errs[2] = ReadCoinsFlag(flagSet, FlagAmount)
+	msg.CurrentMarketId, errs[3] = flagSet.GetUint32(FlagCurrentMarket)
+	msg.NewMarketId, errs[4] = flagSet.GetUint32(FlagNewMarket)
+	msg.EventTag, errs[5] = flagSet.GetString(FlagTag)
+	return msg, errors.Join(errs...)
+}
+
+// SetupCmdTxMarketDistributeCommitmentRewards adds all the flags needed for MakeMsgMarketDistributeCommitmentRewards.
+func SetupCmdTxMarketDistributeCommitmentRewards(cmd *cobra.Command) {
+	AddFlagsAdmin(cmd)
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id (required)")
+	cmd.Flags().String(FlagAmount, "", "The amount to distribute from the reward pool (required)")
+	cmd.Flags().String(FlagBasisDenom, "", "The committed denom used to divide up the rewards (required)")
+
+	MarkFlagsRequired(cmd, FlagMarket, FlagAmount, FlagBasisDenom)
+
+	AddUseArgs(cmd,
+		ReqAdminUse,
+		ReqFlagUse(FlagMarket, "market id"),
+		ReqFlagUse(FlagAmount, "amount"),
+		ReqFlagUse(FlagBasisDenom, "denom"),
+	)
+	AddUseDetails(cmd, ReqAdminDesc)
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgMarketDistributeCommitmentRewards reads all the SetupCmdTxMarketDistributeCommitmentRewards flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgMarketDistributeCommitmentRewards(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgMarketDistributeCommitmentRewardsRequest, error) {
+	msg := &exchange.MsgMarketDistributeCommitmentRewardsRequest{}
+
+	errs := make([]error, 4)
+	msg.Admin, errs[0] = ReadFlagsAdminOrFrom(clientCtx, flagSet)
+	msg.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
+	msg.Amount, errs[2] = ReadReqCoinsFlag(flagSet, FlagAmount)
+	msg.BasisDenom, errs[3] = flagSet.GetString(FlagBasisDenom)
 	return msg, errors.Join(errs...)
 }
 
-// SetupCmdTxMarketTransferCommitment adds all the flags needed for MakeMsgMarketTransferCommitment.
-func SetupCmdTxMarketTransferCommitment(cmd *cobra.Command) {
+// SetupCmdTxMarketBulkCancel adds all the flags needed for MakeMsgMarketBulkCancel.
+func SetupCmdTxMarketBulkCancel(cmd *cobra.Command) {
 	AddFlagsAdmin(cmd)
-	cmd.Flags().String(FlagAccount, "", "The account that will receive the funds (required)")
-	cmd.Flags().String(FlagAmount, "", "The amount to withdraw (required)")
-	cmd.Flags().Uint32(FlagCurrentMarket, 0, "The current market id (required)")
-	cmd.Flags().Uint32(FlagNewMarket, 0, "The new market id (required)")
-	cmd.Flags().String(FlagTag, "", "The tag to include in the events emitted as part of these transfer")
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id (required)")
+	cmd.Flags().Bool(FlagAsks, false, "Only cancel ask orders")
+	cmd.Flags().Bool(FlagBids, false, "Only cancel bid orders")
+	cmd.Flags().String(FlagOwner, "", "Only cancel orders owned by this account")
+	cmd.Flags().String(FlagDenom, "", "Only cancel orders with this asset denom")
+	cmd.Flags().Uint32(FlagMax, 0, fmt.Sprintf("The maximum number of orders to cancel (default %d)", exchange.DefaultMarketBulkCancelLimit))
 
-	MarkFlagsRequired(cmd, FlagAccount, FlagAmount, FlagCurrentMarket, FlagNewMarket)
+	cmd.MarkFlagsMutuallyExclusive(FlagAsks, FlagBids)
+	MarkFlagsRequired(cmd, FlagMarket)
 
 	AddUseArgs(cmd,
 		ReqAdminUse,
-		ReqFlagUse(FlagAccount, "account"),
-		ReqFlagUse(FlagAmount, "amount"),
-		ReqFlagUse(FlagCurrentMarket, "current market id"),
-		ReqFlagUse(FlagNewMarket, "new market id"),
-		OptFlagUse(FlagTag, "event tag"),
+		ReqFlagUse(FlagMarket, "market id"),
+		OptAsksBidsUse,
+		OptFlagUse(FlagOwner, "owner"),
+		OptFlagUse(FlagDenom, "asset denom"),
+		OptFlagUse(FlagMax, "max"),
+	)
+	AddUseDetails(cmd,
+		ReqAdminDesc,
+		OptAsksBidsDesc,
+		fmt.Sprintf("At most %d orders can be cancelled with a single request.", exchange.MaxMarketBulkCancelLimit),
 	)
-	AddUseDetails(cmd, ReqAdminDesc)
 
 	cmd.Args = cobra.NoArgs
 }
 
-// MakeMsgMarketTransferCommitment reads all the SetupCmdTxMarketTransferCommitment flags and creates the desired Msg.
+// MakeMsgMarketBulkCancel reads all the SetupCmdTxMarketBulkCancel flags and creates the desired Msg.
 // Satisfies the msgMaker type.
-func MakeMsgMarketTransferCommitment(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgMarketTransferCommitmentRequest, error) {
-	msg := &exchange.MsgMarketTransferCommitmentRequest{}
+func MakeMsgMarketBulkCancel(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgMarketBulkCancelRequest, error) {
+	msg := &exchange.MsgMarketBulkCancelRequest{}
 
 	errs := make([]error, 6)
 	msg.Admin, errs[0] = ReadFlagsAdminOrFrom(clientCtx, flagSet)
-	msg.Account, errs[1] = flagSet.GetString(FlagAccount)
-	msg.Amount, errs[2] = ReadCoinsFlag(flagSet, FlagAmount)
-	msg.CurrentMarketId, errs[3] = flagSet.GetUint32(FlagCurrentMarket)
-	msg.NewMarketId, errs[4] = flagSet.GetUint32(FlagNewMarket)
-	msg.EventTag, errs[5] = flagSet.GetString(FlagTag)
+	msg.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
+	msg.OrderType, errs[2] = ReadFlagsAsksBidsOpt(flagSet)
+	msg.Owner, errs[3] = flagSet.GetString(FlagOwner)
+	msg.AssetDenom, errs[4] = flagSet.GetString(FlagDenom)
+	msg.Limit, errs[5] = flagSet.GetUint32(FlagMax)
+
 	return msg, errors.Join(errs...)
 }
 
@@ -658,6 +1231,256 @@ func MakeMsgMarketUpdateAcceptingCommitments(clientCtx client.Context, flagSet *
 	return msg, errors.Join(errs...)
 }
 
+// SetupCmdTxMarketUpdateContinuousMatching adds all the flags needed for MakeMsgMarketUpdateContinuousMatching.
+func SetupCmdTxMarketUpdateContinuousMatching(cmd *cobra.Command) {
+	AddFlagsAdmin(cmd)
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id (required)")
+	AddFlagsEnableDisable(cmd, "continuous_matching")
+
+	MarkFlagsRequired(cmd, FlagMarket)
+
+	AddUseArgs(cmd,
+		ReqAdminUse,
+		ReqFlagUse(FlagMarket, "market id"),
+		ReqEnableDisableUse,
+	)
+	AddUseDetails(cmd, ReqAdminDesc, ReqEnableDisableDesc)
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgMarketUpdateContinuousMatching reads all the SetupCmdTxMarketUpdateContinuousMatching flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgMarketUpdateContinuousMatching(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgMarketUpdateContinuousMatchingRequest, error) {
+	msg := &exchange.MsgMarketUpdateContinuousMatchingRequest{}
+
+	errs := make([]error, 3)
+	msg.Admin, errs[0] = ReadFlagsAdminOrFrom(clientCtx, flagSet)
+	msg.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
+	msg.ContinuousMatching, errs[2] = ReadFlagsEnableDisable(flagSet)
+
+	return msg, errors.Join(errs...)
+}
+
+// SetupCmdTxMarketUpdateBatchAuction adds all the flags needed for MakeMsgMarketUpdateBatchAuction.
+func SetupCmdTxMarketUpdateBatchAuction(cmd *cobra.Command) {
+	AddFlagsAdmin(cmd)
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id (required)")
+	cmd.Flags().Uint32(FlagBatchAuctionInterval, 0, "The number of blocks between batch auctions, 0 to disable them (required)")
+
+	MarkFlagsRequired(cmd, FlagMarket, FlagBatchAuctionInterval)
+
+	AddUseArgs(cmd,
+		ReqAdminUse,
+		ReqFlagUse(FlagMarket, "market id"),
+		ReqFlagUse(FlagBatchAuctionInterval, "blocks"),
+	)
+	AddUseDetails(cmd, ReqAdminDesc)
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgMarketUpdateBatchAuction reads all the SetupCmdTxMarketUpdateBatchAuction flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgMarketUpdateBatchAuction(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgMarketUpdateBatchAuctionRequest, error) {
+	msg := &exchange.MsgMarketUpdateBatchAuctionRequest{}
+
+	errs := make([]error, 3)
+	msg.Admin, errs[0] = ReadFlagsAdminOrFrom(clientCtx, flagSet)
+	msg.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
+	msg.BatchAuctionInterval, errs[2] = flagSet.GetUint32(FlagBatchAuctionInterval)
+
+	return msg, errors.Join(errs...)
+}
+
+// SetupCmdTxMarketUpdateSelfTradePrevention adds all the flags needed for MakeMsgMarketUpdateSelfTradePrevention.
+func SetupCmdTxMarketUpdateSelfTradePrevention(cmd *cobra.Command) {
+	AddFlagsAdmin(cmd)
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id (required)")
+	cmd.Flags().String(FlagSelfTradePrevention, "", "The self-trade prevention mode: none, cancel-newest, cancel-oldest, or decrement-both (required)")
+
+	MarkFlagsRequired(cmd, FlagMarket, FlagSelfTradePrevention)
+
+	AddUseArgs(cmd,
+		ReqAdminUse,
+		ReqFlagUse(FlagMarket, "market id"),
+		ReqFlagUse(FlagSelfTradePrevention, "mode"),
+	)
+	AddUseDetails(cmd, ReqAdminDesc, SelfTradePreventionDesc)
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgMarketUpdateSelfTradePrevention reads all the SetupCmdTxMarketUpdateSelfTradePrevention flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgMarketUpdateSelfTradePrevention(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgMarketUpdateSelfTradePreventionRequest, error) {
+	msg := &exchange.MsgMarketUpdateSelfTradePreventionRequest{}
+
+	errs := make([]error, 3)
+	msg.Admin, errs[0] = ReadFlagsAdminOrFrom(clientCtx, flagSet)
+	msg.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
+	msg.SelfTradePrevention, errs[2] = ReadFlagSelfTradePreventionOrDefault(flagSet, FlagSelfTradePrevention, exchange.SelfTradePrevention_unspecified)
+
+	return msg, errors.Join(errs...)
+}
+
+// SetupCmdTxMarketUpdatePublishPrices adds all the flags needed for MakeMsgMarketUpdatePublishPrices.
+func SetupCmdTxMarketUpdatePublishPrices(cmd *cobra.Command) {
+	AddFlagsAdmin(cmd)
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id (required)")
+	AddFlagsEnableDisable(cmd, "publish_prices")
+
+	MarkFlagsRequired(cmd, FlagMarket)
+
+	AddUseArgs(cmd,
+		ReqAdminUse,
+		ReqFlagUse(FlagMarket, "market id"),
+		ReqEnableDisableUse,
+	)
+	AddUseDetails(cmd, ReqAdminDesc, ReqEnableDisableDesc)
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgMarketUpdatePublishPrices reads all the SetupCmdTxMarketUpdatePublishPrices flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgMarketUpdatePublishPrices(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgMarketUpdatePublishPricesRequest, error) {
+	msg := &exchange.MsgMarketUpdatePublishPricesRequest{}
+
+	errs := make([]error, 3)
+	msg.Admin, errs[0] = ReadFlagsAdminOrFrom(clientCtx, flagSet)
+	msg.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
+	msg.PublishPrices, errs[2] = ReadFlagsEnableDisable(flagSet)
+
+	return msg, errors.Join(errs...)
+}
+
+// SetupCmdTxMarketUpdateExternalIDScope adds all the flags needed for MakeMsgMarketUpdateExternalIDScope.
+func SetupCmdTxMarketUpdateExternalIDScope(cmd *cobra.Command) {
+	AddFlagsAdmin(cmd)
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id (required)")
+	cmd.Flags().String(FlagExternalIDScope, "", "The external id scope: market or account (required)")
+
+	MarkFlagsRequired(cmd, FlagMarket, FlagExternalIDScope)
+
+	AddUseArgs(cmd,
+		ReqAdminUse,
+		ReqFlagUse(FlagMarket, "market id"),
+		ReqFlagUse(FlagExternalIDScope, "scope"),
+	)
+	AddUseDetails(cmd, ReqAdminDesc, ExternalIDScopeDesc)
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgMarketUpdateExternalIDScope reads all the SetupCmdTxMarketUpdateExternalIDScope flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgMarketUpdateExternalIDScope(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgMarketUpdateExternalIDScopeRequest, error) {
+	msg := &exchange.MsgMarketUpdateExternalIDScopeRequest{}
+
+	errs := make([]error, 3)
+	msg.Admin, errs[0] = ReadFlagsAdminOrFrom(clientCtx, flagSet)
+	msg.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
+	msg.ExternalIdScope, errs[2] = ReadFlagExternalIDScopeOrDefault(flagSet, FlagExternalIDScope, exchange.ExternalIDScope_unspecified)
+
+	return msg, errors.Join(errs...)
+}
+
+// SetupCmdTxMarketUpdateOrderRateLimit adds all the flags needed for MakeMsgMarketUpdateOrderRateLimit.
+func SetupCmdTxMarketUpdateOrderRateLimit(cmd *cobra.Command) {
+	AddFlagsAdmin(cmd)
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id (required)")
+	cmd.Flags().Uint32(FlagMaxOrders, 0, "The most orders an account can create during the window, 0 to remove the limit (required)")
+	cmd.Flags().Uint32(FlagWindowBlocks, 1, "The number of blocks to count orders over")
+
+	MarkFlagsRequired(cmd, FlagMarket, FlagMaxOrders)
+
+	AddUseArgs(cmd,
+		ReqAdminUse,
+		ReqFlagUse(FlagMarket, "market id"),
+		ReqFlagUse(FlagMaxOrders, "count"),
+		OptFlagUse(FlagWindowBlocks, "blocks"),
+	)
+	AddUseDetails(cmd, ReqAdminDesc, OrderRateLimitDesc)
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgMarketUpdateOrderRateLimit reads all the SetupCmdTxMarketUpdateOrderRateLimit flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgMarketUpdateOrderRateLimit(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgMarketUpdateOrderRateLimitRequest, error) {
+	msg := &exchange.MsgMarketUpdateOrderRateLimitRequest{}
+
+	errs := make([]error, 3)
+	msg.Admin, errs[0] = ReadFlagsAdminOrFrom(clientCtx, flagSet)
+	msg.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
+	msg.OrderRateLimit, errs[2] = ReadFlagsOrderRateLimitOrDefault(flagSet, nil)
+
+	return msg, errors.Join(errs...)
+}
+
+// SetupCmdTxMarketUpdateMaxExposure adds all the flags needed for MakeMsgMarketUpdateMaxExposure.
+func SetupCmdTxMarketUpdateMaxExposure(cmd *cobra.Command) {
+	AddFlagsAdmin(cmd)
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id (required)")
+	cmd.Flags().String(FlagMaxExposure, "", "The most an account can have in orders and commitments, a zero amount to remove it (required)")
+
+	MarkFlagsRequired(cmd, FlagMarket, FlagMaxExposure)
+
+	AddUseArgs(cmd,
+		ReqAdminUse,
+		ReqFlagUse(FlagMarket, "market id"),
+		ReqFlagUse(FlagMaxExposure, "coin"),
+	)
+	AddUseDetails(cmd, ReqAdminDesc, MaxExposureDesc)
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgMarketUpdateMaxExposure reads all the SetupCmdTxMarketUpdateMaxExposure flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgMarketUpdateMaxExposure(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgMarketUpdateMaxExposureRequest, error) {
+	msg := &exchange.MsgMarketUpdateMaxExposureRequest{}
+
+	errs := make([]error, 3)
+	msg.Admin, errs[0] = ReadFlagsAdminOrFrom(clientCtx, flagSet)
+	msg.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
+	msg.MaxExposure, errs[2] = ReadFlagMaxExposureOrDefault(flagSet, nil)
+
+	return msg, errors.Join(errs...)
+}
+
+// SetupCmdTxMarketUpdateFillAlgorithm adds all the flags needed for MakeMsgMarketUpdateFillAlgorithm.
+func SetupCmdTxMarketUpdateFillAlgorithm(cmd *cobra.Command) {
+	AddFlagsAdmin(cmd)
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id (required)")
+	cmd.Flags().String(FlagFillAlgorithm, "", "The fill algorithm: unspecified, price-time, or pro-rata (required)")
+
+	MarkFlagsRequired(cmd, FlagMarket, FlagFillAlgorithm)
+
+	AddUseArgs(cmd,
+		ReqAdminUse,
+		ReqFlagUse(FlagMarket, "market id"),
+		ReqFlagUse(FlagFillAlgorithm, "algorithm"),
+	)
+	AddUseDetails(cmd, ReqAdminDesc, FillAlgorithmDesc)
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgMarketUpdateFillAlgorithm reads all the SetupCmdTxMarketUpdateFillAlgorithm flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgMarketUpdateFillAlgorithm(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgMarketUpdateFillAlgorithmRequest, error) {
+	msg := &exchange.MsgMarketUpdateFillAlgorithmRequest{}
+
+	errs := make([]error, 3)
+	msg.Admin, errs[0] = ReadFlagsAdminOrFrom(clientCtx, flagSet)
+	msg.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
+	msg.FillAlgorithm, errs[2] = ReadFlagFillAlgorithmOrDefault(flagSet, FlagFillAlgorithm, exchange.FillAlgorithm_unspecified)
+
+	return msg, errors.Join(errs...)
+}
+
 // SetupCmdTxMarketUpdateIntermediaryDenom adds all the flags needed for MakeMsgMarketUpdateIntermediaryDenom.
 func SetupCmdTxMarketUpdateIntermediaryDenom(cmd *cobra.Command) {
 	AddFlagsAdmin(cmd)
@@ -728,6 +1551,76 @@ func MakeMsgMarketManagePermissions(clientCtx client.Context, flagSet *pflag.Fla
 	return msg, errors.Join(errs...)
 }
 
+// SetupCmdTxMarketTransferAuthority adds all the flags needed for MakeMsgMarketTransferAuthority.
+func SetupCmdTxMarketTransferAuthority(cmd *cobra.Command) {
+	AddFlagsAdmin(cmd)
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id (required)")
+	cmd.Flags().String(FlagNewAuthority, "", "The account that will take over the market (required)")
+	cmd.Flags().StringSlice(FlagGrant, nil, "The <access grants> the market will have once the transfer is accepted (repeatable)")
+
+	MarkFlagsRequired(cmd, FlagMarket, FlagNewAuthority, FlagGrant)
+
+	AddUseArgs(cmd,
+		ReqAdminUse,
+		ReqFlagUse(FlagMarket, "market id"),
+		ReqFlagUse(FlagNewAuthority, "address"),
+		UseFlagsBreak,
+		ReqFlagUse(FlagGrant, "access grants"),
+	)
+	AddUseDetails(cmd,
+		ReqAdminDesc,
+		RepeatableDesc,
+		AccessGrantsDesc,
+		`Once accepted, the provided <access grants> replace all of the market's existing access grants.
+Unless the new authority is the governance module account, it must be given the permissions permission.`,
+	)
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgMarketTransferAuthority reads all the SetupCmdTxMarketTransferAuthority flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgMarketTransferAuthority(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgMarketTransferAuthorityRequest, error) {
+	msg := &exchange.MsgMarketTransferAuthorityRequest{}
+
+	errs := make([]error, 4)
+	msg.Admin, errs[0] = ReadFlagsAdminOrFrom(clientCtx, flagSet)
+	msg.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
+	msg.NewAuthority, errs[2] = flagSet.GetString(FlagNewAuthority)
+	msg.AccessGrants, errs[3] = ReadAccessGrantsFlag(flagSet, FlagGrant, nil)
+
+	return msg, errors.Join(errs...)
+}
+
+// SetupCmdTxMarketAcceptAuthority adds all the flags needed for MakeMsgMarketAcceptAuthority.
+func SetupCmdTxMarketAcceptAuthority(cmd *cobra.Command) {
+	cmd.Flags().String(FlagSigner, "", "The new authority accepting the transfer (defaults to --from account)")
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id (required)")
+
+	cmd.MarkFlagsOneRequired(flags.FlagFrom, FlagSigner)
+	MarkFlagsRequired(cmd, FlagMarket)
+
+	AddUseArgs(cmd,
+		ReqSignerUse(FlagSigner),
+		ReqFlagUse(FlagMarket, "market id"),
+	)
+	AddUseDetails(cmd, ReqSignerDesc(FlagSigner))
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgMarketAcceptAuthority reads all the SetupCmdTxMarketAcceptAuthority flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgMarketAcceptAuthority(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgMarketAcceptAuthorityRequest, error) {
+	msg := &exchange.MsgMarketAcceptAuthorityRequest{}
+
+	errs := make([]error, 2)
+	msg.NewAuthority, errs[0] = ReadAddrFlagOrFrom(clientCtx, flagSet, FlagSigner)
+	msg.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
+
+	return msg, errors.Join(errs...)
+}
+
 // SetupCmdTxMarketManageReqAttrs adds all the flags needed for MakeMsgMarketManageReqAttrs.
 func SetupCmdTxMarketManageReqAttrs(cmd *cobra.Command) {
 	AddFlagsAdmin(cmd)
@@ -778,6 +1671,49 @@ func MakeMsgMarketManageReqAttrs(clientCtx client.Context, flagSet *pflag.FlagSe
 	return msg, errors.Join(errs...)
 }
 
+// SetupCmdTxMarketManageAcceptedDenoms adds all the flags needed for MakeMsgMarketManageAcceptedDenoms.
+func SetupCmdTxMarketManageAcceptedDenoms(cmd *cobra.Command) {
+	AddFlagsAdmin(cmd)
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id (required)")
+	cmd.Flags().StringSlice(FlagAssetDenomsAdd, nil, "The accepted asset denoms to add (repeatable)")
+	cmd.Flags().StringSlice(FlagAssetDenomsRemove, nil, "The accepted asset denoms to remove (repeatable)")
+	cmd.Flags().StringSlice(FlagPriceDenomsAdd, nil, "The accepted price denoms to add (repeatable)")
+	cmd.Flags().StringSlice(FlagPriceDenomsRemove, nil, "The accepted price denoms to remove (repeatable)")
+
+	cmd.MarkFlagsOneRequired(FlagAssetDenomsAdd, FlagAssetDenomsRemove, FlagPriceDenomsAdd, FlagPriceDenomsRemove)
+	MarkFlagsRequired(cmd, FlagMarket)
+
+	AddUseArgs(cmd,
+		ReqAdminUse,
+		ReqFlagUse(FlagMarket, "market id"),
+		UseFlagsBreak,
+		OptFlagUse(FlagAssetDenomsAdd, "denoms"),
+		OptFlagUse(FlagAssetDenomsRemove, "denoms"),
+		UseFlagsBreak,
+		OptFlagUse(FlagPriceDenomsAdd, "denoms"),
+		OptFlagUse(FlagPriceDenomsRemove, "denoms"),
+	)
+	AddUseDetails(cmd, ReqAdminDesc, RepeatableDesc, AcceptedDenomsDesc)
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgMarketManageAcceptedDenoms reads all the SetupCmdTxMarketManageAcceptedDenoms flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgMarketManageAcceptedDenoms(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgMarketManageAcceptedDenomsRequest, error) {
+	msg := &exchange.MsgMarketManageAcceptedDenomsRequest{}
+
+	errs := make([]error, 6)
+	msg.Admin, errs[0] = ReadFlagsAdminOrFrom(clientCtx, flagSet)
+	msg.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
+	msg.AssetDenomsToAdd, errs[2] = flagSet.GetStringSlice(FlagAssetDenomsAdd)
+	msg.AssetDenomsToRemove, errs[3] = flagSet.GetStringSlice(FlagAssetDenomsRemove)
+	msg.PriceDenomsToAdd, errs[4] = flagSet.GetStringSlice(FlagPriceDenomsAdd)
+	msg.PriceDenomsToRemove, errs[5] = flagSet.GetStringSlice(FlagPriceDenomsRemove)
+
+	return msg, errors.Join(errs...)
+}
+
 // SetupCmdTxCreatePayment adds all the flags needed for MakeMsgCreatePayment.
 func SetupCmdTxCreatePayment(cmd *cobra.Command) {
 	cmd.Flags().String(FlagSource, "", "The source account (defaults to --from account)")
@@ -785,6 +1721,8 @@ func SetupCmdTxCreatePayment(cmd *cobra.Command) {
 	cmd.Flags().String(FlagTarget, "", "The target account")
 	cmd.Flags().String(FlagTargetAmount, "", "The target funds, e.g. 10nhash")
 	cmd.Flags().String(FlagExternalID, "", "The external id")
+	cmd.Flags().String(FlagExpiration, "", "The RFC3339 time after which this payment is cancelled, e.g. 2030-01-02T15:04:05Z")
+	cmd.Flags().Uint64(FlagDisputeWindow, 0, "The number of seconds the funds are held in escrow after acceptance")
 	cmd.Flags().String(FlagFile, "", "a json file of a Tx with a MsgCreatePaymentRequest")
 
 	cmd.MarkFlagsOneRequired(FlagFile, flags.FlagFrom, FlagSource)
@@ -796,6 +1734,8 @@ func SetupCmdTxCreatePayment(cmd *cobra.Command) {
 		OptFlagUse(FlagTarget, "target"),
 		OptFlagUse(FlagTargetAmount, "target amount"),
 		OptFlagUse(FlagExternalID, "external id"),
+		OptFlagUse(FlagExpiration, "expiration"),
+		OptFlagUse(FlagDisputeWindow, "seconds"),
 		UseFlagsBreak,
 		OptFlagUse(FlagFile, "filename"),
 	)
@@ -809,13 +1749,15 @@ func SetupCmdTxCreatePayment(cmd *cobra.Command) {
 func MakeMsgCreatePayment(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgCreatePaymentRequest, error) {
 	msg := &exchange.MsgCreatePaymentRequest{}
 
-	errs := make([]error, 6)
+	errs := make([]error, 8)
 	msg.Payment, errs[0] = ReadPaymentFromFileFlag(clientCtx, flagSet)
 	msg.Payment.Source, errs[1] = ReadAddrFlagOrFromOrDefault(clientCtx, flagSet, FlagSource, msg.Payment.Source)
 	msg.Payment.SourceAmount, errs[2] = ReadCoinsFlagOrDefault(flagSet, FlagSourceAmount, msg.Payment.SourceAmount)
 	msg.Payment.Target, errs[3] = ReadFlagStringOrDefault(flagSet, FlagTarget, msg.Payment.Target)
 	msg.Payment.TargetAmount, errs[4] = ReadCoinsFlagOrDefault(flagSet, FlagTargetAmount, msg.Payment.TargetAmount)
 	msg.Payment.ExternalId, errs[5] = ReadFlagStringOrDefault(flagSet, FlagExternalID, msg.Payment.ExternalId)
+	msg.Payment.Expiration, errs[6] = ReadFlagTimeOrDefault(flagSet, FlagExpiration, msg.Payment.Expiration)
+	msg.Payment.DisputeWindowSeconds, errs[7] = ReadFlagUint64OrDefault(flagSet, FlagDisputeWindow, msg.Payment.DisputeWindowSeconds)
 
 	return msg, errors.Join(errs...)
 }
@@ -862,6 +1804,48 @@ func MakeMsgAcceptPayment(clientCtx client.Context, flagSet *pflag.FlagSet, _ []
 	return msg, errors.Join(errs...)
 }
 
+// SetupCmdTxAcceptPayments adds all the flags needed for MakeMsgAcceptPayments.
+func SetupCmdTxAcceptPayments(cmd *cobra.Command) {
+	cmd.Flags().String(FlagTarget, "", "The target account (defaults to --from account)")
+	cmd.Flags().StringArray(FlagPaymentIDs, nil, "The payments to accept, each as <source>:<external id> (repeatable)")
+	cmd.Flags().StringSlice(FlagSources, nil, "Accept all payments from these source accounts (repeatable)")
+	cmd.Flags().String(FlagMaxTargetAmount, "", "The most the target will pay for any one payment, e.g. 10nhash")
+
+	cmd.MarkFlagsOneRequired(flags.FlagFrom, FlagTarget)
+	cmd.MarkFlagsOneRequired(FlagPaymentIDs, FlagSources)
+
+	AddUseArgs(cmd,
+		ReqSignerUse(FlagTarget),
+		UseFlagsBreak,
+		OptFlagUse(FlagPaymentIDs, "payment id"),
+		OptFlagUse(FlagSources, "sources"),
+		OptFlagUse(FlagMaxTargetAmount, "max target amount"),
+	)
+	AddUseDetails(cmd,
+		ReqSignerDesc(FlagTarget),
+		fmt.Sprintf("At least one of --%s and/or --%s must be provided", FlagPaymentIDs, FlagSources),
+		fmt.Sprintf(`A <payment id> has the format "<source>:<external id>".
+A payment is only accepted if its target amount is zero or at most the --%s.`, FlagMaxTargetAmount),
+		RepeatableDesc,
+	)
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgAcceptPayments reads all the SetupCmdTxAcceptPayments flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgAcceptPayments(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgAcceptPaymentsRequest, error) {
+	msg := &exchange.MsgAcceptPaymentsRequest{}
+
+	errs := make([]error, 4)
+	msg.Target, errs[0] = ReadAddrFlagOrFrom(clientCtx, flagSet, FlagTarget)
+	msg.PaymentIds, errs[1] = ReadFlagPaymentIDs(flagSet, FlagPaymentIDs)
+	msg.Sources, errs[2] = flagSet.GetStringSlice(FlagSources)
+	msg.MaxTargetAmount, errs[3] = ReadCoinsFlag(flagSet, FlagMaxTargetAmount)
+
+	return msg, errors.Join(errs...)
+}
+
 // SetupCmdTxRejectPayment adds all the flags needed for MakeMsgRejectPayment.
 func SetupCmdTxRejectPayment(cmd *cobra.Command) {
 	cmd.Flags().String(FlagTarget, "", "The target account (defaults to --from account)")
@@ -923,6 +1907,38 @@ func MakeMsgRejectPayments(clientCtx client.Context, flagSet *pflag.FlagSet, _ [
 	return msg, errors.Join(errs...)
 }
 
+// SetupCmdTxDisputePayment adds all the flags needed for MakeMsgDisputePayment.
+func SetupCmdTxDisputePayment(cmd *cobra.Command) {
+	cmd.Flags().String(FlagTarget, "", "The target account (defaults to --from account)")
+	cmd.Flags().String(FlagSource, "", "The source account")
+	cmd.Flags().String(FlagExternalID, "", "The external id")
+
+	cmd.MarkFlagsOneRequired(flags.FlagFrom, FlagTarget)
+	MarkFlagsRequired(cmd, FlagSource)
+
+	AddUseArgs(cmd,
+		ReqSignerUse(FlagTarget),
+		ReqFlagUse(FlagSource, "source"),
+		OptFlagUse(FlagExternalID, "external id"),
+	)
+	AddUseDetails(cmd, ReqSignerDesc(FlagTarget))
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgDisputePayment reads all the SetupCmdTxDisputePayment flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgDisputePayment(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgDisputePaymentRequest, error) {
+	msg := &exchange.MsgDisputePaymentRequest{}
+
+	errs := make([]error, 3)
+	msg.Target, errs[0] = ReadAddrFlagOrFrom(clientCtx, flagSet, FlagTarget)
+	msg.Source, errs[1] = flagSet.GetString(FlagSource)
+	msg.ExternalId, errs[2] = flagSet.GetString(FlagExternalID)
+
+	return msg, errors.Join(errs...)
+}
+
 // SetupCmdTxCancelPayments adds all the flags needed for MakeMsgCancelPayments.
 func SetupCmdTxCancelPayments(cmd *cobra.Command) {
 	cmd.Flags().String(FlagSource, "", "The source account (defaults to --from account)")
@@ -990,6 +2006,192 @@ func MakeMsgChangePaymentTarget(clientCtx client.Context, flagSet *pflag.FlagSet
 	return msg, errors.Join(errs...)
 }
 
+// SetupCmdTxCreateRecurringPayment adds all the flags needed for MakeMsgCreateRecurringPayment.
+func SetupCmdTxCreateRecurringPayment(cmd *cobra.Command) {
+	cmd.Flags().String(FlagSource, "", "The source account (defaults to --from account)")
+	cmd.Flags().String(FlagTarget, "", "The target account (required)")
+	cmd.Flags().String(FlagAmount, "", "The funds to transfer each interval, e.g. 10nhash (required)")
+	cmd.Flags().String(FlagExternalID, "", "The external id")
+	cmd.Flags().Uint64(FlagInterval, 0, "The number of seconds between transfers (required)")
+	cmd.Flags().Uint32(FlagTransfers, 0, "The total number of transfers to make (required)")
+	cmd.Flags().String(FlagStartTime, "", "The RFC3339 time of the first transfer (defaults to now), e.g. 2030-01-02T15:04:05Z")
+
+	cmd.MarkFlagsOneRequired(flags.FlagFrom, FlagSource)
+	MarkFlagsRequired(cmd, FlagTarget, FlagAmount, FlagInterval, FlagTransfers)
+
+	AddUseArgs(cmd,
+		ReqSignerUse(FlagSource),
+		ReqFlagUse(FlagTarget, "target"),
+		ReqFlagUse(FlagAmount, "amount"),
+		UseFlagsBreak,
+		ReqFlagUse(FlagInterval, "seconds"),
+		ReqFlagUse(FlagTransfers, "count"),
+		OptFlagUse(FlagExternalID, "external id"),
+		OptFlagUse(FlagStartTime, "start time"),
+	)
+	AddUseDetails(cmd, ReqSignerDesc(FlagSource))
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgCreateRecurringPayment reads all the SetupCmdTxCreateRecurringPayment flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgCreateRecurringPayment(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgCreateRecurringPaymentRequest, error) {
+	msg := &exchange.MsgCreateRecurringPaymentRequest{}
+
+	errs := make([]error, 7)
+	msg.Source, errs[0] = ReadAddrFlagOrFrom(clientCtx, flagSet, FlagSource)
+	msg.Target, errs[1] = flagSet.GetString(FlagTarget)
+	msg.Amount, errs[2] = ReadCoinsFlag(flagSet, FlagAmount)
+	msg.ExternalId, errs[3] = flagSet.GetString(FlagExternalID)
+	msg.IntervalSeconds, errs[4] = flagSet.GetUint64(FlagInterval)
+	msg.Transfers, errs[5] = flagSet.GetUint32(FlagTransfers)
+	msg.StartTime, errs[6] = ReadTimeFlag(flagSet, FlagStartTime)
+
+	return msg, errors.Join(errs...)
+}
+
+// SetupCmdTxCancelRecurringPayments adds all the flags needed for MakeMsgCancelRecurringPayments.
+func SetupCmdTxCancelRecurringPayments(cmd *cobra.Command) {
+	cmd.Flags().String(FlagSource, "", "The source account (defaults to --from account)")
+	cmd.Flags().StringSlice(FlagExternalIDs, nil, "The external ids (repeatable, required)")
+	cmd.Flags().Bool(FlagEmptyExternalID, false, "Include an empty string in the external ids")
+
+	cmd.MarkFlagsOneRequired(flags.FlagFrom, FlagSource)
+	MarkFlagsRequired(cmd, FlagExternalIDs)
+
+	AddUseArgs(cmd,
+		ReqSignerUse(FlagSource),
+		ReqFlagUse(FlagExternalIDs, "external ids"),
+		OptFlagUse(FlagEmptyExternalID, ""),
+	)
+	AddUseDetails(cmd, ReqSignerDesc(FlagSource), RepeatableDesc)
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgCancelRecurringPayments reads all the SetupCmdTxCancelRecurringPayments flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgCancelRecurringPayments(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgCancelRecurringPaymentsRequest, error) {
+	msg := &exchange.MsgCancelRecurringPaymentsRequest{}
+
+	errs := make([]error, 3)
+	msg.Source, errs[0] = ReadAddrFlagOrFrom(clientCtx, flagSet, FlagSource)
+	msg.ExternalIds, errs[1] = flagSet.GetStringSlice(FlagExternalIDs)
+	var incEmpty bool
+	incEmpty, errs[2] = flagSet.GetBool(FlagEmptyExternalID)
+	if incEmpty {
+		msg.ExternalIds = append(msg.ExternalIds, "")
+	}
+
+	return msg, errors.Join(errs...)
+}
+
+// SetupCmdTxCreateMultiPartyPayment adds all the flags needed for MakeMsgCreateMultiPartyPayment.
+func SetupCmdTxCreateMultiPartyPayment(cmd *cobra.Command) {
+	cmd.Flags().String(FlagCreator, "", "The creator account (defaults to --from account)")
+	cmd.Flags().String(FlagExternalID, "", "The external id")
+	cmd.Flags().StringSlice(FlagInputs, nil, "The <account-amount> funds each party will send (repeatable)")
+	cmd.Flags().StringSlice(FlagOutputs, nil, "The <account-amount> funds each party will receive (repeatable)")
+
+	cmd.MarkFlagsOneRequired(flags.FlagFrom, FlagCreator)
+	MarkFlagsRequired(cmd, FlagInputs, FlagOutputs)
+
+	AddUseArgs(cmd,
+		ReqSignerUse(FlagCreator),
+		OptFlagUse(FlagExternalID, "external id"),
+		UseFlagsBreak,
+		ReqFlagUse(FlagInputs, "account-amount"),
+		ReqFlagUse(FlagOutputs, "account-amount"),
+	)
+	AddUseDetails(cmd, ReqSignerDesc(FlagCreator), RepeatableDesc, MultiPartyPaymentDesc, AccountAmountDesc)
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgCreateMultiPartyPayment reads all the SetupCmdTxCreateMultiPartyPayment flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgCreateMultiPartyPayment(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgCreateMultiPartyPaymentRequest, error) {
+	msg := &exchange.MsgCreateMultiPartyPaymentRequest{}
+
+	errs := make([]error, 3)
+	msg.Creator, errs[0] = ReadAddrFlagOrFrom(clientCtx, flagSet, FlagCreator)
+	msg.ExternalId, errs[1] = flagSet.GetString(FlagExternalID)
+	msg.Parties, errs[2] = ReadFlagsPaymentParties(flagSet, FlagInputs, FlagOutputs)
+
+	return msg, errors.Join(errs...)
+}
+
+// SetupCmdTxAcceptMultiPartyPayment adds all the flags needed for MakeMsgAcceptMultiPartyPayment.
+func SetupCmdTxAcceptMultiPartyPayment(cmd *cobra.Command) {
+	cmd.Flags().String(FlagParty, "", "The accepting party (defaults to --from account)")
+	cmd.Flags().String(FlagCreator, "", "The creator of the multi-party payment (required)")
+	cmd.Flags().String(FlagExternalID, "", "The external id")
+	cmd.Flags().StringSlice(FlagInputs, nil, "The <account-amount> funds each party will send (repeatable)")
+	cmd.Flags().StringSlice(FlagOutputs, nil, "The <account-amount> funds each party will receive (repeatable)")
+
+	cmd.MarkFlagsOneRequired(flags.FlagFrom, FlagParty)
+	MarkFlagsRequired(cmd, FlagCreator, FlagInputs, FlagOutputs)
+
+	AddUseArgs(cmd,
+		ReqSignerUse(FlagParty),
+		ReqFlagUse(FlagCreator, "creator"),
+		OptFlagUse(FlagExternalID, "external id"),
+		UseFlagsBreak,
+		ReqFlagUse(FlagInputs, "account-amount"),
+		ReqFlagUse(FlagOutputs, "account-amount"),
+	)
+	AddUseDetails(cmd, ReqSignerDesc(FlagParty), RepeatableDesc, MultiPartyPaymentDesc, AccountAmountDesc)
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgAcceptMultiPartyPayment reads all the SetupCmdTxAcceptMultiPartyPayment flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgAcceptMultiPartyPayment(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgAcceptMultiPartyPaymentRequest, error) {
+	msg := &exchange.MsgAcceptMultiPartyPaymentRequest{}
+
+	errs := make([]error, 4)
+	msg.Party, errs[0] = ReadAddrFlagOrFrom(clientCtx, flagSet, FlagParty)
+	msg.Creator, errs[1] = flagSet.GetString(FlagCreator)
+	msg.ExternalId, errs[2] = flagSet.GetString(FlagExternalID)
+	msg.Parties, errs[3] = ReadFlagsPaymentParties(flagSet, FlagInputs, FlagOutputs)
+
+	return msg, errors.Join(errs...)
+}
+
+// SetupCmdTxCancelMultiPartyPayment adds all the flags needed for MakeMsgCancelMultiPartyPayment.
+func SetupCmdTxCancelMultiPartyPayment(cmd *cobra.Command) {
+	cmd.Flags().String(FlagParty, "", "The cancelling party (defaults to --from account)")
+	cmd.Flags().String(FlagCreator, "", "The creator of the multi-party payment (required)")
+	cmd.Flags().String(FlagExternalID, "", "The external id")
+
+	cmd.MarkFlagsOneRequired(flags.FlagFrom, FlagParty)
+	MarkFlagsRequired(cmd, FlagCreator)
+
+	AddUseArgs(cmd,
+		ReqSignerUse(FlagParty),
+		ReqFlagUse(FlagCreator, "creator"),
+		OptFlagUse(FlagExternalID, "external id"),
+	)
+	AddUseDetails(cmd, ReqSignerDesc(FlagParty))
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgCancelMultiPartyPayment reads all the SetupCmdTxCancelMultiPartyPayment flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgCancelMultiPartyPayment(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgCancelMultiPartyPaymentRequest, error) {
+	msg := &exchange.MsgCancelMultiPartyPaymentRequest{}
+
+	errs := make([]error, 3)
+	msg.Party, errs[0] = ReadAddrFlagOrFrom(clientCtx, flagSet, FlagParty)
+	msg.Creator, errs[1] = flagSet.GetString(FlagCreator)
+	msg.ExternalId, errs[2] = flagSet.GetString(FlagExternalID)
+
+	return msg, errors.Join(errs...)
+}
+
 // SetupCmdTxGovCreateMarket adds all the flags needed for MakeMsgGovCreateMarket.
 func SetupCmdTxGovCreateMarket(cmd *cobra.Command) {
 	cmd.Flags().String(FlagAuthority, "", "The authority address to use (defaults to the governance module account)")
@@ -999,6 +2201,11 @@ func SetupCmdTxGovCreateMarket(cmd *cobra.Command) {
 	cmd.Flags().StringSlice(FlagCreateBid, nil, "The create-bid fee options, e.g. 10nhash (repeatable)")
 	cmd.Flags().StringSlice(FlagSellerFlat, nil, "The seller settlement flat fee options, e.g. 10nhash (repeatable)")
 	cmd.Flags().StringSlice(FlagSellerRatios, nil, "The seller settlement fee ratios, e.g. 100nhash:1nhash (repeatable)")
+	cmd.Flags().StringSlice(FlagTakerRatios, nil, "The seller settlement taker fee ratios, e.g. 100nhash:2nhash (repeatable)")
+	cmd.Flags().StringSlice(FlagRebateRatios, nil, "The seller settlement rebate ratios, e.g. 1000nhash:1nhash (repeatable)")
+	cmd.Flags().StringSlice(FlagRebateAddrs, nil, "The addresses eligible for rebates (repeatable)")
+	cmd.Flags().Uint32(FlagReferralBips, 0, "The portion of settlement fees paid to referrers in bips (min=0, max=10,000)")
+	cmd.Flags().StringSlice(FlagReferralCap, nil, "The most paid to a referrer for one order, e.g. 10nhash (repeatable)")
 	cmd.Flags().StringSlice(FlagBuyerFlat, nil, "The buyer settlement flat fee options, e.g. 10nhash (repeatable)")
 	cmd.Flags().StringSlice(FlagBuyerRatios, nil, "The buyer settlement fee ratios, e.g. 100nhash:1nhash (repeatable)")
 	cmd.Flags().Bool(FlagAcceptingOrders, false, "The market should allow orders to be created")
@@ -1008,17 +2215,33 @@ func SetupCmdTxGovCreateMarket(cmd *cobra.Command) {
 	cmd.Flags().StringSlice(FlagReqAttrBid, nil, "Attributes required to create bid orders (repeatable)")
 	cmd.Flags().String(FlagProposal, "", "a json file of a Tx with a gov proposal with a MsgGovCreateMarketRequest")
 	cmd.Flags().Bool(FlagAcceptingCommitments, false, "The market should allow commitments to be created")
+	cmd.Flags().Bool(FlagContinuousMatching, false, "The market should automatically match new orders against the order book")
+	cmd.Flags().Uint32(FlagBatchAuctionInterval, 0, "The number of blocks between the market's batch auctions")
+	cmd.Flags().String(FlagSelfTradePrevention, "", "The market's self-trade prevention mode")
+	cmd.Flags().Bool(FlagPublishPrices, false, "The market should publish its settlement prices to the oracle module's price feed")
+	cmd.Flags().String(FlagExternalIDScope, "", "The market's external id scope")
+	cmd.Flags().String(FlagFillAlgorithm, "", "The market's fill algorithm")
+	cmd.Flags().Uint32(FlagMaxOrders, 0, "The most orders an account can create in the market during the window")
+	cmd.Flags().Uint32(FlagWindowBlocks, 1, "The number of blocks to count an account's orders over")
+	cmd.Flags().String(FlagMaxExposure, "", "The most an account can have in the market's orders and commitments")
 	cmd.Flags().StringSlice(FlagCreateCommitment, nil, "The create-commitment fee options, e.g. 10nhash (repeatable)")
 	cmd.Flags().Uint32(FlagBips, 0, "The commitment settlement bips (min=0, max=10,000)")
 	cmd.Flags().String(FlagDenom, "", "The intermediary denom")
 	cmd.Flags().StringSlice(FlagReqAttrCommitment, nil, "Attributes required to create commitments (repeatable)")
+	cmd.Flags().StringSlice(FlagAssetDenoms, nil, "The asset denoms that orders can use (repeatable)")
+	cmd.Flags().StringSlice(FlagPriceDenoms, nil, "The price denoms that orders can use (repeatable)")
 
 	cmd.MarkFlagsOneRequired(
 		FlagMarket, FlagName, FlagDescription, FlagURL, FlagIcon,
 		FlagCreateAsk, FlagCreateBid, FlagCreateCommitment,
-		FlagSellerFlat, FlagSellerRatios, FlagBuyerFlat, FlagBuyerRatios,
-		FlagAcceptingOrders, FlagAllowUserSettle, FlagAcceptingCommitments, FlagAccessGrants,
+		FlagSellerFlat, FlagSellerRatios, FlagTakerRatios, FlagBuyerFlat, FlagBuyerRatios,
+		FlagRebateRatios, FlagRebateAddrs, FlagReferralBips, FlagReferralCap,
+		FlagAcceptingOrders, FlagAllowUserSettle, FlagAcceptingCommitments, FlagContinuousMatching, FlagBatchAuctionInterval,
+		FlagSelfTradePrevention, FlagPublishPrices, FlagExternalIDScope, FlagFillAlgorithm,
+		FlagMaxOrders, FlagWindowBlocks, FlagMaxExposure,
+		FlagAccessGrants,
 		FlagReqAttrAsk, FlagReqAttrBid, FlagReqAttrCommitment,
+		FlagAssetDenoms, FlagPriceDenoms,
 		FlagBips, FlagDenom,
 		FlagProposal,
 	)
@@ -1038,6 +2261,13 @@ func SetupCmdTxGovCreateMarket(cmd *cobra.Command) {
 		UseFlagsBreak,
 		OptFlagUse(FlagSellerFlat, "coins"),
 		OptFlagUse(FlagSellerRatios, "fee ratios"),
+		OptFlagUse(FlagTakerRatios, "fee ratios"),
+		UseFlagsBreak,
+		OptFlagUse(FlagRebateRatios, "fee ratios"),
+		OptFlagUse(FlagRebateAddrs, "addresses"),
+		UseFlagsBreak,
+		OptFlagUse(FlagReferralBips, "bips"),
+		OptFlagUse(FlagReferralCap, "coins"),
 		UseFlagsBreak,
 		OptFlagUse(FlagBuyerFlat, "coins"),
 		OptFlagUse(FlagBuyerRatios, "fee ratios"),
@@ -1045,6 +2275,16 @@ func SetupCmdTxGovCreateMarket(cmd *cobra.Command) {
 		OptFlagUse(FlagAcceptingOrders, ""),
 		OptFlagUse(FlagAllowUserSettle, ""),
 		OptFlagUse(FlagAcceptingCommitments, ""),
+		OptFlagUse(FlagContinuousMatching, ""),
+		OptFlagUse(FlagBatchAuctionInterval, "blocks"),
+		OptFlagUse(FlagSelfTradePrevention, "mode"),
+		OptFlagUse(FlagPublishPrices, ""),
+		OptFlagUse(FlagExternalIDScope, "scope"),
+		OptFlagUse(FlagFillAlgorithm, "algorithm"),
+		UseFlagsBreak,
+		OptFlagUse(FlagMaxOrders, "count"),
+		OptFlagUse(FlagWindowBlocks, "blocks"),
+		OptFlagUse(FlagMaxExposure, "coin"),
 		UseFlagsBreak,
 		OptFlagUse(FlagAccessGrants, "access grants"),
 		UseFlagsBreak,
@@ -1052,13 +2292,17 @@ func SetupCmdTxGovCreateMarket(cmd *cobra.Command) {
 		OptFlagUse(FlagReqAttrBid, "attrs"),
 		OptFlagUse(FlagReqAttrCommitment, "attrs"),
 		UseFlagsBreak,
+		OptFlagUse(FlagAssetDenoms, "denoms"),
+		OptFlagUse(FlagPriceDenoms, "denoms"),
+		UseFlagsBreak,
 		OptFlagUse(FlagBips, "bips"),
 		OptFlagUse(FlagDenom, "denom"),
 		UseFlagsBreak,
 		OptFlagUse(FlagProposal, "json filename"),
 	)
 	AddUseDetails(cmd,
-		AuthorityDesc, RepeatableDesc, AccessGrantsDesc, FeeRatioDesc,
+		AuthorityDesc, RepeatableDesc, AccessGrantsDesc, FeeRatioDesc, SelfTradePreventionDesc, ExternalIDScopeDesc,
+		FillAlgorithmDesc, OrderRateLimitDesc, MaxExposureDesc, AcceptedDenomsDesc,
 		ProposalFileDesc(&exchange.MsgGovCreateMarketRequest{}),
 	)
 
@@ -1070,7 +2314,7 @@ func SetupCmdTxGovCreateMarket(cmd *cobra.Command) {
 func MakeMsgGovCreateMarket(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgGovCreateMarketRequest, error) {
 	var msg *exchange.MsgGovCreateMarketRequest
 
-	errs := make([]error, 20)
+	errs := make([]error, 35)
 	msg, errs[0] = ReadMsgGovCreateMarketRequestFromProposalFlag(clientCtx, flagSet)
 	msg.Authority, errs[1] = ReadFlagAuthorityOrDefault(flagSet, msg.Authority)
 	msg.Market.MarketId, errs[2] = ReadFlagUint32OrDefault(flagSet, FlagMarket, msg.Market.MarketId)
@@ -1091,6 +2335,21 @@ func MakeMsgGovCreateMarket(clientCtx client.Context, flagSet *pflag.FlagSet, _
 	msg.Market.ReqAttrCreateCommitment, errs[17] = ReadFlagStringSliceOrDefault(flagSet, FlagReqAttrCommitment, msg.Market.ReqAttrCreateCommitment)
 	msg.Market.CommitmentSettlementBips, errs[18] = ReadFlagUint32OrDefault(flagSet, FlagBips, msg.Market.CommitmentSettlementBips)
 	msg.Market.IntermediaryDenom, errs[19] = ReadFlagStringOrDefault(flagSet, FlagDenom, msg.Market.IntermediaryDenom)
+	msg.Market.ContinuousMatching, errs[20] = ReadFlagBoolOrDefault(flagSet, FlagContinuousMatching, msg.Market.ContinuousMatching)
+	msg.Market.BatchAuctionInterval, errs[21] = ReadFlagUint32OrDefault(flagSet, FlagBatchAuctionInterval, msg.Market.BatchAuctionInterval)
+	msg.Market.SelfTradePrevention, errs[22] = ReadFlagSelfTradePreventionOrDefault(flagSet, FlagSelfTradePrevention, msg.Market.SelfTradePrevention)
+	msg.Market.AcceptedAssetDenoms, errs[23] = ReadFlagStringSliceOrDefault(flagSet, FlagAssetDenoms, msg.Market.AcceptedAssetDenoms)
+	msg.Market.AcceptedPriceDenoms, errs[24] = ReadFlagStringSliceOrDefault(flagSet, FlagPriceDenoms, msg.Market.AcceptedPriceDenoms)
+	msg.Market.FeeSellerSettlementTakerRatios, errs[25] = ReadFeeRatiosFlag(flagSet, FlagTakerRatios, msg.Market.FeeSellerSettlementTakerRatios)
+	msg.Market.FeeSellerSettlementRebateRatios, errs[26] = ReadFeeRatiosFlag(flagSet, FlagRebateRatios, msg.Market.FeeSellerSettlementRebateRatios)
+	msg.Market.RebateAddresses, errs[27] = ReadFlagStringSliceOrDefault(flagSet, FlagRebateAddrs, msg.Market.RebateAddresses)
+	msg.Market.ReferralBips, errs[28] = ReadFlagUint32OrDefault(flagSet, FlagReferralBips, msg.Market.ReferralBips)
+	msg.Market.ReferralCap, errs[29] = ReadFlatFeeFlag(flagSet, FlagReferralCap, msg.Market.ReferralCap)
+	msg.Market.PublishPrices, errs[30] = ReadFlagBoolOrDefault(flagSet, FlagPublishPrices, msg.Market.PublishPrices)
+	msg.Market.ExternalIdScope, errs[31] = ReadFlagExternalIDScopeOrDefault(flagSet, FlagExternalIDScope, msg.Market.ExternalIdScope)
+	msg.Market.OrderRateLimit, errs[32] = ReadFlagsOrderRateLimitOrDefault(flagSet, msg.Market.OrderRateLimit)
+	msg.Market.MaxExposure, errs[33] = ReadFlagMaxExposureOrDefault(flagSet, msg.Market.MaxExposure)
+	msg.Market.FillAlgorithm, errs[34] = ReadFlagFillAlgorithmOrDefault(flagSet, FlagFillAlgorithm, msg.Market.FillAlgorithm)
 
 	return msg, errors.Join(errs...)
 }
@@ -1107,6 +2366,16 @@ func SetupCmdTxGovManageFees(cmd *cobra.Command) {
 	cmd.Flags().StringSlice(FlagSellerFlatRemove, nil, "Seller settlement flat fee options to remove, e.g. 10nhash (repeatable)")
 	cmd.Flags().StringSlice(FlagSellerRatiosAdd, nil, "Seller settlement fee ratios to add, e.g. 100nhash:1nhash (repeatable)")
 	cmd.Flags().StringSlice(FlagSellerRatiosRemove, nil, "Seller settlement fee ratios to remove, e.g. 100nhash:1nhash (repeatable)")
+	cmd.Flags().StringSlice(FlagTakerRatiosAdd, nil, "Seller settlement taker fee ratios to add, e.g. 100nhash:2nhash (repeatable)")
+	cmd.Flags().StringSlice(FlagTakerRatiosRemove, nil, "Seller settlement taker fee ratios to remove, e.g. 100nhash:2nhash (repeatable)")
+	cmd.Flags().StringSlice(FlagRebateRatiosAdd, nil, "Seller settlement rebate ratios to add, e.g. 1000nhash:1nhash (repeatable)")
+	cmd.Flags().StringSlice(FlagRebateRatiosRemove, nil, "Seller settlement rebate ratios to remove, e.g. 1000nhash:1nhash (repeatable)")
+	cmd.Flags().StringSlice(FlagRebateAddrsAdd, nil, "Addresses eligible for rebates to add (repeatable)")
+	cmd.Flags().StringSlice(FlagRebateAddrsRemove, nil, "Addresses eligible for rebates to remove (repeatable)")
+	cmd.Flags().Uint32(FlagReferralBips, 0, "Referral bips")
+	cmd.Flags().Bool(FlagUnsetReferralBips, false, "Unset the referral bips")
+	cmd.Flags().StringSlice(FlagReferralCapAdd, nil, "Referral cap entries to add, e.g. 10nhash (repeatable)")
+	cmd.Flags().StringSlice(FlagReferralCapRemove, nil, "Referral cap entries to remove, e.g. 10nhash (repeatable)")
 	cmd.Flags().StringSlice(FlagBuyerFlatAdd, nil, "Buyer settlement flat fee options to add, e.g. 10nhash (repeatable)")
 	cmd.Flags().StringSlice(FlagBuyerFlatRemove, nil, "Buyer settlement flat fee options to remove, e.g. 10nhash (repeatable)")
 	cmd.Flags().StringSlice(FlagBuyerRatiosAdd, nil, "Seller settlement fee ratios to add, e.g. 100nhash:1nhash (repeatable)")
@@ -1115,16 +2384,22 @@ func SetupCmdTxGovManageFees(cmd *cobra.Command) {
 	cmd.Flags().StringSlice(FlagCommitmentRemove, nil, "Create-commitment flat fee options to remove, e.g. 10nhash (repeatable)")
 	cmd.Flags().Uint32(FlagBips, 0, "Commitment settlement bips")
 	cmd.Flags().Bool(FlagUnsetBips, false, "Unset the commitment settlement bips")
+	cmd.Flags().Int64(FlagEffectiveHeight, 0, "The block height at which to apply these fee changes")
+	cmd.Flags().String(FlagEffectiveTime, "", "The RFC3339 time at which to apply these fee changes, e.g. 2030-01-02T15:04:05Z")
 	cmd.Flags().String(FlagProposal, "", "a json file of a Tx with a gov proposal with a MsgGovManageFeesRequest")
 
 	MarkFlagsRequired(cmd, FlagMarket)
 	cmd.MarkFlagsOneRequired(
 		FlagAskAdd, FlagAskRemove, FlagBidAdd, FlagBidRemove,
 		FlagSellerFlatAdd, FlagSellerFlatRemove, FlagSellerRatiosAdd, FlagSellerRatiosRemove,
+		FlagTakerRatiosAdd, FlagTakerRatiosRemove,
+		FlagRebateRatiosAdd, FlagRebateRatiosRemove, FlagRebateAddrsAdd, FlagRebateAddrsRemove,
+		FlagReferralBips, FlagUnsetReferralBips, FlagReferralCapAdd, FlagReferralCapRemove,
 		FlagBuyerFlatAdd, FlagBuyerFlatRemove, FlagBuyerRatiosAdd, FlagBuyerRatiosRemove,
 		FlagCommitmentAdd, FlagCommitmentRemove, FlagBips, FlagUnsetBips,
 		FlagProposal,
 	)
+	cmd.MarkFlagsMutuallyExclusive(FlagEffectiveHeight, FlagEffectiveTime)
 
 	AddUseArgs(cmd,
 		ReqFlagUse(FlagMarket, "market id"),
@@ -1145,6 +2420,21 @@ func SetupCmdTxGovManageFees(cmd *cobra.Command) {
 		OptFlagUse(FlagSellerRatiosAdd, "fee ratios"),
 		OptFlagUse(FlagSellerRatiosRemove, "fee ratios"),
 		UseFlagsBreak,
+		OptFlagUse(FlagTakerRatiosAdd, "fee ratios"),
+		OptFlagUse(FlagTakerRatiosRemove, "fee ratios"),
+		UseFlagsBreak,
+		OptFlagUse(FlagRebateRatiosAdd, "fee ratios"),
+		OptFlagUse(FlagRebateRatiosRemove, "fee ratios"),
+		UseFlagsBreak,
+		OptFlagUse(FlagRebateAddrsAdd, "addresses"),
+		OptFlagUse(FlagRebateAddrsRemove, "addresses"),
+		UseFlagsBreak,
+		OptFlagUse(FlagReferralBips, "bips"),
+		OptFlagUse(FlagUnsetReferralBips, ""),
+		UseFlagsBreak,
+		OptFlagUse(FlagReferralCapAdd, "coins"),
+		OptFlagUse(FlagReferralCapRemove, "coins"),
+		UseFlagsBreak,
 		OptFlagUse(FlagBuyerFlatAdd, "coins"),
 		OptFlagUse(FlagBuyerFlatRemove, "coins"),
 		UseFlagsBreak,
@@ -1154,10 +2444,13 @@ func SetupCmdTxGovManageFees(cmd *cobra.Command) {
 		OptFlagUse(FlagBips, "bips"),
 		OptFlagUse(FlagUnsetBips, ""),
 		UseFlagsBreak,
+		OptFlagUse(FlagEffectiveHeight, "height"),
+		OptFlagUse(FlagEffectiveTime, "time"),
+		UseFlagsBreak,
 		OptFlagUse(FlagProposal, "json filename"),
 	)
 	AddUseDetails(cmd,
-		AuthorityDesc, RepeatableDesc, FeeRatioDesc,
+		AuthorityDesc, RepeatableDesc, FeeRatioDesc, ScheduledFeesDesc,
 		ProposalFileDesc(&exchange.MsgGovManageFeesRequest{}),
 	)
 
@@ -1169,7 +2462,7 @@ func SetupCmdTxGovManageFees(cmd *cobra.Command) {
 func MakeMsgGovManageFees(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgGovManageFeesRequest, error) {
 	var msg *exchange.MsgGovManageFeesRequest
 
-	errs := make([]error, 19)
+	errs := make([]error, 31)
 	msg, errs[0] = ReadMsgGovManageFeesRequestFromProposalFlag(clientCtx, flagSet)
 	msg.Authority, errs[1] = ReadFlagAuthorityOrDefault(flagSet, msg.Authority)
 	msg.MarketId, errs[2] = ReadFlagUint32OrDefault(flagSet, FlagMarket, msg.MarketId)
@@ -1189,6 +2482,61 @@ func MakeMsgGovManageFees(clientCtx client.Context, flagSet *pflag.FlagSet, _ []
 	msg.RemoveFeeBuyerSettlementRatios, errs[16] = ReadFeeRatiosFlag(flagSet, FlagBuyerRatiosRemove, msg.RemoveFeeBuyerSettlementRatios)
 	msg.SetFeeCommitmentSettlementBips, errs[17] = ReadFlagUint32OrDefault(flagSet, FlagBips, msg.SetFeeCommitmentSettlementBips)
 	msg.UnsetFeeCommitmentSettlementBips, errs[18] = ReadFlagBoolOrDefault(flagSet, FlagUnsetBips, msg.UnsetFeeCommitmentSettlementBips)
+	msg.EffectiveHeight, errs[19] = ReadFlagInt64OrDefault(flagSet, FlagEffectiveHeight, msg.EffectiveHeight)
+	msg.EffectiveTime, errs[20] = ReadFlagTimeOrDefault(flagSet, FlagEffectiveTime, msg.EffectiveTime)
+	msg.AddFeeSellerSettlementTakerRatios, errs[21] = ReadFeeRatiosFlag(flagSet, FlagTakerRatiosAdd, msg.AddFeeSellerSettlementTakerRatios)
+	msg.RemoveFeeSellerSettlementTakerRatios, errs[22] = ReadFeeRatiosFlag(flagSet, FlagTakerRatiosRemove, msg.RemoveFeeSellerSettlementTakerRatios)
+	msg.AddFeeSellerSettlementRebateRatios, errs[23] = ReadFeeRatiosFlag(flagSet, FlagRebateRatiosAdd, msg.AddFeeSellerSettlementRebateRatios)
+	msg.RemoveFeeSellerSettlementRebateRatios, errs[24] = ReadFeeRatiosFlag(flagSet, FlagRebateRatiosRemove, msg.RemoveFeeSellerSettlementRebateRatios)
+	msg.AddRebateAddresses, errs[25] = ReadFlagStringSliceOrDefault(flagSet, FlagRebateAddrsAdd, msg.AddRebateAddresses)
+	msg.RemoveRebateAddresses, errs[26] = ReadFlagStringSliceOrDefault(flagSet, FlagRebateAddrsRemove, msg.RemoveRebateAddresses)
+	msg.SetReferralBips, errs[27] = ReadFlagUint32OrDefault(flagSet, FlagReferralBips, msg.SetReferralBips)
+	msg.UnsetReferralBips, errs[28] = ReadFlagBoolOrDefault(flagSet, FlagUnsetReferralBips, msg.UnsetReferralBips)
+	msg.AddReferralCap, errs[29] = ReadFlatFeeFlag(flagSet, FlagReferralCapAdd, msg.AddReferralCap)
+	msg.RemoveReferralCap, errs[30] = ReadFlatFeeFlag(flagSet, FlagReferralCapRemove, msg.RemoveReferralCap)
+
+	return msg, errors.Join(errs...)
+}
+
+// SetupCmdTxGovManageExchangeSplits adds all the flags needed for MakeMsgGovManageExchangeSplits.
+func SetupCmdTxGovManageExchangeSplits(cmd *cobra.Command) {
+	cmd.Flags().String(FlagAuthority, "", "The authority address to use (defaults to the governance module account)")
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id (required)")
+	cmd.Flags().StringSlice(FlagSplit, nil, "The denom-splits to set for the market (repeatable)")
+	cmd.Flags().StringSlice(FlagSplitRemove, nil, "The denoms of the market's denom-splits to remove (repeatable)")
+
+	MarkFlagsRequired(cmd, FlagMarket)
+	cmd.MarkFlagsOneRequired(FlagSplit, FlagSplitRemove)
+
+	AddUseArgs(cmd,
+		ReqFlagUse(FlagMarket, "market id"),
+		OptFlagUse(FlagSplit, "splits"),
+		OptFlagUse(FlagSplitRemove, "denoms"),
+		OptFlagUse(FlagAuthority, "authority"),
+	)
+	AddUseDetails(cmd,
+		AuthorityDesc,
+		RepeatableDesc,
+		`A <split> has the format "<denom>:<amount>".
+An <amount> is in basis points and is limited to 0 to 10,000 (both inclusive).
+
+Example <split>: nhash:500`,
+		"A market's denom-splits take precedence over the ones in the exchange module params.",
+	)
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgGovManageExchangeSplits reads all the SetupCmdTxGovManageExchangeSplits flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgGovManageExchangeSplits(_ client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgGovManageExchangeSplitsRequest, error) {
+	msg := &exchange.MsgGovManageExchangeSplitsRequest{}
+
+	errs := make([]error, 4)
+	msg.Authority, errs[0] = ReadFlagAuthority(flagSet)
+	msg.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
+	msg.SetExchangeSplits, errs[2] = ReadSplitsFlag(flagSet, FlagSplit)
+	msg.RemoveExchangeSplits, errs[3] = flagSet.GetStringSlice(FlagSplitRemove)
 
 	return msg, errors.Join(errs...)
 }
@@ -1221,17 +2569,54 @@ func MakeMsgGovCloseMarket(_ client.Context, flagSet *pflag.FlagSet, _ []string)
 	return msg, errors.Join(errs...)
 }
 
+// SetupCmdTxGovWindDownMarket adds all the flags needed for MakeMsgGovWindDownMarket.
+func SetupCmdTxGovWindDownMarket(cmd *cobra.Command) {
+	cmd.Flags().String(FlagAuthority, "", "The authority address to use (defaults to the governance module account)")
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id (required)")
+	cmd.Flags().Bool(FlagDeleteMarket, false, "Also delete the market's record")
+
+	MarkFlagsRequired(cmd, FlagMarket)
+
+	AddUseArgs(cmd,
+		ReqFlagUse(FlagMarket, "market id"),
+		OptFlagUse(FlagDeleteMarket, ""),
+		OptFlagUse(FlagAuthority, "authority"),
+	)
+	AddUseDetails(cmd, AuthorityDesc)
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgGovWindDownMarket reads all the SetupCmdTxGovWindDownMarket flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgGovWindDownMarket(_ client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgGovWindDownMarketRequest, error) {
+	msg := &exchange.MsgGovWindDownMarketRequest{}
+
+	errs := make([]error, 3)
+	msg.Authority, errs[0] = ReadFlagAuthority(flagSet)
+	msg.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
+	msg.DeleteMarket, errs[2] = flagSet.GetBool(FlagDeleteMarket)
+
+	return msg, errors.Join(errs...)
+}
+
 // SetupCmdTxUpdateParams adds all the flags needed for MakeMsgUpdateParams.
 func SetupCmdTxUpdateParams(cmd *cobra.Command) {
 	cmd.Flags().String(FlagAuthority, "", "The authority address to use (defaults to the governance module account)")
 	cmd.Flags().Uint32(FlagDefault, 0, "The default split (required)")
 	cmd.Flags().StringSlice(FlagSplit, nil, "The denom-splits (repeatable)")
+	cmd.Flags().Uint64(FlagSettlementHistory, exchange.DefaultSettlementHistoryBlocks, "The number of blocks to keep settlement records for")
+	cmd.Flags().Uint64(FlagNAVHistory, exchange.DefaultNAVHistoryBlocks, "The number of blocks to keep NAV records for")
+	cmd.Flags().Uint32(FlagNAVHistoryMax, exchange.DefaultNAVHistoryMaxRecords, "The max number of NAV records to keep for each denom pair")
 
 	MarkFlagsRequired(cmd, FlagDefault)
 
 	AddUseArgs(cmd,
 		ReqFlagUse(FlagDefault, "amount"),
 		OptFlagUse(FlagSplit, "splits"),
+		OptFlagUse(FlagSettlementHistory, "blocks"),
+		OptFlagUse(FlagNAVHistory, "blocks"),
+		OptFlagUse(FlagNAVHistoryMax, "count"),
 		OptFlagUse(FlagAuthority, "authority"),
 	)
 	AddUseDetails(cmd,
@@ -1241,6 +2626,8 @@ func SetupCmdTxUpdateParams(cmd *cobra.Command) {
 An <amount> is in basis points and is limited to 0 to 10,000 (both inclusive).
 
 Example <split>: nhash:500`,
+		fmt.Sprintf("If --%s is 0, settlement records are not kept.", FlagSettlementHistory),
+		fmt.Sprintf("If --%s is 0, NAV records are not kept. If --%s is 0, there is no limit.", FlagNAVHistory, FlagNAVHistoryMax),
 	)
 
 	cmd.Args = cobra.NoArgs
@@ -1251,10 +2638,13 @@ Example <split>: nhash:500`,
 func MakeMsgUpdateParams(_ client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgUpdateParamsRequest, error) {
 	msg := &exchange.MsgUpdateParamsRequest{}
 
-	errs := make([]error, 3)
+	errs := make([]error, 6)
 	msg.Authority, errs[0] = ReadFlagAuthority(flagSet)
 	msg.Params.DefaultSplit, errs[1] = flagSet.GetUint32(FlagDefault)
 	msg.Params.DenomSplits, errs[2] = ReadSplitsFlag(flagSet, FlagSplit)
+	msg.Params.SettlementHistoryBlocks, errs[3] = flagSet.GetUint64(FlagSettlementHistory)
+	msg.Params.NavHistoryBlocks, errs[4] = flagSet.GetUint64(FlagNAVHistory)
+	msg.Params.NavHistoryMaxRecords, errs[5] = flagSet.GetUint32(FlagNAVHistoryMax)
 
 	return msg, errors.Join(errs...)
 }
@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -85,7 +86,8 @@ func TestSetupCmdTxCreateAsk(t *testing.T) {
 		setup: cli.SetupCmdTxCreateAsk,
 		expFlags: []string{
 			cli.FlagSeller, cli.FlagMarket, cli.FlagAssets, cli.FlagPrice,
-			cli.FlagSettlementFee, cli.FlagPartial, cli.FlagExternalID, cli.FlagCreationFee,
+			cli.FlagSettlementFee, cli.FlagPartial, cli.FlagExternalID, cli.FlagExpiration, cli.FlagDisplay,
+			cli.FlagReferrer, cli.FlagCreationFee, cli.FlagBasket, cli.FlagIdempotencyKey,
 			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
 		},
 		expAnnotations: map[string]map[string][]string{
@@ -98,13 +100,16 @@ func TestSetupCmdTxCreateAsk(t *testing.T) {
 		expInUse: []string{
 			"--seller", "--market <market id>", "--assets <assets>", "--price <price>",
 			"[--settlement-fee <seller settlement flat fee>]", "[--partial]",
-			"[--external-id <external id>]", "[--creation-fee <creation fee>]",
+			"[--external-id <external id>]", "[--expiration <expiration>]", "[--display <display assets>]",
+			"[--referrer <referrer>]", "[--creation-fee <creation fee>]",
+			"[--basket <basket assets>]", "[--idempotency-key <idempotency key>]",
 			cli.ReqSignerDesc(cli.FlagSeller),
 		},
 	})
 }
 
 func TestMakeMsgCreateAsk(t *testing.T) {
+	expiration := time.Date(2030, 6, 7, 8, 9, 10, 0, time.UTC)
 	td := txMakerTestDef[*exchange.MsgCreateAskRequest]{
 		makerName: "MakeMsgCreateAsk",
 		maker:     cli.MakeMsgCreateAsk,
@@ -115,13 +120,14 @@ func TestMakeMsgCreateAsk(t *testing.T) {
 		{
 			name:      "a couple errors",
 			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
-			flags:     []string{"--assets", "nope", "--creation-fee", "123"},
+			flags:     []string{"--assets", "nope", "--expiration", "tomorrow", "--creation-fee", "123"},
 			expMsg: &exchange.MsgCreateAskRequest{
 				AskOrder: exchange.AskOrder{Seller: sdk.AccAddress("FromAddress_________").String()},
 			},
 			expErr: joinErrs(
 				"error parsing --assets as a coin: invalid coin expression: \"nope\"",
 				"missing required --price flag",
+				"error parsing --expiration as an RFC3339 time: parsing time \"tomorrow\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"tomorrow\" as \"2006\"",
 				"error parsing --creation-fee as a coin: invalid coin expression: \"123\"",
 			),
 		},
@@ -131,7 +137,9 @@ func TestMakeMsgCreateAsk(t *testing.T) {
 				"--seller", "someaddr", "--market", "4",
 				"--assets", "10apple", "--price", "55plum",
 				"--settlement-fee", "5fig", "--partial",
-				"--external-id", "uuid", "--creation-fee", "6grape",
+				"--external-id", "uuid", "--expiration", "2030-06-07T08:09:10Z",
+				"--display", "3apple", "--referrer", "refaddr", "--creation-fee", "6grape",
+				"--basket", "2banana,1cherry", "--idempotency-key", "retry-1",
 			},
 			expMsg: &exchange.MsgCreateAskRequest{
 				AskOrder: exchange.AskOrder{
@@ -142,8 +150,25 @@ func TestMakeMsgCreateAsk(t *testing.T) {
 					SellerSettlementFlatFee: &sdk.Coin{Denom: "fig", Amount: sdkmath.NewInt(5)},
 					AllowPartial:            true,
 					ExternalId:              "uuid",
+					Expiration:              &expiration,
+					DisplayAssets:           &sdk.Coin{Denom: "apple", Amount: sdkmath.NewInt(3)},
+					Referrer:                "refaddr",
+					BasketAssets:            sdk.NewCoins(sdk.NewInt64Coin("banana", 2), sdk.NewInt64Coin("cherry", 1)),
 				},
 				OrderCreationFee: &sdk.Coin{Denom: "grape", Amount: sdkmath.NewInt(6)},
+				IdempotencyKey:   "retry-1",
+			},
+		},
+		{
+			name:  "scope assets",
+			flags: []string{"--seller", "someaddr", "--market", "2", "--assets", "scope1qzxcpvj6czy5g354dews3nlruxjsahhnsp", "--price", "500plum"},
+			expMsg: &exchange.MsgCreateAskRequest{
+				AskOrder: exchange.AskOrder{
+					MarketId: 2,
+					Seller:   "someaddr",
+					Assets:   sdk.NewInt64Coin("nft/scope1qzxcpvj6czy5g354dews3nlruxjsahhnsp", 1),
+					Price:    sdk.NewInt64Coin("plum", 500),
+				},
 			},
 		},
 	}
@@ -161,7 +186,8 @@ func TestSetupCmdTxCreateBid(t *testing.T) {
 		setup: cli.SetupCmdTxCreateBid,
 		expFlags: []string{
 			cli.FlagBuyer, cli.FlagMarket, cli.FlagAssets, cli.FlagPrice,
-			cli.FlagSettlementFee, cli.FlagPartial, cli.FlagExternalID, cli.FlagCreationFee,
+			cli.FlagSettlementFee, cli.FlagPartial, cli.FlagExternalID, cli.FlagExpiration, cli.FlagDisplay,
+			cli.FlagReferrer, cli.FlagCreationFee, cli.FlagBasket, cli.FlagIdempotencyKey,
 			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
 		},
 		expAnnotations: map[string]map[string][]string{
@@ -174,13 +200,16 @@ func TestSetupCmdTxCreateBid(t *testing.T) {
 		expInUse: []string{
 			"--buyer", "--market <market id>", "--assets <assets>", "--price <price>",
 			"[--settlement-fee <seller settlement flat fee>]", "[--partial]",
-			"[--external-id <external id>]", "[--creation-fee <creation fee>]",
+			"[--external-id <external id>]", "[--expiration <expiration>]", "[--display <display assets>]",
+			"[--referrer <referrer>]", "[--creation-fee <creation fee>]",
+			"[--basket <basket assets>]", "[--idempotency-key <idempotency key>]",
 			cli.ReqSignerDesc(cli.FlagBuyer),
 		},
 	})
 }
 
 func TestMakeMsgCreateBid(t *testing.T) {
+	expiration := time.Date(2030, 6, 7, 8, 9, 10, 0, time.UTC)
 	td := txMakerTestDef[*exchange.MsgCreateBidRequest]{
 		makerName: "MakeMsgCreateBid",
 		maker:     cli.MakeMsgCreateBid,
@@ -207,7 +236,9 @@ func TestMakeMsgCreateBid(t *testing.T) {
 				"--buyer", "someaddr", "--market", "4",
 				"--assets", "10apple", "--price", "55plum",
 				"--settlement-fee", "5fig", "--partial",
-				"--external-id", "uuid", "--creation-fee", "6grape",
+				"--external-id", "uuid", "--expiration", "2030-06-07T08:09:10Z",
+				"--display", "3apple", "--referrer", "refaddr", "--creation-fee", "6grape",
+				"--basket", "2banana,1cherry", "--idempotency-key", "retry-1",
 			},
 			expMsg: &exchange.MsgCreateBidRequest{
 				BidOrder: exchange.BidOrder{
@@ -218,8 +249,25 @@ func TestMakeMsgCreateBid(t *testing.T) {
 					BuyerSettlementFees: sdk.Coins{sdk.Coin{Denom: "fig", Amount: sdkmath.NewInt(5)}},
 					AllowPartial:        true,
 					ExternalId:          "uuid",
+					Expiration:          &expiration,
+					DisplayAssets:       &sdk.Coin{Denom: "apple", Amount: sdkmath.NewInt(3)},
+					Referrer:            "refaddr",
+					BasketAssets:        sdk.NewCoins(sdk.NewInt64Coin("banana", 2), sdk.NewInt64Coin("cherry", 1)),
 				},
 				OrderCreationFee: &sdk.Coin{Denom: "grape", Amount: sdkmath.NewInt(6)},
+				IdempotencyKey:   "retry-1",
+			},
+		},
+		{
+			name:  "scope assets",
+			flags: []string{"--buyer", "someaddr", "--market", "2", "--assets", "scope1qzxcpvj6czy5g354dews3nlruxjsahhnsp", "--price", "500plum"},
+			expMsg: &exchange.MsgCreateBidRequest{
+				BidOrder: exchange.BidOrder{
+					MarketId: 2,
+					Buyer:    "someaddr",
+					Assets:   sdk.NewInt64Coin("nft/scope1qzxcpvj6czy5g354dews3nlruxjsahhnsp", 1),
+					Price:    sdk.NewInt64Coin("plum", 500),
+				},
 			},
 		},
 	}
@@ -231,60 +279,80 @@ func TestMakeMsgCreateBid(t *testing.T) {
 	}
 }
 
-func TestSetupCmdTxCommitFunds(t *testing.T) {
+func TestSetupCmdTxCreateTriggerAsk(t *testing.T) {
 	runSetupTestCase(t, setupTestCase{
-		name:  "SetupCmdTxCommitFunds",
-		setup: cli.SetupCmdTxCommitFunds,
+		name:  "SetupCmdTxCreateTriggerAsk",
+		setup: cli.SetupCmdTxCreateTriggerAsk,
 		expFlags: []string{
-			cli.FlagAccount, cli.FlagMarket, cli.FlagAmount, cli.FlagCreationFee, cli.FlagTag,
+			cli.FlagSeller, cli.FlagMarket, cli.FlagAssets, cli.FlagPrice, cli.FlagTriggerPrice,
+			cli.FlagSettlementFee, cli.FlagPartial, cli.FlagExternalID, cli.FlagExpiration, cli.FlagDisplay,
+			cli.FlagReferrer, cli.FlagCreationFee,
 			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
 		},
 		expAnnotations: map[string]map[string][]string{
-			flags.FlagFrom:  {oneReq: {flags.FlagFrom + " " + cli.FlagAccount}},
-			cli.FlagAccount: {oneReq: {flags.FlagFrom + " " + cli.FlagAccount}},
-			cli.FlagMarket:  {required: {"true"}},
-			cli.FlagAmount:  {required: {"true"}},
+			flags.FlagFrom:       {oneReq: {flags.FlagFrom + " " + cli.FlagSeller}},
+			cli.FlagSeller:       {oneReq: {flags.FlagFrom + " " + cli.FlagSeller}},
+			cli.FlagMarket:       {required: {"true"}},
+			cli.FlagAssets:       {required: {"true"}},
+			cli.FlagPrice:        {required: {"true"}},
+			cli.FlagTriggerPrice: {required: {"true"}},
 		},
 		expInUse: []string{
-			"--account", "--market <market id>", "--amount <amount>",
-			"[--creation-fee <creation fee>]", "[--tag <event tag>]",
-			cli.ReqSignerDesc(cli.FlagAccount),
+			"--seller", "--market <market id>", "--assets <assets>", "--price <price>",
+			"--trigger-price <trigger price>",
+			"[--settlement-fee <seller settlement flat fee>]", "[--partial]",
+			"[--external-id <external id>]", "[--expiration <expiration>]", "[--display <display assets>]",
+			"[--referrer <referrer>]", "[--creation-fee <creation fee>]",
+			cli.ReqSignerDesc(cli.FlagSeller),
 		},
 	})
 }
 
-func TestMakeMsgCommitFunds(t *testing.T) {
-	td := txMakerTestDef[*exchange.MsgCommitFundsRequest]{
-		makerName: "MakeMsgCommitFunds",
-		maker:     cli.MakeMsgCommitFunds,
-		setup:     cli.SetupCmdTxCommitFunds,
+func TestMakeMsgCreateTriggerAsk(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgCreateTriggerAskRequest]{
+		makerName: "MakeMsgCreateTriggerAsk",
+		maker:     cli.MakeMsgCreateTriggerAsk,
+		setup:     cli.SetupCmdTxCreateTriggerAsk,
 	}
 
-	tests := []txMakerTestCase[*exchange.MsgCommitFundsRequest]{
+	tests := []txMakerTestCase[*exchange.MsgCreateTriggerAskRequest]{
 		{
 			name:      "a couple errors",
 			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
-			flags:     []string{"--amount", "nope", "--creation-fee", "123"},
-			expMsg: &exchange.MsgCommitFundsRequest{
-				Account: sdk.AccAddress("FromAddress_________").String(),
+			flags:     []string{"--assets", "nope", "--price", "3plum"},
+			expMsg: &exchange.MsgCreateTriggerAskRequest{
+				AskOrder: exchange.AskOrder{
+					Seller: sdk.AccAddress("FromAddress_________").String(),
+					Price:  sdk.NewInt64Coin("plum", 3),
+				},
 			},
 			expErr: joinErrs(
-				"error parsing --amount as coins: invalid coin expression: \"nope\"",
-				"error parsing --creation-fee as a coin: invalid coin expression: \"123\"",
+				"error parsing --assets as a coin: invalid coin expression: \"nope\"",
+				"missing required --trigger-price flag",
 			),
 		},
 		{
 			name: "all fields",
 			flags: []string{
-				"--account", "someaddr", "--market", "4", "--amount", "10apple",
-				"--tag", "atagofsomesort", "--creation-fee", "6grape",
+				"--seller", "someaddr", "--market", "4",
+				"--assets", "10apple", "--price", "55plum", "--trigger-price", "60plum",
+				"--settlement-fee", "5fig", "--partial", "--external-id", "uuid",
+				"--display", "3apple", "--referrer", "refaddr", "--creation-fee", "6grape",
 			},
-			expMsg: &exchange.MsgCommitFundsRequest{
-				Account:     "someaddr",
-				MarketId:    4,
-				Amount:      sdk.NewCoins(sdk.NewInt64Coin("apple", 10)),
-				CreationFee: &sdk.Coin{Denom: "grape", Amount: sdkmath.NewInt(6)},
-				EventTag:    "atagofsomesort",
+			expMsg: &exchange.MsgCreateTriggerAskRequest{
+				AskOrder: exchange.AskOrder{
+					MarketId:                4,
+					Seller:                  "someaddr",
+					Assets:                  sdk.NewInt64Coin("apple", 10),
+					Price:                   sdk.NewInt64Coin("plum", 55),
+					SellerSettlementFlatFee: &sdk.Coin{Denom: "fig", Amount: sdkmath.NewInt(5)},
+					AllowPartial:            true,
+					ExternalId:              "uuid",
+					DisplayAssets:           &sdk.Coin{Denom: "apple", Amount: sdkmath.NewInt(3)},
+					Referrer:                "refaddr",
+				},
+				TriggerPrice:     sdk.NewInt64Coin("plum", 60),
+				OrderCreationFee: &sdk.Coin{Denom: "grape", Amount: sdkmath.NewInt(6)},
 			},
 		},
 	}
@@ -296,58 +364,82 @@ func TestMakeMsgCommitFunds(t *testing.T) {
 	}
 }
 
-func TestSetupCmdTxCancelOrder(t *testing.T) {
+func TestSetupCmdTxCreateTriggerBid(t *testing.T) {
 	runSetupTestCase(t, setupTestCase{
-		name:  "SetupCmdTxCancelOrder",
-		setup: cli.SetupCmdTxCancelOrder,
+		name:  "SetupCmdTxCreateTriggerBid",
+		setup: cli.SetupCmdTxCreateTriggerBid,
 		expFlags: []string{
-			cli.FlagSigner, cli.FlagOrder,
+			cli.FlagBuyer, cli.FlagMarket, cli.FlagAssets, cli.FlagPrice, cli.FlagTriggerPrice,
+			cli.FlagSettlementFee, cli.FlagPartial, cli.FlagExternalID, cli.FlagExpiration, cli.FlagDisplay,
+			cli.FlagReferrer, cli.FlagCreationFee,
 			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
 		},
 		expAnnotations: map[string]map[string][]string{
-			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagSigner}},
-			cli.FlagSigner: {oneReq: {flags.FlagFrom + " " + cli.FlagSigner}},
+			flags.FlagFrom:       {oneReq: {flags.FlagFrom + " " + cli.FlagBuyer}},
+			cli.FlagBuyer:        {oneReq: {flags.FlagFrom + " " + cli.FlagBuyer}},
+			cli.FlagMarket:       {required: {"true"}},
+			cli.FlagAssets:       {required: {"true"}},
+			cli.FlagPrice:        {required: {"true"}},
+			cli.FlagTriggerPrice: {required: {"true"}},
 		},
 		expInUse: []string{
-			"{<order id>|--order <order id>}",
-			"{--from|--signer} <signer>",
-			cli.ReqSignerDesc(cli.FlagSigner),
-			"The <order id> must be provided either as the first argument or using the --order flag, but not both.",
+			"--buyer", "--market <market id>", "--assets <assets>", "--price <price>",
+			"--trigger-price <trigger price>",
+			"[--settlement-fee <seller settlement flat fee>]", "[--partial]",
+			"[--external-id <external id>]", "[--expiration <expiration>]", "[--display <display assets>]",
+			"[--referrer <referrer>]", "[--creation-fee <creation fee>]",
+			cli.ReqSignerDesc(cli.FlagBuyer),
 		},
 	})
 }
 
-func TestMakeMsgCancelOrder(t *testing.T) {
-	td := txMakerTestDef[*exchange.MsgCancelOrderRequest]{
-		makerName: "MakeMsgCancelOrder",
-		maker:     cli.MakeMsgCancelOrder,
-		setup:     cli.SetupCmdTxCancelOrder,
+func TestMakeMsgCreateTriggerBid(t *testing.T) {
+	expiration := time.Date(2030, 6, 7, 8, 9, 10, 0, time.UTC)
+	td := txMakerTestDef[*exchange.MsgCreateTriggerBidRequest]{
+		makerName: "MakeMsgCreateTriggerBid",
+		maker:     cli.MakeMsgCreateTriggerBid,
+		setup:     cli.SetupCmdTxCreateTriggerBid,
 	}
 
-	tests := []txMakerTestCase[*exchange.MsgCancelOrderRequest]{
+	tests := []txMakerTestCase[*exchange.MsgCreateTriggerBidRequest]{
 		{
-			name:   "nothing",
-			expMsg: &exchange.MsgCancelOrderRequest{},
+			name:      "a couple errors",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--trigger-price", "nope", "--creation-fee", "123"},
+			expMsg: &exchange.MsgCreateTriggerBidRequest{
+				BidOrder: exchange.BidOrder{Buyer: sdk.AccAddress("FromAddress_________").String()},
+			},
 			expErr: joinErrs(
-				"no <signer> provided",
-				"no <order id> provided",
+				"missing required --assets flag",
+				"missing required --price flag",
+				"error parsing --trigger-price as a coin: invalid coin expression: \"nope\"",
+				"error parsing --creation-fee as a coin: invalid coin expression: \"123\"",
 			),
 		},
 		{
-			name:      "from and arg",
-			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
-			args:      []string{"87"},
-			expMsg: &exchange.MsgCancelOrderRequest{
-				Signer:  sdk.AccAddress("FromAddress_________").String(),
-				OrderId: 87,
+			name: "all fields",
+			flags: []string{
+				"--buyer", "someaddr", "--market", "4",
+				"--assets", "10apple", "--price", "55plum", "--trigger-price", "50plum",
+				"--settlement-fee", "5fig", "--partial",
+				"--external-id", "uuid", "--expiration", "2030-06-07T08:09:10Z",
+				"--display", "3apple", "--referrer", "refaddr", "--creation-fee", "6grape",
 			},
-		},
-		{
-			name:  "signer and flag",
-			flags: []string{"--order", "52", "--signer", "someone"},
-			expMsg: &exchange.MsgCancelOrderRequest{
-				Signer:  "someone",
-				OrderId: 52,
+			expMsg: &exchange.MsgCreateTriggerBidRequest{
+				BidOrder: exchange.BidOrder{
+					MarketId:            4,
+					Buyer:               "someaddr",
+					Assets:              sdk.NewInt64Coin("apple", 10),
+					Price:               sdk.NewInt64Coin("plum", 55),
+					BuyerSettlementFees: sdk.Coins{sdk.Coin{Denom: "fig", Amount: sdkmath.NewInt(5)}},
+					AllowPartial:        true,
+					ExternalId:          "uuid",
+					Expiration:          &expiration,
+					DisplayAssets:       &sdk.Coin{Denom: "apple", Amount: sdkmath.NewInt(3)},
+					Referrer:            "refaddr",
+				},
+				TriggerPrice:     sdk.NewInt64Coin("plum", 50),
+				OrderCreationFee: &sdk.Coin{Denom: "grape", Amount: sdkmath.NewInt(6)},
 			},
 		},
 	}
@@ -359,67 +451,117 @@ func TestMakeMsgCancelOrder(t *testing.T) {
 	}
 }
 
-func TestSetupCmdTxFillBids(t *testing.T) {
+func TestSetupCmdTxCreateOrdersBatch(t *testing.T) {
 	runSetupTestCase(t, setupTestCase{
-		name:  "SetupCmdTxFillBids",
-		setup: cli.SetupCmdTxFillBids,
+		name:  "SetupCmdTxCreateOrdersBatch",
+		setup: cli.SetupCmdTxCreateOrdersBatch,
 		expFlags: []string{
-			cli.FlagSeller, cli.FlagMarket, cli.FlagAssets,
-			cli.FlagBids, cli.FlagSettlementFee, cli.FlagCreationFee,
+			cli.FlagOwner, cli.FlagMarket, cli.FlagAskOrder, cli.FlagBidOrder,
+			cli.FlagAskSettlementFee, cli.FlagBidSettlementFee, cli.FlagPartial, cli.FlagExpiration,
+			cli.FlagAskCreationFee, cli.FlagBidCreationFee,
 			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
 		},
 		expAnnotations: map[string]map[string][]string{
-			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagSeller}},
-			cli.FlagSeller: {oneReq: {flags.FlagFrom + " " + cli.FlagSeller}},
-			cli.FlagMarket: {required: {"true"}},
-			cli.FlagAssets: {required: {"true"}},
-			cli.FlagBids:   {required: {"true"}},
+			flags.FlagFrom:   {oneReq: {flags.FlagFrom + " " + cli.FlagOwner}},
+			cli.FlagOwner:    {oneReq: {flags.FlagFrom + " " + cli.FlagOwner}},
+			cli.FlagMarket:   {required: {"true"}},
+			cli.FlagAskOrder: {oneReq: {cli.FlagAskOrder + " " + cli.FlagBidOrder}},
+			cli.FlagBidOrder: {oneReq: {cli.FlagAskOrder + " " + cli.FlagBidOrder}},
 		},
 		expInUse: []string{
-			"{--from|--seller} <seller>", "--market <market id>", "--assets <total assets>",
-			"--bids <bid order ids>", "[--settlement-fee <seller settlement flat fee>]",
-			"[--creation-fee <ask order creation fee>]",
-			cli.ReqSignerDesc(cli.FlagSeller),
-			cli.RepeatableDesc,
+			"--owner", "--market <market id>",
+			"[--ask-order <order>]", "[--bid-order <order>]",
+			"[--ask-settlement-fee <seller settlement flat fee>]", "[--bid-settlement-fee <buyer settlement fees>]",
+			"[--partial]", "[--expiration <expiration>]",
+			"[--ask-creation-fee <ask creation fee>]", "[--bid-creation-fee <bid creation fee>]",
+			cli.ReqSignerDesc(cli.FlagOwner), cli.RepeatableDesc, cli.BatchOrderDesc,
 		},
 	})
 }
 
-func TestMakeMsgFillBids(t *testing.T) {
-	td := txMakerTestDef[*exchange.MsgFillBidsRequest]{
-		makerName: "MakeMsgFillBids",
-		maker:     cli.MakeMsgFillBids,
-		setup:     cli.SetupCmdTxFillBids,
+func TestMakeMsgCreateOrdersBatch(t *testing.T) {
+	expiration := time.Date(2030, 6, 7, 8, 9, 10, 0, time.UTC)
+	td := txMakerTestDef[*exchange.MsgCreateOrdersBatchRequest]{
+		makerName: "MakeMsgCreateOrdersBatch",
+		maker:     cli.MakeMsgCreateOrdersBatch,
+		setup:     cli.SetupCmdTxCreateOrdersBatch,
 	}
 
-	tests := []txMakerTestCase[*exchange.MsgFillBidsRequest]{
+	tests := []txMakerTestCase[*exchange.MsgCreateOrdersBatchRequest]{
 		{
-			name:      "some errors",
+			name:      "a couple errors",
 			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
-			flags:     []string{"--assets", "18", "--creation-fee", "apple"},
-			expMsg: &exchange.MsgFillBidsRequest{
-				Seller: sdk.AccAddress("FromAddress_________").String(),
+			flags:     []string{"--market", "3", "--ask-order", "10apple", "--bid-creation-fee", "123"},
+			expMsg: &exchange.MsgCreateOrdersBatchRequest{
+				Owner:    sdk.AccAddress("FromAddress_________").String(),
+				MarketId: 3,
 			},
 			expErr: joinErrs(
-				"error parsing --assets as coins: invalid coin expression: \"18\"",
-				"error parsing --creation-fee as a coin: invalid coin expression: \"apple\"",
+				"invalid net-asset-price \"10apple\": expected format <assets>:<price>",
+				"error parsing --bid-creation-fee as a coin: invalid coin expression: \"123\"",
 			),
 		},
 		{
-			name: "all the flags",
-			flags: []string{
-				"--market", "10", "--seller", "mike",
-				"--assets", "18acorn,5apple", "--bids", "83,52,99",
-				"--settlement-fee", "15fig", "--creation-fee", "9grape",
-				"--bids", "5",
+			name:  "only bids",
+			flags: []string{"--owner", "someaddr", "--market", "4", "--bid-order", "10apple:55plum"},
+			expMsg: &exchange.MsgCreateOrdersBatchRequest{
+				Owner:    "someaddr",
+				MarketId: 4,
+				BidOrders: []exchange.BidOrder{
+					{
+						MarketId: 4,
+						Buyer:    "someaddr",
+						Assets:   sdk.NewInt64Coin("apple", 10),
+						Price:    sdk.NewInt64Coin("plum", 55),
+					},
+				},
 			},
-			expMsg: &exchange.MsgFillBidsRequest{
-				Seller:                  "mike",
-				MarketId:                10,
-				TotalAssets:             sdk.NewCoins(sdk.NewInt64Coin("acorn", 18), sdk.NewInt64Coin("apple", 5)),
-				BidOrderIds:             []uint64{83, 52, 99, 5},
-				SellerSettlementFlatFee: &sdk.Coin{Denom: "fig", Amount: sdkmath.NewInt(15)},
-				AskOrderCreationFee:     &sdk.Coin{Denom: "grape", Amount: sdkmath.NewInt(9)},
+		},
+		{
+			name: "all fields",
+			flags: []string{
+				"--owner", "someaddr", "--market", "4",
+				"--ask-order", "10apple:60plum,5apple:35plum", "--bid-order", "10apple:55plum",
+				"--ask-settlement-fee", "3fig", "--bid-settlement-fee", "5fig",
+				"--partial", "--expiration", "2030-06-07T08:09:10Z",
+				"--ask-creation-fee", "6grape", "--bid-creation-fee", "7grape",
+			},
+			expMsg: &exchange.MsgCreateOrdersBatchRequest{
+				Owner:    "someaddr",
+				MarketId: 4,
+				AskOrders: []exchange.AskOrder{
+					{
+						MarketId:                4,
+						Seller:                  "someaddr",
+						Assets:                  sdk.NewInt64Coin("apple", 10),
+						Price:                   sdk.NewInt64Coin("plum", 60),
+						SellerSettlementFlatFee: &sdk.Coin{Denom: "fig", Amount: sdkmath.NewInt(3)},
+						AllowPartial:            true,
+						Expiration:              &expiration,
+					},
+					{
+						MarketId:                4,
+						Seller:                  "someaddr",
+						Assets:                  sdk.NewInt64Coin("apple", 5),
+						Price:                   sdk.NewInt64Coin("plum", 35),
+						SellerSettlementFlatFee: &sdk.Coin{Denom: "fig", Amount: sdkmath.NewInt(3)},
+						AllowPartial:            true,
+						Expiration:              &expiration,
+					},
+				},
+				BidOrders: []exchange.BidOrder{
+					{
+						MarketId:            4,
+						Buyer:               "someaddr",
+						Assets:              sdk.NewInt64Coin("apple", 10),
+						Price:               sdk.NewInt64Coin("plum", 55),
+						BuyerSettlementFees: sdk.Coins{sdk.Coin{Denom: "fig", Amount: sdkmath.NewInt(5)}},
+						AllowPartial:        true,
+						Expiration:          &expiration,
+					},
+				},
+				AskOrderCreationFee: &sdk.Coin{Denom: "grape", Amount: sdkmath.NewInt(6)},
+				BidOrderCreationFee: &sdk.Coin{Denom: "grape", Amount: sdkmath.NewInt(7)},
 			},
 		},
 	}
@@ -431,67 +573,63 @@ func TestMakeMsgFillBids(t *testing.T) {
 	}
 }
 
-func TestSetupCmdTxFillAsks(t *testing.T) {
+func TestSetupCmdTxCommitFunds(t *testing.T) {
 	runSetupTestCase(t, setupTestCase{
-		name:  "SetupCmdTxFillAsks",
-		setup: cli.SetupCmdTxFillAsks,
+		name:  "SetupCmdTxCommitFunds",
+		setup: cli.SetupCmdTxCommitFunds,
 		expFlags: []string{
-			cli.FlagBuyer, cli.FlagMarket, cli.FlagPrice,
-			cli.FlagAsks, cli.FlagSettlementFee, cli.FlagCreationFee,
+			cli.FlagAccount, cli.FlagMarket, cli.FlagAmount, cli.FlagCreationFee, cli.FlagTag, cli.FlagExpiration,
 			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
 		},
 		expAnnotations: map[string]map[string][]string{
-			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagBuyer}},
-			cli.FlagBuyer:  {oneReq: {flags.FlagFrom + " " + cli.FlagBuyer}},
-			cli.FlagMarket: {required: {"true"}},
-			cli.FlagPrice:  {required: {"true"}},
-			cli.FlagAsks:   {required: {"true"}},
+			flags.FlagFrom:  {oneReq: {flags.FlagFrom + " " + cli.FlagAccount}},
+			cli.FlagAccount: {oneReq: {flags.FlagFrom + " " + cli.FlagAccount}},
+			cli.FlagMarket:  {required: {"true"}},
+			cli.FlagAmount:  {required: {"true"}},
 		},
 		expInUse: []string{
-			"{--from|--buyer} <buyer>", "--market <market id>", "--price <total price>",
-			"--asks <ask order ids>", "[--settlement-fee <buyer settlement fees>]",
-			"[--creation-fee <bid order creation fee>]",
-			cli.ReqSignerDesc(cli.FlagBuyer),
-			cli.RepeatableDesc,
+			"--account", "--market <market id>", "--amount <amount>",
+			"[--creation-fee <creation fee>]", "[--tag <event tag>]", "[--expiration <expiration>]",
+			cli.ReqSignerDesc(cli.FlagAccount),
 		},
 	})
 }
 
-func TestMakeMsgFillAsks(t *testing.T) {
-	td := txMakerTestDef[*exchange.MsgFillAsksRequest]{
-		makerName: "MakeMsgFillAsks",
-		maker:     cli.MakeMsgFillAsks,
-		setup:     cli.SetupCmdTxFillAsks,
+func TestMakeMsgCommitFunds(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgCommitFundsRequest]{
+		makerName: "MakeMsgCommitFunds",
+		maker:     cli.MakeMsgCommitFunds,
+		setup:     cli.SetupCmdTxCommitFunds,
 	}
+	expiration := time.Date(2030, 6, 7, 8, 9, 10, 0, time.UTC)
 
-	tests := []txMakerTestCase[*exchange.MsgFillAsksRequest]{
+	tests := []txMakerTestCase[*exchange.MsgCommitFundsRequest]{
 		{
-			name:      "some errors",
+			name:      "a couple errors",
 			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
-			flags:     []string{"--price", "18", "--creation-fee", "apple"},
-			expMsg: &exchange.MsgFillAsksRequest{
-				Buyer: sdk.AccAddress("FromAddress_________").String(),
+			flags:     []string{"--amount", "nope", "--creation-fee", "123", "--expiration", "tomorrow"},
+			expMsg: &exchange.MsgCommitFundsRequest{
+				Account: sdk.AccAddress("FromAddress_________").String(),
 			},
 			expErr: joinErrs(
-				"error parsing --price as a coin: invalid coin expression: \"18\"",
-				"error parsing --creation-fee as a coin: invalid coin expression: \"apple\"",
+				"error parsing --amount as coins: invalid coin expression: \"nope\"",
+				"error parsing --creation-fee as a coin: invalid coin expression: \"123\"",
+				"error parsing --expiration as an RFC3339 time: parsing time \"tomorrow\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"tomorrow\" as \"2006\"",
 			),
 		},
 		{
-			name: "all the flags",
+			name: "all fields",
 			flags: []string{
-				"--market", "10", "--buyer", "george",
-				"--price", "23apple", "--asks", "41,12,77",
-				"--settlement-fee", "15fig", "--creation-fee", "9grape",
-				"--asks", "20", "--asks", "987,444,6",
+				"--account", "someaddr", "--market", "4", "--amount", "10apple",
+				"--tag", "atagofsomesort", "--creation-fee", "6grape", "--expiration", "2030-06-07T08:09:10Z",
 			},
-			expMsg: &exchange.MsgFillAsksRequest{
-				Buyer:               "george",
-				MarketId:            10,
-				TotalPrice:          sdk.NewInt64Coin("apple", 23),
-				AskOrderIds:         []uint64{41, 12, 77, 20, 987, 444, 6},
-				BuyerSettlementFees: sdk.NewCoins(sdk.NewInt64Coin("fig", 15)),
-				BidOrderCreationFee: &sdk.Coin{Denom: "grape", Amount: sdkmath.NewInt(9)},
+			expMsg: &exchange.MsgCommitFundsRequest{
+				Account:     "someaddr",
+				MarketId:    4,
+				Amount:      sdk.NewCoins(sdk.NewInt64Coin("apple", 10)),
+				CreationFee: &sdk.Coin{Denom: "grape", Amount: sdkmath.NewInt(6)},
+				EventTag:    "atagofsomesort",
+				Expiration:  &expiration,
 			},
 		},
 	}
@@ -503,88 +641,59 @@ func TestMakeMsgFillAsks(t *testing.T) {
 	}
 }
 
-func TestSetupCmdTxMarketSettle(t *testing.T) {
+func TestSetupCmdTxTransferCommitment(t *testing.T) {
 	runSetupTestCase(t, setupTestCase{
-		name:  "SetupCmdTxMarketSettle",
-		setup: cli.SetupCmdTxMarketSettle,
+		name:  "SetupCmdTxTransferCommitment",
+		setup: cli.SetupCmdTxTransferCommitment,
 		expFlags: []string{
-			cli.FlagAdmin, cli.FlagAuthority,
-			cli.FlagMarket, cli.FlagAsks, cli.FlagBids, cli.FlagPartial,
+			cli.FlagAccount, cli.FlagAmount, cli.FlagCurrentMarket, cli.FlagNewMarket, cli.FlagTag,
 			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
 		},
 		expAnnotations: map[string]map[string][]string{
-			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
-			cli.FlagAdmin: {
-				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
-				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
-			},
-			cli.FlagAuthority: {
-				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
-				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
-			},
-			cli.FlagMarket: {required: {"true"}},
-			cli.FlagAsks:   {required: {"true"}},
-			cli.FlagBids:   {required: {"true"}},
+			flags.FlagFrom:        {oneReq: {flags.FlagFrom + " " + cli.FlagAccount}},
+			cli.FlagAccount:       {oneReq: {flags.FlagFrom + " " + cli.FlagAccount}},
+			cli.FlagAmount:        {required: {"true"}},
+			cli.FlagCurrentMarket: {required: {"true"}},
+			cli.FlagNewMarket:     {required: {"true"}},
 		},
 		expInUse: []string{
-			cli.ReqAdminUse, "--market <market id>",
-			"--asks <ask order ids>", "--bids <bid order ids>",
-			"[--partial]",
-			cli.ReqAdminDesc, cli.RepeatableDesc,
+			"--account", "--amount <amount>", "--current-market <current market id>", "--new-market <new market id>",
+			"[--tag <event tag>]",
+			cli.ReqSignerDesc(cli.FlagAccount),
 		},
 	})
 }
 
-func TestMakeMsgMarketSettle(t *testing.T) {
-	td := txMakerTestDef[*exchange.MsgMarketSettleRequest]{
-		makerName: "MakeMsgMarketSettle",
-		maker:     cli.MakeMsgMarketSettle,
-		setup:     cli.SetupCmdTxMarketSettle,
+func TestMakeMsgTransferCommitment(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgTransferCommitmentRequest]{
+		makerName: "MakeMsgTransferCommitment",
+		maker:     cli.MakeMsgTransferCommitment,
+		setup:     cli.SetupCmdTxTransferCommitment,
 	}
 
-	tests := []txMakerTestCase[*exchange.MsgMarketSettleRequest]{
-		{
-			name:  "no admin",
-			flags: []string{"--asks", "7", "--bids", "8", "--partial"},
-			expMsg: &exchange.MsgMarketSettleRequest{
-				AskOrderIds:   []uint64{7},
-				BidOrderIds:   []uint64{8},
-				ExpectPartial: true,
-			},
-			expErr: "no <admin> provided",
-		},
+	tests := []txMakerTestCase[*exchange.MsgTransferCommitmentRequest]{
 		{
-			name:      "from",
+			name:      "some errors",
 			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
-			flags: []string{
-				"--asks", "15,16,17", "--market", "52", "--bids", "51,52,53",
-				"--asks", "8", "--bids", "9"},
-			expMsg: &exchange.MsgMarketSettleRequest{
-				Admin:       sdk.AccAddress("FromAddress_________").String(),
-				MarketId:    52,
-				AskOrderIds: []uint64{15, 16, 17, 8},
-				BidOrderIds: []uint64{51, 52, 53, 9},
+			flags:     []string{"--amount", "bill", "--current-market", "5"},
+			expMsg: &exchange.MsgTransferCommitmentRequest{
+				Account:         sdk.AccAddress("FromAddress_________").String(),
+				CurrentMarketId: 5,
 			},
+			expErr: "error parsing --amount as coins: invalid coin expression: \"bill\"",
 		},
 		{
-			name:  "authority",
-			flags: []string{"--market", "52", "--asks", "91", "--bids", "12,13", "--authority", "--partial"},
-			expMsg: &exchange.MsgMarketSettleRequest{
-				Admin:         cli.AuthorityAddr.String(),
-				MarketId:      52,
-				AskOrderIds:   []uint64{91},
-				BidOrderIds:   []uint64{12, 13},
-				ExpectPartial: true,
+			name: "all fields",
+			flags: []string{
+				"--account", "samantha", "--amount", "52plum", "--current-market", "2", "--new-market", "4",
+				"--tag", "moveit",
 			},
-		},
-		{
-			name:  "admin",
-			flags: []string{"--market", "14", "--admin", "bob", "--asks", "1,2,3", "--bids", "5"},
-			expMsg: &exchange.MsgMarketSettleRequest{
-				Admin:       "bob",
-				MarketId:    14,
-				AskOrderIds: []uint64{1, 2, 3},
-				BidOrderIds: []uint64{5},
+			expMsg: &exchange.MsgTransferCommitmentRequest{
+				Account:         "samantha",
+				Amount:          sdk.NewCoins(sdk.NewInt64Coin("plum", 52)),
+				CurrentMarketId: 2,
+				NewMarketId:     4,
+				EventTag:        "moveit",
 			},
 		},
 	}
@@ -596,161 +705,52 @@ func TestMakeMsgMarketSettle(t *testing.T) {
 	}
 }
 
-func TestSetupCmdTxMarketCommitmentSettle(t *testing.T) {
+func TestSetupCmdTxFundCommitmentRewards(t *testing.T) {
 	runSetupTestCase(t, setupTestCase{
-		name:  "SetupCmdTxMarketCommitmentSettle",
-		setup: cli.SetupCmdTxMarketCommitmentSettle,
+		name:  "SetupCmdTxFundCommitmentRewards",
+		setup: cli.SetupCmdTxFundCommitmentRewards,
 		expFlags: []string{
-			cli.FlagAdmin, cli.FlagAuthority,
-			cli.FlagMarket, cli.FlagInputs, cli.FlagOutputs,
-			cli.FlagSettlementFees, cli.FlagNavs, cli.FlagTag, cli.FlagFile,
+			cli.FlagSigner, cli.FlagMarket, cli.FlagAmount,
 			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
 		},
 		expAnnotations: map[string]map[string][]string{
-			flags.FlagFrom: {oneReq: {cli.FlagFile + " " + flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
-			cli.FlagAdmin: {
-				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
-				oneReq: {cli.FlagFile + " " + flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
-			},
-			cli.FlagAuthority: {
-				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
-				oneReq: {cli.FlagFile + " " + flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
-			},
-			cli.FlagFile: {oneReq: {
-				cli.FlagFile + " " + flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority,
-				cli.FlagFile + " " + cli.FlagMarket,
-			}},
-			cli.FlagMarket: {oneReq: {cli.FlagFile + " " + cli.FlagMarket}},
+			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagSigner}},
+			cli.FlagSigner: {oneReq: {flags.FlagFrom + " " + cli.FlagSigner}},
+			cli.FlagMarket: {required: {"true"}},
+			cli.FlagAmount: {required: {"true"}},
 		},
 		expInUse: []string{
-			cli.ReqAdminUse, "[--market <market id>]",
-			"[--inputs <account-amount>]", "[--outputs <account-amount>]",
-			"[--settlement-fees <account-amount>]", "[--navs <nav>]", "[--tag <event tag>]",
-			"[--file <filename>]",
-			cli.ReqAdminDesc, cli.RepeatableDesc, cli.AccountAmountDesc, cli.NAVDesc,
-			cli.MsgFileDesc(&exchange.MsgMarketCommitmentSettleRequest{}),
+			"{--from|--signer} <signer>", "--market <market id>", "--amount <amount>",
+			cli.ReqSignerDesc(cli.FlagSigner),
 		},
 	})
 }
 
-func TestMakeMsgMarketCommitmentSettle(t *testing.T) {
-	td := txMakerTestDef[*exchange.MsgMarketCommitmentSettleRequest]{
-		makerName: "MakeMsgMarketCommitmentSettle",
-		maker:     cli.MakeMsgMarketCommitmentSettle,
-		setup:     cli.SetupCmdTxMarketCommitmentSettle,
-	}
-
-	tdir := t.TempDir()
-	filename := filepath.Join(tdir, "commitment-settle.json")
-	fileMsg := &exchange.MsgMarketCommitmentSettleRequest{
-		Admin:    sdk.AccAddress("msg_admin___________").String(),
-		MarketId: 4,
-		Inputs:   []exchange.AccountAmount{{Account: "devin", Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 10))}},
-		Outputs:  []exchange.AccountAmount{{Account: "parker", Amount: sdk.NewCoins(sdk.NewInt64Coin("peach", 11))}},
-		Fees:     []exchange.AccountAmount{{Account: "tracey", Amount: sdk.NewCoins(sdk.NewInt64Coin("fig", 4))}},
-		Navs:     []exchange.NetAssetPrice{{Assets: sdk.NewInt64Coin("acorn", 44), Price: sdk.NewInt64Coin("pear", 7)}},
-		EventTag: "the-msg-event-tag",
+func TestMakeMsgFundCommitmentRewards(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgFundCommitmentRewardsRequest]{
+		makerName: "MakeMsgFundCommitmentRewards",
+		maker:     cli.MakeMsgFundCommitmentRewards,
+		setup:     cli.SetupCmdTxFundCommitmentRewards,
 	}
-	tx := newTx(t, fileMsg)
-	writeFileAsJson(t, filename, tx)
 
-	tests := []txMakerTestCase[*exchange.MsgMarketCommitmentSettleRequest]{
-		{
-			name:   "no flags",
-			expMsg: &exchange.MsgMarketCommitmentSettleRequest{},
-			expErr: "no <admin> provided",
-		},
+	tests := []txMakerTestCase[*exchange.MsgFundCommitmentRewardsRequest]{
 		{
-			name:      "admin from from",
+			name:      "some errors",
 			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
-			expMsg: &exchange.MsgMarketCommitmentSettleRequest{
-				Admin: sdk.AccAddress("FromAddress_________").String(),
-			},
-		},
-		{
-			name:   "admin from flag",
-			flags:  []string{"--admin", "kelly"},
-			expMsg: &exchange.MsgMarketCommitmentSettleRequest{Admin: "kelly"},
-		},
-		{
-			name:  "admin as authority",
-			flags: []string{"--authority"},
-			expMsg: &exchange.MsgMarketCommitmentSettleRequest{
-				Admin: cli.AuthorityAddr.String(),
-			},
-		},
-		{
-			name: "bad inputs outputs fees and navs",
-			flags: []string{
-				"--admin", "sam",
-				"--inputs", "10nhash", "--outputs", "addr3",
-				"--settlement-fees", "42cherry", "--navs", "18banana",
-			},
-			expMsg: &exchange.MsgMarketCommitmentSettleRequest{Admin: "sam"},
-			expErr: joinErrs(
-				"invalid account-amount \"10nhash\": expected format <account>:<amount>",
-				"invalid account-amount \"addr3\": expected format <account>:<amount>",
-				"invalid account-amount \"42cherry\": expected format <account>:<amount>",
-				"invalid net-asset-price \"18banana\": expected format <assets>:<price>",
-			),
-		},
-		{
-			name: "all provided",
-			flags: []string{
-				"--authority", "--market", "18", "--tag", "thing-4DE17436",
-				"--inputs", "addr1:10nhash,5cherry,addr2:12nhash,addr3:4nhash,10cherry",
-				"--settlement-fees", "addr7:10apple,1prune",
-				"--outputs", "addr4:26nhash,1cherry",
-				"--settlement-fees", "addr6:8apple",
-				"--navs", "8apple:10cherry,10apple:3nhash",
-				"--outputs", "addr5:14cherry",
-				"--navs", "4cherry:15nhash",
-			},
-			expMsg: &exchange.MsgMarketCommitmentSettleRequest{
-				Admin:    cli.AuthorityAddr.String(),
-				MarketId: 18,
-				Inputs: []exchange.AccountAmount{
-					{Account: "addr1", Amount: sdk.NewCoins(sdk.NewInt64Coin("nhash", 10), sdk.NewInt64Coin("cherry", 5))},
-					{Account: "addr2", Amount: sdk.NewCoins(sdk.NewInt64Coin("nhash", 12))},
-					{Account: "addr3", Amount: sdk.NewCoins(sdk.NewInt64Coin("nhash", 4), sdk.NewInt64Coin("cherry", 10))},
-				},
-				Outputs: []exchange.AccountAmount{
-					{Account: "addr4", Amount: sdk.NewCoins(sdk.NewInt64Coin("nhash", 26), sdk.NewInt64Coin("cherry", 1))},
-					{Account: "addr5", Amount: sdk.NewCoins(sdk.NewInt64Coin("cherry", 14))},
-				},
-				Fees: []exchange.AccountAmount{
-					{Account: "addr7", Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 10), sdk.NewInt64Coin("prune", 1))},
-					{Account: "addr6", Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 8))},
-				},
-				Navs: []exchange.NetAssetPrice{
-					{Assets: sdk.NewInt64Coin("apple", 8), Price: sdk.NewInt64Coin("cherry", 10)},
-					{Assets: sdk.NewInt64Coin("apple", 10), Price: sdk.NewInt64Coin("nhash", 3)},
-					{Assets: sdk.NewInt64Coin("cherry", 4), Price: sdk.NewInt64Coin("nhash", 15)},
-				},
-				EventTag: "thing-4DE17436",
+			flags:     []string{"--market", "3"},
+			expMsg: &exchange.MsgFundCommitmentRewardsRequest{
+				Funder:   sdk.AccAddress("FromAddress_________").String(),
+				MarketId: 3,
 			},
+			expErr: "missing required --amount flag",
 		},
 		{
-			name:      "from file",
-			clientCtx: newClientContext(t),
-			flags:     []string{"--file", filename},
-			expMsg:    fileMsg,
-		},
-		{
-			name: "file with overrides",
-			flags: []string{
-				"--file", filename, "--tag", "new-thang", "--authority",
-				"--outputs", "monroe:87plum",
-			},
-			clientCtx: newClientContext(t),
-			expMsg: &exchange.MsgMarketCommitmentSettleRequest{
-				Admin:    cli.AuthorityAddr.String(),
-				MarketId: 4,
-				Inputs:   []exchange.AccountAmount{{Account: "devin", Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 10))}},
-				Outputs:  []exchange.AccountAmount{{Account: "monroe", Amount: sdk.NewCoins(sdk.NewInt64Coin("plum", 87))}},
-				Fees:     []exchange.AccountAmount{{Account: "tracey", Amount: sdk.NewCoins(sdk.NewInt64Coin("fig", 4))}},
-				Navs:     []exchange.NetAssetPrice{{Assets: sdk.NewInt64Coin("acorn", 44), Price: sdk.NewInt64Coin("pear", 7)}},
-				EventTag: "new-thang",
+			name:  "all fields",
+			flags: []string{"--signer", "someone", "--market", "8", "--amount", "15cherry"},
+			expMsg: &exchange.MsgFundCommitmentRewardsRequest{
+				Funder:   "someone",
+				MarketId: 8,
+				Amount:   sdk.NewCoins(sdk.NewInt64Coin("cherry", 15)),
 			},
 		},
 	}
@@ -762,116 +762,54 @@ func TestMakeMsgMarketCommitmentSettle(t *testing.T) {
 	}
 }
 
-func TestSetupCmdTxMarketReleaseCommitments(t *testing.T) {
+func TestSetupCmdTxClaimCommitmentRewards(t *testing.T) {
 	runSetupTestCase(t, setupTestCase{
-		name:  "SetupCmdTxMarketReleaseCommitments",
-		setup: cli.SetupCmdTxMarketReleaseCommitments,
+		name:  "SetupCmdTxClaimCommitmentRewards",
+		setup: cli.SetupCmdTxClaimCommitmentRewards,
 		expFlags: []string{
-			cli.FlagAdmin, cli.FlagAuthority,
-			cli.FlagMarket, cli.FlagRelease, cli.FlagReleaseAll, cli.FlagTag,
+			cli.FlagAccount, cli.FlagMarket,
 			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
 		},
 		expAnnotations: map[string]map[string][]string{
-			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
-			cli.FlagAdmin: {
-				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
-				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
-			},
-			cli.FlagAuthority: {
-				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
-				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
-			},
-			cli.FlagMarket:     {required: {"true"}},
-			cli.FlagRelease:    {oneReq: {cli.FlagRelease + " " + cli.FlagReleaseAll}},
-			cli.FlagReleaseAll: {oneReq: {cli.FlagRelease + " " + cli.FlagReleaseAll}},
+			flags.FlagFrom:  {oneReq: {flags.FlagFrom + " " + cli.FlagAccount}},
+			cli.FlagAccount: {oneReq: {flags.FlagFrom + " " + cli.FlagAccount}},
+			cli.FlagMarket:  {required: {"true"}},
 		},
 		expInUse: []string{
-			cli.ReqAdminUse, "--market <market id>",
-			"[--release <account-amount>]", "[--release-all <account>]", "[--tag <event tag>]",
-			cli.ReqAdminUse,
-			"At least one of --release and/or --release-all must be provided",
-			cli.RepeatableDesc, cli.AccountAmountDesc,
+			"{--from|--account} <account>", "--market <market id>",
+			cli.ReqSignerDesc(cli.FlagAccount),
 		},
 	})
 }
 
-func TestMakeMsgMarketReleaseCommitments(t *testing.T) {
-	td := txMakerTestDef[*exchange.MsgMarketReleaseCommitmentsRequest]{
-		makerName: "MakeMsgMarketReleaseCommitments",
-		maker:     cli.MakeMsgMarketReleaseCommitments,
-		setup:     cli.SetupCmdTxMarketReleaseCommitments,
+func TestMakeMsgClaimCommitmentRewards(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgClaimCommitmentRewardsRequest]{
+		makerName: "MakeMsgClaimCommitmentRewards",
+		maker:     cli.MakeMsgClaimCommitmentRewards,
+		setup:     cli.SetupCmdTxClaimCommitmentRewards,
 	}
 
-	tests := []txMakerTestCase[*exchange.MsgMarketReleaseCommitmentsRequest]{
+	tests := []txMakerTestCase[*exchange.MsgClaimCommitmentRewardsRequest]{
 		{
 			name:   "nothing",
-			expMsg: &exchange.MsgMarketReleaseCommitmentsRequest{},
-			expErr: "no <admin> provided",
+			expMsg: &exchange.MsgClaimCommitmentRewardsRequest{},
+			expErr: "no <account> provided",
 		},
 		{
-			name:      "admin from from",
+			name:      "account from from",
 			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
-			expMsg: &exchange.MsgMarketReleaseCommitmentsRequest{
-				Admin: sdk.AccAddress("FromAddress_________").String(),
-			},
-		},
-		{
-			name:  "admin from flag",
-			flags: []string{"--admin", "forest"},
-			expMsg: &exchange.MsgMarketReleaseCommitmentsRequest{
-				Admin: "forest",
-			},
-		},
-		{
-			name:  "authority",
-			flags: []string{"--authority"},
-			expMsg: &exchange.MsgMarketReleaseCommitmentsRequest{
-				Admin: cli.AuthorityAddr.String(),
+			flags:     []string{"--market", "4"},
+			expMsg: &exchange.MsgClaimCommitmentRewardsRequest{
+				Account:  sdk.AccAddress("FromAddress_________").String(),
+				MarketId: 4,
 			},
 		},
 		{
-			name:      "only release",
-			flags:     []string{"--release", "addr1:10cherry,addr2:5apple"},
-			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
-			expMsg: &exchange.MsgMarketReleaseCommitmentsRequest{
-				Admin: sdk.AccAddress("FromAddress_________").String(),
-				ToRelease: []exchange.AccountAmount{
-					{Account: "addr1", Amount: sdk.NewCoins(sdk.NewInt64Coin("cherry", 10))},
-					{Account: "addr2", Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 5))},
-				},
-			},
-		},
-		{
-			name:      "only release-all",
-			flags:     []string{"--release-all", "addr1,addr2"},
-			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
-			expMsg: &exchange.MsgMarketReleaseCommitmentsRequest{
-				Admin: sdk.AccAddress("FromAddress_________").String(),
-				ToRelease: []exchange.AccountAmount{
-					{Account: "addr1", Amount: nil},
-					{Account: "addr2", Amount: nil},
-				},
-			},
-		},
-		{
-			name: "everything",
-			flags: []string{
-				"--admin", "charly", "--market", "4", "--tag", "someragtag",
-				"--release", "addr1:4apple,5banana",
-				"--release-all", "addr2,addr3",
-				"--release", "addr4:10cherry,addr5:8date",
-			},
-			expMsg: &exchange.MsgMarketReleaseCommitmentsRequest{
-				Admin:    "charly",
-				MarketId: 4,
-				ToRelease: []exchange.AccountAmount{
-					{Account: "addr1", Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 4), sdk.NewInt64Coin("banana", 5))},
-					{Account: "addr4", Amount: sdk.NewCoins(sdk.NewInt64Coin("cherry", 10))},
-					{Account: "addr5", Amount: sdk.NewCoins(sdk.NewInt64Coin("date", 8))},
-					{Account: "addr2", Amount: nil},
-					{Account: "addr3", Amount: nil},
-				},
-				EventTag: "someragtag",
+			name:  "all fields",
+			flags: []string{"--account", "claimer", "--market", "2"},
+			expMsg: &exchange.MsgClaimCommitmentRewardsRequest{
+				Account:  "claimer",
+				MarketId: 2,
 			},
 		},
 	}
@@ -883,91 +821,148 @@ func TestMakeMsgMarketReleaseCommitments(t *testing.T) {
 	}
 }
 
-func TestSetupCmdTxMarketTransferCommitments(t *testing.T) {
+func TestSetupCmdTxCancelOrder(t *testing.T) {
 	runSetupTestCase(t, setupTestCase{
-		name:  "SetupCmdTxMarketTransferCommitments",
-		setup: cli.SetupCmdTxMarketTransferCommitment,
+		name:  "SetupCmdTxCancelOrder",
+		setup: cli.SetupCmdTxCancelOrder,
 		expFlags: []string{
-			cli.FlagAdmin, cli.FlagAuthority,
-			cli.FlagAccount, cli.FlagAmount, cli.FlagCurrentMarket, cli.FlagNewMarket,
+			cli.FlagSigner, cli.FlagOrder,
 			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
 		},
 		expAnnotations: map[string]map[string][]string{
-			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
-			cli.FlagAdmin: {
-				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
-				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
-			},
-			cli.FlagAuthority: {
-				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
-				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
-			},
-			cli.FlagAccount:       {required: {"true"}},
-			cli.FlagAmount:        {required: {"true"}},
-			cli.FlagCurrentMarket: {required: {"true"}},
-			cli.FlagNewMarket:     {required: {"true"}},
+			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagSigner}},
+			cli.FlagSigner: {oneReq: {flags.FlagFrom + " " + cli.FlagSigner}},
 		},
 		expInUse: []string{
-			cli.ReqAdminDesc,
-			cli.ReqAdminUse, "--account <account>", "--amount <amount>", "--current-market <current market id>", "--new-market <new market id>", "[--tag <event tag>]",
+			"{<order id>|--order <order id>}",
+			"{--from|--signer} <signer>",
+			cli.ReqSignerDesc(cli.FlagSigner),
+			"The <order id> must be provided either as the first argument or using the --order flag, but not both.",
 		},
 	})
 }
 
-func TestMakeMsgMarketTransferCommitments(t *testing.T) {
-	td := txMakerTestDef[*exchange.MsgMarketTransferCommitmentRequest]{
-		makerName: "MakeMsgMarketTransferCommitments",
-		maker:     cli.MakeMsgMarketTransferCommitment,
-		setup:     cli.SetupCmdTxMarketTransferCommitment,
+func TestMakeMsgCancelOrder(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgCancelOrderRequest]{
+		makerName: "MakeMsgCancelOrder",
+		maker:     cli.MakeMsgCancelOrder,
+		setup:     cli.SetupCmdTxCancelOrder,
 	}
 
-	tests := []txMakerTestCase[*exchange.MsgMarketTransferCommitmentRequest]{
+	tests := []txMakerTestCase[*exchange.MsgCancelOrderRequest]{
 		{
 			name:   "nothing",
-			expMsg: &exchange.MsgMarketTransferCommitmentRequest{},
-			expErr: "no <admin> provided",
+			expMsg: &exchange.MsgCancelOrderRequest{},
+			expErr: joinErrs(
+				"no <signer> provided",
+				"no <order id> provided",
+			),
 		},
 		{
-			name:      "admin from from",
+			name:      "from and arg",
 			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
-			expMsg: &exchange.MsgMarketTransferCommitmentRequest{
-				Admin: sdk.AccAddress("FromAddress_________").String(),
+			args:      []string{"87"},
+			expMsg: &exchange.MsgCancelOrderRequest{
+				Signer:  sdk.AccAddress("FromAddress_________").String(),
+				OrderId: 87,
 			},
 		},
 		{
-			name:  "admin from flag",
-			flags: []string{"--admin", "forest"},
-			expMsg: &exchange.MsgMarketTransferCommitmentRequest{
-				Admin: "forest",
+			name:  "signer and flag",
+			flags: []string{"--order", "52", "--signer", "someone"},
+			expMsg: &exchange.MsgCancelOrderRequest{
+				Signer:  "someone",
+				OrderId: 52,
 			},
 		},
-		{
-			name:  "authority",
-			flags: []string{"--authority"},
-			expMsg: &exchange.MsgMarketTransferCommitmentRequest{
-				Admin: cli.AuthorityAddr.String(),
-			},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdTxAmendOrder(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdTxAmendOrder",
+		setup: cli.SetupCmdTxAmendOrder,
+		expFlags: []string{
+			cli.FlagOwner, cli.FlagMarket, cli.FlagOrder, cli.FlagAssets, cli.FlagPrice,
+			cli.FlagAskSettlementFee, cli.FlagBidSettlementFee, cli.FlagPartial,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			flags.FlagFrom:           {oneReq: {flags.FlagFrom + " " + cli.FlagOwner}},
+			cli.FlagOwner:            {oneReq: {flags.FlagFrom + " " + cli.FlagOwner}},
+			cli.FlagMarket:           {required: {"true"}},
+			cli.FlagAssets:           {required: {"true"}},
+			cli.FlagPrice:            {required: {"true"}},
+			cli.FlagAskSettlementFee: {mutExc: {cli.FlagAskSettlementFee + " " + cli.FlagBidSettlementFee}},
+			cli.FlagBidSettlementFee: {mutExc: {cli.FlagAskSettlementFee + " " + cli.FlagBidSettlementFee}},
+		},
+		expInUse: []string{
+			"{<order id>|--order <order id>}",
+			"{--from|--owner} <owner>", "--market <market id>",
+			"--assets <assets>", "--price <price>",
+			"[--ask-settlement-fee <seller settlement flat fee>]",
+			"[--bid-settlement-fee <buyer settlement fees>]",
+			"[--partial]",
+			cli.ReqSignerDesc(cli.FlagOwner),
+			"The <order id> must be provided either as the first argument or using the --order flag, but not both.",
+			"The --ask-settlement-fee can only be used with ask orders, and the --bid-settlement-fee can only be used with bid orders.",
 		},
+	})
+}
+
+func TestMakeMsgAmendOrder(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgAmendOrderRequest]{
+		makerName: "MakeMsgAmendOrder",
+		maker:     cli.MakeMsgAmendOrder,
+		setup:     cli.SetupCmdTxAmendOrder,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgAmendOrderRequest]{
 		{
-			name:  "some errors",
-			flags: []string{"--account", "annie", "--amount", "bill", "--current-market", "5", "--new-market", "0"},
-			expMsg: &exchange.MsgMarketTransferCommitmentRequest{
-				Admin: "", Account: "annie", Amount: nil, CurrentMarketId: 5, NewMarketId: 0},
+			name:   "nothing",
+			expMsg: &exchange.MsgAmendOrderRequest{},
 			expErr: joinErrs(
-				"no <admin> provided",
-				"error parsing --amount as coins: invalid coin expression: \"bill\"",
+				"no <owner> provided",
+				"no <order id> provided",
+				"missing required --assets flag",
+				"missing required --price flag",
 			),
 		},
 		{
-			name:      "all fields",
+			name:      "from and arg with ask fee",
 			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
-			flags:     []string{"--account", "samantha", "--amount", "52plum", "--current-market", "2", "--new-market", "4"},
-			expMsg: &exchange.MsgMarketTransferCommitmentRequest{
-				Admin:           sdk.AccAddress("FromAddress_________").String(),
-				Account:         "samantha",
-				Amount:          sdk.NewCoins(sdk.NewInt64Coin("plum", 52)),
-				CurrentMarketId: 2,
-				NewMarketId:     4,
+			flags:     []string{"--market", "3", "--assets", "10apple", "--price", "55plum", "--ask-settlement-fee", "5fig"},
+			args:      []string{"87"},
+			expMsg: &exchange.MsgAmendOrderRequest{
+				Owner:                   sdk.AccAddress("FromAddress_________").String(),
+				MarketId:                3,
+				OrderId:                 87,
+				Assets:                  sdk.NewInt64Coin("apple", 10),
+				Price:                   sdk.NewInt64Coin("plum", 55),
+				SellerSettlementFlatFee: &sdk.Coin{Denom: "fig", Amount: sdkmath.NewInt(5)},
+			},
+		},
+		{
+			name: "owner and flag with bid fees",
+			flags: []string{
+				"--owner", "someone", "--market", "7", "--order", "52",
+				"--assets", "10apple", "--price", "55plum",
+				"--bid-settlement-fee", "5fig,1plum", "--partial",
+			},
+			expMsg: &exchange.MsgAmendOrderRequest{
+				Owner:               "someone",
+				MarketId:            7,
+				OrderId:             52,
+				Assets:              sdk.NewInt64Coin("apple", 10),
+				Price:               sdk.NewInt64Coin("plum", 55),
+				AllowPartial:        true,
+				BuyerSettlementFees: sdk.NewCoins(sdk.NewInt64Coin("fig", 5), sdk.NewInt64Coin("plum", 1)),
 			},
 		},
 	}
@@ -979,69 +974,62 @@ func TestMakeMsgMarketTransferCommitments(t *testing.T) {
 	}
 }
 
-func TestSetupCmdTxMarketSetOrderExternalID(t *testing.T) {
+func TestSetupCmdTxLinkOrders(t *testing.T) {
 	runSetupTestCase(t, setupTestCase{
-		name:  "SetupCmdTxMarketSetOrderExternalID",
-		setup: cli.SetupCmdTxMarketSetOrderExternalID,
+		name:  "SetupCmdTxLinkOrders",
+		setup: cli.SetupCmdTxLinkOrders,
 		expFlags: []string{
-			cli.FlagAdmin, cli.FlagAuthority,
-			cli.FlagMarket, cli.FlagOrder, cli.FlagExternalID,
+			cli.FlagOwner, cli.FlagMarket, cli.FlagOrder, cli.FlagLinkedOrder,
 			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
 		},
 		expAnnotations: map[string]map[string][]string{
-			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
-			cli.FlagAdmin: {
-				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
-				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
-			},
-			cli.FlagAuthority: {
-				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
-				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
-			},
-			cli.FlagMarket: {required: {"true"}},
-			cli.FlagOrder:  {required: {"true"}},
+			flags.FlagFrom:      {oneReq: {flags.FlagFrom + " " + cli.FlagOwner}},
+			cli.FlagOwner:       {oneReq: {flags.FlagFrom + " " + cli.FlagOwner}},
+			cli.FlagMarket:      {required: {"true"}},
+			cli.FlagOrder:       {required: {"true"}},
+			cli.FlagLinkedOrder: {required: {"true"}},
 		},
 		expInUse: []string{
-			cli.ReqAdminUse, "--market <market id>", "--order <order id>",
-			"[--external-id <external id>]",
-			cli.ReqAdminDesc,
+			"{--from|--owner} <owner>", "--market <market id>",
+			"--order <order id>", "--linked-order <linked order id>",
+			cli.ReqSignerDesc(cli.FlagOwner),
+			"Once linked, when either order is filled (fully or partially) or cancelled, the other is cancelled.",
 		},
 	})
 }
 
-func TestMakeMsgMarketSetOrderExternalID(t *testing.T) {
-	td := txMakerTestDef[*exchange.MsgMarketSetOrderExternalIDRequest]{
-		makerName: "MakeMsgMarketSetOrderExternalID",
-		maker:     cli.MakeMsgMarketSetOrderExternalID,
-		setup:     cli.SetupCmdTxMarketSetOrderExternalID,
+func TestMakeMsgLinkOrders(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgLinkOrdersRequest]{
+		makerName: "MakeMsgLinkOrders",
+		maker:     cli.MakeMsgLinkOrders,
+		setup:     cli.SetupCmdTxLinkOrders,
 	}
 
-	tests := []txMakerTestCase[*exchange.MsgMarketSetOrderExternalIDRequest]{
+	tests := []txMakerTestCase[*exchange.MsgLinkOrdersRequest]{
 		{
-			name:  "no admin",
-			flags: []string{"--market", "8", "--order", "7", "--external-id", "markus"},
-			expMsg: &exchange.MsgMarketSetOrderExternalIDRequest{
-				MarketId: 8, OrderId: 7, ExternalId: "markus",
-			},
-			expErr: "no <admin> provided",
+			name:   "nothing",
+			expMsg: &exchange.MsgLinkOrdersRequest{},
+			expErr: "no <owner> provided",
 		},
 		{
-			name:      "no external id",
+			name:      "from",
 			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
-			flags:     []string{"--market", "4000", "--order", "9001"},
-			expMsg: &exchange.MsgMarketSetOrderExternalIDRequest{
-				Admin:    sdk.AccAddress("FromAddress_________").String(),
-				MarketId: 4000, OrderId: 9001, ExternalId: "",
+			flags:     []string{"--market", "3", "--order", "87", "--linked-order", "88"},
+			expMsg: &exchange.MsgLinkOrdersRequest{
+				Owner:         sdk.AccAddress("FromAddress_________").String(),
+				MarketId:      3,
+				OrderId:       87,
+				LinkedOrderId: 88,
 			},
 		},
 		{
-			name: "all the flags",
-			flags: []string{
-				"--market", "5", "--order", "100000000000001",
-				"--external-id", "one", "--admin", "michelle",
-			},
-			expMsg: &exchange.MsgMarketSetOrderExternalIDRequest{
-				Admin: "michelle", MarketId: 5, OrderId: 100000000000001, ExternalId: "one",
+			name:  "owner",
+			flags: []string{"--owner", "someone", "--linked-order", "4", "--order", "52", "--market", "7"},
+			expMsg: &exchange.MsgLinkOrdersRequest{
+				Owner:         "someone",
+				MarketId:      7,
+				OrderId:       52,
+				LinkedOrderId: 4,
 			},
 		},
 	}
@@ -1053,65 +1041,55 @@ func TestMakeMsgMarketSetOrderExternalID(t *testing.T) {
 	}
 }
 
-func TestSetupCmdTxMarketWithdraw(t *testing.T) {
+func TestSetupCmdTxSetDeadManSwitch(t *testing.T) {
 	runSetupTestCase(t, setupTestCase{
-		name:  "SetupCmdTxMarketWithdraw",
-		setup: cli.SetupCmdTxMarketWithdraw,
+		name:  "SetupCmdTxSetDeadManSwitch",
+		setup: cli.SetupCmdTxSetDeadManSwitch,
 		expFlags: []string{
-			cli.FlagAdmin, cli.FlagAuthority,
-			cli.FlagMarket, cli.FlagTo, cli.FlagAmount,
+			cli.FlagAccount, cli.FlagInterval,
 			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
 		},
 		expAnnotations: map[string]map[string][]string{
-			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
-			cli.FlagAdmin: {
-				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
-				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
-			},
-			cli.FlagAuthority: {
-				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
-				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
-			},
-			cli.FlagMarket: {required: {"true"}},
-			cli.FlagTo:     {required: {"true"}},
-			cli.FlagAmount: {required: {"true"}},
+			flags.FlagFrom:   {oneReq: {flags.FlagFrom + " " + cli.FlagAccount}},
+			cli.FlagAccount:  {oneReq: {flags.FlagFrom + " " + cli.FlagAccount}},
+			cli.FlagInterval: {required: {"true"}},
 		},
 		expInUse: []string{
-			cli.ReqAdminUse, "--market <market id>", "--to <to address>", "--amount <amount>",
-			cli.ReqAdminDesc,
+			"{--from|--account} <account>", "--interval <seconds>",
+			cli.ReqSignerDesc(cli.FlagAccount),
+			"If no heartbeat is received within the interval, all of the account's orders are cancelled.",
+			"Use an --interval of 0 to remove the account's dead-man switch.",
 		},
 	})
 }
 
-func TestMakeMsgMarketWithdraw(t *testing.T) {
-	td := txMakerTestDef[*exchange.MsgMarketWithdrawRequest]{
-		makerName: "MakeMsgMarketWithdraw",
-		maker:     cli.MakeMsgMarketWithdraw,
-		setup:     cli.SetupCmdTxMarketWithdraw,
+func TestMakeMsgSetDeadManSwitch(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgSetDeadManSwitchRequest]{
+		makerName: "MakeMsgSetDeadManSwitch",
+		maker:     cli.MakeMsgSetDeadManSwitch,
+		setup:     cli.SetupCmdTxSetDeadManSwitch,
 	}
 
-	tests := []txMakerTestCase[*exchange.MsgMarketWithdrawRequest]{
+	tests := []txMakerTestCase[*exchange.MsgSetDeadManSwitchRequest]{
 		{
-			name:  "some errors",
-			flags: []string{"--market", "5", "--to", "annie", "--amount", "bill"},
-			expMsg: &exchange.MsgMarketWithdrawRequest{
-				Admin: "", MarketId: 5, ToAddress: "annie", Amount: nil,
-			},
-			expErr: joinErrs(
-				"no <admin> provided",
-				"error parsing --amount as coins: invalid coin expression: \"bill\"",
-			),
+			name:   "nothing",
+			expMsg: &exchange.MsgSetDeadManSwitchRequest{},
+			expErr: "no <account> provided",
 		},
 		{
-			name:      "all fields",
+			name:      "from",
 			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
-			flags:     []string{"--market", "2", "--to", "samantha", "--amount", "52plum,18pear"},
-			expMsg: &exchange.MsgMarketWithdrawRequest{
-				Admin:    sdk.AccAddress("FromAddress_________").String(),
-				MarketId: 2, ToAddress: "samantha",
-				Amount: sdk.NewCoins(sdk.NewInt64Coin("plum", 52), sdk.NewInt64Coin("pear", 18)),
+			flags:     []string{"--interval", "30"},
+			expMsg: &exchange.MsgSetDeadManSwitchRequest{
+				Account:         sdk.AccAddress("FromAddress_________").String(),
+				IntervalSeconds: 30,
 			},
 		},
+		{
+			name:   "account with zero interval",
+			flags:  []string{"--account", "someone", "--interval", "0"},
+			expMsg: &exchange.MsgSetDeadManSwitchRequest{Account: "someone"},
+		},
 	}
 
 	for _, tc := range tests {
@@ -1121,186 +1099,191 @@ func TestMakeMsgMarketWithdraw(t *testing.T) {
 	}
 }
 
-func TestAddFlagsMarketDetails(t *testing.T) {
+func TestSetupCmdTxHeartbeat(t *testing.T) {
 	runSetupTestCase(t, setupTestCase{
-		name:               "AddFlagsMarketDetails",
-		setup:              cli.AddFlagsMarketDetails,
-		expFlags:           []string{cli.FlagName, cli.FlagDescription, cli.FlagURL, cli.FlagIcon},
-		skipArgsCheck:      true,
-		skipFlagInUseCheck: true,
-	})
-}
-
-func TestReadFlagsMarketDetails(t *testing.T) {
-	tests := []struct {
-		name       string
-		skipSetup  bool
-		flags      []string
-		def        exchange.MarketDetails
-		expDetails exchange.MarketDetails
-		expErr     string
-	}{
+		name:  "SetupCmdTxHeartbeat",
+		setup: cli.SetupCmdTxHeartbeat,
+		expFlags: []string{
+			cli.FlagAccount,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			flags.FlagFrom:  {oneReq: {flags.FlagFrom + " " + cli.FlagAccount}},
+			cli.FlagAccount: {oneReq: {flags.FlagFrom + " " + cli.FlagAccount}},
+		},
+		expInUse: []string{
+			"{--from|--account} <account>",
+			cli.ReqSignerDesc(cli.FlagAccount),
+			"The account's dead-man switch deadline is reset to one interval after the current block time.",
+		},
+	})
+}
+
+func TestMakeMsgHeartbeat(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgHeartbeatRequest]{
+		makerName: "MakeMsgHeartbeat",
+		maker:     cli.MakeMsgHeartbeat,
+		setup:     cli.SetupCmdTxHeartbeat,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgHeartbeatRequest]{
 		{
-			name:      "no setup",
-			skipSetup: true,
-			expErr: joinErrs(
-				"flag accessed but not defined: name",
-				"flag accessed but not defined: description",
-				"flag accessed but not defined: url",
-				"flag accessed but not defined: icon",
-			),
+			name:   "nothing",
+			expMsg: &exchange.MsgHeartbeatRequest{},
+			expErr: "no <account> provided",
 		},
 		{
-			name:       "just name",
-			flags:      []string{"--name", "Richard"},
-			expDetails: exchange.MarketDetails{Name: "Richard"},
+			name:      "from",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			expMsg:    &exchange.MsgHeartbeatRequest{Account: sdk.AccAddress("FromAddress_________").String()},
 		},
 		{
-			name:  "name and url, no defaults",
-			flags: []string{"--url", "https://example.com", "--name", "Sally"},
-			expDetails: exchange.MarketDetails{
-				Name:       "Sally",
-				WebsiteUrl: "https://example.com",
-			},
+			name:   "account",
+			flags:  []string{"--account", "someone"},
+			expMsg: &exchange.MsgHeartbeatRequest{Account: "someone"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdTxFillBids(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdTxFillBids",
+		setup: cli.SetupCmdTxFillBids,
+		expFlags: []string{
+			cli.FlagSeller, cli.FlagMarket, cli.FlagAssets,
+			cli.FlagBids, cli.FlagSettlementFee, cli.FlagCreationFee,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagSeller}},
+			cli.FlagSeller: {oneReq: {flags.FlagFrom + " " + cli.FlagSeller}},
+			cli.FlagMarket: {required: {"true"}},
+			cli.FlagAssets: {required: {"true"}},
+			cli.FlagBids:   {required: {"true"}},
 		},
+		expInUse: []string{
+			"{--from|--seller} <seller>", "--market <market id>", "--assets <total assets>",
+			"--bids <bid order ids>", "[--settlement-fee <seller settlement flat fee>]",
+			"[--creation-fee <ask order creation fee>]",
+			cli.ReqSignerDesc(cli.FlagSeller),
+			cli.RepeatableDesc,
+		},
+	})
+}
+
+func TestMakeMsgFillBids(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgFillBidsRequest]{
+		makerName: "MakeMsgFillBids",
+		maker:     cli.MakeMsgFillBids,
+		setup:     cli.SetupCmdTxFillBids,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgFillBidsRequest]{
 		{
-			name:  "name and url, with defaults",
-			flags: []string{"--url", "https://example.com/new", "--name", "Glen"},
-			def: exchange.MarketDetails{
-				Name:        "Martha",
-				Description: "Some existing Description",
-				WebsiteUrl:  "https://old.example.com",
-				IconUri:     "https://example.com/icon",
-			},
-			expDetails: exchange.MarketDetails{
-				Name:        "Glen",
-				Description: "Some existing Description",
-				WebsiteUrl:  "https://example.com/new",
-				IconUri:     "https://example.com/icon",
+			name:      "some errors",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--assets", "18", "--creation-fee", "apple"},
+			expMsg: &exchange.MsgFillBidsRequest{
+				Seller: sdk.AccAddress("FromAddress_________").String(),
 			},
+			expErr: joinErrs(
+				"error parsing --assets as coins: invalid coin expression: \"18\"",
+				"error parsing --creation-fee as a coin: invalid coin expression: \"apple\"",
+			),
 		},
 		{
-			name: "all fields",
+			name: "all the flags",
 			flags: []string{
-				"--name", "Market Eight Dude",
-				"--description", "The Little Lebowski",
-				"--url", "https://bowling.god",
-				"--icon", "https://bowling.god/icon",
+				"--market", "10", "--seller", "mike",
+				"--assets", "18acorn,5apple", "--bids", "83,52,99",
+				"--settlement-fee", "15fig", "--creation-fee", "9grape",
+				"--bids", "5",
 			},
-			expDetails: exchange.MarketDetails{
-				Name:        "Market Eight Dude",
-				Description: "The Little Lebowski",
-				WebsiteUrl:  "https://bowling.god",
-				IconUri:     "https://bowling.god/icon",
+			expMsg: &exchange.MsgFillBidsRequest{
+				Seller:                  "mike",
+				MarketId:                10,
+				TotalAssets:             sdk.NewCoins(sdk.NewInt64Coin("acorn", 18), sdk.NewInt64Coin("apple", 5)),
+				BidOrderIds:             []uint64{83, 52, 99, 5},
+				SellerSettlementFlatFee: &sdk.Coin{Denom: "fig", Amount: sdkmath.NewInt(15)},
+				AskOrderCreationFee:     &sdk.Coin{Denom: "grape", Amount: sdkmath.NewInt(9)},
 			},
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			cmd := &cobra.Command{
-				Use: "dummy",
-				RunE: func(cmd *cobra.Command, args []string) error {
-					return errors.New("this dummy command should not have been executed")
-				},
-			}
-			if !tc.skipSetup {
-				cli.AddFlagsMarketDetails(cmd)
-			}
-
-			err := cmd.Flags().Parse(tc.flags)
-			require.NoError(t, err, "cmd.Flags().Parse(%q)", tc.flags)
-
-			var details exchange.MarketDetails
-			testFunc := func() {
-				details, err = cli.ReadFlagsMarketDetails(cmd.Flags(), tc.def)
-			}
-			require.NotPanics(t, testFunc, "ReadFlagsMarketDetails")
-			assertions.AssertErrorValue(t, err, tc.expErr, "ReadFlagsMarketDetails")
-			assert.Equal(t, tc.expDetails, details, "ReadFlagsMarketDetails")
+			runTxMakerTestCase(t, td, tc)
 		})
 	}
 }
 
-func TestSetupCmdTxMarketUpdateDetails(t *testing.T) {
+func TestSetupCmdTxFillAsks(t *testing.T) {
 	runSetupTestCase(t, setupTestCase{
-		name:  "SetupCmdTxMarketUpdateDetails",
-		setup: cli.SetupCmdTxMarketUpdateDetails,
+		name:  "SetupCmdTxFillAsks",
+		setup: cli.SetupCmdTxFillAsks,
 		expFlags: []string{
-			cli.FlagAdmin, cli.FlagAuthority,
-			cli.FlagMarket,
-			cli.FlagName, cli.FlagDescription, cli.FlagURL, cli.FlagIcon,
+			cli.FlagBuyer, cli.FlagMarket, cli.FlagPrice,
+			cli.FlagAsks, cli.FlagSettlementFee, cli.FlagCreationFee,
 			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
 		},
 		expAnnotations: map[string]map[string][]string{
-			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
-			cli.FlagAdmin: {
-				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
-				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
-			},
-			cli.FlagAuthority: {
-				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
-				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
-			},
+			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagBuyer}},
+			cli.FlagBuyer:  {oneReq: {flags.FlagFrom + " " + cli.FlagBuyer}},
 			cli.FlagMarket: {required: {"true"}},
+			cli.FlagPrice:  {required: {"true"}},
+			cli.FlagAsks:   {required: {"true"}},
 		},
 		expInUse: []string{
-			cli.ReqAdminUse, "--market <market id>",
-			"[--name <name>]", "[--description <description>]", "[--url <website url>]", "[--icon <icon uri>]",
-			cli.ReqAdminDesc,
-			`All fields of a market's details will be updated.
-If you omit an optional flag, that field will be updated to an empty string.`,
+			"{--from|--buyer} <buyer>", "--market <market id>", "--price <total price>",
+			"--asks <ask order ids>", "[--settlement-fee <buyer settlement fees>]",
+			"[--creation-fee <bid order creation fee>]",
+			cli.ReqSignerDesc(cli.FlagBuyer),
+			cli.RepeatableDesc,
 		},
 	})
 }
 
-func TestMakeMsgMarketUpdateDetails(t *testing.T) {
-	td := txMakerTestDef[*exchange.MsgMarketUpdateDetailsRequest]{
-		makerName: "MakeMsgMarketUpdateDetails",
-		maker:     cli.MakeMsgMarketUpdateDetails,
-		setup:     cli.SetupCmdTxMarketUpdateDetails,
+func TestMakeMsgFillAsks(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgFillAsksRequest]{
+		makerName: "MakeMsgFillAsks",
+		maker:     cli.MakeMsgFillAsks,
+		setup:     cli.SetupCmdTxFillAsks,
 	}
 
-	tests := []txMakerTestCase[*exchange.MsgMarketUpdateDetailsRequest]{
-		{
-			name:  "no admin",
-			flags: []string{"--market", "8", "--name", "Lynne"},
-			expMsg: &exchange.MsgMarketUpdateDetailsRequest{
-				MarketId:      8,
-				MarketDetails: exchange.MarketDetails{Name: "Lynne"},
-			},
-			expErr: "no <admin> provided",
-		},
+	tests := []txMakerTestCase[*exchange.MsgFillAsksRequest]{
 		{
-			name:      "just name and description",
+			name:      "some errors",
 			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
-			flags:     []string{"--market", "9002", "--name", "River", "--description", "The person, not the water."},
-			expMsg: &exchange.MsgMarketUpdateDetailsRequest{
-				Admin:    sdk.AccAddress("FromAddress_________").String(),
-				MarketId: 9002,
-				MarketDetails: exchange.MarketDetails{
-					Name:        "River",
-					Description: "The person, not the water.",
-				},
+			flags:     []string{"--price", "18", "--creation-fee", "apple"},
+			expMsg: &exchange.MsgFillAsksRequest{
+				Buyer: sdk.AccAddress("FromAddress_________").String(),
 			},
+			expErr: joinErrs(
+				"error parsing --price as a coin: invalid coin expression: \"18\"",
+				"error parsing --creation-fee as a coin: invalid coin expression: \"apple\"",
+			),
 		},
 		{
-			name: "all fields",
+			name: "all the flags",
 			flags: []string{
-				"--market", "14", "--authority", "--name", "Ashley",
-				"--icon", "https://example.com/ashley/icon",
-				"--url", "https://example.com/ashley",
-				"--description", "The best market out there.",
+				"--market", "10", "--buyer", "george",
+				"--price", "23apple", "--asks", "41,12,77",
+				"--settlement-fee", "15fig", "--creation-fee", "9grape",
+				"--asks", "20", "--asks", "987,444,6",
 			},
-			expMsg: &exchange.MsgMarketUpdateDetailsRequest{
-				Admin:    cli.AuthorityAddr.String(),
-				MarketId: 14,
-				MarketDetails: exchange.MarketDetails{
-					Name:        "Ashley",
-					Description: "The best market out there.",
-					WebsiteUrl:  "https://example.com/ashley",
-					IconUri:     "https://example.com/ashley/icon",
-				},
+			expMsg: &exchange.MsgFillAsksRequest{
+				Buyer:               "george",
+				MarketId:            10,
+				TotalPrice:          sdk.NewInt64Coin("apple", 23),
+				AskOrderIds:         []uint64{41, 12, 77, 20, 987, 444, 6},
+				BuyerSettlementFees: sdk.NewCoins(sdk.NewInt64Coin("fig", 15)),
+				BidOrderCreationFee: &sdk.Coin{Denom: "grape", Amount: sdkmath.NewInt(9)},
 			},
 		},
 	}
@@ -1312,13 +1295,13 @@ func TestMakeMsgMarketUpdateDetails(t *testing.T) {
 	}
 }
 
-func TestSetupCmdTxMarketUpdateAcceptingOrders(t *testing.T) {
+func TestSetupCmdTxMarketSettle(t *testing.T) {
 	runSetupTestCase(t, setupTestCase{
-		name:  "SetupCmdTxMarketUpdateAcceptingOrders",
-		setup: cli.SetupCmdTxMarketUpdateAcceptingOrders,
+		name:  "SetupCmdTxMarketSettle",
+		setup: cli.SetupCmdTxMarketSettle,
 		expFlags: []string{
 			cli.FlagAdmin, cli.FlagAuthority,
-			cli.FlagMarket, cli.FlagEnable, cli.FlagDisable,
+			cli.FlagMarket, cli.FlagAsks, cli.FlagBids, cli.FlagPartial,
 			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
 		},
 		expAnnotations: map[string]map[string][]string{
@@ -1332,58 +1315,2594 @@ func TestSetupCmdTxMarketUpdateAcceptingOrders(t *testing.T) {
 				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
 			},
 			cli.FlagMarket: {required: {"true"}},
-			cli.FlagEnable: {
-				mutExc: {cli.FlagEnable + " " + cli.FlagDisable},
-				oneReq: {cli.FlagEnable + " " + cli.FlagDisable},
+			cli.FlagAsks:   {required: {"true"}},
+			cli.FlagBids:   {required: {"true"}},
+		},
+		expInUse: []string{
+			cli.ReqAdminUse, "--market <market id>",
+			"--asks <ask order ids>", "--bids <bid order ids>",
+			"[--partial]",
+			cli.ReqAdminDesc, cli.RepeatableDesc,
+		},
+	})
+}
+
+func TestMakeMsgMarketSettle(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketSettleRequest]{
+		makerName: "MakeMsgMarketSettle",
+		maker:     cli.MakeMsgMarketSettle,
+		setup:     cli.SetupCmdTxMarketSettle,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgMarketSettleRequest]{
+		{
+			name:  "no admin",
+			flags: []string{"--asks", "7", "--bids", "8", "--partial"},
+			expMsg: &exchange.MsgMarketSettleRequest{
+				AskOrderIds:   []uint64{7},
+				BidOrderIds:   []uint64{8},
+				ExpectPartial: true,
 			},
-			cli.FlagDisable: {
-				mutExc: {cli.FlagEnable + " " + cli.FlagDisable},
-				oneReq: {cli.FlagEnable + " " + cli.FlagDisable},
+			expErr: "no <admin> provided",
+		},
+		{
+			name:      "from",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags: []string{
+				"--asks", "15,16,17", "--market", "52", "--bids", "51,52,53",
+				"--asks", "8", "--bids", "9"},
+			expMsg: &exchange.MsgMarketSettleRequest{
+				Admin:       sdk.AccAddress("FromAddress_________").String(),
+				MarketId:    52,
+				AskOrderIds: []uint64{15, 16, 17, 8},
+				BidOrderIds: []uint64{51, 52, 53, 9},
+			},
+		},
+		{
+			name:  "authority",
+			flags: []string{"--market", "52", "--asks", "91", "--bids", "12,13", "--authority", "--partial"},
+			expMsg: &exchange.MsgMarketSettleRequest{
+				Admin:         cli.AuthorityAddr.String(),
+				MarketId:      52,
+				AskOrderIds:   []uint64{91},
+				BidOrderIds:   []uint64{12, 13},
+				ExpectPartial: true,
+			},
+		},
+		{
+			name:  "admin",
+			flags: []string{"--market", "14", "--admin", "bob", "--asks", "1,2,3", "--bids", "5"},
+			expMsg: &exchange.MsgMarketSettleRequest{
+				Admin:       "bob",
+				MarketId:    14,
+				AskOrderIds: []uint64{1, 2, 3},
+				BidOrderIds: []uint64{5},
 			},
 		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdTxMarketCommitmentSettle(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdTxMarketCommitmentSettle",
+		setup: cli.SetupCmdTxMarketCommitmentSettle,
+		expFlags: []string{
+			cli.FlagAdmin, cli.FlagAuthority,
+			cli.FlagMarket, cli.FlagInputs, cli.FlagOutputs,
+			cli.FlagSettlementFees, cli.FlagNavs, cli.FlagTag, cli.FlagFile,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			flags.FlagFrom: {oneReq: {cli.FlagFile + " " + flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
+			cli.FlagAdmin: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {cli.FlagFile + " " + flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagAuthority: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {cli.FlagFile + " " + flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagFile: {oneReq: {
+				cli.FlagFile + " " + flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority,
+				cli.FlagFile + " " + cli.FlagMarket,
+			}},
+			cli.FlagMarket: {oneReq: {cli.FlagFile + " " + cli.FlagMarket}},
+		},
 		expInUse: []string{
-			cli.ReqAdminUse, "--market <market id>", cli.ReqEnableDisableUse,
-			cli.ReqAdminDesc, cli.ReqEnableDisableDesc,
+			cli.ReqAdminUse, "[--market <market id>]",
+			"[--inputs <account-amount>]", "[--outputs <account-amount>]",
+			"[--settlement-fees <account-amount>]", "[--navs <nav>]", "[--tag <event tag>]",
+			"[--file <filename>]",
+			cli.ReqAdminDesc, cli.RepeatableDesc, cli.AccountAmountDesc, cli.NAVDesc,
+			cli.MsgFileDesc(&exchange.MsgMarketCommitmentSettleRequest{}),
 		},
 	})
 }
 
-func TestMakeMsgMarketUpdateAcceptingOrders(t *testing.T) {
-	td := txMakerTestDef[*exchange.MsgMarketUpdateAcceptingOrdersRequest]{
-		makerName: "MakeMsgMarketUpdateAcceptingOrders",
-		maker:     cli.MakeMsgMarketUpdateAcceptingOrders,
-		setup:     cli.SetupCmdTxMarketUpdateAcceptingOrders,
+func TestMakeMsgMarketCommitmentSettle(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketCommitmentSettleRequest]{
+		makerName: "MakeMsgMarketCommitmentSettle",
+		maker:     cli.MakeMsgMarketCommitmentSettle,
+		setup:     cli.SetupCmdTxMarketCommitmentSettle,
 	}
 
-	tests := []txMakerTestCase[*exchange.MsgMarketUpdateAcceptingOrdersRequest]{
+	tdir := t.TempDir()
+	filename := filepath.Join(tdir, "commitment-settle.json")
+	fileMsg := &exchange.MsgMarketCommitmentSettleRequest{
+		Admin:    sdk.AccAddress("msg_admin___________").String(),
+		MarketId: 4,
+		Inputs:   []exchange.AccountAmount{{Account: "devin", Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 10))}},
+		Outputs:  []exchange.AccountAmount{{Account: "parker", Amount: sdk.NewCoins(sdk.NewInt64Coin("peach", 11))}},
+		Fees:     []exchange.AccountAmount{{Account: "tracey", Amount: sdk.NewCoins(sdk.NewInt64Coin("fig", 4))}},
+		Navs:     []exchange.NetAssetPrice{{Assets: sdk.NewInt64Coin("acorn", 44), Price: sdk.NewInt64Coin("pear", 7)}},
+		EventTag: "the-msg-event-tag",
+	}
+	tx := newTx(t, fileMsg)
+	writeFileAsJson(t, filename, tx)
+
+	tests := []txMakerTestCase[*exchange.MsgMarketCommitmentSettleRequest]{
 		{
-			name:   "some errors",
-			flags:  []string{"--market", "56"},
-			expMsg: &exchange.MsgMarketUpdateAcceptingOrdersRequest{MarketId: 56},
+			name:   "no flags",
+			expMsg: &exchange.MsgMarketCommitmentSettleRequest{},
+			expErr: "no <admin> provided",
+		},
+		{
+			name:      "admin from from",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			expMsg: &exchange.MsgMarketCommitmentSettleRequest{
+				Admin: sdk.AccAddress("FromAddress_________").String(),
+			},
+		},
+		{
+			name:   "admin from flag",
+			flags:  []string{"--admin", "kelly"},
+			expMsg: &exchange.MsgMarketCommitmentSettleRequest{Admin: "kelly"},
+		},
+		{
+			name:  "admin as authority",
+			flags: []string{"--authority"},
+			expMsg: &exchange.MsgMarketCommitmentSettleRequest{
+				Admin: cli.AuthorityAddr.String(),
+			},
+		},
+		{
+			name: "bad inputs outputs fees and navs",
+			flags: []string{
+				"--admin", "sam",
+				"--inputs", "10nhash", "--outputs", "addr3",
+				"--settlement-fees", "42cherry", "--navs", "18banana",
+			},
+			expMsg: &exchange.MsgMarketCommitmentSettleRequest{Admin: "sam"},
 			expErr: joinErrs(
-				"no <admin> provided",
-				"exactly one of --enable or --disable must be provided",
+				"invalid account-amount \"10nhash\": expected format <account>:<amount>",
+				"invalid account-amount \"addr3\": expected format <account>:<amount>",
+				"invalid account-amount \"42cherry\": expected format <account>:<amount>",
+				"invalid net-asset-price \"18banana\": expected format <assets>:<price>",
 			),
 		},
 		{
-			name:      "enable",
-			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
-			flags:     []string{"--enable", "--market", "4"},
-			expMsg: &exchange.MsgMarketUpdateAcceptingOrdersRequest{
-				Admin:           sdk.AccAddress("FromAddress_________").String(),
-				MarketId:        4,
-				AcceptingOrders: true,
+			name: "all provided",
+			flags: []string{
+				"--authority", "--market", "18", "--tag", "thing-4DE17436",
+				"--inputs", "addr1:10nhash,5cherry,addr2:12nhash,addr3:4nhash,10cherry",
+				"--settlement-fees", "addr7:10apple,1prune",
+				"--outputs", "addr4:26nhash,1cherry",
+				"--settlement-fees", "addr6:8apple",
+				"--navs", "8apple:10cherry,10apple:3nhash",
+				"--outputs", "addr5:14cherry",
+				"--navs", "4cherry:15nhash",
+			},
+			expMsg: &exchange.MsgMarketCommitmentSettleRequest{
+				Admin:    cli.AuthorityAddr.String(),
+				MarketId: 18,
+				Inputs: []exchange.AccountAmount{
+					{Account: "addr1", Amount: sdk.NewCoins(sdk.NewInt64Coin("nhash", 10), sdk.NewInt64Coin("cherry", 5))},
+					{Account: "addr2", Amount: sdk.NewCoins(sdk.NewInt64Coin("nhash", 12))},
+					{Account: "addr3", Amount: sdk.NewCoins(sdk.NewInt64Coin("nhash", 4), sdk.NewInt64Coin("cherry", 10))},
+				},
+				Outputs: []exchange.AccountAmount{
+					{Account: "addr4", Amount: sdk.NewCoins(sdk.NewInt64Coin("nhash", 26), sdk.NewInt64Coin("cherry", 1))},
+					{Account: "addr5", Amount: sdk.NewCoins(sdk.NewInt64Coin("cherry", 14))},
+				},
+				Fees: []exchange.AccountAmount{
+					{Account: "addr7", Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 10), sdk.NewInt64Coin("prune", 1))},
+					{Account: "addr6", Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 8))},
+				},
+				Navs: []exchange.NetAssetPrice{
+					{Assets: sdk.NewInt64Coin("apple", 8), Price: sdk.NewInt64Coin("cherry", 10)},
+					{Assets: sdk.NewInt64Coin("apple", 10), Price: sdk.NewInt64Coin("nhash", 3)},
+					{Assets: sdk.NewInt64Coin("cherry", 4), Price: sdk.NewInt64Coin("nhash", 15)},
+				},
+				EventTag: "thing-4DE17436",
+			},
+		},
+		{
+			name:      "from file",
+			clientCtx: newClientContext(t),
+			flags:     []string{"--file", filename},
+			expMsg:    fileMsg,
+		},
+		{
+			name: "file with overrides",
+			flags: []string{
+				"--file", filename, "--tag", "new-thang", "--authority",
+				"--outputs", "monroe:87plum",
+			},
+			clientCtx: newClientContext(t),
+			expMsg: &exchange.MsgMarketCommitmentSettleRequest{
+				Admin:    cli.AuthorityAddr.String(),
+				MarketId: 4,
+				Inputs:   []exchange.AccountAmount{{Account: "devin", Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 10))}},
+				Outputs:  []exchange.AccountAmount{{Account: "monroe", Amount: sdk.NewCoins(sdk.NewInt64Coin("plum", 87))}},
+				Fees:     []exchange.AccountAmount{{Account: "tracey", Amount: sdk.NewCoins(sdk.NewInt64Coin("fig", 4))}},
+				Navs:     []exchange.NetAssetPrice{{Assets: sdk.NewInt64Coin("acorn", 44), Price: sdk.NewInt64Coin("pear", 7)}},
+				EventTag: "new-thang",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdTxMarketReleaseCommitments(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdTxMarketReleaseCommitments",
+		setup: cli.SetupCmdTxMarketReleaseCommitments,
+		expFlags: []string{
+			cli.FlagAdmin, cli.FlagAuthority,
+			cli.FlagMarket, cli.FlagRelease, cli.FlagReleaseAll, cli.FlagTag,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
+			cli.FlagAdmin: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagAuthority: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagMarket:     {required: {"true"}},
+			cli.FlagRelease:    {oneReq: {cli.FlagRelease + " " + cli.FlagReleaseAll}},
+			cli.FlagReleaseAll: {oneReq: {cli.FlagRelease + " " + cli.FlagReleaseAll}},
+		},
+		expInUse: []string{
+			cli.ReqAdminUse, "--market <market id>",
+			"[--release <account-amount>]", "[--release-all <account>]", "[--tag <event tag>]",
+			cli.ReqAdminUse,
+			"At least one of --release and/or --release-all must be provided",
+			cli.RepeatableDesc, cli.AccountAmountDesc,
+		},
+	})
+}
+
+func TestMakeMsgMarketReleaseCommitments(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketReleaseCommitmentsRequest]{
+		makerName: "MakeMsgMarketReleaseCommitments",
+		maker:     cli.MakeMsgMarketReleaseCommitments,
+		setup:     cli.SetupCmdTxMarketReleaseCommitments,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgMarketReleaseCommitmentsRequest]{
+		{
+			name:   "nothing",
+			expMsg: &exchange.MsgMarketReleaseCommitmentsRequest{},
+			expErr: "no <admin> provided",
+		},
+		{
+			name:      "admin from from",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			expMsg: &exchange.MsgMarketReleaseCommitmentsRequest{
+				Admin: sdk.AccAddress("FromAddress_________").String(),
+			},
+		},
+		{
+			name:  "admin from flag",
+			flags: []string{"--admin", "forest"},
+			expMsg: &exchange.MsgMarketReleaseCommitmentsRequest{
+				Admin: "forest",
+			},
+		},
+		{
+			name:  "authority",
+			flags: []string{"--authority"},
+			expMsg: &exchange.MsgMarketReleaseCommitmentsRequest{
+				Admin: cli.AuthorityAddr.String(),
+			},
+		},
+		{
+			name:      "only release",
+			flags:     []string{"--release", "addr1:10cherry,addr2:5apple"},
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			expMsg: &exchange.MsgMarketReleaseCommitmentsRequest{
+				Admin: sdk.AccAddress("FromAddress_________").String(),
+				ToRelease: []exchange.AccountAmount{
+					{Account: "addr1", Amount: sdk.NewCoins(sdk.NewInt64Coin("cherry", 10))},
+					{Account: "addr2", Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 5))},
+				},
+			},
+		},
+		{
+			name:      "only release-all",
+			flags:     []string{"--release-all", "addr1,addr2"},
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			expMsg: &exchange.MsgMarketReleaseCommitmentsRequest{
+				Admin: sdk.AccAddress("FromAddress_________").String(),
+				ToRelease: []exchange.AccountAmount{
+					{Account: "addr1", Amount: nil},
+					{Account: "addr2", Amount: nil},
+				},
+			},
+		},
+		{
+			name: "everything",
+			flags: []string{
+				"--admin", "charly", "--market", "4", "--tag", "someragtag",
+				"--release", "addr1:4apple,5banana",
+				"--release-all", "addr2,addr3",
+				"--release", "addr4:10cherry,addr5:8date",
+			},
+			expMsg: &exchange.MsgMarketReleaseCommitmentsRequest{
+				Admin:    "charly",
+				MarketId: 4,
+				ToRelease: []exchange.AccountAmount{
+					{Account: "addr1", Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 4), sdk.NewInt64Coin("banana", 5))},
+					{Account: "addr4", Amount: sdk.NewCoins(sdk.NewInt64Coin("cherry", 10))},
+					{Account: "addr5", Amount: sdk.NewCoins(sdk.NewInt64Coin("date", 8))},
+					{Account: "addr2", Amount: nil},
+					{Account: "addr3", Amount: nil},
+				},
+				EventTag: "someragtag",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdTxMarketTransferCommitments(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdTxMarketTransferCommitments",
+		setup: cli.SetupCmdTxMarketTransferCommitment,
+		expFlags: []string{
+			cli.FlagAdmin, cli.FlagAuthority,
+			cli.FlagAccount, cli.FlagAmount, cli.FlagCurrentMarket, cli.FlagNewMarket,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
+			cli.FlagAdmin: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagAuthority: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagAccount:       {required: {"true"}},
+			cli.FlagAmount:        {required: {"true"}},
+			cli.FlagCurrentMarket: {required: {"true"}},
+			cli.FlagNewMarket:     {required: {"true"}},
+		},
+		expInUse: []string{
+			cli.ReqAdminDesc,
+			cli.ReqAdminUse, "--account <account>", "--amount <amount>", "--current-market <current market id>", "--new-market <new market id>", "[--tag <event tag>]",
+		},
+	})
+}
+
+func TestMakeMsgMarketTransferCommitments(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketTransferCommitmentRequest]{
+		makerName: "MakeMsgMarketTransferCommitments",
+		maker:     cli.MakeMsgMarketTransferCommitment,
+		setup:     cli.SetupCmdTxMarketTransferCommitment,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgMarketTransferCommitmentRequest]{
+		{
+			name:   "nothing",
+			expMsg: &exchange.MsgMarketTransferCommitmentRequest{},
+			expErr: "no <admin> provided",
+		},
+		{
+			name:      "admin from from",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			expMsg: &exchange.MsgMarketTransferCommitmentRequest{
+				Admin: sdk.AccAddress("FromAddress_________").String(),
+			},
+		},
+		{
+			name:  "admin from flag",
+			flags: []string{"--admin", "forest"},
+			expMsg: &exchange.MsgMarketTransferCommitmentRequest{
+				Admin: "forest",
+			},
+		},
+		{
+			name:  "authority",
+			flags: []string{"--authority"},
+			expMsg: &exchange.MsgMarketTransferCommitmentRequest{
+				Admin: cli.AuthorityAddr.String(),
+			},
+		},
+		{
+			name:  "some errors",
+			flags: []string{"--account", "annie", "--amount", "bill", "--current-market", "5", "--new-market", "0"},
+			expMsg: &exchange.MsgMarketTransferCommitmentRequest{
+				Admin: "", Account: "annie", Amount: nil, CurrentMarketId: 5, NewMarketId: 0},
+			expErr: joinErrs(
+				"no <admin> provided",
+				"error parsing --amount as coins: invalid coin expression: \"bill\"",
+			),
+		},
+		{
+			name:      "all fields",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--account", "samantha", "--amount", "52plum", "--current-market", "2", "--new-market", "4"},
+			expMsg: &exchange.MsgMarketTransferCommitmentRequest{
+				Admin:           sdk.AccAddress("FromAddress_________").String(),
+				Account:         "samantha",
+				Amount:          sdk.NewCoins(sdk.NewInt64Coin("plum", 52)),
+				CurrentMarketId: 2,
+				NewMarketId:     4,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdTxMarketDistributeCommitmentRewards(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdTxMarketDistributeCommitmentRewards",
+		setup: cli.SetupCmdTxMarketDistributeCommitmentRewards,
+		expFlags: []string{
+			cli.FlagAdmin, cli.FlagAuthority,
+			cli.FlagMarket, cli.FlagAmount, cli.FlagBasisDenom,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
+			cli.FlagAdmin: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagAuthority: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagMarket:     {required: {"true"}},
+			cli.FlagAmount:     {required: {"true"}},
+			cli.FlagBasisDenom: {required: {"true"}},
+		},
+		expInUse: []string{
+			cli.ReqAdminUse, "--market <market id>", "--amount <amount>", "--basis-denom <denom>",
+			cli.ReqAdminDesc,
+		},
+	})
+}
+
+func TestMakeMsgMarketDistributeCommitmentRewards(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketDistributeCommitmentRewardsRequest]{
+		makerName: "MakeMsgMarketDistributeCommitmentRewards",
+		maker:     cli.MakeMsgMarketDistributeCommitmentRewards,
+		setup:     cli.SetupCmdTxMarketDistributeCommitmentRewards,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgMarketDistributeCommitmentRewardsRequest]{
+		{
+			name:  "some errors",
+			flags: []string{"--market", "3", "--basis-denom", "apple"},
+			expMsg: &exchange.MsgMarketDistributeCommitmentRewardsRequest{
+				MarketId:   3,
+				BasisDenom: "apple",
+			},
+			expErr: joinErrs("no <admin> provided", "missing required --amount flag"),
+		},
+		{
+			name:  "all fields",
+			flags: []string{"--admin", "marketeer", "--market", "6", "--amount", "100cherry", "--basis-denom", "apple"},
+			expMsg: &exchange.MsgMarketDistributeCommitmentRewardsRequest{
+				Admin:      "marketeer",
+				MarketId:   6,
+				Amount:     sdk.NewCoins(sdk.NewInt64Coin("cherry", 100)),
+				BasisDenom: "apple",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdTxMarketBulkCancel(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdTxMarketBulkCancel",
+		setup: cli.SetupCmdTxMarketBulkCancel,
+		expFlags: []string{
+			cli.FlagAdmin, cli.FlagAuthority,
+			cli.FlagMarket, cli.FlagAsks, cli.FlagBids, cli.FlagOwner, cli.FlagDenom, cli.FlagMax,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
+			cli.FlagAdmin: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagAuthority: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagMarket: {required: {"true"}},
+			cli.FlagAsks:   {mutExc: {cli.FlagAsks + " " + cli.FlagBids}},
+			cli.FlagBids:   {mutExc: {cli.FlagAsks + " " + cli.FlagBids}},
+		},
+		expInUse: []string{
+			cli.ReqAdminUse, "--market <market id>", cli.OptAsksBidsUse,
+			"[--owner <owner>]", "[--denom <asset denom>]", "[--max <max>]",
+			cli.ReqAdminDesc, cli.OptAsksBidsDesc,
+			"At most 1000 orders can be cancelled with a single request.",
+		},
+	})
+}
+
+func TestMakeMsgMarketBulkCancel(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketBulkCancelRequest]{
+		makerName: "MakeMsgMarketBulkCancel",
+		maker:     cli.MakeMsgMarketBulkCancel,
+		setup:     cli.SetupCmdTxMarketBulkCancel,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgMarketBulkCancelRequest]{
+		{
+			name:   "no admin",
+			flags:  []string{"--market", "3"},
+			expMsg: &exchange.MsgMarketBulkCancelRequest{MarketId: 3},
+			expErr: "no <admin> provided",
+		},
+		{
+			name:      "only market",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--market", "12"},
+			expMsg: &exchange.MsgMarketBulkCancelRequest{
+				Admin:    sdk.AccAddress("FromAddress_________").String(),
+				MarketId: 12,
+			},
+		},
+		{
+			name:  "asks",
+			flags: []string{"--admin", "michelle", "--market", "4", "--asks"},
+			expMsg: &exchange.MsgMarketBulkCancelRequest{
+				Admin: "michelle", MarketId: 4, OrderType: "ask",
+			},
+		},
+		{
+			name: "all the flags",
+			flags: []string{
+				"--market", "5", "--bids", "--owner", "someone", "--denom", "apple",
+				"--max", "250", "--admin", "michelle",
+			},
+			expMsg: &exchange.MsgMarketBulkCancelRequest{
+				Admin: "michelle", MarketId: 5, OrderType: "bid",
+				Owner: "someone", AssetDenom: "apple", Limit: 250,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdTxMarketSetOrderExternalID(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdTxMarketSetOrderExternalID",
+		setup: cli.SetupCmdTxMarketSetOrderExternalID,
+		expFlags: []string{
+			cli.FlagAdmin, cli.FlagAuthority,
+			cli.FlagMarket, cli.FlagOrder, cli.FlagExternalID,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
+			cli.FlagAdmin: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagAuthority: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagMarket: {required: {"true"}},
+			cli.FlagOrder:  {required: {"true"}},
+		},
+		expInUse: []string{
+			cli.ReqAdminUse, "--market <market id>", "--order <order id>",
+			"[--external-id <external id>]",
+			cli.ReqAdminDesc,
+		},
+	})
+}
+
+func TestMakeMsgMarketSetOrderExternalID(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketSetOrderExternalIDRequest]{
+		makerName: "MakeMsgMarketSetOrderExternalID",
+		maker:     cli.MakeMsgMarketSetOrderExternalID,
+		setup:     cli.SetupCmdTxMarketSetOrderExternalID,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgMarketSetOrderExternalIDRequest]{
+		{
+			name:  "no admin",
+			flags: []string{"--market", "8", "--order", "7", "--external-id", "markus"},
+			expMsg: &exchange.MsgMarketSetOrderExternalIDRequest{
+				MarketId: 8, OrderId: 7, ExternalId: "markus",
+			},
+			expErr: "no <admin> provided",
+		},
+		{
+			name:      "no external id",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--market", "4000", "--order", "9001"},
+			expMsg: &exchange.MsgMarketSetOrderExternalIDRequest{
+				Admin:    sdk.AccAddress("FromAddress_________").String(),
+				MarketId: 4000, OrderId: 9001, ExternalId: "",
+			},
+		},
+		{
+			name: "all the flags",
+			flags: []string{
+				"--market", "5", "--order", "100000000000001",
+				"--external-id", "one", "--admin", "michelle",
+			},
+			expMsg: &exchange.MsgMarketSetOrderExternalIDRequest{
+				Admin: "michelle", MarketId: 5, OrderId: 100000000000001, ExternalId: "one",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdTxMarketWithdraw(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdTxMarketWithdraw",
+		setup: cli.SetupCmdTxMarketWithdraw,
+		expFlags: []string{
+			cli.FlagAdmin, cli.FlagAuthority,
+			cli.FlagMarket, cli.FlagTo, cli.FlagAmount,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
+			cli.FlagAdmin: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagAuthority: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagMarket: {required: {"true"}},
+			cli.FlagTo:     {required: {"true"}},
+			cli.FlagAmount: {required: {"true"}},
+		},
+		expInUse: []string{
+			cli.ReqAdminUse, "--market <market id>", "--to <to address>", "--amount <amount>",
+			cli.ReqAdminDesc,
+		},
+	})
+}
+
+func TestMakeMsgMarketWithdraw(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketWithdrawRequest]{
+		makerName: "MakeMsgMarketWithdraw",
+		maker:     cli.MakeMsgMarketWithdraw,
+		setup:     cli.SetupCmdTxMarketWithdraw,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgMarketWithdrawRequest]{
+		{
+			name:  "some errors",
+			flags: []string{"--market", "5", "--to", "annie", "--amount", "bill"},
+			expMsg: &exchange.MsgMarketWithdrawRequest{
+				Admin: "", MarketId: 5, ToAddress: "annie", Amount: nil,
+			},
+			expErr: joinErrs(
+				"no <admin> provided",
+				"error parsing --amount as coins: invalid coin expression: \"bill\"",
+			),
+		},
+		{
+			name:      "all fields",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--market", "2", "--to", "samantha", "--amount", "52plum,18pear"},
+			expMsg: &exchange.MsgMarketWithdrawRequest{
+				Admin:    sdk.AccAddress("FromAddress_________").String(),
+				MarketId: 2, ToAddress: "samantha",
+				Amount: sdk.NewCoins(sdk.NewInt64Coin("plum", 52), sdk.NewInt64Coin("pear", 18)),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
+func TestAddFlagsMarketDetails(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:               "AddFlagsMarketDetails",
+		setup:              cli.AddFlagsMarketDetails,
+		expFlags:           []string{cli.FlagName, cli.FlagDescription, cli.FlagURL, cli.FlagIcon},
+		skipArgsCheck:      true,
+		skipFlagInUseCheck: true,
+	})
+}
+
+func TestReadFlagsMarketDetails(t *testing.T) {
+	tests := []struct {
+		name       string
+		skipSetup  bool
+		flags      []string
+		def        exchange.MarketDetails
+		expDetails exchange.MarketDetails
+		expErr     string
+	}{
+		{
+			name:      "no setup",
+			skipSetup: true,
+			expErr: joinErrs(
+				"flag accessed but not defined: name",
+				"flag accessed but not defined: description",
+				"flag accessed but not defined: url",
+				"flag accessed but not defined: icon",
+			),
+		},
+		{
+			name:       "just name",
+			flags:      []string{"--name", "Richard"},
+			expDetails: exchange.MarketDetails{Name: "Richard"},
+		},
+		{
+			name:  "name and url, no defaults",
+			flags: []string{"--url", "https://example.com", "--name", "Sally"},
+			expDetails: exchange.MarketDetails{
+				Name:       "Sally",
+				WebsiteUrl: "https://example.com",
+			},
+		},
+		{
+			name:  "name and url, with defaults",
+			flags: []string{"--url", "https://example.com/new", "--name", "Glen"},
+			def: exchange.MarketDetails{
+				Name:        "Martha",
+				Description: "Some existing Description",
+				WebsiteUrl:  "https://old.example.com",
+				IconUri:     "https://example.com/icon",
+			},
+			expDetails: exchange.MarketDetails{
+				Name:        "Glen",
+				Description: "Some existing Description",
+				WebsiteUrl:  "https://example.com/new",
+				IconUri:     "https://example.com/icon",
+			},
+		},
+		{
+			name: "all fields",
+			flags: []string{
+				"--name", "Market Eight Dude",
+				"--description", "The Little Lebowski",
+				"--url", "https://bowling.god",
+				"--icon", "https://bowling.god/icon",
+			},
+			expDetails: exchange.MarketDetails{
+				Name:        "Market Eight Dude",
+				Description: "The Little Lebowski",
+				WebsiteUrl:  "https://bowling.god",
+				IconUri:     "https://bowling.god/icon",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := &cobra.Command{
+				Use: "dummy",
+				RunE: func(cmd *cobra.Command, args []string) error {
+					return errors.New("this dummy command should not have been executed")
+				},
+			}
+			if !tc.skipSetup {
+				cli.AddFlagsMarketDetails(cmd)
+			}
+
+			err := cmd.Flags().Parse(tc.flags)
+			require.NoError(t, err, "cmd.Flags().Parse(%q)", tc.flags)
+
+			var details exchange.MarketDetails
+			testFunc := func() {
+				details, err = cli.ReadFlagsMarketDetails(cmd.Flags(), tc.def)
+			}
+			require.NotPanics(t, testFunc, "ReadFlagsMarketDetails")
+			assertions.AssertErrorValue(t, err, tc.expErr, "ReadFlagsMarketDetails")
+			assert.Equal(t, tc.expDetails, details, "ReadFlagsMarketDetails")
+		})
+	}
+}
+
+func TestSetupCmdTxMarketUpdateDetails(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdTxMarketUpdateDetails",
+		setup: cli.SetupCmdTxMarketUpdateDetails,
+		expFlags: []string{
+			cli.FlagAdmin, cli.FlagAuthority,
+			cli.FlagMarket,
+			cli.FlagName, cli.FlagDescription, cli.FlagURL, cli.FlagIcon,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
+			cli.FlagAdmin: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagAuthority: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagMarket: {required: {"true"}},
+		},
+		expInUse: []string{
+			cli.ReqAdminUse, "--market <market id>",
+			"[--name <name>]", "[--description <description>]", "[--url <website url>]", "[--icon <icon uri>]",
+			cli.ReqAdminDesc,
+			`All fields of a market's details will be updated.
+If you omit an optional flag, that field will be updated to an empty string.`,
+		},
+	})
+}
+
+func TestMakeMsgMarketUpdateDetails(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketUpdateDetailsRequest]{
+		makerName: "MakeMsgMarketUpdateDetails",
+		maker:     cli.MakeMsgMarketUpdateDetails,
+		setup:     cli.SetupCmdTxMarketUpdateDetails,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgMarketUpdateDetailsRequest]{
+		{
+			name:  "no admin",
+			flags: []string{"--market", "8", "--name", "Lynne"},
+			expMsg: &exchange.MsgMarketUpdateDetailsRequest{
+				MarketId:      8,
+				MarketDetails: exchange.MarketDetails{Name: "Lynne"},
+			},
+			expErr: "no <admin> provided",
+		},
+		{
+			name:      "just name and description",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--market", "9002", "--name", "River", "--description", "The person, not the water."},
+			expMsg: &exchange.MsgMarketUpdateDetailsRequest{
+				Admin:    sdk.AccAddress("FromAddress_________").String(),
+				MarketId: 9002,
+				MarketDetails: exchange.MarketDetails{
+					Name:        "River",
+					Description: "The person, not the water.",
+				},
+			},
+		},
+		{
+			name: "all fields",
+			flags: []string{
+				"--market", "14", "--authority", "--name", "Ashley",
+				"--icon", "https://example.com/ashley/icon",
+				"--url", "https://example.com/ashley",
+				"--description", "The best market out there.",
+			},
+			expMsg: &exchange.MsgMarketUpdateDetailsRequest{
+				Admin:    cli.AuthorityAddr.String(),
+				MarketId: 14,
+				MarketDetails: exchange.MarketDetails{
+					Name:        "Ashley",
+					Description: "The best market out there.",
+					WebsiteUrl:  "https://example.com/ashley",
+					IconUri:     "https://example.com/ashley/icon",
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdTxMarketUpdateAcceptingOrders(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdTxMarketUpdateAcceptingOrders",
+		setup: cli.SetupCmdTxMarketUpdateAcceptingOrders,
+		expFlags: []string{
+			cli.FlagAdmin, cli.FlagAuthority,
+			cli.FlagMarket, cli.FlagEnable, cli.FlagDisable,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
+			cli.FlagAdmin: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagAuthority: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagMarket: {required: {"true"}},
+			cli.FlagEnable: {
+				mutExc: {cli.FlagEnable + " " + cli.FlagDisable},
+				oneReq: {cli.FlagEnable + " " + cli.FlagDisable},
+			},
+			cli.FlagDisable: {
+				mutExc: {cli.FlagEnable + " " + cli.FlagDisable},
+				oneReq: {cli.FlagEnable + " " + cli.FlagDisable},
+			},
+		},
+		expInUse: []string{
+			cli.ReqAdminUse, "--market <market id>", cli.ReqEnableDisableUse,
+			cli.ReqAdminDesc, cli.ReqEnableDisableDesc,
+		},
+	})
+}
+
+func TestMakeMsgMarketUpdateAcceptingOrders(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketUpdateAcceptingOrdersRequest]{
+		makerName: "MakeMsgMarketUpdateAcceptingOrders",
+		maker:     cli.MakeMsgMarketUpdateAcceptingOrders,
+		setup:     cli.SetupCmdTxMarketUpdateAcceptingOrders,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgMarketUpdateAcceptingOrdersRequest]{
+		{
+			name:   "some errors",
+			flags:  []string{"--market", "56"},
+			expMsg: &exchange.MsgMarketUpdateAcceptingOrdersRequest{MarketId: 56},
+			expErr: joinErrs(
+				"no <admin> provided",
+				"exactly one of --enable or --disable must be provided",
+			),
+		},
+		{
+			name:      "enable",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--enable", "--market", "4"},
+			expMsg: &exchange.MsgMarketUpdateAcceptingOrdersRequest{
+				Admin:           sdk.AccAddress("FromAddress_________").String(),
+				MarketId:        4,
+				AcceptingOrders: true,
+			},
+		},
+		{
+			name:      "disable",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--admin", "Blake", "--market", "94", "--disable"},
+			expMsg: &exchange.MsgMarketUpdateAcceptingOrdersRequest{
+				Admin:           "Blake",
+				MarketId:        94,
+				AcceptingOrders: false,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdTxMarketUpdateUserSettle(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdTxMarketUpdateUserSettle",
+		setup: cli.SetupCmdTxMarketUpdateUserSettle,
+		expFlags: []string{
+			cli.FlagAdmin, cli.FlagAuthority,
+			cli.FlagMarket, cli.FlagEnable, cli.FlagDisable,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
+			cli.FlagAdmin: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagAuthority: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagMarket: {required: {"true"}},
+			cli.FlagEnable: {
+				mutExc: {cli.FlagEnable + " " + cli.FlagDisable},
+				oneReq: {cli.FlagEnable + " " + cli.FlagDisable},
+			},
+			cli.FlagDisable: {
+				mutExc: {cli.FlagEnable + " " + cli.FlagDisable},
+				oneReq: {cli.FlagEnable + " " + cli.FlagDisable},
+			},
+		},
+		expInUse: []string{
+			cli.ReqAdminUse, "--market <market id>", cli.ReqEnableDisableUse,
+			cli.ReqAdminDesc, cli.ReqEnableDisableDesc,
+		},
+	})
+}
+
+func TestMakeMsgMarketUpdateUserSettle(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketUpdateUserSettleRequest]{
+		makerName: "MakeMsgMarketUpdateUserSettle",
+		maker:     cli.MakeMsgMarketUpdateUserSettle,
+		setup:     cli.SetupCmdTxMarketUpdateUserSettle,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgMarketUpdateUserSettleRequest]{
+		{
+			name:   "some errors",
+			flags:  []string{"--market", "56"},
+			expMsg: &exchange.MsgMarketUpdateUserSettleRequest{MarketId: 56},
+			expErr: joinErrs(
+				"no <admin> provided",
+				"exactly one of --enable or --disable must be provided",
+			),
+		},
+		{
+			name:      "enable",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--enable", "--market", "4"},
+			expMsg: &exchange.MsgMarketUpdateUserSettleRequest{
+				Admin:               sdk.AccAddress("FromAddress_________").String(),
+				MarketId:            4,
+				AllowUserSettlement: true,
+			},
+		},
+		{
+			name:      "disable",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--admin", "Blake", "--market", "94", "--disable"},
+			expMsg: &exchange.MsgMarketUpdateUserSettleRequest{
+				Admin:               "Blake",
+				MarketId:            94,
+				AllowUserSettlement: false,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdTxMarketUpdateAcceptingCommitments(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdTxMarketUpdateAcceptingCommitments",
+		setup: cli.SetupCmdTxMarketUpdateAcceptingCommitments,
+		expFlags: []string{
+			cli.FlagAdmin, cli.FlagAuthority,
+			cli.FlagMarket, cli.FlagEnable, cli.FlagDisable,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
+			cli.FlagAdmin: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagAuthority: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagMarket: {required: {"true"}},
+			cli.FlagEnable: {
+				mutExc: {cli.FlagEnable + " " + cli.FlagDisable},
+				oneReq: {cli.FlagEnable + " " + cli.FlagDisable},
+			},
+			cli.FlagDisable: {
+				mutExc: {cli.FlagEnable + " " + cli.FlagDisable},
+				oneReq: {cli.FlagEnable + " " + cli.FlagDisable},
+			},
+		},
+		expInUse: []string{
+			cli.ReqAdminUse, "--market <market id>", cli.ReqEnableDisableUse,
+			cli.ReqAdminDesc, cli.ReqEnableDisableDesc,
+		},
+	})
+}
+
+func TestMakeMsgMarketUpdateAcceptingCommitments(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketUpdateAcceptingCommitmentsRequest]{
+		makerName: "MakeMsgMarketUpdateAcceptingCommitments",
+		maker:     cli.MakeMsgMarketUpdateAcceptingCommitments,
+		setup:     cli.SetupCmdTxMarketUpdateAcceptingCommitments,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgMarketUpdateAcceptingCommitmentsRequest]{
+		{
+			name:   "some errors",
+			flags:  []string{"--market", "56"},
+			expMsg: &exchange.MsgMarketUpdateAcceptingCommitmentsRequest{MarketId: 56},
+			expErr: joinErrs(
+				"no <admin> provided",
+				"exactly one of --enable or --disable must be provided",
+			),
+		},
+		{
+			name:      "enable",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--enable", "--market", "4"},
+			expMsg: &exchange.MsgMarketUpdateAcceptingCommitmentsRequest{
+				Admin:                sdk.AccAddress("FromAddress_________").String(),
+				MarketId:             4,
+				AcceptingCommitments: true,
+			},
+		},
+		{
+			name:      "disable",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--admin", "Blake", "--market", "94", "--disable"},
+			expMsg: &exchange.MsgMarketUpdateAcceptingCommitmentsRequest{
+				Admin:                "Blake",
+				MarketId:             94,
+				AcceptingCommitments: false,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdTxMarketUpdateContinuousMatching(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdTxMarketUpdateContinuousMatching",
+		setup: cli.SetupCmdTxMarketUpdateContinuousMatching,
+		expFlags: []string{
+			cli.FlagAdmin, cli.FlagAuthority,
+			cli.FlagMarket, cli.FlagEnable, cli.FlagDisable,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
+			cli.FlagAdmin: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagAuthority: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagMarket: {required: {"true"}},
+			cli.FlagEnable: {
+				mutExc: {cli.FlagEnable + " " + cli.FlagDisable},
+				oneReq: {cli.FlagEnable + " " + cli.FlagDisable},
+			},
+			cli.FlagDisable: {
+				mutExc: {cli.FlagEnable + " " + cli.FlagDisable},
+				oneReq: {cli.FlagEnable + " " + cli.FlagDisable},
+			},
+		},
+		expInUse: []string{
+			cli.ReqAdminUse, "--market <market id>", cli.ReqEnableDisableUse,
+			cli.ReqAdminDesc, cli.ReqEnableDisableDesc,
+		},
+	})
+}
+
+func TestMakeMsgMarketUpdateContinuousMatching(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketUpdateContinuousMatchingRequest]{
+		makerName: "MakeMsgMarketUpdateContinuousMatching",
+		maker:     cli.MakeMsgMarketUpdateContinuousMatching,
+		setup:     cli.SetupCmdTxMarketUpdateContinuousMatching,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgMarketUpdateContinuousMatchingRequest]{
+		{
+			name:   "some errors",
+			flags:  []string{"--market", "56"},
+			expMsg: &exchange.MsgMarketUpdateContinuousMatchingRequest{MarketId: 56},
+			expErr: joinErrs(
+				"no <admin> provided",
+				"exactly one of --enable or --disable must be provided",
+			),
+		},
+		{
+			name:      "enable",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--enable", "--market", "4"},
+			expMsg: &exchange.MsgMarketUpdateContinuousMatchingRequest{
+				Admin:              sdk.AccAddress("FromAddress_________").String(),
+				MarketId:           4,
+				ContinuousMatching: true,
+			},
+		},
+		{
+			name:      "disable",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--admin", "Blake", "--market", "94", "--disable"},
+			expMsg: &exchange.MsgMarketUpdateContinuousMatchingRequest{
+				Admin:              "Blake",
+				MarketId:           94,
+				ContinuousMatching: false,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdTxMarketUpdateBatchAuction(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdTxMarketUpdateBatchAuction",
+		setup: cli.SetupCmdTxMarketUpdateBatchAuction,
+		expFlags: []string{
+			cli.FlagAdmin, cli.FlagAuthority,
+			cli.FlagMarket, cli.FlagBatchAuctionInterval,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
+			cli.FlagAdmin: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagAuthority: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagMarket:               {required: {"true"}},
+			cli.FlagBatchAuctionInterval: {required: {"true"}},
+		},
+		expInUse: []string{
+			cli.ReqAdminUse, "--market <market id>", "--batch-auction-interval <blocks>",
+			cli.ReqAdminDesc,
+		},
+	})
+}
+
+func TestMakeMsgMarketUpdateBatchAuction(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketUpdateBatchAuctionRequest]{
+		makerName: "MakeMsgMarketUpdateBatchAuction",
+		maker:     cli.MakeMsgMarketUpdateBatchAuction,
+		setup:     cli.SetupCmdTxMarketUpdateBatchAuction,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgMarketUpdateBatchAuctionRequest]{
+		{
+			name:   "no admin",
+			flags:  []string{"--market", "56", "--batch-auction-interval", "10"},
+			expMsg: &exchange.MsgMarketUpdateBatchAuctionRequest{MarketId: 56, BatchAuctionInterval: 10},
+			expErr: "no <admin> provided",
+		},
+		{
+			name:      "enable",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--batch-auction-interval", "25", "--market", "4"},
+			expMsg: &exchange.MsgMarketUpdateBatchAuctionRequest{
+				Admin:                sdk.AccAddress("FromAddress_________").String(),
+				MarketId:             4,
+				BatchAuctionInterval: 25,
+			},
+		},
+		{
+			name:      "disable",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--admin", "Blake", "--market", "94", "--batch-auction-interval", "0"},
+			expMsg: &exchange.MsgMarketUpdateBatchAuctionRequest{
+				Admin:                "Blake",
+				MarketId:             94,
+				BatchAuctionInterval: 0,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdTxMarketUpdateSelfTradePrevention(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdTxMarketUpdateSelfTradePrevention",
+		setup: cli.SetupCmdTxMarketUpdateSelfTradePrevention,
+		expFlags: []string{
+			cli.FlagAdmin, cli.FlagAuthority,
+			cli.FlagMarket, cli.FlagSelfTradePrevention,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
+			cli.FlagAdmin: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagAuthority: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagMarket:              {required: {"true"}},
+			cli.FlagSelfTradePrevention: {required: {"true"}},
+		},
+		expInUse: []string{
+			cli.ReqAdminUse, "--market <market id>", "--self-trade-prevention <mode>",
+			cli.ReqAdminDesc, cli.SelfTradePreventionDesc,
+		},
+	})
+}
+
+func TestMakeMsgMarketUpdateSelfTradePrevention(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketUpdateSelfTradePreventionRequest]{
+		makerName: "MakeMsgMarketUpdateSelfTradePrevention",
+		maker:     cli.MakeMsgMarketUpdateSelfTradePrevention,
+		setup:     cli.SetupCmdTxMarketUpdateSelfTradePrevention,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgMarketUpdateSelfTradePreventionRequest]{
+		{
+			name:   "no admin",
+			flags:  []string{"--market", "56", "--self-trade-prevention", "cancel-newest"},
+			expMsg: &exchange.MsgMarketUpdateSelfTradePreventionRequest{MarketId: 56, SelfTradePrevention: exchange.SelfTradePrevention_cancel_newest},
+			expErr: "no <admin> provided",
+		},
+		{
+			name:      "invalid mode",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--market", "3", "--self-trade-prevention", "cancel-both"},
+			expMsg: &exchange.MsgMarketUpdateSelfTradePreventionRequest{
+				Admin:    sdk.AccAddress("FromAddress_________").String(),
+				MarketId: 3,
+			},
+			expErr: "invalid self-trade prevention: \"cancel-both\"",
+		},
+		{
+			name:      "decrement both",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--self-trade-prevention", "decrement-both", "--market", "4"},
+			expMsg: &exchange.MsgMarketUpdateSelfTradePreventionRequest{
+				Admin:               sdk.AccAddress("FromAddress_________").String(),
+				MarketId:            4,
+				SelfTradePrevention: exchange.SelfTradePrevention_decrement_both,
+			},
+		},
+		{
+			name:      "none",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--admin", "Blake", "--market", "94", "--self-trade-prevention", "none"},
+			expMsg: &exchange.MsgMarketUpdateSelfTradePreventionRequest{
+				Admin:               "Blake",
+				MarketId:            94,
+				SelfTradePrevention: exchange.SelfTradePrevention_unspecified,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdTxMarketUpdatePublishPrices(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdTxMarketUpdatePublishPrices",
+		setup: cli.SetupCmdTxMarketUpdatePublishPrices,
+		expFlags: []string{
+			cli.FlagAdmin, cli.FlagAuthority,
+			cli.FlagMarket, cli.FlagEnable, cli.FlagDisable,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
+			cli.FlagAdmin: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagAuthority: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagMarket: {required: {"true"}},
+			cli.FlagEnable: {
+				mutExc: {cli.FlagEnable + " " + cli.FlagDisable},
+				oneReq: {cli.FlagEnable + " " + cli.FlagDisable},
+			},
+			cli.FlagDisable: {
+				mutExc: {cli.FlagEnable + " " + cli.FlagDisable},
+				oneReq: {cli.FlagEnable + " " + cli.FlagDisable},
+			},
+		},
+		expInUse: []string{
+			cli.ReqAdminUse, "--market <market id>", cli.ReqEnableDisableUse,
+			cli.ReqAdminDesc, cli.ReqEnableDisableDesc,
+		},
+	})
+}
+
+func TestMakeMsgMarketUpdatePublishPrices(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketUpdatePublishPricesRequest]{
+		makerName: "MakeMsgMarketUpdatePublishPrices",
+		maker:     cli.MakeMsgMarketUpdatePublishPrices,
+		setup:     cli.SetupCmdTxMarketUpdatePublishPrices,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgMarketUpdatePublishPricesRequest]{
+		{
+			name:   "some errors",
+			flags:  []string{"--market", "56"},
+			expMsg: &exchange.MsgMarketUpdatePublishPricesRequest{MarketId: 56},
+			expErr: joinErrs(
+				"no <admin> provided",
+				"exactly one of --enable or --disable must be provided",
+			),
+		},
+		{
+			name:      "enable",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--enable", "--market", "4"},
+			expMsg: &exchange.MsgMarketUpdatePublishPricesRequest{
+				Admin:         sdk.AccAddress("FromAddress_________").String(),
+				MarketId:      4,
+				PublishPrices: true,
+			},
+		},
+		{
+			name:      "disable",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--admin", "Blake", "--market", "94", "--disable"},
+			expMsg: &exchange.MsgMarketUpdatePublishPricesRequest{
+				Admin:         "Blake",
+				MarketId:      94,
+				PublishPrices: false,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdTxMarketUpdateExternalIDScope(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdTxMarketUpdateExternalIDScope",
+		setup: cli.SetupCmdTxMarketUpdateExternalIDScope,
+		expFlags: []string{
+			cli.FlagAdmin, cli.FlagAuthority,
+			cli.FlagMarket, cli.FlagExternalIDScope,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
+			cli.FlagAdmin: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagAuthority: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagMarket:          {required: {"true"}},
+			cli.FlagExternalIDScope: {required: {"true"}},
+		},
+		expInUse: []string{
+			cli.ReqAdminUse, "--market <market id>", "--external-id-scope <scope>",
+			cli.ReqAdminDesc, cli.ExternalIDScopeDesc,
+		},
+	})
+}
+
+func TestMakeMsgMarketUpdateExternalIDScope(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketUpdateExternalIDScopeRequest]{
+		makerName: "MakeMsgMarketUpdateExternalIDScope",
+		maker:     cli.MakeMsgMarketUpdateExternalIDScope,
+		setup:     cli.SetupCmdTxMarketUpdateExternalIDScope,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgMarketUpdateExternalIDScopeRequest]{
+		{
+			name:   "no admin",
+			flags:  []string{"--market", "56", "--external-id-scope", "account"},
+			expMsg: &exchange.MsgMarketUpdateExternalIDScopeRequest{MarketId: 56, ExternalIdScope: exchange.ExternalIDScope_account},
+			expErr: "no <admin> provided",
+		},
+		{
+			name:      "invalid scope",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--market", "3", "--external-id-scope", "global"},
+			expMsg: &exchange.MsgMarketUpdateExternalIDScopeRequest{
+				Admin:    sdk.AccAddress("FromAddress_________").String(),
+				MarketId: 3,
+			},
+			expErr: "invalid external id scope: \"global\"",
+		},
+		{
+			name:      "account",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--external-id-scope", "account", "--market", "4"},
+			expMsg: &exchange.MsgMarketUpdateExternalIDScopeRequest{
+				Admin:           sdk.AccAddress("FromAddress_________").String(),
+				MarketId:        4,
+				ExternalIdScope: exchange.ExternalIDScope_account,
+			},
+		},
+		{
+			name:      "market",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--admin", "Blake", "--market", "94", "--external-id-scope", "market"},
+			expMsg: &exchange.MsgMarketUpdateExternalIDScopeRequest{
+				Admin:           "Blake",
+				MarketId:        94,
+				ExternalIdScope: exchange.ExternalIDScope_unspecified,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdTxMarketUpdateOrderRateLimit(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdTxMarketUpdateOrderRateLimit",
+		setup: cli.SetupCmdTxMarketUpdateOrderRateLimit,
+		expFlags: []string{
+			cli.FlagAdmin, cli.FlagAuthority,
+			cli.FlagMarket, cli.FlagMaxOrders, cli.FlagWindowBlocks,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
+			cli.FlagAdmin: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagAuthority: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagMarket:    {required: {"true"}},
+			cli.FlagMaxOrders: {required: {"true"}},
+		},
+		expInUse: []string{
+			cli.ReqAdminUse, "--market <market id>", "--max-orders <count>", "[--window-blocks <blocks>]",
+			cli.ReqAdminDesc, cli.OrderRateLimitDesc,
+		},
+	})
+}
+
+func TestMakeMsgMarketUpdateOrderRateLimit(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketUpdateOrderRateLimitRequest]{
+		makerName: "MakeMsgMarketUpdateOrderRateLimit",
+		maker:     cli.MakeMsgMarketUpdateOrderRateLimit,
+		setup:     cli.SetupCmdTxMarketUpdateOrderRateLimit,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgMarketUpdateOrderRateLimitRequest]{
+		{
+			name:  "no admin",
+			flags: []string{"--market", "56", "--max-orders", "10"},
+			expMsg: &exchange.MsgMarketUpdateOrderRateLimitRequest{
+				MarketId:       56,
+				OrderRateLimit: &exchange.OrderRateLimit{MaxOrders: 10, WindowBlocks: 1},
+			},
+			expErr: "no <admin> provided",
+		},
+		{
+			name:      "remove limit",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--market", "3", "--max-orders", "0"},
+			expMsg: &exchange.MsgMarketUpdateOrderRateLimitRequest{
+				Admin:    sdk.AccAddress("FromAddress_________").String(),
+				MarketId: 3,
+			},
+		},
+		{
+			name:      "with window",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--admin", "Blake", "--window-blocks", "20", "--market", "94", "--max-orders", "5"},
+			expMsg: &exchange.MsgMarketUpdateOrderRateLimitRequest{
+				Admin:          "Blake",
+				MarketId:       94,
+				OrderRateLimit: &exchange.OrderRateLimit{MaxOrders: 5, WindowBlocks: 20},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdTxMarketUpdateMaxExposure(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdTxMarketUpdateMaxExposure",
+		setup: cli.SetupCmdTxMarketUpdateMaxExposure,
+		expFlags: []string{
+			cli.FlagAdmin, cli.FlagAuthority,
+			cli.FlagMarket, cli.FlagMaxExposure,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
+			cli.FlagAdmin: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagAuthority: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagMarket:      {required: {"true"}},
+			cli.FlagMaxExposure: {required: {"true"}},
+		},
+		expInUse: []string{
+			cli.ReqAdminUse, "--market <market id>", "--max-exposure <coin>",
+			cli.ReqAdminDesc, cli.MaxExposureDesc,
+		},
+	})
+}
+
+func TestMakeMsgMarketUpdateMaxExposure(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketUpdateMaxExposureRequest]{
+		makerName: "MakeMsgMarketUpdateMaxExposure",
+		maker:     cli.MakeMsgMarketUpdateMaxExposure,
+		setup:     cli.SetupCmdTxMarketUpdateMaxExposure,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgMarketUpdateMaxExposureRequest]{
+		{
+			name:  "no admin",
+			flags: []string{"--market", "56", "--max-exposure", "10cherry"},
+			expMsg: &exchange.MsgMarketUpdateMaxExposureRequest{
+				MarketId:    56,
+				MaxExposure: &sdk.Coin{Denom: "cherry", Amount: sdkmath.NewInt(10)},
+			},
+			expErr: "no <admin> provided",
+		},
+		{
+			name:      "invalid max exposure",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--market", "3", "--max-exposure", "cherry"},
+			expMsg: &exchange.MsgMarketUpdateMaxExposureRequest{
+				Admin:    sdk.AccAddress("FromAddress_________").String(),
+				MarketId: 3,
+			},
+			expErr: "error parsing --max-exposure as a coin: invalid coin expression: \"cherry\"",
+		},
+		{
+			name:      "remove max exposure",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--market", "3", "--max-exposure", "0cherry"},
+			expMsg: &exchange.MsgMarketUpdateMaxExposureRequest{
+				Admin:    sdk.AccAddress("FromAddress_________").String(),
+				MarketId: 3,
+			},
+		},
+		{
+			name:      "with admin",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--admin", "Blake", "--max-exposure", "5000nhash", "--market", "94"},
+			expMsg: &exchange.MsgMarketUpdateMaxExposureRequest{
+				Admin:       "Blake",
+				MarketId:    94,
+				MaxExposure: &sdk.Coin{Denom: "nhash", Amount: sdkmath.NewInt(5000)},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdTxMarketUpdateFillAlgorithm(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdTxMarketUpdateFillAlgorithm",
+		setup: cli.SetupCmdTxMarketUpdateFillAlgorithm,
+		expFlags: []string{
+			cli.FlagAdmin, cli.FlagAuthority,
+			cli.FlagMarket, cli.FlagFillAlgorithm,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
+			cli.FlagAdmin: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagAuthority: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagMarket:        {required: {"true"}},
+			cli.FlagFillAlgorithm: {required: {"true"}},
+		},
+		expInUse: []string{
+			cli.ReqAdminUse, "--market <market id>", "--fill-algorithm <algorithm>",
+			cli.ReqAdminDesc, cli.FillAlgorithmDesc,
+		},
+	})
+}
+
+func TestMakeMsgMarketUpdateFillAlgorithm(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketUpdateFillAlgorithmRequest]{
+		makerName: "MakeMsgMarketUpdateFillAlgorithm",
+		maker:     cli.MakeMsgMarketUpdateFillAlgorithm,
+		setup:     cli.SetupCmdTxMarketUpdateFillAlgorithm,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgMarketUpdateFillAlgorithmRequest]{
+		{
+			name:   "no admin",
+			flags:  []string{"--market", "56", "--fill-algorithm", "pro-rata"},
+			expMsg: &exchange.MsgMarketUpdateFillAlgorithmRequest{MarketId: 56, FillAlgorithm: exchange.FillAlgorithm_pro_rata},
+			expErr: "no <admin> provided",
+		},
+		{
+			name:      "invalid algorithm",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--market", "3", "--fill-algorithm", "fifo"},
+			expMsg: &exchange.MsgMarketUpdateFillAlgorithmRequest{
+				Admin:    sdk.AccAddress("FromAddress_________").String(),
+				MarketId: 3,
+			},
+			expErr: "invalid fill algorithm: \"fifo\"",
+		},
+		{
+			name:      "price time",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--fill-algorithm", "price-time", "--market", "4"},
+			expMsg: &exchange.MsgMarketUpdateFillAlgorithmRequest{
+				Admin:         sdk.AccAddress("FromAddress_________").String(),
+				MarketId:      4,
+				FillAlgorithm: exchange.FillAlgorithm_price_time,
+			},
+		},
+		{
+			name:      "unspecified",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--admin", "Blake", "--market", "94", "--fill-algorithm", "unspecified"},
+			expMsg: &exchange.MsgMarketUpdateFillAlgorithmRequest{
+				Admin:         "Blake",
+				MarketId:      94,
+				FillAlgorithm: exchange.FillAlgorithm_unspecified,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdTxMarketUpdateIntermediaryDenom(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdTxMarketUpdateIntermediaryDenom",
+		setup: cli.SetupCmdTxMarketUpdateIntermediaryDenom,
+		expFlags: []string{
+			cli.FlagAdmin, cli.FlagAuthority,
+			cli.FlagMarket, cli.FlagDenom,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
+			cli.FlagAdmin: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagAuthority: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagMarket: {required: {"true"}},
+			cli.FlagDenom:  {required: {"true"}},
+		},
+		expInUse: []string{
+			cli.ReqAdminUse, "--market <market id>", "--denom <denom>",
+			cli.ReqAdminDesc,
+		},
+	})
+}
+
+func TestMakeMsgMarketUpdateIntermediaryDenom(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketUpdateIntermediaryDenomRequest]{
+		makerName: "MakeMsgMarketUpdateIntermediaryDenom",
+		maker:     cli.MakeMsgMarketUpdateIntermediaryDenom,
+		setup:     cli.SetupCmdTxMarketUpdateIntermediaryDenom,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgMarketUpdateIntermediaryDenomRequest]{
+		{
+			name:  "an error",
+			flags: []string{"--market", "12"},
+			expMsg: &exchange.MsgMarketUpdateIntermediaryDenomRequest{
+				MarketId: 12,
+			},
+			expErr: "no <admin> provided",
+		},
+		{
+			name:      "admin from from",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--market", "4", "--denom", "cherry"},
+			expMsg: &exchange.MsgMarketUpdateIntermediaryDenomRequest{
+				Admin:             sdk.AccAddress("FromAddress_________").String(),
+				MarketId:          4,
+				IntermediaryDenom: "cherry",
+			},
+		},
+		{
+			name:  "admin from flag",
+			flags: []string{"--market", "51", "--denom", "prune", "--admin", "blake"},
+			expMsg: &exchange.MsgMarketUpdateIntermediaryDenomRequest{
+				Admin:             "blake",
+				MarketId:          51,
+				IntermediaryDenom: "prune",
+			},
+		},
+		{
+			name:  "admin as authority",
+			flags: []string{"--market", "7", "--authority", "--denom", "banana"},
+			expMsg: &exchange.MsgMarketUpdateIntermediaryDenomRequest{
+				Admin:             cli.AuthorityAddr.String(),
+				MarketId:          7,
+				IntermediaryDenom: "banana",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdTxMarketManagePermissions(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdTxMarketManagePermissions",
+		setup: cli.SetupCmdTxMarketManagePermissions,
+		expFlags: []string{
+			cli.FlagAdmin, cli.FlagAuthority,
+			cli.FlagMarket, cli.FlagRevokeAll, cli.FlagRevoke, cli.FlagGrant,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
+			cli.FlagAdmin: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagAuthority: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagMarket:    {required: {"true"}},
+			cli.FlagRevokeAll: {oneReq: {cli.FlagRevokeAll + " " + cli.FlagRevoke + " " + cli.FlagGrant}},
+			cli.FlagRevoke:    {oneReq: {cli.FlagRevokeAll + " " + cli.FlagRevoke + " " + cli.FlagGrant}},
+			cli.FlagGrant:     {oneReq: {cli.FlagRevokeAll + " " + cli.FlagRevoke + " " + cli.FlagGrant}},
+		},
+		expInUse: []string{
+			cli.ReqAdminUse, "--market <market id>",
+			"[--revoke-all <addresses>]", "[--revoke <access grants>]", "[--grant <access grants>]",
+			cli.ReqAdminDesc, cli.RepeatableDesc, cli.AccessGrantsDesc,
+		},
+	})
+}
+
+func TestMakeMsgMarketManagePermissions(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketManagePermissionsRequest]{
+		makerName: "MakeMsgMarketManagePermissions",
+		maker:     cli.MakeMsgMarketManagePermissions,
+		setup:     cli.SetupCmdTxMarketManagePermissions,
+	}
+
+	accessGrant := func(addr string, perms ...exchange.Permission) exchange.AccessGrant {
+		return exchange.AccessGrant{Address: addr, Permissions: perms}
+	}
+	tests := []txMakerTestCase[*exchange.MsgMarketManagePermissionsRequest]{
+		{
+			name:  "some errors",
+			flags: []string{"--revoke", "addr8:oops", "--revoke", "Ryan", "--market", "1", "--grant", ":settle"},
+			expMsg: &exchange.MsgMarketManagePermissionsRequest{
+				MarketId:  1,
+				RevokeAll: []string{},
+				ToRevoke:  []exchange.AccessGrant{},
+				ToGrant:   []exchange.AccessGrant{},
+			},
+			expErr: joinErrs(
+				"no <admin> provided",
+				"could not parse permissions for \"addr8\" from \"oops\": invalid permission: \"oops\"",
+				"could not parse \"Ryan\" as an <access grant>: expected format <address>:<permissions>[:<expiration>]",
+				"invalid <access grant> \":settle\": both an <address> and <permissions> are required",
+			),
+		},
+		{
+			name:      "just a revoke",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags: []string{
+				"--market", "6", "--revoke", "alan:settle+update"},
+			expMsg: &exchange.MsgMarketManagePermissionsRequest{
+				Admin:     sdk.AccAddress("FromAddress_________").String(),
+				MarketId:  6,
+				RevokeAll: []string{},
+				ToRevoke: []exchange.AccessGrant{
+					accessGrant("alan", exchange.Permission_settle, exchange.Permission_update),
+				},
+				ToGrant: nil,
+			},
+		},
+		{
+			name: "all fields",
+			flags: []string{
+				"--market", "123", "--admin", "Frankie", "--revoke-all", "Freddie,Fritz,Forrest",
+				"--revoke", "Dylan:settle,Devin:update", "--revoke-all", "Finn",
+				"--grant", "Sam:permissions+update", "--revoke", "Dave:setids",
+				"--grant", "Skylar:all,Fritz:all",
+			},
+			expMsg: &exchange.MsgMarketManagePermissionsRequest{
+				Admin:     "Frankie",
+				MarketId:  123,
+				RevokeAll: []string{"Freddie", "Fritz", "Forrest", "Finn"},
+				ToRevoke: []exchange.AccessGrant{
+					accessGrant("Dylan", exchange.Permission_settle),
+					accessGrant("Devin", exchange.Permission_update),
+					accessGrant("Dave", exchange.Permission_set_ids),
+				},
+				ToGrant: []exchange.AccessGrant{
+					accessGrant("Sam", exchange.Permission_permissions, exchange.Permission_update),
+					accessGrant("Skylar", exchange.AllPermissions()...),
+					accessGrant("Fritz", exchange.AllPermissions()...),
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdTxMarketTransferAuthority(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdTxMarketTransferAuthority",
+		setup: cli.SetupCmdTxMarketTransferAuthority,
+		expFlags: []string{
+			cli.FlagAdmin, cli.FlagAuthority,
+			cli.FlagMarket, cli.FlagNewAuthority, cli.FlagGrant,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
+			cli.FlagAdmin: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagAuthority: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagMarket:       {required: {"true"}},
+			cli.FlagNewAuthority: {required: {"true"}},
+			cli.FlagGrant:        {required: {"true"}},
+		},
+		expInUse: []string{
+			cli.ReqAdminUse, "--market <market id>", "--new-authority <address>", "--grant <access grants>",
+			cli.ReqAdminDesc, cli.RepeatableDesc, cli.AccessGrantsDesc,
+			"Once accepted, the provided <access grants> replace all of the market's existing access grants.",
+		},
+	})
+}
+
+func TestMakeMsgMarketTransferAuthority(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketTransferAuthorityRequest]{
+		makerName: "MakeMsgMarketTransferAuthority",
+		maker:     cli.MakeMsgMarketTransferAuthority,
+		setup:     cli.SetupCmdTxMarketTransferAuthority,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgMarketTransferAuthorityRequest]{
+		{
+			name:  "some errors",
+			flags: []string{"--market", "1", "--grant", "addr8:oops"},
+			expMsg: &exchange.MsgMarketTransferAuthorityRequest{
+				MarketId:     1,
+				AccessGrants: []exchange.AccessGrant{},
+			},
+			expErr: joinErrs(
+				"no <admin> provided",
+				"could not parse permissions for \"addr8\" from \"oops\": invalid permission: \"oops\"",
+			),
+		},
+		{
+			name:      "admin from from",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--market", "6", "--new-authority", "newbie", "--grant", "newbie:all"},
+			expMsg: &exchange.MsgMarketTransferAuthorityRequest{
+				Admin:        sdk.AccAddress("FromAddress_________").String(),
+				MarketId:     6,
+				NewAuthority: "newbie",
+				AccessGrants: []exchange.AccessGrant{
+					{Address: "newbie", Permissions: exchange.AllPermissions()},
+				},
+			},
+		},
+		{
+			name: "all fields",
+			flags: []string{
+				"--authority", "--market", "12", "--new-authority", "Sam",
+				"--grant", "Sam:permissions+update,Dave:settle", "--grant", "Skylar:cancel",
+			},
+			expMsg: &exchange.MsgMarketTransferAuthorityRequest{
+				Admin:        cli.AuthorityAddr.String(),
+				MarketId:     12,
+				NewAuthority: "Sam",
+				AccessGrants: []exchange.AccessGrant{
+					{Address: "Sam", Permissions: []exchange.Permission{exchange.Permission_permissions, exchange.Permission_update}},
+					{Address: "Dave", Permissions: []exchange.Permission{exchange.Permission_settle}},
+					{Address: "Skylar", Permissions: []exchange.Permission{exchange.Permission_cancel}},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdTxMarketAcceptAuthority(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdTxMarketAcceptAuthority",
+		setup: cli.SetupCmdTxMarketAcceptAuthority,
+		expFlags: []string{
+			cli.FlagSigner, cli.FlagMarket,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagSigner}},
+			cli.FlagSigner: {oneReq: {flags.FlagFrom + " " + cli.FlagSigner}},
+			cli.FlagMarket: {required: {"true"}},
+		},
+		expInUse: []string{
+			"{--from|--signer} <signer>", "--market <market id>",
+			cli.ReqSignerDesc(cli.FlagSigner),
+		},
+	})
+}
+
+func TestMakeMsgMarketAcceptAuthority(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketAcceptAuthorityRequest]{
+		makerName: "MakeMsgMarketAcceptAuthority",
+		maker:     cli.MakeMsgMarketAcceptAuthority,
+		setup:     cli.SetupCmdTxMarketAcceptAuthority,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgMarketAcceptAuthorityRequest]{
+		{
+			name:   "nothing",
+			expMsg: &exchange.MsgMarketAcceptAuthorityRequest{},
+			expErr: "no <signer> provided",
+		},
+		{
+			name:      "signer from from",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--market", "4"},
+			expMsg: &exchange.MsgMarketAcceptAuthorityRequest{
+				NewAuthority: sdk.AccAddress("FromAddress_________").String(),
+				MarketId:     4,
+			},
+		},
+		{
+			name:  "all fields",
+			flags: []string{"--signer", "newbie", "--market", "2"},
+			expMsg: &exchange.MsgMarketAcceptAuthorityRequest{
+				NewAuthority: "newbie",
+				MarketId:     2,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdTxMarketManageReqAttrs(t *testing.T) {
+	tc := setupTestCase{
+		name:  "SetupCmdTxMarketManageReqAttrs",
+		setup: cli.SetupCmdTxMarketManageReqAttrs,
+		expFlags: []string{
+			cli.FlagAdmin, cli.FlagAuthority, cli.FlagMarket,
+			cli.FlagAskAdd, cli.FlagAskRemove, cli.FlagBidAdd, cli.FlagBidRemove,
+			cli.FlagCommitmentAdd, cli.FlagCommitmentRemove,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
+			cli.FlagAdmin: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagAuthority: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagMarket: {required: {"true"}},
+		},
+		expInUse: []string{
+			cli.ReqAdminUse, "--market <market id>",
+			"[--ask-add <attrs>]", "[--ask-remove <attrs>]",
+			"[--bid-add <attrs>]", "[--bid-remove <attrs>]",
+			"[--commitment-add <attrs>]", "[--commitment-remove <attrs>]",
+			cli.ReqAdminDesc, cli.RepeatableDesc,
+		},
+	}
+
+	oneReqFlags := []string{
+		cli.FlagAskAdd, cli.FlagAskRemove, cli.FlagBidAdd, cli.FlagBidRemove,
+		cli.FlagCommitmentAdd, cli.FlagCommitmentRemove,
+	}
+	oneReqVal := strings.Join(oneReqFlags, " ")
+	if tc.expAnnotations == nil {
+		tc.expAnnotations = make(map[string]map[string][]string)
+	}
+	for _, name := range oneReqFlags {
+		if tc.expAnnotations[name] == nil {
+			tc.expAnnotations[name] = make(map[string][]string)
+		}
+		tc.expAnnotations[name][oneReq] = []string{oneReqVal}
+	}
+
+	runSetupTestCase(t, tc)
+}
+
+func TestMakeMsgMarketManageReqAttrs(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketManageReqAttrsRequest]{
+		makerName: "MakeMsgMarketManageReqAttrs",
+		maker:     cli.MakeMsgMarketManageReqAttrs,
+		setup:     cli.SetupCmdTxMarketManageReqAttrs,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgMarketManageReqAttrsRequest]{
+		{
+			name:  "no admin",
+			flags: []string{"--market", "41", "--bid-add", "*.kyc"},
+			expMsg: &exchange.MsgMarketManageReqAttrsRequest{
+				MarketId:                 41,
+				CreateAskToAdd:           []string{},
+				CreateAskToRemove:        []string{},
+				CreateBidToAdd:           []string{"*.kyc"},
+				CreateBidToRemove:        []string{},
+				CreateCommitmentToAdd:    []string{},
+				CreateCommitmentToRemove: []string{},
+			},
+			expErr: "no <admin> provided",
+		},
+		{
+			name:      "all fields",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags: []string{
+				"--market", "44444",
+				"--ask-add", "def.abc,*.xyz", "--ask-remove", "uvw.xyz",
+				"--bid-add", "ghi.abc,*.xyz", "--bid-remove", "rst.xyz",
+				"--commitment-add", "jkl.abc,*.xyz", "--commitment-remove", "opq.xyz",
+			},
+			expMsg: &exchange.MsgMarketManageReqAttrsRequest{
+				Admin:                    sdk.AccAddress("FromAddress_________").String(),
+				MarketId:                 44444,
+				CreateAskToAdd:           []string{"def.abc", "*.xyz"},
+				CreateAskToRemove:        []string{"uvw.xyz"},
+				CreateBidToAdd:           []string{"ghi.abc", "*.xyz"},
+				CreateBidToRemove:        []string{"rst.xyz"},
+				CreateCommitmentToAdd:    []string{"jkl.abc", "*.xyz"},
+				CreateCommitmentToRemove: []string{"opq.xyz"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdTxMarketManageAcceptedDenoms(t *testing.T) {
+	tc := setupTestCase{
+		name:  "SetupCmdTxMarketManageAcceptedDenoms",
+		setup: cli.SetupCmdTxMarketManageAcceptedDenoms,
+		expFlags: []string{
+			cli.FlagAdmin, cli.FlagAuthority, cli.FlagMarket,
+			cli.FlagAssetDenomsAdd, cli.FlagAssetDenomsRemove, cli.FlagPriceDenomsAdd, cli.FlagPriceDenomsRemove,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
+			cli.FlagAdmin: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagAuthority: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagMarket: {required: {"true"}},
+		},
+		expInUse: []string{
+			cli.ReqAdminUse, "--market <market id>",
+			"[--asset-denoms-add <denoms>]", "[--asset-denoms-remove <denoms>]",
+			"[--price-denoms-add <denoms>]", "[--price-denoms-remove <denoms>]",
+			cli.ReqAdminDesc, cli.RepeatableDesc, cli.AcceptedDenomsDesc,
+		},
+	}
+
+	oneReqFlags := []string{
+		cli.FlagAssetDenomsAdd, cli.FlagAssetDenomsRemove, cli.FlagPriceDenomsAdd, cli.FlagPriceDenomsRemove,
+	}
+	oneReqVal := strings.Join(oneReqFlags, " ")
+	if tc.expAnnotations == nil {
+		tc.expAnnotations = make(map[string]map[string][]string)
+	}
+	for _, name := range oneReqFlags {
+		if tc.expAnnotations[name] == nil {
+			tc.expAnnotations[name] = make(map[string][]string)
+		}
+		tc.expAnnotations[name][oneReq] = []string{oneReqVal}
+	}
+
+	runSetupTestCase(t, tc)
+}
+
+func TestMakeMsgMarketManageAcceptedDenoms(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketManageAcceptedDenomsRequest]{
+		makerName: "MakeMsgMarketManageAcceptedDenoms",
+		maker:     cli.MakeMsgMarketManageAcceptedDenoms,
+		setup:     cli.SetupCmdTxMarketManageAcceptedDenoms,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgMarketManageAcceptedDenomsRequest]{
+		{
+			name:  "no admin",
+			flags: []string{"--market", "41", "--price-denoms-add", "nhash"},
+			expMsg: &exchange.MsgMarketManageAcceptedDenomsRequest{
+				MarketId:            41,
+				AssetDenomsToAdd:    []string{},
+				AssetDenomsToRemove: []string{},
+				PriceDenomsToAdd:    []string{"nhash"},
+				PriceDenomsToRemove: []string{},
+			},
+			expErr: "no <admin> provided",
+		},
+		{
+			name:      "all fields",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags: []string{
+				"--market", "44444",
+				"--asset-denoms-add", "apple,banana", "--asset-denoms-remove", "cherry",
+				"--price-denoms-add", "nhash,usd", "--price-denoms-remove", "euro",
+			},
+			expMsg: &exchange.MsgMarketManageAcceptedDenomsRequest{
+				Admin:               sdk.AccAddress("FromAddress_________").String(),
+				MarketId:            44444,
+				AssetDenomsToAdd:    []string{"apple", "banana"},
+				AssetDenomsToRemove: []string{"cherry"},
+				PriceDenomsToAdd:    []string{"nhash", "usd"},
+				PriceDenomsToRemove: []string{"euro"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdTxCreatePayment(t *testing.T) {
+	tc := setupTestCase{
+		name:  "SetupCmdTxCreatePayment",
+		setup: cli.SetupCmdTxCreatePayment,
+		expFlags: []string{
+			cli.FlagSource, cli.FlagSourceAmount,
+			cli.FlagTarget, cli.FlagTargetAmount,
+			cli.FlagExternalID, cli.FlagExpiration, cli.FlagDisputeWindow, cli.FlagFile,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expInUse: []string{
+			"{--from|--source} <source>", "[--source-amount <source amount>]",
+			"[--target <target>]", "[--target-amount <target amount>]",
+			"[--external-id <external id>]", "[--expiration <expiration>]",
+			"[--dispute-window <seconds>]", "[--file <filename>]",
+			cli.ReqSignerDesc(cli.FlagSource),
+			cli.MsgFileDesc(&exchange.MsgCreatePaymentRequest{}),
+		},
+	}
+	addOneReqAnnotations(&tc, cli.FlagFile, flags.FlagFrom, cli.FlagSource)
+
+	runSetupTestCase(t, tc)
+}
+
+func TestMakeMsgCreatePayment(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgCreatePaymentRequest]{
+		makerName: "MakeMsgCreatePayment",
+		maker:     cli.MakeMsgCreatePayment,
+		setup:     cli.SetupCmdTxCreatePayment,
+	}
+
+	coins := func(str string) sdk.Coins {
+		rv, err := sdk.ParseCoinsNormalized(str)
+		require.NoError(t, err, "ParseCoinsNormalized(%q)", str)
+		return rv
+	}
+	testAddr := func(prefix string) string {
+		return sdk.AccAddress(prefix + strings.Repeat("_", 20-len(prefix))).String()
+	}
+	newPayment := func(sourceAddrPrefix, sourceAmount, targetAddrPrefix, targetAmount, externalID string) exchange.Payment {
+		return exchange.Payment{
+			Source:       testAddr(sourceAddrPrefix),
+			SourceAmount: coins(sourceAmount),
+			Target:       testAddr(targetAddrPrefix),
+			TargetAmount: coins(targetAmount),
+			ExternalId:   externalID,
+		}
+	}
+
+	filePayment := newPayment("file_source", "88strawberry", "file_target", "44tangerine", "some-file-id")
+	fileMsg := &exchange.MsgCreatePaymentRequest{Payment: filePayment}
+	expiration := time.Date(2030, 6, 7, 8, 9, 10, 0, time.UTC)
+	tx := newTx(t, fileMsg)
+	tdir := t.TempDir()
+	txFN := filepath.Join(tdir, "create-payment.json")
+	writeFileAsJson(t, txFN, tx)
+
+	tests := []txMakerTestCase[*exchange.MsgCreatePaymentRequest]{
+		{
+			name: "no source",
+			flags: []string{
+				"--source-amount", "3starfruit",
+				"--target", testAddr("some-target"),
+			},
+			expMsg: &exchange.MsgCreatePaymentRequest{Payment: exchange.Payment{
+				Source:       "",
+				SourceAmount: coins("3starfruit"),
+				Target:       testAddr("some-target"),
+				TargetAmount: nil,
+				ExternalId:   "",
+			}},
+			expErr: "no <source> provided",
+		},
+		{
+			name:      "all fields",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("source_from_from____")},
+			flags: []string{
+				"--external-id", "random-dcic",
+				"--target", testAddr("my-target"),
+				"--source-amount", "13strawberry",
+				"--target-amount", "31tangerine",
+				"--expiration", "2030-06-07T08:09:10Z",
+				"--dispute-window", "86400",
+			},
+			expMsg: &exchange.MsgCreatePaymentRequest{Payment: exchange.Payment{
+				Source:               sdk.AccAddress("source_from_from____").String(),
+				SourceAmount:         coins("13strawberry"),
+				Target:               testAddr("my-target"),
+				TargetAmount:         coins("31tangerine"),
+				ExternalId:           "random-dcic",
+				Expiration:           &expiration,
+				DisputeWindowSeconds: 86400,
+			}},
+		},
+		{
+			name:      "bad expiration",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("source_from_from____")},
+			flags:     []string{"--expiration", "tomorrow"},
+			expMsg: &exchange.MsgCreatePaymentRequest{Payment: exchange.Payment{
+				Source: sdk.AccAddress("source_from_from____").String(),
+			}},
+			expErr: "error parsing --expiration as an RFC3339 time: parsing time \"tomorrow\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"tomorrow\" as \"2006\"",
+		},
+		{
+			name:      "from file",
+			clientCtx: clientContextWithCodec(t, client.Context{}),
+			flags:     []string{"--file", txFN},
+			expMsg:    &exchange.MsgCreatePaymentRequest{Payment: filePayment},
+		},
+		{
+			name:      "from file with override",
+			clientCtx: clientContextWithCodec(t, client.Context{FromAddress: sdk.AccAddress("another_from________")}),
+			flags:     []string{"--external-id", "something-else", "--file", txFN},
+			expMsg: &exchange.MsgCreatePaymentRequest{Payment: exchange.Payment{
+				Source:       sdk.AccAddress("another_from________").String(),
+				SourceAmount: filePayment.SourceAmount,
+				Target:       filePayment.Target,
+				TargetAmount: filePayment.TargetAmount,
+				ExternalId:   "something-else",
+			}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdTxAcceptPayment(t *testing.T) {
+	tc := setupTestCase{
+		name:  "SetupCmdTxAcceptPayment",
+		setup: cli.SetupCmdTxAcceptPayment,
+		expFlags: []string{
+			cli.FlagSource, cli.FlagSourceAmount,
+			cli.FlagTarget, cli.FlagTargetAmount,
+			cli.FlagExternalID, cli.FlagFile,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expInUse: []string{
+			"[--source <source>]", "[--source-amount <source amount>]",
+			"{--from|--target} <target>", "[--target-amount <target amount>]",
+			"[--external-id <external id>]", "[--file <filename>]",
+			cli.ReqSignerDesc(cli.FlagTarget),
+			cli.MsgFileDesc(&exchange.MsgAcceptPaymentRequest{}),
+		},
+	}
+	addOneReqAnnotations(&tc, cli.FlagFile, flags.FlagFrom, cli.FlagTarget)
+
+	runSetupTestCase(t, tc)
+}
+
+func TestMakeMsgAcceptPayment(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgAcceptPaymentRequest]{
+		makerName: "MakeMsgAcceptPayment",
+		maker:     cli.MakeMsgAcceptPayment,
+		setup:     cli.SetupCmdTxAcceptPayment,
+	}
+
+	coins := func(str string) sdk.Coins {
+		rv, err := sdk.ParseCoinsNormalized(str)
+		require.NoError(t, err, "ParseCoinsNormalized(%q)", str)
+		return rv
+	}
+	testAddr := func(prefix string) string {
+		return sdk.AccAddress(prefix + strings.Repeat("_", 20-len(prefix))).String()
+	}
+	newPayment := func(sourceAddrPrefix, sourceAmount, targetAddrPrefix, targetAmount, externalID string) exchange.Payment {
+		return exchange.Payment{
+			Source:       testAddr(sourceAddrPrefix),
+			SourceAmount: coins(sourceAmount),
+			Target:       testAddr(targetAddrPrefix),
+			TargetAmount: coins(targetAmount),
+			ExternalId:   externalID,
+		}
+	}
+
+	filePayment := newPayment("file_source", "9strawberry", "file_target", "5tangerine", "some-other-file-id")
+	fileMsg := &exchange.MsgAcceptPaymentRequest{Payment: filePayment}
+	tx := newTx(t, fileMsg)
+	tdir := t.TempDir()
+	txFN := filepath.Join(tdir, "accept-payment.json")
+	writeFileAsJson(t, txFN, tx)
+
+	tests := []txMakerTestCase[*exchange.MsgAcceptPaymentRequest]{
+		{
+			name: "no target",
+			flags: []string{
+				"--source-amount", "4starfruit",
+				"--source", testAddr("some-source"),
+			},
+			expMsg: &exchange.MsgAcceptPaymentRequest{Payment: exchange.Payment{
+				Source:       testAddr("some-source"),
+				SourceAmount: coins("4starfruit"),
+				Target:       "",
+				TargetAmount: nil,
+				ExternalId:   "",
+			}},
+			expErr: "no <target> provided",
+		},
+		{
+			name:      "all fields",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("target_from_from____")},
+			flags: []string{
+				"--external-id", "random-9scik2",
+				"--source", testAddr("my-source"),
+				"--source-amount", "130strawberry",
+				"--target-amount", "310tangerine",
 			},
+			expMsg: &exchange.MsgAcceptPaymentRequest{Payment: exchange.Payment{
+				Source:       testAddr("my-source"),
+				SourceAmount: coins("130strawberry"),
+				Target:       sdk.AccAddress("target_from_from____").String(),
+				TargetAmount: coins("310tangerine"),
+				ExternalId:   "random-9scik2",
+			}},
 		},
 		{
-			name:      "disable",
-			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
-			flags:     []string{"--admin", "Blake", "--market", "94", "--disable"},
-			expMsg: &exchange.MsgMarketUpdateAcceptingOrdersRequest{
-				Admin:           "Blake",
-				MarketId:        94,
-				AcceptingOrders: false,
-			},
+			name:      "from file",
+			clientCtx: clientContextWithCodec(t, client.Context{}),
+			flags:     []string{"--file", txFN},
+			expMsg:    &exchange.MsgAcceptPaymentRequest{Payment: filePayment},
+		},
+		{
+			name:      "from file with override",
+			clientCtx: clientContextWithCodec(t, client.Context{FromAddress: sdk.AccAddress("another_from________")}),
+			flags:     []string{"--external-id", "something-else", "--file", txFN},
+			expMsg: &exchange.MsgAcceptPaymentRequest{Payment: exchange.Payment{
+				Source:       filePayment.Source,
+				SourceAmount: filePayment.SourceAmount,
+				Target:       sdk.AccAddress("another_from________").String(),
+				TargetAmount: filePayment.TargetAmount,
+				ExternalId:   "something-else",
+			}},
 		},
 	}
 
@@ -1394,77 +3913,96 @@ func TestMakeMsgMarketUpdateAcceptingOrders(t *testing.T) {
 	}
 }
 
-func TestSetupCmdTxMarketUpdateUserSettle(t *testing.T) {
-	runSetupTestCase(t, setupTestCase{
-		name:  "SetupCmdTxMarketUpdateUserSettle",
-		setup: cli.SetupCmdTxMarketUpdateUserSettle,
+func TestSetupCmdTxAcceptPayments(t *testing.T) {
+	tc := setupTestCase{
+		name:  "SetupCmdTxAcceptPayments",
+		setup: cli.SetupCmdTxAcceptPayments,
 		expFlags: []string{
-			cli.FlagAdmin, cli.FlagAuthority,
-			cli.FlagMarket, cli.FlagEnable, cli.FlagDisable,
+			cli.FlagTarget, cli.FlagPaymentIDs, cli.FlagSources, cli.FlagMaxTargetAmount,
 			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
 		},
-		expAnnotations: map[string]map[string][]string{
-			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
-			cli.FlagAdmin: {
-				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
-				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
-			},
-			cli.FlagAuthority: {
-				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
-				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
-			},
-			cli.FlagMarket: {required: {"true"}},
-			cli.FlagEnable: {
-				mutExc: {cli.FlagEnable + " " + cli.FlagDisable},
-				oneReq: {cli.FlagEnable + " " + cli.FlagDisable},
-			},
-			cli.FlagDisable: {
-				mutExc: {cli.FlagEnable + " " + cli.FlagDisable},
-				oneReq: {cli.FlagEnable + " " + cli.FlagDisable},
-			},
-		},
 		expInUse: []string{
-			cli.ReqAdminUse, "--market <market id>", cli.ReqEnableDisableUse,
-			cli.ReqAdminDesc, cli.ReqEnableDisableDesc,
+			"{--from|--target} <target>", "[--payment-ids <payment id>]", "[--sources <sources>]",
+			"[--max-target-amount <max target amount>]",
+			cli.ReqSignerDesc(cli.FlagTarget),
+			"At least one of --payment-ids and/or --sources must be provided",
+			`A <payment id> has the format "<source>:<external id>".`,
+			cli.RepeatableDesc,
 		},
-	})
+	}
+	addOneReqAnnotations(&tc, flags.FlagFrom, cli.FlagTarget)
+	addOneReqAnnotations(&tc, cli.FlagPaymentIDs, cli.FlagSources)
+
+	runSetupTestCase(t, tc)
 }
 
-func TestMakeMsgMarketUpdateUserSettle(t *testing.T) {
-	td := txMakerTestDef[*exchange.MsgMarketUpdateUserSettleRequest]{
-		makerName: "MakeMsgMarketUpdateUserSettle",
-		maker:     cli.MakeMsgMarketUpdateUserSettle,
-		setup:     cli.SetupCmdTxMarketUpdateUserSettle,
+func TestMakeMsgAcceptPayments(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgAcceptPaymentsRequest]{
+		makerName: "MakeMsgAcceptPayments",
+		maker:     cli.MakeMsgAcceptPayments,
+		setup:     cli.SetupCmdTxAcceptPayments,
 	}
 
-	tests := []txMakerTestCase[*exchange.MsgMarketUpdateUserSettleRequest]{
+	tests := []txMakerTestCase[*exchange.MsgAcceptPaymentsRequest]{
 		{
-			name:   "some errors",
-			flags:  []string{"--market", "56"},
-			expMsg: &exchange.MsgMarketUpdateUserSettleRequest{MarketId: 56},
-			expErr: joinErrs(
-				"no <admin> provided",
-				"exactly one of --enable or --disable must be provided",
-			),
+			name:  "no target",
+			flags: []string{"--sources", "source1,source2"},
+			expMsg: &exchange.MsgAcceptPaymentsRequest{
+				Target:  "",
+				Sources: []string{"source1", "source2"},
+			},
+			expErr: "no <target> provided",
 		},
 		{
-			name:      "enable",
-			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
-			flags:     []string{"--enable", "--market", "4"},
-			expMsg: &exchange.MsgMarketUpdateUserSettleRequest{
-				Admin:               sdk.AccAddress("FromAddress_________").String(),
-				MarketId:            4,
-				AllowUserSettlement: true,
+			name:      "target from from",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("this-is-me")},
+			flags:     []string{"--payment-ids", "this-is-you:abc"},
+			expMsg: &exchange.MsgAcceptPaymentsRequest{
+				Target:     sdk.AccAddress("this-is-me").String(),
+				PaymentIds: []exchange.PaymentID{{Source: "this-is-you", ExternalId: "abc"}},
+				Sources:    []string{},
 			},
 		},
 		{
-			name:      "disable",
-			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
-			flags:     []string{"--admin", "Blake", "--market", "94", "--disable"},
-			expMsg: &exchange.MsgMarketUpdateUserSettleRequest{
-				Admin:               "Blake",
-				MarketId:            94,
-				AllowUserSettlement: false,
+			name:  "invalid payment id",
+			flags: []string{"--target", "flea", "--payment-ids", ":abc"},
+			expMsg: &exchange.MsgAcceptPaymentsRequest{
+				Target:     "flea",
+				PaymentIds: []exchange.PaymentID{},
+				Sources:    []string{},
+			},
+			expErr: "invalid payment id \":abc\": expected format <source>:<external id>",
+		},
+		{
+			name:  "invalid max target amount",
+			flags: []string{"--target", "flea", "--sources", "anthony", "--max-target-amount", "nope"},
+			expMsg: &exchange.MsgAcceptPaymentsRequest{
+				Target:  "flea",
+				Sources: []string{"anthony"},
+			},
+			expErr: "error parsing --max-target-amount as coins: invalid coin expression: \"nope\"",
+		},
+		{
+			name: "all given",
+			flags: []string{
+				"--payment-ids", "anthony:one,two",
+				"--sources", "anthony,chad",
+				"--target", "flea",
+				"--payment-ids", "chad:",
+				"--max-target-amount", "8tomato,2tangerine",
+				"--payment-ids", "john",
+				"--payment-ids", "john:a:b",
+			},
+			expMsg: &exchange.MsgAcceptPaymentsRequest{
+				Target: "flea",
+				PaymentIds: []exchange.PaymentID{
+					{Source: "anthony", ExternalId: "one,two"},
+					{Source: "chad", ExternalId: ""},
+					{Source: "john", ExternalId: ""},
+					{Source: "john", ExternalId: "a:b"},
+				},
+				Sources:         []string{"anthony", "chad"},
+				MaxTargetAmount: sdk.NewCoins(sdk.NewInt64Coin("tangerine", 2), sdk.NewInt64Coin("tomato", 8)),
 			},
 		},
 	}
@@ -1476,77 +4014,66 @@ func TestMakeMsgMarketUpdateUserSettle(t *testing.T) {
 	}
 }
 
-func TestSetupCmdTxMarketUpdateAcceptingCommitments(t *testing.T) {
-	runSetupTestCase(t, setupTestCase{
-		name:  "SetupCmdTxMarketUpdateAcceptingCommitments",
-		setup: cli.SetupCmdTxMarketUpdateAcceptingCommitments,
+func TestSetupCmdTxRejectPayment(t *testing.T) {
+	tc := setupTestCase{
+		name:  "SetupCmdTxRejectPayment",
+		setup: cli.SetupCmdTxRejectPayment,
 		expFlags: []string{
-			cli.FlagAdmin, cli.FlagAuthority,
-			cli.FlagMarket, cli.FlagEnable, cli.FlagDisable,
+			cli.FlagTarget, cli.FlagSource, cli.FlagExternalID,
 			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
 		},
 		expAnnotations: map[string]map[string][]string{
-			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
-			cli.FlagAdmin: {
-				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
-				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
-			},
-			cli.FlagAuthority: {
-				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
-				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
-			},
-			cli.FlagMarket: {required: {"true"}},
-			cli.FlagEnable: {
-				mutExc: {cli.FlagEnable + " " + cli.FlagDisable},
-				oneReq: {cli.FlagEnable + " " + cli.FlagDisable},
-			},
-			cli.FlagDisable: {
-				mutExc: {cli.FlagEnable + " " + cli.FlagDisable},
-				oneReq: {cli.FlagEnable + " " + cli.FlagDisable},
-			},
+			cli.FlagSource: {required: {"true"}},
 		},
 		expInUse: []string{
-			cli.ReqAdminUse, "--market <market id>", cli.ReqEnableDisableUse,
-			cli.ReqAdminDesc, cli.ReqEnableDisableDesc,
+			"{--from|--target} <target>", "--source <source>", "[--external-id <external id>",
+			cli.ReqSignerDesc(cli.FlagTarget),
 		},
-	})
+	}
+	addOneReqAnnotations(&tc, flags.FlagFrom, cli.FlagTarget)
+
+	runSetupTestCase(t, tc)
 }
 
-func TestMakeMsgMarketUpdateAcceptingCommitments(t *testing.T) {
-	td := txMakerTestDef[*exchange.MsgMarketUpdateAcceptingCommitmentsRequest]{
-		makerName: "MakeMsgMarketUpdateAcceptingCommitments",
-		maker:     cli.MakeMsgMarketUpdateAcceptingCommitments,
-		setup:     cli.SetupCmdTxMarketUpdateAcceptingCommitments,
+func TestMakeMsgRejectPayment(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgRejectPaymentRequest]{
+		makerName: "MakeMsgRejectPayment",
+		maker:     cli.MakeMsgRejectPayment,
+		setup:     cli.SetupCmdTxRejectPayment,
 	}
 
-	tests := []txMakerTestCase[*exchange.MsgMarketUpdateAcceptingCommitmentsRequest]{
+	tests := []txMakerTestCase[*exchange.MsgRejectPaymentRequest]{
 		{
-			name:   "some errors",
-			flags:  []string{"--market", "56"},
-			expMsg: &exchange.MsgMarketUpdateAcceptingCommitmentsRequest{MarketId: 56},
-			expErr: joinErrs(
-				"no <admin> provided",
-				"exactly one of --enable or --disable must be provided",
-			),
+			name:  "no target",
+			flags: []string{"--source", "the-source"},
+			expMsg: &exchange.MsgRejectPaymentRequest{
+				Target:     "",
+				Source:     "the-source",
+				ExternalId: "",
+			},
+			expErr: "no <target> provided",
 		},
 		{
-			name:      "enable",
-			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
-			flags:     []string{"--enable", "--market", "4"},
-			expMsg: &exchange.MsgMarketUpdateAcceptingCommitmentsRequest{
-				Admin:                sdk.AccAddress("FromAddress_________").String(),
-				MarketId:             4,
-				AcceptingCommitments: true,
+			name:      "no external id",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("this-is-me")},
+			flags:     []string{"--source", "this-is-you"},
+			expMsg: &exchange.MsgRejectPaymentRequest{
+				Target:     sdk.AccAddress("this-is-me").String(),
+				Source:     "this-is-you",
+				ExternalId: "",
 			},
 		},
 		{
-			name:      "disable",
-			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
-			flags:     []string{"--admin", "Blake", "--market", "94", "--disable"},
-			expMsg: &exchange.MsgMarketUpdateAcceptingCommitmentsRequest{
-				Admin:                "Blake",
-				MarketId:             94,
-				AcceptingCommitments: false,
+			name: "all given",
+			flags: []string{
+				"--target", "jake",
+				"--source", "elroy",
+				"--external-id", "one-two-aone two three four",
+			},
+			expMsg: &exchange.MsgRejectPaymentRequest{
+				Target:     "jake",
+				Source:     "elroy",
+				ExternalId: "one-two-aone two three four",
 			},
 		},
 	}
@@ -1558,77 +4085,64 @@ func TestMakeMsgMarketUpdateAcceptingCommitments(t *testing.T) {
 	}
 }
 
-func TestSetupCmdTxMarketUpdateIntermediaryDenom(t *testing.T) {
-	runSetupTestCase(t, setupTestCase{
-		name:  "SetupCmdTxMarketUpdateIntermediaryDenom",
-		setup: cli.SetupCmdTxMarketUpdateIntermediaryDenom,
+func TestSetupCmdTxRejectPayments(t *testing.T) {
+	tc := setupTestCase{
+		name:  "SetupCmdTxRejectPayments",
+		setup: cli.SetupCmdTxRejectPayments,
 		expFlags: []string{
-			cli.FlagAdmin, cli.FlagAuthority,
-			cli.FlagMarket, cli.FlagDenom,
+			cli.FlagTarget, cli.FlagSources,
 			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
 		},
 		expAnnotations: map[string]map[string][]string{
-			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
-			cli.FlagAdmin: {
-				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
-				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
-			},
-			cli.FlagAuthority: {
-				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
-				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
-			},
-			cli.FlagMarket: {required: {"true"}},
-			cli.FlagDenom:  {required: {"true"}},
+			cli.FlagSources: {required: {"true"}},
 		},
 		expInUse: []string{
-			cli.ReqAdminUse, "--market <market id>", "--denom <denom>",
-			cli.ReqAdminDesc,
+			"{--from|--target} <target>", "--sources <sources>",
+			cli.ReqSignerDesc(cli.FlagTarget),
+			cli.RepeatableDesc,
 		},
-	})
+	}
+	addOneReqAnnotations(&tc, flags.FlagFrom, cli.FlagTarget)
+
+	runSetupTestCase(t, tc)
 }
 
-func TestMakeMsgMarketUpdateIntermediaryDenom(t *testing.T) {
-	td := txMakerTestDef[*exchange.MsgMarketUpdateIntermediaryDenomRequest]{
-		makerName: "MakeMsgMarketUpdateIntermediaryDenom",
-		maker:     cli.MakeMsgMarketUpdateIntermediaryDenom,
-		setup:     cli.SetupCmdTxMarketUpdateIntermediaryDenom,
+func TestMakeMsgRejectPayments(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgRejectPaymentsRequest]{
+		makerName: "MakeMsgRejectPayments",
+		maker:     cli.MakeMsgRejectPayments,
+		setup:     cli.SetupCmdTxRejectPayments,
 	}
 
-	tests := []txMakerTestCase[*exchange.MsgMarketUpdateIntermediaryDenomRequest]{
+	tests := []txMakerTestCase[*exchange.MsgRejectPaymentsRequest]{
 		{
-			name:  "an error",
-			flags: []string{"--market", "12"},
-			expMsg: &exchange.MsgMarketUpdateIntermediaryDenomRequest{
-				MarketId: 12,
+			name:  "no target",
+			flags: []string{"--sources", "source1,source2"},
+			expMsg: &exchange.MsgRejectPaymentsRequest{
+				Target:  "",
+				Sources: []string{"source1", "source2"},
 			},
-			expErr: "no <admin> provided",
+			expErr: "no <target> provided",
 		},
 		{
-			name:      "admin from from",
-			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
-			flags:     []string{"--market", "4", "--denom", "cherry"},
-			expMsg: &exchange.MsgMarketUpdateIntermediaryDenomRequest{
-				Admin:             sdk.AccAddress("FromAddress_________").String(),
-				MarketId:          4,
-				IntermediaryDenom: "cherry",
+			name:      "target from from",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("this-is-me")},
+			flags:     []string{"--sources", "this-is-you"},
+			expMsg: &exchange.MsgRejectPaymentsRequest{
+				Target:  sdk.AccAddress("this-is-me").String(),
+				Sources: []string{"this-is-you"},
 			},
 		},
 		{
-			name:  "admin from flag",
-			flags: []string{"--market", "51", "--denom", "prune", "--admin", "blake"},
-			expMsg: &exchange.MsgMarketUpdateIntermediaryDenomRequest{
-				Admin:             "blake",
-				MarketId:          51,
-				IntermediaryDenom: "prune",
+			name: "all given",
+			flags: []string{
+				"--sources", "anthony,chad",
+				"--target", "flea",
+				"--sources", "john",
 			},
-		},
-		{
-			name:  "admin as authority",
-			flags: []string{"--market", "7", "--authority", "--denom", "banana"},
-			expMsg: &exchange.MsgMarketUpdateIntermediaryDenomRequest{
-				Admin:             cli.AuthorityAddr.String(),
-				MarketId:          7,
-				IntermediaryDenom: "banana",
+			expMsg: &exchange.MsgRejectPaymentsRequest{
+				Target:  "flea",
+				Sources: []string{"anthony", "chad", "john"},
 			},
 		},
 	}
@@ -1640,102 +4154,66 @@ func TestMakeMsgMarketUpdateIntermediaryDenom(t *testing.T) {
 	}
 }
 
-func TestSetupCmdTxMarketManagePermissions(t *testing.T) {
-	runSetupTestCase(t, setupTestCase{
-		name:  "SetupCmdTxMarketManagePermissions",
-		setup: cli.SetupCmdTxMarketManagePermissions,
+func TestSetupCmdTxDisputePayment(t *testing.T) {
+	tc := setupTestCase{
+		name:  "SetupCmdTxDisputePayment",
+		setup: cli.SetupCmdTxDisputePayment,
 		expFlags: []string{
-			cli.FlagAdmin, cli.FlagAuthority,
-			cli.FlagMarket, cli.FlagRevokeAll, cli.FlagRevoke, cli.FlagGrant,
+			cli.FlagTarget, cli.FlagSource, cli.FlagExternalID,
 			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
 		},
 		expAnnotations: map[string]map[string][]string{
-			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
-			cli.FlagAdmin: {
-				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
-				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
-			},
-			cli.FlagAuthority: {
-				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
-				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
-			},
-			cli.FlagMarket:    {required: {"true"}},
-			cli.FlagRevokeAll: {oneReq: {cli.FlagRevokeAll + " " + cli.FlagRevoke + " " + cli.FlagGrant}},
-			cli.FlagRevoke:    {oneReq: {cli.FlagRevokeAll + " " + cli.FlagRevoke + " " + cli.FlagGrant}},
-			cli.FlagGrant:     {oneReq: {cli.FlagRevokeAll + " " + cli.FlagRevoke + " " + cli.FlagGrant}},
+			cli.FlagSource: {required: {"true"}},
 		},
 		expInUse: []string{
-			cli.ReqAdminUse, "--market <market id>",
-			"[--revoke-all <addresses>]", "[--revoke <access grants>]", "[--grant <access grants>]",
-			cli.ReqAdminDesc, cli.RepeatableDesc, cli.AccessGrantsDesc,
+			"{--from|--target} <target>", "--source <source>", "[--external-id <external id>",
+			cli.ReqSignerDesc(cli.FlagTarget),
 		},
-	})
+	}
+	addOneReqAnnotations(&tc, flags.FlagFrom, cli.FlagTarget)
+
+	runSetupTestCase(t, tc)
 }
 
-func TestMakeMsgMarketManagePermissions(t *testing.T) {
-	td := txMakerTestDef[*exchange.MsgMarketManagePermissionsRequest]{
-		makerName: "MakeMsgMarketManagePermissions",
-		maker:     cli.MakeMsgMarketManagePermissions,
-		setup:     cli.SetupCmdTxMarketManagePermissions,
+func TestMakeMsgDisputePayment(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgDisputePaymentRequest]{
+		makerName: "MakeMsgDisputePayment",
+		maker:     cli.MakeMsgDisputePayment,
+		setup:     cli.SetupCmdTxDisputePayment,
 	}
 
-	accessGrant := func(addr string, perms ...exchange.Permission) exchange.AccessGrant {
-		return exchange.AccessGrant{Address: addr, Permissions: perms}
-	}
-	tests := []txMakerTestCase[*exchange.MsgMarketManagePermissionsRequest]{
+	tests := []txMakerTestCase[*exchange.MsgDisputePaymentRequest]{
 		{
-			name:  "some errors",
-			flags: []string{"--revoke", "addr8:oops", "--revoke", "Ryan", "--market", "1", "--grant", ":settle"},
-			expMsg: &exchange.MsgMarketManagePermissionsRequest{
-				MarketId:  1,
-				RevokeAll: []string{},
-				ToRevoke:  []exchange.AccessGrant{},
-				ToGrant:   []exchange.AccessGrant{},
+			name:  "no target",
+			flags: []string{"--source", "the-source"},
+			expMsg: &exchange.MsgDisputePaymentRequest{
+				Target:     "",
+				Source:     "the-source",
+				ExternalId: "",
 			},
-			expErr: joinErrs(
-				"no <admin> provided",
-				"could not parse permissions for \"addr8\" from \"oops\": invalid permission: \"oops\"",
-				"could not parse \"Ryan\" as an <access grant>: expected format <address>:<permissions>",
-				"invalid <access grant> \":settle\": both an <address> and <permissions> are required",
-			),
+			expErr: "no <target> provided",
 		},
 		{
-			name:      "just a revoke",
-			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
-			flags: []string{
-				"--market", "6", "--revoke", "alan:settle+update"},
-			expMsg: &exchange.MsgMarketManagePermissionsRequest{
-				Admin:     sdk.AccAddress("FromAddress_________").String(),
-				MarketId:  6,
-				RevokeAll: []string{},
-				ToRevoke: []exchange.AccessGrant{
-					accessGrant("alan", exchange.Permission_settle, exchange.Permission_update),
-				},
-				ToGrant: nil,
+			name:      "no external id",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("this-is-me")},
+			flags:     []string{"--source", "this-is-you"},
+			expMsg: &exchange.MsgDisputePaymentRequest{
+				Target:     sdk.AccAddress("this-is-me").String(),
+				Source:     "this-is-you",
+				ExternalId: "",
 			},
 		},
 		{
-			name: "all fields",
+			name: "all given",
 			flags: []string{
-				"--market", "123", "--admin", "Frankie", "--revoke-all", "Freddie,Fritz,Forrest",
-				"--revoke", "Dylan:settle,Devin:update", "--revoke-all", "Finn",
-				"--grant", "Sam:permissions+update", "--revoke", "Dave:setids",
-				"--grant", "Skylar:all,Fritz:all",
+				"--target", "judy",
+				"--source", "george",
+				"--external-id", "sprockets-invoice-7",
 			},
-			expMsg: &exchange.MsgMarketManagePermissionsRequest{
-				Admin:     "Frankie",
-				MarketId:  123,
-				RevokeAll: []string{"Freddie", "Fritz", "Forrest", "Finn"},
-				ToRevoke: []exchange.AccessGrant{
-					accessGrant("Dylan", exchange.Permission_settle),
-					accessGrant("Devin", exchange.Permission_update),
-					accessGrant("Dave", exchange.Permission_set_ids),
-				},
-				ToGrant: []exchange.AccessGrant{
-					accessGrant("Sam", exchange.Permission_permissions, exchange.Permission_update),
-					accessGrant("Skylar", exchange.AllPermissions()...),
-					accessGrant("Fritz", exchange.AllPermissions()...),
-				},
+			expMsg: &exchange.MsgDisputePaymentRequest{
+				Target:     "judy",
+				Source:     "george",
+				ExternalId: "sprockets-invoice-7",
 			},
 		},
 	}
@@ -1747,95 +4225,66 @@ func TestMakeMsgMarketManagePermissions(t *testing.T) {
 	}
 }
 
-func TestSetupCmdTxMarketManageReqAttrs(t *testing.T) {
+func TestSetupCmdTxCancelPayments(t *testing.T) {
 	tc := setupTestCase{
-		name:  "SetupCmdTxMarketManageReqAttrs",
-		setup: cli.SetupCmdTxMarketManageReqAttrs,
+		name:  "SetupCmdTxCancelPayments",
+		setup: cli.SetupCmdTxCancelPayments,
 		expFlags: []string{
-			cli.FlagAdmin, cli.FlagAuthority, cli.FlagMarket,
-			cli.FlagAskAdd, cli.FlagAskRemove, cli.FlagBidAdd, cli.FlagBidRemove,
-			cli.FlagCommitmentAdd, cli.FlagCommitmentRemove,
+			cli.FlagSource, cli.FlagExternalIDs, cli.FlagEmptyExternalID,
 			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
 		},
 		expAnnotations: map[string]map[string][]string{
-			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
-			cli.FlagAdmin: {
-				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
-				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
-			},
-			cli.FlagAuthority: {
-				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
-				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
-			},
-			cli.FlagMarket: {required: {"true"}},
+			cli.FlagExternalIDs: {required: {"true"}},
 		},
 		expInUse: []string{
-			cli.ReqAdminUse, "--market <market id>",
-			"[--ask-add <attrs>]", "[--ask-remove <attrs>]",
-			"[--bid-add <attrs>]", "[--bid-remove <attrs>]",
-			"[--commitment-add <attrs>]", "[--commitment-remove <attrs>]",
-			cli.ReqAdminDesc, cli.RepeatableDesc,
+			"{--from|--source} <source>", "--external-ids <external ids>",
+			"[--empty-external-id]",
+			cli.ReqSignerDesc(cli.FlagSource),
+			cli.RepeatableDesc,
 		},
 	}
-
-	oneReqFlags := []string{
-		cli.FlagAskAdd, cli.FlagAskRemove, cli.FlagBidAdd, cli.FlagBidRemove,
-		cli.FlagCommitmentAdd, cli.FlagCommitmentRemove,
-	}
-	oneReqVal := strings.Join(oneReqFlags, " ")
-	if tc.expAnnotations == nil {
-		tc.expAnnotations = make(map[string]map[string][]string)
-	}
-	for _, name := range oneReqFlags {
-		if tc.expAnnotations[name] == nil {
-			tc.expAnnotations[name] = make(map[string][]string)
-		}
-		tc.expAnnotations[name][oneReq] = []string{oneReqVal}
-	}
+	addOneReqAnnotations(&tc, flags.FlagFrom, cli.FlagSource)
 
 	runSetupTestCase(t, tc)
 }
 
-func TestMakeMsgMarketManageReqAttrs(t *testing.T) {
-	td := txMakerTestDef[*exchange.MsgMarketManageReqAttrsRequest]{
-		makerName: "MakeMsgMarketManageReqAttrs",
-		maker:     cli.MakeMsgMarketManageReqAttrs,
-		setup:     cli.SetupCmdTxMarketManageReqAttrs,
+func TestMakeMsgCancelPayments(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgCancelPaymentsRequest]{
+		makerName: "MakeMsgCancelPayments",
+		maker:     cli.MakeMsgCancelPayments,
+		setup:     cli.SetupCmdTxCancelPayments,
 	}
 
-	tests := []txMakerTestCase[*exchange.MsgMarketManageReqAttrsRequest]{
+	tests := []txMakerTestCase[*exchange.MsgCancelPaymentsRequest]{
 		{
-			name:  "no admin",
-			flags: []string{"--market", "41", "--bid-add", "*.kyc"},
-			expMsg: &exchange.MsgMarketManageReqAttrsRequest{
-				MarketId:                 41,
-				CreateAskToAdd:           []string{},
-				CreateAskToRemove:        []string{},
-				CreateBidToAdd:           []string{"*.kyc"},
-				CreateBidToRemove:        []string{},
-				CreateCommitmentToAdd:    []string{},
-				CreateCommitmentToRemove: []string{},
+			name:  "no source",
+			flags: []string{"--external-ids", "id1,id2"},
+			expMsg: &exchange.MsgCancelPaymentsRequest{
+				Source:      "",
+				ExternalIds: []string{"id1", "id2"},
 			},
-			expErr: "no <admin> provided",
+			expErr: "no <source> provided",
 		},
 		{
-			name:      "all fields",
-			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
-			flags: []string{
-				"--market", "44444",
-				"--ask-add", "def.abc,*.xyz", "--ask-remove", "uvw.xyz",
-				"--bid-add", "ghi.abc,*.xyz", "--bid-remove", "rst.xyz",
-				"--commitment-add", "jkl.abc,*.xyz", "--commitment-remove", "opq.xyz",
+			name:      "source from from",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("the_from_address____")},
+			flags:     []string{"--external-ids", "the-id"},
+			expMsg: &exchange.MsgCancelPaymentsRequest{
+				Source:      sdk.AccAddress("the_from_address____").String(),
+				ExternalIds: []string{"the-id"},
 			},
-			expMsg: &exchange.MsgMarketManageReqAttrsRequest{
-				Admin:                    sdk.AccAddress("FromAddress_________").String(),
-				MarketId:                 44444,
-				CreateAskToAdd:           []string{"def.abc", "*.xyz"},
-				CreateAskToRemove:        []string{"uvw.xyz"},
-				CreateBidToAdd:           []string{"ghi.abc", "*.xyz"},
-				CreateBidToRemove:        []string{"rst.xyz"},
-				CreateCommitmentToAdd:    []string{"jkl.abc", "*.xyz"},
-				CreateCommitmentToRemove: []string{"opq.xyz"},
+		},
+		{
+			name: "all given",
+			flags: []string{
+				"--external-ids", "a,b,c",
+				"--source", "myself",
+				"--empty-external-id",
+				"--external-ids", "def,ghi",
+			},
+			expMsg: &exchange.MsgCancelPaymentsRequest{
+				Source:      "myself",
+				ExternalIds: []string{"a", "b", "c", "def", "ghi", ""},
 			},
 		},
 	}
@@ -1847,111 +4296,65 @@ func TestMakeMsgMarketManageReqAttrs(t *testing.T) {
 	}
 }
 
-func TestSetupCmdTxCreatePayment(t *testing.T) {
+func TestSetupCmdTxChangePaymentTarget(t *testing.T) {
 	tc := setupTestCase{
-		name:  "SetupCmdTxCreatePayment",
-		setup: cli.SetupCmdTxCreatePayment,
+		name:  "SetupCmdTxChangePaymentTarget",
+		setup: cli.SetupCmdTxChangePaymentTarget,
 		expFlags: []string{
-			cli.FlagSource, cli.FlagSourceAmount,
-			cli.FlagTarget, cli.FlagTargetAmount,
-			cli.FlagExternalID, cli.FlagFile,
+			cli.FlagSource, cli.FlagExternalID, cli.FlagNewTarget,
 			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
 		},
 		expInUse: []string{
-			"{--from|--source} <source>", "[--source-amount <source amount>]",
-			"[--target <target>]", "[--target-amount <target amount>]",
-			"[--external-id <external id>]", "[--file <filename>]",
+			"{--from|--source} <source>",
+			"[--external-id <external id>]", "[--new-target <new target>]",
 			cli.ReqSignerDesc(cli.FlagSource),
-			cli.MsgFileDesc(&exchange.MsgCreatePaymentRequest{}),
 		},
 	}
-	addOneReqAnnotations(&tc, cli.FlagFile, flags.FlagFrom, cli.FlagSource)
+	addOneReqAnnotations(&tc, flags.FlagFrom, cli.FlagSource)
 
 	runSetupTestCase(t, tc)
 }
 
-func TestMakeMsgCreatePayment(t *testing.T) {
-	td := txMakerTestDef[*exchange.MsgCreatePaymentRequest]{
-		makerName: "MakeMsgCreatePayment",
-		maker:     cli.MakeMsgCreatePayment,
-		setup:     cli.SetupCmdTxCreatePayment,
-	}
-
-	coins := func(str string) sdk.Coins {
-		rv, err := sdk.ParseCoinsNormalized(str)
-		require.NoError(t, err, "ParseCoinsNormalized(%q)", str)
-		return rv
-	}
-	testAddr := func(prefix string) string {
-		return sdk.AccAddress(prefix + strings.Repeat("_", 20-len(prefix))).String()
-	}
-	newPayment := func(sourceAddrPrefix, sourceAmount, targetAddrPrefix, targetAmount, externalID string) exchange.Payment {
-		return exchange.Payment{
-			Source:       testAddr(sourceAddrPrefix),
-			SourceAmount: coins(sourceAmount),
-			Target:       testAddr(targetAddrPrefix),
-			TargetAmount: coins(targetAmount),
-			ExternalId:   externalID,
-		}
+func TestMakeMsgChangePaymentTarget(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgChangePaymentTargetRequest]{
+		makerName: "MakeMsgChangePaymentTarget",
+		maker:     cli.MakeMsgChangePaymentTarget,
+		setup:     cli.SetupCmdTxChangePaymentTarget,
 	}
 
-	filePayment := newPayment("file_source", "88strawberry", "file_target", "44tangerine", "some-file-id")
-	fileMsg := &exchange.MsgCreatePaymentRequest{Payment: filePayment}
-	tx := newTx(t, fileMsg)
-	tdir := t.TempDir()
-	txFN := filepath.Join(tdir, "create-payment.json")
-	writeFileAsJson(t, txFN, tx)
-
-	tests := []txMakerTestCase[*exchange.MsgCreatePaymentRequest]{
+	tests := []txMakerTestCase[*exchange.MsgChangePaymentTargetRequest]{
 		{
-			name: "no source",
-			flags: []string{
-				"--source-amount", "3starfruit",
-				"--target", testAddr("some-target"),
+			name:  "no source",
+			flags: []string{},
+			expMsg: &exchange.MsgChangePaymentTargetRequest{
+				Source:     "",
+				ExternalId: "",
+				NewTarget:  "",
 			},
-			expMsg: &exchange.MsgCreatePaymentRequest{Payment: exchange.Payment{
-				Source:       "",
-				SourceAmount: coins("3starfruit"),
-				Target:       testAddr("some-target"),
-				TargetAmount: nil,
-				ExternalId:   "",
-			}},
 			expErr: "no <source> provided",
 		},
 		{
-			name:      "all fields",
-			clientCtx: client.Context{FromAddress: sdk.AccAddress("source_from_from____")},
-			flags: []string{
-				"--external-id", "random-dcic",
-				"--target", testAddr("my-target"),
-				"--source-amount", "13strawberry",
-				"--target-amount", "31tangerine",
+			name:      "source from from",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("the_from_address____")},
+			flags:     []string{},
+			expMsg: &exchange.MsgChangePaymentTargetRequest{
+				Source:     sdk.AccAddress("the_from_address____").String(),
+				ExternalId: "",
+				NewTarget:  "",
 			},
-			expMsg: &exchange.MsgCreatePaymentRequest{Payment: exchange.Payment{
-				Source:       sdk.AccAddress("source_from_from____").String(),
-				SourceAmount: coins("13strawberry"),
-				Target:       testAddr("my-target"),
-				TargetAmount: coins("31tangerine"),
-				ExternalId:   "random-dcic",
-			}},
-		},
-		{
-			name:      "from file",
-			clientCtx: clientContextWithCodec(t, client.Context{}),
-			flags:     []string{"--file", txFN},
-			expMsg:    &exchange.MsgCreatePaymentRequest{Payment: filePayment},
 		},
 		{
-			name:      "from file with override",
-			clientCtx: clientContextWithCodec(t, client.Context{FromAddress: sdk.AccAddress("another_from________")}),
-			flags:     []string{"--external-id", "something-else", "--file", txFN},
-			expMsg: &exchange.MsgCreatePaymentRequest{Payment: exchange.Payment{
-				Source:       sdk.AccAddress("another_from________").String(),
-				SourceAmount: filePayment.SourceAmount,
-				Target:       filePayment.Target,
-				TargetAmount: filePayment.TargetAmount,
-				ExternalId:   "something-else",
-			}},
+			name: "all given",
+			flags: []string{
+				"--source", "thesource",
+				"--new-target", "thenewtarget",
+				"--external-id", "theexternalidentifier",
+			},
+			expMsg: &exchange.MsgChangePaymentTargetRequest{
+				Source:     "thesource",
+				ExternalId: "theexternalidentifier",
+				NewTarget:  "thenewtarget",
+			},
 		},
 	}
 
@@ -1962,111 +4365,92 @@ func TestMakeMsgCreatePayment(t *testing.T) {
 	}
 }
 
-func TestSetupCmdTxAcceptPayment(t *testing.T) {
+func TestSetupCmdTxCreateRecurringPayment(t *testing.T) {
 	tc := setupTestCase{
-		name:  "SetupCmdTxAcceptPayment",
-		setup: cli.SetupCmdTxAcceptPayment,
+		name:  "SetupCmdTxCreateRecurringPayment",
+		setup: cli.SetupCmdTxCreateRecurringPayment,
 		expFlags: []string{
-			cli.FlagSource, cli.FlagSourceAmount,
-			cli.FlagTarget, cli.FlagTargetAmount,
-			cli.FlagExternalID, cli.FlagFile,
+			cli.FlagSource, cli.FlagTarget, cli.FlagAmount, cli.FlagExternalID,
+			cli.FlagInterval, cli.FlagTransfers, cli.FlagStartTime,
 			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
 		},
+		expAnnotations: map[string]map[string][]string{
+			cli.FlagTarget:    {required: {"true"}},
+			cli.FlagAmount:    {required: {"true"}},
+			cli.FlagInterval:  {required: {"true"}},
+			cli.FlagTransfers: {required: {"true"}},
+		},
 		expInUse: []string{
-			"[--source <source>]", "[--source-amount <source amount>]",
-			"{--from|--target} <target>", "[--target-amount <target amount>]",
-			"[--external-id <external id>]", "[--file <filename>]",
-			cli.ReqSignerDesc(cli.FlagTarget),
-			cli.MsgFileDesc(&exchange.MsgAcceptPaymentRequest{}),
+			"{--from|--source} <source>", "--target <target>", "--amount <amount>",
+			"--interval <seconds>", "--transfers <count>",
+			"[--external-id <external id>]", "[--start-time <start time>]",
+			cli.ReqSignerDesc(cli.FlagSource),
 		},
 	}
-	addOneReqAnnotations(&tc, cli.FlagFile, flags.FlagFrom, cli.FlagTarget)
+	addOneReqAnnotations(&tc, flags.FlagFrom, cli.FlagSource)
 
 	runSetupTestCase(t, tc)
 }
 
-func TestMakeMsgAcceptPayment(t *testing.T) {
-	td := txMakerTestDef[*exchange.MsgAcceptPaymentRequest]{
-		makerName: "MakeMsgAcceptPayment",
-		maker:     cli.MakeMsgAcceptPayment,
-		setup:     cli.SetupCmdTxAcceptPayment,
-	}
-
-	coins := func(str string) sdk.Coins {
-		rv, err := sdk.ParseCoinsNormalized(str)
-		require.NoError(t, err, "ParseCoinsNormalized(%q)", str)
-		return rv
-	}
-	testAddr := func(prefix string) string {
-		return sdk.AccAddress(prefix + strings.Repeat("_", 20-len(prefix))).String()
-	}
-	newPayment := func(sourceAddrPrefix, sourceAmount, targetAddrPrefix, targetAmount, externalID string) exchange.Payment {
-		return exchange.Payment{
-			Source:       testAddr(sourceAddrPrefix),
-			SourceAmount: coins(sourceAmount),
-			Target:       testAddr(targetAddrPrefix),
-			TargetAmount: coins(targetAmount),
-			ExternalId:   externalID,
-		}
+func TestMakeMsgCreateRecurringPayment(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgCreateRecurringPaymentRequest]{
+		makerName: "MakeMsgCreateRecurringPayment",
+		maker:     cli.MakeMsgCreateRecurringPayment,
+		setup:     cli.SetupCmdTxCreateRecurringPayment,
 	}
+	startTime := time.Date(2030, 1, 2, 15, 4, 5, 0, time.UTC)
 
-	filePayment := newPayment("file_source", "9strawberry", "file_target", "5tangerine", "some-other-file-id")
-	fileMsg := &exchange.MsgAcceptPaymentRequest{Payment: filePayment}
-	tx := newTx(t, fileMsg)
-	tdir := t.TempDir()
-	txFN := filepath.Join(tdir, "accept-payment.json")
-	writeFileAsJson(t, txFN, tx)
-
-	tests := []txMakerTestCase[*exchange.MsgAcceptPaymentRequest]{
+	tests := []txMakerTestCase[*exchange.MsgCreateRecurringPaymentRequest]{
 		{
-			name: "no target",
-			flags: []string{
-				"--source-amount", "4starfruit",
-				"--source", testAddr("some-source"),
+			name:  "no source",
+			flags: []string{"--target", "someone", "--amount", "5plum", "--interval", "60", "--transfers", "2"},
+			expMsg: &exchange.MsgCreateRecurringPaymentRequest{
+				Target: "someone", Amount: sdk.NewCoins(sdk.NewInt64Coin("plum", 5)),
+				IntervalSeconds: 60, Transfers: 2,
 			},
-			expMsg: &exchange.MsgAcceptPaymentRequest{Payment: exchange.Payment{
-				Source:       testAddr("some-source"),
-				SourceAmount: coins("4starfruit"),
-				Target:       "",
-				TargetAmount: nil,
-				ExternalId:   "",
-			}},
-			expErr: "no <target> provided",
+			expErr: "no <source> provided",
 		},
 		{
-			name:      "all fields",
-			clientCtx: client.Context{FromAddress: sdk.AccAddress("target_from_from____")},
-			flags: []string{
-				"--external-id", "random-9scik2",
-				"--source", testAddr("my-source"),
-				"--source-amount", "130strawberry",
-				"--target-amount", "310tangerine",
+			name:      "source from from",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("the_from_address____")},
+			flags:     []string{"--target", "someone", "--amount", "5plum", "--interval", "60", "--transfers", "2"},
+			expMsg: &exchange.MsgCreateRecurringPaymentRequest{
+				Source: sdk.AccAddress("the_from_address____").String(),
+				Target: "someone", Amount: sdk.NewCoins(sdk.NewInt64Coin("plum", 5)),
+				IntervalSeconds: 60, Transfers: 2,
 			},
-			expMsg: &exchange.MsgAcceptPaymentRequest{Payment: exchange.Payment{
-				Source:       testAddr("my-source"),
-				SourceAmount: coins("130strawberry"),
-				Target:       sdk.AccAddress("target_from_from____").String(),
-				TargetAmount: coins("310tangerine"),
-				ExternalId:   "random-9scik2",
-			}},
 		},
 		{
-			name:      "from file",
-			clientCtx: clientContextWithCodec(t, client.Context{}),
-			flags:     []string{"--file", txFN},
-			expMsg:    &exchange.MsgAcceptPaymentRequest{Payment: filePayment},
+			name: "bad amount and start time",
+			flags: []string{
+				"--source", "myself", "--target", "someone", "--amount", "5",
+				"--interval", "60", "--transfers", "2", "--start-time", "tomorrow",
+			},
+			expMsg: &exchange.MsgCreateRecurringPaymentRequest{
+				Source: "myself", Target: "someone", IntervalSeconds: 60, Transfers: 2,
+			},
+			expErr: joinErrs(
+				"error parsing --amount as coins: invalid coin expression: \"5\"",
+				"error parsing --start-time as an RFC3339 time: parsing time \"tomorrow\" as "+
+					"\"2006-01-02T15:04:05Z07:00\": cannot parse \"tomorrow\" as \"2006\"",
+			),
 		},
 		{
-			name:      "from file with override",
-			clientCtx: clientContextWithCodec(t, client.Context{FromAddress: sdk.AccAddress("another_from________")}),
-			flags:     []string{"--external-id", "something-else", "--file", txFN},
-			expMsg: &exchange.MsgAcceptPaymentRequest{Payment: exchange.Payment{
-				Source:       filePayment.Source,
-				SourceAmount: filePayment.SourceAmount,
-				Target:       sdk.AccAddress("another_from________").String(),
-				TargetAmount: filePayment.TargetAmount,
-				ExternalId:   "something-else",
-			}},
+			name: "all given",
+			flags: []string{
+				"--source", "myself", "--target", "someone", "--amount", "5plum,3tangerine",
+				"--external-id", "payroll-1", "--interval", "86400", "--transfers", "12",
+				"--start-time", "2030-01-02T15:04:05Z",
+			},
+			expMsg: &exchange.MsgCreateRecurringPaymentRequest{
+				Source:          "myself",
+				Target:          "someone",
+				Amount:          sdk.NewCoins(sdk.NewInt64Coin("plum", 5), sdk.NewInt64Coin("tangerine", 3)),
+				ExternalId:      "payroll-1",
+				IntervalSeconds: 86400,
+				Transfers:       12,
+				StartTime:       &startTime,
+			},
 		},
 	}
 
@@ -2077,66 +4461,58 @@ func TestMakeMsgAcceptPayment(t *testing.T) {
 	}
 }
 
-func TestSetupCmdTxRejectPayment(t *testing.T) {
+func TestSetupCmdTxCancelRecurringPayments(t *testing.T) {
 	tc := setupTestCase{
-		name:  "SetupCmdTxRejectPayment",
-		setup: cli.SetupCmdTxRejectPayment,
+		name:  "SetupCmdTxCancelRecurringPayments",
+		setup: cli.SetupCmdTxCancelRecurringPayments,
 		expFlags: []string{
-			cli.FlagTarget, cli.FlagSource, cli.FlagExternalID,
+			cli.FlagSource, cli.FlagExternalIDs, cli.FlagEmptyExternalID,
 			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
 		},
 		expAnnotations: map[string]map[string][]string{
-			cli.FlagSource: {required: {"true"}},
+			cli.FlagExternalIDs: {required: {"true"}},
 		},
 		expInUse: []string{
-			"{--from|--target} <target>", "--source <source>", "[--external-id <external id>",
-			cli.ReqSignerDesc(cli.FlagTarget),
+			"{--from|--source} <source>", "--external-ids <external ids>",
+			"[--empty-external-id]",
+			cli.ReqSignerDesc(cli.FlagSource),
+			cli.RepeatableDesc,
 		},
 	}
-	addOneReqAnnotations(&tc, flags.FlagFrom, cli.FlagTarget)
+	addOneReqAnnotations(&tc, flags.FlagFrom, cli.FlagSource)
 
 	runSetupTestCase(t, tc)
 }
 
-func TestMakeMsgRejectPayment(t *testing.T) {
-	td := txMakerTestDef[*exchange.MsgRejectPaymentRequest]{
-		makerName: "MakeMsgRejectPayment",
-		maker:     cli.MakeMsgRejectPayment,
-		setup:     cli.SetupCmdTxRejectPayment,
+func TestMakeMsgCancelRecurringPayments(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgCancelRecurringPaymentsRequest]{
+		makerName: "MakeMsgCancelRecurringPayments",
+		maker:     cli.MakeMsgCancelRecurringPayments,
+		setup:     cli.SetupCmdTxCancelRecurringPayments,
 	}
 
-	tests := []txMakerTestCase[*exchange.MsgRejectPaymentRequest]{
-		{
-			name:  "no target",
-			flags: []string{"--source", "the-source"},
-			expMsg: &exchange.MsgRejectPaymentRequest{
-				Target:     "",
-				Source:     "the-source",
-				ExternalId: "",
-			},
-			expErr: "no <target> provided",
+	tests := []txMakerTestCase[*exchange.MsgCancelRecurringPaymentsRequest]{
+		{
+			name:   "no source",
+			flags:  []string{"--external-ids", "id1,id2"},
+			expMsg: &exchange.MsgCancelRecurringPaymentsRequest{ExternalIds: []string{"id1", "id2"}},
+			expErr: "no <source> provided",
 		},
 		{
-			name:      "no external id",
-			clientCtx: client.Context{FromAddress: sdk.AccAddress("this-is-me")},
-			flags:     []string{"--source", "this-is-you"},
-			expMsg: &exchange.MsgRejectPaymentRequest{
-				Target:     sdk.AccAddress("this-is-me").String(),
-				Source:     "this-is-you",
-				ExternalId: "",
+			name:      "source from from",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("the_from_address____")},
+			flags:     []string{"--external-ids", "the-id"},
+			expMsg: &exchange.MsgCancelRecurringPaymentsRequest{
+				Source:      sdk.AccAddress("the_from_address____").String(),
+				ExternalIds: []string{"the-id"},
 			},
 		},
 		{
-			name: "all given",
-			flags: []string{
-				"--target", "jake",
-				"--source", "elroy",
-				"--external-id", "one-two-aone two three four",
-			},
-			expMsg: &exchange.MsgRejectPaymentRequest{
-				Target:     "jake",
-				Source:     "elroy",
-				ExternalId: "one-two-aone two three four",
+			name:  "all given",
+			flags: []string{"--external-ids", "a,b", "--source", "myself", "--empty-external-id"},
+			expMsg: &exchange.MsgCancelRecurringPaymentsRequest{
+				Source:      "myself",
+				ExternalIds: []string{"a", "b", ""},
 			},
 		},
 	}
@@ -2148,64 +4524,94 @@ func TestMakeMsgRejectPayment(t *testing.T) {
 	}
 }
 
-func TestSetupCmdTxRejectPayments(t *testing.T) {
+func TestSetupCmdTxCreateMultiPartyPayment(t *testing.T) {
 	tc := setupTestCase{
-		name:  "SetupCmdTxRejectPayments",
-		setup: cli.SetupCmdTxRejectPayments,
+		name:  "SetupCmdTxCreateMultiPartyPayment",
+		setup: cli.SetupCmdTxCreateMultiPartyPayment,
 		expFlags: []string{
-			cli.FlagTarget, cli.FlagSources,
+			cli.FlagCreator, cli.FlagExternalID, cli.FlagInputs, cli.FlagOutputs,
 			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
 		},
 		expAnnotations: map[string]map[string][]string{
-			cli.FlagSources: {required: {"true"}},
+			cli.FlagInputs:  {required: {"true"}},
+			cli.FlagOutputs: {required: {"true"}},
 		},
 		expInUse: []string{
-			"{--from|--target} <target>", "--sources <sources>",
-			cli.ReqSignerDesc(cli.FlagTarget),
-			cli.RepeatableDesc,
+			"{--from|--creator} <creator>", "[--external-id <external id>]",
+			"--inputs <account-amount>", "--outputs <account-amount>",
+			cli.ReqSignerDesc(cli.FlagCreator),
+			cli.RepeatableDesc, cli.MultiPartyPaymentDesc, cli.AccountAmountDesc,
 		},
 	}
-	addOneReqAnnotations(&tc, flags.FlagFrom, cli.FlagTarget)
+	addOneReqAnnotations(&tc, flags.FlagFrom, cli.FlagCreator)
 
 	runSetupTestCase(t, tc)
 }
 
-func TestMakeMsgRejectPayments(t *testing.T) {
-	td := txMakerTestDef[*exchange.MsgRejectPaymentsRequest]{
-		makerName: "MakeMsgRejectPayments",
-		maker:     cli.MakeMsgRejectPayments,
-		setup:     cli.SetupCmdTxRejectPayments,
+func TestMakeMsgCreateMultiPartyPayment(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgCreateMultiPartyPaymentRequest]{
+		makerName: "MakeMsgCreateMultiPartyPayment",
+		maker:     cli.MakeMsgCreateMultiPartyPayment,
+		setup:     cli.SetupCmdTxCreateMultiPartyPayment,
 	}
 
-	tests := []txMakerTestCase[*exchange.MsgRejectPaymentsRequest]{
+	tests := []txMakerTestCase[*exchange.MsgCreateMultiPartyPaymentRequest]{
 		{
-			name:  "no target",
-			flags: []string{"--sources", "source1,source2"},
-			expMsg: &exchange.MsgRejectPaymentsRequest{
-				Target:  "",
-				Sources: []string{"source1", "source2"},
+			name:  "no creator",
+			flags: []string{"--inputs", "one:5plum", "--outputs", "two:5plum"},
+			expMsg: &exchange.MsgCreateMultiPartyPaymentRequest{
+				Parties: []exchange.PaymentParty{
+					{Address: "one", SendAmount: sdk.NewCoins(sdk.NewInt64Coin("plum", 5))},
+					{Address: "two", ReceiveAmount: sdk.NewCoins(sdk.NewInt64Coin("plum", 5))},
+				},
 			},
-			expErr: "no <target> provided",
+			expErr: "no <creator> provided",
 		},
 		{
-			name:      "target from from",
-			clientCtx: client.Context{FromAddress: sdk.AccAddress("this-is-me")},
-			flags:     []string{"--sources", "this-is-you"},
-			expMsg: &exchange.MsgRejectPaymentsRequest{
-				Target:  sdk.AccAddress("this-is-me").String(),
-				Sources: []string{"this-is-you"},
+			name:      "creator from from",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("the_from_address____")},
+			flags:     []string{"--inputs", "one:5plum", "--outputs", "two:5plum"},
+			expMsg: &exchange.MsgCreateMultiPartyPaymentRequest{
+				Creator: sdk.AccAddress("the_from_address____").String(),
+				Parties: []exchange.PaymentParty{
+					{Address: "one", SendAmount: sdk.NewCoins(sdk.NewInt64Coin("plum", 5))},
+					{Address: "two", ReceiveAmount: sdk.NewCoins(sdk.NewInt64Coin("plum", 5))},
+				},
 			},
 		},
+		{
+			name:   "bad inputs",
+			flags:  []string{"--creator", "one", "--inputs", "one:5", "--outputs", "two:5plum"},
+			expMsg: &exchange.MsgCreateMultiPartyPaymentRequest{Creator: "one"},
+			expErr: "could not parse \"one:5\" amount: invalid coin expression: \"5\"",
+		},
 		{
 			name: "all given",
 			flags: []string{
-				"--sources", "anthony,chad",
-				"--target", "flea",
-				"--sources", "john",
-			},
-			expMsg: &exchange.MsgRejectPaymentsRequest{
-				Target:  "flea",
-				Sources: []string{"anthony", "chad", "john"},
+				"--creator", "one", "--external-id", "otc-1",
+				"--inputs", "one:5plum,two:3tangerine", "--inputs", "three:2cherry,one:1plum",
+				"--outputs", "two:6plum,three:3tangerine", "--outputs", "one:2cherry",
+			},
+			expMsg: &exchange.MsgCreateMultiPartyPaymentRequest{
+				Creator:    "one",
+				ExternalId: "otc-1",
+				Parties: []exchange.PaymentParty{
+					{
+						Address:       "one",
+						SendAmount:    sdk.NewCoins(sdk.NewInt64Coin("plum", 6)),
+						ReceiveAmount: sdk.NewCoins(sdk.NewInt64Coin("cherry", 2)),
+					},
+					{
+						Address:       "two",
+						SendAmount:    sdk.NewCoins(sdk.NewInt64Coin("tangerine", 3)),
+						ReceiveAmount: sdk.NewCoins(sdk.NewInt64Coin("plum", 6)),
+					},
+					{
+						Address:       "three",
+						SendAmount:    sdk.NewCoins(sdk.NewInt64Coin("cherry", 2)),
+						ReceiveAmount: sdk.NewCoins(sdk.NewInt64Coin("tangerine", 3)),
+					},
+				},
 			},
 		},
 	}
@@ -2217,66 +4623,76 @@ func TestMakeMsgRejectPayments(t *testing.T) {
 	}
 }
 
-func TestSetupCmdTxCancelPayments(t *testing.T) {
+func TestSetupCmdTxAcceptMultiPartyPayment(t *testing.T) {
 	tc := setupTestCase{
-		name:  "SetupCmdTxCancelPayments",
-		setup: cli.SetupCmdTxCancelPayments,
+		name:  "SetupCmdTxAcceptMultiPartyPayment",
+		setup: cli.SetupCmdTxAcceptMultiPartyPayment,
 		expFlags: []string{
-			cli.FlagSource, cli.FlagExternalIDs, cli.FlagEmptyExternalID,
+			cli.FlagParty, cli.FlagCreator, cli.FlagExternalID, cli.FlagInputs, cli.FlagOutputs,
 			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
 		},
 		expAnnotations: map[string]map[string][]string{
-			cli.FlagExternalIDs: {required: {"true"}},
+			cli.FlagCreator: {required: {"true"}},
+			cli.FlagInputs:  {required: {"true"}},
+			cli.FlagOutputs: {required: {"true"}},
 		},
 		expInUse: []string{
-			"{--from|--source} <source>", "--external-ids <external ids>",
-			"[--empty-external-id]",
-			cli.ReqSignerDesc(cli.FlagSource),
-			cli.RepeatableDesc,
+			"{--from|--party} <party>", "--creator <creator>", "[--external-id <external id>]",
+			"--inputs <account-amount>", "--outputs <account-amount>",
+			cli.ReqSignerDesc(cli.FlagParty),
+			cli.RepeatableDesc, cli.MultiPartyPaymentDesc, cli.AccountAmountDesc,
 		},
 	}
-	addOneReqAnnotations(&tc, flags.FlagFrom, cli.FlagSource)
+	addOneReqAnnotations(&tc, flags.FlagFrom, cli.FlagParty)
 
 	runSetupTestCase(t, tc)
 }
 
-func TestMakeMsgCancelPayments(t *testing.T) {
-	td := txMakerTestDef[*exchange.MsgCancelPaymentsRequest]{
-		makerName: "MakeMsgCancelPayments",
-		maker:     cli.MakeMsgCancelPayments,
-		setup:     cli.SetupCmdTxCancelPayments,
+func TestMakeMsgAcceptMultiPartyPayment(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgAcceptMultiPartyPaymentRequest]{
+		makerName: "MakeMsgAcceptMultiPartyPayment",
+		maker:     cli.MakeMsgAcceptMultiPartyPayment,
+		setup:     cli.SetupCmdTxAcceptMultiPartyPayment,
+	}
+	parties := []exchange.PaymentParty{
+		{Address: "one", SendAmount: sdk.NewCoins(sdk.NewInt64Coin("plum", 5))},
+		{Address: "two", ReceiveAmount: sdk.NewCoins(sdk.NewInt64Coin("plum", 5))},
 	}
 
-	tests := []txMakerTestCase[*exchange.MsgCancelPaymentsRequest]{
+	tests := []txMakerTestCase[*exchange.MsgAcceptMultiPartyPaymentRequest]{
 		{
-			name:  "no source",
-			flags: []string{"--external-ids", "id1,id2"},
-			expMsg: &exchange.MsgCancelPaymentsRequest{
-				Source:      "",
-				ExternalIds: []string{"id1", "id2"},
-			},
-			expErr: "no <source> provided",
+			name:   "no party",
+			flags:  []string{"--creator", "one", "--inputs", "one:5plum", "--outputs", "two:5plum"},
+			expMsg: &exchange.MsgAcceptMultiPartyPaymentRequest{Creator: "one", Parties: parties},
+			expErr: "no <party> provided",
 		},
 		{
-			name:      "source from from",
+			name:      "party from from",
 			clientCtx: client.Context{FromAddress: sdk.AccAddress("the_from_address____")},
-			flags:     []string{"--external-ids", "the-id"},
-			expMsg: &exchange.MsgCancelPaymentsRequest{
-				Source:      sdk.AccAddress("the_from_address____").String(),
-				ExternalIds: []string{"the-id"},
+			flags:     []string{"--creator", "one", "--inputs", "one:5plum", "--outputs", "two:5plum"},
+			expMsg: &exchange.MsgAcceptMultiPartyPaymentRequest{
+				Party:   sdk.AccAddress("the_from_address____").String(),
+				Creator: "one",
+				Parties: parties,
 			},
 		},
+		{
+			name:   "bad outputs",
+			flags:  []string{"--party", "two", "--creator", "one", "--inputs", "one:5plum", "--outputs", "5plum"},
+			expMsg: &exchange.MsgAcceptMultiPartyPaymentRequest{Party: "two", Creator: "one"},
+			expErr: "invalid account-amount \"5plum\": expected format <account>:<amount>",
+		},
 		{
 			name: "all given",
 			flags: []string{
-				"--external-ids", "a,b,c",
-				"--source", "myself",
-				"--empty-external-id",
-				"--external-ids", "def,ghi",
+				"--party", "two", "--creator", "one", "--external-id", "otc-1",
+				"--inputs", "one:5plum", "--outputs", "two:5plum",
 			},
-			expMsg: &exchange.MsgCancelPaymentsRequest{
-				Source:      "myself",
-				ExternalIds: []string{"a", "b", "c", "def", "ghi", ""},
+			expMsg: &exchange.MsgAcceptMultiPartyPaymentRequest{
+				Party:      "two",
+				Creator:    "one",
+				ExternalId: "otc-1",
+				Parties:    parties,
 			},
 		},
 	}
@@ -2288,64 +4704,58 @@ func TestMakeMsgCancelPayments(t *testing.T) {
 	}
 }
 
-func TestSetupCmdTxChangePaymentTarget(t *testing.T) {
+func TestSetupCmdTxCancelMultiPartyPayment(t *testing.T) {
 	tc := setupTestCase{
-		name:  "SetupCmdTxChangePaymentTarget",
-		setup: cli.SetupCmdTxChangePaymentTarget,
+		name:  "SetupCmdTxCancelMultiPartyPayment",
+		setup: cli.SetupCmdTxCancelMultiPartyPayment,
 		expFlags: []string{
-			cli.FlagSource, cli.FlagExternalID, cli.FlagNewTarget,
+			cli.FlagParty, cli.FlagCreator, cli.FlagExternalID,
 			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
 		},
+		expAnnotations: map[string]map[string][]string{
+			cli.FlagCreator: {required: {"true"}},
+		},
 		expInUse: []string{
-			"{--from|--source} <source>",
-			"[--external-id <external id>]", "[--new-target <new target>]",
-			cli.ReqSignerDesc(cli.FlagSource),
+			"{--from|--party} <party>", "--creator <creator>", "[--external-id <external id>]",
+			cli.ReqSignerDesc(cli.FlagParty),
 		},
 	}
-	addOneReqAnnotations(&tc, flags.FlagFrom, cli.FlagSource)
+	addOneReqAnnotations(&tc, flags.FlagFrom, cli.FlagParty)
 
 	runSetupTestCase(t, tc)
 }
 
-func TestMakeMsgChangePaymentTarget(t *testing.T) {
-	td := txMakerTestDef[*exchange.MsgChangePaymentTargetRequest]{
-		makerName: "MakeMsgChangePaymentTarget",
-		maker:     cli.MakeMsgChangePaymentTarget,
-		setup:     cli.SetupCmdTxChangePaymentTarget,
+func TestMakeMsgCancelMultiPartyPayment(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgCancelMultiPartyPaymentRequest]{
+		makerName: "MakeMsgCancelMultiPartyPayment",
+		maker:     cli.MakeMsgCancelMultiPartyPayment,
+		setup:     cli.SetupCmdTxCancelMultiPartyPayment,
 	}
 
-	tests := []txMakerTestCase[*exchange.MsgChangePaymentTargetRequest]{
+	tests := []txMakerTestCase[*exchange.MsgCancelMultiPartyPaymentRequest]{
 		{
-			name:  "no source",
-			flags: []string{},
-			expMsg: &exchange.MsgChangePaymentTargetRequest{
-				Source:     "",
-				ExternalId: "",
-				NewTarget:  "",
-			},
-			expErr: "no <source> provided",
+			name:   "no party",
+			flags:  []string{"--creator", "one"},
+			expMsg: &exchange.MsgCancelMultiPartyPaymentRequest{Creator: "one"},
+			expErr: "no <party> provided",
 		},
 		{
-			name:      "source from from",
+			name:      "party from from",
 			clientCtx: client.Context{FromAddress: sdk.AccAddress("the_from_address____")},
-			flags:     []string{},
-			expMsg: &exchange.MsgChangePaymentTargetRequest{
-				Source:     sdk.AccAddress("the_from_address____").String(),
-				ExternalId: "",
-				NewTarget:  "",
+			flags:     []string{"--creator", "one", "--external-id", "otc-1"},
+			expMsg: &exchange.MsgCancelMultiPartyPaymentRequest{
+				Party:      sdk.AccAddress("the_from_address____").String(),
+				Creator:    "one",
+				ExternalId: "otc-1",
 			},
 		},
 		{
-			name: "all given",
-			flags: []string{
-				"--source", "thesource",
-				"--new-target", "thenewtarget",
-				"--external-id", "theexternalidentifier",
-			},
-			expMsg: &exchange.MsgChangePaymentTargetRequest{
-				Source:     "thesource",
-				ExternalId: "theexternalidentifier",
-				NewTarget:  "thenewtarget",
+			name:  "all given",
+			flags: []string{"--party", "two", "--creator", "one", "--external-id", "otc-1"},
+			expMsg: &exchange.MsgCancelMultiPartyPaymentRequest{
+				Party:      "two",
+				Creator:    "one",
+				ExternalId: "otc-1",
 			},
 		},
 	}
@@ -2365,9 +4775,15 @@ func TestSetupCmdTxGovCreateMarket(t *testing.T) {
 			cli.FlagAuthority,
 			cli.FlagMarket, cli.FlagName, cli.FlagDescription, cli.FlagURL, cli.FlagIcon,
 			cli.FlagCreateAsk, cli.FlagCreateBid, cli.FlagCreateCommitment,
-			cli.FlagSellerFlat, cli.FlagSellerRatios, cli.FlagBuyerFlat, cli.FlagBuyerRatios,
-			cli.FlagAcceptingOrders, cli.FlagAllowUserSettle, cli.FlagAcceptingCommitments, cli.FlagAccessGrants,
+			cli.FlagSellerFlat, cli.FlagSellerRatios, cli.FlagTakerRatios, cli.FlagBuyerFlat, cli.FlagBuyerRatios,
+			cli.FlagRebateRatios, cli.FlagRebateAddrs, cli.FlagReferralBips, cli.FlagReferralCap,
+			cli.FlagAcceptingOrders, cli.FlagAllowUserSettle, cli.FlagAcceptingCommitments, cli.FlagContinuousMatching,
+			cli.FlagBatchAuctionInterval, cli.FlagSelfTradePrevention, cli.FlagPublishPrices, cli.FlagExternalIDScope,
+			cli.FlagFillAlgorithm,
+			cli.FlagMaxOrders, cli.FlagWindowBlocks, cli.FlagMaxExposure,
+			cli.FlagAccessGrants,
 			cli.FlagReqAttrAsk, cli.FlagReqAttrBid, cli.FlagReqAttrCommitment,
+			cli.FlagAssetDenoms, cli.FlagPriceDenoms,
 			cli.FlagBips, cli.FlagDenom,
 			cli.FlagProposal,
 		},
@@ -2375,14 +4791,21 @@ func TestSetupCmdTxGovCreateMarket(t *testing.T) {
 			"[--authority <authority>]", "[--market <market id>]",
 			"[--name <name>]", "[--description <description>]", "[--url <website url>]", "[--icon <icon uri>]",
 			"[--create-ask <coins>]", "[--create-bid <coins>]", "[--create-commitment <coins>]",
-			"[--seller-flat <coins>]", "[--seller-ratios <fee ratios>]",
+			"[--seller-flat <coins>]", "[--seller-ratios <fee ratios>]", "[--taker-ratios <fee ratios>]",
+			"[--rebate-ratios <fee ratios>]", "[--rebate-addrs <addresses>]",
+			"[--referral-bips <bips>]", "[--referral-cap <coins>]",
 			"[--buyer-flat <coins>]", "[--buyer-ratios <fee ratios>]",
-			"[--accepting-orders]", "[--allow-user-settle]", "[--accepting-commitments]",
+			"[--accepting-orders]", "[--allow-user-settle]", "[--accepting-commitments]", "[--continuous-matching]",
+			"[--batch-auction-interval <blocks>]", "[--self-trade-prevention <mode>]", "[--publish-prices]",
+			"[--external-id-scope <scope>]", "[--fill-algorithm <algorithm>]",
+			"[--max-orders <count>]", "[--window-blocks <blocks>]", "[--max-exposure <coin>]",
 			"[--access-grants <access grants>]",
 			"[--req-attr-ask <attrs>]", "[--req-attr-bid <attrs>]", "[--req-attr-commitment <attrs>]",
+			"[--asset-denoms <denoms>]", "[--price-denoms <denoms>]",
 			"[--bips <bips>]", "[--denom <denom>]",
 			"[--proposal <json filename>",
-			cli.AuthorityDesc, cli.RepeatableDesc, cli.AccessGrantsDesc, cli.FeeRatioDesc,
+			cli.AuthorityDesc, cli.RepeatableDesc, cli.AccessGrantsDesc, cli.FeeRatioDesc, cli.SelfTradePreventionDesc, cli.OrderRateLimitDesc,
+			cli.FillAlgorithmDesc, cli.MaxExposureDesc, cli.AcceptedDenomsDesc,
 			cli.ProposalFileDesc(&exchange.MsgGovCreateMarketRequest{}),
 		},
 	}
@@ -2390,9 +4813,15 @@ func TestSetupCmdTxGovCreateMarket(t *testing.T) {
 	oneReqFlags := []string{
 		cli.FlagMarket, cli.FlagName, cli.FlagDescription, cli.FlagURL, cli.FlagIcon,
 		cli.FlagCreateAsk, cli.FlagCreateBid, cli.FlagCreateCommitment,
-		cli.FlagSellerFlat, cli.FlagSellerRatios, cli.FlagBuyerFlat, cli.FlagBuyerRatios,
-		cli.FlagAcceptingOrders, cli.FlagAllowUserSettle, cli.FlagAcceptingCommitments, cli.FlagAccessGrants,
+		cli.FlagSellerFlat, cli.FlagSellerRatios, cli.FlagTakerRatios, cli.FlagBuyerFlat, cli.FlagBuyerRatios,
+		cli.FlagRebateRatios, cli.FlagRebateAddrs, cli.FlagReferralBips, cli.FlagReferralCap,
+		cli.FlagAcceptingOrders, cli.FlagAllowUserSettle, cli.FlagAcceptingCommitments, cli.FlagContinuousMatching,
+		cli.FlagBatchAuctionInterval, cli.FlagSelfTradePrevention, cli.FlagPublishPrices, cli.FlagExternalIDScope,
+		cli.FlagFillAlgorithm,
+		cli.FlagMaxOrders, cli.FlagWindowBlocks, cli.FlagMaxExposure,
+		cli.FlagAccessGrants,
 		cli.FlagReqAttrAsk, cli.FlagReqAttrBid, cli.FlagReqAttrCommitment,
+		cli.FlagAssetDenoms, cli.FlagPriceDenoms,
 		cli.FlagBips, cli.FlagDenom,
 		cli.FlagProposal,
 	}
@@ -2455,6 +4884,16 @@ func TestMakeMsgGovCreateMarket(t *testing.T) {
 			CommitmentSettlementBips: 84,
 			IntermediaryDenom:        "fig",
 			ReqAttrCreateCommitment:  []string{"commitment.create"},
+
+			ContinuousMatching:   true,
+			BatchAuctionInterval: 3,
+			SelfTradePrevention:  exchange.SelfTradePrevention_decrement_both,
+			PublishPrices:        true,
+			ExternalIdScope:      exchange.ExternalIDScope_account,
+			FillAlgorithm:        exchange.FillAlgorithm_price_time,
+
+			AcceptedAssetDenoms: []string{"apple", "banana"},
+			AcceptedPriceDenoms: []string{"nhash"},
 		},
 	}
 	prop := newGovProp(t, fileMsg)
@@ -2467,6 +4906,7 @@ func TestMakeMsgGovCreateMarket(t *testing.T) {
 			flags: []string{
 				"--create-ask", "nope", "--seller-ratios", "8apple",
 				"--access-grants", "addr8:set", "--accepting-orders",
+				"--self-trade-prevention", "cancel-all",
 			},
 			expMsg: &exchange.MsgGovCreateMarketRequest{
 				Authority: cli.AuthorityAddr.String(),
@@ -2481,6 +4921,7 @@ func TestMakeMsgGovCreateMarket(t *testing.T) {
 				"invalid coin expression: \"nope\"",
 				"cannot create FeeRatio from \"8apple\": expected exactly one colon",
 				"could not parse permissions for \"addr8\" from \"set\": invalid permission: \"set\"",
+				"invalid self-trade prevention: \"cancel-all\"",
 			),
 		},
 		{
@@ -2489,7 +4930,7 @@ func TestMakeMsgGovCreateMarket(t *testing.T) {
 			flags: []string{
 				"--market", "18",
 				"--create-ask", "10fig", "--create-bid", "5grape", "--create-commitment", "7honeydew",
-				"--seller-flat", "12fig", "--seller-ratios", "100prune:1prune",
+				"--seller-flat", "12fig", "--seller-ratios", "100prune:1prune", "--taker-ratios", "100prune:3prune",
 				"--buyer-flat", "17fig", "--buyer-ratios", "88plum:3plum",
 				"--accepting-orders", "--allow-user-settle", "--accepting-commitments",
 				"--access-grants", "addr1:settle+cancel", "--access-grants", "addr2:update+permissions",
@@ -2497,7 +4938,13 @@ func TestMakeMsgGovCreateMarket(t *testing.T) {
 				"--name", "Special market", "--description", "This market is special.",
 				"--url", "https://example.com", "--icon", "https://example.com/icon",
 				"--access-grants", "addr3:all",
-				"--bips", "47", "--denom", "raisin",
+				"--bips", "47", "--denom", "raisin", "--continuous-matching", "--batch-auction-interval", "12",
+				"--self-trade-prevention", "cancel-oldest", "--publish-prices", "--external-id-scope", "account",
+				"--fill-algorithm", "pro-rata",
+				"--max-orders", "20", "--window-blocks", "5", "--max-exposure", "1000prune",
+				"--asset-denoms", "apple,banana", "--price-denoms", "nhash",
+				"--rebate-ratios", "1000prune:1prune", "--rebate-addrs", "addr4,addr5",
+				"--referral-bips", "2500", "--referral-cap", "10prune",
 			},
 			expMsg: &exchange.MsgGovCreateMarketRequest{
 				Authority: cli.AuthorityAddr.String(),
@@ -2515,6 +4962,9 @@ func TestMakeMsgGovCreateMarket(t *testing.T) {
 					FeeSellerSettlementRatios: []exchange.FeeRatio{
 						{Price: sdk.NewInt64Coin("prune", 100), Fee: sdk.NewInt64Coin("prune", 1)},
 					},
+					FeeSellerSettlementTakerRatios: []exchange.FeeRatio{
+						{Price: sdk.NewInt64Coin("prune", 100), Fee: sdk.NewInt64Coin("prune", 3)},
+					},
 					FeeBuyerSettlementFlat: []sdk.Coin{sdk.NewInt64Coin("fig", 17)},
 					FeeBuyerSettlementRatios: []exchange.FeeRatio{
 						{Price: sdk.NewInt64Coin("plum", 88), Fee: sdk.NewInt64Coin("plum", 3)},
@@ -2543,6 +4993,26 @@ func TestMakeMsgGovCreateMarket(t *testing.T) {
 					CommitmentSettlementBips: 47,
 					IntermediaryDenom:        "raisin",
 					ReqAttrCreateCommitment:  []string{"com.kyc"},
+
+					ContinuousMatching:   true,
+					BatchAuctionInterval: 12,
+					SelfTradePrevention:  exchange.SelfTradePrevention_cancel_oldest,
+					PublishPrices:        true,
+					ExternalIdScope:      exchange.ExternalIDScope_account,
+					FillAlgorithm:        exchange.FillAlgorithm_pro_rata,
+					OrderRateLimit:       &exchange.OrderRateLimit{MaxOrders: 20, WindowBlocks: 5},
+					MaxExposure:          &sdk.Coin{Denom: "prune", Amount: sdkmath.NewInt(1000)},
+
+					AcceptedAssetDenoms: []string{"apple", "banana"},
+					AcceptedPriceDenoms: []string{"nhash"},
+
+					FeeSellerSettlementRebateRatios: []exchange.FeeRatio{
+						{Price: sdk.NewInt64Coin("prune", 1000), Fee: sdk.NewInt64Coin("prune", 1)},
+					},
+					RebateAddresses: []string{"addr4", "addr5"},
+
+					ReferralBips: 2500,
+					ReferralCap:  []sdk.Coin{sdk.NewInt64Coin("prune", 10)},
 				},
 			},
 		},
@@ -2577,6 +5047,14 @@ func TestMakeMsgGovCreateMarket(t *testing.T) {
 					CommitmentSettlementBips:  fileMsg.Market.CommitmentSettlementBips,
 					IntermediaryDenom:         fileMsg.Market.IntermediaryDenom,
 					ReqAttrCreateCommitment:   fileMsg.Market.ReqAttrCreateCommitment,
+					ContinuousMatching:        fileMsg.Market.ContinuousMatching,
+					BatchAuctionInterval:      fileMsg.Market.BatchAuctionInterval,
+					SelfTradePrevention:       fileMsg.Market.SelfTradePrevention,
+					PublishPrices:             fileMsg.Market.PublishPrices,
+					ExternalIdScope:           fileMsg.Market.ExternalIdScope,
+					FillAlgorithm:             fileMsg.Market.FillAlgorithm,
+					AcceptedAssetDenoms:       fileMsg.Market.AcceptedAssetDenoms,
+					AcceptedPriceDenoms:       fileMsg.Market.AcceptedPriceDenoms,
 				},
 			},
 		},
@@ -2597,12 +5075,17 @@ func TestSetupCmdTxGovManageFees(t *testing.T) {
 			cli.FlagAuthority, cli.FlagMarket,
 			cli.FlagAskAdd, cli.FlagAskRemove, cli.FlagBidAdd, cli.FlagBidRemove,
 			cli.FlagSellerFlatAdd, cli.FlagSellerFlatRemove, cli.FlagSellerRatiosAdd, cli.FlagSellerRatiosRemove,
+			cli.FlagTakerRatiosAdd, cli.FlagTakerRatiosRemove,
+			cli.FlagRebateRatiosAdd, cli.FlagRebateRatiosRemove, cli.FlagRebateAddrsAdd, cli.FlagRebateAddrsRemove,
+			cli.FlagReferralBips, cli.FlagUnsetReferralBips, cli.FlagReferralCapAdd, cli.FlagReferralCapRemove,
 			cli.FlagBuyerFlatAdd, cli.FlagBuyerFlatRemove, cli.FlagBuyerRatiosAdd, cli.FlagBuyerRatiosRemove,
 			cli.FlagCommitmentAdd, cli.FlagCommitmentRemove, cli.FlagBips, cli.FlagUnsetBips,
-			cli.FlagProposal,
+			cli.FlagEffectiveHeight, cli.FlagEffectiveTime, cli.FlagProposal,
 		},
 		expAnnotations: map[string]map[string][]string{
-			cli.FlagMarket: {required: {"true"}},
+			cli.FlagMarket:          {required: {"true"}},
+			cli.FlagEffectiveHeight: {mutExc: {cli.FlagEffectiveHeight + " " + cli.FlagEffectiveTime}},
+			cli.FlagEffectiveTime:   {mutExc: {cli.FlagEffectiveHeight + " " + cli.FlagEffectiveTime}},
 		},
 		expInUse: []string{
 			"--market <market id>", "[--authority <authority>]",
@@ -2611,11 +5094,17 @@ func TestSetupCmdTxGovManageFees(t *testing.T) {
 			"[--commitment-add <coins>]", "[--commitment-remove <coins>]",
 			"[--seller-flat-add <coins>]", "[--seller-flat-remove <coins>]",
 			"[--seller-ratios-add <fee ratios>]", "[--seller-ratios-remove <fee ratios>]",
+			"[--taker-ratios-add <fee ratios>]", "[--taker-ratios-remove <fee ratios>]",
+			"[--rebate-ratios-add <fee ratios>]", "[--rebate-ratios-remove <fee ratios>]",
+			"[--rebate-addrs-add <addresses>]", "[--rebate-addrs-remove <addresses>]",
+			"[--referral-bips <bips>]", "[--unset-referral-bips]",
+			"[--referral-cap-add <coins>]", "[--referral-cap-remove <coins>]",
 			"[--buyer-flat-add <coins>]", "[--buyer-flat-remove <coins>]",
 			"[--buyer-ratios-add <fee ratios>]", "[--buyer-ratios-remove <fee ratios>]",
 			"[--bips <bips>]", "[--unset-bips]",
+			"[--effective-height <height>]", "[--effective-time <time>]",
 			"[--proposal <json filename>",
-			cli.AuthorityDesc, cli.RepeatableDesc, cli.FeeRatioDesc,
+			cli.AuthorityDesc, cli.RepeatableDesc, cli.FeeRatioDesc, cli.ScheduledFeesDesc,
 			cli.ProposalFileDesc(&exchange.MsgGovManageFeesRequest{}),
 		},
 	}
@@ -2623,6 +5112,9 @@ func TestSetupCmdTxGovManageFees(t *testing.T) {
 	oneReqFlags := []string{
 		cli.FlagAskAdd, cli.FlagAskRemove, cli.FlagBidAdd, cli.FlagBidRemove,
 		cli.FlagSellerFlatAdd, cli.FlagSellerFlatRemove, cli.FlagSellerRatiosAdd, cli.FlagSellerRatiosRemove,
+		cli.FlagTakerRatiosAdd, cli.FlagTakerRatiosRemove,
+		cli.FlagRebateRatiosAdd, cli.FlagRebateRatiosRemove, cli.FlagRebateAddrsAdd, cli.FlagRebateAddrsRemove,
+		cli.FlagReferralBips, cli.FlagUnsetReferralBips, cli.FlagReferralCapAdd, cli.FlagReferralCapRemove,
 		cli.FlagBuyerFlatAdd, cli.FlagBuyerFlatRemove, cli.FlagBuyerRatiosAdd, cli.FlagBuyerRatiosRemove,
 		cli.FlagCommitmentAdd, cli.FlagCommitmentRemove, cli.FlagBips, cli.FlagUnsetBips,
 		cli.FlagProposal,
@@ -2648,6 +5140,7 @@ func TestMakeMsgGovManageFees(t *testing.T) {
 		setup:     cli.SetupCmdTxGovManageFees,
 	}
 
+	effTime := time.Date(2030, 1, 2, 15, 4, 5, 0, time.UTC)
 	tdir := t.TempDir()
 	propFN := filepath.Join(tdir, "manage-fees-prop.json")
 	fileMsg := &exchange.MsgGovManageFeesRequest{
@@ -2706,6 +5199,10 @@ func TestMakeMsgGovManageFees(t *testing.T) {
 				"--bid-add", "17fig", "--bid-remove", "14fig",
 				"--seller-flat-add", "55prune", "--seller-flat-remove", "54prune",
 				"--seller-ratios-add", "101prune:7prune", "--seller-ratios-remove", "101prune:3prune",
+				"--taker-ratios-add", "101prune:9prune", "--taker-ratios-remove", "101prune:5prune",
+				"--rebate-ratios-add", "1000prune:2prune", "--rebate-ratios-remove", "1000prune:1prune",
+				"--rebate-addrs-add", "addr6", "--rebate-addrs-remove", "addr7,addr8",
+				"--referral-bips", "2500", "--referral-cap-add", "10prune", "--referral-cap-remove", "5prune",
 				"--buyer-flat-add", "59prune", "--buyer-flat-remove", "57prune",
 				"--buyer-ratios-add", "107prune:1prune", "--buyer-ratios-remove", "43prune:2prune",
 				"--commitment-add", "20lychee", "--commitment-remove", "21lingonberry",
@@ -2726,6 +5223,23 @@ func TestMakeMsgGovManageFees(t *testing.T) {
 				RemoveFeeSellerSettlementRatios: []exchange.FeeRatio{
 					{Price: sdk.NewInt64Coin("prune", 101), Fee: sdk.NewInt64Coin("prune", 3)},
 				},
+				AddFeeSellerSettlementTakerRatios: []exchange.FeeRatio{
+					{Price: sdk.NewInt64Coin("prune", 101), Fee: sdk.NewInt64Coin("prune", 9)},
+				},
+				RemoveFeeSellerSettlementTakerRatios: []exchange.FeeRatio{
+					{Price: sdk.NewInt64Coin("prune", 101), Fee: sdk.NewInt64Coin("prune", 5)},
+				},
+				AddFeeSellerSettlementRebateRatios: []exchange.FeeRatio{
+					{Price: sdk.NewInt64Coin("prune", 1000), Fee: sdk.NewInt64Coin("prune", 2)},
+				},
+				RemoveFeeSellerSettlementRebateRatios: []exchange.FeeRatio{
+					{Price: sdk.NewInt64Coin("prune", 1000), Fee: sdk.NewInt64Coin("prune", 1)},
+				},
+				AddRebateAddresses:           []string{"addr6"},
+				RemoveRebateAddresses:        []string{"addr7", "addr8"},
+				SetReferralBips:              2500,
+				AddReferralCap:               []sdk.Coin{sdk.NewInt64Coin("prune", 10)},
+				RemoveReferralCap:            []sdk.Coin{sdk.NewInt64Coin("prune", 5)},
 				AddFeeBuyerSettlementFlat:    []sdk.Coin{sdk.NewInt64Coin("prune", 59)},
 				RemoveFeeBuyerSettlementFlat: []sdk.Coin{sdk.NewInt64Coin("prune", 57)},
 				AddFeeBuyerSettlementRatios: []exchange.FeeRatio{
@@ -2740,6 +5254,37 @@ func TestMakeMsgGovManageFees(t *testing.T) {
 				UnsetFeeCommitmentSettlementBips: true,
 			},
 		},
+		{
+			name:  "effective height",
+			flags: []string{"--market", "3", "--bips", "5", "--effective-height", "12345"},
+			expMsg: &exchange.MsgGovManageFeesRequest{
+				Authority:                      cli.AuthorityAddr.String(),
+				MarketId:                       3,
+				SetFeeCommitmentSettlementBips: 5,
+				EffectiveHeight:                12345,
+			},
+		},
+		{
+			name:  "effective time",
+			flags: []string{"--market", "3", "--bips", "5", "--effective-time", "2030-01-02T15:04:05Z"},
+			expMsg: &exchange.MsgGovManageFeesRequest{
+				Authority:                      cli.AuthorityAddr.String(),
+				MarketId:                       3,
+				SetFeeCommitmentSettlementBips: 5,
+				EffectiveTime:                  &effTime,
+			},
+		},
+		{
+			name:  "invalid effective time",
+			flags: []string{"--market", "3", "--bips", "5", "--effective-time", "tomorrow"},
+			expMsg: &exchange.MsgGovManageFeesRequest{
+				Authority:                      cli.AuthorityAddr.String(),
+				MarketId:                       3,
+				SetFeeCommitmentSettlementBips: 5,
+			},
+			expErr: "error parsing --effective-time as an RFC3339 time: " +
+				"parsing time \"tomorrow\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"tomorrow\" as \"2006\"",
+		},
 		{
 			name:      "proposal flag",
 			clientCtx: clientContextWithCodec(t, client.Context{FromAddress: sdk.AccAddress("FromAddress_________")}),
@@ -2781,6 +5326,85 @@ func TestMakeMsgGovManageFees(t *testing.T) {
 	}
 }
 
+func TestSetupCmdTxGovManageExchangeSplits(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdTxGovManageExchangeSplits",
+		setup: cli.SetupCmdTxGovManageExchangeSplits,
+		expFlags: []string{
+			cli.FlagAuthority, cli.FlagMarket, cli.FlagSplit, cli.FlagSplitRemove,
+		},
+		expAnnotations: map[string]map[string][]string{
+			cli.FlagMarket:      {required: {"true"}},
+			cli.FlagSplit:       {oneReq: {cli.FlagSplit + " " + cli.FlagSplitRemove}},
+			cli.FlagSplitRemove: {oneReq: {cli.FlagSplit + " " + cli.FlagSplitRemove}},
+		},
+		expInUse: []string{
+			"--market <market id>", "[--split <splits>]", "[--split-remove <denoms>]",
+			"[--authority <authority>]",
+			cli.AuthorityDesc, cli.RepeatableDesc,
+			`A <split> has the format "<denom>:<amount>".
+An <amount> is in basis points and is limited to 0 to 10,000 (both inclusive).
+
+Example <split>: nhash:500`,
+			"A market's denom-splits take precedence over the ones in the exchange module params.",
+		},
+	})
+}
+
+func TestMakeMsgGovManageExchangeSplits(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgGovManageExchangeSplitsRequest]{
+		makerName: "MakeMsgGovManageExchangeSplits",
+		maker:     cli.MakeMsgGovManageExchangeSplits,
+		setup:     cli.SetupCmdTxGovManageExchangeSplits,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgGovManageExchangeSplitsRequest]{
+		{
+			name:      "nothing",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			expMsg: &exchange.MsgGovManageExchangeSplitsRequest{
+				Authority:            cli.AuthorityAddr.String(),
+				RemoveExchangeSplits: []string{},
+			},
+		},
+		{
+			name:  "bad split",
+			flags: []string{"--market", "3", "--split", "apple"},
+			expMsg: &exchange.MsgGovManageExchangeSplitsRequest{
+				Authority:            cli.AuthorityAddr.String(),
+				MarketId:             3,
+				SetExchangeSplits:    []exchange.DenomSplit{},
+				RemoveExchangeSplits: []string{},
+			},
+			expErr: "invalid denom split \"apple\": expected format <denom>:<amount>",
+		},
+		{
+			name: "everything",
+			flags: []string{
+				"--market", "2", "--authority", "alex",
+				"--split", "apple:100,banana:0", "--split", "cherry:10000",
+				"--split-remove", "date", "--split-remove", "eggplant,fig",
+			},
+			expMsg: &exchange.MsgGovManageExchangeSplitsRequest{
+				Authority: "alex",
+				MarketId:  2,
+				SetExchangeSplits: []exchange.DenomSplit{
+					{Denom: "apple", Split: 100},
+					{Denom: "banana", Split: 0},
+					{Denom: "cherry", Split: 10_000},
+				},
+				RemoveExchangeSplits: []string{"date", "eggplant", "fig"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
 func TestSetupCmdTxGovCloseMarket(t *testing.T) {
 	runSetupTestCase(t, setupTestCase{
 		name:  "SetupCmdTxGovCloseMarket",
@@ -2830,23 +5454,85 @@ func TestMakeMsgGovCloseMarket(t *testing.T) {
 	}
 }
 
+func TestSetupCmdTxGovWindDownMarket(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdTxGovWindDownMarket",
+		setup: cli.SetupCmdTxGovWindDownMarket,
+		expFlags: []string{
+			cli.FlagAuthority, cli.FlagMarket, cli.FlagDeleteMarket,
+		},
+		expAnnotations: map[string]map[string][]string{
+			cli.FlagMarket: {required: {"true"}},
+		},
+		expInUse: []string{
+			"--market <market id>", "[--delete-market]", "[--authority <authority>]",
+			cli.AuthorityDesc,
+		},
+	})
+}
+
+func TestMakeMsgGovWindDownMarket(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgGovWindDownMarketRequest]{
+		makerName: "MakeMsgGovWindDownMarket",
+		maker:     cli.MakeMsgGovWindDownMarket,
+		setup:     cli.SetupCmdTxGovWindDownMarket,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgGovWindDownMarketRequest]{
+		{
+			name:      "nothing",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			expMsg: &exchange.MsgGovWindDownMarketRequest{
+				Authority: cli.AuthorityAddr.String(),
+			},
+		},
+		{
+			name:  "no delete",
+			flags: []string{"--market", "7"},
+			expMsg: &exchange.MsgGovWindDownMarketRequest{
+				Authority: cli.AuthorityAddr.String(),
+				MarketId:  7,
+			},
+		},
+		{
+			name:  "everything",
+			flags: []string{"--market", "2", "--authority", "alex", "--delete-market"},
+			expMsg: &exchange.MsgGovWindDownMarketRequest{
+				Authority:    "alex",
+				MarketId:     2,
+				DeleteMarket: true,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
 func TestSetupCmdTxUpdateParams(t *testing.T) {
 	runSetupTestCase(t, setupTestCase{
 		name:  "SetupCmdTxUpdateParams",
 		setup: cli.SetupCmdTxUpdateParams,
 		expFlags: []string{
-			cli.FlagAuthority, cli.FlagDefault, cli.FlagSplit,
+			cli.FlagAuthority, cli.FlagDefault, cli.FlagSplit, cli.FlagSettlementHistory,
+			cli.FlagNAVHistory, cli.FlagNAVHistoryMax,
 		},
 		expAnnotations: map[string]map[string][]string{
 			cli.FlagDefault: {required: {"true"}},
 		},
 		expInUse: []string{
-			"--default <amount>", "[--split <splits>]", "[--authority <authority>]",
+			"--default <amount>", "[--split <splits>]", "[--settlement-history <blocks>]",
+			"[--nav-history <blocks>]", "[--nav-history-max <count>]", "[--authority <authority>]",
 			cli.AuthorityDesc, cli.RepeatableDesc,
 			`A <split> has the format "<denom>:<amount>".
 An <amount> is in basis points and is limited to 0 to 10,000 (both inclusive).
 
 Example <split>: nhash:500`,
+			"If --settlement-history is 0, settlement records are not kept.",
+			"If --nav-history is 0, NAV records are not kept. If --nav-history-max is 0, there is no limit.",
 		},
 	})
 }
@@ -2864,7 +5550,12 @@ func TestMakeMsgUpdateParams(t *testing.T) {
 			flags: []string{"--split", "jack,14"},
 			expMsg: &exchange.MsgUpdateParamsRequest{
 				Authority: cli.AuthorityAddr.String(),
-				Params:    exchange.Params{DenomSplits: []exchange.DenomSplit{}},
+				Params: exchange.Params{
+					DenomSplits:             []exchange.DenomSplit{},
+					SettlementHistoryBlocks: exchange.DefaultSettlementHistoryBlocks,
+					NavHistoryBlocks:        exchange.DefaultNAVHistoryBlocks,
+					NavHistoryMaxRecords:    exchange.DefaultNAVHistoryMaxRecords,
+				},
 			},
 			expErr: joinErrs(
 				"invalid denom split \"jack\": expected format <denom>:<amount>",
@@ -2877,7 +5568,12 @@ func TestMakeMsgUpdateParams(t *testing.T) {
 			flags:     []string{"--default", "501"},
 			expMsg: &exchange.MsgUpdateParamsRequest{
 				Authority: cli.AuthorityAddr.String(),
-				Params:    exchange.Params{DefaultSplit: 501},
+				Params: exchange.Params{
+					DefaultSplit:            501,
+					SettlementHistoryBlocks: exchange.DefaultSettlementHistoryBlocks,
+					NavHistoryBlocks:        exchange.DefaultNAVHistoryBlocks,
+					NavHistoryMaxRecords:    exchange.DefaultNAVHistoryMaxRecords,
+				},
 			},
 		},
 		{
@@ -2885,7 +5581,8 @@ func TestMakeMsgUpdateParams(t *testing.T) {
 			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
 			flags: []string{
 				"--split", "banana:99", "--default", "105",
-				"--authority", "Jeff", "--split", "apple:333,plum:555"},
+				"--authority", "Jeff", "--split", "apple:333,plum:555",
+				"--settlement-history", "1000", "--nav-history", "2000", "--nav-history-max", "30"},
 			expMsg: &exchange.MsgUpdateParamsRequest{
 				Authority: "Jeff",
 				Params: exchange.Params{
@@ -2895,6 +5592,9 @@ func TestMakeMsgUpdateParams(t *testing.T) {
 						{Denom: "apple", Split: 333},
 						{Denom: "plum", Split: 555},
 					},
+					SettlementHistoryBlocks: 1000,
+					NavHistoryBlocks:        2000,
+					NavHistoryMaxRecords:    30,
 				},
 			},
 		},
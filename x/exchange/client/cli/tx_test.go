@@ -68,6 +68,7 @@ func (s *CmdTestSuite) TestCmdTxCreateAsk() {
 				"--creation-fee", "10peach",
 				"--from", s.addr2.String(),
 				"--external-id", "my-new-ask-order-E2DF6AFE",
+				"--idempotency-key", "retry-E2DF6AFE",
 			},
 			expectedCode: 0,
 		},
@@ -133,6 +134,55 @@ func (s *CmdTestSuite) TestCmdTxCreateBid() {
 	}
 }
 
+func (s *CmdTestSuite) TestCmdTxCreateOrdersBatch() {
+	tests := []txCmdTestCase{
+		{
+			name:     "cmd error",
+			args:     []string{"create-orders-batch", "--market", "3", "--from", s.addr2.String()},
+			expInErr: []string{"at least one of the flags in the group [ask-order bid-order] is required"},
+		},
+		{
+			name: "insufficient creation fee",
+			args: []string{"orders-batch", "--market", "3",
+				"--ask-order", "1000apple:2000peach", "--ask-order", "500apple:1100peach",
+				"--ask-settlement-fee", "50peach",
+				"--ask-creation-fee", "9peach",
+				"--from", s.addr2.String(),
+			},
+			expInRawLog: []string{"failed to execute message", "invalid request",
+				"ask order [0]: insufficient ask order creation fee: \"9peach\" is less than required amount \"10peach\""},
+			expectedCode: invReqCode,
+		},
+		{
+			name: "okay",
+			preRun: func() ([]string, func(txResponse *sdk.TxResponse)) {
+				expOrder := exchange.NewOrder(1).WithBid(&exchange.BidOrder{
+					MarketId:            5,
+					Buyer:               s.addr2.String(),
+					Assets:              sdk.NewInt64Coin("apple", 1000),
+					Price:               sdk.NewInt64Coin("peach", 2000),
+					BuyerSettlementFees: sdk.NewCoins(sdk.NewInt64Coin("peach", 70)),
+					AllowPartial:        true,
+				})
+				return nil, s.createOrderFollowup(expOrder)
+			},
+			args: []string{"batch-orders", "--market", "5", "--partial",
+				"--bid-order", "1000apple:2000peach",
+				"--bid-settlement-fee", "70peach",
+				"--bid-creation-fee", "10peach",
+				"--from", s.addr2.String(),
+			},
+			expectedCode: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runTxCmdTestCase(tc)
+		})
+	}
+}
+
 func (s *CmdTestSuite) TestCmdTxCommitFunds() {
 	tests := []txCmdTestCase{
 		{
@@ -233,6 +283,174 @@ func (s *CmdTestSuite) TestCmdTxCancelOrder() {
 	}
 }
 
+func (s *CmdTestSuite) TestCmdTxAmendOrder() {
+	tests := []txCmdTestCase{
+		{
+			name:     "no order id",
+			args:     []string{"amend-order", "--from", s.addr2.String(), "--market", "5", "--assets", "1apple", "--price", "2peach"},
+			expInErr: []string{"no <order id> provided"},
+		},
+		{
+			name: "order does not exist",
+			args: []string{"amend", "18446744073709551615", "--from", s.addr2.String(),
+				"--market", "5", "--assets", "1apple", "--price", "2peach"},
+			expInRawLog: []string{"failed to execute message", "invalid request",
+				"order 18446744073709551615 not found"},
+			expectedCode: invReqCode,
+		},
+		{
+			name: "order amended",
+			preRun: func() ([]string, func(txResponse *sdk.TxResponse)) {
+				newOrder := exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 5,
+					Seller:   s.addr2.String(),
+					Assets:   sdk.NewInt64Coin("apple", 100),
+					Price:    sdk.NewInt64Coin("peach", 150),
+				})
+				orderID := s.createOrder(newOrder, nil)
+				orderIDStr := orderIDStringer(orderID)
+
+				expOrder := exchange.NewOrder(orderID).WithAsk(&exchange.AskOrder{
+					MarketId:     5,
+					Seller:       s.addr2.String(),
+					Assets:       sdk.NewInt64Coin("apple", 120),
+					Price:        sdk.NewInt64Coin("peach", 200),
+					AllowPartial: true,
+				})
+				return []string{"--order", orderIDStr}, s.getOrderFollowup(orderIDStr, expOrder)
+			},
+			args: []string{"amend", "--from", s.addr2.String(), "--market", "5",
+				"--assets", "120apple", "--price", "200peach", "--partial"},
+			expectedCode: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runTxCmdTestCase(tc)
+		})
+	}
+}
+
+func (s *CmdTestSuite) TestCmdTxLinkOrders() {
+	tests := []txCmdTestCase{
+		{
+			name:     "no linked order id",
+			args:     []string{"link-orders", "--from", s.addr2.String(), "--market", "5", "--order", "1"},
+			expInErr: []string{"required flag(s) \"linked-order\" not set"},
+		},
+		{
+			name: "order does not exist",
+			args: []string{"link", "--from", s.addr2.String(), "--market", "5",
+				"--order", "18446744073709551615", "--linked-order", "18446744073709551614"},
+			expInRawLog: []string{"failed to execute message", "invalid request",
+				"order 18446744073709551615 not found"},
+			expectedCode: invReqCode,
+		},
+		{
+			name: "orders linked",
+			preRun: func() ([]string, func(txResponse *sdk.TxResponse)) {
+				askOrderID := s.createOrder(exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 5,
+					Seller:   s.addr2.String(),
+					Assets:   sdk.NewInt64Coin("apple", 100),
+					Price:    sdk.NewInt64Coin("peach", 250),
+				}), nil)
+				stopOrderID := s.createOrder(exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 5,
+					Seller:   s.addr2.String(),
+					Assets:   sdk.NewInt64Coin("apple", 100),
+					Price:    sdk.NewInt64Coin("peach", 50),
+				}), nil)
+				askOrderIDStr := orderIDStringer(askOrderID)
+				stopOrderIDStr := orderIDStringer(stopOrderID)
+
+				followup := func(*sdk.TxResponse) {
+					s.runQueryCmdTestCase(queryCmdTestCase{
+						name: "order-link",
+						args: []string{"order-link", stopOrderIDStr, "--output", "json"},
+						expInOut: []string{
+							`"market_id":5`,
+							fmt.Sprintf(`"order_id":"%s"`, stopOrderIDStr),
+							fmt.Sprintf(`"linked_order_id":"%s"`, askOrderIDStr),
+						},
+					})
+				}
+				return []string{"--order", askOrderIDStr, "--linked-order", stopOrderIDStr}, followup
+			},
+			args:         []string{"link-orders", "--from", s.addr2.String(), "--market", "5"},
+			expectedCode: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runTxCmdTestCase(tc)
+		})
+	}
+}
+
+func (s *CmdTestSuite) TestCmdTxSetDeadManSwitch() {
+	tests := []txCmdTestCase{
+		{
+			name:     "no interval",
+			args:     []string{"set-dead-man-switch", "--from", s.addr4.String()},
+			expInErr: []string{"required flag(s) \"interval\" not set"},
+		},
+		{
+			name: "remove when none exists",
+			args: []string{"dead-man-switch", "--from", s.addr1.String(), "--interval", "0"},
+			expInRawLog: []string{"failed to execute message", "invalid request",
+				"no dead-man switch found for " + s.addr1.String()},
+			expectedCode: invReqCode,
+		},
+		{
+			name: "dead-man switch set",
+			preRun: func() ([]string, func(txResponse *sdk.TxResponse)) {
+				followup := func(*sdk.TxResponse) {
+					s.runQueryCmdTestCase(queryCmdTestCase{
+						name:     "dead-man-switch",
+						args:     []string{"dead-man-switch", s.addr4.String(), "--output", "json"},
+						expInOut: []string{`"interval_seconds":"86400"`},
+					})
+				}
+				return nil, followup
+			},
+			args:         []string{"dms", "--from", s.addr4.String(), "--interval", "86400"},
+			expectedCode: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runTxCmdTestCase(tc)
+		})
+	}
+}
+
+func (s *CmdTestSuite) TestCmdTxHeartbeat() {
+	tests := []txCmdTestCase{
+		{
+			name: "no dead-man switch",
+			args: []string{"heartbeat", "--from", s.addr2.String()},
+			expInRawLog: []string{"failed to execute message", "invalid request",
+				"no dead-man switch found for " + s.addr2.String()},
+			expectedCode: invReqCode,
+		},
+		{
+			name:         "deadline reset",
+			args:         []string{"heartbeat", "--from", s.addr9.String()},
+			expectedCode: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runTxCmdTestCase(tc)
+		})
+	}
+}
+
 func (s *CmdTestSuite) TestCmdTxFillBids() {
 	tests := []txCmdTestCase{
 		{
@@ -295,6 +513,7 @@ func (s *CmdTestSuite) TestCmdTxFillBids() {
 				return args, s.assertBalancesFollowup(expBals)
 			},
 			args:         []string{"fill-bids", "--from", s.addr4.String(), "--market", "5", "--assets", "1500apple"},
+			gas:          300_000,
 			expectedCode: 0,
 		},
 	}
@@ -367,6 +586,7 @@ func (s *CmdTestSuite) TestCmdTxFillAsks() {
 			},
 			args: []string{"fill-asks", "--from", s.addr4.String(), "--market", "5",
 				"--price", "2500peach", "--settlement-fee", "75peach", "--creation-fee", "10peach"},
+			gas:          350_000,
 			expectedCode: 0,
 		},
 	}
@@ -447,7 +667,7 @@ func (s *CmdTestSuite) TestCmdTxMarketSettle() {
 				return args, s.assertBalancesFollowup(expBals)
 			},
 			args:         []string{"settle", "--from", s.addr1.String(), "--market", "5"},
-			gas:          300_000,
+			gas:          400_000,
 			expectedCode: 0,
 		},
 	}
@@ -768,30 +988,408 @@ func (s *CmdTestSuite) TestCmdTxMarketWithdraw() {
 			args: []string{"market-withdraw", "--from", s.addr1.String(),
 				"--market", "3", "--to", s.addr2.String(), "--amount", "50avocado"},
 			expInRawLog: []string{"failed to execute message", "invalid request",
-				"failed to withdraw 50avocado from market 3",
-				"spendable balance 0avocado is smaller than 50avocado",
-				"insufficient funds",
+				"failed to withdraw 50avocado from market 3",
+				"spendable balance 0avocado is smaller than 50avocado",
+				"insufficient funds",
+			},
+			expectedCode: invReqCode,
+		},
+		{
+			name: "success",
+			preRun: func() ([]string, func(txResponse *sdk.TxResponse)) {
+				amount := sdk.NewInt64Coin("acorn", 50)
+				market3Addr := exchange.GetMarketAddress(3)
+				s.execBankSend(s.addr8.String(), market3Addr.String(), amount.String())
+
+				preBalsMarket3 := s.queryBankBalances(market3Addr.String())
+				preBalsAddr8 := s.queryBankBalances(s.addr8.String())
+
+				expBals := []banktypes.Balance{
+					{Address: market3Addr.String(), Coins: preBalsMarket3.Sub(amount)},
+					{Address: s.addr8.String(), Coins: preBalsAddr8.Add(amount)},
+				}
+
+				return []string{"--amount", amount.String()}, s.assertBalancesFollowup(expBals)
+			},
+			args:         []string{"withdraw", "--market", "3", "--from", s.addr1.String(), "--to", s.addr8.String()},
+			expectedCode: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runTxCmdTestCase(tc)
+		})
+	}
+}
+
+func (s *CmdTestSuite) TestCmdTxMarketUpdateDetails() {
+	tests := []txCmdTestCase{
+		{
+			name:     "no market",
+			args:     []string{"market-details", "--from", s.addr1.String(), "--name", "Notgonnawork"},
+			expInErr: []string{"required flag(s) \"market\" not set"},
+		},
+		{
+			name: "market does not exist",
+			args: []string{"market-update-details", "--market", "419",
+				"--from", s.addr1.String(), "--name", "No Such Market"},
+			expInRawLog: []string{"failed to execute message", "invalid request",
+				"account " + s.addr1.String() + " does not have permission to update market 419",
+			},
+			expectedCode: invReqCode,
+		},
+		{
+			name: "success",
+			preRun: func() ([]string, func(txResponse *sdk.TxResponse)) {
+				market3 := s.getMarket("3")
+				if len(market3.MarketDetails.IconUri) == 0 {
+					market3.MarketDetails.IconUri = "https://example.com/3/icon"
+				}
+				market3.MarketDetails.IconUri += "?7A9AF177=true"
+
+				args := make([]string, 0, 8)
+				if len(market3.MarketDetails.Name) > 0 {
+					args = append(args, "--name", market3.MarketDetails.Name)
+				}
+				if len(market3.MarketDetails.Description) > 0 {
+					args = append(args, "--description", market3.MarketDetails.Description)
+				}
+				if len(market3.MarketDetails.WebsiteUrl) > 0 {
+					args = append(args, "--url", market3.MarketDetails.WebsiteUrl)
+				}
+				if len(market3.MarketDetails.IconUri) > 0 {
+					args = append(args, "--icon", market3.MarketDetails.IconUri)
+				}
+
+				return args, s.getMarketFollowup("3", market3)
+			},
+			args:         []string{"market-update-details", "--from", s.addr1.String(), "--market", "3"},
+			expectedCode: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runTxCmdTestCase(tc)
+		})
+	}
+}
+
+func (s *CmdTestSuite) TestCmdTxMarketUpdateAcceptingOrders() {
+	tests := []txCmdTestCase{
+		{
+			name:     "no market",
+			args:     []string{"market-accepting-orders", "--from", s.addr1.String(), "--enable"},
+			expInErr: []string{"required flag(s) \"market\" not set"},
+		},
+		{
+			name: "market does not exist",
+			args: []string{"market-update-accepting-orders", "--market", "419",
+				"--from", s.addr4.String(), "--enable"},
+			expInRawLog: []string{"failed to execute message", "invalid request",
+				"account " + s.addr4.String() + " does not have permission to update market 419",
+			},
+			expectedCode: invReqCode,
+		},
+		{
+			name: "disable market",
+			preRun: func() ([]string, func(*sdk.TxResponse)) {
+				market420 := s.getMarket("420")
+				market420.AcceptingOrders = false
+				return nil, s.getMarketFollowup("420", market420)
+			},
+			args:         []string{"update-accepting-orders", "--disable", "--market", "420", "--from", s.addr1.String()},
+			expectedCode: 0,
+		},
+		{
+			name: "enable market",
+			preRun: func() ([]string, func(*sdk.TxResponse)) {
+				market420 := s.getMarket("420")
+				market420.AcceptingOrders = true
+				return nil, s.getMarketFollowup("420", market420)
+			},
+			args:         []string{"update-accepting-orders", "--enable", "--market", "420", "--from", s.addr1.String()},
+			expectedCode: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runTxCmdTestCase(tc)
+		})
+	}
+}
+
+func (s *CmdTestSuite) TestCmdTxMarketUpdateUserSettle() {
+	tests := []txCmdTestCase{
+		{
+			name:     "no market",
+			args:     []string{"market-user-settle", "--from", s.addr1.String(), "--enable"},
+			expInErr: []string{"required flag(s) \"market\" not set"},
+		},
+		{
+			name: "market does not exist",
+			args: []string{"market-update-user-settle", "--market", "419",
+				"--from", s.addr4.String(), "--enable"},
+			expInRawLog: []string{"failed to execute message", "invalid request",
+				"account " + s.addr4.String() + " does not have permission to update market 419",
+			},
+			expectedCode: invReqCode,
+		},
+		{
+			name: "disable user settle",
+			preRun: func() ([]string, func(*sdk.TxResponse)) {
+				market420 := s.getMarket("420")
+				market420.AllowUserSettlement = false
+				return nil, s.getMarketFollowup("420", market420)
+			},
+			args:         []string{"update-user-settle", "--disable", "--market", "420", "--from", s.addr1.String()},
+			expectedCode: 0,
+		},
+		{
+			name: "enable user settle",
+			preRun: func() ([]string, func(*sdk.TxResponse)) {
+				market420 := s.getMarket("420")
+				market420.AllowUserSettlement = true
+				return nil, s.getMarketFollowup("420", market420)
+			},
+			args:         []string{"update-user-settle", "--enable", "--market", "420", "--from", s.addr1.String()},
+			expectedCode: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runTxCmdTestCase(tc)
+		})
+	}
+}
+
+func (s *CmdTestSuite) TestCmdTxMarketUpdateAcceptingCommitments() {
+	tests := []txCmdTestCase{
+		{
+			name:     "no market",
+			args:     []string{"market-accepting-commitments", "--from", s.addr1.String(), "--enable"},
+			expInErr: []string{"required flag(s) \"market\" not set"},
+		},
+		{
+			name: "market does not exist",
+			args: []string{"market-update-accepting-commitments", "--market", "419",
+				"--from", s.addr4.String(), "--enable"},
+			expInRawLog: []string{"failed to execute message", "invalid request",
+				"account " + s.addr4.String() + " does not have permission to update market 419",
+			},
+			expectedCode: invReqCode,
+		},
+		{
+			name: "disable market",
+			preRun: func() ([]string, func(*sdk.TxResponse)) {
+				market420 := s.getMarket("420")
+				market420.AcceptingCommitments = false
+				return nil, s.getMarketFollowup("420", market420)
+			},
+			args:         []string{"update-market-accepting-commitments", "--disable", "--market", "420", "--from", s.addr1.String()},
+			expectedCode: 0,
+		},
+		{
+			name: "enable market",
+			preRun: func() ([]string, func(*sdk.TxResponse)) {
+				market420 := s.getMarket("420")
+				market420.AcceptingCommitments = true
+				return nil, s.getMarketFollowup("420", market420)
+			},
+			args:         []string{"update-accepting-commitments", "--enable", "--market", "420", "--from", s.addr1.String()},
+			expectedCode: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runTxCmdTestCase(tc)
+		})
+	}
+}
+
+func (s *CmdTestSuite) TestCmdTxMarketUpdateContinuousMatching() {
+	tests := []txCmdTestCase{
+		{
+			name:     "no market",
+			args:     []string{"market-continuous-matching", "--from", s.addr1.String(), "--enable"},
+			expInErr: []string{"required flag(s) \"market\" not set"},
+		},
+		{
+			name: "market does not exist",
+			args: []string{"market-update-continuous-matching", "--market", "419",
+				"--from", s.addr4.String(), "--enable"},
+			expInRawLog: []string{"failed to execute message", "invalid request",
+				"account " + s.addr4.String() + " does not have permission to update market 419",
+			},
+			expectedCode: invReqCode,
+		},
+		{
+			name: "enable market",
+			preRun: func() ([]string, func(*sdk.TxResponse)) {
+				market420 := s.getMarket("420")
+				market420.ContinuousMatching = true
+				return nil, s.getMarketFollowup("420", market420)
+			},
+			args:         []string{"update-market-continuous-matching", "--enable", "--market", "420", "--from", s.addr1.String()},
+			expectedCode: 0,
+		},
+		{
+			name: "disable market",
+			preRun: func() ([]string, func(*sdk.TxResponse)) {
+				market420 := s.getMarket("420")
+				market420.ContinuousMatching = false
+				return nil, s.getMarketFollowup("420", market420)
+			},
+			args:         []string{"update-continuous-matching", "--disable", "--market", "420", "--from", s.addr1.String()},
+			expectedCode: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runTxCmdTestCase(tc)
+		})
+	}
+}
+
+func (s *CmdTestSuite) TestCmdTxMarketUpdateBatchAuction() {
+	tests := []txCmdTestCase{
+		{
+			name:     "no interval",
+			args:     []string{"market-batch-auction", "--from", s.addr1.String(), "--market", "420"},
+			expInErr: []string{"required flag(s) \"batch-auction-interval\" not set"},
+		},
+		{
+			name: "market does not exist",
+			args: []string{"market-update-batch-auction", "--market", "419",
+				"--from", s.addr4.String(), "--batch-auction-interval", "1000"},
+			expInRawLog: []string{"failed to execute message", "invalid request",
+				"account " + s.addr4.String() + " does not have permission to update market 419",
+			},
+			expectedCode: invReqCode,
+		},
+		{
+			name: "enable batch auctions",
+			preRun: func() ([]string, func(*sdk.TxResponse)) {
+				market420 := s.getMarket("420")
+				market420.BatchAuctionInterval = 1000
+				return nil, s.getMarketFollowup("420", market420)
+			},
+			args: []string{"update-market-batch-auction", "--batch-auction-interval", "1000",
+				"--market", "420", "--from", s.addr1.String()},
+			expectedCode: 0,
+		},
+		{
+			name: "disable batch auctions",
+			preRun: func() ([]string, func(*sdk.TxResponse)) {
+				market420 := s.getMarket("420")
+				market420.BatchAuctionInterval = 0
+				return nil, s.getMarketFollowup("420", market420)
+			},
+			args: []string{"update-batch-auction", "--batch-auction-interval", "0",
+				"--market", "420", "--from", s.addr1.String()},
+			expectedCode: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runTxCmdTestCase(tc)
+		})
+	}
+}
+
+func (s *CmdTestSuite) TestCmdTxMarketUpdateSelfTradePrevention() {
+	tests := []txCmdTestCase{
+		{
+			name:     "no mode",
+			args:     []string{"market-self-trade-prevention", "--from", s.addr1.String(), "--market", "420"},
+			expInErr: []string{"required flag(s) \"self-trade-prevention\" not set"},
+		},
+		{
+			name: "invalid mode",
+			args: []string{"market-stp", "--from", s.addr1.String(), "--market", "420",
+				"--self-trade-prevention", "cancel-all"},
+			expInErr: []string{"invalid self-trade prevention: \"cancel-all\""},
+		},
+		{
+			name: "market does not exist",
+			args: []string{"market-update-self-trade-prevention", "--market", "419",
+				"--from", s.addr4.String(), "--self-trade-prevention", "cancel-oldest"},
+			expInRawLog: []string{"failed to execute message", "invalid request",
+				"account " + s.addr4.String() + " does not have permission to update market 419",
+			},
+			expectedCode: invReqCode,
+		},
+		{
+			name: "enable self-trade prevention",
+			preRun: func() ([]string, func(*sdk.TxResponse)) {
+				market420 := s.getMarket("420")
+				market420.SelfTradePrevention = exchange.SelfTradePrevention_decrement_both
+				return nil, s.getMarketFollowup("420", market420)
+			},
+			args: []string{"update-market-self-trade-prevention", "--self-trade-prevention", "decrement-both",
+				"--market", "420", "--from", s.addr1.String()},
+			expectedCode: 0,
+		},
+		{
+			name: "disable self-trade prevention",
+			preRun: func() ([]string, func(*sdk.TxResponse)) {
+				market420 := s.getMarket("420")
+				market420.SelfTradePrevention = exchange.SelfTradePrevention_unspecified
+				return nil, s.getMarketFollowup("420", market420)
+			},
+			args: []string{"update-self-trade-prevention", "--self-trade-prevention", "none",
+				"--market", "420", "--from", s.addr1.String()},
+			expectedCode: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runTxCmdTestCase(tc)
+		})
+	}
+}
+
+func (s *CmdTestSuite) TestCmdTxMarketUpdatePublishPrices() {
+	tests := []txCmdTestCase{
+		{
+			name:     "no market",
+			args:     []string{"market-publish-prices", "--from", s.addr1.String(), "--enable"},
+			expInErr: []string{"required flag(s) \"market\" not set"},
+		},
+		{
+			name: "market does not exist",
+			args: []string{"market-update-publish-prices", "--market", "419",
+				"--from", s.addr4.String(), "--enable"},
+			expInRawLog: []string{"failed to execute message", "invalid request",
+				"account " + s.addr4.String() + " does not have permission to update market 419",
 			},
 			expectedCode: invReqCode,
 		},
 		{
-			name: "success",
-			preRun: func() ([]string, func(txResponse *sdk.TxResponse)) {
-				amount := sdk.NewInt64Coin("acorn", 50)
-				market3Addr := exchange.GetMarketAddress(3)
-				s.execBankSend(s.addr8.String(), market3Addr.String(), amount.String())
-
-				preBalsMarket3 := s.queryBankBalances(market3Addr.String())
-				preBalsAddr8 := s.queryBankBalances(s.addr8.String())
-
-				expBals := []banktypes.Balance{
-					{Address: market3Addr.String(), Coins: preBalsMarket3.Sub(amount)},
-					{Address: s.addr8.String(), Coins: preBalsAddr8.Add(amount)},
-				}
-
-				return []string{"--amount", amount.String()}, s.assertBalancesFollowup(expBals)
+			name: "enable market",
+			preRun: func() ([]string, func(*sdk.TxResponse)) {
+				market420 := s.getMarket("420")
+				market420.PublishPrices = true
+				return nil, s.getMarketFollowup("420", market420)
 			},
-			args:         []string{"withdraw", "--market", "3", "--from", s.addr1.String(), "--to", s.addr8.String()},
+			args:         []string{"update-market-publish-prices", "--enable", "--market", "420", "--from", s.addr1.String()},
+			expectedCode: 0,
+		},
+		{
+			name: "disable market",
+			preRun: func() ([]string, func(*sdk.TxResponse)) {
+				market420 := s.getMarket("420")
+				market420.PublishPrices = false
+				return nil, s.getMarketFollowup("420", market420)
+			},
+			args:         []string{"update-publish-prices", "--disable", "--market", "420", "--from", s.addr1.String()},
 			expectedCode: 0,
 		},
 	}
@@ -803,48 +1401,41 @@ func (s *CmdTestSuite) TestCmdTxMarketWithdraw() {
 	}
 }
 
-func (s *CmdTestSuite) TestCmdTxMarketUpdateDetails() {
+func (s *CmdTestSuite) TestCmdTxMarketUpdateExternalIDScope() {
 	tests := []txCmdTestCase{
 		{
-			name:     "no market",
-			args:     []string{"market-details", "--from", s.addr1.String(), "--name", "Notgonnawork"},
-			expInErr: []string{"required flag(s) \"market\" not set"},
+			name:     "no scope",
+			args:     []string{"market-external-id-scope", "--from", s.addr1.String(), "--market", "420"},
+			expInErr: []string{"required flag(s) \"external-id-scope\" not set"},
 		},
 		{
 			name: "market does not exist",
-			args: []string{"market-update-details", "--market", "419",
-				"--from", s.addr1.String(), "--name", "No Such Market"},
+			args: []string{"market-update-external-id-scope", "--market", "419",
+				"--from", s.addr4.String(), "--external-id-scope", "account"},
 			expInRawLog: []string{"failed to execute message", "invalid request",
-				"account " + s.addr1.String() + " does not have permission to update market 419",
+				"account " + s.addr4.String() + " does not have permission to update market 419",
 			},
 			expectedCode: invReqCode,
 		},
 		{
-			name: "success",
-			preRun: func() ([]string, func(txResponse *sdk.TxResponse)) {
-				market3 := s.getMarket("3")
-				if len(market3.MarketDetails.IconUri) == 0 {
-					market3.MarketDetails.IconUri = "https://example.com/3/icon"
-				}
-				market3.MarketDetails.IconUri += "?7A9AF177=true"
-
-				args := make([]string, 0, 8)
-				if len(market3.MarketDetails.Name) > 0 {
-					args = append(args, "--name", market3.MarketDetails.Name)
-				}
-				if len(market3.MarketDetails.Description) > 0 {
-					args = append(args, "--description", market3.MarketDetails.Description)
-				}
-				if len(market3.MarketDetails.WebsiteUrl) > 0 {
-					args = append(args, "--url", market3.MarketDetails.WebsiteUrl)
-				}
-				if len(market3.MarketDetails.IconUri) > 0 {
-					args = append(args, "--icon", market3.MarketDetails.IconUri)
-				}
-
-				return args, s.getMarketFollowup("3", market3)
+			name: "account scope",
+			preRun: func() ([]string, func(*sdk.TxResponse)) {
+				market420 := s.getMarket("420")
+				market420.ExternalIdScope = exchange.ExternalIDScope_account
+				return nil, s.getMarketFollowup("420", market420)
 			},
-			args:         []string{"market-update-details", "--from", s.addr1.String(), "--market", "3"},
+			args:         []string{"update-market-external-id-scope", "--external-id-scope", "account", "--market", "420", "--from", s.addr1.String()},
+			gas:          350_000,
+			expectedCode: 0,
+		},
+		{
+			name: "market scope",
+			preRun: func() ([]string, func(*sdk.TxResponse)) {
+				market420 := s.getMarket("420")
+				market420.ExternalIdScope = exchange.ExternalIDScope_unspecified
+				return nil, s.getMarketFollowup("420", market420)
+			},
+			args:         []string{"update-external-id-scope", "--external-id-scope", "market", "--market", "420", "--from", s.addr1.String()},
 			expectedCode: 0,
 		},
 	}
@@ -856,40 +1447,41 @@ func (s *CmdTestSuite) TestCmdTxMarketUpdateDetails() {
 	}
 }
 
-func (s *CmdTestSuite) TestCmdTxMarketUpdateAcceptingOrders() {
+func (s *CmdTestSuite) TestCmdTxMarketUpdateOrderRateLimit() {
 	tests := []txCmdTestCase{
 		{
-			name:     "no market",
-			args:     []string{"market-accepting-orders", "--from", s.addr1.String(), "--enable"},
-			expInErr: []string{"required flag(s) \"market\" not set"},
+			name:     "no max orders",
+			args:     []string{"market-order-rate-limit", "--from", s.addr1.String(), "--market", "420"},
+			expInErr: []string{"required flag(s) \"max-orders\" not set"},
 		},
 		{
 			name: "market does not exist",
-			args: []string{"market-update-accepting-orders", "--market", "419",
-				"--from", s.addr4.String(), "--enable"},
+			args: []string{"market-update-order-rate-limit", "--market", "419",
+				"--from", s.addr4.String(), "--max-orders", "10"},
 			expInRawLog: []string{"failed to execute message", "invalid request",
 				"account " + s.addr4.String() + " does not have permission to update market 419",
 			},
 			expectedCode: invReqCode,
 		},
 		{
-			name: "disable market",
+			name: "set limit",
 			preRun: func() ([]string, func(*sdk.TxResponse)) {
 				market420 := s.getMarket("420")
-				market420.AcceptingOrders = false
+				market420.OrderRateLimit = &exchange.OrderRateLimit{MaxOrders: 100, WindowBlocks: 10}
 				return nil, s.getMarketFollowup("420", market420)
 			},
-			args:         []string{"update-accepting-orders", "--disable", "--market", "420", "--from", s.addr1.String()},
+			args: []string{"update-market-order-rate-limit", "--max-orders", "100", "--window-blocks", "10",
+				"--market", "420", "--from", s.addr1.String()},
 			expectedCode: 0,
 		},
 		{
-			name: "enable market",
+			name: "remove limit",
 			preRun: func() ([]string, func(*sdk.TxResponse)) {
 				market420 := s.getMarket("420")
-				market420.AcceptingOrders = true
+				market420.OrderRateLimit = nil
 				return nil, s.getMarketFollowup("420", market420)
 			},
-			args:         []string{"update-accepting-orders", "--enable", "--market", "420", "--from", s.addr1.String()},
+			args:         []string{"update-order-rate-limit", "--max-orders", "0", "--market", "420", "--from", s.addr1.String()},
 			expectedCode: 0,
 		},
 	}
@@ -901,40 +1493,42 @@ func (s *CmdTestSuite) TestCmdTxMarketUpdateAcceptingOrders() {
 	}
 }
 
-func (s *CmdTestSuite) TestCmdTxMarketUpdateUserSettle() {
+func (s *CmdTestSuite) TestCmdTxMarketUpdateMaxExposure() {
 	tests := []txCmdTestCase{
 		{
-			name:     "no market",
-			args:     []string{"market-user-settle", "--from", s.addr1.String(), "--enable"},
-			expInErr: []string{"required flag(s) \"market\" not set"},
+			name:     "no max exposure",
+			args:     []string{"market-max-exposure", "--from", s.addr1.String(), "--market", "420"},
+			expInErr: []string{"required flag(s) \"max-exposure\" not set"},
 		},
 		{
 			name: "market does not exist",
-			args: []string{"market-update-user-settle", "--market", "419",
-				"--from", s.addr4.String(), "--enable"},
+			args: []string{"market-update-max-exposure", "--market", "419",
+				"--from", s.addr4.String(), "--max-exposure", "1000peach"},
 			expInRawLog: []string{"failed to execute message", "invalid request",
 				"account " + s.addr4.String() + " does not have permission to update market 419",
 			},
 			expectedCode: invReqCode,
 		},
 		{
-			name: "disable user settle",
+			name: "set max exposure",
 			preRun: func() ([]string, func(*sdk.TxResponse)) {
 				market420 := s.getMarket("420")
-				market420.AllowUserSettlement = false
+				maxExposure := sdk.NewInt64Coin("peach", 1_000_000)
+				market420.MaxExposure = &maxExposure
 				return nil, s.getMarketFollowup("420", market420)
 			},
-			args:         []string{"update-user-settle", "--disable", "--market", "420", "--from", s.addr1.String()},
+			args: []string{"update-market-max-exposure", "--max-exposure", "1000000peach",
+				"--market", "420", "--from", s.addr1.String()},
 			expectedCode: 0,
 		},
 		{
-			name: "enable user settle",
+			name: "remove max exposure",
 			preRun: func() ([]string, func(*sdk.TxResponse)) {
 				market420 := s.getMarket("420")
-				market420.AllowUserSettlement = true
+				market420.MaxExposure = nil
 				return nil, s.getMarketFollowup("420", market420)
 			},
-			args:         []string{"update-user-settle", "--enable", "--market", "420", "--from", s.addr1.String()},
+			args:         []string{"update-max-exposure", "--max-exposure", "0peach", "--market", "420", "--from", s.addr1.String()},
 			expectedCode: 0,
 		},
 	}
@@ -946,40 +1540,40 @@ func (s *CmdTestSuite) TestCmdTxMarketUpdateUserSettle() {
 	}
 }
 
-func (s *CmdTestSuite) TestCmdTxMarketUpdateAcceptingCommitments() {
+func (s *CmdTestSuite) TestCmdTxMarketUpdateFillAlgorithm() {
 	tests := []txCmdTestCase{
 		{
-			name:     "no market",
-			args:     []string{"market-accepting-commitments", "--from", s.addr1.String(), "--enable"},
-			expInErr: []string{"required flag(s) \"market\" not set"},
+			name:     "no fill algorithm",
+			args:     []string{"market-fill-algorithm", "--from", s.addr1.String(), "--market", "420"},
+			expInErr: []string{"required flag(s) \"fill-algorithm\" not set"},
 		},
 		{
 			name: "market does not exist",
-			args: []string{"market-update-accepting-commitments", "--market", "419",
-				"--from", s.addr4.String(), "--enable"},
+			args: []string{"market-update-fill-algorithm", "--market", "419",
+				"--from", s.addr4.String(), "--fill-algorithm", "pro-rata"},
 			expInRawLog: []string{"failed to execute message", "invalid request",
 				"account " + s.addr4.String() + " does not have permission to update market 419",
 			},
 			expectedCode: invReqCode,
 		},
 		{
-			name: "disable market",
+			name: "pro rata",
 			preRun: func() ([]string, func(*sdk.TxResponse)) {
 				market420 := s.getMarket("420")
-				market420.AcceptingCommitments = false
+				market420.FillAlgorithm = exchange.FillAlgorithm_pro_rata
 				return nil, s.getMarketFollowup("420", market420)
 			},
-			args:         []string{"update-market-accepting-commitments", "--disable", "--market", "420", "--from", s.addr1.String()},
+			args:         []string{"update-market-fill-algorithm", "--fill-algorithm", "pro-rata", "--market", "420", "--from", s.addr1.String()},
 			expectedCode: 0,
 		},
 		{
-			name: "enable market",
+			name: "unspecified",
 			preRun: func() ([]string, func(*sdk.TxResponse)) {
 				market420 := s.getMarket("420")
-				market420.AcceptingCommitments = true
+				market420.FillAlgorithm = exchange.FillAlgorithm_unspecified
 				return nil, s.getMarketFollowup("420", market420)
 			},
-			args:         []string{"update-accepting-commitments", "--enable", "--market", "420", "--from", s.addr1.String()},
+			args:         []string{"update-fill-algorithm", "--fill-algorithm", "unspecified", "--market", "420", "--from", s.addr1.String()},
 			expectedCode: 0,
 		},
 	}
@@ -1121,6 +1715,65 @@ func (s *CmdTestSuite) TestCmdTxMarketManagePermissions() {
 	}
 }
 
+func (s *CmdTestSuite) TestCmdTxMarketTransferAuthority() {
+	tests := []txCmdTestCase{
+		{
+			name: "no new authority",
+			args: []string{"market-transfer-authority", "--from", s.addr1.String(), "--market", "3",
+				"--grant", s.addr4.String() + ":all"},
+			expInErr: []string{"required flag(s) \"new-authority\" not set"},
+		},
+		{
+			name: "market does not exist",
+			args: []string{"transfer-authority", "--market", "419", "--from", s.addr4.String(),
+				"--new-authority", s.addr4.String(), "--grant", s.addr4.String() + ":all"},
+			expInRawLog: []string{"failed to execute message", "invalid request",
+				"account " + s.addr4.String() + " does not have permission to transfer authority of market 419",
+			},
+			expectedCode: invReqCode,
+		},
+		{
+			name: "new authority not given permissions permission",
+			args: []string{"transfer-market-authority", "--market", "3", "--from", s.addr1.String(),
+				"--new-authority", s.addr4.String(), "--grant", s.addr4.String() + ":settle"},
+			expInRawLog: []string{"failed to execute message", "invalid request",
+				"new authority " + s.addr4.String() + " must be granted permissions permission",
+			},
+			expectedCode: invReqCode,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runTxCmdTestCase(tc)
+		})
+	}
+}
+
+func (s *CmdTestSuite) TestCmdTxMarketAcceptAuthority() {
+	tests := []txCmdTestCase{
+		{
+			name:     "no market",
+			args:     []string{"market-accept-authority", "--from", s.addr4.String()},
+			expInErr: []string{"required flag(s) \"market\" not set"},
+		},
+		{
+			name: "no pending transfer",
+			args: []string{"accept-authority", "--market", "419", "--from", s.addr4.String()},
+			expInRawLog: []string{"failed to execute message", "invalid request",
+				"market 419 does not have a pending authority transfer",
+			},
+			expectedCode: invReqCode,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runTxCmdTestCase(tc)
+		})
+	}
+}
+
 func (s *CmdTestSuite) TestCmdTxMarketManageReqAttrs() {
 	tests := []txCmdTestCase{
 		{
@@ -1170,6 +1823,55 @@ func (s *CmdTestSuite) TestCmdTxMarketManageReqAttrs() {
 	}
 }
 
+func (s *CmdTestSuite) TestCmdTxMarketManageAcceptedDenoms() {
+	tests := []txCmdTestCase{
+		{
+			name:     "no market",
+			args:     []string{"market-accepted-denoms", "--from", s.addr1.String(), "--asset-denoms-add", "apple"},
+			expInErr: []string{"required flag(s) \"market\" not set"},
+		},
+		{
+			name: "market does not exist",
+			args: []string{"market-manage-accepted-denoms", "--market", "419",
+				"--from", s.addr4.String(), "--price-denoms-add", "peach"},
+			expInRawLog: []string{"failed to execute message", "invalid request",
+				"account " + s.addr4.String() + " does not have permission to update market 419",
+			},
+			expectedCode: invReqCode,
+		},
+		{
+			name: "accepted denoms added",
+			preRun: func() ([]string, func(*sdk.TxResponse)) {
+				market420 := s.getMarket("420")
+				market420.AcceptedAssetDenoms = []string{"apple", "acorn"}
+				market420.AcceptedPriceDenoms = []string{"peach"}
+				return nil, s.getMarketFollowup("420", market420)
+			},
+			args: []string{"manage-accepted-denoms", "--from", s.addr1.String(), "--market", "420",
+				"--asset-denoms-add", "apple,acorn", "--price-denoms-add", "peach"},
+			expectedCode: 0,
+		},
+		{
+			name: "accepted denoms removed",
+			preRun: func() ([]string, func(*sdk.TxResponse)) {
+				market420 := s.getMarket("420")
+				market420.AcceptedAssetDenoms = []string{}
+				market420.AcceptedPriceDenoms = []string{}
+				return nil, s.getMarketFollowup("420", market420)
+			},
+			args: []string{"manage-market-accepted-denoms", "--from", s.addr1.String(), "--market", "420",
+				"--asset-denoms-remove", "apple,acorn", "--price-denoms-remove", "peach"},
+			expectedCode: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runTxCmdTestCase(tc)
+		})
+	}
+}
+
 func (s *CmdTestSuite) TestCmdTxCreatePayment() {
 	tests := []txCmdTestCase{
 		{
@@ -1765,6 +2467,50 @@ func (s *CmdTestSuite) TestCmdTxGovManageFees() {
 	}
 }
 
+func (s *CmdTestSuite) TestCmdTxGovManageExchangeSplits() {
+	tests := []txCmdTestCase{
+		{
+			name:     "cmd error",
+			args:     []string{"gov-manage-exchange-splits", "--from", s.addr1.String(), "--split", "banana", "--market", "12"},
+			expInErr: []string{"invalid denom split \"banana\": expected format <denom>:<amount>"},
+		},
+		{
+			name: "wrong authority",
+			args: []string{"manage-exchange-splits", "--from", s.addr2.String(), "--authority", s.addr2.String(),
+				"--split", "banana:99", "--market", "12",
+				"--title", "mwahahaha", "--summary", "your laugh is evil",
+			},
+			expInRawLog: []string{"failed to execute message",
+				s.addr2.String(), "expected gov account as only signer for proposal message",
+			},
+			expectedCode: invSigCode,
+		},
+		{
+			name: "prop created",
+			preRun: func() ([]string, func(*sdk.TxResponse)) {
+				expMsg := &exchange.MsgGovManageExchangeSplitsRequest{
+					Authority:            cli.AuthorityAddr.String(),
+					MarketId:             419,
+					SetExchangeSplits:    []exchange.DenomSplit{{Denom: "banana", Split: 99}},
+					RemoveExchangeSplits: []string{"acorn"},
+				}
+				return nil, s.govPropFollowup(expMsg)
+			},
+			args: []string{"update-exchange-splits", "--from", s.addr4.String(), "--market", "419",
+				"--split", "banana:99", "--split-remove", "acorn",
+				"--title", "Update Market 419 Exchange Splits", "--summary", "Update the exchange splits for Market 419",
+			},
+			expectedCode: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runTxCmdTestCase(tc)
+		})
+	}
+}
+
 func (s *CmdTestSuite) TestCmdTxGovCloseMarket() {
 	tests := []txCmdTestCase{
 		{
@@ -1806,6 +2552,48 @@ func (s *CmdTestSuite) TestCmdTxGovCloseMarket() {
 	}
 }
 
+func (s *CmdTestSuite) TestCmdTxGovWindDownMarket() {
+	tests := []txCmdTestCase{
+		{
+			name:     "cmd error",
+			args:     []string{"gov-wind-down-market"},
+			expInErr: []string{"required flag(s) \"market\" not set"},
+		},
+		{
+			name: "wrong authority",
+			args: []string{"wind-down-market", "--market", "419",
+				"--from", s.addr2.String(), "--authority", s.addr2.String(),
+				"--title", "mwahahaha", "--summary", "your laugh is evil",
+			},
+			expInRawLog: []string{"failed to execute message",
+				s.addr2.String(), "expected gov account as only signer for proposal message",
+			},
+			expectedCode: invSigCode,
+		},
+		{
+			name: "prop created",
+			preRun: func() ([]string, func(*sdk.TxResponse)) {
+				expMsg := &exchange.MsgGovWindDownMarketRequest{
+					Authority:    cli.AuthorityAddr.String(),
+					MarketId:     419,
+					DeleteMarket: true,
+				}
+				return nil, s.govPropFollowup(expMsg)
+			},
+			args: []string{"wind-down-market", "--market", "419", "--delete-market", "--from", s.addr2.String(),
+				"--title", "Wind down Market 419", "--summary", "Cancel everything in Market 419 and delete it",
+			},
+			expectedCode: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runTxCmdTestCase(tc)
+		})
+	}
+}
+
 func (s *CmdTestSuite) TestCmdTxUpdateParams() {
 	tests := []txCmdTestCase{
 		{
@@ -1834,13 +2622,16 @@ func (s *CmdTestSuite) TestCmdTxUpdateParams() {
 							{Denom: "apple", Split: 500},
 							{Denom: "acorn", Split: 555},
 						},
+						SettlementHistoryBlocks: 10_000,
+						NavHistoryBlocks:        exchange.DefaultNAVHistoryBlocks,
+						NavHistoryMaxRecords:    50,
 					},
 				}
 				return nil, s.govPropFollowup(expMsg)
 			},
 			args: []string{"params", "--from", s.addr4.String(),
-				"--default", "777", "--split", "apple:500", "--split", "acorn:555",
-				"--title", "Update Params", "--summary", "Change Dem Params",
+				"--default", "777", "--split", "apple:500", "--split", "acorn:555", "--settlement-history", "10000",
+				"--nav-history-max", "50", "--title", "Update Params", "--summary", "Change Dem Params",
 			},
 			expectedCode: 0,
 		},
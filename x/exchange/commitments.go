@@ -14,6 +14,10 @@ import (
 // 100 was chosen because that's what we used for the external ids.
 const MaxEventTagLength = 100
 
+// MaxExpiredCommitmentsPerBlock is the maximum number of expired commitments that are released at the end of a block.
+// Any others are released at the end of a later block.
+const MaxExpiredCommitmentsPerBlock = 1_000
+
 // Validate returns an error if this Commitment is invalid.
 func (c Commitment) Validate() error {
 	if _, err := sdk.AccAddressFromBech32(c.Account); err != nil {
@@ -28,6 +32,40 @@ func (c Commitment) Validate() error {
 		return fmt.Errorf("invalid amount %q: %w", c.Amount, err)
 	}
 
+	return validateExpiration(c.Expiration)
+}
+
+// Validate returns an error if this CommitmentReward is invalid.
+func (r CommitmentReward) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.Account); err != nil {
+		return fmt.Errorf("invalid account %q: %w", r.Account, err)
+	}
+
+	if r.MarketId == 0 {
+		return errors.New("invalid market id: cannot be zero")
+	}
+
+	if err := r.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid amount %q: %w", r.Amount, err)
+	}
+
+	return nil
+}
+
+// Validate returns an error if this CommitmentAccrual is invalid.
+func (a CommitmentAccrual) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(a.Account); err != nil {
+		return fmt.Errorf("invalid account %q: %w", a.Account, err)
+	}
+
+	if a.MarketId == 0 {
+		return errors.New("invalid market id: cannot be zero")
+	}
+
+	if err := a.Accrued.Validate(); err != nil {
+		return fmt.Errorf("invalid accrued amount %q: %w", a.Accrued, err)
+	}
+
 	return nil
 }
 
@@ -109,6 +147,24 @@ func AccountAmountsToBankOutputs(entries ...AccountAmount) []banktypes.Output {
 	return rv
 }
 
+// BankInputsToAccountAmounts converts each banktypes.Input to an AccountAmount entry.
+func BankInputsToAccountAmounts(inputs ...banktypes.Input) []AccountAmount {
+	rv := make([]AccountAmount, len(inputs))
+	for i, input := range inputs {
+		rv[i] = AccountAmount{Account: input.Address, Amount: input.Coins}
+	}
+	return rv
+}
+
+// BankOutputsToAccountAmounts converts each banktypes.Output to an AccountAmount entry.
+func BankOutputsToAccountAmounts(outputs ...banktypes.Output) []AccountAmount {
+	rv := make([]AccountAmount, len(outputs))
+	for i, output := range outputs {
+		rv[i] = AccountAmount{Account: output.Address, Amount: output.Coins}
+	}
+	return rv
+}
+
 // String returns a string representation of this MarketAmount.
 func (m MarketAmount) String() string {
 	return fmt.Sprintf("%d:%q", m.MarketId, m.Amount)
@@ -11,15 +11,19 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -35,6 +39,8 @@ type Commitment struct {
 	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
 	// amount is the funds that have been committed by the account to the market.
 	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// expiration is an optional time after which the committed funds are automatically released back to the account.
+	Expiration *time.Time `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
 }
 
 func (m *Commitment) Reset()         { *m = Commitment{} }
@@ -91,6 +97,13 @@ func (m *Commitment) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *Commitment) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
 // AccountAmount associates an account with a coins amount.
 type AccountAmount struct {
 	// account is the bech32 address string of the account associated with the amount.
@@ -254,11 +267,152 @@ func (m *NetAssetPrice) GetPrice() types.Coin {
 	return types.Coin{}
 }
 
+// CommitmentReward contains information on commitment rewards that an account can claim from a market.
+type CommitmentReward struct {
+	// account is the bech32 address string of the account that can claim the rewards.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// market_id is the numeric identifier of the market that distributed the rewards.
+	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// amount is the rewards that the account can claim.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *CommitmentReward) Reset()         { *m = CommitmentReward{} }
+func (m *CommitmentReward) String() string { return proto.CompactTextString(m) }
+func (*CommitmentReward) ProtoMessage()    {}
+func (*CommitmentReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5607ea444303a1f8, []int{4}
+}
+func (m *CommitmentReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitmentReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitmentReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitmentReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitmentReward.Merge(m, src)
+}
+func (m *CommitmentReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitmentReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitmentReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitmentReward proto.InternalMessageInfo
+
+func (m *CommitmentReward) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *CommitmentReward) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *CommitmentReward) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// CommitmentAccrual contains information on how long an account has had funds committed to a market.
+// It is used to weight each account's share of commitment reward distributions by time.
+type CommitmentAccrual struct {
+	// account is the bech32 address string of the account with the committed funds.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// market_id is the numeric identifier of the market the funds are committed to.
+	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// accrued is, for each committed denom, the sum of the amount committed times the number of seconds it was committed.
+	// A denom's accrual is reset when it is used as the basis of a commitment reward distribution.
+	Accrued github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=accrued,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"accrued"`
+	// last_accrued is the time that the accrued amount was last updated.
+	LastAccrued time.Time `protobuf:"bytes,4,opt,name=last_accrued,json=lastAccrued,proto3,stdtime" json:"last_accrued"`
+}
+
+func (m *CommitmentAccrual) Reset()         { *m = CommitmentAccrual{} }
+func (m *CommitmentAccrual) String() string { return proto.CompactTextString(m) }
+func (*CommitmentAccrual) ProtoMessage()    {}
+func (*CommitmentAccrual) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5607ea444303a1f8, []int{5}
+}
+func (m *CommitmentAccrual) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitmentAccrual) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitmentAccrual.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitmentAccrual) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitmentAccrual.Merge(m, src)
+}
+func (m *CommitmentAccrual) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitmentAccrual) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitmentAccrual.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitmentAccrual proto.InternalMessageInfo
+
+func (m *CommitmentAccrual) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *CommitmentAccrual) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *CommitmentAccrual) GetAccrued() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Accrued
+	}
+	return nil
+}
+
+func (m *CommitmentAccrual) GetLastAccrued() time.Time {
+	if m != nil {
+		return m.LastAccrued
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*Commitment)(nil), "provenance.exchange.v1.Commitment")
 	proto.RegisterType((*AccountAmount)(nil), "provenance.exchange.v1.AccountAmount")
 	proto.RegisterType((*MarketAmount)(nil), "provenance.exchange.v1.MarketAmount")
 	proto.RegisterType((*NetAssetPrice)(nil), "provenance.exchange.v1.NetAssetPrice")
+	proto.RegisterType((*CommitmentReward)(nil), "provenance.exchange.v1.CommitmentReward")
+	proto.RegisterType((*CommitmentAccrual)(nil), "provenance.exchange.v1.CommitmentAccrual")
 }
 
 func init() {
@@ -266,36 +420,43 @@ func init() {
 }
 
 var fileDescriptor_5607ea444303a1f8 = []byte{
-	// 457 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x93, 0xbf, 0x6f, 0x13, 0x31,
-	0x14, 0xc7, 0xcf, 0x6d, 0x09, 0xd4, 0x34, 0x03, 0xa7, 0x0a, 0x25, 0x45, 0xba, 0x44, 0x99, 0x4e,
-	0x95, 0x62, 0xab, 0x45, 0x08, 0x89, 0x2d, 0xa9, 0x84, 0xc4, 0x00, 0xaa, 0x8e, 0x8d, 0x25, 0xf2,
-	0xf9, 0xac, 0xab, 0x55, 0x6c, 0x9f, 0xce, 0x6e, 0xd4, 0xfc, 0x01, 0xec, 0x8c, 0x88, 0x89, 0x11,
-	0x21, 0x86, 0x0e, 0xcc, 0x4c, 0x0c, 0x1d, 0x2b, 0x26, 0x26, 0x40, 0xc9, 0xd0, 0x7f, 0x03, 0xf9,
-	0x47, 0x7b, 0x01, 0x01, 0x62, 0xa2, 0x4b, 0x72, 0xef, 0xf9, 0xfb, 0x9e, 0x3f, 0xdf, 0x27, 0x3f,
-	0x98, 0x56, 0xb5, 0x9a, 0x32, 0x49, 0x24, 0x65, 0x98, 0x1d, 0xd3, 0x03, 0x22, 0x4b, 0x86, 0xa7,
-	0x3b, 0x98, 0x2a, 0x21, 0xb8, 0x11, 0x4c, 0x1a, 0x8d, 0xaa, 0x5a, 0x19, 0x15, 0xdf, 0x6e, 0x94,
-	0xe8, 0x42, 0x89, 0xa6, 0x3b, 0x5b, 0xb7, 0x88, 0xe0, 0x52, 0x61, 0xf7, 0xeb, 0xa5, 0x5b, 0x09,
-	0x55, 0x5a, 0x28, 0x8d, 0x73, 0xa2, 0x6d, 0xb3, 0x9c, 0x19, 0x62, 0x3b, 0x72, 0x19, 0xce, 0xbb,
-	0xfe, 0x7c, 0xe2, 0x22, 0xec, 0x83, 0x70, 0xb4, 0x59, 0xaa, 0x52, 0xf9, 0xbc, 0xfd, 0xf2, 0xd9,
-	0xc1, 0x47, 0x00, 0xe1, 0xde, 0x25, 0x51, 0xdc, 0x81, 0xd7, 0x09, 0xa5, 0xea, 0x48, 0x9a, 0x0e,
-	0xe8, 0x83, 0x74, 0x3d, 0xbb, 0x08, 0xe3, 0x3b, 0x70, 0x5d, 0x90, 0xfa, 0x90, 0x99, 0x09, 0x2f,
-	0x3a, 0x2b, 0x7d, 0x90, 0xb6, 0xb3, 0x1b, 0x3e, 0xf1, 0xa8, 0x88, 0x67, 0xb0, 0x45, 0x84, 0xab,
-	0x5a, 0xed, 0xaf, 0xa6, 0x37, 0x77, 0xbb, 0x28, 0x5c, 0x6d, 0x39, 0x51, 0xe0, 0x44, 0x7b, 0x8a,
-	0xcb, 0xf1, 0xc3, 0xd3, 0xaf, 0xbd, 0xe8, 0xdd, 0xb7, 0x5e, 0x5a, 0x72, 0x73, 0x70, 0x94, 0x23,
-	0xaa, 0x44, 0xe0, 0x0c, 0x7f, 0x43, 0x5d, 0x1c, 0x62, 0x33, 0xab, 0x98, 0x76, 0x05, 0xfa, 0xf5,
-	0xf9, 0xc9, 0xf6, 0xc6, 0x73, 0x56, 0x12, 0x3a, 0x9b, 0x58, 0xa7, 0xfa, 0xed, 0xf9, 0xc9, 0x36,
-	0xc8, 0xc2, 0x85, 0x83, 0x4f, 0x00, 0xb6, 0x47, 0x9e, 0x71, 0xe4, 0x32, 0xf1, 0xee, 0x2f, 0x1e,
-	0xc6, 0x9d, 0xcf, 0x1f, 0x86, 0x9b, 0x01, 0x68, 0x54, 0x14, 0x35, 0xd3, 0xfa, 0xa9, 0xa9, 0xb9,
-	0x2c, 0x1b, 0x77, 0x8d, 0x81, 0x95, 0xff, 0x6c, 0xe0, 0xc1, 0xda, 0xab, 0x37, 0xbd, 0x68, 0xf0,
-	0x1e, 0xc0, 0x8d, 0xc7, 0x6e, 0x9c, 0xc1, 0xc5, 0x4f, 0xf3, 0x06, 0x7f, 0x9c, 0xf7, 0x15, 0xe1,
-	0xbe, 0x00, 0xb0, 0xfd, 0x84, 0x99, 0x91, 0xd6, 0xcc, 0xec, 0xd7, 0x9c, 0xb2, 0xf8, 0x3e, 0x6c,
-	0x11, 0x1b, 0x69, 0x07, 0xfb, 0x57, 0xa4, 0x35, 0x8b, 0x94, 0x05, 0x79, 0x7c, 0x0f, 0x5e, 0xab,
-	0x6c, 0x07, 0xf7, 0xa8, 0xfe, 0xa1, 0xce, 0xab, 0x3d, 0xc7, 0x98, 0x9d, 0xce, 0x13, 0x70, 0x36,
-	0x4f, 0xc0, 0xf7, 0x79, 0x02, 0x5e, 0x2e, 0x92, 0xe8, 0x6c, 0x91, 0x44, 0x5f, 0x16, 0x49, 0x04,
-	0xbb, 0xdc, 0x3d, 0xf1, 0xdf, 0xec, 0xd5, 0x3e, 0x78, 0x86, 0x96, 0x86, 0xd1, 0x88, 0x86, 0x5c,
-	0x2d, 0x45, 0xf8, 0xf8, 0x72, 0x6d, 0xf3, 0x96, 0x5b, 0x96, 0xbb, 0x3f, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x85, 0x93, 0x37, 0x22, 0xd4, 0x03, 0x00, 0x00,
+	// 576 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x7d, 0x69, 0x08, 0xed, 0x25, 0x91, 0xa8, 0x55, 0x21, 0x27, 0x48, 0x76, 0x94, 0xc9,
+	0xaa, 0x94, 0xb3, 0x1a, 0x84, 0x90, 0xd8, 0x9c, 0x22, 0x10, 0x03, 0xa8, 0x32, 0x4c, 0x2c, 0xd1,
+	0xc5, 0x3e, 0xdc, 0x53, 0x63, 0x9f, 0xe5, 0xbb, 0x84, 0x44, 0xac, 0xb0, 0x77, 0x44, 0x4c, 0x8c,
+	0xa8, 0x62, 0xe8, 0xc0, 0x9f, 0xc0, 0x90, 0xb1, 0x62, 0x62, 0x4a, 0x51, 0x32, 0xf4, 0xdf, 0x40,
+	0xb6, 0xcf, 0x75, 0xc4, 0x2f, 0x55, 0x08, 0x95, 0x2e, 0x89, 0xdf, 0xdd, 0xf7, 0xbd, 0xfb, 0x7e,
+	0xde, 0xf9, 0xce, 0xd0, 0x8c, 0x62, 0x36, 0x26, 0x21, 0x0e, 0x5d, 0x62, 0x91, 0x89, 0xbb, 0x8f,
+	0x43, 0x9f, 0x58, 0xe3, 0x1d, 0xcb, 0x65, 0x41, 0x40, 0x45, 0x40, 0x42, 0xc1, 0x51, 0x14, 0x33,
+	0xc1, 0xd4, 0x9b, 0x85, 0x12, 0xe5, 0x4a, 0x34, 0xde, 0x69, 0x6e, 0xe2, 0x80, 0x86, 0xcc, 0x4a,
+	0x7f, 0x33, 0x69, 0x53, 0x77, 0x19, 0x0f, 0x18, 0xb7, 0x06, 0x98, 0x27, 0xc5, 0x06, 0x44, 0xe0,
+	0xa4, 0x22, 0x0d, 0xe5, 0x7c, 0x23, 0x9b, 0xef, 0xa7, 0x91, 0x95, 0x05, 0x72, 0x6a, 0xcb, 0x67,
+	0x3e, 0xcb, 0xc6, 0x93, 0x27, 0x39, 0x6a, 0xf8, 0x8c, 0xf9, 0x43, 0x62, 0xa5, 0xd1, 0x60, 0xf4,
+	0xc2, 0x12, 0x34, 0x20, 0x5c, 0xe0, 0x20, 0xca, 0x04, 0xed, 0xd7, 0x25, 0x08, 0x77, 0xcf, 0x2d,
+	0xab, 0x1a, 0xbc, 0x8e, 0x5d, 0x97, 0x8d, 0x42, 0xa1, 0x81, 0x16, 0x30, 0x37, 0x9c, 0x3c, 0x54,
+	0x6f, 0xc1, 0x8d, 0x00, 0xc7, 0x07, 0x44, 0xf4, 0xa9, 0xa7, 0x95, 0x5a, 0xc0, 0xac, 0x3b, 0xeb,
+	0xd9, 0xc0, 0x23, 0x4f, 0x9d, 0xc2, 0x0a, 0x0e, 0xd2, 0xac, 0xb5, 0xd6, 0x9a, 0x59, 0xed, 0x36,
+	0x90, 0xf4, 0x96, 0x80, 0x20, 0x09, 0x82, 0x76, 0x19, 0x0d, 0x7b, 0x0f, 0x66, 0x73, 0x43, 0x39,
+	0x3a, 0x35, 0x4c, 0x9f, 0x8a, 0xfd, 0xd1, 0x00, 0xb9, 0x2c, 0x90, 0x20, 0xf2, 0xaf, 0xc3, 0xbd,
+	0x03, 0x4b, 0x4c, 0x23, 0xc2, 0xd3, 0x04, 0xfe, 0xee, 0xec, 0x78, 0xbb, 0x36, 0x24, 0x3e, 0x76,
+	0xa7, 0xfd, 0xa4, 0x15, 0xfc, 0xc3, 0xd9, 0xf1, 0x36, 0x70, 0xe4, 0x82, 0xea, 0x7d, 0x08, 0xc9,
+	0x24, 0xa2, 0x31, 0x16, 0x94, 0x85, 0x5a, 0xb9, 0x05, 0xcc, 0x6a, 0xb7, 0x89, 0x32, 0x6c, 0x94,
+	0x63, 0xa3, 0x67, 0x39, 0x76, 0x6f, 0x7d, 0x36, 0x37, 0xc0, 0xe1, 0xa9, 0x01, 0x9c, 0x95, 0xbc,
+	0xf6, 0x67, 0x00, 0xeb, 0x76, 0x46, 0x6a, 0x67, 0x75, 0xbb, 0x3f, 0x74, 0xa2, 0xa7, 0x7d, 0xf9,
+	0xd4, 0xd9, 0x92, 0x58, 0xb6, 0xe7, 0xc5, 0x84, 0xf3, 0xa7, 0x22, 0xa6, 0xa1, 0x5f, 0xf4, 0xa8,
+	0x68, 0x43, 0xe9, 0x92, 0xdb, 0x70, 0xaf, 0xfc, 0xf6, 0xbd, 0xa1, 0xb4, 0x3f, 0x02, 0x58, 0x7b,
+	0x9c, 0x6e, 0x8a, 0x1d, 0xfc, 0xbc, 0x6b, 0xe0, 0xb7, 0xbb, 0xf6, 0x9f, 0xec, 0xbe, 0x01, 0xb0,
+	0xfe, 0x84, 0x08, 0x9b, 0x73, 0x22, 0xf6, 0x62, 0xea, 0x12, 0xf5, 0x2e, 0xac, 0xe0, 0x24, 0xe2,
+	0xa9, 0xd9, 0x3f, 0x5a, 0x2a, 0x27, 0x96, 0x1c, 0x29, 0x57, 0xef, 0xc0, 0x6b, 0x51, 0x52, 0x41,
+	0x2b, 0x5d, 0x2c, 0x2f, 0x53, 0x4b, 0x1f, 0x73, 0x00, 0x6f, 0x14, 0x87, 0xc0, 0x21, 0x2f, 0x71,
+	0xec, 0xfd, 0xd5, 0x0b, 0x70, 0x45, 0x0f, 0x49, 0xfb, 0xa8, 0x04, 0x37, 0x0b, 0x40, 0xdb, 0x75,
+	0xe3, 0x11, 0x1e, 0xfe, 0x7b, 0xc2, 0x57, 0x69, 0xc1, 0x78, 0x44, 0xbc, 0xcb, 0x43, 0xcc, 0x57,
+	0x54, 0x1f, 0xc2, 0xda, 0x10, 0x73, 0xd1, 0xcf, 0x1d, 0x5c, 0xec, 0x2a, 0x50, 0xd2, 0xab, 0xa0,
+	0x9a, 0x64, 0xda, 0x59, 0x62, 0x8f, 0xcc, 0x16, 0x3a, 0x38, 0x59, 0xe8, 0xe0, 0xdb, 0x42, 0x07,
+	0x87, 0x4b, 0x5d, 0x39, 0x59, 0xea, 0xca, 0xd7, 0xa5, 0xae, 0xc0, 0x06, 0x65, 0xe8, 0xd7, 0x97,
+	0xf9, 0x1e, 0x78, 0x8e, 0x56, 0x40, 0x0a, 0x51, 0x87, 0xb2, 0x95, 0xc8, 0x9a, 0x9c, 0x7f, 0x2b,
+	0x06, 0x95, 0xd4, 0xd1, 0xed, 0xef, 0x03, 0x00, 0xc4, 0x80, 0x5c, 0x9c, 0x49, 0x06, 0x00, 0x00,
 }
 
 func (m *Commitment) Marshal() (dAtA []byte, err error) {
@@ -318,6 +479,16 @@ func (m *Commitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Expiration != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintCommitments(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Amount) > 0 {
 		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -476,6 +647,112 @@ func (m *NetAssetPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CommitmentReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitmentReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitmentReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCommitments(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintCommitments(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintCommitments(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitmentAccrual) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitmentAccrual) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitmentAccrual) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastAccrued, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastAccrued):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintCommitments(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Accrued) > 0 {
+		for iNdEx := len(m.Accrued) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accrued[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCommitments(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintCommitments(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintCommitments(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCommitments(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCommitments(v)
 	base := offset
@@ -506,6 +783,10 @@ func (m *Commitment) Size() (n int) {
 			n += 1 + l + sovCommitments(uint64(l))
 		}
 	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovCommitments(uint64(l))
+	}
 	return n
 }
 
@@ -559,6 +840,52 @@ func (m *NetAssetPrice) Size() (n int) {
 	return n
 }
 
+func (m *CommitmentReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovCommitments(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovCommitments(uint64(m.MarketId))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovCommitments(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CommitmentAccrual) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovCommitments(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovCommitments(uint64(m.MarketId))
+	}
+	if len(m.Accrued) > 0 {
+		for _, e := range m.Accrued {
+			l = e.Size()
+			n += 1 + l + sovCommitments(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastAccrued)
+	n += 1 + l + sovCommitments(uint64(l))
+	return n
+}
+
 func sovCommitments(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -679,17 +1006,53 @@ func (m *Commitment) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCommitments(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCommitments
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommitments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthCommitments
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommitments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommitments(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommitments
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -1035,6 +1398,309 @@ func (m *NetAssetPrice) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CommitmentReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommitments
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitmentReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitmentReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommitments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommitments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommitments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommitments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommitments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommitments
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommitments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommitments(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommitments
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitmentAccrual) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommitments
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitmentAccrual: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitmentAccrual: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommitments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommitments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommitments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommitments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accrued", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommitments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommitments
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommitments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accrued = append(m.Accrued, types.Coin{})
+			if err := m.Accrued[len(m.Accrued)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAccrued", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommitments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommitments
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommitments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastAccrued, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommitments(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommitments
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCommitments(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,6 +5,7 @@ import (
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -18,6 +19,9 @@ import (
 )
 
 func TestCommitment_Validate(t *testing.T) {
+	epoch := time.Unix(0, 0).UTC()
+	future := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+
 	tests := []struct {
 		name       string
 		commitment Commitment
@@ -59,6 +63,16 @@ func TestCommitment_Validate(t *testing.T) {
 			},
 			exp: "invalid amount \"-5plum\": coin -5plum amount is not positive",
 		},
+		{
+			name: "expiration at epoch",
+			commitment: Commitment{
+				Account:    sdk.AccAddress("account_____________").String(),
+				MarketId:   1,
+				Amount:     sdk.NewCoins(sdk.NewInt64Coin("cherry", 5000)),
+				Expiration: &epoch,
+			},
+			exp: "invalid expiration 1970-01-01T00:00:00Z: must be after 1970-01-01T00:00:00Z",
+		},
 		{
 			name: "okay",
 			commitment: Commitment{
@@ -68,6 +82,16 @@ func TestCommitment_Validate(t *testing.T) {
 			},
 			exp: "",
 		},
+		{
+			name: "okay with expiration",
+			commitment: Commitment{
+				Account:    sdk.AccAddress("account_____________").String(),
+				MarketId:   1,
+				Amount:     sdk.NewCoins(sdk.NewInt64Coin("cherry", 5000)),
+				Expiration: &future,
+			},
+			exp: "",
+		},
 	}
 
 	for _, tc := range tests {
@@ -82,6 +106,127 @@ func TestCommitment_Validate(t *testing.T) {
 	}
 }
 
+func TestCommitmentReward_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		reward CommitmentReward
+		exp    string
+	}{
+		{
+			name: "bad account",
+			reward: CommitmentReward{
+				Account:  "badaccount",
+				MarketId: 1,
+				Amount:   sdk.NewCoins(sdk.NewInt64Coin("nhash", 1000)),
+			},
+			exp: "invalid account \"badaccount\": decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name: "bad market",
+			reward: CommitmentReward{
+				Account:  sdk.AccAddress("account_____________").String(),
+				MarketId: 0,
+				Amount:   sdk.NewCoins(sdk.NewInt64Coin("nhash", 1000)),
+			},
+			exp: "invalid market id: cannot be zero",
+		},
+		{
+			name: "negative amount",
+			reward: CommitmentReward{
+				Account:  sdk.AccAddress("account_____________").String(),
+				MarketId: 1,
+				Amount:   sdk.Coins{sdk.Coin{Denom: "plum", Amount: sdkmath.NewInt(-5)}},
+			},
+			exp: "invalid amount \"-5plum\": coin -5plum amount is not positive",
+		},
+		{
+			name: "okay",
+			reward: CommitmentReward{
+				Account:  sdk.AccAddress("account_____________").String(),
+				MarketId: 1,
+				Amount:   sdk.NewCoins(sdk.NewInt64Coin("cherry", 5000)),
+			},
+			exp: "",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = tc.reward.Validate()
+			}
+			require.NotPanics(t, testFunc, "reward.Validate()")
+			assertions.AssertErrorValue(t, err, tc.exp, "reward.Validate() result")
+		})
+	}
+}
+
+func TestCommitmentAccrual_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		accrual CommitmentAccrual
+		exp     string
+	}{
+		{
+			name: "bad account",
+			accrual: CommitmentAccrual{
+				Account:  "badaccount",
+				MarketId: 1,
+				Accrued:  sdk.NewCoins(sdk.NewInt64Coin("nhash", 1000)),
+			},
+			exp: "invalid account \"badaccount\": decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name: "bad market",
+			accrual: CommitmentAccrual{
+				Account:  sdk.AccAddress("account_____________").String(),
+				MarketId: 0,
+				Accrued:  sdk.NewCoins(sdk.NewInt64Coin("nhash", 1000)),
+			},
+			exp: "invalid market id: cannot be zero",
+		},
+		{
+			name: "negative accrued",
+			accrual: CommitmentAccrual{
+				Account:  sdk.AccAddress("account_____________").String(),
+				MarketId: 1,
+				Accrued:  sdk.Coins{sdk.Coin{Denom: "plum", Amount: sdkmath.NewInt(-5)}},
+			},
+			exp: "invalid accrued amount \"-5plum\": coin -5plum amount is not positive",
+		},
+		{
+			name: "nothing accrued",
+			accrual: CommitmentAccrual{
+				Account:  sdk.AccAddress("account_____________").String(),
+				MarketId: 1,
+			},
+			exp: "",
+		},
+		{
+			name: "okay",
+			accrual: CommitmentAccrual{
+				Account:     sdk.AccAddress("account_____________").String(),
+				MarketId:    1,
+				Accrued:     sdk.NewCoins(sdk.NewInt64Coin("cherry", 5000)),
+				LastAccrued: time.Unix(1_700_000_000, 0).UTC(),
+			},
+			exp: "",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = tc.accrual.Validate()
+			}
+			require.NotPanics(t, testFunc, "accrual.Validate()")
+			assertions.AssertErrorValue(t, err, tc.exp, "accrual.Validate() result")
+		})
+	}
+}
+
 func TestAccountAmount_String(t *testing.T) {
 	tests := []struct {
 		name string
@@ -566,6 +711,102 @@ func TestAccountAmountsToBankOutputs(t *testing.T) {
 	}
 }
 
+func TestBankInputsToAccountAmounts(t *testing.T) {
+	tests := []struct {
+		name     string
+		inputs   []banktypes.Input
+		expected []AccountAmount
+	}{
+		{
+			name:     "no inputs",
+			inputs:   nil,
+			expected: []AccountAmount{},
+		},
+		{
+			name:     "one input",
+			inputs:   []banktypes.Input{{Address: "someacct", Coins: sdk.NewCoins(sdk.NewInt64Coin("cherry", 5000))}},
+			expected: []AccountAmount{{Account: "someacct", Amount: sdk.NewCoins(sdk.NewInt64Coin("cherry", 5000))}},
+		},
+		{
+			name:     "one input: empty",
+			inputs:   []banktypes.Input{{Address: "", Coins: nil}},
+			expected: []AccountAmount{{Account: "", Amount: nil}},
+		},
+		{
+			name: "three inputs",
+			inputs: []banktypes.Input{
+				{Address: "addr0", Coins: sdk.NewCoins(sdk.NewInt64Coin("cherry", 23))},
+				{Address: "addr1", Coins: sdk.NewCoins(sdk.NewInt64Coin("banana", 99))},
+				{Address: "addr2", Coins: sdk.NewCoins(sdk.NewInt64Coin("apple", 42))},
+			},
+			expected: []AccountAmount{
+				{Account: "addr0", Amount: sdk.NewCoins(sdk.NewInt64Coin("cherry", 23))},
+				{Account: "addr1", Amount: sdk.NewCoins(sdk.NewInt64Coin("banana", 99))},
+				{Account: "addr2", Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 42))},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual []AccountAmount
+			testFunc := func() {
+				actual = BankInputsToAccountAmounts(tc.inputs...)
+			}
+			require.NotPanics(t, testFunc, "BankInputsToAccountAmounts")
+			assertEqualSlice(t, tc.expected, actual, AccountAmount.String, "BankInputsToAccountAmounts")
+		})
+	}
+}
+
+func TestBankOutputsToAccountAmounts(t *testing.T) {
+	tests := []struct {
+		name     string
+		outputs  []banktypes.Output
+		expected []AccountAmount
+	}{
+		{
+			name:     "no outputs",
+			outputs:  nil,
+			expected: []AccountAmount{},
+		},
+		{
+			name:     "one output",
+			outputs:  []banktypes.Output{{Address: "someacct", Coins: sdk.NewCoins(sdk.NewInt64Coin("cherry", 5000))}},
+			expected: []AccountAmount{{Account: "someacct", Amount: sdk.NewCoins(sdk.NewInt64Coin("cherry", 5000))}},
+		},
+		{
+			name:     "one output: empty",
+			outputs:  []banktypes.Output{{Address: "", Coins: nil}},
+			expected: []AccountAmount{{Account: "", Amount: nil}},
+		},
+		{
+			name: "three outputs",
+			outputs: []banktypes.Output{
+				{Address: "addr0", Coins: sdk.NewCoins(sdk.NewInt64Coin("cherry", 23))},
+				{Address: "addr1", Coins: sdk.NewCoins(sdk.NewInt64Coin("banana", 99))},
+				{Address: "addr2", Coins: sdk.NewCoins(sdk.NewInt64Coin("apple", 42))},
+			},
+			expected: []AccountAmount{
+				{Account: "addr0", Amount: sdk.NewCoins(sdk.NewInt64Coin("cherry", 23))},
+				{Account: "addr1", Amount: sdk.NewCoins(sdk.NewInt64Coin("banana", 99))},
+				{Account: "addr2", Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 42))},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual []AccountAmount
+			testFunc := func() {
+				actual = BankOutputsToAccountAmounts(tc.outputs...)
+			}
+			require.NotPanics(t, testFunc, "BankOutputsToAccountAmounts")
+			assertEqualSlice(t, tc.expected, actual, AccountAmount.String, "BankOutputsToAccountAmounts")
+		})
+	}
+}
+
 func TestMarketAmount_String(t *testing.T) {
 	tests := []struct {
 		name string
@@ -0,0 +1,40 @@
+package exchange
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxDeadManSwitchesTriggeredPerBlock is the maximum number of dead-man switches that are triggered at the end of a block.
+const MaxDeadManSwitchesTriggeredPerBlock = 100
+
+// MaxDeadManSwitchCancellationsPerBlock is the maximum number of orders cancelled by dead-man switches at the end of a block.
+const MaxDeadManSwitchCancellationsPerBlock = 1_000
+
+// NewDeadManSwitch creates a new DeadManSwitch with a deadline one interval after the provided time.
+func NewDeadManSwitch(account string, intervalSeconds uint64, now time.Time) *DeadManSwitch {
+	return &DeadManSwitch{
+		Account:         account,
+		IntervalSeconds: intervalSeconds,
+		Deadline:        now.Add(time.Duration(intervalSeconds) * time.Second).UTC(), //nolint:gosec // G115: Overflow just results in a weird time.
+	}
+}
+
+// Validate returns an error if anything in this dead-man switch is invalid.
+func (s DeadManSwitch) Validate() error {
+	var errs []error
+	if _, err := sdk.AccAddressFromBech32(s.Account); err != nil {
+		errs = append(errs, fmt.Errorf("invalid account %q: %w", s.Account, err))
+	}
+	if s.IntervalSeconds == 0 {
+		errs = append(errs, errors.New("invalid interval: cannot be zero"))
+	}
+	if s.Deadline.Unix() <= 0 {
+		errs = append(errs, fmt.Errorf("invalid deadline %s: must be after %s",
+			s.Deadline.UTC().Format(time.RFC3339), time.Unix(0, 0).UTC().Format(time.RFC3339)))
+	}
+	return errors.Join(errs...)
+}
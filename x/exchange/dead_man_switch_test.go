@@ -0,0 +1,95 @@
+package exchange
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+func TestNewDeadManSwitch(t *testing.T) {
+	account := sdk.AccAddress("account_____________").String()
+	now := time.Date(2024, 3, 7, 15, 4, 5, 0, time.FixedZone("other", 3600))
+
+	tests := []struct {
+		name            string
+		intervalSeconds uint64
+		exp             *DeadManSwitch
+	}{
+		{
+			name:            "one second",
+			intervalSeconds: 1,
+			exp:             &DeadManSwitch{Account: account, IntervalSeconds: 1, Deadline: time.Date(2024, 3, 7, 14, 4, 6, 0, time.UTC)},
+		},
+		{
+			name:            "one day",
+			intervalSeconds: 86_400,
+			exp:             &DeadManSwitch{Account: account, IntervalSeconds: 86_400, Deadline: time.Date(2024, 3, 8, 14, 4, 5, 0, time.UTC)},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual *DeadManSwitch
+			testFunc := func() {
+				actual = NewDeadManSwitch(account, tc.intervalSeconds, now)
+			}
+			require.NotPanics(t, testFunc, "NewDeadManSwitch")
+			assert.Equal(t, tc.exp, actual, "NewDeadManSwitch result")
+		})
+	}
+}
+
+func TestDeadManSwitch_Validate(t *testing.T) {
+	account := sdk.AccAddress("account_____________").String()
+	deadline := time.Date(2024, 3, 7, 15, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		dms    DeadManSwitch
+		expErr string
+	}{
+		{
+			name: "okay",
+			dms:  DeadManSwitch{Account: account, IntervalSeconds: 30, Deadline: deadline},
+		},
+		{
+			name:   "bad account",
+			dms:    DeadManSwitch{Account: "bad", IntervalSeconds: 30, Deadline: deadline},
+			expErr: "invalid account \"bad\": decoding bech32 failed: invalid bech32 string length 3",
+		},
+		{
+			name:   "zero interval",
+			dms:    DeadManSwitch{Account: account, IntervalSeconds: 0, Deadline: deadline},
+			expErr: "invalid interval: cannot be zero",
+		},
+		{
+			name:   "deadline at epoch",
+			dms:    DeadManSwitch{Account: account, IntervalSeconds: 30, Deadline: time.Unix(0, 0)},
+			expErr: "invalid deadline 1970-01-01T00:00:00Z: must be after 1970-01-01T00:00:00Z",
+		},
+		{
+			name: "multiple errors",
+			dms:  DeadManSwitch{},
+			expErr: "invalid account \"\": empty address string is not allowed\n" +
+				"invalid interval: cannot be zero\n" +
+				"invalid deadline 0001-01-01T00:00:00Z: must be after 1970-01-01T00:00:00Z",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = tc.dms.Validate()
+			}
+			require.NotPanics(t, testFunc, "Validate")
+			assertions.AssertErrorValue(t, err, tc.expErr, "Validate error")
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package exchange
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/gogoproto/proto"
 )
@@ -23,33 +25,210 @@ func NewEventOrderCancelled(order OrderI, cancelledBy string) *EventOrderCancell
 	}
 }
 
-func NewEventOrderFilled(order OrderI) *EventOrderFilled {
+func NewEventOrderFilled(order OrderI, settlementID uint64) *EventOrderFilled {
 	return &EventOrderFilled{
+		OrderId:      order.GetOrderID(),
+		Assets:       order.GetAssets().String(),
+		Price:        order.GetPrice().String(),
+		Fees:         order.GetSettlementFees().String(),
+		MarketId:     order.GetMarketID(),
+		ExternalId:   order.GetExternalID(),
+		SettlementId: settlementID,
+	}
+}
+
+func NewEventOrderPartiallyFilled(order OrderI, settlementID uint64) *EventOrderPartiallyFilled {
+	return &EventOrderPartiallyFilled{
+		OrderId:      order.GetOrderID(),
+		Assets:       order.GetAssets().String(),
+		Price:        order.GetPrice().String(),
+		Fees:         order.GetSettlementFees().String(),
+		MarketId:     order.GetMarketID(),
+		ExternalId:   order.GetExternalID(),
+		SettlementId: settlementID,
+	}
+}
+
+// NewEventOrderPartialFill creates a new EventOrderPartialFill for the partially filled order in the provided settlement.
+// Returns nil if the settlement does not have a partially filled order.
+func NewEventOrderPartialFill(settlement *Settlement) *EventOrderPartialFill {
+	if settlement == nil || settlement.PartialOrderFilled == nil {
+		return nil
+	}
+	return NewEventOrderPartialFillFor(settlement, &PartialOrder{
+		Filled: settlement.PartialOrderFilled,
+		Left:   settlement.PartialOrderLeft,
+	})
+}
+
+// NewEventOrderPartialFillFor creates a new EventOrderPartialFill for the provided partially filled order
+// of a settlement. The participants are all the orders in the settlement, with the partially filled ones last.
+// Returns nil if the partial (or what was filled of it) is nil.
+func NewEventOrderPartialFillFor(settlement *Settlement, partial *PartialOrder) *EventOrderPartialFill {
+	if partial == nil || partial.Filled == nil {
+		return nil
+	}
+
+	filled := partial.Filled
+	rv := &EventOrderPartialFill{
+		OrderId:      filled.GetOrderID(),
+		OrderType:    filled.GetOrderType(),
+		MarketId:     filled.GetMarketID(),
+		ExternalId:   filled.GetExternalID(),
+		Owner:        filled.GetOwner(),
+		FilledAssets: filled.GetAssets().String(),
+		FilledPrice:  filled.GetPrice().String(),
+		FilledFees:   filled.GetSettlementFees().String(),
+	}
+	if partial.Left != nil {
+		rv.RemainingAssets = partial.Left.GetAssets().String()
+		rv.RemainingPrice = partial.Left.GetPrice().String()
+		rv.RemainingFees = partial.Left.GetSettlementFees().String()
+	}
+
+	var fullyFilled []*FilledOrder
+	partials := []*PartialOrder{partial}
+	if settlement != nil {
+		fullyFilled = settlement.FullyFilledOrders
+		partials = settlement.GetPartialOrders()
+	}
+	rv.Participants = make([]*FillParticipant, 0, len(fullyFilled)+len(partials))
+	for _, order := range fullyFilled {
+		rv.Participants = append(rv.Participants, NewFillParticipant(order, false))
+	}
+	for _, other := range partials {
+		rv.Participants = append(rv.Participants, NewFillParticipant(other.Filled, true))
+	}
+	return rv
+}
+
+// NewFillParticipant creates a new FillParticipant describing what the provided order filled and paid.
+func NewFillParticipant(order *FilledOrder, partial bool) *FillParticipant {
+	return &FillParticipant{
+		OrderId:   order.GetOrderID(),
+		OrderType: order.GetOrderType(),
+		Owner:     order.GetOwner(),
+		Assets:    order.GetAssets().String(),
+		Price:     order.GetPrice().String(),
+		Fees:      order.GetSettlementFees().String(),
+		Partial:   partial,
+	}
+}
+
+func NewEventOrderExternalIDUpdated(order OrderI) *EventOrderExternalIDUpdated {
+	return &EventOrderExternalIDUpdated{
 		OrderId:    order.GetOrderID(),
-		Assets:     order.GetAssets().String(),
-		Price:      order.GetPrice().String(),
-		Fees:       order.GetSettlementFees().String(),
 		MarketId:   order.GetMarketID(),
 		ExternalId: order.GetExternalID(),
 	}
 }
 
-func NewEventOrderPartiallyFilled(order OrderI) *EventOrderPartiallyFilled {
-	return &EventOrderPartiallyFilled{
+func NewEventOrderExpired(order OrderI) *EventOrderExpired {
+	return &EventOrderExpired{
+		OrderId:    order.GetOrderID(),
+		MarketId:   order.GetMarketID(),
+		ExternalId: order.GetExternalID(),
+	}
+}
+
+func NewEventOrderAmended(order OrderI) *EventOrderAmended {
+	return &EventOrderAmended{
 		OrderId:    order.GetOrderID(),
 		Assets:     order.GetAssets().String(),
 		Price:      order.GetPrice().String(),
-		Fees:       order.GetSettlementFees().String(),
 		MarketId:   order.GetMarketID(),
 		ExternalId: order.GetExternalID(),
 	}
 }
 
-func NewEventOrderExternalIDUpdated(order OrderI) *EventOrderExternalIDUpdated {
-	return &EventOrderExternalIDUpdated{
+func NewEventOrdersLinked(link *OrderLink) *EventOrdersLinked {
+	return &EventOrdersLinked{
+		MarketId:      link.MarketId,
+		OrderId:       link.OrderId,
+		LinkedOrderId: link.LinkedOrderId,
+	}
+}
+
+func NewEventLinkedOrderCancelled(order OrderI, linkedOrderID uint64) *EventLinkedOrderCancelled {
+	return &EventLinkedOrderCancelled{
+		OrderId:       order.GetOrderID(),
+		LinkedOrderId: linkedOrderID,
+		MarketId:      order.GetMarketID(),
+		ExternalId:    order.GetExternalID(),
+	}
+}
+
+func NewEventDeadManSwitchSet(dms *DeadManSwitch) *EventDeadManSwitchSet {
+	return &EventDeadManSwitchSet{
+		Account:         dms.Account,
+		IntervalSeconds: dms.IntervalSeconds,
+		Deadline:        dms.Deadline.UTC().Format(time.RFC3339),
+	}
+}
+
+func NewEventDeadManSwitchHeartbeat(dms *DeadManSwitch) *EventDeadManSwitchHeartbeat {
+	return &EventDeadManSwitchHeartbeat{
+		Account:  dms.Account,
+		Deadline: dms.Deadline.UTC().Format(time.RFC3339),
+	}
+}
+
+func NewEventDeadManSwitchRemoved(account string) *EventDeadManSwitchRemoved {
+	return &EventDeadManSwitchRemoved{Account: account}
+}
+
+func NewEventDeadManSwitchTriggered(account string, ordersCancelled uint32) *EventDeadManSwitchTriggered {
+	return &EventDeadManSwitchTriggered{
+		Account:         account,
+		OrdersCancelled: ordersCancelled,
+	}
+}
+
+func NewEventMarketOrdersBulkCancelled(marketID uint32, cancelledBy string, count uint32, hasMore bool) *EventMarketOrdersBulkCancelled {
+	return &EventMarketOrdersBulkCancelled{
+		MarketId:    marketID,
+		CancelledBy: cancelledBy,
+		Count:       count,
+		HasMore:     hasMore,
+	}
+}
+
+func NewEventSelfTradePrevented(order OrderI, opposingOrderID uint64, assets sdk.Coin) *EventSelfTradePrevented {
+	return &EventSelfTradePrevented{
+		OrderId:         order.GetOrderID(),
+		OpposingOrderId: opposingOrderID,
+		MarketId:        order.GetMarketID(),
+		ExternalId:      order.GetExternalID(),
+		Assets:          assets.String(),
+	}
+}
+
+func NewEventTriggerOrderCreated(triggerOrder *TriggerOrder) *EventTriggerOrderCreated {
+	return &EventTriggerOrderCreated{
+		OrderId:      triggerOrder.Order.GetOrderID(),
+		OrderType:    triggerOrder.Order.GetOrderType(),
+		MarketId:     triggerOrder.Order.GetMarketID(),
+		ExternalId:   triggerOrder.Order.GetExternalID(),
+		TriggerPrice: triggerOrder.TriggerPrice.String(),
+	}
+}
+
+func NewEventTriggerOrderActivated(order OrderI, price sdk.Coin) *EventTriggerOrderActivated {
+	return &EventTriggerOrderActivated{
 		OrderId:    order.GetOrderID(),
+		OrderType:  order.GetOrderType(),
 		MarketId:   order.GetMarketID(),
 		ExternalId: order.GetExternalID(),
+		Price:      price.String(),
+	}
+}
+
+func NewEventBatchAuctionSettled(marketID uint32, assets, price sdk.Coin, clearingPrice sdk.DecCoin) *EventBatchAuctionSettled {
+	return &EventBatchAuctionSettled{
+		MarketId:      marketID,
+		Assets:        assets.String(),
+		Price:         price.String(),
+		ClearingPrice: clearingPrice.String(),
 	}
 }
 
@@ -71,6 +250,58 @@ func NewEventCommitmentReleased(account string, marketID uint32, amount sdk.Coin
 	}
 }
 
+func NewEventCommitmentExpired(account string, marketID uint32, amount sdk.Coins) *EventCommitmentExpired {
+	return &EventCommitmentExpired{
+		Account:  account,
+		MarketId: marketID,
+		Amount:   amount.String(),
+	}
+}
+
+func NewEventCommitmentRewardsFunded(marketID uint32, funder string, amount sdk.Coins) *EventCommitmentRewardsFunded {
+	return &EventCommitmentRewardsFunded{
+		MarketId: marketID,
+		Funder:   funder,
+		Amount:   amount.String(),
+	}
+}
+
+func NewEventCommitmentRewardsDistributed(marketID uint32, amount sdk.Coins, basisDenom string, distributedBy string) *EventCommitmentRewardsDistributed {
+	return &EventCommitmentRewardsDistributed{
+		MarketId:      marketID,
+		Amount:        amount.String(),
+		BasisDenom:    basisDenom,
+		DistributedBy: distributedBy,
+	}
+}
+
+func NewEventCommitmentRewardsClaimed(account string, marketID uint32, amount sdk.Coins) *EventCommitmentRewardsClaimed {
+	return &EventCommitmentRewardsClaimed{
+		Account:  account,
+		MarketId: marketID,
+		Amount:   amount.String(),
+	}
+}
+
+func NewEventMarketWoundDown(marketID uint32, ordersCancelled, commitmentsReleased uint32, marketDeleted bool, releases []*WindDownRelease) *EventMarketWoundDown {
+	return &EventMarketWoundDown{
+		MarketId:            marketID,
+		OrdersCancelled:     ordersCancelled,
+		CommitmentsReleased: commitmentsReleased,
+		MarketDeleted:       marketDeleted,
+		Releases:            releases,
+	}
+}
+
+// NewWindDownRelease creates a new WindDownRelease describing the funds released for an account during a market wind down.
+func NewWindDownRelease(account string, orderFunds, commitmentFunds sdk.Coins) *WindDownRelease {
+	return &WindDownRelease{
+		Account:         account,
+		OrderFunds:      orderFunds.String(),
+		CommitmentFunds: commitmentFunds.String(),
+	}
+}
+
 func NewEventMarketWithdraw(marketID uint32, amount sdk.Coins, destination sdk.AccAddress, withdrawnBy string) *EventMarketWithdraw {
 	return &EventMarketWithdraw{
 		MarketId:    marketID,
@@ -156,6 +387,94 @@ func NewEventMarketCommitmentsDisabled(marketID uint32, updatedBy string) *Event
 	}
 }
 
+// NewEventMarketContinuousMatchingUpdated returns a new EventMarketContinuousMatchingEnabled if isEnabled == true,
+// or a new EventMarketContinuousMatchingDisabled if isEnabled == false.
+func NewEventMarketContinuousMatchingUpdated(marketID uint32, updatedBy string, isEnabled bool) proto.Message {
+	if isEnabled {
+		return NewEventMarketContinuousMatchingEnabled(marketID, updatedBy)
+	}
+	return NewEventMarketContinuousMatchingDisabled(marketID, updatedBy)
+}
+
+func NewEventMarketContinuousMatchingEnabled(marketID uint32, updatedBy string) *EventMarketContinuousMatchingEnabled {
+	return &EventMarketContinuousMatchingEnabled{
+		MarketId:  marketID,
+		UpdatedBy: updatedBy,
+	}
+}
+
+func NewEventMarketContinuousMatchingDisabled(marketID uint32, updatedBy string) *EventMarketContinuousMatchingDisabled {
+	return &EventMarketContinuousMatchingDisabled{
+		MarketId:  marketID,
+		UpdatedBy: updatedBy,
+	}
+}
+
+func NewEventMarketBatchAuctionUpdated(marketID uint32, updatedBy string) *EventMarketBatchAuctionUpdated {
+	return &EventMarketBatchAuctionUpdated{
+		MarketId:  marketID,
+		UpdatedBy: updatedBy,
+	}
+}
+
+func NewEventMarketSelfTradePreventionUpdated(marketID uint32, updatedBy string) *EventMarketSelfTradePreventionUpdated {
+	return &EventMarketSelfTradePreventionUpdated{
+		MarketId:  marketID,
+		UpdatedBy: updatedBy,
+	}
+}
+
+// NewEventMarketPublishPricesUpdated returns a new EventMarketPublishPricesEnabled if isEnabled == true,
+// or a new EventMarketPublishPricesDisabled if isEnabled == false.
+func NewEventMarketPublishPricesUpdated(marketID uint32, updatedBy string, isEnabled bool) proto.Message {
+	if isEnabled {
+		return NewEventMarketPublishPricesEnabled(marketID, updatedBy)
+	}
+	return NewEventMarketPublishPricesDisabled(marketID, updatedBy)
+}
+
+func NewEventMarketPublishPricesEnabled(marketID uint32, updatedBy string) *EventMarketPublishPricesEnabled {
+	return &EventMarketPublishPricesEnabled{
+		MarketId:  marketID,
+		UpdatedBy: updatedBy,
+	}
+}
+
+func NewEventMarketPublishPricesDisabled(marketID uint32, updatedBy string) *EventMarketPublishPricesDisabled {
+	return &EventMarketPublishPricesDisabled{
+		MarketId:  marketID,
+		UpdatedBy: updatedBy,
+	}
+}
+
+func NewEventMarketExternalIDScopeUpdated(marketID uint32, updatedBy string) *EventMarketExternalIDScopeUpdated {
+	return &EventMarketExternalIDScopeUpdated{
+		MarketId:  marketID,
+		UpdatedBy: updatedBy,
+	}
+}
+
+func NewEventMarketOrderRateLimitUpdated(marketID uint32, updatedBy string) *EventMarketOrderRateLimitUpdated {
+	return &EventMarketOrderRateLimitUpdated{
+		MarketId:  marketID,
+		UpdatedBy: updatedBy,
+	}
+}
+
+func NewEventMarketMaxExposureUpdated(marketID uint32, updatedBy string) *EventMarketMaxExposureUpdated {
+	return &EventMarketMaxExposureUpdated{
+		MarketId:  marketID,
+		UpdatedBy: updatedBy,
+	}
+}
+
+func NewEventMarketFillAlgorithmUpdated(marketID uint32, updatedBy string) *EventMarketFillAlgorithmUpdated {
+	return &EventMarketFillAlgorithmUpdated{
+		MarketId:  marketID,
+		UpdatedBy: updatedBy,
+	}
+}
+
 func NewEventMarketIntermediaryDenomUpdated(marketID uint32, updatedBy string) *EventMarketIntermediaryDenomUpdated {
 	return &EventMarketIntermediaryDenomUpdated{
 		MarketId:  marketID,
@@ -170,6 +489,29 @@ func NewEventMarketPermissionsUpdated(marketID uint32, updatedBy string) *EventM
 	}
 }
 
+func NewEventMarketAuthorityTransferProposed(transfer *MarketAuthorityTransfer) *EventMarketAuthorityTransferProposed {
+	return &EventMarketAuthorityTransferProposed{
+		MarketId:     transfer.MarketId,
+		ProposedBy:   transfer.ProposedBy,
+		NewAuthority: transfer.NewAuthority,
+	}
+}
+
+func NewEventMarketAuthorityTransferred(transfer *MarketAuthorityTransfer) *EventMarketAuthorityTransferred {
+	return &EventMarketAuthorityTransferred{
+		MarketId:     transfer.MarketId,
+		ProposedBy:   transfer.ProposedBy,
+		NewAuthority: transfer.NewAuthority,
+	}
+}
+
+func NewEventMarketAccessGrantExpired(marketID uint32, addr sdk.AccAddress) *EventMarketAccessGrantExpired {
+	return &EventMarketAccessGrantExpired{
+		MarketId: marketID,
+		Address:  addr.String(),
+	}
+}
+
 func NewEventMarketReqAttrUpdated(marketID uint32, updatedBy string) *EventMarketReqAttrUpdated {
 	return &EventMarketReqAttrUpdated{
 		MarketId:  marketID,
@@ -177,6 +519,13 @@ func NewEventMarketReqAttrUpdated(marketID uint32, updatedBy string) *EventMarke
 	}
 }
 
+func NewEventMarketAcceptedDenomsUpdated(marketID uint32, updatedBy string) *EventMarketAcceptedDenomsUpdated {
+	return &EventMarketAcceptedDenomsUpdated{
+		MarketId:  marketID,
+		UpdatedBy: updatedBy,
+	}
+}
+
 func NewEventMarketCreated(marketID uint32) *EventMarketCreated {
 	return &EventMarketCreated{
 		MarketId: marketID,
@@ -189,6 +538,40 @@ func NewEventMarketFeesUpdated(marketID uint32) *EventMarketFeesUpdated {
 	}
 }
 
+func NewEventMarketExchangeSplitsUpdated(marketID uint32) *EventMarketExchangeSplitsUpdated {
+	return &EventMarketExchangeSplitsUpdated{
+		MarketId: marketID,
+	}
+}
+
+func NewEventMarketFeesScheduled(marketID uint32, effectiveHeight int64, effectiveTime *time.Time) *EventMarketFeesScheduled {
+	rv := &EventMarketFeesScheduled{
+		MarketId:        marketID,
+		EffectiveHeight: effectiveHeight,
+	}
+	if effectiveTime != nil {
+		rv.EffectiveTime = effectiveTime.UTC().Format(time.RFC3339)
+	}
+	return rv
+}
+
+func NewEventRebatePaid(marketID uint32, recipient string, amount sdk.Coins) *EventRebatePaid {
+	return &EventRebatePaid{
+		MarketId:  marketID,
+		Recipient: recipient,
+		Amount:    amount.String(),
+	}
+}
+
+func NewEventReferralPaid(order OrderI, amount sdk.Coins) *EventReferralPaid {
+	return &EventReferralPaid{
+		MarketId: order.GetMarketID(),
+		OrderId:  order.GetOrderID(),
+		Referrer: order.GetReferrer(),
+		Amount:   amount.String(),
+	}
+}
+
 func NewEventParamsUpdated() *EventParamsUpdated {
 	return &EventParamsUpdated{}
 }
@@ -257,3 +640,121 @@ func NewEventsPaymentsCancelled(payments []*Payment) []*EventPaymentCancelled {
 	}
 	return rv
 }
+
+func NewEventPaymentExpired(payment *Payment) *EventPaymentExpired {
+	return &EventPaymentExpired{
+		Source:     payment.Source,
+		Target:     payment.Target,
+		ExternalId: payment.ExternalId,
+	}
+}
+
+func NewEventPaymentEscrowed(ep *EscrowedPayment) *EventPaymentEscrowed {
+	return &EventPaymentEscrowed{
+		Source:      ep.Payment.Source,
+		Target:      ep.Payment.Target,
+		ExternalId:  ep.Payment.ExternalId,
+		ReleaseTime: ep.ReleaseTime.UTC().Format(time.RFC3339),
+	}
+}
+
+func NewEventPaymentReleased(payment *Payment) *EventPaymentReleased {
+	return &EventPaymentReleased{
+		Source:       payment.Source,
+		SourceAmount: payment.SourceAmount.String(),
+		Target:       payment.Target,
+		TargetAmount: payment.TargetAmount.String(),
+		ExternalId:   payment.ExternalId,
+	}
+}
+
+func NewEventPaymentDisputed(payment *Payment) *EventPaymentDisputed {
+	return &EventPaymentDisputed{
+		Source:     payment.Source,
+		Target:     payment.Target,
+		ExternalId: payment.ExternalId,
+	}
+}
+
+func NewEventRecurringPaymentCreated(rp *RecurringPayment) *EventRecurringPaymentCreated {
+	return &EventRecurringPaymentCreated{
+		Source:     rp.Source,
+		Target:     rp.Target,
+		Amount:     rp.Amount.String(),
+		ExternalId: rp.ExternalId,
+		Transfers:  rp.TransfersRemaining,
+	}
+}
+
+func NewEventRecurringPaymentTransferred(rp *RecurringPayment) *EventRecurringPaymentTransferred {
+	return &EventRecurringPaymentTransferred{
+		Source:             rp.Source,
+		Target:             rp.Target,
+		Amount:             rp.Amount.String(),
+		ExternalId:         rp.ExternalId,
+		TransfersRemaining: rp.TransfersRemaining,
+	}
+}
+
+func NewEventRecurringPaymentFailed(rp *RecurringPayment, err error) *EventRecurringPaymentFailed {
+	rv := &EventRecurringPaymentFailed{
+		Source:     rp.Source,
+		Target:     rp.Target,
+		ExternalId: rp.ExternalId,
+		Failures:   rp.Failures,
+	}
+	if err != nil {
+		rv.Error = err.Error()
+	}
+	return rv
+}
+
+func NewEventRecurringPaymentCancelled(rp *RecurringPayment) *EventRecurringPaymentCancelled {
+	return &EventRecurringPaymentCancelled{
+		Source:             rp.Source,
+		Target:             rp.Target,
+		ExternalId:         rp.ExternalId,
+		TransfersRemaining: rp.TransfersRemaining,
+	}
+}
+
+// NewEventsRecurringPaymentsCancelled creates a recurring-payment-cancelled event for each recurring payment provided.
+func NewEventsRecurringPaymentsCancelled(rps []*RecurringPayment) []*EventRecurringPaymentCancelled {
+	rv := make([]*EventRecurringPaymentCancelled, len(rps))
+	for i, rp := range rps {
+		rv[i] = NewEventRecurringPaymentCancelled(rp)
+	}
+	return rv
+}
+
+func NewEventMultiPartyPaymentCreated(mpp *MultiPartyPayment) *EventMultiPartyPaymentCreated {
+	return &EventMultiPartyPaymentCreated{
+		Creator:    mpp.Creator,
+		ExternalId: mpp.ExternalId,
+		Parties:    mpp.GetPartyAddrs(),
+	}
+}
+
+func NewEventMultiPartyPaymentAccepted(mpp *MultiPartyPayment, party string) *EventMultiPartyPaymentAccepted {
+	return &EventMultiPartyPaymentAccepted{
+		Creator:    mpp.Creator,
+		ExternalId: mpp.ExternalId,
+		Party:      party,
+	}
+}
+
+func NewEventMultiPartyPaymentSettled(mpp *MultiPartyPayment) *EventMultiPartyPaymentSettled {
+	return &EventMultiPartyPaymentSettled{
+		Creator:    mpp.Creator,
+		ExternalId: mpp.ExternalId,
+		Parties:    mpp.GetPartyAddrs(),
+	}
+}
+
+func NewEventMultiPartyPaymentCancelled(mpp *MultiPartyPayment, cancelledBy string) *EventMultiPartyPaymentCancelled {
+	return &EventMultiPartyPaymentCancelled{
+		Creator:     mpp.Creator,
+		ExternalId:  mpp.ExternalId,
+		CancelledBy: cancelledBy,
+	}
+}
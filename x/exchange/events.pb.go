@@ -184,6 +184,9 @@ type EventOrderFilled struct {
 	MarketId uint32 `protobuf:"varint,5,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
 	// external_id is the order's external id.
 	ExternalId string `protobuf:"bytes,6,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// settlement_id is the id of the settlement that filled the order.
+	// It is zero if settlement history is not being kept.
+	SettlementId uint64 `protobuf:"varint,7,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
 }
 
 func (m *EventOrderFilled) Reset()         { *m = EventOrderFilled{} }
@@ -261,6 +264,13 @@ func (m *EventOrderFilled) GetExternalId() string {
 	return ""
 }
 
+func (m *EventOrderFilled) GetSettlementId() uint64 {
+	if m != nil {
+		return m.SettlementId
+	}
+	return 0
+}
+
 // EventOrderPartiallyFilled is an event emitted when an order filled in part and still has more left to fill.
 type EventOrderPartiallyFilled struct {
 	// order_id is the numerical identifier of the order partially filled.
@@ -277,6 +287,9 @@ type EventOrderPartiallyFilled struct {
 	MarketId uint32 `protobuf:"varint,5,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
 	// external_id is the order's external id.
 	ExternalId string `protobuf:"bytes,6,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// settlement_id is the id of the settlement that filled the order.
+	// It is zero if settlement history is not being kept.
+	SettlementId uint64 `protobuf:"varint,7,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
 }
 
 func (m *EventOrderPartiallyFilled) Reset()         { *m = EventOrderPartiallyFilled{} }
@@ -354,28 +367,53 @@ func (m *EventOrderPartiallyFilled) GetExternalId() string {
 	return ""
 }
 
-// EventOrderExternalIDUpdated is an event emitted when an order's external id is updated.
-type EventOrderExternalIDUpdated struct {
+func (m *EventOrderPartiallyFilled) GetSettlementId() uint64 {
+	if m != nil {
+		return m.SettlementId
+	}
+	return 0
+}
+
+// EventOrderPartialFill is an event emitted along with an EventOrderPartiallyFilled to describe the economics of the fill.
+type EventOrderPartialFill struct {
 	// order_id is the numerical identifier of the order partially filled.
 	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// order_type is the type of order, e.g. "ask" or "bid".
+	OrderType string `protobuf:"bytes,2,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
 	// market_id is the numerical identifier of the market.
-	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	// external_id is the order's new external id.
-	ExternalId string `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
-}
-
-func (m *EventOrderExternalIDUpdated) Reset()         { *m = EventOrderExternalIDUpdated{} }
-func (m *EventOrderExternalIDUpdated) String() string { return proto.CompactTextString(m) }
-func (*EventOrderExternalIDUpdated) ProtoMessage()    {}
-func (*EventOrderExternalIDUpdated) Descriptor() ([]byte, []int) {
+	MarketId uint32 `protobuf:"varint,3,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// external_id is the order's external id.
+	ExternalId string `protobuf:"bytes,4,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// owner is the bech32 address string of the order's seller (for ask orders) or buyer (for bid orders).
+	Owner string `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
+	// filled_assets is the coin amount string of assets that were filled and removed from the order.
+	FilledAssets string `protobuf:"bytes,6,opt,name=filled_assets,json=filledAssets,proto3" json:"filled_assets,omitempty"`
+	// filled_price is the coin amount string of the price payed/received for the filled assets.
+	FilledPrice string `protobuf:"bytes,7,opt,name=filled_price,json=filledPrice,proto3" json:"filled_price,omitempty"`
+	// filled_fees is the coins amount string of settlement fees paid for the filled assets.
+	FilledFees string `protobuf:"bytes,8,opt,name=filled_fees,json=filledFees,proto3" json:"filled_fees,omitempty"`
+	// remaining_assets is the coin amount string of assets still in the order.
+	RemainingAssets string `protobuf:"bytes,9,opt,name=remaining_assets,json=remainingAssets,proto3" json:"remaining_assets,omitempty"`
+	// remaining_price is the coin amount string of the price still in the order.
+	RemainingPrice string `protobuf:"bytes,10,opt,name=remaining_price,json=remainingPrice,proto3" json:"remaining_price,omitempty"`
+	// remaining_fees is the coins amount string of settlement fees still in the order.
+	RemainingFees string `protobuf:"bytes,11,opt,name=remaining_fees,json=remainingFees,proto3" json:"remaining_fees,omitempty"`
+	// participants are all of the orders in the settlement (including this one), and what each one filled and paid.
+	Participants []*FillParticipant `protobuf:"bytes,12,rep,name=participants,proto3" json:"participants,omitempty"`
+}
+
+func (m *EventOrderPartialFill) Reset()         { *m = EventOrderPartialFill{} }
+func (m *EventOrderPartialFill) String() string { return proto.CompactTextString(m) }
+func (*EventOrderPartialFill) ProtoMessage()    {}
+func (*EventOrderPartialFill) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c1b69385a348cffa, []int{4}
 }
-func (m *EventOrderExternalIDUpdated) XXX_Unmarshal(b []byte) error {
+func (m *EventOrderPartialFill) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventOrderExternalIDUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventOrderPartialFill) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventOrderExternalIDUpdated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventOrderPartialFill.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -385,136 +423,132 @@ func (m *EventOrderExternalIDUpdated) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *EventOrderExternalIDUpdated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventOrderExternalIDUpdated.Merge(m, src)
+func (m *EventOrderPartialFill) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOrderPartialFill.Merge(m, src)
 }
-func (m *EventOrderExternalIDUpdated) XXX_Size() int {
+func (m *EventOrderPartialFill) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventOrderExternalIDUpdated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventOrderExternalIDUpdated.DiscardUnknown(m)
+func (m *EventOrderPartialFill) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOrderPartialFill.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventOrderExternalIDUpdated proto.InternalMessageInfo
+var xxx_messageInfo_EventOrderPartialFill proto.InternalMessageInfo
 
-func (m *EventOrderExternalIDUpdated) GetOrderId() uint64 {
+func (m *EventOrderPartialFill) GetOrderId() uint64 {
 	if m != nil {
 		return m.OrderId
 	}
 	return 0
 }
 
-func (m *EventOrderExternalIDUpdated) GetMarketId() uint32 {
+func (m *EventOrderPartialFill) GetOrderType() string {
+	if m != nil {
+		return m.OrderType
+	}
+	return ""
+}
+
+func (m *EventOrderPartialFill) GetMarketId() uint32 {
 	if m != nil {
 		return m.MarketId
 	}
 	return 0
 }
 
-func (m *EventOrderExternalIDUpdated) GetExternalId() string {
+func (m *EventOrderPartialFill) GetExternalId() string {
 	if m != nil {
 		return m.ExternalId
 	}
 	return ""
 }
 
-// EventFundsCommitted is an event emitted when funds are committed to a market.
-type EventFundsCommitted struct {
-	// account is the bech32 address string of the account.
-	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	// market_id is the numerical identifier of the market.
-	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	// amount is the coins string of the newly committed funds.
-	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	// tag is the string provided in the message causing this event.
-	Tag string `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
+func (m *EventOrderPartialFill) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-func (m *EventFundsCommitted) Reset()         { *m = EventFundsCommitted{} }
-func (m *EventFundsCommitted) String() string { return proto.CompactTextString(m) }
-func (*EventFundsCommitted) ProtoMessage()    {}
-func (*EventFundsCommitted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{5}
-}
-func (m *EventFundsCommitted) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *EventFundsCommitted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_EventFundsCommitted.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *EventOrderPartialFill) GetFilledAssets() string {
+	if m != nil {
+		return m.FilledAssets
 	}
-}
-func (m *EventFundsCommitted) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventFundsCommitted.Merge(m, src)
-}
-func (m *EventFundsCommitted) XXX_Size() int {
-	return m.Size()
-}
-func (m *EventFundsCommitted) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventFundsCommitted.DiscardUnknown(m)
+	return ""
 }
 
-var xxx_messageInfo_EventFundsCommitted proto.InternalMessageInfo
+func (m *EventOrderPartialFill) GetFilledPrice() string {
+	if m != nil {
+		return m.FilledPrice
+	}
+	return ""
+}
 
-func (m *EventFundsCommitted) GetAccount() string {
+func (m *EventOrderPartialFill) GetFilledFees() string {
 	if m != nil {
-		return m.Account
+		return m.FilledFees
 	}
 	return ""
 }
 
-func (m *EventFundsCommitted) GetMarketId() uint32 {
+func (m *EventOrderPartialFill) GetRemainingAssets() string {
 	if m != nil {
-		return m.MarketId
+		return m.RemainingAssets
 	}
-	return 0
+	return ""
 }
 
-func (m *EventFundsCommitted) GetAmount() string {
+func (m *EventOrderPartialFill) GetRemainingPrice() string {
 	if m != nil {
-		return m.Amount
+		return m.RemainingPrice
 	}
 	return ""
 }
 
-func (m *EventFundsCommitted) GetTag() string {
+func (m *EventOrderPartialFill) GetRemainingFees() string {
 	if m != nil {
-		return m.Tag
+		return m.RemainingFees
 	}
 	return ""
 }
 
-// EventCommitmentReleased is an event emitted when funds are released from their commitment.
-type EventCommitmentReleased struct {
-	// account is the bech32 address string of the account.
-	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	// market_id is the numerical identifier of the market.
-	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	// amount is the coins string of the funds that were released from commitment.
-	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	// tag is the string provided in the message causing this event.
-	Tag string `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
+func (m *EventOrderPartialFill) GetParticipants() []*FillParticipant {
+	if m != nil {
+		return m.Participants
+	}
+	return nil
 }
 
-func (m *EventCommitmentReleased) Reset()         { *m = EventCommitmentReleased{} }
-func (m *EventCommitmentReleased) String() string { return proto.CompactTextString(m) }
-func (*EventCommitmentReleased) ProtoMessage()    {}
-func (*EventCommitmentReleased) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{6}
+// FillParticipant is a summary of what a single order filled in a settlement.
+type FillParticipant struct {
+	// order_id is the numerical identifier of the order.
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// order_type is the type of order, e.g. "ask" or "bid".
+	OrderType string `protobuf:"bytes,2,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
+	// owner is the bech32 address string of the order's seller (for ask orders) or buyer (for bid orders).
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	// assets is the coin amount string of assets bought/sold by this order.
+	Assets string `protobuf:"bytes,4,opt,name=assets,proto3" json:"assets,omitempty"`
+	// price is the coin amount string of the price payed/received by this order.
+	Price string `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
+	// fees is the coins amount string of settlement fees paid by this order.
+	Fees string `protobuf:"bytes,6,opt,name=fees,proto3" json:"fees,omitempty"`
+	// partial is whether this order was only partially filled.
+	Partial bool `protobuf:"varint,7,opt,name=partial,proto3" json:"partial,omitempty"`
+}
+
+func (m *FillParticipant) Reset()         { *m = FillParticipant{} }
+func (m *FillParticipant) String() string { return proto.CompactTextString(m) }
+func (*FillParticipant) ProtoMessage()    {}
+func (*FillParticipant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{5}
 }
-func (m *EventCommitmentReleased) XXX_Unmarshal(b []byte) error {
+func (m *FillParticipant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventCommitmentReleased) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FillParticipant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventCommitmentReleased.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FillParticipant.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -524,70 +558,89 @@ func (m *EventCommitmentReleased) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *EventCommitmentReleased) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventCommitmentReleased.Merge(m, src)
+func (m *FillParticipant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FillParticipant.Merge(m, src)
 }
-func (m *EventCommitmentReleased) XXX_Size() int {
+func (m *FillParticipant) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventCommitmentReleased) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventCommitmentReleased.DiscardUnknown(m)
+func (m *FillParticipant) XXX_DiscardUnknown() {
+	xxx_messageInfo_FillParticipant.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventCommitmentReleased proto.InternalMessageInfo
+var xxx_messageInfo_FillParticipant proto.InternalMessageInfo
 
-func (m *EventCommitmentReleased) GetAccount() string {
+func (m *FillParticipant) GetOrderId() uint64 {
 	if m != nil {
-		return m.Account
+		return m.OrderId
+	}
+	return 0
+}
+
+func (m *FillParticipant) GetOrderType() string {
+	if m != nil {
+		return m.OrderType
 	}
 	return ""
 }
 
-func (m *EventCommitmentReleased) GetMarketId() uint32 {
+func (m *FillParticipant) GetOwner() string {
 	if m != nil {
-		return m.MarketId
+		return m.Owner
 	}
-	return 0
+	return ""
 }
 
-func (m *EventCommitmentReleased) GetAmount() string {
+func (m *FillParticipant) GetAssets() string {
 	if m != nil {
-		return m.Amount
+		return m.Assets
 	}
 	return ""
 }
 
-func (m *EventCommitmentReleased) GetTag() string {
+func (m *FillParticipant) GetPrice() string {
 	if m != nil {
-		return m.Tag
+		return m.Price
 	}
 	return ""
 }
 
-// EventMarketWithdraw is an event emitted when a withdrawal of a market's collected fees is made.
-type EventMarketWithdraw struct {
+func (m *FillParticipant) GetFees() string {
+	if m != nil {
+		return m.Fees
+	}
+	return ""
+}
+
+func (m *FillParticipant) GetPartial() bool {
+	if m != nil {
+		return m.Partial
+	}
+	return false
+}
+
+// EventOrderExternalIDUpdated is an event emitted when an order's external id is updated.
+type EventOrderExternalIDUpdated struct {
+	// order_id is the numerical identifier of the order partially filled.
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 	// market_id is the numerical identifier of the market.
-	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	// amount is the coins amount string of funds withdrawn from the market account.
-	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	// destination is the account that received the funds.
-	Destination string `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
-	// withdrawn_by is the account that requested the withdrawal.
-	WithdrawnBy string `protobuf:"bytes,4,opt,name=withdrawn_by,json=withdrawnBy,proto3" json:"withdrawn_by,omitempty"`
+	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// external_id is the order's new external id.
+	ExternalId string `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
 }
 
-func (m *EventMarketWithdraw) Reset()         { *m = EventMarketWithdraw{} }
-func (m *EventMarketWithdraw) String() string { return proto.CompactTextString(m) }
-func (*EventMarketWithdraw) ProtoMessage()    {}
-func (*EventMarketWithdraw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{7}
+func (m *EventOrderExternalIDUpdated) Reset()         { *m = EventOrderExternalIDUpdated{} }
+func (m *EventOrderExternalIDUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventOrderExternalIDUpdated) ProtoMessage()    {}
+func (*EventOrderExternalIDUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{6}
 }
-func (m *EventMarketWithdraw) XXX_Unmarshal(b []byte) error {
+func (m *EventOrderExternalIDUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarketWithdraw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventOrderExternalIDUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarketWithdraw.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventOrderExternalIDUpdated.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -597,66 +650,61 @@ func (m *EventMarketWithdraw) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventMarketWithdraw) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarketWithdraw.Merge(m, src)
+func (m *EventOrderExternalIDUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOrderExternalIDUpdated.Merge(m, src)
 }
-func (m *EventMarketWithdraw) XXX_Size() int {
+func (m *EventOrderExternalIDUpdated) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarketWithdraw) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarketWithdraw.DiscardUnknown(m)
+func (m *EventOrderExternalIDUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOrderExternalIDUpdated.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarketWithdraw proto.InternalMessageInfo
+var xxx_messageInfo_EventOrderExternalIDUpdated proto.InternalMessageInfo
 
-func (m *EventMarketWithdraw) GetMarketId() uint32 {
+func (m *EventOrderExternalIDUpdated) GetOrderId() uint64 {
 	if m != nil {
-		return m.MarketId
+		return m.OrderId
 	}
 	return 0
 }
 
-func (m *EventMarketWithdraw) GetAmount() string {
-	if m != nil {
-		return m.Amount
-	}
-	return ""
-}
-
-func (m *EventMarketWithdraw) GetDestination() string {
+func (m *EventOrderExternalIDUpdated) GetMarketId() uint32 {
 	if m != nil {
-		return m.Destination
+		return m.MarketId
 	}
-	return ""
+	return 0
 }
 
-func (m *EventMarketWithdraw) GetWithdrawnBy() string {
+func (m *EventOrderExternalIDUpdated) GetExternalId() string {
 	if m != nil {
-		return m.WithdrawnBy
+		return m.ExternalId
 	}
 	return ""
 }
 
-// EventMarketDetailsUpdated is an event emitted when a market's details are updated.
-type EventMarketDetailsUpdated struct {
+// EventOrderExpired is an event emitted when an order is cancelled because its expiration has passed.
+type EventOrderExpired struct {
+	// order_id is the numerical identifier of the order that expired.
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 	// market_id is the numerical identifier of the market.
-	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	// updated_by is the account that updated the details.
-	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// external_id is the order's external id.
+	ExternalId string `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
 }
 
-func (m *EventMarketDetailsUpdated) Reset()         { *m = EventMarketDetailsUpdated{} }
-func (m *EventMarketDetailsUpdated) String() string { return proto.CompactTextString(m) }
-func (*EventMarketDetailsUpdated) ProtoMessage()    {}
-func (*EventMarketDetailsUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{8}
+func (m *EventOrderExpired) Reset()         { *m = EventOrderExpired{} }
+func (m *EventOrderExpired) String() string { return proto.CompactTextString(m) }
+func (*EventOrderExpired) ProtoMessage()    {}
+func (*EventOrderExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{7}
 }
-func (m *EventMarketDetailsUpdated) XXX_Unmarshal(b []byte) error {
+func (m *EventOrderExpired) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarketDetailsUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventOrderExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarketDetailsUpdated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventOrderExpired.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -666,55 +714,65 @@ func (m *EventMarketDetailsUpdated) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *EventMarketDetailsUpdated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarketDetailsUpdated.Merge(m, src)
+func (m *EventOrderExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOrderExpired.Merge(m, src)
 }
-func (m *EventMarketDetailsUpdated) XXX_Size() int {
+func (m *EventOrderExpired) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarketDetailsUpdated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarketDetailsUpdated.DiscardUnknown(m)
+func (m *EventOrderExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOrderExpired.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarketDetailsUpdated proto.InternalMessageInfo
+var xxx_messageInfo_EventOrderExpired proto.InternalMessageInfo
 
-func (m *EventMarketDetailsUpdated) GetMarketId() uint32 {
+func (m *EventOrderExpired) GetOrderId() uint64 {
 	if m != nil {
-		return m.MarketId
+		return m.OrderId
 	}
 	return 0
 }
 
-func (m *EventMarketDetailsUpdated) GetUpdatedBy() string {
+func (m *EventOrderExpired) GetMarketId() uint32 {
 	if m != nil {
-		return m.UpdatedBy
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *EventOrderExpired) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
 	}
 	return ""
 }
 
-// EventMarketEnabled is an event emitted when a market is enabled.
-// Deprecated: This event is no longer used. It is replaced with EventMarketOrdersEnabled.
-//
-// Deprecated: Do not use.
-type EventMarketEnabled struct {
+// EventOrderAmended is an event emitted when an order is amended.
+type EventOrderAmended struct {
+	// order_id is the numerical identifier of the order that was amended.
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// assets is the coin amount string of the order's new assets.
+	Assets string `protobuf:"bytes,2,opt,name=assets,proto3" json:"assets,omitempty"`
+	// price is the coin amount string of the order's new price.
+	Price string `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
 	// market_id is the numerical identifier of the market.
-	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	// updated_by is the account that enabled the market.
-	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	MarketId uint32 `protobuf:"varint,4,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// external_id is the order's external id.
+	ExternalId string `protobuf:"bytes,5,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
 }
 
-func (m *EventMarketEnabled) Reset()         { *m = EventMarketEnabled{} }
-func (m *EventMarketEnabled) String() string { return proto.CompactTextString(m) }
-func (*EventMarketEnabled) ProtoMessage()    {}
-func (*EventMarketEnabled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{9}
+func (m *EventOrderAmended) Reset()         { *m = EventOrderAmended{} }
+func (m *EventOrderAmended) String() string { return proto.CompactTextString(m) }
+func (*EventOrderAmended) ProtoMessage()    {}
+func (*EventOrderAmended) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{8}
 }
-func (m *EventMarketEnabled) XXX_Unmarshal(b []byte) error {
+func (m *EventOrderAmended) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarketEnabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventOrderAmended) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarketEnabled.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventOrderAmended.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -724,55 +782,75 @@ func (m *EventMarketEnabled) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventMarketEnabled) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarketEnabled.Merge(m, src)
+func (m *EventOrderAmended) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOrderAmended.Merge(m, src)
 }
-func (m *EventMarketEnabled) XXX_Size() int {
+func (m *EventOrderAmended) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarketEnabled) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarketEnabled.DiscardUnknown(m)
+func (m *EventOrderAmended) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOrderAmended.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarketEnabled proto.InternalMessageInfo
+var xxx_messageInfo_EventOrderAmended proto.InternalMessageInfo
 
-func (m *EventMarketEnabled) GetMarketId() uint32 {
+func (m *EventOrderAmended) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
+	}
+	return 0
+}
+
+func (m *EventOrderAmended) GetAssets() string {
+	if m != nil {
+		return m.Assets
+	}
+	return ""
+}
+
+func (m *EventOrderAmended) GetPrice() string {
+	if m != nil {
+		return m.Price
+	}
+	return ""
+}
+
+func (m *EventOrderAmended) GetMarketId() uint32 {
 	if m != nil {
 		return m.MarketId
 	}
 	return 0
 }
 
-func (m *EventMarketEnabled) GetUpdatedBy() string {
+func (m *EventOrderAmended) GetExternalId() string {
 	if m != nil {
-		return m.UpdatedBy
+		return m.ExternalId
 	}
 	return ""
 }
 
-// EventMarketDisabled is an event emitted when a market is disabled.
-// Deprecated: This event is no longer used. It is replaced with EventMarketOrdersDisabled.
-//
-// Deprecated: Do not use.
-type EventMarketDisabled struct {
+// EventOrdersLinked is an event emitted when two orders are linked.
+type EventOrdersLinked struct {
 	// market_id is the numerical identifier of the market.
 	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	// updated_by is the account that disabled the market.
-	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	// order_id is the numerical identifier of one of the linked orders.
+	OrderId uint64 `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// linked_order_id is the numerical identifier of the other linked order.
+	LinkedOrderId uint64 `protobuf:"varint,3,opt,name=linked_order_id,json=linkedOrderId,proto3" json:"linked_order_id,omitempty"`
 }
 
-func (m *EventMarketDisabled) Reset()         { *m = EventMarketDisabled{} }
-func (m *EventMarketDisabled) String() string { return proto.CompactTextString(m) }
-func (*EventMarketDisabled) ProtoMessage()    {}
-func (*EventMarketDisabled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{10}
+func (m *EventOrdersLinked) Reset()         { *m = EventOrdersLinked{} }
+func (m *EventOrdersLinked) String() string { return proto.CompactTextString(m) }
+func (*EventOrdersLinked) ProtoMessage()    {}
+func (*EventOrdersLinked) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{9}
 }
-func (m *EventMarketDisabled) XXX_Unmarshal(b []byte) error {
+func (m *EventOrdersLinked) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarketDisabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventOrdersLinked) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarketDisabled.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventOrdersLinked.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -782,52 +860,64 @@ func (m *EventMarketDisabled) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventMarketDisabled) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarketDisabled.Merge(m, src)
+func (m *EventOrdersLinked) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOrdersLinked.Merge(m, src)
 }
-func (m *EventMarketDisabled) XXX_Size() int {
+func (m *EventOrdersLinked) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarketDisabled) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarketDisabled.DiscardUnknown(m)
+func (m *EventOrdersLinked) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOrdersLinked.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarketDisabled proto.InternalMessageInfo
+var xxx_messageInfo_EventOrdersLinked proto.InternalMessageInfo
 
-func (m *EventMarketDisabled) GetMarketId() uint32 {
+func (m *EventOrdersLinked) GetMarketId() uint32 {
 	if m != nil {
 		return m.MarketId
 	}
 	return 0
 }
 
-func (m *EventMarketDisabled) GetUpdatedBy() string {
+func (m *EventOrdersLinked) GetOrderId() uint64 {
 	if m != nil {
-		return m.UpdatedBy
+		return m.OrderId
 	}
-	return ""
+	return 0
 }
 
-// EventMarketOrdersEnabled is an event emitted when a market enables order creation.
-type EventMarketOrdersEnabled struct {
+func (m *EventOrdersLinked) GetLinkedOrderId() uint64 {
+	if m != nil {
+		return m.LinkedOrderId
+	}
+	return 0
+}
+
+// EventLinkedOrderCancelled is an event emitted when an order is cancelled because the order it was linked to was
+// filled, cancelled, or expired.
+type EventLinkedOrderCancelled struct {
+	// order_id is the numerical identifier of the order that was cancelled.
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// linked_order_id is the numerical identifier of the order that caused this one to be cancelled.
+	LinkedOrderId uint64 `protobuf:"varint,2,opt,name=linked_order_id,json=linkedOrderId,proto3" json:"linked_order_id,omitempty"`
 	// market_id is the numerical identifier of the market.
-	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	// updated_by is the account that updated the accepting_orders option.
-	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	MarketId uint32 `protobuf:"varint,3,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// external_id is the cancelled order's external id.
+	ExternalId string `protobuf:"bytes,4,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
 }
 
-func (m *EventMarketOrdersEnabled) Reset()         { *m = EventMarketOrdersEnabled{} }
-func (m *EventMarketOrdersEnabled) String() string { return proto.CompactTextString(m) }
-func (*EventMarketOrdersEnabled) ProtoMessage()    {}
-func (*EventMarketOrdersEnabled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{11}
+func (m *EventLinkedOrderCancelled) Reset()         { *m = EventLinkedOrderCancelled{} }
+func (m *EventLinkedOrderCancelled) String() string { return proto.CompactTextString(m) }
+func (*EventLinkedOrderCancelled) ProtoMessage()    {}
+func (*EventLinkedOrderCancelled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{10}
 }
-func (m *EventMarketOrdersEnabled) XXX_Unmarshal(b []byte) error {
+func (m *EventLinkedOrderCancelled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarketOrdersEnabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventLinkedOrderCancelled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarketOrdersEnabled.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventLinkedOrderCancelled.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -837,52 +927,68 @@ func (m *EventMarketOrdersEnabled) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *EventMarketOrdersEnabled) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarketOrdersEnabled.Merge(m, src)
+func (m *EventLinkedOrderCancelled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventLinkedOrderCancelled.Merge(m, src)
 }
-func (m *EventMarketOrdersEnabled) XXX_Size() int {
+func (m *EventLinkedOrderCancelled) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarketOrdersEnabled) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarketOrdersEnabled.DiscardUnknown(m)
+func (m *EventLinkedOrderCancelled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventLinkedOrderCancelled.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarketOrdersEnabled proto.InternalMessageInfo
+var xxx_messageInfo_EventLinkedOrderCancelled proto.InternalMessageInfo
 
-func (m *EventMarketOrdersEnabled) GetMarketId() uint32 {
+func (m *EventLinkedOrderCancelled) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
+	}
+	return 0
+}
+
+func (m *EventLinkedOrderCancelled) GetLinkedOrderId() uint64 {
+	if m != nil {
+		return m.LinkedOrderId
+	}
+	return 0
+}
+
+func (m *EventLinkedOrderCancelled) GetMarketId() uint32 {
 	if m != nil {
 		return m.MarketId
 	}
 	return 0
 }
 
-func (m *EventMarketOrdersEnabled) GetUpdatedBy() string {
+func (m *EventLinkedOrderCancelled) GetExternalId() string {
 	if m != nil {
-		return m.UpdatedBy
+		return m.ExternalId
 	}
 	return ""
 }
 
-// EventMarketOrdersEnabled is an event emitted when a market disables order creation.
-type EventMarketOrdersDisabled struct {
-	// market_id is the numerical identifier of the market.
-	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	// updated_by is the account that updated the accepting_orders option.
-	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+// EventDeadManSwitchSet is an event emitted when an account registers or updates its dead-man switch.
+type EventDeadManSwitchSet struct {
+	// account is the account that set the dead-man switch.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// interval_seconds is the maximum number of seconds allowed between heartbeats.
+	IntervalSeconds uint64 `protobuf:"varint,2,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	// deadline is the RFC 3339 time at which the account's orders will be cancelled if a heartbeat isn't sent.
+	Deadline string `protobuf:"bytes,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
 }
 
-func (m *EventMarketOrdersDisabled) Reset()         { *m = EventMarketOrdersDisabled{} }
-func (m *EventMarketOrdersDisabled) String() string { return proto.CompactTextString(m) }
-func (*EventMarketOrdersDisabled) ProtoMessage()    {}
-func (*EventMarketOrdersDisabled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{12}
+func (m *EventDeadManSwitchSet) Reset()         { *m = EventDeadManSwitchSet{} }
+func (m *EventDeadManSwitchSet) String() string { return proto.CompactTextString(m) }
+func (*EventDeadManSwitchSet) ProtoMessage()    {}
+func (*EventDeadManSwitchSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{11}
 }
-func (m *EventMarketOrdersDisabled) XXX_Unmarshal(b []byte) error {
+func (m *EventDeadManSwitchSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarketOrdersDisabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventDeadManSwitchSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarketOrdersDisabled.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventDeadManSwitchSet.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -892,52 +998,59 @@ func (m *EventMarketOrdersDisabled) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *EventMarketOrdersDisabled) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarketOrdersDisabled.Merge(m, src)
+func (m *EventDeadManSwitchSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDeadManSwitchSet.Merge(m, src)
 }
-func (m *EventMarketOrdersDisabled) XXX_Size() int {
+func (m *EventDeadManSwitchSet) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarketOrdersDisabled) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarketOrdersDisabled.DiscardUnknown(m)
+func (m *EventDeadManSwitchSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDeadManSwitchSet.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarketOrdersDisabled proto.InternalMessageInfo
+var xxx_messageInfo_EventDeadManSwitchSet proto.InternalMessageInfo
 
-func (m *EventMarketOrdersDisabled) GetMarketId() uint32 {
+func (m *EventDeadManSwitchSet) GetAccount() string {
 	if m != nil {
-		return m.MarketId
+		return m.Account
+	}
+	return ""
+}
+
+func (m *EventDeadManSwitchSet) GetIntervalSeconds() uint64 {
+	if m != nil {
+		return m.IntervalSeconds
 	}
 	return 0
 }
 
-func (m *EventMarketOrdersDisabled) GetUpdatedBy() string {
+func (m *EventDeadManSwitchSet) GetDeadline() string {
 	if m != nil {
-		return m.UpdatedBy
+		return m.Deadline
 	}
 	return ""
 }
 
-// EventMarketUserSettleEnabled is an event emitted when a market's user_settle option is enabled.
-type EventMarketUserSettleEnabled struct {
-	// market_id is the numerical identifier of the market.
-	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	// updated_by is the account that updated the user_settle option.
-	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+// EventDeadManSwitchHeartbeat is an event emitted when an account sends a heartbeat, resetting its dead-man switch.
+type EventDeadManSwitchHeartbeat struct {
+	// account is the account that sent the heartbeat.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// deadline is the RFC 3339 time at which the account's orders will be cancelled if another heartbeat isn't sent.
+	Deadline string `protobuf:"bytes,2,opt,name=deadline,proto3" json:"deadline,omitempty"`
 }
 
-func (m *EventMarketUserSettleEnabled) Reset()         { *m = EventMarketUserSettleEnabled{} }
-func (m *EventMarketUserSettleEnabled) String() string { return proto.CompactTextString(m) }
-func (*EventMarketUserSettleEnabled) ProtoMessage()    {}
-func (*EventMarketUserSettleEnabled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{13}
+func (m *EventDeadManSwitchHeartbeat) Reset()         { *m = EventDeadManSwitchHeartbeat{} }
+func (m *EventDeadManSwitchHeartbeat) String() string { return proto.CompactTextString(m) }
+func (*EventDeadManSwitchHeartbeat) ProtoMessage()    {}
+func (*EventDeadManSwitchHeartbeat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{12}
 }
-func (m *EventMarketUserSettleEnabled) XXX_Unmarshal(b []byte) error {
+func (m *EventDeadManSwitchHeartbeat) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarketUserSettleEnabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventDeadManSwitchHeartbeat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarketUserSettleEnabled.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventDeadManSwitchHeartbeat.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -947,52 +1060,50 @@ func (m *EventMarketUserSettleEnabled) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *EventMarketUserSettleEnabled) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarketUserSettleEnabled.Merge(m, src)
+func (m *EventDeadManSwitchHeartbeat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDeadManSwitchHeartbeat.Merge(m, src)
 }
-func (m *EventMarketUserSettleEnabled) XXX_Size() int {
+func (m *EventDeadManSwitchHeartbeat) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarketUserSettleEnabled) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarketUserSettleEnabled.DiscardUnknown(m)
+func (m *EventDeadManSwitchHeartbeat) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDeadManSwitchHeartbeat.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarketUserSettleEnabled proto.InternalMessageInfo
+var xxx_messageInfo_EventDeadManSwitchHeartbeat proto.InternalMessageInfo
 
-func (m *EventMarketUserSettleEnabled) GetMarketId() uint32 {
+func (m *EventDeadManSwitchHeartbeat) GetAccount() string {
 	if m != nil {
-		return m.MarketId
+		return m.Account
 	}
-	return 0
+	return ""
 }
 
-func (m *EventMarketUserSettleEnabled) GetUpdatedBy() string {
+func (m *EventDeadManSwitchHeartbeat) GetDeadline() string {
 	if m != nil {
-		return m.UpdatedBy
+		return m.Deadline
 	}
 	return ""
 }
 
-// EventMarketUserSettleDisabled is an event emitted when a market's user_settle option is disabled.
-type EventMarketUserSettleDisabled struct {
-	// market_id is the numerical identifier of the market.
-	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	// updated_by is the account that updated the user_settle option.
-	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+// EventDeadManSwitchRemoved is an event emitted when an account removes its dead-man switch.
+type EventDeadManSwitchRemoved struct {
+	// account is the account that removed the dead-man switch.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func (m *EventMarketUserSettleDisabled) Reset()         { *m = EventMarketUserSettleDisabled{} }
-func (m *EventMarketUserSettleDisabled) String() string { return proto.CompactTextString(m) }
-func (*EventMarketUserSettleDisabled) ProtoMessage()    {}
-func (*EventMarketUserSettleDisabled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{14}
+func (m *EventDeadManSwitchRemoved) Reset()         { *m = EventDeadManSwitchRemoved{} }
+func (m *EventDeadManSwitchRemoved) String() string { return proto.CompactTextString(m) }
+func (*EventDeadManSwitchRemoved) ProtoMessage()    {}
+func (*EventDeadManSwitchRemoved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{13}
 }
-func (m *EventMarketUserSettleDisabled) XXX_Unmarshal(b []byte) error {
+func (m *EventDeadManSwitchRemoved) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarketUserSettleDisabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventDeadManSwitchRemoved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarketUserSettleDisabled.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventDeadManSwitchRemoved.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1002,52 +1113,47 @@ func (m *EventMarketUserSettleDisabled) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *EventMarketUserSettleDisabled) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarketUserSettleDisabled.Merge(m, src)
+func (m *EventDeadManSwitchRemoved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDeadManSwitchRemoved.Merge(m, src)
 }
-func (m *EventMarketUserSettleDisabled) XXX_Size() int {
+func (m *EventDeadManSwitchRemoved) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarketUserSettleDisabled) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarketUserSettleDisabled.DiscardUnknown(m)
+func (m *EventDeadManSwitchRemoved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDeadManSwitchRemoved.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarketUserSettleDisabled proto.InternalMessageInfo
-
-func (m *EventMarketUserSettleDisabled) GetMarketId() uint32 {
-	if m != nil {
-		return m.MarketId
-	}
-	return 0
-}
+var xxx_messageInfo_EventDeadManSwitchRemoved proto.InternalMessageInfo
 
-func (m *EventMarketUserSettleDisabled) GetUpdatedBy() string {
+func (m *EventDeadManSwitchRemoved) GetAccount() string {
 	if m != nil {
-		return m.UpdatedBy
+		return m.Account
 	}
 	return ""
 }
 
-// EventMarketCommitmentsEnabled is an event emitted when a market's accepting_commitments option is enabled.
-type EventMarketCommitmentsEnabled struct {
-	// market_id is the numerical identifier of the market.
-	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	// updated_by is the account that updated the accepting_commitments option.
-	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+// EventDeadManSwitchTriggered is an event emitted when an account misses a heartbeat and its orders are cancelled.
+// If the account has too many orders to cancel in one block, this is emitted in each block that cancels some of them.
+// An EventOrderCancelled is also emitted for each order that was cancelled.
+type EventDeadManSwitchTriggered struct {
+	// account is the account that missed the heartbeat.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// orders_cancelled is the number of orders (including trigger orders) cancelled in this block.
+	OrdersCancelled uint32 `protobuf:"varint,2,opt,name=orders_cancelled,json=ordersCancelled,proto3" json:"orders_cancelled,omitempty"`
 }
 
-func (m *EventMarketCommitmentsEnabled) Reset()         { *m = EventMarketCommitmentsEnabled{} }
-func (m *EventMarketCommitmentsEnabled) String() string { return proto.CompactTextString(m) }
-func (*EventMarketCommitmentsEnabled) ProtoMessage()    {}
-func (*EventMarketCommitmentsEnabled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{15}
+func (m *EventDeadManSwitchTriggered) Reset()         { *m = EventDeadManSwitchTriggered{} }
+func (m *EventDeadManSwitchTriggered) String() string { return proto.CompactTextString(m) }
+func (*EventDeadManSwitchTriggered) ProtoMessage()    {}
+func (*EventDeadManSwitchTriggered) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{14}
 }
-func (m *EventMarketCommitmentsEnabled) XXX_Unmarshal(b []byte) error {
+func (m *EventDeadManSwitchTriggered) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarketCommitmentsEnabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventDeadManSwitchTriggered) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarketCommitmentsEnabled.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventDeadManSwitchTriggered.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1057,52 +1163,57 @@ func (m *EventMarketCommitmentsEnabled) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *EventMarketCommitmentsEnabled) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarketCommitmentsEnabled.Merge(m, src)
+func (m *EventDeadManSwitchTriggered) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDeadManSwitchTriggered.Merge(m, src)
 }
-func (m *EventMarketCommitmentsEnabled) XXX_Size() int {
+func (m *EventDeadManSwitchTriggered) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarketCommitmentsEnabled) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarketCommitmentsEnabled.DiscardUnknown(m)
+func (m *EventDeadManSwitchTriggered) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDeadManSwitchTriggered.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarketCommitmentsEnabled proto.InternalMessageInfo
+var xxx_messageInfo_EventDeadManSwitchTriggered proto.InternalMessageInfo
 
-func (m *EventMarketCommitmentsEnabled) GetMarketId() uint32 {
+func (m *EventDeadManSwitchTriggered) GetAccount() string {
 	if m != nil {
-		return m.MarketId
+		return m.Account
 	}
-	return 0
+	return ""
 }
 
-func (m *EventMarketCommitmentsEnabled) GetUpdatedBy() string {
+func (m *EventDeadManSwitchTriggered) GetOrdersCancelled() uint32 {
 	if m != nil {
-		return m.UpdatedBy
+		return m.OrdersCancelled
 	}
-	return ""
+	return 0
 }
 
-// EventMarketCommitmentsDisabled is an event emitted when a market's accepting_commitments option is disabled.
-type EventMarketCommitmentsDisabled struct {
+// EventMarketOrdersBulkCancelled is an event emitted when a market cancels several orders using a bulk cancel.
+// An EventOrderCancelled is also emitted for each order that was cancelled.
+type EventMarketOrdersBulkCancelled struct {
 	// market_id is the numerical identifier of the market.
 	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	// updated_by is the account that updated the accepting_commitments option.
-	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	// cancelled_by is the account that requested the cancellations.
+	CancelledBy string `protobuf:"bytes,2,opt,name=cancelled_by,json=cancelledBy,proto3" json:"cancelled_by,omitempty"`
+	// count is the number of orders that were cancelled.
+	Count uint32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// has_more is true if there are still orders that match the request but were not cancelled due to the limit.
+	HasMore bool `protobuf:"varint,4,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
 }
 
-func (m *EventMarketCommitmentsDisabled) Reset()         { *m = EventMarketCommitmentsDisabled{} }
-func (m *EventMarketCommitmentsDisabled) String() string { return proto.CompactTextString(m) }
-func (*EventMarketCommitmentsDisabled) ProtoMessage()    {}
-func (*EventMarketCommitmentsDisabled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{16}
+func (m *EventMarketOrdersBulkCancelled) Reset()         { *m = EventMarketOrdersBulkCancelled{} }
+func (m *EventMarketOrdersBulkCancelled) String() string { return proto.CompactTextString(m) }
+func (*EventMarketOrdersBulkCancelled) ProtoMessage()    {}
+func (*EventMarketOrdersBulkCancelled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{15}
 }
-func (m *EventMarketCommitmentsDisabled) XXX_Unmarshal(b []byte) error {
+func (m *EventMarketOrdersBulkCancelled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarketCommitmentsDisabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventMarketOrdersBulkCancelled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarketCommitmentsDisabled.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventMarketOrdersBulkCancelled.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1112,53 +1223,74 @@ func (m *EventMarketCommitmentsDisabled) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *EventMarketCommitmentsDisabled) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarketCommitmentsDisabled.Merge(m, src)
+func (m *EventMarketOrdersBulkCancelled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketOrdersBulkCancelled.Merge(m, src)
 }
-func (m *EventMarketCommitmentsDisabled) XXX_Size() int {
+func (m *EventMarketOrdersBulkCancelled) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarketCommitmentsDisabled) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarketCommitmentsDisabled.DiscardUnknown(m)
+func (m *EventMarketOrdersBulkCancelled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketOrdersBulkCancelled.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarketCommitmentsDisabled proto.InternalMessageInfo
+var xxx_messageInfo_EventMarketOrdersBulkCancelled proto.InternalMessageInfo
 
-func (m *EventMarketCommitmentsDisabled) GetMarketId() uint32 {
+func (m *EventMarketOrdersBulkCancelled) GetMarketId() uint32 {
 	if m != nil {
 		return m.MarketId
 	}
 	return 0
 }
 
-func (m *EventMarketCommitmentsDisabled) GetUpdatedBy() string {
+func (m *EventMarketOrdersBulkCancelled) GetCancelledBy() string {
 	if m != nil {
-		return m.UpdatedBy
+		return m.CancelledBy
 	}
 	return ""
 }
 
-// EventMarketIntermediaryDenomUpdated is an event emitted when a market updates its
-// commitment_settlement_intermediary_denom field.
-type EventMarketIntermediaryDenomUpdated struct {
+func (m *EventMarketOrdersBulkCancelled) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *EventMarketOrdersBulkCancelled) GetHasMore() bool {
+	if m != nil {
+		return m.HasMore
+	}
+	return false
+}
+
+// EventSelfTradePrevented is an event emitted when an order is cancelled or reduced to prevent it from being
+// settled against another order from the same account.
+type EventSelfTradePrevented struct {
+	// order_id is the numerical identifier of the order that was cancelled or reduced.
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// opposing_order_id is the numerical identifier of the order (from the same account) it would have traded with.
+	OpposingOrderId uint64 `protobuf:"varint,2,opt,name=opposing_order_id,json=opposingOrderId,proto3" json:"opposing_order_id,omitempty"`
 	// market_id is the numerical identifier of the market.
-	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	// updated_by is the account that updated the intermediary denom.
-	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	MarketId uint32 `protobuf:"varint,3,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// external_id is the order's external id.
+	ExternalId string `protobuf:"bytes,4,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// assets is the coin amount string of the assets removed from the order.
+	// If this is all of the order's assets, the order was cancelled.
+	Assets string `protobuf:"bytes,5,opt,name=assets,proto3" json:"assets,omitempty"`
 }
 
-func (m *EventMarketIntermediaryDenomUpdated) Reset()         { *m = EventMarketIntermediaryDenomUpdated{} }
-func (m *EventMarketIntermediaryDenomUpdated) String() string { return proto.CompactTextString(m) }
-func (*EventMarketIntermediaryDenomUpdated) ProtoMessage()    {}
-func (*EventMarketIntermediaryDenomUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{17}
+func (m *EventSelfTradePrevented) Reset()         { *m = EventSelfTradePrevented{} }
+func (m *EventSelfTradePrevented) String() string { return proto.CompactTextString(m) }
+func (*EventSelfTradePrevented) ProtoMessage()    {}
+func (*EventSelfTradePrevented) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{16}
 }
-func (m *EventMarketIntermediaryDenomUpdated) XXX_Unmarshal(b []byte) error {
+func (m *EventSelfTradePrevented) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarketIntermediaryDenomUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventSelfTradePrevented) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarketIntermediaryDenomUpdated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventSelfTradePrevented.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1168,52 +1300,79 @@ func (m *EventMarketIntermediaryDenomUpdated) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *EventMarketIntermediaryDenomUpdated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarketIntermediaryDenomUpdated.Merge(m, src)
+func (m *EventSelfTradePrevented) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSelfTradePrevented.Merge(m, src)
 }
-func (m *EventMarketIntermediaryDenomUpdated) XXX_Size() int {
+func (m *EventSelfTradePrevented) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarketIntermediaryDenomUpdated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarketIntermediaryDenomUpdated.DiscardUnknown(m)
+func (m *EventSelfTradePrevented) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSelfTradePrevented.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarketIntermediaryDenomUpdated proto.InternalMessageInfo
+var xxx_messageInfo_EventSelfTradePrevented proto.InternalMessageInfo
 
-func (m *EventMarketIntermediaryDenomUpdated) GetMarketId() uint32 {
+func (m *EventSelfTradePrevented) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
+	}
+	return 0
+}
+
+func (m *EventSelfTradePrevented) GetOpposingOrderId() uint64 {
+	if m != nil {
+		return m.OpposingOrderId
+	}
+	return 0
+}
+
+func (m *EventSelfTradePrevented) GetMarketId() uint32 {
 	if m != nil {
 		return m.MarketId
 	}
 	return 0
 }
 
-func (m *EventMarketIntermediaryDenomUpdated) GetUpdatedBy() string {
+func (m *EventSelfTradePrevented) GetExternalId() string {
 	if m != nil {
-		return m.UpdatedBy
+		return m.ExternalId
 	}
 	return ""
 }
 
-// EventMarketPermissionsUpdated is an event emitted when a market's permissions are updated.
-type EventMarketPermissionsUpdated struct {
+func (m *EventSelfTradePrevented) GetAssets() string {
+	if m != nil {
+		return m.Assets
+	}
+	return ""
+}
+
+// EventTriggerOrderCreated is an event emitted when a trigger order is created.
+type EventTriggerOrderCreated struct {
+	// order_id is the numerical identifier of the trigger order created.
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// order_type is the type of order, e.g. "ask" or "bid".
+	OrderType string `protobuf:"bytes,2,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
 	// market_id is the numerical identifier of the market.
-	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	// updated_by is the account that updated the permissions.
-	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	MarketId uint32 `protobuf:"varint,3,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// external_id is the order's external id.
+	ExternalId string `protobuf:"bytes,4,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// trigger_price is the price that will activate the order (Coin string).
+	TriggerPrice string `protobuf:"bytes,5,opt,name=trigger_price,json=triggerPrice,proto3" json:"trigger_price,omitempty"`
 }
 
-func (m *EventMarketPermissionsUpdated) Reset()         { *m = EventMarketPermissionsUpdated{} }
-func (m *EventMarketPermissionsUpdated) String() string { return proto.CompactTextString(m) }
-func (*EventMarketPermissionsUpdated) ProtoMessage()    {}
-func (*EventMarketPermissionsUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{18}
+func (m *EventTriggerOrderCreated) Reset()         { *m = EventTriggerOrderCreated{} }
+func (m *EventTriggerOrderCreated) String() string { return proto.CompactTextString(m) }
+func (*EventTriggerOrderCreated) ProtoMessage()    {}
+func (*EventTriggerOrderCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{17}
 }
-func (m *EventMarketPermissionsUpdated) XXX_Unmarshal(b []byte) error {
+func (m *EventTriggerOrderCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarketPermissionsUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventTriggerOrderCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarketPermissionsUpdated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventTriggerOrderCreated.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1223,52 +1382,79 @@ func (m *EventMarketPermissionsUpdated) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *EventMarketPermissionsUpdated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarketPermissionsUpdated.Merge(m, src)
+func (m *EventTriggerOrderCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTriggerOrderCreated.Merge(m, src)
 }
-func (m *EventMarketPermissionsUpdated) XXX_Size() int {
+func (m *EventTriggerOrderCreated) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarketPermissionsUpdated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarketPermissionsUpdated.DiscardUnknown(m)
+func (m *EventTriggerOrderCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTriggerOrderCreated.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarketPermissionsUpdated proto.InternalMessageInfo
+var xxx_messageInfo_EventTriggerOrderCreated proto.InternalMessageInfo
 
-func (m *EventMarketPermissionsUpdated) GetMarketId() uint32 {
+func (m *EventTriggerOrderCreated) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
+	}
+	return 0
+}
+
+func (m *EventTriggerOrderCreated) GetOrderType() string {
+	if m != nil {
+		return m.OrderType
+	}
+	return ""
+}
+
+func (m *EventTriggerOrderCreated) GetMarketId() uint32 {
 	if m != nil {
 		return m.MarketId
 	}
 	return 0
 }
 
-func (m *EventMarketPermissionsUpdated) GetUpdatedBy() string {
+func (m *EventTriggerOrderCreated) GetExternalId() string {
 	if m != nil {
-		return m.UpdatedBy
+		return m.ExternalId
 	}
 	return ""
 }
 
-// EventMarketReqAttrUpdated is an event emitted when a market's required attributes are updated.
-type EventMarketReqAttrUpdated struct {
+func (m *EventTriggerOrderCreated) GetTriggerPrice() string {
+	if m != nil {
+		return m.TriggerPrice
+	}
+	return ""
+}
+
+// EventTriggerOrderActivated is an event emitted when a trigger order's price is reached and it becomes a normal order.
+type EventTriggerOrderActivated struct {
+	// order_id is the numerical identifier of the order activated.
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// order_type is the type of order, e.g. "ask" or "bid".
+	OrderType string `protobuf:"bytes,2,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
 	// market_id is the numerical identifier of the market.
-	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	// updated_by is the account that updated the required attributes.
-	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	MarketId uint32 `protobuf:"varint,3,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// external_id is the order's external id.
+	ExternalId string `protobuf:"bytes,4,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// price is the price (per the trigger order's assets amount) that caused the activation (Coin string).
+	Price string `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
 }
 
-func (m *EventMarketReqAttrUpdated) Reset()         { *m = EventMarketReqAttrUpdated{} }
-func (m *EventMarketReqAttrUpdated) String() string { return proto.CompactTextString(m) }
-func (*EventMarketReqAttrUpdated) ProtoMessage()    {}
-func (*EventMarketReqAttrUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{19}
+func (m *EventTriggerOrderActivated) Reset()         { *m = EventTriggerOrderActivated{} }
+func (m *EventTriggerOrderActivated) String() string { return proto.CompactTextString(m) }
+func (*EventTriggerOrderActivated) ProtoMessage()    {}
+func (*EventTriggerOrderActivated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{18}
 }
-func (m *EventMarketReqAttrUpdated) XXX_Unmarshal(b []byte) error {
+func (m *EventTriggerOrderActivated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarketReqAttrUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventTriggerOrderActivated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarketReqAttrUpdated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventTriggerOrderActivated.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1278,50 +1464,77 @@ func (m *EventMarketReqAttrUpdated) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *EventMarketReqAttrUpdated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarketReqAttrUpdated.Merge(m, src)
+func (m *EventTriggerOrderActivated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTriggerOrderActivated.Merge(m, src)
 }
-func (m *EventMarketReqAttrUpdated) XXX_Size() int {
+func (m *EventTriggerOrderActivated) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarketReqAttrUpdated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarketReqAttrUpdated.DiscardUnknown(m)
+func (m *EventTriggerOrderActivated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTriggerOrderActivated.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarketReqAttrUpdated proto.InternalMessageInfo
+var xxx_messageInfo_EventTriggerOrderActivated proto.InternalMessageInfo
 
-func (m *EventMarketReqAttrUpdated) GetMarketId() uint32 {
+func (m *EventTriggerOrderActivated) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
+	}
+	return 0
+}
+
+func (m *EventTriggerOrderActivated) GetOrderType() string {
+	if m != nil {
+		return m.OrderType
+	}
+	return ""
+}
+
+func (m *EventTriggerOrderActivated) GetMarketId() uint32 {
 	if m != nil {
 		return m.MarketId
 	}
 	return 0
 }
 
-func (m *EventMarketReqAttrUpdated) GetUpdatedBy() string {
+func (m *EventTriggerOrderActivated) GetExternalId() string {
 	if m != nil {
-		return m.UpdatedBy
+		return m.ExternalId
 	}
 	return ""
 }
 
-// EventMarketCreated is an event emitted when a market has been created.
-type EventMarketCreated struct {
+func (m *EventTriggerOrderActivated) GetPrice() string {
+	if m != nil {
+		return m.Price
+	}
+	return ""
+}
+
+// EventBatchAuctionSettled is an event emitted when a batch auction settles orders in a market.
+type EventBatchAuctionSettled struct {
 	// market_id is the numerical identifier of the market.
 	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// assets is the coin amount string of the total assets that were traded.
+	Assets string `protobuf:"bytes,2,opt,name=assets,proto3" json:"assets,omitempty"`
+	// price is the coin amount string of the total price paid for the assets.
+	Price string `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+	// clearing_price is the dec-coin string of the price for one of the assets that all the orders were settled at.
+	ClearingPrice string `protobuf:"bytes,4,opt,name=clearing_price,json=clearingPrice,proto3" json:"clearing_price,omitempty"`
 }
 
-func (m *EventMarketCreated) Reset()         { *m = EventMarketCreated{} }
-func (m *EventMarketCreated) String() string { return proto.CompactTextString(m) }
-func (*EventMarketCreated) ProtoMessage()    {}
-func (*EventMarketCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{20}
+func (m *EventBatchAuctionSettled) Reset()         { *m = EventBatchAuctionSettled{} }
+func (m *EventBatchAuctionSettled) String() string { return proto.CompactTextString(m) }
+func (*EventBatchAuctionSettled) ProtoMessage()    {}
+func (*EventBatchAuctionSettled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{19}
 }
-func (m *EventMarketCreated) XXX_Unmarshal(b []byte) error {
+func (m *EventBatchAuctionSettled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarketCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventBatchAuctionSettled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarketCreated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventBatchAuctionSettled.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1331,43 +1544,70 @@ func (m *EventMarketCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventMarketCreated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarketCreated.Merge(m, src)
+func (m *EventBatchAuctionSettled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBatchAuctionSettled.Merge(m, src)
 }
-func (m *EventMarketCreated) XXX_Size() int {
+func (m *EventBatchAuctionSettled) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarketCreated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarketCreated.DiscardUnknown(m)
+func (m *EventBatchAuctionSettled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBatchAuctionSettled.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarketCreated proto.InternalMessageInfo
+var xxx_messageInfo_EventBatchAuctionSettled proto.InternalMessageInfo
 
-func (m *EventMarketCreated) GetMarketId() uint32 {
+func (m *EventBatchAuctionSettled) GetMarketId() uint32 {
 	if m != nil {
 		return m.MarketId
 	}
 	return 0
 }
 
-// EventMarketFeesUpdated is an event emitted when a market's fees have been updated.
-type EventMarketFeesUpdated struct {
+func (m *EventBatchAuctionSettled) GetAssets() string {
+	if m != nil {
+		return m.Assets
+	}
+	return ""
+}
+
+func (m *EventBatchAuctionSettled) GetPrice() string {
+	if m != nil {
+		return m.Price
+	}
+	return ""
+}
+
+func (m *EventBatchAuctionSettled) GetClearingPrice() string {
+	if m != nil {
+		return m.ClearingPrice
+	}
+	return ""
+}
+
+// EventFundsCommitted is an event emitted when funds are committed to a market.
+type EventFundsCommitted struct {
+	// account is the bech32 address string of the account.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 	// market_id is the numerical identifier of the market.
-	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// amount is the coins string of the newly committed funds.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// tag is the string provided in the message causing this event.
+	Tag string `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
 }
 
-func (m *EventMarketFeesUpdated) Reset()         { *m = EventMarketFeesUpdated{} }
-func (m *EventMarketFeesUpdated) String() string { return proto.CompactTextString(m) }
-func (*EventMarketFeesUpdated) ProtoMessage()    {}
-func (*EventMarketFeesUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{21}
+func (m *EventFundsCommitted) Reset()         { *m = EventFundsCommitted{} }
+func (m *EventFundsCommitted) String() string { return proto.CompactTextString(m) }
+func (*EventFundsCommitted) ProtoMessage()    {}
+func (*EventFundsCommitted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{20}
 }
-func (m *EventMarketFeesUpdated) XXX_Unmarshal(b []byte) error {
+func (m *EventFundsCommitted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarketFeesUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventFundsCommitted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarketFeesUpdated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventFundsCommitted.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1377,41 +1617,70 @@ func (m *EventMarketFeesUpdated) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *EventMarketFeesUpdated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarketFeesUpdated.Merge(m, src)
+func (m *EventFundsCommitted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFundsCommitted.Merge(m, src)
 }
-func (m *EventMarketFeesUpdated) XXX_Size() int {
+func (m *EventFundsCommitted) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarketFeesUpdated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarketFeesUpdated.DiscardUnknown(m)
+func (m *EventFundsCommitted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFundsCommitted.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarketFeesUpdated proto.InternalMessageInfo
+var xxx_messageInfo_EventFundsCommitted proto.InternalMessageInfo
 
-func (m *EventMarketFeesUpdated) GetMarketId() uint32 {
+func (m *EventFundsCommitted) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *EventFundsCommitted) GetMarketId() uint32 {
 	if m != nil {
 		return m.MarketId
 	}
 	return 0
 }
 
-// EventParamsUpdated is an event emitted when the exchange module's params have been updated.
-type EventParamsUpdated struct {
+func (m *EventFundsCommitted) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
 }
 
-func (m *EventParamsUpdated) Reset()         { *m = EventParamsUpdated{} }
-func (m *EventParamsUpdated) String() string { return proto.CompactTextString(m) }
-func (*EventParamsUpdated) ProtoMessage()    {}
-func (*EventParamsUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{22}
+func (m *EventFundsCommitted) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
 }
-func (m *EventParamsUpdated) XXX_Unmarshal(b []byte) error {
+
+// EventCommitmentReleased is an event emitted when funds are released from their commitment.
+type EventCommitmentReleased struct {
+	// account is the bech32 address string of the account.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// amount is the coins string of the funds that were released from commitment.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// tag is the string provided in the message causing this event.
+	Tag string `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
+}
+
+func (m *EventCommitmentReleased) Reset()         { *m = EventCommitmentReleased{} }
+func (m *EventCommitmentReleased) String() string { return proto.CompactTextString(m) }
+func (*EventCommitmentReleased) ProtoMessage()    {}
+func (*EventCommitmentReleased) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{21}
+}
+func (m *EventCommitmentReleased) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventParamsUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventCommitmentReleased) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventParamsUpdated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventCommitmentReleased.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1421,44 +1690,68 @@ func (m *EventParamsUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventParamsUpdated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventParamsUpdated.Merge(m, src)
+func (m *EventCommitmentReleased) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCommitmentReleased.Merge(m, src)
 }
-func (m *EventParamsUpdated) XXX_Size() int {
+func (m *EventCommitmentReleased) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventParamsUpdated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventParamsUpdated.DiscardUnknown(m)
+func (m *EventCommitmentReleased) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCommitmentReleased.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventParamsUpdated proto.InternalMessageInfo
+var xxx_messageInfo_EventCommitmentReleased proto.InternalMessageInfo
 
-// EventPaymentCreated is an event emitted when a payment is created.
-type EventPaymentCreated struct {
-	// source is the account that created the Payment.
-	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
-	// source_amount is the coins amount string of the funds that the source will pay (to the target).
-	SourceAmount string `protobuf:"bytes,2,opt,name=source_amount,json=sourceAmount,proto3" json:"source_amount,omitempty"`
-	// target is the account that can accept the Payment.
-	Target string `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
-	// target_amount is the coins amount string of the funds that the target will pay (to the source).
-	TargetAmount string `protobuf:"bytes,4,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
-	// external_id is used along with the source to uniquely identify this Payment.
-	ExternalId string `protobuf:"bytes,5,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+func (m *EventCommitmentReleased) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
 }
 
-func (m *EventPaymentCreated) Reset()         { *m = EventPaymentCreated{} }
-func (m *EventPaymentCreated) String() string { return proto.CompactTextString(m) }
-func (*EventPaymentCreated) ProtoMessage()    {}
-func (*EventPaymentCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{23}
+func (m *EventCommitmentReleased) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
 }
-func (m *EventPaymentCreated) XXX_Unmarshal(b []byte) error {
+
+func (m *EventCommitmentReleased) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventCommitmentReleased) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+// EventCommitmentExpired is an event emitted when committed funds are released because the commitment's expiration has passed.
+type EventCommitmentExpired struct {
+	// account is the bech32 address string of the account.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// amount is the coins string of the funds that were released from commitment.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *EventCommitmentExpired) Reset()         { *m = EventCommitmentExpired{} }
+func (m *EventCommitmentExpired) String() string { return proto.CompactTextString(m) }
+func (*EventCommitmentExpired) ProtoMessage()    {}
+func (*EventCommitmentExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{22}
+}
+func (m *EventCommitmentExpired) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventPaymentCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventCommitmentExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventPaymentCreated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventCommitmentExpired.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1468,81 +1761,127 @@ func (m *EventPaymentCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventPaymentCreated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventPaymentCreated.Merge(m, src)
+func (m *EventCommitmentExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCommitmentExpired.Merge(m, src)
 }
-func (m *EventPaymentCreated) XXX_Size() int {
+func (m *EventCommitmentExpired) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventPaymentCreated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventPaymentCreated.DiscardUnknown(m)
+func (m *EventCommitmentExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCommitmentExpired.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventPaymentCreated proto.InternalMessageInfo
+var xxx_messageInfo_EventCommitmentExpired proto.InternalMessageInfo
 
-func (m *EventPaymentCreated) GetSource() string {
+func (m *EventCommitmentExpired) GetAccount() string {
 	if m != nil {
-		return m.Source
+		return m.Account
 	}
 	return ""
 }
 
-func (m *EventPaymentCreated) GetSourceAmount() string {
+func (m *EventCommitmentExpired) GetMarketId() uint32 {
 	if m != nil {
-		return m.SourceAmount
+		return m.MarketId
 	}
-	return ""
+	return 0
 }
 
-func (m *EventPaymentCreated) GetTarget() string {
+func (m *EventCommitmentExpired) GetAmount() string {
 	if m != nil {
-		return m.Target
+		return m.Amount
 	}
 	return ""
 }
 
-func (m *EventPaymentCreated) GetTargetAmount() string {
+// EventCommitmentRewardsFunded is an event emitted when funds are added to a market's commitment reward pool.
+type EventCommitmentRewardsFunded struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// funder is the bech32 address string of the account that provided the funds.
+	Funder string `protobuf:"bytes,2,opt,name=funder,proto3" json:"funder,omitempty"`
+	// amount is the coins string of the funds added to the reward pool.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *EventCommitmentRewardsFunded) Reset()         { *m = EventCommitmentRewardsFunded{} }
+func (m *EventCommitmentRewardsFunded) String() string { return proto.CompactTextString(m) }
+func (*EventCommitmentRewardsFunded) ProtoMessage()    {}
+func (*EventCommitmentRewardsFunded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{23}
+}
+func (m *EventCommitmentRewardsFunded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCommitmentRewardsFunded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCommitmentRewardsFunded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCommitmentRewardsFunded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCommitmentRewardsFunded.Merge(m, src)
+}
+func (m *EventCommitmentRewardsFunded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCommitmentRewardsFunded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCommitmentRewardsFunded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCommitmentRewardsFunded proto.InternalMessageInfo
+
+func (m *EventCommitmentRewardsFunded) GetMarketId() uint32 {
 	if m != nil {
-		return m.TargetAmount
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *EventCommitmentRewardsFunded) GetFunder() string {
+	if m != nil {
+		return m.Funder
 	}
 	return ""
 }
 
-func (m *EventPaymentCreated) GetExternalId() string {
+func (m *EventCommitmentRewardsFunded) GetAmount() string {
 	if m != nil {
-		return m.ExternalId
+		return m.Amount
 	}
 	return ""
 }
 
-// EventPaymentUpdated is an event emitted when a payment is updated.
-type EventPaymentUpdated struct {
-	// source is the account that updated (and previously created) the Payment.
-	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
-	// source_amount is the coins amount string of the funds that the source will pay (to the target).
-	SourceAmount string `protobuf:"bytes,2,opt,name=source_amount,json=sourceAmount,proto3" json:"source_amount,omitempty"`
-	// old_target is the account that used to be able to accept the Payment (but not any more).
-	OldTarget string `protobuf:"bytes,3,opt,name=old_target,json=oldTarget,proto3" json:"old_target,omitempty"`
-	// new_target is the account that is now able to accept the Payment.
-	NewTarget string `protobuf:"bytes,4,opt,name=new_target,json=newTarget,proto3" json:"new_target,omitempty"`
-	// target_amount is the coins amount string of the funds that the target will pay (to the source).
-	TargetAmount string `protobuf:"bytes,5,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
-	// external_id is used along with the source to uniquely identify this Payment.
-	ExternalId string `protobuf:"bytes,6,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+// EventCommitmentRewardsDistributed is an event emitted when a market distributes funds from its commitment reward pool.
+type EventCommitmentRewardsDistributed struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// amount is the coins string of the funds distributed from the reward pool.
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// basis_denom is the committed denom used to divide up the rewards.
+	BasisDenom string `protobuf:"bytes,3,opt,name=basis_denom,json=basisDenom,proto3" json:"basis_denom,omitempty"`
+	// distributed_by is the account that requested the distribution.
+	DistributedBy string `protobuf:"bytes,4,opt,name=distributed_by,json=distributedBy,proto3" json:"distributed_by,omitempty"`
 }
 
-func (m *EventPaymentUpdated) Reset()         { *m = EventPaymentUpdated{} }
-func (m *EventPaymentUpdated) String() string { return proto.CompactTextString(m) }
-func (*EventPaymentUpdated) ProtoMessage()    {}
-func (*EventPaymentUpdated) Descriptor() ([]byte, []int) {
+func (m *EventCommitmentRewardsDistributed) Reset()         { *m = EventCommitmentRewardsDistributed{} }
+func (m *EventCommitmentRewardsDistributed) String() string { return proto.CompactTextString(m) }
+func (*EventCommitmentRewardsDistributed) ProtoMessage()    {}
+func (*EventCommitmentRewardsDistributed) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c1b69385a348cffa, []int{24}
 }
-func (m *EventPaymentUpdated) XXX_Unmarshal(b []byte) error {
+func (m *EventCommitmentRewardsDistributed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventPaymentUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventCommitmentRewardsDistributed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventPaymentUpdated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventCommitmentRewardsDistributed.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1552,86 +1891,68 @@ func (m *EventPaymentUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventPaymentUpdated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventPaymentUpdated.Merge(m, src)
+func (m *EventCommitmentRewardsDistributed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCommitmentRewardsDistributed.Merge(m, src)
 }
-func (m *EventPaymentUpdated) XXX_Size() int {
+func (m *EventCommitmentRewardsDistributed) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventPaymentUpdated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventPaymentUpdated.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_EventPaymentUpdated proto.InternalMessageInfo
-
-func (m *EventPaymentUpdated) GetSource() string {
-	if m != nil {
-		return m.Source
-	}
-	return ""
+func (m *EventCommitmentRewardsDistributed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCommitmentRewardsDistributed.DiscardUnknown(m)
 }
 
-func (m *EventPaymentUpdated) GetSourceAmount() string {
-	if m != nil {
-		return m.SourceAmount
-	}
-	return ""
-}
+var xxx_messageInfo_EventCommitmentRewardsDistributed proto.InternalMessageInfo
 
-func (m *EventPaymentUpdated) GetOldTarget() string {
+func (m *EventCommitmentRewardsDistributed) GetMarketId() uint32 {
 	if m != nil {
-		return m.OldTarget
+		return m.MarketId
 	}
-	return ""
+	return 0
 }
 
-func (m *EventPaymentUpdated) GetNewTarget() string {
+func (m *EventCommitmentRewardsDistributed) GetAmount() string {
 	if m != nil {
-		return m.NewTarget
+		return m.Amount
 	}
 	return ""
 }
 
-func (m *EventPaymentUpdated) GetTargetAmount() string {
+func (m *EventCommitmentRewardsDistributed) GetBasisDenom() string {
 	if m != nil {
-		return m.TargetAmount
+		return m.BasisDenom
 	}
 	return ""
 }
 
-func (m *EventPaymentUpdated) GetExternalId() string {
+func (m *EventCommitmentRewardsDistributed) GetDistributedBy() string {
 	if m != nil {
-		return m.ExternalId
+		return m.DistributedBy
 	}
 	return ""
 }
 
-// EventPaymentAccepted is an event emitted when a payment is accepted.
-type EventPaymentAccepted struct {
-	// source is the account that created the Payment.
-	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
-	// source_amount is the coins amount string of the funds that the source will pay (to the target).
-	SourceAmount string `protobuf:"bytes,2,opt,name=source_amount,json=sourceAmount,proto3" json:"source_amount,omitempty"`
-	// target is the account that accepted the Payment.
-	Target string `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
-	// target_amount is the coins amount string of the funds that the target will pay (to the source).
-	TargetAmount string `protobuf:"bytes,4,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
-	// external_id is used along with the source to uniquely identify this Payment.
-	ExternalId string `protobuf:"bytes,5,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+// EventCommitmentRewardsClaimed is an event emitted when an account claims its commitment rewards.
+type EventCommitmentRewardsClaimed struct {
+	// account is the bech32 address string of the account.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// amount is the coins string of the rewards sent to the account.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (m *EventPaymentAccepted) Reset()         { *m = EventPaymentAccepted{} }
-func (m *EventPaymentAccepted) String() string { return proto.CompactTextString(m) }
-func (*EventPaymentAccepted) ProtoMessage()    {}
-func (*EventPaymentAccepted) Descriptor() ([]byte, []int) {
+func (m *EventCommitmentRewardsClaimed) Reset()         { *m = EventCommitmentRewardsClaimed{} }
+func (m *EventCommitmentRewardsClaimed) String() string { return proto.CompactTextString(m) }
+func (*EventCommitmentRewardsClaimed) ProtoMessage()    {}
+func (*EventCommitmentRewardsClaimed) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c1b69385a348cffa, []int{25}
 }
-func (m *EventPaymentAccepted) XXX_Unmarshal(b []byte) error {
+func (m *EventCommitmentRewardsClaimed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventPaymentAccepted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventCommitmentRewardsClaimed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventPaymentAccepted.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventCommitmentRewardsClaimed.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1641,75 +1962,143 @@ func (m *EventPaymentAccepted) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *EventPaymentAccepted) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventPaymentAccepted.Merge(m, src)
+func (m *EventCommitmentRewardsClaimed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCommitmentRewardsClaimed.Merge(m, src)
 }
-func (m *EventPaymentAccepted) XXX_Size() int {
+func (m *EventCommitmentRewardsClaimed) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventPaymentAccepted) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventPaymentAccepted.DiscardUnknown(m)
+func (m *EventCommitmentRewardsClaimed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCommitmentRewardsClaimed.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventPaymentAccepted proto.InternalMessageInfo
+var xxx_messageInfo_EventCommitmentRewardsClaimed proto.InternalMessageInfo
 
-func (m *EventPaymentAccepted) GetSource() string {
+func (m *EventCommitmentRewardsClaimed) GetAccount() string {
 	if m != nil {
-		return m.Source
+		return m.Account
 	}
 	return ""
 }
 
-func (m *EventPaymentAccepted) GetSourceAmount() string {
+func (m *EventCommitmentRewardsClaimed) GetMarketId() uint32 {
 	if m != nil {
-		return m.SourceAmount
+		return m.MarketId
 	}
-	return ""
+	return 0
 }
 
-func (m *EventPaymentAccepted) GetTarget() string {
+func (m *EventCommitmentRewardsClaimed) GetAmount() string {
 	if m != nil {
-		return m.Target
+		return m.Amount
 	}
 	return ""
 }
 
-func (m *EventPaymentAccepted) GetTargetAmount() string {
+// EventMarketWoundDown is an event emitted when a market is wound down using the GovWindDownMarket endpoint.
+type EventMarketWoundDown struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// orders_cancelled is the number of orders (including trigger orders) that were cancelled.
+	OrdersCancelled uint32 `protobuf:"varint,2,opt,name=orders_cancelled,json=ordersCancelled,proto3" json:"orders_cancelled,omitempty"`
+	// commitments_released is the number of accounts that had their commitments released.
+	CommitmentsReleased uint32 `protobuf:"varint,3,opt,name=commitments_released,json=commitmentsReleased,proto3" json:"commitments_released,omitempty"`
+	// market_deleted is whether the market's record was deleted.
+	MarketDeleted bool `protobuf:"varint,4,opt,name=market_deleted,json=marketDeleted,proto3" json:"market_deleted,omitempty"`
+	// releases are the funds released for each affected account.
+	Releases []*WindDownRelease `protobuf:"bytes,5,rep,name=releases,proto3" json:"releases,omitempty"`
+}
+
+func (m *EventMarketWoundDown) Reset()         { *m = EventMarketWoundDown{} }
+func (m *EventMarketWoundDown) String() string { return proto.CompactTextString(m) }
+func (*EventMarketWoundDown) ProtoMessage()    {}
+func (*EventMarketWoundDown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{26}
+}
+func (m *EventMarketWoundDown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketWoundDown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketWoundDown.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMarketWoundDown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketWoundDown.Merge(m, src)
+}
+func (m *EventMarketWoundDown) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketWoundDown) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketWoundDown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarketWoundDown proto.InternalMessageInfo
+
+func (m *EventMarketWoundDown) GetMarketId() uint32 {
 	if m != nil {
-		return m.TargetAmount
+		return m.MarketId
 	}
-	return ""
+	return 0
 }
 
-func (m *EventPaymentAccepted) GetExternalId() string {
+func (m *EventMarketWoundDown) GetOrdersCancelled() uint32 {
 	if m != nil {
-		return m.ExternalId
+		return m.OrdersCancelled
 	}
-	return ""
+	return 0
 }
 
-// EventPaymentRejected is an event emitted when a payment is rejected (by the target).
-type EventPaymentRejected struct {
-	// source is the account that created the Payment.
-	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
-	// target is the account that rejected the Payment.
-	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
-	// external_id is used along with the source to uniquely identify this Payment.
-	ExternalId string `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+func (m *EventMarketWoundDown) GetCommitmentsReleased() uint32 {
+	if m != nil {
+		return m.CommitmentsReleased
+	}
+	return 0
 }
 
-func (m *EventPaymentRejected) Reset()         { *m = EventPaymentRejected{} }
-func (m *EventPaymentRejected) String() string { return proto.CompactTextString(m) }
-func (*EventPaymentRejected) ProtoMessage()    {}
-func (*EventPaymentRejected) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{26}
+func (m *EventMarketWoundDown) GetMarketDeleted() bool {
+	if m != nil {
+		return m.MarketDeleted
+	}
+	return false
 }
-func (m *EventPaymentRejected) XXX_Unmarshal(b []byte) error {
+
+func (m *EventMarketWoundDown) GetReleases() []*WindDownRelease {
+	if m != nil {
+		return m.Releases
+	}
+	return nil
+}
+
+// WindDownRelease is a summary of the funds released for a single account when a market is wound down.
+type WindDownRelease struct {
+	// account is the bech32 address string of the account that had funds released.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// order_funds is the coins amount string of funds released from the account's cancelled orders.
+	OrderFunds string `protobuf:"bytes,2,opt,name=order_funds,json=orderFunds,proto3" json:"order_funds,omitempty"`
+	// commitment_funds is the coins amount string of the account's commitment that was released.
+	CommitmentFunds string `protobuf:"bytes,3,opt,name=commitment_funds,json=commitmentFunds,proto3" json:"commitment_funds,omitempty"`
+}
+
+func (m *WindDownRelease) Reset()         { *m = WindDownRelease{} }
+func (m *WindDownRelease) String() string { return proto.CompactTextString(m) }
+func (*WindDownRelease) ProtoMessage()    {}
+func (*WindDownRelease) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{27}
+}
+func (m *WindDownRelease) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventPaymentRejected) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WindDownRelease) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventPaymentRejected.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WindDownRelease.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1719,61 +2108,63 @@ func (m *EventPaymentRejected) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *EventPaymentRejected) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventPaymentRejected.Merge(m, src)
+func (m *WindDownRelease) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WindDownRelease.Merge(m, src)
 }
-func (m *EventPaymentRejected) XXX_Size() int {
+func (m *WindDownRelease) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventPaymentRejected) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventPaymentRejected.DiscardUnknown(m)
+func (m *WindDownRelease) XXX_DiscardUnknown() {
+	xxx_messageInfo_WindDownRelease.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventPaymentRejected proto.InternalMessageInfo
+var xxx_messageInfo_WindDownRelease proto.InternalMessageInfo
 
-func (m *EventPaymentRejected) GetSource() string {
+func (m *WindDownRelease) GetAccount() string {
 	if m != nil {
-		return m.Source
+		return m.Account
 	}
 	return ""
 }
 
-func (m *EventPaymentRejected) GetTarget() string {
+func (m *WindDownRelease) GetOrderFunds() string {
 	if m != nil {
-		return m.Target
+		return m.OrderFunds
 	}
 	return ""
 }
 
-func (m *EventPaymentRejected) GetExternalId() string {
+func (m *WindDownRelease) GetCommitmentFunds() string {
 	if m != nil {
-		return m.ExternalId
+		return m.CommitmentFunds
 	}
 	return ""
 }
 
-// EventPaymentCancelled is an event emitted when a payment is cancelled (by the source).
-type EventPaymentCancelled struct {
-	// source is the account that cancelled (and created) the Payment.
-	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
-	// target is the account that could have accepted the Payment.
-	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
-	// external_id is used along with the source to uniquely identify this Payment.
-	ExternalId string `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+// EventMarketWithdraw is an event emitted when a withdrawal of a market's collected fees is made.
+type EventMarketWithdraw struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// amount is the coins amount string of funds withdrawn from the market account.
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// destination is the account that received the funds.
+	Destination string `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
+	// withdrawn_by is the account that requested the withdrawal.
+	WithdrawnBy string `protobuf:"bytes,4,opt,name=withdrawn_by,json=withdrawnBy,proto3" json:"withdrawn_by,omitempty"`
 }
 
-func (m *EventPaymentCancelled) Reset()         { *m = EventPaymentCancelled{} }
-func (m *EventPaymentCancelled) String() string { return proto.CompactTextString(m) }
-func (*EventPaymentCancelled) ProtoMessage()    {}
-func (*EventPaymentCancelled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{27}
+func (m *EventMarketWithdraw) Reset()         { *m = EventMarketWithdraw{} }
+func (m *EventMarketWithdraw) String() string { return proto.CompactTextString(m) }
+func (*EventMarketWithdraw) ProtoMessage()    {}
+func (*EventMarketWithdraw) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{28}
 }
-func (m *EventPaymentCancelled) XXX_Unmarshal(b []byte) error {
+func (m *EventMarketWithdraw) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventPaymentCancelled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventMarketWithdraw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventPaymentCancelled.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventMarketWithdraw.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1783,1880 +2174,15382 @@ func (m *EventPaymentCancelled) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *EventPaymentCancelled) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventPaymentCancelled.Merge(m, src)
+func (m *EventMarketWithdraw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketWithdraw.Merge(m, src)
 }
-func (m *EventPaymentCancelled) XXX_Size() int {
+func (m *EventMarketWithdraw) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventPaymentCancelled) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventPaymentCancelled.DiscardUnknown(m)
+func (m *EventMarketWithdraw) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketWithdraw.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventPaymentCancelled proto.InternalMessageInfo
+var xxx_messageInfo_EventMarketWithdraw proto.InternalMessageInfo
 
-func (m *EventPaymentCancelled) GetSource() string {
+func (m *EventMarketWithdraw) GetMarketId() uint32 {
 	if m != nil {
-		return m.Source
+		return m.MarketId
 	}
-	return ""
+	return 0
 }
 
-func (m *EventPaymentCancelled) GetTarget() string {
+func (m *EventMarketWithdraw) GetAmount() string {
 	if m != nil {
-		return m.Target
+		return m.Amount
 	}
 	return ""
 }
 
-func (m *EventPaymentCancelled) GetExternalId() string {
+func (m *EventMarketWithdraw) GetDestination() string {
 	if m != nil {
-		return m.ExternalId
+		return m.Destination
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*EventOrderCreated)(nil), "provenance.exchange.v1.EventOrderCreated")
-	proto.RegisterType((*EventOrderCancelled)(nil), "provenance.exchange.v1.EventOrderCancelled")
-	proto.RegisterType((*EventOrderFilled)(nil), "provenance.exchange.v1.EventOrderFilled")
-	proto.RegisterType((*EventOrderPartiallyFilled)(nil), "provenance.exchange.v1.EventOrderPartiallyFilled")
-	proto.RegisterType((*EventOrderExternalIDUpdated)(nil), "provenance.exchange.v1.EventOrderExternalIDUpdated")
-	proto.RegisterType((*EventFundsCommitted)(nil), "provenance.exchange.v1.EventFundsCommitted")
-	proto.RegisterType((*EventCommitmentReleased)(nil), "provenance.exchange.v1.EventCommitmentReleased")
-	proto.RegisterType((*EventMarketWithdraw)(nil), "provenance.exchange.v1.EventMarketWithdraw")
-	proto.RegisterType((*EventMarketDetailsUpdated)(nil), "provenance.exchange.v1.EventMarketDetailsUpdated")
-	proto.RegisterType((*EventMarketEnabled)(nil), "provenance.exchange.v1.EventMarketEnabled")
-	proto.RegisterType((*EventMarketDisabled)(nil), "provenance.exchange.v1.EventMarketDisabled")
-	proto.RegisterType((*EventMarketOrdersEnabled)(nil), "provenance.exchange.v1.EventMarketOrdersEnabled")
-	proto.RegisterType((*EventMarketOrdersDisabled)(nil), "provenance.exchange.v1.EventMarketOrdersDisabled")
-	proto.RegisterType((*EventMarketUserSettleEnabled)(nil), "provenance.exchange.v1.EventMarketUserSettleEnabled")
-	proto.RegisterType((*EventMarketUserSettleDisabled)(nil), "provenance.exchange.v1.EventMarketUserSettleDisabled")
-	proto.RegisterType((*EventMarketCommitmentsEnabled)(nil), "provenance.exchange.v1.EventMarketCommitmentsEnabled")
-	proto.RegisterType((*EventMarketCommitmentsDisabled)(nil), "provenance.exchange.v1.EventMarketCommitmentsDisabled")
-	proto.RegisterType((*EventMarketIntermediaryDenomUpdated)(nil), "provenance.exchange.v1.EventMarketIntermediaryDenomUpdated")
-	proto.RegisterType((*EventMarketPermissionsUpdated)(nil), "provenance.exchange.v1.EventMarketPermissionsUpdated")
-	proto.RegisterType((*EventMarketReqAttrUpdated)(nil), "provenance.exchange.v1.EventMarketReqAttrUpdated")
-	proto.RegisterType((*EventMarketCreated)(nil), "provenance.exchange.v1.EventMarketCreated")
-	proto.RegisterType((*EventMarketFeesUpdated)(nil), "provenance.exchange.v1.EventMarketFeesUpdated")
-	proto.RegisterType((*EventParamsUpdated)(nil), "provenance.exchange.v1.EventParamsUpdated")
-	proto.RegisterType((*EventPaymentCreated)(nil), "provenance.exchange.v1.EventPaymentCreated")
-	proto.RegisterType((*EventPaymentUpdated)(nil), "provenance.exchange.v1.EventPaymentUpdated")
-	proto.RegisterType((*EventPaymentAccepted)(nil), "provenance.exchange.v1.EventPaymentAccepted")
-	proto.RegisterType((*EventPaymentRejected)(nil), "provenance.exchange.v1.EventPaymentRejected")
-	proto.RegisterType((*EventPaymentCancelled)(nil), "provenance.exchange.v1.EventPaymentCancelled")
+func (m *EventMarketWithdraw) GetWithdrawnBy() string {
+	if m != nil {
+		return m.WithdrawnBy
+	}
+	return ""
 }
 
-func init() {
-	proto.RegisterFile("provenance/exchange/v1/events.proto", fileDescriptor_c1b69385a348cffa)
+// EventMarketDetailsUpdated is an event emitted when a market's details are updated.
+type EventMarketDetailsUpdated struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// updated_by is the account that updated the details.
+	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 }
 
-var fileDescriptor_c1b69385a348cffa = []byte{
-	// 884 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x31, 0x6f, 0xe4, 0x44,
-	0x14, 0xce, 0x6c, 0x92, 0xbd, 0xdb, 0x97, 0x9c, 0x74, 0x98, 0x10, 0x36, 0x1c, 0xb7, 0x44, 0x4e,
-	0x93, 0xe6, 0x76, 0x2f, 0x20, 0x14, 0xe9, 0xa8, 0xb2, 0x97, 0x44, 0x4a, 0x81, 0x58, 0xf9, 0x72,
-	0x42, 0xa2, 0x59, 0x4d, 0xec, 0xc7, 0x66, 0xc0, 0x9e, 0xf1, 0xcd, 0xcc, 0x6e, 0x62, 0xf1, 0x13,
-	0x68, 0xae, 0xa0, 0x83, 0x92, 0x0e, 0xd1, 0x21, 0xfe, 0x00, 0x0d, 0xe5, 0x89, 0x8a, 0x12, 0x25,
-	0xf0, 0x3f, 0x90, 0x3d, 0x76, 0xd6, 0xce, 0xe6, 0xec, 0x15, 0xc8, 0x22, 0xa2, 0x9b, 0x19, 0xbf,
-	0xf7, 0xbe, 0xef, 0x7b, 0x9e, 0x79, 0x6f, 0x06, 0xb6, 0x42, 0x29, 0x26, 0xc8, 0x29, 0x77, 0xb1,
-	0x87, 0xe7, 0xee, 0x29, 0xe5, 0x23, 0xec, 0x4d, 0x76, 0x7a, 0x38, 0x41, 0xae, 0x55, 0x37, 0x94,
-	0x42, 0x0b, 0x6b, 0x7d, 0x6a, 0xd4, 0xcd, 0x8c, 0xba, 0x93, 0x9d, 0x77, 0x36, 0x5c, 0xa1, 0x02,
-	0xa1, 0x86, 0x89, 0x55, 0xcf, 0x4c, 0x8c, 0x8b, 0xfd, 0x35, 0x81, 0x37, 0x0e, 0xe2, 0x18, 0x9f,
-	0x48, 0x0f, 0xe5, 0x53, 0x89, 0x54, 0xa3, 0x67, 0x6d, 0xc0, 0x5d, 0x11, 0xcf, 0x87, 0xcc, 0x6b,
-	0x93, 0x4d, 0xb2, 0xbd, 0xe4, 0xdc, 0x49, 0xe6, 0x47, 0x9e, 0xf5, 0x10, 0xc0, 0x7c, 0xd2, 0x51,
-	0x88, 0xed, 0xc6, 0x26, 0xd9, 0x6e, 0x39, 0xad, 0x64, 0xe5, 0x38, 0x0a, 0xd1, 0x7a, 0x00, 0xad,
-	0x80, 0xca, 0x2f, 0x51, 0xc7, 0xae, 0x8b, 0x9b, 0x64, 0xfb, 0x9e, 0x73, 0xd7, 0x2c, 0x1c, 0x79,
-	0xd6, 0x7b, 0xb0, 0x82, 0xe7, 0x1a, 0x25, 0xa7, 0x7e, 0xfc, 0x79, 0x29, 0x71, 0x86, 0x6c, 0xe9,
-	0xc8, 0xb3, 0x7f, 0x20, 0xf0, 0x66, 0x8e, 0x4d, 0x2c, 0xc4, 0xf7, 0xcb, 0xf9, 0x7c, 0x04, 0xab,
-	0x6e, 0x66, 0x37, 0x3c, 0x89, 0x0c, 0xa3, 0x7e, 0xfb, 0xb7, 0x9f, 0x1e, 0xad, 0xa5, 0x42, 0xf7,
-	0x3c, 0x4f, 0xa2, 0x52, 0xcf, 0xb4, 0x64, 0x7c, 0xe4, 0xac, 0x5c, 0x59, 0xf7, 0xa3, 0x7f, 0xc9,
-	0xf6, 0x47, 0x02, 0xf7, 0xa7, 0x6c, 0x0f, 0x59, 0x15, 0xd5, 0x75, 0x68, 0x52, 0xa5, 0x50, 0xab,
-	0x34, 0x6d, 0xe9, 0xcc, 0x5a, 0x83, 0xe5, 0x50, 0x32, 0x17, 0x13, 0x06, 0x2d, 0xc7, 0x4c, 0x2c,
-	0x0b, 0x96, 0x3e, 0x47, 0x54, 0x29, 0x6e, 0x32, 0x2e, 0xf2, 0x5d, 0x2e, 0xe7, 0xdb, 0x9c, 0xe1,
-	0xfb, 0x33, 0x81, 0x8d, 0x29, 0xdf, 0x01, 0x95, 0x9a, 0x51, 0xdf, 0x8f, 0x6e, 0x3f, 0xf1, 0x09,
-	0x3c, 0x98, 0xf2, 0x3e, 0xc8, 0xd6, 0xf7, 0x9f, 0x87, 0x5e, 0xd5, 0x6e, 0x2d, 0xe0, 0x36, 0xca,
-	0x71, 0x17, 0x67, 0x70, 0x5f, 0x66, 0xdb, 0xf1, 0x70, 0xcc, 0x3d, 0xf5, 0x54, 0x04, 0x01, 0xd3,
-	0x31, 0xe0, 0xfb, 0x70, 0x87, 0xba, 0xae, 0x18, 0x73, 0x9d, 0xe0, 0x95, 0x6d, 0xb7, 0xcc, 0xb0,
-	0x9c, 0x49, 0x9c, 0xe0, 0x20, 0x89, 0xb7, 0x98, 0x26, 0x38, 0x99, 0x59, 0xf7, 0x61, 0x51, 0xd3,
-	0x51, 0x9a, 0xc9, 0x78, 0x68, 0x7f, 0x43, 0xe0, 0xed, 0x84, 0x92, 0x61, 0x13, 0x20, 0xd7, 0x0e,
-	0xfa, 0x48, 0xd5, 0x7f, 0x4b, 0xeb, 0x97, 0x2c, 0x53, 0x1f, 0x27, 0xbe, 0x9f, 0x32, 0x7d, 0xea,
-	0x49, 0x7a, 0x56, 0x0c, 0x4f, 0x5e, 0x1b, 0xbe, 0x51, 0x08, 0xff, 0x04, 0x56, 0x3c, 0x54, 0x9a,
-	0x71, 0xaa, 0x99, 0xe0, 0x06, 0xbb, 0xec, 0x44, 0xe7, 0x8c, 0xe3, 0x72, 0x70, 0x96, 0x82, 0xf3,
-	0xb8, 0x1c, 0x2c, 0x55, 0x39, 0x5f, 0x59, 0xf7, 0x23, 0xfb, 0x45, 0x7a, 0x3e, 0x8c, 0x88, 0x7d,
-	0xd4, 0x94, 0xf9, 0x2a, 0xdb, 0x65, 0xa5, 0x52, 0x76, 0x01, 0xc6, 0xc6, 0x6e, 0x9e, 0x1a, 0xd4,
-	0x4a, 0x6d, 0xfb, 0x91, 0xcd, 0xc1, 0xca, 0x41, 0x1e, 0x70, 0x7a, 0xe2, 0xd7, 0x85, 0xf5, 0xa4,
-	0xd1, 0x26, 0xb6, 0x28, 0xfc, 0xa7, 0x7d, 0xa6, 0xea, 0x06, 0x0c, 0xa1, 0x9d, 0x03, 0x4c, 0x4e,
-	0xb0, 0xaa, 0x55, 0xe6, 0xb5, 0xbf, 0x68, 0x10, 0xeb, 0x15, 0x6a, 0x6b, 0x78, 0x37, 0x07, 0xf9,
-	0x5c, 0xa1, 0x7c, 0x86, 0x5a, 0xfb, 0x58, 0xaf, 0xd0, 0x31, 0x3c, 0xbc, 0x11, 0xb5, 0x66, 0xb1,
-	0x45, 0xd8, 0x69, 0x1d, 0xaa, 0xf9, 0xb7, 0x4e, 0xa0, 0x73, 0x33, 0x6c, 0xcd, 0x72, 0xbf, 0x82,
-	0xad, 0x1c, 0xee, 0x11, 0xd7, 0x28, 0x03, 0xf4, 0x18, 0x95, 0xd1, 0x3e, 0x72, 0x11, 0xd4, 0x5b,
-	0x1e, 0x8a, 0xb9, 0x1e, 0xa0, 0x0c, 0x98, 0x52, 0x4c, 0xf0, 0x9a, 0xab, 0x52, 0xf1, 0x08, 0x39,
-	0xf8, 0x62, 0x4f, 0x6b, 0x59, 0x2f, 0xe4, 0x4e, 0xa1, 0x10, 0x66, 0x17, 0xd1, 0x32, 0x2c, 0xfb,
-	0x43, 0x58, 0xcf, 0xb9, 0x1c, 0x22, 0xce, 0x95, 0x15, 0x7b, 0x2d, 0x45, 0x1a, 0x50, 0x49, 0x83,
-	0xcc, 0xc5, 0xfe, 0x33, 0xeb, 0x60, 0x03, 0x1a, 0xc5, 0xdb, 0x2a, 0x63, 0xf0, 0x18, 0x9a, 0x4a,
-	0x8c, 0xa5, 0x8b, 0x95, 0x3d, 0x35, 0xb5, 0xb3, 0xb6, 0xe0, 0x9e, 0x19, 0x0d, 0x0b, 0xdd, 0x6d,
-	0xd5, 0x2c, 0xee, 0x99, 0x1e, 0xf7, 0x18, 0x9a, 0x9a, 0xca, 0x11, 0xea, 0xca, 0xf6, 0x96, 0xda,
-	0xc5, 0x61, 0xcd, 0x28, 0x0b, 0x6b, 0xda, 0xef, 0xaa, 0x59, 0x4c, 0xc3, 0x5e, 0xbb, 0xd2, 0x2c,
-	0xcf, 0x5c, 0x69, 0xbe, 0x6f, 0x14, 0x65, 0x66, 0x19, 0xab, 0x49, 0xe6, 0x2e, 0x80, 0xf0, 0xbd,
-	0xe1, 0x9c, 0x52, 0x5b, 0xc2, 0xf7, 0x8e, 0x8d, 0xda, 0x5d, 0x00, 0x8e, 0x67, 0x99, 0x63, 0x55,
-	0x17, 0x6f, 0x71, 0x3c, 0x3b, 0x7e, 0x4d, 0x9a, 0x96, 0xab, 0xd3, 0x34, 0x7b, 0xe3, 0xfc, 0x8b,
-	0xc0, 0x5a, 0x3e, 0x4d, 0x7b, 0xae, 0x8b, 0xe1, 0xff, 0x70, 0x3b, 0x7c, 0x7b, 0x4d, 0xa7, 0x83,
-	0x5f, 0xa0, 0xfb, 0xcf, 0x74, 0x4e, 0x25, 0x34, 0xe6, 0x94, 0x50, 0x79, 0xff, 0xfe, 0x8e, 0xc0,
-	0x5b, 0x85, 0x33, 0x79, 0xf5, 0x20, 0xbc, 0x0d, 0xf4, 0xfa, 0xf8, 0xeb, 0x45, 0x87, 0xbc, 0xba,
-	0xe8, 0x90, 0x3f, 0x2e, 0x3a, 0xe4, 0xe5, 0x65, 0x67, 0xe1, 0xd5, 0x65, 0x67, 0xe1, 0xf7, 0xcb,
-	0xce, 0x02, 0x6c, 0x30, 0xd1, 0xbd, 0xf9, 0x2d, 0x3e, 0x20, 0x9f, 0x75, 0x47, 0x4c, 0x9f, 0x8e,
-	0x4f, 0xba, 0xae, 0x08, 0x7a, 0x53, 0xa3, 0x47, 0x4c, 0xe4, 0x66, 0xbd, 0xf3, 0xab, 0x57, 0xfe,
-	0x49, 0x33, 0x79, 0xa9, 0x7f, 0xf0, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x47, 0x79, 0x48, 0x20,
-	0x03, 0x10, 0x00, 0x00,
+func (m *EventMarketDetailsUpdated) Reset()         { *m = EventMarketDetailsUpdated{} }
+func (m *EventMarketDetailsUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventMarketDetailsUpdated) ProtoMessage()    {}
+func (*EventMarketDetailsUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{29}
 }
-
-func (m *EventOrderCreated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarketDetailsUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketDetailsUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketDetailsUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventOrderCreated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarketDetailsUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketDetailsUpdated.Merge(m, src)
+}
+func (m *EventMarketDetailsUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketDetailsUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketDetailsUpdated.DiscardUnknown(m)
 }
 
-func (m *EventOrderCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExternalId) > 0 {
-		i -= len(m.ExternalId)
-		copy(dAtA[i:], m.ExternalId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.OrderType) > 0 {
-		i -= len(m.OrderType)
-		copy(dAtA[i:], m.OrderType)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderType)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.OrderId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.OrderId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_EventMarketDetailsUpdated proto.InternalMessageInfo
+
+func (m *EventMarketDetailsUpdated) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventOrderCancelled) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarketDetailsUpdated) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventOrderCancelled) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarketEnabled is an event emitted when a market is enabled.
+// Deprecated: This event is no longer used. It is replaced with EventMarketOrdersEnabled.
+//
+// Deprecated: Do not use.
+type EventMarketEnabled struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// updated_by is the account that enabled the market.
+	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 }
 
-func (m *EventOrderCancelled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExternalId) > 0 {
-		i -= len(m.ExternalId)
-		copy(dAtA[i:], m.ExternalId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.CancelledBy) > 0 {
-		i -= len(m.CancelledBy)
-		copy(dAtA[i:], m.CancelledBy)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.CancelledBy)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.OrderId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.OrderId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *EventMarketEnabled) Reset()         { *m = EventMarketEnabled{} }
+func (m *EventMarketEnabled) String() string { return proto.CompactTextString(m) }
+func (*EventMarketEnabled) ProtoMessage()    {}
+func (*EventMarketEnabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{30}
 }
-
-func (m *EventOrderFilled) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarketEnabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketEnabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketEnabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventOrderFilled) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarketEnabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketEnabled.Merge(m, src)
+}
+func (m *EventMarketEnabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketEnabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketEnabled.DiscardUnknown(m)
 }
 
-func (m *EventOrderFilled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExternalId) > 0 {
-		i -= len(m.ExternalId)
-		copy(dAtA[i:], m.ExternalId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Fees) > 0 {
-		i -= len(m.Fees)
-		copy(dAtA[i:], m.Fees)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fees)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Price) > 0 {
-		i -= len(m.Price)
-		copy(dAtA[i:], m.Price)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Assets) > 0 {
-		i -= len(m.Assets)
-		copy(dAtA[i:], m.Assets)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Assets)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.OrderId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.OrderId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_EventMarketEnabled proto.InternalMessageInfo
+
+func (m *EventMarketEnabled) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventOrderPartiallyFilled) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarketEnabled) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventOrderPartiallyFilled) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarketDisabled is an event emitted when a market is disabled.
+// Deprecated: This event is no longer used. It is replaced with EventMarketOrdersDisabled.
+//
+// Deprecated: Do not use.
+type EventMarketDisabled struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// updated_by is the account that disabled the market.
+	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 }
 
-func (m *EventOrderPartiallyFilled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExternalId) > 0 {
-		i -= len(m.ExternalId)
-		copy(dAtA[i:], m.ExternalId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Fees) > 0 {
-		i -= len(m.Fees)
-		copy(dAtA[i:], m.Fees)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fees)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Price) > 0 {
-		i -= len(m.Price)
-		copy(dAtA[i:], m.Price)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Assets) > 0 {
-		i -= len(m.Assets)
-		copy(dAtA[i:], m.Assets)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Assets)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventMarketDisabled) Reset()         { *m = EventMarketDisabled{} }
+func (m *EventMarketDisabled) String() string { return proto.CompactTextString(m) }
+func (*EventMarketDisabled) ProtoMessage()    {}
+func (*EventMarketDisabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{31}
+}
+func (m *EventMarketDisabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketDisabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketDisabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.OrderId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.OrderId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *EventMarketDisabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketDisabled.Merge(m, src)
+}
+func (m *EventMarketDisabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketDisabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketDisabled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarketDisabled proto.InternalMessageInfo
+
+func (m *EventMarketDisabled) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventOrderExternalIDUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarketDisabled) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventOrderExternalIDUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarketOrdersEnabled is an event emitted when a market enables order creation.
+type EventMarketOrdersEnabled struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// updated_by is the account that updated the accepting_orders option.
+	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 }
 
-func (m *EventOrderExternalIDUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExternalId) > 0 {
-		i -= len(m.ExternalId)
-		copy(dAtA[i:], m.ExternalId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x10
+func (m *EventMarketOrdersEnabled) Reset()         { *m = EventMarketOrdersEnabled{} }
+func (m *EventMarketOrdersEnabled) String() string { return proto.CompactTextString(m) }
+func (*EventMarketOrdersEnabled) ProtoMessage()    {}
+func (*EventMarketOrdersEnabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{32}
+}
+func (m *EventMarketOrdersEnabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketOrdersEnabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketOrdersEnabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.OrderId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.OrderId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *EventMarketOrdersEnabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketOrdersEnabled.Merge(m, src)
+}
+func (m *EventMarketOrdersEnabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketOrdersEnabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketOrdersEnabled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarketOrdersEnabled proto.InternalMessageInfo
+
+func (m *EventMarketOrdersEnabled) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventFundsCommitted) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarketOrdersEnabled) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventFundsCommitted) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarketOrdersEnabled is an event emitted when a market disables order creation.
+type EventMarketOrdersDisabled struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// updated_by is the account that updated the accepting_orders option.
+	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 }
 
-func (m *EventFundsCommitted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Tag) > 0 {
-		i -= len(m.Tag)
-		copy(dAtA[i:], m.Tag)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Tag)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x10
+func (m *EventMarketOrdersDisabled) Reset()         { *m = EventMarketOrdersDisabled{} }
+func (m *EventMarketOrdersDisabled) String() string { return proto.CompactTextString(m) }
+func (*EventMarketOrdersDisabled) ProtoMessage()    {}
+func (*EventMarketOrdersDisabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{33}
+}
+func (m *EventMarketOrdersDisabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketOrdersDisabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketOrdersDisabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventMarketOrdersDisabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketOrdersDisabled.Merge(m, src)
+}
+func (m *EventMarketOrdersDisabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketOrdersDisabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketOrdersDisabled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarketOrdersDisabled proto.InternalMessageInfo
+
+func (m *EventMarketOrdersDisabled) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventCommitmentReleased) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarketOrdersDisabled) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventCommitmentReleased) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarketUserSettleEnabled is an event emitted when a market's user_settle option is enabled.
+type EventMarketUserSettleEnabled struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// updated_by is the account that updated the user_settle option.
+	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 }
 
-func (m *EventCommitmentReleased) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Tag) > 0 {
-		i -= len(m.Tag)
-		copy(dAtA[i:], m.Tag)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Tag)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x10
+func (m *EventMarketUserSettleEnabled) Reset()         { *m = EventMarketUserSettleEnabled{} }
+func (m *EventMarketUserSettleEnabled) String() string { return proto.CompactTextString(m) }
+func (*EventMarketUserSettleEnabled) ProtoMessage()    {}
+func (*EventMarketUserSettleEnabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{34}
+}
+func (m *EventMarketUserSettleEnabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketUserSettleEnabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketUserSettleEnabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventMarketUserSettleEnabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketUserSettleEnabled.Merge(m, src)
+}
+func (m *EventMarketUserSettleEnabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketUserSettleEnabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketUserSettleEnabled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarketUserSettleEnabled proto.InternalMessageInfo
+
+func (m *EventMarketUserSettleEnabled) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventMarketWithdraw) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarketUserSettleEnabled) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarketWithdraw) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarketUserSettleDisabled is an event emitted when a market's user_settle option is disabled.
+type EventMarketUserSettleDisabled struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// updated_by is the account that updated the user_settle option.
+	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 }
 
-func (m *EventMarketWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.WithdrawnBy) > 0 {
-		i -= len(m.WithdrawnBy)
-		copy(dAtA[i:], m.WithdrawnBy)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.WithdrawnBy)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Destination) > 0 {
-		i -= len(m.Destination)
-		copy(dAtA[i:], m.Destination)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Destination)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *EventMarketUserSettleDisabled) Reset()         { *m = EventMarketUserSettleDisabled{} }
+func (m *EventMarketUserSettleDisabled) String() string { return proto.CompactTextString(m) }
+func (*EventMarketUserSettleDisabled) ProtoMessage()    {}
+func (*EventMarketUserSettleDisabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{35}
 }
-
-func (m *EventMarketDetailsUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarketUserSettleDisabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketUserSettleDisabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketUserSettleDisabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventMarketDetailsUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarketUserSettleDisabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketUserSettleDisabled.Merge(m, src)
+}
+func (m *EventMarketUserSettleDisabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketUserSettleDisabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketUserSettleDisabled.DiscardUnknown(m)
 }
 
-func (m *EventMarketDetailsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UpdatedBy) > 0 {
-		i -= len(m.UpdatedBy)
-		copy(dAtA[i:], m.UpdatedBy)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_EventMarketUserSettleDisabled proto.InternalMessageInfo
+
+func (m *EventMarketUserSettleDisabled) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventMarketEnabled) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarketUserSettleDisabled) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarketEnabled) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarketCommitmentsEnabled is an event emitted when a market's accepting_commitments option is enabled.
+type EventMarketCommitmentsEnabled struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// updated_by is the account that updated the accepting_commitments option.
+	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 }
 
-func (m *EventMarketEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UpdatedBy) > 0 {
-		i -= len(m.UpdatedBy)
-		copy(dAtA[i:], m.UpdatedBy)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *EventMarketCommitmentsEnabled) Reset()         { *m = EventMarketCommitmentsEnabled{} }
+func (m *EventMarketCommitmentsEnabled) String() string { return proto.CompactTextString(m) }
+func (*EventMarketCommitmentsEnabled) ProtoMessage()    {}
+func (*EventMarketCommitmentsEnabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{36}
 }
-
-func (m *EventMarketDisabled) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarketCommitmentsEnabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketCommitmentsEnabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketCommitmentsEnabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventMarketDisabled) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarketCommitmentsEnabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketCommitmentsEnabled.Merge(m, src)
+}
+func (m *EventMarketCommitmentsEnabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketCommitmentsEnabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketCommitmentsEnabled.DiscardUnknown(m)
 }
 
-func (m *EventMarketDisabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UpdatedBy) > 0 {
-		i -= len(m.UpdatedBy)
-		copy(dAtA[i:], m.UpdatedBy)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_EventMarketCommitmentsEnabled proto.InternalMessageInfo
+
+func (m *EventMarketCommitmentsEnabled) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventMarketOrdersEnabled) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarketCommitmentsEnabled) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarketOrdersEnabled) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarketCommitmentsDisabled is an event emitted when a market's accepting_commitments option is disabled.
+type EventMarketCommitmentsDisabled struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// updated_by is the account that updated the accepting_commitments option.
+	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 }
 
-func (m *EventMarketOrdersEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UpdatedBy) > 0 {
-		i -= len(m.UpdatedBy)
-		copy(dAtA[i:], m.UpdatedBy)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *EventMarketCommitmentsDisabled) Reset()         { *m = EventMarketCommitmentsDisabled{} }
+func (m *EventMarketCommitmentsDisabled) String() string { return proto.CompactTextString(m) }
+func (*EventMarketCommitmentsDisabled) ProtoMessage()    {}
+func (*EventMarketCommitmentsDisabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{37}
 }
-
-func (m *EventMarketOrdersDisabled) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarketCommitmentsDisabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketCommitmentsDisabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketCommitmentsDisabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventMarketOrdersDisabled) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarketCommitmentsDisabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketCommitmentsDisabled.Merge(m, src)
+}
+func (m *EventMarketCommitmentsDisabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketCommitmentsDisabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketCommitmentsDisabled.DiscardUnknown(m)
 }
 
-func (m *EventMarketOrdersDisabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UpdatedBy) > 0 {
-		i -= len(m.UpdatedBy)
-		copy(dAtA[i:], m.UpdatedBy)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_EventMarketCommitmentsDisabled proto.InternalMessageInfo
+
+func (m *EventMarketCommitmentsDisabled) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventMarketUserSettleEnabled) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarketCommitmentsDisabled) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarketUserSettleEnabled) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarketContinuousMatchingEnabled is an event emitted when a market's continuous_matching option is enabled.
+type EventMarketContinuousMatchingEnabled struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// updated_by is the account that updated the continuous_matching option.
+	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 }
 
-func (m *EventMarketUserSettleEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UpdatedBy) > 0 {
-		i -= len(m.UpdatedBy)
-		copy(dAtA[i:], m.UpdatedBy)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventMarketContinuousMatchingEnabled) Reset()         { *m = EventMarketContinuousMatchingEnabled{} }
+func (m *EventMarketContinuousMatchingEnabled) String() string { return proto.CompactTextString(m) }
+func (*EventMarketContinuousMatchingEnabled) ProtoMessage()    {}
+func (*EventMarketContinuousMatchingEnabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{38}
+}
+func (m *EventMarketContinuousMatchingEnabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketContinuousMatchingEnabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketContinuousMatchingEnabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *EventMarketContinuousMatchingEnabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketContinuousMatchingEnabled.Merge(m, src)
+}
+func (m *EventMarketContinuousMatchingEnabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketContinuousMatchingEnabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketContinuousMatchingEnabled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarketContinuousMatchingEnabled proto.InternalMessageInfo
+
+func (m *EventMarketContinuousMatchingEnabled) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventMarketUserSettleDisabled) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarketContinuousMatchingEnabled) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarketUserSettleDisabled) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarketContinuousMatchingDisabled is an event emitted when a market's continuous_matching option is disabled.
+type EventMarketContinuousMatchingDisabled struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// updated_by is the account that updated the continuous_matching option.
+	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 }
 
-func (m *EventMarketUserSettleDisabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UpdatedBy) > 0 {
-		i -= len(m.UpdatedBy)
-		copy(dAtA[i:], m.UpdatedBy)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventMarketContinuousMatchingDisabled) Reset()         { *m = EventMarketContinuousMatchingDisabled{} }
+func (m *EventMarketContinuousMatchingDisabled) String() string { return proto.CompactTextString(m) }
+func (*EventMarketContinuousMatchingDisabled) ProtoMessage()    {}
+func (*EventMarketContinuousMatchingDisabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{39}
+}
+func (m *EventMarketContinuousMatchingDisabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketContinuousMatchingDisabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketContinuousMatchingDisabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *EventMarketContinuousMatchingDisabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketContinuousMatchingDisabled.Merge(m, src)
+}
+func (m *EventMarketContinuousMatchingDisabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketContinuousMatchingDisabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketContinuousMatchingDisabled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarketContinuousMatchingDisabled proto.InternalMessageInfo
+
+func (m *EventMarketContinuousMatchingDisabled) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventMarketCommitmentsEnabled) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarketContinuousMatchingDisabled) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarketCommitmentsEnabled) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarketBatchAuctionUpdated is an event emitted when a market's batch_auction_interval is updated.
+type EventMarketBatchAuctionUpdated struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// updated_by is the account that updated the batch_auction_interval.
+	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 }
 
-func (m *EventMarketCommitmentsEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UpdatedBy) > 0 {
-		i -= len(m.UpdatedBy)
-		copy(dAtA[i:], m.UpdatedBy)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventMarketBatchAuctionUpdated) Reset()         { *m = EventMarketBatchAuctionUpdated{} }
+func (m *EventMarketBatchAuctionUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventMarketBatchAuctionUpdated) ProtoMessage()    {}
+func (*EventMarketBatchAuctionUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{40}
+}
+func (m *EventMarketBatchAuctionUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketBatchAuctionUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketBatchAuctionUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *EventMarketBatchAuctionUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketBatchAuctionUpdated.Merge(m, src)
+}
+func (m *EventMarketBatchAuctionUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketBatchAuctionUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketBatchAuctionUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarketBatchAuctionUpdated proto.InternalMessageInfo
+
+func (m *EventMarketBatchAuctionUpdated) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventMarketCommitmentsDisabled) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarketBatchAuctionUpdated) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarketCommitmentsDisabled) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarketSelfTradePreventionUpdated is an event emitted when a market's self_trade_prevention is updated.
+type EventMarketSelfTradePreventionUpdated struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// updated_by is the account that updated the self_trade_prevention.
+	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 }
 
-func (m *EventMarketCommitmentsDisabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UpdatedBy) > 0 {
-		i -= len(m.UpdatedBy)
-		copy(dAtA[i:], m.UpdatedBy)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventMarketSelfTradePreventionUpdated) Reset()         { *m = EventMarketSelfTradePreventionUpdated{} }
+func (m *EventMarketSelfTradePreventionUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventMarketSelfTradePreventionUpdated) ProtoMessage()    {}
+func (*EventMarketSelfTradePreventionUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{41}
+}
+func (m *EventMarketSelfTradePreventionUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketSelfTradePreventionUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketSelfTradePreventionUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *EventMarketSelfTradePreventionUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketSelfTradePreventionUpdated.Merge(m, src)
+}
+func (m *EventMarketSelfTradePreventionUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketSelfTradePreventionUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketSelfTradePreventionUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarketSelfTradePreventionUpdated proto.InternalMessageInfo
+
+func (m *EventMarketSelfTradePreventionUpdated) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventMarketIntermediaryDenomUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarketSelfTradePreventionUpdated) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarketIntermediaryDenomUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarketPublishPricesEnabled is an event emitted when a market's publish_prices option is enabled.
+type EventMarketPublishPricesEnabled struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// updated_by is the account that updated the publish_prices option.
+	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 }
 
-func (m *EventMarketIntermediaryDenomUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UpdatedBy) > 0 {
-		i -= len(m.UpdatedBy)
-		copy(dAtA[i:], m.UpdatedBy)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventMarketPublishPricesEnabled) Reset()         { *m = EventMarketPublishPricesEnabled{} }
+func (m *EventMarketPublishPricesEnabled) String() string { return proto.CompactTextString(m) }
+func (*EventMarketPublishPricesEnabled) ProtoMessage()    {}
+func (*EventMarketPublishPricesEnabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{42}
+}
+func (m *EventMarketPublishPricesEnabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketPublishPricesEnabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketPublishPricesEnabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *EventMarketPublishPricesEnabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketPublishPricesEnabled.Merge(m, src)
+}
+func (m *EventMarketPublishPricesEnabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketPublishPricesEnabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketPublishPricesEnabled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarketPublishPricesEnabled proto.InternalMessageInfo
+
+func (m *EventMarketPublishPricesEnabled) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventMarketPermissionsUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarketPublishPricesEnabled) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarketPermissionsUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarketPublishPricesDisabled is an event emitted when a market's publish_prices option is disabled.
+type EventMarketPublishPricesDisabled struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// updated_by is the account that updated the publish_prices option.
+	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 }
 
-func (m *EventMarketPermissionsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UpdatedBy) > 0 {
-		i -= len(m.UpdatedBy)
-		copy(dAtA[i:], m.UpdatedBy)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
+func (m *EventMarketPublishPricesDisabled) Reset()         { *m = EventMarketPublishPricesDisabled{} }
+func (m *EventMarketPublishPricesDisabled) String() string { return proto.CompactTextString(m) }
+func (*EventMarketPublishPricesDisabled) ProtoMessage()    {}
+func (*EventMarketPublishPricesDisabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{43}
+}
+func (m *EventMarketPublishPricesDisabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketPublishPricesDisabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketPublishPricesDisabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventMarketPublishPricesDisabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketPublishPricesDisabled.Merge(m, src)
+}
+func (m *EventMarketPublishPricesDisabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketPublishPricesDisabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketPublishPricesDisabled.DiscardUnknown(m)
 }
 
-func (m *EventMarketReqAttrUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventMarketPublishPricesDisabled proto.InternalMessageInfo
+
+func (m *EventMarketPublishPricesDisabled) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventMarketReqAttrUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarketPublishPricesDisabled) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
+	}
+	return ""
 }
 
-func (m *EventMarketReqAttrUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UpdatedBy) > 0 {
-		i -= len(m.UpdatedBy)
-		copy(dAtA[i:], m.UpdatedBy)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// EventMarketExternalIDScopeUpdated is an event emitted when a market's external_id_scope is updated.
+type EventMarketExternalIDScopeUpdated struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// updated_by is the account that updated the external_id_scope.
+	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 }
 
-func (m *EventMarketCreated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarketExternalIDScopeUpdated) Reset()         { *m = EventMarketExternalIDScopeUpdated{} }
+func (m *EventMarketExternalIDScopeUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventMarketExternalIDScopeUpdated) ProtoMessage()    {}
+func (*EventMarketExternalIDScopeUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{44}
+}
+func (m *EventMarketExternalIDScopeUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketExternalIDScopeUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketExternalIDScopeUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventMarketCreated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarketExternalIDScopeUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketExternalIDScopeUpdated.Merge(m, src)
+}
+func (m *EventMarketExternalIDScopeUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketExternalIDScopeUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketExternalIDScopeUpdated.DiscardUnknown(m)
 }
 
-func (m *EventMarketCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_EventMarketExternalIDScopeUpdated proto.InternalMessageInfo
+
+func (m *EventMarketExternalIDScopeUpdated) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventMarketFeesUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarketExternalIDScopeUpdated) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarketFeesUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarketOrderRateLimitUpdated is an event emitted when a market's order_rate_limit is updated.
+type EventMarketOrderRateLimitUpdated struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// updated_by is the account that updated the order_rate_limit.
+	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 }
 
-func (m *EventMarketFeesUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
+func (m *EventMarketOrderRateLimitUpdated) Reset()         { *m = EventMarketOrderRateLimitUpdated{} }
+func (m *EventMarketOrderRateLimitUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventMarketOrderRateLimitUpdated) ProtoMessage()    {}
+func (*EventMarketOrderRateLimitUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{45}
+}
+func (m *EventMarketOrderRateLimitUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketOrderRateLimitUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketOrderRateLimitUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventMarketOrderRateLimitUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketOrderRateLimitUpdated.Merge(m, src)
+}
+func (m *EventMarketOrderRateLimitUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketOrderRateLimitUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketOrderRateLimitUpdated.DiscardUnknown(m)
 }
 
-func (m *EventParamsUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventMarketOrderRateLimitUpdated proto.InternalMessageInfo
+
+func (m *EventMarketOrderRateLimitUpdated) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventParamsUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarketOrderRateLimitUpdated) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
+	}
+	return ""
 }
 
-func (m *EventParamsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// EventMarketMaxExposureUpdated is an event emitted when a market's max_exposure is updated.
+type EventMarketMaxExposureUpdated struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// updated_by is the account that updated the max_exposure.
+	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 }
 
-func (m *EventPaymentCreated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarketMaxExposureUpdated) Reset()         { *m = EventMarketMaxExposureUpdated{} }
+func (m *EventMarketMaxExposureUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventMarketMaxExposureUpdated) ProtoMessage()    {}
+func (*EventMarketMaxExposureUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{46}
+}
+func (m *EventMarketMaxExposureUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketMaxExposureUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketMaxExposureUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *EventMarketMaxExposureUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketMaxExposureUpdated.Merge(m, src)
+}
+func (m *EventMarketMaxExposureUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketMaxExposureUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketMaxExposureUpdated.DiscardUnknown(m)
 }
 
-func (m *EventPaymentCreated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_EventMarketMaxExposureUpdated proto.InternalMessageInfo
+
+func (m *EventMarketMaxExposureUpdated) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
 }
 
-func (m *EventPaymentCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExternalId) > 0 {
-		i -= len(m.ExternalId)
-		copy(dAtA[i:], m.ExternalId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.TargetAmount) > 0 {
-		i -= len(m.TargetAmount)
-		copy(dAtA[i:], m.TargetAmount)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.TargetAmount)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Target) > 0 {
-		i -= len(m.Target)
-		copy(dAtA[i:], m.Target)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Target)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.SourceAmount) > 0 {
-		i -= len(m.SourceAmount)
-		copy(dAtA[i:], m.SourceAmount)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SourceAmount)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventMarketMaxExposureUpdated) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventPaymentUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// EventMarketFillAlgorithmUpdated is an event emitted when a market's fill_algorithm is updated.
+type EventMarketFillAlgorithmUpdated struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// updated_by is the account that updated the fill_algorithm.
+	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 }
 
-func (m *EventPaymentUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarketFillAlgorithmUpdated) Reset()         { *m = EventMarketFillAlgorithmUpdated{} }
+func (m *EventMarketFillAlgorithmUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventMarketFillAlgorithmUpdated) ProtoMessage()    {}
+func (*EventMarketFillAlgorithmUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{47}
 }
-
-func (m *EventPaymentUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExternalId) > 0 {
-		i -= len(m.ExternalId)
-		copy(dAtA[i:], m.ExternalId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.TargetAmount) > 0 {
-		i -= len(m.TargetAmount)
-		copy(dAtA[i:], m.TargetAmount)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.TargetAmount)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.NewTarget) > 0 {
-		i -= len(m.NewTarget)
-		copy(dAtA[i:], m.NewTarget)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewTarget)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.OldTarget) > 0 {
-		i -= len(m.OldTarget)
-		copy(dAtA[i:], m.OldTarget)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.OldTarget)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.SourceAmount) > 0 {
-		i -= len(m.SourceAmount)
-		copy(dAtA[i:], m.SourceAmount)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SourceAmount)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventMarketFillAlgorithmUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketFillAlgorithmUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketFillAlgorithmUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventMarketFillAlgorithmUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketFillAlgorithmUpdated.Merge(m, src)
+}
+func (m *EventMarketFillAlgorithmUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketFillAlgorithmUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketFillAlgorithmUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarketFillAlgorithmUpdated proto.InternalMessageInfo
+
+func (m *EventMarketFillAlgorithmUpdated) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventPaymentAccepted) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarketFillAlgorithmUpdated) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventPaymentAccepted) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarketIntermediaryDenomUpdated is an event emitted when a market updates its
+// commitment_settlement_intermediary_denom field.
+type EventMarketIntermediaryDenomUpdated struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// updated_by is the account that updated the intermediary denom.
+	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 }
 
-func (m *EventPaymentAccepted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExternalId) > 0 {
-		i -= len(m.ExternalId)
-		copy(dAtA[i:], m.ExternalId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.TargetAmount) > 0 {
-		i -= len(m.TargetAmount)
-		copy(dAtA[i:], m.TargetAmount)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.TargetAmount)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Target) > 0 {
-		i -= len(m.Target)
-		copy(dAtA[i:], m.Target)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Target)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.SourceAmount) > 0 {
-		i -= len(m.SourceAmount)
-		copy(dAtA[i:], m.SourceAmount)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SourceAmount)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventMarketIntermediaryDenomUpdated) Reset()         { *m = EventMarketIntermediaryDenomUpdated{} }
+func (m *EventMarketIntermediaryDenomUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventMarketIntermediaryDenomUpdated) ProtoMessage()    {}
+func (*EventMarketIntermediaryDenomUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{48}
+}
+func (m *EventMarketIntermediaryDenomUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketIntermediaryDenomUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketIntermediaryDenomUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventMarketIntermediaryDenomUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketIntermediaryDenomUpdated.Merge(m, src)
+}
+func (m *EventMarketIntermediaryDenomUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketIntermediaryDenomUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketIntermediaryDenomUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarketIntermediaryDenomUpdated proto.InternalMessageInfo
+
+func (m *EventMarketIntermediaryDenomUpdated) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventPaymentRejected) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarketIntermediaryDenomUpdated) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventPaymentRejected) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarketPermissionsUpdated is an event emitted when a market's permissions are updated.
+type EventMarketPermissionsUpdated struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// updated_by is the account that updated the permissions.
+	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 }
 
-func (m *EventPaymentRejected) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExternalId) > 0 {
-		i -= len(m.ExternalId)
-		copy(dAtA[i:], m.ExternalId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Target) > 0 {
-		i -= len(m.Target)
-		copy(dAtA[i:], m.Target)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Target)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventMarketPermissionsUpdated) Reset()         { *m = EventMarketPermissionsUpdated{} }
+func (m *EventMarketPermissionsUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventMarketPermissionsUpdated) ProtoMessage()    {}
+func (*EventMarketPermissionsUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{49}
+}
+func (m *EventMarketPermissionsUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketPermissionsUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketPermissionsUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventMarketPermissionsUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketPermissionsUpdated.Merge(m, src)
+}
+func (m *EventMarketPermissionsUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketPermissionsUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketPermissionsUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarketPermissionsUpdated proto.InternalMessageInfo
+
+func (m *EventMarketPermissionsUpdated) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventPaymentCancelled) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarketPermissionsUpdated) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventPaymentCancelled) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarketAuthorityTransferProposed is an event emitted when a transfer of a market's authority is proposed.
+type EventMarketAuthorityTransferProposed struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// proposed_by is the account that proposed the transfer.
+	ProposedBy string `protobuf:"bytes,2,opt,name=proposed_by,json=proposedBy,proto3" json:"proposed_by,omitempty"`
+	// new_authority is the account that must accept the transfer.
+	NewAuthority string `protobuf:"bytes,3,opt,name=new_authority,json=newAuthority,proto3" json:"new_authority,omitempty"`
 }
 
-func (m *EventPaymentCancelled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExternalId) > 0 {
-		i -= len(m.ExternalId)
-		copy(dAtA[i:], m.ExternalId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Target) > 0 {
-		i -= len(m.Target)
-		copy(dAtA[i:], m.Target)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Target)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventMarketAuthorityTransferProposed) Reset()         { *m = EventMarketAuthorityTransferProposed{} }
+func (m *EventMarketAuthorityTransferProposed) String() string { return proto.CompactTextString(m) }
+func (*EventMarketAuthorityTransferProposed) ProtoMessage()    {}
+func (*EventMarketAuthorityTransferProposed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{50}
+}
+func (m *EventMarketAuthorityTransferProposed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketAuthorityTransferProposed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketAuthorityTransferProposed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventMarketAuthorityTransferProposed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketAuthorityTransferProposed.Merge(m, src)
+}
+func (m *EventMarketAuthorityTransferProposed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketAuthorityTransferProposed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketAuthorityTransferProposed.DiscardUnknown(m)
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_EventMarketAuthorityTransferProposed proto.InternalMessageInfo
+
+func (m *EventMarketAuthorityTransferProposed) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *EventOrderCreated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OrderId != 0 {
-		n += 1 + sovEvents(uint64(m.OrderId))
-	}
-	l = len(m.OrderType)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
-	}
-	l = len(m.ExternalId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+
+func (m *EventMarketAuthorityTransferProposed) GetProposedBy() string {
+	if m != nil {
+		return m.ProposedBy
 	}
-	return n
+	return ""
 }
 
-func (m *EventOrderCancelled) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OrderId != 0 {
-		n += 1 + sovEvents(uint64(m.OrderId))
-	}
-	l = len(m.CancelledBy)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
-	}
-	l = len(m.ExternalId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventMarketAuthorityTransferProposed) GetNewAuthority() string {
+	if m != nil {
+		return m.NewAuthority
 	}
-	return n
+	return ""
 }
 
-func (m *EventOrderFilled) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OrderId != 0 {
-		n += 1 + sovEvents(uint64(m.OrderId))
-	}
-	l = len(m.Assets)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Price)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Fees)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
-	}
-	l = len(m.ExternalId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
+// EventMarketAuthorityTransferred is an event emitted when a market's access grants are replaced
+// because the new authority accepted a transfer.
+type EventMarketAuthorityTransferred struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// proposed_by is the account that proposed the transfer.
+	ProposedBy string `protobuf:"bytes,2,opt,name=proposed_by,json=proposedBy,proto3" json:"proposed_by,omitempty"`
+	// new_authority is the account that accepted the transfer.
+	NewAuthority string `protobuf:"bytes,3,opt,name=new_authority,json=newAuthority,proto3" json:"new_authority,omitempty"`
 }
 
-func (m *EventOrderPartiallyFilled) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OrderId != 0 {
-		n += 1 + sovEvents(uint64(m.OrderId))
-	}
-	l = len(m.Assets)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Price)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Fees)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
-	}
-	l = len(m.ExternalId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventMarketAuthorityTransferred) Reset()         { *m = EventMarketAuthorityTransferred{} }
+func (m *EventMarketAuthorityTransferred) String() string { return proto.CompactTextString(m) }
+func (*EventMarketAuthorityTransferred) ProtoMessage()    {}
+func (*EventMarketAuthorityTransferred) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{51}
+}
+func (m *EventMarketAuthorityTransferred) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketAuthorityTransferred) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketAuthorityTransferred.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *EventMarketAuthorityTransferred) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketAuthorityTransferred.Merge(m, src)
+}
+func (m *EventMarketAuthorityTransferred) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketAuthorityTransferred) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketAuthorityTransferred.DiscardUnknown(m)
 }
 
-func (m *EventOrderExternalIDUpdated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OrderId != 0 {
-		n += 1 + sovEvents(uint64(m.OrderId))
-	}
-	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
-	}
-	l = len(m.ExternalId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+var xxx_messageInfo_EventMarketAuthorityTransferred proto.InternalMessageInfo
+
+func (m *EventMarketAuthorityTransferred) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	return n
+	return 0
 }
 
-func (m *EventFundsCommitted) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMarketAuthorityTransferred) GetProposedBy() string {
+	if m != nil {
+		return m.ProposedBy
 	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
-	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Tag)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
+	return ""
 }
 
-func (m *EventCommitmentReleased) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
-	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Tag)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventMarketAuthorityTransferred) GetNewAuthority() string {
+	if m != nil {
+		return m.NewAuthority
 	}
-	return n
+	return ""
 }
 
-func (m *EventMarketWithdraw) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
-	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Destination)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.WithdrawnBy)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
+// EventMarketAccessGrantExpired is an event emitted when an address' permissions in a market are revoked
+// because its access grant's expiration has passed.
+type EventMarketAccessGrantExpired struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// address is the bech32 address string of the account that no longer has permissions in the market.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *EventMarketDetailsUpdated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
-	}
-	l = len(m.UpdatedBy)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
+func (m *EventMarketAccessGrantExpired) Reset()         { *m = EventMarketAccessGrantExpired{} }
+func (m *EventMarketAccessGrantExpired) String() string { return proto.CompactTextString(m) }
+func (*EventMarketAccessGrantExpired) ProtoMessage()    {}
+func (*EventMarketAccessGrantExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{52}
 }
-
-func (m *EventMarketEnabled) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
-	}
-	l = len(m.UpdatedBy)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventMarketAccessGrantExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketAccessGrantExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketAccessGrantExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *EventMarketAccessGrantExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketAccessGrantExpired.Merge(m, src)
+}
+func (m *EventMarketAccessGrantExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketAccessGrantExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketAccessGrantExpired.DiscardUnknown(m)
 }
 
-func (m *EventMarketDisabled) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
-	}
-	l = len(m.UpdatedBy)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+var xxx_messageInfo_EventMarketAccessGrantExpired proto.InternalMessageInfo
+
+func (m *EventMarketAccessGrantExpired) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	return n
+	return 0
 }
 
-func (m *EventMarketOrdersEnabled) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
-	}
-	l = len(m.UpdatedBy)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventMarketAccessGrantExpired) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return n
+	return ""
 }
 
-func (m *EventMarketOrdersDisabled) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
-	}
-	l = len(m.UpdatedBy)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
+// EventMarketReqAttrUpdated is an event emitted when a market's required attributes are updated.
+type EventMarketReqAttrUpdated struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// updated_by is the account that updated the required attributes.
+	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 }
 
-func (m *EventMarketUserSettleEnabled) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
-	}
-	l = len(m.UpdatedBy)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventMarketReqAttrUpdated) Reset()         { *m = EventMarketReqAttrUpdated{} }
+func (m *EventMarketReqAttrUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventMarketReqAttrUpdated) ProtoMessage()    {}
+func (*EventMarketReqAttrUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{53}
+}
+func (m *EventMarketReqAttrUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketReqAttrUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketReqAttrUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *EventMarketReqAttrUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketReqAttrUpdated.Merge(m, src)
+}
+func (m *EventMarketReqAttrUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketReqAttrUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketReqAttrUpdated.DiscardUnknown(m)
 }
 
-func (m *EventMarketUserSettleDisabled) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
-	}
-	l = len(m.UpdatedBy)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+var xxx_messageInfo_EventMarketReqAttrUpdated proto.InternalMessageInfo
+
+func (m *EventMarketReqAttrUpdated) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	return n
+	return 0
 }
 
-func (m *EventMarketCommitmentsEnabled) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
-	}
-	l = len(m.UpdatedBy)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventMarketReqAttrUpdated) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
 	}
-	return n
+	return ""
 }
 
-func (m *EventMarketCommitmentsDisabled) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
-	}
-	l = len(m.UpdatedBy)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
+// EventMarketAcceptedDenomsUpdated is an event emitted when a market's accepted asset or price denoms are updated.
+type EventMarketAcceptedDenomsUpdated struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// updated_by is the account that updated the accepted denoms.
+	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 }
 
-func (m *EventMarketIntermediaryDenomUpdated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
+func (m *EventMarketAcceptedDenomsUpdated) Reset()         { *m = EventMarketAcceptedDenomsUpdated{} }
+func (m *EventMarketAcceptedDenomsUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventMarketAcceptedDenomsUpdated) ProtoMessage()    {}
+func (*EventMarketAcceptedDenomsUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{54}
+}
+func (m *EventMarketAcceptedDenomsUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketAcceptedDenomsUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketAcceptedDenomsUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.UpdatedBy)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+}
+func (m *EventMarketAcceptedDenomsUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketAcceptedDenomsUpdated.Merge(m, src)
+}
+func (m *EventMarketAcceptedDenomsUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketAcceptedDenomsUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketAcceptedDenomsUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarketAcceptedDenomsUpdated proto.InternalMessageInfo
+
+func (m *EventMarketAcceptedDenomsUpdated) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	return n
+	return 0
 }
 
-func (m *EventMarketPermissionsUpdated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMarketAcceptedDenomsUpdated) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
 	}
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
+	return ""
+}
+
+// EventMarketCreated is an event emitted when a market has been created.
+type EventMarketCreated struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+}
+
+func (m *EventMarketCreated) Reset()         { *m = EventMarketCreated{} }
+func (m *EventMarketCreated) String() string { return proto.CompactTextString(m) }
+func (*EventMarketCreated) ProtoMessage()    {}
+func (*EventMarketCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{55}
+}
+func (m *EventMarketCreated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketCreated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.UpdatedBy)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+}
+func (m *EventMarketCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketCreated.Merge(m, src)
+}
+func (m *EventMarketCreated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketCreated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarketCreated proto.InternalMessageInfo
+
+func (m *EventMarketCreated) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	return n
+	return 0
 }
 
-func (m *EventMarketReqAttrUpdated) Size() (n int) {
-	if m == nil {
-		return 0
+// EventMarketFeesUpdated is an event emitted when a market's fees have been updated.
+type EventMarketFeesUpdated struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+}
+
+func (m *EventMarketFeesUpdated) Reset()         { *m = EventMarketFeesUpdated{} }
+func (m *EventMarketFeesUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventMarketFeesUpdated) ProtoMessage()    {}
+func (*EventMarketFeesUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{56}
+}
+func (m *EventMarketFeesUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketFeesUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketFeesUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
+}
+func (m *EventMarketFeesUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketFeesUpdated.Merge(m, src)
+}
+func (m *EventMarketFeesUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketFeesUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketFeesUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarketFeesUpdated proto.InternalMessageInfo
+
+func (m *EventMarketFeesUpdated) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	l = len(m.UpdatedBy)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return 0
+}
+
+// EventMarketExchangeSplitsUpdated is an event emitted when a market's exchange split overrides are updated.
+type EventMarketExchangeSplitsUpdated struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+}
+
+func (m *EventMarketExchangeSplitsUpdated) Reset()         { *m = EventMarketExchangeSplitsUpdated{} }
+func (m *EventMarketExchangeSplitsUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventMarketExchangeSplitsUpdated) ProtoMessage()    {}
+func (*EventMarketExchangeSplitsUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{57}
+}
+func (m *EventMarketExchangeSplitsUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketExchangeSplitsUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketExchangeSplitsUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *EventMarketExchangeSplitsUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketExchangeSplitsUpdated.Merge(m, src)
+}
+func (m *EventMarketExchangeSplitsUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketExchangeSplitsUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketExchangeSplitsUpdated.DiscardUnknown(m)
 }
 
-func (m *EventMarketCreated) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_EventMarketExchangeSplitsUpdated proto.InternalMessageInfo
+
+func (m *EventMarketExchangeSplitsUpdated) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
+	return 0
+}
+
+// EventMarketFeesScheduled is an event emitted when fee changes have been scheduled for a market.
+type EventMarketFeesScheduled struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// effective_height is the block height at which the fee changes will be applied (or zero if not height-based).
+	EffectiveHeight int64 `protobuf:"varint,2,opt,name=effective_height,json=effectiveHeight,proto3" json:"effective_height,omitempty"`
+	// effective_time is the RFC 3339 block time at which the fee changes will be applied (or empty if not time-based).
+	EffectiveTime string `protobuf:"bytes,3,opt,name=effective_time,json=effectiveTime,proto3" json:"effective_time,omitempty"`
+}
+
+func (m *EventMarketFeesScheduled) Reset()         { *m = EventMarketFeesScheduled{} }
+func (m *EventMarketFeesScheduled) String() string { return proto.CompactTextString(m) }
+func (*EventMarketFeesScheduled) ProtoMessage()    {}
+func (*EventMarketFeesScheduled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{58}
+}
+func (m *EventMarketFeesScheduled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketFeesScheduled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketFeesScheduled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *EventMarketFeesScheduled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketFeesScheduled.Merge(m, src)
+}
+func (m *EventMarketFeesScheduled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketFeesScheduled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketFeesScheduled.DiscardUnknown(m)
 }
 
-func (m *EventMarketFeesUpdated) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_EventMarketFeesScheduled proto.InternalMessageInfo
+
+func (m *EventMarketFeesScheduled) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
+	return 0
+}
+
+func (m *EventMarketFeesScheduled) GetEffectiveHeight() int64 {
+	if m != nil {
+		return m.EffectiveHeight
 	}
-	return n
+	return 0
 }
 
-func (m *EventParamsUpdated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMarketFeesScheduled) GetEffectiveTime() string {
+	if m != nil {
+		return m.EffectiveTime
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *EventPaymentCreated) Size() (n int) {
-	if m == nil {
-		return 0
+// EventRebatePaid is an event emitted when a market pays a settlement rebate to a seller.
+type EventRebatePaid struct {
+	// market_id is the numerical identifier of the market that paid the rebate.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// recipient is the bech32 address string of the account that received the rebate.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the coins amount string of the rebate.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *EventRebatePaid) Reset()         { *m = EventRebatePaid{} }
+func (m *EventRebatePaid) String() string { return proto.CompactTextString(m) }
+func (*EventRebatePaid) ProtoMessage()    {}
+func (*EventRebatePaid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{59}
+}
+func (m *EventRebatePaid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRebatePaid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRebatePaid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+}
+func (m *EventRebatePaid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRebatePaid.Merge(m, src)
+}
+func (m *EventRebatePaid) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRebatePaid) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRebatePaid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRebatePaid proto.InternalMessageInfo
+
+func (m *EventRebatePaid) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	l = len(m.SourceAmount)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return 0
+}
+
+func (m *EventRebatePaid) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	l = len(m.Target)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.TargetAmount)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.ExternalId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
+	return ""
 }
 
-func (m *EventPaymentUpdated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.SourceAmount)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventRebatePaid) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	l = len(m.OldTarget)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return ""
+}
+
+// EventReferralPaid is an event emitted when part of a market's settlement fees are paid to an order's referrer.
+type EventReferralPaid struct {
+	// market_id is the numerical identifier of the market that paid the referral fee.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// order_id is the numerical identifier of the order that had the referrer.
+	OrderId uint64 `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// referrer is the bech32 address string of the account that received the referral fee.
+	Referrer string `protobuf:"bytes,3,opt,name=referrer,proto3" json:"referrer,omitempty"`
+	// amount is the coins amount string of the referral fee.
+	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *EventReferralPaid) Reset()         { *m = EventReferralPaid{} }
+func (m *EventReferralPaid) String() string { return proto.CompactTextString(m) }
+func (*EventReferralPaid) ProtoMessage()    {}
+func (*EventReferralPaid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{60}
+}
+func (m *EventReferralPaid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventReferralPaid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventReferralPaid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.NewTarget)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+}
+func (m *EventReferralPaid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventReferralPaid.Merge(m, src)
+}
+func (m *EventReferralPaid) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventReferralPaid) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventReferralPaid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventReferralPaid proto.InternalMessageInfo
+
+func (m *EventReferralPaid) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	l = len(m.TargetAmount)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return 0
+}
+
+func (m *EventReferralPaid) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
 	}
-	l = len(m.ExternalId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return 0
+}
+
+func (m *EventReferralPaid) GetReferrer() string {
+	if m != nil {
+		return m.Referrer
 	}
-	return n
+	return ""
 }
 
-func (m *EventPaymentAccepted) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventReferralPaid) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	var l int
-	_ = l
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return ""
+}
+
+// EventParamsUpdated is an event emitted when the exchange module's params have been updated.
+type EventParamsUpdated struct {
+}
+
+func (m *EventParamsUpdated) Reset()         { *m = EventParamsUpdated{} }
+func (m *EventParamsUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventParamsUpdated) ProtoMessage()    {}
+func (*EventParamsUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{61}
+}
+func (m *EventParamsUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventParamsUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventParamsUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.SourceAmount)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+}
+func (m *EventParamsUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventParamsUpdated.Merge(m, src)
+}
+func (m *EventParamsUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventParamsUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventParamsUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventParamsUpdated proto.InternalMessageInfo
+
+// EventPaymentCreated is an event emitted when a payment is created.
+type EventPaymentCreated struct {
+	// source is the account that created the Payment.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// source_amount is the coins amount string of the funds that the source will pay (to the target).
+	SourceAmount string `protobuf:"bytes,2,opt,name=source_amount,json=sourceAmount,proto3" json:"source_amount,omitempty"`
+	// target is the account that can accept the Payment.
+	Target string `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	// target_amount is the coins amount string of the funds that the target will pay (to the source).
+	TargetAmount string `protobuf:"bytes,4,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
+	// external_id is used along with the source to uniquely identify this Payment.
+	ExternalId string `protobuf:"bytes,5,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+}
+
+func (m *EventPaymentCreated) Reset()         { *m = EventPaymentCreated{} }
+func (m *EventPaymentCreated) String() string { return proto.CompactTextString(m) }
+func (*EventPaymentCreated) ProtoMessage()    {}
+func (*EventPaymentCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{62}
+}
+func (m *EventPaymentCreated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPaymentCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPaymentCreated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Target)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+}
+func (m *EventPaymentCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPaymentCreated.Merge(m, src)
+}
+func (m *EventPaymentCreated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPaymentCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPaymentCreated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPaymentCreated proto.InternalMessageInfo
+
+func (m *EventPaymentCreated) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	l = len(m.TargetAmount)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return ""
+}
+
+func (m *EventPaymentCreated) GetSourceAmount() string {
+	if m != nil {
+		return m.SourceAmount
 	}
-	l = len(m.ExternalId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return ""
+}
+
+func (m *EventPaymentCreated) GetTarget() string {
+	if m != nil {
+		return m.Target
 	}
-	return n
+	return ""
 }
 
-func (m *EventPaymentRejected) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventPaymentCreated) GetTargetAmount() string {
+	if m != nil {
+		return m.TargetAmount
 	}
-	var l int
-	_ = l
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return ""
+}
+
+func (m *EventPaymentCreated) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
 	}
-	l = len(m.Target)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return ""
+}
+
+// EventPaymentUpdated is an event emitted when a payment is updated.
+type EventPaymentUpdated struct {
+	// source is the account that updated (and previously created) the Payment.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// source_amount is the coins amount string of the funds that the source will pay (to the target).
+	SourceAmount string `protobuf:"bytes,2,opt,name=source_amount,json=sourceAmount,proto3" json:"source_amount,omitempty"`
+	// old_target is the account that used to be able to accept the Payment (but not any more).
+	OldTarget string `protobuf:"bytes,3,opt,name=old_target,json=oldTarget,proto3" json:"old_target,omitempty"`
+	// new_target is the account that is now able to accept the Payment.
+	NewTarget string `protobuf:"bytes,4,opt,name=new_target,json=newTarget,proto3" json:"new_target,omitempty"`
+	// target_amount is the coins amount string of the funds that the target will pay (to the source).
+	TargetAmount string `protobuf:"bytes,5,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
+	// external_id is used along with the source to uniquely identify this Payment.
+	ExternalId string `protobuf:"bytes,6,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+}
+
+func (m *EventPaymentUpdated) Reset()         { *m = EventPaymentUpdated{} }
+func (m *EventPaymentUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventPaymentUpdated) ProtoMessage()    {}
+func (*EventPaymentUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{63}
+}
+func (m *EventPaymentUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPaymentUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPaymentUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPaymentUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPaymentUpdated.Merge(m, src)
+}
+func (m *EventPaymentUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPaymentUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPaymentUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPaymentUpdated proto.InternalMessageInfo
+
+func (m *EventPaymentUpdated) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *EventPaymentUpdated) GetSourceAmount() string {
+	if m != nil {
+		return m.SourceAmount
+	}
+	return ""
+}
+
+func (m *EventPaymentUpdated) GetOldTarget() string {
+	if m != nil {
+		return m.OldTarget
+	}
+	return ""
+}
+
+func (m *EventPaymentUpdated) GetNewTarget() string {
+	if m != nil {
+		return m.NewTarget
+	}
+	return ""
+}
+
+func (m *EventPaymentUpdated) GetTargetAmount() string {
+	if m != nil {
+		return m.TargetAmount
+	}
+	return ""
+}
+
+func (m *EventPaymentUpdated) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
+// EventPaymentAccepted is an event emitted when a payment is accepted.
+type EventPaymentAccepted struct {
+	// source is the account that created the Payment.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// source_amount is the coins amount string of the funds that the source will pay (to the target).
+	SourceAmount string `protobuf:"bytes,2,opt,name=source_amount,json=sourceAmount,proto3" json:"source_amount,omitempty"`
+	// target is the account that accepted the Payment.
+	Target string `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	// target_amount is the coins amount string of the funds that the target will pay (to the source).
+	TargetAmount string `protobuf:"bytes,4,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
+	// external_id is used along with the source to uniquely identify this Payment.
+	ExternalId string `protobuf:"bytes,5,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+}
+
+func (m *EventPaymentAccepted) Reset()         { *m = EventPaymentAccepted{} }
+func (m *EventPaymentAccepted) String() string { return proto.CompactTextString(m) }
+func (*EventPaymentAccepted) ProtoMessage()    {}
+func (*EventPaymentAccepted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{64}
+}
+func (m *EventPaymentAccepted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPaymentAccepted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPaymentAccepted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPaymentAccepted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPaymentAccepted.Merge(m, src)
+}
+func (m *EventPaymentAccepted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPaymentAccepted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPaymentAccepted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPaymentAccepted proto.InternalMessageInfo
+
+func (m *EventPaymentAccepted) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *EventPaymentAccepted) GetSourceAmount() string {
+	if m != nil {
+		return m.SourceAmount
+	}
+	return ""
+}
+
+func (m *EventPaymentAccepted) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *EventPaymentAccepted) GetTargetAmount() string {
+	if m != nil {
+		return m.TargetAmount
+	}
+	return ""
+}
+
+func (m *EventPaymentAccepted) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
+// EventPaymentRejected is an event emitted when a payment is rejected (by the target).
+type EventPaymentRejected struct {
+	// source is the account that created the Payment.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// target is the account that rejected the Payment.
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// external_id is used along with the source to uniquely identify this Payment.
+	ExternalId string `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+}
+
+func (m *EventPaymentRejected) Reset()         { *m = EventPaymentRejected{} }
+func (m *EventPaymentRejected) String() string { return proto.CompactTextString(m) }
+func (*EventPaymentRejected) ProtoMessage()    {}
+func (*EventPaymentRejected) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{65}
+}
+func (m *EventPaymentRejected) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPaymentRejected) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPaymentRejected.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPaymentRejected) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPaymentRejected.Merge(m, src)
+}
+func (m *EventPaymentRejected) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPaymentRejected) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPaymentRejected.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPaymentRejected proto.InternalMessageInfo
+
+func (m *EventPaymentRejected) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *EventPaymentRejected) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *EventPaymentRejected) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
+// EventPaymentCancelled is an event emitted when a payment is cancelled (by the source).
+type EventPaymentCancelled struct {
+	// source is the account that cancelled (and created) the Payment.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// target is the account that could have accepted the Payment.
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// external_id is used along with the source to uniquely identify this Payment.
+	ExternalId string `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+}
+
+func (m *EventPaymentCancelled) Reset()         { *m = EventPaymentCancelled{} }
+func (m *EventPaymentCancelled) String() string { return proto.CompactTextString(m) }
+func (*EventPaymentCancelled) ProtoMessage()    {}
+func (*EventPaymentCancelled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{66}
+}
+func (m *EventPaymentCancelled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPaymentCancelled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPaymentCancelled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPaymentCancelled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPaymentCancelled.Merge(m, src)
+}
+func (m *EventPaymentCancelled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPaymentCancelled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPaymentCancelled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPaymentCancelled proto.InternalMessageInfo
+
+func (m *EventPaymentCancelled) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *EventPaymentCancelled) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *EventPaymentCancelled) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
+// EventPaymentExpired is an event emitted when a payment is cancelled because its expiration has passed.
+type EventPaymentExpired struct {
+	// source is the account that created the Payment.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// target is the account that could have accepted the Payment.
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// external_id is used along with the source to uniquely identify this Payment.
+	ExternalId string `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+}
+
+func (m *EventPaymentExpired) Reset()         { *m = EventPaymentExpired{} }
+func (m *EventPaymentExpired) String() string { return proto.CompactTextString(m) }
+func (*EventPaymentExpired) ProtoMessage()    {}
+func (*EventPaymentExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{67}
+}
+func (m *EventPaymentExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPaymentExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPaymentExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPaymentExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPaymentExpired.Merge(m, src)
+}
+func (m *EventPaymentExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPaymentExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPaymentExpired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPaymentExpired proto.InternalMessageInfo
+
+func (m *EventPaymentExpired) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *EventPaymentExpired) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *EventPaymentExpired) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
+// EventRecurringPaymentCreated is an event emitted when a recurring payment is created.
+type EventRecurringPaymentCreated struct {
+	// source is the account that created the RecurringPayment.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// target is the account that receives the transfers.
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// amount is the coins amount string of the funds in each transfer.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// external_id is used along with the source to uniquely identify this RecurringPayment.
+	ExternalId string `protobuf:"bytes,4,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// transfers is the number of transfers that will be made.
+	Transfers uint32 `protobuf:"varint,5,opt,name=transfers,proto3" json:"transfers,omitempty"`
+}
+
+func (m *EventRecurringPaymentCreated) Reset()         { *m = EventRecurringPaymentCreated{} }
+func (m *EventRecurringPaymentCreated) String() string { return proto.CompactTextString(m) }
+func (*EventRecurringPaymentCreated) ProtoMessage()    {}
+func (*EventRecurringPaymentCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{68}
+}
+func (m *EventRecurringPaymentCreated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRecurringPaymentCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRecurringPaymentCreated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRecurringPaymentCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRecurringPaymentCreated.Merge(m, src)
+}
+func (m *EventRecurringPaymentCreated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRecurringPaymentCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRecurringPaymentCreated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRecurringPaymentCreated proto.InternalMessageInfo
+
+func (m *EventRecurringPaymentCreated) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *EventRecurringPaymentCreated) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *EventRecurringPaymentCreated) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventRecurringPaymentCreated) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
+func (m *EventRecurringPaymentCreated) GetTransfers() uint32 {
+	if m != nil {
+		return m.Transfers
+	}
+	return 0
+}
+
+// EventRecurringPaymentTransferred is an event emitted when a recurring payment transfer is made.
+type EventRecurringPaymentTransferred struct {
+	// source is the account that created the RecurringPayment and provided the funds.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// target is the account that received the funds.
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// amount is the coins amount string of the funds that were transferred.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// external_id is used along with the source to uniquely identify this RecurringPayment.
+	ExternalId string `protobuf:"bytes,4,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// transfers_remaining is the number of transfers left to make. Zero means the RecurringPayment is complete.
+	TransfersRemaining uint32 `protobuf:"varint,5,opt,name=transfers_remaining,json=transfersRemaining,proto3" json:"transfers_remaining,omitempty"`
+}
+
+func (m *EventRecurringPaymentTransferred) Reset()         { *m = EventRecurringPaymentTransferred{} }
+func (m *EventRecurringPaymentTransferred) String() string { return proto.CompactTextString(m) }
+func (*EventRecurringPaymentTransferred) ProtoMessage()    {}
+func (*EventRecurringPaymentTransferred) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{69}
+}
+func (m *EventRecurringPaymentTransferred) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRecurringPaymentTransferred) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRecurringPaymentTransferred.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRecurringPaymentTransferred) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRecurringPaymentTransferred.Merge(m, src)
+}
+func (m *EventRecurringPaymentTransferred) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRecurringPaymentTransferred) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRecurringPaymentTransferred.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRecurringPaymentTransferred proto.InternalMessageInfo
+
+func (m *EventRecurringPaymentTransferred) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *EventRecurringPaymentTransferred) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *EventRecurringPaymentTransferred) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventRecurringPaymentTransferred) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
+func (m *EventRecurringPaymentTransferred) GetTransfersRemaining() uint32 {
+	if m != nil {
+		return m.TransfersRemaining
+	}
+	return 0
+}
+
+// EventRecurringPaymentFailed is an event emitted when a recurring payment transfer cannot be made.
+type EventRecurringPaymentFailed struct {
+	// source is the account that created the RecurringPayment.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// target is the account that would have received the funds.
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// external_id is used along with the source to uniquely identify this RecurringPayment.
+	ExternalId string `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// error is the reason the transfer failed.
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// failures is the number of consecutive transfers that have failed (including this one).
+	Failures uint32 `protobuf:"varint,5,opt,name=failures,proto3" json:"failures,omitempty"`
+}
+
+func (m *EventRecurringPaymentFailed) Reset()         { *m = EventRecurringPaymentFailed{} }
+func (m *EventRecurringPaymentFailed) String() string { return proto.CompactTextString(m) }
+func (*EventRecurringPaymentFailed) ProtoMessage()    {}
+func (*EventRecurringPaymentFailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{70}
+}
+func (m *EventRecurringPaymentFailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRecurringPaymentFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRecurringPaymentFailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRecurringPaymentFailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRecurringPaymentFailed.Merge(m, src)
+}
+func (m *EventRecurringPaymentFailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRecurringPaymentFailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRecurringPaymentFailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRecurringPaymentFailed proto.InternalMessageInfo
+
+func (m *EventRecurringPaymentFailed) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *EventRecurringPaymentFailed) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *EventRecurringPaymentFailed) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
+func (m *EventRecurringPaymentFailed) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *EventRecurringPaymentFailed) GetFailures() uint32 {
+	if m != nil {
+		return m.Failures
+	}
+	return 0
+}
+
+// EventRecurringPaymentCancelled is an event emitted when a recurring payment is cancelled before it is complete,
+// either by the source, or because too many consecutive transfers failed.
+type EventRecurringPaymentCancelled struct {
+	// source is the account that created the RecurringPayment.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// target is the account that was receiving the transfers.
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// external_id is used along with the source to uniquely identify this RecurringPayment.
+	ExternalId string `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// transfers_remaining is the number of transfers that will no longer be made.
+	TransfersRemaining uint32 `protobuf:"varint,4,opt,name=transfers_remaining,json=transfersRemaining,proto3" json:"transfers_remaining,omitempty"`
+}
+
+func (m *EventRecurringPaymentCancelled) Reset()         { *m = EventRecurringPaymentCancelled{} }
+func (m *EventRecurringPaymentCancelled) String() string { return proto.CompactTextString(m) }
+func (*EventRecurringPaymentCancelled) ProtoMessage()    {}
+func (*EventRecurringPaymentCancelled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{71}
+}
+func (m *EventRecurringPaymentCancelled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRecurringPaymentCancelled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRecurringPaymentCancelled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRecurringPaymentCancelled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRecurringPaymentCancelled.Merge(m, src)
+}
+func (m *EventRecurringPaymentCancelled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRecurringPaymentCancelled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRecurringPaymentCancelled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRecurringPaymentCancelled proto.InternalMessageInfo
+
+func (m *EventRecurringPaymentCancelled) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *EventRecurringPaymentCancelled) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *EventRecurringPaymentCancelled) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
+func (m *EventRecurringPaymentCancelled) GetTransfersRemaining() uint32 {
+	if m != nil {
+		return m.TransfersRemaining
+	}
+	return 0
+}
+
+// EventMultiPartyPaymentCreated is an event emitted when a multi-party payment is created.
+type EventMultiPartyPaymentCreated struct {
+	// creator is the account that created the MultiPartyPayment.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// external_id is used along with the creator to uniquely identify this MultiPartyPayment.
+	ExternalId string `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// parties are the addresses of all the parties in the MultiPartyPayment.
+	Parties []string `protobuf:"bytes,3,rep,name=parties,proto3" json:"parties,omitempty"`
+}
+
+func (m *EventMultiPartyPaymentCreated) Reset()         { *m = EventMultiPartyPaymentCreated{} }
+func (m *EventMultiPartyPaymentCreated) String() string { return proto.CompactTextString(m) }
+func (*EventMultiPartyPaymentCreated) ProtoMessage()    {}
+func (*EventMultiPartyPaymentCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{72}
+}
+func (m *EventMultiPartyPaymentCreated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMultiPartyPaymentCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMultiPartyPaymentCreated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMultiPartyPaymentCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMultiPartyPaymentCreated.Merge(m, src)
+}
+func (m *EventMultiPartyPaymentCreated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMultiPartyPaymentCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMultiPartyPaymentCreated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMultiPartyPaymentCreated proto.InternalMessageInfo
+
+func (m *EventMultiPartyPaymentCreated) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *EventMultiPartyPaymentCreated) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
+func (m *EventMultiPartyPaymentCreated) GetParties() []string {
+	if m != nil {
+		return m.Parties
+	}
+	return nil
+}
+
+// EventMultiPartyPaymentAccepted is an event emitted when a party accepts a multi-party payment.
+type EventMultiPartyPaymentAccepted struct {
+	// creator is the account that created the MultiPartyPayment.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// external_id is used along with the creator to uniquely identify this MultiPartyPayment.
+	ExternalId string `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// party is the account that accepted the MultiPartyPayment.
+	Party string `protobuf:"bytes,3,opt,name=party,proto3" json:"party,omitempty"`
+}
+
+func (m *EventMultiPartyPaymentAccepted) Reset()         { *m = EventMultiPartyPaymentAccepted{} }
+func (m *EventMultiPartyPaymentAccepted) String() string { return proto.CompactTextString(m) }
+func (*EventMultiPartyPaymentAccepted) ProtoMessage()    {}
+func (*EventMultiPartyPaymentAccepted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{73}
+}
+func (m *EventMultiPartyPaymentAccepted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMultiPartyPaymentAccepted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMultiPartyPaymentAccepted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMultiPartyPaymentAccepted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMultiPartyPaymentAccepted.Merge(m, src)
+}
+func (m *EventMultiPartyPaymentAccepted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMultiPartyPaymentAccepted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMultiPartyPaymentAccepted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMultiPartyPaymentAccepted proto.InternalMessageInfo
+
+func (m *EventMultiPartyPaymentAccepted) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *EventMultiPartyPaymentAccepted) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
+func (m *EventMultiPartyPaymentAccepted) GetParty() string {
+	if m != nil {
+		return m.Party
+	}
+	return ""
+}
+
+// EventMultiPartyPaymentSettled is an event emitted when all parties have accepted a multi-party payment
+// and its funds have been transferred.
+type EventMultiPartyPaymentSettled struct {
+	// creator is the account that created the MultiPartyPayment.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// external_id is used along with the creator to uniquely identify this MultiPartyPayment.
+	ExternalId string `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// parties are the addresses of all the parties in the MultiPartyPayment.
+	Parties []string `protobuf:"bytes,3,rep,name=parties,proto3" json:"parties,omitempty"`
+}
+
+func (m *EventMultiPartyPaymentSettled) Reset()         { *m = EventMultiPartyPaymentSettled{} }
+func (m *EventMultiPartyPaymentSettled) String() string { return proto.CompactTextString(m) }
+func (*EventMultiPartyPaymentSettled) ProtoMessage()    {}
+func (*EventMultiPartyPaymentSettled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{74}
+}
+func (m *EventMultiPartyPaymentSettled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMultiPartyPaymentSettled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMultiPartyPaymentSettled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMultiPartyPaymentSettled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMultiPartyPaymentSettled.Merge(m, src)
+}
+func (m *EventMultiPartyPaymentSettled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMultiPartyPaymentSettled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMultiPartyPaymentSettled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMultiPartyPaymentSettled proto.InternalMessageInfo
+
+func (m *EventMultiPartyPaymentSettled) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *EventMultiPartyPaymentSettled) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
+func (m *EventMultiPartyPaymentSettled) GetParties() []string {
+	if m != nil {
+		return m.Parties
+	}
+	return nil
+}
+
+// EventMultiPartyPaymentCancelled is an event emitted when a multi-party payment is cancelled by one of its parties.
+type EventMultiPartyPaymentCancelled struct {
+	// creator is the account that created the MultiPartyPayment.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// external_id is used along with the creator to uniquely identify this MultiPartyPayment.
+	ExternalId string `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// cancelled_by is the party that cancelled the MultiPartyPayment.
+	CancelledBy string `protobuf:"bytes,3,opt,name=cancelled_by,json=cancelledBy,proto3" json:"cancelled_by,omitempty"`
+}
+
+func (m *EventMultiPartyPaymentCancelled) Reset()         { *m = EventMultiPartyPaymentCancelled{} }
+func (m *EventMultiPartyPaymentCancelled) String() string { return proto.CompactTextString(m) }
+func (*EventMultiPartyPaymentCancelled) ProtoMessage()    {}
+func (*EventMultiPartyPaymentCancelled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{75}
+}
+func (m *EventMultiPartyPaymentCancelled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMultiPartyPaymentCancelled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMultiPartyPaymentCancelled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMultiPartyPaymentCancelled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMultiPartyPaymentCancelled.Merge(m, src)
+}
+func (m *EventMultiPartyPaymentCancelled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMultiPartyPaymentCancelled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMultiPartyPaymentCancelled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMultiPartyPaymentCancelled proto.InternalMessageInfo
+
+func (m *EventMultiPartyPaymentCancelled) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *EventMultiPartyPaymentCancelled) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
+func (m *EventMultiPartyPaymentCancelled) GetCancelledBy() string {
+	if m != nil {
+		return m.CancelledBy
+	}
+	return ""
+}
+
+// EventPaymentEscrowed is an event emitted when a payment with a dispute window is accepted,
+// and its funds are moved into escrow.
+type EventPaymentEscrowed struct {
+	// source is the account that created the Payment.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// target is the account that accepted the Payment.
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// external_id is used along with the source to uniquely identify this Payment.
+	ExternalId string `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// release_time is the RFC 3339 time at which the dispute window ends and the funds will be released.
+	ReleaseTime string `protobuf:"bytes,4,opt,name=release_time,json=releaseTime,proto3" json:"release_time,omitempty"`
+}
+
+func (m *EventPaymentEscrowed) Reset()         { *m = EventPaymentEscrowed{} }
+func (m *EventPaymentEscrowed) String() string { return proto.CompactTextString(m) }
+func (*EventPaymentEscrowed) ProtoMessage()    {}
+func (*EventPaymentEscrowed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{76}
+}
+func (m *EventPaymentEscrowed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPaymentEscrowed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPaymentEscrowed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPaymentEscrowed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPaymentEscrowed.Merge(m, src)
+}
+func (m *EventPaymentEscrowed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPaymentEscrowed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPaymentEscrowed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPaymentEscrowed proto.InternalMessageInfo
+
+func (m *EventPaymentEscrowed) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *EventPaymentEscrowed) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *EventPaymentEscrowed) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
+func (m *EventPaymentEscrowed) GetReleaseTime() string {
+	if m != nil {
+		return m.ReleaseTime
+	}
+	return ""
+}
+
+// EventPaymentReleased is an event emitted when the dispute window of an escrowed payment ends,
+// and its funds are released to the source and target.
+type EventPaymentReleased struct {
+	// source is the account that created the Payment.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// source_amount is the coins amount string of the funds that were released to the target.
+	SourceAmount string `protobuf:"bytes,2,opt,name=source_amount,json=sourceAmount,proto3" json:"source_amount,omitempty"`
+	// target is the account that accepted the Payment.
+	Target string `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	// target_amount is the coins amount string of the funds that were released to the source.
+	TargetAmount string `protobuf:"bytes,4,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
+	// external_id is used along with the source to uniquely identify this Payment.
+	ExternalId string `protobuf:"bytes,5,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+}
+
+func (m *EventPaymentReleased) Reset()         { *m = EventPaymentReleased{} }
+func (m *EventPaymentReleased) String() string { return proto.CompactTextString(m) }
+func (*EventPaymentReleased) ProtoMessage()    {}
+func (*EventPaymentReleased) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{77}
+}
+func (m *EventPaymentReleased) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPaymentReleased) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPaymentReleased.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPaymentReleased) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPaymentReleased.Merge(m, src)
+}
+func (m *EventPaymentReleased) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPaymentReleased) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPaymentReleased.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPaymentReleased proto.InternalMessageInfo
+
+func (m *EventPaymentReleased) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *EventPaymentReleased) GetSourceAmount() string {
+	if m != nil {
+		return m.SourceAmount
+	}
+	return ""
+}
+
+func (m *EventPaymentReleased) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *EventPaymentReleased) GetTargetAmount() string {
+	if m != nil {
+		return m.TargetAmount
+	}
+	return ""
+}
+
+func (m *EventPaymentReleased) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
+// EventPaymentDisputed is an event emitted when an escrowed payment is disputed (by the target),
+// and its funds are refunded to the source and target.
+type EventPaymentDisputed struct {
+	// source is the account that created the Payment.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// target is the account that disputed the Payment.
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// external_id is used along with the source to uniquely identify this Payment.
+	ExternalId string `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+}
+
+func (m *EventPaymentDisputed) Reset()         { *m = EventPaymentDisputed{} }
+func (m *EventPaymentDisputed) String() string { return proto.CompactTextString(m) }
+func (*EventPaymentDisputed) ProtoMessage()    {}
+func (*EventPaymentDisputed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{78}
+}
+func (m *EventPaymentDisputed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPaymentDisputed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPaymentDisputed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPaymentDisputed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPaymentDisputed.Merge(m, src)
+}
+func (m *EventPaymentDisputed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPaymentDisputed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPaymentDisputed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPaymentDisputed proto.InternalMessageInfo
+
+func (m *EventPaymentDisputed) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *EventPaymentDisputed) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *EventPaymentDisputed) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*EventOrderCreated)(nil), "provenance.exchange.v1.EventOrderCreated")
+	proto.RegisterType((*EventOrderCancelled)(nil), "provenance.exchange.v1.EventOrderCancelled")
+	proto.RegisterType((*EventOrderFilled)(nil), "provenance.exchange.v1.EventOrderFilled")
+	proto.RegisterType((*EventOrderPartiallyFilled)(nil), "provenance.exchange.v1.EventOrderPartiallyFilled")
+	proto.RegisterType((*EventOrderPartialFill)(nil), "provenance.exchange.v1.EventOrderPartialFill")
+	proto.RegisterType((*FillParticipant)(nil), "provenance.exchange.v1.FillParticipant")
+	proto.RegisterType((*EventOrderExternalIDUpdated)(nil), "provenance.exchange.v1.EventOrderExternalIDUpdated")
+	proto.RegisterType((*EventOrderExpired)(nil), "provenance.exchange.v1.EventOrderExpired")
+	proto.RegisterType((*EventOrderAmended)(nil), "provenance.exchange.v1.EventOrderAmended")
+	proto.RegisterType((*EventOrdersLinked)(nil), "provenance.exchange.v1.EventOrdersLinked")
+	proto.RegisterType((*EventLinkedOrderCancelled)(nil), "provenance.exchange.v1.EventLinkedOrderCancelled")
+	proto.RegisterType((*EventDeadManSwitchSet)(nil), "provenance.exchange.v1.EventDeadManSwitchSet")
+	proto.RegisterType((*EventDeadManSwitchHeartbeat)(nil), "provenance.exchange.v1.EventDeadManSwitchHeartbeat")
+	proto.RegisterType((*EventDeadManSwitchRemoved)(nil), "provenance.exchange.v1.EventDeadManSwitchRemoved")
+	proto.RegisterType((*EventDeadManSwitchTriggered)(nil), "provenance.exchange.v1.EventDeadManSwitchTriggered")
+	proto.RegisterType((*EventMarketOrdersBulkCancelled)(nil), "provenance.exchange.v1.EventMarketOrdersBulkCancelled")
+	proto.RegisterType((*EventSelfTradePrevented)(nil), "provenance.exchange.v1.EventSelfTradePrevented")
+	proto.RegisterType((*EventTriggerOrderCreated)(nil), "provenance.exchange.v1.EventTriggerOrderCreated")
+	proto.RegisterType((*EventTriggerOrderActivated)(nil), "provenance.exchange.v1.EventTriggerOrderActivated")
+	proto.RegisterType((*EventBatchAuctionSettled)(nil), "provenance.exchange.v1.EventBatchAuctionSettled")
+	proto.RegisterType((*EventFundsCommitted)(nil), "provenance.exchange.v1.EventFundsCommitted")
+	proto.RegisterType((*EventCommitmentReleased)(nil), "provenance.exchange.v1.EventCommitmentReleased")
+	proto.RegisterType((*EventCommitmentExpired)(nil), "provenance.exchange.v1.EventCommitmentExpired")
+	proto.RegisterType((*EventCommitmentRewardsFunded)(nil), "provenance.exchange.v1.EventCommitmentRewardsFunded")
+	proto.RegisterType((*EventCommitmentRewardsDistributed)(nil), "provenance.exchange.v1.EventCommitmentRewardsDistributed")
+	proto.RegisterType((*EventCommitmentRewardsClaimed)(nil), "provenance.exchange.v1.EventCommitmentRewardsClaimed")
+	proto.RegisterType((*EventMarketWoundDown)(nil), "provenance.exchange.v1.EventMarketWoundDown")
+	proto.RegisterType((*WindDownRelease)(nil), "provenance.exchange.v1.WindDownRelease")
+	proto.RegisterType((*EventMarketWithdraw)(nil), "provenance.exchange.v1.EventMarketWithdraw")
+	proto.RegisterType((*EventMarketDetailsUpdated)(nil), "provenance.exchange.v1.EventMarketDetailsUpdated")
+	proto.RegisterType((*EventMarketEnabled)(nil), "provenance.exchange.v1.EventMarketEnabled")
+	proto.RegisterType((*EventMarketDisabled)(nil), "provenance.exchange.v1.EventMarketDisabled")
+	proto.RegisterType((*EventMarketOrdersEnabled)(nil), "provenance.exchange.v1.EventMarketOrdersEnabled")
+	proto.RegisterType((*EventMarketOrdersDisabled)(nil), "provenance.exchange.v1.EventMarketOrdersDisabled")
+	proto.RegisterType((*EventMarketUserSettleEnabled)(nil), "provenance.exchange.v1.EventMarketUserSettleEnabled")
+	proto.RegisterType((*EventMarketUserSettleDisabled)(nil), "provenance.exchange.v1.EventMarketUserSettleDisabled")
+	proto.RegisterType((*EventMarketCommitmentsEnabled)(nil), "provenance.exchange.v1.EventMarketCommitmentsEnabled")
+	proto.RegisterType((*EventMarketCommitmentsDisabled)(nil), "provenance.exchange.v1.EventMarketCommitmentsDisabled")
+	proto.RegisterType((*EventMarketContinuousMatchingEnabled)(nil), "provenance.exchange.v1.EventMarketContinuousMatchingEnabled")
+	proto.RegisterType((*EventMarketContinuousMatchingDisabled)(nil), "provenance.exchange.v1.EventMarketContinuousMatchingDisabled")
+	proto.RegisterType((*EventMarketBatchAuctionUpdated)(nil), "provenance.exchange.v1.EventMarketBatchAuctionUpdated")
+	proto.RegisterType((*EventMarketSelfTradePreventionUpdated)(nil), "provenance.exchange.v1.EventMarketSelfTradePreventionUpdated")
+	proto.RegisterType((*EventMarketPublishPricesEnabled)(nil), "provenance.exchange.v1.EventMarketPublishPricesEnabled")
+	proto.RegisterType((*EventMarketPublishPricesDisabled)(nil), "provenance.exchange.v1.EventMarketPublishPricesDisabled")
+	proto.RegisterType((*EventMarketExternalIDScopeUpdated)(nil), "provenance.exchange.v1.EventMarketExternalIDScopeUpdated")
+	proto.RegisterType((*EventMarketOrderRateLimitUpdated)(nil), "provenance.exchange.v1.EventMarketOrderRateLimitUpdated")
+	proto.RegisterType((*EventMarketMaxExposureUpdated)(nil), "provenance.exchange.v1.EventMarketMaxExposureUpdated")
+	proto.RegisterType((*EventMarketFillAlgorithmUpdated)(nil), "provenance.exchange.v1.EventMarketFillAlgorithmUpdated")
+	proto.RegisterType((*EventMarketIntermediaryDenomUpdated)(nil), "provenance.exchange.v1.EventMarketIntermediaryDenomUpdated")
+	proto.RegisterType((*EventMarketPermissionsUpdated)(nil), "provenance.exchange.v1.EventMarketPermissionsUpdated")
+	proto.RegisterType((*EventMarketAuthorityTransferProposed)(nil), "provenance.exchange.v1.EventMarketAuthorityTransferProposed")
+	proto.RegisterType((*EventMarketAuthorityTransferred)(nil), "provenance.exchange.v1.EventMarketAuthorityTransferred")
+	proto.RegisterType((*EventMarketAccessGrantExpired)(nil), "provenance.exchange.v1.EventMarketAccessGrantExpired")
+	proto.RegisterType((*EventMarketReqAttrUpdated)(nil), "provenance.exchange.v1.EventMarketReqAttrUpdated")
+	proto.RegisterType((*EventMarketAcceptedDenomsUpdated)(nil), "provenance.exchange.v1.EventMarketAcceptedDenomsUpdated")
+	proto.RegisterType((*EventMarketCreated)(nil), "provenance.exchange.v1.EventMarketCreated")
+	proto.RegisterType((*EventMarketFeesUpdated)(nil), "provenance.exchange.v1.EventMarketFeesUpdated")
+	proto.RegisterType((*EventMarketExchangeSplitsUpdated)(nil), "provenance.exchange.v1.EventMarketExchangeSplitsUpdated")
+	proto.RegisterType((*EventMarketFeesScheduled)(nil), "provenance.exchange.v1.EventMarketFeesScheduled")
+	proto.RegisterType((*EventRebatePaid)(nil), "provenance.exchange.v1.EventRebatePaid")
+	proto.RegisterType((*EventReferralPaid)(nil), "provenance.exchange.v1.EventReferralPaid")
+	proto.RegisterType((*EventParamsUpdated)(nil), "provenance.exchange.v1.EventParamsUpdated")
+	proto.RegisterType((*EventPaymentCreated)(nil), "provenance.exchange.v1.EventPaymentCreated")
+	proto.RegisterType((*EventPaymentUpdated)(nil), "provenance.exchange.v1.EventPaymentUpdated")
+	proto.RegisterType((*EventPaymentAccepted)(nil), "provenance.exchange.v1.EventPaymentAccepted")
+	proto.RegisterType((*EventPaymentRejected)(nil), "provenance.exchange.v1.EventPaymentRejected")
+	proto.RegisterType((*EventPaymentCancelled)(nil), "provenance.exchange.v1.EventPaymentCancelled")
+	proto.RegisterType((*EventPaymentExpired)(nil), "provenance.exchange.v1.EventPaymentExpired")
+	proto.RegisterType((*EventRecurringPaymentCreated)(nil), "provenance.exchange.v1.EventRecurringPaymentCreated")
+	proto.RegisterType((*EventRecurringPaymentTransferred)(nil), "provenance.exchange.v1.EventRecurringPaymentTransferred")
+	proto.RegisterType((*EventRecurringPaymentFailed)(nil), "provenance.exchange.v1.EventRecurringPaymentFailed")
+	proto.RegisterType((*EventRecurringPaymentCancelled)(nil), "provenance.exchange.v1.EventRecurringPaymentCancelled")
+	proto.RegisterType((*EventMultiPartyPaymentCreated)(nil), "provenance.exchange.v1.EventMultiPartyPaymentCreated")
+	proto.RegisterType((*EventMultiPartyPaymentAccepted)(nil), "provenance.exchange.v1.EventMultiPartyPaymentAccepted")
+	proto.RegisterType((*EventMultiPartyPaymentSettled)(nil), "provenance.exchange.v1.EventMultiPartyPaymentSettled")
+	proto.RegisterType((*EventMultiPartyPaymentCancelled)(nil), "provenance.exchange.v1.EventMultiPartyPaymentCancelled")
+	proto.RegisterType((*EventPaymentEscrowed)(nil), "provenance.exchange.v1.EventPaymentEscrowed")
+	proto.RegisterType((*EventPaymentReleased)(nil), "provenance.exchange.v1.EventPaymentReleased")
+	proto.RegisterType((*EventPaymentDisputed)(nil), "provenance.exchange.v1.EventPaymentDisputed")
+}
+
+func init() {
+	proto.RegisterFile("provenance/exchange/v1/events.proto", fileDescriptor_c1b69385a348cffa)
+}
+
+var fileDescriptor_c1b69385a348cffa = []byte{
+	// 2268 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5b, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0x4f, 0xfb, 0xdb, 0x6f, 0xec, 0x38, 0x3b, 0x31, 0x61, 0x9c, 0x0f, 0xc7, 0xe9, 0x10, 0x36,
+	0x8b, 0xb4, 0xf6, 0x26, 0x7c, 0x44, 0x2c, 0x42, 0xab, 0x71, 0xec, 0xb0, 0x11, 0xb1, 0x62, 0x8d,
+	0xbd, 0x5a, 0x89, 0xcb, 0xa8, 0xdc, 0xfd, 0x3c, 0x53, 0xa4, 0xa7, 0xbb, 0xb7, 0xaa, 0xc6, 0xe3,
+	0x11, 0x0b, 0x12, 0x07, 0x24, 0x10, 0x1c, 0x16, 0xc4, 0x85, 0x65, 0x8f, 0x20, 0x21, 0x38, 0x82,
+	0x00, 0x21, 0xc1, 0x85, 0x0b, 0xc7, 0xd5, 0x0a, 0xf1, 0x21, 0x2e, 0x28, 0x61, 0xef, 0xfb, 0x0f,
+	0x20, 0xad, 0xea, 0xa3, 0xbf, 0x66, 0xc6, 0xd3, 0x13, 0x67, 0x3b, 0xb1, 0x72, 0x9b, 0x7a, 0xfd,
+	0xba, 0x7e, 0xbf, 0x57, 0xef, 0xd5, 0xab, 0x57, 0x55, 0x3d, 0x70, 0x35, 0x64, 0xc1, 0x01, 0xfa,
+	0xc4, 0x77, 0x70, 0x0d, 0x0f, 0x9d, 0x26, 0xf1, 0x1b, 0xb8, 0x76, 0x70, 0x63, 0x0d, 0x0f, 0xd0,
+	0x17, 0x7c, 0x35, 0x64, 0x81, 0x08, 0xca, 0xe7, 0x12, 0xa5, 0xd5, 0x48, 0x69, 0xf5, 0xe0, 0xc6,
+	0xf9, 0x25, 0x27, 0xe0, 0xad, 0x80, 0xd7, 0x95, 0xd6, 0x9a, 0x6e, 0xe8, 0x57, 0xec, 0x1f, 0x5a,
+	0xf0, 0xc2, 0xa6, 0xec, 0xe3, 0x3e, 0x73, 0x91, 0xdd, 0x66, 0x48, 0x04, 0xba, 0xe5, 0x25, 0x98,
+	0x09, 0x64, 0xbb, 0x4e, 0xdd, 0x8a, 0xb5, 0x62, 0x5d, 0x9f, 0xa8, 0x4d, 0xab, 0xf6, 0x5d, 0xb7,
+	0x7c, 0x09, 0x40, 0x3f, 0x12, 0xdd, 0x10, 0x2b, 0x63, 0x2b, 0xd6, 0xf5, 0xd9, 0xda, 0xac, 0x92,
+	0xec, 0x76, 0x43, 0x2c, 0x5f, 0x80, 0xd9, 0x16, 0x61, 0x0f, 0x50, 0xc8, 0x57, 0xc7, 0x57, 0xac,
+	0xeb, 0xf3, 0xb5, 0x19, 0x2d, 0xb8, 0xeb, 0x96, 0x2f, 0x43, 0x09, 0x0f, 0x05, 0x32, 0x9f, 0x78,
+	0xf2, 0xf1, 0x84, 0x7a, 0x19, 0x22, 0xd1, 0x5d, 0xd7, 0xfe, 0x8d, 0x05, 0x67, 0x53, 0x6c, 0xa4,
+	0x21, 0x9e, 0x37, 0x9c, 0xcf, 0x57, 0x60, 0xce, 0x89, 0xf4, 0xea, 0x7b, 0x5d, 0xcd, 0x68, 0xbd,
+	0xf2, 0xc1, 0xef, 0x5e, 0x5e, 0x34, 0x86, 0x56, 0x5d, 0x97, 0x21, 0xe7, 0x3b, 0x82, 0x51, 0xbf,
+	0x51, 0x2b, 0xc5, 0xda, 0xeb, 0xdd, 0x27, 0x64, 0xfb, 0x81, 0x05, 0x67, 0x12, 0xb6, 0x77, 0x68,
+	0x1e, 0xd5, 0x73, 0x30, 0x45, 0x38, 0x47, 0xc1, 0xcd, 0xb0, 0x99, 0x56, 0x79, 0x11, 0x26, 0x43,
+	0x46, 0x1d, 0x54, 0x0c, 0x66, 0x6b, 0xba, 0x51, 0x2e, 0xc3, 0xc4, 0x3e, 0x22, 0x37, 0xb8, 0xea,
+	0x77, 0x96, 0xef, 0xe4, 0x70, 0xbe, 0x53, 0xbd, 0x7c, 0xcb, 0x57, 0x61, 0x9e, 0xa3, 0x10, 0x1e,
+	0xb6, 0xd0, 0x57, 0x3d, 0x4c, 0x2b, 0x7e, 0x73, 0x89, 0xf0, 0xae, 0x6b, 0xff, 0xc7, 0x82, 0xa5,
+	0xc4, 0xa8, 0x6d, 0xc2, 0x04, 0x25, 0x9e, 0xd7, 0x7d, 0x4e, 0xac, 0xfb, 0x68, 0x1c, 0x3e, 0xd5,
+	0x67, 0x9d, 0xb4, 0xed, 0x59, 0x85, 0x7c, 0x79, 0x15, 0x26, 0x83, 0x8e, 0x8f, 0xac, 0x32, 0x99,
+	0x13, 0xb8, 0x5a, 0x4d, 0x9a, 0xb9, 0xaf, 0x7c, 0x51, 0x37, 0xa3, 0xad, 0x47, 0x62, 0x4e, 0x0b,
+	0xab, 0x7a, 0xcc, 0xaf, 0x80, 0x69, 0xd7, 0xf5, 0xd0, 0x4f, 0x2b, 0x9d, 0x92, 0x96, 0x6d, 0x2b,
+	0x07, 0x5c, 0x06, 0xd3, 0xac, 0x2b, 0x3f, 0xcc, 0x68, 0x62, 0x5a, 0x74, 0x47, 0x7a, 0xe3, 0x25,
+	0x38, 0xc3, 0xb0, 0x45, 0xa8, 0x4f, 0xfd, 0x46, 0x84, 0x35, 0xab, 0xb4, 0x16, 0x62, 0xb9, 0x81,
+	0x7b, 0x11, 0x12, 0x91, 0x41, 0x04, 0xa5, 0x79, 0x3a, 0x16, 0x6b, 0xd0, 0x6b, 0x90, 0x48, 0x34,
+	0x6e, 0x49, 0xe9, 0xcd, 0xc7, 0x52, 0x05, 0xfd, 0x75, 0x98, 0x0b, 0xa5, 0x6b, 0x1c, 0x1a, 0x12,
+	0x5f, 0xf0, 0xca, 0xdc, 0xca, 0xf8, 0xf5, 0xd2, 0xcd, 0x17, 0x57, 0x07, 0xa7, 0xb7, 0x55, 0xe9,
+	0xbf, 0xed, 0x44, 0xbf, 0x96, 0x79, 0xd9, 0xfe, 0xa7, 0x05, 0x0b, 0x3d, 0x1a, 0x4f, 0xe0, 0xec,
+	0xd8, 0x5d, 0xe3, 0xa3, 0xb9, 0x2b, 0x99, 0x15, 0x13, 0x83, 0x67, 0xc5, 0xe4, 0xa0, 0x59, 0x31,
+	0x95, 0x9a, 0x15, 0x15, 0x98, 0x0e, 0x75, 0x9c, 0x2a, 0x37, 0xce, 0xd4, 0xa2, 0xa6, 0x7d, 0x00,
+	0x17, 0x92, 0x58, 0xde, 0x8c, 0x42, 0x6a, 0xe3, 0x8d, 0xd0, 0xcd, 0x4b, 0xe2, 0x99, 0x90, 0x1d,
+	0x1b, 0x1e, 0xb2, 0xe3, 0x7d, 0x79, 0xcf, 0x4b, 0x2f, 0x19, 0x9b, 0x87, 0x21, 0x65, 0x45, 0xa2,
+	0xbd, 0x9b, 0x59, 0xa1, 0xaa, 0x2d, 0xf4, 0xdd, 0x4f, 0x32, 0x11, 0x65, 0xc8, 0x4d, 0x0c, 0x27,
+	0x37, 0xd9, 0x47, 0x8e, 0xa7, 0xb9, 0xf1, 0x7b, 0xd4, 0x7f, 0x80, 0x3d, 0xf6, 0x5a, 0x3d, 0x5d,
+	0xa6, 0x89, 0x8f, 0x65, 0x89, 0x7f, 0x16, 0x16, 0x3c, 0xd5, 0x43, 0x3d, 0xd6, 0x18, 0x57, 0x1a,
+	0xf3, 0x5a, 0x7c, 0x5f, 0xeb, 0xd9, 0xef, 0x45, 0x29, 0xfa, 0x5e, 0x22, 0x1e, 0x69, 0xad, 0x1c,
+	0x00, 0x30, 0x36, 0x00, 0xe0, 0x09, 0x97, 0xc5, 0x9f, 0x58, 0x26, 0xc7, 0x6e, 0x20, 0x71, 0xb7,
+	0x88, 0xbf, 0xd3, 0xa1, 0xc2, 0x69, 0xee, 0xa0, 0x28, 0xdf, 0x84, 0x69, 0xe2, 0x38, 0x41, 0xdb,
+	0x17, 0x15, 0x2b, 0x67, 0xfa, 0x44, 0x8a, 0x32, 0x0d, 0x51, 0x5f, 0x20, 0x3b, 0x20, 0x5e, 0x9d,
+	0xa3, 0x13, 0xf8, 0x2e, 0x37, 0xa4, 0x17, 0x22, 0xf9, 0x8e, 0x16, 0x97, 0xcf, 0xc3, 0x8c, 0x8b,
+	0xc4, 0xf5, 0xa8, 0x1f, 0xf9, 0x38, 0x6e, 0xdb, 0x2d, 0xb8, 0xd0, 0xcf, 0xe9, 0x75, 0x24, 0x4c,
+	0xec, 0x21, 0x39, 0x1e, 0xb3, 0x34, 0xdc, 0x58, 0x0f, 0xdc, 0x7d, 0x58, 0xea, 0x87, 0xab, 0x61,
+	0x2b, 0x38, 0x40, 0xf7, 0x38, 0x60, 0xf6, 0xdb, 0x83, 0xf8, 0xef, 0x32, 0xda, 0x68, 0x20, 0x43,
+	0xf7, 0xb8, 0x23, 0xab, 0xc2, 0x80, 0xd7, 0xe3, 0x92, 0xc8, 0xcc, 0xce, 0x05, 0x2d, 0x8f, 0x63,
+	0x4a, 0xd6, 0x65, 0xcb, 0x0a, 0x7e, 0x4b, 0x45, 0x81, 0x8e, 0xf6, 0xf5, 0xb6, 0xf7, 0x20, 0x09,
+	0xbb, 0xa1, 0x41, 0xff, 0x44, 0x45, 0xda, 0x22, 0x4c, 0x6a, 0xcb, 0x74, 0x24, 0xea, 0x86, 0x0c,
+	0xf3, 0x26, 0xe1, 0xf5, 0x56, 0xc0, 0x50, 0xc5, 0xe0, 0x4c, 0x6d, 0xba, 0x49, 0xf8, 0x56, 0xc0,
+	0xd0, 0xfe, 0xbd, 0x05, 0x9f, 0x56, 0x6c, 0x77, 0xd0, 0xdb, 0xdf, 0x65, 0xc4, 0xc5, 0x6d, 0xa6,
+	0xea, 0xe4, 0xe1, 0xb3, 0xe3, 0x73, 0xf0, 0x42, 0x10, 0x86, 0x01, 0x97, 0x6b, 0x53, 0xcf, 0xfc,
+	0x58, 0x88, 0x1e, 0x7c, 0x22, 0x33, 0x24, 0x95, 0xa1, 0x26, 0xd3, 0x19, 0xca, 0xfe, 0x83, 0x05,
+	0x15, 0x45, 0xdc, 0x38, 0xf6, 0x24, 0xd4, 0xe4, 0xb2, 0xe0, 0x10, 0x9a, 0x4e, 0x3d, 0xbd, 0x62,
+	0xcd, 0x19, 0xa1, 0x5a, 0xd8, 0xed, 0x5f, 0x5b, 0x70, 0xbe, 0x8f, 0x79, 0xd5, 0x11, 0xf4, 0xe0,
+	0x99, 0x72, 0x1f, 0xb8, 0xca, 0xda, 0x3f, 0x8a, 0x86, 0x79, 0x9d, 0x08, 0xa7, 0x59, 0x6d, 0x3b,
+	0x82, 0x06, 0xfe, 0x8e, 0x2a, 0x13, 0x73, 0xe2, 0xf8, 0xf1, 0x96, 0x96, 0x6b, 0x70, 0xda, 0xf1,
+	0x90, 0xb0, 0xa4, 0x2a, 0xd2, 0x0c, 0xe7, 0x23, 0xa9, 0x1e, 0xbb, 0x77, 0xa2, 0x4d, 0xcf, 0x9d,
+	0xb6, 0xef, 0xf2, 0xdb, 0x41, 0xab, 0x45, 0x85, 0x38, 0xe6, 0x9c, 0x1e, 0xba, 0xd4, 0x4a, 0xf6,
+	0xad, 0x78, 0x26, 0xcd, 0xd6, 0x4c, 0xab, 0x7c, 0x06, 0xc6, 0x05, 0x69, 0x18, 0x72, 0xf2, 0xa7,
+	0xfd, 0xd3, 0x68, 0x06, 0x69, 0x36, 0xb2, 0x78, 0xae, 0xa1, 0x87, 0x84, 0x3f, 0x5b, 0x5a, 0xdf,
+	0xb5, 0xe0, 0x5c, 0x0f, 0xad, 0xa8, 0xfc, 0x78, 0x5a, 0xac, 0xec, 0xef, 0x59, 0x70, 0xb1, 0x6f,
+	0x68, 0x3a, 0x84, 0xb9, 0x5c, 0xba, 0x2f, 0x2f, 0x80, 0x5e, 0x81, 0xa9, 0x7d, 0xa9, 0xc6, 0x72,
+	0x53, 0xa0, 0xd1, 0x3b, 0x92, 0xc7, 0x9f, 0x2c, 0xb8, 0x32, 0x98, 0xc7, 0x06, 0xe5, 0x82, 0xd1,
+	0xbd, 0xb6, 0x18, 0x25, 0x9a, 0x75, 0xd7, 0x63, 0x99, 0x81, 0xbf, 0x0c, 0xa5, 0x3d, 0xc2, 0x29,
+	0xaf, 0xbb, 0xe8, 0x07, 0x2d, 0x83, 0x0b, 0x4a, 0xb4, 0x21, 0x25, 0xe5, 0xd7, 0xe0, 0xb4, 0x9b,
+	0x80, 0xc8, 0x84, 0x3e, 0x91, 0x63, 0xcd, 0x7c, 0x4a, 0x7f, 0xbd, 0x6b, 0x7f, 0xdf, 0x82, 0x4b,
+	0x83, 0xc9, 0xdf, 0xf6, 0x08, 0x6d, 0x3d, 0x4d, 0x7f, 0xfe, 0xdf, 0x82, 0xc5, 0xd4, 0xd2, 0xf6,
+	0x66, 0xd0, 0xf6, 0xdd, 0x8d, 0xa0, 0xe3, 0x0f, 0x1f, 0xba, 0xd1, 0xd7, 0xce, 0xf2, 0x0d, 0x58,
+	0x74, 0x62, 0x2b, 0x79, 0x9d, 0x99, 0x79, 0x64, 0x92, 0xd9, 0xd9, 0xd4, 0xb3, 0x78, 0x8a, 0x5d,
+	0x83, 0xd3, 0x06, 0xda, 0x45, 0x0f, 0x05, 0xba, 0x66, 0x85, 0x9b, 0xd7, 0xd2, 0x0d, 0x2d, 0x2c,
+	0xdf, 0x86, 0x19, 0xd3, 0x9b, 0x5c, 0x48, 0x86, 0x6e, 0x91, 0xde, 0xa4, 0xda, 0x2a, 0x03, 0x51,
+	0x8b, 0x5f, 0xb4, 0x7f, 0x6c, 0xc1, 0x42, 0xcf, 0xd3, 0x63, 0x0d, 0xfe, 0x65, 0x28, 0xe9, 0x3c,
+	0x2e, 0xe3, 0x36, 0xca, 0x8f, 0x3a, 0xb5, 0xab, 0xbc, 0x26, 0x87, 0x2c, 0xb1, 0xd5, 0x68, 0x69,
+	0x57, 0x2c, 0x24, 0x72, 0xa5, 0x6a, 0xff, 0x35, 0xca, 0x88, 0xc6, 0x27, 0x54, 0x34, 0x5d, 0x46,
+	0x3a, 0xc7, 0x8b, 0xe6, 0x57, 0xa1, 0xe4, 0x22, 0x17, 0xd4, 0x27, 0x32, 0xcd, 0xe7, 0xee, 0xdb,
+	0xd2, 0xca, 0xb2, 0x6e, 0xe9, 0x18, 0x70, 0x7f, 0x94, 0x30, 0x2f, 0xc5, 0xda, 0xeb, 0x5d, 0xfb,
+	0x2d, 0x58, 0x4a, 0x19, 0xb1, 0x81, 0x82, 0x50, 0x8f, 0x47, 0x9b, 0xb3, 0xa1, 0xa6, 0xdc, 0x02,
+	0x68, 0x6b, 0xbd, 0x51, 0x8a, 0xa5, 0x59, 0xa3, 0xbb, 0xde, 0xb5, 0x7d, 0x28, 0xa7, 0x20, 0x37,
+	0x7d, 0xb2, 0xe7, 0x15, 0x85, 0xf5, 0xea, 0x58, 0xc5, 0xb2, 0x83, 0x8c, 0x9f, 0x36, 0x28, 0x2f,
+	0x1a, 0x30, 0x84, 0x4a, 0x0a, 0x50, 0xd7, 0xa1, 0x85, 0x9a, 0xd9, 0xe3, 0x45, 0x8d, 0x58, 0xac,
+	0xa1, 0xb6, 0x80, 0x8b, 0x29, 0xc8, 0x37, 0x38, 0x32, 0x5d, 0x9c, 0x14, 0x6b, 0x68, 0x1b, 0x2e,
+	0x0d, 0x44, 0x2d, 0xd8, 0xd8, 0x2c, 0x6c, 0xb2, 0x1e, 0x14, 0xec, 0xd6, 0x03, 0x58, 0x1e, 0x0c,
+	0x5b, 0xb0, 0xb9, 0x6f, 0xc3, 0x67, 0x32, 0xb8, 0xbe, 0xa0, 0x7e, 0x3b, 0x68, 0xf3, 0x2d, 0x59,
+	0x8a, 0x52, 0xbf, 0x51, 0xac, 0xd5, 0xdf, 0x86, 0x6b, 0x43, 0xd1, 0x0b, 0x36, 0x3e, 0x3b, 0xe8,
+	0xe9, 0xea, 0xbb, 0xd8, 0xb4, 0x98, 0x35, 0xbb, 0x77, 0x57, 0x58, 0x38, 0x7c, 0x07, 0x2e, 0xa7,
+	0xe0, 0xb7, 0xdb, 0x7b, 0x1e, 0xe5, 0x4d, 0x55, 0xfb, 0x17, 0x1c, 0xe4, 0x87, 0xb0, 0x72, 0x14,
+	0x70, 0xc1, 0x9e, 0xee, 0xc2, 0x95, 0x14, 0x72, 0x72, 0x36, 0xb9, 0xe3, 0x04, 0x21, 0x16, 0x3b,
+	0xda, 0x59, 0xa3, 0x55, 0xc2, 0xae, 0x11, 0x81, 0xf7, 0x68, 0x8b, 0x8a, 0x62, 0x91, 0xb3, 0xa9,
+	0x6c, 0x8b, 0x1c, 0x6e, 0x1e, 0x86, 0x01, 0x6f, 0x33, 0x7c, 0x9a, 0xe1, 0x25, 0x8f, 0xba, 0xab,
+	0x5e, 0x23, 0x60, 0x54, 0x34, 0x5b, 0xc5, 0x02, 0x7f, 0x0b, 0xae, 0xa6, 0x80, 0xef, 0xfa, 0x02,
+	0x59, 0x0b, 0x5d, 0x4a, 0x58, 0x57, 0x6d, 0x13, 0x9e, 0xe6, 0x60, 0x6f, 0x23, 0x6b, 0x51, 0xce,
+	0x69, 0xe0, 0x17, 0x5c, 0x61, 0xfd, 0xd9, 0xca, 0x24, 0xf0, 0x6a, 0x5b, 0x34, 0xe5, 0x50, 0x77,
+	0x77, 0x19, 0xf1, 0xf9, 0xbe, 0x3c, 0x0e, 0x09, 0xc2, 0x80, 0xe7, 0xc1, 0x7f, 0x19, 0x4a, 0xa1,
+	0x51, 0x1c, 0x05, 0x1f, 0x22, 0xe5, 0xf5, 0x6e, 0xf9, 0xab, 0x30, 0xef, 0x63, 0xa7, 0x4e, 0x22,
+	0xe0, 0xdc, 0x82, 0x76, 0xce, 0xc7, 0x4e, 0x4c, 0xd3, 0xfe, 0xa3, 0x95, 0x89, 0x96, 0x3e, 0xfe,
+	0xec, 0xe4, 0x52, 0x0f, 0x33, 0x1e, 0xaf, 0x3a, 0x0e, 0x72, 0xfe, 0x35, 0x46, 0x92, 0x33, 0x80,
+	0xa1, 0xbc, 0xe5, 0x9e, 0x46, 0x77, 0x9e, 0xcb, 0x39, 0x52, 0xec, 0xa9, 0xfd, 0x6a, 0xf8, 0x56,
+	0x55, 0x08, 0xf6, 0x34, 0xb3, 0x97, 0x34, 0x32, 0x14, 0xe8, 0xaa, 0xf9, 0x54, 0x70, 0x64, 0xdf,
+	0xc8, 0xec, 0x1d, 0xa2, 0x53, 0xc7, 0x61, 0x58, 0xf6, 0x17, 0xe1, 0x5c, 0xea, 0x15, 0x79, 0x75,
+	0x37, 0x0a, 0x45, 0xfb, 0xb5, 0x8c, 0x8d, 0x9b, 0x66, 0x9b, 0xba, 0x13, 0x7a, 0x54, 0x8c, 0xd6,
+	0xc1, 0x0f, 0x2c, 0xa8, 0xf4, 0x00, 0xef, 0x38, 0x4d, 0x74, 0xdb, 0xb9, 0x0b, 0xda, 0x4b, 0x70,
+	0x06, 0xf7, 0xf7, 0x51, 0x1e, 0x4c, 0x62, 0xbd, 0x89, 0xb4, 0xd1, 0xd4, 0xdb, 0xc5, 0xf1, 0xda,
+	0x42, 0x2c, 0x7f, 0x5d, 0x89, 0xe5, 0x26, 0x3c, 0x51, 0x15, 0xb4, 0x15, 0x1d, 0xee, 0xcd, 0xc7,
+	0xd2, 0x5d, 0xda, 0x42, 0xfb, 0x3b, 0xb0, 0xa0, 0xa8, 0xd4, 0x70, 0x8f, 0x08, 0xdc, 0x26, 0x34,
+	0x87, 0xc1, 0x97, 0x60, 0x96, 0xa1, 0x43, 0x43, 0x8a, 0xbe, 0xc8, 0x77, 0x4f, 0xac, 0x7a, 0xe4,
+	0xf9, 0xc5, 0xcf, 0xa2, 0xeb, 0xb1, 0x1a, 0xca, 0xe9, 0x4b, 0xbc, 0x7c, 0x0a, 0x43, 0xae, 0xa0,
+	0xbe, 0x20, 0x8f, 0x14, 0x64, 0x3f, 0x23, 0xdc, 0x70, 0xc6, 0x9a, 0x29, 0x6e, 0x13, 0x19, 0x6e,
+	0x8b, 0x26, 0xa4, 0xb6, 0x09, 0x23, 0x71, 0xf8, 0xda, 0xff, 0x8b, 0x76, 0xf7, 0xdb, 0xa4, 0x2b,
+	0x4b, 0xee, 0x28, 0xd4, 0x5e, 0x81, 0x29, 0x1e, 0xb4, 0x99, 0x83, 0xb9, 0x87, 0x0e, 0x46, 0x4f,
+	0x5d, 0xf9, 0xab, 0x5f, 0xf5, 0xcc, 0xce, 0x7f, 0x4e, 0x0b, 0xab, 0x4a, 0x26, 0xbb, 0x15, 0x84,
+	0x35, 0x50, 0xe4, 0x1a, 0x64, 0xf4, 0x64, 0xb7, 0xfa, 0x57, 0x3d, 0x63, 0xd5, 0x9c, 0x16, 0x56,
+	0xe3, 0x43, 0xb2, 0xe1, 0x57, 0x83, 0xbf, 0x18, 0xcb, 0x9a, 0x19, 0x45, 0x76, 0x41, 0x66, 0xde,
+	0x02, 0x08, 0x3c, 0xb7, 0x3e, 0xa2, 0xa9, 0xb3, 0x81, 0xe7, 0xee, 0x6a, 0x6b, 0x6f, 0x01, 0xc8,
+	0xac, 0x6c, 0x5e, 0xcc, 0x3b, 0xe1, 0x98, 0xf5, 0xb1, 0xb3, 0x7b, 0xc4, 0x30, 0x4d, 0xe6, 0x0f,
+	0x53, 0xdf, 0x67, 0x1b, 0xf6, 0x87, 0xd1, 0xf9, 0x9b, 0x19, 0xa6, 0x28, 0xe5, 0x3d, 0x6f, 0xe1,
+	0xf0, 0xf3, 0x1e, 0x3b, 0x6b, 0xf8, 0x4d, 0x74, 0x8e, 0x67, 0x67, 0x62, 0xc2, 0xd8, 0x88, 0x26,
+	0xe4, 0x5e, 0xb2, 0xbf, 0x17, 0xdd, 0xd9, 0x46, 0x73, 0x32, 0x3e, 0xbe, 0x3c, 0x11, 0xf4, 0xde,
+	0xed, 0x49, 0x19, 0xd1, 0x8a, 0x7f, 0x22, 0xc8, 0xfd, 0x3b, 0xba, 0x11, 0xa8, 0xa1, 0xd3, 0x66,
+	0xea, 0x5e, 0xe7, 0x49, 0x13, 0xdb, 0xe3, 0xb3, 0x3c, 0xea, 0x12, 0x25, 0xf7, 0x8a, 0xec, 0x22,
+	0xcc, 0x0a, 0x53, 0xfb, 0x71, 0xf3, 0xd1, 0x55, 0x22, 0xb0, 0x3f, 0xb2, 0x60, 0x65, 0xa0, 0x6d,
+	0xe9, 0x7a, 0xf1, 0x44, 0xdb, 0xb7, 0x06, 0x67, 0x63, 0x73, 0xea, 0xf1, 0x67, 0x46, 0xc6, 0xd2,
+	0x72, 0xfc, 0xa8, 0x16, 0x3d, 0xb1, 0xff, 0x6e, 0xc1, 0x85, 0x81, 0x26, 0xdf, 0x21, 0xf4, 0xa4,
+	0x4c, 0x08, 0x79, 0xe1, 0x88, 0x8c, 0x05, 0xcc, 0x18, 0xac, 0x1b, 0xf2, 0x8b, 0x84, 0x7d, 0x42,
+	0xbd, 0x36, 0xc3, 0xc8, 0x95, 0x71, 0xdb, 0xfe, 0x47, 0x74, 0x85, 0xdf, 0x17, 0xa5, 0x27, 0x6a,
+	0xaa, 0x1f, 0xe5, 0xaf, 0x89, 0x23, 0xfd, 0xf5, 0xab, 0xe8, 0x2e, 0x69, 0xab, 0xed, 0x09, 0x2a,
+	0xbf, 0xf2, 0xea, 0xf6, 0xcc, 0xbf, 0x9b, 0x30, 0xed, 0xc8, 0x9f, 0x01, 0xcb, 0xbf, 0xce, 0x30,
+	0x8a, 0xbd, 0x3c, 0xc7, 0xfa, 0x78, 0xde, 0x34, 0x9f, 0x65, 0xa1, 0xbc, 0xc5, 0x18, 0x1f, 0xde,
+	0xa9, 0x51, 0xb4, 0x7f, 0x19, 0x7f, 0x46, 0xd1, 0x4b, 0x35, 0x5e, 0xf5, 0x0a, 0xe1, 0xba, 0x0a,
+	0x93, 0x92, 0x42, 0xfe, 0x86, 0x4b, 0xab, 0x0d, 0x19, 0xd2, 0xe8, 0x96, 0xfc, 0xc4, 0x0c, 0xe9,
+	0x6f, 0xe3, 0xfd, 0x6c, 0x9f, 0xf7, 0xe3, 0xb8, 0x2e, 0x84, 0x6c, 0xef, 0x27, 0x2d, 0xe3, 0x8f,
+	0xf1, 0x49, 0x8b, 0xfd, 0x97, 0x9e, 0x62, 0x60, 0x93, 0x3b, 0x2c, 0xe8, 0x9c, 0x94, 0x29, 0x78,
+	0x05, 0xe6, 0xcc, 0xf5, 0xa0, 0xde, 0xf7, 0xe8, 0x1c, 0x53, 0x32, 0x32, 0xb5, 0xeb, 0xf9, 0xb0,
+	0xaf, 0x9a, 0x31, 0x57, 0x97, 0xcf, 0x79, 0xd5, 0xb6, 0x41, 0x79, 0xd8, 0x3e, 0x29, 0x55, 0xdb,
+	0x3a, 0xfe, 0xed, 0xe1, 0xb2, 0xf5, 0xfe, 0xc3, 0x65, 0xeb, 0xbf, 0x0f, 0x97, 0xad, 0x77, 0x1e,
+	0x2d, 0x9f, 0x7a, 0xff, 0xd1, 0xf2, 0xa9, 0x7f, 0x3d, 0x5a, 0x3e, 0x05, 0x4b, 0x34, 0x38, 0xe2,
+	0x2a, 0x78, 0xdb, 0xfa, 0xc6, 0x6a, 0x83, 0x8a, 0x66, 0x7b, 0x6f, 0xd5, 0x09, 0x5a, 0x6b, 0x89,
+	0xd2, 0xcb, 0x34, 0x48, 0xb5, 0xd6, 0x0e, 0xe3, 0xbf, 0x19, 0xec, 0x4d, 0xa9, 0xbf, 0x0a, 0x7c,
+	0xfe, 0xe3, 0x01, 0x00, 0x3c, 0xa7, 0xcc, 0x32, 0x84, 0x30, 0x00, 0x00,
+}
+
+func (m *EventOrderCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OrderType) > 0 {
+		i -= len(m.OrderType)
+		copy(dAtA[i:], m.OrderType)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOrderCancelled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderCancelled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderCancelled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CancelledBy) > 0 {
+		i -= len(m.CancelledBy)
+		copy(dAtA[i:], m.CancelledBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CancelledBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOrderFilled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderFilled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderFilled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SettlementId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SettlementId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Fees) > 0 {
+		i -= len(m.Fees)
+		copy(dAtA[i:], m.Fees)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fees)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Assets) > 0 {
+		i -= len(m.Assets)
+		copy(dAtA[i:], m.Assets)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Assets)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOrderPartiallyFilled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderPartiallyFilled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderPartiallyFilled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SettlementId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SettlementId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Fees) > 0 {
+		i -= len(m.Fees)
+		copy(dAtA[i:], m.Fees)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fees)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Assets) > 0 {
+		i -= len(m.Assets)
+		copy(dAtA[i:], m.Assets)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Assets)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOrderPartialFill) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderPartialFill) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderPartialFill) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.RemainingFees) > 0 {
+		i -= len(m.RemainingFees)
+		copy(dAtA[i:], m.RemainingFees)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RemainingFees)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.RemainingPrice) > 0 {
+		i -= len(m.RemainingPrice)
+		copy(dAtA[i:], m.RemainingPrice)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RemainingPrice)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.RemainingAssets) > 0 {
+		i -= len(m.RemainingAssets)
+		copy(dAtA[i:], m.RemainingAssets)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RemainingAssets)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.FilledFees) > 0 {
+		i -= len(m.FilledFees)
+		copy(dAtA[i:], m.FilledFees)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.FilledFees)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.FilledPrice) > 0 {
+		i -= len(m.FilledPrice)
+		copy(dAtA[i:], m.FilledPrice)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.FilledPrice)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.FilledAssets) > 0 {
+		i -= len(m.FilledAssets)
+		copy(dAtA[i:], m.FilledAssets)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.FilledAssets)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OrderType) > 0 {
+		i -= len(m.OrderType)
+		copy(dAtA[i:], m.OrderType)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FillParticipant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FillParticipant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FillParticipant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Partial {
+		i--
+		if m.Partial {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Fees) > 0 {
+		i -= len(m.Fees)
+		copy(dAtA[i:], m.Fees)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fees)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Assets) > 0 {
+		i -= len(m.Assets)
+		copy(dAtA[i:], m.Assets)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Assets)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OrderType) > 0 {
+		i -= len(m.OrderType)
+		copy(dAtA[i:], m.OrderType)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOrderExternalIDUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderExternalIDUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderExternalIDUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOrderExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOrderAmended) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderAmended) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderAmended) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Assets) > 0 {
+		i -= len(m.Assets)
+		copy(dAtA[i:], m.Assets)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Assets)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOrdersLinked) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrdersLinked) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrdersLinked) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LinkedOrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.LinkedOrderId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventLinkedOrderCancelled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventLinkedOrderCancelled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventLinkedOrderCancelled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LinkedOrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.LinkedOrderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDeadManSwitchSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDeadManSwitchSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDeadManSwitchSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deadline) > 0 {
+		i -= len(m.Deadline)
+		copy(dAtA[i:], m.Deadline)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Deadline)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.IntervalSeconds != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.IntervalSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDeadManSwitchHeartbeat) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDeadManSwitchHeartbeat) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDeadManSwitchHeartbeat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deadline) > 0 {
+		i -= len(m.Deadline)
+		copy(dAtA[i:], m.Deadline)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Deadline)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDeadManSwitchRemoved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDeadManSwitchRemoved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDeadManSwitchRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDeadManSwitchTriggered) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDeadManSwitchTriggered) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDeadManSwitchTriggered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrdersCancelled != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OrdersCancelled))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketOrdersBulkCancelled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketOrdersBulkCancelled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketOrdersBulkCancelled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HasMore {
+		i--
+		if m.HasMore {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Count != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CancelledBy) > 0 {
+		i -= len(m.CancelledBy)
+		copy(dAtA[i:], m.CancelledBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CancelledBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSelfTradePrevented) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSelfTradePrevented) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSelfTradePrevented) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Assets) > 0 {
+		i -= len(m.Assets)
+		copy(dAtA[i:], m.Assets)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Assets)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OpposingOrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OpposingOrderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTriggerOrderCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTriggerOrderCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTriggerOrderCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TriggerPrice) > 0 {
+		i -= len(m.TriggerPrice)
+		copy(dAtA[i:], m.TriggerPrice)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TriggerPrice)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OrderType) > 0 {
+		i -= len(m.OrderType)
+		copy(dAtA[i:], m.OrderType)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTriggerOrderActivated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTriggerOrderActivated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTriggerOrderActivated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OrderType) > 0 {
+		i -= len(m.OrderType)
+		copy(dAtA[i:], m.OrderType)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBatchAuctionSettled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBatchAuctionSettled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBatchAuctionSettled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClearingPrice) > 0 {
+		i -= len(m.ClearingPrice)
+		copy(dAtA[i:], m.ClearingPrice)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ClearingPrice)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Assets) > 0 {
+		i -= len(m.Assets)
+		copy(dAtA[i:], m.Assets)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Assets)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFundsCommitted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFundsCommitted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFundsCommitted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tag) > 0 {
+		i -= len(m.Tag)
+		copy(dAtA[i:], m.Tag)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Tag)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCommitmentReleased) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCommitmentReleased) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCommitmentReleased) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tag) > 0 {
+		i -= len(m.Tag)
+		copy(dAtA[i:], m.Tag)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Tag)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCommitmentExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCommitmentExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCommitmentExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCommitmentRewardsFunded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCommitmentRewardsFunded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCommitmentRewardsFunded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCommitmentRewardsDistributed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCommitmentRewardsDistributed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCommitmentRewardsDistributed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DistributedBy) > 0 {
+		i -= len(m.DistributedBy)
+		copy(dAtA[i:], m.DistributedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.DistributedBy)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BasisDenom) > 0 {
+		i -= len(m.BasisDenom)
+		copy(dAtA[i:], m.BasisDenom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BasisDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCommitmentRewardsClaimed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCommitmentRewardsClaimed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCommitmentRewardsClaimed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketWoundDown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketWoundDown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketWoundDown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Releases) > 0 {
+		for iNdEx := len(m.Releases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Releases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.MarketDeleted {
+		i--
+		if m.MarketDeleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CommitmentsReleased != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CommitmentsReleased))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OrdersCancelled != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OrdersCancelled))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WindDownRelease) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WindDownRelease) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WindDownRelease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CommitmentFunds) > 0 {
+		i -= len(m.CommitmentFunds)
+		copy(dAtA[i:], m.CommitmentFunds)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CommitmentFunds)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OrderFunds) > 0 {
+		i -= len(m.OrderFunds)
+		copy(dAtA[i:], m.OrderFunds)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderFunds)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketWithdraw) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketWithdraw) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawnBy) > 0 {
+		i -= len(m.WithdrawnBy)
+		copy(dAtA[i:], m.WithdrawnBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.WithdrawnBy)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketDetailsUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketDetailsUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketDetailsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketDisabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketDisabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketDisabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketOrdersEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketOrdersEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketOrdersEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketOrdersDisabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketOrdersDisabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketOrdersDisabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketUserSettleEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketUserSettleEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketUserSettleEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketUserSettleDisabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketUserSettleDisabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketUserSettleDisabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketCommitmentsEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketCommitmentsEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketCommitmentsEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketCommitmentsDisabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketCommitmentsDisabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketCommitmentsDisabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketContinuousMatchingEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketContinuousMatchingEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketContinuousMatchingEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketContinuousMatchingDisabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketContinuousMatchingDisabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketContinuousMatchingDisabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketBatchAuctionUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketBatchAuctionUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketBatchAuctionUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketSelfTradePreventionUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketSelfTradePreventionUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketSelfTradePreventionUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketPublishPricesEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketPublishPricesEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketPublishPricesEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketPublishPricesDisabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketPublishPricesDisabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketPublishPricesDisabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketExternalIDScopeUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketExternalIDScopeUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketExternalIDScopeUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketOrderRateLimitUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketOrderRateLimitUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketOrderRateLimitUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketMaxExposureUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketMaxExposureUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketMaxExposureUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketFillAlgorithmUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketFillAlgorithmUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketFillAlgorithmUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketIntermediaryDenomUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketIntermediaryDenomUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketIntermediaryDenomUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketPermissionsUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketPermissionsUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketPermissionsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketAuthorityTransferProposed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketAuthorityTransferProposed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketAuthorityTransferProposed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewAuthority) > 0 {
+		i -= len(m.NewAuthority)
+		copy(dAtA[i:], m.NewAuthority)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewAuthority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ProposedBy) > 0 {
+		i -= len(m.ProposedBy)
+		copy(dAtA[i:], m.ProposedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ProposedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketAuthorityTransferred) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketAuthorityTransferred) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketAuthorityTransferred) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewAuthority) > 0 {
+		i -= len(m.NewAuthority)
+		copy(dAtA[i:], m.NewAuthority)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewAuthority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ProposedBy) > 0 {
+		i -= len(m.ProposedBy)
+		copy(dAtA[i:], m.ProposedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ProposedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketAccessGrantExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketAccessGrantExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketAccessGrantExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketReqAttrUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketReqAttrUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketReqAttrUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketAcceptedDenomsUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketAcceptedDenomsUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketAcceptedDenomsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketFeesUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketFeesUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketFeesUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketExchangeSplitsUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketExchangeSplitsUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketExchangeSplitsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketFeesScheduled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketFeesScheduled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketFeesScheduled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EffectiveTime) > 0 {
+		i -= len(m.EffectiveTime)
+		copy(dAtA[i:], m.EffectiveTime)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.EffectiveTime)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EffectiveHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EffectiveHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRebatePaid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRebatePaid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRebatePaid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventReferralPaid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventReferralPaid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventReferralPaid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Referrer) > 0 {
+		i -= len(m.Referrer)
+		copy(dAtA[i:], m.Referrer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Referrer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventParamsUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventParamsUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventParamsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPaymentCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPaymentCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPaymentCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TargetAmount) > 0 {
+		i -= len(m.TargetAmount)
+		copy(dAtA[i:], m.TargetAmount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TargetAmount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourceAmount) > 0 {
+		i -= len(m.SourceAmount)
+		copy(dAtA[i:], m.SourceAmount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SourceAmount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPaymentUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPaymentUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPaymentUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TargetAmount) > 0 {
+		i -= len(m.TargetAmount)
+		copy(dAtA[i:], m.TargetAmount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TargetAmount)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.NewTarget) > 0 {
+		i -= len(m.NewTarget)
+		copy(dAtA[i:], m.NewTarget)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewTarget)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OldTarget) > 0 {
+		i -= len(m.OldTarget)
+		copy(dAtA[i:], m.OldTarget)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OldTarget)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourceAmount) > 0 {
+		i -= len(m.SourceAmount)
+		copy(dAtA[i:], m.SourceAmount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SourceAmount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPaymentAccepted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPaymentAccepted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPaymentAccepted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TargetAmount) > 0 {
+		i -= len(m.TargetAmount)
+		copy(dAtA[i:], m.TargetAmount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TargetAmount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourceAmount) > 0 {
+		i -= len(m.SourceAmount)
+		copy(dAtA[i:], m.SourceAmount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SourceAmount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPaymentRejected) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPaymentRejected) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPaymentRejected) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPaymentCancelled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPaymentCancelled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPaymentCancelled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPaymentExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPaymentExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPaymentExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRecurringPaymentCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRecurringPaymentCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRecurringPaymentCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Transfers != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Transfers))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRecurringPaymentTransferred) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRecurringPaymentTransferred) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRecurringPaymentTransferred) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TransfersRemaining != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TransfersRemaining))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRecurringPaymentFailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRecurringPaymentFailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRecurringPaymentFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Failures != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Failures))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRecurringPaymentCancelled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRecurringPaymentCancelled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRecurringPaymentCancelled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TransfersRemaining != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TransfersRemaining))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMultiPartyPaymentCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMultiPartyPaymentCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMultiPartyPaymentCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Parties) > 0 {
+		for iNdEx := len(m.Parties) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Parties[iNdEx])
+			copy(dAtA[i:], m.Parties[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Parties[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMultiPartyPaymentAccepted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMultiPartyPaymentAccepted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMultiPartyPaymentAccepted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Party) > 0 {
+		i -= len(m.Party)
+		copy(dAtA[i:], m.Party)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Party)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMultiPartyPaymentSettled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMultiPartyPaymentSettled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMultiPartyPaymentSettled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Parties) > 0 {
+		for iNdEx := len(m.Parties) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Parties[iNdEx])
+			copy(dAtA[i:], m.Parties[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Parties[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMultiPartyPaymentCancelled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMultiPartyPaymentCancelled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMultiPartyPaymentCancelled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CancelledBy) > 0 {
+		i -= len(m.CancelledBy)
+		copy(dAtA[i:], m.CancelledBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CancelledBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPaymentEscrowed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPaymentEscrowed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPaymentEscrowed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReleaseTime) > 0 {
+		i -= len(m.ReleaseTime)
+		copy(dAtA[i:], m.ReleaseTime)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ReleaseTime)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPaymentReleased) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPaymentReleased) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPaymentReleased) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TargetAmount) > 0 {
+		i -= len(m.TargetAmount)
+		copy(dAtA[i:], m.TargetAmount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TargetAmount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourceAmount) > 0 {
+		i -= len(m.SourceAmount)
+		copy(dAtA[i:], m.SourceAmount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SourceAmount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPaymentDisputed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPaymentDisputed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPaymentDisputed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventOrderCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovEvents(uint64(m.OrderId))
+	}
+	l = len(m.OrderType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOrderCancelled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovEvents(uint64(m.OrderId))
+	}
+	l = len(m.CancelledBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOrderFilled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovEvents(uint64(m.OrderId))
+	}
+	l = len(m.Assets)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Fees)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.SettlementId != 0 {
+		n += 1 + sovEvents(uint64(m.SettlementId))
+	}
+	return n
+}
+
+func (m *EventOrderPartiallyFilled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovEvents(uint64(m.OrderId))
+	}
+	l = len(m.Assets)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Fees)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.SettlementId != 0 {
+		n += 1 + sovEvents(uint64(m.SettlementId))
+	}
+	return n
+}
+
+func (m *EventOrderPartialFill) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovEvents(uint64(m.OrderId))
+	}
+	l = len(m.OrderType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.FilledAssets)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.FilledPrice)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.FilledFees)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RemainingAssets)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RemainingPrice)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RemainingFees)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FillParticipant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovEvents(uint64(m.OrderId))
+	}
+	l = len(m.OrderType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Assets)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Fees)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Partial {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventOrderExternalIDUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovEvents(uint64(m.OrderId))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOrderExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovEvents(uint64(m.OrderId))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOrderAmended) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovEvents(uint64(m.OrderId))
+	}
+	l = len(m.Assets)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOrdersLinked) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovEvents(uint64(m.OrderId))
+	}
+	if m.LinkedOrderId != 0 {
+		n += 1 + sovEvents(uint64(m.LinkedOrderId))
+	}
+	return n
+}
+
+func (m *EventLinkedOrderCancelled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovEvents(uint64(m.OrderId))
+	}
+	if m.LinkedOrderId != 0 {
+		n += 1 + sovEvents(uint64(m.LinkedOrderId))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDeadManSwitchSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.IntervalSeconds != 0 {
+		n += 1 + sovEvents(uint64(m.IntervalSeconds))
+	}
+	l = len(m.Deadline)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDeadManSwitchHeartbeat) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Deadline)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDeadManSwitchRemoved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDeadManSwitchTriggered) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.OrdersCancelled != 0 {
+		n += 1 + sovEvents(uint64(m.OrdersCancelled))
+	}
+	return n
+}
+
+func (m *EventMarketOrdersBulkCancelled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.CancelledBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovEvents(uint64(m.Count))
+	}
+	if m.HasMore {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventSelfTradePrevented) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovEvents(uint64(m.OrderId))
+	}
+	if m.OpposingOrderId != 0 {
+		n += 1 + sovEvents(uint64(m.OpposingOrderId))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Assets)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventTriggerOrderCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovEvents(uint64(m.OrderId))
+	}
+	l = len(m.OrderType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.TriggerPrice)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventTriggerOrderActivated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovEvents(uint64(m.OrderId))
+	}
+	l = len(m.OrderType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventBatchAuctionSettled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.Assets)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ClearingPrice)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventFundsCommitted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Tag)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCommitmentReleased) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Tag)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCommitmentExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCommitmentRewardsFunded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCommitmentRewardsDistributed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BasisDenom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.DistributedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCommitmentRewardsClaimed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketWoundDown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	if m.OrdersCancelled != 0 {
+		n += 1 + sovEvents(uint64(m.OrdersCancelled))
+	}
+	if m.CommitmentsReleased != 0 {
+		n += 1 + sovEvents(uint64(m.CommitmentsReleased))
+	}
+	if m.MarketDeleted {
+		n += 2
+	}
+	if len(m.Releases) > 0 {
+		for _, e := range m.Releases {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WindDownRelease) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.OrderFunds)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.CommitmentFunds)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketWithdraw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.WithdrawnBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketDetailsUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketDisabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketOrdersEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketOrdersDisabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketUserSettleEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketUserSettleDisabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketCommitmentsEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketCommitmentsDisabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketContinuousMatchingEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketContinuousMatchingDisabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketBatchAuctionUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketSelfTradePreventionUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketPublishPricesEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketPublishPricesDisabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketExternalIDScopeUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketOrderRateLimitUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketMaxExposureUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketFillAlgorithmUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketIntermediaryDenomUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketPermissionsUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketAuthorityTransferProposed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.ProposedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewAuthority)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketAuthorityTransferred) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.ProposedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewAuthority)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketAccessGrantExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketReqAttrUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketAcceptedDenomsUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	return n
+}
+
+func (m *EventMarketFeesUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	return n
+}
+
+func (m *EventMarketExchangeSplitsUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	return n
+}
+
+func (m *EventMarketFeesScheduled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	if m.EffectiveHeight != 0 {
+		n += 1 + sovEvents(uint64(m.EffectiveHeight))
+	}
+	l = len(m.EffectiveTime)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRebatePaid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventReferralPaid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovEvents(uint64(m.OrderId))
+	}
+	l = len(m.Referrer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventParamsUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EventPaymentCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SourceAmount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.TargetAmount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPaymentUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SourceAmount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.OldTarget)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewTarget)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.TargetAmount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPaymentAccepted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SourceAmount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.TargetAmount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPaymentRejected) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPaymentCancelled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPaymentExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRecurringPaymentCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Transfers != 0 {
+		n += 1 + sovEvents(uint64(m.Transfers))
+	}
+	return n
+}
+
+func (m *EventRecurringPaymentTransferred) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.TransfersRemaining != 0 {
+		n += 1 + sovEvents(uint64(m.TransfersRemaining))
+	}
+	return n
+}
+
+func (m *EventRecurringPaymentFailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Failures != 0 {
+		n += 1 + sovEvents(uint64(m.Failures))
+	}
+	return n
+}
+
+func (m *EventRecurringPaymentCancelled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.TransfersRemaining != 0 {
+		n += 1 + sovEvents(uint64(m.TransfersRemaining))
+	}
+	return n
+}
+
+func (m *EventMultiPartyPaymentCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Parties) > 0 {
+		for _, s := range m.Parties {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventMultiPartyPaymentAccepted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Party)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMultiPartyPaymentSettled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Parties) > 0 {
+		for _, s := range m.Parties {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventMultiPartyPaymentCancelled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.CancelledBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPaymentEscrowed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ReleaseTime)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPaymentReleased) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SourceAmount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.TargetAmount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPaymentDisputed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
 	}
 	l = len(m.ExternalId)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventOrderCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOrderCancelled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderCancelled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderCancelled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CancelledBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOrderFilled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderFilled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderFilled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assets = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementId", wireType)
+			}
+			m.SettlementId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOrderPartiallyFilled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderPartiallyFilled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderPartiallyFilled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assets = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementId", wireType)
+			}
+			m.SettlementId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOrderPartialFill) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderPartialFill: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderPartialFill: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilledAssets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FilledAssets = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilledPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FilledPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilledFees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FilledFees = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingAssets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemainingAssets = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemainingPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingFees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemainingFees = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, &FillParticipant{})
+			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FillParticipant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FillParticipant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FillParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assets = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partial", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Partial = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOrderExternalIDUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderExternalIDUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderExternalIDUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOrderExpired) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderExpired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOrderAmended) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderAmended: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderAmended: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assets = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOrdersLinked) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrdersLinked: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrdersLinked: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkedOrderId", wireType)
+			}
+			m.LinkedOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LinkedOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventLinkedOrderCancelled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventLinkedOrderCancelled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventLinkedOrderCancelled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkedOrderId", wireType)
+			}
+			m.LinkedOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LinkedOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDeadManSwitchSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDeadManSwitchSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDeadManSwitchSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalSeconds", wireType)
+			}
+			m.IntervalSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deadline = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDeadManSwitchHeartbeat) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDeadManSwitchHeartbeat: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDeadManSwitchHeartbeat: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deadline = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDeadManSwitchRemoved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDeadManSwitchRemoved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDeadManSwitchRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDeadManSwitchTriggered) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDeadManSwitchTriggered: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDeadManSwitchTriggered: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrdersCancelled", wireType)
+			}
+			m.OrdersCancelled = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrdersCancelled |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketOrdersBulkCancelled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketOrdersBulkCancelled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketOrdersBulkCancelled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CancelledBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMore", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasMore = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSelfTradePrevented) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSelfTradePrevented: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSelfTradePrevented: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpposingOrderId", wireType)
+			}
+			m.OpposingOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpposingOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assets = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTriggerOrderCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTriggerOrderCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTriggerOrderCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TriggerPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTriggerOrderActivated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTriggerOrderActivated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTriggerOrderActivated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBatchAuctionSettled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBatchAuctionSettled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBatchAuctionSettled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assets = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearingPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClearingPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFundsCommitted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFundsCommitted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFundsCommitted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCommitmentReleased) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCommitmentReleased: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCommitmentReleased: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCommitmentExpired) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCommitmentExpired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCommitmentExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCommitmentRewardsFunded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCommitmentRewardsFunded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCommitmentRewardsFunded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCommitmentRewardsDistributed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCommitmentRewardsDistributed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCommitmentRewardsDistributed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasisDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BasisDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCommitmentRewardsClaimed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCommitmentRewardsClaimed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCommitmentRewardsClaimed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketWoundDown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketWoundDown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketWoundDown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrdersCancelled", wireType)
+			}
+			m.OrdersCancelled = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrdersCancelled |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitmentsReleased", wireType)
+			}
+			m.CommitmentsReleased = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitmentsReleased |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketDeleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MarketDeleted = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Releases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Releases = append(m.Releases, &WindDownRelease{})
+			if err := m.Releases[len(m.Releases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WindDownRelease) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WindDownRelease: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WindDownRelease: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderFunds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderFunds = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitmentFunds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitmentFunds = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketWithdraw) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketWithdraw: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawnBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawnBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketDetailsUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketDetailsUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketDetailsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketEnabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketEnabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketDisabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketDisabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketOrdersEnabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketOrdersEnabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketOrdersEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketOrdersDisabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketOrdersDisabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketOrdersDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketUserSettleEnabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketUserSettleEnabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketUserSettleEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketUserSettleDisabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketUserSettleDisabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketUserSettleDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketCommitmentsEnabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketCommitmentsEnabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketCommitmentsEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketCommitmentsDisabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketCommitmentsDisabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketCommitmentsDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketContinuousMatchingEnabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketContinuousMatchingEnabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketContinuousMatchingEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketContinuousMatchingDisabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketContinuousMatchingDisabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketContinuousMatchingDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketBatchAuctionUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketBatchAuctionUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketBatchAuctionUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketSelfTradePreventionUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketSelfTradePreventionUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketSelfTradePreventionUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketPublishPricesEnabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketPublishPricesEnabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketPublishPricesEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketPublishPricesDisabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketPublishPricesDisabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketPublishPricesDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketExternalIDScopeUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketExternalIDScopeUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketExternalIDScopeUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketOrderRateLimitUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketOrderRateLimitUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketOrderRateLimitUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketMaxExposureUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketMaxExposureUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketMaxExposureUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketFillAlgorithmUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketFillAlgorithmUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketFillAlgorithmUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketIntermediaryDenomUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketIntermediaryDenomUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketIntermediaryDenomUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketPermissionsUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketPermissionsUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketPermissionsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *EventPaymentCancelled) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Target)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.ExternalId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventOrderCreated) Unmarshal(dAtA []byte) error {
+func (m *EventMarketAuthorityTransferProposed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3679,17 +17572,17 @@ func (m *EventOrderCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOrderCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarketAuthorityTransferProposed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOrderCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarketAuthorityTransferProposed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			m.OrderId = 0
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3699,14 +17592,14 @@ func (m *EventOrderCreated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderId |= uint64(b&0x7F) << shift
+				m.MarketId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3734,9 +17627,91 @@ func (m *EventOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderType = string(dAtA[iNdEx:postIndex])
+			m.ProposedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAuthority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAuthority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketAuthorityTransferred) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketAuthorityTransferred: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketAuthorityTransferred: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
@@ -3755,9 +17730,9 @@ func (m *EventOrderCreated) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3785,7 +17760,39 @@ func (m *EventOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			m.ProposedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAuthority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAuthority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3808,7 +17815,7 @@ func (m *EventOrderCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventOrderCancelled) Unmarshal(dAtA []byte) error {
+func (m *EventMarketAccessGrantExpired) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3831,17 +17838,17 @@ func (m *EventOrderCancelled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOrderCancelled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarketAccessGrantExpired: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOrderCancelled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarketAccessGrantExpired: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			m.OrderId = 0
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3851,14 +17858,14 @@ func (m *EventOrderCancelled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderId |= uint64(b&0x7F) << shift
+				m.MarketId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancelledBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3886,9 +17893,59 @@ func (m *EventOrderCancelled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CancelledBy = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketReqAttrUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketReqAttrUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketReqAttrUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
@@ -3907,9 +17964,9 @@ func (m *EventOrderCancelled) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3937,7 +17994,7 @@ func (m *EventOrderCancelled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3960,7 +18017,7 @@ func (m *EventOrderCancelled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventOrderFilled) Unmarshal(dAtA []byte) error {
+func (m *EventMarketAcceptedDenomsUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3983,17 +18040,17 @@ func (m *EventOrderFilled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOrderFilled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarketAcceptedDenomsUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOrderFilled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarketAcceptedDenomsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			m.OrderId = 0
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4003,14 +18060,14 @@ func (m *EventOrderFilled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderId |= uint64(b&0x7F) << shift
+				m.MarketId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4038,73 +18095,59 @@ func (m *EventOrderFilled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Assets = string(dAtA[iNdEx:postIndex])
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Price = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fees = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
@@ -4123,38 +18166,6 @@ func (m *EventOrderFilled) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ExternalId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -4176,7 +18187,7 @@ func (m *EventOrderFilled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventOrderPartiallyFilled) Unmarshal(dAtA []byte) error {
+func (m *EventMarketFeesUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4199,36 +18210,17 @@ func (m *EventOrderPartiallyFilled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOrderPartiallyFilled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarketFeesUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOrderPartiallyFilled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarketFeesUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
-			}
-			m.OrderId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OrderId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			var stringLen uint64
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4238,61 +18230,66 @@ func (m *EventOrderPartiallyFilled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MarketId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Assets = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketExchangeSplitsUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Price = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketExchangeSplitsUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketExchangeSplitsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			var stringLen uint64
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4302,25 +18299,62 @@ func (m *EventOrderPartiallyFilled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MarketId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fees = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketFeesScheduled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketFeesScheduled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketFeesScheduled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
@@ -4339,9 +18373,28 @@ func (m *EventOrderPartiallyFilled) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 6:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveHeight", wireType)
+			}
+			m.EffectiveHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4369,7 +18422,7 @@ func (m *EventOrderPartiallyFilled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			m.EffectiveTime = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4392,7 +18445,7 @@ func (m *EventOrderPartiallyFilled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventOrderExternalIDUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventRebatePaid) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4415,17 +18468,17 @@ func (m *EventOrderExternalIDUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOrderExternalIDUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRebatePaid: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOrderExternalIDUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRebatePaid: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			m.OrderId = 0
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4435,16 +18488,16 @@ func (m *EventOrderExternalIDUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderId |= uint64(b&0x7F) << shift
+				m.MarketId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
-			m.MarketId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4454,14 +18507,27 @@ func (m *EventOrderExternalIDUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4489,7 +18555,7 @@ func (m *EventOrderExternalIDUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4512,7 +18578,7 @@ func (m *EventOrderExternalIDUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventFundsCommitted) Unmarshal(dAtA []byte) error {
+func (m *EventReferralPaid) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4535,17 +18601,17 @@ func (m *EventFundsCommitted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventFundsCommitted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventReferralPaid: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventFundsCommitted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventReferralPaid: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			var stringLen uint64
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4555,29 +18621,16 @@ func (m *EventFundsCommitted) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MarketId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Account = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
-			m.MarketId = 0
+			m.OrderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4587,14 +18640,14 @@ func (m *EventFundsCommitted) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
+				m.OrderId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4622,11 +18675,11 @@ func (m *EventFundsCommitted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.Referrer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4654,7 +18707,7 @@ func (m *EventFundsCommitted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tag = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4677,7 +18730,7 @@ func (m *EventFundsCommitted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCommitmentReleased) Unmarshal(dAtA []byte) error {
+func (m *EventParamsUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4700,15 +18753,65 @@ func (m *EventCommitmentReleased) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCommitmentReleased: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventParamsUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCommitmentReleased: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventParamsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPaymentCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPaymentCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPaymentCreated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4736,13 +18839,13 @@ func (m *EventCommitmentReleased) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAmount", wireType)
 			}
-			m.MarketId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4752,14 +18855,59 @@ func (m *EventCommitmentReleased) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4787,11 +18935,11 @@ func (m *EventCommitmentReleased) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.TargetAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4819,7 +18967,7 @@ func (m *EventCommitmentReleased) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tag = string(dAtA[iNdEx:postIndex])
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4842,7 +18990,7 @@ func (m *EventCommitmentReleased) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarketWithdraw) Unmarshal(dAtA []byte) error {
+func (m *EventPaymentUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4865,17 +19013,17 @@ func (m *EventMarketWithdraw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarketWithdraw: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPaymentUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarketWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPaymentUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			m.MarketId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4885,14 +19033,27 @@ func (m *EventMarketWithdraw) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4920,11 +19081,11 @@ func (m *EventMarketWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.SourceAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldTarget", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4952,11 +19113,11 @@ func (m *EventMarketWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Destination = string(dAtA[iNdEx:postIndex])
+			m.OldTarget = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawnBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewTarget", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4984,63 +19145,13 @@ func (m *EventMarketWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithdrawnBy = string(dAtA[iNdEx:postIndex])
+			m.NewTarget = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventMarketDetailsUpdated) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarketDetailsUpdated: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarketDetailsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAmount", wireType)
 			}
-			m.MarketId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5050,14 +19161,27 @@ func (m *EventMarketDetailsUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5085,7 +19209,7 @@ func (m *EventMarketDetailsUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5108,7 +19232,7 @@ func (m *EventMarketDetailsUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarketEnabled) Unmarshal(dAtA []byte) error {
+func (m *EventPaymentAccepted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5131,17 +19255,17 @@ func (m *EventMarketEnabled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarketEnabled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPaymentAccepted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarketEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPaymentAccepted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			m.MarketId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5151,14 +19275,27 @@ func (m *EventMarketEnabled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5186,63 +19323,45 @@ func (m *EventMarketEnabled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			m.SourceAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventMarketDisabled) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarketDisabled: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarketDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAmount", wireType)
 			}
-			m.MarketId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5252,14 +19371,27 @@ func (m *EventMarketDisabled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5287,7 +19419,7 @@ func (m *EventMarketDisabled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5310,7 +19442,7 @@ func (m *EventMarketDisabled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarketOrdersEnabled) Unmarshal(dAtA []byte) error {
+func (m *EventPaymentRejected) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5333,17 +19465,17 @@ func (m *EventMarketOrdersEnabled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarketOrdersEnabled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPaymentRejected: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarketOrdersEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPaymentRejected: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			m.MarketId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5353,14 +19485,27 @@ func (m *EventMarketOrdersEnabled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5388,7 +19533,39 @@ func (m *EventMarketOrdersEnabled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5411,7 +19588,7 @@ func (m *EventMarketOrdersEnabled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarketOrdersDisabled) Unmarshal(dAtA []byte) error {
+func (m *EventPaymentCancelled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5434,17 +19611,17 @@ func (m *EventMarketOrdersDisabled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarketOrdersDisabled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPaymentCancelled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarketOrdersDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPaymentCancelled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			m.MarketId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5454,14 +19631,27 @@ func (m *EventMarketOrdersDisabled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5489,7 +19679,39 @@ func (m *EventMarketOrdersDisabled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5512,7 +19734,7 @@ func (m *EventMarketOrdersDisabled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarketUserSettleEnabled) Unmarshal(dAtA []byte) error {
+func (m *EventPaymentExpired) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5535,17 +19757,17 @@ func (m *EventMarketUserSettleEnabled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarketUserSettleEnabled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPaymentExpired: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarketUserSettleEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPaymentExpired: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			m.MarketId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5555,14 +19777,27 @@ func (m *EventMarketUserSettleEnabled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5590,7 +19825,39 @@ func (m *EventMarketUserSettleEnabled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5613,7 +19880,7 @@ func (m *EventMarketUserSettleEnabled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarketUserSettleDisabled) Unmarshal(dAtA []byte) error {
+func (m *EventRecurringPaymentCreated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5636,17 +19903,81 @@ func (m *EventMarketUserSettleDisabled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarketUserSettleDisabled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRecurringPaymentCreated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarketUserSettleDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRecurringPaymentCreated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-			m.MarketId = 0
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5656,14 +19987,27 @@ func (m *EventMarketUserSettleDisabled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5691,8 +20035,27 @@ func (m *EventMarketUserSettleDisabled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
+			}
+			m.Transfers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Transfers |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -5714,7 +20077,7 @@ func (m *EventMarketUserSettleDisabled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarketCommitmentsEnabled) Unmarshal(dAtA []byte) error {
+func (m *EventRecurringPaymentTransferred) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5737,17 +20100,17 @@ func (m *EventMarketCommitmentsEnabled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarketCommitmentsEnabled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRecurringPaymentTransferred: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarketCommitmentsEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRecurringPaymentTransferred: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			m.MarketId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5757,14 +20120,27 @@ func (m *EventMarketCommitmentsEnabled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5792,63 +20168,13 @@ func (m *EventMarketCommitmentsEnabled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			m.Target = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventMarketCommitmentsDisabled) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarketCommitmentsDisabled: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarketCommitmentsDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.MarketId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5858,14 +20184,27 @@ func (m *EventMarketCommitmentsDisabled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5893,8 +20232,27 @@ func (m *EventMarketCommitmentsDisabled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransfersRemaining", wireType)
+			}
+			m.TransfersRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransfersRemaining |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -5916,7 +20274,7 @@ func (m *EventMarketCommitmentsDisabled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarketIntermediaryDenomUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventRecurringPaymentFailed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5939,17 +20297,17 @@ func (m *EventMarketIntermediaryDenomUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarketIntermediaryDenomUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRecurringPaymentFailed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarketIntermediaryDenomUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRecurringPaymentFailed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			m.MarketId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5959,14 +20317,27 @@ func (m *EventMarketIntermediaryDenomUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5994,63 +20365,13 @@ func (m *EventMarketIntermediaryDenomUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			m.Target = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventMarketPermissionsUpdated) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarketPermissionsUpdated: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarketPermissionsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
 			}
-			m.MarketId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6060,14 +20381,27 @@ func (m *EventMarketPermissionsUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6095,8 +20429,27 @@ func (m *EventMarketPermissionsUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
+			}
+			m.Failures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failures |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -6118,7 +20471,7 @@ func (m *EventMarketPermissionsUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarketReqAttrUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventRecurringPaymentCancelled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6141,17 +20494,17 @@ func (m *EventMarketReqAttrUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarketReqAttrUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRecurringPaymentCancelled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarketReqAttrUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRecurringPaymentCancelled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			m.MarketId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6161,14 +20514,27 @@ func (m *EventMarketReqAttrUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6196,63 +20562,45 @@ func (m *EventMarketReqAttrUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			m.Target = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventMarketCreated) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarketCreated: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarketCreated: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransfersRemaining", wireType)
 			}
-			m.MarketId = 0
+			m.TransfersRemaining = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6262,7 +20610,7 @@ func (m *EventMarketCreated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
+				m.TransfersRemaining |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6288,7 +20636,7 @@ func (m *EventMarketCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarketFeesUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventMultiPartyPaymentCreated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6311,17 +20659,17 @@ func (m *EventMarketFeesUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarketFeesUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMultiPartyPaymentCreated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarketFeesUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMultiPartyPaymentCreated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			m.MarketId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6331,114 +20679,27 @@ func (m *EventMarketFeesUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventParamsUpdated) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventParamsUpdated: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventParamsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventPaymentCreated) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventPaymentCreated: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventPaymentCreated: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6466,11 +20727,11 @@ func (m *EventPaymentCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parties", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6498,11 +20759,61 @@ func (m *EventPaymentCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceAmount = string(dAtA[iNdEx:postIndex])
+			m.Parties = append(m.Parties, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMultiPartyPaymentAccepted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMultiPartyPaymentAccepted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMultiPartyPaymentAccepted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6530,11 +20841,11 @@ func (m *EventPaymentCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Target = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6562,11 +20873,11 @@ func (m *EventPaymentCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetAmount = string(dAtA[iNdEx:postIndex])
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Party", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6594,7 +20905,7 @@ func (m *EventPaymentCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			m.Party = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6617,7 +20928,7 @@ func (m *EventPaymentCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventPaymentUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventMultiPartyPaymentSettled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6640,15 +20951,15 @@ func (m *EventPaymentUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventPaymentUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMultiPartyPaymentSettled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventPaymentUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMultiPartyPaymentSettled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6676,11 +20987,11 @@ func (m *EventPaymentUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6708,11 +21019,11 @@ func (m *EventPaymentUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceAmount = string(dAtA[iNdEx:postIndex])
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldTarget", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parties", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6740,11 +21051,61 @@ func (m *EventPaymentUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OldTarget = string(dAtA[iNdEx:postIndex])
+			m.Parties = append(m.Parties, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMultiPartyPaymentCancelled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMultiPartyPaymentCancelled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMultiPartyPaymentCancelled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewTarget", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6772,11 +21133,11 @@ func (m *EventPaymentUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewTarget = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6804,11 +21165,11 @@ func (m *EventPaymentUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetAmount = string(dAtA[iNdEx:postIndex])
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6836,7 +21197,7 @@ func (m *EventPaymentUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			m.CancelledBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6859,7 +21220,7 @@ func (m *EventPaymentUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventPaymentAccepted) Unmarshal(dAtA []byte) error {
+func (m *EventPaymentEscrowed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6882,10 +21243,10 @@ func (m *EventPaymentAccepted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventPaymentAccepted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPaymentEscrowed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventPaymentAccepted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPaymentEscrowed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6921,38 +21282,6 @@ func (m *EventPaymentAccepted) Unmarshal(dAtA []byte) error {
 			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceAmount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SourceAmount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
@@ -6984,9 +21313,9 @@ func (m *EventPaymentAccepted) Unmarshal(dAtA []byte) error {
 			}
 			m.Target = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7014,11 +21343,11 @@ func (m *EventPaymentAccepted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetAmount = string(dAtA[iNdEx:postIndex])
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseTime", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7046,7 +21375,7 @@ func (m *EventPaymentAccepted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			m.ReleaseTime = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7069,7 +21398,7 @@ func (m *EventPaymentAccepted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventPaymentRejected) Unmarshal(dAtA []byte) error {
+func (m *EventPaymentReleased) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7092,10 +21421,10 @@ func (m *EventPaymentRejected) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventPaymentRejected: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPaymentReleased: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventPaymentRejected: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPaymentReleased: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7131,6 +21460,38 @@ func (m *EventPaymentRejected) Unmarshal(dAtA []byte) error {
 			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
@@ -7162,7 +21523,39 @@ func (m *EventPaymentRejected) Unmarshal(dAtA []byte) error {
 			}
 			m.Target = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
 			}
@@ -7215,7 +21608,7 @@ func (m *EventPaymentRejected) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventPaymentCancelled) Unmarshal(dAtA []byte) error {
+func (m *EventPaymentDisputed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7238,10 +21631,10 @@ func (m *EventPaymentCancelled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventPaymentCancelled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPaymentDisputed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventPaymentCancelled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPaymentDisputed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
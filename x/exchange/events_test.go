@@ -1,14 +1,18 @@
 package exchange
 
 import (
+	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/gogoproto/proto"
 
@@ -153,9 +157,10 @@ func TestNewEventOrderFilled(t *testing.T) {
 	}
 
 	tests := []struct {
-		name     string
-		order    OrderI
-		expected *EventOrderFilled
+		name         string
+		order        OrderI
+		settlementID uint64
+		expected     *EventOrderFilled
 	}{
 		{
 			name: "ask",
@@ -166,13 +171,15 @@ func TestNewEventOrderFilled(t *testing.T) {
 				SellerSettlementFlatFee: coinP("fig", 57),
 				ExternalId:              "one",
 			}),
+			settlementID: 11,
 			expected: &EventOrderFilled{
-				OrderId:    4,
-				Assets:     "22apple",
-				Price:      "18plum",
-				Fees:       "57fig",
-				MarketId:   57,
-				ExternalId: "one",
+				OrderId:      4,
+				Assets:       "22apple",
+				Price:        "18plum",
+				Fees:         "57fig",
+				MarketId:     57,
+				ExternalId:   "one",
+				SettlementId: 11,
 			},
 		},
 		{
@@ -184,13 +191,15 @@ func TestNewEventOrderFilled(t *testing.T) {
 				SellerSettlementFlatFee: coinP("fig", 57),
 				ExternalId:              "two",
 			}), sdk.NewInt64Coin("plum", 88), sdk.NewCoins(sdk.NewInt64Coin("fig", 61), sdk.NewInt64Coin("grape", 12))),
+			settlementID: 12,
 			expected: &EventOrderFilled{
-				OrderId:    4,
-				Assets:     "22apple",
-				Price:      "88plum",
-				Fees:       "61fig,12grape",
-				MarketId:   1234,
-				ExternalId: "two",
+				OrderId:      4,
+				Assets:       "22apple",
+				Price:        "88plum",
+				Fees:         "61fig,12grape",
+				MarketId:     1234,
+				ExternalId:   "two",
+				SettlementId: 12,
 			},
 		},
 		{
@@ -202,13 +211,15 @@ func TestNewEventOrderFilled(t *testing.T) {
 				BuyerSettlementFees: sdk.NewCoins(sdk.NewInt64Coin("fig", 58)),
 				ExternalId:          "three",
 			}),
+			settlementID: 13,
 			expected: &EventOrderFilled{
-				OrderId:    104,
-				Assets:     "23apple",
-				Price:      "19plum",
-				Fees:       "58fig",
-				MarketId:   87878,
-				ExternalId: "three",
+				OrderId:      104,
+				Assets:       "23apple",
+				Price:        "19plum",
+				Fees:         "58fig",
+				MarketId:     87878,
+				ExternalId:   "three",
+				SettlementId: 13,
 			},
 		},
 		{
@@ -220,13 +231,15 @@ func TestNewEventOrderFilled(t *testing.T) {
 				BuyerSettlementFees: sdk.NewCoins(sdk.NewInt64Coin("fig", 59)),
 				ExternalId:          "four",
 			}), sdk.NewInt64Coin("plum", 89), sdk.NewCoins(sdk.NewInt64Coin("fig", 62), sdk.NewInt64Coin("grape", 13))),
+			settlementID: 14,
 			expected: &EventOrderFilled{
-				OrderId:    105,
-				Assets:     "24apple",
-				Price:      "89plum",
-				Fees:       "62fig,13grape",
-				MarketId:   9119,
-				ExternalId: "four",
+				OrderId:      105,
+				Assets:       "24apple",
+				Price:        "89plum",
+				Fees:         "62fig,13grape",
+				MarketId:     9119,
+				ExternalId:   "four",
+				SettlementId: 14,
 			},
 		},
 	}
@@ -235,7 +248,7 @@ func TestNewEventOrderFilled(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			var event *EventOrderFilled
 			testFunc := func() {
-				event = NewEventOrderFilled(tc.order)
+				event = NewEventOrderFilled(tc.order, tc.settlementID)
 			}
 			require.NotPanics(t, testFunc, "NewEventOrderFilled")
 			assert.Equal(t, tc.expected, event, "NewEventOrderFilled result")
@@ -251,9 +264,10 @@ func TestNewEventOrderPartiallyFilled(t *testing.T) {
 	}
 
 	tests := []struct {
-		name     string
-		order    OrderI
-		expected *EventOrderPartiallyFilled
+		name         string
+		order        OrderI
+		settlementID uint64
+		expected     *EventOrderPartiallyFilled
 	}{
 		{
 			name: "ask",
@@ -264,13 +278,15 @@ func TestNewEventOrderPartiallyFilled(t *testing.T) {
 				SellerSettlementFlatFee: coinP("fig", 57),
 				ExternalId:              "five",
 			}),
+			settlementID: 21,
 			expected: &EventOrderPartiallyFilled{
-				OrderId:    4,
-				Assets:     "22apple",
-				Price:      "18plum",
-				Fees:       "57fig",
-				MarketId:   432,
-				ExternalId: "five",
+				OrderId:      4,
+				Assets:       "22apple",
+				Price:        "18plum",
+				Fees:         "57fig",
+				MarketId:     432,
+				ExternalId:   "five",
+				SettlementId: 21,
 			},
 		},
 		{
@@ -282,13 +298,15 @@ func TestNewEventOrderPartiallyFilled(t *testing.T) {
 				SellerSettlementFlatFee: coinP("fig", 57),
 				ExternalId:              "six",
 			}), sdk.NewInt64Coin("plum", 88), sdk.NewCoins(sdk.NewInt64Coin("fig", 61), sdk.NewInt64Coin("grape", 12))),
+			settlementID: 22,
 			expected: &EventOrderPartiallyFilled{
-				OrderId:    4,
-				Assets:     "22apple",
-				Price:      "88plum",
-				Fees:       "61fig,12grape",
-				MarketId:   456,
-				ExternalId: "six",
+				OrderId:      4,
+				Assets:       "22apple",
+				Price:        "88plum",
+				Fees:         "61fig,12grape",
+				MarketId:     456,
+				ExternalId:   "six",
+				SettlementId: 22,
 			},
 		},
 		{
@@ -300,13 +318,15 @@ func TestNewEventOrderPartiallyFilled(t *testing.T) {
 				BuyerSettlementFees: sdk.NewCoins(sdk.NewInt64Coin("fig", 58)),
 				ExternalId:          "seven",
 			}),
+			settlementID: 23,
 			expected: &EventOrderPartiallyFilled{
-				OrderId:    104,
-				Assets:     "23apple",
-				Price:      "19plum",
-				Fees:       "58fig",
-				MarketId:   765,
-				ExternalId: "seven",
+				OrderId:      104,
+				Assets:       "23apple",
+				Price:        "19plum",
+				Fees:         "58fig",
+				MarketId:     765,
+				ExternalId:   "seven",
+				SettlementId: 23,
 			},
 		},
 		{
@@ -318,13 +338,15 @@ func TestNewEventOrderPartiallyFilled(t *testing.T) {
 				BuyerSettlementFees: sdk.NewCoins(sdk.NewInt64Coin("fig", 58)),
 				ExternalId:          "eight",
 			}), sdk.NewInt64Coin("plum", 89), sdk.NewCoins(sdk.NewInt64Coin("fig", 62), sdk.NewInt64Coin("grape", 13))),
+			settlementID: 24,
 			expected: &EventOrderPartiallyFilled{
-				OrderId:    104,
-				Assets:     "23apple",
-				Price:      "89plum",
-				Fees:       "62fig,13grape",
-				MarketId:   818,
-				ExternalId: "eight",
+				OrderId:      104,
+				Assets:       "23apple",
+				Price:        "89plum",
+				Fees:         "62fig,13grape",
+				MarketId:     818,
+				ExternalId:   "eight",
+				SettlementId: 24,
 			},
 		},
 	}
@@ -333,7 +355,7 @@ func TestNewEventOrderPartiallyFilled(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			var event *EventOrderPartiallyFilled
 			testFunc := func() {
-				event = NewEventOrderPartiallyFilled(tc.order)
+				event = NewEventOrderPartiallyFilled(tc.order, tc.settlementID)
 			}
 			require.NotPanics(t, testFunc, "NewEventOrderPartiallyFilled")
 			assert.Equal(t, tc.expected, event, "NewEventOrderPartiallyFilled result")
@@ -342,6 +364,273 @@ func TestNewEventOrderPartiallyFilled(t *testing.T) {
 	}
 }
 
+func TestNewEventOrderPartialFill(t *testing.T) {
+	seller := sdk.AccAddress("seller______________").String()
+	buyer1 := sdk.AccAddress("buyer1______________").String()
+	buyer2 := sdk.AccAddress("buyer2______________").String()
+	coin := func(amount int64, denom string) sdk.Coin {
+		return sdk.NewInt64Coin(denom, amount)
+	}
+	coins := func(amount int64, denom string) sdk.Coins {
+		return sdk.NewCoins(coin(amount, denom))
+	}
+	fig3 := coin(3, "fig")
+	askOrder := NewOrder(3).WithAsk(&AskOrder{
+		MarketId: 12, Seller: seller, Assets: coin(10, "apple"), Price: coin(50, "plum"),
+		AllowPartial: true, ExternalId: "the-ask",
+	})
+	bidOrder1 := NewOrder(5).WithBid(&BidOrder{
+		MarketId: 12, Buyer: buyer1, Assets: coin(4, "apple"), Price: coin(24, "plum"),
+		BuyerSettlementFees: coins(2, "fig"),
+	})
+	bidOrder2 := NewOrder(7).WithBid(&BidOrder{
+		MarketId: 12, Buyer: buyer2, Assets: coin(10, "apple"), Price: coin(60, "plum"),
+		BuyerSettlementFees: coins(10, "fig"), AllowPartial: true, ExternalId: "the-bid",
+	})
+
+	tests := []struct {
+		name       string
+		settlement *Settlement
+		expected   *EventOrderPartialFill
+	}{
+		{
+			name:       "nil settlement",
+			settlement: nil,
+			expected:   nil,
+		},
+		{
+			name: "no partial order",
+			settlement: &Settlement{
+				FullyFilledOrders: []*FilledOrder{NewFilledOrder(askOrder, coin(50, "plum"), nil)},
+			},
+			expected: nil,
+		},
+		{
+			name: "partial ask",
+			settlement: &Settlement{
+				FullyFilledOrders: []*FilledOrder{NewFilledOrder(bidOrder1, coin(24, "plum"), coins(2, "fig"))},
+				PartialOrderFilled: NewFilledOrder(NewOrder(3).WithAsk(&AskOrder{
+					MarketId: 12, Seller: seller, Assets: coin(4, "apple"), Price: coin(20, "plum"),
+					AllowPartial: true, ExternalId: "the-ask",
+				}), coin(24, "plum"), coins(1, "fig")),
+				PartialOrderLeft: NewOrder(3).WithAsk(&AskOrder{
+					MarketId: 12, Seller: seller, Assets: coin(6, "apple"), Price: coin(30, "plum"),
+					SellerSettlementFlatFee: &fig3,
+					AllowPartial:            true,
+					ExternalId:              "the-ask",
+				}),
+			},
+			expected: &EventOrderPartialFill{
+				OrderId:         3,
+				OrderType:       "ask",
+				MarketId:        12,
+				ExternalId:      "the-ask",
+				Owner:           seller,
+				FilledAssets:    "4apple",
+				FilledPrice:     "24plum",
+				FilledFees:      "1fig",
+				RemainingAssets: "6apple",
+				RemainingPrice:  "30plum",
+				RemainingFees:   "3fig",
+				Participants: []*FillParticipant{
+					{OrderId: 5, OrderType: "bid", Owner: buyer1, Assets: "4apple", Price: "24plum", Fees: "2fig"},
+					{OrderId: 3, OrderType: "ask", Owner: seller, Assets: "4apple", Price: "24plum", Fees: "1fig", Partial: true},
+				},
+			},
+		},
+		{
+			name: "partial bid, two asks",
+			settlement: &Settlement{
+				FullyFilledOrders: []*FilledOrder{
+					NewFilledOrder(askOrder, coin(50, "plum"), coins(5, "fig")),
+					NewFilledOrder(NewOrder(9).WithAsk(&AskOrder{
+						MarketId: 12, Seller: buyer1, Assets: coin(2, "apple"), Price: coin(8, "plum"),
+					}), coin(10, "plum"), nil),
+				},
+				PartialOrderFilled: NewFilledOrder(NewOrder(7).WithBid(&BidOrder{
+					MarketId: 12, Buyer: buyer2, Assets: coin(12, "apple"), Price: coin(60, "plum"),
+					BuyerSettlementFees: coins(12, "fig"), AllowPartial: true, ExternalId: "the-bid",
+				}), coin(60, "plum"), coins(12, "fig")),
+				PartialOrderLeft: bidOrder2,
+			},
+			expected: &EventOrderPartialFill{
+				OrderId:         7,
+				OrderType:       "bid",
+				MarketId:        12,
+				ExternalId:      "the-bid",
+				Owner:           buyer2,
+				FilledAssets:    "12apple",
+				FilledPrice:     "60plum",
+				FilledFees:      "12fig",
+				RemainingAssets: "10apple",
+				RemainingPrice:  "60plum",
+				RemainingFees:   "10fig",
+				Participants: []*FillParticipant{
+					{OrderId: 3, OrderType: "ask", Owner: seller, Assets: "10apple", Price: "50plum", Fees: "5fig"},
+					{OrderId: 9, OrderType: "ask", Owner: buyer1, Assets: "2apple", Price: "10plum"},
+					{OrderId: 7, OrderType: "bid", Owner: buyer2, Assets: "12apple", Price: "60plum", Fees: "12fig", Partial: true},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var event *EventOrderPartialFill
+			testFunc := func() {
+				event = NewEventOrderPartialFill(tc.settlement)
+			}
+			require.NotPanics(t, testFunc, "NewEventOrderPartialFill")
+			assert.Equal(t, tc.expected, event, "NewEventOrderPartialFill result")
+			if tc.expected != nil {
+				assertEverythingSet(t, event, "EventOrderPartialFill")
+			}
+		})
+	}
+}
+
+func TestNewEventOrderPartialFillFor(t *testing.T) {
+	seller1 := sdk.AccAddress("seller1_____________").String()
+	seller2 := sdk.AccAddress("seller2_____________").String()
+	buyer := sdk.AccAddress("buyer_______________").String()
+	coin := func(amount int64, denom string) sdk.Coin {
+		return sdk.NewInt64Coin(denom, amount)
+	}
+	askOrder := func(orderID uint64, seller string, assets, price int64) *Order {
+		return NewOrder(orderID).WithAsk(&AskOrder{
+			MarketId: 4, Seller: seller, Assets: coin(assets, "apple"), Price: coin(price, "plum"), AllowPartial: true,
+		})
+	}
+
+	bidOrder := NewOrder(8).WithBid(&BidOrder{
+		MarketId: 4, Buyer: buyer, Assets: coin(6, "apple"), Price: coin(30, "plum"), ExternalId: "the-bid",
+	})
+	partial1 := &PartialOrder{
+		Filled: NewFilledOrder(askOrder(2, seller1, 4, 20), coin(20, "plum"), nil),
+		Left:   askOrder(2, seller1, 6, 30),
+	}
+	partial2 := &PartialOrder{
+		Filled: NewFilledOrder(askOrder(3, seller2, 2, 10), coin(10, "plum"), nil),
+		Left:   askOrder(3, seller2, 3, 15),
+	}
+	settlement := &Settlement{
+		FullyFilledOrders:  []*FilledOrder{NewFilledOrder(bidOrder, coin(30, "plum"), nil)},
+		OtherPartialOrders: []*PartialOrder{partial1, partial2},
+	}
+	participants := []*FillParticipant{
+		{OrderId: 8, OrderType: "bid", Owner: buyer, Assets: "6apple", Price: "30plum", Fees: ""},
+		{OrderId: 2, OrderType: "ask", Owner: seller1, Assets: "4apple", Price: "20plum", Fees: "", Partial: true},
+		{OrderId: 3, OrderType: "ask", Owner: seller2, Assets: "2apple", Price: "10plum", Fees: "", Partial: true},
+	}
+
+	tests := []struct {
+		name       string
+		settlement *Settlement
+		partial    *PartialOrder
+		expected   *EventOrderPartialFill
+	}{
+		{
+			name:       "nil partial",
+			settlement: settlement,
+			partial:    nil,
+			expected:   nil,
+		},
+		{
+			name:       "nothing filled",
+			settlement: settlement,
+			partial:    &PartialOrder{Left: askOrder(2, seller1, 6, 30)},
+			expected:   nil,
+		},
+		{
+			name:       "first of two other partials",
+			settlement: settlement,
+			partial:    partial1,
+			expected: &EventOrderPartialFill{
+				OrderId: 2, OrderType: "ask", MarketId: 4, Owner: seller1,
+				FilledAssets: "4apple", FilledPrice: "20plum", FilledFees: "",
+				RemainingAssets: "6apple", RemainingPrice: "30plum", RemainingFees: "",
+				Participants: participants,
+			},
+		},
+		{
+			name:       "second of two other partials",
+			settlement: settlement,
+			partial:    partial2,
+			expected: &EventOrderPartialFill{
+				OrderId: 3, OrderType: "ask", MarketId: 4, Owner: seller2,
+				FilledAssets: "2apple", FilledPrice: "10plum", FilledFees: "",
+				RemainingAssets: "3apple", RemainingPrice: "15plum", RemainingFees: "",
+				Participants: participants,
+			},
+		},
+		{
+			name:       "nil settlement",
+			settlement: nil,
+			partial:    partial2,
+			expected: &EventOrderPartialFill{
+				OrderId: 3, OrderType: "ask", MarketId: 4, Owner: seller2,
+				FilledAssets: "2apple", FilledPrice: "10plum", FilledFees: "",
+				RemainingAssets: "3apple", RemainingPrice: "15plum", RemainingFees: "",
+				Participants: participants[2:],
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var event *EventOrderPartialFill
+			testFunc := func() {
+				event = NewEventOrderPartialFillFor(tc.settlement, tc.partial)
+			}
+			require.NotPanics(t, testFunc, "NewEventOrderPartialFillFor")
+			assert.Equal(t, tc.expected, event, "NewEventOrderPartialFillFor result")
+		})
+	}
+}
+
+func TestNewFillParticipant(t *testing.T) {
+	owner := sdk.AccAddress("owner_______________").String()
+
+	tests := []struct {
+		name     string
+		order    *FilledOrder
+		partial  bool
+		expected *FillParticipant
+	}{
+		{
+			name: "ask, not partial",
+			order: NewFilledOrder(NewOrder(1).WithAsk(&AskOrder{
+				MarketId: 2, Seller: owner, Assets: sdk.NewInt64Coin("apple", 3), Price: sdk.NewInt64Coin("plum", 4),
+			}), sdk.NewInt64Coin("plum", 5), sdk.NewCoins(sdk.NewInt64Coin("fig", 6))),
+			partial: false,
+			expected: &FillParticipant{
+				OrderId: 1, OrderType: "ask", Owner: owner, Assets: "3apple", Price: "5plum", Fees: "6fig",
+			},
+		},
+		{
+			name: "bid, partial",
+			order: NewFilledOrder(NewOrder(7).WithBid(&BidOrder{
+				MarketId: 8, Buyer: owner, Assets: sdk.NewInt64Coin("apple", 9), Price: sdk.NewInt64Coin("plum", 10),
+			}), sdk.NewInt64Coin("plum", 10), nil),
+			partial: true,
+			expected: &FillParticipant{
+				OrderId: 7, OrderType: "bid", Owner: owner, Assets: "9apple", Price: "10plum", Partial: true,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual *FillParticipant
+			testFunc := func() {
+				actual = NewFillParticipant(tc.order, tc.partial)
+			}
+			require.NotPanics(t, testFunc, "NewFillParticipant")
+			assert.Equal(t, tc.expected, actual, "NewFillParticipant result")
+		})
+	}
+}
+
 func TestNewEventOrderExternalIDUpdated(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -381,159 +670,655 @@ func TestNewEventOrderExternalIDUpdated(t *testing.T) {
 	}
 }
 
-func TestNewEventFundsCommitted(t *testing.T) {
-	account := sdk.AccAddress("account_____________").String()
-	marketID := uint32(4444)
-	amount := sdk.NewCoins(sdk.NewInt64Coin("apple", 57), sdk.NewInt64Coin("banana", 99))
-	tag := "help-help-i-have-been-committed"
-
-	var event *EventFundsCommitted
-	testFunc := func() {
-		event = NewEventFundsCommitted(account, marketID, amount, tag)
+func TestNewEventOrderExpired(t *testing.T) {
+	tests := []struct {
+		name     string
+		order    OrderI
+		expected *EventOrderExpired
+	}{
+		{
+			name:  "ask",
+			order: NewOrder(14).WithAsk(&AskOrder{MarketId: 3, ExternalId: "stale-ask"}),
+			expected: &EventOrderExpired{
+				OrderId:    14,
+				MarketId:   3,
+				ExternalId: "stale-ask",
+			},
+		},
+		{
+			name:  "bid",
+			order: NewOrder(4_000).WithBid(&BidOrder{MarketId: 88, ExternalId: "stale-bid"}),
+			expected: &EventOrderExpired{
+				OrderId:    4_000,
+				MarketId:   88,
+				ExternalId: "stale-bid",
+			},
+		},
 	}
-	require.NotPanics(t, testFunc, "NewEventFundsCommitted(%q, %d, %q, %q)", account, marketID, amount, tag)
-	assert.Equal(t, account, event.Account, "Account")
-	assert.Equal(t, marketID, event.MarketId, "MarketId")
-	assert.Equal(t, amount.String(), event.Amount, "Amount")
-	assert.Equal(t, tag, event.Tag, "Tag")
-	assertEverythingSet(t, event, "EventFundsCommitted")
-}
 
-func TestNewEventCommitmentReleased(t *testing.T) {
-	account := sdk.AccAddress("account_____________").String()
-	marketID := uint32(4444)
-	amount := sdk.NewCoins(sdk.NewInt64Coin("apple", 57), sdk.NewInt64Coin("banana", 99))
-	tag := "i-have-been-released"
-
-	var event *EventCommitmentReleased
-	testFunc := func() {
-		event = NewEventCommitmentReleased(account, marketID, amount, tag)
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var event *EventOrderExpired
+			testFunc := func() {
+				event = NewEventOrderExpired(tc.order)
+			}
+			require.NotPanics(t, testFunc, "NewEventOrderExpired")
+			assert.Equal(t, tc.expected, event, "NewEventOrderExpired result")
+			assertEverythingSet(t, event, "EventOrderExpired")
+		})
 	}
-	require.NotPanics(t, testFunc, "NewEventCommitmentReleased(%q, %d, %q, %q)", account, marketID, amount, tag)
-	assert.Equal(t, account, event.Account, "Account")
-	assert.Equal(t, marketID, event.MarketId, "MarketId")
-	assert.Equal(t, amount.String(), event.Amount, "Amount")
-	assert.Equal(t, tag, event.Tag, "Tag")
-	assertEverythingSet(t, event, "EventCommitmentReleased")
 }
 
-func TestNewEventMarketWithdraw(t *testing.T) {
-	marketID := uint32(55)
-	amountWithdrawn := sdk.NewCoins(sdk.NewInt64Coin("mine", 188382), sdk.NewInt64Coin("yours", 3))
-	destination := sdk.AccAddress("destination_________")
-	withdrawnBy := sdk.AccAddress("withdrawnBy_________").String()
+func TestNewEventOrderAmended(t *testing.T) {
+	tests := []struct {
+		name     string
+		order    OrderI
+		expected *EventOrderAmended
+	}{
+		{
+			name: "ask",
+			order: NewOrder(21).WithAsk(&AskOrder{
+				MarketId:   4,
+				Assets:     sdk.NewInt64Coin("apple", 15),
+				Price:      sdk.NewInt64Coin("plum", 30),
+				ExternalId: "changed-ask",
+			}),
+			expected: &EventOrderAmended{
+				OrderId:    21,
+				Assets:     "15apple",
+				Price:      "30plum",
+				MarketId:   4,
+				ExternalId: "changed-ask",
+			},
+		},
+		{
+			name: "bid",
+			order: NewOrder(3_000).WithBid(&BidOrder{
+				MarketId:   52,
+				Assets:     sdk.NewInt64Coin("apple", 8),
+				Price:      sdk.NewInt64Coin("plum", 77),
+				ExternalId: "changed-bid",
+			}),
+			expected: &EventOrderAmended{
+				OrderId:    3_000,
+				Assets:     "8apple",
+				Price:      "77plum",
+				MarketId:   52,
+				ExternalId: "changed-bid",
+			},
+		},
+	}
 
-	var event *EventMarketWithdraw
-	testFunc := func() {
-		event = NewEventMarketWithdraw(marketID, amountWithdrawn, destination, withdrawnBy)
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var event *EventOrderAmended
+			testFunc := func() {
+				event = NewEventOrderAmended(tc.order)
+			}
+			require.NotPanics(t, testFunc, "NewEventOrderAmended")
+			assert.Equal(t, tc.expected, event, "NewEventOrderAmended result")
+			assertEverythingSet(t, event, "EventOrderAmended")
+		})
 	}
-	require.NotPanics(t, testFunc, "NewEventMarketWithdraw(%d, %q, %q, %q)",
-		marketID, amountWithdrawn, string(destination), withdrawnBy)
-	assert.Equal(t, marketID, event.MarketId, "MarketId")
-	assert.Equal(t, amountWithdrawn.String(), event.Amount, "Amount")
-	assert.Equal(t, destination.String(), event.Destination, "Destination")
-	assert.Equal(t, withdrawnBy, event.WithdrawnBy, "WithdrawnBy")
-	assertEverythingSet(t, event, "EventMarketWithdraw")
 }
 
-func TestNewEventMarketDetailsUpdated(t *testing.T) {
-	marketID := uint32(84)
-	updatedBy := sdk.AccAddress("updatedBy___________").String()
+func TestNewEventOrdersLinked(t *testing.T) {
+	link := NewOrderLink(3, 88, 91)
+	expected := &EventOrdersLinked{MarketId: 3, OrderId: 88, LinkedOrderId: 91}
 
-	var event *EventMarketDetailsUpdated
+	var event *EventOrdersLinked
 	testFunc := func() {
-		event = NewEventMarketDetailsUpdated(marketID, updatedBy)
+		event = NewEventOrdersLinked(link)
 	}
-	require.NotPanics(t, testFunc, "NewEventMarketDetailsUpdated(%d, %q)", marketID, updatedBy)
-	assert.Equal(t, marketID, event.MarketId, "MarketId")
-	assert.Equal(t, updatedBy, event.UpdatedBy, "UpdatedBy")
-	assertEverythingSet(t, event, "EventMarketDetailsUpdated")
+	require.NotPanics(t, testFunc, "NewEventOrdersLinked")
+	assert.Equal(t, expected, event, "NewEventOrdersLinked result")
+	assertEverythingSet(t, event, "EventOrdersLinked")
 }
 
-func TestNewEventMarketAcceptingOrdersUpdated(t *testing.T) {
-	someAddr := sdk.AccAddress("some_address________").String()
-
+func TestNewEventLinkedOrderCancelled(t *testing.T) {
 	tests := []struct {
-		name      string
-		marketID  uint32
-		updatedBy string
-		isActive  bool
-		expected  proto.Message
+		name          string
+		order         OrderI
+		linkedOrderID uint64
+		expected      *EventLinkedOrderCancelled
 	}{
 		{
-			name:      "enabled",
-			marketID:  33,
-			updatedBy: someAddr,
-			isActive:  true,
-			expected:  NewEventMarketOrdersEnabled(33, someAddr),
+			name:          "ask",
+			order:         NewOrder(17).WithAsk(&AskOrder{MarketId: 5, ExternalId: "take-profit"}),
+			linkedOrderID: 18,
+			expected: &EventLinkedOrderCancelled{
+				OrderId:       17,
+				LinkedOrderId: 18,
+				MarketId:      5,
+				ExternalId:    "take-profit",
+			},
 		},
 		{
-			name:      "disabled",
-			marketID:  556,
-			updatedBy: someAddr,
-			isActive:  false,
-			expected:  NewEventMarketOrdersDisabled(556, someAddr),
+			name:          "bid",
+			order:         NewOrder(4_321).WithBid(&BidOrder{MarketId: 62, ExternalId: "other-bid"}),
+			linkedOrderID: 1_234,
+			expected: &EventLinkedOrderCancelled{
+				OrderId:       4_321,
+				LinkedOrderId: 1_234,
+				MarketId:      62,
+				ExternalId:    "other-bid",
+			},
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			var event proto.Message
+			var event *EventLinkedOrderCancelled
 			testFunc := func() {
-				event = NewEventMarketAcceptingOrdersUpdated(tc.marketID, tc.updatedBy, tc.isActive)
+				event = NewEventLinkedOrderCancelled(tc.order, tc.linkedOrderID)
 			}
-			require.NotPanics(t, testFunc, "NewEventMarketAcceptingOrdersUpdated(%d, %q, %t) result",
-				tc.marketID, tc.updatedBy, tc.isActive)
-			assert.Equal(t, tc.expected, event, "NewEventMarketAcceptingOrdersUpdated(%d, %q, %t) result",
-				tc.marketID, tc.updatedBy, tc.isActive)
+			require.NotPanics(t, testFunc, "NewEventLinkedOrderCancelled")
+			assert.Equal(t, tc.expected, event, "NewEventLinkedOrderCancelled result")
+			assertEverythingSet(t, event, "EventLinkedOrderCancelled")
 		})
 	}
 }
 
-func TestNewEventMarketOrdersEnabled(t *testing.T) {
-	marketID := uint32(919)
-	updatedBy := sdk.AccAddress("updatedBy___________").String()
+func TestNewEventDeadManSwitchSet(t *testing.T) {
+	account := sdk.AccAddress("account_____________").String()
+	dms := &DeadManSwitch{
+		Account:         account,
+		IntervalSeconds: 90,
+		Deadline:        time.Date(2024, 5, 6, 7, 8, 9, 0, time.FixedZone("other", -3600)),
+	}
+	expected := &EventDeadManSwitchSet{Account: account, IntervalSeconds: 90, Deadline: "2024-05-06T08:08:09Z"}
 
-	var event *EventMarketOrdersEnabled
+	var event *EventDeadManSwitchSet
 	testFunc := func() {
-		event = NewEventMarketOrdersEnabled(marketID, updatedBy)
+		event = NewEventDeadManSwitchSet(dms)
 	}
-	require.NotPanics(t, testFunc, "NewEventMarketOrdersEnabled(%d, %q)", marketID, updatedBy)
-	assert.Equal(t, marketID, event.MarketId, "MarketId")
-	assert.Equal(t, updatedBy, event.UpdatedBy, "UpdatedBy")
-	assertEverythingSet(t, event, "EventMarketOrdersEnabled")
+	require.NotPanics(t, testFunc, "NewEventDeadManSwitchSet")
+	assert.Equal(t, expected, event, "NewEventDeadManSwitchSet result")
+	assertEverythingSet(t, event, "EventDeadManSwitchSet")
 }
 
-func TestNewEventMarketOrdersDisabled(t *testing.T) {
-	marketID := uint32(5555)
-	updatedBy := sdk.AccAddress("updatedBy___________").String()
+func TestNewEventDeadManSwitchHeartbeat(t *testing.T) {
+	account := sdk.AccAddress("account_____________").String()
+	dms := &DeadManSwitch{
+		Account:         account,
+		IntervalSeconds: 90,
+		Deadline:        time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC),
+	}
+	expected := &EventDeadManSwitchHeartbeat{Account: account, Deadline: "2024-05-06T07:08:09Z"}
 
-	var event *EventMarketOrdersDisabled
+	var event *EventDeadManSwitchHeartbeat
 	testFunc := func() {
-		event = NewEventMarketOrdersDisabled(marketID, updatedBy)
+		event = NewEventDeadManSwitchHeartbeat(dms)
 	}
-	require.NotPanics(t, testFunc, "NewEventMarketOrdersDisabled(%d, %q)", marketID, updatedBy)
-	assert.Equal(t, marketID, event.MarketId, "MarketId")
-	assert.Equal(t, updatedBy, event.UpdatedBy, "UpdatedBy")
-	assertEverythingSet(t, event, "EventMarketOrdersDisabled")
+	require.NotPanics(t, testFunc, "NewEventDeadManSwitchHeartbeat")
+	assert.Equal(t, expected, event, "NewEventDeadManSwitchHeartbeat result")
+	assertEverythingSet(t, event, "EventDeadManSwitchHeartbeat")
 }
 
-func TestNewEventMarketUserSettleUpdated(t *testing.T) {
-	someAddr := sdk.AccAddress("some_address________").String()
+func TestNewEventDeadManSwitchRemoved(t *testing.T) {
+	account := sdk.AccAddress("account_____________").String()
+
+	var event *EventDeadManSwitchRemoved
+	testFunc := func() {
+		event = NewEventDeadManSwitchRemoved(account)
+	}
+	require.NotPanics(t, testFunc, "NewEventDeadManSwitchRemoved")
+	assert.Equal(t, account, event.Account, "Account")
+	assertEverythingSet(t, event, "EventDeadManSwitchRemoved")
+}
+
+func TestNewEventDeadManSwitchTriggered(t *testing.T) {
+	account := sdk.AccAddress("account_____________").String()
+	ordersCancelled := uint32(12)
+
+	var event *EventDeadManSwitchTriggered
+	testFunc := func() {
+		event = NewEventDeadManSwitchTriggered(account, ordersCancelled)
+	}
+	require.NotPanics(t, testFunc, "NewEventDeadManSwitchTriggered(%q, %d)", account, ordersCancelled)
+	assert.Equal(t, account, event.Account, "Account")
+	assert.Equal(t, ordersCancelled, event.OrdersCancelled, "OrdersCancelled")
+	assertEverythingSet(t, event, "EventDeadManSwitchTriggered")
+}
+
+func TestNewEventMarketOrdersBulkCancelled(t *testing.T) {
+	marketID := uint32(83)
+	cancelledBy := sdk.AccAddress("cancelledBy_________").String()
+	count := uint32(100)
+	hasMore := true
 
+	var event *EventMarketOrdersBulkCancelled
+	testFunc := func() {
+		event = NewEventMarketOrdersBulkCancelled(marketID, cancelledBy, count, hasMore)
+	}
+	require.NotPanics(t, testFunc, "NewEventMarketOrdersBulkCancelled(%d, %q, %d, %t)", marketID, cancelledBy, count, hasMore)
+	assert.Equal(t, marketID, event.MarketId, "MarketId")
+	assert.Equal(t, cancelledBy, event.CancelledBy, "CancelledBy")
+	assert.Equal(t, count, event.Count, "Count")
+	assert.Equal(t, hasMore, event.HasMore, "HasMore")
+	assertEverythingSet(t, event, "EventMarketOrdersBulkCancelled")
+}
+
+func TestNewEventSelfTradePrevented(t *testing.T) {
 	tests := []struct {
-		name      string
-		marketID  uint32
-		updatedBy string
-		isAllowed bool
-		expected  proto.Message
+		name            string
+		order           OrderI
+		opposingOrderID uint64
+		assets          sdk.Coin
+		expected        *EventSelfTradePrevented
 	}{
 		{
-			name:      "enabled",
-			marketID:  33,
-			updatedBy: someAddr,
-			isAllowed: true,
-			expected:  NewEventMarketUserSettleEnabled(33, someAddr),
+			name:            "ask",
+			order:           NewOrder(21).WithAsk(&AskOrder{MarketId: 3, ExternalId: "wash-ask"}),
+			opposingOrderID: 20,
+			assets:          sdk.NewInt64Coin("apple", 15),
+			expected: &EventSelfTradePrevented{
+				OrderId:         21,
+				OpposingOrderId: 20,
+				MarketId:        3,
+				ExternalId:      "wash-ask",
+				Assets:          "15apple",
+			},
+		},
+		{
+			name:            "bid",
+			order:           NewOrder(5_678).WithBid(&BidOrder{MarketId: 71, ExternalId: "wash-bid"}),
+			opposingOrderID: 8_765,
+			assets:          sdk.NewInt64Coin("banana", 3),
+			expected: &EventSelfTradePrevented{
+				OrderId:         5_678,
+				OpposingOrderId: 8_765,
+				MarketId:        71,
+				ExternalId:      "wash-bid",
+				Assets:          "3banana",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var event *EventSelfTradePrevented
+			testFunc := func() {
+				event = NewEventSelfTradePrevented(tc.order, tc.opposingOrderID, tc.assets)
+			}
+			require.NotPanics(t, testFunc, "NewEventSelfTradePrevented")
+			assert.Equal(t, tc.expected, event, "NewEventSelfTradePrevented result")
+			assertEverythingSet(t, event, "EventSelfTradePrevented")
+		})
+	}
+}
+
+func TestNewEventTriggerOrderCreated(t *testing.T) {
+	tests := []struct {
+		name         string
+		triggerOrder *TriggerOrder
+		expected     *EventTriggerOrderCreated
+	}{
+		{
+			name: "ask",
+			triggerOrder: NewTriggerOrder(
+				*NewOrder(5).WithAsk(&AskOrder{MarketId: 2, ExternalId: "stop-loss"}),
+				sdk.NewInt64Coin("nhash", 80),
+			),
+			expected: &EventTriggerOrderCreated{
+				OrderId:      5,
+				OrderType:    "ask",
+				MarketId:     2,
+				ExternalId:   "stop-loss",
+				TriggerPrice: "80nhash",
+			},
+		},
+		{
+			name: "bid",
+			triggerOrder: NewTriggerOrder(
+				*NewOrder(1_234).WithBid(&BidOrder{MarketId: 44, ExternalId: "stop-buy"}),
+				sdk.NewInt64Coin("pear", 1_500),
+			),
+			expected: &EventTriggerOrderCreated{
+				OrderId:      1_234,
+				OrderType:    "bid",
+				MarketId:     44,
+				ExternalId:   "stop-buy",
+				TriggerPrice: "1500pear",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var event *EventTriggerOrderCreated
+			testFunc := func() {
+				event = NewEventTriggerOrderCreated(tc.triggerOrder)
+			}
+			require.NotPanics(t, testFunc, "NewEventTriggerOrderCreated")
+			assert.Equal(t, tc.expected, event, "NewEventTriggerOrderCreated result")
+			assertEverythingSet(t, event, "EventTriggerOrderCreated")
+		})
+	}
+}
+
+func TestNewEventTriggerOrderActivated(t *testing.T) {
+	tests := []struct {
+		name     string
+		order    OrderI
+		price    sdk.Coin
+		expected *EventTriggerOrderActivated
+	}{
+		{
+			name:  "ask",
+			order: NewOrder(8).WithAsk(&AskOrder{MarketId: 3, ExternalId: "stop-loss"}),
+			price: sdk.NewInt64Coin("nhash", 7),
+			expected: &EventTriggerOrderActivated{
+				OrderId:    8,
+				OrderType:  "ask",
+				MarketId:   3,
+				ExternalId: "stop-loss",
+				Price:      "7nhash",
+			},
+		},
+		{
+			name:  "bid",
+			order: NewOrder(90_210).WithBid(&BidOrder{MarketId: 55, ExternalId: "stop-buy"}),
+			price: sdk.NewInt64Coin("plum", 123),
+			expected: &EventTriggerOrderActivated{
+				OrderId:    90_210,
+				OrderType:  "bid",
+				MarketId:   55,
+				ExternalId: "stop-buy",
+				Price:      "123plum",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var event *EventTriggerOrderActivated
+			testFunc := func() {
+				event = NewEventTriggerOrderActivated(tc.order, tc.price)
+			}
+			require.NotPanics(t, testFunc, "NewEventTriggerOrderActivated")
+			assert.Equal(t, tc.expected, event, "NewEventTriggerOrderActivated result")
+			assertEverythingSet(t, event, "EventTriggerOrderActivated")
+		})
+	}
+}
+
+func TestNewEventBatchAuctionSettled(t *testing.T) {
+	marketID := uint32(4004)
+	assets := sdk.NewInt64Coin("apple", 25)
+	price := sdk.NewInt64Coin("plum", 53)
+	clearingPrice := sdk.NewDecCoinFromDec("plum", sdkmath.LegacyMustNewDecFromStr("2.12"))
+
+	var event *EventBatchAuctionSettled
+	testFunc := func() {
+		event = NewEventBatchAuctionSettled(marketID, assets, price, clearingPrice)
+	}
+	require.NotPanics(t, testFunc, "NewEventBatchAuctionSettled(%d, %q, %q, %q)", marketID, assets, price, clearingPrice)
+	assert.Equal(t, marketID, event.MarketId, "MarketId")
+	assert.Equal(t, "25apple", event.Assets, "Assets")
+	assert.Equal(t, "53plum", event.Price, "Price")
+	assert.Equal(t, "2.120000000000000000plum", event.ClearingPrice, "ClearingPrice")
+	assertEverythingSet(t, event, "EventBatchAuctionSettled")
+}
+
+func TestNewEventFundsCommitted(t *testing.T) {
+	account := sdk.AccAddress("account_____________").String()
+	marketID := uint32(4444)
+	amount := sdk.NewCoins(sdk.NewInt64Coin("apple", 57), sdk.NewInt64Coin("banana", 99))
+	tag := "help-help-i-have-been-committed"
+
+	var event *EventFundsCommitted
+	testFunc := func() {
+		event = NewEventFundsCommitted(account, marketID, amount, tag)
+	}
+	require.NotPanics(t, testFunc, "NewEventFundsCommitted(%q, %d, %q, %q)", account, marketID, amount, tag)
+	assert.Equal(t, account, event.Account, "Account")
+	assert.Equal(t, marketID, event.MarketId, "MarketId")
+	assert.Equal(t, amount.String(), event.Amount, "Amount")
+	assert.Equal(t, tag, event.Tag, "Tag")
+	assertEverythingSet(t, event, "EventFundsCommitted")
+}
+
+func TestNewEventCommitmentReleased(t *testing.T) {
+	account := sdk.AccAddress("account_____________").String()
+	marketID := uint32(4444)
+	amount := sdk.NewCoins(sdk.NewInt64Coin("apple", 57), sdk.NewInt64Coin("banana", 99))
+	tag := "i-have-been-released"
+
+	var event *EventCommitmentReleased
+	testFunc := func() {
+		event = NewEventCommitmentReleased(account, marketID, amount, tag)
+	}
+	require.NotPanics(t, testFunc, "NewEventCommitmentReleased(%q, %d, %q, %q)", account, marketID, amount, tag)
+	assert.Equal(t, account, event.Account, "Account")
+	assert.Equal(t, marketID, event.MarketId, "MarketId")
+	assert.Equal(t, amount.String(), event.Amount, "Amount")
+	assert.Equal(t, tag, event.Tag, "Tag")
+	assertEverythingSet(t, event, "EventCommitmentReleased")
+}
+
+func TestNewEventCommitmentExpired(t *testing.T) {
+	account := sdk.AccAddress("account_____________").String()
+	marketID := uint32(4444)
+	amount := sdk.NewCoins(sdk.NewInt64Coin("apple", 57), sdk.NewInt64Coin("banana", 99))
+
+	var event *EventCommitmentExpired
+	testFunc := func() {
+		event = NewEventCommitmentExpired(account, marketID, amount)
+	}
+	require.NotPanics(t, testFunc, "NewEventCommitmentExpired(%q, %d, %q)", account, marketID, amount)
+	assert.Equal(t, account, event.Account, "Account")
+	assert.Equal(t, marketID, event.MarketId, "MarketId")
+	assert.Equal(t, amount.String(), event.Amount, "Amount")
+	assertEverythingSet(t, event, "EventCommitmentExpired")
+}
+
+func TestNewEventCommitmentRewardsFunded(t *testing.T) {
+	marketID := uint32(4444)
+	funder := sdk.AccAddress("funder______________").String()
+	amount := sdk.NewCoins(sdk.NewInt64Coin("apple", 57), sdk.NewInt64Coin("banana", 99))
+
+	var event *EventCommitmentRewardsFunded
+	testFunc := func() {
+		event = NewEventCommitmentRewardsFunded(marketID, funder, amount)
+	}
+	require.NotPanics(t, testFunc, "NewEventCommitmentRewardsFunded(%d, %q, %q)", marketID, funder, amount)
+	assert.Equal(t, marketID, event.MarketId, "MarketId")
+	assert.Equal(t, funder, event.Funder, "Funder")
+	assert.Equal(t, amount.String(), event.Amount, "Amount")
+	assertEverythingSet(t, event, "EventCommitmentRewardsFunded")
+}
+
+func TestNewEventCommitmentRewardsDistributed(t *testing.T) {
+	marketID := uint32(4444)
+	amount := sdk.NewCoins(sdk.NewInt64Coin("apple", 57), sdk.NewInt64Coin("banana", 99))
+	basisDenom := "cherry"
+	distributedBy := sdk.AccAddress("distributedBy_______").String()
+
+	var event *EventCommitmentRewardsDistributed
+	testFunc := func() {
+		event = NewEventCommitmentRewardsDistributed(marketID, amount, basisDenom, distributedBy)
+	}
+	require.NotPanics(t, testFunc, "NewEventCommitmentRewardsDistributed(%d, %q, %q, %q)", marketID, amount, basisDenom, distributedBy)
+	assert.Equal(t, marketID, event.MarketId, "MarketId")
+	assert.Equal(t, amount.String(), event.Amount, "Amount")
+	assert.Equal(t, basisDenom, event.BasisDenom, "BasisDenom")
+	assert.Equal(t, distributedBy, event.DistributedBy, "DistributedBy")
+	assertEverythingSet(t, event, "EventCommitmentRewardsDistributed")
+}
+
+func TestNewEventCommitmentRewardsClaimed(t *testing.T) {
+	account := sdk.AccAddress("account_____________").String()
+	marketID := uint32(4444)
+	amount := sdk.NewCoins(sdk.NewInt64Coin("apple", 57), sdk.NewInt64Coin("banana", 99))
+
+	var event *EventCommitmentRewardsClaimed
+	testFunc := func() {
+		event = NewEventCommitmentRewardsClaimed(account, marketID, amount)
+	}
+	require.NotPanics(t, testFunc, "NewEventCommitmentRewardsClaimed(%q, %d, %q)", account, marketID, amount)
+	assert.Equal(t, account, event.Account, "Account")
+	assert.Equal(t, marketID, event.MarketId, "MarketId")
+	assert.Equal(t, amount.String(), event.Amount, "Amount")
+	assertEverythingSet(t, event, "EventCommitmentRewardsClaimed")
+}
+
+func TestNewEventMarketWoundDown(t *testing.T) {
+	marketID := uint32(12)
+	ordersCancelled := uint32(43)
+	commitmentsReleased := uint32(6)
+	marketDeleted := true
+	releases := []*WindDownRelease{
+		{Account: sdk.AccAddress("account1____________").String(), OrderFunds: "5apple", CommitmentFunds: "3banana"},
+		{Account: sdk.AccAddress("account2____________").String(), OrderFunds: "8cherry"},
+	}
+
+	var event *EventMarketWoundDown
+	testFunc := func() {
+		event = NewEventMarketWoundDown(marketID, ordersCancelled, commitmentsReleased, marketDeleted, releases)
+	}
+	require.NotPanics(t, testFunc, "NewEventMarketWoundDown")
+	assert.Equal(t, marketID, event.MarketId, "MarketId")
+	assert.Equal(t, ordersCancelled, event.OrdersCancelled, "OrdersCancelled")
+	assert.Equal(t, commitmentsReleased, event.CommitmentsReleased, "CommitmentsReleased")
+	assert.Equal(t, marketDeleted, event.MarketDeleted, "MarketDeleted")
+	assert.Equal(t, releases, event.Releases, "Releases")
+	assertEverythingSet(t, event, "EventMarketWoundDown")
+}
+
+func TestNewWindDownRelease(t *testing.T) {
+	account := sdk.AccAddress("account_____________").String()
+	orderFunds := sdk.NewCoins(sdk.NewInt64Coin("apple", 15), sdk.NewInt64Coin("plum", 4))
+	commitmentFunds := sdk.NewCoins(sdk.NewInt64Coin("cherry", 57))
+
+	var release *WindDownRelease
+	testFunc := func() {
+		release = NewWindDownRelease(account, orderFunds, commitmentFunds)
+	}
+	require.NotPanics(t, testFunc, "NewWindDownRelease(%q, %q, %q)", account, orderFunds, commitmentFunds)
+	assert.Equal(t, account, release.Account, "Account")
+	assert.Equal(t, orderFunds.String(), release.OrderFunds, "OrderFunds")
+	assert.Equal(t, commitmentFunds.String(), release.CommitmentFunds, "CommitmentFunds")
+	assertEverythingSet(t, release, "WindDownRelease")
+}
+
+func TestNewEventMarketWithdraw(t *testing.T) {
+	marketID := uint32(55)
+	amountWithdrawn := sdk.NewCoins(sdk.NewInt64Coin("mine", 188382), sdk.NewInt64Coin("yours", 3))
+	destination := sdk.AccAddress("destination_________")
+	withdrawnBy := sdk.AccAddress("withdrawnBy_________").String()
+
+	var event *EventMarketWithdraw
+	testFunc := func() {
+		event = NewEventMarketWithdraw(marketID, amountWithdrawn, destination, withdrawnBy)
+	}
+	require.NotPanics(t, testFunc, "NewEventMarketWithdraw(%d, %q, %q, %q)",
+		marketID, amountWithdrawn, string(destination), withdrawnBy)
+	assert.Equal(t, marketID, event.MarketId, "MarketId")
+	assert.Equal(t, amountWithdrawn.String(), event.Amount, "Amount")
+	assert.Equal(t, destination.String(), event.Destination, "Destination")
+	assert.Equal(t, withdrawnBy, event.WithdrawnBy, "WithdrawnBy")
+	assertEverythingSet(t, event, "EventMarketWithdraw")
+}
+
+func TestNewEventMarketDetailsUpdated(t *testing.T) {
+	marketID := uint32(84)
+	updatedBy := sdk.AccAddress("updatedBy___________").String()
+
+	var event *EventMarketDetailsUpdated
+	testFunc := func() {
+		event = NewEventMarketDetailsUpdated(marketID, updatedBy)
+	}
+	require.NotPanics(t, testFunc, "NewEventMarketDetailsUpdated(%d, %q)", marketID, updatedBy)
+	assert.Equal(t, marketID, event.MarketId, "MarketId")
+	assert.Equal(t, updatedBy, event.UpdatedBy, "UpdatedBy")
+	assertEverythingSet(t, event, "EventMarketDetailsUpdated")
+}
+
+func TestNewEventMarketAcceptingOrdersUpdated(t *testing.T) {
+	someAddr := sdk.AccAddress("some_address________").String()
+
+	tests := []struct {
+		name      string
+		marketID  uint32
+		updatedBy string
+		isActive  bool
+		expected  proto.Message
+	}{
+		{
+			name:      "enabled",
+			marketID:  33,
+			updatedBy: someAddr,
+			isActive:  true,
+			expected:  NewEventMarketOrdersEnabled(33, someAddr),
+		},
+		{
+			name:      "disabled",
+			marketID:  556,
+			updatedBy: someAddr,
+			isActive:  false,
+			expected:  NewEventMarketOrdersDisabled(556, someAddr),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var event proto.Message
+			testFunc := func() {
+				event = NewEventMarketAcceptingOrdersUpdated(tc.marketID, tc.updatedBy, tc.isActive)
+			}
+			require.NotPanics(t, testFunc, "NewEventMarketAcceptingOrdersUpdated(%d, %q, %t) result",
+				tc.marketID, tc.updatedBy, tc.isActive)
+			assert.Equal(t, tc.expected, event, "NewEventMarketAcceptingOrdersUpdated(%d, %q, %t) result",
+				tc.marketID, tc.updatedBy, tc.isActive)
+		})
+	}
+}
+
+func TestNewEventMarketOrdersEnabled(t *testing.T) {
+	marketID := uint32(919)
+	updatedBy := sdk.AccAddress("updatedBy___________").String()
+
+	var event *EventMarketOrdersEnabled
+	testFunc := func() {
+		event = NewEventMarketOrdersEnabled(marketID, updatedBy)
+	}
+	require.NotPanics(t, testFunc, "NewEventMarketOrdersEnabled(%d, %q)", marketID, updatedBy)
+	assert.Equal(t, marketID, event.MarketId, "MarketId")
+	assert.Equal(t, updatedBy, event.UpdatedBy, "UpdatedBy")
+	assertEverythingSet(t, event, "EventMarketOrdersEnabled")
+}
+
+func TestNewEventMarketOrdersDisabled(t *testing.T) {
+	marketID := uint32(5555)
+	updatedBy := sdk.AccAddress("updatedBy___________").String()
+
+	var event *EventMarketOrdersDisabled
+	testFunc := func() {
+		event = NewEventMarketOrdersDisabled(marketID, updatedBy)
+	}
+	require.NotPanics(t, testFunc, "NewEventMarketOrdersDisabled(%d, %q)", marketID, updatedBy)
+	assert.Equal(t, marketID, event.MarketId, "MarketId")
+	assert.Equal(t, updatedBy, event.UpdatedBy, "UpdatedBy")
+	assertEverythingSet(t, event, "EventMarketOrdersDisabled")
+}
+
+func TestNewEventMarketUserSettleUpdated(t *testing.T) {
+	someAddr := sdk.AccAddress("some_address________").String()
+
+	tests := []struct {
+		name      string
+		marketID  uint32
+		updatedBy string
+		isAllowed bool
+		expected  proto.Message
+	}{
+		{
+			name:      "enabled",
+			marketID:  33,
+			updatedBy: someAddr,
+			isAllowed: true,
+			expected:  NewEventMarketUserSettleEnabled(33, someAddr),
 		},
 		{
 			name:      "disabled",
@@ -654,6 +1439,226 @@ func TestNewEventMarketCommitmentsDisabled(t *testing.T) {
 	assertEverythingSet(t, event, "EventMarketCommitmentsDisabled")
 }
 
+func TestNewEventMarketContinuousMatchingUpdated(t *testing.T) {
+	updatedBy := sdk.AccAddress("updatedBy___________").String()
+
+	tests := []struct {
+		name      string
+		marketID  uint32
+		updatedBy string
+		isEnabled bool
+		expected  proto.Message
+	}{
+		{
+			name:      "enabled",
+			marketID:  312,
+			updatedBy: updatedBy,
+			isEnabled: true,
+			expected:  NewEventMarketContinuousMatchingEnabled(312, updatedBy),
+		},
+		{
+			name:      "disabled",
+			marketID:  86,
+			updatedBy: updatedBy,
+			isEnabled: false,
+			expected:  NewEventMarketContinuousMatchingDisabled(86, updatedBy),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var event proto.Message
+			testFunc := func() {
+				event = NewEventMarketContinuousMatchingUpdated(tc.marketID, tc.updatedBy, tc.isEnabled)
+			}
+			require.NotPanics(t, testFunc, "NewEventMarketContinuousMatchingUpdated(%d, %q, %t) result",
+				tc.marketID, tc.updatedBy, tc.isEnabled)
+			assert.Equal(t, tc.expected, event, "NewEventMarketContinuousMatchingUpdated(%d, %q, %t) result",
+				tc.marketID, tc.updatedBy, tc.isEnabled)
+		})
+	}
+}
+
+func TestNewEventMarketContinuousMatchingEnabled(t *testing.T) {
+	marketID := uint32(4541)
+	updatedBy := sdk.AccAddress("updatedBy___________").String()
+
+	var event *EventMarketContinuousMatchingEnabled
+	testFunc := func() {
+		event = NewEventMarketContinuousMatchingEnabled(marketID, updatedBy)
+	}
+	require.NotPanics(t, testFunc, "NewEventMarketContinuousMatchingEnabled(%d, %q)", marketID, updatedBy)
+	assert.Equal(t, marketID, event.MarketId, "MarketId")
+	assert.Equal(t, updatedBy, event.UpdatedBy, "UpdatedBy")
+	assertEverythingSet(t, event, "EventMarketContinuousMatchingEnabled")
+}
+
+func TestNewEventMarketContinuousMatchingDisabled(t *testing.T) {
+	marketID := uint32(4541)
+	updatedBy := sdk.AccAddress("updatedBy___________").String()
+
+	var event *EventMarketContinuousMatchingDisabled
+	testFunc := func() {
+		event = NewEventMarketContinuousMatchingDisabled(marketID, updatedBy)
+	}
+	require.NotPanics(t, testFunc, "NewEventMarketContinuousMatchingDisabled(%d, %q)", marketID, updatedBy)
+	assert.Equal(t, marketID, event.MarketId, "MarketId")
+	assert.Equal(t, updatedBy, event.UpdatedBy, "UpdatedBy")
+	assertEverythingSet(t, event, "EventMarketContinuousMatchingDisabled")
+}
+
+func TestNewEventMarketBatchAuctionUpdated(t *testing.T) {
+	marketID := uint32(4004)
+	updatedBy := sdk.AccAddress("updatedBy___________").String()
+
+	var event *EventMarketBatchAuctionUpdated
+	testFunc := func() {
+		event = NewEventMarketBatchAuctionUpdated(marketID, updatedBy)
+	}
+	require.NotPanics(t, testFunc, "NewEventMarketBatchAuctionUpdated(%d, %q)", marketID, updatedBy)
+	assert.Equal(t, marketID, event.MarketId, "MarketId")
+	assert.Equal(t, updatedBy, event.UpdatedBy, "UpdatedBy")
+	assertEverythingSet(t, event, "EventMarketBatchAuctionUpdated")
+}
+
+func TestNewEventMarketSelfTradePreventionUpdated(t *testing.T) {
+	marketID := uint32(4009)
+	updatedBy := sdk.AccAddress("updatedBy___________").String()
+
+	var event *EventMarketSelfTradePreventionUpdated
+	testFunc := func() {
+		event = NewEventMarketSelfTradePreventionUpdated(marketID, updatedBy)
+	}
+	require.NotPanics(t, testFunc, "NewEventMarketSelfTradePreventionUpdated(%d, %q)", marketID, updatedBy)
+	assert.Equal(t, marketID, event.MarketId, "MarketId")
+	assert.Equal(t, updatedBy, event.UpdatedBy, "UpdatedBy")
+	assertEverythingSet(t, event, "EventMarketSelfTradePreventionUpdated")
+}
+
+func TestNewEventMarketPublishPricesUpdated(t *testing.T) {
+	updatedBy := sdk.AccAddress("updatedBy___________").String()
+
+	tests := []struct {
+		name      string
+		marketID  uint32
+		updatedBy string
+		isEnabled bool
+		expected  proto.Message
+	}{
+		{
+			name:      "enabled",
+			marketID:  47,
+			updatedBy: updatedBy,
+			isEnabled: true,
+			expected:  NewEventMarketPublishPricesEnabled(47, updatedBy),
+		},
+		{
+			name:      "disabled",
+			marketID:  211,
+			updatedBy: updatedBy,
+			isEnabled: false,
+			expected:  NewEventMarketPublishPricesDisabled(211, updatedBy),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var event proto.Message
+			testFunc := func() {
+				event = NewEventMarketPublishPricesUpdated(tc.marketID, tc.updatedBy, tc.isEnabled)
+			}
+			require.NotPanics(t, testFunc, "NewEventMarketPublishPricesUpdated(%d, %q, %t) result",
+				tc.marketID, tc.updatedBy, tc.isEnabled)
+			assert.Equal(t, tc.expected, event, "NewEventMarketPublishPricesUpdated(%d, %q, %t) result",
+				tc.marketID, tc.updatedBy, tc.isEnabled)
+		})
+	}
+}
+
+func TestNewEventMarketPublishPricesEnabled(t *testing.T) {
+	marketID := uint32(3993)
+	updatedBy := sdk.AccAddress("updatedBy___________").String()
+
+	var event *EventMarketPublishPricesEnabled
+	testFunc := func() {
+		event = NewEventMarketPublishPricesEnabled(marketID, updatedBy)
+	}
+	require.NotPanics(t, testFunc, "NewEventMarketPublishPricesEnabled(%d, %q)", marketID, updatedBy)
+	assert.Equal(t, marketID, event.MarketId, "MarketId")
+	assert.Equal(t, updatedBy, event.UpdatedBy, "UpdatedBy")
+	assertEverythingSet(t, event, "EventMarketPublishPricesEnabled")
+}
+
+func TestNewEventMarketPublishPricesDisabled(t *testing.T) {
+	marketID := uint32(3993)
+	updatedBy := sdk.AccAddress("updatedBy___________").String()
+
+	var event *EventMarketPublishPricesDisabled
+	testFunc := func() {
+		event = NewEventMarketPublishPricesDisabled(marketID, updatedBy)
+	}
+	require.NotPanics(t, testFunc, "NewEventMarketPublishPricesDisabled(%d, %q)", marketID, updatedBy)
+	assert.Equal(t, marketID, event.MarketId, "MarketId")
+	assert.Equal(t, updatedBy, event.UpdatedBy, "UpdatedBy")
+	assertEverythingSet(t, event, "EventMarketPublishPricesDisabled")
+}
+
+func TestNewEventMarketExternalIDScopeUpdated(t *testing.T) {
+	marketID := uint32(4041)
+	updatedBy := sdk.AccAddress("updatedBy___________").String()
+
+	var event *EventMarketExternalIDScopeUpdated
+	testFunc := func() {
+		event = NewEventMarketExternalIDScopeUpdated(marketID, updatedBy)
+	}
+	require.NotPanics(t, testFunc, "NewEventMarketExternalIDScopeUpdated(%d, %q)", marketID, updatedBy)
+	assert.Equal(t, marketID, event.MarketId, "MarketId")
+	assert.Equal(t, updatedBy, event.UpdatedBy, "UpdatedBy")
+	assertEverythingSet(t, event, "EventMarketExternalIDScopeUpdated")
+}
+
+func TestNewEventMarketOrderRateLimitUpdated(t *testing.T) {
+	marketID := uint32(4043)
+	updatedBy := sdk.AccAddress("updatedBy___________").String()
+
+	var event *EventMarketOrderRateLimitUpdated
+	testFunc := func() {
+		event = NewEventMarketOrderRateLimitUpdated(marketID, updatedBy)
+	}
+	require.NotPanics(t, testFunc, "NewEventMarketOrderRateLimitUpdated(%d, %q)", marketID, updatedBy)
+	assert.Equal(t, marketID, event.MarketId, "MarketId")
+	assert.Equal(t, updatedBy, event.UpdatedBy, "UpdatedBy")
+	assertEverythingSet(t, event, "EventMarketOrderRateLimitUpdated")
+}
+
+func TestNewEventMarketMaxExposureUpdated(t *testing.T) {
+	marketID := uint32(4044)
+	updatedBy := sdk.AccAddress("updatedBy___________").String()
+
+	var event *EventMarketMaxExposureUpdated
+	testFunc := func() {
+		event = NewEventMarketMaxExposureUpdated(marketID, updatedBy)
+	}
+	require.NotPanics(t, testFunc, "NewEventMarketMaxExposureUpdated(%d, %q)", marketID, updatedBy)
+	assert.Equal(t, marketID, event.MarketId, "MarketId")
+	assert.Equal(t, updatedBy, event.UpdatedBy, "UpdatedBy")
+	assertEverythingSet(t, event, "EventMarketMaxExposureUpdated")
+}
+
+func TestNewEventMarketFillAlgorithmUpdated(t *testing.T) {
+	marketID := uint32(4045)
+	updatedBy := sdk.AccAddress("updatedBy___________").String()
+
+	var event *EventMarketFillAlgorithmUpdated
+	testFunc := func() {
+		event = NewEventMarketFillAlgorithmUpdated(marketID, updatedBy)
+	}
+	require.NotPanics(t, testFunc, "NewEventMarketFillAlgorithmUpdated(%d, %q)", marketID, updatedBy)
+	assert.Equal(t, marketID, event.MarketId, "MarketId")
+	assert.Equal(t, updatedBy, event.UpdatedBy, "UpdatedBy")
+	assertEverythingSet(t, event, "EventMarketFillAlgorithmUpdated")
+}
+
 func TestNewEventMarketIntermediaryDenomUpdated(t *testing.T) {
 	marketID := uint32(4541)
 	updatedBy := sdk.AccAddress("updatedBy___________").String()
@@ -682,18 +1687,84 @@ func TestNewEventMarketPermissionsUpdated(t *testing.T) {
 	assertEverythingSet(t, event, "EventMarketPermissionsUpdated")
 }
 
+func TestNewEventMarketAuthorityTransferProposed(t *testing.T) {
+	transfer := &MarketAuthorityTransfer{
+		MarketId:     5434,
+		ProposedBy:   sdk.AccAddress("proposedBy__________").String(),
+		NewAuthority: sdk.AccAddress("newAuthority________").String(),
+		AccessGrants: []AccessGrant{{Address: "whatever", Permissions: AllPermissions()}},
+	}
+
+	var event *EventMarketAuthorityTransferProposed
+	testFunc := func() {
+		event = NewEventMarketAuthorityTransferProposed(transfer)
+	}
+	require.NotPanics(t, testFunc, "NewEventMarketAuthorityTransferProposed")
+	assert.Equal(t, transfer.MarketId, event.MarketId, "MarketId")
+	assert.Equal(t, transfer.ProposedBy, event.ProposedBy, "ProposedBy")
+	assert.Equal(t, transfer.NewAuthority, event.NewAuthority, "NewAuthority")
+	assertEverythingSet(t, event, "EventMarketAuthorityTransferProposed")
+}
+
+func TestNewEventMarketAuthorityTransferred(t *testing.T) {
+	transfer := &MarketAuthorityTransfer{
+		MarketId:     5435,
+		ProposedBy:   sdk.AccAddress("proposedBy__________").String(),
+		NewAuthority: sdk.AccAddress("newAuthority________").String(),
+		AccessGrants: []AccessGrant{{Address: "whatever", Permissions: AllPermissions()}},
+	}
+
+	var event *EventMarketAuthorityTransferred
+	testFunc := func() {
+		event = NewEventMarketAuthorityTransferred(transfer)
+	}
+	require.NotPanics(t, testFunc, "NewEventMarketAuthorityTransferred")
+	assert.Equal(t, transfer.MarketId, event.MarketId, "MarketId")
+	assert.Equal(t, transfer.ProposedBy, event.ProposedBy, "ProposedBy")
+	assert.Equal(t, transfer.NewAuthority, event.NewAuthority, "NewAuthority")
+	assertEverythingSet(t, event, "EventMarketAuthorityTransferred")
+}
+
+func TestNewEventMarketAccessGrantExpired(t *testing.T) {
+	marketID := uint32(5433)
+	addr := sdk.AccAddress("addr________________")
+
+	var event *EventMarketAccessGrantExpired
+	testFunc := func() {
+		event = NewEventMarketAccessGrantExpired(marketID, addr)
+	}
+	require.NotPanics(t, testFunc, "NewEventMarketAccessGrantExpired(%d, %q)", marketID, addr)
+	assert.Equal(t, marketID, event.MarketId, "MarketId")
+	assert.Equal(t, addr.String(), event.Address, "Address")
+	assertEverythingSet(t, event, "EventMarketAccessGrantExpired")
+}
+
 func TestNewEventMarketReqAttrUpdated(t *testing.T) {
 	marketID := uint32(3334)
 	updatedBy := sdk.AccAddress("updatedBy___________").String()
 
-	var event *EventMarketReqAttrUpdated
+	var event *EventMarketReqAttrUpdated
+	testFunc := func() {
+		event = NewEventMarketReqAttrUpdated(marketID, updatedBy)
+	}
+	require.NotPanics(t, testFunc, "NewEventMarketReqAttrUpdated(%d, %q)", marketID, updatedBy)
+	assert.Equal(t, marketID, event.MarketId, "MarketId")
+	assert.Equal(t, updatedBy, event.UpdatedBy, "UpdatedBy")
+	assertEverythingSet(t, event, "EventMarketReqAttrUpdated")
+}
+
+func TestNewEventMarketAcceptedDenomsUpdated(t *testing.T) {
+	marketID := uint32(3335)
+	updatedBy := sdk.AccAddress("updatedBy___________").String()
+
+	var event *EventMarketAcceptedDenomsUpdated
 	testFunc := func() {
-		event = NewEventMarketReqAttrUpdated(marketID, updatedBy)
+		event = NewEventMarketAcceptedDenomsUpdated(marketID, updatedBy)
 	}
-	require.NotPanics(t, testFunc, "NewEventMarketReqAttrUpdated(%d, %q)", marketID, updatedBy)
+	require.NotPanics(t, testFunc, "NewEventMarketAcceptedDenomsUpdated(%d, %q)", marketID, updatedBy)
 	assert.Equal(t, marketID, event.MarketId, "MarketId")
 	assert.Equal(t, updatedBy, event.UpdatedBy, "UpdatedBy")
-	assertEverythingSet(t, event, "EventMarketReqAttrUpdated")
+	assertEverythingSet(t, event, "EventMarketAcceptedDenomsUpdated")
 }
 
 func TestNewEventMarketCreated(t *testing.T) {
@@ -720,6 +1791,95 @@ func TestNewEventMarketFeesUpdated(t *testing.T) {
 	assertEverythingSet(t, event, "EventMarketFeesUpdated")
 }
 
+func TestNewEventMarketExchangeSplitsUpdated(t *testing.T) {
+	marketID := uint32(1617)
+
+	var event *EventMarketExchangeSplitsUpdated
+	testFunc := func() {
+		event = NewEventMarketExchangeSplitsUpdated(marketID)
+	}
+	require.NotPanics(t, testFunc, "NewEventMarketExchangeSplitsUpdated(%d)", marketID)
+	assert.Equal(t, marketID, event.MarketId, "MarketId")
+	assertEverythingSet(t, event, "EventMarketExchangeSplitsUpdated")
+}
+
+func TestNewEventMarketFeesScheduled(t *testing.T) {
+	minus3 := time.Date(2030, 4, 5, 6, 7, 8, 9, time.FixedZone("UTC-3", -3*60*60))
+	utc := time.Date(2031, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		marketID        uint32
+		effectiveHeight int64
+		effectiveTime   *time.Time
+		expected        *EventMarketFeesScheduled
+	}{
+		{
+			name:            "height",
+			marketID:        3,
+			effectiveHeight: 5000,
+			expected:        &EventMarketFeesScheduled{MarketId: 3, EffectiveHeight: 5000},
+		},
+		{
+			name:          "time",
+			marketID:      44,
+			effectiveTime: &minus3,
+			expected:      &EventMarketFeesScheduled{MarketId: 44, EffectiveTime: "2030-04-05T09:07:08Z"},
+		},
+		{
+			name:            "both",
+			marketID:        1415,
+			effectiveHeight: 92,
+			effectiveTime:   &utc,
+			expected:        &EventMarketFeesScheduled{MarketId: 1415, EffectiveHeight: 92, EffectiveTime: "2031-01-02T03:04:05Z"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var event *EventMarketFeesScheduled
+			testFunc := func() {
+				event = NewEventMarketFeesScheduled(tc.marketID, tc.effectiveHeight, tc.effectiveTime)
+			}
+			require.NotPanics(t, testFunc, "NewEventMarketFeesScheduled")
+			assert.Equal(t, tc.expected, event, "NewEventMarketFeesScheduled result")
+		})
+	}
+}
+
+func TestNewEventRebatePaid(t *testing.T) {
+	marketID := uint32(71)
+	recipient := sdk.AccAddress("recipient___________").String()
+	amount := sdk.NewCoins(sdk.NewInt64Coin("rebate", 12))
+
+	var event *EventRebatePaid
+	testFunc := func() {
+		event = NewEventRebatePaid(marketID, recipient, amount)
+	}
+	require.NotPanics(t, testFunc, "NewEventRebatePaid(%d, %q, %q)", marketID, recipient, amount)
+	assert.Equal(t, marketID, event.MarketId, "MarketId")
+	assert.Equal(t, recipient, event.Recipient, "Recipient")
+	assert.Equal(t, amount.String(), event.Amount, "Amount")
+	assertEverythingSet(t, event, "EventRebatePaid")
+}
+
+func TestNewEventReferralPaid(t *testing.T) {
+	referrer := sdk.AccAddress("referrer____________").String()
+	order := NewOrder(5).WithBid(&BidOrder{MarketId: 72, Referrer: referrer})
+	amount := sdk.NewCoins(sdk.NewInt64Coin("referral", 3))
+
+	var event *EventReferralPaid
+	testFunc := func() {
+		event = NewEventReferralPaid(order, amount)
+	}
+	require.NotPanics(t, testFunc, "NewEventReferralPaid")
+	assert.Equal(t, uint32(72), event.MarketId, "MarketId")
+	assert.Equal(t, uint64(5), event.OrderId, "OrderId")
+	assert.Equal(t, referrer, event.Referrer, "Referrer")
+	assert.Equal(t, amount.String(), event.Amount, "Amount")
+	assertEverythingSet(t, event, "EventReferralPaid")
+}
+
 func TestNewEventParamsUpdated(t *testing.T) {
 	var event *EventParamsUpdated
 	testFunc := func() {
@@ -1132,119 +2292,527 @@ func TestEventPaymentCancelled(t *testing.T) {
 		expAllSet bool
 	}{
 		{
-			name:    "all payment fields have content",
-			payment: newTestPayment(t, "source_addr", "312strawberry", "target_addr", "7tangerine", "just_some_identifier"),
-			expected: &EventPaymentCancelled{
-				Source:     "source_addr",
-				Target:     "target_addr",
-				ExternalId: "just_some_identifier",
-			},
-			expAllSet: true,
-		},
-		{
-			name:    "zero source amount",
-			payment: newTestPayment(t, "source_addr", "", "target_addr", "7tangerine", "just_some_identifier"),
-			expected: &EventPaymentCancelled{
-				Source:     "source_addr",
-				Target:     "target_addr",
-				ExternalId: "just_some_identifier",
-			},
-			expAllSet: true,
-		},
-		{
-			name:    "no target",
-			payment: newTestPayment(t, "source_addr", "312strawberry", "", "7tangerine", "just_some_identifier"),
-			expected: &EventPaymentCancelled{
-				Source:     "source_addr",
-				Target:     "",
-				ExternalId: "just_some_identifier",
-			},
-		},
-		{
-			name:    "zero target amount",
-			payment: newTestPayment(t, "source_addr", "312strawberry", "target_addr", "", "just_some_identifier"),
-			expected: &EventPaymentCancelled{
+			name:    "all payment fields have content",
+			payment: newTestPayment(t, "source_addr", "312strawberry", "target_addr", "7tangerine", "just_some_identifier"),
+			expected: &EventPaymentCancelled{
+				Source:     "source_addr",
+				Target:     "target_addr",
+				ExternalId: "just_some_identifier",
+			},
+			expAllSet: true,
+		},
+		{
+			name:    "zero source amount",
+			payment: newTestPayment(t, "source_addr", "", "target_addr", "7tangerine", "just_some_identifier"),
+			expected: &EventPaymentCancelled{
+				Source:     "source_addr",
+				Target:     "target_addr",
+				ExternalId: "just_some_identifier",
+			},
+			expAllSet: true,
+		},
+		{
+			name:    "no target",
+			payment: newTestPayment(t, "source_addr", "312strawberry", "", "7tangerine", "just_some_identifier"),
+			expected: &EventPaymentCancelled{
+				Source:     "source_addr",
+				Target:     "",
+				ExternalId: "just_some_identifier",
+			},
+		},
+		{
+			name:    "zero target amount",
+			payment: newTestPayment(t, "source_addr", "312strawberry", "target_addr", "", "just_some_identifier"),
+			expected: &EventPaymentCancelled{
+				Source:     "source_addr",
+				Target:     "target_addr",
+				ExternalId: "just_some_identifier",
+			},
+			expAllSet: true,
+		},
+		{
+			name:    "empty external id",
+			payment: newTestPayment(t, "source_addr", "312strawberry", "target_addr", "7tangerine", ""),
+			expected: &EventPaymentCancelled{
+				Source:     "source_addr",
+				Target:     "target_addr",
+				ExternalId: "",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var event *EventPaymentCancelled
+			testFunc := func() {
+				event = NewEventPaymentCancelled(tc.payment)
+			}
+			require.NotPanics(t, testFunc, "NewEventPaymentCancelled")
+			assert.Equal(t, tc.expected, event, "NewEventPaymentCancelled result")
+			assertEventContent(t, event, "EventPaymentCancelled", tc.expAllSet)
+		})
+	}
+}
+
+func TestNewEventsPaymentsCancelled(t *testing.T) {
+	eStringer := func(event *EventPaymentCancelled) string {
+		return fmt.Sprintf("%s+%q<->%s", event.Source, event.ExternalId, event.Target)
+	}
+
+	tests := []struct {
+		name     string
+		payments []*Payment
+		expected []*EventPaymentCancelled
+	}{
+		{
+			name:     "nil payments",
+			payments: nil,
+			expected: []*EventPaymentCancelled{},
+		},
+		{
+			name:     "empty payments",
+			payments: []*Payment{},
+			expected: []*EventPaymentCancelled{},
+		},
+		{
+			name: "five payments",
+			payments: []*Payment{
+				newTestPayment(t, "source_addr_0", "300strawberry", "target_addr_0", "70tangerine", "just_some_identifier_0"),
+				newTestPayment(t, "source_addr_1", "", "target_addr_1", "71tangerine", "just_some_identifier_1"),
+				newTestPayment(t, "source_addr_2", "302strawberry", "", "72tangerine", "just_some_identifier_2"),
+				newTestPayment(t, "source_addr_3", "303strawberry", "target_addr_3", "", "just_some_identifier_3"),
+				newTestPayment(t, "source_addr_4", "304strawberry", "target_addr_4", "74tangerine", ""),
+			},
+			expected: []*EventPaymentCancelled{
+				{Source: "source_addr_0", Target: "target_addr_0", ExternalId: "just_some_identifier_0"},
+				{Source: "source_addr_1", Target: "target_addr_1", ExternalId: "just_some_identifier_1"},
+				{Source: "source_addr_2", Target: "", ExternalId: "just_some_identifier_2"},
+				{Source: "source_addr_3", Target: "target_addr_3", ExternalId: "just_some_identifier_3"},
+				{Source: "source_addr_4", Target: "target_addr_4", ExternalId: ""},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual []*EventPaymentCancelled
+			testFunc := func() {
+				actual = NewEventsPaymentsCancelled(tc.payments)
+			}
+			require.NotPanics(t, testFunc, "NewEventsPaymentsCancelled")
+			assertEqualSlice(t, tc.expected, actual, eStringer, "NewEventsPaymentsCancelled")
+		})
+	}
+}
+
+func TestNewEventPaymentExpired(t *testing.T) {
+	tests := []struct {
+		name      string
+		payment   *Payment
+		expected  *EventPaymentExpired
+		expAllSet bool
+	}{
+		{
+			name:    "all payment fields have content",
+			payment: newTestPayment(t, "source_addr", "312strawberry", "target_addr", "7tangerine", "just_some_identifier"),
+			expected: &EventPaymentExpired{
+				Source:     "source_addr",
+				Target:     "target_addr",
+				ExternalId: "just_some_identifier",
+			},
+			expAllSet: true,
+		},
+		{
+			name:    "no target",
+			payment: newTestPayment(t, "source_addr", "312strawberry", "", "7tangerine", "just_some_identifier"),
+			expected: &EventPaymentExpired{
+				Source:     "source_addr",
+				Target:     "",
+				ExternalId: "just_some_identifier",
+			},
+		},
+		{
+			name:    "empty external id",
+			payment: newTestPayment(t, "source_addr", "312strawberry", "target_addr", "7tangerine", ""),
+			expected: &EventPaymentExpired{
+				Source:     "source_addr",
+				Target:     "target_addr",
+				ExternalId: "",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var event *EventPaymentExpired
+			testFunc := func() {
+				event = NewEventPaymentExpired(tc.payment)
+			}
+			require.NotPanics(t, testFunc, "NewEventPaymentExpired")
+			assert.Equal(t, tc.expected, event, "NewEventPaymentExpired result")
+			assertEventContent(t, event, "EventPaymentExpired", tc.expAllSet)
+		})
+	}
+}
+
+func TestNewEventPaymentEscrowed(t *testing.T) {
+	tests := []struct {
+		name      string
+		ep        *EscrowedPayment
+		expected  *EventPaymentEscrowed
+		expAllSet bool
+	}{
+		{
+			name: "all fields have content",
+			ep: &EscrowedPayment{
+				Payment:     *newTestPayment(t, "source_addr", "312strawberry", "target_addr", "7tangerine", "just_some_identifier"),
+				ReleaseTime: time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC),
+			},
+			expected: &EventPaymentEscrowed{
+				Source:      "source_addr",
+				Target:      "target_addr",
+				ExternalId:  "just_some_identifier",
+				ReleaseTime: "2030-01-02T03:04:05Z",
+			},
+			expAllSet: true,
+		},
+		{
+			name: "empty external id",
+			ep: &EscrowedPayment{
+				Payment:     *newTestPayment(t, "source_addr", "312strawberry", "target_addr", "7tangerine", ""),
+				ReleaseTime: time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC),
+			},
+			expected: &EventPaymentEscrowed{
+				Source:      "source_addr",
+				Target:      "target_addr",
+				ExternalId:  "",
+				ReleaseTime: "2030-01-02T03:04:05Z",
+			},
+		},
+		{
+			name: "release time not in UTC",
+			ep: &EscrowedPayment{
+				Payment:     *newTestPayment(t, "source_addr", "312strawberry", "target_addr", "7tangerine", "eid"),
+				ReleaseTime: time.Date(2030, 1, 2, 3, 4, 5, 0, time.FixedZone("UTC-2", -2*60*60)),
+			},
+			expected: &EventPaymentEscrowed{
+				Source:      "source_addr",
+				Target:      "target_addr",
+				ExternalId:  "eid",
+				ReleaseTime: "2030-01-02T05:04:05Z",
+			},
+			expAllSet: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var event *EventPaymentEscrowed
+			testFunc := func() {
+				event = NewEventPaymentEscrowed(tc.ep)
+			}
+			require.NotPanics(t, testFunc, "NewEventPaymentEscrowed")
+			assert.Equal(t, tc.expected, event, "NewEventPaymentEscrowed result")
+			assertEventContent(t, event, "EventPaymentEscrowed", tc.expAllSet)
+		})
+	}
+}
+
+func TestNewEventPaymentReleased(t *testing.T) {
+	payment := newTestPayment(t, "source_addr", "312strawberry", "target_addr", "7tangerine", "just_some_identifier")
+	expected := &EventPaymentReleased{
+		Source:       "source_addr",
+		SourceAmount: "312strawberry",
+		Target:       "target_addr",
+		TargetAmount: "7tangerine",
+		ExternalId:   "just_some_identifier",
+	}
+
+	var event *EventPaymentReleased
+	testFunc := func() {
+		event = NewEventPaymentReleased(payment)
+	}
+	require.NotPanics(t, testFunc, "NewEventPaymentReleased")
+	assert.Equal(t, expected, event, "NewEventPaymentReleased result")
+	assertEverythingSet(t, event, "EventPaymentReleased")
+}
+
+func TestNewEventPaymentDisputed(t *testing.T) {
+	payment := newTestPayment(t, "source_addr", "312strawberry", "target_addr", "7tangerine", "just_some_identifier")
+	expected := &EventPaymentDisputed{
+		Source:     "source_addr",
+		Target:     "target_addr",
+		ExternalId: "just_some_identifier",
+	}
+
+	var event *EventPaymentDisputed
+	testFunc := func() {
+		event = NewEventPaymentDisputed(payment)
+	}
+	require.NotPanics(t, testFunc, "NewEventPaymentDisputed")
+	assert.Equal(t, expected, event, "NewEventPaymentDisputed result")
+	assertEverythingSet(t, event, "EventPaymentDisputed")
+}
+
+func TestNewEventRecurringPaymentCreated(t *testing.T) {
+	rp := &RecurringPayment{
+		Source:             "source_addr",
+		Target:             "target_addr",
+		Amount:             sdk.NewCoins(sdk.NewInt64Coin("plum", 5)),
+		ExternalId:         "sub-1",
+		IntervalSeconds:    86400,
+		TransfersRemaining: 12,
+	}
+	expected := &EventRecurringPaymentCreated{
+		Source:     "source_addr",
+		Target:     "target_addr",
+		Amount:     "5plum",
+		ExternalId: "sub-1",
+		Transfers:  12,
+	}
+
+	var event *EventRecurringPaymentCreated
+	testFunc := func() {
+		event = NewEventRecurringPaymentCreated(rp)
+	}
+	require.NotPanics(t, testFunc, "NewEventRecurringPaymentCreated")
+	assert.Equal(t, expected, event, "NewEventRecurringPaymentCreated result")
+	assertEverythingSet(t, event, "EventRecurringPaymentCreated")
+}
+
+func TestNewEventRecurringPaymentTransferred(t *testing.T) {
+	rp := &RecurringPayment{
+		Source:             "source_addr",
+		Target:             "target_addr",
+		Amount:             sdk.NewCoins(sdk.NewInt64Coin("plum", 5)),
+		ExternalId:         "sub-1",
+		IntervalSeconds:    86400,
+		TransfersRemaining: 11,
+	}
+	expected := &EventRecurringPaymentTransferred{
+		Source:             "source_addr",
+		Target:             "target_addr",
+		Amount:             "5plum",
+		ExternalId:         "sub-1",
+		TransfersRemaining: 11,
+	}
+
+	var event *EventRecurringPaymentTransferred
+	testFunc := func() {
+		event = NewEventRecurringPaymentTransferred(rp)
+	}
+	require.NotPanics(t, testFunc, "NewEventRecurringPaymentTransferred")
+	assert.Equal(t, expected, event, "NewEventRecurringPaymentTransferred result")
+	assertEverythingSet(t, event, "EventRecurringPaymentTransferred")
+}
+
+func TestNewEventRecurringPaymentFailed(t *testing.T) {
+	rp := &RecurringPayment{
+		Source:             "source_addr",
+		Target:             "target_addr",
+		Amount:             sdk.NewCoins(sdk.NewInt64Coin("plum", 5)),
+		ExternalId:         "sub-1",
+		IntervalSeconds:    86400,
+		TransfersRemaining: 11,
+		Failures:           2,
+	}
+
+	tests := []struct {
+		name      string
+		err       error
+		expected  *EventRecurringPaymentFailed
+		expAllSet bool
+	}{
+		{
+			name: "with error",
+			err:  errors.New("insufficient funds"),
+			expected: &EventRecurringPaymentFailed{
 				Source:     "source_addr",
 				Target:     "target_addr",
-				ExternalId: "just_some_identifier",
+				ExternalId: "sub-1",
+				Error:      "insufficient funds",
+				Failures:   2,
 			},
 			expAllSet: true,
 		},
 		{
-			name:    "empty external id",
-			payment: newTestPayment(t, "source_addr", "312strawberry", "target_addr", "7tangerine", ""),
-			expected: &EventPaymentCancelled{
+			name: "nil error",
+			err:  nil,
+			expected: &EventRecurringPaymentFailed{
 				Source:     "source_addr",
 				Target:     "target_addr",
-				ExternalId: "",
+				ExternalId: "sub-1",
+				Failures:   2,
 			},
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			var event *EventPaymentCancelled
+			var event *EventRecurringPaymentFailed
 			testFunc := func() {
-				event = NewEventPaymentCancelled(tc.payment)
+				event = NewEventRecurringPaymentFailed(rp, tc.err)
 			}
-			require.NotPanics(t, testFunc, "NewEventPaymentCancelled")
-			assert.Equal(t, tc.expected, event, "NewEventPaymentCancelled result")
-			assertEventContent(t, event, "EventPaymentCancelled", tc.expAllSet)
+			require.NotPanics(t, testFunc, "NewEventRecurringPaymentFailed")
+			assert.Equal(t, tc.expected, event, "NewEventRecurringPaymentFailed result")
+			assertEventContent(t, event, "EventRecurringPaymentFailed", tc.expAllSet)
 		})
 	}
 }
 
-func TestNewEventsPaymentsCancelled(t *testing.T) {
-	eStringer := func(event *EventPaymentCancelled) string {
-		return fmt.Sprintf("%s+%q<->%s", event.Source, event.ExternalId, event.Target)
+func TestNewEventRecurringPaymentCancelled(t *testing.T) {
+	rp := &RecurringPayment{
+		Source:             "source_addr",
+		Target:             "target_addr",
+		Amount:             sdk.NewCoins(sdk.NewInt64Coin("plum", 5)),
+		ExternalId:         "sub-1",
+		IntervalSeconds:    86400,
+		TransfersRemaining: 4,
+	}
+	expected := &EventRecurringPaymentCancelled{
+		Source:             "source_addr",
+		Target:             "target_addr",
+		ExternalId:         "sub-1",
+		TransfersRemaining: 4,
+	}
+
+	var event *EventRecurringPaymentCancelled
+	testFunc := func() {
+		event = NewEventRecurringPaymentCancelled(rp)
+	}
+	require.NotPanics(t, testFunc, "NewEventRecurringPaymentCancelled")
+	assert.Equal(t, expected, event, "NewEventRecurringPaymentCancelled result")
+	assertEverythingSet(t, event, "EventRecurringPaymentCancelled")
+}
+
+func TestNewEventsRecurringPaymentsCancelled(t *testing.T) {
+	eStringer := func(event *EventRecurringPaymentCancelled) string {
+		return fmt.Sprintf("%s+%q->%s(%d)", event.Source, event.ExternalId, event.Target, event.TransfersRemaining)
 	}
 
 	tests := []struct {
 		name     string
-		payments []*Payment
-		expected []*EventPaymentCancelled
+		rps      []*RecurringPayment
+		expected []*EventRecurringPaymentCancelled
 	}{
 		{
-			name:     "nil payments",
-			payments: nil,
-			expected: []*EventPaymentCancelled{},
-		},
-		{
-			name:     "empty payments",
-			payments: []*Payment{},
-			expected: []*EventPaymentCancelled{},
+			name:     "nil recurring payments",
+			rps:      nil,
+			expected: []*EventRecurringPaymentCancelled{},
 		},
 		{
-			name: "five payments",
-			payments: []*Payment{
-				newTestPayment(t, "source_addr_0", "300strawberry", "target_addr_0", "70tangerine", "just_some_identifier_0"),
-				newTestPayment(t, "source_addr_1", "", "target_addr_1", "71tangerine", "just_some_identifier_1"),
-				newTestPayment(t, "source_addr_2", "302strawberry", "", "72tangerine", "just_some_identifier_2"),
-				newTestPayment(t, "source_addr_3", "303strawberry", "target_addr_3", "", "just_some_identifier_3"),
-				newTestPayment(t, "source_addr_4", "304strawberry", "target_addr_4", "74tangerine", ""),
+			name: "two recurring payments",
+			rps: []*RecurringPayment{
+				{Source: "source_addr_0", Target: "target_addr_0", ExternalId: "sub-0", TransfersRemaining: 3},
+				{Source: "source_addr_1", Target: "target_addr_1", ExternalId: "", TransfersRemaining: 1},
 			},
-			expected: []*EventPaymentCancelled{
-				{Source: "source_addr_0", Target: "target_addr_0", ExternalId: "just_some_identifier_0"},
-				{Source: "source_addr_1", Target: "target_addr_1", ExternalId: "just_some_identifier_1"},
-				{Source: "source_addr_2", Target: "", ExternalId: "just_some_identifier_2"},
-				{Source: "source_addr_3", Target: "target_addr_3", ExternalId: "just_some_identifier_3"},
-				{Source: "source_addr_4", Target: "target_addr_4", ExternalId: ""},
+			expected: []*EventRecurringPaymentCancelled{
+				{Source: "source_addr_0", Target: "target_addr_0", ExternalId: "sub-0", TransfersRemaining: 3},
+				{Source: "source_addr_1", Target: "target_addr_1", ExternalId: "", TransfersRemaining: 1},
 			},
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			var actual []*EventPaymentCancelled
+			var actual []*EventRecurringPaymentCancelled
 			testFunc := func() {
-				actual = NewEventsPaymentsCancelled(tc.payments)
+				actual = NewEventsRecurringPaymentsCancelled(tc.rps)
 			}
-			require.NotPanics(t, testFunc, "NewEventsPaymentsCancelled")
-			assertEqualSlice(t, tc.expected, actual, eStringer, "NewEventsPaymentsCancelled")
+			require.NotPanics(t, testFunc, "NewEventsRecurringPaymentsCancelled")
+			assertEqualSlice(t, tc.expected, actual, eStringer, "NewEventsRecurringPaymentsCancelled")
 		})
 	}
 }
 
+func TestNewEventMultiPartyPaymentCreated(t *testing.T) {
+	mpp := &MultiPartyPayment{
+		Creator:    "creator_addr",
+		ExternalId: "otc-1",
+		Parties: []PaymentParty{
+			{Address: "creator_addr", SendAmount: sdk.NewCoins(sdk.NewInt64Coin("apple", 1)), Accepted: true},
+			{Address: "party_addr_1", ReceiveAmount: sdk.NewCoins(sdk.NewInt64Coin("apple", 1))},
+			{Address: "party_addr_2", SendAmount: sdk.NewCoins(sdk.NewInt64Coin("plum", 1))},
+		},
+	}
+	expected := &EventMultiPartyPaymentCreated{
+		Creator:    "creator_addr",
+		ExternalId: "otc-1",
+		Parties:    []string{"creator_addr", "party_addr_1", "party_addr_2"},
+	}
+
+	var event *EventMultiPartyPaymentCreated
+	testFunc := func() {
+		event = NewEventMultiPartyPaymentCreated(mpp)
+	}
+	require.NotPanics(t, testFunc, "NewEventMultiPartyPaymentCreated")
+	assert.Equal(t, expected, event, "NewEventMultiPartyPaymentCreated result")
+	assertEverythingSet(t, event, "EventMultiPartyPaymentCreated")
+}
+
+func TestNewEventMultiPartyPaymentAccepted(t *testing.T) {
+	mpp := &MultiPartyPayment{
+		Creator:    "creator_addr",
+		ExternalId: "otc-1",
+		Parties:    []PaymentParty{{Address: "creator_addr"}, {Address: "party_addr_1"}},
+	}
+	expected := &EventMultiPartyPaymentAccepted{
+		Creator:    "creator_addr",
+		ExternalId: "otc-1",
+		Party:      "party_addr_1",
+	}
+
+	var event *EventMultiPartyPaymentAccepted
+	testFunc := func() {
+		event = NewEventMultiPartyPaymentAccepted(mpp, "party_addr_1")
+	}
+	require.NotPanics(t, testFunc, "NewEventMultiPartyPaymentAccepted")
+	assert.Equal(t, expected, event, "NewEventMultiPartyPaymentAccepted result")
+	assertEverythingSet(t, event, "EventMultiPartyPaymentAccepted")
+}
+
+func TestNewEventMultiPartyPaymentSettled(t *testing.T) {
+	mpp := &MultiPartyPayment{
+		Creator:    "creator_addr",
+		ExternalId: "otc-1",
+		Parties:    []PaymentParty{{Address: "creator_addr"}, {Address: "party_addr_1"}, {Address: "party_addr_2"}},
+	}
+	expected := &EventMultiPartyPaymentSettled{
+		Creator:    "creator_addr",
+		ExternalId: "otc-1",
+		Parties:    []string{"creator_addr", "party_addr_1", "party_addr_2"},
+	}
+
+	var event *EventMultiPartyPaymentSettled
+	testFunc := func() {
+		event = NewEventMultiPartyPaymentSettled(mpp)
+	}
+	require.NotPanics(t, testFunc, "NewEventMultiPartyPaymentSettled")
+	assert.Equal(t, expected, event, "NewEventMultiPartyPaymentSettled result")
+	assertEverythingSet(t, event, "EventMultiPartyPaymentSettled")
+}
+
+func TestNewEventMultiPartyPaymentCancelled(t *testing.T) {
+	mpp := &MultiPartyPayment{
+		Creator:    "creator_addr",
+		ExternalId: "otc-1",
+		Parties:    []PaymentParty{{Address: "creator_addr"}, {Address: "party_addr_1"}},
+	}
+	expected := &EventMultiPartyPaymentCancelled{
+		Creator:     "creator_addr",
+		ExternalId:  "otc-1",
+		CancelledBy: "party_addr_1",
+	}
+
+	var event *EventMultiPartyPaymentCancelled
+	testFunc := func() {
+		event = NewEventMultiPartyPaymentCancelled(mpp, "party_addr_1")
+	}
+	require.NotPanics(t, testFunc, "NewEventMultiPartyPaymentCancelled")
+	assert.Equal(t, expected, event, "NewEventMultiPartyPaymentCancelled result")
+	assertEverythingSet(t, event, "EventMultiPartyPaymentCancelled")
+}
+
 func TestTypedEventToEvent(t *testing.T) {
 	quoteStr := func(str string) string {
 		return fmt.Sprintf("%q", str)
@@ -1269,6 +2837,7 @@ func TestTypedEventToEvent(t *testing.T) {
 	pcoinQ := quoteStr(pcoin.String())
 	fcoin := sdk.NewInt64Coin("fcoin", 33)
 	fcoinQ := quoteStr(fcoin.String())
+	effTime := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
 	payment := &Payment{
 		Source:       "source______________",
 		SourceAmount: coins1,
@@ -1281,6 +2850,25 @@ func TestTypedEventToEvent(t *testing.T) {
 	externalIDQ := quoteStr(payment.ExternalId)
 	oldTarget := "old_target__________"
 	oldTargetQ := quoteStr(oldTarget)
+	recurring := &RecurringPayment{
+		Source:             payment.Source,
+		Target:             payment.Target,
+		Amount:             coins1,
+		ExternalId:         payment.ExternalId,
+		IntervalSeconds:    3600,
+		TransfersRemaining: 5,
+		Failures:           1,
+	}
+	multiParty := &MultiPartyPayment{
+		Creator:    payment.Source,
+		ExternalId: payment.ExternalId,
+		Parties: []PaymentParty{
+			{Address: payment.Source, SendAmount: coins1, ReceiveAmount: coins2, Accepted: true},
+			{Address: payment.Target, SendAmount: coins2, ReceiveAmount: coins1},
+		},
+	}
+	partiesQ := fmt.Sprintf("[%s,%s]", sourceQ, targetQ)
+	escrowed := &EscrowedPayment{Payment: *payment, ReleaseTime: effTime}
 
 	tests := []struct {
 		name     string
@@ -1347,7 +2935,7 @@ func TestTypedEventToEvent(t *testing.T) {
 				Price:                   pcoin,
 				SellerSettlementFlatFee: &fcoin,
 				ExternalId:              "eeeeiiiiiddddd",
-			})),
+			}), 31),
 			expEvent: sdk.Event{
 				Type: "provenance.exchange.v1.EventOrderFilled",
 				Attributes: []abci.EventAttribute{
@@ -1357,6 +2945,7 @@ func TestTypedEventToEvent(t *testing.T) {
 					{Key: "market_id", Value: "33"},
 					{Key: "order_id", Value: quoteStr("4")},
 					{Key: "price", Value: pcoinQ},
+					{Key: "settlement_id", Value: quoteStr("31")},
 				},
 			},
 		},
@@ -1368,7 +2957,7 @@ func TestTypedEventToEvent(t *testing.T) {
 				Price:               pcoin,
 				BuyerSettlementFees: sdk.Coins{fcoin},
 				ExternalId:          "that one thing",
-			})),
+			}), 32),
 			expEvent: sdk.Event{
 				Type: "provenance.exchange.v1.EventOrderFilled",
 				Attributes: []abci.EventAttribute{
@@ -1378,6 +2967,7 @@ func TestTypedEventToEvent(t *testing.T) {
 					{Key: "market_id", Value: "44"},
 					{Key: "order_id", Value: quoteStr("104")},
 					{Key: "price", Value: pcoinQ},
+					{Key: "settlement_id", Value: quoteStr("32")},
 				},
 			},
 		},
@@ -1389,7 +2979,7 @@ func TestTypedEventToEvent(t *testing.T) {
 				Price:                   pcoin,
 				SellerSettlementFlatFee: &fcoin,
 				ExternalId:              "12345",
-			})),
+			}), 33),
 			expEvent: sdk.Event{
 				Type: "provenance.exchange.v1.EventOrderPartiallyFilled",
 				Attributes: []abci.EventAttribute{
@@ -1399,6 +2989,7 @@ func TestTypedEventToEvent(t *testing.T) {
 					{Key: "market_id", Value: "22"},
 					{Key: "order_id", Value: quoteStr("5")},
 					{Key: "price", Value: pcoinQ},
+					{Key: "settlement_id", Value: quoteStr("33")},
 				},
 			},
 		},
@@ -1410,7 +3001,7 @@ func TestTypedEventToEvent(t *testing.T) {
 				Price:               pcoin,
 				BuyerSettlementFees: sdk.Coins{fcoin},
 				ExternalId:          "67890",
-			})),
+			}), 34),
 			expEvent: sdk.Event{
 				Type: "provenance.exchange.v1.EventOrderPartiallyFilled",
 				Attributes: []abci.EventAttribute{
@@ -1420,6 +3011,7 @@ func TestTypedEventToEvent(t *testing.T) {
 					{Key: "market_id", Value: "11"},
 					{Key: "order_id", Value: quoteStr("5")},
 					{Key: "price", Value: pcoinQ},
+					{Key: "settlement_id", Value: quoteStr("34")},
 				},
 			},
 		},
@@ -1439,37 +3031,281 @@ func TestTypedEventToEvent(t *testing.T) {
 			name: "EventOrderExternalIDUpdated bid",
 			tev:  NewEventOrderExternalIDUpdated(NewOrder(8).WithBid(&BidOrder{MarketId: 111, ExternalId: "yellow"})),
 			expEvent: sdk.Event{
-				Type: "provenance.exchange.v1.EventOrderExternalIDUpdated",
+				Type: "provenance.exchange.v1.EventOrderExternalIDUpdated",
+				Attributes: []abci.EventAttribute{
+					{Key: "external_id", Value: quoteStr("yellow")},
+					{Key: "market_id", Value: "111"},
+					{Key: "order_id", Value: quoteStr("8")},
+				},
+			},
+		},
+		{
+			name: "EventOrderExpired",
+			tev:  NewEventOrderExpired(NewOrder(12).WithBid(&BidOrder{MarketId: 7, ExternalId: "old"})),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventOrderExpired",
+				Attributes: []abci.EventAttribute{
+					{Key: "external_id", Value: quoteStr("old")},
+					{Key: "market_id", Value: "7"},
+					{Key: "order_id", Value: quoteStr("12")},
+				},
+			},
+		},
+		{
+			name: "EventOrderAmended",
+			tev: NewEventOrderAmended(NewOrder(14).WithAsk(&AskOrder{
+				MarketId:   6,
+				Assets:     acoin,
+				Price:      pcoin,
+				ExternalId: "tweaked",
+			})),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventOrderAmended",
+				Attributes: []abci.EventAttribute{
+					{Key: "assets", Value: acoinQ},
+					{Key: "external_id", Value: quoteStr("tweaked")},
+					{Key: "market_id", Value: "6"},
+					{Key: "order_id", Value: quoteStr("14")},
+					{Key: "price", Value: pcoinQ},
+				},
+			},
+		},
+		{
+			name: "EventOrdersLinked",
+			tev:  NewEventOrdersLinked(NewOrderLink(4, 15, 16)),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventOrdersLinked",
+				Attributes: []abci.EventAttribute{
+					{Key: "linked_order_id", Value: quoteStr("16")},
+					{Key: "market_id", Value: "4"},
+					{Key: "order_id", Value: quoteStr("15")},
+				},
+			},
+		},
+		{
+			name: "EventLinkedOrderCancelled",
+			tev: NewEventLinkedOrderCancelled(
+				NewOrder(16).WithBid(&BidOrder{MarketId: 4, ExternalId: "leg2"}), 15,
+			),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventLinkedOrderCancelled",
+				Attributes: []abci.EventAttribute{
+					{Key: "external_id", Value: quoteStr("leg2")},
+					{Key: "linked_order_id", Value: quoteStr("15")},
+					{Key: "market_id", Value: "4"},
+					{Key: "order_id", Value: quoteStr("16")},
+				},
+			},
+		},
+		{
+			name: "EventDeadManSwitchSet",
+			tev: NewEventDeadManSwitchSet(&DeadManSwitch{
+				Account: cancelledBy, IntervalSeconds: 60, Deadline: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			}),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventDeadManSwitchSet",
+				Attributes: []abci.EventAttribute{
+					{Key: "account", Value: cancelledByQ},
+					{Key: "deadline", Value: quoteStr("2024-01-02T03:04:05Z")},
+					{Key: "interval_seconds", Value: quoteStr("60")},
+				},
+			},
+		},
+		{
+			name: "EventDeadManSwitchHeartbeat",
+			tev: NewEventDeadManSwitchHeartbeat(&DeadManSwitch{
+				Account: cancelledBy, IntervalSeconds: 60, Deadline: time.Date(2024, 1, 2, 3, 5, 5, 0, time.UTC),
+			}),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventDeadManSwitchHeartbeat",
+				Attributes: []abci.EventAttribute{
+					{Key: "account", Value: cancelledByQ},
+					{Key: "deadline", Value: quoteStr("2024-01-02T03:05:05Z")},
+				},
+			},
+		},
+		{
+			name: "EventDeadManSwitchRemoved",
+			tev:  NewEventDeadManSwitchRemoved(cancelledBy),
+			expEvent: sdk.Event{
+				Type:       "provenance.exchange.v1.EventDeadManSwitchRemoved",
+				Attributes: []abci.EventAttribute{{Key: "account", Value: cancelledByQ}},
+			},
+		},
+		{
+			name: "EventDeadManSwitchTriggered",
+			tev:  NewEventDeadManSwitchTriggered(cancelledBy, 7),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventDeadManSwitchTriggered",
+				Attributes: []abci.EventAttribute{
+					{Key: "account", Value: cancelledByQ},
+					{Key: "orders_cancelled", Value: "7"},
+				},
+			},
+		},
+		{
+			name: "EventMarketOrdersBulkCancelled",
+			tev:  NewEventMarketOrdersBulkCancelled(6, cancelledBy, 250, false),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventMarketOrdersBulkCancelled",
+				Attributes: []abci.EventAttribute{
+					{Key: "cancelled_by", Value: cancelledByQ},
+					{Key: "count", Value: "250"},
+					{Key: "has_more", Value: "false"},
+					{Key: "market_id", Value: "6"},
+				},
+			},
+		},
+		{
+			name: "EventSelfTradePrevented",
+			tev: NewEventSelfTradePrevented(
+				NewOrder(17).WithAsk(&AskOrder{MarketId: 4, ExternalId: "wash"}), 12, sdk.NewInt64Coin("apple", 8),
+			),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventSelfTradePrevented",
+				Attributes: []abci.EventAttribute{
+					{Key: "assets", Value: quoteStr("8apple")},
+					{Key: "external_id", Value: quoteStr("wash")},
+					{Key: "market_id", Value: "4"},
+					{Key: "opposing_order_id", Value: quoteStr("12")},
+					{Key: "order_id", Value: quoteStr("17")},
+				},
+			},
+		},
+		{
+			name: "EventTriggerOrderCreated",
+			tev: NewEventTriggerOrderCreated(NewTriggerOrder(
+				*NewOrder(13).WithAsk(&AskOrder{MarketId: 9, ExternalId: "stop"}),
+				sdk.NewInt64Coin("nhash", 50),
+			)),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventTriggerOrderCreated",
+				Attributes: []abci.EventAttribute{
+					{Key: "external_id", Value: quoteStr("stop")},
+					{Key: "market_id", Value: "9"},
+					{Key: "order_id", Value: quoteStr("13")},
+					{Key: "order_type", Value: quoteStr("ask")},
+					{Key: "trigger_price", Value: quoteStr("50nhash")},
+				},
+			},
+		},
+		{
+			name: "EventTriggerOrderActivated",
+			tev: NewEventTriggerOrderActivated(
+				NewOrder(14).WithBid(&BidOrder{MarketId: 10, ExternalId: "go"}),
+				sdk.NewInt64Coin("nhash", 60),
+			),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventTriggerOrderActivated",
+				Attributes: []abci.EventAttribute{
+					{Key: "external_id", Value: quoteStr("go")},
+					{Key: "market_id", Value: "10"},
+					{Key: "order_id", Value: quoteStr("14")},
+					{Key: "order_type", Value: quoteStr("bid")},
+					{Key: "price", Value: quoteStr("60nhash")},
+				},
+			},
+		},
+		{
+			name: "EventBatchAuctionSettled",
+			tev: NewEventBatchAuctionSettled(15, sdk.NewInt64Coin("apple", 7), sdk.NewInt64Coin("nhash", 22),
+				sdk.NewDecCoinFromDec("nhash", sdkmath.LegacyMustNewDecFromStr("3.125"))),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventBatchAuctionSettled",
+				Attributes: []abci.EventAttribute{
+					{Key: "assets", Value: quoteStr("7apple")},
+					{Key: "clearing_price", Value: quoteStr("3.125000000000000000nhash")},
+					{Key: "market_id", Value: "15"},
+					{Key: "price", Value: quoteStr("22nhash")},
+				},
+			},
+		},
+		{
+			name: "EventFundsCommitted",
+			tev:  NewEventFundsCommitted(account, 44, coins1, "tagTagTAG"),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventFundsCommitted",
+				Attributes: []abci.EventAttribute{
+					{Key: "account", Value: accountQ},
+					{Key: "amount", Value: coins1Q},
+					{Key: "market_id", Value: "44"},
+					{Key: "tag", Value: quoteStr("tagTagTAG")},
+				},
+			},
+		},
+		{
+			name: "EventCommitmentReleased",
+			tev:  NewEventCommitmentReleased(account, 15, coins1, "something"),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventCommitmentReleased",
+				Attributes: []abci.EventAttribute{
+					{Key: "account", Value: accountQ},
+					{Key: "amount", Value: coins1Q},
+					{Key: "market_id", Value: "15"},
+					{Key: "tag", Value: quoteStr("something")},
+				},
+			},
+		},
+		{
+			name: "EventCommitmentExpired",
+			tev:  NewEventCommitmentExpired(account, 16, coins1),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventCommitmentExpired",
+				Attributes: []abci.EventAttribute{
+					{Key: "account", Value: accountQ},
+					{Key: "amount", Value: coins1Q},
+					{Key: "market_id", Value: "16"},
+				},
+			},
+		},
+		{
+			name: "EventCommitmentRewardsFunded",
+			tev:  NewEventCommitmentRewardsFunded(17, account, coins1),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventCommitmentRewardsFunded",
+				Attributes: []abci.EventAttribute{
+					{Key: "amount", Value: coins1Q},
+					{Key: "funder", Value: accountQ},
+					{Key: "market_id", Value: "17"},
+				},
+			},
+		},
+		{
+			name: "EventCommitmentRewardsDistributed",
+			tev:  NewEventCommitmentRewardsDistributed(18, coins1, "cherry", withdrawnBy.String()),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventCommitmentRewardsDistributed",
 				Attributes: []abci.EventAttribute{
-					{Key: "external_id", Value: quoteStr("yellow")},
-					{Key: "market_id", Value: "111"},
-					{Key: "order_id", Value: quoteStr("8")},
+					{Key: "amount", Value: coins1Q},
+					{Key: "basis_denom", Value: quoteStr("cherry")},
+					{Key: "distributed_by", Value: withdrawnByQ},
+					{Key: "market_id", Value: "18"},
 				},
 			},
 		},
 		{
-			name: "EventFundsCommitted",
-			tev:  NewEventFundsCommitted(account, 44, coins1, "tagTagTAG"),
+			name: "EventCommitmentRewardsClaimed",
+			tev:  NewEventCommitmentRewardsClaimed(account, 19, coins1),
 			expEvent: sdk.Event{
-				Type: "provenance.exchange.v1.EventFundsCommitted",
+				Type: "provenance.exchange.v1.EventCommitmentRewardsClaimed",
 				Attributes: []abci.EventAttribute{
 					{Key: "account", Value: accountQ},
 					{Key: "amount", Value: coins1Q},
-					{Key: "market_id", Value: "44"},
-					{Key: "tag", Value: quoteStr("tagTagTAG")},
+					{Key: "market_id", Value: "19"},
 				},
 			},
 		},
 		{
-			name: "EventCommitmentReleased",
-			tev:  NewEventCommitmentReleased(account, 15, coins1, "something"),
+			name: "EventMarketWoundDown",
+			tev:  NewEventMarketWoundDown(6, 3, 1, false, nil),
 			expEvent: sdk.Event{
-				Type: "provenance.exchange.v1.EventCommitmentReleased",
+				Type: "provenance.exchange.v1.EventMarketWoundDown",
 				Attributes: []abci.EventAttribute{
-					{Key: "account", Value: accountQ},
-					{Key: "amount", Value: coins1Q},
-					{Key: "market_id", Value: "15"},
-					{Key: "tag", Value: quoteStr("something")},
+					{Key: "commitments_released", Value: "1"},
+					{Key: "market_deleted", Value: "false"},
+					{Key: "market_id", Value: "6"},
+					{Key: "orders_cancelled", Value: "3"},
+					{Key: "releases", Value: "[]"},
 				},
 			},
 		},
@@ -1563,6 +3399,116 @@ func TestTypedEventToEvent(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "EventMarketContinuousMatchingEnabled",
+			tev:  NewEventMarketContinuousMatchingEnabled(41, updatedBy),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventMarketContinuousMatchingEnabled",
+				Attributes: []abci.EventAttribute{
+					{Key: "market_id", Value: "41"},
+					{Key: "updated_by", Value: updatedByQ},
+				},
+			},
+		},
+		{
+			name: "EventMarketContinuousMatchingDisabled",
+			tev:  NewEventMarketContinuousMatchingDisabled(14, updatedBy),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventMarketContinuousMatchingDisabled",
+				Attributes: []abci.EventAttribute{
+					{Key: "market_id", Value: "14"},
+					{Key: "updated_by", Value: updatedByQ},
+				},
+			},
+		},
+		{
+			name: "EventMarketBatchAuctionUpdated",
+			tev:  NewEventMarketBatchAuctionUpdated(16, updatedBy),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventMarketBatchAuctionUpdated",
+				Attributes: []abci.EventAttribute{
+					{Key: "market_id", Value: "16"},
+					{Key: "updated_by", Value: updatedByQ},
+				},
+			},
+		},
+		{
+			name: "EventMarketSelfTradePreventionUpdated",
+			tev:  NewEventMarketSelfTradePreventionUpdated(17, updatedBy),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventMarketSelfTradePreventionUpdated",
+				Attributes: []abci.EventAttribute{
+					{Key: "market_id", Value: "17"},
+					{Key: "updated_by", Value: updatedByQ},
+				},
+			},
+		},
+		{
+			name: "EventMarketPublishPricesEnabled",
+			tev:  NewEventMarketPublishPricesEnabled(51, updatedBy),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventMarketPublishPricesEnabled",
+				Attributes: []abci.EventAttribute{
+					{Key: "market_id", Value: "51"},
+					{Key: "updated_by", Value: updatedByQ},
+				},
+			},
+		},
+		{
+			name: "EventMarketPublishPricesDisabled",
+			tev:  NewEventMarketPublishPricesDisabled(15, updatedBy),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventMarketPublishPricesDisabled",
+				Attributes: []abci.EventAttribute{
+					{Key: "market_id", Value: "15"},
+					{Key: "updated_by", Value: updatedByQ},
+				},
+			},
+		},
+		{
+			name: "EventMarketExternalIDScopeUpdated",
+			tev:  NewEventMarketExternalIDScopeUpdated(41, updatedBy),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventMarketExternalIDScopeUpdated",
+				Attributes: []abci.EventAttribute{
+					{Key: "market_id", Value: "41"},
+					{Key: "updated_by", Value: updatedByQ},
+				},
+			},
+		},
+		{
+			name: "EventMarketOrderRateLimitUpdated",
+			tev:  NewEventMarketOrderRateLimitUpdated(43, updatedBy),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventMarketOrderRateLimitUpdated",
+				Attributes: []abci.EventAttribute{
+					{Key: "market_id", Value: "43"},
+					{Key: "updated_by", Value: updatedByQ},
+				},
+			},
+		},
+		{
+			name: "EventMarketMaxExposureUpdated",
+			tev:  NewEventMarketMaxExposureUpdated(44, updatedBy),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventMarketMaxExposureUpdated",
+				Attributes: []abci.EventAttribute{
+					{Key: "market_id", Value: "44"},
+					{Key: "updated_by", Value: updatedByQ},
+				},
+			},
+		},
+		{
+			name: "EventMarketFillAlgorithmUpdated",
+			tev:  NewEventMarketFillAlgorithmUpdated(45, updatedBy),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventMarketFillAlgorithmUpdated",
+				Attributes: []abci.EventAttribute{
+					{Key: "market_id", Value: "45"},
+					{Key: "updated_by", Value: updatedByQ},
+				},
+			},
+		},
 		{
 			name: "EventMarketIntermediaryDenomUpdated",
 			tev:  NewEventMarketIntermediaryDenomUpdated(18, updatedBy),
@@ -1585,6 +3531,45 @@ func TestTypedEventToEvent(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "EventMarketAuthorityTransferProposed",
+			tev: NewEventMarketAuthorityTransferProposed(&MarketAuthorityTransfer{
+				MarketId: 12, ProposedBy: updatedBy, NewAuthority: account,
+			}),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventMarketAuthorityTransferProposed",
+				Attributes: []abci.EventAttribute{
+					{Key: "market_id", Value: "12"},
+					{Key: "new_authority", Value: accountQ},
+					{Key: "proposed_by", Value: updatedByQ},
+				},
+			},
+		},
+		{
+			name: "EventMarketAuthorityTransferred",
+			tev: NewEventMarketAuthorityTransferred(&MarketAuthorityTransfer{
+				MarketId: 12, ProposedBy: updatedBy, NewAuthority: account,
+			}),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventMarketAuthorityTransferred",
+				Attributes: []abci.EventAttribute{
+					{Key: "market_id", Value: "12"},
+					{Key: "new_authority", Value: accountQ},
+					{Key: "proposed_by", Value: updatedByQ},
+				},
+			},
+		},
+		{
+			name: "EventMarketAccessGrantExpired",
+			tev:  NewEventMarketAccessGrantExpired(12, destination),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventMarketAccessGrantExpired",
+				Attributes: []abci.EventAttribute{
+					{Key: "address", Value: destinationQ},
+					{Key: "market_id", Value: "12"},
+				},
+			},
+		},
 		{
 			name: "EventMarketReqAttrUpdated",
 			tev:  NewEventMarketReqAttrUpdated(13, updatedBy),
@@ -1596,6 +3581,17 @@ func TestTypedEventToEvent(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "EventMarketAcceptedDenomsUpdated",
+			tev:  NewEventMarketAcceptedDenomsUpdated(13, updatedBy),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventMarketAcceptedDenomsUpdated",
+				Attributes: []abci.EventAttribute{
+					{Key: "market_id", Value: "13"},
+					{Key: "updated_by", Value: updatedByQ},
+				},
+			},
+		},
 		{
 			name: "EventMarketCreated",
 			tev:  NewEventMarketCreated(14),
@@ -1616,6 +3612,53 @@ func TestTypedEventToEvent(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "EventMarketExchangeSplitsUpdated",
+			tev:  NewEventMarketExchangeSplitsUpdated(16),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventMarketExchangeSplitsUpdated",
+				Attributes: []abci.EventAttribute{
+					{Key: "market_id", Value: "16"},
+				},
+			},
+		},
+		{
+			name: "EventMarketFeesScheduled",
+			tev:  NewEventMarketFeesScheduled(15, 200, &effTime),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventMarketFeesScheduled",
+				Attributes: []abci.EventAttribute{
+					{Key: "effective_height", Value: quoteStr("200")},
+					{Key: "effective_time", Value: quoteStr("2030-01-02T03:04:05Z")},
+					{Key: "market_id", Value: "15"},
+				},
+			},
+		},
+		{
+			name: "EventRebatePaid",
+			tev:  NewEventRebatePaid(16, account, coins1),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventRebatePaid",
+				Attributes: []abci.EventAttribute{
+					{Key: "amount", Value: coins1Q},
+					{Key: "market_id", Value: "16"},
+					{Key: "recipient", Value: accountQ},
+				},
+			},
+		},
+		{
+			name: "EventReferralPaid",
+			tev:  NewEventReferralPaid(NewOrder(17).WithAsk(&AskOrder{MarketId: 18, Referrer: account}), coins1),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventReferralPaid",
+				Attributes: []abci.EventAttribute{
+					{Key: "amount", Value: coins1Q},
+					{Key: "market_id", Value: "18"},
+					{Key: "order_id", Value: quoteStr("17")},
+					{Key: "referrer", Value: accountQ},
+				},
+			},
+		},
 		{
 			name: "EventParamsUpdated",
 			tev:  NewEventParamsUpdated(),
@@ -1691,6 +3734,160 @@ func TestTypedEventToEvent(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "EventPaymentExpired",
+			tev:  NewEventPaymentExpired(payment),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventPaymentExpired",
+				Attributes: []abci.EventAttribute{
+					{Key: "external_id", Value: externalIDQ},
+					{Key: "source", Value: sourceQ},
+					{Key: "target", Value: targetQ},
+				},
+			},
+		},
+		{
+			name: "EventPaymentEscrowed",
+			tev:  NewEventPaymentEscrowed(escrowed),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventPaymentEscrowed",
+				Attributes: []abci.EventAttribute{
+					{Key: "external_id", Value: externalIDQ},
+					{Key: "release_time", Value: quoteStr("2030-01-02T03:04:05Z")},
+					{Key: "source", Value: sourceQ},
+					{Key: "target", Value: targetQ},
+				},
+			},
+		},
+		{
+			name: "EventPaymentReleased",
+			tev:  NewEventPaymentReleased(payment),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventPaymentReleased",
+				Attributes: []abci.EventAttribute{
+					{Key: "external_id", Value: externalIDQ},
+					{Key: "source", Value: sourceQ},
+					{Key: "source_amount", Value: coins1Q},
+					{Key: "target", Value: targetQ},
+					{Key: "target_amount", Value: coins2Q},
+				},
+			},
+		},
+		{
+			name: "EventPaymentDisputed",
+			tev:  NewEventPaymentDisputed(payment),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventPaymentDisputed",
+				Attributes: []abci.EventAttribute{
+					{Key: "external_id", Value: externalIDQ},
+					{Key: "source", Value: sourceQ},
+					{Key: "target", Value: targetQ},
+				},
+			},
+		},
+		{
+			name: "EventRecurringPaymentCreated",
+			tev:  NewEventRecurringPaymentCreated(recurring),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventRecurringPaymentCreated",
+				Attributes: []abci.EventAttribute{
+					{Key: "amount", Value: coins1Q},
+					{Key: "external_id", Value: externalIDQ},
+					{Key: "source", Value: sourceQ},
+					{Key: "target", Value: targetQ},
+					{Key: "transfers", Value: "5"},
+				},
+			},
+		},
+		{
+			name: "EventRecurringPaymentTransferred",
+			tev:  NewEventRecurringPaymentTransferred(recurring),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventRecurringPaymentTransferred",
+				Attributes: []abci.EventAttribute{
+					{Key: "amount", Value: coins1Q},
+					{Key: "external_id", Value: externalIDQ},
+					{Key: "source", Value: sourceQ},
+					{Key: "target", Value: targetQ},
+					{Key: "transfers_remaining", Value: "5"},
+				},
+			},
+		},
+		{
+			name: "EventRecurringPaymentFailed",
+			tev:  NewEventRecurringPaymentFailed(recurring, errors.New("no money")),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventRecurringPaymentFailed",
+				Attributes: []abci.EventAttribute{
+					{Key: "error", Value: quoteStr("no money")},
+					{Key: "external_id", Value: externalIDQ},
+					{Key: "failures", Value: "1"},
+					{Key: "source", Value: sourceQ},
+					{Key: "target", Value: targetQ},
+				},
+			},
+		},
+		{
+			name: "EventRecurringPaymentCancelled",
+			tev:  NewEventRecurringPaymentCancelled(recurring),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventRecurringPaymentCancelled",
+				Attributes: []abci.EventAttribute{
+					{Key: "external_id", Value: externalIDQ},
+					{Key: "source", Value: sourceQ},
+					{Key: "target", Value: targetQ},
+					{Key: "transfers_remaining", Value: "5"},
+				},
+			},
+		},
+		{
+			name: "EventMultiPartyPaymentCreated",
+			tev:  NewEventMultiPartyPaymentCreated(multiParty),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventMultiPartyPaymentCreated",
+				Attributes: []abci.EventAttribute{
+					{Key: "creator", Value: sourceQ},
+					{Key: "external_id", Value: externalIDQ},
+					{Key: "parties", Value: partiesQ},
+				},
+			},
+		},
+		{
+			name: "EventMultiPartyPaymentAccepted",
+			tev:  NewEventMultiPartyPaymentAccepted(multiParty, payment.Target),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventMultiPartyPaymentAccepted",
+				Attributes: []abci.EventAttribute{
+					{Key: "creator", Value: sourceQ},
+					{Key: "external_id", Value: externalIDQ},
+					{Key: "party", Value: targetQ},
+				},
+			},
+		},
+		{
+			name: "EventMultiPartyPaymentSettled",
+			tev:  NewEventMultiPartyPaymentSettled(multiParty),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventMultiPartyPaymentSettled",
+				Attributes: []abci.EventAttribute{
+					{Key: "creator", Value: sourceQ},
+					{Key: "external_id", Value: externalIDQ},
+					{Key: "parties", Value: partiesQ},
+				},
+			},
+		},
+		{
+			name: "EventMultiPartyPaymentCancelled",
+			tev:  NewEventMultiPartyPaymentCancelled(multiParty, cancelledBy),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventMultiPartyPaymentCancelled",
+				Attributes: []abci.EventAttribute{
+					{Key: "cancelled_by", Value: cancelledByQ},
+					{Key: "creator", Value: sourceQ},
+					{Key: "external_id", Value: externalIDQ},
+				},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -27,12 +27,14 @@ type BankKeeper interface {
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	InputOutputCoinsProv(ctx context.Context, inputs []banktypes.Input, outputs []banktypes.Output) error
 	BlockedAddr(addr sdk.AccAddress) bool
+	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 }
 
 type HoldKeeper interface {
 	AddHold(ctx sdk.Context, addr sdk.AccAddress, funds sdk.Coins, reason string) error
 	ReleaseHold(ctx sdk.Context, addr sdk.AccAddress, funds sdk.Coins) error
 	GetHoldCoin(ctx sdk.Context, addr sdk.AccAddress, denom string) (sdk.Coin, error)
+	IterateAllHolds(ctx sdk.Context, process func(sdk.AccAddress, sdk.Coin) bool) error
 }
 
 type MarkerKeeper interface {
@@ -45,3 +47,7 @@ type MetadataKeeper interface {
 	AddSetNetAssetValues(ctx sdk.Context, scopeID metadatatypes.MetadataAddress, netAssetValues []metadatatypes.NetAssetValue, source string) error
 	GetNetAssetValue(ctx sdk.Context, metadataDenom, priceDenom string) (*metadatatypes.NetAssetValue, error)
 }
+
+type OracleKeeper interface {
+	PublishPrice(ctx sdk.Context, assets, price sdk.Coin, source string) error
+}
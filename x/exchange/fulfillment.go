@@ -3,6 +3,7 @@ package exchange
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -32,10 +33,45 @@ type Settlement struct {
 	PartialOrderFilled *FilledOrder
 	// PartialOrderLeft is what's left of the partially filled order.
 	PartialOrderLeft *Order
+	// OtherPartialOrders are any other orders being partially filled (e.g. by a pro-rata fill).
+	// BuildSettlement never populates this, and none of these are included in FullyFilledOrders.
+	OtherPartialOrders []*PartialOrder
+}
+
+// PartialOrder is an order that's being partially filled in a settlement.
+type PartialOrder struct {
+	// Filled is the partially filled order with amounts indicating how much was filled.
+	Filled *FilledOrder
+	// Left is what's left of the partially filled order.
+	Left *Order
+}
+
+// GetPartialOrders returns all the partially filled orders in this settlement.
+// The one in PartialOrderFilled (if there is one) is first, followed by the OtherPartialOrders.
+func (s *Settlement) GetPartialOrders() []*PartialOrder {
+	if s == nil {
+		return nil
+	}
+	var rv []*PartialOrder
+	if s.PartialOrderFilled != nil {
+		rv = append(rv, &PartialOrder{Filled: s.PartialOrderFilled, Left: s.PartialOrderLeft})
+	}
+	rv = append(rv, s.OtherPartialOrders...)
+	return rv
+}
+
+// NewSettlementTransfer creates a new SettlementTransfer with the inputs and outputs of the provided transfer.
+func NewSettlementTransfer(transfer *Transfer) SettlementTransfer {
+	return SettlementTransfer{
+		Inputs:  BankInputsToAccountAmounts(transfer.Inputs...),
+		Outputs: BankOutputsToAccountAmounts(transfer.Outputs...),
+	}
 }
 
 // BuildSettlement processes the provided orders, identifying how the provided orders can be settled.
-func BuildSettlement(askOrders, bidOrders []*Order, sellerFeeRatioLookup func(denom string) (*FeeRatio, error)) (*Settlement, error) {
+// The sellerFeeRatioLookup should return the seller settlement fee ratio for the given price denom,
+// using the taker ratio if isTaker is true. An ask order is a taker when it is newer than all the bid orders.
+func BuildSettlement(askOrders, bidOrders []*Order, sellerFeeRatioLookup func(denom string, isTaker bool) (*FeeRatio, error)) (*Settlement, error) {
 	if err := validateCanSettle(askOrders, bidOrders); err != nil {
 		return nil, err
 	}
@@ -60,11 +96,16 @@ func BuildSettlement(askOrders, bidOrders []*Order, sellerFeeRatioLookup func(de
 	}
 
 	// Set the fees in the fulfillments
-	sellerFeeRatio, err := sellerFeeRatioLookup(askOFs[0].GetPrice().Denom)
+	priceDenom := askOFs[0].GetPrice().Denom
+	makerFeeRatio, err := sellerFeeRatioLookup(priceDenom, false)
 	if err != nil {
 		return nil, err
 	}
-	if err = setFeesToPay(askOFs, bidOFs, sellerFeeRatio); err != nil {
+	takerFeeRatio, err := sellerFeeRatioLookup(priceDenom, true)
+	if err != nil {
+		return nil, err
+	}
+	if err = setFeesToPay(askOFs, bidOFs, makerFeeRatio, takerFeeRatio); err != nil {
 		return nil, err
 	}
 
@@ -288,6 +329,26 @@ func (f orderFulfillment) GetExternalID() string {
 	return f.Order.GetExternalID()
 }
 
+// GetExpiration gets this fulfillment's order's expiration.
+func (f orderFulfillment) GetExpiration() *time.Time {
+	return f.Order.GetExpiration()
+}
+
+// GetDisplayAssets gets this fulfillment's order's display assets.
+func (f orderFulfillment) GetDisplayAssets() *sdk.Coin {
+	return f.Order.GetDisplayAssets()
+}
+
+// GetReferrer gets this fulfillment's order's referrer.
+func (f orderFulfillment) GetReferrer() string {
+	return f.Order.GetReferrer()
+}
+
+// GetBasketAssets gets this fulfillment's order's basket assets.
+func (f orderFulfillment) GetBasketAssets() sdk.Coins {
+	return f.Order.GetBasketAssets()
+}
+
 // GetOrderType gets this fulfillment's order's type string.
 func (f orderFulfillment) GetOrderType() string {
 	return f.Order.GetOrderType()
@@ -450,7 +511,42 @@ func validateCanSettle(askOrders, bidOrders []*Order) error {
 	// Note: We don't compare the total asset and price amounts because we need to know what's
 	// being partially filled before we can make an assertions about the amounts.
 
-	return errors.Join(errs...)
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	return validateBaskets(askOrders, bidOrders)
+}
+
+// validateBaskets makes sure that, if any of the provided orders are basket orders, they can be settled together.
+// A basket order can only be settled as the only ask with a bid order (or the only bid with an ask order)
+// having the same assets and basket assets.
+func validateBaskets(askOrders, bidOrders []*Order) error {
+	hasBasket := false
+	for _, orders := range [][]*Order{askOrders, bidOrders} {
+		for _, order := range orders {
+			hasBasket = hasBasket || order.IsBasketOrder()
+		}
+	}
+	if !hasBasket {
+		return nil
+	}
+
+	if len(askOrders) != 1 || len(bidOrders) != 1 {
+		return fmt.Errorf("cannot settle basket orders with %d asks and %d bids: "+
+			"a basket order can only be settled with exactly one other order", len(askOrders), len(bidOrders))
+	}
+
+	ask, bid := askOrders[0], bidOrders[0]
+	askAssets, bidAssets := ask.GetAssets(), bid.GetAssets()
+	if !askAssets.Equal(bidAssets) || !ask.GetBasketAssets().Equal(bid.GetBasketAssets()) {
+		return fmt.Errorf("cannot settle %s order %d having assets %q and basket %q with %s order %d "+
+			"having assets %q and basket %q: assets and basket must be the same",
+			ask.GetOrderType(), ask.GetOrderID(), askAssets, ask.GetBasketAssets(),
+			bid.GetOrderType(), bid.GetOrderID(), bidAssets, bid.GetBasketAssets())
+	}
+
+	return nil
 }
 
 // allocateAssets distributes the assets among the fulfillments.
@@ -649,10 +745,23 @@ func getFulfillmentPriceAmt(of1, of2 *orderFulfillment) (sdkmath.Int, error) {
 }
 
 // setFeesToPay sets the FeesToPay on each fulfillment.
-func setFeesToPay(askOFs, bidOFs []*orderFulfillment, sellerFeeRatio *FeeRatio) error {
+// Ask orders that are newer than all the bid orders are takers and are charged the takerFeeRatio,
+// all other ask orders are makers and are charged the makerFeeRatio.
+func setFeesToPay(askOFs, bidOFs []*orderFulfillment, makerFeeRatio, takerFeeRatio *FeeRatio) error {
+	var newestBidID uint64
+	for _, bidOF := range bidOFs {
+		if bidOF.GetOrderID() > newestBidID {
+			newestBidID = bidOF.GetOrderID()
+		}
+	}
+
 	var errs []error
 	for _, askOF := range askOFs {
 		feesToPay := askOF.GetSettlementFees()
+		sellerFeeRatio := makerFeeRatio
+		if askOF.GetOrderID() > newestBidID {
+			sellerFeeRatio = takerFeeRatio
+		}
 		if sellerFeeRatio != nil {
 			fee, err := sellerFeeRatio.ApplyToLoosely(askOF.GetPriceApplied())
 			if err != nil {
@@ -824,16 +933,27 @@ func getAssetTransfer(f *orderFulfillment) (*Transfer, error) {
 			f.GetOrderType(), f.GetOrderID(), assetsFilled, f.AssetCoin(sumDists))
 	}
 
+	// The basket assets always go along with the assets, so they must all go to (or come from) one place.
+	assetsMoved := sdk.NewCoins(assetsFilled)
+	if basket := f.GetBasketAssets(); len(basket) > 0 {
+		if len(f.AssetDists) != 1 || !assetsFilled.Equal(f.GetAssets()) {
+			return nil, fmt.Errorf("%s order %d cannot have basket %q split up: basket orders must be filled in full by one order",
+				f.GetOrderType(), f.GetOrderID(), basket)
+		}
+		indexedDists.Add(f.AssetDists[0].Address, basket...)
+		assetsMoved = assetsMoved.Add(basket...)
+	}
+
 	if f.IsAskOrder() {
 		return &Transfer{
-			Inputs:  []banktypes.Input{{Address: f.GetOwner(), Coins: sdk.NewCoins(assetsFilled)}},
+			Inputs:  []banktypes.Input{{Address: f.GetOwner(), Coins: assetsMoved}},
 			Outputs: indexedDists.GetAsOutputs(),
 		}, nil
 	}
 	if f.IsBidOrder() {
 		return &Transfer{
 			Inputs:  indexedDists.GetAsInputs(),
-			Outputs: []banktypes.Output{{Address: f.GetOwner(), Coins: sdk.NewCoins(assetsFilled)}},
+			Outputs: []banktypes.Output{{Address: f.GetOwner(), Coins: assetsMoved}},
 		}, nil
 	}
 
@@ -890,8 +1010,10 @@ func filterOrders(settlement *Settlement, checker func(order OrderI) bool) []Ord
 			rv = append(rv, order)
 		}
 	}
-	if settlement.PartialOrderFilled != nil && checker(settlement.PartialOrderFilled) {
-		rv = append(rv, settlement.PartialOrderFilled)
+	for _, partial := range settlement.GetPartialOrders() {
+		if checker(partial.Filled) {
+			rv = append(rv, partial.Filled)
+		}
 	}
 	return rv
 }
@@ -910,6 +1032,10 @@ func GetNAVs(settlement *Settlement) []NetAssetPrice {
 
 	var navs []NetAssetPrice
 	for _, order := range orders {
+		// The price of a basket order is for more than just its assets, so it doesn't indicate their value.
+		if len(order.GetBasketAssets()) > 0 {
+			continue
+		}
 		assets := order.GetAssets()
 		price := order.GetPrice()
 		found := false
@@ -301,6 +301,54 @@ func assertEqualNAVs(t *testing.T, expected, actual []NetAssetPrice, message str
 	return false
 }
 
+func TestNewSettlementTransfer(t *testing.T) {
+	coins := func(coins string) sdk.Coins {
+		rv, err := sdk.ParseCoinsNormalized(coins)
+		require.NoError(t, err, "ParseCoinsNormalized(%q)", coins)
+		return rv
+	}
+
+	tests := []struct {
+		name     string
+		transfer *Transfer
+		expected SettlementTransfer
+	}{
+		{
+			name:     "empty transfer",
+			transfer: &Transfer{},
+			expected: SettlementTransfer{Inputs: []AccountAmount{}, Outputs: []AccountAmount{}},
+		},
+		{
+			name: "one input two outputs",
+			transfer: &Transfer{
+				Inputs: []banktypes.Input{{Address: "seller", Coins: coins("10apple")}},
+				Outputs: []banktypes.Output{
+					{Address: "buyer1", Coins: coins("3apple")},
+					{Address: "buyer2", Coins: coins("7apple")},
+				},
+			},
+			expected: SettlementTransfer{
+				Inputs: []AccountAmount{{Account: "seller", Amount: coins("10apple")}},
+				Outputs: []AccountAmount{
+					{Account: "buyer1", Amount: coins("3apple")},
+					{Account: "buyer2", Amount: coins("7apple")},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual SettlementTransfer
+			testFunc := func() {
+				actual = NewSettlementTransfer(tc.transfer)
+			}
+			require.NotPanics(t, testFunc, "NewSettlementTransfer")
+			assert.Equal(t, tc.expected, actual, "NewSettlementTransfer result")
+		})
+	}
+}
+
 func TestBuildSettlement(t *testing.T) {
 	assetDenom, priceDenom := "apple", "peach"
 	feeDenoms := []string{"fig", "grape"}
@@ -342,8 +390,8 @@ func TestBuildSettlement(t *testing.T) {
 			AllowPartial:        allowPartial,
 		})
 	}
-	ratio := func(price, fee int64) func(denom string) (*FeeRatio, error) {
-		return func(denom string) (*FeeRatio, error) {
+	ratio := func(price, fee int64) func(denom string, isTaker bool) (*FeeRatio, error) {
+		return func(denom string, isTaker bool) (*FeeRatio, error) {
 			return &FeeRatio{Price: sdk.NewInt64Coin(priceDenom, price), Fee: sdk.NewInt64Coin(feeDenoms[0], fee)}, nil
 		}
 	}
@@ -384,7 +432,7 @@ func TestBuildSettlement(t *testing.T) {
 		name                 string
 		askOrders            []*Order
 		bidOrders            []*Order
-		sellerFeeRatioLookup func(denom string) (*FeeRatio, error)
+		sellerFeeRatioLookup func(denom string, isTaker bool) (*FeeRatio, error)
 		expSettlement        *Settlement
 		expErr               string
 	}{
@@ -399,16 +447,28 @@ func TestBuildSettlement(t *testing.T) {
 			name:      "error from ratio lookup",
 			askOrders: []*Order{askOrder(3, 1, 10, false)},
 			bidOrders: []*Order{bidOrder(4, 1, 10, false)},
-			sellerFeeRatioLookup: func(denom string) (*FeeRatio, error) {
+			sellerFeeRatioLookup: func(denom string, isTaker bool) (*FeeRatio, error) {
 				return nil, errors.New("this is a test error")
 			},
 			expErr: "this is a test error",
 		},
+		{
+			name:      "error from taker ratio lookup",
+			askOrders: []*Order{askOrder(3, 1, 10, false)},
+			bidOrders: []*Order{bidOrder(4, 1, 10, false)},
+			sellerFeeRatioLookup: func(denom string, isTaker bool) (*FeeRatio, error) {
+				if isTaker {
+					return nil, errors.New("this is a taker test error")
+				}
+				return nil, nil
+			},
+			expErr: "this is a taker test error",
+		},
 		{
 			name:      "error from setFeesToPay",
 			askOrders: []*Order{askOrder(3, 1, 10, false)},
 			bidOrders: []*Order{bidOrder(4, 1, 10, false)},
-			sellerFeeRatioLookup: func(denom string) (*FeeRatio, error) {
+			sellerFeeRatioLookup: func(denom string, isTaker bool) (*FeeRatio, error) {
 				return &FeeRatio{Price: sdk.NewInt64Coin("prune", 10), Fee: sdk.NewInt64Coin("fig", 1)}, nil
 			},
 			expErr: "failed calculate ratio fee for ask order 3: cannot apply ratio 10prune:1fig to price 10peach: incorrect price denom",
@@ -990,7 +1050,7 @@ func TestBuildSettlement(t *testing.T) {
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			if tc.sellerFeeRatioLookup == nil {
-				tc.sellerFeeRatioLookup = func(denom string) (*FeeRatio, error) {
+				tc.sellerFeeRatioLookup = func(denom string, isTaker bool) (*FeeRatio, error) {
 					return nil, nil
 				}
 			}
@@ -2635,6 +2695,67 @@ func TestOrderFulfillment_GetExternalID(t *testing.T) {
 	}
 }
 
+func TestOrderFulfillment_GetReferrer(t *testing.T) {
+	askOrder := func(referrer string) orderFulfillment {
+		return orderFulfillment{
+			Order: NewOrder(999).WithAsk(&AskOrder{Referrer: referrer}),
+		}
+	}
+	bidOrder := func(referrer string) orderFulfillment {
+		return orderFulfillment{
+			Order: NewOrder(999).WithBid(&BidOrder{Referrer: referrer}),
+		}
+	}
+
+	tests := []struct {
+		name string
+		f    orderFulfillment
+		exp  string
+	}{
+		{name: "ask empty", f: askOrder(""), exp: ""},
+		{name: "ask something", f: askOrder("something"), exp: "something"},
+		{name: "bid empty", f: bidOrder(""), exp: ""},
+		{name: "bid something", f: bidOrder("SOMETHING"), exp: "SOMETHING"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual string
+			testFunc := func() {
+				actual = tc.f.GetReferrer()
+			}
+			require.NotPanics(t, testFunc, "GetReferrer()")
+			assert.Equal(t, tc.exp, actual, "GetReferrer() result")
+		})
+	}
+}
+
+func TestOrderFulfillment_GetBasketAssets(t *testing.T) {
+	basket := sdk.NewCoins(sdk.NewInt64Coin("banana", 2), sdk.NewInt64Coin("cherry", 3))
+
+	tests := []struct {
+		name string
+		f    orderFulfillment
+		exp  sdk.Coins
+	}{
+		{name: "ask without basket", f: orderFulfillment{Order: NewOrder(1).WithAsk(&AskOrder{})}, exp: nil},
+		{name: "ask with basket", f: orderFulfillment{Order: NewOrder(2).WithAsk(&AskOrder{BasketAssets: basket})}, exp: basket},
+		{name: "bid without basket", f: orderFulfillment{Order: NewOrder(3).WithBid(&BidOrder{})}, exp: nil},
+		{name: "bid with basket", f: orderFulfillment{Order: NewOrder(4).WithBid(&BidOrder{BasketAssets: basket})}, exp: basket},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual sdk.Coins
+			testFunc := func() {
+				actual = tc.f.GetBasketAssets()
+			}
+			require.NotPanics(t, testFunc, "GetBasketAssets()")
+			assert.Equal(t, tc.exp, actual, "GetBasketAssets() result")
+		})
+	}
+}
+
 func TestOrderFulfillment_GetOrderType(t *testing.T) {
 	tests := []struct {
 		name string
@@ -3678,6 +3799,17 @@ func TestValidateCanSettle(t *testing.T) {
 			Price:  price,
 		})
 	}
+	withBasket := func(order *Order, basket string) *Order {
+		coins, err := sdk.ParseCoinsNormalized(basket)
+		require.NoError(t, err, "ParseCoinsNormalized(%q)", basket)
+		switch {
+		case order.IsAskOrder():
+			order.GetAskOrder().BasketAssets = coins
+		case order.IsBidOrder():
+			order.GetBidOrder().BasketAssets = coins
+		}
+		return order
+	}
 
 	tests := []struct {
 		name      string
@@ -3876,6 +4008,57 @@ func TestValidateCanSettle(t *testing.T) {
 				"cannot settle different ask \"33peach\" and bid \"77plum\" price denoms",
 			),
 		},
+		{
+			name:      "basket ask with matching basket bid",
+			askOrders: []*Order{withBasket(askOrder(15, coin(10, "apple"), coin(11, "peach")), "2banana,3cherry")},
+			bidOrders: []*Order{withBasket(bidOrder(16, coin(10, "apple"), coin(11, "peach")), "2banana,3cherry")},
+			expErr:    "",
+		},
+		{
+			name: "basket ask with two bids",
+			askOrders: []*Order{
+				withBasket(askOrder(15, coin(20, "apple"), coin(22, "peach")), "2banana"),
+			},
+			bidOrders: []*Order{
+				bidOrder(16, coin(10, "apple"), coin(11, "peach")),
+				bidOrder(17, coin(10, "apple"), coin(11, "peach")),
+			},
+			expErr: "cannot settle basket orders with 1 asks and 2 bids: " +
+				"a basket order can only be settled with exactly one other order",
+		},
+		{
+			name: "basket bid with two asks",
+			askOrders: []*Order{
+				askOrder(15, coin(10, "apple"), coin(11, "peach")),
+				askOrder(16, coin(10, "apple"), coin(11, "peach")),
+			},
+			bidOrders: []*Order{
+				withBasket(bidOrder(17, coin(20, "apple"), coin(22, "peach")), "2banana"),
+			},
+			expErr: "cannot settle basket orders with 2 asks and 1 bids: " +
+				"a basket order can only be settled with exactly one other order",
+		},
+		{
+			name:      "basket ask with non-basket bid",
+			askOrders: []*Order{withBasket(askOrder(15, coin(10, "apple"), coin(11, "peach")), "2banana")},
+			bidOrders: []*Order{bidOrder(16, coin(10, "apple"), coin(11, "peach"))},
+			expErr: "cannot settle ask order 15 having assets \"10apple\" and basket \"2banana\" " +
+				"with bid order 16 having assets \"10apple\" and basket \"\": assets and basket must be the same",
+		},
+		{
+			name:      "basket orders with different baskets",
+			askOrders: []*Order{withBasket(askOrder(15, coin(10, "apple"), coin(11, "peach")), "2banana")},
+			bidOrders: []*Order{withBasket(bidOrder(16, coin(10, "apple"), coin(11, "peach")), "3banana")},
+			expErr: "cannot settle ask order 15 having assets \"10apple\" and basket \"2banana\" " +
+				"with bid order 16 having assets \"10apple\" and basket \"3banana\": assets and basket must be the same",
+		},
+		{
+			name:      "basket orders with different asset amounts",
+			askOrders: []*Order{withBasket(askOrder(15, coin(10, "apple"), coin(11, "peach")), "2banana")},
+			bidOrders: []*Order{withBasket(bidOrder(16, coin(9, "apple"), coin(11, "peach")), "2banana")},
+			expErr: "cannot settle ask order 15 having assets \"10apple\" and basket \"2banana\" " +
+				"with bid order 16 having assets \"9apple\" and basket \"2banana\": assets and basket must be the same",
+		},
 	}
 
 	for _, tc := range tests {
@@ -5093,13 +5276,14 @@ func TestSetFeesToPay(t *testing.T) {
 	}
 
 	tests := []struct {
-		name      string
-		askOFs    []*orderFulfillment
-		bidOFs    []*orderFulfillment
-		ratio     *FeeRatio
-		expAskOFs []*orderFulfillment
-		expBidOFs []*orderFulfillment
-		expErr    string
+		name       string
+		askOFs     []*orderFulfillment
+		bidOFs     []*orderFulfillment
+		makerRatio *FeeRatio
+		takerRatio *FeeRatio
+		expAskOFs  []*orderFulfillment
+		expBidOFs  []*orderFulfillment
+		expErr     string
 	}{
 		{
 			name: "cannot apply ratio",
@@ -5113,7 +5297,7 @@ func TestSetFeesToPay(t *testing.T) {
 				bidOF(2222, 200, coin(20, "grape")),
 				bidOF(3333, 300),
 			},
-			ratio: &FeeRatio{Price: coin(30, "peach"), Fee: coin(1, "fig")},
+			takerRatio: &FeeRatio{Price: coin(30, "peach"), Fee: coin(1, "fig")},
 			expAskOFs: []*orderFulfillment{
 				expOF(askOF(7777, 55, coin(20, "grape"))),
 				expOF(askOF(5555, 71)),
@@ -5142,7 +5326,7 @@ func TestSetFeesToPay(t *testing.T) {
 				bidOF(2222, 200, coin(20, "grape")),
 				bidOF(3333, 300),
 			},
-			ratio: nil,
+			takerRatio: nil,
 			expAskOFs: []*orderFulfillment{
 				expOF(askOF(7777, 55, coin(20, "grape")), coin(20, "grape")),
 				expOF(askOF(5555, 71)),
@@ -5166,7 +5350,7 @@ func TestSetFeesToPay(t *testing.T) {
 				bidOF(2222, 200, coin(20, "grape")),
 				bidOF(3333, 300),
 			},
-			ratio: &FeeRatio{Price: coin(30, "plum"), Fee: coin(1, "fig")},
+			takerRatio: &FeeRatio{Price: coin(30, "plum"), Fee: coin(1, "fig")},
 			expAskOFs: []*orderFulfillment{
 				expOF(askOF(7777, 55, coin(20, "grape")), coin(2, "fig"), coin(20, "grape")),
 				expOF(askOF(5555, 71), coin(3, "fig")),
@@ -5178,13 +5362,54 @@ func TestSetFeesToPay(t *testing.T) {
 				expOF(bidOF(3333, 300)),
 			},
 		},
+		{
+			name: "makers and takers",
+			askOFs: []*orderFulfillment{
+				askOF(1000, 60, coin(20, "grape")),
+				askOF(3000, 90),
+				askOF(2000, 30),
+			},
+			bidOFs: []*orderFulfillment{
+				bidOF(2500, 100),
+				bidOF(1500, 80),
+			},
+			makerRatio: &FeeRatio{Price: coin(30, "plum"), Fee: coin(1, "plum")},
+			takerRatio: &FeeRatio{Price: coin(30, "plum"), Fee: coin(2, "plum")},
+			expAskOFs: []*orderFulfillment{
+				expOF(askOF(1000, 60, coin(20, "grape")), coin(2, "plum"), coin(20, "grape")),
+				expOF(askOF(3000, 90), coin(6, "plum")),
+				expOF(askOF(2000, 30), coin(1, "plum")),
+			},
+			expBidOFs: []*orderFulfillment{
+				expOF(bidOF(2500, 100)),
+				expOF(bidOF(1500, 80)),
+			},
+		},
+		{
+			name: "takers without a taker ratio",
+			askOFs: []*orderFulfillment{
+				askOF(1000, 60),
+				askOF(3000, 90),
+			},
+			bidOFs: []*orderFulfillment{
+				bidOF(2000, 150),
+			},
+			makerRatio: &FeeRatio{Price: coin(30, "plum"), Fee: coin(1, "plum")},
+			expAskOFs: []*orderFulfillment{
+				expOF(askOF(1000, 60), coin(2, "plum")),
+				expOF(askOF(3000, 90)),
+			},
+			expBidOFs: []*orderFulfillment{
+				expOF(bidOF(2000, 150)),
+			},
+		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			var err error
 			testFunc := func() {
-				err = setFeesToPay(tc.askOFs, tc.bidOFs, tc.ratio)
+				err = setFeesToPay(tc.askOFs, tc.bidOFs, tc.makerRatio, tc.takerRatio)
 			}
 			require.NotPanics(t, testFunc, "setFeesToPay")
 			assertions.AssertErrorValue(t, err, tc.expErr, "setFeesToPay error")
@@ -5844,9 +6069,22 @@ func TestGetAssetTransfer(t *testing.T) {
 			Price:    sdk.Coin{Denom: priceDenom, Amount: sdkmath.NewInt(999)},
 		})
 	}
+	withBasket := func(order *Order) *Order {
+		basket := sdk.NewCoins(sdk.NewInt64Coin("banana", 2), sdk.NewInt64Coin("cherry", 3))
+		switch {
+		case order.IsAskOrder():
+			order.GetAskOrder().BasketAssets = basket
+		case order.IsBidOrder():
+			order.GetBidOrder().BasketAssets = basket
+		}
+		return order
+	}
 	dist := func(addr string, amount int64) *distribution {
 		return &distribution{Address: addr, Amount: sdkmath.NewInt(amount)}
 	}
+	basketCoins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(assetDenom, amount), sdk.NewInt64Coin("banana", 2), sdk.NewInt64Coin("cherry", 3))
+	}
 	input := func(addr string, amount int64) banktypes.Input {
 		return banktypes.Input{
 			Address: addr,
@@ -6003,6 +6241,32 @@ func TestGetAssetTransfer(t *testing.T) {
 				Outputs: []banktypes.Output{output(buyer, 33)},
 			},
 		},
+		{
+			name: "basket: ask",
+			f:    newOF(withBasket(askOrder(7001)), 111, dist("bob", 111)),
+			expTransfer: &Transfer{
+				Inputs:  []banktypes.Input{{Address: seller, Coins: basketCoins(111)}},
+				Outputs: []banktypes.Output{{Address: "bob", Coins: basketCoins(111)}},
+			},
+		},
+		{
+			name: "basket: bid",
+			f:    newOF(withBasket(bidOrder(7002)), 111, dist("sam", 111)),
+			expTransfer: &Transfer{
+				Inputs:  []banktypes.Input{{Address: "sam", Coins: basketCoins(111)}},
+				Outputs: []banktypes.Output{{Address: buyer, Coins: basketCoins(111)}},
+			},
+		},
+		{
+			name:   "basket partially filled: ask",
+			f:      newOF(withBasket(askOrder(7003)), 100, dist("bob", 100)),
+			expErr: "ask order 7003 cannot have basket \"2banana,3cherry\" split up: basket orders must be filled in full by one order",
+		},
+		{
+			name:   "basket with two dists: bid",
+			f:      newOF(withBasket(bidOrder(7004)), 111, dist("sam", 100), dist("sue", 11)),
+			expErr: "bid order 7004 cannot have basket \"2banana,3cherry\" split up: basket orders must be filled in full by one order",
+		},
 	}
 
 	for _, tc := range tests {
@@ -6233,6 +6497,73 @@ func TestGetPriceTransfer(t *testing.T) {
 	}
 }
 
+func TestSettlement_GetPartialOrders(t *testing.T) {
+	askOrder := func(orderID uint64) *FilledOrder {
+		return NewFilledOrder(NewOrder(orderID).WithAsk(&AskOrder{}), sdk.NewInt64Coin("accordion", 12), nil)
+	}
+	leftOrder := func(orderID uint64) *Order {
+		return NewOrder(orderID).WithAsk(&AskOrder{})
+	}
+
+	tests := []struct {
+		name       string
+		settlement *Settlement
+		expected   []*PartialOrder
+	}{
+		{
+			name:       "nil settlement",
+			settlement: nil,
+			expected:   nil,
+		},
+		{
+			name:       "no partial orders",
+			settlement: &Settlement{FullyFilledOrders: []*FilledOrder{askOrder(1)}},
+			expected:   nil,
+		},
+		{
+			name:       "only the partial order",
+			settlement: &Settlement{PartialOrderFilled: askOrder(2), PartialOrderLeft: leftOrder(2)},
+			expected:   []*PartialOrder{{Filled: askOrder(2), Left: leftOrder(2)}},
+		},
+		{
+			name: "only other partial orders",
+			settlement: &Settlement{
+				OtherPartialOrders: []*PartialOrder{
+					{Filled: askOrder(3), Left: leftOrder(3)},
+					{Filled: askOrder(4), Left: leftOrder(4)},
+				},
+			},
+			expected: []*PartialOrder{
+				{Filled: askOrder(3), Left: leftOrder(3)},
+				{Filled: askOrder(4), Left: leftOrder(4)},
+			},
+		},
+		{
+			name: "both",
+			settlement: &Settlement{
+				PartialOrderFilled: askOrder(5),
+				PartialOrderLeft:   leftOrder(5),
+				OtherPartialOrders: []*PartialOrder{{Filled: askOrder(3), Left: leftOrder(3)}},
+			},
+			expected: []*PartialOrder{
+				{Filled: askOrder(5), Left: leftOrder(5)},
+				{Filled: askOrder(3), Left: leftOrder(3)},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual []*PartialOrder
+			testFunc := func() {
+				actual = tc.settlement.GetPartialOrders()
+			}
+			require.NotPanics(t, testFunc, "GetPartialOrders")
+			assert.Equal(t, tc.expected, actual, "GetPartialOrders result")
+		})
+	}
+}
+
 func TestFilterOrders(t *testing.T) {
 	askOrder := func(orderID uint64) *FilledOrder {
 		return NewFilledOrder(NewOrder(orderID).WithAsk(&AskOrder{}), sdk.NewInt64Coin("accordion", 12), nil)
@@ -6376,6 +6707,16 @@ func TestFilterOrders(t *testing.T) {
 			checker:   evens,
 			expOrders: []OrderI{askOrder(2), bidOrder(4), bidOrder(6)},
 		},
+		{
+			name: "other partial orders, get asks",
+			settlement: &Settlement{
+				FullyFilledOrders:  []*FilledOrder{bidOrder(1), askOrder(2)},
+				PartialOrderFilled: bidOrder(3),
+				OtherPartialOrders: []*PartialOrder{{Filled: askOrder(4)}, {Filled: askOrder(5)}},
+			},
+			checker:   OrderI.IsAskOrder,
+			expOrders: []OrderI{askOrder(2), askOrder(4), askOrder(5)},
+		},
 	}
 
 	for _, tc := range tests {
@@ -6404,6 +6745,14 @@ func TestGetNAVs(t *testing.T) {
 		order := NewOrder(orderID).WithBid(&BidOrder{Assets: coin(assets), Price: coin(price)})
 		return NewFilledOrder(order, order.GetPrice(), nil)
 	}
+	basketBidOrder := func(orderID uint64, assets, price string) *FilledOrder {
+		order := NewOrder(orderID).WithBid(&BidOrder{
+			Assets:       coin(assets),
+			Price:        coin(price),
+			BasketAssets: sdk.NewCoins(sdk.NewInt64Coin("banana", 3)),
+		})
+		return NewFilledOrder(order, order.GetPrice(), nil)
+	}
 	nav := func(assets, price string) NetAssetPrice {
 		return NetAssetPrice{Assets: coin(assets), Price: coin(price)}
 	}
@@ -6418,6 +6767,13 @@ func TestGetNAVs(t *testing.T) {
 			settlement: &Settlement{},
 			expNAVs:    nil,
 		},
+		{
+			name: "basket bid",
+			settlement: &Settlement{
+				FullyFilledOrders: []*FilledOrder{askOrder(1, "10apple", "20plum"), basketBidOrder(2, "10apple", "20plum")},
+			},
+			expNAVs: nil,
+		},
 		{
 			name: "full ask, no partial",
 			settlement: &Settlement{
@@ -40,6 +40,7 @@ func (g GenesisState) Validate() error {
 
 	maxOrderID := uint64(0)
 	orderIDs := make(map[uint64]int, len(g.Orders))
+	orderMarketIDs := make(map[uint64]uint32, len(g.Orders))
 	for i, order := range g.Orders {
 		if order.OrderId != 0 {
 			j, seen := orderIDs[order.OrderId]
@@ -60,11 +61,70 @@ func (g GenesisState) Validate() error {
 			errs = append(errs, fmt.Errorf("invalid order[%d]: unknown market id %d", i, order.GetMarketID()))
 		}
 
+		orderMarketIDs[order.OrderId] = order.GetMarketID()
+
 		if order.OrderId > maxOrderID {
 			maxOrderID = order.OrderId
 		}
 	}
 
+	triggerOrderIDs := make(map[uint64]int, len(g.TriggerOrders))
+	for i, triggerOrder := range g.TriggerOrders {
+		orderID := triggerOrder.GetOrderID()
+		if orderID != 0 {
+			if j, seen := orderIDs[orderID]; seen {
+				errs = append(errs, fmt.Errorf("invalid trigger order[%d]: order id %d already used by order[%d]", i, orderID, j))
+				continue
+			}
+			if j, seen := triggerOrderIDs[orderID]; seen {
+				errs = append(errs, fmt.Errorf("invalid trigger order[%d]: duplicate order id %d seen at [%d]", i, orderID, j))
+				continue
+			}
+			triggerOrderIDs[orderID] = i
+		}
+
+		if err := triggerOrder.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid trigger order[%d]: %w", i, err))
+			continue
+		}
+
+		_, knownMarket := marketIDs[triggerOrder.GetMarketID()]
+		if !knownMarket {
+			errs = append(errs, fmt.Errorf("invalid trigger order[%d]: unknown market id %d", i, triggerOrder.GetMarketID()))
+		}
+
+		orderMarketIDs[orderID] = triggerOrder.GetMarketID()
+
+		if orderID > maxOrderID {
+			maxOrderID = orderID
+		}
+	}
+
+	linkedOrderIDs := make(map[uint64]int, 2*len(g.OrderLinks))
+	for i, link := range g.OrderLinks {
+		if err := link.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid order link[%d]: %w", i, err))
+			continue
+		}
+
+		for _, orderID := range []uint64{link.OrderId, link.LinkedOrderId} {
+			marketID, known := orderMarketIDs[orderID]
+			switch {
+			case !known:
+				errs = append(errs, fmt.Errorf("invalid order link[%d]: unknown order id %d", i, orderID))
+			case marketID != link.MarketId:
+				errs = append(errs, fmt.Errorf("invalid order link[%d]: order %d is in market %d, not %d",
+					i, orderID, marketID, link.MarketId))
+			}
+
+			if j, seen := linkedOrderIDs[orderID]; seen {
+				errs = append(errs, fmt.Errorf("invalid order link[%d]: order id %d already linked at [%d]", i, orderID, j))
+			} else {
+				linkedOrderIDs[orderID] = i
+			}
+		}
+	}
+
 	if g.LastOrderId < maxOrderID {
 		errs = append(errs, fmt.Errorf("last order id %d is less than the largest id in the provided orders %d",
 			g.LastOrderId, maxOrderID))
@@ -80,6 +140,38 @@ func (g GenesisState) Validate() error {
 		}
 	}
 
+	rewardPoolMarketIDs := make(map[uint32]int)
+	for i, pool := range g.RewardPools {
+		if _, known := marketIDs[pool.MarketId]; !known {
+			errs = append(errs, fmt.Errorf("invalid reward pool[%d]: unknown market id %d", i, pool.MarketId))
+			continue
+		}
+		if j, seen := rewardPoolMarketIDs[pool.MarketId]; seen {
+			errs = append(errs, fmt.Errorf("invalid reward pool[%d]: duplicate market id %d seen at [%d]", i, pool.MarketId, j))
+			continue
+		}
+		rewardPoolMarketIDs[pool.MarketId] = i
+		if err := pool.Amount.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid reward pool[%d]: invalid amount %q: %w", i, pool.Amount, err))
+		}
+	}
+
+	for i, reward := range g.CommitmentRewards {
+		if err := reward.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid commitment reward[%d]: %w", i, err))
+		} else if _, known := marketIDs[reward.MarketId]; !known {
+			errs = append(errs, fmt.Errorf("invalid commitment reward[%d]: unknown market id %d", i, reward.MarketId))
+		}
+	}
+
+	for i, accrual := range g.CommitmentAccruals {
+		if err := accrual.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid commitment accrual[%d]: %w", i, err))
+		} else if _, known := marketIDs[accrual.MarketId]; !known {
+			errs = append(errs, fmt.Errorf("invalid commitment accrual[%d]: unknown market id %d", i, accrual.MarketId))
+		}
+	}
+
 	paymentIDs := make(map[string]int)
 	for i, payment := range g.Payments {
 		id := payment.Source + " " + payment.ExternalId
@@ -95,5 +187,203 @@ func (g GenesisState) Validate() error {
 		}
 	}
 
+	recurringPaymentIDs := make(map[string]int)
+	for i, rp := range g.RecurringPayments {
+		id := rp.Source + " " + rp.ExternalId
+		if j, seen := recurringPaymentIDs[id]; seen {
+			errs = append(errs, fmt.Errorf("invalid recurring payment[%d]: duplicate recurring payment, source %s and external id %q seen at [%d]",
+				i, rp.Source, rp.ExternalId, j))
+			continue
+		}
+		recurringPaymentIDs[id] = i
+
+		if err := rp.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid recurring payment[%d]: %w", i, err))
+		}
+	}
+
+	escrowedPaymentIDs := make(map[string]int)
+	for i, ep := range g.EscrowedPayments {
+		id := ep.Payment.Source + " " + ep.Payment.ExternalId
+		if j, seen := escrowedPaymentIDs[id]; seen {
+			errs = append(errs, fmt.Errorf("invalid escrowed payment[%d]: duplicate escrowed payment, source %s and external id %q seen at [%d]",
+				i, ep.Payment.Source, ep.Payment.ExternalId, j))
+			continue
+		}
+		escrowedPaymentIDs[id] = i
+		if j, seen := paymentIDs[id]; seen {
+			errs = append(errs, fmt.Errorf("invalid escrowed payment[%d]: source %s and external id %q also used by payment[%d]",
+				i, ep.Payment.Source, ep.Payment.ExternalId, j))
+			continue
+		}
+
+		if err := ep.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid escrowed payment[%d]: %w", i, err))
+		}
+	}
+
+	multiPartyPaymentIDs := make(map[string]int)
+	for i, mpp := range g.MultiPartyPayments {
+		id := mpp.Creator + " " + mpp.ExternalId
+		if j, seen := multiPartyPaymentIDs[id]; seen {
+			errs = append(errs, fmt.Errorf("invalid multi-party payment[%d]: duplicate multi-party payment, creator %s and external id %q seen at [%d]",
+				i, mpp.Creator, mpp.ExternalId, j))
+			continue
+		}
+		multiPartyPaymentIDs[id] = i
+
+		if err := mpp.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid multi-party payment[%d]: %w", i, err))
+			continue
+		}
+		if mpp.AllAccepted() {
+			errs = append(errs, fmt.Errorf("invalid multi-party payment[%d]: all parties have already accepted", i))
+		}
+	}
+
+	settlementPriceIDs := make(map[string]int)
+	for i, price := range g.SettlementPrices {
+		if err := price.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid settlement price[%d]: %w", i, err))
+			continue
+		}
+		if _, known := marketIDs[price.MarketId]; !known {
+			errs = append(errs, fmt.Errorf("invalid settlement price[%d]: unknown market id %d", i, price.MarketId))
+			continue
+		}
+
+		id := fmt.Sprintf("%d %s %s %d", price.MarketId, price.Assets.Denom, price.Price.Denom, price.Time.Unix())
+		if j, seen := settlementPriceIDs[id]; seen {
+			errs = append(errs, fmt.Errorf("invalid settlement price[%d]: duplicate of [%d]", i, j))
+			continue
+		}
+		settlementPriceIDs[id] = i
+	}
+
+	settlementRecordIDs := make(map[string]int)
+	for i, record := range g.SettlementRecords {
+		if err := record.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid settlement record[%d]: %w", i, err))
+			continue
+		}
+		if _, known := marketIDs[record.MarketId]; !known {
+			errs = append(errs, fmt.Errorf("invalid settlement record[%d]: unknown market id %d", i, record.MarketId))
+			continue
+		}
+
+		id := fmt.Sprintf("%d %d %d", record.MarketId, record.Height, record.Sequence)
+		if j, seen := settlementRecordIDs[id]; seen {
+			errs = append(errs, fmt.Errorf("invalid settlement record[%d]: duplicate of [%d]", i, j))
+			continue
+		}
+		settlementRecordIDs[id] = i
+	}
+
+	navRecordIDs := make(map[string]int)
+	for i, record := range g.NavRecords {
+		if err := record.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid nav record[%d]: %w", i, err))
+			continue
+		}
+		if _, known := marketIDs[record.MarketId]; !known {
+			errs = append(errs, fmt.Errorf("invalid nav record[%d]: unknown market id %d", i, record.MarketId))
+			continue
+		}
+
+		id := fmt.Sprintf("%s %s %d %d", record.Assets.Denom, record.Price.Denom, record.Height, record.MarketId)
+		if j, seen := navRecordIDs[id]; seen {
+			errs = append(errs, fmt.Errorf("invalid nav record[%d]: duplicate of [%d]", i, j))
+			continue
+		}
+		navRecordIDs[id] = i
+	}
+
+	for i, change := range g.ScheduledFeeChanges {
+		if err := change.ValidateBasic(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid scheduled fee change[%d]: %w", i, err))
+			continue
+		}
+		if !change.IsScheduled() {
+			errs = append(errs, fmt.Errorf("invalid scheduled fee change[%d]: no effective height or time", i))
+			continue
+		}
+		if _, known := marketIDs[change.MarketId]; !known {
+			errs = append(errs, fmt.Errorf("invalid scheduled fee change[%d]: unknown market id %d", i, change.MarketId))
+		}
+	}
+
+	transferMarketIDs := make(map[uint32]int)
+	for i, transfer := range g.AuthorityTransfers {
+		if err := transfer.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid authority transfer[%d]: %w", i, err))
+			continue
+		}
+		if _, known := marketIDs[transfer.MarketId]; !known {
+			errs = append(errs, fmt.Errorf("invalid authority transfer[%d]: unknown market id %d", i, transfer.MarketId))
+			continue
+		}
+		if j, seen := transferMarketIDs[transfer.MarketId]; seen {
+			errs = append(errs, fmt.Errorf("invalid authority transfer[%d]: duplicate market id %d seen at [%d]", i, transfer.MarketId, j))
+			continue
+		}
+		transferMarketIDs[transfer.MarketId] = i
+	}
+
+	idempotencyKeyIDs := make(map[string]int)
+	for i, record := range g.IdempotencyKeys {
+		if err := record.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid idempotency key[%d]: %w", i, err))
+			continue
+		}
+		if record.OrderId > g.LastOrderId {
+			errs = append(errs, fmt.Errorf("invalid idempotency key[%d]: order id %d is greater than last order id %d",
+				i, record.OrderId, g.LastOrderId))
+			continue
+		}
+
+		id := record.Account + " " + record.IdempotencyKey
+		if j, seen := idempotencyKeyIDs[id]; seen {
+			errs = append(errs, fmt.Errorf("invalid idempotency key[%d]: duplicate of [%d]", i, j))
+			continue
+		}
+		idempotencyKeyIDs[id] = i
+	}
+
+	settlementReceiptIDs := make(map[uint64]int)
+	for i, receipt := range g.SettlementReceipts {
+		if err := receipt.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid settlement receipt[%d]: %w", i, err))
+			continue
+		}
+		if _, known := marketIDs[receipt.MarketId]; !known {
+			errs = append(errs, fmt.Errorf("invalid settlement receipt[%d]: unknown market id %d", i, receipt.MarketId))
+			continue
+		}
+		if receipt.SettlementId > g.LastSettlementId {
+			errs = append(errs, fmt.Errorf("invalid settlement receipt[%d]: settlement id %d is greater than last settlement id %d",
+				i, receipt.SettlementId, g.LastSettlementId))
+			continue
+		}
+		if j, seen := settlementReceiptIDs[receipt.SettlementId]; seen {
+			errs = append(errs, fmt.Errorf("invalid settlement receipt[%d]: duplicate settlement id %d seen at [%d]",
+				i, receipt.SettlementId, j))
+			continue
+		}
+		settlementReceiptIDs[receipt.SettlementId] = i
+	}
+
+	deadManSwitchAccounts := make(map[string]int)
+	for i, dms := range g.DeadManSwitches {
+		if err := dms.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid dead-man switch[%d]: %w", i, err))
+			continue
+		}
+		if j, seen := deadManSwitchAccounts[dms.Account]; seen {
+			errs = append(errs, fmt.Errorf("invalid dead-man switch[%d]: duplicate account %s seen at [%d]", i, dms.Account, j))
+			continue
+		}
+		deadManSwitchAccounts[dms.Account] = i
+	}
+
 	return errors.Join(errs...)
 }
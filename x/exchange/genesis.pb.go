@@ -39,6 +39,40 @@ type GenesisState struct {
 	Commitments []Commitment `protobuf:"bytes,6,rep,name=commitments,proto3" json:"commitments"`
 	// payments are all the payments to create at genesis.
 	Payments []Payment `protobuf:"bytes,7,rep,name=payments,proto3" json:"payments"`
+	// trigger_orders are all the pending trigger orders to create at genesis.
+	TriggerOrders []TriggerOrder `protobuf:"bytes,8,rep,name=trigger_orders,json=triggerOrders,proto3" json:"trigger_orders"`
+	// order_links are all the one-cancels-other order links to create at genesis.
+	OrderLinks []OrderLink `protobuf:"bytes,9,rep,name=order_links,json=orderLinks,proto3" json:"order_links"`
+	// settlement_prices are the recent settlement prices to record at genesis.
+	SettlementPrices []SettlementPrice `protobuf:"bytes,10,rep,name=settlement_prices,json=settlementPrices,proto3" json:"settlement_prices"`
+	// settlement_records are the recent settlement records to store at genesis.
+	SettlementRecords []SettlementRecord `protobuf:"bytes,11,rep,name=settlement_records,json=settlementRecords,proto3" json:"settlement_records"`
+	// reward_pools are the undistributed commitment reward funds of each market.
+	RewardPools []MarketAmount `protobuf:"bytes,12,rep,name=reward_pools,json=rewardPools,proto3" json:"reward_pools"`
+	// commitment_rewards are the distributed commitment rewards that have not yet been claimed.
+	CommitmentRewards []CommitmentReward `protobuf:"bytes,13,rep,name=commitment_rewards,json=commitmentRewards,proto3" json:"commitment_rewards"`
+	// scheduled_fee_changes are the market fee changes that are waiting to be applied.
+	ScheduledFeeChanges []MsgGovManageFeesRequest `protobuf:"bytes,14,rep,name=scheduled_fee_changes,json=scheduledFeeChanges,proto3" json:"scheduled_fee_changes"`
+	// recurring_payments are all the recurring payments to create at genesis.
+	RecurringPayments []RecurringPayment `protobuf:"bytes,15,rep,name=recurring_payments,json=recurringPayments,proto3" json:"recurring_payments"`
+	// multi_party_payments are all the multi-party payments to create at genesis.
+	MultiPartyPayments []MultiPartyPayment `protobuf:"bytes,16,rep,name=multi_party_payments,json=multiPartyPayments,proto3" json:"multi_party_payments"`
+	// escrowed_payments are all the accepted payments with funds held in escrow to create at genesis.
+	EscrowedPayments []EscrowedPayment `protobuf:"bytes,17,rep,name=escrowed_payments,json=escrowedPayments,proto3" json:"escrowed_payments"`
+	// nav_records are the recent NAV records to store at genesis.
+	NavRecords []NAVRecord `protobuf:"bytes,18,rep,name=nav_records,json=navRecords,proto3" json:"nav_records"`
+	// authority_transfers are the market authority transfers waiting to be accepted.
+	AuthorityTransfers []MarketAuthorityTransfer `protobuf:"bytes,19,rep,name=authority_transfers,json=authorityTransfers,proto3" json:"authority_transfers"`
+	// idempotency_keys are the recently used order creation idempotency keys.
+	IdempotencyKeys []IdempotencyKeyRecord `protobuf:"bytes,20,rep,name=idempotency_keys,json=idempotencyKeys,proto3" json:"idempotency_keys"`
+	// last_settlement_id is the value of the last settlement id assigned.
+	LastSettlementId uint64 `protobuf:"varint,21,opt,name=last_settlement_id,json=lastSettlementId,proto3" json:"last_settlement_id,omitempty"`
+	// settlement_receipts are the recent settlement receipts to store at genesis.
+	SettlementReceipts []SettlementReceipt `protobuf:"bytes,22,rep,name=settlement_receipts,json=settlementReceipts,proto3" json:"settlement_receipts"`
+	// dead_man_switches are the registered dead-man switches.
+	DeadManSwitches []DeadManSwitch `protobuf:"bytes,23,rep,name=dead_man_switches,json=deadManSwitches,proto3" json:"dead_man_switches"`
+	// commitment_accruals are the time-weighted commitment amounts used for commitment reward distributions.
+	CommitmentAccruals []CommitmentAccrual `protobuf:"bytes,24,rep,name=commitment_accruals,json=commitmentAccruals,proto3" json:"commitment_accruals"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -83,32 +117,62 @@ func init() {
 }
 
 var fileDescriptor_087ceebafabf03c9 = []byte{
-	// 387 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x29, 0x28, 0xca, 0x2f,
-	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0x4f, 0xad, 0x48, 0xce, 0x48, 0xcc, 0x4b, 0x4f, 0xd5,
-	0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x43, 0xa8, 0xd2, 0x83, 0xa9, 0xd2, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf,
-	0x07, 0x2b, 0xd1, 0x07, 0xb1, 0x20, 0xaa, 0xa5, 0x34, 0x70, 0x98, 0x99, 0x9c, 0x9f, 0x9b, 0x9b,
-	0x59, 0x92, 0x9b, 0x9a, 0x57, 0x02, 0x35, 0x57, 0x4a, 0x19, 0x87, 0xca, 0xdc, 0xc4, 0xa2, 0xec,
-	0xd4, 0x12, 0x02, 0x8a, 0xf2, 0x8b, 0x52, 0x52, 0x8b, 0x08, 0x99, 0x54, 0x90, 0x58, 0x94, 0x98,
-	0x0b, 0x53, 0xa4, 0x8a, 0x53, 0x51, 0x25, 0x92, 0xab, 0x94, 0x96, 0x31, 0x73, 0xf1, 0xb8, 0x43,
-	0xfc, 0x1f, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x64, 0xc6, 0xc5, 0x06, 0x31, 0x47, 0x82, 0x51, 0x81,
-	0x51, 0x83, 0xdb, 0x48, 0x4e, 0x0f, 0x7b, 0x78, 0xe8, 0x05, 0x80, 0x55, 0x05, 0x41, 0x55, 0x0b,
-	0xd9, 0x71, 0xb1, 0x43, 0x7c, 0x52, 0x2c, 0xc1, 0xa4, 0xc0, 0x8c, 0x4f, 0xa3, 0x2f, 0x58, 0x99,
-	0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x30, 0x4d, 0x42, 0xd6, 0x5c, 0x6c, 0x10, 0x4f, 0x4a,
-	0x30, 0x83, 0xb5, 0xcb, 0xe2, 0xd2, 0xee, 0x0f, 0x52, 0x05, 0xd5, 0x0d, 0xd5, 0x22, 0xa4, 0xc2,
-	0xc5, 0x97, 0x93, 0x58, 0x5c, 0x12, 0x0f, 0x31, 0x2c, 0x3e, 0x33, 0x45, 0x82, 0x45, 0x81, 0x51,
-	0x83, 0x37, 0x88, 0x07, 0x24, 0x0a, 0xb1, 0xcf, 0x33, 0x45, 0x48, 0x89, 0x8b, 0x17, 0xac, 0x0a,
-	0xac, 0x09, 0xa4, 0x88, 0x55, 0x81, 0x51, 0x83, 0x25, 0x88, 0x1b, 0x24, 0x08, 0x36, 0xd5, 0x33,
-	0x45, 0xc8, 0x8b, 0x8b, 0x1b, 0x29, 0xea, 0x24, 0xd8, 0xc0, 0x6e, 0x51, 0xc2, 0xe5, 0x16, 0x67,
-	0xb8, 0x52, 0xa8, 0x83, 0x90, 0x35, 0x0b, 0x39, 0x72, 0x71, 0xc0, 0x42, 0x5b, 0x82, 0x1d, 0x6c,
-	0x90, 0x3c, 0xee, 0xc0, 0xac, 0x44, 0x32, 0x05, 0xae, 0xcd, 0x8a, 0xa3, 0x63, 0x81, 0x3c, 0xc3,
-	0x8b, 0x05, 0xf2, 0x0c, 0x4e, 0xa9, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0,
-	0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0xc0,
-	0x25, 0x99, 0x99, 0x8f, 0xc3, 0xd8, 0x00, 0xc6, 0x28, 0xbd, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24,
-	0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0x84, 0x22, 0xdd, 0xcc, 0x7c, 0x24, 0x9e, 0x7e, 0x05, 0x3c, 0x85,
-	0x24, 0xb1, 0x81, 0x93, 0x85, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xc4, 0x2c, 0xee, 0x93, 0x2c,
-	0x03, 0x00, 0x00,
+	// 869 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x56, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0xb6, 0x69, 0x48, 0xc3, 0x38, 0x3f, 0xf6, 0x24, 0x2d, 0x43, 0x24, 0xec, 0x10, 0x52, 0x61,
+	0xa4, 0x62, 0xab, 0x20, 0x71, 0x01, 0x12, 0x52, 0x5a, 0x68, 0x09, 0x10, 0x30, 0x4e, 0x05, 0x52,
+	0x25, 0xb4, 0x4c, 0x77, 0x4f, 0xd6, 0xa3, 0x78, 0x77, 0xcc, 0x9c, 0xb1, 0x13, 0xbf, 0x01, 0x97,
+	0xf0, 0x06, 0x7d, 0x9c, 0x5e, 0xe6, 0x92, 0x2b, 0x84, 0x92, 0x1b, 0x1e, 0x03, 0xcd, 0xcc, 0xae,
+	0x77, 0xd7, 0x62, 0xd7, 0x77, 0xf6, 0x39, 0xdf, 0xf7, 0x9d, 0xff, 0xd5, 0x90, 0xa3, 0x89, 0x92,
+	0x33, 0x88, 0x79, 0xec, 0x43, 0x1f, 0xae, 0xfc, 0x11, 0x8f, 0x43, 0xe8, 0xcf, 0x1e, 0xf5, 0x43,
+	0x88, 0x01, 0x05, 0xf6, 0x26, 0x4a, 0x6a, 0x49, 0xef, 0x67, 0xa8, 0x5e, 0x8a, 0xea, 0xcd, 0x1e,
+	0xed, 0xef, 0x85, 0x32, 0x94, 0x16, 0xd2, 0x37, 0xbf, 0x1c, 0x7a, 0xbf, 0x5b, 0xa2, 0xe9, 0xcb,
+	0x28, 0x12, 0x3a, 0x82, 0x58, 0x27, 0xba, 0xfb, 0xef, 0x97, 0x20, 0x23, 0xae, 0x2e, 0x40, 0xaf,
+	0x00, 0x49, 0x15, 0x80, 0x5a, 0xa5, 0x34, 0xe1, 0x8a, 0x47, 0x29, 0xe8, 0x41, 0x29, 0x68, 0x9e,
+	0xcf, 0xaa, 0x53, 0x02, 0xd3, 0x57, 0x0e, 0x70, 0xf8, 0xe7, 0x0e, 0xd9, 0x7c, 0xe6, 0x1a, 0x74,
+	0xa6, 0xb9, 0x06, 0xfa, 0x29, 0x59, 0x77, 0x81, 0x58, 0xfd, 0xa0, 0xde, 0x6d, 0x7c, 0xdc, 0xee,
+	0xfd, 0x7f, 0xc3, 0x7a, 0x03, 0x8b, 0x1a, 0x26, 0x68, 0xfa, 0x05, 0xb9, 0xeb, 0x4a, 0x45, 0xf6,
+	0xc6, 0xc1, 0x9d, 0x2a, 0xe2, 0xa9, 0x85, 0x3d, 0x5e, 0x7b, 0xfd, 0x77, 0xa7, 0x36, 0x4c, 0x49,
+	0xf4, 0x73, 0xb2, 0xee, 0xba, 0xc0, 0xee, 0x58, 0xfa, 0xbb, 0x65, 0xf4, 0x1f, 0x0c, 0x2a, 0x61,
+	0x27, 0x14, 0x7a, 0x44, 0xb6, 0xc7, 0x1c, 0xb5, 0xe7, 0xc4, 0x3c, 0x11, 0xb0, 0xb5, 0x83, 0x7a,
+	0x77, 0x6b, 0xb8, 0x69, 0xac, 0x2e, 0xde, 0x49, 0x40, 0x0f, 0xc9, 0x96, 0x45, 0x59, 0x92, 0x01,
+	0xbd, 0x79, 0x50, 0xef, 0xae, 0x0d, 0x1b, 0xc6, 0x68, 0x55, 0x4f, 0x02, 0xfa, 0x0d, 0x69, 0xe4,
+	0x66, 0xcb, 0xd6, 0x6d, 0x2e, 0x87, 0x65, 0xb9, 0x3c, 0x59, 0x40, 0x93, 0x84, 0xf2, 0x64, 0x7a,
+	0x4c, 0x36, 0xd2, 0x71, 0xb0, 0xbb, 0x56, 0xa8, 0x53, 0xde, 0xcc, 0x79, 0x4e, 0x65, 0x41, 0xa3,
+	0x3f, 0x92, 0x6d, 0xad, 0x44, 0x18, 0x82, 0xf2, 0x92, 0xee, 0x6c, 0x58, 0xa1, 0xa3, 0x32, 0xa1,
+	0xe7, 0x0e, 0x9d, 0x6f, 0xd2, 0x96, 0xce, 0xd9, 0x90, 0x7e, 0x4d, 0x1a, 0xae, 0x01, 0x63, 0x11,
+	0x5f, 0x20, 0x7b, 0xcb, 0xea, 0xbd, 0x57, 0xd9, 0xed, 0xef, 0x44, 0x7c, 0x91, 0x88, 0x11, 0x99,
+	0x1a, 0x90, 0xbe, 0x20, 0x2d, 0x04, 0xad, 0xc7, 0x60, 0x72, 0xf5, 0x26, 0x4a, 0xf8, 0x80, 0x8c,
+	0x58, 0xbd, 0x0f, 0xca, 0xf4, 0xce, 0x16, 0x84, 0x81, 0xc1, 0x27, 0xaa, 0x4d, 0x2c, 0x9a, 0x91,
+	0xfe, 0x42, 0x68, 0x4e, 0x5b, 0x81, 0x2f, 0x55, 0x80, 0xac, 0x61, 0xc5, 0xbb, 0xab, 0xc5, 0x87,
+	0x96, 0x90, 0xa8, 0xb7, 0x70, 0xc9, 0x8e, 0xf4, 0x94, 0x6c, 0x2a, 0xb8, 0xe4, 0x2a, 0xf0, 0x26,
+	0x52, 0x8e, 0x91, 0x6d, 0x56, 0x77, 0xd5, 0xad, 0xd0, 0x71, 0x24, 0xa7, 0xd9, 0xa4, 0x1d, 0x7f,
+	0x60, 0xe8, 0x26, 0xdb, 0x6c, 0xf0, 0x9e, 0xf3, 0x20, 0xdb, 0xaa, 0xce, 0x36, 0x5b, 0x9e, 0xa1,
+	0x25, 0xa4, 0xd9, 0xfa, 0x4b, 0x76, 0xa4, 0x82, 0xdc, 0x43, 0x7f, 0x04, 0xc1, 0x74, 0x0c, 0x81,
+	0x77, 0x0e, 0xe0, 0x39, 0x11, 0x64, 0xdb, 0x36, 0x42, 0xbf, 0x34, 0x6d, 0x0c, 0x9f, 0xc9, 0xd9,
+	0x29, 0x8f, 0x79, 0x08, 0x4f, 0x01, 0x70, 0x08, 0xbf, 0x4d, 0x01, 0xd3, 0x0a, 0x76, 0x17, 0x9a,
+	0x4f, 0x01, 0x9e, 0x38, 0x45, 0x53, 0x89, 0x02, 0x7f, 0xaa, 0x94, 0x88, 0x43, 0x6f, 0xb1, 0xbd,
+	0x3b, 0xd5, 0x95, 0x0c, 0x53, 0x46, 0x71, 0x8d, 0x5b, 0x6a, 0xc9, 0x8e, 0x94, 0x93, 0xbd, 0x68,
+	0x3a, 0xd6, 0xc2, 0x9b, 0x70, 0xa5, 0xe7, 0x59, 0x80, 0xa6, 0x0d, 0xf0, 0x61, 0x69, 0x21, 0x86,
+	0x33, 0x30, 0x94, 0x62, 0x04, 0x1a, 0x2d, 0x3b, 0xec, 0x56, 0x02, 0xfa, 0x4a, 0x5e, 0x42, 0x90,
+	0xe9, 0xb7, 0xaa, 0xb7, 0xf2, 0xab, 0x84, 0x50, 0x54, 0x6f, 0x42, 0xd1, 0x6c, 0x6f, 0x27, 0xe6,
+	0xb3, 0xc5, 0x3a, 0xd2, 0xea, 0xdb, 0xf9, 0xfe, 0xf8, 0xa7, 0xc2, 0x1e, 0x92, 0x98, 0xcf, 0xd2,
+	0x05, 0x3c, 0x27, 0xbb, 0x7c, 0xaa, 0x47, 0x52, 0x09, 0x3d, 0xf7, 0xb4, 0xe2, 0x31, 0x9e, 0x9b,
+	0xeb, 0xde, 0x5d, 0x31, 0x50, 0xb7, 0x87, 0x29, 0xf1, 0x79, 0xc2, 0x4b, 0xbb, 0xc1, 0x97, 0x1d,
+	0x66, 0x9e, 0x4d, 0x11, 0x40, 0x34, 0x91, 0x1a, 0x62, 0x7f, 0xee, 0x5d, 0xc0, 0x1c, 0xd9, 0x9e,
+	0x0d, 0xf2, 0xb0, 0x2c, 0xc8, 0x49, 0x86, 0xff, 0x16, 0xe6, 0x85, 0x0a, 0x76, 0x44, 0xc1, 0x87,
+	0xf4, 0x21, 0xa1, 0xf6, 0x93, 0x9a, 0xbb, 0x55, 0x11, 0xb0, 0x7b, 0xf6, 0xbb, 0xda, 0x34, 0x9e,
+	0xec, 0x24, 0x4f, 0x02, 0xfa, 0x2b, 0xd9, 0x2d, 0x1e, 0x35, 0x88, 0x89, 0x46, 0x76, 0xbf, 0x7a,
+	0xf8, 0x85, 0xab, 0x36, 0x8c, 0xb4, 0x5c, 0x5c, 0x76, 0x20, 0xfd, 0x99, 0xb4, 0x02, 0xe0, 0x81,
+	0x17, 0xf1, 0xd8, 0xc3, 0x4b, 0xa1, 0xfd, 0x11, 0x20, 0x7b, 0xdb, 0xea, 0x3f, 0x28, 0xd3, 0xff,
+	0x12, 0x78, 0x70, 0xca, 0xe3, 0x33, 0x0b, 0x4f, 0x0b, 0x0d, 0xf2, 0x46, 0x40, 0x93, 0x7a, 0xee,
+	0xc2, 0xb9, 0xef, 0xab, 0x29, 0x1f, 0x23, 0x63, 0xd5, 0xa9, 0x67, 0x27, 0x7e, 0xec, 0x18, 0x69,
+	0xea, 0xfe, 0xb2, 0x03, 0x3f, 0xdb, 0xf8, 0xfd, 0x55, 0xa7, 0xf6, 0xef, 0xab, 0x4e, 0xed, 0x31,
+	0xbc, 0xbe, 0x69, 0xd7, 0xaf, 0x6f, 0xda, 0xf5, 0x7f, 0x6e, 0xda, 0xf5, 0x3f, 0x6e, 0xdb, 0xb5,
+	0xeb, 0xdb, 0x76, 0xed, 0xaf, 0xdb, 0x76, 0x8d, 0xbc, 0x23, 0x64, 0x49, 0xa8, 0x41, 0xfd, 0x45,
+	0x2f, 0x14, 0x7a, 0x34, 0x7d, 0xd9, 0xf3, 0x65, 0xd4, 0xcf, 0x40, 0x1f, 0x09, 0x99, 0xfb, 0xd7,
+	0xbf, 0x5a, 0x3c, 0x03, 0x5e, 0xae, 0xdb, 0x17, 0xc0, 0x27, 0xff, 0x0d, 0x00, 0x6f, 0xb7, 0xb2,
+	0xc8, 0x38, 0x09, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -131,6 +195,253 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CommitmentAccruals) > 0 {
+		for iNdEx := len(m.CommitmentAccruals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommitmentAccruals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.DeadManSwitches) > 0 {
+		for iNdEx := len(m.DeadManSwitches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeadManSwitches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.SettlementReceipts) > 0 {
+		for iNdEx := len(m.SettlementReceipts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SettlementReceipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if m.LastSettlementId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSettlementId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if len(m.IdempotencyKeys) > 0 {
+		for iNdEx := len(m.IdempotencyKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IdempotencyKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.AuthorityTransfers) > 0 {
+		for iNdEx := len(m.AuthorityTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuthorityTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.NavRecords) > 0 {
+		for iNdEx := len(m.NavRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NavRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.EscrowedPayments) > 0 {
+		for iNdEx := len(m.EscrowedPayments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EscrowedPayments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.MultiPartyPayments) > 0 {
+		for iNdEx := len(m.MultiPartyPayments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MultiPartyPayments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.RecurringPayments) > 0 {
+		for iNdEx := len(m.RecurringPayments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecurringPayments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.ScheduledFeeChanges) > 0 {
+		for iNdEx := len(m.ScheduledFeeChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledFeeChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.CommitmentRewards) > 0 {
+		for iNdEx := len(m.CommitmentRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommitmentRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.RewardPools) > 0 {
+		for iNdEx := len(m.RewardPools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.SettlementRecords) > 0 {
+		for iNdEx := len(m.SettlementRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SettlementRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.SettlementPrices) > 0 {
+		for iNdEx := len(m.SettlementPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SettlementPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.OrderLinks) > 0 {
+		for iNdEx := len(m.OrderLinks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrderLinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.TriggerOrders) > 0 {
+		for iNdEx := len(m.TriggerOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TriggerOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.Payments) > 0 {
 		for iNdEx := len(m.Payments) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -263,6 +574,105 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.TriggerOrders) > 0 {
+		for _, e := range m.TriggerOrders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OrderLinks) > 0 {
+		for _, e := range m.OrderLinks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SettlementPrices) > 0 {
+		for _, e := range m.SettlementPrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SettlementRecords) > 0 {
+		for _, e := range m.SettlementRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardPools) > 0 {
+		for _, e := range m.RewardPools {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CommitmentRewards) > 0 {
+		for _, e := range m.CommitmentRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScheduledFeeChanges) > 0 {
+		for _, e := range m.ScheduledFeeChanges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RecurringPayments) > 0 {
+		for _, e := range m.RecurringPayments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MultiPartyPayments) > 0 {
+		for _, e := range m.MultiPartyPayments {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EscrowedPayments) > 0 {
+		for _, e := range m.EscrowedPayments {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NavRecords) > 0 {
+		for _, e := range m.NavRecords {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AuthorityTransfers) > 0 {
+		for _, e := range m.AuthorityTransfers {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IdempotencyKeys) > 0 {
+		for _, e := range m.IdempotencyKeys {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastSettlementId != 0 {
+		n += 2 + sovGenesis(uint64(m.LastSettlementId))
+	}
+	if len(m.SettlementReceipts) > 0 {
+		for _, e := range m.SettlementReceipts {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DeadManSwitches) > 0 {
+		for _, e := range m.DeadManSwitches {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CommitmentAccruals) > 0 {
+		for _, e := range m.CommitmentAccruals {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -511,6 +921,569 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TriggerOrders = append(m.TriggerOrders, TriggerOrder{})
+			if err := m.TriggerOrders[len(m.TriggerOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderLinks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderLinks = append(m.OrderLinks, OrderLink{})
+			if err := m.OrderLinks[len(m.OrderLinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettlementPrices = append(m.SettlementPrices, SettlementPrice{})
+			if err := m.SettlementPrices[len(m.SettlementPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettlementRecords = append(m.SettlementRecords, SettlementRecord{})
+			if err := m.SettlementRecords[len(m.SettlementRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPools = append(m.RewardPools, MarketAmount{})
+			if err := m.RewardPools[len(m.RewardPools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitmentRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitmentRewards = append(m.CommitmentRewards, CommitmentReward{})
+			if err := m.CommitmentRewards[len(m.CommitmentRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledFeeChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledFeeChanges = append(m.ScheduledFeeChanges, MsgGovManageFeesRequest{})
+			if err := m.ScheduledFeeChanges[len(m.ScheduledFeeChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecurringPayments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecurringPayments = append(m.RecurringPayments, RecurringPayment{})
+			if err := m.RecurringPayments[len(m.RecurringPayments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiPartyPayments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MultiPartyPayments = append(m.MultiPartyPayments, MultiPartyPayment{})
+			if err := m.MultiPartyPayments[len(m.MultiPartyPayments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowedPayments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowedPayments = append(m.EscrowedPayments, EscrowedPayment{})
+			if err := m.EscrowedPayments[len(m.EscrowedPayments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NavRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NavRecords = append(m.NavRecords, NAVRecord{})
+			if err := m.NavRecords[len(m.NavRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorityTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthorityTransfers = append(m.AuthorityTransfers, MarketAuthorityTransfer{})
+			if err := m.AuthorityTransfers[len(m.AuthorityTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdempotencyKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdempotencyKeys = append(m.IdempotencyKeys, IdempotencyKeyRecord{})
+			if err := m.IdempotencyKeys[len(m.IdempotencyKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSettlementId", wireType)
+			}
+			m.LastSettlementId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSettlementId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementReceipts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettlementReceipts = append(m.SettlementReceipts, SettlementReceipt{})
+			if err := m.SettlementReceipts[len(m.SettlementReceipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadManSwitches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeadManSwitches = append(m.DeadManSwitches, DeadManSwitch{})
+			if err := m.DeadManSwitches[len(m.DeadManSwitches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitmentAccruals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitmentAccruals = append(m.CommitmentAccruals, CommitmentAccrual{})
+			if err := m.CommitmentAccruals[len(m.CommitmentAccruals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
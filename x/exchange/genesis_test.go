@@ -2,6 +2,7 @@ package exchange
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -21,6 +22,10 @@ func TestDefaultGenesisState(t *testing.T) {
 			DenomSplits:          nil,
 			FeeCreatePaymentFlat: []sdk.Coin{{Denom: "nhash", Amount: sdkmath.NewInt(DefaultFeeCreatePaymentFlatAmount)}},
 			FeeAcceptPaymentFlat: []sdk.Coin{{Denom: "nhash", Amount: sdkmath.NewInt(DefaultFeeAcceptPaymentFlatAmount)}},
+
+			SettlementHistoryBlocks: DefaultSettlementHistoryBlocks,
+			NavHistoryBlocks:        DefaultNAVHistoryBlocks,
+			NavHistoryMaxRecords:    DefaultNAVHistoryMaxRecords,
 		},
 		Markets:      nil,
 		Orders:       nil,
@@ -42,6 +47,7 @@ func TestGenesisState_Validate(t *testing.T) {
 	addr2 := sdk.AccAddress("addr2_______________").String()
 	addr3 := sdk.AccAddress("addr3_______________").String()
 	addr4 := sdk.AccAddress("addr4_______________").String()
+	effTime := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
 	coin := func(amount int64, denom string) sdk.Coin {
 		return sdk.Coin{Denom: denom, Amount: sdkmath.NewInt(amount)}
 	}
@@ -69,6 +75,64 @@ func TestGenesisState_Validate(t *testing.T) {
 			Price:    priceCoin,
 		})
 	}
+	settlementPrice := func(marketID uint32, unixSecs int64, assets, price string) SettlementPrice {
+		assetsCoin, err := sdk.ParseCoinNormalized(assets)
+		require.NoError(t, err, "settlement assets sdk.ParseCoinNormalized(%q)", assets)
+		priceCoin, err := sdk.ParseCoinNormalized(price)
+		require.NoError(t, err, "settlement price sdk.ParseCoinNormalized(%q)", price)
+		return SettlementPrice{
+			MarketId: marketID,
+			Time:     time.Unix(unixSecs, 0).UTC(),
+			Assets:   assetsCoin,
+			Price:    priceCoin,
+		}
+	}
+	navRecord := func(marketID uint32, height int64, assets, price string) NAVRecord {
+		assetsCoin, err := sdk.ParseCoinNormalized(assets)
+		require.NoError(t, err, "nav record assets sdk.ParseCoinNormalized(%q)", assets)
+		priceCoin, err := sdk.ParseCoinNormalized(price)
+		require.NoError(t, err, "nav record price sdk.ParseCoinNormalized(%q)", price)
+		return NAVRecord{
+			Assets:   assetsCoin,
+			Price:    priceCoin,
+			Height:   height,
+			MarketId: marketID,
+		}
+	}
+	settlementRecord := func(marketID uint32, height int64, sequence uint32, assets string) SettlementRecord {
+		assetsCoin, err := sdk.ParseCoinNormalized(assets)
+		require.NoError(t, err, "settlement record assets sdk.ParseCoinNormalized(%q)", assets)
+		return SettlementRecord{
+			MarketId: marketID,
+			Height:   height,
+			Sequence: sequence,
+			Time:     time.Unix(1_700_000_000, 0).UTC(),
+			Fills: []SettlementFill{{
+				OrderId:   1,
+				OrderType: OrderTypeAsk,
+				Owner:     addr1,
+				Assets:    assetsCoin,
+				Price:     sdk.NewInt64Coin("plum", 7),
+			}},
+		}
+	}
+	settlementReceipt := func(settlementID uint64, marketID uint32, height int64, assets string) SettlementReceipt {
+		assetsCoin, err := sdk.ParseCoinNormalized(assets)
+		require.NoError(t, err, "settlement receipt assets sdk.ParseCoinNormalized(%q)", assets)
+		return SettlementReceipt{
+			SettlementId: settlementID,
+			MarketId:     marketID,
+			Height:       height,
+			Time:         time.Unix(1_700_000_000, 0).UTC(),
+			Fills: []SettlementFill{{
+				OrderId:   1,
+				OrderType: OrderTypeAsk,
+				Owner:     addr1,
+				Assets:    assetsCoin,
+				Price:     sdk.NewInt64Coin("plum", 7),
+			}},
+		}
+	}
 	payment := func(source, sourceAmount, target, targetAmount, externalID string) Payment {
 		rv := Payment{
 			Source:     source,
@@ -86,6 +150,32 @@ func TestGenesisState_Validate(t *testing.T) {
 		}
 		return rv
 	}
+	escrowedPayment := func(payment Payment) EscrowedPayment {
+		payment.DisputeWindowSeconds = 3600
+		return EscrowedPayment{Payment: payment, ReleaseTime: time.Unix(1_700_003_600, 0).UTC()}
+	}
+	recurringPayment := func(source, target, externalID string) RecurringPayment {
+		return RecurringPayment{
+			Source:             source,
+			Target:             target,
+			Amount:             sdk.NewCoins(sdk.NewInt64Coin("plum", 5)),
+			ExternalId:         externalID,
+			IntervalSeconds:    86400,
+			TransfersRemaining: 3,
+			NextTransferTime:   time.Unix(1_700_000_000, 0).UTC(),
+		}
+	}
+	multiPartyPayment := func(creator, externalID string, accepted bool, others ...string) MultiPartyPayment {
+		rv := MultiPartyPayment{
+			Creator:    creator,
+			ExternalId: externalID,
+			Parties:    []PaymentParty{{Address: creator, SendAmount: sdk.NewCoins(sdk.NewInt64Coin("plum", 5)), Accepted: accepted}},
+		}
+		for _, other := range others {
+			rv.Parties = append(rv.Parties, PaymentParty{Address: other, ReceiveAmount: sdk.NewCoins(sdk.NewInt64Coin("plum", 5))})
+		}
+		return rv
+	}
 
 	tests := []struct {
 		name     string
@@ -339,6 +429,85 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			expErr: nil,
 		},
+		{
+			name: "trigger orders: all valid",
+			genState: GenesisState{
+				Markets: []Market{{MarketId: 1}},
+				Orders:  []Order{askOrder(1, 1, "28fry", "2bender")},
+				TriggerOrders: []TriggerOrder{
+					*NewTriggerOrder(askOrder(2, 1, "28fry", "2bender"), coin(3, "bender")),
+					*NewTriggerOrder(bidOrder(3, 1, "28fry", "2bender"), coin(1, "bender")),
+				},
+				LastOrderId: 3,
+			},
+			expErr: nil,
+		},
+		{
+			name: "trigger orders: all invalid",
+			genState: GenesisState{
+				Markets: []Market{{MarketId: 1}},
+				Orders:  []Order{askOrder(1, 1, "28fry", "2bender")},
+				TriggerOrders: []TriggerOrder{
+					*NewTriggerOrder(askOrder(1, 1, "28fry", "2bender"), coin(3, "bender")),
+					*NewTriggerOrder(bidOrder(2, 1, "28fry", "2bender"), coin(3, "fry")),
+					*NewTriggerOrder(bidOrder(3, 2, "28fry", "2bender"), coin(3, "bender")),
+					*NewTriggerOrder(askOrder(3, 1, "28fry", "2bender"), coin(3, "bender")),
+				},
+				LastOrderId: 2,
+			},
+			expErr: []string{
+				"invalid trigger order[0]: order id 1 already used by order[0]",
+				"invalid trigger order[1]: invalid trigger price \"3fry\": denom must match order price denom \"bender\"",
+				"invalid trigger order[2]: unknown market id 2",
+				"invalid trigger order[3]: duplicate order id 3 seen at [2]",
+				"last order id 2 is less than the largest id in the provided orders 3",
+			},
+		},
+		{
+			name: "order links: all valid",
+			genState: GenesisState{
+				Markets: []Market{{MarketId: 1}, {MarketId: 2}},
+				Orders: []Order{
+					askOrder(1, 1, "28fry", "2bender"),
+					askOrder(2, 1, "28fry", "2bender"),
+					bidOrder(3, 2, "28fry", "2bender"),
+				},
+				TriggerOrders: []TriggerOrder{
+					*NewTriggerOrder(bidOrder(4, 2, "28fry", "2bender"), coin(3, "bender")),
+				},
+				OrderLinks: []OrderLink{
+					{MarketId: 1, OrderId: 1, LinkedOrderId: 2},
+					{MarketId: 2, OrderId: 3, LinkedOrderId: 4},
+				},
+				LastOrderId: 4,
+			},
+			expErr: nil,
+		},
+		{
+			name: "order links: all invalid",
+			genState: GenesisState{
+				Markets: []Market{{MarketId: 1}, {MarketId: 2}},
+				Orders: []Order{
+					askOrder(1, 1, "28fry", "2bender"),
+					askOrder(2, 1, "28fry", "2bender"),
+					bidOrder(3, 2, "28fry", "2bender"),
+					bidOrder(4, 2, "28fry", "2bender"),
+				},
+				OrderLinks: []OrderLink{
+					{MarketId: 1, OrderId: 1, LinkedOrderId: 1},
+					{MarketId: 1, OrderId: 2, LinkedOrderId: 3},
+					{MarketId: 2, OrderId: 4, LinkedOrderId: 5},
+					{MarketId: 1, OrderId: 1, LinkedOrderId: 2},
+				},
+				LastOrderId: 4,
+			},
+			expErr: []string{
+				"invalid order link[0]: cannot link order 1 to itself",
+				"invalid order link[1]: order 3 is in market 2, not 1",
+				"invalid order link[2]: unknown order id 5",
+				"invalid order link[3]: order id 2 already linked at [1]",
+			},
+		},
 		{
 			name: "one commitment: bad account",
 			genState: GenesisState{
@@ -436,6 +605,395 @@ func TestGenesisState_Validate(t *testing.T) {
 				"invalid payment[2]: duplicate payment, source " + addr3 + " and external id \"there's two of me\" seen at [1]",
 			},
 		},
+		{
+			name: "recurring payments: all valid",
+			genState: GenesisState{
+				RecurringPayments: []RecurringPayment{
+					recurringPayment(addr1, addr2, "rp-one"),
+					recurringPayment(addr1, addr3, "rp-two"),
+					recurringPayment(addr2, addr3, "rp-one"),
+				},
+			},
+			expErr: nil,
+		},
+		{
+			name: "recurring payments: two invalid",
+			genState: GenesisState{
+				RecurringPayments: []RecurringPayment{
+					recurringPayment(addr1, addr2, "rp-one"),
+					recurringPayment(addr1, "", "rp-two"),
+					recurringPayment(addr1, addr3, "rp-one"),
+				},
+			},
+			expErr: []string{
+				"invalid recurring payment[1]: invalid target \"\": empty address string is not allowed",
+				"invalid recurring payment[2]: duplicate recurring payment, source " + addr1 + " and external id \"rp-one\" seen at [0]",
+			},
+		},
+		{
+			name: "escrowed payments: all valid",
+			genState: GenesisState{
+				Payments: []Payment{payment(addr1, "12strawberry", addr2, "", "ep-two")},
+				EscrowedPayments: []EscrowedPayment{
+					escrowedPayment(payment(addr1, "12strawberry", addr2, "", "ep-one")),
+					escrowedPayment(payment(addr1, "", addr3, "3tomato", "ep-three")),
+					escrowedPayment(payment(addr2, "12strawberry", addr3, "3tomato", "ep-one")),
+				},
+			},
+			expErr: nil,
+		},
+		{
+			name: "escrowed payments: four invalid",
+			genState: GenesisState{
+				Payments: []Payment{payment(addr1, "12strawberry", addr2, "", "ep-two")},
+				EscrowedPayments: []EscrowedPayment{
+					escrowedPayment(payment(addr1, "12strawberry", addr2, "", "ep-one")),
+					escrowedPayment(payment(addr1, "12strawberry", addr2, "", "ep-two")),
+					escrowedPayment(payment(addr1, "12strawberry", addr3, "", "ep-one")),
+					escrowedPayment(payment(addr2, "12strawberry", "", "", "ep-one")),
+					{Payment: payment(addr3, "12strawberry", addr2, "", "ep-one")},
+				},
+			},
+			expErr: []string{
+				"invalid escrowed payment[1]: source " + addr1 + " and external id \"ep-two\" also used by payment[0]",
+				"invalid escrowed payment[2]: duplicate escrowed payment, source " + addr1 + " and external id \"ep-one\" seen at [0]",
+				"invalid escrowed payment[3]: invalid target: cannot be empty",
+				"invalid escrowed payment[4]: invalid release time 0001-01-01T00:00:00Z: must be after 1970-01-01T00:00:00Z",
+			},
+		},
+		{
+			name: "multi-party payments: all valid",
+			genState: GenesisState{
+				MultiPartyPayments: []MultiPartyPayment{
+					multiPartyPayment(addr1, "mpp-one", true, addr2),
+					multiPartyPayment(addr1, "mpp-two", false, addr3),
+					multiPartyPayment(addr2, "mpp-one", true, addr3),
+				},
+			},
+			expErr: nil,
+		},
+		{
+			name: "multi-party payments: three invalid",
+			genState: GenesisState{
+				MultiPartyPayments: []MultiPartyPayment{
+					multiPartyPayment(addr1, "mpp-one", true, addr2),
+					multiPartyPayment(addr1, "mpp-two", true),
+					multiPartyPayment(addr1, "mpp-one", true, addr3),
+					func() MultiPartyPayment {
+						rv := multiPartyPayment(addr2, "mpp-three", true, addr3)
+						rv.Parties[1].Accepted = true
+						return rv
+					}(),
+				},
+			},
+			expErr: []string{
+				"invalid multi-party payment[1]: at least 2 parties are required, found 1",
+				"invalid multi-party payment[2]: duplicate multi-party payment, creator " + addr1 + " and external id \"mpp-one\" seen at [0]",
+				"invalid multi-party payment[3]: all parties have already accepted",
+			},
+		},
+		{
+			name: "settlement prices: all valid",
+			genState: GenesisState{
+				Markets: []Market{{MarketId: 1}, {MarketId: 2}},
+				SettlementPrices: []SettlementPrice{
+					settlementPrice(1, 1_700_000_000, "2apple", "7plum"),
+					settlementPrice(1, 1_700_000_001, "2apple", "7plum"),
+					settlementPrice(1, 1_700_000_000, "2apple", "7pear"),
+					settlementPrice(1, 1_700_000_000, "2banana", "7plum"),
+					settlementPrice(2, 1_700_000_000, "2apple", "7plum"),
+				},
+			},
+			expErr: nil,
+		},
+		{
+			name: "settlement prices: three invalid",
+			genState: GenesisState{
+				Markets: []Market{{MarketId: 1}},
+				SettlementPrices: []SettlementPrice{
+					settlementPrice(1, 1_700_000_000, "2apple", "7plum"),
+					settlementPrice(1, 1_700_000_000, "0apple", "7plum"),
+					settlementPrice(2, 1_700_000_000, "2apple", "7plum"),
+					settlementPrice(1, 1_700_000_000, "3apple", "8plum"),
+				},
+			},
+			expErr: []string{
+				"invalid settlement price[1]: invalid assets \"0apple\": amount must be positive",
+				"invalid settlement price[2]: unknown market id 2",
+				"invalid settlement price[3]: duplicate of [0]",
+			},
+		},
+		{
+			name: "settlement records: all valid",
+			genState: GenesisState{
+				Markets: []Market{{MarketId: 1}, {MarketId: 2}},
+				SettlementRecords: []SettlementRecord{
+					settlementRecord(1, 5, 0, "2apple"),
+					settlementRecord(1, 5, 1, "2apple"),
+					settlementRecord(1, 6, 0, "2apple"),
+					settlementRecord(2, 5, 0, "2apple"),
+				},
+			},
+			expErr: nil,
+		},
+		{
+			name: "settlement records: three invalid",
+			genState: GenesisState{
+				Markets: []Market{{MarketId: 1}},
+				SettlementRecords: []SettlementRecord{
+					settlementRecord(1, 5, 0, "2apple"),
+					settlementRecord(1, 0, 1, "2apple"),
+					settlementRecord(2, 5, 0, "2apple"),
+					settlementRecord(1, 5, 0, "3apple"),
+				},
+			},
+			expErr: []string{
+				"invalid settlement record[1]: invalid height 0: must be positive",
+				"invalid settlement record[2]: unknown market id 2",
+				"invalid settlement record[3]: duplicate of [0]",
+			},
+		},
+		{
+			name: "nav records: all valid",
+			genState: GenesisState{
+				Markets: []Market{{MarketId: 1}, {MarketId: 2}},
+				NavRecords: []NAVRecord{
+					navRecord(1, 5, "2apple", "10plum"),
+					navRecord(2, 5, "2apple", "10plum"),
+					navRecord(1, 6, "2apple", "10plum"),
+					navRecord(1, 5, "2apple", "10prune"),
+					navRecord(1, 5, "2acorn", "10plum"),
+				},
+			},
+			expErr: nil,
+		},
+		{
+			name: "nav records: three invalid",
+			genState: GenesisState{
+				Markets: []Market{{MarketId: 1}},
+				NavRecords: []NAVRecord{
+					navRecord(1, 5, "2apple", "10plum"),
+					navRecord(1, 5, "0apple", "10plum"),
+					navRecord(2, 5, "2apple", "10plum"),
+					navRecord(1, 5, "3apple", "12plum"),
+				},
+			},
+			expErr: []string{
+				"invalid nav record[1]: invalid assets \"0apple\": amount must be positive",
+				"invalid nav record[2]: unknown market id 2",
+				"invalid nav record[3]: duplicate of [0]",
+			},
+		},
+		{
+			name: "scheduled fee changes: all valid",
+			genState: GenesisState{
+				Markets: []Market{{MarketId: 1}, {MarketId: 2}},
+				ScheduledFeeChanges: []MsgGovManageFeesRequest{
+					{Authority: addr1, MarketId: 1, SetFeeCommitmentSettlementBips: 5, EffectiveHeight: 100},
+					{Authority: addr1, MarketId: 1, UnsetFeeCommitmentSettlementBips: true, EffectiveHeight: 50},
+					{Authority: addr1, MarketId: 2, SetFeeCommitmentSettlementBips: 7, EffectiveTime: &effTime},
+				},
+			},
+			expErr: nil,
+		},
+		{
+			name: "scheduled fee changes: three invalid",
+			genState: GenesisState{
+				Markets: []Market{{MarketId: 1}},
+				ScheduledFeeChanges: []MsgGovManageFeesRequest{
+					{Authority: addr1, MarketId: 1, SetFeeCommitmentSettlementBips: 5, EffectiveHeight: 100},
+					{Authority: addr1, MarketId: 1, EffectiveHeight: 100},
+					{Authority: addr1, MarketId: 1, SetFeeCommitmentSettlementBips: 5},
+					{Authority: addr1, MarketId: 2, SetFeeCommitmentSettlementBips: 5, EffectiveTime: &effTime},
+				},
+			},
+			expErr: []string{
+				"invalid scheduled fee change[1]: no updates",
+				"invalid scheduled fee change[2]: no effective height or time",
+				"invalid scheduled fee change[3]: unknown market id 2",
+			},
+		},
+		{
+			name: "authority transfers: all valid",
+			genState: GenesisState{
+				Markets: []Market{{MarketId: 1}, {MarketId: 2}},
+				AuthorityTransfers: []MarketAuthorityTransfer{
+					{MarketId: 1, ProposedBy: addr1, NewAuthority: addr1},
+					{
+						MarketId: 2, ProposedBy: addr1, NewAuthority: addr1,
+						AccessGrants: []AccessGrant{{Address: addr1, Permissions: AllPermissions()}},
+					},
+				},
+			},
+			expErr: nil,
+		},
+		{
+			name: "authority transfers: three invalid",
+			genState: GenesisState{
+				Markets: []Market{{MarketId: 1}},
+				AuthorityTransfers: []MarketAuthorityTransfer{
+					{MarketId: 1, ProposedBy: addr1, NewAuthority: addr1},
+					{MarketId: 1, ProposedBy: addr1, NewAuthority: "badaddr"},
+					{MarketId: 2, ProposedBy: addr1, NewAuthority: addr1},
+					{MarketId: 1, ProposedBy: addr1, NewAuthority: addr1},
+				},
+			},
+			expErr: []string{
+				"invalid authority transfer[1]: invalid new authority \"badaddr\"",
+				"invalid authority transfer[2]: unknown market id 2",
+				"invalid authority transfer[3]: duplicate market id 1 seen at [0]",
+			},
+		},
+		{
+			name: "idempotency keys: all valid",
+			genState: GenesisState{
+				LastOrderId: 3,
+				IdempotencyKeys: []IdempotencyKeyRecord{
+					{Account: addr1, IdempotencyKey: "key1", OrderId: 1, Height: 5},
+					{Account: addr1, IdempotencyKey: "key2", OrderId: 3, Height: 6},
+				},
+			},
+			expErr: nil,
+		},
+		{
+			name: "idempotency keys: three invalid",
+			genState: GenesisState{
+				LastOrderId: 3,
+				IdempotencyKeys: []IdempotencyKeyRecord{
+					{Account: addr1, IdempotencyKey: "key1", OrderId: 1, Height: 5},
+					{Account: "badaddr", IdempotencyKey: "key2", OrderId: 2, Height: 5},
+					{Account: addr1, IdempotencyKey: "key3", OrderId: 4, Height: 5},
+					{Account: addr1, IdempotencyKey: "key1", OrderId: 3, Height: 6},
+				},
+			},
+			expErr: []string{
+				"invalid idempotency key[1]: invalid account \"badaddr\"",
+				"invalid idempotency key[2]: order id 4 is greater than last order id 3",
+				"invalid idempotency key[3]: duplicate of [0]",
+			},
+		},
+		{
+			name: "settlement receipts: all valid",
+			genState: GenesisState{
+				Markets:          []Market{{MarketId: 1}, {MarketId: 2}},
+				LastSettlementId: 5,
+				SettlementReceipts: []SettlementReceipt{
+					settlementReceipt(1, 1, 5, "2apple"),
+					settlementReceipt(2, 2, 5, "2apple"),
+					settlementReceipt(5, 1, 6, "2apple"),
+				},
+			},
+			expErr: nil,
+		},
+		{
+			name: "settlement receipts: four invalid",
+			genState: GenesisState{
+				Markets:          []Market{{MarketId: 1}},
+				LastSettlementId: 5,
+				SettlementReceipts: []SettlementReceipt{
+					settlementReceipt(1, 1, 5, "2apple"),
+					settlementReceipt(2, 1, 0, "2apple"),
+					settlementReceipt(3, 2, 5, "2apple"),
+					settlementReceipt(6, 1, 7, "2apple"),
+					settlementReceipt(1, 1, 6, "3apple"),
+				},
+			},
+			expErr: []string{
+				"invalid settlement receipt[1]: invalid height 0: must be positive",
+				"invalid settlement receipt[2]: unknown market id 2",
+				"invalid settlement receipt[3]: settlement id 6 is greater than last settlement id 5",
+				"invalid settlement receipt[4]: duplicate settlement id 1 seen at [0]",
+			},
+		},
+		{
+			name: "dead-man switches: all valid",
+			genState: GenesisState{
+				DeadManSwitches: []DeadManSwitch{
+					{Account: addr1, IntervalSeconds: 30, Deadline: time.Unix(1_700_000_030, 0).UTC()},
+					{Account: addr2, IntervalSeconds: 60, Deadline: time.Unix(1_700_000_060, 0).UTC()},
+				},
+			},
+			expErr: nil,
+		},
+		{
+			name: "dead-man switches: three invalid",
+			genState: GenesisState{
+				DeadManSwitches: []DeadManSwitch{
+					{Account: addr1, IntervalSeconds: 30, Deadline: time.Unix(1_700_000_030, 0).UTC()},
+					{Account: "badaddr", IntervalSeconds: 30, Deadline: time.Unix(1_700_000_030, 0).UTC()},
+					{Account: addr2, IntervalSeconds: 0, Deadline: time.Unix(1_700_000_030, 0).UTC()},
+					{Account: addr1, IntervalSeconds: 60, Deadline: time.Unix(1_700_000_060, 0).UTC()},
+				},
+			},
+			expErr: []string{
+				"invalid dead-man switch[1]: invalid account \"badaddr\"",
+				"invalid dead-man switch[2]: invalid interval: cannot be zero",
+				"invalid dead-man switch[3]: duplicate account " + addr1 + " seen at [0]",
+			},
+		},
+		{
+			name: "reward pools and commitment rewards: all valid",
+			genState: GenesisState{
+				Markets: []Market{{MarketId: 1}, {MarketId: 2}},
+				RewardPools: []MarketAmount{
+					{MarketId: 1, Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 5))},
+					{MarketId: 2, Amount: sdk.NewCoins(sdk.NewInt64Coin("banana", 6))},
+				},
+				CommitmentRewards: []CommitmentReward{
+					{Account: addr1, MarketId: 1, Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 3))},
+					{Account: addr1, MarketId: 2, Amount: sdk.NewCoins(sdk.NewInt64Coin("banana", 4))},
+				},
+			},
+			expErr: nil,
+		},
+		{
+			name: "reward pools and commitment rewards: several invalid",
+			genState: GenesisState{
+				Markets: []Market{{MarketId: 1}},
+				RewardPools: []MarketAmount{
+					{MarketId: 1, Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 5))},
+					{MarketId: 2, Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 5))},
+					{MarketId: 1, Amount: sdk.NewCoins(sdk.NewInt64Coin("banana", 5))},
+				},
+				CommitmentRewards: []CommitmentReward{
+					{Account: addr1, MarketId: 1, Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 3))},
+					{Account: "notanaccountstring", MarketId: 1, Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 3))},
+					{Account: addr1, MarketId: 3, Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 3))},
+				},
+			},
+			expErr: []string{
+				"invalid reward pool[1]: unknown market id 2",
+				"invalid reward pool[2]: duplicate market id 1 seen at [0]",
+				`invalid commitment reward[1]: invalid account "notanaccountstring": decoding bech32 failed: invalid separator index -1`,
+				"invalid commitment reward[2]: unknown market id 3",
+			},
+		},
+		{
+			name: "commitment accruals: all valid",
+			genState: GenesisState{
+				Markets: []Market{{MarketId: 1}, {MarketId: 2}},
+				CommitmentAccruals: []CommitmentAccrual{
+					{Account: addr1, MarketId: 1, Accrued: sdk.NewCoins(sdk.NewInt64Coin("apple", 300)), LastAccrued: time.Unix(1_700_000_000, 0).UTC()},
+					{Account: addr1, MarketId: 2, LastAccrued: time.Unix(1_700_000_000, 0).UTC()},
+				},
+			},
+			expErr: nil,
+		},
+		{
+			name: "commitment accruals: several invalid",
+			genState: GenesisState{
+				Markets: []Market{{MarketId: 1}},
+				CommitmentAccruals: []CommitmentAccrual{
+					{Account: addr1, MarketId: 1, Accrued: sdk.NewCoins(sdk.NewInt64Coin("apple", 300))},
+					{Account: "notanaccountstring", MarketId: 1, Accrued: sdk.NewCoins(sdk.NewInt64Coin("apple", 300))},
+					{Account: addr1, MarketId: 3, Accrued: sdk.NewCoins(sdk.NewInt64Coin("apple", 300))},
+				},
+			},
+			expErr: []string{
+				`invalid commitment accrual[1]: invalid account "notanaccountstring": decoding bech32 failed: invalid separator index -1`,
+				"invalid commitment accrual[2]: unknown market id 3",
+			},
+		},
 	}
 
 	for _, tc := range tests {
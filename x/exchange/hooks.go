@@ -0,0 +1,76 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+)
+
+// ExchangeHooks defines the functions that other modules can use to react to the lifecycle of orders and commitments.
+// If a hook returns an error, the action that triggered it fails.
+type ExchangeHooks interface {
+	// OnOrderCreated is called after an order has been added to the order book (this includes activated trigger orders).
+	OnOrderCreated(ctx context.Context, order OrderI) error
+	// OnOrderFilled is called after an order has been settled. The order's GetPrice and GetSettlementFees
+	// methods indicate the amounts actually involved. isPartial is true if some of the order is still in the book.
+	OnOrderFilled(ctx context.Context, order *FilledOrder, isPartial bool) error
+	// OnOrderCancelled is called after an order has been removed from the order book without being filled.
+	// The cancelledBy string is empty if the order wasn't cancelled by an account
+	// (e.g. it expired, or was linked to another order that was filled or cancelled).
+	OnOrderCancelled(ctx context.Context, order OrderI, cancelledBy string) error
+	// OnCommitmentSettled is called after a market has settled committed funds.
+	OnCommitmentSettled(ctx context.Context, marketID uint32, inputs, outputs, fees []AccountAmount) error
+}
+
+var _ ExchangeHooks = MultiExchangeHooks{}
+
+// MultiExchangeHooks combines multiple ExchangeHooks. All hooks are called (in order) and their errors joined.
+type MultiExchangeHooks []ExchangeHooks
+
+// NewMultiExchangeHooks creates a new MultiExchangeHooks with the provided hooks.
+func NewMultiExchangeHooks(hooks ...ExchangeHooks) MultiExchangeHooks {
+	return hooks
+}
+
+// OnOrderCreated calls OnOrderCreated on each of the hooks.
+func (h MultiExchangeHooks) OnOrderCreated(ctx context.Context, order OrderI) error {
+	var errs []error
+	for _, hook := range h {
+		if err := hook.OnOrderCreated(ctx, order); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// OnOrderFilled calls OnOrderFilled on each of the hooks.
+func (h MultiExchangeHooks) OnOrderFilled(ctx context.Context, order *FilledOrder, isPartial bool) error {
+	var errs []error
+	for _, hook := range h {
+		if err := hook.OnOrderFilled(ctx, order, isPartial); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// OnOrderCancelled calls OnOrderCancelled on each of the hooks.
+func (h MultiExchangeHooks) OnOrderCancelled(ctx context.Context, order OrderI, cancelledBy string) error {
+	var errs []error
+	for _, hook := range h {
+		if err := hook.OnOrderCancelled(ctx, order, cancelledBy); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// OnCommitmentSettled calls OnCommitmentSettled on each of the hooks.
+func (h MultiExchangeHooks) OnCommitmentSettled(ctx context.Context, marketID uint32, inputs, outputs, fees []AccountAmount) error {
+	var errs []error
+	for _, hook := range h {
+		if err := hook.OnCommitmentSettled(ctx, marketID, inputs, outputs, fees); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
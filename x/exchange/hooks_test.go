@@ -0,0 +1,125 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+// recordingHooks is an ExchangeHooks that records the name of each hook called and returns an error if it has one.
+type recordingHooks struct {
+	name  string
+	calls *[]string
+	err   string
+}
+
+var _ ExchangeHooks = recordingHooks{}
+
+func (h recordingHooks) record(hook string) error {
+	*h.calls = append(*h.calls, h.name+"."+hook)
+	if len(h.err) > 0 {
+		return errors.New(h.err)
+	}
+	return nil
+}
+
+func (h recordingHooks) OnOrderCreated(_ context.Context, _ OrderI) error {
+	return h.record("OnOrderCreated")
+}
+
+func (h recordingHooks) OnOrderFilled(_ context.Context, _ *FilledOrder, _ bool) error {
+	return h.record("OnOrderFilled")
+}
+
+func (h recordingHooks) OnOrderCancelled(_ context.Context, _ OrderI, _ string) error {
+	return h.record("OnOrderCancelled")
+}
+
+func (h recordingHooks) OnCommitmentSettled(_ context.Context, _ uint32, _, _, _ []AccountAmount) error {
+	return h.record("OnCommitmentSettled")
+}
+
+func TestMultiExchangeHooks(t *testing.T) {
+	order := NewOrder(1).WithAsk(&AskOrder{MarketId: 1})
+	filled := NewFilledOrder(order, order.GetPrice(), nil)
+
+	hookCalls := []struct {
+		name string
+		call func(h MultiExchangeHooks) error
+	}{
+		{
+			name: "OnOrderCreated",
+			call: func(h MultiExchangeHooks) error { return h.OnOrderCreated(context.Background(), order) },
+		},
+		{
+			name: "OnOrderFilled",
+			call: func(h MultiExchangeHooks) error { return h.OnOrderFilled(context.Background(), filled, true) },
+		},
+		{
+			name: "OnOrderCancelled",
+			call: func(h MultiExchangeHooks) error { return h.OnOrderCancelled(context.Background(), order, "") },
+		},
+		{
+			name: "OnCommitmentSettled",
+			call: func(h MultiExchangeHooks) error { return h.OnCommitmentSettled(context.Background(), 1, nil, nil, nil) },
+		},
+	}
+
+	tests := []struct {
+		name     string
+		errs     []string
+		expCalls []string
+		expErr   string
+	}{
+		{
+			name: "no hooks",
+		},
+		{
+			name:     "one hook",
+			errs:     []string{""},
+			expCalls: []string{"h0"},
+		},
+		{
+			name:     "three hooks",
+			errs:     []string{"", "", ""},
+			expCalls: []string{"h0", "h1", "h2"},
+		},
+		{
+			name:     "three hooks, middle has error",
+			errs:     []string{"", "middle error", ""},
+			expCalls: []string{"h0", "h1", "h2"},
+			expErr:   "middle error",
+		},
+		{
+			name:     "three hooks, two have errors",
+			errs:     []string{"first error", "", "third error"},
+			expCalls: []string{"h0", "h1", "h2"},
+			expErr:   "first error\nthird error",
+		},
+	}
+
+	for _, hc := range hookCalls {
+		for _, tc := range tests {
+			t.Run(hc.name+": "+tc.name, func(t *testing.T) {
+				var calls []string
+				hooks := make([]ExchangeHooks, len(tc.errs))
+				for i, err := range tc.errs {
+					hooks[i] = recordingHooks{name: fmt.Sprintf("h%d", i), calls: &calls, err: err}
+				}
+				var expCalls []string
+				for _, name := range tc.expCalls {
+					expCalls = append(expCalls, name+"."+hc.name)
+				}
+
+				err := hc.call(NewMultiExchangeHooks(hooks...))
+				assertions.AssertErrorValue(t, err, tc.expErr, "%s error", hc.name)
+				assert.Equal(t, expCalls, calls, "hooks called by %s", hc.name)
+			})
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package exchange
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxIdempotencyKeyLength is the maximum length that an order creation idempotency key can have.
+	MaxIdempotencyKeyLength = 100
+	// IdempotencyKeyTTLBlocks is the number of blocks that an idempotency key is remembered after it's used.
+	IdempotencyKeyTTLBlocks = int64(100_000)
+	// MaxIdempotencyKeysPrunedPerBlock is the maximum number of expired idempotency keys that are deleted at the end of a block.
+	MaxIdempotencyKeysPrunedPerBlock = 1_000
+)
+
+// ValidateIdempotencyKey returns an error if the provided idempotency key is too long.
+// An empty idempotency key is okay.
+func ValidateIdempotencyKey(key string) error {
+	if len(key) > MaxIdempotencyKeyLength {
+		return fmt.Errorf("invalid idempotency key %q (length %d): max length %d",
+			key[:5]+"..."+key[len(key)-5:], len(key), MaxIdempotencyKeyLength)
+	}
+	return nil
+}
+
+// NewIdempotencyKeyRecord creates a new IdempotencyKeyRecord for an order created at the given height.
+func NewIdempotencyKeyRecord(account, key string, orderID uint64, height int64) *IdempotencyKeyRecord {
+	return &IdempotencyKeyRecord{
+		Account:        account,
+		IdempotencyKey: key,
+		OrderId:        orderID,
+		Height:         height,
+	}
+}
+
+// Validate returns an error if anything in this idempotency key record is invalid.
+func (r IdempotencyKeyRecord) Validate() error {
+	var errs []error
+	if _, err := sdk.AccAddressFromBech32(r.Account); err != nil {
+		errs = append(errs, fmt.Errorf("invalid account %q: %w", r.Account, err))
+	}
+	if len(r.IdempotencyKey) == 0 {
+		errs = append(errs, errors.New("invalid idempotency key: cannot be empty"))
+	} else if err := ValidateIdempotencyKey(r.IdempotencyKey); err != nil {
+		errs = append(errs, err)
+	}
+	if r.OrderId == 0 {
+		errs = append(errs, errors.New("invalid order id: cannot be zero"))
+	}
+	if r.Height <= 0 {
+		errs = append(errs, fmt.Errorf("invalid height %d: must be positive", r.Height))
+	}
+	return errors.Join(errs...)
+}
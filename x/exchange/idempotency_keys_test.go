@@ -0,0 +1,125 @@
+package exchange
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+func TestValidateIdempotencyKey(t *testing.T) {
+	tests := []struct {
+		name   string
+		key    string
+		expErr string
+	}{
+		{name: "empty", key: "", expErr: ""},
+		{name: "one char", key: "a", expErr: ""},
+		{name: "max length", key: strings.Repeat("b", MaxIdempotencyKeyLength), expErr: ""},
+		{
+			name: "too long",
+			key:  "start" + strings.Repeat("c", MaxIdempotencyKeyLength-7) + "end",
+			expErr: fmt.Sprintf("invalid idempotency key %q (length %d): max length %d",
+				"start...ccend", MaxIdempotencyKeyLength+1, MaxIdempotencyKeyLength),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = ValidateIdempotencyKey(tc.key)
+			}
+			assert.NotPanics(t, testFunc, "ValidateIdempotencyKey(%q)", tc.key)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ValidateIdempotencyKey(%q)", tc.key)
+		})
+	}
+}
+
+func TestNewIdempotencyKeyRecord(t *testing.T) {
+	account := sdk.AccAddress("account_____________").String()
+	exp := &IdempotencyKeyRecord{Account: account, IdempotencyKey: "retry-1", OrderId: 7, Height: 55}
+	var act *IdempotencyKeyRecord
+	testFunc := func() {
+		act = NewIdempotencyKeyRecord(account, "retry-1", 7, 55)
+	}
+	assert.NotPanics(t, testFunc, "NewIdempotencyKeyRecord")
+	assert.Equal(t, exp, act, "NewIdempotencyKeyRecord result")
+}
+
+func TestIdempotencyKeyRecord_Validate(t *testing.T) {
+	account := sdk.AccAddress("account_____________").String()
+
+	tests := []struct {
+		name   string
+		record IdempotencyKeyRecord
+		expErr string
+	}{
+		{
+			name:   "okay",
+			record: IdempotencyKeyRecord{Account: account, IdempotencyKey: "retry-1", OrderId: 7, Height: 55},
+		},
+		{
+			name:   "empty account",
+			record: IdempotencyKeyRecord{Account: "", IdempotencyKey: "retry-1", OrderId: 7, Height: 55},
+			expErr: "invalid account \"\": empty address string is not allowed",
+		},
+		{
+			name:   "bad account",
+			record: IdempotencyKeyRecord{Account: "badaddr", IdempotencyKey: "retry-1", OrderId: 7, Height: 55},
+			expErr: "invalid account \"badaddr\": decoding bech32 failed: invalid bech32 string length 7",
+		},
+		{
+			name:   "empty idempotency key",
+			record: IdempotencyKeyRecord{Account: account, IdempotencyKey: "", OrderId: 7, Height: 55},
+			expErr: "invalid idempotency key: cannot be empty",
+		},
+		{
+			name: "idempotency key too long",
+			record: IdempotencyKeyRecord{
+				Account: account, IdempotencyKey: strings.Repeat("k", MaxIdempotencyKeyLength+1), OrderId: 7, Height: 55,
+			},
+			expErr: fmt.Sprintf("invalid idempotency key %q (length %d): max length %d",
+				"kkkkk...kkkkk", MaxIdempotencyKeyLength+1, MaxIdempotencyKeyLength),
+		},
+		{
+			name:   "zero order id",
+			record: IdempotencyKeyRecord{Account: account, IdempotencyKey: "retry-1", OrderId: 0, Height: 55},
+			expErr: "invalid order id: cannot be zero",
+		},
+		{
+			name:   "zero height",
+			record: IdempotencyKeyRecord{Account: account, IdempotencyKey: "retry-1", OrderId: 7, Height: 0},
+			expErr: "invalid height 0: must be positive",
+		},
+		{
+			name:   "negative height",
+			record: IdempotencyKeyRecord{Account: account, IdempotencyKey: "retry-1", OrderId: 7, Height: -3},
+			expErr: "invalid height -3: must be positive",
+		},
+		{
+			name:   "multiple errors",
+			record: IdempotencyKeyRecord{},
+			expErr: "invalid account \"\": empty address string is not allowed\n" +
+				"invalid idempotency key: cannot be empty\n" +
+				"invalid order id: cannot be zero\n" +
+				"invalid height 0: must be positive",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = tc.record.Validate()
+			}
+			assert.NotPanics(t, testFunc, "Validate")
+			assertions.AssertErrorValue(t, err, tc.expErr, "Validate error")
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+// GetAccountActivitySummary gets the totals of an account's orders, commitments, and payments,
+// as well as the funds that are on hold for them. Any entries that cannot be read are skipped.
+func (k Keeper) GetAccountActivitySummary(ctx sdk.Context, addr sdk.AccAddress) *exchange.QueryGetAccountActivitySummaryResponse {
+	store := k.getStore(ctx)
+	rv := &exchange.QueryGetAccountActivitySummaryResponse{}
+	var onHold sdk.Coins
+
+	var orderIDs []uint64
+	iterate(store, GetIndexKeyPrefixAddressToOrder(addr), func(keySuffix, _ []byte) bool {
+		if orderID, ok := ParseIndexKeySuffixOrderID(keySuffix); ok {
+			orderIDs = append(orderIDs, orderID)
+		}
+		return false
+	})
+
+	summaries := make(map[uint32]*exchange.MarketOrdersSummary)
+	for _, orderID := range orderIDs {
+		order, err := k.getOrderFromStore(store, orderID)
+		if err != nil || order == nil {
+			continue
+		}
+		marketID := order.GetMarketID()
+		summary, known := summaries[marketID]
+		if !known {
+			summary = &exchange.MarketOrdersSummary{MarketId: marketID}
+			summaries[marketID] = summary
+		}
+		summary.AddOrder(order)
+		onHold = onHold.Add(order.GetHoldAmount()...)
+	}
+	for _, summary := range summaries {
+		rv.Orders = append(rv.Orders, *summary)
+	}
+	sort.Slice(rv.Orders, func(i, j int) bool {
+		return rv.Orders[i].MarketId < rv.Orders[j].MarketId
+	})
+
+	k.IterateKnownMarketIDs(ctx, func(marketID uint32) bool {
+		amount := getCommitmentAmount(store, marketID, addr)
+		if !amount.IsZero() {
+			rv.Commitments = append(rv.Commitments, &exchange.MarketAmount{MarketId: marketID, Amount: amount})
+			onHold = onHold.Add(amount...)
+		}
+		return false
+	})
+
+	k.IteratePaymentsForSource(ctx, addr, func(payment *exchange.Payment) bool {
+		rv.OutgoingPayments.AddPayment(payment)
+		onHold = onHold.Add(payment.SourceAmount...)
+		return false
+	})
+
+	var sources []sdk.AccAddress
+	var externalIDs []string
+	iterate(store, GetIndexKeyPrefixTargetToPayments(addr), func(keySuffix, _ []byte) bool {
+		source, externalID, err := ParseIndexKeySuffixTargetToPayment(keySuffix)
+		if err == nil {
+			sources = append(sources, source)
+			externalIDs = append(externalIDs, externalID)
+		}
+		return false
+	})
+	for i, source := range sources {
+		payment, err := k.getPaymentFromStore(store, source, externalIDs[i])
+		if err != nil || payment == nil {
+			continue
+		}
+		rv.IncomingPayments.AddPayment(payment)
+	}
+
+	rv.OnHold = onHold
+	return rv
+}
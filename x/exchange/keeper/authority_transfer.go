@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+// parseAuthorityTransferStoreValue converts a market authority transfer store value into a MarketAuthorityTransfer.
+func (k Keeper) parseAuthorityTransferStoreValue(value []byte) (*exchange.MarketAuthorityTransfer, error) {
+	var transfer exchange.MarketAuthorityTransfer
+	if err := k.cdc.Unmarshal(value, &transfer); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal market authority transfer: %w", err)
+	}
+	return &transfer, nil
+}
+
+// getAuthorityTransferFromStore gets a market's pending authority transfer.
+// Returns nil (without an error) if the market does not have one.
+func (k Keeper) getAuthorityTransferFromStore(store storetypes.KVStore, marketID uint32) (*exchange.MarketAuthorityTransfer, error) {
+	value := store.Get(MakeKeyMarketAuthorityTransfer(marketID))
+	if len(value) == 0 {
+		return nil, nil
+	}
+	return k.parseAuthorityTransferStoreValue(value)
+}
+
+// setAuthorityTransferInStore writes a market authority transfer to the store, replacing any the market already has.
+func (k Keeper) setAuthorityTransferInStore(store storetypes.KVStore, transfer exchange.MarketAuthorityTransfer) error {
+	value, err := k.cdc.Marshal(&transfer)
+	if err != nil {
+		return fmt.Errorf("failed to marshal market authority transfer: %w", err)
+	}
+	store.Set(MakeKeyMarketAuthorityTransfer(transfer.MarketId), value)
+	return nil
+}
+
+// validateAuthorityTransfer returns an error if the provided transfer cannot currently be applied.
+func (k Keeper) validateAuthorityTransfer(ctx sdk.Context, transfer *exchange.MarketAuthorityTransfer) error {
+	var errs []error
+	if !k.IsAuthority(transfer.NewAuthority) && !transfer.GrantsPermissionsTo(transfer.NewAuthority) {
+		errs = append(errs, fmt.Errorf("new authority %s must be granted %s permission",
+			transfer.NewAuthority, exchange.Permission_permissions.SimpleString()))
+	}
+	for _, ag := range transfer.AccessGrants {
+		if err := validateOrderExpiration(ctx, ag.Expiration); err != nil {
+			errs = append(errs, fmt.Errorf("account %s access grant for market %d: %w", ag.Address, transfer.MarketId, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// GetAuthorityTransfer gets the authority transfer waiting to be accepted for a market.
+// Returns nil (without an error) if the market does not have one.
+func (k Keeper) GetAuthorityTransfer(ctx sdk.Context, marketID uint32) (*exchange.MarketAuthorityTransfer, error) {
+	return k.getAuthorityTransferFromStore(k.getStore(ctx), marketID)
+}
+
+// IterateAuthorityTransfers iterates over all pending market authority transfers. An error is returned if there was a
+// problem reading an entry along the way. Such a problem does not interrupt iteration.
+// The callback takes in the authority transfer and should return whether to stop iterating.
+func (k Keeper) IterateAuthorityTransfers(ctx sdk.Context, cb func(transfer *exchange.MarketAuthorityTransfer) bool) error {
+	var errs []error
+	k.iterate(ctx, GetKeyPrefixMarketAuthorityTransfers(), func(_, value []byte) bool {
+		transfer, err := k.parseAuthorityTransferStoreValue(value)
+		if err != nil {
+			errs = append(errs, err)
+			return false
+		}
+		return cb(transfer)
+	})
+	return errors.Join(errs...)
+}
+
+// ProposeAuthorityTransfer records a transfer of a market's authority that will be applied once the new authority
+// accepts it. Any transfer already pending for the market is replaced.
+func (k Keeper) ProposeAuthorityTransfer(ctx sdk.Context, transfer *exchange.MarketAuthorityTransfer) error {
+	store := k.getStore(ctx)
+	if err := validateMarketExists(store, transfer.MarketId); err != nil {
+		return err
+	}
+	if err := k.validateAuthorityTransfer(ctx, transfer); err != nil {
+		return err
+	}
+	if err := k.setAuthorityTransferInStore(store, *transfer); err != nil {
+		return err
+	}
+
+	k.emitEvent(ctx, exchange.NewEventMarketAuthorityTransferProposed(transfer))
+	return nil
+}
+
+// AcceptAuthorityTransfer applies a market's pending authority transfer, replacing all of the market's access grants
+// with the ones in the transfer. The provided newAuthority must be the one named in the transfer.
+func (k Keeper) AcceptAuthorityTransfer(ctx sdk.Context, marketID uint32, newAuthority string) error {
+	store := k.getStore(ctx)
+	transfer, err := k.getAuthorityTransferFromStore(store, marketID)
+	if err != nil {
+		return err
+	}
+	if transfer == nil {
+		return fmt.Errorf("market %d does not have a pending authority transfer", marketID)
+	}
+	if transfer.NewAuthority != newAuthority {
+		return fmt.Errorf("account %s is not the new authority of the pending transfer for market %d", newAuthority, marketID)
+	}
+	if err = k.validateAuthorityTransfer(ctx, transfer); err != nil {
+		return err
+	}
+
+	setAccessGrants(store, marketID, transfer.AccessGrants)
+	store.Delete(MakeKeyMarketAuthorityTransfer(marketID))
+
+	k.emitEvent(ctx, exchange.NewEventMarketAuthorityTransferred(transfer))
+	return nil
+}
@@ -0,0 +1,198 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+// getLastBatchAuctionHeight gets the block height of a market's last batch auction.
+func getLastBatchAuctionHeight(store storetypes.KVStore, marketID uint32) int64 {
+	key := MakeKeyMarketLastBatchAuction(marketID)
+	value := store.Get(key)
+	if len(value) == 0 {
+		return 0
+	}
+	rv, _ := uint64FromBz(value)
+	return int64(rv)
+}
+
+// setLastBatchAuctionHeight sets the block height of a market's last batch auction.
+func setLastBatchAuctionHeight(store storetypes.KVStore, marketID uint32, height int64) {
+	key := MakeKeyMarketLastBatchAuction(marketID)
+	store.Set(key, uint64Bz(uint64(height)))
+}
+
+// RunBatchAuctions runs a batch auction in each market that has batch auctions enabled,
+// and whose batch auction interval has passed since its last one.
+func (k Keeper) RunBatchAuctions(ctx sdk.Context) {
+	store := k.getStore(ctx)
+	height := ctx.BlockHeight()
+
+	var marketIDs []uint32
+	k.IterateKnownMarketIDs(ctx, func(marketID uint32) bool {
+		interval := getBatchAuctionInterval(store, marketID)
+		if interval != 0 && height-getLastBatchAuctionHeight(store, marketID) >= int64(interval) {
+			marketIDs = append(marketIDs, marketID)
+		}
+		return false
+	})
+
+	for _, marketID := range marketIDs {
+		setLastBatchAuctionHeight(store, marketID, height)
+		k.runBatchAuction(ctx, marketID)
+	}
+}
+
+// runBatchAuction settles the orders in a market that have the same assets and price denoms at a single
+// clearing price. Expired orders are not included. If the market has self-trade prevention, it is applied
+// to the orders before they're settled. If the orders for a pair of denoms cannot be settled,
+// the error is logged and those orders are left in the book.
+func (k Keeper) runBatchAuction(ctx sdk.Context, marketID uint32) {
+	store := k.getStore(ctx)
+
+	var orderIDs []uint64
+	iterate(store, GetIndexKeyPrefixMarketToOrder(marketID), func(keySuffix, _ []byte) bool {
+		if orderID, ok := ParseIndexKeySuffixOrderID(keySuffix); ok {
+			orderIDs = append(orderIDs, orderID)
+		}
+		return false
+	})
+
+	blockTime := ctx.BlockTime().Unix()
+	var denomPairs []string
+	askOrders := make(map[string][]*exchange.Order)
+	bidOrders := make(map[string][]*exchange.Order)
+	for _, orderID := range orderIDs {
+		order, err := k.getOrderFromStore(store, orderID)
+		if err != nil || order == nil {
+			k.logErrorf(ctx, "could not get order %d for market %d batch auction: %v", orderID, marketID, err)
+			continue
+		}
+		if exp := order.GetExpiration(); exp != nil && exp.Unix() <= blockTime {
+			continue
+		}
+		if order.IsBasketOrder() {
+			continue
+		}
+
+		denomPair := order.GetAssets().Denom + " " + order.GetPrice().Denom
+		if _, known := askOrders[denomPair]; !known {
+			if _, known = bidOrders[denomPair]; !known {
+				denomPairs = append(denomPairs, denomPair)
+			}
+		}
+		if order.IsAskOrder() {
+			askOrders[denomPair] = append(askOrders[denomPair], order)
+		} else {
+			bidOrders[denomPair] = append(bidOrders[denomPair], order)
+		}
+	}
+
+	sort.Strings(denomPairs)
+	for _, denomPair := range denomPairs {
+		if len(askOrders[denomPair]) == 0 || len(bidOrders[denomPair]) == 0 {
+			continue
+		}
+		asks, bids := askOrders[denomPair], bidOrders[denomPair]
+		if mode := getSelfTradePrevention(store, marketID); mode != exchange.SelfTradePrevention_unspecified {
+			cacheCtx, writeCache := ctx.CacheContext()
+			var err error
+			asks, bids, err = k.preventBatchAuctionSelfTrades(cacheCtx, k.getStore(cacheCtx), mode, asks, bids)
+			if err != nil {
+				k.logErrorf(ctx, "could not apply self-trade prevention to market %d batch auction for %q: %v", marketID, denomPair, err)
+				continue
+			}
+			writeCache()
+			if len(asks) == 0 || len(bids) == 0 {
+				continue
+			}
+		}
+		if err := k.settleBatchAuction(ctx, marketID, asks, bids); err != nil {
+			k.logErrorf(ctx, "could not settle market %d batch auction for %q: %v", marketID, denomPair, err)
+		}
+	}
+}
+
+// settleBatchAuction settles the provided orders at the clearing price that trades the most assets.
+// Nothing is changed if there's an error.
+func (k Keeper) settleBatchAuction(ctx sdk.Context, marketID uint32, askOrders, bidOrders []*exchange.Order) error {
+	auction, err := exchange.MatchBatchAuction(askOrders, bidOrders)
+	if err != nil || auction == nil {
+		return err
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	store := k.getStore(cacheCtx)
+
+	// Each bid is settled at a price at or below what it offered. Release the hold on the difference so
+	// that closing the settlement releases the rest, and any partial order left keeps the hold it needs.
+	heldByID := make(map[uint64]sdk.Coins, len(bidOrders))
+	for _, bidOrder := range bidOrders {
+		heldByID[bidOrder.OrderId] = bidOrder.GetHoldAmount()
+	}
+	for _, bidOrder := range auction.BidOrders {
+		needed := bidOrder.GetHoldAmount()
+		if left := auction.PartialOrderLeft; left != nil && left.OrderId == bidOrder.OrderId {
+			needed = needed.Add(left.GetHoldAmount()...)
+		}
+		excess, hasNeg := heldByID[bidOrder.OrderId].SafeSub(needed...)
+		if hasNeg {
+			return fmt.Errorf("bid order %d hold %q is less than the %q needed",
+				bidOrder.OrderId, heldByID[bidOrder.OrderId], needed)
+		}
+		if excess.IsZero() {
+			continue
+		}
+		buyer, err := sdk.AccAddressFromBech32(bidOrder.GetOwner())
+		if err != nil {
+			return fmt.Errorf("invalid bid order %d owner %q: %w", bidOrder.OrderId, bidOrder.GetOwner(), err)
+		}
+		if err = k.holdKeeper.ReleaseHold(cacheCtx, buyer, excess); err != nil {
+			return fmt.Errorf("error releasing hold for bid order %d: %w", bidOrder.OrderId, err)
+		}
+	}
+
+	ratioGetter := func(denom string, isTaker bool) (*exchange.FeeRatio, error) {
+		return getSellerSettlementRatioFor(store, marketID, denom, isTaker)
+	}
+
+	settlement, err := exchange.BuildSettlement(auction.AskOrders, auction.BidOrders, ratioGetter)
+	if err != nil {
+		return err
+	}
+
+	// The filled portion of a partial order was provided as a whole order, so it's one of the fully filled ones.
+	if auction.PartialOrderLeft != nil {
+		partialID := auction.PartialOrderLeft.OrderId
+		for i, filled := range settlement.FullyFilledOrders {
+			if filled.GetOrderID() == partialID {
+				settlement.PartialOrderFilled = filled
+				settlement.FullyFilledOrders = append(settlement.FullyFilledOrders[:i], settlement.FullyFilledOrders[i+1:]...)
+				break
+			}
+		}
+		settlement.PartialOrderLeft = auction.PartialOrderLeft
+	}
+
+	if err = k.closeSettlement(cacheCtx, store, marketID, settlement); err != nil {
+		return err
+	}
+
+	assets := sdk.NewCoin(askOrders[0].GetAssets().Denom, sdkmath.ZeroInt())
+	price := sdk.NewCoin(auction.ClearingPrice.Denom, sdkmath.ZeroInt())
+	for _, bidOrder := range auction.BidOrders {
+		assets = assets.Add(bidOrder.GetAssets())
+		price = price.Add(bidOrder.GetPrice())
+	}
+	k.emitEvent(cacheCtx, exchange.NewEventBatchAuctionSettled(marketID, assets, price, auction.ClearingPrice))
+
+	writeCache()
+	return nil
+}
@@ -0,0 +1,380 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/provenance-io/provenance/x/exchange"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+func (s *TestSuite) TestKeeper_RunBatchAuctions() {
+	appleMarker := s.markerAccount("1000000000apple")
+
+	tests := []struct {
+		name           string
+		bankKeeper     *MockBankKeeper
+		holdKeeper     *MockHoldKeeper
+		markerKeeper   *MockMarkerKeeper
+		interval       uint32
+		stp            exchange.SelfTradePrevention
+		blocksPassed   int64
+		book           []*exchange.Order
+		expRan         bool
+		expEvents      []proto.Message
+		expLeft        []*exchange.Order
+		expGone        []uint64
+		expHoldCalls   HoldCalls
+		expBankCalls   BankCalls
+		expMarkerCalls MarkerCalls
+		expLog         string
+	}{
+		{
+			name:         "batch auctions not enabled",
+			interval:     0,
+			blocksPassed: 100,
+			book: []*exchange.Order{
+				exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+				}),
+				exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+				}),
+			},
+			expLeft: []*exchange.Order{
+				exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+				}),
+				exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+				}),
+			},
+		},
+		{
+			name:         "interval not yet passed",
+			interval:     10,
+			blocksPassed: 9,
+			book: []*exchange.Order{
+				exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+				}),
+				exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+				}),
+			},
+			expLeft: []*exchange.Order{
+				exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+				}),
+				exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+				}),
+			},
+		},
+		{
+			name:         "orders do not cross",
+			interval:     10,
+			blocksPassed: 10,
+			book: []*exchange.Order{
+				exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("6peach"),
+				}),
+				exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+				}),
+			},
+			expRan: true,
+			expLeft: []*exchange.Order{
+				exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("6peach"),
+				}),
+				exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+				}),
+			},
+		},
+		{
+			name:         "one ask and one bid settled at the clearing price",
+			markerKeeper: NewMockMarkerKeeper().WithGetMarkerAccount(appleMarker),
+			interval:     10,
+			blocksPassed: 12,
+			book: []*exchange.Order{
+				exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("10apple"), Price: s.coin("20peach"),
+				}),
+				exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("30peach"),
+				}),
+			},
+			expRan: true,
+			expEvents: []proto.Message{
+				&exchange.EventOrderFilled{OrderId: 1, Assets: "10apple", Price: "20peach", MarketId: 1},
+				&exchange.EventOrderFilled{OrderId: 2, Assets: "10apple", Price: "20peach", MarketId: 1},
+				&exchange.EventBatchAuctionSettled{
+					MarketId: 1, Assets: "10apple", Price: "20peach", ClearingPrice: "2.000000000000000000peach",
+				},
+			},
+			expGone: []uint64{1, 2},
+			expHoldCalls: HoldCalls{
+				ReleaseHold: []*ReleaseHoldArgs{
+					{addr: s.addr2, funds: s.coins("10peach")},
+					{addr: s.addr1, funds: s.coins("10apple")},
+					{addr: s.addr2, funds: s.coins("20peach")},
+				},
+			},
+			expBankCalls: BankCalls{
+				BlockedAddr: []sdk.AccAddress{s.addr2, s.addr1},
+				SendCoins: []*SendCoinsArgs{
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr1, toAddr: s.addr2, amt: s.coins("10apple")},
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr2, toAddr: s.addr1, amt: s.coins("20peach")},
+				},
+			},
+			expMarkerCalls: MarkerCalls{
+				GetMarker: []sdk.AccAddress{appleMarker.GetAddress()},
+				AddSetNetAssetValues: []*AddSetNetAssetValuesArgs{
+					{
+						marker:         appleMarker,
+						netAssetValues: []markertypes.NetAssetValue{{Price: s.coin("20peach"), Volume: 10}},
+						source:         "x/exchange market 1",
+					},
+				},
+			},
+		},
+		{
+			name:         "bid partially filled",
+			markerKeeper: NewMockMarkerKeeper().WithGetMarkerAccount(appleMarker),
+			interval:     1,
+			blocksPassed: 1,
+			book: []*exchange.Order{
+				exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("5apple"), Price: s.coin("10peach"),
+				}),
+				exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("30peach"),
+					AllowPartial: true,
+				}),
+			},
+			expRan: true,
+			expEvents: []proto.Message{
+				&exchange.EventOrderFilled{OrderId: 1, Assets: "5apple", Price: "10peach", MarketId: 1},
+				&exchange.EventOrderPartiallyFilled{OrderId: 2, Assets: "5apple", Price: "10peach", MarketId: 1},
+				&exchange.EventOrderPartialFill{
+					OrderId: 2, OrderType: "bid", MarketId: 1, Owner: s.addr2.String(),
+					FilledAssets: "5apple", FilledPrice: "10peach", RemainingAssets: "5apple", RemainingPrice: "15peach",
+					Participants: []*exchange.FillParticipant{
+						{OrderId: 1, OrderType: "ask", Owner: s.addr1.String(), Assets: "5apple", Price: "10peach"},
+						{OrderId: 2, OrderType: "bid", Owner: s.addr2.String(), Assets: "5apple", Price: "10peach", Partial: true},
+					},
+				},
+				&exchange.EventBatchAuctionSettled{
+					MarketId: 1, Assets: "5apple", Price: "10peach", ClearingPrice: "2.000000000000000000peach",
+				},
+			},
+			expLeft: []*exchange.Order{
+				exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("5apple"), Price: s.coin("15peach"),
+					AllowPartial: true,
+				}),
+			},
+			expGone: []uint64{1},
+			expHoldCalls: HoldCalls{
+				ReleaseHold: []*ReleaseHoldArgs{
+					{addr: s.addr2, funds: s.coins("5peach")},
+					{addr: s.addr1, funds: s.coins("5apple")},
+					{addr: s.addr2, funds: s.coins("10peach")},
+				},
+			},
+			expBankCalls: BankCalls{
+				BlockedAddr: []sdk.AccAddress{s.addr2, s.addr1},
+				SendCoins: []*SendCoinsArgs{
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr1, toAddr: s.addr2, amt: s.coins("5apple")},
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr2, toAddr: s.addr1, amt: s.coins("10peach")},
+				},
+			},
+			expMarkerCalls: MarkerCalls{
+				GetMarker: []sdk.AccAddress{appleMarker.GetAddress()},
+				AddSetNetAssetValues: []*AddSetNetAssetValuesArgs{
+					{
+						marker:         appleMarker,
+						netAssetValues: []markertypes.NetAssetValue{{Price: s.coin("10peach"), Volume: 5}},
+						source:         "x/exchange market 1",
+					},
+				},
+			},
+		},
+		{
+			name:         "self-trade prevention: cancel newest leaves nothing to settle",
+			interval:     10,
+			stp:          exchange.SelfTradePrevention_cancel_newest,
+			blocksPassed: 10,
+			book: []*exchange.Order{
+				exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("10apple"), Price: s.coin("20peach"),
+				}),
+				exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr1.String(), Assets: s.coin("10apple"), Price: s.coin("30peach"),
+				}),
+			},
+			expRan: true,
+			expEvents: []proto.Message{
+				&exchange.EventSelfTradePrevented{OrderId: 2, OpposingOrderId: 1, MarketId: 1, Assets: "10apple"},
+			},
+			expLeft: []*exchange.Order{
+				exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("10apple"), Price: s.coin("20peach"),
+				}),
+			},
+			expGone: []uint64{2},
+			expHoldCalls: HoldCalls{
+				ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr1, funds: s.coins("30peach")}},
+			},
+		},
+		{
+			name:         "self-trade prevention: cancel oldest then settle",
+			markerKeeper: NewMockMarkerKeeper().WithGetMarkerAccount(appleMarker),
+			interval:     10,
+			stp:          exchange.SelfTradePrevention_cancel_oldest,
+			blocksPassed: 10,
+			book: []*exchange.Order{
+				exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("10apple"), Price: s.coin("20peach"),
+				}),
+				exchange.NewOrder(2).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr3.String(), Assets: s.coin("10apple"), Price: s.coin("20peach"),
+				}),
+				exchange.NewOrder(3).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr1.String(), Assets: s.coin("10apple"), Price: s.coin("30peach"),
+				}),
+			},
+			expRan: true,
+			expEvents: []proto.Message{
+				&exchange.EventSelfTradePrevented{OrderId: 1, OpposingOrderId: 3, MarketId: 1, Assets: "10apple"},
+				&exchange.EventOrderFilled{OrderId: 2, Assets: "10apple", Price: "20peach", MarketId: 1},
+				&exchange.EventOrderFilled{OrderId: 3, Assets: "10apple", Price: "20peach", MarketId: 1},
+				&exchange.EventBatchAuctionSettled{
+					MarketId: 1, Assets: "10apple", Price: "20peach", ClearingPrice: "2.000000000000000000peach",
+				},
+			},
+			expGone: []uint64{1, 2, 3},
+			expHoldCalls: HoldCalls{
+				ReleaseHold: []*ReleaseHoldArgs{
+					{addr: s.addr1, funds: s.coins("10apple")},
+					{addr: s.addr1, funds: s.coins("10peach")},
+					{addr: s.addr3, funds: s.coins("10apple")},
+					{addr: s.addr1, funds: s.coins("20peach")},
+				},
+			},
+			expBankCalls: BankCalls{
+				BlockedAddr: []sdk.AccAddress{s.addr1, s.addr3},
+				SendCoins: []*SendCoinsArgs{
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr3, toAddr: s.addr1, amt: s.coins("10apple")},
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr1, toAddr: s.addr3, amt: s.coins("20peach")},
+				},
+			},
+			expMarkerCalls: MarkerCalls{
+				GetMarker: []sdk.AccAddress{appleMarker.GetAddress()},
+				AddSetNetAssetValues: []*AddSetNetAssetValuesArgs{
+					{
+						marker:         appleMarker,
+						netAssetValues: []markertypes.NetAssetValue{{Price: s.coin("20peach"), Volume: 10}},
+						source:         "x/exchange market 1",
+					},
+				},
+			},
+		},
+		{
+			name:         "error settling",
+			bankKeeper:   NewMockBankKeeper().WithSendCoinsResults("injected send error"),
+			interval:     3,
+			blocksPassed: 5,
+			book: []*exchange.Order{
+				exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("10apple"), Price: s.coin("20peach"),
+				}),
+				exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("30peach"),
+				}),
+			},
+			expRan: true,
+			expLeft: []*exchange.Order{
+				exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("10apple"), Price: s.coin("20peach"),
+				}),
+				exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("30peach"),
+				}),
+			},
+			expHoldCalls: HoldCalls{
+				ReleaseHold: []*ReleaseHoldArgs{
+					{addr: s.addr2, funds: s.coins("10peach")},
+					{addr: s.addr1, funds: s.coins("10apple")},
+					{addr: s.addr2, funds: s.coins("20peach")},
+				},
+			},
+			expBankCalls: BankCalls{
+				BlockedAddr: []sdk.AccAddress{s.addr2, s.addr1},
+				SendCoins: []*SendCoinsArgs{
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr1, toAddr: s.addr2, amt: s.coins("10apple")},
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr2, toAddr: s.addr1, amt: s.coins("20peach")},
+				},
+			},
+			expLog: "ERR could not settle market 1 batch auction for \"apple peach\": injected send error module=x/exchange\n",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			s.requireCreateMarket(exchange.Market{MarketId: 1, BatchAuctionInterval: tc.interval, SelfTradePrevention: tc.stp})
+			store := s.getStore()
+			s.requireSetOrdersInStore(store, tc.book...)
+
+			if tc.bankKeeper == nil {
+				tc.bankKeeper = NewMockBankKeeper()
+			}
+			if tc.holdKeeper == nil {
+				tc.holdKeeper = NewMockHoldKeeper()
+			}
+			if tc.markerKeeper == nil {
+				tc.markerKeeper = NewMockMarkerKeeper()
+			}
+
+			startHeight := s.k.GetLastBatchAuctionHeight(s.ctx, 1)
+			expHeight := startHeight
+			if tc.expRan {
+				expHeight += tc.blocksPassed
+			}
+
+			expEvents := untypeEvents(s, tc.expEvents)
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em).WithBlockHeight(s.ctx.BlockHeight() + tc.blocksPassed)
+			kpr := s.k.WithBankKeeper(tc.bankKeeper).
+				WithHoldKeeper(tc.holdKeeper).
+				WithMarkerKeeper(tc.markerKeeper)
+			s.logBuffer.Reset()
+			testFunc := func() {
+				kpr.RunBatchAuctions(ctx)
+			}
+			s.Require().NotPanics(testFunc, "RunBatchAuctions")
+			s.assertEqualEvents(expEvents, em.Events(), "RunBatchAuctions events")
+			s.assertHoldKeeperCalls(tc.holdKeeper, tc.expHoldCalls, "RunBatchAuctions")
+			s.assertBankKeeperCalls(tc.bankKeeper, tc.expBankCalls, "RunBatchAuctions")
+			s.assertMarkerKeeperCalls(tc.markerKeeper, tc.expMarkerCalls, "RunBatchAuctions")
+			actLog := s.getLogOutput("RunBatchAuctions")
+			s.Assert().Equal(tc.expLog, actLog, "RunBatchAuctions log output")
+
+			actHeight := s.k.GetLastBatchAuctionHeight(s.ctx, 1)
+			s.Assert().Equal(expHeight, actHeight, "GetLastBatchAuctionHeight")
+			for _, expOrder := range tc.expLeft {
+				order, err := s.k.GetOrder(s.ctx, expOrder.OrderId)
+				s.Assert().NoError(err, "GetOrder(%d) error", expOrder.OrderId)
+				s.Assert().Equal(expOrder, order, "GetOrder(%d)", expOrder.OrderId)
+			}
+			for _, orderID := range tc.expGone {
+				order, err := s.k.GetOrder(s.ctx, orderID)
+				s.Assert().NoError(err, "GetOrder(%d) error", orderID)
+				s.Assert().Nil(order, "GetOrder(%d)", orderID)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+// BenchmarkSettleOrders measures settling one bid order against N ask orders in a single market.
+// Run with: go test -run='^$' -bench=BenchmarkSettleOrders -benchmem ./x/exchange/keeper
+func BenchmarkSettleOrders(b *testing.B) {
+	pioApp := app.Setup(b)
+	ctx := pioApp.BaseApp.NewContext(false)
+	k := pioApp.ExchangeKeeper
+
+	coin := func(amount int, denom string) sdk.Coin {
+		return sdk.NewCoin(denom, sdkmath.NewInt(int64(amount)))
+	}
+
+	admin := sdk.AccAddress("bench_admin_________")
+	marketID, err := k.CreateMarket(ctx, exchange.Market{
+		MarketDetails:   exchange.MarketDetails{Name: "Benchmark Market"},
+		AcceptingOrders: true,
+		AccessGrants: []exchange.AccessGrant{
+			{Address: admin.String(), Permissions: []exchange.Permission{exchange.Permission_settle}},
+		},
+	})
+	require.NoError(b, err, "CreateMarket")
+
+	orderCounts := []int{1, 10, 100}
+	maxAsks := orderCounts[len(orderCounts)-1]
+
+	buyer := sdk.AccAddress("bench_buyer_________")
+	require.NoError(b, testutil.FundAccount(ctx, pioApp.BankKeeper, buyer, sdk.NewCoins(coin(10*maxAsks, "plum"))),
+		"FundAccount(buyer)")
+	sellers := make([]sdk.AccAddress, maxAsks)
+	for i := range sellers {
+		sellers[i] = sdk.AccAddress(fmt.Sprintf("bench_seller_%07d", i))
+		require.NoError(b, testutil.FundAccount(ctx, pioApp.BankKeeper, sellers[i], sdk.NewCoins(coin(10, "apple"))),
+			"FundAccount(sellers[%d])", i)
+	}
+
+	for _, numAsks := range orderCounts {
+		b.Run(fmt.Sprintf("%d asks", numAsks), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				// Create the orders in a cache context so that each iteration starts from the same state.
+				b.StopTimer()
+				cacheCtx, _ := ctx.CacheContext()
+				req := &exchange.MsgMarketSettleRequest{Admin: admin.String(), MarketId: marketID}
+				for j := 0; j < numAsks; j++ {
+					orderID, oerr := k.CreateAskOrder(cacheCtx, exchange.AskOrder{
+						MarketId: marketID,
+						Seller:   sellers[j].String(),
+						Assets:   coin(10, "apple"),
+						Price:    coin(10, "plum"),
+					}, nil)
+					require.NoError(b, oerr, "CreateAskOrder(%d)", j)
+					req.AskOrderIds = append(req.AskOrderIds, orderID)
+				}
+				orderID, oerr := k.CreateBidOrder(cacheCtx, exchange.BidOrder{
+					MarketId: marketID,
+					Buyer:    buyer.String(),
+					Assets:   coin(10*numAsks, "apple"),
+					Price:    coin(10*numAsks, "plum"),
+				}, nil)
+				require.NoError(b, oerr, "CreateBidOrder")
+				req.BidOrderIds = []uint64{orderID}
+				b.StartTimer()
+
+				serr := k.SettleOrders(cacheCtx, req)
+
+				b.StopTimer()
+				require.NoError(b, serr, "SettleOrders")
+				b.StartTimer()
+			}
+		})
+	}
+}
@@ -0,0 +1,355 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+	"github.com/provenance-io/provenance/x/quarantine"
+)
+
+// parseCoinsValue parses a store value that is a coins string.
+func parseCoinsValue(value []byte) (sdk.Coins, error) {
+	rv, err := sdk.ParseCoinsNormalized(string(value))
+	if err != nil {
+		return nil, fmt.Errorf("invalid coins value: %w", err)
+	}
+	return rv, nil
+}
+
+// setCoinsValue sets the given key to the provided amount, or deletes it if the amount is zero.
+func setCoinsValue(store storetypes.KVStore, key []byte, amount sdk.Coins) {
+	if amount.IsZero() {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, []byte(amount.String()))
+}
+
+// getCommitmentRewardPool gets the undistributed funds in a market's commitment reward pool.
+func getCommitmentRewardPool(store storetypes.KVStore, marketID uint32) sdk.Coins {
+	value := store.Get(MakeKeyCommitmentRewardPool(marketID))
+	if len(value) == 0 {
+		return nil
+	}
+	// Skipping the error check here because I'd just be returning nil on error anyway.
+	rv, _ := parseCoinsValue(value)
+	return rv
+}
+
+// setCommitmentRewardPool sets the undistributed funds in a market's commitment reward pool.
+// If the amount is zero, the entry is deleted.
+func setCommitmentRewardPool(store storetypes.KVStore, marketID uint32, amount sdk.Coins) {
+	setCoinsValue(store, MakeKeyCommitmentRewardPool(marketID), amount)
+}
+
+// getCommitmentReward gets the rewards that the given address can claim from the provided market.
+func getCommitmentReward(store storetypes.KVStore, addr sdk.AccAddress, marketID uint32) sdk.Coins {
+	value := store.Get(MakeKeyCommitmentReward(addr, marketID))
+	if len(value) == 0 {
+		return nil
+	}
+	// Skipping the error check here because I'd just be returning nil on error anyway.
+	rv, _ := parseCoinsValue(value)
+	return rv
+}
+
+// setCommitmentReward sets the rewards that the given address can claim from the provided market.
+// If the amount is zero, the entry is deleted.
+func setCommitmentReward(store storetypes.KVStore, addr sdk.AccAddress, marketID uint32, amount sdk.Coins) {
+	setCoinsValue(store, MakeKeyCommitmentReward(addr, marketID), amount)
+}
+
+// createCommitmentAccrualValue creates the store value for a commitment accrual entry.
+// The format is: <last accrued (8 bytes)> | <accrued coins string>.
+func createCommitmentAccrualValue(lastAccrued time.Time, accrued sdk.Coins) []byte {
+	accruedStr := accrued.String()
+	rv := make([]byte, 0, 8+len(accruedStr))
+	rv = append(rv, uint64Bz(uint64(lastAccrued.Unix()))...) //nolint:gosec // G115: Parsed back into an int64.
+	rv = append(rv, accruedStr...)
+	return rv
+}
+
+// parseCommitmentAccrualValue extracts the last accrued time and accrued amount from a commitment accrual store value.
+func parseCommitmentAccrualValue(value []byte) (time.Time, sdk.Coins, error) {
+	if len(value) < 8 {
+		return time.Time{}, nil, fmt.Errorf("cannot parse commitment accrual value: only has %d bytes, expected at least 8", len(value))
+	}
+	lastSecs, _ := uint64FromBz(value[:8])
+	lastAccrued := time.Unix(int64(lastSecs), 0).UTC() //nolint:gosec // G115: We wrote it from an int64.
+	accrued, err := parseCoinsValue(value[8:])
+	if err != nil {
+		return time.Time{}, nil, fmt.Errorf("cannot parse commitment accrual value: %w", err)
+	}
+	return lastAccrued, accrued, nil
+}
+
+// getCommitmentAccrual gets the amount accrued by an address in a market and the time it was last updated.
+// Returns false if there is no accrual entry for the address in the market.
+func getCommitmentAccrual(store storetypes.KVStore, marketID uint32, addr sdk.AccAddress) (time.Time, sdk.Coins, bool) {
+	value := store.Get(MakeKeyCommitmentAccrual(marketID, addr))
+	if len(value) == 0 {
+		return time.Time{}, nil, false
+	}
+	lastAccrued, accrued, err := parseCommitmentAccrualValue(value)
+	if err != nil {
+		return time.Time{}, nil, false
+	}
+	return lastAccrued, accrued, true
+}
+
+// setCommitmentAccrual sets the amount accrued by an address in a market and the time it was last updated.
+func setCommitmentAccrual(store storetypes.KVStore, marketID uint32, addr sdk.AccAddress, lastAccrued time.Time, accrued sdk.Coins) {
+	store.Set(MakeKeyCommitmentAccrual(marketID, addr), createCommitmentAccrualValue(lastAccrued, accrued))
+}
+
+// getAccruedCommitment gets what an address' commitment accrual in a market would be if it were updated to the
+// provided block time, i.e. the accrued amount plus the amount currently committed multiplied by the number of
+// seconds since it was last accrued. Nothing is written to the store.
+func getAccruedCommitment(store storetypes.KVStore, marketID uint32, addr sdk.AccAddress, blockTime time.Time) sdk.Coins {
+	lastAccrued, accrued, found := getCommitmentAccrual(store, marketID, addr)
+	if !found {
+		return nil
+	}
+	if secs := blockTime.Unix() - lastAccrued.Unix(); secs > 0 {
+		for _, coin := range getCommitmentAmount(store, marketID, addr) {
+			accrued = accrued.Add(sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(secs)))
+		}
+	}
+	return accrued
+}
+
+// accrueCommitment updates an address' commitment accrual in a market to the provided block time.
+// Returns the new accrued amount.
+//
+// This must be called before the amount committed is changed so that the previous amount is accrued.
+func accrueCommitment(store storetypes.KVStore, marketID uint32, addr sdk.AccAddress, blockTime time.Time) sdk.Coins {
+	accrued := getAccruedCommitment(store, marketID, addr, blockTime)
+	setCommitmentAccrual(store, marketID, addr, blockTime, accrued)
+	return accrued
+}
+
+// GetCommitmentRewardPool gets the funds in a market's commitment reward pool that have not yet been distributed.
+func (k Keeper) GetCommitmentRewardPool(ctx sdk.Context, marketID uint32) sdk.Coins {
+	return getCommitmentRewardPool(k.getStore(ctx), marketID)
+}
+
+// GetCommitmentReward gets the rewards that the given address can claim from the provided market.
+func (k Keeper) GetCommitmentReward(ctx sdk.Context, addr sdk.AccAddress, marketID uint32) sdk.Coins {
+	return getCommitmentReward(k.getStore(ctx), addr, marketID)
+}
+
+// GetAccountCommitmentRewards gets the rewards that the given address can claim from each market.
+func (k Keeper) GetAccountCommitmentRewards(ctx sdk.Context, addr sdk.AccAddress) []exchange.MarketAmount {
+	var rv []exchange.MarketAmount
+	k.iterate(ctx, GetKeyPrefixCommitmentRewardsForAddr(addr), func(keySuffix, value []byte) bool {
+		marketID, ok := uint32FromBz(keySuffix)
+		if !ok {
+			return false
+		}
+		amount, err := parseCoinsValue(value)
+		if err != nil || amount.IsZero() {
+			return false
+		}
+		rv = append(rv, exchange.MarketAmount{MarketId: marketID, Amount: amount})
+		return false
+	})
+	return rv
+}
+
+// IterateCommitmentRewardPools iterates over all commitment reward pools in the store.
+func (k Keeper) IterateCommitmentRewardPools(ctx sdk.Context, cb func(pool exchange.MarketAmount) bool) {
+	keyPrefix := GetKeyPrefixCommitmentRewardPools()
+	k.iterate(ctx, keyPrefix, func(keySuffix, value []byte) bool {
+		marketID, err := ParseKeyCommitmentRewardPool(append(keyPrefix, keySuffix...))
+		if err != nil {
+			return false
+		}
+		amount, err := parseCoinsValue(value)
+		if err != nil {
+			return false
+		}
+		return cb(exchange.MarketAmount{MarketId: marketID, Amount: amount})
+	})
+}
+
+// IterateCommitmentRewards iterates over all commitment reward entries in the store.
+func (k Keeper) IterateCommitmentRewards(ctx sdk.Context, cb func(reward exchange.CommitmentReward) bool) {
+	keyPrefix := GetKeyPrefixCommitmentRewards()
+	k.iterate(ctx, keyPrefix, func(keySuffix, value []byte) bool {
+		addr, marketID, err := ParseKeyCommitmentReward(append(keyPrefix, keySuffix...))
+		if err != nil {
+			return false
+		}
+		amount, err := parseCoinsValue(value)
+		if err != nil {
+			return false
+		}
+		return cb(exchange.CommitmentReward{Account: addr.String(), MarketId: marketID, Amount: amount})
+	})
+}
+
+// IterateCommitmentAccruals iterates over all commitment accrual entries in the store.
+func (k Keeper) IterateCommitmentAccruals(ctx sdk.Context, cb func(accrual exchange.CommitmentAccrual) bool) {
+	keyPrefix := GetKeyPrefixCommitmentAccruals()
+	k.iterate(ctx, keyPrefix, func(keySuffix, value []byte) bool {
+		marketID, addr, err := ParseKeyCommitmentAccrual(append(keyPrefix, keySuffix...))
+		if err != nil {
+			return false
+		}
+		lastAccrued, accrued, err := parseCommitmentAccrualValue(value)
+		if err != nil {
+			return false
+		}
+		return cb(exchange.CommitmentAccrual{Account: addr.String(), MarketId: marketID, Accrued: accrued, LastAccrued: lastAccrued})
+	})
+}
+
+// FundCommitmentRewards moves funds from the funder into a market's commitment reward pool.
+func (k Keeper) FundCommitmentRewards(ctx sdk.Context, marketID uint32, funder sdk.AccAddress, amount sdk.Coins) error {
+	if amount.IsZero() {
+		return errors.New("cannot fund commitment rewards with zero")
+	}
+	if amount.IsAnyNegative() {
+		return fmt.Errorf("cannot fund commitment rewards with negative amount %q", amount)
+	}
+
+	store := k.getStore(ctx)
+	if err := validateMarketExists(store, marketID); err != nil {
+		return err
+	}
+
+	err := k.bankKeeper.SendCoins(ctx, funder, exchange.GetCommitmentRewardsAddress(), amount)
+	if err != nil {
+		return fmt.Errorf("failed to add %s to market %d commitment reward pool: %w", amount, marketID, err)
+	}
+
+	setCommitmentRewardPool(store, marketID, getCommitmentRewardPool(store, marketID).Add(amount...))
+	k.emitEvent(ctx, exchange.NewEventCommitmentRewardsFunded(marketID, funder.String(), amount))
+	return nil
+}
+
+// DistributeCommitmentRewards divides up the provided amount from a market's commitment reward pool among
+// the accounts that have had funds committed to that market. Each account gets a portion of each coin in the amount
+// proportional to how much of the basis denom it has accrued, i.e. the amount committed times how long (in seconds)
+// it was committed, since the last distribution that used that basis denom. Any remainder left from rounding down is
+// kept in the pool. The basis denom's accruals in the market are then reset.
+func (k Keeper) DistributeCommitmentRewards(ctx sdk.Context, marketID uint32, amount sdk.Coins, basisDenom string, distributedBy string) error {
+	if amount.IsZero() {
+		return errors.New("cannot distribute zero commitment rewards")
+	}
+	if amount.IsAnyNegative() {
+		return fmt.Errorf("cannot distribute negative commitment rewards %q", amount)
+	}
+
+	store := k.getStore(ctx)
+	pool := getCommitmentRewardPool(store, marketID)
+	if !pool.IsAllGTE(amount) {
+		return fmt.Errorf("market %d commitment reward pool %q does not have %q to distribute", marketID, pool, amount)
+	}
+
+	// Accounts that released all their funds since the last distribution only have an accrual entry,
+	// and ones that haven't changed since genesis might only have a commitment entry, so we need both.
+	var addrs []sdk.AccAddress
+	known := make(map[string]bool)
+	var errs []error
+	for _, keyPrefix := range [][]byte{GetKeyPrefixCommitmentAccrualsInMarket(marketID), GetKeyPrefixCommitmentsToMarket(marketID)} {
+		k.iterate(ctx, keyPrefix, func(keySuffix, _ []byte) bool {
+			addr, err := ParseKeySuffixCommitment(keySuffix)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to parse addr from key suffix %x: %w", keySuffix, err))
+				return false
+			}
+			if !known[string(addr)] {
+				known[string(addr)] = true
+				addrs = append(addrs, addr)
+			}
+			return false
+		})
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	type basisEntry struct {
+		addr    sdk.AccAddress
+		accrued sdk.Coins
+		amount  sdkmath.Int
+	}
+	basis := make([]basisEntry, 0, len(addrs))
+	total := sdkmath.ZeroInt()
+	blockTime := ctx.BlockTime()
+	for _, addr := range addrs {
+		accrued := getAccruedCommitment(store, marketID, addr, blockTime)
+		basisAmt := accrued.AmountOf(basisDenom)
+		basis = append(basis, basisEntry{addr: addr, accrued: accrued, amount: basisAmt})
+		total = total.Add(basisAmt)
+	}
+	if !total.IsPositive() {
+		return fmt.Errorf("no %q committed to market %d since the last distribution", basisDenom, marketID)
+	}
+
+	var distributed sdk.Coins
+	for _, entry := range basis {
+		// The basis denom's accrual has now been used, so it's reset for the next distribution.
+		accrued := entry.accrued
+		if entry.amount.IsPositive() {
+			accrued = accrued.Sub(sdk.NewCoin(basisDenom, entry.amount))
+		}
+		if accrued.IsZero() && getCommitmentAmount(store, marketID, entry.addr).IsZero() {
+			store.Delete(MakeKeyCommitmentAccrual(marketID, entry.addr))
+		} else {
+			setCommitmentAccrual(store, marketID, entry.addr, blockTime, accrued)
+		}
+		if !entry.amount.IsPositive() {
+			continue
+		}
+
+		var reward sdk.Coins
+		for _, coin := range amount {
+			share := coin.Amount.Mul(entry.amount).Quo(total)
+			if share.IsPositive() {
+				reward = reward.Add(sdk.NewCoin(coin.Denom, share))
+			}
+		}
+		if reward.IsZero() {
+			continue
+		}
+		setCommitmentReward(store, entry.addr, marketID, getCommitmentReward(store, entry.addr, marketID).Add(reward...))
+		distributed = distributed.Add(reward...)
+	}
+
+	setCommitmentRewardPool(store, marketID, pool.Sub(distributed...))
+	k.emitEvent(ctx, exchange.NewEventCommitmentRewardsDistributed(marketID, distributed, basisDenom, distributedBy))
+	return nil
+}
+
+// ClaimCommitmentRewards sends the addr all the commitment rewards it has accrued in the given market.
+// Returns the amount that was sent.
+func (k Keeper) ClaimCommitmentRewards(ctx sdk.Context, marketID uint32, addr sdk.AccAddress) (sdk.Coins, error) {
+	store := k.getStore(ctx)
+	reward := getCommitmentReward(store, addr, marketID)
+	if reward.IsZero() {
+		return nil, fmt.Errorf("account %s does not have any commitment rewards to claim from market %d", addr, marketID)
+	}
+	if k.bankKeeper.BlockedAddr(addr) {
+		return nil, fmt.Errorf("%s is not allowed to receive funds", addr)
+	}
+
+	// The account is claiming the funds, so that counts as acceptance for the quarantine module.
+	xferCtx := quarantine.WithBypass(ctx)
+	err := k.bankKeeper.SendCoins(xferCtx, exchange.GetCommitmentRewardsAddress(), addr, reward)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send commitment rewards %s from market %d: %w", reward, marketID, err)
+	}
+
+	setCommitmentReward(store, addr, marketID, nil)
+	k.emitEvent(ctx, exchange.NewEventCommitmentRewardsClaimed(addr.String(), marketID, reward))
+	return reward, nil
+}
@@ -0,0 +1,541 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+	"github.com/provenance-io/provenance/x/exchange/keeper"
+)
+
+func (s *TestSuite) TestKeeper_GetAccountCommitmentRewards() {
+	tests := []struct {
+		name   string
+		setup  func()
+		addr   sdk.AccAddress
+		expAmt []exchange.MarketAmount
+	}{
+		{
+			name:   "empty state",
+			addr:   s.addr1,
+			expAmt: nil,
+		},
+		{
+			name: "no rewards for addr",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetCommitmentReward(store, s.addr2, 1, s.coins("12cherry"))
+				keeper.SetCommitmentReward(store, s.addr3, 1, s.coins("13cherry"))
+			},
+			addr:   s.addr1,
+			expAmt: nil,
+		},
+		{
+			name: "rewards from several markets",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetCommitmentReward(store, s.addr1, 3, s.coins("31cherry,3grape"))
+				keeper.SetCommitmentReward(store, s.addr1, 1, s.coins("11cherry"))
+				keeper.SetCommitmentReward(store, s.addr2, 1, s.coins("12cherry"))
+				keeper.SetCommitmentReward(store, s.addr1, 2, s.coins("21cherry"))
+			},
+			addr: s.addr1,
+			expAmt: []exchange.MarketAmount{
+				{MarketId: 1, Amount: s.coins("11cherry")},
+				{MarketId: 2, Amount: s.coins("21cherry")},
+				{MarketId: 3, Amount: s.coins("31cherry,3grape")},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var actAmt []exchange.MarketAmount
+			testFunc := func() {
+				actAmt = s.k.GetAccountCommitmentRewards(s.ctx, tc.addr)
+			}
+			s.Require().NotPanics(testFunc, "GetAccountCommitmentRewards(%s)", s.getAddrName(tc.addr))
+			s.Assert().Equal(tc.expAmt, actAmt, "GetAccountCommitmentRewards(%s) result", s.getAddrName(tc.addr))
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_FundCommitmentRewards() {
+	tests := []struct {
+		name         string
+		setup        func()
+		bankKeeper   *MockBankKeeper
+		marketID     uint32
+		funder       sdk.AccAddress
+		amount       sdk.Coins
+		expErr       string
+		expBankCalls BankCalls
+		expPool      sdk.Coins
+	}{
+		{
+			name:     "zero amount",
+			marketID: 1,
+			funder:   s.addr1,
+			amount:   nil,
+			expErr:   "cannot fund commitment rewards with zero",
+		},
+		{
+			name:     "negative amount",
+			marketID: 1,
+			funder:   s.addr1,
+			amount:   sdk.Coins{sdk.Coin{Denom: "cherry", Amount: sdkmath.NewInt(-5)}},
+			expErr:   "cannot fund commitment rewards with negative amount \"-5cherry\"",
+		},
+		{
+			name:     "market does not exist",
+			marketID: 1,
+			funder:   s.addr1,
+			amount:   s.coins("5cherry"),
+			expErr:   "market 1 does not exist",
+		},
+		{
+			name: "error sending funds",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{MarketId: 1})
+			},
+			bankKeeper: NewMockBankKeeper().WithSendCoinsResults("not enough cherries"),
+			marketID:   1,
+			funder:     s.addr1,
+			amount:     s.coins("5cherry"),
+			expErr:     "failed to add 5cherry to market 1 commitment reward pool: not enough cherries",
+			expBankCalls: BankCalls{SendCoins: []*SendCoinsArgs{
+				{fromAddr: s.addr1, toAddr: exchange.GetCommitmentRewardsAddress(), amt: s.coins("5cherry")},
+			}},
+		},
+		{
+			name: "empty pool",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{MarketId: 1})
+			},
+			marketID: 1,
+			funder:   s.addr1,
+			amount:   s.coins("5cherry"),
+			expBankCalls: BankCalls{SendCoins: []*SendCoinsArgs{
+				{fromAddr: s.addr1, toAddr: exchange.GetCommitmentRewardsAddress(), amt: s.coins("5cherry")},
+			}},
+			expPool: s.coins("5cherry"),
+		},
+		{
+			name: "existing pool",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{MarketId: 2})
+				keeper.SetCommitmentRewardPool(s.getStore(), 2, s.coins("7cherry,3grape"))
+			},
+			marketID: 2,
+			funder:   s.addr3,
+			amount:   s.coins("5cherry,10plum"),
+			expBankCalls: BankCalls{SendCoins: []*SendCoinsArgs{
+				{fromAddr: s.addr3, toAddr: exchange.GetCommitmentRewardsAddress(), amt: s.coins("5cherry,10plum")},
+			}},
+			expPool: s.coins("12cherry,3grape,10plum"),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+			if tc.bankKeeper == nil {
+				tc.bankKeeper = NewMockBankKeeper()
+			}
+
+			var expEvents sdk.Events
+			if len(tc.expErr) == 0 {
+				expEvents = sdk.Events{s.untypeEvent(exchange.NewEventCommitmentRewardsFunded(tc.marketID, tc.funder.String(), tc.amount))}
+			}
+
+			kpr := s.k.WithBankKeeper(tc.bankKeeper)
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			var err error
+			testFunc := func() {
+				err = kpr.FundCommitmentRewards(ctx, tc.marketID, tc.funder, tc.amount)
+			}
+			s.Require().NotPanics(testFunc, "FundCommitmentRewards(%d, %s, %q)", tc.marketID, s.getAddrName(tc.funder), tc.amount)
+			s.assertErrorValue(err, tc.expErr, "FundCommitmentRewards error")
+			s.assertBankKeeperCalls(tc.bankKeeper, tc.expBankCalls, "FundCommitmentRewards bank keeper calls")
+			s.assertEqualEvents(expEvents, em.Events(), "FundCommitmentRewards events")
+
+			actPool := s.k.GetCommitmentRewardPool(s.ctx, tc.marketID)
+			s.Assert().Equal(tc.expPool.String(), actPool.String(), "GetCommitmentRewardPool(%d) after FundCommitmentRewards", tc.marketID)
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_DistributeCommitmentRewards() {
+	blockTime := time.Unix(1_700_000_000, 0).UTC()
+	ago := func(offset time.Duration) time.Time {
+		return blockTime.Add(-1 * offset)
+	}
+
+	tests := []struct {
+		name        string
+		setup       func()
+		marketID    uint32
+		amount      sdk.Coins
+		basisDenom  string
+		expErr      string
+		expDist     sdk.Coins
+		expPool     sdk.Coins
+		expRewards  []exchange.CommitmentReward
+		expAccruals []exchange.CommitmentAccrual
+	}{
+		{
+			name:       "zero amount",
+			marketID:   1,
+			amount:     nil,
+			basisDenom: "apple",
+			expErr:     "cannot distribute zero commitment rewards",
+		},
+		{
+			name:       "negative amount",
+			marketID:   1,
+			amount:     sdk.Coins{sdk.Coin{Denom: "cherry", Amount: sdkmath.NewInt(-5)}},
+			basisDenom: "apple",
+			expErr:     "cannot distribute negative commitment rewards \"-5cherry\"",
+		},
+		{
+			name: "pool does not have enough",
+			setup: func() {
+				keeper.SetCommitmentRewardPool(s.getStore(), 1, s.coins("4cherry"))
+			},
+			marketID:   1,
+			amount:     s.coins("5cherry"),
+			basisDenom: "apple",
+			expErr:     "market 1 commitment reward pool \"4cherry\" does not have \"5cherry\" to distribute",
+			expPool:    s.coins("4cherry"),
+		},
+		{
+			name: "nothing committed in basis denom",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetCommitmentRewardPool(store, 1, s.coins("5cherry"))
+				keeper.SetCommitmentAmount(store, 1, s.addr1, s.coins("10banana"), ago(time.Hour))
+			},
+			marketID:   1,
+			amount:     s.coins("5cherry"),
+			basisDenom: "apple",
+			expErr:     "no \"apple\" committed to market 1 since the last distribution",
+			expPool:    s.coins("5cherry"),
+			expAccruals: []exchange.CommitmentAccrual{
+				{Account: s.addr1.String(), MarketId: 1, LastAccrued: ago(time.Hour)},
+			},
+		},
+		{
+			name: "committed in basis denom this block",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetCommitmentRewardPool(store, 1, s.coins("5cherry"))
+				keeper.SetCommitmentAmount(store, 1, s.addr1, s.coins("10apple"), blockTime)
+			},
+			marketID:   1,
+			amount:     s.coins("5cherry"),
+			basisDenom: "apple",
+			expErr:     "no \"apple\" committed to market 1 since the last distribution",
+			expPool:    s.coins("5cherry"),
+			expAccruals: []exchange.CommitmentAccrual{
+				{Account: s.addr1.String(), MarketId: 1, LastAccrued: blockTime},
+			},
+		},
+		{
+			name: "even split",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetCommitmentRewardPool(store, 1, s.coins("100cherry"))
+				keeper.SetCommitmentAmount(store, 1, s.addr1, s.coins("10apple"), ago(time.Hour))
+				keeper.SetCommitmentAmount(store, 1, s.addr2, s.coins("10apple,3banana"), ago(time.Hour))
+				keeper.SetCommitmentAmount(store, 1, s.addr3, s.coins("7banana"), ago(time.Hour))
+				keeper.SetCommitmentAmount(store, 2, s.addr3, s.coins("50apple"), ago(time.Hour))
+			},
+			marketID:   1,
+			amount:     s.coins("60cherry"),
+			basisDenom: "apple",
+			expDist:    s.coins("60cherry"),
+			expPool:    s.coins("40cherry"),
+			expRewards: []exchange.CommitmentReward{
+				{Account: s.addr1.String(), MarketId: 1, Amount: s.coins("30cherry")},
+				{Account: s.addr2.String(), MarketId: 1, Amount: s.coins("30cherry")},
+			},
+			expAccruals: []exchange.CommitmentAccrual{
+				{Account: s.addr1.String(), MarketId: 1, LastAccrued: blockTime},
+				{Account: s.addr2.String(), MarketId: 1, Accrued: s.coins("10800banana"), LastAccrued: blockTime},
+				{Account: s.addr3.String(), MarketId: 1, Accrued: s.coins("25200banana"), LastAccrued: blockTime},
+				{Account: s.addr3.String(), MarketId: 2, LastAccrued: ago(time.Hour)},
+			},
+		},
+		{
+			name: "uneven split with remainder and existing rewards",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetCommitmentRewardPool(store, 3, s.coins("100cherry,10grape"))
+				keeper.SetCommitmentAmount(store, 3, s.addr1, s.coins("1apple"), ago(time.Minute))
+				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("2apple"), ago(time.Minute))
+				keeper.SetCommitmentReward(store, s.addr2, 3, s.coins("4cherry"))
+			},
+			marketID:   3,
+			amount:     s.coins("100cherry,10grape"),
+			basisDenom: "apple",
+			expDist:    s.coins("99cherry,9grape"),
+			expPool:    s.coins("1cherry,1grape"),
+			expRewards: []exchange.CommitmentReward{
+				{Account: s.addr1.String(), MarketId: 3, Amount: s.coins("33cherry,3grape")},
+				{Account: s.addr2.String(), MarketId: 3, Amount: s.coins("70cherry,6grape")},
+			},
+			expAccruals: []exchange.CommitmentAccrual{
+				{Account: s.addr1.String(), MarketId: 3, LastAccrued: blockTime},
+				{Account: s.addr2.String(), MarketId: 3, LastAccrued: blockTime},
+			},
+		},
+		{
+			name: "late commitment gets a smaller share",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetCommitmentRewardPool(store, 1, s.coins("3601cherry"))
+				keeper.SetCommitmentAmount(store, 1, s.addr1, s.coins("10apple"), ago(time.Hour))
+				keeper.SetCommitmentAmount(store, 1, s.addr2, s.coins("10apple"), ago(time.Second))
+			},
+			marketID:   1,
+			amount:     s.coins("3601cherry"),
+			basisDenom: "apple",
+			expDist:    s.coins("3601cherry"),
+			expRewards: []exchange.CommitmentReward{
+				{Account: s.addr1.String(), MarketId: 1, Amount: s.coins("3600cherry")},
+				{Account: s.addr2.String(), MarketId: 1, Amount: s.coins("1cherry")},
+			},
+			expAccruals: []exchange.CommitmentAccrual{
+				{Account: s.addr1.String(), MarketId: 1, LastAccrued: blockTime},
+				{Account: s.addr2.String(), MarketId: 1, LastAccrued: blockTime},
+			},
+		},
+		{
+			name: "released commitment still gets a share",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetCommitmentRewardPool(store, 1, s.coins("30cherry"))
+				keeper.SetCommitmentAmount(store, 1, s.addr1, s.coins("10apple"), ago(time.Hour))
+				keeper.SetCommitmentAmount(store, 1, s.addr2, s.coins("10apple"), ago(time.Hour))
+				keeper.SetCommitmentAmount(store, 1, s.addr2, nil, ago(30*time.Minute))
+			},
+			marketID:   1,
+			amount:     s.coins("30cherry"),
+			basisDenom: "apple",
+			expDist:    s.coins("30cherry"),
+			expRewards: []exchange.CommitmentReward{
+				{Account: s.addr1.String(), MarketId: 1, Amount: s.coins("20cherry")},
+				{Account: s.addr2.String(), MarketId: 1, Amount: s.coins("10cherry")},
+			},
+			expAccruals: []exchange.CommitmentAccrual{
+				{Account: s.addr1.String(), MarketId: 1, LastAccrued: blockTime},
+			},
+		},
+		{
+			name: "previous distribution in same basis denom",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetCommitmentRewardPool(store, 1, s.coins("30cherry"))
+				keeper.SetCommitmentAmount(store, 1, s.addr1, s.coins("10apple"), ago(time.Hour))
+				keeper.SetCommitmentAmount(store, 1, s.addr2, s.coins("20apple"), ago(time.Minute))
+				keeper.SetCommitmentAccrual(store, 1, s.addr1, ago(time.Minute), s.coins("1banana"))
+			},
+			marketID:   1,
+			amount:     s.coins("30cherry"),
+			basisDenom: "apple",
+			expDist:    s.coins("30cherry"),
+			expRewards: []exchange.CommitmentReward{
+				{Account: s.addr1.String(), MarketId: 1, Amount: s.coins("10cherry")},
+				{Account: s.addr2.String(), MarketId: 1, Amount: s.coins("20cherry")},
+			},
+			expAccruals: []exchange.CommitmentAccrual{
+				{Account: s.addr1.String(), MarketId: 1, Accrued: s.coins("1banana"), LastAccrued: blockTime},
+				{Account: s.addr2.String(), MarketId: 1, LastAccrued: blockTime},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var expEvents sdk.Events
+			if len(tc.expErr) == 0 {
+				expEvents = sdk.Events{s.untypeEvent(exchange.NewEventCommitmentRewardsDistributed(tc.marketID, tc.expDist, tc.basisDenom, "testing"))}
+			}
+
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em).WithBlockTime(blockTime)
+			var err error
+			testFunc := func() {
+				err = s.k.DistributeCommitmentRewards(ctx, tc.marketID, tc.amount, tc.basisDenom, "testing")
+			}
+			s.Require().NotPanics(testFunc, "DistributeCommitmentRewards(%d, %q, %q)", tc.marketID, tc.amount, tc.basisDenom)
+			s.assertErrorValue(err, tc.expErr, "DistributeCommitmentRewards error")
+			s.assertEqualEvents(expEvents, em.Events(), "DistributeCommitmentRewards events")
+
+			actPool := s.k.GetCommitmentRewardPool(s.ctx, tc.marketID)
+			s.Assert().Equal(tc.expPool.String(), actPool.String(), "GetCommitmentRewardPool(%d) after DistributeCommitmentRewards", tc.marketID)
+
+			var actRewards []exchange.CommitmentReward
+			s.k.IterateCommitmentRewards(s.ctx, func(reward exchange.CommitmentReward) bool {
+				actRewards = append(actRewards, reward)
+				return false
+			})
+			s.Assert().ElementsMatch(tc.expRewards, actRewards, "commitment rewards after DistributeCommitmentRewards")
+
+			var actAccruals []exchange.CommitmentAccrual
+			s.k.IterateCommitmentAccruals(s.ctx, func(accrual exchange.CommitmentAccrual) bool {
+				actAccruals = append(actAccruals, accrual)
+				return false
+			})
+			s.Assert().ElementsMatch(tc.expAccruals, actAccruals, "commitment accruals after DistributeCommitmentRewards")
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_ClaimCommitmentRewards() {
+	tests := []struct {
+		name         string
+		setup        func()
+		bankKeeper   *MockBankKeeper
+		marketID     uint32
+		addr         sdk.AccAddress
+		expErr       string
+		expAmt       sdk.Coins
+		expBankCalls BankCalls
+		expLeft      sdk.Coins
+	}{
+		{
+			name:     "no rewards",
+			marketID: 1,
+			addr:     s.addr1,
+			expErr:   "account " + s.addr1.String() + " does not have any commitment rewards to claim from market 1",
+		},
+		{
+			name: "blocked address",
+			setup: func() {
+				keeper.SetCommitmentReward(s.getStore(), s.addr1, 1, s.coins("5cherry"))
+			},
+			bankKeeper:   NewMockBankKeeper().WithBlockedAddrResults(true),
+			marketID:     1,
+			addr:         s.addr1,
+			expErr:       s.addr1.String() + " is not allowed to receive funds",
+			expBankCalls: BankCalls{BlockedAddr: []sdk.AccAddress{s.addr1}},
+			expLeft:      s.coins("5cherry"),
+		},
+		{
+			name: "error sending funds",
+			setup: func() {
+				keeper.SetCommitmentReward(s.getStore(), s.addr1, 1, s.coins("5cherry"))
+			},
+			bankKeeper: NewMockBankKeeper().WithSendCoinsResults("oops, no cherries"),
+			marketID:   1,
+			addr:       s.addr1,
+			expErr:     "failed to send commitment rewards 5cherry from market 1: oops, no cherries",
+			expBankCalls: BankCalls{
+				BlockedAddr: []sdk.AccAddress{s.addr1},
+				SendCoins: []*SendCoinsArgs{
+					{ctxHasQuarantineBypass: true, fromAddr: exchange.GetCommitmentRewardsAddress(), toAddr: s.addr1, amt: s.coins("5cherry")},
+				},
+			},
+			expLeft: s.coins("5cherry"),
+		},
+		{
+			name: "rewards claimed",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetCommitmentReward(store, s.addr1, 1, s.coins("11cherry"))
+				keeper.SetCommitmentReward(store, s.addr2, 2, s.coins("22cherry,2grape"))
+				keeper.SetCommitmentReward(store, s.addr2, 3, s.coins("32cherry"))
+			},
+			marketID: 2,
+			addr:     s.addr2,
+			expAmt:   s.coins("22cherry,2grape"),
+			expBankCalls: BankCalls{
+				BlockedAddr: []sdk.AccAddress{s.addr2},
+				SendCoins: []*SendCoinsArgs{
+					{ctxHasQuarantineBypass: true, fromAddr: exchange.GetCommitmentRewardsAddress(), toAddr: s.addr2, amt: s.coins("22cherry,2grape")},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+			if tc.bankKeeper == nil {
+				tc.bankKeeper = NewMockBankKeeper()
+			}
+
+			var expEvents sdk.Events
+			if len(tc.expErr) == 0 {
+				expEvents = sdk.Events{s.untypeEvent(exchange.NewEventCommitmentRewardsClaimed(tc.addr.String(), tc.marketID, tc.expAmt))}
+			}
+
+			kpr := s.k.WithBankKeeper(tc.bankKeeper)
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			var actAmt sdk.Coins
+			var err error
+			testFunc := func() {
+				actAmt, err = kpr.ClaimCommitmentRewards(ctx, tc.marketID, tc.addr)
+			}
+			s.Require().NotPanics(testFunc, "ClaimCommitmentRewards(%d, %s)", tc.marketID, s.getAddrName(tc.addr))
+			s.assertErrorValue(err, tc.expErr, "ClaimCommitmentRewards error")
+			s.Assert().Equal(tc.expAmt.String(), actAmt.String(), "ClaimCommitmentRewards amount")
+			s.assertBankKeeperCalls(tc.bankKeeper, tc.expBankCalls, "ClaimCommitmentRewards bank keeper calls")
+			s.assertEqualEvents(expEvents, em.Events(), "ClaimCommitmentRewards events")
+
+			actLeft := s.k.GetCommitmentReward(s.ctx, tc.addr, tc.marketID)
+			s.Assert().Equal(tc.expLeft.String(), actLeft.String(), "GetCommitmentReward(%s, %d) after ClaimCommitmentRewards", s.getAddrName(tc.addr), tc.marketID)
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_CommitmentRewardsWithRestrictedCoin() {
+	// The reward pool address doesn't have the marker's required attribute, so this only works if it's a bypass address.
+	reqAttr := "rewards.kyc.test"
+	s.requireSetNameRecord(reqAttr, s.addr5)
+	s.requireSetAttr(s.addr1, reqAttr, s.addr5)
+	s.requireSetAttr(s.addr2, reqAttr, s.addr5)
+	s.requireAddFinalizeAndActivateMarker(s.coin("1000rcoin"), s.addr5, reqAttr)
+	s.requireFundAccount(s.addr1, "50rcoin")
+	s.requireCreateMarketUnmocked(exchange.Market{MarketId: 1})
+
+	pool := exchange.GetCommitmentRewardsAddress()
+	s.addAddrLookup(pool, "pool")
+
+	err := s.k.FundCommitmentRewards(s.ctx, 1, s.addr1, s.coins("20rcoin"))
+	s.Require().NoError(err, "FundCommitmentRewards")
+	s.checkBalances(expBalances{addr: s.addr1, expBal: s.coins("30rcoin")})
+	s.checkBalances(expBalances{addr: pool, expBal: s.coins("20rcoin")})
+
+	// Skip the distribution; it doesn't move any funds.
+	keeper.SetCommitmentRewardPool(s.getStore(), 1, nil)
+	keeper.SetCommitmentReward(s.getStore(), s.addr2, 1, s.coins("20rcoin"))
+
+	claimed, err := s.k.ClaimCommitmentRewards(s.ctx, 1, s.addr2)
+	s.Require().NoError(err, "ClaimCommitmentRewards")
+	s.Assert().Equal("20rcoin", claimed.String(), "ClaimCommitmentRewards result")
+	s.checkBalances(expBalances{addr: pool, expBal: s.zeroCoins("rcoin")})
+	s.checkBalances(expBalances{addr: s.addr2, expBal: s.coins("20rcoin")})
+}
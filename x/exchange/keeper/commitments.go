@@ -3,6 +3,7 @@ package keeper
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
@@ -52,21 +53,55 @@ func parseCommitmentKeyValue(keyPrefix, keySuffix, value []byte) (*exchange.Comm
 }
 
 // setCommitmentAmount sets the amount that the given address has committed to the provided market.
-// If the amount is zero, the entry is deleted.
-func setCommitmentAmount(store storetypes.KVStore, marketID uint32, addr sdk.AccAddress, amount sdk.Coins) {
+// If the amount is zero, the entry is deleted along with any expiration it had.
+// The previous amount is first accrued up to the provided block time.
+func setCommitmentAmount(store storetypes.KVStore, marketID uint32, addr sdk.AccAddress, amount sdk.Coins, blockTime time.Time) {
+	accrued := accrueCommitment(store, marketID, addr, blockTime)
 	key := MakeKeyCommitment(marketID, addr)
 	if !amount.IsZero() {
 		value := amount.String()
 		store.Set(key, []byte(value))
 	} else {
 		store.Delete(key)
+		setCommitmentExpiration(store, marketID, addr, nil)
+		if accrued.IsZero() {
+			store.Delete(MakeKeyCommitmentAccrual(marketID, addr))
+		}
+	}
+}
+
+// getCommitmentExpiration gets the expiration of the commitment the given address has in the provided market.
+// Returns nil if the commitment doesn't have an expiration.
+func getCommitmentExpiration(store storetypes.KVStore, marketID uint32, addr sdk.AccAddress) *time.Time {
+	value := store.Get(MakeKeyCommitmentExpiration(marketID, addr))
+	expSecs, ok := uint64FromBz(value)
+	if !ok {
+		return nil
+	}
+	rv := time.Unix(int64(expSecs), 0).UTC() //nolint:gosec // G115: We wrote it from an int64.
+	return &rv
+}
+
+// setCommitmentExpiration sets (or replaces) the expiration of the commitment the given address has in the provided market.
+// If the expiration is nil, any existing expiration (and its index entry) is deleted.
+func setCommitmentExpiration(store storetypes.KVStore, marketID uint32, addr sdk.AccAddress, expiration *time.Time) {
+	key := MakeKeyCommitmentExpiration(marketID, addr)
+	if cur := getCommitmentExpiration(store, marketID, addr); cur != nil {
+		store.Delete(MakeIndexKeyExpirationToCommitment(*cur, marketID, addr))
+		store.Delete(key)
 	}
+	if expiration == nil {
+		return
+	}
+	expSecs := uint64(expiration.Unix()) //nolint:gosec // G115: Expirations are validated to be after the epoch.
+	store.Set(key, uint64Bz(expSecs))
+	store.Set(MakeIndexKeyExpirationToCommitment(*expiration, marketID, addr), []byte{})
 }
 
 // addCommitmentAmount adds the provided amount to the funds committed by the addr to the given market.
-func addCommitmentAmount(store storetypes.KVStore, marketID uint32, addr sdk.AccAddress, amount sdk.Coins) {
+func addCommitmentAmount(store storetypes.KVStore, marketID uint32, addr sdk.AccAddress, amount sdk.Coins, blockTime time.Time) {
 	cur := getCommitmentAmount(store, marketID, addr)
-	setCommitmentAmount(store, marketID, addr, cur.Add(amount...))
+	setCommitmentAmount(store, marketID, addr, cur.Add(amount...), blockTime)
 }
 
 // validateMarketIsAcceptingCommitments makes sure the market exists and is accepting commitments.
@@ -93,11 +128,35 @@ func (k Keeper) GetCommitmentAmount(ctx sdk.Context, marketID uint32, addr sdk.A
 	return getCommitmentAmount(k.getStore(ctx), marketID, addr)
 }
 
+// GetCommitmentExpiration gets the expiration of the commitment the given address has in the provided market.
+// Returns nil if the commitment doesn't have an expiration.
+func (k Keeper) GetCommitmentExpiration(ctx sdk.Context, marketID uint32, addr sdk.AccAddress) *time.Time {
+	return getCommitmentExpiration(k.getStore(ctx), marketID, addr)
+}
+
+// SetCommitmentExpiration sets the time after which all funds committed by the addr to the given market are
+// automatically released. Any existing expiration of the commitment is replaced.
+// The expiration must be after the current block time, and the addr must have funds committed to the market.
+func (k Keeper) SetCommitmentExpiration(ctx sdk.Context, marketID uint32, addr sdk.AccAddress, expiration time.Time) error {
+	if err := validateOrderExpiration(ctx, &expiration); err != nil {
+		return err
+	}
+
+	store := k.getStore(ctx)
+	if getCommitmentAmount(store, marketID, addr).IsZero() {
+		return fmt.Errorf("account %s does not have any funds committed to market %d", addr, marketID)
+	}
+
+	setCommitmentExpiration(store, marketID, addr, &expiration)
+	return nil
+}
+
 // addCommitment commits the provided amount by the addr to the given market, and places a hold on them.
 // If the addr already has funds committed to the market, the provided amount is added to that.
 // Otherwise a new commitment record is created.
 //
-// If doMarketChecks = true, the market must be accepting commitments and the addr must have the required attributes.
+// If doMarketChecks = true, the market must be accepting commitments, the addr must have the required attributes,
+// and the new amount cannot put the addr over the market's max exposure.
 func (k Keeper) addCommitment(ctx sdk.Context, marketID uint32, addr sdk.AccAddress, amount sdk.Coins, eventTag string, doMarketChecks bool) error {
 	if amount.IsZero() {
 		return nil
@@ -114,6 +173,9 @@ func (k Keeper) addCommitment(ctx sdk.Context, marketID uint32, addr sdk.AccAddr
 		if err := k.validateUserCanCreateCommitment(ctx, marketID, addr); err != nil {
 			return err
 		}
+		if err := k.validateExposure(ctx, store, marketID, addr, amount, nil); err != nil {
+			return err
+		}
 	}
 
 	err := k.holdKeeper.AddHold(ctx, addr, amount, fmt.Sprintf("x/exchange: commitment to %d", marketID))
@@ -121,8 +183,9 @@ func (k Keeper) addCommitment(ctx sdk.Context, marketID uint32, addr sdk.AccAddr
 		return err
 	}
 
-	addCommitmentAmount(k.getStore(ctx), marketID, addr, amount)
+	addCommitmentAmount(k.getStore(ctx), marketID, addr, amount, ctx.BlockTime())
 	k.emitEvent(ctx, exchange.NewEventFundsCommitted(addr.String(), marketID, amount, eventTag))
+	incCommitmentActionCounter(marketID, exchange.TelemetryActionCommitted)
 	return nil
 }
 
@@ -183,8 +246,9 @@ func (k Keeper) ReleaseCommitment(ctx sdk.Context, marketID uint32, addr sdk.Acc
 		return err
 	}
 
-	setCommitmentAmount(store, marketID, addr, newAmt)
+	setCommitmentAmount(store, marketID, addr, newAmt, ctx.BlockTime())
 	k.emitEvent(ctx, exchange.NewEventCommitmentReleased(addr.String(), marketID, toRelease, eventTag))
+	incCommitmentActionCounter(marketID, exchange.TelemetryActionReleased)
 	return nil
 }
 
@@ -234,14 +298,77 @@ func (k Keeper) ReleaseAllCommitmentsForMarket(ctx sdk.Context, marketID uint32)
 	}
 }
 
+// ExpireCommitments releases all commitments with an expiration at or before the block time.
+// At most limit commitments are released (0 = no limit); any others are picked up by a later call.
+// Returns the number of commitments that were released.
+func (k Keeper) ExpireCommitments(ctx sdk.Context, limit int) int {
+	store := k.getStore(ctx)
+	start := GetIndexKeyPrefixExpirationToCommitment()
+	end := storetypes.PrefixEndBytes(GetIndexKeyPrefixExpirationToCommitmentAt(ctx.BlockTime()))
+
+	// Gather the keys first so we aren't writing to the store while iterating it.
+	var keys [][]byte
+	iter := store.Iterator(start, end)
+	for ; iter.Valid() && (limit == 0 || len(keys) < limit); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	count := 0
+	for _, key := range keys {
+		_, marketID, addr, err := ParseIndexKeyExpirationToCommitment(key)
+		if err != nil {
+			k.logErrorf(ctx, "invalid commitment expiration index entry %v (deleted): %v", key, err)
+			store.Delete(key)
+			continue
+		}
+
+		if getCommitmentAmount(store, marketID, addr).IsZero() {
+			k.logErrorf(ctx, "no funds committed by %s to market %d (expiration deleted)", addr, marketID)
+			setCommitmentExpiration(store, marketID, addr, nil)
+			store.Delete(key)
+			continue
+		}
+
+		if err = k.expireCommitment(ctx, marketID, addr); err != nil {
+			k.logErrorf(ctx, "could not expire commitment of %s to market %d: %v", addr, marketID, err)
+			continue
+		}
+		count++
+	}
+
+	return count
+}
+
+// expireCommitment releases all funds committed by the addr to the given market.
+// Nothing is changed if there's an error.
+func (k Keeper) expireCommitment(ctx sdk.Context, marketID uint32, addr sdk.AccAddress) error {
+	cacheCtx, writeCache := ctx.CacheContext()
+	store := k.getStore(cacheCtx)
+
+	amount := getCommitmentAmount(store, marketID, addr)
+	if err := k.holdKeeper.ReleaseHold(cacheCtx, addr, amount); err != nil {
+		return fmt.Errorf("unable to release hold on committed funds %q: %w", amount, err)
+	}
+
+	// Setting the amount to zero also deletes the expiration and its index entry.
+	setCommitmentAmount(store, marketID, addr, nil, cacheCtx.BlockTime())
+	k.emitEvent(cacheCtx, exchange.NewEventCommitmentExpired(addr.String(), marketID, amount))
+	writeCache()
+	incCommitmentActionCounter(marketID, exchange.TelemetryActionExpired)
+	return nil
+}
+
 // IterateCommitments iterates over all commitment entries in the store.
 func (k Keeper) IterateCommitments(ctx sdk.Context, cb func(commitment exchange.Commitment) bool) {
+	store := k.getStore(ctx)
 	keyPrefix := GetKeyPrefixCommitments()
 	k.iterate(ctx, keyPrefix, func(keySuffix, value []byte) bool {
 		commitment, err := parseCommitmentKeyValue(keyPrefix, keySuffix, value)
 		if err != nil || commitment == nil {
 			return false
 		}
+		commitment.Expiration = getCommitmentExpiration(store, commitment.MarketId, sdk.MustAccAddressFromBech32(commitment.Account))
 		return cb(*commitment)
 	})
 }
@@ -376,6 +503,8 @@ func (k Keeper) SettleCommitments(ctx sdk.Context, req *exchange.MsgMarketCommit
 
 	// Record all the navs.
 	k.recordNAVs(ctx, req.MarketId, req.Navs)
+	k.recordNAVHistory(ctx, k.getStore(ctx), req.MarketId, req.Navs)
+	k.publishPrices(ctx, k.getStore(ctx), req.MarketId, req.Navs)
 
 	// Build the transfers
 	inputs := exchange.SimplifyAccountAmounts(req.Inputs)
@@ -414,37 +543,52 @@ func (k Keeper) SettleCommitments(ctx sdk.Context, req *exchange.MsgMarketCommit
 		return fmt.Errorf("failed to re-commit funds after transfer: %w", err)
 	}
 
+	if err = k.Hooks().OnCommitmentSettled(ctx, req.MarketId, inputs, outputs, fees); err != nil {
+		return err
+	}
+
+	// Activate any trigger orders that the navs cross.
+	k.activateTriggerOrders(ctx, req.MarketId, req.Navs)
+
 	return nil
 }
 
-// TransferCommitment transfers committed funds from one market to another.
+// TransferCommitments transfers committed funds from one market to another.
 func (k Keeper) TransferCommitments(ctx sdk.Context, req *exchange.MsgMarketTransferCommitmentRequest) error {
 	account, err := sdk.AccAddressFromBech32(req.Account)
 	if err != nil {
 		return fmt.Errorf("invalid account %q: %w", account, err)
 	}
+	return k.MoveCommitment(ctx, req.CurrentMarketId, req.NewMarketId, account, req.Amount, req.EventTag)
+}
 
+// MoveCommitment moves funds committed by an address from one market to another.
+// The funds stay on hold the whole time; they are never released back to the account.
+// The new market must be accepting commitments and the address must have the attributes required to commit to it.
+func (k Keeper) MoveCommitment(ctx sdk.Context, currentMarketID, newMarketID uint32, addr sdk.AccAddress, amount sdk.Coins, eventTag string) error {
 	store := k.getStore(ctx)
-	if err := k.validateTransferCommitments(ctx, store, req, account); err != nil {
+	if err := k.validateTransferCommitment(ctx, store, currentMarketID, newMarketID, addr, amount); err != nil {
 		return err
 	}
 
-	currentAmount := getCommitmentAmount(store, req.CurrentMarketId, account)
+	currentAmount := getCommitmentAmount(store, currentMarketID, addr)
 	if currentAmount.IsZero() {
-		return fmt.Errorf("account %s does not have any funds committed to market %d", account, req.CurrentMarketId)
+		return fmt.Errorf("account %s does not have any funds committed to market %d", addr, currentMarketID)
 	}
-	newAmt, isNeg := currentAmount.SafeSub(req.Amount...)
+	newAmt, isNeg := currentAmount.SafeSub(amount...)
 	if isNeg {
 		return fmt.Errorf("commitment amount to transfer %q is more than currently committed amount %q for %s in market %d",
-			req.Amount, currentAmount, account, req.CurrentMarketId)
+			amount, currentAmount, addr, currentMarketID)
 	}
 	// subtract requested amount and store the new balance for the current market
-	setCommitmentAmount(store, req.CurrentMarketId, sdk.AccAddress(req.Account), newAmt)
-	k.emitEvent(ctx, exchange.NewEventCommitmentReleased(req.Account, req.CurrentMarketId, req.Amount, req.EventTag))
+	setCommitmentAmount(store, currentMarketID, addr, newAmt, ctx.BlockTime())
+	k.emitEvent(ctx, exchange.NewEventCommitmentReleased(addr.String(), currentMarketID, amount, eventTag))
 
 	// update the commitment to new market for given account
-	addCommitmentAmount(store, req.NewMarketId, account, req.Amount)
-	k.emitEvent(ctx, exchange.NewEventFundsCommitted(req.Account, req.NewMarketId, req.Amount, req.EventTag))
+	addCommitmentAmount(store, newMarketID, addr, amount, ctx.BlockTime())
+	k.emitEvent(ctx, exchange.NewEventFundsCommitted(addr.String(), newMarketID, amount, eventTag))
+	incCommitmentActionCounter(currentMarketID, exchange.TelemetryActionReleased)
+	incCommitmentActionCounter(newMarketID, exchange.TelemetryActionCommitted)
 	return nil
 }
 
@@ -458,29 +602,30 @@ func (k Keeper) consumeCommitmentSettlementFee(ctx sdk.Context, req *exchange.Ms
 	return nil
 }
 
-// validateTransferCommitments which validate TransferCommitments message request.
-func (k Keeper) validateTransferCommitments(
+// validateTransferCommitment returns an error if the amount cannot be transferred to the new market.
+func (k Keeper) validateTransferCommitment(
 	ctx sdk.Context,
 	store storetypes.KVStore,
-	req *exchange.MsgMarketTransferCommitmentRequest,
-	account sdk.AccAddress,
+	currentMarketID, newMarketID uint32,
+	addr sdk.AccAddress,
+	amount sdk.Coins,
 ) error {
-	if req.Amount.IsZero() {
-		return fmt.Errorf("cannot transfer zero for %s in market %d", account, req.CurrentMarketId)
+	if amount.IsZero() {
+		return fmt.Errorf("cannot transfer zero for %s in market %d", addr, currentMarketID)
 	}
 	// Negative amount
-	if req.Amount.IsAnyNegative() {
-		return fmt.Errorf("cannot transfer negative commitment amount %q for %s in market %d", req.Amount, req.Account, req.CurrentMarketId)
+	if amount.IsAnyNegative() {
+		return fmt.Errorf("cannot transfer negative commitment amount %q for %s in market %d", amount, addr, currentMarketID)
 	}
 
 	// Market validity
-	if err := validateMarketIsAcceptingCommitments(store, req.NewMarketId); err != nil {
-		return fmt.Errorf("new market %d is invalid: %w", req.NewMarketId, err)
+	if err := validateMarketIsAcceptingCommitments(store, newMarketID); err != nil {
+		return fmt.Errorf("new market %d is invalid: %w", newMarketID, err)
 	}
 
 	// User permission
-	if err := k.validateUserCanCreateCommitment(ctx, req.NewMarketId, account); err != nil {
-		return fmt.Errorf("account %s does not have permission to create commitments in market %d: %w", account, req.NewMarketId, err)
+	if err := k.validateUserCanCreateCommitment(ctx, newMarketID, addr); err != nil {
+		return fmt.Errorf("account %s does not have permission to create commitments in market %d: %w", addr, newMarketID, err)
 	}
 
 	return nil
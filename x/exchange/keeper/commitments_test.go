@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -34,10 +35,10 @@ func (s *TestSuite) TestKeeper_GetCommitmentAmount() {
 			name: "market has commitments from other addrs, not this one",
 			setup: func() {
 				store := s.getStore()
-				keeper.SetCommitmentAmount(store, 3, s.addr1, s.coins("31apple"))
-				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32apple"))
-				keeper.SetCommitmentAmount(store, 3, s.addr4, s.coins("34apple"))
-				keeper.SetCommitmentAmount(store, 3, s.addr5, s.coins("35apple"))
+				keeper.SetCommitmentAmount(store, 3, s.addr1, s.coins("31apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, s.addr4, s.coins("34apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, s.addr5, s.coins("35apple"), s.ctx.BlockTime())
 			},
 			marketID: 3,
 			addr:     s.addr3,
@@ -47,10 +48,10 @@ func (s *TestSuite) TestKeeper_GetCommitmentAmount() {
 			name: "addr has commitments in other markets, not this one",
 			setup: func() {
 				store := s.getStore()
-				keeper.SetCommitmentAmount(store, 1, s.addr3, s.coins("13apple"))
-				keeper.SetCommitmentAmount(store, 2, s.addr3, s.coins("23apple"))
-				keeper.SetCommitmentAmount(store, 4, s.addr3, s.coins("43apple"))
-				keeper.SetCommitmentAmount(store, 5, s.addr3, s.coins("53apple"))
+				keeper.SetCommitmentAmount(store, 1, s.addr3, s.coins("13apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr3, s.coins("23apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 4, s.addr3, s.coins("43apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 5, s.addr3, s.coins("53apple"), s.ctx.BlockTime())
 			},
 			marketID: 3,
 			addr:     s.addr3,
@@ -60,23 +61,23 @@ func (s *TestSuite) TestKeeper_GetCommitmentAmount() {
 			name: "one coin committed",
 			setup: func() {
 				store := s.getStore()
-				keeper.SetCommitmentAmount(store, 2, s.addr1, s.coins("21apple"))
-				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("22apple"))
-				keeper.SetCommitmentAmount(store, 2, s.addr3, s.coins("23apple"))
-				keeper.SetCommitmentAmount(store, 2, s.addr4, s.coins("24apple"))
-				keeper.SetCommitmentAmount(store, 2, s.addr5, s.coins("25apple"))
-
-				keeper.SetCommitmentAmount(store, 3, s.addr1, s.coins("31apple"))
-				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32apple"))
-				keeper.SetCommitmentAmount(store, 3, s.addr3, s.coins("33apple"))
-				keeper.SetCommitmentAmount(store, 3, s.addr4, s.coins("34apple"))
-				keeper.SetCommitmentAmount(store, 3, s.addr5, s.coins("35apple"))
-
-				keeper.SetCommitmentAmount(store, 4, s.addr1, s.coins("41apple"))
-				keeper.SetCommitmentAmount(store, 4, s.addr2, s.coins("42apple"))
-				keeper.SetCommitmentAmount(store, 4, s.addr3, s.coins("43apple"))
-				keeper.SetCommitmentAmount(store, 4, s.addr4, s.coins("44apple"))
-				keeper.SetCommitmentAmount(store, 4, s.addr5, s.coins("45apple"))
+				keeper.SetCommitmentAmount(store, 2, s.addr1, s.coins("21apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("22apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr3, s.coins("23apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr4, s.coins("24apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr5, s.coins("25apple"), s.ctx.BlockTime())
+
+				keeper.SetCommitmentAmount(store, 3, s.addr1, s.coins("31apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, s.addr3, s.coins("33apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, s.addr4, s.coins("34apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, s.addr5, s.coins("35apple"), s.ctx.BlockTime())
+
+				keeper.SetCommitmentAmount(store, 4, s.addr1, s.coins("41apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 4, s.addr2, s.coins("42apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 4, s.addr3, s.coins("43apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 4, s.addr4, s.coins("44apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 4, s.addr5, s.coins("45apple"), s.ctx.BlockTime())
 			},
 			marketID: 3,
 			addr:     s.addr3,
@@ -86,17 +87,17 @@ func (s *TestSuite) TestKeeper_GetCommitmentAmount() {
 			name: "three coins committed",
 			setup: func() {
 				store := s.getStore()
-				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("22apple"))
-				keeper.SetCommitmentAmount(store, 2, s.addr3, s.coins("23apple"))
-				keeper.SetCommitmentAmount(store, 2, s.addr4, s.coins("24apple"))
+				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("22apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr3, s.coins("23apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr4, s.coins("24apple"), s.ctx.BlockTime())
 
-				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32apple"))
-				keeper.SetCommitmentAmount(store, 3, s.addr3, s.coins("33apple,133banana,233cherry"))
-				keeper.SetCommitmentAmount(store, 3, s.addr4, s.coins("34apple"))
+				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, s.addr3, s.coins("33apple,133banana,233cherry"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, s.addr4, s.coins("34apple"), s.ctx.BlockTime())
 
-				keeper.SetCommitmentAmount(store, 4, s.addr2, s.coins("42apple"))
-				keeper.SetCommitmentAmount(store, 4, s.addr3, s.coins("43apple"))
-				keeper.SetCommitmentAmount(store, 4, s.addr4, s.coins("44apple"))
+				keeper.SetCommitmentAmount(store, 4, s.addr2, s.coins("42apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 4, s.addr3, s.coins("43apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 4, s.addr4, s.coins("44apple"), s.ctx.BlockTime())
 			},
 			marketID: 3,
 			addr:     s.addr3,
@@ -146,15 +147,15 @@ func (s *TestSuite) TestKeeper_AddCommitment() {
 				ReqAttrCreateCommitment:  reqAttr,
 			})
 			store := s.getStore()
-			keeper.SetCommitmentAmount(store, 1, s.addr1, s.coins("11apple"))
-			keeper.SetCommitmentAmount(store, 1, s.addr2, s.coins("12apple"))
-			keeper.SetCommitmentAmount(store, 1, s.addr4, s.coins("14apple"))
-			keeper.SetCommitmentAmount(store, 2, s.addr1, s.coins("21apple"))
-			keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("22apple"))
-			keeper.SetCommitmentAmount(store, 2, s.addr4, s.coins("24apple"))
-			keeper.SetCommitmentAmount(store, 3, s.addr1, s.coins("31apple"))
-			keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32apple"))
-			keeper.SetCommitmentAmount(store, 3, s.addr4, s.coins("34apple"))
+			keeper.SetCommitmentAmount(store, 1, s.addr1, s.coins("11apple"), s.ctx.BlockTime())
+			keeper.SetCommitmentAmount(store, 1, s.addr2, s.coins("12apple"), s.ctx.BlockTime())
+			keeper.SetCommitmentAmount(store, 1, s.addr4, s.coins("14apple"), s.ctx.BlockTime())
+			keeper.SetCommitmentAmount(store, 2, s.addr1, s.coins("21apple"), s.ctx.BlockTime())
+			keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("22apple"), s.ctx.BlockTime())
+			keeper.SetCommitmentAmount(store, 2, s.addr4, s.coins("24apple"), s.ctx.BlockTime())
+			keeper.SetCommitmentAmount(store, 3, s.addr1, s.coins("31apple"), s.ctx.BlockTime())
+			keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32apple"), s.ctx.BlockTime())
+			keeper.SetCommitmentAmount(store, 3, s.addr4, s.coins("34apple"), s.ctx.BlockTime())
 		}
 	}
 
@@ -288,7 +289,7 @@ func (s *TestSuite) TestKeeper_AddCommitment() {
 					IntermediaryDenom:        "cherry",
 				})
 				store := s.getStore()
-				keeper.SetCommitmentAmount(store, 2, s.addr3, s.coins("23apple"))
+				keeper.SetCommitmentAmount(store, 2, s.addr3, s.coins("23apple"), s.ctx.BlockTime())
 			},
 			marketID:    2,
 			addr:        s.addr3,
@@ -307,9 +308,9 @@ func (s *TestSuite) TestKeeper_AddCommitment() {
 					IntermediaryDenom:        "cherry",
 				})
 				store := s.getStore()
-				keeper.SetCommitmentAmount(store, 2, s.addr1, s.coins("21apple"))
-				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("22apple"))
-				keeper.SetCommitmentAmount(store, 2, s.addr4, s.coins("24apple"))
+				keeper.SetCommitmentAmount(store, 2, s.addr1, s.coins("21apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("22apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr4, s.coins("24apple"), s.ctx.BlockTime())
 			},
 			marketID:  2,
 			addr:      s.addr1,
@@ -328,9 +329,9 @@ func (s *TestSuite) TestKeeper_AddCommitment() {
 					ReqAttrCreateCommitment:  []string{"just.some.com.okay"},
 				})
 				store := s.getStore()
-				keeper.SetCommitmentAmount(store, 2, s.addr1, s.coins("21apple"))
-				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("22apple"))
-				keeper.SetCommitmentAmount(store, 2, s.addr4, s.coins("24apple"))
+				keeper.SetCommitmentAmount(store, 2, s.addr1, s.coins("21apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("22apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr4, s.coins("24apple"), s.ctx.BlockTime())
 			},
 			marketID:    2,
 			addr:        s.addr4,
@@ -442,15 +443,15 @@ func (s *TestSuite) TestKeeper_AddCommitment() {
 func (s *TestSuite) TestKeeper_AddCommitmentsUnsafe() {
 	existingSetup := func() {
 		store := s.getStore()
-		keeper.SetCommitmentAmount(store, 1, s.addr1, s.coins("11apple"))
-		keeper.SetCommitmentAmount(store, 1, s.addr2, s.coins("12apple"))
-		keeper.SetCommitmentAmount(store, 1, s.addr4, s.coins("14apple"))
-		keeper.SetCommitmentAmount(store, 2, s.addr1, s.coins("21apple"))
-		keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("22apple"))
-		keeper.SetCommitmentAmount(store, 2, s.addr4, s.coins("24apple"))
-		keeper.SetCommitmentAmount(store, 3, s.addr1, s.coins("31apple"))
-		keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32apple"))
-		keeper.SetCommitmentAmount(store, 3, s.addr4, s.coins("34apple"))
+		keeper.SetCommitmentAmount(store, 1, s.addr1, s.coins("11apple"), s.ctx.BlockTime())
+		keeper.SetCommitmentAmount(store, 1, s.addr2, s.coins("12apple"), s.ctx.BlockTime())
+		keeper.SetCommitmentAmount(store, 1, s.addr4, s.coins("14apple"), s.ctx.BlockTime())
+		keeper.SetCommitmentAmount(store, 2, s.addr1, s.coins("21apple"), s.ctx.BlockTime())
+		keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("22apple"), s.ctx.BlockTime())
+		keeper.SetCommitmentAmount(store, 2, s.addr4, s.coins("24apple"), s.ctx.BlockTime())
+		keeper.SetCommitmentAmount(store, 3, s.addr1, s.coins("31apple"), s.ctx.BlockTime())
+		keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32apple"), s.ctx.BlockTime())
+		keeper.SetCommitmentAmount(store, 3, s.addr4, s.coins("34apple"), s.ctx.BlockTime())
 	}
 	eventTag := "justsometag"
 	reason := func(marketID uint32) string {
@@ -683,7 +684,7 @@ func (s *TestSuite) TestKeeper_ReleaseCommitment() {
 			name: "amount to release more than committed: same denom",
 			setup: func() {
 				store := s.getStore()
-				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("10apple"))
+				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("10apple"), s.ctx.BlockTime())
 			},
 			marketID: 2,
 			addr:     s.addr2,
@@ -696,7 +697,7 @@ func (s *TestSuite) TestKeeper_ReleaseCommitment() {
 			name: "amount to release more than committed: extra denom",
 			setup: func() {
 				store := s.getStore()
-				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("10apple"))
+				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("10apple"), s.ctx.BlockTime())
 			},
 			marketID: 2,
 			addr:     s.addr2,
@@ -709,7 +710,7 @@ func (s *TestSuite) TestKeeper_ReleaseCommitment() {
 			name: "error releasing hold",
 			setup: func() {
 				store := s.getStore()
-				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("10apple"))
+				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("10apple"), s.ctx.BlockTime())
 			},
 			holdKeeper: NewMockHoldKeeper().WithReleaseHoldResults("oops, we injected an error"),
 			marketID:   2,
@@ -722,11 +723,11 @@ func (s *TestSuite) TestKeeper_ReleaseCommitment() {
 			name: "release some of commitment",
 			setup: func() {
 				store := s.getStore()
-				keeper.SetCommitmentAmount(store, 1, s.addr2, s.coins("12banana"))
-				keeper.SetCommitmentAmount(store, 2, s.addr1, s.coins("21banana"))
-				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("10apple,22banana,88cherry"))
-				keeper.SetCommitmentAmount(store, 2, s.addr3, s.coins("23banana"))
-				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32banana"))
+				keeper.SetCommitmentAmount(store, 1, s.addr2, s.coins("12banana"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr1, s.coins("21banana"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("10apple,22banana,88cherry"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr3, s.coins("23banana"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32banana"), s.ctx.BlockTime())
 			},
 			marketID:    2,
 			addr:        s.addr2,
@@ -739,11 +740,11 @@ func (s *TestSuite) TestKeeper_ReleaseCommitment() {
 			name: "release all of commitment",
 			setup: func() {
 				store := s.getStore()
-				keeper.SetCommitmentAmount(store, 1, s.addr2, s.coins("12banana"))
-				keeper.SetCommitmentAmount(store, 2, s.addr1, s.coins("21banana"))
-				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("10apple,22banana,88cherry"))
-				keeper.SetCommitmentAmount(store, 2, s.addr3, s.coins("23banana"))
-				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32banana"))
+				keeper.SetCommitmentAmount(store, 1, s.addr2, s.coins("12banana"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr1, s.coins("21banana"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("10apple,22banana,88cherry"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr3, s.coins("23banana"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32banana"), s.ctx.BlockTime())
 			},
 			marketID:    2,
 			addr:        s.addr2,
@@ -803,15 +804,15 @@ func (s *TestSuite) TestKeeper_ReleaseCommitment() {
 func (s *TestSuite) TestKeeper_ReleaseCommitments() {
 	existingSetup := func() {
 		store := s.getStore()
-		keeper.SetCommitmentAmount(store, 1, s.addr1, s.coins("11apple"))
-		keeper.SetCommitmentAmount(store, 1, s.addr2, s.coins("12apple"))
-		keeper.SetCommitmentAmount(store, 1, s.addr4, s.coins("14apple"))
-		keeper.SetCommitmentAmount(store, 2, s.addr1, s.coins("21apple"))
-		keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("22apple"))
-		keeper.SetCommitmentAmount(store, 2, s.addr4, s.coins("24apple"))
-		keeper.SetCommitmentAmount(store, 3, s.addr1, s.coins("31apple"))
-		keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32apple"))
-		keeper.SetCommitmentAmount(store, 3, s.addr4, s.coins("34apple"))
+		keeper.SetCommitmentAmount(store, 1, s.addr1, s.coins("11apple"), s.ctx.BlockTime())
+		keeper.SetCommitmentAmount(store, 1, s.addr2, s.coins("12apple"), s.ctx.BlockTime())
+		keeper.SetCommitmentAmount(store, 1, s.addr4, s.coins("14apple"), s.ctx.BlockTime())
+		keeper.SetCommitmentAmount(store, 2, s.addr1, s.coins("21apple"), s.ctx.BlockTime())
+		keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("22apple"), s.ctx.BlockTime())
+		keeper.SetCommitmentAmount(store, 2, s.addr4, s.coins("24apple"), s.ctx.BlockTime())
+		keeper.SetCommitmentAmount(store, 3, s.addr1, s.coins("31apple"), s.ctx.BlockTime())
+		keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32apple"), s.ctx.BlockTime())
+		keeper.SetCommitmentAmount(store, 3, s.addr4, s.coins("34apple"), s.ctx.BlockTime())
 	}
 	eventTag := "justsomeothertag"
 
@@ -1076,7 +1077,7 @@ func (s *TestSuite) TestKeeper_TransferCommitments() {
 			setup: func() {
 				s.requireCreateMarket(exchange.Market{MarketId: 3, AcceptingCommitments: true})
 				store := s.getStore()
-				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("10apple"))
+				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("10apple"), s.ctx.BlockTime())
 			},
 			req: &exchange.MsgMarketTransferCommitmentRequest{
 				Admin:           s.addr1.String(),
@@ -1094,7 +1095,7 @@ func (s *TestSuite) TestKeeper_TransferCommitments() {
 				s.requireCreateMarket(exchange.Market{MarketId: 3, AcceptingCommitments: true})
 				s.requireCreateMarket(exchange.Market{MarketId: 5, AcceptingCommitments: false})
 				store := s.getStore()
-				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("10apple"))
+				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("10apple"), s.ctx.BlockTime())
 			},
 			req: &exchange.MsgMarketTransferCommitmentRequest{
 				Admin:           s.addr1.String(),
@@ -1113,7 +1114,7 @@ func (s *TestSuite) TestKeeper_TransferCommitments() {
 				s.requireCreateMarket(exchange.Market{MarketId: 3, AcceptingCommitments: true})
 				s.requireCreateMarket(exchange.Market{MarketId: 5, AcceptingCommitments: true})
 				store := s.getStore()
-				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("10apple"))
+				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("10apple"), s.ctx.BlockTime())
 			},
 			req: &exchange.MsgMarketTransferCommitmentRequest{
 				Admin:           s.addr1.String(),
@@ -1132,7 +1133,7 @@ func (s *TestSuite) TestKeeper_TransferCommitments() {
 				s.requireCreateMarket(exchange.Market{MarketId: 3, AcceptingCommitments: true})
 				s.requireCreateMarket(exchange.Market{MarketId: 5, AcceptingCommitments: true})
 				store := s.getStore()
-				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("10apple"))
+				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("10apple"), s.ctx.BlockTime())
 			},
 			req: &exchange.MsgMarketTransferCommitmentRequest{
 				Admin:           s.addr1.String(),
@@ -1151,7 +1152,7 @@ func (s *TestSuite) TestKeeper_TransferCommitments() {
 				s.requireCreateMarket(exchange.Market{MarketId: 3, AcceptingCommitments: true})
 				s.requireCreateMarket(exchange.Market{MarketId: 5, AcceptingCommitments: true})
 				store := s.getStore()
-				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("10apple"))
+				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("10apple"), s.ctx.BlockTime())
 			},
 			req: &exchange.MsgMarketTransferCommitmentRequest{
 				Admin:           s.addr1.String(),
@@ -1183,7 +1184,7 @@ func (s *TestSuite) TestKeeper_TransferCommitments() {
 				s.requireCreateMarket(exchange.Market{MarketId: 3, AcceptingCommitments: true})
 				s.requireCreateMarket(exchange.Market{MarketId: 5, AcceptingCommitments: true})
 				store := s.getStore()
-				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("10apple"))
+				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("10apple"), s.ctx.BlockTime())
 			},
 			req: &exchange.MsgMarketTransferCommitmentRequest{
 				Admin:           s.addr1.String(),
@@ -1215,7 +1216,7 @@ func (s *TestSuite) TestKeeper_TransferCommitments() {
 				s.requireCreateMarket(exchange.Market{MarketId: 3, AcceptingCommitments: true})
 				s.requireCreateMarket(exchange.Market{MarketId: 5, AcceptingCommitments: true})
 				store := s.getStore()
-				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("10apple,20cherry"))
+				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("10apple,20cherry"), s.ctx.BlockTime())
 			},
 			req: &exchange.MsgMarketTransferCommitmentRequest{
 				Admin:           s.addr1.String(),
@@ -1338,7 +1339,7 @@ func (s *TestSuite) TestKeeper_ReleaseAllCommitmentsForMarket() {
 	s.clearExchangeState()
 	store := s.getStore()
 	for _, com := range initCommitments {
-		keeper.SetCommitmentAmount(store, com.marketID, com.addr, com.amount)
+		keeper.SetCommitmentAmount(store, com.marketID, com.addr, com.amount, s.ctx.BlockTime())
 	}
 
 	kpr := s.k.WithHoldKeeper(NewMockHoldKeeper())
@@ -1357,6 +1358,293 @@ func (s *TestSuite) TestKeeper_ReleaseAllCommitmentsForMarket() {
 	}
 }
 
+func (s *TestSuite) TestKeeper_SetCommitmentExpiration() {
+	blockTime := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	timeP := func(offset time.Duration) *time.Time {
+		rv := blockTime.Add(offset)
+		return &rv
+	}
+
+	tests := []struct {
+		name       string
+		setup      func()
+		marketID   uint32
+		addr       sdk.AccAddress
+		expiration time.Time
+		expErr     string
+		expExp     *time.Time
+		expGone    *time.Time
+	}{
+		{
+			name:       "expiration at block time",
+			setup:      func() { keeper.SetCommitmentAmount(s.getStore(), 3, s.addr1, s.coins("10apple"), s.ctx.BlockTime()) },
+			marketID:   3,
+			addr:       s.addr1,
+			expiration: blockTime,
+			expErr:     "invalid expiration 2030-01-01T12:00:00Z: must be after the current block time 2030-01-01T12:00:00Z",
+		},
+		{
+			name:       "nothing committed",
+			marketID:   3,
+			addr:       s.addr1,
+			expiration: *timeP(time.Hour),
+			expErr:     "account " + s.addr1.String() + " does not have any funds committed to market 3",
+		},
+		{
+			name:       "new expiration",
+			setup:      func() { keeper.SetCommitmentAmount(s.getStore(), 3, s.addr1, s.coins("10apple"), s.ctx.BlockTime()) },
+			marketID:   3,
+			addr:       s.addr1,
+			expiration: *timeP(time.Hour),
+			expExp:     timeP(time.Hour),
+		},
+		{
+			name: "replaces existing expiration",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetCommitmentAmount(store, 3, s.addr1, s.coins("10apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentExpirationInStore(store, 3, s.addr1, timeP(time.Minute))
+			},
+			marketID:   3,
+			addr:       s.addr1,
+			expiration: *timeP(time.Hour),
+			expExp:     timeP(time.Hour),
+			expGone:    timeP(time.Minute),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			ctx := s.ctx.WithBlockTime(blockTime)
+			var err error
+			testFunc := func() {
+				err = s.k.SetCommitmentExpiration(ctx, tc.marketID, tc.addr, tc.expiration)
+			}
+			s.Require().NotPanics(testFunc, "SetCommitmentExpiration")
+			s.assertErrorValue(err, tc.expErr, "SetCommitmentExpiration error")
+
+			actExp := s.k.GetCommitmentExpiration(s.ctx, tc.marketID, tc.addr)
+			s.Assert().Equal(tc.expExp, actExp, "GetCommitmentExpiration after SetCommitmentExpiration")
+
+			store := s.getStore()
+			if tc.expExp != nil {
+				key := keeper.MakeIndexKeyExpirationToCommitment(*tc.expExp, tc.marketID, tc.addr)
+				s.Assert().True(store.Has(key), "store.Has(%v) (new index entry)", key)
+			}
+			if tc.expGone != nil {
+				key := keeper.MakeIndexKeyExpirationToCommitment(*tc.expGone, tc.marketID, tc.addr)
+				s.Assert().False(store.Has(key), "store.Has(%v) (old index entry)", key)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_ExpireCommitments() {
+	blockTime := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	timeP := func(offset time.Duration) *time.Time {
+		rv := blockTime.Add(offset)
+		return &rv
+	}
+	type commitment struct {
+		marketID   uint32
+		addr       sdk.AccAddress
+		amount     string
+		expiration *time.Time
+	}
+
+	tests := []struct {
+		name         string
+		holdKeeper   *MockHoldKeeper
+		commitments  []commitment
+		setup        func()
+		limit        int
+		expCount     int
+		expExpired   []commitment
+		expRemaining []commitment
+		expDelKeys   [][]byte
+		expHoldCalls HoldCalls
+	}{
+		{
+			name:     "no commitments",
+			limit:    10,
+			expCount: 0,
+		},
+		{
+			name: "nothing expired yet",
+			commitments: []commitment{
+				{marketID: 1, addr: s.addr1, amount: "10apple", expiration: timeP(time.Second)},
+				{marketID: 2, addr: s.addr2, amount: "20plum"},
+			},
+			limit:    10,
+			expCount: 0,
+			expRemaining: []commitment{
+				{marketID: 1, addr: s.addr1, amount: "10apple", expiration: timeP(time.Second)},
+				{marketID: 2, addr: s.addr2, amount: "20plum"},
+			},
+		},
+		{
+			name: "some expired",
+			commitments: []commitment{
+				{marketID: 1, addr: s.addr1, amount: "10apple", expiration: timeP(-1 * time.Hour)},
+				{marketID: 1, addr: s.addr2, amount: "20plum", expiration: timeP(time.Hour)},
+				{marketID: 2, addr: s.addr1, amount: "30apple"},
+				{marketID: 2, addr: s.addr3, amount: "40plum", expiration: timeP(0)},
+			},
+			limit:    10,
+			expCount: 2,
+			expExpired: []commitment{
+				{marketID: 1, addr: s.addr1, amount: "10apple", expiration: timeP(-1 * time.Hour)},
+				{marketID: 2, addr: s.addr3, amount: "40plum", expiration: timeP(0)},
+			},
+			expRemaining: []commitment{
+				{marketID: 1, addr: s.addr2, amount: "20plum", expiration: timeP(time.Hour)},
+				{marketID: 2, addr: s.addr1, amount: "30apple"},
+			},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{
+				{addr: s.addr1, funds: s.coins("10apple")},
+				{addr: s.addr3, funds: s.coins("40plum")},
+			}},
+		},
+		{
+			name: "more expired than the limit",
+			commitments: []commitment{
+				{marketID: 1, addr: s.addr1, amount: "10apple", expiration: timeP(-1 * time.Minute)},
+				{marketID: 1, addr: s.addr2, amount: "20plum", expiration: timeP(-3 * time.Minute)},
+				{marketID: 1, addr: s.addr3, amount: "30apple", expiration: timeP(-2 * time.Minute)},
+			},
+			limit:    2,
+			expCount: 2,
+			expExpired: []commitment{
+				{marketID: 1, addr: s.addr2, amount: "20plum", expiration: timeP(-3 * time.Minute)},
+				{marketID: 1, addr: s.addr3, amount: "30apple", expiration: timeP(-2 * time.Minute)},
+			},
+			expRemaining: []commitment{
+				{marketID: 1, addr: s.addr1, amount: "10apple", expiration: timeP(-1 * time.Minute)},
+			},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{
+				{addr: s.addr2, funds: s.coins("20plum")},
+				{addr: s.addr3, funds: s.coins("30apple")},
+			}},
+		},
+		{
+			name: "no limit",
+			commitments: []commitment{
+				{marketID: 1, addr: s.addr1, amount: "10apple", expiration: timeP(-1 * time.Minute)},
+				{marketID: 1, addr: s.addr2, amount: "20plum", expiration: timeP(-3 * time.Minute)},
+			},
+			limit:    0,
+			expCount: 2,
+			expExpired: []commitment{
+				{marketID: 1, addr: s.addr2, amount: "20plum", expiration: timeP(-3 * time.Minute)},
+				{marketID: 1, addr: s.addr1, amount: "10apple", expiration: timeP(-1 * time.Minute)},
+			},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{
+				{addr: s.addr2, funds: s.coins("20plum")},
+				{addr: s.addr1, funds: s.coins("10apple")},
+			}},
+		},
+		{
+			name:       "error releasing hold",
+			holdKeeper: NewMockHoldKeeper().WithReleaseHoldResults("", "injected error for addr2"),
+			commitments: []commitment{
+				{marketID: 1, addr: s.addr1, amount: "10apple", expiration: timeP(-2 * time.Minute)},
+				{marketID: 1, addr: s.addr2, amount: "20plum", expiration: timeP(-1 * time.Minute)},
+			},
+			limit:    10,
+			expCount: 1,
+			expExpired: []commitment{
+				{marketID: 1, addr: s.addr1, amount: "10apple", expiration: timeP(-2 * time.Minute)},
+			},
+			expRemaining: []commitment{
+				{marketID: 1, addr: s.addr2, amount: "20plum", expiration: timeP(-1 * time.Minute)},
+			},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{
+				{addr: s.addr1, funds: s.coins("10apple")},
+				{addr: s.addr2, funds: s.coins("20plum")},
+			}},
+		},
+		{
+			name: "index entry without a commitment",
+			commitments: []commitment{
+				{marketID: 1, addr: s.addr1, amount: "10apple", expiration: timeP(-1 * time.Minute)},
+			},
+			setup: func() {
+				s.getStore().Set(keeper.MakeIndexKeyExpirationToCommitment(*timeP(-2 * time.Minute), 7, s.addr2), []byte{})
+			},
+			limit:    10,
+			expCount: 1,
+			expExpired: []commitment{
+				{marketID: 1, addr: s.addr1, amount: "10apple", expiration: timeP(-1 * time.Minute)},
+			},
+			expDelKeys: [][]byte{keeper.MakeIndexKeyExpirationToCommitment(*timeP(-2 * time.Minute), 7, s.addr2)},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{
+				{addr: s.addr1, funds: s.coins("10apple")},
+			}},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			store := s.getStore()
+			for _, com := range tc.commitments {
+				keeper.SetCommitmentAmount(store, com.marketID, com.addr, s.coins(com.amount), s.ctx.BlockTime())
+				keeper.SetCommitmentExpirationInStore(store, com.marketID, com.addr, com.expiration)
+			}
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var expEvents sdk.Events
+			expDelKeys := tc.expDelKeys
+			for _, com := range tc.expExpired {
+				event := exchange.NewEventCommitmentExpired(com.addr.String(), com.marketID, s.coins(com.amount))
+				expEvents = append(expEvents, s.untypeEvent(event))
+				expDelKeys = append(expDelKeys,
+					keeper.MakeKeyCommitmentExpiration(com.marketID, com.addr),
+					keeper.MakeIndexKeyExpirationToCommitment(*com.expiration, com.marketID, com.addr),
+				)
+			}
+
+			if tc.holdKeeper == nil {
+				tc.holdKeeper = NewMockHoldKeeper()
+			}
+			kpr := s.k.WithHoldKeeper(tc.holdKeeper)
+
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em).WithBlockTime(blockTime)
+			var count int
+			testFunc := func() {
+				count = kpr.ExpireCommitments(ctx, tc.limit)
+			}
+			s.Require().NotPanics(testFunc, "ExpireCommitments(%d)", tc.limit)
+			s.Assert().Equal(tc.expCount, count, "ExpireCommitments(%d) result", tc.limit)
+			actEvents := em.Events()
+			s.assertEqualEvents(expEvents, actEvents, "ExpireCommitments(%d) events", tc.limit)
+			s.assertHoldKeeperCalls(tc.holdKeeper, tc.expHoldCalls, "ExpireCommitments(%d)", tc.limit)
+
+			for _, com := range tc.expExpired {
+				actAmt := s.k.GetCommitmentAmount(s.ctx, com.marketID, com.addr)
+				s.Assert().Equal("", actAmt.String(), "GetCommitmentAmount(%d, %s) (expired)", com.marketID, s.getAddrName(com.addr))
+			}
+			for i, key := range expDelKeys {
+				s.Assert().False(store.Has(key), "[%d]: store.Has(%v) after expire", i, key)
+			}
+			for _, com := range tc.expRemaining {
+				actAmt := s.k.GetCommitmentAmount(s.ctx, com.marketID, com.addr)
+				s.Assert().Equal(com.amount, actAmt.String(), "GetCommitmentAmount(%d, %s) (remaining)", com.marketID, s.getAddrName(com.addr))
+				actExp := s.k.GetCommitmentExpiration(s.ctx, com.marketID, com.addr)
+				s.Assert().Equal(com.expiration, actExp, "GetCommitmentExpiration(%d, %s) (remaining)", com.marketID, s.getAddrName(com.addr))
+			}
+		})
+	}
+}
+
 func (s *TestSuite) TestKeeper_IterateCommitments() {
 	var commitments []exchange.Commitment
 	stopAfter := func(count int) func(com exchange.Commitment) bool {
@@ -1369,6 +1657,7 @@ func (s *TestSuite) TestKeeper_IterateCommitments() {
 		commitments = append(commitments, com)
 		return false
 	}
+	expiration := time.Date(2030, 5, 6, 7, 8, 9, 0, time.UTC)
 
 	tests := []struct {
 		name    string
@@ -1385,7 +1674,7 @@ func (s *TestSuite) TestKeeper_IterateCommitments() {
 			name: "one commitment",
 			setup: func() {
 				store := s.getStore()
-				keeper.SetCommitmentAmount(store, 7, s.addr4, s.coins("74cherry"))
+				keeper.SetCommitmentAmount(store, 7, s.addr4, s.coins("74cherry"), s.ctx.BlockTime())
 			},
 			cb:      getAll,
 			expComs: []exchange.Commitment{{MarketId: 7, Account: s.addr4.String(), Amount: s.coins("74cherry")}},
@@ -1394,9 +1683,9 @@ func (s *TestSuite) TestKeeper_IterateCommitments() {
 			name: "three commitments: get all",
 			setup: func() {
 				store := s.getStore()
-				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32cherry"))
-				keeper.SetCommitmentAmount(store, 7, s.addr4, s.coins("74cherry"))
-				keeper.SetCommitmentAmount(store, 22, s.addr1, s.coins("221cherry"))
+				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32cherry"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 7, s.addr4, s.coins("74cherry"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 22, s.addr1, s.coins("221cherry"), s.ctx.BlockTime())
 			},
 			cb: getAll,
 			expComs: []exchange.Commitment{
@@ -1405,13 +1694,25 @@ func (s *TestSuite) TestKeeper_IterateCommitments() {
 				{MarketId: 22, Account: s.addr1.String(), Amount: s.coins("221cherry")},
 			},
 		},
+		{
+			name: "commitment with an expiration",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetCommitmentAmount(store, 7, s.addr4, s.coins("74cherry"), s.ctx.BlockTime())
+				keeper.SetCommitmentExpirationInStore(store, 7, s.addr4, &expiration)
+			},
+			cb: getAll,
+			expComs: []exchange.Commitment{
+				{MarketId: 7, Account: s.addr4.String(), Amount: s.coins("74cherry"), Expiration: &expiration},
+			},
+		},
 		{
 			name: "three commitments: get one",
 			setup: func() {
 				store := s.getStore()
-				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32cherry"))
-				keeper.SetCommitmentAmount(store, 7, s.addr4, s.coins("74cherry"))
-				keeper.SetCommitmentAmount(store, 22, s.addr1, s.coins("221cherry"))
+				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32cherry"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 7, s.addr4, s.coins("74cherry"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 22, s.addr1, s.coins("221cherry"), s.ctx.BlockTime())
 			},
 			cb:      stopAfter(1),
 			expComs: []exchange.Commitment{{MarketId: 3, Account: s.addr2.String(), Amount: s.coins("32cherry")}},
@@ -1420,9 +1721,9 @@ func (s *TestSuite) TestKeeper_IterateCommitments() {
 			name: "three commitments: get two",
 			setup: func() {
 				store := s.getStore()
-				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32cherry"))
-				keeper.SetCommitmentAmount(store, 7, s.addr4, s.coins("74cherry"))
-				keeper.SetCommitmentAmount(store, 22, s.addr1, s.coins("221cherry"))
+				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32cherry"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 7, s.addr4, s.coins("74cherry"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 22, s.addr1, s.coins("221cherry"), s.ctx.BlockTime())
 			},
 			cb: stopAfter(2),
 			expComs: []exchange.Commitment{
@@ -2071,6 +2372,12 @@ func (s *TestSuite) TestKeeper_SettleCommitments() {
 	}
 	scopeID1 := s.scopeID("1_scope")
 	scopeID2 := s.scopeID("2_scope")
+	stopLoss := exchange.NewTriggerOrder(*exchange.NewOrder(7).WithAsk(&exchange.AskOrder{
+		MarketId: 4,
+		Seller:   s.addr4.String(),
+		Assets:   s.coin("20apple"),
+		Price:    s.coin("60cherry"),
+	}), s.coin("70cherry"))
 
 	tests := []struct {
 		name           string
@@ -2107,7 +2414,7 @@ func (s *TestSuite) TestKeeper_SettleCommitments() {
 			name: "cannot release commitments",
 			setup: func() {
 				store := s.getStore()
-				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("9apple"))
+				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("9apple"), s.ctx.BlockTime())
 			},
 			req: &exchange.MsgMarketCommitmentSettleRequest{
 				Admin:    s.addr1.String(),
@@ -2123,7 +2430,7 @@ func (s *TestSuite) TestKeeper_SettleCommitments() {
 			name: "transfer failure",
 			setup: func() {
 				store := s.getStore()
-				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("10apple"))
+				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("10apple"), s.ctx.BlockTime())
 			},
 			req: &exchange.MsgMarketCommitmentSettleRequest{
 				Admin:    s.addr1.String(),
@@ -2144,7 +2451,7 @@ func (s *TestSuite) TestKeeper_SettleCommitments() {
 			name: "cannot add new commitments",
 			setup: func() {
 				store := s.getStore()
-				keeper.SetCommitmentAmount(store, 4, s.addr3, s.coins("10apple"))
+				keeper.SetCommitmentAmount(store, 4, s.addr3, s.coins("10apple"), s.ctx.BlockTime())
 			},
 			holdKeeper: NewMockHoldKeeper().WithAddHoldResults("no hold 4u"),
 			req: &exchange.MsgMarketCommitmentSettleRequest{
@@ -2173,7 +2480,8 @@ func (s *TestSuite) TestKeeper_SettleCommitments() {
 			name: "one in/out with navs",
 			setup: func() {
 				store := s.getStore()
-				keeper.SetCommitmentAmount(store, 4, s.addr3, s.coins("10apple,10banana"))
+				keeper.SetCommitmentAmount(store, 4, s.addr3, s.coins("10apple,10banana"), s.ctx.BlockTime())
+				s.requireSetTriggerOrderInStore(stopLoss)
 			},
 			markerKeeper: NewMockMarkerKeeper().
 				WithGetMarkerAccount(appleMarker).
@@ -2198,6 +2506,8 @@ func (s *TestSuite) TestKeeper_SettleCommitments() {
 			expEvents: sdk.Events{
 				s.untypeEvent(exchange.NewEventCommitmentReleased(s.addr3.String(), 4, s.coins("10apple,10banana"), "testtag3")),
 				s.untypeEvent(exchange.NewEventFundsCommitted(s.addr5.String(), 4, s.coins("10apple,10banana"), "testtag3")),
+				s.untypeEvent(exchange.NewEventTriggerOrderActivated(&stopLoss.Order, s.coin("66cherry"))),
+				s.untypeEvent(exchange.NewEventOrderCreated(&stopLoss.Order)),
 			},
 			expMDCalls: MetadataCalls{
 				AddSetNetAssetValues: []*MDAddSetNetAssetValuesArgs{
@@ -2255,11 +2565,11 @@ func (s *TestSuite) TestKeeper_SettleCommitments() {
 			name: "one in/out with fees",
 			setup: func() {
 				store := s.getStore()
-				keeper.SetCommitmentAmount(store, 2, s.addr1, s.coins("1cherry"))
-				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("2cherry"))
-				keeper.SetCommitmentAmount(store, 2, s.addr3, s.coins("3cherry"))
-				keeper.SetCommitmentAmount(store, 2, s.addr4, s.coins("11apple,4cherry"))
-				keeper.SetCommitmentAmount(store, 2, s.addr5, s.coins("5cherry"))
+				keeper.SetCommitmentAmount(store, 2, s.addr1, s.coins("1cherry"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("2cherry"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr3, s.coins("3cherry"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr4, s.coins("11apple,4cherry"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr5, s.coins("5cherry"), s.ctx.BlockTime())
 			},
 			req: &exchange.MsgMarketCommitmentSettleRequest{
 				Admin:    s.addr1.String(),
@@ -2318,11 +2628,11 @@ func (s *TestSuite) TestKeeper_SettleCommitments() {
 			name: "multiple ins/outs/fees",
 			setup: func() {
 				store := s.getStore()
-				keeper.SetCommitmentAmount(store, 2, s.addr1, s.coins("11apple,51banana,1cherry"))
-				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("12apple,2cherry,72orange"))
-				keeper.SetCommitmentAmount(store, 2, s.addr3, s.coins("13apple,3cherry,50orange,41pear"))
-				keeper.SetCommitmentAmount(store, 2, s.addr4, s.coins("14apple,4cherry"))
-				keeper.SetCommitmentAmount(store, 2, s.addr5, s.coins("5cherry,500raspberry"))
+				keeper.SetCommitmentAmount(store, 2, s.addr1, s.coins("11apple,51banana,1cherry"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("12apple,2cherry,72orange"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr3, s.coins("13apple,3cherry,50orange,41pear"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr4, s.coins("14apple,4cherry"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr5, s.coins("5cherry,500raspberry"), s.ctx.BlockTime())
 			},
 			req: &exchange.MsgMarketCommitmentSettleRequest{
 				Admin:    s.k.GetAuthority(),
@@ -0,0 +1,272 @@
+package keeper
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+// parseDeadManSwitchStoreValue converts a dead-man switch store value into the DeadManSwitch object.
+// If the value is empty then nil, nil is returned.
+func (k Keeper) parseDeadManSwitchStoreValue(value []byte) (*exchange.DeadManSwitch, error) {
+	if len(value) == 0 {
+		return nil, nil
+	}
+
+	var dms exchange.DeadManSwitch
+	err := k.cdc.Unmarshal(value, &dms)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal dead-man switch: %w", err)
+	}
+	return &dms, nil
+}
+
+// getDeadManSwitchFromStore gets an account's DeadManSwitch from the store.
+func (k Keeper) getDeadManSwitchFromStore(store storetypes.KVStore, addr sdk.AccAddress) (*exchange.DeadManSwitch, error) {
+	return k.parseDeadManSwitchStoreValue(store.Get(MakeKeyDeadManSwitch(addr)))
+}
+
+// setDeadManSwitchInStore sets a dead-man switch in the store making sure its index entry stays up to date.
+func (k Keeper) setDeadManSwitchInStore(store storetypes.KVStore, dms *exchange.DeadManSwitch) error {
+	addr, err := sdk.AccAddressFromBech32(dms.Account)
+	if err != nil {
+		return fmt.Errorf("invalid account %q: %w", dms.Account, err)
+	}
+	key := MakeKeyDeadManSwitch(addr)
+	value, err := k.cdc.Marshal(dms)
+	if err != nil {
+		return fmt.Errorf("error marshaling dead-man switch: %w", err)
+	}
+
+	iKey := MakeIndexKeyDeadlineToDeadManSwitch(dms.Deadline, addr)
+	var oldIKey []byte
+	if existing, _ := k.getDeadManSwitchFromStore(store, addr); existing != nil {
+		oldIKey = MakeIndexKeyDeadlineToDeadManSwitch(existing.Deadline, addr)
+		if bytes.Equal(oldIKey, iKey) {
+			oldIKey = nil
+		}
+	}
+
+	store.Set(key, value)
+	if len(oldIKey) > 0 {
+		store.Delete(oldIKey)
+	}
+	store.Set(iKey, []byte{})
+	return nil
+}
+
+// deleteDeadManSwitchFromStore deletes a dead-man switch (and its index entry) from the state store.
+func deleteDeadManSwitchFromStore(store storetypes.KVStore, dms *exchange.DeadManSwitch) error {
+	if dms == nil {
+		return errors.New("cannot delete nil dead-man switch")
+	}
+	addr, err := sdk.AccAddressFromBech32(dms.Account)
+	if err != nil {
+		return fmt.Errorf("invalid account %q: %w", dms.Account, err)
+	}
+	store.Delete(MakeKeyDeadManSwitch(addr))
+	store.Delete(MakeIndexKeyDeadlineToDeadManSwitch(dms.Deadline, addr))
+	return nil
+}
+
+// GetDeadManSwitch gets an account's dead-man switch from the state store. If it doesn't exist, nil, nil is returned.
+func (k Keeper) GetDeadManSwitch(ctx sdk.Context, addr sdk.AccAddress) (*exchange.DeadManSwitch, error) {
+	return k.getDeadManSwitchFromStore(k.getStore(ctx), addr)
+}
+
+// SetDeadManSwitch registers a dead-man switch for an account with a deadline one interval after the block time,
+// replacing any it already has. If the interval is zero, the account's existing dead-man switch is removed instead.
+func (k Keeper) SetDeadManSwitch(ctx sdk.Context, account string, intervalSeconds uint64) error {
+	addr, err := sdk.AccAddressFromBech32(account)
+	if err != nil {
+		return fmt.Errorf("invalid account %q: %w", account, err)
+	}
+
+	store := k.getStore(ctx)
+	if intervalSeconds == 0 {
+		existing, gerr := k.getDeadManSwitchFromStore(store, addr)
+		if gerr != nil {
+			return fmt.Errorf("error getting existing dead-man switch for %s: %w", account, gerr)
+		}
+		if existing == nil {
+			return fmt.Errorf("no dead-man switch found for %s", account)
+		}
+		if err = deleteDeadManSwitchFromStore(store, existing); err != nil {
+			return err
+		}
+		k.emitEvent(ctx, exchange.NewEventDeadManSwitchRemoved(account))
+		return nil
+	}
+
+	dms := exchange.NewDeadManSwitch(account, intervalSeconds, ctx.BlockTime())
+	if err = k.setDeadManSwitchInStore(store, dms); err != nil {
+		return fmt.Errorf("failed to set dead-man switch: %w", err)
+	}
+	k.emitEvent(ctx, exchange.NewEventDeadManSwitchSet(dms))
+	return nil
+}
+
+// Heartbeat resets the deadline of an account's dead-man switch to one interval after the block time.
+func (k Keeper) Heartbeat(ctx sdk.Context, account string) error {
+	addr, err := sdk.AccAddressFromBech32(account)
+	if err != nil {
+		return fmt.Errorf("invalid account %q: %w", account, err)
+	}
+
+	store := k.getStore(ctx)
+	existing, err := k.getDeadManSwitchFromStore(store, addr)
+	if err != nil {
+		return fmt.Errorf("error getting existing dead-man switch for %s: %w", account, err)
+	}
+	if existing == nil {
+		return fmt.Errorf("no dead-man switch found for %s", account)
+	}
+
+	dms := exchange.NewDeadManSwitch(account, existing.IntervalSeconds, ctx.BlockTime())
+	if err = k.setDeadManSwitchInStore(store, dms); err != nil {
+		return fmt.Errorf("failed to update dead-man switch: %w", err)
+	}
+	k.emitEvent(ctx, exchange.NewEventDeadManSwitchHeartbeat(dms))
+	return nil
+}
+
+// TriggerDeadManSwitches cancels the orders and trigger orders of each account with a dead-man switch deadline at or
+// before the block time. At most limit dead-man switches are processed and at most cancelLimit orders are cancelled
+// (0 = no limit for either). A dead-man switch is only deleted once all of its account's orders have been handled;
+// until then it stays due and a later call picks up where this one left off.
+// Returns the number of dead-man switches that were fully triggered (and deleted).
+func (k Keeper) TriggerDeadManSwitches(ctx sdk.Context, limit, cancelLimit int) int {
+	store := k.getStore(ctx)
+	start := GetIndexKeyPrefixDeadlineToDeadManSwitch()
+	end := storetypes.PrefixEndBytes(GetIndexKeyPrefixDeadlineToDeadManSwitchAt(ctx.BlockTime()))
+
+	// Gather the keys first so we aren't writing to the store while iterating it.
+	var keys [][]byte
+	iter := store.Iterator(start, end)
+	for ; iter.Valid() && (limit == 0 || len(keys) < limit); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	count := 0
+	remaining := cancelLimit
+	for _, key := range keys {
+		if cancelLimit > 0 && remaining <= 0 {
+			break
+		}
+
+		deadline, addr, err := ParseIndexKeyDeadlineToDeadManSwitch(key)
+		if err != nil {
+			k.logErrorf(ctx, "invalid dead-man switch deadline index entry %v (deleted): %v", key, err)
+			store.Delete(key)
+			continue
+		}
+
+		dms, err := k.getDeadManSwitchFromStore(store, addr)
+		if err != nil || dms == nil {
+			k.logErrorf(ctx, "could not get dead-man switch for %s (index entry deleted): %v", addr, err)
+			store.Delete(key)
+			continue
+		}
+		if dms.Deadline.Unix() != deadline.Unix() {
+			k.logErrorf(ctx, "stale dead-man switch deadline index entry %v (deleted)", key)
+			store.Delete(key)
+			continue
+		}
+
+		attempted, done, err := k.triggerDeadManSwitch(ctx, dms, addr, remaining)
+		remaining -= attempted
+		if err != nil {
+			k.logErrorf(ctx, "could not trigger dead-man switch for %s: %v", addr, err)
+			continue
+		}
+		if done {
+			count++
+		}
+	}
+
+	return count
+}
+
+// triggerDeadManSwitch cancels up to limit of an account's orders and trigger orders (0 = no limit).
+// Orders that cannot be cancelled are logged and skipped. If that covers all of them, the dead-man switch is deleted.
+// Returns the number of cancellations attempted and whether the dead-man switch was deleted.
+func (k Keeper) triggerDeadManSwitch(ctx sdk.Context, dms *exchange.DeadManSwitch, addr sdk.AccAddress, limit int) (int, bool, error) {
+	// Get up to one more than the limit so that we know whether any will be left for later.
+	atLimit := func(orderIDs []uint64) bool {
+		return limit > 0 && len(orderIDs) > limit
+	}
+	var orderIDs []uint64
+	k.IterateAddressOrders(ctx, addr, func(orderID uint64, _ byte) bool {
+		orderIDs = append(orderIDs, orderID)
+		return atLimit(orderIDs)
+	})
+	if !atLimit(orderIDs) {
+		k.IterateAddressTriggerOrders(ctx, addr, func(orderID uint64, _ byte) bool {
+			orderIDs = append(orderIDs, orderID)
+			return atLimit(orderIDs)
+		})
+	}
+	done := !atLimit(orderIDs)
+	if !done {
+		orderIDs = orderIDs[:limit]
+	}
+
+	store := k.getStore(ctx)
+	attempted := 0
+	var cancelled uint32
+	for _, orderID := range orderIDs {
+		// An order might have already been cancelled because it was linked to one cancelled earlier in this loop.
+		if !store.Has(MakeKeyOrder(orderID)) && !store.Has(MakeKeyTriggerOrder(orderID)) {
+			continue
+		}
+		attempted++
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.CancelOrder(cacheCtx, orderID, dms.Account); err != nil {
+			k.logErrorf(ctx, "could not cancel order %d for dead-man switch of %s: %v", orderID, dms.Account, err)
+			continue
+		}
+		writeCache()
+		cancelled++
+	}
+
+	// If none of this account's orders could be cancelled, there's no point in trying them again later.
+	if !done && cancelled == 0 {
+		k.logErrorf(ctx, "could not cancel any of the first %d orders for dead-man switch of %s, giving up on the rest", attempted, dms.Account)
+		done = true
+	}
+
+	if done {
+		if err := deleteDeadManSwitchFromStore(store, dms); err != nil {
+			return attempted, false, err
+		}
+	}
+
+	k.emitEvent(ctx, exchange.NewEventDeadManSwitchTriggered(dms.Account, cancelled))
+	return attempted, done, nil
+}
+
+// IterateDeadManSwitches iterates over all dead-man switches. An error is returned if there was a problem
+// reading an entry along the way. Such a problem does not interrupt iteration.
+// The callback takes in the dead-man switch and should return whether to stop iterating.
+func (k Keeper) IterateDeadManSwitches(ctx sdk.Context, cb func(dms *exchange.DeadManSwitch) bool) error {
+	var errs []error
+	k.iterate(ctx, GetKeyPrefixDeadManSwitches(), func(_, value []byte) bool {
+		dms, err := k.parseDeadManSwitchStoreValue(value)
+		if err != nil {
+			errs = append(errs, err)
+			return false
+		}
+		if dms == nil {
+			return false
+		}
+		return cb(dms)
+	})
+	return errors.Join(errs...)
+}
@@ -0,0 +1,674 @@
+package keeper_test
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+	"github.com/provenance-io/provenance/x/exchange"
+	"github.com/provenance-io/provenance/x/exchange/keeper"
+)
+
+// requireSetDeadManSwitchesInStore calls setDeadManSwitchInStore on each dead-man switch,
+// making sure it doesn't panic or return an error.
+func (s *TestSuite) requireSetDeadManSwitchesInStore(switches ...*exchange.DeadManSwitch) {
+	for i, dms := range switches {
+		assertions.RequireNotPanicsNoErrorf(s.T(), func() error {
+			return s.k.SetDeadManSwitchInStore(s.getStore(), dms)
+		}, "[%d]: SetDeadManSwitchInStore(%s)", i, dms.Account)
+	}
+}
+
+// getAllDeadManSwitches gets all the dead-man switches currently in state.
+func (s *TestSuite) getAllDeadManSwitches() []*exchange.DeadManSwitch {
+	var rv []*exchange.DeadManSwitch
+	err := s.k.IterateDeadManSwitches(s.ctx, func(dms *exchange.DeadManSwitch) bool {
+		rv = append(rv, dms)
+		return false
+	})
+	s.Require().NoError(err, "IterateDeadManSwitches")
+	return rv
+}
+
+// assertDeadlineToDeadManSwitchIndexEntriesMatch gets all the dead-man switches and deadline
+// index entries from state and makes sure that they're all as they should be.
+func (s *TestSuite) assertDeadlineToDeadManSwitchIndexEntriesMatch() bool {
+	s.T().Helper()
+	var expKeys [][]byte
+	for _, dms := range s.getAllDeadManSwitches() {
+		addr, _ := sdk.AccAddressFromBech32(dms.Account)
+		if len(addr) > 0 {
+			expKeys = append(expKeys, keeper.MakeIndexKeyDeadlineToDeadManSwitch(dms.Deadline, addr))
+		}
+	}
+	sort.Slice(expKeys, func(i, j int) bool {
+		return bytes.Compare(expKeys[i], expKeys[j]) < 0
+	})
+
+	var actKeys [][]byte
+	keyPrefix := keeper.GetIndexKeyPrefixDeadlineToDeadManSwitch()
+	keeper.Iterate(s.getStore(), keyPrefix, func(keySuffix, _ []byte) bool {
+		actKeys = append(actKeys, concatBz(keyPrefix, keySuffix))
+		return false
+	})
+
+	keyStringer := func(key []byte) string {
+		deadline, addr, err := keeper.ParseIndexKeyDeadlineToDeadManSwitch(key)
+		if err != nil {
+			return fmt.Sprintf("%v", key)
+		}
+		return fmt.Sprintf("%s %s", deadline.Format(time.RFC3339), s.getAddrName(addr))
+	}
+	return assertEqualSlice(s, expKeys, actKeys, keyStringer, "deadline to dead-man switch index entries")
+}
+
+func (s *TestSuite) TestKeeper_GetDeadManSwitch() {
+	deadline := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	dms1 := exchange.NewDeadManSwitch(s.addr1.String(), 60, deadline.Add(-time.Minute))
+
+	tests := []struct {
+		name   string
+		setup  func()
+		addr   sdk.AccAddress
+		expDMS *exchange.DeadManSwitch
+		expErr string
+	}{
+		{
+			name: "not found",
+			addr: s.addr2,
+		},
+		{
+			name:   "found",
+			addr:   s.addr1,
+			expDMS: dms1,
+		},
+		{
+			name: "invalid value in state",
+			setup: func() {
+				s.getStore().Set(keeper.MakeKeyDeadManSwitch(s.addr3), []byte{0x0a, 0x05, 'x'})
+			},
+			addr:   s.addr3,
+			expErr: "failed to unmarshal dead-man switch: unexpected EOF",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			s.requireSetDeadManSwitchesInStore(dms1)
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var dms *exchange.DeadManSwitch
+			var err error
+			testFunc := func() {
+				dms, err = s.k.GetDeadManSwitch(s.ctx, tc.addr)
+			}
+			s.Require().NotPanics(testFunc, "GetDeadManSwitch(%s)", s.getAddrName(tc.addr))
+			s.assertErrorValue(err, tc.expErr, "GetDeadManSwitch(%s) error", s.getAddrName(tc.addr))
+			s.Assert().Equal(tc.expDMS, dms, "GetDeadManSwitch(%s) result", s.getAddrName(tc.addr))
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_SetDeadManSwitch() {
+	blockTime := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	existing := exchange.NewDeadManSwitch(s.addr1.String(), 60, blockTime.Add(-30*time.Second))
+
+	tests := []struct {
+		name      string
+		account   string
+		interval  uint64
+		expErr    string
+		expEvent  proto.Message
+		expResult []*exchange.DeadManSwitch
+	}{
+		{
+			name:      "invalid account",
+			account:   "not-an-account",
+			interval:  60,
+			expErr:    "invalid account \"not-an-account\": decoding bech32 failed: invalid separator index -1",
+			expResult: []*exchange.DeadManSwitch{existing},
+		},
+		{
+			name:      "remove: none exists",
+			account:   s.addr2.String(),
+			interval:  0,
+			expErr:    "no dead-man switch found for " + s.addr2.String(),
+			expResult: []*exchange.DeadManSwitch{existing},
+		},
+		{
+			name:     "remove: exists",
+			account:  s.addr1.String(),
+			interval: 0,
+			expEvent: exchange.NewEventDeadManSwitchRemoved(s.addr1.String()),
+		},
+		{
+			name:     "new",
+			account:  s.addr2.String(),
+			interval: 300,
+			expEvent: exchange.NewEventDeadManSwitchSet(exchange.NewDeadManSwitch(s.addr2.String(), 300, blockTime)),
+			expResult: []*exchange.DeadManSwitch{
+				existing,
+				exchange.NewDeadManSwitch(s.addr2.String(), 300, blockTime),
+			},
+		},
+		{
+			name:      "replace existing",
+			account:   s.addr1.String(),
+			interval:  5,
+			expEvent:  exchange.NewEventDeadManSwitchSet(exchange.NewDeadManSwitch(s.addr1.String(), 5, blockTime)),
+			expResult: []*exchange.DeadManSwitch{exchange.NewDeadManSwitch(s.addr1.String(), 5, blockTime)},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			s.requireSetDeadManSwitchesInStore(existing)
+
+			var expEvents sdk.Events
+			if tc.expEvent != nil {
+				expEvents = append(expEvents, s.untypeEvent(tc.expEvent))
+			}
+
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em).WithBlockTime(blockTime)
+			var err error
+			testFunc := func() {
+				err = s.k.SetDeadManSwitch(ctx, tc.account, tc.interval)
+			}
+			s.Require().NotPanics(testFunc, "SetDeadManSwitch(%q, %d)", tc.account, tc.interval)
+			s.assertErrorValue(err, tc.expErr, "SetDeadManSwitch(%q, %d) error", tc.account, tc.interval)
+			s.assertEqualEvents(expEvents, em.Events(), "SetDeadManSwitch(%q, %d) events", tc.account, tc.interval)
+			s.Assert().ElementsMatch(tc.expResult, s.getAllDeadManSwitches(), "dead-man switches in state")
+			s.assertDeadlineToDeadManSwitchIndexEntriesMatch()
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_Heartbeat() {
+	blockTime := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	existing1 := exchange.NewDeadManSwitch(s.addr1.String(), 60, blockTime.Add(-30*time.Second))
+	existing2 := exchange.NewDeadManSwitch(s.addr2.String(), 3600, blockTime.Add(-time.Hour))
+
+	tests := []struct {
+		name      string
+		account   string
+		expErr    string
+		expEvent  proto.Message
+		expResult []*exchange.DeadManSwitch
+	}{
+		{
+			name:      "invalid account",
+			account:   "not-an-account",
+			expErr:    "invalid account \"not-an-account\": decoding bech32 failed: invalid separator index -1",
+			expResult: []*exchange.DeadManSwitch{existing1, existing2},
+		},
+		{
+			name:      "no dead-man switch",
+			account:   s.addr3.String(),
+			expErr:    "no dead-man switch found for " + s.addr3.String(),
+			expResult: []*exchange.DeadManSwitch{existing1, existing2},
+		},
+		{
+			name:     "deadline extended",
+			account:  s.addr1.String(),
+			expEvent: exchange.NewEventDeadManSwitchHeartbeat(exchange.NewDeadManSwitch(s.addr1.String(), 60, blockTime)),
+			expResult: []*exchange.DeadManSwitch{
+				exchange.NewDeadManSwitch(s.addr1.String(), 60, blockTime),
+				existing2,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			s.requireSetDeadManSwitchesInStore(existing1, existing2)
+
+			var expEvents sdk.Events
+			if tc.expEvent != nil {
+				expEvents = append(expEvents, s.untypeEvent(tc.expEvent))
+			}
+
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em).WithBlockTime(blockTime)
+			var err error
+			testFunc := func() {
+				err = s.k.Heartbeat(ctx, tc.account)
+			}
+			s.Require().NotPanics(testFunc, "Heartbeat(%q)", tc.account)
+			s.assertErrorValue(err, tc.expErr, "Heartbeat(%q) error", tc.account)
+			s.assertEqualEvents(expEvents, em.Events(), "Heartbeat(%q) events", tc.account)
+			s.Assert().ElementsMatch(tc.expResult, s.getAllDeadManSwitches(), "dead-man switches in state")
+			s.assertDeadlineToDeadManSwitchIndexEntriesMatch()
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_TriggerDeadManSwitches() {
+	blockTime := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	newDMS := func(addr sdk.AccAddress, offset time.Duration) *exchange.DeadManSwitch {
+		return exchange.NewDeadManSwitch(addr.String(), 60, blockTime.Add(offset-time.Minute))
+	}
+	notDue := newDMS(s.addr1, time.Second)
+	due1 := newDMS(s.addr1, -time.Minute)
+	due2 := newDMS(s.addr2, 0)
+	due3 := newDMS(s.addr3, -time.Hour)
+
+	newBid := func(orderID uint64, marketID uint32, buyer sdk.AccAddress) *exchange.Order {
+		return exchange.NewOrder(orderID).WithBid(&exchange.BidOrder{
+			MarketId: marketID,
+			Buyer:    buyer.String(),
+			Assets:   s.coin("10apple"),
+			Price:    s.coin("25peach"),
+		})
+	}
+	newAsk := func(orderID uint64, marketID uint32, seller sdk.AccAddress) *exchange.Order {
+		return exchange.NewOrder(orderID).WithAsk(&exchange.AskOrder{
+			MarketId: marketID,
+			Seller:   seller.String(),
+			Assets:   s.coin("10apple"),
+			Price:    s.coin("25peach"),
+		})
+	}
+	order1 := newBid(1, 1, s.addr1)
+	order2 := newAsk(2, 2, s.addr1)
+	order3 := newBid(3, 1, s.addr2)
+	order4 := newAsk(4, 3, s.addr1)
+	order5 := newAsk(5, 1, s.addr4)
+	allOrders := []*exchange.Order{order1, order2, order3, order4, order5}
+	trigger6 := exchange.NewTriggerOrder(*newAsk(6, 1, s.addr1), s.coin("20peach"))
+	trigger7 := exchange.NewTriggerOrder(*newBid(7, 1, s.addr4), s.coin("30peach"))
+	allTriggerOrders := []*exchange.TriggerOrder{trigger6, trigger7}
+
+	releaseArgs := func(order *exchange.Order) *ReleaseHoldArgs {
+		return &ReleaseHoldArgs{addr: sdk.MustAccAddressFromBech32(order.GetOwner()), funds: order.GetHoldAmount()}
+	}
+
+	tests := []struct {
+		name          string
+		switches      []*exchange.DeadManSwitch
+		setup         func()
+		holdKeeper    *MockHoldKeeper
+		limit         int
+		cancelLimit   int
+		expCount      int
+		expEvents     []proto.Message
+		expReleases   []*ReleaseHoldArgs
+		expSwitches   []*exchange.DeadManSwitch
+		expCancelled  []*exchange.Order
+		expTCancelled []*exchange.TriggerOrder
+		expDelKeys    [][]byte
+		expLog        []string
+	}{
+		{
+			name:     "no dead-man switches",
+			limit:    10,
+			expCount: 0,
+		},
+		{
+			name:        "nothing due yet",
+			switches:    []*exchange.DeadManSwitch{notDue},
+			limit:       10,
+			expCount:    0,
+			expSwitches: []*exchange.DeadManSwitch{notDue},
+		},
+		{
+			name:     "two due",
+			switches: []*exchange.DeadManSwitch{due1, due2},
+			limit:    10,
+			expCount: 2,
+			expEvents: []proto.Message{
+				exchange.NewEventOrderCancelled(order1, s.addr1.String()),
+				exchange.NewEventOrderCancelled(order2, s.addr1.String()),
+				exchange.NewEventOrderCancelled(order4, s.addr1.String()),
+				exchange.NewEventOrderCancelled(&trigger6.Order, s.addr1.String()),
+				exchange.NewEventDeadManSwitchTriggered(s.addr1.String(), 4),
+				exchange.NewEventOrderCancelled(order3, s.addr2.String()),
+				exchange.NewEventDeadManSwitchTriggered(s.addr2.String(), 1),
+			},
+			expReleases: []*ReleaseHoldArgs{
+				releaseArgs(order1), releaseArgs(order2), releaseArgs(order4),
+				releaseArgs(&trigger6.Order), releaseArgs(order3),
+			},
+			expCancelled:  []*exchange.Order{order1, order2, order3, order4},
+			expTCancelled: []*exchange.TriggerOrder{trigger6},
+		},
+		{
+			name:      "due but no orders",
+			switches:  []*exchange.DeadManSwitch{due3},
+			limit:     10,
+			expCount:  1,
+			expEvents: []proto.Message{exchange.NewEventDeadManSwitchTriggered(s.addr3.String(), 0)},
+		},
+		{
+			name:     "more due than the limit",
+			switches: []*exchange.DeadManSwitch{due2, due3},
+			limit:    1,
+			expCount: 1,
+			expEvents: []proto.Message{
+				exchange.NewEventDeadManSwitchTriggered(s.addr3.String(), 0),
+			},
+			expSwitches: []*exchange.DeadManSwitch{due2},
+		},
+		{
+			name:        "cancel limit reached part way through an account",
+			switches:    []*exchange.DeadManSwitch{due1, due2},
+			limit:       10,
+			cancelLimit: 2,
+			expCount:    0,
+			expEvents: []proto.Message{
+				exchange.NewEventOrderCancelled(order1, s.addr1.String()),
+				exchange.NewEventOrderCancelled(order2, s.addr1.String()),
+				exchange.NewEventDeadManSwitchTriggered(s.addr1.String(), 2),
+			},
+			expReleases:  []*ReleaseHoldArgs{releaseArgs(order1), releaseArgs(order2)},
+			expSwitches:  []*exchange.DeadManSwitch{due1, due2},
+			expCancelled: []*exchange.Order{order1, order2},
+		},
+		{
+			name:        "cancel limit reached part way through trigger orders",
+			switches:    []*exchange.DeadManSwitch{due1},
+			limit:       10,
+			cancelLimit: 3,
+			expCount:    0,
+			expEvents: []proto.Message{
+				exchange.NewEventOrderCancelled(order1, s.addr1.String()),
+				exchange.NewEventOrderCancelled(order2, s.addr1.String()),
+				exchange.NewEventOrderCancelled(order4, s.addr1.String()),
+				exchange.NewEventDeadManSwitchTriggered(s.addr1.String(), 3),
+			},
+			expReleases:  []*ReleaseHoldArgs{releaseArgs(order1), releaseArgs(order2), releaseArgs(order4)},
+			expSwitches:  []*exchange.DeadManSwitch{due1},
+			expCancelled: []*exchange.Order{order1, order2, order4},
+		},
+		{
+			name:        "cancel limit reached at the end of an account",
+			switches:    []*exchange.DeadManSwitch{due1, due2},
+			limit:       10,
+			cancelLimit: 4,
+			expCount:    1,
+			expEvents: []proto.Message{
+				exchange.NewEventOrderCancelled(order1, s.addr1.String()),
+				exchange.NewEventOrderCancelled(order2, s.addr1.String()),
+				exchange.NewEventOrderCancelled(order4, s.addr1.String()),
+				exchange.NewEventOrderCancelled(&trigger6.Order, s.addr1.String()),
+				exchange.NewEventDeadManSwitchTriggered(s.addr1.String(), 4),
+			},
+			expReleases: []*ReleaseHoldArgs{
+				releaseArgs(order1), releaseArgs(order2), releaseArgs(order4), releaseArgs(&trigger6.Order),
+			},
+			expSwitches:   []*exchange.DeadManSwitch{due2},
+			expCancelled:  []*exchange.Order{order1, order2, order4},
+			expTCancelled: []*exchange.TriggerOrder{trigger6},
+		},
+		{
+			name:       "an order cannot be cancelled",
+			switches:   []*exchange.DeadManSwitch{due1},
+			holdKeeper: NewMockHoldKeeper().WithReleaseHoldResults("", "nope", ""),
+			limit:      10,
+			expCount:   1,
+			expEvents: []proto.Message{
+				exchange.NewEventOrderCancelled(order1, s.addr1.String()),
+				exchange.NewEventOrderCancelled(order4, s.addr1.String()),
+				exchange.NewEventOrderCancelled(&trigger6.Order, s.addr1.String()),
+				exchange.NewEventDeadManSwitchTriggered(s.addr1.String(), 3),
+			},
+			expReleases: []*ReleaseHoldArgs{
+				releaseArgs(order1), releaseArgs(order2), releaseArgs(order4), releaseArgs(&trigger6.Order),
+			},
+			expCancelled:  []*exchange.Order{order1, order4},
+			expTCancelled: []*exchange.TriggerOrder{trigger6},
+			expLog: []string{
+				"ERR could not cancel order 2 for dead-man switch of " + s.addr1.String() +
+					": unable to release hold on order 2 funds: nope module=x/exchange",
+			},
+		},
+		{
+			name:        "none of the orders up to the cancel limit can be cancelled",
+			switches:    []*exchange.DeadManSwitch{due1, due2},
+			holdKeeper:  NewMockHoldKeeper().WithReleaseHoldResults("nope", "nope"),
+			limit:       10,
+			cancelLimit: 2,
+			expCount:    1,
+			expEvents: []proto.Message{
+				exchange.NewEventDeadManSwitchTriggered(s.addr1.String(), 0),
+			},
+			expReleases: []*ReleaseHoldArgs{releaseArgs(order1), releaseArgs(order2)},
+			expSwitches: []*exchange.DeadManSwitch{due2},
+			expLog: []string{
+				"ERR could not cancel order 1 for dead-man switch of " + s.addr1.String() +
+					": unable to release hold on order 1 funds: nope module=x/exchange",
+				"ERR could not cancel order 2 for dead-man switch of " + s.addr1.String() +
+					": unable to release hold on order 2 funds: nope module=x/exchange",
+				"ERR could not cancel any of the first 2 orders for dead-man switch of " + s.addr1.String() +
+					", giving up on the rest module=x/exchange",
+			},
+		},
+		{
+			name:     "invalid index entry",
+			switches: []*exchange.DeadManSwitch{due3},
+			setup: func() {
+				key := keeper.GetIndexKeyPrefixDeadlineToDeadManSwitchAt(blockTime.Add(-2 * time.Hour))
+				s.getStore().Set(append(key, 20, 'x'), []byte{})
+			},
+			limit:      10,
+			expCount:   1,
+			expEvents:  []proto.Message{exchange.NewEventDeadManSwitchTriggered(s.addr3.String(), 0)},
+			expDelKeys: [][]byte{append(keeper.GetIndexKeyPrefixDeadlineToDeadManSwitchAt(blockTime.Add(-2*time.Hour)), 20, 'x')},
+			expLog: []string{
+				"ERR invalid dead-man switch deadline index entry " +
+					fmt.Sprintf("%v", append(keeper.GetIndexKeyPrefixDeadlineToDeadManSwitchAt(blockTime.Add(-2*time.Hour)), 20, 'x')) +
+					" (deleted): cannot parse address from deadline to dead-man switch key: " +
+					"length byte is 20, but slice only has 1 left module=x/exchange",
+			},
+		},
+		{
+			name: "index entry without a dead-man switch",
+			setup: func() {
+				key := keeper.MakeIndexKeyDeadlineToDeadManSwitch(blockTime.Add(-time.Minute), s.addr2)
+				s.getStore().Set(key, []byte{})
+			},
+			limit:      10,
+			expCount:   0,
+			expDelKeys: [][]byte{keeper.MakeIndexKeyDeadlineToDeadManSwitch(blockTime.Add(-time.Minute), s.addr2)},
+			expLog: []string{
+				"ERR could not get dead-man switch for " + s.addr2.String() +
+					" (index entry deleted): <nil> module=x/exchange",
+			},
+		},
+		{
+			name:     "stale index entry",
+			switches: []*exchange.DeadManSwitch{notDue},
+			setup: func() {
+				key := keeper.MakeIndexKeyDeadlineToDeadManSwitch(blockTime.Add(-time.Minute), s.addr1)
+				s.getStore().Set(key, []byte{})
+			},
+			limit:       10,
+			expCount:    0,
+			expSwitches: []*exchange.DeadManSwitch{notDue},
+			expDelKeys:  [][]byte{keeper.MakeIndexKeyDeadlineToDeadManSwitch(blockTime.Add(-time.Minute), s.addr1)},
+			expLog: []string{
+				"ERR stale dead-man switch deadline index entry " +
+					fmt.Sprintf("%v", keeper.MakeIndexKeyDeadlineToDeadManSwitch(blockTime.Add(-time.Minute), s.addr1)) +
+					" (deleted) module=x/exchange",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			s.requireSetOrdersInStore(s.getStore(), allOrders...)
+			for _, triggerOrder := range allTriggerOrders {
+				s.requireSetTriggerOrderInStore(triggerOrder)
+			}
+			s.requireSetDeadManSwitchesInStore(tc.switches...)
+			if tc.setup != nil {
+				tc.setup()
+			}
+			if tc.holdKeeper == nil {
+				tc.holdKeeper = NewMockHoldKeeper()
+			}
+
+			expEvents := make(sdk.Events, len(tc.expEvents))
+			for i, tev := range tc.expEvents {
+				expEvents[i] = s.untypeEvent(tev)
+			}
+
+			var expRemaining []*exchange.Order
+			for _, order := range allOrders {
+				cancelled := false
+				for _, canc := range tc.expCancelled {
+					if order == canc {
+						cancelled = true
+					}
+				}
+				if !cancelled {
+					expRemaining = append(expRemaining, order)
+				}
+			}
+			var expTRemaining []*exchange.TriggerOrder
+			for _, triggerOrder := range allTriggerOrders {
+				cancelled := false
+				for _, canc := range tc.expTCancelled {
+					if triggerOrder == canc {
+						cancelled = true
+					}
+				}
+				if !cancelled {
+					expTRemaining = append(expTRemaining, triggerOrder)
+				}
+			}
+
+			kpr := s.k.WithHoldKeeper(tc.holdKeeper)
+			em := sdk.NewEventManager()
+			s.logBuffer.Reset()
+			ctx := s.ctx.WithEventManager(em).WithBlockTime(blockTime)
+			var count int
+			testFunc := func() {
+				count = kpr.TriggerDeadManSwitches(ctx, tc.limit, tc.cancelLimit)
+			}
+			s.Require().NotPanics(testFunc, "TriggerDeadManSwitches(%d, %d)", tc.limit, tc.cancelLimit)
+			s.Assert().Equal(tc.expCount, count, "TriggerDeadManSwitches(%d, %d) result", tc.limit, tc.cancelLimit)
+			s.assertEqualEvents(expEvents, em.Events(), "TriggerDeadManSwitches(%d, %d) events", tc.limit, tc.cancelLimit)
+			s.assertHoldKeeperCalls(tc.holdKeeper, HoldCalls{ReleaseHold: tc.expReleases}, "TriggerDeadManSwitches(%d, %d)", tc.limit, tc.cancelLimit)
+
+			actLog := s.splitOutputLog(s.getLogOutput("TriggerDeadManSwitches(%d, %d)", tc.limit, tc.cancelLimit))
+			s.Assert().Equal(tc.expLog, actLog, "TriggerDeadManSwitches(%d, %d) logged lines", tc.limit, tc.cancelLimit)
+
+			store := s.getStore()
+			for i, key := range tc.expDelKeys {
+				s.Assert().False(store.Has(key), "[%d]: store.Has(%v) after trigger", i, key)
+			}
+			var actRemaining []*exchange.Order
+			err := s.k.IterateOrders(s.ctx, func(order *exchange.Order) bool {
+				actRemaining = append(actRemaining, order)
+				return false
+			})
+			s.Require().NoError(err, "IterateOrders")
+			s.Assert().Equal(expRemaining, actRemaining, "orders after TriggerDeadManSwitches(%d, %d)", tc.limit, tc.cancelLimit)
+			var actTRemaining []*exchange.TriggerOrder
+			err = s.k.IterateTriggerOrders(s.ctx, func(triggerOrder *exchange.TriggerOrder) bool {
+				actTRemaining = append(actTRemaining, triggerOrder)
+				return false
+			})
+			s.Require().NoError(err, "IterateTriggerOrders")
+			s.Assert().Equal(expTRemaining, actTRemaining, "trigger orders after TriggerDeadManSwitches(%d, %d)", tc.limit, tc.cancelLimit)
+			s.Assert().ElementsMatch(tc.expSwitches, s.getAllDeadManSwitches(), "dead-man switches after TriggerDeadManSwitches(%d, %d)", tc.limit, tc.cancelLimit)
+			s.assertDeadlineToDeadManSwitchIndexEntriesMatch()
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_TriggerDeadManSwitches_CarriesOver() {
+	blockTime := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	due1 := exchange.NewDeadManSwitch(s.addr1.String(), 60, blockTime.Add(-2*time.Minute))
+	due2 := exchange.NewDeadManSwitch(s.addr2.String(), 60, blockTime.Add(-time.Minute))
+
+	newAsk := func(orderID uint64, seller sdk.AccAddress) *exchange.Order {
+		return exchange.NewOrder(orderID).WithAsk(&exchange.AskOrder{
+			MarketId: 1,
+			Seller:   seller.String(),
+			Assets:   s.coin("10apple"),
+			Price:    s.coin("25peach"),
+		})
+	}
+	order1 := newAsk(1, s.addr1)
+	order2 := newAsk(2, s.addr1)
+	order3 := newAsk(3, s.addr2)
+	order4 := newAsk(4, s.addr1)
+	trigger5 := exchange.NewTriggerOrder(*newAsk(5, s.addr1), s.coin("20peach"))
+
+	s.clearExchangeState()
+	s.requireSetOrdersInStore(s.getStore(), order1, order2, order3, order4)
+	s.requireSetTriggerOrderInStore(trigger5)
+	s.requireSetDeadManSwitchesInStore(due1, due2)
+
+	steps := []struct {
+		expCount    int
+		expEvents   []proto.Message
+		expSwitches []*exchange.DeadManSwitch
+	}{
+		{
+			expCount: 0,
+			expEvents: []proto.Message{
+				exchange.NewEventOrderCancelled(order1, s.addr1.String()),
+				exchange.NewEventOrderCancelled(order2, s.addr1.String()),
+				exchange.NewEventOrderCancelled(order4, s.addr1.String()),
+				exchange.NewEventDeadManSwitchTriggered(s.addr1.String(), 3),
+			},
+			expSwitches: []*exchange.DeadManSwitch{due1, due2},
+		},
+		{
+			expCount: 2,
+			expEvents: []proto.Message{
+				exchange.NewEventOrderCancelled(&trigger5.Order, s.addr1.String()),
+				exchange.NewEventDeadManSwitchTriggered(s.addr1.String(), 1),
+				exchange.NewEventOrderCancelled(order3, s.addr2.String()),
+				exchange.NewEventDeadManSwitchTriggered(s.addr2.String(), 1),
+			},
+		},
+		{
+			expCount: 0,
+		},
+	}
+
+	for i, step := range steps {
+		expEvents := make(sdk.Events, len(step.expEvents))
+		for j, tev := range step.expEvents {
+			expEvents[j] = s.untypeEvent(tev)
+		}
+
+		em := sdk.NewEventManager()
+		ctx := s.ctx.WithEventManager(em).WithBlockTime(blockTime.Add(time.Duration(i) * time.Second))
+		var count int
+		testFunc := func() {
+			count = s.k.WithHoldKeeper(NewMockHoldKeeper()).TriggerDeadManSwitches(ctx, 10, 3)
+		}
+		s.Require().NotPanics(testFunc, "[%d]: TriggerDeadManSwitches(10, 3)", i)
+		s.Assert().Equal(step.expCount, count, "[%d]: TriggerDeadManSwitches(10, 3) result", i)
+		s.assertEqualEvents(expEvents, em.Events(), "[%d]: TriggerDeadManSwitches(10, 3) events", i)
+		s.Assert().ElementsMatch(step.expSwitches, s.getAllDeadManSwitches(), "[%d]: dead-man switches after TriggerDeadManSwitches(10, 3)", i)
+		s.assertDeadlineToDeadManSwitchIndexEntriesMatch()
+	}
+
+	var orderIDs []uint64
+	s.k.IterateAddressOrders(s.ctx, s.addr1, func(orderID uint64, _ byte) bool {
+		orderIDs = append(orderIDs, orderID)
+		return false
+	})
+	s.k.IterateAddressTriggerOrders(s.ctx, s.addr1, func(orderID uint64, _ byte) bool {
+		orderIDs = append(orderIDs, orderID)
+		return false
+	})
+	s.Assert().Empty(orderIDs, "addr1 order ids left after all TriggerDeadManSwitches calls")
+}
@@ -0,0 +1,262 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+	"github.com/provenance-io/provenance/x/quarantine"
+)
+
+// escrowedPaymentExists returns true if there's an escrowed payment in the store with the given source and external id.
+func escrowedPaymentExists(store storetypes.KVStore, source, externalID string) bool {
+	sourceAddr, err := sdk.AccAddressFromBech32(source)
+	if err != nil {
+		return false
+	}
+	return store.Has(MakeKeyEscrowedPayment(sourceAddr, externalID))
+}
+
+// parseEscrowedPaymentStoreValue converts an escrowed payment store value into the EscrowedPayment object.
+// If the value is empty then nil, nil is returned.
+func (k Keeper) parseEscrowedPaymentStoreValue(value []byte) (*exchange.EscrowedPayment, error) {
+	if len(value) == 0 {
+		return nil, nil
+	}
+
+	var ep exchange.EscrowedPayment
+	err := k.cdc.Unmarshal(value, &ep)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal escrowed payment: %w", err)
+	}
+	return &ep, nil
+}
+
+// getEscrowedPaymentFromStore gets an EscrowedPayment from the store.
+func (k Keeper) getEscrowedPaymentFromStore(store storetypes.KVStore, source sdk.AccAddress, externalID string) (*exchange.EscrowedPayment, error) {
+	key := MakeKeyEscrowedPayment(source, externalID)
+	value := store.Get(key)
+	return k.parseEscrowedPaymentStoreValue(value)
+}
+
+// requireEscrowedPaymentFromStore is like getEscrowedPaymentFromStore but returns with an error if it does not exist.
+// This will always return either an escrowed payment or error. It will never return both or nil, nil.
+func (k Keeper) requireEscrowedPaymentFromStore(store storetypes.KVStore, source sdk.AccAddress, externalID string) (*exchange.EscrowedPayment, error) {
+	ep, err := k.getEscrowedPaymentFromStore(store, source, externalID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting existing escrowed payment with source %s and external id %q: %w",
+			source, externalID, err)
+	}
+	if ep == nil {
+		return nil, fmt.Errorf("no escrowed payment found with source %s and external id %q", source, externalID)
+	}
+	return ep, nil
+}
+
+// setEscrowedPaymentInStore sets an escrowed payment (and its index entry) in the store.
+// The index entry is for the escrowed payment's next release attempt. If that is changing,
+// the old index entry must be deleted separately.
+func (k Keeper) setEscrowedPaymentInStore(store storetypes.KVStore, ep *exchange.EscrowedPayment) error {
+	source, err := sdk.AccAddressFromBech32(ep.Payment.Source)
+	if err != nil {
+		return fmt.Errorf("invalid source %q: %w", ep.Payment.Source, err)
+	}
+	if paymentExists(store, ep.Payment.Source, ep.Payment.ExternalId) {
+		return fmt.Errorf("a payment already exists with source %s and external id %q",
+			ep.Payment.Source, ep.Payment.ExternalId)
+	}
+	key := MakeKeyEscrowedPayment(source, ep.Payment.ExternalId)
+	value, err := k.cdc.Marshal(ep)
+	if err != nil {
+		return fmt.Errorf("error marshaling escrowed payment: %w", err)
+	}
+
+	store.Set(key, value)
+	store.Set(MakeIndexKeyReleaseTimeToEscrowedPayment(ep.GetNextReleaseAttempt(), source, ep.Payment.ExternalId), []byte{})
+	return nil
+}
+
+// deleteEscrowedPaymentFromStore deletes an escrowed payment (and its index entry) from the state store.
+func deleteEscrowedPaymentFromStore(store storetypes.KVStore, ep *exchange.EscrowedPayment) error {
+	if ep == nil {
+		return errors.New("cannot delete nil escrowed payment")
+	}
+	source, err := sdk.AccAddressFromBech32(ep.Payment.Source)
+	if err != nil {
+		return fmt.Errorf("invalid source %q: %w", ep.Payment.Source, err)
+	}
+	store.Delete(MakeKeyEscrowedPayment(source, ep.Payment.ExternalId))
+	store.Delete(MakeIndexKeyReleaseTimeToEscrowedPayment(ep.GetNextReleaseAttempt(), source, ep.Payment.ExternalId))
+	return nil
+}
+
+// GetEscrowedPayment gets an escrowed payment from the state store. If it doesn't exist, nil, nil is returned.
+func (k Keeper) GetEscrowedPayment(ctx sdk.Context, source sdk.AccAddress, externalID string) (*exchange.EscrowedPayment, error) {
+	return k.getEscrowedPaymentFromStore(k.getStore(ctx), source, externalID)
+}
+
+// escrowPayment moves the funds of an accepted payment into escrow and records it as an escrowed payment.
+// The payment should have already been deleted (and its hold released).
+func (k Keeper) escrowPayment(ctx sdk.Context, payment *exchange.Payment) (*exchange.EscrowedPayment, error) {
+	source, _ := sdk.AccAddressFromBech32(payment.Source)
+	target, _ := sdk.AccAddressFromBech32(payment.Target)
+	escrow := exchange.GetPaymentEscrowAddress()
+
+	if !payment.SourceAmount.IsZero() {
+		err := k.bankKeeper.SendCoins(ctx, source, escrow, payment.SourceAmount)
+		if err != nil {
+			return nil, fmt.Errorf("error sending %q from source %s to escrow: %w", payment.SourceAmount, source, err)
+		}
+	}
+	if !payment.TargetAmount.IsZero() {
+		err := k.bankKeeper.SendCoins(ctx, target, escrow, payment.TargetAmount)
+		if err != nil {
+			return nil, fmt.Errorf("error sending %q from target %s to escrow: %w", payment.TargetAmount, target, err)
+		}
+	}
+
+	// The release time index only has second precision, so the release time does too.
+	ep := &exchange.EscrowedPayment{
+		Payment:     *payment,
+		ReleaseTime: time.Unix(ctx.BlockTime().Unix()+int64(payment.DisputeWindowSeconds), 0).UTC(), //nolint:gosec // G115: The dispute window is capped at a year.
+	}
+	if err := k.setEscrowedPaymentInStore(k.getStore(ctx), ep); err != nil {
+		return nil, fmt.Errorf("failed to escrow payment: %w", err)
+	}
+	return ep, nil
+}
+
+// payOutEscrowedPayment deletes an escrowed payment and sends its escrowed funds to the provided accounts.
+func (k Keeper) payOutEscrowedPayment(ctx sdk.Context, ep *exchange.EscrowedPayment, sourceAmountTo, targetAmountTo sdk.AccAddress) error {
+	if err := deleteEscrowedPaymentFromStore(k.getStore(ctx), ep); err != nil {
+		return err
+	}
+
+	ctx = quarantine.WithBypass(ctx)
+	escrow := exchange.GetPaymentEscrowAddress()
+	if !ep.Payment.SourceAmount.IsZero() {
+		err := k.bankKeeper.SendCoins(ctx, escrow, sourceAmountTo, ep.Payment.SourceAmount)
+		if err != nil {
+			return fmt.Errorf("error sending %q from escrow to %s: %w", ep.Payment.SourceAmount, sourceAmountTo, err)
+		}
+	}
+	if !ep.Payment.TargetAmount.IsZero() {
+		err := k.bankKeeper.SendCoins(ctx, escrow, targetAmountTo, ep.Payment.TargetAmount)
+		if err != nil {
+			return fmt.Errorf("error sending %q from escrow to %s: %w", ep.Payment.TargetAmount, targetAmountTo, err)
+		}
+	}
+	return nil
+}
+
+// DisputePayment refunds the escrowed funds of an accepted payment: the source amount goes back to the source,
+// and the target amount goes back to the target. It can only be done by the payment's target, and only
+// before the end of the payment's dispute window.
+func (k Keeper) DisputePayment(ctx sdk.Context, target, source sdk.AccAddress, externalID string) error {
+	if len(target) == 0 {
+		return errors.New("a target is required in order to dispute a payment")
+	}
+	if len(source) == 0 {
+		return errors.New("a source is required in order to dispute a payment")
+	}
+
+	ep, err := k.requireEscrowedPaymentFromStore(k.getStore(ctx), source, externalID)
+	if err != nil {
+		return err
+	}
+	if ep.Payment.Target != target.String() {
+		return fmt.Errorf("target %s cannot dispute payment with target %s", target, ep.Payment.Target)
+	}
+	if !ctx.BlockTime().Before(ep.ReleaseTime) {
+		return fmt.Errorf("the dispute window for payment with source %s and external id %q ended at %s",
+			ep.Payment.Source, ep.Payment.ExternalId, ep.ReleaseTime.UTC().Format(time.RFC3339))
+	}
+
+	if err = k.payOutEscrowedPayment(ctx, ep, source, target); err != nil {
+		return fmt.Errorf("error refunding escrowed payment: %w", err)
+	}
+
+	k.emitEvent(ctx, exchange.NewEventPaymentDisputed(&ep.Payment))
+	return nil
+}
+
+// ReleaseEscrowedPayments releases the funds of all escrowed payments with a next release attempt at or before the
+// block time. The source amount goes to the target, and the target amount goes to the source.
+// An escrowed payment that fails to be released is retried later (see EscrowedPayment.GetNextReleaseAttempt).
+// At most limit escrowed payments are released (0 = no limit); any others are picked up by a later call.
+// Returns the number of escrowed payments that were released.
+func (k Keeper) ReleaseEscrowedPayments(ctx sdk.Context, limit int) int {
+	store := k.getStore(ctx)
+	start := GetIndexKeyPrefixReleaseTimeToEscrowedPayment()
+	end := storetypes.PrefixEndBytes(GetIndexKeyPrefixReleaseTimeToEscrowedPaymentAt(ctx.BlockTime()))
+
+	// Gather the keys first so we aren't writing to the store while iterating it.
+	var keys [][]byte
+	iter := store.Iterator(start, end)
+	for ; iter.Valid() && (limit == 0 || len(keys) < limit); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	count := 0
+	for _, key := range keys {
+		releaseTime, source, externalID, err := ParseIndexKeyReleaseTimeToEscrowedPayment(key)
+		if err != nil {
+			k.logErrorf(ctx, "invalid escrowed payment release time index entry %v (deleted): %v", key, err)
+			store.Delete(key)
+			continue
+		}
+
+		ep, err := k.getEscrowedPaymentFromStore(store, source, externalID)
+		if err != nil || ep == nil {
+			k.logErrorf(ctx, "could not get escrowed payment with source %s and external id %q (index entry deleted): %v",
+				source, externalID, err)
+			store.Delete(key)
+			continue
+		}
+		if ep.GetNextReleaseAttempt().Unix() != releaseTime.Unix() {
+			k.logErrorf(ctx, "stale escrowed payment release time index entry %v (deleted)", key)
+			store.Delete(key)
+			continue
+		}
+
+		target, _ := sdk.AccAddressFromBech32(ep.Payment.Target)
+		cacheCtx, writeCache := ctx.CacheContext()
+		err = k.payOutEscrowedPayment(cacheCtx, ep, target, source)
+		if err != nil {
+			// Move it to a later release attempt so that it doesn't block the ones behind it.
+			ep.FailedReleases++
+			if serr := k.setEscrowedPaymentInStore(store, ep); serr != nil {
+				k.logErrorf(ctx, "could not release escrowed payment with source %s and external id %q: %v (could not reschedule: %v)",
+					source, externalID, err, serr)
+				continue
+			}
+			store.Delete(key)
+			k.logErrorf(ctx, "could not release escrowed payment with source %s and external id %q (will retry at %s): %v",
+				source, externalID, ep.GetNextReleaseAttempt().UTC().Format(time.RFC3339), err)
+			continue
+		}
+		writeCache()
+		k.emitEvent(ctx, exchange.NewEventPaymentReleased(&ep.Payment))
+		count++
+	}
+
+	return count
+}
+
+// IterateEscrowedPayments iterates over all escrowed payments.
+// The callback should return whether to stop, i.e. true = stop iterating, false = keep going.
+func (k Keeper) IterateEscrowedPayments(ctx sdk.Context, cb func(ep *exchange.EscrowedPayment) bool) {
+	k.iterate(ctx, GetKeyPrefixAllEscrowedPayments(), func(_, value []byte) bool {
+		ep, err := k.parseEscrowedPaymentStoreValue(value)
+		if err != nil || ep == nil {
+			return false
+		}
+		return cb(ep)
+	})
+}
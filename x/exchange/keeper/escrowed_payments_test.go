@@ -0,0 +1,495 @@
+package keeper_test
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+	"github.com/provenance-io/provenance/x/exchange"
+	"github.com/provenance-io/provenance/x/exchange/keeper"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+// newTestEscrowedPayment creates a new EscrowedPayment using the provided info and a one hour dispute window.
+func (s *TestSuite) newTestEscrowedPayment(source sdk.AccAddress, sourceAmount string, target sdk.AccAddress, targetAmount string,
+	externalID string, releaseTime time.Time,
+) *exchange.EscrowedPayment {
+	s.T().Helper()
+	return &exchange.EscrowedPayment{
+		Payment:     *withDisputeWindow(s.newTestPayment(source, sourceAmount, target, targetAmount, externalID), 3600),
+		ReleaseTime: releaseTime,
+	}
+}
+
+// requireSetEscrowedPaymentsInStore calls setEscrowedPaymentInStore on each escrowed payment,
+// making sure it doesn't panic or return an error.
+func (s *TestSuite) requireSetEscrowedPaymentsInStore(eps ...*exchange.EscrowedPayment) {
+	for i, ep := range eps {
+		assertions.RequireNotPanicsNoErrorf(s.T(), func() error {
+			return s.k.SetEscrowedPaymentInStore(s.getStore(), ep)
+		}, "[%d]: SetEscrowedPaymentInStore(%s %q)", i, ep.Payment.Source, ep.Payment.ExternalId)
+	}
+}
+
+// getAllEscrowedPayments gets all the escrowed payments currently in state.
+func (s *TestSuite) getAllEscrowedPayments() []*exchange.EscrowedPayment {
+	var rv []*exchange.EscrowedPayment
+	s.k.IterateEscrowedPayments(s.ctx, func(ep *exchange.EscrowedPayment) bool {
+		rv = append(rv, ep)
+		return false
+	})
+	return rv
+}
+
+// assertReleaseTimeToEscrowedPaymentIndexEntriesMatch gets all the escrowed payments and release time
+// index entries from state and makes sure that they're all as they should be.
+func (s *TestSuite) assertReleaseTimeToEscrowedPaymentIndexEntriesMatch() bool {
+	s.T().Helper()
+	var expKeys [][]byte
+	for _, ep := range s.getAllEscrowedPayments() {
+		source, _ := sdk.AccAddressFromBech32(ep.Payment.Source)
+		if len(source) > 0 {
+			expKeys = append(expKeys, keeper.MakeIndexKeyReleaseTimeToEscrowedPayment(ep.GetNextReleaseAttempt(), source, ep.Payment.ExternalId))
+		}
+	}
+	sort.Slice(expKeys, func(i, j int) bool {
+		return bytes.Compare(expKeys[i], expKeys[j]) < 0
+	})
+
+	var actKeys [][]byte
+	keyPrefix := keeper.GetIndexKeyPrefixReleaseTimeToEscrowedPayment()
+	keeper.Iterate(s.getStore(), keyPrefix, func(keySuffix, _ []byte) bool {
+		actKeys = append(actKeys, concatBz(keyPrefix, keySuffix))
+		return false
+	})
+
+	keyStringer := func(key []byte) string {
+		releaseTime, source, externalID, err := keeper.ParseIndexKeyReleaseTimeToEscrowedPayment(key)
+		if err != nil {
+			return fmt.Sprintf("%v", key)
+		}
+		return fmt.Sprintf("%s %s %q", releaseTime.Format(time.RFC3339), s.getAddrName(source), externalID)
+	}
+	return assertEqualSlice(s, expKeys, actKeys, keyStringer, "release time to escrowed payment index entries")
+}
+
+func (s *TestSuite) TestKeeper_DisputePayment() {
+	blockTime := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	escrow := exchange.GetPaymentEscrowAddress()
+	both := s.newTestEscrowedPayment(s.addr1, "5strawberry", s.addr2, "2tomato", "both", blockTime.Add(time.Minute))
+	sourceOnly := s.newTestEscrowedPayment(s.addr3, "4strawberry", s.addr2, "", "source-only", blockTime.Add(time.Second))
+	released := s.newTestEscrowedPayment(s.addr1, "3strawberry", s.addr2, "", "released", blockTime)
+
+	tests := []struct {
+		name       string
+		eps        []*exchange.EscrowedPayment
+		bankKeeper *MockBankKeeper
+		target     sdk.AccAddress
+		source     sdk.AccAddress
+		externalID string
+		expErr     string
+		expSends   []*SendCoinsArgs
+		expEPs     []*exchange.EscrowedPayment
+	}{
+		{
+			name:       "no target",
+			eps:        []*exchange.EscrowedPayment{both},
+			target:     nil,
+			source:     s.addr1,
+			externalID: "both",
+			expErr:     "a target is required in order to dispute a payment",
+			expEPs:     []*exchange.EscrowedPayment{both},
+		},
+		{
+			name:       "no source",
+			eps:        []*exchange.EscrowedPayment{both},
+			target:     s.addr2,
+			source:     nil,
+			externalID: "both",
+			expErr:     "a source is required in order to dispute a payment",
+			expEPs:     []*exchange.EscrowedPayment{both},
+		},
+		{
+			name:       "escrowed payment does not exist",
+			eps:        []*exchange.EscrowedPayment{both},
+			target:     s.addr2,
+			source:     s.addr1,
+			externalID: "nope",
+			expErr:     "no escrowed payment found with source " + s.addr1.String() + " and external id \"nope\"",
+			expEPs:     []*exchange.EscrowedPayment{both},
+		},
+		{
+			name:       "wrong target",
+			eps:        []*exchange.EscrowedPayment{both},
+			target:     s.addr3,
+			source:     s.addr1,
+			externalID: "both",
+			expErr:     "target " + s.addr3.String() + " cannot dispute payment with target " + s.addr2.String(),
+			expEPs:     []*exchange.EscrowedPayment{both},
+		},
+		{
+			name:       "dispute window has ended",
+			eps:        []*exchange.EscrowedPayment{released},
+			target:     s.addr2,
+			source:     s.addr1,
+			externalID: "released",
+			expErr: "the dispute window for payment with source " + s.addr1.String() +
+				" and external id \"released\" ended at 2030-01-01T12:00:00Z",
+			expEPs: []*exchange.EscrowedPayment{released},
+		},
+		{
+			name:       "error refunding",
+			eps:        []*exchange.EscrowedPayment{both},
+			bankKeeper: NewMockBankKeeper().WithSendCoinsResults("escrow is empty"),
+			target:     s.addr2,
+			source:     s.addr1,
+			externalID: "both",
+			expErr: "error refunding escrowed payment: error sending \"5strawberry\" from escrow to " +
+				s.addr1.String() + ": escrow is empty",
+			expSends: []*SendCoinsArgs{{fromAddr: escrow, toAddr: s.addr1, amt: s.coins("5strawberry")}},
+		},
+		{
+			name:       "both amounts refunded",
+			eps:        []*exchange.EscrowedPayment{both, sourceOnly},
+			target:     s.addr2,
+			source:     s.addr1,
+			externalID: "both",
+			expSends: []*SendCoinsArgs{
+				{fromAddr: escrow, toAddr: s.addr1, amt: s.coins("5strawberry")},
+				{fromAddr: escrow, toAddr: s.addr2, amt: s.coins("2tomato")},
+			},
+			expEPs: []*exchange.EscrowedPayment{sourceOnly},
+		},
+		{
+			name:       "only source amount refunded",
+			eps:        []*exchange.EscrowedPayment{both, sourceOnly},
+			target:     s.addr2,
+			source:     s.addr3,
+			externalID: "source-only",
+			expSends:   []*SendCoinsArgs{{fromAddr: escrow, toAddr: s.addr3, amt: s.coins("4strawberry")}},
+			expEPs:     []*exchange.EscrowedPayment{both},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			s.requireSetEscrowedPaymentsInStore(tc.eps...)
+			if tc.bankKeeper == nil {
+				tc.bankKeeper = NewMockBankKeeper()
+			}
+			for _, send := range tc.expSends {
+				send.ctxHasQuarantineBypass = true
+			}
+
+			var expEvents sdk.Events
+			if len(tc.expErr) == 0 {
+				expEvents = sdk.Events{s.untypeEvent(&exchange.EventPaymentDisputed{
+					Source:     tc.source.String(),
+					Target:     tc.target.String(),
+					ExternalId: tc.externalID,
+				})}
+			}
+
+			kpr := s.k.WithBankKeeper(tc.bankKeeper)
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em).WithBlockTime(blockTime)
+			var err error
+			testFunc := func() {
+				err = kpr.DisputePayment(ctx, tc.target, tc.source, tc.externalID)
+			}
+			s.Require().NotPanics(testFunc, "DisputePayment")
+			s.assertErrorValue(err, tc.expErr, "DisputePayment error")
+			s.assertEqualEvents(expEvents, em.Events(), "DisputePayment events")
+			s.assertBankKeeperCalls(tc.bankKeeper, BankCalls{SendCoins: tc.expSends}, "DisputePayment")
+			if len(tc.expSends) == 0 || len(tc.expErr) == 0 {
+				// When a send fails, the store changes aren't rolled back here (that's the tx's job), so skip these checks.
+				s.Assert().ElementsMatch(tc.expEPs, s.getAllEscrowedPayments(), "escrowed payments after DisputePayment")
+				s.assertReleaseTimeToEscrowedPaymentIndexEntriesMatch()
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_ReleaseEscrowedPayments() {
+	blockTime := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	escrow := exchange.GetPaymentEscrowAddress()
+	notDue := s.newTestEscrowedPayment(s.addr1, "1strawberry", s.addr5, "", "later", blockTime.Add(time.Minute))
+	due := s.newTestEscrowedPayment(s.addr1, "2strawberry", s.addr5, "3tomato", "due", blockTime.Add(-1*time.Minute))
+	dueNow := s.newTestEscrowedPayment(s.addr2, "", s.addr5, "4tomato", "now", blockTime)
+	longDue := s.newTestEscrowedPayment(s.addr3, "5strawberry", s.addr4, "", "long", blockTime.Add(-1*time.Hour))
+	releasedEvent := func(ep *exchange.EscrowedPayment) proto.Message {
+		return exchange.NewEventPaymentReleased(&ep.Payment)
+	}
+	withFailedReleases := func(ep *exchange.EscrowedPayment, failedReleases uint32) *exchange.EscrowedPayment {
+		rv := *ep
+		rv.FailedReleases = failedReleases
+		return &rv
+	}
+
+	tests := []struct {
+		name       string
+		eps        []*exchange.EscrowedPayment
+		setup      func()
+		bankKeeper *MockBankKeeper
+		limit      int
+		expCount   int
+		expSends   []*SendCoinsArgs
+		expEvents  []proto.Message
+		expEPs     []*exchange.EscrowedPayment
+		expDelKeys [][]byte
+		expLog     []string
+	}{
+		{
+			name:     "no escrowed payments",
+			limit:    10,
+			expCount: 0,
+		},
+		{
+			name:     "nothing due yet",
+			eps:      []*exchange.EscrowedPayment{notDue},
+			limit:    10,
+			expCount: 0,
+			expEPs:   []*exchange.EscrowedPayment{notDue},
+		},
+		{
+			name:     "some due",
+			eps:      []*exchange.EscrowedPayment{notDue, due, dueNow, longDue},
+			limit:    10,
+			expCount: 3,
+			expSends: []*SendCoinsArgs{
+				{fromAddr: escrow, toAddr: s.addr4, amt: s.coins("5strawberry")},
+				{fromAddr: escrow, toAddr: s.addr5, amt: s.coins("2strawberry")},
+				{fromAddr: escrow, toAddr: s.addr1, amt: s.coins("3tomato")},
+				{fromAddr: escrow, toAddr: s.addr2, amt: s.coins("4tomato")},
+			},
+			expEvents: []proto.Message{releasedEvent(longDue), releasedEvent(due), releasedEvent(dueNow)},
+			expEPs:    []*exchange.EscrowedPayment{notDue},
+		},
+		{
+			name:     "more due than the limit",
+			eps:      []*exchange.EscrowedPayment{due, dueNow, longDue},
+			limit:    2,
+			expCount: 2,
+			expSends: []*SendCoinsArgs{
+				{fromAddr: escrow, toAddr: s.addr4, amt: s.coins("5strawberry")},
+				{fromAddr: escrow, toAddr: s.addr5, amt: s.coins("2strawberry")},
+				{fromAddr: escrow, toAddr: s.addr1, amt: s.coins("3tomato")},
+			},
+			expEvents: []proto.Message{releasedEvent(longDue), releasedEvent(due)},
+			expEPs:    []*exchange.EscrowedPayment{dueNow},
+		},
+		{
+			name:       "release fails",
+			eps:        []*exchange.EscrowedPayment{longDue, dueNow},
+			bankKeeper: NewMockBankKeeper().WithSendCoinsResults("escrow is empty"),
+			limit:      10,
+			expCount:   1,
+			expSends: []*SendCoinsArgs{
+				{fromAddr: escrow, toAddr: s.addr4, amt: s.coins("5strawberry")},
+				{fromAddr: escrow, toAddr: s.addr2, amt: s.coins("4tomato")},
+			},
+			expEvents:  []proto.Message{releasedEvent(dueNow)},
+			expEPs:     []*exchange.EscrowedPayment{withFailedReleases(longDue, 1)},
+			expDelKeys: [][]byte{keeper.MakeIndexKeyReleaseTimeToEscrowedPayment(longDue.ReleaseTime, s.addr3, "long")},
+			expLog: []string{
+				"ERR could not release escrowed payment with source " + s.addr3.String() +
+					" and external id \"long\" (will retry at 2030-01-01T12:00:00Z): error sending \"5strawberry\" from escrow to " +
+					s.addr4.String() + ": escrow is empty module=x/exchange",
+			},
+		},
+		{
+			name: "failed release does not block later releases",
+			eps:  []*exchange.EscrowedPayment{longDue, dueNow},
+			setup: func() {
+				// The first call can't release longDue, but it shouldn't be looked at again until its retry.
+				kpr := s.k.WithBankKeeper(NewMockBankKeeper().WithSendCoinsResults("escrow is empty"))
+				kpr.ReleaseEscrowedPayments(s.ctx.WithBlockTime(blockTime.Add(-30*time.Minute)), 1)
+			},
+			limit:    1,
+			expCount: 1,
+			expSends: []*SendCoinsArgs{
+				{fromAddr: escrow, toAddr: s.addr2, amt: s.coins("4tomato")},
+			},
+			expEvents: []proto.Message{releasedEvent(dueNow)},
+			expEPs:    []*exchange.EscrowedPayment{withFailedReleases(longDue, 1)},
+		},
+		{
+			name: "previously failed releases",
+			eps: []*exchange.EscrowedPayment{
+				withFailedReleases(due, 1),
+				withFailedReleases(s.newTestEscrowedPayment(s.addr3, "5strawberry", s.addr4, "", "long", blockTime.Add(-3*time.Hour)), 2),
+			},
+			limit:    10,
+			expCount: 1,
+			expSends: []*SendCoinsArgs{
+				{fromAddr: escrow, toAddr: s.addr4, amt: s.coins("5strawberry")},
+			},
+			expEvents: []proto.Message{releasedEvent(longDue)},
+			expEPs:    []*exchange.EscrowedPayment{withFailedReleases(due, 1)},
+		},
+		{
+			name: "invalid index entry",
+			eps:  []*exchange.EscrowedPayment{due},
+			setup: func() {
+				key := keeper.GetIndexKeyPrefixReleaseTimeToEscrowedPaymentAt(blockTime.Add(-2 * time.Minute))
+				s.getStore().Set(append(key, 20, 'x'), []byte{})
+			},
+			limit:    10,
+			expCount: 1,
+			expSends: []*SendCoinsArgs{
+				{fromAddr: escrow, toAddr: s.addr5, amt: s.coins("2strawberry")},
+				{fromAddr: escrow, toAddr: s.addr1, amt: s.coins("3tomato")},
+			},
+			expEvents: []proto.Message{releasedEvent(due)},
+			expDelKeys: [][]byte{
+				append(keeper.GetIndexKeyPrefixReleaseTimeToEscrowedPaymentAt(blockTime.Add(-2*time.Minute)), 20, 'x'),
+			},
+			expLog: []string{
+				"ERR invalid escrowed payment release time index entry " +
+					fmt.Sprintf("%v", append(keeper.GetIndexKeyPrefixReleaseTimeToEscrowedPaymentAt(blockTime.Add(-2*time.Minute)), 20, 'x')) +
+					" (deleted): cannot parse source from release time to escrowed payment key: " +
+					"length byte is 20, but slice only has 1 left module=x/exchange",
+			},
+		},
+		{
+			name: "index entry without an escrowed payment",
+			setup: func() {
+				key := keeper.MakeIndexKeyReleaseTimeToEscrowedPayment(blockTime.Add(-2*time.Minute), s.addr2, "gone")
+				s.getStore().Set(key, []byte{})
+			},
+			limit:      10,
+			expCount:   0,
+			expDelKeys: [][]byte{keeper.MakeIndexKeyReleaseTimeToEscrowedPayment(blockTime.Add(-2*time.Minute), s.addr2, "gone")},
+			expLog: []string{
+				"ERR could not get escrowed payment with source " + s.addr2.String() +
+					" and external id \"gone\" (index entry deleted): <nil> module=x/exchange",
+			},
+		},
+		{
+			name: "stale index entry",
+			eps:  []*exchange.EscrowedPayment{notDue},
+			setup: func() {
+				key := keeper.MakeIndexKeyReleaseTimeToEscrowedPayment(blockTime.Add(-2*time.Minute), s.addr1, "later")
+				s.getStore().Set(key, []byte{})
+			},
+			limit:      10,
+			expCount:   0,
+			expEPs:     []*exchange.EscrowedPayment{notDue},
+			expDelKeys: [][]byte{keeper.MakeIndexKeyReleaseTimeToEscrowedPayment(blockTime.Add(-2*time.Minute), s.addr1, "later")},
+			expLog: []string{
+				"ERR stale escrowed payment release time index entry " +
+					fmt.Sprintf("%v", keeper.MakeIndexKeyReleaseTimeToEscrowedPayment(blockTime.Add(-2*time.Minute), s.addr1, "later")) +
+					" (deleted) module=x/exchange",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			s.requireSetEscrowedPaymentsInStore(tc.eps...)
+			if tc.setup != nil {
+				tc.setup()
+			}
+			if tc.bankKeeper == nil {
+				tc.bankKeeper = NewMockBankKeeper()
+			}
+			for _, send := range tc.expSends {
+				send.ctxHasQuarantineBypass = true
+			}
+
+			expEvents := make(sdk.Events, len(tc.expEvents))
+			for i, tev := range tc.expEvents {
+				expEvents[i] = s.untypeEvent(tev)
+			}
+
+			kpr := s.k.WithBankKeeper(tc.bankKeeper)
+			em := sdk.NewEventManager()
+			s.logBuffer.Reset()
+			ctx := s.ctx.WithEventManager(em).WithBlockTime(blockTime)
+			var count int
+			testFunc := func() {
+				count = kpr.ReleaseEscrowedPayments(ctx, tc.limit)
+			}
+			s.Require().NotPanics(testFunc, "ReleaseEscrowedPayments(%d)", tc.limit)
+			s.Assert().Equal(tc.expCount, count, "ReleaseEscrowedPayments(%d) result", tc.limit)
+			s.assertEqualEvents(expEvents, em.Events(), "ReleaseEscrowedPayments(%d) events", tc.limit)
+			s.assertBankKeeperCalls(tc.bankKeeper, BankCalls{SendCoins: tc.expSends}, "ReleaseEscrowedPayments(%d)", tc.limit)
+			actLog := s.splitOutputLog(s.getLogOutput("ReleaseEscrowedPayments(%d)", tc.limit))
+			s.Assert().Equal(tc.expLog, actLog, "ReleaseEscrowedPayments(%d) logged lines", tc.limit)
+
+			store := s.getStore()
+			for i, key := range tc.expDelKeys {
+				s.Assert().False(store.Has(key), "[%d]: store.Has(%v) after release", i, key)
+			}
+			s.Assert().ElementsMatch(tc.expEPs, s.getAllEscrowedPayments(), "escrowed payments after ReleaseEscrowedPayments(%d)", tc.limit)
+			s.assertReleaseTimeToEscrowedPaymentIndexEntriesMatch()
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_EscrowedPaymentWithRestrictedCoin() {
+	// The escrow address doesn't have the marker's required attribute, so this only works if it's a bypass address.
+	reqAttr := "escrow.kyc.test"
+	s.requireSetNameRecord(reqAttr, s.addr5)
+	s.requireSetAttr(s.addr1, reqAttr, s.addr5)
+	s.requireSetAttr(s.addr2, reqAttr, s.addr5)
+	s.requireAddFinalizeAndActivateMarker(s.coin("1000rcoin"), s.addr5, reqAttr)
+	s.requireFundAccount(s.addr1, "50rcoin")
+
+	escrow := exchange.GetPaymentEscrowAddress()
+	s.addAddrLookup(escrow, "escrow")
+	blockTime := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	s.ctx = s.ctx.WithBlockTime(blockTime)
+	payment := withDisputeWindow(s.newTestPayment(s.addr1, "20rcoin", s.addr2, "", "restricted"), 60)
+	s.requireCreatePayments(payment)
+
+	err := s.k.AcceptPayment(s.ctx, payment)
+	s.Require().NoError(err, "AcceptPayment")
+	s.checkBalances(expBalances{addr: s.addr1, expBal: s.coins("30rcoin"), expHold: s.zeroCoins("rcoin")})
+	s.checkBalances(expBalances{addr: escrow, expBal: s.coins("20rcoin")})
+	s.checkBalances(expBalances{addr: s.addr2, expBal: s.zeroCoins("rcoin")})
+
+	s.ctx = s.ctx.WithBlockTime(blockTime.Add(time.Minute))
+	count := s.k.ReleaseEscrowedPayments(s.ctx, 0)
+	s.Assert().Equal(1, count, "ReleaseEscrowedPayments(0) result")
+	s.Assert().Empty(s.getAllEscrowedPayments(), "escrowed payments after ReleaseEscrowedPayments(0)")
+	s.checkBalances(expBalances{addr: escrow, expBal: s.zeroCoins("rcoin")})
+	s.checkBalances(expBalances{addr: s.addr2, expBal: s.coins("20rcoin")})
+}
+
+func (s *TestSuite) TestKeeper_EscrowedPaymentWithTransferFee() {
+	// The escrow address is a bypass address, so neither moving funds into it nor out of it incurs a transfer fee.
+	s.requireAddFinalizeAndActivateCoinMarker(s.coin("1000fcoin"), s.addr5)
+	s.requireFundAccount(s.addr1, "300fcoin")
+	err := s.app.MarkerKeeper.SetTransferFee(s.ctx, s.markerAddr("fcoin"), markertypes.NewTransferFee("fcoin", 100, s.addr4.String()))
+	s.Require().NoError(err, "SetTransferFee(fcoin)")
+
+	escrow := exchange.GetPaymentEscrowAddress()
+	s.addAddrLookup(escrow, "escrow")
+	blockTime := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	s.ctx = s.ctx.WithBlockTime(blockTime)
+	payment := withDisputeWindow(s.newTestPayment(s.addr1, "200fcoin", s.addr2, "", "fee-bearing"), 60)
+	s.requireCreatePayments(payment)
+
+	err = s.k.AcceptPayment(s.ctx, payment)
+	s.Require().NoError(err, "AcceptPayment")
+	s.checkBalances(expBalances{addr: s.addr1, expBal: s.coins("100fcoin"), expHold: s.zeroCoins("fcoin")})
+	s.checkBalances(expBalances{addr: escrow, expBal: s.coins("200fcoin")})
+	s.checkBalances(expBalances{addr: s.addr2, expBal: s.zeroCoins("fcoin")})
+	s.checkBalances(expBalances{addr: s.addr4, expBal: s.zeroCoins("fcoin")})
+
+	s.ctx = s.ctx.WithBlockTime(blockTime.Add(time.Minute))
+	count := s.k.ReleaseEscrowedPayments(s.ctx, 0)
+	s.Assert().Equal(1, count, "ReleaseEscrowedPayments(0) result")
+	s.Assert().Empty(s.getAllEscrowedPayments(), "escrowed payments after ReleaseEscrowedPayments(0)")
+	s.checkBalances(expBalances{addr: escrow, expBal: s.zeroCoins("fcoin")})
+	s.checkBalances(expBalances{addr: s.addr2, expBal: s.coins("200fcoin")})
+	s.checkBalances(expBalances{addr: s.addr4, expBal: s.zeroCoins("fcoin")})
+}
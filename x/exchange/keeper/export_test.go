@@ -36,6 +36,13 @@ func (k Keeper) WithHoldKeeper(holdKeeper exchange.HoldKeeper) Keeper {
 	return k
 }
 
+// WithHooks is a test-only method that returns a new Keeper that uses the provided ExchangeHooks.
+// Unlike SetHooks, this will replace any hooks that are already set.
+func (k Keeper) WithHooks(hooks exchange.ExchangeHooks) Keeper {
+	k.hooks = hooks
+	return k
+}
+
 // WithMarkerKeeper is a test-only method that returns a new Keeper that uses the provided MarkerKeeper.
 func (k Keeper) WithMarkerKeeper(markerKeeper exchange.MarkerKeeper) Keeper {
 	k.markerKeeper = markerKeeper
@@ -48,11 +55,88 @@ func (k Keeper) WithMetadataKeeper(metadataKeeper exchange.MetadataKeeper) Keepe
 	return k
 }
 
+// WithOracleKeeper is a test-only method that returns a new Keeper that uses the provided OracleKeeper.
+// Unlike SetOracleKeeper, this will replace any oracle keeper that is already set.
+func (k Keeper) WithOracleKeeper(oracleKeeper exchange.OracleKeeper) Keeper {
+	k.oracleKeeper = oracleKeeper
+	return k
+}
+
 // GetStore is a test-only exposure of getStore.
 func (k Keeper) GetStore(ctx sdk.Context) storetypes.KVStore {
 	return k.getStore(ctx)
 }
 
+// SetTriggerOrderInStore is a test-only exposure of setTriggerOrderInStore.
+func (k Keeper) SetTriggerOrderInStore(store storetypes.KVStore, triggerOrder exchange.TriggerOrder) error {
+	return k.setTriggerOrderInStore(store, triggerOrder)
+}
+
+// ActivateTriggerOrders is a test-only exposure of activateTriggerOrders.
+func (k Keeper) ActivateTriggerOrders(ctx sdk.Context, marketID uint32, navs []exchange.NetAssetPrice) {
+	k.activateTriggerOrders(ctx, marketID, navs)
+}
+
+// MatchOrder is a test-only exposure of matchOrder.
+func (k Keeper) MatchOrder(ctx sdk.Context, order *exchange.Order) {
+	k.matchOrder(ctx, order)
+}
+
+// GetMatchableOrders is a test-only exposure of getMatchableOrders.
+func (k Keeper) GetMatchableOrders(ctx sdk.Context, order *exchange.Order) ([]*exchange.Order, error) {
+	return k.getMatchableOrders(ctx, k.getStore(ctx), order)
+}
+
+// SetSettlementPriceInStore is a test-only exposure of setSettlementPriceInStore.
+func (k Keeper) SetSettlementPriceInStore(store storetypes.KVStore, price exchange.SettlementPrice) error {
+	return k.setSettlementPriceInStore(store, price)
+}
+
+// RecordSettlementPrices is a test-only exposure of recordSettlementPrices.
+func (k Keeper) RecordSettlementPrices(ctx sdk.Context, marketID uint32, navs []exchange.NetAssetPrice) {
+	k.recordSettlementPrices(ctx, k.getStore(ctx), marketID, navs)
+}
+
+// SetSettlementRecordInStore is a test-only exposure of setSettlementRecordInStore.
+func (k Keeper) SetSettlementRecordInStore(store storetypes.KVStore, record exchange.SettlementRecord) error {
+	return k.setSettlementRecordInStore(store, record)
+}
+
+// SetSettlementReceiptInStore is a test-only exposure of setSettlementReceiptInStore.
+func (k Keeper) SetSettlementReceiptInStore(store storetypes.KVStore, receipt exchange.SettlementReceipt) error {
+	return k.setSettlementReceiptInStore(store, receipt)
+}
+
+// RecordSettlementReceipt is a test-only exposure of recordSettlementReceipt.
+func (k Keeper) RecordSettlementReceipt(ctx sdk.Context, settlementID uint64, marketID uint32, settlement *exchange.Settlement, navs []exchange.NetAssetPrice) {
+	k.recordSettlementReceipt(ctx, k.getStore(ctx), settlementID, marketID, settlement, navs)
+}
+
+// SetNAVRecordInStore is a test-only exposure of setNAVRecordInStore.
+func (k Keeper) SetNAVRecordInStore(store storetypes.KVStore, record exchange.NAVRecord) error {
+	return k.setNAVRecordInStore(store, record)
+}
+
+// RecordNAVHistory is a test-only exposure of recordNAVHistory.
+func (k Keeper) RecordNAVHistory(ctx sdk.Context, marketID uint32, navs []exchange.NetAssetPrice) {
+	k.recordNAVHistory(ctx, k.getStore(ctx), marketID, navs)
+}
+
+// PublishPrices is a test-only exposure of publishPrices.
+func (k Keeper) PublishPrices(ctx sdk.Context, marketID uint32, navs []exchange.NetAssetPrice) {
+	k.publishPrices(ctx, k.getStore(ctx), marketID, navs)
+}
+
+// AddScheduledFeeChangeToStore is a test-only exposure of addScheduledFeeChangeToStore.
+func (k Keeper) AddScheduledFeeChangeToStore(store storetypes.KVStore, change exchange.MsgGovManageFeesRequest) error {
+	return k.addScheduledFeeChangeToStore(store, change)
+}
+
+// RecordSettlement is a test-only exposure of recordSettlement.
+func (k Keeper) RecordSettlement(ctx sdk.Context, marketID uint32, settlement *exchange.Settlement) {
+	k.recordSettlement(ctx, k.getStore(ctx), marketID, settlement)
+}
+
 // SetOrderInStore is a test-only exposure of setOrderInStore.
 func (k Keeper) SetOrderInStore(store storetypes.KVStore, order exchange.Order) error {
 	return k.setOrderInStore(store, order)
@@ -68,11 +152,36 @@ func (k Keeper) AddCommitmentsUnsafe(ctx sdk.Context, marketID uint32, toAdd []e
 	return k.addCommitmentsUnsafe(ctx, marketID, toAdd, eventTag)
 }
 
+// ValidateExposure is a test-only exposure of validateExposure.
+func (k Keeper) ValidateExposure(ctx sdk.Context, marketID uint32, addr sdk.AccAddress, toAdd, toRemove sdk.Coins) error {
+	return k.validateExposure(ctx, k.getStore(ctx), marketID, addr, toAdd, toRemove)
+}
+
 // SetPaymentInStore is a test-only exposure of setPaymentInStore.
 func (k Keeper) SetPaymentInStore(store storetypes.KVStore, payment *exchange.Payment) error {
 	return k.setPaymentInStore(store, payment)
 }
 
+// SetRecurringPaymentInStore is a test-only exposure of setRecurringPaymentInStore.
+func (k Keeper) SetRecurringPaymentInStore(store storetypes.KVStore, rp *exchange.RecurringPayment) error {
+	return k.setRecurringPaymentInStore(store, rp)
+}
+
+// SetEscrowedPaymentInStore is a test-only exposure of setEscrowedPaymentInStore.
+func (k Keeper) SetEscrowedPaymentInStore(store storetypes.KVStore, ep *exchange.EscrowedPayment) error {
+	return k.setEscrowedPaymentInStore(store, ep)
+}
+
+// SetMultiPartyPaymentInStore is a test-only exposure of setMultiPartyPaymentInStore.
+func (k Keeper) SetMultiPartyPaymentInStore(store storetypes.KVStore, mpp *exchange.MultiPartyPayment) error {
+	return k.setMultiPartyPaymentInStore(store, mpp)
+}
+
+// SetDeadManSwitchInStore is a test-only exposure of setDeadManSwitchInStore.
+func (k Keeper) SetDeadManSwitchInStore(store storetypes.KVStore, dms *exchange.DeadManSwitch) error {
+	return k.setDeadManSwitchInStore(store, dms)
+}
+
 // GetCodec is a test-only exposure of this keeper's cdc.
 func (k Keeper) GetCodec() codec.BinaryCodec {
 	return k.cdc
@@ -98,6 +207,12 @@ var (
 	SetParamsFeeCreatePaymentFlat = setParamsFeeCreatePaymentFlat
 	// SetParamsFeeAcceptPaymentFlat is a test-only exposure of setParamsFeeAcceptPaymentFlat.
 	SetParamsFeeAcceptPaymentFlat = setParamsFeeAcceptPaymentFlat
+	// SetParamsSettlementHistoryBlocks is a test-only exposure of setParamsSettlementHistoryBlocks.
+	SetParamsSettlementHistoryBlocks = setParamsSettlementHistoryBlocks
+	// SetParamsNAVHistoryBlocks is a test-only exposure of setParamsNAVHistoryBlocks.
+	SetParamsNAVHistoryBlocks = setParamsNAVHistoryBlocks
+	// SetParamsNAVHistoryMaxRecords is a test-only exposure of setParamsNAVHistoryMaxRecords.
+	SetParamsNAVHistoryMaxRecords = setParamsNAVHistoryMaxRecords
 
 	// GetLastAutoMarketID is a test-only exposure of getLastAutoMarketID.
 	GetLastAutoMarketID = getLastAutoMarketID
@@ -117,10 +232,20 @@ var (
 	SetBuyerSettlementFlatFees = setBuyerSettlementFlatFees
 	// SetSellerSettlementRatios is a test-only exposure of setSellerSettlementRatios.
 	SetSellerSettlementRatios = setSellerSettlementRatios
+	// SetSellerSettlementTakerRatios is a test-only exposure of setSellerSettlementTakerRatios.
+	SetSellerSettlementTakerRatios = setSellerSettlementTakerRatios
+	// SetSellerSettlementRebateRatios is a test-only exposure of setSellerSettlementRebateRatios.
+	SetSellerSettlementRebateRatios = setSellerSettlementRebateRatios
+	// SetRebateAddresses is a test-only exposure of setRebateAddresses.
+	SetRebateAddresses = setRebateAddresses
 	// SetBuyerSettlementRatios is a test-only exposure of setBuyerSettlementRatios.
 	SetBuyerSettlementRatios = setBuyerSettlementRatios
 	// SetCommitmentSettlementBips is a test-only exposure of setCommitmentSettlementBips.
 	SetCommitmentSettlementBips = setCommitmentSettlementBips
+	// SetReferralBips is a test-only exposure of setReferralBips.
+	SetReferralBips = setReferralBips
+	// SetReferralCap is a test-only exposure of setReferralCap.
+	SetReferralCap = setReferralCap
 	// SetIntermediaryDenom is a test-only exposure of setIntermediaryDenom.
 	SetIntermediaryDenom = setIntermediaryDenom
 	// SetMarketAcceptingOrders is a test-only exposure of setMarketAcceptingOrders.
@@ -129,14 +254,40 @@ var (
 	SetUserSettlementAllowed = setUserSettlementAllowed
 	// SetMarketAcceptingCommitments is a test-only exposure of setMarketAcceptingCommitments.
 	SetMarketAcceptingCommitments = setMarketAcceptingCommitments
+	// SetContinuousMatchingEnabled is a test-only exposure of setContinuousMatchingEnabled.
+	SetContinuousMatchingEnabled = setContinuousMatchingEnabled
+	// SetSelfTradePrevention is a test-only exposure of setSelfTradePrevention.
+	SetSelfTradePrevention = setSelfTradePrevention
+	// SetPublishPricesEnabled is a test-only exposure of setPublishPricesEnabled.
+	SetPublishPricesEnabled = setPublishPricesEnabled
+	// SetExternalIDScope is a test-only exposure of setExternalIDScope.
+	SetExternalIDScope = setExternalIDScope
+	// SetOrderRateLimit is a test-only exposure of setOrderRateLimit.
+	SetOrderRateLimit = setOrderRateLimit
+	// SetMaxExposure is a test-only exposure of setMaxExposure.
+	SetMaxExposure = setMaxExposure
+	// SetFillAlgorithm is a test-only exposure of setFillAlgorithm.
+	SetFillAlgorithm = setFillAlgorithm
+	// SetExchangeSplits is a test-only exposure of setExchangeSplits.
+	SetExchangeSplits = setExchangeSplits
+	// SelectMatchedOrders is a test-only exposure of selectMatchedOrders.
+	SelectMatchedOrders = selectMatchedOrders
+	// SelectOrdersToFill is a test-only exposure of selectOrdersToFill.
+	SelectOrdersToFill = selectOrdersToFill
 	// GrantPermissions is a test-only exposure of grantPermissions.
 	GrantPermissions = grantPermissions
+	// SetAccessGrantExpiration is a test-only exposure of setAccessGrantExpiration.
+	SetAccessGrantExpiration = setAccessGrantExpiration
 	// SetReqAttrsAsk is a test-only exposure of setReqAttrsAsk.
 	SetReqAttrsAsk = setReqAttrsAsk
 	// SetReqAttrsBid is a test-only exposure of setReqAttrsBid.
 	SetReqAttrsBid = setReqAttrsBid
 	// SetReqAttrsCommitment is a test-only exposure of setReqAttrsCommitment.
 	SetReqAttrsCommitment = setReqAttrsCommitment
+	// SetAcceptedAssetDenoms is a test-only exposure of setAcceptedAssetDenoms.
+	SetAcceptedAssetDenoms = setAcceptedAssetDenoms
+	// SetAcceptedPriceDenoms is a test-only exposure of setAcceptedPriceDenoms.
+	SetAcceptedPriceDenoms = setAcceptedPriceDenoms
 	// StoreMarket is a test-only exposure of storeMarket.
 	StoreMarket = storeMarket
 
@@ -144,11 +295,37 @@ var (
 	GetLastOrderID = getLastOrderID
 	// SetLastOrderID is a test-only exposure of setLastOrderID.
 	SetLastOrderID = setLastOrderID
+	// GetLastSettlementID is a test-only exposure of getLastSettlementID.
+	GetLastSettlementID = getLastSettlementID
+	// SetLastSettlementID is a test-only exposure of setLastSettlementID.
+	SetLastSettlementID = setLastSettlementID
+	// NextSettlementID is a test-only exposure of nextSettlementID.
+	NextSettlementID = nextSettlementID
 	// CreateConstantIndexEntries is a test-only exposure of createConstantIndexEntries.
 	CreateConstantIndexEntries = createConstantIndexEntries
 	// CreateMarketExternalIDToOrderEntry is a test-only exposure of createMarketExternalIDToOrderEntry.
 	CreateMarketExternalIDToOrderEntry = createMarketExternalIDToOrderEntry
+	// SetOrderLink is a test-only exposure of setOrderLink.
+	SetOrderLink = setOrderLink
 
 	// SetCommitmentAmount is a test-only exposure of setCommitmentAmount.
 	SetCommitmentAmount = setCommitmentAmount
+	// SetCommitmentExpirationInStore is a test-only exposure of setCommitmentExpiration.
+	SetCommitmentExpirationInStore = setCommitmentExpiration
+	// SetCommitmentRewardPool is a test-only exposure of setCommitmentRewardPool.
+	SetCommitmentRewardPool = setCommitmentRewardPool
+	// SetCommitmentReward is a test-only exposure of setCommitmentReward.
+	SetCommitmentReward = setCommitmentReward
+	// SetCommitmentAccrual is a test-only exposure of setCommitmentAccrual.
+	SetCommitmentAccrual = setCommitmentAccrual
 )
+
+// GetLastBatchAuctionHeight is a test-only exposure of getLastBatchAuctionHeight.
+func (k Keeper) GetLastBatchAuctionHeight(ctx sdk.Context, marketID uint32) int64 {
+	return getLastBatchAuctionHeight(k.getStore(ctx), marketID)
+}
+
+// SetIdempotencyKeyRecordInStore is a test-only exposure of setIdempotencyKeyRecordInStore.
+func SetIdempotencyKeyRecordInStore(store storetypes.KVStore, record exchange.IdempotencyKeyRecord) error {
+	return setIdempotencyKeyRecordInStore(store, record)
+}
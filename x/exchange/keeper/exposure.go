@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// convertExposureAmount converts the provided coin to the given denom using a recorded NAV.
+// The result is rounded up so that an account's exposure is never under-counted.
+func (k Keeper) convertExposureAmount(ctx sdk.Context, coin sdk.Coin, denom string) (sdkmath.Int, error) {
+	if coin.Denom == denom || coin.Amount.IsZero() {
+		return coin.Amount, nil
+	}
+	nav := k.GetNav(ctx, coin.Denom, denom)
+	if nav == nil || !nav.Assets.Amount.IsPositive() {
+		return sdkmath.ZeroInt(), fmt.Errorf("no nav found from %q to %q", coin.Denom, denom)
+	}
+	num := coin.Amount.Mul(nav.Price.Amount)
+	rv := num.Quo(nav.Assets.Amount)
+	if !num.Mod(nav.Assets.Amount).IsZero() {
+		rv = rv.AddRaw(1)
+	}
+	return rv, nil
+}
+
+// sumExposure converts each of the provided coins to the given denom and adds them all up.
+func (k Keeper) sumExposure(ctx sdk.Context, coins sdk.Coins, denom string) (sdkmath.Int, error) {
+	rv := sdkmath.ZeroInt()
+	var errs []error
+	for _, coin := range coins {
+		amt, err := k.convertExposureAmount(ctx, coin, denom)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		rv = rv.Add(amt)
+	}
+	return rv, errors.Join(errs...)
+}
+
+// GetAccountExposure gets the value (in the provided denom) of everything an account has in a market.
+// That is the price of each of its orders (including trigger orders) plus the amount it has committed.
+// Amounts in other denoms are converted using recorded NAVs. An error is returned if a needed NAV is not found.
+func (k Keeper) GetAccountExposure(ctx sdk.Context, marketID uint32, addr sdk.AccAddress, denom string) (sdk.Coin, error) {
+	store := k.getStore(ctx)
+	owner := addr.String()
+
+	var toSum sdk.Coins
+	var errs []error
+	k.IterateAddressOrders(ctx, addr, func(orderID uint64, _ byte) bool {
+		order, err := k.getOrderFromStore(store, orderID)
+		switch {
+		case err != nil:
+			errs = append(errs, err)
+		case order != nil && order.GetMarketID() == marketID:
+			toSum = toSum.Add(order.GetPrice())
+		}
+		return false
+	})
+
+	triggerOrders, err := k.getMarketTriggerOrdersFromStore(store, marketID)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	for _, triggerOrder := range triggerOrders {
+		if triggerOrder.Order.GetOwner() == owner {
+			toSum = toSum.Add(triggerOrder.Order.GetPrice())
+		}
+	}
+
+	toSum = toSum.Add(getCommitmentAmount(store, marketID, addr)...)
+
+	amt, err := k.sumExposure(ctx, toSum, denom)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	if len(errs) > 0 {
+		return sdk.Coin{Denom: denom, Amount: sdkmath.ZeroInt()}, errors.Join(errs...)
+	}
+	return sdk.NewCoin(denom, amt), nil
+}
+
+// validateExposure returns an error if adding the provided amount to what an account already
+// has in a market would put the account over the market's max exposure.
+// The toRemove amount is anything that toAdd is replacing (e.g. the old price of an amended order).
+// It's taken out of the account's exposure, and if toAdd isn't more than it, there's nothing to check.
+func (k Keeper) validateExposure(ctx sdk.Context, store storetypes.KVStore, marketID uint32, addr sdk.AccAddress, toAdd, toRemove sdk.Coins) error {
+	maxExposure := getMaxExposure(store, marketID)
+	if maxExposure == nil {
+		return nil
+	}
+
+	added, err := k.sumExposure(ctx, toAdd, maxExposure.Denom)
+	if err != nil {
+		return fmt.Errorf("could not determine exposure of account %s in market %d: %w", addr, marketID, err)
+	}
+	removed, err := k.sumExposure(ctx, toRemove, maxExposure.Denom)
+	if err != nil {
+		return fmt.Errorf("could not determine exposure of account %s in market %d: %w", addr, marketID, err)
+	}
+	if !toRemove.IsZero() && added.LTE(removed) {
+		return nil
+	}
+
+	cur, err := k.GetAccountExposure(ctx, marketID, addr, maxExposure.Denom)
+	if err != nil {
+		return fmt.Errorf("could not determine exposure of account %s in market %d: %w", addr, marketID, err)
+	}
+
+	total := cur.AddAmount(added).SubAmount(removed)
+	if total.Amount.GT(maxExposure.Amount) {
+		return fmt.Errorf("account %s exposure in market %d would be %s, which exceeds the max of %s",
+			addr, marketID, total, maxExposure)
+	}
+	return nil
+}
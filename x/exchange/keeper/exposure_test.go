@@ -0,0 +1,372 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+	"github.com/provenance-io/provenance/x/exchange/keeper"
+)
+
+func (s *TestSuite) TestKeeper_GetAccountExposure() {
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+
+	askOrder := func(orderID uint64, marketID uint32, seller sdk.AccAddress, price string) *exchange.Order {
+		return exchange.NewOrder(orderID).WithAsk(&exchange.AskOrder{
+			MarketId: marketID,
+			Seller:   seller.String(),
+			Assets:   s.coin("10apple"),
+			Price:    s.coin(price),
+		})
+	}
+	bidOrder := func(orderID uint64, marketID uint32, buyer sdk.AccAddress, price string) *exchange.Order {
+		return exchange.NewOrder(orderID).WithBid(&exchange.BidOrder{
+			MarketId: marketID,
+			Buyer:    buyer.String(),
+			Assets:   s.coin("10apple"),
+			Price:    s.coin(price),
+		})
+	}
+	navs := NewMockMarkerKeeper().
+		WithGetNetAssetValueResult(s.coin("3plum"), s.coin("10cherry")).
+		WithGetNetAssetValueResult(s.coin("1cherry"), s.coin("1plum"))
+
+	tests := []struct {
+		name       string
+		setup      func()
+		marketID   uint32
+		addr       sdk.AccAddress
+		denom      string
+		expected   sdk.Coin
+		expErr     string
+		useNavMock bool
+	}{
+		{
+			name:     "empty state",
+			marketID: 3,
+			addr:     addr1,
+			denom:    "cherry",
+			expected: s.coin("0cherry"),
+		},
+		{
+			name: "only other accounts and markets",
+			setup: func() {
+				store := s.getStore()
+				s.requireSetOrdersInStore(store,
+					askOrder(1, 3, addr2, "5cherry"),
+					bidOrder(2, 4, addr1, "7cherry"),
+				)
+				s.requireSetTriggerOrderInStore(&exchange.TriggerOrder{
+					Order:        *bidOrder(3, 3, addr2, "11cherry"),
+					TriggerPrice: s.coin("1cherry"),
+				})
+				keeper.SetCommitmentAmount(store, 3, addr2, s.coins("13cherry"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 4, addr1, s.coins("17cherry"), s.ctx.BlockTime())
+			},
+			marketID: 3,
+			addr:     addr1,
+			denom:    "cherry",
+			expected: s.coin("0cherry"),
+		},
+		{
+			name: "orders, trigger orders, and commitments all in the same denom",
+			setup: func() {
+				store := s.getStore()
+				s.requireSetOrdersInStore(store,
+					askOrder(1, 3, addr1, "5cherry"),
+					bidOrder(2, 3, addr1, "7cherry"),
+					askOrder(3, 3, addr2, "100cherry"),
+					bidOrder(4, 4, addr1, "100cherry"),
+				)
+				s.requireSetTriggerOrderInStore(&exchange.TriggerOrder{
+					Order:        *askOrder(5, 3, addr1, "11cherry"),
+					TriggerPrice: s.coin("1cherry"),
+				})
+				s.requireSetTriggerOrderInStore(&exchange.TriggerOrder{
+					Order:        *askOrder(6, 3, addr2, "100cherry"),
+					TriggerPrice: s.coin("1cherry"),
+				})
+				keeper.SetCommitmentAmount(store, 3, addr1, s.coins("13cherry"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, addr2, s.coins("100cherry"), s.ctx.BlockTime())
+			},
+			marketID: 3,
+			addr:     addr1,
+			denom:    "cherry",
+			expected: s.coin("36cherry"),
+		},
+		{
+			name: "other denoms are converted and rounded up",
+			setup: func() {
+				store := s.getStore()
+				s.requireSetOrdersInStore(store,
+					bidOrder(1, 3, addr1, "7cherry"),
+					bidOrder(2, 3, addr1, "4plum"),
+				)
+				keeper.SetCommitmentAmount(store, 3, addr1, s.coins("2cherry,5plum"), s.ctx.BlockTime())
+			},
+			useNavMock: true,
+			marketID:   3,
+			addr:       addr1,
+			denom:      "cherry",
+			// 7 + 2 = 9cherry; 9plum * 10cherry / 3plum = 30cherry.
+			expected: s.coin("39cherry"),
+		},
+		{
+			name: "rounding up",
+			setup: func() {
+				keeper.SetCommitmentAmount(s.getStore(), 3, addr1, s.coins("4plum"), s.ctx.BlockTime())
+			},
+			useNavMock: true,
+			marketID:   3,
+			addr:       addr1,
+			denom:      "cherry",
+			// 4plum * 10cherry / 3plum = 13.33 => 14cherry.
+			expected: s.coin("14cherry"),
+		},
+		{
+			name: "no nav for a denom",
+			setup: func() {
+				keeper.SetCommitmentAmount(s.getStore(), 3, addr1, s.coins("2cherry,5pear"), s.ctx.BlockTime())
+			},
+			useNavMock: true,
+			marketID:   3,
+			addr:       addr1,
+			denom:      "cherry",
+			expected:   s.coin("0cherry"),
+			expErr:     "no nav found from \"pear\" to \"cherry\"",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			kpr := s.k
+			if tc.useNavMock {
+				kpr = s.k.WithMarkerKeeper(navs)
+			}
+
+			var actual sdk.Coin
+			var err error
+			testFunc := func() {
+				actual, err = kpr.GetAccountExposure(s.ctx, tc.marketID, tc.addr, tc.denom)
+			}
+			s.Require().NotPanics(testFunc, "GetAccountExposure(%d, %s, %q)", tc.marketID, tc.addr, tc.denom)
+			s.assertErrorValue(err, tc.expErr, "GetAccountExposure(%d, %s, %q) error", tc.marketID, tc.addr, tc.denom)
+			s.Assert().Equal(tc.expected.String(), actual.String(), "GetAccountExposure(%d, %s, %q) result",
+				tc.marketID, tc.addr, tc.denom)
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_ValidateExposure() {
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	maxExposure := func(coin string) func() {
+		return func() {
+			keeper.SetMaxExposure(s.getStore(), 3, s.coinP(coin))
+		}
+	}
+	navs := NewMockMarkerKeeper().WithGetNetAssetValueResult(s.coin("3plum"), s.coin("10cherry"))
+
+	tests := []struct {
+		name     string
+		setup    func()
+		marketID uint32
+		addr     sdk.AccAddress
+		toAdd    string
+		toRemove string
+		expErr   string
+	}{
+		{
+			name:     "no max exposure",
+			marketID: 3,
+			addr:     addr1,
+			toAdd:    "1000000cherry,5pear",
+		},
+		{
+			name: "max exposure in another market",
+			setup: func() {
+				keeper.SetMaxExposure(s.getStore(), 4, s.coinP("1cherry"))
+			},
+			marketID: 3,
+			addr:     addr1,
+			toAdd:    "1000cherry",
+		},
+		{
+			name:     "nothing yet: under the max",
+			setup:    maxExposure("100cherry"),
+			marketID: 3,
+			addr:     addr1,
+			toAdd:    "99cherry",
+		},
+		{
+			name:     "nothing yet: exactly the max",
+			setup:    maxExposure("100cherry"),
+			marketID: 3,
+			addr:     addr1,
+			toAdd:    "100cherry",
+		},
+		{
+			name:     "nothing yet: over the max",
+			setup:    maxExposure("100cherry"),
+			marketID: 3,
+			addr:     addr1,
+			toAdd:    "101cherry",
+			expErr:   "account " + addr1.String() + " exposure in market 3 would be 101cherry, which exceeds the max of 100cherry",
+		},
+		{
+			name: "existing exposure: exactly the max",
+			setup: func() {
+				maxExposure("100cherry")()
+				keeper.SetCommitmentAmount(s.getStore(), 3, addr1, s.coins("60cherry"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(s.getStore(), 3, addr2, s.coins("60cherry"), s.ctx.BlockTime())
+			},
+			marketID: 3,
+			addr:     addr1,
+			toAdd:    "40cherry",
+		},
+		{
+			name: "existing exposure: over the max",
+			setup: func() {
+				maxExposure("100cherry")()
+				keeper.SetCommitmentAmount(s.getStore(), 3, addr1, s.coins("60cherry"), s.ctx.BlockTime())
+			},
+			marketID: 3,
+			addr:     addr1,
+			toAdd:    "41cherry",
+			expErr:   "account " + addr1.String() + " exposure in market 3 would be 101cherry, which exceeds the max of 100cherry",
+		},
+		{
+			name: "converted amount over the max",
+			setup: func() {
+				maxExposure("100cherry")()
+				keeper.SetCommitmentAmount(s.getStore(), 3, addr1, s.coins("60cherry"), s.ctx.BlockTime())
+			},
+			marketID: 3,
+			addr:     addr1,
+			toAdd:    "13plum",
+			expErr:   "account " + addr1.String() + " exposure in market 3 would be 104cherry, which exceeds the max of 100cherry",
+		},
+		{
+			name: "converted amount under the max",
+			setup: func() {
+				maxExposure("100cherry")()
+				keeper.SetCommitmentAmount(s.getStore(), 3, addr1, s.coins("60cherry"), s.ctx.BlockTime())
+			},
+			marketID: 3,
+			addr:     addr1,
+			toAdd:    "12plum",
+		},
+		{
+			name: "no nav for existing exposure",
+			setup: func() {
+				maxExposure("100cherry")()
+				keeper.SetCommitmentAmount(s.getStore(), 3, addr1, s.coins("1pear"), s.ctx.BlockTime())
+			},
+			marketID: 3,
+			addr:     addr1,
+			toAdd:    "1cherry",
+			expErr: "could not determine exposure of account " + addr1.String() + " in market 3: " +
+				"no nav found from \"pear\" to \"cherry\"",
+		},
+		{
+			name:     "no nav for amount to add",
+			setup:    maxExposure("100cherry"),
+			marketID: 3,
+			addr:     addr1,
+			toAdd:    "1pear",
+			expErr: "could not determine exposure of account " + addr1.String() + " in market 3: " +
+				"no nav found from \"pear\" to \"cherry\"",
+		},
+		{
+			name:     "no nav for amount to remove",
+			setup:    maxExposure("100cherry"),
+			marketID: 3,
+			addr:     addr1,
+			toAdd:    "1cherry",
+			toRemove: "1pear",
+			expErr: "could not determine exposure of account " + addr1.String() + " in market 3: " +
+				"no nav found from \"pear\" to \"cherry\"",
+		},
+		{
+			name: "replacement: increase up to the max",
+			setup: func() {
+				maxExposure("100cherry")()
+				keeper.SetCommitmentAmount(s.getStore(), 3, addr1, s.coins("90cherry"), s.ctx.BlockTime())
+			},
+			marketID: 3,
+			addr:     addr1,
+			toAdd:    "50cherry",
+			toRemove: "40cherry",
+		},
+		{
+			name: "replacement: increase over the max",
+			setup: func() {
+				maxExposure("100cherry")()
+				keeper.SetCommitmentAmount(s.getStore(), 3, addr1, s.coins("90cherry"), s.ctx.BlockTime())
+			},
+			marketID: 3,
+			addr:     addr1,
+			toAdd:    "51cherry",
+			toRemove: "40cherry",
+			expErr:   "account " + addr1.String() + " exposure in market 3 would be 101cherry, which exceeds the max of 100cherry",
+		},
+		{
+			name: "replacement: converted increase over the max",
+			setup: func() {
+				maxExposure("100cherry")()
+				keeper.SetCommitmentAmount(s.getStore(), 3, addr1, s.coins("90cherry"), s.ctx.BlockTime())
+			},
+			marketID: 3,
+			addr:     addr1,
+			toAdd:    "16plum",
+			toRemove: "40cherry",
+			expErr:   "account " + addr1.String() + " exposure in market 3 would be 104cherry, which exceeds the max of 100cherry",
+		},
+		{
+			name: "replacement: decrease while already over the max",
+			setup: func() {
+				maxExposure("100cherry")()
+				keeper.SetCommitmentAmount(s.getStore(), 3, addr1, s.coins("150cherry"), s.ctx.BlockTime())
+			},
+			marketID: 3,
+			addr:     addr1,
+			toAdd:    "30cherry",
+			toRemove: "40cherry",
+		},
+		{
+			name: "replacement: no change while already over the max",
+			setup: func() {
+				maxExposure("100cherry")()
+				keeper.SetCommitmentAmount(s.getStore(), 3, addr1, s.coins("150cherry"), s.ctx.BlockTime())
+			},
+			marketID: 3,
+			addr:     addr1,
+			toAdd:    "40cherry",
+			toRemove: "40cherry",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			kpr := s.k.WithMarkerKeeper(navs)
+			toAdd := s.coins(tc.toAdd)
+			toRemove := s.coins(tc.toRemove)
+			var err error
+			testFunc := func() {
+				err = kpr.ValidateExposure(s.ctx, tc.marketID, tc.addr, toAdd, toRemove)
+			}
+			s.Require().NotPanics(testFunc, "validateExposure(%d, %s, %q, %q)", tc.marketID, tc.addr, tc.toAdd, tc.toRemove)
+			s.assertErrorValue(err, tc.expErr, "validateExposure(%d, %s, %q, %q)", tc.marketID, tc.addr, tc.toAdd, tc.toRemove)
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+// validateAccountExternalIDIsAvailable returns an error if the owner cannot use the provided external id for an order
+// in a market with the given scope. The order with the provided id is ignored so that it doesn't conflict with itself.
+//
+// An order's external id conflicts with another of the owner's orders if either order is in a market
+// with the account scope. If the scope is account, it also conflicts with the owner's payments.
+func validateAccountExternalIDIsAvailable(store storetypes.KVStore, scope exchange.ExternalIDScope, owner sdk.AccAddress, externalID string, orderID uint64) error {
+	if len(externalID) == 0 {
+		return nil
+	}
+
+	isAccountScope := scope == exchange.ExternalIDScope_account
+	var err error
+	iterate(store, GetIndexKeyPrefixAddressExternalIDToOrder(owner, externalID), func(keySuffix, value []byte) bool {
+		otherOrderID, ok := uint64FromBz(keySuffix)
+		if !ok || otherOrderID == orderID {
+			return false
+		}
+		otherMarketID, _ := uint32FromBz(value)
+		if isAccountScope || getExternalIDScope(store, otherMarketID) == exchange.ExternalIDScope_account {
+			err = fmt.Errorf("external id %q is already in use by order %d: cannot be used for order %d",
+				externalID, otherOrderID, orderID)
+			return true
+		}
+		return false
+	})
+	if err != nil || !isAccountScope {
+		return err
+	}
+
+	if store.Has(MakeKeyPayment(owner, externalID)) {
+		return fmt.Errorf("external id %q is already in use by a payment from %s: cannot be used for order %d",
+			externalID, owner, orderID)
+	}
+	if store.Has(MakeKeyEscrowedPayment(owner, externalID)) {
+		return fmt.Errorf("external id %q is already in use by an escrowed payment from %s: cannot be used for order %d",
+			externalID, owner, orderID)
+	}
+	return nil
+}
+
+// validateExternalIDNotUsedByAccountScopedOrder returns an error if the source has an order with
+// the provided external id in a market with the account external id scope.
+func validateExternalIDNotUsedByAccountScopedOrder(store storetypes.KVStore, source, externalID string) error {
+	if len(externalID) == 0 || exchange.ValidateExternalID(externalID) != nil {
+		return nil
+	}
+	sourceAddr, err := sdk.AccAddressFromBech32(source)
+	if err != nil {
+		return nil
+	}
+
+	iterate(store, GetIndexKeyPrefixAddressExternalIDToOrder(sourceAddr, externalID), func(keySuffix, value []byte) bool {
+		orderID, _ := uint64FromBz(keySuffix)
+		marketID, _ := uint32FromBz(value)
+		if getExternalIDScope(store, marketID) == exchange.ExternalIDScope_account {
+			err = fmt.Errorf("external id %q is already in use by order %d from %s in market %d",
+				externalID, orderID, source, marketID)
+			return true
+		}
+		return false
+	})
+	return err
+}
+
+// validateMarketExternalIDsForScope returns an error if any of a market's orders
+// would have a conflicting external id under the provided scope.
+func (k Keeper) validateMarketExternalIDsForScope(store storetypes.KVStore, marketID uint32, scope exchange.ExternalIDScope) error {
+	if scope != exchange.ExternalIDScope_account {
+		// Narrowing the scope can't create any conflicts.
+		return nil
+	}
+
+	var orderIDs []uint64
+	iterate(store, GetIndexKeyPrefixMarketExternalIDToOrder(marketID, ""), func(_, value []byte) bool {
+		if orderID, ok := uint64FromBz(value); ok {
+			orderIDs = append(orderIDs, orderID)
+		}
+		return false
+	})
+
+	for _, orderID := range orderIDs {
+		order, err := k.getOrderFromStore(store, orderID)
+		if err != nil {
+			return err
+		}
+		if order == nil {
+			continue
+		}
+		owner := sdk.MustAccAddressFromBech32(order.GetOwner())
+		err = validateAccountExternalIDIsAvailable(store, scope, owner, order.GetExternalID(), orderID)
+		if err != nil {
+			return fmt.Errorf("cannot change market %d external id scope to %s: %w", marketID, scope.SimpleString(), err)
+		}
+	}
+	return nil
+}
+
+// IndexOrderExternalIDs makes sure every order with an external id has an entry in the address and external id
+// to order index. This is only needed for orders that were created before that index existed.
+// Returns the number of orders indexed and any problems encountered reading the orders.
+func (k Keeper) IndexOrderExternalIDs(ctx sdk.Context) (int, error) {
+	var orders []*exchange.Order
+	err := k.IterateOrders(ctx, func(order *exchange.Order) bool {
+		if len(order.GetExternalID()) > 0 {
+			orders = append(orders, order)
+		}
+		return false
+	})
+
+	store := k.getStore(ctx)
+	for _, order := range orders {
+		entry := createAddressExternalIDToOrderEntry(order)
+		store.Set(entry.Key, entry.Value)
+	}
+	return len(orders), err
+}
@@ -0,0 +1,164 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+	"github.com/provenance-io/provenance/x/exchange/keeper"
+)
+
+func (s *TestSuite) TestKeeper_SetOrderInStore_ExternalIDScope() {
+	newAsk := func(orderID uint64, marketID uint32, seller sdk.AccAddress, externalID string) *exchange.Order {
+		return exchange.NewOrder(orderID).WithAsk(&exchange.AskOrder{
+			MarketId:   marketID,
+			Seller:     seller.String(),
+			Assets:     s.coin("10apple"),
+			Price:      s.coin("80prune"),
+			ExternalId: externalID,
+		})
+	}
+	newBid := func(orderID uint64, marketID uint32, buyer sdk.AccAddress, externalID string) *exchange.Order {
+		return exchange.NewOrder(orderID).WithBid(&exchange.BidOrder{
+			MarketId:   marketID,
+			Buyer:      buyer.String(),
+			Assets:     s.coin("10apple"),
+			Price:      s.coin("80prune"),
+			ExternalId: externalID,
+		})
+	}
+
+	tests := []struct {
+		name   string
+		setup  func()
+		order  *exchange.Order
+		expErr string
+	}{
+		{
+			name: "market scope: same owner and external id in another market",
+			setup: func() {
+				s.requireSetOrderInStore(s.getStore(), newAsk(1, 3, s.addr1, "dup"))
+			},
+			order: newBid(2, 4, s.addr1, "dup"),
+		},
+		{
+			name: "account scope: same owner and external id in another market",
+			setup: func() {
+				keeper.SetExternalIDScope(s.getStore(), 4, exchange.ExternalIDScope_account)
+				s.requireSetOrderInStore(s.getStore(), newAsk(1, 3, s.addr1, "dup"))
+			},
+			order:  newBid(2, 4, s.addr1, "dup"),
+			expErr: "external id \"dup\" is already in use by order 1: cannot be used for order 2",
+		},
+		{
+			name: "market scope: same owner and external id in an account-scoped market",
+			setup: func() {
+				keeper.SetExternalIDScope(s.getStore(), 3, exchange.ExternalIDScope_account)
+				s.requireSetOrderInStore(s.getStore(), newAsk(1, 3, s.addr1, "dup"))
+			},
+			order:  newBid(2, 4, s.addr1, "dup"),
+			expErr: "external id \"dup\" is already in use by order 1: cannot be used for order 2",
+		},
+		{
+			name: "account scope: different owner with same external id",
+			setup: func() {
+				keeper.SetExternalIDScope(s.getStore(), 4, exchange.ExternalIDScope_account)
+				s.requireSetOrderInStore(s.getStore(), newAsk(1, 3, s.addr2, "dup"))
+			},
+			order: newBid(2, 4, s.addr1, "dup"),
+		},
+		{
+			name: "account scope: same owner with different external id",
+			setup: func() {
+				keeper.SetExternalIDScope(s.getStore(), 4, exchange.ExternalIDScope_account)
+				s.requireSetOrderInStore(s.getStore(), newAsk(1, 3, s.addr1, "dup1"))
+			},
+			order: newBid(2, 4, s.addr1, "dup2"),
+		},
+		{
+			name: "account scope: re-setting the same order",
+			setup: func() {
+				keeper.SetExternalIDScope(s.getStore(), 4, exchange.ExternalIDScope_account)
+				s.requireSetOrderInStore(s.getStore(), newBid(2, 4, s.addr1, "dup"))
+			},
+			order: newBid(2, 4, s.addr1, "dup"),
+		},
+		{
+			name: "account scope: payment from owner with same external id",
+			setup: func() {
+				keeper.SetExternalIDScope(s.getStore(), 4, exchange.ExternalIDScope_account)
+				s.requireSetPaymentsInStore(s.newTestPayment(s.addr1, "5apple", s.addr2, "", "dup"))
+			},
+			order:  newBid(2, 4, s.addr1, "dup"),
+			expErr: "external id \"dup\" is already in use by a payment from " + s.addr1.String() + ": cannot be used for order 2",
+		},
+		{
+			name: "market scope: payment from owner with same external id",
+			setup: func() {
+				s.requireSetPaymentsInStore(s.newTestPayment(s.addr1, "5apple", s.addr2, "", "dup"))
+			},
+			order: newBid(2, 4, s.addr1, "dup"),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var err error
+			testFunc := func() {
+				err = s.k.SetOrderInStore(s.getStore(), *tc.order)
+			}
+			s.Require().NotPanics(testFunc, "SetOrderInStore(%d)", tc.order.OrderId)
+			s.assertErrorValue(err, tc.expErr, "SetOrderInStore(%d)", tc.order.OrderId)
+
+			order, err := s.k.GetOrder(s.ctx, tc.order.OrderId)
+			s.Require().NoError(err, "GetOrder(%d)", tc.order.OrderId)
+			if len(tc.expErr) == 0 {
+				s.Assert().Equal(tc.order, order, "GetOrder(%d)", tc.order.OrderId)
+			} else {
+				s.Assert().Nil(order, "GetOrder(%d)", tc.order.OrderId)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_IndexOrderExternalIDs() {
+	s.clearExchangeState()
+
+	orders := []*exchange.Order{
+		exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+			MarketId: 3, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("1prune"), ExternalId: "one",
+		}),
+		exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+			MarketId: 3, Buyer: s.addr2.String(), Assets: s.coin("1apple"), Price: s.coin("1prune"),
+		}),
+		exchange.NewOrder(3).WithBid(&exchange.BidOrder{
+			MarketId: 5, Buyer: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("1prune"), ExternalId: "three",
+		}),
+	}
+	store := s.getStore()
+	for _, order := range orders {
+		s.requireSetOrderInStore(store, order)
+	}
+
+	// Remove the index entries so it looks like the orders were created before the index existed.
+	store.Delete(keeper.MakeIndexKeyAddressExternalIDToOrder(s.addr1, "one", 1))
+	store.Delete(keeper.MakeIndexKeyAddressExternalIDToOrder(s.addr1, "three", 3))
+
+	var count int
+	var err error
+	testFunc := func() {
+		count, err = s.k.IndexOrderExternalIDs(s.ctx)
+	}
+	s.Require().NotPanics(testFunc, "IndexOrderExternalIDs")
+	s.Require().NoError(err, "IndexOrderExternalIDs")
+	s.Assert().Equal(2, count, "IndexOrderExternalIDs count")
+
+	s.Assert().Equal([]byte{0, 0, 0, 3}, store.Get(keeper.MakeIndexKeyAddressExternalIDToOrder(s.addr1, "one", 1)),
+		"index entry for order 1")
+	s.Assert().Equal([]byte{0, 0, 0, 5}, store.Get(keeper.MakeIndexKeyAddressExternalIDToOrder(s.addr1, "three", 3)),
+		"index entry for order 3")
+}
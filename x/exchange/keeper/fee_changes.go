@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+// parseScheduledFeeChangeStoreValue converts a scheduled fee change store value into a MsgGovManageFeesRequest.
+func (k Keeper) parseScheduledFeeChangeStoreValue(value []byte) (*exchange.MsgGovManageFeesRequest, error) {
+	var change exchange.MsgGovManageFeesRequest
+	if err := k.cdc.Unmarshal(value, &change); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal scheduled fee change: %w", err)
+	}
+	return &change, nil
+}
+
+// getNextScheduledFeeChangeSequence gets the sequence to use for the next scheduled fee change in a market.
+func getNextScheduledFeeChangeSequence(store storetypes.KVStore, marketID uint32) uint64 {
+	iter := storetypes.KVStoreReversePrefixIterator(store, GetKeyPrefixMarketScheduledFeeChange(marketID))
+	defer iter.Close()
+	if !iter.Valid() {
+		return 0
+	}
+	sequence, _ := ParseKeySuffixScheduledFeeChange(iter.Key())
+	return sequence + 1
+}
+
+// addScheduledFeeChangeToStore writes a scheduled fee change to the store after any others already in its market.
+func (k Keeper) addScheduledFeeChangeToStore(store storetypes.KVStore, change exchange.MsgGovManageFeesRequest) error {
+	value, err := k.cdc.Marshal(&change)
+	if err != nil {
+		return fmt.Errorf("failed to marshal scheduled fee change: %w", err)
+	}
+	sequence := getNextScheduledFeeChangeSequence(store, change.MarketId)
+	store.Set(MakeKeyScheduledFeeChange(change.MarketId, sequence), value)
+	return nil
+}
+
+// validateFeeChangeSchedule returns an error if the provided fee change is not scheduled for a future block.
+func validateFeeChangeSchedule(change *exchange.MsgGovManageFeesRequest, height int64, blockTime time.Time) error {
+	if change.EffectiveTime != nil {
+		if !change.EffectiveTime.After(blockTime) {
+			return fmt.Errorf("invalid effective time %s: must be after the current block time %s",
+				change.EffectiveTime.UTC().Format(time.RFC3339), blockTime.UTC().Format(time.RFC3339))
+		}
+		return nil
+	}
+	if change.EffectiveHeight <= height {
+		return fmt.Errorf("invalid effective height %d: must be after the current block height %d",
+			change.EffectiveHeight, height)
+	}
+	return nil
+}
+
+// ScheduleFeeChange stores the provided fee changes so that they are applied once their effective height or time is reached.
+func (k Keeper) ScheduleFeeChange(ctx sdk.Context, change *exchange.MsgGovManageFeesRequest) error {
+	if !change.IsScheduled() {
+		return errors.New("fee change does not have an effective height or time")
+	}
+	if err := validateFeeChangeSchedule(change, ctx.BlockHeight(), ctx.BlockTime()); err != nil {
+		return err
+	}
+
+	store := k.getStore(ctx)
+	if err := validateMarketExists(store, change.MarketId); err != nil {
+		return err
+	}
+	if err := k.addScheduledFeeChangeToStore(store, *change); err != nil {
+		return err
+	}
+
+	k.emitEvent(ctx, exchange.NewEventMarketFeesScheduled(change.MarketId, change.EffectiveHeight, change.EffectiveTime))
+	return nil
+}
+
+// GetScheduledFeeChanges gets the fee changes waiting to be applied to a market, in the order they'll be applied.
+func (k Keeper) GetScheduledFeeChanges(ctx sdk.Context, marketID uint32) ([]exchange.MsgGovManageFeesRequest, error) {
+	var rv []exchange.MsgGovManageFeesRequest
+	var errs []error
+	k.iterate(ctx, GetKeyPrefixMarketScheduledFeeChange(marketID), func(_, value []byte) bool {
+		change, err := k.parseScheduledFeeChangeStoreValue(value)
+		if err != nil {
+			errs = append(errs, err)
+			return false
+		}
+		rv = append(rv, *change)
+		return false
+	})
+	return rv, errors.Join(errs...)
+}
+
+// IterateScheduledFeeChanges iterates over all scheduled fee changes. An error is returned if there was a problem
+// reading an entry along the way. Such a problem does not interrupt iteration.
+// The callback takes in the scheduled fee change and should return whether to stop iterating.
+func (k Keeper) IterateScheduledFeeChanges(ctx sdk.Context, cb func(change *exchange.MsgGovManageFeesRequest) bool) error {
+	var errs []error
+	k.iterate(ctx, GetKeyPrefixScheduledFeeChange(), func(_, value []byte) bool {
+		change, err := k.parseScheduledFeeChangeStoreValue(value)
+		if err != nil {
+			errs = append(errs, err)
+			return false
+		}
+		return cb(change)
+	})
+	return errors.Join(errs...)
+}
+
+// ApplyScheduledFeeChanges applies (then deletes) all the scheduled fee changes that have reached their
+// effective height or time. Changes to the same market are applied in the order they were scheduled.
+// Entries that cannot be read, or that are for a market that no longer exists, are logged and deleted.
+func (k Keeper) ApplyScheduledFeeChanges(ctx sdk.Context) {
+	store := k.getStore(ctx)
+	height, blockTime := ctx.BlockHeight(), ctx.BlockTime()
+
+	// Gather everything first so we aren't writing to the store while iterating it.
+	var keys [][]byte
+	var changes []*exchange.MsgGovManageFeesRequest
+	iter := storetypes.KVStorePrefixIterator(store, GetKeyPrefixScheduledFeeChange())
+	for ; iter.Valid(); iter.Next() {
+		change, err := k.parseScheduledFeeChangeStoreValue(iter.Value())
+		if err != nil {
+			k.logErrorf(ctx, "error reading scheduled fee change with key %x: %v", iter.Key(), err)
+			keys = append(keys, iter.Key())
+			changes = append(changes, nil)
+			continue
+		}
+		if change.IsDue(height, blockTime) {
+			keys = append(keys, iter.Key())
+			changes = append(changes, change)
+		}
+	}
+	iter.Close()
+
+	for i, key := range keys {
+		store.Delete(key)
+		change := changes[i]
+		if change == nil {
+			continue
+		}
+		if !isMarketKnown(store, change.MarketId) {
+			k.logErrorf(ctx, "could not apply scheduled fee change: market %d does not exist", change.MarketId)
+			continue
+		}
+		k.UpdateFees(ctx, change)
+	}
+}
@@ -0,0 +1,302 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+	"github.com/provenance-io/provenance/x/exchange"
+	"github.com/provenance-io/provenance/x/exchange/keeper"
+)
+
+// requireAddScheduledFeeChanges stores the provided scheduled fee changes.
+func (s *TestSuite) requireAddScheduledFeeChanges(changes ...exchange.MsgGovManageFeesRequest) {
+	for i, change := range changes {
+		assertions.RequireNotPanicsNoErrorf(s.T(), func() error {
+			return s.k.AddScheduledFeeChangeToStore(s.getStore(), change)
+		}, "[%d]: AddScheduledFeeChangeToStore", i)
+	}
+}
+
+// getAllScheduledFeeChanges gets all the scheduled fee changes in state, requiring there to not be any errors.
+func (s *TestSuite) getAllScheduledFeeChanges() []exchange.MsgGovManageFeesRequest {
+	var rv []exchange.MsgGovManageFeesRequest
+	err := s.k.IterateScheduledFeeChanges(s.ctx, func(change *exchange.MsgGovManageFeesRequest) bool {
+		rv = append(rv, *change)
+		return false
+	})
+	s.Require().NoError(err, "IterateScheduledFeeChanges")
+	return rv
+}
+
+// feeChangeAtHeight creates a MsgGovManageFeesRequest that sets the commitment settlement bips at the given height.
+func (s *TestSuite) feeChangeAtHeight(marketID uint32, bips uint32, height int64) exchange.MsgGovManageFeesRequest {
+	return exchange.MsgGovManageFeesRequest{
+		Authority:                      s.k.GetAuthority(),
+		MarketId:                       marketID,
+		SetFeeCommitmentSettlementBips: bips,
+		EffectiveHeight:                height,
+	}
+}
+
+// feeChangeAtTime creates a MsgGovManageFeesRequest that sets the commitment settlement bips at the given time.
+func (s *TestSuite) feeChangeAtTime(marketID uint32, bips uint32, effTime time.Time) exchange.MsgGovManageFeesRequest {
+	return exchange.MsgGovManageFeesRequest{
+		Authority:                      s.k.GetAuthority(),
+		MarketId:                       marketID,
+		SetFeeCommitmentSettlementBips: bips,
+		EffectiveTime:                  &effTime,
+	}
+}
+
+func (s *TestSuite) TestKeeper_ScheduleFeeChange() {
+	blockTime := time.Unix(1_700_000_000, 0).UTC()
+	height := int64(100)
+	setup := func() {
+		keeper.SetMarketKnown(s.getStore(), 1)
+		keeper.SetMarketKnown(s.getStore(), 2)
+		s.requireAddScheduledFeeChanges(
+			s.feeChangeAtHeight(1, 11, 200),
+			s.feeChangeAtHeight(2, 21, 200),
+		)
+	}
+
+	tests := []struct {
+		name       string
+		change     exchange.MsgGovManageFeesRequest
+		expErr     string
+		expChanges []exchange.MsgGovManageFeesRequest
+	}{
+		{
+			name:   "not scheduled",
+			change: s.feeChangeAtHeight(1, 5, 0),
+			expErr: "fee change does not have an effective height or time",
+		},
+		{
+			name:   "effective height is current height",
+			change: s.feeChangeAtHeight(1, 5, height),
+			expErr: "invalid effective height 100: must be after the current block height 100",
+		},
+		{
+			name:   "effective height before current height",
+			change: s.feeChangeAtHeight(1, 5, height-1),
+			expErr: "invalid effective height 99: must be after the current block height 100",
+		},
+		{
+			name:   "effective time is current time",
+			change: s.feeChangeAtTime(1, 5, blockTime),
+			expErr: "invalid effective time 2023-11-14T22:13:20Z: must be after the current block time 2023-11-14T22:13:20Z",
+		},
+		{
+			name:   "unknown market",
+			change: s.feeChangeAtHeight(3, 5, height+1),
+			expErr: "market 3 does not exist",
+		},
+		{
+			name:   "at height",
+			change: s.feeChangeAtHeight(1, 5, height+1),
+			expChanges: []exchange.MsgGovManageFeesRequest{
+				s.feeChangeAtHeight(1, 11, 200),
+				s.feeChangeAtHeight(1, 5, height+1),
+				s.feeChangeAtHeight(2, 21, 200),
+			},
+		},
+		{
+			name:   "at time",
+			change: s.feeChangeAtTime(2, 5, blockTime.Add(time.Second)),
+			expChanges: []exchange.MsgGovManageFeesRequest{
+				s.feeChangeAtHeight(1, 11, 200),
+				s.feeChangeAtHeight(2, 21, 200),
+				s.feeChangeAtTime(2, 5, blockTime.Add(time.Second)),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			setup()
+			if len(tc.expErr) > 0 {
+				tc.expChanges = s.getAllScheduledFeeChanges()
+			}
+			var expEvents sdk.Events
+			if len(tc.expErr) == 0 {
+				expEvents = append(expEvents, s.untypeEvent(exchange.NewEventMarketFeesScheduled(
+					tc.change.MarketId, tc.change.EffectiveHeight, tc.change.EffectiveTime)))
+			}
+
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em).WithBlockHeight(height).WithBlockTime(blockTime)
+			var err error
+			testFunc := func() {
+				err = s.k.ScheduleFeeChange(ctx, &tc.change)
+			}
+			s.Require().NotPanics(testFunc, "ScheduleFeeChange")
+			s.assertErrorValue(err, tc.expErr, "ScheduleFeeChange error")
+			s.assertEqualEvents(expEvents, em.Events(), "events emitted during ScheduleFeeChange")
+
+			actChanges := s.getAllScheduledFeeChanges()
+			s.Assert().Equal(tc.expChanges, actChanges, "scheduled fee changes after ScheduleFeeChange")
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_GetScheduledFeeChanges() {
+	effTime := time.Unix(1_800_000_000, 0).UTC()
+	setup := func() {
+		s.requireAddScheduledFeeChanges(
+			s.feeChangeAtHeight(1, 11, 200),
+			s.feeChangeAtHeight(2, 21, 300),
+			s.feeChangeAtTime(2, 22, effTime),
+			s.feeChangeAtHeight(2, 23, 250),
+			s.feeChangeAtHeight(4, 41, 400),
+		)
+	}
+
+	tests := []struct {
+		name     string
+		marketID uint32
+		expected []exchange.MsgGovManageFeesRequest
+	}{
+		{
+			name:     "market without any",
+			marketID: 3,
+			expected: nil,
+		},
+		{
+			name:     "market with one",
+			marketID: 1,
+			expected: []exchange.MsgGovManageFeesRequest{s.feeChangeAtHeight(1, 11, 200)},
+		},
+		{
+			name:     "market with three",
+			marketID: 2,
+			expected: []exchange.MsgGovManageFeesRequest{
+				s.feeChangeAtHeight(2, 21, 300),
+				s.feeChangeAtTime(2, 22, effTime),
+				s.feeChangeAtHeight(2, 23, 250),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			setup()
+
+			var actual []exchange.MsgGovManageFeesRequest
+			var err error
+			testFunc := func() {
+				actual, err = s.k.GetScheduledFeeChanges(s.ctx, tc.marketID)
+			}
+			s.Require().NotPanics(testFunc, "GetScheduledFeeChanges(%d)", tc.marketID)
+			s.Assert().NoError(err, "GetScheduledFeeChanges(%d) error", tc.marketID)
+			s.Assert().Equal(tc.expected, actual, "GetScheduledFeeChanges(%d) result", tc.marketID)
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_ApplyScheduledFeeChanges() {
+	blockTime := time.Unix(1_700_000_000, 0).UTC()
+	height := int64(100)
+
+	tests := []struct {
+		name       string
+		setup      func()
+		expBips    map[uint32]uint32
+		expEvents  sdk.Events
+		expChanges []exchange.MsgGovManageFeesRequest
+	}{
+		{
+			name:  "nothing scheduled",
+			setup: func() { keeper.SetMarketKnown(s.getStore(), 1) },
+		},
+		{
+			name: "nothing due",
+			setup: func() {
+				keeper.SetMarketKnown(s.getStore(), 1)
+				s.requireAddScheduledFeeChanges(
+					s.feeChangeAtHeight(1, 11, height+1),
+					s.feeChangeAtTime(1, 12, blockTime.Add(time.Second)),
+				)
+			},
+			expChanges: []exchange.MsgGovManageFeesRequest{
+				s.feeChangeAtHeight(1, 11, height+1),
+				s.feeChangeAtTime(1, 12, blockTime.Add(time.Second)),
+			},
+		},
+		{
+			name: "some due in multiple markets",
+			setup: func() {
+				keeper.SetMarketKnown(s.getStore(), 1)
+				keeper.SetMarketKnown(s.getStore(), 2)
+				keeper.SetMarketKnown(s.getStore(), 3)
+				s.requireAddScheduledFeeChanges(
+					s.feeChangeAtHeight(1, 11, height),
+					s.feeChangeAtHeight(1, 12, height+1),
+					s.feeChangeAtTime(2, 21, blockTime.Add(-1*time.Hour)),
+					s.feeChangeAtHeight(2, 22, height-5),
+					s.feeChangeAtTime(3, 31, blockTime.Add(time.Hour)),
+				)
+			},
+			expBips: map[uint32]uint32{1: 11, 2: 22, 3: 0},
+			expEvents: sdk.Events{
+				s.untypeEvent(exchange.NewEventMarketFeesUpdated(1)),
+				s.untypeEvent(exchange.NewEventMarketFeesUpdated(2)),
+				s.untypeEvent(exchange.NewEventMarketFeesUpdated(2)),
+			},
+			expChanges: []exchange.MsgGovManageFeesRequest{
+				s.feeChangeAtHeight(1, 12, height+1),
+				s.feeChangeAtTime(3, 31, blockTime.Add(time.Hour)),
+			},
+		},
+		{
+			name: "market no longer exists",
+			setup: func() {
+				keeper.SetMarketKnown(s.getStore(), 1)
+				s.requireAddScheduledFeeChanges(
+					s.feeChangeAtHeight(1, 11, height),
+					s.feeChangeAtHeight(2, 21, height),
+				)
+			},
+			expBips:   map[uint32]uint32{1: 11, 2: 0},
+			expEvents: sdk.Events{s.untypeEvent(exchange.NewEventMarketFeesUpdated(1))},
+		},
+		{
+			name: "unreadable entry",
+			setup: func() {
+				keeper.SetMarketKnown(s.getStore(), 1)
+				s.getStore().Set(keeper.MakeKeyScheduledFeeChange(1, 0), []byte{0xFF, 0xFF})
+				s.requireAddScheduledFeeChanges(s.feeChangeAtHeight(1, 11, height))
+			},
+			expBips:   map[uint32]uint32{1: 11},
+			expEvents: sdk.Events{s.untypeEvent(exchange.NewEventMarketFeesUpdated(1))},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em).WithBlockHeight(height).WithBlockTime(blockTime)
+			testFunc := func() {
+				s.k.ApplyScheduledFeeChanges(ctx)
+			}
+			s.Require().NotPanics(testFunc, "ApplyScheduledFeeChanges")
+			s.assertEqualEvents(tc.expEvents, em.Events(), "events emitted during ApplyScheduledFeeChanges")
+
+			for marketID, expBips := range tc.expBips {
+				actBips := s.k.GetCommitmentSettlementBips(s.ctx, marketID)
+				s.Assert().Equal(int(expBips), int(actBips), "market %d commitment settlement bips", marketID)
+			}
+
+			actChanges := s.getAllScheduledFeeChanges()
+			s.Assert().Equal(tc.expChanges, actChanges, "scheduled fee changes after ApplyScheduledFeeChanges")
+		})
+	}
+}
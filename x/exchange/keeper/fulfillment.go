@@ -8,6 +8,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/gogoproto/proto"
@@ -40,32 +41,44 @@ func validateAcceptingOrdersAndCanUserSettle(store storetypes.KVStore, marketID
 
 // FillBids settles one or more bid orders for a seller.
 func (k Keeper) FillBids(ctx sdk.Context, msg *exchange.MsgFillBidsRequest) error {
+	_, err := k.fillBids(ctx, msg)
+	return err
+}
+
+// fillBids settles one or more bid orders for a seller, returning the settlement that was processed.
+func (k Keeper) fillBids(ctx sdk.Context, msg *exchange.MsgFillBidsRequest) (*exchange.Settlement, error) {
 	if err := msg.ValidateBasic(); err != nil {
-		return err
+		return nil, err
 	}
 
 	marketID := msg.MarketId
 	store := k.getStore(ctx)
 
 	if err := validateAcceptingOrdersAndCanUserSettle(store, marketID); err != nil {
-		return err
+		return nil, err
 	}
 	seller := sdk.MustAccAddressFromBech32(msg.Seller)
 	if err := k.validateUserCanCreateAsk(ctx, marketID, seller); err != nil {
-		return err
+		return nil, err
 	}
 	if err := validateCreateAskFees(store, marketID, msg.AskOrderCreationFee, msg.SellerSettlementFlatFee); err != nil {
-		return err
+		return nil, err
 	}
 
 	orders, oerrs := k.getBidOrders(store, marketID, msg.BidOrderIds, msg.Seller)
 	if oerrs != nil {
-		return oerrs
+		return nil, oerrs
+	}
+	if err := validateNoBasketOrders(orders); err != nil {
+		return nil, err
+	}
+	if err := validateNoExpiredOrders(orders, ctx.BlockTime()); err != nil {
+		return nil, err
 	}
 
 	totalAssets, totalPrice := sumAssetsAndPrice(orders)
 	if !totalAssets.Equal(msg.TotalAssets) {
-		return fmt.Errorf("total assets %q does not equal sum of bid order assets %q", msg.TotalAssets, totalAssets)
+		return nil, fmt.Errorf("total assets %q does not equal sum of bid order assets %q", msg.TotalAssets, totalAssets)
 	}
 
 	var totalSellerFee sdk.Coins
@@ -91,8 +104,9 @@ func (k Keeper) FillBids(ctx sdk.Context, msg *exchange.MsgFillBidsRequest) erro
 		settlement.FullyFilledOrders = append(settlement.FullyFilledOrders, exchange.NewFilledOrder(order, price, buyerFees))
 	}
 
+	// The seller is the taker here since the bids were already on the books.
 	for _, price := range totalPrice {
-		sellerRatioFee, rerr := calculateSellerSettlementRatioFee(store, marketID, price)
+		sellerRatioFee, rerr := calculateSellerSettlementRatioFee(store, marketID, price, true)
 		if rerr != nil {
 			errs = append(errs, fmt.Errorf("error calculating seller settlement ratio fee: %w", rerr))
 		}
@@ -102,7 +116,7 @@ func (k Keeper) FillBids(ctx sdk.Context, msg *exchange.MsgFillBidsRequest) erro
 	}
 
 	if len(errs) > 0 {
-		return errors.Join(errs...)
+		return nil, errors.Join(errs...)
 	}
 
 	feeAddrIdx.Add(msg.Seller, totalSellerFee...)
@@ -120,48 +134,60 @@ func (k Keeper) FillBids(ctx sdk.Context, msg *exchange.MsgFillBidsRequest) erro
 	settlement.FeeInputs = feeAddrIdx.GetAsInputs()
 
 	if err := k.closeSettlement(ctx, store, marketID, settlement); err != nil {
-		return err
+		return nil, err
 	}
 
 	// Collected last so that it's easier for a seller to fill bids without needing those funds first.
 	// Collected separately so it's not combined with the seller settlement fees in the events.
 	if msg.AskOrderCreationFee != nil {
 		if err := k.CollectFee(ctx, marketID, seller, sdk.Coins{*msg.AskOrderCreationFee}); err != nil {
-			return fmt.Errorf("error collecting create-ask fee %q: %w", msg.AskOrderCreationFee, err)
+			return nil, fmt.Errorf("error collecting create-ask fee %q: %w", msg.AskOrderCreationFee, err)
 		}
 	}
 
-	return nil
+	return settlement, nil
 }
 
 // FillAsks settles one or more ask orders for a buyer.
 func (k Keeper) FillAsks(ctx sdk.Context, msg *exchange.MsgFillAsksRequest) error {
+	_, err := k.fillAsks(ctx, msg)
+	return err
+}
+
+// fillAsks settles one or more ask orders for a buyer, returning the settlement that was processed.
+func (k Keeper) fillAsks(ctx sdk.Context, msg *exchange.MsgFillAsksRequest) (*exchange.Settlement, error) {
 	if err := msg.ValidateBasic(); err != nil {
-		return err
+		return nil, err
 	}
 
 	marketID := msg.MarketId
 	store := k.getStore(ctx)
 
 	if err := validateAcceptingOrdersAndCanUserSettle(store, marketID); err != nil {
-		return err
+		return nil, err
 	}
 	buyer := sdk.MustAccAddressFromBech32(msg.Buyer)
 	if err := k.validateUserCanCreateBid(ctx, marketID, buyer); err != nil {
-		return err
+		return nil, err
 	}
 	if err := validateCreateBidFees(store, marketID, msg.BidOrderCreationFee, msg.TotalPrice, msg.BuyerSettlementFees); err != nil {
-		return err
+		return nil, err
 	}
 
 	orders, oerrs := k.getAskOrders(store, marketID, msg.AskOrderIds, msg.Buyer)
 	if oerrs != nil {
-		return oerrs
+		return nil, oerrs
+	}
+	if err := validateNoBasketOrders(orders); err != nil {
+		return nil, err
+	}
+	if err := validateNoExpiredOrders(orders, ctx.BlockTime()); err != nil {
+		return nil, err
 	}
 
 	totalAssets, totalPrice := sumAssetsAndPrice(orders)
 	if !totalPrice.Equal(sdk.Coins{msg.TotalPrice}) {
-		return fmt.Errorf("total price %q does not equal sum of ask order prices %q", msg.TotalPrice, totalPrice)
+		return nil, fmt.Errorf("total price %q does not equal sum of ask order prices %q", msg.TotalPrice, totalPrice)
 	}
 
 	var errs []error
@@ -176,7 +202,7 @@ func (k Keeper) FillAsks(ctx sdk.Context, msg *exchange.MsgFillAsksRequest) erro
 		price := askOrder.Price
 		sellerFees := askOrder.GetSettlementFees()
 
-		sellerRatioFee, rerr := calculateSellerSettlementRatioFee(store, marketID, price)
+		sellerRatioFee, rerr := calculateSellerSettlementRatioFee(store, marketID, price, false)
 		if rerr != nil {
 			errs = append(errs, fmt.Errorf("error calculating seller settlement ratio fee for order %d: %w",
 				order.OrderId, rerr))
@@ -192,7 +218,7 @@ func (k Keeper) FillAsks(ctx sdk.Context, msg *exchange.MsgFillAsksRequest) erro
 	}
 
 	if len(errs) > 0 {
-		return errors.Join(errs...)
+		return nil, errors.Join(errs...)
 	}
 
 	// Done after the loop so that it's always last like it has to be in FillBids.
@@ -211,60 +237,83 @@ func (k Keeper) FillAsks(ctx sdk.Context, msg *exchange.MsgFillAsksRequest) erro
 	settlement.FeeInputs = feeAddrIdx.GetAsInputs()
 
 	if err := k.closeSettlement(ctx, store, marketID, settlement); err != nil {
-		return err
+		return nil, err
 	}
 
 	// Collected last so that it's easier for a seller to fill asks without needing those funds first.
 	// Collected separately so it's not combined with the buyer settlement fees in the events.
 	if msg.BidOrderCreationFee != nil {
 		if err := k.CollectFee(ctx, marketID, buyer, sdk.Coins{*msg.BidOrderCreationFee}); err != nil {
-			return fmt.Errorf("error collecting create-ask fee %q: %w", msg.BidOrderCreationFee, err)
+			return nil, fmt.Errorf("error collecting create-ask fee %q: %w", msg.BidOrderCreationFee, err)
 		}
 	}
 
-	return nil
+	return settlement, nil
 }
 
 // SettleOrders attempts to settle all the provided orders.
 func (k Keeper) SettleOrders(ctx sdk.Context, req *exchange.MsgMarketSettleRequest) error {
+	_, err := k.settleOrders(ctx, req)
+	return err
+}
+
+// settleOrders attempts to settle all the provided orders, returning the settlement that was processed.
+func (k Keeper) settleOrders(ctx sdk.Context, req *exchange.MsgMarketSettleRequest) (*exchange.Settlement, error) {
 	admin, adminErr := sdk.AccAddressFromBech32(req.Admin)
 	if adminErr != nil {
-		return fmt.Errorf("invalid admin %q: %w", req.Admin, adminErr)
+		return nil, fmt.Errorf("invalid admin %q: %w", req.Admin, adminErr)
 	}
 
 	store := k.getStore(ctx)
 	if err := validateMarketExists(store, req.MarketId); err != nil {
-		return err
+		return nil, err
 	}
 
 	askOrders, aoerr := k.getAskOrders(store, req.MarketId, req.AskOrderIds, "")
 	bidOrders, boerr := k.getBidOrders(store, req.MarketId, req.BidOrderIds, "")
 	if aoerr != nil || boerr != nil {
-		return errors.Join(aoerr, boerr)
+		return nil, errors.Join(aoerr, boerr)
+	}
+	aeerr := validateNoExpiredOrders(askOrders, ctx.BlockTime())
+	beerr := validateNoExpiredOrders(bidOrders, ctx.BlockTime())
+	if aeerr != nil || beerr != nil {
+		return nil, errors.Join(aeerr, beerr)
+	}
+
+	if getSelfTradePrevention(store, req.MarketId) != exchange.SelfTradePrevention_unspecified {
+		if err := validateNoSelfTrades(askOrders, bidOrders); err != nil {
+			return nil, fmt.Errorf("market %d has self-trade prevention: %w", req.MarketId, err)
+		}
 	}
 
-	ratioGetter := func(denom string) (*exchange.FeeRatio, error) {
-		return getSellerSettlementRatio(store, req.MarketId, denom)
+	ratioGetter := func(denom string, isTaker bool) (*exchange.FeeRatio, error) {
+		return getSellerSettlementRatioFor(store, req.MarketId, denom, isTaker)
 	}
 
 	settlement, err := exchange.BuildSettlement(askOrders, bidOrders, ratioGetter)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if !req.ExpectPartial && settlement.PartialOrderFilled != nil {
-		return fmt.Errorf("settlement resulted in unexpected partial order %d", settlement.PartialOrderFilled.GetOrderID())
+		return nil, fmt.Errorf("settlement resulted in unexpected partial order %d", settlement.PartialOrderFilled.GetOrderID())
 	}
 	if req.ExpectPartial && settlement.PartialOrderFilled == nil {
-		return errors.New("settlement unexpectedly resulted in all orders fully filled")
+		return nil, errors.New("settlement unexpectedly resulted in all orders fully filled")
 	}
 
-	return k.closeSettlement(markertypes.WithTransferAgents(ctx, admin), store, req.MarketId, settlement)
+	if err = k.closeSettlement(markertypes.WithTransferAgents(ctx, admin), store, req.MarketId, settlement); err != nil {
+		return nil, err
+	}
+	return settlement, nil
 }
 
 // closeSettlement does all the processing needed to complete a settlement.
-// It releases all the holds, does all the transfers, collects the fees, deletes/updates the orders, and emits events.
+// It releases all the holds, does all the transfers, collects the fees, pays the referrers and rebates,
+// deletes/updates the orders, and emits events.
 func (k Keeper) closeSettlement(ctx sdk.Context, store storetypes.KVStore, marketID uint32, settlement *exchange.Settlement) error {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, exchange.TelemetryKeySettlementTime)
+
 	// Release the holds!!!!
 	var errs []error
 	for _, order := range settlement.FullyFilledOrders {
@@ -272,8 +321,9 @@ func (k Keeper) closeSettlement(ctx sdk.Context, store storetypes.KVStore, marke
 			errs = append(errs, err)
 		}
 	}
-	if settlement.PartialOrderFilled != nil {
-		if err := k.releaseHoldOnOrder(ctx, settlement.PartialOrderFilled); err != nil {
+	partials := settlement.GetPartialOrders()
+	for _, partial := range partials {
+		if err := k.releaseHoldOnOrder(ctx, partial.Filled); err != nil {
 			errs = append(errs, err)
 		}
 	}
@@ -297,11 +347,24 @@ func (k Keeper) closeSettlement(ctx sdk.Context, store storetypes.KVStore, marke
 		return errors.Join(errs...)
 	}
 
-	// Update the partial order if there was one.
-	if settlement.PartialOrderLeft != nil {
-		if err := k.setOrderInStore(store, *settlement.PartialOrderLeft); err != nil {
+	// Pay the referrers their portion of the fees.
+	if err := k.payReferrals(ctx, store, marketID, settlement); err != nil {
+		return err
+	}
+
+	// Pay out any rebates owed to the sellers.
+	if err := k.payRebates(ctx, store, marketID, settlement); err != nil {
+		return err
+	}
+
+	// Update the partial orders if there were any.
+	for _, partial := range partials {
+		if partial.Left == nil {
+			continue
+		}
+		if err := k.setOrderInStore(store, *partial.Left); err != nil {
 			return fmt.Errorf("could not update partial %s order %d: %w",
-				settlement.PartialOrderLeft.GetOrderType(), settlement.PartialOrderLeft.OrderId, err)
+				partial.Left.GetOrderType(), partial.Left.OrderId, err)
 		}
 	}
 	// Delete all the fully filled orders.
@@ -310,18 +373,66 @@ func (k Keeper) closeSettlement(ctx sdk.Context, store storetypes.KVStore, marke
 	}
 
 	// Emit all the needed events.
-	events := make([]proto.Message, 0, len(settlement.FullyFilledOrders)+1)
+	settlementID := nextSettlementID(store)
+	events := make([]proto.Message, 0, len(settlement.FullyFilledOrders)+2*len(partials))
 	for _, order := range settlement.FullyFilledOrders {
-		events = append(events, exchange.NewEventOrderFilled(order))
+		events = append(events, exchange.NewEventOrderFilled(order, settlementID))
 	}
-	if settlement.PartialOrderFilled != nil {
-		events = append(events, exchange.NewEventOrderPartiallyFilled(settlement.PartialOrderFilled))
+	for _, partial := range partials {
+		events = append(events, exchange.NewEventOrderPartiallyFilled(partial.Filled, settlementID),
+			exchange.NewEventOrderPartialFillFor(settlement, partial))
 	}
 	k.emitEvents(ctx, events)
+	for _, order := range settlement.FullyFilledOrders {
+		incOrderActionCounter(order, exchange.TelemetryActionFilled)
+	}
+	for _, partial := range partials {
+		incOrderActionCounter(partial.Filled, exchange.TelemetryActionPartiallyFilled)
+	}
+	incSettlementCounter(marketID)
 
-	// Record the NAVs
+	// Let the hooks know about the fills.
+	hooks := k.Hooks()
+	for _, order := range settlement.FullyFilledOrders {
+		if err := hooks.OnOrderFilled(ctx, order, false); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for _, partial := range partials {
+		if err := hooks.OnOrderFilled(ctx, partial.Filled, true); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	// Cancel any orders linked to the ones that were filled.
+	for _, order := range settlement.FullyFilledOrders {
+		if err := k.cancelLinkedOrder(ctx, order); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for _, partial := range partials {
+		if err := k.cancelLinkedOrder(ctx, partial.Filled); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	// Record the NAVs, the settlement, and its receipt.
 	navs := exchange.GetNAVs(settlement)
 	k.recordNAVs(ctx, marketID, navs)
+	k.recordSettlementPrices(ctx, store, marketID, navs)
+	k.recordNAVHistory(ctx, store, marketID, navs)
+	k.publishPrices(ctx, store, marketID, navs)
+	k.recordSettlement(ctx, store, marketID, settlement)
+	k.recordSettlementReceipt(ctx, store, settlementID, marketID, settlement, navs)
+
+	// Activate any trigger orders that these prices cross.
+	k.activateTriggerOrders(ctx, marketID, navs)
 
 	return nil
 }
@@ -422,6 +533,21 @@ func (k Keeper) recordNAVs(ctx sdk.Context, marketID uint32, navs []exchange.Net
 	}
 }
 
+// publishPrices publishes the provided NAVs to the x/oracle price feed if the market has publish-prices enabled.
+// If a problem is encountered for one (or more), the error is logged and the rest are still published.
+func (k Keeper) publishPrices(ctx sdk.Context, store storetypes.KVStore, marketID uint32, navs []exchange.NetAssetPrice) {
+	if k.oracleKeeper == nil || len(navs) == 0 || !isPublishPricesEnabled(store, marketID) {
+		return
+	}
+
+	source := fmt.Sprintf("x/exchange market %d", marketID)
+	for _, nav := range navs {
+		if err := k.oracleKeeper.PublishPrice(ctx, nav.Assets, nav.Price, source); err != nil {
+			k.logErrorf(ctx, "error publishing price of %q at %q: %v", nav.Assets, nav.Price, err)
+		}
+	}
+}
+
 // emitMarkerNAVEvents emits the marker module's EventSetNetAssetValue events for the given navs.
 // The AddSetNetAssetValues func does this too, so this should only be used when that isn't being called.
 func (k Keeper) emitMarkerNAVEvents(ctx sdk.Context, denom string, navs []markertypes.NetAssetValue, source string) {
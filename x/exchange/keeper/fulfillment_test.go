@@ -1,16 +1,25 @@
 package keeper_test
 
 import (
+	"fmt"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/gogoproto/proto"
 
 	"github.com/provenance-io/provenance/x/exchange"
+	"github.com/provenance-io/provenance/x/exchange/keeper"
 	markertypes "github.com/provenance-io/provenance/x/marker/types"
 	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
 )
 
 func (s *TestSuite) TestKeeper_FillBids() {
+	blockTime := time.Unix(1_700_000_000, 0).UTC()
+	timeP := func(offset time.Duration) *time.Time {
+		rv := blockTime.Add(offset)
+		return &rv
+	}
 	appleMarker := s.markerAccount("100000000000apple")
 	acornMarker := s.markerAccount("100000000000acorn")
 
@@ -21,6 +30,7 @@ func (s *TestSuite) TestKeeper_FillBids() {
 		holdKeeper     *MockHoldKeeper
 		markerKeeper   *MockMarkerKeeper
 		setup          func()
+		blockTime      time.Time
 		msg            exchange.MsgFillBidsRequest
 		expErr         string
 		expEvents      []*exchange.EventOrderFilled
@@ -205,6 +215,60 @@ func (s *TestSuite) TestKeeper_FillBids() {
 			},
 			expErr: "order 8 has the same buyer " + s.addr1.String() + " as the requested seller",
 		},
+		{
+			name: "basket order",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{MarketId: 1, AcceptingOrders: true, AllowUserSettlement: true})
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(7).WithBid(&exchange.BidOrder{
+					MarketId: 1,
+					Buyer:    s.addr3.String(),
+					Assets:   s.coin("1apple"),
+					Price:    s.coin("1plum"),
+				}))
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(8).WithBid(&exchange.BidOrder{
+					MarketId:     1,
+					Buyer:        s.addr2.String(),
+					Assets:       s.coin("1apple"),
+					Price:        s.coin("10plum"),
+					BasketAssets: s.coins("3acorn"),
+				}))
+			},
+			msg: exchange.MsgFillBidsRequest{
+				Seller:      s.addr1.String(),
+				MarketId:    1,
+				TotalAssets: s.coins("2apple"),
+				BidOrderIds: []uint64{7, 8},
+			},
+			expErr: "order 8 is a basket order: basket orders can only be settled by the market",
+		},
+		{
+			name: "expired order",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{MarketId: 1, AcceptingOrders: true, AllowUserSettlement: true})
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(7).WithBid(&exchange.BidOrder{
+					MarketId:   1,
+					Buyer:      s.addr3.String(),
+					Assets:     s.coin("1apple"),
+					Price:      s.coin("1plum"),
+					Expiration: timeP(time.Hour),
+				}))
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(8).WithBid(&exchange.BidOrder{
+					MarketId:   1,
+					Buyer:      s.addr2.String(),
+					Assets:     s.coin("1apple"),
+					Price:      s.coin("10plum"),
+					Expiration: timeP(0),
+				}))
+			},
+			blockTime: blockTime,
+			msg: exchange.MsgFillBidsRequest{
+				Seller:      s.addr1.String(),
+				MarketId:    1,
+				TotalAssets: s.coins("2apple"),
+				BidOrderIds: []uint64{7, 8},
+			},
+			expErr: "order 8 expired at 2023-11-14T22:13:20Z",
+		},
 		{
 			name: "multiple problems with orders",
 			setup: func() {
@@ -578,6 +642,50 @@ func (s *TestSuite) TestKeeper_FillBids() {
 			},
 			expLog: []string{"INF no marker found for asset denom \"apple\" module=x/exchange"},
 		},
+		{
+			name: "one order: linked to another order",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{MarketId: 6, AcceptingOrders: true, AllowUserSettlement: true})
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(13).WithBid(&exchange.BidOrder{
+					Assets: s.coin("12apple"), Price: s.coin("60plum"), MarketId: 6, Buyer: s.addr2.String(),
+				}))
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(14).WithBid(&exchange.BidOrder{
+					Assets: s.coin("20apple"), Price: s.coin("90plum"), MarketId: 6, Buyer: s.addr2.String(),
+					ExternalId: "other leg",
+				}))
+				keeper.SetOrderLink(s.getStore(), *exchange.NewOrderLink(6, 13, 14))
+			},
+			msg: exchange.MsgFillBidsRequest{
+				Seller:      s.addr5.String(),
+				MarketId:    6,
+				TotalAssets: s.coins("12apple"),
+				BidOrderIds: []uint64{13},
+			},
+			expEvents: []*exchange.EventOrderFilled{
+				{OrderId: 13, Assets: "12apple", Price: "60plum", MarketId: 6},
+			},
+			adlEvents: sdk.Events{
+				s.untypeEvent(&exchange.EventLinkedOrderCancelled{
+					OrderId: 14, LinkedOrderId: 13, MarketId: 6, ExternalId: "other leg",
+				}),
+				s.markerNavSetEvent("12apple", "60plum", 6),
+			},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{
+				{addr: s.addr2, funds: s.coins("60plum")},
+				{addr: s.addr2, funds: s.coins("90plum")},
+			}},
+			expBankCalls: BankCalls{
+				BlockedAddr: []sdk.AccAddress{s.addr2, s.addr5},
+				SendCoins: []*SendCoinsArgs{
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr5, toAddr: s.addr2, amt: s.coins("12apple")},
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr2, toAddr: s.addr5, amt: s.coins("60plum")},
+				},
+			},
+			expMarkerCalls: MarkerCalls{
+				GetMarker: []sdk.AccAddress{appleMarker.GetAddress()},
+			},
+			expLog: []string{"INF no marker found for asset denom \"apple\" module=x/exchange"},
+		},
 		{
 			name:         "one order: no fees, very large amount",
 			markerKeeper: NewMockMarkerKeeper().WithGetMarkerAccount(appleMarker),
@@ -712,6 +820,52 @@ func (s *TestSuite) TestKeeper_FillBids() {
 				},
 			},
 		},
+		{
+			name:         "one order: seller is the taker",
+			markerKeeper: NewMockMarkerKeeper().WithGetMarkerAccount(appleMarker),
+			setup: func() {
+				s.k.SetParams(s.ctx, &exchange.Params{DefaultSplit: 1000})
+				s.requireCreateMarket(exchange.Market{
+					MarketId: 3, AcceptingOrders: true, AllowUserSettlement: true,
+					FeeSellerSettlementRatios:      s.ratios("30plum:1plum"),
+					FeeSellerSettlementTakerRatios: s.ratios("30plum:5plum"),
+				})
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(13).WithBid(&exchange.BidOrder{
+					Assets: s.coin("12apple"), Price: s.coin("60plum"), MarketId: 3, Buyer: s.addr2.String(),
+				}))
+			},
+			msg: exchange.MsgFillBidsRequest{
+				Seller:      s.addr5.String(),
+				MarketId:    3,
+				TotalAssets: s.coins("12apple"),
+				BidOrderIds: []uint64{13},
+			},
+			expEvents: []*exchange.EventOrderFilled{
+				{OrderId: 13, Assets: "12apple", Price: "60plum", MarketId: 3},
+			},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr2, funds: s.coins("60plum")}}},
+			expBankCalls: BankCalls{
+				BlockedAddr: []sdk.AccAddress{s.addr2, s.addr5},
+				SendCoins: []*SendCoinsArgs{
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr5, toAddr: s.addr2, amt: s.coins("12apple")},
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr2, toAddr: s.addr5, amt: s.coins("60plum")},
+					{ctxHasQuarantineBypass: false, fromAddr: s.addr5, toAddr: s.marketAddr3, amt: s.coins("10plum")},
+				},
+				SendCoinsFromAccountToModule: []*SendCoinsFromAccountToModuleArgs{
+					{senderAddr: s.marketAddr3, recipientModule: s.feeCollector, amt: s.coins("1plum")},
+				},
+			},
+			expMarkerCalls: MarkerCalls{
+				GetMarker: []sdk.AccAddress{appleMarker.GetAddress()},
+				AddSetNetAssetValues: []*AddSetNetAssetValuesArgs{
+					{
+						marker:         appleMarker,
+						netAssetValues: []markertypes.NetAssetValue{{Price: s.coin("60plum"), Volume: 12}},
+						source:         "x/exchange market 3",
+					},
+				},
+			},
+		},
 		{
 			name:         "three orders",
 			markerKeeper: NewMockMarkerKeeper().WithGetMarkerAccount(appleMarker).WithGetMarkerAccount(acornMarker),
@@ -831,6 +985,9 @@ func (s *TestSuite) TestKeeper_FillBids() {
 
 			em := sdk.NewEventManager()
 			ctx := s.ctx.WithEventManager(em)
+			if !tc.blockTime.IsZero() {
+				ctx = ctx.WithBlockTime(tc.blockTime)
+			}
 			kpr := s.k.WithAttributeKeeper(tc.attrKeeper).
 				WithAccountKeeper(s.accKeeper).
 				WithBankKeeper(tc.bankKeeper).
@@ -869,6 +1026,11 @@ func (s *TestSuite) TestKeeper_FillBids() {
 }
 
 func (s *TestSuite) TestKeeper_FillAsks() {
+	blockTime := time.Unix(1_700_000_000, 0).UTC()
+	timeP := func(offset time.Duration) *time.Time {
+		rv := blockTime.Add(offset)
+		return &rv
+	}
 	appleMarker := s.markerAccount("100000apple")
 	acornMarker := s.markerAccount("100000acorn")
 
@@ -879,6 +1041,7 @@ func (s *TestSuite) TestKeeper_FillAsks() {
 		holdKeeper     *MockHoldKeeper
 		markerKeeper   *MockMarkerKeeper
 		setup          func()
+		blockTime      time.Time
 		msg            exchange.MsgFillAsksRequest
 		expErr         string
 		expEvents      []*exchange.EventOrderFilled
@@ -1067,6 +1230,60 @@ func (s *TestSuite) TestKeeper_FillAsks() {
 			},
 			expErr: "order 8 has the same seller " + s.addr1.String() + " as the requested buyer",
 		},
+		{
+			name: "basket order",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{MarketId: 1, AcceptingOrders: true, AllowUserSettlement: true})
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(7).WithAsk(&exchange.AskOrder{
+					MarketId: 1,
+					Seller:   s.addr3.String(),
+					Assets:   s.coin("1apple"),
+					Price:    s.coin("1plum"),
+				}))
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(8).WithAsk(&exchange.AskOrder{
+					MarketId:     1,
+					Seller:       s.addr2.String(),
+					Assets:       s.coin("1apple"),
+					Price:        s.coin("10plum"),
+					BasketAssets: s.coins("3acorn"),
+				}))
+			},
+			msg: exchange.MsgFillAsksRequest{
+				Buyer:       s.addr1.String(),
+				MarketId:    1,
+				TotalPrice:  s.coin("11plum"),
+				AskOrderIds: []uint64{7, 8},
+			},
+			expErr: "order 8 is a basket order: basket orders can only be settled by the market",
+		},
+		{
+			name: "expired order",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{MarketId: 1, AcceptingOrders: true, AllowUserSettlement: true})
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(7).WithAsk(&exchange.AskOrder{
+					MarketId:   1,
+					Seller:     s.addr3.String(),
+					Assets:     s.coin("1apple"),
+					Price:      s.coin("1plum"),
+					Expiration: timeP(time.Hour),
+				}))
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(8).WithAsk(&exchange.AskOrder{
+					MarketId:   1,
+					Seller:     s.addr2.String(),
+					Assets:     s.coin("1apple"),
+					Price:      s.coin("10plum"),
+					Expiration: timeP(0),
+				}))
+			},
+			blockTime: blockTime,
+			msg: exchange.MsgFillAsksRequest{
+				Buyer:       s.addr1.String(),
+				MarketId:    1,
+				TotalPrice:  s.coin("11plum"),
+				AskOrderIds: []uint64{7, 8},
+			},
+			expErr: "order 8 expired at 2023-11-14T22:13:20Z",
+		},
 		{
 			name: "multiple problems with orders",
 			setup: func() {
@@ -1692,6 +1909,9 @@ func (s *TestSuite) TestKeeper_FillAsks() {
 
 			em := sdk.NewEventManager()
 			ctx := s.ctx.WithEventManager(em)
+			if !tc.blockTime.IsZero() {
+				ctx = ctx.WithBlockTime(tc.blockTime)
+			}
 			kpr := s.k.WithAttributeKeeper(tc.attrKeeper).
 				WithAccountKeeper(s.accKeeper).
 				WithBankKeeper(tc.bankKeeper).
@@ -1730,6 +1950,11 @@ func (s *TestSuite) TestKeeper_FillAsks() {
 }
 
 func (s *TestSuite) TestKeeper_SettleOrders() {
+	blockTime := time.Unix(1_700_000_000, 0).UTC()
+	timeP := func(offset time.Duration) *time.Time {
+		rv := blockTime.Add(offset)
+		return &rv
+	}
 	appleMarker := s.markerAccount("1000000000apple")
 	scopeID1 := s.scopeID("1_scopeID1")
 
@@ -1740,6 +1965,7 @@ func (s *TestSuite) TestKeeper_SettleOrders() {
 		markerKeeper   *MockMarkerKeeper
 		mdKeeper       *MockMetadataKeeper
 		setup          func()
+		blockTime      time.Time
 		marketID       uint32
 		askOrderIDs    []uint64
 		bidOrderIDs    []uint64
@@ -1794,6 +2020,56 @@ func (s *TestSuite) TestKeeper_SettleOrders() {
 				"order 6 market id 3 does not equal requested market id 1",
 			),
 		},
+		{
+			name: "self-trade prevention with same owner on both sides",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{MarketId: 1, SelfTradePrevention: exchange.SelfTradePrevention_cancel_newest})
+				store := s.getStore()
+				s.requireSetOrderInStore(store, exchange.NewOrder(3).WithAsk(&exchange.AskOrder{
+					Assets: s.coin("1apple"), Price: s.coin("6peach"), MarketId: 1, Seller: s.addr1.String(),
+				}))
+				s.requireSetOrderInStore(store, exchange.NewOrder(4).WithAsk(&exchange.AskOrder{
+					Assets: s.coin("1apple"), Price: s.coin("6peach"), MarketId: 1, Seller: s.addr2.String(),
+				}))
+				s.requireSetOrderInStore(store, exchange.NewOrder(5).WithBid(&exchange.BidOrder{
+					Assets: s.coin("2apple"), Price: s.coin("12peach"), MarketId: 1, Buyer: s.addr2.String(),
+				}))
+			},
+			marketID:      1,
+			askOrderIDs:   []uint64{3, 4},
+			bidOrderIDs:   []uint64{5},
+			expectPartial: false,
+			expErr: "market 1 has self-trade prevention: ask order 4 and bid order 5 have the same owner " +
+				s.addr2.String(),
+		},
+		{
+			name: "expired orders",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{MarketId: 1})
+				store := s.getStore()
+				s.requireSetOrderInStore(store, exchange.NewOrder(3).WithAsk(&exchange.AskOrder{
+					Assets: s.coin("1apple"), Price: s.coin("6peach"), MarketId: 1, Seller: s.addr1.String(),
+					Expiration: timeP(-1 * time.Hour),
+				}))
+				s.requireSetOrderInStore(store, exchange.NewOrder(4).WithAsk(&exchange.AskOrder{
+					Assets: s.coin("1apple"), Price: s.coin("6peach"), MarketId: 1, Seller: s.addr2.String(),
+					Expiration: timeP(time.Hour),
+				}))
+				s.requireSetOrderInStore(store, exchange.NewOrder(5).WithBid(&exchange.BidOrder{
+					Assets: s.coin("2apple"), Price: s.coin("12peach"), MarketId: 1, Buyer: s.addr3.String(),
+					Expiration: timeP(0),
+				}))
+			},
+			blockTime:     blockTime,
+			marketID:      1,
+			askOrderIDs:   []uint64{3, 4},
+			bidOrderIDs:   []uint64{5},
+			expectPartial: false,
+			expErr: s.joinErrs(
+				"order 3 expired at 2023-11-14T21:13:20Z",
+				"order 5 expired at 2023-11-14T22:13:20Z",
+			),
+		},
 		{
 			name: "errors building settlement",
 			setup: func() {
@@ -2308,84 +2584,629 @@ func (s *TestSuite) TestKeeper_SettleOrders() {
 			},
 		},
 		{
-			name:         "one ask one bid: partial ask",
+			name:         "one ask one bid: referrers get their portion of the fees",
+			bankKeeper:   NewMockBankKeeper().WithSpendableCoinsResults(s.coins("18peach")),
 			markerKeeper: NewMockMarkerKeeper().WithGetMarkerAccount(appleMarker),
 			setup: func() {
-				s.k.SetParams(s.ctx, &exchange.Params{})
-				s.requireCreateMarket(exchange.Market{MarketId: 1})
+				s.k.SetParams(s.ctx, &exchange.Params{DefaultSplit: 1000})
+				s.requireCreateMarket(exchange.Market{
+					MarketId:                  1,
+					FeeSellerSettlementRatios: s.ratios("25peach:1peach"),
+					ReferralBips:              5000,
+				})
 				store := s.getStore()
 				s.requireSetOrderInStore(store, exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
-					Assets: s.coin("10apple"), Price: s.coin("50peach"), MarketId: 1, Seller: s.addr5.String(),
-					SellerSettlementFlatFee: s.coinP("20fig"),
-					ExternalId:              "the-ask-order",
-					AllowPartial:            true,
+					Assets: s.coin("10apple"), Price: s.coin("50peach"), MarketId: 1, Seller: s.addr3.String(),
+					SellerSettlementFlatFee: s.coinP("3peach"),
+					Referrer:                s.addr2.String(),
 				}))
-				s.requireSetOrderInStore(store, exchange.NewOrder(2).WithBid(&exchange.BidOrder{
-					Assets: s.coin("7apple"), Price: s.coin("40peach"), MarketId: 1, Buyer: s.addr3.String(),
-					ExternalId: "the-bid-order",
+				s.requireSetOrderInStore(store, exchange.NewOrder(5).WithBid(&exchange.BidOrder{
+					Assets: s.coin("10apple"), Price: s.coin("50peach"), MarketId: 1, Buyer: s.addr4.String(),
+					BuyerSettlementFees: s.coins("15peach"),
+					Referrer:            s.addr5.String(),
 				}))
 			},
 			marketID:      1,
 			askOrderIDs:   []uint64{1},
-			bidOrderIDs:   []uint64{2},
-			expectPartial: true,
+			bidOrderIDs:   []uint64{5},
+			expectPartial: false,
 			expEvents: []proto.Message{
-				&exchange.EventOrderFilled{
-					OrderId: 2, Assets: "7apple", Price: "40peach",
-					MarketId: 1, ExternalId: "the-bid-order",
-				},
-				&exchange.EventOrderPartiallyFilled{
-					OrderId: 1, Assets: "7apple", Price: "40peach", Fees: "14fig",
-					MarketId: 1, ExternalId: "the-ask-order",
-				},
+				&exchange.EventReferralPaid{MarketId: 1, OrderId: 1, Referrer: s.addr2.String(), Amount: "2peach"},
+				&exchange.EventReferralPaid{MarketId: 1, OrderId: 5, Referrer: s.addr5.String(), Amount: "6peach"},
+				&exchange.EventOrderFilled{OrderId: 1, Assets: "10apple", Price: "50peach", MarketId: 1, Fees: "5peach"},
+				&exchange.EventOrderFilled{OrderId: 5, Assets: "10apple", Price: "50peach", MarketId: 1, Fees: "15peach"},
 			},
-			expPartialLeft: exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
-				Assets: s.coin("3apple"), Price: s.coin("15peach"), MarketId: 1, Seller: s.addr5.String(),
-				SellerSettlementFlatFee: s.coinP("6fig"),
-				ExternalId:              "the-ask-order",
-				AllowPartial:            true,
-			}),
 			expHoldCalls: HoldCalls{
 				ReleaseHold: []*ReleaseHoldArgs{
-					{addr: s.addr3, funds: s.coins("40peach")},
-					{addr: s.addr5, funds: s.coins("14fig,7apple")},
+					{addr: s.addr3, funds: s.coins("10apple")},
+					{addr: s.addr4, funds: s.coins("65peach")},
 				},
 			},
 			expBankCalls: BankCalls{
-				BlockedAddr: []sdk.AccAddress{s.addr3, s.addr5},
+				BlockedAddr: []sdk.AccAddress{s.addr4, s.addr3, s.addr2, s.addr5},
 				SendCoins: []*SendCoinsArgs{
-					{ctxHasQuarantineBypass: true, fromAddr: s.addr5, toAddr: s.addr3, amt: s.coins("7apple")},
-					{ctxHasQuarantineBypass: true, fromAddr: s.addr3, toAddr: s.addr5, amt: s.coins("40peach")},
-					{fromAddr: s.addr5, toAddr: s.marketAddr1, amt: s.coins("14fig")},
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr3, toAddr: s.addr4, amt: s.coins("10apple")},
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr4, toAddr: s.addr3, amt: s.coins("50peach")},
+				},
+				InputOutputCoins: []*InputOutputCoinsArgs{
+					{
+						inputs: []banktypes.Input{
+							{Address: s.addr3.String(), Coins: s.coins("5peach")},
+							{Address: s.addr4.String(), Coins: s.coins("15peach")},
+						},
+						outputs: []banktypes.Output{{Address: s.marketAddr1.String(), Coins: s.coins("20peach")}},
+					},
+					{
+						ctxHasQuarantineBypass: true,
+						inputs:                 []banktypes.Input{{Address: s.marketAddr1.String(), Coins: s.coins("8peach")}},
+						outputs: []banktypes.Output{
+							{Address: s.addr2.String(), Coins: s.coins("2peach")},
+							{Address: s.addr5.String(), Coins: s.coins("6peach")},
+						},
+					},
+				},
+				SendCoinsFromAccountToModule: []*SendCoinsFromAccountToModuleArgs{
+					{senderAddr: s.marketAddr1, recipientModule: s.feeCollector, amt: s.coins("2peach")},
 				},
+				SpendableCoins: []sdk.AccAddress{s.marketAddr1},
 			},
 			expMarkerCalls: MarkerCalls{
 				GetMarker: []sdk.AccAddress{appleMarker.GetAddress()},
 				AddSetNetAssetValues: []*AddSetNetAssetValuesArgs{
 					{
 						marker:         appleMarker,
-						netAssetValues: []markertypes.NetAssetValue{{Price: s.coin("40peach"), Volume: 7}},
+						netAssetValues: []markertypes.NetAssetValue{{Price: s.coin("50peach"), Volume: 10}},
 						source:         "x/exchange market 1",
 					},
 				},
 			},
 		},
 		{
-			name:         "one ask one bid: partial bid",
+			name:         "one ask one bid: referral limited by cap",
+			bankKeeper:   NewMockBankKeeper().WithSpendableCoinsResults(s.coins("18peach")),
 			markerKeeper: NewMockMarkerKeeper().WithGetMarkerAccount(appleMarker),
 			setup: func() {
-				s.k.SetParams(s.ctx, &exchange.Params{})
-				s.requireCreateMarket(exchange.Market{MarketId: 1})
+				s.k.SetParams(s.ctx, &exchange.Params{DefaultSplit: 1000})
+				s.requireCreateMarket(exchange.Market{
+					MarketId:                  1,
+					FeeSellerSettlementRatios: s.ratios("25peach:1peach"),
+					ReferralBips:              5000,
+					ReferralCap:               s.coins("5peach"),
+				})
 				store := s.getStore()
 				s.requireSetOrderInStore(store, exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
-					Assets: s.coin("7apple"), Price: s.coin("30peach"), MarketId: 1, Seller: s.addr5.String(),
-					ExternalId: "the-ask-order",
+					Assets: s.coin("10apple"), Price: s.coin("50peach"), MarketId: 1, Seller: s.addr3.String(),
+					SellerSettlementFlatFee: s.coinP("3peach"),
 				}))
-				s.requireSetOrderInStore(store, exchange.NewOrder(2).WithBid(&exchange.BidOrder{
-					Assets: s.coin("10apple"), Price: s.coin("50peach"), MarketId: 1, Buyer: s.addr3.String(),
-					BuyerSettlementFees: s.coins("20fig"),
-					ExternalId:          "the-bid-order",
-					AllowPartial:        true,
+				s.requireSetOrderInStore(store, exchange.NewOrder(5).WithBid(&exchange.BidOrder{
+					Assets: s.coin("10apple"), Price: s.coin("50peach"), MarketId: 1, Buyer: s.addr4.String(),
+					BuyerSettlementFees: s.coins("15peach"),
+					Referrer:            s.addr5.String(),
+				}))
+			},
+			marketID:      1,
+			askOrderIDs:   []uint64{1},
+			bidOrderIDs:   []uint64{5},
+			expectPartial: false,
+			expEvents: []proto.Message{
+				&exchange.EventReferralPaid{MarketId: 1, OrderId: 5, Referrer: s.addr5.String(), Amount: "5peach"},
+				&exchange.EventOrderFilled{OrderId: 1, Assets: "10apple", Price: "50peach", MarketId: 1, Fees: "5peach"},
+				&exchange.EventOrderFilled{OrderId: 5, Assets: "10apple", Price: "50peach", MarketId: 1, Fees: "15peach"},
+			},
+			expHoldCalls: HoldCalls{
+				ReleaseHold: []*ReleaseHoldArgs{
+					{addr: s.addr3, funds: s.coins("10apple")},
+					{addr: s.addr4, funds: s.coins("65peach")},
+				},
+			},
+			expBankCalls: BankCalls{
+				BlockedAddr: []sdk.AccAddress{s.addr4, s.addr3, s.addr5},
+				SendCoins: []*SendCoinsArgs{
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr3, toAddr: s.addr4, amt: s.coins("10apple")},
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr4, toAddr: s.addr3, amt: s.coins("50peach")},
+					{ctxHasQuarantineBypass: true, fromAddr: s.marketAddr1, toAddr: s.addr5, amt: s.coins("5peach")},
+				},
+				InputOutputCoins: []*InputOutputCoinsArgs{
+					{
+						inputs: []banktypes.Input{
+							{Address: s.addr3.String(), Coins: s.coins("5peach")},
+							{Address: s.addr4.String(), Coins: s.coins("15peach")},
+						},
+						outputs: []banktypes.Output{{Address: s.marketAddr1.String(), Coins: s.coins("20peach")}},
+					},
+				},
+				SendCoinsFromAccountToModule: []*SendCoinsFromAccountToModuleArgs{
+					{senderAddr: s.marketAddr1, recipientModule: s.feeCollector, amt: s.coins("2peach")},
+				},
+				SpendableCoins: []sdk.AccAddress{s.marketAddr1},
+			},
+			expMarkerCalls: MarkerCalls{
+				GetMarker: []sdk.AccAddress{appleMarker.GetAddress()},
+				AddSetNetAssetValues: []*AddSetNetAssetValuesArgs{
+					{
+						marker:         appleMarker,
+						netAssetValues: []markertypes.NetAssetValue{{Price: s.coin("50peach"), Volume: 10}},
+						source:         "x/exchange market 1",
+					},
+				},
+			},
+		},
+		{
+			name:         "one ask one bid: referrals limited by market funds",
+			bankKeeper:   NewMockBankKeeper().WithSpendableCoinsResults(s.coins("5peach")),
+			markerKeeper: NewMockMarkerKeeper().WithGetMarkerAccount(appleMarker),
+			setup: func() {
+				s.k.SetParams(s.ctx, &exchange.Params{DefaultSplit: 1000})
+				s.requireCreateMarket(exchange.Market{
+					MarketId:                  1,
+					FeeSellerSettlementRatios: s.ratios("25peach:1peach"),
+					ReferralBips:              5000,
+				})
+				store := s.getStore()
+				s.requireSetOrderInStore(store, exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					Assets: s.coin("10apple"), Price: s.coin("50peach"), MarketId: 1, Seller: s.addr3.String(),
+					SellerSettlementFlatFee: s.coinP("3peach"),
+					Referrer:                s.addr2.String(),
+				}))
+				s.requireSetOrderInStore(store, exchange.NewOrder(5).WithBid(&exchange.BidOrder{
+					Assets: s.coin("10apple"), Price: s.coin("50peach"), MarketId: 1, Buyer: s.addr4.String(),
+					BuyerSettlementFees: s.coins("15peach"),
+					Referrer:            s.addr5.String(),
+				}))
+			},
+			marketID:      1,
+			askOrderIDs:   []uint64{1},
+			bidOrderIDs:   []uint64{5},
+			expectPartial: false,
+			expLog: []string{"ERR market 1 account has insufficient funds to pay referral of 6peach for order 5: " +
+				"paying \"3peach\" instead module=x/exchange"},
+			expEvents: []proto.Message{
+				&exchange.EventReferralPaid{MarketId: 1, OrderId: 1, Referrer: s.addr2.String(), Amount: "2peach"},
+				&exchange.EventReferralPaid{MarketId: 1, OrderId: 5, Referrer: s.addr5.String(), Amount: "3peach"},
+				&exchange.EventOrderFilled{OrderId: 1, Assets: "10apple", Price: "50peach", MarketId: 1, Fees: "5peach"},
+				&exchange.EventOrderFilled{OrderId: 5, Assets: "10apple", Price: "50peach", MarketId: 1, Fees: "15peach"},
+			},
+			expHoldCalls: HoldCalls{
+				ReleaseHold: []*ReleaseHoldArgs{
+					{addr: s.addr3, funds: s.coins("10apple")},
+					{addr: s.addr4, funds: s.coins("65peach")},
+				},
+			},
+			expBankCalls: BankCalls{
+				BlockedAddr: []sdk.AccAddress{s.addr4, s.addr3, s.addr2, s.addr5},
+				SendCoins: []*SendCoinsArgs{
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr3, toAddr: s.addr4, amt: s.coins("10apple")},
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr4, toAddr: s.addr3, amt: s.coins("50peach")},
+				},
+				InputOutputCoins: []*InputOutputCoinsArgs{
+					{
+						inputs: []banktypes.Input{
+							{Address: s.addr3.String(), Coins: s.coins("5peach")},
+							{Address: s.addr4.String(), Coins: s.coins("15peach")},
+						},
+						outputs: []banktypes.Output{{Address: s.marketAddr1.String(), Coins: s.coins("20peach")}},
+					},
+					{
+						ctxHasQuarantineBypass: true,
+						inputs:                 []banktypes.Input{{Address: s.marketAddr1.String(), Coins: s.coins("5peach")}},
+						outputs: []banktypes.Output{
+							{Address: s.addr2.String(), Coins: s.coins("2peach")},
+							{Address: s.addr5.String(), Coins: s.coins("3peach")},
+						},
+					},
+				},
+				SendCoinsFromAccountToModule: []*SendCoinsFromAccountToModuleArgs{
+					{senderAddr: s.marketAddr1, recipientModule: s.feeCollector, amt: s.coins("2peach")},
+				},
+				SpendableCoins: []sdk.AccAddress{s.marketAddr1},
+			},
+			expMarkerCalls: MarkerCalls{
+				GetMarker: []sdk.AccAddress{appleMarker.GetAddress()},
+				AddSetNetAssetValues: []*AddSetNetAssetValuesArgs{
+					{
+						marker:         appleMarker,
+						netAssetValues: []markertypes.NetAssetValue{{Price: s.coin("50peach"), Volume: 10}},
+						source:         "x/exchange market 1",
+					},
+				},
+			},
+		},
+		{
+			name:         "one ask one bid: referrer without referral bips",
+			markerKeeper: NewMockMarkerKeeper().WithGetMarkerAccount(appleMarker),
+			setup: func() {
+				s.k.SetParams(s.ctx, &exchange.Params{DefaultSplit: 1000})
+				s.requireCreateMarket(exchange.Market{
+					MarketId:                  1,
+					FeeSellerSettlementRatios: s.ratios("25peach:1peach"),
+				})
+				store := s.getStore()
+				s.requireSetOrderInStore(store, exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					Assets: s.coin("10apple"), Price: s.coin("50peach"), MarketId: 1, Seller: s.addr3.String(),
+					SellerSettlementFlatFee: s.coinP("3peach"),
+				}))
+				s.requireSetOrderInStore(store, exchange.NewOrder(5).WithBid(&exchange.BidOrder{
+					Assets: s.coin("10apple"), Price: s.coin("50peach"), MarketId: 1, Buyer: s.addr4.String(),
+					BuyerSettlementFees: s.coins("15peach"),
+					Referrer:            s.addr5.String(),
+				}))
+			},
+			marketID:      1,
+			askOrderIDs:   []uint64{1},
+			bidOrderIDs:   []uint64{5},
+			expectPartial: false,
+			expEvents: []proto.Message{
+				&exchange.EventOrderFilled{OrderId: 1, Assets: "10apple", Price: "50peach", MarketId: 1, Fees: "5peach"},
+				&exchange.EventOrderFilled{OrderId: 5, Assets: "10apple", Price: "50peach", MarketId: 1, Fees: "15peach"},
+			},
+			expHoldCalls: HoldCalls{
+				ReleaseHold: []*ReleaseHoldArgs{
+					{addr: s.addr3, funds: s.coins("10apple")},
+					{addr: s.addr4, funds: s.coins("65peach")},
+				},
+			},
+			expBankCalls: BankCalls{
+				BlockedAddr: []sdk.AccAddress{s.addr4, s.addr3},
+				SendCoins: []*SendCoinsArgs{
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr3, toAddr: s.addr4, amt: s.coins("10apple")},
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr4, toAddr: s.addr3, amt: s.coins("50peach")},
+				},
+				InputOutputCoins: []*InputOutputCoinsArgs{
+					{
+						inputs: []banktypes.Input{
+							{Address: s.addr3.String(), Coins: s.coins("5peach")},
+							{Address: s.addr4.String(), Coins: s.coins("15peach")},
+						},
+						outputs: []banktypes.Output{{Address: s.marketAddr1.String(), Coins: s.coins("20peach")}},
+					},
+				},
+				SendCoinsFromAccountToModule: []*SendCoinsFromAccountToModuleArgs{
+					{senderAddr: s.marketAddr1, recipientModule: s.feeCollector, amt: s.coins("2peach")},
+				},
+			},
+			expMarkerCalls: MarkerCalls{
+				GetMarker: []sdk.AccAddress{appleMarker.GetAddress()},
+				AddSetNetAssetValues: []*AddSetNetAssetValuesArgs{
+					{
+						marker:         appleMarker,
+						netAssetValues: []markertypes.NetAssetValue{{Price: s.coin("50peach"), Volume: 10}},
+						source:         "x/exchange market 1",
+					},
+				},
+			},
+		},
+		{
+			name:         "one ask one bid: maker seller gets rebate",
+			bankKeeper:   NewMockBankKeeper().WithSpendableCoinsResults(s.coins("100peach")),
+			markerKeeper: NewMockMarkerKeeper().WithGetMarkerAccount(appleMarker),
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:                        1,
+					FeeSellerSettlementRebateRatios: s.ratios("10peach:1peach"),
+				})
+				store := s.getStore()
+				s.requireSetOrderInStore(store, exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					Assets: s.coin("10apple"), Price: s.coin("50peach"), MarketId: 1, Seller: s.addr3.String(),
+				}))
+				s.requireSetOrderInStore(store, exchange.NewOrder(5).WithBid(&exchange.BidOrder{
+					Assets: s.coin("10apple"), Price: s.coin("50peach"), MarketId: 1, Buyer: s.addr4.String(),
+				}))
+			},
+			marketID:      1,
+			askOrderIDs:   []uint64{1},
+			bidOrderIDs:   []uint64{5},
+			expectPartial: false,
+			expEvents: []proto.Message{
+				&exchange.EventRebatePaid{MarketId: 1, Recipient: s.addr3.String(), Amount: "5peach"},
+				&exchange.EventOrderFilled{OrderId: 1, Assets: "10apple", Price: "50peach", MarketId: 1},
+				&exchange.EventOrderFilled{OrderId: 5, Assets: "10apple", Price: "50peach", MarketId: 1},
+			},
+			expHoldCalls: HoldCalls{
+				ReleaseHold: []*ReleaseHoldArgs{
+					{addr: s.addr3, funds: s.coins("10apple")},
+					{addr: s.addr4, funds: s.coins("50peach")},
+				},
+			},
+			expBankCalls: BankCalls{
+				BlockedAddr: []sdk.AccAddress{s.addr4, s.addr3, s.addr3},
+				SendCoins: []*SendCoinsArgs{
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr3, toAddr: s.addr4, amt: s.coins("10apple")},
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr4, toAddr: s.addr3, amt: s.coins("50peach")},
+					{ctxHasQuarantineBypass: true, fromAddr: s.marketAddr1, toAddr: s.addr3, amt: s.coins("5peach")},
+				},
+				SpendableCoins: []sdk.AccAddress{s.marketAddr1},
+			},
+			expMarkerCalls: MarkerCalls{
+				GetMarker: []sdk.AccAddress{appleMarker.GetAddress()},
+				AddSetNetAssetValues: []*AddSetNetAssetValuesArgs{
+					{
+						marker:         appleMarker,
+						netAssetValues: []markertypes.NetAssetValue{{Price: s.coin("50peach"), Volume: 10}},
+						source:         "x/exchange market 1",
+					},
+				},
+			},
+		},
+		{
+			name:         "one ask one bid: taker seller does not get rebate",
+			bankKeeper:   NewMockBankKeeper().WithSpendableCoinsResults(s.coins("100peach")),
+			markerKeeper: NewMockMarkerKeeper().WithGetMarkerAccount(appleMarker),
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:                        1,
+					FeeSellerSettlementRebateRatios: s.ratios("10peach:1peach"),
+				})
+				store := s.getStore()
+				s.requireSetOrderInStore(store, exchange.NewOrder(7).WithAsk(&exchange.AskOrder{
+					Assets: s.coin("10apple"), Price: s.coin("50peach"), MarketId: 1, Seller: s.addr3.String(),
+				}))
+				s.requireSetOrderInStore(store, exchange.NewOrder(5).WithBid(&exchange.BidOrder{
+					Assets: s.coin("10apple"), Price: s.coin("50peach"), MarketId: 1, Buyer: s.addr4.String(),
+				}))
+			},
+			marketID:      1,
+			askOrderIDs:   []uint64{7},
+			bidOrderIDs:   []uint64{5},
+			expectPartial: false,
+			expEvents: []proto.Message{
+				&exchange.EventOrderFilled{OrderId: 7, Assets: "10apple", Price: "50peach", MarketId: 1},
+				&exchange.EventOrderFilled{OrderId: 5, Assets: "10apple", Price: "50peach", MarketId: 1},
+			},
+			expHoldCalls: HoldCalls{
+				ReleaseHold: []*ReleaseHoldArgs{
+					{addr: s.addr3, funds: s.coins("10apple")},
+					{addr: s.addr4, funds: s.coins("50peach")},
+				},
+			},
+			expBankCalls: BankCalls{
+				BlockedAddr: []sdk.AccAddress{s.addr4, s.addr3},
+				SendCoins: []*SendCoinsArgs{
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr3, toAddr: s.addr4, amt: s.coins("10apple")},
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr4, toAddr: s.addr3, amt: s.coins("50peach")},
+				},
+			},
+			expMarkerCalls: MarkerCalls{
+				GetMarker: []sdk.AccAddress{appleMarker.GetAddress()},
+				AddSetNetAssetValues: []*AddSetNetAssetValuesArgs{
+					{
+						marker:         appleMarker,
+						netAssetValues: []markertypes.NetAssetValue{{Price: s.coin("50peach"), Volume: 10}},
+						source:         "x/exchange market 1",
+					},
+				},
+			},
+		},
+		{
+			name:         "one ask one bid: taker seller in rebate addresses gets rebate",
+			bankKeeper:   NewMockBankKeeper().WithSpendableCoinsResults(s.coins("100peach")),
+			markerKeeper: NewMockMarkerKeeper().WithGetMarkerAccount(appleMarker),
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:                        1,
+					FeeSellerSettlementRebateRatios: s.ratios("10peach:1peach"),
+					RebateAddresses:                 []string{s.addr3.String()},
+				})
+				store := s.getStore()
+				s.requireSetOrderInStore(store, exchange.NewOrder(7).WithAsk(&exchange.AskOrder{
+					Assets: s.coin("10apple"), Price: s.coin("50peach"), MarketId: 1, Seller: s.addr3.String(),
+				}))
+				s.requireSetOrderInStore(store, exchange.NewOrder(5).WithBid(&exchange.BidOrder{
+					Assets: s.coin("10apple"), Price: s.coin("50peach"), MarketId: 1, Buyer: s.addr4.String(),
+				}))
+			},
+			marketID:      1,
+			askOrderIDs:   []uint64{7},
+			bidOrderIDs:   []uint64{5},
+			expectPartial: false,
+			expEvents: []proto.Message{
+				&exchange.EventRebatePaid{MarketId: 1, Recipient: s.addr3.String(), Amount: "5peach"},
+				&exchange.EventOrderFilled{OrderId: 7, Assets: "10apple", Price: "50peach", MarketId: 1},
+				&exchange.EventOrderFilled{OrderId: 5, Assets: "10apple", Price: "50peach", MarketId: 1},
+			},
+			expHoldCalls: HoldCalls{
+				ReleaseHold: []*ReleaseHoldArgs{
+					{addr: s.addr3, funds: s.coins("10apple")},
+					{addr: s.addr4, funds: s.coins("50peach")},
+				},
+			},
+			expBankCalls: BankCalls{
+				BlockedAddr: []sdk.AccAddress{s.addr4, s.addr3, s.addr3},
+				SendCoins: []*SendCoinsArgs{
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr3, toAddr: s.addr4, amt: s.coins("10apple")},
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr4, toAddr: s.addr3, amt: s.coins("50peach")},
+					{ctxHasQuarantineBypass: true, fromAddr: s.marketAddr1, toAddr: s.addr3, amt: s.coins("5peach")},
+				},
+				SpendableCoins: []sdk.AccAddress{s.marketAddr1},
+			},
+			expMarkerCalls: MarkerCalls{
+				GetMarker: []sdk.AccAddress{appleMarker.GetAddress()},
+				AddSetNetAssetValues: []*AddSetNetAssetValuesArgs{
+					{
+						marker:         appleMarker,
+						netAssetValues: []markertypes.NetAssetValue{{Price: s.coin("50peach"), Volume: 10}},
+						source:         "x/exchange market 1",
+					},
+				},
+			},
+		},
+		{
+			name:         "one ask one bid: maker seller not in rebate addresses",
+			bankKeeper:   NewMockBankKeeper().WithSpendableCoinsResults(s.coins("100peach")),
+			markerKeeper: NewMockMarkerKeeper().WithGetMarkerAccount(appleMarker),
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:                        1,
+					FeeSellerSettlementRebateRatios: s.ratios("10peach:1peach"),
+					RebateAddresses:                 []string{s.addr2.String()},
+				})
+				store := s.getStore()
+				s.requireSetOrderInStore(store, exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					Assets: s.coin("10apple"), Price: s.coin("50peach"), MarketId: 1, Seller: s.addr3.String(),
+				}))
+				s.requireSetOrderInStore(store, exchange.NewOrder(5).WithBid(&exchange.BidOrder{
+					Assets: s.coin("10apple"), Price: s.coin("50peach"), MarketId: 1, Buyer: s.addr4.String(),
+				}))
+			},
+			marketID:      1,
+			askOrderIDs:   []uint64{1},
+			bidOrderIDs:   []uint64{5},
+			expectPartial: false,
+			expEvents: []proto.Message{
+				&exchange.EventOrderFilled{OrderId: 1, Assets: "10apple", Price: "50peach", MarketId: 1},
+				&exchange.EventOrderFilled{OrderId: 5, Assets: "10apple", Price: "50peach", MarketId: 1},
+			},
+			expHoldCalls: HoldCalls{
+				ReleaseHold: []*ReleaseHoldArgs{
+					{addr: s.addr3, funds: s.coins("10apple")},
+					{addr: s.addr4, funds: s.coins("50peach")},
+				},
+			},
+			expBankCalls: BankCalls{
+				BlockedAddr: []sdk.AccAddress{s.addr4, s.addr3},
+				SendCoins: []*SendCoinsArgs{
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr3, toAddr: s.addr4, amt: s.coins("10apple")},
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr4, toAddr: s.addr3, amt: s.coins("50peach")},
+				},
+			},
+			expMarkerCalls: MarkerCalls{
+				GetMarker: []sdk.AccAddress{appleMarker.GetAddress()},
+				AddSetNetAssetValues: []*AddSetNetAssetValuesArgs{
+					{
+						marker:         appleMarker,
+						netAssetValues: []markertypes.NetAssetValue{{Price: s.coin("50peach"), Volume: 10}},
+						source:         "x/exchange market 1",
+					},
+				},
+			},
+		},
+		{
+			name:         "one ask one bid: insufficient funds for rebate",
+			bankKeeper:   NewMockBankKeeper().WithSpendableCoinsResults(s.coins("4peach")),
+			markerKeeper: NewMockMarkerKeeper().WithGetMarkerAccount(appleMarker),
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:                        1,
+					FeeSellerSettlementRebateRatios: s.ratios("10peach:1peach"),
+				})
+				store := s.getStore()
+				s.requireSetOrderInStore(store, exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					Assets: s.coin("10apple"), Price: s.coin("50peach"), MarketId: 1, Seller: s.addr3.String(),
+				}))
+				s.requireSetOrderInStore(store, exchange.NewOrder(5).WithBid(&exchange.BidOrder{
+					Assets: s.coin("10apple"), Price: s.coin("50peach"), MarketId: 1, Buyer: s.addr4.String(),
+				}))
+			},
+			marketID:      1,
+			askOrderIDs:   []uint64{1},
+			bidOrderIDs:   []uint64{5},
+			expectPartial: false,
+			expErr:        `market 1 account has insufficient funds to pay rebates 5peach: spendable balance "4peach"`,
+			expHoldCalls: HoldCalls{
+				ReleaseHold: []*ReleaseHoldArgs{
+					{addr: s.addr3, funds: s.coins("10apple")},
+					{addr: s.addr4, funds: s.coins("50peach")},
+				},
+			},
+			expBankCalls: BankCalls{
+				BlockedAddr: []sdk.AccAddress{s.addr4, s.addr3},
+				SendCoins: []*SendCoinsArgs{
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr3, toAddr: s.addr4, amt: s.coins("10apple")},
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr4, toAddr: s.addr3, amt: s.coins("50peach")},
+				},
+				SpendableCoins: []sdk.AccAddress{s.marketAddr1},
+			},
+		},
+		{
+			name:         "one ask one bid: partial ask",
+			markerKeeper: NewMockMarkerKeeper().WithGetMarkerAccount(appleMarker),
+			setup: func() {
+				s.k.SetParams(s.ctx, &exchange.Params{})
+				s.requireCreateMarket(exchange.Market{MarketId: 1})
+				store := s.getStore()
+				s.requireSetOrderInStore(store, exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					Assets: s.coin("10apple"), Price: s.coin("50peach"), MarketId: 1, Seller: s.addr5.String(),
+					SellerSettlementFlatFee: s.coinP("20fig"),
+					ExternalId:              "the-ask-order",
+					AllowPartial:            true,
+				}))
+				s.requireSetOrderInStore(store, exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+					Assets: s.coin("7apple"), Price: s.coin("40peach"), MarketId: 1, Buyer: s.addr3.String(),
+					ExternalId: "the-bid-order",
+				}))
+			},
+			marketID:      1,
+			askOrderIDs:   []uint64{1},
+			bidOrderIDs:   []uint64{2},
+			expectPartial: true,
+			expEvents: []proto.Message{
+				&exchange.EventOrderFilled{
+					OrderId: 2, Assets: "7apple", Price: "40peach",
+					MarketId: 1, ExternalId: "the-bid-order",
+				},
+				&exchange.EventOrderPartiallyFilled{
+					OrderId: 1, Assets: "7apple", Price: "40peach", Fees: "14fig",
+					MarketId: 1, ExternalId: "the-ask-order",
+				},
+				&exchange.EventOrderPartialFill{
+					OrderId: 1, OrderType: "ask", MarketId: 1, ExternalId: "the-ask-order", Owner: s.addr5.String(),
+					FilledAssets: "7apple", FilledPrice: "40peach", FilledFees: "14fig",
+					RemainingAssets: "3apple", RemainingPrice: "15peach", RemainingFees: "6fig",
+					Participants: []*exchange.FillParticipant{
+						{OrderId: 2, OrderType: "bid", Owner: s.addr3.String(), Assets: "7apple", Price: "40peach"},
+						{
+							OrderId: 1, OrderType: "ask", Owner: s.addr5.String(),
+							Assets: "7apple", Price: "40peach", Fees: "14fig", Partial: true,
+						},
+					},
+				},
+			},
+			expPartialLeft: exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+				Assets: s.coin("3apple"), Price: s.coin("15peach"), MarketId: 1, Seller: s.addr5.String(),
+				SellerSettlementFlatFee: s.coinP("6fig"),
+				ExternalId:              "the-ask-order",
+				AllowPartial:            true,
+			}),
+			expHoldCalls: HoldCalls{
+				ReleaseHold: []*ReleaseHoldArgs{
+					{addr: s.addr3, funds: s.coins("40peach")},
+					{addr: s.addr5, funds: s.coins("14fig,7apple")},
+				},
+			},
+			expBankCalls: BankCalls{
+				BlockedAddr: []sdk.AccAddress{s.addr3, s.addr5},
+				SendCoins: []*SendCoinsArgs{
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr5, toAddr: s.addr3, amt: s.coins("7apple")},
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr3, toAddr: s.addr5, amt: s.coins("40peach")},
+					{fromAddr: s.addr5, toAddr: s.marketAddr1, amt: s.coins("14fig")},
+				},
+			},
+			expMarkerCalls: MarkerCalls{
+				GetMarker: []sdk.AccAddress{appleMarker.GetAddress()},
+				AddSetNetAssetValues: []*AddSetNetAssetValuesArgs{
+					{
+						marker:         appleMarker,
+						netAssetValues: []markertypes.NetAssetValue{{Price: s.coin("40peach"), Volume: 7}},
+						source:         "x/exchange market 1",
+					},
+				},
+			},
+		},
+		{
+			name:         "one ask one bid: partial bid",
+			markerKeeper: NewMockMarkerKeeper().WithGetMarkerAccount(appleMarker),
+			setup: func() {
+				s.k.SetParams(s.ctx, &exchange.Params{})
+				s.requireCreateMarket(exchange.Market{MarketId: 1})
+				store := s.getStore()
+				s.requireSetOrderInStore(store, exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					Assets: s.coin("7apple"), Price: s.coin("30peach"), MarketId: 1, Seller: s.addr5.String(),
+					ExternalId: "the-ask-order",
+				}))
+				s.requireSetOrderInStore(store, exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+					Assets: s.coin("10apple"), Price: s.coin("50peach"), MarketId: 1, Buyer: s.addr3.String(),
+					BuyerSettlementFees: s.coins("20fig"),
+					ExternalId:          "the-bid-order",
+					AllowPartial:        true,
 				}))
 			},
 			marketID:      1,
@@ -2401,6 +3222,18 @@ func (s *TestSuite) TestKeeper_SettleOrders() {
 					OrderId: 2, Assets: "7apple", Price: "35peach", Fees: "14fig",
 					MarketId: 1, ExternalId: "the-bid-order",
 				},
+				&exchange.EventOrderPartialFill{
+					OrderId: 2, OrderType: "bid", MarketId: 1, ExternalId: "the-bid-order", Owner: s.addr3.String(),
+					FilledAssets: "7apple", FilledPrice: "35peach", FilledFees: "14fig",
+					RemainingAssets: "3apple", RemainingPrice: "15peach", RemainingFees: "6fig",
+					Participants: []*exchange.FillParticipant{
+						{OrderId: 1, OrderType: "ask", Owner: s.addr5.String(), Assets: "7apple", Price: "35peach"},
+						{
+							OrderId: 2, OrderType: "bid", Owner: s.addr3.String(),
+							Assets: "7apple", Price: "35peach", Fees: "14fig", Partial: true,
+						},
+					},
+				},
 			},
 			expPartialLeft: exchange.NewOrder(2).WithBid(&exchange.BidOrder{
 				Assets: s.coin("3apple"), Price: s.coin("15peach"), MarketId: 1, Buyer: s.addr3.String(),
@@ -2568,6 +3401,9 @@ func (s *TestSuite) TestKeeper_SettleOrders() {
 
 			em := sdk.NewEventManager()
 			ctx := s.ctx.WithEventManager(em)
+			if !tc.blockTime.IsZero() {
+				ctx = ctx.WithBlockTime(tc.blockTime)
+			}
 			kpr := s.k.WithAccountKeeper(s.accKeeper).
 				WithBankKeeper(tc.bankKeeper).
 				WithHoldKeeper(tc.holdKeeper).
@@ -2618,6 +3454,98 @@ func (s *TestSuite) TestKeeper_SettleOrders() {
 	}
 }
 
+func (s *TestSuite) TestKeeper_PublishPrices() {
+	nav := func(assets, price string) exchange.NetAssetPrice {
+		return exchange.NetAssetPrice{Assets: s.coin(assets), Price: s.coin(price)}
+	}
+	publishArgs := func(assets, price string, marketID uint32) *PublishPriceArgs {
+		return &PublishPriceArgs{
+			Assets: s.coin(assets),
+			Price:  s.coin(price),
+			Source: fmt.Sprintf("x/exchange market %d", marketID),
+		}
+	}
+
+	tests := []struct {
+		name         string
+		noOracle     bool
+		enabled      bool
+		oracleKeeper *MockOracleKeeper
+		marketID     uint32
+		navs         []exchange.NetAssetPrice
+		expCalls     []*PublishPriceArgs
+		expLog       []string
+	}{
+		{
+			name:     "no oracle keeper",
+			noOracle: true,
+			enabled:  true,
+			marketID: 1,
+			navs:     []exchange.NetAssetPrice{nav("10apple", "25plum")},
+		},
+		{
+			name:     "publish prices not enabled",
+			marketID: 1,
+			navs:     []exchange.NetAssetPrice{nav("10apple", "25plum")},
+		},
+		{
+			name:     "no navs",
+			enabled:  true,
+			marketID: 1,
+		},
+		{
+			name:     "two navs",
+			enabled:  true,
+			marketID: 3,
+			navs:     []exchange.NetAssetPrice{nav("10apple", "25plum"), nav("3apple", "4pear")},
+			expCalls: []*PublishPriceArgs{
+				publishArgs("10apple", "25plum", 3),
+				publishArgs("3apple", "4pear", 3),
+			},
+		},
+		{
+			name:         "error publishing first of two",
+			enabled:      true,
+			oracleKeeper: NewMockOracleKeeper().WithPublishPriceResults("injected test error"),
+			marketID:     2,
+			navs:         []exchange.NetAssetPrice{nav("10apple", "25plum"), nav("3apple", "4pear")},
+			expCalls: []*PublishPriceArgs{
+				publishArgs("10apple", "25plum", 2),
+				publishArgs("3apple", "4pear", 2),
+			},
+			expLog: []string{"ERR error publishing price of \"10apple\" at \"25plum\": injected test error module=x/exchange"},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.enabled {
+				keeper.SetPublishPricesEnabled(s.getStore(), tc.marketID, true)
+			}
+			if tc.oracleKeeper == nil {
+				tc.oracleKeeper = NewMockOracleKeeper()
+			}
+			kpr := s.k.WithOracleKeeper(tc.oracleKeeper)
+			if tc.noOracle {
+				kpr = s.k.WithOracleKeeper(nil)
+			}
+
+			s.logBuffer.Reset()
+			testFunc := func() {
+				kpr.PublishPrices(s.ctx, tc.marketID, tc.navs)
+			}
+			s.Require().NotPanics(testFunc, "PublishPrices")
+			assertEqualSlice(s, tc.expCalls, tc.oracleKeeper.Calls.PublishPrice, publishPriceArgsString,
+				"oracle PublishPrice calls")
+
+			outputLog := s.getLogOutput("PublishPrices")
+			actLog := s.splitOutputLog(outputLog)
+			s.Assert().Equal(tc.expLog, actLog, "Lines logged during PublishPrices")
+		})
+	}
+}
+
 func (s *TestSuite) TestKeeper_GetNav() {
 	scopeDenom := s.scopeID("scope_uuid").Denom()
 	tests := []struct {
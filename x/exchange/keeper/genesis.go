@@ -46,6 +46,20 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *exchange.GenesisState) {
 		}
 	}
 
+	for i, triggerOrder := range genState.TriggerOrders {
+		if err := k.setTriggerOrderInStore(store, triggerOrder); err != nil {
+			panic(fmt.Errorf("failed to store TriggerOrders[%d]: %w", i, err))
+		}
+		recordHold(triggerOrder.Order.GetOwner(), triggerOrder.Order.GetHoldAmount())
+		if triggerOrder.GetOrderID() > maxOrderID {
+			maxOrderID = triggerOrder.GetOrderID()
+		}
+	}
+
+	for _, link := range genState.OrderLinks {
+		setOrderLink(store, link)
+	}
+
 	if genState.LastOrderId < maxOrderID {
 		panic(fmt.Errorf("last order id %d is less than largest order id %d", genState.LastOrderId, maxOrderID))
 	}
@@ -56,10 +70,34 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *exchange.GenesisState) {
 		if err != nil {
 			panic(fmt.Errorf("failed to convert commitments[%d].Account=%q to AccAddress: %w", i, com.Account, err))
 		}
-		addCommitmentAmount(store, com.MarketId, addr, com.Amount)
+		addCommitmentAmount(store, com.MarketId, addr, com.Amount, ctx.BlockTime())
+		if com.Expiration != nil {
+			setCommitmentExpiration(store, com.MarketId, addr, com.Expiration)
+		}
 		recordHold(com.Account, com.Amount)
 	}
 
+	for _, pool := range genState.RewardPools {
+		setCommitmentRewardPool(store, pool.MarketId, pool.Amount)
+	}
+
+	for i, reward := range genState.CommitmentRewards {
+		addr, err := sdk.AccAddressFromBech32(reward.Account)
+		if err != nil {
+			panic(fmt.Errorf("failed to convert CommitmentRewards[%d].Account=%q to AccAddress: %w", i, reward.Account, err))
+		}
+		setCommitmentReward(store, addr, reward.MarketId, reward.Amount)
+	}
+
+	// The commitments above got fresh accrual entries, so these need to come after them.
+	for i, accrual := range genState.CommitmentAccruals {
+		addr, err := sdk.AccAddressFromBech32(accrual.Account)
+		if err != nil {
+			panic(fmt.Errorf("failed to convert CommitmentAccruals[%d].Account=%q to AccAddress: %w", i, accrual.Account, err))
+		}
+		setCommitmentAccrual(store, accrual.MarketId, addr, accrual.LastAccrued, accrual.Accrued)
+	}
+
 	for i := range genState.Payments {
 		payment := &genState.Payments[i]
 		err := k.createPaymentInStore(store, payment)
@@ -69,6 +107,79 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *exchange.GenesisState) {
 		recordHold(payment.Source, payment.SourceAmount)
 	}
 
+	for i := range genState.RecurringPayments {
+		if err := k.setRecurringPaymentInStore(store, &genState.RecurringPayments[i]); err != nil {
+			panic(fmt.Errorf("failed to store RecurringPayments[%d]: %w", i, err))
+		}
+	}
+
+	for i := range genState.EscrowedPayments {
+		if err := k.setEscrowedPaymentInStore(store, &genState.EscrowedPayments[i]); err != nil {
+			panic(fmt.Errorf("failed to store EscrowedPayments[%d]: %w", i, err))
+		}
+	}
+
+	for i := range genState.MultiPartyPayments {
+		mpp := &genState.MultiPartyPayments[i]
+		if err := k.setMultiPartyPaymentInStore(store, mpp); err != nil {
+			panic(fmt.Errorf("failed to store MultiPartyPayments[%d]: %w", i, err))
+		}
+		for _, party := range mpp.Parties {
+			if party.Accepted {
+				recordHold(party.Address, party.SendAmount)
+			}
+		}
+	}
+
+	for i, price := range genState.SettlementPrices {
+		if err := k.setSettlementPriceInStore(store, price); err != nil {
+			panic(fmt.Errorf("failed to store SettlementPrices[%d]: %w", i, err))
+		}
+	}
+
+	for i, record := range genState.SettlementRecords {
+		if err := k.setSettlementRecordInStore(store, record); err != nil {
+			panic(fmt.Errorf("failed to store SettlementRecords[%d]: %w", i, err))
+		}
+	}
+
+	for i, record := range genState.NavRecords {
+		if err := k.setNAVRecordInStore(store, record); err != nil {
+			panic(fmt.Errorf("failed to store NavRecords[%d]: %w", i, err))
+		}
+	}
+
+	for i, change := range genState.ScheduledFeeChanges {
+		if err := k.addScheduledFeeChangeToStore(store, change); err != nil {
+			panic(fmt.Errorf("failed to store ScheduledFeeChanges[%d]: %w", i, err))
+		}
+	}
+
+	for i, transfer := range genState.AuthorityTransfers {
+		if err := k.setAuthorityTransferInStore(store, transfer); err != nil {
+			panic(fmt.Errorf("failed to store AuthorityTransfers[%d]: %w", i, err))
+		}
+	}
+
+	for i, record := range genState.IdempotencyKeys {
+		if err := setIdempotencyKeyRecordInStore(store, record); err != nil {
+			panic(fmt.Errorf("failed to store IdempotencyKeys[%d]: %w", i, err))
+		}
+	}
+
+	setLastSettlementID(store, genState.LastSettlementId)
+	for i, receipt := range genState.SettlementReceipts {
+		if err := k.setSettlementReceiptInStore(store, receipt); err != nil {
+			panic(fmt.Errorf("failed to store SettlementReceipts[%d]: %w", i, err))
+		}
+	}
+
+	for i, dms := range genState.DeadManSwitches {
+		if err := k.setDeadManSwitchInStore(store, &dms); err != nil {
+			panic(fmt.Errorf("failed to store DeadManSwitches[%d]: %w", i, err))
+		}
+	}
+
 	// Make sure all the needed funds have holds on them. These should have been placed during initialization of the hold module.
 	for _, addr := range holdAddrs {
 		for _, reqAmt := range holdAmounts[addr] {
@@ -87,9 +198,10 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *exchange.GenesisState) {
 func (k Keeper) ExportGenesis(ctx sdk.Context) *exchange.GenesisState {
 	store := k.getStore(ctx)
 	genState := &exchange.GenesisState{
-		Params:       k.GetParams(ctx),
-		LastMarketId: getLastAutoMarketID(store),
-		LastOrderId:  getLastOrderID(store),
+		Params:           k.GetParams(ctx),
+		LastMarketId:     getLastAutoMarketID(store),
+		LastOrderId:      getLastOrderID(store),
+		LastSettlementId: getLastSettlementID(store),
 	}
 
 	k.IterateMarkets(ctx, func(market *exchange.Market) bool {
@@ -105,15 +217,125 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *exchange.GenesisState {
 		k.logErrorf(ctx, "error (ignored) while reading orders: %v", err)
 	}
 
+	err = k.IterateTriggerOrders(ctx, func(triggerOrder *exchange.TriggerOrder) bool {
+		genState.TriggerOrders = append(genState.TriggerOrders, *triggerOrder)
+		return false
+	})
+	if err != nil {
+		k.logErrorf(ctx, "error (ignored) while reading trigger orders: %v", err)
+	}
+
+	err = k.IterateOrderLinks(ctx, func(link *exchange.OrderLink) bool {
+		genState.OrderLinks = append(genState.OrderLinks, *link)
+		return false
+	})
+	if err != nil {
+		k.logErrorf(ctx, "error (ignored) while reading order links: %v", err)
+	}
+
 	k.IterateCommitments(ctx, func(commitment exchange.Commitment) bool {
 		genState.Commitments = append(genState.Commitments, commitment)
 		return false
 	})
 
+	k.IterateCommitmentRewardPools(ctx, func(pool exchange.MarketAmount) bool {
+		genState.RewardPools = append(genState.RewardPools, pool)
+		return false
+	})
+
+	k.IterateCommitmentRewards(ctx, func(reward exchange.CommitmentReward) bool {
+		genState.CommitmentRewards = append(genState.CommitmentRewards, reward)
+		return false
+	})
+
+	k.IterateCommitmentAccruals(ctx, func(accrual exchange.CommitmentAccrual) bool {
+		genState.CommitmentAccruals = append(genState.CommitmentAccruals, accrual)
+		return false
+	})
+
 	k.IteratePayments(ctx, func(payment *exchange.Payment) bool {
 		genState.Payments = append(genState.Payments, *payment)
 		return false
 	})
 
+	k.IterateRecurringPayments(ctx, func(rp *exchange.RecurringPayment) bool {
+		genState.RecurringPayments = append(genState.RecurringPayments, *rp)
+		return false
+	})
+
+	k.IterateMultiPartyPayments(ctx, func(mpp *exchange.MultiPartyPayment) bool {
+		genState.MultiPartyPayments = append(genState.MultiPartyPayments, *mpp)
+		return false
+	})
+
+	k.IterateEscrowedPayments(ctx, func(ep *exchange.EscrowedPayment) bool {
+		genState.EscrowedPayments = append(genState.EscrowedPayments, *ep)
+		return false
+	})
+
+	err = k.IterateSettlementPrices(ctx, func(price *exchange.SettlementPrice) bool {
+		genState.SettlementPrices = append(genState.SettlementPrices, *price)
+		return false
+	})
+	if err != nil {
+		k.logErrorf(ctx, "error (ignored) while reading settlement prices: %v", err)
+	}
+
+	err = k.IterateSettlementRecords(ctx, func(record *exchange.SettlementRecord) bool {
+		genState.SettlementRecords = append(genState.SettlementRecords, *record)
+		return false
+	})
+	if err != nil {
+		k.logErrorf(ctx, "error (ignored) while reading settlement records: %v", err)
+	}
+
+	err = k.IterateNAVRecords(ctx, func(record *exchange.NAVRecord) bool {
+		genState.NavRecords = append(genState.NavRecords, *record)
+		return false
+	})
+	if err != nil {
+		k.logErrorf(ctx, "error (ignored) while reading nav records: %v", err)
+	}
+
+	err = k.IterateScheduledFeeChanges(ctx, func(change *exchange.MsgGovManageFeesRequest) bool {
+		genState.ScheduledFeeChanges = append(genState.ScheduledFeeChanges, *change)
+		return false
+	})
+	if err != nil {
+		k.logErrorf(ctx, "error (ignored) while reading scheduled fee changes: %v", err)
+	}
+
+	err = k.IterateAuthorityTransfers(ctx, func(transfer *exchange.MarketAuthorityTransfer) bool {
+		genState.AuthorityTransfers = append(genState.AuthorityTransfers, *transfer)
+		return false
+	})
+	if err != nil {
+		k.logErrorf(ctx, "error (ignored) while reading authority transfers: %v", err)
+	}
+
+	err = k.IterateIdempotencyKeys(ctx, func(record *exchange.IdempotencyKeyRecord) bool {
+		genState.IdempotencyKeys = append(genState.IdempotencyKeys, *record)
+		return false
+	})
+	if err != nil {
+		k.logErrorf(ctx, "error (ignored) while reading idempotency keys: %v", err)
+	}
+
+	err = k.IterateSettlementReceipts(ctx, func(receipt *exchange.SettlementReceipt) bool {
+		genState.SettlementReceipts = append(genState.SettlementReceipts, *receipt)
+		return false
+	})
+	if err != nil {
+		k.logErrorf(ctx, "error (ignored) while reading settlement receipts: %v", err)
+	}
+
+	err = k.IterateDeadManSwitches(ctx, func(dms *exchange.DeadManSwitch) bool {
+		genState.DeadManSwitches = append(genState.DeadManSwitches, *dms)
+		return false
+	})
+	if err != nil {
+		k.logErrorf(ctx, "error (ignored) while reading dead-man switches: %v", err)
+	}
+
 	return genState
 }
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -29,10 +30,24 @@ func (s *TestSuite) assertEqualGenState(expected, actual *exchange.GenesisState,
 	}
 	assertEqualSlice(s, expected.Markets, actual.Markets, s.getGenStateMarketStr, msg+" Markets", args...)
 	assertEqualSlice(s, expected.Orders, actual.Orders, s.getGenStateOrderStr, msg+" Orders", args...)
+	assertEqualSlice(s, expected.TriggerOrders, actual.TriggerOrders, s.getGenStateTriggerOrderStr, msg+" TriggerOrders", args...)
+	s.Assert().Equalf(expected.OrderLinks, actual.OrderLinks, msg+" OrderLinks", args...)
 	s.Assert().Equalf(int(expected.LastMarketId), int(actual.LastMarketId), msg+" LastMarketId", args...)
 	s.Assert().Equalf(fmt.Sprintf("%d", expected.LastOrderId), fmt.Sprintf("%d", actual.LastOrderId), msg+" LastMarketId", args...)
 	s.assertEqualCommitments(expected.Commitments, actual.Commitments, msg+" Commitments", args...)
 	assertEqualSlice(s, expected.Payments, actual.Payments, s.getPaymentString, msg+" Payments", args...)
+	s.Assert().Equalf(expected.RecurringPayments, actual.RecurringPayments, msg+" RecurringPayments", args...)
+	s.Assert().Equalf(expected.MultiPartyPayments, actual.MultiPartyPayments, msg+" MultiPartyPayments", args...)
+	s.Assert().Equalf(expected.EscrowedPayments, actual.EscrowedPayments, msg+" EscrowedPayments", args...)
+	assertEqualSlice(s, expected.SettlementPrices, actual.SettlementPrices, s.getGenStateSettlementPriceStr, msg+" SettlementPrices", args...)
+	assertEqualSlice(s, expected.SettlementRecords, actual.SettlementRecords, s.getGenStateSettlementRecordStr, msg+" SettlementRecords", args...)
+	assertEqualSlice(s, expected.NavRecords, actual.NavRecords, s.getGenStateNAVRecordStr, msg+" NavRecords", args...)
+	s.Assert().Equalf(expected.ScheduledFeeChanges, actual.ScheduledFeeChanges, msg+" ScheduledFeeChanges", args...)
+	s.Assert().Equalf(expected.AuthorityTransfers, actual.AuthorityTransfers, msg+" AuthorityTransfers", args...)
+	s.Assert().Equalf(expected.IdempotencyKeys, actual.IdempotencyKeys, msg+" IdempotencyKeys", args...)
+	s.Assert().Equalf(fmt.Sprintf("%d", expected.LastSettlementId), fmt.Sprintf("%d", actual.LastSettlementId), msg+" LastSettlementId", args...)
+	assertEqualSlice(s, expected.SettlementReceipts, actual.SettlementReceipts, s.getGenStateSettlementReceiptStr, msg+" SettlementReceipts", args...)
+	s.Assert().Equalf(expected.DeadManSwitches, actual.DeadManSwitches, msg+" DeadManSwitches", args...)
 	return false
 }
 
@@ -52,6 +67,31 @@ func (s *TestSuite) getGenStateOrderStr(order exchange.Order) string {
 		order.GetOrderType(), order.OrderId, order.GetOwner(), order.GetAssets(), order.GetPrice())
 }
 
+// getGenStateTriggerOrderStr returns a string representing the trigger order to help identify slice entries.
+func (s *TestSuite) getGenStateTriggerOrderStr(triggerOrder exchange.TriggerOrder) string {
+	return s.getGenStateOrderStr(triggerOrder.Order) + " triggered at " + triggerOrder.TriggerPrice.String()
+}
+
+// getGenStateSettlementPriceStr returns a string representing the settlement price to help identify slice entries.
+func (s *TestSuite) getGenStateSettlementPriceStr(price exchange.SettlementPrice) string {
+	return fmt.Sprintf("%d: %s for %s at %s", price.MarketId, price.Assets, price.Price, price.Time.Format(time.RFC3339))
+}
+
+// getGenStateSettlementRecordStr returns a string representing the settlement record to help identify slice entries.
+func (s *TestSuite) getGenStateSettlementRecordStr(record exchange.SettlementRecord) string {
+	return fmt.Sprintf("%d: %d/%d with %d fills", record.MarketId, record.Height, record.Sequence, len(record.Fills))
+}
+
+// getGenStateSettlementReceiptStr returns a string representing the settlement receipt to help identify slice entries.
+func (s *TestSuite) getGenStateSettlementReceiptStr(receipt exchange.SettlementReceipt) string {
+	return fmt.Sprintf("%d: market %d at %d with %d fills", receipt.SettlementId, receipt.MarketId, receipt.Height, len(receipt.Fills))
+}
+
+// getGenStateNAVRecordStr returns a string representing the NAV record to help identify slice entries.
+func (s *TestSuite) getGenStateNAVRecordStr(record exchange.NAVRecord) string {
+	return fmt.Sprintf("%d: %s for %s at %d", record.MarketId, record.Assets, record.Price, record.Height)
+}
+
 func (s *TestSuite) TestKeeper_InitAndExportGenesis() {
 	marketAcc := func(marketID uint32, name string) *exchange.MarketAccount {
 		return &exchange.MarketAccount{
@@ -105,6 +145,57 @@ func (s *TestSuite) TestKeeper_InitAndExportGenesis() {
 			Amount:   s.coins(amount),
 		}
 	}
+	accrual := func(addr sdk.AccAddress, marketID uint32, accrued string, unixSecs int64) exchange.CommitmentAccrual {
+		rv := exchange.CommitmentAccrual{
+			Account:  addr.String(),
+			MarketId: marketID,
+			Accrued:  s.coins(accrued),
+		}
+		if unixSecs != 0 {
+			rv.LastAccrued = time.Unix(unixSecs, 0).UTC()
+		}
+		return rv
+	}
+	settlementPrice := func(marketID uint32, unixSecs int64, assets, price string) exchange.SettlementPrice {
+		return exchange.SettlementPrice{
+			MarketId: marketID,
+			Time:     time.Unix(unixSecs, 0).UTC(),
+			Assets:   s.coin(assets),
+			Price:    s.coin(price),
+		}
+	}
+	settlementRecord := func(marketID uint32, height int64, sequence uint32, orderIDs ...uint64) exchange.SettlementRecord {
+		rv := exchange.SettlementRecord{
+			MarketId: marketID,
+			Height:   height,
+			Sequence: sequence,
+			Time:     time.Unix(1_700_000_000+height, 0).UTC(),
+		}
+		for i, orderID := range orderIDs {
+			rv.Fills = append(rv.Fills, exchange.SettlementFill{
+				OrderId:   orderID,
+				OrderType: exchange.OrderTypeAsk,
+				Owner:     s.addr1.String(),
+				Assets:    s.coin("5apple"),
+				Price:     s.coin("12pear"),
+				Fees:      s.coins("1pear"),
+				Partial:   i == len(orderIDs)-1,
+			})
+		}
+		return rv
+	}
+	navRecord := func(marketID uint32, height int64, assets, price string) exchange.NAVRecord {
+		return exchange.NAVRecord{
+			Assets:   s.coin(assets),
+			Price:    s.coin(price),
+			Height:   height,
+			MarketId: marketID,
+		}
+	}
+	paymentWithWindow := func(payment exchange.Payment, seconds uint64) exchange.Payment {
+		payment.DisputeWindowSeconds = seconds
+		return payment
+	}
 	payment := func(source sdk.AccAddress, sourceAmount string, target sdk.AccAddress, targetAmount string, externalID string) exchange.Payment {
 		return exchange.Payment{
 			Source:       source.String(),
@@ -313,6 +404,68 @@ func (s *TestSuite) TestKeeper_InitAndExportGenesis() {
 				},
 			},
 		},
+		{
+			name: "two trigger orders",
+			holdKeeper: NewMockHoldKeeper().
+				WithGetHoldCoinResult(accAddr("seller", 3), askHoldCoins(3)...).
+				WithGetHoldCoinResult(accAddr("seller", 5), askHoldCoins(5)...).
+				WithGetHoldCoinResult(accAddr("buyer", 6), bidHoldCoins(6)...),
+			genState: &exchange.GenesisState{
+				Orders: []exchange.Order{askOrder(3, 1, "")},
+				TriggerOrders: []exchange.TriggerOrder{
+					*exchange.NewTriggerOrder(askOrder(5, 1, ""), s.coin("4"+priceDenom)),
+					*exchange.NewTriggerOrder(bidOrder(6, 1, ""), s.coin("7"+priceDenom)),
+				},
+				LastOrderId: 6,
+			},
+			expHoldCalls: HoldCalls{
+				GetHoldCoin: []*GetHoldCoinArgs{
+					{addr: accAddr("seller", 3), denom: assetDenom},
+					{addr: accAddr("seller", 3), denom: feeDenom},
+					{addr: accAddr("seller", 5), denom: assetDenom},
+					{addr: accAddr("seller", 5), denom: feeDenom},
+					{addr: accAddr("buyer", 6), denom: feeDenom},
+					{addr: accAddr("buyer", 6), denom: priceDenom},
+				},
+			},
+		},
+		{
+			name: "linked orders",
+			holdKeeper: NewMockHoldKeeper().
+				WithGetHoldCoinResult(s.addr1, askHoldCoins(3).Add(askHoldCoins(5)...).Add(bidHoldCoins(6)...)...),
+			genState: &exchange.GenesisState{
+				Orders: []exchange.Order{
+					askOrder(3, 1, s.addr1.String()),
+					bidOrder(6, 1, s.addr1.String()),
+				},
+				TriggerOrders: []exchange.TriggerOrder{
+					*exchange.NewTriggerOrder(askOrder(5, 1, s.addr1.String()), s.coin("4"+priceDenom)),
+				},
+				OrderLinks: []exchange.OrderLink{
+					{MarketId: 1, OrderId: 3, LinkedOrderId: 5},
+				},
+				LastOrderId: 6,
+			},
+			expHoldCalls: HoldCalls{
+				GetHoldCoin: []*GetHoldCoinArgs{
+					{addr: s.addr1, denom: assetDenom},
+					{addr: s.addr1, denom: feeDenom},
+					{addr: s.addr1, denom: priceDenom},
+				},
+			},
+		},
+		{
+			name: "trigger order id more than last order id",
+			holdKeeper: NewMockHoldKeeper().
+				WithGetHoldCoinResult(accAddr("seller", 5), askHoldCoins(5)...),
+			genState: &exchange.GenesisState{
+				TriggerOrders: []exchange.TriggerOrder{
+					*exchange.NewTriggerOrder(askOrder(5, 1, ""), s.coin("4"+priceDenom)),
+				},
+				LastOrderId: 4,
+			},
+			expInitPanic: "last order id 4 is less than largest order id 5",
+		},
 		{
 			name: "several orders",
 			holdKeeper: NewMockHoldKeeper().
@@ -460,7 +613,8 @@ func (s *TestSuite) TestKeeper_InitAndExportGenesis() {
 			name:       "one commitment",
 			holdKeeper: NewMockHoldKeeper().WithGetHoldCoinResult(s.addr2, s.coins("25cherry")...),
 			genState: &exchange.GenesisState{
-				Commitments: []exchange.Commitment{commitment(s.addr2, 1, "25cherry")},
+				Commitments:        []exchange.Commitment{commitment(s.addr2, 1, "25cherry")},
+				CommitmentAccruals: []exchange.CommitmentAccrual{accrual(s.addr2, 1, "", 0)},
 			},
 			expHoldCalls: HoldCalls{GetHoldCoin: []*GetHoldCoinArgs{{addr: s.addr2, denom: "cherry"}}},
 		},
@@ -475,6 +629,11 @@ func (s *TestSuite) TestKeeper_InitAndExportGenesis() {
 					commitment(s.addr3, 1, "15cherry,15pear"),
 					commitment(s.addr3, 3, "17apple,17cherry"),
 				},
+				CommitmentAccruals: []exchange.CommitmentAccrual{
+					accrual(s.addr2, 1, "", 0),
+					accrual(s.addr3, 1, "", 0),
+					accrual(s.addr3, 3, "", 0),
+				},
 			},
 			expHoldCalls: HoldCalls{
 				GetHoldCoin: []*GetHoldCoinArgs{
@@ -513,7 +672,8 @@ func (s *TestSuite) TestKeeper_InitAndExportGenesis() {
 				s.getStore().Set(keeper.MakeKeyCommitment(8, s.addr3), []byte("x")) // Entry should just get ignored.
 			},
 			genState: &exchange.GenesisState{
-				Commitments: []exchange.Commitment{commitment(s.addr3, 1, "25cherry")},
+				Commitments:        []exchange.Commitment{commitment(s.addr3, 1, "25cherry")},
+				CommitmentAccruals: []exchange.CommitmentAccrual{accrual(s.addr3, 1, "", 0)},
 			},
 			expHoldCalls: HoldCalls{GetHoldCoin: []*GetHoldCoinArgs{{addr: s.addr3, denom: "cherry"}}},
 		},
@@ -590,6 +750,93 @@ func (s *TestSuite) TestKeeper_InitAndExportGenesis() {
 			},
 			expInitPanic: "failed to store Payments[0]: a payment already exists with source " + s.addr4.String() + " and external id \"taken\"",
 		},
+		{
+			name: "two recurring payments",
+			genState: &exchange.GenesisState{
+				RecurringPayments: []exchange.RecurringPayment{
+					*s.newTestRecurringPayment(s.addr1, s.addr2, "5strawberry", "rent", 11, time.Unix(1_900_000_000, 0).UTC()),
+					*s.newTestRecurringPayment(s.addr3, s.addr2, "2tangerine", "", 1, time.Unix(1_900_003_600, 0).UTC()),
+				},
+			},
+		},
+		{
+			name: "recurring payment with bad source",
+			genState: &exchange.GenesisState{
+				RecurringPayments: []exchange.RecurringPayment{
+					{Source: "notavalidaddressstring", Target: s.addr3.String(), ExternalId: "eid-8"},
+				},
+			},
+			expInitPanic: "failed to store RecurringPayments[0]: invalid source \"notavalidaddressstring\": " +
+				"decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name: "two escrowed payments",
+			genState: &exchange.GenesisState{
+				EscrowedPayments: []exchange.EscrowedPayment{
+					{
+						Payment:     paymentWithWindow(payment(s.addr1, "5strawberry", s.addr2, "", "purchase"), 3600),
+						ReleaseTime: time.Unix(1_900_003_600, 0).UTC(),
+					},
+					{
+						Payment:     paymentWithWindow(payment(s.addr3, "", s.addr2, "2tangerine", ""), 60),
+						ReleaseTime: time.Unix(1_900_000_060, 0).UTC(),
+					},
+				},
+			},
+		},
+		{
+			name: "escrowed payment that is also a payment",
+			genState: &exchange.GenesisState{
+				Payments: []exchange.Payment{payment(s.addr4, "", s.addr1, "6tangerine", "both")},
+				EscrowedPayments: []exchange.EscrowedPayment{
+					{
+						Payment:     paymentWithWindow(payment(s.addr4, "", s.addr1, "6tangerine", "both"), 60),
+						ReleaseTime: time.Unix(1_900_000_060, 0).UTC(),
+					},
+				},
+			},
+			expInitPanic: "failed to store EscrowedPayments[0]: a payment already exists with source " + s.addr4.String() + " and external id \"both\"",
+		},
+		{
+			name: "two multi-party payments",
+			holdKeeper: NewMockHoldKeeper().
+				WithGetHoldCoinResult(s.addr1, s.coins("5strawberry")...).
+				WithGetHoldCoinResult(s.addr3, s.coins("2tangerine")...),
+			genState: &exchange.GenesisState{
+				MultiPartyPayments: []exchange.MultiPartyPayment{
+					{
+						Creator:    s.addr1.String(),
+						ExternalId: "otc",
+						Parties: []exchange.PaymentParty{
+							{Address: s.addr1.String(), SendAmount: s.coins("5strawberry"), Accepted: true},
+							{Address: s.addr2.String(), ReceiveAmount: s.coins("5strawberry,2tangerine")},
+							{Address: s.addr3.String(), SendAmount: s.coins("2tangerine"), Accepted: true},
+						},
+					},
+					{
+						Creator: s.addr2.String(),
+						Parties: []exchange.PaymentParty{
+							{Address: s.addr2.String(), ReceiveAmount: s.coins("1strawberry"), Accepted: true},
+							{Address: s.addr4.String(), SendAmount: s.coins("1strawberry")},
+						},
+					},
+				},
+			},
+			expHoldCalls: HoldCalls{
+				GetHoldCoin: []*GetHoldCoinArgs{
+					{addr: s.addr1, denom: "strawberry"},
+					{addr: s.addr3, denom: "tangerine"},
+				},
+			},
+		},
+		{
+			name: "multi-party payment with bad creator",
+			genState: &exchange.GenesisState{
+				MultiPartyPayments: []exchange.MultiPartyPayment{{Creator: "notavalidaddressstring", ExternalId: "eid-8"}},
+			},
+			expInitPanic: "failed to store MultiPartyPayments[0]: invalid creator \"notavalidaddressstring\": " +
+				"decoding bech32 failed: invalid separator index -1",
+		},
 		{
 			name: "not enough hold on account: multiple sources",
 			holdKeeper: NewMockHoldKeeper().
@@ -612,6 +859,201 @@ func (s *TestSuite) TestKeeper_InitAndExportGenesis() {
 			expInitPanic: "account " + s.addr3.String() + " should have at least \"187fig\" on hold " +
 				"(due to the exchange module), but only has \"186fig\"",
 		},
+		{
+			name: "three settlement prices",
+			genState: &exchange.GenesisState{
+				SettlementPrices: []exchange.SettlementPrice{
+					settlementPrice(2, 1_700_000_100, "5apple", "12pear"),
+					settlementPrice(1, 1_700_000_200, "3apple", "7pear"),
+					settlementPrice(1, 1_700_000_100, "8apple", "20pear"),
+				},
+			},
+		},
+		{
+			name: "bad settlement price entry in state",
+			setup: func() {
+				key := keeper.MakeKeySettlementPrice(1, "apple", "pear", time.Unix(1_700_000_000, 0))
+				s.getStore().Set(key, []byte("x"))
+			},
+			genState: &exchange.GenesisState{
+				SettlementPrices: []exchange.SettlementPrice{settlementPrice(1, 1_700_000_100, "8apple", "20pear")},
+			},
+			expExportLog: "ERR error (ignored) while reading settlement prices: failed to unmarshal settlement price: " +
+				"unexpected EOF module=x/exchange\n",
+		},
+		{
+			name: "three settlement records",
+			genState: &exchange.GenesisState{
+				SettlementRecords: []exchange.SettlementRecord{
+					settlementRecord(2, 10, 0, 3),
+					settlementRecord(1, 12, 0, 5, 6),
+					settlementRecord(1, 10, 1, 4),
+				},
+			},
+		},
+		{
+			name: "bad settlement record entry in state",
+			setup: func() {
+				s.getStore().Set(keeper.MakeKeySettlementRecord(1, 5, 0), []byte("x"))
+			},
+			genState: &exchange.GenesisState{
+				SettlementRecords: []exchange.SettlementRecord{settlementRecord(1, 10, 0, 4)},
+			},
+			expExportLog: "ERR error (ignored) while reading settlement records: failed to unmarshal settlement record: " +
+				"unexpected EOF module=x/exchange\n",
+		},
+		{
+			name: "three nav records",
+			genState: &exchange.GenesisState{
+				NavRecords: []exchange.NAVRecord{
+					navRecord(2, 10, "5apple", "12pear"),
+					navRecord(1, 12, "3apple", "7pear"),
+					navRecord(1, 10, "8apple", "20plum"),
+				},
+			},
+		},
+		{
+			name: "bad nav record entry in state",
+			setup: func() {
+				s.getStore().Set(keeper.MakeKeyNAVRecord("apple", "pear", 5, 1), []byte("x"))
+			},
+			genState: &exchange.GenesisState{
+				NavRecords: []exchange.NAVRecord{navRecord(1, 10, "8apple", "20pear")},
+			},
+			expExportLog: "ERR error (ignored) while reading nav records: failed to unmarshal nav record: " +
+				"unexpected EOF module=x/exchange\n",
+		},
+		{
+			name: "three scheduled fee changes",
+			genState: &exchange.GenesisState{
+				ScheduledFeeChanges: []exchange.MsgGovManageFeesRequest{
+					s.feeChangeAtHeight(1, 12, 300),
+					s.feeChangeAtHeight(1, 11, 200),
+					s.feeChangeAtTime(2, 21, time.Unix(1_800_000_000, 0).UTC()),
+				},
+			},
+		},
+		{
+			name: "bad scheduled fee change entry in state",
+			setup: func() {
+				s.getStore().Set(keeper.MakeKeyScheduledFeeChange(1, 5), []byte{0x0A})
+			},
+			genState: &exchange.GenesisState{
+				ScheduledFeeChanges: []exchange.MsgGovManageFeesRequest{s.feeChangeAtHeight(2, 21, 200)},
+			},
+			expExportLog: "ERR error (ignored) while reading scheduled fee changes: failed to unmarshal scheduled fee change: " +
+				"unexpected EOF module=x/exchange\n",
+		},
+		{
+			name: "two authority transfers",
+			genState: &exchange.GenesisState{
+				AuthorityTransfers: []exchange.MarketAuthorityTransfer{
+					{MarketId: 1, ProposedBy: s.addr1.String(), NewAuthority: s.k.GetAuthority()},
+					{
+						MarketId: 3, ProposedBy: s.addr2.String(), NewAuthority: s.addr3.String(),
+						AccessGrants: []exchange.AccessGrant{s.agCanEverything(s.addr3), s.agCanOnly(s.addr4, exchange.Permission_settle)},
+					},
+				},
+			},
+		},
+		{
+			name: "bad authority transfer entry in state",
+			setup: func() {
+				s.getStore().Set(keeper.MakeKeyMarketAuthorityTransfer(1), []byte{0x12})
+			},
+			genState: &exchange.GenesisState{
+				AuthorityTransfers: []exchange.MarketAuthorityTransfer{
+					{MarketId: 2, ProposedBy: s.addr1.String(), NewAuthority: s.addr1.String()},
+				},
+			},
+			expExportLog: "ERR error (ignored) while reading authority transfers: failed to unmarshal market authority transfer: " +
+				"unexpected EOF module=x/exchange\n",
+		},
+		{
+			name: "two idempotency keys",
+			genState: &exchange.GenesisState{
+				LastOrderId: 10,
+				IdempotencyKeys: []exchange.IdempotencyKeyRecord{
+					{Account: s.addr1.String(), IdempotencyKey: "retry-1", OrderId: 3, Height: 12},
+					{Account: s.addr2.String(), IdempotencyKey: "retry-1", OrderId: 7, Height: 14},
+				},
+			},
+		},
+		{
+			name: "bad idempotency key entry in state",
+			setup: func() {
+				s.getStore().Set(keeper.MakeKeyIdempotencyKey(s.addr1, "bad"), []byte{0x12})
+			},
+			genState: &exchange.GenesisState{
+				LastOrderId: 10,
+				IdempotencyKeys: []exchange.IdempotencyKeyRecord{
+					{Account: s.addr2.String(), IdempotencyKey: "retry-1", OrderId: 7, Height: 14},
+				},
+			},
+			expExportLog: "ERR error (ignored) while reading idempotency keys: account " + s.addr1.String() +
+				" idempotency key \"bad\": cannot parse idempotency key entry value: has 1 bytes, expected 16 module=x/exchange\n",
+		},
+		{
+			name: "last settlement id without receipts",
+			genState: &exchange.GenesisState{
+				LastSettlementId: 42,
+			},
+		},
+		{
+			name: "three settlement receipts",
+			genState: &exchange.GenesisState{
+				LastSettlementId: 12,
+				SettlementReceipts: []exchange.SettlementReceipt{
+					s.settlementReceipt(4, 2, 10, 3),
+					s.settlementReceipt(12, 1, 14, 5, 6),
+					s.settlementReceipt(7, 1, 11, 4),
+				},
+			},
+		},
+		{
+			name: "bad settlement receipt entry in state",
+			setup: func() {
+				s.getStore().Set(keeper.MakeKeySettlementReceipt(3), []byte("x"))
+			},
+			genState: &exchange.GenesisState{
+				LastSettlementId:   5,
+				SettlementReceipts: []exchange.SettlementReceipt{s.settlementReceipt(5, 1, 10, 4)},
+			},
+			expExportLog: "ERR error (ignored) while reading settlement receipts: failed to unmarshal settlement receipt: " +
+				"unexpected EOF module=x/exchange\n",
+		},
+		{
+			name: "two dead-man switches",
+			genState: &exchange.GenesisState{
+				DeadManSwitches: []exchange.DeadManSwitch{
+					*exchange.NewDeadManSwitch(s.addr1.String(), 60, time.Unix(1_900_000_000, 0)),
+					*exchange.NewDeadManSwitch(s.addr2.String(), 3600, time.Unix(1_900_000_100, 0)),
+				},
+			},
+		},
+		{
+			name: "invalid dead-man switch",
+			genState: &exchange.GenesisState{
+				DeadManSwitches: []exchange.DeadManSwitch{
+					{Account: "notavalidaddressstring", IntervalSeconds: 60, Deadline: time.Unix(1_900_000_000, 0).UTC()},
+				},
+			},
+			expInitPanic: "failed to store DeadManSwitches[0]: invalid account \"notavalidaddressstring\": " +
+				"decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name: "bad dead-man switch entry in state",
+			setup: func() {
+				s.getStore().Set(keeper.MakeKeyDeadManSwitch(s.addr3), []byte("x"))
+			},
+			genState: &exchange.GenesisState{
+				DeadManSwitches: []exchange.DeadManSwitch{
+					*exchange.NewDeadManSwitch(s.addr1.String(), 60, time.Unix(1_900_000_000, 0)),
+				},
+			},
+			expExportLog: "ERR error (ignored) while reading dead-man switches: failed to unmarshal dead-man switch: " +
+				"unexpected EOF module=x/exchange\n",
+		},
 		{
 			name: "a little of everything",
 			holdKeeper: NewMockHoldKeeper().
@@ -660,6 +1102,12 @@ func (s *TestSuite) TestKeeper_InitAndExportGenesis() {
 					commitment(s.addr5, 1, "26cherry"),
 					commitment(s.addr5, 420, "27cherry,27grape"),
 				},
+				CommitmentAccruals: []exchange.CommitmentAccrual{
+					accrual(s.addr3, 1, "2500cherry,2500fig", 1_700_000_000),
+					accrual(s.addr4, 1, "700cherry", 1_699_999_000),
+					accrual(s.addr5, 1, "", 1_700_000_000),
+					accrual(s.addr5, 420, "27cherry", 1_700_000_000),
+				},
 				Payments: []exchange.Payment{
 					payment(s.addr1, "", s.addr2, "4tomato", "abc"),
 					payment(s.addr2, "8strawberry", s.addr3, "1tangerine", "def"),
@@ -4,12 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"cosmossdk.io/store/prefix"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
@@ -27,8 +29,19 @@ func NewQueryServer(k Keeper) exchange.QueryServer {
 
 var _ exchange.QueryServer = QueryServer{}
 
+// withoutHiddenAssets replaces each of the provided orders with a copy that only has its displayed assets.
+// Queries are not signed, so there's no way to know whether the requestor owns an order. As such,
+// the hidden portion of an iceberg order is never included in a query response.
+func withoutHiddenAssets(orders []*exchange.Order) []*exchange.Order {
+	for i, order := range orders {
+		orders[i] = order.WithoutHiddenAssets()
+	}
+	return orders
+}
+
 // OrderFeeCalc calculates the fees that will be associated with the provided order.
 func (k QueryServer) OrderFeeCalc(goCtx context.Context, req *exchange.QueryOrderFeeCalcRequest) (*exchange.QueryOrderFeeCalcResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "OrderFeeCalc")
 	if req == nil || (req.AskOrder == nil && req.BidOrder == nil) {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
@@ -46,13 +59,20 @@ func (k QueryServer) OrderFeeCalc(goCtx context.Context, req *exchange.QueryOrde
 		if err := validateMarketExists(store, order.MarketId); err != nil {
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
-		ratioFee, err := calculateSellerSettlementRatioFee(store, order.MarketId, order.Price)
+		ratioFee, err := calculateSellerSettlementRatioFee(store, order.MarketId, order.Price, false)
 		if err != nil {
 			return nil, status.Errorf(codes.InvalidArgument, "failed to calculate seller ratio fee option: %v", err)
 		}
 		if ratioFee != nil {
 			resp.SettlementRatioFeeOptions = append(resp.SettlementRatioFeeOptions, *ratioFee)
 		}
+		takerRatioFee, err := calculateSellerSettlementRatioFee(store, order.MarketId, order.Price, true)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to calculate seller taker ratio fee option: %v", err)
+		}
+		if takerRatioFee != nil {
+			resp.SettlementTakerRatioFeeOptions = append(resp.SettlementTakerRatioFeeOptions, *takerRatioFee)
+		}
 		resp.SettlementFlatFeeOptions = getSellerSettlementFlatFees(store, order.MarketId)
 		resp.CreationFeeOptions = getCreateAskFlatFees(store, order.MarketId)
 	case req.BidOrder != nil:
@@ -79,6 +99,7 @@ func (k QueryServer) OrderFeeCalc(goCtx context.Context, req *exchange.QueryOrde
 
 // GetOrder looks up an order by id.
 func (k QueryServer) GetOrder(goCtx context.Context, req *exchange.QueryGetOrderRequest) (*exchange.QueryGetOrderResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "GetOrder")
 	if req == nil || req.OrderId == 0 {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
@@ -92,11 +113,12 @@ func (k QueryServer) GetOrder(goCtx context.Context, req *exchange.QueryGetOrder
 		return nil, status.Errorf(codes.InvalidArgument, "order %d not found", req.OrderId)
 	}
 
-	return &exchange.QueryGetOrderResponse{Order: order}, nil
+	return &exchange.QueryGetOrderResponse{Order: order.WithoutHiddenAssets()}, nil
 }
 
 // GetOrderByExternalID looks up an order by market id and external id.
 func (k QueryServer) GetOrderByExternalID(goCtx context.Context, req *exchange.QueryGetOrderByExternalIDRequest) (*exchange.QueryGetOrderByExternalIDResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "GetOrderByExternalID")
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
@@ -114,21 +136,78 @@ func (k QueryServer) GetOrderByExternalID(goCtx context.Context, req *exchange.Q
 			req.MarketId, req.ExternalId)
 	}
 
-	return &exchange.QueryGetOrderByExternalIDResponse{Order: order}, nil
+	return &exchange.QueryGetOrderByExternalIDResponse{Order: order.WithoutHiddenAssets()}, nil
+}
+
+// GetOrdersByExternalIDPrefix looks up the orders in a market that have an external id starting with a given prefix.
+func (k QueryServer) GetOrdersByExternalIDPrefix(goCtx context.Context, req *exchange.QueryGetOrdersByExternalIDPrefixRequest) (*exchange.QueryGetOrdersByExternalIDPrefixResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "GetOrdersByExternalIDPrefix")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.MarketId == 0 || len(req.ExternalIdPrefix) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if len(req.ExternalIdPrefix) > exchange.MaxExternalIDLength {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid external id prefix (length %d): max length %d",
+			len(req.ExternalIdPrefix), exchange.MaxExternalIDLength)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	rootStore := k.getStore(ctx)
+	pre := GetIndexKeyPrefixMarketExternalIDToOrder(req.MarketId, req.ExternalIdPrefix)
+	store := prefix.NewStore(rootStore, pre)
+	resp := &exchange.QueryGetOrdersByExternalIDPrefixResponse{}
+	var pageErr error
+
+	resp.Pagination, pageErr = query.FilteredPaginate(store, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		// If we can't get the order id from the value, just pretend like it doesn't exist.
+		orderID, ok := uint64FromBz(value)
+		if !ok {
+			return false, nil
+		}
+		if accumulate {
+			// Only add it to the result if we can read it. This might result in fewer results than the limit,
+			// but at least one bad entry won't block others by causing the whole thing to return an error.
+			order, oerr := k.getOrderFromStore(rootStore, orderID)
+			if oerr == nil && order != nil {
+				resp.Orders = append(resp.Orders, order.WithoutHiddenAssets())
+			}
+		}
+		return true, nil
+	})
+
+	if pageErr != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error iterating orders in market %d with external id prefix %q: %v",
+			req.MarketId, req.ExternalIdPrefix, pageErr)
+	}
+
+	return resp, nil
 }
 
 // GetMarketOrders looks up the orders in a market.
+// If an asset and price denom are provided, the results are ordered by unit price instead of order id.
 func (k QueryServer) GetMarketOrders(goCtx context.Context, req *exchange.QueryGetMarketOrdersRequest) (*exchange.QueryGetMarketOrdersResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "GetMarketOrders")
 	if req == nil || req.MarketId == 0 {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	ctx := sdk.UnwrapSDKContext(goCtx)
-	pre := GetIndexKeyPrefixMarketToOrder(req.MarketId)
+	if (len(req.AssetDenom) == 0) != (len(req.PriceDenom) == 0) {
+		return nil, status.Error(codes.InvalidArgument, "asset denom and price denom must either both be provided or both be empty")
+	}
 
+	ctx := sdk.UnwrapSDKContext(goCtx)
 	resp := &exchange.QueryGetMarketOrdersResponse{}
 	var err error
-	resp.Pagination, resp.Orders, err = k.getPageOfOrdersFromIndex(ctx, pre, req.Pagination, req.OrderType, req.AfterOrderId)
+	if len(req.AssetDenom) > 0 {
+		resp.Pagination, resp.Orders, err = k.getPageOfOrdersByPrice(ctx, req.MarketId, req.AssetDenom, req.PriceDenom,
+			req.Pagination, req.OrderType, req.AfterOrderId)
+	} else {
+		pre := GetIndexKeyPrefixMarketToOrder(req.MarketId)
+		resp.Pagination, resp.Orders, err = k.getPageOfOrdersFromIndex(ctx, pre, req.Pagination, req.OrderType, req.AfterOrderId)
+	}
+	resp.Orders = withoutHiddenAssets(resp.Orders)
 
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "error iterating orders for market %d: %v", req.MarketId, err)
@@ -139,6 +218,7 @@ func (k QueryServer) GetMarketOrders(goCtx context.Context, req *exchange.QueryG
 
 // GetOwnerOrders looks up the orders from the provided owner address.
 func (k QueryServer) GetOwnerOrders(goCtx context.Context, req *exchange.QueryGetOwnerOrdersRequest) (*exchange.QueryGetOwnerOrdersResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "GetOwnerOrders")
 	if req == nil || len(req.Owner) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
@@ -154,6 +234,7 @@ func (k QueryServer) GetOwnerOrders(goCtx context.Context, req *exchange.QueryGe
 	resp := &exchange.QueryGetOwnerOrdersResponse{}
 	var err error
 	resp.Pagination, resp.Orders, err = k.getPageOfOrdersFromIndex(ctx, pre, req.Pagination, req.OrderType, req.AfterOrderId)
+	resp.Orders = withoutHiddenAssets(resp.Orders)
 
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "error iterating orders for owner %s: %v", req.Owner, err)
@@ -164,6 +245,7 @@ func (k QueryServer) GetOwnerOrders(goCtx context.Context, req *exchange.QueryGe
 
 // GetAssetOrders looks up the orders for a specific asset denom.
 func (k QueryServer) GetAssetOrders(goCtx context.Context, req *exchange.QueryGetAssetOrdersRequest) (*exchange.QueryGetAssetOrdersResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "GetAssetOrders")
 	if req == nil || len(req.Asset) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
@@ -174,6 +256,7 @@ func (k QueryServer) GetAssetOrders(goCtx context.Context, req *exchange.QueryGe
 	resp := &exchange.QueryGetAssetOrdersResponse{}
 	var err error
 	resp.Pagination, resp.Orders, err = k.getPageOfOrdersFromIndex(ctx, pre, req.Pagination, req.OrderType, req.AfterOrderId)
+	resp.Orders = withoutHiddenAssets(resp.Orders)
 
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "error iterating orders for asset %s: %v", req.Asset, err)
@@ -184,6 +267,7 @@ func (k QueryServer) GetAssetOrders(goCtx context.Context, req *exchange.QueryGe
 
 // GetAllOrders gets all orders in the exchange module.
 func (k QueryServer) GetAllOrders(goCtx context.Context, req *exchange.QueryGetAllOrdersRequest) (*exchange.QueryGetAllOrdersResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "GetAllOrders")
 	var pagination *query.PageRequest
 	if req != nil {
 		pagination = req.Pagination
@@ -206,7 +290,7 @@ func (k QueryServer) GetAllOrders(goCtx context.Context, req *exchange.QueryGetA
 			// but at least one bad entry won't block others by causing the whole thing to return an error.
 			order, oerr := k.parseOrderStoreValue(orderID, value)
 			if oerr == nil {
-				resp.Orders = append(resp.Orders, order)
+				resp.Orders = append(resp.Orders, order.WithoutHiddenAssets())
 			}
 		}
 		return true, nil
@@ -219,8 +303,472 @@ func (k QueryServer) GetAllOrders(goCtx context.Context, req *exchange.QueryGetA
 	return resp, nil
 }
 
+// GetTriggerOrder looks up a trigger order that has not yet been activated.
+func (k QueryServer) GetTriggerOrder(goCtx context.Context, req *exchange.QueryGetTriggerOrderRequest) (*exchange.QueryGetTriggerOrderResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "GetTriggerOrder")
+	if req == nil || req.OrderId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	triggerOrder, err := k.Keeper.GetTriggerOrder(ctx, req.OrderId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if triggerOrder == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "trigger order %d not found", req.OrderId)
+	}
+
+	return &exchange.QueryGetTriggerOrderResponse{TriggerOrder: triggerOrder.WithoutHiddenAssets()}, nil
+}
+
+// GetMarketTriggerOrders looks up the trigger orders in a market that have not yet been activated.
+func (k QueryServer) GetMarketTriggerOrders(goCtx context.Context, req *exchange.QueryGetMarketTriggerOrdersRequest) (*exchange.QueryGetMarketTriggerOrdersResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "GetMarketTriggerOrders")
+	if req == nil || req.MarketId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	kvStore := k.getStore(ctx)
+	store := prefix.NewStore(kvStore, GetIndexKeyPrefixMarketToTriggerOrder(req.MarketId))
+	resp := &exchange.QueryGetMarketTriggerOrdersResponse{}
+	var pageErr error
+
+	resp.Pagination, pageErr = query.FilteredPaginate(store, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		// If we can't get the trigger order, just pretend like it doesn't exist.
+		orderID, ok := ParseIndexKeySuffixOrderID(key)
+		if !ok {
+			return false, nil
+		}
+		triggerOrder, err := k.getTriggerOrderFromStore(kvStore, orderID)
+		if err != nil || triggerOrder == nil {
+			return false, nil
+		}
+		if accumulate {
+			resp.TriggerOrders = append(resp.TriggerOrders, *triggerOrder.WithoutHiddenAssets())
+		}
+		return true, nil
+	})
+
+	if pageErr != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error iterating trigger orders for market %d: %v", req.MarketId, pageErr)
+	}
+
+	return resp, nil
+}
+
+// GetOrderLink looks up the order that a given order is linked to.
+func (k QueryServer) GetOrderLink(goCtx context.Context, req *exchange.QueryGetOrderLinkRequest) (*exchange.QueryGetOrderLinkResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "GetOrderLink")
+	if req == nil || req.OrderId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	link, err := k.Keeper.GetOrderLink(ctx, req.OrderId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if link == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "order %d is not linked", req.OrderId)
+	}
+
+	return &exchange.QueryGetOrderLinkResponse{OrderLink: link}, nil
+}
+
+// GetDeadManSwitch looks up an account's dead-man switch.
+func (k QueryServer) GetDeadManSwitch(goCtx context.Context, req *exchange.QueryGetDeadManSwitchRequest) (*exchange.QueryGetDeadManSwitchResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "GetDeadManSwitch")
+	if req == nil || len(req.Account) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid account %q: %v", req.Account, err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	dms, err := k.Keeper.GetDeadManSwitch(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if dms == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "no dead-man switch found for %s", req.Account)
+	}
+
+	return &exchange.QueryGetDeadManSwitchResponse{DeadManSwitch: dms}, nil
+}
+
+// GetMarketOrderLinks looks up all the order links in a market.
+func (k QueryServer) GetMarketOrderLinks(goCtx context.Context, req *exchange.QueryGetMarketOrderLinksRequest) (*exchange.QueryGetMarketOrderLinksResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "GetMarketOrderLinks")
+	if req == nil || req.MarketId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(k.getStore(ctx), GetKeyPrefixMarketOrderLink(req.MarketId))
+	resp := &exchange.QueryGetMarketOrderLinksResponse{}
+	var pageErr error
+
+	resp.Pagination, pageErr = query.FilteredPaginate(store, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		// Each link is stored in both directions, but should only be included once.
+		// If we can't read an entry, just pretend like it doesn't exist.
+		orderID, ok := uint64FromBz(key)
+		if !ok {
+			return false, nil
+		}
+		linkedOrderID, ok := uint64FromBz(value)
+		if !ok || orderID > linkedOrderID {
+			return false, nil
+		}
+		if accumulate {
+			resp.OrderLinks = append(resp.OrderLinks, *exchange.NewOrderLink(req.MarketId, orderID, linkedOrderID))
+		}
+		return true, nil
+	})
+
+	if pageErr != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error iterating order links for market %d: %v", req.MarketId, pageErr)
+	}
+
+	return resp, nil
+}
+
+// OrderBookDepth gets the orders for an asset and price denom pair in a market, aggregated by unit price.
+func (k QueryServer) OrderBookDepth(goCtx context.Context, req *exchange.QueryOrderBookDepthRequest) (*exchange.QueryOrderBookDepthResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "OrderBookDepth")
+	if req == nil || req.MarketId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := sdk.ValidateDenom(req.AssetDenom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid asset denom: %v", err)
+	}
+	if err := sdk.ValidateDenom(req.PriceDenom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid price denom: %v", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	resp := &exchange.QueryOrderBookDepthResponse{}
+	var err error
+	resp.Bids, resp.Asks, err = k.Keeper.GetOrderBookDepth(ctx, req.MarketId, req.AssetDenom, req.PriceDenom, req.Depth)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
+
+// TopOfBook gets the best bid and ask prices for an asset and price denom pair in a market.
+func (k QueryServer) TopOfBook(goCtx context.Context, req *exchange.QueryTopOfBookRequest) (*exchange.QueryTopOfBookResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "TopOfBook")
+	if req == nil || req.MarketId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := sdk.ValidateDenom(req.AssetDenom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid asset denom: %v", err)
+	}
+	if err := sdk.ValidateDenom(req.PriceDenom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid price denom: %v", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	resp := &exchange.QueryTopOfBookResponse{}
+	var err error
+	resp.BestBid, resp.BestAsk, err = k.Keeper.GetTopOfBook(ctx, req.MarketId, req.AssetDenom, req.PriceDenom)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if resp.BestBid != nil && resp.BestAsk != nil {
+		resp.Spread = resp.BestAsk.Price.Amount.Sub(resp.BestBid.Price.Amount).String()
+	}
+
+	return resp, nil
+}
+
+// PriceAverages gets the time-weighted and volume-weighted average prices of recent settlements
+// for an asset and price denom pair in a market.
+func (k QueryServer) PriceAverages(goCtx context.Context, req *exchange.QueryPriceAveragesRequest) (*exchange.QueryPriceAveragesResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "PriceAverages")
+	if req == nil || req.MarketId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := sdk.ValidateDenom(req.AssetDenom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid asset denom: %v", err)
+	}
+	if err := sdk.ValidateDenom(req.PriceDenom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid price denom: %v", err)
+	}
+	maxWindow := uint64(exchange.SettlementPriceMaxAge / time.Second)
+	if req.WindowSeconds == 0 || req.WindowSeconds > maxWindow {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid window %d: must be from 1 to %d seconds", req.WindowSeconds, maxWindow)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	end := ctx.BlockTime()
+	start := end.Add(-time.Duration(req.WindowSeconds) * time.Second) //nolint:gosec // G115: Window is at most 30 days.
+	prior, prices, err := k.Keeper.GetSettlementPrices(ctx, req.MarketId, req.AssetDenom, req.PriceDenom, start, end)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	averages := exchange.CalculatePriceAverages(prior, prices, start, end)
+	resp := &exchange.QueryPriceAveragesResponse{
+		Volume:     sdk.NewCoin(req.AssetDenom, averages.Volume),
+		TotalPrice: sdk.NewCoin(req.PriceDenom, averages.TotalPrice),
+	}
+	if averages.TWAP != nil {
+		resp.Twap = averages.TWAP.String()
+	}
+	if averages.VWAP != nil {
+		resp.Vwap = averages.VWAP.String()
+	}
+
+	return resp, nil
+}
+
+// GetMarketSettlements gets the settlement records of a market.
+func (k QueryServer) GetMarketSettlements(goCtx context.Context, req *exchange.QueryGetMarketSettlementsRequest) (*exchange.QueryGetMarketSettlementsResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "GetMarketSettlements")
+	if req == nil || req.MarketId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(k.getStore(ctx), GetKeyPrefixMarketSettlementRecord(req.MarketId))
+	resp := &exchange.QueryGetMarketSettlementsResponse{}
+	var pageErr error
+
+	resp.Pagination, pageErr = query.FilteredPaginate(store, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		// If we can't get the height from the key, just pretend like it doesn't exist.
+		height, _, ok := ParseKeySuffixSettlementRecord(key)
+		if !ok || height <= req.AfterHeight {
+			return false, nil
+		}
+		record, err := k.parseSettlementRecordStoreValue(value)
+		if err != nil {
+			return false, nil
+		}
+		if accumulate {
+			resp.Settlements = append(resp.Settlements, *record)
+		}
+		return true, nil
+	})
+
+	if pageErr != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error iterating settlements for market %d: %v", req.MarketId, pageErr)
+	}
+
+	return resp, nil
+}
+
+// GetAllSettlements gets all settlement records.
+func (k QueryServer) GetAllSettlements(goCtx context.Context, req *exchange.QueryGetAllSettlementsRequest) (*exchange.QueryGetAllSettlementsResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "GetAllSettlements")
+	var pagination *query.PageRequest
+	if req != nil {
+		pagination = req.Pagination
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(k.getStore(ctx), GetKeyPrefixSettlementRecord())
+	resp := &exchange.QueryGetAllSettlementsResponse{}
+	var pageErr error
+
+	resp.Pagination, pageErr = query.FilteredPaginate(store, pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		// If we can't read the record, just pretend like it doesn't exist.
+		record, err := k.parseSettlementRecordStoreValue(value)
+		if err != nil {
+			return false, nil
+		}
+		if accumulate {
+			resp.Settlements = append(resp.Settlements, *record)
+		}
+		return true, nil
+	})
+
+	if pageErr != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error iterating all settlements: %v", pageErr)
+	}
+
+	return resp, nil
+}
+
+// GetSettlementReceipt gets the receipt of a settlement.
+func (k QueryServer) GetSettlementReceipt(goCtx context.Context, req *exchange.QueryGetSettlementReceiptRequest) (*exchange.QueryGetSettlementReceiptResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "GetSettlementReceipt")
+	if req == nil || req.SettlementId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	receipt, err := k.Keeper.GetSettlementReceipt(ctx, req.SettlementId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if receipt == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "settlement %d receipt not found", req.SettlementId)
+	}
+
+	return &exchange.QueryGetSettlementReceiptResponse{Receipt: receipt}, nil
+}
+
+// GetLatestNAV gets the most recent NAV record of an asset and price denom pair.
+func (k QueryServer) GetLatestNAV(goCtx context.Context, req *exchange.QueryGetLatestNAVRequest) (*exchange.QueryGetLatestNAVResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "GetLatestNAV")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := sdk.ValidateDenom(req.AssetDenom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid asset denom: %v", err)
+	}
+	if err := sdk.ValidateDenom(req.PriceDenom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid price denom: %v", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	record, err := k.Keeper.GetLatestNAVRecord(ctx, req.AssetDenom, req.PriceDenom)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if record == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "no nav records found for %q priced in %q",
+			req.AssetDenom, req.PriceDenom)
+	}
+
+	return &exchange.QueryGetLatestNAVResponse{Nav: record}, nil
+}
+
+// GetNAVHistory gets the NAV records of an asset and price denom pair, optionally limited to a range of heights.
+func (k QueryServer) GetNAVHistory(goCtx context.Context, req *exchange.QueryGetNAVHistoryRequest) (*exchange.QueryGetNAVHistoryResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "GetNAVHistory")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := sdk.ValidateDenom(req.AssetDenom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid asset denom: %v", err)
+	}
+	if err := sdk.ValidateDenom(req.PriceDenom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid price denom: %v", err)
+	}
+	if req.MinHeight < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid min height %d: cannot be negative", req.MinHeight)
+	}
+	if req.MaxHeight < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid max height %d: cannot be negative", req.MaxHeight)
+	}
+	if req.MaxHeight != 0 && req.MaxHeight < req.MinHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "max height %d cannot be less than min height %d",
+			req.MaxHeight, req.MinHeight)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(k.getStore(ctx), GetKeyPrefixNAVRecordDenoms(req.AssetDenom, req.PriceDenom))
+	resp := &exchange.QueryGetNAVHistoryResponse{}
+	var pageErr error
+
+	resp.Pagination, pageErr = query.FilteredPaginate(store, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		height, _, ok := ParseKeySuffixNAVRecord(key)
+		if !ok || height < req.MinHeight || (req.MaxHeight != 0 && height > req.MaxHeight) {
+			return false, nil
+		}
+		// If we can't read the record, just pretend like it doesn't exist.
+		record, err := k.parseNAVRecordStoreValue(value)
+		if err != nil {
+			return false, nil
+		}
+		if accumulate {
+			resp.Navs = append(resp.Navs, *record)
+		}
+		return true, nil
+	})
+
+	if pageErr != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error iterating nav records of %q priced in %q: %v",
+			req.AssetDenom, req.PriceDenom, pageErr)
+	}
+
+	return resp, nil
+}
+
+// SimulateSettlement runs a market settle, fill bids, or fill asks request without committing any of the changes.
+func (k QueryServer) SimulateSettlement(goCtx context.Context, req *exchange.QuerySimulateSettlementRequest) (*exchange.QuerySimulateSettlementResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "SimulateSettlement")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var count int
+	if req.MarketSettleRequest != nil {
+		count++
+	}
+	if req.FillBidsRequest != nil {
+		count++
+	}
+	if req.FillAsksRequest != nil {
+		count++
+	}
+	switch count {
+	case 0:
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	case 1:
+	default:
+		return nil, status.Error(codes.InvalidArgument, "only one request can be simulated at a time")
+	}
+
+	resp := &exchange.QuerySimulateSettlementResponse{}
+
+	// The SDK *should* already be using a cache context for queries, but I'm doing it here too just to be on the safe side.
+	ctx, _ := sdk.UnwrapSDKContext(goCtx).CacheContext()
+	var settlement *exchange.Settlement
+	var creationFee *exchange.AccountAmount
+	var err error
+	switch {
+	case req.MarketSettleRequest != nil:
+		msg := req.MarketSettleRequest
+		err = msg.ValidateBasic()
+		if err == nil && !k.CanSettleOrders(ctx, msg.MarketId, msg.Admin) {
+			err = permError("settle orders for", msg.Admin, msg.MarketId)
+		}
+		if err == nil {
+			settlement, err = k.settleOrders(ctx, msg)
+		}
+	case req.FillBidsRequest != nil:
+		msg := req.FillBidsRequest
+		settlement, err = k.fillBids(ctx, msg)
+		if msg.AskOrderCreationFee != nil {
+			creationFee = &exchange.AccountAmount{Account: msg.Seller, Amount: sdk.Coins{*msg.AskOrderCreationFee}}
+		}
+	case req.FillAsksRequest != nil:
+		msg := req.FillAsksRequest
+		settlement, err = k.fillAsks(ctx, msg)
+		if msg.BidOrderCreationFee != nil {
+			creationFee = &exchange.AccountAmount{Account: msg.Buyer, Amount: sdk.Coins{*msg.BidOrderCreationFee}}
+		}
+	}
+	if err != nil {
+		resp.Error = err.Error()
+		return resp, nil
+	}
+
+	resp.Fills = exchange.NewSettlementFills(settlement)
+	resp.Transfers = make([]exchange.SettlementTransfer, len(settlement.Transfers))
+	for i, transfer := range settlement.Transfers {
+		resp.Transfers[i] = exchange.NewSettlementTransfer(transfer)
+	}
+	resp.FeeInputs = exchange.BankInputsToAccountAmounts(settlement.FeeInputs...)
+	if creationFee != nil {
+		resp.FeeInputs = append(resp.FeeInputs, *creationFee)
+	}
+	resp.Navs = exchange.GetNAVs(settlement)
+
+	return resp, nil
+}
+
 // GetCommitment gets the funds in an account that are committed to the market.
 func (k QueryServer) GetCommitment(goCtx context.Context, req *exchange.QueryGetCommitmentRequest) (*exchange.QueryGetCommitmentResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "GetCommitment")
 	if req == nil || len(req.Account) == 0 || req.MarketId == 0 {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
@@ -239,6 +787,7 @@ func (k QueryServer) GetCommitment(goCtx context.Context, req *exchange.QueryGet
 
 // GetAccountCommitments gets all the funds in an account that are committed to any market.
 func (k QueryServer) GetAccountCommitments(goCtx context.Context, req *exchange.QueryGetAccountCommitmentsRequest) (*exchange.QueryGetAccountCommitmentsResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "GetAccountCommitments")
 	if req == nil || len(req.Account) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
@@ -275,6 +824,7 @@ func (k QueryServer) GetAccountCommitments(goCtx context.Context, req *exchange.
 
 // GetMarketCommitments gets all the funds committed to a market from any account.
 func (k QueryServer) GetMarketCommitments(goCtx context.Context, req *exchange.QueryGetMarketCommitmentsRequest) (*exchange.QueryGetMarketCommitmentsResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "GetMarketCommitments")
 	if req == nil || req.MarketId == 0 {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
@@ -302,6 +852,7 @@ func (k QueryServer) GetMarketCommitments(goCtx context.Context, req *exchange.Q
 
 // GetAllCommitments gets all fund committed to any market from any account.
 func (k QueryServer) GetAllCommitments(goCtx context.Context, req *exchange.QueryGetAllCommitmentsRequest) (*exchange.QueryGetAllCommitmentsResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "GetAllCommitments")
 	var pageReq *query.PageRequest
 	if req != nil {
 		pageReq = req.Pagination
@@ -316,6 +867,7 @@ func (k QueryServer) GetAllCommitments(goCtx context.Context, req *exchange.Quer
 	resp.Pagination, pageErr = query.Paginate(store, pageReq, func(keySuffix []byte, value []byte) error {
 		com, _ := parseCommitmentKeyValue(keyPrefix, keySuffix, value)
 		if com != nil && !com.Amount.IsZero() {
+			com.Expiration = k.GetCommitmentExpiration(ctx, com.MarketId, sdk.MustAccAddressFromBech32(com.Account))
 			resp.Commitments = append(resp.Commitments, com)
 		}
 		return nil
@@ -328,8 +880,42 @@ func (k QueryServer) GetAllCommitments(goCtx context.Context, req *exchange.Quer
 	return resp, nil
 }
 
+// GetCommitmentRewardPool gets the undistributed funds in a market's commitment reward pool.
+func (k QueryServer) GetCommitmentRewardPool(goCtx context.Context, req *exchange.QueryGetCommitmentRewardPoolRequest) (*exchange.QueryGetCommitmentRewardPoolResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "GetCommitmentRewardPool")
+	if req == nil || req.MarketId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	resp := &exchange.QueryGetCommitmentRewardPoolResponse{
+		Amount: k.Keeper.GetCommitmentRewardPool(ctx, req.MarketId),
+	}
+	return resp, nil
+}
+
+// GetAccountCommitmentRewards gets the commitment rewards that an account can claim from each market.
+func (k QueryServer) GetAccountCommitmentRewards(goCtx context.Context, req *exchange.QueryGetAccountCommitmentRewardsRequest) (*exchange.QueryGetAccountCommitmentRewardsResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "GetAccountCommitmentRewards")
+	if req == nil || len(req.Account) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid account %q: %v", req.Account, err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	resp := &exchange.QueryGetAccountCommitmentRewardsResponse{}
+	for _, reward := range k.Keeper.GetAccountCommitmentRewards(ctx, addr) {
+		resp.Rewards = append(resp.Rewards, &exchange.MarketAmount{MarketId: reward.MarketId, Amount: reward.Amount})
+	}
+	return resp, nil
+}
+
 // GetMarket returns all the information and details about a market.
 func (k QueryServer) GetMarket(goCtx context.Context, req *exchange.QueryGetMarketRequest) (*exchange.QueryGetMarketResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "GetMarket")
 	if req == nil || req.MarketId == 0 {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
@@ -348,8 +934,102 @@ func (k QueryServer) GetMarket(goCtx context.Context, req *exchange.QueryGetMark
 	return resp, nil
 }
 
+// GetScheduledFeeChanges returns the fee changes that are waiting to be applied to a market.
+func (k QueryServer) GetScheduledFeeChanges(goCtx context.Context, req *exchange.QueryGetScheduledFeeChangesRequest) (*exchange.QueryGetScheduledFeeChangesResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "GetScheduledFeeChanges")
+	if req == nil || req.MarketId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	changes, err := k.Keeper.GetScheduledFeeChanges(ctx, req.MarketId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &exchange.QueryGetScheduledFeeChangesResponse{ScheduledFeeChanges: changes}, nil
+}
+
+// GetMarketAuthorityTransfer returns the authority transfer waiting to be accepted for a market.
+func (k QueryServer) GetMarketAuthorityTransfer(goCtx context.Context, req *exchange.QueryGetMarketAuthorityTransferRequest) (*exchange.QueryGetMarketAuthorityTransferResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "GetMarketAuthorityTransfer")
+	if req == nil || req.MarketId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	transfer, err := k.Keeper.GetAuthorityTransfer(ctx, req.MarketId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if transfer == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "market %d does not have a pending authority transfer", req.MarketId)
+	}
+
+	return &exchange.QueryGetMarketAuthorityTransferResponse{AuthorityTransfer: transfer}, nil
+}
+
+// ExportMarket returns a snapshot of a market's setup, fees, orders, and commitments.
+func (k QueryServer) ExportMarket(goCtx context.Context, req *exchange.QueryExportMarketRequest) (*exchange.QueryExportMarketResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "ExportMarket")
+	if req == nil || req.MarketId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	market := k.Keeper.GetMarket(ctx, req.MarketId)
+	if market == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "market %d not found", req.MarketId)
+	}
+
+	resp := &exchange.QueryExportMarketResponse{
+		Height:  ctx.BlockHeight(),
+		Address: exchange.GetMarketAddress(req.MarketId).String(),
+		Market:  market,
+	}
+
+	var errs []error
+	var err error
+	resp.ScheduledFeeChanges, err = k.Keeper.GetScheduledFeeChanges(ctx, req.MarketId)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	k.IterateMarketOrders(ctx, req.MarketId, func(orderID uint64, _ byte) bool {
+		order, oerr := k.Keeper.GetOrder(ctx, orderID)
+		if oerr != nil {
+			errs = append(errs, oerr)
+			return false
+		}
+		if order != nil {
+			resp.Orders = append(resp.Orders, order)
+		}
+		return false
+	})
+
+	keyPrefix := GetKeyPrefixCommitmentsToMarket(req.MarketId)
+	k.iterate(ctx, keyPrefix, func(keySuffix, value []byte) bool {
+		com, cerr := parseCommitmentKeyValue(keyPrefix, keySuffix, value)
+		if cerr != nil {
+			errs = append(errs, fmt.Errorf("failed to read commitment with key suffix %x: %w", keySuffix, cerr))
+			return false
+		}
+		if !com.Amount.IsZero() {
+			resp.Commitments = append(resp.Commitments, &exchange.AccountAmount{Account: com.Account, Amount: com.Amount})
+		}
+		return false
+	})
+
+	if len(errs) > 0 {
+		return nil, status.Errorf(codes.Internal, "error exporting market %d: %v", req.MarketId, errors.Join(errs...))
+	}
+
+	return resp, nil
+}
+
 // GetAllMarkets returns brief information about each market.
 func (k QueryServer) GetAllMarkets(goCtx context.Context, req *exchange.QueryGetAllMarketsRequest) (*exchange.QueryGetAllMarketsResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "GetAllMarkets")
 	var pagination *query.PageRequest
 	if req != nil {
 		pagination = req.Pagination
@@ -387,6 +1067,7 @@ func (k QueryServer) GetAllMarkets(goCtx context.Context, req *exchange.QueryGet
 
 // Params returns the exchange module parameters.
 func (k QueryServer) Params(goCtx context.Context, _ *exchange.QueryParamsRequest) (*exchange.QueryParamsResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "Params")
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	resp := &exchange.QueryParamsResponse{Params: k.GetParamsOrDefaults(ctx)}
 	return resp, nil
@@ -394,6 +1075,7 @@ func (k QueryServer) Params(goCtx context.Context, _ *exchange.QueryParamsReques
 
 // CommitmentSettlementFeeCalc calculates the fees a market will pay for a commitment settlement using current NAVs.
 func (k QueryServer) CommitmentSettlementFeeCalc(goCtx context.Context, req *exchange.QueryCommitmentSettlementFeeCalcRequest) (*exchange.QueryCommitmentSettlementFeeCalcResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "CommitmentSettlementFeeCalc")
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
@@ -413,6 +1095,7 @@ func (k QueryServer) CommitmentSettlementFeeCalc(goCtx context.Context, req *exc
 
 // ValidateCreateMarket checks the provided MsgGovCreateMarketResponse and returns any errors it might have.
 func (k QueryServer) ValidateCreateMarket(goCtx context.Context, req *exchange.QueryValidateCreateMarketRequest) (*exchange.QueryValidateCreateMarketResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "ValidateCreateMarket")
 	if req == nil || req.CreateMarketRequest == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
@@ -461,6 +1144,7 @@ func (k QueryServer) ValidateCreateMarket(goCtx context.Context, req *exchange.Q
 
 // ValidateMarket checks for any problems with a market's setup.
 func (k QueryServer) ValidateMarket(goCtx context.Context, req *exchange.QueryValidateMarketRequest) (*exchange.QueryValidateMarketResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "ValidateMarket")
 	if req == nil || req.MarketId == 0 {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
@@ -476,6 +1160,7 @@ func (k QueryServer) ValidateMarket(goCtx context.Context, req *exchange.QueryVa
 
 // ValidateManageFees checks the provided MsgGovManageFeesRequest and returns any errors that it might have.
 func (k QueryServer) ValidateManageFees(goCtx context.Context, req *exchange.QueryValidateManageFeesRequest) (*exchange.QueryValidateManageFeesResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "ValidateManageFees")
 	if req == nil || req.ManageFeesRequest == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
@@ -534,6 +1219,24 @@ func (k QueryServer) ValidateManageFees(goCtx context.Context, req *exchange.Que
 			sellerRatios, msg.AddFeeSellerSettlementRatios, msg.RemoveFeeSellerSettlementRatios)...)
 	}
 
+	if len(msg.AddFeeSellerSettlementTakerRatios) > 0 || len(msg.RemoveFeeSellerSettlementTakerRatios) > 0 {
+		takerRatios := getSellerSettlementTakerRatios(store, msg.MarketId)
+		errs = append(errs, exchange.ValidateAddRemoveFeeRatiosWithExisting("seller settlement taker",
+			takerRatios, msg.AddFeeSellerSettlementTakerRatios, msg.RemoveFeeSellerSettlementTakerRatios)...)
+	}
+
+	if len(msg.AddFeeSellerSettlementRebateRatios) > 0 || len(msg.RemoveFeeSellerSettlementRebateRatios) > 0 {
+		rebateRatios := getSellerSettlementRebateRatios(store, msg.MarketId)
+		errs = append(errs, exchange.ValidateAddRemoveFeeRatiosWithExisting("seller settlement rebate",
+			rebateRatios, msg.AddFeeSellerSettlementRebateRatios, msg.RemoveFeeSellerSettlementRebateRatios)...)
+	}
+
+	if len(msg.AddRebateAddresses) > 0 || len(msg.RemoveRebateAddresses) > 0 {
+		rebateAddrs := getRebateAddresses(store, msg.MarketId)
+		errs = append(errs, exchange.ValidateAddRemoveRebateAddressesWithExisting(
+			rebateAddrs, msg.AddRebateAddresses, msg.RemoveRebateAddresses)...)
+	}
+
 	if len(msg.AddFeeBuyerSettlementFlat) > 0 || len(msg.RemoveFeeBuyerSettlementFlat) > 0 {
 		buyerFlats := getBuyerSettlementFlatFees(store, msg.MarketId)
 		errs = append(errs, exchange.ValidateAddRemoveFeeOptionsWithExisting("buyer settlement",
@@ -546,6 +1249,12 @@ func (k QueryServer) ValidateManageFees(goCtx context.Context, req *exchange.Que
 			buyerRatios, msg.AddFeeBuyerSettlementRatios, msg.RemoveFeeBuyerSettlementRatios)...)
 	}
 
+	if len(msg.AddReferralCap) > 0 || len(msg.RemoveReferralCap) > 0 {
+		referralCap := getReferralCap(store, msg.MarketId)
+		errs = append(errs, exchange.ValidateAddRemoveFeeOptionsWithExisting("referral cap",
+			referralCap, msg.AddReferralCap, msg.RemoveReferralCap)...)
+	}
+
 	k.UpdateFees(ctx, msg)
 	if err := k.Keeper.ValidateMarket(ctx, msg.MarketId); err != nil {
 		errs = append(errs, err)
@@ -560,6 +1269,7 @@ func (k QueryServer) ValidateManageFees(goCtx context.Context, req *exchange.Que
 
 // GetPayment gets a single specific payment.
 func (k QueryServer) GetPayment(goCtx context.Context, req *exchange.QueryGetPaymentRequest) (*exchange.QueryGetPaymentResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "GetPayment")
 	if req == nil || len(req.Source) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
@@ -585,6 +1295,7 @@ func (k QueryServer) GetPayment(goCtx context.Context, req *exchange.QueryGetPay
 
 // GetPaymentsWithSource gets all payments with a specific source account.
 func (k QueryServer) GetPaymentsWithSource(goCtx context.Context, req *exchange.QueryGetPaymentsWithSourceRequest) (*exchange.QueryGetPaymentsWithSourceResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "GetPaymentsWithSource")
 	if req == nil || len(req.Source) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
@@ -594,11 +1305,22 @@ func (k QueryServer) GetPaymentsWithSource(goCtx context.Context, req *exchange.
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	keyPrefix := GetKeyPrefixPaymentsForSource(source)
-	preStore := prefix.NewStore(k.getStore(ctx), keyPrefix)
-
 	resp := &exchange.QueryGetPaymentsWithSourceResponse{}
 	var pageErr error
+	if !req.Filter.IsEmpty() {
+		if err = req.Filter.Validate(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+		}
+		resp.Payments, resp.Pagination, pageErr = k.getFilteredPayments(ctx, "GetPaymentsWithSource",
+			source, PaymentRoleSource, *req.Filter, req.Pagination)
+		if pageErr != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "error iterating payments with source %s: %v", req.Source, pageErr)
+		}
+		return resp, nil
+	}
+
+	keyPrefix := GetKeyPrefixPaymentsForSource(source)
+	preStore := prefix.NewStore(k.getStore(ctx), keyPrefix)
 	resp.Pagination, pageErr = query.Paginate(preStore, req.Pagination, func(keySuffix, value []byte) error {
 		// Only add it to the result if we can read it. This might result in fewer results than the limit,
 		// but at least one bad entry won't block others by causing the whole thing to return an error.
@@ -628,6 +1350,7 @@ func (k QueryServer) GetPaymentsWithSource(goCtx context.Context, req *exchange.
 
 // GetPaymentsWithTarget gets all payments with a specific target account.
 func (k QueryServer) GetPaymentsWithTarget(goCtx context.Context, req *exchange.QueryGetPaymentsWithTargetRequest) (*exchange.QueryGetPaymentsWithTargetResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "GetPaymentsWithTarget")
 	if req == nil || len(req.Target) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
@@ -637,12 +1360,23 @@ func (k QueryServer) GetPaymentsWithTarget(goCtx context.Context, req *exchange.
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	resp := &exchange.QueryGetPaymentsWithTargetResponse{}
+	var pageErr error
+	if !req.Filter.IsEmpty() {
+		if err = req.Filter.Validate(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+		}
+		resp.Payments, resp.Pagination, pageErr = k.getFilteredPayments(ctx, "GetPaymentsWithTarget",
+			target, PaymentRoleTarget, *req.Filter, req.Pagination)
+		if pageErr != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "error iterating payments with target %s: %v", req.Target, pageErr)
+		}
+		return resp, nil
+	}
+
 	keyPrefix := GetIndexKeyPrefixTargetToPayments(target)
 	store := k.getStore(ctx)
 	preStore := prefix.NewStore(store, keyPrefix)
-
-	resp := &exchange.QueryGetPaymentsWithTargetResponse{}
-	var pageErr error
 	resp.Pagination, pageErr = query.Paginate(preStore, req.Pagination, func(keySuffix, _ []byte) error {
 		// Only add it to the result if we can read it. This might result in fewer results than the limit,
 		// but at least one bad entry won't block others by causing the whole thing to return an error.
@@ -678,8 +1412,77 @@ func (k QueryServer) GetPaymentsWithTarget(goCtx context.Context, req *exchange.
 	return resp, nil
 }
 
+// getFilteredPayments gets a page of the payments where the account has the provided role and that match the filter.
+// The denom filter uses the account denom to payment index, otherwise the account target amount to payment index is used.
+// The filter must be valid and not empty.
+func (k QueryServer) getFilteredPayments(ctx sdk.Context, endpoint string, account sdk.AccAddress, role byte,
+	filter exchange.PaymentFilter, pageReq *query.PageRequest,
+) ([]*exchange.Payment, *query.PageResponse, error) {
+	var keyPrefix []byte
+	var parseSuffix func(keySuffix []byte) (sdk.AccAddress, string, bool)
+	if len(filter.Denom) > 0 {
+		keyPrefix = GetIndexKeyPrefixAccountDenomToPayments(account, role, filter.Denom)
+		minAmount, maxAmount, _ := filter.GetAmountLimits()
+		parseSuffix = func(keySuffix []byte) (sdk.AccAddress, string, bool) {
+			amount, source, externalID, err := ParseIndexKeySuffixAccountDenomToPayment(keySuffix)
+			if err != nil {
+				k.logEndpointError(ctx, endpoint, "Error reading account denom to payment index entry.",
+					"error", err, "account", account.String(),
+					"keyPrefix", fmt.Sprintf("%v", keyPrefix), "keySuffix", fmt.Sprintf("%v", keySuffix))
+				return nil, "", false
+			}
+			// The amount is in the key, so payments outside the range are skipped without being read.
+			if (minAmount != nil && amount.LT(*minAmount)) || (maxAmount != nil && amount.GT(*maxAmount)) {
+				return nil, "", false
+			}
+			return source, externalID, true
+		}
+	} else {
+		keyPrefix = GetIndexKeyPrefixAccountTargetAmountToPayments(account, role, filter.TargetAmount == exchange.TargetAmountFilter_present)
+		parseSuffix = func(keySuffix []byte) (sdk.AccAddress, string, bool) {
+			source, externalID, err := ParseIndexKeySuffixAccountTargetAmountToPayment(keySuffix)
+			if err != nil {
+				k.logEndpointError(ctx, endpoint, "Error reading account target amount to payment index entry.",
+					"error", err, "account", account.String(),
+					"keyPrefix", fmt.Sprintf("%v", keyPrefix), "keySuffix", fmt.Sprintf("%v", keySuffix))
+				return nil, "", false
+			}
+			return source, externalID, true
+		}
+	}
+
+	store := k.getStore(ctx)
+	preStore := prefix.NewStore(store, keyPrefix)
+	var payments []*exchange.Payment
+	pageResp, err := query.FilteredPaginate(preStore, pageReq, func(keySuffix, _ []byte, accumulate bool) (bool, error) {
+		// Only include entries that we can read. This might result in fewer results than the limit,
+		// but at least one bad entry won't block others by causing the whole thing to return an error.
+		source, externalID, ok := parseSuffix(keySuffix)
+		if !ok {
+			return false, nil
+		}
+
+		payment, pErr := k.getPaymentFromStore(store, source, externalID)
+		if pErr != nil || payment == nil {
+			k.logEndpointError(ctx, endpoint, "Error reading payment from payment filter index entry.", "error", pErr,
+				"account", account.String(), "source", source.String(), "externalID", externalID)
+			return false, nil
+		}
+		if !filter.Matches(payment) {
+			return false, nil
+		}
+
+		if accumulate {
+			payments = append(payments, payment)
+		}
+		return true, nil
+	})
+	return payments, pageResp, err
+}
+
 // GetAllPayments gets all payments.
 func (k QueryServer) GetAllPayments(goCtx context.Context, req *exchange.QueryGetAllPaymentsRequest) (*exchange.QueryGetAllPaymentsResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "GetAllPayments")
 	var pagination *query.PageRequest
 	if req != nil {
 		pagination = req.Pagination
@@ -718,6 +1521,7 @@ func (k QueryServer) GetAllPayments(goCtx context.Context, req *exchange.QueryGe
 
 // PaymentFeeCalc calculates the fees that must be paid for creating or accepting a specific payment.
 func (k QueryServer) PaymentFeeCalc(goCtx context.Context, req *exchange.QueryPaymentFeeCalcRequest) (*exchange.QueryPaymentFeeCalcResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "PaymentFeeCalc")
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
@@ -726,3 +1530,20 @@ func (k QueryServer) PaymentFeeCalc(goCtx context.Context, req *exchange.QueryPa
 	resp := k.CalculatePaymentFees(ctx, &req.Payment)
 	return resp, nil
 }
+
+// GetAccountActivitySummary gets the totals of an account's open orders, commitments, and pending payments,
+// as well as the funds the exchange has on hold for them.
+func (k QueryServer) GetAccountActivitySummary(goCtx context.Context, req *exchange.QueryGetAccountActivitySummaryRequest) (*exchange.QueryGetAccountActivitySummaryResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "GetAccountActivitySummary")
+	if req == nil || len(req.Account) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid account %q: %v", req.Account, err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	resp := k.Keeper.GetAccountActivitySummary(ctx, addr)
+	return resp, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -119,6 +120,8 @@ func (s *TestSuite) TestQueryServer_OrderFeeCalc() {
 				"SettlementFlatFeeOptions (as strings)")
 			s.Assert().Equal(s.coinsString(expected.SettlementRatioFeeOptions), s.coinsString(actual.SettlementRatioFeeOptions),
 				"SettlementRatioFeeOptions (as strings)")
+			s.Assert().Equal(s.coinsString(expected.SettlementTakerRatioFeeOptions), s.coinsString(actual.SettlementTakerRatioFeeOptions),
+				"SettlementTakerRatioFeeOptions (as strings)")
 		},
 	}
 
@@ -247,7 +250,8 @@ func (s *TestSuite) TestQueryServer_OrderFeeCalc() {
 				Assets: s.coin("1apple"), Price: s.coin("2000plum"), MarketId: 8,
 			}},
 			expResp: &exchange.QueryOrderFeeCalcResponse{
-				SettlementRatioFeeOptions: s.coins("12plum"),
+				SettlementRatioFeeOptions:      s.coins("12plum"),
+				SettlementTakerRatioFeeOptions: s.coins("12plum"),
 			},
 		},
 		{
@@ -263,8 +267,9 @@ func (s *TestSuite) TestQueryServer_OrderFeeCalc() {
 				Assets: s.coin("1apple"), Price: s.coin("2000plum"), MarketId: 8,
 			}},
 			expResp: &exchange.QueryOrderFeeCalcResponse{
-				SettlementFlatFeeOptions:  s.coins("23fig,6grape,15pineapple"),
-				SettlementRatioFeeOptions: s.coins("12plum"),
+				SettlementFlatFeeOptions:       s.coins("23fig,6grape,15pineapple"),
+				SettlementRatioFeeOptions:      s.coins("12plum"),
+				SettlementTakerRatioFeeOptions: s.coins("12plum"),
 			},
 		},
 		{
@@ -281,9 +286,44 @@ func (s *TestSuite) TestQueryServer_OrderFeeCalc() {
 				Assets: s.coin("1apple"), Price: s.coin("2000plum"), MarketId: 1,
 			}},
 			expResp: &exchange.QueryOrderFeeCalcResponse{
-				CreationFeeOptions:        s.coins("3fig,52grape,1honeydew"),
-				SettlementFlatFeeOptions:  s.coins("23fig,6grape,15pineapple"),
-				SettlementRatioFeeOptions: s.coins("12plum"),
+				CreationFeeOptions:             s.coins("3fig,52grape,1honeydew"),
+				SettlementFlatFeeOptions:       s.coins("23fig,6grape,15pineapple"),
+				SettlementRatioFeeOptions:      s.coins("12plum"),
+				SettlementTakerRatioFeeOptions: s.coins("12plum"),
+			},
+		},
+		{
+			name: "ask: maker and taker ratios",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId:                       8,
+					FeeSellerSettlementRatios:      s.ratios("500plum:3plum"),
+					FeeSellerSettlementTakerRatios: s.ratios("500plum:5plum"),
+				})
+			},
+			req: &exchange.QueryOrderFeeCalcRequest{AskOrder: &exchange.AskOrder{
+				Assets: s.coin("1apple"), Price: s.coin("2000plum"), MarketId: 8,
+			}},
+			expResp: &exchange.QueryOrderFeeCalcResponse{
+				SettlementRatioFeeOptions:      s.coins("12plum"),
+				SettlementTakerRatioFeeOptions: s.coins("20plum"),
+			},
+		},
+		{
+			name: "ask: taker ratio for other denom",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId:                       8,
+					FeeSellerSettlementRatios:      s.ratios("500plum:3plum,100peach:1peach"),
+					FeeSellerSettlementTakerRatios: s.ratios("100peach:2peach"),
+				})
+			},
+			req: &exchange.QueryOrderFeeCalcRequest{AskOrder: &exchange.AskOrder{
+				Assets: s.coin("1apple"), Price: s.coin("2000plum"), MarketId: 8,
+			}},
+			expResp: &exchange.QueryOrderFeeCalcResponse{
+				SettlementRatioFeeOptions:      s.coins("12plum"),
+				SettlementTakerRatioFeeOptions: s.coins("12plum"),
 			},
 		},
 
@@ -570,6 +610,56 @@ func (s *TestSuite) TestQueryServer_GetOrder() {
 				ExternalId:          "ask-order-1-id",
 			})},
 		},
+		{
+			name: "order 2: iceberg ask",
+			setup: func() {
+				store := s.getStore()
+				s.requireSetOrderInStore(store, exchange.NewOrder(2).WithAsk(&exchange.AskOrder{
+					MarketId:                1,
+					Seller:                  s.addr1.String(),
+					Assets:                  s.coin("20apple"),
+					Price:                   s.coin("30pineapple"),
+					SellerSettlementFlatFee: s.coinP("15fig"),
+					AllowPartial:            true,
+					DisplayAssets:           s.coinP("5apple"),
+				}))
+			},
+			req: &exchange.QueryGetOrderRequest{OrderId: 2},
+			expResp: &exchange.QueryGetOrderResponse{Order: exchange.NewOrder(2).WithAsk(&exchange.AskOrder{
+				MarketId:                1,
+				Seller:                  s.addr1.String(),
+				Assets:                  s.coin("5apple"),
+				Price:                   s.coin("7pineapple"),
+				SellerSettlementFlatFee: s.coinP("3fig"),
+				AllowPartial:            true,
+				DisplayAssets:           s.coinP("5apple"),
+			})},
+		},
+		{
+			name: "order 2: iceberg bid",
+			setup: func() {
+				store := s.getStore()
+				s.requireSetOrderInStore(store, exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+					MarketId:            1,
+					Buyer:               s.addr1.String(),
+					Assets:              s.coin("20apple"),
+					Price:               s.coin("30pineapple"),
+					BuyerSettlementFees: s.coins("15fig,10grape"),
+					AllowPartial:        true,
+					DisplayAssets:       s.coinP("5apple"),
+				}))
+			},
+			req: &exchange.QueryGetOrderRequest{OrderId: 2},
+			expResp: &exchange.QueryGetOrderResponse{Order: exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+				MarketId:            1,
+				Buyer:               s.addr1.String(),
+				Assets:              s.coin("5apple"),
+				Price:               s.coin("7pineapple"),
+				BuyerSettlementFees: s.coins("3fig,2grape"),
+				AllowPartial:        true,
+				DisplayAssets:       s.coinP("5apple"),
+			})},
+		},
 		{
 			name: "order 5555",
 			setup: func() {
@@ -797,6 +887,207 @@ func (s *TestSuite) TestQueryServer_GetOrderByExternalID() {
 	}
 }
 
+func (s *TestSuite) TestQueryServer_GetOrdersByExternalIDPrefix() {
+	testDef := queryTestDef[exchange.QueryGetOrdersByExternalIDPrefixRequest, exchange.QueryGetOrdersByExternalIDPrefixResponse]{
+		queryName: "GetOrdersByExternalIDPrefix",
+		query:     keeper.NewQueryServer(s.k).GetOrdersByExternalIDPrefix,
+		followup: func(expected, actual *exchange.QueryGetOrdersByExternalIDPrefixResponse) {
+			s.assertEqualOrders(expected.Orders, actual.Orders, "Orders")
+			s.assertEqualPageResponse(expected.Pagination, actual.Pagination, "Pagination")
+		},
+	}
+
+	order1 := exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+		MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("1plum"),
+		ExternalId: "acme-2024-001",
+	})
+	order2 := exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+		MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("2apple"), Price: s.coin("2plum"),
+		ExternalId: "acme-2024-002",
+	})
+	order3 := exchange.NewOrder(3).WithAsk(&exchange.AskOrder{
+		MarketId: 1, Seller: s.addr3.String(), Assets: s.coin("3apple"), Price: s.coin("3plum"),
+		ExternalId: "acme-2023-001",
+	})
+	order4 := exchange.NewOrder(4).WithBid(&exchange.BidOrder{
+		MarketId: 1, Buyer: s.addr4.String(), Assets: s.coin("4apple"), Price: s.coin("4plum"),
+		ExternalId: "other-1",
+	})
+	order5 := exchange.NewOrder(5).WithAsk(&exchange.AskOrder{
+		MarketId: 2, Seller: s.addr5.String(), Assets: s.coin("5apple"), Price: s.coin("5plum"),
+		ExternalId: "acme-2024-003",
+	})
+	order6 := exchange.NewOrder(6).WithBid(&exchange.BidOrder{
+		MarketId: 1, Buyer: s.addr1.String(), Assets: s.coin("6apple"), Price: s.coin("6plum"),
+	})
+	defaultSetup := func() {
+		store := s.getStore()
+		for _, order := range []*exchange.Order{order1, order2, order3, order4, order5, order6} {
+			s.requireSetOrderInStore(store, order)
+		}
+	}
+
+	tests := []queryTestCase[exchange.QueryGetOrdersByExternalIDPrefixRequest, exchange.QueryGetOrdersByExternalIDPrefixResponse]{
+		{
+			name:     "nil request",
+			req:      nil,
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:     "market 0",
+			req:      &exchange.QueryGetOrdersByExternalIDPrefixRequest{MarketId: 0, ExternalIdPrefix: "acme"},
+			expInErr: []string{invalidArgErr, "invalid request"},
+		},
+		{
+			name:     "no external id prefix",
+			req:      &exchange.QueryGetOrdersByExternalIDPrefixRequest{MarketId: 1, ExternalIdPrefix: ""},
+			expInErr: []string{invalidArgErr, "invalid request"},
+		},
+		{
+			name: "external id prefix too long",
+			req: &exchange.QueryGetOrdersByExternalIDPrefixRequest{
+				MarketId: 1, ExternalIdPrefix: strings.Repeat("p", exchange.MaxExternalIDLength+1),
+			},
+			expInErr: []string{invalidArgErr, fmt.Sprintf("invalid external id prefix (length %d): max length %d",
+				exchange.MaxExternalIDLength+1, exchange.MaxExternalIDLength)},
+		},
+		{
+			name: "both offset and key provided",
+			req: &exchange.QueryGetOrdersByExternalIDPrefixRequest{
+				MarketId: 1, ExternalIdPrefix: "acme",
+				Pagination: &query.PageRequest{Offset: 1, Key: []byte("-2024-002")},
+			},
+			expInErr: []string{invalidArgErr, "error iterating orders in market 1 with external id prefix \"acme\"",
+				"invalid request, either offset or key is expected, got both"},
+		},
+		{
+			name:    "no orders",
+			req:     &exchange.QueryGetOrdersByExternalIDPrefixRequest{MarketId: 1, ExternalIdPrefix: "acme"},
+			expResp: &exchange.QueryGetOrdersByExternalIDPrefixResponse{Pagination: &query.PageResponse{}},
+		},
+		{
+			name:    "no matching orders",
+			setup:   defaultSetup,
+			req:     &exchange.QueryGetOrdersByExternalIDPrefixRequest{MarketId: 1, ExternalIdPrefix: "acme-2025"},
+			expResp: &exchange.QueryGetOrdersByExternalIDPrefixResponse{Pagination: &query.PageResponse{}},
+		},
+		{
+			name:    "prefix matches orders in another market only",
+			setup:   defaultSetup,
+			req:     &exchange.QueryGetOrdersByExternalIDPrefixRequest{MarketId: 3, ExternalIdPrefix: "acme"},
+			expResp: &exchange.QueryGetOrdersByExternalIDPrefixResponse{Pagination: &query.PageResponse{}},
+		},
+		{
+			name:  "all acme orders in market 1",
+			setup: defaultSetup,
+			req:   &exchange.QueryGetOrdersByExternalIDPrefixRequest{MarketId: 1, ExternalIdPrefix: "acme-"},
+			expResp: &exchange.QueryGetOrdersByExternalIDPrefixResponse{
+				Orders:     []*exchange.Order{order3, order1, order2},
+				Pagination: &query.PageResponse{Total: 3},
+			},
+		},
+		{
+			name:  "acme 2024 orders in market 1",
+			setup: defaultSetup,
+			req:   &exchange.QueryGetOrdersByExternalIDPrefixRequest{MarketId: 1, ExternalIdPrefix: "acme-2024-"},
+			expResp: &exchange.QueryGetOrdersByExternalIDPrefixResponse{
+				Orders:     []*exchange.Order{order1, order2},
+				Pagination: &query.PageResponse{Total: 2},
+			},
+		},
+		{
+			name:  "acme 2024 orders in market 2",
+			setup: defaultSetup,
+			req:   &exchange.QueryGetOrdersByExternalIDPrefixRequest{MarketId: 2, ExternalIdPrefix: "acme-2024-"},
+			expResp: &exchange.QueryGetOrdersByExternalIDPrefixResponse{
+				Orders:     []*exchange.Order{order5},
+				Pagination: &query.PageResponse{Total: 1},
+			},
+		},
+		{
+			name:  "full external id as prefix",
+			setup: defaultSetup,
+			req:   &exchange.QueryGetOrdersByExternalIDPrefixRequest{MarketId: 1, ExternalIdPrefix: "other-1"},
+			expResp: &exchange.QueryGetOrdersByExternalIDPrefixResponse{
+				Orders:     []*exchange.Order{order4},
+				Pagination: &query.PageResponse{Total: 1},
+			},
+		},
+		{
+			name:  "limit with offset",
+			setup: defaultSetup,
+			req: &exchange.QueryGetOrdersByExternalIDPrefixRequest{
+				MarketId: 1, ExternalIdPrefix: "acme",
+				Pagination: &query.PageRequest{Limit: 1, Offset: 1},
+			},
+			expResp: &exchange.QueryGetOrdersByExternalIDPrefixResponse{
+				Orders:     []*exchange.Order{order1},
+				Pagination: &query.PageResponse{NextKey: []byte("-2024-002")},
+			},
+		},
+		{
+			name:  "limit with key",
+			setup: defaultSetup,
+			req: &exchange.QueryGetOrdersByExternalIDPrefixRequest{
+				MarketId: 1, ExternalIdPrefix: "acme",
+				Pagination: &query.PageRequest{Limit: 5, Key: []byte("-2024-002")},
+			},
+			expResp: &exchange.QueryGetOrdersByExternalIDPrefixResponse{
+				Orders:     []*exchange.Order{order2},
+				Pagination: &query.PageResponse{},
+			},
+		},
+		{
+			name:  "reversed",
+			setup: defaultSetup,
+			req: &exchange.QueryGetOrdersByExternalIDPrefixRequest{
+				MarketId: 1, ExternalIdPrefix: "acme",
+				Pagination: &query.PageRequest{Reverse: true, CountTotal: true},
+			},
+			expResp: &exchange.QueryGetOrdersByExternalIDPrefixResponse{
+				Orders:     []*exchange.Order{order2, order1, order3},
+				Pagination: &query.PageResponse{Total: 3},
+			},
+		},
+		{
+			name: "bad index entry and index entry to order that does not exist",
+			setup: func() {
+				defaultSetup()
+				store := s.getStore()
+				store.Set(keeper.MakeIndexKeyMarketExternalIDToOrder(1, "acme-2024-0015"), []byte{1, 2, 3})
+				store.Set(keeper.MakeIndexKeyMarketExternalIDToOrder(1, "acme-2024-0016"), keeper.Uint64Bz(99))
+			},
+			req: &exchange.QueryGetOrdersByExternalIDPrefixRequest{MarketId: 1, ExternalIdPrefix: "acme-2024"},
+			expResp: &exchange.QueryGetOrdersByExternalIDPrefixResponse{
+				Orders:     []*exchange.Order{order1, order2},
+				Pagination: &query.PageResponse{Total: 3},
+			},
+		},
+		{
+			name: "error reading an order",
+			setup: func() {
+				defaultSetup()
+				store := s.getStore()
+				key1, value1, err := s.k.GetOrderStoreKeyValue(*order1)
+				s.Require().NoError(err, "GetOrderStoreKeyValue 1")
+				value1[0] = 9
+				store.Set(key1, value1)
+			},
+			req: &exchange.QueryGetOrdersByExternalIDPrefixRequest{MarketId: 1, ExternalIdPrefix: "acme-2024"},
+			expResp: &exchange.QueryGetOrdersByExternalIDPrefixResponse{
+				Orders:     []*exchange.Order{order2},
+				Pagination: &query.PageResponse{Total: 2},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runQueryTestCase(s, testDef, tc)
+		})
+	}
+}
+
 func (s *TestSuite) TestQueryServer_GetMarketOrders() {
 	testDef := queryTestDef[exchange.QueryGetMarketOrdersRequest, exchange.QueryGetMarketOrdersResponse]{
 		queryName: "GetMarketOrders",
@@ -849,6 +1140,39 @@ func (s *TestSuite) TestQueryServer_GetMarketOrders() {
 		s.requireSetOrderInStore(mainStore, order)
 	}
 
+	// Orders in market 8 with different unit prices for the tests that order by price.
+	priceAsk201 := exchange.NewOrder(201).WithAsk(&exchange.AskOrder{
+		MarketId: 8, Seller: s.addr1.String(), Assets: s.coin("10apple"), Price: s.coin("30plum"),
+	})
+	priceAsk202 := exchange.NewOrder(202).WithAsk(&exchange.AskOrder{
+		MarketId: 8, Seller: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("10plum"),
+	})
+	priceBid203 := exchange.NewOrder(203).WithBid(&exchange.BidOrder{
+		MarketId: 8, Buyer: s.addr3.String(), Assets: s.coin("10apple"), Price: s.coin("20plum"),
+	})
+	priceBid204 := exchange.NewOrder(204).WithBid(&exchange.BidOrder{
+		MarketId: 8, Buyer: s.addr4.String(), Assets: s.coin("5apple"), Price: s.coin("5plum"),
+	})
+	priceAsk205 := exchange.NewOrder(205).WithAsk(&exchange.AskOrder{
+		MarketId: 8, Seller: s.addr5.String(), Assets: s.coin("4apple"), Price: s.coin("8plum"),
+	})
+	priceAsk206 := exchange.NewOrder(206).WithAsk(&exchange.AskOrder{
+		MarketId: 8, Seller: s.addr1.String(), Assets: s.coin("10apple"), Price: s.coin("10prune"),
+	})
+	priceBid207 := exchange.NewOrder(207).WithBid(&exchange.BidOrder{
+		MarketId: 8, Buyer: s.addr2.String(), Assets: s.coin("10acorn"), Price: s.coin("10plum"),
+	})
+	priceSetup := func() {
+		store := s.getStore()
+		for _, order := range []*exchange.Order{priceAsk201, priceAsk202, priceBid203, priceBid204, priceAsk205, priceAsk206, priceBid207} {
+			s.requireSetOrderInStore(store, order)
+		}
+	}
+	makePriceKey := func(order *exchange.Order) []byte {
+		return keeper.MakeIndexKeyPriceToOrder(order)[len(keeper.GetIndexKeyPrefixPriceToOrder(8, "apple", "plum")):]
+	}
+	// Unit prices in market 8 for apple/plum: asks: 202 = 1, 205 = 2, 201 = 3; bids: 204 = 1, 203 = 2.
+
 	// OrderIDs in each market:
 	//   0  1  2   3   4   5   6   7   8   9  10  11  12  13  14  15  16  17  18  19
 	//1: 1, 4, 7, 10, 13, 16, 19, 22, 25, 28, 31, 34, 37, 40, 43, 46, 49, 52, 55, 58
@@ -1499,6 +1823,107 @@ func (s *TestSuite) TestQueryServer_GetMarketOrders() {
 				Pagination: &query.PageResponse{NextKey: makeKey(marketBidOrders[1][5]), Total: 5},
 			},
 		},
+
+		// Ordered by price.
+		{
+			name:     "by price, only asset denom",
+			req:      &exchange.QueryGetMarketOrdersRequest{MarketId: 8, AssetDenom: "apple"},
+			expInErr: []string{invalidArgErr, "asset denom and price denom must either both be provided or both be empty"},
+		},
+		{
+			name:     "by price, only price denom",
+			req:      &exchange.QueryGetMarketOrdersRequest{MarketId: 8, PriceDenom: "plum"},
+			expInErr: []string{invalidArgErr, "asset denom and price denom must either both be provided or both be empty"},
+		},
+		{
+			name: "by price, unknown order type",
+			req: &exchange.QueryGetMarketOrdersRequest{
+				MarketId: 8, AssetDenom: "apple", PriceDenom: "plum", OrderType: "trade",
+			},
+			expInErr: []string{invalidArgErr, "error iterating orders for market 8: unknown order type \"trade\""},
+		},
+		{
+			name:    "by price, no orders",
+			setup:   priceSetup,
+			req:     &exchange.QueryGetMarketOrdersRequest{MarketId: 8, AssetDenom: "apple", PriceDenom: "nhash"},
+			expResp: &exchange.QueryGetMarketOrdersResponse{Orders: nil, Pagination: &query.PageResponse{}},
+		},
+		{
+			name:  "by price, get all",
+			setup: priceSetup,
+			req:   &exchange.QueryGetMarketOrdersRequest{MarketId: 8, AssetDenom: "apple", PriceDenom: "plum"},
+			expResp: &exchange.QueryGetMarketOrdersResponse{
+				Orders:     []*exchange.Order{priceAsk202, priceAsk205, priceAsk201, priceBid204, priceBid203},
+				Pagination: &query.PageResponse{Total: 5},
+			},
+		},
+		{
+			name:  "by price, other denoms",
+			setup: priceSetup,
+			req:   &exchange.QueryGetMarketOrdersRequest{MarketId: 8, AssetDenom: "apple", PriceDenom: "prune"},
+			expResp: &exchange.QueryGetMarketOrdersResponse{
+				Orders:     []*exchange.Order{priceAsk206},
+				Pagination: &query.PageResponse{Total: 1},
+			},
+		},
+		{
+			name:  "by price, asks",
+			setup: priceSetup,
+			req: &exchange.QueryGetMarketOrdersRequest{
+				MarketId: 8, AssetDenom: "apple", PriceDenom: "plum", OrderType: "asks",
+			},
+			expResp: &exchange.QueryGetMarketOrdersResponse{
+				Orders:     []*exchange.Order{priceAsk202, priceAsk205, priceAsk201},
+				Pagination: &query.PageResponse{Total: 3},
+			},
+		},
+		{
+			name:  "by price, bids, reverse",
+			setup: priceSetup,
+			req: &exchange.QueryGetMarketOrdersRequest{
+				MarketId: 8, AssetDenom: "apple", PriceDenom: "plum", OrderType: "bid",
+				Pagination: &query.PageRequest{Reverse: true},
+			},
+			expResp: &exchange.QueryGetMarketOrdersResponse{
+				Orders:     []*exchange.Order{priceBid203, priceBid204},
+				Pagination: &query.PageResponse{Total: 2},
+			},
+		},
+		{
+			name:  "by price, after order 202",
+			setup: priceSetup,
+			req: &exchange.QueryGetMarketOrdersRequest{
+				MarketId: 8, AssetDenom: "apple", PriceDenom: "plum", AfterOrderId: 202,
+			},
+			expResp: &exchange.QueryGetMarketOrdersResponse{
+				Orders:     []*exchange.Order{priceAsk205, priceBid204, priceBid203},
+				Pagination: &query.PageResponse{Total: 3},
+			},
+		},
+		{
+			name:  "by price, limit with offset and count",
+			setup: priceSetup,
+			req: &exchange.QueryGetMarketOrdersRequest{
+				MarketId: 8, AssetDenom: "apple", PriceDenom: "plum",
+				Pagination: &query.PageRequest{Limit: 2, Offset: 1, CountTotal: true},
+			},
+			expResp: &exchange.QueryGetMarketOrdersResponse{
+				Orders:     []*exchange.Order{priceAsk205, priceAsk201},
+				Pagination: &query.PageResponse{NextKey: makePriceKey(priceBid204), Total: 5},
+			},
+		},
+		{
+			name:  "by price, limit with key",
+			setup: priceSetup,
+			req: &exchange.QueryGetMarketOrdersRequest{
+				MarketId: 8, AssetDenom: "apple", PriceDenom: "plum",
+				Pagination: &query.PageRequest{Limit: 2, Key: makePriceKey(priceAsk201)},
+			},
+			expResp: &exchange.QueryGetMarketOrdersResponse{
+				Orders:     []*exchange.Order{priceAsk201, priceBid204},
+				Pagination: &query.PageResponse{NextKey: makePriceKey(priceBid203)},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -3129,54 +3554,78 @@ func (s *TestSuite) TestQueryServer_GetAllOrders() {
 	}
 }
 
-func (s *TestSuite) TestQueryServer_GetCommitment() {
-	testDef := queryTestDef[exchange.QueryGetCommitmentRequest, exchange.QueryGetCommitmentResponse]{
-		queryName: "GetCommitment",
-		query:     keeper.NewQueryServer(s.k).GetCommitment,
+func (s *TestSuite) TestQueryServer_GetTriggerOrder() {
+	testDef := queryTestDef[exchange.QueryGetTriggerOrderRequest, exchange.QueryGetTriggerOrderResponse]{
+		queryName: "GetTriggerOrder",
+		query:     keeper.NewQueryServer(s.k).GetTriggerOrder,
 	}
 
-	tests := []queryTestCase[exchange.QueryGetCommitmentRequest, exchange.QueryGetCommitmentResponse]{
+	tests := []queryTestCase[exchange.QueryGetTriggerOrderRequest, exchange.QueryGetTriggerOrderResponse]{
 		{
 			name:     "nil req",
 			req:      nil,
 			expInErr: []string{invalidArgErr, "empty request"},
 		},
 		{
-			name:     "no account",
-			req:      &exchange.QueryGetCommitmentRequest{MarketId: 1},
+			name:     "order 0",
+			req:      &exchange.QueryGetTriggerOrderRequest{OrderId: 0},
 			expInErr: []string{invalidArgErr, "empty request"},
 		},
 		{
-			name:     "no market",
-			req:      &exchange.QueryGetCommitmentRequest{Account: s.addr1.String()},
-			expInErr: []string{invalidArgErr, "empty request"},
+			name: "error getting trigger order",
+			setup: func() {
+				s.getStore().Set(keeper.MakeKeyTriggerOrder(4), []byte{9, 9, 9})
+			},
+			req:      &exchange.QueryGetTriggerOrderRequest{OrderId: 4},
+			expInErr: []string{invalidArgErr, "failed to unmarshal trigger order 4"},
 		},
 		{
-			name:     "invalid account",
-			req:      &exchange.QueryGetCommitmentRequest{Account: "badbadaddr", MarketId: 1},
-			expInErr: []string{invalidArgErr, "invalid account \"badbadaddr\""},
+			name: "trigger order not found",
+			setup: func() {
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(4).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("55apple"), Price: s.coin("99prune"),
+				}))
+			},
+			req:      &exchange.QueryGetTriggerOrderRequest{OrderId: 4},
+			expInErr: []string{invalidArgErr, "trigger order 4 not found"},
 		},
 		{
-			name:    "nothing committed",
-			req:     &exchange.QueryGetCommitmentRequest{Account: s.addr1.String(), MarketId: 1},
-			expResp: &exchange.QueryGetCommitmentResponse{},
+			name: "trigger ask order",
+			setup: func() {
+				s.requireSetTriggerOrderInStore(exchange.NewTriggerOrder(*exchange.NewOrder(3).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("20apple"), Price: s.coin("3pineapple"),
+					ExternalId: "ask-trigger-3",
+				}), s.coin("4pineapple")))
+				s.requireSetTriggerOrderInStore(exchange.NewTriggerOrder(*exchange.NewOrder(5).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("20apple"), Price: s.coin("3pineapple"),
+				}), s.coin("2pineapple")))
+			},
+			req: &exchange.QueryGetTriggerOrderRequest{OrderId: 3},
+			expResp: &exchange.QueryGetTriggerOrderResponse{
+				TriggerOrder: exchange.NewTriggerOrder(*exchange.NewOrder(3).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("20apple"), Price: s.coin("3pineapple"),
+					ExternalId: "ask-trigger-3",
+				}), s.coin("4pineapple")),
+			},
 		},
 		{
-			name: "funds committed",
+			name: "trigger bid order",
 			setup: func() {
-				store := s.getStore()
-				keeper.SetCommitmentAmount(store, 1, s.addr1, s.coins("11apple"))
-				keeper.SetCommitmentAmount(store, 1, s.addr2, s.coins("12apple"))
-				keeper.SetCommitmentAmount(store, 1, s.addr3, s.coins("13apple"))
-				keeper.SetCommitmentAmount(store, 2, s.addr1, s.coins("21apple"))
-				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("22apple,157banana,386cherry"))
-				keeper.SetCommitmentAmount(store, 2, s.addr3, s.coins("23apple"))
-				keeper.SetCommitmentAmount(store, 3, s.addr1, s.coins("31apple"))
-				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32apple"))
-				keeper.SetCommitmentAmount(store, 3, s.addr3, s.coins("33apple"))
+				s.requireSetTriggerOrderInStore(exchange.NewTriggerOrder(*exchange.NewOrder(3).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("20apple"), Price: s.coin("3pineapple"),
+				}), s.coin("4pineapple")))
+				s.requireSetTriggerOrderInStore(exchange.NewTriggerOrder(*exchange.NewOrder(5).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("20apple"), Price: s.coin("3pineapple"),
+					BuyerSettlementFees: s.coins("1fig"),
+				}), s.coin("2pineapple")))
+			},
+			req: &exchange.QueryGetTriggerOrderRequest{OrderId: 5},
+			expResp: &exchange.QueryGetTriggerOrderResponse{
+				TriggerOrder: exchange.NewTriggerOrder(*exchange.NewOrder(5).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("20apple"), Price: s.coin("3pineapple"),
+					BuyerSettlementFees: s.coins("1fig"),
+				}), s.coin("2pineapple")),
 			},
-			req:     &exchange.QueryGetCommitmentRequest{Account: s.addr2.String(), MarketId: 2},
-			expResp: &exchange.QueryGetCommitmentResponse{Amount: s.coins("22apple,157banana,386cherry")},
 		},
 	}
 
@@ -3187,41 +3636,1487 @@ func (s *TestSuite) TestQueryServer_GetCommitment() {
 	}
 }
 
-func (s *TestSuite) TestQueryServer_GetAccountCommitments() {
-	testDef := queryTestDef[exchange.QueryGetAccountCommitmentsRequest, exchange.QueryGetAccountCommitmentsResponse]{
-		queryName: "GetAccountCommitments",
-		query:     keeper.NewQueryServer(s.k).GetAccountCommitments,
+func (s *TestSuite) TestQueryServer_GetMarketTriggerOrders() {
+	testDef := queryTestDef[exchange.QueryGetMarketTriggerOrdersRequest, exchange.QueryGetMarketTriggerOrdersResponse]{
+		queryName: "GetMarketTriggerOrders",
+		query:     keeper.NewQueryServer(s.k).GetMarketTriggerOrders,
+	}
+	makeKey := func(triggerOrder *exchange.TriggerOrder) []byte {
+		return keeper.Uint64Bz(triggerOrder.GetOrderID())
 	}
 
-	tests := []queryTestCase[exchange.QueryGetAccountCommitmentsRequest, exchange.QueryGetAccountCommitmentsResponse]{
+	triggerOrders := []*exchange.TriggerOrder{
+		exchange.NewTriggerOrder(*exchange.NewOrder(2).WithAsk(&exchange.AskOrder{
+			MarketId: 2, Seller: s.addr1.String(), Assets: s.coin("2apple"), Price: s.coin("20prune"),
+		}), s.coin("22prune")),
+		exchange.NewTriggerOrder(*exchange.NewOrder(3).WithBid(&exchange.BidOrder{
+			MarketId: 2, Buyer: s.addr2.String(), Assets: s.coin("3apple"), Price: s.coin("30prune"),
+		}), s.coin("28prune")),
+		exchange.NewTriggerOrder(*exchange.NewOrder(4).WithAsk(&exchange.AskOrder{
+			MarketId: 1, Seller: s.addr3.String(), Assets: s.coin("4apple"), Price: s.coin("40prune"),
+		}), s.coin("44prune")),
+		exchange.NewTriggerOrder(*exchange.NewOrder(5).WithBid(&exchange.BidOrder{
+			MarketId: 2, Buyer: s.addr3.String(), Assets: s.coin("5apple"), Price: s.coin("50prune"),
+		}), s.coin("48prune")),
+	}
+	setup := func() {
+		for _, triggerOrder := range triggerOrders {
+			s.requireSetTriggerOrderInStore(triggerOrder)
+		}
+	}
+
+	tests := []queryTestCase[exchange.QueryGetMarketTriggerOrdersRequest, exchange.QueryGetMarketTriggerOrdersResponse]{
 		{
 			name:     "nil req",
 			req:      nil,
 			expInErr: []string{invalidArgErr, "empty request"},
 		},
 		{
-			name:     "no account",
-			req:      &exchange.QueryGetAccountCommitmentsRequest{},
+			name:     "no market",
+			req:      &exchange.QueryGetMarketTriggerOrdersRequest{},
 			expInErr: []string{invalidArgErr, "empty request"},
 		},
 		{
-			name:     "invalid account",
-			req:      &exchange.QueryGetAccountCommitmentsRequest{Account: "badbadaddr"},
-			expInErr: []string{invalidArgErr, "invalid account \"badbadaddr\""},
+			name:    "no trigger orders in market",
+			setup:   setup,
+			req:     &exchange.QueryGetMarketTriggerOrdersRequest{MarketId: 3},
+			expResp: &exchange.QueryGetMarketTriggerOrdersResponse{Pagination: &query.PageResponse{}},
 		},
 		{
-			name: "nothing committed",
-			setup: func() {
-				store := s.getStore()
-				s.requireCreateMarket(exchange.Market{MarketId: 1})
-				s.requireCreateMarket(exchange.Market{MarketId: 2})
-				s.requireCreateMarket(exchange.Market{MarketId: 3})
-				keeper.SetCommitmentAmount(store, 1, s.addr1, s.coins("11apple"))
-				keeper.SetCommitmentAmount(store, 1, s.addr3, s.coins("13apple"))
-				keeper.SetCommitmentAmount(store, 2, s.addr1, s.coins("21apple"))
-				keeper.SetCommitmentAmount(store, 2, s.addr3, s.coins("23apple"))
-				keeper.SetCommitmentAmount(store, 3, s.addr1, s.coins("31apple"))
-				keeper.SetCommitmentAmount(store, 3, s.addr3, s.coins("33apple"))
+			name:  "one trigger order in market",
+			setup: setup,
+			req:   &exchange.QueryGetMarketTriggerOrdersRequest{MarketId: 1},
+			expResp: &exchange.QueryGetMarketTriggerOrdersResponse{
+				TriggerOrders: []exchange.TriggerOrder{*triggerOrders[2]},
+				Pagination:    &query.PageResponse{Total: 1},
+			},
+		},
+		{
+			name:  "three trigger orders in market",
+			setup: setup,
+			req:   &exchange.QueryGetMarketTriggerOrdersRequest{MarketId: 2},
+			expResp: &exchange.QueryGetMarketTriggerOrdersResponse{
+				TriggerOrders: []exchange.TriggerOrder{*triggerOrders[0], *triggerOrders[1], *triggerOrders[3]},
+				Pagination:    &query.PageResponse{Total: 3},
+			},
+		},
+		{
+			name:  "limit 1 offset 1",
+			setup: setup,
+			req: &exchange.QueryGetMarketTriggerOrdersRequest{
+				MarketId:   2,
+				Pagination: &query.PageRequest{Offset: 1, Limit: 1},
+			},
+			expResp: &exchange.QueryGetMarketTriggerOrdersResponse{
+				TriggerOrders: []exchange.TriggerOrder{*triggerOrders[1]},
+				Pagination:    &query.PageResponse{NextKey: makeKey(triggerOrders[3])},
+			},
+		},
+		{
+			name: "bad entry skipped",
+			setup: func() {
+				setup()
+				s.getStore().Set(keeper.MakeKeyTriggerOrder(3), []byte{9, 9, 9})
+			},
+			req: &exchange.QueryGetMarketTriggerOrdersRequest{MarketId: 2},
+			expResp: &exchange.QueryGetMarketTriggerOrdersResponse{
+				TriggerOrders: []exchange.TriggerOrder{*triggerOrders[0], *triggerOrders[3]},
+				Pagination:    &query.PageResponse{Total: 2},
+			},
+		},
+		{
+			name:  "reversed",
+			setup: setup,
+			req: &exchange.QueryGetMarketTriggerOrdersRequest{
+				MarketId:   2,
+				Pagination: &query.PageRequest{Reverse: true},
+			},
+			expResp: &exchange.QueryGetMarketTriggerOrdersResponse{
+				TriggerOrders: []exchange.TriggerOrder{*triggerOrders[3], *triggerOrders[1], *triggerOrders[0]},
+				Pagination:    &query.PageResponse{Total: 3},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runQueryTestCase(s, testDef, tc)
+		})
+	}
+}
+
+func (s *TestSuite) TestQueryServer_GetOrderLink() {
+	testDef := queryTestDef[exchange.QueryGetOrderLinkRequest, exchange.QueryGetOrderLinkResponse]{
+		queryName: "GetOrderLink",
+		query:     keeper.NewQueryServer(s.k).GetOrderLink,
+	}
+
+	setup := func() {
+		s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(3).WithAsk(&exchange.AskOrder{
+			MarketId: 2, Seller: s.addr1.String(), Assets: s.coin("20apple"), Price: s.coin("30prune"),
+		}))
+		s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(4).WithAsk(&exchange.AskOrder{
+			MarketId: 2, Seller: s.addr1.String(), Assets: s.coin("20apple"), Price: s.coin("40prune"),
+		}))
+		s.requireSetTriggerOrderInStore(exchange.NewTriggerOrder(*exchange.NewOrder(5).WithAsk(&exchange.AskOrder{
+			MarketId: 2, Seller: s.addr1.String(), Assets: s.coin("20apple"), Price: s.coin("15prune"),
+		}), s.coin("16prune")))
+		s.requireSetOrderLink(2, 3, 5)
+	}
+
+	tests := []queryTestCase[exchange.QueryGetOrderLinkRequest, exchange.QueryGetOrderLinkResponse]{
+		{
+			name:     "nil req",
+			req:      nil,
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:     "order 0",
+			req:      &exchange.QueryGetOrderLinkRequest{OrderId: 0},
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name: "error getting order",
+			setup: func() {
+				s.getStore().Set(keeper.MakeKeyOrder(4), []byte{9, 9, 9})
+			},
+			req:      &exchange.QueryGetOrderLinkRequest{OrderId: 4},
+			expInErr: []string{invalidArgErr, "failed to read order 4"},
+		},
+		{
+			name:     "order not found",
+			setup:    setup,
+			req:      &exchange.QueryGetOrderLinkRequest{OrderId: 6},
+			expInErr: []string{invalidArgErr, "order 6 is not linked"},
+		},
+		{
+			name:     "order not linked",
+			setup:    setup,
+			req:      &exchange.QueryGetOrderLinkRequest{OrderId: 4},
+			expInErr: []string{invalidArgErr, "order 4 is not linked"},
+		},
+		{
+			name:    "order linked to trigger order",
+			setup:   setup,
+			req:     &exchange.QueryGetOrderLinkRequest{OrderId: 3},
+			expResp: &exchange.QueryGetOrderLinkResponse{OrderLink: exchange.NewOrderLink(2, 3, 5)},
+		},
+		{
+			name:    "trigger order linked to order",
+			setup:   setup,
+			req:     &exchange.QueryGetOrderLinkRequest{OrderId: 5},
+			expResp: &exchange.QueryGetOrderLinkResponse{OrderLink: exchange.NewOrderLink(2, 5, 3)},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runQueryTestCase(s, testDef, tc)
+		})
+	}
+}
+
+func (s *TestSuite) TestQueryServer_GetDeadManSwitch() {
+	testDef := queryTestDef[exchange.QueryGetDeadManSwitchRequest, exchange.QueryGetDeadManSwitchResponse]{
+		queryName: "GetDeadManSwitch",
+		query:     keeper.NewQueryServer(s.k).GetDeadManSwitch,
+	}
+	dms := exchange.NewDeadManSwitch(s.addr1.String(), 90, time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC))
+	setup := func() {
+		s.requireSetDeadManSwitchesInStore(dms)
+	}
+
+	tests := []queryTestCase[exchange.QueryGetDeadManSwitchRequest, exchange.QueryGetDeadManSwitchResponse]{
+		{
+			name:     "nil req",
+			req:      nil,
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:     "no account",
+			req:      &exchange.QueryGetDeadManSwitchRequest{},
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:     "invalid account",
+			req:      &exchange.QueryGetDeadManSwitchRequest{Account: "not-an-account"},
+			expInErr: []string{invalidArgErr, "invalid account \"not-an-account\"", "decoding bech32 failed"},
+		},
+		{
+			name:     "no dead-man switch",
+			setup:    setup,
+			req:      &exchange.QueryGetDeadManSwitchRequest{Account: s.addr2.String()},
+			expInErr: []string{invalidArgErr, "no dead-man switch found for " + s.addr2.String()},
+		},
+		{
+			name:    "has dead-man switch",
+			setup:   setup,
+			req:     &exchange.QueryGetDeadManSwitchRequest{Account: s.addr1.String()},
+			expResp: &exchange.QueryGetDeadManSwitchResponse{DeadManSwitch: dms},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runQueryTestCase(s, testDef, tc)
+		})
+	}
+}
+
+func (s *TestSuite) TestQueryServer_GetMarketOrderLinks() {
+	testDef := queryTestDef[exchange.QueryGetMarketOrderLinksRequest, exchange.QueryGetMarketOrderLinksResponse]{
+		queryName: "GetMarketOrderLinks",
+		query:     keeper.NewQueryServer(s.k).GetMarketOrderLinks,
+	}
+
+	setup := func() {
+		s.requireSetOrderLink(2, 7, 2)
+		s.requireSetOrderLink(2, 3, 4)
+		s.requireSetOrderLink(1, 5, 6)
+		s.requireSetOrderLink(2, 9, 8)
+	}
+
+	tests := []queryTestCase[exchange.QueryGetMarketOrderLinksRequest, exchange.QueryGetMarketOrderLinksResponse]{
+		{
+			name:     "nil req",
+			req:      nil,
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:     "no market",
+			req:      &exchange.QueryGetMarketOrderLinksRequest{},
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:    "no links in market",
+			setup:   setup,
+			req:     &exchange.QueryGetMarketOrderLinksRequest{MarketId: 3},
+			expResp: &exchange.QueryGetMarketOrderLinksResponse{Pagination: &query.PageResponse{}},
+		},
+		{
+			name:  "one link in market",
+			setup: setup,
+			req:   &exchange.QueryGetMarketOrderLinksRequest{MarketId: 1},
+			expResp: &exchange.QueryGetMarketOrderLinksResponse{
+				OrderLinks: []exchange.OrderLink{*exchange.NewOrderLink(1, 5, 6)},
+				Pagination: &query.PageResponse{Total: 1},
+			},
+		},
+		{
+			name:  "three links in market",
+			setup: setup,
+			req:   &exchange.QueryGetMarketOrderLinksRequest{MarketId: 2},
+			expResp: &exchange.QueryGetMarketOrderLinksResponse{
+				OrderLinks: []exchange.OrderLink{
+					*exchange.NewOrderLink(2, 2, 7),
+					*exchange.NewOrderLink(2, 3, 4),
+					*exchange.NewOrderLink(2, 8, 9),
+				},
+				Pagination: &query.PageResponse{Total: 3},
+			},
+		},
+		{
+			name:  "limit 1 offset 1",
+			setup: setup,
+			req: &exchange.QueryGetMarketOrderLinksRequest{
+				MarketId:   2,
+				Pagination: &query.PageRequest{Offset: 1, Limit: 1},
+			},
+			expResp: &exchange.QueryGetMarketOrderLinksResponse{
+				OrderLinks: []exchange.OrderLink{*exchange.NewOrderLink(2, 3, 4)},
+				Pagination: &query.PageResponse{NextKey: keeper.Uint64Bz(8)},
+			},
+		},
+		{
+			name:  "reversed",
+			setup: setup,
+			req: &exchange.QueryGetMarketOrderLinksRequest{
+				MarketId:   2,
+				Pagination: &query.PageRequest{Reverse: true},
+			},
+			expResp: &exchange.QueryGetMarketOrderLinksResponse{
+				OrderLinks: []exchange.OrderLink{
+					*exchange.NewOrderLink(2, 8, 9),
+					*exchange.NewOrderLink(2, 3, 4),
+					*exchange.NewOrderLink(2, 2, 7),
+				},
+				Pagination: &query.PageResponse{Total: 3},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runQueryTestCase(s, testDef, tc)
+		})
+	}
+}
+
+func (s *TestSuite) TestQueryServer_OrderBookDepth() {
+	testDef := queryTestDef[exchange.QueryOrderBookDepthRequest, exchange.QueryOrderBookDepthResponse]{
+		queryName: "OrderBookDepth",
+		query:     keeper.NewQueryServer(s.k).OrderBookDepth,
+	}
+
+	askOrder := func(orderID uint64, marketID uint32, assets, price string) *exchange.Order {
+		return exchange.NewOrder(orderID).WithAsk(&exchange.AskOrder{
+			MarketId: marketID,
+			Seller:   s.addr1.String(),
+			Assets:   s.coin(assets),
+			Price:    s.coin(price),
+		})
+	}
+	bidOrder := func(orderID uint64, marketID uint32, assets, price string) *exchange.Order {
+		return exchange.NewOrder(orderID).WithBid(&exchange.BidOrder{
+			MarketId: marketID,
+			Buyer:    s.addr2.String(),
+			Assets:   s.coin(assets),
+			Price:    s.coin(price),
+		})
+	}
+	level := func(price, assets string, orderCount uint32) exchange.PriceLevel {
+		rv := exchange.PriceLevel{Assets: s.coin(assets), OrderCount: orderCount}
+		var err error
+		rv.Price, err = sdk.ParseDecCoin(price)
+		s.Require().NoError(err, "ParseDecCoin(%q)", price)
+		return rv
+	}
+
+	setup := func() {
+		s.requireCreateMarket(exchange.Market{MarketId: 1})
+		s.requireCreateMarket(exchange.Market{MarketId: 2})
+		expired := bidOrder(9, 1, "50apple", "500plum")
+		blockTime := s.ctx.BlockTime()
+		expired.GetBidOrder().Expiration = &blockTime
+		iceberg := askOrder(12, 1, "100apple", "400plum")
+		iceberg.GetAskOrder().DisplayAssets = s.coinP("6apple")
+		s.requireSetOrdersInStore(s.getStore(),
+			askOrder(1, 1, "10apple", "30plum"),
+			askOrder(2, 1, "5apple", "20plum"),
+			bidOrder(3, 1, "4apple", "8plum"),
+			bidOrder(4, 1, "6apple", "15plum"),
+			askOrder(5, 1, "2apple", "6plum"),
+			bidOrder(6, 1, "3apple", "6plum"),
+			askOrder(7, 1, "10apple", "30pear"),
+			bidOrder(8, 2, "10apple", "30plum"),
+			expired,
+			bidOrder(10, 1, "1apple", "1plum"),
+			askOrder(11, 1, "7banana", "30plum"),
+			iceberg,
+		)
+	}
+
+	tests := []queryTestCase[exchange.QueryOrderBookDepthRequest, exchange.QueryOrderBookDepthResponse]{
+		{
+			name:     "nil req",
+			req:      nil,
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:     "no market",
+			req:      &exchange.QueryOrderBookDepthRequest{AssetDenom: "apple", PriceDenom: "plum"},
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:     "invalid asset denom",
+			req:      &exchange.QueryOrderBookDepthRequest{MarketId: 1, AssetDenom: "", PriceDenom: "plum"},
+			expInErr: []string{invalidArgErr, "invalid asset denom: invalid denom: "},
+		},
+		{
+			name:     "invalid price denom",
+			req:      &exchange.QueryOrderBookDepthRequest{MarketId: 1, AssetDenom: "apple", PriceDenom: "x"},
+			expInErr: []string{invalidArgErr, "invalid price denom: invalid denom: x"},
+		},
+		{
+			name:     "unknown market",
+			setup:    setup,
+			req:      &exchange.QueryOrderBookDepthRequest{MarketId: 3, AssetDenom: "apple", PriceDenom: "plum"},
+			expInErr: []string{invalidArgErr, "market 3 does not exist"},
+		},
+		{
+			name:    "no orders for denoms",
+			setup:   setup,
+			req:     &exchange.QueryOrderBookDepthRequest{MarketId: 1, AssetDenom: "cherry", PriceDenom: "plum"},
+			expResp: &exchange.QueryOrderBookDepthResponse{},
+		},
+		{
+			name:  "only bids",
+			setup: setup,
+			req:   &exchange.QueryOrderBookDepthRequest{MarketId: 2, AssetDenom: "apple", PriceDenom: "plum"},
+			expResp: &exchange.QueryOrderBookDepthResponse{
+				Bids: []exchange.PriceLevel{level("3plum", "10apple", 1)},
+			},
+		},
+		{
+			name:  "all levels",
+			setup: setup,
+			req:   &exchange.QueryOrderBookDepthRequest{MarketId: 1, AssetDenom: "apple", PriceDenom: "plum"},
+			expResp: &exchange.QueryOrderBookDepthResponse{
+				Bids: []exchange.PriceLevel{
+					level("2.5plum", "6apple", 1),
+					level("2plum", "7apple", 2),
+					level("1plum", "1apple", 1),
+				},
+				Asks: []exchange.PriceLevel{
+					level("3plum", "12apple", 2),
+					level("4plum", "11apple", 2),
+				},
+			},
+		},
+		{
+			name:  "depth 1",
+			setup: setup,
+			req:   &exchange.QueryOrderBookDepthRequest{MarketId: 1, AssetDenom: "apple", PriceDenom: "plum", Depth: 1},
+			expResp: &exchange.QueryOrderBookDepthResponse{
+				Bids: []exchange.PriceLevel{level("2.5plum", "6apple", 1)},
+				Asks: []exchange.PriceLevel{level("3plum", "12apple", 2)},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runQueryTestCase(s, testDef, tc)
+		})
+	}
+}
+
+func (s *TestSuite) TestQueryServer_TopOfBook() {
+	testDef := queryTestDef[exchange.QueryTopOfBookRequest, exchange.QueryTopOfBookResponse]{
+		queryName: "TopOfBook",
+		query:     keeper.NewQueryServer(s.k).TopOfBook,
+	}
+
+	askOrder := func(orderID uint64, marketID uint32, assets, price string) *exchange.Order {
+		return exchange.NewOrder(orderID).WithAsk(&exchange.AskOrder{
+			MarketId: marketID,
+			Seller:   s.addr1.String(),
+			Assets:   s.coin(assets),
+			Price:    s.coin(price),
+		})
+	}
+	bidOrder := func(orderID uint64, marketID uint32, assets, price string) *exchange.Order {
+		return exchange.NewOrder(orderID).WithBid(&exchange.BidOrder{
+			MarketId: marketID,
+			Buyer:    s.addr2.String(),
+			Assets:   s.coin(assets),
+			Price:    s.coin(price),
+		})
+	}
+	level := func(price, assets string, orderCount uint32) *exchange.PriceLevel {
+		rv := &exchange.PriceLevel{Assets: s.coin(assets), OrderCount: orderCount}
+		var err error
+		rv.Price, err = sdk.ParseDecCoin(price)
+		s.Require().NoError(err, "ParseDecCoin(%q)", price)
+		return rv
+	}
+
+	setup := func() {
+		s.requireCreateMarket(exchange.Market{MarketId: 1})
+		s.requireCreateMarket(exchange.Market{MarketId: 2})
+		s.requireCreateMarket(exchange.Market{MarketId: 3})
+		blockTime := s.ctx.BlockTime()
+		expiredAsk := askOrder(9, 1, "50apple", "50plum")
+		expiredAsk.GetAskOrder().Expiration = &blockTime
+		expiredBid := bidOrder(10, 1, "50apple", "500plum")
+		expiredBid.GetBidOrder().Expiration = &blockTime
+		iceberg := askOrder(12, 1, "100apple", "300plum")
+		iceberg.GetAskOrder().DisplayAssets = s.coinP("6apple")
+		s.requireSetOrdersInStore(s.getStore(),
+			askOrder(1, 1, "10apple", "30plum"),
+			askOrder(2, 1, "5apple", "20plum"),
+			bidOrder(3, 1, "4apple", "8plum"),
+			bidOrder(4, 1, "6apple", "15plum"),
+			askOrder(5, 1, "2apple", "6plum"),
+			bidOrder(6, 1, "2apple", "5plum"),
+			askOrder(7, 1, "10apple", "20pear"),
+			bidOrder(8, 2, "10apple", "30plum"),
+			expiredAsk,
+			expiredBid,
+			askOrder(11, 1, "7banana", "30plum"),
+			iceberg,
+			// These two have unit prices that are the same to 18 decimal places, but 14 is actually higher.
+			bidOrder(13, 3, "3apple", "1plum"),
+			bidOrder(14, 3, "2000000000000000000apple", "666666666666666667plum"),
+			// These two cross each other.
+			askOrder(15, 3, "1cherry", "2plum"),
+			bidOrder(16, 3, "1cherry", "3plum"),
+		)
+	}
+
+	tests := []queryTestCase[exchange.QueryTopOfBookRequest, exchange.QueryTopOfBookResponse]{
+		{
+			name:     "nil req",
+			req:      nil,
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:     "no market",
+			req:      &exchange.QueryTopOfBookRequest{AssetDenom: "apple", PriceDenom: "plum"},
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:     "invalid asset denom",
+			req:      &exchange.QueryTopOfBookRequest{MarketId: 1, AssetDenom: "", PriceDenom: "plum"},
+			expInErr: []string{invalidArgErr, "invalid asset denom: invalid denom: "},
+		},
+		{
+			name:     "invalid price denom",
+			req:      &exchange.QueryTopOfBookRequest{MarketId: 1, AssetDenom: "apple", PriceDenom: "x"},
+			expInErr: []string{invalidArgErr, "invalid price denom: invalid denom: x"},
+		},
+		{
+			name:     "unknown market",
+			setup:    setup,
+			req:      &exchange.QueryTopOfBookRequest{MarketId: 4, AssetDenom: "apple", PriceDenom: "plum"},
+			expInErr: []string{invalidArgErr, "market 4 does not exist"},
+		},
+		{
+			name:    "no orders for denoms",
+			setup:   setup,
+			req:     &exchange.QueryTopOfBookRequest{MarketId: 1, AssetDenom: "cherry", PriceDenom: "plum"},
+			expResp: &exchange.QueryTopOfBookResponse{},
+		},
+		{
+			name:    "only bids",
+			setup:   setup,
+			req:     &exchange.QueryTopOfBookRequest{MarketId: 2, AssetDenom: "apple", PriceDenom: "plum"},
+			expResp: &exchange.QueryTopOfBookResponse{BestBid: level("3plum", "10apple", 1)},
+		},
+		{
+			name:    "only asks",
+			setup:   setup,
+			req:     &exchange.QueryTopOfBookRequest{MarketId: 1, AssetDenom: "apple", PriceDenom: "pear"},
+			expResp: &exchange.QueryTopOfBookResponse{BestAsk: level("2pear", "10apple", 1)},
+		},
+		{
+			name:  "both sides",
+			setup: setup,
+			req:   &exchange.QueryTopOfBookRequest{MarketId: 1, AssetDenom: "apple", PriceDenom: "plum"},
+			expResp: &exchange.QueryTopOfBookResponse{
+				BestBid: level("2.5plum", "8apple", 2),
+				BestAsk: level("3plum", "18apple", 3),
+				Spread:  "0.500000000000000000",
+			},
+		},
+		{
+			name:  "unit prices equal when truncated",
+			setup: setup,
+			req:   &exchange.QueryTopOfBookRequest{MarketId: 3, AssetDenom: "apple", PriceDenom: "plum"},
+			expResp: &exchange.QueryTopOfBookResponse{
+				BestBid: level("0.333333333333333333plum", "2000000000000000000apple", 1),
+			},
+		},
+		{
+			name:  "crossed book",
+			setup: setup,
+			req:   &exchange.QueryTopOfBookRequest{MarketId: 3, AssetDenom: "cherry", PriceDenom: "plum"},
+			expResp: &exchange.QueryTopOfBookResponse{
+				BestBid: level("3plum", "1cherry", 1),
+				BestAsk: level("2plum", "1cherry", 1),
+				Spread:  "-1.000000000000000000",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runQueryTestCase(s, testDef, tc)
+		})
+	}
+}
+
+func (s *TestSuite) TestQueryServer_PriceAverages() {
+	testDef := queryTestDef[exchange.QueryPriceAveragesRequest, exchange.QueryPriceAveragesResponse]{
+		queryName: "PriceAverages",
+		query:     keeper.NewQueryServer(s.k).PriceAverages,
+	}
+
+	blockTime := time.Unix(1_700_000_000, 0).UTC()
+	price := func(marketID uint32, secsAgo int64, assets, priceAmt string) exchange.SettlementPrice {
+		return exchange.SettlementPrice{
+			MarketId: marketID,
+			Time:     blockTime.Add(-time.Duration(secsAgo) * time.Second),
+			Assets:   s.coin(assets),
+			Price:    s.coin(priceAmt),
+		}
+	}
+
+	setup := func() {
+		s.ctx = s.ctx.WithBlockTime(blockTime)
+		s.requireCreateMarket(exchange.Market{MarketId: 1})
+		s.requireCreateMarket(exchange.Market{MarketId: 2})
+		s.requireSetSettlementPrices(
+			price(1, 7200, "2apple", "6plum"),
+			price(1, 1800, "4apple", "10plum"),
+			price(1, 600, "1apple", "3plum"),
+			price(1, 600, "5banana", "5plum"),
+			price(2, 600, "8apple", "8plum"),
+		)
+	}
+
+	tests := []queryTestCase[exchange.QueryPriceAveragesRequest, exchange.QueryPriceAveragesResponse]{
+		{
+			name:     "nil req",
+			req:      nil,
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:     "no market",
+			req:      &exchange.QueryPriceAveragesRequest{AssetDenom: "apple", PriceDenom: "plum", WindowSeconds: 60},
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:     "invalid asset denom",
+			req:      &exchange.QueryPriceAveragesRequest{MarketId: 1, AssetDenom: "", PriceDenom: "plum", WindowSeconds: 60},
+			expInErr: []string{invalidArgErr, "invalid asset denom: invalid denom: "},
+		},
+		{
+			name:     "invalid price denom",
+			req:      &exchange.QueryPriceAveragesRequest{MarketId: 1, AssetDenom: "apple", PriceDenom: "x", WindowSeconds: 60},
+			expInErr: []string{invalidArgErr, "invalid price denom: invalid denom: x"},
+		},
+		{
+			name:     "no window",
+			req:      &exchange.QueryPriceAveragesRequest{MarketId: 1, AssetDenom: "apple", PriceDenom: "plum"},
+			expInErr: []string{invalidArgErr, "invalid window 0: must be from 1 to 2592000 seconds"},
+		},
+		{
+			name:     "window too large",
+			req:      &exchange.QueryPriceAveragesRequest{MarketId: 1, AssetDenom: "apple", PriceDenom: "plum", WindowSeconds: 2_592_001},
+			expInErr: []string{invalidArgErr, "invalid window 2592001: must be from 1 to 2592000 seconds"},
+		},
+		{
+			name:     "unknown market",
+			setup:    setup,
+			req:      &exchange.QueryPriceAveragesRequest{MarketId: 3, AssetDenom: "apple", PriceDenom: "plum", WindowSeconds: 60},
+			expInErr: []string{invalidArgErr, "market 3 does not exist"},
+		},
+		{
+			name:  "no settlements",
+			setup: setup,
+			req:   &exchange.QueryPriceAveragesRequest{MarketId: 1, AssetDenom: "cherry", PriceDenom: "plum", WindowSeconds: 60},
+			expResp: &exchange.QueryPriceAveragesResponse{
+				Volume:     s.coin("0cherry"),
+				TotalPrice: s.coin("0plum"),
+			},
+		},
+		{
+			name:  "no settlements in window",
+			setup: setup,
+			req:   &exchange.QueryPriceAveragesRequest{MarketId: 1, AssetDenom: "apple", PriceDenom: "plum", WindowSeconds: 300},
+			expResp: &exchange.QueryPriceAveragesResponse{
+				Twap:       "3.000000000000000000",
+				Volume:     s.coin("0apple"),
+				TotalPrice: s.coin("0plum"),
+			},
+		},
+		{
+			name:  "settlements in window with one before it",
+			setup: setup,
+			req:   &exchange.QueryPriceAveragesRequest{MarketId: 1, AssetDenom: "apple", PriceDenom: "plum", WindowSeconds: 3600},
+			// 3.0 for 1800 seconds, 2.5 for 1200 seconds, then 3.0 for 600 seconds = 10200 / 3600.
+			expResp: &exchange.QueryPriceAveragesResponse{
+				Twap:       "2.833333333333333333",
+				Vwap:       "2.600000000000000000",
+				Volume:     s.coin("5apple"),
+				TotalPrice: s.coin("13plum"),
+			},
+		},
+		{
+			name:  "all settlements in window",
+			setup: setup,
+			req:   &exchange.QueryPriceAveragesRequest{MarketId: 1, AssetDenom: "apple", PriceDenom: "plum", WindowSeconds: 86400},
+			// 3.0 for 5400 seconds, 2.5 for 1200 seconds, then 3.0 for 600 seconds = 21000 / 7200.
+			expResp: &exchange.QueryPriceAveragesResponse{
+				Twap:       "2.916666666666666666",
+				Vwap:       "2.714285714285714285",
+				Volume:     s.coin("7apple"),
+				TotalPrice: s.coin("19plum"),
+			},
+		},
+		{
+			name:  "other market",
+			setup: setup,
+			req:   &exchange.QueryPriceAveragesRequest{MarketId: 2, AssetDenom: "apple", PriceDenom: "plum", WindowSeconds: 3600},
+			expResp: &exchange.QueryPriceAveragesResponse{
+				Twap:       "1.000000000000000000",
+				Vwap:       "1.000000000000000000",
+				Volume:     s.coin("8apple"),
+				TotalPrice: s.coin("8plum"),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runQueryTestCase(s, testDef, tc)
+		})
+	}
+}
+
+func (s *TestSuite) TestQueryServer_GetMarketSettlements() {
+	testDef := queryTestDef[exchange.QueryGetMarketSettlementsRequest, exchange.QueryGetMarketSettlementsResponse]{
+		queryName: "GetMarketSettlements",
+		query:     keeper.NewQueryServer(s.k).GetMarketSettlements,
+	}
+	makeKey := func(record exchange.SettlementRecord) []byte {
+		return keeper.MakeKeySettlementRecord(record.MarketId, record.Height, record.Sequence)[5:]
+	}
+
+	records := []exchange.SettlementRecord{
+		s.settlementRecord(2, 5, 0, 1),
+		s.settlementRecord(2, 5, 1, 2, 3),
+		s.settlementRecord(1, 6, 0, 4),
+		s.settlementRecord(2, 7, 0, 5, 6),
+	}
+	setup := func() {
+		s.requireSetSettlementRecords(records...)
+	}
+
+	tests := []queryTestCase[exchange.QueryGetMarketSettlementsRequest, exchange.QueryGetMarketSettlementsResponse]{
+		{
+			name:     "nil req",
+			req:      nil,
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:     "no market",
+			req:      &exchange.QueryGetMarketSettlementsRequest{},
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:    "no settlements in market",
+			setup:   setup,
+			req:     &exchange.QueryGetMarketSettlementsRequest{MarketId: 3},
+			expResp: &exchange.QueryGetMarketSettlementsResponse{Pagination: &query.PageResponse{}},
+		},
+		{
+			name:  "one settlement in market",
+			setup: setup,
+			req:   &exchange.QueryGetMarketSettlementsRequest{MarketId: 1},
+			expResp: &exchange.QueryGetMarketSettlementsResponse{
+				Settlements: []exchange.SettlementRecord{records[2]},
+				Pagination:  &query.PageResponse{Total: 1},
+			},
+		},
+		{
+			name:  "three settlements in market",
+			setup: setup,
+			req:   &exchange.QueryGetMarketSettlementsRequest{MarketId: 2},
+			expResp: &exchange.QueryGetMarketSettlementsResponse{
+				Settlements: []exchange.SettlementRecord{records[0], records[1], records[3]},
+				Pagination:  &query.PageResponse{Total: 3},
+			},
+		},
+		{
+			name:  "after height",
+			setup: setup,
+			req:   &exchange.QueryGetMarketSettlementsRequest{MarketId: 2, AfterHeight: 5},
+			expResp: &exchange.QueryGetMarketSettlementsResponse{
+				Settlements: []exchange.SettlementRecord{records[3]},
+				Pagination:  &query.PageResponse{Total: 1},
+			},
+		},
+		{
+			name:  "limit 1 offset 1",
+			setup: setup,
+			req: &exchange.QueryGetMarketSettlementsRequest{
+				MarketId:   2,
+				Pagination: &query.PageRequest{Offset: 1, Limit: 1},
+			},
+			expResp: &exchange.QueryGetMarketSettlementsResponse{
+				Settlements: []exchange.SettlementRecord{records[1]},
+				Pagination:  &query.PageResponse{NextKey: makeKey(records[3])},
+			},
+		},
+		{
+			name: "bad entry skipped",
+			setup: func() {
+				setup()
+				s.getStore().Set(keeper.MakeKeySettlementRecord(2, 6, 0), []byte{9, 9, 9})
+			},
+			req: &exchange.QueryGetMarketSettlementsRequest{MarketId: 2},
+			expResp: &exchange.QueryGetMarketSettlementsResponse{
+				Settlements: []exchange.SettlementRecord{records[0], records[1], records[3]},
+				Pagination:  &query.PageResponse{Total: 3},
+			},
+		},
+		{
+			name:  "reversed",
+			setup: setup,
+			req: &exchange.QueryGetMarketSettlementsRequest{
+				MarketId:   2,
+				Pagination: &query.PageRequest{Reverse: true},
+			},
+			expResp: &exchange.QueryGetMarketSettlementsResponse{
+				Settlements: []exchange.SettlementRecord{records[3], records[1], records[0]},
+				Pagination:  &query.PageResponse{Total: 3},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runQueryTestCase(s, testDef, tc)
+		})
+	}
+}
+
+func (s *TestSuite) TestQueryServer_GetAllSettlements() {
+	testDef := queryTestDef[exchange.QueryGetAllSettlementsRequest, exchange.QueryGetAllSettlementsResponse]{
+		queryName: "GetAllSettlements",
+		query:     keeper.NewQueryServer(s.k).GetAllSettlements,
+	}
+	makeKey := func(record exchange.SettlementRecord) []byte {
+		return keeper.MakeKeySettlementRecord(record.MarketId, record.Height, record.Sequence)[1:]
+	}
+
+	records := []exchange.SettlementRecord{
+		s.settlementRecord(1, 6, 0, 4),
+		s.settlementRecord(2, 5, 0, 1),
+		s.settlementRecord(2, 5, 1, 2, 3),
+		s.settlementRecord(2, 7, 0, 5, 6),
+	}
+	setup := func() {
+		s.requireSetSettlementRecords(records...)
+	}
+
+	tests := []queryTestCase[exchange.QueryGetAllSettlementsRequest, exchange.QueryGetAllSettlementsResponse]{
+		{
+			name:    "nil req",
+			req:     nil,
+			expResp: &exchange.QueryGetAllSettlementsResponse{Pagination: &query.PageResponse{}},
+		},
+		{
+			name:    "no settlements",
+			req:     &exchange.QueryGetAllSettlementsRequest{},
+			expResp: &exchange.QueryGetAllSettlementsResponse{Pagination: &query.PageResponse{}},
+		},
+		{
+			name:  "four settlements",
+			setup: setup,
+			req:   &exchange.QueryGetAllSettlementsRequest{},
+			expResp: &exchange.QueryGetAllSettlementsResponse{
+				Settlements: records,
+				Pagination:  &query.PageResponse{Total: 4},
+			},
+		},
+		{
+			name:  "limit 2 offset 1",
+			setup: setup,
+			req:   &exchange.QueryGetAllSettlementsRequest{Pagination: &query.PageRequest{Offset: 1, Limit: 2}},
+			expResp: &exchange.QueryGetAllSettlementsResponse{
+				Settlements: []exchange.SettlementRecord{records[1], records[2]},
+				Pagination:  &query.PageResponse{NextKey: makeKey(records[3])},
+			},
+		},
+		{
+			name: "bad entry skipped",
+			setup: func() {
+				setup()
+				s.getStore().Set(keeper.MakeKeySettlementRecord(1, 9, 0), []byte{9, 9, 9})
+			},
+			req: &exchange.QueryGetAllSettlementsRequest{},
+			expResp: &exchange.QueryGetAllSettlementsResponse{
+				Settlements: records,
+				Pagination:  &query.PageResponse{Total: 4},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runQueryTestCase(s, testDef, tc)
+		})
+	}
+}
+
+func (s *TestSuite) TestQueryServer_GetSettlementReceipt() {
+	testDef := queryTestDef[exchange.QueryGetSettlementReceiptRequest, exchange.QueryGetSettlementReceiptResponse]{
+		queryName: "GetSettlementReceipt",
+		query:     keeper.NewQueryServer(s.k).GetSettlementReceipt,
+	}
+
+	receipt := s.settlementReceipt(3, 1, 7, 4, 5)
+	setup := func() {
+		s.requireSetSettlementReceipts(s.settlementReceipt(2, 1, 6, 1), receipt, s.settlementReceipt(4, 2, 7, 8))
+	}
+
+	tests := []queryTestCase[exchange.QueryGetSettlementReceiptRequest, exchange.QueryGetSettlementReceiptResponse]{
+		{
+			name:     "nil req",
+			req:      nil,
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:     "settlement 0",
+			req:      &exchange.QueryGetSettlementReceiptRequest{SettlementId: 0},
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name: "error reading receipt",
+			setup: func() {
+				setup()
+				s.getStore().Set(keeper.MakeKeySettlementReceipt(5), []byte{9, 9, 9})
+			},
+			req:      &exchange.QueryGetSettlementReceiptRequest{SettlementId: 5},
+			expInErr: []string{invalidArgErr, "failed to unmarshal settlement receipt"},
+		},
+		{
+			name:     "receipt not found",
+			setup:    setup,
+			req:      &exchange.QueryGetSettlementReceiptRequest{SettlementId: 1},
+			expInErr: []string{invalidArgErr, "settlement 1 receipt not found"},
+		},
+		{
+			name:    "receipt found",
+			setup:   setup,
+			req:     &exchange.QueryGetSettlementReceiptRequest{SettlementId: 3},
+			expResp: &exchange.QueryGetSettlementReceiptResponse{Receipt: &receipt},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runQueryTestCase(s, testDef, tc)
+		})
+	}
+}
+
+func (s *TestSuite) TestQueryServer_GetLatestNAV() {
+	testDef := queryTestDef[exchange.QueryGetLatestNAVRequest, exchange.QueryGetLatestNAVResponse]{
+		queryName: "GetLatestNAV",
+		query:     keeper.NewQueryServer(s.k).GetLatestNAV,
+	}
+
+	records := []exchange.NAVRecord{
+		s.navRecord(1, 5, "1apple", "2plum"),
+		s.navRecord(2, 8, "1apple", "5plum"),
+		s.navRecord(1, 8, "1apple", "4plum"),
+		s.navRecord(1, 9, "1apple", "6pear"),
+	}
+	setup := func() {
+		s.requireSetNAVRecords(records...)
+	}
+
+	tests := []queryTestCase[exchange.QueryGetLatestNAVRequest, exchange.QueryGetLatestNAVResponse]{
+		{
+			name:     "nil req",
+			req:      nil,
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:     "invalid asset denom",
+			req:      &exchange.QueryGetLatestNAVRequest{PriceDenom: "plum"},
+			expInErr: []string{invalidArgErr, "invalid asset denom: invalid denom: "},
+		},
+		{
+			name:     "invalid price denom",
+			req:      &exchange.QueryGetLatestNAVRequest{AssetDenom: "apple", PriceDenom: "x"},
+			expInErr: []string{invalidArgErr, "invalid price denom: invalid denom: x"},
+		},
+		{
+			name:     "no records for pair",
+			setup:    setup,
+			req:      &exchange.QueryGetLatestNAVRequest{AssetDenom: "plum", PriceDenom: "apple"},
+			expInErr: []string{invalidArgErr, "no nav records found for \"plum\" priced in \"apple\""},
+		},
+		{
+			name:    "one record for pair",
+			setup:   setup,
+			req:     &exchange.QueryGetLatestNAVRequest{AssetDenom: "apple", PriceDenom: "pear"},
+			expResp: &exchange.QueryGetLatestNAVResponse{Nav: &records[3]},
+		},
+		{
+			name:    "several records for pair",
+			setup:   setup,
+			req:     &exchange.QueryGetLatestNAVRequest{AssetDenom: "apple", PriceDenom: "plum"},
+			expResp: &exchange.QueryGetLatestNAVResponse{Nav: &records[1]},
+		},
+		{
+			name: "bad latest entry",
+			setup: func() {
+				setup()
+				s.getStore().Set(keeper.MakeKeyNAVRecord("apple", "plum", 10, 1), []byte{9, 9, 9})
+			},
+			req:      &exchange.QueryGetLatestNAVRequest{AssetDenom: "apple", PriceDenom: "plum"},
+			expInErr: []string{invalidArgErr, "failed to unmarshal nav record"},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runQueryTestCase(s, testDef, tc)
+		})
+	}
+}
+
+func (s *TestSuite) TestQueryServer_GetNAVHistory() {
+	testDef := queryTestDef[exchange.QueryGetNAVHistoryRequest, exchange.QueryGetNAVHistoryResponse]{
+		queryName: "GetNAVHistory",
+		query:     keeper.NewQueryServer(s.k).GetNAVHistory,
+	}
+	makeKey := func(record exchange.NAVRecord) []byte {
+		key := keeper.MakeKeyNAVRecord(record.Assets.Denom, record.Price.Denom, record.Height, record.MarketId)
+		return key[len(key)-12:]
+	}
+
+	records := []exchange.NAVRecord{
+		s.navRecord(1, 5, "1apple", "2plum"),
+		s.navRecord(2, 5, "1apple", "3plum"),
+		s.navRecord(1, 8, "1apple", "4plum"),
+		s.navRecord(2, 11, "1apple", "5plum"),
+	}
+	setup := func() {
+		s.requireSetNAVRecords(records...)
+		s.requireSetNAVRecords(s.navRecord(1, 9, "1apple", "6pear"))
+	}
+
+	tests := []queryTestCase[exchange.QueryGetNAVHistoryRequest, exchange.QueryGetNAVHistoryResponse]{
+		{
+			name:     "nil req",
+			req:      nil,
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:     "invalid asset denom",
+			req:      &exchange.QueryGetNAVHistoryRequest{PriceDenom: "plum"},
+			expInErr: []string{invalidArgErr, "invalid asset denom: invalid denom: "},
+		},
+		{
+			name:     "invalid price denom",
+			req:      &exchange.QueryGetNAVHistoryRequest{AssetDenom: "apple", PriceDenom: "x"},
+			expInErr: []string{invalidArgErr, "invalid price denom: invalid denom: x"},
+		},
+		{
+			name:     "negative min height",
+			req:      &exchange.QueryGetNAVHistoryRequest{AssetDenom: "apple", PriceDenom: "plum", MinHeight: -1},
+			expInErr: []string{invalidArgErr, "invalid min height -1: cannot be negative"},
+		},
+		{
+			name:     "negative max height",
+			req:      &exchange.QueryGetNAVHistoryRequest{AssetDenom: "apple", PriceDenom: "plum", MaxHeight: -1},
+			expInErr: []string{invalidArgErr, "invalid max height -1: cannot be negative"},
+		},
+		{
+			name:     "max height less than min height",
+			req:      &exchange.QueryGetNAVHistoryRequest{AssetDenom: "apple", PriceDenom: "plum", MinHeight: 5, MaxHeight: 4},
+			expInErr: []string{invalidArgErr, "max height 4 cannot be less than min height 5"},
+		},
+		{
+			name:    "no records for pair",
+			setup:   setup,
+			req:     &exchange.QueryGetNAVHistoryRequest{AssetDenom: "plum", PriceDenom: "apple"},
+			expResp: &exchange.QueryGetNAVHistoryResponse{Pagination: &query.PageResponse{}},
+		},
+		{
+			name:  "all records for pair",
+			setup: setup,
+			req:   &exchange.QueryGetNAVHistoryRequest{AssetDenom: "apple", PriceDenom: "plum"},
+			expResp: &exchange.QueryGetNAVHistoryResponse{
+				Navs:       records,
+				Pagination: &query.PageResponse{Total: 4},
+			},
+		},
+		{
+			name:  "min height",
+			setup: setup,
+			req:   &exchange.QueryGetNAVHistoryRequest{AssetDenom: "apple", PriceDenom: "plum", MinHeight: 8},
+			expResp: &exchange.QueryGetNAVHistoryResponse{
+				Navs:       []exchange.NAVRecord{records[2], records[3]},
+				Pagination: &query.PageResponse{Total: 2},
+			},
+		},
+		{
+			name:  "max height",
+			setup: setup,
+			req:   &exchange.QueryGetNAVHistoryRequest{AssetDenom: "apple", PriceDenom: "plum", MaxHeight: 5},
+			expResp: &exchange.QueryGetNAVHistoryResponse{
+				Navs:       []exchange.NAVRecord{records[0], records[1]},
+				Pagination: &query.PageResponse{Total: 2},
+			},
+		},
+		{
+			name:  "min and max height",
+			setup: setup,
+			req:   &exchange.QueryGetNAVHistoryRequest{AssetDenom: "apple", PriceDenom: "plum", MinHeight: 6, MaxHeight: 10},
+			expResp: &exchange.QueryGetNAVHistoryResponse{
+				Navs:       []exchange.NAVRecord{records[2]},
+				Pagination: &query.PageResponse{Total: 1},
+			},
+		},
+		{
+			name:  "limit 2 offset 1",
+			setup: setup,
+			req: &exchange.QueryGetNAVHistoryRequest{
+				AssetDenom: "apple",
+				PriceDenom: "plum",
+				Pagination: &query.PageRequest{Offset: 1, Limit: 2},
+			},
+			expResp: &exchange.QueryGetNAVHistoryResponse{
+				Navs:       []exchange.NAVRecord{records[1], records[2]},
+				Pagination: &query.PageResponse{NextKey: makeKey(records[3])},
+			},
+		},
+		{
+			name: "bad entry skipped",
+			setup: func() {
+				setup()
+				s.getStore().Set(keeper.MakeKeyNAVRecord("apple", "plum", 6, 1), []byte{9, 9, 9})
+			},
+			req: &exchange.QueryGetNAVHistoryRequest{AssetDenom: "apple", PriceDenom: "plum"},
+			expResp: &exchange.QueryGetNAVHistoryResponse{
+				Navs:       records,
+				Pagination: &query.PageResponse{Total: 4},
+			},
+		},
+		{
+			name:  "reversed",
+			setup: setup,
+			req: &exchange.QueryGetNAVHistoryRequest{
+				AssetDenom: "apple",
+				PriceDenom: "plum",
+				Pagination: &query.PageRequest{Reverse: true},
+			},
+			expResp: &exchange.QueryGetNAVHistoryResponse{
+				Navs:       []exchange.NAVRecord{records[3], records[2], records[1], records[0]},
+				Pagination: &query.PageResponse{Total: 4},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runQueryTestCase(s, testDef, tc)
+		})
+	}
+}
+
+func (s *TestSuite) TestQueryServer_SimulateSettlement() {
+	testDef := queryTestDef[exchange.QuerySimulateSettlementRequest, exchange.QuerySimulateSettlementResponse]{
+		queryName: "SimulateSettlement",
+		query:     keeper.NewQueryServer(s.k).SimulateSettlement,
+	}
+
+	setup := func() {
+		s.requireFundAccount(s.addr1, "10apple")
+		s.requireFundAccount(s.addr2, "102pear")
+		s.requireFundAccount(s.addr3, "20apple,5fig")
+		s.requireFundAccount(s.addr4, "180pear")
+
+		s.requireCreateMarketUnmocked(exchange.Market{
+			MarketId: 1, AcceptingOrders: true,
+			FeeSellerSettlementRatios: s.ratios("100pear:1pear"),
+			AccessGrants:              []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_settle)},
+		})
+		s.requireCreateMarketUnmocked(exchange.Market{
+			MarketId: 2, AcceptingOrders: true, AllowUserSettlement: true,
+			FeeCreateAskFlat:          s.coins("5fig"),
+			FeeSellerSettlementRatios: s.ratios("100pear:1pear"),
+		})
+
+		store := s.getStore()
+		s.requireSetOrderInStore(store, exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+			MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("10apple"), Price: s.coin("75pear"),
+		}))
+		s.requireAddHold(s.addr1, "10apple", 1)
+		s.requireSetOrderInStore(store, exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+			MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("100pear"),
+			BuyerSettlementFees: s.coins("2pear"),
+		}))
+		s.requireAddHold(s.addr2, "102pear", 2)
+		s.requireSetOrderInStore(store, exchange.NewOrder(3).WithBid(&exchange.BidOrder{
+			MarketId: 2, Buyer: s.addr4.String(), Assets: s.coin("10apple"), Price: s.coin("100pear"),
+		}))
+		s.requireAddHold(s.addr4, "100pear", 3)
+		s.requireSetOrderInStore(store, exchange.NewOrder(4).WithAsk(&exchange.AskOrder{
+			MarketId: 2, Seller: s.addr3.String(), Assets: s.coin("10apple"), Price: s.coin("80pear"),
+		}))
+		s.requireAddHold(s.addr3, "10apple", 4)
+	}
+
+	tests := []queryTestCase[exchange.QuerySimulateSettlementRequest, exchange.QuerySimulateSettlementResponse]{
+		{
+			name:     "nil req",
+			req:      nil,
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:     "empty req",
+			req:      &exchange.QuerySimulateSettlementRequest{},
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name: "two requests",
+			req: &exchange.QuerySimulateSettlementRequest{
+				FillBidsRequest: &exchange.MsgFillBidsRequest{},
+				FillAsksRequest: &exchange.MsgFillAsksRequest{},
+			},
+			expInErr: []string{invalidArgErr, "only one request can be simulated at a time"},
+		},
+		{
+			name: "market settle: invalid msg",
+			req: &exchange.QuerySimulateSettlementRequest{MarketSettleRequest: &exchange.MsgMarketSettleRequest{
+				Admin: s.addr5.String(), MarketId: 1, BidOrderIds: []uint64{2},
+			}},
+			expResp: &exchange.QuerySimulateSettlementResponse{Error: "no ask order ids provided"},
+		},
+		{
+			name:  "market settle: no permission",
+			setup: setup,
+			req: &exchange.QuerySimulateSettlementRequest{MarketSettleRequest: &exchange.MsgMarketSettleRequest{
+				Admin: s.addr1.String(), MarketId: 1, AskOrderIds: []uint64{1}, BidOrderIds: []uint64{2},
+			}},
+			expResp: &exchange.QuerySimulateSettlementResponse{
+				Error: "account " + s.addr1.String() + " does not have permission to settle orders for market 1: invalid request",
+			},
+		},
+		{
+			name:  "market settle: okay",
+			setup: setup,
+			req: &exchange.QuerySimulateSettlementRequest{MarketSettleRequest: &exchange.MsgMarketSettleRequest{
+				Admin: s.addr5.String(), MarketId: 1, AskOrderIds: []uint64{1}, BidOrderIds: []uint64{2},
+			}},
+			expResp: &exchange.QuerySimulateSettlementResponse{
+				Fills: []exchange.SettlementFill{
+					{
+						OrderId: 1, OrderType: exchange.OrderTypeAsk, Owner: s.addr1.String(),
+						Assets: s.coin("10apple"), Price: s.coin("100pear"), Fees: s.coins("1pear"),
+					},
+					{
+						OrderId: 2, OrderType: exchange.OrderTypeBid, Owner: s.addr2.String(),
+						Assets: s.coin("10apple"), Price: s.coin("100pear"), Fees: s.coins("2pear"),
+					},
+				},
+				Transfers: []exchange.SettlementTransfer{
+					{
+						Inputs:  []exchange.AccountAmount{{Account: s.addr1.String(), Amount: s.coins("10apple")}},
+						Outputs: []exchange.AccountAmount{{Account: s.addr2.String(), Amount: s.coins("10apple")}},
+					},
+					{
+						Inputs:  []exchange.AccountAmount{{Account: s.addr2.String(), Amount: s.coins("100pear")}},
+						Outputs: []exchange.AccountAmount{{Account: s.addr1.String(), Amount: s.coins("100pear")}},
+					},
+				},
+				FeeInputs: []exchange.AccountAmount{
+					{Account: s.addr1.String(), Amount: s.coins("1pear")},
+					{Account: s.addr2.String(), Amount: s.coins("2pear")},
+				},
+				Navs: []exchange.NetAssetPrice{{Assets: s.coin("10apple"), Price: s.coin("100pear")}},
+			},
+		},
+		{
+			name:  "fill bids: market does not allow user settlement",
+			setup: setup,
+			req: &exchange.QuerySimulateSettlementRequest{FillBidsRequest: &exchange.MsgFillBidsRequest{
+				Seller: s.addr1.String(), MarketId: 1, TotalAssets: s.coins("10apple"), BidOrderIds: []uint64{2},
+			}},
+			expResp: &exchange.QuerySimulateSettlementResponse{Error: "market 1 does not allow user settlement"},
+		},
+		{
+			name:  "fill bids: insufficient funds",
+			setup: setup,
+			req: &exchange.QuerySimulateSettlementRequest{FillBidsRequest: &exchange.MsgFillBidsRequest{
+				Seller: s.addr1.String(), MarketId: 2, TotalAssets: s.coins("10apple"), BidOrderIds: []uint64{3},
+				AskOrderCreationFee: s.coinP("5fig"),
+			}},
+			expResp: &exchange.QuerySimulateSettlementResponse{
+				Error: "spendable balance 0apple is smaller than 10apple: insufficient funds",
+			},
+		},
+		{
+			name:  "fill bids: okay",
+			setup: setup,
+			req: &exchange.QuerySimulateSettlementRequest{FillBidsRequest: &exchange.MsgFillBidsRequest{
+				Seller: s.addr3.String(), MarketId: 2, TotalAssets: s.coins("10apple"), BidOrderIds: []uint64{3},
+				AskOrderCreationFee: s.coinP("5fig"),
+			}},
+			expResp: &exchange.QuerySimulateSettlementResponse{
+				Fills: []exchange.SettlementFill{
+					{
+						OrderId: 3, OrderType: exchange.OrderTypeBid, Owner: s.addr4.String(),
+						Assets: s.coin("10apple"), Price: s.coin("100pear"),
+					},
+				},
+				Transfers: []exchange.SettlementTransfer{
+					{
+						Inputs:  []exchange.AccountAmount{{Account: s.addr3.String(), Amount: s.coins("10apple")}},
+						Outputs: []exchange.AccountAmount{{Account: s.addr4.String(), Amount: s.coins("10apple")}},
+					},
+					{
+						Inputs:  []exchange.AccountAmount{{Account: s.addr4.String(), Amount: s.coins("100pear")}},
+						Outputs: []exchange.AccountAmount{{Account: s.addr3.String(), Amount: s.coins("100pear")}},
+					},
+				},
+				FeeInputs: []exchange.AccountAmount{
+					{Account: s.addr3.String(), Amount: s.coins("1pear")},
+					{Account: s.addr3.String(), Amount: s.coins("5fig")},
+				},
+				Navs: []exchange.NetAssetPrice{{Assets: s.coin("10apple"), Price: s.coin("100pear")}},
+			},
+		},
+		{
+			name: "fill asks: market does not exist",
+			req: &exchange.QuerySimulateSettlementRequest{FillAsksRequest: &exchange.MsgFillAsksRequest{
+				Buyer: s.addr4.String(), MarketId: 9, TotalPrice: s.coin("80pear"), AskOrderIds: []uint64{4},
+			}},
+			expResp: &exchange.QuerySimulateSettlementResponse{Error: "market 9 does not exist"},
+		},
+		{
+			name:  "fill asks: okay",
+			setup: setup,
+			req: &exchange.QuerySimulateSettlementRequest{FillAsksRequest: &exchange.MsgFillAsksRequest{
+				Buyer: s.addr4.String(), MarketId: 2, TotalPrice: s.coin("80pear"), AskOrderIds: []uint64{4},
+			}},
+			expResp: &exchange.QuerySimulateSettlementResponse{
+				Fills: []exchange.SettlementFill{
+					{
+						OrderId: 4, OrderType: exchange.OrderTypeAsk, Owner: s.addr3.String(),
+						Assets: s.coin("10apple"), Price: s.coin("80pear"), Fees: s.coins("1pear"),
+					},
+				},
+				Transfers: []exchange.SettlementTransfer{
+					{
+						Inputs:  []exchange.AccountAmount{{Account: s.addr3.String(), Amount: s.coins("10apple")}},
+						Outputs: []exchange.AccountAmount{{Account: s.addr4.String(), Amount: s.coins("10apple")}},
+					},
+					{
+						Inputs:  []exchange.AccountAmount{{Account: s.addr4.String(), Amount: s.coins("80pear")}},
+						Outputs: []exchange.AccountAmount{{Account: s.addr3.String(), Amount: s.coins("80pear")}},
+					},
+				},
+				FeeInputs: []exchange.AccountAmount{{Account: s.addr3.String(), Amount: s.coins("1pear")}},
+				Navs:      []exchange.NetAssetPrice{{Assets: s.coin("10apple"), Price: s.coin("80pear")}},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runQueryTestCase(s, testDef, tc)
+		})
+	}
+
+	s.Run("state is not changed", func() {
+		origCtx := s.ctx
+		defer func() {
+			s.ctx = origCtx
+		}()
+		s.ctx, _ = s.ctx.CacheContext()
+		setup()
+
+		req := &exchange.QuerySimulateSettlementRequest{MarketSettleRequest: &exchange.MsgMarketSettleRequest{
+			Admin: s.addr5.String(), MarketId: 1, AskOrderIds: []uint64{1}, BidOrderIds: []uint64{2},
+		}}
+		resp, err := testDef.query(s.ctx, req)
+		s.Require().NoError(err, "SimulateSettlement error")
+		s.Require().Empty(resp.Error, "SimulateSettlement response error")
+
+		for _, orderID := range []uint64{1, 2} {
+			order, oerr := s.k.GetOrder(s.ctx, orderID)
+			s.Assert().NoError(oerr, "GetOrder(%d) error", orderID)
+			s.Assert().NotNil(order, "GetOrder(%d) order", orderID)
+		}
+		s.Assert().Equal("10apple", s.app.BankKeeper.GetBalance(s.ctx, s.addr1, "apple").String(), "addr1 apple balance")
+		s.Assert().Equal("102pear", s.app.BankKeeper.GetBalance(s.ctx, s.addr2, "pear").String(), "addr2 pear balance")
+		s.Assert().Empty(s.getAllSettlementRecords(), "settlement records")
+	})
+}
+
+func (s *TestSuite) TestQueryServer_GetCommitment() {
+	testDef := queryTestDef[exchange.QueryGetCommitmentRequest, exchange.QueryGetCommitmentResponse]{
+		queryName: "GetCommitment",
+		query:     keeper.NewQueryServer(s.k).GetCommitment,
+	}
+
+	tests := []queryTestCase[exchange.QueryGetCommitmentRequest, exchange.QueryGetCommitmentResponse]{
+		{
+			name:     "nil req",
+			req:      nil,
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:     "no account",
+			req:      &exchange.QueryGetCommitmentRequest{MarketId: 1},
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:     "no market",
+			req:      &exchange.QueryGetCommitmentRequest{Account: s.addr1.String()},
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:     "invalid account",
+			req:      &exchange.QueryGetCommitmentRequest{Account: "badbadaddr", MarketId: 1},
+			expInErr: []string{invalidArgErr, "invalid account \"badbadaddr\""},
+		},
+		{
+			name:    "nothing committed",
+			req:     &exchange.QueryGetCommitmentRequest{Account: s.addr1.String(), MarketId: 1},
+			expResp: &exchange.QueryGetCommitmentResponse{},
+		},
+		{
+			name: "funds committed",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetCommitmentAmount(store, 1, s.addr1, s.coins("11apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 1, s.addr2, s.coins("12apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 1, s.addr3, s.coins("13apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr1, s.coins("21apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("22apple,157banana,386cherry"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr3, s.coins("23apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, s.addr1, s.coins("31apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, s.addr3, s.coins("33apple"), s.ctx.BlockTime())
+			},
+			req:     &exchange.QueryGetCommitmentRequest{Account: s.addr2.String(), MarketId: 2},
+			expResp: &exchange.QueryGetCommitmentResponse{Amount: s.coins("22apple,157banana,386cherry")},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runQueryTestCase(s, testDef, tc)
+		})
+	}
+}
+
+func (s *TestSuite) TestQueryServer_GetAccountCommitments() {
+	testDef := queryTestDef[exchange.QueryGetAccountCommitmentsRequest, exchange.QueryGetAccountCommitmentsResponse]{
+		queryName: "GetAccountCommitments",
+		query:     keeper.NewQueryServer(s.k).GetAccountCommitments,
+	}
+
+	tests := []queryTestCase[exchange.QueryGetAccountCommitmentsRequest, exchange.QueryGetAccountCommitmentsResponse]{
+		{
+			name:     "nil req",
+			req:      nil,
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:     "no account",
+			req:      &exchange.QueryGetAccountCommitmentsRequest{},
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:     "invalid account",
+			req:      &exchange.QueryGetAccountCommitmentsRequest{Account: "badbadaddr"},
+			expInErr: []string{invalidArgErr, "invalid account \"badbadaddr\""},
+		},
+		{
+			name: "nothing committed",
+			setup: func() {
+				store := s.getStore()
+				s.requireCreateMarket(exchange.Market{MarketId: 1})
+				s.requireCreateMarket(exchange.Market{MarketId: 2})
+				s.requireCreateMarket(exchange.Market{MarketId: 3})
+				keeper.SetCommitmentAmount(store, 1, s.addr1, s.coins("11apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 1, s.addr3, s.coins("13apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr1, s.coins("21apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr3, s.coins("23apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, s.addr1, s.coins("31apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, s.addr3, s.coins("33apple"), s.ctx.BlockTime())
 			},
 			req:     &exchange.QueryGetAccountCommitmentsRequest{Account: s.addr2.String()},
 			expResp: &exchange.QueryGetAccountCommitmentsResponse{},
@@ -3233,15 +5128,15 @@ func (s *TestSuite) TestQueryServer_GetAccountCommitments() {
 				s.requireCreateMarket(exchange.Market{MarketId: 1})
 				s.requireCreateMarket(exchange.Market{MarketId: 2})
 				s.requireCreateMarket(exchange.Market{MarketId: 3})
-				keeper.SetCommitmentAmount(store, 1, s.addr1, s.coins("11apple"))
-				keeper.SetCommitmentAmount(store, 1, s.addr2, s.coins("12apple"))
-				keeper.SetCommitmentAmount(store, 1, s.addr3, s.coins("13apple"))
-				keeper.SetCommitmentAmount(store, 2, s.addr1, s.coins("21apple"))
-				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("22apple,157banana,386cherry"))
-				keeper.SetCommitmentAmount(store, 2, s.addr3, s.coins("23apple"))
-				keeper.SetCommitmentAmount(store, 3, s.addr1, s.coins("31apple"))
-				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32apple"))
-				keeper.SetCommitmentAmount(store, 3, s.addr3, s.coins("33apple"))
+				keeper.SetCommitmentAmount(store, 1, s.addr1, s.coins("11apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 1, s.addr2, s.coins("12apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 1, s.addr3, s.coins("13apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr1, s.coins("21apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("22apple,157banana,386cherry"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr3, s.coins("23apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, s.addr1, s.coins("31apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, s.addr3, s.coins("33apple"), s.ctx.BlockTime())
 			},
 			req: &exchange.QueryGetAccountCommitmentsRequest{Account: s.addr2.String()},
 			expResp: &exchange.QueryGetAccountCommitmentsResponse{
@@ -3259,10 +5154,10 @@ func (s *TestSuite) TestQueryServer_GetAccountCommitments() {
 				s.requireCreateMarket(exchange.Market{MarketId: 1})
 				s.requireCreateMarket(exchange.Market{MarketId: 2})
 				s.requireCreateMarket(exchange.Market{MarketId: 3})
-				keeper.SetCommitmentAmount(store, 1, s.addr1, s.coins("11apple"))
-				keeper.SetCommitmentAmount(store, 1, s.addr2, s.coins("12apple"))
-				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("22apple,157banana,386cherry"))
-				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32apple"))
+				keeper.SetCommitmentAmount(store, 1, s.addr1, s.coins("11apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 1, s.addr2, s.coins("12apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("22apple,157banana,386cherry"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32apple"), s.ctx.BlockTime())
 			},
 			req: &exchange.QueryGetAccountCommitmentsRequest{
 				Account: s.addr2.String(),
@@ -3281,7 +5176,7 @@ func (s *TestSuite) TestQueryServer_GetAccountCommitments() {
 			setup: func() {
 				store := s.getStore()
 				s.requireCreateMarket(exchange.Market{MarketId: 2})
-				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("22apple,157banana,386cherry"))
+				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("22apple,157banana,386cherry"), s.ctx.BlockTime())
 			},
 			req: &exchange.QueryGetAccountCommitmentsRequest{
 				Account: s.addr2.String(),
@@ -3298,7 +5193,7 @@ func (s *TestSuite) TestQueryServer_GetAccountCommitments() {
 			setup: func() {
 				store := s.getStore()
 				s.requireCreateMarket(exchange.Market{MarketId: 2})
-				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("22apple,157banana,386cherry"))
+				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("22apple,157banana,386cherry"), s.ctx.BlockTime())
 			},
 			req: &exchange.QueryGetAccountCommitmentsRequest{
 				Account: s.addr2.String(),
@@ -3345,12 +5240,12 @@ func (s *TestSuite) TestQueryServer_GetMarketCommitments() {
 			name: "nothing committed",
 			setup: func() {
 				store := s.getStore()
-				keeper.SetCommitmentAmount(store, 1, s.addr1, s.coins("11apple"))
-				keeper.SetCommitmentAmount(store, 1, s.addr2, s.coins("12apple"))
-				keeper.SetCommitmentAmount(store, 1, s.addr3, s.coins("13apple"))
-				keeper.SetCommitmentAmount(store, 3, s.addr1, s.coins("31apple"))
-				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32apple"))
-				keeper.SetCommitmentAmount(store, 3, s.addr3, s.coins("33apple"))
+				keeper.SetCommitmentAmount(store, 1, s.addr1, s.coins("11apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 1, s.addr2, s.coins("12apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 1, s.addr3, s.coins("13apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, s.addr1, s.coins("31apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, s.addr3, s.coins("33apple"), s.ctx.BlockTime())
 			},
 			req:     &exchange.QueryGetMarketCommitmentsRequest{MarketId: 2},
 			expResp: &exchange.QueryGetMarketCommitmentsResponse{Pagination: &query.PageResponse{}},
@@ -3359,15 +5254,15 @@ func (s *TestSuite) TestQueryServer_GetMarketCommitments() {
 			name: "funds committed",
 			setup: func() {
 				store := s.getStore()
-				keeper.SetCommitmentAmount(store, 1, s.addr1, s.coins("11apple"))
-				keeper.SetCommitmentAmount(store, 1, s.addr2, s.coins("12apple"))
-				keeper.SetCommitmentAmount(store, 1, s.addr3, s.coins("13apple"))
-				keeper.SetCommitmentAmount(store, 2, s.addr1, s.coins("21apple"))
-				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("22apple,157banana,386cherry"))
-				keeper.SetCommitmentAmount(store, 2, s.addr3, s.coins("23apple"))
-				keeper.SetCommitmentAmount(store, 3, s.addr1, s.coins("31apple"))
-				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32apple"))
-				keeper.SetCommitmentAmount(store, 3, s.addr3, s.coins("33apple"))
+				keeper.SetCommitmentAmount(store, 1, s.addr1, s.coins("11apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 1, s.addr2, s.coins("12apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 1, s.addr3, s.coins("13apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr1, s.coins("21apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("22apple,157banana,386cherry"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr3, s.coins("23apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, s.addr1, s.coins("31apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, s.addr3, s.coins("33apple"), s.ctx.BlockTime())
 			},
 			req: &exchange.QueryGetMarketCommitmentsRequest{MarketId: 2},
 			expResp: &exchange.QueryGetMarketCommitmentsResponse{
@@ -3383,15 +5278,15 @@ func (s *TestSuite) TestQueryServer_GetMarketCommitments() {
 			name: "limit 1 offset 1",
 			setup: func() {
 				store := s.getStore()
-				keeper.SetCommitmentAmount(store, 1, s.addr1, s.coins("11apple"))
-				keeper.SetCommitmentAmount(store, 1, s.addr2, s.coins("12apple"))
-				keeper.SetCommitmentAmount(store, 1, s.addr3, s.coins("13apple"))
-				keeper.SetCommitmentAmount(store, 2, s.addr1, s.coins("21apple"))
-				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("22apple,157banana,386cherry"))
-				keeper.SetCommitmentAmount(store, 2, s.addr3, s.coins("23apple"))
-				keeper.SetCommitmentAmount(store, 3, s.addr1, s.coins("31apple"))
-				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32apple"))
-				keeper.SetCommitmentAmount(store, 3, s.addr3, s.coins("33apple"))
+				keeper.SetCommitmentAmount(store, 1, s.addr1, s.coins("11apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 1, s.addr2, s.coins("12apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 1, s.addr3, s.coins("13apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr1, s.coins("21apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("22apple,157banana,386cherry"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr3, s.coins("23apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, s.addr1, s.coins("31apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, s.addr3, s.coins("33apple"), s.ctx.BlockTime())
 			},
 			req: &exchange.QueryGetMarketCommitmentsRequest{
 				MarketId:   2,
@@ -3408,15 +5303,15 @@ func (s *TestSuite) TestQueryServer_GetMarketCommitments() {
 			name: "reversed",
 			setup: func() {
 				store := s.getStore()
-				keeper.SetCommitmentAmount(store, 1, s.addr1, s.coins("11apple"))
-				keeper.SetCommitmentAmount(store, 1, s.addr2, s.coins("12apple"))
-				keeper.SetCommitmentAmount(store, 1, s.addr3, s.coins("13apple"))
-				keeper.SetCommitmentAmount(store, 2, s.addr1, s.coins("21apple"))
-				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("22apple,157banana,386cherry"))
-				keeper.SetCommitmentAmount(store, 2, s.addr3, s.coins("23apple"))
-				keeper.SetCommitmentAmount(store, 3, s.addr1, s.coins("31apple"))
-				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32apple"))
-				keeper.SetCommitmentAmount(store, 3, s.addr3, s.coins("33apple"))
+				keeper.SetCommitmentAmount(store, 1, s.addr1, s.coins("11apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 1, s.addr2, s.coins("12apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 1, s.addr3, s.coins("13apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr1, s.coins("21apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("22apple,157banana,386cherry"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr3, s.coins("23apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, s.addr1, s.coins("31apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, s.addr3, s.coins("33apple"), s.ctx.BlockTime())
 			},
 			req: &exchange.QueryGetMarketCommitmentsRequest{
 				MarketId:   2,
@@ -3465,15 +5360,15 @@ func (s *TestSuite) TestQueryServer_GetAllCommitments() {
 			name: "funds committed",
 			setup: func() {
 				store := s.getStore()
-				keeper.SetCommitmentAmount(store, 1, s.addr1, s.coins("11apple"))
-				keeper.SetCommitmentAmount(store, 1, s.addr2, s.coins("12apple"))
-				keeper.SetCommitmentAmount(store, 1, s.addr3, s.coins("13apple"))
-				keeper.SetCommitmentAmount(store, 2, s.addr1, s.coins("21apple"))
-				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("22apple,157banana,386cherry"))
-				keeper.SetCommitmentAmount(store, 2, s.addr3, s.coins("23apple"))
-				keeper.SetCommitmentAmount(store, 3, s.addr1, s.coins("31apple"))
-				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32apple"))
-				keeper.SetCommitmentAmount(store, 3, s.addr3, s.coins("33apple"))
+				keeper.SetCommitmentAmount(store, 1, s.addr1, s.coins("11apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 1, s.addr2, s.coins("12apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 1, s.addr3, s.coins("13apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr1, s.coins("21apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("22apple,157banana,386cherry"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr3, s.coins("23apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, s.addr1, s.coins("31apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, s.addr3, s.coins("33apple"), s.ctx.BlockTime())
 			},
 			req: &exchange.QueryGetAllCommitmentsRequest{},
 			expResp: &exchange.QueryGetAllCommitmentsResponse{
@@ -3495,15 +5390,15 @@ func (s *TestSuite) TestQueryServer_GetAllCommitments() {
 			name: "limit 3 offset 2 reversed",
 			setup: func() {
 				store := s.getStore()
-				keeper.SetCommitmentAmount(store, 1, s.addr1, s.coins("11apple"))
-				keeper.SetCommitmentAmount(store, 1, s.addr2, s.coins("12apple"))
-				keeper.SetCommitmentAmount(store, 1, s.addr3, s.coins("13apple"))
-				keeper.SetCommitmentAmount(store, 2, s.addr1, s.coins("21apple"))
-				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("22apple,157banana,386cherry"))
-				keeper.SetCommitmentAmount(store, 2, s.addr3, s.coins("23apple"))
-				keeper.SetCommitmentAmount(store, 3, s.addr1, s.coins("31apple"))
-				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32apple"))
-				keeper.SetCommitmentAmount(store, 3, s.addr3, s.coins("33apple"))
+				keeper.SetCommitmentAmount(store, 1, s.addr1, s.coins("11apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 1, s.addr2, s.coins("12apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 1, s.addr3, s.coins("13apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr1, s.coins("21apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("22apple,157banana,386cherry"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr3, s.coins("23apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, s.addr1, s.coins("31apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, s.addr3, s.coins("33apple"), s.ctx.BlockTime())
 			},
 			req: &exchange.QueryGetAllCommitmentsRequest{Pagination: &query.PageRequest{Limit: 3, Offset: 2, Reverse: true}},
 			expResp: &exchange.QueryGetAllCommitmentsResponse{
@@ -3519,15 +5414,15 @@ func (s *TestSuite) TestQueryServer_GetAllCommitments() {
 			name: "using next key",
 			setup: func() {
 				store := s.getStore()
-				keeper.SetCommitmentAmount(store, 1, s.addr1, s.coins("11apple"))
-				keeper.SetCommitmentAmount(store, 1, s.addr2, s.coins("12apple"))
-				keeper.SetCommitmentAmount(store, 1, s.addr3, s.coins("13apple"))
-				keeper.SetCommitmentAmount(store, 2, s.addr1, s.coins("21apple"))
-				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("22apple,157banana,386cherry"))
-				keeper.SetCommitmentAmount(store, 2, s.addr3, s.coins("23apple"))
-				keeper.SetCommitmentAmount(store, 3, s.addr1, s.coins("31apple"))
-				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32apple"))
-				keeper.SetCommitmentAmount(store, 3, s.addr3, s.coins("33apple"))
+				keeper.SetCommitmentAmount(store, 1, s.addr1, s.coins("11apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 1, s.addr2, s.coins("12apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 1, s.addr3, s.coins("13apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr1, s.coins("21apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("22apple,157banana,386cherry"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 2, s.addr3, s.coins("23apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, s.addr1, s.coins("31apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, s.addr3, s.coins("33apple"), s.ctx.BlockTime())
 			},
 			req: &exchange.QueryGetAllCommitmentsRequest{Pagination: &query.PageRequest{Key: makeKey(3, s.addr2)}},
 			expResp: &exchange.QueryGetAllCommitmentsResponse{
@@ -3649,11 +5544,208 @@ func (s *TestSuite) TestQueryServer_GetMarket() {
 					ReqAttrCreateAsk: []string{"ask.good.kyc", "*.my.custom"},
 					ReqAttrCreateBid: []string{"bid.good.kyc", "*.my.custom"},
 
-					AcceptingCommitments:     true,
-					FeeCreateCommitmentFlat:  s.coins("30fig,300grape"),
-					CommitmentSettlementBips: 23,
-					IntermediaryDenom:        "cherry",
-					ReqAttrCreateCommitment:  []string{"commitment.good.kyc", "*.my.custom"},
+					AcceptingCommitments:     true,
+					FeeCreateCommitmentFlat:  s.coins("30fig,300grape"),
+					CommitmentSettlementBips: 23,
+					IntermediaryDenom:        "cherry",
+					ReqAttrCreateCommitment:  []string{"commitment.good.kyc", "*.my.custom"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runQueryTestCase(s, testDef, tc)
+		})
+	}
+}
+
+func (s *TestSuite) TestQueryServer_GetScheduledFeeChanges() {
+	testDef := queryTestDef[exchange.QueryGetScheduledFeeChangesRequest, exchange.QueryGetScheduledFeeChangesResponse]{
+		queryName: "GetScheduledFeeChanges",
+		query:     keeper.NewQueryServer(s.k).GetScheduledFeeChanges,
+	}
+	effTime := time.Unix(1_800_000_000, 0).UTC()
+	setup := func() {
+		s.requireAddScheduledFeeChanges(
+			s.feeChangeAtHeight(1, 11, 200),
+			s.feeChangeAtTime(2, 21, effTime),
+			s.feeChangeAtHeight(2, 22, 150),
+		)
+	}
+
+	tests := []queryTestCase[exchange.QueryGetScheduledFeeChangesRequest, exchange.QueryGetScheduledFeeChangesResponse]{
+		{
+			name:     "nil req",
+			req:      nil,
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:     "market zero",
+			req:      &exchange.QueryGetScheduledFeeChangesRequest{MarketId: 0},
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:    "nothing scheduled",
+			setup:   setup,
+			req:     &exchange.QueryGetScheduledFeeChangesRequest{MarketId: 3},
+			expResp: &exchange.QueryGetScheduledFeeChangesResponse{},
+		},
+		{
+			name:  "two scheduled",
+			setup: setup,
+			req:   &exchange.QueryGetScheduledFeeChangesRequest{MarketId: 2},
+			expResp: &exchange.QueryGetScheduledFeeChangesResponse{
+				ScheduledFeeChanges: []exchange.MsgGovManageFeesRequest{
+					s.feeChangeAtTime(2, 21, effTime),
+					s.feeChangeAtHeight(2, 22, 150),
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runQueryTestCase(s, testDef, tc)
+		})
+	}
+}
+
+func (s *TestSuite) TestQueryServer_GetMarketAuthorityTransfer() {
+	testDef := queryTestDef[exchange.QueryGetMarketAuthorityTransferRequest, exchange.QueryGetMarketAuthorityTransferResponse]{
+		queryName: "GetMarketAuthorityTransfer",
+		query:     keeper.NewQueryServer(s.k).GetMarketAuthorityTransfer,
+	}
+	transfer := &exchange.MarketAuthorityTransfer{
+		MarketId:     2,
+		ProposedBy:   s.addr5.String(),
+		NewAuthority: s.addr1.String(),
+		AccessGrants: []exchange.AccessGrant{s.agCanEverything(s.addr1)},
+	}
+	setup := func() {
+		s.requireCreateMarketUnmocked(exchange.Market{MarketId: 1})
+		s.requireCreateMarketUnmocked(exchange.Market{MarketId: 2})
+		s.Require().NoError(s.k.ProposeAuthorityTransfer(s.ctx, transfer), "ProposeAuthorityTransfer")
+	}
+
+	tests := []queryTestCase[exchange.QueryGetMarketAuthorityTransferRequest, exchange.QueryGetMarketAuthorityTransferResponse]{
+		{
+			name:     "nil req",
+			req:      nil,
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:     "market zero",
+			req:      &exchange.QueryGetMarketAuthorityTransferRequest{MarketId: 0},
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:     "no pending transfer",
+			setup:    setup,
+			req:      &exchange.QueryGetMarketAuthorityTransferRequest{MarketId: 1},
+			expInErr: []string{invalidArgErr, "market 1 does not have a pending authority transfer"},
+		},
+		{
+			name:    "pending transfer",
+			setup:   setup,
+			req:     &exchange.QueryGetMarketAuthorityTransferRequest{MarketId: 2},
+			expResp: &exchange.QueryGetMarketAuthorityTransferResponse{AuthorityTransfer: transfer},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runQueryTestCase(s, testDef, tc)
+		})
+	}
+}
+
+func (s *TestSuite) TestQueryServer_ExportMarket() {
+	testDef := queryTestDef[exchange.QueryExportMarketRequest, exchange.QueryExportMarketResponse]{
+		queryName: "ExportMarket",
+		query:     keeper.NewQueryServer(s.k).ExportMarket,
+	}
+	market2 := exchange.Market{
+		MarketId:                  2,
+		MarketDetails:             exchange.MarketDetails{Name: "Market Two"},
+		FeeCreateAskFlat:          s.coins("10fig"),
+		FeeSellerSettlementRatios: s.ratios("100pineapple:1pineapple"),
+		AcceptingOrders:           true,
+		AcceptingCommitments:      true,
+		AccessGrants:              []exchange.AccessGrant{s.agCanEverything(s.addr1)},
+	}
+	askOrder := func(orderID uint64, marketID uint32, seller sdk.AccAddress) *exchange.Order {
+		return exchange.NewOrder(orderID).WithAsk(&exchange.AskOrder{
+			MarketId: marketID, Seller: seller.String(), Assets: s.coin("10apple"), Price: s.coin("20pineapple"),
+		})
+	}
+	bidOrder := func(orderID uint64, marketID uint32, buyer sdk.AccAddress) *exchange.Order {
+		return exchange.NewOrder(orderID).WithBid(&exchange.BidOrder{
+			MarketId: marketID, Buyer: buyer.String(), Assets: s.coin("10apple"), Price: s.coin("20pineapple"),
+		})
+	}
+	setup := func() {
+		s.requireCreateMarketUnmocked(exchange.Market{MarketId: 1})
+		s.requireCreateMarketUnmocked(market2)
+		s.requireCreateMarketUnmocked(exchange.Market{MarketId: 3})
+		s.requireAddScheduledFeeChanges(
+			s.feeChangeAtHeight(1, 11, 200),
+			s.feeChangeAtHeight(2, 21, 150),
+		)
+		store := s.getStore()
+		s.requireSetOrdersInStore(store,
+			askOrder(1, 1, s.addr1),
+			askOrder(2, 2, s.addr2),
+			bidOrder(3, 1, s.addr3),
+			bidOrder(4, 2, s.addr4),
+		)
+		keeper.SetCommitmentAmount(store, 1, s.addr1, s.coins("5apple"), s.ctx.BlockTime())
+		keeper.SetCommitmentAmount(store, 2, s.addr2, s.coins("7apple"), s.ctx.BlockTime())
+		keeper.SetCommitmentAmount(store, 2, s.addr3, s.coins("9pineapple"), s.ctx.BlockTime())
+		s.ctx = s.ctx.WithBlockHeight(88)
+	}
+
+	tests := []queryTestCase[exchange.QueryExportMarketRequest, exchange.QueryExportMarketResponse]{
+		{
+			name:     "nil req",
+			req:      nil,
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:     "market zero",
+			req:      &exchange.QueryExportMarketRequest{MarketId: 0},
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:     "market does not exist",
+			setup:    setup,
+			req:      &exchange.QueryExportMarketRequest{MarketId: 4},
+			expInErr: []string{invalidArgErr, "market 4 not found"},
+		},
+		{
+			name:  "market without orders or commitments",
+			setup: setup,
+			req:   &exchange.QueryExportMarketRequest{MarketId: 3},
+			expResp: &exchange.QueryExportMarketResponse{
+				Height:  88,
+				Address: exchange.GetMarketAddress(3).String(),
+				Market:  &exchange.Market{MarketId: 3},
+			},
+		},
+		{
+			name:  "market with everything",
+			setup: setup,
+			req:   &exchange.QueryExportMarketRequest{MarketId: 2},
+			expResp: &exchange.QueryExportMarketResponse{
+				Height:              88,
+				Address:             exchange.GetMarketAddress(2).String(),
+				Market:              &market2,
+				ScheduledFeeChanges: []exchange.MsgGovManageFeesRequest{s.feeChangeAtHeight(2, 21, 150)},
+				Orders:              []*exchange.Order{askOrder(2, 2, s.addr2), bidOrder(4, 2, s.addr4)},
+				Commitments: []*exchange.AccountAmount{
+					{Account: s.addr2.String(), Amount: s.coins("7apple")},
+					{Account: s.addr3.String(), Amount: s.coins("9pineapple")},
 				},
 			},
 		},
@@ -4454,6 +6546,69 @@ func (s *TestSuite) TestQueryServer_ValidateManageFees() {
 				),
 			},
 		},
+		{
+			name: "add/rem seller rebate ratio errors",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId:                        7,
+					FeeSellerSettlementRebateRatios: s.ratios("100pear:1pear"),
+				})
+			},
+			req: &exchange.QueryValidateManageFeesRequest{ManageFeesRequest: &exchange.MsgGovManageFeesRequest{
+				Authority: s.k.GetAuthority(), MarketId: 7,
+				RemoveFeeSellerSettlementRebateRatios: s.ratios("100prune:1prune"),
+				AddFeeSellerSettlementRebateRatios:    s.ratios("90pear:1pear"),
+			}},
+			expResp: &exchange.QueryValidateManageFeesResponse{
+				GovPropWillPass: true,
+				Error: s.joinErrs(
+					"cannot remove seller settlement rebate ratio fee \"100prune:1prune\": no such ratio exists",
+					"cannot add seller settlement rebate ratio fee \"90pear:1pear\": ratio with those denoms already exists",
+				),
+			},
+		},
+		{
+			name: "add/rem rebate address errors",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId:        7,
+					RebateAddresses: []string{s.addr1.String()},
+				})
+			},
+			req: &exchange.QueryValidateManageFeesRequest{ManageFeesRequest: &exchange.MsgGovManageFeesRequest{
+				Authority: s.k.GetAuthority(), MarketId: 7,
+				RemoveRebateAddresses: []string{s.addr2.String()},
+				AddRebateAddresses:    []string{s.addr1.String()},
+			}},
+			expResp: &exchange.QueryValidateManageFeesResponse{
+				GovPropWillPass: true,
+				Error: s.joinErrs(
+					"cannot remove rebate address \""+s.addr2.String()+"\": address not currently present",
+					"cannot add rebate address \""+s.addr1.String()+"\": address already present",
+				),
+			},
+		},
+		{
+			name: "add/rem referral cap errors",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId:    7,
+					ReferralCap: s.coins("10pear"),
+				})
+			},
+			req: &exchange.QueryValidateManageFeesRequest{ManageFeesRequest: &exchange.MsgGovManageFeesRequest{
+				Authority: s.k.GetAuthority(), MarketId: 7,
+				RemoveReferralCap: s.coins("10prune"),
+				AddReferralCap:    s.coins("5pear"),
+			}},
+			expResp: &exchange.QueryValidateManageFeesResponse{
+				GovPropWillPass: true,
+				Error: s.joinErrs(
+					"cannot remove referral cap flat fee \"10prune\": no such fee exists",
+					"cannot add referral cap flat fee \"5pear\": fee with that denom already exists",
+				),
+			},
+		},
 		{
 			name: "add/rem buyer flat errors",
 			setup: func() {
@@ -4888,6 +7043,101 @@ func (s *TestSuite) TestQueryServer_GetPaymentsWithSource() {
 				},
 			},
 		},
+		{
+			name: "invalid filter",
+			req: &exchange.QueryGetPaymentsWithSourceRequest{
+				Source: s.addr2.String(),
+				Filter: &exchange.PaymentFilter{MinAmount: "5"},
+			},
+			expInErr: []string{invalidArgErr, "invalid filter: a denom is required in order to filter by amount"},
+		},
+		{
+			name: "filter by denom",
+			setup: func() {
+				s.requireSetPaymentsInStore(
+					s.newTestPayment(s.addr2, "4strawberry,2starfruit", nil, "1tomato", "moveit"),
+					s.newTestPayment(s.addr2, "3strawberry", s.addr1, "", ""),
+					s.newTestPayment(s.addr2, "10starfruit", s.addr3, "", "zlastone"),
+					s.newTestPayment(s.addr3, "6strawberry", s.addr2, "", "other"),
+				)
+			},
+			req: &exchange.QueryGetPaymentsWithSourceRequest{
+				Source: s.addr2.String(),
+				Filter: &exchange.PaymentFilter{Denom: "strawberry"},
+			},
+			expResp: &exchange.QueryGetPaymentsWithSourceResponse{
+				Payments: []*exchange.Payment{
+					s.newTestPayment(s.addr2, "3strawberry", s.addr1, "", ""),
+					s.newTestPayment(s.addr2, "4strawberry,2starfruit", nil, "1tomato", "moveit"),
+				},
+				Pagination: &query.PageResponse{Total: 2},
+			},
+		},
+		{
+			name: "filter by denom with min and max",
+			setup: func() {
+				s.requireSetPaymentsInStore(
+					s.newTestPayment(s.addr2, "4strawberry,2starfruit", nil, "1tomato", "moveit"),
+					s.newTestPayment(s.addr2, "3strawberry", s.addr1, "", ""),
+					s.newTestPayment(s.addr2, "10starfruit", s.addr3, "", "zlastone"),
+					s.newTestPayment(s.addr2, "6strawberry", s.addr4, "", "toomuch"),
+				)
+			},
+			req: &exchange.QueryGetPaymentsWithSourceRequest{
+				Source: s.addr2.String(),
+				Filter: &exchange.PaymentFilter{Denom: "strawberry", MinAmount: "4", MaxAmount: "5"},
+			},
+			expResp: &exchange.QueryGetPaymentsWithSourceResponse{
+				Payments: []*exchange.Payment{
+					s.newTestPayment(s.addr2, "4strawberry,2starfruit", nil, "1tomato", "moveit"),
+				},
+				Pagination: &query.PageResponse{Total: 1},
+			},
+		},
+		{
+			name: "filter by target amount present",
+			setup: func() {
+				s.requireSetPaymentsInStore(
+					s.newTestPayment(s.addr2, "4strawberry,2starfruit", nil, "1tomato", "moveit"),
+					s.newTestPayment(s.addr2, "3strawberry", s.addr1, "", ""),
+					s.newTestPayment(s.addr2, "10starfruit", s.addr3, "", "zlastone"),
+					s.newTestPayment(s.addr3, "6strawberry", s.addr2, "8tomato", "other"),
+				)
+			},
+			req: &exchange.QueryGetPaymentsWithSourceRequest{
+				Source: s.addr2.String(),
+				Filter: &exchange.PaymentFilter{TargetAmount: exchange.TargetAmountFilter_present},
+			},
+			expResp: &exchange.QueryGetPaymentsWithSourceResponse{
+				Payments: []*exchange.Payment{
+					s.newTestPayment(s.addr2, "4strawberry,2starfruit", nil, "1tomato", "moveit"),
+				},
+				Pagination: &query.PageResponse{Total: 1},
+			},
+		},
+		{
+			name: "filter by target amount absent: limit 1",
+			setup: func() {
+				s.requireSetPaymentsInStore(
+					s.newTestPayment(s.addr2, "4strawberry,2starfruit", nil, "1tomato", "moveit"),
+					s.newTestPayment(s.addr2, "3strawberry", s.addr1, "", ""),
+					s.newTestPayment(s.addr2, "10starfruit", s.addr3, "", "zlastone"),
+				)
+			},
+			req: &exchange.QueryGetPaymentsWithSourceRequest{
+				Source:     s.addr2.String(),
+				Filter:     &exchange.PaymentFilter{TargetAmount: exchange.TargetAmountFilter_absent},
+				Pagination: &query.PageRequest{Limit: 1},
+			},
+			expResp: &exchange.QueryGetPaymentsWithSourceResponse{
+				Payments: []*exchange.Payment{
+					s.newTestPayment(s.addr2, "3strawberry", s.addr1, "", ""),
+				},
+				Pagination: &query.PageResponse{
+					NextKey: concatBz(address.MustLengthPrefix(s.addr2), []byte("zlastone")),
+				},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -5000,6 +7250,78 @@ func (s *TestSuite) TestQueryServer_GetPaymentsWithTarget() {
 				},
 			},
 		},
+		{
+			name: "invalid filter",
+			req: &exchange.QueryGetPaymentsWithTargetRequest{
+				Target: s.addr3.String(),
+				Filter: &exchange.PaymentFilter{Denom: "strawberry", TargetAmount: 5},
+			},
+			expInErr: []string{invalidArgErr, "invalid filter: unknown target amount filter 5"},
+		},
+		{
+			name: "filter by denom with min",
+			setup: func() {
+				s.requireSetPaymentsInStore(
+					s.newTestPayment(s.addr1, "3strawberry", s.addr3, "", ""),
+					s.newTestPayment(s.addr2, "5strawberry,1starfruit", s.addr3, "", "zlastone"),
+					s.newTestPayment(s.addr2, "4strawberry", s.addr3, "1tomato", "moveit"),
+					s.newTestPayment(s.addr3, "9strawberry", s.addr4, "", "fromtarget"),
+				)
+			},
+			req: &exchange.QueryGetPaymentsWithTargetRequest{
+				Target: s.addr3.String(),
+				Filter: &exchange.PaymentFilter{Denom: "strawberry", MinAmount: "4"},
+			},
+			expResp: &exchange.QueryGetPaymentsWithTargetResponse{
+				Payments: []*exchange.Payment{
+					s.newTestPayment(s.addr2, "4strawberry", s.addr3, "1tomato", "moveit"),
+					s.newTestPayment(s.addr2, "5strawberry,1starfruit", s.addr3, "", "zlastone"),
+				},
+				Pagination: &query.PageResponse{Total: 2},
+			},
+		},
+		{
+			name: "filter by target amount absent",
+			setup: func() {
+				s.requireSetPaymentsInStore(
+					s.newTestPayment(s.addr1, "3strawberry", s.addr3, "", ""),
+					s.newTestPayment(s.addr2, "5strawberry,1starfruit", s.addr3, "", "zlastone"),
+					s.newTestPayment(s.addr2, "4strawberry", s.addr3, "1tomato", "moveit"),
+					s.newTestPayment(s.addr3, "9strawberry", s.addr4, "", "fromtarget"),
+				)
+			},
+			req: &exchange.QueryGetPaymentsWithTargetRequest{
+				Target: s.addr3.String(),
+				Filter: &exchange.PaymentFilter{TargetAmount: exchange.TargetAmountFilter_absent},
+			},
+			expResp: &exchange.QueryGetPaymentsWithTargetResponse{
+				Payments: []*exchange.Payment{
+					s.newTestPayment(s.addr1, "3strawberry", s.addr3, "", ""),
+					s.newTestPayment(s.addr2, "5strawberry,1starfruit", s.addr3, "", "zlastone"),
+				},
+				Pagination: &query.PageResponse{Total: 2},
+			},
+		},
+		{
+			name: "filter by denom and target amount absent",
+			setup: func() {
+				s.requireSetPaymentsInStore(
+					s.newTestPayment(s.addr1, "3strawberry", s.addr3, "", ""),
+					s.newTestPayment(s.addr2, "5strawberry,1starfruit", s.addr3, "", "zlastone"),
+					s.newTestPayment(s.addr2, "4strawberry,2starfruit", s.addr3, "1tomato", "moveit"),
+				)
+			},
+			req: &exchange.QueryGetPaymentsWithTargetRequest{
+				Target: s.addr3.String(),
+				Filter: &exchange.PaymentFilter{Denom: "starfruit", TargetAmount: exchange.TargetAmountFilter_absent},
+			},
+			expResp: &exchange.QueryGetPaymentsWithTargetResponse{
+				Payments: []*exchange.Payment{
+					s.newTestPayment(s.addr2, "5strawberry,1starfruit", s.addr3, "", "zlastone"),
+				},
+				Pagination: &query.PageResponse{Total: 1},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -5129,3 +7451,125 @@ func (s *TestSuite) TestQueryServer_PaymentFeeCalc() {
 		})
 	}
 }
+
+func (s *TestSuite) TestQueryServer_GetAccountActivitySummary() {
+	testDef := queryTestDef[exchange.QueryGetAccountActivitySummaryRequest, exchange.QueryGetAccountActivitySummaryResponse]{
+		queryName: "GetAccountActivitySummary",
+		query:     keeper.NewQueryServer(s.k).GetAccountActivitySummary,
+	}
+
+	askOrder := func(orderID uint64, marketID uint32, seller sdk.AccAddress, assets, price string, fee string) *exchange.Order {
+		rv := &exchange.AskOrder{
+			MarketId: marketID,
+			Seller:   seller.String(),
+			Assets:   s.coin(assets),
+			Price:    s.coin(price),
+		}
+		if len(fee) > 0 {
+			rv.SellerSettlementFlatFee = s.coinP(fee)
+		}
+		return exchange.NewOrder(orderID).WithAsk(rv)
+	}
+	bidOrder := func(orderID uint64, marketID uint32, buyer sdk.AccAddress, assets, price string, fees string) *exchange.Order {
+		return exchange.NewOrder(orderID).WithBid(&exchange.BidOrder{
+			MarketId:            marketID,
+			Buyer:               buyer.String(),
+			Assets:              s.coin(assets),
+			Price:               s.coin(price),
+			BuyerSettlementFees: s.coins(fees),
+		})
+	}
+
+	tests := []queryTestCase[exchange.QueryGetAccountActivitySummaryRequest, exchange.QueryGetAccountActivitySummaryResponse]{
+		{
+			name:     "nil req",
+			req:      nil,
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:     "no account",
+			req:      &exchange.QueryGetAccountActivitySummaryRequest{},
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:     "invalid account",
+			req:      &exchange.QueryGetAccountActivitySummaryRequest{Account: "badbadaddr"},
+			expInErr: []string{invalidArgErr, "invalid account \"badbadaddr\""},
+		},
+		{
+			name: "no activity",
+			setup: func() {
+				store := s.getStore()
+				s.requireCreateMarket(exchange.Market{MarketId: 1})
+				keeper.SetCommitmentAmount(store, 1, s.addr1, s.coins("11apple"), s.ctx.BlockTime())
+				s.requireSetOrderInStore(store, askOrder(1, 1, s.addr1, "10apple", "50plum", ""))
+				s.requireSetPaymentsInStore(s.newTestPayment(s.addr1, "5apple", s.addr3, "", "one"))
+			},
+			req:     &exchange.QueryGetAccountActivitySummaryRequest{Account: s.addr2.String()},
+			expResp: &exchange.QueryGetAccountActivitySummaryResponse{},
+		},
+		{
+			name: "lots of activity",
+			setup: func() {
+				store := s.getStore()
+				s.requireCreateMarket(exchange.Market{MarketId: 1})
+				s.requireCreateMarket(exchange.Market{MarketId: 2})
+				s.requireCreateMarket(exchange.Market{MarketId: 3})
+				keeper.SetCommitmentAmount(store, 1, s.addr1, s.coins("11apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 1, s.addr2, s.coins("12apple"), s.ctx.BlockTime())
+				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32apple,7banana"), s.ctx.BlockTime())
+
+				iceberg := bidOrder(6, 3, s.addr2, "100apple", "400plum", "")
+				iceberg.GetBidOrder().DisplayAssets = s.coinP("25apple")
+				for _, order := range []*exchange.Order{
+					askOrder(1, 2, s.addr2, "10apple", "50plum", "3banana"),
+					askOrder(2, 2, s.addr1, "10apple", "50plum", ""),
+					bidOrder(3, 2, s.addr2, "4apple", "20plum", "1plum"),
+					askOrder(4, 2, s.addr2, "5apple", "30plum", "1plum"),
+					bidOrder(5, 1, s.addr2, "7cherry", "14plum", ""),
+					iceberg,
+				} {
+					s.requireSetOrderInStore(store, order)
+				}
+
+				s.requireSetPaymentsInStore(
+					s.newTestPayment(s.addr2, "5apple", s.addr3, "1plum", "out1"),
+					s.newTestPayment(s.addr2, "6banana", nil, "", "out2"),
+					s.newTestPayment(s.addr1, "8apple", s.addr2, "2plum", "in1"),
+					s.newTestPayment(s.addr3, "9cherry", s.addr2, "", "in2"),
+					s.newTestPayment(s.addr3, "10cherry", s.addr1, "", "other"),
+				)
+			},
+			req: &exchange.QueryGetAccountActivitySummaryRequest{Account: s.addr2.String()},
+			expResp: &exchange.QueryGetAccountActivitySummaryResponse{
+				Orders: []exchange.MarketOrdersSummary{
+					{MarketId: 1, BidOrderCount: 1, BidAssets: s.coins("7cherry"), BidPrice: s.coins("14plum")},
+					{
+						MarketId:      2,
+						AskOrderCount: 2, AskAssets: s.coins("15apple"), AskPrice: s.coins("80plum"),
+						BidOrderCount: 1, BidAssets: s.coins("4apple"), BidPrice: s.coins("20plum"),
+					},
+					{MarketId: 3, BidOrderCount: 1, BidAssets: s.coins("25apple"), BidPrice: s.coins("100plum")},
+				},
+				Commitments: []*exchange.MarketAmount{
+					{MarketId: 1, Amount: s.coins("12apple")},
+					{MarketId: 3, Amount: s.coins("32apple,7banana")},
+				},
+				OutgoingPayments: exchange.PaymentsSummary{
+					PaymentCount: 2, SourceAmount: s.coins("5apple,6banana"), TargetAmount: s.coins("1plum"),
+				},
+				IncomingPayments: exchange.PaymentsSummary{
+					PaymentCount: 2, SourceAmount: s.coins("8apple,9cherry"), TargetAmount: s.coins("2plum"),
+				},
+				// Orders: 15apple,3banana,435plum; Commitments: 44apple,7banana; Payments: 5apple,6banana.
+				OnHold: s.coins("64apple,16banana,435plum"),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runQueryTestCase(s, testDef, tc)
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/internal/fieldmask"
+	"github.com/provenance-io/provenance/internal/pagination"
+	"github.com/provenance-io/provenance/x/exchange"
+	exchangev2 "github.com/provenance-io/provenance/x/exchange/v2"
+)
+
+// QueryServerV2 is an alias for a Keeper that implements the exchangev2.QueryServer interface.
+type QueryServerV2 struct {
+	Keeper
+}
+
+func NewQueryServerV2(k Keeper) exchangev2.QueryServer {
+	return QueryServerV2{Keeper: k}
+}
+
+var _ exchangev2.QueryServer = QueryServerV2{}
+
+// Markets gets all the markets.
+func (k QueryServerV2) Markets(goCtx context.Context, req *exchangev2.QueryMarketsRequest) (*exchangev2.QueryMarketsResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "v2", "Markets")
+	if req == nil {
+		req = &exchangev2.QueryMarketsRequest{}
+	}
+	mask, err := fieldmask.Parse(req.FieldMask, &exchange.Market{})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid field mask: %v", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(k.getStore(ctx), GetKeyPrefixKnownMarketID())
+	resp := &exchangev2.QueryMarketsResponse{}
+	var pageErr error
+	resp.Pagination, pageErr = pagination.Paginate(store, req.Pagination, req.Order == exchangev2.Order_ORDER_DESCENDING,
+		func(key []byte, _ []byte) (bool, error) {
+			// If we can't get the market id from the key, or can't read the market, just pretend like it doesn't exist.
+			marketID, ok := ParseKeySuffixKnownMarketID(key)
+			if !ok {
+				return false, nil
+			}
+			market := k.GetMarket(ctx, marketID)
+			if market == nil {
+				return false, nil
+			}
+			mask.Apply(market)
+			resp.Markets = append(resp.Markets, market)
+			return true, nil
+		})
+
+	if pageErr != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error iterating all known markets: %v", pageErr)
+	}
+
+	return resp, nil
+}
+
+// Orders gets all the orders.
+func (k QueryServerV2) Orders(goCtx context.Context, req *exchangev2.QueryOrdersRequest) (*exchangev2.QueryOrdersResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "query", "v2", "Orders")
+	if req == nil {
+		req = &exchangev2.QueryOrdersRequest{}
+	}
+	mask, err := fieldmask.Parse(req.FieldMask, &exchange.Order{})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid field mask: %v", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(k.getStore(ctx), GetKeyPrefixOrder())
+	resp := &exchangev2.QueryOrdersResponse{}
+	var pageErr error
+	resp.Pagination, pageErr = pagination.Paginate(store, req.Pagination, req.Order == exchangev2.Order_ORDER_DESCENDING,
+		func(key []byte, value []byte) (bool, error) {
+			// If we can't get the order id from the key, or can't read the order, just pretend like it doesn't exist.
+			orderID, ok := ParseKeyOrder(key)
+			if !ok {
+				return false, nil
+			}
+			order, oerr := k.parseOrderStoreValue(orderID, value)
+			if oerr != nil || order == nil {
+				return false, nil
+			}
+			mask.Apply(order)
+			resp.Orders = append(resp.Orders, order)
+			return true, nil
+		})
+
+	if pageErr != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error iterating all orders: %v", pageErr)
+	}
+
+	return resp, nil
+}
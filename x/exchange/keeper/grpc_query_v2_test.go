@@ -0,0 +1,242 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	gogotypes "github.com/cosmos/gogoproto/types"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/provenance-io/provenance/x/exchange"
+	"github.com/provenance-io/provenance/x/exchange/keeper"
+	exchangev2 "github.com/provenance-io/provenance/x/exchange/v2"
+)
+
+func (s *TestSuite) TestQueryServerV2_Markets() {
+	testDef := queryTestDef[exchangev2.QueryMarketsRequest, exchangev2.QueryMarketsResponse]{
+		queryName: "Markets",
+		query:     keeper.NewQueryServerV2(s.k).Markets,
+		followup: func(expected, actual *exchangev2.QueryMarketsResponse) {
+			s.assertEqualPageResponse(expected.Pagination, actual.Pagination, "Pagination")
+		},
+	}
+	makeKey := func(marketID uint32) []byte {
+		return keeper.Uint32Bz(marketID)
+	}
+	newMarket := func(marketID uint32) *exchange.Market {
+		return &exchange.Market{
+			MarketId: marketID,
+			MarketDetails: exchange.MarketDetails{
+				Name:        fmt.Sprintf("Market %d", marketID),
+				Description: fmt.Sprintf("This is the description of market %d.", marketID),
+			},
+			AcceptingOrders: true,
+		}
+	}
+	// masked is what a market looks like after applying the idAndNameMask.
+	masked := func(marketID uint32) *exchange.Market {
+		return &exchange.Market{
+			MarketId:      marketID,
+			MarketDetails: exchange.MarketDetails{Name: fmt.Sprintf("Market %d", marketID)},
+		}
+	}
+	idAndNameMask := &gogotypes.FieldMask{Paths: []string{"market_id", "market_details.name"}}
+	fourMarketsSetup := func() {
+		s.requireCreateMarketUnmocked(*newMarket(34))
+		s.requireCreateMarketUnmocked(*newMarket(6))
+		s.requireCreateMarketUnmocked(*newMarket(81))
+		s.requireCreateMarketUnmocked(*newMarket(53))
+	}
+
+	tests := []queryTestCase[exchangev2.QueryMarketsRequest, exchangev2.QueryMarketsResponse]{
+		{
+			name:     "offset provided",
+			req:      &exchangev2.QueryMarketsRequest{Pagination: &query.PageRequest{Offset: 2}},
+			expInErr: []string{invalidArgErr, "error iterating all known markets", "offset is not supported"},
+		},
+		{
+			name:     "count total requested",
+			req:      &exchangev2.QueryMarketsRequest{Pagination: &query.PageRequest{CountTotal: true}},
+			expInErr: []string{invalidArgErr, "error iterating all known markets", "count_total is not supported"},
+		},
+		{
+			name:     "reverse requested",
+			req:      &exchangev2.QueryMarketsRequest{Pagination: &query.PageRequest{Reverse: true}},
+			expInErr: []string{invalidArgErr, "error iterating all known markets", "reverse is not supported"},
+		},
+		{
+			name:     "unknown field in mask",
+			req:      &exchangev2.QueryMarketsRequest{FieldMask: &gogotypes.FieldMask{Paths: []string{"market_details.nope"}}},
+			expInErr: []string{invalidArgErr, "invalid field mask", `unknown field "market_details.nope"`},
+		},
+		{
+			name:    "no markets in state",
+			expResp: &exchangev2.QueryMarketsResponse{Pagination: &query.PageResponse{}},
+		},
+		{
+			name:  "4 markets: masked: ascending",
+			setup: fourMarketsSetup,
+			req:   &exchangev2.QueryMarketsRequest{FieldMask: idAndNameMask},
+			expResp: &exchangev2.QueryMarketsResponse{
+				Markets:    []*exchange.Market{masked(6), masked(34), masked(53), masked(81)},
+				Pagination: &query.PageResponse{},
+			},
+		},
+		{
+			name:  "4 markets: masked: descending with limit",
+			setup: fourMarketsSetup,
+			req: &exchangev2.QueryMarketsRequest{
+				Order:      exchangev2.Order_ORDER_DESCENDING,
+				FieldMask:  idAndNameMask,
+				Pagination: &query.PageRequest{Limit: 3},
+			},
+			expResp: &exchangev2.QueryMarketsResponse{
+				Markets:    []*exchange.Market{masked(81), masked(53), masked(34)},
+				Pagination: &query.PageResponse{NextKey: makeKey(6)},
+			},
+		},
+		{
+			name:  "4 markets: masked: descending from key",
+			setup: fourMarketsSetup,
+			req: &exchangev2.QueryMarketsRequest{
+				Order:      exchangev2.Order_ORDER_DESCENDING,
+				FieldMask:  idAndNameMask,
+				Pagination: &query.PageRequest{Key: makeKey(34)},
+			},
+			expResp: &exchangev2.QueryMarketsResponse{
+				Markets:    []*exchange.Market{masked(34), masked(6)},
+				Pagination: &query.PageResponse{},
+			},
+		},
+		{
+			name:  "4 markets: only ids: ascending from key with limit",
+			setup: fourMarketsSetup,
+			req: &exchangev2.QueryMarketsRequest{
+				FieldMask:  &gogotypes.FieldMask{Paths: []string{"market_id"}},
+				Pagination: &query.PageRequest{Key: makeKey(34), Limit: 2},
+			},
+			expResp: &exchangev2.QueryMarketsResponse{
+				Markets:    []*exchange.Market{{MarketId: 34}, {MarketId: 53}},
+				Pagination: &query.PageResponse{NextKey: makeKey(81)},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runQueryTestCase(s, testDef, tc)
+		})
+	}
+}
+
+func (s *TestSuite) TestQueryServerV2_Orders() {
+	testDef := queryTestDef[exchangev2.QueryOrdersRequest, exchangev2.QueryOrdersResponse]{
+		queryName: "Orders",
+		query:     keeper.NewQueryServerV2(s.k).Orders,
+		followup: func(expected, actual *exchangev2.QueryOrdersResponse) {
+			s.assertEqualOrders(expected.Orders, actual.Orders, "Orders")
+			s.assertEqualPageResponse(expected.Pagination, actual.Pagination, "Pagination")
+		},
+	}
+	makeKey := func(orderID uint64) []byte {
+		return keeper.Uint64Bz(orderID)
+	}
+
+	fourOrders := []*exchange.Order{
+		exchange.NewOrder(14).WithAsk(&exchange.AskOrder{
+			MarketId: 8, Seller: s.addr1.String(), Assets: s.coin("14apple"), Price: s.coin("14prune"),
+			SellerSettlementFlatFee: s.coinP("14fig"), AllowPartial: false, ExternalId: "external-id-4",
+		}),
+		exchange.NewOrder(38).WithBid(&exchange.BidOrder{
+			MarketId: 6, Buyer: s.addr1.String(), Assets: s.coin("38apple"), Price: s.coin("38prune"),
+			BuyerSettlementFees: s.coins("38fig"), AllowPartial: true, ExternalId: "external-id-3",
+		}),
+		exchange.NewOrder(39).WithBid(&exchange.BidOrder{
+			MarketId: 5, Buyer: s.addr2.String(), Assets: s.coin("39apple"), Price: s.coin("39prune"),
+			BuyerSettlementFees: s.coins("39fig"), AllowPartial: false, ExternalId: "external-id-1",
+		}),
+		exchange.NewOrder(71).WithAsk(&exchange.AskOrder{
+			MarketId: 5, Seller: s.addr3.String(), Assets: s.coin("71apple"), Price: s.coin("71prune"),
+			SellerSettlementFlatFee: s.coinP("71fig"), AllowPartial: true, ExternalId: "external-id-2",
+		}),
+	}
+	fourOrdersSetup := func() {
+		store := s.getStore()
+		s.requireSetOrderInStore(store, fourOrders[2])
+		s.requireSetOrderInStore(store, fourOrders[0])
+		s.requireSetOrderInStore(store, fourOrders[3])
+		s.requireSetOrderInStore(store, fourOrders[1])
+	}
+
+	tests := []queryTestCase[exchangev2.QueryOrdersRequest, exchangev2.QueryOrdersResponse]{
+		{
+			name:     "offset provided",
+			req:      &exchangev2.QueryOrdersRequest{Pagination: &query.PageRequest{Offset: 2}},
+			expInErr: []string{invalidArgErr, "error iterating all orders", "offset is not supported"},
+		},
+		{
+			name:     "mask traverses a non-message field",
+			req:      &exchangev2.QueryOrdersRequest{FieldMask: &gogotypes.FieldMask{Paths: []string{"order_id.value"}}},
+			expInErr: []string{invalidArgErr, "invalid field mask", `field "order_id" is not a message`},
+		},
+		{
+			name:    "no orders in state",
+			expResp: &exchangev2.QueryOrdersResponse{Pagination: &query.PageResponse{}},
+		},
+		{
+			name:    "4 orders: nil req",
+			setup:   fourOrdersSetup,
+			req:     nil,
+			expResp: &exchangev2.QueryOrdersResponse{Orders: fourOrders, Pagination: &query.PageResponse{}},
+		},
+		{
+			name:  "4 orders: limit 2",
+			setup: fourOrdersSetup,
+			req:   &exchangev2.QueryOrdersRequest{Pagination: &query.PageRequest{Limit: 2}},
+			expResp: &exchangev2.QueryOrdersResponse{
+				Orders:     fourOrders[0:2],
+				Pagination: &query.PageResponse{NextKey: makeKey(39)},
+			},
+		},
+		{
+			name:  "4 orders: next page",
+			setup: fourOrdersSetup,
+			req:   &exchangev2.QueryOrdersRequest{Pagination: &query.PageRequest{Limit: 2, Key: makeKey(39)}},
+			expResp: &exchangev2.QueryOrdersResponse{
+				Orders:     fourOrders[2:4],
+				Pagination: &query.PageResponse{},
+			},
+		},
+		{
+			name:  "4 orders: descending",
+			setup: fourOrdersSetup,
+			req:   &exchangev2.QueryOrdersRequest{Order: exchangev2.Order_ORDER_DESCENDING},
+			expResp: &exchangev2.QueryOrdersResponse{
+				Orders:     []*exchange.Order{fourOrders[3], fourOrders[2], fourOrders[1], fourOrders[0]},
+				Pagination: &query.PageResponse{},
+			},
+		},
+		{
+			name:  "4 orders: ask prices only",
+			setup: fourOrdersSetup,
+			req: &exchangev2.QueryOrdersRequest{
+				FieldMask:  &gogotypes.FieldMask{Paths: []string{"order_id", "ask_order.price"}},
+				Pagination: &query.PageRequest{Limit: 3},
+			},
+			expResp: &exchangev2.QueryOrdersResponse{
+				Orders: []*exchange.Order{
+					exchange.NewOrder(14).WithAsk(&exchange.AskOrder{Price: s.coin("14prune")}),
+					{OrderId: 38},
+					{OrderId: 39},
+				},
+				Pagination: &query.PageResponse{NextKey: makeKey(71)},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runQueryTestCase(s, testDef, tc)
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+// createIdempotencyKeyValue creates the store value for an idempotency key entry.
+func createIdempotencyKeyValue(orderID uint64, height int64) []byte {
+	rv := make([]byte, 0, 16)
+	rv = append(rv, uint64Bz(orderID)...)
+	rv = append(rv, uint64Bz(uint64(height))...) //nolint:gosec // G115: Block heights are not negative.
+	return rv
+}
+
+// parseIdempotencyKeyValue extracts the order id and height from an idempotency key entry's store value.
+func parseIdempotencyKeyValue(value []byte) (uint64, int64, error) {
+	if len(value) != 16 {
+		return 0, 0, fmt.Errorf("cannot parse idempotency key entry value: has %d bytes, expected 16", len(value))
+	}
+	orderID, _ := uint64FromBz(value[:8])
+	height, _ := uint64FromBz(value[8:])
+	return orderID, int64(height), nil //nolint:gosec // G115: Heights were stored from an int64.
+}
+
+// getIdempotencyKeyFromStore gets the order id and height recorded for an account's idempotency key.
+// Returns false if the account has not used that key (or it has been pruned).
+func getIdempotencyKeyFromStore(store storetypes.KVStore, addr sdk.AccAddress, idempotencyKey string) (uint64, int64, bool) {
+	orderID, height, err := parseIdempotencyKeyValue(store.Get(MakeKeyIdempotencyKey(addr, idempotencyKey)))
+	if err != nil {
+		return 0, 0, false
+	}
+	return orderID, height, true
+}
+
+// setIdempotencyKeyInStore records an account's idempotency key in the store (along with its index entry),
+// replacing any entry the account already has for that key.
+func setIdempotencyKeyInStore(store storetypes.KVStore, addr sdk.AccAddress, idempotencyKey string, orderID uint64, height int64) {
+	deleteIdempotencyKeyFromStore(store, addr, idempotencyKey)
+	store.Set(MakeKeyIdempotencyKey(addr, idempotencyKey), createIdempotencyKeyValue(orderID, height))
+	store.Set(MakeIndexKeyHeightToIdempotencyKey(height, addr, idempotencyKey), []byte{})
+}
+
+// deleteIdempotencyKeyFromStore deletes an account's idempotency key entry and its index entry from the store.
+func deleteIdempotencyKeyFromStore(store storetypes.KVStore, addr sdk.AccAddress, idempotencyKey string) {
+	key := MakeKeyIdempotencyKey(addr, idempotencyKey)
+	if _, height, err := parseIdempotencyKeyValue(store.Get(key)); err == nil {
+		store.Delete(MakeIndexKeyHeightToIdempotencyKey(height, addr, idempotencyKey))
+	}
+	store.Delete(key)
+}
+
+// setIdempotencyKeyRecordInStore writes the provided idempotency key record to the store.
+func setIdempotencyKeyRecordInStore(store storetypes.KVStore, record exchange.IdempotencyKeyRecord) error {
+	addr, err := sdk.AccAddressFromBech32(record.Account)
+	if err != nil {
+		return fmt.Errorf("invalid account %q: %w", record.Account, err)
+	}
+	setIdempotencyKeyInStore(store, addr, record.IdempotencyKey, record.OrderId, record.Height)
+	return nil
+}
+
+// isIdempotencyKeyExpired returns true if a key used at the given height is too old to be honored at the current height.
+func isIdempotencyKeyExpired(ctx sdk.Context, height int64) bool {
+	return height <= ctx.BlockHeight()-exchange.IdempotencyKeyTTLBlocks
+}
+
+// GetIdempotentOrderID gets the id of the order previously created by an account using the provided idempotency key.
+// Returns false if the key is empty, if the account has not used it, or if it was used too long ago.
+func (k Keeper) GetIdempotentOrderID(ctx sdk.Context, owner string, idempotencyKey string) (uint64, bool) {
+	if len(idempotencyKey) == 0 {
+		return 0, false
+	}
+	addr, err := sdk.AccAddressFromBech32(owner)
+	if err != nil {
+		return 0, false
+	}
+	orderID, height, found := getIdempotencyKeyFromStore(k.getStore(ctx), addr, idempotencyKey)
+	if !found || isIdempotencyKeyExpired(ctx, height) {
+		return 0, false
+	}
+	return orderID, true
+}
+
+// RecordIdempotencyKey records that an account used the provided idempotency key to create an order.
+// Nothing is recorded if the key is empty.
+func (k Keeper) RecordIdempotencyKey(ctx sdk.Context, owner string, idempotencyKey string, orderID uint64) error {
+	if len(idempotencyKey) == 0 {
+		return nil
+	}
+	if err := exchange.ValidateIdempotencyKey(idempotencyKey); err != nil {
+		return err
+	}
+	addr, err := sdk.AccAddressFromBech32(owner)
+	if err != nil {
+		return fmt.Errorf("invalid owner %q: %w", owner, err)
+	}
+	setIdempotencyKeyInStore(k.getStore(ctx), addr, idempotencyKey, orderID, ctx.BlockHeight())
+	return nil
+}
+
+// IterateIdempotencyKeys iterates over all recorded idempotency keys. An error is returned if there was a
+// problem reading an entry along the way. Such a problem does not interrupt iteration.
+// The callback takes in the idempotency key record and should return whether to stop iterating.
+func (k Keeper) IterateIdempotencyKeys(ctx sdk.Context, cb func(record *exchange.IdempotencyKeyRecord) bool) error {
+	var errs []error
+	k.iterate(ctx, GetKeyPrefixIdempotencyKeys(), func(keySuffix, value []byte) bool {
+		addr, idempotencyKey, err := ParseKeySuffixIdempotencyKey(keySuffix)
+		if err != nil {
+			errs = append(errs, err)
+			return false
+		}
+		orderID, height, err := parseIdempotencyKeyValue(value)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("account %s idempotency key %q: %w", addr, idempotencyKey, err))
+			return false
+		}
+		return cb(exchange.NewIdempotencyKeyRecord(addr.String(), idempotencyKey, orderID, height))
+	})
+	return errors.Join(errs...)
+}
+
+// PruneIdempotencyKeys deletes up to maxToDelete idempotency keys that were used too long ago to still be honored.
+// Returns the number of keys deleted.
+func (k Keeper) PruneIdempotencyKeys(ctx sdk.Context, maxToDelete int) int {
+	height := ctx.BlockHeight()
+	if height <= exchange.IdempotencyKeyTTLBlocks {
+		return 0
+	}
+	// Keys used at this height (and before) are deleted.
+	cutoff := height - exchange.IdempotencyKeyTTLBlocks
+
+	// Gather the keys first so we aren't writing to the store while iterating it.
+	store := k.getStore(ctx)
+	var keys [][]byte
+	iter := store.Iterator(GetIndexKeyPrefixHeightToIdempotencyKey(), GetIndexKeyPrefixHeightToIdempotencyKeyAt(cutoff+1))
+	for ; iter.Valid() && len(keys) < maxToDelete; iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		keyHeight, addr, idempotencyKey, err := ParseIndexKeyHeightToIdempotencyKey(key)
+		if err != nil {
+			k.logErrorf(ctx, "invalid height to idempotency key index entry %v (deleted): %v", key, err)
+			store.Delete(key)
+			continue
+		}
+		// Only delete the primary entry if this index entry is the one for it.
+		if _, entryHeight, found := getIdempotencyKeyFromStore(store, addr, idempotencyKey); found && entryHeight == keyHeight {
+			store.Delete(MakeKeyIdempotencyKey(addr, idempotencyKey))
+		}
+		store.Delete(key)
+	}
+	return len(keys)
+}
@@ -0,0 +1,394 @@
+package keeper_test
+
+import (
+	"github.com/provenance-io/provenance/testutil/assertions"
+	"github.com/provenance-io/provenance/x/exchange"
+	"github.com/provenance-io/provenance/x/exchange/keeper"
+)
+
+// requireSetIdempotencyKeyRecords stores the provided idempotency key records.
+func (s *TestSuite) requireSetIdempotencyKeyRecords(records ...exchange.IdempotencyKeyRecord) {
+	for _, record := range records {
+		assertions.RequireNotPanicsNoErrorf(s.T(), func() error {
+			return keeper.SetIdempotencyKeyRecordInStore(s.getStore(), record)
+		}, "SetIdempotencyKeyRecordInStore(%s %q)", record.Account, record.IdempotencyKey)
+	}
+}
+
+// getAllIdempotencyKeyRecords gets all the idempotency key records in state, requiring there to not be any errors.
+func (s *TestSuite) getAllIdempotencyKeyRecords() []exchange.IdempotencyKeyRecord {
+	var rv []exchange.IdempotencyKeyRecord
+	err := s.k.IterateIdempotencyKeys(s.ctx, func(record *exchange.IdempotencyKeyRecord) bool {
+		rv = append(rv, *record)
+		return false
+	})
+	s.Require().NoError(err, "IterateIdempotencyKeys")
+	return rv
+}
+
+// idempotencyKeyRecord creates an idempotency key record.
+func (s *TestSuite) idempotencyKeyRecord(account string, key string, orderID uint64, height int64) exchange.IdempotencyKeyRecord {
+	return *exchange.NewIdempotencyKeyRecord(account, key, orderID, height)
+}
+
+// getIdempotencyKeyIndexKeys gets all the keys in the height to idempotency key index.
+func (s *TestSuite) getIdempotencyKeyIndexKeys() [][]byte {
+	var rv [][]byte
+	iter := s.getStore().Iterator(keeper.GetIndexKeyPrefixHeightToIdempotencyKey(), nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		rv = append(rv, iter.Key())
+	}
+	return rv
+}
+
+func (s *TestSuite) TestKeeper_GetIdempotentOrderID() {
+	ttl := exchange.IdempotencyKeyTTLBlocks
+	setup := func() {
+		s.requireSetIdempotencyKeyRecords(
+			s.idempotencyKeyRecord(s.addr1.String(), "retry-1", 3, 10),
+			s.idempotencyKeyRecord(s.addr2.String(), "retry-1", 5, 20),
+		)
+	}
+
+	tests := []struct {
+		name   string
+		height int64
+		owner  string
+		key    string
+		expID  uint64
+		expOK  bool
+	}{
+		{name: "empty key", height: 25, owner: s.addr1.String(), key: "", expID: 0, expOK: false},
+		{name: "invalid owner", height: 25, owner: "badaddr", key: "retry-1", expID: 0, expOK: false},
+		{name: "unknown key", height: 25, owner: s.addr1.String(), key: "retry-2", expID: 0, expOK: false},
+		{name: "other account's key", height: 25, owner: s.addr3.String(), key: "retry-1", expID: 0, expOK: false},
+		{name: "addr1 key", height: 25, owner: s.addr1.String(), key: "retry-1", expID: 3, expOK: true},
+		{name: "addr2 key", height: 25, owner: s.addr2.String(), key: "retry-1", expID: 5, expOK: true},
+		{name: "last block before expiring", height: 10 + ttl - 1, owner: s.addr1.String(), key: "retry-1", expID: 3, expOK: true},
+		{name: "expired", height: 10 + ttl, owner: s.addr1.String(), key: "retry-1", expID: 0, expOK: false},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			setup()
+
+			ctx := s.ctx.WithBlockHeight(tc.height)
+			var orderID uint64
+			var ok bool
+			testFunc := func() {
+				orderID, ok = s.k.GetIdempotentOrderID(ctx, tc.owner, tc.key)
+			}
+			s.Require().NotPanics(testFunc, "GetIdempotentOrderID(%q, %q)", tc.owner, tc.key)
+			s.Assert().Equal(tc.expID, orderID, "GetIdempotentOrderID(%q, %q) order id", tc.owner, tc.key)
+			s.Assert().Equal(tc.expOK, ok, "GetIdempotentOrderID(%q, %q) bool", tc.owner, tc.key)
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_RecordIdempotencyKey() {
+	tests := []struct {
+		name       string
+		setup      func()
+		owner      string
+		key        string
+		orderID    uint64
+		expErr     string
+		expRecords []exchange.IdempotencyKeyRecord
+		expIndexes [][]byte
+	}{
+		{
+			name:    "empty key",
+			owner:   s.addr1.String(),
+			key:     "",
+			orderID: 3,
+		},
+		{
+			name:    "key too long",
+			owner:   s.addr1.String(),
+			key:     "a123456789b123456789c123456789d123456789e123456789f123456789g123456789h123456789i123456789j123456789k",
+			orderID: 3,
+			expErr:  "invalid idempotency key \"a1234...6789k\" (length 101): max length 100",
+		},
+		{
+			name:    "invalid owner",
+			owner:   "badaddr",
+			key:     "retry-1",
+			orderID: 3,
+			expErr:  "invalid owner \"badaddr\": decoding bech32 failed: invalid bech32 string length 7",
+		},
+		{
+			name:       "new key",
+			owner:      s.addr1.String(),
+			key:        "retry-1",
+			orderID:    3,
+			expRecords: []exchange.IdempotencyKeyRecord{s.idempotencyKeyRecord(s.addr1.String(), "retry-1", 3, 50)},
+			expIndexes: [][]byte{keeper.MakeIndexKeyHeightToIdempotencyKey(50, s.addr1, "retry-1")},
+		},
+		{
+			name: "replaces existing key",
+			setup: func() {
+				s.requireSetIdempotencyKeyRecords(
+					s.idempotencyKeyRecord(s.addr1.String(), "retry-1", 2, 7),
+					s.idempotencyKeyRecord(s.addr1.String(), "retry-2", 4, 8),
+				)
+			},
+			owner:   s.addr1.String(),
+			key:     "retry-1",
+			orderID: 9,
+			expRecords: []exchange.IdempotencyKeyRecord{
+				s.idempotencyKeyRecord(s.addr1.String(), "retry-1", 9, 50),
+				s.idempotencyKeyRecord(s.addr1.String(), "retry-2", 4, 8),
+			},
+			expIndexes: [][]byte{
+				keeper.MakeIndexKeyHeightToIdempotencyKey(8, s.addr1, "retry-2"),
+				keeper.MakeIndexKeyHeightToIdempotencyKey(50, s.addr1, "retry-1"),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			ctx := s.ctx.WithBlockHeight(50)
+			var err error
+			testFunc := func() {
+				err = s.k.RecordIdempotencyKey(ctx, tc.owner, tc.key, tc.orderID)
+			}
+			s.Require().NotPanics(testFunc, "RecordIdempotencyKey")
+			s.assertErrorValue(err, tc.expErr, "RecordIdempotencyKey error")
+			actRecords := s.getAllIdempotencyKeyRecords()
+			s.Assert().Equal(tc.expRecords, actRecords, "idempotency key records in state")
+			actIndexes := s.getIdempotencyKeyIndexKeys()
+			s.Assert().Equal(tc.expIndexes, actIndexes, "height to idempotency key index entries")
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_IterateIdempotencyKeys() {
+	var records []exchange.IdempotencyKeyRecord
+	getAll := func(record *exchange.IdempotencyKeyRecord) bool {
+		records = append(records, *record)
+		return false
+	}
+	stopAfter := func(n int) func(record *exchange.IdempotencyKeyRecord) bool {
+		return func(record *exchange.IdempotencyKeyRecord) bool {
+			records = append(records, *record)
+			return len(records) >= n
+		}
+	}
+	defaultSetup := func() {
+		s.requireSetIdempotencyKeyRecords(
+			s.idempotencyKeyRecord(s.addr2.String(), "a", 3, 10),
+			s.idempotencyKeyRecord(s.addr1.String(), "b", 1, 5),
+			s.idempotencyKeyRecord(s.addr1.String(), "a", 2, 6),
+		)
+	}
+
+	tests := []struct {
+		name       string
+		setup      func()
+		cb         func(record *exchange.IdempotencyKeyRecord) bool
+		expRecords []exchange.IdempotencyKeyRecord
+		expErr     string
+	}{
+		{
+			name: "no records",
+			cb:   getAll,
+		},
+		{
+			name:  "three records",
+			setup: defaultSetup,
+			cb:    getAll,
+			expRecords: []exchange.IdempotencyKeyRecord{
+				s.idempotencyKeyRecord(s.addr1.String(), "a", 2, 6),
+				s.idempotencyKeyRecord(s.addr1.String(), "b", 1, 5),
+				s.idempotencyKeyRecord(s.addr2.String(), "a", 3, 10),
+			},
+		},
+		{
+			name:  "stop after two",
+			setup: defaultSetup,
+			cb:    stopAfter(2),
+			expRecords: []exchange.IdempotencyKeyRecord{
+				s.idempotencyKeyRecord(s.addr1.String(), "a", 2, 6),
+				s.idempotencyKeyRecord(s.addr1.String(), "b", 1, 5),
+			},
+		},
+		{
+			name: "bad value",
+			setup: func() {
+				defaultSetup()
+				s.getStore().Set(keeper.MakeKeyIdempotencyKey(s.addr1, "c"), []byte("x"))
+			},
+			cb: getAll,
+			expRecords: []exchange.IdempotencyKeyRecord{
+				s.idempotencyKeyRecord(s.addr1.String(), "a", 2, 6),
+				s.idempotencyKeyRecord(s.addr1.String(), "b", 1, 5),
+				s.idempotencyKeyRecord(s.addr2.String(), "a", 3, 10),
+			},
+			expErr: "account " + s.addr1.String() + " idempotency key \"c\": " +
+				"cannot parse idempotency key entry value: has 1 bytes, expected 16",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			records = nil
+			var err error
+			testFunc := func() {
+				err = s.k.IterateIdempotencyKeys(s.ctx, tc.cb)
+			}
+			s.Require().NotPanics(testFunc, "IterateIdempotencyKeys")
+			s.assertErrorValue(err, tc.expErr, "IterateIdempotencyKeys error")
+			s.Assert().Equal(tc.expRecords, records, "records provided to callback")
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_PruneIdempotencyKeys() {
+	ttl := exchange.IdempotencyKeyTTLBlocks
+	defaultSetup := func() {
+		s.requireSetIdempotencyKeyRecords(
+			s.idempotencyKeyRecord(s.addr1.String(), "a", 1, 4),
+			s.idempotencyKeyRecord(s.addr2.String(), "a", 2, 5),
+			s.idempotencyKeyRecord(s.addr1.String(), "b", 3, 5),
+			s.idempotencyKeyRecord(s.addr3.String(), "a", 4, 8),
+		)
+	}
+
+	tests := []struct {
+		name        string
+		setup       func()
+		height      int64
+		maxToDelete int
+		expCount    int
+		expRecords  []exchange.IdempotencyKeyRecord
+		expIndexes  [][]byte
+	}{
+		{
+			name:        "no records",
+			height:      ttl + 10,
+			maxToDelete: 100,
+			expCount:    0,
+		},
+		{
+			name:        "chain not older than the ttl",
+			setup:       defaultSetup,
+			height:      ttl,
+			maxToDelete: 100,
+			expCount:    0,
+			expRecords: []exchange.IdempotencyKeyRecord{
+				s.idempotencyKeyRecord(s.addr1.String(), "a", 1, 4),
+				s.idempotencyKeyRecord(s.addr1.String(), "b", 3, 5),
+				s.idempotencyKeyRecord(s.addr2.String(), "a", 2, 5),
+				s.idempotencyKeyRecord(s.addr3.String(), "a", 4, 8),
+			},
+			expIndexes: [][]byte{
+				keeper.MakeIndexKeyHeightToIdempotencyKey(4, s.addr1, "a"),
+				keeper.MakeIndexKeyHeightToIdempotencyKey(5, s.addr1, "b"),
+				keeper.MakeIndexKeyHeightToIdempotencyKey(5, s.addr2, "a"),
+				keeper.MakeIndexKeyHeightToIdempotencyKey(8, s.addr3, "a"),
+			},
+		},
+		{
+			name:        "keys at the cutoff are deleted",
+			setup:       defaultSetup,
+			height:      ttl + 5,
+			maxToDelete: 100,
+			expCount:    3,
+			expRecords: []exchange.IdempotencyKeyRecord{
+				s.idempotencyKeyRecord(s.addr3.String(), "a", 4, 8),
+			},
+			expIndexes: [][]byte{keeper.MakeIndexKeyHeightToIdempotencyKey(8, s.addr3, "a")},
+		},
+		{
+			name:        "limited by max to delete",
+			setup:       defaultSetup,
+			height:      ttl + 5,
+			maxToDelete: 2,
+			expCount:    2,
+			expRecords: []exchange.IdempotencyKeyRecord{
+				s.idempotencyKeyRecord(s.addr2.String(), "a", 2, 5),
+				s.idempotencyKeyRecord(s.addr3.String(), "a", 4, 8),
+			},
+			expIndexes: [][]byte{
+				keeper.MakeIndexKeyHeightToIdempotencyKey(5, s.addr2, "a"),
+				keeper.MakeIndexKeyHeightToIdempotencyKey(8, s.addr3, "a"),
+			},
+		},
+		{
+			name: "stale index entry does not delete the current key",
+			setup: func() {
+				defaultSetup()
+				s.getStore().Set(keeper.MakeIndexKeyHeightToIdempotencyKey(2, s.addr3, "a"), []byte{})
+			},
+			height:      ttl + 2,
+			maxToDelete: 100,
+			expCount:    1,
+			expRecords: []exchange.IdempotencyKeyRecord{
+				s.idempotencyKeyRecord(s.addr1.String(), "a", 1, 4),
+				s.idempotencyKeyRecord(s.addr1.String(), "b", 3, 5),
+				s.idempotencyKeyRecord(s.addr2.String(), "a", 2, 5),
+				s.idempotencyKeyRecord(s.addr3.String(), "a", 4, 8),
+			},
+			expIndexes: [][]byte{
+				keeper.MakeIndexKeyHeightToIdempotencyKey(4, s.addr1, "a"),
+				keeper.MakeIndexKeyHeightToIdempotencyKey(5, s.addr1, "b"),
+				keeper.MakeIndexKeyHeightToIdempotencyKey(5, s.addr2, "a"),
+				keeper.MakeIndexKeyHeightToIdempotencyKey(8, s.addr3, "a"),
+			},
+		},
+		{
+			name: "bad index entry",
+			setup: func() {
+				defaultSetup()
+				s.getStore().Set(append(keeper.GetIndexKeyPrefixHeightToIdempotencyKeyAt(3), 1, 'a'), []byte{})
+			},
+			height:      ttl + 4,
+			maxToDelete: 100,
+			expCount:    2,
+			expRecords: []exchange.IdempotencyKeyRecord{
+				s.idempotencyKeyRecord(s.addr1.String(), "b", 3, 5),
+				s.idempotencyKeyRecord(s.addr2.String(), "a", 2, 5),
+				s.idempotencyKeyRecord(s.addr3.String(), "a", 4, 8),
+			},
+			expIndexes: [][]byte{
+				keeper.MakeIndexKeyHeightToIdempotencyKey(5, s.addr1, "b"),
+				keeper.MakeIndexKeyHeightToIdempotencyKey(5, s.addr2, "a"),
+				keeper.MakeIndexKeyHeightToIdempotencyKey(8, s.addr3, "a"),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			ctx := s.ctx.WithBlockHeight(tc.height)
+			var count int
+			testFunc := func() {
+				count = s.k.PruneIdempotencyKeys(ctx, tc.maxToDelete)
+			}
+			s.Require().NotPanics(testFunc, "PruneIdempotencyKeys")
+			s.Assert().Equal(tc.expCount, count, "PruneIdempotencyKeys result")
+			actRecords := s.getAllIdempotencyKeyRecords()
+			s.Assert().Equal(tc.expRecords, actRecords, "idempotency key records in state")
+			actIndexes := s.getIdempotencyKeyIndexKeys()
+			s.Assert().Equal(tc.expIndexes, actIndexes, "height to idempotency key index entries")
+		})
+	}
+}
@@ -0,0 +1,308 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+const (
+	orderIndexInvariant    = "Order-Indexes"
+	requiredHoldsInvariant = "Required-Holds"
+	orphanedHoldsInvariant = "Orphaned-Holds"
+)
+
+// RegisterInvariants registers all exchange invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
+	ir.RegisterRoute(exchange.ModuleName, orderIndexInvariant, OrderIndexesInvariant(keeper))
+	ir.RegisterRoute(exchange.ModuleName, requiredHoldsInvariant, RequiredHoldsInvariant(keeper))
+	ir.RegisterRoute(exchange.ModuleName, orphanedHoldsInvariant, OrphanedHoldsInvariant(keeper))
+}
+
+// OrderIndexesInvariant checks that every order has all of its index entries and that every index entry points to an order.
+func OrderIndexesInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg, broken := orderIndexesInvariantHelper(ctx, keeper)
+		return sdk.FormatInvariant(exchange.ModuleName, orderIndexInvariant, msg), broken
+	}
+}
+
+// orderIndexesInvariantHelper does all the heavy lifting for OrderIndexesInvariant.
+func orderIndexesInvariantHelper(ctx sdk.Context, keeper Keeper) (string, bool) {
+	store := keeper.getStore(ctx)
+	var errs []error
+
+	// Make sure each order has all of its index entries.
+	orderCount := 0
+	err := keeper.IterateOrders(ctx, func(order *exchange.Order) bool {
+		orderCount++
+		if _, err := sdk.AccAddressFromBech32(order.GetOwner()); err != nil {
+			errs = append(errs, fmt.Errorf("order %d has an invalid owner %q: %w", order.OrderId, order.GetOwner(), err))
+			return false
+		}
+		for _, entry := range createConstantIndexEntries(*order) {
+			value := store.Get(entry.Key)
+			if !bytes.Equal(value, entry.Value) {
+				errs = append(errs, fmt.Errorf("order %d index entry %v has value %v, expected %v",
+					order.OrderId, entry.Key, value, entry.Value))
+			}
+		}
+		if entry := createMarketExternalIDToOrderEntry(order); entry != nil {
+			value := store.Get(entry.Key)
+			if !bytes.Equal(value, entry.Value) {
+				errs = append(errs, fmt.Errorf("order %d external id %q index entry has value %v, expected %v",
+					order.OrderId, order.GetExternalID(), value, entry.Value))
+			}
+		}
+		if entry := createAddressExternalIDToOrderEntry(order); entry != nil {
+			value := store.Get(entry.Key)
+			if !bytes.Equal(value, entry.Value) {
+				errs = append(errs, fmt.Errorf("order %d address external id %q index entry has value %v, expected %v",
+					order.OrderId, order.GetExternalID(), value, entry.Value))
+			}
+		}
+		return false
+	})
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	// Make sure each index entry points to an existing order of the right type.
+	checkTypeIndex := func(name string, prefix []byte) {
+		keeper.iterate(ctx, prefix, func(key, value []byte) bool {
+			orderID, ok := ParseIndexKeySuffixOrderID(key)
+			if !ok {
+				errs = append(errs, fmt.Errorf("%s index has an invalid key %v", name, key))
+				return false
+			}
+			order, oerr := keeper.getOrderFromStore(store, orderID)
+			switch {
+			case oerr != nil:
+				errs = append(errs, fmt.Errorf("%s index entry for order %d: %w", name, orderID, oerr))
+			case order == nil:
+				errs = append(errs, fmt.Errorf("%s index has an entry for order %d, which does not exist", name, orderID))
+			case len(value) != 1 || value[0] != order.GetOrderTypeByte():
+				errs = append(errs, fmt.Errorf("%s index entry for order %d has value %v, expected %v",
+					name, orderID, value, []byte{order.GetOrderTypeByte()}))
+			}
+			return false
+		})
+	}
+	checkTypeIndex("market to order", []byte{KeyTypeMarketToOrderIndex})
+	checkTypeIndex("address to order", []byte{KeyTypeAddressToOrderIndex})
+	checkTypeIndex("asset to order", []byte{KeyTypeAssetToOrderIndex})
+	checkTypeIndex("expiration to order", []byte{KeyTypeExpirationToOrderIndex})
+
+	keeper.iterate(ctx, []byte{KeyTypeMarketExternalIDToOrderIndex}, func(key, value []byte) bool {
+		orderID, ok := uint64FromBz(value)
+		if !ok {
+			errs = append(errs, fmt.Errorf("market external id to order index entry %v has an invalid value %v", key, value))
+			return false
+		}
+		order, oerr := keeper.getOrderFromStore(store, orderID)
+		switch {
+		case oerr != nil:
+			errs = append(errs, fmt.Errorf("market external id to order index entry for order %d: %w", orderID, oerr))
+		case order == nil:
+			errs = append(errs, fmt.Errorf("market external id to order index has an entry for order %d, which does not exist", orderID))
+		case len(order.GetExternalID()) == 0 ||
+			!bytes.Equal(MakeIndexKeyMarketExternalIDToOrder(order.GetMarketID(), order.GetExternalID())[1:], key):
+			errs = append(errs, fmt.Errorf("market external id to order index entry %v for order %d does not match "+
+				"the order's market %d and external id %q", key, orderID, order.GetMarketID(), order.GetExternalID()))
+		}
+		return false
+	})
+
+	keeper.iterate(ctx, []byte{KeyTypeAddressExternalIDToOrderIndex}, func(key, _ []byte) bool {
+		addr, externalID, orderID, perr := ParseIndexKeyAddressExternalIDToOrder(append([]byte{KeyTypeAddressExternalIDToOrderIndex}, key...))
+		if perr != nil {
+			errs = append(errs, fmt.Errorf("address external id to order index has an invalid key %v: %w", key, perr))
+			return false
+		}
+		order, oerr := keeper.getOrderFromStore(store, orderID)
+		switch {
+		case oerr != nil:
+			errs = append(errs, fmt.Errorf("address external id to order index entry for order %d: %w", orderID, oerr))
+		case order == nil:
+			errs = append(errs, fmt.Errorf("address external id to order index has an entry for order %d, which does not exist", orderID))
+		case order.GetOwner() != addr.String() || order.GetExternalID() != externalID:
+			errs = append(errs, fmt.Errorf("address external id to order index entry for order %d has owner %s and "+
+				"external id %q, but the order has owner %s and external id %q",
+				orderID, addr, externalID, order.GetOwner(), order.GetExternalID()))
+		}
+		return false
+	})
+
+	var summary string
+	switch orderCount {
+	case 1:
+		summary = "1 order checked."
+	default:
+		summary = fmt.Sprintf("%d orders checked.", orderCount)
+	}
+
+	return invariantMsg(summary, errs)
+}
+
+// RequiredHoldsInvariant checks that every account has at least as much on hold as is needed for its
+// orders, trigger orders, commitments, payments, and accepted multi-party payments.
+func RequiredHoldsInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg, broken := requiredHoldsInvariantHelper(ctx, keeper)
+		return sdk.FormatInvariant(exchange.ModuleName, requiredHoldsInvariant, msg), broken
+	}
+}
+
+// requiredHoldsInvariantHelper does all the heavy lifting for RequiredHoldsInvariant.
+func requiredHoldsInvariantHelper(ctx sdk.Context, keeper Keeper) (string, bool) {
+	addrs, required, errs := getRequiredHolds(ctx, keeper)
+
+	for _, addrStr := range addrs {
+		addr, err := sdk.AccAddressFromBech32(addrStr)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid address %q needing funds on hold: %w", addrStr, err))
+			continue
+		}
+		for _, reqAmt := range required[addrStr] {
+			holdAmt, err := keeper.holdKeeper.GetHoldCoin(ctx, addr, reqAmt.Denom)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to look up amount of %q on hold for %s: %w", reqAmt.Denom, addrStr, err))
+				continue
+			}
+			if holdAmt.Amount.LT(reqAmt.Amount) {
+				errs = append(errs, fmt.Errorf("account %s should have at least %q on hold, but only has %q",
+					addrStr, reqAmt, holdAmt))
+			}
+		}
+	}
+
+	var summary string
+	switch len(addrs) {
+	case 1:
+		summary = "1 account needs funds on hold."
+	default:
+		summary = fmt.Sprintf("%d accounts need funds on hold.", len(addrs))
+	}
+
+	return invariantMsg(summary, errs)
+}
+
+// OrphanedHoldsInvariant checks that no account has more on hold than is needed for its
+// orders, trigger orders, commitments, payments, and accepted multi-party payments.
+// It assumes that the exchange module is the only one that places holds on funds.
+func OrphanedHoldsInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg, broken := orphanedHoldsInvariantHelper(ctx, keeper)
+		return sdk.FormatInvariant(exchange.ModuleName, orphanedHoldsInvariant, msg), broken
+	}
+}
+
+// orphanedHoldsInvariantHelper does all the heavy lifting for OrphanedHoldsInvariant.
+func orphanedHoldsInvariantHelper(ctx sdk.Context, keeper Keeper) (string, bool) {
+	_, required, errs := getRequiredHolds(ctx, keeper)
+
+	holdCount := 0
+	err := keeper.holdKeeper.IterateAllHolds(ctx, func(addr sdk.AccAddress, held sdk.Coin) bool {
+		holdCount++
+		reqAmt := sdk.Coin{Denom: held.Denom, Amount: required[addr.String()].AmountOf(held.Denom)}
+		if held.Amount.GT(reqAmt.Amount) {
+			errs = append(errs, fmt.Errorf("account %s has %q on hold, but only %q is needed",
+				addr, held, reqAmt))
+		}
+		return false
+	})
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	var summary string
+	switch holdCount {
+	case 1:
+		summary = "1 hold checked."
+	default:
+		summary = fmt.Sprintf("%d holds checked.", holdCount)
+	}
+
+	return invariantMsg(summary, errs)
+}
+
+// getRequiredHolds gets the funds that each account is required to have on hold due to the exchange module.
+// The addresses are returned in the order they were first found, along with a map of address to required funds.
+func getRequiredHolds(ctx sdk.Context, keeper Keeper) ([]string, map[string]sdk.Coins, []error) {
+	var addrs []string
+	required := make(map[string]sdk.Coins)
+	recordHold := func(addr string, amount sdk.Coins) {
+		if amount.IsZero() {
+			return
+		}
+		if _, known := required[addr]; !known {
+			addrs = append(addrs, addr)
+		}
+		required[addr] = required[addr].Add(amount...)
+	}
+
+	var errs []error
+	err := keeper.IterateOrders(ctx, func(order *exchange.Order) bool {
+		recordHold(order.GetOwner(), order.GetHoldAmount())
+		return false
+	})
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	err = keeper.IterateTriggerOrders(ctx, func(triggerOrder *exchange.TriggerOrder) bool {
+		recordHold(triggerOrder.Order.GetOwner(), triggerOrder.Order.GetHoldAmount())
+		return false
+	})
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	keeper.IterateCommitments(ctx, func(commitment exchange.Commitment) bool {
+		recordHold(commitment.Account, commitment.Amount)
+		return false
+	})
+
+	keeper.IteratePayments(ctx, func(payment *exchange.Payment) bool {
+		recordHold(payment.Source, payment.SourceAmount)
+		return false
+	})
+
+	keeper.IterateMultiPartyPayments(ctx, func(mpp *exchange.MultiPartyPayment) bool {
+		for _, party := range mpp.Parties {
+			if party.Accepted {
+				recordHold(party.Address, party.SendAmount)
+			}
+		}
+		return false
+	})
+
+	return addrs, required, errs
+}
+
+// invariantMsg creates an invariant message with the provided summary followed by a description of the errors.
+// Also returns whether the invariant is broken, which is true if there are any errors.
+func invariantMsg(summary string, errs []error) (string, bool) {
+	var msg strings.Builder
+	msg.WriteString(summary)
+	msg.WriteByte(' ')
+	errCount := len(errs)
+	broken := errCount != 0
+	switch errCount {
+	case 0:
+		msg.WriteString("No problems detected.")
+	case 1:
+		msg.WriteString(fmt.Sprintf("1 problem detected: %v", errs[0]))
+	default:
+		msg.WriteString(fmt.Sprintf("%d problems detected:", errCount))
+		for i, er := range errs {
+			msg.WriteString(fmt.Sprintf("\n%d: %v", i+1, er))
+		}
+	}
+
+	return msg.String(), broken
+}
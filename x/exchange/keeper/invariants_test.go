@@ -0,0 +1,334 @@
+package keeper_test
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+	"github.com/provenance-io/provenance/x/exchange/keeper"
+)
+
+func (s *TestSuite) TestOrderIndexesInvariant() {
+	seller := sdk.AccAddress("the_seller__________")
+	buyer := sdk.AccAddress("the_buyer___________")
+	askOrder := func(orderID uint64, externalID string) *exchange.Order {
+		return exchange.NewOrder(orderID).WithAsk(&exchange.AskOrder{
+			MarketId:   3,
+			Seller:     seller.String(),
+			Assets:     s.coin("10apple"),
+			Price:      s.coin("20prune"),
+			ExternalId: externalID,
+		})
+	}
+	bidOrder := func(orderID uint64, externalID string) *exchange.Order {
+		return exchange.NewOrder(orderID).WithBid(&exchange.BidOrder{
+			MarketId:   3,
+			Buyer:      buyer.String(),
+			Assets:     s.coin("10apple"),
+			Price:      s.coin("20prune"),
+			ExternalId: externalID,
+		})
+	}
+
+	tests := []struct {
+		name      string
+		setup     func(store storetypes.KVStore)
+		expInMsg  []string
+		expBroken bool
+	}{
+		{
+			name:     "no orders",
+			expInMsg: []string{"0 orders checked. No problems detected."},
+		},
+		{
+			name: "one order",
+			setup: func(store storetypes.KVStore) {
+				s.requireSetOrderInStore(store, askOrder(1, ""))
+			},
+			expInMsg: []string{"1 order checked. No problems detected."},
+		},
+		{
+			name: "several orders with external ids",
+			setup: func(store storetypes.KVStore) {
+				s.requireSetOrdersInStore(store, askOrder(1, "one"), bidOrder(2, "two"), askOrder(3, ""), bidOrder(4, ""))
+			},
+			expInMsg: []string{"4 orders checked. No problems detected."},
+		},
+		{
+			name: "order missing an index entry",
+			setup: func(store storetypes.KVStore) {
+				s.requireSetOrdersInStore(store, askOrder(1, ""), bidOrder(2, ""))
+				store.Delete(keeper.MakeIndexKeyAddressToOrder(buyer, 2))
+			},
+			expInMsg: []string{
+				"2 orders checked. 1 problem detected: order 2 index entry",
+				"has value [], expected [1]",
+			},
+			expBroken: true,
+		},
+		{
+			name: "order missing its external id entry",
+			setup: func(store storetypes.KVStore) {
+				s.requireSetOrderInStore(store, askOrder(1, "one"))
+				store.Delete(keeper.MakeIndexKeyMarketExternalIDToOrder(3, "one"))
+			},
+			expInMsg: []string{
+				"1 order checked. 1 problem detected: order 1 external id \"one\" index entry has value [], " +
+					"expected [0 0 0 0 0 0 0 1]",
+			},
+			expBroken: true,
+		},
+		{
+			name: "index entries for an order that does not exist",
+			setup: func(store storetypes.KVStore) {
+				s.requireSetOrderInStore(store, askOrder(1, ""))
+				store.Set(keeper.MakeIndexKeyMarketToOrder(3, 5), []byte{keeper.OrderKeyTypeBid})
+				store.Set(keeper.MakeIndexKeyAssetToOrder("apple", 5), []byte{keeper.OrderKeyTypeBid})
+				store.Set(keeper.MakeIndexKeyMarketExternalIDToOrder(3, "five"), []byte{0, 0, 0, 0, 0, 0, 0, 5})
+			},
+			expInMsg: []string{
+				"1 order checked. 3 problems detected:",
+				"\n1: market to order index has an entry for order 5, which does not exist",
+				"\n2: asset to order index has an entry for order 5, which does not exist",
+				"\n3: market external id to order index has an entry for order 5, which does not exist",
+			},
+			expBroken: true,
+		},
+		{
+			name: "index entry with the wrong order type",
+			setup: func(store storetypes.KVStore) {
+				s.requireSetOrderInStore(store, askOrder(1, ""))
+				store.Set(keeper.MakeIndexKeyMarketToOrder(3, 1), []byte{keeper.OrderKeyTypeBid})
+			},
+			expInMsg: []string{
+				"order 1 index entry",
+				"market to order index entry for order 1 has value [1], expected [0]",
+			},
+			expBroken: true,
+		},
+		{
+			name: "external id entry for the wrong order",
+			setup: func(store storetypes.KVStore) {
+				s.requireSetOrdersInStore(store, askOrder(1, "one"), bidOrder(2, ""))
+				store.Set(keeper.MakeIndexKeyMarketExternalIDToOrder(3, "one"), []byte{0, 0, 0, 0, 0, 0, 0, 2})
+			},
+			expInMsg: []string{
+				"order 1 external id \"one\" index entry has value [0 0 0 0 0 0 0 2], expected [0 0 0 0 0 0 0 1]",
+				"for order 2 does not match the order's market 3 and external id \"\"",
+			},
+			expBroken: true,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup(s.getStore())
+			}
+
+			invariant := keeper.OrderIndexesInvariant(s.k)
+			var msg string
+			var broken bool
+			testFunc := func() {
+				msg, broken = invariant(s.ctx)
+			}
+			s.Require().NotPanics(testFunc, "OrderIndexesInvariant")
+			s.Assert().Contains(msg, "exchange: Order-Indexes invariant", "invariant message")
+			for _, exp := range tc.expInMsg {
+				s.Assert().Contains(msg, exp, "invariant message")
+			}
+			s.Assert().Equal(tc.expBroken, broken, "invariant broken")
+		})
+	}
+}
+
+// setupHoldInvariantState puts an order, trigger order, commitment, payment, and multi-party payment in state.
+// The funds needed on hold are 10apple,5prune,4grape for addr1; 3fig,20prune for addr2; and 7cherry for addr3.
+func (s *TestSuite) setupHoldInvariantState() {
+	store := s.getStore()
+	s.requireSetOrdersInStore(store,
+		exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+			MarketId: 3, Seller: s.addr1.String(), Assets: s.coin("10apple"), Price: s.coin("20prune"),
+		}),
+		exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+			MarketId: 3, Buyer: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("20prune"),
+		}),
+	)
+	triggerOrder := exchange.NewTriggerOrder(*exchange.NewOrder(3).WithBid(&exchange.BidOrder{
+		MarketId: 3, Buyer: s.addr1.String(), Assets: s.coin("2apple"), Price: s.coin("5prune"),
+	}), s.coin("3prune"))
+	s.Require().NoError(s.k.SetTriggerOrderInStore(store, *triggerOrder), "SetTriggerOrderInStore")
+	keeper.SetCommitmentAmount(store, 3, s.addr3, s.coins("7cherry"), s.ctx.BlockTime())
+	s.Require().NoError(s.k.SetPaymentInStore(store, &exchange.Payment{
+		Source: s.addr2.String(), SourceAmount: s.coins("3fig"), ExternalId: "pay1",
+	}), "SetPaymentInStore")
+	s.Require().NoError(s.k.SetMultiPartyPaymentInStore(store, &exchange.MultiPartyPayment{
+		Creator:    s.addr1.String(),
+		ExternalId: "mpp1",
+		Parties: []exchange.PaymentParty{
+			{Address: s.addr1.String(), SendAmount: s.coins("4grape"), Accepted: true},
+			{Address: s.addr3.String(), SendAmount: s.coins("6grape"), ReceiveAmount: s.coins("4grape")},
+		},
+	}), "SetMultiPartyPaymentInStore")
+}
+
+func (s *TestSuite) TestRequiredHoldsInvariant() {
+	tests := []struct {
+		name       string
+		setup      bool
+		holdKeeper *MockHoldKeeper
+		expInMsg   []string
+		expBroken  bool
+	}{
+		{
+			name:       "nothing in state",
+			holdKeeper: NewMockHoldKeeper(),
+			expInMsg:   []string{"0 accounts need funds on hold. No problems detected."},
+		},
+		{
+			name:  "all required funds on hold",
+			setup: true,
+			holdKeeper: NewMockHoldKeeper().
+				WithGetHoldCoinResult(s.addr1, s.coins("10apple,4grape,5prune")...).
+				WithGetHoldCoinResult(s.addr2, s.coins("3fig,20prune")...).
+				WithGetHoldCoinResult(s.addr3, s.coins("7cherry")...),
+			expInMsg: []string{"3 accounts need funds on hold. No problems detected."},
+		},
+		{
+			name:  "more than required on hold",
+			setup: true,
+			holdKeeper: NewMockHoldKeeper().
+				WithGetHoldCoinResult(s.addr1, s.coins("11apple,4grape,5prune,1plum")...).
+				WithGetHoldCoinResult(s.addr2, s.coins("3fig,20prune")...).
+				WithGetHoldCoinResult(s.addr3, s.coins("7cherry,6grape")...),
+			expInMsg: []string{"3 accounts need funds on hold. No problems detected."},
+		},
+		{
+			name:  "some funds not on hold",
+			setup: true,
+			holdKeeper: NewMockHoldKeeper().
+				WithGetHoldCoinResult(s.addr1, s.coins("9apple,4grape,5prune")...).
+				WithGetHoldCoinResult(s.addr2, s.coins("3fig,20prune")...),
+			expInMsg: []string{
+				"3 accounts need funds on hold. 2 problems detected:",
+				"\n1: account " + s.addr1.String() + " should have at least \"10apple\" on hold, but only has \"9apple\"",
+				"\n2: account " + s.addr3.String() + " should have at least \"7cherry\" on hold, but only has \"0cherry\"",
+			},
+			expBroken: true,
+		},
+		{
+			name:  "error getting hold",
+			setup: true,
+			holdKeeper: NewMockHoldKeeper().
+				WithGetHoldCoinResult(s.addr1, s.coins("10apple,4grape,5prune")...).
+				WithGetHoldCoinResult(s.addr2, s.coins("3fig,20prune")...).
+				WithGetHoldCoinErrorResult(s.addr3, "cherry", "injected error"),
+			expInMsg: []string{
+				"3 accounts need funds on hold. 1 problem detected: failed to look up amount of \"cherry\" on hold for " +
+					s.addr3.String() + ": injected error",
+			},
+			expBroken: true,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup {
+				s.setupHoldInvariantState()
+			}
+
+			invariant := keeper.RequiredHoldsInvariant(s.k.WithHoldKeeper(tc.holdKeeper))
+			var msg string
+			var broken bool
+			testFunc := func() {
+				msg, broken = invariant(s.ctx)
+			}
+			s.Require().NotPanics(testFunc, "RequiredHoldsInvariant")
+			s.Assert().Contains(msg, "exchange: Required-Holds invariant", "invariant message")
+			for _, exp := range tc.expInMsg {
+				s.Assert().Contains(msg, exp, "invariant message")
+			}
+			s.Assert().Equal(tc.expBroken, broken, "invariant broken")
+		})
+	}
+}
+
+func (s *TestSuite) TestOrphanedHoldsInvariant() {
+	tests := []struct {
+		name       string
+		setup      bool
+		holdKeeper *MockHoldKeeper
+		expInMsg   []string
+		expBroken  bool
+	}{
+		{
+			name:       "nothing in state",
+			holdKeeper: NewMockHoldKeeper(),
+			expInMsg:   []string{"0 holds checked. No problems detected."},
+		},
+		{
+			name:  "holds exactly as required",
+			setup: true,
+			holdKeeper: NewMockHoldKeeper().
+				WithGetHoldCoinResult(s.addr1, s.coins("10apple,4grape,5prune")...).
+				WithGetHoldCoinResult(s.addr2, s.coins("3fig,20prune")...).
+				WithGetHoldCoinResult(s.addr3, s.coins("7cherry")...),
+			expInMsg: []string{"6 holds checked. No problems detected."},
+		},
+		{
+			name:  "less than required on hold",
+			setup: true,
+			holdKeeper: NewMockHoldKeeper().
+				WithGetHoldCoinResult(s.addr1, s.coins("9apple")...),
+			expInMsg: []string{"1 hold checked. No problems detected."},
+		},
+		{
+			name:       "hold without anything in state",
+			holdKeeper: NewMockHoldKeeper().WithGetHoldCoinResult(s.addr4, s.coins("1apple")...),
+			expInMsg: []string{
+				"1 hold checked. 1 problem detected: account " + s.addr4.String() +
+					" has \"1apple\" on hold, but only \"0apple\" is needed",
+			},
+			expBroken: true,
+		},
+		{
+			name:  "extra funds on hold",
+			setup: true,
+			holdKeeper: NewMockHoldKeeper().
+				WithGetHoldCoinResult(s.addr1, s.coins("11apple,4grape,5prune")...).
+				WithGetHoldCoinResult(s.addr2, s.coins("3fig,20prune")...).
+				WithGetHoldCoinResult(s.addr3, s.coins("7cherry,6grape")...),
+			expInMsg: []string{
+				"7 holds checked. 2 problems detected:",
+				"account " + s.addr1.String() + " has \"11apple\" on hold, but only \"10apple\" is needed",
+				"account " + s.addr3.String() + " has \"6grape\" on hold, but only \"0grape\" is needed",
+			},
+			expBroken: true,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup {
+				s.setupHoldInvariantState()
+			}
+
+			invariant := keeper.OrphanedHoldsInvariant(s.k.WithHoldKeeper(tc.holdKeeper))
+			var msg string
+			var broken bool
+			testFunc := func() {
+				msg, broken = invariant(s.ctx)
+			}
+			s.Require().NotPanics(testFunc, "OrphanedHoldsInvariant")
+			s.Assert().Contains(msg, "exchange: Orphaned-Holds invariant", "invariant message")
+			for _, exp := range tc.expInMsg {
+				s.Assert().Contains(msg, exp, "invariant message")
+			}
+			s.Assert().Equal(tc.expBroken, broken, "invariant broken")
+		})
+	}
+}
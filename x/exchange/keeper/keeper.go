@@ -38,6 +38,9 @@ type Keeper struct {
 	holdKeeper     exchange.HoldKeeper
 	markerKeeper   exchange.MarkerKeeper
 	metadataKeeper exchange.MetadataKeeper
+	oracleKeeper   exchange.OracleKeeper
+
+	hooks exchange.ExchangeHooks
 
 	authority        string
 	feeCollectorName string
@@ -137,6 +140,33 @@ func (k Keeper) ValidateAuthority(addr string) error {
 	return nil
 }
 
+// SetHooks sets the exchange hooks. This can only be done once, and should be done before the keeper is provided to anything else.
+func (k *Keeper) SetHooks(eh exchange.ExchangeHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set exchange hooks twice")
+	}
+	k.hooks = eh
+	return k
+}
+
+// SetOracleKeeper sets the keeper used to publish settlement prices to x/oracle.
+// This can only be done once, and should be done before the keeper is provided to anything else.
+func (k *Keeper) SetOracleKeeper(ok exchange.OracleKeeper) *Keeper {
+	if k.oracleKeeper != nil {
+		panic("cannot set exchange oracle keeper twice")
+	}
+	k.oracleKeeper = ok
+	return k
+}
+
+// Hooks gets the exchange hooks. If none have been set, a no-op set of hooks is returned.
+func (k Keeper) Hooks() exchange.ExchangeHooks {
+	if k.hooks == nil {
+		return exchange.MultiExchangeHooks{}
+	}
+	return k.hooks
+}
+
 // GetFeeCollectorName gets the name of the fee collector.
 func (k Keeper) GetFeeCollectorName() string {
 	return k.feeCollectorName
@@ -232,8 +262,8 @@ func (k Keeper) DoTransfer(ctxIn sdk.Context, inputs []banktypes.Input, outputs
 	return k.bankKeeper.InputOutputCoinsProv(ctx, inputs, outputs)
 }
 
-// CalculateExchangeSplit calculates the amount that the exchange will keep of the provided fee.
-func (k Keeper) CalculateExchangeSplit(ctx sdk.Context, feeAmt sdk.Coins) sdk.Coins {
+// CalculateExchangeSplit calculates the amount that the exchange will keep of the provided fee paid to a market.
+func (k Keeper) CalculateExchangeSplit(ctx sdk.Context, marketID uint32, feeAmt sdk.Coins) sdk.Coins {
 	if feeAmt.IsZero() {
 		return nil
 	}
@@ -243,7 +273,7 @@ func (k Keeper) CalculateExchangeSplit(ctx sdk.Context, feeAmt sdk.Coins) sdk.Co
 			continue
 		}
 
-		split := int64(k.GetExchangeSplit(ctx, coin.Denom))
+		split := int64(k.GetMarketExchangeSplit(ctx, marketID, coin.Denom))
 		if split == 0 {
 			continue
 		}
@@ -264,7 +294,7 @@ func (k Keeper) CollectFee(ctx sdk.Context, marketID uint32, payer sdk.AccAddres
 	if fee.IsZero() {
 		return nil
 	}
-	exchangeSplit := k.CalculateExchangeSplit(ctx, fee)
+	exchangeSplit := k.CalculateExchangeSplit(ctx, marketID, fee)
 
 	marketAddr := exchange.GetMarketAddress(marketID)
 	if err := k.bankKeeper.SendCoins(ctx, payer, marketAddr, fee); err != nil {
@@ -276,6 +306,7 @@ func (k Keeper) CollectFee(ctx sdk.Context, marketID uint32, payer sdk.AccAddres
 		}
 	}
 
+	incFeesCollectedCounter(marketID, fee)
 	return nil
 }
 
@@ -303,7 +334,7 @@ func (k Keeper) CollectFees(ctx sdk.Context, marketID uint32, inputs []banktypes
 		return nil
 	}
 
-	exchangeAmt := k.CalculateExchangeSplit(ctx, feeAmt)
+	exchangeAmt := k.CalculateExchangeSplit(ctx, marketID, feeAmt)
 
 	marketAddr := exchange.GetMarketAddress(marketID)
 	outputs := []banktypes.Output{{Address: marketAddr.String(), Coins: feeAmt}}
@@ -316,5 +347,6 @@ func (k Keeper) CollectFees(ctx sdk.Context, marketID uint32, inputs []banktypes
 		}
 	}
 
+	incFeesCollectedCounter(marketID, feeAmt)
 	return nil
 }
@@ -10,6 +10,7 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/provenance-io/provenance/x/exchange"
+	"github.com/provenance-io/provenance/x/exchange/keeper"
 )
 
 func (s *TestSuite) TestKeeper_GetAuthority() {
@@ -80,6 +81,19 @@ func (s *TestSuite) TestKeeper_ValidateAuthority() {
 	}
 }
 
+func (s *TestSuite) TestKeeper_SetHooks() {
+	kpr := s.k
+	s.Assert().Equal(exchange.MultiExchangeHooks{}, kpr.Hooks(), "Hooks() before SetHooks")
+
+	hooks := NewMockExchangeHooks()
+	s.Require().NotPanics(func() { kpr.SetHooks(hooks) }, "SetHooks first time")
+	s.Assert().Same(hooks, kpr.Hooks(), "Hooks() after SetHooks")
+
+	s.Require().PanicsWithValue("cannot set exchange hooks twice", func() { kpr.SetHooks(NewMockExchangeHooks()) }, "SetHooks second time")
+	s.Assert().Same(hooks, kpr.Hooks(), "Hooks() after second SetHooks")
+	s.Assert().Equal(exchange.MultiExchangeHooks{}, s.k.Hooks(), "s.k.Hooks() after SetHooks on a copy")
+}
+
 func (s *TestSuite) TestKeeper_GetFeeCollectorName() {
 	expected := authtypes.FeeCollectorName
 	var actual string
@@ -244,10 +258,11 @@ func (s *TestSuite) TestKeeper_DoTransfer() {
 
 func (s *TestSuite) TestKeeper_CalculateExchangeSplit() {
 	tests := []struct {
-		name   string
-		params *exchange.Params
-		feeAmt sdk.Coins
-		expAmt sdk.Coins
+		name         string
+		params       *exchange.Params
+		marketSplits []exchange.DenomSplit
+		feeAmt       sdk.Coins
+		expAmt       sdk.Coins
 	}{
 		{
 			name:   "no params in state",
@@ -329,15 +344,43 @@ func (s *TestSuite) TestKeeper_CalculateExchangeSplit() {
 			feeAmt: sdk.Coins{sdk.NewInt64Coin("apple", 0), sdk.NewInt64Coin("banana", 0)},
 			expAmt: nil,
 		},
+		{
+			name: "market split overrides a params denom split",
+			params: &exchange.Params{
+				DefaultSplit: 500,
+				DenomSplits:  []exchange.DenomSplit{{Denom: "apple", Split: 100}},
+			},
+			marketSplits: []exchange.DenomSplit{{Denom: "apple", Split: 2000}},
+			feeAmt:       s.coins("500apple"),
+			expAmt:       s.coins("100apple"),
+		},
+		{
+			name: "market split overrides the params default split",
+			params: &exchange.Params{
+				DefaultSplit: 1000,
+				DenomSplits:  []exchange.DenomSplit{{Denom: "apple", Split: 100}},
+			},
+			marketSplits: []exchange.DenomSplit{{Denom: "banana", Split: 250}},
+			feeAmt:       s.coins("500apple,400banana,30fig"),
+			expAmt:       s.coins("5apple,10banana,3fig"),
+		},
+		{
+			name:         "market split of zero",
+			params:       exchange.DefaultParams(),
+			marketSplits: []exchange.DenomSplit{{Denom: "apple", Split: 0}},
+			feeAmt:       s.coins("100apple,20banana"),
+			expAmt:       s.coins("1banana"),
+		},
 	}
 
 	for _, tc := range tests {
 		s.Run(tc.name, func() {
 			s.k.SetParams(s.ctx, tc.params)
+			keeper.StoreMarket(s.getStore(), exchange.Market{MarketId: 1, ExchangeSplits: tc.marketSplits})
 
 			var actAmt sdk.Coins
 			testFunc := func() {
-				actAmt = s.k.CalculateExchangeSplit(s.ctx, tc.feeAmt)
+				actAmt = s.k.CalculateExchangeSplit(s.ctx, 1, tc.feeAmt)
 			}
 			s.Require().NotPanics(testFunc, "CalculateExchangeSplit(%q)", tc.feeAmt)
 			s.Assert().Equal(tc.expAmt.String(), actAmt.String(), "CalculateExchangeSplit(%q) result", tc.feeAmt)
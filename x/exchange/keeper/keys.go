@@ -5,7 +5,9 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"math/big"
 	"strings"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -25,6 +27,9 @@ import (
 //   The payment flat fees are stored as string versions of the coins.
 //   Create Payment Flat: 0x00 | "fee_create_payment_flat" => string(coins)
 //   Accept Payment Flat: 0x00 | "fee_accept_payment_flat" => string(coins)
+//   Settlement History Blocks: 0x00 | "settlement_history_blocks" => uint64
+//   NAV History Blocks: 0x00 | "nav_history_blocks" => uint64
+//   NAV History Max Records: 0x00 | "nav_history_max_records" => uint32
 //
 // Last Market ID: 0x06 => uint32
 //   This stores the last auto-selected market id.
@@ -53,6 +58,25 @@ import (
 //   Market Create-Commitment Flat Fee: 0x01 | <market_id> | 0x11 | <denom> => <amount> (string)
 //   Market Commitment Settlement Bips: 0x01 | <market_id> | 0x12 => uint16
 //   Market Intermediary Denom: 0x01 | <market_id> | 0x13 => <denom>
+//   Market continuous matching indicator: 0x01 | <market_id> | 0x14 => nil
+//   Market Batch Auction Interval: 0x01 | <market_id> | 0x15 => uint32
+//   Market Last Batch Auction Height: 0x01 | <market_id> | 0x16 => uint64
+//   Market Self-Trade Prevention: 0x01 | <market_id> | 0x17 => byte
+//   Market permission expirations: 0x01 | <market_id> | 0x18 | <addr len byte> | <address> => <expiration> (8 bytes)
+//     The <expiration> is the time the address' permissions lapse, as unix seconds in a uint64 in big-endian order.
+//   Market Accepted Asset Denoms: 0x01 | <market_id> | 0x19 => 0x1E-separated list of denoms.
+//   Market Accepted Price Denoms: 0x01 | <market_id> | 0x1A => 0x1E-separated list of denoms.
+//   Market Seller Settlement Taker Fee Ratio: 0x01 | <market_id> | 0x1B | <price_denom> | 0x1E | <fee_denom> => price and fee amounts (strings) separated by 0x1E.
+//   Market Seller Settlement Rebate Ratio: 0x01 | <market_id> | 0x1C | <price_denom> | 0x1E | <fee_denom> => price and rebate amounts (strings) separated by 0x1E.
+//   Market Rebate Addresses: 0x01 | <market_id> | 0x1D => 0x1E-separated list of bech32 addresses.
+//   Market Referral Bips: 0x01 | <market_id> | 0x1E => uint32
+//   Market Referral Cap: 0x01 | <market_id> | 0x1F | <denom> => <amount> (string)
+//   Market publish prices indicator: 0x01 | <market_id> | 0x20 => nil
+//   Market External ID Scope: 0x01 | <market_id> | 0x21 => byte
+//   Market Order Rate Limit: 0x01 | <market_id> | 0x22 => <max_orders> (4 bytes) | <window_blocks> (4 bytes)
+//   Market Max Exposure: 0x01 | <market_id> | 0x23 => <coin> (string)
+//   Market Fill Algorithm: 0x01 | <market_id> | 0x24 => byte
+//   Market Exchange Splits: 0x01 | <market_id> | 0x25 | <denom> => uint16
 //
 //   The <permission_type_byte> is a single byte as uint8 with the same values as the enum entries.
 //   The <req_attr_type_byte> is either an order type byte or 0x63 (= 'c' for commitments).
@@ -68,18 +92,112 @@ import (
 //     Ask Orders: 0x02 | <order_id> (8 bytes) => 0x00 | protobuf(AskOrder)
 //     Bid Orders: 0x02 | <order_id> (8 bytes) => 0x01 | protobuf(BidOrder)
 //
+// Trigger Orders: 0x0B | <order_id> (8 bytes) => protobuf(TriggerOrder)
+//   These are orders that are waiting for a settlement price to cause them to be activated.
+//   Once activated, the trigger order entry is deleted and the order is stored with the other orders.
+//
+// Order Links: 0x0D | <market_id> (4 bytes) | <order_id> (8 bytes) => <linked_order_id> (8 bytes)
+//   Each link is stored in both directions so that either order can be used to look up the other.
+//
+// Settlement Prices: 0x0F | <market_id> (4 bytes) | len(<asset_denom>) (1 byte) | <asset_denom> | len(<price_denom>) (1 byte) | <price_denom>
+//                    | <time> (8 bytes) => protobuf(SettlementPrice)
+//   The <time> is the block time as unix seconds in a uint64 in big-endian order.
+//   These are deleted once they're older than exchange.SettlementPriceMaxAge (except the most recent one).
+//
+// Settlement Records: 0x11 | <market_id> (4 bytes) | <height> (8 bytes) | <sequence> (4 bytes) => protobuf(SettlementRecord)
+//   The <height> is the block height as a uint64 in big-endian order.
+//   The <sequence> is the order of the settlement among those in the same market and block, as a uint32 in big-endian order.
+//   These are deleted once they're older than the settlement_history_blocks param.
+//
+// NAV Records: 0x20 | len(<asset_denom>) (1 byte) | <asset_denom> | len(<price_denom>) (1 byte) | <price_denom>
+//              | <height> (8 bytes) | <market_id> (4 bytes) => protobuf(NAVRecord)
+//   The <height> is the block height as a uint64 in big-endian order.
+//   These are deleted once they're older than the nav_history_blocks param,
+//   or once there are more than the nav_history_max_records param for the denom pair.
+//
+// Order Creation Counts: 0x23 | <market_id> (4 bytes) | len(<address>) (1 byte) | <address> | <height> (8 bytes) => uint32
+//   The <height> is the block height as a uint64 in big-endian order.
+//   These are only kept for markets with an order rate limit, and are deleted once they're outside the limit's window.
+//
+// Market Authority Transfers: 0x24 | <market_id> (4 bytes) => protobuf(MarketAuthorityTransfer)
+//   These are deleted once they've been accepted.
+//
+// Idempotency Keys: 0x25 | len(<address>) (1 byte) | <address> | <idempotency_key> => <order_id> (8 bytes) | <height> (8 bytes)
+//   The <height> is the block height at which the key was used, as a uint64 in big-endian order.
+//   These are deleted once they're older than exchange.IdempotencyKeyTTLBlocks.
+//
+// Last Settlement ID: 0x27 => uint64
+//
+// Settlement Receipts: 0x28 | <settlement_id> (8 bytes) => protobuf(SettlementReceipt)
+//   These are only kept if the settlement_history_blocks param is not zero, and are deleted once they're older than that.
+//
+// Dead-Man Switches: 0x29 | len(<account>) (1 byte) | <account> => protobuf(DeadManSwitch)
+//   These are deleted when the account removes it or misses a heartbeat.
+//
+// Scheduled Fee Changes: 0x17 | <market_id> (4 bytes) | <sequence> (8 bytes) => protobuf(MsgGovManageFeesRequest)
+//   The <sequence> is the order in which the changes were scheduled in the market, as a uint64 in big-endian order.
+//   These are deleted once they've been applied.
+//
 // Commitments:
 //   0x63 | <market_id> (4 bytes) | <address> => <coins> (string)
 //
+// Commitment Expirations: 0x12 | <market_id> (4 bytes) | len(<address>) (1 byte) | <address> => <expiration> (8 bytes)
+//   The <expiration> is the commitment's expiration as unix seconds in a uint64 in big-endian order.
+//
+// Commitment Reward Pools: 0x14 | <market_id> (4 bytes) => <coins> (string)
+//
+// Commitment Rewards: 0x15 | len(<address>) (1 byte) | <address> | <market_id> (4 bytes) => <coins> (string)
+//
 // Payments:
 //    0x70 | len(<source>) (1 byte) | <source> | <external id>
 //
+// Recurring Payments: 0x19 | len(<source>) (1 byte) | <source> | <external id> => protobuf(RecurringPayment)
+//
+// Multi-Party Payments: 0x1B | len(<creator>) (1 byte) | <creator> | <external id> => protobuf(MultiPartyPayment)
+//
+// Escrowed Payments: 0x1E | len(<source>) (1 byte) | <source> | <external id> => protobuf(EscrowedPayment)
+//
 // Indexes:
 //    Market to order: 0x03 | <market_id> (4 bytes) | <order_id> (8 bytes) => <order type byte>
 //    Address to order: 0x04 | len(<address>) (1 byte) | <address> | <order_id> (8 bytes) => <order type byte>
 //    Asset denom to order: 0x05 | <asset_denom> | <order_id> (8 bytes) => <order type byte>
 //    Market + external id to order: 0x09 | <market id> (4 bytes) | <external_id> => <order id> (8 bytes)
+//    Expiration to order: 0x0A | <expiration> (8 bytes) | <order_id> (8 bytes) => <order type byte>
+//      The <expiration> is the order's expiration as unix seconds in a uint64 in big-endian order.
+//    Market to trigger order: 0x0C | <market_id> (4 bytes) | <order_id> (8 bytes) => <order type byte>
+//    Price to order: 0x0E | <market_id> (4 bytes) | len(<asset_denom>) (1 byte) | <asset_denom> | len(<price_denom>) (1 byte) | <price_denom>
+//                    | <order type byte> | len(<unit price>) (1 byte) | <unit price> | <order_id> (8 bytes) => nil
+//      The <unit price> is the order's price * 10^18 / assets (truncated) as a big-endian unsigned integer without leading zeros.
 //    Target to payment: 0x10 | len(<target>) (1 byte) | <target> | len(<source>) (1 byte) | <source> | <external id>
+//    Expiration to commitment: 0x13 | <expiration> (8 bytes) | <market_id> (4 bytes) | len(<address>) (1 byte) | <address> => nil
+//      The <expiration> is the commitment's expiration as unix seconds in a uint64 in big-endian order.
+//    Expiration to access grant: 0x16 | <expiration> (8 bytes) | <market_id> (4 bytes) | len(<address>) (1 byte) | <address> => nil
+//      The <expiration> is the access grant's expiration as unix seconds in a uint64 in big-endian order.
+//    Expiration to payment: 0x18 | <expiration> (8 bytes) | len(<source>) (1 byte) | <source> | <external id> => nil
+//      The <expiration> is the payment's expiration as unix seconds in a uint64 in big-endian order.
+//    Transfer time to recurring payment: 0x1A | <time> (8 bytes) | len(<source>) (1 byte) | <source> | <external id> => nil
+//      The <time> is the recurring payment's next transfer time as unix seconds in a uint64 in big-endian order.
+//    Account denom to payment: 0x1C | len(<account>) (1 byte) | <account> | <role byte> | len(<denom>) (1 byte) | <denom>
+//                              | len(<amount>) (1 byte) | <amount> | len(<source>) (1 byte) | <source> | <external id> => nil
+//      There is an entry for each denom in a payment's source amount for both the source and target (if there is one).
+//      The <role byte> is 0x01 for the source or 0x02 for the target.
+//      The <amount> is the amount of the denom as a big-endian unsigned integer without leading zeros.
+//    Account target amount to payment: 0x1D | len(<account>) (1 byte) | <account> | <role byte> | <has target amount byte>
+//                                      | len(<source>) (1 byte) | <source> | <external id> => nil
+//      There is an entry for both the source and target (if there is one) of each payment.
+//      The <has target amount byte> is 0x01 if the payment has a target amount, or 0x00 if it does not.
+//    Release time to escrowed payment: 0x1F | <time> (8 bytes) | len(<source>) (1 byte) | <source> | <external id> => nil
+//      The <time> is the escrowed payment's release time as unix seconds in a uint64 in big-endian order.
+//    Height to NAV record: 0x21 | <height> (8 bytes) | len(<asset_denom>) (1 byte) | <asset_denom>
+//                          | len(<price_denom>) (1 byte) | <price_denom> | <market_id> (4 bytes) => nil
+//      The <height> is the NAV record's block height as a uint64 in big-endian order.
+//    Address + external id to order: 0x22 | len(<address>) (1 byte) | <address> | len(<external_id>) (1 byte) | <external_id>
+//                                    | <order_id> (8 bytes) => <market_id> (4 bytes)
+//    Height to idempotency key: 0x26 | <height> (8 bytes) | len(<address>) (1 byte) | <address> | <idempotency_key> => nil
+//      The <height> is the block height at which the key was used, as a uint64 in big-endian order.
+//    Deadline to dead-man switch: 0x2A | <deadline> (8 bytes) | len(<account>) (1 byte) | <account> => nil
+//      The <deadline> is the dead-man switch's deadline as unix seconds in a uint64 in big-endian order.
+//    Address to trigger order: 0x2B | len(<address>) (1 byte) | <address> | <order_id> (8 bytes) => <order type byte>
 
 const (
 	// KeyTypeParams is the type byte for params entries.
@@ -102,12 +220,85 @@ const (
 	KeyTypeAssetToOrderIndex = byte(0x05)
 	// KeyTypeMarketExternalIDToOrderIndex is the type byte for entries in the market and uuid to order index.
 	KeyTypeMarketExternalIDToOrderIndex = byte(0x09)
+	// KeyTypeExpirationToOrderIndex is the type byte for entries in the expiration to order index.
+	KeyTypeExpirationToOrderIndex = byte(0x0A)
+	// KeyTypeTriggerOrder is the type byte for trigger order entries.
+	KeyTypeTriggerOrder = byte(0x0B)
+	// KeyTypeMarketToTriggerOrderIndex is the type byte for entries in the market to trigger order index.
+	KeyTypeMarketToTriggerOrderIndex = byte(0x0C)
+	// KeyTypeOrderLink is the type byte for order link entries.
+	KeyTypeOrderLink = byte(0x0D)
+	// KeyTypePriceToOrderIndex is the type byte for entries in the price to order index.
+	KeyTypePriceToOrderIndex = byte(0x0E)
+	// KeyTypeSettlementPrice is the type byte for settlement price entries.
+	KeyTypeSettlementPrice = byte(0x0F)
+	// KeyTypeSettlementRecord is the type byte for settlement record entries.
+	KeyTypeSettlementRecord = byte(0x11)
 	// KeyTypeCommitment is the type byte for commitments.
 	KeyTypeCommitment = byte(0x63)
+	// KeyTypeCommitmentExpiration is the type byte for commitment expirations.
+	KeyTypeCommitmentExpiration = byte(0x12)
+	// KeyTypeExpirationToCommitmentIndex is the type byte for entries in the expiration to commitment index.
+	KeyTypeExpirationToCommitmentIndex = byte(0x13)
+	// KeyTypeCommitmentRewardPool is the type byte for commitment reward pools.
+	KeyTypeCommitmentRewardPool = byte(0x14)
+	// KeyTypeCommitmentReward is the type byte for commitment rewards.
+	KeyTypeCommitmentReward = byte(0x15)
+	// KeyTypeExpirationToAccessGrantIndex is the type byte for entries in the expiration to access grant index.
+	KeyTypeExpirationToAccessGrantIndex = byte(0x16)
+	// KeyTypeScheduledFeeChange is the type byte for scheduled fee change entries.
+	KeyTypeScheduledFeeChange = byte(0x17)
 	// KeyTypePayment is the type byte for payments.
 	KeyTypePayment = byte(0x70)
 	// KeyTypeTargetToPaymentIndex is the type byte for entries in the target to payment index.
 	KeyTypeTargetToPaymentIndex = byte(0x10)
+	// KeyTypeExpirationToPaymentIndex is the type byte for entries in the expiration to payment index.
+	KeyTypeExpirationToPaymentIndex = byte(0x18)
+	// KeyTypeRecurringPayment is the type byte for recurring payments.
+	KeyTypeRecurringPayment = byte(0x19)
+	// KeyTypeTransferTimeToRecurringPaymentIndex is the type byte for entries in the transfer time to recurring payment index.
+	KeyTypeTransferTimeToRecurringPaymentIndex = byte(0x1A)
+	// KeyTypeMultiPartyPayment is the type byte for multi-party payments.
+	KeyTypeMultiPartyPayment = byte(0x1B)
+	// KeyTypeAccountDenomToPaymentIndex is the type byte for entries in the account denom to payment index.
+	KeyTypeAccountDenomToPaymentIndex = byte(0x1C)
+	// KeyTypeAccountTargetAmountToPaymentIndex is the type byte for entries in the account target amount to payment index.
+	KeyTypeAccountTargetAmountToPaymentIndex = byte(0x1D)
+	// KeyTypeEscrowedPayment is the type byte for escrowed payments.
+	KeyTypeEscrowedPayment = byte(0x1E)
+	// KeyTypeReleaseTimeToEscrowedPaymentIndex is the type byte for entries in the release time to escrowed payment index.
+	KeyTypeReleaseTimeToEscrowedPaymentIndex = byte(0x1F)
+	// KeyTypeNAVRecord is the type byte for NAV record entries.
+	KeyTypeNAVRecord = byte(0x20)
+	// KeyTypeHeightToNAVRecordIndex is the type byte for entries in the height to NAV record index.
+	KeyTypeHeightToNAVRecordIndex = byte(0x21)
+	// KeyTypeAddressExternalIDToOrderIndex is the type byte for entries in the address and external id to order index.
+	KeyTypeAddressExternalIDToOrderIndex = byte(0x22)
+	// KeyTypeOrderCreationCount is the type byte for the number of orders an account created in a market in a block.
+	KeyTypeOrderCreationCount = byte(0x23)
+	// KeyTypeMarketAuthorityTransfer is the type byte for market authority transfers waiting to be accepted.
+	KeyTypeMarketAuthorityTransfer = byte(0x24)
+	// KeyTypeIdempotencyKey is the type byte for the order creation idempotency keys that have been used.
+	KeyTypeIdempotencyKey = byte(0x25)
+	// KeyTypeHeightToIdempotencyKeyIndex is the type byte for entries in the height to idempotency key index.
+	KeyTypeHeightToIdempotencyKeyIndex = byte(0x26)
+	// KeyTypeLastSettlementID is the type byte for the id of the last settlement receipt recorded.
+	KeyTypeLastSettlementID = byte(0x27)
+	// KeyTypeSettlementReceipt is the type byte for settlement receipt entries.
+	KeyTypeSettlementReceipt = byte(0x28)
+	// KeyTypeDeadManSwitch is the type byte for dead-man switch entries.
+	KeyTypeDeadManSwitch = byte(0x29)
+	// KeyTypeDeadlineToDeadManSwitchIndex is the type byte for entries in the deadline to dead-man switch index.
+	KeyTypeDeadlineToDeadManSwitchIndex = byte(0x2A)
+	// KeyTypeAddressToTriggerOrderIndex is the type byte for entries in the address to trigger order index.
+	KeyTypeAddressToTriggerOrderIndex = byte(0x2B)
+	// KeyTypeCommitmentAccrual is the type byte for commitment accrual entries.
+	KeyTypeCommitmentAccrual = byte(0x2C)
+
+	// PaymentRoleSource is the role byte used in payment index keys for entries about a payment's source.
+	PaymentRoleSource = byte(0x01)
+	// PaymentRoleTarget is the role byte used in payment index keys for entries about a payment's target.
+	PaymentRoleTarget = byte(0x02)
 
 	// ParamsKeyTypeSplit is the type string used in the keys for params.DefaultSplit and params.DenomSplits.
 	ParamsKeyTypeSplit = "split"
@@ -115,6 +306,12 @@ const (
 	ParamsKeyTypeFeeCreatePaymentFlat = "fee_create_payment_flat"
 	// ParamsKeyTypeFeeAcceptPaymentFlat is the type string used in the keys for params.FeeAcceptPaymentFlat.
 	ParamsKeyTypeFeeAcceptPaymentFlat = "fee_accept_payment_flat"
+	// ParamsKeyTypeSettlementHistoryBlocks is the type string used in the key for params.SettlementHistoryBlocks.
+	ParamsKeyTypeSettlementHistoryBlocks = "settlement_history_blocks"
+	// ParamsKeyTypeNAVHistoryBlocks is the type string used in the key for params.NavHistoryBlocks.
+	ParamsKeyTypeNAVHistoryBlocks = "nav_history_blocks"
+	// ParamsKeyTypeNAVHistoryMaxRecords is the type string used in the key for params.NavHistoryMaxRecords.
+	ParamsKeyTypeNAVHistoryMaxRecords = "nav_history_max_records"
 
 	// MarketKeyTypeCreateAskFlat is the market-specific type byte for the create-ask flat fees.
 	MarketKeyTypeCreateAskFlat = byte(0x00)
@@ -144,6 +341,42 @@ const (
 	MarketKeyTypeCommitmentSettlementBips = byte(0x12)
 	// MarketKeyTypeIntermediaryDenom is the market-specific type byte for the intermediary denom used in fee calcs.
 	MarketKeyTypeIntermediaryDenom = byte(0x13)
+	// MarketKeyTypeContinuousMatching is the market-specific type byte for the continuous-matching indicators.
+	MarketKeyTypeContinuousMatching = byte(0x14)
+	// MarketKeyTypeBatchAuctionInterval is the market-specific type byte for the number of blocks between batch auctions.
+	MarketKeyTypeBatchAuctionInterval = byte(0x15)
+	// MarketKeyTypeLastBatchAuction is the market-specific type byte for the block height of the last batch auction.
+	MarketKeyTypeLastBatchAuction = byte(0x16)
+	// MarketKeyTypeSelfTradePrevention is the market-specific type byte for the self-trade prevention mode.
+	MarketKeyTypeSelfTradePrevention = byte(0x17)
+	// MarketKeyTypePermissionsExpiration is the market-specific type byte for the expirations of an address' permissions.
+	MarketKeyTypePermissionsExpiration = byte(0x18)
+	// MarketKeyTypeAcceptedAssetDenoms is the market-specific type byte for the denoms accepted as order assets.
+	MarketKeyTypeAcceptedAssetDenoms = byte(0x19)
+	// MarketKeyTypeAcceptedPriceDenoms is the market-specific type byte for the denoms accepted as order prices.
+	MarketKeyTypeAcceptedPriceDenoms = byte(0x1A)
+	// MarketKeyTypeSellerSettlementTakerRatio is the market-specific type byte for the seller settlement taker ratios.
+	MarketKeyTypeSellerSettlementTakerRatio = byte(0x1B)
+	// MarketKeyTypeSellerSettlementRebateRatio is the market-specific type byte for the seller settlement rebate ratios.
+	MarketKeyTypeSellerSettlementRebateRatio = byte(0x1C)
+	// MarketKeyTypeRebateAddresses is the market-specific type byte for the addresses that are eligible for rebates.
+	MarketKeyTypeRebateAddresses = byte(0x1D)
+	// MarketKeyTypeReferralBips is the market-specific type byte for the portion of settlement fees paid to referrers.
+	MarketKeyTypeReferralBips = byte(0x1E)
+	// MarketKeyTypeReferralCap is the market-specific type byte for the most paid to a referrer for one order.
+	MarketKeyTypeReferralCap = byte(0x1F)
+	// MarketKeyTypePublishPrices is the market-specific type byte for the publish-prices indicators.
+	MarketKeyTypePublishPrices = byte(0x20)
+	// MarketKeyTypeExternalIDScope is the market-specific type byte for how unique order external ids must be.
+	MarketKeyTypeExternalIDScope = byte(0x21)
+	// MarketKeyTypeOrderRateLimit is the market-specific type byte for the most orders an account can create in a window.
+	MarketKeyTypeOrderRateLimit = byte(0x22)
+	// MarketKeyTypeMaxExposure is the market-specific type byte for the most an account can have in orders and commitments.
+	MarketKeyTypeMaxExposure = byte(0x23)
+	// MarketKeyTypeFillAlgorithm is the market-specific type byte for how resting orders are chosen to fill a new order.
+	MarketKeyTypeFillAlgorithm = byte(0x24)
+	// MarketKeyTypeExchangeSplit is the market-specific type byte for the market's overrides of the exchange split.
+	MarketKeyTypeExchangeSplit = byte(0x25)
 
 	// OrderKeyTypeAsk is the order-specific type byte for ask orders.
 	OrderKeyTypeAsk = exchange.OrderTypeByteAsk
@@ -256,6 +489,21 @@ func MakeKeyParamsFeeAcceptPaymentFlat() []byte {
 	return prepKey(KeyTypeParams, []byte(ParamsKeyTypeFeeAcceptPaymentFlat), 0)
 }
 
+// MakeKeyParamsSettlementHistoryBlocks creates the key to use for the params SettlementHistoryBlocks entry.
+func MakeKeyParamsSettlementHistoryBlocks() []byte {
+	return prepKey(KeyTypeParams, []byte(ParamsKeyTypeSettlementHistoryBlocks), 0)
+}
+
+// MakeKeyParamsNAVHistoryBlocks creates the key to use for the params NavHistoryBlocks entry.
+func MakeKeyParamsNAVHistoryBlocks() []byte {
+	return prepKey(KeyTypeParams, []byte(ParamsKeyTypeNAVHistoryBlocks), 0)
+}
+
+// MakeKeyParamsNAVHistoryMaxRecords creates the key to use for the params NavHistoryMaxRecords entry.
+func MakeKeyParamsNAVHistoryMaxRecords() []byte {
+	return prepKey(KeyTypeParams, []byte(ParamsKeyTypeNAVHistoryMaxRecords), 0)
+}
+
 // MakeKeyLastMarketID creates the key for the last auto-selected market id.
 func MakeKeyLastMarketID() []byte {
 	return []byte{KeyTypeLastMarketID}
@@ -568,6 +816,21 @@ func ParseKeySuffixMarketPermissions(suffix []byte) (sdk.AccAddress, exchange.Pe
 	return addr, exchange.Permission(remainder[0]), nil
 }
 
+// GetKeyPrefixMarketPermissionsExpiration creates the key prefix for a market's permission expirations.
+func GetKeyPrefixMarketPermissionsExpiration(marketID uint32) []byte {
+	return keyPrefixMarketType(marketID, MarketKeyTypePermissionsExpiration, 0)
+}
+
+// MakeKeyMarketPermissionsExpiration creates the key to use for the expiration of an address' permissions in a market.
+func MakeKeyMarketPermissionsExpiration(marketID uint32, addr sdk.AccAddress) []byte {
+	if len(addr) == 0 {
+		panic(errors.New("empty address not allowed"))
+	}
+	rv := keyPrefixMarketType(marketID, MarketKeyTypePermissionsExpiration, 1+len(addr))
+	rv = append(rv, address.MustLengthPrefix(addr)...)
+	return rv
+}
+
 // marketKeyReqAttr creates the key for a market's required attributes entries of a specific type.
 func marketKeyReqAttr(marketID uint32, reqAttrType byte) []byte {
 	rv := keyPrefixMarketType(marketID, MarketKeyTypeReqAttr, 1)
@@ -613,6 +876,176 @@ func MakeKeyMarketIntermediaryDenom(marketID uint32) []byte {
 	return keyPrefixMarketType(marketID, MarketKeyTypeIntermediaryDenom, 0)
 }
 
+// MakeKeyMarketContinuousMatching creates the key to use to indicate that a market automatically matches new orders.
+func MakeKeyMarketContinuousMatching(marketID uint32) []byte {
+	return keyPrefixMarketType(marketID, MarketKeyTypeContinuousMatching, 0)
+}
+
+// MakeKeyMarketBatchAuctionInterval creates the key to use for a market's batch auction interval.
+func MakeKeyMarketBatchAuctionInterval(marketID uint32) []byte {
+	return keyPrefixMarketType(marketID, MarketKeyTypeBatchAuctionInterval, 0)
+}
+
+// MakeKeyMarketLastBatchAuction creates the key to use for the block height of a market's last batch auction.
+func MakeKeyMarketLastBatchAuction(marketID uint32) []byte {
+	return keyPrefixMarketType(marketID, MarketKeyTypeLastBatchAuction, 0)
+}
+
+// MakeKeyMarketSelfTradePrevention creates the key to use for a market's self-trade prevention mode.
+func MakeKeyMarketSelfTradePrevention(marketID uint32) []byte {
+	return keyPrefixMarketType(marketID, MarketKeyTypeSelfTradePrevention, 0)
+}
+
+// MakeKeyMarketAcceptedAssetDenoms creates the key to use for a market's accepted asset denoms.
+func MakeKeyMarketAcceptedAssetDenoms(marketID uint32) []byte {
+	return keyPrefixMarketType(marketID, MarketKeyTypeAcceptedAssetDenoms, 0)
+}
+
+// MakeKeyMarketAcceptedPriceDenoms creates the key to use for a market's accepted price denoms.
+func MakeKeyMarketAcceptedPriceDenoms(marketID uint32) []byte {
+	return keyPrefixMarketType(marketID, MarketKeyTypeAcceptedPriceDenoms, 0)
+}
+
+// marketKeyPrefixSellerSettlementTakerRatio creates the key prefix for a market's seller settlement taker ratios with extra capacity for the rest.
+func marketKeyPrefixSellerSettlementTakerRatio(marketID uint32, extraCap int) []byte {
+	return keyPrefixMarketType(marketID, MarketKeyTypeSellerSettlementTakerRatio, extraCap)
+}
+
+// GetKeyPrefixMarketSellerSettlementTakerRatio creates the key prefix for a market's seller settlement taker fee ratios.
+func GetKeyPrefixMarketSellerSettlementTakerRatio(marketID uint32) []byte {
+	return marketKeyPrefixSellerSettlementTakerRatio(marketID, 0)
+}
+
+// MakeKeyMarketSellerSettlementTakerRatio creates the key to use for the given seller settlement taker fee ratio in the given market.
+func MakeKeyMarketSellerSettlementTakerRatio(marketID uint32, ratio exchange.FeeRatio) []byte {
+	suffix := GetKeySuffixSettlementRatio(ratio)
+	rv := marketKeyPrefixSellerSettlementTakerRatio(marketID, len(suffix))
+	rv = append(rv, suffix...)
+	return rv
+}
+
+// marketKeyPrefixSellerSettlementRebateRatio creates the key prefix for a market's seller settlement rebate ratios with extra capacity for the rest.
+func marketKeyPrefixSellerSettlementRebateRatio(marketID uint32, extraCap int) []byte {
+	return keyPrefixMarketType(marketID, MarketKeyTypeSellerSettlementRebateRatio, extraCap)
+}
+
+// GetKeyPrefixMarketSellerSettlementRebateRatio creates the key prefix for a market's seller settlement rebate fee ratios.
+func GetKeyPrefixMarketSellerSettlementRebateRatio(marketID uint32) []byte {
+	return marketKeyPrefixSellerSettlementRebateRatio(marketID, 0)
+}
+
+// MakeKeyMarketSellerSettlementRebateRatio creates the key to use for the given seller settlement rebate fee ratio in the given market.
+func MakeKeyMarketSellerSettlementRebateRatio(marketID uint32, ratio exchange.FeeRatio) []byte {
+	suffix := GetKeySuffixSettlementRatio(ratio)
+	rv := marketKeyPrefixSellerSettlementRebateRatio(marketID, len(suffix))
+	rv = append(rv, suffix...)
+	return rv
+}
+
+// MakeKeyMarketRebateAddresses creates the key to use for a market's rebate addresses.
+func MakeKeyMarketRebateAddresses(marketID uint32) []byte {
+	return keyPrefixMarketType(marketID, MarketKeyTypeRebateAddresses, 0)
+}
+
+// MakeKeyMarketReferralBips creates the key to use for a market's referral bips.
+func MakeKeyMarketReferralBips(marketID uint32) []byte {
+	return keyPrefixMarketType(marketID, MarketKeyTypeReferralBips, 0)
+}
+
+// marketKeyPrefixReferralCap creates the key prefix for a market's referral cap with extra capacity for the rest.
+func marketKeyPrefixReferralCap(marketID uint32, extraCap int) []byte {
+	return keyPrefixMarketType(marketID, MarketKeyTypeReferralCap, extraCap)
+}
+
+// GetKeyPrefixMarketReferralCap creates the key prefix for a market's referral cap entries.
+func GetKeyPrefixMarketReferralCap(marketID uint32) []byte {
+	return marketKeyPrefixReferralCap(marketID, 0)
+}
+
+// MakeKeyMarketReferralCap creates the key for a market's referral cap entry with the given denom.
+func MakeKeyMarketReferralCap(marketID uint32, denom string) []byte {
+	rv := marketKeyPrefixReferralCap(marketID, len(denom))
+	rv = append(rv, denom...)
+	return rv
+}
+
+// MakeKeyMarketPublishPrices creates the key to use to indicate that a market publishes its settlement prices to x/oracle.
+func MakeKeyMarketPublishPrices(marketID uint32) []byte {
+	return keyPrefixMarketType(marketID, MarketKeyTypePublishPrices, 0)
+}
+
+// MakeKeyMarketExternalIDScope creates the key to use for a market's external id scope.
+func MakeKeyMarketExternalIDScope(marketID uint32) []byte {
+	return keyPrefixMarketType(marketID, MarketKeyTypeExternalIDScope, 0)
+}
+
+// MakeKeyMarketOrderRateLimit creates the key to use for a market's order rate limit.
+func MakeKeyMarketOrderRateLimit(marketID uint32) []byte {
+	return keyPrefixMarketType(marketID, MarketKeyTypeOrderRateLimit, 0)
+}
+
+// MakeKeyMarketMaxExposure creates the key to use for a market's max exposure.
+func MakeKeyMarketMaxExposure(marketID uint32) []byte {
+	return keyPrefixMarketType(marketID, MarketKeyTypeMaxExposure, 0)
+}
+
+// MakeKeyMarketFillAlgorithm creates the key to use for a market's fill algorithm.
+func MakeKeyMarketFillAlgorithm(marketID uint32) []byte {
+	return keyPrefixMarketType(marketID, MarketKeyTypeFillAlgorithm, 0)
+}
+
+// marketKeyPrefixExchangeSplit creates the key prefix for a market's exchange splits with extra capacity for the rest.
+func marketKeyPrefixExchangeSplit(marketID uint32, extraCap int) []byte {
+	return keyPrefixMarketType(marketID, MarketKeyTypeExchangeSplit, extraCap)
+}
+
+// GetKeyPrefixMarketExchangeSplit creates the key prefix for a market's exchange split entries.
+func GetKeyPrefixMarketExchangeSplit(marketID uint32) []byte {
+	return marketKeyPrefixExchangeSplit(marketID, 0)
+}
+
+// MakeKeyMarketExchangeSplit creates the key for a market's exchange split entry with the given denom.
+func MakeKeyMarketExchangeSplit(marketID uint32, denom string) []byte {
+	rv := marketKeyPrefixExchangeSplit(marketID, len(denom))
+	rv = append(rv, denom...)
+	return rv
+}
+
+// keyPrefixOrderCreationCount creates the key prefix for a market's order creation counts
+// with extra capacity for the rest.
+func keyPrefixOrderCreationCount(marketID uint32, extraCap int) []byte {
+	return prepKey(KeyTypeOrderCreationCount, uint32Bz(marketID), extraCap)
+}
+
+// GetKeyPrefixOrderCreationCounts creates the key prefix for all of a market's order creation counts.
+func GetKeyPrefixOrderCreationCounts(marketID uint32) []byte {
+	return keyPrefixOrderCreationCount(marketID, 0)
+}
+
+// keyPrefixOrderCreationCountAddr creates the key prefix for an account's order creation counts in a market
+// with extra capacity for the rest.
+func keyPrefixOrderCreationCountAddr(marketID uint32, addr sdk.AccAddress, extraCap int) []byte {
+	if len(addr) == 0 {
+		panic(errors.New("empty address not allowed"))
+	}
+	addrBz := address.MustLengthPrefix(addr)
+	rv := keyPrefixOrderCreationCount(marketID, len(addrBz)+extraCap)
+	rv = append(rv, addrBz...)
+	return rv
+}
+
+// GetKeyPrefixOrderCreationCountsForAddr creates the key prefix for an account's order creation counts in a market.
+func GetKeyPrefixOrderCreationCountsForAddr(marketID uint32, addr sdk.AccAddress) []byte {
+	return keyPrefixOrderCreationCountAddr(marketID, addr, 0)
+}
+
+// MakeKeyOrderCreationCount creates the key to use for the number of orders an account created in a market at a height.
+func MakeKeyOrderCreationCount(marketID uint32, addr sdk.AccAddress, height int64) []byte {
+	rv := keyPrefixOrderCreationCountAddr(marketID, addr, 8)
+	rv = append(rv, uint64Bz(uint64(height))...)
+	return rv
+}
+
 // keyPrefixOrder creates the key prefix for orders with the provided extra capacity for additional elements.
 func keyPrefixOrder(extraCap int) []byte {
 	return prepKey(KeyTypeOrder, nil, extraCap)
@@ -805,6 +1238,15 @@ func ParseIndexKeyAssetToOrder(key []byte) (string, uint64, error) {
 	return denom, orderID, nil
 }
 
+// GetIndexKeyPrefixMarketExternalIDToOrder creates the prefix for the market and uuid to order index limited to
+// the given market id and entries with external ids that start with the provided external id prefix.
+// An empty external id prefix yields the prefix for all entries in the market.
+func GetIndexKeyPrefixMarketExternalIDToOrder(marketID uint32, externalIDPrefix string) []byte {
+	rv := prepKey(KeyTypeMarketExternalIDToOrderIndex, uint32Bz(marketID), len(externalIDPrefix))
+	rv = append(rv, externalIDPrefix...)
+	return rv
+}
+
 // MakeIndexKeyMarketExternalIDToOrder creates the key to use for the market and uuid to order index for the provided values.
 func MakeIndexKeyMarketExternalIDToOrder(marketID uint32, externalID string) []byte {
 	if len(externalID) == 0 {
@@ -818,164 +1260,938 @@ func MakeIndexKeyMarketExternalIDToOrder(marketID uint32, externalID string) []b
 	return rv
 }
 
-// keyPrefixCommitment creates the key prefix for commitments with the provided extra capacity for additional elements.
-func keyPrefixCommitment(extraCap int) []byte {
-	return prepKey(KeyTypeCommitment, nil, extraCap)
-}
-
-// keyPrefixMarketCommitments creates the key prefix for comitments to a market with the provided extra capacity for additional elements.
-func keyPrefixMarketCommitments(marketID uint32, extraCap int) []byte {
-	suffix := uint32Bz(marketID)
-	rv := keyPrefixCommitment(extraCap + len(suffix))
-	rv = append(rv, suffix...)
+// indexPrefixAddressExternalIDToOrder creates the prefix for the address and external id to order index entries
+// with some extra space for the rest.
+func indexPrefixAddressExternalIDToOrder(addr sdk.AccAddress, externalID string, extraCap int) []byte {
+	if len(addr) == 0 {
+		panic(errors.New("empty address not allowed"))
+	}
+	if len(externalID) == 0 {
+		panic(errors.New("cannot create address external id to order index with empty external id"))
+	}
+	if err := exchange.ValidateExternalID(externalID); err != nil {
+		panic(fmt.Errorf("cannot create address external id to order index: %w", err))
+	}
+	addrBz := address.MustLengthPrefix(addr)
+	rv := prepKey(KeyTypeAddressExternalIDToOrderIndex, addrBz, 1+len(externalID)+extraCap)
+	rv = append(rv, byte(len(externalID)))
+	rv = append(rv, externalID...)
 	return rv
 }
 
-// GetKeyPrefixCommitments gets the key prefix for all commitments.
-func GetKeyPrefixCommitments() []byte {
-	return keyPrefixCommitment(0)
-}
-
-// GetKeyPrefixCommitmentsToMarket gets the key prefix for all commitments in a market.
-func GetKeyPrefixCommitmentsToMarket(marketID uint32) []byte {
-	return keyPrefixMarketCommitments(marketID, 0)
+// GetIndexKeyPrefixAddressExternalIDToOrder creates the prefix for the address and external id to order index
+// limited to the given address and external id.
+func GetIndexKeyPrefixAddressExternalIDToOrder(addr sdk.AccAddress, externalID string) []byte {
+	return indexPrefixAddressExternalIDToOrder(addr, externalID, 0)
 }
 
-// MakeKeyCommitment creates the key to use for a commitment
-func MakeKeyCommitment(marketID uint32, addr sdk.AccAddress) []byte {
-	if len(addr) == 0 {
-		panic(errors.New("empty address not allowed"))
-	}
-	suffix := address.MustLengthPrefix(addr)
-	rv := keyPrefixMarketCommitments(marketID, len(suffix))
-	rv = append(rv, suffix...)
+// MakeIndexKeyAddressExternalIDToOrder creates the key to use for the address and external id to order index
+// for the provided values.
+func MakeIndexKeyAddressExternalIDToOrder(addr sdk.AccAddress, externalID string, orderID uint64) []byte {
+	rv := indexPrefixAddressExternalIDToOrder(addr, externalID, 8)
+	rv = append(rv, uint64Bz(orderID)...)
 	return rv
 }
 
-// ParseKeyCommitment extracts the market id and address from a commitment key.
-// The input must have the format: <type byte> | <market id> | <addr length byte> | <addr>.
-func ParseKeyCommitment(key []byte) (uint32, sdk.AccAddress, error) {
-	if len(key) < 7 {
-		return 0, nil, fmt.Errorf("cannot parse commitment key: only has %d bytes, expected at least 7", len(key))
-	}
-	if key[0] != KeyTypeCommitment {
-		return 0, nil, fmt.Errorf("cannot parse commitment key: incorrect type byte %#x", key[0])
+// ParseIndexKeyAddressExternalIDToOrder extracts the address, external id, and order id from
+// an address and external id to order index key.
+// The input must have the format: <type byte> | <addr length byte> | <addr> | <external id length byte>
+// | <external id> | <order id> (8 bytes).
+func ParseIndexKeyAddressExternalIDToOrder(key []byte) (sdk.AccAddress, string, uint64, error) {
+	if len(key) < 13 {
+		return nil, "", 0, fmt.Errorf("cannot parse address external id to order key: only has %d bytes, expected at least 13", len(key))
 	}
-	marketIDBz, addrBz := key[1:5], key[5:]
-	marketID, _ := uint32FromBz(marketIDBz)
-	addr, err := ParseKeySuffixCommitment(addrBz)
-	if err != nil {
-		return 0, nil, err
+	if key[0] != KeyTypeAddressExternalIDToOrderIndex {
+		return nil, "", 0, fmt.Errorf("cannot parse address external id to order key: unknown type byte %#x, expected %#x",
+			key[0], KeyTypeAddressExternalIDToOrderIndex)
 	}
-	return marketID, addr, nil
-}
 
-// ParseKeySuffixCommitment parses the addr portion of a commitment key.
-// The input must have the format: <addr length byte> | <addr>.
-func ParseKeySuffixCommitment(suffix []byte) (sdk.AccAddress, error) {
-	addr, left, err := parseLengthPrefixedAddr(suffix)
+	addr, rest, err := parseLengthPrefixedAddr(key[1:])
 	if err != nil {
-		return nil, fmt.Errorf("cannot parse address from commitment key: %w", err)
+		return nil, "", 0, fmt.Errorf("cannot parse address external id to order key: %w", err)
 	}
-	if len(left) != 0 {
-		return nil, fmt.Errorf("cannot parse address from commitment key: found %d bytes after address, expected 0", len(left))
+	if len(rest) < 10 {
+		return nil, "", 0, fmt.Errorf("cannot parse address external id to order key: only %d bytes after the address, expected at least 10", len(rest))
 	}
-	return addr, nil
+	l := int(rest[0])
+	if len(rest) != 1+l+8 {
+		return nil, "", 0, fmt.Errorf("cannot parse address external id to order key: external id length %d with %d bytes after the address", l, len(rest))
+	}
+	externalID := string(rest[1 : 1+l])
+	orderID, _ := uint64FromBz(rest[1+l:])
+	return addr, externalID, orderID, nil
 }
 
-// keyPrefixPayment creates the key prefix for payments with the provided extra capacity for additional elements.
-func keyPrefixPayment(extraCap int) []byte {
-	rv := make([]byte, 1, 1+extraCap)
-	rv[0] = KeyTypePayment
+// indexPrefixExpirationToOrder creates the prefix for the expiration to order index entries with some extra space for the rest.
+func indexPrefixExpirationToOrder(expiration time.Time, extraCap int) []byte {
+	expSecs := uint64(expiration.Unix()) //nolint:gosec // G115: Expirations are validated to be after the epoch.
+	return prepKey(KeyTypeExpirationToOrderIndex, uint64Bz(expSecs), extraCap)
+}
+
+// GetIndexKeyPrefixExpirationToOrder gets the key prefix for the entire expiration to order index.
+func GetIndexKeyPrefixExpirationToOrder() []byte {
+	return []byte{KeyTypeExpirationToOrderIndex}
+}
+
+// GetIndexKeyPrefixExpirationToOrderAt creates a key prefix for the expiration to order index
+// limited to orders that expire during the same second as the provided time.
+func GetIndexKeyPrefixExpirationToOrderAt(expiration time.Time) []byte {
+	return indexPrefixExpirationToOrder(expiration, 0)
+}
+
+// MakeIndexKeyExpirationToOrder creates the key to use for the expiration to order index for the provided values.
+func MakeIndexKeyExpirationToOrder(expiration time.Time, orderID uint64) []byte {
+	rv := indexPrefixExpirationToOrder(expiration, 8)
+	rv = append(rv, uint64Bz(orderID)...)
 	return rv
 }
 
-// keyPrefixPaymentsForSource creates the key prefix for payments for a source with the provided extra capacity for additional elements.
-func keyPrefixPaymentsForSource(source sdk.AccAddress, extraCap int) []byte {
-	if len(source) == 0 {
-		panic(errors.New("empty source address not allowed"))
+// ParseIndexKeyExpirationToOrder extracts the expiration and order id from an expiration to order index key.
+// The input can have the following formats:
+//   - <type byte> | <expiration> (8 bytes) | <order id> (8 bytes)
+//   - <expiration> (8 bytes) | <order id> (8 bytes)
+//
+// The returned expiration only has second precision and is in UTC.
+func ParseIndexKeyExpirationToOrder(key []byte) (time.Time, uint64, error) {
+	switch len(key) {
+	case 16:
+	case 17:
+		if key[0] != KeyTypeExpirationToOrderIndex {
+			return time.Time{}, 0, fmt.Errorf("cannot parse expiration to order key: unknown type byte %#x, expected %#x",
+				key[0], KeyTypeExpirationToOrderIndex)
+		}
+		key = key[1:]
+	default:
+		return time.Time{}, 0, fmt.Errorf("cannot parse expiration to order key: length %d, expected 16 or 17", len(key))
 	}
-	sourceBz := address.MustLengthPrefix(source)
-	rv := keyPrefixPayment(len(sourceBz) + extraCap)
-	rv = append(rv, sourceBz...)
-	return rv
+
+	expSecs, _ := uint64FromBz(key[:8])
+	orderID, _ := uint64FromBz(key[8:])
+	return time.Unix(int64(expSecs), 0).UTC(), orderID, nil //nolint:gosec // G115: We wrote it from an int64.
 }
 
-// GetKeyPrefixAllPayments gets the key prefix for all payments.
-func GetKeyPrefixAllPayments() []byte {
-	return keyPrefixPayment(0)
+// keyPrefixTriggerOrder creates the key prefix for trigger orders with the provided extra capacity for additional elements.
+func keyPrefixTriggerOrder(extraCap int) []byte {
+	return prepKey(KeyTypeTriggerOrder, nil, extraCap)
 }
 
-// GetKeyPrefixPaymentsForSource gets the key prefix for payments with a given source.
-func GetKeyPrefixPaymentsForSource(source sdk.AccAddress) []byte {
-	return keyPrefixPaymentsForSource(source, 0)
+// GetKeyPrefixTriggerOrder gets the key prefix for all trigger orders.
+func GetKeyPrefixTriggerOrder() []byte {
+	return keyPrefixTriggerOrder(0)
 }
 
-// MakeKeyPayment creates the key for a payment.
-func MakeKeyPayment(source sdk.AccAddress, externalID string) []byte {
-	suffix := []byte(externalID)
-	rv := keyPrefixPaymentsForSource(source, len(suffix))
+// MakeKeyTriggerOrder creates the key to use for a trigger order with the given order id.
+func MakeKeyTriggerOrder(orderID uint64) []byte {
+	suffix := uint64Bz(orderID)
+	rv := keyPrefixTriggerOrder(len(suffix))
 	rv = append(rv, suffix...)
 	return rv
 }
 
-// ParseKeyPayment parses the full key that identifies a payment.
-// The input must have the format: <type byte> | <source length byte> | <source> | <external id>.
-func ParseKeyPayment(key []byte) (sdk.AccAddress, string, error) {
-	if len(key) < 3 {
-		return nil, "", fmt.Errorf("cannot parse payment key: only has %d bytes, expected at least 3", len(key))
-	}
-	if key[0] != KeyTypePayment {
-		return nil, "", fmt.Errorf("cannot parse payment key: incorrect type byte %#x, expected %#x", key[0], KeyTypePayment)
-	}
-	return ParseKeySuffixPayment(key[1:])
+// indexPrefixMarketToTriggerOrder creates the prefix for the market to trigger order index entries with some extra space for the rest.
+func indexPrefixMarketToTriggerOrder(marketID uint32, extraCap int) []byte {
+	return prepKey(KeyTypeMarketToTriggerOrderIndex, uint32Bz(marketID), extraCap)
 }
 
-// ParseKeySuffixPayment parses a payment key that does not have its type byte.
-// The input must have the format: <source length byte> | <source> | <external id>.
-func ParseKeySuffixPayment(suffix []byte) (sdk.AccAddress, string, error) {
-	addr, left, err := parseLengthPrefixedAddr(suffix)
-	if err != nil {
-		return nil, "", fmt.Errorf("cannot parse payment key: invalid source: %w", err)
-	}
-	return addr, string(left), nil
+// GetIndexKeyPrefixMarketToTriggerOrder creates the prefix for the market to trigger order index limited to the given market id.
+func GetIndexKeyPrefixMarketToTriggerOrder(marketID uint32) []byte {
+	return indexPrefixMarketToTriggerOrder(marketID, 0)
 }
 
-// indexPrefixTargetToPayments creates the prefix for the target to payments index entries with some extra space for the rest.
-func indexPrefixTargetToPayments(target sdk.AccAddress, extraCap int) []byte {
-	if len(target) == 0 {
-		panic(errors.New("empty target address not allowed"))
-	}
-	return prepKey(KeyTypeTargetToPaymentIndex, address.MustLengthPrefix(target), extraCap)
+// MakeIndexKeyMarketToTriggerOrder creates the key to use for the market to trigger order index with the given ids.
+func MakeIndexKeyMarketToTriggerOrder(marketID uint32, orderID uint64) []byte {
+	rv := indexPrefixMarketToTriggerOrder(marketID, 8)
+	rv = append(rv, uint64Bz(orderID)...)
+	return rv
 }
 
-// indexPrefixTargetToPayments creates the prefix for the target to payments index entries, specific to a source, with some extra space for the rest.
-func indexPrefixTargetToPaymentsForSource(target, source sdk.AccAddress, extraCap int) []byte {
-	if len(source) == 0 {
-		panic(errors.New("empty source address not allowed"))
+// indexPrefixAddressToTriggerOrder creates the prefix for the address to trigger order index entries with some extra space for the rest.
+func indexPrefixAddressToTriggerOrder(addr sdk.AccAddress, extraCap int) []byte {
+	if len(addr) == 0 {
+		panic(errors.New("empty address not allowed"))
 	}
-	suffix := address.MustLengthPrefix(source)
-	rv := indexPrefixTargetToPayments(target, len(suffix)+extraCap)
-	rv = append(rv, suffix...)
-	return rv
+	return prepKey(KeyTypeAddressToTriggerOrderIndex, address.MustLengthPrefix(addr), extraCap)
 }
 
-// GetIndexKeyPrefixTargetToPayments creates a key prefix for the target to payments index.
-func GetIndexKeyPrefixTargetToPayments(target sdk.AccAddress) []byte {
-	return indexPrefixTargetToPayments(target, 0)
+// GetIndexKeyPrefixAddressToTriggerOrder creates a key prefix for the address to trigger order index limited to the given address.
+func GetIndexKeyPrefixAddressToTriggerOrder(addr sdk.AccAddress) []byte {
+	return indexPrefixAddressToTriggerOrder(addr, 0)
 }
 
-// GetIndexKeyPrefixTargetToPaymentsForSource creates a key prefix for the target to payments index, specific to a source.
-func GetIndexKeyPrefixTargetToPaymentsForSource(target, source sdk.AccAddress) []byte {
-	return indexPrefixTargetToPaymentsForSource(target, source, 0)
+// MakeIndexKeyAddressToTriggerOrder creates the key to use for the address to trigger order index with the given values.
+func MakeIndexKeyAddressToTriggerOrder(addr sdk.AccAddress, orderID uint64) []byte {
+	rv := indexPrefixAddressToTriggerOrder(addr, 8)
+	rv = append(rv, uint64Bz(orderID)...)
+	return rv
 }
 
-// MakeIndexKeyTargetToPayment creates the key for the target to payment index.
-func MakeIndexKeyTargetToPayment(target, source sdk.AccAddress, externalID string) []byte {
-	suffix := []byte(externalID)
-	rv := indexPrefixTargetToPaymentsForSource(target, source, len(suffix))
-	rv = append(rv, suffix...)
-	return rv
+// keyPrefixOrderLink creates the key prefix for order links with the provided extra capacity for additional elements.
+func keyPrefixOrderLink(extraCap int) []byte {
+	return prepKey(KeyTypeOrderLink, nil, extraCap)
+}
+
+// keyPrefixMarketOrderLink creates the key prefix for order links in a market with the provided extra capacity for additional elements.
+func keyPrefixMarketOrderLink(marketID uint32, extraCap int) []byte {
+	return prepKey(KeyTypeOrderLink, uint32Bz(marketID), extraCap)
+}
+
+// GetKeyPrefixOrderLink gets the key prefix for all order links.
+func GetKeyPrefixOrderLink() []byte {
+	return keyPrefixOrderLink(0)
+}
+
+// GetKeyPrefixMarketOrderLink gets the key prefix for all order links in the given market.
+func GetKeyPrefixMarketOrderLink(marketID uint32) []byte {
+	return keyPrefixMarketOrderLink(marketID, 0)
+}
+
+// MakeKeyOrderLink creates the key to use for the link of the given order in the given market.
+func MakeKeyOrderLink(marketID uint32, orderID uint64) []byte {
+	rv := keyPrefixMarketOrderLink(marketID, 8)
+	rv = append(rv, uint64Bz(orderID)...)
+	return rv
+}
+
+// ParseKeyOrderLink will extract the market id and order id from an order link key.
+// The input can have the following formats:
+//   - <type byte> | <market id> (4 bytes) | <order id> (8 bytes)
+//   - <market id> (4 bytes) | <order id> (8 bytes)
+//   - <order id> (8 bytes)
+//
+// In the case where just the <order id> is provided, the returned market id will be 0.
+func ParseKeyOrderLink(key []byte) (uint32, uint64, error) {
+	var marketIDBz, orderIDBz []byte
+	switch len(key) {
+	case 8:
+		orderIDBz = key
+	case 12:
+		marketIDBz = key[:4]
+		orderIDBz = key[4:]
+	case 13:
+		if key[0] != KeyTypeOrderLink {
+			return 0, 0, fmt.Errorf("cannot parse order link key: unknown type byte %#x, expected %#x",
+				key[0], KeyTypeOrderLink)
+		}
+		marketIDBz = key[1:5]
+		orderIDBz = key[5:]
+	default:
+		return 0, 0, fmt.Errorf("cannot parse order link key: length %d, expected 8, 12, or 13", len(key))
+	}
+
+	var marketID uint32
+	if len(marketIDBz) > 0 {
+		marketID, _ = uint32FromBz(marketIDBz)
+	}
+	orderID, _ := uint64FromBz(orderIDBz)
+	return marketID, orderID, nil
+}
+
+// unitPriceScale is the amount that an order's unit price is multiplied by for the price to order index.
+var unitPriceScale = new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+
+// unitPriceBz converts the unit price of an order (price / assets) into a length-prefixed byte slice.
+// The result sorts the same way as the unit prices do (up to 18 decimal places).
+func unitPriceBz(price, assets sdkmath.Int) []byte {
+	val := new(big.Int)
+	if assets.IsPositive() && price.IsPositive() {
+		val.Mul(price.BigInt(), unitPriceScale)
+		val.Quo(val, assets.BigInt())
+	}
+	bz := val.Bytes()
+	rv := make([]byte, 0, 1+len(bz))
+	rv = append(rv, byte(len(bz)))
+	rv = append(rv, bz...)
+	return rv
+}
+
+// prepMarketDenomsKey creates a key with the provided type byte followed by the market id and
+// the length-prefixed asset and price denoms, with some extra space for the rest.
+func prepMarketDenomsKey(typeByte byte, marketID uint32, assetDenom, priceDenom string, extraCap int) []byte {
+	assetBz := address.MustLengthPrefix([]byte(assetDenom))
+	priceBz := address.MustLengthPrefix([]byte(priceDenom))
+	rv := prepKey(typeByte, uint32Bz(marketID), len(assetBz)+len(priceBz)+extraCap)
+	rv = append(rv, assetBz...)
+	rv = append(rv, priceBz...)
+	return rv
+}
+
+// indexPrefixPriceToOrder creates the prefix for the price to order index entries for a market and denom pair
+// with some extra space for the rest.
+func indexPrefixPriceToOrder(marketID uint32, assetDenom, priceDenom string, extraCap int) []byte {
+	return prepMarketDenomsKey(KeyTypePriceToOrderIndex, marketID, assetDenom, priceDenom, extraCap)
+}
+
+// GetIndexKeyPrefixPriceToOrder creates the prefix for the price to order index limited to the given market and denoms.
+func GetIndexKeyPrefixPriceToOrder(marketID uint32, assetDenom, priceDenom string) []byte {
+	return indexPrefixPriceToOrder(marketID, assetDenom, priceDenom, 0)
+}
+
+// GetIndexKeyPrefixPriceToOrderType creates the prefix for the price to order index limited to the given
+// market, denoms, and order type.
+func GetIndexKeyPrefixPriceToOrderType(marketID uint32, assetDenom, priceDenom string, orderTypeByte byte) []byte {
+	rv := indexPrefixPriceToOrder(marketID, assetDenom, priceDenom, 1)
+	rv = append(rv, orderTypeByte)
+	return rv
+}
+
+// MakeIndexKeyPriceToOrder creates the key to use in the price to order index for the provided order.
+func MakeIndexKeyPriceToOrder(order exchange.OrderI) []byte {
+	assets := order.GetAssets()
+	price := order.GetPrice()
+	unitPrice := unitPriceBz(price.Amount, assets.Amount)
+	rv := indexPrefixPriceToOrder(order.GetMarketID(), assets.Denom, price.Denom, 1+len(unitPrice)+8)
+	rv = append(rv, order.GetOrderTypeByte())
+	rv = append(rv, unitPrice...)
+	rv = append(rv, uint64Bz(order.GetOrderID())...)
+	return rv
+}
+
+// ParseIndexKeySuffixPriceToOrder extracts the unit price and order id from the part of
+// a price to order index key that comes after the order type byte.
+// The input must have the format len(<unit price>) (1 byte) | <unit price> | <order id> (8 bytes).
+// The returned unit price has the same format as it does in the key (i.e. it's length-prefixed).
+func ParseIndexKeySuffixPriceToOrder(suffix []byte) ([]byte, uint64, error) {
+	if len(suffix) < 9 {
+		return nil, 0, fmt.Errorf("cannot parse price to order key suffix: only has %d bytes, expected at least 9", len(suffix))
+	}
+	unitPriceLen := int(suffix[0])
+	if len(suffix) != 9+unitPriceLen {
+		return nil, 0, fmt.Errorf("cannot parse price to order key suffix: length %d, expected %d", len(suffix), 9+unitPriceLen)
+	}
+	orderID, _ := uint64FromBz(suffix[1+unitPriceLen:])
+	return suffix[:1+unitPriceLen], orderID, nil
+}
+
+// GetKeyPrefixSettlementPrice gets the key prefix for all settlement price entries.
+func GetKeyPrefixSettlementPrice() []byte {
+	return []byte{KeyTypeSettlementPrice}
+}
+
+// GetKeyPrefixSettlementPriceDenoms creates the key prefix for the settlement prices of a denom pair in a market.
+func GetKeyPrefixSettlementPriceDenoms(marketID uint32, assetDenom, priceDenom string) []byte {
+	return prepMarketDenomsKey(KeyTypeSettlementPrice, marketID, assetDenom, priceDenom, 0)
+}
+
+// MakeKeySettlementPrice creates the key to use for a settlement price of a denom pair in a market at the given time.
+// Only the seconds of the time are used.
+func MakeKeySettlementPrice(marketID uint32, assetDenom, priceDenom string, settlementTime time.Time) []byte {
+	rv := prepMarketDenomsKey(KeyTypeSettlementPrice, marketID, assetDenom, priceDenom, 8)
+	rv = append(rv, uint64Bz(uint64(settlementTime.Unix()))...) //nolint:gosec // G115: Block times are after the epoch.
+	return rv
+}
+
+// keyPrefixSettlementRecord creates the key prefix for settlement records with the provided extra capacity for additional elements.
+func keyPrefixSettlementRecord(extraCap int) []byte {
+	return prepKey(KeyTypeSettlementRecord, nil, extraCap)
+}
+
+// keyPrefixMarketSettlementRecord creates the key prefix for settlement records in a market with the provided extra capacity for additional elements.
+func keyPrefixMarketSettlementRecord(marketID uint32, extraCap int) []byte {
+	return prepKey(KeyTypeSettlementRecord, uint32Bz(marketID), extraCap)
+}
+
+// GetKeyPrefixSettlementRecord gets the key prefix for all settlement records.
+func GetKeyPrefixSettlementRecord() []byte {
+	return keyPrefixSettlementRecord(0)
+}
+
+// GetKeyPrefixMarketSettlementRecord gets the key prefix for all settlement records in the given market.
+func GetKeyPrefixMarketSettlementRecord(marketID uint32) []byte {
+	return keyPrefixMarketSettlementRecord(marketID, 0)
+}
+
+// GetKeyPrefixMarketSettlementRecordHeight gets the key prefix for the settlement records in the given market at the given height.
+func GetKeyPrefixMarketSettlementRecordHeight(marketID uint32, height int64) []byte {
+	rv := keyPrefixMarketSettlementRecord(marketID, 8)
+	rv = append(rv, uint64Bz(uint64(height))...) //nolint:gosec // G115: Block heights are not negative.
+	return rv
+}
+
+// MakeKeySettlementRecord creates the key to use for a settlement record.
+func MakeKeySettlementRecord(marketID uint32, height int64, sequence uint32) []byte {
+	rv := keyPrefixMarketSettlementRecord(marketID, 12)
+	rv = append(rv, uint64Bz(uint64(height))...) //nolint:gosec // G115: Block heights are not negative.
+	rv = append(rv, uint32Bz(sequence)...)
+	return rv
+}
+
+// ParseKeySuffixSettlementRecord extracts the height and sequence from the last 12 bytes of a settlement record key.
+// The returned bool will be false only if the key has fewer than 12 bytes.
+func ParseKeySuffixSettlementRecord(key []byte) (int64, uint32, bool) {
+	if len(key) < 12 {
+		return 0, 0, false
+	}
+	height, _ := uint64FromBz(key[len(key)-12:])
+	sequence, _ := uint32FromBz(key[len(key)-4:])
+	return int64(height), sequence, true //nolint:gosec // G115: Heights were stored from an int64.
+}
+
+// denomsBz creates the length-prefixed asset denom followed by the length-prefixed price denom.
+func denomsBz(assetDenom, priceDenom string) []byte {
+	assetBz := address.MustLengthPrefix([]byte(assetDenom))
+	priceBz := address.MustLengthPrefix([]byte(priceDenom))
+	rv := make([]byte, 0, len(assetBz)+len(priceBz))
+	rv = append(rv, assetBz...)
+	rv = append(rv, priceBz...)
+	return rv
+}
+
+// parseDenomsBz extracts the asset and price denoms from the start of the provided bytes.
+// Returns the denoms and any bytes left over.
+func parseDenomsBz(bz []byte) (string, string, []byte, error) {
+	denoms := make([]string, 2)
+	for i, name := range []string{"asset", "price"} {
+		if len(bz) == 0 {
+			return "", "", nil, fmt.Errorf("no %s denom length byte", name)
+		}
+		l := int(bz[0])
+		if len(bz) < 1+l {
+			return "", "", nil, fmt.Errorf("%s denom length byte is %d but there are only %d bytes left", name, l, len(bz)-1)
+		}
+		denoms[i] = string(bz[1 : 1+l])
+		bz = bz[1+l:]
+	}
+	return denoms[0], denoms[1], bz, nil
+}
+
+// keyPrefixNAVRecordDenoms creates the key prefix for the NAV records of a denom pair with the provided extra capacity for additional elements.
+func keyPrefixNAVRecordDenoms(assetDenom, priceDenom string, extraCap int) []byte {
+	return prepKey(KeyTypeNAVRecord, denomsBz(assetDenom, priceDenom), extraCap)
+}
+
+// GetKeyPrefixNAVRecord gets the key prefix for all NAV records.
+func GetKeyPrefixNAVRecord() []byte {
+	return []byte{KeyTypeNAVRecord}
+}
+
+// GetKeyPrefixNAVRecordDenoms gets the key prefix for all NAV records of a denom pair.
+func GetKeyPrefixNAVRecordDenoms(assetDenom, priceDenom string) []byte {
+	return keyPrefixNAVRecordDenoms(assetDenom, priceDenom, 0)
+}
+
+// GetKeyPrefixNAVRecordDenomsHeight gets the key prefix for the NAV records of a denom pair at the given height.
+func GetKeyPrefixNAVRecordDenomsHeight(assetDenom, priceDenom string, height int64) []byte {
+	rv := keyPrefixNAVRecordDenoms(assetDenom, priceDenom, 8)
+	rv = append(rv, uint64Bz(uint64(height))...) //nolint:gosec // G115: Block heights are not negative.
+	return rv
+}
+
+// MakeKeyNAVRecord creates the key to use for a NAV record.
+func MakeKeyNAVRecord(assetDenom, priceDenom string, height int64, marketID uint32) []byte {
+	rv := keyPrefixNAVRecordDenoms(assetDenom, priceDenom, 12)
+	rv = append(rv, uint64Bz(uint64(height))...) //nolint:gosec // G115: Block heights are not negative.
+	rv = append(rv, uint32Bz(marketID)...)
+	return rv
+}
+
+// ParseKeySuffixNAVRecord extracts the height and market id from the last 12 bytes of a NAV record key.
+// The returned bool will be false only if the key has fewer than 12 bytes.
+func ParseKeySuffixNAVRecord(key []byte) (int64, uint32, bool) {
+	if len(key) < 12 {
+		return 0, 0, false
+	}
+	height, _ := uint64FromBz(key[len(key)-12:])
+	marketID, _ := uint32FromBz(key[len(key)-4:])
+	return int64(height), marketID, true //nolint:gosec // G115: Heights were stored from an int64.
+}
+
+// indexPrefixHeightToNAVRecord creates the prefix for the height to NAV record index with some extra space for the rest.
+func indexPrefixHeightToNAVRecord(extraCap int) []byte {
+	return prepKey(KeyTypeHeightToNAVRecordIndex, nil, extraCap)
+}
+
+// GetIndexKeyPrefixHeightToNAVRecord gets the prefix for all height to NAV record index entries.
+func GetIndexKeyPrefixHeightToNAVRecord() []byte {
+	return indexPrefixHeightToNAVRecord(0)
+}
+
+// GetIndexKeyPrefixHeightToNAVRecordAt gets the prefix for the height to NAV record index entries at the given height.
+func GetIndexKeyPrefixHeightToNAVRecordAt(height int64) []byte {
+	rv := indexPrefixHeightToNAVRecord(8)
+	rv = append(rv, uint64Bz(uint64(height))...) //nolint:gosec // G115: Block heights are not negative.
+	return rv
+}
+
+// MakeIndexKeyHeightToNAVRecord creates the key to use in the height to NAV record index for the provided values.
+func MakeIndexKeyHeightToNAVRecord(height int64, assetDenom, priceDenom string, marketID uint32) []byte {
+	denomBz := denomsBz(assetDenom, priceDenom)
+	rv := indexPrefixHeightToNAVRecord(8 + len(denomBz) + 4)
+	rv = append(rv, uint64Bz(uint64(height))...) //nolint:gosec // G115: Block heights are not negative.
+	rv = append(rv, denomBz...)
+	rv = append(rv, uint32Bz(marketID)...)
+	return rv
+}
+
+// ParseIndexKeyHeightToNAVRecord extracts the height, denoms, and market id from a height to NAV record index key.
+// The input must have the format: <type byte> | <height> (8 bytes) | <asset denom length byte> | <asset denom>
+// | <price denom length byte> | <price denom> | <market id> (4 bytes).
+func ParseIndexKeyHeightToNAVRecord(key []byte) (int64, string, string, uint32, error) {
+	if len(key) < 15 {
+		return 0, "", "", 0, fmt.Errorf("cannot parse height to nav record key: only has %d bytes, expected at least 15", len(key))
+	}
+	if key[0] != KeyTypeHeightToNAVRecordIndex {
+		return 0, "", "", 0, fmt.Errorf("cannot parse height to nav record key: unknown type byte %#x, expected %#x",
+			key[0], KeyTypeHeightToNAVRecordIndex)
+	}
+
+	height, _ := uint64FromBz(key[1:9])
+	assetDenom, priceDenom, left, err := parseDenomsBz(key[9:])
+	if err != nil {
+		return 0, "", "", 0, fmt.Errorf("cannot parse height to nav record key: %w", err)
+	}
+	if len(left) != 4 {
+		return 0, "", "", 0, fmt.Errorf("cannot parse height to nav record key: market id has %d bytes, expected 4", len(left))
+	}
+	marketID, _ := uint32FromBz(left)
+	return int64(height), assetDenom, priceDenom, marketID, nil //nolint:gosec // G115: Heights were stored from an int64.
+}
+
+// keyPrefixScheduledFeeChange creates the key prefix for scheduled fee changes with the provided extra capacity for additional elements.
+func keyPrefixScheduledFeeChange(extraCap int) []byte {
+	return prepKey(KeyTypeScheduledFeeChange, nil, extraCap)
+}
+
+// keyPrefixMarketScheduledFeeChange creates the key prefix for scheduled fee changes in a market with the provided extra capacity for additional elements.
+func keyPrefixMarketScheduledFeeChange(marketID uint32, extraCap int) []byte {
+	return prepKey(KeyTypeScheduledFeeChange, uint32Bz(marketID), extraCap)
+}
+
+// GetKeyPrefixScheduledFeeChange gets the key prefix for all scheduled fee changes.
+func GetKeyPrefixScheduledFeeChange() []byte {
+	return keyPrefixScheduledFeeChange(0)
+}
+
+// GetKeyPrefixMarketScheduledFeeChange gets the key prefix for all scheduled fee changes in the given market.
+func GetKeyPrefixMarketScheduledFeeChange(marketID uint32) []byte {
+	return keyPrefixMarketScheduledFeeChange(marketID, 0)
+}
+
+// MakeKeyScheduledFeeChange creates the key to use for a scheduled fee change.
+func MakeKeyScheduledFeeChange(marketID uint32, sequence uint64) []byte {
+	rv := keyPrefixMarketScheduledFeeChange(marketID, 8)
+	rv = append(rv, uint64Bz(sequence)...)
+	return rv
+}
+
+// ParseKeySuffixScheduledFeeChange extracts the sequence from the last 8 bytes of a scheduled fee change key.
+// The returned bool will be false only if the key has fewer than 8 bytes.
+func ParseKeySuffixScheduledFeeChange(key []byte) (uint64, bool) {
+	if len(key) < 8 {
+		return 0, false
+	}
+	sequence, _ := uint64FromBz(key[len(key)-8:])
+	return sequence, true
+}
+
+// keyPrefixCommitment creates the key prefix for commitments with the provided extra capacity for additional elements.
+func keyPrefixCommitment(extraCap int) []byte {
+	return prepKey(KeyTypeCommitment, nil, extraCap)
+}
+
+// keyPrefixMarketCommitments creates the key prefix for comitments to a market with the provided extra capacity for additional elements.
+func keyPrefixMarketCommitments(marketID uint32, extraCap int) []byte {
+	suffix := uint32Bz(marketID)
+	rv := keyPrefixCommitment(extraCap + len(suffix))
+	rv = append(rv, suffix...)
+	return rv
+}
+
+// GetKeyPrefixCommitments gets the key prefix for all commitments.
+func GetKeyPrefixCommitments() []byte {
+	return keyPrefixCommitment(0)
+}
+
+// GetKeyPrefixCommitmentsToMarket gets the key prefix for all commitments in a market.
+func GetKeyPrefixCommitmentsToMarket(marketID uint32) []byte {
+	return keyPrefixMarketCommitments(marketID, 0)
+}
+
+// MakeKeyCommitment creates the key to use for a commitment
+func MakeKeyCommitment(marketID uint32, addr sdk.AccAddress) []byte {
+	if len(addr) == 0 {
+		panic(errors.New("empty address not allowed"))
+	}
+	suffix := address.MustLengthPrefix(addr)
+	rv := keyPrefixMarketCommitments(marketID, len(suffix))
+	rv = append(rv, suffix...)
+	return rv
+}
+
+// ParseKeyCommitment extracts the market id and address from a commitment key.
+// The input must have the format: <type byte> | <market id> | <addr length byte> | <addr>.
+func ParseKeyCommitment(key []byte) (uint32, sdk.AccAddress, error) {
+	if len(key) < 7 {
+		return 0, nil, fmt.Errorf("cannot parse commitment key: only has %d bytes, expected at least 7", len(key))
+	}
+	if key[0] != KeyTypeCommitment {
+		return 0, nil, fmt.Errorf("cannot parse commitment key: incorrect type byte %#x", key[0])
+	}
+	marketIDBz, addrBz := key[1:5], key[5:]
+	marketID, _ := uint32FromBz(marketIDBz)
+	addr, err := ParseKeySuffixCommitment(addrBz)
+	if err != nil {
+		return 0, nil, err
+	}
+	return marketID, addr, nil
+}
+
+// ParseKeySuffixCommitment parses the addr portion of a commitment key.
+// The input must have the format: <addr length byte> | <addr>.
+func ParseKeySuffixCommitment(suffix []byte) (sdk.AccAddress, error) {
+	addr, left, err := parseLengthPrefixedAddr(suffix)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse address from commitment key: %w", err)
+	}
+	if len(left) != 0 {
+		return nil, fmt.Errorf("cannot parse address from commitment key: found %d bytes after address, expected 0", len(left))
+	}
+	return addr, nil
+}
+
+// MakeKeyCommitmentExpiration creates the key to use for a commitment's expiration.
+func MakeKeyCommitmentExpiration(marketID uint32, addr sdk.AccAddress) []byte {
+	if len(addr) == 0 {
+		panic(errors.New("empty address not allowed"))
+	}
+	suffix := address.MustLengthPrefix(addr)
+	rv := prepKey(KeyTypeCommitmentExpiration, uint32Bz(marketID), len(suffix))
+	rv = append(rv, suffix...)
+	return rv
+}
+
+// indexPrefixExpirationToCommitment creates the prefix for the expiration to commitment index entries with some extra space for the rest.
+func indexPrefixExpirationToCommitment(expiration time.Time, extraCap int) []byte {
+	expSecs := uint64(expiration.Unix()) //nolint:gosec // G115: Expirations are validated to be after the epoch.
+	return prepKey(KeyTypeExpirationToCommitmentIndex, uint64Bz(expSecs), extraCap)
+}
+
+// GetIndexKeyPrefixExpirationToCommitment gets the key prefix for the entire expiration to commitment index.
+func GetIndexKeyPrefixExpirationToCommitment() []byte {
+	return []byte{KeyTypeExpirationToCommitmentIndex}
+}
+
+// GetIndexKeyPrefixExpirationToCommitmentAt creates a key prefix for the expiration to commitment index
+// limited to commitments that expire during the same second as the provided time.
+func GetIndexKeyPrefixExpirationToCommitmentAt(expiration time.Time) []byte {
+	return indexPrefixExpirationToCommitment(expiration, 0)
+}
+
+// MakeIndexKeyExpirationToCommitment creates the key to use for the expiration to commitment index for the provided values.
+func MakeIndexKeyExpirationToCommitment(expiration time.Time, marketID uint32, addr sdk.AccAddress) []byte {
+	if len(addr) == 0 {
+		panic(errors.New("empty address not allowed"))
+	}
+	addrBz := address.MustLengthPrefix(addr)
+	rv := indexPrefixExpirationToCommitment(expiration, 4+len(addrBz))
+	rv = append(rv, uint32Bz(marketID)...)
+	rv = append(rv, addrBz...)
+	return rv
+}
+
+// ParseIndexKeyExpirationToCommitment extracts the expiration, market id, and address from an expiration to commitment index key.
+// The input must have the format: <type byte> | <expiration> (8 bytes) | <market id> (4 bytes) | <addr length byte> | <addr>.
+//
+// The returned expiration only has second precision and is in UTC.
+func ParseIndexKeyExpirationToCommitment(key []byte) (time.Time, uint32, sdk.AccAddress, error) {
+	if len(key) < 15 {
+		return time.Time{}, 0, nil, fmt.Errorf("cannot parse expiration to commitment key: only has %d bytes, expected at least 15", len(key))
+	}
+	if key[0] != KeyTypeExpirationToCommitmentIndex {
+		return time.Time{}, 0, nil, fmt.Errorf("cannot parse expiration to commitment key: unknown type byte %#x, expected %#x",
+			key[0], KeyTypeExpirationToCommitmentIndex)
+	}
+
+	expSecs, _ := uint64FromBz(key[1:9])
+	marketID, _ := uint32FromBz(key[9:13])
+	addr, left, err := parseLengthPrefixedAddr(key[13:])
+	if err != nil {
+		return time.Time{}, 0, nil, fmt.Errorf("cannot parse address from expiration to commitment key: %w", err)
+	}
+	if len(left) != 0 {
+		return time.Time{}, 0, nil, fmt.Errorf("cannot parse address from expiration to commitment key: found %d bytes after address, expected 0", len(left))
+	}
+	return time.Unix(int64(expSecs), 0).UTC(), marketID, addr, nil //nolint:gosec // G115: We wrote it from an int64.
+}
+
+// GetKeyPrefixCommitmentRewardPools gets the key prefix for all commitment reward pools.
+func GetKeyPrefixCommitmentRewardPools() []byte {
+	return []byte{KeyTypeCommitmentRewardPool}
+}
+
+// GetKeyPrefixMarketAuthorityTransfers gets the key prefix for all market authority transfers.
+func GetKeyPrefixMarketAuthorityTransfers() []byte {
+	return []byte{KeyTypeMarketAuthorityTransfer}
+}
+
+// MakeKeyMarketAuthorityTransfer creates the key to use for a market's pending authority transfer.
+func MakeKeyMarketAuthorityTransfer(marketID uint32) []byte {
+	return prepKey(KeyTypeMarketAuthorityTransfer, uint32Bz(marketID), 0)
+}
+
+// MakeKeyCommitmentRewardPool creates the key to use for a market's commitment reward pool.
+func MakeKeyCommitmentRewardPool(marketID uint32) []byte {
+	return prepKey(KeyTypeCommitmentRewardPool, uint32Bz(marketID), 0)
+}
+
+// ParseKeyCommitmentRewardPool extracts the market id from a commitment reward pool key.
+// The input must have the format: <type byte> | <market id>.
+func ParseKeyCommitmentRewardPool(key []byte) (uint32, error) {
+	if len(key) != 5 {
+		return 0, fmt.Errorf("cannot parse commitment reward pool key: has %d bytes, expected 5", len(key))
+	}
+	if key[0] != KeyTypeCommitmentRewardPool {
+		return 0, fmt.Errorf("cannot parse commitment reward pool key: incorrect type byte %#x", key[0])
+	}
+	marketID, _ := uint32FromBz(key[1:])
+	return marketID, nil
+}
+
+// keyPrefixCommitmentRewardsForAddr creates the key prefix for an address' commitment rewards with the provided extra capacity for additional elements.
+func keyPrefixCommitmentRewardsForAddr(addr sdk.AccAddress, extraCap int) []byte {
+	if len(addr) == 0 {
+		panic(errors.New("empty address not allowed"))
+	}
+	return prepKey(KeyTypeCommitmentReward, address.MustLengthPrefix(addr), extraCap)
+}
+
+// GetKeyPrefixCommitmentRewards gets the key prefix for all commitment rewards.
+func GetKeyPrefixCommitmentRewards() []byte {
+	return []byte{KeyTypeCommitmentReward}
+}
+
+// GetKeyPrefixCommitmentRewardsForAddr gets the key prefix for all commitment rewards of an address.
+func GetKeyPrefixCommitmentRewardsForAddr(addr sdk.AccAddress) []byte {
+	return keyPrefixCommitmentRewardsForAddr(addr, 0)
+}
+
+// MakeKeyCommitmentReward creates the key to use for the commitment rewards an address has in a market.
+func MakeKeyCommitmentReward(addr sdk.AccAddress, marketID uint32) []byte {
+	rv := keyPrefixCommitmentRewardsForAddr(addr, 4)
+	rv = append(rv, uint32Bz(marketID)...)
+	return rv
+}
+
+// ParseKeyCommitmentReward extracts the address and market id from a commitment reward key.
+// The input must have the format: <type byte> | <addr length byte> | <addr> | <market id>.
+func ParseKeyCommitmentReward(key []byte) (sdk.AccAddress, uint32, error) {
+	if len(key) < 7 {
+		return nil, 0, fmt.Errorf("cannot parse commitment reward key: only has %d bytes, expected at least 7", len(key))
+	}
+	if key[0] != KeyTypeCommitmentReward {
+		return nil, 0, fmt.Errorf("cannot parse commitment reward key: incorrect type byte %#x", key[0])
+	}
+	addr, left, err := parseLengthPrefixedAddr(key[1:])
+	if err != nil {
+		return nil, 0, fmt.Errorf("cannot parse address from commitment reward key: %w", err)
+	}
+	if len(left) != 4 {
+		return nil, 0, fmt.Errorf("cannot parse market id from commitment reward key: found %d bytes after address, expected 4", len(left))
+	}
+	marketID, _ := uint32FromBz(left)
+	return addr, marketID, nil
+}
+
+// keyPrefixCommitmentAccruals creates the key prefix for commitment accruals with the provided extra capacity for additional elements.
+func keyPrefixCommitmentAccruals(extraCap int) []byte {
+	return prepKey(KeyTypeCommitmentAccrual, nil, extraCap)
+}
+
+// keyPrefixMarketCommitmentAccruals creates the key prefix for commitment accruals in a market with the provided extra capacity for additional elements.
+func keyPrefixMarketCommitmentAccruals(marketID uint32, extraCap int) []byte {
+	suffix := uint32Bz(marketID)
+	rv := keyPrefixCommitmentAccruals(extraCap + len(suffix))
+	rv = append(rv, suffix...)
+	return rv
+}
+
+// GetKeyPrefixCommitmentAccruals gets the key prefix for all commitment accruals.
+func GetKeyPrefixCommitmentAccruals() []byte {
+	return keyPrefixCommitmentAccruals(0)
+}
+
+// GetKeyPrefixCommitmentAccrualsInMarket gets the key prefix for all commitment accruals in a market.
+func GetKeyPrefixCommitmentAccrualsInMarket(marketID uint32) []byte {
+	return keyPrefixMarketCommitmentAccruals(marketID, 0)
+}
+
+// MakeKeyCommitmentAccrual creates the key to use for the commitment accrual of an address in a market.
+func MakeKeyCommitmentAccrual(marketID uint32, addr sdk.AccAddress) []byte {
+	if len(addr) == 0 {
+		panic(errors.New("empty address not allowed"))
+	}
+	suffix := address.MustLengthPrefix(addr)
+	rv := keyPrefixMarketCommitmentAccruals(marketID, len(suffix))
+	rv = append(rv, suffix...)
+	return rv
+}
+
+// ParseKeyCommitmentAccrual extracts the market id and address from a commitment accrual key.
+// The input must have the format: <type byte> | <market id> | <addr length byte> | <addr>.
+func ParseKeyCommitmentAccrual(key []byte) (uint32, sdk.AccAddress, error) {
+	if len(key) < 7 {
+		return 0, nil, fmt.Errorf("cannot parse commitment accrual key: only has %d bytes, expected at least 7", len(key))
+	}
+	if key[0] != KeyTypeCommitmentAccrual {
+		return 0, nil, fmt.Errorf("cannot parse commitment accrual key: incorrect type byte %#x", key[0])
+	}
+	marketID, _ := uint32FromBz(key[1:5])
+	addr, left, err := parseLengthPrefixedAddr(key[5:])
+	if err != nil {
+		return 0, nil, fmt.Errorf("cannot parse address from commitment accrual key: %w", err)
+	}
+	if len(left) != 0 {
+		return 0, nil, fmt.Errorf("cannot parse address from commitment accrual key: found %d bytes after address, expected 0", len(left))
+	}
+	return marketID, addr, nil
+}
+
+// indexPrefixExpirationToAccessGrant creates the prefix for the expiration to access grant index entries with some extra space for the rest.
+func indexPrefixExpirationToAccessGrant(expiration time.Time, extraCap int) []byte {
+	expSecs := uint64(expiration.Unix()) //nolint:gosec // G115: Expirations are validated to be after the epoch.
+	return prepKey(KeyTypeExpirationToAccessGrantIndex, uint64Bz(expSecs), extraCap)
+}
+
+// GetIndexKeyPrefixExpirationToAccessGrant gets the key prefix for the entire expiration to access grant index.
+func GetIndexKeyPrefixExpirationToAccessGrant() []byte {
+	return []byte{KeyTypeExpirationToAccessGrantIndex}
+}
+
+// GetIndexKeyPrefixExpirationToAccessGrantAt creates a key prefix for the expiration to access grant index
+// limited to access grants that expire during the same second as the provided time.
+func GetIndexKeyPrefixExpirationToAccessGrantAt(expiration time.Time) []byte {
+	return indexPrefixExpirationToAccessGrant(expiration, 0)
+}
+
+// MakeIndexKeyExpirationToAccessGrant creates the key to use for the expiration to access grant index for the provided values.
+func MakeIndexKeyExpirationToAccessGrant(expiration time.Time, marketID uint32, addr sdk.AccAddress) []byte {
+	if len(addr) == 0 {
+		panic(errors.New("empty address not allowed"))
+	}
+	addrBz := address.MustLengthPrefix(addr)
+	rv := indexPrefixExpirationToAccessGrant(expiration, 4+len(addrBz))
+	rv = append(rv, uint32Bz(marketID)...)
+	rv = append(rv, addrBz...)
+	return rv
+}
+
+// ParseIndexKeyExpirationToAccessGrant extracts the expiration, market id, and address from an expiration to access grant index key.
+// The input must have the format: <type byte> | <expiration> (8 bytes) | <market id> (4 bytes) | <addr length byte> | <addr>.
+//
+// The returned expiration only has second precision and is in UTC.
+func ParseIndexKeyExpirationToAccessGrant(key []byte) (time.Time, uint32, sdk.AccAddress, error) {
+	if len(key) < 15 {
+		return time.Time{}, 0, nil, fmt.Errorf("cannot parse expiration to access grant key: only has %d bytes, expected at least 15", len(key))
+	}
+	if key[0] != KeyTypeExpirationToAccessGrantIndex {
+		return time.Time{}, 0, nil, fmt.Errorf("cannot parse expiration to access grant key: unknown type byte %#x, expected %#x",
+			key[0], KeyTypeExpirationToAccessGrantIndex)
+	}
+
+	expSecs, _ := uint64FromBz(key[1:9])
+	marketID, _ := uint32FromBz(key[9:13])
+	addr, left, err := parseLengthPrefixedAddr(key[13:])
+	if err != nil {
+		return time.Time{}, 0, nil, fmt.Errorf("cannot parse address from expiration to access grant key: %w", err)
+	}
+	if len(left) != 0 {
+		return time.Time{}, 0, nil, fmt.Errorf("cannot parse address from expiration to access grant key: found %d bytes after address, expected 0", len(left))
+	}
+	return time.Unix(int64(expSecs), 0).UTC(), marketID, addr, nil //nolint:gosec // G115: We wrote it from an int64.
+}
+
+// keyPrefixPayment creates the key prefix for payments with the provided extra capacity for additional elements.
+func keyPrefixPayment(extraCap int) []byte {
+	rv := make([]byte, 1, 1+extraCap)
+	rv[0] = KeyTypePayment
+	return rv
+}
+
+// keyPrefixPaymentsForSource creates the key prefix for payments for a source with the provided extra capacity for additional elements.
+func keyPrefixPaymentsForSource(source sdk.AccAddress, extraCap int) []byte {
+	if len(source) == 0 {
+		panic(errors.New("empty source address not allowed"))
+	}
+	sourceBz := address.MustLengthPrefix(source)
+	rv := keyPrefixPayment(len(sourceBz) + extraCap)
+	rv = append(rv, sourceBz...)
+	return rv
+}
+
+// GetKeyPrefixAllPayments gets the key prefix for all payments.
+func GetKeyPrefixAllPayments() []byte {
+	return keyPrefixPayment(0)
+}
+
+// GetKeyPrefixPaymentsForSource gets the key prefix for payments with a given source.
+func GetKeyPrefixPaymentsForSource(source sdk.AccAddress) []byte {
+	return keyPrefixPaymentsForSource(source, 0)
+}
+
+// MakeKeyPayment creates the key for a payment.
+func MakeKeyPayment(source sdk.AccAddress, externalID string) []byte {
+	suffix := []byte(externalID)
+	rv := keyPrefixPaymentsForSource(source, len(suffix))
+	rv = append(rv, suffix...)
+	return rv
+}
+
+// ParseKeyPayment parses the full key that identifies a payment.
+// The input must have the format: <type byte> | <source length byte> | <source> | <external id>.
+func ParseKeyPayment(key []byte) (sdk.AccAddress, string, error) {
+	if len(key) < 3 {
+		return nil, "", fmt.Errorf("cannot parse payment key: only has %d bytes, expected at least 3", len(key))
+	}
+	if key[0] != KeyTypePayment {
+		return nil, "", fmt.Errorf("cannot parse payment key: incorrect type byte %#x, expected %#x", key[0], KeyTypePayment)
+	}
+	return ParseKeySuffixPayment(key[1:])
+}
+
+// ParseKeySuffixPayment parses a payment key that does not have its type byte.
+// The input must have the format: <source length byte> | <source> | <external id>.
+func ParseKeySuffixPayment(suffix []byte) (sdk.AccAddress, string, error) {
+	addr, left, err := parseLengthPrefixedAddr(suffix)
+	if err != nil {
+		return nil, "", fmt.Errorf("cannot parse payment key: invalid source: %w", err)
+	}
+	return addr, string(left), nil
+}
+
+// indexPrefixTargetToPayments creates the prefix for the target to payments index entries with some extra space for the rest.
+func indexPrefixTargetToPayments(target sdk.AccAddress, extraCap int) []byte {
+	if len(target) == 0 {
+		panic(errors.New("empty target address not allowed"))
+	}
+	return prepKey(KeyTypeTargetToPaymentIndex, address.MustLengthPrefix(target), extraCap)
+}
+
+// indexPrefixTargetToPayments creates the prefix for the target to payments index entries, specific to a source, with some extra space for the rest.
+func indexPrefixTargetToPaymentsForSource(target, source sdk.AccAddress, extraCap int) []byte {
+	if len(source) == 0 {
+		panic(errors.New("empty source address not allowed"))
+	}
+	suffix := address.MustLengthPrefix(source)
+	rv := indexPrefixTargetToPayments(target, len(suffix)+extraCap)
+	rv = append(rv, suffix...)
+	return rv
+}
+
+// GetIndexKeyPrefixTargetToPayments creates a key prefix for the target to payments index.
+func GetIndexKeyPrefixTargetToPayments(target sdk.AccAddress) []byte {
+	return indexPrefixTargetToPayments(target, 0)
+}
+
+// GetIndexKeyPrefixTargetToPaymentsForSource creates a key prefix for the target to payments index, specific to a source.
+func GetIndexKeyPrefixTargetToPaymentsForSource(target, source sdk.AccAddress) []byte {
+	return indexPrefixTargetToPaymentsForSource(target, source, 0)
+}
+
+// MakeIndexKeyTargetToPayment creates the key for the target to payment index.
+func MakeIndexKeyTargetToPayment(target, source sdk.AccAddress, externalID string) []byte {
+	suffix := []byte(externalID)
+	rv := indexPrefixTargetToPaymentsForSource(target, source, len(suffix))
+	rv = append(rv, suffix...)
+	return rv
 }
 
 // ParseIndexKeyTargetToPayment parses a target to payment key.
@@ -1011,3 +2227,534 @@ func ParseIndexKeySuffixTargetToPayment(suffix []byte) (sdk.AccAddress, string,
 	}
 	return source, string(left), nil
 }
+
+// indexPrefixExpirationToPayment creates the prefix for the expiration to payment index entries with some extra space for the rest.
+func indexPrefixExpirationToPayment(expiration time.Time, extraCap int) []byte {
+	expSecs := uint64(expiration.Unix()) //nolint:gosec // G115: Expirations are validated to be after the epoch.
+	return prepKey(KeyTypeExpirationToPaymentIndex, uint64Bz(expSecs), extraCap)
+}
+
+// GetIndexKeyPrefixExpirationToPayment gets the key prefix for the entire expiration to payment index.
+func GetIndexKeyPrefixExpirationToPayment() []byte {
+	return []byte{KeyTypeExpirationToPaymentIndex}
+}
+
+// GetIndexKeyPrefixExpirationToPaymentAt creates a key prefix for the expiration to payment index
+// limited to payments that expire during the same second as the provided time.
+func GetIndexKeyPrefixExpirationToPaymentAt(expiration time.Time) []byte {
+	return indexPrefixExpirationToPayment(expiration, 0)
+}
+
+// MakeIndexKeyExpirationToPayment creates the key to use for the expiration to payment index for the provided values.
+func MakeIndexKeyExpirationToPayment(expiration time.Time, source sdk.AccAddress, externalID string) []byte {
+	if len(source) == 0 {
+		panic(errors.New("empty source address not allowed"))
+	}
+	sourceBz := address.MustLengthPrefix(source)
+	rv := indexPrefixExpirationToPayment(expiration, len(sourceBz)+len(externalID))
+	rv = append(rv, sourceBz...)
+	rv = append(rv, externalID...)
+	return rv
+}
+
+// ParseIndexKeyExpirationToPayment extracts the expiration, source, and external id from an expiration to payment index key.
+// The input must have the format: <type byte> | <expiration> (8 bytes) | <source length byte> | <source> | <external id>.
+//
+// The returned expiration only has second precision and is in UTC.
+func ParseIndexKeyExpirationToPayment(key []byte) (time.Time, sdk.AccAddress, string, error) {
+	if len(key) < 11 {
+		return time.Time{}, nil, "", fmt.Errorf("cannot parse expiration to payment key: only has %d bytes, expected at least 11", len(key))
+	}
+	if key[0] != KeyTypeExpirationToPaymentIndex {
+		return time.Time{}, nil, "", fmt.Errorf("cannot parse expiration to payment key: unknown type byte %#x, expected %#x",
+			key[0], KeyTypeExpirationToPaymentIndex)
+	}
+
+	expSecs, _ := uint64FromBz(key[1:9])
+	source, left, err := parseLengthPrefixedAddr(key[9:])
+	if err != nil {
+		return time.Time{}, nil, "", fmt.Errorf("cannot parse source from expiration to payment key: %w", err)
+	}
+	return time.Unix(int64(expSecs), 0).UTC(), source, string(left), nil //nolint:gosec // G115: We wrote it from an int64.
+}
+
+// keyPrefixRecurringPaymentsForSource creates the key prefix for the recurring payments of a source with some extra space for the rest.
+func keyPrefixRecurringPaymentsForSource(source sdk.AccAddress, extraCap int) []byte {
+	if len(source) == 0 {
+		panic(errors.New("empty source address not allowed"))
+	}
+	return prepKey(KeyTypeRecurringPayment, address.MustLengthPrefix(source), extraCap)
+}
+
+// GetKeyPrefixAllRecurringPayments gets the key prefix for all recurring payments.
+func GetKeyPrefixAllRecurringPayments() []byte {
+	return []byte{KeyTypeRecurringPayment}
+}
+
+// GetKeyPrefixRecurringPaymentsForSource gets the key prefix for the recurring payments with a given source.
+func GetKeyPrefixRecurringPaymentsForSource(source sdk.AccAddress) []byte {
+	return keyPrefixRecurringPaymentsForSource(source, 0)
+}
+
+// MakeKeyRecurringPayment creates the key for a recurring payment.
+func MakeKeyRecurringPayment(source sdk.AccAddress, externalID string) []byte {
+	rv := keyPrefixRecurringPaymentsForSource(source, len(externalID))
+	rv = append(rv, externalID...)
+	return rv
+}
+
+// indexPrefixTransferTimeToRecurringPayment creates the prefix for the transfer time to recurring payment index entries
+// with some extra space for the rest.
+func indexPrefixTransferTimeToRecurringPayment(transferTime time.Time, extraCap int) []byte {
+	secs := uint64(transferTime.Unix()) //nolint:gosec // G115: Transfer times are validated to be after the epoch.
+	return prepKey(KeyTypeTransferTimeToRecurringPaymentIndex, uint64Bz(secs), extraCap)
+}
+
+// GetIndexKeyPrefixTransferTimeToRecurringPayment gets the key prefix for the entire transfer time to recurring payment index.
+func GetIndexKeyPrefixTransferTimeToRecurringPayment() []byte {
+	return []byte{KeyTypeTransferTimeToRecurringPaymentIndex}
+}
+
+// GetIndexKeyPrefixTransferTimeToRecurringPaymentAt creates a key prefix for the transfer time to recurring payment index
+// limited to recurring payments with a next transfer during the same second as the provided time.
+func GetIndexKeyPrefixTransferTimeToRecurringPaymentAt(transferTime time.Time) []byte {
+	return indexPrefixTransferTimeToRecurringPayment(transferTime, 0)
+}
+
+// MakeIndexKeyTransferTimeToRecurringPayment creates the key to use for the transfer time to recurring payment index for the provided values.
+func MakeIndexKeyTransferTimeToRecurringPayment(transferTime time.Time, source sdk.AccAddress, externalID string) []byte {
+	if len(source) == 0 {
+		panic(errors.New("empty source address not allowed"))
+	}
+	sourceBz := address.MustLengthPrefix(source)
+	rv := indexPrefixTransferTimeToRecurringPayment(transferTime, len(sourceBz)+len(externalID))
+	rv = append(rv, sourceBz...)
+	rv = append(rv, externalID...)
+	return rv
+}
+
+// ParseIndexKeyTransferTimeToRecurringPayment extracts the transfer time, source, and external id from a
+// transfer time to recurring payment index key.
+// The input must have the format: <type byte> | <time> (8 bytes) | <source length byte> | <source> | <external id>.
+//
+// The returned time only has second precision and is in UTC.
+func ParseIndexKeyTransferTimeToRecurringPayment(key []byte) (time.Time, sdk.AccAddress, string, error) {
+	if len(key) < 11 {
+		return time.Time{}, nil, "", fmt.Errorf("cannot parse transfer time to recurring payment key: only has %d bytes, expected at least 11", len(key))
+	}
+	if key[0] != KeyTypeTransferTimeToRecurringPaymentIndex {
+		return time.Time{}, nil, "", fmt.Errorf("cannot parse transfer time to recurring payment key: unknown type byte %#x, expected %#x",
+			key[0], KeyTypeTransferTimeToRecurringPaymentIndex)
+	}
+
+	secs, _ := uint64FromBz(key[1:9])
+	source, left, err := parseLengthPrefixedAddr(key[9:])
+	if err != nil {
+		return time.Time{}, nil, "", fmt.Errorf("cannot parse source from transfer time to recurring payment key: %w", err)
+	}
+	return time.Unix(int64(secs), 0).UTC(), source, string(left), nil //nolint:gosec // G115: We wrote it from an int64.
+}
+
+// keyPrefixMultiPartyPaymentsForCreator creates the key prefix for the multi-party payments of a creator with some extra space for the rest.
+func keyPrefixMultiPartyPaymentsForCreator(creator sdk.AccAddress, extraCap int) []byte {
+	if len(creator) == 0 {
+		panic(errors.New("empty creator address not allowed"))
+	}
+	return prepKey(KeyTypeMultiPartyPayment, address.MustLengthPrefix(creator), extraCap)
+}
+
+// GetKeyPrefixAllMultiPartyPayments gets the key prefix for all multi-party payments.
+func GetKeyPrefixAllMultiPartyPayments() []byte {
+	return []byte{KeyTypeMultiPartyPayment}
+}
+
+// GetKeyPrefixMultiPartyPaymentsForCreator gets the key prefix for the multi-party payments with a given creator.
+func GetKeyPrefixMultiPartyPaymentsForCreator(creator sdk.AccAddress) []byte {
+	return keyPrefixMultiPartyPaymentsForCreator(creator, 0)
+}
+
+// MakeKeyMultiPartyPayment creates the key for a multi-party payment.
+func MakeKeyMultiPartyPayment(creator sdk.AccAddress, externalID string) []byte {
+	rv := keyPrefixMultiPartyPaymentsForCreator(creator, len(externalID))
+	rv = append(rv, externalID...)
+	return rv
+}
+
+// keyPrefixEscrowedPaymentsForSource creates the key prefix for the escrowed payments of a source with some extra space for the rest.
+func keyPrefixEscrowedPaymentsForSource(source sdk.AccAddress, extraCap int) []byte {
+	if len(source) == 0 {
+		panic(errors.New("empty source address not allowed"))
+	}
+	return prepKey(KeyTypeEscrowedPayment, address.MustLengthPrefix(source), extraCap)
+}
+
+// GetKeyPrefixAllEscrowedPayments gets the key prefix for all escrowed payments.
+func GetKeyPrefixAllEscrowedPayments() []byte {
+	return []byte{KeyTypeEscrowedPayment}
+}
+
+// GetKeyPrefixEscrowedPaymentsForSource gets the key prefix for the escrowed payments with a given source.
+func GetKeyPrefixEscrowedPaymentsForSource(source sdk.AccAddress) []byte {
+	return keyPrefixEscrowedPaymentsForSource(source, 0)
+}
+
+// MakeKeyEscrowedPayment creates the key for an escrowed payment.
+func MakeKeyEscrowedPayment(source sdk.AccAddress, externalID string) []byte {
+	rv := keyPrefixEscrowedPaymentsForSource(source, len(externalID))
+	rv = append(rv, externalID...)
+	return rv
+}
+
+// indexPrefixReleaseTimeToEscrowedPayment creates the prefix for the release time to escrowed payment index entries
+// with some extra space for the rest.
+func indexPrefixReleaseTimeToEscrowedPayment(releaseTime time.Time, extraCap int) []byte {
+	secs := uint64(releaseTime.Unix()) //nolint:gosec // G115: Release times are validated to be after the epoch.
+	return prepKey(KeyTypeReleaseTimeToEscrowedPaymentIndex, uint64Bz(secs), extraCap)
+}
+
+// GetIndexKeyPrefixReleaseTimeToEscrowedPayment gets the key prefix for the entire release time to escrowed payment index.
+func GetIndexKeyPrefixReleaseTimeToEscrowedPayment() []byte {
+	return []byte{KeyTypeReleaseTimeToEscrowedPaymentIndex}
+}
+
+// GetIndexKeyPrefixReleaseTimeToEscrowedPaymentAt creates a key prefix for the release time to escrowed payment index
+// limited to escrowed payments with a release time during the same second as the provided time.
+func GetIndexKeyPrefixReleaseTimeToEscrowedPaymentAt(releaseTime time.Time) []byte {
+	return indexPrefixReleaseTimeToEscrowedPayment(releaseTime, 0)
+}
+
+// MakeIndexKeyReleaseTimeToEscrowedPayment creates the key to use for the release time to escrowed payment index for the provided values.
+func MakeIndexKeyReleaseTimeToEscrowedPayment(releaseTime time.Time, source sdk.AccAddress, externalID string) []byte {
+	if len(source) == 0 {
+		panic(errors.New("empty source address not allowed"))
+	}
+	sourceBz := address.MustLengthPrefix(source)
+	rv := indexPrefixReleaseTimeToEscrowedPayment(releaseTime, len(sourceBz)+len(externalID))
+	rv = append(rv, sourceBz...)
+	rv = append(rv, externalID...)
+	return rv
+}
+
+// ParseIndexKeyReleaseTimeToEscrowedPayment extracts the release time, source, and external id from a
+// release time to escrowed payment index key.
+// The input must have the format: <type byte> | <time> (8 bytes) | <source length byte> | <source> | <external id>.
+//
+// The returned time only has second precision and is in UTC.
+func ParseIndexKeyReleaseTimeToEscrowedPayment(key []byte) (time.Time, sdk.AccAddress, string, error) {
+	if len(key) < 11 {
+		return time.Time{}, nil, "", fmt.Errorf("cannot parse release time to escrowed payment key: only has %d bytes, expected at least 11", len(key))
+	}
+	if key[0] != KeyTypeReleaseTimeToEscrowedPaymentIndex {
+		return time.Time{}, nil, "", fmt.Errorf("cannot parse release time to escrowed payment key: unknown type byte %#x, expected %#x",
+			key[0], KeyTypeReleaseTimeToEscrowedPaymentIndex)
+	}
+
+	secs, _ := uint64FromBz(key[1:9])
+	source, left, err := parseLengthPrefixedAddr(key[9:])
+	if err != nil {
+		return time.Time{}, nil, "", fmt.Errorf("cannot parse source from release time to escrowed payment key: %w", err)
+	}
+	return time.Unix(int64(secs), 0).UTC(), source, string(left), nil //nolint:gosec // G115: We wrote it from an int64.
+}
+
+// paymentAmountBz converts the provided amount into a length-prefixed byte slice that sorts the same way the amounts do.
+func paymentAmountBz(amount sdkmath.Int) []byte {
+	var bz []byte
+	if amount.IsPositive() {
+		bz = amount.BigInt().Bytes()
+	}
+	rv := make([]byte, 0, 1+len(bz))
+	rv = append(rv, byte(len(bz)))
+	rv = append(rv, bz...)
+	return rv
+}
+
+// indexPrefixAccountDenomToPayments creates the prefix for the account denom to payment index entries
+// for an account's role and a denom, with some extra space for the rest.
+func indexPrefixAccountDenomToPayments(account sdk.AccAddress, role byte, denom string, extraCap int) []byte {
+	if len(account) == 0 {
+		panic(errors.New("empty account address not allowed"))
+	}
+	acctBz := address.MustLengthPrefix(account)
+	denomBz := address.MustLengthPrefix([]byte(denom))
+	rv := prepKey(KeyTypeAccountDenomToPaymentIndex, acctBz, 1+len(denomBz)+extraCap)
+	rv = append(rv, role)
+	rv = append(rv, denomBz...)
+	return rv
+}
+
+// GetIndexKeyPrefixAccountDenomToPayments creates a key prefix for the account denom to payment index
+// limited to the payments where the account has the given role and the source amount has the given denom.
+func GetIndexKeyPrefixAccountDenomToPayments(account sdk.AccAddress, role byte, denom string) []byte {
+	return indexPrefixAccountDenomToPayments(account, role, denom, 0)
+}
+
+// MakeIndexKeyAccountDenomToPayment creates the key to use in the account denom to payment index for the provided values.
+func MakeIndexKeyAccountDenomToPayment(account sdk.AccAddress, role byte, denom string, amount sdkmath.Int, source sdk.AccAddress, externalID string) []byte {
+	if len(source) == 0 {
+		panic(errors.New("empty source address not allowed"))
+	}
+	amountBz := paymentAmountBz(amount)
+	sourceBz := address.MustLengthPrefix(source)
+	rv := indexPrefixAccountDenomToPayments(account, role, denom, len(amountBz)+len(sourceBz)+len(externalID))
+	rv = append(rv, amountBz...)
+	rv = append(rv, sourceBz...)
+	rv = append(rv, externalID...)
+	return rv
+}
+
+// ParseIndexKeySuffixAccountDenomToPayment extracts the amount, source, and external id from the part of
+// an account denom to payment index key that comes after the denom.
+// The input must have the format: len(<amount>) (1 byte) | <amount> | len(<source>) (1 byte) | <source> | <external id>.
+func ParseIndexKeySuffixAccountDenomToPayment(suffix []byte) (sdkmath.Int, sdk.AccAddress, string, error) {
+	if len(suffix) == 0 {
+		return sdkmath.Int{}, nil, "", errors.New("cannot parse account denom to payment key suffix: suffix is empty")
+	}
+	amountLen := int(suffix[0])
+	if len(suffix) <= 1+amountLen {
+		return sdkmath.Int{}, nil, "", fmt.Errorf("cannot parse account denom to payment key suffix: amount length byte is %d, but suffix only has %d left",
+			amountLen, len(suffix)-1)
+	}
+	amount := sdkmath.NewIntFromBigInt(new(big.Int).SetBytes(suffix[1 : 1+amountLen]))
+	source, left, err := parseLengthPrefixedAddr(suffix[1+amountLen:])
+	if err != nil {
+		return sdkmath.Int{}, nil, "", fmt.Errorf("cannot parse account denom to payment key suffix: invalid source: %w", err)
+	}
+	return amount, source, string(left), nil
+}
+
+// indexPrefixAccountTargetAmountToPayments creates the prefix for the account target amount to payment index entries
+// for an account's role and whether there's a target amount, with some extra space for the rest.
+func indexPrefixAccountTargetAmountToPayments(account sdk.AccAddress, role byte, hasTargetAmount bool, extraCap int) []byte {
+	if len(account) == 0 {
+		panic(errors.New("empty account address not allowed"))
+	}
+	hasByte := byte(0x00)
+	if hasTargetAmount {
+		hasByte = 0x01
+	}
+	rv := prepKey(KeyTypeAccountTargetAmountToPaymentIndex, address.MustLengthPrefix(account), 2+extraCap)
+	rv = append(rv, role, hasByte)
+	return rv
+}
+
+// GetIndexKeyPrefixAccountTargetAmountToPayments creates a key prefix for the account target amount to payment index
+// limited to the payments where the account has the given role and that either have or do not have a target amount.
+func GetIndexKeyPrefixAccountTargetAmountToPayments(account sdk.AccAddress, role byte, hasTargetAmount bool) []byte {
+	return indexPrefixAccountTargetAmountToPayments(account, role, hasTargetAmount, 0)
+}
+
+// MakeIndexKeyAccountTargetAmountToPayment creates the key to use in the account target amount to payment index for the provided values.
+func MakeIndexKeyAccountTargetAmountToPayment(account sdk.AccAddress, role byte, hasTargetAmount bool, source sdk.AccAddress, externalID string) []byte {
+	if len(source) == 0 {
+		panic(errors.New("empty source address not allowed"))
+	}
+	sourceBz := address.MustLengthPrefix(source)
+	rv := indexPrefixAccountTargetAmountToPayments(account, role, hasTargetAmount, len(sourceBz)+len(externalID))
+	rv = append(rv, sourceBz...)
+	rv = append(rv, externalID...)
+	return rv
+}
+
+// ParseIndexKeySuffixAccountTargetAmountToPayment extracts the source and external id from the part of
+// an account target amount to payment index key that comes after the has target amount byte.
+// The input must have the format: len(<source>) (1 byte) | <source> | <external id>.
+func ParseIndexKeySuffixAccountTargetAmountToPayment(suffix []byte) (sdk.AccAddress, string, error) {
+	source, left, err := parseLengthPrefixedAddr(suffix)
+	if err != nil {
+		return nil, "", fmt.Errorf("cannot parse account target amount to payment key suffix: invalid source: %w", err)
+	}
+	return source, string(left), nil
+}
+
+// GetKeyPrefixIdempotencyKeys gets the key prefix for all idempotency key entries.
+func GetKeyPrefixIdempotencyKeys() []byte {
+	return []byte{KeyTypeIdempotencyKey}
+}
+
+// MakeKeyIdempotencyKey creates the key to use for an account's idempotency key entry.
+func MakeKeyIdempotencyKey(addr sdk.AccAddress, idempotencyKey string) []byte {
+	if len(addr) == 0 {
+		panic(errors.New("empty address not allowed"))
+	}
+	addrBz := address.MustLengthPrefix(addr)
+	rv := prepKey(KeyTypeIdempotencyKey, addrBz, len(idempotencyKey))
+	rv = append(rv, idempotencyKey...)
+	return rv
+}
+
+// ParseKeyIdempotencyKey extracts the address and idempotency key from an idempotency key entry's key.
+// The input must have the format: <type byte> | <addr length byte> | <addr> | <idempotency key>.
+func ParseKeyIdempotencyKey(key []byte) (sdk.AccAddress, string, error) {
+	if len(key) < 4 {
+		return nil, "", fmt.Errorf("cannot parse idempotency key entry key: only has %d bytes, expected at least 4", len(key))
+	}
+	if key[0] != KeyTypeIdempotencyKey {
+		return nil, "", fmt.Errorf("cannot parse idempotency key entry key: incorrect type byte %#x", key[0])
+	}
+	return ParseKeySuffixIdempotencyKey(key[1:])
+}
+
+// ParseKeySuffixIdempotencyKey parses the addr and idempotency key portion of an idempotency key entry's key.
+// The input must have the format: <addr length byte> | <addr> | <idempotency key>.
+func ParseKeySuffixIdempotencyKey(suffix []byte) (sdk.AccAddress, string, error) {
+	addr, idempotencyKey, err := parseLengthPrefixedAddr(suffix)
+	if err != nil {
+		return nil, "", fmt.Errorf("cannot parse address from idempotency key entry key: %w", err)
+	}
+	if len(idempotencyKey) == 0 {
+		return nil, "", errors.New("cannot parse idempotency key entry key: no idempotency key found")
+	}
+	return addr, string(idempotencyKey), nil
+}
+
+// indexPrefixHeightToIdempotencyKey creates the prefix for the height to idempotency key index with some extra space for the rest.
+func indexPrefixHeightToIdempotencyKey(extraCap int) []byte {
+	return prepKey(KeyTypeHeightToIdempotencyKeyIndex, nil, extraCap)
+}
+
+// GetIndexKeyPrefixHeightToIdempotencyKey gets the prefix for all height to idempotency key index entries.
+func GetIndexKeyPrefixHeightToIdempotencyKey() []byte {
+	return indexPrefixHeightToIdempotencyKey(0)
+}
+
+// GetIndexKeyPrefixHeightToIdempotencyKeyAt gets the prefix for the height to idempotency key index entries at the given height.
+func GetIndexKeyPrefixHeightToIdempotencyKeyAt(height int64) []byte {
+	rv := indexPrefixHeightToIdempotencyKey(8)
+	rv = append(rv, uint64Bz(uint64(height))...) //nolint:gosec // G115: Block heights are not negative.
+	return rv
+}
+
+// MakeIndexKeyHeightToIdempotencyKey creates the key to use in the height to idempotency key index for the provided values.
+func MakeIndexKeyHeightToIdempotencyKey(height int64, addr sdk.AccAddress, idempotencyKey string) []byte {
+	if len(addr) == 0 {
+		panic(errors.New("empty address not allowed"))
+	}
+	addrBz := address.MustLengthPrefix(addr)
+	rv := indexPrefixHeightToIdempotencyKey(8 + len(addrBz) + len(idempotencyKey))
+	rv = append(rv, uint64Bz(uint64(height))...) //nolint:gosec // G115: Block heights are not negative.
+	rv = append(rv, addrBz...)
+	rv = append(rv, idempotencyKey...)
+	return rv
+}
+
+// ParseIndexKeyHeightToIdempotencyKey extracts the height, address, and idempotency key from a height to idempotency key index key.
+// The input must have the format: <type byte> | <height> (8 bytes) | <addr length byte> | <addr> | <idempotency key>.
+func ParseIndexKeyHeightToIdempotencyKey(key []byte) (int64, sdk.AccAddress, string, error) {
+	if len(key) < 12 {
+		return 0, nil, "", fmt.Errorf("cannot parse height to idempotency key index key: only has %d bytes, expected at least 12", len(key))
+	}
+	if key[0] != KeyTypeHeightToIdempotencyKeyIndex {
+		return 0, nil, "", fmt.Errorf("cannot parse height to idempotency key index key: incorrect type byte %#x", key[0])
+	}
+
+	height, _ := uint64FromBz(key[1:9])
+	addr, idempotencyKey, err := parseLengthPrefixedAddr(key[9:])
+	if err != nil {
+		return 0, nil, "", fmt.Errorf("cannot parse address from height to idempotency key index key: %w", err)
+	}
+	if len(idempotencyKey) == 0 {
+		return 0, nil, "", errors.New("cannot parse height to idempotency key index key: no idempotency key found")
+	}
+	return int64(height), addr, string(idempotencyKey), nil //nolint:gosec // G115: Heights were stored from an int64.
+}
+
+// MakeKeyLastSettlementID creates the key for the id of the last settlement receipt recorded.
+func MakeKeyLastSettlementID() []byte {
+	return []byte{KeyTypeLastSettlementID}
+}
+
+// keyPrefixSettlementReceipt creates the key prefix for settlement receipts with some extra space for the rest.
+func keyPrefixSettlementReceipt(extraCap int) []byte {
+	return prepKey(KeyTypeSettlementReceipt, nil, extraCap)
+}
+
+// GetKeyPrefixSettlementReceipt gets the key prefix for all settlement receipts.
+func GetKeyPrefixSettlementReceipt() []byte {
+	return keyPrefixSettlementReceipt(0)
+}
+
+// MakeKeySettlementReceipt creates the key to use for a settlement receipt.
+func MakeKeySettlementReceipt(settlementID uint64) []byte {
+	rv := keyPrefixSettlementReceipt(8)
+	rv = append(rv, uint64Bz(settlementID)...)
+	return rv
+}
+
+// ParseKeySettlementReceipt extracts the settlement id from a settlement receipt key.
+// The input must have the format: <type byte> | <settlement_id> (8 bytes).
+func ParseKeySettlementReceipt(key []byte) (uint64, error) {
+	if len(key) != 9 {
+		return 0, fmt.Errorf("cannot parse settlement receipt key: has %d bytes, expected 9", len(key))
+	}
+	if key[0] != KeyTypeSettlementReceipt {
+		return 0, fmt.Errorf("cannot parse settlement receipt key: incorrect type byte %#x", key[0])
+	}
+	rv, _ := uint64FromBz(key[1:])
+	return rv, nil
+}
+
+// GetKeyPrefixDeadManSwitches gets the key prefix for all dead-man switches.
+func GetKeyPrefixDeadManSwitches() []byte {
+	return []byte{KeyTypeDeadManSwitch}
+}
+
+// MakeKeyDeadManSwitch creates the key to use for an account's dead-man switch.
+func MakeKeyDeadManSwitch(addr sdk.AccAddress) []byte {
+	if len(addr) == 0 {
+		panic(errors.New("empty address not allowed"))
+	}
+	return prepKey(KeyTypeDeadManSwitch, address.MustLengthPrefix(addr), 0)
+}
+
+// indexPrefixDeadlineToDeadManSwitch creates the prefix for the deadline to dead-man switch index entries
+// with some extra space for the rest.
+func indexPrefixDeadlineToDeadManSwitch(deadline time.Time, extraCap int) []byte {
+	secs := uint64(deadline.Unix()) //nolint:gosec // G115: Deadlines are validated to be after the epoch.
+	return prepKey(KeyTypeDeadlineToDeadManSwitchIndex, uint64Bz(secs), extraCap)
+}
+
+// GetIndexKeyPrefixDeadlineToDeadManSwitch gets the key prefix for the entire deadline to dead-man switch index.
+func GetIndexKeyPrefixDeadlineToDeadManSwitch() []byte {
+	return []byte{KeyTypeDeadlineToDeadManSwitchIndex}
+}
+
+// GetIndexKeyPrefixDeadlineToDeadManSwitchAt creates a key prefix for the deadline to dead-man switch index
+// limited to dead-man switches with a deadline during the same second as the provided time.
+func GetIndexKeyPrefixDeadlineToDeadManSwitchAt(deadline time.Time) []byte {
+	return indexPrefixDeadlineToDeadManSwitch(deadline, 0)
+}
+
+// MakeIndexKeyDeadlineToDeadManSwitch creates the key to use in the deadline to dead-man switch index for the provided values.
+func MakeIndexKeyDeadlineToDeadManSwitch(deadline time.Time, addr sdk.AccAddress) []byte {
+	if len(addr) == 0 {
+		panic(errors.New("empty address not allowed"))
+	}
+	addrBz := address.MustLengthPrefix(addr)
+	rv := indexPrefixDeadlineToDeadManSwitch(deadline, len(addrBz))
+	rv = append(rv, addrBz...)
+	return rv
+}
+
+// ParseIndexKeyDeadlineToDeadManSwitch extracts the deadline and account from a deadline to dead-man switch index key.
+// The input must have the format: <type byte> | <deadline> (8 bytes) | <addr length byte> | <addr>.
+//
+// The returned time only has second precision and is in UTC.
+func ParseIndexKeyDeadlineToDeadManSwitch(key []byte) (time.Time, sdk.AccAddress, error) {
+	if len(key) < 11 {
+		return time.Time{}, nil, fmt.Errorf("cannot parse deadline to dead-man switch key: only has %d bytes, expected at least 11", len(key))
+	}
+	if key[0] != KeyTypeDeadlineToDeadManSwitchIndex {
+		return time.Time{}, nil, fmt.Errorf("cannot parse deadline to dead-man switch key: incorrect type byte %#x", key[0])
+	}
+
+	secs, _ := uint64FromBz(key[1:9])
+	addr, left, err := parseLengthPrefixedAddr(key[9:])
+	if err != nil {
+		return time.Time{}, nil, fmt.Errorf("cannot parse address from deadline to dead-man switch key: %w", err)
+	}
+	if len(left) != 0 {
+		return time.Time{}, nil, fmt.Errorf("cannot parse deadline to dead-man switch key: found %d bytes after address, expected 0", len(left))
+	}
+	return time.Unix(int64(secs), 0).UTC(), addr, nil //nolint:gosec // G115: We wrote it from an int64.
+}
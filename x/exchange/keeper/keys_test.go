@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -103,9 +104,43 @@ func TestKeyTypeUniqueness(t *testing.T) {
 				{name: "KeyTypeAddressToOrderIndex", value: keeper.KeyTypeAddressToOrderIndex},
 				{name: "KeyTypeAssetToOrderIndex", value: keeper.KeyTypeAssetToOrderIndex},
 				{name: "KeyTypeMarketExternalIDToOrderIndex", value: keeper.KeyTypeMarketExternalIDToOrderIndex},
+				{name: "KeyTypeExpirationToOrderIndex", value: keeper.KeyTypeExpirationToOrderIndex},
+				{name: "KeyTypeTriggerOrder", value: keeper.KeyTypeTriggerOrder},
+				{name: "KeyTypeMarketToTriggerOrderIndex", value: keeper.KeyTypeMarketToTriggerOrderIndex},
+				{name: "KeyTypeOrderLink", value: keeper.KeyTypeOrderLink},
+				{name: "KeyTypePriceToOrderIndex", value: keeper.KeyTypePriceToOrderIndex},
+				{name: "KeyTypeSettlementPrice", value: keeper.KeyTypeSettlementPrice},
+				{name: "KeyTypeSettlementRecord", value: keeper.KeyTypeSettlementRecord},
 				{name: "KeyTypeCommitment", value: keeper.KeyTypeCommitment},
+				{name: "KeyTypeCommitmentExpiration", value: keeper.KeyTypeCommitmentExpiration},
+				{name: "KeyTypeExpirationToCommitmentIndex", value: keeper.KeyTypeExpirationToCommitmentIndex},
+				{name: "KeyTypeCommitmentRewardPool", value: keeper.KeyTypeCommitmentRewardPool},
+				{name: "KeyTypeCommitmentReward", value: keeper.KeyTypeCommitmentReward},
+				{name: "KeyTypeExpirationToAccessGrantIndex", value: keeper.KeyTypeExpirationToAccessGrantIndex},
+				{name: "KeyTypeScheduledFeeChange", value: keeper.KeyTypeScheduledFeeChange},
 				{name: "KeyTypePayment", value: keeper.KeyTypePayment},
 				{name: "KeyTypeTargetToPaymentIndex", value: keeper.KeyTypeTargetToPaymentIndex},
+				{name: "KeyTypeExpirationToPaymentIndex", value: keeper.KeyTypeExpirationToPaymentIndex},
+				{name: "KeyTypeRecurringPayment", value: keeper.KeyTypeRecurringPayment},
+				{name: "KeyTypeTransferTimeToRecurringPaymentIndex", value: keeper.KeyTypeTransferTimeToRecurringPaymentIndex},
+				{name: "KeyTypeMultiPartyPayment", value: keeper.KeyTypeMultiPartyPayment},
+				{name: "KeyTypeAccountDenomToPaymentIndex", value: keeper.KeyTypeAccountDenomToPaymentIndex},
+				{name: "KeyTypeAccountTargetAmountToPaymentIndex", value: keeper.KeyTypeAccountTargetAmountToPaymentIndex},
+				{name: "KeyTypeEscrowedPayment", value: keeper.KeyTypeEscrowedPayment},
+				{name: "KeyTypeReleaseTimeToEscrowedPaymentIndex", value: keeper.KeyTypeReleaseTimeToEscrowedPaymentIndex},
+				{name: "KeyTypeNAVRecord", value: keeper.KeyTypeNAVRecord},
+				{name: "KeyTypeHeightToNAVRecordIndex", value: keeper.KeyTypeHeightToNAVRecordIndex},
+				{name: "KeyTypeAddressExternalIDToOrderIndex", value: keeper.KeyTypeAddressExternalIDToOrderIndex},
+				{name: "KeyTypeOrderCreationCount", value: keeper.KeyTypeOrderCreationCount},
+				{name: "KeyTypeMarketAuthorityTransfer", value: keeper.KeyTypeMarketAuthorityTransfer},
+				{name: "KeyTypeIdempotencyKey", value: keeper.KeyTypeIdempotencyKey},
+				{name: "KeyTypeHeightToIdempotencyKeyIndex", value: keeper.KeyTypeHeightToIdempotencyKeyIndex},
+				{name: "KeyTypeLastSettlementID", value: keeper.KeyTypeLastSettlementID},
+				{name: "KeyTypeSettlementReceipt", value: keeper.KeyTypeSettlementReceipt},
+				{name: "KeyTypeDeadManSwitch", value: keeper.KeyTypeDeadManSwitch},
+				{name: "KeyTypeDeadlineToDeadManSwitchIndex", value: keeper.KeyTypeDeadlineToDeadManSwitchIndex},
+				{name: "KeyTypeAddressToTriggerOrderIndex", value: keeper.KeyTypeAddressToTriggerOrderIndex},
+				{name: "KeyTypeCommitmentAccrual", value: keeper.KeyTypeCommitmentAccrual},
 			},
 		},
 		{
@@ -125,6 +160,24 @@ func TestKeyTypeUniqueness(t *testing.T) {
 				{name: "MarketKeyTypeCreateCommitmentFlat", value: keeper.MarketKeyTypeCreateCommitmentFlat},
 				{name: "MarketKeyTypeCommitmentSettlementBips", value: keeper.MarketKeyTypeCommitmentSettlementBips},
 				{name: "MarketKeyTypeIntermediaryDenom", value: keeper.MarketKeyTypeIntermediaryDenom},
+				{name: "MarketKeyTypeContinuousMatching", value: keeper.MarketKeyTypeContinuousMatching},
+				{name: "MarketKeyTypeBatchAuctionInterval", value: keeper.MarketKeyTypeBatchAuctionInterval},
+				{name: "MarketKeyTypeLastBatchAuction", value: keeper.MarketKeyTypeLastBatchAuction},
+				{name: "MarketKeyTypeSelfTradePrevention", value: keeper.MarketKeyTypeSelfTradePrevention},
+				{name: "MarketKeyTypePermissionsExpiration", value: keeper.MarketKeyTypePermissionsExpiration},
+				{name: "MarketKeyTypeAcceptedAssetDenoms", value: keeper.MarketKeyTypeAcceptedAssetDenoms},
+				{name: "MarketKeyTypeAcceptedPriceDenoms", value: keeper.MarketKeyTypeAcceptedPriceDenoms},
+				{name: "MarketKeyTypeSellerSettlementTakerRatio", value: keeper.MarketKeyTypeSellerSettlementTakerRatio},
+				{name: "MarketKeyTypeSellerSettlementRebateRatio", value: keeper.MarketKeyTypeSellerSettlementRebateRatio},
+				{name: "MarketKeyTypeRebateAddresses", value: keeper.MarketKeyTypeRebateAddresses},
+				{name: "MarketKeyTypeReferralBips", value: keeper.MarketKeyTypeReferralBips},
+				{name: "MarketKeyTypeReferralCap", value: keeper.MarketKeyTypeReferralCap},
+				{name: "MarketKeyTypePublishPrices", value: keeper.MarketKeyTypePublishPrices},
+				{name: "MarketKeyTypeExternalIDScope", value: keeper.MarketKeyTypeExternalIDScope},
+				{name: "MarketKeyTypeOrderRateLimit", value: keeper.MarketKeyTypeOrderRateLimit},
+				{name: "MarketKeyTypeMaxExposure", value: keeper.MarketKeyTypeMaxExposure},
+				{name: "MarketKeyTypeFillAlgorithm", value: keeper.MarketKeyTypeFillAlgorithm},
+				{name: "MarketKeyTypeExchangeSplit", value: keeper.MarketKeyTypeExchangeSplit},
 			},
 		},
 		{
@@ -134,6 +187,13 @@ func TestKeyTypeUniqueness(t *testing.T) {
 				{name: "OrderKeyTypeBid", value: keeper.OrderKeyTypeBid},
 			},
 		},
+		{
+			name: "payment roles",
+			types: []byteEntry{
+				{name: "PaymentRoleSource", value: keeper.PaymentRoleSource},
+				{name: "PaymentRoleTarget", value: keeper.PaymentRoleTarget},
+			},
+		},
 		{
 			name: "required attribute types",
 			types: []byteEntry{
@@ -159,6 +219,9 @@ func TestKeyTypeUniqueness(t *testing.T) {
 		{name: "ParamsKeyTypeSplit", value: keeper.ParamsKeyTypeSplit},
 		{name: "ParamsKeyTypeFeeCreatePaymentFlat", value: keeper.ParamsKeyTypeFeeCreatePaymentFlat},
 		{name: "ParamsKeyTypeFeeAcceptPaymentFlat", value: keeper.ParamsKeyTypeFeeAcceptPaymentFlat},
+		{name: "ParamsKeyTypeSettlementHistoryBlocks", value: keeper.ParamsKeyTypeSettlementHistoryBlocks},
+		{name: "ParamsKeyTypeNAVHistoryBlocks", value: keeper.ParamsKeyTypeNAVHistoryBlocks},
+		{name: "ParamsKeyTypeNAVHistoryMaxRecords", value: keeper.ParamsKeyTypeNAVHistoryMaxRecords},
 	}
 
 	t.Run("params keys", func(t *testing.T) {
@@ -321,6 +384,36 @@ func TestMakeKeyParamsFeeAcceptPaymentFlat(t *testing.T) {
 	checkKey(t, ktc, "MakeKeyParamsFeeAcceptPaymentFlat")
 }
 
+func TestMakeKeyParamsSettlementHistoryBlocks(t *testing.T) {
+	ktc := keyTestCase{
+		maker: func() []byte {
+			return keeper.MakeKeyParamsSettlementHistoryBlocks()
+		},
+		expected: append([]byte{keeper.KeyTypeParams}, []byte("settlement_history_blocks")...),
+	}
+	checkKey(t, ktc, "MakeKeyParamsSettlementHistoryBlocks")
+}
+
+func TestMakeKeyParamsNAVHistoryBlocks(t *testing.T) {
+	ktc := keyTestCase{
+		maker: func() []byte {
+			return keeper.MakeKeyParamsNAVHistoryBlocks()
+		},
+		expected: append([]byte{keeper.KeyTypeParams}, []byte("nav_history_blocks")...),
+	}
+	checkKey(t, ktc, "MakeKeyParamsNAVHistoryBlocks")
+}
+
+func TestMakeKeyParamsNAVHistoryMaxRecords(t *testing.T) {
+	ktc := keyTestCase{
+		maker: func() []byte {
+			return keeper.MakeKeyParamsNAVHistoryMaxRecords()
+		},
+		expected: append([]byte{keeper.KeyTypeParams}, []byte("nav_history_max_records")...),
+	}
+	checkKey(t, ktc, "MakeKeyParamsNAVHistoryMaxRecords")
+}
+
 func TestMakeKeyLastMarketID(t *testing.T) {
 	ktc := keyTestCase{
 		maker: func() []byte {
@@ -2773,6 +2866,106 @@ func TestParseKeySuffixMarketPermissions(t *testing.T) {
 	}
 }
 
+func TestGetKeyPrefixMarketPermissionsExpiration(t *testing.T) {
+	marketTypeByte := keeper.MarketKeyTypePermissionsExpiration
+
+	tests := []struct {
+		name     string
+		marketID uint32
+		expected []byte
+	}{
+		{
+			name:     "market id 0",
+			marketID: 0,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 1",
+			marketID: 1,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 1, marketTypeByte},
+		},
+		{
+			name:     "market id 16,843,009",
+			marketID: 16_843_009,
+			expected: []byte{keeper.KeyTypeMarket, 1, 1, 1, 1, marketTypeByte},
+		},
+		{
+			name:     "market id 4,294,967,295",
+			marketID: 4_294_967_295,
+			expected: []byte{keeper.KeyTypeMarket, 255, 255, 255, 255, marketTypeByte},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetKeyPrefixMarketPermissionsExpiration(tc.marketID)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixMarket", value: keeper.GetKeyPrefixMarket(tc.marketID)},
+				},
+			}
+			checkKey(t, ktc, "GetKeyPrefixMarketPermissionsExpiration(%d)", tc.marketID)
+		})
+	}
+}
+
+func TestMakeKeyMarketPermissionsExpiration(t *testing.T) {
+	marketTypeByte := keeper.MarketKeyTypePermissionsExpiration
+
+	tests := []struct {
+		name     string
+		marketID uint32
+		addr     sdk.AccAddress
+		expected []byte
+		expPanic string
+	}{
+		{
+			name:     "nil addr",
+			addr:     nil,
+			expPanic: "empty address not allowed",
+		},
+		{
+			name:     "256 byte addr",
+			addr:     bytes.Repeat([]byte{'p'}, 256),
+			expPanic: "address length should be max 255 bytes, got 256: unknown address",
+		},
+		{
+			name:     "market id 1 20 byte addr",
+			marketID: 1,
+			addr:     sdk.AccAddress("abcdefghijklmnopqrst"),
+			expected: append([]byte{keeper.KeyTypeMarket, 0, 0, 0, 1, marketTypeByte, 20}, "abcdefghijklmnopqrst"...),
+		},
+		{
+			name:     "market id 16,843,009 32 byte addr",
+			marketID: 16_843_009,
+			addr:     sdk.AccAddress("abcdefghijklmnopqrstuvwxyzABCDEF"),
+			expected: append([]byte{keeper.KeyTypeMarket, 1, 1, 1, 1, marketTypeByte, 32}, "abcdefghijklmnopqrstuvwxyzABCDEF"...),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeKeyMarketPermissionsExpiration(tc.marketID, tc.addr)
+				},
+				expected: tc.expected,
+				expPanic: tc.expPanic,
+			}
+			if len(tc.expPanic) == 0 {
+				ktc.expPrefixes = []expectedPrefix{
+					{name: "GetKeyPrefixMarket", value: keeper.GetKeyPrefixMarket(tc.marketID)},
+					{name: "GetKeyPrefixMarketPermissionsExpiration", value: keeper.GetKeyPrefixMarketPermissionsExpiration(tc.marketID)},
+				}
+			}
+			checkKey(t, ktc, "MakeKeyMarketPermissionsExpiration(%d, %s)", tc.marketID, tc.addr)
+		})
+	}
+}
+
 func TestMakeKeyMarketReqAttrAsk(t *testing.T) {
 	marketTypeByte := keeper.MarketKeyTypeReqAttr
 	orderTypeByte := keeper.OrderKeyTypeAsk
@@ -3231,81 +3424,119 @@ func TestMakeKeyMarketIntermediaryDenom(t *testing.T) {
 	}
 }
 
-func TestGetKeyPrefixOrder(t *testing.T) {
-	ktc := keyTestCase{
-		maker: func() []byte {
-			return keeper.GetKeyPrefixOrder()
-		},
-		expected: []byte{keeper.KeyTypeOrder},
-	}
-	checkKey(t, ktc, "GetKeyPrefixOrder")
-}
+func TestMakeKeyMarketContinuousMatching(t *testing.T) {
+	marketTypeByte := keeper.MarketKeyTypeContinuousMatching
 
-func TestMakeKeyOrder(t *testing.T) {
 	tests := []struct {
 		name     string
-		orderID  uint64
+		marketID uint32
 		expected []byte
 	}{
 		{
-			name:     "order id 0",
-			orderID:  0,
-			expected: []byte{keeper.KeyTypeOrder, 0, 0, 0, 0, 0, 0, 0, 0},
+			name:     "market id 0",
+			marketID: 0,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 0, marketTypeByte},
 		},
 		{
-			name:     "order id 1",
-			orderID:  1,
-			expected: []byte{keeper.KeyTypeOrder, 0, 0, 0, 0, 0, 0, 0, 1},
+			name:     "market id 1",
+			marketID: 1,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 1, marketTypeByte},
 		},
 		{
-			name:     "order id 256",
-			orderID:  256,
-			expected: []byte{keeper.KeyTypeOrder, 0, 0, 0, 0, 0, 0, 1, 0},
+			name:     "market id 255",
+			marketID: 255,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 255, marketTypeByte},
 		},
 		{
-			name:     "order id 65,536",
-			orderID:  65_536,
-			expected: []byte{keeper.KeyTypeOrder, 0, 0, 0, 0, 0, 1, 0, 0},
+			name:     "market id 256",
+			marketID: 256,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 1, 0, marketTypeByte},
 		},
 		{
-			name:     "order id 16,777,216",
-			orderID:  16_777_216,
-			expected: []byte{keeper.KeyTypeOrder, 0, 0, 0, 0, 1, 0, 0, 0},
+			name:     "market id 65_536",
+			marketID: 65_536,
+			expected: []byte{keeper.KeyTypeMarket, 0, 1, 0, 0, marketTypeByte},
 		},
 		{
-			name:     "order id 4,294,967,296",
-			orderID:  4_294_967_296,
-			expected: []byte{keeper.KeyTypeOrder, 0, 0, 0, 1, 0, 0, 0, 0},
+			name:     "market id 16,777,216",
+			marketID: 16_777_216,
+			expected: []byte{keeper.KeyTypeMarket, 1, 0, 0, 0, marketTypeByte},
 		},
 		{
-			name:     "order id 1,099,511,627,776",
-			orderID:  1_099_511_627_776,
-			expected: []byte{keeper.KeyTypeOrder, 0, 0, 1, 0, 0, 0, 0, 0},
+			name:     "market id 16,843,009",
+			marketID: 16_843_009,
+			expected: []byte{keeper.KeyTypeMarket, 1, 1, 1, 1, marketTypeByte},
 		},
 		{
-			name:     "order id 281,474,976,710,656",
-			orderID:  281_474_976_710_656,
-			expected: []byte{keeper.KeyTypeOrder, 0, 1, 0, 0, 0, 0, 0, 0},
+			name:     "market id 4,294,967,295",
+			marketID: 4_294_967_295,
+			expected: []byte{keeper.KeyTypeMarket, 255, 255, 255, 255, marketTypeByte},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeKeyMarketContinuousMatching(tc.marketID)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixMarket", value: keeper.GetKeyPrefixMarket(tc.marketID)},
+				},
+			}
+			checkKey(t, ktc, "MakeKeyMarketContinuousMatching(%d)", tc.marketID)
+		})
+	}
+}
+
+func TestMakeKeyMarketBatchAuctionInterval(t *testing.T) {
+	marketTypeByte := keeper.MarketKeyTypeBatchAuctionInterval
+
+	tests := []struct {
+		name     string
+		marketID uint32
+		expected []byte
+	}{
+		{
+			name:     "market id 0",
+			marketID: 0,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 0, marketTypeByte},
 		},
 		{
-			name:     "order id 72,057,594,037,927,936",
-			orderID:  72_057_594_037_927_936,
-			expected: []byte{keeper.KeyTypeOrder, 1, 0, 0, 0, 0, 0, 0, 0},
+			name:     "market id 1",
+			marketID: 1,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 1, marketTypeByte},
 		},
 		{
-			name:     "order id 72,340,172,838,076,673",
-			orderID:  72_340_172_838_076_673,
-			expected: []byte{keeper.KeyTypeOrder, 1, 1, 1, 1, 1, 1, 1, 1},
+			name:     "market id 255",
+			marketID: 255,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 255, marketTypeByte},
 		},
 		{
-			name:     "order id 1,229,782,938,247,303,441",
-			orderID:  1_229_782_938_247_303_441,
-			expected: []byte{keeper.KeyTypeOrder, 17, 17, 17, 17, 17, 17, 17, 17},
+			name:     "market id 256",
+			marketID: 256,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 1, 0, marketTypeByte},
 		},
 		{
-			name:     "order id 18,446,744,073,709,551,615",
-			orderID:  18_446_744_073_709_551_615,
-			expected: []byte{keeper.KeyTypeOrder, 255, 255, 255, 255, 255, 255, 255, 255},
+			name:     "market id 65_536",
+			marketID: 65_536,
+			expected: []byte{keeper.KeyTypeMarket, 0, 1, 0, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 16,777,216",
+			marketID: 16_777_216,
+			expected: []byte{keeper.KeyTypeMarket, 1, 0, 0, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 16,843,009",
+			marketID: 16_843_009,
+			expected: []byte{keeper.KeyTypeMarket, 1, 1, 1, 1, marketTypeByte},
+		},
+		{
+			name:     "market id 4,294,967,295",
+			marketID: 4_294_967_295,
+			expected: []byte{keeper.KeyTypeMarket, 255, 255, 255, 255, marketTypeByte},
 		},
 	}
 
@@ -3313,160 +3544,87 @@ func TestMakeKeyOrder(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			ktc := keyTestCase{
 				maker: func() []byte {
-					return keeper.MakeKeyOrder(tc.orderID)
+					return keeper.MakeKeyMarketBatchAuctionInterval(tc.marketID)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixMarket", value: keeper.GetKeyPrefixMarket(tc.marketID)},
 				},
-				expected:    tc.expected,
-				expPrefixes: []expectedPrefix{{name: "", value: keeper.GetKeyPrefixOrder()}},
 			}
-			checkKey(t, ktc, "MakeKeyOrder(%d)", tc.orderID)
+			checkKey(t, ktc, "MakeKeyMarketBatchAuctionInterval(%d)", tc.marketID)
 		})
 	}
 }
 
-func TestParseKeyOrder(t *testing.T) {
+func TestMakeKeyMarketLastBatchAuction(t *testing.T) {
+	marketTypeByte := keeper.MarketKeyTypeLastBatchAuction
+
 	tests := []struct {
-		name       string
-		key        []byte
-		expOrderID uint64
-		expOK      bool
+		name     string
+		marketID uint32
+		expected []byte
 	}{
-		{name: "nil key", key: nil, expOrderID: 0, expOK: false},
-		{name: "empty key", key: []byte{}, expOrderID: 0, expOK: false},
-		{name: "7 byte key", key: []byte{1, 2, 3, 4, 5, 6, 7}, expOrderID: 0, expOK: false},
-		{name: "10 byte key", key: []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, expOrderID: 0, expOK: false},
-		{name: "9 byte key unknown type", key: []byte{99, 1, 2, 3, 4, 5, 6, 7, 8}, expOrderID: 0, expOK: false},
-		{
-			name:       "8 byte key",
-			key:        []byte{1, 2, 3, 4, 5, 6, 7, 8},
-			expOrderID: 72_623_859_790_382_856,
-			expOK:      true,
-		},
-		{
-			name:       "9 byte key ask",
-			key:        []byte{keeper.OrderKeyTypeAsk, 1, 2, 3, 4, 5, 6, 7, 8},
-			expOrderID: 72_623_859_790_382_856,
-			expOK:      true,
-		},
-		{
-			name:       "9 byte key bid",
-			key:        []byte{keeper.OrderKeyTypeBid, 1, 2, 3, 4, 5, 6, 7, 8},
-			expOrderID: 72_623_859_790_382_856,
-			expOK:      true,
-		},
-	}
-
-	for _, tc := range tests {
-		t.Run(tc.name, func(t *testing.T) {
-			var orderID uint64
-			var ok bool
-			testFunc := func() {
-				orderID, ok = keeper.ParseKeyOrder(tc.key)
-			}
-			require.NotPanics(t, testFunc, "ParseKeyOrder")
-			assert.Equal(t, tc.expOK, ok, "ParseKeyOrder bool ok")
-			assert.Equal(t, tc.expOrderID, orderID, "ParseKeyOrder order ID")
-		})
-	}
-}
-
-func TestParseIndexKeySuffixOrderID(t *testing.T) {
-	tests := []struct {
-		name       string
-		key        []byte
-		expOrderID uint64
-		expOK      bool
-	}{
-		{name: "nil", key: nil, expOrderID: 0, expOK: false},
-		{name: "empty", key: []byte{}, expOrderID: 0, expOK: false},
-		{name: "1 byte", key: []byte{1}, expOrderID: 0, expOK: false},
-		{name: "7 byte", key: []byte{1, 2, 3, 4, 5, 6, 7}, expOrderID: 0, expOK: false},
-		{name: "8 bytes: 0", key: []byte{0, 0, 0, 0, 0, 0, 0, 0}, expOrderID: 0, expOK: true},
-		{name: "8 bytes: 1", key: []byte{0, 0, 0, 0, 0, 0, 0, 1}, expOrderID: 1, expOK: true},
-		{
-			name:       "8 bytes: 4,294,967,296",
-			key:        []byte{0, 0, 0, 1, 0, 0, 0, 0},
-			expOrderID: 4_294_967_296,
-			expOK:      true,
-		},
-		{
-			name:       "8 bytes: 72,623,859,790,382,856",
-			key:        []byte{1, 2, 3, 4, 5, 6, 7, 8},
-			expOrderID: 72_623_859_790_382_856,
-			expOK:      true,
-		},
 		{
-			name:       "8 bytes: max uint64",
-			key:        []byte{255, 255, 255, 255, 255, 255, 255, 255},
-			expOrderID: 18_446_744_073_709_551_615,
-			expOK:      true,
-		},
-		{name: "9 bytes: 0", key: []byte{9, 0, 0, 0, 0, 0, 0, 0, 0}, expOrderID: 0, expOK: true},
-		{name: "9 bytes: 1", key: []byte{9, 0, 0, 0, 0, 0, 0, 0, 1}, expOrderID: 1, expOK: true},
-		{
-			name:       "9 bytes: 4,294,967,296",
-			key:        []byte{9, 0, 0, 0, 1, 0, 0, 0, 0},
-			expOrderID: 4_294_967_296,
-			expOK:      true,
+			name:     "market id 0",
+			marketID: 0,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 0, marketTypeByte},
 		},
 		{
-			name:       "9 bytes: 72,623,859,790,382,856",
-			key:        []byte{9, 1, 2, 3, 4, 5, 6, 7, 8},
-			expOrderID: 72_623_859_790_382_856,
-			expOK:      true,
+			name:     "market id 1",
+			marketID: 1,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 1, marketTypeByte},
 		},
 		{
-			name:       "9 bytes: max uint64",
-			key:        []byte{9, 255, 255, 255, 255, 255, 255, 255, 255},
-			expOrderID: 18_446_744_073_709_551_615,
-			expOK:      true,
+			name:     "market id 255",
+			marketID: 255,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 255, marketTypeByte},
 		},
 		{
-			name:       "20 bytes: 0",
-			key:        []byte{20, 19, 18, 17, 16, 15, 14, 13, 12, 11, 10, 9, 0, 0, 0, 0, 0, 0, 0, 0},
-			expOrderID: 0,
-			expOK:      true,
+			name:     "market id 256",
+			marketID: 256,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 1, 0, marketTypeByte},
 		},
 		{
-			name:       "20 bytes: 1",
-			key:        []byte{20, 19, 18, 17, 16, 15, 14, 13, 12, 11, 10, 9, 0, 0, 0, 0, 0, 0, 0, 1},
-			expOrderID: 1,
-			expOK:      true,
+			name:     "market id 65_536",
+			marketID: 65_536,
+			expected: []byte{keeper.KeyTypeMarket, 0, 1, 0, 0, marketTypeByte},
 		},
 		{
-			name:       "20 bytes: 4,294,967,296",
-			key:        []byte{20, 19, 18, 17, 16, 15, 14, 13, 12, 11, 10, 9, 0, 0, 0, 1, 0, 0, 0, 0},
-			expOrderID: 4_294_967_296,
-			expOK:      true,
+			name:     "market id 16,777,216",
+			marketID: 16_777_216,
+			expected: []byte{keeper.KeyTypeMarket, 1, 0, 0, 0, marketTypeByte},
 		},
 		{
-			name:       "20 bytes: 72,623,859,790,382,856",
-			key:        []byte{20, 19, 18, 17, 16, 15, 14, 13, 12, 11, 10, 9, 1, 2, 3, 4, 5, 6, 7, 8},
-			expOrderID: 72_623_859_790_382_856,
-			expOK:      true,
+			name:     "market id 16,843,009",
+			marketID: 16_843_009,
+			expected: []byte{keeper.KeyTypeMarket, 1, 1, 1, 1, marketTypeByte},
 		},
 		{
-			name:       "20 bytes: max uint64",
-			key:        []byte{20, 19, 18, 17, 16, 15, 14, 13, 12, 11, 10, 9, 255, 255, 255, 255, 255, 255, 255, 255},
-			expOrderID: 18_446_744_073_709_551_615,
-			expOK:      true,
+			name:     "market id 4,294,967,295",
+			marketID: 4_294_967_295,
+			expected: []byte{keeper.KeyTypeMarket, 255, 255, 255, 255, marketTypeByte},
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			var orderID uint64
-			var ok bool
-			testFunc := func() {
-				orderID, ok = keeper.ParseIndexKeySuffixOrderID(tc.key)
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeKeyMarketLastBatchAuction(tc.marketID)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixMarket", value: keeper.GetKeyPrefixMarket(tc.marketID)},
+				},
 			}
-			require.NotPanics(t, testFunc, "ParseIndexKeySuffixOrderID")
-			assert.Equal(t, tc.expOrderID, orderID, "ParseIndexKeySuffixOrderID orderID")
-			assert.Equal(t, tc.expOK, ok, "ParseIndexKeySuffixOrderID ok bool")
+			checkKey(t, ktc, "MakeKeyMarketLastBatchAuction(%d)", tc.marketID)
 		})
 	}
 }
 
-func TestGetIndexKeyPrefixMarketToOrder(t *testing.T) {
+func TestMakeKeyMarketSelfTradePrevention(t *testing.T) {
+	marketTypeByte := keeper.MarketKeyTypeSelfTradePrevention
+
 	tests := []struct {
 		name     string
 		marketID uint32
@@ -3475,42 +3633,42 @@ func TestGetIndexKeyPrefixMarketToOrder(t *testing.T) {
 		{
 			name:     "market id 0",
 			marketID: 0,
-			expected: []byte{keeper.KeyTypeMarketToOrderIndex, 0, 0, 0, 0},
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 0, marketTypeByte},
 		},
 		{
 			name:     "market id 1",
 			marketID: 1,
-			expected: []byte{keeper.KeyTypeMarketToOrderIndex, 0, 0, 0, 1},
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 1, marketTypeByte},
 		},
 		{
 			name:     "market id 255",
 			marketID: 255,
-			expected: []byte{keeper.KeyTypeMarketToOrderIndex, 0, 0, 0, 255},
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 255, marketTypeByte},
 		},
 		{
 			name:     "market id 256",
 			marketID: 256,
-			expected: []byte{keeper.KeyTypeMarketToOrderIndex, 0, 0, 1, 0},
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 1, 0, marketTypeByte},
 		},
 		{
 			name:     "market id 65_536",
 			marketID: 65_536,
-			expected: []byte{keeper.KeyTypeMarketToOrderIndex, 0, 1, 0, 0},
+			expected: []byte{keeper.KeyTypeMarket, 0, 1, 0, 0, marketTypeByte},
 		},
 		{
 			name:     "market id 16,777,216",
 			marketID: 16_777_216,
-			expected: []byte{keeper.KeyTypeMarketToOrderIndex, 1, 0, 0, 0},
+			expected: []byte{keeper.KeyTypeMarket, 1, 0, 0, 0, marketTypeByte},
 		},
 		{
 			name:     "market id 16,843,009",
 			marketID: 16_843_009,
-			expected: []byte{keeper.KeyTypeMarketToOrderIndex, 1, 1, 1, 1},
+			expected: []byte{keeper.KeyTypeMarket, 1, 1, 1, 1, marketTypeByte},
 		},
 		{
 			name:     "market id 4,294,967,295",
 			marketID: 4_294_967_295,
-			expected: []byte{keeper.KeyTypeMarketToOrderIndex, 255, 255, 255, 255},
+			expected: []byte{keeper.KeyTypeMarket, 255, 255, 255, 255, marketTypeByte},
 		},
 	}
 
@@ -3518,82 +3676,65 @@ func TestGetIndexKeyPrefixMarketToOrder(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			ktc := keyTestCase{
 				maker: func() []byte {
-					return keeper.GetIndexKeyPrefixMarketToOrder(tc.marketID)
+					return keeper.MakeKeyMarketSelfTradePrevention(tc.marketID)
 				},
 				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixMarket", value: keeper.GetKeyPrefixMarket(tc.marketID)},
+				},
 			}
-			checkKey(t, ktc, "GetIndexKeyPrefixMarketToOrder(%d)", tc.marketID)
+			checkKey(t, ktc, "MakeKeyMarketSelfTradePrevention(%d)", tc.marketID)
 		})
 	}
 }
 
-func TestMakeIndexKeyMarketToOrder(t *testing.T) {
+func TestMakeKeyMarketAcceptedAssetDenoms(t *testing.T) {
+	marketTypeByte := keeper.MarketKeyTypeAcceptedAssetDenoms
+
 	tests := []struct {
 		name     string
 		marketID uint32
-		orderID  uint64
 		expected []byte
 	}{
 		{
-			name:     "market 0 order 0",
-			marketID: 0,
-			orderID:  0,
-			expected: []byte{keeper.KeyTypeMarketToOrderIndex, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
-		},
-		{
-			name:     "market 0 order 1",
-			marketID: 0,
-			orderID:  1,
-			expected: []byte{keeper.KeyTypeMarketToOrderIndex, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1},
-		},
-		{
-			name:     "market 0 order 72,340,172,838,076,673",
+			name:     "market id 0",
 			marketID: 0,
-			orderID:  72_340_172_838_076_673,
-			expected: []byte{keeper.KeyTypeMarketToOrderIndex, 0, 0, 0, 0, 1, 1, 1, 1, 1, 1, 1, 1},
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 0, marketTypeByte},
 		},
 		{
-			name:     "market 2 order 0",
-			marketID: 2,
-			orderID:  0,
-			expected: []byte{keeper.KeyTypeMarketToOrderIndex, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 0},
+			name:     "market id 1",
+			marketID: 1,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 1, marketTypeByte},
 		},
 		{
-			name:     "market 2 order 1",
-			marketID: 2,
-			orderID:  1,
-			expected: []byte{keeper.KeyTypeMarketToOrderIndex, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 1},
+			name:     "market id 255",
+			marketID: 255,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 255, marketTypeByte},
 		},
 		{
-			name:     "market 2 order 72,340,172,838,076,673",
-			marketID: 2,
-			orderID:  72_340_172_838_076_673,
-			expected: []byte{keeper.KeyTypeMarketToOrderIndex, 0, 0, 0, 2, 1, 1, 1, 1, 1, 1, 1, 1},
+			name:     "market id 256",
+			marketID: 256,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 1, 0, marketTypeByte},
 		},
 		{
-			name:     "market 33,686,018 order 0",
-			marketID: 33_686_018,
-			orderID:  0,
-			expected: []byte{keeper.KeyTypeMarketToOrderIndex, 2, 2, 2, 2, 0, 0, 0, 0, 0, 0, 0, 0},
+			name:     "market id 65_536",
+			marketID: 65_536,
+			expected: []byte{keeper.KeyTypeMarket, 0, 1, 0, 0, marketTypeByte},
 		},
 		{
-			name:     "market 33,686,018 order 1",
-			marketID: 33_686_018,
-			orderID:  1,
-			expected: []byte{keeper.KeyTypeMarketToOrderIndex, 2, 2, 2, 2, 0, 0, 0, 0, 0, 0, 0, 1},
+			name:     "market id 16,777,216",
+			marketID: 16_777_216,
+			expected: []byte{keeper.KeyTypeMarket, 1, 0, 0, 0, marketTypeByte},
 		},
 		{
-			name:     "market 33,686,018 order 72,340,172,838,076,673",
-			marketID: 33_686_018,
-			orderID:  72_340_172_838_076_673,
-			expected: []byte{keeper.KeyTypeMarketToOrderIndex, 2, 2, 2, 2, 1, 1, 1, 1, 1, 1, 1, 1},
+			name:     "market id 16,843,009",
+			marketID: 16_843_009,
+			expected: []byte{keeper.KeyTypeMarket, 1, 1, 1, 1, marketTypeByte},
 		},
-
 		{
-			name:     "market max order max",
+			name:     "market id 4,294,967,295",
 			marketID: 4_294_967_295,
-			orderID:  18_446_744_073_709_551_615,
-			expected: []byte{keeper.KeyTypeMarketToOrderIndex, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255},
+			expected: []byte{keeper.KeyTypeMarket, 255, 255, 255, 255, marketTypeByte},
 		},
 	}
 
@@ -3601,165 +3742,192 @@ func TestMakeIndexKeyMarketToOrder(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			ktc := keyTestCase{
 				maker: func() []byte {
-					return keeper.MakeIndexKeyMarketToOrder(tc.marketID, tc.orderID)
+					return keeper.MakeKeyMarketAcceptedAssetDenoms(tc.marketID)
 				},
 				expected: tc.expected,
 				expPrefixes: []expectedPrefix{
-					{name: "", value: keeper.GetIndexKeyPrefixMarketToOrder(tc.marketID)},
+					{name: "GetKeyPrefixMarket", value: keeper.GetKeyPrefixMarket(tc.marketID)},
 				},
 			}
-			checkKey(t, ktc, "MakeIndexKeyMarketToOrder(%d, %d)", tc.marketID, tc.orderID)
+			checkKey(t, ktc, "MakeKeyMarketAcceptedAssetDenoms(%d)", tc.marketID)
 		})
 	}
 }
 
-func TestParseIndexKeyMarketToOrder(t *testing.T) {
+func TestMakeKeyMarketAcceptedPriceDenoms(t *testing.T) {
+	marketTypeByte := keeper.MarketKeyTypeAcceptedPriceDenoms
+
 	tests := []struct {
-		name        string
-		key         []byte
-		expMarketID uint32
-		expOrderID  uint64
-		expErr      string
+		name     string
+		marketID uint32
+		expected []byte
 	}{
 		{
-			name:   "nil key",
-			key:    nil,
-			expErr: "cannot parse market to order key: length 0, expected 8, 12, or 13",
+			name:     "market id 0",
+			marketID: 0,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 0, marketTypeByte},
 		},
 		{
-			name:   "empty key",
-			key:    []byte{},
-			expErr: "cannot parse market to order key: length 0, expected 8, 12, or 13",
+			name:     "market id 1",
+			marketID: 1,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 1, marketTypeByte},
 		},
 		{
-			name:   "7 bytes",
-			key:    []byte{1, 2, 3, 4, 5, 6, 7},
-			expErr: "cannot parse market to order key: length 7, expected 8, 12, or 13",
+			name:     "market id 255",
+			marketID: 255,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 255, marketTypeByte},
 		},
 		{
-			name:   "9 bytes",
-			key:    []byte{1, 2, 3, 4, 5, 6, 7, 8, 9},
-			expErr: "cannot parse market to order key: length 9, expected 8, 12, or 13",
+			name:     "market id 256",
+			marketID: 256,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 1, 0, marketTypeByte},
 		},
 		{
-			name:   "10 bytes",
-			key:    []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
-			expErr: "cannot parse market to order key: length 10, expected 8, 12, or 13",
+			name:     "market id 65_536",
+			marketID: 65_536,
+			expected: []byte{keeper.KeyTypeMarket, 0, 1, 0, 0, marketTypeByte},
 		},
 		{
-			name:   "11 bytes",
-			key:    []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11},
-			expErr: "cannot parse market to order key: length 11, expected 8, 12, or 13",
+			name:     "market id 16,777,216",
+			marketID: 16_777_216,
+			expected: []byte{keeper.KeyTypeMarket, 1, 0, 0, 0, marketTypeByte},
 		},
 		{
-			name:   "14 bytes",
-			key:    []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14},
-			expErr: "cannot parse market to order key: length 14, expected 8, 12, or 13",
+			name:     "market id 16,843,009",
+			marketID: 16_843_009,
+			expected: []byte{keeper.KeyTypeMarket, 1, 1, 1, 1, marketTypeByte},
 		},
 		{
-			name:       "8 bytes order id 0",
-			key:        []byte{0, 0, 0, 0, 0, 0, 0, 0},
-			expOrderID: 0,
+			name:     "market id 4,294,967,295",
+			marketID: 4_294_967_295,
+			expected: []byte{keeper.KeyTypeMarket, 255, 255, 255, 255, marketTypeByte},
 		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeKeyMarketAcceptedPriceDenoms(tc.marketID)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixMarket", value: keeper.GetKeyPrefixMarket(tc.marketID)},
+				},
+			}
+			checkKey(t, ktc, "MakeKeyMarketAcceptedPriceDenoms(%d)", tc.marketID)
+		})
+	}
+}
+
+func TestGetKeyPrefixMarketSellerSettlementTakerRatio(t *testing.T) {
+	marketTypeByte := keeper.MarketKeyTypeSellerSettlementTakerRatio
+
+	tests := []struct {
+		name     string
+		marketID uint32
+		expected []byte
+	}{
 		{
-			name:        "8 bytes order id 1",
-			key:         []byte{0, 0, 0, 0, 0, 0, 0, 1},
-			expMarketID: 0,
-			expOrderID:  1,
+			name:     "market id 0",
+			marketID: 0,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 0, marketTypeByte},
 		},
 		{
-			name:       "8 bytes order id 72,623,859,790,382,856",
-			key:        []byte{1, 2, 3, 4, 5, 6, 7, 8},
-			expOrderID: 72_623_859_790_382_856,
+			name:     "market id 1",
+			marketID: 1,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 1, marketTypeByte},
 		},
 		{
-			name:        "12 bytes market id 1 order id 1",
-			key:         []byte{0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 1},
-			expMarketID: 1,
-			expOrderID:  1,
+			name:     "market id 255",
+			marketID: 255,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 255, marketTypeByte},
 		},
 		{
-			name:        "12 bytes market id 16,843,009 order id 144,680,345,676,153,346",
-			key:         []byte{1, 1, 1, 1, 2, 2, 2, 2, 2, 2, 2, 2},
-			expMarketID: 16_843_009,
-			expOrderID:  144_680_345_676_153_346,
+			name:     "market id 256",
+			marketID: 256,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 1, 0, marketTypeByte},
 		},
 		{
-			name:        "13 bytes market id 1 order id 1",
-			key:         []byte{keeper.KeyTypeMarketToOrderIndex, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 1},
-			expMarketID: 1,
-			expOrderID:  1,
+			name:     "market id 65_536",
+			marketID: 65_536,
+			expected: []byte{keeper.KeyTypeMarket, 0, 1, 0, 0, marketTypeByte},
 		},
 		{
-			name:        "13 bytes market id 16,843,009 order id 144,680,345,676,153,346",
-			key:         []byte{keeper.KeyTypeMarketToOrderIndex, 1, 1, 1, 1, 2, 2, 2, 2, 2, 2, 2, 2},
-			expMarketID: 16_843_009,
-			expOrderID:  144_680_345_676_153_346,
+			name:     "market id 16,777,216",
+			marketID: 16_777_216,
+			expected: []byte{keeper.KeyTypeMarket, 1, 0, 0, 0, marketTypeByte},
 		},
 		{
-			name:   "13 bytes first byte too high",
-			key:    []byte{0x4, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 1},
-			expErr: "cannot parse market to order key: unknown type byte 0x4, expected 0x3",
+			name:     "market id 16,843,009",
+			marketID: 16_843_009,
+			expected: []byte{keeper.KeyTypeMarket, 1, 1, 1, 1, marketTypeByte},
 		},
 		{
-			name:   "13 bytes first byte too low",
-			key:    []byte{0x2, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 1},
-			expErr: "cannot parse market to order key: unknown type byte 0x2, expected 0x3",
+			name:     "market id 4,294,967,295",
+			marketID: 4_294_967_295,
+			expected: []byte{keeper.KeyTypeMarket, 255, 255, 255, 255, marketTypeByte},
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			var marketID uint32
-			var orderID uint64
-			var err error
-			testFunc := func() {
-				marketID, orderID, err = keeper.ParseIndexKeyMarketToOrder(tc.key)
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetKeyPrefixMarketSellerSettlementTakerRatio(tc.marketID)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixMarket", value: keeper.GetKeyPrefixMarket(tc.marketID)},
+				},
 			}
-			require.NotPanics(t, testFunc, "ParseIndexKeyMarketToOrder(%v)", tc.key)
-			assertions.AssertErrorValue(t, err, tc.expErr, "ParseIndexKeyMarketToOrder(%v) error", tc.key)
-			assert.Equal(t, tc.expMarketID, marketID, "ParseIndexKeyMarketToOrder(%v) market id", tc.key)
-			assert.Equal(t, tc.expOrderID, orderID, "ParseIndexKeyMarketToOrder(%v) order id", tc.key)
+			checkKey(t, ktc, "GetKeyPrefixMarketSellerSettlementTakerRatio(%d)", tc.marketID)
 		})
 	}
 }
 
-func TestGetIndexKeyPrefixAddressToOrder(t *testing.T) {
+func TestMakeKeyMarketSellerSettlementTakerRatio(t *testing.T) {
+	marketTypeByte := keeper.MarketKeyTypeSellerSettlementTakerRatio
+	coin := func(denom string) sdk.Coin {
+		return sdk.Coin{Denom: denom, Amount: sdkmath.OneInt()}
+	}
+	rs := keeper.RecordSeparator
+
 	tests := []struct {
 		name     string
-		addr     sdk.AccAddress
+		marketID uint32
+		ratio    exchange.FeeRatio
 		expected []byte
-		expPanic string
 	}{
 		{
-			name:     "nil addr",
-			addr:     nil,
-			expPanic: "empty address not allowed",
-		},
-		{
-			name:     "empty addr",
-			addr:     sdk.AccAddress{},
-			expPanic: "empty address not allowed",
+			name:     "market id 0 both denoms empty",
+			marketID: 0,
+			ratio:    exchange.FeeRatio{Price: coin(""), Fee: coin("")},
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 0, marketTypeByte, rs},
 		},
 		{
-			name:     "256 byte addr",
-			addr:     sdk.AccAddress(bytes.Repeat([]byte{'P'}, 256)),
-			expPanic: "address length should be max 255 bytes, got 256: unknown address",
+			name:     "market id 1 nhash to empty",
+			marketID: 1,
+			ratio:    exchange.FeeRatio{Price: coin("nhash"), Fee: coin("")},
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 1, marketTypeByte, 'n', 'h', 'a', 's', 'h', rs},
 		},
 		{
-			name:     "5 byte addr",
-			addr:     sdk.AccAddress("abcde"),
-			expected: append([]byte{keeper.KeyTypeAddressToOrderIndex, 5}, "abcde"...),
+			name:     "market id 1 empty to nhash",
+			marketID: 1,
+			ratio:    exchange.FeeRatio{Price: coin(""), Fee: coin("nhash")},
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 1, marketTypeByte, rs, 'n', 'h', 'a', 's', 'h'},
 		},
 		{
-			name:     "20 byte addr",
-			addr:     sdk.AccAddress("abcdefghijklmnopqrst"),
-			expected: append([]byte{keeper.KeyTypeAddressToOrderIndex, 20}, "abcdefghijklmnopqrst"...),
+			name:     "market id 1 nhash to nhash",
+			marketID: 1,
+			ratio:    exchange.FeeRatio{Price: coin("nhash"), Fee: coin("nhash")},
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 1, marketTypeByte, 'n', 'h', 'a', 's', 'h', rs, 'n', 'h', 'a', 's', 'h'},
 		},
 		{
-			name:     "32 byte addr",
-			addr:     sdk.AccAddress("abcdefghijklmnopqrstuvwxyzABCDEF"),
-			expected: append([]byte{keeper.KeyTypeAddressToOrderIndex, 32}, "abcdefghijklmnopqrstuvwxyzABCDEF"...),
+			name:     "market id 16,843,009 nhash to hex string",
+			marketID: 16_843_009,
+			ratio:    exchange.FeeRatio{Price: coin("nhash"), Fee: coin(hexString)},
+			expected: append([]byte{keeper.KeyTypeMarket, 1, 1, 1, 1, marketTypeByte, 'n', 'h', 'a', 's', 'h', rs}, hexString...),
 		},
 	}
 
@@ -3767,88 +3935,5845 @@ func TestGetIndexKeyPrefixAddressToOrder(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			ktc := keyTestCase{
 				maker: func() []byte {
-					return keeper.GetIndexKeyPrefixAddressToOrder(tc.addr)
+					return keeper.MakeKeyMarketSellerSettlementTakerRatio(tc.marketID, tc.ratio)
 				},
 				expected: tc.expected,
-				expPanic: tc.expPanic,
+				expPrefixes: []expectedPrefix{
+					{
+						name:  "GetKeyPrefixMarket",
+						value: keeper.GetKeyPrefixMarket(tc.marketID),
+					},
+					{
+						name:  "GetKeyPrefixMarketSellerSettlementTakerRatio",
+						value: keeper.GetKeyPrefixMarketSellerSettlementTakerRatio(tc.marketID),
+					},
+				},
 			}
-			checkKey(t, ktc, "GetIndexKeyPrefixAddressToOrder(%s)", string(tc.addr))
+			checkKey(t, ktc, "MakeKeyMarketSellerSettlementTakerRatio(%d)", tc.marketID)
 		})
 	}
 }
 
-func TestMakeIndexKeyAddressToOrder(t *testing.T) {
+func TestGetKeyPrefixMarketSellerSettlementRebateRatio(t *testing.T) {
+	marketTypeByte := keeper.MarketKeyTypeSellerSettlementRebateRatio
+
 	tests := []struct {
 		name     string
-		addr     sdk.AccAddress
-		orderID  uint64
+		marketID uint32
 		expected []byte
-		expPanic string
 	}{
 		{
-			name:     "nil addr",
-			addr:     nil,
-			expPanic: "empty address not allowed",
+			name:     "market id 0",
+			marketID: 0,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 0, marketTypeByte},
 		},
 		{
-			name:     "empty addr",
-			addr:     sdk.AccAddress{},
-			expPanic: "empty address not allowed",
+			name:     "market id 1",
+			marketID: 1,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 1, marketTypeByte},
 		},
 		{
-			name:     "256 byte addr",
-			addr:     sdk.AccAddress(bytes.Repeat([]byte{'P'}, 256)),
-			expPanic: "address length should be max 255 bytes, got 256: unknown address",
+			name:     "market id 255",
+			marketID: 255,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 255, marketTypeByte},
 		},
 		{
-			name:    "5 byte addr order 1",
-			addr:    sdk.AccAddress("abcde"),
-			orderID: 1,
-			expected: concatBz(
-				[]byte{keeper.KeyTypeAddressToOrderIndex, 5},
-				[]byte("abcde"),
-				[]byte{0, 0, 0, 0, 0, 0, 0, 1},
-			),
+			name:     "market id 256",
+			marketID: 256,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 1, 0, marketTypeByte},
 		},
 		{
-			name:    "20 byte addr order 1",
-			addr:    sdk.AccAddress("abcdefghijklmnopqrst"),
-			orderID: 1,
-			expected: concatBz(
-				[]byte{keeper.KeyTypeAddressToOrderIndex, 20},
-				[]byte("abcdefghijklmnopqrst"),
-				[]byte{0, 0, 0, 0, 0, 0, 0, 1},
-			),
+			name:     "market id 65_536",
+			marketID: 65_536,
+			expected: []byte{keeper.KeyTypeMarket, 0, 1, 0, 0, marketTypeByte},
 		},
 		{
-			name:    "32 byte addr order 1",
-			addr:    sdk.AccAddress("abcdefghijklmnopqrstuvwxyzABCDEF"),
-			orderID: 1,
-			expected: concatBz(
-				[]byte{keeper.KeyTypeAddressToOrderIndex, 32},
-				[]byte("abcdefghijklmnopqrstuvwxyzABCDEF"),
-				[]byte{0, 0, 0, 0, 0, 0, 0, 1},
-			),
+			name:     "market id 16,777,216",
+			marketID: 16_777_216,
+			expected: []byte{keeper.KeyTypeMarket, 1, 0, 0, 0, marketTypeByte},
 		},
 		{
-			name:    "20 byte addr order 5",
-			addr:    sdk.AccAddress("ABCDEFGHIJKLMNOPQRST"),
-			orderID: 5,
-			expected: concatBz(
-				[]byte{keeper.KeyTypeAddressToOrderIndex, 20},
-				[]byte("ABCDEFGHIJKLMNOPQRST"),
-				[]byte{0, 0, 0, 0, 0, 0, 0, 5},
-			),
+			name:     "market id 16,843,009",
+			marketID: 16_843_009,
+			expected: []byte{keeper.KeyTypeMarket, 1, 1, 1, 1, marketTypeByte},
+		},
+		{
+			name:     "market id 4,294,967,295",
+			marketID: 4_294_967_295,
+			expected: []byte{keeper.KeyTypeMarket, 255, 255, 255, 255, marketTypeByte},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetKeyPrefixMarketSellerSettlementRebateRatio(tc.marketID)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixMarket", value: keeper.GetKeyPrefixMarket(tc.marketID)},
+				},
+			}
+			checkKey(t, ktc, "GetKeyPrefixMarketSellerSettlementRebateRatio(%d)", tc.marketID)
+		})
+	}
+}
+
+func TestMakeKeyMarketSellerSettlementRebateRatio(t *testing.T) {
+	marketTypeByte := keeper.MarketKeyTypeSellerSettlementRebateRatio
+	coin := func(denom string) sdk.Coin {
+		return sdk.Coin{Denom: denom, Amount: sdkmath.OneInt()}
+	}
+	rs := keeper.RecordSeparator
+
+	tests := []struct {
+		name     string
+		marketID uint32
+		ratio    exchange.FeeRatio
+		expected []byte
+	}{
+		{
+			name:     "market id 0 both denoms empty",
+			marketID: 0,
+			ratio:    exchange.FeeRatio{Price: coin(""), Fee: coin("")},
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 0, marketTypeByte, rs},
+		},
+		{
+			name:     "market id 1 nhash to empty",
+			marketID: 1,
+			ratio:    exchange.FeeRatio{Price: coin("nhash"), Fee: coin("")},
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 1, marketTypeByte, 'n', 'h', 'a', 's', 'h', rs},
+		},
+		{
+			name:     "market id 1 empty to nhash",
+			marketID: 1,
+			ratio:    exchange.FeeRatio{Price: coin(""), Fee: coin("nhash")},
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 1, marketTypeByte, rs, 'n', 'h', 'a', 's', 'h'},
+		},
+		{
+			name:     "market id 1 nhash to nhash",
+			marketID: 1,
+			ratio:    exchange.FeeRatio{Price: coin("nhash"), Fee: coin("nhash")},
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 1, marketTypeByte, 'n', 'h', 'a', 's', 'h', rs, 'n', 'h', 'a', 's', 'h'},
+		},
+		{
+			name:     "market id 16,843,009 nhash to hex string",
+			marketID: 16_843_009,
+			ratio:    exchange.FeeRatio{Price: coin("nhash"), Fee: coin(hexString)},
+			expected: append([]byte{keeper.KeyTypeMarket, 1, 1, 1, 1, marketTypeByte, 'n', 'h', 'a', 's', 'h', rs}, hexString...),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeKeyMarketSellerSettlementRebateRatio(tc.marketID, tc.ratio)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{
+						name:  "GetKeyPrefixMarket",
+						value: keeper.GetKeyPrefixMarket(tc.marketID),
+					},
+					{
+						name:  "GetKeyPrefixMarketSellerSettlementRebateRatio",
+						value: keeper.GetKeyPrefixMarketSellerSettlementRebateRatio(tc.marketID),
+					},
+				},
+			}
+			checkKey(t, ktc, "MakeKeyMarketSellerSettlementRebateRatio(%d)", tc.marketID)
+		})
+	}
+}
+
+func TestMakeKeyMarketRebateAddresses(t *testing.T) {
+	marketTypeByte := keeper.MarketKeyTypeRebateAddresses
+
+	tests := []struct {
+		name     string
+		marketID uint32
+		expected []byte
+	}{
+		{
+			name:     "market id 0",
+			marketID: 0,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 1",
+			marketID: 1,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 1, marketTypeByte},
+		},
+		{
+			name:     "market id 255",
+			marketID: 255,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 255, marketTypeByte},
+		},
+		{
+			name:     "market id 256",
+			marketID: 256,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 1, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 65_536",
+			marketID: 65_536,
+			expected: []byte{keeper.KeyTypeMarket, 0, 1, 0, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 16,777,216",
+			marketID: 16_777_216,
+			expected: []byte{keeper.KeyTypeMarket, 1, 0, 0, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 16,843,009",
+			marketID: 16_843_009,
+			expected: []byte{keeper.KeyTypeMarket, 1, 1, 1, 1, marketTypeByte},
+		},
+		{
+			name:     "market id 4,294,967,295",
+			marketID: 4_294_967_295,
+			expected: []byte{keeper.KeyTypeMarket, 255, 255, 255, 255, marketTypeByte},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeKeyMarketRebateAddresses(tc.marketID)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixMarket", value: keeper.GetKeyPrefixMarket(tc.marketID)},
+				},
+			}
+			checkKey(t, ktc, "MakeKeyMarketRebateAddresses(%d)", tc.marketID)
+		})
+	}
+}
+
+func TestMakeKeyMarketReferralBips(t *testing.T) {
+	marketTypeByte := keeper.MarketKeyTypeReferralBips
+
+	tests := []struct {
+		name     string
+		marketID uint32
+		expected []byte
+	}{
+		{
+			name:     "market id 0",
+			marketID: 0,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 1",
+			marketID: 1,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 1, marketTypeByte},
+		},
+		{
+			name:     "market id 255",
+			marketID: 255,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 255, marketTypeByte},
+		},
+		{
+			name:     "market id 256",
+			marketID: 256,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 1, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 65_536",
+			marketID: 65_536,
+			expected: []byte{keeper.KeyTypeMarket, 0, 1, 0, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 16,777,216",
+			marketID: 16_777_216,
+			expected: []byte{keeper.KeyTypeMarket, 1, 0, 0, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 16,843,009",
+			marketID: 16_843_009,
+			expected: []byte{keeper.KeyTypeMarket, 1, 1, 1, 1, marketTypeByte},
+		},
+		{
+			name:     "market id 4,294,967,295",
+			marketID: 4_294_967_295,
+			expected: []byte{keeper.KeyTypeMarket, 255, 255, 255, 255, marketTypeByte},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeKeyMarketReferralBips(tc.marketID)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixMarket", value: keeper.GetKeyPrefixMarket(tc.marketID)},
+				},
+			}
+			checkKey(t, ktc, "MakeKeyMarketReferralBips(%d)", tc.marketID)
+		})
+	}
+}
+
+func TestGetKeyPrefixMarketReferralCap(t *testing.T) {
+	marketTypeByte := keeper.MarketKeyTypeReferralCap
+
+	tests := []struct {
+		name     string
+		marketID uint32
+		expected []byte
+	}{
+		{
+			name:     "market id 0",
+			marketID: 0,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 1",
+			marketID: 1,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 1, marketTypeByte},
+		},
+		{
+			name:     "market id 255",
+			marketID: 255,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 255, marketTypeByte},
+		},
+		{
+			name:     "market id 256",
+			marketID: 256,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 1, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 65_536",
+			marketID: 65_536,
+			expected: []byte{keeper.KeyTypeMarket, 0, 1, 0, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 16,777,216",
+			marketID: 16_777_216,
+			expected: []byte{keeper.KeyTypeMarket, 1, 0, 0, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 16,843,009",
+			marketID: 16_843_009,
+			expected: []byte{keeper.KeyTypeMarket, 1, 1, 1, 1, marketTypeByte},
+		},
+		{
+			name:     "market id 4,294,967,295",
+			marketID: 4_294_967_295,
+			expected: []byte{keeper.KeyTypeMarket, 255, 255, 255, 255, marketTypeByte},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetKeyPrefixMarketReferralCap(tc.marketID)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixMarket", value: keeper.GetKeyPrefixMarket(tc.marketID)},
+				},
+			}
+			checkKey(t, ktc, "GetKeyPrefixMarketReferralCap(%d)", tc.marketID)
+		})
+	}
+}
+
+func TestMakeKeyMarketReferralCap(t *testing.T) {
+	marketTypeByte := keeper.MarketKeyTypeReferralCap
+
+	tests := []struct {
+		name     string
+		marketID uint32
+		denom    string
+		expected []byte
+	}{
+		{
+			name:     "market id 0 no denom",
+			marketID: 0,
+			denom:    "",
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 0 nhash",
+			marketID: 0,
+			denom:    "nhash",
+			expected: append([]byte{keeper.KeyTypeMarket, 0, 0, 0, 0, marketTypeByte}, "nhash"...),
+		},
+		{
+			name:     "market id 0 hex string",
+			marketID: 0,
+			denom:    hexString,
+			expected: append([]byte{keeper.KeyTypeMarket, 0, 0, 0, 0, marketTypeByte}, hexString...),
+		},
+		{
+			name:     "market id 1 no denom",
+			marketID: 1,
+			denom:    "",
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 1, marketTypeByte},
+		},
+		{
+			name:     "market id 1 nhash",
+			marketID: 1,
+			denom:    "nhash",
+			expected: append([]byte{keeper.KeyTypeMarket, 0, 0, 0, 1, marketTypeByte}, "nhash"...),
+		},
+		{
+			name:     "market id 1 hex string",
+			marketID: 1,
+			denom:    hexString,
+			expected: append([]byte{keeper.KeyTypeMarket, 0, 0, 0, 1, marketTypeByte}, hexString...),
+		},
+		{
+			name:     "market id 16,843,009 no denom",
+			marketID: 16_843_009,
+			denom:    "",
+			expected: []byte{keeper.KeyTypeMarket, 1, 1, 1, 1, marketTypeByte},
+		},
+		{
+			name:     "market id 16,843,009 nhash",
+			marketID: 16_843_009,
+			denom:    "nhash",
+			expected: append([]byte{keeper.KeyTypeMarket, 1, 1, 1, 1, marketTypeByte}, "nhash"...),
+		},
+		{
+			name:     "market id 16,843,009 hex string",
+			marketID: 16_843_009,
+			denom:    hexString,
+			expected: append([]byte{keeper.KeyTypeMarket, 1, 1, 1, 1, marketTypeByte}, hexString...),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeKeyMarketReferralCap(tc.marketID, tc.denom)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{
+						name:  "GetKeyPrefixMarket",
+						value: keeper.GetKeyPrefixMarket(tc.marketID),
+					},
+					{
+						name:  "GetKeyPrefixMarketReferralCap",
+						value: keeper.GetKeyPrefixMarketReferralCap(tc.marketID),
+					},
+				},
+			}
+			checkKey(t, ktc, "MakeKeyMarketReferralCap(%d)", tc.marketID)
+		})
+	}
+}
+
+func TestMakeKeyMarketPublishPrices(t *testing.T) {
+	marketTypeByte := keeper.MarketKeyTypePublishPrices
+
+	tests := []struct {
+		name     string
+		marketID uint32
+		expected []byte
+	}{
+		{
+			name:     "market id 0",
+			marketID: 0,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 1",
+			marketID: 1,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 1, marketTypeByte},
+		},
+		{
+			name:     "market id 255",
+			marketID: 255,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 255, marketTypeByte},
+		},
+		{
+			name:     "market id 256",
+			marketID: 256,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 1, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 65_536",
+			marketID: 65_536,
+			expected: []byte{keeper.KeyTypeMarket, 0, 1, 0, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 16,777,216",
+			marketID: 16_777_216,
+			expected: []byte{keeper.KeyTypeMarket, 1, 0, 0, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 16,843,009",
+			marketID: 16_843_009,
+			expected: []byte{keeper.KeyTypeMarket, 1, 1, 1, 1, marketTypeByte},
+		},
+		{
+			name:     "market id 4,294,967,295",
+			marketID: 4_294_967_295,
+			expected: []byte{keeper.KeyTypeMarket, 255, 255, 255, 255, marketTypeByte},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeKeyMarketPublishPrices(tc.marketID)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixMarket", value: keeper.GetKeyPrefixMarket(tc.marketID)},
+				},
+			}
+			checkKey(t, ktc, "MakeKeyMarketPublishPrices(%d)", tc.marketID)
+		})
+	}
+}
+
+func TestMakeKeyMarketExternalIDScope(t *testing.T) {
+	marketTypeByte := keeper.MarketKeyTypeExternalIDScope
+
+	tests := []struct {
+		name     string
+		marketID uint32
+		expected []byte
+	}{
+		{
+			name:     "market id 0",
+			marketID: 0,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 1",
+			marketID: 1,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 1, marketTypeByte},
+		},
+		{
+			name:     "market id 255",
+			marketID: 255,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 255, marketTypeByte},
+		},
+		{
+			name:     "market id 256",
+			marketID: 256,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 1, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 65_536",
+			marketID: 65_536,
+			expected: []byte{keeper.KeyTypeMarket, 0, 1, 0, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 16,777,216",
+			marketID: 16_777_216,
+			expected: []byte{keeper.KeyTypeMarket, 1, 0, 0, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 16,843,009",
+			marketID: 16_843_009,
+			expected: []byte{keeper.KeyTypeMarket, 1, 1, 1, 1, marketTypeByte},
+		},
+		{
+			name:     "market id 4,294,967,295",
+			marketID: 4_294_967_295,
+			expected: []byte{keeper.KeyTypeMarket, 255, 255, 255, 255, marketTypeByte},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeKeyMarketExternalIDScope(tc.marketID)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixMarket", value: keeper.GetKeyPrefixMarket(tc.marketID)},
+				},
+			}
+			checkKey(t, ktc, "MakeKeyMarketExternalIDScope(%d)", tc.marketID)
+		})
+	}
+}
+
+func TestMakeKeyMarketOrderRateLimit(t *testing.T) {
+	marketTypeByte := keeper.MarketKeyTypeOrderRateLimit
+
+	tests := []struct {
+		name     string
+		marketID uint32
+		expected []byte
+	}{
+		{
+			name:     "market id 0",
+			marketID: 0,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 1",
+			marketID: 1,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 1, marketTypeByte},
+		},
+		{
+			name:     "market id 16,843,009",
+			marketID: 16_843_009,
+			expected: []byte{keeper.KeyTypeMarket, 1, 1, 1, 1, marketTypeByte},
+		},
+		{
+			name:     "market id 4,294,967,295",
+			marketID: 4_294_967_295,
+			expected: []byte{keeper.KeyTypeMarket, 255, 255, 255, 255, marketTypeByte},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeKeyMarketOrderRateLimit(tc.marketID)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixMarket", value: keeper.GetKeyPrefixMarket(tc.marketID)},
+				},
+			}
+			checkKey(t, ktc, "MakeKeyMarketOrderRateLimit(%d)", tc.marketID)
+		})
+	}
+}
+
+func TestMakeKeyMarketMaxExposure(t *testing.T) {
+	marketTypeByte := keeper.MarketKeyTypeMaxExposure
+
+	tests := []struct {
+		name     string
+		marketID uint32
+		expected []byte
+	}{
+		{
+			name:     "market id 0",
+			marketID: 0,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 1",
+			marketID: 1,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 1, marketTypeByte},
+		},
+		{
+			name:     "market id 16,843,009",
+			marketID: 16_843_009,
+			expected: []byte{keeper.KeyTypeMarket, 1, 1, 1, 1, marketTypeByte},
+		},
+		{
+			name:     "market id 4,294,967,295",
+			marketID: 4_294_967_295,
+			expected: []byte{keeper.KeyTypeMarket, 255, 255, 255, 255, marketTypeByte},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeKeyMarketMaxExposure(tc.marketID)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixMarket", value: keeper.GetKeyPrefixMarket(tc.marketID)},
+				},
+			}
+			checkKey(t, ktc, "MakeKeyMarketMaxExposure(%d)", tc.marketID)
+		})
+	}
+}
+
+func TestMakeKeyMarketFillAlgorithm(t *testing.T) {
+	marketTypeByte := keeper.MarketKeyTypeFillAlgorithm
+
+	tests := []struct {
+		name     string
+		marketID uint32
+		expected []byte
+	}{
+		{
+			name:     "market id 0",
+			marketID: 0,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 1",
+			marketID: 1,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 1, marketTypeByte},
+		},
+		{
+			name:     "market id 16,843,009",
+			marketID: 16_843_009,
+			expected: []byte{keeper.KeyTypeMarket, 1, 1, 1, 1, marketTypeByte},
+		},
+		{
+			name:     "market id 4,294,967,295",
+			marketID: 4_294_967_295,
+			expected: []byte{keeper.KeyTypeMarket, 255, 255, 255, 255, marketTypeByte},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeKeyMarketFillAlgorithm(tc.marketID)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixMarket", value: keeper.GetKeyPrefixMarket(tc.marketID)},
+				},
+			}
+			checkKey(t, ktc, "MakeKeyMarketFillAlgorithm(%d)", tc.marketID)
+		})
+	}
+}
+
+func TestGetKeyPrefixMarketExchangeSplit(t *testing.T) {
+	marketTypeByte := keeper.MarketKeyTypeExchangeSplit
+
+	tests := []struct {
+		name     string
+		marketID uint32
+		expected []byte
+	}{
+		{
+			name:     "market id 0",
+			marketID: 0,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 1",
+			marketID: 1,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 1, marketTypeByte},
+		},
+		{
+			name:     "market id 255",
+			marketID: 255,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 255, marketTypeByte},
+		},
+		{
+			name:     "market id 256",
+			marketID: 256,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 1, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 65_536",
+			marketID: 65_536,
+			expected: []byte{keeper.KeyTypeMarket, 0, 1, 0, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 16,777,216",
+			marketID: 16_777_216,
+			expected: []byte{keeper.KeyTypeMarket, 1, 0, 0, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 16,843,009",
+			marketID: 16_843_009,
+			expected: []byte{keeper.KeyTypeMarket, 1, 1, 1, 1, marketTypeByte},
+		},
+		{
+			name:     "market id 4,294,967,295",
+			marketID: 4_294_967_295,
+			expected: []byte{keeper.KeyTypeMarket, 255, 255, 255, 255, marketTypeByte},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetKeyPrefixMarketExchangeSplit(tc.marketID)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixMarket", value: keeper.GetKeyPrefixMarket(tc.marketID)},
+				},
+			}
+			checkKey(t, ktc, "GetKeyPrefixMarketExchangeSplit(%d)", tc.marketID)
+		})
+	}
+}
+
+func TestMakeKeyMarketExchangeSplit(t *testing.T) {
+	marketTypeByte := keeper.MarketKeyTypeExchangeSplit
+
+	tests := []struct {
+		name     string
+		marketID uint32
+		denom    string
+		expected []byte
+	}{
+		{
+			name:     "market id 0 no denom",
+			marketID: 0,
+			denom:    "",
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 0 nhash",
+			marketID: 0,
+			denom:    "nhash",
+			expected: append([]byte{keeper.KeyTypeMarket, 0, 0, 0, 0, marketTypeByte}, "nhash"...),
+		},
+		{
+			name:     "market id 0 hex string",
+			marketID: 0,
+			denom:    hexString,
+			expected: append([]byte{keeper.KeyTypeMarket, 0, 0, 0, 0, marketTypeByte}, hexString...),
+		},
+		{
+			name:     "market id 1 no denom",
+			marketID: 1,
+			denom:    "",
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 1, marketTypeByte},
+		},
+		{
+			name:     "market id 1 nhash",
+			marketID: 1,
+			denom:    "nhash",
+			expected: append([]byte{keeper.KeyTypeMarket, 0, 0, 0, 1, marketTypeByte}, "nhash"...),
+		},
+		{
+			name:     "market id 1 hex string",
+			marketID: 1,
+			denom:    hexString,
+			expected: append([]byte{keeper.KeyTypeMarket, 0, 0, 0, 1, marketTypeByte}, hexString...),
+		},
+		{
+			name:     "market id 16,843,009 no denom",
+			marketID: 16_843_009,
+			denom:    "",
+			expected: []byte{keeper.KeyTypeMarket, 1, 1, 1, 1, marketTypeByte},
+		},
+		{
+			name:     "market id 16,843,009 nhash",
+			marketID: 16_843_009,
+			denom:    "nhash",
+			expected: append([]byte{keeper.KeyTypeMarket, 1, 1, 1, 1, marketTypeByte}, "nhash"...),
+		},
+		{
+			name:     "market id 16,843,009 hex string",
+			marketID: 16_843_009,
+			denom:    hexString,
+			expected: append([]byte{keeper.KeyTypeMarket, 1, 1, 1, 1, marketTypeByte}, hexString...),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeKeyMarketExchangeSplit(tc.marketID, tc.denom)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{
+						name:  "GetKeyPrefixMarket",
+						value: keeper.GetKeyPrefixMarket(tc.marketID),
+					},
+					{
+						name:  "GetKeyPrefixMarketExchangeSplit",
+						value: keeper.GetKeyPrefixMarketExchangeSplit(tc.marketID),
+					},
+				},
+			}
+			checkKey(t, ktc, "MakeKeyMarketExchangeSplit(%d)", tc.marketID)
+		})
+	}
+}
+
+func TestGetKeyPrefixOrderCreationCounts(t *testing.T) {
+	tests := []struct {
+		name     string
+		marketID uint32
+		expected []byte
+	}{
+		{
+			name:     "market 1",
+			marketID: 1,
+			expected: []byte{keeper.KeyTypeOrderCreationCount, 0, 0, 0, 1},
+		},
+		{
+			name:     "market 16,843,009",
+			marketID: 16_843_009,
+			expected: []byte{keeper.KeyTypeOrderCreationCount, 1, 1, 1, 1},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetKeyPrefixOrderCreationCounts(tc.marketID)
+				},
+				expected: tc.expected,
+			}
+			checkKey(t, ktc, "GetKeyPrefixOrderCreationCounts(%d)", tc.marketID)
+		})
+	}
+}
+
+func TestGetKeyPrefixOrderCreationCountsForAddr(t *testing.T) {
+	tests := []struct {
+		name     string
+		marketID uint32
+		addr     sdk.AccAddress
+		expected []byte
+		expPanic string
+	}{
+		{
+			name:     "nil address",
+			marketID: 1,
+			addr:     nil,
+			expPanic: "empty address not allowed",
+		},
+		{
+			name:     "market 1, 5 byte address",
+			marketID: 1,
+			addr:     sdk.AccAddress("abcde"),
+			expected: concatBz([]byte{keeper.KeyTypeOrderCreationCount, 0, 0, 0, 1, 5}, []byte("abcde")),
+		},
+		{
+			name:     "market 16,843,009, 20 byte address",
+			marketID: 16_843_009,
+			addr:     sdk.AccAddress("addr_with_20_bytes__"),
+			expected: concatBz([]byte{keeper.KeyTypeOrderCreationCount, 1, 1, 1, 1, 20}, []byte("addr_with_20_bytes__")),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetKeyPrefixOrderCreationCountsForAddr(tc.marketID, tc.addr)
+				},
+				expected: tc.expected,
+				expPanic: tc.expPanic,
+			}
+			if len(tc.expPanic) == 0 {
+				ktc.expPrefixes = []expectedPrefix{
+					{name: "GetKeyPrefixOrderCreationCounts", value: keeper.GetKeyPrefixOrderCreationCounts(tc.marketID)},
+				}
+			}
+			checkKey(t, ktc, "GetKeyPrefixOrderCreationCountsForAddr(%d, %s)", tc.marketID, string(tc.addr))
+		})
+	}
+}
+
+func TestMakeKeyOrderCreationCount(t *testing.T) {
+	tests := []struct {
+		name     string
+		marketID uint32
+		addr     sdk.AccAddress
+		height   int64
+		expected []byte
+		expPanic string
+	}{
+		{
+			name:     "nil address",
+			marketID: 1,
+			addr:     nil,
+			height:   5,
+			expPanic: "empty address not allowed",
+		},
+		{
+			name:     "market 1, 5 byte address, height 1",
+			marketID: 1,
+			addr:     sdk.AccAddress("abcde"),
+			height:   1,
+			expected: concatBz(
+				[]byte{keeper.KeyTypeOrderCreationCount, 0, 0, 0, 1, 5},
+				[]byte("abcde"),
+				[]byte{0, 0, 0, 0, 0, 0, 0, 1},
+			),
+		},
+		{
+			name:     "market 16,843,009, 20 byte address, height 578,437,695,752,307,201",
+			marketID: 16_843_009,
+			addr:     sdk.AccAddress("addr_with_20_bytes__"),
+			height:   578_437_695_752_307_201,
+			expected: concatBz(
+				[]byte{keeper.KeyTypeOrderCreationCount, 1, 1, 1, 1, 20},
+				[]byte("addr_with_20_bytes__"),
+				[]byte{8, 7, 6, 5, 4, 3, 2, 1},
+			),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeKeyOrderCreationCount(tc.marketID, tc.addr, tc.height)
+				},
+				expected: tc.expected,
+				expPanic: tc.expPanic,
+			}
+			if len(tc.expPanic) == 0 {
+				ktc.expPrefixes = []expectedPrefix{
+					{name: "GetKeyPrefixOrderCreationCounts", value: keeper.GetKeyPrefixOrderCreationCounts(tc.marketID)},
+					{
+						name:  "GetKeyPrefixOrderCreationCountsForAddr",
+						value: keeper.GetKeyPrefixOrderCreationCountsForAddr(tc.marketID, tc.addr),
+					},
+				}
+			}
+			checkKey(t, ktc, "MakeKeyOrderCreationCount(%d, %s, %d)", tc.marketID, string(tc.addr), tc.height)
+		})
+	}
+}
+
+func TestGetKeyPrefixOrder(t *testing.T) {
+	ktc := keyTestCase{
+		maker: func() []byte {
+			return keeper.GetKeyPrefixOrder()
+		},
+		expected: []byte{keeper.KeyTypeOrder},
+	}
+	checkKey(t, ktc, "GetKeyPrefixOrder")
+}
+
+func TestMakeKeyOrder(t *testing.T) {
+	tests := []struct {
+		name     string
+		orderID  uint64
+		expected []byte
+	}{
+		{
+			name:     "order id 0",
+			orderID:  0,
+			expected: []byte{keeper.KeyTypeOrder, 0, 0, 0, 0, 0, 0, 0, 0},
+		},
+		{
+			name:     "order id 1",
+			orderID:  1,
+			expected: []byte{keeper.KeyTypeOrder, 0, 0, 0, 0, 0, 0, 0, 1},
+		},
+		{
+			name:     "order id 256",
+			orderID:  256,
+			expected: []byte{keeper.KeyTypeOrder, 0, 0, 0, 0, 0, 0, 1, 0},
+		},
+		{
+			name:     "order id 65,536",
+			orderID:  65_536,
+			expected: []byte{keeper.KeyTypeOrder, 0, 0, 0, 0, 0, 1, 0, 0},
+		},
+		{
+			name:     "order id 16,777,216",
+			orderID:  16_777_216,
+			expected: []byte{keeper.KeyTypeOrder, 0, 0, 0, 0, 1, 0, 0, 0},
+		},
+		{
+			name:     "order id 4,294,967,296",
+			orderID:  4_294_967_296,
+			expected: []byte{keeper.KeyTypeOrder, 0, 0, 0, 1, 0, 0, 0, 0},
+		},
+		{
+			name:     "order id 1,099,511,627,776",
+			orderID:  1_099_511_627_776,
+			expected: []byte{keeper.KeyTypeOrder, 0, 0, 1, 0, 0, 0, 0, 0},
+		},
+		{
+			name:     "order id 281,474,976,710,656",
+			orderID:  281_474_976_710_656,
+			expected: []byte{keeper.KeyTypeOrder, 0, 1, 0, 0, 0, 0, 0, 0},
+		},
+		{
+			name:     "order id 72,057,594,037,927,936",
+			orderID:  72_057_594_037_927_936,
+			expected: []byte{keeper.KeyTypeOrder, 1, 0, 0, 0, 0, 0, 0, 0},
+		},
+		{
+			name:     "order id 72,340,172,838,076,673",
+			orderID:  72_340_172_838_076_673,
+			expected: []byte{keeper.KeyTypeOrder, 1, 1, 1, 1, 1, 1, 1, 1},
+		},
+		{
+			name:     "order id 1,229,782,938,247,303,441",
+			orderID:  1_229_782_938_247_303_441,
+			expected: []byte{keeper.KeyTypeOrder, 17, 17, 17, 17, 17, 17, 17, 17},
+		},
+		{
+			name:     "order id 18,446,744,073,709,551,615",
+			orderID:  18_446_744_073_709_551_615,
+			expected: []byte{keeper.KeyTypeOrder, 255, 255, 255, 255, 255, 255, 255, 255},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeKeyOrder(tc.orderID)
+				},
+				expected:    tc.expected,
+				expPrefixes: []expectedPrefix{{name: "", value: keeper.GetKeyPrefixOrder()}},
+			}
+			checkKey(t, ktc, "MakeKeyOrder(%d)", tc.orderID)
+		})
+	}
+}
+
+func TestParseKeyOrder(t *testing.T) {
+	tests := []struct {
+		name       string
+		key        []byte
+		expOrderID uint64
+		expOK      bool
+	}{
+		{name: "nil key", key: nil, expOrderID: 0, expOK: false},
+		{name: "empty key", key: []byte{}, expOrderID: 0, expOK: false},
+		{name: "7 byte key", key: []byte{1, 2, 3, 4, 5, 6, 7}, expOrderID: 0, expOK: false},
+		{name: "10 byte key", key: []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, expOrderID: 0, expOK: false},
+		{name: "9 byte key unknown type", key: []byte{99, 1, 2, 3, 4, 5, 6, 7, 8}, expOrderID: 0, expOK: false},
+		{
+			name:       "8 byte key",
+			key:        []byte{1, 2, 3, 4, 5, 6, 7, 8},
+			expOrderID: 72_623_859_790_382_856,
+			expOK:      true,
+		},
+		{
+			name:       "9 byte key ask",
+			key:        []byte{keeper.OrderKeyTypeAsk, 1, 2, 3, 4, 5, 6, 7, 8},
+			expOrderID: 72_623_859_790_382_856,
+			expOK:      true,
+		},
+		{
+			name:       "9 byte key bid",
+			key:        []byte{keeper.OrderKeyTypeBid, 1, 2, 3, 4, 5, 6, 7, 8},
+			expOrderID: 72_623_859_790_382_856,
+			expOK:      true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var orderID uint64
+			var ok bool
+			testFunc := func() {
+				orderID, ok = keeper.ParseKeyOrder(tc.key)
+			}
+			require.NotPanics(t, testFunc, "ParseKeyOrder")
+			assert.Equal(t, tc.expOK, ok, "ParseKeyOrder bool ok")
+			assert.Equal(t, tc.expOrderID, orderID, "ParseKeyOrder order ID")
+		})
+	}
+}
+
+func TestParseIndexKeySuffixOrderID(t *testing.T) {
+	tests := []struct {
+		name       string
+		key        []byte
+		expOrderID uint64
+		expOK      bool
+	}{
+		{name: "nil", key: nil, expOrderID: 0, expOK: false},
+		{name: "empty", key: []byte{}, expOrderID: 0, expOK: false},
+		{name: "1 byte", key: []byte{1}, expOrderID: 0, expOK: false},
+		{name: "7 byte", key: []byte{1, 2, 3, 4, 5, 6, 7}, expOrderID: 0, expOK: false},
+		{name: "8 bytes: 0", key: []byte{0, 0, 0, 0, 0, 0, 0, 0}, expOrderID: 0, expOK: true},
+		{name: "8 bytes: 1", key: []byte{0, 0, 0, 0, 0, 0, 0, 1}, expOrderID: 1, expOK: true},
+		{
+			name:       "8 bytes: 4,294,967,296",
+			key:        []byte{0, 0, 0, 1, 0, 0, 0, 0},
+			expOrderID: 4_294_967_296,
+			expOK:      true,
+		},
+		{
+			name:       "8 bytes: 72,623,859,790,382,856",
+			key:        []byte{1, 2, 3, 4, 5, 6, 7, 8},
+			expOrderID: 72_623_859_790_382_856,
+			expOK:      true,
+		},
+		{
+			name:       "8 bytes: max uint64",
+			key:        []byte{255, 255, 255, 255, 255, 255, 255, 255},
+			expOrderID: 18_446_744_073_709_551_615,
+			expOK:      true,
+		},
+		{name: "9 bytes: 0", key: []byte{9, 0, 0, 0, 0, 0, 0, 0, 0}, expOrderID: 0, expOK: true},
+		{name: "9 bytes: 1", key: []byte{9, 0, 0, 0, 0, 0, 0, 0, 1}, expOrderID: 1, expOK: true},
+		{
+			name:       "9 bytes: 4,294,967,296",
+			key:        []byte{9, 0, 0, 0, 1, 0, 0, 0, 0},
+			expOrderID: 4_294_967_296,
+			expOK:      true,
+		},
+		{
+			name:       "9 bytes: 72,623,859,790,382,856",
+			key:        []byte{9, 1, 2, 3, 4, 5, 6, 7, 8},
+			expOrderID: 72_623_859_790_382_856,
+			expOK:      true,
+		},
+		{
+			name:       "9 bytes: max uint64",
+			key:        []byte{9, 255, 255, 255, 255, 255, 255, 255, 255},
+			expOrderID: 18_446_744_073_709_551_615,
+			expOK:      true,
+		},
+		{
+			name:       "20 bytes: 0",
+			key:        []byte{20, 19, 18, 17, 16, 15, 14, 13, 12, 11, 10, 9, 0, 0, 0, 0, 0, 0, 0, 0},
+			expOrderID: 0,
+			expOK:      true,
+		},
+		{
+			name:       "20 bytes: 1",
+			key:        []byte{20, 19, 18, 17, 16, 15, 14, 13, 12, 11, 10, 9, 0, 0, 0, 0, 0, 0, 0, 1},
+			expOrderID: 1,
+			expOK:      true,
+		},
+		{
+			name:       "20 bytes: 4,294,967,296",
+			key:        []byte{20, 19, 18, 17, 16, 15, 14, 13, 12, 11, 10, 9, 0, 0, 0, 1, 0, 0, 0, 0},
+			expOrderID: 4_294_967_296,
+			expOK:      true,
+		},
+		{
+			name:       "20 bytes: 72,623,859,790,382,856",
+			key:        []byte{20, 19, 18, 17, 16, 15, 14, 13, 12, 11, 10, 9, 1, 2, 3, 4, 5, 6, 7, 8},
+			expOrderID: 72_623_859_790_382_856,
+			expOK:      true,
+		},
+		{
+			name:       "20 bytes: max uint64",
+			key:        []byte{20, 19, 18, 17, 16, 15, 14, 13, 12, 11, 10, 9, 255, 255, 255, 255, 255, 255, 255, 255},
+			expOrderID: 18_446_744_073_709_551_615,
+			expOK:      true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var orderID uint64
+			var ok bool
+			testFunc := func() {
+				orderID, ok = keeper.ParseIndexKeySuffixOrderID(tc.key)
+			}
+			require.NotPanics(t, testFunc, "ParseIndexKeySuffixOrderID")
+			assert.Equal(t, tc.expOrderID, orderID, "ParseIndexKeySuffixOrderID orderID")
+			assert.Equal(t, tc.expOK, ok, "ParseIndexKeySuffixOrderID ok bool")
+		})
+	}
+}
+
+func TestGetIndexKeyPrefixMarketToOrder(t *testing.T) {
+	tests := []struct {
+		name     string
+		marketID uint32
+		expected []byte
+	}{
+		{
+			name:     "market id 0",
+			marketID: 0,
+			expected: []byte{keeper.KeyTypeMarketToOrderIndex, 0, 0, 0, 0},
+		},
+		{
+			name:     "market id 1",
+			marketID: 1,
+			expected: []byte{keeper.KeyTypeMarketToOrderIndex, 0, 0, 0, 1},
+		},
+		{
+			name:     "market id 255",
+			marketID: 255,
+			expected: []byte{keeper.KeyTypeMarketToOrderIndex, 0, 0, 0, 255},
+		},
+		{
+			name:     "market id 256",
+			marketID: 256,
+			expected: []byte{keeper.KeyTypeMarketToOrderIndex, 0, 0, 1, 0},
+		},
+		{
+			name:     "market id 65_536",
+			marketID: 65_536,
+			expected: []byte{keeper.KeyTypeMarketToOrderIndex, 0, 1, 0, 0},
+		},
+		{
+			name:     "market id 16,777,216",
+			marketID: 16_777_216,
+			expected: []byte{keeper.KeyTypeMarketToOrderIndex, 1, 0, 0, 0},
+		},
+		{
+			name:     "market id 16,843,009",
+			marketID: 16_843_009,
+			expected: []byte{keeper.KeyTypeMarketToOrderIndex, 1, 1, 1, 1},
+		},
+		{
+			name:     "market id 4,294,967,295",
+			marketID: 4_294_967_295,
+			expected: []byte{keeper.KeyTypeMarketToOrderIndex, 255, 255, 255, 255},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetIndexKeyPrefixMarketToOrder(tc.marketID)
+				},
+				expected: tc.expected,
+			}
+			checkKey(t, ktc, "GetIndexKeyPrefixMarketToOrder(%d)", tc.marketID)
+		})
+	}
+}
+
+func TestMakeIndexKeyMarketToOrder(t *testing.T) {
+	tests := []struct {
+		name     string
+		marketID uint32
+		orderID  uint64
+		expected []byte
+	}{
+		{
+			name:     "market 0 order 0",
+			marketID: 0,
+			orderID:  0,
+			expected: []byte{keeper.KeyTypeMarketToOrderIndex, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+		},
+		{
+			name:     "market 0 order 1",
+			marketID: 0,
+			orderID:  1,
+			expected: []byte{keeper.KeyTypeMarketToOrderIndex, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1},
+		},
+		{
+			name:     "market 0 order 72,340,172,838,076,673",
+			marketID: 0,
+			orderID:  72_340_172_838_076_673,
+			expected: []byte{keeper.KeyTypeMarketToOrderIndex, 0, 0, 0, 0, 1, 1, 1, 1, 1, 1, 1, 1},
+		},
+		{
+			name:     "market 2 order 0",
+			marketID: 2,
+			orderID:  0,
+			expected: []byte{keeper.KeyTypeMarketToOrderIndex, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 0},
+		},
+		{
+			name:     "market 2 order 1",
+			marketID: 2,
+			orderID:  1,
+			expected: []byte{keeper.KeyTypeMarketToOrderIndex, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 1},
+		},
+		{
+			name:     "market 2 order 72,340,172,838,076,673",
+			marketID: 2,
+			orderID:  72_340_172_838_076_673,
+			expected: []byte{keeper.KeyTypeMarketToOrderIndex, 0, 0, 0, 2, 1, 1, 1, 1, 1, 1, 1, 1},
+		},
+		{
+			name:     "market 33,686,018 order 0",
+			marketID: 33_686_018,
+			orderID:  0,
+			expected: []byte{keeper.KeyTypeMarketToOrderIndex, 2, 2, 2, 2, 0, 0, 0, 0, 0, 0, 0, 0},
+		},
+		{
+			name:     "market 33,686,018 order 1",
+			marketID: 33_686_018,
+			orderID:  1,
+			expected: []byte{keeper.KeyTypeMarketToOrderIndex, 2, 2, 2, 2, 0, 0, 0, 0, 0, 0, 0, 1},
+		},
+		{
+			name:     "market 33,686,018 order 72,340,172,838,076,673",
+			marketID: 33_686_018,
+			orderID:  72_340_172_838_076_673,
+			expected: []byte{keeper.KeyTypeMarketToOrderIndex, 2, 2, 2, 2, 1, 1, 1, 1, 1, 1, 1, 1},
+		},
+
+		{
+			name:     "market max order max",
+			marketID: 4_294_967_295,
+			orderID:  18_446_744_073_709_551_615,
+			expected: []byte{keeper.KeyTypeMarketToOrderIndex, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeIndexKeyMarketToOrder(tc.marketID, tc.orderID)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "", value: keeper.GetIndexKeyPrefixMarketToOrder(tc.marketID)},
+				},
+			}
+			checkKey(t, ktc, "MakeIndexKeyMarketToOrder(%d, %d)", tc.marketID, tc.orderID)
+		})
+	}
+}
+
+func TestParseIndexKeyMarketToOrder(t *testing.T) {
+	tests := []struct {
+		name        string
+		key         []byte
+		expMarketID uint32
+		expOrderID  uint64
+		expErr      string
+	}{
+		{
+			name:   "nil key",
+			key:    nil,
+			expErr: "cannot parse market to order key: length 0, expected 8, 12, or 13",
+		},
+		{
+			name:   "empty key",
+			key:    []byte{},
+			expErr: "cannot parse market to order key: length 0, expected 8, 12, or 13",
+		},
+		{
+			name:   "7 bytes",
+			key:    []byte{1, 2, 3, 4, 5, 6, 7},
+			expErr: "cannot parse market to order key: length 7, expected 8, 12, or 13",
+		},
+		{
+			name:   "9 bytes",
+			key:    []byte{1, 2, 3, 4, 5, 6, 7, 8, 9},
+			expErr: "cannot parse market to order key: length 9, expected 8, 12, or 13",
+		},
+		{
+			name:   "10 bytes",
+			key:    []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+			expErr: "cannot parse market to order key: length 10, expected 8, 12, or 13",
+		},
+		{
+			name:   "11 bytes",
+			key:    []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11},
+			expErr: "cannot parse market to order key: length 11, expected 8, 12, or 13",
+		},
+		{
+			name:   "14 bytes",
+			key:    []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14},
+			expErr: "cannot parse market to order key: length 14, expected 8, 12, or 13",
+		},
+		{
+			name:       "8 bytes order id 0",
+			key:        []byte{0, 0, 0, 0, 0, 0, 0, 0},
+			expOrderID: 0,
+		},
+		{
+			name:        "8 bytes order id 1",
+			key:         []byte{0, 0, 0, 0, 0, 0, 0, 1},
+			expMarketID: 0,
+			expOrderID:  1,
+		},
+		{
+			name:       "8 bytes order id 72,623,859,790,382,856",
+			key:        []byte{1, 2, 3, 4, 5, 6, 7, 8},
+			expOrderID: 72_623_859_790_382_856,
+		},
+		{
+			name:        "12 bytes market id 1 order id 1",
+			key:         []byte{0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 1},
+			expMarketID: 1,
+			expOrderID:  1,
+		},
+		{
+			name:        "12 bytes market id 16,843,009 order id 144,680,345,676,153,346",
+			key:         []byte{1, 1, 1, 1, 2, 2, 2, 2, 2, 2, 2, 2},
+			expMarketID: 16_843_009,
+			expOrderID:  144_680_345_676_153_346,
+		},
+		{
+			name:        "13 bytes market id 1 order id 1",
+			key:         []byte{keeper.KeyTypeMarketToOrderIndex, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 1},
+			expMarketID: 1,
+			expOrderID:  1,
+		},
+		{
+			name:        "13 bytes market id 16,843,009 order id 144,680,345,676,153,346",
+			key:         []byte{keeper.KeyTypeMarketToOrderIndex, 1, 1, 1, 1, 2, 2, 2, 2, 2, 2, 2, 2},
+			expMarketID: 16_843_009,
+			expOrderID:  144_680_345_676_153_346,
+		},
+		{
+			name:   "13 bytes first byte too high",
+			key:    []byte{0x4, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 1},
+			expErr: "cannot parse market to order key: unknown type byte 0x4, expected 0x3",
+		},
+		{
+			name:   "13 bytes first byte too low",
+			key:    []byte{0x2, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 1},
+			expErr: "cannot parse market to order key: unknown type byte 0x2, expected 0x3",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var marketID uint32
+			var orderID uint64
+			var err error
+			testFunc := func() {
+				marketID, orderID, err = keeper.ParseIndexKeyMarketToOrder(tc.key)
+			}
+			require.NotPanics(t, testFunc, "ParseIndexKeyMarketToOrder(%v)", tc.key)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseIndexKeyMarketToOrder(%v) error", tc.key)
+			assert.Equal(t, tc.expMarketID, marketID, "ParseIndexKeyMarketToOrder(%v) market id", tc.key)
+			assert.Equal(t, tc.expOrderID, orderID, "ParseIndexKeyMarketToOrder(%v) order id", tc.key)
+		})
+	}
+}
+
+func TestGetIndexKeyPrefixAddressToOrder(t *testing.T) {
+	tests := []struct {
+		name     string
+		addr     sdk.AccAddress
+		expected []byte
+		expPanic string
+	}{
+		{
+			name:     "nil addr",
+			addr:     nil,
+			expPanic: "empty address not allowed",
+		},
+		{
+			name:     "empty addr",
+			addr:     sdk.AccAddress{},
+			expPanic: "empty address not allowed",
+		},
+		{
+			name:     "256 byte addr",
+			addr:     sdk.AccAddress(bytes.Repeat([]byte{'P'}, 256)),
+			expPanic: "address length should be max 255 bytes, got 256: unknown address",
+		},
+		{
+			name:     "5 byte addr",
+			addr:     sdk.AccAddress("abcde"),
+			expected: append([]byte{keeper.KeyTypeAddressToOrderIndex, 5}, "abcde"...),
+		},
+		{
+			name:     "20 byte addr",
+			addr:     sdk.AccAddress("abcdefghijklmnopqrst"),
+			expected: append([]byte{keeper.KeyTypeAddressToOrderIndex, 20}, "abcdefghijklmnopqrst"...),
+		},
+		{
+			name:     "32 byte addr",
+			addr:     sdk.AccAddress("abcdefghijklmnopqrstuvwxyzABCDEF"),
+			expected: append([]byte{keeper.KeyTypeAddressToOrderIndex, 32}, "abcdefghijklmnopqrstuvwxyzABCDEF"...),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetIndexKeyPrefixAddressToOrder(tc.addr)
+				},
+				expected: tc.expected,
+				expPanic: tc.expPanic,
+			}
+			checkKey(t, ktc, "GetIndexKeyPrefixAddressToOrder(%s)", string(tc.addr))
+		})
+	}
+}
+
+func TestMakeIndexKeyAddressToOrder(t *testing.T) {
+	tests := []struct {
+		name     string
+		addr     sdk.AccAddress
+		orderID  uint64
+		expected []byte
+		expPanic string
+	}{
+		{
+			name:     "nil addr",
+			addr:     nil,
+			expPanic: "empty address not allowed",
+		},
+		{
+			name:     "empty addr",
+			addr:     sdk.AccAddress{},
+			expPanic: "empty address not allowed",
+		},
+		{
+			name:     "256 byte addr",
+			addr:     sdk.AccAddress(bytes.Repeat([]byte{'P'}, 256)),
+			expPanic: "address length should be max 255 bytes, got 256: unknown address",
+		},
+		{
+			name:    "5 byte addr order 1",
+			addr:    sdk.AccAddress("abcde"),
+			orderID: 1,
+			expected: concatBz(
+				[]byte{keeper.KeyTypeAddressToOrderIndex, 5},
+				[]byte("abcde"),
+				[]byte{0, 0, 0, 0, 0, 0, 0, 1},
+			),
+		},
+		{
+			name:    "20 byte addr order 1",
+			addr:    sdk.AccAddress("abcdefghijklmnopqrst"),
+			orderID: 1,
+			expected: concatBz(
+				[]byte{keeper.KeyTypeAddressToOrderIndex, 20},
+				[]byte("abcdefghijklmnopqrst"),
+				[]byte{0, 0, 0, 0, 0, 0, 0, 1},
+			),
+		},
+		{
+			name:    "32 byte addr order 1",
+			addr:    sdk.AccAddress("abcdefghijklmnopqrstuvwxyzABCDEF"),
+			orderID: 1,
+			expected: concatBz(
+				[]byte{keeper.KeyTypeAddressToOrderIndex, 32},
+				[]byte("abcdefghijklmnopqrstuvwxyzABCDEF"),
+				[]byte{0, 0, 0, 0, 0, 0, 0, 1},
+			),
+		},
+		{
+			name:    "20 byte addr order 5",
+			addr:    sdk.AccAddress("ABCDEFGHIJKLMNOPQRST"),
+			orderID: 5,
+			expected: concatBz(
+				[]byte{keeper.KeyTypeAddressToOrderIndex, 20},
+				[]byte("ABCDEFGHIJKLMNOPQRST"),
+				[]byte{0, 0, 0, 0, 0, 0, 0, 5},
+			),
+		},
+		{
+			name:    "20 byte addr order 72,623,859,790,382,856",
+			addr:    sdk.AccAddress("ABCDEFGHIJKLMNOPQRST"),
+			orderID: 72_623_859_790_382_856,
+			expected: concatBz(
+				[]byte{keeper.KeyTypeAddressToOrderIndex, 20},
+				[]byte("ABCDEFGHIJKLMNOPQRST"),
+				[]byte{1, 2, 3, 4, 5, 6, 7, 8},
+			),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeIndexKeyAddressToOrder(tc.addr, tc.orderID)
+				},
+				expected: tc.expected,
+				expPanic: tc.expPanic,
+			}
+			if len(tc.expPanic) == 0 {
+				ktc.expPrefixes = []expectedPrefix{
+					{name: "", value: keeper.GetIndexKeyPrefixAddressToOrder(tc.addr)},
+				}
+			}
+			checkKey(t, ktc, "MakeIndexKeyAddressToOrder(%s, %d)", string(tc.addr), tc.orderID)
+		})
+	}
+}
+
+func TestParseIndexKeyAddressToOrder(t *testing.T) {
+	tests := []struct {
+		name       string
+		key        []byte
+		expAddr    sdk.AccAddress
+		expOrderID uint64
+		expErr     string
+	}{
+		{
+			name:   "nil key",
+			key:    nil,
+			expErr: "cannot parse address to order index key: only has 0 bytes, expected at least 8",
+		},
+		{
+			name:   "empty key",
+			key:    []byte{},
+			expErr: "cannot parse address to order index key: only has 0 bytes, expected at least 8",
+		},
+		{
+			name:   "7 bytes",
+			key:    []byte{1, 2, 3, 4, 5, 6, 7},
+			expErr: "cannot parse address to order index key: only has 7 bytes, expected at least 8",
+		},
+		{
+			name:       "just order id 1",
+			key:        []byte{0, 0, 0, 0, 0, 0, 0, 1},
+			expOrderID: 1,
+		},
+		{
+			name:       "just order id 72,623,859,790,382,856",
+			key:        []byte{1, 2, 3, 4, 5, 6, 7, 8},
+			expOrderID: 72_623_859_790_382_856,
+		},
+		{
+			name:   "9 bytes",
+			key:    []byte{1, 2, 3, 4, 5, 6, 7, 8, 9},
+			expErr: "cannot parse address to order index key: unable to determine address from single byte 0x1",
+		},
+		{
+			name:       "1 byte address order id 72,623,859,790,382,856",
+			key:        []byte{1, 55, 1, 2, 3, 4, 5, 6, 7, 8},
+			expAddr:    sdk.AccAddress{55},
+			expOrderID: 72_623_859_790_382_856,
+		},
+		{
+			name:   "length byte 2 but only 1 byte after it",
+			key:    []byte{2, 55, 1, 2, 3, 4, 5, 6, 7, 8},
+			expErr: "cannot parse address to order index key: unable to determine address from [2, 55, ...(length 2)]",
+		},
+		{
+			name:   "length byte 2 but 3 bytes after it",
+			key:    []byte{2, 55, 56, 57, 1, 2, 3, 4, 5, 6, 7, 8},
+			expErr: "cannot parse address to order index key: unable to determine address from [2, 55, ...(length 4)]",
+		},
+		{
+			name:       "length byte 4 order id 72,623,859,790,382,856",
+			key:        []byte{4, 55, 56, 57, 58, 1, 2, 3, 4, 5, 6, 7, 8},
+			expAddr:    sdk.AccAddress{55, 56, 57, 58},
+			expOrderID: 72_623_859_790_382_856,
+		},
+		{
+			name: "length byte 20 but only 19 byte after it",
+			key: []byte{20, 101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
+				111, 112, 113, 114, 115, 116, 117, 118, 119,
+				1, 2, 3, 4, 5, 6, 7, 8},
+			expErr: "cannot parse address to order index key: unable to determine address from [20, 101, ...(length 20)]",
+		},
+		{
+			name: "length byte 20 but 21 byte after it",
+			key: []byte{20, 101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
+				111, 112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
+				1, 2, 3, 4, 5, 6, 7, 8},
+			expErr: "cannot parse address to order index key: unable to determine address from [20, 101, ...(length 22)]",
+		},
+		{
+			name: "20 byte address order id 72,623,859,790,382,856",
+			key: []byte{20, 101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
+				111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
+				1, 2, 3, 4, 5, 6, 7, 8},
+			expAddr: sdk.AccAddress{101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
+				111, 112, 113, 114, 115, 116, 117, 118, 119, 120},
+			expOrderID: 72_623_859_790_382_856,
+		},
+		{
+			name:       "with type byte: 1 byte address order id 72,623,859,790,382,856",
+			key:        []byte{keeper.KeyTypeAddressToOrderIndex, 1, 55, 1, 2, 3, 4, 5, 6, 7, 8},
+			expAddr:    sdk.AccAddress{55},
+			expOrderID: 72_623_859_790_382_856,
+		},
+		{
+			name:   "with type byte: length byte 2 but only 1 byte after it",
+			key:    []byte{keeper.KeyTypeAddressToOrderIndex, 2, 55, 1, 2, 3, 4, 5, 6, 7, 8},
+			expErr: "cannot parse address to order index key: unable to determine address from [4, 2, ...(length 3)]",
+		},
+		{
+			name:   "with type byte: length byte 2 but 5 bytes after it",
+			key:    []byte{keeper.KeyTypeAddressToOrderIndex, 2, 55, 56, 57, 58, 1, 2, 3, 4, 5, 6, 7, 8},
+			expErr: "cannot parse address to order index key: unable to determine address from [4, 2, ...(length 6)]",
+		},
+		{
+			name:       "with type byte: length byte 4 order id 72,623,859,790,382,856",
+			key:        []byte{keeper.KeyTypeAddressToOrderIndex, 4, 55, 56, 57, 58, 1, 2, 3, 4, 5, 6, 7, 8},
+			expAddr:    sdk.AccAddress{55, 56, 57, 58},
+			expOrderID: 72_623_859_790_382_856,
+		},
+		{
+			name: "with type byte: length byte 20 but only 19 byte after it",
+			key: []byte{keeper.KeyTypeAddressToOrderIndex, 20,
+				101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
+				111, 112, 113, 114, 115, 116, 117, 118, 119,
+				1, 2, 3, 4, 5, 6, 7, 8},
+			expErr: "cannot parse address to order index key: unable to determine address from [4, 20, ...(length 21)]",
+		},
+		{
+			name: "with type byte: length byte 20 but 21 byte after it",
+			key: []byte{keeper.KeyTypeAddressToOrderIndex, 20,
+				101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
+				111, 112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
+				1, 2, 3, 4, 5, 6, 7, 8},
+			expErr: "cannot parse address to order index key: unable to determine address from [4, 20, ...(length 23)]",
+		},
+		{
+			name: "with type byte: 20 byte address order id 72,623,859,790,382,856",
+			key: []byte{keeper.KeyTypeAddressToOrderIndex, 20,
+				101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
+				111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
+				1, 2, 3, 4, 5, 6, 7, 8},
+			expAddr: sdk.AccAddress{101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
+				111, 112, 113, 114, 115, 116, 117, 118, 119, 120},
+			expOrderID: 72_623_859_790_382_856,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var addr sdk.AccAddress
+			var orderID uint64
+			var err error
+			testFunc := func() {
+				addr, orderID, err = keeper.ParseIndexKeyAddressToOrder(tc.key)
+			}
+			require.NotPanics(t, testFunc, "ParseIndexKeyAddressToOrder(%v)", tc.key)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseIndexKeyAddressToOrder(%v) error", tc.key)
+			assert.Equal(t, tc.expAddr, addr, "ParseIndexKeyAddressToOrder(%v) address", tc.key)
+			assert.Equal(t, tc.expOrderID, orderID, "ParseIndexKeyAddressToOrder(%v) order id", tc.key)
+		})
+	}
+}
+
+func TestGetIndexKeyPrefixAssetToOrder(t *testing.T) {
+	tests := []struct {
+		name       string
+		assetDenom string
+		expected   []byte
+	}{
+		{
+			name:       "empty",
+			assetDenom: "",
+			expected:   []byte{keeper.KeyTypeAssetToOrderIndex},
+		},
+		{
+			name:       "1 char denom",
+			assetDenom: "p",
+			expected:   []byte{keeper.KeyTypeAssetToOrderIndex, 'p'},
+		},
+		{
+			name:       "nhash",
+			assetDenom: "nhash",
+			expected:   []byte{keeper.KeyTypeAssetToOrderIndex, 'n', 'h', 'a', 's', 'h'},
+		},
+		{
+			name:       "hex string",
+			assetDenom: hexString,
+			expected:   append([]byte{keeper.KeyTypeAssetToOrderIndex}, hexString...),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetIndexKeyPrefixAssetToOrder(tc.assetDenom)
+				},
+				expected: tc.expected,
+			}
+			checkKey(t, ktc, "GetIndexKeyPrefixAssetToOrder(%q)", tc.assetDenom)
+		})
+	}
+}
+
+func TestMakeIndexKeyAssetToOrder(t *testing.T) {
+	tests := []struct {
+		name       string
+		assetDenom string
+		orderID    uint64
+		expected   []byte
+		expPanic   string
+	}{
+		{
+			name:       "no asset order 0",
+			assetDenom: "",
+			orderID:    0,
+			expected:   []byte{keeper.KeyTypeAssetToOrderIndex, 0, 0, 0, 0, 0, 0, 0, 0},
+		},
+		{
+			name:       "nhash order 1",
+			assetDenom: "nhash",
+			orderID:    1,
+			expected:   []byte{keeper.KeyTypeAssetToOrderIndex, 'n', 'h', 'a', 's', 'h', 0, 0, 0, 0, 0, 0, 0, 1},
+		},
+		{
+			name:       "hex string order 5",
+			assetDenom: hexString,
+			orderID:    5,
+			expected: concatBz(
+				[]byte{keeper.KeyTypeAssetToOrderIndex},
+				[]byte(hexString),
+				[]byte{0, 0, 0, 0, 0, 0, 0, 5},
+			),
+		},
+		{
+			name:       "nhash order 4,294,967,296",
+			assetDenom: "nhash",
+			orderID:    4_294_967_296,
+			expected: []byte{
+				keeper.KeyTypeAssetToOrderIndex,
+				'n', 'h', 'a', 's', 'h',
+				0, 0, 0, 1, 0, 0, 0, 0,
+			},
+		},
+		{
+			name:       "nhash order max",
+			assetDenom: "nhash",
+			orderID:    18_446_744_073_709_551_615,
+			expected: []byte{
+				keeper.KeyTypeAssetToOrderIndex,
+				'n', 'h', 'a', 's', 'h',
+				255, 255, 255, 255, 255, 255, 255, 255,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeIndexKeyAssetToOrder(tc.assetDenom, tc.orderID)
+				},
+				expected: tc.expected,
+				expPanic: tc.expPanic,
+			}
+			if len(tc.expPanic) == 0 {
+				ktc.expPrefixes = []expectedPrefix{
+					{name: "GetIndexKeyPrefixAssetToOrder", value: keeper.GetIndexKeyPrefixAssetToOrder(tc.assetDenom)},
+				}
+			}
+
+			checkKey(t, ktc, "MakeIndexKeyAssetToOrder(%q, %d)", tc.assetDenom, tc.orderID)
+		})
+	}
+}
+
+func TestParseIndexKeyAssetToOrder(t *testing.T) {
+	tests := []struct {
+		name       string
+		key        []byte
+		expDenom   string
+		expOrderID uint64
+		expErr     string
+	}{
+		{
+			name:   "nil key",
+			key:    nil,
+			expErr: "cannot parse asset to order key: only has 0 bytes, expected at least 8",
+		},
+		{
+			name:   "empty key",
+			key:    []byte{},
+			expErr: "cannot parse asset to order key: only has 0 bytes, expected at least 8",
+		},
+		{
+			name:   "7 bytes",
+			key:    []byte{1, 2, 3, 4, 5, 6, 7},
+			expErr: "cannot parse asset to order key: only has 7 bytes, expected at least 8",
+		},
+		{
+			name:       "order id 1",
+			key:        []byte{0, 0, 0, 0, 0, 0, 0, 1},
+			expOrderID: 1,
+		},
+		{
+			name:       "order id 578,437,695,752,307,201",
+			key:        []byte{8, 7, 6, 5, 4, 3, 2, 1},
+			expOrderID: 578_437_695_752_307_201,
+		},
+		{
+			name:       "nhash order id 578,437,695,752,307,201",
+			key:        []byte{'n', 'h', 'a', 's', 'h', 8, 7, 6, 5, 4, 3, 2, 1},
+			expDenom:   "nhash",
+			expOrderID: 578_437_695_752_307_201,
+		},
+		{
+			name:       "hex string order id 578,437,695,752,307,201",
+			key:        append([]byte(hexString), 8, 7, 6, 5, 4, 3, 2, 1),
+			expDenom:   hexString,
+			expOrderID: 578_437_695_752_307_201,
+		},
+		{
+			name:       "with type byte nhash order id 578,437,695,752,307,201",
+			key:        []byte{keeper.KeyTypeAssetToOrderIndex, 'n', 'h', 'a', 's', 'h', 8, 7, 6, 5, 4, 3, 2, 1},
+			expDenom:   "nhash",
+			expOrderID: 578_437_695_752_307_201,
+		},
+		{
+			name: "with type byte hex string order id 578,437,695,752,307,201",
+			key: concatBz(
+				[]byte{keeper.KeyTypeAssetToOrderIndex},
+				[]byte(hexString),
+				[]byte{8, 7, 6, 5, 4, 3, 2, 1},
+			),
+			expDenom:   hexString,
+			expOrderID: 578_437_695_752_307_201,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var denom string
+			var orderiD uint64
+			var err error
+			testFunc := func() {
+				denom, orderiD, err = keeper.ParseIndexKeyAssetToOrder(tc.key)
+			}
+			require.NotPanics(t, testFunc, "ParseIndexKeyAssetToOrder(%v)", tc.key)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseIndexKeyAssetToOrder(%v) error", tc.key)
+			assert.Equal(t, tc.expDenom, denom, "ParseIndexKeyAssetToOrder(%v) denom", tc.key)
+			assert.Equal(t, tc.expOrderID, orderiD, "ParseIndexKeyAssetToOrder(%v) order id", tc.key)
+		})
+	}
+}
+
+func TestGetIndexKeyPrefixMarketExternalIDToOrder(t *testing.T) {
+	tests := []struct {
+		name             string
+		marketID         uint32
+		externalIDPrefix string
+		expected         []byte
+	}{
+		{
+			name:             "market 0, empty prefix",
+			marketID:         0,
+			externalIDPrefix: "",
+			expected:         []byte{keeper.KeyTypeMarketExternalIDToOrderIndex, 0, 0, 0, 0},
+		},
+		{
+			name:             "market 1, empty prefix",
+			marketID:         1,
+			externalIDPrefix: "",
+			expected:         []byte{keeper.KeyTypeMarketExternalIDToOrderIndex, 0, 0, 0, 1},
+		},
+		{
+			name:             "market 16,843,009, one char prefix",
+			marketID:         16_843_009,
+			externalIDPrefix: "a",
+			expected:         []byte{keeper.KeyTypeMarketExternalIDToOrderIndex, 1, 1, 1, 1, 'a'},
+		},
+		{
+			name:             "market 4,294,967,295, longer prefix",
+			marketID:         4_294_967_295,
+			externalIDPrefix: "acme-2024-",
+			expected: append([]byte{keeper.KeyTypeMarketExternalIDToOrderIndex, 255, 255, 255, 255},
+				"acme-2024-"...),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetIndexKeyPrefixMarketExternalIDToOrder(tc.marketID, tc.externalIDPrefix)
+				},
+				expected: tc.expected,
+			}
+			checkKey(t, ktc, "GetIndexKeyPrefixMarketExternalIDToOrder(%d, %q)", tc.marketID, tc.externalIDPrefix)
+		})
+	}
+}
+
+func TestMakeIndexKeyMarketExternalIDToOrder(t *testing.T) {
+	tests := []struct {
+		name       string
+		marketID   uint32
+		externalID string
+		expected   []byte
+		expPanic   string
+	}{
+		{
+			name:       "empty external id",
+			marketID:   1,
+			externalID: "",
+			expPanic:   "cannot create market external id to order index with empty external id",
+		},
+		{
+			name:       "external id too long",
+			marketID:   2,
+			externalID: strings.Repeat("H", exchange.MaxExternalIDLength+1),
+			expPanic: fmt.Sprintf("cannot create market external id to order index: invalid external id %q (length %d): max length %d",
+				"HHHHH...HHHHH", exchange.MaxExternalIDLength+1, exchange.MaxExternalIDLength),
+		},
+		{
+			name:       "market 0, a zeroed uuid",
+			marketID:   0,
+			externalID: "00000000-0000-0000-0000-000000000000",
+			expected: append([]byte{keeper.KeyTypeMarketExternalIDToOrderIndex, 0, 0, 0, 0},
+				"00000000-0000-0000-0000-000000000000"...),
+		},
+		{
+			name:       "market 1, random uuid",
+			marketID:   1,
+			externalID: "814348F5-DE62-4954-81D1-65874E37C0BE",
+			expected: append([]byte{keeper.KeyTypeMarketExternalIDToOrderIndex, 0, 0, 0, 1},
+				"814348F5-DE62-4954-81D1-65874E37C0BE"...),
+		},
+		{
+			name:       "market 67,305,985, a wierd external id",
+			marketID:   67_305_985,
+			externalID: "ThisIsWierd",
+			expected:   append([]byte{keeper.KeyTypeMarketExternalIDToOrderIndex, 4, 3, 2, 1}, "ThisIsWierd"...),
+		},
+		{
+			name:       "max market id and lots of Zs",
+			marketID:   4_294_967_295,
+			externalID: strings.Repeat("Z", exchange.MaxExternalIDLength),
+			expected: append([]byte{keeper.KeyTypeMarketExternalIDToOrderIndex, 255, 255, 255, 255},
+				strings.Repeat("Z", exchange.MaxExternalIDLength)...),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeIndexKeyMarketExternalIDToOrder(tc.marketID, tc.externalID)
+				},
+				expected: tc.expected,
+				expPanic: tc.expPanic,
+			}
+
+			checkKey(t, ktc, "MakeIndexKeyMarketExternalIDToOrder(%d, %v)", tc.marketID, tc.externalID)
+		})
+	}
+}
+
+func TestGetIndexKeyPrefixAddressExternalIDToOrder(t *testing.T) {
+	tests := []struct {
+		name       string
+		addr       sdk.AccAddress
+		externalID string
+		expected   []byte
+		expPanic   string
+	}{
+		{
+			name:       "nil address",
+			addr:       nil,
+			externalID: "abc",
+			expPanic:   "empty address not allowed",
+		},
+		{
+			name:       "empty external id",
+			addr:       sdk.AccAddress("addr"),
+			externalID: "",
+			expPanic:   "cannot create address external id to order index with empty external id",
+		},
+		{
+			name:       "external id too long",
+			addr:       sdk.AccAddress("addr"),
+			externalID: strings.Repeat("H", exchange.MaxExternalIDLength+1),
+			expPanic: fmt.Sprintf("cannot create address external id to order index: invalid external id %q (length %d): max length %d",
+				"HHHHH...HHHHH", exchange.MaxExternalIDLength+1, exchange.MaxExternalIDLength),
+		},
+		{
+			name:       "4 byte address, 3 char external id",
+			addr:       sdk.AccAddress("addr"),
+			externalID: "abc",
+			expected:   []byte{keeper.KeyTypeAddressExternalIDToOrderIndex, 4, 'a', 'd', 'd', 'r', 3, 'a', 'b', 'c'},
+		},
+		{
+			name:       "20 byte address, max length external id",
+			addr:       sdk.AccAddress("20_byte_address_____"),
+			externalID: strings.Repeat("Z", exchange.MaxExternalIDLength),
+			expected: append(append([]byte{keeper.KeyTypeAddressExternalIDToOrderIndex, 20}, "20_byte_address_____"...),
+				append([]byte{byte(exchange.MaxExternalIDLength)}, strings.Repeat("Z", exchange.MaxExternalIDLength)...)...),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetIndexKeyPrefixAddressExternalIDToOrder(tc.addr, tc.externalID)
+				},
+				expected: tc.expected,
+				expPanic: tc.expPanic,
+			}
+			checkKey(t, ktc, "GetIndexKeyPrefixAddressExternalIDToOrder(%s, %q)", tc.addr, tc.externalID)
+		})
+	}
+}
+
+func TestMakeIndexKeyAddressExternalIDToOrder(t *testing.T) {
+	tests := []struct {
+		name       string
+		addr       sdk.AccAddress
+		externalID string
+		orderID    uint64
+		expected   []byte
+		expPanic   string
+	}{
+		{
+			name:       "empty address",
+			addr:       sdk.AccAddress{},
+			externalID: "abc",
+			orderID:    1,
+			expPanic:   "empty address not allowed",
+		},
+		{
+			name:       "empty external id",
+			addr:       sdk.AccAddress("addr"),
+			externalID: "",
+			orderID:    1,
+			expPanic:   "cannot create address external id to order index with empty external id",
+		},
+		{
+			name:       "order 1",
+			addr:       sdk.AccAddress("addr"),
+			externalID: "abc",
+			orderID:    1,
+			expected: []byte{
+				keeper.KeyTypeAddressExternalIDToOrderIndex, 4, 'a', 'd', 'd', 'r', 3, 'a', 'b', 'c',
+				0, 0, 0, 0, 0, 0, 0, 1,
+			},
+		},
+		{
+			name:       "order 578,437,695,752,307,201",
+			addr:       sdk.AccAddress("a"),
+			externalID: "x-y",
+			orderID:    578_437_695_752_307_201,
+			expected: []byte{
+				keeper.KeyTypeAddressExternalIDToOrderIndex, 1, 'a', 3, 'x', '-', 'y',
+				8, 7, 6, 5, 4, 3, 2, 1,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeIndexKeyAddressExternalIDToOrder(tc.addr, tc.externalID, tc.orderID)
+				},
+				expected: tc.expected,
+				expPanic: tc.expPanic,
+			}
+			if len(tc.expPanic) == 0 {
+				ktc.expPrefixes = []expectedPrefix{
+					{
+						name:  "GetIndexKeyPrefixAddressExternalIDToOrder",
+						value: keeper.GetIndexKeyPrefixAddressExternalIDToOrder(tc.addr, tc.externalID),
+					},
+				}
+			}
+			checkKey(t, ktc, "MakeIndexKeyAddressExternalIDToOrder(%s, %q, %d)", tc.addr, tc.externalID, tc.orderID)
+		})
+	}
+}
+
+func TestParseIndexKeyAddressExternalIDToOrder(t *testing.T) {
+	tests := []struct {
+		name          string
+		key           []byte
+		expAddr       sdk.AccAddress
+		expExternalID string
+		expOrderID    uint64
+		expErr        string
+	}{
+		{
+			name:   "nil key",
+			key:    nil,
+			expErr: "cannot parse address external id to order key: only has 0 bytes, expected at least 13",
+		},
+		{
+			name:   "12 bytes",
+			key:    []byte{keeper.KeyTypeAddressExternalIDToOrderIndex, 1, 'a', 1, 'b', 0, 0, 0, 0, 0, 0, 1},
+			expErr: "cannot parse address external id to order key: only has 12 bytes, expected at least 13",
+		},
+		{
+			name:   "wrong type byte",
+			key:    []byte{keeper.KeyTypeAddressToOrderIndex, 1, 'a', 1, 'b', 0, 0, 0, 0, 0, 0, 0, 1},
+			expErr: "cannot parse address external id to order key: unknown type byte 0x4, expected 0x22",
+		},
+		{
+			name:   "address length too long",
+			key:    []byte{keeper.KeyTypeAddressExternalIDToOrderIndex, 20, 'a', 1, 'b', 0, 0, 0, 0, 0, 0, 0, 1},
+			expErr: "cannot parse address external id to order key: length byte is 20, but slice only has 11 left",
+		},
+		{
+			name:   "not enough after the address",
+			key:    []byte{keeper.KeyTypeAddressExternalIDToOrderIndex, 3, 'a', 'b', 'c', 1, 'b', 0, 0, 0, 0, 0, 1},
+			expErr: "cannot parse address external id to order key: only 8 bytes after the address, expected at least 10",
+		},
+		{
+			name:   "external id length too long",
+			key:    []byte{keeper.KeyTypeAddressExternalIDToOrderIndex, 1, 'a', 2, 'b', 0, 0, 0, 0, 0, 0, 0, 1},
+			expErr: "cannot parse address external id to order key: external id length 2 with 10 bytes after the address",
+		},
+		{
+			name:          "good key",
+			key:           keeper.MakeIndexKeyAddressExternalIDToOrder(sdk.AccAddress("owner_address_______"), "my-ext-id", 578_437_695_752_307_201),
+			expAddr:       sdk.AccAddress("owner_address_______"),
+			expExternalID: "my-ext-id",
+			expOrderID:    578_437_695_752_307_201,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var addr sdk.AccAddress
+			var externalID string
+			var orderID uint64
+			var err error
+			testFunc := func() {
+				addr, externalID, orderID, err = keeper.ParseIndexKeyAddressExternalIDToOrder(tc.key)
+			}
+			require.NotPanics(t, testFunc, "ParseIndexKeyAddressExternalIDToOrder(%v)", tc.key)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseIndexKeyAddressExternalIDToOrder(%v) error", tc.key)
+			assert.Equal(t, tc.expAddr, addr, "ParseIndexKeyAddressExternalIDToOrder(%v) address", tc.key)
+			assert.Equal(t, tc.expExternalID, externalID, "ParseIndexKeyAddressExternalIDToOrder(%v) external id", tc.key)
+			assert.Equal(t, tc.expOrderID, orderID, "ParseIndexKeyAddressExternalIDToOrder(%v) order id", tc.key)
+		})
+	}
+}
+
+func TestGetIndexKeyPrefixExpirationToOrder(t *testing.T) {
+	ktc := keyTestCase{
+		maker: func() []byte {
+			return keeper.GetIndexKeyPrefixExpirationToOrder()
+		},
+		expected: []byte{keeper.KeyTypeExpirationToOrderIndex},
+	}
+	checkKey(t, ktc, "GetIndexKeyPrefixExpirationToOrder")
+}
+
+func TestGetIndexKeyPrefixExpirationToOrderAt(t *testing.T) {
+	tests := []struct {
+		name       string
+		expiration time.Time
+		expected   []byte
+	}{
+		{
+			name:       "one second after epoch",
+			expiration: time.Unix(1, 0),
+			expected:   []byte{keeper.KeyTypeExpirationToOrderIndex, 0, 0, 0, 0, 0, 0, 0, 1},
+		},
+		{
+			name:       "sub-second part is ignored",
+			expiration: time.Unix(1, 999_999_999),
+			expected:   []byte{keeper.KeyTypeExpirationToOrderIndex, 0, 0, 0, 0, 0, 0, 0, 1},
+		},
+		{
+			name:       "2030-01-01 in a different time zone",
+			expiration: time.Date(2029, 12, 31, 18, 0, 0, 0, time.FixedZone("CST", -6*60*60)),
+			expected:   []byte{keeper.KeyTypeExpirationToOrderIndex, 0, 0, 0, 0, 0x70, 0xdb, 0xd8, 0x80},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetIndexKeyPrefixExpirationToOrderAt(tc.expiration)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetIndexKeyPrefixExpirationToOrder", value: keeper.GetIndexKeyPrefixExpirationToOrder()},
+				},
+			}
+			checkKey(t, ktc, "GetIndexKeyPrefixExpirationToOrderAt(%s)", tc.expiration)
+		})
+	}
+}
+
+func TestMakeIndexKeyExpirationToOrder(t *testing.T) {
+	tests := []struct {
+		name       string
+		expiration time.Time
+		orderID    uint64
+		expected   []byte
+	}{
+		{
+			name:       "one second after epoch, order 1",
+			expiration: time.Unix(1, 0),
+			orderID:    1,
+			expected: []byte{keeper.KeyTypeExpirationToOrderIndex,
+				0, 0, 0, 0, 0, 0, 0, 1,
+				0, 0, 0, 0, 0, 0, 0, 1},
+		},
+		{
+			name:       "2030-01-01, order 72,623,859,790,382,856",
+			expiration: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+			orderID:    72_623_859_790_382_856,
+			expected: []byte{keeper.KeyTypeExpirationToOrderIndex,
+				0, 0, 0, 0, 0x70, 0xdb, 0xd8, 0x80,
+				1, 2, 3, 4, 5, 6, 7, 8},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeIndexKeyExpirationToOrder(tc.expiration, tc.orderID)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetIndexKeyPrefixExpirationToOrder", value: keeper.GetIndexKeyPrefixExpirationToOrder()},
+					{name: "GetIndexKeyPrefixExpirationToOrderAt", value: keeper.GetIndexKeyPrefixExpirationToOrderAt(tc.expiration)},
+				},
+			}
+			checkKey(t, ktc, "MakeIndexKeyExpirationToOrder(%s, %d)", tc.expiration, tc.orderID)
+		})
+	}
+}
+
+func TestParseIndexKeyExpirationToOrder(t *testing.T) {
+	tests := []struct {
+		name          string
+		key           []byte
+		expExpiration time.Time
+		expOrderID    uint64
+		expErr        string
+	}{
+		{
+			name:   "nil key",
+			key:    nil,
+			expErr: "cannot parse expiration to order key: length 0, expected 16 or 17",
+		},
+		{
+			name:   "15 bytes",
+			key:    []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
+			expErr: "cannot parse expiration to order key: length 15, expected 16 or 17",
+		},
+		{
+			name:   "18 bytes",
+			key:    []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18},
+			expErr: "cannot parse expiration to order key: length 18, expected 16 or 17",
+		},
+		{
+			name:   "17 bytes wrong type byte",
+			key:    []byte{keeper.KeyTypeMarketExternalIDToOrderIndex, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 1},
+			expErr: "cannot parse expiration to order key: unknown type byte 0x9, expected 0xa",
+		},
+		{
+			name:          "16 bytes",
+			key:           []byte{0, 0, 0, 0, 0x70, 0xdb, 0xd8, 0x80, 1, 2, 3, 4, 5, 6, 7, 8},
+			expExpiration: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+			expOrderID:    72_623_859_790_382_856,
+		},
+		{
+			name:          "17 bytes",
+			key:           keeper.MakeIndexKeyExpirationToOrder(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC), 3),
+			expExpiration: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+			expOrderID:    3,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var expiration time.Time
+			var orderID uint64
+			var err error
+			testFunc := func() {
+				expiration, orderID, err = keeper.ParseIndexKeyExpirationToOrder(tc.key)
+			}
+			require.NotPanics(t, testFunc, "ParseIndexKeyExpirationToOrder(%v)", tc.key)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseIndexKeyExpirationToOrder(%v) error", tc.key)
+			assert.Equal(t, tc.expExpiration, expiration, "ParseIndexKeyExpirationToOrder(%v) expiration", tc.key)
+			assert.Equal(t, tc.expOrderID, orderID, "ParseIndexKeyExpirationToOrder(%v) order id", tc.key)
+		})
+	}
+}
+
+func TestGetKeyPrefixTriggerOrder(t *testing.T) {
+	ktc := keyTestCase{
+		maker: func() []byte {
+			return keeper.GetKeyPrefixTriggerOrder()
+		},
+		expected: []byte{keeper.KeyTypeTriggerOrder},
+	}
+	checkKey(t, ktc, "GetKeyPrefixTriggerOrder")
+}
+
+func TestMakeKeyTriggerOrder(t *testing.T) {
+	tests := []struct {
+		name     string
+		orderID  uint64
+		expected []byte
+	}{
+		{
+			name:     "order 1",
+			orderID:  1,
+			expected: []byte{keeper.KeyTypeTriggerOrder, 0, 0, 0, 0, 0, 0, 0, 1},
+		},
+		{
+			name:     "order 72,623,859,790,382,856",
+			orderID:  72_623_859_790_382_856,
+			expected: []byte{keeper.KeyTypeTriggerOrder, 1, 2, 3, 4, 5, 6, 7, 8},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeKeyTriggerOrder(tc.orderID)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixTriggerOrder", value: keeper.GetKeyPrefixTriggerOrder()},
+				},
+			}
+			checkKey(t, ktc, "MakeKeyTriggerOrder(%d)", tc.orderID)
+		})
+	}
+}
+
+func TestGetIndexKeyPrefixMarketToTriggerOrder(t *testing.T) {
+	tests := []struct {
+		name     string
+		marketID uint32
+		expected []byte
+	}{
+		{
+			name:     "market 0",
+			marketID: 0,
+			expected: []byte{keeper.KeyTypeMarketToTriggerOrderIndex, 0, 0, 0, 0},
+		},
+		{
+			name:     "market 16,909,060",
+			marketID: 16_909_060,
+			expected: []byte{keeper.KeyTypeMarketToTriggerOrderIndex, 1, 2, 3, 4},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetIndexKeyPrefixMarketToTriggerOrder(tc.marketID)
+				},
+				expected: tc.expected,
+			}
+			checkKey(t, ktc, "GetIndexKeyPrefixMarketToTriggerOrder(%d)", tc.marketID)
+		})
+	}
+}
+
+func TestMakeIndexKeyMarketToTriggerOrder(t *testing.T) {
+	tests := []struct {
+		name     string
+		marketID uint32
+		orderID  uint64
+		expected []byte
+	}{
+		{
+			name:     "market 1, order 1",
+			marketID: 1,
+			orderID:  1,
+			expected: []byte{keeper.KeyTypeMarketToTriggerOrderIndex, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 1},
+		},
+		{
+			name:     "market 16,909,060, order 72,623,859,790,382,856",
+			marketID: 16_909_060,
+			orderID:  72_623_859_790_382_856,
+			expected: []byte{keeper.KeyTypeMarketToTriggerOrderIndex, 1, 2, 3, 4, 1, 2, 3, 4, 5, 6, 7, 8},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeIndexKeyMarketToTriggerOrder(tc.marketID, tc.orderID)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetIndexKeyPrefixMarketToTriggerOrder", value: keeper.GetIndexKeyPrefixMarketToTriggerOrder(tc.marketID)},
+				},
+			}
+			checkKey(t, ktc, "MakeIndexKeyMarketToTriggerOrder(%d, %d)", tc.marketID, tc.orderID)
+		})
+	}
+}
+
+func TestGetIndexKeyPrefixAddressToTriggerOrder(t *testing.T) {
+	tests := []struct {
+		name     string
+		addr     sdk.AccAddress
+		expected []byte
+		expPanic string
+	}{
+		{
+			name:     "nil addr",
+			addr:     nil,
+			expPanic: "empty address not allowed",
+		},
+		{
+			name:     "empty addr",
+			addr:     sdk.AccAddress{},
+			expPanic: "empty address not allowed",
+		},
+		{
+			name:     "256 byte addr",
+			addr:     sdk.AccAddress(bytes.Repeat([]byte{'P'}, 256)),
+			expPanic: "address length should be max 255 bytes, got 256: unknown address",
+		},
+		{
+			name:     "20 byte addr",
+			addr:     sdk.AccAddress("abcdefghijklmnopqrst"),
+			expected: append([]byte{keeper.KeyTypeAddressToTriggerOrderIndex, 20}, "abcdefghijklmnopqrst"...),
+		},
+		{
+			name:     "32 byte addr",
+			addr:     sdk.AccAddress("abcdefghijklmnopqrstuvwxyzABCDEF"),
+			expected: append([]byte{keeper.KeyTypeAddressToTriggerOrderIndex, 32}, "abcdefghijklmnopqrstuvwxyzABCDEF"...),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetIndexKeyPrefixAddressToTriggerOrder(tc.addr)
+				},
+				expected: tc.expected,
+				expPanic: tc.expPanic,
+			}
+			checkKey(t, ktc, "GetIndexKeyPrefixAddressToTriggerOrder(%s)", string(tc.addr))
+		})
+	}
+}
+
+func TestMakeIndexKeyAddressToTriggerOrder(t *testing.T) {
+	tests := []struct {
+		name     string
+		addr     sdk.AccAddress
+		orderID  uint64
+		expected []byte
+		expPanic string
+	}{
+		{
+			name:     "nil addr",
+			addr:     nil,
+			expPanic: "empty address not allowed",
+		},
+		{
+			name:     "empty addr",
+			addr:     sdk.AccAddress{},
+			expPanic: "empty address not allowed",
+		},
+		{
+			name:    "20 byte addr order 1",
+			addr:    sdk.AccAddress("abcdefghijklmnopqrst"),
+			orderID: 1,
+			expected: concatBz(
+				[]byte{keeper.KeyTypeAddressToTriggerOrderIndex, 20},
+				[]byte("abcdefghijklmnopqrst"),
+				[]byte{0, 0, 0, 0, 0, 0, 0, 1},
+			),
+		},
+		{
+			name:    "32 byte addr order 72,623,859,790,382,856",
+			addr:    sdk.AccAddress("abcdefghijklmnopqrstuvwxyzABCDEF"),
+			orderID: 72_623_859_790_382_856,
+			expected: concatBz(
+				[]byte{keeper.KeyTypeAddressToTriggerOrderIndex, 32},
+				[]byte("abcdefghijklmnopqrstuvwxyzABCDEF"),
+				[]byte{1, 2, 3, 4, 5, 6, 7, 8},
+			),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeIndexKeyAddressToTriggerOrder(tc.addr, tc.orderID)
+				},
+				expected: tc.expected,
+				expPanic: tc.expPanic,
+			}
+			if len(tc.expPanic) == 0 {
+				ktc.expPrefixes = []expectedPrefix{
+					{name: "GetIndexKeyPrefixAddressToTriggerOrder", value: keeper.GetIndexKeyPrefixAddressToTriggerOrder(tc.addr)},
+				}
+			}
+			checkKey(t, ktc, "MakeIndexKeyAddressToTriggerOrder(%s, %d)", string(tc.addr), tc.orderID)
+		})
+	}
+}
+
+func TestGetKeyPrefixOrderLink(t *testing.T) {
+	ktc := keyTestCase{
+		maker: func() []byte {
+			return keeper.GetKeyPrefixOrderLink()
+		},
+		expected: []byte{keeper.KeyTypeOrderLink},
+	}
+	checkKey(t, ktc, "GetKeyPrefixOrderLink")
+}
+
+func TestGetKeyPrefixMarketOrderLink(t *testing.T) {
+	tests := []struct {
+		name     string
+		marketID uint32
+		expected []byte
+	}{
+		{
+			name:     "market 0",
+			marketID: 0,
+			expected: []byte{keeper.KeyTypeOrderLink, 0, 0, 0, 0},
+		},
+		{
+			name:     "market 16,909,060",
+			marketID: 16_909_060,
+			expected: []byte{keeper.KeyTypeOrderLink, 1, 2, 3, 4},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetKeyPrefixMarketOrderLink(tc.marketID)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixOrderLink", value: keeper.GetKeyPrefixOrderLink()},
+				},
+			}
+			checkKey(t, ktc, "GetKeyPrefixMarketOrderLink(%d)", tc.marketID)
+		})
+	}
+}
+
+func TestMakeKeyOrderLink(t *testing.T) {
+	tests := []struct {
+		name     string
+		marketID uint32
+		orderID  uint64
+		expected []byte
+	}{
+		{
+			name:     "market 1, order 1",
+			marketID: 1,
+			orderID:  1,
+			expected: []byte{keeper.KeyTypeOrderLink, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 1},
+		},
+		{
+			name:     "market 16,909,060, order 72,623,859,790,382,856",
+			marketID: 16_909_060,
+			orderID:  72_623_859_790_382_856,
+			expected: []byte{keeper.KeyTypeOrderLink, 1, 2, 3, 4, 1, 2, 3, 4, 5, 6, 7, 8},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeKeyOrderLink(tc.marketID, tc.orderID)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixOrderLink", value: keeper.GetKeyPrefixOrderLink()},
+					{name: "GetKeyPrefixMarketOrderLink", value: keeper.GetKeyPrefixMarketOrderLink(tc.marketID)},
+				},
+			}
+			checkKey(t, ktc, "MakeKeyOrderLink(%d, %d)", tc.marketID, tc.orderID)
+		})
+	}
+}
+
+func TestParseKeyOrderLink(t *testing.T) {
+	tests := []struct {
+		name        string
+		key         []byte
+		expMarketID uint32
+		expOrderID  uint64
+		expErr      string
+	}{
+		{
+			name:   "nil key",
+			key:    nil,
+			expErr: "cannot parse order link key: length 0, expected 8, 12, or 13",
+		},
+		{
+			name:   "7 bytes",
+			key:    []byte{1, 2, 3, 4, 5, 6, 7},
+			expErr: "cannot parse order link key: length 7, expected 8, 12, or 13",
+		},
+		{
+			name:   "11 bytes",
+			key:    []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11},
+			expErr: "cannot parse order link key: length 11, expected 8, 12, or 13",
+		},
+		{
+			name:   "14 bytes",
+			key:    []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14},
+			expErr: "cannot parse order link key: length 14, expected 8, 12, or 13",
+		},
+		{
+			name:       "8 bytes order id 72,623,859,790,382,856",
+			key:        []byte{1, 2, 3, 4, 5, 6, 7, 8},
+			expOrderID: 72_623_859_790_382_856,
+		},
+		{
+			name:        "12 bytes market id 16,843,009 order id 144,680,345,676,153,346",
+			key:         []byte{1, 1, 1, 1, 2, 2, 2, 2, 2, 2, 2, 2},
+			expMarketID: 16_843_009,
+			expOrderID:  144_680_345_676_153_346,
+		},
+		{
+			name:        "13 bytes market id 1 order id 1",
+			key:         []byte{keeper.KeyTypeOrderLink, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 1},
+			expMarketID: 1,
+			expOrderID:  1,
+		},
+		{
+			name:   "13 bytes wrong type byte",
+			key:    []byte{keeper.KeyTypeMarketToOrderIndex, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 1},
+			expErr: "cannot parse order link key: unknown type byte 0x3, expected 0xd",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var marketID uint32
+			var orderID uint64
+			var err error
+			testFunc := func() {
+				marketID, orderID, err = keeper.ParseKeyOrderLink(tc.key)
+			}
+			require.NotPanics(t, testFunc, "ParseKeyOrderLink(%v)", tc.key)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseKeyOrderLink(%v) error", tc.key)
+			assert.Equal(t, tc.expMarketID, marketID, "ParseKeyOrderLink(%v) market id", tc.key)
+			assert.Equal(t, tc.expOrderID, orderID, "ParseKeyOrderLink(%v) order id", tc.key)
+		})
+	}
+}
+
+func TestGetIndexKeyPrefixPriceToOrder(t *testing.T) {
+	tests := []struct {
+		name       string
+		marketID   uint32
+		assetDenom string
+		priceDenom string
+		expected   []byte
+	}{
+		{
+			name:     "market 0, no denoms",
+			marketID: 0,
+			expected: []byte{keeper.KeyTypePriceToOrderIndex, 0, 0, 0, 0},
+		},
+		{
+			name:       "market 1, apple and plum",
+			marketID:   1,
+			assetDenom: "apple",
+			priceDenom: "plum",
+			expected: []byte{
+				keeper.KeyTypePriceToOrderIndex, 0, 0, 0, 1,
+				5, 'a', 'p', 'p', 'l', 'e',
+				4, 'p', 'l', 'u', 'm',
+			},
+		},
+		{
+			name:       "market 16,843,009, hex string and nhash",
+			marketID:   16_843_009,
+			assetDenom: hexString,
+			priceDenom: "nhash",
+			expected: concatBz(
+				[]byte{keeper.KeyTypePriceToOrderIndex, 1, 1, 1, 1, byte(len(hexString))},
+				[]byte(hexString),
+				[]byte{5, 'n', 'h', 'a', 's', 'h'},
+			),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetIndexKeyPrefixPriceToOrder(tc.marketID, tc.assetDenom, tc.priceDenom)
+				},
+				expected: tc.expected,
+			}
+			checkKey(t, ktc, "GetIndexKeyPrefixPriceToOrder(%d, %q, %q)", tc.marketID, tc.assetDenom, tc.priceDenom)
+		})
+	}
+}
+
+func TestGetIndexKeyPrefixPriceToOrderType(t *testing.T) {
+	tests := []struct {
+		name          string
+		marketID      uint32
+		assetDenom    string
+		priceDenom    string
+		orderTypeByte byte
+		expected      []byte
+	}{
+		{
+			name:          "market 0, no denoms, ask",
+			marketID:      0,
+			orderTypeByte: exchange.OrderTypeByteAsk,
+			expected:      []byte{keeper.KeyTypePriceToOrderIndex, 0, 0, 0, 0, exchange.OrderTypeByteAsk},
+		},
+		{
+			name:          "market 3, apple and plum, bid",
+			marketID:      3,
+			assetDenom:    "apple",
+			priceDenom:    "plum",
+			orderTypeByte: exchange.OrderTypeByteBid,
+			expected: []byte{
+				keeper.KeyTypePriceToOrderIndex, 0, 0, 0, 3,
+				5, 'a', 'p', 'p', 'l', 'e',
+				4, 'p', 'l', 'u', 'm',
+				exchange.OrderTypeByteBid,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetIndexKeyPrefixPriceToOrderType(tc.marketID, tc.assetDenom, tc.priceDenom, tc.orderTypeByte)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{
+						name:  "GetIndexKeyPrefixPriceToOrder",
+						value: keeper.GetIndexKeyPrefixPriceToOrder(tc.marketID, tc.assetDenom, tc.priceDenom),
+					},
+				},
+			}
+			checkKey(t, ktc, "GetIndexKeyPrefixPriceToOrderType(%d, %q, %q, %#x)",
+				tc.marketID, tc.assetDenom, tc.priceDenom, tc.orderTypeByte)
+		})
+	}
+}
+
+func TestMakeIndexKeyPriceToOrder(t *testing.T) {
+	// 10^18 = 0x0DE0B6B3A7640000
+	oneBz := []byte{8, 0x0D, 0xE0, 0xB6, 0xB3, 0xA7, 0x64, 0x00, 0x00}
+	pre := func(orderTypeByte byte) []byte {
+		return []byte{
+			keeper.KeyTypePriceToOrderIndex, 0, 0, 0, 3,
+			5, 'a', 'p', 'p', 'l', 'e',
+			4, 'p', 'l', 'u', 'm',
+			orderTypeByte,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		order    exchange.OrderI
+		expected []byte
+	}{
+		{
+			name: "ask: unit price 1",
+			order: exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+				MarketId: 3, Assets: sdk.NewInt64Coin("apple", 7), Price: sdk.NewInt64Coin("plum", 7),
+			}),
+			expected: concatBz(pre(exchange.OrderTypeByteAsk), oneBz, []byte{0, 0, 0, 0, 0, 0, 0, 1}),
+		},
+		{
+			name: "bid: unit price 1",
+			order: exchange.NewOrder(578_437_695_752_307_201).WithBid(&exchange.BidOrder{
+				MarketId: 3, Assets: sdk.NewInt64Coin("apple", 20), Price: sdk.NewInt64Coin("plum", 20),
+			}),
+			expected: concatBz(pre(exchange.OrderTypeByteBid), oneBz, []byte{8, 7, 6, 5, 4, 3, 2, 1}),
+		},
+		{
+			name: "ask: unit price 0.000000000000000001",
+			order: exchange.NewOrder(2).WithAsk(&exchange.AskOrder{
+				MarketId: 3, Assets: sdk.NewInt64Coin("apple", 1_000_000_000_000_000_000), Price: sdk.NewInt64Coin("plum", 1),
+			}),
+			expected: concatBz(pre(exchange.OrderTypeByteAsk), []byte{1, 1}, []byte{0, 0, 0, 0, 0, 0, 0, 2}),
+		},
+		{
+			name: "ask: zero assets",
+			order: exchange.NewOrder(3).WithAsk(&exchange.AskOrder{
+				MarketId: 3, Assets: sdk.NewInt64Coin("apple", 0), Price: sdk.NewInt64Coin("plum", 5),
+			}),
+			expected: concatBz(pre(exchange.OrderTypeByteAsk), []byte{0}, []byte{0, 0, 0, 0, 0, 0, 0, 3}),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assets, price := tc.order.GetAssets(), tc.order.GetPrice()
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeIndexKeyPriceToOrder(tc.order)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{
+						name:  "GetIndexKeyPrefixPriceToOrder",
+						value: keeper.GetIndexKeyPrefixPriceToOrder(3, assets.Denom, price.Denom),
+					},
+					{
+						name:  "GetIndexKeyPrefixPriceToOrderType",
+						value: keeper.GetIndexKeyPrefixPriceToOrderType(3, assets.Denom, price.Denom, tc.order.GetOrderTypeByte()),
+					},
+				},
+			}
+			checkKey(t, ktc, "MakeIndexKeyPriceToOrder(%d)", tc.order.GetOrderID())
+		})
+	}
+
+	t.Run("keys are ordered by unit price", func(t *testing.T) {
+		// Each of these has a larger unit price than the one before it.
+		prices := []struct{ assets, price int64 }{
+			{assets: 1_000_000_000_000_000_000, price: 1},
+			{assets: 3, price: 1},
+			{assets: 5, price: 2},
+			{assets: 1, price: 1},
+			{assets: 2, price: 3},
+			{assets: 1, price: 255},
+			{assets: 1, price: 256},
+			{assets: 1, price: 9_000_000_000},
+		}
+		var prevKey []byte
+		for i, p := range prices {
+			// Using decreasing order ids to make sure the unit price is what's used for sorting.
+			order := exchange.NewOrder(uint64(100 - i)).WithAsk(&exchange.AskOrder{
+				MarketId: 3, Assets: sdk.NewInt64Coin("apple", p.assets), Price: sdk.NewInt64Coin("plum", p.price),
+			})
+			key := keeper.MakeIndexKeyPriceToOrder(order)
+			if prevKey != nil {
+				assert.Equal(t, 1, bytes.Compare(key, prevKey), "key for %dplum/%dapple compared to the previous key", p.price, p.assets)
+			}
+			prevKey = key
+		}
+	})
+}
+
+func TestParseIndexKeySuffixPriceToOrder(t *testing.T) {
+	tests := []struct {
+		name         string
+		suffix       []byte
+		expUnitPrice []byte
+		expOrderID   uint64
+		expErr       string
+	}{
+		{
+			name:   "nil suffix",
+			suffix: nil,
+			expErr: "cannot parse price to order key suffix: only has 0 bytes, expected at least 9",
+		},
+		{
+			name:   "8 bytes",
+			suffix: []byte{0, 1, 2, 3, 4, 5, 6, 7},
+			expErr: "cannot parse price to order key suffix: only has 8 bytes, expected at least 9",
+		},
+		{
+			name:   "unit price length too long",
+			suffix: []byte{2, 1, 0, 0, 0, 0, 0, 0, 0, 1},
+			expErr: "cannot parse price to order key suffix: length 10, expected 11",
+		},
+		{
+			name:   "unit price length too short",
+			suffix: []byte{0, 1, 0, 0, 0, 0, 0, 0, 0, 1},
+			expErr: "cannot parse price to order key suffix: length 10, expected 9",
+		},
+		{
+			name:         "zero unit price",
+			suffix:       []byte{0, 0, 0, 0, 0, 0, 0, 0, 5},
+			expUnitPrice: []byte{0},
+			expOrderID:   5,
+		},
+		{
+			name:         "unit price 1 order id 578,437,695,752,307,201",
+			suffix:       []byte{8, 0x0D, 0xE0, 0xB6, 0xB3, 0xA7, 0x64, 0x00, 0x00, 8, 7, 6, 5, 4, 3, 2, 1},
+			expUnitPrice: []byte{8, 0x0D, 0xE0, 0xB6, 0xB3, 0xA7, 0x64, 0x00, 0x00},
+			expOrderID:   578_437_695_752_307_201,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var unitPrice []byte
+			var orderID uint64
+			var err error
+			testFunc := func() {
+				unitPrice, orderID, err = keeper.ParseIndexKeySuffixPriceToOrder(tc.suffix)
+			}
+			require.NotPanics(t, testFunc, "ParseIndexKeySuffixPriceToOrder(%v)", tc.suffix)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseIndexKeySuffixPriceToOrder(%v) error", tc.suffix)
+			assert.Equal(t, tc.expUnitPrice, unitPrice, "ParseIndexKeySuffixPriceToOrder(%v) unit price", tc.suffix)
+			assert.Equal(t, tc.expOrderID, orderID, "ParseIndexKeySuffixPriceToOrder(%v) order id", tc.suffix)
+		})
+	}
+
+	t.Run("from a made key", func(t *testing.T) {
+		order := exchange.NewOrder(12).WithBid(&exchange.BidOrder{
+			MarketId: 3, Assets: sdk.NewInt64Coin("apple", 4), Price: sdk.NewInt64Coin("plum", 10),
+		})
+		key := keeper.MakeIndexKeyPriceToOrder(order)
+		prefix := keeper.GetIndexKeyPrefixPriceToOrderType(3, "apple", "plum", exchange.OrderTypeByteBid)
+		unitPrice, orderID, err := keeper.ParseIndexKeySuffixPriceToOrder(key[len(prefix):])
+		require.NoError(t, err, "ParseIndexKeySuffixPriceToOrder error")
+		// 2.5 * 10^18 = 0x22B1C8C1227A0000
+		assert.Equal(t, []byte{8, 0x22, 0xB1, 0xC8, 0xC1, 0x22, 0x7A, 0x00, 0x00}, unitPrice, "unit price")
+		assert.Equal(t, 12, int(orderID), "order id")
+	})
+}
+
+func TestGetKeyPrefixSettlementPrice(t *testing.T) {
+	ktc := keyTestCase{
+		maker: func() []byte {
+			return keeper.GetKeyPrefixSettlementPrice()
+		},
+		expected: []byte{keeper.KeyTypeSettlementPrice},
+	}
+	checkKey(t, ktc, "GetKeyPrefixSettlementPrice()")
+}
+
+func TestGetKeyPrefixSettlementPriceDenoms(t *testing.T) {
+	tests := []struct {
+		name       string
+		marketID   uint32
+		assetDenom string
+		priceDenom string
+		expected   []byte
+	}{
+		{
+			name:     "market 0, no denoms",
+			marketID: 0,
+			expected: []byte{keeper.KeyTypeSettlementPrice, 0, 0, 0, 0},
+		},
+		{
+			name:       "market 1, apple and plum",
+			marketID:   1,
+			assetDenom: "apple",
+			priceDenom: "plum",
+			expected: []byte{
+				keeper.KeyTypeSettlementPrice, 0, 0, 0, 1,
+				5, 'a', 'p', 'p', 'l', 'e',
+				4, 'p', 'l', 'u', 'm',
+			},
+		},
+		{
+			name:       "market 16,843,009, hex string and nhash",
+			marketID:   16_843_009,
+			assetDenom: hexString,
+			priceDenom: "nhash",
+			expected: concatBz(
+				[]byte{keeper.KeyTypeSettlementPrice, 1, 1, 1, 1, byte(len(hexString))},
+				[]byte(hexString),
+				[]byte{5, 'n', 'h', 'a', 's', 'h'},
+			),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetKeyPrefixSettlementPriceDenoms(tc.marketID, tc.assetDenom, tc.priceDenom)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixSettlementPrice", value: keeper.GetKeyPrefixSettlementPrice()},
+				},
+			}
+			checkKey(t, ktc, "GetKeyPrefixSettlementPriceDenoms(%d, %q, %q)", tc.marketID, tc.assetDenom, tc.priceDenom)
+		})
+	}
+}
+
+func TestMakeKeySettlementPrice(t *testing.T) {
+	tests := []struct {
+		name           string
+		marketID       uint32
+		assetDenom     string
+		priceDenom     string
+		settlementTime time.Time
+		expected       []byte
+	}{
+		{
+			name:           "market 1, apple and plum, 1 second after epoch",
+			marketID:       1,
+			assetDenom:     "apple",
+			priceDenom:     "plum",
+			settlementTime: time.Unix(1, 0),
+			expected: []byte{
+				keeper.KeyTypeSettlementPrice, 0, 0, 0, 1,
+				5, 'a', 'p', 'p', 'l', 'e',
+				4, 'p', 'l', 'u', 'm',
+				0, 0, 0, 0, 0, 0, 0, 1,
+			},
+		},
+		{
+			name:           "market 3, apple and nhash, nanoseconds are ignored",
+			marketID:       3,
+			assetDenom:     "apple",
+			priceDenom:     "nhash",
+			settlementTime: time.Unix(578_437_695_752_307_201&0x7fffffffffffffff, 999_999_999),
+			expected: []byte{
+				keeper.KeyTypeSettlementPrice, 0, 0, 0, 3,
+				5, 'a', 'p', 'p', 'l', 'e',
+				5, 'n', 'h', 'a', 's', 'h',
+				8, 7, 6, 5, 4, 3, 2, 1,
+			},
+		},
+		{
+			name:           "market 2, hex string and plum, time zones are ignored",
+			marketID:       2,
+			assetDenom:     hexString,
+			priceDenom:     "plum",
+			settlementTime: time.Date(2024, 1, 1, 1, 0, 0, 0, time.FixedZone("plus one", 3600)),
+			expected: concatBz(
+				[]byte{keeper.KeyTypeSettlementPrice, 0, 0, 0, 2, byte(len(hexString))},
+				[]byte(hexString),
+				[]byte{4, 'p', 'l', 'u', 'm'},
+				[]byte{0, 0, 0, 0, 0x65, 0x92, 0x00, 0x80},
+			),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeKeySettlementPrice(tc.marketID, tc.assetDenom, tc.priceDenom, tc.settlementTime)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixSettlementPrice", value: keeper.GetKeyPrefixSettlementPrice()},
+					{
+						name:  "GetKeyPrefixSettlementPriceDenoms",
+						value: keeper.GetKeyPrefixSettlementPriceDenoms(tc.marketID, tc.assetDenom, tc.priceDenom),
+					},
+				},
+			}
+			checkKey(t, ktc, "MakeKeySettlementPrice(%d, %q, %q, %s)",
+				tc.marketID, tc.assetDenom, tc.priceDenom, tc.settlementTime)
+		})
+	}
+}
+
+func TestGetKeyPrefixSettlementRecord(t *testing.T) {
+	ktc := keyTestCase{
+		maker: func() []byte {
+			return keeper.GetKeyPrefixSettlementRecord()
+		},
+		expected: []byte{keeper.KeyTypeSettlementRecord},
+	}
+	checkKey(t, ktc, "GetKeyPrefixSettlementRecord()")
+}
+
+func TestGetKeyPrefixMarketSettlementRecord(t *testing.T) {
+	tests := []struct {
+		name     string
+		marketID uint32
+		expected []byte
+	}{
+		{
+			name:     "market 0",
+			marketID: 0,
+			expected: []byte{keeper.KeyTypeSettlementRecord, 0, 0, 0, 0},
+		},
+		{
+			name:     "market 1",
+			marketID: 1,
+			expected: []byte{keeper.KeyTypeSettlementRecord, 0, 0, 0, 1},
+		},
+		{
+			name:     "market 16,843,009",
+			marketID: 16_843_009,
+			expected: []byte{keeper.KeyTypeSettlementRecord, 1, 1, 1, 1},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetKeyPrefixMarketSettlementRecord(tc.marketID)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixSettlementRecord", value: keeper.GetKeyPrefixSettlementRecord()},
+				},
+			}
+			checkKey(t, ktc, "GetKeyPrefixMarketSettlementRecord(%d)", tc.marketID)
+		})
+	}
+}
+
+func TestGetKeyPrefixMarketSettlementRecordHeight(t *testing.T) {
+	tests := []struct {
+		name     string
+		marketID uint32
+		height   int64
+		expected []byte
+	}{
+		{
+			name:     "market 1, height 0",
+			marketID: 1,
+			height:   0,
+			expected: []byte{keeper.KeyTypeSettlementRecord, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0},
+		},
+		{
+			name:     "market 3, height 578,437,695,752,307,201",
+			marketID: 3,
+			height:   578_437_695_752_307_201,
+			expected: []byte{keeper.KeyTypeSettlementRecord, 0, 0, 0, 3, 8, 7, 6, 5, 4, 3, 2, 1},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetKeyPrefixMarketSettlementRecordHeight(tc.marketID, tc.height)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixSettlementRecord", value: keeper.GetKeyPrefixSettlementRecord()},
+					{name: "GetKeyPrefixMarketSettlementRecord", value: keeper.GetKeyPrefixMarketSettlementRecord(tc.marketID)},
+				},
+			}
+			checkKey(t, ktc, "GetKeyPrefixMarketSettlementRecordHeight(%d, %d)", tc.marketID, tc.height)
+		})
+	}
+}
+
+func TestMakeKeySettlementRecord(t *testing.T) {
+	tests := []struct {
+		name     string
+		marketID uint32
+		height   int64
+		sequence uint32
+		expected []byte
+	}{
+		{
+			name:     "market 1, height 1, sequence 0",
+			marketID: 1,
+			height:   1,
+			sequence: 0,
+			expected: []byte{
+				keeper.KeyTypeSettlementRecord, 0, 0, 0, 1,
+				0, 0, 0, 0, 0, 0, 0, 1,
+				0, 0, 0, 0,
+			},
+		},
+		{
+			name:     "market 16,843,009, height 578,437,695,752,307,201, sequence 16,909,060",
+			marketID: 16_843_009,
+			height:   578_437_695_752_307_201,
+			sequence: 16_909_060,
+			expected: []byte{
+				keeper.KeyTypeSettlementRecord, 1, 1, 1, 1,
+				8, 7, 6, 5, 4, 3, 2, 1,
+				1, 2, 3, 4,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeKeySettlementRecord(tc.marketID, tc.height, tc.sequence)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixSettlementRecord", value: keeper.GetKeyPrefixSettlementRecord()},
+					{name: "GetKeyPrefixMarketSettlementRecord", value: keeper.GetKeyPrefixMarketSettlementRecord(tc.marketID)},
+					{
+						name:  "GetKeyPrefixMarketSettlementRecordHeight",
+						value: keeper.GetKeyPrefixMarketSettlementRecordHeight(tc.marketID, tc.height),
+					},
+				},
+			}
+			checkKey(t, ktc, "MakeKeySettlementRecord(%d, %d, %d)", tc.marketID, tc.height, tc.sequence)
+		})
+	}
+}
+
+func TestParseKeySuffixSettlementRecord(t *testing.T) {
+	tests := []struct {
+		name        string
+		key         []byte
+		expHeight   int64
+		expSequence uint32
+		expOK       bool
+	}{
+		{
+			name:  "nil key",
+			key:   nil,
+			expOK: false,
+		},
+		{
+			name:  "11 bytes",
+			key:   []byte{0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0},
+			expOK: false,
+		},
+		{
+			name:        "12 bytes",
+			key:         []byte{0, 0, 0, 0, 0, 0, 0, 5, 0, 0, 0, 2},
+			expHeight:   5,
+			expSequence: 2,
+			expOK:       true,
+		},
+		{
+			name:        "full key",
+			key:         keeper.MakeKeySettlementRecord(7, 578_437_695_752_307_201, 16_909_060),
+			expHeight:   578_437_695_752_307_201,
+			expSequence: 16_909_060,
+			expOK:       true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var height int64
+			var sequence uint32
+			var ok bool
+			testFunc := func() {
+				height, sequence, ok = keeper.ParseKeySuffixSettlementRecord(tc.key)
+			}
+			require.NotPanics(t, testFunc, "ParseKeySuffixSettlementRecord(%v)", tc.key)
+			assert.Equal(t, tc.expHeight, height, "ParseKeySuffixSettlementRecord(%v) height", tc.key)
+			assert.Equal(t, tc.expSequence, sequence, "ParseKeySuffixSettlementRecord(%v) sequence", tc.key)
+			assert.Equal(t, tc.expOK, ok, "ParseKeySuffixSettlementRecord(%v) ok", tc.key)
+		})
+	}
+}
+
+func TestGetKeyPrefixNAVRecord(t *testing.T) {
+	ktc := keyTestCase{
+		maker: func() []byte {
+			return keeper.GetKeyPrefixNAVRecord()
+		},
+		expected: []byte{keeper.KeyTypeNAVRecord},
+	}
+	checkKey(t, ktc, "GetKeyPrefixNAVRecord()")
+}
+
+func TestGetKeyPrefixNAVRecordDenoms(t *testing.T) {
+	tests := []struct {
+		name       string
+		assetDenom string
+		priceDenom string
+		expected   []byte
+	}{
+		{
+			name:       "short denoms",
+			assetDenom: "abc",
+			priceDenom: "de",
+			expected:   []byte{keeper.KeyTypeNAVRecord, 3, 'a', 'b', 'c', 2, 'd', 'e'},
+		},
+		{
+			name:       "longer denoms",
+			assetDenom: "apple",
+			priceDenom: "nhash",
+			expected:   append([]byte{keeper.KeyTypeNAVRecord, 5}, "apple\x05nhash"...),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetKeyPrefixNAVRecordDenoms(tc.assetDenom, tc.priceDenom)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixNAVRecord", value: keeper.GetKeyPrefixNAVRecord()},
+				},
+			}
+			checkKey(t, ktc, "GetKeyPrefixNAVRecordDenoms(%q, %q)", tc.assetDenom, tc.priceDenom)
+		})
+	}
+}
+
+func TestGetKeyPrefixNAVRecordDenomsHeight(t *testing.T) {
+	tests := []struct {
+		name       string
+		assetDenom string
+		priceDenom string
+		height     int64
+		expected   []byte
+	}{
+		{
+			name:       "height 0",
+			assetDenom: "abc",
+			priceDenom: "de",
+			height:     0,
+			expected:   []byte{keeper.KeyTypeNAVRecord, 3, 'a', 'b', 'c', 2, 'd', 'e', 0, 0, 0, 0, 0, 0, 0, 0},
+		},
+		{
+			name:       "height 578,437,695,752,307,201",
+			assetDenom: "abc",
+			priceDenom: "de",
+			height:     578_437_695_752_307_201,
+			expected:   []byte{keeper.KeyTypeNAVRecord, 3, 'a', 'b', 'c', 2, 'd', 'e', 8, 7, 6, 5, 4, 3, 2, 1},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetKeyPrefixNAVRecordDenomsHeight(tc.assetDenom, tc.priceDenom, tc.height)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixNAVRecord", value: keeper.GetKeyPrefixNAVRecord()},
+					{name: "GetKeyPrefixNAVRecordDenoms", value: keeper.GetKeyPrefixNAVRecordDenoms(tc.assetDenom, tc.priceDenom)},
+				},
+			}
+			checkKey(t, ktc, "GetKeyPrefixNAVRecordDenomsHeight(%q, %q, %d)", tc.assetDenom, tc.priceDenom, tc.height)
+		})
+	}
+}
+
+func TestMakeKeyNAVRecord(t *testing.T) {
+	tests := []struct {
+		name       string
+		assetDenom string
+		priceDenom string
+		height     int64
+		marketID   uint32
+		expected   []byte
+	}{
+		{
+			name:       "height 1, market 1",
+			assetDenom: "abc",
+			priceDenom: "de",
+			height:     1,
+			marketID:   1,
+			expected: []byte{
+				keeper.KeyTypeNAVRecord, 3, 'a', 'b', 'c', 2, 'd', 'e',
+				0, 0, 0, 0, 0, 0, 0, 1,
+				0, 0, 0, 1,
+			},
+		},
+		{
+			name:       "height 578,437,695,752,307,201, market 16,909,060",
+			assetDenom: "abc",
+			priceDenom: "de",
+			height:     578_437_695_752_307_201,
+			marketID:   16_909_060,
+			expected: []byte{
+				keeper.KeyTypeNAVRecord, 3, 'a', 'b', 'c', 2, 'd', 'e',
+				8, 7, 6, 5, 4, 3, 2, 1,
+				1, 2, 3, 4,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeKeyNAVRecord(tc.assetDenom, tc.priceDenom, tc.height, tc.marketID)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixNAVRecord", value: keeper.GetKeyPrefixNAVRecord()},
+					{name: "GetKeyPrefixNAVRecordDenoms", value: keeper.GetKeyPrefixNAVRecordDenoms(tc.assetDenom, tc.priceDenom)},
+					{
+						name:  "GetKeyPrefixNAVRecordDenomsHeight",
+						value: keeper.GetKeyPrefixNAVRecordDenomsHeight(tc.assetDenom, tc.priceDenom, tc.height),
+					},
+				},
+			}
+			checkKey(t, ktc, "MakeKeyNAVRecord(%q, %q, %d, %d)", tc.assetDenom, tc.priceDenom, tc.height, tc.marketID)
+		})
+	}
+}
+
+func TestParseKeySuffixNAVRecord(t *testing.T) {
+	tests := []struct {
+		name        string
+		key         []byte
+		expHeight   int64
+		expMarketID uint32
+		expOK       bool
+	}{
+		{
+			name:  "nil key",
+			key:   nil,
+			expOK: false,
+		},
+		{
+			name:  "11 bytes",
+			key:   []byte{0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0},
+			expOK: false,
+		},
+		{
+			name:        "12 bytes",
+			key:         []byte{0, 0, 0, 0, 0, 0, 0, 5, 0, 0, 0, 2},
+			expHeight:   5,
+			expMarketID: 2,
+			expOK:       true,
+		},
+		{
+			name:        "full key",
+			key:         keeper.MakeKeyNAVRecord("apple", "nhash", 578_437_695_752_307_201, 16_909_060),
+			expHeight:   578_437_695_752_307_201,
+			expMarketID: 16_909_060,
+			expOK:       true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var height int64
+			var marketID uint32
+			var ok bool
+			testFunc := func() {
+				height, marketID, ok = keeper.ParseKeySuffixNAVRecord(tc.key)
+			}
+			require.NotPanics(t, testFunc, "ParseKeySuffixNAVRecord(%v)", tc.key)
+			assert.Equal(t, tc.expHeight, height, "ParseKeySuffixNAVRecord(%v) height", tc.key)
+			assert.Equal(t, tc.expMarketID, marketID, "ParseKeySuffixNAVRecord(%v) market id", tc.key)
+			assert.Equal(t, tc.expOK, ok, "ParseKeySuffixNAVRecord(%v) ok", tc.key)
+		})
+	}
+}
+
+func TestGetIndexKeyPrefixHeightToNAVRecord(t *testing.T) {
+	ktc := keyTestCase{
+		maker: func() []byte {
+			return keeper.GetIndexKeyPrefixHeightToNAVRecord()
+		},
+		expected: []byte{keeper.KeyTypeHeightToNAVRecordIndex},
+	}
+	checkKey(t, ktc, "GetIndexKeyPrefixHeightToNAVRecord")
+}
+
+func TestGetIndexKeyPrefixHeightToNAVRecordAt(t *testing.T) {
+	tests := []struct {
+		name     string
+		height   int64
+		expected []byte
+	}{
+		{
+			name:     "height 1",
+			height:   1,
+			expected: []byte{keeper.KeyTypeHeightToNAVRecordIndex, 0, 0, 0, 0, 0, 0, 0, 1},
+		},
+		{
+			name:     "height 578,437,695,752,307,201",
+			height:   578_437_695_752_307_201,
+			expected: []byte{keeper.KeyTypeHeightToNAVRecordIndex, 8, 7, 6, 5, 4, 3, 2, 1},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetIndexKeyPrefixHeightToNAVRecordAt(tc.height)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetIndexKeyPrefixHeightToNAVRecord", value: keeper.GetIndexKeyPrefixHeightToNAVRecord()},
+				},
+			}
+			checkKey(t, ktc, "GetIndexKeyPrefixHeightToNAVRecordAt(%d)", tc.height)
+		})
+	}
+}
+
+func TestMakeIndexKeyHeightToNAVRecord(t *testing.T) {
+	tests := []struct {
+		name       string
+		height     int64
+		assetDenom string
+		priceDenom string
+		marketID   uint32
+		expected   []byte
+	}{
+		{
+			name:       "height 1, market 1",
+			height:     1,
+			assetDenom: "abc",
+			priceDenom: "de",
+			marketID:   1,
+			expected: []byte{
+				keeper.KeyTypeHeightToNAVRecordIndex,
+				0, 0, 0, 0, 0, 0, 0, 1,
+				3, 'a', 'b', 'c', 2, 'd', 'e',
+				0, 0, 0, 1,
+			},
+		},
+		{
+			name:       "height 578,437,695,752,307,201, market 16,909,060",
+			height:     578_437_695_752_307_201,
+			assetDenom: "abc",
+			priceDenom: "de",
+			marketID:   16_909_060,
+			expected: []byte{
+				keeper.KeyTypeHeightToNAVRecordIndex,
+				8, 7, 6, 5, 4, 3, 2, 1,
+				3, 'a', 'b', 'c', 2, 'd', 'e',
+				1, 2, 3, 4,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeIndexKeyHeightToNAVRecord(tc.height, tc.assetDenom, tc.priceDenom, tc.marketID)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetIndexKeyPrefixHeightToNAVRecord", value: keeper.GetIndexKeyPrefixHeightToNAVRecord()},
+					{name: "GetIndexKeyPrefixHeightToNAVRecordAt", value: keeper.GetIndexKeyPrefixHeightToNAVRecordAt(tc.height)},
+				},
+			}
+			checkKey(t, ktc, "MakeIndexKeyHeightToNAVRecord(%d, %q, %q, %d)", tc.height, tc.assetDenom, tc.priceDenom, tc.marketID)
+		})
+	}
+}
+
+func TestParseIndexKeyHeightToNAVRecord(t *testing.T) {
+	tests := []struct {
+		name          string
+		key           []byte
+		expHeight     int64
+		expAssetDenom string
+		expPriceDenom string
+		expMarketID   uint32
+		expErr        string
+	}{
+		{
+			name:   "nil key",
+			key:    nil,
+			expErr: "cannot parse height to nav record key: only has 0 bytes, expected at least 15",
+		},
+		{
+			name:   "14 bytes",
+			key:    []byte{keeper.KeyTypeHeightToNAVRecordIndex, 0, 0, 0, 0, 0, 0, 0, 1, 1, 'a', 1, 'b', 0},
+			expErr: "cannot parse height to nav record key: only has 14 bytes, expected at least 15",
+		},
+		{
+			name:   "wrong type byte",
+			key:    []byte{keeper.KeyTypeNAVRecord, 0, 0, 0, 0, 0, 0, 0, 1, 1, 'a', 1, 'b', 0, 0, 0, 1},
+			expErr: "cannot parse height to nav record key: unknown type byte 0x20, expected 0x21",
+		},
+		{
+			name:   "price denom length too long",
+			key:    []byte{keeper.KeyTypeHeightToNAVRecordIndex, 0, 0, 0, 0, 0, 0, 0, 1, 1, 'a', 9, 'b', 0, 0, 0, 1},
+			expErr: "cannot parse height to nav record key: price denom length byte is 9 but there are only 5 bytes left",
+		},
+		{
+			name:   "market id too short",
+			key:    []byte{keeper.KeyTypeHeightToNAVRecordIndex, 0, 0, 0, 0, 0, 0, 0, 1, 1, 'a', 1, 'b', 0, 0, 1},
+			expErr: "cannot parse height to nav record key: market id has 3 bytes, expected 4",
+		},
+		{
+			name:   "market id too long",
+			key:    []byte{keeper.KeyTypeHeightToNAVRecordIndex, 0, 0, 0, 0, 0, 0, 0, 1, 1, 'a', 1, 'b', 0, 0, 0, 0, 1},
+			expErr: "cannot parse height to nav record key: market id has 5 bytes, expected 4",
+		},
+		{
+			name:          "good key",
+			key:           keeper.MakeIndexKeyHeightToNAVRecord(578_437_695_752_307_201, "apple", "nhash", 16_909_060),
+			expHeight:     578_437_695_752_307_201,
+			expAssetDenom: "apple",
+			expPriceDenom: "nhash",
+			expMarketID:   16_909_060,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var height int64
+			var assetDenom, priceDenom string
+			var marketID uint32
+			var err error
+			testFunc := func() {
+				height, assetDenom, priceDenom, marketID, err = keeper.ParseIndexKeyHeightToNAVRecord(tc.key)
+			}
+			require.NotPanics(t, testFunc, "ParseIndexKeyHeightToNAVRecord(%v)", tc.key)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseIndexKeyHeightToNAVRecord(%v) error", tc.key)
+			assert.Equal(t, tc.expHeight, height, "ParseIndexKeyHeightToNAVRecord(%v) height", tc.key)
+			assert.Equal(t, tc.expAssetDenom, assetDenom, "ParseIndexKeyHeightToNAVRecord(%v) asset denom", tc.key)
+			assert.Equal(t, tc.expPriceDenom, priceDenom, "ParseIndexKeyHeightToNAVRecord(%v) price denom", tc.key)
+			assert.Equal(t, tc.expMarketID, marketID, "ParseIndexKeyHeightToNAVRecord(%v) market id", tc.key)
+		})
+	}
+}
+
+func TestGetKeyPrefixScheduledFeeChange(t *testing.T) {
+	ktc := keyTestCase{
+		maker: func() []byte {
+			return keeper.GetKeyPrefixScheduledFeeChange()
+		},
+		expected: []byte{keeper.KeyTypeScheduledFeeChange},
+	}
+	checkKey(t, ktc, "GetKeyPrefixScheduledFeeChange()")
+}
+
+func TestGetKeyPrefixMarketScheduledFeeChange(t *testing.T) {
+	tests := []struct {
+		name     string
+		marketID uint32
+		expected []byte
+	}{
+		{
+			name:     "market 0",
+			marketID: 0,
+			expected: []byte{keeper.KeyTypeScheduledFeeChange, 0, 0, 0, 0},
+		},
+		{
+			name:     "market 1",
+			marketID: 1,
+			expected: []byte{keeper.KeyTypeScheduledFeeChange, 0, 0, 0, 1},
+		},
+		{
+			name:     "market 16,843,009",
+			marketID: 16_843_009,
+			expected: []byte{keeper.KeyTypeScheduledFeeChange, 1, 1, 1, 1},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetKeyPrefixMarketScheduledFeeChange(tc.marketID)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixScheduledFeeChange", value: keeper.GetKeyPrefixScheduledFeeChange()},
+				},
+			}
+			checkKey(t, ktc, "GetKeyPrefixMarketScheduledFeeChange(%d)", tc.marketID)
+		})
+	}
+}
+
+func TestMakeKeyScheduledFeeChange(t *testing.T) {
+	tests := []struct {
+		name     string
+		marketID uint32
+		sequence uint64
+		expected []byte
+	}{
+		{
+			name:     "market 1, sequence 0",
+			marketID: 1,
+			sequence: 0,
+			expected: []byte{keeper.KeyTypeScheduledFeeChange, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0},
+		},
+		{
+			name:     "market 16,843,009, sequence 578,437,695,752,307,201",
+			marketID: 16_843_009,
+			sequence: 578_437_695_752_307_201,
+			expected: []byte{keeper.KeyTypeScheduledFeeChange, 1, 1, 1, 1, 8, 7, 6, 5, 4, 3, 2, 1},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeKeyScheduledFeeChange(tc.marketID, tc.sequence)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixScheduledFeeChange", value: keeper.GetKeyPrefixScheduledFeeChange()},
+					{name: "GetKeyPrefixMarketScheduledFeeChange", value: keeper.GetKeyPrefixMarketScheduledFeeChange(tc.marketID)},
+				},
+			}
+			checkKey(t, ktc, "MakeKeyScheduledFeeChange(%d, %d)", tc.marketID, tc.sequence)
+		})
+	}
+}
+
+func TestParseKeySuffixScheduledFeeChange(t *testing.T) {
+	tests := []struct {
+		name        string
+		key         []byte
+		expSequence uint64
+		expOK       bool
+	}{
+		{
+			name:  "nil key",
+			key:   nil,
+			expOK: false,
+		},
+		{
+			name:  "7 bytes",
+			key:   []byte{0, 0, 0, 0, 0, 0, 1},
+			expOK: false,
+		},
+		{
+			name:        "8 bytes",
+			key:         []byte{0, 0, 0, 0, 0, 0, 0, 5},
+			expSequence: 5,
+			expOK:       true,
+		},
+		{
+			name:        "full key",
+			key:         keeper.MakeKeyScheduledFeeChange(7, 578_437_695_752_307_201),
+			expSequence: 578_437_695_752_307_201,
+			expOK:       true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var sequence uint64
+			var ok bool
+			testFunc := func() {
+				sequence, ok = keeper.ParseKeySuffixScheduledFeeChange(tc.key)
+			}
+			require.NotPanics(t, testFunc, "ParseKeySuffixScheduledFeeChange(%v)", tc.key)
+			assert.Equal(t, tc.expSequence, sequence, "ParseKeySuffixScheduledFeeChange(%v) sequence", tc.key)
+			assert.Equal(t, tc.expOK, ok, "ParseKeySuffixScheduledFeeChange(%v) ok", tc.key)
+		})
+	}
+}
+
+func TestGetKeyPrefixCommitments(t *testing.T) {
+	ktc := keyTestCase{
+		maker: func() []byte {
+			return keeper.GetKeyPrefixCommitments()
+		},
+		expected: []byte{keeper.KeyTypeCommitment},
+	}
+	checkKey(t, ktc, "GetKeyPrefixCommitments")
+
+}
+
+func TestGetKeyPrefixCommitmentsToMarket(t *testing.T) {
+	tests := []struct {
+		name     string
+		marketID uint32
+		expected []byte
+	}{
+		{
+			name:     "market id 0",
+			marketID: 0,
+			expected: []byte{keeper.KeyTypeCommitment, 0, 0, 0, 0},
+		},
+		{
+			name:     "market id 1",
+			marketID: 1,
+			expected: []byte{keeper.KeyTypeCommitment, 0, 0, 0, 1},
+		},
+		{
+			name:     "market id 255",
+			marketID: 255,
+			expected: []byte{keeper.KeyTypeCommitment, 0, 0, 0, 255},
+		},
+		{
+			name:     "market id 256",
+			marketID: 256,
+			expected: []byte{keeper.KeyTypeCommitment, 0, 0, 1, 0},
+		},
+		{
+			name:     "market id 65_536",
+			marketID: 65_536,
+			expected: []byte{keeper.KeyTypeCommitment, 0, 1, 0, 0},
+		},
+		{
+			name:     "market id 16,777,216",
+			marketID: 16_777_216,
+			expected: []byte{keeper.KeyTypeCommitment, 1, 0, 0, 0},
+		},
+		{
+			name:     "market id 16,843,009",
+			marketID: 16_843_009,
+			expected: []byte{keeper.KeyTypeCommitment, 1, 1, 1, 1},
+		},
+		{
+			name:     "market id 4,294,967,295",
+			marketID: 4_294_967_295,
+			expected: []byte{keeper.KeyTypeCommitment, 255, 255, 255, 255},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetKeyPrefixCommitmentsToMarket(tc.marketID)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixCommitments", value: keeper.GetKeyPrefixCommitments()},
+				},
+			}
+			checkKey(t, ktc, "GetKeyPrefixCommitmentsToMarket(%d)", tc.marketID)
+		})
+	}
+}
+
+func TestMakeKeyCommitment(t *testing.T) {
+	tests := []struct {
+		name     string
+		marketID uint32
+		addr     sdk.AccAddress
+		expected []byte
+		expPanic string
+	}{
+		{
+			name:     "nil addr",
+			addr:     nil,
+			expPanic: "empty address not allowed",
+		},
+		{
+			name:     "empty addr",
+			addr:     sdk.AccAddress{},
+			expPanic: "empty address not allowed",
+		},
+		{
+			name:     "256 byte addr",
+			addr:     bytes.Repeat([]byte{'p'}, 256),
+			expPanic: "address length should be max 255 bytes, got 256: unknown address",
+		},
+		{
+			name:     "market id 0 5 byte addr",
+			marketID: 0,
+			addr:     sdk.AccAddress("abcde"),
+			expected: append([]byte{keeper.KeyTypeCommitment, 0, 0, 0, 0, 5}, "abcde"...),
+		},
+		{
+			name:     "market id 0 20 byte addr",
+			marketID: 0,
+			addr:     sdk.AccAddress("abcdefghijklmnopqrst"),
+			expected: append([]byte{keeper.KeyTypeCommitment, 0, 0, 0, 0, 20}, "abcdefghijklmnopqrst"...),
+		},
+		{
+			name:     "market id 0 32 byte addr",
+			marketID: 0,
+			addr:     sdk.AccAddress("abcdefghijklmnopqrstuvwxyzABCDEF"),
+			expected: append([]byte{keeper.KeyTypeCommitment, 0, 0, 0, 0, 32}, "abcdefghijklmnopqrstuvwxyzABCDEF"...),
+		},
+		{
+			name:     "market id 1 20 byte addr",
+			marketID: 1,
+			addr:     sdk.AccAddress("abcdefghijklmnopqrst"),
+			expected: append([]byte{keeper.KeyTypeCommitment, 0, 0, 0, 1, 20}, "abcdefghijklmnopqrst"...),
+		},
+		{
+			name:     "market id 1 32 byte addr",
+			marketID: 1,
+			addr:     sdk.AccAddress("abcdefghijklmnopqrstuvwxyzABCDEF"),
+			expected: append([]byte{keeper.KeyTypeCommitment, 0, 0, 0, 1, 32}, "abcdefghijklmnopqrstuvwxyzABCDEF"...),
+		},
+		{
+			name:     "market id 16,843,009 20 byte addr",
+			marketID: 16_843_009,
+			addr:     sdk.AccAddress("abcdefghijklmnopqrst"),
+			expected: append([]byte{keeper.KeyTypeCommitment, 1, 1, 1, 1, 20}, "abcdefghijklmnopqrst"...),
+		},
+		{
+			name:     "market id 16,843,009 32 byte addr",
+			marketID: 16_843_009,
+			addr:     sdk.AccAddress("abcdefghijklmnopqrstuvwxyzABCDEF"),
+			expected: append([]byte{keeper.KeyTypeCommitment, 1, 1, 1, 1, 32}, "abcdefghijklmnopqrstuvwxyzABCDEF"...),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeKeyCommitment(tc.marketID, tc.addr)
+				},
+				expected: tc.expected,
+				expPanic: tc.expPanic,
+			}
+			if len(tc.expPanic) == 0 {
+				ktc.expPrefixes = []expectedPrefix{
+					{name: "GetKeyPrefixCommitments", value: keeper.GetKeyPrefixCommitments()},
+					{name: "GetKeyPrefixCommitmentsToMarket", value: keeper.GetKeyPrefixCommitmentsToMarket(tc.marketID)},
+				}
+			}
+			checkKey(t, ktc, "MakeKeyCommitment(%d, %s)", tc.marketID, tc.addr)
+		})
+	}
+}
+
+func TestParseKeyCommitment(t *testing.T) {
+	tests := []struct {
+		name        string
+		key         []byte
+		expMarketID uint32
+		expAddr     sdk.AccAddress
+		expErr      string
+	}{
+		{
+			name:   "nil",
+			key:    nil,
+			expErr: "cannot parse commitment key: only has 0 bytes, expected at least 7",
+		},
+		{
+			name:   "empty",
+			key:    []byte{},
+			expErr: "cannot parse commitment key: only has 0 bytes, expected at least 7",
+		},
+		{
+			name:   "6 bytes",
+			key:    []byte{keeper.KeyTypeCommitment, 2, 3, 4, 5, 6},
+			expErr: "cannot parse commitment key: only has 6 bytes, expected at least 7",
+		},
+		{
+			name:   "type byte one low",
+			key:    []byte{keeper.KeyTypeCommitment - 1, 2, 3, 4, 5, 6, 7},
+			expErr: "cannot parse commitment key: incorrect type byte 0x62",
+		},
+		{
+			name:   "type byte one high",
+			key:    []byte{keeper.KeyTypeCommitment + 1, 2, 3, 4, 5, 6, 7},
+			expErr: "cannot parse commitment key: incorrect type byte 0x64",
+		},
+		{
+			name:   "addr length byte zero",
+			key:    []byte{keeper.KeyTypeCommitment, 1, 2, 3, 4, 0, 7},
+			expErr: "cannot parse address from commitment key: length byte is zero",
+		},
+		{
+			name:   "addr length byte too large",
+			key:    []byte{keeper.KeyTypeCommitment, 1, 2, 3, 4, 6, 1, 2, 3, 4, 5},
+			expErr: "cannot parse address from commitment key: length byte is 6, but slice only has 5 left",
+		},
+		{
+			name:   "addr length byte too small",
+			key:    []byte{keeper.KeyTypeCommitment, 1, 2, 3, 4, 4, 1, 2, 3, 4, 5},
+			expErr: "cannot parse address from commitment key: found 1 bytes after address, expected 0",
+		},
+		{
+			name:        "market 1; 1 byte addr",
+			key:         []byte{keeper.KeyTypeCommitment, 0, 0, 0, 1, 1, 7},
+			expMarketID: 1,
+			expAddr:     sdk.AccAddress{7},
+		},
+		{
+			name: "market 2; 20 byte addr",
+			key: []byte{keeper.KeyTypeCommitment, 0, 0, 0, 2, 20,
+				1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20},
+			expMarketID: 2,
+			expAddr:     sdk.AccAddress{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20},
+		},
+		{
+			name: "market 67,305,985; 20 byte addr",
+			key: []byte{keeper.KeyTypeCommitment, 4, 3, 2, 1, 20,
+				20, 19, 18, 17, 16, 15, 14, 13, 12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1},
+			expMarketID: 67_305_985,
+			expAddr:     sdk.AccAddress{20, 19, 18, 17, 16, 15, 14, 13, 12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1},
+		},
+		{
+			name: "market 4,294,967,295; 32 byte addr",
+			key: []byte{keeper.KeyTypeCommitment, 255, 255, 255, 255, 32,
+				101, 102, 103, 104, 105, 106, 107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
+				117, 118, 119, 120, 121, 122, 123, 124, 125, 126, 127, 128, 129, 130, 131, 132,
+			},
+			expMarketID: 4_294_967_295,
+			expAddr: sdk.AccAddress{
+				101, 102, 103, 104, 105, 106, 107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
+				117, 118, 119, 120, 121, 122, 123, 124, 125, 126, 127, 128, 129, 130, 131, 132,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var marketID uint32
+			var addr sdk.AccAddress
+			var err error
+			testFunc := func() {
+				marketID, addr, err = keeper.ParseKeyCommitment(tc.key)
+			}
+			require.NotPanics(t, testFunc, "ParseKeyCommitment(%q)", tc.key)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseKeyCommitment(%q) error", tc.key)
+			assert.Equal(t, tc.expMarketID, marketID, "ParseKeyCommitment(%q) market id", tc.key)
+			assert.Equal(t, tc.expAddr, addr, "ParseKeyCommitment(%q) addr", tc.key)
+		})
+	}
+}
+
+func TestParseKeySuffixCommitment(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     []byte
+		expAddr sdk.AccAddress
+		expErr  string
+	}{
+		{
+			name:   "nil",
+			key:    nil,
+			expErr: "cannot parse address from commitment key: slice is empty",
+		},
+		{
+			name:   "empty",
+			key:    []byte{},
+			expErr: "cannot parse address from commitment key: slice is empty",
+		},
+		{
+			name:   "addr length byte zero",
+			key:    []byte{0},
+			expErr: "cannot parse address from commitment key: length byte is zero",
+		},
+		{
+			name:   "addr length byte too large",
+			key:    []byte{6, 1, 2, 3, 4, 5},
+			expErr: "cannot parse address from commitment key: length byte is 6, but slice only has 5 left",
+		},
+		{
+			name:   "addr length byte too small",
+			key:    []byte{4, 1, 2, 3, 4, 5},
+			expErr: "cannot parse address from commitment key: found 1 bytes after address, expected 0",
+		},
+		{
+			name:    "1 byte addr",
+			key:     []byte{1, 7},
+			expAddr: sdk.AccAddress{7},
+		},
+		{
+			name:    "20 byte addr",
+			key:     []byte{20, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20},
+			expAddr: sdk.AccAddress{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20},
+		},
+		{
+			name: "32 byte addr",
+			key: []byte{32,
+				101, 102, 103, 104, 105, 106, 107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
+				117, 118, 119, 120, 121, 122, 123, 124, 125, 126, 127, 128, 129, 130, 131, 132,
+			},
+			expAddr: sdk.AccAddress{
+				101, 102, 103, 104, 105, 106, 107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
+				117, 118, 119, 120, 121, 122, 123, 124, 125, 126, 127, 128, 129, 130, 131, 132,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var addr sdk.AccAddress
+			var err error
+			testFunc := func() {
+				addr, err = keeper.ParseKeySuffixCommitment(tc.key)
+			}
+			require.NotPanics(t, testFunc, "ParseKeySuffixCommitment(%q)", tc.key)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseKeySuffixCommitment(%q) error", tc.key)
+			assert.Equal(t, tc.expAddr, addr, "ParseKeySuffixCommitment(%q) addr", tc.key)
+		})
+	}
+}
+
+func TestMakeKeyCommitmentExpiration(t *testing.T) {
+	tests := []struct {
+		name     string
+		marketID uint32
+		addr     sdk.AccAddress
+		expected []byte
+		expPanic string
+	}{
+		{
+			name:     "nil addr",
+			addr:     nil,
+			expPanic: "empty address not allowed",
+		},
+		{
+			name:     "256 byte addr",
+			addr:     bytes.Repeat([]byte{'p'}, 256),
+			expPanic: "address length should be max 255 bytes, got 256: unknown address",
+		},
+		{
+			name:     "market id 1 20 byte addr",
+			marketID: 1,
+			addr:     sdk.AccAddress("abcdefghijklmnopqrst"),
+			expected: append([]byte{keeper.KeyTypeCommitmentExpiration, 0, 0, 0, 1, 20}, "abcdefghijklmnopqrst"...),
+		},
+		{
+			name:     "market id 16,843,009 32 byte addr",
+			marketID: 16_843_009,
+			addr:     sdk.AccAddress("abcdefghijklmnopqrstuvwxyzABCDEF"),
+			expected: append([]byte{keeper.KeyTypeCommitmentExpiration, 1, 1, 1, 1, 32}, "abcdefghijklmnopqrstuvwxyzABCDEF"...),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeKeyCommitmentExpiration(tc.marketID, tc.addr)
+				},
+				expected: tc.expected,
+				expPanic: tc.expPanic,
+			}
+			checkKey(t, ktc, "MakeKeyCommitmentExpiration(%d, %s)", tc.marketID, tc.addr)
+		})
+	}
+}
+
+func TestGetIndexKeyPrefixExpirationToCommitment(t *testing.T) {
+	ktc := keyTestCase{
+		maker: func() []byte {
+			return keeper.GetIndexKeyPrefixExpirationToCommitment()
+		},
+		expected: []byte{keeper.KeyTypeExpirationToCommitmentIndex},
+	}
+	checkKey(t, ktc, "GetIndexKeyPrefixExpirationToCommitment")
+}
+
+func TestGetIndexKeyPrefixExpirationToCommitmentAt(t *testing.T) {
+	tests := []struct {
+		name       string
+		expiration time.Time
+		expected   []byte
+	}{
+		{
+			name:       "one second after epoch",
+			expiration: time.Unix(1, 0),
+			expected:   []byte{keeper.KeyTypeExpirationToCommitmentIndex, 0, 0, 0, 0, 0, 0, 0, 1},
+		},
+		{
+			name:       "sub-second part is ignored",
+			expiration: time.Unix(1, 999_999_999),
+			expected:   []byte{keeper.KeyTypeExpirationToCommitmentIndex, 0, 0, 0, 0, 0, 0, 0, 1},
+		},
+		{
+			name:       "2030-01-01 in a different time zone",
+			expiration: time.Date(2029, 12, 31, 18, 0, 0, 0, time.FixedZone("CST", -6*60*60)),
+			expected:   []byte{keeper.KeyTypeExpirationToCommitmentIndex, 0, 0, 0, 0, 0x70, 0xdb, 0xd8, 0x80},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetIndexKeyPrefixExpirationToCommitmentAt(tc.expiration)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetIndexKeyPrefixExpirationToCommitment", value: keeper.GetIndexKeyPrefixExpirationToCommitment()},
+				},
+			}
+			checkKey(t, ktc, "GetIndexKeyPrefixExpirationToCommitmentAt(%s)", tc.expiration)
+		})
+	}
+}
+
+func TestMakeIndexKeyExpirationToCommitment(t *testing.T) {
+	tests := []struct {
+		name       string
+		expiration time.Time
+		marketID   uint32
+		addr       sdk.AccAddress
+		expected   []byte
+		expPanic   string
+	}{
+		{
+			name:       "nil addr",
+			expiration: time.Unix(1, 0),
+			addr:       nil,
+			expPanic:   "empty address not allowed",
+		},
+		{
+			name:       "one second after epoch, market 1, 5 byte addr",
+			expiration: time.Unix(1, 0),
+			marketID:   1,
+			addr:       sdk.AccAddress("abcde"),
+			expected: append([]byte{keeper.KeyTypeExpirationToCommitmentIndex,
+				0, 0, 0, 0, 0, 0, 0, 1,
+				0, 0, 0, 1, 5}, "abcde"...),
+		},
+		{
+			name:       "2030-01-01, market 16,843,009, 20 byte addr",
+			expiration: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+			marketID:   16_843_009,
+			addr:       sdk.AccAddress("abcdefghijklmnopqrst"),
+			expected: append([]byte{keeper.KeyTypeExpirationToCommitmentIndex,
+				0, 0, 0, 0, 0x70, 0xdb, 0xd8, 0x80,
+				1, 1, 1, 1, 20}, "abcdefghijklmnopqrst"...),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeIndexKeyExpirationToCommitment(tc.expiration, tc.marketID, tc.addr)
+				},
+				expected: tc.expected,
+				expPanic: tc.expPanic,
+			}
+			if len(tc.expPanic) == 0 {
+				ktc.expPrefixes = []expectedPrefix{
+					{name: "GetIndexKeyPrefixExpirationToCommitment", value: keeper.GetIndexKeyPrefixExpirationToCommitment()},
+					{name: "GetIndexKeyPrefixExpirationToCommitmentAt", value: keeper.GetIndexKeyPrefixExpirationToCommitmentAt(tc.expiration)},
+				}
+			}
+			checkKey(t, ktc, "MakeIndexKeyExpirationToCommitment(%s, %d, %s)", tc.expiration, tc.marketID, tc.addr)
+		})
+	}
+}
+
+func TestParseIndexKeyExpirationToCommitment(t *testing.T) {
+	expiration := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	addr := sdk.AccAddress("abcdefghijklmnopqrst")
+
+	tests := []struct {
+		name          string
+		key           []byte
+		expExpiration time.Time
+		expMarketID   uint32
+		expAddr       sdk.AccAddress
+		expErr        string
+	}{
+		{
+			name:   "nil key",
+			key:    nil,
+			expErr: "cannot parse expiration to commitment key: only has 0 bytes, expected at least 15",
+		},
+		{
+			name:   "14 bytes",
+			key:    []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14},
+			expErr: "cannot parse expiration to commitment key: only has 14 bytes, expected at least 15",
+		},
+		{
+			name:   "wrong type byte",
+			key:    []byte{keeper.KeyTypeExpirationToOrderIndex, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 1, 1, 'a'},
+			expErr: "cannot parse expiration to commitment key: unknown type byte 0xa, expected 0x13",
+		},
+		{
+			name:   "address length too long",
+			key:    []byte{keeper.KeyTypeExpirationToCommitmentIndex, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 1, 2, 'a'},
+			expErr: "cannot parse address from expiration to commitment key: length byte is 2, but slice only has 1 left",
+		},
+		{
+			name:   "extra bytes after address",
+			key:    []byte{keeper.KeyTypeExpirationToCommitmentIndex, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 1, 1, 'a', 'b'},
+			expErr: "cannot parse address from expiration to commitment key: found 1 bytes after address, expected 0",
+		},
+		{
+			name:          "good key",
+			key:           keeper.MakeIndexKeyExpirationToCommitment(expiration, 16_843_009, addr),
+			expExpiration: expiration,
+			expMarketID:   16_843_009,
+			expAddr:       addr,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actExpiration time.Time
+			var marketID uint32
+			var actAddr sdk.AccAddress
+			var err error
+			testFunc := func() {
+				actExpiration, marketID, actAddr, err = keeper.ParseIndexKeyExpirationToCommitment(tc.key)
+			}
+			require.NotPanics(t, testFunc, "ParseIndexKeyExpirationToCommitment(%v)", tc.key)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseIndexKeyExpirationToCommitment(%v) error", tc.key)
+			assert.Equal(t, tc.expExpiration, actExpiration, "ParseIndexKeyExpirationToCommitment(%v) expiration", tc.key)
+			assert.Equal(t, tc.expMarketID, marketID, "ParseIndexKeyExpirationToCommitment(%v) market id", tc.key)
+			assert.Equal(t, tc.expAddr, actAddr, "ParseIndexKeyExpirationToCommitment(%v) addr", tc.key)
+		})
+	}
+}
+
+func TestGetKeyPrefixCommitmentRewardPools(t *testing.T) {
+	ktc := keyTestCase{
+		maker: func() []byte {
+			return keeper.GetKeyPrefixCommitmentRewardPools()
+		},
+		expected: []byte{keeper.KeyTypeCommitmentRewardPool},
+	}
+	checkKey(t, ktc, "GetKeyPrefixCommitmentRewardPools")
+}
+
+func TestMakeKeyCommitmentRewardPool(t *testing.T) {
+	tests := []struct {
+		name     string
+		marketID uint32
+		expected []byte
+	}{
+		{
+			name:     "market id 0",
+			marketID: 0,
+			expected: []byte{keeper.KeyTypeCommitmentRewardPool, 0, 0, 0, 0},
+		},
+		{
+			name:     "market id 1",
+			marketID: 1,
+			expected: []byte{keeper.KeyTypeCommitmentRewardPool, 0, 0, 0, 1},
+		},
+		{
+			name:     "market id 16,843,009",
+			marketID: 16_843_009,
+			expected: []byte{keeper.KeyTypeCommitmentRewardPool, 1, 1, 1, 1},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeKeyCommitmentRewardPool(tc.marketID)
+				},
+				expected: tc.expected,
+			}
+			checkKey(t, ktc, "MakeKeyCommitmentRewardPool(%d)", tc.marketID)
+		})
+	}
+}
+
+func TestGetKeyPrefixMarketAuthorityTransfers(t *testing.T) {
+	ktc := keyTestCase{
+		maker: func() []byte {
+			return keeper.GetKeyPrefixMarketAuthorityTransfers()
+		},
+		expected: []byte{keeper.KeyTypeMarketAuthorityTransfer},
+	}
+	checkKey(t, ktc, "GetKeyPrefixMarketAuthorityTransfers")
+}
+
+func TestMakeKeyMarketAuthorityTransfer(t *testing.T) {
+	tests := []struct {
+		name     string
+		marketID uint32
+		expected []byte
+	}{
+		{
+			name:     "market id 0",
+			marketID: 0,
+			expected: []byte{keeper.KeyTypeMarketAuthorityTransfer, 0, 0, 0, 0},
+		},
+		{
+			name:     "market id 1",
+			marketID: 1,
+			expected: []byte{keeper.KeyTypeMarketAuthorityTransfer, 0, 0, 0, 1},
+		},
+		{
+			name:     "market id 16,843,009",
+			marketID: 16_843_009,
+			expected: []byte{keeper.KeyTypeMarketAuthorityTransfer, 1, 1, 1, 1},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeKeyMarketAuthorityTransfer(tc.marketID)
+				},
+				expected: tc.expected,
+			}
+			checkKey(t, ktc, "MakeKeyMarketAuthorityTransfer(%d)", tc.marketID)
+		})
+	}
+}
+
+func TestParseKeyCommitmentRewardPool(t *testing.T) {
+	tests := []struct {
+		name        string
+		key         []byte
+		expMarketID uint32
+		expErr      string
+	}{
+		{
+			name:   "nil key",
+			key:    nil,
+			expErr: "cannot parse commitment reward pool key: has 0 bytes, expected 5",
+		},
+		{
+			name:   "6 bytes",
+			key:    []byte{keeper.KeyTypeCommitmentRewardPool, 0, 0, 0, 1, 2},
+			expErr: "cannot parse commitment reward pool key: has 6 bytes, expected 5",
+		},
+		{
+			name:   "wrong type byte",
+			key:    []byte{keeper.KeyTypeCommitmentReward, 0, 0, 0, 1},
+			expErr: "cannot parse commitment reward pool key: incorrect type byte 0x15",
+		},
+		{
+			name:        "good key",
+			key:         keeper.MakeKeyCommitmentRewardPool(16_843_009),
+			expMarketID: 16_843_009,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var marketID uint32
+			var err error
+			testFunc := func() {
+				marketID, err = keeper.ParseKeyCommitmentRewardPool(tc.key)
+			}
+			require.NotPanics(t, testFunc, "ParseKeyCommitmentRewardPool(%v)", tc.key)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseKeyCommitmentRewardPool(%v) error", tc.key)
+			assert.Equal(t, tc.expMarketID, marketID, "ParseKeyCommitmentRewardPool(%v) market id", tc.key)
+		})
+	}
+}
+
+func TestGetKeyPrefixCommitmentRewards(t *testing.T) {
+	ktc := keyTestCase{
+		maker: func() []byte {
+			return keeper.GetKeyPrefixCommitmentRewards()
+		},
+		expected: []byte{keeper.KeyTypeCommitmentReward},
+	}
+	checkKey(t, ktc, "GetKeyPrefixCommitmentRewards")
+}
+
+func TestGetKeyPrefixCommitmentRewardsForAddr(t *testing.T) {
+	tests := []struct {
+		name     string
+		addr     sdk.AccAddress
+		expected []byte
+		expPanic string
+	}{
+		{
+			name:     "nil addr",
+			addr:     nil,
+			expPanic: "empty address not allowed",
+		},
+		{
+			name:     "256 byte addr",
+			addr:     bytes.Repeat([]byte{'p'}, 256),
+			expPanic: "address length should be max 255 bytes, got 256: unknown address",
+		},
+		{
+			name:     "20 byte addr",
+			addr:     sdk.AccAddress("abcdefghijklmnopqrst"),
+			expected: append([]byte{keeper.KeyTypeCommitmentReward, 20}, "abcdefghijklmnopqrst"...),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetKeyPrefixCommitmentRewardsForAddr(tc.addr)
+				},
+				expected: tc.expected,
+				expPanic: tc.expPanic,
+			}
+			checkKey(t, ktc, "GetKeyPrefixCommitmentRewardsForAddr(%s)", tc.addr)
+		})
+	}
+}
+
+func TestMakeKeyCommitmentReward(t *testing.T) {
+	tests := []struct {
+		name     string
+		addr     sdk.AccAddress
+		marketID uint32
+		expected []byte
+		expPanic string
+	}{
+		{
+			name:     "nil addr",
+			addr:     nil,
+			expPanic: "empty address not allowed",
+		},
+		{
+			name:     "256 byte addr",
+			addr:     bytes.Repeat([]byte{'p'}, 256),
+			expPanic: "address length should be max 255 bytes, got 256: unknown address",
+		},
+		{
+			name:     "20 byte addr market id 1",
+			addr:     sdk.AccAddress("abcdefghijklmnopqrst"),
+			marketID: 1,
+			expected: concatBz([]byte{keeper.KeyTypeCommitmentReward, 20}, []byte("abcdefghijklmnopqrst"), []byte{0, 0, 0, 1}),
+		},
+		{
+			name:     "32 byte addr market id 16,843,009",
+			addr:     sdk.AccAddress("abcdefghijklmnopqrstuvwxyzABCDEF"),
+			marketID: 16_843_009,
+			expected: concatBz([]byte{keeper.KeyTypeCommitmentReward, 32}, []byte("abcdefghijklmnopqrstuvwxyzABCDEF"), []byte{1, 1, 1, 1}),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeKeyCommitmentReward(tc.addr, tc.marketID)
+				},
+				expected: tc.expected,
+				expPanic: tc.expPanic,
+			}
+			checkKey(t, ktc, "MakeKeyCommitmentReward(%s, %d)", tc.addr, tc.marketID)
+		})
+	}
+}
+
+func TestParseKeyCommitmentReward(t *testing.T) {
+	addr := sdk.AccAddress("abcdefghijklmnopqrst")
+
+	tests := []struct {
+		name        string
+		key         []byte
+		expAddr     sdk.AccAddress
+		expMarketID uint32
+		expErr      string
+	}{
+		{
+			name:   "nil key",
+			key:    nil,
+			expErr: "cannot parse commitment reward key: only has 0 bytes, expected at least 7",
+		},
+		{
+			name:   "wrong type byte",
+			key:    []byte{keeper.KeyTypeCommitment, 1, 'a', 0, 0, 0, 1},
+			expErr: "cannot parse commitment reward key: incorrect type byte 0x63",
+		},
+		{
+			name:   "address length too long",
+			key:    []byte{keeper.KeyTypeCommitmentReward, 7, 'a', 0, 0, 0, 1},
+			expErr: "cannot parse address from commitment reward key: length byte is 7, but slice only has 5 left",
+		},
+		{
+			name:   "too few bytes after address",
+			key:    []byte{keeper.KeyTypeCommitmentReward, 2, 'a', 'b', 0, 0, 1},
+			expErr: "cannot parse market id from commitment reward key: found 3 bytes after address, expected 4",
+		},
+		{
+			name:   "too many bytes after address",
+			key:    []byte{keeper.KeyTypeCommitmentReward, 1, 'a', 0, 0, 0, 0, 1},
+			expErr: "cannot parse market id from commitment reward key: found 5 bytes after address, expected 4",
+		},
+		{
+			name:        "good key",
+			key:         keeper.MakeKeyCommitmentReward(addr, 16_843_009),
+			expAddr:     addr,
+			expMarketID: 16_843_009,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actAddr sdk.AccAddress
+			var marketID uint32
+			var err error
+			testFunc := func() {
+				actAddr, marketID, err = keeper.ParseKeyCommitmentReward(tc.key)
+			}
+			require.NotPanics(t, testFunc, "ParseKeyCommitmentReward(%v)", tc.key)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseKeyCommitmentReward(%v) error", tc.key)
+			assert.Equal(t, tc.expAddr, actAddr, "ParseKeyCommitmentReward(%v) addr", tc.key)
+			assert.Equal(t, tc.expMarketID, marketID, "ParseKeyCommitmentReward(%v) market id", tc.key)
+		})
+	}
+}
+
+func TestGetKeyPrefixCommitmentAccruals(t *testing.T) {
+	ktc := keyTestCase{
+		maker: func() []byte {
+			return keeper.GetKeyPrefixCommitmentAccruals()
+		},
+		expected: []byte{keeper.KeyTypeCommitmentAccrual},
+	}
+	checkKey(t, ktc, "GetKeyPrefixCommitmentAccruals")
+}
+
+func TestGetKeyPrefixCommitmentAccrualsInMarket(t *testing.T) {
+	tests := []struct {
+		name     string
+		marketID uint32
+		expected []byte
+	}{
+		{
+			name:     "market id 0",
+			marketID: 0,
+			expected: []byte{keeper.KeyTypeCommitmentAccrual, 0, 0, 0, 0},
+		},
+		{
+			name:     "market id 1",
+			marketID: 1,
+			expected: []byte{keeper.KeyTypeCommitmentAccrual, 0, 0, 0, 1},
+		},
+		{
+			name:     "market id 16,843,009",
+			marketID: 16_843_009,
+			expected: []byte{keeper.KeyTypeCommitmentAccrual, 1, 1, 1, 1},
+		},
+		{
+			name:     "market id 4,294,967,295",
+			marketID: 4_294_967_295,
+			expected: []byte{keeper.KeyTypeCommitmentAccrual, 255, 255, 255, 255},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetKeyPrefixCommitmentAccrualsInMarket(tc.marketID)
+				},
+				expected: tc.expected,
+			}
+			checkKey(t, ktc, "GetKeyPrefixCommitmentAccrualsInMarket(%d)", tc.marketID)
+		})
+	}
+}
+
+func TestMakeKeyCommitmentAccrual(t *testing.T) {
+	tests := []struct {
+		name     string
+		marketID uint32
+		addr     sdk.AccAddress
+		expected []byte
+		expPanic string
+	}{
+		{
+			name:     "nil addr",
+			addr:     nil,
+			expPanic: "empty address not allowed",
+		},
+		{
+			name:     "256 byte addr",
+			addr:     bytes.Repeat([]byte{'p'}, 256),
+			expPanic: "address length should be max 255 bytes, got 256: unknown address",
+		},
+		{
+			name:     "market id 1 20 byte addr",
+			marketID: 1,
+			addr:     sdk.AccAddress("abcdefghijklmnopqrst"),
+			expected: append([]byte{keeper.KeyTypeCommitmentAccrual, 0, 0, 0, 1, 20}, "abcdefghijklmnopqrst"...),
+		},
+		{
+			name:     "market id 16,843,009 32 byte addr",
+			marketID: 16_843_009,
+			addr:     sdk.AccAddress("abcdefghijklmnopqrstuvwxyzABCDEF"),
+			expected: append([]byte{keeper.KeyTypeCommitmentAccrual, 1, 1, 1, 1, 32}, "abcdefghijklmnopqrstuvwxyzABCDEF"...),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeKeyCommitmentAccrual(tc.marketID, tc.addr)
+				},
+				expected: tc.expected,
+				expPanic: tc.expPanic,
+			}
+			checkKey(t, ktc, "MakeKeyCommitmentAccrual(%d, %s)", tc.marketID, tc.addr)
+		})
+	}
+}
+
+func TestParseKeyCommitmentAccrual(t *testing.T) {
+	addr := sdk.AccAddress("abcdefghijklmnopqrst")
+
+	tests := []struct {
+		name        string
+		key         []byte
+		expMarketID uint32
+		expAddr     sdk.AccAddress
+		expErr      string
+	}{
+		{
+			name:   "nil key",
+			key:    nil,
+			expErr: "cannot parse commitment accrual key: only has 0 bytes, expected at least 7",
+		},
+		{
+			name:   "wrong type byte",
+			key:    []byte{keeper.KeyTypeCommitment, 0, 0, 0, 1, 1, 'a'},
+			expErr: "cannot parse commitment accrual key: incorrect type byte 0x63",
+		},
+		{
+			name:   "address length too long",
+			key:    []byte{keeper.KeyTypeCommitmentAccrual, 0, 0, 0, 1, 7, 'a'},
+			expErr: "cannot parse address from commitment accrual key: length byte is 7, but slice only has 1 left",
+		},
+		{
+			name:   "extra bytes after address",
+			key:    []byte{keeper.KeyTypeCommitmentAccrual, 0, 0, 0, 1, 1, 'a', 'b'},
+			expErr: "cannot parse address from commitment accrual key: found 1 bytes after address, expected 0",
+		},
+		{
+			name:        "good key",
+			key:         keeper.MakeKeyCommitmentAccrual(16_843_009, addr),
+			expMarketID: 16_843_009,
+			expAddr:     addr,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var marketID uint32
+			var actAddr sdk.AccAddress
+			var err error
+			testFunc := func() {
+				marketID, actAddr, err = keeper.ParseKeyCommitmentAccrual(tc.key)
+			}
+			require.NotPanics(t, testFunc, "ParseKeyCommitmentAccrual(%v)", tc.key)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseKeyCommitmentAccrual(%v) error", tc.key)
+			assert.Equal(t, tc.expMarketID, marketID, "ParseKeyCommitmentAccrual(%v) market id", tc.key)
+			assert.Equal(t, tc.expAddr, actAddr, "ParseKeyCommitmentAccrual(%v) addr", tc.key)
+		})
+	}
+}
+
+func TestGetIndexKeyPrefixExpirationToAccessGrant(t *testing.T) {
+	ktc := keyTestCase{
+		maker: func() []byte {
+			return keeper.GetIndexKeyPrefixExpirationToAccessGrant()
+		},
+		expected: []byte{keeper.KeyTypeExpirationToAccessGrantIndex},
+	}
+	checkKey(t, ktc, "GetIndexKeyPrefixExpirationToAccessGrant")
+}
+
+func TestGetIndexKeyPrefixExpirationToAccessGrantAt(t *testing.T) {
+	tests := []struct {
+		name       string
+		expiration time.Time
+		expected   []byte
+	}{
+		{
+			name:       "one second after epoch",
+			expiration: time.Unix(1, 0),
+			expected:   []byte{keeper.KeyTypeExpirationToAccessGrantIndex, 0, 0, 0, 0, 0, 0, 0, 1},
+		},
+		{
+			name:       "sub-second part is ignored",
+			expiration: time.Unix(1, 999_999_999),
+			expected:   []byte{keeper.KeyTypeExpirationToAccessGrantIndex, 0, 0, 0, 0, 0, 0, 0, 1},
+		},
+		{
+			name:       "2030-01-01 in a different time zone",
+			expiration: time.Date(2029, 12, 31, 18, 0, 0, 0, time.FixedZone("CST", -6*60*60)),
+			expected:   []byte{keeper.KeyTypeExpirationToAccessGrantIndex, 0, 0, 0, 0, 0x70, 0xdb, 0xd8, 0x80},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetIndexKeyPrefixExpirationToAccessGrantAt(tc.expiration)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetIndexKeyPrefixExpirationToAccessGrant", value: keeper.GetIndexKeyPrefixExpirationToAccessGrant()},
+				},
+			}
+			checkKey(t, ktc, "GetIndexKeyPrefixExpirationToAccessGrantAt(%s)", tc.expiration)
+		})
+	}
+}
+
+func TestMakeIndexKeyExpirationToAccessGrant(t *testing.T) {
+	tests := []struct {
+		name       string
+		expiration time.Time
+		marketID   uint32
+		addr       sdk.AccAddress
+		expected   []byte
+		expPanic   string
+	}{
+		{
+			name:       "nil addr",
+			expiration: time.Unix(1, 0),
+			addr:       nil,
+			expPanic:   "empty address not allowed",
+		},
+		{
+			name:       "one second after epoch, market 1, 5 byte addr",
+			expiration: time.Unix(1, 0),
+			marketID:   1,
+			addr:       sdk.AccAddress("abcde"),
+			expected: append([]byte{keeper.KeyTypeExpirationToAccessGrantIndex,
+				0, 0, 0, 0, 0, 0, 0, 1,
+				0, 0, 0, 1, 5}, "abcde"...),
+		},
+		{
+			name:       "2030-01-01, market 16,843,009, 20 byte addr",
+			expiration: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+			marketID:   16_843_009,
+			addr:       sdk.AccAddress("abcdefghijklmnopqrst"),
+			expected: append([]byte{keeper.KeyTypeExpirationToAccessGrantIndex,
+				0, 0, 0, 0, 0x70, 0xdb, 0xd8, 0x80,
+				1, 1, 1, 1, 20}, "abcdefghijklmnopqrst"...),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeIndexKeyExpirationToAccessGrant(tc.expiration, tc.marketID, tc.addr)
+				},
+				expected: tc.expected,
+				expPanic: tc.expPanic,
+			}
+			if len(tc.expPanic) == 0 {
+				ktc.expPrefixes = []expectedPrefix{
+					{name: "GetIndexKeyPrefixExpirationToAccessGrant", value: keeper.GetIndexKeyPrefixExpirationToAccessGrant()},
+					{name: "GetIndexKeyPrefixExpirationToAccessGrantAt", value: keeper.GetIndexKeyPrefixExpirationToAccessGrantAt(tc.expiration)},
+				}
+			}
+			checkKey(t, ktc, "MakeIndexKeyExpirationToAccessGrant(%s, %d, %s)", tc.expiration, tc.marketID, tc.addr)
+		})
+	}
+}
+
+func TestParseIndexKeyExpirationToAccessGrant(t *testing.T) {
+	expiration := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	addr := sdk.AccAddress("abcdefghijklmnopqrst")
+
+	tests := []struct {
+		name          string
+		key           []byte
+		expExpiration time.Time
+		expMarketID   uint32
+		expAddr       sdk.AccAddress
+		expErr        string
+	}{
+		{
+			name:   "nil key",
+			key:    nil,
+			expErr: "cannot parse expiration to access grant key: only has 0 bytes, expected at least 15",
+		},
+		{
+			name:   "14 bytes",
+			key:    []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14},
+			expErr: "cannot parse expiration to access grant key: only has 14 bytes, expected at least 15",
+		},
+		{
+			name:   "wrong type byte",
+			key:    []byte{keeper.KeyTypeExpirationToOrderIndex, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 1, 1, 'a'},
+			expErr: "cannot parse expiration to access grant key: unknown type byte 0xa, expected 0x16",
+		},
+		{
+			name:   "address length too long",
+			key:    []byte{keeper.KeyTypeExpirationToAccessGrantIndex, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 1, 2, 'a'},
+			expErr: "cannot parse address from expiration to access grant key: length byte is 2, but slice only has 1 left",
+		},
+		{
+			name:   "extra bytes after address",
+			key:    []byte{keeper.KeyTypeExpirationToAccessGrantIndex, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 1, 1, 'a', 'b'},
+			expErr: "cannot parse address from expiration to access grant key: found 1 bytes after address, expected 0",
+		},
+		{
+			name:          "good key",
+			key:           keeper.MakeIndexKeyExpirationToAccessGrant(expiration, 16_843_009, addr),
+			expExpiration: expiration,
+			expMarketID:   16_843_009,
+			expAddr:       addr,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actExpiration time.Time
+			var marketID uint32
+			var actAddr sdk.AccAddress
+			var err error
+			testFunc := func() {
+				actExpiration, marketID, actAddr, err = keeper.ParseIndexKeyExpirationToAccessGrant(tc.key)
+			}
+			require.NotPanics(t, testFunc, "ParseIndexKeyExpirationToAccessGrant(%v)", tc.key)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseIndexKeyExpirationToAccessGrant(%v) error", tc.key)
+			assert.Equal(t, tc.expExpiration, actExpiration, "ParseIndexKeyExpirationToAccessGrant(%v) expiration", tc.key)
+			assert.Equal(t, tc.expMarketID, marketID, "ParseIndexKeyExpirationToAccessGrant(%v) market id", tc.key)
+			assert.Equal(t, tc.expAddr, actAddr, "ParseIndexKeyExpirationToAccessGrant(%v) addr", tc.key)
+		})
+	}
+}
+
+func TestGetKeyPrefixAllPayments(t *testing.T) {
+	ktc := keyTestCase{
+		maker:    keeper.GetKeyPrefixAllPayments,
+		expected: []byte{keeper.KeyTypePayment},
+	}
+	checkKey(t, ktc, "GetKeyPrefixAllPayments()")
+}
+
+func TestGetKeyPrefixPaymentsForSource(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   sdk.AccAddress
+		expected []byte
+		expPanic string
+	}{
+		{
+			name:     "nil source",
+			source:   nil,
+			expPanic: "empty source address not allowed",
+		},
+		{
+			name:     "empty source",
+			source:   sdk.AccAddress{},
+			expPanic: "empty source address not allowed",
+		},
+		{
+			name: "20 byte source",
+			source: sdk.AccAddress{
+				0, 1, 2, 3, 4, 5, 6, 7, 8, 9,
+				10, 11, 12, 13, 14, 15, 16, 17, 18, 19,
+			},
+			expected: []byte{keeper.KeyTypePayment, 20,
+				0, 1, 2, 3, 4, 5, 6, 7, 8, 9,
+				10, 11, 12, 13, 14, 15, 16, 17, 18, 19,
+			},
+		},
+		{
+			name: "32 byte source",
+			source: sdk.AccAddress{
+				51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64, 65, 66,
+				67, 68, 69, 70, 71, 72, 73, 74, 75, 76, 77, 78, 79, 80, 81, 82,
+			},
+			expected: []byte{keeper.KeyTypePayment, 32,
+				51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64, 65, 66,
+				67, 68, 69, 70, 71, 72, 73, 74, 75, 76, 77, 78, 79, 80, 81, 82,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetKeyPrefixPaymentsForSource(tc.source)
+				},
+				expected: tc.expected,
+				expPanic: tc.expPanic,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixAllPayments", value: keeper.GetKeyPrefixAllPayments()},
+				},
+			}
+			checkKey(t, ktc, "GetKeyPrefixPaymentsForSource(%v)", tc.source)
+		})
+	}
+}
+
+func TestMakeKeyPayment(t *testing.T) {
+	tests := []struct {
+		name       string
+		source     sdk.AccAddress
+		externalID string
+		expected   []byte
+		expPanic   string
+	}{
+		{
+			name:     "nil source",
+			source:   nil,
+			expPanic: "empty source address not allowed",
+		},
+		{
+			name:     "empty source",
+			source:   sdk.AccAddress{},
+			expPanic: "empty source address not allowed",
+		},
+		{
+			name: "20 byte source, empty external id",
+			source: sdk.AccAddress{
+				0, 1, 2, 3, 4, 5, 6, 7, 8, 9,
+				10, 11, 12, 13, 14, 15, 16, 17, 18, 19,
+			},
+			externalID: "",
+			expected: []byte{keeper.KeyTypePayment, 20,
+				0, 1, 2, 3, 4, 5, 6, 7, 8, 9,
+				10, 11, 12, 13, 14, 15, 16, 17, 18, 19,
+			},
+		},
+		{
+			name: "20 byte source, empty external id",
+			source: sdk.AccAddress{
+				0, 1, 2, 3, 4, 5, 6, 7, 8, 9,
+				10, 11, 12, 13, 14, 15, 16, 17, 18, 19,
+			},
+			externalID: "",
+			expected: []byte{keeper.KeyTypePayment, 20,
+				0, 1, 2, 3, 4, 5, 6, 7, 8, 9,
+				10, 11, 12, 13, 14, 15, 16, 17, 18, 19,
+			},
+		},
+		{
+			name: "32 byte source, empty external id",
+			source: sdk.AccAddress{
+				51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64, 65, 66,
+				67, 68, 69, 70, 71, 72, 73, 74, 75, 76, 77, 78, 79, 80, 81, 82,
+			},
+			externalID: "my-eid",
+			expected: concatBz(
+				[]byte{keeper.KeyTypePayment, 32,
+					51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64, 65, 66,
+					67, 68, 69, 70, 71, 72, 73, 74, 75, 76, 77, 78, 79, 80, 81, 82,
+				},
+				[]byte("my-eid"),
+			),
+		},
+		{
+			name: "32 byte source, with external id",
+			source: sdk.AccAddress{
+				51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64, 65, 66,
+				67, 68, 69, 70, 71, 72, 73, 74, 75, 76, 77, 78, 79, 80, 81, 82,
+			},
+			externalID: "just-some-6f584be7-debd-479b-ae5b-779f36a0bc20-id",
+			expected: concatBz(
+				[]byte{keeper.KeyTypePayment, 32,
+					51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64, 65, 66,
+					67, 68, 69, 70, 71, 72, 73, 74, 75, 76, 77, 78, 79, 80, 81, 82,
+				},
+				[]byte("just-some-6f584be7-debd-479b-ae5b-779f36a0bc20-id"),
+			),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeKeyPayment(tc.source, tc.externalID)
+				},
+				expected: tc.expected,
+				expPanic: tc.expPanic,
+			}
+			if len(tc.expPanic) == 0 {
+				ktc.expPrefixes = []expectedPrefix{
+					{name: "GetKeyPrefixAllPayments", value: keeper.GetKeyPrefixAllPayments()},
+					{name: "GetKeyPrefixPaymentsForSource", value: keeper.GetKeyPrefixPaymentsForSource(tc.source)},
+				}
+			}
+			checkKey(t, ktc, "MakeKeyPayment(%v, %q)", tc.source, tc.externalID)
+		})
+	}
+}
+
+func TestParseKeyPayment(t *testing.T) {
+	tests := []struct {
+		name          string
+		key           []byte
+		expSource     sdk.AccAddress
+		expExternalID string
+		expErr        string
+	}{
+		{
+			name:   "nil key",
+			key:    nil,
+			expErr: "cannot parse payment key: only has 0 bytes, expected at least 3",
+		},
+		{
+			name:   "empty key",
+			key:    []byte{},
+			expErr: "cannot parse payment key: only has 0 bytes, expected at least 3",
+		},
+		{
+			name:   "1 byte key",
+			key:    []byte{1},
+			expErr: "cannot parse payment key: only has 1 bytes, expected at least 3",
+		},
+		{
+			name:   "2 byte key",
+			key:    []byte{1, 2},
+			expErr: "cannot parse payment key: only has 2 bytes, expected at least 3",
+		},
+		{
+			name:   "wrong type byte",
+			key:    []byte{0xaa, 5, 0, 1, 2, 3, 4},
+			expErr: "cannot parse payment key: incorrect type byte 0xaa, expected 0x70",
+		},
+		{
+			name:   "wrong source length",
+			key:    []byte{keeper.KeyTypePayment, 5, 0, 1, 2, 3},
+			expErr: "cannot parse payment key: invalid source: length byte is 5, but slice only has 4 left",
+		},
+		{
+			name:          "okay: with external id",
+			key:           concatBz([]byte{keeper.KeyTypePayment, 5, 0, 1, 2, 3, 4}, []byte("my id")),
+			expSource:     sdk.AccAddress{0, 1, 2, 3, 4},
+			expExternalID: "my id",
+		},
+		{
+			name:          "okay: empty external id",
+			key:           []byte{keeper.KeyTypePayment, 5, 0, 1, 2, 3, 4},
+			expSource:     sdk.AccAddress{0, 1, 2, 3, 4},
+			expExternalID: "",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var source sdk.AccAddress
+			var externalID string
+			var err error
+			testFunc := func() {
+				source, externalID, err = keeper.ParseKeyPayment(tc.key)
+			}
+			require.NotPanics(t, testFunc, "ParseKeyPayment(%v)", tc.key)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseKeyPayment(%v) error", tc.key)
+			assert.Equal(t, tc.expSource, source, "ParseKeyPayment(%v) source", tc.key)
+			assert.Equal(t, tc.expExternalID, externalID, "ParseKeyPayment(%v) externalID", tc.key)
+		})
+	}
+}
+
+func TestParseKeySuffixPayment(t *testing.T) {
+	tests := []struct {
+		name          string
+		key           []byte
+		expSource     sdk.AccAddress
+		expExternalID string
+		expErr        string
+	}{
+		{
+			name:   "nil suffix",
+			key:    nil,
+			expErr: "cannot parse payment key: invalid source: slice is empty",
+		},
+		{
+			name:   "empty suffix",
+			key:    []byte{},
+			expErr: "cannot parse payment key: invalid source: slice is empty",
+		},
+		{
+			name:   "wrong source length",
+			key:    []byte{5, 0, 1, 2, 3},
+			expErr: "cannot parse payment key: invalid source: length byte is 5, but slice only has 4 left",
+		},
+		{
+			name:          "okay: with external id",
+			key:           concatBz([]byte{5, 0, 1, 2, 3, 4}, []byte("my id")),
+			expSource:     sdk.AccAddress{0, 1, 2, 3, 4},
+			expExternalID: "my id",
+		},
+		{
+			name:          "okay: empty external id",
+			key:           []byte{5, 0, 1, 2, 3, 4},
+			expSource:     sdk.AccAddress{0, 1, 2, 3, 4},
+			expExternalID: "",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var source sdk.AccAddress
+			var externalID string
+			var err error
+			testFunc := func() {
+				source, externalID, err = keeper.ParseKeySuffixPayment(tc.key)
+			}
+			require.NotPanics(t, testFunc, "ParseKeySuffixPayment(%v)", tc.key)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseKeySuffixPayment(%v) error", tc.key)
+			assert.Equal(t, tc.expSource, source, "ParseKeySuffixPayment(%v) source", tc.key)
+			assert.Equal(t, tc.expExternalID, externalID, "ParseKeySuffixPayment(%v) externalID", tc.key)
+		})
+	}
+}
+
+func TestGetIndexKeyPrefixTargetToPayments(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   sdk.AccAddress
+		expected []byte
+		expPanic string
+	}{
+		{
+			name:     "nil target",
+			target:   nil,
+			expPanic: "empty target address not allowed",
+		},
+		{
+			name:     "empty target",
+			target:   sdk.AccAddress{},
+			expPanic: "empty target address not allowed",
+		},
+		{
+			name:     "1 byte target",
+			target:   sdk.AccAddress{1},
+			expected: []byte{keeper.KeyTypeTargetToPaymentIndex, 1, 1},
+		},
+		{
+			name: "20 byte target",
+			target: sdk.AccAddress{
+				1, 2, 3, 4, 5, 6, 7, 8, 9, 10,
+				11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
+			},
+			expected: []byte{keeper.KeyTypeTargetToPaymentIndex, 20,
+				1, 2, 3, 4, 5, 6, 7, 8, 9, 10,
+				11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
+			},
+		},
+		{
+			name: "32 byte target",
+			target: sdk.AccAddress{
+				152, 154, 156, 158, 160, 162, 164, 166, 168, 170, 172, 174, 176, 178, 180, 182,
+				184, 186, 188, 190, 192, 194, 196, 198, 200, 202, 204, 206, 208, 210, 212, 214,
+			},
+			expected: []byte{keeper.KeyTypeTargetToPaymentIndex, 32,
+				152, 154, 156, 158, 160, 162, 164, 166, 168, 170, 172, 174, 176, 178, 180, 182,
+				184, 186, 188, 190, 192, 194, 196, 198, 200, 202, 204, 206, 208, 210, 212, 214,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetIndexKeyPrefixTargetToPayments(tc.target)
+				},
+				expected: tc.expected,
+				expPanic: tc.expPanic,
+			}
+			checkKey(t, ktc, "GetIndexKeyPrefixTargetToPayments(%v)", tc.target)
+		})
+	}
+}
+
+func TestGetIndexKeyPrefixTargetToPaymentsForSource(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   sdk.AccAddress
+		source   sdk.AccAddress
+		expected []byte
+		expPanic string
+	}{
+		{
+			name:     "nil source and target",
+			target:   nil,
+			source:   nil,
+			expPanic: "empty source address not allowed",
+		},
+		{
+			name:     "empty source and target",
+			target:   sdk.AccAddress{},
+			source:   sdk.AccAddress{},
+			expPanic: "empty source address not allowed",
+		},
+		{
+			name:     "nil source",
+			target:   sdk.AccAddress{1, 2, 3},
+			source:   nil,
+			expPanic: "empty source address not allowed",
+		},
+		{
+			name:     "empty source",
+			target:   sdk.AccAddress{1, 2, 3},
+			source:   sdk.AccAddress{},
+			expPanic: "empty source address not allowed",
+		},
+		{
+			name:     "nil target",
+			target:   nil,
+			source:   sdk.AccAddress{1, 2, 3},
+			expPanic: "empty target address not allowed",
+		},
+		{
+			name:     "empty target",
+			target:   sdk.AccAddress{},
+			source:   sdk.AccAddress{1, 2, 3},
+			expPanic: "empty target address not allowed",
+		},
+		{
+			name: "20 byte target and source",
+			target: sdk.AccAddress{
+				0, 5, 10, 15, 20, 25, 30, 35, 40, 45,
+				50, 55, 60, 65, 70, 75, 80, 85, 90, 95,
+			},
+			source: sdk.AccAddress{
+				51, 53, 55, 57, 59, 61, 63, 65, 67, 69,
+				71, 73, 75, 77, 79, 81, 83, 85, 87, 89,
+			},
+			expected: []byte{keeper.KeyTypeTargetToPaymentIndex,
+				20, 0, 5, 10, 15, 20, 25, 30, 35, 40, 45,
+				50, 55, 60, 65, 70, 75, 80, 85, 90, 95,
+				20, 51, 53, 55, 57, 59, 61, 63, 65, 67, 69,
+				71, 73, 75, 77, 79, 81, 83, 85, 87, 89,
+			},
+		},
+		{
+			name: "32 byte target and source",
+			target: sdk.AccAddress{
+				197, 162, 106, 22, 183, 29, 50, 134, 204, 155, 36, 64, 15, 1, 78, 120,
+				211, 43, 169, 85, 127, 113, 190, 92, 71, 218, 176, 99, 8, 148, 57, 141,
+			},
+			source: sdk.AccAddress{
+				178, 17, 3, 73, 206, 129, 150, 136, 108, 45, 199, 59, 213, 94, 143, 31,
+				164, 101, 52, 24, 220, 80, 10, 122, 38, 157, 115, 66, 185, 171, 87, 192,
+			},
+			expected: []byte{keeper.KeyTypeTargetToPaymentIndex,
+				32, 197, 162, 106, 22, 183, 29, 50, 134, 204, 155, 36, 64, 15, 1, 78, 120,
+				211, 43, 169, 85, 127, 113, 190, 92, 71, 218, 176, 99, 8, 148, 57, 141,
+				32, 178, 17, 3, 73, 206, 129, 150, 136, 108, 45, 199, 59, 213, 94, 143, 31,
+				164, 101, 52, 24, 220, 80, 10, 122, 38, 157, 115, 66, 185, 171, 87, 192,
+			},
+		},
+		{
+			name:   "5 byte target and 10 byte source",
+			target: sdk.AccAddress{37, 44, 133, 148, 222},
+			source: sdk.AccAddress{77, 239, 153, 70, 130, 115, 169, 117, 39, 151},
+			expected: []byte{keeper.KeyTypeTargetToPaymentIndex,
+				5, 37, 44, 133, 148, 222,
+				10, 77, 239, 153, 70, 130, 115, 169, 117, 39, 151,
+			},
+		},
+		{
+			name:   "10 byte target and 5 byte source",
+			target: sdk.AccAddress{163, 126, 189, 116, 202, 218, 38, 74, 146, 90},
+			source: sdk.AccAddress{121, 88, 212, 152, 171},
+			expected: []byte{keeper.KeyTypeTargetToPaymentIndex,
+				10, 163, 126, 189, 116, 202, 218, 38, 74, 146, 90,
+				5, 121, 88, 212, 152, 171,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetIndexKeyPrefixTargetToPaymentsForSource(tc.target, tc.source)
+				},
+				expected: tc.expected,
+				expPanic: tc.expPanic,
+			}
+			if len(tc.expPanic) == 0 {
+				ktc.expPrefixes = []expectedPrefix{
+					{name: "GetIndexKeyPrefixTargetToPayments", value: keeper.GetIndexKeyPrefixTargetToPayments(tc.target)},
+				}
+			}
+			checkKey(t, ktc, "GetIndexKeyPrefixTargetToPaymentsForSource(%v, %v)", tc.target, tc.source)
+		})
+	}
+}
+
+func TestMakeIndexKeyTargetToPayment(t *testing.T) {
+	tests := []struct {
+		name       string
+		target     sdk.AccAddress
+		source     sdk.AccAddress
+		externalID string
+		expected   []byte
+		expPanic   string
+	}{
+		{
+			name:       "nil target",
+			target:     nil,
+			source:     sdk.AccAddress{1, 2, 3},
+			externalID: "abc",
+			expPanic:   "empty target address not allowed",
+		},
+		{
+			name:       "empty target",
+			target:     sdk.AccAddress{},
+			source:     sdk.AccAddress{1, 2, 3},
+			externalID: "abc",
+			expPanic:   "empty target address not allowed",
+		},
+		{
+			name:       "nil source",
+			target:     sdk.AccAddress{1, 2, 3},
+			source:     nil,
+			externalID: "abc",
+			expPanic:   "empty source address not allowed",
+		},
+		{
+			name:       "empty source",
+			target:     sdk.AccAddress{1, 2, 3},
+			source:     sdk.AccAddress{},
+			externalID: "abc",
+			expPanic:   "empty source address not allowed",
+		},
+		{
+			name: "20 byte addrs, empty external id",
+			target: sdk.AccAddress{
+				0, 5, 10, 15, 20, 25, 30, 35, 40, 45,
+				50, 55, 60, 65, 70, 75, 80, 85, 90, 95,
+			},
+			source: sdk.AccAddress{
+				51, 53, 55, 57, 59, 61, 63, 65, 67, 69,
+				71, 73, 75, 77, 79, 81, 83, 85, 87, 89,
+			},
+			externalID: "",
+			expected: []byte{keeper.KeyTypeTargetToPaymentIndex,
+				20, 0, 5, 10, 15, 20, 25, 30, 35, 40, 45,
+				50, 55, 60, 65, 70, 75, 80, 85, 90, 95,
+				20, 51, 53, 55, 57, 59, 61, 63, 65, 67, 69,
+				71, 73, 75, 77, 79, 81, 83, 85, 87, 89,
+			},
+		},
+		{
+			name: "20 byte addrs, 10 byte external id",
+			target: sdk.AccAddress{
+				50, 55, 60, 65, 70, 75, 80, 85, 90, 95,
+				0, 5, 10, 15, 20, 25, 30, 35, 40, 45,
+			},
+			source: sdk.AccAddress{
+				71, 73, 75, 77, 79, 81, 83, 85, 87, 89,
+				51, 53, 55, 57, 59, 61, 63, 65, 67, 69,
+			},
+			externalID: "abcdefghij",
+			expected: concatBz(
+				[]byte{keeper.KeyTypeTargetToPaymentIndex,
+					20, 50, 55, 60, 65, 70, 75, 80, 85, 90, 95,
+					0, 5, 10, 15, 20, 25, 30, 35, 40, 45,
+					20, 71, 73, 75, 77, 79, 81, 83, 85, 87, 89,
+					51, 53, 55, 57, 59, 61, 63, 65, 67, 69,
+				},
+				[]byte("abcdefghij"),
+			),
+		},
+		{
+			name: "32 byte addrs, no external id",
+			target: sdk.AccAddress{
+				197, 162, 106, 22, 183, 29, 50, 134, 204, 155, 36, 64, 15, 1, 78, 120,
+				211, 43, 169, 85, 127, 113, 190, 92, 71, 218, 176, 99, 8, 148, 57, 141,
+			},
+			source: sdk.AccAddress{
+				178, 17, 3, 73, 206, 129, 150, 136, 108, 45, 199, 59, 213, 94, 143, 31,
+				164, 101, 52, 24, 220, 80, 10, 122, 38, 157, 115, 66, 185, 171, 87, 192,
+			},
+			externalID: "",
+			expected: []byte{keeper.KeyTypeTargetToPaymentIndex,
+				32, 197, 162, 106, 22, 183, 29, 50, 134, 204, 155, 36, 64, 15, 1, 78, 120,
+				211, 43, 169, 85, 127, 113, 190, 92, 71, 218, 176, 99, 8, 148, 57, 141,
+				32, 178, 17, 3, 73, 206, 129, 150, 136, 108, 45, 199, 59, 213, 94, 143, 31,
+				164, 101, 52, 24, 220, 80, 10, 122, 38, 157, 115, 66, 185, 171, 87, 192,
+			},
+		},
+		{
+			name: "32 byte addrs, 100 byte external id",
+			target: sdk.AccAddress{
+				211, 43, 169, 85, 127, 113, 190, 92, 71, 218, 176, 99, 8, 148, 57, 141,
+				197, 162, 106, 22, 183, 29, 50, 134, 204, 155, 36, 64, 15, 1, 78, 120,
+			},
+			source: sdk.AccAddress{
+				164, 101, 52, 24, 220, 80, 10, 122, 38, 157, 115, 66, 185, 171, 87, 192,
+				178, 17, 3, 73, 206, 129, 150, 136, 108, 45, 199, 59, 213, 94, 143, 31,
+			},
+			externalID: strings.Repeat("prov", 25),
+			expected: concatBz(
+				[]byte{keeper.KeyTypeTargetToPaymentIndex,
+					32, 211, 43, 169, 85, 127, 113, 190, 92, 71, 218, 176, 99, 8, 148, 57, 141,
+					197, 162, 106, 22, 183, 29, 50, 134, 204, 155, 36, 64, 15, 1, 78, 120,
+					32, 164, 101, 52, 24, 220, 80, 10, 122, 38, 157, 115, 66, 185, 171, 87, 192,
+					178, 17, 3, 73, 206, 129, 150, 136, 108, 45, 199, 59, 213, 94, 143, 31,
+				},
+				bytes.Repeat([]byte("prov"), 25),
+			),
+		},
+		{
+			name:       "5 byte target, 10 byte source, 15 byte external id",
+			target:     sdk.AccAddress{119, 120, 199, 30, 126},
+			source:     sdk.AccAddress{125, 135, 144, 240, 156, 163, 157, 130, 158, 68},
+			externalID: string([]byte{137, 78, 56, 142, 173, 94, 178, 166, 16, 109, 96, 24, 31, 218, 17}),
+			expected: []byte{keeper.KeyTypeTargetToPaymentIndex,
+				5, 119, 120, 199, 30, 126,
+				10, 125, 135, 144, 240, 156, 163, 157, 130, 158, 68,
+				137, 78, 56, 142, 173, 94, 178, 166, 16, 109, 96, 24, 31, 218, 17,
+			},
+		},
+		{
+			name:       "10 byte target, 5 byte source, 3 byte external id",
+			target:     sdk.AccAddress{17, 154, 138, 160, 186, 42, 91, 212, 23, 190},
+			source:     sdk.AccAddress{93, 172, 201, 243, 165},
+			externalID: string([]byte{169, 167, 148}),
+			expected: []byte{
+				keeper.KeyTypeTargetToPaymentIndex,
+				10, 17, 154, 138, 160, 186, 42, 91, 212, 23, 190,
+				5, 93, 172, 201, 243, 165,
+				169, 167, 148,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeIndexKeyTargetToPayment(tc.target, tc.source, tc.externalID)
+				},
+				expected: tc.expected,
+				expPanic: tc.expPanic,
+			}
+			if len(tc.expPanic) == 0 {
+				ktc.expPrefixes = []expectedPrefix{
+					{
+						name:  "GetIndexKeyPrefixTargetToPayments",
+						value: keeper.GetIndexKeyPrefixTargetToPayments(tc.target),
+					},
+					{
+						name:  "GetIndexKeyPrefixTargetToPaymentsForSource",
+						value: keeper.GetIndexKeyPrefixTargetToPaymentsForSource(tc.target, tc.source),
+					},
+				}
+			}
+			checkKey(t, ktc, "MakeIndexKeyTargetToPayment(%v, %v, %q)", tc.target, tc.source, tc.externalID)
+		})
+	}
+}
+
+func TestParseIndexKeyTargetToPayment(t *testing.T) {
+	tests := []struct {
+		name          string
+		key           []byte
+		expTarget     sdk.AccAddress
+		expSource     sdk.AccAddress
+		expExternalID string
+		expErr        string
+	}{
+		{
+			name:   "nil",
+			key:    nil,
+			expErr: "cannot parse target to payment index key: only has 0 bytes, expected at least 5",
+		},
+		{
+			name:   "empty",
+			key:    []byte{},
+			expErr: "cannot parse target to payment index key: only has 0 bytes, expected at least 5",
+		},
+		{
+			name:   "4 bytes",
+			key:    []byte{1, 2, 3, 4},
+			expErr: "cannot parse target to payment index key: only has 4 bytes, expected at least 5",
+		},
+		{
+			name:   "wrong type byte",
+			key:    []byte{1, 2, 3, 4, 5},
+			expErr: "cannot parse target to payment index key: incorrect type byte 0x1, expected 0x10",
+		},
+		{
+			name:   "target has length zero",
+			key:    []byte{keeper.KeyTypeTargetToPaymentIndex, 0, 5, 1, 2, 3, 4, 5},
+			expErr: "cannot parse target to payment index key: invalid target: length byte is zero",
+		},
+		{
+			name:   "source has length zero",
+			key:    []byte{keeper.KeyTypeTargetToPaymentIndex, 5, 1, 2, 3, 4, 5, 0},
+			expErr: "cannot parse target to payment index key: invalid source: length byte is zero",
+		},
+		{
+			name:          "external id has length zero",
+			key:           []byte{keeper.KeyTypeTargetToPaymentIndex, 3, 1, 2, 3, 4, 11, 12, 13, 14},
+			expTarget:     sdk.AccAddress{1, 2, 3},
+			expSource:     sdk.AccAddress{11, 12, 13, 14},
+			expExternalID: "",
+		},
+		{
+			name: "external id has content",
+			key: []byte{keeper.KeyTypeTargetToPaymentIndex,
+				32, 247, 208, 145, 192, 91, 18, 179, 34, 96, 28, 40, 70, 225, 125, 71, 10,
+				255, 84, 50, 68, 245, 118, 123, 33, 104, 75, 12, 58, 168, 177, 107, 205,
+				20, 117, 169, 6, 140, 176, 237, 220, 171, 100, 213,
+				235, 204, 250, 195, 114, 59, 122, 67, 44, 222,
+				89, 97, 121, 32, 80, 114, 111, 118, 101, 110, 97, 110, 99, 101, 33,
+			},
+			expTarget: sdk.AccAddress{
+				247, 208, 145, 192, 91, 18, 179, 34, 96, 28, 40, 70, 225, 125, 71, 10,
+				255, 84, 50, 68, 245, 118, 123, 33, 104, 75, 12, 58, 168, 177, 107, 205,
+			},
+			expSource: sdk.AccAddress{
+				117, 169, 6, 140, 176, 237, 220, 171, 100, 213,
+				235, 204, 250, 195, 114, 59, 122, 67, 44, 222,
+			},
+			expExternalID: "Yay Provenance!",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var target, source sdk.AccAddress
+			var externalID string
+			var err error
+			testFunc := func() {
+				target, source, externalID, err = keeper.ParseIndexKeyTargetToPayment(tc.key)
+			}
+			require.NotPanics(t, testFunc, "ParseIndexKeyTargetToPayment(%v)", tc.key)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseIndexKeyTargetToPayment(%v) error", tc.key)
+			assert.Equal(t, tc.expTarget, target, "ParseIndexKeyTargetToPayment(%v) target", tc.key)
+			assert.Equal(t, tc.expSource, source, "ParseIndexKeyTargetToPayment(%v) source", tc.key)
+			assert.Equal(t, tc.expExternalID, externalID, "ParseIndexKeyTargetToPayment(%v) external id", tc.key)
+		})
+	}
+}
+
+func TestParseIndexKeySuffixTargetToPayment(t *testing.T) {
+	tests := []struct {
+		name          string
+		key           []byte
+		expSource     sdk.AccAddress
+		expExternalID string
+		expErr        string
+	}{
+		{
+			name:   "nil",
+			key:    nil,
+			expErr: "cannot parse target to payment index key: invalid source: slice is empty",
+		},
+		{
+			name:   "empty",
+			key:    []byte{},
+			expErr: "cannot parse target to payment index key: invalid source: slice is empty",
+		},
+		{
+			name:   "zero byte source",
+			key:    []byte{0},
+			expErr: "cannot parse target to payment index key: invalid source: length byte is zero",
+		},
+		{
+			name:          "empty external id",
+			key:           []byte{5, 10, 15, 20, 25, 30},
+			expSource:     sdk.AccAddress{10, 15, 20, 25, 30},
+			expExternalID: "",
+		},
+		{
+			name: "20 byte addr, 36 byte external id",
+			key: concatBz(
+				[]byte{20,
+					129, 181, 145, 146, 65, 87, 128, 45, 51, 95,
+					170, 10, 156, 100, 72, 231, 74, 130, 252, 186,
+				},
+				[]byte("229C4877-B1Eb-4069-B9AC-3D64F92F250F"),
+			),
+			expSource: sdk.AccAddress{
+				129, 181, 145, 146, 65, 87, 128, 45, 51, 95,
+				170, 10, 156, 100, 72, 231, 74, 130, 252, 186,
+			},
+			expExternalID: "229C4877-B1Eb-4069-B9AC-3D64F92F250F",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var source sdk.AccAddress
+			var externalID string
+			var err error
+			testFunc := func() {
+				source, externalID, err = keeper.ParseIndexKeySuffixTargetToPayment(tc.key)
+			}
+			require.NotPanics(t, testFunc, "ParseIndexKeySuffixTargetToPayment(%v)", tc.key)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseIndexKeySuffixTargetToPayment(%v) error", tc.key)
+			assert.Equal(t, tc.expSource, source, "ParseIndexKeySuffixTargetToPayment(%v) source", tc.key)
+			assert.Equal(t, tc.expExternalID, externalID, "ParseIndexKeySuffixTargetToPayment(%v) external id", tc.key)
+		})
+	}
+}
+
+func TestGetIndexKeyPrefixExpirationToPayment(t *testing.T) {
+	ktc := keyTestCase{
+		maker: func() []byte {
+			return keeper.GetIndexKeyPrefixExpirationToPayment()
+		},
+		expected: []byte{keeper.KeyTypeExpirationToPaymentIndex},
+	}
+	checkKey(t, ktc, "GetIndexKeyPrefixExpirationToPayment")
+}
+
+func TestGetIndexKeyPrefixExpirationToPaymentAt(t *testing.T) {
+	tests := []struct {
+		name       string
+		expiration time.Time
+		expected   []byte
+	}{
+		{
+			name:       "one second after epoch",
+			expiration: time.Unix(1, 0),
+			expected:   []byte{keeper.KeyTypeExpirationToPaymentIndex, 0, 0, 0, 0, 0, 0, 0, 1},
 		},
 		{
-			name:    "20 byte addr order 72,623,859,790,382,856",
-			addr:    sdk.AccAddress("ABCDEFGHIJKLMNOPQRST"),
-			orderID: 72_623_859_790_382_856,
-			expected: concatBz(
-				[]byte{keeper.KeyTypeAddressToOrderIndex, 20},
-				[]byte("ABCDEFGHIJKLMNOPQRST"),
-				[]byte{1, 2, 3, 4, 5, 6, 7, 8},
-			),
+			name:       "sub-second part is ignored",
+			expiration: time.Unix(1, 999_999_999),
+			expected:   []byte{keeper.KeyTypeExpirationToPaymentIndex, 0, 0, 0, 0, 0, 0, 0, 1},
+		},
+		{
+			name:       "2030-01-01 in a different time zone",
+			expiration: time.Date(2029, 12, 31, 18, 0, 0, 0, time.FixedZone("CST", -6*60*60)),
+			expected:   []byte{keeper.KeyTypeExpirationToPaymentIndex, 0, 0, 0, 0, 0x70, 0xdb, 0xd8, 0x80},
 		},
 	}
 
@@ -3856,195 +9781,438 @@ func TestMakeIndexKeyAddressToOrder(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			ktc := keyTestCase{
 				maker: func() []byte {
-					return keeper.MakeIndexKeyAddressToOrder(tc.addr, tc.orderID)
+					return keeper.GetIndexKeyPrefixExpirationToPaymentAt(tc.expiration)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetIndexKeyPrefixExpirationToPayment", value: keeper.GetIndexKeyPrefixExpirationToPayment()},
+				},
+			}
+			checkKey(t, ktc, "GetIndexKeyPrefixExpirationToPaymentAt(%s)", tc.expiration)
+		})
+	}
+}
+
+func TestMakeIndexKeyExpirationToPayment(t *testing.T) {
+	tests := []struct {
+		name       string
+		expiration time.Time
+		source     sdk.AccAddress
+		externalID string
+		expected   []byte
+		expPanic   string
+	}{
+		{
+			name:       "nil source",
+			expiration: time.Unix(1, 0),
+			source:     nil,
+			expPanic:   "empty source address not allowed",
+		},
+		{
+			name:       "one second after epoch, 5 byte source, no external id",
+			expiration: time.Unix(1, 0),
+			source:     sdk.AccAddress("abcde"),
+			expected: append([]byte{keeper.KeyTypeExpirationToPaymentIndex,
+				0, 0, 0, 0, 0, 0, 0, 1, 5}, "abcde"...),
+		},
+		{
+			name:       "2030-01-01, 20 byte source, with external id",
+			expiration: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+			source:     sdk.AccAddress("abcdefghijklmnopqrst"),
+			externalID: "some-id",
+			expected: append([]byte{keeper.KeyTypeExpirationToPaymentIndex,
+				0, 0, 0, 0, 0x70, 0xdb, 0xd8, 0x80, 20}, "abcdefghijklmnopqrstsome-id"...),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeIndexKeyExpirationToPayment(tc.expiration, tc.source, tc.externalID)
 				},
 				expected: tc.expected,
 				expPanic: tc.expPanic,
 			}
 			if len(tc.expPanic) == 0 {
 				ktc.expPrefixes = []expectedPrefix{
-					{name: "", value: keeper.GetIndexKeyPrefixAddressToOrder(tc.addr)},
+					{name: "GetIndexKeyPrefixExpirationToPayment", value: keeper.GetIndexKeyPrefixExpirationToPayment()},
+					{name: "GetIndexKeyPrefixExpirationToPaymentAt", value: keeper.GetIndexKeyPrefixExpirationToPaymentAt(tc.expiration)},
 				}
 			}
-			checkKey(t, ktc, "MakeIndexKeyAddressToOrder(%s, %d)", string(tc.addr), tc.orderID)
+			checkKey(t, ktc, "MakeIndexKeyExpirationToPayment(%s, %s, %q)", tc.expiration, tc.source, tc.externalID)
 		})
 	}
 }
 
-func TestParseIndexKeyAddressToOrder(t *testing.T) {
+func TestParseIndexKeyExpirationToPayment(t *testing.T) {
+	expiration := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	source := sdk.AccAddress("abcdefghijklmnopqrst")
+
 	tests := []struct {
-		name       string
-		key        []byte
-		expAddr    sdk.AccAddress
-		expOrderID uint64
-		expErr     string
+		name          string
+		key           []byte
+		expExpiration time.Time
+		expSource     sdk.AccAddress
+		expExternalID string
+		expErr        string
 	}{
 		{
 			name:   "nil key",
 			key:    nil,
-			expErr: "cannot parse address to order index key: only has 0 bytes, expected at least 8",
+			expErr: "cannot parse expiration to payment key: only has 0 bytes, expected at least 11",
 		},
 		{
-			name:   "empty key",
-			key:    []byte{},
-			expErr: "cannot parse address to order index key: only has 0 bytes, expected at least 8",
+			name:   "10 bytes",
+			key:    []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+			expErr: "cannot parse expiration to payment key: only has 10 bytes, expected at least 11",
 		},
 		{
-			name:   "7 bytes",
-			key:    []byte{1, 2, 3, 4, 5, 6, 7},
-			expErr: "cannot parse address to order index key: only has 7 bytes, expected at least 8",
+			name:   "wrong type byte",
+			key:    []byte{keeper.KeyTypeExpirationToOrderIndex, 0, 0, 0, 0, 0, 0, 0, 1, 1, 'a'},
+			expErr: "cannot parse expiration to payment key: unknown type byte 0xa, expected 0x18",
 		},
 		{
-			name:       "just order id 1",
-			key:        []byte{0, 0, 0, 0, 0, 0, 0, 1},
-			expOrderID: 1,
+			name:   "source length too long",
+			key:    []byte{keeper.KeyTypeExpirationToPaymentIndex, 0, 0, 0, 0, 0, 0, 0, 1, 2, 'a'},
+			expErr: "cannot parse source from expiration to payment key: length byte is 2, but slice only has 1 left",
 		},
 		{
-			name:       "just order id 72,623,859,790,382,856",
-			key:        []byte{1, 2, 3, 4, 5, 6, 7, 8},
-			expOrderID: 72_623_859_790_382_856,
+			name:          "good key without external id",
+			key:           keeper.MakeIndexKeyExpirationToPayment(expiration, source, ""),
+			expExpiration: expiration,
+			expSource:     source,
+			expExternalID: "",
 		},
 		{
-			name:   "9 bytes",
-			key:    []byte{1, 2, 3, 4, 5, 6, 7, 8, 9},
-			expErr: "cannot parse address to order index key: unable to determine address from single byte 0x1",
+			name:          "good key with external id",
+			key:           keeper.MakeIndexKeyExpirationToPayment(expiration, source, "some-id"),
+			expExpiration: expiration,
+			expSource:     source,
+			expExternalID: "some-id",
 		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actExpiration time.Time
+			var actSource sdk.AccAddress
+			var actExternalID string
+			var err error
+			testFunc := func() {
+				actExpiration, actSource, actExternalID, err = keeper.ParseIndexKeyExpirationToPayment(tc.key)
+			}
+			require.NotPanics(t, testFunc, "ParseIndexKeyExpirationToPayment(%v)", tc.key)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseIndexKeyExpirationToPayment(%v) error", tc.key)
+			assert.Equal(t, tc.expExpiration, actExpiration, "ParseIndexKeyExpirationToPayment(%v) expiration", tc.key)
+			assert.Equal(t, tc.expSource, actSource, "ParseIndexKeyExpirationToPayment(%v) source", tc.key)
+			assert.Equal(t, tc.expExternalID, actExternalID, "ParseIndexKeyExpirationToPayment(%v) external id", tc.key)
+		})
+	}
+}
+
+func TestGetKeyPrefixAllRecurringPayments(t *testing.T) {
+	ktc := keyTestCase{
+		maker:    keeper.GetKeyPrefixAllRecurringPayments,
+		expected: []byte{keeper.KeyTypeRecurringPayment},
+	}
+	checkKey(t, ktc, "GetKeyPrefixAllRecurringPayments()")
+}
+
+func TestGetKeyPrefixRecurringPaymentsForSource(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   sdk.AccAddress
+		expected []byte
+		expPanic string
+	}{
 		{
-			name:       "1 byte address order id 72,623,859,790,382,856",
-			key:        []byte{1, 55, 1, 2, 3, 4, 5, 6, 7, 8},
-			expAddr:    sdk.AccAddress{55},
-			expOrderID: 72_623_859_790_382_856,
+			name:     "nil source",
+			source:   nil,
+			expPanic: "empty source address not allowed",
 		},
 		{
-			name:   "length byte 2 but only 1 byte after it",
-			key:    []byte{2, 55, 1, 2, 3, 4, 5, 6, 7, 8},
-			expErr: "cannot parse address to order index key: unable to determine address from [2, 55, ...(length 2)]",
+			name:     "empty source",
+			source:   sdk.AccAddress{},
+			expPanic: "empty source address not allowed",
 		},
 		{
-			name:   "length byte 2 but 3 bytes after it",
-			key:    []byte{2, 55, 56, 57, 1, 2, 3, 4, 5, 6, 7, 8},
-			expErr: "cannot parse address to order index key: unable to determine address from [2, 55, ...(length 4)]",
+			name:     "5 byte source",
+			source:   sdk.AccAddress("abcde"),
+			expected: append([]byte{keeper.KeyTypeRecurringPayment, 5}, "abcde"...),
 		},
 		{
-			name:       "length byte 4 order id 72,623,859,790,382,856",
-			key:        []byte{4, 55, 56, 57, 58, 1, 2, 3, 4, 5, 6, 7, 8},
-			expAddr:    sdk.AccAddress{55, 56, 57, 58},
-			expOrderID: 72_623_859_790_382_856,
+			name:     "20 byte source",
+			source:   sdk.AccAddress("abcdefghijklmnopqrst"),
+			expected: append([]byte{keeper.KeyTypeRecurringPayment, 20}, "abcdefghijklmnopqrst"...),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetKeyPrefixRecurringPaymentsForSource(tc.source)
+				},
+				expected: tc.expected,
+				expPanic: tc.expPanic,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixAllRecurringPayments", value: keeper.GetKeyPrefixAllRecurringPayments()},
+				},
+			}
+			checkKey(t, ktc, "GetKeyPrefixRecurringPaymentsForSource(%v)", tc.source)
+		})
+	}
+}
+
+func TestMakeKeyRecurringPayment(t *testing.T) {
+	tests := []struct {
+		name       string
+		source     sdk.AccAddress
+		externalID string
+		expected   []byte
+		expPanic   string
+	}{
+		{
+			name:     "nil source",
+			source:   nil,
+			expPanic: "empty source address not allowed",
 		},
 		{
-			name: "length byte 20 but only 19 byte after it",
-			key: []byte{20, 101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
-				111, 112, 113, 114, 115, 116, 117, 118, 119,
-				1, 2, 3, 4, 5, 6, 7, 8},
-			expErr: "cannot parse address to order index key: unable to determine address from [20, 101, ...(length 20)]",
+			name:       "20 byte source, empty external id",
+			source:     sdk.AccAddress("abcdefghijklmnopqrst"),
+			externalID: "",
+			expected:   append([]byte{keeper.KeyTypeRecurringPayment, 20}, "abcdefghijklmnopqrst"...),
 		},
 		{
-			name: "length byte 20 but 21 byte after it",
-			key: []byte{20, 101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
-				111, 112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
-				1, 2, 3, 4, 5, 6, 7, 8},
-			expErr: "cannot parse address to order index key: unable to determine address from [20, 101, ...(length 22)]",
+			name:       "20 byte source, with external id",
+			source:     sdk.AccAddress("abcdefghijklmnopqrst"),
+			externalID: "payroll-7",
+			expected:   append([]byte{keeper.KeyTypeRecurringPayment, 20}, "abcdefghijklmnopqrstpayroll-7"...),
 		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeKeyRecurringPayment(tc.source, tc.externalID)
+				},
+				expected: tc.expected,
+				expPanic: tc.expPanic,
+			}
+			if len(tc.expPanic) == 0 {
+				ktc.expPrefixes = []expectedPrefix{
+					{name: "GetKeyPrefixAllRecurringPayments", value: keeper.GetKeyPrefixAllRecurringPayments()},
+					{name: "GetKeyPrefixRecurringPaymentsForSource", value: keeper.GetKeyPrefixRecurringPaymentsForSource(tc.source)},
+				}
+			}
+			checkKey(t, ktc, "MakeKeyRecurringPayment(%v, %q)", tc.source, tc.externalID)
+		})
+	}
+}
+
+func TestGetIndexKeyPrefixTransferTimeToRecurringPayment(t *testing.T) {
+	ktc := keyTestCase{
+		maker: func() []byte {
+			return keeper.GetIndexKeyPrefixTransferTimeToRecurringPayment()
+		},
+		expected: []byte{keeper.KeyTypeTransferTimeToRecurringPaymentIndex},
+	}
+	checkKey(t, ktc, "GetIndexKeyPrefixTransferTimeToRecurringPayment")
+}
+
+func TestGetIndexKeyPrefixTransferTimeToRecurringPaymentAt(t *testing.T) {
+	tests := []struct {
+		name         string
+		transferTime time.Time
+		expected     []byte
+	}{
 		{
-			name: "20 byte address order id 72,623,859,790,382,856",
-			key: []byte{20, 101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
-				111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-				1, 2, 3, 4, 5, 6, 7, 8},
-			expAddr: sdk.AccAddress{101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
-				111, 112, 113, 114, 115, 116, 117, 118, 119, 120},
-			expOrderID: 72_623_859_790_382_856,
+			name:         "one second after epoch",
+			transferTime: time.Unix(1, 0),
+			expected:     []byte{keeper.KeyTypeTransferTimeToRecurringPaymentIndex, 0, 0, 0, 0, 0, 0, 0, 1},
 		},
 		{
-			name:       "with type byte: 1 byte address order id 72,623,859,790,382,856",
-			key:        []byte{keeper.KeyTypeAddressToOrderIndex, 1, 55, 1, 2, 3, 4, 5, 6, 7, 8},
-			expAddr:    sdk.AccAddress{55},
-			expOrderID: 72_623_859_790_382_856,
+			name:         "sub-second part is ignored",
+			transferTime: time.Unix(1, 999_999_999),
+			expected:     []byte{keeper.KeyTypeTransferTimeToRecurringPaymentIndex, 0, 0, 0, 0, 0, 0, 0, 1},
 		},
 		{
-			name:   "with type byte: length byte 2 but only 1 byte after it",
-			key:    []byte{keeper.KeyTypeAddressToOrderIndex, 2, 55, 1, 2, 3, 4, 5, 6, 7, 8},
-			expErr: "cannot parse address to order index key: unable to determine address from [4, 2, ...(length 3)]",
+			name:         "2030-01-01 in a different time zone",
+			transferTime: time.Date(2029, 12, 31, 18, 0, 0, 0, time.FixedZone("CST", -6*60*60)),
+			expected:     []byte{keeper.KeyTypeTransferTimeToRecurringPaymentIndex, 0, 0, 0, 0, 0x70, 0xdb, 0xd8, 0x80},
 		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetIndexKeyPrefixTransferTimeToRecurringPaymentAt(tc.transferTime)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetIndexKeyPrefixTransferTimeToRecurringPayment", value: keeper.GetIndexKeyPrefixTransferTimeToRecurringPayment()},
+				},
+			}
+			checkKey(t, ktc, "GetIndexKeyPrefixTransferTimeToRecurringPaymentAt(%s)", tc.transferTime)
+		})
+	}
+}
+
+func TestMakeIndexKeyTransferTimeToRecurringPayment(t *testing.T) {
+	tests := []struct {
+		name         string
+		transferTime time.Time
+		source       sdk.AccAddress
+		externalID   string
+		expected     []byte
+		expPanic     string
+	}{
 		{
-			name:   "with type byte: length byte 2 but 5 bytes after it",
-			key:    []byte{keeper.KeyTypeAddressToOrderIndex, 2, 55, 56, 57, 58, 1, 2, 3, 4, 5, 6, 7, 8},
-			expErr: "cannot parse address to order index key: unable to determine address from [4, 2, ...(length 6)]",
+			name:         "nil source",
+			transferTime: time.Unix(1, 0),
+			source:       nil,
+			expPanic:     "empty source address not allowed",
 		},
 		{
-			name:       "with type byte: length byte 4 order id 72,623,859,790,382,856",
-			key:        []byte{keeper.KeyTypeAddressToOrderIndex, 4, 55, 56, 57, 58, 1, 2, 3, 4, 5, 6, 7, 8},
-			expAddr:    sdk.AccAddress{55, 56, 57, 58},
-			expOrderID: 72_623_859_790_382_856,
+			name:         "one second after epoch, 5 byte source, no external id",
+			transferTime: time.Unix(1, 0),
+			source:       sdk.AccAddress("abcde"),
+			expected: append([]byte{keeper.KeyTypeTransferTimeToRecurringPaymentIndex,
+				0, 0, 0, 0, 0, 0, 0, 1, 5}, "abcde"...),
+		},
+		{
+			name:         "2030-01-01, 20 byte source, with external id",
+			transferTime: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+			source:       sdk.AccAddress("abcdefghijklmnopqrst"),
+			externalID:   "some-id",
+			expected: append([]byte{keeper.KeyTypeTransferTimeToRecurringPaymentIndex,
+				0, 0, 0, 0, 0x70, 0xdb, 0xd8, 0x80, 20}, "abcdefghijklmnopqrstsome-id"...),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeIndexKeyTransferTimeToRecurringPayment(tc.transferTime, tc.source, tc.externalID)
+				},
+				expected: tc.expected,
+				expPanic: tc.expPanic,
+			}
+			if len(tc.expPanic) == 0 {
+				ktc.expPrefixes = []expectedPrefix{
+					{name: "GetIndexKeyPrefixTransferTimeToRecurringPayment", value: keeper.GetIndexKeyPrefixTransferTimeToRecurringPayment()},
+					{name: "GetIndexKeyPrefixTransferTimeToRecurringPaymentAt", value: keeper.GetIndexKeyPrefixTransferTimeToRecurringPaymentAt(tc.transferTime)},
+				}
+			}
+			checkKey(t, ktc, "MakeIndexKeyTransferTimeToRecurringPayment(%s, %s, %q)", tc.transferTime, tc.source, tc.externalID)
+		})
+	}
+}
+
+func TestParseIndexKeyTransferTimeToRecurringPayment(t *testing.T) {
+	transferTime := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	source := sdk.AccAddress("abcdefghijklmnopqrst")
+
+	tests := []struct {
+		name            string
+		key             []byte
+		expTransferTime time.Time
+		expSource       sdk.AccAddress
+		expExternalID   string
+		expErr          string
+	}{
+		{
+			name:   "nil key",
+			key:    nil,
+			expErr: "cannot parse transfer time to recurring payment key: only has 0 bytes, expected at least 11",
+		},
+		{
+			name:   "10 bytes",
+			key:    []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+			expErr: "cannot parse transfer time to recurring payment key: only has 10 bytes, expected at least 11",
+		},
+		{
+			name:   "wrong type byte",
+			key:    []byte{keeper.KeyTypeExpirationToPaymentIndex, 0, 0, 0, 0, 0, 0, 0, 1, 1, 'a'},
+			expErr: "cannot parse transfer time to recurring payment key: unknown type byte 0x18, expected 0x1a",
 		},
 		{
-			name: "with type byte: length byte 20 but only 19 byte after it",
-			key: []byte{keeper.KeyTypeAddressToOrderIndex, 20,
-				101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
-				111, 112, 113, 114, 115, 116, 117, 118, 119,
-				1, 2, 3, 4, 5, 6, 7, 8},
-			expErr: "cannot parse address to order index key: unable to determine address from [4, 20, ...(length 21)]",
+			name:   "source length too long",
+			key:    []byte{keeper.KeyTypeTransferTimeToRecurringPaymentIndex, 0, 0, 0, 0, 0, 0, 0, 1, 2, 'a'},
+			expErr: "cannot parse source from transfer time to recurring payment key: length byte is 2, but slice only has 1 left",
 		},
 		{
-			name: "with type byte: length byte 20 but 21 byte after it",
-			key: []byte{keeper.KeyTypeAddressToOrderIndex, 20,
-				101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
-				111, 112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
-				1, 2, 3, 4, 5, 6, 7, 8},
-			expErr: "cannot parse address to order index key: unable to determine address from [4, 20, ...(length 23)]",
+			name:            "good key without external id",
+			key:             keeper.MakeIndexKeyTransferTimeToRecurringPayment(transferTime, source, ""),
+			expTransferTime: transferTime,
+			expSource:       source,
+			expExternalID:   "",
 		},
 		{
-			name: "with type byte: 20 byte address order id 72,623,859,790,382,856",
-			key: []byte{keeper.KeyTypeAddressToOrderIndex, 20,
-				101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
-				111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-				1, 2, 3, 4, 5, 6, 7, 8},
-			expAddr: sdk.AccAddress{101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
-				111, 112, 113, 114, 115, 116, 117, 118, 119, 120},
-			expOrderID: 72_623_859_790_382_856,
+			name:            "good key with external id",
+			key:             keeper.MakeIndexKeyTransferTimeToRecurringPayment(transferTime, source, "some-id"),
+			expTransferTime: transferTime,
+			expSource:       source,
+			expExternalID:   "some-id",
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			var addr sdk.AccAddress
-			var orderID uint64
+			var actTransferTime time.Time
+			var actSource sdk.AccAddress
+			var actExternalID string
 			var err error
 			testFunc := func() {
-				addr, orderID, err = keeper.ParseIndexKeyAddressToOrder(tc.key)
+				actTransferTime, actSource, actExternalID, err = keeper.ParseIndexKeyTransferTimeToRecurringPayment(tc.key)
 			}
-			require.NotPanics(t, testFunc, "ParseIndexKeyAddressToOrder(%v)", tc.key)
-			assertions.AssertErrorValue(t, err, tc.expErr, "ParseIndexKeyAddressToOrder(%v) error", tc.key)
-			assert.Equal(t, tc.expAddr, addr, "ParseIndexKeyAddressToOrder(%v) address", tc.key)
-			assert.Equal(t, tc.expOrderID, orderID, "ParseIndexKeyAddressToOrder(%v) order id", tc.key)
+			require.NotPanics(t, testFunc, "ParseIndexKeyTransferTimeToRecurringPayment(%v)", tc.key)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseIndexKeyTransferTimeToRecurringPayment(%v) error", tc.key)
+			assert.Equal(t, tc.expTransferTime, actTransferTime, "ParseIndexKeyTransferTimeToRecurringPayment(%v) transfer time", tc.key)
+			assert.Equal(t, tc.expSource, actSource, "ParseIndexKeyTransferTimeToRecurringPayment(%v) source", tc.key)
+			assert.Equal(t, tc.expExternalID, actExternalID, "ParseIndexKeyTransferTimeToRecurringPayment(%v) external id", tc.key)
 		})
 	}
 }
 
-func TestGetIndexKeyPrefixAssetToOrder(t *testing.T) {
+func TestGetKeyPrefixAllEscrowedPayments(t *testing.T) {
+	ktc := keyTestCase{
+		maker:    keeper.GetKeyPrefixAllEscrowedPayments,
+		expected: []byte{keeper.KeyTypeEscrowedPayment},
+	}
+	checkKey(t, ktc, "GetKeyPrefixAllEscrowedPayments()")
+}
+
+func TestGetKeyPrefixEscrowedPaymentsForSource(t *testing.T) {
 	tests := []struct {
-		name       string
-		assetDenom string
-		expected   []byte
+		name     string
+		source   sdk.AccAddress
+		expected []byte
+		expPanic string
 	}{
 		{
-			name:       "empty",
-			assetDenom: "",
-			expected:   []byte{keeper.KeyTypeAssetToOrderIndex},
+			name:     "nil source",
+			source:   nil,
+			expPanic: "empty source address not allowed",
 		},
 		{
-			name:       "1 char denom",
-			assetDenom: "p",
-			expected:   []byte{keeper.KeyTypeAssetToOrderIndex, 'p'},
+			name:     "empty source",
+			source:   sdk.AccAddress{},
+			expPanic: "empty source address not allowed",
 		},
 		{
-			name:       "nhash",
-			assetDenom: "nhash",
-			expected:   []byte{keeper.KeyTypeAssetToOrderIndex, 'n', 'h', 'a', 's', 'h'},
+			name:     "5 byte source",
+			source:   sdk.AccAddress("abcde"),
+			expected: append([]byte{keeper.KeyTypeEscrowedPayment, 5}, "abcde"...),
 		},
 		{
-			name:       "hex string",
-			assetDenom: hexString,
-			expected:   append([]byte{keeper.KeyTypeAssetToOrderIndex}, hexString...),
+			name:     "20 byte source",
+			source:   sdk.AccAddress("abcdefghijklmnopqrst"),
+			expected: append([]byte{keeper.KeyTypeEscrowedPayment, 20}, "abcdefghijklmnopqrst"...),
 		},
 	}
 
@@ -4052,64 +10220,43 @@ func TestGetIndexKeyPrefixAssetToOrder(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			ktc := keyTestCase{
 				maker: func() []byte {
-					return keeper.GetIndexKeyPrefixAssetToOrder(tc.assetDenom)
+					return keeper.GetKeyPrefixEscrowedPaymentsForSource(tc.source)
 				},
 				expected: tc.expected,
+				expPanic: tc.expPanic,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixAllEscrowedPayments", value: keeper.GetKeyPrefixAllEscrowedPayments()},
+				},
 			}
-			checkKey(t, ktc, "GetIndexKeyPrefixAssetToOrder(%q)", tc.assetDenom)
+			checkKey(t, ktc, "GetKeyPrefixEscrowedPaymentsForSource(%v)", tc.source)
 		})
 	}
 }
 
-func TestMakeIndexKeyAssetToOrder(t *testing.T) {
+func TestMakeKeyEscrowedPayment(t *testing.T) {
 	tests := []struct {
 		name       string
-		assetDenom string
-		orderID    uint64
+		source     sdk.AccAddress
+		externalID string
 		expected   []byte
 		expPanic   string
 	}{
 		{
-			name:       "no asset order 0",
-			assetDenom: "",
-			orderID:    0,
-			expected:   []byte{keeper.KeyTypeAssetToOrderIndex, 0, 0, 0, 0, 0, 0, 0, 0},
-		},
-		{
-			name:       "nhash order 1",
-			assetDenom: "nhash",
-			orderID:    1,
-			expected:   []byte{keeper.KeyTypeAssetToOrderIndex, 'n', 'h', 'a', 's', 'h', 0, 0, 0, 0, 0, 0, 0, 1},
-		},
-		{
-			name:       "hex string order 5",
-			assetDenom: hexString,
-			orderID:    5,
-			expected: concatBz(
-				[]byte{keeper.KeyTypeAssetToOrderIndex},
-				[]byte(hexString),
-				[]byte{0, 0, 0, 0, 0, 0, 0, 5},
-			),
+			name:     "nil source",
+			source:   nil,
+			expPanic: "empty source address not allowed",
 		},
 		{
-			name:       "nhash order 4,294,967,296",
-			assetDenom: "nhash",
-			orderID:    4_294_967_296,
-			expected: []byte{
-				keeper.KeyTypeAssetToOrderIndex,
-				'n', 'h', 'a', 's', 'h',
-				0, 0, 0, 1, 0, 0, 0, 0,
-			},
+			name:       "20 byte source, empty external id",
+			source:     sdk.AccAddress("abcdefghijklmnopqrst"),
+			externalID: "",
+			expected:   append([]byte{keeper.KeyTypeEscrowedPayment, 20}, "abcdefghijklmnopqrst"...),
 		},
 		{
-			name:       "nhash order max",
-			assetDenom: "nhash",
-			orderID:    18_446_744_073_709_551_615,
-			expected: []byte{
-				keeper.KeyTypeAssetToOrderIndex,
-				'n', 'h', 'a', 's', 'h',
-				255, 255, 255, 255, 255, 255, 255, 255,
-			},
+			name:       "20 byte source, with external id",
+			source:     sdk.AccAddress("abcdefghijklmnopqrst"),
+			externalID: "purchase-7",
+			expected:   append([]byte{keeper.KeyTypeEscrowedPayment, 20}, "abcdefghijklmnopqrstpurchase-7"...),
 		},
 	}
 
@@ -4117,222 +10264,223 @@ func TestMakeIndexKeyAssetToOrder(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			ktc := keyTestCase{
 				maker: func() []byte {
-					return keeper.MakeIndexKeyAssetToOrder(tc.assetDenom, tc.orderID)
+					return keeper.MakeKeyEscrowedPayment(tc.source, tc.externalID)
 				},
 				expected: tc.expected,
 				expPanic: tc.expPanic,
 			}
 			if len(tc.expPanic) == 0 {
 				ktc.expPrefixes = []expectedPrefix{
-					{name: "GetIndexKeyPrefixAssetToOrder", value: keeper.GetIndexKeyPrefixAssetToOrder(tc.assetDenom)},
+					{name: "GetKeyPrefixAllEscrowedPayments", value: keeper.GetKeyPrefixAllEscrowedPayments()},
+					{name: "GetKeyPrefixEscrowedPaymentsForSource", value: keeper.GetKeyPrefixEscrowedPaymentsForSource(tc.source)},
 				}
 			}
-
-			checkKey(t, ktc, "MakeIndexKeyAssetToOrder(%q, %d)", tc.assetDenom, tc.orderID)
+			checkKey(t, ktc, "MakeKeyEscrowedPayment(%v, %q)", tc.source, tc.externalID)
 		})
 	}
 }
 
-func TestParseIndexKeyAssetToOrder(t *testing.T) {
+func TestGetIndexKeyPrefixReleaseTimeToEscrowedPayment(t *testing.T) {
+	ktc := keyTestCase{
+		maker: func() []byte {
+			return keeper.GetIndexKeyPrefixReleaseTimeToEscrowedPayment()
+		},
+		expected: []byte{keeper.KeyTypeReleaseTimeToEscrowedPaymentIndex},
+	}
+	checkKey(t, ktc, "GetIndexKeyPrefixReleaseTimeToEscrowedPayment")
+}
+
+func TestGetIndexKeyPrefixReleaseTimeToEscrowedPaymentAt(t *testing.T) {
 	tests := []struct {
-		name       string
-		key        []byte
-		expDenom   string
-		expOrderID uint64
-		expErr     string
+		name        string
+		releaseTime time.Time
+		expected    []byte
 	}{
 		{
-			name:   "nil key",
-			key:    nil,
-			expErr: "cannot parse asset to order key: only has 0 bytes, expected at least 8",
-		},
-		{
-			name:   "empty key",
-			key:    []byte{},
-			expErr: "cannot parse asset to order key: only has 0 bytes, expected at least 8",
-		},
-		{
-			name:   "7 bytes",
-			key:    []byte{1, 2, 3, 4, 5, 6, 7},
-			expErr: "cannot parse asset to order key: only has 7 bytes, expected at least 8",
-		},
-		{
-			name:       "order id 1",
-			key:        []byte{0, 0, 0, 0, 0, 0, 0, 1},
-			expOrderID: 1,
+			name:        "one second after epoch",
+			releaseTime: time.Unix(1, 0),
+			expected:    []byte{keeper.KeyTypeReleaseTimeToEscrowedPaymentIndex, 0, 0, 0, 0, 0, 0, 0, 1},
 		},
 		{
-			name:       "order id 578,437,695,752,307,201",
-			key:        []byte{8, 7, 6, 5, 4, 3, 2, 1},
-			expOrderID: 578_437_695_752_307_201,
+			name:        "sub-second part is ignored",
+			releaseTime: time.Unix(1, 999_999_999),
+			expected:    []byte{keeper.KeyTypeReleaseTimeToEscrowedPaymentIndex, 0, 0, 0, 0, 0, 0, 0, 1},
 		},
 		{
-			name:       "nhash order id 578,437,695,752,307,201",
-			key:        []byte{'n', 'h', 'a', 's', 'h', 8, 7, 6, 5, 4, 3, 2, 1},
-			expDenom:   "nhash",
-			expOrderID: 578_437_695_752_307_201,
+			name:        "2030-01-01 in a different time zone",
+			releaseTime: time.Date(2029, 12, 31, 18, 0, 0, 0, time.FixedZone("CST", -6*60*60)),
+			expected:    []byte{keeper.KeyTypeReleaseTimeToEscrowedPaymentIndex, 0, 0, 0, 0, 0x70, 0xdb, 0xd8, 0x80},
 		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetIndexKeyPrefixReleaseTimeToEscrowedPaymentAt(tc.releaseTime)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetIndexKeyPrefixReleaseTimeToEscrowedPayment", value: keeper.GetIndexKeyPrefixReleaseTimeToEscrowedPayment()},
+				},
+			}
+			checkKey(t, ktc, "GetIndexKeyPrefixReleaseTimeToEscrowedPaymentAt(%s)", tc.releaseTime)
+		})
+	}
+}
+
+func TestMakeIndexKeyReleaseTimeToEscrowedPayment(t *testing.T) {
+	tests := []struct {
+		name        string
+		releaseTime time.Time
+		source      sdk.AccAddress
+		externalID  string
+		expected    []byte
+		expPanic    string
+	}{
 		{
-			name:       "hex string order id 578,437,695,752,307,201",
-			key:        append([]byte(hexString), 8, 7, 6, 5, 4, 3, 2, 1),
-			expDenom:   hexString,
-			expOrderID: 578_437_695_752_307_201,
+			name:        "nil source",
+			releaseTime: time.Unix(1, 0),
+			source:      nil,
+			expPanic:    "empty source address not allowed",
 		},
 		{
-			name:       "with type byte nhash order id 578,437,695,752,307,201",
-			key:        []byte{keeper.KeyTypeAssetToOrderIndex, 'n', 'h', 'a', 's', 'h', 8, 7, 6, 5, 4, 3, 2, 1},
-			expDenom:   "nhash",
-			expOrderID: 578_437_695_752_307_201,
+			name:        "one second after epoch, 5 byte source, no external id",
+			releaseTime: time.Unix(1, 0),
+			source:      sdk.AccAddress("abcde"),
+			expected: append([]byte{keeper.KeyTypeReleaseTimeToEscrowedPaymentIndex,
+				0, 0, 0, 0, 0, 0, 0, 1, 5}, "abcde"...),
 		},
 		{
-			name: "with type byte hex string order id 578,437,695,752,307,201",
-			key: concatBz(
-				[]byte{keeper.KeyTypeAssetToOrderIndex},
-				[]byte(hexString),
-				[]byte{8, 7, 6, 5, 4, 3, 2, 1},
-			),
-			expDenom:   hexString,
-			expOrderID: 578_437_695_752_307_201,
+			name:        "2030-01-01, 20 byte source, with external id",
+			releaseTime: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+			source:      sdk.AccAddress("abcdefghijklmnopqrst"),
+			externalID:  "some-id",
+			expected: append([]byte{keeper.KeyTypeReleaseTimeToEscrowedPaymentIndex,
+				0, 0, 0, 0, 0x70, 0xdb, 0xd8, 0x80, 20}, "abcdefghijklmnopqrstsome-id"...),
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			var denom string
-			var orderiD uint64
-			var err error
-			testFunc := func() {
-				denom, orderiD, err = keeper.ParseIndexKeyAssetToOrder(tc.key)
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeIndexKeyReleaseTimeToEscrowedPayment(tc.releaseTime, tc.source, tc.externalID)
+				},
+				expected: tc.expected,
+				expPanic: tc.expPanic,
 			}
-			require.NotPanics(t, testFunc, "ParseIndexKeyAssetToOrder(%v)", tc.key)
-			assertions.AssertErrorValue(t, err, tc.expErr, "ParseIndexKeyAssetToOrder(%v) error", tc.key)
-			assert.Equal(t, tc.expDenom, denom, "ParseIndexKeyAssetToOrder(%v) denom", tc.key)
-			assert.Equal(t, tc.expOrderID, orderiD, "ParseIndexKeyAssetToOrder(%v) order id", tc.key)
+			if len(tc.expPanic) == 0 {
+				ktc.expPrefixes = []expectedPrefix{
+					{name: "GetIndexKeyPrefixReleaseTimeToEscrowedPayment", value: keeper.GetIndexKeyPrefixReleaseTimeToEscrowedPayment()},
+					{name: "GetIndexKeyPrefixReleaseTimeToEscrowedPaymentAt", value: keeper.GetIndexKeyPrefixReleaseTimeToEscrowedPaymentAt(tc.releaseTime)},
+				}
+			}
+			checkKey(t, ktc, "MakeIndexKeyReleaseTimeToEscrowedPayment(%s, %s, %q)", tc.releaseTime, tc.source, tc.externalID)
 		})
 	}
 }
 
-func TestMakeIndexKeyMarketExternalIDToOrder(t *testing.T) {
+func TestParseIndexKeyReleaseTimeToEscrowedPayment(t *testing.T) {
+	releaseTime := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	source := sdk.AccAddress("abcdefghijklmnopqrst")
+
 	tests := []struct {
-		name       string
-		marketID   uint32
-		externalID string
-		expected   []byte
-		expPanic   string
+		name           string
+		key            []byte
+		expReleaseTime time.Time
+		expSource      sdk.AccAddress
+		expExternalID  string
+		expErr         string
 	}{
 		{
-			name:       "empty external id",
-			marketID:   1,
-			externalID: "",
-			expPanic:   "cannot create market external id to order index with empty external id",
+			name:   "nil key",
+			key:    nil,
+			expErr: "cannot parse release time to escrowed payment key: only has 0 bytes, expected at least 11",
 		},
 		{
-			name:       "external id too long",
-			marketID:   2,
-			externalID: strings.Repeat("H", exchange.MaxExternalIDLength+1),
-			expPanic: fmt.Sprintf("cannot create market external id to order index: invalid external id %q (length %d): max length %d",
-				"HHHHH...HHHHH", exchange.MaxExternalIDLength+1, exchange.MaxExternalIDLength),
+			name:   "10 bytes",
+			key:    []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+			expErr: "cannot parse release time to escrowed payment key: only has 10 bytes, expected at least 11",
 		},
 		{
-			name:       "market 0, a zeroed uuid",
-			marketID:   0,
-			externalID: "00000000-0000-0000-0000-000000000000",
-			expected: append([]byte{keeper.KeyTypeMarketExternalIDToOrderIndex, 0, 0, 0, 0},
-				"00000000-0000-0000-0000-000000000000"...),
+			name:   "wrong type byte",
+			key:    []byte{keeper.KeyTypeExpirationToPaymentIndex, 0, 0, 0, 0, 0, 0, 0, 1, 1, 'a'},
+			expErr: "cannot parse release time to escrowed payment key: unknown type byte 0x18, expected 0x1f",
 		},
 		{
-			name:       "market 1, random uuid",
-			marketID:   1,
-			externalID: "814348F5-DE62-4954-81D1-65874E37C0BE",
-			expected: append([]byte{keeper.KeyTypeMarketExternalIDToOrderIndex, 0, 0, 0, 1},
-				"814348F5-DE62-4954-81D1-65874E37C0BE"...),
+			name:   "source length too long",
+			key:    []byte{keeper.KeyTypeReleaseTimeToEscrowedPaymentIndex, 0, 0, 0, 0, 0, 0, 0, 1, 2, 'a'},
+			expErr: "cannot parse source from release time to escrowed payment key: length byte is 2, but slice only has 1 left",
 		},
 		{
-			name:       "market 67,305,985, a wierd external id",
-			marketID:   67_305_985,
-			externalID: "ThisIsWierd",
-			expected:   append([]byte{keeper.KeyTypeMarketExternalIDToOrderIndex, 4, 3, 2, 1}, "ThisIsWierd"...),
+			name:           "good key without external id",
+			key:            keeper.MakeIndexKeyReleaseTimeToEscrowedPayment(releaseTime, source, ""),
+			expReleaseTime: releaseTime,
+			expSource:      source,
+			expExternalID:  "",
 		},
 		{
-			name:       "max market id and lots of Zs",
-			marketID:   4_294_967_295,
-			externalID: strings.Repeat("Z", exchange.MaxExternalIDLength),
-			expected: append([]byte{keeper.KeyTypeMarketExternalIDToOrderIndex, 255, 255, 255, 255},
-				strings.Repeat("Z", exchange.MaxExternalIDLength)...),
+			name:           "good key with external id",
+			key:            keeper.MakeIndexKeyReleaseTimeToEscrowedPayment(releaseTime, source, "some-id"),
+			expReleaseTime: releaseTime,
+			expSource:      source,
+			expExternalID:  "some-id",
 		},
-	}
-
-	for _, tc := range tests {
-		t.Run(tc.name, func(t *testing.T) {
-			ktc := keyTestCase{
-				maker: func() []byte {
-					return keeper.MakeIndexKeyMarketExternalIDToOrder(tc.marketID, tc.externalID)
-				},
-				expected: tc.expected,
-				expPanic: tc.expPanic,
-			}
+	}
 
-			checkKey(t, ktc, "MakeIndexKeyMarketExternalIDToOrder(%d, %v)", tc.marketID, tc.externalID)
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actReleaseTime time.Time
+			var actSource sdk.AccAddress
+			var actExternalID string
+			var err error
+			testFunc := func() {
+				actReleaseTime, actSource, actExternalID, err = keeper.ParseIndexKeyReleaseTimeToEscrowedPayment(tc.key)
+			}
+			require.NotPanics(t, testFunc, "ParseIndexKeyReleaseTimeToEscrowedPayment(%v)", tc.key)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseIndexKeyReleaseTimeToEscrowedPayment(%v) error", tc.key)
+			assert.Equal(t, tc.expReleaseTime, actReleaseTime, "ParseIndexKeyReleaseTimeToEscrowedPayment(%v) release time", tc.key)
+			assert.Equal(t, tc.expSource, actSource, "ParseIndexKeyReleaseTimeToEscrowedPayment(%v) source", tc.key)
+			assert.Equal(t, tc.expExternalID, actExternalID, "ParseIndexKeyReleaseTimeToEscrowedPayment(%v) external id", tc.key)
 		})
 	}
 }
 
-func TestGetKeyPrefixCommitments(t *testing.T) {
+func TestGetKeyPrefixAllMultiPartyPayments(t *testing.T) {
 	ktc := keyTestCase{
-		maker: func() []byte {
-			return keeper.GetKeyPrefixCommitments()
-		},
-		expected: []byte{keeper.KeyTypeCommitment},
+		maker:    keeper.GetKeyPrefixAllMultiPartyPayments,
+		expected: []byte{keeper.KeyTypeMultiPartyPayment},
 	}
-	checkKey(t, ktc, "GetKeyPrefixCommitments")
-
+	checkKey(t, ktc, "GetKeyPrefixAllMultiPartyPayments()")
 }
 
-func TestGetKeyPrefixCommitmentsToMarket(t *testing.T) {
+func TestGetKeyPrefixMultiPartyPaymentsForCreator(t *testing.T) {
 	tests := []struct {
 		name     string
-		marketID uint32
+		creator  sdk.AccAddress
 		expected []byte
+		expPanic string
 	}{
 		{
-			name:     "market id 0",
-			marketID: 0,
-			expected: []byte{keeper.KeyTypeCommitment, 0, 0, 0, 0},
-		},
-		{
-			name:     "market id 1",
-			marketID: 1,
-			expected: []byte{keeper.KeyTypeCommitment, 0, 0, 0, 1},
-		},
-		{
-			name:     "market id 255",
-			marketID: 255,
-			expected: []byte{keeper.KeyTypeCommitment, 0, 0, 0, 255},
-		},
-		{
-			name:     "market id 256",
-			marketID: 256,
-			expected: []byte{keeper.KeyTypeCommitment, 0, 0, 1, 0},
-		},
-		{
-			name:     "market id 65_536",
-			marketID: 65_536,
-			expected: []byte{keeper.KeyTypeCommitment, 0, 1, 0, 0},
+			name:     "nil creator",
+			creator:  nil,
+			expPanic: "empty creator address not allowed",
 		},
 		{
-			name:     "market id 16,777,216",
-			marketID: 16_777_216,
-			expected: []byte{keeper.KeyTypeCommitment, 1, 0, 0, 0},
+			name:     "empty creator",
+			creator:  sdk.AccAddress{},
+			expPanic: "empty creator address not allowed",
 		},
 		{
-			name:     "market id 16,843,009",
-			marketID: 16_843_009,
-			expected: []byte{keeper.KeyTypeCommitment, 1, 1, 1, 1},
+			name:     "5 byte creator",
+			creator:  sdk.AccAddress("abcde"),
+			expected: append([]byte{keeper.KeyTypeMultiPartyPayment, 5}, "abcde"...),
 		},
 		{
-			name:     "market id 4,294,967,295",
-			marketID: 4_294_967_295,
-			expected: []byte{keeper.KeyTypeCommitment, 255, 255, 255, 255},
+			name:     "20 byte creator",
+			creator:  sdk.AccAddress("abcdefghijklmnopqrst"),
+			expected: append([]byte{keeper.KeyTypeMultiPartyPayment, 20}, "abcdefghijklmnopqrst"...),
 		},
 	}
 
@@ -4340,82 +10488,43 @@ func TestGetKeyPrefixCommitmentsToMarket(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			ktc := keyTestCase{
 				maker: func() []byte {
-					return keeper.GetKeyPrefixCommitmentsToMarket(tc.marketID)
+					return keeper.GetKeyPrefixMultiPartyPaymentsForCreator(tc.creator)
 				},
 				expected: tc.expected,
+				expPanic: tc.expPanic,
 				expPrefixes: []expectedPrefix{
-					{name: "GetKeyPrefixCommitments", value: keeper.GetKeyPrefixCommitments()},
+					{name: "GetKeyPrefixAllMultiPartyPayments", value: keeper.GetKeyPrefixAllMultiPartyPayments()},
 				},
 			}
-			checkKey(t, ktc, "GetKeyPrefixCommitmentsToMarket(%d)", tc.marketID)
+			checkKey(t, ktc, "GetKeyPrefixMultiPartyPaymentsForCreator(%v)", tc.creator)
 		})
 	}
 }
 
-func TestMakeKeyCommitment(t *testing.T) {
+func TestMakeKeyMultiPartyPayment(t *testing.T) {
 	tests := []struct {
-		name     string
-		marketID uint32
-		addr     sdk.AccAddress
-		expected []byte
-		expPanic string
+		name       string
+		creator    sdk.AccAddress
+		externalID string
+		expected   []byte
+		expPanic   string
 	}{
 		{
-			name:     "nil addr",
-			addr:     nil,
-			expPanic: "empty address not allowed",
-		},
-		{
-			name:     "empty addr",
-			addr:     sdk.AccAddress{},
-			expPanic: "empty address not allowed",
-		},
-		{
-			name:     "256 byte addr",
-			addr:     bytes.Repeat([]byte{'p'}, 256),
-			expPanic: "address length should be max 255 bytes, got 256: unknown address",
-		},
-		{
-			name:     "market id 0 5 byte addr",
-			marketID: 0,
-			addr:     sdk.AccAddress("abcde"),
-			expected: append([]byte{keeper.KeyTypeCommitment, 0, 0, 0, 0, 5}, "abcde"...),
-		},
-		{
-			name:     "market id 0 20 byte addr",
-			marketID: 0,
-			addr:     sdk.AccAddress("abcdefghijklmnopqrst"),
-			expected: append([]byte{keeper.KeyTypeCommitment, 0, 0, 0, 0, 20}, "abcdefghijklmnopqrst"...),
-		},
-		{
-			name:     "market id 0 32 byte addr",
-			marketID: 0,
-			addr:     sdk.AccAddress("abcdefghijklmnopqrstuvwxyzABCDEF"),
-			expected: append([]byte{keeper.KeyTypeCommitment, 0, 0, 0, 0, 32}, "abcdefghijklmnopqrstuvwxyzABCDEF"...),
-		},
-		{
-			name:     "market id 1 20 byte addr",
-			marketID: 1,
-			addr:     sdk.AccAddress("abcdefghijklmnopqrst"),
-			expected: append([]byte{keeper.KeyTypeCommitment, 0, 0, 0, 1, 20}, "abcdefghijklmnopqrst"...),
-		},
-		{
-			name:     "market id 1 32 byte addr",
-			marketID: 1,
-			addr:     sdk.AccAddress("abcdefghijklmnopqrstuvwxyzABCDEF"),
-			expected: append([]byte{keeper.KeyTypeCommitment, 0, 0, 0, 1, 32}, "abcdefghijklmnopqrstuvwxyzABCDEF"...),
+			name:     "nil creator",
+			creator:  nil,
+			expPanic: "empty creator address not allowed",
 		},
 		{
-			name:     "market id 16,843,009 20 byte addr",
-			marketID: 16_843_009,
-			addr:     sdk.AccAddress("abcdefghijklmnopqrst"),
-			expected: append([]byte{keeper.KeyTypeCommitment, 1, 1, 1, 1, 20}, "abcdefghijklmnopqrst"...),
+			name:       "20 byte creator, empty external id",
+			creator:    sdk.AccAddress("abcdefghijklmnopqrst"),
+			externalID: "",
+			expected:   append([]byte{keeper.KeyTypeMultiPartyPayment, 20}, "abcdefghijklmnopqrst"...),
 		},
 		{
-			name:     "market id 16,843,009 32 byte addr",
-			marketID: 16_843_009,
-			addr:     sdk.AccAddress("abcdefghijklmnopqrstuvwxyzABCDEF"),
-			expected: append([]byte{keeper.KeyTypeCommitment, 1, 1, 1, 1, 32}, "abcdefghijklmnopqrstuvwxyzABCDEF"...),
+			name:       "32 byte creator, with external id",
+			creator:    sdk.AccAddress("abcdefghijklmnopqrstuvwxyzABCDEF"),
+			externalID: "otc-3",
+			expected:   append([]byte{keeper.KeyTypeMultiPartyPayment, 32}, "abcdefghijklmnopqrstuvwxyzABCDEFotc-3"...),
 		},
 	}
 
@@ -4423,235 +10532,245 @@ func TestMakeKeyCommitment(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			ktc := keyTestCase{
 				maker: func() []byte {
-					return keeper.MakeKeyCommitment(tc.marketID, tc.addr)
+					return keeper.MakeKeyMultiPartyPayment(tc.creator, tc.externalID)
 				},
 				expected: tc.expected,
 				expPanic: tc.expPanic,
 			}
 			if len(tc.expPanic) == 0 {
 				ktc.expPrefixes = []expectedPrefix{
-					{name: "GetKeyPrefixCommitments", value: keeper.GetKeyPrefixCommitments()},
-					{name: "GetKeyPrefixCommitmentsToMarket", value: keeper.GetKeyPrefixCommitmentsToMarket(tc.marketID)},
+					{name: "GetKeyPrefixAllMultiPartyPayments", value: keeper.GetKeyPrefixAllMultiPartyPayments()},
+					{name: "GetKeyPrefixMultiPartyPaymentsForCreator", value: keeper.GetKeyPrefixMultiPartyPaymentsForCreator(tc.creator)},
 				}
 			}
-			checkKey(t, ktc, "MakeKeyCommitment(%d, %s)", tc.marketID, tc.addr)
+			checkKey(t, ktc, "MakeKeyMultiPartyPayment(%v, %q)", tc.creator, tc.externalID)
 		})
 	}
 }
 
-func TestParseKeyCommitment(t *testing.T) {
+func TestGetIndexKeyPrefixAccountDenomToPayments(t *testing.T) {
 	tests := []struct {
-		name        string
-		key         []byte
-		expMarketID uint32
-		expAddr     sdk.AccAddress
-		expErr      string
+		name     string
+		account  sdk.AccAddress
+		role     byte
+		denom    string
+		expected []byte
+		expPanic string
 	}{
 		{
-			name:   "nil",
-			key:    nil,
-			expErr: "cannot parse commitment key: only has 0 bytes, expected at least 7",
-		},
-		{
-			name:   "empty",
-			key:    []byte{},
-			expErr: "cannot parse commitment key: only has 0 bytes, expected at least 7",
-		},
-		{
-			name:   "6 bytes",
-			key:    []byte{keeper.KeyTypeCommitment, 2, 3, 4, 5, 6},
-			expErr: "cannot parse commitment key: only has 6 bytes, expected at least 7",
-		},
-		{
-			name:   "type byte one low",
-			key:    []byte{keeper.KeyTypeCommitment - 1, 2, 3, 4, 5, 6, 7},
-			expErr: "cannot parse commitment key: incorrect type byte 0x62",
-		},
-		{
-			name:   "type byte one high",
-			key:    []byte{keeper.KeyTypeCommitment + 1, 2, 3, 4, 5, 6, 7},
-			expErr: "cannot parse commitment key: incorrect type byte 0x64",
-		},
-		{
-			name:   "addr length byte zero",
-			key:    []byte{keeper.KeyTypeCommitment, 1, 2, 3, 4, 0, 7},
-			expErr: "cannot parse address from commitment key: length byte is zero",
+			name:     "nil account",
+			account:  nil,
+			role:     keeper.PaymentRoleSource,
+			denom:    "nhash",
+			expPanic: "empty account address not allowed",
 		},
 		{
-			name:   "addr length byte too large",
-			key:    []byte{keeper.KeyTypeCommitment, 1, 2, 3, 4, 6, 1, 2, 3, 4, 5},
-			expErr: "cannot parse address from commitment key: length byte is 6, but slice only has 5 left",
+			name:     "source role",
+			account:  sdk.AccAddress("acct"),
+			role:     keeper.PaymentRoleSource,
+			denom:    "nhash",
+			expected: concatBz([]byte{keeper.KeyTypeAccountDenomToPaymentIndex, 4}, []byte("acct"), []byte{keeper.PaymentRoleSource, 5}, []byte("nhash")),
 		},
 		{
-			name:   "addr length byte too small",
-			key:    []byte{keeper.KeyTypeCommitment, 1, 2, 3, 4, 4, 1, 2, 3, 4, 5},
-			expErr: "cannot parse address from commitment key: found 1 bytes after address, expected 0",
+			name:     "target role",
+			account:  sdk.AccAddress("acct"),
+			role:     keeper.PaymentRoleTarget,
+			denom:    "banana",
+			expected: concatBz([]byte{keeper.KeyTypeAccountDenomToPaymentIndex, 4}, []byte("acct"), []byte{keeper.PaymentRoleTarget, 6}, []byte("banana")),
 		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetIndexKeyPrefixAccountDenomToPayments(tc.account, tc.role, tc.denom)
+				},
+				expected: tc.expected,
+				expPanic: tc.expPanic,
+			}
+			checkKey(t, ktc, "GetIndexKeyPrefixAccountDenomToPayments(%v, %d, %q)", tc.account, tc.role, tc.denom)
+		})
+	}
+}
+
+func TestMakeIndexKeyAccountDenomToPayment(t *testing.T) {
+	tests := []struct {
+		name       string
+		account    sdk.AccAddress
+		role       byte
+		denom      string
+		amount     sdkmath.Int
+		source     sdk.AccAddress
+		externalID string
+		expected   []byte
+		expPanic   string
+	}{
 		{
-			name:        "market 1; 1 byte addr",
-			key:         []byte{keeper.KeyTypeCommitment, 0, 0, 0, 1, 1, 7},
-			expMarketID: 1,
-			expAddr:     sdk.AccAddress{7},
+			name:     "nil account",
+			account:  nil,
+			source:   sdk.AccAddress("source"),
+			amount:   sdkmath.NewInt(1),
+			expPanic: "empty account address not allowed",
 		},
 		{
-			name: "market 2; 20 byte addr",
-			key: []byte{keeper.KeyTypeCommitment, 0, 0, 0, 2, 20,
-				1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20},
-			expMarketID: 2,
-			expAddr:     sdk.AccAddress{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20},
+			name:     "nil source",
+			account:  sdk.AccAddress("account"),
+			source:   nil,
+			amount:   sdkmath.NewInt(1),
+			expPanic: "empty source address not allowed",
 		},
 		{
-			name: "market 67,305,985; 20 byte addr",
-			key: []byte{keeper.KeyTypeCommitment, 4, 3, 2, 1, 20,
-				20, 19, 18, 17, 16, 15, 14, 13, 12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1},
-			expMarketID: 67_305_985,
-			expAddr:     sdk.AccAddress{20, 19, 18, 17, 16, 15, 14, 13, 12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1},
+			name:       "zero amount, no external id",
+			account:    sdk.AccAddress("target"),
+			role:       keeper.PaymentRoleTarget,
+			denom:      "nhash",
+			amount:     sdkmath.ZeroInt(),
+			source:     sdk.AccAddress("source"),
+			externalID: "",
+			expected: concatBz([]byte{keeper.KeyTypeAccountDenomToPaymentIndex, 6}, []byte("target"),
+				[]byte{keeper.PaymentRoleTarget, 5}, []byte("nhash"), []byte{0, 6}, []byte("source")),
 		},
 		{
-			name: "market 4,294,967,295; 32 byte addr",
-			key: []byte{keeper.KeyTypeCommitment, 255, 255, 255, 255, 32,
-				101, 102, 103, 104, 105, 106, 107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-				117, 118, 119, 120, 121, 122, 123, 124, 125, 126, 127, 128, 129, 130, 131, 132,
-			},
-			expMarketID: 4_294_967_295,
-			expAddr: sdk.AccAddress{
-				101, 102, 103, 104, 105, 106, 107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-				117, 118, 119, 120, 121, 122, 123, 124, 125, 126, 127, 128, 129, 130, 131, 132,
-			},
+			name:       "multi-byte amount, with external id",
+			account:    sdk.AccAddress("source"),
+			role:       keeper.PaymentRoleSource,
+			denom:      "nhash",
+			amount:     sdkmath.NewInt(258),
+			source:     sdk.AccAddress("source"),
+			externalID: "abc",
+			expected: concatBz([]byte{keeper.KeyTypeAccountDenomToPaymentIndex, 6}, []byte("source"),
+				[]byte{keeper.PaymentRoleSource, 5}, []byte("nhash"), []byte{2, 1, 2, 6}, []byte("sourceabc")),
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			var marketID uint32
-			var addr sdk.AccAddress
-			var err error
-			testFunc := func() {
-				marketID, addr, err = keeper.ParseKeyCommitment(tc.key)
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeIndexKeyAccountDenomToPayment(tc.account, tc.role, tc.denom, tc.amount, tc.source, tc.externalID)
+				},
+				expected: tc.expected,
+				expPanic: tc.expPanic,
 			}
-			require.NotPanics(t, testFunc, "ParseKeyCommitment(%q)", tc.key)
-			assertions.AssertErrorValue(t, err, tc.expErr, "ParseKeyCommitment(%q) error", tc.key)
-			assert.Equal(t, tc.expMarketID, marketID, "ParseKeyCommitment(%q) market id", tc.key)
-			assert.Equal(t, tc.expAddr, addr, "ParseKeyCommitment(%q) addr", tc.key)
+			if len(tc.expPanic) == 0 {
+				ktc.expPrefixes = []expectedPrefix{
+					{
+						name:  "GetIndexKeyPrefixAccountDenomToPayments",
+						value: keeper.GetIndexKeyPrefixAccountDenomToPayments(tc.account, tc.role, tc.denom),
+					},
+				}
+			}
+			checkKey(t, ktc, "MakeIndexKeyAccountDenomToPayment(%v, %d, %q, %s, %v, %q)",
+				tc.account, tc.role, tc.denom, tc.amount, tc.source, tc.externalID)
 		})
 	}
+
+	t.Run("keys sort by amount", func(t *testing.T) {
+		account := sdk.AccAddress("account")
+		source := sdk.AccAddress("source")
+		amounts := []int64{0, 1, 255, 256, 1000, 65535, 65536, 1_000_000_000_000}
+		var prevKey []byte
+		for i, amount := range amounts {
+			key := keeper.MakeIndexKeyAccountDenomToPayment(account, keeper.PaymentRoleSource, "nhash", sdkmath.NewInt(amount), source, "zzz")
+			if i > 0 {
+				assert.Less(t, bytes.Compare(prevKey, key), 0, "key for %d compared to key for %d", amounts[i-1], amount)
+			}
+			prevKey = key
+		}
+	})
 }
 
-func TestParseKeySuffixCommitment(t *testing.T) {
-	tests := []struct {
-		name    string
-		key     []byte
-		expAddr sdk.AccAddress
-		expErr  string
-	}{
-		{
-			name:   "nil",
-			key:    nil,
-			expErr: "cannot parse address from commitment key: slice is empty",
-		},
-		{
-			name:   "empty",
-			key:    []byte{},
-			expErr: "cannot parse address from commitment key: slice is empty",
-		},
+func TestParseIndexKeySuffixAccountDenomToPayment(t *testing.T) {
+	tests := []struct {
+		name          string
+		suffix        []byte
+		expAmount     sdkmath.Int
+		expSource     sdk.AccAddress
+		expExternalID string
+		expErr        string
+	}{
 		{
-			name:   "addr length byte zero",
-			key:    []byte{0},
-			expErr: "cannot parse address from commitment key: length byte is zero",
+			name:   "nil",
+			suffix: nil,
+			expErr: "cannot parse account denom to payment key suffix: suffix is empty",
 		},
 		{
-			name:   "addr length byte too large",
-			key:    []byte{6, 1, 2, 3, 4, 5},
-			expErr: "cannot parse address from commitment key: length byte is 6, but slice only has 5 left",
+			name:   "amount length too long",
+			suffix: []byte{3, 1, 2},
+			expErr: "cannot parse account denom to payment key suffix: amount length byte is 3, but suffix only has 2 left",
 		},
 		{
-			name:   "addr length byte too small",
-			key:    []byte{4, 1, 2, 3, 4, 5},
-			expErr: "cannot parse address from commitment key: found 1 bytes after address, expected 0",
+			name:   "no source",
+			suffix: []byte{1, 5},
+			expErr: "cannot parse account denom to payment key suffix: amount length byte is 1, but suffix only has 1 left",
 		},
 		{
-			name:    "1 byte addr",
-			key:     []byte{1, 7},
-			expAddr: sdk.AccAddress{7},
+			name:   "source has length zero",
+			suffix: []byte{1, 5, 0},
+			expErr: "cannot parse account denom to payment key suffix: invalid source: length byte is zero",
 		},
 		{
-			name:    "20 byte addr",
-			key:     []byte{20, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20},
-			expAddr: sdk.AccAddress{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20},
+			name:          "zero amount, no external id",
+			suffix:        []byte{0, 3, 'a', 'b', 'c'},
+			expAmount:     sdkmath.ZeroInt(),
+			expSource:     sdk.AccAddress("abc"),
+			expExternalID: "",
 		},
 		{
-			name: "32 byte addr",
-			key: []byte{32,
-				101, 102, 103, 104, 105, 106, 107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-				117, 118, 119, 120, 121, 122, 123, 124, 125, 126, 127, 128, 129, 130, 131, 132,
-			},
-			expAddr: sdk.AccAddress{
-				101, 102, 103, 104, 105, 106, 107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-				117, 118, 119, 120, 121, 122, 123, 124, 125, 126, 127, 128, 129, 130, 131, 132,
-			},
+			name:          "multi-byte amount, with external id",
+			suffix:        concatBz([]byte{2, 1, 2, 6}, []byte("sourceext-id")),
+			expAmount:     sdkmath.NewInt(258),
+			expSource:     sdk.AccAddress("source"),
+			expExternalID: "ext-id",
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			var addr sdk.AccAddress
+			var amount sdkmath.Int
+			var source sdk.AccAddress
+			var externalID string
 			var err error
 			testFunc := func() {
-				addr, err = keeper.ParseKeySuffixCommitment(tc.key)
+				amount, source, externalID, err = keeper.ParseIndexKeySuffixAccountDenomToPayment(tc.suffix)
 			}
-			require.NotPanics(t, testFunc, "ParseKeySuffixCommitment(%q)", tc.key)
-			assertions.AssertErrorValue(t, err, tc.expErr, "ParseKeySuffixCommitment(%q) error", tc.key)
-			assert.Equal(t, tc.expAddr, addr, "ParseKeySuffixCommitment(%q) addr", tc.key)
+			require.NotPanics(t, testFunc, "ParseIndexKeySuffixAccountDenomToPayment(%v)", tc.suffix)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseIndexKeySuffixAccountDenomToPayment(%v) error", tc.suffix)
+			assert.Equal(t, tc.expAmount.String(), amount.String(), "ParseIndexKeySuffixAccountDenomToPayment(%v) amount", tc.suffix)
+			assert.Equal(t, tc.expSource, source, "ParseIndexKeySuffixAccountDenomToPayment(%v) source", tc.suffix)
+			assert.Equal(t, tc.expExternalID, externalID, "ParseIndexKeySuffixAccountDenomToPayment(%v) external id", tc.suffix)
 		})
 	}
 }
 
-func TestGetKeyPrefixAllPayments(t *testing.T) {
-	ktc := keyTestCase{
-		maker:    keeper.GetKeyPrefixAllPayments,
-		expected: []byte{keeper.KeyTypePayment},
-	}
-	checkKey(t, ktc, "GetKeyPrefixAllPayments()")
-}
-
-func TestGetKeyPrefixPaymentsForSource(t *testing.T) {
+func TestGetIndexKeyPrefixAccountTargetAmountToPayments(t *testing.T) {
 	tests := []struct {
-		name     string
-		source   sdk.AccAddress
-		expected []byte
-		expPanic string
+		name            string
+		account         sdk.AccAddress
+		role            byte
+		hasTargetAmount bool
+		expected        []byte
+		expPanic        string
 	}{
 		{
-			name:     "nil source",
-			source:   nil,
-			expPanic: "empty source address not allowed",
-		},
-		{
-			name:     "empty source",
-			source:   sdk.AccAddress{},
-			expPanic: "empty source address not allowed",
+			name:     "nil account",
+			account:  nil,
+			expPanic: "empty account address not allowed",
 		},
 		{
-			name: "20 byte source",
-			source: sdk.AccAddress{
-				0, 1, 2, 3, 4, 5, 6, 7, 8, 9,
-				10, 11, 12, 13, 14, 15, 16, 17, 18, 19,
-			},
-			expected: []byte{keeper.KeyTypePayment, 20,
-				0, 1, 2, 3, 4, 5, 6, 7, 8, 9,
-				10, 11, 12, 13, 14, 15, 16, 17, 18, 19,
-			},
+			name:            "source role, has target amount",
+			account:         sdk.AccAddress("acct"),
+			role:            keeper.PaymentRoleSource,
+			hasTargetAmount: true,
+			expected:        concatBz([]byte{keeper.KeyTypeAccountTargetAmountToPaymentIndex, 4}, []byte("acct"), []byte{keeper.PaymentRoleSource, 1}),
 		},
 		{
-			name: "32 byte source",
-			source: sdk.AccAddress{
-				51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64, 65, 66,
-				67, 68, 69, 70, 71, 72, 73, 74, 75, 76, 77, 78, 79, 80, 81, 82,
-			},
-			expected: []byte{keeper.KeyTypePayment, 32,
-				51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64, 65, 66,
-				67, 68, 69, 70, 71, 72, 73, 74, 75, 76, 77, 78, 79, 80, 81, 82,
-			},
+			name:            "target role, no target amount",
+			account:         sdk.AccAddress("acct"),
+			role:            keeper.PaymentRoleTarget,
+			hasTargetAmount: false,
+			expected:        concatBz([]byte{keeper.KeyTypeAccountTargetAmountToPaymentIndex, 4}, []byte("acct"), []byte{keeper.PaymentRoleTarget, 0}),
 		},
 	}
 
@@ -4659,90 +10778,57 @@ func TestGetKeyPrefixPaymentsForSource(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			ktc := keyTestCase{
 				maker: func() []byte {
-					return keeper.GetKeyPrefixPaymentsForSource(tc.source)
+					return keeper.GetIndexKeyPrefixAccountTargetAmountToPayments(tc.account, tc.role, tc.hasTargetAmount)
 				},
 				expected: tc.expected,
 				expPanic: tc.expPanic,
-				expPrefixes: []expectedPrefix{
-					{name: "GetKeyPrefixAllPayments", value: keeper.GetKeyPrefixAllPayments()},
-				},
 			}
-			checkKey(t, ktc, "GetKeyPrefixPaymentsForSource(%v)", tc.source)
+			checkKey(t, ktc, "GetIndexKeyPrefixAccountTargetAmountToPayments(%v, %d, %t)", tc.account, tc.role, tc.hasTargetAmount)
 		})
 	}
 }
 
-func TestMakeKeyPayment(t *testing.T) {
+func TestMakeIndexKeyAccountTargetAmountToPayment(t *testing.T) {
 	tests := []struct {
-		name       string
-		source     sdk.AccAddress
-		externalID string
-		expected   []byte
-		expPanic   string
+		name            string
+		account         sdk.AccAddress
+		role            byte
+		hasTargetAmount bool
+		source          sdk.AccAddress
+		externalID      string
+		expected        []byte
+		expPanic        string
 	}{
 		{
-			name:     "nil source",
-			source:   nil,
-			expPanic: "empty source address not allowed",
+			name:     "nil account",
+			account:  nil,
+			source:   sdk.AccAddress("source"),
+			expPanic: "empty account address not allowed",
 		},
 		{
-			name:     "empty source",
-			source:   sdk.AccAddress{},
+			name:     "nil source",
+			account:  sdk.AccAddress("account"),
+			source:   nil,
 			expPanic: "empty source address not allowed",
 		},
 		{
-			name: "20 byte source, empty external id",
-			source: sdk.AccAddress{
-				0, 1, 2, 3, 4, 5, 6, 7, 8, 9,
-				10, 11, 12, 13, 14, 15, 16, 17, 18, 19,
-			},
-			externalID: "",
-			expected: []byte{keeper.KeyTypePayment, 20,
-				0, 1, 2, 3, 4, 5, 6, 7, 8, 9,
-				10, 11, 12, 13, 14, 15, 16, 17, 18, 19,
-			},
-		},
-		{
-			name: "20 byte source, empty external id",
-			source: sdk.AccAddress{
-				0, 1, 2, 3, 4, 5, 6, 7, 8, 9,
-				10, 11, 12, 13, 14, 15, 16, 17, 18, 19,
-			},
-			externalID: "",
-			expected: []byte{keeper.KeyTypePayment, 20,
-				0, 1, 2, 3, 4, 5, 6, 7, 8, 9,
-				10, 11, 12, 13, 14, 15, 16, 17, 18, 19,
-			},
-		},
-		{
-			name: "32 byte source, empty external id",
-			source: sdk.AccAddress{
-				51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64, 65, 66,
-				67, 68, 69, 70, 71, 72, 73, 74, 75, 76, 77, 78, 79, 80, 81, 82,
-			},
-			externalID: "my-eid",
-			expected: concatBz(
-				[]byte{keeper.KeyTypePayment, 32,
-					51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64, 65, 66,
-					67, 68, 69, 70, 71, 72, 73, 74, 75, 76, 77, 78, 79, 80, 81, 82,
-				},
-				[]byte("my-eid"),
-			),
+			name:            "source role, no target amount, no external id",
+			account:         sdk.AccAddress("source"),
+			role:            keeper.PaymentRoleSource,
+			hasTargetAmount: false,
+			source:          sdk.AccAddress("source"),
+			expected: concatBz([]byte{keeper.KeyTypeAccountTargetAmountToPaymentIndex, 6}, []byte("source"),
+				[]byte{keeper.PaymentRoleSource, 0, 6}, []byte("source")),
 		},
 		{
-			name: "32 byte source, with external id",
-			source: sdk.AccAddress{
-				51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64, 65, 66,
-				67, 68, 69, 70, 71, 72, 73, 74, 75, 76, 77, 78, 79, 80, 81, 82,
-			},
-			externalID: "just-some-6f584be7-debd-479b-ae5b-779f36a0bc20-id",
-			expected: concatBz(
-				[]byte{keeper.KeyTypePayment, 32,
-					51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64, 65, 66,
-					67, 68, 69, 70, 71, 72, 73, 74, 75, 76, 77, 78, 79, 80, 81, 82,
-				},
-				[]byte("just-some-6f584be7-debd-479b-ae5b-779f36a0bc20-id"),
-			),
+			name:            "target role, has target amount, with external id",
+			account:         sdk.AccAddress("target"),
+			role:            keeper.PaymentRoleTarget,
+			hasTargetAmount: true,
+			source:          sdk.AccAddress("source"),
+			externalID:      "xyz",
+			expected: concatBz([]byte{keeper.KeyTypeAccountTargetAmountToPaymentIndex, 6}, []byte("target"),
+				[]byte{keeper.PaymentRoleTarget, 1, 6}, []byte("sourcexyz")),
 		},
 	}
 
@@ -4750,185 +10836,279 @@ func TestMakeKeyPayment(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			ktc := keyTestCase{
 				maker: func() []byte {
-					return keeper.MakeKeyPayment(tc.source, tc.externalID)
+					return keeper.MakeIndexKeyAccountTargetAmountToPayment(tc.account, tc.role, tc.hasTargetAmount, tc.source, tc.externalID)
 				},
 				expected: tc.expected,
 				expPanic: tc.expPanic,
 			}
 			if len(tc.expPanic) == 0 {
 				ktc.expPrefixes = []expectedPrefix{
-					{name: "GetKeyPrefixAllPayments", value: keeper.GetKeyPrefixAllPayments()},
-					{name: "GetKeyPrefixPaymentsForSource", value: keeper.GetKeyPrefixPaymentsForSource(tc.source)},
+					{
+						name:  "GetIndexKeyPrefixAccountTargetAmountToPayments",
+						value: keeper.GetIndexKeyPrefixAccountTargetAmountToPayments(tc.account, tc.role, tc.hasTargetAmount),
+					},
 				}
 			}
-			checkKey(t, ktc, "MakeKeyPayment(%v, %q)", tc.source, tc.externalID)
+			checkKey(t, ktc, "MakeIndexKeyAccountTargetAmountToPayment(%v, %d, %t, %v, %q)",
+				tc.account, tc.role, tc.hasTargetAmount, tc.source, tc.externalID)
 		})
 	}
 }
 
-func TestParseKeyPayment(t *testing.T) {
+func TestParseIndexKeySuffixAccountTargetAmountToPayment(t *testing.T) {
 	tests := []struct {
 		name          string
-		key           []byte
+		suffix        []byte
 		expSource     sdk.AccAddress
 		expExternalID string
 		expErr        string
 	}{
 		{
-			name:   "nil key",
-			key:    nil,
-			expErr: "cannot parse payment key: only has 0 bytes, expected at least 3",
+			name:   "nil",
+			suffix: nil,
+			expErr: "cannot parse account target amount to payment key suffix: invalid source: slice is empty",
 		},
 		{
-			name:   "empty key",
-			key:    []byte{},
-			expErr: "cannot parse payment key: only has 0 bytes, expected at least 3",
+			name:   "source has length zero",
+			suffix: []byte{0},
+			expErr: "cannot parse account target amount to payment key suffix: invalid source: length byte is zero",
 		},
 		{
-			name:   "1 byte key",
-			key:    []byte{1},
-			expErr: "cannot parse payment key: only has 1 bytes, expected at least 3",
+			name:          "no external id",
+			suffix:        []byte{3, 'a', 'b', 'c'},
+			expSource:     sdk.AccAddress("abc"),
+			expExternalID: "",
 		},
 		{
-			name:   "2 byte key",
-			key:    []byte{1, 2},
-			expErr: "cannot parse payment key: only has 2 bytes, expected at least 3",
+			name:          "with external id",
+			suffix:        concatBz([]byte{6}, []byte("sourceext-id")),
+			expSource:     sdk.AccAddress("source"),
+			expExternalID: "ext-id",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var source sdk.AccAddress
+			var externalID string
+			var err error
+			testFunc := func() {
+				source, externalID, err = keeper.ParseIndexKeySuffixAccountTargetAmountToPayment(tc.suffix)
+			}
+			require.NotPanics(t, testFunc, "ParseIndexKeySuffixAccountTargetAmountToPayment(%v)", tc.suffix)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseIndexKeySuffixAccountTargetAmountToPayment(%v) error", tc.suffix)
+			assert.Equal(t, tc.expSource, source, "ParseIndexKeySuffixAccountTargetAmountToPayment(%v) source", tc.suffix)
+			assert.Equal(t, tc.expExternalID, externalID, "ParseIndexKeySuffixAccountTargetAmountToPayment(%v) external id", tc.suffix)
+		})
+	}
+}
+
+func TestGetKeyPrefixIdempotencyKeys(t *testing.T) {
+	ktc := keyTestCase{
+		maker: func() []byte {
+			return keeper.GetKeyPrefixIdempotencyKeys()
 		},
+		expected: []byte{keeper.KeyTypeIdempotencyKey},
+	}
+	checkKey(t, ktc, "GetKeyPrefixIdempotencyKeys")
+}
+
+func TestMakeKeyIdempotencyKey(t *testing.T) {
+	tests := []struct {
+		name           string
+		addr           sdk.AccAddress
+		idempotencyKey string
+		expected       []byte
+		expPanic       string
+	}{
 		{
-			name:   "wrong type byte",
-			key:    []byte{0xaa, 5, 0, 1, 2, 3, 4},
-			expErr: "cannot parse payment key: incorrect type byte 0xaa, expected 0x70",
+			name:           "nil addr",
+			addr:           nil,
+			idempotencyKey: "abc",
+			expPanic:       "empty address not allowed",
 		},
 		{
-			name:   "wrong source length",
-			key:    []byte{keeper.KeyTypePayment, 5, 0, 1, 2, 3},
-			expErr: "cannot parse payment key: invalid source: length byte is 5, but slice only has 4 left",
+			name:           "empty addr",
+			addr:           sdk.AccAddress{},
+			idempotencyKey: "abc",
+			expPanic:       "empty address not allowed",
 		},
 		{
-			name:          "okay: with external id",
-			key:           concatBz([]byte{keeper.KeyTypePayment, 5, 0, 1, 2, 3, 4}, []byte("my id")),
-			expSource:     sdk.AccAddress{0, 1, 2, 3, 4},
-			expExternalID: "my id",
+			name:           "5 byte addr",
+			addr:           sdk.AccAddress("abcde"),
+			idempotencyKey: "key",
+			expected:       []byte{keeper.KeyTypeIdempotencyKey, 5, 'a', 'b', 'c', 'd', 'e', 'k', 'e', 'y'},
 		},
 		{
-			name:          "okay: empty external id",
-			key:           []byte{keeper.KeyTypePayment, 5, 0, 1, 2, 3, 4},
-			expSource:     sdk.AccAddress{0, 1, 2, 3, 4},
-			expExternalID: "",
+			name:           "20 byte addr",
+			addr:           sdk.AccAddress("abcdefghijklmnopqrst"),
+			idempotencyKey: "x",
+			expected: concatBz(
+				[]byte{keeper.KeyTypeIdempotencyKey, 20},
+				[]byte("abcdefghijklmnopqrst"),
+				[]byte{'x'},
+			),
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			var source sdk.AccAddress
-			var externalID string
-			var err error
-			testFunc := func() {
-				source, externalID, err = keeper.ParseKeyPayment(tc.key)
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeKeyIdempotencyKey(tc.addr, tc.idempotencyKey)
+				},
+				expected: tc.expected,
+				expPanic: tc.expPanic,
 			}
-			require.NotPanics(t, testFunc, "ParseKeyPayment(%v)", tc.key)
-			assertions.AssertErrorValue(t, err, tc.expErr, "ParseKeyPayment(%v) error", tc.key)
-			assert.Equal(t, tc.expSource, source, "ParseKeyPayment(%v) source", tc.key)
-			assert.Equal(t, tc.expExternalID, externalID, "ParseKeyPayment(%v) externalID", tc.key)
+			if len(tc.expPanic) == 0 {
+				ktc.expPrefixes = []expectedPrefix{
+					{name: "GetKeyPrefixIdempotencyKeys", value: keeper.GetKeyPrefixIdempotencyKeys()},
+				}
+			}
+			checkKey(t, ktc, "MakeKeyIdempotencyKey(%s, %q)", tc.addr, tc.idempotencyKey)
 		})
 	}
 }
 
-func TestParseKeySuffixPayment(t *testing.T) {
+func TestParseKeyIdempotencyKey(t *testing.T) {
 	tests := []struct {
-		name          string
-		key           []byte
-		expSource     sdk.AccAddress
-		expExternalID string
-		expErr        string
+		name              string
+		key               []byte
+		expAddr           sdk.AccAddress
+		expIdempotencyKey string
+		expErr            string
 	}{
 		{
-			name:   "nil suffix",
+			name:   "nil key",
 			key:    nil,
-			expErr: "cannot parse payment key: invalid source: slice is empty",
+			expErr: "cannot parse idempotency key entry key: only has 0 bytes, expected at least 4",
 		},
 		{
-			name:   "empty suffix",
-			key:    []byte{},
-			expErr: "cannot parse payment key: invalid source: slice is empty",
+			name:   "3 bytes",
+			key:    []byte{keeper.KeyTypeIdempotencyKey, 1, 'a'},
+			expErr: "cannot parse idempotency key entry key: only has 3 bytes, expected at least 4",
 		},
 		{
-			name:   "wrong source length",
-			key:    []byte{5, 0, 1, 2, 3},
-			expErr: "cannot parse payment key: invalid source: length byte is 5, but slice only has 4 left",
+			name:   "wrong type byte",
+			key:    []byte{keeper.KeyTypeHeightToIdempotencyKeyIndex, 1, 'a', 'k'},
+			expErr: "cannot parse idempotency key entry key: incorrect type byte 0x26",
 		},
 		{
-			name:          "okay: with external id",
-			key:           concatBz([]byte{5, 0, 1, 2, 3, 4}, []byte("my id")),
-			expSource:     sdk.AccAddress{0, 1, 2, 3, 4},
-			expExternalID: "my id",
+			name:   "addr length too long",
+			key:    []byte{keeper.KeyTypeIdempotencyKey, 5, 'a', 'b', 'c'},
+			expErr: "cannot parse address from idempotency key entry key: length byte is 5, but slice only has 3 left",
 		},
 		{
-			name:          "okay: empty external id",
-			key:           []byte{5, 0, 1, 2, 3, 4},
-			expSource:     sdk.AccAddress{0, 1, 2, 3, 4},
-			expExternalID: "",
+			name:   "no idempotency key",
+			key:    []byte{keeper.KeyTypeIdempotencyKey, 3, 'a', 'b', 'c'},
+			expErr: "cannot parse idempotency key entry key: no idempotency key found",
+		},
+		{
+			name:              "good key",
+			key:               keeper.MakeKeyIdempotencyKey(sdk.AccAddress("addr________________"), "retry-1"),
+			expAddr:           sdk.AccAddress("addr________________"),
+			expIdempotencyKey: "retry-1",
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			var source sdk.AccAddress
-			var externalID string
+			var addr sdk.AccAddress
+			var idempotencyKey string
 			var err error
 			testFunc := func() {
-				source, externalID, err = keeper.ParseKeySuffixPayment(tc.key)
+				addr, idempotencyKey, err = keeper.ParseKeyIdempotencyKey(tc.key)
 			}
-			require.NotPanics(t, testFunc, "ParseKeySuffixPayment(%v)", tc.key)
-			assertions.AssertErrorValue(t, err, tc.expErr, "ParseKeySuffixPayment(%v) error", tc.key)
-			assert.Equal(t, tc.expSource, source, "ParseKeySuffixPayment(%v) source", tc.key)
-			assert.Equal(t, tc.expExternalID, externalID, "ParseKeySuffixPayment(%v) externalID", tc.key)
+			require.NotPanics(t, testFunc, "ParseKeyIdempotencyKey(%v)", tc.key)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseKeyIdempotencyKey(%v) error", tc.key)
+			assert.Equal(t, tc.expAddr, addr, "ParseKeyIdempotencyKey(%v) addr", tc.key)
+			assert.Equal(t, tc.expIdempotencyKey, idempotencyKey, "ParseKeyIdempotencyKey(%v) idempotency key", tc.key)
 		})
 	}
 }
 
-func TestGetIndexKeyPrefixTargetToPayments(t *testing.T) {
+func TestGetIndexKeyPrefixHeightToIdempotencyKey(t *testing.T) {
+	ktc := keyTestCase{
+		maker: func() []byte {
+			return keeper.GetIndexKeyPrefixHeightToIdempotencyKey()
+		},
+		expected: []byte{keeper.KeyTypeHeightToIdempotencyKeyIndex},
+	}
+	checkKey(t, ktc, "GetIndexKeyPrefixHeightToIdempotencyKey")
+}
+
+func TestGetIndexKeyPrefixHeightToIdempotencyKeyAt(t *testing.T) {
 	tests := []struct {
 		name     string
-		target   sdk.AccAddress
+		height   int64
 		expected []byte
-		expPanic string
 	}{
 		{
-			name:     "nil target",
-			target:   nil,
-			expPanic: "empty target address not allowed",
+			name:     "height 1",
+			height:   1,
+			expected: []byte{keeper.KeyTypeHeightToIdempotencyKeyIndex, 0, 0, 0, 0, 0, 0, 0, 1},
 		},
 		{
-			name:     "empty target",
-			target:   sdk.AccAddress{},
-			expPanic: "empty target address not allowed",
+			name:     "height 578,437,695,752,307,201",
+			height:   578_437_695_752_307_201,
+			expected: []byte{keeper.KeyTypeHeightToIdempotencyKeyIndex, 8, 7, 6, 5, 4, 3, 2, 1},
 		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetIndexKeyPrefixHeightToIdempotencyKeyAt(tc.height)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetIndexKeyPrefixHeightToIdempotencyKey", value: keeper.GetIndexKeyPrefixHeightToIdempotencyKey()},
+				},
+			}
+			checkKey(t, ktc, "GetIndexKeyPrefixHeightToIdempotencyKeyAt(%d)", tc.height)
+		})
+	}
+}
+
+func TestMakeIndexKeyHeightToIdempotencyKey(t *testing.T) {
+	tests := []struct {
+		name           string
+		height         int64
+		addr           sdk.AccAddress
+		idempotencyKey string
+		expected       []byte
+		expPanic       string
+	}{
 		{
-			name:     "1 byte target",
-			target:   sdk.AccAddress{1},
-			expected: []byte{keeper.KeyTypeTargetToPaymentIndex, 1, 1},
+			name:           "nil addr",
+			height:         1,
+			addr:           nil,
+			idempotencyKey: "abc",
+			expPanic:       "empty address not allowed",
 		},
 		{
-			name: "20 byte target",
-			target: sdk.AccAddress{
-				1, 2, 3, 4, 5, 6, 7, 8, 9, 10,
-				11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
-			},
-			expected: []byte{keeper.KeyTypeTargetToPaymentIndex, 20,
-				1, 2, 3, 4, 5, 6, 7, 8, 9, 10,
-				11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
+			name:           "height 1",
+			height:         1,
+			addr:           sdk.AccAddress("abc"),
+			idempotencyKey: "key",
+			expected: []byte{
+				keeper.KeyTypeHeightToIdempotencyKeyIndex,
+				0, 0, 0, 0, 0, 0, 0, 1,
+				3, 'a', 'b', 'c',
+				'k', 'e', 'y',
 			},
 		},
 		{
-			name: "32 byte target",
-			target: sdk.AccAddress{
-				152, 154, 156, 158, 160, 162, 164, 166, 168, 170, 172, 174, 176, 178, 180, 182,
-				184, 186, 188, 190, 192, 194, 196, 198, 200, 202, 204, 206, 208, 210, 212, 214,
-			},
-			expected: []byte{keeper.KeyTypeTargetToPaymentIndex, 32,
-				152, 154, 156, 158, 160, 162, 164, 166, 168, 170, 172, 174, 176, 178, 180, 182,
-				184, 186, 188, 190, 192, 194, 196, 198, 200, 202, 204, 206, 208, 210, 212, 214,
+			name:           "height 578,437,695,752,307,201",
+			height:         578_437_695_752_307_201,
+			addr:           sdk.AccAddress("abcde"),
+			idempotencyKey: "z",
+			expected: []byte{
+				keeper.KeyTypeHeightToIdempotencyKeyIndex,
+				8, 7, 6, 5, 4, 3, 2, 1,
+				5, 'a', 'b', 'c', 'd', 'e',
+				'z',
 			},
 		},
 	}
@@ -4937,111 +11117,123 @@ func TestGetIndexKeyPrefixTargetToPayments(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			ktc := keyTestCase{
 				maker: func() []byte {
-					return keeper.GetIndexKeyPrefixTargetToPayments(tc.target)
+					return keeper.MakeIndexKeyHeightToIdempotencyKey(tc.height, tc.addr, tc.idempotencyKey)
 				},
 				expected: tc.expected,
 				expPanic: tc.expPanic,
 			}
-			checkKey(t, ktc, "GetIndexKeyPrefixTargetToPayments(%v)", tc.target)
+			if len(tc.expPanic) == 0 {
+				ktc.expPrefixes = []expectedPrefix{
+					{name: "GetIndexKeyPrefixHeightToIdempotencyKey", value: keeper.GetIndexKeyPrefixHeightToIdempotencyKey()},
+					{name: "GetIndexKeyPrefixHeightToIdempotencyKeyAt", value: keeper.GetIndexKeyPrefixHeightToIdempotencyKeyAt(tc.height)},
+				}
+			}
+			checkKey(t, ktc, "MakeIndexKeyHeightToIdempotencyKey(%d, %s, %q)", tc.height, tc.addr, tc.idempotencyKey)
 		})
 	}
 }
 
-func TestGetIndexKeyPrefixTargetToPaymentsForSource(t *testing.T) {
+func TestParseIndexKeyHeightToIdempotencyKey(t *testing.T) {
 	tests := []struct {
-		name     string
-		target   sdk.AccAddress
-		source   sdk.AccAddress
-		expected []byte
-		expPanic string
+		name              string
+		key               []byte
+		expHeight         int64
+		expAddr           sdk.AccAddress
+		expIdempotencyKey string
+		expErr            string
 	}{
 		{
-			name:     "nil source and target",
-			target:   nil,
-			source:   nil,
-			expPanic: "empty source address not allowed",
+			name:   "nil key",
+			key:    nil,
+			expErr: "cannot parse height to idempotency key index key: only has 0 bytes, expected at least 12",
 		},
 		{
-			name:     "empty source and target",
-			target:   sdk.AccAddress{},
-			source:   sdk.AccAddress{},
-			expPanic: "empty source address not allowed",
+			name:   "11 bytes",
+			key:    []byte{keeper.KeyTypeHeightToIdempotencyKeyIndex, 0, 0, 0, 0, 0, 0, 0, 1, 1, 'a'},
+			expErr: "cannot parse height to idempotency key index key: only has 11 bytes, expected at least 12",
 		},
 		{
-			name:     "nil source",
-			target:   sdk.AccAddress{1, 2, 3},
-			source:   nil,
-			expPanic: "empty source address not allowed",
+			name:   "wrong type byte",
+			key:    []byte{keeper.KeyTypeIdempotencyKey, 0, 0, 0, 0, 0, 0, 0, 1, 1, 'a', 'k'},
+			expErr: "cannot parse height to idempotency key index key: incorrect type byte 0x25",
 		},
 		{
-			name:     "empty source",
-			target:   sdk.AccAddress{1, 2, 3},
-			source:   sdk.AccAddress{},
-			expPanic: "empty source address not allowed",
+			name:   "addr length too long",
+			key:    []byte{keeper.KeyTypeHeightToIdempotencyKeyIndex, 0, 0, 0, 0, 0, 0, 0, 1, 4, 'a', 'k'},
+			expErr: "cannot parse address from height to idempotency key index key: length byte is 4, but slice only has 2 left",
 		},
 		{
-			name:     "nil target",
-			target:   nil,
-			source:   sdk.AccAddress{1, 2, 3},
-			expPanic: "empty target address not allowed",
+			name:   "no idempotency key",
+			key:    []byte{keeper.KeyTypeHeightToIdempotencyKeyIndex, 0, 0, 0, 0, 0, 0, 0, 1, 2, 'a', 'b'},
+			expErr: "cannot parse height to idempotency key index key: no idempotency key found",
 		},
 		{
-			name:     "empty target",
-			target:   sdk.AccAddress{},
-			source:   sdk.AccAddress{1, 2, 3},
-			expPanic: "empty target address not allowed",
+			name:              "good key",
+			key:               keeper.MakeIndexKeyHeightToIdempotencyKey(578_437_695_752_307_201, sdk.AccAddress("addr________________"), "retry-1"),
+			expHeight:         578_437_695_752_307_201,
+			expAddr:           sdk.AccAddress("addr________________"),
+			expIdempotencyKey: "retry-1",
 		},
-		{
-			name: "20 byte target and source",
-			target: sdk.AccAddress{
-				0, 5, 10, 15, 20, 25, 30, 35, 40, 45,
-				50, 55, 60, 65, 70, 75, 80, 85, 90, 95,
-			},
-			source: sdk.AccAddress{
-				51, 53, 55, 57, 59, 61, 63, 65, 67, 69,
-				71, 73, 75, 77, 79, 81, 83, 85, 87, 89,
-			},
-			expected: []byte{keeper.KeyTypeTargetToPaymentIndex,
-				20, 0, 5, 10, 15, 20, 25, 30, 35, 40, 45,
-				50, 55, 60, 65, 70, 75, 80, 85, 90, 95,
-				20, 51, 53, 55, 57, 59, 61, 63, 65, 67, 69,
-				71, 73, 75, 77, 79, 81, 83, 85, 87, 89,
-			},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var height int64
+			var addr sdk.AccAddress
+			var idempotencyKey string
+			var err error
+			testFunc := func() {
+				height, addr, idempotencyKey, err = keeper.ParseIndexKeyHeightToIdempotencyKey(tc.key)
+			}
+			require.NotPanics(t, testFunc, "ParseIndexKeyHeightToIdempotencyKey(%v)", tc.key)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseIndexKeyHeightToIdempotencyKey(%v) error", tc.key)
+			assert.Equal(t, tc.expHeight, height, "ParseIndexKeyHeightToIdempotencyKey(%v) height", tc.key)
+			assert.Equal(t, tc.expAddr, addr, "ParseIndexKeyHeightToIdempotencyKey(%v) addr", tc.key)
+			assert.Equal(t, tc.expIdempotencyKey, idempotencyKey, "ParseIndexKeyHeightToIdempotencyKey(%v) idempotency key", tc.key)
+		})
+	}
+}
+
+func TestMakeKeyLastSettlementID(t *testing.T) {
+	ktc := keyTestCase{
+		maker: func() []byte {
+			return keeper.MakeKeyLastSettlementID()
+		},
+		expected: []byte{keeper.KeyTypeLastSettlementID},
+	}
+	checkKey(t, ktc, "MakeKeyLastSettlementID")
+}
+
+func TestGetKeyPrefixSettlementReceipt(t *testing.T) {
+	ktc := keyTestCase{
+		maker: func() []byte {
+			return keeper.GetKeyPrefixSettlementReceipt()
 		},
+		expected: []byte{keeper.KeyTypeSettlementReceipt},
+	}
+	checkKey(t, ktc, "GetKeyPrefixSettlementReceipt")
+}
+
+func TestMakeKeySettlementReceipt(t *testing.T) {
+	tests := []struct {
+		name         string
+		settlementID uint64
+		expected     []byte
+	}{
 		{
-			name: "32 byte target and source",
-			target: sdk.AccAddress{
-				197, 162, 106, 22, 183, 29, 50, 134, 204, 155, 36, 64, 15, 1, 78, 120,
-				211, 43, 169, 85, 127, 113, 190, 92, 71, 218, 176, 99, 8, 148, 57, 141,
-			},
-			source: sdk.AccAddress{
-				178, 17, 3, 73, 206, 129, 150, 136, 108, 45, 199, 59, 213, 94, 143, 31,
-				164, 101, 52, 24, 220, 80, 10, 122, 38, 157, 115, 66, 185, 171, 87, 192,
-			},
-			expected: []byte{keeper.KeyTypeTargetToPaymentIndex,
-				32, 197, 162, 106, 22, 183, 29, 50, 134, 204, 155, 36, 64, 15, 1, 78, 120,
-				211, 43, 169, 85, 127, 113, 190, 92, 71, 218, 176, 99, 8, 148, 57, 141,
-				32, 178, 17, 3, 73, 206, 129, 150, 136, 108, 45, 199, 59, 213, 94, 143, 31,
-				164, 101, 52, 24, 220, 80, 10, 122, 38, 157, 115, 66, 185, 171, 87, 192,
-			},
+			name:         "zero",
+			settlementID: 0,
+			expected:     []byte{keeper.KeyTypeSettlementReceipt, 0, 0, 0, 0, 0, 0, 0, 0},
 		},
 		{
-			name:   "5 byte target and 10 byte source",
-			target: sdk.AccAddress{37, 44, 133, 148, 222},
-			source: sdk.AccAddress{77, 239, 153, 70, 130, 115, 169, 117, 39, 151},
-			expected: []byte{keeper.KeyTypeTargetToPaymentIndex,
-				5, 37, 44, 133, 148, 222,
-				10, 77, 239, 153, 70, 130, 115, 169, 117, 39, 151,
-			},
+			name:         "one",
+			settlementID: 1,
+			expected:     []byte{keeper.KeyTypeSettlementReceipt, 0, 0, 0, 0, 0, 0, 0, 1},
 		},
 		{
-			name:   "10 byte target and 5 byte source",
-			target: sdk.AccAddress{163, 126, 189, 116, 202, 218, 38, 74, 146, 90},
-			source: sdk.AccAddress{121, 88, 212, 152, 171},
-			expected: []byte{keeper.KeyTypeTargetToPaymentIndex,
-				10, 163, 126, 189, 116, 202, 218, 38, 74, 146, 90,
-				5, 121, 88, 212, 152, 171,
-			},
+			name:         "578,437,695,752,307,201",
+			settlementID: 578_437_695_752_307_201,
+			expected:     []byte{keeper.KeyTypeSettlementReceipt, 8, 7, 6, 5, 4, 3, 2, 1},
 		},
 	}
 
@@ -5049,158 +11241,100 @@ func TestGetIndexKeyPrefixTargetToPaymentsForSource(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			ktc := keyTestCase{
 				maker: func() []byte {
-					return keeper.GetIndexKeyPrefixTargetToPaymentsForSource(tc.target, tc.source)
+					return keeper.MakeKeySettlementReceipt(tc.settlementID)
 				},
 				expected: tc.expected,
-				expPanic: tc.expPanic,
-			}
-			if len(tc.expPanic) == 0 {
-				ktc.expPrefixes = []expectedPrefix{
-					{name: "GetIndexKeyPrefixTargetToPayments", value: keeper.GetIndexKeyPrefixTargetToPayments(tc.target)},
-				}
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixSettlementReceipt", value: keeper.GetKeyPrefixSettlementReceipt()},
+				},
 			}
-			checkKey(t, ktc, "GetIndexKeyPrefixTargetToPaymentsForSource(%v, %v)", tc.target, tc.source)
+			checkKey(t, ktc, "MakeKeySettlementReceipt(%d)", tc.settlementID)
 		})
 	}
 }
 
-func TestMakeIndexKeyTargetToPayment(t *testing.T) {
+func TestParseKeySettlementReceipt(t *testing.T) {
 	tests := []struct {
-		name       string
-		target     sdk.AccAddress
-		source     sdk.AccAddress
-		externalID string
-		expected   []byte
-		expPanic   string
+		name            string
+		key             []byte
+		expSettlementID uint64
+		expErr          string
 	}{
-		{
-			name:       "nil target",
-			target:     nil,
-			source:     sdk.AccAddress{1, 2, 3},
-			externalID: "abc",
-			expPanic:   "empty target address not allowed",
-		},
-		{
-			name:       "empty target",
-			target:     sdk.AccAddress{},
-			source:     sdk.AccAddress{1, 2, 3},
-			externalID: "abc",
-			expPanic:   "empty target address not allowed",
-		},
-		{
-			name:       "nil source",
-			target:     sdk.AccAddress{1, 2, 3},
-			source:     nil,
-			externalID: "abc",
-			expPanic:   "empty source address not allowed",
-		},
-		{
-			name:       "empty source",
-			target:     sdk.AccAddress{1, 2, 3},
-			source:     sdk.AccAddress{},
-			externalID: "abc",
-			expPanic:   "empty source address not allowed",
-		},
-		{
-			name: "20 byte addrs, empty external id",
-			target: sdk.AccAddress{
-				0, 5, 10, 15, 20, 25, 30, 35, 40, 45,
-				50, 55, 60, 65, 70, 75, 80, 85, 90, 95,
-			},
-			source: sdk.AccAddress{
-				51, 53, 55, 57, 59, 61, 63, 65, 67, 69,
-				71, 73, 75, 77, 79, 81, 83, 85, 87, 89,
-			},
-			externalID: "",
-			expected: []byte{keeper.KeyTypeTargetToPaymentIndex,
-				20, 0, 5, 10, 15, 20, 25, 30, 35, 40, 45,
-				50, 55, 60, 65, 70, 75, 80, 85, 90, 95,
-				20, 51, 53, 55, 57, 59, 61, 63, 65, 67, 69,
-				71, 73, 75, 77, 79, 81, 83, 85, 87, 89,
-			},
-		},
-		{
-			name: "20 byte addrs, 10 byte external id",
-			target: sdk.AccAddress{
-				50, 55, 60, 65, 70, 75, 80, 85, 90, 95,
-				0, 5, 10, 15, 20, 25, 30, 35, 40, 45,
-			},
-			source: sdk.AccAddress{
-				71, 73, 75, 77, 79, 81, 83, 85, 87, 89,
-				51, 53, 55, 57, 59, 61, 63, 65, 67, 69,
-			},
-			externalID: "abcdefghij",
-			expected: concatBz(
-				[]byte{keeper.KeyTypeTargetToPaymentIndex,
-					20, 50, 55, 60, 65, 70, 75, 80, 85, 90, 95,
-					0, 5, 10, 15, 20, 25, 30, 35, 40, 45,
-					20, 71, 73, 75, 77, 79, 81, 83, 85, 87, 89,
-					51, 53, 55, 57, 59, 61, 63, 65, 67, 69,
-				},
-				[]byte("abcdefghij"),
-			),
+		{
+			name:   "nil key",
+			key:    nil,
+			expErr: "cannot parse settlement receipt key: has 0 bytes, expected 9",
 		},
 		{
-			name: "32 byte addrs, no external id",
-			target: sdk.AccAddress{
-				197, 162, 106, 22, 183, 29, 50, 134, 204, 155, 36, 64, 15, 1, 78, 120,
-				211, 43, 169, 85, 127, 113, 190, 92, 71, 218, 176, 99, 8, 148, 57, 141,
-			},
-			source: sdk.AccAddress{
-				178, 17, 3, 73, 206, 129, 150, 136, 108, 45, 199, 59, 213, 94, 143, 31,
-				164, 101, 52, 24, 220, 80, 10, 122, 38, 157, 115, 66, 185, 171, 87, 192,
-			},
-			externalID: "",
-			expected: []byte{keeper.KeyTypeTargetToPaymentIndex,
-				32, 197, 162, 106, 22, 183, 29, 50, 134, 204, 155, 36, 64, 15, 1, 78, 120,
-				211, 43, 169, 85, 127, 113, 190, 92, 71, 218, 176, 99, 8, 148, 57, 141,
-				32, 178, 17, 3, 73, 206, 129, 150, 136, 108, 45, 199, 59, 213, 94, 143, 31,
-				164, 101, 52, 24, 220, 80, 10, 122, 38, 157, 115, 66, 185, 171, 87, 192,
-			},
+			name:   "8 bytes",
+			key:    []byte{keeper.KeyTypeSettlementReceipt, 0, 0, 0, 0, 0, 0, 1},
+			expErr: "cannot parse settlement receipt key: has 8 bytes, expected 9",
 		},
 		{
-			name: "32 byte addrs, 100 byte external id",
-			target: sdk.AccAddress{
-				211, 43, 169, 85, 127, 113, 190, 92, 71, 218, 176, 99, 8, 148, 57, 141,
-				197, 162, 106, 22, 183, 29, 50, 134, 204, 155, 36, 64, 15, 1, 78, 120,
-			},
-			source: sdk.AccAddress{
-				164, 101, 52, 24, 220, 80, 10, 122, 38, 157, 115, 66, 185, 171, 87, 192,
-				178, 17, 3, 73, 206, 129, 150, 136, 108, 45, 199, 59, 213, 94, 143, 31,
-			},
-			externalID: strings.Repeat("prov", 25),
-			expected: concatBz(
-				[]byte{keeper.KeyTypeTargetToPaymentIndex,
-					32, 211, 43, 169, 85, 127, 113, 190, 92, 71, 218, 176, 99, 8, 148, 57, 141,
-					197, 162, 106, 22, 183, 29, 50, 134, 204, 155, 36, 64, 15, 1, 78, 120,
-					32, 164, 101, 52, 24, 220, 80, 10, 122, 38, 157, 115, 66, 185, 171, 87, 192,
-					178, 17, 3, 73, 206, 129, 150, 136, 108, 45, 199, 59, 213, 94, 143, 31,
-				},
-				bytes.Repeat([]byte("prov"), 25),
-			),
+			name:   "10 bytes",
+			key:    []byte{keeper.KeyTypeSettlementReceipt, 0, 0, 0, 0, 0, 0, 0, 1, 2},
+			expErr: "cannot parse settlement receipt key: has 10 bytes, expected 9",
 		},
 		{
-			name:       "5 byte target, 10 byte source, 15 byte external id",
-			target:     sdk.AccAddress{119, 120, 199, 30, 126},
-			source:     sdk.AccAddress{125, 135, 144, 240, 156, 163, 157, 130, 158, 68},
-			externalID: string([]byte{137, 78, 56, 142, 173, 94, 178, 166, 16, 109, 96, 24, 31, 218, 17}),
-			expected: []byte{keeper.KeyTypeTargetToPaymentIndex,
-				5, 119, 120, 199, 30, 126,
-				10, 125, 135, 144, 240, 156, 163, 157, 130, 158, 68,
-				137, 78, 56, 142, 173, 94, 178, 166, 16, 109, 96, 24, 31, 218, 17,
-			},
+			name:   "wrong type byte",
+			key:    []byte{keeper.KeyTypeLastSettlementID, 0, 0, 0, 0, 0, 0, 0, 1},
+			expErr: "cannot parse settlement receipt key: incorrect type byte 0x27",
 		},
 		{
-			name:       "10 byte target, 5 byte source, 3 byte external id",
-			target:     sdk.AccAddress{17, 154, 138, 160, 186, 42, 91, 212, 23, 190},
-			source:     sdk.AccAddress{93, 172, 201, 243, 165},
-			externalID: string([]byte{169, 167, 148}),
-			expected: []byte{
-				keeper.KeyTypeTargetToPaymentIndex,
-				10, 17, 154, 138, 160, 186, 42, 91, 212, 23, 190,
-				5, 93, 172, 201, 243, 165,
-				169, 167, 148,
-			},
+			name:            "good key",
+			key:             keeper.MakeKeySettlementReceipt(578_437_695_752_307_201),
+			expSettlementID: 578_437_695_752_307_201,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var settlementID uint64
+			var err error
+			testFunc := func() {
+				settlementID, err = keeper.ParseKeySettlementReceipt(tc.key)
+			}
+			require.NotPanics(t, testFunc, "ParseKeySettlementReceipt(%v)", tc.key)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseKeySettlementReceipt(%v) error", tc.key)
+			assert.Equal(t, tc.expSettlementID, settlementID, "ParseKeySettlementReceipt(%v) settlement id", tc.key)
+		})
+	}
+}
+
+func TestGetKeyPrefixDeadManSwitches(t *testing.T) {
+	ktc := keyTestCase{
+		maker:    keeper.GetKeyPrefixDeadManSwitches,
+		expected: []byte{keeper.KeyTypeDeadManSwitch},
+	}
+	checkKey(t, ktc, "GetKeyPrefixDeadManSwitches()")
+}
+
+func TestMakeKeyDeadManSwitch(t *testing.T) {
+	tests := []struct {
+		name     string
+		addr     sdk.AccAddress
+		expected []byte
+		expPanic string
+	}{
+		{
+			name:     "nil address",
+			addr:     nil,
+			expPanic: "empty address not allowed",
+		},
+		{
+			name:     "empty address",
+			addr:     sdk.AccAddress{},
+			expPanic: "empty address not allowed",
+		},
+		{
+			name:     "5 byte address",
+			addr:     sdk.AccAddress("abcde"),
+			expected: append([]byte{keeper.KeyTypeDeadManSwitch, 5}, "abcde"...),
+		},
+		{
+			name:     "20 byte address",
+			addr:     sdk.AccAddress("abcdefghijklmnopqrst"),
+			expected: append([]byte{keeper.KeyTypeDeadManSwitch, 20}, "abcdefghijklmnopqrst"...),
 		},
 	}
 
@@ -5208,170 +11342,176 @@ func TestMakeIndexKeyTargetToPayment(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			ktc := keyTestCase{
 				maker: func() []byte {
-					return keeper.MakeIndexKeyTargetToPayment(tc.target, tc.source, tc.externalID)
+					return keeper.MakeKeyDeadManSwitch(tc.addr)
 				},
 				expected: tc.expected,
 				expPanic: tc.expPanic,
 			}
 			if len(tc.expPanic) == 0 {
 				ktc.expPrefixes = []expectedPrefix{
-					{
-						name:  "GetIndexKeyPrefixTargetToPayments",
-						value: keeper.GetIndexKeyPrefixTargetToPayments(tc.target),
-					},
-					{
-						name:  "GetIndexKeyPrefixTargetToPaymentsForSource",
-						value: keeper.GetIndexKeyPrefixTargetToPaymentsForSource(tc.target, tc.source),
-					},
+					{name: "GetKeyPrefixDeadManSwitches", value: keeper.GetKeyPrefixDeadManSwitches()},
 				}
 			}
-			checkKey(t, ktc, "MakeIndexKeyTargetToPayment(%v, %v, %q)", tc.target, tc.source, tc.externalID)
+			checkKey(t, ktc, "MakeKeyDeadManSwitch(%v)", tc.addr)
 		})
 	}
 }
 
-func TestParseIndexKeyTargetToPayment(t *testing.T) {
+func TestGetIndexKeyPrefixDeadlineToDeadManSwitch(t *testing.T) {
+	ktc := keyTestCase{
+		maker: func() []byte {
+			return keeper.GetIndexKeyPrefixDeadlineToDeadManSwitch()
+		},
+		expected: []byte{keeper.KeyTypeDeadlineToDeadManSwitchIndex},
+	}
+	checkKey(t, ktc, "GetIndexKeyPrefixDeadlineToDeadManSwitch")
+}
+
+func TestGetIndexKeyPrefixDeadlineToDeadManSwitchAt(t *testing.T) {
 	tests := []struct {
-		name          string
-		key           []byte
-		expTarget     sdk.AccAddress
-		expSource     sdk.AccAddress
-		expExternalID string
-		expErr        string
+		name     string
+		deadline time.Time
+		expected []byte
 	}{
 		{
-			name:   "nil",
-			key:    nil,
-			expErr: "cannot parse target to payment index key: only has 0 bytes, expected at least 5",
-		},
-		{
-			name:   "empty",
-			key:    []byte{},
-			expErr: "cannot parse target to payment index key: only has 0 bytes, expected at least 5",
-		},
-		{
-			name:   "4 bytes",
-			key:    []byte{1, 2, 3, 4},
-			expErr: "cannot parse target to payment index key: only has 4 bytes, expected at least 5",
+			name:     "one second after epoch",
+			deadline: time.Unix(1, 0),
+			expected: []byte{keeper.KeyTypeDeadlineToDeadManSwitchIndex, 0, 0, 0, 0, 0, 0, 0, 1},
 		},
 		{
-			name:   "wrong type byte",
-			key:    []byte{1, 2, 3, 4, 5},
-			expErr: "cannot parse target to payment index key: incorrect type byte 0x1, expected 0x10",
+			name:     "sub-second part is ignored",
+			deadline: time.Unix(1, 999_999_999),
+			expected: []byte{keeper.KeyTypeDeadlineToDeadManSwitchIndex, 0, 0, 0, 0, 0, 0, 0, 1},
 		},
 		{
-			name:   "target has length zero",
-			key:    []byte{keeper.KeyTypeTargetToPaymentIndex, 0, 5, 1, 2, 3, 4, 5},
-			expErr: "cannot parse target to payment index key: invalid target: length byte is zero",
+			name:     "2030-01-01 in a different time zone",
+			deadline: time.Date(2029, 12, 31, 18, 0, 0, 0, time.FixedZone("CST", -6*60*60)),
+			expected: []byte{keeper.KeyTypeDeadlineToDeadManSwitchIndex, 0, 0, 0, 0, 0x70, 0xdb, 0xd8, 0x80},
 		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetIndexKeyPrefixDeadlineToDeadManSwitchAt(tc.deadline)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetIndexKeyPrefixDeadlineToDeadManSwitch", value: keeper.GetIndexKeyPrefixDeadlineToDeadManSwitch()},
+				},
+			}
+			checkKey(t, ktc, "GetIndexKeyPrefixDeadlineToDeadManSwitchAt(%s)", tc.deadline)
+		})
+	}
+}
+
+func TestMakeIndexKeyDeadlineToDeadManSwitch(t *testing.T) {
+	tests := []struct {
+		name     string
+		deadline time.Time
+		addr     sdk.AccAddress
+		expected []byte
+		expPanic string
+	}{
 		{
-			name:   "source has length zero",
-			key:    []byte{keeper.KeyTypeTargetToPaymentIndex, 5, 1, 2, 3, 4, 5, 0},
-			expErr: "cannot parse target to payment index key: invalid source: length byte is zero",
+			name:     "nil address",
+			deadline: time.Unix(1, 0),
+			addr:     nil,
+			expPanic: "empty address not allowed",
 		},
 		{
-			name:          "external id has length zero",
-			key:           []byte{keeper.KeyTypeTargetToPaymentIndex, 3, 1, 2, 3, 4, 11, 12, 13, 14},
-			expTarget:     sdk.AccAddress{1, 2, 3},
-			expSource:     sdk.AccAddress{11, 12, 13, 14},
-			expExternalID: "",
+			name:     "one second after epoch, 5 byte address",
+			deadline: time.Unix(1, 0),
+			addr:     sdk.AccAddress("abcde"),
+			expected: append([]byte{keeper.KeyTypeDeadlineToDeadManSwitchIndex,
+				0, 0, 0, 0, 0, 0, 0, 1, 5}, "abcde"...),
 		},
 		{
-			name: "external id has content",
-			key: []byte{keeper.KeyTypeTargetToPaymentIndex,
-				32, 247, 208, 145, 192, 91, 18, 179, 34, 96, 28, 40, 70, 225, 125, 71, 10,
-				255, 84, 50, 68, 245, 118, 123, 33, 104, 75, 12, 58, 168, 177, 107, 205,
-				20, 117, 169, 6, 140, 176, 237, 220, 171, 100, 213,
-				235, 204, 250, 195, 114, 59, 122, 67, 44, 222,
-				89, 97, 121, 32, 80, 114, 111, 118, 101, 110, 97, 110, 99, 101, 33,
-			},
-			expTarget: sdk.AccAddress{
-				247, 208, 145, 192, 91, 18, 179, 34, 96, 28, 40, 70, 225, 125, 71, 10,
-				255, 84, 50, 68, 245, 118, 123, 33, 104, 75, 12, 58, 168, 177, 107, 205,
-			},
-			expSource: sdk.AccAddress{
-				117, 169, 6, 140, 176, 237, 220, 171, 100, 213,
-				235, 204, 250, 195, 114, 59, 122, 67, 44, 222,
-			},
-			expExternalID: "Yay Provenance!",
+			name:     "2030-01-01, 20 byte address",
+			deadline: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+			addr:     sdk.AccAddress("abcdefghijklmnopqrst"),
+			expected: append([]byte{keeper.KeyTypeDeadlineToDeadManSwitchIndex,
+				0, 0, 0, 0, 0x70, 0xdb, 0xd8, 0x80, 20}, "abcdefghijklmnopqrst"...),
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			var target, source sdk.AccAddress
-			var externalID string
-			var err error
-			testFunc := func() {
-				target, source, externalID, err = keeper.ParseIndexKeyTargetToPayment(tc.key)
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeIndexKeyDeadlineToDeadManSwitch(tc.deadline, tc.addr)
+				},
+				expected: tc.expected,
+				expPanic: tc.expPanic,
 			}
-			require.NotPanics(t, testFunc, "ParseIndexKeyTargetToPayment(%v)", tc.key)
-			assertions.AssertErrorValue(t, err, tc.expErr, "ParseIndexKeyTargetToPayment(%v) error", tc.key)
-			assert.Equal(t, tc.expTarget, target, "ParseIndexKeyTargetToPayment(%v) target", tc.key)
-			assert.Equal(t, tc.expSource, source, "ParseIndexKeyTargetToPayment(%v) source", tc.key)
-			assert.Equal(t, tc.expExternalID, externalID, "ParseIndexKeyTargetToPayment(%v) external id", tc.key)
+			if len(tc.expPanic) == 0 {
+				ktc.expPrefixes = []expectedPrefix{
+					{name: "GetIndexKeyPrefixDeadlineToDeadManSwitch", value: keeper.GetIndexKeyPrefixDeadlineToDeadManSwitch()},
+					{name: "GetIndexKeyPrefixDeadlineToDeadManSwitchAt", value: keeper.GetIndexKeyPrefixDeadlineToDeadManSwitchAt(tc.deadline)},
+				}
+			}
+			checkKey(t, ktc, "MakeIndexKeyDeadlineToDeadManSwitch(%s, %s)", tc.deadline, tc.addr)
 		})
 	}
 }
 
-func TestParseIndexKeySuffixTargetToPayment(t *testing.T) {
+func TestParseIndexKeyDeadlineToDeadManSwitch(t *testing.T) {
+	deadline := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	addr := sdk.AccAddress("abcdefghijklmnopqrst")
+
 	tests := []struct {
-		name          string
-		key           []byte
-		expSource     sdk.AccAddress
-		expExternalID string
-		expErr        string
+		name        string
+		key         []byte
+		expDeadline time.Time
+		expAddr     sdk.AccAddress
+		expErr      string
 	}{
 		{
-			name:   "nil",
+			name:   "nil key",
 			key:    nil,
-			expErr: "cannot parse target to payment index key: invalid source: slice is empty",
+			expErr: "cannot parse deadline to dead-man switch key: only has 0 bytes, expected at least 11",
 		},
 		{
-			name:   "empty",
-			key:    []byte{},
-			expErr: "cannot parse target to payment index key: invalid source: slice is empty",
+			name:   "10 bytes",
+			key:    []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+			expErr: "cannot parse deadline to dead-man switch key: only has 10 bytes, expected at least 11",
 		},
 		{
-			name:   "zero byte source",
-			key:    []byte{0},
-			expErr: "cannot parse target to payment index key: invalid source: length byte is zero",
+			name:   "wrong type byte",
+			key:    []byte{keeper.KeyTypeDeadManSwitch, 0, 0, 0, 0, 0, 0, 0, 1, 1, 'a'},
+			expErr: "cannot parse deadline to dead-man switch key: incorrect type byte 0x29",
 		},
 		{
-			name:          "empty external id",
-			key:           []byte{5, 10, 15, 20, 25, 30},
-			expSource:     sdk.AccAddress{10, 15, 20, 25, 30},
-			expExternalID: "",
+			name:   "address length too long",
+			key:    []byte{keeper.KeyTypeDeadlineToDeadManSwitchIndex, 0, 0, 0, 0, 0, 0, 0, 1, 2, 'a'},
+			expErr: "cannot parse address from deadline to dead-man switch key: length byte is 2, but slice only has 1 left",
 		},
 		{
-			name: "20 byte addr, 36 byte external id",
-			key: concatBz(
-				[]byte{20,
-					129, 181, 145, 146, 65, 87, 128, 45, 51, 95,
-					170, 10, 156, 100, 72, 231, 74, 130, 252, 186,
-				},
-				[]byte("229C4877-B1Eb-4069-B9AC-3D64F92F250F"),
-			),
-			expSource: sdk.AccAddress{
-				129, 181, 145, 146, 65, 87, 128, 45, 51, 95,
-				170, 10, 156, 100, 72, 231, 74, 130, 252, 186,
-			},
-			expExternalID: "229C4877-B1Eb-4069-B9AC-3D64F92F250F",
+			name:   "extra bytes after address",
+			key:    []byte{keeper.KeyTypeDeadlineToDeadManSwitchIndex, 0, 0, 0, 0, 0, 0, 0, 1, 1, 'a', 'b', 'c'},
+			expErr: "cannot parse deadline to dead-man switch key: found 2 bytes after address, expected 0",
+		},
+		{
+			name:        "good key",
+			key:         keeper.MakeIndexKeyDeadlineToDeadManSwitch(deadline, addr),
+			expDeadline: deadline,
+			expAddr:     addr,
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			var source sdk.AccAddress
-			var externalID string
+			var actDeadline time.Time
+			var actAddr sdk.AccAddress
 			var err error
 			testFunc := func() {
-				source, externalID, err = keeper.ParseIndexKeySuffixTargetToPayment(tc.key)
+				actDeadline, actAddr, err = keeper.ParseIndexKeyDeadlineToDeadManSwitch(tc.key)
 			}
-			require.NotPanics(t, testFunc, "ParseIndexKeySuffixTargetToPayment(%v)", tc.key)
-			assertions.AssertErrorValue(t, err, tc.expErr, "ParseIndexKeySuffixTargetToPayment(%v) error", tc.key)
-			assert.Equal(t, tc.expSource, source, "ParseIndexKeySuffixTargetToPayment(%v) source", tc.key)
-			assert.Equal(t, tc.expExternalID, externalID, "ParseIndexKeySuffixTargetToPayment(%v) external id", tc.key)
+			require.NotPanics(t, testFunc, "ParseIndexKeyDeadlineToDeadManSwitch(%v)", tc.key)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseIndexKeyDeadlineToDeadManSwitch(%v) error", tc.key)
+			assert.Equal(t, tc.expDeadline, actDeadline, "ParseIndexKeyDeadlineToDeadManSwitch(%v) deadline", tc.key)
+			assert.Equal(t, tc.expAddr, actAddr, "ParseIndexKeyDeadlineToDeadManSwitch(%v) address", tc.key)
 		})
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
@@ -107,6 +108,11 @@ var (
 		key:    MakeKeyMarketBuyerSettlementFlatFee,
 		prefix: GetKeyPrefixMarketBuyerSettlementFlatFee,
 	}
+	// referralCapKeyMakers are the key and prefix makers for the referral cap entries.
+	referralCapKeyMakers = flatFeeKeyMakers{
+		key:    MakeKeyMarketReferralCap,
+		prefix: GetKeyPrefixMarketReferralCap,
+	}
 )
 
 // hasFlatFee returns true if this market has any flat fee for a given type.
@@ -301,6 +307,16 @@ var (
 		key:    MakeKeyMarketSellerSettlementRatio,
 		prefix: GetKeyPrefixMarketSellerSettlementRatio,
 	}
+	// sellerSettlementTakerRatioKeyMakers are the key and prefix makers for the seller settlement taker fee ratios.
+	sellerSettlementTakerRatioKeyMakers = ratioKeyMakers{
+		key:    MakeKeyMarketSellerSettlementTakerRatio,
+		prefix: GetKeyPrefixMarketSellerSettlementTakerRatio,
+	}
+	// sellerSettlementRebateRatioKeyMakers are the key and prefix makers for the seller settlement rebate ratios.
+	sellerSettlementRebateRatioKeyMakers = ratioKeyMakers{
+		key:    MakeKeyMarketSellerSettlementRebateRatio,
+		prefix: GetKeyPrefixMarketSellerSettlementRebateRatio,
+	}
 	// buyerSettlementRatioKeyMakers are the key and prefix makers for the buyer settlement fee ratios.
 	buyerSettlementRatioKeyMakers = ratioKeyMakers{
 		key:    MakeKeyMarketBuyerSettlementRatio,
@@ -408,13 +424,114 @@ func updateSellerSettlementRatios(store storetypes.KVStore, marketID uint32, toD
 	updateFeeRatios(store, marketID, toDelete, toAdd, sellerSettlementRatioKeyMakers)
 }
 
+// getSellerSettlementTakerRatio gets the seller settlement taker fee ratio for the given market with the provided denom.
+func getSellerSettlementTakerRatio(store storetypes.KVStore, marketID uint32, priceDenom string) *exchange.FeeRatio {
+	return getFeeRatio(store, marketID, priceDenom, priceDenom, sellerSettlementTakerRatioKeyMakers)
+}
+
+// getSellerSettlementRatioFor gets the seller settlement fee ratio to apply to an ask order with the provided price denom.
+// Takers use the taker ratio for that denom if there is one. Otherwise, the seller settlement ratio is used.
+func getSellerSettlementRatioFor(store storetypes.KVStore, marketID uint32, priceDenom string, isTaker bool) (*exchange.FeeRatio, error) {
+	if isTaker {
+		if ratio := getSellerSettlementTakerRatio(store, marketID, priceDenom); ratio != nil {
+			return ratio, nil
+		}
+	}
+	return getSellerSettlementRatio(store, marketID, priceDenom)
+}
+
+// getSellerSettlementTakerRatios gets the seller settlement taker fee ratios for a market.
+func getSellerSettlementTakerRatios(store storetypes.KVStore, marketID uint32) []exchange.FeeRatio {
+	return getAllFeeRatios(store, marketID, sellerSettlementTakerRatioKeyMakers)
+}
+
+// setSellerSettlementTakerRatios sets the seller settlement taker fee ratios for a market.
+func setSellerSettlementTakerRatios(store storetypes.KVStore, marketID uint32, ratios []exchange.FeeRatio) {
+	setAllFeeRatios(store, marketID, ratios, sellerSettlementTakerRatioKeyMakers)
+}
+
+// updateSellerSettlementTakerRatios deletes all seller settlement taker ratio entries to delete then adds the ones to add.
+func updateSellerSettlementTakerRatios(store storetypes.KVStore, marketID uint32, toDelete, toAdd []exchange.FeeRatio) {
+	updateFeeRatios(store, marketID, toDelete, toAdd, sellerSettlementTakerRatioKeyMakers)
+}
+
+// getSellerSettlementRebateRatio gets the seller settlement rebate ratio for the given market with the provided denom.
+func getSellerSettlementRebateRatio(store storetypes.KVStore, marketID uint32, priceDenom string) *exchange.FeeRatio {
+	return getFeeRatio(store, marketID, priceDenom, priceDenom, sellerSettlementRebateRatioKeyMakers)
+}
+
+// getSellerSettlementRebateRatios gets the seller settlement rebate ratios for a market.
+func getSellerSettlementRebateRatios(store storetypes.KVStore, marketID uint32) []exchange.FeeRatio {
+	return getAllFeeRatios(store, marketID, sellerSettlementRebateRatioKeyMakers)
+}
+
+// setSellerSettlementRebateRatios sets the seller settlement rebate ratios for a market.
+func setSellerSettlementRebateRatios(store storetypes.KVStore, marketID uint32, ratios []exchange.FeeRatio) {
+	setAllFeeRatios(store, marketID, ratios, sellerSettlementRebateRatioKeyMakers)
+}
+
+// updateSellerSettlementRebateRatios deletes all seller settlement rebate ratio entries to delete then adds the ones to add.
+func updateSellerSettlementRebateRatios(store storetypes.KVStore, marketID uint32, toDelete, toAdd []exchange.FeeRatio) {
+	updateFeeRatios(store, marketID, toDelete, toAdd, sellerSettlementRebateRatioKeyMakers)
+}
+
+// getRebateAddresses gets the addresses that are eligible for rebates in a market.
+func getRebateAddresses(store storetypes.KVStore, marketID uint32) []string {
+	return ParseReqAttrStoreValue(store.Get(MakeKeyMarketRebateAddresses(marketID)))
+}
+
+// setRebateAddresses sets the addresses that are eligible for rebates in a market.
+func setRebateAddresses(store storetypes.KVStore, marketID uint32, addrs []string) {
+	key := MakeKeyMarketRebateAddresses(marketID)
+	if len(addrs) == 0 {
+		store.Delete(key)
+	} else {
+		value := []byte(strings.Join(addrs, string(RecordSeparator)))
+		store.Set(key, value)
+	}
+}
+
+// updateRebateAddresses removes the provided addresses from a market's rebate addresses, then adds the others.
+func updateRebateAddresses(store storetypes.KVStore, marketID uint32, toRemove, toAdd []string) {
+	if len(toRemove) == 0 && len(toAdd) == 0 {
+		return
+	}
+	curAddrs := getRebateAddresses(store, marketID)
+	var updatedAddrs []string
+	for _, addr := range curAddrs {
+		if !exchange.ContainsString(toRemove, addr) {
+			updatedAddrs = append(updatedAddrs, addr)
+		}
+	}
+	for _, addr := range toAdd {
+		if !exchange.ContainsString(updatedAddrs, addr) {
+			updatedAddrs = append(updatedAddrs, addr)
+		}
+	}
+	setRebateAddresses(store, marketID, updatedAddrs)
+}
+
 // validateAskPrice validates that the provided ask price is acceptable.
+// Since it isn't known yet whether the ask will be a maker or taker, it's checked against both ratios.
 func validateAskPrice(store storetypes.KVStore, marketID uint32, price sdk.Coin, settlementFlatFee *sdk.Coin) error {
 	ratio, err := getSellerSettlementRatio(store, marketID, price.Denom)
 	if err != nil {
 		return err
 	}
+	if err = validateAskPriceWithRatio(price, settlementFlatFee, ratio, "seller settlement"); err != nil {
+		return err
+	}
 
+	takerRatio := getSellerSettlementTakerRatio(store, marketID, price.Denom)
+	if takerRatio == nil {
+		return nil
+	}
+	return validateAskPriceWithRatio(price, settlementFlatFee, takerRatio, "seller settlement taker")
+}
+
+// validateAskPriceWithRatio validates that the provided ask price is more than the fees that
+// will come out of it when the provided ratio is applied. The name is used in error messages.
+func validateAskPriceWithRatio(price sdk.Coin, settlementFlatFee *sdk.Coin, ratio *exchange.FeeRatio, name string) error {
 	// If there is a settlement flat fee with a different denom as the price, a hold is placed on it.
 	// If there's a settlement flat fee with the same denom as the price, it's paid out of the price along
 	// with the ratio amount. Assuming the ratio is less than one, the price will always be at least the ratio fee amount.
@@ -439,7 +556,7 @@ func validateAskPrice(store storetypes.KVStore, marketID uint32, price sdk.Coin,
 	if !checkFlat {
 		// There's no flat aspect to check, just check the ratio.
 		if price.Amount.LTE(ratioFee.Amount) {
-			return fmt.Errorf("price %s is not more than seller settlement ratio fee %s", price, ratioFee)
+			return fmt.Errorf("price %s is not more than %s ratio fee %s", price, name, ratioFee)
 		}
 		return nil
 	}
@@ -447,16 +564,17 @@ func validateAskPrice(store storetypes.KVStore, marketID uint32, price sdk.Coin,
 	// Check both together.
 	reqPriceAmt := settlementFlatFee.Amount.Add(ratioFee.Amount)
 	if price.Amount.LTE(reqPriceAmt) {
-		return fmt.Errorf("price %s is not more than total required seller settlement fee %s = %s flat + %s ratio",
-			price, sdk.NewCoin(price.Denom, reqPriceAmt), settlementFlatFee, ratioFee)
+		return fmt.Errorf("price %s is not more than total required %s fee %s = %s flat + %s ratio",
+			price, name, sdk.NewCoin(price.Denom, reqPriceAmt), settlementFlatFee, ratioFee)
 	}
 
 	return nil
 }
 
 // calculateSellerSettlementRatioFee calculates the seller settlement fee required for the given price.
-func calculateSellerSettlementRatioFee(store storetypes.KVStore, marketID uint32, price sdk.Coin) (*sdk.Coin, error) {
-	ratio, err := getSellerSettlementRatio(store, marketID, price.Denom)
+// If isTaker is true, the market's taker ratio for the price denom is used (if it has one).
+func calculateSellerSettlementRatioFee(store storetypes.KVStore, marketID uint32, price sdk.Coin, isTaker bool) (*sdk.Coin, error) {
+	ratio, err := getSellerSettlementRatioFor(store, marketID, price.Denom, isTaker)
 	if err != nil {
 		return nil, err
 	}
@@ -687,6 +805,62 @@ func updateCommitmentSettlementBips(store storetypes.KVStore, marketID uint32, b
 	}
 }
 
+// getReferralBips gets the portion of a market's settlement fees that is paid to referrers.
+func getReferralBips(store storetypes.KVStore, marketID uint32) uint32 {
+	key := MakeKeyMarketReferralBips(marketID)
+	value := store.Get(key)
+	if len(value) == 0 {
+		return 0
+	}
+	rv, _ := uint32FromBz(value)
+	return rv
+}
+
+// setReferralBips sets the portion of a market's settlement fees that is paid to referrers.
+func setReferralBips(store storetypes.KVStore, marketID uint32, bips uint32) {
+	key := MakeKeyMarketReferralBips(marketID)
+	if bips != 0 {
+		value := uint32Bz(bips)
+		store.Set(key, value)
+	} else {
+		store.Delete(key)
+	}
+}
+
+// updateReferralBips updates the referral bips for a market.
+// If unsetBips is true, the bips entry for the market will be deleted.
+// If bips is not zero, the entry will be set to that value.
+// If bips is zero and unsetBips is false, this does nothing.
+func updateReferralBips(store storetypes.KVStore, marketID uint32, bips uint32, unsetBips bool) {
+	if unsetBips {
+		setReferralBips(store, marketID, 0)
+	}
+	if bips > 0 {
+		setReferralBips(store, marketID, bips)
+	}
+}
+
+// getReferralCapAmount gets the most that will be paid to a referrer (in the given denom) for a single order.
+// Returns nil if there is no cap for that denom.
+func getReferralCapAmount(store storetypes.KVStore, marketID uint32, denom string) *sdk.Coin {
+	return getFlatFee(store, marketID, denom, referralCapKeyMakers)
+}
+
+// getReferralCap gets all the referral cap entries for a market.
+func getReferralCap(store storetypes.KVStore, marketID uint32) []sdk.Coin {
+	return getAllFlatFees(store, marketID, referralCapKeyMakers)
+}
+
+// setReferralCap sets the referral cap entries for a market.
+func setReferralCap(store storetypes.KVStore, marketID uint32, entries []sdk.Coin) {
+	setAllFlatFees(store, marketID, entries, referralCapKeyMakers)
+}
+
+// updateReferralCap deletes all referral cap entries to delete then adds the ones to add.
+func updateReferralCap(store storetypes.KVStore, marketID uint32, toDelete, toAdd []sdk.Coin) {
+	updateFlatFees(store, marketID, toDelete, toAdd, referralCapKeyMakers)
+}
+
 // getIntermediaryDenom gets a market's intermediary denom.
 func getIntermediaryDenom(store storetypes.KVStore, marketID uint32) string {
 	key := MakeKeyMarketIntermediaryDenom(marketID)
@@ -729,6 +903,22 @@ func (k Keeper) GetSellerSettlementRatios(ctx sdk.Context, marketID uint32) []ex
 	return getSellerSettlementRatios(k.getStore(ctx), marketID)
 }
 
+// GetSellerSettlementTakerRatios gets the seller settlement taker fee ratios for a market.
+func (k Keeper) GetSellerSettlementTakerRatios(ctx sdk.Context, marketID uint32) []exchange.FeeRatio {
+	return getSellerSettlementTakerRatios(k.getStore(ctx), marketID)
+}
+
+// GetSellerSettlementRebateRatios gets the seller settlement rebate ratios for a market.
+func (k Keeper) GetSellerSettlementRebateRatios(ctx sdk.Context, marketID uint32) []exchange.FeeRatio {
+	return getSellerSettlementRebateRatios(k.getStore(ctx), marketID)
+}
+
+// GetRebateAddresses gets the addresses that are eligible for rebates in a market.
+// An empty result means that maker-side sellers are eligible.
+func (k Keeper) GetRebateAddresses(ctx sdk.Context, marketID uint32) []string {
+	return getRebateAddresses(k.getStore(ctx), marketID)
+}
+
 // GetBuyerSettlementFlatFees gets the buyer settlement flat fee options for a market.
 func (k Keeper) GetBuyerSettlementFlatFees(ctx sdk.Context, marketID uint32) []sdk.Coin {
 	return getBuyerSettlementFlatFees(k.getStore(ctx), marketID)
@@ -744,14 +934,25 @@ func (k Keeper) GetCommitmentSettlementBips(ctx sdk.Context, marketID uint32) ui
 	return getCommitmentSettlementBips(k.getStore(ctx), marketID)
 }
 
+// GetReferralBips gets the portion (in bips) of a market's share of settlement fees that is paid to referrers.
+func (k Keeper) GetReferralBips(ctx sdk.Context, marketID uint32) uint32 {
+	return getReferralBips(k.getStore(ctx), marketID)
+}
+
+// GetReferralCap gets the most (in each denom) that a market will pay to a referrer for a single order.
+func (k Keeper) GetReferralCap(ctx sdk.Context, marketID uint32) []sdk.Coin {
+	return getReferralCap(k.getStore(ctx), marketID)
+}
+
 // GetIntermediaryDenom gets a market's intermediary denom.
 func (k Keeper) GetIntermediaryDenom(ctx sdk.Context, marketID uint32) string {
 	return getIntermediaryDenom(k.getStore(ctx), marketID)
 }
 
 // CalculateSellerSettlementRatioFee calculates the seller settlement fee required for the given price.
-func (k Keeper) CalculateSellerSettlementRatioFee(ctx sdk.Context, marketID uint32, price sdk.Coin) (*sdk.Coin, error) {
-	return calculateSellerSettlementRatioFee(k.getStore(ctx), marketID, price)
+// If isTaker is true, the market's taker ratio for the price denom is used (if it has one).
+func (k Keeper) CalculateSellerSettlementRatioFee(ctx sdk.Context, marketID uint32, price sdk.Coin, isTaker bool) (*sdk.Coin, error) {
+	return calculateSellerSettlementRatioFee(k.getStore(ctx), marketID, price, isTaker)
 }
 
 // CalculateBuyerSettlementRatioFeeOptions calculates the buyer settlement ratio fee options available for the given price.
@@ -798,9 +999,14 @@ func (k Keeper) UpdateFees(ctx sdk.Context, msg *exchange.MsgGovManageFeesReques
 	updateCreateCommitmentFlatFees(store, msg.MarketId, msg.RemoveFeeCreateCommitmentFlat, msg.AddFeeCreateCommitmentFlat)
 	updateSellerSettlementFlatFees(store, msg.MarketId, msg.RemoveFeeSellerSettlementFlat, msg.AddFeeSellerSettlementFlat)
 	updateSellerSettlementRatios(store, msg.MarketId, msg.RemoveFeeSellerSettlementRatios, msg.AddFeeSellerSettlementRatios)
+	updateSellerSettlementTakerRatios(store, msg.MarketId, msg.RemoveFeeSellerSettlementTakerRatios, msg.AddFeeSellerSettlementTakerRatios)
+	updateSellerSettlementRebateRatios(store, msg.MarketId, msg.RemoveFeeSellerSettlementRebateRatios, msg.AddFeeSellerSettlementRebateRatios)
+	updateRebateAddresses(store, msg.MarketId, msg.RemoveRebateAddresses, msg.AddRebateAddresses)
 	updateBuyerSettlementFlatFees(store, msg.MarketId, msg.RemoveFeeBuyerSettlementFlat, msg.AddFeeBuyerSettlementFlat)
 	updateBuyerSettlementRatios(store, msg.MarketId, msg.RemoveFeeBuyerSettlementRatios, msg.AddFeeBuyerSettlementRatios)
 	updateCommitmentSettlementBips(store, msg.MarketId, msg.SetFeeCommitmentSettlementBips, msg.UnsetFeeCommitmentSettlementBips)
+	updateReferralBips(store, msg.MarketId, msg.SetReferralBips, msg.UnsetReferralBips)
+	updateReferralCap(store, msg.MarketId, msg.RemoveReferralCap, msg.AddReferralCap)
 
 	k.emitEvent(ctx, exchange.NewEventMarketFeesUpdated(msg.MarketId))
 }
@@ -879,6 +1085,205 @@ func setMarketAcceptingCommitments(store storetypes.KVStore, marketID uint32, al
 	}
 }
 
+// isContinuousMatchingEnabled gets whether continuous matching is enabled for a market.
+func isContinuousMatchingEnabled(store storetypes.KVStore, marketID uint32) bool {
+	key := MakeKeyMarketContinuousMatching(marketID)
+	return store.Has(key)
+}
+
+// setContinuousMatchingEnabled sets whether continuous matching is enabled for a market.
+func setContinuousMatchingEnabled(store storetypes.KVStore, marketID uint32, enabled bool) {
+	key := MakeKeyMarketContinuousMatching(marketID)
+	if enabled {
+		store.Set(key, []byte{})
+	} else {
+		store.Delete(key)
+	}
+}
+
+// getBatchAuctionInterval gets the number of blocks between a market's batch auctions (0 = disabled).
+func getBatchAuctionInterval(store storetypes.KVStore, marketID uint32) uint32 {
+	key := MakeKeyMarketBatchAuctionInterval(marketID)
+	value := store.Get(key)
+	if len(value) == 0 {
+		return 0
+	}
+	rv, _ := uint32FromBz(value)
+	return rv
+}
+
+// setBatchAuctionInterval sets the number of blocks between a market's batch auctions (0 = disabled).
+func setBatchAuctionInterval(store storetypes.KVStore, marketID uint32, interval uint32) {
+	key := MakeKeyMarketBatchAuctionInterval(marketID)
+	if interval != 0 {
+		value := uint32Bz(interval)
+		store.Set(key, value)
+	} else {
+		store.Delete(key)
+	}
+}
+
+// getSelfTradePrevention gets a market's self-trade prevention mode.
+func getSelfTradePrevention(store storetypes.KVStore, marketID uint32) exchange.SelfTradePrevention {
+	key := MakeKeyMarketSelfTradePrevention(marketID)
+	value := store.Get(key)
+	if len(value) == 0 {
+		return exchange.SelfTradePrevention_unspecified
+	}
+	return exchange.SelfTradePrevention(value[0])
+}
+
+// setSelfTradePrevention sets a market's self-trade prevention mode.
+func setSelfTradePrevention(store storetypes.KVStore, marketID uint32, stp exchange.SelfTradePrevention) {
+	key := MakeKeyMarketSelfTradePrevention(marketID)
+	if stp != exchange.SelfTradePrevention_unspecified {
+		store.Set(key, []byte{byte(stp)})
+	} else {
+		store.Delete(key)
+	}
+}
+
+// getExternalIDScope gets a market's external id scope.
+func getExternalIDScope(store storetypes.KVStore, marketID uint32) exchange.ExternalIDScope {
+	key := MakeKeyMarketExternalIDScope(marketID)
+	value := store.Get(key)
+	if len(value) == 0 {
+		return exchange.ExternalIDScope_unspecified
+	}
+	return exchange.ExternalIDScope(value[0])
+}
+
+// setExternalIDScope sets a market's external id scope.
+func setExternalIDScope(store storetypes.KVStore, marketID uint32, scope exchange.ExternalIDScope) {
+	key := MakeKeyMarketExternalIDScope(marketID)
+	if scope != exchange.ExternalIDScope_unspecified {
+		store.Set(key, []byte{byte(scope)})
+	} else {
+		store.Delete(key)
+	}
+}
+
+// getOrderRateLimit gets a market's order rate limit. Returns nil if the market does not have one.
+func getOrderRateLimit(store storetypes.KVStore, marketID uint32) *exchange.OrderRateLimit {
+	key := MakeKeyMarketOrderRateLimit(marketID)
+	value := store.Get(key)
+	if len(value) < 8 {
+		return nil
+	}
+	maxOrders, _ := uint32FromBz(value)
+	windowBlocks, _ := uint32FromBz(value[4:])
+	return &exchange.OrderRateLimit{MaxOrders: maxOrders, WindowBlocks: windowBlocks}
+}
+
+// setOrderRateLimit sets a market's order rate limit. A nil limit (or one with zero max orders) removes it.
+func setOrderRateLimit(store storetypes.KVStore, marketID uint32, limit *exchange.OrderRateLimit) {
+	key := MakeKeyMarketOrderRateLimit(marketID)
+	if limit != nil && limit.MaxOrders != 0 {
+		value := make([]byte, 0, 8)
+		value = append(value, uint32Bz(limit.MaxOrders)...)
+		value = append(value, uint32Bz(limit.WindowBlocks)...)
+		store.Set(key, value)
+	} else {
+		store.Delete(key)
+	}
+}
+
+// getMaxExposure gets a market's max exposure. Returns nil if the market does not have one.
+func getMaxExposure(store storetypes.KVStore, marketID uint32) *sdk.Coin {
+	key := MakeKeyMarketMaxExposure(marketID)
+	value := store.Get(key)
+	if len(value) == 0 {
+		return nil
+	}
+	rv, err := sdk.ParseCoinNormalized(string(value))
+	if err != nil {
+		return nil
+	}
+	return &rv
+}
+
+// setMaxExposure sets a market's max exposure. A nil (or zero) max exposure removes it.
+func setMaxExposure(store storetypes.KVStore, marketID uint32, maxExposure *sdk.Coin) {
+	key := MakeKeyMarketMaxExposure(marketID)
+	if maxExposure != nil && !maxExposure.IsZero() {
+		store.Set(key, []byte(maxExposure.String()))
+	} else {
+		store.Delete(key)
+	}
+}
+
+// getFillAlgorithm gets a market's fill algorithm.
+func getFillAlgorithm(store storetypes.KVStore, marketID uint32) exchange.FillAlgorithm {
+	key := MakeKeyMarketFillAlgorithm(marketID)
+	value := store.Get(key)
+	if len(value) == 0 {
+		return exchange.FillAlgorithm_unspecified
+	}
+	return exchange.FillAlgorithm(value[0])
+}
+
+// setFillAlgorithm sets a market's fill algorithm.
+func setFillAlgorithm(store storetypes.KVStore, marketID uint32, algorithm exchange.FillAlgorithm) {
+	key := MakeKeyMarketFillAlgorithm(marketID)
+	if algorithm != exchange.FillAlgorithm_unspecified {
+		store.Set(key, []byte{byte(algorithm)})
+	} else {
+		store.Delete(key)
+	}
+}
+
+// getExchangeSplit gets a market's exchange split for the provided denom, and whether the market has one.
+func getExchangeSplit(store storetypes.KVStore, marketID uint32, denom string) (uint16, bool) {
+	key := MakeKeyMarketExchangeSplit(marketID, denom)
+	value := store.Get(key)
+	if len(value) == 0 {
+		return 0, false
+	}
+	return uint16FromBz(value)
+}
+
+// getExchangeSplits gets all of a market's exchange splits.
+func getExchangeSplits(store storetypes.KVStore, marketID uint32) []exchange.DenomSplit {
+	var rv []exchange.DenomSplit
+	iterate(store, GetKeyPrefixMarketExchangeSplit(marketID), func(key, value []byte) bool {
+		if split, ok := uint16FromBz(value); ok {
+			rv = append(rv, exchange.DenomSplit{Denom: string(key), Split: uint32(split)})
+		}
+		return false
+	})
+	return rv
+}
+
+// setExchangeSplit sets a market's exchange split for a single denom.
+func setExchangeSplit(store storetypes.KVStore, marketID uint32, split exchange.DenomSplit) {
+	key := MakeKeyMarketExchangeSplit(marketID, split.Denom)
+	store.Set(key, uint16Bz(uint16(split.Split))) //nolint:gosec // G115: Validated elsewhere to be 10,000 max.
+}
+
+// setExchangeSplits deletes all of a market's exchange splits, then sets the ones provided.
+func setExchangeSplits(store storetypes.KVStore, marketID uint32, splits []exchange.DenomSplit) {
+	deleteAll(store, GetKeyPrefixMarketExchangeSplit(marketID))
+	for _, split := range splits {
+		setExchangeSplit(store, marketID, split)
+	}
+}
+
+// isPublishPricesEnabled gets whether a market publishes its settlement prices to x/oracle.
+func isPublishPricesEnabled(store storetypes.KVStore, marketID uint32) bool {
+	key := MakeKeyMarketPublishPrices(marketID)
+	return store.Has(key)
+}
+
+// setPublishPricesEnabled sets whether a market publishes its settlement prices to x/oracle.
+func setPublishPricesEnabled(store storetypes.KVStore, marketID uint32, enabled bool) {
+	key := MakeKeyMarketPublishPrices(marketID)
+	if enabled {
+		store.Set(key, []byte{})
+	} else {
+		store.Delete(key)
+	}
+}
+
 // IsMarketKnown returns true if the provided market id is a known market's id.
 func (k Keeper) IsMarketKnown(ctx sdk.Context, marketID uint32) bool {
 	return isMarketKnown(k.getStore(ctx), marketID)
@@ -942,6 +1347,207 @@ func (k Keeper) UpdateMarketAcceptingCommitments(ctx sdk.Context, marketID uint3
 	return nil
 }
 
+// IsContinuousMatchingEnabled gets whether continuous matching is enabled for a market.
+func (k Keeper) IsContinuousMatchingEnabled(ctx sdk.Context, marketID uint32) bool {
+	return isContinuousMatchingEnabled(k.getStore(ctx), marketID)
+}
+
+// UpdateContinuousMatching updates the continuous-matching flag for a market.
+// An error is returned if the setting is already what is provided.
+func (k Keeper) UpdateContinuousMatching(ctx sdk.Context, marketID uint32, enabled bool, updatedBy string) error {
+	store := k.getStore(ctx)
+	current := isContinuousMatchingEnabled(store, marketID)
+	if current == enabled {
+		return fmt.Errorf("market %d already has continuous-matching %t", marketID, enabled)
+	}
+	setContinuousMatchingEnabled(store, marketID, enabled)
+	if enabled && getBatchAuctionInterval(store, marketID) != 0 {
+		return fmt.Errorf("market %d cannot have continuous-matching while batch auctions are enabled", marketID)
+	}
+	setContinuousMatchingEnabled(store, marketID, enabled)
+	k.emitEvent(ctx, exchange.NewEventMarketContinuousMatchingUpdated(marketID, updatedBy, enabled))
+	return nil
+}
+
+// GetBatchAuctionInterval gets the number of blocks between a market's batch auctions (0 = disabled).
+func (k Keeper) GetBatchAuctionInterval(ctx sdk.Context, marketID uint32) uint32 {
+	return getBatchAuctionInterval(k.getStore(ctx), marketID)
+}
+
+// UpdateBatchAuctionInterval updates the number of blocks between a market's batch auctions.
+// The next batch auction will be interval blocks after this one.
+// An error is returned if the setting is already what is provided.
+func (k Keeper) UpdateBatchAuctionInterval(ctx sdk.Context, marketID uint32, interval uint32, updatedBy string) error {
+	store := k.getStore(ctx)
+	current := getBatchAuctionInterval(store, marketID)
+	if current == interval {
+		return fmt.Errorf("market %d already has batch-auction-interval %d", marketID, interval)
+	}
+	if interval != 0 && isContinuousMatchingEnabled(store, marketID) {
+		return fmt.Errorf("market %d cannot have batch auctions while continuous-matching is enabled", marketID)
+	}
+	setBatchAuctionInterval(store, marketID, interval)
+	setLastBatchAuctionHeight(store, marketID, ctx.BlockHeight())
+	k.emitEvent(ctx, exchange.NewEventMarketBatchAuctionUpdated(marketID, updatedBy))
+	return nil
+}
+
+// GetSelfTradePrevention gets a market's self-trade prevention mode.
+func (k Keeper) GetSelfTradePrevention(ctx sdk.Context, marketID uint32) exchange.SelfTradePrevention {
+	return getSelfTradePrevention(k.getStore(ctx), marketID)
+}
+
+// UpdateSelfTradePrevention updates a market's self-trade prevention mode.
+// An error is returned if the setting is already what is provided.
+func (k Keeper) UpdateSelfTradePrevention(ctx sdk.Context, marketID uint32, stp exchange.SelfTradePrevention, updatedBy string) error {
+	store := k.getStore(ctx)
+	current := getSelfTradePrevention(store, marketID)
+	if current == stp {
+		return fmt.Errorf("market %d already has self-trade-prevention %s", marketID, stp.SimpleString())
+	}
+	setSelfTradePrevention(store, marketID, stp)
+	k.emitEvent(ctx, exchange.NewEventMarketSelfTradePreventionUpdated(marketID, updatedBy))
+	return nil
+}
+
+// IsPublishPricesEnabled gets whether a market publishes its settlement prices to x/oracle.
+func (k Keeper) IsPublishPricesEnabled(ctx sdk.Context, marketID uint32) bool {
+	return isPublishPricesEnabled(k.getStore(ctx), marketID)
+}
+
+// UpdatePublishPrices updates the publish-prices flag for a market.
+// An error is returned if the setting is already what is provided.
+func (k Keeper) UpdatePublishPrices(ctx sdk.Context, marketID uint32, enabled bool, updatedBy string) error {
+	store := k.getStore(ctx)
+	current := isPublishPricesEnabled(store, marketID)
+	if current == enabled {
+		return fmt.Errorf("market %d already has publish-prices %t", marketID, enabled)
+	}
+	setPublishPricesEnabled(store, marketID, enabled)
+	k.emitEvent(ctx, exchange.NewEventMarketPublishPricesUpdated(marketID, updatedBy, enabled))
+	return nil
+}
+
+// GetExternalIDScope gets a market's external id scope.
+func (k Keeper) GetExternalIDScope(ctx sdk.Context, marketID uint32) exchange.ExternalIDScope {
+	return getExternalIDScope(k.getStore(ctx), marketID)
+}
+
+// UpdateExternalIDScope updates a market's external id scope.
+// An error is returned if the setting is already what is provided, or if the market
+// has orders with external ids that would conflict under the new scope.
+func (k Keeper) UpdateExternalIDScope(ctx sdk.Context, marketID uint32, scope exchange.ExternalIDScope, updatedBy string) error {
+	store := k.getStore(ctx)
+	current := getExternalIDScope(store, marketID)
+	if current == scope {
+		return fmt.Errorf("market %d already has external-id-scope %s", marketID, scope.SimpleString())
+	}
+	if err := k.validateMarketExternalIDsForScope(store, marketID, scope); err != nil {
+		return err
+	}
+	setExternalIDScope(store, marketID, scope)
+	k.emitEvent(ctx, exchange.NewEventMarketExternalIDScopeUpdated(marketID, updatedBy))
+	return nil
+}
+
+// GetOrderRateLimit gets a market's order rate limit. Returns nil if the market does not have one.
+func (k Keeper) GetOrderRateLimit(ctx sdk.Context, marketID uint32) *exchange.OrderRateLimit {
+	return getOrderRateLimit(k.getStore(ctx), marketID)
+}
+
+// UpdateOrderRateLimit updates a market's order rate limit. A nil limit removes it.
+// The market's order creation counts are reset, so every account starts with a fresh window.
+// An error is returned if the setting is already what is provided.
+func (k Keeper) UpdateOrderRateLimit(ctx sdk.Context, marketID uint32, limit *exchange.OrderRateLimit, updatedBy string) error {
+	store := k.getStore(ctx)
+	current := getOrderRateLimit(store, marketID)
+	if current.Equals(limit) {
+		if limit == nil {
+			return fmt.Errorf("market %d does not have an order rate limit", marketID)
+		}
+		return fmt.Errorf("market %d already has an order rate limit of %d orders per %d blocks",
+			marketID, limit.MaxOrders, limit.WindowBlocks)
+	}
+	setOrderRateLimit(store, marketID, limit)
+	deleteAll(store, GetKeyPrefixOrderCreationCounts(marketID))
+	k.emitEvent(ctx, exchange.NewEventMarketOrderRateLimitUpdated(marketID, updatedBy))
+	return nil
+}
+
+// GetMaxExposure gets a market's max exposure. Returns nil if the market does not have one.
+func (k Keeper) GetMaxExposure(ctx sdk.Context, marketID uint32) *sdk.Coin {
+	return getMaxExposure(k.getStore(ctx), marketID)
+}
+
+// UpdateMaxExposure updates a market's max exposure. A nil max exposure removes it.
+// Existing orders and commitments are not affected; it is only checked when new ones are created.
+// An error is returned if the setting is already what is provided.
+func (k Keeper) UpdateMaxExposure(ctx sdk.Context, marketID uint32, maxExposure *sdk.Coin, updatedBy string) error {
+	store := k.getStore(ctx)
+	current := getMaxExposure(store, marketID)
+	switch {
+	case current == nil && maxExposure == nil:
+		return fmt.Errorf("market %d does not have a max exposure", marketID)
+	case current != nil && maxExposure != nil && current.Equal(*maxExposure):
+		return fmt.Errorf("market %d already has a max exposure of %s", marketID, current)
+	}
+	setMaxExposure(store, marketID, maxExposure)
+	k.emitEvent(ctx, exchange.NewEventMarketMaxExposureUpdated(marketID, updatedBy))
+	return nil
+}
+
+// GetFillAlgorithm gets a market's fill algorithm.
+func (k Keeper) GetFillAlgorithm(ctx sdk.Context, marketID uint32) exchange.FillAlgorithm {
+	return getFillAlgorithm(k.getStore(ctx), marketID)
+}
+
+// UpdateFillAlgorithm updates a market's fill algorithm.
+// An error is returned if the setting is already what is provided.
+func (k Keeper) UpdateFillAlgorithm(ctx sdk.Context, marketID uint32, algorithm exchange.FillAlgorithm, updatedBy string) error {
+	store := k.getStore(ctx)
+	current := getFillAlgorithm(store, marketID)
+	if current == algorithm {
+		return fmt.Errorf("market %d already has fill-algorithm %s", marketID, algorithm.SimpleString())
+	}
+	setFillAlgorithm(store, marketID, algorithm)
+	k.emitEvent(ctx, exchange.NewEventMarketFillAlgorithmUpdated(marketID, updatedBy))
+	return nil
+}
+
+// GetMarketExchangeSplits gets a market's overrides of the exchange split.
+func (k Keeper) GetMarketExchangeSplits(ctx sdk.Context, marketID uint32) []exchange.DenomSplit {
+	return getExchangeSplits(k.getStore(ctx), marketID)
+}
+
+// UpdateExchangeSplits updates a market's overrides of the exchange split as provided in the MsgGovManageExchangeSplitsRequest.
+// An error is returned if the market does not exist or a denom to remove does not have an override.
+func (k Keeper) UpdateExchangeSplits(ctx sdk.Context, msg *exchange.MsgGovManageExchangeSplitsRequest) error {
+	store := k.getStore(ctx)
+	if err := validateMarketExists(store, msg.MarketId); err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, denom := range msg.RemoveExchangeSplits {
+		if _, found := getExchangeSplit(store, msg.MarketId, denom); !found {
+			errs = append(errs, fmt.Errorf("cannot remove exchange split for %q: market %d does not have one", denom, msg.MarketId))
+		}
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	for _, denom := range msg.RemoveExchangeSplits {
+		store.Delete(MakeKeyMarketExchangeSplit(msg.MarketId, denom))
+	}
+	for _, split := range msg.SetExchangeSplits {
+		setExchangeSplit(store, msg.MarketId, split)
+	}
+
+	k.emitEvent(ctx, exchange.NewEventMarketExchangeSplitsUpdated(msg.MarketId))
+	return nil
+}
+
 // storeHasPermission returns true if there is an entry in the store for the given market, address, and permissions.
 func storeHasPermission(store storetypes.KVStore, marketID uint32, addr sdk.AccAddress, permission exchange.Permission) bool {
 	key := MakeKeyMarketPermissions(marketID, addr, permission)
@@ -957,17 +1563,22 @@ func grantPermissions(store storetypes.KVStore, marketID uint32, addr sdk.AccAdd
 }
 
 // revokePermissions updates the store so that the given address does NOT have the provided permissions for the market.
+// If the address is left without any permissions, its expiration is deleted too.
 func revokePermissions(store storetypes.KVStore, marketID uint32, addr sdk.AccAddress, permissions []exchange.Permission) {
 	for _, perm := range permissions {
 		key := MakeKeyMarketPermissions(marketID, addr, perm)
 		store.Delete(key)
 	}
+	if len(getUserPermissions(store, marketID, addr)) == 0 {
+		setAccessGrantExpiration(store, marketID, addr, nil)
+	}
 }
 
 // revokeUserPermissions updates the store so that the given address does not have any permissions for the market.
 func revokeUserPermissions(store storetypes.KVStore, marketID uint32, addr sdk.AccAddress) {
 	key := GetKeyPrefixMarketPermissionsForAddress(marketID, addr)
 	deleteAll(store, key)
+	setAccessGrantExpiration(store, marketID, addr, nil)
 }
 
 // getUserPermissions gets all permissions that have been granted to a user in a market.
@@ -980,10 +1591,57 @@ func getUserPermissions(store storetypes.KVStore, marketID uint32, addr sdk.AccA
 	return rv
 }
 
-// revokeAllMarketPermissions clears out all permissions for a market.
+// revokeAllMarketPermissions clears out all permissions (and their expirations) for a market.
 func revokeAllMarketPermissions(store storetypes.KVStore, marketID uint32) {
 	key := GetKeyPrefixMarketPermissions(marketID)
 	deleteAll(store, key)
+
+	expPrefix := GetKeyPrefixMarketPermissionsExpiration(marketID)
+	iterate(store, expPrefix, func(key, value []byte) bool {
+		addr, _, err := parseLengthPrefixedAddr(key)
+		expSecs, ok := uint64FromBz(value)
+		if err == nil && ok {
+			exp := time.Unix(int64(expSecs), 0).UTC() //nolint:gosec // G115: We wrote it from an int64.
+			store.Delete(MakeIndexKeyExpirationToAccessGrant(exp, marketID, addr))
+		}
+		return false
+	})
+	deleteAll(store, expPrefix)
+}
+
+// getAccessGrantExpiration gets the time at which the given address' permissions in a market lapse.
+// Returns nil if the address' permissions do not expire.
+func getAccessGrantExpiration(store storetypes.KVStore, marketID uint32, addr sdk.AccAddress) *time.Time {
+	value := store.Get(MakeKeyMarketPermissionsExpiration(marketID, addr))
+	expSecs, ok := uint64FromBz(value)
+	if !ok {
+		return nil
+	}
+	rv := time.Unix(int64(expSecs), 0).UTC() //nolint:gosec // G115: We wrote it from an int64.
+	return &rv
+}
+
+// setAccessGrantExpiration sets (or replaces) the time at which the given address' permissions in a market lapse.
+// If the expiration is nil, any existing expiration (and its index entry) is deleted.
+func setAccessGrantExpiration(store storetypes.KVStore, marketID uint32, addr sdk.AccAddress, expiration *time.Time) {
+	key := MakeKeyMarketPermissionsExpiration(marketID, addr)
+	if cur := getAccessGrantExpiration(store, marketID, addr); cur != nil {
+		store.Delete(MakeIndexKeyExpirationToAccessGrant(*cur, marketID, addr))
+		store.Delete(key)
+	}
+	if expiration == nil {
+		return
+	}
+	expSecs := uint64(expiration.Unix()) //nolint:gosec // G115: Expirations are validated to be after the epoch.
+	store.Set(key, uint64Bz(expSecs))
+	store.Set(MakeIndexKeyExpirationToAccessGrant(*expiration, marketID, addr), []byte{})
+}
+
+// isAccessGrantExpired returns true if the given address' permissions in a market have an expiration
+// that is at or before the provided block time.
+func isAccessGrantExpired(store storetypes.KVStore, marketID uint32, addr sdk.AccAddress, blockTime time.Time) bool {
+	exp := getAccessGrantExpiration(store, marketID, addr)
+	return exp != nil && exp.Unix() <= blockTime.Unix()
 }
 
 // getAccessGrants gets all the access grants for a market.
@@ -996,6 +1654,7 @@ func getAccessGrants(store storetypes.KVStore, marketID uint32) []exchange.Acces
 			if last < 0 || addr.String() != rv[last].Address {
 				rv = append(rv, exchange.AccessGrant{Address: addr.String()})
 				last++
+				rv[last].Expiration = getAccessGrantExpiration(store, marketID, addr)
 			}
 			rv[last].Permissions = append(rv[last].Permissions, perm)
 		}
@@ -1008,12 +1667,15 @@ func getAccessGrants(store storetypes.KVStore, marketID uint32) []exchange.Acces
 func setAccessGrants(store storetypes.KVStore, marketID uint32, grants []exchange.AccessGrant) {
 	revokeAllMarketPermissions(store, marketID)
 	for _, ag := range grants {
-		grantPermissions(store, marketID, sdk.MustAccAddressFromBech32(ag.Address), ag.Permissions)
+		addr := sdk.MustAccAddressFromBech32(ag.Address)
+		grantPermissions(store, marketID, addr, ag.Permissions)
+		setAccessGrantExpiration(store, marketID, addr, ag.Expiration)
 	}
 }
 
 // HasPermission returns true if the provided address has the permission in question for a given market.
 // Also returns true if the provided address is the authority address.
+// Returns false if the address' access grant has expired, even if it hasn't been removed from state yet.
 func (k Keeper) HasPermission(ctx sdk.Context, marketID uint32, address string, permission exchange.Permission) bool {
 	if k.IsAuthority(address) {
 		return true
@@ -1022,7 +1684,8 @@ func (k Keeper) HasPermission(ctx sdk.Context, marketID uint32, address string,
 	if err != nil {
 		return false
 	}
-	return storeHasPermission(k.getStore(ctx), marketID, addr, permission)
+	store := k.getStore(ctx)
+	return storeHasPermission(store, marketID, addr, permission) && !isAccessGrantExpired(store, marketID, addr, ctx.BlockTime())
 }
 
 // CanSettleOrders returns true if the provided admin bech32 address has permission to
@@ -1095,6 +1758,12 @@ func (k Keeper) GetAccessGrants(ctx sdk.Context, marketID uint32) []exchange.Acc
 	return getAccessGrants(k.getStore(ctx), marketID)
 }
 
+// GetAccessGrantExpiration gets the time at which the given address' permissions in a market lapse.
+// Returns nil if the address' permissions do not expire.
+func (k Keeper) GetAccessGrantExpiration(ctx sdk.Context, marketID uint32, addr sdk.AccAddress) *time.Time {
+	return getAccessGrantExpiration(k.getStore(ctx), marketID, addr)
+}
+
 // UpdatePermissions updates users permissions in the store using the provided changes.
 // The caller is responsible for making sure this update should be allowed (e.g. by calling CanManagePermissions first).
 func (k Keeper) UpdatePermissions(ctx sdk.Context, msg *exchange.MsgMarketManagePermissionsRequest) error {
@@ -1132,8 +1801,12 @@ func (k Keeper) UpdatePermissions(ctx sdk.Context, msg *exchange.MsgMarketManage
 				errs = append(errs, fmt.Errorf("account %s already has %s for market %d", ag.Address, perm.String(), marketID))
 			}
 		}
+		if err := validateOrderExpiration(ctx, ag.Expiration); err != nil {
+			errs = append(errs, fmt.Errorf("account %s access grant for market %d: %w", ag.Address, marketID, err))
+		}
 		if len(errs) == 0 {
 			grantPermissions(store, marketID, addr, ag.Permissions)
+			setAccessGrantExpiration(store, marketID, addr, ag.Expiration)
 		}
 	}
 
@@ -1145,6 +1818,40 @@ func (k Keeper) UpdatePermissions(ctx sdk.Context, msg *exchange.MsgMarketManage
 	return nil
 }
 
+// ExpireAccessGrants revokes all permissions from access grants with an expiration at or before the block time.
+// At most limit access grants are revoked (0 = no limit); any others are picked up by a later call.
+// Returns the number of access grants that were revoked.
+func (k Keeper) ExpireAccessGrants(ctx sdk.Context, limit int) int {
+	store := k.getStore(ctx)
+	start := GetIndexKeyPrefixExpirationToAccessGrant()
+	end := storetypes.PrefixEndBytes(GetIndexKeyPrefixExpirationToAccessGrantAt(ctx.BlockTime()))
+
+	// Gather the keys first so we aren't writing to the store while iterating it.
+	var keys [][]byte
+	iter := store.Iterator(start, end)
+	for ; iter.Valid() && (limit == 0 || len(keys) < limit); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	count := 0
+	for _, key := range keys {
+		_, marketID, addr, err := ParseIndexKeyExpirationToAccessGrant(key)
+		if err != nil {
+			k.logErrorf(ctx, "invalid access grant expiration index entry %v (deleted): %v", key, err)
+			store.Delete(key)
+			continue
+		}
+
+		// Revoking all of the user's permissions also deletes the expiration and this index entry.
+		revokeUserPermissions(store, marketID, addr)
+		k.emitEvent(ctx, exchange.NewEventMarketAccessGrantExpired(marketID, addr))
+		count++
+	}
+
+	return count
+}
+
 // reqAttrKeyMaker is a function that returns a key for required attributes.
 type reqAttrKeyMaker func(marketID uint32) []byte
 
@@ -1348,6 +2055,134 @@ func (k Keeper) UpdateReqAttrs(ctx sdk.Context, msg *exchange.MsgMarketManageReq
 	return nil
 }
 
+// acceptedDenomsKeyMaker is a function that returns a key for a list of accepted denoms.
+type acceptedDenomsKeyMaker func(marketID uint32) []byte
+
+// getAcceptedDenoms gets a market's list of accepted denoms using the provided key maker.
+func getAcceptedDenoms(store storetypes.KVStore, marketID uint32, maker acceptedDenomsKeyMaker) []string {
+	key := maker(marketID)
+	value := store.Get(key)
+	return ParseReqAttrStoreValue(value)
+}
+
+// setAcceptedDenoms sets a market's list of accepted denoms using the provided key maker.
+func setAcceptedDenoms(store storetypes.KVStore, marketID uint32, denoms []string, maker acceptedDenomsKeyMaker) {
+	key := maker(marketID)
+	if len(denoms) == 0 {
+		store.Delete(key)
+	} else {
+		value := []byte(strings.Join(denoms, string(RecordSeparator)))
+		store.Set(key, value)
+	}
+}
+
+// updateAcceptedDenoms updates a market's list of accepted denoms that use the provided key maker by removing then
+// adding the provided denoms to the existing entries.
+func updateAcceptedDenoms(store storetypes.KVStore, marketID uint32, toRemove, toAdd []string, field string, maker acceptedDenomsKeyMaker) error {
+	var errs []error
+	curDenoms := getAcceptedDenoms(store, marketID, maker)
+
+	for _, denom := range toRemove {
+		if !exchange.ContainsString(curDenoms, denom) {
+			errs = append(errs, fmt.Errorf("cannot remove accepted %s denom %q: denom not currently accepted", field, denom))
+		}
+	}
+
+	var updatedDenoms []string
+	for _, denom := range curDenoms {
+		if !exchange.ContainsString(toRemove, denom) {
+			updatedDenoms = append(updatedDenoms, denom)
+		}
+	}
+
+	for _, denom := range toAdd {
+		if !exchange.ContainsString(curDenoms, denom) {
+			updatedDenoms = append(updatedDenoms, denom)
+		} else {
+			errs = append(errs, fmt.Errorf("cannot add accepted %s denom %q: denom already accepted", field, denom))
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	setAcceptedDenoms(store, marketID, updatedDenoms, maker)
+	return nil
+}
+
+// getAcceptedAssetDenoms gets the denoms that a market accepts as order assets.
+func getAcceptedAssetDenoms(store storetypes.KVStore, marketID uint32) []string {
+	return getAcceptedDenoms(store, marketID, MakeKeyMarketAcceptedAssetDenoms)
+}
+
+// setAcceptedAssetDenoms sets the denoms that a market accepts as order assets.
+func setAcceptedAssetDenoms(store storetypes.KVStore, marketID uint32, denoms []string) {
+	setAcceptedDenoms(store, marketID, denoms, MakeKeyMarketAcceptedAssetDenoms)
+}
+
+// getAcceptedPriceDenoms gets the denoms that a market accepts as order prices.
+func getAcceptedPriceDenoms(store storetypes.KVStore, marketID uint32) []string {
+	return getAcceptedDenoms(store, marketID, MakeKeyMarketAcceptedPriceDenoms)
+}
+
+// setAcceptedPriceDenoms sets the denoms that a market accepts as order prices.
+func setAcceptedPriceDenoms(store storetypes.KVStore, marketID uint32, denoms []string) {
+	setAcceptedDenoms(store, marketID, denoms, MakeKeyMarketAcceptedPriceDenoms)
+}
+
+// validateOrderDenoms returns an error if the market does not accept the denoms of the provided order's
+// assets (including any basket assets) or price.
+func validateOrderDenoms(store storetypes.KVStore, marketID uint32, order exchange.SubOrderI) error {
+	assetDenoms := getAcceptedAssetDenoms(store, marketID)
+	if len(assetDenoms) > 0 {
+		for _, assets := range order.GetBasketAssets().Add(order.GetAssets()) {
+			if !exchange.ContainsString(assetDenoms, assets.Denom) {
+				return fmt.Errorf("market %d does not accept asset denom %q", marketID, assets.Denom)
+			}
+		}
+	}
+	price := order.GetPrice()
+	priceDenoms := getAcceptedPriceDenoms(store, marketID)
+	if len(priceDenoms) > 0 && !exchange.ContainsString(priceDenoms, price.Denom) {
+		return fmt.Errorf("market %d does not accept price denom %q", marketID, price.Denom)
+	}
+	return nil
+}
+
+// GetAcceptedAssetDenoms gets the denoms that a market accepts as order assets.
+// An empty result means the market accepts any asset denom.
+func (k Keeper) GetAcceptedAssetDenoms(ctx sdk.Context, marketID uint32) []string {
+	return getAcceptedAssetDenoms(k.getStore(ctx), marketID)
+}
+
+// GetAcceptedPriceDenoms gets the denoms that a market accepts as order prices.
+// An empty result means the market accepts any price denom.
+func (k Keeper) GetAcceptedPriceDenoms(ctx sdk.Context, marketID uint32) []string {
+	return getAcceptedPriceDenoms(k.getStore(ctx), marketID)
+}
+
+// UpdateAcceptedDenoms updates a market's accepted asset and price denoms using the provided changes.
+// The caller is responsible for making sure this update should be allowed (e.g. by calling CanUpdateMarket first).
+func (k Keeper) UpdateAcceptedDenoms(ctx sdk.Context, msg *exchange.MsgMarketManageAcceptedDenomsRequest) error {
+	var errs []error
+	marketID := msg.MarketId
+	store := k.getStore(ctx)
+
+	if err := updateAcceptedDenoms(store, marketID, msg.AssetDenomsToRemove, msg.AssetDenomsToAdd, "asset", MakeKeyMarketAcceptedAssetDenoms); err != nil {
+		errs = append(errs, err)
+	}
+	if err := updateAcceptedDenoms(store, marketID, msg.PriceDenomsToRemove, msg.PriceDenomsToAdd, "price", MakeKeyMarketAcceptedPriceDenoms); err != nil {
+		errs = append(errs, err)
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	k.emitEvent(ctx, exchange.NewEventMarketAcceptedDenomsUpdated(marketID, msg.Admin))
+	return nil
+}
+
 // getMarketAccountByAddr gets a market's account given its address.
 // This is for when you've already called exchange.GetMarketAddress(marketID) and need it for other things too.
 func (k Keeper) getMarketAccountByAddr(ctx sdk.Context, marketAddr sdk.AccAddress) *exchange.MarketAccount {
@@ -1409,6 +2244,11 @@ func storeMarket(store storetypes.KVStore, market exchange.Market) {
 	setCreateCommitmentFlatFees(store, marketID, market.FeeCreateCommitmentFlat)
 	setSellerSettlementFlatFees(store, marketID, market.FeeSellerSettlementFlat)
 	setSellerSettlementRatios(store, marketID, market.FeeSellerSettlementRatios)
+	setSellerSettlementTakerRatios(store, marketID, market.FeeSellerSettlementTakerRatios)
+	setSellerSettlementRebateRatios(store, marketID, market.FeeSellerSettlementRebateRatios)
+	setRebateAddresses(store, marketID, market.RebateAddresses)
+	setReferralBips(store, marketID, market.ReferralBips)
+	setReferralCap(store, marketID, market.ReferralCap)
 	setBuyerSettlementFlatFees(store, marketID, market.FeeBuyerSettlementFlat)
 	setBuyerSettlementRatios(store, marketID, market.FeeBuyerSettlementRatios)
 	setMarketAcceptingOrders(store, marketID, market.AcceptingOrders)
@@ -1420,6 +2260,17 @@ func storeMarket(store storetypes.KVStore, market exchange.Market) {
 	setMarketAcceptingCommitments(store, marketID, market.AcceptingCommitments)
 	setCommitmentSettlementBips(store, marketID, market.CommitmentSettlementBips)
 	setIntermediaryDenom(store, marketID, market.IntermediaryDenom)
+	setContinuousMatchingEnabled(store, marketID, market.ContinuousMatching)
+	setBatchAuctionInterval(store, marketID, market.BatchAuctionInterval)
+	setSelfTradePrevention(store, marketID, market.SelfTradePrevention)
+	setAcceptedAssetDenoms(store, marketID, market.AcceptedAssetDenoms)
+	setAcceptedPriceDenoms(store, marketID, market.AcceptedPriceDenoms)
+	setPublishPricesEnabled(store, marketID, market.PublishPrices)
+	setExternalIDScope(store, marketID, market.ExternalIdScope)
+	setOrderRateLimit(store, marketID, market.OrderRateLimit)
+	setMaxExposure(store, marketID, market.MaxExposure)
+	setFillAlgorithm(store, marketID, market.FillAlgorithm)
+	setExchangeSplits(store, marketID, market.ExchangeSplits)
 }
 
 // initMarket is similar to CreateMarket but assumes the market has already been
@@ -1482,6 +2333,9 @@ func (k Keeper) CreateMarket(ctx sdk.Context, market exchange.Market) (uint32, e
 	k.accountKeeper.SetAccount(ctx, marketAcc)
 
 	storeMarket(store, market)
+	if market.BatchAuctionInterval != 0 {
+		setLastBatchAuctionHeight(store, market.MarketId, ctx.BlockHeight())
+	}
 	k.emitEvent(ctx, exchange.NewEventMarketCreated(market.MarketId))
 
 	return market.MarketId, nil
@@ -1501,6 +2355,11 @@ func (k Keeper) GetMarket(ctx sdk.Context, marketID uint32) *exchange.Market {
 	market.FeeCreateCommitmentFlat = getCreateCommitmentFlatFees(store, marketID)
 	market.FeeSellerSettlementFlat = getSellerSettlementFlatFees(store, marketID)
 	market.FeeSellerSettlementRatios = getSellerSettlementRatios(store, marketID)
+	market.FeeSellerSettlementTakerRatios = getSellerSettlementTakerRatios(store, marketID)
+	market.FeeSellerSettlementRebateRatios = getSellerSettlementRebateRatios(store, marketID)
+	market.RebateAddresses = getRebateAddresses(store, marketID)
+	market.ReferralBips = getReferralBips(store, marketID)
+	market.ReferralCap = getReferralCap(store, marketID)
 	market.FeeBuyerSettlementFlat = getBuyerSettlementFlatFees(store, marketID)
 	market.FeeBuyerSettlementRatios = getBuyerSettlementRatios(store, marketID)
 	market.AcceptingOrders = isMarketAcceptingOrders(store, marketID)
@@ -1512,6 +2371,17 @@ func (k Keeper) GetMarket(ctx sdk.Context, marketID uint32) *exchange.Market {
 	market.AcceptingCommitments = isMarketAcceptingCommitments(store, marketID)
 	market.CommitmentSettlementBips = getCommitmentSettlementBips(store, marketID)
 	market.IntermediaryDenom = getIntermediaryDenom(store, marketID)
+	market.ContinuousMatching = isContinuousMatchingEnabled(store, marketID)
+	market.BatchAuctionInterval = getBatchAuctionInterval(store, marketID)
+	market.SelfTradePrevention = getSelfTradePrevention(store, marketID)
+	market.AcceptedAssetDenoms = getAcceptedAssetDenoms(store, marketID)
+	market.AcceptedPriceDenoms = getAcceptedPriceDenoms(store, marketID)
+	market.PublishPrices = isPublishPricesEnabled(store, marketID)
+	market.ExternalIdScope = getExternalIDScope(store, marketID)
+	market.OrderRateLimit = getOrderRateLimit(store, marketID)
+	market.MaxExposure = getMaxExposure(store, marketID)
+	market.FillAlgorithm = getFillAlgorithm(store, marketID)
+	market.ExchangeSplits = getExchangeSplits(store, marketID)
 
 	if marketAcc := k.GetMarketAccount(ctx, marketID); marketAcc != nil {
 		market.MarketDetails = marketAcc.MarketDetails
@@ -1576,6 +2446,8 @@ func (k Keeper) ValidateMarket(ctx sdk.Context, marketID uint32) error {
 	sellerRatios := getSellerSettlementRatios(store, marketID)
 	buyerRatios := getBuyerSettlementRatios(store, marketID)
 	errs := exchange.ValidateRatioDenoms(sellerRatios, buyerRatios)
+	takerRatios := getSellerSettlementTakerRatios(store, marketID)
+	errs = append(errs, exchange.ValidateTakerRatioDenoms(sellerRatios, takerRatios)...)
 
 	bips := getCommitmentSettlementBips(store, marketID)
 	convDenom := getIntermediaryDenom(store, marketID)
@@ -1610,3 +2482,163 @@ func (k Keeper) CloseMarket(ctx sdk.Context, marketID uint32, signer string) {
 	k.CancelAllOrdersForMarket(ctx, marketID, signer)
 	k.ReleaseAllCommitmentsForMarket(ctx, marketID)
 }
+
+// windDownAccounting keeps track of the funds released for each account while winding down a market.
+type windDownAccounting struct {
+	accounts    []string
+	orderFunds  map[string]sdk.Coins
+	commitFunds map[string]sdk.Coins
+}
+
+// newWindDownAccounting creates a new, empty windDownAccounting.
+func newWindDownAccounting() *windDownAccounting {
+	return &windDownAccounting{
+		orderFunds:  make(map[string]sdk.Coins),
+		commitFunds: make(map[string]sdk.Coins),
+	}
+}
+
+// track makes sure the provided account is included in the accounting.
+func (a *windDownAccounting) track(account string) {
+	_, haveOrder := a.orderFunds[account]
+	_, haveCommit := a.commitFunds[account]
+	if !haveOrder && !haveCommit {
+		a.accounts = append(a.accounts, account)
+	}
+}
+
+// addOrderFunds records that the provided funds were released from one of the account's orders.
+func (a *windDownAccounting) addOrderFunds(account string, funds sdk.Coins) {
+	a.track(account)
+	a.orderFunds[account] = a.orderFunds[account].Add(funds...)
+}
+
+// addCommitFunds records that the provided funds were released from the account's commitment.
+func (a *windDownAccounting) addCommitFunds(account string, funds sdk.Coins) {
+	a.track(account)
+	a.commitFunds[account] = a.commitFunds[account].Add(funds...)
+}
+
+// releases gets the WindDownRelease entries for each account in the order they were first seen.
+func (a *windDownAccounting) releases() []*exchange.WindDownRelease {
+	rv := make([]*exchange.WindDownRelease, len(a.accounts))
+	for i, account := range a.accounts {
+		rv[i] = exchange.NewWindDownRelease(account, a.orderFunds[account], a.commitFunds[account])
+	}
+	return rv
+}
+
+// WindDownMarket closes a market (see CloseMarket), also cancelling its trigger orders, then optionally
+// deletes the market's record. An EventMarketWoundDown is emitted with an accounting of all the released funds.
+// Problems cancelling individual orders or releasing individual commitments are logged, but do not stop the process.
+//
+// Deleting a market removes its exchange-module state, but leaves its account, so its market id cannot be reused.
+// A market cannot be deleted while its commitment reward pool has funds.
+func (k Keeper) WindDownMarket(ctx sdk.Context, marketID uint32, deleteMarket bool, signer string) error {
+	store := k.getStore(ctx)
+	if err := validateMarketExists(store, marketID); err != nil {
+		return err
+	}
+	if deleteMarket {
+		if pool := getCommitmentRewardPool(store, marketID); !pool.IsZero() {
+			return fmt.Errorf("cannot delete market %d: commitment reward pool still has %s", marketID, pool)
+		}
+	}
+
+	_ = k.UpdateMarketAcceptingOrders(ctx, marketID, false, signer)
+	_ = k.UpdateMarketAcceptingCommitments(ctx, marketID, false, signer)
+
+	var errs []error
+	// Gather everything first so we aren't writing to the store while iterating it.
+	var orders []exchange.Order
+	k.IterateMarketOrders(ctx, marketID, func(orderID uint64, _ byte) bool {
+		order, err := k.getOrderFromStore(store, orderID)
+		switch {
+		case err != nil:
+			errs = append(errs, err)
+		case order == nil:
+			errs = append(errs, fmt.Errorf("order %d not found", orderID))
+		default:
+			orders = append(orders, *order)
+		}
+		return false
+	})
+	triggerOrders, err := k.getMarketTriggerOrdersFromStore(store, marketID)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	for _, triggerOrder := range triggerOrders {
+		orders = append(orders, triggerOrder.Order)
+	}
+
+	orderExists := func(orderID uint64) bool {
+		return store.Has(MakeKeyOrder(orderID)) || store.Has(MakeKeyTriggerOrder(orderID))
+	}
+
+	for _, order := range orders {
+		// An order might have already been cancelled because it was linked to one cancelled earlier in this loop.
+		if !orderExists(order.OrderId) {
+			continue
+		}
+		// Using a cache context so that nothing is changed if there's an error.
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err = k.CancelOrder(cacheCtx, order.OrderId, signer); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		writeCache()
+	}
+
+	accounting := newWindDownAccounting()
+	var ordersCancelled uint32
+	// Linked orders are always in the same market, so checking what's gone accounts for those too.
+	for _, order := range orders {
+		if orderExists(order.OrderId) {
+			continue
+		}
+		ordersCancelled++
+		accounting.addOrderFunds(order.GetOwner(), order.GetHoldAmount())
+	}
+
+	var commitments []exchange.AccountAmount
+	k.iterate(ctx, GetKeyPrefixCommitmentsToMarket(marketID), func(keySuffix, value []byte) bool {
+		addr, perr := ParseKeySuffixCommitment(keySuffix)
+		if perr != nil {
+			errs = append(errs, fmt.Errorf("failed to parse addr from key suffix %x: %w", keySuffix, perr))
+			return false
+		}
+		amount, perr := parseCommitmentValue(value)
+		if perr != nil {
+			errs = append(errs, fmt.Errorf("failed to read commitment of %s: %w", addr, perr))
+			return false
+		}
+		commitments = append(commitments, exchange.AccountAmount{Account: addr.String(), Amount: amount})
+		return false
+	})
+
+	var commitmentsReleased uint32
+	for _, commitment := range commitments {
+		addr := sdk.MustAccAddressFromBech32(commitment.Account)
+		if err = k.ReleaseCommitment(ctx, marketID, addr, nil, "GovWindDownMarket"); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		commitmentsReleased++
+		accounting.addCommitFunds(commitment.Account, commitment.Amount)
+	}
+
+	if len(errs) > 0 {
+		k.logErrorf(ctx, "%d error(s) encountered winding down market %d:\n%v",
+			len(errs), marketID, errors.Join(errs...))
+	}
+
+	if deleteMarket {
+		deleteAll(store, GetKeyPrefixMarket(marketID))
+		deleteAll(store, GetKeyPrefixMarketScheduledFeeChange(marketID))
+		deleteAll(store, GetKeyPrefixOrderCreationCounts(marketID))
+		store.Delete(MakeKeyKnownMarketID(marketID))
+	}
+
+	k.emitEvent(ctx, exchange.NewEventMarketWoundDown(marketID, ordersCancelled, commitmentsReleased, deleteMarket, accounting.releases()))
+	return nil
+}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -436,6 +437,214 @@ func (s *TestSuite) TestKeeper_GetSellerSettlementRatios() {
 	}
 }
 
+func (s *TestSuite) TestKeeper_GetSellerSettlementTakerRatios() {
+	setter := keeper.SetSellerSettlementTakerRatios
+	tests := []struct {
+		name     string
+		setup    func()
+		marketID uint32
+		expected []exchange.FeeRatio
+	}{
+		{
+			name:     "no entries at all",
+			setup:    nil,
+			marketID: 1,
+			expected: nil,
+		},
+		{
+			name: "no entries for market",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, []exchange.FeeRatio{s.ratio("8peach:1fig")})
+				setter(store, 3, []exchange.FeeRatio{s.ratio("10plum:1fig")})
+			},
+			marketID: 2,
+			expected: nil,
+		},
+		{
+			name: "market with one entry",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, []exchange.FeeRatio{s.ratio("8peach:1fig")})
+				setter(store, 2, []exchange.FeeRatio{s.ratio("50pear:3fig")})
+				setter(store, 3, []exchange.FeeRatio{s.ratio("10plum:1fig")})
+			},
+			marketID: 2,
+			expected: []exchange.FeeRatio{s.ratio("50pear:3fig")},
+		},
+		{
+			name: "market with two coins",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, []exchange.FeeRatio{s.ratio("8peach:1fig")})
+				setter(store, 2, []exchange.FeeRatio{
+					s.ratio("50pear:3fig"),
+					s.ratio("100apple:7grape"),
+				})
+				setter(store, 3, []exchange.FeeRatio{s.ratio("10plum:1fig")})
+			},
+			marketID: 2,
+			expected: []exchange.FeeRatio{
+				s.ratio("100apple:7grape"),
+				s.ratio("50pear:3fig"),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var actual []exchange.FeeRatio
+			testFunc := func() {
+				actual = s.k.GetSellerSettlementTakerRatios(s.ctx, tc.marketID)
+			}
+			s.Require().NotPanics(testFunc, "GetSellerSettlementTakerRatios(%d)", tc.marketID)
+			s.Assert().Equal(s.ratiosStrings(tc.expected), s.ratiosStrings(actual),
+				"GetSellerSettlementTakerRatios(%d)", tc.marketID)
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_GetSellerSettlementRebateRatios() {
+	setter := keeper.SetSellerSettlementRebateRatios
+	tests := []struct {
+		name     string
+		setup    func()
+		marketID uint32
+		expected []exchange.FeeRatio
+	}{
+		{
+			name:     "no entries at all",
+			setup:    nil,
+			marketID: 1,
+			expected: nil,
+		},
+		{
+			name: "no entries for market",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, []exchange.FeeRatio{s.ratio("8peach:1peach")})
+				setter(store, 3, []exchange.FeeRatio{s.ratio("10plum:1plum")})
+			},
+			marketID: 2,
+			expected: nil,
+		},
+		{
+			name: "market with one entry",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, []exchange.FeeRatio{s.ratio("8peach:1peach")})
+				setter(store, 2, []exchange.FeeRatio{s.ratio("50pear:3pear")})
+				setter(store, 3, []exchange.FeeRatio{s.ratio("10plum:1plum")})
+			},
+			marketID: 2,
+			expected: []exchange.FeeRatio{s.ratio("50pear:3pear")},
+		},
+		{
+			name: "market with two coins",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, []exchange.FeeRatio{s.ratio("8peach:1peach")})
+				setter(store, 2, []exchange.FeeRatio{
+					s.ratio("50pear:3pear"),
+					s.ratio("100apple:7apple"),
+				})
+				setter(store, 3, []exchange.FeeRatio{s.ratio("10plum:1plum")})
+			},
+			marketID: 2,
+			expected: []exchange.FeeRatio{
+				s.ratio("100apple:7apple"),
+				s.ratio("50pear:3pear"),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var actual []exchange.FeeRatio
+			testFunc := func() {
+				actual = s.k.GetSellerSettlementRebateRatios(s.ctx, tc.marketID)
+			}
+			s.Require().NotPanics(testFunc, "GetSellerSettlementRebateRatios(%d)", tc.marketID)
+			s.Assert().Equal(s.ratiosStrings(tc.expected), s.ratiosStrings(actual),
+				"GetSellerSettlementRebateRatios(%d)", tc.marketID)
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_GetRebateAddresses() {
+	setter := keeper.SetRebateAddresses
+	tests := []struct {
+		name     string
+		setup    func()
+		marketID uint32
+		expected []string
+	}{
+		{
+			name:     "empty state",
+			marketID: 1,
+			expected: nil,
+		},
+		{
+			name: "market without any",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, []string{s.addr1.String(), s.addr2.String()})
+				setter(store, 3, []string{s.addr3.String()})
+			},
+			marketID: 2,
+			expected: nil,
+		},
+		{
+			name: "market with one",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, []string{s.addr1.String(), s.addr2.String()})
+				setter(store, 2, []string{s.addr3.String()})
+				setter(store, 3, []string{s.addr4.String(), s.addr5.String()})
+			},
+			marketID: 2,
+			expected: []string{s.addr3.String()},
+		},
+		{
+			name: "market with three",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, []string{s.addr1.String()})
+				setter(store, 22, []string{s.addr5.String(), s.addr2.String(), s.addr4.String()})
+				setter(store, 333, []string{s.addr3.String()})
+			},
+			marketID: 22,
+			expected: []string{s.addr5.String(), s.addr2.String(), s.addr4.String()},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var actual []string
+			testFunc := func() {
+				actual = s.k.GetRebateAddresses(s.ctx, tc.marketID)
+			}
+			s.Require().NotPanics(testFunc, "GetRebateAddresses(%d)", tc.marketID)
+			s.Assert().Equal(tc.expected, actual, "GetRebateAddresses(%d)", tc.marketID)
+		})
+	}
+}
+
 func (s *TestSuite) TestKeeper_GetBuyerSettlementFlatFees() {
 	setter := keeper.SetBuyerSettlementFlatFees
 	tests := []struct {
@@ -628,40 +837,40 @@ func (s *TestSuite) TestKeeper_GetCommitmentSettlementBips() {
 	}
 }
 
-func (s *TestSuite) TestKeeper_GetIntermediaryDenom() {
-	setter := keeper.SetIntermediaryDenom
+func (s *TestSuite) TestKeeper_GetReferralBips() {
+	setter := keeper.SetReferralBips
 	tests := []struct {
 		name     string
 		setup    func()
 		marketID uint32
-		expected string
+		expected uint32
 	}{
 		{
 			name:     "no entries at all",
 			setup:    nil,
 			marketID: 1,
-			expected: "",
+			expected: 0,
 		},
 		{
 			name: "no entry for market",
 			setup: func() {
 				store := s.getStore()
-				setter(store, 1, "one")
-				setter(store, 3, "three")
+				setter(store, 1, 10)
+				setter(store, 3, 30)
 			},
 			marketID: 2,
-			expected: "",
+			expected: 0,
 		},
 		{
 			name: "market has entry",
 			setup: func() {
 				store := s.getStore()
-				setter(store, 1, "one")
-				setter(store, 2, "two")
-				setter(store, 3, "three")
+				setter(store, 1, 10)
+				setter(store, 2, 20)
+				setter(store, 3, 30)
 			},
 			marketID: 2,
-			expected: "two",
+			expected: 20,
 		},
 	}
 
@@ -672,79 +881,200 @@ func (s *TestSuite) TestKeeper_GetIntermediaryDenom() {
 				tc.setup()
 			}
 
-			var actual string
+			var actual uint32
 			testFunc := func() {
-				actual = s.k.GetIntermediaryDenom(s.ctx, tc.marketID)
+				actual = s.k.GetReferralBips(s.ctx, tc.marketID)
 			}
-			s.Require().NotPanics(testFunc, "GetIntermediaryDenom(%d)", tc.marketID)
-			s.Assert().Equal(tc.expected, actual, "GetIntermediaryDenom(%d)", tc.marketID)
+			s.Require().NotPanics(testFunc, "GetReferralBips(%d)", tc.marketID)
+			s.Assert().Equal(int(tc.expected), int(actual), "GetReferralBips(%d)", tc.marketID)
 		})
 	}
 }
 
-func (s *TestSuite) TestKeeper_CalculateSellerSettlementRatioFee() {
-	setter := keeper.SetSellerSettlementRatios
+func (s *TestSuite) TestKeeper_GetReferralCap() {
+	setter := keeper.SetReferralCap
 	tests := []struct {
 		name     string
 		setup    func()
 		marketID uint32
-		price    sdk.Coin
-		expFee   *sdk.Coin
-		expErr   string
+		expected []sdk.Coin
 	}{
 		{
-			name:     "no ratios in store",
+			name:     "no entries at all",
 			setup:    nil,
 			marketID: 1,
-			price:    s.coin("100plum"),
-			expFee:   nil,
-			expErr:   "",
+			expected: nil,
 		},
 		{
-			name: "no ratios for market",
+			name: "no entries for market",
 			setup: func() {
 				store := s.getStore()
-				setter(store, 1, []exchange.FeeRatio{s.ratio("8peach:1peach")})
-				setter(store, 3, []exchange.FeeRatio{s.ratio("10plum:1plum")})
+				setter(store, 1, []sdk.Coin{s.coin("8acorn")})
+				setter(store, 3, []sdk.Coin{s.coin("3apple")})
 			},
 			marketID: 2,
-			price:    s.coin("100plum"),
-			expFee:   nil,
-			expErr:   "",
+			expected: nil,
 		},
 		{
-			name: "no ratio for price denom",
+			name: "market with one entry",
 			setup: func() {
 				store := s.getStore()
-				setter(store, 1, []exchange.FeeRatio{s.ratio("8peach:1peach")})
-				setter(store, 2, []exchange.FeeRatio{
-					s.ratio("10prune:1prune"),
-					s.ratio("50pear:3pear"),
-				})
-				setter(store, 3, []exchange.FeeRatio{s.ratio("10plum:1plum")})
+				setter(store, 1, []sdk.Coin{s.coin("8acorn")})
+				setter(store, 2, []sdk.Coin{s.coin("5avocado")})
+				setter(store, 3, []sdk.Coin{s.coin("3apple")})
 			},
 			marketID: 2,
-			price:    s.coin("100pears"),
-			expErr:   "no seller settlement fee ratio found for denom \"pears\"",
+			expected: []sdk.Coin{s.coin("5avocado")},
 		},
 		{
-			name: "ratio evenly applicable",
+			name: "market with two coins",
 			setup: func() {
 				store := s.getStore()
-				setter(store, 1, []exchange.FeeRatio{s.ratio("8peach:1peach")})
-				setter(store, 2, []exchange.FeeRatio{s.ratio("50pear:3pear")})
-				setter(store, 3, []exchange.FeeRatio{s.ratio("10plum:1plum")})
+				setter(store, 1, []sdk.Coin{s.coin("1acorn")})
+				setter(store, 2, []sdk.Coin{s.coin("8plum"), s.coin("2apple")})
+				setter(store, 3, []sdk.Coin{s.coin("3acorn")})
 			},
 			marketID: 2,
-			price:    s.coin("350pear"),
-			expFee:   s.coinP("21pear"),
+			expected: []sdk.Coin{s.coin("2apple"), s.coin("8plum")},
 		},
-		{
-			name: "ratio not evenly applicable",
-			setup: func() {
-				store := s.getStore()
-				setter(store, 1, []exchange.FeeRatio{s.ratio("8peach:1peach")})
-				setter(store, 2, []exchange.FeeRatio{s.ratio("50pear:3pear")})
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var actual []sdk.Coin
+			testFunc := func() {
+				actual = s.k.GetReferralCap(s.ctx, tc.marketID)
+			}
+			s.Require().NotPanics(testFunc, "GetReferralCap(%d)", tc.marketID)
+			s.Assert().Equal(s.coinsString(tc.expected), s.coinsString(actual),
+				"GetReferralCap(%d)", tc.marketID)
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_GetIntermediaryDenom() {
+	setter := keeper.SetIntermediaryDenom
+	tests := []struct {
+		name     string
+		setup    func()
+		marketID uint32
+		expected string
+	}{
+		{
+			name:     "no entries at all",
+			setup:    nil,
+			marketID: 1,
+			expected: "",
+		},
+		{
+			name: "no entry for market",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, "one")
+				setter(store, 3, "three")
+			},
+			marketID: 2,
+			expected: "",
+		},
+		{
+			name: "market has entry",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, "one")
+				setter(store, 2, "two")
+				setter(store, 3, "three")
+			},
+			marketID: 2,
+			expected: "two",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var actual string
+			testFunc := func() {
+				actual = s.k.GetIntermediaryDenom(s.ctx, tc.marketID)
+			}
+			s.Require().NotPanics(testFunc, "GetIntermediaryDenom(%d)", tc.marketID)
+			s.Assert().Equal(tc.expected, actual, "GetIntermediaryDenom(%d)", tc.marketID)
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_CalculateSellerSettlementRatioFee() {
+	setter := keeper.SetSellerSettlementRatios
+	tests := []struct {
+		name     string
+		setup    func()
+		marketID uint32
+		price    sdk.Coin
+		isTaker  bool
+		expFee   *sdk.Coin
+		expErr   string
+	}{
+		{
+			name:     "no ratios in store",
+			setup:    nil,
+			marketID: 1,
+			price:    s.coin("100plum"),
+			expFee:   nil,
+			expErr:   "",
+		},
+		{
+			name: "no ratios for market",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, []exchange.FeeRatio{s.ratio("8peach:1peach")})
+				setter(store, 3, []exchange.FeeRatio{s.ratio("10plum:1plum")})
+			},
+			marketID: 2,
+			price:    s.coin("100plum"),
+			expFee:   nil,
+			expErr:   "",
+		},
+		{
+			name: "no ratio for price denom",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, []exchange.FeeRatio{s.ratio("8peach:1peach")})
+				setter(store, 2, []exchange.FeeRatio{
+					s.ratio("10prune:1prune"),
+					s.ratio("50pear:3pear"),
+				})
+				setter(store, 3, []exchange.FeeRatio{s.ratio("10plum:1plum")})
+			},
+			marketID: 2,
+			price:    s.coin("100pears"),
+			expErr:   "no seller settlement fee ratio found for denom \"pears\"",
+		},
+		{
+			name: "ratio evenly applicable",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, []exchange.FeeRatio{s.ratio("8peach:1peach")})
+				setter(store, 2, []exchange.FeeRatio{s.ratio("50pear:3pear")})
+				setter(store, 3, []exchange.FeeRatio{s.ratio("10plum:1plum")})
+			},
+			marketID: 2,
+			price:    s.coin("350pear"),
+			expFee:   s.coinP("21pear"),
+		},
+		{
+			name: "ratio not evenly applicable",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, []exchange.FeeRatio{s.ratio("8peach:1peach")})
+				setter(store, 2, []exchange.FeeRatio{s.ratio("50pear:3pear")})
 				setter(store, 3, []exchange.FeeRatio{s.ratio("10plum:1plum")})
 			},
 			marketID: 2,
@@ -799,6 +1129,49 @@ func (s *TestSuite) TestKeeper_CalculateSellerSettlementRatioFee() {
 			price:    s.coin("123456pear"),
 			expFee:   s.coinP("7408pear"), // 123456 * 3 = 370368, 370368 / 50 = 7407.36 => 7408.
 		},
+		{
+			name: "maker with taker ratio",
+			setup: func() {
+				setter(s.getStore(), 2, []exchange.FeeRatio{s.ratio("50pear:3pear")})
+				keeper.SetSellerSettlementTakerRatios(s.getStore(), 2, []exchange.FeeRatio{s.ratio("50pear:5pear")})
+			},
+			marketID: 2,
+			price:    s.coin("350pear"),
+			isTaker:  false,
+			expFee:   s.coinP("21pear"),
+		},
+		{
+			name: "taker with taker ratio",
+			setup: func() {
+				setter(s.getStore(), 2, []exchange.FeeRatio{s.ratio("50pear:3pear")})
+				keeper.SetSellerSettlementTakerRatios(s.getStore(), 2, []exchange.FeeRatio{s.ratio("50pear:5pear")})
+			},
+			marketID: 2,
+			price:    s.coin("350pear"),
+			isTaker:  true,
+			expFee:   s.coinP("35pear"),
+		},
+		{
+			name: "taker without taker ratio for price denom",
+			setup: func() {
+				setter(s.getStore(), 2, []exchange.FeeRatio{s.ratio("50pear:3pear"), s.ratio("10plum:1plum")})
+				keeper.SetSellerSettlementTakerRatios(s.getStore(), 2, []exchange.FeeRatio{s.ratio("10plum:2plum")})
+			},
+			marketID: 2,
+			price:    s.coin("350pear"),
+			isTaker:  true,
+			expFee:   s.coinP("21pear"),
+		},
+		{
+			name: "taker with only taker ratios",
+			setup: func() {
+				keeper.SetSellerSettlementTakerRatios(s.getStore(), 2, []exchange.FeeRatio{s.ratio("50pear:5pear")})
+			},
+			marketID: 2,
+			price:    s.coin("350pear"),
+			isTaker:  true,
+			expFee:   s.coinP("35pear"),
+		},
 	}
 
 	for _, tc := range tests {
@@ -811,12 +1184,12 @@ func (s *TestSuite) TestKeeper_CalculateSellerSettlementRatioFee() {
 			var fee *sdk.Coin
 			var err error
 			testFunc := func() {
-				fee, err = s.k.CalculateSellerSettlementRatioFee(s.ctx, tc.marketID, tc.price)
+				fee, err = s.k.CalculateSellerSettlementRatioFee(s.ctx, tc.marketID, tc.price, tc.isTaker)
 			}
-			s.Require().NotPanics(testFunc, "CalculateSellerSettlementRatioFee(%d, %q)", tc.marketID, tc.price)
-			s.assertErrorValue(err, tc.expErr, "CalculateSellerSettlementRatioFee(%d, %q)", tc.marketID, tc.price)
+			s.Require().NotPanics(testFunc, "CalculateSellerSettlementRatioFee(%d, %q, %t)", tc.marketID, tc.price, tc.isTaker)
+			s.assertErrorValue(err, tc.expErr, "CalculateSellerSettlementRatioFee(%d, %q, %t)", tc.marketID, tc.price, tc.isTaker)
 			s.Assert().Equal(s.coinPString(tc.expFee), s.coinPString(fee),
-				"CalculateSellerSettlementRatioFee(%d, %q)", tc.marketID, tc.price)
+				"CalculateSellerSettlementRatioFee(%d, %q, %t)", tc.marketID, tc.price, tc.isTaker)
 		})
 	}
 }
@@ -2117,6 +2490,38 @@ func (s *TestSuite) TestKeeper_ValidateAskPrice() {
 			settlementFlatFee: nil,
 			expErr:            "",
 		},
+		{
+			name: "taker ratio: price more than both",
+			setup: func() {
+				keeper.SetSellerSettlementRatios(s.getStore(), 1, []exchange.FeeRatio{s.ratio("100plum:1plum")})
+				keeper.SetSellerSettlementTakerRatios(s.getStore(), 1, []exchange.FeeRatio{s.ratio("100plum:5plum")})
+			},
+			marketID:          1,
+			price:             s.coin("10plum"),
+			settlementFlatFee: s.coinP("8plum"),
+			expErr:            "",
+		},
+		{
+			name: "taker ratio: price more than maker but not taker",
+			setup: func() {
+				keeper.SetSellerSettlementRatios(s.getStore(), 1, []exchange.FeeRatio{s.ratio("100plum:1plum")})
+				keeper.SetSellerSettlementTakerRatios(s.getStore(), 1, []exchange.FeeRatio{s.ratio("100plum:5plum")})
+			},
+			marketID:          1,
+			price:             s.coin("1000plum"),
+			settlementFlatFee: s.coinP("960plum"),
+			expErr:            "price 1000plum is not more than total required seller settlement taker fee 1010plum = 960plum flat + 50plum ratio",
+		},
+		{
+			name: "taker ratio: only taker ratios",
+			setup: func() {
+				keeper.SetSellerSettlementTakerRatios(s.getStore(), 1, []exchange.FeeRatio{s.ratio("10plum:10plum")})
+			},
+			marketID:          1,
+			price:             s.coin("20plum"),
+			settlementFlatFee: nil,
+			expErr:            "price 20plum is not more than seller settlement taker ratio fee 20plum",
+		},
 	}
 
 	for _, tc := range tests {
@@ -2720,9 +3125,14 @@ func (s *TestSuite) TestKeeper_UpdateFees() {
 		createCom   string
 		sellerFlat  string
 		sellerRatio string
+		takerRatio  string
+		rebateRatio string
+		rebateAddrs string
 		buyerFlat   string
 		buyerRatio  string
 		comBips     string
+		refBips     string
+		refCap      string
 	}
 	getMarketFees := func(marketID uint32) marketFees {
 		rv := marketFees{
@@ -2732,6 +3142,9 @@ func (s *TestSuite) TestKeeper_UpdateFees() {
 			createCom:   sdk.Coins(s.k.GetCreateCommitmentFlatFees(s.ctx, marketID)).String(),
 			sellerFlat:  sdk.Coins(s.k.GetSellerSettlementFlatFees(s.ctx, marketID)).String(),
 			sellerRatio: exchange.FeeRatiosString(s.k.GetSellerSettlementRatios(s.ctx, marketID)),
+			takerRatio:  exchange.FeeRatiosString(s.k.GetSellerSettlementTakerRatios(s.ctx, marketID)),
+			rebateRatio: exchange.FeeRatiosString(s.k.GetSellerSettlementRebateRatios(s.ctx, marketID)),
+			rebateAddrs: strings.Join(s.k.GetRebateAddresses(s.ctx, marketID), ","),
 			buyerFlat:   sdk.Coins(s.k.GetBuyerSettlementFlatFees(s.ctx, marketID)).String(),
 			buyerRatio:  exchange.FeeRatiosString(s.k.GetBuyerSettlementRatios(s.ctx, marketID)),
 		}
@@ -2739,6 +3152,11 @@ func (s *TestSuite) TestKeeper_UpdateFees() {
 		if bips != 0 {
 			rv.comBips = fmt.Sprintf("%d", bips)
 		}
+		refBips := s.k.GetReferralBips(s.ctx, marketID)
+		if refBips != 0 {
+			rv.refBips = fmt.Sprintf("%d", refBips)
+		}
+		rv.refCap = sdk.Coins(s.k.GetReferralCap(s.ctx, marketID)).String()
 		return rv
 	}
 
@@ -3382,6 +3800,94 @@ func (s *TestSuite) TestKeeper_UpdateFees() {
 			expPanic:    "",
 		},
 
+		// Only seller settlement taker ratio fee changes.
+		{
+			name: "taker ratio: add one",
+			setup: func() {
+				keeper.SetSellerSettlementTakerRatios(s.getStore(), 3, s.ratios("100peach:3fig"))
+			},
+			msg:         &exchange.MsgGovManageFeesRequest{MarketId: 5, AddFeeSellerSettlementTakerRatios: s.ratios("50plum:1grape")},
+			expFees:     marketFees{marketID: 5, takerRatio: "50plum:1grape"},
+			expNoChange: []uint32{3},
+		},
+		{
+			name: "taker ratio: remove one, exists",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetSellerSettlementTakerRatios(store, 3, s.ratios("100peach:3fig"))
+				keeper.SetSellerSettlementTakerRatios(store, 5, s.ratios("90peach:2fig"))
+			},
+			msg:         &exchange.MsgGovManageFeesRequest{MarketId: 5, RemoveFeeSellerSettlementTakerRatios: s.ratios("90peach:2fig")},
+			expFees:     marketFees{marketID: 5},
+			expNoChange: []uint32{3},
+		},
+		{
+			name: "taker ratio: add+remove with same denoms",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetSellerSettlementRatios(store, 1, s.ratios("100peach:1fig"))
+				keeper.SetSellerSettlementTakerRatios(store, 1, s.ratios("100peach:3fig,100peach:1grape"))
+			},
+			msg: &exchange.MsgGovManageFeesRequest{
+				MarketId:                             1,
+				RemoveFeeSellerSettlementTakerRatios: s.ratios("100peach:3fig"),
+				AddFeeSellerSettlementTakerRatios:    s.ratios("90peach:2fig"),
+			},
+			expFees: marketFees{marketID: 1, sellerRatio: "100peach:1fig", takerRatio: "90peach:2fig,100peach:1grape"},
+		},
+
+		// Only seller settlement rebate ratio and rebate address changes.
+		{
+			name: "rebate ratio: add one",
+			setup: func() {
+				keeper.SetSellerSettlementRebateRatios(s.getStore(), 3, s.ratios("100peach:1peach"))
+			},
+			msg:         &exchange.MsgGovManageFeesRequest{MarketId: 5, AddFeeSellerSettlementRebateRatios: s.ratios("50plum:1plum")},
+			expFees:     marketFees{marketID: 5, rebateRatio: "50plum:1plum"},
+			expNoChange: []uint32{3},
+		},
+		{
+			name: "rebate ratio: add+remove",
+			setup: func() {
+				keeper.SetSellerSettlementRebateRatios(s.getStore(), 5, s.ratios("100peach:1peach,50plum:1plum"))
+			},
+			msg: &exchange.MsgGovManageFeesRequest{
+				MarketId:                              5,
+				RemoveFeeSellerSettlementRebateRatios: s.ratios("100peach:1peach"),
+				AddFeeSellerSettlementRebateRatios:    s.ratios("200peach:1peach"),
+			},
+			expFees: marketFees{marketID: 5, rebateRatio: "200peach:1peach,50plum:1plum"},
+		},
+		{
+			name: "rebate addresses: add two",
+			setup: func() {
+				keeper.SetRebateAddresses(s.getStore(), 3, []string{s.addr1.String()})
+			},
+			msg:         &exchange.MsgGovManageFeesRequest{MarketId: 5, AddRebateAddresses: []string{s.addr2.String(), s.addr3.String()}},
+			expFees:     marketFees{marketID: 5, rebateAddrs: s.addr2.String() + "," + s.addr3.String()},
+			expNoChange: []uint32{3},
+		},
+		{
+			name: "rebate addresses: add+remove",
+			setup: func() {
+				keeper.SetRebateAddresses(s.getStore(), 5, []string{s.addr1.String(), s.addr2.String()})
+			},
+			msg: &exchange.MsgGovManageFeesRequest{
+				MarketId:              5,
+				RemoveRebateAddresses: []string{s.addr1.String()},
+				AddRebateAddresses:    []string{s.addr4.String()},
+			},
+			expFees: marketFees{marketID: 5, rebateAddrs: s.addr2.String() + "," + s.addr4.String()},
+		},
+		{
+			name: "rebate addresses: remove all",
+			setup: func() {
+				keeper.SetRebateAddresses(s.getStore(), 5, []string{s.addr1.String()})
+			},
+			msg:     &exchange.MsgGovManageFeesRequest{MarketId: 5, RemoveRebateAddresses: []string{s.addr1.String()}},
+			expFees: marketFees{marketID: 5},
+		},
+
 		// Only buyer settlement ratio fee changes.
 		{
 			name: "buyer ratio: add one",
@@ -3597,6 +4103,49 @@ func (s *TestSuite) TestKeeper_UpdateFees() {
 			expNoChange: []uint32{1, 3},
 		},
 
+		// Only referral bips and referral cap changes.
+		{
+			name: "referral bips: setting",
+			setup: func() {
+				keeper.SetReferralBips(s.getStore(), 1, 100)
+			},
+			msg:         &exchange.MsgGovManageFeesRequest{MarketId: 2, SetReferralBips: 2500},
+			expFees:     marketFees{marketID: 2, refBips: "2500"},
+			expNoChange: []uint32{1},
+		},
+		{
+			name: "referral bips: unsetting",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetReferralBips(store, 1, 100)
+				keeper.SetReferralBips(store, 2, 200)
+			},
+			msg:         &exchange.MsgGovManageFeesRequest{MarketId: 2, UnsetReferralBips: true},
+			expFees:     marketFees{marketID: 2},
+			expNoChange: []uint32{1},
+		},
+		{
+			name: "referral cap: add one",
+			setup: func() {
+				keeper.SetReferralCap(s.getStore(), 3, s.coins("10peach"))
+			},
+			msg:         &exchange.MsgGovManageFeesRequest{MarketId: 5, AddReferralCap: s.coins("5plum")},
+			expFees:     marketFees{marketID: 5, refCap: "5plum"},
+			expNoChange: []uint32{3},
+		},
+		{
+			name: "referral cap: add+remove",
+			setup: func() {
+				keeper.SetReferralCap(s.getStore(), 5, s.coins("10peach,5plum"))
+			},
+			msg: &exchange.MsgGovManageFeesRequest{
+				MarketId:          5,
+				RemoveReferralCap: s.coins("10peach"),
+				AddReferralCap:    s.coins("20peach"),
+			},
+			expFees: marketFees{marketID: 5, refCap: "20peach,5plum"},
+		},
+
 		// combo
 		{
 			name: "a little bit of everything",
@@ -4452,15 +5001,1494 @@ func (s *TestSuite) TestKeeper_UpdateMarketAcceptingCommitments() {
 	}
 }
 
-func (s *TestSuite) TestKeeper_HasPermission() {
-	goodAcc := sdk.AccAddress("goodAddr____________")
-	goodAddr := goodAcc.String()
-	authority := s.k.GetAuthority()
+func (s *TestSuite) TestKeeper_IsContinuousMatchingEnabled() {
+	setter := keeper.SetContinuousMatchingEnabled
 	tests := []struct {
-		name       string
-		setup      func()
-		marketID   uint32
-		address    string
+		name     string
+		setup    func()
+		marketID uint32
+		expected bool
+	}{
+		{
+			name:     "empty state",
+			marketID: 1,
+			expected: false,
+		},
+		{
+			name: "unknown market id",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, true)
+				setter(store, 3, true)
+			},
+			marketID: 2,
+			expected: false,
+		},
+		{
+			name: "not enabled",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, true)
+				setter(store, 2, false)
+				setter(store, 3, true)
+			},
+			marketID: 2,
+			expected: false,
+		},
+		{
+			name: "enabled",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, true)
+				setter(store, 2, true)
+				setter(store, 3, true)
+			},
+			marketID: 2,
+			expected: true,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var actual bool
+			testFunc := func() {
+				actual = s.k.IsContinuousMatchingEnabled(s.ctx, tc.marketID)
+			}
+			s.Require().NotPanics(testFunc, "IsContinuousMatchingEnabled(%d)", tc.marketID)
+			s.Assert().Equal(tc.expected, actual, "IsContinuousMatchingEnabled(%d) result", tc.marketID)
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_UpdateContinuousMatching() {
+	setter := keeper.SetContinuousMatchingEnabled
+	tests := []struct {
+		name      string
+		setup     func()
+		marketID  uint32
+		enabled   bool
+		updatedBy string
+		expErr    string
+	}{
+		{
+			name:      "empty state to enabled",
+			marketID:  1,
+			enabled:   true,
+			updatedBy: "updatedBy___________",
+			expErr:    "",
+		},
+		{
+			name:      "empty state to not enabled",
+			marketID:  1,
+			enabled:   false,
+			updatedBy: "updatedBy___________",
+			expErr:    "market 1 already has continuous-matching false",
+		},
+		{
+			name: "enabled to enabled",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, true)
+				setter(store, 2, false)
+				setter(store, 3, true)
+				setter(store, 4, true)
+				setter(store, 5, false)
+			},
+			marketID:  3,
+			enabled:   true,
+			updatedBy: "updatedBy___________",
+			expErr:    "market 3 already has continuous-matching true",
+		},
+		{
+			name: "enabled to not enabled",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, true)
+				setter(store, 2, false)
+				setter(store, 3, true)
+				setter(store, 4, true)
+				setter(store, 5, false)
+			},
+			marketID:  3,
+			enabled:   false,
+			updatedBy: "updated_by__________",
+			expErr:    "",
+		},
+		{
+			name: "not enabled to enabled",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 11, true)
+				setter(store, 12, false)
+				setter(store, 13, false)
+				setter(store, 14, true)
+				setter(store, 15, false)
+			},
+			marketID:  13,
+			enabled:   true,
+			updatedBy: "updated___by________",
+			expErr:    "",
+		},
+		{
+			name: "not enabled to not enabled",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 11, true)
+				setter(store, 12, false)
+				setter(store, 13, false)
+				setter(store, 14, true)
+				setter(store, 15, false)
+			},
+			marketID:  13,
+			enabled:   false,
+			updatedBy: "__updated_____by____",
+			expErr:    "market 13 already has continuous-matching false",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var expEvents sdk.Events
+			if len(tc.expErr) == 0 {
+				event := exchange.NewEventMarketContinuousMatchingUpdated(tc.marketID, tc.updatedBy, tc.enabled)
+				expEvents = append(expEvents, s.untypeEvent(event))
+			}
+
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			var err error
+			testFunc := func() {
+				err = s.k.UpdateContinuousMatching(ctx, tc.marketID, tc.enabled, tc.updatedBy)
+			}
+			s.Require().NotPanics(testFunc, "UpdateContinuousMatching(%d, %t, %s)", tc.marketID, tc.enabled, string(tc.updatedBy))
+			s.assertErrorValue(err, tc.expErr, "UpdateContinuousMatching(%d, %t, %s)", tc.marketID, tc.enabled, string(tc.updatedBy))
+
+			events := em.Events()
+			s.assertEqualEvents(expEvents, events, "events after UpdateContinuousMatching")
+
+			if len(tc.expErr) == 0 {
+				isActive := s.k.IsContinuousMatchingEnabled(s.ctx, tc.marketID)
+				s.Assert().Equal(tc.enabled, isActive, "IsContinuousMatchingEnabled(%d) after UpdateContinuousMatching(%d, %t, ...)",
+					tc.marketID, tc.marketID, tc.enabled)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_GetSelfTradePrevention() {
+	setter := keeper.SetSelfTradePrevention
+	tests := []struct {
+		name     string
+		setup    func()
+		marketID uint32
+		expected exchange.SelfTradePrevention
+	}{
+		{
+			name:     "empty state",
+			marketID: 1,
+			expected: exchange.SelfTradePrevention_unspecified,
+		},
+		{
+			name: "unknown market id",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, exchange.SelfTradePrevention_cancel_newest)
+				setter(store, 3, exchange.SelfTradePrevention_cancel_oldest)
+			},
+			marketID: 2,
+			expected: exchange.SelfTradePrevention_unspecified,
+		},
+		{
+			name: "set to unspecified",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, exchange.SelfTradePrevention_cancel_newest)
+				setter(store, 2, exchange.SelfTradePrevention_unspecified)
+				setter(store, 3, exchange.SelfTradePrevention_cancel_oldest)
+			},
+			marketID: 2,
+			expected: exchange.SelfTradePrevention_unspecified,
+		},
+		{
+			name: "cancel newest",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, exchange.SelfTradePrevention_decrement_both)
+				setter(store, 2, exchange.SelfTradePrevention_cancel_newest)
+				setter(store, 3, exchange.SelfTradePrevention_cancel_oldest)
+			},
+			marketID: 2,
+			expected: exchange.SelfTradePrevention_cancel_newest,
+		},
+		{
+			name: "cancel oldest",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, exchange.SelfTradePrevention_decrement_both)
+				setter(store, 2, exchange.SelfTradePrevention_cancel_oldest)
+				setter(store, 3, exchange.SelfTradePrevention_cancel_newest)
+			},
+			marketID: 2,
+			expected: exchange.SelfTradePrevention_cancel_oldest,
+		},
+		{
+			name: "decrement both",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, exchange.SelfTradePrevention_cancel_oldest)
+				setter(store, 2, exchange.SelfTradePrevention_decrement_both)
+				setter(store, 3, exchange.SelfTradePrevention_cancel_newest)
+			},
+			marketID: 2,
+			expected: exchange.SelfTradePrevention_decrement_both,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var actual exchange.SelfTradePrevention
+			testFunc := func() {
+				actual = s.k.GetSelfTradePrevention(s.ctx, tc.marketID)
+			}
+			s.Require().NotPanics(testFunc, "GetSelfTradePrevention(%d)", tc.marketID)
+			s.Assert().Equal(tc.expected.String(), actual.String(), "GetSelfTradePrevention(%d) result", tc.marketID)
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_UpdateSelfTradePrevention() {
+	setter := keeper.SetSelfTradePrevention
+	tests := []struct {
+		name      string
+		setup     func()
+		marketID  uint32
+		stp       exchange.SelfTradePrevention
+		updatedBy string
+		expErr    string
+	}{
+		{
+			name:      "empty state to cancel newest",
+			marketID:  1,
+			stp:       exchange.SelfTradePrevention_cancel_newest,
+			updatedBy: "updatedBy___________",
+			expErr:    "",
+		},
+		{
+			name:      "empty state to unspecified",
+			marketID:  1,
+			stp:       exchange.SelfTradePrevention_unspecified,
+			updatedBy: "updatedBy___________",
+			expErr:    "market 1 already has self-trade-prevention unspecified",
+		},
+		{
+			name: "cancel oldest to cancel oldest",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 2, exchange.SelfTradePrevention_cancel_newest)
+				setter(store, 3, exchange.SelfTradePrevention_cancel_oldest)
+				setter(store, 4, exchange.SelfTradePrevention_decrement_both)
+			},
+			marketID:  3,
+			stp:       exchange.SelfTradePrevention_cancel_oldest,
+			updatedBy: "updatedBy___________",
+			expErr:    "market 3 already has self-trade-prevention cancel_oldest",
+		},
+		{
+			name: "cancel oldest to decrement both",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 2, exchange.SelfTradePrevention_cancel_newest)
+				setter(store, 3, exchange.SelfTradePrevention_cancel_oldest)
+				setter(store, 4, exchange.SelfTradePrevention_decrement_both)
+			},
+			marketID:  3,
+			stp:       exchange.SelfTradePrevention_decrement_both,
+			updatedBy: "updated_by__________",
+			expErr:    "",
+		},
+		{
+			name: "decrement both to unspecified",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 11, exchange.SelfTradePrevention_cancel_newest)
+				setter(store, 12, exchange.SelfTradePrevention_decrement_both)
+				setter(store, 13, exchange.SelfTradePrevention_cancel_oldest)
+			},
+			marketID:  12,
+			stp:       exchange.SelfTradePrevention_unspecified,
+			updatedBy: "updated___by________",
+			expErr:    "",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var expEvents sdk.Events
+			if len(tc.expErr) == 0 {
+				event := exchange.NewEventMarketSelfTradePreventionUpdated(tc.marketID, tc.updatedBy)
+				expEvents = append(expEvents, s.untypeEvent(event))
+			}
+
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			var err error
+			testFunc := func() {
+				err = s.k.UpdateSelfTradePrevention(ctx, tc.marketID, tc.stp, tc.updatedBy)
+			}
+			s.Require().NotPanics(testFunc, "UpdateSelfTradePrevention(%d, %s, %s)", tc.marketID, tc.stp, tc.updatedBy)
+			s.assertErrorValue(err, tc.expErr, "UpdateSelfTradePrevention(%d, %s, %s)", tc.marketID, tc.stp, tc.updatedBy)
+
+			events := em.Events()
+			s.assertEqualEvents(expEvents, events, "events after UpdateSelfTradePrevention")
+
+			if len(tc.expErr) == 0 {
+				actual := s.k.GetSelfTradePrevention(s.ctx, tc.marketID)
+				s.Assert().Equal(tc.stp.String(), actual.String(), "GetSelfTradePrevention(%d) after UpdateSelfTradePrevention(%d, %s, ...)",
+					tc.marketID, tc.marketID, tc.stp)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_IsPublishPricesEnabled() {
+	setter := keeper.SetPublishPricesEnabled
+	tests := []struct {
+		name     string
+		setup    func()
+		marketID uint32
+		expected bool
+	}{
+		{
+			name:     "empty state",
+			marketID: 1,
+			expected: false,
+		},
+		{
+			name: "unknown market id",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, true)
+				setter(store, 3, true)
+			},
+			marketID: 2,
+			expected: false,
+		},
+		{
+			name: "not enabled",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, true)
+				setter(store, 2, false)
+				setter(store, 3, true)
+			},
+			marketID: 2,
+			expected: false,
+		},
+		{
+			name: "enabled",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, true)
+				setter(store, 2, true)
+				setter(store, 3, true)
+			},
+			marketID: 2,
+			expected: true,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var actual bool
+			testFunc := func() {
+				actual = s.k.IsPublishPricesEnabled(s.ctx, tc.marketID)
+			}
+			s.Require().NotPanics(testFunc, "IsPublishPricesEnabled(%d)", tc.marketID)
+			s.Assert().Equal(tc.expected, actual, "IsPublishPricesEnabled(%d) result", tc.marketID)
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_UpdatePublishPrices() {
+	setter := keeper.SetPublishPricesEnabled
+	tests := []struct {
+		name      string
+		setup     func()
+		marketID  uint32
+		enabled   bool
+		updatedBy string
+		expErr    string
+	}{
+		{
+			name:      "empty state to enabled",
+			marketID:  1,
+			enabled:   true,
+			updatedBy: "updatedBy___________",
+			expErr:    "",
+		},
+		{
+			name:      "empty state to not enabled",
+			marketID:  1,
+			enabled:   false,
+			updatedBy: "updatedBy___________",
+			expErr:    "market 1 already has publish-prices false",
+		},
+		{
+			name: "enabled to enabled",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, true)
+				setter(store, 2, false)
+				setter(store, 3, true)
+				setter(store, 4, true)
+				setter(store, 5, false)
+			},
+			marketID:  3,
+			enabled:   true,
+			updatedBy: "updatedBy___________",
+			expErr:    "market 3 already has publish-prices true",
+		},
+		{
+			name: "enabled to not enabled",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, true)
+				setter(store, 2, false)
+				setter(store, 3, true)
+				setter(store, 4, true)
+				setter(store, 5, false)
+			},
+			marketID:  3,
+			enabled:   false,
+			updatedBy: "updated_by__________",
+			expErr:    "",
+		},
+		{
+			name: "not enabled to enabled",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 11, true)
+				setter(store, 12, false)
+				setter(store, 13, false)
+				setter(store, 14, true)
+				setter(store, 15, false)
+			},
+			marketID:  13,
+			enabled:   true,
+			updatedBy: "updated___by________",
+			expErr:    "",
+		},
+		{
+			name: "not enabled to not enabled",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 11, true)
+				setter(store, 12, false)
+				setter(store, 13, false)
+				setter(store, 14, true)
+				setter(store, 15, false)
+			},
+			marketID:  13,
+			enabled:   false,
+			updatedBy: "__updated_____by____",
+			expErr:    "market 13 already has publish-prices false",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var expEvents sdk.Events
+			if len(tc.expErr) == 0 {
+				event := exchange.NewEventMarketPublishPricesUpdated(tc.marketID, tc.updatedBy, tc.enabled)
+				expEvents = append(expEvents, s.untypeEvent(event))
+			}
+
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			var err error
+			testFunc := func() {
+				err = s.k.UpdatePublishPrices(ctx, tc.marketID, tc.enabled, tc.updatedBy)
+			}
+			s.Require().NotPanics(testFunc, "UpdatePublishPrices(%d, %t, %s)", tc.marketID, tc.enabled, string(tc.updatedBy))
+			s.assertErrorValue(err, tc.expErr, "UpdatePublishPrices(%d, %t, %s)", tc.marketID, tc.enabled, string(tc.updatedBy))
+
+			events := em.Events()
+			s.assertEqualEvents(expEvents, events, "events after UpdatePublishPrices")
+
+			if len(tc.expErr) == 0 {
+				isActive := s.k.IsPublishPricesEnabled(s.ctx, tc.marketID)
+				s.Assert().Equal(tc.enabled, isActive, "IsPublishPricesEnabled(%d) after UpdatePublishPrices(%d, %t, ...)",
+					tc.marketID, tc.marketID, tc.enabled)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_GetExternalIDScope() {
+	setter := keeper.SetExternalIDScope
+	tests := []struct {
+		name     string
+		setup    func()
+		marketID uint32
+		expected exchange.ExternalIDScope
+	}{
+		{
+			name:     "empty state",
+			marketID: 1,
+			expected: exchange.ExternalIDScope_unspecified,
+		},
+		{
+			name: "unknown market id",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, exchange.ExternalIDScope_account)
+				setter(store, 3, exchange.ExternalIDScope_account)
+			},
+			marketID: 2,
+			expected: exchange.ExternalIDScope_unspecified,
+		},
+		{
+			name: "set to unspecified",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, exchange.ExternalIDScope_account)
+				setter(store, 2, exchange.ExternalIDScope_unspecified)
+				setter(store, 3, exchange.ExternalIDScope_account)
+			},
+			marketID: 2,
+			expected: exchange.ExternalIDScope_unspecified,
+		},
+		{
+			name: "account",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, exchange.ExternalIDScope_unspecified)
+				setter(store, 2, exchange.ExternalIDScope_account)
+				setter(store, 3, exchange.ExternalIDScope_unspecified)
+			},
+			marketID: 2,
+			expected: exchange.ExternalIDScope_account,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var actual exchange.ExternalIDScope
+			testFunc := func() {
+				actual = s.k.GetExternalIDScope(s.ctx, tc.marketID)
+			}
+			s.Require().NotPanics(testFunc, "GetExternalIDScope(%d)", tc.marketID)
+			s.Assert().Equal(tc.expected.String(), actual.String(), "GetExternalIDScope(%d) result", tc.marketID)
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_UpdateExternalIDScope() {
+	setter := keeper.SetExternalIDScope
+	owner := sdk.AccAddress("owner_______________").String()
+	newAsk := func(orderID uint64, marketID uint32, externalID string) *exchange.Order {
+		return exchange.NewOrder(orderID).WithAsk(&exchange.AskOrder{
+			MarketId:   marketID,
+			Seller:     owner,
+			Assets:     s.coin("10apple"),
+			Price:      s.coin("80prune"),
+			ExternalId: externalID,
+		})
+	}
+
+	tests := []struct {
+		name      string
+		setup     func()
+		marketID  uint32
+		scope     exchange.ExternalIDScope
+		updatedBy string
+		expErr    string
+	}{
+		{
+			name:      "empty state to account",
+			marketID:  1,
+			scope:     exchange.ExternalIDScope_account,
+			updatedBy: "updatedBy___________",
+			expErr:    "",
+		},
+		{
+			name:      "empty state to unspecified",
+			marketID:  1,
+			scope:     exchange.ExternalIDScope_unspecified,
+			updatedBy: "updatedBy___________",
+			expErr:    "market 1 already has external-id-scope unspecified",
+		},
+		{
+			name: "account to account",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 2, exchange.ExternalIDScope_account)
+				setter(store, 3, exchange.ExternalIDScope_account)
+			},
+			marketID:  3,
+			scope:     exchange.ExternalIDScope_account,
+			updatedBy: "updatedBy___________",
+			expErr:    "market 3 already has external-id-scope account",
+		},
+		{
+			name: "account to unspecified",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 2, exchange.ExternalIDScope_account)
+				setter(store, 3, exchange.ExternalIDScope_account)
+				setter(store, 4, exchange.ExternalIDScope_account)
+				s.requireSetOrderInStore(store, newAsk(1, 3, "shared"))
+				s.requireSetOrderInStore(store, newAsk(2, 3, "other"))
+			},
+			marketID:  3,
+			scope:     exchange.ExternalIDScope_unspecified,
+			updatedBy: "updated_by__________",
+			expErr:    "",
+		},
+		{
+			name: "to account: no conflicts",
+			setup: func() {
+				store := s.getStore()
+				s.requireSetOrderInStore(store, newAsk(1, 3, "one"))
+				s.requireSetOrderInStore(store, newAsk(2, 4, "two"))
+				s.requireSetOrderInStore(store, newAsk(3, 3, ""))
+			},
+			marketID:  3,
+			scope:     exchange.ExternalIDScope_account,
+			updatedBy: "updated___by________",
+			expErr:    "",
+		},
+		{
+			name: "to account: order in another market has same external id",
+			setup: func() {
+				store := s.getStore()
+				s.requireSetOrderInStore(store, newAsk(1, 3, "one"))
+				s.requireSetOrderInStore(store, newAsk(2, 4, "one"))
+			},
+			marketID:  3,
+			scope:     exchange.ExternalIDScope_account,
+			updatedBy: "updated___by________",
+			expErr: "cannot change market 3 external id scope to account: " +
+				"external id \"one\" is already in use by order 2: cannot be used for order 1",
+		},
+		{
+			name: "to account: payment has same external id",
+			setup: func() {
+				store := s.getStore()
+				s.requireSetOrderInStore(store, newAsk(1, 3, "one"))
+				s.requireSetPaymentsInStore(&exchange.Payment{
+					Source:       owner,
+					SourceAmount: s.coins("5apple"),
+					Target:       sdk.AccAddress("target______________").String(),
+					ExternalId:   "one",
+				})
+			},
+			marketID:  3,
+			scope:     exchange.ExternalIDScope_account,
+			updatedBy: "updated___by________",
+			expErr: "cannot change market 3 external id scope to account: " +
+				"external id \"one\" is already in use by a payment from " + owner + ": cannot be used for order 1",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var expEvents sdk.Events
+			if len(tc.expErr) == 0 {
+				event := exchange.NewEventMarketExternalIDScopeUpdated(tc.marketID, tc.updatedBy)
+				expEvents = append(expEvents, s.untypeEvent(event))
+			}
+
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			var err error
+			testFunc := func() {
+				err = s.k.UpdateExternalIDScope(ctx, tc.marketID, tc.scope, tc.updatedBy)
+			}
+			s.Require().NotPanics(testFunc, "UpdateExternalIDScope(%d, %s, %s)", tc.marketID, tc.scope, tc.updatedBy)
+			s.assertErrorValue(err, tc.expErr, "UpdateExternalIDScope(%d, %s, %s)", tc.marketID, tc.scope, tc.updatedBy)
+
+			events := em.Events()
+			s.assertEqualEvents(expEvents, events, "events after UpdateExternalIDScope")
+
+			if len(tc.expErr) == 0 {
+				actual := s.k.GetExternalIDScope(s.ctx, tc.marketID)
+				s.Assert().Equal(tc.scope.String(), actual.String(), "GetExternalIDScope(%d) after UpdateExternalIDScope(%d, %s, ...)",
+					tc.marketID, tc.marketID, tc.scope)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_GetOrderRateLimit() {
+	setter := keeper.SetOrderRateLimit
+	tests := []struct {
+		name     string
+		setup    func()
+		marketID uint32
+		expected *exchange.OrderRateLimit
+	}{
+		{
+			name:     "empty state",
+			marketID: 1,
+			expected: nil,
+		},
+		{
+			name: "unknown market id",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, &exchange.OrderRateLimit{MaxOrders: 5, WindowBlocks: 1})
+				setter(store, 3, &exchange.OrderRateLimit{MaxOrders: 5, WindowBlocks: 1})
+			},
+			marketID: 2,
+			expected: nil,
+		},
+		{
+			name: "set to nil",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, &exchange.OrderRateLimit{MaxOrders: 5, WindowBlocks: 1})
+				setter(store, 2, nil)
+				setter(store, 3, &exchange.OrderRateLimit{MaxOrders: 5, WindowBlocks: 1})
+			},
+			marketID: 2,
+			expected: nil,
+		},
+		{
+			name: "set to zero max orders",
+			setup: func() {
+				setter(s.getStore(), 2, &exchange.OrderRateLimit{MaxOrders: 0, WindowBlocks: 8})
+			},
+			marketID: 2,
+			expected: nil,
+		},
+		{
+			name: "has a limit",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, &exchange.OrderRateLimit{MaxOrders: 1, WindowBlocks: 1})
+				setter(store, 2, &exchange.OrderRateLimit{MaxOrders: 16_909_060, WindowBlocks: 100_000})
+				setter(store, 3, &exchange.OrderRateLimit{MaxOrders: 3, WindowBlocks: 3})
+			},
+			marketID: 2,
+			expected: &exchange.OrderRateLimit{MaxOrders: 16_909_060, WindowBlocks: 100_000},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var actual *exchange.OrderRateLimit
+			testFunc := func() {
+				actual = s.k.GetOrderRateLimit(s.ctx, tc.marketID)
+			}
+			s.Require().NotPanics(testFunc, "GetOrderRateLimit(%d)", tc.marketID)
+			s.Assert().Equal(tc.expected, actual, "GetOrderRateLimit(%d) result", tc.marketID)
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_UpdateOrderRateLimit() {
+	setter := keeper.SetOrderRateLimit
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+
+	tests := []struct {
+		name      string
+		setup     func()
+		marketID  uint32
+		limit     *exchange.OrderRateLimit
+		updatedBy string
+		expErr    string
+		expKept   [][]byte
+		expGone   [][]byte
+	}{
+		{
+			name:      "empty state to nil",
+			marketID:  1,
+			limit:     nil,
+			updatedBy: "updatedBy___________",
+			expErr:    "market 1 does not have an order rate limit",
+		},
+		{
+			name:      "empty state to a limit",
+			marketID:  1,
+			limit:     &exchange.OrderRateLimit{MaxOrders: 10, WindowBlocks: 2},
+			updatedBy: "updatedBy___________",
+		},
+		{
+			name: "same limit",
+			setup: func() {
+				setter(s.getStore(), 3, &exchange.OrderRateLimit{MaxOrders: 10, WindowBlocks: 2})
+			},
+			marketID:  3,
+			limit:     &exchange.OrderRateLimit{MaxOrders: 10, WindowBlocks: 2},
+			updatedBy: "updatedBy___________",
+			expErr:    "market 3 already has an order rate limit of 10 orders per 2 blocks",
+		},
+		{
+			name: "different limit: counts are reset",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 3, &exchange.OrderRateLimit{MaxOrders: 10, WindowBlocks: 2})
+				setter(store, 4, &exchange.OrderRateLimit{MaxOrders: 10, WindowBlocks: 2})
+				store.Set(keeper.MakeKeyOrderCreationCount(3, addr1, 5), []byte{0, 0, 0, 2})
+				store.Set(keeper.MakeKeyOrderCreationCount(3, addr2, 6), []byte{0, 0, 0, 1})
+				store.Set(keeper.MakeKeyOrderCreationCount(4, addr1, 6), []byte{0, 0, 0, 3})
+			},
+			marketID:  3,
+			limit:     &exchange.OrderRateLimit{MaxOrders: 10, WindowBlocks: 3},
+			updatedBy: "updated_by__________",
+			expKept:   [][]byte{keeper.MakeKeyOrderCreationCount(4, addr1, 6)},
+			expGone: [][]byte{
+				keeper.MakeKeyOrderCreationCount(3, addr1, 5),
+				keeper.MakeKeyOrderCreationCount(3, addr2, 6),
+			},
+		},
+		{
+			name: "removing the limit",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 3, &exchange.OrderRateLimit{MaxOrders: 1, WindowBlocks: 1})
+				store.Set(keeper.MakeKeyOrderCreationCount(3, addr1, 5), []byte{0, 0, 0, 1})
+			},
+			marketID:  3,
+			limit:     nil,
+			updatedBy: "updated___by________",
+			expGone:   [][]byte{keeper.MakeKeyOrderCreationCount(3, addr1, 5)},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var expEvents sdk.Events
+			if len(tc.expErr) == 0 {
+				event := exchange.NewEventMarketOrderRateLimitUpdated(tc.marketID, tc.updatedBy)
+				expEvents = append(expEvents, s.untypeEvent(event))
+			}
+
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			var err error
+			testFunc := func() {
+				err = s.k.UpdateOrderRateLimit(ctx, tc.marketID, tc.limit, tc.updatedBy)
+			}
+			s.Require().NotPanics(testFunc, "UpdateOrderRateLimit(%d, %v, %s)", tc.marketID, tc.limit, tc.updatedBy)
+			s.assertErrorValue(err, tc.expErr, "UpdateOrderRateLimit(%d, %v, %s)", tc.marketID, tc.limit, tc.updatedBy)
+
+			events := em.Events()
+			s.assertEqualEvents(expEvents, events, "events after UpdateOrderRateLimit")
+
+			if len(tc.expErr) == 0 {
+				actual := s.k.GetOrderRateLimit(s.ctx, tc.marketID)
+				s.Assert().Equal(tc.limit, actual, "GetOrderRateLimit(%d) after UpdateOrderRateLimit", tc.marketID)
+			}
+			store := s.getStore()
+			for _, key := range tc.expKept {
+				s.Assert().True(store.Has(key), "store.Has(%x): should have been kept", key)
+			}
+			for _, key := range tc.expGone {
+				s.Assert().False(store.Has(key), "store.Has(%x): should have been deleted", key)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_GetMaxExposure() {
+	setter := keeper.SetMaxExposure
+	tests := []struct {
+		name     string
+		setup    func()
+		marketID uint32
+		expected *sdk.Coin
+	}{
+		{
+			name:     "empty state",
+			marketID: 1,
+			expected: nil,
+		},
+		{
+			name: "unknown market id",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, s.coinP("100cherry"))
+				setter(store, 3, s.coinP("300cherry"))
+			},
+			marketID: 2,
+			expected: nil,
+		},
+		{
+			name: "set to nil",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, s.coinP("100cherry"))
+				setter(store, 2, nil)
+				setter(store, 3, s.coinP("300cherry"))
+			},
+			marketID: 2,
+			expected: nil,
+		},
+		{
+			name: "set to zero",
+			setup: func() {
+				setter(s.getStore(), 2, s.coinP("0cherry"))
+			},
+			marketID: 2,
+			expected: nil,
+		},
+		{
+			name: "invalid value in store",
+			setup: func() {
+				s.getStore().Set(keeper.MakeKeyMarketMaxExposure(2), []byte("notacoin"))
+			},
+			marketID: 2,
+			expected: nil,
+		},
+		{
+			name: "has a max exposure",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, s.coinP("100cherry"))
+				setter(store, 2, s.coinP("16909060cherry"))
+				setter(store, 3, s.coinP("300cherry"))
+			},
+			marketID: 2,
+			expected: s.coinP("16909060cherry"),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var actual *sdk.Coin
+			testFunc := func() {
+				actual = s.k.GetMaxExposure(s.ctx, tc.marketID)
+			}
+			s.Require().NotPanics(testFunc, "GetMaxExposure(%d)", tc.marketID)
+			s.Assert().Equal(tc.expected, actual, "GetMaxExposure(%d) result", tc.marketID)
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_UpdateMaxExposure() {
+	setter := keeper.SetMaxExposure
+
+	tests := []struct {
+		name        string
+		setup       func()
+		marketID    uint32
+		maxExposure *sdk.Coin
+		updatedBy   string
+		expErr      string
+	}{
+		{
+			name:        "empty state to nil",
+			marketID:    1,
+			maxExposure: nil,
+			updatedBy:   "updatedBy___________",
+			expErr:      "market 1 does not have a max exposure",
+		},
+		{
+			name:        "empty state to a max exposure",
+			marketID:    1,
+			maxExposure: s.coinP("500cherry"),
+			updatedBy:   "updatedBy___________",
+		},
+		{
+			name: "same max exposure",
+			setup: func() {
+				setter(s.getStore(), 3, s.coinP("500cherry"))
+			},
+			marketID:    3,
+			maxExposure: s.coinP("500cherry"),
+			updatedBy:   "updatedBy___________",
+			expErr:      "market 3 already has a max exposure of 500cherry",
+		},
+		{
+			name: "different amount",
+			setup: func() {
+				setter(s.getStore(), 3, s.coinP("500cherry"))
+			},
+			marketID:    3,
+			maxExposure: s.coinP("501cherry"),
+			updatedBy:   "updated_by__________",
+		},
+		{
+			name: "different denom",
+			setup: func() {
+				setter(s.getStore(), 3, s.coinP("500cherry"))
+			},
+			marketID:    3,
+			maxExposure: s.coinP("500plum"),
+			updatedBy:   "updated_by__________",
+		},
+		{
+			name: "removing the max exposure",
+			setup: func() {
+				setter(s.getStore(), 3, s.coinP("500cherry"))
+			},
+			marketID:    3,
+			maxExposure: nil,
+			updatedBy:   "updated___by________",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var expEvents sdk.Events
+			if len(tc.expErr) == 0 {
+				event := exchange.NewEventMarketMaxExposureUpdated(tc.marketID, tc.updatedBy)
+				expEvents = append(expEvents, s.untypeEvent(event))
+			}
+
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			var err error
+			testFunc := func() {
+				err = s.k.UpdateMaxExposure(ctx, tc.marketID, tc.maxExposure, tc.updatedBy)
+			}
+			s.Require().NotPanics(testFunc, "UpdateMaxExposure(%d, %v, %s)", tc.marketID, tc.maxExposure, tc.updatedBy)
+			s.assertErrorValue(err, tc.expErr, "UpdateMaxExposure(%d, %v, %s)", tc.marketID, tc.maxExposure, tc.updatedBy)
+
+			events := em.Events()
+			s.assertEqualEvents(expEvents, events, "events after UpdateMaxExposure")
+
+			if len(tc.expErr) == 0 {
+				actual := s.k.GetMaxExposure(s.ctx, tc.marketID)
+				s.Assert().Equal(tc.maxExposure, actual, "GetMaxExposure(%d) after UpdateMaxExposure", tc.marketID)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_GetFillAlgorithm() {
+	setter := keeper.SetFillAlgorithm
+	tests := []struct {
+		name     string
+		setup    func()
+		marketID uint32
+		expected exchange.FillAlgorithm
+	}{
+		{
+			name:     "empty state",
+			marketID: 1,
+			expected: exchange.FillAlgorithm_unspecified,
+		},
+		{
+			name: "unknown market id",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, exchange.FillAlgorithm_pro_rata)
+				setter(store, 3, exchange.FillAlgorithm_price_time)
+			},
+			marketID: 2,
+			expected: exchange.FillAlgorithm_unspecified,
+		},
+		{
+			name: "set to unspecified",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, exchange.FillAlgorithm_pro_rata)
+				setter(store, 2, exchange.FillAlgorithm_unspecified)
+				setter(store, 3, exchange.FillAlgorithm_pro_rata)
+			},
+			marketID: 2,
+			expected: exchange.FillAlgorithm_unspecified,
+		},
+		{
+			name: "price time",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, exchange.FillAlgorithm_pro_rata)
+				setter(store, 2, exchange.FillAlgorithm_price_time)
+				setter(store, 3, exchange.FillAlgorithm_pro_rata)
+			},
+			marketID: 2,
+			expected: exchange.FillAlgorithm_price_time,
+		},
+		{
+			name: "pro rata",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, exchange.FillAlgorithm_price_time)
+				setter(store, 2, exchange.FillAlgorithm_pro_rata)
+				setter(store, 3, exchange.FillAlgorithm_price_time)
+			},
+			marketID: 2,
+			expected: exchange.FillAlgorithm_pro_rata,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var actual exchange.FillAlgorithm
+			testFunc := func() {
+				actual = s.k.GetFillAlgorithm(s.ctx, tc.marketID)
+			}
+			s.Require().NotPanics(testFunc, "GetFillAlgorithm(%d)", tc.marketID)
+			s.Assert().Equal(tc.expected.String(), actual.String(), "GetFillAlgorithm(%d) result", tc.marketID)
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_UpdateFillAlgorithm() {
+	setter := keeper.SetFillAlgorithm
+
+	tests := []struct {
+		name      string
+		setup     func()
+		marketID  uint32
+		algorithm exchange.FillAlgorithm
+		updatedBy string
+		expErr    string
+	}{
+		{
+			name:      "empty state to pro rata",
+			marketID:  1,
+			algorithm: exchange.FillAlgorithm_pro_rata,
+			updatedBy: "updatedBy___________",
+			expErr:    "",
+		},
+		{
+			name:      "empty state to unspecified",
+			marketID:  1,
+			algorithm: exchange.FillAlgorithm_unspecified,
+			updatedBy: "updatedBy___________",
+			expErr:    "market 1 already has fill-algorithm unspecified",
+		},
+		{
+			name: "pro rata to pro rata",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 2, exchange.FillAlgorithm_price_time)
+				setter(store, 3, exchange.FillAlgorithm_pro_rata)
+			},
+			marketID:  3,
+			algorithm: exchange.FillAlgorithm_pro_rata,
+			updatedBy: "updatedBy___________",
+			expErr:    "market 3 already has fill-algorithm pro_rata",
+		},
+		{
+			name: "pro rata to price time",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 2, exchange.FillAlgorithm_pro_rata)
+				setter(store, 3, exchange.FillAlgorithm_pro_rata)
+				setter(store, 4, exchange.FillAlgorithm_pro_rata)
+			},
+			marketID:  3,
+			algorithm: exchange.FillAlgorithm_price_time,
+			updatedBy: "updated_by__________",
+			expErr:    "",
+		},
+		{
+			name: "price time to unspecified",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 3, exchange.FillAlgorithm_price_time)
+			},
+			marketID:  3,
+			algorithm: exchange.FillAlgorithm_unspecified,
+			updatedBy: "updated___by________",
+			expErr:    "",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var expEvents sdk.Events
+			if len(tc.expErr) == 0 {
+				event := exchange.NewEventMarketFillAlgorithmUpdated(tc.marketID, tc.updatedBy)
+				expEvents = append(expEvents, s.untypeEvent(event))
+			}
+
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			var err error
+			testFunc := func() {
+				err = s.k.UpdateFillAlgorithm(ctx, tc.marketID, tc.algorithm, tc.updatedBy)
+			}
+			s.Require().NotPanics(testFunc, "UpdateFillAlgorithm(%d, %s, %s)", tc.marketID, tc.algorithm, tc.updatedBy)
+			s.assertErrorValue(err, tc.expErr, "UpdateFillAlgorithm(%d, %s, %s)", tc.marketID, tc.algorithm, tc.updatedBy)
+
+			events := em.Events()
+			s.assertEqualEvents(expEvents, events, "events after UpdateFillAlgorithm")
+
+			if len(tc.expErr) == 0 {
+				actual := s.k.GetFillAlgorithm(s.ctx, tc.marketID)
+				s.Assert().Equal(tc.algorithm.String(), actual.String(), "GetFillAlgorithm(%d) after UpdateFillAlgorithm(%d, %s, ...)",
+					tc.marketID, tc.marketID, tc.algorithm)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_GetMarketExchangeSplits() {
+	setter := keeper.SetExchangeSplits
+	tests := []struct {
+		name     string
+		setup    func()
+		marketID uint32
+		expected []exchange.DenomSplit
+	}{
+		{
+			name:     "empty state",
+			marketID: 1,
+			expected: nil,
+		},
+		{
+			name: "unknown market id",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, []exchange.DenomSplit{{Denom: "apple", Split: 100}})
+				setter(store, 3, []exchange.DenomSplit{{Denom: "cherry", Split: 300}})
+			},
+			marketID: 2,
+			expected: nil,
+		},
+		{
+			name: "market with one split",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, []exchange.DenomSplit{{Denom: "apple", Split: 100}})
+				setter(store, 2, []exchange.DenomSplit{{Denom: "banana", Split: 0}})
+				setter(store, 3, []exchange.DenomSplit{{Denom: "cherry", Split: 300}})
+			},
+			marketID: 2,
+			expected: []exchange.DenomSplit{{Denom: "banana", Split: 0}},
+		},
+		{
+			name: "market with three splits",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, []exchange.DenomSplit{{Denom: "apple", Split: 100}})
+				setter(store, 2, []exchange.DenomSplit{
+					{Denom: "cherry", Split: 3},
+					{Denom: "apple", Split: 10_000},
+					{Denom: "banana", Split: 250},
+				})
+				setter(store, 3, []exchange.DenomSplit{{Denom: "cherry", Split: 300}})
+			},
+			marketID: 2,
+			expected: []exchange.DenomSplit{
+				{Denom: "apple", Split: 10_000},
+				{Denom: "banana", Split: 250},
+				{Denom: "cherry", Split: 3},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var actual []exchange.DenomSplit
+			testFunc := func() {
+				actual = s.k.GetMarketExchangeSplits(s.ctx, tc.marketID)
+			}
+			s.Require().NotPanics(testFunc, "GetMarketExchangeSplits(%d)", tc.marketID)
+			s.Assert().Equal(tc.expected, actual, "GetMarketExchangeSplits(%d) result", tc.marketID)
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_UpdateExchangeSplits() {
+	setter := keeper.SetExchangeSplits
+	authority := s.k.GetAuthority()
+
+	tests := []struct {
+		name     string
+		setup    func()
+		msg      *exchange.MsgGovManageExchangeSplitsRequest
+		expErr   string
+		expSplit []exchange.DenomSplit
+	}{
+		{
+			name: "market does not exist",
+			msg: &exchange.MsgGovManageExchangeSplitsRequest{
+				Authority:         authority,
+				MarketId:          1,
+				SetExchangeSplits: []exchange.DenomSplit{{Denom: "apple", Split: 100}},
+			},
+			expErr: "market 1 does not exist",
+		},
+		{
+			name: "removing denoms without a split",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetMarketKnown(store, 2)
+				setter(store, 2, []exchange.DenomSplit{{Denom: "banana", Split: 250}})
+			},
+			msg: &exchange.MsgGovManageExchangeSplitsRequest{
+				Authority:            authority,
+				MarketId:             2,
+				SetExchangeSplits:    []exchange.DenomSplit{{Denom: "apple", Split: 100}},
+				RemoveExchangeSplits: []string{"cherry", "banana", "durian"},
+			},
+			expErr: s.joinErrs(
+				"cannot remove exchange split for \"cherry\": market 2 does not have one",
+				"cannot remove exchange split for \"durian\": market 2 does not have one",
+			),
+		},
+		{
+			name: "new market split",
+			setup: func() {
+				keeper.SetMarketKnown(s.getStore(), 2)
+			},
+			msg: &exchange.MsgGovManageExchangeSplitsRequest{
+				Authority:         authority,
+				MarketId:          2,
+				SetExchangeSplits: []exchange.DenomSplit{{Denom: "apple", Split: 100}},
+			},
+			expSplit: []exchange.DenomSplit{{Denom: "apple", Split: 100}},
+		},
+		{
+			name: "set, change, and remove splits",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetMarketKnown(store, 1)
+				keeper.SetMarketKnown(store, 2)
+				setter(store, 1, []exchange.DenomSplit{{Denom: "apple", Split: 1}})
+				setter(store, 2, []exchange.DenomSplit{
+					{Denom: "apple", Split: 100},
+					{Denom: "banana", Split: 250},
+					{Denom: "cherry", Split: 3},
+				})
+			},
+			msg: &exchange.MsgGovManageExchangeSplitsRequest{
+				Authority: authority,
+				MarketId:  2,
+				SetExchangeSplits: []exchange.DenomSplit{
+					{Denom: "durian", Split: 0},
+					{Denom: "banana", Split: 5000},
+				},
+				RemoveExchangeSplits: []string{"apple"},
+			},
+			expSplit: []exchange.DenomSplit{
+				{Denom: "banana", Split: 5000},
+				{Denom: "cherry", Split: 3},
+				{Denom: "durian", Split: 0},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var expEvents sdk.Events
+			if len(tc.expErr) == 0 {
+				event := exchange.NewEventMarketExchangeSplitsUpdated(tc.msg.MarketId)
+				expEvents = append(expEvents, s.untypeEvent(event))
+			}
+
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			var err error
+			testFunc := func() {
+				err = s.k.UpdateExchangeSplits(ctx, tc.msg)
+			}
+			s.Require().NotPanics(testFunc, "UpdateExchangeSplits")
+			s.assertErrorValue(err, tc.expErr, "UpdateExchangeSplits")
+
+			events := em.Events()
+			s.assertEqualEvents(expEvents, events, "events after UpdateExchangeSplits")
+
+			if len(tc.expErr) == 0 {
+				actual := s.k.GetMarketExchangeSplits(s.ctx, tc.msg.MarketId)
+				s.Assert().Equal(tc.expSplit, actual, "GetMarketExchangeSplits(%d) after UpdateExchangeSplits", tc.msg.MarketId)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_HasPermission() {
+	goodAcc := sdk.AccAddress("goodAddr____________")
+	goodAddr := goodAcc.String()
+	authority := s.k.GetAuthority()
+	timeP := func(offset time.Duration) *time.Time {
+		rv := s.ctx.BlockTime().Add(offset)
+		return &rv
+	}
+	tests := []struct {
+		name       string
+		setup      func()
+		marketID   uint32
+		address    string
 		permission exchange.Permission
 		expected   bool
 	}{
@@ -4632,6 +6660,55 @@ func (s *TestSuite) TestKeeper_HasPermission() {
 			permission: exchange.Permission_permissions,
 			expected:   true,
 		},
+		{
+			name: "address has perm with future expiration",
+			setup: func() {
+				store := s.getStore()
+				keeper.GrantPermissions(store, 2, goodAcc, exchange.AllPermissions())
+				keeper.SetAccessGrantExpiration(store, 2, goodAcc, timeP(time.Second))
+			},
+			marketID:   2,
+			address:    goodAddr,
+			permission: exchange.Permission_cancel,
+			expected:   true,
+		},
+		{
+			name: "address has perm with expiration equal to block time",
+			setup: func() {
+				store := s.getStore()
+				keeper.GrantPermissions(store, 2, goodAcc, exchange.AllPermissions())
+				keeper.SetAccessGrantExpiration(store, 2, goodAcc, timeP(0))
+			},
+			marketID:   2,
+			address:    goodAddr,
+			permission: exchange.Permission_cancel,
+			expected:   false,
+		},
+		{
+			name: "address has perm with past expiration",
+			setup: func() {
+				store := s.getStore()
+				keeper.GrantPermissions(store, 2, goodAcc, exchange.AllPermissions())
+				keeper.SetAccessGrantExpiration(store, 2, goodAcc, timeP(-1*time.Hour))
+			},
+			marketID:   2,
+			address:    goodAddr,
+			permission: exchange.Permission_cancel,
+			expected:   false,
+		},
+		{
+			name: "address has perm with past expiration in other market",
+			setup: func() {
+				store := s.getStore()
+				keeper.GrantPermissions(store, 1, goodAcc, exchange.AllPermissions())
+				keeper.SetAccessGrantExpiration(store, 1, goodAcc, timeP(-1*time.Hour))
+				keeper.GrantPermissions(store, 2, goodAcc, exchange.AllPermissions())
+			},
+			marketID:   2,
+			address:    goodAddr,
+			permission: exchange.Permission_cancel,
+			expected:   true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -4971,6 +7048,7 @@ func (s *TestSuite) TestKeeper_GetAccessGrants() {
 	addrAll := sdk.AccAddress("address_all_________")
 	addrEven := sdk.AccAddress("address_even________")
 	addrOdd := sdk.AccAddress("address_odd_________")
+	expiration := time.Date(2030, 4, 5, 6, 7, 8, 0, time.UTC)
 
 	onePerm := []exchange.Permission{exchange.Permission_settle}
 	oneOtherPerm := []exchange.Permission{exchange.Permission_set_ids}
@@ -5037,6 +7115,17 @@ func (s *TestSuite) TestKeeper_GetAccessGrants() {
 				{Address: addrOne.String(), Permissions: oneOtherPerm},
 			},
 		},
+		{
+			name: "market with an expiring grant",
+			setup: func() {
+				defaultSetup()
+				keeper.SetAccessGrantExpiration(s.getStore(), 2, addrOne, &expiration)
+			},
+			marketID: 2,
+			expected: []exchange.AccessGrant{
+				{Address: addrOne.String(), Permissions: oneOtherPerm, Expiration: &expiration},
+			},
+		},
 		{
 			name:     "market with several permissions",
 			setup:    defaultSetup,
@@ -5074,6 +7163,10 @@ func (s *TestSuite) TestKeeper_UpdatePermissions() {
 	oneAddr := oneAcc.String()
 	twoAcc := sdk.AccAddress("addr_two____________")
 	twoAddr := twoAcc.String()
+	timeP := func(offset time.Duration) *time.Time {
+		rv := s.ctx.BlockTime().Add(offset).UTC()
+		return &rv
+	}
 
 	tests := []struct {
 		name      string
@@ -5282,6 +7375,86 @@ func (s *TestSuite) TestKeeper_UpdatePermissions() {
 				{Address: twoAddr, Permissions: []exchange.Permission{1, 2, 4}},
 			},
 		},
+		{
+			name: "to-grant expiration not after block time",
+			setup: func() {
+				keeper.GrantPermissions(s.getStore(), 6, oneAcc, []exchange.Permission{3})
+			},
+			msg: &exchange.MsgMarketManagePermissionsRequest{
+				Admin:    adminAddr,
+				MarketId: 6,
+				ToGrant: []exchange.AccessGrant{
+					{Address: twoAddr, Permissions: []exchange.Permission{1}, Expiration: timeP(0)},
+				},
+			},
+			expErr: "account " + twoAddr + " access grant for market 6: invalid expiration " +
+				s.ctx.BlockTime().UTC().Format(time.RFC3339) + ": must be after the current block time " +
+				s.ctx.BlockTime().UTC().Format(time.RFC3339),
+		},
+		{
+			name: "to-grant with expiration",
+			setup: func() {
+				keeper.GrantPermissions(s.getStore(), 6, oneAcc, []exchange.Permission{3})
+				keeper.GrantPermissions(s.getStore(), 6, twoAcc, []exchange.Permission{4, 2})
+			},
+			msg: &exchange.MsgMarketManagePermissionsRequest{
+				Admin:    adminAddr,
+				MarketId: 6,
+				ToGrant: []exchange.AccessGrant{
+					{Address: twoAddr, Permissions: []exchange.Permission{1}, Expiration: timeP(time.Hour)},
+				},
+			},
+			expGrants: []exchange.AccessGrant{
+				{Address: oneAddr, Permissions: []exchange.Permission{3}},
+				{Address: twoAddr, Permissions: []exchange.Permission{1, 2, 4}, Expiration: timeP(time.Hour)},
+			},
+		},
+		{
+			name: "to-grant without expiration removes existing one",
+			setup: func() {
+				keeper.GrantPermissions(s.getStore(), 6, twoAcc, []exchange.Permission{4, 2})
+				keeper.SetAccessGrantExpiration(s.getStore(), 6, twoAcc, timeP(time.Hour))
+			},
+			msg: &exchange.MsgMarketManagePermissionsRequest{
+				Admin:    adminAddr,
+				MarketId: 6,
+				ToGrant:  []exchange.AccessGrant{{Address: twoAddr, Permissions: []exchange.Permission{1}}},
+			},
+			expGrants: []exchange.AccessGrant{
+				{Address: twoAddr, Permissions: []exchange.Permission{1, 2, 4}},
+			},
+		},
+		{
+			name: "to-revoke some of expiring grant",
+			setup: func() {
+				keeper.GrantPermissions(s.getStore(), 6, twoAcc, []exchange.Permission{4, 2})
+				keeper.SetAccessGrantExpiration(s.getStore(), 6, twoAcc, timeP(time.Hour))
+			},
+			msg: &exchange.MsgMarketManagePermissionsRequest{
+				Admin:    adminAddr,
+				MarketId: 6,
+				ToRevoke: []exchange.AccessGrant{{Address: twoAddr, Permissions: []exchange.Permission{2}}},
+			},
+			expGrants: []exchange.AccessGrant{
+				{Address: twoAddr, Permissions: []exchange.Permission{4}, Expiration: timeP(time.Hour)},
+			},
+		},
+		{
+			name: "revoke all then grant without expiration",
+			setup: func() {
+				keeper.GrantPermissions(s.getStore(), 6, twoAcc, []exchange.Permission{4, 2})
+				keeper.SetAccessGrantExpiration(s.getStore(), 6, twoAcc, timeP(time.Hour))
+			},
+			msg: &exchange.MsgMarketManagePermissionsRequest{
+				Admin:     adminAddr,
+				MarketId:  6,
+				RevokeAll: []string{twoAddr},
+				ToGrant:   []exchange.AccessGrant{{Address: twoAddr, Permissions: []exchange.Permission{1}}},
+			},
+			expGrants: []exchange.AccessGrant{
+				{Address: twoAddr, Permissions: []exchange.Permission{1}},
+			},
+		},
 		{
 			name: "revoke all grant one",
 			setup: func() {
@@ -5392,6 +7565,165 @@ func (s *TestSuite) TestKeeper_UpdatePermissions() {
 	}
 }
 
+func (s *TestSuite) TestKeeper_ExpireAccessGrants() {
+	blockTime := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	timeP := func(offset time.Duration) *time.Time {
+		rv := blockTime.Add(offset)
+		return &rv
+	}
+	type grant struct {
+		marketID   uint32
+		addr       sdk.AccAddress
+		perms      []exchange.Permission
+		expiration *time.Time
+	}
+
+	tests := []struct {
+		name         string
+		grants       []grant
+		setup        func()
+		limit        int
+		expCount     int
+		expExpired   []grant
+		expRemaining []grant
+		expDelKeys   [][]byte
+	}{
+		{
+			name:     "no grants",
+			limit:    10,
+			expCount: 0,
+		},
+		{
+			name: "nothing expired yet",
+			grants: []grant{
+				{marketID: 1, addr: s.addr1, perms: []exchange.Permission{1}, expiration: timeP(time.Second)},
+				{marketID: 2, addr: s.addr2, perms: []exchange.Permission{2, 3}},
+			},
+			limit:    10,
+			expCount: 0,
+			expRemaining: []grant{
+				{marketID: 1, addr: s.addr1, perms: []exchange.Permission{1}, expiration: timeP(time.Second)},
+				{marketID: 2, addr: s.addr2, perms: []exchange.Permission{2, 3}},
+			},
+		},
+		{
+			name: "some expired",
+			grants: []grant{
+				{marketID: 1, addr: s.addr1, perms: []exchange.Permission{1}, expiration: timeP(-1 * time.Hour)},
+				{marketID: 1, addr: s.addr2, perms: []exchange.Permission{2}, expiration: timeP(time.Hour)},
+				{marketID: 2, addr: s.addr1, perms: []exchange.Permission{3}},
+				{marketID: 2, addr: s.addr3, perms: []exchange.Permission{4, 5}, expiration: timeP(0)},
+			},
+			limit:    10,
+			expCount: 2,
+			expExpired: []grant{
+				{marketID: 1, addr: s.addr1, perms: []exchange.Permission{1}, expiration: timeP(-1 * time.Hour)},
+				{marketID: 2, addr: s.addr3, perms: []exchange.Permission{4, 5}, expiration: timeP(0)},
+			},
+			expRemaining: []grant{
+				{marketID: 1, addr: s.addr2, perms: []exchange.Permission{2}, expiration: timeP(time.Hour)},
+				{marketID: 2, addr: s.addr1, perms: []exchange.Permission{3}},
+			},
+		},
+		{
+			name: "more expired than the limit",
+			grants: []grant{
+				{marketID: 1, addr: s.addr1, perms: []exchange.Permission{1}, expiration: timeP(-1 * time.Minute)},
+				{marketID: 1, addr: s.addr2, perms: []exchange.Permission{2}, expiration: timeP(-3 * time.Minute)},
+				{marketID: 1, addr: s.addr3, perms: []exchange.Permission{3}, expiration: timeP(-2 * time.Minute)},
+			},
+			limit:    2,
+			expCount: 2,
+			expExpired: []grant{
+				{marketID: 1, addr: s.addr2, perms: []exchange.Permission{2}, expiration: timeP(-3 * time.Minute)},
+				{marketID: 1, addr: s.addr3, perms: []exchange.Permission{3}, expiration: timeP(-2 * time.Minute)},
+			},
+			expRemaining: []grant{
+				{marketID: 1, addr: s.addr1, perms: []exchange.Permission{1}, expiration: timeP(-1 * time.Minute)},
+			},
+		},
+		{
+			name: "no limit",
+			grants: []grant{
+				{marketID: 1, addr: s.addr1, perms: []exchange.Permission{1}, expiration: timeP(-1 * time.Minute)},
+				{marketID: 1, addr: s.addr2, perms: []exchange.Permission{2}, expiration: timeP(-3 * time.Minute)},
+			},
+			limit:    0,
+			expCount: 2,
+			expExpired: []grant{
+				{marketID: 1, addr: s.addr2, perms: []exchange.Permission{2}, expiration: timeP(-3 * time.Minute)},
+				{marketID: 1, addr: s.addr1, perms: []exchange.Permission{1}, expiration: timeP(-1 * time.Minute)},
+			},
+		},
+		{
+			name: "invalid index entry",
+			grants: []grant{
+				{marketID: 1, addr: s.addr1, perms: []exchange.Permission{1}, expiration: timeP(-1 * time.Minute)},
+			},
+			setup: func() {
+				key := keeper.MakeIndexKeyExpirationToAccessGrant(*timeP(-2 * time.Minute), 7, s.addr2)
+				s.getStore().Set(append(key, 'x'), []byte{})
+			},
+			limit:    10,
+			expCount: 1,
+			expExpired: []grant{
+				{marketID: 1, addr: s.addr1, perms: []exchange.Permission{1}, expiration: timeP(-1 * time.Minute)},
+			},
+			expDelKeys: [][]byte{append(keeper.MakeIndexKeyExpirationToAccessGrant(*timeP(-2 * time.Minute), 7, s.addr2), 'x')},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			store := s.getStore()
+			for _, g := range tc.grants {
+				keeper.GrantPermissions(store, g.marketID, g.addr, g.perms)
+				keeper.SetAccessGrantExpiration(store, g.marketID, g.addr, g.expiration)
+			}
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var expEvents sdk.Events
+			expDelKeys := tc.expDelKeys
+			for _, g := range tc.expExpired {
+				event := exchange.NewEventMarketAccessGrantExpired(g.marketID, g.addr)
+				expEvents = append(expEvents, s.untypeEvent(event))
+				expDelKeys = append(expDelKeys,
+					keeper.MakeKeyMarketPermissionsExpiration(g.marketID, g.addr),
+					keeper.MakeIndexKeyExpirationToAccessGrant(*g.expiration, g.marketID, g.addr),
+				)
+			}
+
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em).WithBlockTime(blockTime)
+			var count int
+			testFunc := func() {
+				count = s.k.ExpireAccessGrants(ctx, tc.limit)
+			}
+			s.Require().NotPanics(testFunc, "ExpireAccessGrants(%d)", tc.limit)
+			s.Assert().Equal(tc.expCount, count, "ExpireAccessGrants(%d) result", tc.limit)
+			actEvents := em.Events()
+			s.assertEqualEvents(expEvents, actEvents, "ExpireAccessGrants(%d) events", tc.limit)
+
+			for _, g := range tc.expExpired {
+				actPerms := s.k.GetUserPermissions(s.ctx, g.marketID, g.addr)
+				s.Assert().Empty(actPerms, "GetUserPermissions(%d, %s) (expired)", g.marketID, s.getAddrName(g.addr))
+			}
+			for i, key := range expDelKeys {
+				s.Assert().False(store.Has(key), "[%d]: store.Has(%v) after expire", i, key)
+			}
+			for _, g := range tc.expRemaining {
+				actPerms := s.k.GetUserPermissions(s.ctx, g.marketID, g.addr)
+				s.Assert().Equal(g.perms, actPerms, "GetUserPermissions(%d, %s) (remaining)", g.marketID, s.getAddrName(g.addr))
+				actExp := s.k.GetAccessGrantExpiration(s.ctx, g.marketID, g.addr)
+				s.Assert().Equal(g.expiration, actExp, "GetAccessGrantExpiration(%d, %s) (remaining)", g.marketID, s.getAddrName(g.addr))
+			}
+		})
+	}
+}
+
 func (s *TestSuite) TestKeeper_GetReqAttrsAsk() {
 	setter := keeper.SetReqAttrsAsk
 	tests := []struct {
@@ -5746,6 +8078,66 @@ func (s *TestSuite) TestKeeper_CanCreateAsk() {
 			expected:       false,
 			expGetAttrCall: true,
 		},
+		{
+			name: "one req attr with options, acc has first",
+			setup: func() {
+				setter(s.getStore(), 42, []string{"kyc.a.com || kyc.b.com"})
+			},
+			attrKeeper: NewMockAttributeKeeper().
+				WithGetAllAttributesAddrResult(addr2, []string{"yy.zz", "kyc.a.com"}, ""),
+			marketID:       42,
+			addr:           addr2,
+			expected:       true,
+			expGetAttrCall: true,
+		},
+		{
+			name: "one req attr with options, acc has second",
+			setup: func() {
+				setter(s.getStore(), 42, []string{"kyc.a.com || *.kyc.b.com"})
+			},
+			attrKeeper: NewMockAttributeKeeper().
+				WithGetAllAttributesAddrResult(addr2, []string{"yy.zz", "buyer.kyc.b.com"}, ""),
+			marketID:       42,
+			addr:           addr2,
+			expected:       true,
+			expGetAttrCall: true,
+		},
+		{
+			name: "one req attr with options, acc has neither",
+			setup: func() {
+				setter(s.getStore(), 42, []string{"kyc.a.com || kyc.b.com"})
+			},
+			attrKeeper: NewMockAttributeKeeper().
+				WithGetAllAttributesAddrResult(addr2, []string{"yy.zz", "kyc.c.com", "a.kyc.b.com"}, ""),
+			marketID:       42,
+			addr:           addr2,
+			expected:       false,
+			expGetAttrCall: true,
+		},
+		{
+			name: "req attr with options and another, acc has just an option",
+			setup: func() {
+				setter(s.getStore(), 42, []string{"kyc.a.com || kyc.b.com", "accredited"})
+			},
+			attrKeeper: NewMockAttributeKeeper().
+				WithGetAllAttributesAddrResult(addr2, []string{"kyc.b.com"}, ""),
+			marketID:       42,
+			addr:           addr2,
+			expected:       false,
+			expGetAttrCall: true,
+		},
+		{
+			name: "req attr with options and another, acc has both",
+			setup: func() {
+				setter(s.getStore(), 42, []string{"kyc.a.com || kyc.b.com", "accredited"})
+			},
+			attrKeeper: NewMockAttributeKeeper().
+				WithGetAllAttributesAddrResult(addr2, []string{"accredited", "kyc.b.com"}, ""),
+			marketID:       42,
+			addr:           addr2,
+			expected:       true,
+			expGetAttrCall: true,
+		},
 		{
 			name: "two req attr, acc has neither",
 			setup: func() {
@@ -6530,7 +8922,41 @@ func (s *TestSuite) TestKeeper_UpdateReqAttrs() {
 				MarketId:       1,
 				CreateAskToAdd: []string{"*.ask.can.create.bananas"},
 			},
-			expAsk: []string{"ask.can.create.bananas", "*.ask.can.create.bananas"},
+			expAsk: []string{"ask.can.create.bananas", "*.ask.can.create.bananas"},
+			expBid: []string{"bid.can.create.bananas"},
+			expCom: []string{"com.can.create.bananas"},
+		},
+		{
+			name: "add create-ask with options",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetReqAttrsAsk(store, 1, []string{"ask.can.create.bananas"})
+				keeper.SetReqAttrsBid(store, 1, []string{"bid.can.create.bananas"})
+				keeper.SetReqAttrsCommitment(store, 1, []string{"com.can.create.bananas"})
+			},
+			msg: &exchange.MsgMarketManageReqAttrsRequest{
+				Admin:          "admin_addr_str",
+				MarketId:       1,
+				CreateAskToAdd: []string{"KYC.a.com||*.kyc.b.com"},
+			},
+			expAsk: []string{"ask.can.create.bananas", "kyc.a.com || *.kyc.b.com"},
+			expBid: []string{"bid.can.create.bananas"},
+			expCom: []string{"com.can.create.bananas"},
+		},
+		{
+			name: "remove create-ask with options",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetReqAttrsAsk(store, 1, []string{"kyc.a.com || *.kyc.b.com", "ask.can.create.bananas"})
+				keeper.SetReqAttrsBid(store, 1, []string{"bid.can.create.bananas"})
+				keeper.SetReqAttrsCommitment(store, 1, []string{"com.can.create.bananas"})
+			},
+			msg: &exchange.MsgMarketManageReqAttrsRequest{
+				Admin:             "admin_addr_str",
+				MarketId:          1,
+				CreateAskToRemove: []string{"kyc.a.com ||*.kyc.b.com"},
+			},
+			expAsk: []string{"ask.can.create.bananas"},
 			expBid: []string{"bid.can.create.bananas"},
 			expCom: []string{"com.can.create.bananas"},
 		},
@@ -6691,156 +9117,431 @@ func (s *TestSuite) TestKeeper_UpdateReqAttrs() {
 
 		// just create-commitment manipulation.
 		{
-			name: "remove one create-commitment from one",
+			name: "remove one create-commitment from one",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetReqAttrsAsk(store, 9, []string{"ask.can.create.bananas"})
+				keeper.SetReqAttrsBid(store, 9, []string{"bid.can.create.bananas"})
+				keeper.SetReqAttrsCommitment(store, 9, []string{"com.can.create.bananas"})
+			},
+			msg: &exchange.MsgMarketManageReqAttrsRequest{
+				Admin:                    "admin_addr_str",
+				MarketId:                 9,
+				CreateCommitmentToRemove: []string{"com.can.create.bananas"},
+			},
+			expAsk: []string{"ask.can.create.bananas"},
+			expBid: []string{"bid.can.create.bananas"},
+			expCom: nil,
+		},
+		{
+			name: "remove one create-commitment from two",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetReqAttrsAsk(store, 9, []string{"ask.can.create.bananas"})
+				keeper.SetReqAttrsBid(store, 9, []string{"bid.can.create.bananas"})
+				keeper.SetReqAttrsCommitment(store, 9, []string{"com.can.create.bananas", "also.com.okay"})
+			},
+			msg: &exchange.MsgMarketManageReqAttrsRequest{
+				Admin:                    "admin_addr_str",
+				MarketId:                 9,
+				CreateCommitmentToRemove: []string{"also.com.okay"},
+			},
+			expAsk: []string{"ask.can.create.bananas"},
+			expBid: []string{"bid.can.create.bananas"},
+			expCom: []string{"com.can.create.bananas"},
+		},
+		{
+			name: "remove one create-commitment with wildcard",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetReqAttrsAsk(store, 9, []string{"ask.can.create.bananas"})
+				keeper.SetReqAttrsBid(store, 9, []string{"bid.can.create.bananas"})
+				keeper.SetReqAttrsCommitment(store, 9, []string{
+					"com.can.create.bananas", "one.com.can.create.bananas",
+					"*.com.can.create.bananas", "two.com.can.create.bananas",
+				})
+			},
+			msg: &exchange.MsgMarketManageReqAttrsRequest{
+				Admin:                    "admin_addr_str",
+				MarketId:                 9,
+				CreateCommitmentToRemove: []string{"*.com.can.create.bananas"},
+			},
+			expAsk: []string{"ask.can.create.bananas"},
+			expBid: []string{"bid.can.create.bananas"},
+			expCom: []string{"com.can.create.bananas", "one.com.can.create.bananas", "two.com.can.create.bananas"},
+		},
+		{
+			name: "remove last two create-commitment",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetReqAttrsAsk(store, 55, []string{"one.ask.can.create.bananas", "two.ask.can.create.bananas"})
+				keeper.SetReqAttrsBid(store, 55, []string{"one.bid.can.create.bananas", "two.bid.can.create.bananas"})
+				keeper.SetReqAttrsCommitment(store, 55, []string{"one.com.can.create.bananas", "two.com.can.create.bananas"})
+			},
+			msg: &exchange.MsgMarketManageReqAttrsRequest{
+				Admin:                    "admin_addr_str",
+				MarketId:                 55,
+				CreateCommitmentToRemove: []string{"two.com.can.create.bananas", "one.com.can.create.bananas"},
+			},
+			expAsk: []string{"one.ask.can.create.bananas", "two.ask.can.create.bananas"},
+			expBid: []string{"one.bid.can.create.bananas", "two.bid.can.create.bananas"},
+			expCom: nil,
+		},
+		{
+			name: "add one create-commitment to empty",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetReqAttrsAsk(store, 1, []string{"ask.can.create.bananas"})
+				keeper.SetReqAttrsBid(store, 1, []string{"bid.can.create.bananas"})
+			},
+			msg: &exchange.MsgMarketManageReqAttrsRequest{
+				Admin:                 "admin_addr_str",
+				MarketId:              1,
+				CreateCommitmentToAdd: []string{"com.can.create.bananas"},
+			},
+			expAsk: []string{"ask.can.create.bananas"},
+			expBid: []string{"bid.can.create.bananas"},
+			expCom: []string{"com.can.create.bananas"},
+		},
+		{
+			name: "add one create-commitment to existing",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetReqAttrsAsk(store, 1, []string{"ask.can.create.bananas"})
+				keeper.SetReqAttrsBid(store, 1, []string{"bid.can.create.bananas"})
+				keeper.SetReqAttrsCommitment(store, 1, []string{"com.can.create.bananas"})
+			},
+			msg: &exchange.MsgMarketManageReqAttrsRequest{
+				Admin:                 "admin_addr_str",
+				MarketId:              1,
+				CreateCommitmentToAdd: []string{"*.com.can.create.bananas"},
+			},
+			expAsk: []string{"ask.can.create.bananas"},
+			expBid: []string{"bid.can.create.bananas"},
+			expCom: []string{"com.can.create.bananas", "*.com.can.create.bananas"},
+		},
+		{
+			name: "remove one, add diff create-commitment",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetReqAttrsAsk(store, 4, []string{"four.ask.can.create.bananas"})
+				keeper.SetReqAttrsBid(store, 4, []string{"four.bid.can.create.bananas"})
+				keeper.SetReqAttrsCommitment(store, 4, []string{"four.com.can.create.bananas"})
+				keeper.SetReqAttrsAsk(store, 5, []string{"five.ask.can.create.bananas"})
+				keeper.SetReqAttrsBid(store, 5, []string{"five.bid.can.create.bananas"})
+				keeper.SetReqAttrsCommitment(store, 5, []string{"five.com.can.create.bananas"})
+				keeper.SetReqAttrsAsk(store, 6, []string{"six.ask.can.create.bananas"})
+				keeper.SetReqAttrsBid(store, 6, []string{"six.bid.can.create.bananas"})
+				keeper.SetReqAttrsCommitment(store, 6, []string{"six.com.can.create.bananas"})
+			},
+			msg: &exchange.MsgMarketManageReqAttrsRequest{
+				Admin:                    "admin_addr_str",
+				MarketId:                 5,
+				CreateCommitmentToAdd:    []string{"*.com.can.create.bananas"},
+				CreateCommitmentToRemove: []string{"five.com.can.create.bananas"},
+			},
+			expAsk: []string{"five.ask.can.create.bananas"},
+			expBid: []string{"five.bid.can.create.bananas"},
+			expCom: []string{"*.com.can.create.bananas"},
+		},
+
+		// manipulation of all.
+		{
+			name: "add and remove two of each",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetReqAttrsAsk(store, 2, []string{"one.ask", "two.ask", "three.ask"})
+				keeper.SetReqAttrsBid(store, 2, []string{"one.bid", "two.bid", "three.bid"})
+				keeper.SetReqAttrsCommitment(store, 2, []string{"one.com", "two.com", "three.com"})
+			},
+			msg: &exchange.MsgMarketManageReqAttrsRequest{
+				Admin:                    "admin_addr_string",
+				MarketId:                 2,
+				CreateAskToAdd:           []string{"*.other", "four.ask"},
+				CreateAskToRemove:        []string{"one.ask", "three.ask"},
+				CreateBidToAdd:           []string{"*.other", "five.bid"},
+				CreateBidToRemove:        []string{"three.bid", "two.bid"},
+				CreateCommitmentToAdd:    []string{"*.other", "six.com"},
+				CreateCommitmentToRemove: []string{"three.com", "two.com"},
+			},
+			expAsk: []string{"two.ask", "*.other", "four.ask"},
+			expBid: []string{"one.bid", "*.other", "five.bid"},
+			expCom: []string{"one.com", "*.other", "six.com"},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var expEvents sdk.Events
+			if len(tc.expPanic) == 0 && len(tc.expErr) == 0 {
+				event := exchange.NewEventMarketReqAttrUpdated(tc.msg.MarketId, tc.msg.Admin)
+				expEvents = append(expEvents, s.untypeEvent(event))
+			}
+
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			var err error
+			testFunc := func() {
+				err = s.k.UpdateReqAttrs(ctx, tc.msg)
+			}
+			s.requirePanicEquals(testFunc, tc.expPanic, "UpdateReqAttrs")
+			s.assertErrorValue(err, tc.expErr, "UpdateReqAttrs error")
+
+			actEvents := em.Events()
+			s.assertEqualEvents(expEvents, actEvents, "events emitted during UpdateReqAttrs")
+
+			if len(tc.expPanic) > 0 || len(tc.expErr) > 0 {
+				return
+			}
+
+			reqAttrAsk := s.k.GetReqAttrsAsk(s.ctx, tc.msg.MarketId)
+			reqAttrBid := s.k.GetReqAttrsBid(s.ctx, tc.msg.MarketId)
+			reqAttrCom := s.k.GetReqAttrsCommitment(s.ctx, tc.msg.MarketId)
+			s.Assert().Equal(tc.expAsk, reqAttrAsk, "create-ask req attrs after UpdateReqAttrs")
+			s.Assert().Equal(tc.expBid, reqAttrBid, "create-bid req attrs after UpdateReqAttrs")
+			s.Assert().Equal(tc.expCom, reqAttrCom, "create-commitment req attrs after UpdateReqAttrs")
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_GetAcceptedAssetDenoms() {
+	setter := keeper.SetAcceptedAssetDenoms
+	tests := []struct {
+		name     string
+		setup    func()
+		marketID uint32
+		expected []string
+	}{
+		{
+			name:     "empty state",
+			marketID: 1,
+			expected: nil,
+		},
+		{
+			name: "market without any",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, []string{"apple", "banana"})
+				setter(store, 3, []string{"cherry"})
+				keeper.SetAcceptedPriceDenoms(store, 2, []string{"nhash"})
+			},
+			marketID: 2,
+			expected: nil,
+		},
+		{
+			name: "market with one",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, []string{"apple", "banana"})
+				setter(store, 2, []string{"cherry"})
+				setter(store, 3, []string{"date", "elderberry"})
+			},
+			marketID: 2,
+			expected: []string{"cherry"},
+		},
+		{
+			name: "market with three",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, []string{"apple", "banana"})
+				setter(store, 22, []string{"fig", "grape", "honeydew"})
+				setter(store, 333, []string{"date", "elderberry"})
+			},
+			marketID: 22,
+			expected: []string{"fig", "grape", "honeydew"},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var actual []string
+			testFunc := func() {
+				actual = s.k.GetAcceptedAssetDenoms(s.ctx, tc.marketID)
+			}
+			s.Require().NotPanics(testFunc, "GetAcceptedAssetDenoms(%d)", tc.marketID)
+			s.Assert().Equal(tc.expected, actual, "GetAcceptedAssetDenoms(%d)", tc.marketID)
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_GetAcceptedPriceDenoms() {
+	setter := keeper.SetAcceptedPriceDenoms
+	tests := []struct {
+		name     string
+		setup    func()
+		marketID uint32
+		expected []string
+	}{
+		{
+			name:     "empty state",
+			marketID: 1,
+			expected: nil,
+		},
+		{
+			name: "market without any",
 			setup: func() {
 				store := s.getStore()
-				keeper.SetReqAttrsAsk(store, 9, []string{"ask.can.create.bananas"})
-				keeper.SetReqAttrsBid(store, 9, []string{"bid.can.create.bananas"})
-				keeper.SetReqAttrsCommitment(store, 9, []string{"com.can.create.bananas"})
-			},
-			msg: &exchange.MsgMarketManageReqAttrsRequest{
-				Admin:                    "admin_addr_str",
-				MarketId:                 9,
-				CreateCommitmentToRemove: []string{"com.can.create.bananas"},
+				setter(store, 1, []string{"nhash", "usd"})
+				setter(store, 3, []string{"euro"})
+				keeper.SetAcceptedAssetDenoms(store, 2, []string{"apple"})
 			},
-			expAsk: []string{"ask.can.create.bananas"},
-			expBid: []string{"bid.can.create.bananas"},
-			expCom: nil,
+			marketID: 2,
+			expected: nil,
 		},
 		{
-			name: "remove one create-commitment from two",
+			name: "market with one",
 			setup: func() {
 				store := s.getStore()
-				keeper.SetReqAttrsAsk(store, 9, []string{"ask.can.create.bananas"})
-				keeper.SetReqAttrsBid(store, 9, []string{"bid.can.create.bananas"})
-				keeper.SetReqAttrsCommitment(store, 9, []string{"com.can.create.bananas", "also.com.okay"})
-			},
-			msg: &exchange.MsgMarketManageReqAttrsRequest{
-				Admin:                    "admin_addr_str",
-				MarketId:                 9,
-				CreateCommitmentToRemove: []string{"also.com.okay"},
+				setter(store, 1, []string{"nhash", "usd"})
+				setter(store, 2, []string{"euro"})
+				setter(store, 3, []string{"yen", "peso"})
 			},
-			expAsk: []string{"ask.can.create.bananas"},
-			expBid: []string{"bid.can.create.bananas"},
-			expCom: []string{"com.can.create.bananas"},
+			marketID: 2,
+			expected: []string{"euro"},
 		},
 		{
-			name: "remove one create-commitment with wildcard",
+			name: "market with three",
 			setup: func() {
 				store := s.getStore()
-				keeper.SetReqAttrsAsk(store, 9, []string{"ask.can.create.bananas"})
-				keeper.SetReqAttrsBid(store, 9, []string{"bid.can.create.bananas"})
-				keeper.SetReqAttrsCommitment(store, 9, []string{
-					"com.can.create.bananas", "one.com.can.create.bananas",
-					"*.com.can.create.bananas", "two.com.can.create.bananas",
-				})
+				setter(store, 1, []string{"nhash", "usd"})
+				setter(store, 22, []string{"pound", "franc", "rupee"})
+				setter(store, 333, []string{"yen", "peso"})
 			},
-			msg: &exchange.MsgMarketManageReqAttrsRequest{
-				Admin:                    "admin_addr_str",
-				MarketId:                 9,
-				CreateCommitmentToRemove: []string{"*.com.can.create.bananas"},
+			marketID: 22,
+			expected: []string{"pound", "franc", "rupee"},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var actual []string
+			testFunc := func() {
+				actual = s.k.GetAcceptedPriceDenoms(s.ctx, tc.marketID)
+			}
+			s.Require().NotPanics(testFunc, "GetAcceptedPriceDenoms(%d)", tc.marketID)
+			s.Assert().Equal(tc.expected, actual, "GetAcceptedPriceDenoms(%d)", tc.marketID)
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_UpdateAcceptedDenoms() {
+	tests := []struct {
+		name      string
+		setup     func()
+		msg       *exchange.MsgMarketManageAcceptedDenomsRequest
+		expAssets []string
+		expPrices []string
+		expErr    string
+		expPanic  string
+	}{
+		{
+			name:     "nil msg",
+			msg:      nil,
+			expPanic: "runtime error: invalid memory address or nil pointer dereference",
+		},
+		{
+			name: "remove asset denom that is not accepted",
+			msg: &exchange.MsgMarketManageAcceptedDenomsRequest{
+				Admin:               "admin_addr_str",
+				MarketId:            1,
+				AssetDenomsToRemove: []string{"apple"},
 			},
-			expAsk: []string{"ask.can.create.bananas"},
-			expBid: []string{"bid.can.create.bananas"},
-			expCom: []string{"com.can.create.bananas", "one.com.can.create.bananas", "two.com.can.create.bananas"},
+			expErr: "cannot remove accepted asset denom \"apple\": denom not currently accepted",
 		},
 		{
-			name: "remove last two create-commitment",
+			name: "add price denom that is already accepted",
 			setup: func() {
-				store := s.getStore()
-				keeper.SetReqAttrsAsk(store, 55, []string{"one.ask.can.create.bananas", "two.ask.can.create.bananas"})
-				keeper.SetReqAttrsBid(store, 55, []string{"one.bid.can.create.bananas", "two.bid.can.create.bananas"})
-				keeper.SetReqAttrsCommitment(store, 55, []string{"one.com.can.create.bananas", "two.com.can.create.bananas"})
+				keeper.SetAcceptedPriceDenoms(s.getStore(), 5, []string{"nhash"})
 			},
-			msg: &exchange.MsgMarketManageReqAttrsRequest{
-				Admin:                    "admin_addr_str",
-				MarketId:                 55,
-				CreateCommitmentToRemove: []string{"two.com.can.create.bananas", "one.com.can.create.bananas"},
+			msg: &exchange.MsgMarketManageAcceptedDenomsRequest{
+				Admin:            "admin_addr_str",
+				MarketId:         5,
+				PriceDenomsToAdd: []string{"nhash"},
 			},
-			expAsk: []string{"one.ask.can.create.bananas", "two.ask.can.create.bananas"},
-			expBid: []string{"one.bid.can.create.bananas", "two.bid.can.create.bananas"},
-			expCom: nil,
+			expErr: "cannot add accepted price denom \"nhash\": denom already accepted",
 		},
 		{
-			name: "add one create-commitment to empty",
+			name: "multiple errors",
 			setup: func() {
 				store := s.getStore()
-				keeper.SetReqAttrsAsk(store, 1, []string{"ask.can.create.bananas"})
-				keeper.SetReqAttrsBid(store, 1, []string{"bid.can.create.bananas"})
+				keeper.SetAcceptedAssetDenoms(store, 3, []string{"apple", "banana"})
+				keeper.SetAcceptedPriceDenoms(store, 3, []string{"nhash", "usd"})
 			},
-			msg: &exchange.MsgMarketManageReqAttrsRequest{
-				Admin:                 "admin_addr_str",
-				MarketId:              1,
-				CreateCommitmentToAdd: []string{"com.can.create.bananas"},
+			msg: &exchange.MsgMarketManageAcceptedDenomsRequest{
+				Admin:               "admin_addr_str",
+				MarketId:            3,
+				AssetDenomsToAdd:    []string{"banana", "cherry"},
+				AssetDenomsToRemove: []string{"date"},
+				PriceDenomsToAdd:    []string{"usd"},
+				PriceDenomsToRemove: []string{"euro", "nhash"},
 			},
-			expAsk: []string{"ask.can.create.bananas"},
-			expBid: []string{"bid.can.create.bananas"},
-			expCom: []string{"com.can.create.bananas"},
+			expErr: s.joinErrs(
+				"cannot remove accepted asset denom \"date\": denom not currently accepted",
+				"cannot add accepted asset denom \"banana\": denom already accepted",
+				"cannot remove accepted price denom \"euro\": denom not currently accepted",
+				"cannot add accepted price denom \"usd\": denom already accepted",
+			),
 		},
 		{
-			name: "add one create-commitment to existing",
-			setup: func() {
-				store := s.getStore()
-				keeper.SetReqAttrsAsk(store, 1, []string{"ask.can.create.bananas"})
-				keeper.SetReqAttrsBid(store, 1, []string{"bid.can.create.bananas"})
-				keeper.SetReqAttrsCommitment(store, 1, []string{"com.can.create.bananas"})
-			},
-			msg: &exchange.MsgMarketManageReqAttrsRequest{
-				Admin:                 "admin_addr_str",
-				MarketId:              1,
-				CreateCommitmentToAdd: []string{"*.com.can.create.bananas"},
+			name: "add to empty",
+			msg: &exchange.MsgMarketManageAcceptedDenomsRequest{
+				Admin:            "admin_addr_str",
+				MarketId:         2,
+				AssetDenomsToAdd: []string{"apple", "banana"},
+				PriceDenomsToAdd: []string{"nhash"},
 			},
-			expAsk: []string{"ask.can.create.bananas"},
-			expBid: []string{"bid.can.create.bananas"},
-			expCom: []string{"com.can.create.bananas", "*.com.can.create.bananas"},
+			expAssets: []string{"apple", "banana"},
+			expPrices: []string{"nhash"},
 		},
 		{
-			name: "remove one, add diff create-commitment",
+			name: "remove all",
 			setup: func() {
 				store := s.getStore()
-				keeper.SetReqAttrsAsk(store, 4, []string{"four.ask.can.create.bananas"})
-				keeper.SetReqAttrsBid(store, 4, []string{"four.bid.can.create.bananas"})
-				keeper.SetReqAttrsCommitment(store, 4, []string{"four.com.can.create.bananas"})
-				keeper.SetReqAttrsAsk(store, 5, []string{"five.ask.can.create.bananas"})
-				keeper.SetReqAttrsBid(store, 5, []string{"five.bid.can.create.bananas"})
-				keeper.SetReqAttrsCommitment(store, 5, []string{"five.com.can.create.bananas"})
-				keeper.SetReqAttrsAsk(store, 6, []string{"six.ask.can.create.bananas"})
-				keeper.SetReqAttrsBid(store, 6, []string{"six.bid.can.create.bananas"})
-				keeper.SetReqAttrsCommitment(store, 6, []string{"six.com.can.create.bananas"})
+				keeper.SetAcceptedAssetDenoms(store, 8, []string{"apple", "banana"})
+				keeper.SetAcceptedPriceDenoms(store, 8, []string{"nhash"})
 			},
-			msg: &exchange.MsgMarketManageReqAttrsRequest{
-				Admin:                    "admin_addr_str",
-				MarketId:                 5,
-				CreateCommitmentToAdd:    []string{"*.com.can.create.bananas"},
-				CreateCommitmentToRemove: []string{"five.com.can.create.bananas"},
+			msg: &exchange.MsgMarketManageAcceptedDenomsRequest{
+				Admin:               "admin_addr_str",
+				MarketId:            8,
+				AssetDenomsToRemove: []string{"banana", "apple"},
+				PriceDenomsToRemove: []string{"nhash"},
 			},
-			expAsk: []string{"five.ask.can.create.bananas"},
-			expBid: []string{"five.bid.can.create.bananas"},
-			expCom: []string{"*.com.can.create.bananas"},
+			expAssets: nil,
+			expPrices: nil,
 		},
-
-		// manipulation of all.
 		{
-			name: "add and remove two of each",
+			name: "add and remove from each",
 			setup: func() {
 				store := s.getStore()
-				keeper.SetReqAttrsAsk(store, 2, []string{"one.ask", "two.ask", "three.ask"})
-				keeper.SetReqAttrsBid(store, 2, []string{"one.bid", "two.bid", "three.bid"})
-				keeper.SetReqAttrsCommitment(store, 2, []string{"one.com", "two.com", "three.com"})
+				keeper.SetAcceptedAssetDenoms(store, 4, []string{"apple", "banana", "cherry"})
+				keeper.SetAcceptedPriceDenoms(store, 4, []string{"nhash", "usd"})
+				keeper.SetAcceptedAssetDenoms(store, 5, []string{"apple"})
 			},
-			msg: &exchange.MsgMarketManageReqAttrsRequest{
-				Admin:                    "admin_addr_string",
-				MarketId:                 2,
-				CreateAskToAdd:           []string{"*.other", "four.ask"},
-				CreateAskToRemove:        []string{"one.ask", "three.ask"},
-				CreateBidToAdd:           []string{"*.other", "five.bid"},
-				CreateBidToRemove:        []string{"three.bid", "two.bid"},
-				CreateCommitmentToAdd:    []string{"*.other", "six.com"},
-				CreateCommitmentToRemove: []string{"three.com", "two.com"},
+			msg: &exchange.MsgMarketManageAcceptedDenomsRequest{
+				Admin:               "admin_addr_str",
+				MarketId:            4,
+				AssetDenomsToAdd:    []string{"date"},
+				AssetDenomsToRemove: []string{"banana"},
+				PriceDenomsToAdd:    []string{"euro"},
+				PriceDenomsToRemove: []string{"nhash"},
 			},
-			expAsk: []string{"two.ask", "*.other", "four.ask"},
-			expBid: []string{"one.bid", "*.other", "five.bid"},
-			expCom: []string{"one.com", "*.other", "six.com"},
+			expAssets: []string{"apple", "cherry", "date"},
+			expPrices: []string{"usd", "euro"},
 		},
 	}
 
@@ -6852,8 +9553,8 @@ func (s *TestSuite) TestKeeper_UpdateReqAttrs() {
 			}
 
 			var expEvents sdk.Events
-			if len(tc.expPanic) == 0 && len(tc.expErr) == 0 {
-				event := exchange.NewEventMarketReqAttrUpdated(tc.msg.MarketId, tc.msg.Admin)
+			if len(tc.expErr) == 0 && len(tc.expPanic) == 0 {
+				event := exchange.NewEventMarketAcceptedDenomsUpdated(tc.msg.MarketId, tc.msg.Admin)
 				expEvents = append(expEvents, s.untypeEvent(event))
 			}
 
@@ -6861,24 +9562,22 @@ func (s *TestSuite) TestKeeper_UpdateReqAttrs() {
 			ctx := s.ctx.WithEventManager(em)
 			var err error
 			testFunc := func() {
-				err = s.k.UpdateReqAttrs(ctx, tc.msg)
+				err = s.k.UpdateAcceptedDenoms(ctx, tc.msg)
 			}
-			s.requirePanicEquals(testFunc, tc.expPanic, "UpdateReqAttrs")
-			s.assertErrorValue(err, tc.expErr, "UpdateReqAttrs error")
+			s.requirePanicEquals(testFunc, tc.expPanic, "UpdateAcceptedDenoms")
+			s.assertErrorValue(err, tc.expErr, "UpdateAcceptedDenoms error")
 
-			actEvents := em.Events()
-			s.assertEqualEvents(expEvents, actEvents, "events emitted during UpdateReqAttrs")
+			events := em.Events()
+			s.assertEqualEvents(expEvents, events, "events emitted during UpdateAcceptedDenoms")
 
-			if len(tc.expPanic) > 0 || len(tc.expErr) > 0 {
+			if len(tc.expErr) > 0 || len(tc.expPanic) > 0 {
 				return
 			}
 
-			reqAttrAsk := s.k.GetReqAttrsAsk(s.ctx, tc.msg.MarketId)
-			reqAttrBid := s.k.GetReqAttrsBid(s.ctx, tc.msg.MarketId)
-			reqAttrCom := s.k.GetReqAttrsCommitment(s.ctx, tc.msg.MarketId)
-			s.Assert().Equal(tc.expAsk, reqAttrAsk, "create-ask req attrs after UpdateReqAttrs")
-			s.Assert().Equal(tc.expBid, reqAttrBid, "create-bid req attrs after UpdateReqAttrs")
-			s.Assert().Equal(tc.expCom, reqAttrCom, "create-commitment req attrs after UpdateReqAttrs")
+			actAssets := s.k.GetAcceptedAssetDenoms(s.ctx, tc.msg.MarketId)
+			s.Assert().Equal(tc.expAssets, actAssets, "accepted asset denoms after UpdateAcceptedDenoms")
+			actPrices := s.k.GetAcceptedPriceDenoms(s.ctx, tc.msg.MarketId)
+			s.Assert().Equal(tc.expPrices, actPrices, "accepted price denoms after UpdateAcceptedDenoms")
 		})
 	}
 }
@@ -7307,6 +10006,15 @@ func (s *TestSuite) TestKeeper_CreateMarket() {
 				CommitmentSettlementBips: 15,
 				IntermediaryDenom:        "cherry",
 				ReqAttrCreateCommitment:  []string{"*.com.whatever"},
+
+				ContinuousMatching:  true,
+				SelfTradePrevention: exchange.SelfTradePrevention_cancel_newest,
+				PublishPrices:       true,
+
+				AcceptedAssetDenoms: []string{"apple", "banana"},
+				AcceptedPriceDenoms: []string{"cherry"},
+
+				ExchangeSplits: []exchange.DenomSplit{{Denom: "apple", Split: 250}},
 			},
 			expMarketID:   3,
 			expHasAccCall: true,
@@ -7513,6 +10221,19 @@ func (s *TestSuite) TestKeeper_GetMarket() {
 					CommitmentSettlementBips: 15,
 					IntermediaryDenom:        "cherry",
 					ReqAttrCreateCommitment:  []string{"create-com.my.market", "*.kyc.someone"},
+
+					ContinuousMatching:  true,
+					SelfTradePrevention: exchange.SelfTradePrevention_decrement_both,
+					PublishPrices:       true,
+
+					AcceptedAssetDenoms: []string{"apple", "banana"},
+					AcceptedPriceDenoms: []string{"cherry"},
+
+					FeeSellerSettlementRebateRatios: []exchange.FeeRatio{{Price: sdk.NewInt64Coin("pear", 1000), Fee: sdk.NewInt64Coin("pear", 1)}},
+					RebateAddresses:                 []string{s.addr2.String(), s.addr4.String()},
+
+					ReferralBips: 2500,
+					ReferralCap:  []sdk.Coin{sdk.NewInt64Coin("pear", 10)},
 				}
 
 				store := s.getStore()
@@ -8092,6 +10813,34 @@ func (s *TestSuite) TestKeeper_ValidateMarket() {
 			marketID: 55,
 			expErr:   "",
 		},
+		{
+			name: "taker price denom not in seller",
+			setup: func() {
+				keeper.StoreMarket(s.getStore(), exchange.Market{
+					MarketId:                  3,
+					FeeSellerSettlementRatios: []exchange.FeeRatio{{Price: s.coin("500pear"), Fee: s.coin("3pear")}},
+					FeeSellerSettlementTakerRatios: []exchange.FeeRatio{
+						{Price: s.coin("500pear"), Fee: s.coin("5pear")},
+						{Price: s.coin("500prune"), Fee: s.coin("5prune")},
+					},
+				})
+			},
+			marketID: 3,
+			expErr: "seller settlement taker fee ratios have price denom \"prune\" but there is not a " +
+				"seller settlement fee ratio with that price denom",
+		},
+		{
+			name: "taker ratios with same price denoms as seller",
+			setup: func() {
+				keeper.StoreMarket(s.getStore(), exchange.Market{
+					MarketId:                       3,
+					FeeSellerSettlementRatios:      []exchange.FeeRatio{{Price: s.coin("500pear"), Fee: s.coin("3pear")}},
+					FeeSellerSettlementTakerRatios: []exchange.FeeRatio{{Price: s.coin("500pear"), Fee: s.coin("5pear")}},
+				})
+			},
+			marketID: 3,
+			expErr:   "",
+		},
 		{
 			name: "commitment bips without denom",
 			setup: func() {
@@ -8239,7 +10988,7 @@ func (s *TestSuite) TestKeeper_CloseMarket() {
 	for i, com := range allCommitments {
 		addr, err := sdk.AccAddressFromBech32(com.Account)
 		s.Require().NoError(err, "[%d]: AccAddressFromBech32(%q) error", i, com.Account)
-		keeper.SetCommitmentAmount(store, com.MarketId, addr, com.Amount)
+		keeper.SetCommitmentAmount(store, com.MarketId, addr, com.Amount, s.ctx.BlockTime())
 	}
 	store = nil
 
@@ -8277,3 +11026,207 @@ func (s *TestSuite) TestKeeper_CloseMarket() {
 	})
 	s.assertEqualCommitments(nonMarketCommitments, actCommitments, "commitments left after CloseMarket(%d, %q)", marketID, signer)
 }
+
+func (s *TestSuite) TestKeeper_WindDownMarket() {
+	marketID := uint32(14)
+	signer := s.k.GetAuthority()
+	askOrder := exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+		MarketId: marketID, Seller: s.addr1.String(), Assets: s.coin("10apple"), Price: s.coin("20peach"),
+	})
+	bidOrder := exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+		MarketId: marketID, Buyer: s.addr2.String(), Assets: s.coin("45apple"), Price: s.coin("70peach"),
+	})
+	triggerOrder := exchange.NewTriggerOrder(*exchange.NewOrder(3).WithAsk(&exchange.AskOrder{
+		MarketId: marketID, Seller: s.addr1.String(), Assets: s.coin("5apple"), Price: s.coin("9peach"),
+	}), s.coin("2peach"))
+	otherOrder := exchange.NewOrder(4).WithAsk(&exchange.AskOrder{
+		MarketId: marketID - 1, Seller: s.addr1.String(), Assets: s.coin("3apple"), Price: s.coin("4peach"),
+	})
+
+	setup := func() {
+		s.requireCreateMarket(exchange.Market{MarketId: marketID - 1, AcceptingOrders: true})
+		s.requireCreateMarket(exchange.Market{
+			MarketId:             marketID,
+			AcceptingOrders:      true,
+			AcceptingCommitments: true,
+			AccessGrants:         []exchange.AccessGrant{s.agCanOnly(s.addr4, exchange.Permission_cancel)},
+			IntermediaryDenom:    "cherry",
+		})
+		store := s.getStore()
+		s.requireSetOrdersInStore(store, askOrder, bidOrder, otherOrder)
+		s.Require().NoError(s.k.SetTriggerOrderInStore(store, *triggerOrder), "SetTriggerOrderInStore")
+		keeper.SetCommitmentAmount(store, marketID, s.addr1, s.coins("57cherry"), s.ctx.BlockTime())
+		keeper.SetCommitmentAmount(store, marketID, s.addr3, s.coins("88apple"), s.ctx.BlockTime())
+		keeper.SetCommitmentAmount(store, marketID-1, s.addr3, s.coins("12apple"), s.ctx.BlockTime())
+	}
+	startEvents := func() sdk.Events {
+		return sdk.Events{
+			s.untypeEvent(exchange.NewEventMarketOrdersDisabled(marketID, signer)),
+			s.untypeEvent(exchange.NewEventMarketCommitmentsDisabled(marketID, signer)),
+		}
+	}
+	cancelEvents := func(orders ...*exchange.Order) sdk.Events {
+		rv := make(sdk.Events, len(orders))
+		for i, order := range orders {
+			rv[i] = s.untypeEvent(exchange.NewEventOrderCancelled(order, signer))
+		}
+		return rv
+	}
+	releaseEvents := sdk.Events{
+		s.untypeEvent(exchange.NewEventCommitmentReleased(s.addr1.String(), marketID, s.coins("57cherry"), "GovWindDownMarket")),
+		s.untypeEvent(exchange.NewEventCommitmentReleased(s.addr3.String(), marketID, s.coins("88apple"), "GovWindDownMarket")),
+	}
+	allReleases := []*exchange.WindDownRelease{
+		exchange.NewWindDownRelease(s.addr1.String(), s.coins("15apple"), s.coins("57cherry")),
+		exchange.NewWindDownRelease(s.addr2.String(), s.coins("70peach"), nil),
+		exchange.NewWindDownRelease(s.addr3.String(), nil, s.coins("88apple")),
+	}
+	joinEvents := func(eventss ...sdk.Events) sdk.Events {
+		var rv sdk.Events
+		for _, events := range eventss {
+			rv = append(rv, events...)
+		}
+		return rv
+	}
+
+	tests := []struct {
+		name         string
+		setup        func()
+		holdKeeper   *MockHoldKeeper
+		marketID     uint32
+		deleteMarket bool
+		expErr       string
+		expLog       []string
+		expEvents    sdk.Events
+		expCancelled []uint64
+	}{
+		{
+			name:     "market does not exist",
+			setup:    setup,
+			marketID: marketID + 1,
+			expErr:   fmt.Sprintf("market %d does not exist", marketID+1),
+		},
+		{
+			name: "delete with funds in the commitment reward pool",
+			setup: func() {
+				setup()
+				keeper.SetCommitmentRewardPool(s.getStore(), marketID, s.coins("5cherry"))
+			},
+			marketID:     marketID,
+			deleteMarket: true,
+			expErr:       fmt.Sprintf("cannot delete market %d: commitment reward pool still has 5cherry", marketID),
+		},
+		{
+			name: "nothing to cancel or release",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{MarketId: marketID - 1})
+				s.requireCreateMarket(exchange.Market{MarketId: marketID})
+				store := s.getStore()
+				s.requireSetOrdersInStore(store, otherOrder)
+				keeper.SetCommitmentAmount(store, marketID-1, s.addr3, s.coins("12apple"), s.ctx.BlockTime())
+			},
+			marketID: marketID,
+			expEvents: sdk.Events{
+				s.untypeEvent(exchange.NewEventMarketWoundDown(marketID, 0, 0, false, []*exchange.WindDownRelease{})),
+			},
+		},
+		{
+			name:     "okay: market kept",
+			setup:    setup,
+			marketID: marketID,
+			expEvents: joinEvents(
+				startEvents(),
+				cancelEvents(askOrder, bidOrder, &triggerOrder.Order),
+				releaseEvents,
+				sdk.Events{s.untypeEvent(exchange.NewEventMarketWoundDown(marketID, 3, 2, false, allReleases))},
+			),
+			expCancelled: []uint64{1, 2, 3},
+		},
+		{
+			name:         "okay: market deleted",
+			setup:        setup,
+			marketID:     marketID,
+			deleteMarket: true,
+			expEvents: joinEvents(
+				startEvents(),
+				cancelEvents(askOrder, bidOrder, &triggerOrder.Order),
+				releaseEvents,
+				sdk.Events{s.untypeEvent(exchange.NewEventMarketWoundDown(marketID, 3, 2, true, allReleases))},
+			),
+			expCancelled: []uint64{1, 2, 3},
+		},
+		{
+			name:       "error cancelling an order",
+			setup:      setup,
+			holdKeeper: NewMockHoldKeeper().WithReleaseHoldResults("", "injected error"),
+			marketID:   marketID,
+			expLog: []string{
+				fmt.Sprintf("ERR 1 error(s) encountered winding down market %d:", marketID),
+				"unable to release hold on order 2 funds: injected error module=x/exchange",
+			},
+			expEvents: joinEvents(
+				startEvents(),
+				cancelEvents(askOrder, &triggerOrder.Order),
+				releaseEvents,
+				sdk.Events{s.untypeEvent(exchange.NewEventMarketWoundDown(marketID, 2, 2, false, []*exchange.WindDownRelease{
+					exchange.NewWindDownRelease(s.addr1.String(), s.coins("15apple"), s.coins("57cherry")),
+					exchange.NewWindDownRelease(s.addr3.String(), nil, s.coins("88apple")),
+				}))},
+			),
+			expCancelled: []uint64{1, 3},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+			if tc.holdKeeper == nil {
+				tc.holdKeeper = NewMockHoldKeeper()
+			}
+
+			kpr := s.k.WithHoldKeeper(tc.holdKeeper)
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			s.logBuffer.Reset()
+			var err error
+			testFunc := func() {
+				err = kpr.WindDownMarket(ctx, tc.marketID, tc.deleteMarket, signer)
+			}
+			s.Require().NotPanics(testFunc, "WindDownMarket(%d, %t)", tc.marketID, tc.deleteMarket)
+			s.assertErrorValue(err, tc.expErr, "WindDownMarket(%d, %t) error", tc.marketID, tc.deleteMarket)
+
+			outputLog := s.getLogOutput("WindDownMarket(%d, %t)", tc.marketID, tc.deleteMarket)
+			actLog := s.splitOutputLog(outputLog)
+			s.Assert().Equal(tc.expLog, actLog, "Lines logged during WindDownMarket(%d, %t)", tc.marketID, tc.deleteMarket)
+			s.assertEqualEvents(tc.expEvents, em.Events(), "events emitted during WindDownMarket(%d, %t)", tc.marketID, tc.deleteMarket)
+
+			for _, orderID := range tc.expCancelled {
+				order, oerr := s.k.GetOrder(s.ctx, orderID)
+				s.Assert().NoError(oerr, "GetOrder(%d) error", orderID)
+				s.Assert().Nil(order, "GetOrder(%d)", orderID)
+				trigOrder, oerr := s.k.GetTriggerOrder(s.ctx, orderID)
+				s.Assert().NoError(oerr, "GetTriggerOrder(%d) error", orderID)
+				s.Assert().Nil(trigOrder, "GetTriggerOrder(%d)", orderID)
+			}
+
+			if len(tc.expErr) > 0 {
+				return
+			}
+			market := s.k.GetMarket(s.ctx, tc.marketID)
+			if tc.deleteMarket {
+				s.Assert().Nil(market, "GetMarket(%d) after deleting it", tc.marketID)
+			} else if s.Assert().NotNil(market, "GetMarket(%d) after winding it down", tc.marketID) {
+				s.Assert().False(market.AcceptingOrders, "market AcceptingOrders")
+				s.Assert().False(market.AcceptingCommitments, "market AcceptingCommitments")
+			}
+			s.Assert().NotNil(s.k.GetMarket(s.ctx, marketID-1), "GetMarket(%d) (other market)", marketID-1)
+			otherOrderAfter, oerr := s.k.GetOrder(s.ctx, otherOrder.OrderId)
+			s.Assert().NoError(oerr, "GetOrder(%d) (other market) error", otherOrder.OrderId)
+			s.Assert().NotNil(otherOrderAfter, "GetOrder(%d) (other market)", otherOrder.OrderId)
+			s.Assert().Equal(s.coins("12apple"), s.k.GetCommitmentAmount(s.ctx, marketID-1, s.addr3), "commitment in other market")
+		})
+	}
+}
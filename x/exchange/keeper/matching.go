@@ -0,0 +1,374 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+// isOrderCrossedBy returns true if the unit price of the ask order is at or below the unit price of the bid order.
+// Both orders are assumed to have the same assets and price denoms.
+func isOrderCrossedBy(askOrder, bidOrder exchange.OrderI) bool {
+	return exchange.CompareUnitPrices(askOrder, bidOrder) <= 0
+}
+
+// getMatchableOrders gets all the orders in the book that can be matched with the provided order.
+// These are the crossing orders (see getCrossingOrders) that have a different owner than the provided order.
+// The result is sorted by price-time priority: best unit price first, then lowest order id.
+func (k Keeper) getMatchableOrders(ctx sdk.Context, store storetypes.KVStore, order *exchange.Order) ([]*exchange.Order, error) {
+	crossing, err := k.getCrossingOrders(ctx, store, order)
+	owner := order.GetOwner()
+	var rv []*exchange.Order
+	for _, other := range crossing {
+		if other.GetOwner() != owner {
+			rv = append(rv, other)
+		}
+	}
+	return rv, err
+}
+
+// getCrossingOrders gets all the orders in the book that cross the provided order.
+// These are the orders on the other side of the market that have the same assets and price denoms,
+// have not expired, are not basket orders, and have a unit price that crosses the provided order's.
+// The result is sorted by price-time priority: best unit price first, then lowest order id.
+func (k Keeper) getCrossingOrders(ctx sdk.Context, store storetypes.KVStore, order *exchange.Order) ([]*exchange.Order, error) {
+	isAsk := order.IsAskOrder()
+	otherTypeByte := exchange.OrderTypeByteAsk
+	if isAsk {
+		otherTypeByte = exchange.OrderTypeByteBid
+	}
+
+	var orderIDs []uint64
+	iterate(store, GetIndexKeyPrefixMarketToOrder(order.GetMarketID()), func(keySuffix, value []byte) bool {
+		if len(value) == 0 || value[0] != otherTypeByte {
+			return false
+		}
+		if orderID, ok := ParseIndexKeySuffixOrderID(keySuffix); ok {
+			orderIDs = append(orderIDs, orderID)
+		}
+		return false
+	})
+
+	assetsDenom := order.GetAssets().Denom
+	priceDenom := order.GetPrice().Denom
+	blockTime := ctx.BlockTime().Unix()
+
+	var errs []error
+	var rv []*exchange.Order
+	for _, orderID := range orderIDs {
+		other, err := k.getOrderFromStore(store, orderID)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if other == nil {
+			errs = append(errs, fmt.Errorf("order %d not found", orderID))
+			continue
+		}
+
+		if other.GetAssets().Denom != assetsDenom || other.GetPrice().Denom != priceDenom {
+			continue
+		}
+		if exp := other.GetExpiration(); exp != nil && exp.Unix() <= blockTime {
+			continue
+		}
+		if other.IsBasketOrder() {
+			continue
+		}
+
+		askOrder, bidOrder := exchange.OrderI(other), exchange.OrderI(order)
+		if isAsk {
+			askOrder, bidOrder = bidOrder, askOrder
+		}
+		if !isOrderCrossedBy(askOrder, bidOrder) {
+			continue
+		}
+
+		rv = append(rv, other)
+	}
+
+	sort.SliceStable(rv, func(i, j int) bool {
+		cmp := exchange.CompareUnitPrices(rv[i], rv[j])
+		if cmp != 0 {
+			// When the new order is an ask, the highest bids are best; otherwise, the lowest asks are best.
+			return (cmp > 0) == isAsk
+		}
+		return rv[i].OrderId < rv[j].OrderId
+	})
+
+	return rv, errors.Join(errs...)
+}
+
+// selectMatchedOrders picks the orders (in priority order) that will be settled against the provided order.
+// Orders are taken in full until the provided order's assets are used up. An order with more assets than
+// are left is only taken if it allows partial fills, in which case it's the last one taken; otherwise it's skipped.
+// If the provided order would only be partially filled, but does not allow it, nil is returned.
+func selectMatchedOrders(order *exchange.Order, candidates []*exchange.Order) []*exchange.Order {
+	var rv []*exchange.Order
+	assetsLeft := order.GetAssets().Amount
+	for _, candidate := range candidates {
+		candidateAssets := candidate.GetAssets().Amount
+		if candidateAssets.GT(assetsLeft) {
+			if !candidate.PartialFillAllowed() {
+				continue
+			}
+			candidateAssets = assetsLeft
+		}
+		rv = append(rv, candidate)
+		assetsLeft = assetsLeft.Sub(candidateAssets)
+		if assetsLeft.IsZero() {
+			break
+		}
+	}
+
+	if len(rv) == 0 || (assetsLeft.IsPositive() && !order.PartialFillAllowed()) {
+		return nil
+	}
+	return rv
+}
+
+// selectOrdersToFill picks the orders that will be settled against the provided order using the given fill algorithm.
+// The matched orders are in priority order. Any resting order that is to be partially filled is split ahead of time:
+// the filled portion is in matched, and what's left of it is in left.
+// If the provided order would only be partially filled, but does not allow it, nil is returned.
+func selectOrdersToFill(algorithm exchange.FillAlgorithm, order *exchange.Order, candidates []*exchange.Order) (matched, left []*exchange.Order) {
+	switch algorithm {
+	case exchange.FillAlgorithm_price_time:
+		return selectPriceTimeOrders(order, candidates), nil
+	case exchange.FillAlgorithm_pro_rata:
+		return selectProRataOrders(order, candidates)
+	default:
+		return selectMatchedOrders(order, candidates), nil
+	}
+}
+
+// selectPriceTimeOrders picks the orders (in priority order) that will be settled against the provided order
+// using strict price-time priority. Orders are taken in full until the provided order's assets are used up.
+// Selection stops at the first order with more assets than are left. That order is taken (as the last one)
+// if it allows partial fills; otherwise, it, and every order after it, is left alone.
+// If the provided order would only be partially filled, but does not allow it, nil is returned.
+func selectPriceTimeOrders(order *exchange.Order, candidates []*exchange.Order) []*exchange.Order {
+	var rv []*exchange.Order
+	assetsLeft := order.GetAssets().Amount
+	for _, candidate := range candidates {
+		candidateAssets := candidate.GetAssets().Amount
+		if candidateAssets.GT(assetsLeft) {
+			if !candidate.PartialFillAllowed() {
+				break
+			}
+			candidateAssets = assetsLeft
+		}
+		rv = append(rv, candidate)
+		assetsLeft = assetsLeft.Sub(candidateAssets)
+		if assetsLeft.IsZero() {
+			break
+		}
+	}
+
+	if len(rv) == 0 || (assetsLeft.IsPositive() && !order.PartialFillAllowed()) {
+		return nil
+	}
+	return rv
+}
+
+// selectProRataOrders picks the orders that will be settled against the provided order using pro-rata allocation.
+// Price levels are still taken best first, and every order at a level is taken in full if the level fits in
+// what's left of the provided order. At the first level that doesn't fit, what's left is shared among that level's
+// orders that allow partial fills in proportion to their assets (rounded down). The remaining units from rounding are
+// then given out one at a time in priority order (lowest order id first). Orders that end up with nothing are not
+// matched, and orders that cannot be split for the amount they were given (e.g. a price that isn't evenly divisible)
+// are skipped. Orders at that level that don't allow partial fills are only taken if the level's partial-fill
+// orders all fit, and there's enough left for them (in priority order).
+//
+// The matched orders are in priority order. Orders being partially filled are split: the filled portion is in
+// matched, and what's left of it is in left. If the provided order would only be partially filled, but does not
+// allow it, nil is returned.
+func selectProRataOrders(order *exchange.Order, candidates []*exchange.Order) (matched, left []*exchange.Order) {
+	assetsLeft := order.GetAssets().Amount
+	for i := 0; i < len(candidates) && assetsLeft.IsPositive(); {
+		j := i + 1
+		for j < len(candidates) && exchange.CompareUnitPrices(candidates[i], candidates[j]) == 0 {
+			j++
+		}
+		level := candidates[i:j]
+		i = j
+
+		levelTotal := sdkmath.ZeroInt()
+		partialTotal := sdkmath.ZeroInt()
+		var partials []*exchange.Order
+		for _, candidate := range level {
+			candidateAssets := candidate.GetAssets().Amount
+			levelTotal = levelTotal.Add(candidateAssets)
+			if candidate.PartialFillAllowed() {
+				partials = append(partials, candidate)
+				partialTotal = partialTotal.Add(candidateAssets)
+			}
+		}
+
+		if levelTotal.LTE(assetsLeft) {
+			matched = append(matched, level...)
+			assetsLeft = assetsLeft.Sub(levelTotal)
+			continue
+		}
+
+		if partialTotal.LTE(assetsLeft) {
+			assetsLeft = assetsLeft.Sub(partialTotal)
+			for _, candidate := range level {
+				if candidate.PartialFillAllowed() {
+					matched = append(matched, candidate)
+					continue
+				}
+				candidateAssets := candidate.GetAssets().Amount
+				if candidateAssets.LTE(assetsLeft) {
+					matched = append(matched, candidate)
+					assetsLeft = assetsLeft.Sub(candidateAssets)
+				}
+			}
+			continue
+		}
+
+		amounts := make([]sdkmath.Int, len(partials))
+		allocated := sdkmath.ZeroInt()
+		for k, partial := range partials {
+			amounts[k] = assetsLeft.Mul(partial.GetAssets().Amount).Quo(partialTotal)
+			allocated = allocated.Add(amounts[k])
+		}
+		// The leftover from rounding down is less than the number of orders, so each gets at most one more.
+		for k := int64(0); k < assetsLeft.Sub(allocated).Int64(); k++ {
+			amounts[k] = amounts[k].AddRaw(1)
+		}
+
+		for k, partial := range partials {
+			switch {
+			case amounts[k].IsZero():
+				continue
+			case amounts[k].Equal(partial.GetAssets().Amount):
+				matched = append(matched, partial)
+			default:
+				filled, unfilled, err := partial.Split(amounts[k])
+				if err != nil {
+					continue
+				}
+				matched = append(matched, filled)
+				left = append(left, unfilled)
+			}
+			assetsLeft = assetsLeft.Sub(amounts[k])
+		}
+		break
+	}
+
+	if len(matched) == 0 || (assetsLeft.IsPositive() && !order.PartialFillAllowed()) {
+		return nil, nil
+	}
+	return matched, left
+}
+
+// matchOrder settles a newly booked order against the resting orders in its market if the
+// market has continuous matching enabled and the order crosses the book. Basket orders are never matched.
+// If the order cannot be matched, the error is logged and the order is left in the book.
+func (k Keeper) matchOrder(ctx sdk.Context, order *exchange.Order) {
+	marketID := order.GetMarketID()
+	if order.IsBasketOrder() || !isContinuousMatchingEnabled(k.getStore(ctx), marketID) {
+		return
+	}
+
+	if err := k.settleMatchingOrders(ctx, order); err != nil {
+		k.logErrorf(ctx, "could not match %s order %d in market %d: %v", order.GetOrderType(), order.OrderId, marketID, err)
+	}
+}
+
+// settleMatchingOrders identifies the orders that can be matched with the provided one, and settles them.
+// If the market has self-trade prevention, it is first applied to the crossing orders with the same owner.
+// Nothing is changed if there's an error.
+func (k Keeper) settleMatchingOrders(ctx sdk.Context, order *exchange.Order) error {
+	cacheCtx, writeCache := ctx.CacheContext()
+	store := k.getStore(cacheCtx)
+	marketID := order.GetMarketID()
+
+	crossing, err := k.getCrossingOrders(cacheCtx, store, order)
+	if err != nil {
+		// Still try to match with the orders we could read.
+		k.logErrorf(ctx, "error reading orders to match with order %d: %v", order.OrderId, err)
+	}
+
+	mode := getSelfTradePrevention(store, marketID)
+	selfTraded := false
+	var candidates []*exchange.Order
+	for _, other := range crossing {
+		if other.GetOwner() != order.GetOwner() {
+			candidates = append(candidates, other)
+			continue
+		}
+		if mode == exchange.SelfTradePrevention_unspecified {
+			continue
+		}
+		order, _, err = k.preventSelfTrade(cacheCtx, store, mode, order, other)
+		if err != nil {
+			return err
+		}
+		selfTraded = true
+		if order == nil {
+			writeCache()
+			return nil
+		}
+	}
+
+	if selfTraded {
+		// An order might have been cancelled because an order linked to it was.
+		var stillBooked []*exchange.Order
+		for _, candidate := range candidates {
+			if store.Has(MakeKeyOrder(candidate.OrderId)) {
+				stillBooked = append(stillBooked, candidate)
+			}
+		}
+		candidates = stillBooked
+	}
+
+	matched, left := selectOrdersToFill(getFillAlgorithm(store, marketID), order, candidates)
+	if len(matched) == 0 {
+		if selfTraded {
+			writeCache()
+		}
+		return nil
+	}
+
+	askOrders, bidOrders := []*exchange.Order{order}, matched
+	if order.IsBidOrder() {
+		askOrders, bidOrders = matched, []*exchange.Order{order}
+	}
+
+	ratioGetter := func(denom string, isTaker bool) (*exchange.FeeRatio, error) {
+		return getSellerSettlementRatioFor(store, marketID, denom, isTaker)
+	}
+
+	settlement, err := exchange.BuildSettlement(askOrders, bidOrders, ratioGetter)
+	if err != nil {
+		return err
+	}
+
+	// The filled portion of each order that was split ahead of time was provided as a whole order,
+	// so it's one of the fully filled ones.
+	for _, unfilled := range left {
+		for i, filled := range settlement.FullyFilledOrders {
+			if filled.GetOrderID() == unfilled.OrderId {
+				settlement.OtherPartialOrders = append(settlement.OtherPartialOrders, &exchange.PartialOrder{Filled: filled, Left: unfilled})
+				settlement.FullyFilledOrders = append(settlement.FullyFilledOrders[:i], settlement.FullyFilledOrders[i+1:]...)
+				break
+			}
+		}
+	}
+
+	if err = k.closeSettlement(cacheCtx, store, marketID, settlement); err != nil {
+		return err
+	}
+
+	writeCache()
+	return nil
+}
@@ -0,0 +1,993 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/provenance-io/provenance/x/exchange"
+	"github.com/provenance-io/provenance/x/exchange/keeper"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestSelectMatchedOrders(t *testing.T) {
+	addr := sdk.AccAddress("addr________________")
+	coin := func(amount int64) sdk.Coin {
+		return sdk.NewInt64Coin("apple", amount)
+	}
+	askOrder := func(orderID uint64, assets int64, allowPartial bool) *exchange.Order {
+		return exchange.NewOrder(orderID).WithAsk(&exchange.AskOrder{
+			MarketId: 1, Seller: addr.String(), Assets: coin(assets),
+			Price: sdk.NewInt64Coin("peach", assets), AllowPartial: allowPartial,
+		})
+	}
+	bidOrder := func(orderID uint64, assets int64, allowPartial bool) *exchange.Order {
+		return exchange.NewOrder(orderID).WithBid(&exchange.BidOrder{
+			MarketId: 1, Buyer: addr.String(), Assets: coin(assets),
+			Price: sdk.NewInt64Coin("peach", assets), AllowPartial: allowPartial,
+		})
+	}
+
+	tests := []struct {
+		name       string
+		order      *exchange.Order
+		candidates []*exchange.Order
+		expIDs     []uint64
+	}{
+		{
+			name:       "no candidates",
+			order:      askOrder(1, 10, true),
+			candidates: nil,
+			expIDs:     nil,
+		},
+		{
+			name:       "one candidate: same amount",
+			order:      askOrder(1, 10, false),
+			candidates: []*exchange.Order{bidOrder(2, 10, false)},
+			expIDs:     []uint64{2},
+		},
+		{
+			name:       "one candidate: smaller, order allows partial",
+			order:      askOrder(1, 10, true),
+			candidates: []*exchange.Order{bidOrder(2, 6, false)},
+			expIDs:     []uint64{2},
+		},
+		{
+			name:       "one candidate: smaller, order does not allow partial",
+			order:      askOrder(1, 10, false),
+			candidates: []*exchange.Order{bidOrder(2, 6, true)},
+			expIDs:     nil,
+		},
+		{
+			name:       "one candidate: larger, allows partial",
+			order:      bidOrder(1, 10, false),
+			candidates: []*exchange.Order{askOrder(2, 15, true)},
+			expIDs:     []uint64{2},
+		},
+		{
+			name:       "one candidate: larger, does not allow partial",
+			order:      bidOrder(1, 10, true),
+			candidates: []*exchange.Order{askOrder(2, 15, false)},
+			expIDs:     nil,
+		},
+		{
+			name:  "three candidates: all used exactly",
+			order: bidOrder(1, 10, false),
+			candidates: []*exchange.Order{
+				askOrder(2, 3, false), askOrder(3, 3, false), askOrder(4, 4, false),
+			},
+			expIDs: []uint64{2, 3, 4},
+		},
+		{
+			name:  "three candidates: first two fill the order",
+			order: bidOrder(1, 10, false),
+			candidates: []*exchange.Order{
+				askOrder(2, 3, false), askOrder(3, 7, false), askOrder(4, 4, false),
+			},
+			expIDs: []uint64{2, 3},
+		},
+		{
+			name:  "three candidates: middle one too large and skipped",
+			order: bidOrder(1, 10, false),
+			candidates: []*exchange.Order{
+				askOrder(2, 3, false), askOrder(3, 8, false), askOrder(4, 7, false),
+			},
+			expIDs: []uint64{2, 4},
+		},
+		{
+			name:  "three candidates: middle one partially filled",
+			order: bidOrder(1, 10, false),
+			candidates: []*exchange.Order{
+				askOrder(2, 3, false), askOrder(3, 8, true), askOrder(4, 7, false),
+			},
+			expIDs: []uint64{2, 3},
+		},
+		{
+			name:  "three candidates: not enough, order allows partial",
+			order: askOrder(1, 10, true),
+			candidates: []*exchange.Order{
+				bidOrder(2, 3, false), bidOrder(3, 2, false), bidOrder(4, 4, false),
+			},
+			expIDs: []uint64{2, 3, 4},
+		},
+		{
+			name:  "three candidates: not enough, order does not allow partial",
+			order: askOrder(1, 10, false),
+			candidates: []*exchange.Order{
+				bidOrder(2, 3, false), bidOrder(3, 2, false), bidOrder(4, 4, false),
+			},
+			expIDs: nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual []*exchange.Order
+			testFunc := func() {
+				actual = keeper.SelectMatchedOrders(tc.order, tc.candidates)
+			}
+			if !assert.NotPanics(t, testFunc, "selectMatchedOrders") {
+				return
+			}
+			var actIDs []uint64
+			for _, order := range actual {
+				actIDs = append(actIDs, order.OrderId)
+			}
+			assert.Equal(t, tc.expIDs, actIDs, "selectMatchedOrders order ids")
+		})
+	}
+}
+
+func TestSelectOrdersToFill(t *testing.T) {
+	addr := sdk.AccAddress("addr________________")
+	askOrder := func(orderID uint64, assets, price int64, allowPartial bool) *exchange.Order {
+		return exchange.NewOrder(orderID).WithAsk(&exchange.AskOrder{
+			MarketId: 1, Seller: addr.String(), Assets: sdk.NewInt64Coin("apple", assets),
+			Price: sdk.NewInt64Coin("peach", price), AllowPartial: allowPartial,
+		})
+	}
+	bidOrder := func(orderID uint64, assets, price int64, allowPartial bool) *exchange.Order {
+		return exchange.NewOrder(orderID).WithBid(&exchange.BidOrder{
+			MarketId: 1, Buyer: addr.String(), Assets: sdk.NewInt64Coin("apple", assets),
+			Price: sdk.NewInt64Coin("peach", price), AllowPartial: allowPartial,
+		})
+	}
+	// toStrs converts each order to a string with the format "<order id>:<assets>".
+	toStrs := func(orders []*exchange.Order) []string {
+		if orders == nil {
+			return nil
+		}
+		rv := make([]string, len(orders))
+		for i, order := range orders {
+			rv[i] = fmt.Sprintf("%d:%s", order.OrderId, order.GetAssets())
+		}
+		return rv
+	}
+
+	tests := []struct {
+		name       string
+		algorithm  exchange.FillAlgorithm
+		order      *exchange.Order
+		candidates []*exchange.Order
+		expMatched []string
+		expLeft    []string
+	}{
+		{
+			name:      "unspecified: too large one is skipped",
+			algorithm: exchange.FillAlgorithm_unspecified,
+			order:     bidOrder(1, 10, 10, false),
+			candidates: []*exchange.Order{
+				askOrder(2, 3, 3, false), askOrder(3, 8, 8, false), askOrder(4, 7, 7, false),
+			},
+			expMatched: []string{"2:3apple", "4:7apple"},
+		},
+		{
+			name:      "price time: too large one stops selection",
+			algorithm: exchange.FillAlgorithm_price_time,
+			order:     bidOrder(1, 10, 10, true),
+			candidates: []*exchange.Order{
+				askOrder(2, 3, 3, false), askOrder(3, 8, 8, false), askOrder(4, 7, 7, false),
+			},
+			expMatched: []string{"2:3apple"},
+		},
+		{
+			name:      "price time: too large one stops selection, order does not allow partial",
+			algorithm: exchange.FillAlgorithm_price_time,
+			order:     bidOrder(1, 10, 10, false),
+			candidates: []*exchange.Order{
+				askOrder(2, 3, 3, false), askOrder(3, 8, 8, false), askOrder(4, 7, 7, false),
+			},
+			expMatched: nil,
+		},
+		{
+			name:      "price time: too large one partially filled",
+			algorithm: exchange.FillAlgorithm_price_time,
+			order:     bidOrder(1, 10, 10, false),
+			candidates: []*exchange.Order{
+				askOrder(2, 3, 3, false), askOrder(3, 8, 8, true), askOrder(4, 7, 7, false),
+			},
+			expMatched: []string{"2:3apple", "3:8apple"},
+		},
+		{
+			name:       "pro rata: no candidates",
+			algorithm:  exchange.FillAlgorithm_pro_rata,
+			order:      bidOrder(1, 10, 10, true),
+			candidates: nil,
+			expMatched: nil,
+		},
+		{
+			name:      "pro rata: all levels fit",
+			algorithm: exchange.FillAlgorithm_pro_rata,
+			order:     askOrder(1, 10, 10, true),
+			candidates: []*exchange.Order{
+				bidOrder(2, 3, 6, false), bidOrder(3, 2, 4, false), bidOrder(4, 4, 4, false),
+			},
+			expMatched: []string{"2:3apple", "3:2apple", "4:4apple"},
+		},
+		{
+			name:      "pro rata: shared proportionally without rounding",
+			algorithm: exchange.FillAlgorithm_pro_rata,
+			order:     bidOrder(1, 5, 5, false),
+			candidates: []*exchange.Order{
+				askOrder(2, 6, 6, true), askOrder(3, 4, 4, true),
+			},
+			expMatched: []string{"2:3apple", "3:2apple"},
+			expLeft:    []string{"2:3apple", "3:2apple"},
+		},
+		{
+			name:      "pro rata: rounding remainder goes to lowest order ids",
+			algorithm: exchange.FillAlgorithm_pro_rata,
+			order:     bidOrder(1, 5, 5, false),
+			candidates: []*exchange.Order{
+				askOrder(2, 4, 4, true), askOrder(3, 4, 4, true), askOrder(4, 4, 4, true),
+			},
+			expMatched: []string{"2:2apple", "3:2apple", "4:1apple"},
+			expLeft:    []string{"2:2apple", "3:2apple", "4:3apple"},
+		},
+		{
+			name:      "pro rata: order that rounds to zero is not matched",
+			algorithm: exchange.FillAlgorithm_pro_rata,
+			order:     bidOrder(1, 2, 2, false),
+			candidates: []*exchange.Order{
+				askOrder(2, 10, 10, true), askOrder(3, 1, 1, true),
+			},
+			expMatched: []string{"2:2apple"},
+			expLeft:    []string{"2:8apple"},
+		},
+		{
+			name:      "pro rata: better level taken in full before sharing the next one",
+			algorithm: exchange.FillAlgorithm_pro_rata,
+			order:     bidOrder(1, 8, 40, false),
+			candidates: []*exchange.Order{
+				askOrder(2, 2, 4, false), askOrder(3, 4, 12, true), askOrder(4, 8, 24, true), askOrder(5, 5, 20, true),
+			},
+			expMatched: []string{"2:2apple", "3:2apple", "4:4apple"},
+			expLeft:    []string{"3:2apple", "4:4apple"},
+		},
+		{
+			name:      "pro rata: order without partial fills left out of sharing",
+			algorithm: exchange.FillAlgorithm_pro_rata,
+			order:     bidOrder(1, 6, 6, false),
+			candidates: []*exchange.Order{
+				askOrder(2, 3, 3, false), askOrder(3, 6, 6, true), askOrder(4, 6, 6, true),
+			},
+			expMatched: []string{"3:3apple", "4:3apple"},
+			expLeft:    []string{"3:3apple", "4:3apple"},
+		},
+		{
+			name:      "pro rata: partial fill orders fit, whole order taken with what's left",
+			algorithm: exchange.FillAlgorithm_pro_rata,
+			order:     bidOrder(1, 6, 6, false),
+			candidates: []*exchange.Order{
+				askOrder(2, 5, 5, false), askOrder(3, 2, 2, false), askOrder(4, 4, 4, true),
+			},
+			expMatched: []string{"3:2apple", "4:4apple"},
+		},
+		{
+			name:      "pro rata: fee not evenly divisible, order skipped",
+			algorithm: exchange.FillAlgorithm_pro_rata,
+			order:     bidOrder(1, 4, 4, true),
+			candidates: []*exchange.Order{
+				askOrder(2, 4, 4, true),
+				exchange.NewOrder(3).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: addr.String(), Assets: sdk.NewInt64Coin("apple", 4),
+					Price: sdk.NewInt64Coin("peach", 4), AllowPartial: true,
+					SellerSettlementFlatFee: &sdk.Coin{Denom: "peach", Amount: sdkmath.NewInt(1)},
+				}),
+			},
+			expMatched: []string{"2:2apple"},
+			expLeft:    []string{"2:2apple"},
+		},
+		{
+			name:      "pro rata: order would be partially filled but does not allow it",
+			algorithm: exchange.FillAlgorithm_pro_rata,
+			order:     bidOrder(1, 10, 10, false),
+			candidates: []*exchange.Order{
+				askOrder(2, 3, 3, false), askOrder(3, 2, 2, true),
+			},
+			expMatched: nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var matched, left []*exchange.Order
+			testFunc := func() {
+				matched, left = keeper.SelectOrdersToFill(tc.algorithm, tc.order, tc.candidates)
+			}
+			if !assert.NotPanics(t, testFunc, "selectOrdersToFill") {
+				return
+			}
+			assert.Equal(t, tc.expMatched, toStrs(matched), "selectOrdersToFill matched")
+			assert.Equal(t, tc.expLeft, toStrs(left), "selectOrdersToFill left")
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_GetMatchableOrders() {
+	blockTime := time.Unix(1_700_000_000, 0).UTC()
+	past := blockTime.Add(-1 * time.Hour)
+	future := blockTime.Add(time.Hour)
+	askOrder := func(orderID uint64, marketID uint32, seller sdk.AccAddress, assets, price string) *exchange.Order {
+		return exchange.NewOrder(orderID).WithAsk(&exchange.AskOrder{
+			MarketId: marketID, Seller: seller.String(), Assets: s.coin(assets), Price: s.coin(price),
+		})
+	}
+	bidOrder := func(orderID uint64, marketID uint32, buyer sdk.AccAddress, assets, price string) *exchange.Order {
+		return exchange.NewOrder(orderID).WithBid(&exchange.BidOrder{
+			MarketId: marketID, Buyer: buyer.String(), Assets: s.coin(assets), Price: s.coin(price),
+		})
+	}
+	withExpiration := func(order *exchange.Order, expiration time.Time) *exchange.Order {
+		if order.IsAskOrder() {
+			order.GetAskOrder().Expiration = &expiration
+		} else {
+			order.GetBidOrder().Expiration = &expiration
+		}
+		return order
+	}
+
+	// Order book in market 1:
+	// Asks (sorted best first): 5 (1/apple), 2 (2/apple), 3 (2/apple), 9 (2/apple), 4 (3/apple).
+	// Bids (sorted best first): 12 (6/apple), 11 (5/apple), 13 (5/apple), 14 (4/apple).
+	book := []*exchange.Order{
+		askOrder(2, 1, s.addr1, "10apple", "20peach"),
+		askOrder(3, 1, s.addr2, "5apple", "10peach"),
+		askOrder(4, 1, s.addr3, "2apple", "6peach"),
+		askOrder(5, 1, s.addr1, "3apple", "3peach"),
+		askOrder(6, 1, s.addr2, "10banana", "1peach"),
+		askOrder(7, 1, s.addr3, "10apple", "1plum"),
+		withExpiration(askOrder(8, 1, s.addr3, "10apple", "1peach"), past),
+		withExpiration(askOrder(9, 1, s.addr3, "10apple", "20peach"), future),
+		askOrder(10, 2, s.addr3, "10apple", "1peach"),
+		bidOrder(11, 1, s.addr1, "2apple", "10peach"),
+		bidOrder(12, 1, s.addr2, "1apple", "6peach"),
+		bidOrder(13, 1, s.addr3, "4apple", "20peach"),
+		bidOrder(14, 1, s.addr1, "5apple", "20peach"),
+		bidOrder(15, 1, s.addr2, "10banana", "100peach"),
+		withExpiration(bidOrder(16, 1, s.addr3, "1apple", "100peach"), past),
+		bidOrder(17, 2, s.addr3, "1apple", "100peach"),
+	}
+
+	tests := []struct {
+		name   string
+		order  *exchange.Order
+		expIDs []uint64
+		expErr string
+	}{
+		{
+			name:   "ask: crosses all bids",
+			order:  askOrder(1, 1, s.addr4, "1apple", "1peach"),
+			expIDs: []uint64{12, 11, 13, 14},
+		},
+		{
+			name:   "ask: crosses some bids",
+			order:  askOrder(1, 1, s.addr4, "2apple", "10peach"),
+			expIDs: []uint64{12, 11, 13},
+		},
+		{
+			name:   "ask: crosses no bids",
+			order:  askOrder(1, 1, s.addr4, "1apple", "7peach"),
+			expIDs: nil,
+		},
+		{
+			name:   "ask: skips own bids",
+			order:  askOrder(1, 1, s.addr1, "1apple", "1peach"),
+			expIDs: []uint64{12, 13},
+		},
+		{
+			name:   "ask: different price denom",
+			order:  askOrder(1, 1, s.addr4, "1apple", "1plum"),
+			expIDs: nil,
+		},
+		{
+			name:   "bid: crosses all asks",
+			order:  bidOrder(1, 1, s.addr4, "1apple", "5peach"),
+			expIDs: []uint64{5, 2, 3, 9, 4},
+		},
+		{
+			name:   "bid: crosses some asks",
+			order:  bidOrder(1, 1, s.addr4, "5apple", "10peach"),
+			expIDs: []uint64{5, 2, 3, 9},
+		},
+		{
+			name:   "bid: crosses no asks",
+			order:  bidOrder(1, 1, s.addr4, "10apple", "5peach"),
+			expIDs: nil,
+		},
+		{
+			name:   "bid: skips own asks",
+			order:  bidOrder(1, 1, s.addr3, "1apple", "5peach"),
+			expIDs: []uint64{5, 2, 3},
+		},
+		{
+			name:   "bid: different assets denom",
+			order:  bidOrder(1, 1, s.addr4, "1banana", "5peach"),
+			expIDs: []uint64{6},
+		},
+		{
+			name:   "bid: other market",
+			order:  bidOrder(1, 2, s.addr4, "1apple", "5peach"),
+			expIDs: []uint64{10},
+		},
+		{
+			name:   "bid: empty market",
+			order:  bidOrder(1, 3, s.addr4, "1apple", "5peach"),
+			expIDs: nil,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			store := s.getStore()
+			s.requireSetOrdersInStore(store, book...)
+
+			ctx := s.ctx.WithBlockTime(blockTime)
+			var actual []*exchange.Order
+			var err error
+			testFunc := func() {
+				actual, err = s.k.GetMatchableOrders(ctx, tc.order)
+			}
+			s.Require().NotPanics(testFunc, "getMatchableOrders")
+			s.assertErrorValue(err, tc.expErr, "getMatchableOrders error")
+			var actIDs []uint64
+			for _, order := range actual {
+				actIDs = append(actIDs, order.OrderId)
+			}
+			s.Assert().Equal(tc.expIDs, actIDs, "getMatchableOrders order ids")
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_MatchOrder() {
+	appleMarker := s.markerAccount("1000000000apple")
+
+	tests := []struct {
+		name           string
+		bankKeeper     *MockBankKeeper
+		holdKeeper     *MockHoldKeeper
+		markerKeeper   *MockMarkerKeeper
+		notContinuous  bool
+		stp            exchange.SelfTradePrevention
+		fillAlgorithm  exchange.FillAlgorithm
+		book           []*exchange.Order
+		order          *exchange.Order
+		expEvents      []proto.Message
+		expLeft        []*exchange.Order
+		expGone        []uint64
+		expHoldCalls   HoldCalls
+		expBankCalls   BankCalls
+		expMarkerCalls MarkerCalls
+		expLog         string
+	}{
+		{
+			name:          "continuous matching not enabled",
+			notContinuous: true,
+			book: []*exchange.Order{
+				exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+				}),
+			},
+			order: exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+				MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+			}),
+			expLeft: []*exchange.Order{
+				exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+				}),
+				exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+				}),
+			},
+		},
+		{
+			name: "does not cross the book",
+			book: []*exchange.Order{
+				exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("6peach"),
+				}),
+			},
+			order: exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+				MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+			}),
+			expLeft: []*exchange.Order{
+				exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("6peach"),
+				}),
+				exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+				}),
+			},
+		},
+		{
+			name: "new order would be partially filled but does not allow it",
+			book: []*exchange.Order{
+				exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+				}),
+			},
+			order: exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+				MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("2apple"), Price: s.coin("10peach"),
+			}),
+			expLeft: []*exchange.Order{
+				exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+				}),
+				exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("2apple"), Price: s.coin("10peach"),
+				}),
+			},
+		},
+		{
+			name: "new order is a basket order",
+			book: []*exchange.Order{
+				exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+				}),
+			},
+			order: exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+				MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+				BasketAssets: s.coins("2banana"),
+			}),
+			expLeft: []*exchange.Order{
+				exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+				}),
+				exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+					BasketAssets: s.coins("2banana"),
+				}),
+			},
+		},
+		{
+			name: "only crossing order is a basket order",
+			book: []*exchange.Order{
+				exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+					BasketAssets: s.coins("2banana"),
+				}),
+			},
+			order: exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+				MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+			}),
+			expLeft: []*exchange.Order{
+				exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+					BasketAssets: s.coins("2banana"),
+				}),
+				exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+				}),
+			},
+		},
+		{
+			name:         "new bid fully matches one ask",
+			markerKeeper: NewMockMarkerKeeper().WithGetMarkerAccount(appleMarker),
+			book: []*exchange.Order{
+				exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+				}),
+			},
+			order: exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+				MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+			}),
+			expEvents: []proto.Message{
+				&exchange.EventOrderFilled{OrderId: 1, Assets: "1apple", Price: "5peach", MarketId: 1},
+				&exchange.EventOrderFilled{OrderId: 2, Assets: "1apple", Price: "5peach", MarketId: 1},
+			},
+			expGone: []uint64{1, 2},
+			expHoldCalls: HoldCalls{
+				ReleaseHold: []*ReleaseHoldArgs{
+					{addr: s.addr1, funds: s.coins("1apple")},
+					{addr: s.addr2, funds: s.coins("5peach")},
+				},
+			},
+			expBankCalls: BankCalls{
+				BlockedAddr: []sdk.AccAddress{s.addr2, s.addr1},
+				SendCoins: []*SendCoinsArgs{
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr1, toAddr: s.addr2, amt: s.coins("1apple")},
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr2, toAddr: s.addr1, amt: s.coins("5peach")},
+				},
+			},
+			expMarkerCalls: MarkerCalls{
+				GetMarker: []sdk.AccAddress{appleMarker.GetAddress()},
+				AddSetNetAssetValues: []*AddSetNetAssetValuesArgs{
+					{
+						marker:         appleMarker,
+						netAssetValues: []markertypes.NetAssetValue{{Price: s.coin("5peach"), Volume: 1}},
+						source:         "x/exchange market 1",
+					},
+				},
+			},
+		},
+		{
+			name:         "new ask partially fills a resting bid",
+			markerKeeper: NewMockMarkerKeeper().WithGetMarkerAccount(appleMarker),
+			book: []*exchange.Order{
+				exchange.NewOrder(1).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr1.String(), Assets: s.coin("10apple"), Price: s.coin("50peach"),
+					AllowPartial: true,
+				}),
+				exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("40peach"),
+				}),
+			},
+			order: exchange.NewOrder(3).WithAsk(&exchange.AskOrder{
+				MarketId: 1, Seller: s.addr3.String(), Assets: s.coin("4apple"), Price: s.coin("12peach"),
+			}),
+			expEvents: []proto.Message{
+				&exchange.EventOrderFilled{OrderId: 3, Assets: "4apple", Price: "20peach", MarketId: 1},
+				&exchange.EventOrderPartiallyFilled{OrderId: 1, Assets: "4apple", Price: "20peach", MarketId: 1},
+				&exchange.EventOrderPartialFill{
+					OrderId: 1, OrderType: "bid", MarketId: 1, Owner: s.addr1.String(),
+					FilledAssets: "4apple", FilledPrice: "20peach", RemainingAssets: "6apple", RemainingPrice: "30peach",
+					Participants: []*exchange.FillParticipant{
+						{OrderId: 3, OrderType: "ask", Owner: s.addr3.String(), Assets: "4apple", Price: "20peach"},
+						{OrderId: 1, OrderType: "bid", Owner: s.addr1.String(), Assets: "4apple", Price: "20peach", Partial: true},
+					},
+				},
+			},
+			expLeft: []*exchange.Order{
+				exchange.NewOrder(1).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr1.String(), Assets: s.coin("6apple"), Price: s.coin("30peach"),
+					AllowPartial: true,
+				}),
+				exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("40peach"),
+				}),
+			},
+			expGone: []uint64{3},
+			expHoldCalls: HoldCalls{
+				ReleaseHold: []*ReleaseHoldArgs{
+					{addr: s.addr3, funds: s.coins("4apple")},
+					{addr: s.addr1, funds: s.coins("20peach")},
+				},
+			},
+			expBankCalls: BankCalls{
+				BlockedAddr: []sdk.AccAddress{s.addr1, s.addr3},
+				SendCoins: []*SendCoinsArgs{
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr3, toAddr: s.addr1, amt: s.coins("4apple")},
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr1, toAddr: s.addr3, amt: s.coins("20peach")},
+				},
+			},
+			expMarkerCalls: MarkerCalls{
+				GetMarker: []sdk.AccAddress{appleMarker.GetAddress()},
+				AddSetNetAssetValues: []*AddSetNetAssetValuesArgs{
+					{
+						marker:         appleMarker,
+						netAssetValues: []markertypes.NetAssetValue{{Price: s.coin("20peach"), Volume: 4}},
+						source:         "x/exchange market 1",
+					},
+				},
+			},
+		},
+		{
+			name:          "pro rata: new bid shared by two resting asks",
+			fillAlgorithm: exchange.FillAlgorithm_pro_rata,
+			markerKeeper:  NewMockMarkerKeeper().WithGetMarkerAccount(appleMarker),
+			book: []*exchange.Order{
+				exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("6apple"), Price: s.coin("30peach"),
+					AllowPartial: true,
+				}),
+				exchange.NewOrder(2).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr2.String(), Assets: s.coin("4apple"), Price: s.coin("20peach"),
+					AllowPartial: true,
+				}),
+			},
+			order: exchange.NewOrder(3).WithBid(&exchange.BidOrder{
+				MarketId: 1, Buyer: s.addr3.String(), Assets: s.coin("5apple"), Price: s.coin("25peach"),
+			}),
+			expEvents: []proto.Message{
+				&exchange.EventOrderFilled{OrderId: 3, Assets: "5apple", Price: "25peach", MarketId: 1},
+				&exchange.EventOrderPartiallyFilled{OrderId: 1, Assets: "3apple", Price: "15peach", MarketId: 1},
+				&exchange.EventOrderPartialFill{
+					OrderId: 1, OrderType: "ask", MarketId: 1, Owner: s.addr1.String(),
+					FilledAssets: "3apple", FilledPrice: "15peach", RemainingAssets: "3apple", RemainingPrice: "15peach",
+					Participants: []*exchange.FillParticipant{
+						{OrderId: 3, OrderType: "bid", Owner: s.addr3.String(), Assets: "5apple", Price: "25peach"},
+						{OrderId: 1, OrderType: "ask", Owner: s.addr1.String(), Assets: "3apple", Price: "15peach", Partial: true},
+						{OrderId: 2, OrderType: "ask", Owner: s.addr2.String(), Assets: "2apple", Price: "10peach", Partial: true},
+					},
+				},
+				&exchange.EventOrderPartiallyFilled{OrderId: 2, Assets: "2apple", Price: "10peach", MarketId: 1},
+				&exchange.EventOrderPartialFill{
+					OrderId: 2, OrderType: "ask", MarketId: 1, Owner: s.addr2.String(),
+					FilledAssets: "2apple", FilledPrice: "10peach", RemainingAssets: "2apple", RemainingPrice: "10peach",
+					Participants: []*exchange.FillParticipant{
+						{OrderId: 3, OrderType: "bid", Owner: s.addr3.String(), Assets: "5apple", Price: "25peach"},
+						{OrderId: 1, OrderType: "ask", Owner: s.addr1.String(), Assets: "3apple", Price: "15peach", Partial: true},
+						{OrderId: 2, OrderType: "ask", Owner: s.addr2.String(), Assets: "2apple", Price: "10peach", Partial: true},
+					},
+				},
+			},
+			expLeft: []*exchange.Order{
+				exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("3apple"), Price: s.coin("15peach"),
+					AllowPartial: true,
+				}),
+				exchange.NewOrder(2).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr2.String(), Assets: s.coin("2apple"), Price: s.coin("10peach"),
+					AllowPartial: true,
+				}),
+			},
+			expGone: []uint64{3},
+			expHoldCalls: HoldCalls{
+				ReleaseHold: []*ReleaseHoldArgs{
+					{addr: s.addr3, funds: s.coins("25peach")},
+					{addr: s.addr1, funds: s.coins("3apple")},
+					{addr: s.addr2, funds: s.coins("2apple")},
+				},
+			},
+			expBankCalls: BankCalls{
+				BlockedAddr: []sdk.AccAddress{s.addr3, s.addr3, s.addr1, s.addr2},
+				SendCoins: []*SendCoinsArgs{
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr1, toAddr: s.addr3, amt: s.coins("3apple")},
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr2, toAddr: s.addr3, amt: s.coins("2apple")},
+				},
+				InputOutputCoins: []*InputOutputCoinsArgs{
+					{
+						ctxHasQuarantineBypass: true,
+						inputs:                 []banktypes.Input{{Address: s.addr3.String(), Coins: s.coins("25peach")}},
+						outputs: []banktypes.Output{
+							{Address: s.addr1.String(), Coins: s.coins("15peach")},
+							{Address: s.addr2.String(), Coins: s.coins("10peach")},
+						},
+					},
+				},
+			},
+			expMarkerCalls: MarkerCalls{
+				GetMarker: []sdk.AccAddress{appleMarker.GetAddress()},
+				AddSetNetAssetValues: []*AddSetNetAssetValuesArgs{
+					{
+						marker:         appleMarker,
+						netAssetValues: []markertypes.NetAssetValue{{Price: s.coin("25peach"), Volume: 5}},
+						source:         "x/exchange market 1",
+					},
+				},
+			},
+		},
+		{
+			name: "crosses own order without self-trade prevention",
+			book: []*exchange.Order{
+				exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+				}),
+			},
+			order: exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+				MarketId: 1, Buyer: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+			}),
+			expLeft: []*exchange.Order{
+				exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+				}),
+				exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+				}),
+			},
+		},
+		{
+			name: "self-trade prevention: cancel newest",
+			stp:  exchange.SelfTradePrevention_cancel_newest,
+			book: []*exchange.Order{
+				exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+				}),
+				exchange.NewOrder(2).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr3.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+				}),
+			},
+			order: exchange.NewOrder(3).WithBid(&exchange.BidOrder{
+				MarketId: 1, Buyer: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+			}),
+			expEvents: []proto.Message{
+				&exchange.EventSelfTradePrevented{OrderId: 3, OpposingOrderId: 1, MarketId: 1, Assets: "1apple"},
+			},
+			expLeft: []*exchange.Order{
+				exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+				}),
+				exchange.NewOrder(2).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr3.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+				}),
+			},
+			expGone: []uint64{3},
+			expHoldCalls: HoldCalls{
+				ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr1, funds: s.coins("5peach")}},
+			},
+		},
+		{
+			name:         "self-trade prevention: cancel oldest",
+			stp:          exchange.SelfTradePrevention_cancel_oldest,
+			markerKeeper: NewMockMarkerKeeper().WithGetMarkerAccount(appleMarker),
+			book: []*exchange.Order{
+				exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+				}),
+				exchange.NewOrder(2).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr3.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+				}),
+			},
+			order: exchange.NewOrder(3).WithBid(&exchange.BidOrder{
+				MarketId: 1, Buyer: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+			}),
+			expEvents: []proto.Message{
+				&exchange.EventSelfTradePrevented{OrderId: 1, OpposingOrderId: 3, MarketId: 1, Assets: "1apple"},
+				&exchange.EventOrderFilled{OrderId: 2, Assets: "1apple", Price: "5peach", MarketId: 1},
+				&exchange.EventOrderFilled{OrderId: 3, Assets: "1apple", Price: "5peach", MarketId: 1},
+			},
+			expGone: []uint64{1, 2, 3},
+			expHoldCalls: HoldCalls{
+				ReleaseHold: []*ReleaseHoldArgs{
+					{addr: s.addr1, funds: s.coins("1apple")},
+					{addr: s.addr3, funds: s.coins("1apple")},
+					{addr: s.addr1, funds: s.coins("5peach")},
+				},
+			},
+			expBankCalls: BankCalls{
+				BlockedAddr: []sdk.AccAddress{s.addr1, s.addr3},
+				SendCoins: []*SendCoinsArgs{
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr3, toAddr: s.addr1, amt: s.coins("1apple")},
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr1, toAddr: s.addr3, amt: s.coins("5peach")},
+				},
+			},
+			expMarkerCalls: MarkerCalls{
+				GetMarker: []sdk.AccAddress{appleMarker.GetAddress()},
+				AddSetNetAssetValues: []*AddSetNetAssetValuesArgs{
+					{
+						marker:         appleMarker,
+						netAssetValues: []markertypes.NetAssetValue{{Price: s.coin("5peach"), Volume: 1}},
+						source:         "x/exchange market 1",
+					},
+				},
+			},
+		},
+		{
+			name: "self-trade prevention: decrement both",
+			stp:  exchange.SelfTradePrevention_decrement_both,
+			book: []*exchange.Order{
+				exchange.NewOrder(1).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr1.String(), Assets: s.coin("10apple"), Price: s.coin("50peach"),
+					AllowPartial: true,
+				}),
+			},
+			order: exchange.NewOrder(2).WithAsk(&exchange.AskOrder{
+				MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("4apple"), Price: s.coin("12peach"),
+			}),
+			expEvents: []proto.Message{
+				&exchange.EventSelfTradePrevented{OrderId: 2, OpposingOrderId: 1, MarketId: 1, Assets: "4apple"},
+				&exchange.EventSelfTradePrevented{OrderId: 1, OpposingOrderId: 2, MarketId: 1, Assets: "4apple"},
+			},
+			expLeft: []*exchange.Order{
+				exchange.NewOrder(1).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr1.String(), Assets: s.coin("6apple"), Price: s.coin("30peach"),
+					AllowPartial: true,
+				}),
+			},
+			expGone: []uint64{2},
+			expHoldCalls: HoldCalls{
+				ReleaseHold: []*ReleaseHoldArgs{
+					{addr: s.addr1, funds: s.coins("4apple")},
+					{addr: s.addr1, funds: s.coins("20peach")},
+				},
+			},
+		},
+		{
+			name:       "error settling",
+			bankKeeper: NewMockBankKeeper().WithSendCoinsResults("injected send error"),
+			book: []*exchange.Order{
+				exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+				}),
+			},
+			order: exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+				MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+			}),
+			expLeft: []*exchange.Order{
+				exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+				}),
+				exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+				}),
+			},
+			expHoldCalls: HoldCalls{
+				ReleaseHold: []*ReleaseHoldArgs{
+					{addr: s.addr1, funds: s.coins("1apple")},
+					{addr: s.addr2, funds: s.coins("5peach")},
+				},
+			},
+			expBankCalls: BankCalls{
+				BlockedAddr: []sdk.AccAddress{s.addr2, s.addr1},
+				SendCoins: []*SendCoinsArgs{
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr1, toAddr: s.addr2, amt: s.coins("1apple")},
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr2, toAddr: s.addr1, amt: s.coins("5peach")},
+				},
+			},
+			expLog: "ERR could not match bid order 2 in market 1: injected send error module=x/exchange\n",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			s.requireCreateMarket(exchange.Market{
+				MarketId:            1,
+				ContinuousMatching:  !tc.notContinuous,
+				SelfTradePrevention: tc.stp,
+				FillAlgorithm:       tc.fillAlgorithm,
+			})
+			store := s.getStore()
+			s.requireSetOrdersInStore(store, tc.book...)
+			s.requireSetOrderInStore(store, tc.order)
+
+			if tc.bankKeeper == nil {
+				tc.bankKeeper = NewMockBankKeeper()
+			}
+			if tc.holdKeeper == nil {
+				tc.holdKeeper = NewMockHoldKeeper()
+			}
+			if tc.markerKeeper == nil {
+				tc.markerKeeper = NewMockMarkerKeeper()
+			}
+
+			expEvents := untypeEvents(s, tc.expEvents)
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			kpr := s.k.WithBankKeeper(tc.bankKeeper).
+				WithHoldKeeper(tc.holdKeeper).
+				WithMarkerKeeper(tc.markerKeeper)
+			s.logBuffer.Reset()
+			testFunc := func() {
+				kpr.MatchOrder(ctx, tc.order)
+			}
+			s.Require().NotPanics(testFunc, "matchOrder")
+			s.assertEqualEvents(expEvents, em.Events(), "matchOrder events")
+			s.assertHoldKeeperCalls(tc.holdKeeper, tc.expHoldCalls, "matchOrder")
+			s.assertBankKeeperCalls(tc.bankKeeper, tc.expBankCalls, "matchOrder")
+			s.assertMarkerKeeperCalls(tc.markerKeeper, tc.expMarkerCalls, "matchOrder")
+			actLog := s.getLogOutput("matchOrder")
+			s.Assert().Equal(tc.expLog, actLog, "matchOrder log output")
+
+			for _, expOrder := range tc.expLeft {
+				order, err := s.k.GetOrder(s.ctx, expOrder.OrderId)
+				s.Assert().NoError(err, "GetOrder(%d) error", expOrder.OrderId)
+				s.Assert().Equal(expOrder, order, "GetOrder(%d)", expOrder.OrderId)
+			}
+			for _, orderID := range tc.expGone {
+				order, err := s.k.GetOrder(s.ctx, orderID)
+				s.Assert().NoError(err, "GetOrder(%d) error", orderID)
+				s.Assert().Nil(order, "GetOrder(%d)", orderID)
+			}
+		})
+	}
+}
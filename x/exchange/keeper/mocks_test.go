@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 
 	sdkmath "cosmossdk.io/math"
@@ -256,6 +257,7 @@ type MockBankKeeper struct {
 	SendCoinsFromAccountToModuleResultsQueue []string
 	InputOutputCoinsResultsQueue             []string
 	BlockedAddrQueue                         []bool
+	SpendableCoinsQueue                      []sdk.Coins
 }
 
 // BankCalls contains all the calls that the mock bank keeper makes.
@@ -264,6 +266,7 @@ type BankCalls struct {
 	SendCoinsFromAccountToModule []*SendCoinsFromAccountToModuleArgs
 	InputOutputCoins             []*InputOutputCoinsArgs
 	BlockedAddr                  []sdk.AccAddress
+	SpendableCoins               []sdk.AccAddress
 }
 
 // SendCoinsArgs is a record of a call that is made to SendCoins.
@@ -331,6 +334,14 @@ func (k *MockBankKeeper) WithBlockedAddrResults(results ...bool) *MockBankKeeper
 	return k
 }
 
+// WithSpendableCoinsResults queues up the provided coins to be returned from SpendableCoins.
+// Each entry is used only once. If entries run out, nil is returned.
+// This method both updates the receiver and returns it.
+func (k *MockBankKeeper) WithSpendableCoinsResults(results ...sdk.Coins) *MockBankKeeper {
+	k.SpendableCoinsQueue = append(k.SpendableCoinsQueue, results...)
+	return k
+}
+
 func (k *MockBankKeeper) SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
 	k.Calls.SendCoins = append(k.Calls.SendCoins, NewSendCoinsArgs(ctx, fromAddr, toAddr, amt))
 	var err error
@@ -378,6 +389,16 @@ func (k *MockBankKeeper) BlockedAddr(addr sdk.AccAddress) bool {
 	return rv
 }
 
+func (k *MockBankKeeper) SpendableCoins(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	k.Calls.SpendableCoins = append(k.Calls.SpendableCoins, addr)
+	var rv sdk.Coins
+	if len(k.SpendableCoinsQueue) > 0 {
+		rv = k.SpendableCoinsQueue[0]
+		k.SpendableCoinsQueue = k.SpendableCoinsQueue[1:]
+	}
+	return rv
+}
+
 // assertSendCoinsCalls asserts that a mock keeper's Calls.SendCoins match the provided expected calls.
 func (s *TestSuite) assertSendCoinsCalls(mk *MockBankKeeper, expected []*SendCoinsArgs, msg string, args ...interface{}) bool {
 	s.T().Helper()
@@ -407,12 +428,20 @@ func (s *TestSuite) assertBlockedAddrCalls(mk *MockBankKeeper, expected []sdk.Ac
 		msg+" BlockedAddr calls", args...)
 }
 
+// assertSpendableCoinsCalls asserts that a mock keeper's Calls.SpendableCoins match the provided expected calls.
+func (s *TestSuite) assertSpendableCoinsCalls(mk *MockBankKeeper, expected []sdk.AccAddress, msg string, args ...interface{}) bool {
+	s.T().Helper()
+	return assertEqualSlice(s, expected, mk.Calls.SpendableCoins, s.getAddrName,
+		msg+" SpendableCoins calls", args...)
+}
+
 // assertBankKeeperCalls asserts that all the calls made to a mock bank keeper match the provided expected calls.
 func (s *TestSuite) assertBankKeeperCalls(mk *MockBankKeeper, expected BankCalls, msg string, args ...interface{}) bool {
 	s.T().Helper()
 	rv := s.assertSendCoinsCalls(mk, expected.SendCoins, msg, args...)
 	rv = s.assertInputOutputCoinsCalls(mk, expected.InputOutputCoins, msg, args...) && rv
 	rv = s.assertSendCoinsFromAccountToModuleCalls(mk, expected.SendCoinsFromAccountToModule, msg, args...) && rv
+	rv = s.assertSpendableCoinsCalls(mk, expected.SpendableCoins, msg, args...) && rv
 	return s.assertBlockedAddrCalls(mk, expected.BlockedAddr, msg, args...) && rv
 }
 
@@ -638,6 +667,30 @@ func (k *MockHoldKeeper) GetHoldCoin(_ sdk.Context, addr sdk.AccAddress, denom s
 	return sdk.NewInt64Coin(denom, 0), nil
 }
 
+// IterateAllHolds iterates over the non-error GetHoldCoin results, ordered by address then denom.
+// These calls are not recorded.
+func (k *MockHoldKeeper) IterateAllHolds(_ sdk.Context, process func(sdk.AccAddress, sdk.Coin) bool) error {
+	addrs := make([]string, 0, len(k.GetHoldCoinResultsMap))
+	for addr := range k.GetHoldCoinResultsMap {
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+	for _, addr := range addrs {
+		denomMap := k.GetHoldCoinResultsMap[addr]
+		denoms := make([]string, 0, len(denomMap))
+		for denom := range denomMap {
+			denoms = append(denoms, denom)
+		}
+		sort.Strings(denoms)
+		for _, denom := range denoms {
+			if rv := denomMap[denom]; rv.err == nil && process(sdk.AccAddress(addr), sdk.NewCoin(denom, rv.amount)) {
+				return nil
+			}
+		}
+	}
+	return nil
+}
+
 // assertAddHoldCalls asserts that a mock keeper's Calls.AddHold match the provided expected calls.
 func (s *TestSuite) assertAddHoldCalls(mk *MockHoldKeeper, expected []*AddHoldArgs, msg string, args ...interface{}) bool {
 	s.T().Helper()
@@ -1099,3 +1152,146 @@ func (p MDGetNetAssetValueResult) Err() error {
 	}
 	return errors.New(p.B)
 }
+
+// #############################################################################
+// ###########################                      ############################
+// #########################   MockExchangeHooks   #############################
+// ###########################                      ############################
+// #############################################################################
+
+var _ exchange.ExchangeHooks = (*MockExchangeHooks)(nil)
+
+// MockExchangeHooks satisfies the exchange.ExchangeHooks interface but just records the calls and allows dictation of results.
+type MockExchangeHooks struct {
+	Calls     ExchangeHooksCalls
+	ErrResult string
+}
+
+// ExchangeHooksCalls contains all the calls that the mock exchange hooks receives.
+type ExchangeHooksCalls struct {
+	OnOrderCreated      []uint64
+	OnOrderFilled       []*OnOrderFilledArgs
+	OnOrderCancelled    []*OnOrderCancelledArgs
+	OnCommitmentSettled []uint32
+}
+
+// OnOrderFilledArgs is a record of a call that is made to OnOrderFilled.
+type OnOrderFilledArgs struct {
+	orderID   uint64
+	isPartial bool
+}
+
+// OnOrderCancelledArgs is a record of a call that is made to OnOrderCancelled.
+type OnOrderCancelledArgs struct {
+	orderID     uint64
+	cancelledBy string
+}
+
+// NewMockExchangeHooks creates a new empty MockExchangeHooks.
+// Follow it up with WithErrResult to have all the hooks return an error.
+func NewMockExchangeHooks() *MockExchangeHooks {
+	return &MockExchangeHooks{}
+}
+
+// WithErrResult sets the error string that every hook will return.
+// An empty string means no error. This method both updates the receiver and returns it.
+func (h *MockExchangeHooks) WithErrResult(errStr string) *MockExchangeHooks {
+	h.ErrResult = errStr
+	return h
+}
+
+// getErr gets the error to return from a hook.
+func (h *MockExchangeHooks) getErr() error {
+	if len(h.ErrResult) == 0 {
+		return nil
+	}
+	return errors.New(h.ErrResult)
+}
+
+func (h *MockExchangeHooks) OnOrderCreated(_ context.Context, order exchange.OrderI) error {
+	h.Calls.OnOrderCreated = append(h.Calls.OnOrderCreated, order.GetOrderID())
+	return h.getErr()
+}
+
+func (h *MockExchangeHooks) OnOrderFilled(_ context.Context, order *exchange.FilledOrder, isPartial bool) error {
+	h.Calls.OnOrderFilled = append(h.Calls.OnOrderFilled, &OnOrderFilledArgs{orderID: order.GetOrderID(), isPartial: isPartial})
+	return h.getErr()
+}
+
+func (h *MockExchangeHooks) OnOrderCancelled(_ context.Context, order exchange.OrderI, cancelledBy string) error {
+	h.Calls.OnOrderCancelled = append(h.Calls.OnOrderCancelled, &OnOrderCancelledArgs{orderID: order.GetOrderID(), cancelledBy: cancelledBy})
+	return h.getErr()
+}
+
+func (h *MockExchangeHooks) OnCommitmentSettled(_ context.Context, marketID uint32, _, _, _ []exchange.AccountAmount) error {
+	h.Calls.OnCommitmentSettled = append(h.Calls.OnCommitmentSettled, marketID)
+	return h.getErr()
+}
+
+// assertExchangeHooksCalls asserts that a mock exchange hooks received the expected calls.
+func (s *TestSuite) assertExchangeHooksCalls(mh *MockExchangeHooks, expected ExchangeHooksCalls, msg string, args ...interface{}) bool {
+	s.T().Helper()
+	return s.Assert().Equalf(expected, mh.Calls, msg+" ExchangeHooks calls", args...)
+}
+
+// #############################################################################
+// #############################                   #############################
+// ###########################   MockOracleKeeper   ############################
+// #############################                   #############################
+// #############################################################################
+
+var _ exchange.OracleKeeper = (*MockOracleKeeper)(nil)
+
+// MockOracleKeeper satisfies the exchange.OracleKeeper interface but just records the calls and allows dictation of results.
+type MockOracleKeeper struct {
+	Calls                    OracleCalls
+	PublishPriceResultsQueue []string
+}
+
+// OracleCalls contains all the calls that the mock oracle keeper makes.
+type OracleCalls struct {
+	PublishPrice []*PublishPriceArgs
+}
+
+// PublishPriceArgs is a record of a call that is made to PublishPrice.
+type PublishPriceArgs struct {
+	Assets sdk.Coin
+	Price  sdk.Coin
+	Source string
+}
+
+// NewMockOracleKeeper creates a new empty MockOracleKeeper.
+// Follow it up with WithPublishPriceResults to dictate results.
+func NewMockOracleKeeper() *MockOracleKeeper {
+	return &MockOracleKeeper{}
+}
+
+// WithPublishPriceResults queues up the provided error strings to be returned from PublishPrice.
+// An empty string means no error. Each entry is used only once. If entries run out, nil is returned.
+// This method both updates the receiver and returns it.
+func (k *MockOracleKeeper) WithPublishPriceResults(errs ...string) *MockOracleKeeper {
+	k.PublishPriceResultsQueue = append(k.PublishPriceResultsQueue, errs...)
+	return k
+}
+
+func (k *MockOracleKeeper) PublishPrice(_ sdk.Context, assets, price sdk.Coin, source string) error {
+	k.Calls.PublishPrice = append(k.Calls.PublishPrice, &PublishPriceArgs{Assets: assets, Price: price, Source: source})
+	if len(k.PublishPriceResultsQueue) > 0 {
+		rv := k.PublishPriceResultsQueue[0]
+		k.PublishPriceResultsQueue = k.PublishPriceResultsQueue[1:]
+		if len(rv) > 0 {
+			return errors.New(rv)
+		}
+	}
+	return nil
+}
+
+// String returns a string of this PublishPriceArgs.
+func (a PublishPriceArgs) String() string {
+	return fmt.Sprintf("%s@%s(%s)", a.Assets, a.Price, a.Source)
+}
+
+// publishPriceArgsString is the same as PublishPriceArgs.String but with a pointer arg.
+func publishPriceArgsString(p *PublishPriceArgs) string {
+	return p.String()
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -25,27 +26,94 @@ func NewMsgServer(k Keeper) exchange.MsgServer {
 var _ exchange.MsgServer = MsgServer{}
 
 // CreateAsk creates an ask order (to sell something you own).
+// If the idempotency key was already used by the seller, the previously created order's id is returned instead.
 func (k MsgServer) CreateAsk(goCtx context.Context, msg *exchange.MsgCreateAskRequest) (*exchange.MsgCreateAskResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "CreateAsk")
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if orderID, found := k.GetIdempotentOrderID(ctx, msg.AskOrder.Seller, msg.IdempotencyKey); found {
+		return &exchange.MsgCreateAskResponse{OrderId: orderID}, nil
+	}
+	if err := k.RecordOrderCreation(ctx, msg.AskOrder.MarketId, msg.AskOrder.Seller); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
 	orderID, err := k.CreateAskOrder(ctx, msg.AskOrder, msg.OrderCreationFee)
 	if err != nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
+	if err = k.RecordIdempotencyKey(ctx, msg.AskOrder.Seller, msg.IdempotencyKey, orderID); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
 	return &exchange.MsgCreateAskResponse{OrderId: orderID}, nil
 }
 
 // CreateBid creates a bid order (to buy something you want).
+// If the idempotency key was already used by the buyer, the previously created order's id is returned instead.
 func (k MsgServer) CreateBid(goCtx context.Context, msg *exchange.MsgCreateBidRequest) (*exchange.MsgCreateBidResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "CreateBid")
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if orderID, found := k.GetIdempotentOrderID(ctx, msg.BidOrder.Buyer, msg.IdempotencyKey); found {
+		return &exchange.MsgCreateBidResponse{OrderId: orderID}, nil
+	}
+	if err := k.RecordOrderCreation(ctx, msg.BidOrder.MarketId, msg.BidOrder.Buyer); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
 	orderID, err := k.CreateBidOrder(ctx, msg.BidOrder, msg.OrderCreationFee)
 	if err != nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
+	if err = k.RecordIdempotencyKey(ctx, msg.BidOrder.Buyer, msg.IdempotencyKey, orderID); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
 	return &exchange.MsgCreateBidResponse{OrderId: orderID}, nil
 }
 
+// CreateTriggerAsk creates an ask order that is only activated once a settlement price is at or below a trigger price.
+func (k MsgServer) CreateTriggerAsk(goCtx context.Context, msg *exchange.MsgCreateTriggerAskRequest) (*exchange.MsgCreateTriggerAskResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "CreateTriggerAsk")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.RecordOrderCreation(ctx, msg.AskOrder.MarketId, msg.AskOrder.Seller); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	orderID, err := k.CreateTriggerAskOrder(ctx, msg.AskOrder, msg.TriggerPrice, msg.OrderCreationFee)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &exchange.MsgCreateTriggerAskResponse{OrderId: orderID}, nil
+}
+
+// CreateTriggerBid creates a bid order that is only activated once a settlement price is at or above a trigger price.
+func (k MsgServer) CreateTriggerBid(goCtx context.Context, msg *exchange.MsgCreateTriggerBidRequest) (*exchange.MsgCreateTriggerBidResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "CreateTriggerBid")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.RecordOrderCreation(ctx, msg.BidOrder.MarketId, msg.BidOrder.Buyer); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	orderID, err := k.CreateTriggerBidOrder(ctx, msg.BidOrder, msg.TriggerPrice, msg.OrderCreationFee)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &exchange.MsgCreateTriggerBidResponse{OrderId: orderID}, nil
+}
+
+// CreateOrdersBatch creates several ask and bid orders in a market at once.
+func (k MsgServer) CreateOrdersBatch(goCtx context.Context, msg *exchange.MsgCreateOrdersBatchRequest) (*exchange.MsgCreateOrdersBatchResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "CreateOrdersBatch")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for i := 0; i < len(msg.AskOrders)+len(msg.BidOrders); i++ {
+		if err := k.RecordOrderCreation(ctx, msg.MarketId, msg.Owner); err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+	}
+	askOrderIDs, bidOrderIDs, err := k.Keeper.CreateOrdersBatch(ctx, msg.AskOrders, msg.BidOrders, msg.AskOrderCreationFee, msg.BidOrderCreationFee)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &exchange.MsgCreateOrdersBatchResponse{AskOrderIds: askOrderIDs, BidOrderIds: bidOrderIDs}, nil
+}
+
 // CommitFunds marks funds in an account as manageable by a market.
 func (k MsgServer) CommitFunds(goCtx context.Context, msg *exchange.MsgCommitFundsRequest) (*exchange.MsgCommitFundsResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "CommitFunds")
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	addr, _ := sdk.AccAddressFromBech32(msg.Account)
 
@@ -58,11 +126,58 @@ func (k MsgServer) CommitFunds(goCtx context.Context, msg *exchange.MsgCommitFun
 	if err != nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
+
+	if msg.Expiration != nil {
+		err = k.SetCommitmentExpiration(ctx, msg.MarketId, addr, *msg.Expiration)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+	}
 	return &exchange.MsgCommitFundsResponse{}, nil
 }
 
+// TransferCommitment moves funds an account has committed to one market over to another market.
+func (k MsgServer) TransferCommitment(goCtx context.Context, msg *exchange.MsgTransferCommitmentRequest) (*exchange.MsgTransferCommitmentResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "TransferCommitment")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.IsUserSettlementAllowed(ctx, msg.CurrentMarketId) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("market %d does not allow user-initiated commitment transfers", msg.CurrentMarketId)
+	}
+	addr, _ := sdk.AccAddressFromBech32(msg.Account)
+	err := k.MoveCommitment(ctx, msg.CurrentMarketId, msg.NewMarketId, addr, msg.Amount, msg.EventTag)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &exchange.MsgTransferCommitmentResponse{}, nil
+}
+
+// FundCommitmentRewards adds funds to a market's commitment reward pool.
+func (k MsgServer) FundCommitmentRewards(goCtx context.Context, msg *exchange.MsgFundCommitmentRewardsRequest) (*exchange.MsgFundCommitmentRewardsResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "FundCommitmentRewards")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	funder, _ := sdk.AccAddressFromBech32(msg.Funder)
+	err := k.Keeper.FundCommitmentRewards(ctx, msg.MarketId, funder, msg.Amount)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &exchange.MsgFundCommitmentRewardsResponse{}, nil
+}
+
+// ClaimCommitmentRewards sends an account the commitment rewards it has accrued in a market.
+func (k MsgServer) ClaimCommitmentRewards(goCtx context.Context, msg *exchange.MsgClaimCommitmentRewardsRequest) (*exchange.MsgClaimCommitmentRewardsResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "ClaimCommitmentRewards")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	addr, _ := sdk.AccAddressFromBech32(msg.Account)
+	amount, err := k.Keeper.ClaimCommitmentRewards(ctx, msg.MarketId, addr)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &exchange.MsgClaimCommitmentRewardsResponse{Amount: amount}, nil
+}
+
 // CancelOrder cancels an order.
 func (k MsgServer) CancelOrder(goCtx context.Context, msg *exchange.MsgCancelOrderRequest) (*exchange.MsgCancelOrderResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "CancelOrder")
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	err := k.Keeper.CancelOrder(ctx, msg.OrderId, msg.Signer)
 	if err != nil {
@@ -71,8 +186,54 @@ func (k MsgServer) CancelOrder(goCtx context.Context, msg *exchange.MsgCancelOrd
 	return &exchange.MsgCancelOrderResponse{}, nil
 }
 
+// AmendOrder changes the assets, price, partial-fill flag, and settlement fees of an existing order.
+func (k MsgServer) AmendOrder(goCtx context.Context, msg *exchange.MsgAmendOrderRequest) (*exchange.MsgAmendOrderResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "AmendOrder")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := k.Keeper.AmendOrder(ctx, msg)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &exchange.MsgAmendOrderResponse{}, nil
+}
+
+// LinkOrders links two orders so that filling or cancelling one cancels the other.
+func (k MsgServer) LinkOrders(goCtx context.Context, msg *exchange.MsgLinkOrdersRequest) (*exchange.MsgLinkOrdersResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "LinkOrders")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := k.Keeper.LinkOrders(ctx, msg)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &exchange.MsgLinkOrdersResponse{}, nil
+}
+
+// SetDeadManSwitch registers (or removes) an interval within which an account must send heartbeats
+// to keep its orders from being cancelled.
+func (k MsgServer) SetDeadManSwitch(goCtx context.Context, msg *exchange.MsgSetDeadManSwitchRequest) (*exchange.MsgSetDeadManSwitchResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "SetDeadManSwitch")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := k.Keeper.SetDeadManSwitch(ctx, msg.Account, msg.IntervalSeconds)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &exchange.MsgSetDeadManSwitchResponse{}, nil
+}
+
+// Heartbeat resets the deadline of an account's dead-man switch.
+func (k MsgServer) Heartbeat(goCtx context.Context, msg *exchange.MsgHeartbeatRequest) (*exchange.MsgHeartbeatResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "Heartbeat")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := k.Keeper.Heartbeat(ctx, msg.Account)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &exchange.MsgHeartbeatResponse{}, nil
+}
+
 // FillBids uses the assets in your account to fulfill one or more bids (similar to a fill-or-cancel ask).
 func (k MsgServer) FillBids(goCtx context.Context, msg *exchange.MsgFillBidsRequest) (*exchange.MsgFillBidsResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "FillBids")
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	err := k.Keeper.FillBids(ctx, msg)
 	if err != nil {
@@ -83,6 +244,7 @@ func (k MsgServer) FillBids(goCtx context.Context, msg *exchange.MsgFillBidsRequ
 
 // FillAsks uses the funds in your account to fulfill one or more asks (similar to a fill-or-cancel bid).
 func (k MsgServer) FillAsks(goCtx context.Context, msg *exchange.MsgFillAsksRequest) (*exchange.MsgFillAsksResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "FillAsks")
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	err := k.Keeper.FillAsks(ctx, msg)
 	if err != nil {
@@ -98,6 +260,7 @@ func permError(desc string, account string, marketID uint32) error {
 
 // MarketSettle is a market endpoint to trigger the settlement of orders.
 func (k MsgServer) MarketSettle(goCtx context.Context, msg *exchange.MsgMarketSettleRequest) (*exchange.MsgMarketSettleResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "MarketSettle")
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	if !k.CanSettleOrders(ctx, msg.MarketId, msg.Admin) {
 		return nil, permError("settle orders for", msg.Admin, msg.MarketId)
@@ -111,6 +274,7 @@ func (k MsgServer) MarketSettle(goCtx context.Context, msg *exchange.MsgMarketSe
 
 // MarketCommitmentSettle is a market endpoint to transfer committed funds.
 func (k MsgServer) MarketCommitmentSettle(goCtx context.Context, msg *exchange.MsgMarketCommitmentSettleRequest) (*exchange.MsgMarketCommitmentSettleResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "MarketCommitmentSettle")
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	if !k.CanSettleCommitments(ctx, msg.MarketId, msg.Admin) {
 		return nil, permError("settle commitments for", msg.Admin, msg.MarketId)
@@ -128,6 +292,7 @@ func (k MsgServer) MarketCommitmentSettle(goCtx context.Context, msg *exchange.M
 
 // MarketReleaseCommitments is a market endpoint return control of funds back to the account owner(s).
 func (k MsgServer) MarketReleaseCommitments(goCtx context.Context, msg *exchange.MsgMarketReleaseCommitmentsRequest) (*exchange.MsgMarketReleaseCommitmentsResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "MarketReleaseCommitments")
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	if !k.CanReleaseCommitmentsForMarket(ctx, msg.MarketId, msg.Admin) {
 		return nil, permError("release commitments for", msg.Admin, msg.MarketId)
@@ -141,6 +306,7 @@ func (k MsgServer) MarketReleaseCommitments(goCtx context.Context, msg *exchange
 
 // MarketTransferCommitment is a market endpoint to transfers committed funds from one market to another.
 func (k MsgServer) MarketTransferCommitment(goCtx context.Context, msg *exchange.MsgMarketTransferCommitmentRequest) (*exchange.MsgMarketTransferCommitmentResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "MarketTransferCommitment")
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	if !k.CanTransferCommitmentForMarket(ctx, msg.CurrentMarketId, msg.Admin) {
 		return nil, permError("transfer commitments for", msg.Admin, msg.CurrentMarketId)
@@ -152,8 +318,38 @@ func (k MsgServer) MarketTransferCommitment(goCtx context.Context, msg *exchange
 	return &exchange.MsgMarketTransferCommitmentResponse{}, nil
 }
 
+// MarketDistributeCommitmentRewards is a market endpoint to distribute funds from its commitment reward pool
+// to the accounts with funds committed to it.
+func (k MsgServer) MarketDistributeCommitmentRewards(goCtx context.Context, msg *exchange.MsgMarketDistributeCommitmentRewardsRequest) (*exchange.MsgMarketDistributeCommitmentRewardsResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "MarketDistributeCommitmentRewards")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.CanSettleCommitments(ctx, msg.MarketId, msg.Admin) {
+		return nil, permError("distribute commitment rewards for", msg.Admin, msg.MarketId)
+	}
+	err := k.DistributeCommitmentRewards(ctx, msg.MarketId, msg.Amount, msg.BasisDenom, msg.Admin)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &exchange.MsgMarketDistributeCommitmentRewardsResponse{}, nil
+}
+
+// MarketBulkCancel is a market endpoint to cancel many (or all) of the open orders in the market at once.
+func (k MsgServer) MarketBulkCancel(goCtx context.Context, msg *exchange.MsgMarketBulkCancelRequest) (*exchange.MsgMarketBulkCancelResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "MarketBulkCancel")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.CanCancelOrdersForMarket(ctx, msg.MarketId, msg.Admin) {
+		return nil, permError("cancel orders for", msg.Admin, msg.MarketId)
+	}
+	cancelled, hasMore, err := k.Keeper.MarketBulkCancel(ctx, msg)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &exchange.MsgMarketBulkCancelResponse{CancelledOrderIds: cancelled, HasMore: hasMore}, nil
+}
+
 // MarketSetOrderExternalID updates an order's external id field.
 func (k MsgServer) MarketSetOrderExternalID(goCtx context.Context, msg *exchange.MsgMarketSetOrderExternalIDRequest) (*exchange.MsgMarketSetOrderExternalIDResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "MarketSetOrderExternalID")
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	if !k.CanSetIDs(ctx, msg.MarketId, msg.Admin) {
 		return nil, permError("set external ids on orders for", msg.Admin, msg.MarketId)
@@ -167,6 +363,7 @@ func (k MsgServer) MarketSetOrderExternalID(goCtx context.Context, msg *exchange
 
 // MarketWithdraw is a market endpoint to withdraw fees that have been collected.
 func (k MsgServer) MarketWithdraw(goCtx context.Context, msg *exchange.MsgMarketWithdrawRequest) (*exchange.MsgMarketWithdrawResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "MarketWithdraw")
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	if !k.CanWithdrawMarketFunds(ctx, msg.MarketId, msg.Admin) {
 		return nil, permError("withdraw from", msg.Admin, msg.MarketId)
@@ -181,6 +378,7 @@ func (k MsgServer) MarketWithdraw(goCtx context.Context, msg *exchange.MsgMarket
 
 // MarketUpdateDetails is a market endpoint to update its details.
 func (k MsgServer) MarketUpdateDetails(goCtx context.Context, msg *exchange.MsgMarketUpdateDetailsRequest) (*exchange.MsgMarketUpdateDetailsResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "MarketUpdateDetails")
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	if !k.CanUpdateMarket(ctx, msg.MarketId, msg.Admin) {
 		return nil, permError("update", msg.Admin, msg.MarketId)
@@ -201,6 +399,7 @@ func (k MsgServer) MarketUpdateEnabled(_ context.Context, _ *exchange.MsgMarketU
 
 // MarketUpdateAcceptingOrders is a market endpoint to update whether its accepting orders.
 func (k MsgServer) MarketUpdateAcceptingOrders(goCtx context.Context, msg *exchange.MsgMarketUpdateAcceptingOrdersRequest) (*exchange.MsgMarketUpdateAcceptingOrdersResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "MarketUpdateAcceptingOrders")
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	if !k.CanUpdateMarket(ctx, msg.MarketId, msg.Admin) {
 		return nil, permError("update", msg.Admin, msg.MarketId)
@@ -214,6 +413,7 @@ func (k MsgServer) MarketUpdateAcceptingOrders(goCtx context.Context, msg *excha
 
 // MarketUpdateUserSettle is a market endpoint to update whether it allows user-initiated settlement.
 func (k MsgServer) MarketUpdateUserSettle(goCtx context.Context, msg *exchange.MsgMarketUpdateUserSettleRequest) (*exchange.MsgMarketUpdateUserSettleResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "MarketUpdateUserSettle")
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	if !k.CanUpdateMarket(ctx, msg.MarketId, msg.Admin) {
 		return nil, permError("update", msg.Admin, msg.MarketId)
@@ -227,6 +427,7 @@ func (k MsgServer) MarketUpdateUserSettle(goCtx context.Context, msg *exchange.M
 
 // MarketUpdateAcceptingCommitments is a market endpoint to update whether it accepts commitments.
 func (k MsgServer) MarketUpdateAcceptingCommitments(goCtx context.Context, msg *exchange.MsgMarketUpdateAcceptingCommitmentsRequest) (*exchange.MsgMarketUpdateAcceptingCommitmentsResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "MarketUpdateAcceptingCommitments")
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	if !k.CanUpdateMarket(ctx, msg.MarketId, msg.Admin) {
 		return nil, permError("update", msg.Admin, msg.MarketId)
@@ -245,8 +446,121 @@ func (k MsgServer) MarketUpdateAcceptingCommitments(goCtx context.Context, msg *
 	return &exchange.MsgMarketUpdateAcceptingCommitmentsResponse{}, nil
 }
 
+// MarketUpdateContinuousMatching is a market endpoint to update whether it automatically matches new orders.
+func (k MsgServer) MarketUpdateContinuousMatching(goCtx context.Context, msg *exchange.MsgMarketUpdateContinuousMatchingRequest) (*exchange.MsgMarketUpdateContinuousMatchingResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "MarketUpdateContinuousMatching")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.CanUpdateMarket(ctx, msg.MarketId, msg.Admin) {
+		return nil, permError("update", msg.Admin, msg.MarketId)
+	}
+	err := k.UpdateContinuousMatching(ctx, msg.MarketId, msg.ContinuousMatching, msg.Admin)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &exchange.MsgMarketUpdateContinuousMatchingResponse{}, nil
+}
+
+// MarketUpdateBatchAuction is a market endpoint to update how often it runs batch auctions.
+func (k MsgServer) MarketUpdateBatchAuction(goCtx context.Context, msg *exchange.MsgMarketUpdateBatchAuctionRequest) (*exchange.MsgMarketUpdateBatchAuctionResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "MarketUpdateBatchAuction")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.CanUpdateMarket(ctx, msg.MarketId, msg.Admin) {
+		return nil, permError("update", msg.Admin, msg.MarketId)
+	}
+	err := k.UpdateBatchAuctionInterval(ctx, msg.MarketId, msg.BatchAuctionInterval, msg.Admin)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &exchange.MsgMarketUpdateBatchAuctionResponse{}, nil
+}
+
+// MarketUpdateSelfTradePrevention is a market endpoint to update how it handles orders from the same owner that would match.
+func (k MsgServer) MarketUpdateSelfTradePrevention(goCtx context.Context, msg *exchange.MsgMarketUpdateSelfTradePreventionRequest) (*exchange.MsgMarketUpdateSelfTradePreventionResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "MarketUpdateSelfTradePrevention")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.CanUpdateMarket(ctx, msg.MarketId, msg.Admin) {
+		return nil, permError("update", msg.Admin, msg.MarketId)
+	}
+	err := k.UpdateSelfTradePrevention(ctx, msg.MarketId, msg.SelfTradePrevention, msg.Admin)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &exchange.MsgMarketUpdateSelfTradePreventionResponse{}, nil
+}
+
+// MarketUpdatePublishPrices is a market endpoint to update whether its settlement prices are published to x/oracle.
+func (k MsgServer) MarketUpdatePublishPrices(goCtx context.Context, msg *exchange.MsgMarketUpdatePublishPricesRequest) (*exchange.MsgMarketUpdatePublishPricesResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "MarketUpdatePublishPrices")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.CanUpdateMarket(ctx, msg.MarketId, msg.Admin) {
+		return nil, permError("update", msg.Admin, msg.MarketId)
+	}
+	err := k.UpdatePublishPrices(ctx, msg.MarketId, msg.PublishPrices, msg.Admin)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &exchange.MsgMarketUpdatePublishPricesResponse{}, nil
+}
+
+// MarketUpdateExternalIDScope is a market endpoint to update how unique the external ids of its orders must be.
+func (k MsgServer) MarketUpdateExternalIDScope(goCtx context.Context, msg *exchange.MsgMarketUpdateExternalIDScopeRequest) (*exchange.MsgMarketUpdateExternalIDScopeResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "MarketUpdateExternalIDScope")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.CanUpdateMarket(ctx, msg.MarketId, msg.Admin) {
+		return nil, permError("update", msg.Admin, msg.MarketId)
+	}
+	err := k.UpdateExternalIDScope(ctx, msg.MarketId, msg.ExternalIdScope, msg.Admin)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &exchange.MsgMarketUpdateExternalIDScopeResponse{}, nil
+}
+
+// MarketUpdateOrderRateLimit is a market endpoint to update how many orders an account can create in it.
+func (k MsgServer) MarketUpdateOrderRateLimit(goCtx context.Context, msg *exchange.MsgMarketUpdateOrderRateLimitRequest) (*exchange.MsgMarketUpdateOrderRateLimitResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "MarketUpdateOrderRateLimit")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.CanUpdateMarket(ctx, msg.MarketId, msg.Admin) {
+		return nil, permError("update", msg.Admin, msg.MarketId)
+	}
+	err := k.UpdateOrderRateLimit(ctx, msg.MarketId, msg.OrderRateLimit, msg.Admin)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &exchange.MsgMarketUpdateOrderRateLimitResponse{}, nil
+}
+
+// MarketUpdateMaxExposure is a market endpoint to update the most an account can have in its orders and commitments.
+func (k MsgServer) MarketUpdateMaxExposure(goCtx context.Context, msg *exchange.MsgMarketUpdateMaxExposureRequest) (*exchange.MsgMarketUpdateMaxExposureResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "MarketUpdateMaxExposure")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.CanUpdateMarket(ctx, msg.MarketId, msg.Admin) {
+		return nil, permError("update", msg.Admin, msg.MarketId)
+	}
+	err := k.UpdateMaxExposure(ctx, msg.MarketId, msg.MaxExposure, msg.Admin)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &exchange.MsgMarketUpdateMaxExposureResponse{}, nil
+}
+
+// MarketUpdateFillAlgorithm is a market endpoint to update how resting orders are chosen to fill a new order.
+func (k MsgServer) MarketUpdateFillAlgorithm(goCtx context.Context, msg *exchange.MsgMarketUpdateFillAlgorithmRequest) (*exchange.MsgMarketUpdateFillAlgorithmResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "MarketUpdateFillAlgorithm")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.CanUpdateMarket(ctx, msg.MarketId, msg.Admin) {
+		return nil, permError("update", msg.Admin, msg.MarketId)
+	}
+	err := k.UpdateFillAlgorithm(ctx, msg.MarketId, msg.FillAlgorithm, msg.Admin)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &exchange.MsgMarketUpdateFillAlgorithmResponse{}, nil
+}
+
 // MarketUpdateIntermediaryDenom sets a market's intermediary denom.
 func (k MsgServer) MarketUpdateIntermediaryDenom(goCtx context.Context, msg *exchange.MsgMarketUpdateIntermediaryDenomRequest) (*exchange.MsgMarketUpdateIntermediaryDenomResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "MarketUpdateIntermediaryDenom")
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	if !k.CanUpdateMarket(ctx, msg.MarketId, msg.Admin) {
 		return nil, permError("update", msg.Admin, msg.MarketId)
@@ -257,6 +571,7 @@ func (k MsgServer) MarketUpdateIntermediaryDenom(goCtx context.Context, msg *exc
 
 // MarketManagePermissions is a market endpoint to manage a market's user permissions.
 func (k MsgServer) MarketManagePermissions(goCtx context.Context, msg *exchange.MsgMarketManagePermissionsRequest) (*exchange.MsgMarketManagePermissionsResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "MarketManagePermissions")
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	if !k.CanManagePermissions(ctx, msg.MarketId, msg.Admin) {
 		return nil, permError("manage permissions for", msg.Admin, msg.MarketId)
@@ -268,8 +583,34 @@ func (k MsgServer) MarketManagePermissions(goCtx context.Context, msg *exchange.
 	return &exchange.MsgMarketManagePermissionsResponse{}, nil
 }
 
+// MarketTransferAuthority is a market endpoint to propose replacing all of a market's access grants.
+func (k MsgServer) MarketTransferAuthority(goCtx context.Context, msg *exchange.MsgMarketTransferAuthorityRequest) (*exchange.MsgMarketTransferAuthorityResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "MarketTransferAuthority")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.CanManagePermissions(ctx, msg.MarketId, msg.Admin) {
+		return nil, permError("transfer authority of", msg.Admin, msg.MarketId)
+	}
+	err := k.ProposeAuthorityTransfer(ctx, msg.ToMarketAuthorityTransfer())
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &exchange.MsgMarketTransferAuthorityResponse{}, nil
+}
+
+// MarketAcceptAuthority is an endpoint for the new authority to accept a proposed market authority transfer.
+func (k MsgServer) MarketAcceptAuthority(goCtx context.Context, msg *exchange.MsgMarketAcceptAuthorityRequest) (*exchange.MsgMarketAcceptAuthorityResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "MarketAcceptAuthority")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := k.AcceptAuthorityTransfer(ctx, msg.MarketId, msg.NewAuthority)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &exchange.MsgMarketAcceptAuthorityResponse{}, nil
+}
+
 // MarketManageReqAttrs is a market endpoint to manage the attributes required to interact with it.
 func (k MsgServer) MarketManageReqAttrs(goCtx context.Context, msg *exchange.MsgMarketManageReqAttrsRequest) (*exchange.MsgMarketManageReqAttrsResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "MarketManageReqAttrs")
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	if !k.CanManageReqAttrs(ctx, msg.MarketId, msg.Admin) {
 		return nil, permError("manage required attributes for", msg.Admin, msg.MarketId)
@@ -281,8 +622,23 @@ func (k MsgServer) MarketManageReqAttrs(goCtx context.Context, msg *exchange.Msg
 	return &exchange.MsgMarketManageReqAttrsResponse{}, nil
 }
 
+// MarketManageAcceptedDenoms is a market endpoint to manage the denoms that orders in it can use.
+func (k MsgServer) MarketManageAcceptedDenoms(goCtx context.Context, msg *exchange.MsgMarketManageAcceptedDenomsRequest) (*exchange.MsgMarketManageAcceptedDenomsResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "MarketManageAcceptedDenoms")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.CanUpdateMarket(ctx, msg.MarketId, msg.Admin) {
+		return nil, permError("update", msg.Admin, msg.MarketId)
+	}
+	err := k.UpdateAcceptedDenoms(ctx, msg)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &exchange.MsgMarketManageAcceptedDenomsResponse{}, nil
+}
+
 // CreatePayment creates a payment to facilitate a trade between two accounts.
 func (k MsgServer) CreatePayment(goCtx context.Context, msg *exchange.MsgCreatePaymentRequest) (*exchange.MsgCreatePaymentResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "CreatePayment")
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	if err := k.Keeper.CreatePayment(ctx, &msg.Payment); err != nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
@@ -295,6 +651,7 @@ func (k MsgServer) CreatePayment(goCtx context.Context, msg *exchange.MsgCreateP
 
 // AcceptPayment is used by a target to accept a payment.
 func (k MsgServer) AcceptPayment(goCtx context.Context, msg *exchange.MsgAcceptPaymentRequest) (*exchange.MsgAcceptPaymentResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "AcceptPayment")
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	if err := k.Keeper.AcceptPayment(ctx, &msg.Payment); err != nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
@@ -305,8 +662,38 @@ func (k MsgServer) AcceptPayment(goCtx context.Context, msg *exchange.MsgAcceptP
 	return &exchange.MsgAcceptPaymentResponse{}, nil
 }
 
+// AcceptPayments is used by a target to accept several payments at once.
+func (k MsgServer) AcceptPayments(goCtx context.Context, msg *exchange.MsgAcceptPaymentsRequest) (*exchange.MsgAcceptPaymentsResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "AcceptPayments")
+	target, err := sdk.AccAddressFromBech32(msg.Target)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid target %q: %v", msg.Target, err)
+	}
+	sources := make([]sdk.AccAddress, 0, len(msg.Sources))
+	for i, sourceStr := range msg.Sources {
+		var source sdk.AccAddress
+		source, err = sdk.AccAddressFromBech32(sourceStr)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid sources[%d] %q: %v", i, sourceStr, err)
+		}
+		sources = append(sources, source)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	results, totalSent, err := k.Keeper.AcceptPayments(ctx, target, msg.PaymentIds, sources, msg.MaxTargetAmount)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	// The accept-payment fee is only charged once for the whole batch, and only if the target sent funds.
+	if !totalSent.IsZero() {
+		k.consumeAcceptPaymentFee(ctx, msg)
+	}
+	return &exchange.MsgAcceptPaymentsResponse{Results: results}, nil
+}
+
 // RejectPayment can be used by a target to reject a payment.
 func (k MsgServer) RejectPayment(goCtx context.Context, msg *exchange.MsgRejectPaymentRequest) (*exchange.MsgRejectPaymentResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "RejectPayment")
 	target, err := sdk.AccAddressFromBech32(msg.Target)
 	if err != nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid target %q: %v", msg.Target, err)
@@ -326,6 +713,7 @@ func (k MsgServer) RejectPayment(goCtx context.Context, msg *exchange.MsgRejectP
 
 // RejectPayments can be used by a target to reject all payments from one or more sources.
 func (k MsgServer) RejectPayments(goCtx context.Context, msg *exchange.MsgRejectPaymentsRequest) (*exchange.MsgRejectPaymentsResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "RejectPayments")
 	target, err := sdk.AccAddressFromBech32(msg.Target)
 	if err != nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid target %q: %v", msg.Target, err)
@@ -349,8 +737,29 @@ func (k MsgServer) RejectPayments(goCtx context.Context, msg *exchange.MsgReject
 	return &exchange.MsgRejectPaymentsResponse{}, nil
 }
 
+// DisputePayment can be used by a target to dispute an accepted payment during its dispute window.
+func (k MsgServer) DisputePayment(goCtx context.Context, msg *exchange.MsgDisputePaymentRequest) (*exchange.MsgDisputePaymentResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "DisputePayment")
+	target, err := sdk.AccAddressFromBech32(msg.Target)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid target %q: %v", msg.Target, err)
+	}
+	source, err := sdk.AccAddressFromBech32(msg.Source)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid source %q: %v", msg.Source, err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err = k.Keeper.DisputePayment(ctx, target, source, msg.ExternalId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &exchange.MsgDisputePaymentResponse{}, nil
+}
+
 // CancelPayments can be used by a source to cancel one or more payments.
 func (k MsgServer) CancelPayments(goCtx context.Context, msg *exchange.MsgCancelPaymentsRequest) (*exchange.MsgCancelPaymentsResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "CancelPayments")
 	source, err := sdk.AccAddressFromBech32(msg.Source)
 	if err != nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid source %q: %v", msg.Source, err)
@@ -367,6 +776,7 @@ func (k MsgServer) CancelPayments(goCtx context.Context, msg *exchange.MsgCancel
 
 // ChangePaymentTarget can be used by a source to change the target in one of their payments.
 func (k MsgServer) ChangePaymentTarget(goCtx context.Context, msg *exchange.MsgChangePaymentTargetRequest) (*exchange.MsgChangePaymentTargetResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "ChangePaymentTarget")
 	source, err := sdk.AccAddressFromBech32(msg.Source)
 	if err != nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid source %q: %v", msg.Source, err)
@@ -388,8 +798,113 @@ func (k MsgServer) ChangePaymentTarget(goCtx context.Context, msg *exchange.MsgC
 	return &exchange.MsgChangePaymentTargetResponse{}, nil
 }
 
+// CreateRecurringPayment sets up a series of periodic transfers from a source to a target.
+func (k MsgServer) CreateRecurringPayment(goCtx context.Context, msg *exchange.MsgCreateRecurringPaymentRequest) (*exchange.MsgCreateRecurringPaymentResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "CreateRecurringPayment")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	rp := &exchange.RecurringPayment{
+		Source:             msg.Source,
+		Target:             msg.Target,
+		Amount:             msg.Amount,
+		ExternalId:         msg.ExternalId,
+		IntervalSeconds:    msg.IntervalSeconds,
+		TransfersRemaining: msg.Transfers,
+		NextTransferTime:   ctx.BlockTime(),
+	}
+	if msg.StartTime != nil {
+		rp.NextTransferTime = *msg.StartTime
+	}
+
+	if err := k.Keeper.CreateRecurringPayment(ctx, rp); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &exchange.MsgCreateRecurringPaymentResponse{}, nil
+}
+
+// CancelRecurringPayments can be used by a source to cancel one or more recurring payments.
+func (k MsgServer) CancelRecurringPayments(goCtx context.Context, msg *exchange.MsgCancelRecurringPaymentsRequest) (*exchange.MsgCancelRecurringPaymentsResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "CancelRecurringPayments")
+	source, err := sdk.AccAddressFromBech32(msg.Source)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid source %q: %v", msg.Source, err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err = k.Keeper.CancelRecurringPayments(ctx, source, msg.ExternalIds)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &exchange.MsgCancelRecurringPaymentsResponse{}, nil
+}
+
+// CreateMultiPartyPayment creates a payment between several parties that is settled once all of them accept it.
+func (k MsgServer) CreateMultiPartyPayment(goCtx context.Context, msg *exchange.MsgCreateMultiPartyPaymentRequest) (*exchange.MsgCreateMultiPartyPaymentResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "CreateMultiPartyPayment")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	mpp := &exchange.MultiPartyPayment{
+		Creator:    msg.Creator,
+		ExternalId: msg.ExternalId,
+		Parties:    msg.Parties,
+	}
+	if err := k.Keeper.CreateMultiPartyPayment(ctx, mpp); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	if creator := mpp.GetParty(mpp.Creator); creator != nil && !creator.SendAmount.IsZero() {
+		k.consumeCreatePaymentFee(ctx, msg)
+	}
+	return &exchange.MsgCreateMultiPartyPaymentResponse{}, nil
+}
+
+// AcceptMultiPartyPayment is used by a party to accept a multi-party payment.
+func (k MsgServer) AcceptMultiPartyPayment(goCtx context.Context, msg *exchange.MsgAcceptMultiPartyPaymentRequest) (*exchange.MsgAcceptMultiPartyPaymentResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "AcceptMultiPartyPayment")
+	party, err := sdk.AccAddressFromBech32(msg.Party)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid party %q: %v", msg.Party, err)
+	}
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid creator %q: %v", msg.Creator, err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	settled, err := k.Keeper.AcceptMultiPartyPayment(ctx, party, creator, msg.ExternalId, msg.Parties)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	for _, p := range msg.Parties {
+		if p.Address == msg.Party && !p.SendAmount.IsZero() {
+			k.consumeAcceptPaymentFee(ctx, msg)
+		}
+	}
+	return &exchange.MsgAcceptMultiPartyPaymentResponse{Settled: settled}, nil
+}
+
+// CancelMultiPartyPayment can be used by any party to cancel a multi-party payment.
+func (k MsgServer) CancelMultiPartyPayment(goCtx context.Context, msg *exchange.MsgCancelMultiPartyPaymentRequest) (*exchange.MsgCancelMultiPartyPaymentResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "CancelMultiPartyPayment")
+	party, err := sdk.AccAddressFromBech32(msg.Party)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid party %q: %v", msg.Party, err)
+	}
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid creator %q: %v", msg.Creator, err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err = k.Keeper.CancelMultiPartyPayment(ctx, party, creator, msg.ExternalId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &exchange.MsgCancelMultiPartyPaymentResponse{}, nil
+}
+
 // GovCreateMarket is a governance proposal endpoint for creating a market.
 func (k MsgServer) GovCreateMarket(goCtx context.Context, msg *exchange.MsgGovCreateMarketRequest) (*exchange.MsgGovCreateMarketResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "GovCreateMarket")
 	if err := k.ValidateAuthority(msg.Authority); err != nil {
 		return nil, err
 	}
@@ -404,20 +919,44 @@ func (k MsgServer) GovCreateMarket(goCtx context.Context, msg *exchange.MsgGovCr
 }
 
 // GovManageFees is a governance proposal endpoint for updating a market's fees.
+// If an effective height or time is provided, the changes are scheduled to be applied then instead of now.
 func (k MsgServer) GovManageFees(goCtx context.Context, msg *exchange.MsgGovManageFeesRequest) (*exchange.MsgGovManageFeesResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "GovManageFees")
 	if err := k.ValidateAuthority(msg.Authority); err != nil {
 		return nil, err
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	k.UpdateFees(ctx, msg)
+	if msg.IsScheduled() {
+		if err := k.ScheduleFeeChange(ctx, msg); err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+	} else {
+		k.UpdateFees(ctx, msg)
+	}
 
 	return &exchange.MsgGovManageFeesResponse{}, nil
 }
 
+// GovManageExchangeSplits is a governance proposal endpoint for overriding the exchange's split for a market.
+func (k MsgServer) GovManageExchangeSplits(goCtx context.Context, msg *exchange.MsgGovManageExchangeSplitsRequest) (*exchange.MsgGovManageExchangeSplitsResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "GovManageExchangeSplits")
+	if err := k.ValidateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.UpdateExchangeSplits(ctx, msg); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &exchange.MsgGovManageExchangeSplitsResponse{}, nil
+}
+
 // GovCloseMarket is a governance proposal endpoint that will disable order and commitment creation,
 // cancel all orders, and release all commitments.
 func (k MsgServer) GovCloseMarket(goCtx context.Context, msg *exchange.MsgGovCloseMarketRequest) (*exchange.MsgGovCloseMarketResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "GovCloseMarket")
 	if err := k.ValidateAuthority(msg.Authority); err != nil {
 		return nil, err
 	}
@@ -428,6 +967,22 @@ func (k MsgServer) GovCloseMarket(goCtx context.Context, msg *exchange.MsgGovClo
 	return &exchange.MsgGovCloseMarketResponse{}, nil
 }
 
+// GovWindDownMarket is a governance proposal endpoint that will close a market (like GovCloseMarket), also cancel
+// its trigger orders, and optionally delete the market's record.
+func (k MsgServer) GovWindDownMarket(goCtx context.Context, msg *exchange.MsgGovWindDownMarketRequest) (*exchange.MsgGovWindDownMarketResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "GovWindDownMarket")
+	if err := k.ValidateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.WindDownMarket(ctx, msg.MarketId, msg.DeleteMarket, msg.Authority); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &exchange.MsgGovWindDownMarketResponse{}, nil
+}
+
 // GovUpdateParams is a governance proposal endpoint for updating the exchange module's params.
 //
 //nolint:staticcheck // SA1019 Suppress warning for deprecated MsgGovUpdateParamsRequest usage
@@ -437,6 +992,7 @@ func (k MsgServer) GovUpdateParams(_ context.Context, _ *exchange.MsgGovUpdatePa
 
 // UpdateParams is a governance proposal endpoint for updating the exchange module's params.
 func (k MsgServer) UpdateParams(goCtx context.Context, msg *exchange.MsgUpdateParamsRequest) (*exchange.MsgUpdateParamsResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), exchange.ModuleName, "tx", "UpdateParams")
 	if err := k.ValidateAuthority(msg.Authority); err != nil {
 		return nil, err
 	}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"context"
 	"fmt"
+	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 
@@ -181,6 +182,13 @@ func (s *TestSuite) eventHoldAddedPayment(addr sdk.AccAddress, amount string, ex
 	})
 }
 
+// eventHoldAddedMultiPartyPayment creates a new event emitted when a hold is added for a multi-party payment (emitted by the hold module).
+func (s *TestSuite) eventHoldAddedMultiPartyPayment(addr sdk.AccAddress, amount string, externalID string) sdk.Event {
+	return s.untypeEvent(&hold.EventHoldAdded{
+		Address: addr.String(), Amount: amount, Reason: fmt.Sprintf("x/exchange: multi-party payment %q", externalID),
+	})
+}
+
 // eventHoldReleased creates a new event emitted when a hold is released (emitted by the hold module).
 func (s *TestSuite) eventHoldReleased(addr sdk.AccAddress, amount string) sdk.Event {
 	return s.untypeEvent(&hold.EventHoldReleased{Address: addr.String(), Amount: amount})
@@ -215,7 +223,7 @@ func (s *TestSuite) requireAddHold(addr sdk.AccAddress, holdCoins string, orderI
 // requireSetCommitmentAmount sets the commitment amount and adds a hold for that amount.
 func (s *TestSuite) requireSetCommitmentAmount(marketID uint32, addr sdk.AccAddress, amount string) {
 	coins := s.coins(amount)
-	keeper.SetCommitmentAmount(s.getStore(), marketID, addr, coins)
+	keeper.SetCommitmentAmount(s.getStore(), marketID, addr, coins, s.ctx.BlockTime())
 	reason := fmt.Sprintf("test commitment for market %d", marketID)
 	assertions.RequireNotPanicsNoErrorf(s.T(), func() error {
 		return s.app.HoldKeeper.AddHold(s.ctx, addr, coins, reason)
@@ -411,8 +419,13 @@ func (s *TestSuite) TestMsgServer_CreateAsk() {
 	testDef := msgServerTestDef[exchange.MsgCreateAskRequest, exchange.MsgCreateAskResponse, followupArgs]{
 		endpointName: "CreateAsk",
 		endpoint:     keeper.NewMsgServer(s.k).CreateAsk,
-		followup: func(_ *exchange.MsgCreateAskRequest, fargs followupArgs) {
+		followup: func(msg *exchange.MsgCreateAskRequest, fargs followupArgs) {
 			s.checkBalances(fargs.expBal)
+			if len(msg.IdempotencyKey) > 0 {
+				orderID, found := s.k.GetIdempotentOrderID(s.ctx, msg.AskOrder.Seller, msg.IdempotencyKey)
+				s.Assert().True(found, "GetIdempotentOrderID found")
+				s.Assert().Equal(fargs.expOrderID, orderID, "GetIdempotentOrderID order id")
+			}
 		},
 	}
 
@@ -436,6 +449,39 @@ func (s *TestSuite) TestMsgServer_CreateAsk() {
 			},
 			expInErr: []string{invReqErr, "market 7 does not exist"},
 		},
+		{
+			name: "order rate limit reached",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 1, AcceptingOrders: true,
+					OrderRateLimit: &exchange.OrderRateLimit{MaxOrders: 2, WindowBlocks: 1},
+				})
+				s.getStore().Set(keeper.MakeKeyOrderCreationCount(1, s.addr1, s.ctx.BlockHeight()), []byte{0, 0, 0, 2})
+			},
+			msg: exchange.MsgCreateAskRequest{
+				AskOrder: exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("1peach"),
+				},
+			},
+			expInErr: []string{invReqErr,
+				"account " + s.addr1.String() + " cannot create more than 2 orders in market 1 every 1 blocks"},
+		},
+		{
+			name: "max exposure exceeded",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 1, AcceptingOrders: true, MaxExposure: s.coinP("10peach"),
+				})
+				keeper.SetCommitmentAmount(s.getStore(), 1, s.addr1, s.coins("8peach"), s.ctx.BlockTime())
+			},
+			msg: exchange.MsgCreateAskRequest{
+				AskOrder: exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("3peach"),
+				},
+			},
+			expInErr: []string{invReqErr,
+				"account " + s.addr1.String() + " exposure in market 1 would be 11peach, which exceeds the max of 10peach"},
+		},
 		{
 			name: "cannot collect creation fee",
 			setup: func() {
@@ -642,6 +688,62 @@ func (s *TestSuite) TestMsgServer_CreateAsk() {
 				}),
 			},
 		},
+		{
+			name: "okay: new idempotency key",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{MarketId: 5, AcceptingOrders: true})
+				s.requireFundAccount(s.addr2, "100apple,100fig,100pear")
+				keeper.SetLastOrderID(s.getStore(), 83)
+				s.requireSetIdempotencyKeyRecords(s.idempotencyKeyRecord(s.addr1.String(), "retry-1", 12, 1))
+			},
+			msg: exchange.MsgCreateAskRequest{
+				AskOrder: exchange.AskOrder{
+					MarketId: 5, Seller: s.addr2.String(),
+					Assets: s.coin("60apple"), Price: s.coin("45pear"),
+				},
+				IdempotencyKey: "retry-1",
+			},
+			fArgs: followupArgs{
+				expOrderID: 84,
+				expBal: expBalances{
+					addr:     s.addr2,
+					expBal:   s.coins("100apple,100fig,100pear"),
+					expHold:  []sdk.Coin{s.coin("60apple"), s.zeroCoin("fig"), s.zeroCoin("pear")},
+					expSpend: s.coins("40apple,100fig,100pear"),
+				},
+			},
+			expEvents: sdk.Events{
+				s.eventHoldAddedOrder(s.addr2, "60apple", 84),
+				s.untypeEvent(&exchange.EventOrderCreated{
+					OrderId: 84, OrderType: "ask", MarketId: 5, ExternalId: "",
+				}),
+			},
+		},
+		{
+			name: "okay: idempotency key already used",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{MarketId: 5, AcceptingOrders: true})
+				s.requireFundAccount(s.addr2, "100apple,100fig,100pear")
+				keeper.SetLastOrderID(s.getStore(), 83)
+				s.requireSetIdempotencyKeyRecords(s.idempotencyKeyRecord(s.addr2.String(), "retry-1", 12, 1))
+			},
+			msg: exchange.MsgCreateAskRequest{
+				AskOrder: exchange.AskOrder{
+					MarketId: 5, Seller: s.addr2.String(),
+					Assets: s.coin("60apple"), Price: s.coin("45pear"),
+				},
+				IdempotencyKey: "retry-1",
+			},
+			fArgs: followupArgs{
+				expOrderID: 12,
+				expBal: expBalances{
+					addr:     s.addr2,
+					expBal:   s.coins("100apple,100fig,100pear"),
+					expHold:  []sdk.Coin{s.zeroCoin("apple"), s.zeroCoin("fig"), s.zeroCoin("pear")},
+					expSpend: s.coins("100apple,100fig,100pear"),
+				},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -661,8 +763,13 @@ func (s *TestSuite) TestMsgServer_CreateBid() {
 	testDef := msgServerTestDef[exchange.MsgCreateBidRequest, exchange.MsgCreateBidResponse, followupArgs]{
 		endpointName: "CreateBid",
 		endpoint:     keeper.NewMsgServer(s.k).CreateBid,
-		followup: func(_ *exchange.MsgCreateBidRequest, fargs followupArgs) {
+		followup: func(msg *exchange.MsgCreateBidRequest, fargs followupArgs) {
 			s.checkBalances(fargs.expBal)
+			if len(msg.IdempotencyKey) > 0 {
+				orderID, found := s.k.GetIdempotentOrderID(s.ctx, msg.BidOrder.Buyer, msg.IdempotencyKey)
+				s.Assert().True(found, "GetIdempotentOrderID found")
+				s.Assert().Equal(fargs.expOrderID, orderID, "GetIdempotentOrderID order id")
+			}
 		},
 	}
 
@@ -686,6 +793,41 @@ func (s *TestSuite) TestMsgServer_CreateBid() {
 			},
 			expInErr: []string{invReqErr, "market 7 does not exist"},
 		},
+		{
+			name: "order rate limit reached",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 1, AcceptingOrders: true,
+					OrderRateLimit: &exchange.OrderRateLimit{MaxOrders: 3, WindowBlocks: 10},
+				})
+				s.getStore().Set(keeper.MakeKeyOrderCreationCount(1, s.addr1, s.ctx.BlockHeight()), []byte{0, 0, 0, 3})
+			},
+			msg: exchange.MsgCreateBidRequest{
+				BidOrder: exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("1peach"),
+				},
+			},
+			expInErr: []string{invReqErr,
+				"account " + s.addr1.String() + " cannot create more than 3 orders in market 1 every 10 blocks"},
+		},
+		{
+			name: "max exposure exceeded",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 1, AcceptingOrders: true, MaxExposure: s.coinP("10peach"),
+				})
+				s.requireSetOrdersInStore(s.getStore(), exchange.NewOrder(4).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("6peach"),
+				}))
+			},
+			msg: exchange.MsgCreateBidRequest{
+				BidOrder: exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("5peach"),
+				},
+			},
+			expInErr: []string{invReqErr,
+				"account " + s.addr1.String() + " exposure in market 1 would be 11peach, which exceeds the max of 10peach"},
+		},
 		{
 			name: "cannot collect creation fee",
 			setup: func() {
@@ -849,6 +991,62 @@ func (s *TestSuite) TestMsgServer_CreateBid() {
 				}),
 			},
 		},
+		{
+			name: "okay: new idempotency key",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{MarketId: 5, AcceptingOrders: true})
+				s.requireFundAccount(s.addr2, "100apple,100fig,100pear")
+				keeper.SetLastOrderID(s.getStore(), 83)
+				s.requireSetIdempotencyKeyRecords(s.idempotencyKeyRecord(s.addr1.String(), "retry-1", 12, 1))
+			},
+			msg: exchange.MsgCreateBidRequest{
+				BidOrder: exchange.BidOrder{
+					MarketId: 5, Buyer: s.addr2.String(),
+					Assets: s.coin("60apple"), Price: s.coin("45pear"),
+				},
+				IdempotencyKey: "retry-1",
+			},
+			fArgs: followupArgs{
+				expOrderID: 84,
+				expBal: expBalances{
+					addr:     s.addr2,
+					expBal:   s.coins("100apple,100fig,100pear"),
+					expHold:  []sdk.Coin{s.zeroCoin("apple"), s.zeroCoin("fig"), s.coin("45pear")},
+					expSpend: s.coins("100apple,100fig,55pear"),
+				},
+			},
+			expEvents: sdk.Events{
+				s.eventHoldAddedOrder(s.addr2, "45pear", 84),
+				s.untypeEvent(&exchange.EventOrderCreated{
+					OrderId: 84, OrderType: "bid", MarketId: 5, ExternalId: "",
+				}),
+			},
+		},
+		{
+			name: "okay: idempotency key already used",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{MarketId: 5, AcceptingOrders: true})
+				s.requireFundAccount(s.addr2, "100apple,100fig,100pear")
+				keeper.SetLastOrderID(s.getStore(), 83)
+				s.requireSetIdempotencyKeyRecords(s.idempotencyKeyRecord(s.addr2.String(), "retry-1", 12, 1))
+			},
+			msg: exchange.MsgCreateBidRequest{
+				BidOrder: exchange.BidOrder{
+					MarketId: 5, Buyer: s.addr2.String(),
+					Assets: s.coin("60apple"), Price: s.coin("45pear"),
+				},
+				IdempotencyKey: "retry-1",
+			},
+			fArgs: followupArgs{
+				expOrderID: 12,
+				expBal: expBalances{
+					addr:     s.addr2,
+					expBal:   s.coins("100apple,100fig,100pear"),
+					expHold:  []sdk.Coin{s.zeroCoin("apple"), s.zeroCoin("fig"), s.zeroCoin("pear")},
+					expSpend: s.coins("100apple,100fig,100pear"),
+				},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -860,339 +1058,297 @@ func (s *TestSuite) TestMsgServer_CreateBid() {
 	}
 }
 
-func (s *TestSuite) TestMsgServer_CommitFunds() {
-	testDef := msgServerTestDef[exchange.MsgCommitFundsRequest, exchange.MsgCommitFundsResponse, expBalances]{
-		endpointName: "CommitFunds",
-		endpoint:     keeper.NewMsgServer(s.k).CommitFunds,
-		expResp:      &exchange.MsgCommitFundsResponse{},
-		followup: func(_ *exchange.MsgCommitFundsRequest, expBal expBalances) {
-			s.checkBalances(expBal)
+func (s *TestSuite) TestMsgServer_CreateTriggerAsk() {
+	type followupArgs struct {
+		expOrderID uint64
+		expBal     expBalances
+	}
+	testDef := msgServerTestDef[exchange.MsgCreateTriggerAskRequest, exchange.MsgCreateTriggerAskResponse, followupArgs]{
+		endpointName: "CreateTriggerAsk",
+		endpoint:     keeper.NewMsgServer(s.k).CreateTriggerAsk,
+		followup: func(_ *exchange.MsgCreateTriggerAskRequest, fargs followupArgs) {
+			s.checkBalances(fargs.expBal)
 		},
 	}
 
-	tests := []msgServerTestCase[exchange.MsgCommitFundsRequest, expBalances]{
+	tests := []msgServerTestCase[exchange.MsgCreateTriggerAskRequest, followupArgs]{
 		{
-			name: "insufficient fee",
-			setup: func() {
-				s.requireCreateMarket(exchange.Market{
-					MarketId:                3,
-					AcceptingCommitments:    true,
-					FeeCreateCommitmentFlat: s.coins("10cherry"),
-				})
-				s.requireFundAccount(s.addr2, "100apple,100cherry")
-			},
-			msg: exchange.MsgCommitFundsRequest{
-				Account:     s.addr2.String(),
-				MarketId:    3,
-				Amount:      s.coins("15apple"),
-				CreationFee: s.coinP("9cherry"),
-			},
-			expInErr: []string{invReqErr,
-				"insufficient commitment creation fee: \"9cherry\" is less than required amount \"10cherry\""},
-			fArgs: expBalances{
-				addr:     s.addr2,
-				expSpend: s.coins("100apple,100cherry"),
+			name: "market does not exist",
+			msg: exchange.MsgCreateTriggerAskRequest{
+				AskOrder: exchange.AskOrder{
+					MarketId: 7, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("1peach"),
+				},
+				TriggerPrice: s.coin("2peach"),
 			},
+			expInErr: []string{invReqErr, "market 7 does not exist"},
 		},
 		{
-			name: "market does not accept commitments",
+			name: "order rate limit reached",
 			setup: func() {
-				s.requireCreateMarket(exchange.Market{
-					MarketId:             3,
-					AcceptingCommitments: false,
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 1, AcceptingOrders: true,
+					OrderRateLimit: &exchange.OrderRateLimit{MaxOrders: 1, WindowBlocks: 1},
 				})
-				s.requireFundAccount(s.addr2, "100apple,100cherry")
-			},
-			msg: exchange.MsgCommitFundsRequest{
-				Account:  s.addr2.String(),
-				MarketId: 3,
-				Amount:   s.coins("10apple"),
+				s.getStore().Set(keeper.MakeKeyOrderCreationCount(1, s.addr1, s.ctx.BlockHeight()), []byte{0, 0, 0, 1})
 			},
-			expInErr: []string{invReqErr, "market 3 is not accepting commitments"},
-			fArgs: expBalances{
-				addr:     s.addr2,
-				expSpend: s.coins("100apple,100cherry"),
+			msg: exchange.MsgCreateTriggerAskRequest{
+				AskOrder: exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("1peach"),
+				},
+				TriggerPrice: s.coin("2peach"),
 			},
+			expInErr: []string{invReqErr,
+				"account " + s.addr1.String() + " cannot create more than 1 orders in market 1 every 1 blocks"},
 		},
 		{
-			name: "insufficient funds",
+			name: "trigger price denom different from price denom",
 			setup: func() {
-				s.requireCreateMarket(exchange.Market{
-					MarketId:                3,
-					AcceptingCommitments:    true,
-					FeeCreateCommitmentFlat: s.coins("10cherry"),
-				})
-				s.requireFundAccount(s.addr2, "100apple,100cherry")
-			},
-			msg: exchange.MsgCommitFundsRequest{
-				Account:     s.addr2.String(),
-				MarketId:    3,
-				Amount:      s.coins("50apple,91cherry"),
-				CreationFee: s.coinP("10cherry"),
+				s.requireCreateMarketUnmocked(exchange.Market{MarketId: 1, AcceptingOrders: true})
 			},
-			expInErr: []string{invReqErr, "account " + s.addr2.String() + " spendable balance 90cherry is less than hold amount 91cherry"},
-			fArgs: expBalances{
-				addr:     s.addr2,
-				expSpend: s.coins("100apple,100cherry"),
+			msg: exchange.MsgCreateTriggerAskRequest{
+				AskOrder: exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("1peach"),
+				},
+				TriggerPrice: s.coin("2pear"),
 			},
+			expInErr: []string{invReqErr, "invalid trigger price \"2pear\": denom must match order price denom \"peach\""},
 		},
 		{
 			name: "okay",
 			setup: func() {
-				s.requireCreateMarket(exchange.Market{
-					MarketId:                3,
-					AcceptingCommitments:    true,
-					FeeCreateCommitmentFlat: s.coins("10cherry"),
-					ReqAttrCreateCommitment: []string{"you.got.it"},
-				})
-				s.requireFundAccount(s.addr2, "100apple,100cherry")
-				s.requireSetNameRecord("you.got.it", s.addr5)
-				s.requireSetAttr(s.addr2, "you.got.it", s.addr5)
+				s.requireCreateMarketUnmocked(exchange.Market{MarketId: 2, AcceptingOrders: true})
+				s.requireFundAccount(s.addr1, "100apple,100pear")
+				keeper.SetLastOrderID(s.getStore(), 12)
 			},
-			msg: exchange.MsgCommitFundsRequest{
-				Account:     s.addr2.String(),
-				MarketId:    3,
-				Amount:      s.coins("50apple,90cherry"),
-				CreationFee: s.coinP("10cherry"),
-				EventTag:    "yayayayeah",
+			msg: exchange.MsgCreateTriggerAskRequest{
+				AskOrder: exchange.AskOrder{
+					MarketId: 2, Seller: s.addr1.String(),
+					Assets: s.coin("60apple"), Price: s.coin("45pear"),
+					ExternalId: "stop-loss",
+				},
+				TriggerPrice: s.coin("50pear"),
 			},
-			fArgs: expBalances{
-				addr:     s.addr2,
-				expBal:   s.coins("100apple,90cherry"),
-				expHold:  s.coins("50apple,90cherry"),
-				expSpend: []sdk.Coin{s.coin("50apple"), s.zeroCoin("cherry")},
+			fArgs: followupArgs{
+				expOrderID: 13,
+				expBal: expBalances{
+					addr:     s.addr1,
+					expBal:   s.coins("100apple,100pear"),
+					expHold:  []sdk.Coin{s.coin("60apple"), s.zeroCoin("pear")},
+					expSpend: s.coins("40apple,100pear"),
+				},
 			},
 			expEvents: sdk.Events{
-				s.eventCoinSpent(s.addr2, "10cherry"),
-				s.eventCoinReceived(s.marketAddr3, "10cherry"),
-				s.eventTransfer(s.marketAddr3, s.addr2, "10cherry"),
-				s.eventMessageSender(s.addr2),
-				s.eventCoinSpent(s.marketAddr3, "1cherry"),
-				s.eventCoinReceived(s.feeCollectorAddr, "1cherry"),
-				s.eventTransfer(s.feeCollectorAddr, s.marketAddr3, "1cherry"),
-				s.eventMessageSender(s.marketAddr3),
-				s.untypeEvent(hold.NewEventHoldAdded(s.addr2, s.coins("50apple,90cherry"), "x/exchange: commitment to 3")),
-				s.untypeEvent(exchange.NewEventFundsCommitted(s.addr2.String(), 3, s.coins("50apple,90cherry"), "yayayayeah")),
+				s.eventHoldAddedOrder(s.addr1, "60apple", 13),
+				s.untypeEvent(&exchange.EventTriggerOrderCreated{
+					OrderId: 13, OrderType: "ask", MarketId: 2, ExternalId: "stop-loss", TriggerPrice: "50pear",
+				}),
 			},
 		},
 	}
 
 	for _, tc := range tests {
 		s.Run(tc.name, func() {
-			runMsgServerTestCase(s, testDef, tc)
+			td := testDef
+			td.expResp = &exchange.MsgCreateTriggerAskResponse{OrderId: tc.fArgs.expOrderID}
+			runMsgServerTestCase(s, td, tc)
 		})
 	}
 }
 
-func (s *TestSuite) TestMsgServer_CancelOrder() {
-	testDef := msgServerTestDef[exchange.MsgCancelOrderRequest, exchange.MsgCancelOrderResponse, expBalances]{
-		endpointName: "CancelOrder",
-		endpoint:     keeper.NewMsgServer(s.k).CancelOrder,
-		expResp:      &exchange.MsgCancelOrderResponse{},
-		followup: func(msg *exchange.MsgCancelOrderRequest, eb expBalances) {
-			order, err := s.k.GetOrder(s.ctx, msg.OrderId)
-			s.Assert().NoError(err, "GetOrder(%d) error", msg.OrderId)
-			s.Assert().Nil(order, "GetOrder(%d) order", msg.OrderId)
-			s.checkBalances(eb)
+func (s *TestSuite) TestMsgServer_CreateTriggerBid() {
+	type followupArgs struct {
+		expOrderID uint64
+		expBal     expBalances
+	}
+	testDef := msgServerTestDef[exchange.MsgCreateTriggerBidRequest, exchange.MsgCreateTriggerBidResponse, followupArgs]{
+		endpointName: "CreateTriggerBid",
+		endpoint:     keeper.NewMsgServer(s.k).CreateTriggerBid,
+		followup: func(_ *exchange.MsgCreateTriggerBidRequest, fargs followupArgs) {
+			s.checkBalances(fargs.expBal)
 		},
 	}
 
-	tests := []msgServerTestCase[exchange.MsgCancelOrderRequest, expBalances]{
-		{
-			name:     "order 0",
-			setup:    nil,
-			msg:      exchange.MsgCancelOrderRequest{Signer: s.addr1.String(), OrderId: 0},
-			expInErr: []string{invReqErr, "order 0 does not exist"},
-		},
-		{
-			name: "order does not exist",
-			setup: func() {
-				s.requireCreateMarketUnmocked(exchange.Market{MarketId: 3})
-				store := s.getStore()
-				s.requireSetOrderInStore(store, exchange.NewOrder(5).WithAsk(&exchange.AskOrder{
-					MarketId: 3, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("1pear"),
-				}))
-				s.requireSetOrderInStore(store, exchange.NewOrder(7).WithBid(&exchange.BidOrder{
-					MarketId: 3, Buyer: s.addr3.String(), Assets: s.coin("1apple"), Price: s.coin("1pear"),
-				}))
-			},
-			msg:      exchange.MsgCancelOrderRequest{Signer: s.addr2.String(), OrderId: 6},
-			expInErr: []string{invReqErr, "order 6 does not exist"},
-		},
+	tests := []msgServerTestCase[exchange.MsgCreateTriggerBidRequest, followupArgs]{
 		{
-			name: "wrong signer",
-			setup: func() {
-				s.requireCreateMarketUnmocked(exchange.Market{MarketId: 2})
-				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(83).WithAsk(&exchange.AskOrder{
-					MarketId: 2, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("1pear"),
-				}))
+			name: "market does not exist",
+			msg: exchange.MsgCreateTriggerBidRequest{
+				BidOrder: exchange.BidOrder{
+					MarketId: 7, Buyer: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("1peach"),
+				},
+				TriggerPrice: s.coin("2peach"),
 			},
-			msg:      exchange.MsgCancelOrderRequest{Signer: s.addr2.String(), OrderId: 83},
-			expInErr: []string{invReqErr, "account " + s.addr2.String() + " does not have permission to cancel order 83"},
+			expInErr: []string{invReqErr, "market 7 does not exist"},
 		},
 		{
-			name: "market signer: ask",
+			name: "order rate limit reached",
 			setup: func() {
 				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId:     2,
-					AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_cancel)},
+					MarketId: 1, AcceptingOrders: true,
+					OrderRateLimit: &exchange.OrderRateLimit{MaxOrders: 1, WindowBlocks: 1},
 				})
-				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(44).WithAsk(&exchange.AskOrder{
-					MarketId: 2, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("1pear"),
-				}))
-				s.requireFundAccount(s.addr1, "10apple")
-				s.requireAddHold(s.addr1, "2apple", 44)
-			},
-			msg: exchange.MsgCancelOrderRequest{Signer: s.addr5.String(), OrderId: 44},
-			fArgs: expBalances{
-				addr:     s.addr1,
-				expBal:   s.coins("10apple"),
-				expHold:  s.coins("1apple"),
-				expSpend: s.coins("9apple"),
+				s.getStore().Set(keeper.MakeKeyOrderCreationCount(1, s.addr1, s.ctx.BlockHeight()), []byte{0, 0, 0, 1})
 			},
-			expEvents: sdk.Events{
-				s.eventHoldReleased(s.addr1, "1apple"),
-				s.untypeEvent(&exchange.EventOrderCancelled{
-					OrderId: 44, CancelledBy: s.addr5.String(), MarketId: 2, ExternalId: "",
-				}),
+			msg: exchange.MsgCreateTriggerBidRequest{
+				BidOrder: exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("1peach"),
+				},
+				TriggerPrice: s.coin("2peach"),
 			},
+			expInErr: []string{invReqErr,
+				"account " + s.addr1.String() + " cannot create more than 1 orders in market 1 every 1 blocks"},
 		},
 		{
-			name: "market signer: bid",
+			name: "okay",
 			setup: func() {
-				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId:     2,
-					AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_cancel)},
-				})
-				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(44).WithBid(&exchange.BidOrder{
-					MarketId: 2, Buyer: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("1pear"),
-				}))
-				s.requireFundAccount(s.addr1, "10pear")
-				s.requireAddHold(s.addr1, "1pear", 44)
+				s.requireCreateMarketUnmocked(exchange.Market{MarketId: 2, AcceptingOrders: true})
+				s.requireFundAccount(s.addr2, "100apple,100pear")
+				keeper.SetLastOrderID(s.getStore(), 83)
 			},
-			msg: exchange.MsgCancelOrderRequest{Signer: s.addr5.String(), OrderId: 44},
-			fArgs: expBalances{
-				addr:     s.addr1,
-				expBal:   s.coins("10pear"),
-				expHold:  []sdk.Coin{s.zeroCoin("pear")},
-				expSpend: s.coins("10pear"),
+			msg: exchange.MsgCreateTriggerBidRequest{
+				BidOrder: exchange.BidOrder{
+					MarketId: 2, Buyer: s.addr2.String(),
+					Assets: s.coin("60apple"), Price: s.coin("45pear"),
+				},
+				TriggerPrice: s.coin("40pear"),
+			},
+			fArgs: followupArgs{
+				expOrderID: 84,
+				expBal: expBalances{
+					addr:     s.addr2,
+					expBal:   s.coins("100apple,100pear"),
+					expHold:  []sdk.Coin{s.zeroCoin("apple"), s.coin("45pear")},
+					expSpend: s.coins("100apple,55pear"),
+				},
 			},
 			expEvents: sdk.Events{
-				s.eventHoldReleased(s.addr1, "1pear"),
-				s.untypeEvent(&exchange.EventOrderCancelled{
-					OrderId: 44, CancelledBy: s.addr5.String(), MarketId: 2, ExternalId: "",
+				s.eventHoldAddedOrder(s.addr2, "45pear", 84),
+				s.untypeEvent(&exchange.EventTriggerOrderCreated{
+					OrderId: 84, OrderType: "bid", MarketId: 2, ExternalId: "", TriggerPrice: "40pear",
 				}),
 			},
 		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			td := testDef
+			td.expResp = &exchange.MsgCreateTriggerBidResponse{OrderId: tc.fArgs.expOrderID}
+			runMsgServerTestCase(s, td, tc)
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_CreateOrdersBatch() {
+	type followupArgs struct {
+		expAskIDs []uint64
+		expBidIDs []uint64
+		expBal    expBalances
+	}
+	testDef := msgServerTestDef[exchange.MsgCreateOrdersBatchRequest, exchange.MsgCreateOrdersBatchResponse, followupArgs]{
+		endpointName: "CreateOrdersBatch",
+		endpoint:     keeper.NewMsgServer(s.k).CreateOrdersBatch,
+		followup: func(_ *exchange.MsgCreateOrdersBatchRequest, fargs followupArgs) {
+			s.checkBalances(fargs.expBal)
+		},
+	}
+
+	tests := []msgServerTestCase[exchange.MsgCreateOrdersBatchRequest, followupArgs]{
 		{
-			name: "ask with diff fee denom from price",
-			setup: func() {
-				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(5555).WithAsk(&exchange.AskOrder{
-					MarketId:                1,
-					Seller:                  s.addr1.String(),
-					Assets:                  s.coin("10apple"),
-					Price:                   s.coin("5pear"),
-					SellerSettlementFlatFee: s.coinP("1fig"),
-					ExternalId:              "ext-id-5555",
-				}))
-				s.requireFundAccount(s.addr1, "15apple,5fig")
-				s.requireAddHold(s.addr1, "10apple,1fig", 5555)
-			},
-			msg: exchange.MsgCancelOrderRequest{Signer: s.addr1.String(), OrderId: 5555},
-			fArgs: expBalances{
-				addr:     s.addr1,
-				expBal:   s.coins("15apple,5fig"),
-				expHold:  s.zeroCoins("apple", "fig"),
-				expSpend: s.coins("15apple,5fig"),
-			},
-			expEvents: sdk.Events{
-				s.eventHoldReleased(s.addr1, "10apple,1fig"),
-				s.untypeEvent(&exchange.EventOrderCancelled{
-					OrderId: 5555, CancelledBy: s.addr1.String(), MarketId: 1, ExternalId: "ext-id-5555",
-				}),
+			name: "market does not exist",
+			msg: exchange.MsgCreateOrdersBatchRequest{
+				Owner:    s.addr1.String(),
+				MarketId: 7,
+				BidOrders: []exchange.BidOrder{
+					{MarketId: 7, Buyer: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("1peach")},
+				},
 			},
+			expInErr: []string{invReqErr, "bid order [0]: market 7 does not exist"},
 		},
 		{
-			name: "ask with same fee denom as price",
+			name: "order rate limit reached by the batch",
 			setup: func() {
-				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(98765).WithAsk(&exchange.AskOrder{
-					MarketId:                3,
-					Seller:                  s.addr2.String(),
-					Assets:                  s.coin("10apple"),
-					Price:                   s.coin("5pear"),
-					SellerSettlementFlatFee: s.coinP("1pear"),
-					ExternalId:              "whatever",
-				}))
-				s.requireFundAccount(s.addr2, "15apple,5fig")
-				s.requireAddHold(s.addr2, "10apple,1fig", 98765)
-			},
-			msg: exchange.MsgCancelOrderRequest{Signer: s.addr2.String(), OrderId: 98765},
-			fArgs: expBalances{
-				addr:     s.addr2,
-				expBal:   s.coins("15apple,5fig"),
-				expHold:  []sdk.Coin{s.zeroCoin("apple"), s.coin("1fig")},
-				expSpend: s.coins("15apple,4fig"),
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 2, AcceptingOrders: true,
+					OrderRateLimit: &exchange.OrderRateLimit{MaxOrders: 2, WindowBlocks: 1},
+				})
+				s.requireFundAccount(s.addr2, "100apple,100pear")
 			},
-			expEvents: sdk.Events{
-				s.eventHoldReleased(s.addr2, "10apple"),
-				s.untypeEvent(&exchange.EventOrderCancelled{
-					OrderId: 98765, CancelledBy: s.addr2.String(), MarketId: 3, ExternalId: "whatever",
-				}),
+			msg: exchange.MsgCreateOrdersBatchRequest{
+				Owner:    s.addr2.String(),
+				MarketId: 2,
+				AskOrders: []exchange.AskOrder{
+					{MarketId: 2, Seller: s.addr2.String(), Assets: s.coin("60apple"), Price: s.coin("70pear")},
+				},
+				BidOrders: []exchange.BidOrder{
+					{MarketId: 2, Buyer: s.addr2.String(), Assets: s.coin("60apple"), Price: s.coin("45pear")},
+					{MarketId: 2, Buyer: s.addr2.String(), Assets: s.coin("20apple"), Price: s.coin("10pear")},
+				},
 			},
+			expInErr: []string{invReqErr,
+				"account " + s.addr2.String() + " cannot create more than 2 orders in market 2 every 1 blocks"},
 		},
 		{
-			name: "bid with diff fee denom from price",
+			name: "bid price not in account",
 			setup: func() {
-				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(5555).WithBid(&exchange.BidOrder{
-					MarketId:            1,
-					Buyer:               s.addr1.String(),
-					Assets:              s.coin("10apple"),
-					Price:               s.coin("5pear"),
-					BuyerSettlementFees: s.coins("1fig"),
-					ExternalId:          "ext-id-5555",
-				}))
-				s.requireFundAccount(s.addr1, "15pear,5fig")
-				s.requireAddHold(s.addr1, "5pear,1fig", 5555)
+				s.requireCreateMarketUnmocked(exchange.Market{MarketId: 2, AcceptingOrders: true})
+				s.requireFundAccount(s.addr2, "100apple,50pear")
 			},
-			msg: exchange.MsgCancelOrderRequest{Signer: s.addr1.String(), OrderId: 5555},
-			fArgs: expBalances{
-				addr:     s.addr1,
-				expBal:   s.coins("15pear,5fig"),
-				expHold:  s.zeroCoins("pear", "fig"),
-				expSpend: s.coins("15pear,5fig"),
+			msg: exchange.MsgCreateOrdersBatchRequest{
+				Owner:    s.addr2.String(),
+				MarketId: 2,
+				AskOrders: []exchange.AskOrder{
+					{MarketId: 2, Seller: s.addr2.String(), Assets: s.coin("60apple"), Price: s.coin("70pear")},
+				},
+				BidOrders: []exchange.BidOrder{
+					{MarketId: 2, Buyer: s.addr2.String(), Assets: s.coin("60apple"), Price: s.coin("45pear")},
+					{MarketId: 2, Buyer: s.addr2.String(), Assets: s.coin("20apple"), Price: s.coin("10pear")},
+				},
 			},
-			expEvents: sdk.Events{
-				s.eventHoldReleased(s.addr1, "1fig,5pear"),
-				s.untypeEvent(&exchange.EventOrderCancelled{
-					OrderId: 5555, CancelledBy: s.addr1.String(), MarketId: 1, ExternalId: "ext-id-5555",
-				}),
+			expInErr: []string{
+				invReqErr, "bid order [1]: error placing hold for bid order 3",
+				"account " + s.addr2.String() + " spendable balance 5pear is less than hold amount 10pear",
 			},
 		},
 		{
-			name: "bid with same fee denom as price",
+			name: "okay",
 			setup: func() {
-				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(98765).WithBid(&exchange.BidOrder{
-					MarketId:            3,
-					Buyer:               s.addr2.String(),
-					Assets:              s.coin("10apple"),
-					Price:               s.coin("5pear"),
-					BuyerSettlementFees: s.coins("1pear"),
-					ExternalId:          "whatever",
-				}))
-				s.requireFundAccount(s.addr2, "15pear,5fig")
-				s.requireAddHold(s.addr2, "6pear", 98765)
+				s.requireCreateMarketUnmocked(exchange.Market{MarketId: 2, AcceptingOrders: true})
+				s.requireFundAccount(s.addr2, "100apple,100pear")
+				keeper.SetLastOrderID(s.getStore(), 83)
 			},
-			msg: exchange.MsgCancelOrderRequest{Signer: s.addr2.String(), OrderId: 98765},
-			fArgs: expBalances{
-				addr:     s.addr2,
-				expBal:   s.coins("15pear,5fig"),
-				expHold:  s.zeroCoins("pear", "fig"),
-				expSpend: s.coins("15pear,5fig"),
+			msg: exchange.MsgCreateOrdersBatchRequest{
+				Owner:    s.addr2.String(),
+				MarketId: 2,
+				AskOrders: []exchange.AskOrder{
+					{MarketId: 2, Seller: s.addr2.String(), Assets: s.coin("30apple"), Price: s.coin("70pear")},
+					{MarketId: 2, Seller: s.addr2.String(), Assets: s.coin("20apple"), Price: s.coin("50pear")},
+				},
+				BidOrders: []exchange.BidOrder{
+					{MarketId: 2, Buyer: s.addr2.String(), Assets: s.coin("60apple"), Price: s.coin("45pear")},
+				},
+			},
+			fArgs: followupArgs{
+				expAskIDs: []uint64{84, 85},
+				expBidIDs: []uint64{86},
+				expBal: expBalances{
+					addr:     s.addr2,
+					expBal:   s.coins("100apple,100pear"),
+					expHold:  s.coins("50apple,45pear"),
+					expSpend: s.coins("50apple,55pear"),
+				},
 			},
 			expEvents: sdk.Events{
-				s.eventHoldReleased(s.addr2, "6pear"),
-				s.untypeEvent(&exchange.EventOrderCancelled{
-					OrderId: 98765, CancelledBy: s.addr2.String(), MarketId: 3, ExternalId: "whatever",
+				s.eventHoldAddedOrder(s.addr2, "30apple", 84),
+				s.untypeEvent(&exchange.EventOrderCreated{
+					OrderId: 84, OrderType: "ask", MarketId: 2, ExternalId: "",
+				}),
+				s.eventHoldAddedOrder(s.addr2, "20apple", 85),
+				s.untypeEvent(&exchange.EventOrderCreated{
+					OrderId: 85, OrderType: "ask", MarketId: 2, ExternalId: "",
+				}),
+				s.eventHoldAddedOrder(s.addr2, "45pear", 86),
+				s.untypeEvent(&exchange.EventOrderCreated{
+					OrderId: 86, OrderType: "bid", MarketId: 2, ExternalId: "",
 				}),
 			},
 		},
@@ -1200,493 +1356,313 @@ func (s *TestSuite) TestMsgServer_CancelOrder() {
 
 	for _, tc := range tests {
 		s.Run(tc.name, func() {
-			runMsgServerTestCase(s, testDef, tc)
+			td := testDef
+			td.expResp = &exchange.MsgCreateOrdersBatchResponse{AskOrderIds: tc.fArgs.expAskIDs, BidOrderIds: tc.fArgs.expBidIDs}
+			runMsgServerTestCase(s, td, tc)
 		})
 	}
 }
 
-func (s *TestSuite) TestMsgServer_FillBids() {
-	testDef := msgServerTestDef[exchange.MsgFillBidsRequest, exchange.MsgFillBidsResponse, []expBalances]{
-		endpointName: "FillBids",
-		endpoint:     keeper.NewMsgServer(s.k).FillBids,
-		expResp:      &exchange.MsgFillBidsResponse{},
-		followup: func(msg *exchange.MsgFillBidsRequest, ebs []expBalances) {
-			for _, orderID := range msg.BidOrderIds {
-				order, err := s.k.GetOrder(s.ctx, orderID)
-				s.Assert().NoError(err, "GetOrder(%d) error", orderID)
-				s.Assert().Nil(order, "GetOrder(%d) order", orderID)
-			}
-
-			for _, eb := range ebs {
-				s.checkBalances(eb)
-			}
+func (s *TestSuite) TestMsgServer_CommitFunds() {
+	testDef := msgServerTestDef[exchange.MsgCommitFundsRequest, exchange.MsgCommitFundsResponse, expBalances]{
+		endpointName: "CommitFunds",
+		endpoint:     keeper.NewMsgServer(s.k).CommitFunds,
+		expResp:      &exchange.MsgCommitFundsResponse{},
+		followup: func(_ *exchange.MsgCommitFundsRequest, expBal expBalances) {
+			s.checkBalances(expBal)
 		},
 	}
 
-	tests := []msgServerTestCase[exchange.MsgFillBidsRequest, []expBalances]{
-		{
-			name: "user can't create ask",
-			setup: func() {
-				s.requireSetNameRecord("almost.gonna.have.it", s.addr5)
-				s.requireSetAttr(s.addr1, "almost.gonna.have.it", s.addr5)
-
-				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId: 1, AcceptingOrders: true, AllowUserSettlement: true,
-					ReqAttrCreateAsk: []string{"not.gonna.have.it"},
-				})
-			},
-			msg: exchange.MsgFillBidsRequest{
-				Seller:      s.addr1.String(),
-				MarketId:    1,
-				TotalAssets: s.coins("1apple"),
-				BidOrderIds: []uint64{1},
-			},
-			expInErr: []string{invReqErr, "account " + s.addr1.String() + " is not allowed to create ask orders in market 1"},
-		},
+	tests := []msgServerTestCase[exchange.MsgCommitFundsRequest, expBalances]{
 		{
-			name: "one bid, both quarantined, no markers",
+			name: "insufficient fee",
 			setup: func() {
-				s.requireFundAccount(s.addr1, "50pear")
-				s.requireFundAccount(s.addr2, "10apple")
-
-				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId: 3, AcceptingOrders: true, AllowUserSettlement: true,
+				s.requireCreateMarket(exchange.Market{
+					MarketId:                3,
+					AcceptingCommitments:    true,
+					FeeCreateCommitmentFlat: s.coins("10cherry"),
 				})
-				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(54).WithBid(&exchange.BidOrder{
-					MarketId: 3, Buyer: s.addr1.String(), Assets: s.coin("10apple"), Price: s.coin("50pear"),
-				}))
-				s.requireAddHold(s.addr1, "50pear", 54)
-
-				s.requireQuarantineOptIn(s.addr1)
-				s.requireQuarantineOptIn(s.addr2)
+				s.requireFundAccount(s.addr2, "100apple,100cherry")
 			},
-			msg: exchange.MsgFillBidsRequest{
-				Seller:      s.addr2.String(),
+			msg: exchange.MsgCommitFundsRequest{
+				Account:     s.addr2.String(),
 				MarketId:    3,
-				TotalAssets: s.coins("10apple"),
-				BidOrderIds: []uint64{54},
-			},
-			fArgs: []expBalances{
-				{
-					addr:     s.addr1,
-					expBal:   []sdk.Coin{s.coin("10apple"), s.zeroCoin("pear")},
-					expHold:  s.zeroCoins("apple", "pear"),
-					expSpend: []sdk.Coin{s.coin("10apple"), s.zeroCoin("pear")},
-				},
-				{
-					addr:     s.addr2,
-					expBal:   []sdk.Coin{s.zeroCoin("apple"), s.coin("50pear")},
-					expHold:  s.zeroCoins("apple", "pear"),
-					expSpend: []sdk.Coin{s.zeroCoin("apple"), s.coin("50pear")},
-				},
+				Amount:      s.coins("15apple"),
+				CreationFee: s.coinP("9cherry"),
 			},
-			expEvents: sdk.Events{
-				s.eventHoldReleased(s.addr1, "50pear"),
-				s.eventCoinSpent(s.addr2, "10apple"),
-				s.eventCoinReceived(s.addr1, "10apple"),
-				s.eventTransfer(s.addr1, s.addr2, "10apple"),
-				s.eventMessageSender(s.addr2),
-				s.eventCoinSpent(s.addr1, "50pear"),
-				s.eventCoinReceived(s.addr2, "50pear"),
-				s.eventTransfer(s.addr2, s.addr1, "50pear"),
-				s.eventMessageSender(s.addr1),
-				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 54, Assets: "10apple", Price: "50pear", MarketId: 3,
-				}),
-				s.markerNavSetEvent("10apple", "50pear", 3),
+			expInErr: []string{invReqErr,
+				"insufficient commitment creation fee: \"9cherry\" is less than required amount \"10cherry\""},
+			fArgs: expBalances{
+				addr:     s.addr2,
+				expSpend: s.coins("100apple,100cherry"),
 			},
 		},
 		{
-			name: "one bid, both quarantined, with markers",
+			name: "market does not accept commitments",
 			setup: func() {
-				s.requireAddFinalizeAndActivateMarker(s.coin("10apple"), s.addr5, "got.it")
-				s.requireAddFinalizeAndActivateMarker(s.coin("50pear"), s.addr5, "got.it")
-				s.requireSetNameRecord("got.it", s.addr5)
-				s.requireSetAttr(s.addr1, "got.it", s.addr5)
-				s.requireSetAttr(s.addr2, "got.it", s.addr5)
-				s.requireFundAccount(s.addr1, "50pear")
-				s.requireFundAccount(s.addr2, "10apple")
-
-				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId: 3, AcceptingOrders: true, AllowUserSettlement: true,
+				s.requireCreateMarket(exchange.Market{
+					MarketId:             3,
+					AcceptingCommitments: false,
 				})
-				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(54).WithBid(&exchange.BidOrder{
-					MarketId: 3, Buyer: s.addr1.String(), Assets: s.coin("10apple"), Price: s.coin("50pear"),
-				}))
-				s.requireAddHold(s.addr1, "50pear", 54)
-
-				s.requireQuarantineOptIn(s.addr1)
-				s.requireQuarantineOptIn(s.addr2)
-			},
-			msg: exchange.MsgFillBidsRequest{
-				Seller:      s.addr2.String(),
-				MarketId:    3,
-				TotalAssets: s.coins("10apple"),
-				BidOrderIds: []uint64{54},
+				s.requireFundAccount(s.addr2, "100apple,100cherry")
 			},
-			fArgs: []expBalances{
-				{
-					addr:     s.addr1,
-					expBal:   []sdk.Coin{s.coin("10apple"), s.zeroCoin("pear")},
-					expHold:  s.zeroCoins("apple", "pear"),
-					expSpend: []sdk.Coin{s.coin("10apple"), s.zeroCoin("pear")},
-				},
-				{
-					addr:     s.addr2,
-					expBal:   []sdk.Coin{s.zeroCoin("apple"), s.coin("50pear")},
-					expHold:  s.zeroCoins("apple", "pear"),
-					expSpend: []sdk.Coin{s.zeroCoin("apple"), s.coin("50pear")},
-				},
+			msg: exchange.MsgCommitFundsRequest{
+				Account:  s.addr2.String(),
+				MarketId: 3,
+				Amount:   s.coins("10apple"),
 			},
-			expEvents: sdk.Events{
-				s.eventHoldReleased(s.addr1, "50pear"),
-				s.eventCoinSpent(s.addr2, "10apple"),
-				s.eventCoinReceived(s.addr1, "10apple"),
-				s.eventTransfer(s.addr1, s.addr2, "10apple"),
-				s.eventMessageSender(s.addr2),
-				s.eventCoinSpent(s.addr1, "50pear"),
-				s.eventCoinReceived(s.addr2, "50pear"),
-				s.eventTransfer(s.addr2, s.addr1, "50pear"),
-				s.eventMessageSender(s.addr1),
-				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 54, Assets: "10apple", Price: "50pear", MarketId: 3,
-				}),
-				s.markerNavSetEvent("10apple", "50pear", 3),
+			expInErr: []string{invReqErr, "market 3 is not accepting commitments"},
+			fArgs: expBalances{
+				addr:     s.addr2,
+				expSpend: s.coins("100apple,100cherry"),
 			},
 		},
 		{
-			name: "one bid, buyer sanctioned",
+			name: "insufficient funds",
 			setup: func() {
-				s.requireFundAccount(s.addr1, "50pear")
-				s.requireFundAccount(s.addr4, "10apple")
-
-				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId: 2, AcceptingOrders: true, AllowUserSettlement: true,
+				s.requireCreateMarket(exchange.Market{
+					MarketId:                3,
+					AcceptingCommitments:    true,
+					FeeCreateCommitmentFlat: s.coins("10cherry"),
 				})
-				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(77).WithBid(&exchange.BidOrder{
-					MarketId: 2, Buyer: s.addr1.String(), Assets: s.coin("10apple"), Price: s.coin("50pear"),
-				}))
-				s.requireAddHold(s.addr1, "50pear", 77)
-
-				s.requireSanctionAddress(s.addr1)
-			},
-			msg: exchange.MsgFillBidsRequest{
-				Seller:      s.addr4.String(),
-				MarketId:    2,
-				TotalAssets: s.coins("10apple"),
-				BidOrderIds: []uint64{77},
+				s.requireFundAccount(s.addr2, "100apple,100cherry")
 			},
-			expInErr: []string{invReqErr, "cannot send from " + s.addr1.String(), "account is sanctioned"},
-		},
-		{
-			name: "one bid, seller sanctioned",
-			setup: func() {
-				s.requireFundAccount(s.addr1, "50pear")
-				s.requireFundAccount(s.addr4, "10apple")
-
-				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId: 2, AcceptingOrders: true, AllowUserSettlement: true,
-				})
-				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(77).WithBid(&exchange.BidOrder{
-					MarketId: 2, Buyer: s.addr1.String(), Assets: s.coin("10apple"), Price: s.coin("50pear"),
-				}))
-
-				s.requireAddHold(s.addr1, "50pear", 77)
-
-				s.requireSanctionAddress(s.addr4)
+			msg: exchange.MsgCommitFundsRequest{
+				Account:     s.addr2.String(),
+				MarketId:    3,
+				Amount:      s.coins("50apple,91cherry"),
+				CreationFee: s.coinP("10cherry"),
 			},
-			msg: exchange.MsgFillBidsRequest{
-				Seller:      s.addr4.String(),
-				MarketId:    2,
-				TotalAssets: s.coins("10apple"),
-				BidOrderIds: []uint64{77},
+			expInErr: []string{invReqErr, "account " + s.addr2.String() + " spendable balance 90cherry is less than hold amount 91cherry"},
+			fArgs: expBalances{
+				addr:     s.addr2,
+				expSpend: s.coins("100apple,100cherry"),
 			},
-			expInErr: []string{invReqErr, "cannot send from " + s.addr4.String(), "account is sanctioned"},
 		},
 		{
-			name: "one bid, buyer does not have asset marker's req attrs",
+			name: "max exposure exceeded",
 			setup: func() {
-				s.requireAddFinalizeAndActivateMarker(s.coin("10apple"), s.addr5, "not.gonna.have.it")
-				s.requireSetNameRecord("not.gonna.have.it", s.addr5)
-				s.requireSetAttr(s.addr1, "not.gonna.have.it", s.addr5)
-				s.requireFundAccount(s.addr2, "50pear")
-				s.requireFundAccount(s.addr1, "10apple")
-
-				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId: 2, AcceptingOrders: true, AllowUserSettlement: true,
+				s.requireCreateMarket(exchange.Market{
+					MarketId:             3,
+					AcceptingCommitments: true,
+					MaxExposure:          s.coinP("100cherry"),
 				})
-				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(4).WithBid(&exchange.BidOrder{
-					MarketId: 2, Buyer: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("50pear"),
-				}))
-				s.requireAddHold(s.addr2, "50pear", 4)
+				s.requireFundAccount(s.addr2, "100apple,100cherry")
+				s.requireSetCommitmentAmount(3, s.addr2, "60cherry")
 			},
-			msg: exchange.MsgFillBidsRequest{
-				Seller:      s.addr1.String(),
-				MarketId:    2,
-				TotalAssets: s.coins("10apple"),
-				BidOrderIds: []uint64{4},
+			msg: exchange.MsgCommitFundsRequest{
+				Account:  s.addr2.String(),
+				MarketId: 3,
+				Amount:   s.coins("41cherry"),
 			},
 			expInErr: []string{invReqErr,
-				"address " + s.addr2.String() + " does not contain the \"apple\" required attribute: \"not.gonna.have.it\"",
+				"account " + s.addr2.String() + " exposure in market 3 would be 101cherry, which exceeds the max of 100cherry"},
+			fArgs: expBalances{
+				addr:     s.addr2,
+				expHold:  s.coins("60cherry"),
+				expSpend: s.coins("100apple,40cherry"),
 			},
 		},
 		{
-			name: "one bid, seller does not have price marker's req attrs",
+			name: "okay",
 			setup: func() {
-				s.requireAddFinalizeAndActivateMarker(s.coin("50pear"), s.addr5, "not.gonna.have.it")
-				s.requireSetNameRecord("not.gonna.have.it", s.addr5)
-				s.requireSetAttr(s.addr2, "not.gonna.have.it", s.addr5)
-				s.requireFundAccount(s.addr2, "50pear")
-				s.requireFundAccount(s.addr1, "10apple")
-
-				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId: 2, AcceptingOrders: true, AllowUserSettlement: true,
+				s.requireCreateMarket(exchange.Market{
+					MarketId:                3,
+					AcceptingCommitments:    true,
+					FeeCreateCommitmentFlat: s.coins("10cherry"),
+					ReqAttrCreateCommitment: []string{"you.got.it"},
 				})
-				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(4).WithBid(&exchange.BidOrder{
-					MarketId: 2, Buyer: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("50pear"),
-				}))
-				s.requireAddHold(s.addr2, "50pear", 4)
+				s.requireFundAccount(s.addr2, "100apple,100cherry")
+				s.requireSetNameRecord("you.got.it", s.addr5)
+				s.requireSetAttr(s.addr2, "you.got.it", s.addr5)
 			},
-			msg: exchange.MsgFillBidsRequest{
-				Seller:      s.addr1.String(),
-				MarketId:    2,
-				TotalAssets: s.coins("10apple"),
-				BidOrderIds: []uint64{4},
+			msg: exchange.MsgCommitFundsRequest{
+				Account:     s.addr2.String(),
+				MarketId:    3,
+				Amount:      s.coins("50apple,90cherry"),
+				CreationFee: s.coinP("10cherry"),
+				EventTag:    "yayayayeah",
 			},
-			expInErr: []string{invReqErr,
-				"address " + s.addr1.String() + " does not contain the \"pear\" required attribute: \"not.gonna.have.it\"",
+			fArgs: expBalances{
+				addr:     s.addr2,
+				expBal:   s.coins("100apple,90cherry"),
+				expHold:  s.coins("50apple,90cherry"),
+				expSpend: []sdk.Coin{s.coin("50apple"), s.zeroCoin("cherry")},
+			},
+			expEvents: sdk.Events{
+				s.eventCoinSpent(s.addr2, "10cherry"),
+				s.eventCoinReceived(s.marketAddr3, "10cherry"),
+				s.eventTransfer(s.marketAddr3, s.addr2, "10cherry"),
+				s.eventMessageSender(s.addr2),
+				s.eventCoinSpent(s.marketAddr3, "1cherry"),
+				s.eventCoinReceived(s.feeCollectorAddr, "1cherry"),
+				s.eventTransfer(s.feeCollectorAddr, s.marketAddr3, "1cherry"),
+				s.eventMessageSender(s.marketAddr3),
+				s.untypeEvent(hold.NewEventHoldAdded(s.addr2, s.coins("50apple,90cherry"), "x/exchange: commitment to 3")),
+				s.untypeEvent(exchange.NewEventFundsCommitted(s.addr2.String(), 3, s.coins("50apple,90cherry"), "yayayayeah")),
 			},
 		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runMsgServerTestCase(s, testDef, tc)
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_TransferCommitment() {
+	type followupArgs struct {
+		expBal    expBalances
+		expCurAmt string
+		expNewAmt string
+	}
+	testDef := msgServerTestDef[exchange.MsgTransferCommitmentRequest, exchange.MsgTransferCommitmentResponse, followupArgs]{
+		endpointName: "TransferCommitment",
+		endpoint:     keeper.NewMsgServer(s.k).TransferCommitment,
+		expResp:      &exchange.MsgTransferCommitmentResponse{},
+		followup: func(msg *exchange.MsgTransferCommitmentRequest, fArgs followupArgs) {
+			s.checkBalances(fArgs.expBal)
+			addr, err := sdk.AccAddressFromBech32(msg.Account)
+			s.Require().NoError(err, "AccAddressFromBech32(%q)", msg.Account)
+			actCurAmt := s.k.GetCommitmentAmount(s.ctx, msg.CurrentMarketId, addr)
+			s.Assert().Equal(fArgs.expCurAmt, actCurAmt.String(), "GetCommitmentAmount(%d) (current market)", msg.CurrentMarketId)
+			actNewAmt := s.k.GetCommitmentAmount(s.ctx, msg.NewMarketId, addr)
+			s.Assert().Equal(fArgs.expNewAmt, actNewAmt.String(), "GetCommitmentAmount(%d) (new market)", msg.NewMarketId)
+		},
+	}
+
+	tests := []msgServerTestCase[exchange.MsgTransferCommitmentRequest, followupArgs]{
 		{
-			name: "market does not have req attr for fee denom",
+			name: "current market does not allow user settlement",
 			setup: func() {
-				s.requireAddFinalizeAndActivateMarker(s.coin("200fig"), s.addr5, "not.gonna.have.it")
-				s.requireSetNameRecord("not.gonna.have.it", s.addr5)
-				s.requireSetAttr(s.addr1, "not.gonna.have.it", s.addr5)
-				s.requireSetAttr(s.addr2, "not.gonna.have.it", s.addr5)
-				s.requireFundAccount(s.addr2, "50pear,100fig")
-				s.requireFundAccount(s.addr1, "10apple,100fig")
-
-				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId: 1, AcceptingOrders: true, AllowUserSettlement: true,
+				s.requireCreateMarket(exchange.Market{MarketId: 1, AcceptingCommitments: true})
+				s.requireCreateMarket(exchange.Market{MarketId: 2, AcceptingCommitments: true})
+				s.requireFundAccount(s.addr2, "100apple")
+				s.requireSetCommitmentAmount(1, s.addr2, "50apple")
+			},
+			msg: exchange.MsgTransferCommitmentRequest{
+				Account: s.addr2.String(), Amount: s.coins("50apple"), CurrentMarketId: 1, NewMarketId: 2,
+			},
+			expInErr: []string{invReqErr, "market 1 does not allow user-initiated commitment transfers"},
+			fArgs: followupArgs{
+				expBal:    expBalances{addr: s.addr2, expBal: s.coins("100apple"), expHold: s.coins("50apple"), expSpend: s.coins("50apple")},
+				expCurAmt: "50apple",
+			},
+		},
+		{
+			name: "new market not accepting commitments",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{MarketId: 1, AcceptingCommitments: true, AllowUserSettlement: true})
+				s.requireCreateMarket(exchange.Market{MarketId: 2})
+				s.requireFundAccount(s.addr2, "100apple")
+				s.requireSetCommitmentAmount(1, s.addr2, "50apple")
+			},
+			msg: exchange.MsgTransferCommitmentRequest{
+				Account: s.addr2.String(), Amount: s.coins("50apple"), CurrentMarketId: 1, NewMarketId: 2,
+			},
+			expInErr: []string{invReqErr, "new market 2 is invalid: market 2 is not accepting commitments"},
+			fArgs: followupArgs{
+				expBal:    expBalances{addr: s.addr2, expBal: s.coins("100apple"), expHold: s.coins("50apple"), expSpend: s.coins("50apple")},
+				expCurAmt: "50apple",
+			},
+		},
+		{
+			name: "account missing required attribute for new market",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{MarketId: 1, AcceptingCommitments: true, AllowUserSettlement: true})
+				s.requireCreateMarket(exchange.Market{
+					MarketId:                2,
+					AcceptingCommitments:    true,
+					ReqAttrCreateCommitment: []string{"you.got.it"},
 				})
-				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(12345).WithBid(&exchange.BidOrder{
-					MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("50pear"),
-					BuyerSettlementFees: s.coins("100fig"),
-				}))
-				s.requireAddHold(s.addr2, "50pear,100fig", 12345)
+				s.requireFundAccount(s.addr2, "100apple")
+				s.requireSetCommitmentAmount(1, s.addr2, "50apple")
 			},
-			msg: exchange.MsgFillBidsRequest{
-				Seller:                  s.addr1.String(),
-				MarketId:                1,
-				TotalAssets:             s.coins("10apple"),
-				BidOrderIds:             []uint64{12345},
-				SellerSettlementFlatFee: s.coinP("100fig"),
+			msg: exchange.MsgTransferCommitmentRequest{
+				Account: s.addr2.String(), Amount: s.coins("50apple"), CurrentMarketId: 1, NewMarketId: 2,
 			},
 			expInErr: []string{invReqErr,
-				"address " + s.marketAddr1.String() + " does not contain the \"fig\" required attribute: \"not.gonna.have.it\"",
+				"account " + s.addr2.String() + " does not have permission to create commitments in market 2"},
+			fArgs: followupArgs{
+				expBal:    expBalances{addr: s.addr2, expBal: s.coins("100apple"), expHold: s.coins("50apple"), expSpend: s.coins("50apple")},
+				expCurAmt: "50apple",
 			},
 		},
 		{
-			name: "fee in restricted denom",
+			name: "more than committed",
 			setup: func() {
-				s.requireAddFinalizeAndActivateMarker(s.coin("13apple"), s.addr5, "*.gonna.have.it")
-				s.requireAddFinalizeAndActivateMarker(s.coin("70pear"), s.addr5, "*.gonna.have.it")
-				s.requireAddFinalizeAndActivateMarker(s.coin("300fig"), s.addr5, "*.gonna.have.it")
-				s.requireSetNameRecord("buyer.gonna.have.it", s.addr5)
-				s.requireSetNameRecord("seller.gonna.have.it", s.addr5)
-				s.requireSetNameRecord("market.gonna.have.it", s.addr5)
-				s.requireSetAttr(s.addr1, "seller.gonna.have.it", s.addr5)
-				s.requireSetAttr(s.addr2, "buyer.gonna.have.it", s.addr5)
-				s.requireSetAttr(s.addr3, "buyer.gonna.have.it", s.addr5)
-				s.requireSetAttr(s.marketAddr1, "market.gonna.have.it", s.addr5)
-				s.requireFundAccount(s.addr1, "13apple,100fig")
-				s.requireFundAccount(s.addr2, "50pear,100fig")
-				s.requireFundAccount(s.addr3, "20pear,100fig")
-
-				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId: 1, AcceptingOrders: true, AllowUserSettlement: true,
-					FeeCreateAskFlat:          s.coins("10fig"),
-					FeeCreateBidFlat:          s.coins("200fig"),
-					FeeSellerSettlementFlat:   s.coins("5pear"),
-					FeeSellerSettlementRatios: s.ratios("35pear:2pear"),
-					FeeBuyerSettlementFlat:    s.coins("30fig"),
-					FeeBuyerSettlementRatios:  s.ratios("10pear:1fig"),
-					ReqAttrCreateAsk:          []string{"*.gonna.have.it"},
-					ReqAttrCreateBid:          []string{"not.gonna.have.it"},
-				})
-				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(12345).WithBid(&exchange.BidOrder{
-					MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("50pear"),
-					BuyerSettlementFees: s.coins("35fig"), ExternalId: "first order",
-				}))
-				s.requireAddHold(s.addr2, "50pear,35fig", 12345)
-				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(98765).WithBid(&exchange.BidOrder{
-					MarketId: 1, Buyer: s.addr3.String(), Assets: s.coin("3apple"), Price: s.coin("20pear"),
-					BuyerSettlementFees: s.coins("32fig"), ExternalId: "second order",
-				}))
-				s.requireAddHold(s.addr3, "20pear,32fig", 98765)
+				s.requireCreateMarket(exchange.Market{MarketId: 1, AcceptingCommitments: true, AllowUserSettlement: true})
+				s.requireCreateMarket(exchange.Market{MarketId: 2, AcceptingCommitments: true})
+				s.requireFundAccount(s.addr2, "100apple")
+				s.requireSetCommitmentAmount(1, s.addr2, "50apple")
 			},
-			msg: exchange.MsgFillBidsRequest{
-				Seller:                  s.addr1.String(),
-				MarketId:                1,
-				TotalAssets:             s.coins("13apple"),
-				BidOrderIds:             []uint64{12345, 98765},
-				SellerSettlementFlatFee: s.coinP("5pear"),
-				AskOrderCreationFee:     s.coinP("10fig"),
+			msg: exchange.MsgTransferCommitmentRequest{
+				Account: s.addr2.String(), Amount: s.coins("51apple"), CurrentMarketId: 1, NewMarketId: 2,
 			},
 			expInErr: []string{invReqErr,
-				"error collecting exchange fee 4fig,1pear (based off 67fig,9pear) from market 1",
-				"restricted denom fig cannot be sent to the fee collector",
+				"commitment amount to transfer \"51apple\" is more than currently committed amount \"50apple\" for " +
+					s.addr2.String() + " in market 1"},
+			fArgs: followupArgs{
+				expBal:    expBalances{addr: s.addr2, expBal: s.coins("100apple"), expHold: s.coins("50apple"), expSpend: s.coins("50apple")},
+				expCurAmt: "50apple",
 			},
 		},
 		{
-			name: "okay: two bids, all req attrs and fees",
+			name: "part of commitment",
 			setup: func() {
-				s.requireAddFinalizeAndActivateMarker(s.coin("13apple"), s.addr5, "*.gonna.have.it")
-				s.requireAddFinalizeAndActivateCoinMarker(s.coin("70pear"), s.addr5)
-				s.requireAddFinalizeAndActivateCoinMarker(s.coin("300fig"), s.addr5)
-				s.requireSetNameRecord("buyer.gonna.have.it", s.addr5)
-				s.requireSetNameRecord("seller.gonna.have.it", s.addr5)
-				s.requireSetNameRecord("market.gonna.have.it", s.addr5)
-				s.requireSetAttr(s.addr1, "seller.gonna.have.it", s.addr5)
-				s.requireSetAttr(s.addr2, "buyer.gonna.have.it", s.addr5)
-				s.requireSetAttr(s.addr3, "buyer.gonna.have.it", s.addr5)
-				s.requireSetAttr(s.marketAddr1, "market.gonna.have.it", s.addr5)
-				s.requireFundAccount(s.addr1, "13apple,100fig")
-				s.requireFundAccount(s.addr2, "50pear,100fig")
-				s.requireFundAccount(s.addr3, "20pear,100fig")
-
-				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId: 1, AcceptingOrders: true, AllowUserSettlement: true,
-					FeeCreateAskFlat:          s.coins("10fig"),
-					FeeCreateBidFlat:          s.coins("200fig"),
-					FeeSellerSettlementFlat:   s.coins("5pear"),
-					FeeSellerSettlementRatios: s.ratios("35pear:2pear"),
-					FeeBuyerSettlementFlat:    s.coins("30fig"),
-					FeeBuyerSettlementRatios:  s.ratios("10pear:1fig"),
-					ReqAttrCreateAsk:          []string{"*.gonna.have.it"},
-					ReqAttrCreateBid:          []string{"not.gonna.have.it"},
+				s.requireCreateMarket(exchange.Market{MarketId: 1, AcceptingCommitments: true, AllowUserSettlement: true})
+				s.requireCreateMarket(exchange.Market{
+					MarketId:                2,
+					AcceptingCommitments:    true,
+					ReqAttrCreateCommitment: []string{"you.got.it"},
 				})
-				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(12345).WithBid(&exchange.BidOrder{
-					MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("50pear"),
-					BuyerSettlementFees: s.coins("35fig"), ExternalId: "first order",
-				}))
-				s.requireAddHold(s.addr2, "50pear,35fig", 12345)
-				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(98765).WithBid(&exchange.BidOrder{
-					MarketId: 1, Buyer: s.addr3.String(), Assets: s.coin("3apple"), Price: s.coin("20pear"),
-					BuyerSettlementFees: s.coins("32fig"), ExternalId: "second order",
-				}))
-				s.requireAddHold(s.addr3, "20pear,32fig", 98765)
+				s.requireFundAccount(s.addr2, "100apple")
+				s.requireSetCommitmentAmount(1, s.addr2, "50apple")
+				s.requireSetNameRecord("you.got.it", s.addr5)
+				s.requireSetAttr(s.addr2, "you.got.it", s.addr5)
 			},
-			msg: exchange.MsgFillBidsRequest{
-				Seller:                  s.addr1.String(),
-				MarketId:                1,
-				TotalAssets:             s.coins("13apple"),
-				BidOrderIds:             []uint64{12345, 98765},
-				SellerSettlementFlatFee: s.coinP("5pear"),
-				AskOrderCreationFee:     s.coinP("10fig"),
+			msg: exchange.MsgTransferCommitmentRequest{
+				Account: s.addr2.String(), Amount: s.coins("20apple"), CurrentMarketId: 1, NewMarketId: 2, EventTag: "moving",
 			},
-			fArgs: []expBalances{
-				{
-					addr:    s.addr1,
-					expBal:  []sdk.Coin{s.zeroCoin("apple"), s.coin("61pear"), s.coin("90fig")},
-					expHold: s.zeroCoins("apple", "pear", "fig"),
-				},
-				{
-					addr:    s.addr2,
-					expBal:  []sdk.Coin{s.coin("10apple"), s.zeroCoin("pear"), s.coin("65fig")},
-					expHold: s.zeroCoins("apple", "pear", "fig"),
-				},
-				{
-					addr:    s.addr3,
-					expBal:  []sdk.Coin{s.coin("3apple"), s.zeroCoin("pear"), s.coin("68fig")},
-					expHold: s.zeroCoins("apple", "pear", "fig"),
-				},
-				{
-					addr:    s.marketAddr1,
-					expBal:  []sdk.Coin{s.zeroCoin("apple"), s.coin("8pear"), s.coin("72fig")},
-					expHold: s.zeroCoins("apple", "pear", "fig"),
-				},
-				{
-					addr:   s.feeCollectorAddr,
-					expBal: []sdk.Coin{s.zeroCoin("apple"), s.coin("1pear"), s.coin("5fig")},
-				},
+			expEvents: sdk.Events{
+				s.untypeEvent(exchange.NewEventCommitmentReleased(s.addr2.String(), 1, s.coins("20apple"), "moving")),
+				s.untypeEvent(exchange.NewEventFundsCommitted(s.addr2.String(), 2, s.coins("20apple"), "moving")),
+			},
+			fArgs: followupArgs{
+				expBal:    expBalances{addr: s.addr2, expBal: s.coins("100apple"), expHold: s.coins("50apple"), expSpend: s.coins("50apple")},
+				expCurAmt: "30apple",
+				expNewAmt: "20apple",
+			},
+		},
+		{
+			name: "all of commitment",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{MarketId: 1, AcceptingCommitments: true, AllowUserSettlement: true})
+				s.requireCreateMarket(exchange.Market{MarketId: 2, AcceptingCommitments: true})
+				s.requireFundAccount(s.addr2, "100apple,20plum")
+				s.requireSetCommitmentAmount(1, s.addr2, "50apple,20plum")
+				s.requireSetCommitmentAmount(2, s.addr2, "10apple")
+			},
+			msg: exchange.MsgTransferCommitmentRequest{
+				Account: s.addr2.String(), Amount: s.coins("50apple,20plum"), CurrentMarketId: 1, NewMarketId: 2,
 			},
 			expEvents: sdk.Events{
-				// Hold release events.
-				s.eventHoldReleased(s.addr2, "35fig,50pear"),
-				s.eventHoldReleased(s.addr3, "32fig,20pear"),
-
-				// Asset transfer events.
-				s.eventCoinSpent(s.addr1, "13apple"),
-				s.eventMessageSender(s.addr1),
-				s.eventCoinReceived(s.addr2, "10apple"),
-				s.eventCoinReceived(s.addr3, "3apple"),
-				s.eventTransfer(s.addr2, s.addr1, "10apple"),
-				s.eventTransfer(s.addr3, s.addr1, "3apple"),
-
-				// Price transfer events.
-				s.eventCoinSpent(s.addr2, "50pear"),
-				s.eventMessageSender(s.addr2),
-				s.eventCoinSpent(s.addr3, "20pear"),
-				s.eventMessageSender(s.addr3),
-				s.eventCoinReceived(s.addr1, "70pear"),
-				s.eventTransfer(s.addr1, s.addr2, "50pear"),
-				s.eventTransfer(s.addr1, s.addr3, "20pear"),
-
-				// Settlement fee transfer events.
-				s.eventCoinSpent(s.addr2, "35fig"),
-				s.eventMessageSender(s.addr2),
-				s.eventCoinSpent(s.addr3, "32fig"),
-				s.eventMessageSender(s.addr3),
-				s.eventCoinSpent(s.addr1, "9pear"),
-				s.eventMessageSender(s.addr1),
-				s.eventCoinReceived(s.marketAddr1, "67fig,9pear"),
-				s.eventTransfer(s.marketAddr1, s.addr2, "35fig"),
-				s.eventTransfer(s.marketAddr1, s.addr3, "32fig"),
-				s.eventTransfer(s.marketAddr1, s.addr1, "9pear"),
-
-				// Transfer of exchange portion of settlement fee.
-				s.eventCoinSpent(s.marketAddr1, "4fig,1pear"),
-				s.eventCoinReceived(s.feeCollectorAddr, "4fig,1pear"),
-				s.eventTransfer(s.feeCollectorAddr, s.marketAddr1, "4fig,1pear"),
-				s.eventMessageSender(s.marketAddr1),
-
-				// Order filled events.
-				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId:    12345,
-					Assets:     "10apple",
-					Price:      "50pear",
-					Fees:       "35fig",
-					MarketId:   1,
-					ExternalId: "first order",
-				}),
-				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId:    98765,
-					Assets:     "3apple",
-					Price:      "20pear",
-					Fees:       "32fig",
-					MarketId:   1,
-					ExternalId: "second order",
-				}),
-
-				// The net-asset-value event.
-				s.markerNavSetEvent("13apple", "70pear", 1),
-
-				// Order creation fee events.
-				s.eventCoinSpent(s.addr1, "10fig"),
-				s.eventCoinReceived(s.marketAddr1, "10fig"),
-				s.eventTransfer(s.marketAddr1, s.addr1, "10fig"),
-				s.eventMessageSender(s.addr1),
-
-				// Transfer of exchange portion of order creation fees.
-				s.eventCoinSpent(s.marketAddr1, "1fig"),
-				s.eventCoinReceived(s.feeCollectorAddr, "1fig"),
-				s.eventTransfer(s.feeCollectorAddr, s.marketAddr1, "1fig"),
-				s.eventMessageSender(s.marketAddr1),
-			},
-		},
-	}
+				s.untypeEvent(exchange.NewEventCommitmentReleased(s.addr2.String(), 1, s.coins("50apple,20plum"), "")),
+				s.untypeEvent(exchange.NewEventFundsCommitted(s.addr2.String(), 2, s.coins("50apple,20plum"), "")),
+			},
+			fArgs: followupArgs{
+				expBal: expBalances{
+					addr:     s.addr2,
+					expBal:   s.coins("100apple,20plum"),
+					expHold:  s.coins("60apple,20plum"),
+					expSpend: []sdk.Coin{s.coin("40apple"), s.zeroCoin("plum")},
+				},
+				expCurAmt: "",
+				expNewAmt: "60apple,20plum",
+			},
+		},
+	}
 
 	for _, tc := range tests {
 		s.Run(tc.name, func() {
@@ -1695,485 +1671,468 @@ func (s *TestSuite) TestMsgServer_FillBids() {
 	}
 }
 
-func (s *TestSuite) TestMsgServer_FillAsks() {
-	testDef := msgServerTestDef[exchange.MsgFillAsksRequest, exchange.MsgFillAsksResponse, []expBalances]{
-		endpointName: "FillAsks",
-		endpoint:     keeper.NewMsgServer(s.k).FillAsks,
-		expResp:      &exchange.MsgFillAsksResponse{},
-		followup: func(msg *exchange.MsgFillAsksRequest, ebs []expBalances) {
-			for _, orderID := range msg.AskOrderIds {
-				order, err := s.k.GetOrder(s.ctx, orderID)
-				s.Assert().NoError(err, "GetOrder(%d) error", orderID)
-				s.Assert().Nil(order, "GetOrder(%d) order", orderID)
-			}
-
-			for _, eb := range ebs {
-				s.checkBalances(eb)
-			}
+func (s *TestSuite) TestMsgServer_CancelOrder() {
+	testDef := msgServerTestDef[exchange.MsgCancelOrderRequest, exchange.MsgCancelOrderResponse, expBalances]{
+		endpointName: "CancelOrder",
+		endpoint:     keeper.NewMsgServer(s.k).CancelOrder,
+		expResp:      &exchange.MsgCancelOrderResponse{},
+		followup: func(msg *exchange.MsgCancelOrderRequest, eb expBalances) {
+			order, err := s.k.GetOrder(s.ctx, msg.OrderId)
+			s.Assert().NoError(err, "GetOrder(%d) error", msg.OrderId)
+			s.Assert().Nil(order, "GetOrder(%d) order", msg.OrderId)
+			s.checkBalances(eb)
 		},
 	}
 
-	tests := []msgServerTestCase[exchange.MsgFillAsksRequest, []expBalances]{
+	tests := []msgServerTestCase[exchange.MsgCancelOrderRequest, expBalances]{
 		{
-			name: "user can't create bid",
+			name:     "order 0",
+			setup:    nil,
+			msg:      exchange.MsgCancelOrderRequest{Signer: s.addr1.String(), OrderId: 0},
+			expInErr: []string{invReqErr, "order 0 does not exist"},
+		},
+		{
+			name: "order does not exist",
 			setup: func() {
-				s.requireSetNameRecord("almost.gonna.have.it", s.addr5)
-				s.requireSetAttr(s.addr1, "almost.gonna.have.it", s.addr5)
-
-				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId: 1, AcceptingOrders: true, AllowUserSettlement: true,
-					ReqAttrCreateBid: []string{"not.gonna.have.it"},
-				})
+				s.requireCreateMarketUnmocked(exchange.Market{MarketId: 3})
+				store := s.getStore()
+				s.requireSetOrderInStore(store, exchange.NewOrder(5).WithAsk(&exchange.AskOrder{
+					MarketId: 3, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("1pear"),
+				}))
+				s.requireSetOrderInStore(store, exchange.NewOrder(7).WithBid(&exchange.BidOrder{
+					MarketId: 3, Buyer: s.addr3.String(), Assets: s.coin("1apple"), Price: s.coin("1pear"),
+				}))
 			},
-			msg: exchange.MsgFillAsksRequest{
-				Buyer:       s.addr1.String(),
-				MarketId:    1,
-				TotalPrice:  s.coin("1pear"),
-				AskOrderIds: []uint64{1},
+			msg:      exchange.MsgCancelOrderRequest{Signer: s.addr2.String(), OrderId: 6},
+			expInErr: []string{invReqErr, "order 6 does not exist"},
+		},
+		{
+			name: "wrong signer",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{MarketId: 2})
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(83).WithAsk(&exchange.AskOrder{
+					MarketId: 2, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("1pear"),
+				}))
 			},
-			expInErr: []string{invReqErr, "account " + s.addr1.String() + " is not allowed to create bid orders in market 1"},
+			msg:      exchange.MsgCancelOrderRequest{Signer: s.addr2.String(), OrderId: 83},
+			expInErr: []string{invReqErr, "account " + s.addr2.String() + " does not have permission to cancel order 83"},
 		},
 		{
-			name: "one ask, both quarantined, no markers",
+			name: "market signer: ask",
 			setup: func() {
-				s.requireFundAccount(s.addr1, "50pear")
-				s.requireFundAccount(s.addr2, "10apple")
-
 				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId: 3, AcceptingOrders: true, AllowUserSettlement: true,
+					MarketId:     2,
+					AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_cancel)},
 				})
-				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(54).WithAsk(&exchange.AskOrder{
-					MarketId: 3, Seller: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("50pear"),
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(44).WithAsk(&exchange.AskOrder{
+					MarketId: 2, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("1pear"),
 				}))
-				s.requireAddHold(s.addr2, "10apple", 54)
-
-				s.requireQuarantineOptIn(s.addr1)
-				s.requireQuarantineOptIn(s.addr2)
-			},
-			msg: exchange.MsgFillAsksRequest{
-				Buyer:       s.addr1.String(),
-				MarketId:    3,
-				TotalPrice:  s.coin("50pear"),
-				AskOrderIds: []uint64{54},
+				s.requireFundAccount(s.addr1, "10apple")
+				s.requireAddHold(s.addr1, "2apple", 44)
 			},
-			fArgs: []expBalances{
-				{
-					addr:     s.addr1,
-					expBal:   []sdk.Coin{s.coin("10apple"), s.zeroCoin("pear")},
-					expHold:  s.zeroCoins("apple", "pear"),
-					expSpend: []sdk.Coin{s.coin("10apple"), s.zeroCoin("pear")},
-				},
-				{
-					addr:     s.addr2,
-					expBal:   []sdk.Coin{s.zeroCoin("apple"), s.coin("50pear")},
-					expHold:  s.zeroCoins("apple", "pear"),
-					expSpend: []sdk.Coin{s.zeroCoin("apple"), s.coin("50pear")},
-				},
+			msg: exchange.MsgCancelOrderRequest{Signer: s.addr5.String(), OrderId: 44},
+			fArgs: expBalances{
+				addr:     s.addr1,
+				expBal:   s.coins("10apple"),
+				expHold:  s.coins("1apple"),
+				expSpend: s.coins("9apple"),
 			},
 			expEvents: sdk.Events{
-				s.eventHoldReleased(s.addr2, "10apple"),
-				s.eventCoinSpent(s.addr2, "10apple"),
-				s.eventCoinReceived(s.addr1, "10apple"),
-				s.eventTransfer(s.addr1, s.addr2, "10apple"),
-				s.eventMessageSender(s.addr2),
-				s.eventCoinSpent(s.addr1, "50pear"),
-				s.eventCoinReceived(s.addr2, "50pear"),
-				s.eventTransfer(s.addr2, s.addr1, "50pear"),
-				s.eventMessageSender(s.addr1),
-				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 54, Assets: "10apple", Price: "50pear", MarketId: 3,
+				s.eventHoldReleased(s.addr1, "1apple"),
+				s.untypeEvent(&exchange.EventOrderCancelled{
+					OrderId: 44, CancelledBy: s.addr5.String(), MarketId: 2, ExternalId: "",
 				}),
-				s.markerNavSetEvent("10apple", "50pear", 3),
 			},
 		},
 		{
-			name: "one ask, both quarantined, with markers",
+			name: "market signer: bid",
 			setup: func() {
-				s.requireAddFinalizeAndActivateMarker(s.coin("10apple"), s.addr5, "got.it")
-				s.requireAddFinalizeAndActivateMarker(s.coin("50pear"), s.addr5, "got.it")
-				s.requireSetNameRecord("got.it", s.addr5)
-				s.requireSetAttr(s.addr1, "got.it", s.addr5)
-				s.requireSetAttr(s.addr2, "got.it", s.addr5)
-				s.requireFundAccount(s.addr1, "50pear")
-				s.requireFundAccount(s.addr2, "10apple")
-
 				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId: 3, AcceptingOrders: true, AllowUserSettlement: true,
+					MarketId:     2,
+					AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_cancel)},
 				})
-				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(54).WithAsk(&exchange.AskOrder{
-					MarketId: 3, Seller: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("50pear"),
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(44).WithBid(&exchange.BidOrder{
+					MarketId: 2, Buyer: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("1pear"),
 				}))
-				s.requireAddHold(s.addr2, "10apple", 54)
-
-				s.requireQuarantineOptIn(s.addr1)
-				s.requireQuarantineOptIn(s.addr2)
-			},
-			msg: exchange.MsgFillAsksRequest{
-				Buyer:       s.addr1.String(),
-				MarketId:    3,
-				TotalPrice:  s.coin("50pear"),
-				AskOrderIds: []uint64{54},
+				s.requireFundAccount(s.addr1, "10pear")
+				s.requireAddHold(s.addr1, "1pear", 44)
 			},
-			fArgs: []expBalances{
-				{
-					addr:     s.addr1,
-					expBal:   []sdk.Coin{s.coin("10apple"), s.zeroCoin("pear")},
-					expHold:  s.zeroCoins("apple", "pear"),
-					expSpend: []sdk.Coin{s.coin("10apple"), s.zeroCoin("pear")},
-				},
-				{
-					addr:     s.addr2,
-					expBal:   []sdk.Coin{s.zeroCoin("apple"), s.coin("50pear")},
-					expHold:  s.zeroCoins("apple", "pear"),
-					expSpend: []sdk.Coin{s.zeroCoin("apple"), s.coin("50pear")},
-				},
+			msg: exchange.MsgCancelOrderRequest{Signer: s.addr5.String(), OrderId: 44},
+			fArgs: expBalances{
+				addr:     s.addr1,
+				expBal:   s.coins("10pear"),
+				expHold:  []sdk.Coin{s.zeroCoin("pear")},
+				expSpend: s.coins("10pear"),
 			},
 			expEvents: sdk.Events{
-				s.eventHoldReleased(s.addr2, "10apple"),
-				s.eventCoinSpent(s.addr2, "10apple"),
-				s.eventCoinReceived(s.addr1, "10apple"),
-				s.eventTransfer(s.addr1, s.addr2, "10apple"),
-				s.eventMessageSender(s.addr2),
-				s.eventCoinSpent(s.addr1, "50pear"),
-				s.eventCoinReceived(s.addr2, "50pear"),
-				s.eventTransfer(s.addr2, s.addr1, "50pear"),
-				s.eventMessageSender(s.addr1),
-				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 54, Assets: "10apple", Price: "50pear", MarketId: 3,
+				s.eventHoldReleased(s.addr1, "1pear"),
+				s.untypeEvent(&exchange.EventOrderCancelled{
+					OrderId: 44, CancelledBy: s.addr5.String(), MarketId: 2, ExternalId: "",
 				}),
-				s.markerNavSetEvent("10apple", "50pear", 3),
 			},
 		},
 		{
-			name: "one ask, buyer sanctioned",
+			name: "ask with diff fee denom from price",
 			setup: func() {
-				s.requireFundAccount(s.addr1, "50pear")
-				s.requireFundAccount(s.addr4, "10apple")
-
-				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId: 2, AcceptingOrders: true, AllowUserSettlement: true,
-				})
-				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(77).WithAsk(&exchange.AskOrder{
-					MarketId: 2, Seller: s.addr4.String(), Assets: s.coin("10apple"), Price: s.coin("50pear"),
-				}))
-				s.requireAddHold(s.addr4, "10apple", 77)
-
-				s.requireSanctionAddress(s.addr1)
-			},
-			msg: exchange.MsgFillAsksRequest{
-				Buyer:       s.addr1.String(),
-				MarketId:    2,
-				TotalPrice:  s.coin("50pear"),
-				AskOrderIds: []uint64{77},
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(5555).WithAsk(&exchange.AskOrder{
+					MarketId:                1,
+					Seller:                  s.addr1.String(),
+					Assets:                  s.coin("10apple"),
+					Price:                   s.coin("5pear"),
+					SellerSettlementFlatFee: s.coinP("1fig"),
+					ExternalId:              "ext-id-5555",
+				}))
+				s.requireFundAccount(s.addr1, "15apple,5fig")
+				s.requireAddHold(s.addr1, "10apple,1fig", 5555)
+			},
+			msg: exchange.MsgCancelOrderRequest{Signer: s.addr1.String(), OrderId: 5555},
+			fArgs: expBalances{
+				addr:     s.addr1,
+				expBal:   s.coins("15apple,5fig"),
+				expHold:  s.zeroCoins("apple", "fig"),
+				expSpend: s.coins("15apple,5fig"),
+			},
+			expEvents: sdk.Events{
+				s.eventHoldReleased(s.addr1, "10apple,1fig"),
+				s.untypeEvent(&exchange.EventOrderCancelled{
+					OrderId: 5555, CancelledBy: s.addr1.String(), MarketId: 1, ExternalId: "ext-id-5555",
+				}),
 			},
-			expInErr: []string{invReqErr, "cannot send from " + s.addr1.String(), "account is sanctioned"},
 		},
 		{
-			name: "one ask, seller sanctioned",
+			name: "ask with same fee denom as price",
 			setup: func() {
-				s.requireFundAccount(s.addr1, "50pear")
-				s.requireFundAccount(s.addr4, "10apple")
-
-				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId: 2, AcceptingOrders: true, AllowUserSettlement: true,
-				})
-				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(77).WithAsk(&exchange.AskOrder{
-					MarketId: 2, Seller: s.addr4.String(), Assets: s.coin("10apple"), Price: s.coin("50pear"),
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(98765).WithAsk(&exchange.AskOrder{
+					MarketId:                3,
+					Seller:                  s.addr2.String(),
+					Assets:                  s.coin("10apple"),
+					Price:                   s.coin("5pear"),
+					SellerSettlementFlatFee: s.coinP("1pear"),
+					ExternalId:              "whatever",
 				}))
-				s.requireAddHold(s.addr4, "10apple", 77)
-
-				s.requireSanctionAddress(s.addr4)
+				s.requireFundAccount(s.addr2, "15apple,5fig")
+				s.requireAddHold(s.addr2, "10apple,1fig", 98765)
 			},
-			msg: exchange.MsgFillAsksRequest{
-				Buyer:       s.addr1.String(),
-				MarketId:    2,
-				TotalPrice:  s.coin("50pear"),
-				AskOrderIds: []uint64{77},
+			msg: exchange.MsgCancelOrderRequest{Signer: s.addr2.String(), OrderId: 98765},
+			fArgs: expBalances{
+				addr:     s.addr2,
+				expBal:   s.coins("15apple,5fig"),
+				expHold:  []sdk.Coin{s.zeroCoin("apple"), s.coin("1fig")},
+				expSpend: s.coins("15apple,4fig"),
+			},
+			expEvents: sdk.Events{
+				s.eventHoldReleased(s.addr2, "10apple"),
+				s.untypeEvent(&exchange.EventOrderCancelled{
+					OrderId: 98765, CancelledBy: s.addr2.String(), MarketId: 3, ExternalId: "whatever",
+				}),
 			},
-			expInErr: []string{invReqErr, "cannot send from " + s.addr4.String(), "account is sanctioned"},
 		},
 		{
-			name: "one ask, buyer does not have asset marker's req attrs",
+			name: "bid with diff fee denom from price",
 			setup: func() {
-				s.requireAddFinalizeAndActivateMarker(s.coin("10apple"), s.addr5, "not.gonna.have.it")
-				s.requireSetNameRecord("not.gonna.have.it", s.addr5)
-				s.requireSetAttr(s.addr1, "not.gonna.have.it", s.addr5)
-				s.requireFundAccount(s.addr2, "50pear")
-				s.requireFundAccount(s.addr1, "10apple")
-
-				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId: 2, AcceptingOrders: true, AllowUserSettlement: true,
-				})
-				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(4).WithAsk(&exchange.AskOrder{
-					MarketId: 2, Seller: s.addr1.String(), Assets: s.coin("10apple"), Price: s.coin("50pear"),
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(5555).WithBid(&exchange.BidOrder{
+					MarketId:            1,
+					Buyer:               s.addr1.String(),
+					Assets:              s.coin("10apple"),
+					Price:               s.coin("5pear"),
+					BuyerSettlementFees: s.coins("1fig"),
+					ExternalId:          "ext-id-5555",
 				}))
-				s.requireAddHold(s.addr1, "10apple", 4)
+				s.requireFundAccount(s.addr1, "15pear,5fig")
+				s.requireAddHold(s.addr1, "5pear,1fig", 5555)
 			},
-			msg: exchange.MsgFillAsksRequest{
-				Buyer:       s.addr2.String(),
-				MarketId:    2,
-				TotalPrice:  s.coin("50pear"),
-				AskOrderIds: []uint64{4},
+			msg: exchange.MsgCancelOrderRequest{Signer: s.addr1.String(), OrderId: 5555},
+			fArgs: expBalances{
+				addr:     s.addr1,
+				expBal:   s.coins("15pear,5fig"),
+				expHold:  s.zeroCoins("pear", "fig"),
+				expSpend: s.coins("15pear,5fig"),
 			},
-			expInErr: []string{invReqErr,
-				"address " + s.addr2.String() + " does not contain the \"apple\" required attribute: \"not.gonna.have.it\"",
+			expEvents: sdk.Events{
+				s.eventHoldReleased(s.addr1, "1fig,5pear"),
+				s.untypeEvent(&exchange.EventOrderCancelled{
+					OrderId: 5555, CancelledBy: s.addr1.String(), MarketId: 1, ExternalId: "ext-id-5555",
+				}),
 			},
 		},
 		{
-			name: "one ask, seller does not have price marker's req attrs",
+			name: "bid with same fee denom as price",
 			setup: func() {
-				s.requireAddFinalizeAndActivateMarker(s.coin("50pear"), s.addr5, "not.gonna.have.it")
-				s.requireSetNameRecord("not.gonna.have.it", s.addr5)
-				s.requireSetAttr(s.addr2, "not.gonna.have.it", s.addr5)
-				s.requireFundAccount(s.addr2, "50pear")
-				s.requireFundAccount(s.addr1, "10apple")
-
-				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId: 2, AcceptingOrders: true, AllowUserSettlement: true,
-				})
-				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(4).WithAsk(&exchange.AskOrder{
-					MarketId: 2, Seller: s.addr1.String(), Assets: s.coin("10apple"), Price: s.coin("50pear"),
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(98765).WithBid(&exchange.BidOrder{
+					MarketId:            3,
+					Buyer:               s.addr2.String(),
+					Assets:              s.coin("10apple"),
+					Price:               s.coin("5pear"),
+					BuyerSettlementFees: s.coins("1pear"),
+					ExternalId:          "whatever",
 				}))
-				s.requireAddHold(s.addr1, "10apple", 4)
+				s.requireFundAccount(s.addr2, "15pear,5fig")
+				s.requireAddHold(s.addr2, "6pear", 98765)
 			},
-			msg: exchange.MsgFillAsksRequest{
-				Buyer:       s.addr2.String(),
-				MarketId:    2,
-				TotalPrice:  s.coin("50pear"),
-				AskOrderIds: []uint64{4},
+			msg: exchange.MsgCancelOrderRequest{Signer: s.addr2.String(), OrderId: 98765},
+			fArgs: expBalances{
+				addr:     s.addr2,
+				expBal:   s.coins("15pear,5fig"),
+				expHold:  s.zeroCoins("pear", "fig"),
+				expSpend: s.coins("15pear,5fig"),
 			},
-			expInErr: []string{invReqErr,
-				"address " + s.addr1.String() + " does not contain the \"pear\" required attribute: \"not.gonna.have.it\"",
+			expEvents: sdk.Events{
+				s.eventHoldReleased(s.addr2, "6pear"),
+				s.untypeEvent(&exchange.EventOrderCancelled{
+					OrderId: 98765, CancelledBy: s.addr2.String(), MarketId: 3, ExternalId: "whatever",
+				}),
 			},
 		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runMsgServerTestCase(s, testDef, tc)
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_AmendOrder() {
+	testDef := msgServerTestDef[exchange.MsgAmendOrderRequest, exchange.MsgAmendOrderResponse, expBalances]{
+		endpointName: "AmendOrder",
+		endpoint:     keeper.NewMsgServer(s.k).AmendOrder,
+		expResp:      &exchange.MsgAmendOrderResponse{},
+		followup: func(msg *exchange.MsgAmendOrderRequest, eb expBalances) {
+			order, err := s.k.GetOrder(s.ctx, msg.OrderId)
+			if s.Assert().NoError(err, "GetOrder(%d) error", msg.OrderId) && s.Assert().NotNil(order, "GetOrder(%d)", msg.OrderId) {
+				s.Assert().Equal(msg.Assets.String(), order.GetAssets().String(), "order assets")
+				s.Assert().Equal(msg.Price.String(), order.GetPrice().String(), "order price")
+				s.Assert().Equal(msg.AllowPartial, order.PartialFillAllowed(), "order allow partial")
+			}
+			s.checkBalances(eb)
+		},
+	}
+
+	tests := []msgServerTestCase[exchange.MsgAmendOrderRequest, expBalances]{
 		{
-			name: "market does not have req attr for fee denom",
+			name: "not the owner",
 			setup: func() {
-				s.requireAddFinalizeAndActivateMarker(s.coin("200fig"), s.addr5, "not.gonna.have.it")
-				s.requireSetNameRecord("not.gonna.have.it", s.addr5)
-				s.requireSetAttr(s.addr1, "not.gonna.have.it", s.addr5)
-				s.requireSetAttr(s.addr2, "not.gonna.have.it", s.addr5)
-				s.requireFundAccount(s.addr2, "50pear,100fig")
-				s.requireFundAccount(s.addr1, "10apple,100fig")
-
-				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId: 1, AcceptingOrders: true, AllowUserSettlement: true,
-				})
-				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(12345).WithAsk(&exchange.AskOrder{
-					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("10apple"), Price: s.coin("50pear"),
-					SellerSettlementFlatFee: s.coinP("100fig"),
+				s.requireCreateMarketUnmocked(exchange.Market{MarketId: 2, AcceptingOrders: true})
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(83).WithAsk(&exchange.AskOrder{
+					MarketId: 2, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("1pear"),
 				}))
-				s.requireAddHold(s.addr1, "10apple,100fig", 12345)
 			},
-			msg: exchange.MsgFillAsksRequest{
-				Buyer:               s.addr2.String(),
-				MarketId:            1,
-				TotalPrice:          s.coin("50pear"),
-				AskOrderIds:         []uint64{12345},
-				BuyerSettlementFees: s.coins("100fig"),
-			},
-			expInErr: []string{invReqErr,
-				"address " + s.marketAddr1.String() + " does not contain the \"fig\" required attribute: \"not.gonna.have.it\"",
+			msg: exchange.MsgAmendOrderRequest{
+				Owner: s.addr2.String(), MarketId: 2, OrderId: 83, Assets: s.coin("2apple"), Price: s.coin("2pear"),
 			},
+			expInErr: []string{invReqErr, "account " + s.addr2.String() + " is not the owner of order 83"},
 		},
 		{
-			name: "fee in restricted denom",
+			name: "ask order",
 			setup: func() {
-				s.requireSetNameRecord("buyer.gonna.have.it", s.addr5)
-				s.requireSetNameRecord("seller.gonna.have.it", s.addr5)
-				s.requireSetNameRecord("market.gonna.have.it", s.addr5)
-				s.requireSetAttr(s.addr1, "buyer.gonna.have.it", s.addr5)
-				s.requireSetAttr(s.addr2, "seller.gonna.have.it", s.addr5)
-				s.requireSetAttr(s.addr3, "seller.gonna.have.it", s.addr5)
-				s.requireSetAttr(s.marketAddr1, "market.gonna.have.it", s.addr5)
-				s.requireFundAccount(s.addr1, "70pear,100fig")
-				s.requireFundAccount(s.addr2, "10apple,100fig")
-				s.requireFundAccount(s.addr3, "3apple,100fig")
-				s.requireAddFinalizeAndActivateMarker(s.coin("13apple"), s.addr5, "*.gonna.have.it")
-				s.requireAddFinalizeAndActivateMarker(s.coin("70pear"), s.addr5, "*.gonna.have.it")
-				s.requireAddFinalizeAndActivateMarker(s.coin("300fig"), s.addr5, "*.gonna.have.it")
-
-				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId: 1, AcceptingOrders: true, AllowUserSettlement: true,
-					FeeCreateAskFlat:          s.coins("200fig"),
-					FeeCreateBidFlat:          s.coins("10fig"),
-					FeeSellerSettlementFlat:   s.coins("5pear,12fig"),
-					FeeSellerSettlementRatios: s.ratios("35pear:2pear"),
-					FeeBuyerSettlementFlat:    s.coins("30fig"),
-					FeeBuyerSettlementRatios:  s.ratios("10pear:1fig"),
-					ReqAttrCreateAsk:          []string{"not.gonna.have.it"},
-					ReqAttrCreateBid:          []string{"*.gonna.have.it"},
-				})
-				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(12345).WithAsk(&exchange.AskOrder{
-					MarketId: 1, Seller: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("50pear"),
-					SellerSettlementFlatFee: s.coinP("5pear"), ExternalId: "first order",
-				}))
-				s.requireAddHold(s.addr2, "10apple", 12345)
-				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(98765).WithAsk(&exchange.AskOrder{
-					MarketId: 1, Seller: s.addr3.String(), Assets: s.coin("3apple"), Price: s.coin("20pear"),
-					SellerSettlementFlatFee: s.coinP("12fig"), ExternalId: "second order",
+				s.requireCreateMarketUnmocked(exchange.Market{MarketId: 2, AcceptingOrders: true})
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(44).WithAsk(&exchange.AskOrder{
+					MarketId: 2, Seller: s.addr1.String(), Assets: s.coin("3apple"), Price: s.coin("6pear"),
+					ExternalId: "my ask",
 				}))
-				s.requireAddHold(s.addr3, "3apple,12fig", 98765)
+				s.requireFundAccount(s.addr1, "10apple")
+				s.requireAddHold(s.addr1, "3apple", 44)
 			},
-			msg: exchange.MsgFillAsksRequest{
-				Buyer:               s.addr1.String(),
-				MarketId:            1,
-				TotalPrice:          s.coin("70pear"),
-				AskOrderIds:         []uint64{12345, 98765},
-				BuyerSettlementFees: s.coins("37fig"),
-				BidOrderCreationFee: s.coinP("10fig"),
+			msg: exchange.MsgAmendOrderRequest{
+				Owner: s.addr1.String(), MarketId: 2, OrderId: 44, Assets: s.coin("7apple"), Price: s.coin("15pear"),
+				AllowPartial: true,
 			},
-			expInErr: []string{invReqErr,
-				"error collecting exchange fee 3fig,1pear (based off 49fig,10pear) from market 1",
-				"restricted denom fig cannot be sent to the fee collector",
+			fArgs: expBalances{
+				addr:     s.addr1,
+				expBal:   s.coins("10apple"),
+				expHold:  s.coins("7apple"),
+				expSpend: s.coins("3apple"),
+			},
+			expEvents: sdk.Events{
+				s.eventHoldReleased(s.addr1, "3apple"),
+				s.eventHoldAddedOrder(s.addr1, "7apple", 44),
+				s.untypeEvent(&exchange.EventOrderAmended{
+					OrderId: 44, Assets: "7apple", Price: "15pear", MarketId: 2, ExternalId: "my ask",
+				}),
 			},
 		},
 		{
-			name: "okay: two asks, all req attrs and fees",
+			name: "bid order",
 			setup: func() {
-				s.requireSetNameRecord("buyer.gonna.have.it", s.addr5)
-				s.requireSetNameRecord("seller.gonna.have.it", s.addr5)
-				s.requireSetNameRecord("market.gonna.have.it", s.addr5)
-				s.requireSetAttr(s.addr1, "buyer.gonna.have.it", s.addr5)
-				s.requireSetAttr(s.addr2, "seller.gonna.have.it", s.addr5)
-				s.requireSetAttr(s.addr3, "seller.gonna.have.it", s.addr5)
-				s.requireSetAttr(s.marketAddr1, "market.gonna.have.it", s.addr5)
-				s.requireFundAccount(s.addr1, "70pear,100fig")
-				s.requireFundAccount(s.addr2, "10apple,100fig")
-				s.requireFundAccount(s.addr3, "3apple,100fig")
-				s.requireAddFinalizeAndActivateMarker(s.coin("13apple"), s.addr5, "*.gonna.have.it")
-				s.requireAddFinalizeAndActivateCoinMarker(s.coin("70pear"), s.addr5)
-				s.requireAddFinalizeAndActivateCoinMarker(s.coin("300fig"), s.addr5)
-
-				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId: 1, AcceptingOrders: true, AllowUserSettlement: true,
-					FeeCreateAskFlat:          s.coins("200fig"),
-					FeeCreateBidFlat:          s.coins("10fig"),
-					FeeSellerSettlementFlat:   s.coins("5pear,12fig"),
-					FeeSellerSettlementRatios: s.ratios("35pear:2pear"),
-					FeeBuyerSettlementFlat:    s.coins("30fig"),
-					FeeBuyerSettlementRatios:  s.ratios("10pear:1fig"),
-					ReqAttrCreateAsk:          []string{"not.gonna.have.it"},
-					ReqAttrCreateBid:          []string{"*.gonna.have.it"},
-				})
-				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(12345).WithAsk(&exchange.AskOrder{
-					MarketId: 1, Seller: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("50pear"),
-					SellerSettlementFlatFee: s.coinP("5pear"), ExternalId: "first order",
-				}))
-				s.requireAddHold(s.addr2, "10apple", 12345)
-				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(98765).WithAsk(&exchange.AskOrder{
-					MarketId: 1, Seller: s.addr3.String(), Assets: s.coin("3apple"), Price: s.coin("20pear"),
-					SellerSettlementFlatFee: s.coinP("12fig"), ExternalId: "second order",
+				s.requireCreateMarketUnmocked(exchange.Market{MarketId: 2, AcceptingOrders: true})
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(45).WithBid(&exchange.BidOrder{
+					MarketId: 2, Buyer: s.addr3.String(), Assets: s.coin("3apple"), Price: s.coin("6pear"),
 				}))
-				s.requireAddHold(s.addr3, "3apple,12fig", 98765)
+				s.requireFundAccount(s.addr3, "20pear")
+				s.requireAddHold(s.addr3, "6pear", 45)
 			},
-			msg: exchange.MsgFillAsksRequest{
-				Buyer:               s.addr1.String(),
-				MarketId:            1,
-				TotalPrice:          s.coin("70pear"),
-				AskOrderIds:         []uint64{12345, 98765},
-				BuyerSettlementFees: s.coins("37fig"),
-				BidOrderCreationFee: s.coinP("10fig"),
+			msg: exchange.MsgAmendOrderRequest{
+				Owner: s.addr3.String(), MarketId: 2, OrderId: 45, Assets: s.coin("4apple"), Price: s.coin("9pear"),
 			},
-			fArgs: []expBalances{
-				{
-					addr:    s.addr1,
-					expBal:  []sdk.Coin{s.coin("13apple"), s.zeroCoin("pear"), s.coin("53fig")},
-					expHold: s.zeroCoins("apple", "pear", "fig"),
-				},
-				{
-					addr:    s.addr2,
-					expBal:  []sdk.Coin{s.zeroCoin("apple"), s.coin("42pear"), s.coin("100fig")},
-					expHold: s.zeroCoins("apple", "pear", "fig"),
-				},
-				{
-					addr:    s.addr3,
-					expBal:  []sdk.Coin{s.zeroCoin("apple"), s.coin("18pear"), s.coin("88fig")},
-					expHold: s.zeroCoins("apple", "pear", "fig"),
-				},
-				{
-					addr:    s.marketAddr1,
-					expBal:  []sdk.Coin{s.zeroCoin("apple"), s.coin("9pear"), s.coin("55fig")},
-					expHold: s.zeroCoins("apple", "pear", "fig"),
-				},
-				{
-					addr:   s.feeCollectorAddr,
-					expBal: []sdk.Coin{s.zeroCoin("apple"), s.coin("1pear"), s.coin("4fig")},
-				},
+			fArgs: expBalances{
+				addr:     s.addr3,
+				expBal:   s.coins("20pear"),
+				expHold:  s.coins("9pear"),
+				expSpend: s.coins("11pear"),
 			},
 			expEvents: sdk.Events{
-				// Hold release events.
-				s.eventHoldReleased(s.addr2, "10apple"),
-				s.eventHoldReleased(s.addr3, "3apple,12fig"),
+				s.eventHoldReleased(s.addr3, "6pear"),
+				s.eventHoldAddedOrder(s.addr3, "9pear", 45),
+				s.untypeEvent(&exchange.EventOrderAmended{OrderId: 45, Assets: "4apple", Price: "9pear", MarketId: 2}),
+			},
+		},
+	}
 
-				// Asset transfer events.
-				s.eventCoinSpent(s.addr2, "10apple"),
-				s.eventMessageSender(s.addr2),
-				s.eventCoinSpent(s.addr3, "3apple"),
-				s.eventMessageSender(s.addr3),
-				s.eventCoinReceived(s.addr1, "13apple"),
-				s.eventTransfer(s.addr1, s.addr2, "10apple"),
-				s.eventTransfer(s.addr1, s.addr3, "3apple"),
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runMsgServerTestCase(s, testDef, tc)
+		})
+	}
+}
 
-				// Price transfer events.
-				s.eventCoinSpent(s.addr1, "70pear"),
-				s.eventMessageSender(s.addr1),
-				s.eventCoinReceived(s.addr2, "50pear"),
-				s.eventCoinReceived(s.addr3, "20pear"),
-				s.eventTransfer(s.addr2, s.addr1, "50pear"),
-				s.eventTransfer(s.addr3, s.addr1, "20pear"),
+func (s *TestSuite) TestMsgServer_LinkOrders() {
+	testDef := msgServerTestDef[exchange.MsgLinkOrdersRequest, exchange.MsgLinkOrdersResponse, struct{}]{
+		endpointName: "LinkOrders",
+		endpoint:     keeper.NewMsgServer(s.k).LinkOrders,
+		expResp:      &exchange.MsgLinkOrdersResponse{},
+		followup: func(msg *exchange.MsgLinkOrdersRequest, _ struct{}) {
+			expLink := exchange.NewOrderLink(msg.MarketId, msg.OrderId, msg.LinkedOrderId)
+			link, err := s.k.GetOrderLink(s.ctx, msg.OrderId)
+			if s.Assert().NoError(err, "GetOrderLink(%d) error", msg.OrderId) {
+				s.Assert().Equal(expLink, link, "GetOrderLink(%d)", msg.OrderId)
+			}
+			expLink = exchange.NewOrderLink(msg.MarketId, msg.LinkedOrderId, msg.OrderId)
+			link, err = s.k.GetOrderLink(s.ctx, msg.LinkedOrderId)
+			if s.Assert().NoError(err, "GetOrderLink(%d) error", msg.LinkedOrderId) {
+				s.Assert().Equal(expLink, link, "GetOrderLink(%d)", msg.LinkedOrderId)
+			}
+		},
+	}
 
-				// Settlement fee transfer events.
-				s.eventCoinSpent(s.addr2, "8pear"),
-				s.eventMessageSender(s.addr2),
-				s.eventCoinSpent(s.addr3, "12fig,2pear"),
-				s.eventMessageSender(s.addr3),
-				s.eventCoinSpent(s.addr1, "37fig"),
-				s.eventMessageSender(s.addr1),
-				s.eventCoinReceived(s.marketAddr1, "49fig,10pear"),
-				s.eventTransfer(s.marketAddr1, s.addr2, "8pear"),
-				s.eventTransfer(s.marketAddr1, s.addr3, "12fig,2pear"),
-				s.eventTransfer(s.marketAddr1, s.addr1, "37fig"),
+	tests := []msgServerTestCase[exchange.MsgLinkOrdersRequest, struct{}]{
+		{
+			name: "not the owner",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{MarketId: 2, AcceptingOrders: true})
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(83).WithAsk(&exchange.AskOrder{
+					MarketId: 2, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("1pear"),
+				}))
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(84).WithAsk(&exchange.AskOrder{
+					MarketId: 2, Seller: s.addr2.String(), Assets: s.coin("1apple"), Price: s.coin("2pear"),
+				}))
+			},
+			msg: exchange.MsgLinkOrdersRequest{
+				Owner: s.addr2.String(), MarketId: 2, OrderId: 83, LinkedOrderId: 84,
+			},
+			expInErr: []string{invReqErr, "account " + s.addr2.String() + " is not the owner of order 83"},
+		},
+		{
+			name: "ask and bid",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{MarketId: 2, AcceptingOrders: true})
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(44).WithAsk(&exchange.AskOrder{
+					MarketId: 2, Seller: s.addr1.String(), Assets: s.coin("3apple"), Price: s.coin("6pear"),
+				}))
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(45).WithBid(&exchange.BidOrder{
+					MarketId: 2, Buyer: s.addr1.String(), Assets: s.coin("3apple"), Price: s.coin("4pear"),
+				}))
+			},
+			msg: exchange.MsgLinkOrdersRequest{
+				Owner: s.addr1.String(), MarketId: 2, OrderId: 45, LinkedOrderId: 44,
+			},
+			expEvents: sdk.Events{
+				s.untypeEvent(&exchange.EventOrdersLinked{MarketId: 2, OrderId: 45, LinkedOrderId: 44}),
+			},
+		},
+	}
 
-				// Transfer of exchange portion of settlement fee.
-				s.eventCoinSpent(s.marketAddr1, "3fig,1pear"),
-				s.eventCoinReceived(s.feeCollectorAddr, "3fig,1pear"),
-				s.eventTransfer(s.feeCollectorAddr, s.marketAddr1, "3fig,1pear"),
-				s.eventMessageSender(s.marketAddr1),
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runMsgServerTestCase(s, testDef, tc)
+		})
+	}
+}
 
-				// Order filled events.
-				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId:    12345,
-					Assets:     "10apple",
-					Price:      "50pear",
-					Fees:       "8pear",
-					MarketId:   1,
-					ExternalId: "first order",
-				}),
-				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId:    98765,
-					Assets:     "3apple",
-					Price:      "20pear",
-					Fees:       "12fig,2pear",
-					MarketId:   1,
-					ExternalId: "second order",
-				}),
+func (s *TestSuite) TestMsgServer_SetDeadManSwitch() {
+	blockTime := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	testDef := msgServerTestDef[exchange.MsgSetDeadManSwitchRequest, exchange.MsgSetDeadManSwitchResponse, *exchange.DeadManSwitch]{
+		endpointName: "SetDeadManSwitch",
+		endpoint:     keeper.NewMsgServer(s.k).SetDeadManSwitch,
+		expResp:      &exchange.MsgSetDeadManSwitchResponse{},
+		followup: func(msg *exchange.MsgSetDeadManSwitchRequest, expDMS *exchange.DeadManSwitch) {
+			dms, err := s.k.GetDeadManSwitch(s.ctx, sdk.MustAccAddressFromBech32(msg.Account))
+			if s.Assert().NoError(err, "GetDeadManSwitch error") {
+				s.Assert().Equal(expDMS, dms, "GetDeadManSwitch")
+			}
+		},
+	}
+	setBlockTime := func() {
+		s.ctx = s.ctx.WithBlockTime(blockTime)
+	}
 
-				// The net-asset-value event.
-				s.markerNavSetEvent("13apple", "70pear", 1),
+	tests := []msgServerTestCase[exchange.MsgSetDeadManSwitchRequest, *exchange.DeadManSwitch]{
+		{
+			name:     "remove when none exists",
+			setup:    setBlockTime,
+			msg:      exchange.MsgSetDeadManSwitchRequest{Account: s.addr1.String(), IntervalSeconds: 0},
+			expInErr: []string{invReqErr, "no dead-man switch found for " + s.addr1.String()},
+		},
+		{
+			name:  "new dead-man switch",
+			setup: setBlockTime,
+			msg:   exchange.MsgSetDeadManSwitchRequest{Account: s.addr1.String(), IntervalSeconds: 30},
+			expEvents: sdk.Events{
+				s.untypeEvent(exchange.NewEventDeadManSwitchSet(exchange.NewDeadManSwitch(s.addr1.String(), 30, blockTime))),
+			},
+			fArgs: exchange.NewDeadManSwitch(s.addr1.String(), 30, blockTime),
+		},
+		{
+			name: "remove existing",
+			setup: func() {
+				setBlockTime()
+				s.requireSetDeadManSwitchesInStore(exchange.NewDeadManSwitch(s.addr2.String(), 30, blockTime.Add(-time.Minute)))
+			},
+			msg:       exchange.MsgSetDeadManSwitchRequest{Account: s.addr2.String(), IntervalSeconds: 0},
+			expEvents: sdk.Events{s.untypeEvent(exchange.NewEventDeadManSwitchRemoved(s.addr2.String()))},
+			fArgs:     nil,
+		},
+	}
 
-				// Order creation fee events.
-				s.eventCoinSpent(s.addr1, "10fig"),
-				s.eventCoinReceived(s.marketAddr1, "10fig"),
-				s.eventTransfer(s.marketAddr1, s.addr1, "10fig"),
-				s.eventMessageSender(s.addr1),
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runMsgServerTestCase(s, testDef, tc)
+		})
+	}
+}
 
-				// Transfer of exchange portion of order creation fees.
-				s.eventCoinSpent(s.marketAddr1, "1fig"),
-				s.eventCoinReceived(s.feeCollectorAddr, "1fig"),
-				s.eventTransfer(s.feeCollectorAddr, s.marketAddr1, "1fig"),
-				s.eventMessageSender(s.marketAddr1),
+func (s *TestSuite) TestMsgServer_Heartbeat() {
+	blockTime := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	testDef := msgServerTestDef[exchange.MsgHeartbeatRequest, exchange.MsgHeartbeatResponse, *exchange.DeadManSwitch]{
+		endpointName: "Heartbeat",
+		endpoint:     keeper.NewMsgServer(s.k).Heartbeat,
+		expResp:      &exchange.MsgHeartbeatResponse{},
+		followup: func(msg *exchange.MsgHeartbeatRequest, expDMS *exchange.DeadManSwitch) {
+			dms, err := s.k.GetDeadManSwitch(s.ctx, sdk.MustAccAddressFromBech32(msg.Account))
+			if s.Assert().NoError(err, "GetDeadManSwitch error") {
+				s.Assert().Equal(expDMS, dms, "GetDeadManSwitch")
+			}
+		},
+	}
+	setup := func() {
+		s.ctx = s.ctx.WithBlockTime(blockTime)
+		s.requireSetDeadManSwitchesInStore(exchange.NewDeadManSwitch(s.addr2.String(), 45, blockTime.Add(-30*time.Second)))
+	}
+
+	tests := []msgServerTestCase[exchange.MsgHeartbeatRequest, *exchange.DeadManSwitch]{
+		{
+			name:     "no dead-man switch",
+			setup:    setup,
+			msg:      exchange.MsgHeartbeatRequest{Account: s.addr1.String()},
+			expInErr: []string{invReqErr, "no dead-man switch found for " + s.addr1.String()},
+		},
+		{
+			name:  "has dead-man switch",
+			setup: setup,
+			msg:   exchange.MsgHeartbeatRequest{Account: s.addr2.String()},
+			expEvents: sdk.Events{
+				s.untypeEvent(exchange.NewEventDeadManSwitchHeartbeat(exchange.NewDeadManSwitch(s.addr2.String(), 45, blockTime))),
 			},
+			fArgs: exchange.NewDeadManSwitch(s.addr2.String(), 45, blockTime),
 		},
 	}
 
@@ -2184,921 +2143,3228 @@ func (s *TestSuite) TestMsgServer_FillAsks() {
 	}
 }
 
-func (s *TestSuite) TestMsgServer_MarketSettle() {
-	type followupArgs struct {
-		expBals     []expBalances
-		partialLeft *exchange.Order
-	}
-	testDef := msgServerTestDef[exchange.MsgMarketSettleRequest, exchange.MsgMarketSettleResponse, followupArgs]{
-		endpointName: "MarketSettle",
-		endpoint:     keeper.NewMsgServer(s.k).MarketSettle,
-		expResp:      &exchange.MsgMarketSettleResponse{},
-		followup: func(msg *exchange.MsgMarketSettleRequest, fArgs followupArgs) {
-			for _, orderID := range msg.AskOrderIds {
-				var expOrder *exchange.Order
-				if fArgs.partialLeft != nil && fArgs.partialLeft.OrderId == orderID {
-					expOrder = fArgs.partialLeft
-				}
+func (s *TestSuite) TestMsgServer_FillBids() {
+	testDef := msgServerTestDef[exchange.MsgFillBidsRequest, exchange.MsgFillBidsResponse, []expBalances]{
+		endpointName: "FillBids",
+		endpoint:     keeper.NewMsgServer(s.k).FillBids,
+		expResp:      &exchange.MsgFillBidsResponse{},
+		followup: func(msg *exchange.MsgFillBidsRequest, ebs []expBalances) {
+			for _, orderID := range msg.BidOrderIds {
 				order, err := s.k.GetOrder(s.ctx, orderID)
 				s.Assert().NoError(err, "GetOrder(%d) error", orderID)
-				s.Assert().Equal(expOrder, order, "GetOrder(%d) order", orderID)
+				s.Assert().Nil(order, "GetOrder(%d) order", orderID)
 			}
 
-			for _, eb := range fArgs.expBals {
+			for _, eb := range ebs {
 				s.checkBalances(eb)
 			}
 		},
 	}
 
-	tests := []msgServerTestCase[exchange.MsgMarketSettleRequest, followupArgs]{
+	tests := []msgServerTestCase[exchange.MsgFillBidsRequest, []expBalances]{
 		{
-			name: "admin does not have settle permission",
+			name: "user can't create ask",
 			setup: func() {
+				s.requireSetNameRecord("almost.gonna.have.it", s.addr5)
+				s.requireSetAttr(s.addr1, "almost.gonna.have.it", s.addr5)
+
 				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId:     1,
-					AccessGrants: []exchange.AccessGrant{s.agCanAllBut(s.addr5, exchange.Permission_settle)},
+					MarketId: 1, AcceptingOrders: true, AllowUserSettlement: true,
+					ReqAttrCreateAsk: []string{"not.gonna.have.it"},
 				})
 			},
-			msg: exchange.MsgMarketSettleRequest{
-				Admin:         s.addr5.String(),
-				MarketId:      1,
-				AskOrderIds:   []uint64{1},
-				BidOrderIds:   []uint64{2},
-				ExpectPartial: false,
+			msg: exchange.MsgFillBidsRequest{
+				Seller:      s.addr1.String(),
+				MarketId:    1,
+				TotalAssets: s.coins("1apple"),
+				BidOrderIds: []uint64{1},
 			},
-			expInErr: []string{invReqErr,
-				"account " + s.addr5.String() + " does not have permission to settle orders for market 1"},
+			expInErr: []string{invReqErr, "account " + s.addr1.String() + " is not allowed to create ask orders in market 1"},
 		},
 		{
-			name: "an address is sanctioned",
+			name: "one bid, both quarantined, no markers",
 			setup: func() {
-				s.requireFundAccount(s.addr1, "7apple")
-				s.requireFundAccount(s.addr2, "100pear")
-				s.requireFundAccount(s.addr3, "11apple")
-				s.requireFundAccount(s.addr4, "85pear")
+				s.requireFundAccount(s.addr1, "50pear")
+				s.requireFundAccount(s.addr2, "10apple")
 
 				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId: 1, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_settle)},
+					MarketId: 3, AcceptingOrders: true, AllowUserSettlement: true,
 				})
-
-				store := s.getStore()
-				s.requireSetOrderInStore(store, exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
-					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("7apple"), Price: s.coin("75pear"),
-				}))
-				s.requireAddHold(s.addr1, "7apple", 1)
-				s.requireSetOrderInStore(store, exchange.NewOrder(22).WithBid(&exchange.BidOrder{
-					MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("100pear"),
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(54).WithBid(&exchange.BidOrder{
+					MarketId: 3, Buyer: s.addr1.String(), Assets: s.coin("10apple"), Price: s.coin("50pear"),
 				}))
-				s.requireAddHold(s.addr2, "100pear", 22)
-				s.requireSetOrderInStore(store, exchange.NewOrder(333).WithAsk(&exchange.AskOrder{
-					MarketId: 1, Seller: s.addr3.String(), Assets: s.coin("11apple"), Price: s.coin("105pear"),
-				}))
-				s.requireAddHold(s.addr3, "11apple", 333)
-				s.requireSetOrderInStore(store, exchange.NewOrder(4444).WithBid(&exchange.BidOrder{
-					MarketId: 1, Buyer: s.addr4.String(), Assets: s.coin("8apple"), Price: s.coin("85pear"),
-				}))
-				s.requireAddHold(s.addr4, "85pear", 4444)
+				s.requireAddHold(s.addr1, "50pear", 54)
 
-				s.requireSanctionAddress(s.addr2)
+				s.requireQuarantineOptIn(s.addr1)
+				s.requireQuarantineOptIn(s.addr2)
 			},
-			msg: exchange.MsgMarketSettleRequest{
-				Admin:       s.addr5.String(),
-				MarketId:    1,
-				AskOrderIds: []uint64{333, 1},
-				BidOrderIds: []uint64{22, 4444},
+			msg: exchange.MsgFillBidsRequest{
+				Seller:      s.addr2.String(),
+				MarketId:    3,
+				TotalAssets: s.coins("10apple"),
+				BidOrderIds: []uint64{54},
+			},
+			fArgs: []expBalances{
+				{
+					addr:     s.addr1,
+					expBal:   []sdk.Coin{s.coin("10apple"), s.zeroCoin("pear")},
+					expHold:  s.zeroCoins("apple", "pear"),
+					expSpend: []sdk.Coin{s.coin("10apple"), s.zeroCoin("pear")},
+				},
+				{
+					addr:     s.addr2,
+					expBal:   []sdk.Coin{s.zeroCoin("apple"), s.coin("50pear")},
+					expHold:  s.zeroCoins("apple", "pear"),
+					expSpend: []sdk.Coin{s.zeroCoin("apple"), s.coin("50pear")},
+				},
+			},
+			expEvents: sdk.Events{
+				s.eventHoldReleased(s.addr1, "50pear"),
+				s.eventCoinSpent(s.addr2, "10apple"),
+				s.eventCoinReceived(s.addr1, "10apple"),
+				s.eventTransfer(s.addr1, s.addr2, "10apple"),
+				s.eventMessageSender(s.addr2),
+				s.eventCoinSpent(s.addr1, "50pear"),
+				s.eventCoinReceived(s.addr2, "50pear"),
+				s.eventTransfer(s.addr2, s.addr1, "50pear"),
+				s.eventMessageSender(s.addr1),
+				s.untypeEvent(&exchange.EventOrderFilled{
+					OrderId: 54, Assets: "10apple", Price: "50pear", MarketId: 3, SettlementId: 1,
+				}),
+				s.markerNavSetEvent("10apple", "50pear", 3),
 			},
-			expInErr: []string{invReqErr, "cannot send from " + s.addr2.String(), "account is sanctioned"},
 		},
 		{
-			name: "a buyer does not have asset req attr",
+			name: "one bid, both quarantined, with markers",
 			setup: func() {
-				s.requireAddFinalizeAndActivateMarker(s.coin("18apple"), s.addr5, "*.have.it")
-				s.requireSetNameRecord("buyer.have.it", s.addr5)
-				s.requireSetNameRecord("seller.have.it", s.addr5)
-				s.requireSetNameRecord("doesnot-have.it", s.addr5)
-				s.requireSetAttr(s.addr1, "seller.have.it", s.addr5)
-				s.requireSetAttr(s.addr2, "buyer.have.it", s.addr5)
-				s.requireSetAttr(s.addr3, "seller.have.it", s.addr5)
-				s.requireSetAttr(s.addr4, "doesnot-have.it", s.addr5)
-				s.requireFundAccount(s.addr1, "7apple")
-				s.requireFundAccount(s.addr2, "100pear")
-				s.requireFundAccount(s.addr3, "11apple")
-				s.requireFundAccount(s.addr4, "85pear")
+				s.requireAddFinalizeAndActivateMarker(s.coin("10apple"), s.addr5, "got.it")
+				s.requireAddFinalizeAndActivateMarker(s.coin("50pear"), s.addr5, "got.it")
+				s.requireSetNameRecord("got.it", s.addr5)
+				s.requireSetAttr(s.addr1, "got.it", s.addr5)
+				s.requireSetAttr(s.addr2, "got.it", s.addr5)
+				s.requireFundAccount(s.addr1, "50pear")
+				s.requireFundAccount(s.addr2, "10apple")
 
 				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId: 1, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.adminAddr, exchange.Permission_settle)},
+					MarketId: 3, AcceptingOrders: true, AllowUserSettlement: true,
 				})
-
-				store := s.getStore()
-				s.requireSetOrderInStore(store, exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
-					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("7apple"), Price: s.coin("75pear"),
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(54).WithBid(&exchange.BidOrder{
+					MarketId: 3, Buyer: s.addr1.String(), Assets: s.coin("10apple"), Price: s.coin("50pear"),
 				}))
-				s.requireAddHold(s.addr1, "7apple", 1)
-				s.requireSetOrderInStore(store, exchange.NewOrder(22).WithBid(&exchange.BidOrder{
-					MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("100pear"),
+				s.requireAddHold(s.addr1, "50pear", 54)
+
+				s.requireQuarantineOptIn(s.addr1)
+				s.requireQuarantineOptIn(s.addr2)
+			},
+			msg: exchange.MsgFillBidsRequest{
+				Seller:      s.addr2.String(),
+				MarketId:    3,
+				TotalAssets: s.coins("10apple"),
+				BidOrderIds: []uint64{54},
+			},
+			fArgs: []expBalances{
+				{
+					addr:     s.addr1,
+					expBal:   []sdk.Coin{s.coin("10apple"), s.zeroCoin("pear")},
+					expHold:  s.zeroCoins("apple", "pear"),
+					expSpend: []sdk.Coin{s.coin("10apple"), s.zeroCoin("pear")},
+				},
+				{
+					addr:     s.addr2,
+					expBal:   []sdk.Coin{s.zeroCoin("apple"), s.coin("50pear")},
+					expHold:  s.zeroCoins("apple", "pear"),
+					expSpend: []sdk.Coin{s.zeroCoin("apple"), s.coin("50pear")},
+				},
+			},
+			expEvents: sdk.Events{
+				s.eventHoldReleased(s.addr1, "50pear"),
+				s.eventCoinSpent(s.addr2, "10apple"),
+				s.eventCoinReceived(s.addr1, "10apple"),
+				s.eventTransfer(s.addr1, s.addr2, "10apple"),
+				s.eventMessageSender(s.addr2),
+				s.eventCoinSpent(s.addr1, "50pear"),
+				s.eventCoinReceived(s.addr2, "50pear"),
+				s.eventTransfer(s.addr2, s.addr1, "50pear"),
+				s.eventMessageSender(s.addr1),
+				s.untypeEvent(&exchange.EventOrderFilled{
+					OrderId: 54, Assets: "10apple", Price: "50pear", MarketId: 3, SettlementId: 1,
+				}),
+				s.markerNavSetEvent("10apple", "50pear", 3),
+			},
+		},
+		{
+			name: "one bid, buyer sanctioned",
+			setup: func() {
+				s.requireFundAccount(s.addr1, "50pear")
+				s.requireFundAccount(s.addr4, "10apple")
+
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 2, AcceptingOrders: true, AllowUserSettlement: true,
+				})
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(77).WithBid(&exchange.BidOrder{
+					MarketId: 2, Buyer: s.addr1.String(), Assets: s.coin("10apple"), Price: s.coin("50pear"),
 				}))
-				s.requireAddHold(s.addr2, "100pear", 22)
-				s.requireSetOrderInStore(store, exchange.NewOrder(333).WithAsk(&exchange.AskOrder{
-					MarketId: 1, Seller: s.addr3.String(), Assets: s.coin("11apple"), Price: s.coin("105pear"),
+				s.requireAddHold(s.addr1, "50pear", 77)
+
+				s.requireSanctionAddress(s.addr1)
+			},
+			msg: exchange.MsgFillBidsRequest{
+				Seller:      s.addr4.String(),
+				MarketId:    2,
+				TotalAssets: s.coins("10apple"),
+				BidOrderIds: []uint64{77},
+			},
+			expInErr: []string{invReqErr, "cannot send from " + s.addr1.String(), "account is sanctioned"},
+		},
+		{
+			name: "one bid, seller sanctioned",
+			setup: func() {
+				s.requireFundAccount(s.addr1, "50pear")
+				s.requireFundAccount(s.addr4, "10apple")
+
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 2, AcceptingOrders: true, AllowUserSettlement: true,
+				})
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(77).WithBid(&exchange.BidOrder{
+					MarketId: 2, Buyer: s.addr1.String(), Assets: s.coin("10apple"), Price: s.coin("50pear"),
 				}))
-				s.requireAddHold(s.addr3, "11apple", 333)
-				s.requireSetOrderInStore(store, exchange.NewOrder(4444).WithBid(&exchange.BidOrder{
-					MarketId: 1, Buyer: s.addr4.String(), Assets: s.coin("8apple"), Price: s.coin("85pear"),
+
+				s.requireAddHold(s.addr1, "50pear", 77)
+
+				s.requireSanctionAddress(s.addr4)
+			},
+			msg: exchange.MsgFillBidsRequest{
+				Seller:      s.addr4.String(),
+				MarketId:    2,
+				TotalAssets: s.coins("10apple"),
+				BidOrderIds: []uint64{77},
+			},
+			expInErr: []string{invReqErr, "cannot send from " + s.addr4.String(), "account is sanctioned"},
+		},
+		{
+			name: "one bid, buyer does not have asset marker's req attrs",
+			setup: func() {
+				s.requireAddFinalizeAndActivateMarker(s.coin("10apple"), s.addr5, "not.gonna.have.it")
+				s.requireSetNameRecord("not.gonna.have.it", s.addr5)
+				s.requireSetAttr(s.addr1, "not.gonna.have.it", s.addr5)
+				s.requireFundAccount(s.addr2, "50pear")
+				s.requireFundAccount(s.addr1, "10apple")
+
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 2, AcceptingOrders: true, AllowUserSettlement: true,
+				})
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(4).WithBid(&exchange.BidOrder{
+					MarketId: 2, Buyer: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("50pear"),
 				}))
-				s.requireAddHold(s.addr4, "85pear", 4444)
+				s.requireAddHold(s.addr2, "50pear", 4)
 			},
-			msg: exchange.MsgMarketSettleRequest{
-				Admin:       s.adminAddr.String(),
-				MarketId:    1,
-				AskOrderIds: []uint64{333, 1},
-				BidOrderIds: []uint64{22, 4444},
+			msg: exchange.MsgFillBidsRequest{
+				Seller:      s.addr1.String(),
+				MarketId:    2,
+				TotalAssets: s.coins("10apple"),
+				BidOrderIds: []uint64{4},
 			},
 			expInErr: []string{invReqErr,
-				"address " + s.addr4.String() + " does not contain the \"apple\" required attribute: \"*.have.it\""},
+				"address " + s.addr2.String() + " does not contain the \"apple\" required attribute: \"not.gonna.have.it\"",
+			},
 		},
 		{
-			name: "a buyer does not have asset req attr but admin has transfer",
+			name: "one bid, seller does not have price marker's req attrs",
 			setup: func() {
-				s.requireAddFinalizeAndActivateMarker(s.coin("18apple"), s.addr5, "*.have.it")
-				s.requireSetNameRecord("buyer.have.it", s.addr5)
-				s.requireSetNameRecord("seller.have.it", s.addr5)
-				s.requireSetNameRecord("doesnot-have.it", s.addr5)
-				s.requireSetAttr(s.addr1, "seller.have.it", s.addr5)
-				s.requireSetAttr(s.addr2, "buyer.have.it", s.addr5)
-				s.requireSetAttr(s.addr3, "seller.have.it", s.addr5)
-				s.requireSetAttr(s.addr4, "doesnot-have.it", s.addr5)
-				s.requireFundAccount(s.addr1, "7apple")
-				s.requireFundAccount(s.addr2, "100pear")
-				s.requireFundAccount(s.addr3, "11apple")
-				s.requireFundAccount(s.addr4, "85pear")
+				s.requireAddFinalizeAndActivateMarker(s.coin("50pear"), s.addr5, "not.gonna.have.it")
+				s.requireSetNameRecord("not.gonna.have.it", s.addr5)
+				s.requireSetAttr(s.addr2, "not.gonna.have.it", s.addr5)
+				s.requireFundAccount(s.addr2, "50pear")
+				s.requireFundAccount(s.addr1, "10apple")
 
 				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId: 1, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_settle)},
+					MarketId: 2, AcceptingOrders: true, AllowUserSettlement: true,
 				})
-
-				store := s.getStore()
-				s.requireSetOrderInStore(store, exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
-					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("7apple"), Price: s.coin("75pear"),
-				}))
-				s.requireAddHold(s.addr1, "7apple", 1)
-				s.requireSetOrderInStore(store, exchange.NewOrder(22).WithBid(&exchange.BidOrder{
-					MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("100pear"),
-				}))
-				s.requireAddHold(s.addr2, "100pear", 22)
-				s.requireSetOrderInStore(store, exchange.NewOrder(333).WithAsk(&exchange.AskOrder{
-					MarketId: 1, Seller: s.addr3.String(), Assets: s.coin("11apple"), Price: s.coin("105pear"),
-				}))
-				s.requireAddHold(s.addr3, "11apple", 333)
-				s.requireSetOrderInStore(store, exchange.NewOrder(4444).WithBid(&exchange.BidOrder{
-					MarketId: 1, Buyer: s.addr4.String(), Assets: s.coin("8apple"), Price: s.coin("85pear"),
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(4).WithBid(&exchange.BidOrder{
+					MarketId: 2, Buyer: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("50pear"),
 				}))
-				s.requireAddHold(s.addr4, "85pear", 4444)
+				s.requireAddHold(s.addr2, "50pear", 4)
 			},
-			msg: exchange.MsgMarketSettleRequest{
-				Admin:       s.addr5.String(),
-				MarketId:    1,
-				AskOrderIds: []uint64{333, 1},
-				BidOrderIds: []uint64{22, 4444},
+			msg: exchange.MsgFillBidsRequest{
+				Seller:      s.addr1.String(),
+				MarketId:    2,
+				TotalAssets: s.coins("10apple"),
+				BidOrderIds: []uint64{4},
 			},
-			fArgs: followupArgs{
-				expBals: []expBalances{
-					{
-						addr:    s.addr1,
-						expBal:  []sdk.Coin{s.zeroCoin("apple"), s.coin("76pear"), s.zeroCoin("fig")},
-						expHold: s.zeroCoins("apple", "pear", "fig"),
-					},
-					{
-						addr:    s.addr2,
-						expBal:  []sdk.Coin{s.coin("10apple"), s.zeroCoin("pear"), s.zeroCoin("fig")},
-						expHold: s.zeroCoins("apple", "pear", "fig"),
-					},
-					{
-						addr:    s.addr3,
-						expBal:  []sdk.Coin{s.zeroCoin("apple"), s.coin("109pear"), s.zeroCoin("fig")},
-						expHold: s.zeroCoins("apple", "pear", "fig"),
-					},
-					{
-						addr:    s.addr4,
-						expBal:  []sdk.Coin{s.coin("8apple"), s.zeroCoin("pear"), s.zeroCoin("fig")},
-						expHold: s.zeroCoins("apple", "pear", "fig"),
-					},
-					{
-						addr:   s.marketAddr1,
-						expBal: s.zeroCoins("apple", "pear", "fig"),
-					},
-					{
-						addr:   s.feeCollectorAddr,
-						expBal: s.zeroCoins("apple", "pear", "fig"),
-					},
-				},
+			expInErr: []string{invReqErr,
+				"address " + s.addr1.String() + " does not contain the \"pear\" required attribute: \"not.gonna.have.it\"",
 			},
-			expEvents: sdk.Events{
-				// Hold releases (0-3)
-				s.eventHoldReleased(s.addr3, "11apple"),
-				s.eventHoldReleased(s.addr1, "7apple"),
-				s.eventHoldReleased(s.addr2, "100pear"),
-				s.eventHoldReleased(s.addr4, "85pear"),
-
-				// Asset transfers (4-9, 10-13)
-				s.eventCoinSpent(s.addr3, "11apple"),
-				s.eventMessageSender(s.addr3),
-				s.eventCoinReceived(s.addr2, "10apple"),
-				s.eventCoinReceived(s.addr4, "1apple"),
-				s.eventTransfer(s.addr2, s.addr3, "10apple"),
-				s.eventTransfer(s.addr4, s.addr3, "1apple"),
-
-				s.eventCoinSpent(s.addr1, "7apple"),
-				s.eventCoinReceived(s.addr4, "7apple"),
-				s.eventTransfer(s.addr4, s.addr1, "7apple"),
-				s.eventMessageSender(s.addr1),
-
-				// Price transfers (14-17, 18-23)
-				s.eventCoinSpent(s.addr2, "100pear"),
-				s.eventCoinReceived(s.addr3, "100pear"),
-				s.eventTransfer(s.addr3, s.addr2, "100pear"),
-				s.eventMessageSender(s.addr2),
-
-				s.eventCoinSpent(s.addr4, "85pear"),
-				s.eventMessageSender(s.addr4),
-				s.eventCoinReceived(s.addr3, "9pear"),
-				s.eventCoinReceived(s.addr1, "76pear"),
-				s.eventTransfer(s.addr3, s.addr4, "9pear"),
-				s.eventTransfer(s.addr1, s.addr4, "76pear"),
-
-				// Orders filled (24-27)
-				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 333, Assets: "11apple", Price: "109pear", MarketId: 1,
-				}),
-				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 1, Assets: "7apple", Price: "76pear", MarketId: 1,
-				}),
-				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 22, Assets: "10apple", Price: "100pear", MarketId: 1,
-				}),
-				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 4444, Assets: "8apple", Price: "85pear", MarketId: 1,
-				}),
+		},
+		{
+			name: "market does not have req attr for fee denom",
+			setup: func() {
+				s.requireAddFinalizeAndActivateMarker(s.coin("200fig"), s.addr5, "not.gonna.have.it")
+				s.requireSetNameRecord("not.gonna.have.it", s.addr5)
+				s.requireSetAttr(s.addr1, "not.gonna.have.it", s.addr5)
+				s.requireSetAttr(s.addr2, "not.gonna.have.it", s.addr5)
+				s.requireFundAccount(s.addr2, "50pear,100fig")
+				s.requireFundAccount(s.addr1, "10apple,100fig")
 
-				// The net-asset-value event (28).
-				s.markerNavSetEvent("18apple", "185pear", 1),
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 1, AcceptingOrders: true, AllowUserSettlement: true,
+				})
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(12345).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("50pear"),
+					BuyerSettlementFees: s.coins("100fig"),
+				}))
+				s.requireAddHold(s.addr2, "50pear,100fig", 12345)
+			},
+			msg: exchange.MsgFillBidsRequest{
+				Seller:                  s.addr1.String(),
+				MarketId:                1,
+				TotalAssets:             s.coins("10apple"),
+				BidOrderIds:             []uint64{12345},
+				SellerSettlementFlatFee: s.coinP("100fig"),
+			},
+			expInErr: []string{invReqErr,
+				"address " + s.marketAddr1.String() + " does not contain the \"fig\" required attribute: \"not.gonna.have.it\"",
 			},
 		},
 		{
-			name: "a seller does not have price req attr",
+			name: "fee in restricted denom",
 			setup: func() {
-				s.requireAddFinalizeAndActivateMarker(s.coin("185pear"), s.addr5, "*.have.it")
-				s.requireSetNameRecord("buyer.have.it", s.addr5)
-				s.requireSetNameRecord("seller.have.it", s.addr5)
-				s.requireSetNameRecord("doesnot-have.it", s.addr5)
-				s.requireSetAttr(s.addr1, "doesnot-have.it", s.addr5)
-				s.requireSetAttr(s.addr2, "buyer.have.it", s.addr5)
-				s.requireSetAttr(s.addr3, "seller.have.it", s.addr5)
-				s.requireSetAttr(s.addr4, "buyer.have.it", s.addr5)
-				s.requireFundAccount(s.addr1, "7apple")
-				s.requireFundAccount(s.addr2, "100pear")
-				s.requireFundAccount(s.addr3, "11apple")
-				s.requireFundAccount(s.addr4, "85pear")
+				s.requireAddFinalizeAndActivateMarker(s.coin("13apple"), s.addr5, "*.gonna.have.it")
+				s.requireAddFinalizeAndActivateMarker(s.coin("70pear"), s.addr5, "*.gonna.have.it")
+				s.requireAddFinalizeAndActivateMarker(s.coin("300fig"), s.addr5, "*.gonna.have.it")
+				s.requireSetNameRecord("buyer.gonna.have.it", s.addr5)
+				s.requireSetNameRecord("seller.gonna.have.it", s.addr5)
+				s.requireSetNameRecord("market.gonna.have.it", s.addr5)
+				s.requireSetAttr(s.addr1, "seller.gonna.have.it", s.addr5)
+				s.requireSetAttr(s.addr2, "buyer.gonna.have.it", s.addr5)
+				s.requireSetAttr(s.addr3, "buyer.gonna.have.it", s.addr5)
+				s.requireSetAttr(s.marketAddr1, "market.gonna.have.it", s.addr5)
+				s.requireFundAccount(s.addr1, "13apple,100fig")
+				s.requireFundAccount(s.addr2, "50pear,100fig")
+				s.requireFundAccount(s.addr3, "20pear,100fig")
 
 				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId: 1, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.adminAddr, exchange.Permission_settle)},
+					MarketId: 1, AcceptingOrders: true, AllowUserSettlement: true,
+					FeeCreateAskFlat:          s.coins("10fig"),
+					FeeCreateBidFlat:          s.coins("200fig"),
+					FeeSellerSettlementFlat:   s.coins("5pear"),
+					FeeSellerSettlementRatios: s.ratios("35pear:2pear"),
+					FeeBuyerSettlementFlat:    s.coins("30fig"),
+					FeeBuyerSettlementRatios:  s.ratios("10pear:1fig"),
+					ReqAttrCreateAsk:          []string{"*.gonna.have.it"},
+					ReqAttrCreateBid:          []string{"not.gonna.have.it"},
 				})
-
-				store := s.getStore()
-				s.requireSetOrderInStore(store, exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
-					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("7apple"), Price: s.coin("75pear"),
-				}))
-				s.requireAddHold(s.addr1, "7apple", 1)
-				s.requireSetOrderInStore(store, exchange.NewOrder(22).WithBid(&exchange.BidOrder{
-					MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("100pear"),
-				}))
-				s.requireAddHold(s.addr2, "100pear", 22)
-				s.requireSetOrderInStore(store, exchange.NewOrder(333).WithAsk(&exchange.AskOrder{
-					MarketId: 1, Seller: s.addr3.String(), Assets: s.coin("11apple"), Price: s.coin("105pear"),
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(12345).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("50pear"),
+					BuyerSettlementFees: s.coins("35fig"), ExternalId: "first order",
 				}))
-				s.requireAddHold(s.addr3, "11apple", 333)
-				s.requireSetOrderInStore(store, exchange.NewOrder(4444).WithBid(&exchange.BidOrder{
-					MarketId: 1, Buyer: s.addr4.String(), Assets: s.coin("8apple"), Price: s.coin("85pear"),
+				s.requireAddHold(s.addr2, "50pear,35fig", 12345)
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(98765).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr3.String(), Assets: s.coin("3apple"), Price: s.coin("20pear"),
+					BuyerSettlementFees: s.coins("32fig"), ExternalId: "second order",
 				}))
-				s.requireAddHold(s.addr4, "85pear", 4444)
+				s.requireAddHold(s.addr3, "20pear,32fig", 98765)
 			},
-			msg: exchange.MsgMarketSettleRequest{
-				Admin:       s.adminAddr.String(),
-				MarketId:    1,
-				AskOrderIds: []uint64{333, 1},
-				BidOrderIds: []uint64{22, 4444},
+			msg: exchange.MsgFillBidsRequest{
+				Seller:                  s.addr1.String(),
+				MarketId:                1,
+				TotalAssets:             s.coins("13apple"),
+				BidOrderIds:             []uint64{12345, 98765},
+				SellerSettlementFlatFee: s.coinP("5pear"),
+				AskOrderCreationFee:     s.coinP("10fig"),
 			},
 			expInErr: []string{invReqErr,
-				"address " + s.addr1.String() + " does not contain the \"pear\" required attribute: \"*.have.it\""},
+				"error collecting exchange fee 4fig,1pear (based off 67fig,9pear) from market 1",
+				"restricted denom fig cannot be sent to the fee collector",
+			},
 		},
 		{
-			name: "a seller does not have price req attr but admin has transfer",
+			name: "okay: two bids, all req attrs and fees",
 			setup: func() {
-				s.requireAddFinalizeAndActivateMarker(s.coin("185pear"), s.addr5, "*.have.it")
-				s.requireSetNameRecord("buyer.have.it", s.addr5)
-				s.requireSetNameRecord("seller.have.it", s.addr5)
-				s.requireSetNameRecord("doesnot-have.it", s.addr5)
-				s.requireSetAttr(s.addr1, "doesnot-have.it", s.addr5)
-				s.requireSetAttr(s.addr2, "buyer.have.it", s.addr5)
-				s.requireSetAttr(s.addr3, "seller.have.it", s.addr5)
-				s.requireSetAttr(s.addr4, "buyer.have.it", s.addr5)
-				s.requireFundAccount(s.addr1, "7apple")
-				s.requireFundAccount(s.addr2, "100pear")
-				s.requireFundAccount(s.addr3, "11apple")
-				s.requireFundAccount(s.addr4, "85pear")
+				s.requireAddFinalizeAndActivateMarker(s.coin("13apple"), s.addr5, "*.gonna.have.it")
+				s.requireAddFinalizeAndActivateCoinMarker(s.coin("70pear"), s.addr5)
+				s.requireAddFinalizeAndActivateCoinMarker(s.coin("300fig"), s.addr5)
+				s.requireSetNameRecord("buyer.gonna.have.it", s.addr5)
+				s.requireSetNameRecord("seller.gonna.have.it", s.addr5)
+				s.requireSetNameRecord("market.gonna.have.it", s.addr5)
+				s.requireSetAttr(s.addr1, "seller.gonna.have.it", s.addr5)
+				s.requireSetAttr(s.addr2, "buyer.gonna.have.it", s.addr5)
+				s.requireSetAttr(s.addr3, "buyer.gonna.have.it", s.addr5)
+				s.requireSetAttr(s.marketAddr1, "market.gonna.have.it", s.addr5)
+				s.requireFundAccount(s.addr1, "13apple,100fig")
+				s.requireFundAccount(s.addr2, "50pear,100fig")
+				s.requireFundAccount(s.addr3, "20pear,100fig")
 
 				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId: 1, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_settle)},
+					MarketId: 1, AcceptingOrders: true, AllowUserSettlement: true,
+					FeeCreateAskFlat:          s.coins("10fig"),
+					FeeCreateBidFlat:          s.coins("200fig"),
+					FeeSellerSettlementFlat:   s.coins("5pear"),
+					FeeSellerSettlementRatios: s.ratios("35pear:2pear"),
+					FeeBuyerSettlementFlat:    s.coins("30fig"),
+					FeeBuyerSettlementRatios:  s.ratios("10pear:1fig"),
+					ReqAttrCreateAsk:          []string{"*.gonna.have.it"},
+					ReqAttrCreateBid:          []string{"not.gonna.have.it"},
 				})
-
-				store := s.getStore()
-				s.requireSetOrderInStore(store, exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
-					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("7apple"), Price: s.coin("75pear"),
-				}))
-				s.requireAddHold(s.addr1, "7apple", 1)
-				s.requireSetOrderInStore(store, exchange.NewOrder(22).WithBid(&exchange.BidOrder{
-					MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("100pear"),
-				}))
-				s.requireAddHold(s.addr2, "100pear", 22)
-				s.requireSetOrderInStore(store, exchange.NewOrder(333).WithAsk(&exchange.AskOrder{
-					MarketId: 1, Seller: s.addr3.String(), Assets: s.coin("11apple"), Price: s.coin("105pear"),
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(12345).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("50pear"),
+					BuyerSettlementFees: s.coins("35fig"), ExternalId: "first order",
 				}))
-				s.requireAddHold(s.addr3, "11apple", 333)
-				s.requireSetOrderInStore(store, exchange.NewOrder(4444).WithBid(&exchange.BidOrder{
-					MarketId: 1, Buyer: s.addr4.String(), Assets: s.coin("8apple"), Price: s.coin("85pear"),
+				s.requireAddHold(s.addr2, "50pear,35fig", 12345)
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(98765).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr3.String(), Assets: s.coin("3apple"), Price: s.coin("20pear"),
+					BuyerSettlementFees: s.coins("32fig"), ExternalId: "second order",
 				}))
-				s.requireAddHold(s.addr4, "85pear", 4444)
+				s.requireAddHold(s.addr3, "20pear,32fig", 98765)
 			},
-			msg: exchange.MsgMarketSettleRequest{
-				Admin:       s.addr5.String(),
-				MarketId:    1,
-				AskOrderIds: []uint64{333, 1},
-				BidOrderIds: []uint64{22, 4444},
+			msg: exchange.MsgFillBidsRequest{
+				Seller:                  s.addr1.String(),
+				MarketId:                1,
+				TotalAssets:             s.coins("13apple"),
+				BidOrderIds:             []uint64{12345, 98765},
+				SellerSettlementFlatFee: s.coinP("5pear"),
+				AskOrderCreationFee:     s.coinP("10fig"),
 			},
-			fArgs: followupArgs{
+			fArgs: []expBalances{
+				{
+					addr:    s.addr1,
+					expBal:  []sdk.Coin{s.zeroCoin("apple"), s.coin("61pear"), s.coin("90fig")},
+					expHold: s.zeroCoins("apple", "pear", "fig"),
+				},
+				{
+					addr:    s.addr2,
+					expBal:  []sdk.Coin{s.coin("10apple"), s.zeroCoin("pear"), s.coin("65fig")},
+					expHold: s.zeroCoins("apple", "pear", "fig"),
+				},
+				{
+					addr:    s.addr3,
+					expBal:  []sdk.Coin{s.coin("3apple"), s.zeroCoin("pear"), s.coin("68fig")},
+					expHold: s.zeroCoins("apple", "pear", "fig"),
+				},
+				{
+					addr:    s.marketAddr1,
+					expBal:  []sdk.Coin{s.zeroCoin("apple"), s.coin("8pear"), s.coin("72fig")},
+					expHold: s.zeroCoins("apple", "pear", "fig"),
+				},
+				{
+					addr:   s.feeCollectorAddr,
+					expBal: []sdk.Coin{s.zeroCoin("apple"), s.coin("1pear"), s.coin("5fig")},
+				},
+			},
+			expEvents: sdk.Events{
+				// Hold release events.
+				s.eventHoldReleased(s.addr2, "35fig,50pear"),
+				s.eventHoldReleased(s.addr3, "32fig,20pear"),
+
+				// Asset transfer events.
+				s.eventCoinSpent(s.addr1, "13apple"),
+				s.eventMessageSender(s.addr1),
+				s.eventCoinReceived(s.addr2, "10apple"),
+				s.eventCoinReceived(s.addr3, "3apple"),
+				s.eventTransfer(s.addr2, s.addr1, "10apple"),
+				s.eventTransfer(s.addr3, s.addr1, "3apple"),
+
+				// Price transfer events.
+				s.eventCoinSpent(s.addr2, "50pear"),
+				s.eventMessageSender(s.addr2),
+				s.eventCoinSpent(s.addr3, "20pear"),
+				s.eventMessageSender(s.addr3),
+				s.eventCoinReceived(s.addr1, "70pear"),
+				s.eventTransfer(s.addr1, s.addr2, "50pear"),
+				s.eventTransfer(s.addr1, s.addr3, "20pear"),
+
+				// Settlement fee transfer events.
+				s.eventCoinSpent(s.addr2, "35fig"),
+				s.eventMessageSender(s.addr2),
+				s.eventCoinSpent(s.addr3, "32fig"),
+				s.eventMessageSender(s.addr3),
+				s.eventCoinSpent(s.addr1, "9pear"),
+				s.eventMessageSender(s.addr1),
+				s.eventCoinReceived(s.marketAddr1, "67fig,9pear"),
+				s.eventTransfer(s.marketAddr1, s.addr2, "35fig"),
+				s.eventTransfer(s.marketAddr1, s.addr3, "32fig"),
+				s.eventTransfer(s.marketAddr1, s.addr1, "9pear"),
+
+				// Transfer of exchange portion of settlement fee.
+				s.eventCoinSpent(s.marketAddr1, "4fig,1pear"),
+				s.eventCoinReceived(s.feeCollectorAddr, "4fig,1pear"),
+				s.eventTransfer(s.feeCollectorAddr, s.marketAddr1, "4fig,1pear"),
+				s.eventMessageSender(s.marketAddr1),
+
+				// Order filled events.
+				s.untypeEvent(&exchange.EventOrderFilled{
+					OrderId:      12345,
+					Assets:       "10apple",
+					Price:        "50pear",
+					Fees:         "35fig",
+					MarketId:     1,
+					ExternalId:   "first order",
+					SettlementId: 1,
+				}),
+				s.untypeEvent(&exchange.EventOrderFilled{
+					OrderId:      98765,
+					Assets:       "3apple",
+					Price:        "20pear",
+					Fees:         "32fig",
+					MarketId:     1,
+					ExternalId:   "second order",
+					SettlementId: 1,
+				}),
+
+				// The net-asset-value event.
+				s.markerNavSetEvent("13apple", "70pear", 1),
+
+				// Order creation fee events.
+				s.eventCoinSpent(s.addr1, "10fig"),
+				s.eventCoinReceived(s.marketAddr1, "10fig"),
+				s.eventTransfer(s.marketAddr1, s.addr1, "10fig"),
+				s.eventMessageSender(s.addr1),
+
+				// Transfer of exchange portion of order creation fees.
+				s.eventCoinSpent(s.marketAddr1, "1fig"),
+				s.eventCoinReceived(s.feeCollectorAddr, "1fig"),
+				s.eventTransfer(s.feeCollectorAddr, s.marketAddr1, "1fig"),
+				s.eventMessageSender(s.marketAddr1),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runMsgServerTestCase(s, testDef, tc)
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_FillAsks() {
+	testDef := msgServerTestDef[exchange.MsgFillAsksRequest, exchange.MsgFillAsksResponse, []expBalances]{
+		endpointName: "FillAsks",
+		endpoint:     keeper.NewMsgServer(s.k).FillAsks,
+		expResp:      &exchange.MsgFillAsksResponse{},
+		followup: func(msg *exchange.MsgFillAsksRequest, ebs []expBalances) {
+			for _, orderID := range msg.AskOrderIds {
+				order, err := s.k.GetOrder(s.ctx, orderID)
+				s.Assert().NoError(err, "GetOrder(%d) error", orderID)
+				s.Assert().Nil(order, "GetOrder(%d) order", orderID)
+			}
+
+			for _, eb := range ebs {
+				s.checkBalances(eb)
+			}
+		},
+	}
+
+	tests := []msgServerTestCase[exchange.MsgFillAsksRequest, []expBalances]{
+		{
+			name: "user can't create bid",
+			setup: func() {
+				s.requireSetNameRecord("almost.gonna.have.it", s.addr5)
+				s.requireSetAttr(s.addr1, "almost.gonna.have.it", s.addr5)
+
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 1, AcceptingOrders: true, AllowUserSettlement: true,
+					ReqAttrCreateBid: []string{"not.gonna.have.it"},
+				})
+			},
+			msg: exchange.MsgFillAsksRequest{
+				Buyer:       s.addr1.String(),
+				MarketId:    1,
+				TotalPrice:  s.coin("1pear"),
+				AskOrderIds: []uint64{1},
+			},
+			expInErr: []string{invReqErr, "account " + s.addr1.String() + " is not allowed to create bid orders in market 1"},
+		},
+		{
+			name: "one ask, both quarantined, no markers",
+			setup: func() {
+				s.requireFundAccount(s.addr1, "50pear")
+				s.requireFundAccount(s.addr2, "10apple")
+
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3, AcceptingOrders: true, AllowUserSettlement: true,
+				})
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(54).WithAsk(&exchange.AskOrder{
+					MarketId: 3, Seller: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("50pear"),
+				}))
+				s.requireAddHold(s.addr2, "10apple", 54)
+
+				s.requireQuarantineOptIn(s.addr1)
+				s.requireQuarantineOptIn(s.addr2)
+			},
+			msg: exchange.MsgFillAsksRequest{
+				Buyer:       s.addr1.String(),
+				MarketId:    3,
+				TotalPrice:  s.coin("50pear"),
+				AskOrderIds: []uint64{54},
+			},
+			fArgs: []expBalances{
+				{
+					addr:     s.addr1,
+					expBal:   []sdk.Coin{s.coin("10apple"), s.zeroCoin("pear")},
+					expHold:  s.zeroCoins("apple", "pear"),
+					expSpend: []sdk.Coin{s.coin("10apple"), s.zeroCoin("pear")},
+				},
+				{
+					addr:     s.addr2,
+					expBal:   []sdk.Coin{s.zeroCoin("apple"), s.coin("50pear")},
+					expHold:  s.zeroCoins("apple", "pear"),
+					expSpend: []sdk.Coin{s.zeroCoin("apple"), s.coin("50pear")},
+				},
+			},
+			expEvents: sdk.Events{
+				s.eventHoldReleased(s.addr2, "10apple"),
+				s.eventCoinSpent(s.addr2, "10apple"),
+				s.eventCoinReceived(s.addr1, "10apple"),
+				s.eventTransfer(s.addr1, s.addr2, "10apple"),
+				s.eventMessageSender(s.addr2),
+				s.eventCoinSpent(s.addr1, "50pear"),
+				s.eventCoinReceived(s.addr2, "50pear"),
+				s.eventTransfer(s.addr2, s.addr1, "50pear"),
+				s.eventMessageSender(s.addr1),
+				s.untypeEvent(&exchange.EventOrderFilled{
+					OrderId: 54, Assets: "10apple", Price: "50pear", MarketId: 3, SettlementId: 1,
+				}),
+				s.markerNavSetEvent("10apple", "50pear", 3),
+			},
+		},
+		{
+			name: "one ask, both quarantined, with markers",
+			setup: func() {
+				s.requireAddFinalizeAndActivateMarker(s.coin("10apple"), s.addr5, "got.it")
+				s.requireAddFinalizeAndActivateMarker(s.coin("50pear"), s.addr5, "got.it")
+				s.requireSetNameRecord("got.it", s.addr5)
+				s.requireSetAttr(s.addr1, "got.it", s.addr5)
+				s.requireSetAttr(s.addr2, "got.it", s.addr5)
+				s.requireFundAccount(s.addr1, "50pear")
+				s.requireFundAccount(s.addr2, "10apple")
+
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3, AcceptingOrders: true, AllowUserSettlement: true,
+				})
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(54).WithAsk(&exchange.AskOrder{
+					MarketId: 3, Seller: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("50pear"),
+				}))
+				s.requireAddHold(s.addr2, "10apple", 54)
+
+				s.requireQuarantineOptIn(s.addr1)
+				s.requireQuarantineOptIn(s.addr2)
+			},
+			msg: exchange.MsgFillAsksRequest{
+				Buyer:       s.addr1.String(),
+				MarketId:    3,
+				TotalPrice:  s.coin("50pear"),
+				AskOrderIds: []uint64{54},
+			},
+			fArgs: []expBalances{
+				{
+					addr:     s.addr1,
+					expBal:   []sdk.Coin{s.coin("10apple"), s.zeroCoin("pear")},
+					expHold:  s.zeroCoins("apple", "pear"),
+					expSpend: []sdk.Coin{s.coin("10apple"), s.zeroCoin("pear")},
+				},
+				{
+					addr:     s.addr2,
+					expBal:   []sdk.Coin{s.zeroCoin("apple"), s.coin("50pear")},
+					expHold:  s.zeroCoins("apple", "pear"),
+					expSpend: []sdk.Coin{s.zeroCoin("apple"), s.coin("50pear")},
+				},
+			},
+			expEvents: sdk.Events{
+				s.eventHoldReleased(s.addr2, "10apple"),
+				s.eventCoinSpent(s.addr2, "10apple"),
+				s.eventCoinReceived(s.addr1, "10apple"),
+				s.eventTransfer(s.addr1, s.addr2, "10apple"),
+				s.eventMessageSender(s.addr2),
+				s.eventCoinSpent(s.addr1, "50pear"),
+				s.eventCoinReceived(s.addr2, "50pear"),
+				s.eventTransfer(s.addr2, s.addr1, "50pear"),
+				s.eventMessageSender(s.addr1),
+				s.untypeEvent(&exchange.EventOrderFilled{
+					OrderId: 54, Assets: "10apple", Price: "50pear", MarketId: 3, SettlementId: 1,
+				}),
+				s.markerNavSetEvent("10apple", "50pear", 3),
+			},
+		},
+		{
+			name: "one ask, buyer sanctioned",
+			setup: func() {
+				s.requireFundAccount(s.addr1, "50pear")
+				s.requireFundAccount(s.addr4, "10apple")
+
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 2, AcceptingOrders: true, AllowUserSettlement: true,
+				})
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(77).WithAsk(&exchange.AskOrder{
+					MarketId: 2, Seller: s.addr4.String(), Assets: s.coin("10apple"), Price: s.coin("50pear"),
+				}))
+				s.requireAddHold(s.addr4, "10apple", 77)
+
+				s.requireSanctionAddress(s.addr1)
+			},
+			msg: exchange.MsgFillAsksRequest{
+				Buyer:       s.addr1.String(),
+				MarketId:    2,
+				TotalPrice:  s.coin("50pear"),
+				AskOrderIds: []uint64{77},
+			},
+			expInErr: []string{invReqErr, "cannot send from " + s.addr1.String(), "account is sanctioned"},
+		},
+		{
+			name: "one ask, seller sanctioned",
+			setup: func() {
+				s.requireFundAccount(s.addr1, "50pear")
+				s.requireFundAccount(s.addr4, "10apple")
+
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 2, AcceptingOrders: true, AllowUserSettlement: true,
+				})
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(77).WithAsk(&exchange.AskOrder{
+					MarketId: 2, Seller: s.addr4.String(), Assets: s.coin("10apple"), Price: s.coin("50pear"),
+				}))
+				s.requireAddHold(s.addr4, "10apple", 77)
+
+				s.requireSanctionAddress(s.addr4)
+			},
+			msg: exchange.MsgFillAsksRequest{
+				Buyer:       s.addr1.String(),
+				MarketId:    2,
+				TotalPrice:  s.coin("50pear"),
+				AskOrderIds: []uint64{77},
+			},
+			expInErr: []string{invReqErr, "cannot send from " + s.addr4.String(), "account is sanctioned"},
+		},
+		{
+			name: "one ask, buyer does not have asset marker's req attrs",
+			setup: func() {
+				s.requireAddFinalizeAndActivateMarker(s.coin("10apple"), s.addr5, "not.gonna.have.it")
+				s.requireSetNameRecord("not.gonna.have.it", s.addr5)
+				s.requireSetAttr(s.addr1, "not.gonna.have.it", s.addr5)
+				s.requireFundAccount(s.addr2, "50pear")
+				s.requireFundAccount(s.addr1, "10apple")
+
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 2, AcceptingOrders: true, AllowUserSettlement: true,
+				})
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(4).WithAsk(&exchange.AskOrder{
+					MarketId: 2, Seller: s.addr1.String(), Assets: s.coin("10apple"), Price: s.coin("50pear"),
+				}))
+				s.requireAddHold(s.addr1, "10apple", 4)
+			},
+			msg: exchange.MsgFillAsksRequest{
+				Buyer:       s.addr2.String(),
+				MarketId:    2,
+				TotalPrice:  s.coin("50pear"),
+				AskOrderIds: []uint64{4},
+			},
+			expInErr: []string{invReqErr,
+				"address " + s.addr2.String() + " does not contain the \"apple\" required attribute: \"not.gonna.have.it\"",
+			},
+		},
+		{
+			name: "one ask, seller does not have price marker's req attrs",
+			setup: func() {
+				s.requireAddFinalizeAndActivateMarker(s.coin("50pear"), s.addr5, "not.gonna.have.it")
+				s.requireSetNameRecord("not.gonna.have.it", s.addr5)
+				s.requireSetAttr(s.addr2, "not.gonna.have.it", s.addr5)
+				s.requireFundAccount(s.addr2, "50pear")
+				s.requireFundAccount(s.addr1, "10apple")
+
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 2, AcceptingOrders: true, AllowUserSettlement: true,
+				})
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(4).WithAsk(&exchange.AskOrder{
+					MarketId: 2, Seller: s.addr1.String(), Assets: s.coin("10apple"), Price: s.coin("50pear"),
+				}))
+				s.requireAddHold(s.addr1, "10apple", 4)
+			},
+			msg: exchange.MsgFillAsksRequest{
+				Buyer:       s.addr2.String(),
+				MarketId:    2,
+				TotalPrice:  s.coin("50pear"),
+				AskOrderIds: []uint64{4},
+			},
+			expInErr: []string{invReqErr,
+				"address " + s.addr1.String() + " does not contain the \"pear\" required attribute: \"not.gonna.have.it\"",
+			},
+		},
+		{
+			name: "market does not have req attr for fee denom",
+			setup: func() {
+				s.requireAddFinalizeAndActivateMarker(s.coin("200fig"), s.addr5, "not.gonna.have.it")
+				s.requireSetNameRecord("not.gonna.have.it", s.addr5)
+				s.requireSetAttr(s.addr1, "not.gonna.have.it", s.addr5)
+				s.requireSetAttr(s.addr2, "not.gonna.have.it", s.addr5)
+				s.requireFundAccount(s.addr2, "50pear,100fig")
+				s.requireFundAccount(s.addr1, "10apple,100fig")
+
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 1, AcceptingOrders: true, AllowUserSettlement: true,
+				})
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(12345).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("10apple"), Price: s.coin("50pear"),
+					SellerSettlementFlatFee: s.coinP("100fig"),
+				}))
+				s.requireAddHold(s.addr1, "10apple,100fig", 12345)
+			},
+			msg: exchange.MsgFillAsksRequest{
+				Buyer:               s.addr2.String(),
+				MarketId:            1,
+				TotalPrice:          s.coin("50pear"),
+				AskOrderIds:         []uint64{12345},
+				BuyerSettlementFees: s.coins("100fig"),
+			},
+			expInErr: []string{invReqErr,
+				"address " + s.marketAddr1.String() + " does not contain the \"fig\" required attribute: \"not.gonna.have.it\"",
+			},
+		},
+		{
+			name: "fee in restricted denom",
+			setup: func() {
+				s.requireSetNameRecord("buyer.gonna.have.it", s.addr5)
+				s.requireSetNameRecord("seller.gonna.have.it", s.addr5)
+				s.requireSetNameRecord("market.gonna.have.it", s.addr5)
+				s.requireSetAttr(s.addr1, "buyer.gonna.have.it", s.addr5)
+				s.requireSetAttr(s.addr2, "seller.gonna.have.it", s.addr5)
+				s.requireSetAttr(s.addr3, "seller.gonna.have.it", s.addr5)
+				s.requireSetAttr(s.marketAddr1, "market.gonna.have.it", s.addr5)
+				s.requireFundAccount(s.addr1, "70pear,100fig")
+				s.requireFundAccount(s.addr2, "10apple,100fig")
+				s.requireFundAccount(s.addr3, "3apple,100fig")
+				s.requireAddFinalizeAndActivateMarker(s.coin("13apple"), s.addr5, "*.gonna.have.it")
+				s.requireAddFinalizeAndActivateMarker(s.coin("70pear"), s.addr5, "*.gonna.have.it")
+				s.requireAddFinalizeAndActivateMarker(s.coin("300fig"), s.addr5, "*.gonna.have.it")
+
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 1, AcceptingOrders: true, AllowUserSettlement: true,
+					FeeCreateAskFlat:          s.coins("200fig"),
+					FeeCreateBidFlat:          s.coins("10fig"),
+					FeeSellerSettlementFlat:   s.coins("5pear,12fig"),
+					FeeSellerSettlementRatios: s.ratios("35pear:2pear"),
+					FeeBuyerSettlementFlat:    s.coins("30fig"),
+					FeeBuyerSettlementRatios:  s.ratios("10pear:1fig"),
+					ReqAttrCreateAsk:          []string{"not.gonna.have.it"},
+					ReqAttrCreateBid:          []string{"*.gonna.have.it"},
+				})
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(12345).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("50pear"),
+					SellerSettlementFlatFee: s.coinP("5pear"), ExternalId: "first order",
+				}))
+				s.requireAddHold(s.addr2, "10apple", 12345)
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(98765).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr3.String(), Assets: s.coin("3apple"), Price: s.coin("20pear"),
+					SellerSettlementFlatFee: s.coinP("12fig"), ExternalId: "second order",
+				}))
+				s.requireAddHold(s.addr3, "3apple,12fig", 98765)
+			},
+			msg: exchange.MsgFillAsksRequest{
+				Buyer:               s.addr1.String(),
+				MarketId:            1,
+				TotalPrice:          s.coin("70pear"),
+				AskOrderIds:         []uint64{12345, 98765},
+				BuyerSettlementFees: s.coins("37fig"),
+				BidOrderCreationFee: s.coinP("10fig"),
+			},
+			expInErr: []string{invReqErr,
+				"error collecting exchange fee 3fig,1pear (based off 49fig,10pear) from market 1",
+				"restricted denom fig cannot be sent to the fee collector",
+			},
+		},
+		{
+			name: "okay: two asks, all req attrs and fees",
+			setup: func() {
+				s.requireSetNameRecord("buyer.gonna.have.it", s.addr5)
+				s.requireSetNameRecord("seller.gonna.have.it", s.addr5)
+				s.requireSetNameRecord("market.gonna.have.it", s.addr5)
+				s.requireSetAttr(s.addr1, "buyer.gonna.have.it", s.addr5)
+				s.requireSetAttr(s.addr2, "seller.gonna.have.it", s.addr5)
+				s.requireSetAttr(s.addr3, "seller.gonna.have.it", s.addr5)
+				s.requireSetAttr(s.marketAddr1, "market.gonna.have.it", s.addr5)
+				s.requireFundAccount(s.addr1, "70pear,100fig")
+				s.requireFundAccount(s.addr2, "10apple,100fig")
+				s.requireFundAccount(s.addr3, "3apple,100fig")
+				s.requireAddFinalizeAndActivateMarker(s.coin("13apple"), s.addr5, "*.gonna.have.it")
+				s.requireAddFinalizeAndActivateCoinMarker(s.coin("70pear"), s.addr5)
+				s.requireAddFinalizeAndActivateCoinMarker(s.coin("300fig"), s.addr5)
+
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 1, AcceptingOrders: true, AllowUserSettlement: true,
+					FeeCreateAskFlat:          s.coins("200fig"),
+					FeeCreateBidFlat:          s.coins("10fig"),
+					FeeSellerSettlementFlat:   s.coins("5pear,12fig"),
+					FeeSellerSettlementRatios: s.ratios("35pear:2pear"),
+					FeeBuyerSettlementFlat:    s.coins("30fig"),
+					FeeBuyerSettlementRatios:  s.ratios("10pear:1fig"),
+					ReqAttrCreateAsk:          []string{"not.gonna.have.it"},
+					ReqAttrCreateBid:          []string{"*.gonna.have.it"},
+				})
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(12345).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("50pear"),
+					SellerSettlementFlatFee: s.coinP("5pear"), ExternalId: "first order",
+				}))
+				s.requireAddHold(s.addr2, "10apple", 12345)
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(98765).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr3.String(), Assets: s.coin("3apple"), Price: s.coin("20pear"),
+					SellerSettlementFlatFee: s.coinP("12fig"), ExternalId: "second order",
+				}))
+				s.requireAddHold(s.addr3, "3apple,12fig", 98765)
+			},
+			msg: exchange.MsgFillAsksRequest{
+				Buyer:               s.addr1.String(),
+				MarketId:            1,
+				TotalPrice:          s.coin("70pear"),
+				AskOrderIds:         []uint64{12345, 98765},
+				BuyerSettlementFees: s.coins("37fig"),
+				BidOrderCreationFee: s.coinP("10fig"),
+			},
+			fArgs: []expBalances{
+				{
+					addr:    s.addr1,
+					expBal:  []sdk.Coin{s.coin("13apple"), s.zeroCoin("pear"), s.coin("53fig")},
+					expHold: s.zeroCoins("apple", "pear", "fig"),
+				},
+				{
+					addr:    s.addr2,
+					expBal:  []sdk.Coin{s.zeroCoin("apple"), s.coin("42pear"), s.coin("100fig")},
+					expHold: s.zeroCoins("apple", "pear", "fig"),
+				},
+				{
+					addr:    s.addr3,
+					expBal:  []sdk.Coin{s.zeroCoin("apple"), s.coin("18pear"), s.coin("88fig")},
+					expHold: s.zeroCoins("apple", "pear", "fig"),
+				},
+				{
+					addr:    s.marketAddr1,
+					expBal:  []sdk.Coin{s.zeroCoin("apple"), s.coin("9pear"), s.coin("55fig")},
+					expHold: s.zeroCoins("apple", "pear", "fig"),
+				},
+				{
+					addr:   s.feeCollectorAddr,
+					expBal: []sdk.Coin{s.zeroCoin("apple"), s.coin("1pear"), s.coin("4fig")},
+				},
+			},
+			expEvents: sdk.Events{
+				// Hold release events.
+				s.eventHoldReleased(s.addr2, "10apple"),
+				s.eventHoldReleased(s.addr3, "3apple,12fig"),
+
+				// Asset transfer events.
+				s.eventCoinSpent(s.addr2, "10apple"),
+				s.eventMessageSender(s.addr2),
+				s.eventCoinSpent(s.addr3, "3apple"),
+				s.eventMessageSender(s.addr3),
+				s.eventCoinReceived(s.addr1, "13apple"),
+				s.eventTransfer(s.addr1, s.addr2, "10apple"),
+				s.eventTransfer(s.addr1, s.addr3, "3apple"),
+
+				// Price transfer events.
+				s.eventCoinSpent(s.addr1, "70pear"),
+				s.eventMessageSender(s.addr1),
+				s.eventCoinReceived(s.addr2, "50pear"),
+				s.eventCoinReceived(s.addr3, "20pear"),
+				s.eventTransfer(s.addr2, s.addr1, "50pear"),
+				s.eventTransfer(s.addr3, s.addr1, "20pear"),
+
+				// Settlement fee transfer events.
+				s.eventCoinSpent(s.addr2, "8pear"),
+				s.eventMessageSender(s.addr2),
+				s.eventCoinSpent(s.addr3, "12fig,2pear"),
+				s.eventMessageSender(s.addr3),
+				s.eventCoinSpent(s.addr1, "37fig"),
+				s.eventMessageSender(s.addr1),
+				s.eventCoinReceived(s.marketAddr1, "49fig,10pear"),
+				s.eventTransfer(s.marketAddr1, s.addr2, "8pear"),
+				s.eventTransfer(s.marketAddr1, s.addr3, "12fig,2pear"),
+				s.eventTransfer(s.marketAddr1, s.addr1, "37fig"),
+
+				// Transfer of exchange portion of settlement fee.
+				s.eventCoinSpent(s.marketAddr1, "3fig,1pear"),
+				s.eventCoinReceived(s.feeCollectorAddr, "3fig,1pear"),
+				s.eventTransfer(s.feeCollectorAddr, s.marketAddr1, "3fig,1pear"),
+				s.eventMessageSender(s.marketAddr1),
+
+				// Order filled events.
+				s.untypeEvent(&exchange.EventOrderFilled{
+					OrderId:      12345,
+					Assets:       "10apple",
+					Price:        "50pear",
+					Fees:         "8pear",
+					MarketId:     1,
+					ExternalId:   "first order",
+					SettlementId: 1,
+				}),
+				s.untypeEvent(&exchange.EventOrderFilled{
+					OrderId:      98765,
+					Assets:       "3apple",
+					Price:        "20pear",
+					Fees:         "12fig,2pear",
+					MarketId:     1,
+					ExternalId:   "second order",
+					SettlementId: 1,
+				}),
+
+				// The net-asset-value event.
+				s.markerNavSetEvent("13apple", "70pear", 1),
+
+				// Order creation fee events.
+				s.eventCoinSpent(s.addr1, "10fig"),
+				s.eventCoinReceived(s.marketAddr1, "10fig"),
+				s.eventTransfer(s.marketAddr1, s.addr1, "10fig"),
+				s.eventMessageSender(s.addr1),
+
+				// Transfer of exchange portion of order creation fees.
+				s.eventCoinSpent(s.marketAddr1, "1fig"),
+				s.eventCoinReceived(s.feeCollectorAddr, "1fig"),
+				s.eventTransfer(s.feeCollectorAddr, s.marketAddr1, "1fig"),
+				s.eventMessageSender(s.marketAddr1),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runMsgServerTestCase(s, testDef, tc)
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_MarketSettle() {
+	type followupArgs struct {
+		expBals     []expBalances
+		partialLeft *exchange.Order
+	}
+	testDef := msgServerTestDef[exchange.MsgMarketSettleRequest, exchange.MsgMarketSettleResponse, followupArgs]{
+		endpointName: "MarketSettle",
+		endpoint:     keeper.NewMsgServer(s.k).MarketSettle,
+		expResp:      &exchange.MsgMarketSettleResponse{},
+		followup: func(msg *exchange.MsgMarketSettleRequest, fArgs followupArgs) {
+			for _, orderID := range msg.AskOrderIds {
+				var expOrder *exchange.Order
+				if fArgs.partialLeft != nil && fArgs.partialLeft.OrderId == orderID {
+					expOrder = fArgs.partialLeft
+				}
+				order, err := s.k.GetOrder(s.ctx, orderID)
+				s.Assert().NoError(err, "GetOrder(%d) error", orderID)
+				s.Assert().Equal(expOrder, order, "GetOrder(%d) order", orderID)
+			}
+
+			for _, eb := range fArgs.expBals {
+				s.checkBalances(eb)
+			}
+		},
+	}
+
+	tests := []msgServerTestCase[exchange.MsgMarketSettleRequest, followupArgs]{
+		{
+			name: "admin does not have settle permission",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId:     1,
+					AccessGrants: []exchange.AccessGrant{s.agCanAllBut(s.addr5, exchange.Permission_settle)},
+				})
+			},
+			msg: exchange.MsgMarketSettleRequest{
+				Admin:         s.addr5.String(),
+				MarketId:      1,
+				AskOrderIds:   []uint64{1},
+				BidOrderIds:   []uint64{2},
+				ExpectPartial: false,
+			},
+			expInErr: []string{invReqErr,
+				"account " + s.addr5.String() + " does not have permission to settle orders for market 1"},
+		},
+		{
+			name: "an address is sanctioned",
+			setup: func() {
+				s.requireFundAccount(s.addr1, "7apple")
+				s.requireFundAccount(s.addr2, "100pear")
+				s.requireFundAccount(s.addr3, "11apple")
+				s.requireFundAccount(s.addr4, "85pear")
+
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 1, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_settle)},
+				})
+
+				store := s.getStore()
+				s.requireSetOrderInStore(store, exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("7apple"), Price: s.coin("75pear"),
+				}))
+				s.requireAddHold(s.addr1, "7apple", 1)
+				s.requireSetOrderInStore(store, exchange.NewOrder(22).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("100pear"),
+				}))
+				s.requireAddHold(s.addr2, "100pear", 22)
+				s.requireSetOrderInStore(store, exchange.NewOrder(333).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr3.String(), Assets: s.coin("11apple"), Price: s.coin("105pear"),
+				}))
+				s.requireAddHold(s.addr3, "11apple", 333)
+				s.requireSetOrderInStore(store, exchange.NewOrder(4444).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr4.String(), Assets: s.coin("8apple"), Price: s.coin("85pear"),
+				}))
+				s.requireAddHold(s.addr4, "85pear", 4444)
+
+				s.requireSanctionAddress(s.addr2)
+			},
+			msg: exchange.MsgMarketSettleRequest{
+				Admin:       s.addr5.String(),
+				MarketId:    1,
+				AskOrderIds: []uint64{333, 1},
+				BidOrderIds: []uint64{22, 4444},
+			},
+			expInErr: []string{invReqErr, "cannot send from " + s.addr2.String(), "account is sanctioned"},
+		},
+		{
+			name: "a buyer does not have asset req attr",
+			setup: func() {
+				s.requireAddFinalizeAndActivateMarker(s.coin("18apple"), s.addr5, "*.have.it")
+				s.requireSetNameRecord("buyer.have.it", s.addr5)
+				s.requireSetNameRecord("seller.have.it", s.addr5)
+				s.requireSetNameRecord("doesnot-have.it", s.addr5)
+				s.requireSetAttr(s.addr1, "seller.have.it", s.addr5)
+				s.requireSetAttr(s.addr2, "buyer.have.it", s.addr5)
+				s.requireSetAttr(s.addr3, "seller.have.it", s.addr5)
+				s.requireSetAttr(s.addr4, "doesnot-have.it", s.addr5)
+				s.requireFundAccount(s.addr1, "7apple")
+				s.requireFundAccount(s.addr2, "100pear")
+				s.requireFundAccount(s.addr3, "11apple")
+				s.requireFundAccount(s.addr4, "85pear")
+
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 1, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.adminAddr, exchange.Permission_settle)},
+				})
+
+				store := s.getStore()
+				s.requireSetOrderInStore(store, exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("7apple"), Price: s.coin("75pear"),
+				}))
+				s.requireAddHold(s.addr1, "7apple", 1)
+				s.requireSetOrderInStore(store, exchange.NewOrder(22).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("100pear"),
+				}))
+				s.requireAddHold(s.addr2, "100pear", 22)
+				s.requireSetOrderInStore(store, exchange.NewOrder(333).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr3.String(), Assets: s.coin("11apple"), Price: s.coin("105pear"),
+				}))
+				s.requireAddHold(s.addr3, "11apple", 333)
+				s.requireSetOrderInStore(store, exchange.NewOrder(4444).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr4.String(), Assets: s.coin("8apple"), Price: s.coin("85pear"),
+				}))
+				s.requireAddHold(s.addr4, "85pear", 4444)
+			},
+			msg: exchange.MsgMarketSettleRequest{
+				Admin:       s.adminAddr.String(),
+				MarketId:    1,
+				AskOrderIds: []uint64{333, 1},
+				BidOrderIds: []uint64{22, 4444},
+			},
+			expInErr: []string{invReqErr,
+				"address " + s.addr4.String() + " does not contain the \"apple\" required attribute: \"*.have.it\""},
+		},
+		{
+			name: "a buyer does not have asset req attr but admin has transfer",
+			setup: func() {
+				s.requireAddFinalizeAndActivateMarker(s.coin("18apple"), s.addr5, "*.have.it")
+				s.requireSetNameRecord("buyer.have.it", s.addr5)
+				s.requireSetNameRecord("seller.have.it", s.addr5)
+				s.requireSetNameRecord("doesnot-have.it", s.addr5)
+				s.requireSetAttr(s.addr1, "seller.have.it", s.addr5)
+				s.requireSetAttr(s.addr2, "buyer.have.it", s.addr5)
+				s.requireSetAttr(s.addr3, "seller.have.it", s.addr5)
+				s.requireSetAttr(s.addr4, "doesnot-have.it", s.addr5)
+				s.requireFundAccount(s.addr1, "7apple")
+				s.requireFundAccount(s.addr2, "100pear")
+				s.requireFundAccount(s.addr3, "11apple")
+				s.requireFundAccount(s.addr4, "85pear")
+
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 1, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_settle)},
+				})
+
+				store := s.getStore()
+				s.requireSetOrderInStore(store, exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("7apple"), Price: s.coin("75pear"),
+				}))
+				s.requireAddHold(s.addr1, "7apple", 1)
+				s.requireSetOrderInStore(store, exchange.NewOrder(22).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("100pear"),
+				}))
+				s.requireAddHold(s.addr2, "100pear", 22)
+				s.requireSetOrderInStore(store, exchange.NewOrder(333).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr3.String(), Assets: s.coin("11apple"), Price: s.coin("105pear"),
+				}))
+				s.requireAddHold(s.addr3, "11apple", 333)
+				s.requireSetOrderInStore(store, exchange.NewOrder(4444).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr4.String(), Assets: s.coin("8apple"), Price: s.coin("85pear"),
+				}))
+				s.requireAddHold(s.addr4, "85pear", 4444)
+			},
+			msg: exchange.MsgMarketSettleRequest{
+				Admin:       s.addr5.String(),
+				MarketId:    1,
+				AskOrderIds: []uint64{333, 1},
+				BidOrderIds: []uint64{22, 4444},
+			},
+			fArgs: followupArgs{
+				expBals: []expBalances{
+					{
+						addr:    s.addr1,
+						expBal:  []sdk.Coin{s.zeroCoin("apple"), s.coin("76pear"), s.zeroCoin("fig")},
+						expHold: s.zeroCoins("apple", "pear", "fig"),
+					},
+					{
+						addr:    s.addr2,
+						expBal:  []sdk.Coin{s.coin("10apple"), s.zeroCoin("pear"), s.zeroCoin("fig")},
+						expHold: s.zeroCoins("apple", "pear", "fig"),
+					},
+					{
+						addr:    s.addr3,
+						expBal:  []sdk.Coin{s.zeroCoin("apple"), s.coin("109pear"), s.zeroCoin("fig")},
+						expHold: s.zeroCoins("apple", "pear", "fig"),
+					},
+					{
+						addr:    s.addr4,
+						expBal:  []sdk.Coin{s.coin("8apple"), s.zeroCoin("pear"), s.zeroCoin("fig")},
+						expHold: s.zeroCoins("apple", "pear", "fig"),
+					},
+					{
+						addr:   s.marketAddr1,
+						expBal: s.zeroCoins("apple", "pear", "fig"),
+					},
+					{
+						addr:   s.feeCollectorAddr,
+						expBal: s.zeroCoins("apple", "pear", "fig"),
+					},
+				},
+			},
+			expEvents: sdk.Events{
+				// Hold releases (0-3)
+				s.eventHoldReleased(s.addr3, "11apple"),
+				s.eventHoldReleased(s.addr1, "7apple"),
+				s.eventHoldReleased(s.addr2, "100pear"),
+				s.eventHoldReleased(s.addr4, "85pear"),
+
+				// Asset transfers (4-9, 10-13)
+				s.eventCoinSpent(s.addr3, "11apple"),
+				s.eventMessageSender(s.addr3),
+				s.eventCoinReceived(s.addr2, "10apple"),
+				s.eventCoinReceived(s.addr4, "1apple"),
+				s.eventTransfer(s.addr2, s.addr3, "10apple"),
+				s.eventTransfer(s.addr4, s.addr3, "1apple"),
+
+				s.eventCoinSpent(s.addr1, "7apple"),
+				s.eventCoinReceived(s.addr4, "7apple"),
+				s.eventTransfer(s.addr4, s.addr1, "7apple"),
+				s.eventMessageSender(s.addr1),
+
+				// Price transfers (14-17, 18-23)
+				s.eventCoinSpent(s.addr2, "100pear"),
+				s.eventCoinReceived(s.addr3, "100pear"),
+				s.eventTransfer(s.addr3, s.addr2, "100pear"),
+				s.eventMessageSender(s.addr2),
+
+				s.eventCoinSpent(s.addr4, "85pear"),
+				s.eventMessageSender(s.addr4),
+				s.eventCoinReceived(s.addr3, "9pear"),
+				s.eventCoinReceived(s.addr1, "76pear"),
+				s.eventTransfer(s.addr3, s.addr4, "9pear"),
+				s.eventTransfer(s.addr1, s.addr4, "76pear"),
+
+				// Orders filled (24-27)
+				s.untypeEvent(&exchange.EventOrderFilled{
+					OrderId: 333, Assets: "11apple", Price: "109pear", MarketId: 1, SettlementId: 1,
+				}),
+				s.untypeEvent(&exchange.EventOrderFilled{
+					OrderId: 1, Assets: "7apple", Price: "76pear", MarketId: 1, SettlementId: 1,
+				}),
+				s.untypeEvent(&exchange.EventOrderFilled{
+					OrderId: 22, Assets: "10apple", Price: "100pear", MarketId: 1, SettlementId: 1,
+				}),
+				s.untypeEvent(&exchange.EventOrderFilled{
+					OrderId: 4444, Assets: "8apple", Price: "85pear", MarketId: 1, SettlementId: 1,
+				}),
+
+				// The net-asset-value event (28).
+				s.markerNavSetEvent("18apple", "185pear", 1),
+			},
+		},
+		{
+			name: "a seller does not have price req attr",
+			setup: func() {
+				s.requireAddFinalizeAndActivateMarker(s.coin("185pear"), s.addr5, "*.have.it")
+				s.requireSetNameRecord("buyer.have.it", s.addr5)
+				s.requireSetNameRecord("seller.have.it", s.addr5)
+				s.requireSetNameRecord("doesnot-have.it", s.addr5)
+				s.requireSetAttr(s.addr1, "doesnot-have.it", s.addr5)
+				s.requireSetAttr(s.addr2, "buyer.have.it", s.addr5)
+				s.requireSetAttr(s.addr3, "seller.have.it", s.addr5)
+				s.requireSetAttr(s.addr4, "buyer.have.it", s.addr5)
+				s.requireFundAccount(s.addr1, "7apple")
+				s.requireFundAccount(s.addr2, "100pear")
+				s.requireFundAccount(s.addr3, "11apple")
+				s.requireFundAccount(s.addr4, "85pear")
+
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 1, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.adminAddr, exchange.Permission_settle)},
+				})
+
+				store := s.getStore()
+				s.requireSetOrderInStore(store, exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("7apple"), Price: s.coin("75pear"),
+				}))
+				s.requireAddHold(s.addr1, "7apple", 1)
+				s.requireSetOrderInStore(store, exchange.NewOrder(22).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("100pear"),
+				}))
+				s.requireAddHold(s.addr2, "100pear", 22)
+				s.requireSetOrderInStore(store, exchange.NewOrder(333).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr3.String(), Assets: s.coin("11apple"), Price: s.coin("105pear"),
+				}))
+				s.requireAddHold(s.addr3, "11apple", 333)
+				s.requireSetOrderInStore(store, exchange.NewOrder(4444).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr4.String(), Assets: s.coin("8apple"), Price: s.coin("85pear"),
+				}))
+				s.requireAddHold(s.addr4, "85pear", 4444)
+			},
+			msg: exchange.MsgMarketSettleRequest{
+				Admin:       s.adminAddr.String(),
+				MarketId:    1,
+				AskOrderIds: []uint64{333, 1},
+				BidOrderIds: []uint64{22, 4444},
+			},
+			expInErr: []string{invReqErr,
+				"address " + s.addr1.String() + " does not contain the \"pear\" required attribute: \"*.have.it\""},
+		},
+		{
+			name: "a seller does not have price req attr but admin has transfer",
+			setup: func() {
+				s.requireAddFinalizeAndActivateMarker(s.coin("185pear"), s.addr5, "*.have.it")
+				s.requireSetNameRecord("buyer.have.it", s.addr5)
+				s.requireSetNameRecord("seller.have.it", s.addr5)
+				s.requireSetNameRecord("doesnot-have.it", s.addr5)
+				s.requireSetAttr(s.addr1, "doesnot-have.it", s.addr5)
+				s.requireSetAttr(s.addr2, "buyer.have.it", s.addr5)
+				s.requireSetAttr(s.addr3, "seller.have.it", s.addr5)
+				s.requireSetAttr(s.addr4, "buyer.have.it", s.addr5)
+				s.requireFundAccount(s.addr1, "7apple")
+				s.requireFundAccount(s.addr2, "100pear")
+				s.requireFundAccount(s.addr3, "11apple")
+				s.requireFundAccount(s.addr4, "85pear")
+
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 1, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_settle)},
+				})
+
+				store := s.getStore()
+				s.requireSetOrderInStore(store, exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("7apple"), Price: s.coin("75pear"),
+				}))
+				s.requireAddHold(s.addr1, "7apple", 1)
+				s.requireSetOrderInStore(store, exchange.NewOrder(22).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("100pear"),
+				}))
+				s.requireAddHold(s.addr2, "100pear", 22)
+				s.requireSetOrderInStore(store, exchange.NewOrder(333).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr3.String(), Assets: s.coin("11apple"), Price: s.coin("105pear"),
+				}))
+				s.requireAddHold(s.addr3, "11apple", 333)
+				s.requireSetOrderInStore(store, exchange.NewOrder(4444).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr4.String(), Assets: s.coin("8apple"), Price: s.coin("85pear"),
+				}))
+				s.requireAddHold(s.addr4, "85pear", 4444)
+			},
+			msg: exchange.MsgMarketSettleRequest{
+				Admin:       s.addr5.String(),
+				MarketId:    1,
+				AskOrderIds: []uint64{333, 1},
+				BidOrderIds: []uint64{22, 4444},
+			},
+			fArgs: followupArgs{
+				expBals: []expBalances{
+					{
+						addr:    s.addr1,
+						expBal:  []sdk.Coin{s.zeroCoin("apple"), s.coin("76pear"), s.zeroCoin("fig")},
+						expHold: s.zeroCoins("apple", "pear", "fig"),
+					},
+					{
+						addr:    s.addr2,
+						expBal:  []sdk.Coin{s.coin("10apple"), s.zeroCoin("pear"), s.zeroCoin("fig")},
+						expHold: s.zeroCoins("apple", "pear", "fig"),
+					},
+					{
+						addr:    s.addr3,
+						expBal:  []sdk.Coin{s.zeroCoin("apple"), s.coin("109pear"), s.zeroCoin("fig")},
+						expHold: s.zeroCoins("apple", "pear", "fig"),
+					},
+					{
+						addr:    s.addr4,
+						expBal:  []sdk.Coin{s.coin("8apple"), s.zeroCoin("pear"), s.zeroCoin("fig")},
+						expHold: s.zeroCoins("apple", "pear", "fig"),
+					},
+					{
+						addr:   s.marketAddr1,
+						expBal: s.zeroCoins("apple", "pear", "fig"),
+					},
+					{
+						addr:   s.feeCollectorAddr,
+						expBal: s.zeroCoins("apple", "pear", "fig"),
+					},
+				},
+			},
+			expEvents: sdk.Events{
+				// Hold releases (0-3)
+				s.eventHoldReleased(s.addr3, "11apple"),
+				s.eventHoldReleased(s.addr1, "7apple"),
+				s.eventHoldReleased(s.addr2, "100pear"),
+				s.eventHoldReleased(s.addr4, "85pear"),
+
+				// Asset transfers (4-9, 10-13)
+				s.eventCoinSpent(s.addr3, "11apple"),
+				s.eventMessageSender(s.addr3),
+				s.eventCoinReceived(s.addr2, "10apple"),
+				s.eventCoinReceived(s.addr4, "1apple"),
+				s.eventTransfer(s.addr2, s.addr3, "10apple"),
+				s.eventTransfer(s.addr4, s.addr3, "1apple"),
+
+				s.eventCoinSpent(s.addr1, "7apple"),
+				s.eventCoinReceived(s.addr4, "7apple"),
+				s.eventTransfer(s.addr4, s.addr1, "7apple"),
+				s.eventMessageSender(s.addr1),
+
+				// Price transfers (14-17, 18-23)
+				s.eventCoinSpent(s.addr2, "100pear"),
+				s.eventCoinReceived(s.addr3, "100pear"),
+				s.eventTransfer(s.addr3, s.addr2, "100pear"),
+				s.eventMessageSender(s.addr2),
+
+				s.eventCoinSpent(s.addr4, "85pear"),
+				s.eventMessageSender(s.addr4),
+				s.eventCoinReceived(s.addr3, "9pear"),
+				s.eventCoinReceived(s.addr1, "76pear"),
+				s.eventTransfer(s.addr3, s.addr4, "9pear"),
+				s.eventTransfer(s.addr1, s.addr4, "76pear"),
+
+				// Orders filled (24-27)
+				s.untypeEvent(&exchange.EventOrderFilled{
+					OrderId: 333, Assets: "11apple", Price: "109pear", MarketId: 1, SettlementId: 1,
+				}),
+				s.untypeEvent(&exchange.EventOrderFilled{
+					OrderId: 1, Assets: "7apple", Price: "76pear", MarketId: 1, SettlementId: 1,
+				}),
+				s.untypeEvent(&exchange.EventOrderFilled{
+					OrderId: 22, Assets: "10apple", Price: "100pear", MarketId: 1, SettlementId: 1,
+				}),
+				s.untypeEvent(&exchange.EventOrderFilled{
+					OrderId: 4444, Assets: "8apple", Price: "85pear", MarketId: 1, SettlementId: 1,
+				}),
+
+				// The net-asset-value event (28).
+				s.markerNavSetEvent("18apple", "185pear", 1),
+			},
+		},
+		{
+			name: "all addresses quarantined",
+			setup: func() {
+				s.requireFundAccount(s.addr1, "7apple")
+				s.requireFundAccount(s.addr2, "100pear")
+				s.requireFundAccount(s.addr3, "11apple")
+				s.requireFundAccount(s.addr4, "85pear")
+
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 1, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_settle)},
+				})
+
+				store := s.getStore()
+				s.requireSetOrderInStore(store, exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("7apple"), Price: s.coin("75pear"),
+				}))
+				s.requireAddHold(s.addr1, "7apple", 1)
+				s.requireSetOrderInStore(store, exchange.NewOrder(22).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("100pear"),
+				}))
+				s.requireAddHold(s.addr2, "100pear", 22)
+				s.requireSetOrderInStore(store, exchange.NewOrder(333).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr3.String(), Assets: s.coin("11apple"), Price: s.coin("105pear"),
+				}))
+				s.requireAddHold(s.addr3, "11apple", 333)
+				s.requireSetOrderInStore(store, exchange.NewOrder(4444).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr4.String(), Assets: s.coin("8apple"), Price: s.coin("85pear"),
+				}))
+				s.requireAddHold(s.addr4, "85pear", 4444)
+
+				s.requireQuarantineOptIn(s.addr1)
+				s.requireQuarantineOptIn(s.addr2)
+				s.requireQuarantineOptIn(s.addr3)
+				s.requireQuarantineOptIn(s.addr4)
+				s.requireQuarantineOptIn(s.addr5)
+			},
+			msg: exchange.MsgMarketSettleRequest{
+				Admin:       s.addr5.String(),
+				MarketId:    1,
+				AskOrderIds: []uint64{1, 333},
+				BidOrderIds: []uint64{4444, 22},
+			},
+			fArgs: followupArgs{
+				expBals: []expBalances{
+					{
+						addr:    s.addr1,
+						expBal:  []sdk.Coin{s.zeroCoin("apple"), s.coin("77pear")},
+						expHold: s.zeroCoins("apple", "pear"),
+					},
+					{
+						addr:    s.addr2,
+						expBal:  []sdk.Coin{s.coin("10apple"), s.zeroCoin("pear")},
+						expHold: s.zeroCoins("apple", "pear"),
+					},
+					{
+						addr:    s.addr3,
+						expBal:  []sdk.Coin{s.zeroCoin("apple"), s.coin("108pear")},
+						expHold: s.zeroCoins("apple", "pear"),
+					},
+					{
+						addr:    s.addr4,
+						expBal:  []sdk.Coin{s.coin("8apple"), s.zeroCoin("pear")},
+						expHold: s.zeroCoins("apple", "pear"),
+					},
+				},
+			},
+			expEvents: sdk.Events{
+				// Hold releases
+				s.eventHoldReleased(s.addr1, "7apple"),
+				s.eventHoldReleased(s.addr3, "11apple"),
+				s.eventHoldReleased(s.addr4, "85pear"),
+				s.eventHoldReleased(s.addr2, "100pear"),
+
+				// Asset transfers
+				s.eventCoinSpent(s.addr1, "7apple"),
+				s.eventCoinReceived(s.addr4, "7apple"),
+				s.eventTransfer(s.addr4, s.addr1, "7apple"),
+				s.eventMessageSender(s.addr1),
+
+				s.eventCoinSpent(s.addr3, "11apple"),
+				s.eventMessageSender(s.addr3),
+				s.eventCoinReceived(s.addr4, "1apple"),
+				s.eventCoinReceived(s.addr2, "10apple"),
+				s.eventTransfer(s.addr4, s.addr3, "1apple"),
+				s.eventTransfer(s.addr2, s.addr3, "10apple"),
+
+				// Price transfers
+				s.eventCoinSpent(s.addr4, "85pear"),
+				s.eventMessageSender(s.addr4),
+				s.eventCoinReceived(s.addr1, "75pear"),
+				s.eventCoinReceived(s.addr3, "10pear"),
+				s.eventTransfer(s.addr1, s.addr4, "75pear"),
+				s.eventTransfer(s.addr3, s.addr4, "10pear"),
+
+				s.eventCoinSpent(s.addr2, "100pear"),
+				s.eventMessageSender(s.addr2),
+				s.eventCoinReceived(s.addr3, "98pear"),
+				s.eventCoinReceived(s.addr1, "2pear"),
+				s.eventTransfer(s.addr3, s.addr2, "98pear"),
+				s.eventTransfer(s.addr1, s.addr2, "2pear"),
+
+				// Orders filled
+				s.untypeEvent(&exchange.EventOrderFilled{
+					OrderId: 1, Assets: "7apple", Price: "77pear", MarketId: 1, SettlementId: 1,
+				}),
+				s.untypeEvent(&exchange.EventOrderFilled{
+					OrderId: 333, Assets: "11apple", Price: "108pear", MarketId: 1, SettlementId: 1,
+				}),
+				s.untypeEvent(&exchange.EventOrderFilled{
+					OrderId: 4444, Assets: "8apple", Price: "85pear", MarketId: 1, SettlementId: 1,
+				}),
+				s.untypeEvent(&exchange.EventOrderFilled{
+					OrderId: 22, Assets: "10apple", Price: "100pear", MarketId: 1, SettlementId: 1,
+				}),
+
+				// The net-asset-value event.
+				s.markerNavSetEvent("18apple", "185pear", 1),
+			},
+		},
+		{
+			name: "one ask, one bid, partial ask",
+			setup: func() {
+				s.requireFundAccount(s.addr1, "10apple")
+				s.requireFundAccount(s.addr2, "75pear")
+
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_settle)},
+				})
+
+				store := s.getStore()
+				s.requireSetOrderInStore(store, exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 3, Seller: s.addr1.String(), Assets: s.coin("10apple"), Price: s.coin("100pear"),
+					AllowPartial: true,
+				}))
+				s.requireAddHold(s.addr1, "10apple", 1)
+				s.requireSetOrderInStore(store, exchange.NewOrder(22).WithBid(&exchange.BidOrder{
+					MarketId: 3, Buyer: s.addr2.String(), Assets: s.coin("7apple"), Price: s.coin("75pear"),
+				}))
+				s.requireAddHold(s.addr2, "75pear", 22)
+			},
+			msg: exchange.MsgMarketSettleRequest{
+				Admin:         s.addr5.String(),
+				MarketId:      3,
+				AskOrderIds:   []uint64{1},
+				BidOrderIds:   []uint64{22},
+				ExpectPartial: true,
+			},
+			fArgs: followupArgs{
+				expBals: []expBalances{
+					{
+						addr:    s.addr1,
+						expBal:  s.coins("3apple,75pear"),
+						expHold: []sdk.Coin{s.coin("3apple"), s.zeroCoin("pear")},
+					},
+					{
+						addr:    s.addr2,
+						expBal:  []sdk.Coin{s.coin("7apple"), s.zeroCoin("pear")},
+						expHold: s.zeroCoins("apple", "pear"),
+					},
+				},
+				partialLeft: exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 3, Seller: s.addr1.String(), Assets: s.coin("3apple"), Price: s.coin("30pear"),
+					AllowPartial: true,
+				}),
+			},
+			expEvents: sdk.Events{
+				// Hold releases
+				s.eventHoldReleased(s.addr2, "75pear"),
+				s.eventHoldReleased(s.addr1, "7apple"),
+
+				// Asset transfer
+				s.eventCoinSpent(s.addr1, "7apple"),
+				s.eventCoinReceived(s.addr2, "7apple"),
+				s.eventTransfer(s.addr2, s.addr1, "7apple"),
+				s.eventMessageSender(s.addr1),
+
+				// Price transfer
+				s.eventCoinSpent(s.addr2, "75pear"),
+				s.eventCoinReceived(s.addr1, "75pear"),
+				s.eventTransfer(s.addr1, s.addr2, "75pear"),
+				s.eventMessageSender(s.addr2),
+
+				// Orders filled
+				s.untypeEvent(&exchange.EventOrderFilled{
+					OrderId: 22, Assets: "7apple", Price: "75pear", MarketId: 3, SettlementId: 1,
+				}),
+				// Partial fill
+				s.untypeEvent(&exchange.EventOrderPartiallyFilled{
+					OrderId: 1, Assets: "7apple", Price: "75pear", MarketId: 3, SettlementId: 1,
+				}),
+				s.untypeEvent(&exchange.EventOrderPartialFill{
+					OrderId: 1, OrderType: "ask", MarketId: 3, Owner: s.addr1.String(),
+					FilledAssets: "7apple", FilledPrice: "75pear", RemainingAssets: "3apple", RemainingPrice: "30pear",
+					Participants: []*exchange.FillParticipant{
+						{OrderId: 22, OrderType: "bid", Owner: s.addr2.String(), Assets: "7apple", Price: "75pear"},
+						{OrderId: 1, OrderType: "ask", Owner: s.addr1.String(), Assets: "7apple", Price: "75pear", Partial: true},
+					},
+				}),
+
+				// The net-asset-value event.
+				s.markerNavSetEvent("7apple", "75pear", 3),
+			},
+		},
+		{
+			name: "one ask, one bid, partial bid",
+			setup: func() {
+				s.requireFundAccount(s.addr1, "7apple")
+				s.requireFundAccount(s.addr2, "100pear")
+
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_settle)},
+				})
+
+				store := s.getStore()
+				s.requireSetOrderInStore(store, exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 3, Seller: s.addr1.String(), Assets: s.coin("7apple"), Price: s.coin("65pear"),
+				}))
+				s.requireAddHold(s.addr1, "7apple", 1)
+				s.requireSetOrderInStore(store, exchange.NewOrder(22).WithBid(&exchange.BidOrder{
+					MarketId: 3, Buyer: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("100pear"),
+					AllowPartial: true,
+				}))
+				s.requireAddHold(s.addr2, "100pear", 22)
+			},
+			msg: exchange.MsgMarketSettleRequest{
+				Admin:         s.addr5.String(),
+				MarketId:      3,
+				AskOrderIds:   []uint64{1},
+				BidOrderIds:   []uint64{22},
+				ExpectPartial: true,
+			},
+			fArgs: followupArgs{
+				expBals: []expBalances{
+					{
+						addr:    s.addr1,
+						expBal:  []sdk.Coin{s.zeroCoin("apple"), s.coin("70pear")},
+						expHold: s.zeroCoins("apple", "pear"),
+					},
+					{
+						addr:    s.addr2,
+						expBal:  []sdk.Coin{s.coin("7apple"), s.coin("30pear")},
+						expHold: []sdk.Coin{s.zeroCoin("apple"), s.coin("30pear")},
+					},
+				},
+				partialLeft: exchange.NewOrder(22).WithBid(&exchange.BidOrder{
+					MarketId: 3, Buyer: s.addr2.String(), Assets: s.coin("3apple"), Price: s.coin("30pear"),
+					AllowPartial: true,
+				}),
+			},
+			expEvents: sdk.Events{
+				// Hold releases
+				s.eventHoldReleased(s.addr1, "7apple"),
+				s.eventHoldReleased(s.addr2, "70pear"),
+
+				// Asset transfer
+				s.eventCoinSpent(s.addr1, "7apple"),
+				s.eventCoinReceived(s.addr2, "7apple"),
+				s.eventTransfer(s.addr2, s.addr1, "7apple"),
+				s.eventMessageSender(s.addr1),
+
+				// Price transfer
+				s.eventCoinSpent(s.addr2, "70pear"),
+				s.eventCoinReceived(s.addr1, "70pear"),
+				s.eventTransfer(s.addr1, s.addr2, "70pear"),
+				s.eventMessageSender(s.addr2),
+
+				// Orders filled
+				s.untypeEvent(&exchange.EventOrderFilled{
+					OrderId: 1, Assets: "7apple", Price: "70pear", MarketId: 3, SettlementId: 1,
+				}),
+				// Partial fill
+				s.untypeEvent(&exchange.EventOrderPartiallyFilled{
+					OrderId: 22, Assets: "7apple", Price: "70pear", MarketId: 3, SettlementId: 1,
+				}),
+				s.untypeEvent(&exchange.EventOrderPartialFill{
+					OrderId: 22, OrderType: "bid", MarketId: 3, Owner: s.addr2.String(),
+					FilledAssets: "7apple", FilledPrice: "70pear", RemainingAssets: "3apple", RemainingPrice: "30pear",
+					Participants: []*exchange.FillParticipant{
+						{OrderId: 1, OrderType: "ask", Owner: s.addr1.String(), Assets: "7apple", Price: "70pear"},
+						{OrderId: 22, OrderType: "bid", Owner: s.addr2.String(), Assets: "7apple", Price: "70pear", Partial: true},
+					},
+				}),
+
+				// The net-asset-value event.
+				s.markerNavSetEvent("7apple", "70pear", 3),
+			},
+		},
+		{
+			name: "fee in restricted denom",
+			setup: func() {
+				s.requireAddFinalizeAndActivateMarker(s.coin("185pear"), s.addr5, "*.have.it")
+				s.requireAddFinalizeAndActivateMarker(s.coin("18apple"), s.addr5, "*.have.it")
+				s.requireSetNameRecord("buyer.have.it", s.addr5)
+				s.requireSetNameRecord("seller.have.it", s.addr5)
+				s.requireSetNameRecord("market.have.it", s.addr5)
+				s.requireSetAttr(s.addr1, "seller.have.it", s.addr5)
+				s.requireSetAttr(s.addr2, "buyer.have.it", s.addr5)
+				s.requireSetAttr(s.addr3, "seller.have.it", s.addr5)
+				s.requireSetAttr(s.addr4, "buyer.have.it", s.addr5)
+				s.requireSetAttr(s.marketAddr2, "market.have.it", s.addr5)
+				s.requireFundAccount(s.addr1, "20apple,100pear,100fig")
+				s.requireFundAccount(s.addr2, "20apple,100pear,100fig")
+				s.requireFundAccount(s.addr3, "20apple,100pear,100fig")
+				s.requireFundAccount(s.addr4, "20apple,100pear,100fig")
+
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 2, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_settle)},
+					FeeSellerSettlementRatios: s.ratios("10pear:1pear"),
+				})
+
+				store := s.getStore()
+				s.requireSetOrderInStore(store, exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 2, Seller: s.addr1.String(), Assets: s.coin("7apple"), Price: s.coin("75pear"),
+					SellerSettlementFlatFee: s.coinP("10fig"),
+				}))
+				s.requireAddHold(s.addr1, "7apple,10fig", 1)
+				s.requireSetOrderInStore(store, exchange.NewOrder(22).WithBid(&exchange.BidOrder{
+					MarketId: 2, Buyer: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("100pear"),
+					BuyerSettlementFees: s.coins("20fig"),
+				}))
+				s.requireAddHold(s.addr2, "100pear,20fig", 22)
+				s.requireSetOrderInStore(store, exchange.NewOrder(333).WithAsk(&exchange.AskOrder{
+					MarketId: 2, Seller: s.addr3.String(), Assets: s.coin("11apple"), Price: s.coin("105pear"),
+					SellerSettlementFlatFee: s.coinP("5pear"),
+				}))
+				s.requireAddHold(s.addr3, "11apple", 333)
+				s.requireSetOrderInStore(store, exchange.NewOrder(4444).WithBid(&exchange.BidOrder{
+					MarketId: 2, Buyer: s.addr4.String(), Assets: s.coin("8apple"), Price: s.coin("85pear"),
+					BuyerSettlementFees: s.coins("10pear"),
+				}))
+				s.requireAddHold(s.addr4, "95pear", 4444)
+			},
+			msg: exchange.MsgMarketSettleRequest{
+				Admin:       s.addr5.String(),
+				MarketId:    2,
+				AskOrderIds: []uint64{1, 333},
+				BidOrderIds: []uint64{22, 4444},
+			},
+			expInErr: []string{invReqErr,
+				"error collecting exchange fee 2fig,2pear (based off 30fig,34pear) from market 2",
+				"restricted denom pear cannot be sent to the fee collector",
+			},
+		},
+		{
+			name: "two of each with fees and req attrs",
+			setup: func() {
+				s.requireAddFinalizeAndActivateCoinMarker(s.coin("185pear"), s.addr5)
+				s.requireAddFinalizeAndActivateMarker(s.coin("18apple"), s.addr5, "*.have.it")
+				s.requireSetNameRecord("buyer.have.it", s.addr5)
+				s.requireSetNameRecord("seller.have.it", s.addr5)
+				s.requireSetNameRecord("market.have.it", s.addr5)
+				s.requireSetAttr(s.addr1, "seller.have.it", s.addr5)
+				s.requireSetAttr(s.addr2, "buyer.have.it", s.addr5)
+				s.requireSetAttr(s.addr3, "seller.have.it", s.addr5)
+				s.requireSetAttr(s.addr4, "buyer.have.it", s.addr5)
+				s.requireSetAttr(s.marketAddr2, "market.have.it", s.addr5)
+				s.requireFundAccount(s.addr1, "20apple,100pear,100fig")
+				s.requireFundAccount(s.addr2, "20apple,100pear,100fig")
+				s.requireFundAccount(s.addr3, "20apple,100pear,100fig")
+				s.requireFundAccount(s.addr4, "20apple,100pear,100fig")
+
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 2, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_settle)},
+					FeeSellerSettlementRatios: s.ratios("10pear:1pear"),
+				})
+
+				store := s.getStore()
+				s.requireSetOrderInStore(store, exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 2, Seller: s.addr1.String(), Assets: s.coin("7apple"), Price: s.coin("75pear"),
+					SellerSettlementFlatFee: s.coinP("10fig"),
+				}))
+				s.requireAddHold(s.addr1, "7apple,10fig", 1)
+				s.requireSetOrderInStore(store, exchange.NewOrder(22).WithBid(&exchange.BidOrder{
+					MarketId: 2, Buyer: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("100pear"),
+					BuyerSettlementFees: s.coins("20fig"),
+				}))
+				s.requireAddHold(s.addr2, "100pear,20fig", 22)
+				s.requireSetOrderInStore(store, exchange.NewOrder(333).WithAsk(&exchange.AskOrder{
+					MarketId: 2, Seller: s.addr3.String(), Assets: s.coin("11apple"), Price: s.coin("105pear"),
+					SellerSettlementFlatFee: s.coinP("5pear"),
+				}))
+				s.requireAddHold(s.addr3, "11apple", 333)
+				s.requireSetOrderInStore(store, exchange.NewOrder(4444).WithBid(&exchange.BidOrder{
+					MarketId: 2, Buyer: s.addr4.String(), Assets: s.coin("8apple"), Price: s.coin("85pear"),
+					BuyerSettlementFees: s.coins("10pear"),
+				}))
+				s.requireAddHold(s.addr4, "95pear", 4444)
+			},
+			msg: exchange.MsgMarketSettleRequest{
+				Admin:       s.addr5.String(),
+				MarketId:    2,
+				AskOrderIds: []uint64{1, 333},
+				BidOrderIds: []uint64{22, 4444},
+			},
+			fArgs: followupArgs{
 				expBals: []expBalances{
 					{
 						addr:    s.addr1,
-						expBal:  []sdk.Coin{s.zeroCoin("apple"), s.coin("76pear"), s.zeroCoin("fig")},
+						expBal:  s.coins("13apple,169pear,90fig"),
 						expHold: s.zeroCoins("apple", "pear", "fig"),
 					},
 					{
 						addr:    s.addr2,
-						expBal:  []sdk.Coin{s.coin("10apple"), s.zeroCoin("pear"), s.zeroCoin("fig")},
+						expBal:  []sdk.Coin{s.coin("30apple"), s.zeroCoin("pear"), s.coin("80fig")},
 						expHold: s.zeroCoins("apple", "pear", "fig"),
 					},
 					{
 						addr:    s.addr3,
-						expBal:  []sdk.Coin{s.zeroCoin("apple"), s.coin("109pear"), s.zeroCoin("fig")},
+						expBal:  s.coins("9apple,192pear,100fig"),
 						expHold: s.zeroCoins("apple", "pear", "fig"),
 					},
 					{
 						addr:    s.addr4,
-						expBal:  []sdk.Coin{s.coin("8apple"), s.zeroCoin("pear"), s.zeroCoin("fig")},
+						expBal:  s.coins("28apple,5pear,100fig"),
 						expHold: s.zeroCoins("apple", "pear", "fig"),
 					},
 					{
-						addr:   s.marketAddr1,
-						expBal: s.zeroCoins("apple", "pear", "fig"),
+						addr:   s.marketAddr2,
+						expBal: []sdk.Coin{s.zeroCoin("apple"), s.coin("32pear"), s.coin("28fig")},
 					},
 					{
 						addr:   s.feeCollectorAddr,
-						expBal: s.zeroCoins("apple", "pear", "fig"),
+						expBal: []sdk.Coin{s.zeroCoin("apple"), s.coin("2pear"), s.coin("2fig")},
 					},
 				},
 			},
-			expEvents: sdk.Events{
-				// Hold releases (0-3)
-				s.eventHoldReleased(s.addr3, "11apple"),
-				s.eventHoldReleased(s.addr1, "7apple"),
-				s.eventHoldReleased(s.addr2, "100pear"),
-				s.eventHoldReleased(s.addr4, "85pear"),
+			expEvents: sdk.Events{
+				// Hold releases
+				s.eventHoldReleased(s.addr1, "7apple,10fig"),
+				s.eventHoldReleased(s.addr3, "11apple"),
+				s.eventHoldReleased(s.addr2, "20fig,100pear"),
+				s.eventHoldReleased(s.addr4, "95pear"),
+
+				// Asset transfers
+				s.eventCoinSpent(s.addr1, "7apple"),
+				s.eventCoinReceived(s.addr2, "7apple"),
+				s.eventTransfer(s.addr2, s.addr1, "7apple"),
+				s.eventMessageSender(s.addr1),
+
+				s.eventCoinSpent(s.addr3, "11apple"),
+				s.eventMessageSender(s.addr3),
+				s.eventCoinReceived(s.addr2, "3apple"),
+				s.eventCoinReceived(s.addr4, "8apple"),
+				s.eventTransfer(s.addr2, s.addr3, "3apple"),
+				s.eventTransfer(s.addr4, s.addr3, "8apple"),
+
+				// Price transfers
+				s.eventCoinSpent(s.addr2, "100pear"),
+				s.eventMessageSender(s.addr2),
+				s.eventCoinReceived(s.addr1, "75pear"),
+				s.eventCoinReceived(s.addr3, "25pear"),
+				s.eventTransfer(s.addr1, s.addr2, "75pear"),
+				s.eventTransfer(s.addr3, s.addr2, "25pear"),
+
+				s.eventCoinSpent(s.addr4, "85pear"),
+				s.eventMessageSender(s.addr4),
+				s.eventCoinReceived(s.addr3, "83pear"),
+				s.eventCoinReceived(s.addr1, "2pear"),
+				s.eventTransfer(s.addr3, s.addr4, "83pear"),
+				s.eventTransfer(s.addr1, s.addr4, "2pear"),
+
+				// Fee transfers to market
+				s.eventCoinSpent(s.addr1, "10fig,8pear"),
+				s.eventMessageSender(s.addr1),
+				s.eventCoinSpent(s.addr3, "16pear"),
+				s.eventMessageSender(s.addr3),
+				s.eventCoinSpent(s.addr2, "20fig"),
+				s.eventMessageSender(s.addr2),
+				s.eventCoinSpent(s.addr4, "10pear"),
+				s.eventMessageSender(s.addr4),
+				s.eventCoinReceived(s.marketAddr2, "30fig,34pear"),
+				s.eventTransfer(s.marketAddr2, s.addr1, "10fig,8pear"),
+				s.eventTransfer(s.marketAddr2, s.addr3, "16pear"),
+				s.eventTransfer(s.marketAddr2, s.addr2, "20fig"),
+				s.eventTransfer(s.marketAddr2, s.addr4, "10pear"),
+
+				// Transfers of exchange portion of fees
+				s.eventCoinSpent(s.marketAddr2, "2fig,2pear"),
+				s.eventCoinReceived(s.feeCollectorAddr, "2fig,2pear"),
+				s.eventTransfer(s.feeCollectorAddr, s.marketAddr2, "2fig,2pear"),
+				s.eventMessageSender(s.marketAddr2),
+
+				// Orders filled
+				s.untypeEvent(&exchange.EventOrderFilled{
+					OrderId: 1, Assets: "7apple", Price: "77pear", MarketId: 2, Fees: "10fig,8pear", SettlementId: 1,
+				}),
+				s.untypeEvent(&exchange.EventOrderFilled{
+					OrderId: 333, Assets: "11apple", Price: "108pear", MarketId: 2, Fees: "16pear", SettlementId: 1,
+				}),
+				s.untypeEvent(&exchange.EventOrderFilled{
+					OrderId: 22, Assets: "10apple", Price: "100pear", MarketId: 2, Fees: "20fig", SettlementId: 1,
+				}),
+				s.untypeEvent(&exchange.EventOrderFilled{
+					OrderId: 4444, Assets: "8apple", Price: "85pear", MarketId: 2, Fees: "10pear", SettlementId: 1,
+				}),
+
+				// The net-asset-value event.
+				s.markerNavSetEvent("18apple", "185pear", 2),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runMsgServerTestCase(s, testDef, tc)
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_MarketCommitmentSettle() {
+	testDef := msgServerTestDef[exchange.MsgMarketCommitmentSettleRequest, exchange.MsgMarketCommitmentSettleResponse, []expBalances]{
+		endpointName: "MarketCommitmentSettle",
+		endpoint:     keeper.NewMsgServer(s.k).MarketCommitmentSettle,
+		expResp:      &exchange.MsgMarketCommitmentSettleResponse{},
+		followup: func(_ *exchange.MsgMarketCommitmentSettleRequest, expBals []expBalances) {
+			for _, eb := range expBals {
+				s.checkBalances(eb)
+			}
+		},
+	}
+
+	tests := []msgServerTestCase[exchange.MsgMarketCommitmentSettleRequest, []expBalances]{
+		{
+			name: "does not have permission",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:                 3,
+					AccessGrants:             []exchange.AccessGrant{s.agCanAllBut(s.addr5, exchange.Permission_settle)},
+					CommitmentSettlementBips: 50,
+					IntermediaryDenom:        "cherry",
+				})
+				attr := "can.do"
+				s.requireSetNameRecord(attr, s.addr5)
+				s.requireSetAttr(s.addr1, attr, s.addr5)
+				s.requireAddFinalizeAndActivateMarker(s.coin("1000000apple"), s.addr5, attr)
+				s.requireAddFinalizeAndActivateMarker(s.coin("1000000plum"), s.addr5, attr)
+				s.requireAddFinalizeAndActivateMarker(s.coin("1000000cherry"), s.addr5, attr)
+				appleMarker := s.requireGetMarker("apple")
+				plumMarker := s.requireGetMarker("plum")
+				cherryMarker := s.requireGetMarker("cherry")
+				s.requireSetNav(appleMarker, 100, "23cherry")
+				s.requireSetNav(appleMarker, 5, "12plum")
+				s.requireSetNav(plumMarker, 77, "50cherry")
+				s.requireSetNav(plumMarker, 12, "5apple")
+				s.requireSetNav(cherryMarker, 6, "25nhash")
+
+				s.requireFundAccount(s.addr1, "30apple")
+				s.requireSetCommitmentAmount(3, s.addr1, "25apple")
+			},
+			msg: exchange.MsgMarketCommitmentSettleRequest{
+				Admin:    s.addr5.String(),
+				MarketId: 3,
+				Inputs:   []exchange.AccountAmount{{Account: s.addr1.String(), Amount: s.coins("10apple")}},
+				Outputs:  []exchange.AccountAmount{{Account: s.addr2.String(), Amount: s.coins("10apple")}},
+				EventTag: "nopetag",
+			},
+			expInErr: []string{invReqErr, "account " + s.addr5.String() + " does not have permission to settle commitments for market 3"},
+			fArgs: []expBalances{
+				{addr: s.addr1, expBal: s.coins("30apple"), expHold: s.coins("25apple"), expSpend: s.coins("5apple")},
+				{addr: s.addr2, expBal: s.zeroCoins("apple"), expHold: s.zeroCoins("apple"), expSpend: s.zeroCoins("apple")},
+			},
+		},
+		{
+			name: "success",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:                 3,
+					AccessGrants:             []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_settle)},
+					CommitmentSettlementBips: 50,
+					IntermediaryDenom:        "cherry",
+				})
+				attr := "can.do"
+				s.requireSetNameRecord(attr, s.addr5)
+				s.requireSetAttr(s.addr1, attr, s.addr5)
+				s.requireSetAttr(s.addr2, attr, s.addr5)
+				s.requireSetAttr(s.addr3, attr, s.addr5)
+				s.requireSetAttr(s.addr4, attr, s.addr5)
+				s.requireSetAttr(s.marketAddr3, attr, s.addr5)
+				s.requireAddFinalizeAndActivateMarker(s.coin("1000000apple"), s.addr5, attr)
+				s.requireAddFinalizeAndActivateMarker(s.coin("1000000plum"), s.addr5, attr)
+				s.requireAddFinalizeAndActivateMarker(s.coin("1000000cherry"), s.addr5, attr)
+				appleMarker := s.requireGetMarker("apple")
+				plumMarker := s.requireGetMarker("plum")
+				cherryMarker := s.requireGetMarker("cherry")
+				s.requireSetNav(appleMarker, 100, "23cherry")
+				s.requireSetNav(appleMarker, 5, "12plum")
+				s.requireSetNav(plumMarker, 77, "50cherry")
+				s.requireSetNav(plumMarker, 12, "5apple")
+				s.requireSetNav(cherryMarker, 6, "25nhash")
+
+				s.requireFundAccount(s.addr1, "100apple,12cherry")
+				s.requireFundAccount(s.addr2, "50apple,50cherry,50plum")
+				s.requireFundAccount(s.addr3, "900plum")
+
+				s.requireSetCommitmentAmount(3, s.addr1, "100apple,2cherry")
+				s.requireSetCommitmentAmount(3, s.addr2, "10apple,5cherry,50plum")
+				s.requireSetCommitmentAmount(3, s.addr3, "100plum")
+			},
+			msg: exchange.MsgMarketCommitmentSettleRequest{
+				Admin:    s.addr5.String(),
+				MarketId: 3,
+				Inputs: []exchange.AccountAmount{
+					{Account: s.addr1.String(), Amount: s.coins("95apple")},
+					{Account: s.addr2.String(), Amount: s.coins("50plum")},
+					{Account: s.addr3.String(), Amount: s.coins("77plum")},
+				},
+				Outputs: []exchange.AccountAmount{
+					{Account: s.addr1.String(), Amount: s.coins("90plum")},
+					{Account: s.addr2.String(), Amount: s.coins("57apple")},
+					{Account: s.addr3.String(), Amount: s.coins("12apple")},
+					{Account: s.addr4.String(), Amount: s.coins("26apple,37plum")},
+				},
+				Fees: []exchange.AccountAmount{
+					{Account: s.addr1.String(), Amount: s.coins("2cherry")},
+					{Account: s.addr2.String(), Amount: s.coins("3cherry")},
+				},
+				EventTag: "tagtestbackagain",
+			},
+			expEvents: sdk.Events{
+				// commitment releases
+				s.eventHoldReleased(s.addr1, "95apple,2cherry"),
+				s.eventCommitmentReleased(s.addr1, 3, "95apple,2cherry", "tagtestbackagain"),
+				s.eventHoldReleased(s.addr2, "3cherry,50plum"),
+				s.eventCommitmentReleased(s.addr2, 3, "3cherry,50plum", "tagtestbackagain"),
+				s.eventHoldReleased(s.addr3, "77plum"),
+				s.eventCommitmentReleased(s.addr3, 3, "77plum", "tagtestbackagain"),
+
+				// Transfer from addr1
+				s.eventCoinSpent(s.addr1, "95apple"),
+				s.eventMessageSender(s.addr1),
+				s.eventCoinReceived(s.addr2, "57apple"),
+				s.eventCoinReceived(s.addr3, "12apple"),
+				s.eventCoinReceived(s.addr4, "26apple"),
+				s.eventTransfer(s.addr2, s.addr1, "57apple"),
+				s.eventTransfer(s.addr3, s.addr1, "12apple"),
+				s.eventTransfer(s.addr4, s.addr1, "26apple"),
+
+				// Transfer from addr2
+				s.eventCoinSpent(s.addr2, "50plum"),
+				s.eventCoinReceived(s.addr1, "50plum"),
+				s.eventTransfer(s.addr1, s.addr2, "50plum"),
+				s.eventMessageSender(s.addr2),
+
+				// Transfer from addr3
+				s.eventCoinSpent(s.addr3, "77plum"),
+				s.eventMessageSender(s.addr3),
+				s.eventCoinReceived(s.addr1, "40plum"),
+				s.eventCoinReceived(s.addr4, "37plum"),
+				s.eventTransfer(s.addr1, s.addr3, "40plum"),
+				s.eventTransfer(s.addr4, s.addr3, "37plum"),
+
+				// Fee Transfer
+				s.eventCoinSpent(s.addr1, "2cherry"),
+				s.eventMessageSender(s.addr1),
+				s.eventCoinSpent(s.addr2, "3cherry"),
+				s.eventMessageSender(s.addr2),
+				s.eventCoinReceived(s.marketAddr3, "5cherry"),
+				s.eventTransfer(s.marketAddr3, s.addr1, "2cherry"),
+				s.eventTransfer(s.marketAddr3, s.addr2, "3cherry"),
+
+				// re-commits
+				s.eventHoldAddedCommitment(s.addr1, "90plum", 3),
+				s.eventFundsCommitted(s.addr1, 3, "90plum", "tagtestbackagain"),
+				s.eventHoldAddedCommitment(s.addr2, "57apple", 3),
+				s.eventFundsCommitted(s.addr2, 3, "57apple", "tagtestbackagain"),
+				s.eventHoldAddedCommitment(s.addr3, "12apple", 3),
+				s.eventFundsCommitted(s.addr3, 3, "12apple", "tagtestbackagain"),
+				s.eventHoldAddedCommitment(s.addr4, "26apple,37plum", 3),
+				s.eventFundsCommitted(s.addr4, 3, "26apple,37plum", "tagtestbackagain"),
+			},
+			fArgs: []expBalances{
+				{
+					addr:     s.addr1,
+					expBal:   s.coins("5apple,10cherry,90plum"),
+					expHold:  []sdk.Coin{s.coin("5apple"), s.zeroCoin("cherry"), s.coin("90plum")},
+					expSpend: []sdk.Coin{s.zeroCoin("apple"), s.coin("10cherry"), s.zeroCoin("plum")},
+				},
+				{
+					addr:     s.addr2,
+					expBal:   []sdk.Coin{s.coin("107apple"), s.coin("47cherry"), s.zeroCoin("plum")},
+					expHold:  []sdk.Coin{s.coin("67apple"), s.zeroCoin("plum"), s.coin("2cherry")},
+					expSpend: []sdk.Coin{s.coin("40apple"), s.zeroCoin("plum"), s.coin("45cherry")},
+				},
+				{
+					addr:     s.addr3,
+					expBal:   []sdk.Coin{s.coin("12apple"), s.zeroCoin("cherry"), s.coin("823plum")},
+					expHold:  []sdk.Coin{s.coin("12apple"), s.zeroCoin("cherry"), s.coin("23plum")},
+					expSpend: []sdk.Coin{s.zeroCoin("apple"), s.zeroCoin("cherry"), s.coin("800plum")},
+				},
+				{
+					addr:     s.addr4,
+					expBal:   []sdk.Coin{s.coin("26apple"), s.zeroCoin("cherry"), s.coin("37plum")},
+					expHold:  []sdk.Coin{s.coin("26apple"), s.zeroCoin("cherry"), s.coin("37plum")},
+					expSpend: s.zeroCoins("apple", "cherry", "plum"),
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runMsgServerTestCase(s, testDef, tc)
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_MarketReleaseCommitments() {
+	testDef := msgServerTestDef[exchange.MsgMarketReleaseCommitmentsRequest, exchange.MsgMarketReleaseCommitmentsResponse, []expBalances]{
+		endpointName: "MarketReleaseCommitments",
+		endpoint:     keeper.NewMsgServer(s.k).MarketReleaseCommitments,
+		expResp:      &exchange.MsgMarketReleaseCommitmentsResponse{},
+		followup: func(_ *exchange.MsgMarketReleaseCommitmentsRequest, expBals []expBalances) {
+			for _, eb := range expBals {
+				s.checkBalances(eb)
+			}
+		},
+	}
+
+	tests := []msgServerTestCase[exchange.MsgMarketReleaseCommitmentsRequest, []expBalances]{
+		{
+			name: "no permission",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:     1,
+					AccessGrants: []exchange.AccessGrant{s.agCanAllBut(s.addr1, exchange.Permission_cancel)},
+				})
+				s.requireFundAccount(s.addr2, "100apple,100cherry")
+				s.requireSetCommitmentAmount(1, s.addr2, "50apple")
+			},
+			msg: exchange.MsgMarketReleaseCommitmentsRequest{
+				Admin:     s.addr1.String(),
+				MarketId:  1,
+				ToRelease: []exchange.AccountAmount{{Account: s.addr2.String(), Amount: s.coins("50apple")}},
+			},
+			expInErr: []string{invReqErr, "account " + s.addr1.String() + " does not have permission to release commitments for market 1"},
+			fArgs: []expBalances{
+				{
+					addr:     s.addr2,
+					expBal:   s.coins("100apple,100cherry"),
+					expHold:  s.coins("50apple"),
+					expSpend: s.coins("50apple,100cherry"),
+				},
+			},
+		},
+		{
+			name: "has permission: error in release",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:     1,
+					AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
+				})
+				s.requireFundAccount(s.addr2, "100apple,100cherry")
+				s.requireSetCommitmentAmount(1, s.addr2, "50apple")
+			},
+			msg: exchange.MsgMarketReleaseCommitmentsRequest{
+				Admin:     s.addr1.String(),
+				MarketId:  1,
+				ToRelease: []exchange.AccountAmount{{Account: "badbadaddr", Amount: s.coins("50apple")}},
+			},
+			expInErr: []string{invReqErr, "invalid account \"badbadaddr\""},
+			fArgs: []expBalances{{
+				addr:     s.addr2,
+				expBal:   s.coins("100apple,100cherry"),
+				expHold:  s.coins("50apple"),
+				expSpend: s.coins("50apple,100cherry"),
+			}},
+		},
+		{
+			name: "okay: partial",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:     1,
+					AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
+				})
+				s.requireFundAccount(s.addr2, "100apple,100cherry")
+				s.requireSetCommitmentAmount(1, s.addr2, "50apple")
+			},
+			msg: exchange.MsgMarketReleaseCommitmentsRequest{
+				Admin:     s.addr1.String(),
+				MarketId:  1,
+				ToRelease: []exchange.AccountAmount{{Account: s.addr2.String(), Amount: s.coins("40apple")}},
+				EventTag:  "byebyebye",
+			},
+			expEvents: sdk.Events{
+				s.eventHoldReleased(s.addr2, "40apple"),
+				s.eventCommitmentReleased(s.addr2, 1, "40apple", "byebyebye"),
+			},
+			fArgs: []expBalances{{
+				addr:     s.addr2,
+				expBal:   s.coins("100apple,100cherry"),
+				expHold:  s.coins("10apple"),
+				expSpend: s.coins("90apple,100cherry"),
+			}},
+		},
+		{
+			name: "okay: all provided",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:     1,
+					AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
+				})
+				s.requireFundAccount(s.addr2, "100apple,100cherry")
+				s.requireSetCommitmentAmount(1, s.addr2, "50apple")
+			},
+			msg: exchange.MsgMarketReleaseCommitmentsRequest{
+				Admin:     s.addr1.String(),
+				MarketId:  1,
+				ToRelease: []exchange.AccountAmount{{Account: s.addr2.String(), Amount: s.coins("50apple")}},
+				EventTag:  "hellogoodbye",
+			},
+			expEvents: sdk.Events{
+				s.eventHoldReleased(s.addr2, "50apple"),
+				s.eventCommitmentReleased(s.addr2, 1, "50apple", "hellogoodbye"),
+			},
+			fArgs: []expBalances{{
+				addr:     s.addr2,
+				expBal:   s.coins("100apple,100cherry"),
+				expHold:  s.zeroCoins("apple"),
+				expSpend: s.coins("100apple,100cherry"),
+			}},
+		},
+		{
+			name: "okay: empty amt",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:     1,
+					AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
+				})
+				s.requireFundAccount(s.addr2, "100apple,100cherry")
+				s.requireSetCommitmentAmount(1, s.addr2, "50apple")
+			},
+			msg: exchange.MsgMarketReleaseCommitmentsRequest{
+				Admin:     s.addr1.String(),
+				MarketId:  1,
+				ToRelease: []exchange.AccountAmount{{Account: s.addr2.String()}},
+				EventTag:  "allgonow",
+			},
+			expEvents: sdk.Events{
+				s.eventHoldReleased(s.addr2, "50apple"),
+				s.eventCommitmentReleased(s.addr2, 1, "50apple", "allgonow"),
+			},
+			fArgs: []expBalances{{
+				addr:     s.addr2,
+				expBal:   s.coins("100apple,100cherry"),
+				expHold:  s.zeroCoins("apple"),
+				expSpend: s.coins("100apple,100cherry"),
+			}},
+		},
+		{
+			name: "multiple okay",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:     2,
+					AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
+				})
+				bal := "100apple,200cherry"
+				s.requireFundAccount(s.addr1, bal)
+				s.requireFundAccount(s.addr2, bal)
+				s.requireFundAccount(s.addr3, bal)
+				s.requireFundAccount(s.addr4, bal)
+				s.requireFundAccount(s.addr5, bal)
+				s.requireSetCommitmentAmount(2, s.addr1, "100apple")
+				s.requireSetCommitmentAmount(2, s.addr2, "200cherry")
+				s.requireSetCommitmentAmount(2, s.addr3, "10apple,200cherry")
+				s.requireSetCommitmentAmount(2, s.addr4, "100apple,20cherry")
+				s.requireSetCommitmentAmount(2, s.addr5, "90apple,180cherry")
+			},
+			msg: exchange.MsgMarketReleaseCommitmentsRequest{
+				Admin:    s.addr1.String(),
+				MarketId: 2,
+				ToRelease: []exchange.AccountAmount{
+					{Account: s.addr3.String(), Amount: s.coins("6apple,111cherry")},
+					{Account: s.addr5.String(), Amount: s.coins("180cherry")},
+					{Account: s.addr1.String(), Amount: s.coins("75apple")},
+					{Account: s.addr4.String()},
+					{Account: s.addr2.String(), Amount: s.coins("200cherry")},
+				},
+				EventTag: "multifree",
+			},
+			expEvents: sdk.Events{
+				s.eventHoldReleased(s.addr3, "6apple,111cherry"),
+				s.eventCommitmentReleased(s.addr3, 2, "6apple,111cherry", "multifree"),
+				s.eventHoldReleased(s.addr5, "180cherry"),
+				s.eventCommitmentReleased(s.addr5, 2, "180cherry", "multifree"),
+				s.eventHoldReleased(s.addr1, "75apple"),
+				s.eventCommitmentReleased(s.addr1, 2, "75apple", "multifree"),
+				s.eventHoldReleased(s.addr4, "100apple,20cherry"),
+				s.eventCommitmentReleased(s.addr4, 2, "100apple,20cherry", "multifree"),
+				s.eventHoldReleased(s.addr2, "200cherry"),
+				s.eventCommitmentReleased(s.addr2, 2, "200cherry", "multifree"),
+			},
+			fArgs: []expBalances{
+				{
+					addr:     s.addr1,
+					expBal:   s.coins("100apple,200cherry"),
+					expHold:  []sdk.Coin{s.coin("25apple"), s.zeroCoin("cherry")},
+					expSpend: s.coins("75apple,200cherry"),
+				},
+				{
+					addr:     s.addr2,
+					expBal:   s.coins("100apple,200cherry"),
+					expHold:  s.zeroCoins("apple", "cherry"),
+					expSpend: s.coins("100apple,200cherry"),
+				},
+				{
+					addr:     s.addr3,
+					expBal:   s.coins("100apple,200cherry"),
+					expHold:  s.coins("4apple,89cherry"),
+					expSpend: s.coins("96apple,111cherry"),
+				},
+				{
+					addr:     s.addr4,
+					expBal:   s.coins("100apple,200cherry"),
+					expHold:  s.zeroCoins("apple", "cherry"),
+					expSpend: s.coins("100apple,200cherry"),
+				},
+				{
+					addr:     s.addr5,
+					expBal:   s.coins("100apple,200cherry"),
+					expHold:  []sdk.Coin{s.coin("90apple"), s.zeroCoin("cherry")},
+					expSpend: s.coins("10apple,200cherry"),
+				},
+			},
+		},
+		{
+			name: "authority: error in release",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:     1,
+					AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
+				})
+				s.requireFundAccount(s.addr2, "100apple,100cherry")
+				s.requireSetCommitmentAmount(1, s.addr2, "50apple")
+			},
+			msg: exchange.MsgMarketReleaseCommitmentsRequest{
+				Admin:     s.k.GetAuthority(),
+				MarketId:  1,
+				ToRelease: []exchange.AccountAmount{{Account: "badbadaddr", Amount: s.coins("50apple")}},
+			},
+			expInErr: []string{invReqErr, "invalid account \"badbadaddr\""},
+			fArgs: []expBalances{{
+				addr:     s.addr2,
+				expBal:   s.coins("100apple,100cherry"),
+				expHold:  s.coins("50apple"),
+				expSpend: s.coins("50apple,100cherry"),
+			}},
+		},
+		{
+			name: "authority: multiple okay",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:     2,
+					AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
+				})
+				bal := "100apple,200cherry"
+				s.requireFundAccount(s.addr1, bal)
+				s.requireFundAccount(s.addr2, bal)
+				s.requireFundAccount(s.addr3, bal)
+				s.requireFundAccount(s.addr4, bal)
+				s.requireFundAccount(s.addr5, bal)
+				s.requireSetCommitmentAmount(2, s.addr1, "100apple")
+				s.requireSetCommitmentAmount(2, s.addr2, "200cherry")
+				s.requireSetCommitmentAmount(2, s.addr3, "10apple,200cherry")
+				s.requireSetCommitmentAmount(2, s.addr4, "100apple,20cherry")
+				s.requireSetCommitmentAmount(2, s.addr5, "90apple,180cherry")
+			},
+			msg: exchange.MsgMarketReleaseCommitmentsRequest{
+				Admin:    s.addr1.String(),
+				MarketId: 2,
+				ToRelease: []exchange.AccountAmount{
+					{Account: s.addr3.String(), Amount: s.coins("6apple,111cherry")},
+					{Account: s.addr5.String(), Amount: s.coins("180cherry")},
+					{Account: s.addr1.String(), Amount: s.coins("75apple")},
+					{Account: s.addr4.String()},
+					{Account: s.addr2.String(), Amount: s.coins("200cherry")},
+				},
+				EventTag: "multifree",
+			},
+			expEvents: sdk.Events{
+				s.eventHoldReleased(s.addr3, "6apple,111cherry"),
+				s.eventCommitmentReleased(s.addr3, 2, "6apple,111cherry", "multifree"),
+				s.eventHoldReleased(s.addr5, "180cherry"),
+				s.eventCommitmentReleased(s.addr5, 2, "180cherry", "multifree"),
+				s.eventHoldReleased(s.addr1, "75apple"),
+				s.eventCommitmentReleased(s.addr1, 2, "75apple", "multifree"),
+				s.eventHoldReleased(s.addr4, "100apple,20cherry"),
+				s.eventCommitmentReleased(s.addr4, 2, "100apple,20cherry", "multifree"),
+				s.eventHoldReleased(s.addr2, "200cherry"),
+				s.eventCommitmentReleased(s.addr2, 2, "200cherry", "multifree"),
+			},
+			fArgs: []expBalances{
+				{
+					addr:     s.addr1,
+					expBal:   s.coins("100apple,200cherry"),
+					expHold:  []sdk.Coin{s.coin("25apple"), s.zeroCoin("cherry")},
+					expSpend: s.coins("75apple,200cherry"),
+				},
+				{
+					addr:     s.addr2,
+					expBal:   s.coins("100apple,200cherry"),
+					expHold:  s.zeroCoins("apple", "cherry"),
+					expSpend: s.coins("100apple,200cherry"),
+				},
+				{
+					addr:     s.addr3,
+					expBal:   s.coins("100apple,200cherry"),
+					expHold:  s.coins("4apple,89cherry"),
+					expSpend: s.coins("96apple,111cherry"),
+				},
+				{
+					addr:     s.addr4,
+					expBal:   s.coins("100apple,200cherry"),
+					expHold:  s.zeroCoins("apple", "cherry"),
+					expSpend: s.coins("100apple,200cherry"),
+				},
+				{
+					addr:     s.addr5,
+					expBal:   s.coins("100apple,200cherry"),
+					expHold:  []sdk.Coin{s.coin("90apple"), s.zeroCoin("cherry")},
+					expSpend: s.coins("10apple,200cherry"),
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runMsgServerTestCase(s, testDef, tc)
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_MarketTransferCommitments() {
+	testDef := msgServerTestDef[exchange.MsgMarketTransferCommitmentRequest, exchange.MsgMarketTransferCommitmentResponse, []expBalances]{
+		endpointName: "MarketTransferCommitment",
+		endpoint:     keeper.NewMsgServer(s.k).MarketTransferCommitment,
+		expResp:      &exchange.MsgMarketTransferCommitmentResponse{},
+		followup: func(_ *exchange.MsgMarketTransferCommitmentRequest, expBals []expBalances) {
+			for _, eb := range expBals {
+				s.checkBalances(eb)
+			}
+		},
+	}
+
+	tests := []msgServerTestCase[exchange.MsgMarketTransferCommitmentRequest, []expBalances]{
+		{
+			name: "admin does not have permission to transfer",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId:     1,
+					AccessGrants: []exchange.AccessGrant{s.agCanAllBut(s.addr1, exchange.Permission_cancel)},
+				})
+				s.requireFundAccount(s.addr2, "100apple")
+				s.requireSetCommitmentAmount(1, s.addr2, "50apple")
+			},
+			msg: exchange.MsgMarketTransferCommitmentRequest{
+				Admin: s.addr1.String(), Account: s.addr2.String(), Amount: s.coins("50apple"), CurrentMarketId: 1, NewMarketId: 2,
+			},
+			expInErr: []string{invReqErr,
+				"account " + s.addr1.String() + " does not have permission to transfer commitments for market 1"},
+			fArgs: []expBalances{
+				{
+					addr:     s.addr2,
+					expBal:   s.coins("100apple"),
+					expHold:  s.coins("50apple"),
+					expSpend: s.coins("50apple"),
+				},
+			},
+		},
+		{
+			name: "new market does not exists",
+			setup: func() {
+				s.requireFundAccount(s.addr2, "100apple")
+				s.requireCreateMarket(exchange.Market{
+					MarketId:             1,
+					AccessGrants:         []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
+					AcceptingCommitments: true,
+				})
+				s.requireCreateMarket(exchange.Market{
+					MarketId:     5,
+					AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
+				})
+				s.requireSetCommitmentAmount(1, s.addr2, "40apple")
+			},
+			msg: exchange.MsgMarketTransferCommitmentRequest{
+				Admin: s.addr1.String(), Account: s.addr2.String(), Amount: s.coins("40apple"), CurrentMarketId: 1, NewMarketId: 2,
+			},
+			expInErr: []string{invReqErr, "market 2 does not exist"},
+			fArgs: []expBalances{
+				{
+					addr:     s.addr2,
+					expBal:   s.coins("100apple"),
+					expHold:  s.coins("40apple"),
+					expSpend: s.coins("40apple"),
+				},
+			},
+		},
+		{
+			name: "new market not accpeting commitments",
+			setup: func() {
+				s.requireFundAccount(s.addr2, "100apple")
+				s.requireCreateMarket(exchange.Market{
+					MarketId:             1,
+					AccessGrants:         []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
+					AcceptingCommitments: true,
+				})
+				s.requireCreateMarket(exchange.Market{
+					MarketId:     5,
+					AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
+				})
+				s.requireSetCommitmentAmount(1, s.addr2, "40apple")
+			},
+			msg: exchange.MsgMarketTransferCommitmentRequest{
+				Admin: s.addr1.String(), Account: s.addr2.String(), Amount: s.coins("40apple"), CurrentMarketId: 1, NewMarketId: 5,
+			},
+			expInErr: []string{invReqErr, "market 5 is not accepting commitments"},
+			fArgs: []expBalances{
+				{
+					addr:     s.addr2,
+					expBal:   s.coins("100apple"),
+					expHold:  s.coins("40apple"),
+					expSpend: s.coins("40apple"),
+				},
+			},
+		},
+		{
+			name: "insufficent fund to transfer",
+			setup: func() {
+				s.requireFundAccount(s.addr2, "100apple")
+				s.requireCreateMarket(exchange.Market{
+					MarketId:             1,
+					AccessGrants:         []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
+					AcceptingCommitments: true,
+				})
+				s.requireCreateMarket(exchange.Market{
+					MarketId:             5,
+					AccessGrants:         []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
+					AcceptingCommitments: true,
+				})
+				s.requireSetCommitmentAmount(1, s.addr2, "40apple")
+			},
+			msg: exchange.MsgMarketTransferCommitmentRequest{
+				Admin: s.addr1.String(), Account: s.addr2.String(), Amount: s.coins("50apple"), CurrentMarketId: 1, NewMarketId: 5,
+			},
+			expInErr: []string{invReqErr, fmt.Sprintf(
+				"commitment amount to transfer %q is more than currently committed amount %q for %s in market %d: invalid request",
+				"50apple", "40apple", s.addr2.String(), 1)},
+			fArgs: []expBalances{
+				{
+					addr:     s.addr2,
+					expBal:   s.coins("100apple"),
+					expHold:  s.coins("40apple"),
+					expSpend: s.coins("40apple"),
+				},
+			},
+		},
+		{
+			name: "negative amount rejected",
+			setup: func() {
+				s.requireFundAccount(s.addr2, "100apple")
+				s.requireCreateMarket(exchange.Market{
+					MarketId:             1,
+					AccessGrants:         []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
+					AcceptingCommitments: true,
+				})
+				s.requireCreateMarket(exchange.Market{
+					MarketId:             5,
+					AccessGrants:         []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
+					AcceptingCommitments: true,
+				})
+				s.requireSetCommitmentAmount(1, s.addr2, "40apple")
+			},
+			msg: exchange.MsgMarketTransferCommitmentRequest{
+				Admin: s.addr1.String(), Account: s.addr2.String(), Amount: sdk.Coins{sdk.Coin{Denom: "apple", Amount: sdkmath.NewInt(-30)}}, CurrentMarketId: 1, NewMarketId: 5,
+			},
+			expInErr: []string{invReqErr, fmt.Sprintf("cannot transfer negative commitment amount %q for %s in market %d", "-30apple", s.addr2, 1)},
+			fArgs: []expBalances{
+				{
+					addr:     s.addr2,
+					expBal:   s.coins("100apple"),
+					expHold:  s.coins("40apple"),
+					expSpend: s.coins("40apple"),
+				},
+			},
+		},
+		{
+			name: "partial transfer with same denom",
+			setup: func() {
+				s.requireFundAccount(s.addr2, "500cherry")
+				s.requireSetCommitmentAmount(1, s.addr2, "500cherry")
+				s.requireCreateMarket(exchange.Market{
+					MarketId:             1,
+					AccessGrants:         []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
+					AcceptingCommitments: true,
+				})
+				s.requireCreateMarket(exchange.Market{
+					MarketId:             2,
+					AccessGrants:         []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
+					AcceptingCommitments: true,
+				})
+
+			},
+			msg: exchange.MsgMarketTransferCommitmentRequest{
+				Admin: s.addr1.String(), Account: s.addr2.String(), Amount: s.coins("100cherry"), CurrentMarketId: 1, NewMarketId: 2, EventTag: "partial transfer with same denom",
+			},
+			expEvents: []sdk.Event{
+				s.eventCommitmentReleased(
+					s.addr2,
+					1,
+					s.coins("100cherry").String(),
+					"partial transfer with same denom",
+				),
+				s.eventFundsCommitted(
+					s.addr2,
+					2,
+					s.coins("100cherry").String(),
+					"partial transfer with same denom",
+				),
+			},
+			fArgs: []expBalances{
+				{
+					addr:     s.addr2,
+					expBal:   s.coins("500cherry"),
+					expHold:  s.coins("500cherry"),
+					expSpend: s.coins("0cherry"),
+				},
+			},
+		},
+		{
+			name: "full transfer with same denom",
+			setup: func() {
+				s.requireFundAccount(s.addr2, "500cherry")
+				s.requireSetCommitmentAmount(1, s.addr2, "500cherry")
+				s.requireCreateMarket(exchange.Market{
+					MarketId:             1,
+					AccessGrants:         []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
+					AcceptingCommitments: true,
+				})
+				s.requireCreateMarket(exchange.Market{
+					MarketId:             2,
+					AccessGrants:         []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
+					AcceptingCommitments: true,
+				})
+
+			},
+			msg: exchange.MsgMarketTransferCommitmentRequest{
+				Admin: s.addr1.String(), Account: s.addr2.String(), Amount: s.coins("500cherry"), CurrentMarketId: 1, NewMarketId: 2, EventTag: "full transfer with same denom",
+			},
+			expEvents: []sdk.Event{
+				s.eventCommitmentReleased(
+					s.addr2,
+					1,
+					s.coins("500cherry").String(),
+					"full transfer with same denom",
+				),
+				s.eventFundsCommitted(
+					s.addr2,
+					2,
+					s.coins("500cherry").String(),
+					"full transfer with same denom",
+				),
+			},
+			fArgs: []expBalances{
+				{
+					addr:     s.addr2,
+					expBal:   s.coins("500cherry"),
+					expHold:  s.coins("500cherry"),
+					expSpend: s.coins("0cherry"),
+				},
+			},
+		},
+		{
+			name: "partial transfer with multiple denom",
+			setup: func() {
+				s.requireFundAccount(s.addr2, "500cherry,100apple")
+				s.requireSetCommitmentAmount(1, s.addr2, "500cherry,100apple")
+				s.requireCreateMarket(exchange.Market{
+					MarketId:             1,
+					AccessGrants:         []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
+					AcceptingCommitments: true,
+				})
+				s.requireCreateMarket(exchange.Market{
+					MarketId:             2,
+					AccessGrants:         []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
+					AcceptingCommitments: true,
+				})
+
+			},
+			msg: exchange.MsgMarketTransferCommitmentRequest{
+				Admin: s.addr1.String(), Account: s.addr2.String(), Amount: s.coins("10cherry,29apple"), CurrentMarketId: 1, NewMarketId: 2, EventTag: "partial transfer with multiple denom",
+			},
+			expEvents: []sdk.Event{
+				s.eventCommitmentReleased(
+					s.addr2,
+					1,
+					s.coins("10cherry,29apple").String(),
+					"partial transfer with multiple denom",
+				),
+				s.eventFundsCommitted(
+					s.addr2,
+					2,
+					s.coins("10cherry,29apple").String(),
+					"partial transfer with multiple denom",
+				),
+			},
+			fArgs: []expBalances{
+				{
+					addr:     s.addr2,
+					expBal:   s.coins("500cherry,100apple"),
+					expHold:  s.coins("500cherry,100apple"),
+					expSpend: s.coins("0cherry,0apple"),
+				},
+			},
+		},
+		{
+			name: "full transfer with multiple denom",
+			setup: func() {
+				s.requireFundAccount(s.addr2, "500cherry,100apple")
+				s.requireSetCommitmentAmount(1, s.addr2, "500cherry,100apple")
+				s.requireCreateMarket(exchange.Market{
+					MarketId:             1,
+					AccessGrants:         []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
+					AcceptingCommitments: true,
+				})
+				s.requireCreateMarket(exchange.Market{
+					MarketId:             2,
+					AccessGrants:         []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
+					AcceptingCommitments: true,
+				})
+
+			},
+			msg: exchange.MsgMarketTransferCommitmentRequest{
+				Admin: s.addr1.String(), Account: s.addr2.String(), Amount: s.coins("500cherry,100apple"), CurrentMarketId: 1, NewMarketId: 2, EventTag: "full transfer with multiple denom",
+			},
+			expEvents: []sdk.Event{
+				s.eventCommitmentReleased(
+					s.addr2,
+					1,
+					s.coins("500cherry,100apple").String(),
+					"full transfer with multiple denom",
+				),
+				s.eventFundsCommitted(
+					s.addr2,
+					2,
+					s.coins("500cherry,100apple").String(),
+					"full transfer with multiple denom",
+				),
+			},
+			fArgs: []expBalances{
+				{
+					addr:     s.addr2,
+					expBal:   s.coins("500cherry,100apple"),
+					expHold:  s.coins("500cherry,100apple"),
+					expSpend: s.coins("0cherry,0apple"),
+				},
+			},
+		},
+		{
+			name: "authority from admin",
+			setup: func() {
+				s.requireFundAccount(s.addr2, "500cherry")
+				s.requireSetCommitmentAmount(1, s.addr2, "500cherry")
+				s.requireCreateMarket(exchange.Market{
+					MarketId:             1,
+					AccessGrants:         []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
+					AcceptingCommitments: true,
+				})
+				s.requireCreateMarket(exchange.Market{
+					MarketId:             2,
+					AccessGrants:         []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
+					AcceptingCommitments: true,
+				})
+
+			},
+			msg: exchange.MsgMarketTransferCommitmentRequest{
+				Admin: s.k.GetAuthority(), Account: s.addr2.String(), Amount: s.coins("500cherry"), CurrentMarketId: 1, NewMarketId: 2, EventTag: "authadmin",
+			},
+			expEvents: []sdk.Event{
+				s.eventCommitmentReleased(
+					s.addr2,
+					1,
+					s.coins("500cherry").String(),
+					"authadmin",
+				),
+				s.eventFundsCommitted(
+					s.addr2,
+					2,
+					s.coins("500cherry").String(),
+					"authadmin",
+				),
+			},
+			fArgs: []expBalances{
+				{
+					addr:     s.addr2,
+					expBal:   s.coins("500cherry"),
+					expHold:  s.coins("500cherry"),
+					expSpend: s.coins("0cherry"),
+				},
+			},
+		},
+	}
 
-				// Asset transfers (4-9, 10-13)
-				s.eventCoinSpent(s.addr3, "11apple"),
-				s.eventMessageSender(s.addr3),
-				s.eventCoinReceived(s.addr2, "10apple"),
-				s.eventCoinReceived(s.addr4, "1apple"),
-				s.eventTransfer(s.addr2, s.addr3, "10apple"),
-				s.eventTransfer(s.addr4, s.addr3, "1apple"),
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runMsgServerTestCase(s, testDef, tc)
+		})
+	}
+}
 
-				s.eventCoinSpent(s.addr1, "7apple"),
-				s.eventCoinReceived(s.addr4, "7apple"),
-				s.eventTransfer(s.addr4, s.addr1, "7apple"),
-				s.eventMessageSender(s.addr1),
+func (s *TestSuite) TestMsgServer_MarketDistributeCommitmentRewards() {
+	testDef := msgServerTestDef[exchange.MsgMarketDistributeCommitmentRewardsRequest, exchange.MsgMarketDistributeCommitmentRewardsResponse, string]{
+		endpointName: "MarketDistributeCommitmentRewards",
+		endpoint:     keeper.NewMsgServer(s.k).MarketDistributeCommitmentRewards,
+		expResp:      &exchange.MsgMarketDistributeCommitmentRewardsResponse{},
+		followup: func(msg *exchange.MsgMarketDistributeCommitmentRewardsRequest, expPool string) {
+			actPool := s.k.GetCommitmentRewardPool(s.ctx, msg.MarketId)
+			s.Assert().Equal(expPool, actPool.String(), "GetCommitmentRewardPool(%d)", msg.MarketId)
+		},
+	}
 
-				// Price transfers (14-17, 18-23)
-				s.eventCoinSpent(s.addr2, "100pear"),
-				s.eventCoinReceived(s.addr3, "100pear"),
-				s.eventTransfer(s.addr3, s.addr2, "100pear"),
-				s.eventMessageSender(s.addr2),
+	tests := []msgServerTestCase[exchange.MsgMarketDistributeCommitmentRewardsRequest, string]{
+		{
+			name: "admin does not have permission to distribute",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId:     1,
+					AccessGrants: []exchange.AccessGrant{s.agCanAllBut(s.addr1, exchange.Permission_settle)},
+				})
+				keeper.SetCommitmentRewardPool(s.getStore(), 1, s.coins("100cherry"))
+				s.requireFundAccount(s.addr2, "50apple")
+				s.requireSetCommitmentAmount(1, s.addr2, "50apple")
+			},
+			msg: exchange.MsgMarketDistributeCommitmentRewardsRequest{
+				Admin: s.addr1.String(), MarketId: 1, Amount: s.coins("100cherry"), BasisDenom: "apple",
+			},
+			expInErr: []string{invReqErr,
+				"account " + s.addr1.String() + " does not have permission to distribute commitment rewards for market 1"},
+			fArgs: "100cherry",
+		},
+		{
+			name: "error distributing",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId:     1,
+					AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_settle)},
+				})
+				keeper.SetCommitmentRewardPool(s.getStore(), 1, s.coins("100cherry"))
+				s.requireFundAccount(s.addr2, "50banana")
+				s.requireSetCommitmentAmount(1, s.addr2, "50banana")
+			},
+			msg: exchange.MsgMarketDistributeCommitmentRewardsRequest{
+				Admin: s.addr1.String(), MarketId: 1, Amount: s.coins("100cherry"), BasisDenom: "apple",
+			},
+			expInErr: []string{invReqErr, "no \"apple\" committed to market 1"},
+			fArgs:    "100cherry",
+		},
+		{
+			name: "rewards distributed",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId:     1,
+					AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_settle)},
+				})
+				keeper.SetCommitmentRewardPool(s.getStore(), 1, s.coins("100cherry"))
+				s.ctx = s.ctx.WithBlockTime(time.Unix(1_700_000_000, 0).UTC())
+				s.requireFundAccount(s.addr2, "30apple")
+				s.requireSetCommitmentAmount(1, s.addr2, "30apple")
+				s.requireFundAccount(s.addr3, "10apple")
+				s.requireSetCommitmentAmount(1, s.addr3, "10apple")
+				s.ctx = s.ctx.WithBlockTime(time.Unix(1_700_003_600, 0).UTC())
+			},
+			msg: exchange.MsgMarketDistributeCommitmentRewardsRequest{
+				Admin: s.addr1.String(), MarketId: 1, Amount: s.coins("80cherry"), BasisDenom: "apple",
+			},
+			expEvents: sdk.Events{
+				s.untypeEvent(exchange.NewEventCommitmentRewardsDistributed(1, s.coins("80cherry"), "apple", s.addr1.String())),
+			},
+			fArgs: "20cherry",
+		},
+	}
 
-				s.eventCoinSpent(s.addr4, "85pear"),
-				s.eventMessageSender(s.addr4),
-				s.eventCoinReceived(s.addr3, "9pear"),
-				s.eventCoinReceived(s.addr1, "76pear"),
-				s.eventTransfer(s.addr3, s.addr4, "9pear"),
-				s.eventTransfer(s.addr1, s.addr4, "76pear"),
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runMsgServerTestCase(s, testDef, tc)
+		})
+	}
+}
 
-				// Orders filled (24-27)
-				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 333, Assets: "11apple", Price: "109pear", MarketId: 1,
-				}),
-				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 1, Assets: "7apple", Price: "76pear", MarketId: 1,
-				}),
-				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 22, Assets: "10apple", Price: "100pear", MarketId: 1,
-				}),
-				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 4444, Assets: "8apple", Price: "85pear", MarketId: 1,
-				}),
+func (s *TestSuite) TestMsgServer_MarketBulkCancel() {
+	testDef := msgServerTestDef[exchange.MsgMarketBulkCancelRequest, exchange.MsgMarketBulkCancelResponse, []uint64]{
+		endpointName: "MarketBulkCancel",
+		endpoint:     keeper.NewMsgServer(s.k).MarketBulkCancel,
+		expResp:      &exchange.MsgMarketBulkCancelResponse{CancelledOrderIds: []uint64{3, 7}, HasMore: true},
+		followup: func(_ *exchange.MsgMarketBulkCancelRequest, expLeft []uint64) {
+			for _, orderID := range expLeft {
+				order, err := s.k.GetOrder(s.ctx, orderID)
+				s.Assert().NoError(err, "GetOrder(%d) error", orderID)
+				s.Assert().NotNil(order, "GetOrder(%d) order", orderID)
+			}
+		},
+	}
 
-				// The net-asset-value event (28).
-				s.markerNavSetEvent("18apple", "185pear", 1),
+	tests := []msgServerTestCase[exchange.MsgMarketBulkCancelRequest, []uint64]{
+		{
+			name: "admin does not have permission",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId:     2,
+					AccessGrants: []exchange.AccessGrant{s.agCanAllBut(s.addr5, exchange.Permission_cancel)},
+				})
 			},
+			msg: exchange.MsgMarketBulkCancelRequest{Admin: s.addr5.String(), MarketId: 2},
+			expInErr: []string{invReqErr,
+				"account " + s.addr5.String() + " does not have permission to cancel orders for market 2"},
 		},
 		{
-			name: "all addresses quarantined",
+			name: "okay: some asks",
 			setup: func() {
-				s.requireFundAccount(s.addr1, "7apple")
-				s.requireFundAccount(s.addr2, "100pear")
-				s.requireFundAccount(s.addr3, "11apple")
-				s.requireFundAccount(s.addr4, "85pear")
-
 				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId: 1, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_settle)},
+					MarketId:     2,
+					AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_cancel)},
 				})
-
 				store := s.getStore()
-				s.requireSetOrderInStore(store, exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
-					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("7apple"), Price: s.coin("75pear"),
+				s.requireSetOrderInStore(store, exchange.NewOrder(3).WithAsk(&exchange.AskOrder{
+					MarketId: 2, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("1pear"),
 				}))
-				s.requireAddHold(s.addr1, "7apple", 1)
-				s.requireSetOrderInStore(store, exchange.NewOrder(22).WithBid(&exchange.BidOrder{
-					MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("100pear"),
+				s.requireSetOrderInStore(store, exchange.NewOrder(5).WithBid(&exchange.BidOrder{
+					MarketId: 2, Buyer: s.addr2.String(), Assets: s.coin("1apple"), Price: s.coin("1pear"),
 				}))
-				s.requireAddHold(s.addr2, "100pear", 22)
-				s.requireSetOrderInStore(store, exchange.NewOrder(333).WithAsk(&exchange.AskOrder{
-					MarketId: 1, Seller: s.addr3.String(), Assets: s.coin("11apple"), Price: s.coin("105pear"),
+				s.requireSetOrderInStore(store, exchange.NewOrder(7).WithAsk(&exchange.AskOrder{
+					MarketId: 2, Seller: s.addr2.String(), Assets: s.coin("2apple"), Price: s.coin("2pear"),
 				}))
-				s.requireAddHold(s.addr3, "11apple", 333)
-				s.requireSetOrderInStore(store, exchange.NewOrder(4444).WithBid(&exchange.BidOrder{
-					MarketId: 1, Buyer: s.addr4.String(), Assets: s.coin("8apple"), Price: s.coin("85pear"),
+				s.requireSetOrderInStore(store, exchange.NewOrder(9).WithAsk(&exchange.AskOrder{
+					MarketId: 2, Seller: s.addr1.String(), Assets: s.coin("3apple"), Price: s.coin("3pear"),
 				}))
-				s.requireAddHold(s.addr4, "85pear", 4444)
-
-				s.requireQuarantineOptIn(s.addr1)
-				s.requireQuarantineOptIn(s.addr2)
-				s.requireQuarantineOptIn(s.addr3)
-				s.requireQuarantineOptIn(s.addr4)
-				s.requireQuarantineOptIn(s.addr5)
-			},
-			msg: exchange.MsgMarketSettleRequest{
-				Admin:       s.addr5.String(),
-				MarketId:    1,
-				AskOrderIds: []uint64{1, 333},
-				BidOrderIds: []uint64{4444, 22},
+				s.requireFundAccount(s.addr1, "10apple")
+				s.requireFundAccount(s.addr2, "10apple,10pear")
+				s.requireAddHold(s.addr1, "4apple", 3)
+				s.requireAddHold(s.addr2, "1pear,2apple", 5)
 			},
-			fArgs: followupArgs{
-				expBals: []expBalances{
-					{
-						addr:    s.addr1,
-						expBal:  []sdk.Coin{s.zeroCoin("apple"), s.coin("77pear")},
-						expHold: s.zeroCoins("apple", "pear"),
-					},
-					{
-						addr:    s.addr2,
-						expBal:  []sdk.Coin{s.coin("10apple"), s.zeroCoin("pear")},
-						expHold: s.zeroCoins("apple", "pear"),
-					},
-					{
-						addr:    s.addr3,
-						expBal:  []sdk.Coin{s.zeroCoin("apple"), s.coin("108pear")},
-						expHold: s.zeroCoins("apple", "pear"),
-					},
-					{
-						addr:    s.addr4,
-						expBal:  []sdk.Coin{s.coin("8apple"), s.zeroCoin("pear")},
-						expHold: s.zeroCoins("apple", "pear"),
-					},
-				},
+			msg: exchange.MsgMarketBulkCancelRequest{
+				Admin: s.addr5.String(), MarketId: 2, OrderType: exchange.OrderTypeAsk, Limit: 2,
 			},
+			fArgs: []uint64{5, 9},
 			expEvents: sdk.Events{
-				// Hold releases
-				s.eventHoldReleased(s.addr1, "7apple"),
-				s.eventHoldReleased(s.addr3, "11apple"),
-				s.eventHoldReleased(s.addr4, "85pear"),
-				s.eventHoldReleased(s.addr2, "100pear"),
-
-				// Asset transfers
-				s.eventCoinSpent(s.addr1, "7apple"),
-				s.eventCoinReceived(s.addr4, "7apple"),
-				s.eventTransfer(s.addr4, s.addr1, "7apple"),
-				s.eventMessageSender(s.addr1),
-
-				s.eventCoinSpent(s.addr3, "11apple"),
-				s.eventMessageSender(s.addr3),
-				s.eventCoinReceived(s.addr4, "1apple"),
-				s.eventCoinReceived(s.addr2, "10apple"),
-				s.eventTransfer(s.addr4, s.addr3, "1apple"),
-				s.eventTransfer(s.addr2, s.addr3, "10apple"),
-
-				// Price transfers
-				s.eventCoinSpent(s.addr4, "85pear"),
-				s.eventMessageSender(s.addr4),
-				s.eventCoinReceived(s.addr1, "75pear"),
-				s.eventCoinReceived(s.addr3, "10pear"),
-				s.eventTransfer(s.addr1, s.addr4, "75pear"),
-				s.eventTransfer(s.addr3, s.addr4, "10pear"),
-
-				s.eventCoinSpent(s.addr2, "100pear"),
-				s.eventMessageSender(s.addr2),
-				s.eventCoinReceived(s.addr3, "98pear"),
-				s.eventCoinReceived(s.addr1, "2pear"),
-				s.eventTransfer(s.addr3, s.addr2, "98pear"),
-				s.eventTransfer(s.addr1, s.addr2, "2pear"),
-
-				// Orders filled
-				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 1, Assets: "7apple", Price: "77pear", MarketId: 1,
-				}),
-				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 333, Assets: "11apple", Price: "108pear", MarketId: 1,
+				s.eventHoldReleased(s.addr1, "1apple"),
+				s.untypeEvent(&exchange.EventOrderCancelled{
+					OrderId: 3, CancelledBy: s.addr5.String(), MarketId: 2, ExternalId: "",
 				}),
-				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 4444, Assets: "8apple", Price: "85pear", MarketId: 1,
+				s.eventHoldReleased(s.addr2, "2apple"),
+				s.untypeEvent(&exchange.EventOrderCancelled{
+					OrderId: 7, CancelledBy: s.addr5.String(), MarketId: 2, ExternalId: "",
 				}),
-				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 22, Assets: "10apple", Price: "100pear", MarketId: 1,
+				s.untypeEvent(&exchange.EventMarketOrdersBulkCancelled{
+					MarketId: 2, CancelledBy: s.addr5.String(), Count: 2, HasMore: true,
 				}),
+			},
+		},
+	}
 
-				// The net-asset-value event.
-				s.markerNavSetEvent("18apple", "185pear", 1),
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runMsgServerTestCase(s, testDef, tc)
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_MarketSetOrderExternalID() {
+	type followupArgs struct{}
+	testDef := msgServerTestDef[exchange.MsgMarketSetOrderExternalIDRequest, exchange.MsgMarketSetOrderExternalIDResponse, followupArgs]{
+		endpointName: "MarketSetOrderExternalID",
+		endpoint:     keeper.NewMsgServer(s.k).MarketSetOrderExternalID,
+		expResp:      &exchange.MsgMarketSetOrderExternalIDResponse{},
+		followup: func(msg *exchange.MsgMarketSetOrderExternalIDRequest, _ followupArgs) {
+			order, err := s.k.GetOrder(s.ctx, msg.OrderId)
+			s.Assert().NoError(err, "GetOrder(%d) error", msg.OrderId)
+			if s.Assert().NotNil(order, "GetOrder(%d) order", msg.OrderId) {
+				s.Assert().Equal(msg.ExternalId, order.GetExternalID(), "GetOrder(%d) order ExternalID", msg.OrderId)
+			}
+		},
+	}
+
+	tests := []msgServerTestCase[exchange.MsgMarketSetOrderExternalIDRequest, followupArgs]{
+		{
+			name: "admin does not have permission",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId:     1,
+					AccessGrants: []exchange.AccessGrant{s.agCanAllBut(s.addr5, exchange.Permission_set_ids)},
+				})
+			},
+			msg: exchange.MsgMarketSetOrderExternalIDRequest{
+				Admin: s.addr5.String(), MarketId: 1, OrderId: 1, ExternalId: "bananas",
+			},
+			expInErr: []string{invReqErr,
+				"account " + s.addr5.String() + " does not have permission to set external ids on orders for market 1"},
+		},
+		{
+			name: "order does not exist",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 1, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_set_ids)},
+				})
+			},
+			msg: exchange.MsgMarketSetOrderExternalIDRequest{
+				Admin: s.addr5.String(), MarketId: 1, OrderId: 1, ExternalId: "bananas",
 			},
+			expInErr: []string{invReqErr, "order 1 not found"},
 		},
 		{
-			name: "one ask, one bid, partial ask",
+			name: "okay: nothing to something",
 			setup: func() {
-				s.requireFundAccount(s.addr1, "10apple")
-				s.requireFundAccount(s.addr2, "75pear")
-
 				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_settle)},
+					MarketId: 1, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_set_ids)},
 				})
-
-				store := s.getStore()
-				s.requireSetOrderInStore(store, exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
-					MarketId: 3, Seller: s.addr1.String(), Assets: s.coin("10apple"), Price: s.coin("100pear"),
-					AllowPartial: true,
-				}))
-				s.requireAddHold(s.addr1, "10apple", 1)
-				s.requireSetOrderInStore(store, exchange.NewOrder(22).WithBid(&exchange.BidOrder{
-					MarketId: 3, Buyer: s.addr2.String(), Assets: s.coin("7apple"), Price: s.coin("75pear"),
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(7).WithAsk(&exchange.AskOrder{
+					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("1pear"),
+					ExternalId: "",
 				}))
-				s.requireAddHold(s.addr2, "75pear", 22)
-			},
-			msg: exchange.MsgMarketSettleRequest{
-				Admin:         s.addr5.String(),
-				MarketId:      3,
-				AskOrderIds:   []uint64{1},
-				BidOrderIds:   []uint64{22},
-				ExpectPartial: true,
 			},
-			fArgs: followupArgs{
-				expBals: []expBalances{
-					{
-						addr:    s.addr1,
-						expBal:  s.coins("3apple,75pear"),
-						expHold: []sdk.Coin{s.coin("3apple"), s.zeroCoin("pear")},
-					},
-					{
-						addr:    s.addr2,
-						expBal:  []sdk.Coin{s.coin("7apple"), s.zeroCoin("pear")},
-						expHold: s.zeroCoins("apple", "pear"),
-					},
-				},
-				partialLeft: exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
-					MarketId: 3, Seller: s.addr1.String(), Assets: s.coin("3apple"), Price: s.coin("30pear"),
-					AllowPartial: true,
-				}),
+			msg: exchange.MsgMarketSetOrderExternalIDRequest{
+				Admin: s.addr5.String(), MarketId: 1, OrderId: 7, ExternalId: "bananas",
 			},
 			expEvents: sdk.Events{
-				// Hold releases
-				s.eventHoldReleased(s.addr2, "75pear"),
-				s.eventHoldReleased(s.addr1, "7apple"),
-
-				// Asset transfer
-				s.eventCoinSpent(s.addr1, "7apple"),
-				s.eventCoinReceived(s.addr2, "7apple"),
-				s.eventTransfer(s.addr2, s.addr1, "7apple"),
-				s.eventMessageSender(s.addr1),
-
-				// Price transfer
-				s.eventCoinSpent(s.addr2, "75pear"),
-				s.eventCoinReceived(s.addr1, "75pear"),
-				s.eventTransfer(s.addr1, s.addr2, "75pear"),
-				s.eventMessageSender(s.addr2),
-
-				// Orders filled
-				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 22, Assets: "7apple", Price: "75pear", MarketId: 3,
-				}),
-				// Partial fill
-				s.untypeEvent(&exchange.EventOrderPartiallyFilled{
-					OrderId: 1, Assets: "7apple", Price: "75pear", MarketId: 3,
+				s.untypeEvent(&exchange.EventOrderExternalIDUpdated{
+					OrderId:    7,
+					MarketId:   1,
+					ExternalId: "bananas",
 				}),
-
-				// The net-asset-value event.
-				s.markerNavSetEvent("7apple", "75pear", 3),
 			},
 		},
 		{
-			name: "one ask, one bid, partial bid",
+			name: "okay: something to something else",
 			setup: func() {
-				s.requireFundAccount(s.addr1, "7apple")
-				s.requireFundAccount(s.addr2, "100pear")
-
 				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_settle)},
+					MarketId: 1, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_set_ids)},
 				})
-
-				store := s.getStore()
-				s.requireSetOrderInStore(store, exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
-					MarketId: 3, Seller: s.addr1.String(), Assets: s.coin("7apple"), Price: s.coin("65pear"),
-				}))
-				s.requireAddHold(s.addr1, "7apple", 1)
-				s.requireSetOrderInStore(store, exchange.NewOrder(22).WithBid(&exchange.BidOrder{
-					MarketId: 3, Buyer: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("100pear"),
-					AllowPartial: true,
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(7).WithBid(&exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("1pear"),
+					ExternalId: "something",
 				}))
-				s.requireAddHold(s.addr2, "100pear", 22)
 			},
-			msg: exchange.MsgMarketSettleRequest{
-				Admin:         s.addr5.String(),
-				MarketId:      3,
-				AskOrderIds:   []uint64{1},
-				BidOrderIds:   []uint64{22},
-				ExpectPartial: true,
+			msg: exchange.MsgMarketSetOrderExternalIDRequest{
+				Admin: s.addr5.String(), MarketId: 1, OrderId: 7, ExternalId: "bananas",
 			},
-			fArgs: followupArgs{
-				expBals: []expBalances{
-					{
-						addr:    s.addr1,
-						expBal:  []sdk.Coin{s.zeroCoin("apple"), s.coin("70pear")},
-						expHold: s.zeroCoins("apple", "pear"),
-					},
-					{
-						addr:    s.addr2,
-						expBal:  []sdk.Coin{s.coin("7apple"), s.coin("30pear")},
-						expHold: []sdk.Coin{s.zeroCoin("apple"), s.coin("30pear")},
-					},
-				},
-				partialLeft: exchange.NewOrder(22).WithBid(&exchange.BidOrder{
-					MarketId: 3, Buyer: s.addr2.String(), Assets: s.coin("3apple"), Price: s.coin("30pear"),
-					AllowPartial: true,
+			expEvents: sdk.Events{
+				s.untypeEvent(&exchange.EventOrderExternalIDUpdated{
+					OrderId:    7,
+					MarketId:   1,
+					ExternalId: "bananas",
 				}),
 			},
-			expEvents: sdk.Events{
-				// Hold releases
-				s.eventHoldReleased(s.addr1, "7apple"),
-				s.eventHoldReleased(s.addr2, "70pear"),
-
-				// Asset transfer
-				s.eventCoinSpent(s.addr1, "7apple"),
-				s.eventCoinReceived(s.addr2, "7apple"),
-				s.eventTransfer(s.addr2, s.addr1, "7apple"),
-				s.eventMessageSender(s.addr1),
+		},
+	}
 
-				// Price transfer
-				s.eventCoinSpent(s.addr2, "70pear"),
-				s.eventCoinReceived(s.addr1, "70pear"),
-				s.eventTransfer(s.addr1, s.addr2, "70pear"),
-				s.eventMessageSender(s.addr2),
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runMsgServerTestCase(s, testDef, tc)
+		})
+	}
+}
 
-				// Orders filled
-				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 1, Assets: "7apple", Price: "70pear", MarketId: 3,
-				}),
-				// Partial fill
-				s.untypeEvent(&exchange.EventOrderPartiallyFilled{
-					OrderId: 22, Assets: "7apple", Price: "70pear", MarketId: 3,
-				}),
+func (s *TestSuite) TestMsgServer_MarketWithdraw() {
+	testDef := msgServerTestDef[exchange.MsgMarketWithdrawRequest, exchange.MsgMarketWithdrawResponse, []expBalances]{
+		endpointName: "MarketWithdraw",
+		endpoint:     keeper.NewMsgServer(s.k).MarketWithdraw,
+		expResp:      &exchange.MsgMarketWithdrawResponse{},
+		followup: func(_ *exchange.MsgMarketWithdrawRequest, fArgs []expBalances) {
+			for _, eb := range fArgs {
+				s.checkBalances(eb)
+			}
+		},
+	}
 
-				// The net-asset-value event.
-				s.markerNavSetEvent("7apple", "70pear", 3),
+	tests := []msgServerTestCase[exchange.MsgMarketWithdrawRequest, []expBalances]{
+		{
+			name: "admin does not have permission to withdraw",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId:     1,
+					AccessGrants: []exchange.AccessGrant{s.agCanAllBut(s.addr5, exchange.Permission_withdraw)},
+				})
 			},
+			msg: exchange.MsgMarketWithdrawRequest{
+				Admin: s.addr5.String(), MarketId: 1, ToAddress: s.addr1.String(), Amount: s.coins("100fig"),
+			},
+			expInErr: []string{invReqErr,
+				"account " + s.addr5.String() + " does not have permission to withdraw from market 1"},
 		},
 		{
-			name: "fee in restricted denom",
+			name: "insufficient funds in market",
 			setup: func() {
-				s.requireAddFinalizeAndActivateMarker(s.coin("185pear"), s.addr5, "*.have.it")
-				s.requireAddFinalizeAndActivateMarker(s.coin("18apple"), s.addr5, "*.have.it")
-				s.requireSetNameRecord("buyer.have.it", s.addr5)
-				s.requireSetNameRecord("seller.have.it", s.addr5)
-				s.requireSetNameRecord("market.have.it", s.addr5)
-				s.requireSetAttr(s.addr1, "seller.have.it", s.addr5)
-				s.requireSetAttr(s.addr2, "buyer.have.it", s.addr5)
-				s.requireSetAttr(s.addr3, "seller.have.it", s.addr5)
-				s.requireSetAttr(s.addr4, "buyer.have.it", s.addr5)
-				s.requireSetAttr(s.marketAddr2, "market.have.it", s.addr5)
-				s.requireFundAccount(s.addr1, "20apple,100pear,100fig")
-				s.requireFundAccount(s.addr2, "20apple,100pear,100fig")
-				s.requireFundAccount(s.addr3, "20apple,100pear,100fig")
-				s.requireFundAccount(s.addr4, "20apple,100pear,100fig")
-
 				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId: 2, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_settle)},
-					FeeSellerSettlementRatios: s.ratios("10pear:1pear"),
+					MarketId:     1,
+					AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_withdraw)},
 				})
+				s.requireFundAccount(s.marketAddr1, "100apple,99pear,100fig")
+			},
+			msg: exchange.MsgMarketWithdrawRequest{
+				Admin: s.addr5.String(), MarketId: 1, ToAddress: s.addr1.String(), Amount: s.coins("3apple,100pear,50fig"),
+			},
+			expInErr: []string{invReqErr, "spendable balance 99pear is smaller than 100pear", "insufficient funds"},
+		},
+		{
+			name: "destination does not have req attrs",
+			setup: func() {
+				s.requireAddFinalizeAndActivateMarker(s.coin("105apple"), s.addr5, "*.apple.what.what")
+				s.requireAddFinalizeAndActivateMarker(s.coin("105pear"), s.addr5, "*.pear.what.what")
+				s.requireSetNameRecord("nut.apple.what.what", s.addr5)
+				s.requireSetNameRecord("nut.pear.what.what", s.addr5)
+				s.requireSetAttr(s.marketAddr1, "nut.apple.what.what", s.addr5)
+				s.requireSetAttr(s.marketAddr1, "nut.pear.what.what", s.addr5)
+				s.requireSetAttr(s.addr1, "nut.apple.what.what", s.addr5)
 
-				store := s.getStore()
-				s.requireSetOrderInStore(store, exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
-					MarketId: 2, Seller: s.addr1.String(), Assets: s.coin("7apple"), Price: s.coin("75pear"),
-					SellerSettlementFlatFee: s.coinP("10fig"),
-				}))
-				s.requireAddHold(s.addr1, "7apple,10fig", 1)
-				s.requireSetOrderInStore(store, exchange.NewOrder(22).WithBid(&exchange.BidOrder{
-					MarketId: 2, Buyer: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("100pear"),
-					BuyerSettlementFees: s.coins("20fig"),
-				}))
-				s.requireAddHold(s.addr2, "100pear,20fig", 22)
-				s.requireSetOrderInStore(store, exchange.NewOrder(333).WithAsk(&exchange.AskOrder{
-					MarketId: 2, Seller: s.addr3.String(), Assets: s.coin("11apple"), Price: s.coin("105pear"),
-					SellerSettlementFlatFee: s.coinP("5pear"),
-				}))
-				s.requireAddHold(s.addr3, "11apple", 333)
-				s.requireSetOrderInStore(store, exchange.NewOrder(4444).WithBid(&exchange.BidOrder{
-					MarketId: 2, Buyer: s.addr4.String(), Assets: s.coin("8apple"), Price: s.coin("85pear"),
-					BuyerSettlementFees: s.coins("10pear"),
-				}))
-				s.requireAddHold(s.addr4, "95pear", 4444)
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId:     1,
+					AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr4, exchange.Permission_withdraw)},
+				})
+				s.requireFundAccount(s.marketAddr1, "100apple,100pear,100fig")
+			},
+			msg: exchange.MsgMarketWithdrawRequest{
+				Admin: s.addr4.String(), MarketId: 1, ToAddress: s.addr1.String(), Amount: s.coins("3apple,100pear,50fig"),
+			},
+			expInErr: []string{invReqErr, "failed to withdraw 3apple,50fig,100pear from market 1",
+				"address " + s.addr1.String() + " does not contain the \"pear\" required attribute: \"*.pear.what.what\""},
+		},
+		{
+			name: "destination does not have req attrs but admin has transfer",
+			setup: func() {
+				s.requireAddFinalizeAndActivateMarker(s.coin("105apple"), s.addr5, "*.apple.what.what")
+				s.requireAddFinalizeAndActivateMarker(s.coin("105pear"), s.addr5, "*.pear.what.what")
+				s.requireSetNameRecord("nut.apple.what.what", s.addr5)
+				s.requireSetNameRecord("nut.pear.what.what", s.addr5)
+				s.requireSetAttr(s.marketAddr1, "nut.apple.what.what", s.addr5)
+				s.requireSetAttr(s.marketAddr1, "nut.pear.what.what", s.addr5)
+				s.requireSetAttr(s.addr1, "nut.apple.what.what", s.addr5)
+
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId:     1,
+					AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_withdraw)},
+				})
+				s.requireFundAccount(s.marketAddr1, "100apple,100pear,100fig")
 			},
-			msg: exchange.MsgMarketSettleRequest{
-				Admin:       s.addr5.String(),
-				MarketId:    2,
-				AskOrderIds: []uint64{1, 333},
-				BidOrderIds: []uint64{22, 4444},
+			msg: exchange.MsgMarketWithdrawRequest{
+				Admin: s.addr5.String(), MarketId: 1, ToAddress: s.addr1.String(), Amount: s.coins("3apple,100pear,50fig"),
 			},
-			expInErr: []string{invReqErr,
-				"error collecting exchange fee 2fig,2pear (based off 30fig,34pear) from market 2",
-				"restricted denom pear cannot be sent to the fee collector",
+			fArgs: []expBalances{
+				{addr: s.marketAddr1, expBal: []sdk.Coin{s.coin("97apple"), s.zeroCoin("pear"), s.coin("50fig")}},
+				{addr: s.addr1, expBal: s.coins("3apple,100pear,50fig")},
+			},
+			expEvents: sdk.Events{
+				s.eventCoinSpent(s.marketAddr1, "3apple,50fig,100pear"),
+				s.eventCoinReceived(s.addr1, "3apple,50fig,100pear"),
+				s.eventTransfer(s.addr1, s.marketAddr1, "3apple,50fig,100pear"),
+				s.eventMessageSender(s.marketAddr1),
+				s.untypeEvent(&exchange.EventMarketWithdraw{
+					MarketId:    1,
+					Amount:      "3apple,50fig,100pear",
+					Destination: s.addr1.String(),
+					WithdrawnBy: s.addr5.String(),
+				}),
 			},
 		},
 		{
-			name: "two of each with fees and req attrs",
+			name: "okay",
 			setup: func() {
-				s.requireAddFinalizeAndActivateCoinMarker(s.coin("185pear"), s.addr5)
-				s.requireAddFinalizeAndActivateMarker(s.coin("18apple"), s.addr5, "*.have.it")
-				s.requireSetNameRecord("buyer.have.it", s.addr5)
-				s.requireSetNameRecord("seller.have.it", s.addr5)
-				s.requireSetNameRecord("market.have.it", s.addr5)
-				s.requireSetAttr(s.addr1, "seller.have.it", s.addr5)
-				s.requireSetAttr(s.addr2, "buyer.have.it", s.addr5)
-				s.requireSetAttr(s.addr3, "seller.have.it", s.addr5)
-				s.requireSetAttr(s.addr4, "buyer.have.it", s.addr5)
-				s.requireSetAttr(s.marketAddr2, "market.have.it", s.addr5)
-				s.requireFundAccount(s.addr1, "20apple,100pear,100fig")
-				s.requireFundAccount(s.addr2, "20apple,100pear,100fig")
-				s.requireFundAccount(s.addr3, "20apple,100pear,100fig")
-				s.requireFundAccount(s.addr4, "20apple,100pear,100fig")
+				s.requireAddFinalizeAndActivateMarker(s.coin("100apple"), s.addr5, "*.apple.what.what")
+				s.requireAddFinalizeAndActivateMarker(s.coin("100pear"), s.addr5, "*.pear.what.what")
+				s.requireSetNameRecord("nut.apple.what.what", s.addr5)
+				s.requireSetNameRecord("nut.pear.what.what", s.addr5)
+				s.requireSetAttr(s.marketAddr1, "nut.apple.what.what", s.addr5)
+				s.requireSetAttr(s.marketAddr1, "nut.pear.what.what", s.addr5)
+				s.requireSetAttr(s.addr1, "nut.apple.what.what", s.addr5)
+				s.requireSetAttr(s.addr1, "nut.pear.what.what", s.addr5)
 
 				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId: 2, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_settle)},
-					FeeSellerSettlementRatios: s.ratios("10pear:1pear"),
+					MarketId:     1,
+					AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_withdraw)},
 				})
-
-				store := s.getStore()
-				s.requireSetOrderInStore(store, exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
-					MarketId: 2, Seller: s.addr1.String(), Assets: s.coin("7apple"), Price: s.coin("75pear"),
-					SellerSettlementFlatFee: s.coinP("10fig"),
-				}))
-				s.requireAddHold(s.addr1, "7apple,10fig", 1)
-				s.requireSetOrderInStore(store, exchange.NewOrder(22).WithBid(&exchange.BidOrder{
-					MarketId: 2, Buyer: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("100pear"),
-					BuyerSettlementFees: s.coins("20fig"),
-				}))
-				s.requireAddHold(s.addr2, "100pear,20fig", 22)
-				s.requireSetOrderInStore(store, exchange.NewOrder(333).WithAsk(&exchange.AskOrder{
-					MarketId: 2, Seller: s.addr3.String(), Assets: s.coin("11apple"), Price: s.coin("105pear"),
-					SellerSettlementFlatFee: s.coinP("5pear"),
-				}))
-				s.requireAddHold(s.addr3, "11apple", 333)
-				s.requireSetOrderInStore(store, exchange.NewOrder(4444).WithBid(&exchange.BidOrder{
-					MarketId: 2, Buyer: s.addr4.String(), Assets: s.coin("8apple"), Price: s.coin("85pear"),
-					BuyerSettlementFees: s.coins("10pear"),
-				}))
-				s.requireAddHold(s.addr4, "95pear", 4444)
+				s.requireFundAccount(s.marketAddr1, "100apple,100pear,100fig")
+				s.requireFundAccount(s.addr1, "5apple,5pear")
 			},
-			msg: exchange.MsgMarketSettleRequest{
-				Admin:       s.addr5.String(),
-				MarketId:    2,
-				AskOrderIds: []uint64{1, 333},
-				BidOrderIds: []uint64{22, 4444},
+			msg: exchange.MsgMarketWithdrawRequest{
+				Admin: s.addr5.String(), MarketId: 1, ToAddress: s.addr1.String(), Amount: s.coins("3apple,100pear,50fig"),
 			},
-			fArgs: followupArgs{
-				expBals: []expBalances{
-					{
-						addr:    s.addr1,
-						expBal:  s.coins("13apple,169pear,90fig"),
-						expHold: s.zeroCoins("apple", "pear", "fig"),
-					},
-					{
-						addr:    s.addr2,
-						expBal:  []sdk.Coin{s.coin("30apple"), s.zeroCoin("pear"), s.coin("80fig")},
-						expHold: s.zeroCoins("apple", "pear", "fig"),
-					},
-					{
-						addr:    s.addr3,
-						expBal:  s.coins("9apple,192pear,100fig"),
-						expHold: s.zeroCoins("apple", "pear", "fig"),
-					},
-					{
-						addr:    s.addr4,
-						expBal:  s.coins("28apple,5pear,100fig"),
-						expHold: s.zeroCoins("apple", "pear", "fig"),
-					},
-					{
-						addr:   s.marketAddr2,
-						expBal: []sdk.Coin{s.zeroCoin("apple"), s.coin("32pear"), s.coin("28fig")},
-					},
-					{
-						addr:   s.feeCollectorAddr,
-						expBal: []sdk.Coin{s.zeroCoin("apple"), s.coin("2pear"), s.coin("2fig")},
-					},
+			fArgs: []expBalances{
+				{
+					addr:   s.marketAddr1,
+					expBal: []sdk.Coin{s.coin("97apple"), s.zeroCoin("pear"), s.coin("50fig")},
+				},
+				{
+					addr:   s.addr1,
+					expBal: s.coins("8apple,105pear,50fig"),
 				},
 			},
 			expEvents: sdk.Events{
-				// Hold releases
-				s.eventHoldReleased(s.addr1, "7apple,10fig"),
-				s.eventHoldReleased(s.addr3, "11apple"),
-				s.eventHoldReleased(s.addr2, "20fig,100pear"),
-				s.eventHoldReleased(s.addr4, "95pear"),
-
-				// Asset transfers
-				s.eventCoinSpent(s.addr1, "7apple"),
-				s.eventCoinReceived(s.addr2, "7apple"),
-				s.eventTransfer(s.addr2, s.addr1, "7apple"),
-				s.eventMessageSender(s.addr1),
-
-				s.eventCoinSpent(s.addr3, "11apple"),
-				s.eventMessageSender(s.addr3),
-				s.eventCoinReceived(s.addr2, "3apple"),
-				s.eventCoinReceived(s.addr4, "8apple"),
-				s.eventTransfer(s.addr2, s.addr3, "3apple"),
-				s.eventTransfer(s.addr4, s.addr3, "8apple"),
-
-				// Price transfers
-				s.eventCoinSpent(s.addr2, "100pear"),
-				s.eventMessageSender(s.addr2),
-				s.eventCoinReceived(s.addr1, "75pear"),
-				s.eventCoinReceived(s.addr3, "25pear"),
-				s.eventTransfer(s.addr1, s.addr2, "75pear"),
-				s.eventTransfer(s.addr3, s.addr2, "25pear"),
-
-				s.eventCoinSpent(s.addr4, "85pear"),
-				s.eventMessageSender(s.addr4),
-				s.eventCoinReceived(s.addr3, "83pear"),
-				s.eventCoinReceived(s.addr1, "2pear"),
-				s.eventTransfer(s.addr3, s.addr4, "83pear"),
-				s.eventTransfer(s.addr1, s.addr4, "2pear"),
-
-				// Fee transfers to market
-				s.eventCoinSpent(s.addr1, "10fig,8pear"),
-				s.eventMessageSender(s.addr1),
-				s.eventCoinSpent(s.addr3, "16pear"),
-				s.eventMessageSender(s.addr3),
-				s.eventCoinSpent(s.addr2, "20fig"),
-				s.eventMessageSender(s.addr2),
-				s.eventCoinSpent(s.addr4, "10pear"),
-				s.eventMessageSender(s.addr4),
-				s.eventCoinReceived(s.marketAddr2, "30fig,34pear"),
-				s.eventTransfer(s.marketAddr2, s.addr1, "10fig,8pear"),
-				s.eventTransfer(s.marketAddr2, s.addr3, "16pear"),
-				s.eventTransfer(s.marketAddr2, s.addr2, "20fig"),
-				s.eventTransfer(s.marketAddr2, s.addr4, "10pear"),
-
-				// Transfers of exchange portion of fees
-				s.eventCoinSpent(s.marketAddr2, "2fig,2pear"),
-				s.eventCoinReceived(s.feeCollectorAddr, "2fig,2pear"),
-				s.eventTransfer(s.feeCollectorAddr, s.marketAddr2, "2fig,2pear"),
-				s.eventMessageSender(s.marketAddr2),
-
-				// Orders filled
-				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 1, Assets: "7apple", Price: "77pear", MarketId: 2, Fees: "10fig,8pear",
-				}),
-				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 333, Assets: "11apple", Price: "108pear", MarketId: 2, Fees: "16pear",
-				}),
-				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 22, Assets: "10apple", Price: "100pear", MarketId: 2, Fees: "20fig",
-				}),
-				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 4444, Assets: "8apple", Price: "85pear", MarketId: 2, Fees: "10pear",
+				s.eventCoinSpent(s.marketAddr1, "3apple,50fig,100pear"),
+				s.eventCoinReceived(s.addr1, "3apple,50fig,100pear"),
+				s.eventTransfer(s.addr1, s.marketAddr1, "3apple,50fig,100pear"),
+				s.eventMessageSender(s.marketAddr1),
+				s.untypeEvent(&exchange.EventMarketWithdraw{
+					MarketId:    1,
+					Amount:      "3apple,50fig,100pear",
+					Destination: s.addr1.String(),
+					WithdrawnBy: s.addr5.String(),
 				}),
-
-				// The net-asset-value event.
-				s.markerNavSetEvent("18apple", "185pear", 2),
 			},
 		},
 	}
@@ -3110,192 +5376,77 @@ func (s *TestSuite) TestMsgServer_MarketSettle() {
 	}
 }
 
-func (s *TestSuite) TestMsgServer_MarketCommitmentSettle() {
-	testDef := msgServerTestDef[exchange.MsgMarketCommitmentSettleRequest, exchange.MsgMarketCommitmentSettleResponse, []expBalances]{
-		endpointName: "MarketCommitmentSettle",
-		endpoint:     keeper.NewMsgServer(s.k).MarketCommitmentSettle,
-		expResp:      &exchange.MsgMarketCommitmentSettleResponse{},
-		followup: func(_ *exchange.MsgMarketCommitmentSettleRequest, expBals []expBalances) {
-			for _, eb := range expBals {
-				s.checkBalances(eb)
+func (s *TestSuite) TestMsgServer_MarketUpdateDetails() {
+	testDef := msgServerTestDef[exchange.MsgMarketUpdateDetailsRequest, exchange.MsgMarketUpdateDetailsResponse, struct{}]{
+		endpointName: "MarketUpdateDetails",
+		endpoint:     keeper.NewMsgServer(s.k).MarketUpdateDetails,
+		expResp:      &exchange.MsgMarketUpdateDetailsResponse{},
+		followup: func(msg *exchange.MsgMarketUpdateDetailsRequest, _ struct{}) {
+			market := s.k.GetMarket(s.ctx, msg.MarketId)
+			if s.Assert().NotNil(market, "GetMarket(%d)", msg.MarketId) {
+				s.Assert().Equal(msg.MarketDetails, market.MarketDetails, "market %d details", msg.MarketId)
 			}
 		},
-	}
-
-	tests := []msgServerTestCase[exchange.MsgMarketCommitmentSettleRequest, []expBalances]{
-		{
-			name: "does not have permission",
-			setup: func() {
-				s.requireCreateMarket(exchange.Market{
-					MarketId:                 3,
-					AccessGrants:             []exchange.AccessGrant{s.agCanAllBut(s.addr5, exchange.Permission_settle)},
-					CommitmentSettlementBips: 50,
-					IntermediaryDenom:        "cherry",
-				})
-				attr := "can.do"
-				s.requireSetNameRecord(attr, s.addr5)
-				s.requireSetAttr(s.addr1, attr, s.addr5)
-				s.requireAddFinalizeAndActivateMarker(s.coin("1000000apple"), s.addr5, attr)
-				s.requireAddFinalizeAndActivateMarker(s.coin("1000000plum"), s.addr5, attr)
-				s.requireAddFinalizeAndActivateMarker(s.coin("1000000cherry"), s.addr5, attr)
-				appleMarker := s.requireGetMarker("apple")
-				plumMarker := s.requireGetMarker("plum")
-				cherryMarker := s.requireGetMarker("cherry")
-				s.requireSetNav(appleMarker, 100, "23cherry")
-				s.requireSetNav(appleMarker, 5, "12plum")
-				s.requireSetNav(plumMarker, 77, "50cherry")
-				s.requireSetNav(plumMarker, 12, "5apple")
-				s.requireSetNav(cherryMarker, 6, "25nhash")
+	}
 
-				s.requireFundAccount(s.addr1, "30apple")
-				s.requireSetCommitmentAmount(3, s.addr1, "25apple")
+	tests := []msgServerTestCase[exchange.MsgMarketUpdateDetailsRequest, struct{}]{
+		{
+			name: "admin does not have permission to update market",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId:     2,
+					AccessGrants: []exchange.AccessGrant{s.agCanAllBut(s.addr5, exchange.Permission_update)},
+				})
 			},
-			msg: exchange.MsgMarketCommitmentSettleRequest{
-				Admin:    s.addr5.String(),
-				MarketId: 3,
-				Inputs:   []exchange.AccountAmount{{Account: s.addr1.String(), Amount: s.coins("10apple")}},
-				Outputs:  []exchange.AccountAmount{{Account: s.addr2.String(), Amount: s.coins("10apple")}},
-				EventTag: "nopetag",
+			msg: exchange.MsgMarketUpdateDetailsRequest{
+				Admin:         s.addr5.String(),
+				MarketId:      2,
+				MarketDetails: exchange.MarketDetails{Name: "new name"},
 			},
-			expInErr: []string{invReqErr, "account " + s.addr5.String() + " does not have permission to settle commitments for market 3"},
-			fArgs: []expBalances{
-				{addr: s.addr1, expBal: s.coins("30apple"), expHold: s.coins("25apple"), expSpend: s.coins("5apple")},
-				{addr: s.addr2, expBal: s.zeroCoins("apple"), expHold: s.zeroCoins("apple"), expSpend: s.zeroCoins("apple")},
+			expInErr: []string{invReqErr,
+				"account " + s.addr5.String() + " does not have permission to update market 2"},
+		},
+		{
+			name: "error updating details",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 2, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+				})
+				ma := s.k.GetMarketAccount(s.ctx, 2)
+				s.app.AccountKeeper.SetAccount(s.ctx, ma.BaseAccount)
+			},
+			msg: exchange.MsgMarketUpdateDetailsRequest{
+				Admin:         s.addr5.String(),
+				MarketId:      2,
+				MarketDetails: exchange.MarketDetails{Name: "new name"},
 			},
+			expInErr: []string{invReqErr, "market 2 account not found"},
 		},
 		{
-			name: "success",
+			name: "all good",
 			setup: func() {
-				s.requireCreateMarket(exchange.Market{
-					MarketId:                 3,
-					AccessGrants:             []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_settle)},
-					CommitmentSettlementBips: 50,
-					IntermediaryDenom:        "cherry",
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 2, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					MarketDetails: exchange.MarketDetails{
+						Name:        "Market 2 Old Name",
+						Description: "The old description of market 2.",
+						WebsiteUrl:  "http://example.com/old/market/2",
+						IconUri:     "http://oops.example.com/old/market/2",
+					},
 				})
-				attr := "can.do"
-				s.requireSetNameRecord(attr, s.addr5)
-				s.requireSetAttr(s.addr1, attr, s.addr5)
-				s.requireSetAttr(s.addr2, attr, s.addr5)
-				s.requireSetAttr(s.addr3, attr, s.addr5)
-				s.requireSetAttr(s.addr4, attr, s.addr5)
-				s.requireSetAttr(s.marketAddr3, attr, s.addr5)
-				s.requireAddFinalizeAndActivateMarker(s.coin("1000000apple"), s.addr5, attr)
-				s.requireAddFinalizeAndActivateMarker(s.coin("1000000plum"), s.addr5, attr)
-				s.requireAddFinalizeAndActivateMarker(s.coin("1000000cherry"), s.addr5, attr)
-				appleMarker := s.requireGetMarker("apple")
-				plumMarker := s.requireGetMarker("plum")
-				cherryMarker := s.requireGetMarker("cherry")
-				s.requireSetNav(appleMarker, 100, "23cherry")
-				s.requireSetNav(appleMarker, 5, "12plum")
-				s.requireSetNav(plumMarker, 77, "50cherry")
-				s.requireSetNav(plumMarker, 12, "5apple")
-				s.requireSetNav(cherryMarker, 6, "25nhash")
-
-				s.requireFundAccount(s.addr1, "100apple,12cherry")
-				s.requireFundAccount(s.addr2, "50apple,50cherry,50plum")
-				s.requireFundAccount(s.addr3, "900plum")
-
-				s.requireSetCommitmentAmount(3, s.addr1, "100apple,2cherry")
-				s.requireSetCommitmentAmount(3, s.addr2, "10apple,5cherry,50plum")
-				s.requireSetCommitmentAmount(3, s.addr3, "100plum")
 			},
-			msg: exchange.MsgMarketCommitmentSettleRequest{
+			msg: exchange.MsgMarketUpdateDetailsRequest{
 				Admin:    s.addr5.String(),
-				MarketId: 3,
-				Inputs: []exchange.AccountAmount{
-					{Account: s.addr1.String(), Amount: s.coins("95apple")},
-					{Account: s.addr2.String(), Amount: s.coins("50plum")},
-					{Account: s.addr3.String(), Amount: s.coins("77plum")},
-				},
-				Outputs: []exchange.AccountAmount{
-					{Account: s.addr1.String(), Amount: s.coins("90plum")},
-					{Account: s.addr2.String(), Amount: s.coins("57apple")},
-					{Account: s.addr3.String(), Amount: s.coins("12apple")},
-					{Account: s.addr4.String(), Amount: s.coins("26apple,37plum")},
-				},
-				Fees: []exchange.AccountAmount{
-					{Account: s.addr1.String(), Amount: s.coins("2cherry")},
-					{Account: s.addr2.String(), Amount: s.coins("3cherry")},
+				MarketId: 2,
+				MarketDetails: exchange.MarketDetails{
+					Name:        "Market Two",
+					Description: "This is the new, better, stronger description of Market Two!",
+					WebsiteUrl:  "http://example.com/new/market/2",
+					IconUri:     "http://example.com/new/market/2/icon",
 				},
-				EventTag: "tagtestbackagain",
 			},
 			expEvents: sdk.Events{
-				// commitment releases
-				s.eventHoldReleased(s.addr1, "95apple,2cherry"),
-				s.eventCommitmentReleased(s.addr1, 3, "95apple,2cherry", "tagtestbackagain"),
-				s.eventHoldReleased(s.addr2, "3cherry,50plum"),
-				s.eventCommitmentReleased(s.addr2, 3, "3cherry,50plum", "tagtestbackagain"),
-				s.eventHoldReleased(s.addr3, "77plum"),
-				s.eventCommitmentReleased(s.addr3, 3, "77plum", "tagtestbackagain"),
-
-				// Transfer from addr1
-				s.eventCoinSpent(s.addr1, "95apple"),
-				s.eventMessageSender(s.addr1),
-				s.eventCoinReceived(s.addr2, "57apple"),
-				s.eventCoinReceived(s.addr3, "12apple"),
-				s.eventCoinReceived(s.addr4, "26apple"),
-				s.eventTransfer(s.addr2, s.addr1, "57apple"),
-				s.eventTransfer(s.addr3, s.addr1, "12apple"),
-				s.eventTransfer(s.addr4, s.addr1, "26apple"),
-
-				// Transfer from addr2
-				s.eventCoinSpent(s.addr2, "50plum"),
-				s.eventCoinReceived(s.addr1, "50plum"),
-				s.eventTransfer(s.addr1, s.addr2, "50plum"),
-				s.eventMessageSender(s.addr2),
-
-				// Transfer from addr3
-				s.eventCoinSpent(s.addr3, "77plum"),
-				s.eventMessageSender(s.addr3),
-				s.eventCoinReceived(s.addr1, "40plum"),
-				s.eventCoinReceived(s.addr4, "37plum"),
-				s.eventTransfer(s.addr1, s.addr3, "40plum"),
-				s.eventTransfer(s.addr4, s.addr3, "37plum"),
-
-				// Fee Transfer
-				s.eventCoinSpent(s.addr1, "2cherry"),
-				s.eventMessageSender(s.addr1),
-				s.eventCoinSpent(s.addr2, "3cherry"),
-				s.eventMessageSender(s.addr2),
-				s.eventCoinReceived(s.marketAddr3, "5cherry"),
-				s.eventTransfer(s.marketAddr3, s.addr1, "2cherry"),
-				s.eventTransfer(s.marketAddr3, s.addr2, "3cherry"),
-
-				// re-commits
-				s.eventHoldAddedCommitment(s.addr1, "90plum", 3),
-				s.eventFundsCommitted(s.addr1, 3, "90plum", "tagtestbackagain"),
-				s.eventHoldAddedCommitment(s.addr2, "57apple", 3),
-				s.eventFundsCommitted(s.addr2, 3, "57apple", "tagtestbackagain"),
-				s.eventHoldAddedCommitment(s.addr3, "12apple", 3),
-				s.eventFundsCommitted(s.addr3, 3, "12apple", "tagtestbackagain"),
-				s.eventHoldAddedCommitment(s.addr4, "26apple,37plum", 3),
-				s.eventFundsCommitted(s.addr4, 3, "26apple,37plum", "tagtestbackagain"),
-			},
-			fArgs: []expBalances{
-				{
-					addr:     s.addr1,
-					expBal:   s.coins("5apple,10cherry,90plum"),
-					expHold:  []sdk.Coin{s.coin("5apple"), s.zeroCoin("cherry"), s.coin("90plum")},
-					expSpend: []sdk.Coin{s.zeroCoin("apple"), s.coin("10cherry"), s.zeroCoin("plum")},
-				},
-				{
-					addr:     s.addr2,
-					expBal:   []sdk.Coin{s.coin("107apple"), s.coin("47cherry"), s.zeroCoin("plum")},
-					expHold:  []sdk.Coin{s.coin("67apple"), s.zeroCoin("plum"), s.coin("2cherry")},
-					expSpend: []sdk.Coin{s.coin("40apple"), s.zeroCoin("plum"), s.coin("45cherry")},
-				},
-				{
-					addr:     s.addr3,
-					expBal:   []sdk.Coin{s.coin("12apple"), s.zeroCoin("cherry"), s.coin("823plum")},
-					expHold:  []sdk.Coin{s.coin("12apple"), s.zeroCoin("cherry"), s.coin("23plum")},
-					expSpend: []sdk.Coin{s.zeroCoin("apple"), s.zeroCoin("cherry"), s.coin("800plum")},
-				},
-				{
-					addr:     s.addr4,
-					expBal:   []sdk.Coin{s.coin("26apple"), s.zeroCoin("cherry"), s.coin("37plum")},
-					expHold:  []sdk.Coin{s.coin("26apple"), s.zeroCoin("cherry"), s.coin("37plum")},
-					expSpend: s.zeroCoins("apple", "cherry", "plum"),
-				},
+				s.untypeEvent(&exchange.EventMarketDetailsUpdated{MarketId: 2, UpdatedBy: s.addr5.String()}),
 			},
 		},
 	}
@@ -3307,321 +5458,213 @@ func (s *TestSuite) TestMsgServer_MarketCommitmentSettle() {
 	}
 }
 
-func (s *TestSuite) TestMsgServer_MarketReleaseCommitments() {
-	testDef := msgServerTestDef[exchange.MsgMarketReleaseCommitmentsRequest, exchange.MsgMarketReleaseCommitmentsResponse, []expBalances]{
-		endpointName: "MarketReleaseCommitments",
-		endpoint:     keeper.NewMsgServer(s.k).MarketReleaseCommitments,
-		expResp:      &exchange.MsgMarketReleaseCommitmentsResponse{},
-		followup: func(_ *exchange.MsgMarketReleaseCommitmentsRequest, expBals []expBalances) {
-			for _, eb := range expBals {
-				s.checkBalances(eb)
-			}
+func (s *TestSuite) TestMsgServer_MarketUpdateEnabled() {
+	testDef := msgServerTestDef[exchange.MsgMarketUpdateEnabledRequest, exchange.MsgMarketUpdateEnabledResponse, struct{}]{
+		endpointName: "MarketUpdateEnabled",
+		endpoint:     keeper.NewMsgServer(s.k).MarketUpdateEnabled,
+		expResp:      &exchange.MsgMarketUpdateEnabledResponse{},
+	}
+
+	tc := msgServerTestCase[exchange.MsgMarketUpdateEnabledRequest, struct{}]{
+		name:     "always error",
+		msg:      exchange.MsgMarketUpdateEnabledRequest{},
+		expInErr: []string{"the MarketUpdateEnabled endpoint has been replaced by the MarketUpdateAcceptingOrders endpoint"},
+	}
+
+	runMsgServerTestCase(s, testDef, tc)
+}
+
+func (s *TestSuite) TestMsgServer_MarketUpdateAcceptingOrders() {
+	testDef := msgServerTestDef[exchange.MsgMarketUpdateAcceptingOrdersRequest, exchange.MsgMarketUpdateAcceptingOrdersResponse, struct{}]{
+		endpointName: "MarketUpdateAcceptingOrders",
+		endpoint:     keeper.NewMsgServer(s.k).MarketUpdateAcceptingOrders,
+		expResp:      &exchange.MsgMarketUpdateAcceptingOrdersResponse{},
+		followup: func(msg *exchange.MsgMarketUpdateAcceptingOrdersRequest, _ struct{}) {
+			isEnabled := s.k.IsMarketAcceptingOrders(s.ctx, msg.MarketId)
+			s.Assert().Equal(msg.AcceptingOrders, isEnabled, "IsMarketAcceptingOrders(%d)", msg.MarketId)
 		},
 	}
 
-	tests := []msgServerTestCase[exchange.MsgMarketReleaseCommitmentsRequest, []expBalances]{
+	tests := []msgServerTestCase[exchange.MsgMarketUpdateAcceptingOrdersRequest, struct{}]{
 		{
-			name: "no permission",
+			name: "admin does not have permission to update market",
 			setup: func() {
-				s.requireCreateMarket(exchange.Market{
-					MarketId:     1,
-					AccessGrants: []exchange.AccessGrant{s.agCanAllBut(s.addr1, exchange.Permission_cancel)},
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId:     3,
+					AccessGrants: []exchange.AccessGrant{s.agCanAllBut(s.addr5, exchange.Permission_update)},
 				})
-				s.requireFundAccount(s.addr2, "100apple,100cherry")
-				s.requireSetCommitmentAmount(1, s.addr2, "50apple")
 			},
-			msg: exchange.MsgMarketReleaseCommitmentsRequest{
-				Admin:     s.addr1.String(),
-				MarketId:  1,
-				ToRelease: []exchange.AccountAmount{{Account: s.addr2.String(), Amount: s.coins("50apple")}},
+			msg: exchange.MsgMarketUpdateAcceptingOrdersRequest{
+				Admin:           s.addr5.String(),
+				MarketId:        3,
+				AcceptingOrders: true,
 			},
-			expInErr: []string{invReqErr, "account " + s.addr1.String() + " does not have permission to release commitments for market 1"},
-			fArgs: []expBalances{
-				{
-					addr:     s.addr2,
-					expBal:   s.coins("100apple,100cherry"),
-					expHold:  s.coins("50apple"),
-					expSpend: s.coins("50apple,100cherry"),
-				},
+			expInErr: []string{invReqErr,
+				"account " + s.addr5.String() + " does not have permission to update market 3"},
+		},
+		{
+			name: "false to false",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					AcceptingOrders: false,
+				})
+			},
+			msg: exchange.MsgMarketUpdateAcceptingOrdersRequest{
+				Admin:           s.addr5.String(),
+				MarketId:        3,
+				AcceptingOrders: false,
 			},
+			expInErr: []string{invReqErr, "market 3 already has accepting-orders false"},
 		},
 		{
-			name: "has permission: error in release",
+			name: "true to true",
 			setup: func() {
-				s.requireCreateMarket(exchange.Market{
-					MarketId:     1,
-					AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					AcceptingOrders: true,
 				})
-				s.requireFundAccount(s.addr2, "100apple,100cherry")
-				s.requireSetCommitmentAmount(1, s.addr2, "50apple")
 			},
-			msg: exchange.MsgMarketReleaseCommitmentsRequest{
-				Admin:     s.addr1.String(),
-				MarketId:  1,
-				ToRelease: []exchange.AccountAmount{{Account: "badbadaddr", Amount: s.coins("50apple")}},
+			msg: exchange.MsgMarketUpdateAcceptingOrdersRequest{
+				Admin:           s.addr5.String(),
+				MarketId:        3,
+				AcceptingOrders: true,
 			},
-			expInErr: []string{invReqErr, "invalid account \"badbadaddr\""},
-			fArgs: []expBalances{{
-				addr:     s.addr2,
-				expBal:   s.coins("100apple,100cherry"),
-				expHold:  s.coins("50apple"),
-				expSpend: s.coins("50apple,100cherry"),
-			}},
+			expInErr: []string{invReqErr, "market 3 already has accepting-orders true"},
 		},
 		{
-			name: "okay: partial",
+			name: "false to true",
 			setup: func() {
-				s.requireCreateMarket(exchange.Market{
-					MarketId:     1,
-					AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					AcceptingOrders: false,
 				})
-				s.requireFundAccount(s.addr2, "100apple,100cherry")
-				s.requireSetCommitmentAmount(1, s.addr2, "50apple")
 			},
-			msg: exchange.MsgMarketReleaseCommitmentsRequest{
-				Admin:     s.addr1.String(),
-				MarketId:  1,
-				ToRelease: []exchange.AccountAmount{{Account: s.addr2.String(), Amount: s.coins("40apple")}},
-				EventTag:  "byebyebye",
+			msg: exchange.MsgMarketUpdateAcceptingOrdersRequest{
+				Admin:           s.addr5.String(),
+				MarketId:        3,
+				AcceptingOrders: true,
 			},
 			expEvents: sdk.Events{
-				s.eventHoldReleased(s.addr2, "40apple"),
-				s.eventCommitmentReleased(s.addr2, 1, "40apple", "byebyebye"),
+				s.untypeEvent(&exchange.EventMarketOrdersEnabled{MarketId: 3, UpdatedBy: s.addr5.String()}),
 			},
-			fArgs: []expBalances{{
-				addr:     s.addr2,
-				expBal:   s.coins("100apple,100cherry"),
-				expHold:  s.coins("10apple"),
-				expSpend: s.coins("90apple,100cherry"),
-			}},
 		},
 		{
-			name: "okay: all provided",
+			name: "true to false",
 			setup: func() {
-				s.requireCreateMarket(exchange.Market{
-					MarketId:     1,
-					AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					AcceptingOrders: true,
 				})
-				s.requireFundAccount(s.addr2, "100apple,100cherry")
-				s.requireSetCommitmentAmount(1, s.addr2, "50apple")
 			},
-			msg: exchange.MsgMarketReleaseCommitmentsRequest{
-				Admin:     s.addr1.String(),
-				MarketId:  1,
-				ToRelease: []exchange.AccountAmount{{Account: s.addr2.String(), Amount: s.coins("50apple")}},
-				EventTag:  "hellogoodbye",
+			msg: exchange.MsgMarketUpdateAcceptingOrdersRequest{
+				Admin:           s.addr5.String(),
+				MarketId:        3,
+				AcceptingOrders: false,
 			},
 			expEvents: sdk.Events{
-				s.eventHoldReleased(s.addr2, "50apple"),
-				s.eventCommitmentReleased(s.addr2, 1, "50apple", "hellogoodbye"),
+				s.untypeEvent(&exchange.EventMarketOrdersDisabled{MarketId: 3, UpdatedBy: s.addr5.String()}),
 			},
-			fArgs: []expBalances{{
-				addr:     s.addr2,
-				expBal:   s.coins("100apple,100cherry"),
-				expHold:  s.zeroCoins("apple"),
-				expSpend: s.coins("100apple,100cherry"),
-			}},
 		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runMsgServerTestCase(s, testDef, tc)
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_MarketUpdateUserSettle() {
+	testDef := msgServerTestDef[exchange.MsgMarketUpdateUserSettleRequest, exchange.MsgMarketUpdateUserSettleResponse, struct{}]{
+		endpointName: "MarketUpdateUserSettle",
+		endpoint:     keeper.NewMsgServer(s.k).MarketUpdateUserSettle,
+		expResp:      &exchange.MsgMarketUpdateUserSettleResponse{},
+		followup: func(msg *exchange.MsgMarketUpdateUserSettleRequest, _ struct{}) {
+			allowed := s.k.IsUserSettlementAllowed(s.ctx, msg.MarketId)
+			s.Assert().Equal(msg.AllowUserSettlement, allowed, "IsUserSettlementAllowed(%d)", msg.MarketId)
+		},
+	}
+
+	tests := []msgServerTestCase[exchange.MsgMarketUpdateUserSettleRequest, struct{}]{
 		{
-			name: "okay: empty amt",
+			name: "admin does not have permission to update market",
 			setup: func() {
-				s.requireCreateMarket(exchange.Market{
-					MarketId:     1,
-					AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId:     3,
+					AccessGrants: []exchange.AccessGrant{s.agCanAllBut(s.addr5, exchange.Permission_update)},
 				})
-				s.requireFundAccount(s.addr2, "100apple,100cherry")
-				s.requireSetCommitmentAmount(1, s.addr2, "50apple")
-			},
-			msg: exchange.MsgMarketReleaseCommitmentsRequest{
-				Admin:     s.addr1.String(),
-				MarketId:  1,
-				ToRelease: []exchange.AccountAmount{{Account: s.addr2.String()}},
-				EventTag:  "allgonow",
 			},
-			expEvents: sdk.Events{
-				s.eventHoldReleased(s.addr2, "50apple"),
-				s.eventCommitmentReleased(s.addr2, 1, "50apple", "allgonow"),
+			msg: exchange.MsgMarketUpdateUserSettleRequest{
+				Admin:               s.addr5.String(),
+				MarketId:            3,
+				AllowUserSettlement: true,
 			},
-			fArgs: []expBalances{{
-				addr:     s.addr2,
-				expBal:   s.coins("100apple,100cherry"),
-				expHold:  s.zeroCoins("apple"),
-				expSpend: s.coins("100apple,100cherry"),
-			}},
+			expInErr: []string{invReqErr,
+				"account " + s.addr5.String() + " does not have permission to update market 3"},
 		},
 		{
-			name: "multiple okay",
+			name: "false to false",
 			setup: func() {
-				s.requireCreateMarket(exchange.Market{
-					MarketId:     2,
-					AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					AllowUserSettlement: false,
 				})
-				bal := "100apple,200cherry"
-				s.requireFundAccount(s.addr1, bal)
-				s.requireFundAccount(s.addr2, bal)
-				s.requireFundAccount(s.addr3, bal)
-				s.requireFundAccount(s.addr4, bal)
-				s.requireFundAccount(s.addr5, bal)
-				s.requireSetCommitmentAmount(2, s.addr1, "100apple")
-				s.requireSetCommitmentAmount(2, s.addr2, "200cherry")
-				s.requireSetCommitmentAmount(2, s.addr3, "10apple,200cherry")
-				s.requireSetCommitmentAmount(2, s.addr4, "100apple,20cherry")
-				s.requireSetCommitmentAmount(2, s.addr5, "90apple,180cherry")
 			},
-			msg: exchange.MsgMarketReleaseCommitmentsRequest{
-				Admin:    s.addr1.String(),
-				MarketId: 2,
-				ToRelease: []exchange.AccountAmount{
-					{Account: s.addr3.String(), Amount: s.coins("6apple,111cherry")},
-					{Account: s.addr5.String(), Amount: s.coins("180cherry")},
-					{Account: s.addr1.String(), Amount: s.coins("75apple")},
-					{Account: s.addr4.String()},
-					{Account: s.addr2.String(), Amount: s.coins("200cherry")},
-				},
-				EventTag: "multifree",
+			msg: exchange.MsgMarketUpdateUserSettleRequest{
+				Admin:               s.addr5.String(),
+				MarketId:            3,
+				AllowUserSettlement: false,
 			},
-			expEvents: sdk.Events{
-				s.eventHoldReleased(s.addr3, "6apple,111cherry"),
-				s.eventCommitmentReleased(s.addr3, 2, "6apple,111cherry", "multifree"),
-				s.eventHoldReleased(s.addr5, "180cherry"),
-				s.eventCommitmentReleased(s.addr5, 2, "180cherry", "multifree"),
-				s.eventHoldReleased(s.addr1, "75apple"),
-				s.eventCommitmentReleased(s.addr1, 2, "75apple", "multifree"),
-				s.eventHoldReleased(s.addr4, "100apple,20cherry"),
-				s.eventCommitmentReleased(s.addr4, 2, "100apple,20cherry", "multifree"),
-				s.eventHoldReleased(s.addr2, "200cherry"),
-				s.eventCommitmentReleased(s.addr2, 2, "200cherry", "multifree"),
+			expInErr: []string{invReqErr, "market 3 already has allow-user-settlement false"},
+		},
+		{
+			name: "true to true",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					AllowUserSettlement: true,
+				})
 			},
-			fArgs: []expBalances{
-				{
-					addr:     s.addr1,
-					expBal:   s.coins("100apple,200cherry"),
-					expHold:  []sdk.Coin{s.coin("25apple"), s.zeroCoin("cherry")},
-					expSpend: s.coins("75apple,200cherry"),
-				},
-				{
-					addr:     s.addr2,
-					expBal:   s.coins("100apple,200cherry"),
-					expHold:  s.zeroCoins("apple", "cherry"),
-					expSpend: s.coins("100apple,200cherry"),
-				},
-				{
-					addr:     s.addr3,
-					expBal:   s.coins("100apple,200cherry"),
-					expHold:  s.coins("4apple,89cherry"),
-					expSpend: s.coins("96apple,111cherry"),
-				},
-				{
-					addr:     s.addr4,
-					expBal:   s.coins("100apple,200cherry"),
-					expHold:  s.zeroCoins("apple", "cherry"),
-					expSpend: s.coins("100apple,200cherry"),
-				},
-				{
-					addr:     s.addr5,
-					expBal:   s.coins("100apple,200cherry"),
-					expHold:  []sdk.Coin{s.coin("90apple"), s.zeroCoin("cherry")},
-					expSpend: s.coins("10apple,200cherry"),
-				},
+			msg: exchange.MsgMarketUpdateUserSettleRequest{
+				Admin:               s.addr5.String(),
+				MarketId:            3,
+				AllowUserSettlement: true,
 			},
+			expInErr: []string{invReqErr, "market 3 already has allow-user-settlement true"},
 		},
 		{
-			name: "authority: error in release",
+			name: "false to true",
 			setup: func() {
-				s.requireCreateMarket(exchange.Market{
-					MarketId:     1,
-					AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					AllowUserSettlement: false,
 				})
-				s.requireFundAccount(s.addr2, "100apple,100cherry")
-				s.requireSetCommitmentAmount(1, s.addr2, "50apple")
 			},
-			msg: exchange.MsgMarketReleaseCommitmentsRequest{
-				Admin:     s.k.GetAuthority(),
-				MarketId:  1,
-				ToRelease: []exchange.AccountAmount{{Account: "badbadaddr", Amount: s.coins("50apple")}},
+			msg: exchange.MsgMarketUpdateUserSettleRequest{
+				Admin:               s.addr5.String(),
+				MarketId:            3,
+				AllowUserSettlement: true,
+			},
+			expEvents: sdk.Events{
+				s.untypeEvent(&exchange.EventMarketUserSettleEnabled{MarketId: 3, UpdatedBy: s.addr5.String()}),
 			},
-			expInErr: []string{invReqErr, "invalid account \"badbadaddr\""},
-			fArgs: []expBalances{{
-				addr:     s.addr2,
-				expBal:   s.coins("100apple,100cherry"),
-				expHold:  s.coins("50apple"),
-				expSpend: s.coins("50apple,100cherry"),
-			}},
 		},
 		{
-			name: "authority: multiple okay",
+			name: "true to false",
 			setup: func() {
-				s.requireCreateMarket(exchange.Market{
-					MarketId:     2,
-					AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					AllowUserSettlement: true,
 				})
-				bal := "100apple,200cherry"
-				s.requireFundAccount(s.addr1, bal)
-				s.requireFundAccount(s.addr2, bal)
-				s.requireFundAccount(s.addr3, bal)
-				s.requireFundAccount(s.addr4, bal)
-				s.requireFundAccount(s.addr5, bal)
-				s.requireSetCommitmentAmount(2, s.addr1, "100apple")
-				s.requireSetCommitmentAmount(2, s.addr2, "200cherry")
-				s.requireSetCommitmentAmount(2, s.addr3, "10apple,200cherry")
-				s.requireSetCommitmentAmount(2, s.addr4, "100apple,20cherry")
-				s.requireSetCommitmentAmount(2, s.addr5, "90apple,180cherry")
 			},
-			msg: exchange.MsgMarketReleaseCommitmentsRequest{
-				Admin:    s.addr1.String(),
-				MarketId: 2,
-				ToRelease: []exchange.AccountAmount{
-					{Account: s.addr3.String(), Amount: s.coins("6apple,111cherry")},
-					{Account: s.addr5.String(), Amount: s.coins("180cherry")},
-					{Account: s.addr1.String(), Amount: s.coins("75apple")},
-					{Account: s.addr4.String()},
-					{Account: s.addr2.String(), Amount: s.coins("200cherry")},
-				},
-				EventTag: "multifree",
+			msg: exchange.MsgMarketUpdateUserSettleRequest{
+				Admin:               s.addr5.String(),
+				MarketId:            3,
+				AllowUserSettlement: false,
 			},
 			expEvents: sdk.Events{
-				s.eventHoldReleased(s.addr3, "6apple,111cherry"),
-				s.eventCommitmentReleased(s.addr3, 2, "6apple,111cherry", "multifree"),
-				s.eventHoldReleased(s.addr5, "180cherry"),
-				s.eventCommitmentReleased(s.addr5, 2, "180cherry", "multifree"),
-				s.eventHoldReleased(s.addr1, "75apple"),
-				s.eventCommitmentReleased(s.addr1, 2, "75apple", "multifree"),
-				s.eventHoldReleased(s.addr4, "100apple,20cherry"),
-				s.eventCommitmentReleased(s.addr4, 2, "100apple,20cherry", "multifree"),
-				s.eventHoldReleased(s.addr2, "200cherry"),
-				s.eventCommitmentReleased(s.addr2, 2, "200cherry", "multifree"),
-			},
-			fArgs: []expBalances{
-				{
-					addr:     s.addr1,
-					expBal:   s.coins("100apple,200cherry"),
-					expHold:  []sdk.Coin{s.coin("25apple"), s.zeroCoin("cherry")},
-					expSpend: s.coins("75apple,200cherry"),
-				},
-				{
-					addr:     s.addr2,
-					expBal:   s.coins("100apple,200cherry"),
-					expHold:  s.zeroCoins("apple", "cherry"),
-					expSpend: s.coins("100apple,200cherry"),
-				},
-				{
-					addr:     s.addr3,
-					expBal:   s.coins("100apple,200cherry"),
-					expHold:  s.coins("4apple,89cherry"),
-					expSpend: s.coins("96apple,111cherry"),
-				},
-				{
-					addr:     s.addr4,
-					expBal:   s.coins("100apple,200cherry"),
-					expHold:  s.zeroCoins("apple", "cherry"),
-					expSpend: s.coins("100apple,200cherry"),
-				},
-				{
-					addr:     s.addr5,
-					expBal:   s.coins("100apple,200cherry"),
-					expHold:  []sdk.Coin{s.coin("90apple"), s.zeroCoin("cherry")},
-					expSpend: s.coins("10apple,200cherry"),
-				},
+				s.untypeEvent(&exchange.EventMarketUserSettleDisabled{MarketId: 3, UpdatedBy: s.addr5.String()}),
 			},
 		},
 	}
@@ -3633,467 +5676,376 @@ func (s *TestSuite) TestMsgServer_MarketReleaseCommitments() {
 	}
 }
 
-func (s *TestSuite) TestMsgServer_MarketTransferCommitments() {
-	testDef := msgServerTestDef[exchange.MsgMarketTransferCommitmentRequest, exchange.MsgMarketTransferCommitmentResponse, []expBalances]{
-		endpointName: "MarketTransferCommitment",
-		endpoint:     keeper.NewMsgServer(s.k).MarketTransferCommitment,
-		expResp:      &exchange.MsgMarketTransferCommitmentResponse{},
-		followup: func(_ *exchange.MsgMarketTransferCommitmentRequest, expBals []expBalances) {
-			for _, eb := range expBals {
-				s.checkBalances(eb)
-			}
+func (s *TestSuite) TestMsgServer_MarketUpdateAcceptingCommitments() {
+	testDef := msgServerTestDef[exchange.MsgMarketUpdateAcceptingCommitmentsRequest, exchange.MsgMarketUpdateAcceptingCommitmentsResponse, struct{}]{
+		endpointName: "MarketUpdateAcceptingCommitments",
+		endpoint:     keeper.NewMsgServer(s.k).MarketUpdateAcceptingCommitments,
+		expResp:      &exchange.MsgMarketUpdateAcceptingCommitmentsResponse{},
+		followup: func(msg *exchange.MsgMarketUpdateAcceptingCommitmentsRequest, _ struct{}) {
+			isEnabled := s.k.IsMarketAcceptingCommitments(s.ctx, msg.MarketId)
+			s.Assert().Equal(msg.AcceptingCommitments, isEnabled, "IsMarketAcceptingCommitments(%d)", msg.MarketId)
 		},
 	}
 
-	tests := []msgServerTestCase[exchange.MsgMarketTransferCommitmentRequest, []expBalances]{
+	tests := []msgServerTestCase[exchange.MsgMarketUpdateAcceptingCommitmentsRequest, struct{}]{
 		{
-			name: "admin does not have permission to transfer",
+			name: "admin does not have permission to update market",
 			setup: func() {
 				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId:     1,
-					AccessGrants: []exchange.AccessGrant{s.agCanAllBut(s.addr1, exchange.Permission_cancel)},
+					MarketId:     3,
+					AccessGrants: []exchange.AccessGrant{s.agCanAllBut(s.addr5, exchange.Permission_update)},
 				})
-				s.requireFundAccount(s.addr2, "100apple")
-				s.requireSetCommitmentAmount(1, s.addr2, "50apple")
-			},
-			msg: exchange.MsgMarketTransferCommitmentRequest{
-				Admin: s.addr1.String(), Account: s.addr2.String(), Amount: s.coins("50apple"), CurrentMarketId: 1, NewMarketId: 2,
 			},
-			expInErr: []string{invReqErr,
-				"account " + s.addr1.String() + " does not have permission to transfer commitments for market 1"},
-			fArgs: []expBalances{
-				{
-					addr:     s.addr2,
-					expBal:   s.coins("100apple"),
-					expHold:  s.coins("50apple"),
-					expSpend: s.coins("50apple"),
-				},
+			msg: exchange.MsgMarketUpdateAcceptingCommitmentsRequest{
+				Admin:                s.addr5.String(),
+				MarketId:             3,
+				AcceptingCommitments: true,
 			},
+			expInErr: []string{invReqErr, "account " + s.addr5.String() + " does not have permission to update market 3"},
 		},
 		{
-			name: "new market does not exists",
+			name: "false to false",
 			setup: func() {
-				s.requireFundAccount(s.addr2, "100apple")
-				s.requireCreateMarket(exchange.Market{
-					MarketId:             1,
-					AccessGrants:         []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
-					AcceptingCommitments: true,
-				})
-				s.requireCreateMarket(exchange.Market{
-					MarketId:     5,
-					AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					AcceptingCommitments: false,
 				})
-				s.requireSetCommitmentAmount(1, s.addr2, "40apple")
-			},
-			msg: exchange.MsgMarketTransferCommitmentRequest{
-				Admin: s.addr1.String(), Account: s.addr2.String(), Amount: s.coins("40apple"), CurrentMarketId: 1, NewMarketId: 2,
 			},
-			expInErr: []string{invReqErr, "market 2 does not exist"},
-			fArgs: []expBalances{
-				{
-					addr:     s.addr2,
-					expBal:   s.coins("100apple"),
-					expHold:  s.coins("40apple"),
-					expSpend: s.coins("40apple"),
-				},
+			msg: exchange.MsgMarketUpdateAcceptingCommitmentsRequest{
+				Admin:                s.addr5.String(),
+				MarketId:             3,
+				AcceptingCommitments: false,
 			},
+			expInErr: []string{invReqErr, "market 3 already has accepting-commitments false"},
 		},
 		{
-			name: "new market not accpeting commitments",
+			name: "true to true",
 			setup: func() {
-				s.requireFundAccount(s.addr2, "100apple")
-				s.requireCreateMarket(exchange.Market{
-					MarketId:             1,
-					AccessGrants:         []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
 					AcceptingCommitments: true,
 				})
-				s.requireCreateMarket(exchange.Market{
-					MarketId:     5,
-					AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
+			},
+			msg: exchange.MsgMarketUpdateAcceptingCommitmentsRequest{
+				Admin:                s.addr5.String(),
+				MarketId:             3,
+				AcceptingCommitments: true,
+			},
+			expInErr: []string{invReqErr, "market 3 already has accepting-commitments true"},
+		},
+		{
+			name: "false to true: no fees: authority",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					AcceptingCommitments: false,
 				})
-				s.requireSetCommitmentAmount(1, s.addr2, "40apple")
 			},
-			msg: exchange.MsgMarketTransferCommitmentRequest{
-				Admin: s.addr1.String(), Account: s.addr2.String(), Amount: s.coins("40apple"), CurrentMarketId: 1, NewMarketId: 5,
+			msg: exchange.MsgMarketUpdateAcceptingCommitmentsRequest{
+				Admin:                s.k.GetAuthority(),
+				MarketId:             3,
+				AcceptingCommitments: true,
 			},
-			expInErr: []string{invReqErr, "market 5 is not accepting commitments"},
-			fArgs: []expBalances{
-				{
-					addr:     s.addr2,
-					expBal:   s.coins("100apple"),
-					expHold:  s.coins("40apple"),
-					expSpend: s.coins("40apple"),
-				},
+			expEvents: sdk.Events{
+				s.untypeEvent(&exchange.EventMarketCommitmentsEnabled{MarketId: 3, UpdatedBy: s.k.GetAuthority()}),
 			},
 		},
 		{
-			name: "insufficent fund to transfer",
+			name: "false to true: no fees: addr",
 			setup: func() {
-				s.requireFundAccount(s.addr2, "100apple")
-				s.requireCreateMarket(exchange.Market{
-					MarketId:             1,
-					AccessGrants:         []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
-					AcceptingCommitments: true,
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					AcceptingCommitments: false,
 				})
-				s.requireCreateMarket(exchange.Market{
-					MarketId:             5,
-					AccessGrants:         []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
-					AcceptingCommitments: true,
+			},
+			msg: exchange.MsgMarketUpdateAcceptingCommitmentsRequest{
+				Admin:                s.addr5.String(),
+				MarketId:             3,
+				AcceptingCommitments: true,
+			},
+			expInErr: []string{invReqErr, "market 3 does not have any commitment fees defined"},
+		},
+		{
+			name: "false to true: with fees: addr",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					AcceptingCommitments:     false,
+					CommitmentSettlementBips: 50,
+					IntermediaryDenom:        "cherry",
 				})
-				s.requireSetCommitmentAmount(1, s.addr2, "40apple")
 			},
-			msg: exchange.MsgMarketTransferCommitmentRequest{
-				Admin: s.addr1.String(), Account: s.addr2.String(), Amount: s.coins("50apple"), CurrentMarketId: 1, NewMarketId: 5,
+			msg: exchange.MsgMarketUpdateAcceptingCommitmentsRequest{
+				Admin:                s.addr5.String(),
+				MarketId:             3,
+				AcceptingCommitments: true,
 			},
-			expInErr: []string{invReqErr, fmt.Sprintf(
-				"commitment amount to transfer %q is more than currently committed amount %q for %s in market %d: invalid request",
-				"50apple", "40apple", s.addr2.String(), 1)},
-			fArgs: []expBalances{
-				{
-					addr:     s.addr2,
-					expBal:   s.coins("100apple"),
-					expHold:  s.coins("40apple"),
-					expSpend: s.coins("40apple"),
-				},
+			expEvents: sdk.Events{
+				s.untypeEvent(&exchange.EventMarketCommitmentsEnabled{MarketId: 3, UpdatedBy: s.addr5.String()}),
 			},
 		},
 		{
-			name: "negative amount rejected",
+			name: "true to false",
 			setup: func() {
-				s.requireFundAccount(s.addr2, "100apple")
-				s.requireCreateMarket(exchange.Market{
-					MarketId:             1,
-					AccessGrants:         []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
-					AcceptingCommitments: true,
-				})
-				s.requireCreateMarket(exchange.Market{
-					MarketId:             5,
-					AccessGrants:         []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
 					AcceptingCommitments: true,
 				})
-				s.requireSetCommitmentAmount(1, s.addr2, "40apple")
 			},
-			msg: exchange.MsgMarketTransferCommitmentRequest{
-				Admin: s.addr1.String(), Account: s.addr2.String(), Amount: sdk.Coins{sdk.Coin{Denom: "apple", Amount: sdkmath.NewInt(-30)}}, CurrentMarketId: 1, NewMarketId: 5,
+			msg: exchange.MsgMarketUpdateAcceptingCommitmentsRequest{
+				Admin:                s.addr5.String(),
+				MarketId:             3,
+				AcceptingCommitments: false,
 			},
-			expInErr: []string{invReqErr, fmt.Sprintf("cannot transfer negative commitment amount %q for %s in market %d", "-30apple", s.addr2, 1)},
-			fArgs: []expBalances{
-				{
-					addr:     s.addr2,
-					expBal:   s.coins("100apple"),
-					expHold:  s.coins("40apple"),
-					expSpend: s.coins("40apple"),
-				},
+			expEvents: sdk.Events{
+				s.untypeEvent(&exchange.EventMarketCommitmentsDisabled{MarketId: 3, UpdatedBy: s.addr5.String()}),
 			},
 		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runMsgServerTestCase(s, testDef, tc)
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_MarketUpdateContinuousMatching() {
+	testDef := msgServerTestDef[exchange.MsgMarketUpdateContinuousMatchingRequest, exchange.MsgMarketUpdateContinuousMatchingResponse, struct{}]{
+		endpointName: "MarketUpdateContinuousMatching",
+		endpoint:     keeper.NewMsgServer(s.k).MarketUpdateContinuousMatching,
+		expResp:      &exchange.MsgMarketUpdateContinuousMatchingResponse{},
+		followup: func(msg *exchange.MsgMarketUpdateContinuousMatchingRequest, _ struct{}) {
+			enabled := s.k.IsContinuousMatchingEnabled(s.ctx, msg.MarketId)
+			s.Assert().Equal(msg.ContinuousMatching, enabled, "IsContinuousMatchingEnabled(%d)", msg.MarketId)
+		},
+	}
+
+	tests := []msgServerTestCase[exchange.MsgMarketUpdateContinuousMatchingRequest, struct{}]{
 		{
-			name: "partial transfer with same denom",
+			name: "admin does not have permission to update market",
 			setup: func() {
-				s.requireFundAccount(s.addr2, "500cherry")
-				s.requireSetCommitmentAmount(1, s.addr2, "500cherry")
-				s.requireCreateMarket(exchange.Market{
-					MarketId:             1,
-					AccessGrants:         []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
-					AcceptingCommitments: true,
-				})
-				s.requireCreateMarket(exchange.Market{
-					MarketId:             2,
-					AccessGrants:         []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
-					AcceptingCommitments: true,
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId:     3,
+					AccessGrants: []exchange.AccessGrant{s.agCanAllBut(s.addr5, exchange.Permission_update)},
 				})
-
-			},
-			msg: exchange.MsgMarketTransferCommitmentRequest{
-				Admin: s.addr1.String(), Account: s.addr2.String(), Amount: s.coins("100cherry"), CurrentMarketId: 1, NewMarketId: 2, EventTag: "partial transfer with same denom",
-			},
-			expEvents: []sdk.Event{
-				s.eventCommitmentReleased(
-					s.addr2,
-					1,
-					s.coins("100cherry").String(),
-					"partial transfer with same denom",
-				),
-				s.eventFundsCommitted(
-					s.addr2,
-					2,
-					s.coins("100cherry").String(),
-					"partial transfer with same denom",
-				),
 			},
-			fArgs: []expBalances{
-				{
-					addr:     s.addr2,
-					expBal:   s.coins("500cherry"),
-					expHold:  s.coins("500cherry"),
-					expSpend: s.coins("0cherry"),
-				},
+			msg: exchange.MsgMarketUpdateContinuousMatchingRequest{
+				Admin:              s.addr5.String(),
+				MarketId:           3,
+				ContinuousMatching: true,
 			},
+			expInErr: []string{invReqErr,
+				"account " + s.addr5.String() + " does not have permission to update market 3"},
 		},
 		{
-			name: "full transfer with same denom",
+			name: "false to false",
 			setup: func() {
-				s.requireFundAccount(s.addr2, "500cherry")
-				s.requireSetCommitmentAmount(1, s.addr2, "500cherry")
-				s.requireCreateMarket(exchange.Market{
-					MarketId:             1,
-					AccessGrants:         []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
-					AcceptingCommitments: true,
-				})
-				s.requireCreateMarket(exchange.Market{
-					MarketId:             2,
-					AccessGrants:         []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
-					AcceptingCommitments: true,
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					ContinuousMatching: false,
 				})
-
 			},
-			msg: exchange.MsgMarketTransferCommitmentRequest{
-				Admin: s.addr1.String(), Account: s.addr2.String(), Amount: s.coins("500cherry"), CurrentMarketId: 1, NewMarketId: 2, EventTag: "full transfer with same denom",
+			msg: exchange.MsgMarketUpdateContinuousMatchingRequest{
+				Admin:              s.addr5.String(),
+				MarketId:           3,
+				ContinuousMatching: false,
 			},
-			expEvents: []sdk.Event{
-				s.eventCommitmentReleased(
-					s.addr2,
-					1,
-					s.coins("500cherry").String(),
-					"full transfer with same denom",
-				),
-				s.eventFundsCommitted(
-					s.addr2,
-					2,
-					s.coins("500cherry").String(),
-					"full transfer with same denom",
-				),
+			expInErr: []string{invReqErr, "market 3 already has continuous-matching false"},
+		},
+		{
+			name: "true to true",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					ContinuousMatching: true,
+				})
 			},
-			fArgs: []expBalances{
-				{
-					addr:     s.addr2,
-					expBal:   s.coins("500cherry"),
-					expHold:  s.coins("500cherry"),
-					expSpend: s.coins("0cherry"),
-				},
+			msg: exchange.MsgMarketUpdateContinuousMatchingRequest{
+				Admin:              s.addr5.String(),
+				MarketId:           3,
+				ContinuousMatching: true,
 			},
+			expInErr: []string{invReqErr, "market 3 already has continuous-matching true"},
 		},
 		{
-			name: "partial transfer with multiple denom",
+			name: "false to true",
 			setup: func() {
-				s.requireFundAccount(s.addr2, "500cherry,100apple")
-				s.requireSetCommitmentAmount(1, s.addr2, "500cherry,100apple")
-				s.requireCreateMarket(exchange.Market{
-					MarketId:             1,
-					AccessGrants:         []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
-					AcceptingCommitments: true,
-				})
-				s.requireCreateMarket(exchange.Market{
-					MarketId:             2,
-					AccessGrants:         []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
-					AcceptingCommitments: true,
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					ContinuousMatching: false,
 				})
-
-			},
-			msg: exchange.MsgMarketTransferCommitmentRequest{
-				Admin: s.addr1.String(), Account: s.addr2.String(), Amount: s.coins("10cherry,29apple"), CurrentMarketId: 1, NewMarketId: 2, EventTag: "partial transfer with multiple denom",
 			},
-			expEvents: []sdk.Event{
-				s.eventCommitmentReleased(
-					s.addr2,
-					1,
-					s.coins("10cherry,29apple").String(),
-					"partial transfer with multiple denom",
-				),
-				s.eventFundsCommitted(
-					s.addr2,
-					2,
-					s.coins("10cherry,29apple").String(),
-					"partial transfer with multiple denom",
-				),
+			msg: exchange.MsgMarketUpdateContinuousMatchingRequest{
+				Admin:              s.addr5.String(),
+				MarketId:           3,
+				ContinuousMatching: true,
 			},
-			fArgs: []expBalances{
-				{
-					addr:     s.addr2,
-					expBal:   s.coins("500cherry,100apple"),
-					expHold:  s.coins("500cherry,100apple"),
-					expSpend: s.coins("0cherry,0apple"),
-				},
+			expEvents: sdk.Events{
+				s.untypeEvent(&exchange.EventMarketContinuousMatchingEnabled{MarketId: 3, UpdatedBy: s.addr5.String()}),
 			},
 		},
 		{
-			name: "full transfer with multiple denom",
+			name: "false to true with batch auctions",
 			setup: func() {
-				s.requireFundAccount(s.addr2, "500cherry,100apple")
-				s.requireSetCommitmentAmount(1, s.addr2, "500cherry,100apple")
-				s.requireCreateMarket(exchange.Market{
-					MarketId:             1,
-					AccessGrants:         []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
-					AcceptingCommitments: true,
-				})
-				s.requireCreateMarket(exchange.Market{
-					MarketId:             2,
-					AccessGrants:         []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
-					AcceptingCommitments: true,
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					BatchAuctionInterval: 5,
 				})
-
-			},
-			msg: exchange.MsgMarketTransferCommitmentRequest{
-				Admin: s.addr1.String(), Account: s.addr2.String(), Amount: s.coins("500cherry,100apple"), CurrentMarketId: 1, NewMarketId: 2, EventTag: "full transfer with multiple denom",
 			},
-			expEvents: []sdk.Event{
-				s.eventCommitmentReleased(
-					s.addr2,
-					1,
-					s.coins("500cherry,100apple").String(),
-					"full transfer with multiple denom",
-				),
-				s.eventFundsCommitted(
-					s.addr2,
-					2,
-					s.coins("500cherry,100apple").String(),
-					"full transfer with multiple denom",
-				),
-			},
-			fArgs: []expBalances{
-				{
-					addr:     s.addr2,
-					expBal:   s.coins("500cherry,100apple"),
-					expHold:  s.coins("500cherry,100apple"),
-					expSpend: s.coins("0cherry,0apple"),
-				},
+			msg: exchange.MsgMarketUpdateContinuousMatchingRequest{
+				Admin:              s.addr5.String(),
+				MarketId:           3,
+				ContinuousMatching: true,
 			},
+			expInErr: []string{invReqErr, "market 3 cannot have continuous-matching while batch auctions are enabled"},
 		},
 		{
-			name: "authority from admin",
+			name: "true to false",
 			setup: func() {
-				s.requireFundAccount(s.addr2, "500cherry")
-				s.requireSetCommitmentAmount(1, s.addr2, "500cherry")
-				s.requireCreateMarket(exchange.Market{
-					MarketId:             1,
-					AccessGrants:         []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
-					AcceptingCommitments: true,
-				})
-				s.requireCreateMarket(exchange.Market{
-					MarketId:             2,
-					AccessGrants:         []exchange.AccessGrant{s.agCanOnly(s.addr1, exchange.Permission_cancel)},
-					AcceptingCommitments: true,
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					ContinuousMatching: true,
 				})
-
-			},
-			msg: exchange.MsgMarketTransferCommitmentRequest{
-				Admin: s.k.GetAuthority(), Account: s.addr2.String(), Amount: s.coins("500cherry"), CurrentMarketId: 1, NewMarketId: 2, EventTag: "authadmin",
 			},
-			expEvents: []sdk.Event{
-				s.eventCommitmentReleased(
-					s.addr2,
-					1,
-					s.coins("500cherry").String(),
-					"authadmin",
-				),
-				s.eventFundsCommitted(
-					s.addr2,
-					2,
-					s.coins("500cherry").String(),
-					"authadmin",
-				),
+			msg: exchange.MsgMarketUpdateContinuousMatchingRequest{
+				Admin:              s.addr5.String(),
+				MarketId:           3,
+				ContinuousMatching: false,
 			},
-			fArgs: []expBalances{
-				{
-					addr:     s.addr2,
-					expBal:   s.coins("500cherry"),
-					expHold:  s.coins("500cherry"),
-					expSpend: s.coins("0cherry"),
-				},
+			expEvents: sdk.Events{
+				s.untypeEvent(&exchange.EventMarketContinuousMatchingDisabled{MarketId: 3, UpdatedBy: s.addr5.String()}),
 			},
 		},
 	}
 
-	for _, tc := range tests {
-		s.Run(tc.name, func() {
-			runMsgServerTestCase(s, testDef, tc)
-		})
-	}
-}
-
-func (s *TestSuite) TestMsgServer_MarketSetOrderExternalID() {
-	type followupArgs struct{}
-	testDef := msgServerTestDef[exchange.MsgMarketSetOrderExternalIDRequest, exchange.MsgMarketSetOrderExternalIDResponse, followupArgs]{
-		endpointName: "MarketSetOrderExternalID",
-		endpoint:     keeper.NewMsgServer(s.k).MarketSetOrderExternalID,
-		expResp:      &exchange.MsgMarketSetOrderExternalIDResponse{},
-		followup: func(msg *exchange.MsgMarketSetOrderExternalIDRequest, _ followupArgs) {
-			order, err := s.k.GetOrder(s.ctx, msg.OrderId)
-			s.Assert().NoError(err, "GetOrder(%d) error", msg.OrderId)
-			if s.Assert().NotNil(order, "GetOrder(%d) order", msg.OrderId) {
-				s.Assert().Equal(msg.ExternalId, order.GetExternalID(), "GetOrder(%d) order ExternalID", msg.OrderId)
-			}
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runMsgServerTestCase(s, testDef, tc)
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_MarketUpdateBatchAuction() {
+	testDef := msgServerTestDef[exchange.MsgMarketUpdateBatchAuctionRequest, exchange.MsgMarketUpdateBatchAuctionResponse, struct{}]{
+		endpointName: "MarketUpdateBatchAuction",
+		endpoint:     keeper.NewMsgServer(s.k).MarketUpdateBatchAuction,
+		expResp:      &exchange.MsgMarketUpdateBatchAuctionResponse{},
+		followup: func(msg *exchange.MsgMarketUpdateBatchAuctionRequest, _ struct{}) {
+			interval := s.k.GetBatchAuctionInterval(s.ctx, msg.MarketId)
+			s.Assert().Equal(msg.BatchAuctionInterval, interval, "GetBatchAuctionInterval(%d)", msg.MarketId)
 		},
 	}
 
-	tests := []msgServerTestCase[exchange.MsgMarketSetOrderExternalIDRequest, followupArgs]{
+	tests := []msgServerTestCase[exchange.MsgMarketUpdateBatchAuctionRequest, struct{}]{
 		{
-			name: "admin does not have permission",
+			name: "admin does not have permission to update market",
 			setup: func() {
 				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId:     1,
-					AccessGrants: []exchange.AccessGrant{s.agCanAllBut(s.addr5, exchange.Permission_set_ids)},
+					MarketId:     3,
+					AccessGrants: []exchange.AccessGrant{s.agCanAllBut(s.addr5, exchange.Permission_update)},
 				})
 			},
-			msg: exchange.MsgMarketSetOrderExternalIDRequest{
-				Admin: s.addr5.String(), MarketId: 1, OrderId: 1, ExternalId: "bananas",
+			msg: exchange.MsgMarketUpdateBatchAuctionRequest{
+				Admin:                s.addr5.String(),
+				MarketId:             3,
+				BatchAuctionInterval: 10,
 			},
 			expInErr: []string{invReqErr,
-				"account " + s.addr5.String() + " does not have permission to set external ids on orders for market 1"},
+				"account " + s.addr5.String() + " does not have permission to update market 3"},
 		},
 		{
-			name: "order does not exist",
+			name: "0 to 0",
 			setup: func() {
 				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId: 1, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_set_ids)},
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
 				})
 			},
-			msg: exchange.MsgMarketSetOrderExternalIDRequest{
-				Admin: s.addr5.String(), MarketId: 1, OrderId: 1, ExternalId: "bananas",
+			msg: exchange.MsgMarketUpdateBatchAuctionRequest{
+				Admin:                s.addr5.String(),
+				MarketId:             3,
+				BatchAuctionInterval: 0,
 			},
-			expInErr: []string{invReqErr, "order 1 not found"},
+			expInErr: []string{invReqErr, "market 3 already has batch-auction-interval 0"},
 		},
 		{
-			name: "okay: nothing to something",
+			name: "10 to 10",
 			setup: func() {
 				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId: 1, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_set_ids)},
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					BatchAuctionInterval: 10,
 				})
-				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(7).WithAsk(&exchange.AskOrder{
-					MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("1pear"),
-					ExternalId: "",
-				}))
 			},
-			msg: exchange.MsgMarketSetOrderExternalIDRequest{
-				Admin: s.addr5.String(), MarketId: 1, OrderId: 7, ExternalId: "bananas",
+			msg: exchange.MsgMarketUpdateBatchAuctionRequest{
+				Admin:                s.addr5.String(),
+				MarketId:             3,
+				BatchAuctionInterval: 10,
+			},
+			expInErr: []string{invReqErr, "market 3 already has batch-auction-interval 10"},
+		},
+		{
+			name: "0 to 10 with continuous matching",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					ContinuousMatching: true,
+				})
+			},
+			msg: exchange.MsgMarketUpdateBatchAuctionRequest{
+				Admin:                s.addr5.String(),
+				MarketId:             3,
+				BatchAuctionInterval: 10,
+			},
+			expInErr: []string{invReqErr, "market 3 cannot have batch auctions while continuous-matching is enabled"},
+		},
+		{
+			name: "0 to 10",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+				})
+			},
+			msg: exchange.MsgMarketUpdateBatchAuctionRequest{
+				Admin:                s.addr5.String(),
+				MarketId:             3,
+				BatchAuctionInterval: 10,
 			},
 			expEvents: sdk.Events{
-				s.untypeEvent(&exchange.EventOrderExternalIDUpdated{
-					OrderId:    7,
-					MarketId:   1,
-					ExternalId: "bananas",
-				}),
+				s.untypeEvent(&exchange.EventMarketBatchAuctionUpdated{MarketId: 3, UpdatedBy: s.addr5.String()}),
 			},
 		},
 		{
-			name: "okay: something to something else",
+			name: "10 to 3",
 			setup: func() {
 				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId: 1, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_set_ids)},
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					BatchAuctionInterval: 10,
 				})
-				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(7).WithBid(&exchange.BidOrder{
-					MarketId: 1, Buyer: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("1pear"),
-					ExternalId: "something",
-				}))
 			},
-			msg: exchange.MsgMarketSetOrderExternalIDRequest{
-				Admin: s.addr5.String(), MarketId: 1, OrderId: 7, ExternalId: "bananas",
+			msg: exchange.MsgMarketUpdateBatchAuctionRequest{
+				Admin:                s.addr5.String(),
+				MarketId:             3,
+				BatchAuctionInterval: 3,
 			},
 			expEvents: sdk.Events{
-				s.untypeEvent(&exchange.EventOrderExternalIDUpdated{
-					OrderId:    7,
-					MarketId:   1,
-					ExternalId: "bananas",
-				}),
+				s.untypeEvent(&exchange.EventMarketBatchAuctionUpdated{MarketId: 3, UpdatedBy: s.addr5.String()}),
+			},
+		},
+		{
+			name: "10 to 0",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					BatchAuctionInterval: 10,
+				})
+			},
+			msg: exchange.MsgMarketUpdateBatchAuctionRequest{
+				Admin:                s.addr5.String(),
+				MarketId:             3,
+				BatchAuctionInterval: 0,
+			},
+			expEvents: sdk.Events{
+				s.untypeEvent(&exchange.EventMarketBatchAuctionUpdated{MarketId: 3, UpdatedBy: s.addr5.String()}),
 			},
 		},
 	}
@@ -4105,150 +6057,111 @@ func (s *TestSuite) TestMsgServer_MarketSetOrderExternalID() {
 	}
 }
 
-func (s *TestSuite) TestMsgServer_MarketWithdraw() {
-	testDef := msgServerTestDef[exchange.MsgMarketWithdrawRequest, exchange.MsgMarketWithdrawResponse, []expBalances]{
-		endpointName: "MarketWithdraw",
-		endpoint:     keeper.NewMsgServer(s.k).MarketWithdraw,
-		expResp:      &exchange.MsgMarketWithdrawResponse{},
-		followup: func(_ *exchange.MsgMarketWithdrawRequest, fArgs []expBalances) {
-			for _, eb := range fArgs {
-				s.checkBalances(eb)
-			}
+func (s *TestSuite) TestMsgServer_MarketUpdateSelfTradePrevention() {
+	testDef := msgServerTestDef[exchange.MsgMarketUpdateSelfTradePreventionRequest, exchange.MsgMarketUpdateSelfTradePreventionResponse, struct{}]{
+		endpointName: "MarketUpdateSelfTradePrevention",
+		endpoint:     keeper.NewMsgServer(s.k).MarketUpdateSelfTradePrevention,
+		expResp:      &exchange.MsgMarketUpdateSelfTradePreventionResponse{},
+		followup: func(msg *exchange.MsgMarketUpdateSelfTradePreventionRequest, _ struct{}) {
+			stp := s.k.GetSelfTradePrevention(s.ctx, msg.MarketId)
+			s.Assert().Equal(msg.SelfTradePrevention.String(), stp.String(), "GetSelfTradePrevention(%d)", msg.MarketId)
 		},
 	}
 
-	tests := []msgServerTestCase[exchange.MsgMarketWithdrawRequest, []expBalances]{
+	tests := []msgServerTestCase[exchange.MsgMarketUpdateSelfTradePreventionRequest, struct{}]{
 		{
-			name: "admin does not have permission to withdraw",
+			name: "admin does not have permission to update market",
 			setup: func() {
 				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId:     1,
-					AccessGrants: []exchange.AccessGrant{s.agCanAllBut(s.addr5, exchange.Permission_withdraw)},
+					MarketId:     3,
+					AccessGrants: []exchange.AccessGrant{s.agCanAllBut(s.addr5, exchange.Permission_update)},
 				})
 			},
-			msg: exchange.MsgMarketWithdrawRequest{
-				Admin: s.addr5.String(), MarketId: 1, ToAddress: s.addr1.String(), Amount: s.coins("100fig"),
+			msg: exchange.MsgMarketUpdateSelfTradePreventionRequest{
+				Admin:               s.addr5.String(),
+				MarketId:            3,
+				SelfTradePrevention: exchange.SelfTradePrevention_cancel_newest,
 			},
 			expInErr: []string{invReqErr,
-				"account " + s.addr5.String() + " does not have permission to withdraw from market 1"},
+				"account " + s.addr5.String() + " does not have permission to update market 3"},
 		},
 		{
-			name: "insufficient funds in market",
+			name: "unspecified to unspecified",
 			setup: func() {
 				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId:     1,
-					AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_withdraw)},
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
 				})
-				s.requireFundAccount(s.marketAddr1, "100apple,99pear,100fig")
 			},
-			msg: exchange.MsgMarketWithdrawRequest{
-				Admin: s.addr5.String(), MarketId: 1, ToAddress: s.addr1.String(), Amount: s.coins("3apple,100pear,50fig"),
+			msg: exchange.MsgMarketUpdateSelfTradePreventionRequest{
+				Admin:               s.addr5.String(),
+				MarketId:            3,
+				SelfTradePrevention: exchange.SelfTradePrevention_unspecified,
 			},
-			expInErr: []string{invReqErr, "spendable balance 99pear is smaller than 100pear", "insufficient funds"},
+			expInErr: []string{invReqErr, "market 3 already has self-trade-prevention unspecified"},
 		},
 		{
-			name: "destination does not have req attrs",
+			name: "cancel oldest to cancel oldest",
 			setup: func() {
-				s.requireAddFinalizeAndActivateMarker(s.coin("105apple"), s.addr5, "*.apple.what.what")
-				s.requireAddFinalizeAndActivateMarker(s.coin("105pear"), s.addr5, "*.pear.what.what")
-				s.requireSetNameRecord("nut.apple.what.what", s.addr5)
-				s.requireSetNameRecord("nut.pear.what.what", s.addr5)
-				s.requireSetAttr(s.marketAddr1, "nut.apple.what.what", s.addr5)
-				s.requireSetAttr(s.marketAddr1, "nut.pear.what.what", s.addr5)
-				s.requireSetAttr(s.addr1, "nut.apple.what.what", s.addr5)
-
 				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId:     1,
-					AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr4, exchange.Permission_withdraw)},
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					SelfTradePrevention: exchange.SelfTradePrevention_cancel_oldest,
 				})
-				s.requireFundAccount(s.marketAddr1, "100apple,100pear,100fig")
 			},
-			msg: exchange.MsgMarketWithdrawRequest{
-				Admin: s.addr4.String(), MarketId: 1, ToAddress: s.addr1.String(), Amount: s.coins("3apple,100pear,50fig"),
+			msg: exchange.MsgMarketUpdateSelfTradePreventionRequest{
+				Admin:               s.addr5.String(),
+				MarketId:            3,
+				SelfTradePrevention: exchange.SelfTradePrevention_cancel_oldest,
 			},
-			expInErr: []string{invReqErr, "failed to withdraw 3apple,50fig,100pear from market 1",
-				"address " + s.addr1.String() + " does not contain the \"pear\" required attribute: \"*.pear.what.what\""},
+			expInErr: []string{invReqErr, "market 3 already has self-trade-prevention cancel_oldest"},
 		},
 		{
-			name: "destination does not have req attrs but admin has transfer",
+			name: "unspecified to decrement both",
 			setup: func() {
-				s.requireAddFinalizeAndActivateMarker(s.coin("105apple"), s.addr5, "*.apple.what.what")
-				s.requireAddFinalizeAndActivateMarker(s.coin("105pear"), s.addr5, "*.pear.what.what")
-				s.requireSetNameRecord("nut.apple.what.what", s.addr5)
-				s.requireSetNameRecord("nut.pear.what.what", s.addr5)
-				s.requireSetAttr(s.marketAddr1, "nut.apple.what.what", s.addr5)
-				s.requireSetAttr(s.marketAddr1, "nut.pear.what.what", s.addr5)
-				s.requireSetAttr(s.addr1, "nut.apple.what.what", s.addr5)
-
 				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId:     1,
-					AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_withdraw)},
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
 				})
-				s.requireFundAccount(s.marketAddr1, "100apple,100pear,100fig")
 			},
-			msg: exchange.MsgMarketWithdrawRequest{
-				Admin: s.addr5.String(), MarketId: 1, ToAddress: s.addr1.String(), Amount: s.coins("3apple,100pear,50fig"),
-			},
-			fArgs: []expBalances{
-				{addr: s.marketAddr1, expBal: []sdk.Coin{s.coin("97apple"), s.zeroCoin("pear"), s.coin("50fig")}},
-				{addr: s.addr1, expBal: s.coins("3apple,100pear,50fig")},
+			msg: exchange.MsgMarketUpdateSelfTradePreventionRequest{
+				Admin:               s.addr5.String(),
+				MarketId:            3,
+				SelfTradePrevention: exchange.SelfTradePrevention_decrement_both,
 			},
 			expEvents: sdk.Events{
-				s.eventCoinSpent(s.marketAddr1, "3apple,50fig,100pear"),
-				s.eventCoinReceived(s.addr1, "3apple,50fig,100pear"),
-				s.eventTransfer(s.addr1, s.marketAddr1, "3apple,50fig,100pear"),
-				s.eventMessageSender(s.marketAddr1),
-				s.untypeEvent(&exchange.EventMarketWithdraw{
-					MarketId:    1,
-					Amount:      "3apple,50fig,100pear",
-					Destination: s.addr1.String(),
-					WithdrawnBy: s.addr5.String(),
-				}),
+				s.untypeEvent(&exchange.EventMarketSelfTradePreventionUpdated{MarketId: 3, UpdatedBy: s.addr5.String()}),
 			},
 		},
 		{
-			name: "okay",
+			name: "cancel newest to cancel oldest",
 			setup: func() {
-				s.requireAddFinalizeAndActivateMarker(s.coin("100apple"), s.addr5, "*.apple.what.what")
-				s.requireAddFinalizeAndActivateMarker(s.coin("100pear"), s.addr5, "*.pear.what.what")
-				s.requireSetNameRecord("nut.apple.what.what", s.addr5)
-				s.requireSetNameRecord("nut.pear.what.what", s.addr5)
-				s.requireSetAttr(s.marketAddr1, "nut.apple.what.what", s.addr5)
-				s.requireSetAttr(s.marketAddr1, "nut.pear.what.what", s.addr5)
-				s.requireSetAttr(s.addr1, "nut.apple.what.what", s.addr5)
-				s.requireSetAttr(s.addr1, "nut.pear.what.what", s.addr5)
-
 				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId:     1,
-					AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_withdraw)},
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					SelfTradePrevention: exchange.SelfTradePrevention_cancel_newest,
 				})
-				s.requireFundAccount(s.marketAddr1, "100apple,100pear,100fig")
-				s.requireFundAccount(s.addr1, "5apple,5pear")
 			},
-			msg: exchange.MsgMarketWithdrawRequest{
-				Admin: s.addr5.String(), MarketId: 1, ToAddress: s.addr1.String(), Amount: s.coins("3apple,100pear,50fig"),
+			msg: exchange.MsgMarketUpdateSelfTradePreventionRequest{
+				Admin:               s.addr5.String(),
+				MarketId:            3,
+				SelfTradePrevention: exchange.SelfTradePrevention_cancel_oldest,
 			},
-			fArgs: []expBalances{
-				{
-					addr:   s.marketAddr1,
-					expBal: []sdk.Coin{s.coin("97apple"), s.zeroCoin("pear"), s.coin("50fig")},
-				},
-				{
-					addr:   s.addr1,
-					expBal: s.coins("8apple,105pear,50fig"),
-				},
+			expEvents: sdk.Events{
+				s.untypeEvent(&exchange.EventMarketSelfTradePreventionUpdated{MarketId: 3, UpdatedBy: s.addr5.String()}),
+			},
+		},
+		{
+			name: "decrement both to unspecified",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					SelfTradePrevention: exchange.SelfTradePrevention_decrement_both,
+				})
+			},
+			msg: exchange.MsgMarketUpdateSelfTradePreventionRequest{
+				Admin:               s.addr5.String(),
+				MarketId:            3,
+				SelfTradePrevention: exchange.SelfTradePrevention_unspecified,
 			},
 			expEvents: sdk.Events{
-				s.eventCoinSpent(s.marketAddr1, "3apple,50fig,100pear"),
-				s.eventCoinReceived(s.addr1, "3apple,50fig,100pear"),
-				s.eventTransfer(s.addr1, s.marketAddr1, "3apple,50fig,100pear"),
-				s.eventMessageSender(s.marketAddr1),
-				s.untypeEvent(&exchange.EventMarketWithdraw{
-					MarketId:    1,
-					Amount:      "3apple,50fig,100pear",
-					Destination: s.addr1.String(),
-					WithdrawnBy: s.addr5.String(),
-				}),
+				s.untypeEvent(&exchange.EventMarketSelfTradePreventionUpdated{MarketId: 3, UpdatedBy: s.addr5.String()}),
 			},
 		},
 	}
@@ -4260,77 +6173,96 @@ func (s *TestSuite) TestMsgServer_MarketWithdraw() {
 	}
 }
 
-func (s *TestSuite) TestMsgServer_MarketUpdateDetails() {
-	testDef := msgServerTestDef[exchange.MsgMarketUpdateDetailsRequest, exchange.MsgMarketUpdateDetailsResponse, struct{}]{
-		endpointName: "MarketUpdateDetails",
-		endpoint:     keeper.NewMsgServer(s.k).MarketUpdateDetails,
-		expResp:      &exchange.MsgMarketUpdateDetailsResponse{},
-		followup: func(msg *exchange.MsgMarketUpdateDetailsRequest, _ struct{}) {
-			market := s.k.GetMarket(s.ctx, msg.MarketId)
-			if s.Assert().NotNil(market, "GetMarket(%d)", msg.MarketId) {
-				s.Assert().Equal(msg.MarketDetails, market.MarketDetails, "market %d details", msg.MarketId)
-			}
+func (s *TestSuite) TestMsgServer_MarketUpdatePublishPrices() {
+	testDef := msgServerTestDef[exchange.MsgMarketUpdatePublishPricesRequest, exchange.MsgMarketUpdatePublishPricesResponse, struct{}]{
+		endpointName: "MarketUpdatePublishPrices",
+		endpoint:     keeper.NewMsgServer(s.k).MarketUpdatePublishPrices,
+		expResp:      &exchange.MsgMarketUpdatePublishPricesResponse{},
+		followup: func(msg *exchange.MsgMarketUpdatePublishPricesRequest, _ struct{}) {
+			enabled := s.k.IsPublishPricesEnabled(s.ctx, msg.MarketId)
+			s.Assert().Equal(msg.PublishPrices, enabled, "IsPublishPricesEnabled(%d)", msg.MarketId)
+		},
+	}
+
+	tests := []msgServerTestCase[exchange.MsgMarketUpdatePublishPricesRequest, struct{}]{
+		{
+			name: "admin does not have permission to update market",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId:     3,
+					AccessGrants: []exchange.AccessGrant{s.agCanAllBut(s.addr5, exchange.Permission_update)},
+				})
+			},
+			msg: exchange.MsgMarketUpdatePublishPricesRequest{
+				Admin:         s.addr5.String(),
+				MarketId:      3,
+				PublishPrices: true,
+			},
+			expInErr: []string{invReqErr,
+				"account " + s.addr5.String() + " does not have permission to update market 3"},
+		},
+		{
+			name: "false to false",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					PublishPrices: false,
+				})
+			},
+			msg: exchange.MsgMarketUpdatePublishPricesRequest{
+				Admin:         s.addr5.String(),
+				MarketId:      3,
+				PublishPrices: false,
+			},
+			expInErr: []string{invReqErr, "market 3 already has publish-prices false"},
 		},
-	}
-
-	tests := []msgServerTestCase[exchange.MsgMarketUpdateDetailsRequest, struct{}]{
 		{
-			name: "admin does not have permission to update market",
+			name: "true to true",
 			setup: func() {
 				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId:     2,
-					AccessGrants: []exchange.AccessGrant{s.agCanAllBut(s.addr5, exchange.Permission_update)},
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					PublishPrices: true,
 				})
 			},
-			msg: exchange.MsgMarketUpdateDetailsRequest{
+			msg: exchange.MsgMarketUpdatePublishPricesRequest{
 				Admin:         s.addr5.String(),
-				MarketId:      2,
-				MarketDetails: exchange.MarketDetails{Name: "new name"},
+				MarketId:      3,
+				PublishPrices: true,
 			},
-			expInErr: []string{invReqErr,
-				"account " + s.addr5.String() + " does not have permission to update market 2"},
+			expInErr: []string{invReqErr, "market 3 already has publish-prices true"},
 		},
 		{
-			name: "error updating details",
+			name: "false to true",
 			setup: func() {
 				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId: 2, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					PublishPrices: false,
 				})
-				ma := s.k.GetMarketAccount(s.ctx, 2)
-				s.app.AccountKeeper.SetAccount(s.ctx, ma.BaseAccount)
 			},
-			msg: exchange.MsgMarketUpdateDetailsRequest{
+			msg: exchange.MsgMarketUpdatePublishPricesRequest{
 				Admin:         s.addr5.String(),
-				MarketId:      2,
-				MarketDetails: exchange.MarketDetails{Name: "new name"},
+				MarketId:      3,
+				PublishPrices: true,
+			},
+			expEvents: sdk.Events{
+				s.untypeEvent(&exchange.EventMarketPublishPricesEnabled{MarketId: 3, UpdatedBy: s.addr5.String()}),
 			},
-			expInErr: []string{invReqErr, "market 2 account not found"},
 		},
 		{
-			name: "all good",
+			name: "true to false",
 			setup: func() {
 				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId: 2, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
-					MarketDetails: exchange.MarketDetails{
-						Name:        "Market 2 Old Name",
-						Description: "The old description of market 2.",
-						WebsiteUrl:  "http://example.com/old/market/2",
-						IconUri:     "http://oops.example.com/old/market/2",
-					},
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					PublishPrices: true,
 				})
 			},
-			msg: exchange.MsgMarketUpdateDetailsRequest{
-				Admin:    s.addr5.String(),
-				MarketId: 2,
-				MarketDetails: exchange.MarketDetails{
-					Name:        "Market Two",
-					Description: "This is the new, better, stronger description of Market Two!",
-					WebsiteUrl:  "http://example.com/new/market/2",
-					IconUri:     "http://example.com/new/market/2/icon",
-				},
+			msg: exchange.MsgMarketUpdatePublishPricesRequest{
+				Admin:         s.addr5.String(),
+				MarketId:      3,
+				PublishPrices: false,
 			},
 			expEvents: sdk.Events{
-				s.untypeEvent(&exchange.EventMarketDetailsUpdated{MarketId: 2, UpdatedBy: s.addr5.String()}),
+				s.untypeEvent(&exchange.EventMarketPublishPricesDisabled{MarketId: 3, UpdatedBy: s.addr5.String()}),
 			},
 		},
 	}
@@ -4342,34 +6274,18 @@ func (s *TestSuite) TestMsgServer_MarketUpdateDetails() {
 	}
 }
 
-func (s *TestSuite) TestMsgServer_MarketUpdateEnabled() {
-	testDef := msgServerTestDef[exchange.MsgMarketUpdateEnabledRequest, exchange.MsgMarketUpdateEnabledResponse, struct{}]{
-		endpointName: "MarketUpdateEnabled",
-		endpoint:     keeper.NewMsgServer(s.k).MarketUpdateEnabled,
-		expResp:      &exchange.MsgMarketUpdateEnabledResponse{},
-	}
-
-	tc := msgServerTestCase[exchange.MsgMarketUpdateEnabledRequest, struct{}]{
-		name:     "always error",
-		msg:      exchange.MsgMarketUpdateEnabledRequest{},
-		expInErr: []string{"the MarketUpdateEnabled endpoint has been replaced by the MarketUpdateAcceptingOrders endpoint"},
-	}
-
-	runMsgServerTestCase(s, testDef, tc)
-}
-
-func (s *TestSuite) TestMsgServer_MarketUpdateAcceptingOrders() {
-	testDef := msgServerTestDef[exchange.MsgMarketUpdateAcceptingOrdersRequest, exchange.MsgMarketUpdateAcceptingOrdersResponse, struct{}]{
-		endpointName: "MarketUpdateAcceptingOrders",
-		endpoint:     keeper.NewMsgServer(s.k).MarketUpdateAcceptingOrders,
-		expResp:      &exchange.MsgMarketUpdateAcceptingOrdersResponse{},
-		followup: func(msg *exchange.MsgMarketUpdateAcceptingOrdersRequest, _ struct{}) {
-			isEnabled := s.k.IsMarketAcceptingOrders(s.ctx, msg.MarketId)
-			s.Assert().Equal(msg.AcceptingOrders, isEnabled, "IsMarketAcceptingOrders(%d)", msg.MarketId)
+func (s *TestSuite) TestMsgServer_MarketUpdateExternalIDScope() {
+	testDef := msgServerTestDef[exchange.MsgMarketUpdateExternalIDScopeRequest, exchange.MsgMarketUpdateExternalIDScopeResponse, struct{}]{
+		endpointName: "MarketUpdateExternalIDScope",
+		endpoint:     keeper.NewMsgServer(s.k).MarketUpdateExternalIDScope,
+		expResp:      &exchange.MsgMarketUpdateExternalIDScopeResponse{},
+		followup: func(msg *exchange.MsgMarketUpdateExternalIDScopeRequest, _ struct{}) {
+			scope := s.k.GetExternalIDScope(s.ctx, msg.MarketId)
+			s.Assert().Equal(msg.ExternalIdScope.String(), scope.String(), "GetExternalIDScope(%d)", msg.MarketId)
 		},
 	}
 
-	tests := []msgServerTestCase[exchange.MsgMarketUpdateAcceptingOrdersRequest, struct{}]{
+	tests := []msgServerTestCase[exchange.MsgMarketUpdateExternalIDScopeRequest, struct{}]{
 		{
 			name: "admin does not have permission to update market",
 			setup: func() {
@@ -4378,76 +6294,83 @@ func (s *TestSuite) TestMsgServer_MarketUpdateAcceptingOrders() {
 					AccessGrants: []exchange.AccessGrant{s.agCanAllBut(s.addr5, exchange.Permission_update)},
 				})
 			},
-			msg: exchange.MsgMarketUpdateAcceptingOrdersRequest{
+			msg: exchange.MsgMarketUpdateExternalIDScopeRequest{
 				Admin:           s.addr5.String(),
 				MarketId:        3,
-				AcceptingOrders: true,
+				ExternalIdScope: exchange.ExternalIDScope_account,
 			},
 			expInErr: []string{invReqErr,
 				"account " + s.addr5.String() + " does not have permission to update market 3"},
 		},
 		{
-			name: "false to false",
+			name: "unspecified to unspecified",
 			setup: func() {
 				s.requireCreateMarketUnmocked(exchange.Market{
 					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
-					AcceptingOrders: false,
 				})
 			},
-			msg: exchange.MsgMarketUpdateAcceptingOrdersRequest{
+			msg: exchange.MsgMarketUpdateExternalIDScopeRequest{
 				Admin:           s.addr5.String(),
 				MarketId:        3,
-				AcceptingOrders: false,
+				ExternalIdScope: exchange.ExternalIDScope_unspecified,
 			},
-			expInErr: []string{invReqErr, "market 3 already has accepting-orders false"},
+			expInErr: []string{invReqErr, "market 3 already has external-id-scope unspecified"},
 		},
 		{
-			name: "true to true",
+			name: "conflicting external ids",
 			setup: func() {
 				s.requireCreateMarketUnmocked(exchange.Market{
 					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
-					AcceptingOrders: true,
 				})
+				store := s.getStore()
+				for _, order := range []*exchange.Order{
+					exchange.NewOrder(1).WithAsk(&exchange.AskOrder{MarketId: 3, Seller: s.addr1.String(),
+						Assets: s.coin("1apple"), Price: s.coin("1peach"), ExternalId: "dup"}),
+					exchange.NewOrder(2).WithAsk(&exchange.AskOrder{MarketId: 4, Seller: s.addr1.String(),
+						Assets: s.coin("1apple"), Price: s.coin("1peach"), ExternalId: "dup"}),
+				} {
+					s.requireSetOrderInStore(store, order)
+				}
 			},
-			msg: exchange.MsgMarketUpdateAcceptingOrdersRequest{
+			msg: exchange.MsgMarketUpdateExternalIDScopeRequest{
 				Admin:           s.addr5.String(),
 				MarketId:        3,
-				AcceptingOrders: true,
+				ExternalIdScope: exchange.ExternalIDScope_account,
 			},
-			expInErr: []string{invReqErr, "market 3 already has accepting-orders true"},
+			expInErr: []string{invReqErr, "cannot change market 3 external id scope to account",
+				"external id \"dup\" is already in use by order 2: cannot be used for order 1"},
 		},
 		{
-			name: "false to true",
+			name: "unspecified to account",
 			setup: func() {
 				s.requireCreateMarketUnmocked(exchange.Market{
 					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
-					AcceptingOrders: false,
 				})
 			},
-			msg: exchange.MsgMarketUpdateAcceptingOrdersRequest{
+			msg: exchange.MsgMarketUpdateExternalIDScopeRequest{
 				Admin:           s.addr5.String(),
 				MarketId:        3,
-				AcceptingOrders: true,
+				ExternalIdScope: exchange.ExternalIDScope_account,
 			},
 			expEvents: sdk.Events{
-				s.untypeEvent(&exchange.EventMarketOrdersEnabled{MarketId: 3, UpdatedBy: s.addr5.String()}),
+				s.untypeEvent(&exchange.EventMarketExternalIDScopeUpdated{MarketId: 3, UpdatedBy: s.addr5.String()}),
 			},
 		},
 		{
-			name: "true to false",
+			name: "account to unspecified",
 			setup: func() {
 				s.requireCreateMarketUnmocked(exchange.Market{
 					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
-					AcceptingOrders: true,
+					ExternalIdScope: exchange.ExternalIDScope_account,
 				})
 			},
-			msg: exchange.MsgMarketUpdateAcceptingOrdersRequest{
+			msg: exchange.MsgMarketUpdateExternalIDScopeRequest{
 				Admin:           s.addr5.String(),
 				MarketId:        3,
-				AcceptingOrders: false,
+				ExternalIdScope: exchange.ExternalIDScope_unspecified,
 			},
 			expEvents: sdk.Events{
-				s.untypeEvent(&exchange.EventMarketOrdersDisabled{MarketId: 3, UpdatedBy: s.addr5.String()}),
+				s.untypeEvent(&exchange.EventMarketExternalIDScopeUpdated{MarketId: 3, UpdatedBy: s.addr5.String()}),
 			},
 		},
 	}
@@ -4459,18 +6382,18 @@ func (s *TestSuite) TestMsgServer_MarketUpdateAcceptingOrders() {
 	}
 }
 
-func (s *TestSuite) TestMsgServer_MarketUpdateUserSettle() {
-	testDef := msgServerTestDef[exchange.MsgMarketUpdateUserSettleRequest, exchange.MsgMarketUpdateUserSettleResponse, struct{}]{
-		endpointName: "MarketUpdateUserSettle",
-		endpoint:     keeper.NewMsgServer(s.k).MarketUpdateUserSettle,
-		expResp:      &exchange.MsgMarketUpdateUserSettleResponse{},
-		followup: func(msg *exchange.MsgMarketUpdateUserSettleRequest, _ struct{}) {
-			allowed := s.k.IsUserSettlementAllowed(s.ctx, msg.MarketId)
-			s.Assert().Equal(msg.AllowUserSettlement, allowed, "IsUserSettlementAllowed(%d)", msg.MarketId)
+func (s *TestSuite) TestMsgServer_MarketUpdateOrderRateLimit() {
+	testDef := msgServerTestDef[exchange.MsgMarketUpdateOrderRateLimitRequest, exchange.MsgMarketUpdateOrderRateLimitResponse, struct{}]{
+		endpointName: "MarketUpdateOrderRateLimit",
+		endpoint:     keeper.NewMsgServer(s.k).MarketUpdateOrderRateLimit,
+		expResp:      &exchange.MsgMarketUpdateOrderRateLimitResponse{},
+		followup: func(msg *exchange.MsgMarketUpdateOrderRateLimitRequest, _ struct{}) {
+			limit := s.k.GetOrderRateLimit(s.ctx, msg.MarketId)
+			s.Assert().Equal(msg.OrderRateLimit, limit, "GetOrderRateLimit(%d)", msg.MarketId)
 		},
 	}
 
-	tests := []msgServerTestCase[exchange.MsgMarketUpdateUserSettleRequest, struct{}]{
+	tests := []msgServerTestCase[exchange.MsgMarketUpdateOrderRateLimitRequest, struct{}]{
 		{
 			name: "admin does not have permission to update market",
 			setup: func() {
@@ -4479,76 +6402,57 @@ func (s *TestSuite) TestMsgServer_MarketUpdateUserSettle() {
 					AccessGrants: []exchange.AccessGrant{s.agCanAllBut(s.addr5, exchange.Permission_update)},
 				})
 			},
-			msg: exchange.MsgMarketUpdateUserSettleRequest{
-				Admin:               s.addr5.String(),
-				MarketId:            3,
-				AllowUserSettlement: true,
+			msg: exchange.MsgMarketUpdateOrderRateLimitRequest{
+				Admin:          s.addr5.String(),
+				MarketId:       3,
+				OrderRateLimit: &exchange.OrderRateLimit{MaxOrders: 10, WindowBlocks: 1},
 			},
 			expInErr: []string{invReqErr,
 				"account " + s.addr5.String() + " does not have permission to update market 3"},
 		},
 		{
-			name: "false to false",
-			setup: func() {
-				s.requireCreateMarketUnmocked(exchange.Market{
-					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
-					AllowUserSettlement: false,
-				})
-			},
-			msg: exchange.MsgMarketUpdateUserSettleRequest{
-				Admin:               s.addr5.String(),
-				MarketId:            3,
-				AllowUserSettlement: false,
-			},
-			expInErr: []string{invReqErr, "market 3 already has allow-user-settlement false"},
-		},
-		{
-			name: "true to true",
+			name: "no limit to no limit",
 			setup: func() {
 				s.requireCreateMarketUnmocked(exchange.Market{
 					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
-					AllowUserSettlement: true,
 				})
 			},
-			msg: exchange.MsgMarketUpdateUserSettleRequest{
-				Admin:               s.addr5.String(),
-				MarketId:            3,
-				AllowUserSettlement: true,
+			msg: exchange.MsgMarketUpdateOrderRateLimitRequest{
+				Admin:    s.addr5.String(),
+				MarketId: 3,
 			},
-			expInErr: []string{invReqErr, "market 3 already has allow-user-settlement true"},
+			expInErr: []string{invReqErr, "market 3 does not have an order rate limit"},
 		},
 		{
-			name: "false to true",
+			name: "no limit to a limit",
 			setup: func() {
 				s.requireCreateMarketUnmocked(exchange.Market{
 					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
-					AllowUserSettlement: false,
 				})
 			},
-			msg: exchange.MsgMarketUpdateUserSettleRequest{
-				Admin:               s.addr5.String(),
-				MarketId:            3,
-				AllowUserSettlement: true,
+			msg: exchange.MsgMarketUpdateOrderRateLimitRequest{
+				Admin:          s.addr5.String(),
+				MarketId:       3,
+				OrderRateLimit: &exchange.OrderRateLimit{MaxOrders: 10, WindowBlocks: 1},
 			},
 			expEvents: sdk.Events{
-				s.untypeEvent(&exchange.EventMarketUserSettleEnabled{MarketId: 3, UpdatedBy: s.addr5.String()}),
+				s.untypeEvent(&exchange.EventMarketOrderRateLimitUpdated{MarketId: 3, UpdatedBy: s.addr5.String()}),
 			},
 		},
 		{
-			name: "true to false",
+			name: "a limit to no limit",
 			setup: func() {
 				s.requireCreateMarketUnmocked(exchange.Market{
 					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
-					AllowUserSettlement: true,
+					OrderRateLimit: &exchange.OrderRateLimit{MaxOrders: 10, WindowBlocks: 1},
 				})
 			},
-			msg: exchange.MsgMarketUpdateUserSettleRequest{
-				Admin:               s.addr5.String(),
-				MarketId:            3,
-				AllowUserSettlement: false,
+			msg: exchange.MsgMarketUpdateOrderRateLimitRequest{
+				Admin:    s.addr5.String(),
+				MarketId: 3,
 			},
 			expEvents: sdk.Events{
-				s.untypeEvent(&exchange.EventMarketUserSettleDisabled{MarketId: 3, UpdatedBy: s.addr5.String()}),
+				s.untypeEvent(&exchange.EventMarketOrderRateLimitUpdated{MarketId: 3, UpdatedBy: s.addr5.String()}),
 			},
 		},
 	}
@@ -4560,18 +6464,18 @@ func (s *TestSuite) TestMsgServer_MarketUpdateUserSettle() {
 	}
 }
 
-func (s *TestSuite) TestMsgServer_MarketUpdateAcceptingCommitments() {
-	testDef := msgServerTestDef[exchange.MsgMarketUpdateAcceptingCommitmentsRequest, exchange.MsgMarketUpdateAcceptingCommitmentsResponse, struct{}]{
-		endpointName: "MarketUpdateAcceptingCommitments",
-		endpoint:     keeper.NewMsgServer(s.k).MarketUpdateAcceptingCommitments,
-		expResp:      &exchange.MsgMarketUpdateAcceptingCommitmentsResponse{},
-		followup: func(msg *exchange.MsgMarketUpdateAcceptingCommitmentsRequest, _ struct{}) {
-			isEnabled := s.k.IsMarketAcceptingCommitments(s.ctx, msg.MarketId)
-			s.Assert().Equal(msg.AcceptingCommitments, isEnabled, "IsMarketAcceptingCommitments(%d)", msg.MarketId)
+func (s *TestSuite) TestMsgServer_MarketUpdateMaxExposure() {
+	testDef := msgServerTestDef[exchange.MsgMarketUpdateMaxExposureRequest, exchange.MsgMarketUpdateMaxExposureResponse, struct{}]{
+		endpointName: "MarketUpdateMaxExposure",
+		endpoint:     keeper.NewMsgServer(s.k).MarketUpdateMaxExposure,
+		expResp:      &exchange.MsgMarketUpdateMaxExposureResponse{},
+		followup: func(msg *exchange.MsgMarketUpdateMaxExposureRequest, _ struct{}) {
+			maxExposure := s.k.GetMaxExposure(s.ctx, msg.MarketId)
+			s.Assert().Equal(msg.MaxExposure, maxExposure, "GetMaxExposure(%d)", msg.MarketId)
 		},
 	}
 
-	tests := []msgServerTestCase[exchange.MsgMarketUpdateAcceptingCommitmentsRequest, struct{}]{
+	tests := []msgServerTestCase[exchange.MsgMarketUpdateMaxExposureRequest, struct{}]{
 		{
 			name: "admin does not have permission to update market",
 			setup: func() {
@@ -4580,109 +6484,141 @@ func (s *TestSuite) TestMsgServer_MarketUpdateAcceptingCommitments() {
 					AccessGrants: []exchange.AccessGrant{s.agCanAllBut(s.addr5, exchange.Permission_update)},
 				})
 			},
-			msg: exchange.MsgMarketUpdateAcceptingCommitmentsRequest{
-				Admin:                s.addr5.String(),
-				MarketId:             3,
-				AcceptingCommitments: true,
+			msg: exchange.MsgMarketUpdateMaxExposureRequest{
+				Admin:       s.addr5.String(),
+				MarketId:    3,
+				MaxExposure: s.coinP("100cherry"),
 			},
-			expInErr: []string{invReqErr, "account " + s.addr5.String() + " does not have permission to update market 3"},
+			expInErr: []string{invReqErr,
+				"account " + s.addr5.String() + " does not have permission to update market 3"},
 		},
 		{
-			name: "false to false",
+			name: "no max exposure to none",
 			setup: func() {
 				s.requireCreateMarketUnmocked(exchange.Market{
 					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
-					AcceptingCommitments: false,
 				})
 			},
-			msg: exchange.MsgMarketUpdateAcceptingCommitmentsRequest{
-				Admin:                s.addr5.String(),
-				MarketId:             3,
-				AcceptingCommitments: false,
+			msg: exchange.MsgMarketUpdateMaxExposureRequest{
+				Admin:    s.addr5.String(),
+				MarketId: 3,
 			},
-			expInErr: []string{invReqErr, "market 3 already has accepting-commitments false"},
+			expInErr: []string{invReqErr, "market 3 does not have a max exposure"},
 		},
 		{
-			name: "true to true",
+			name: "no max exposure to one",
 			setup: func() {
 				s.requireCreateMarketUnmocked(exchange.Market{
 					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
-					AcceptingCommitments: true,
 				})
 			},
-			msg: exchange.MsgMarketUpdateAcceptingCommitmentsRequest{
-				Admin:                s.addr5.String(),
-				MarketId:             3,
-				AcceptingCommitments: true,
+			msg: exchange.MsgMarketUpdateMaxExposureRequest{
+				Admin:       s.addr5.String(),
+				MarketId:    3,
+				MaxExposure: s.coinP("100cherry"),
+			},
+			expEvents: sdk.Events{
+				s.untypeEvent(&exchange.EventMarketMaxExposureUpdated{MarketId: 3, UpdatedBy: s.addr5.String()}),
 			},
-			expInErr: []string{invReqErr, "market 3 already has accepting-commitments true"},
 		},
 		{
-			name: "false to true: no fees: authority",
+			name: "a max exposure to none",
 			setup: func() {
 				s.requireCreateMarketUnmocked(exchange.Market{
 					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
-					AcceptingCommitments: false,
+					MaxExposure: s.coinP("100cherry"),
 				})
 			},
-			msg: exchange.MsgMarketUpdateAcceptingCommitmentsRequest{
-				Admin:                s.k.GetAuthority(),
-				MarketId:             3,
-				AcceptingCommitments: true,
+			msg: exchange.MsgMarketUpdateMaxExposureRequest{
+				Admin:    s.addr5.String(),
+				MarketId: 3,
 			},
 			expEvents: sdk.Events{
-				s.untypeEvent(&exchange.EventMarketCommitmentsEnabled{MarketId: 3, UpdatedBy: s.k.GetAuthority()}),
+				s.untypeEvent(&exchange.EventMarketMaxExposureUpdated{MarketId: 3, UpdatedBy: s.addr5.String()}),
 			},
 		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runMsgServerTestCase(s, testDef, tc)
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_MarketUpdateFillAlgorithm() {
+	testDef := msgServerTestDef[exchange.MsgMarketUpdateFillAlgorithmRequest, exchange.MsgMarketUpdateFillAlgorithmResponse, struct{}]{
+		endpointName: "MarketUpdateFillAlgorithm",
+		endpoint:     keeper.NewMsgServer(s.k).MarketUpdateFillAlgorithm,
+		expResp:      &exchange.MsgMarketUpdateFillAlgorithmResponse{},
+		followup: func(msg *exchange.MsgMarketUpdateFillAlgorithmRequest, _ struct{}) {
+			algorithm := s.k.GetFillAlgorithm(s.ctx, msg.MarketId)
+			s.Assert().Equal(msg.FillAlgorithm.String(), algorithm.String(), "GetFillAlgorithm(%d)", msg.MarketId)
+		},
+	}
+
+	tests := []msgServerTestCase[exchange.MsgMarketUpdateFillAlgorithmRequest, struct{}]{
 		{
-			name: "false to true: no fees: addr",
+			name: "admin does not have permission to update market",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId:     3,
+					AccessGrants: []exchange.AccessGrant{s.agCanAllBut(s.addr5, exchange.Permission_update)},
+				})
+			},
+			msg: exchange.MsgMarketUpdateFillAlgorithmRequest{
+				Admin:         s.addr5.String(),
+				MarketId:      3,
+				FillAlgorithm: exchange.FillAlgorithm_pro_rata,
+			},
+			expInErr: []string{invReqErr,
+				"account " + s.addr5.String() + " does not have permission to update market 3"},
+		},
+		{
+			name: "already pro rata",
 			setup: func() {
 				s.requireCreateMarketUnmocked(exchange.Market{
 					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
-					AcceptingCommitments: false,
+					FillAlgorithm: exchange.FillAlgorithm_pro_rata,
 				})
 			},
-			msg: exchange.MsgMarketUpdateAcceptingCommitmentsRequest{
-				Admin:                s.addr5.String(),
-				MarketId:             3,
-				AcceptingCommitments: true,
+			msg: exchange.MsgMarketUpdateFillAlgorithmRequest{
+				Admin:         s.addr5.String(),
+				MarketId:      3,
+				FillAlgorithm: exchange.FillAlgorithm_pro_rata,
 			},
-			expInErr: []string{invReqErr, "market 3 does not have any commitment fees defined"},
+			expInErr: []string{invReqErr, "market 3 already has fill-algorithm pro_rata"},
 		},
 		{
-			name: "false to true: with fees: addr",
+			name: "unspecified to pro rata",
 			setup: func() {
 				s.requireCreateMarketUnmocked(exchange.Market{
 					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
-					AcceptingCommitments:     false,
-					CommitmentSettlementBips: 50,
-					IntermediaryDenom:        "cherry",
 				})
 			},
-			msg: exchange.MsgMarketUpdateAcceptingCommitmentsRequest{
-				Admin:                s.addr5.String(),
-				MarketId:             3,
-				AcceptingCommitments: true,
+			msg: exchange.MsgMarketUpdateFillAlgorithmRequest{
+				Admin:         s.addr5.String(),
+				MarketId:      3,
+				FillAlgorithm: exchange.FillAlgorithm_pro_rata,
 			},
 			expEvents: sdk.Events{
-				s.untypeEvent(&exchange.EventMarketCommitmentsEnabled{MarketId: 3, UpdatedBy: s.addr5.String()}),
+				s.untypeEvent(&exchange.EventMarketFillAlgorithmUpdated{MarketId: 3, UpdatedBy: s.addr5.String()}),
 			},
 		},
 		{
-			name: "true to false",
+			name: "price time to unspecified",
 			setup: func() {
 				s.requireCreateMarketUnmocked(exchange.Market{
 					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
-					AcceptingCommitments: true,
+					FillAlgorithm: exchange.FillAlgorithm_price_time,
 				})
 			},
-			msg: exchange.MsgMarketUpdateAcceptingCommitmentsRequest{
-				Admin:                s.addr5.String(),
-				MarketId:             3,
-				AcceptingCommitments: false,
+			msg: exchange.MsgMarketUpdateFillAlgorithmRequest{
+				Admin:    s.addr5.String(),
+				MarketId: 3,
 			},
 			expEvents: sdk.Events{
-				s.untypeEvent(&exchange.EventMarketCommitmentsDisabled{MarketId: 3, UpdatedBy: s.addr5.String()}),
+				s.untypeEvent(&exchange.EventMarketFillAlgorithmUpdated{MarketId: 3, UpdatedBy: s.addr5.String()}),
 			},
 		},
 	}
@@ -4865,6 +6801,299 @@ func (s *TestSuite) TestMsgServer_MarketManagePermissions() {
 	}
 }
 
+func (s *TestSuite) TestMsgServer_MarketTransferAuthority() {
+	testDef := msgServerTestDef[exchange.MsgMarketTransferAuthorityRequest, exchange.MsgMarketTransferAuthorityResponse, []exchange.AccessGrant]{
+		endpointName: "MarketTransferAuthority",
+		endpoint:     keeper.NewMsgServer(s.k).MarketTransferAuthority,
+		expResp:      &exchange.MsgMarketTransferAuthorityResponse{},
+		followup: func(msg *exchange.MsgMarketTransferAuthorityRequest, expAGs []exchange.AccessGrant) {
+			transfer, err := s.k.GetAuthorityTransfer(s.ctx, msg.MarketId)
+			if s.Assert().NoError(err, "GetAuthorityTransfer(%d)", msg.MarketId) {
+				s.Assert().Equal(msg.ToMarketAuthorityTransfer(), transfer, "GetAuthorityTransfer(%d)", msg.MarketId)
+			}
+			actAGs := s.k.GetAccessGrants(s.ctx, msg.MarketId)
+			s.Assert().Equal(expAGs, actAGs, "GetAccessGrants(%d) (should be unchanged)", msg.MarketId)
+		},
+	}
+
+	expiration := s.ctx.BlockTime().Add(time.Hour).UTC()
+	pastExp := s.ctx.BlockTime().Add(-time.Hour).UTC()
+
+	tests := []msgServerTestCase[exchange.MsgMarketTransferAuthorityRequest, []exchange.AccessGrant]{
+		{
+			name: "admin does not have permission to manage permissions",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId:     3,
+					AccessGrants: []exchange.AccessGrant{s.agCanAllBut(s.addr5, exchange.Permission_permissions)},
+				})
+			},
+			msg: exchange.MsgMarketTransferAuthorityRequest{
+				Admin:        s.addr5.String(),
+				MarketId:     3,
+				NewAuthority: s.addr1.String(),
+				AccessGrants: []exchange.AccessGrant{s.agCanEverything(s.addr1)},
+			},
+			expInErr: []string{invReqErr,
+				"account " + s.addr5.String() + " does not have permission to transfer authority of market 3"},
+		},
+		{
+			name: "market does not exist",
+			msg: exchange.MsgMarketTransferAuthorityRequest{
+				Admin:        s.k.GetAuthority(),
+				MarketId:     3,
+				NewAuthority: s.addr1.String(),
+				AccessGrants: []exchange.AccessGrant{s.agCanEverything(s.addr1)},
+			},
+			expInErr: []string{invReqErr, "market 3 does not exist"},
+		},
+		{
+			name: "new authority not given permissions permission",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId:     3,
+					AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_permissions)},
+				})
+			},
+			msg: exchange.MsgMarketTransferAuthorityRequest{
+				Admin:        s.addr5.String(),
+				MarketId:     3,
+				NewAuthority: s.addr1.String(),
+				AccessGrants: []exchange.AccessGrant{
+					s.agCanAllBut(s.addr1, exchange.Permission_permissions),
+					s.agCanOnly(s.addr2, exchange.Permission_permissions),
+				},
+			},
+			expInErr: []string{invReqErr, "new authority " + s.addr1.String() + " must be granted permissions permission"},
+		},
+		{
+			name: "access grant already expired",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId:     3,
+					AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_permissions)},
+				})
+			},
+			msg: exchange.MsgMarketTransferAuthorityRequest{
+				Admin:        s.addr5.String(),
+				MarketId:     3,
+				NewAuthority: s.addr1.String(),
+				AccessGrants: []exchange.AccessGrant{
+					s.agCanEverything(s.addr1),
+					{Address: s.addr2.String(), Permissions: []exchange.Permission{exchange.Permission_settle},
+						Expiration: &pastExp},
+				},
+			},
+			expInErr: []string{invReqErr, "account " + s.addr2.String() + " access grant for market 3: invalid expiration"},
+		},
+		{
+			name: "to a new admin",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3,
+					AccessGrants: []exchange.AccessGrant{
+						s.agCanOnly(s.addr5, exchange.Permission_permissions),
+						s.agCanOnly(s.addr4, exchange.Permission_settle),
+					},
+				})
+			},
+			msg: exchange.MsgMarketTransferAuthorityRequest{
+				Admin:        s.addr5.String(),
+				MarketId:     3,
+				NewAuthority: s.addr1.String(),
+				AccessGrants: []exchange.AccessGrant{
+					s.agCanEverything(s.addr1),
+					{Address: s.addr2.String(), Permissions: []exchange.Permission{exchange.Permission_settle}, Expiration: &expiration},
+				},
+			},
+			fArgs: []exchange.AccessGrant{
+				s.agCanOnly(s.addr4, exchange.Permission_settle),
+				s.agCanOnly(s.addr5, exchange.Permission_permissions),
+			},
+			expEvents: sdk.Events{
+				s.untypeEvent(&exchange.EventMarketAuthorityTransferProposed{
+					MarketId: 3, ProposedBy: s.addr5.String(), NewAuthority: s.addr1.String(),
+				}),
+			},
+		},
+		{
+			name: "to the exchange authority without any access grants",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId:     3,
+					AccessGrants: []exchange.AccessGrant{s.agCanEverything(s.addr5)},
+				})
+			},
+			msg: exchange.MsgMarketTransferAuthorityRequest{
+				Admin:        s.addr5.String(),
+				MarketId:     3,
+				NewAuthority: s.k.GetAuthority(),
+			},
+			fArgs: []exchange.AccessGrant{s.agCanEverything(s.addr5)},
+			expEvents: sdk.Events{
+				s.untypeEvent(&exchange.EventMarketAuthorityTransferProposed{
+					MarketId: 3, ProposedBy: s.addr5.String(), NewAuthority: s.k.GetAuthority(),
+				}),
+			},
+		},
+		{
+			name: "replaces an existing transfer",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId:     3,
+					AccessGrants: []exchange.AccessGrant{s.agCanEverything(s.addr5)},
+				})
+				err := s.k.ProposeAuthorityTransfer(s.ctx, &exchange.MarketAuthorityTransfer{
+					MarketId:     3,
+					ProposedBy:   s.addr5.String(),
+					NewAuthority: s.addr2.String(),
+					AccessGrants: []exchange.AccessGrant{s.agCanEverything(s.addr2)},
+				})
+				s.Require().NoError(err, "ProposeAuthorityTransfer")
+			},
+			msg: exchange.MsgMarketTransferAuthorityRequest{
+				Admin:        s.addr5.String(),
+				MarketId:     3,
+				NewAuthority: s.addr3.String(),
+				AccessGrants: []exchange.AccessGrant{s.agCanEverything(s.addr3)},
+			},
+			fArgs: []exchange.AccessGrant{s.agCanEverything(s.addr5)},
+			expEvents: sdk.Events{
+				s.untypeEvent(&exchange.EventMarketAuthorityTransferProposed{
+					MarketId: 3, ProposedBy: s.addr5.String(), NewAuthority: s.addr3.String(),
+				}),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runMsgServerTestCase(s, testDef, tc)
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_MarketAcceptAuthority() {
+	testDef := msgServerTestDef[exchange.MsgMarketAcceptAuthorityRequest, exchange.MsgMarketAcceptAuthorityResponse, []exchange.AccessGrant]{
+		endpointName: "MarketAcceptAuthority",
+		endpoint:     keeper.NewMsgServer(s.k).MarketAcceptAuthority,
+		expResp:      &exchange.MsgMarketAcceptAuthorityResponse{},
+		followup: func(msg *exchange.MsgMarketAcceptAuthorityRequest, expAGs []exchange.AccessGrant) {
+			transfer, err := s.k.GetAuthorityTransfer(s.ctx, msg.MarketId)
+			if s.Assert().NoError(err, "GetAuthorityTransfer(%d)", msg.MarketId) {
+				s.Assert().Nil(transfer, "GetAuthorityTransfer(%d)", msg.MarketId)
+			}
+			actAGs := s.k.GetAccessGrants(s.ctx, msg.MarketId)
+			s.Assert().Equal(expAGs, actAGs, "GetAccessGrants(%d)", msg.MarketId)
+		},
+	}
+
+	expiration := s.ctx.BlockTime().Add(time.Hour).UTC()
+	proposeTransfer := func(newAuthority string, accessGrants ...exchange.AccessGrant) {
+		err := s.k.ProposeAuthorityTransfer(s.ctx, &exchange.MarketAuthorityTransfer{
+			MarketId:     3,
+			ProposedBy:   s.addr5.String(),
+			NewAuthority: newAuthority,
+			AccessGrants: accessGrants,
+		})
+		s.Require().NoError(err, "ProposeAuthorityTransfer")
+	}
+
+	tests := []msgServerTestCase[exchange.MsgMarketAcceptAuthorityRequest, []exchange.AccessGrant]{
+		{
+			name: "no pending transfer",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId:     3,
+					AccessGrants: []exchange.AccessGrant{s.agCanEverything(s.addr5)},
+				})
+			},
+			msg:      exchange.MsgMarketAcceptAuthorityRequest{NewAuthority: s.addr1.String(), MarketId: 3},
+			expInErr: []string{invReqErr, "market 3 does not have a pending authority transfer"},
+		},
+		{
+			name: "signer is not the new authority",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId:     3,
+					AccessGrants: []exchange.AccessGrant{s.agCanEverything(s.addr5)},
+				})
+				proposeTransfer(s.addr1.String(), s.agCanEverything(s.addr1))
+			},
+			msg: exchange.MsgMarketAcceptAuthorityRequest{NewAuthority: s.addr5.String(), MarketId: 3},
+			expInErr: []string{invReqErr,
+				"account " + s.addr5.String() + " is not the new authority of the pending transfer for market 3"},
+		},
+		{
+			name: "access grant expired since proposal",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId:     3,
+					AccessGrants: []exchange.AccessGrant{s.agCanEverything(s.addr5)},
+				})
+				proposeTransfer(s.addr1.String(), exchange.AccessGrant{
+					Address: s.addr1.String(), Permissions: exchange.AllPermissions(), Expiration: &expiration,
+				})
+				s.ctx = s.ctx.WithBlockTime(expiration.Add(time.Minute))
+			},
+			msg:      exchange.MsgMarketAcceptAuthorityRequest{NewAuthority: s.addr1.String(), MarketId: 3},
+			expInErr: []string{invReqErr, "account " + s.addr1.String() + " access grant for market 3: invalid expiration"},
+		},
+		{
+			name: "to a new admin",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3,
+					AccessGrants: []exchange.AccessGrant{
+						s.agCanEverything(s.addr5),
+						s.agCanOnly(s.addr4, exchange.Permission_settle),
+					},
+				})
+				proposeTransfer(s.addr1.String(),
+					s.agCanEverything(s.addr1),
+					exchange.AccessGrant{Address: s.addr2.String(), Permissions: []exchange.Permission{exchange.Permission_settle}, Expiration: &expiration},
+				)
+			},
+			msg: exchange.MsgMarketAcceptAuthorityRequest{NewAuthority: s.addr1.String(), MarketId: 3},
+			fArgs: []exchange.AccessGrant{
+				s.agCanEverything(s.addr1),
+				{Address: s.addr2.String(), Permissions: []exchange.Permission{exchange.Permission_settle}, Expiration: &expiration},
+			},
+			expEvents: sdk.Events{
+				s.untypeEvent(&exchange.EventMarketAuthorityTransferred{
+					MarketId: 3, ProposedBy: s.addr5.String(), NewAuthority: s.addr1.String(),
+				}),
+			},
+		},
+		{
+			name: "to the exchange authority",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3,
+					AccessGrants: []exchange.AccessGrant{
+						s.agCanEverything(s.addr5),
+						s.agCanOnly(s.addr4, exchange.Permission_settle),
+					},
+				})
+				proposeTransfer(s.k.GetAuthority())
+			},
+			msg:   exchange.MsgMarketAcceptAuthorityRequest{NewAuthority: s.k.GetAuthority(), MarketId: 3},
+			fArgs: nil,
+			expEvents: sdk.Events{
+				s.untypeEvent(&exchange.EventMarketAuthorityTransferred{
+					MarketId: 3, ProposedBy: s.addr5.String(), NewAuthority: s.k.GetAuthority(),
+				}),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runMsgServerTestCase(s, testDef, tc)
+		})
+	}
+}
+
 func (s *TestSuite) TestMsgServer_MarketManageReqAttrs() {
 	type followupArgs struct {
 		expAsk []string
@@ -4948,6 +7177,88 @@ func (s *TestSuite) TestMsgServer_MarketManageReqAttrs() {
 	}
 }
 
+func (s *TestSuite) TestMsgServer_MarketManageAcceptedDenoms() {
+	type followupArgs struct {
+		expAssets []string
+		expPrices []string
+	}
+	testDef := msgServerTestDef[exchange.MsgMarketManageAcceptedDenomsRequest, exchange.MsgMarketManageAcceptedDenomsResponse, followupArgs]{
+		endpointName: "MarketManageAcceptedDenoms",
+		endpoint:     keeper.NewMsgServer(s.k).MarketManageAcceptedDenoms,
+		expResp:      &exchange.MsgMarketManageAcceptedDenomsResponse{},
+		followup: func(msg *exchange.MsgMarketManageAcceptedDenomsRequest, fArgs followupArgs) {
+			actAssets := s.k.GetAcceptedAssetDenoms(s.ctx, msg.MarketId)
+			actPrices := s.k.GetAcceptedPriceDenoms(s.ctx, msg.MarketId)
+			s.Assert().Equal(fArgs.expAssets, actAssets, "market %d accepted asset denoms", msg.MarketId)
+			s.Assert().Equal(fArgs.expPrices, actPrices, "market %d accepted price denoms", msg.MarketId)
+		},
+	}
+
+	tests := []msgServerTestCase[exchange.MsgMarketManageAcceptedDenomsRequest, followupArgs]{
+		{
+			name: "admin does not have permission to update market",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId:     1,
+					AccessGrants: []exchange.AccessGrant{s.agCanAllBut(s.addr5, exchange.Permission_update)},
+				})
+			},
+			msg: exchange.MsgMarketManageAcceptedDenomsRequest{
+				Admin: s.addr5.String(), MarketId: 1, AssetDenomsToAdd: []string{"apple"},
+			},
+			expInErr: []string{invReqErr,
+				"account " + s.addr5.String() + " does not have permission to update market 1"},
+		},
+		{
+			name: "error updating accepted denoms",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId:     1,
+					AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+				})
+			},
+			msg: exchange.MsgMarketManageAcceptedDenomsRequest{
+				Admin:               s.addr5.String(),
+				MarketId:            1,
+				PriceDenomsToRemove: []string{"nope"},
+			},
+			expInErr: []string{invReqErr,
+				"cannot remove accepted price denom \"nope\": denom not currently accepted"},
+		},
+		{
+			name: "okay",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId:            1,
+					AccessGrants:        []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					AcceptedAssetDenoms: []string{"apple", "banana"},
+					AcceptedPriceDenoms: []string{"peach"},
+				})
+			},
+			msg: exchange.MsgMarketManageAcceptedDenomsRequest{
+				Admin:               s.addr5.String(),
+				MarketId:            1,
+				AssetDenomsToAdd:    []string{"cherry"},
+				AssetDenomsToRemove: []string{"apple"},
+				PriceDenomsToAdd:    []string{"plum"},
+			},
+			fArgs: followupArgs{
+				expAssets: []string{"banana", "cherry"},
+				expPrices: []string{"peach", "plum"},
+			},
+			expEvents: sdk.Events{
+				s.untypeEvent(&exchange.EventMarketAcceptedDenomsUpdated{MarketId: 1, UpdatedBy: s.addr5.String()}),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runMsgServerTestCase(s, testDef, tc)
+		})
+	}
+}
+
 func (s *TestSuite) TestMsgServer_CreatePayment() {
 	testDef := msgServerTestDef[exchange.MsgCreatePaymentRequest, exchange.MsgCreatePaymentResponse, []expBalances]{
 		endpointName: "CreatePayment",
@@ -5084,7 +7395,196 @@ func (s *TestSuite) TestMsgServer_AcceptPayment() {
 
 	for _, tc := range tests {
 		s.Run(tc.name, func() {
-			runMsgServerTestCase(s, testDef, tc)
+			runMsgServerTestCase(s, testDef, tc)
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_AcceptPayments() {
+	type followupArgs struct {
+		expFee  bool
+		expBals []expBalances
+	}
+	testDef := msgServerTestDef[exchange.MsgAcceptPaymentsRequest, exchange.MsgAcceptPaymentsResponse, followupArgs]{
+		endpointName: "AcceptPayments",
+		endpoint:     keeper.NewMsgServer(s.k).AcceptPayments,
+		followup: func(msg *exchange.MsgAcceptPaymentsRequest, fArgs followupArgs) {
+			for _, eb := range fArgs.expBals {
+				s.checkBalances(eb)
+			}
+
+			if fArgs.expFee {
+				s.assertNonZeroMsgFeeConsumed(msg)
+			} else {
+				feeGm, err := antewrapper.GetFeeGasMeter(s.ctx)
+				if s.Assert().NoError(err, "GetFeeGasMeter") {
+					msgType := sdk.MsgTypeURL(msg)
+					s.Assert().True(feeGm.FeeConsumedByMsg()[msgType].IsZero(), "FeeConsumedByMsg()[%q].IsZero()", msgType)
+				}
+			}
+		},
+	}
+
+	tests := []struct {
+		msgServerTestCase[exchange.MsgAcceptPaymentsRequest, followupArgs]
+		expResp *exchange.MsgAcceptPaymentsResponse
+	}{
+		{
+			msgServerTestCase: msgServerTestCase[exchange.MsgAcceptPaymentsRequest, followupArgs]{
+				name: "invalid target",
+				msg: exchange.MsgAcceptPaymentsRequest{
+					Target:  "nopenopenope",
+					Sources: []string{s.addr3.String()},
+				},
+				expInErr: []string{invReqErr,
+					"invalid target \"nopenopenope\": decoding bech32 failed: invalid separator index -1"},
+			},
+		},
+		{
+			msgServerTestCase: msgServerTestCase[exchange.MsgAcceptPaymentsRequest, followupArgs]{
+				name: "invalid source",
+				msg: exchange.MsgAcceptPaymentsRequest{
+					Target:  s.addr2.String(),
+					Sources: []string{s.addr3.String(), "thricenope"},
+				},
+				expInErr: []string{invReqErr,
+					"invalid sources[1] \"thricenope\": decoding bech32 failed: invalid separator index -1"},
+			},
+		},
+		{
+			msgServerTestCase: msgServerTestCase[exchange.MsgAcceptPaymentsRequest, followupArgs]{
+				name:     "no payment ids or sources",
+				msg:      exchange.MsgAcceptPaymentsRequest{Target: s.addr2.String()},
+				expInErr: []string{invReqErr, "at least one payment id or source is required"},
+			},
+		},
+		{
+			msgServerTestCase: msgServerTestCase[exchange.MsgAcceptPaymentsRequest, followupArgs]{
+				name: "no such payment",
+				msg: exchange.MsgAcceptPaymentsRequest{
+					Target:     s.addr2.String(),
+					PaymentIds: []exchange.PaymentID{{Source: s.addr3.String(), ExternalId: "nope"}},
+				},
+			},
+			expResp: &exchange.MsgAcceptPaymentsResponse{Results: []exchange.AcceptPaymentResult{{
+				Source:     s.addr3.String(),
+				ExternalId: "nope",
+				Error:      "no payment found with source " + s.addr3.String() + " and external id \"nope\"",
+			}}},
+		},
+		{
+			msgServerTestCase: msgServerTestCase[exchange.MsgAcceptPaymentsRequest, followupArgs]{
+				name: "payments without target amounts accepted",
+				setup: func() {
+					s.requireFundAccount(s.addr1, "100apple,50starfruit")
+					s.requireFundAccount(s.addr3, "100apple,50strawberry")
+					s.requireCreatePayments(
+						s.newTestPayment(s.addr1, "5starfruit", s.addr4, "", "one"),
+						s.newTestPayment(s.addr3, "7strawberry", s.addr4, "", "two"),
+					)
+				},
+				msg: exchange.MsgAcceptPaymentsRequest{
+					Target:  s.addr4.String(),
+					Sources: []string{s.addr1.String(), s.addr3.String()},
+				},
+				fArgs: followupArgs{
+					expFee: false,
+					expBals: []expBalances{
+						{
+							addr:    s.addr1,
+							expBal:  s.coins("100apple,45starfruit"),
+							expHold: s.zeroCoins("apple", "starfruit"),
+						},
+						{
+							addr:    s.addr3,
+							expBal:  s.coins("100apple,43strawberry"),
+							expHold: s.zeroCoins("apple", "strawberry"),
+						},
+						{
+							addr:   s.addr4,
+							expBal: s.coins("5starfruit,7strawberry"),
+						},
+					},
+				},
+				expEvents: sdk.Events{
+					s.eventHoldReleased(s.addr1, "5starfruit"),
+					s.eventCoinSpent(s.addr1, "5starfruit"),
+					s.eventCoinReceived(s.addr4, "5starfruit"),
+					s.eventTransfer(s.addr4, s.addr1, "5starfruit"),
+					s.eventMessageSender(s.addr1),
+					s.untypeEvent(exchange.NewEventPaymentAccepted(s.newTestPayment(s.addr1, "5starfruit", s.addr4, "", "one"))),
+					s.eventHoldReleased(s.addr3, "7strawberry"),
+					s.eventCoinSpent(s.addr3, "7strawberry"),
+					s.eventCoinReceived(s.addr4, "7strawberry"),
+					s.eventTransfer(s.addr4, s.addr3, "7strawberry"),
+					s.eventMessageSender(s.addr3),
+					s.untypeEvent(exchange.NewEventPaymentAccepted(s.newTestPayment(s.addr3, "7strawberry", s.addr4, "", "two"))),
+				},
+			},
+			expResp: &exchange.MsgAcceptPaymentsResponse{Results: []exchange.AcceptPaymentResult{
+				{Source: s.addr1.String(), ExternalId: "one", Accepted: true},
+				{Source: s.addr3.String(), ExternalId: "two", Accepted: true},
+			}},
+		},
+		{
+			msgServerTestCase: msgServerTestCase[exchange.MsgAcceptPaymentsRequest, followupArgs]{
+				name: "one accepted with a target amount, one over the max",
+				setup: func() {
+					s.requireFundAccount(s.longAddr1, "100apple,50starfruit")
+					s.requireFundAccount(s.addr4, "100apple,20tangerine")
+					s.requireCreatePayments(
+						s.newTestPayment(s.longAddr1, "5starfruit", s.addr4, "6tangerine", "one"),
+						s.newTestPayment(s.longAddr1, "8starfruit", s.addr4, "9tangerine", "two"),
+					)
+				},
+				msg: exchange.MsgAcceptPaymentsRequest{
+					Target: s.addr4.String(),
+					PaymentIds: []exchange.PaymentID{
+						{Source: s.longAddr1.String(), ExternalId: "one"},
+						{Source: s.longAddr1.String(), ExternalId: "two"},
+					},
+					MaxTargetAmount: s.coins("6tangerine"),
+				},
+				fArgs: followupArgs{
+					expFee: true,
+					expBals: []expBalances{
+						{
+							addr:    s.longAddr1,
+							expBal:  s.coins("100apple,45starfruit,6tangerine"),
+							expHold: s.coins("8starfruit"),
+						},
+						{
+							addr:   s.addr4,
+							expBal: s.coins("100apple,5starfruit,14tangerine"),
+						},
+					},
+				},
+				expEvents: sdk.Events{
+					s.eventHoldReleased(s.longAddr1, "5starfruit"),
+					s.eventCoinSpent(s.longAddr1, "5starfruit"),
+					s.eventCoinReceived(s.addr4, "5starfruit"),
+					s.eventTransfer(s.addr4, s.longAddr1, "5starfruit"),
+					s.eventMessageSender(s.longAddr1),
+					s.eventCoinSpent(s.addr4, "6tangerine"),
+					s.eventCoinReceived(s.longAddr1, "6tangerine"),
+					s.eventTransfer(s.longAddr1, s.addr4, "6tangerine"),
+					s.eventMessageSender(s.addr4),
+					s.untypeEvent(exchange.NewEventPaymentAccepted(s.newTestPayment(s.longAddr1, "5starfruit", s.addr4, "6tangerine", "one"))),
+				},
+			},
+			expResp: &exchange.MsgAcceptPaymentsResponse{Results: []exchange.AcceptPaymentResult{
+				{Source: s.longAddr1.String(), ExternalId: "one", Accepted: true},
+				{Source: s.longAddr1.String(), ExternalId: "two",
+					Error: "target amount \"9tangerine\" is more than the max target amount \"6tangerine\""},
+			}},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			td := testDef
+			td.expResp = tc.expResp
+			runMsgServerTestCase(s, td, tc.msgServerTestCase)
 		})
 	}
 }
@@ -5209,6 +7709,99 @@ func (s *TestSuite) TestMsgServer_RejectPayment() {
 	}
 }
 
+func (s *TestSuite) TestMsgServer_DisputePayment() {
+	escrow := exchange.GetPaymentEscrowAddress()
+	testDef := msgServerTestDef[exchange.MsgDisputePaymentRequest, exchange.MsgDisputePaymentResponse, []expBalances]{
+		endpointName: "DisputePayment",
+		endpoint:     keeper.NewMsgServer(s.k).DisputePayment,
+		expResp:      &exchange.MsgDisputePaymentResponse{},
+		followup: func(msg *exchange.MsgDisputePaymentRequest, expBals []expBalances) {
+			if source, ok := s.assertAccAddressFromBech32(msg.Source, "msg.Source"); ok {
+				ep, err := s.k.GetEscrowedPayment(s.ctx, source, msg.ExternalId)
+				if s.Assert().NoError(err, "GetEscrowedPayment(%s, %q): The payment that was just disputed", msg.Source, msg.ExternalId) {
+					s.Assert().Nil(ep, "the escrowed payment that was (supposedly) just disputed")
+				}
+			}
+
+			for _, eb := range expBals {
+				s.checkBalances(eb)
+			}
+		},
+	}
+
+	tests := []msgServerTestCase[exchange.MsgDisputePaymentRequest, []expBalances]{
+		{
+			name: "invalid target",
+			msg: exchange.MsgDisputePaymentRequest{
+				Target:     "nopenopenope",
+				Source:     s.addr3.String(),
+				ExternalId: "a",
+			},
+			expInErr: []string{invReqErr,
+				"invalid target \"nopenopenope\": decoding bech32 failed: invalid separator index -1"},
+		},
+		{
+			name: "invalid source",
+			msg: exchange.MsgDisputePaymentRequest{
+				Target:     s.addr2.String(),
+				Source:     "thricenope",
+				ExternalId: "a",
+			},
+			expInErr: []string{invReqErr,
+				"invalid source \"thricenope\": decoding bech32 failed: invalid separator index -1"},
+		},
+		{
+			name: "no such escrowed payment",
+			msg: exchange.MsgDisputePaymentRequest{
+				Target:     s.addr2.String(),
+				Source:     s.addr3.String(),
+				ExternalId: "oops",
+			},
+			expInErr: []string{invReqErr,
+				"no escrowed payment found with source " + s.addr3.String() + " and external id \"oops\""},
+		},
+		{
+			name: "payment disputed",
+			setup: func() {
+				s.requireFundAccount(escrow, "49strawberry,100tangerine")
+				s.requireSetEscrowedPaymentsInStore(s.newTestEscrowedPayment(
+					s.addr2, "49strawberry", s.addr3, "100tangerine", "four-oh-nine", s.ctx.BlockTime().Add(time.Hour)))
+			},
+			msg: exchange.MsgDisputePaymentRequest{
+				Target:     s.addr3.String(),
+				Source:     s.addr2.String(),
+				ExternalId: "four-oh-nine",
+			},
+			fArgs: []expBalances{
+				{addr: escrow, expBal: s.zeroCoins("strawberry", "tangerine")},
+				{addr: s.addr2, expBal: s.coins("49strawberry")},
+				{addr: s.addr3, expBal: s.coins("100tangerine")},
+			},
+			expEvents: sdk.Events{
+				// Refund to source.
+				s.eventCoinSpent(escrow, "49strawberry"),
+				s.eventCoinReceived(s.addr2, "49strawberry"),
+				s.eventTransfer(s.addr2, escrow, "49strawberry"),
+				s.eventMessageSender(escrow),
+				// Refund to target.
+				s.eventCoinSpent(escrow, "100tangerine"),
+				s.eventCoinReceived(s.addr3, "100tangerine"),
+				s.eventTransfer(s.addr3, escrow, "100tangerine"),
+				s.eventMessageSender(escrow),
+				// Payment disputed.
+				s.untypeEvent(exchange.NewEventPaymentDisputed(
+					s.newTestPayment(s.addr2, "49strawberry", s.addr3, "100tangerine", "four-oh-nine"))),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runMsgServerTestCase(s, testDef, tc)
+		})
+	}
+}
+
 func (s *TestSuite) TestMsgServer_RejectPayments() {
 	testDef := msgServerTestDef[exchange.MsgRejectPaymentsRequest, exchange.MsgRejectPaymentsResponse, []expBalances]{
 		endpointName: "RejectPayments",
@@ -5426,12 +8019,323 @@ func (s *TestSuite) TestMsgServer_CancelPayments() {
 				},
 			},
 			expEvents: sdk.Events{
-				s.eventHoldReleased(s.longAddr3, "4strawberry"),
-				s.eventHoldReleased(s.longAddr3, "8strawberry"),
-				s.eventHoldReleased(s.longAddr3, "1strawberry"),
-				s.untypeEvent(exchange.NewEventPaymentCancelled(s.newTestPayment(s.longAddr3, "4strawberry", s.addr4, "12tangerine", "ghi"))),
-				s.untypeEvent(exchange.NewEventPaymentCancelled(s.newTestPayment(s.longAddr3, "8strawberry", s.addr1, "13tangerine", ""))),
-				s.untypeEvent(exchange.NewEventPaymentCancelled(s.newTestPayment(s.longAddr3, "1strawberry", s.longAddr2, "10tangerine", "abc"))),
+				s.eventHoldReleased(s.longAddr3, "4strawberry"),
+				s.eventHoldReleased(s.longAddr3, "8strawberry"),
+				s.eventHoldReleased(s.longAddr3, "1strawberry"),
+				s.untypeEvent(exchange.NewEventPaymentCancelled(s.newTestPayment(s.longAddr3, "4strawberry", s.addr4, "12tangerine", "ghi"))),
+				s.untypeEvent(exchange.NewEventPaymentCancelled(s.newTestPayment(s.longAddr3, "8strawberry", s.addr1, "13tangerine", ""))),
+				s.untypeEvent(exchange.NewEventPaymentCancelled(s.newTestPayment(s.longAddr3, "1strawberry", s.longAddr2, "10tangerine", "abc"))),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runMsgServerTestCase(s, testDef, tc)
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_ChangePaymentTarget() {
+	testDef := msgServerTestDef[exchange.MsgChangePaymentTargetRequest, exchange.MsgChangePaymentTargetResponse, struct{}]{
+		endpointName: "ChangePaymentTarget",
+		endpoint:     keeper.NewMsgServer(s.k).ChangePaymentTarget,
+		expResp:      &exchange.MsgChangePaymentTargetResponse{},
+		followup: func(msg *exchange.MsgChangePaymentTargetRequest, _ struct{}) {
+			source := s.requireAccAddressFromBech32(msg.Source, "msg.Source")
+			payment, err := s.k.GetPayment(s.ctx, source, msg.ExternalId)
+			s.Require().NoError(err, "GetPayment(%s, %q) error", msg.Source, msg.ExternalId)
+			s.Require().NotNil(payment, "GetPayment(%s, %q) result", msg.Source, msg.ExternalId)
+			s.Assert().Equal(msg.NewTarget, payment.Target, "payment.Target")
+		},
+	}
+
+	tests := []msgServerTestCase[exchange.MsgChangePaymentTargetRequest, struct{}]{
+		{
+			name: "no source",
+			msg: exchange.MsgChangePaymentTargetRequest{
+				Source:     "",
+				ExternalId: "whatever",
+				NewTarget:  s.addr3.String(),
+			},
+			expInErr: []string{invReqErr, "invalid source \"\": empty address string is not allowed"},
+		},
+		{
+			name: "invalid source",
+			msg: exchange.MsgChangePaymentTargetRequest{
+				Source:     "faultysource",
+				ExternalId: "other",
+				NewTarget:  s.addr2.String(),
+			},
+			expInErr: []string{invReqErr,
+				"invalid source \"faultysource\": decoding bech32 failed: invalid separator index -1"},
+		},
+		{
+			name: "invalid new target",
+			msg: exchange.MsgChangePaymentTargetRequest{
+				Source:     s.addr1.String(),
+				ExternalId: "something",
+				NewTarget:  "whatiswrong",
+			},
+			expInErr: []string{invReqErr,
+				"invalid new target \"whatiswrong\": decoding bech32 failed: invalid separator index -1"},
+		},
+		{
+			name: "no such payment",
+			msg: exchange.MsgChangePaymentTargetRequest{
+				Source:     s.addr2.String(),
+				ExternalId: "imaginary",
+				NewTarget:  s.longAddr2.String(),
+			},
+			expInErr: []string{invReqErr,
+				"no payment found with source " + s.addr2.String() + " and external id \"imaginary\""},
+		},
+		{
+			name: "payment updated to no target",
+			setup: func() {
+				s.requireFundAccount(s.addr5, "100apple,100strawberry,100tangerine")
+				s.requireCreatePayments(
+					s.newTestPayment(s.addr5, "3strawberry", s.addr4, "80tangerine", "badtrade"),
+				)
+			},
+			msg: exchange.MsgChangePaymentTargetRequest{
+				Source:     s.addr5.String(),
+				ExternalId: "badtrade",
+				NewTarget:  "",
+			},
+			expEvents: sdk.Events{
+				s.untypeEvent(exchange.NewEventPaymentUpdated(
+					s.newTestPayment(s.addr5, "3strawberry", nil, "80tangerine", "badtrade"), s.addr4.String())),
+			},
+		},
+		{
+			name: "payment updated to different target",
+			setup: func() {
+				s.requireFundAccount(s.addr5, "100apple,100strawberry,100tangerine")
+				s.requireCreatePayments(
+					s.newTestPayment(s.addr5, "3strawberry", s.addr4, "80tangerine", "badtrade"),
+				)
+			},
+			msg: exchange.MsgChangePaymentTargetRequest{
+				Source:     s.addr5.String(),
+				ExternalId: "badtrade",
+				NewTarget:  s.longAddr2.String(),
+			},
+			expEvents: sdk.Events{
+				s.untypeEvent(exchange.NewEventPaymentUpdated(
+					s.newTestPayment(s.addr5, "3strawberry", s.longAddr2, "80tangerine", "badtrade"), s.addr4.String())),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runMsgServerTestCase(s, testDef, tc)
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_CreateRecurringPayment() {
+	blockTime := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	startTime := blockTime.Add(24 * time.Hour)
+	pastTime := blockTime.Add(-1 * time.Hour)
+	testDef := msgServerTestDef[exchange.MsgCreateRecurringPaymentRequest, exchange.MsgCreateRecurringPaymentResponse, time.Time]{
+		endpointName: "CreateRecurringPayment",
+		endpoint:     keeper.NewMsgServer(s.k).CreateRecurringPayment,
+		expResp:      &exchange.MsgCreateRecurringPaymentResponse{},
+		followup: func(msg *exchange.MsgCreateRecurringPaymentRequest, expNext time.Time) {
+			source := s.requireAccAddressFromBech32(msg.Source, "msg.Source")
+			rp, err := s.k.GetRecurringPayment(s.ctx, source, msg.ExternalId)
+			s.Require().NoError(err, "GetRecurringPayment(%s, %q) error", msg.Source, msg.ExternalId)
+			s.Require().NotNil(rp, "GetRecurringPayment(%s, %q) result", msg.Source, msg.ExternalId)
+			s.Assert().Equal(msg.Target, rp.Target, "Target")
+			s.Assert().Equal(msg.Amount.String(), rp.Amount.String(), "Amount")
+			s.Assert().Equal(msg.IntervalSeconds, rp.IntervalSeconds, "IntervalSeconds")
+			s.Assert().Equal(msg.Transfers, rp.TransfersRemaining, "TransfersRemaining")
+			s.Assert().Equal(expNext.UTC(), rp.NextTransferTime.UTC(), "NextTransferTime")
+		},
+	}
+	setBlockTime := func() {
+		s.ctx = s.ctx.WithBlockTime(blockTime)
+	}
+
+	tests := []msgServerTestCase[exchange.MsgCreateRecurringPaymentRequest, time.Time]{
+		{
+			name:  "start time in the past",
+			setup: setBlockTime,
+			msg: exchange.MsgCreateRecurringPaymentRequest{
+				Source: s.addr1.String(), Target: s.addr2.String(), Amount: s.coins("5strawberry"),
+				ExternalId: "sub", IntervalSeconds: 3600, Transfers: 3, StartTime: &pastTime,
+			},
+			expInErr: []string{invReqErr, "invalid next transfer time 2030-01-01T11:00:00Z: " +
+				"cannot be before the current block time 2030-01-01T12:00:00Z"},
+		},
+		{
+			name: "already exists",
+			setup: func() {
+				setBlockTime()
+				s.requireSetRecurringPaymentsInStore(s.newTestRecurringPayment(s.addr1, s.addr3, "1strawberry", "sub", 2, blockTime))
+			},
+			msg: exchange.MsgCreateRecurringPaymentRequest{
+				Source: s.addr1.String(), Target: s.addr2.String(), Amount: s.coins("5strawberry"),
+				ExternalId: "sub", IntervalSeconds: 3600, Transfers: 3,
+			},
+			expInErr: []string{invReqErr, "a recurring payment already exists with source " + s.addr1.String() +
+				" and external id \"sub\""},
+		},
+		{
+			name:  "starts now",
+			setup: setBlockTime,
+			msg: exchange.MsgCreateRecurringPaymentRequest{
+				Source: s.addr1.String(), Target: s.addr2.String(), Amount: s.coins("5strawberry"),
+				ExternalId: "sub", IntervalSeconds: 3600, Transfers: 3,
+			},
+			expEvents: sdk.Events{
+				s.untypeEvent(exchange.NewEventRecurringPaymentCreated(
+					s.newTestRecurringPayment(s.addr1, s.addr2, "5strawberry", "sub", 3, blockTime))),
+			},
+			fArgs: blockTime,
+		},
+		{
+			name:  "starts later",
+			setup: setBlockTime,
+			msg: exchange.MsgCreateRecurringPaymentRequest{
+				Source: s.addr4.String(), Target: s.addr5.String(), Amount: s.coins("8tangerine"),
+				ExternalId: "payroll", IntervalSeconds: 86400, Transfers: 12, StartTime: &startTime,
+			},
+			expEvents: sdk.Events{
+				s.untypeEvent(exchange.NewEventRecurringPaymentCreated(
+					s.newTestRecurringPayment(s.addr4, s.addr5, "8tangerine", "payroll", 12, startTime))),
+			},
+			fArgs: startTime,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runMsgServerTestCase(s, testDef, tc)
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_CancelRecurringPayments() {
+	nextTime := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	testDef := msgServerTestDef[exchange.MsgCancelRecurringPaymentsRequest, exchange.MsgCancelRecurringPaymentsResponse, struct{}]{
+		endpointName: "CancelRecurringPayments",
+		endpoint:     keeper.NewMsgServer(s.k).CancelRecurringPayments,
+		expResp:      &exchange.MsgCancelRecurringPaymentsResponse{},
+		followup: func(msg *exchange.MsgCancelRecurringPaymentsRequest, _ struct{}) {
+			source := s.requireAccAddressFromBech32(msg.Source, "msg.Source")
+			for _, externalID := range msg.ExternalIds {
+				rp, err := s.k.GetRecurringPayment(s.ctx, source, externalID)
+				s.Assert().NoError(err, "GetRecurringPayment(%s, %q) error", msg.Source, externalID)
+				s.Assert().Nil(rp, "GetRecurringPayment(%s, %q) result", msg.Source, externalID)
+			}
+		},
+	}
+
+	tests := []msgServerTestCase[exchange.MsgCancelRecurringPaymentsRequest, struct{}]{
+		{
+			name:     "invalid source",
+			msg:      exchange.MsgCancelRecurringPaymentsRequest{Source: "faultysource", ExternalIds: []string{"a"}},
+			expInErr: []string{invReqErr, "invalid source \"faultysource\": decoding bech32 failed: invalid separator index -1"},
+		},
+		{
+			name: "no such recurring payment",
+			msg:  exchange.MsgCancelRecurringPaymentsRequest{Source: s.addr2.String(), ExternalIds: []string{"imaginary"}},
+			expInErr: []string{invReqErr,
+				"no recurring payment found with source " + s.addr2.String() + " and external id \"imaginary\""},
+		},
+		{
+			name: "cancelled",
+			setup: func() {
+				s.requireSetRecurringPaymentsInStore(
+					s.newTestRecurringPayment(s.addr2, s.addr3, "1strawberry", "a", 2, nextTime),
+					s.newTestRecurringPayment(s.addr2, s.addr4, "2strawberry", "b", 5, nextTime),
+				)
+			},
+			msg: exchange.MsgCancelRecurringPaymentsRequest{Source: s.addr2.String(), ExternalIds: []string{"b", "a"}},
+			expEvents: sdk.Events{
+				s.untypeEvent(exchange.NewEventRecurringPaymentCancelled(
+					s.newTestRecurringPayment(s.addr2, s.addr4, "2strawberry", "b", 5, nextTime))),
+				s.untypeEvent(exchange.NewEventRecurringPaymentCancelled(
+					s.newTestRecurringPayment(s.addr2, s.addr3, "1strawberry", "a", 2, nextTime))),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runMsgServerTestCase(s, testDef, tc)
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_CreateMultiPartyPayment() {
+	testDef := msgServerTestDef[exchange.MsgCreateMultiPartyPaymentRequest, exchange.MsgCreateMultiPartyPaymentResponse, []expBalances]{
+		endpointName: "CreateMultiPartyPayment",
+		endpoint:     keeper.NewMsgServer(s.k).CreateMultiPartyPayment,
+		expResp:      &exchange.MsgCreateMultiPartyPaymentResponse{},
+		followup: func(msg *exchange.MsgCreateMultiPartyPaymentRequest, expBals []expBalances) {
+			creator := s.requireAccAddressFromBech32(msg.Creator, "msg.Creator")
+			mpp, err := s.k.GetMultiPartyPayment(s.ctx, creator, msg.ExternalId)
+			s.Require().NoError(err, "GetMultiPartyPayment(%s, %q) error", msg.Creator, msg.ExternalId)
+			s.Require().NotNil(mpp, "GetMultiPartyPayment(%s, %q) result", msg.Creator, msg.ExternalId)
+			s.Require().Len(mpp.Parties, len(msg.Parties), "Parties")
+			for i, party := range mpp.Parties {
+				s.Assert().True(party.EqualTerms(msg.Parties[i]), "Parties[%d] terms", i)
+				s.Assert().Equal(party.Address == msg.Creator, party.Accepted, "Parties[%d].Accepted", i)
+			}
+
+			for _, eb := range expBals {
+				s.checkBalances(eb)
+			}
+
+			s.assertNonZeroMsgFeeConsumed(msg)
+		},
+	}
+
+	tests := []msgServerTestCase[exchange.MsgCreateMultiPartyPaymentRequest, []expBalances]{
+		{
+			name: "creator is not a party",
+			msg: exchange.MsgCreateMultiPartyPaymentRequest{
+				Creator: s.addr4.String(), ExternalId: "three-way",
+				Parties: s.newTestMultiPartyPayment(s.addr4, "three-way",
+					s.newTestPaymentParty(s.addr1, "5strawberry", "", false),
+					s.newTestPaymentParty(s.addr2, "", "5strawberry", false),
+				).Parties,
+			},
+			expInErr: []string{invReqErr, "creator " + s.addr4.String() + " is not one of the parties"},
+		},
+		{
+			name: "multi-party payment created",
+			setup: func() {
+				s.requireFundAccount(s.addr1, "100apple,25strawberry")
+			},
+			msg: exchange.MsgCreateMultiPartyPaymentRequest{
+				Creator: s.addr1.String(), ExternalId: "three-way",
+				Parties: s.newTestMultiPartyPayment(s.addr1, "three-way",
+					s.newTestPaymentParty(s.addr1, "5strawberry", "2cherry", false),
+					s.newTestPaymentParty(s.addr2, "3tangerine", "5strawberry", false),
+					s.newTestPaymentParty(s.addr3, "2cherry", "3tangerine", false),
+				).Parties,
+			},
+			fArgs: []expBalances{
+				{
+					addr:    s.addr1,
+					expBal:  []sdk.Coin{s.coin("100apple"), s.coin("25strawberry")},
+					expHold: s.coins("5strawberry"),
+				},
+			},
+			expEvents: sdk.Events{
+				s.eventHoldAddedMultiPartyPayment(s.addr1, "5strawberry", "three-way"),
+				s.untypeEvent(&exchange.EventMultiPartyPaymentCreated{
+					Creator: s.addr1.String(), ExternalId: "three-way",
+					Parties: []string{s.addr1.String(), s.addr2.String(), s.addr3.String()},
+				}),
+				s.untypeEvent(&exchange.EventMultiPartyPaymentAccepted{
+					Creator: s.addr1.String(), ExternalId: "three-way", Party: s.addr1.String(),
+				}),
 			},
 		},
 	}
@@ -5443,94 +8347,215 @@ func (s *TestSuite) TestMsgServer_CancelPayments() {
 	}
 }
 
-func (s *TestSuite) TestMsgServer_ChangePaymentTarget() {
-	testDef := msgServerTestDef[exchange.MsgChangePaymentTargetRequest, exchange.MsgChangePaymentTargetResponse, struct{}]{
-		endpointName: "ChangePaymentTarget",
-		endpoint:     keeper.NewMsgServer(s.k).ChangePaymentTarget,
-		expResp:      &exchange.MsgChangePaymentTargetResponse{},
-		followup: func(msg *exchange.MsgChangePaymentTargetRequest, _ struct{}) {
-			source := s.requireAccAddressFromBech32(msg.Source, "msg.Source")
-			payment, err := s.k.GetPayment(s.ctx, source, msg.ExternalId)
-			s.Require().NoError(err, "GetPayment(%s, %q) error", msg.Source, msg.ExternalId)
-			s.Require().NotNil(payment, "GetPayment(%s, %q) result", msg.Source, msg.ExternalId)
-			s.Assert().Equal(msg.NewTarget, payment.Target, "payment.Target")
+func (s *TestSuite) TestMsgServer_AcceptMultiPartyPayment() {
+	type followupArgs struct {
+		settled bool
+		expBals []expBalances
+	}
+	testDef := msgServerTestDef[exchange.MsgAcceptMultiPartyPaymentRequest, exchange.MsgAcceptMultiPartyPaymentResponse, followupArgs]{
+		endpointName: "AcceptMultiPartyPayment",
+		endpoint:     keeper.NewMsgServer(s.k).AcceptMultiPartyPayment,
+		followup: func(msg *exchange.MsgAcceptMultiPartyPaymentRequest, fArgs followupArgs) {
+			creator := s.requireAccAddressFromBech32(msg.Creator, "msg.Creator")
+			mpp, err := s.k.GetMultiPartyPayment(s.ctx, creator, msg.ExternalId)
+			s.Require().NoError(err, "GetMultiPartyPayment(%s, %q) error", msg.Creator, msg.ExternalId)
+			if fArgs.settled {
+				s.Assert().Nil(mpp, "GetMultiPartyPayment(%s, %q) result", msg.Creator, msg.ExternalId)
+			} else if s.Assert().NotNil(mpp, "GetMultiPartyPayment(%s, %q) result", msg.Creator, msg.ExternalId) {
+				party := mpp.GetParty(msg.Party)
+				if s.Assert().NotNil(party, "GetParty(%s)", msg.Party) {
+					s.Assert().True(party.Accepted, "GetParty(%s).Accepted", msg.Party)
+				}
+			}
+
+			for _, eb := range fArgs.expBals {
+				s.checkBalances(eb)
+			}
+
+			s.assertNonZeroMsgFeeConsumed(msg)
 		},
 	}
 
-	tests := []msgServerTestCase[exchange.MsgChangePaymentTargetRequest, struct{}]{
+	parties := s.newTestMultiPartyPayment(s.addr1, "three-way",
+		s.newTestPaymentParty(s.addr1, "5strawberry", "2cherry", false),
+		s.newTestPaymentParty(s.addr2, "3tangerine", "5strawberry", false),
+		s.newTestPaymentParty(s.addr3, "2cherry", "3tangerine", false),
+	).Parties
+	setup := func(accepters ...sdk.AccAddress) func() {
+		return func() {
+			s.requireFundAccount(s.addr1, "100apple,25strawberry")
+			s.requireFundAccount(s.addr2, "100apple,20tangerine")
+			s.requireFundAccount(s.addr3, "100apple,10cherry")
+			mpp := s.newTestMultiPartyPayment(s.addr1, "three-way", parties...)
+			mpp.Parties = append([]exchange.PaymentParty{}, mpp.Parties...)
+			assertions.RequireNotPanicsNoErrorf(s.T(), func() error {
+				return s.k.CreateMultiPartyPayment(s.ctx, mpp)
+			}, "CreateMultiPartyPayment")
+			for _, accepter := range accepters {
+				assertions.RequireNotPanicsNoErrorf(s.T(), func() error {
+					_, err := s.k.AcceptMultiPartyPayment(s.ctx, accepter, s.addr1, "three-way", parties)
+					return err
+				}, "AcceptMultiPartyPayment(%s)", s.getAddrName(accepter))
+			}
+		}
+	}
+
+	tests := []msgServerTestCase[exchange.MsgAcceptMultiPartyPaymentRequest, followupArgs]{
 		{
-			name: "no source",
-			msg: exchange.MsgChangePaymentTargetRequest{
-				Source:     "",
-				ExternalId: "whatever",
-				NewTarget:  s.addr3.String(),
+			name: "invalid creator",
+			msg: exchange.MsgAcceptMultiPartyPaymentRequest{
+				Party: s.addr2.String(), Creator: "faultycreator", ExternalId: "three-way", Parties: parties,
 			},
-			expInErr: []string{invReqErr, "invalid source \"\": empty address string is not allowed"},
+			expInErr: []string{invReqErr, "invalid creator \"faultycreator\": decoding bech32 failed: invalid separator index -1"},
 		},
 		{
-			name: "invalid source",
-			msg: exchange.MsgChangePaymentTargetRequest{
-				Source:     "faultysource",
-				ExternalId: "other",
-				NewTarget:  s.addr2.String(),
+			name: "no such multi-party payment",
+			msg: exchange.MsgAcceptMultiPartyPaymentRequest{
+				Party: s.addr2.String(), Creator: s.addr1.String(), ExternalId: "three-way", Parties: parties,
 			},
 			expInErr: []string{invReqErr,
-				"invalid source \"faultysource\": decoding bech32 failed: invalid separator index -1"},
+				"no multi-party payment found with creator " + s.addr1.String() + " and external id \"three-way\""},
 		},
 		{
-			name: "invalid new target",
-			msg: exchange.MsgChangePaymentTargetRequest{
-				Source:     s.addr1.String(),
-				ExternalId: "something",
-				NewTarget:  "whatiswrong",
+			name:  "accepted but not settled",
+			setup: setup(),
+			msg: exchange.MsgAcceptMultiPartyPaymentRequest{
+				Party: s.addr2.String(), Creator: s.addr1.String(), ExternalId: "three-way", Parties: parties,
 			},
-			expInErr: []string{invReqErr,
-				"invalid new target \"whatiswrong\": decoding bech32 failed: invalid separator index -1"},
-		},
-		{
-			name: "no such payment",
-			msg: exchange.MsgChangePaymentTargetRequest{
-				Source:     s.addr2.String(),
-				ExternalId: "imaginary",
-				NewTarget:  s.longAddr2.String(),
+			fArgs: followupArgs{
+				settled: false,
+				expBals: []expBalances{
+					{addr: s.addr1, expBal: s.coins("100apple,25strawberry"), expHold: s.coins("5strawberry")},
+					{addr: s.addr2, expBal: s.coins("100apple,20tangerine"), expHold: s.coins("3tangerine")},
+				},
+			},
+			expEvents: sdk.Events{
+				s.eventHoldAddedMultiPartyPayment(s.addr2, "3tangerine", "three-way"),
+				s.untypeEvent(&exchange.EventMultiPartyPaymentAccepted{
+					Creator: s.addr1.String(), ExternalId: "three-way", Party: s.addr2.String(),
+				}),
 			},
-			expInErr: []string{invReqErr,
-				"no payment found with source " + s.addr2.String() + " and external id \"imaginary\""},
 		},
 		{
-			name: "payment updated to no target",
-			setup: func() {
-				s.requireFundAccount(s.addr5, "100apple,100strawberry,100tangerine")
-				s.requireCreatePayments(
-					s.newTestPayment(s.addr5, "3strawberry", s.addr4, "80tangerine", "badtrade"),
-				)
+			name:  "accepted and settled",
+			setup: setup(s.addr2),
+			msg: exchange.MsgAcceptMultiPartyPaymentRequest{
+				Party: s.addr3.String(), Creator: s.addr1.String(), ExternalId: "three-way", Parties: parties,
 			},
-			msg: exchange.MsgChangePaymentTargetRequest{
-				Source:     s.addr5.String(),
-				ExternalId: "badtrade",
-				NewTarget:  "",
+			fArgs: followupArgs{
+				settled: true,
+				expBals: []expBalances{
+					{
+						addr:    s.addr1,
+						expBal:  s.coins("100apple,2cherry,20strawberry"),
+						expHold: s.zeroCoins("cherry", "strawberry", "tangerine"),
+					},
+					{
+						addr:    s.addr2,
+						expBal:  s.coins("100apple,5strawberry,17tangerine"),
+						expHold: s.zeroCoins("cherry", "strawberry", "tangerine"),
+					},
+					{
+						addr:    s.addr3,
+						expBal:  s.coins("100apple,8cherry,3tangerine"),
+						expHold: s.zeroCoins("cherry", "strawberry", "tangerine"),
+					},
+				},
 			},
 			expEvents: sdk.Events{
-				s.untypeEvent(exchange.NewEventPaymentUpdated(
-					s.newTestPayment(s.addr5, "3strawberry", nil, "80tangerine", "badtrade"), s.addr4.String())),
+				// Holds released.
+				s.eventHoldReleased(s.addr1, "5strawberry"),
+				s.eventHoldReleased(s.addr2, "3tangerine"),
+				// Send from addr1 to addr2.
+				s.eventCoinSpent(s.addr1, "5strawberry"),
+				s.eventCoinReceived(s.addr2, "5strawberry"),
+				s.eventTransfer(s.addr2, s.addr1, "5strawberry"),
+				s.eventMessageSender(s.addr1),
+				// Send from addr2 to addr3.
+				s.eventCoinSpent(s.addr2, "3tangerine"),
+				s.eventCoinReceived(s.addr3, "3tangerine"),
+				s.eventTransfer(s.addr3, s.addr2, "3tangerine"),
+				s.eventMessageSender(s.addr2),
+				// Send from addr3 to addr1.
+				s.eventCoinSpent(s.addr3, "2cherry"),
+				s.eventCoinReceived(s.addr1, "2cherry"),
+				s.eventTransfer(s.addr1, s.addr3, "2cherry"),
+				s.eventMessageSender(s.addr3),
+				// Multi-party payment accepted and settled.
+				s.untypeEvent(&exchange.EventMultiPartyPaymentAccepted{
+					Creator: s.addr1.String(), ExternalId: "three-way", Party: s.addr3.String(),
+				}),
+				s.untypeEvent(&exchange.EventMultiPartyPaymentSettled{
+					Creator: s.addr1.String(), ExternalId: "three-way",
+					Parties: []string{s.addr1.String(), s.addr2.String(), s.addr3.String()},
+				}),
 			},
 		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			td := testDef
+			td.expResp = &exchange.MsgAcceptMultiPartyPaymentResponse{Settled: tc.fArgs.settled}
+			runMsgServerTestCase(s, td, tc)
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_CancelMultiPartyPayment() {
+	testDef := msgServerTestDef[exchange.MsgCancelMultiPartyPaymentRequest, exchange.MsgCancelMultiPartyPaymentResponse, []expBalances]{
+		endpointName: "CancelMultiPartyPayment",
+		endpoint:     keeper.NewMsgServer(s.k).CancelMultiPartyPayment,
+		expResp:      &exchange.MsgCancelMultiPartyPaymentResponse{},
+		followup: func(msg *exchange.MsgCancelMultiPartyPaymentRequest, expBals []expBalances) {
+			creator := s.requireAccAddressFromBech32(msg.Creator, "msg.Creator")
+			mpp, err := s.k.GetMultiPartyPayment(s.ctx, creator, msg.ExternalId)
+			s.Assert().NoError(err, "GetMultiPartyPayment(%s, %q) error", msg.Creator, msg.ExternalId)
+			s.Assert().Nil(mpp, "GetMultiPartyPayment(%s, %q) result", msg.Creator, msg.ExternalId)
+
+			for _, eb := range expBals {
+				s.checkBalances(eb)
+			}
+		},
+	}
+
+	setup := func() {
+		s.requireFundAccount(s.addr1, "100apple,25strawberry")
+		mpp := s.newTestMultiPartyPayment(s.addr1, "three-way",
+			s.newTestPaymentParty(s.addr1, "5strawberry", "", false),
+			s.newTestPaymentParty(s.addr2, "", "2strawberry", false),
+			s.newTestPaymentParty(s.addr3, "", "3strawberry", false),
+		)
+		assertions.RequireNotPanicsNoErrorf(s.T(), func() error {
+			return s.k.CreateMultiPartyPayment(s.ctx, mpp)
+		}, "CreateMultiPartyPayment")
+	}
+
+	tests := []msgServerTestCase[exchange.MsgCancelMultiPartyPaymentRequest, []expBalances]{
 		{
-			name: "payment updated to different target",
-			setup: func() {
-				s.requireFundAccount(s.addr5, "100apple,100strawberry,100tangerine")
-				s.requireCreatePayments(
-					s.newTestPayment(s.addr5, "3strawberry", s.addr4, "80tangerine", "badtrade"),
-				)
-			},
-			msg: exchange.MsgChangePaymentTargetRequest{
-				Source:     s.addr5.String(),
-				ExternalId: "badtrade",
-				NewTarget:  s.longAddr2.String(),
+			name:     "invalid party",
+			msg:      exchange.MsgCancelMultiPartyPaymentRequest{Party: "faultyparty", Creator: s.addr1.String(), ExternalId: "three-way"},
+			expInErr: []string{invReqErr, "invalid party \"faultyparty\": decoding bech32 failed: invalid separator index -1"},
+		},
+		{
+			name:  "not a party",
+			setup: setup,
+			msg:   exchange.MsgCancelMultiPartyPaymentRequest{Party: s.addr4.String(), Creator: s.addr1.String(), ExternalId: "three-way"},
+			expInErr: []string{invReqErr, "account " + s.addr4.String() + " is not a party in the multi-party payment " +
+				"with creator " + s.addr1.String() + " and external id \"three-way\""},
+		},
+		{
+			name:  "cancelled",
+			setup: setup,
+			msg:   exchange.MsgCancelMultiPartyPaymentRequest{Party: s.addr3.String(), Creator: s.addr1.String(), ExternalId: "three-way"},
+			fArgs: []expBalances{
+				{addr: s.addr1, expBal: s.coins("100apple,25strawberry"), expHold: s.zeroCoins("apple", "strawberry")},
 			},
 			expEvents: sdk.Events{
-				s.untypeEvent(exchange.NewEventPaymentUpdated(
-					s.newTestPayment(s.addr5, "3strawberry", s.longAddr2, "80tangerine", "badtrade"), s.addr4.String())),
+				s.eventHoldReleased(s.addr1, "5strawberry"),
+				s.untypeEvent(&exchange.EventMultiPartyPaymentCancelled{
+					Creator: s.addr1.String(), ExternalId: "three-way", CancelledBy: s.addr3.String(),
+				}),
 			},
 		},
 	}
@@ -5718,6 +8743,131 @@ func (s *TestSuite) TestMsgServer_GovManageFees() {
 				s.untypeEvent(&exchange.EventMarketFeesUpdated{MarketId: 2}),
 			},
 		},
+		{
+			name: "scheduled for current height",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{MarketId: 2, FeeCreateAskFlat: s.coins("9apple")})
+				s.ctx = s.ctx.WithBlockHeight(100)
+			},
+			msg: exchange.MsgGovManageFeesRequest{
+				Authority:           s.k.GetAuthority(),
+				MarketId:            2,
+				AddFeeCreateAskFlat: s.coins("10apple"),
+				EffectiveHeight:     100,
+			},
+			expInErr: []string{invReqErr, "invalid effective height 100: must be after the current block height 100"},
+		},
+		{
+			name: "scheduled for unknown market",
+			setup: func() {
+				s.ctx = s.ctx.WithBlockHeight(100)
+			},
+			msg: exchange.MsgGovManageFeesRequest{
+				Authority:           s.k.GetAuthority(),
+				MarketId:            2,
+				AddFeeCreateAskFlat: s.coins("10apple"),
+				EffectiveHeight:     101,
+			},
+			expInErr: []string{invReqErr, "market 2 does not exist"},
+		},
+		{
+			name: "scheduled",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{MarketId: 2, FeeCreateAskFlat: s.coins("9apple")})
+				s.ctx = s.ctx.WithBlockHeight(100)
+			},
+			msg: exchange.MsgGovManageFeesRequest{
+				Authority:              s.k.GetAuthority(),
+				MarketId:               2,
+				RemoveFeeCreateAskFlat: s.coins("9apple"),
+				AddFeeCreateAskFlat:    s.coins("10apple"),
+				EffectiveHeight:        150,
+			},
+			fArgs: exchange.Market{MarketId: 2, FeeCreateAskFlat: s.coins("9apple")},
+			expEvents: sdk.Events{
+				s.untypeEvent(&exchange.EventMarketFeesScheduled{MarketId: 2, EffectiveHeight: 150}),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runMsgServerTestCase(s, testDef, tc)
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_GovManageExchangeSplits() {
+	testDef := msgServerTestDef[exchange.MsgGovManageExchangeSplitsRequest, exchange.MsgGovManageExchangeSplitsResponse, []exchange.DenomSplit]{
+		endpointName: "GovManageExchangeSplits",
+		endpoint:     keeper.NewMsgServer(s.k).GovManageExchangeSplits,
+		expResp:      &exchange.MsgGovManageExchangeSplitsResponse{},
+		followup: func(msg *exchange.MsgGovManageExchangeSplitsRequest, expSplits []exchange.DenomSplit) {
+			actSplits := s.k.GetMarketExchangeSplits(s.ctx, msg.MarketId)
+			s.Assert().Equal(expSplits, actSplits, "GetMarketExchangeSplits(%d)", msg.MarketId)
+		},
+	}
+
+	tests := []msgServerTestCase[exchange.MsgGovManageExchangeSplitsRequest, []exchange.DenomSplit]{
+		{
+			name: "wrong authority",
+			msg: exchange.MsgGovManageExchangeSplitsRequest{
+				Authority:         s.addr5.String(),
+				MarketId:          2,
+				SetExchangeSplits: []exchange.DenomSplit{{Denom: "apple", Split: 100}},
+			},
+			expInErr: []string{
+				"expected \"" + s.k.GetAuthority() + "\" got \"" + s.addr5.String() + "\"",
+				"expected gov account as only signer for proposal message"},
+		},
+		{
+			name: "unknown market",
+			msg: exchange.MsgGovManageExchangeSplitsRequest{
+				Authority:         s.k.GetAuthority(),
+				MarketId:          2,
+				SetExchangeSplits: []exchange.DenomSplit{{Denom: "apple", Split: 100}},
+			},
+			expInErr: []string{invReqErr, "market 2 does not exist"},
+		},
+		{
+			name: "remove split market does not have",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{MarketId: 2})
+			},
+			msg: exchange.MsgGovManageExchangeSplitsRequest{
+				Authority:            s.k.GetAuthority(),
+				MarketId:             2,
+				RemoveExchangeSplits: []string{"apple"},
+			},
+			expInErr: []string{invReqErr, "cannot remove exchange split for \"apple\": market 2 does not have one"},
+		},
+		{
+			name: "okay",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 2,
+					ExchangeSplits: []exchange.DenomSplit{
+						{Denom: "apple", Split: 100},
+						{Denom: "banana", Split: 200},
+						{Denom: "cherry", Split: 300},
+					},
+				})
+			},
+			msg: exchange.MsgGovManageExchangeSplitsRequest{
+				Authority:            s.k.GetAuthority(),
+				MarketId:             2,
+				SetExchangeSplits:    []exchange.DenomSplit{{Denom: "banana", Split: 0}, {Denom: "date", Split: 400}},
+				RemoveExchangeSplits: []string{"apple"},
+			},
+			fArgs: []exchange.DenomSplit{
+				{Denom: "banana", Split: 0},
+				{Denom: "cherry", Split: 300},
+				{Denom: "date", Split: 400},
+			},
+			expEvents: sdk.Events{
+				s.untypeEvent(&exchange.EventMarketExchangeSplitsUpdated{MarketId: 2}),
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -5825,6 +8975,93 @@ func (s *TestSuite) TestMsgServer_GovCloseMarket() {
 	}
 }
 
+func (s *TestSuite) TestMsgServer_GovWindDownMarket() {
+	testDef := msgServerTestDef[exchange.MsgGovWindDownMarketRequest, exchange.MsgGovWindDownMarketResponse, struct{}]{
+		endpointName: "GovWindDownMarket",
+		endpoint:     keeper.NewMsgServer(s.k).GovWindDownMarket,
+		expResp:      &exchange.MsgGovWindDownMarketResponse{},
+		followup: func(msg *exchange.MsgGovWindDownMarketRequest, _ struct{}) {
+			market := s.k.GetMarket(s.ctx, msg.MarketId)
+			if msg.DeleteMarket {
+				s.Assert().Nil(market, "GetMarket(%d)", msg.MarketId)
+			} else {
+				s.Assert().NotNil(market, "GetMarket(%d)", msg.MarketId)
+			}
+		},
+	}
+
+	tests := []msgServerTestCase[exchange.MsgGovWindDownMarketRequest, struct{}]{
+		{
+			name: "wrong authority",
+			msg: exchange.MsgGovWindDownMarketRequest{
+				Authority: s.addr5.String(),
+				MarketId:  3,
+			},
+			expInErr: []string{
+				"expected \"" + s.k.GetAuthority() + "\" got \"" + s.addr5.String() + "\"",
+				"expected gov account as only signer for proposal message"},
+		},
+		{
+			name: "market does not exist",
+			msg: exchange.MsgGovWindDownMarketRequest{
+				Authority: s.k.GetAuthority(),
+				MarketId:  3,
+			},
+			expInErr: []string{invReqErr, "market 3 does not exist"},
+		},
+		{
+			name: "okay",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId:             2,
+					AcceptingOrders:      true,
+					AcceptingCommitments: true,
+					IntermediaryDenom:    "cherry",
+				})
+
+				s.requireFundAccount(s.addr1, "10apple")
+				askOrder := exchange.NewOrder(18).WithAsk(&exchange.AskOrder{
+					MarketId: 2, Seller: s.addr1.String(), Assets: s.coin("10apple"), Price: s.coin("20peach"),
+				})
+				s.requireSetOrdersInStore(s.getStore(), askOrder)
+				s.requireAddHold(s.addr1, "10apple", askOrder.OrderId)
+
+				s.requireFundAccount(s.addr3, "30banana")
+				s.requireSetCommitmentAmount(2, s.addr3, "30banana")
+			},
+			msg: exchange.MsgGovWindDownMarketRequest{
+				Authority:    s.k.GetAuthority(),
+				MarketId:     2,
+				DeleteMarket: true,
+			},
+			expEvents: sdk.Events{
+				s.untypeEvent(exchange.NewEventMarketOrdersDisabled(2, s.k.GetAuthority())),
+				s.untypeEvent(exchange.NewEventMarketCommitmentsDisabled(2, s.k.GetAuthority())),
+				s.eventHoldReleased(s.addr1, "10apple"),
+				s.untypeEvent(&exchange.EventOrderCancelled{OrderId: 18, MarketId: 2, CancelledBy: s.k.GetAuthority()}),
+				s.eventHoldReleased(s.addr3, "30banana"),
+				s.eventCommitmentReleased(s.addr3, 2, "30banana", "GovWindDownMarket"),
+				s.untypeEvent(&exchange.EventMarketWoundDown{
+					MarketId:            2,
+					OrdersCancelled:     1,
+					CommitmentsReleased: 1,
+					MarketDeleted:       true,
+					Releases: []*exchange.WindDownRelease{
+						{Account: s.addr1.String(), OrderFunds: "10apple", CommitmentFunds: ""},
+						{Account: s.addr3.String(), OrderFunds: "", CommitmentFunds: "30banana"},
+					},
+				}),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runMsgServerTestCase(s, testDef, tc)
+		})
+	}
+}
+
 func (s *TestSuite) TestMsgServer_UpdateParams() {
 	testDef := msgServerTestDef[exchange.MsgUpdateParamsRequest, exchange.MsgUpdateParamsResponse, struct{}]{
 		endpointName: "UpdateParams",
@@ -0,0 +1,272 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+// parseMultiPartyPaymentStoreValue converts a multi-party payment store value into the MultiPartyPayment object.
+// If the value is empty then nil, nil is returned.
+func (k Keeper) parseMultiPartyPaymentStoreValue(value []byte) (*exchange.MultiPartyPayment, error) {
+	if len(value) == 0 {
+		return nil, nil
+	}
+
+	var mpp exchange.MultiPartyPayment
+	err := k.cdc.Unmarshal(value, &mpp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal multi-party payment: %w", err)
+	}
+	return &mpp, nil
+}
+
+// getMultiPartyPaymentFromStore gets a MultiPartyPayment from the store.
+func (k Keeper) getMultiPartyPaymentFromStore(store storetypes.KVStore, creator sdk.AccAddress, externalID string) (*exchange.MultiPartyPayment, error) {
+	key := MakeKeyMultiPartyPayment(creator, externalID)
+	value := store.Get(key)
+	return k.parseMultiPartyPaymentStoreValue(value)
+}
+
+// requireMultiPartyPaymentFromStore is like getMultiPartyPaymentFromStore but returns with an error if it does not exist.
+// This will always return either a multi-party payment or error. It will never return both or nil, nil.
+func (k Keeper) requireMultiPartyPaymentFromStore(store storetypes.KVStore, creator sdk.AccAddress, externalID string) (*exchange.MultiPartyPayment, error) {
+	mpp, err := k.getMultiPartyPaymentFromStore(store, creator, externalID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting existing multi-party payment with creator %s and external id %q: %w",
+			creator, externalID, err)
+	}
+	if mpp == nil {
+		return nil, fmt.Errorf("no multi-party payment found with creator %s and external id %q", creator, externalID)
+	}
+	return mpp, nil
+}
+
+// setMultiPartyPaymentInStore sets a multi-party payment in the store.
+func (k Keeper) setMultiPartyPaymentInStore(store storetypes.KVStore, mpp *exchange.MultiPartyPayment) error {
+	creator, err := sdk.AccAddressFromBech32(mpp.Creator)
+	if err != nil {
+		return fmt.Errorf("invalid creator %q: %w", mpp.Creator, err)
+	}
+	value, err := k.cdc.Marshal(mpp)
+	if err != nil {
+		return fmt.Errorf("error marshaling multi-party payment: %w", err)
+	}
+	store.Set(MakeKeyMultiPartyPayment(creator, mpp.ExternalId), value)
+	return nil
+}
+
+// deleteMultiPartyPaymentFromStore deletes a multi-party payment from the state store.
+func deleteMultiPartyPaymentFromStore(store storetypes.KVStore, mpp *exchange.MultiPartyPayment) error {
+	if mpp == nil {
+		return errors.New("cannot delete nil multi-party payment")
+	}
+	creator, err := sdk.AccAddressFromBech32(mpp.Creator)
+	if err != nil {
+		return fmt.Errorf("invalid creator %q: %w", mpp.Creator, err)
+	}
+	store.Delete(MakeKeyMultiPartyPayment(creator, mpp.ExternalId))
+	return nil
+}
+
+// getMultiPartyPaymentHoldReason gets the reason to use for holds placed on funds for a multi-party payment.
+func getMultiPartyPaymentHoldReason(mpp *exchange.MultiPartyPayment) string {
+	return fmt.Sprintf("x/exchange: multi-party payment %q", mpp.ExternalId)
+}
+
+// releaseMultiPartyPaymentHolds releases the holds on the send amounts of each party that has accepted the payment.
+func (k Keeper) releaseMultiPartyPaymentHolds(ctx sdk.Context, mpp *exchange.MultiPartyPayment) error {
+	for _, party := range mpp.Parties {
+		if !party.Accepted || party.SendAmount.IsZero() {
+			continue
+		}
+		addr, err := sdk.AccAddressFromBech32(party.Address)
+		if err != nil {
+			return fmt.Errorf("invalid party address %q: %w", party.Address, err)
+		}
+		if err = k.holdKeeper.ReleaseHold(ctx, addr, party.SendAmount); err != nil {
+			return fmt.Errorf("error releasing hold on funds of party %s: %w", party.Address, err)
+		}
+	}
+	return nil
+}
+
+// GetMultiPartyPayment gets a multi-party payment from the state store. If it doesn't exist, nil, nil is returned.
+func (k Keeper) GetMultiPartyPayment(ctx sdk.Context, creator sdk.AccAddress, externalID string) (*exchange.MultiPartyPayment, error) {
+	return k.getMultiPartyPaymentFromStore(k.getStore(ctx), creator, externalID)
+}
+
+// CreateMultiPartyPayment stores the provided multi-party payment in the state store, recording the creator's
+// acceptance and placing a hold on the creator's send amount. The accepted flags of the provided parties are ignored.
+func (k Keeper) CreateMultiPartyPayment(ctx sdk.Context, mpp *exchange.MultiPartyPayment) error {
+	if mpp == nil {
+		return errors.New("cannot create nil multi-party payment")
+	}
+	if err := mpp.Validate(); err != nil {
+		return fmt.Errorf("cannot create invalid multi-party payment: %w", err)
+	}
+
+	store := k.getStore(ctx)
+	creator, _ := sdk.AccAddressFromBech32(mpp.Creator)
+	if store.Has(MakeKeyMultiPartyPayment(creator, mpp.ExternalId)) {
+		return fmt.Errorf("a multi-party payment already exists with creator %s and external id %q", mpp.Creator, mpp.ExternalId)
+	}
+
+	for i := range mpp.Parties {
+		mpp.Parties[i].Accepted = mpp.Parties[i].Address == mpp.Creator
+	}
+
+	if err := k.setMultiPartyPaymentInStore(store, mpp); err != nil {
+		return fmt.Errorf("failed to create multi-party payment: %w", err)
+	}
+
+	creatorParty := mpp.GetParty(mpp.Creator)
+	if !creatorParty.SendAmount.IsZero() {
+		err := k.holdKeeper.AddHold(ctx, creator, creatorParty.SendAmount, getMultiPartyPaymentHoldReason(mpp))
+		if err != nil {
+			return fmt.Errorf("error placing hold on funds of creator: %w", err)
+		}
+	}
+
+	k.emitEvents(ctx, []proto.Message{
+		exchange.NewEventMultiPartyPaymentCreated(mpp),
+		exchange.NewEventMultiPartyPaymentAccepted(mpp, mpp.Creator),
+	})
+	incPaymentActionCounter(1, exchange.TelemetryActionCreated)
+	return nil
+}
+
+// AcceptMultiPartyPayment verifies that the provided parties match the existing multi-party payment, then records
+// the acceptance of the party and places a hold on its send amount. Once all parties have accepted, the holds are
+// released, all the funds are transferred (see MultiPartyPayment.GetTransfers), and the multi-party payment is deleted.
+// Returns true if the payment was settled.
+func (k Keeper) AcceptMultiPartyPayment(ctx sdk.Context, party, creator sdk.AccAddress, externalID string, parties []exchange.PaymentParty) (bool, error) {
+	if len(party) == 0 {
+		return false, errors.New("a party is required in order to accept a multi-party payment")
+	}
+	if len(creator) == 0 {
+		return false, errors.New("a creator is required in order to accept a multi-party payment")
+	}
+	if err := exchange.ValidatePaymentParties(parties); err != nil {
+		return false, fmt.Errorf("invalid provided parties: %w", err)
+	}
+
+	store := k.getStore(ctx)
+	existing, err := k.requireMultiPartyPaymentFromStore(store, creator, externalID)
+	if err != nil {
+		return false, err
+	}
+
+	if len(parties) != len(existing.Parties) {
+		return false, fmt.Errorf("provided parties count %d does not equal existing parties count %d",
+			len(parties), len(existing.Parties))
+	}
+	for _, provided := range parties {
+		existingParty := existing.GetParty(provided.Address)
+		if existingParty == nil {
+			return false, fmt.Errorf("provided party %s is not a party in the existing multi-party payment", provided.Address)
+		}
+		if !provided.EqualTerms(*existingParty) {
+			return false, fmt.Errorf("provided terms for party %s (send %q, receive %q) do not equal existing terms (send %q, receive %q)",
+				provided.Address, provided.SendAmount, provided.ReceiveAmount, existingParty.SendAmount, existingParty.ReceiveAmount)
+		}
+	}
+
+	accepter := existing.GetParty(party.String())
+	if accepter == nil {
+		return false, fmt.Errorf("account %s is not a party in the multi-party payment with creator %s and external id %q",
+			party, existing.Creator, existing.ExternalId)
+	}
+	if accepter.Accepted {
+		return false, fmt.Errorf("party %s has already accepted the multi-party payment with creator %s and external id %q",
+			party, existing.Creator, existing.ExternalId)
+	}
+
+	if !existing.AllAcceptedExcept(accepter.Address) {
+		if !accepter.SendAmount.IsZero() {
+			err = k.holdKeeper.AddHold(ctx, party, accepter.SendAmount, getMultiPartyPaymentHoldReason(existing))
+			if err != nil {
+				return false, fmt.Errorf("error placing hold on funds of party %s: %w", party, err)
+			}
+		}
+		accepter.Accepted = true
+		if err = k.setMultiPartyPaymentInStore(store, existing); err != nil {
+			return false, err
+		}
+		k.emitEvent(ctx, exchange.NewEventMultiPartyPaymentAccepted(existing, accepter.Address))
+		return false, nil
+	}
+
+	// This is the last acceptance needed, so there's no need to put a hold on the accepter's funds.
+	if err = k.releaseMultiPartyPaymentHolds(ctx, existing); err != nil {
+		return false, err
+	}
+	accepter.Accepted = true
+	if err = deleteMultiPartyPaymentFromStore(store, existing); err != nil {
+		return false, err
+	}
+
+	for _, transfer := range existing.GetTransfers() {
+		if err = k.DoTransfer(ctx, transfer.Inputs, transfer.Outputs); err != nil {
+			return false, fmt.Errorf("error transferring funds for multi-party payment with creator %s and external id %q: %w",
+				existing.Creator, existing.ExternalId, err)
+		}
+	}
+
+	k.emitEvents(ctx, []proto.Message{
+		exchange.NewEventMultiPartyPaymentAccepted(existing, accepter.Address),
+		exchange.NewEventMultiPartyPaymentSettled(existing),
+	})
+	incPaymentActionCounter(1, exchange.TelemetryActionAccepted)
+	return true, nil
+}
+
+// CancelMultiPartyPayment deletes a multi-party payment and releases the holds on the funds of the parties
+// that have accepted it. The provided party must be one of the payment's parties.
+func (k Keeper) CancelMultiPartyPayment(ctx sdk.Context, party, creator sdk.AccAddress, externalID string) error {
+	if len(party) == 0 {
+		return errors.New("a party is required in order to cancel a multi-party payment")
+	}
+	if len(creator) == 0 {
+		return errors.New("a creator is required in order to cancel a multi-party payment")
+	}
+
+	store := k.getStore(ctx)
+	existing, err := k.requireMultiPartyPaymentFromStore(store, creator, externalID)
+	if err != nil {
+		return err
+	}
+	if existing.GetParty(party.String()) == nil {
+		return fmt.Errorf("account %s is not a party in the multi-party payment with creator %s and external id %q",
+			party, existing.Creator, existing.ExternalId)
+	}
+
+	if err = deleteMultiPartyPaymentFromStore(store, existing); err != nil {
+		return err
+	}
+	if err = k.releaseMultiPartyPaymentHolds(ctx, existing); err != nil {
+		return err
+	}
+
+	k.emitEvent(ctx, exchange.NewEventMultiPartyPaymentCancelled(existing, party.String()))
+	incPaymentActionCounter(1, exchange.TelemetryActionCancelled)
+	return nil
+}
+
+// IterateMultiPartyPayments iterates over all multi-party payments.
+// The callback takes in the multi-party payment and should return whether to stop iterating.
+func (k Keeper) IterateMultiPartyPayments(ctx sdk.Context, cb func(mpp *exchange.MultiPartyPayment) bool) {
+	k.iterate(ctx, GetKeyPrefixAllMultiPartyPayments(), func(_, value []byte) bool {
+		mpp, err := k.parseMultiPartyPaymentStoreValue(value)
+		if err != nil || mpp == nil {
+			return false
+		}
+		return cb(mpp)
+	})
+}
@@ -0,0 +1,605 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+// newTestPaymentParty creates a new PaymentParty using the provided info. Empty amounts are left nil.
+func (s *TestSuite) newTestPaymentParty(addr sdk.AccAddress, send, receive string, accepted bool) exchange.PaymentParty {
+	s.T().Helper()
+	rv := exchange.PaymentParty{Address: addr.String(), Accepted: accepted}
+	if len(send) > 0 {
+		rv.SendAmount = s.coins(send)
+	}
+	if len(receive) > 0 {
+		rv.ReceiveAmount = s.coins(receive)
+	}
+	return rv
+}
+
+// newTestMultiPartyPayment creates a new MultiPartyPayment using the provided info.
+func (s *TestSuite) newTestMultiPartyPayment(creator sdk.AccAddress, externalID string, parties ...exchange.PaymentParty) *exchange.MultiPartyPayment {
+	return &exchange.MultiPartyPayment{
+		Creator:    creator.String(),
+		ExternalId: externalID,
+		Parties:    parties,
+	}
+}
+
+// copyMultiPartyPayment returns a deep copy of the provided multi-party payment with the provided
+// accepted flags applied (in party order). If no accepted flags are provided, they are left as they are.
+func copyMultiPartyPayment(mpp *exchange.MultiPartyPayment, accepted ...bool) *exchange.MultiPartyPayment {
+	rv := &exchange.MultiPartyPayment{
+		Creator:    mpp.Creator,
+		ExternalId: mpp.ExternalId,
+		Parties:    make([]exchange.PaymentParty, len(mpp.Parties)),
+	}
+	copy(rv.Parties, mpp.Parties)
+	for i, a := range accepted {
+		rv.Parties[i].Accepted = a
+	}
+	return rv
+}
+
+// requireSetMultiPartyPaymentsInStore calls setMultiPartyPaymentInStore on each multi-party payment,
+// making sure it doesn't panic or return an error.
+func (s *TestSuite) requireSetMultiPartyPaymentsInStore(mpps ...*exchange.MultiPartyPayment) {
+	for i, mpp := range mpps {
+		assertions.RequireNotPanicsNoErrorf(s.T(), func() error {
+			return s.k.SetMultiPartyPaymentInStore(s.getStore(), mpp)
+		}, "[%d]: SetMultiPartyPaymentInStore(%s %q)", i, mpp.Creator, mpp.ExternalId)
+	}
+}
+
+// getAllMultiPartyPayments gets all the multi-party payments currently in state.
+func (s *TestSuite) getAllMultiPartyPayments() []*exchange.MultiPartyPayment {
+	var rv []*exchange.MultiPartyPayment
+	s.k.IterateMultiPartyPayments(s.ctx, func(mpp *exchange.MultiPartyPayment) bool {
+		rv = append(rv, mpp)
+		return false
+	})
+	return rv
+}
+
+func (s *TestSuite) TestKeeper_CreateMultiPartyPayment() {
+	existing := s.newTestMultiPartyPayment(s.addr1, "dup",
+		s.newTestPaymentParty(s.addr1, "5strawberry", "3tangerine", true),
+		s.newTestPaymentParty(s.addr2, "3tangerine", "5strawberry", false),
+	)
+	threeWay := func(creator sdk.AccAddress, externalID string) *exchange.MultiPartyPayment {
+		return s.newTestMultiPartyPayment(creator, externalID,
+			s.newTestPaymentParty(s.addr1, "5strawberry", "", false),
+			s.newTestPaymentParty(s.addr2, "3tangerine", "5strawberry", false),
+			s.newTestPaymentParty(s.addr3, "", "3tangerine", true),
+		)
+	}
+	holdReason := func(externalID string) string {
+		return "x/exchange: multi-party payment \"" + externalID + "\""
+	}
+
+	tests := []struct {
+		name        string
+		holdKeeper  *MockHoldKeeper
+		mpp         *exchange.MultiPartyPayment
+		expErr      string
+		expMPP      *exchange.MultiPartyPayment
+		expHoldCall *AddHoldArgs
+	}{
+		{
+			name:   "nil multi-party payment",
+			mpp:    nil,
+			expErr: "cannot create nil multi-party payment",
+		},
+		{
+			name: "invalid multi-party payment",
+			mpp:  s.newTestMultiPartyPayment(s.addr1, "x", s.newTestPaymentParty(s.addr1, "5strawberry", "5strawberry", false)),
+			expErr: "cannot create invalid multi-party payment: " +
+				"at least 2 parties are required, found 1",
+		},
+		{
+			name: "already exists",
+			mpp: s.newTestMultiPartyPayment(s.addr1, "dup",
+				s.newTestPaymentParty(s.addr1, "7strawberry", "", false),
+				s.newTestPaymentParty(s.addr3, "", "7strawberry", false),
+			),
+			expErr: "a multi-party payment already exists with creator " + s.addr1.String() +
+				" and external id \"dup\"",
+		},
+		{
+			name:        "error placing hold",
+			holdKeeper:  NewMockHoldKeeper().WithAddHoldResults("insufficient funds"),
+			mpp:         threeWay(s.addr2, "x"),
+			expErr:      "error placing hold on funds of creator: insufficient funds",
+			expHoldCall: NewAddHoldArgs(s.addr2, s.coins("3tangerine"), holdReason("x")),
+		},
+		{
+			name:        "creator sends funds",
+			mpp:         threeWay(s.addr2, "dup"),
+			expMPP:      copyMultiPartyPayment(threeWay(s.addr2, "dup"), false, true, false),
+			expHoldCall: NewAddHoldArgs(s.addr2, s.coins("3tangerine"), holdReason("dup")),
+		},
+		{
+			name:   "creator only receives funds",
+			mpp:    threeWay(s.addr3, "receiver"),
+			expMPP: copyMultiPartyPayment(threeWay(s.addr3, "receiver"), false, false, true),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			s.requireSetMultiPartyPaymentsInStore(existing)
+			if tc.holdKeeper == nil {
+				tc.holdKeeper = NewMockHoldKeeper()
+			}
+
+			var expEvents sdk.Events
+			if tc.expMPP != nil {
+				expEvents = append(expEvents,
+					s.untypeEvent(exchange.NewEventMultiPartyPaymentCreated(tc.expMPP)),
+					s.untypeEvent(exchange.NewEventMultiPartyPaymentAccepted(tc.expMPP, tc.expMPP.Creator)),
+				)
+			}
+			var expHoldCalls HoldCalls
+			if tc.expHoldCall != nil {
+				expHoldCalls.AddHold = append(expHoldCalls.AddHold, tc.expHoldCall)
+			}
+
+			kpr := s.k.WithHoldKeeper(tc.holdKeeper)
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			var err error
+			testFunc := func() {
+				err = kpr.CreateMultiPartyPayment(ctx, tc.mpp)
+			}
+			s.Require().NotPanics(testFunc, "CreateMultiPartyPayment")
+			s.assertErrorValue(err, tc.expErr, "CreateMultiPartyPayment error")
+			s.assertEqualEvents(expEvents, em.Events(), "CreateMultiPartyPayment events")
+			s.assertHoldKeeperCalls(tc.holdKeeper, expHoldCalls, "CreateMultiPartyPayment")
+
+			if tc.expMPP != nil {
+				creator, _ := sdk.AccAddressFromBech32(tc.expMPP.Creator)
+				actMPP, err := s.k.GetMultiPartyPayment(s.ctx, creator, tc.expMPP.ExternalId)
+				s.Require().NoError(err, "GetMultiPartyPayment error")
+				s.Assert().Equal(tc.expMPP, actMPP, "multi-party payment in state")
+			}
+			actExisting, err := s.k.GetMultiPartyPayment(s.ctx, s.addr1, existing.ExternalId)
+			s.Require().NoError(err, "GetMultiPartyPayment(existing) error")
+			s.Assert().Equal(existing, actExisting, "existing multi-party payment in state")
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_AcceptMultiPartyPayment() {
+	holdReason := "x/exchange: multi-party payment \"swap\""
+	// swap is a three-way swap created by addr1 that only addr1 has accepted.
+	swap := s.newTestMultiPartyPayment(s.addr1, "swap",
+		s.newTestPaymentParty(s.addr1, "5strawberry", "2cherry", true),
+		s.newTestPaymentParty(s.addr2, "3tangerine", "5strawberry", false),
+		s.newTestPaymentParty(s.addr3, "2cherry", "3tangerine", false),
+	)
+	// gift is a three-way payment created by addr1 where addr2 only receives.
+	gift := s.newTestMultiPartyPayment(s.addr1, "swap",
+		s.newTestPaymentParty(s.addr1, "5strawberry", "", true),
+		s.newTestPaymentParty(s.addr2, "", "2strawberry", false),
+		s.newTestPaymentParty(s.addr3, "", "3strawberry", true),
+	)
+	// pair is a two-party swap created by addr1 that only addr1 has accepted.
+	pair := s.newTestMultiPartyPayment(s.addr1, "swap",
+		s.newTestPaymentParty(s.addr1, "5strawberry", "3tangerine", true),
+		s.newTestPaymentParty(s.addr2, "3tangerine", "5strawberry", false),
+	)
+	sendArgs := func(fromAddr, toAddr sdk.AccAddress, amt string) *SendCoinsArgs {
+		return &SendCoinsArgs{ctxHasQuarantineBypass: true, fromAddr: fromAddr, toAddr: toAddr, amt: s.coins(amt)}
+	}
+
+	tests := []struct {
+		name         string
+		holdKeeper   *MockHoldKeeper
+		bankKeeper   *MockBankKeeper
+		existing     *exchange.MultiPartyPayment
+		party        sdk.AccAddress
+		creator      sdk.AccAddress
+		externalID   string
+		parties      []exchange.PaymentParty
+		expSettled   bool
+		expErr       string
+		expMPP       *exchange.MultiPartyPayment
+		expHoldCalls HoldCalls
+		expBankCalls BankCalls
+		expEvents    []proto.Message
+	}{
+		{
+			name:       "nil party",
+			existing:   swap,
+			party:      nil,
+			creator:    s.addr1,
+			externalID: "swap",
+			parties:    swap.Parties,
+			expErr:     "a party is required in order to accept a multi-party payment",
+			expMPP:     swap,
+		},
+		{
+			name:       "nil creator",
+			existing:   swap,
+			party:      s.addr2,
+			creator:    nil,
+			externalID: "swap",
+			parties:    swap.Parties,
+			expErr:     "a creator is required in order to accept a multi-party payment",
+			expMPP:     swap,
+		},
+		{
+			name:       "invalid provided parties",
+			existing:   swap,
+			party:      s.addr2,
+			creator:    s.addr1,
+			externalID: "swap",
+			parties:    []exchange.PaymentParty{swap.Parties[0], swap.Parties[1], swap.Parties[0]},
+			expErr: "invalid provided parties: invalid parties[2]: duplicate address " +
+				s.addr1.String() + " seen at [0]\n" +
+				"total send amount \"5strawberry,3tangerine\" does not equal total receive amount \"2cherry,5strawberry\"",
+			expMPP: swap,
+		},
+		{
+			name:       "not found",
+			existing:   swap,
+			party:      s.addr2,
+			creator:    s.addr1,
+			externalID: "other",
+			parties:    swap.Parties,
+			expErr:     "no multi-party payment found with creator " + s.addr1.String() + " and external id \"other\"",
+			expMPP:     swap,
+		},
+		{
+			name:       "different number of parties",
+			existing:   swap,
+			party:      s.addr2,
+			creator:    s.addr1,
+			externalID: "swap",
+			parties:    pair.Parties,
+			expErr:     "provided parties count 2 does not equal existing parties count 3",
+			expMPP:     swap,
+		},
+		{
+			name:       "unknown provided party",
+			existing:   swap,
+			party:      s.addr2,
+			creator:    s.addr1,
+			externalID: "swap",
+			parties: []exchange.PaymentParty{
+				swap.Parties[0], swap.Parties[1],
+				s.newTestPaymentParty(s.addr4, "2cherry", "3tangerine", false),
+			},
+			expErr: "provided party " + s.addr4.String() + " is not a party in the existing multi-party payment",
+			expMPP: swap,
+		},
+		{
+			name:       "different terms",
+			existing:   swap,
+			party:      s.addr2,
+			creator:    s.addr1,
+			externalID: "swap",
+			parties: []exchange.PaymentParty{
+				s.newTestPaymentParty(s.addr1, "5strawberry", "1cherry", true),
+				swap.Parties[1],
+				s.newTestPaymentParty(s.addr3, "1cherry", "3tangerine", false),
+			},
+			expErr: "provided terms for party " + s.addr1.String() + " (send \"5strawberry\", receive \"1cherry\") " +
+				"do not equal existing terms (send \"5strawberry\", receive \"2cherry\")",
+			expMPP: swap,
+		},
+		{
+			name:       "accepter not a party",
+			existing:   swap,
+			party:      s.addr4,
+			creator:    s.addr1,
+			externalID: "swap",
+			parties:    swap.Parties,
+			expErr: "account " + s.addr4.String() + " is not a party in the multi-party payment " +
+				"with creator " + s.addr1.String() + " and external id \"swap\"",
+			expMPP: swap,
+		},
+		{
+			name:       "already accepted",
+			existing:   swap,
+			party:      s.addr1,
+			creator:    s.addr1,
+			externalID: "swap",
+			parties:    swap.Parties,
+			expErr: "party " + s.addr1.String() + " has already accepted the multi-party payment " +
+				"with creator " + s.addr1.String() + " and external id \"swap\"",
+			expMPP: swap,
+		},
+		{
+			name:         "error placing hold",
+			holdKeeper:   NewMockHoldKeeper().WithAddHoldResults("insufficient funds"),
+			existing:     swap,
+			party:        s.addr2,
+			creator:      s.addr1,
+			externalID:   "swap",
+			parties:      swap.Parties,
+			expErr:       "error placing hold on funds of party " + s.addr2.String() + ": insufficient funds",
+			expMPP:       swap,
+			expHoldCalls: HoldCalls{AddHold: []*AddHoldArgs{NewAddHoldArgs(s.addr2, s.coins("3tangerine"), holdReason)}},
+		},
+		{
+			name:         "not the last acceptance",
+			existing:     swap,
+			party:        s.addr2,
+			creator:      s.addr1,
+			externalID:   "swap",
+			parties:      copyMultiPartyPayment(swap, false, true, true).Parties,
+			expMPP:       copyMultiPartyPayment(swap, true, true, false),
+			expHoldCalls: HoldCalls{AddHold: []*AddHoldArgs{NewAddHoldArgs(s.addr2, s.coins("3tangerine"), holdReason)}},
+			expEvents: []proto.Message{
+				exchange.NewEventMultiPartyPaymentAccepted(swap, s.addr2.String()),
+			},
+		},
+		{
+			name:       "last acceptance",
+			existing:   copyMultiPartyPayment(swap, true, true, false),
+			party:      s.addr3,
+			creator:    s.addr1,
+			externalID: "swap",
+			parties:    swap.Parties,
+			expSettled: true,
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{
+				NewReleaseHoldArgs(s.addr1, s.coins("5strawberry")),
+				NewReleaseHoldArgs(s.addr2, s.coins("3tangerine")),
+			}},
+			expBankCalls: BankCalls{
+				BlockedAddr: []sdk.AccAddress{s.addr2, s.addr3, s.addr1},
+				SendCoins: []*SendCoinsArgs{
+					sendArgs(s.addr1, s.addr2, "5strawberry"),
+					sendArgs(s.addr2, s.addr3, "3tangerine"),
+					sendArgs(s.addr3, s.addr1, "2cherry"),
+				},
+			},
+			expEvents: []proto.Message{
+				exchange.NewEventMultiPartyPaymentAccepted(swap, s.addr3.String()),
+				exchange.NewEventMultiPartyPaymentSettled(copyMultiPartyPayment(swap, true, true, true)),
+			},
+		},
+		{
+			name:       "last acceptance by a party that only receives",
+			existing:   gift,
+			party:      s.addr2,
+			creator:    s.addr1,
+			externalID: "swap",
+			parties:    gift.Parties,
+			expSettled: true,
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{
+				NewReleaseHoldArgs(s.addr1, s.coins("5strawberry")),
+			}},
+			expBankCalls: BankCalls{
+				BlockedAddr: []sdk.AccAddress{s.addr2, s.addr3},
+				InputOutputCoins: []*InputOutputCoinsArgs{
+					{
+						ctxHasQuarantineBypass: true,
+						inputs: []banktypes.Input{
+							{Address: s.addr1.String(), Coins: s.coins("5strawberry")},
+						},
+						outputs: []banktypes.Output{
+							{Address: s.addr2.String(), Coins: s.coins("2strawberry")},
+							{Address: s.addr3.String(), Coins: s.coins("3strawberry")},
+						},
+					},
+				},
+			},
+			expEvents: []proto.Message{
+				exchange.NewEventMultiPartyPaymentAccepted(gift, s.addr2.String()),
+				exchange.NewEventMultiPartyPaymentSettled(copyMultiPartyPayment(gift, true, true, true)),
+			},
+		},
+		{
+			name:       "last acceptance of two parties",
+			existing:   pair,
+			party:      s.addr2,
+			creator:    s.addr1,
+			externalID: "swap",
+			parties:    pair.Parties,
+			expSettled: true,
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{
+				NewReleaseHoldArgs(s.addr1, s.coins("5strawberry")),
+			}},
+			expBankCalls: BankCalls{
+				BlockedAddr: []sdk.AccAddress{s.addr2, s.addr1},
+				SendCoins: []*SendCoinsArgs{
+					sendArgs(s.addr1, s.addr2, "5strawberry"),
+					sendArgs(s.addr2, s.addr1, "3tangerine"),
+				},
+			},
+			expEvents: []proto.Message{
+				exchange.NewEventMultiPartyPaymentAccepted(pair, s.addr2.String()),
+				exchange.NewEventMultiPartyPaymentSettled(copyMultiPartyPayment(pair, true, true)),
+			},
+		},
+		{
+			name:       "error transferring funds",
+			bankKeeper: NewMockBankKeeper().WithSendCoinsResults("", "insufficient funds"),
+			existing:   copyMultiPartyPayment(swap, true, true, false),
+			party:      s.addr3,
+			creator:    s.addr1,
+			externalID: "swap",
+			parties:    swap.Parties,
+			expErr: "error transferring funds for multi-party payment with creator " + s.addr1.String() +
+				" and external id \"swap\": insufficient funds",
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{
+				NewReleaseHoldArgs(s.addr1, s.coins("5strawberry")),
+				NewReleaseHoldArgs(s.addr2, s.coins("3tangerine")),
+			}},
+			expBankCalls: BankCalls{
+				BlockedAddr: []sdk.AccAddress{s.addr2, s.addr3},
+				SendCoins: []*SendCoinsArgs{
+					sendArgs(s.addr1, s.addr2, "5strawberry"),
+					sendArgs(s.addr2, s.addr3, "3tangerine"),
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			s.requireSetMultiPartyPaymentsInStore(tc.existing)
+			if tc.holdKeeper == nil {
+				tc.holdKeeper = NewMockHoldKeeper()
+			}
+			if tc.bankKeeper == nil {
+				tc.bankKeeper = NewMockBankKeeper()
+			}
+
+			expEvents := make(sdk.Events, len(tc.expEvents))
+			for i, tev := range tc.expEvents {
+				expEvents[i] = s.untypeEvent(tev)
+			}
+
+			kpr := s.k.WithHoldKeeper(tc.holdKeeper).WithBankKeeper(tc.bankKeeper)
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			var settled bool
+			var err error
+			testFunc := func() {
+				settled, err = kpr.AcceptMultiPartyPayment(ctx, tc.party, tc.creator, tc.externalID, tc.parties)
+			}
+			s.Require().NotPanics(testFunc, "AcceptMultiPartyPayment")
+			s.assertErrorValue(err, tc.expErr, "AcceptMultiPartyPayment error")
+			s.Assert().Equal(tc.expSettled, settled, "AcceptMultiPartyPayment settled")
+			s.assertEqualEvents(expEvents, em.Events(), "AcceptMultiPartyPayment events")
+			s.assertHoldKeeperCalls(tc.holdKeeper, tc.expHoldCalls, "AcceptMultiPartyPayment")
+			s.assertBankKeeperCalls(tc.bankKeeper, tc.expBankCalls, "AcceptMultiPartyPayment")
+
+			actMPP, err := s.k.GetMultiPartyPayment(s.ctx, s.addr1, "swap")
+			s.Require().NoError(err, "GetMultiPartyPayment error")
+			s.Assert().Equal(tc.expMPP, actMPP, "multi-party payment in state")
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_CancelMultiPartyPayment() {
+	swap := s.newTestMultiPartyPayment(s.addr1, "swap",
+		s.newTestPaymentParty(s.addr1, "5strawberry", "2cherry", true),
+		s.newTestPaymentParty(s.addr2, "", "5strawberry", true),
+		s.newTestPaymentParty(s.addr3, "2cherry", "", false),
+	)
+	other := s.newTestMultiPartyPayment(s.addr2, "swap",
+		s.newTestPaymentParty(s.addr2, "1tangerine", "", true),
+		s.newTestPaymentParty(s.addr3, "", "1tangerine", false),
+	)
+	all := []*exchange.MultiPartyPayment{swap, other}
+
+	tests := []struct {
+		name         string
+		holdKeeper   *MockHoldKeeper
+		party        sdk.AccAddress
+		creator      sdk.AccAddress
+		externalID   string
+		expErr       string
+		expRemain    []*exchange.MultiPartyPayment
+		expHoldCalls HoldCalls
+	}{
+		{
+			name:       "nil party",
+			party:      nil,
+			creator:    s.addr1,
+			externalID: "swap",
+			expErr:     "a party is required in order to cancel a multi-party payment",
+			expRemain:  all,
+		},
+		{
+			name:       "nil creator",
+			party:      s.addr1,
+			creator:    nil,
+			externalID: "swap",
+			expErr:     "a creator is required in order to cancel a multi-party payment",
+			expRemain:  all,
+		},
+		{
+			name:       "not found",
+			party:      s.addr1,
+			creator:    s.addr1,
+			externalID: "other",
+			expErr:     "no multi-party payment found with creator " + s.addr1.String() + " and external id \"other\"",
+			expRemain:  all,
+		},
+		{
+			name:       "not a party",
+			party:      s.addr1,
+			creator:    s.addr2,
+			externalID: "swap",
+			expErr: "account " + s.addr1.String() + " is not a party in the multi-party payment " +
+				"with creator " + s.addr2.String() + " and external id \"swap\"",
+			expRemain: all,
+		},
+		{
+			name:       "error releasing hold",
+			holdKeeper: NewMockHoldKeeper().WithReleaseHoldResults("no hold found"),
+			party:      s.addr1,
+			creator:    s.addr1,
+			externalID: "swap",
+			expErr:     "error releasing hold on funds of party " + s.addr1.String() + ": no hold found",
+			expRemain:  []*exchange.MultiPartyPayment{other},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{
+				NewReleaseHoldArgs(s.addr1, s.coins("5strawberry")),
+			}},
+		},
+		{
+			name:       "cancelled by the creator",
+			party:      s.addr1,
+			creator:    s.addr1,
+			externalID: "swap",
+			expRemain:  []*exchange.MultiPartyPayment{other},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{
+				NewReleaseHoldArgs(s.addr1, s.coins("5strawberry")),
+			}},
+		},
+		{
+			name:       "cancelled by a party that has not accepted",
+			party:      s.addr3,
+			creator:    s.addr2,
+			externalID: "swap",
+			expRemain:  []*exchange.MultiPartyPayment{swap},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{
+				NewReleaseHoldArgs(s.addr2, s.coins("1tangerine")),
+			}},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			s.requireSetMultiPartyPaymentsInStore(all...)
+			if tc.holdKeeper == nil {
+				tc.holdKeeper = NewMockHoldKeeper()
+			}
+
+			var expEvents sdk.Events
+			if len(tc.expErr) == 0 {
+				for _, mpp := range all {
+					if mpp.Creator == tc.creator.String() {
+						expEvents = append(expEvents, s.untypeEvent(exchange.NewEventMultiPartyPaymentCancelled(mpp, tc.party.String())))
+					}
+				}
+			}
+
+			kpr := s.k.WithHoldKeeper(tc.holdKeeper)
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			var err error
+			testFunc := func() {
+				err = kpr.CancelMultiPartyPayment(ctx, tc.party, tc.creator, tc.externalID)
+			}
+			s.Require().NotPanics(testFunc, "CancelMultiPartyPayment")
+			s.assertErrorValue(err, tc.expErr, "CancelMultiPartyPayment error")
+			s.assertEqualEvents(expEvents, em.Events(), "CancelMultiPartyPayment events")
+			s.assertHoldKeeperCalls(tc.holdKeeper, tc.expHoldCalls, "CancelMultiPartyPayment")
+			s.Assert().Equal(tc.expRemain, s.getAllMultiPartyPayments(), "multi-party payments in state")
+		})
+	}
+}
@@ -0,0 +1,213 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+// parseNAVRecordStoreValue converts a NAV record store value into a NAVRecord.
+func (k Keeper) parseNAVRecordStoreValue(value []byte) (*exchange.NAVRecord, error) {
+	var record exchange.NAVRecord
+	if err := k.cdc.Unmarshal(value, &record); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal nav record: %w", err)
+	}
+	return &record, nil
+}
+
+// getNAVRecordFromStore gets a NAV record from the store. Returns nil, nil if it does not exist.
+func (k Keeper) getNAVRecordFromStore(store storetypes.KVStore, key []byte) (*exchange.NAVRecord, error) {
+	value := store.Get(key)
+	if len(value) == 0 {
+		return nil, nil
+	}
+	return k.parseNAVRecordStoreValue(value)
+}
+
+// setNAVRecordInStore writes a NAV record (and its index entry) to the store.
+func (k Keeper) setNAVRecordInStore(store storetypes.KVStore, record exchange.NAVRecord) error {
+	value, err := k.cdc.Marshal(&record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal nav record: %w", err)
+	}
+	store.Set(MakeKeyNAVRecord(record.Assets.Denom, record.Price.Denom, record.Height, record.MarketId), value)
+	store.Set(MakeIndexKeyHeightToNAVRecord(record.Height, record.Assets.Denom, record.Price.Denom, record.MarketId), []byte{})
+	return nil
+}
+
+// deleteNAVRecordFromStore deletes a NAV record (and its index entry) from the store.
+func deleteNAVRecordFromStore(store storetypes.KVStore, assetDenom, priceDenom string, height int64, marketID uint32) {
+	store.Delete(MakeKeyNAVRecord(assetDenom, priceDenom, height, marketID))
+	store.Delete(MakeIndexKeyHeightToNAVRecord(height, assetDenom, priceDenom, marketID))
+}
+
+// recordNAVHistory adds the provided navs to the NAV history (if NAV records are being kept).
+// If there's already a NAV record for a denom pair in the market at this height, the nav is added to it.
+// Problems are logged, but otherwise ignored so that they don't prevent the settlement.
+func (k Keeper) recordNAVHistory(ctx sdk.Context, store storetypes.KVStore, marketID uint32, navs []exchange.NetAssetPrice) {
+	if getParamsNAVHistoryBlocks(store) == 0 {
+		return
+	}
+
+	height := ctx.BlockHeight()
+	for _, nav := range navs {
+		if !nav.Assets.Amount.IsPositive() || !nav.Price.Amount.IsPositive() {
+			continue
+		}
+
+		record := exchange.NewNAVRecord(marketID, height, nav)
+		key := MakeKeyNAVRecord(nav.Assets.Denom, nav.Price.Denom, height, marketID)
+		existing, err := k.getNAVRecordFromStore(store, key)
+		if err != nil {
+			k.logErrorf(ctx, "error reading existing nav record of %q for %q in market %d: %v",
+				nav.Assets.Denom, nav.Price.Denom, marketID, err)
+		}
+		if existing != nil {
+			record.Assets = record.Assets.Add(existing.Assets)
+			record.Price = record.Price.Add(existing.Price)
+		}
+
+		if err = k.setNAVRecordInStore(store, *record); err != nil {
+			k.logErrorf(ctx, "error recording nav record of %q for %q in market %d: %v",
+				nav.Assets, nav.Price, marketID, err)
+		}
+	}
+}
+
+// pruneExcessNAVRecords deletes the oldest NAV records of a denom pair so that at most maxRecords are left.
+// Returns the number of records deleted.
+func pruneExcessNAVRecords(store storetypes.KVStore, assetDenom, priceDenom string, maxRecords uint32) int {
+	type recordID struct {
+		height   int64
+		marketID uint32
+	}
+
+	var toDelete []recordID
+	kept := uint32(0)
+	iter := storetypes.KVStoreReversePrefixIterator(store, GetKeyPrefixNAVRecordDenoms(assetDenom, priceDenom))
+	for ; iter.Valid(); iter.Next() {
+		if kept < maxRecords {
+			kept++
+			continue
+		}
+		height, marketID, ok := ParseKeySuffixNAVRecord(iter.Key())
+		if ok {
+			toDelete = append(toDelete, recordID{height: height, marketID: marketID})
+		}
+	}
+	iter.Close()
+
+	for _, id := range toDelete {
+		deleteNAVRecordFromStore(store, assetDenom, priceDenom, id.height, id.marketID)
+	}
+	return len(toDelete)
+}
+
+// PruneNAVRecords deletes the NAV records that are older than the nav_history_blocks param.
+// If that param is zero, all NAV records are deleted. At most maxToDelete old records are deleted.
+// Then, for each denom pair with a NAV record at the current height, the oldest of its records
+// are deleted so that there are at most nav_history_max_records of them.
+// Returns the number of records deleted.
+func (k Keeper) PruneNAVRecords(ctx sdk.Context, maxToDelete int) int {
+	store := k.getStore(ctx)
+	return k.pruneOldNAVRecords(ctx, store, maxToDelete) + pruneCurrentExcessNAVRecords(ctx, store)
+}
+
+// pruneOldNAVRecords deletes up to maxToDelete NAV records that are older than the nav_history_blocks param.
+// Returns the number of records deleted.
+func (k Keeper) pruneOldNAVRecords(ctx sdk.Context, store storetypes.KVStore, maxToDelete int) int {
+	height := ctx.BlockHeight()
+	blocks := getParamsNAVHistoryBlocks(store)
+	if height <= 0 || blocks >= uint64(height) {
+		return 0
+	}
+	// Records at this height (and before) are deleted.
+	cutoff := height - int64(blocks) //nolint:gosec // G115: The blocks are less than the height (an int64).
+
+	// Gather the keys first so we aren't writing to the store while iterating it.
+	var keys [][]byte
+	iter := store.Iterator(GetIndexKeyPrefixHeightToNAVRecord(), GetIndexKeyPrefixHeightToNAVRecordAt(cutoff+1))
+	for ; iter.Valid() && len(keys) < maxToDelete; iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		recHeight, assetDenom, priceDenom, marketID, err := ParseIndexKeyHeightToNAVRecord(key)
+		if err != nil {
+			k.logErrorf(ctx, "invalid height to nav record index entry %v (deleted): %v", key, err)
+			store.Delete(key)
+			continue
+		}
+		deleteNAVRecordFromStore(store, assetDenom, priceDenom, recHeight, marketID)
+	}
+	return len(keys)
+}
+
+// pruneCurrentExcessNAVRecords deletes the oldest NAV records of each denom pair recorded at the current
+// height so that each has at most nav_history_max_records. Returns the number of records deleted.
+func pruneCurrentExcessNAVRecords(ctx sdk.Context, store storetypes.KVStore) int {
+	maxRecords := getParamsNAVHistoryMaxRecords(store)
+	if maxRecords == 0 {
+		return 0
+	}
+
+	type denomPair struct {
+		asset string
+		price string
+	}
+
+	var pairs []denomPair
+	seen := make(map[denomPair]bool)
+	iter := storetypes.KVStorePrefixIterator(store, GetIndexKeyPrefixHeightToNAVRecordAt(ctx.BlockHeight()))
+	for ; iter.Valid(); iter.Next() {
+		_, assetDenom, priceDenom, _, err := ParseIndexKeyHeightToNAVRecord(iter.Key())
+		if err != nil {
+			continue
+		}
+		pair := denomPair{asset: assetDenom, price: priceDenom}
+		if !seen[pair] {
+			seen[pair] = true
+			pairs = append(pairs, pair)
+		}
+	}
+	iter.Close()
+
+	rv := 0
+	for _, pair := range pairs {
+		rv += pruneExcessNAVRecords(store, pair.asset, pair.price, maxRecords)
+	}
+	return rv
+}
+
+// GetLatestNAVRecord gets the most recent NAV record of a denom pair. Returns nil, nil if there aren't any.
+// If there are several at the most recent height, the one from the market with the largest id is returned.
+func (k Keeper) GetLatestNAVRecord(ctx sdk.Context, assetDenom, priceDenom string) (*exchange.NAVRecord, error) {
+	iter := storetypes.KVStoreReversePrefixIterator(k.getStore(ctx), GetKeyPrefixNAVRecordDenoms(assetDenom, priceDenom))
+	defer iter.Close()
+	if !iter.Valid() {
+		return nil, nil
+	}
+	return k.parseNAVRecordStoreValue(iter.Value())
+}
+
+// IterateNAVRecords iterates over all NAV records. An error is returned if there was a problem
+// reading an entry along the way. Such a problem does not interrupt iteration.
+// The callback takes in the NAV record and should return whether to stop iterating.
+func (k Keeper) IterateNAVRecords(ctx sdk.Context, cb func(record *exchange.NAVRecord) bool) error {
+	var errs []error
+	k.iterate(ctx, GetKeyPrefixNAVRecord(), func(_, value []byte) bool {
+		record, err := k.parseNAVRecordStoreValue(value)
+		if err != nil {
+			errs = append(errs, err)
+			return false
+		}
+		return cb(record)
+	})
+	return errors.Join(errs...)
+}
@@ -0,0 +1,472 @@
+package keeper_test
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+	"github.com/provenance-io/provenance/x/exchange"
+	"github.com/provenance-io/provenance/x/exchange/keeper"
+)
+
+// requireSetNAVRecords stores the provided NAV records.
+func (s *TestSuite) requireSetNAVRecords(records ...exchange.NAVRecord) {
+	for _, record := range records {
+		assertions.RequireNotPanicsNoErrorf(s.T(), func() error {
+			return s.k.SetNAVRecordInStore(s.getStore(), record)
+		}, "SetNAVRecordInStore(%s)", s.getGenStateNAVRecordStr(record))
+	}
+}
+
+// getAllNAVRecords gets all the NAV records in state, requiring there to not be any errors.
+func (s *TestSuite) getAllNAVRecords() []exchange.NAVRecord {
+	var rv []exchange.NAVRecord
+	err := s.k.IterateNAVRecords(s.ctx, func(record *exchange.NAVRecord) bool {
+		rv = append(rv, *record)
+		return false
+	})
+	s.Require().NoError(err, "IterateNAVRecords")
+	return rv
+}
+
+// navRecord creates a NAV record.
+func (s *TestSuite) navRecord(marketID uint32, height int64, assets, price string) exchange.NAVRecord {
+	return exchange.NAVRecord{
+		Assets:   s.coin(assets),
+		Price:    s.coin(price),
+		Height:   height,
+		MarketId: marketID,
+	}
+}
+
+func (s *TestSuite) TestKeeper_RecordNAVHistory() {
+	historyOn := func() {
+		s.k.SetParams(s.ctx, &exchange.Params{NavHistoryBlocks: 100})
+	}
+	nav := func(assets, price string) exchange.NetAssetPrice {
+		return exchange.NetAssetPrice{Assets: s.coin(assets), Price: s.coin(price)}
+	}
+
+	tests := []struct {
+		name       string
+		setup      func()
+		marketID   uint32
+		height     int64
+		navs       []exchange.NetAssetPrice
+		expRecords []exchange.NAVRecord
+	}{
+		{
+			name:     "nav history not kept",
+			marketID: 1,
+			height:   10,
+			navs:     []exchange.NetAssetPrice{nav("10apple", "25plum")},
+		},
+		{
+			name:     "no navs",
+			setup:    historyOn,
+			marketID: 1,
+			height:   10,
+		},
+		{
+			name:     "zero amounts are ignored",
+			setup:    historyOn,
+			marketID: 1,
+			height:   10,
+			navs: []exchange.NetAssetPrice{
+				nav("0apple", "25plum"),
+				nav("10apple", "0plum"),
+				nav("3apple", "4pear"),
+			},
+			expRecords: []exchange.NAVRecord{s.navRecord(1, 10, "3apple", "4pear")},
+		},
+		{
+			name:     "two pairs",
+			setup:    historyOn,
+			marketID: 3,
+			height:   10,
+			navs:     []exchange.NetAssetPrice{nav("10apple", "25plum"), nav("3apple", "4pear")},
+			expRecords: []exchange.NAVRecord{
+				s.navRecord(3, 10, "3apple", "4pear"),
+				s.navRecord(3, 10, "10apple", "25plum"),
+			},
+		},
+		{
+			name: "added to existing record at same height and market",
+			setup: func() {
+				historyOn()
+				s.requireSetNAVRecords(
+					s.navRecord(1, 9, "1apple", "2plum"),
+					s.navRecord(1, 10, "5apple", "10plum"),
+					s.navRecord(2, 10, "7apple", "9plum"),
+				)
+			},
+			marketID: 1,
+			height:   10,
+			navs:     []exchange.NetAssetPrice{nav("10apple", "25plum")},
+			expRecords: []exchange.NAVRecord{
+				s.navRecord(1, 9, "1apple", "2plum"),
+				s.navRecord(1, 10, "15apple", "35plum"),
+				s.navRecord(2, 10, "7apple", "9plum"),
+			},
+		},
+		{
+			name: "max records: nothing deleted yet",
+			setup: func() {
+				s.k.SetParams(s.ctx, &exchange.Params{NavHistoryBlocks: 100, NavHistoryMaxRecords: 1})
+				s.requireSetNAVRecords(s.navRecord(1, 7, "1apple", "2plum"))
+			},
+			marketID: 1,
+			height:   10,
+			navs:     []exchange.NetAssetPrice{nav("10apple", "25plum")},
+			expRecords: []exchange.NAVRecord{
+				s.navRecord(1, 7, "1apple", "2plum"),
+				s.navRecord(1, 10, "10apple", "25plum"),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			ctx := s.ctx.WithBlockHeight(tc.height)
+			testFunc := func() {
+				s.k.RecordNAVHistory(ctx, tc.marketID, tc.navs)
+			}
+			s.Require().NotPanics(testFunc, "RecordNAVHistory")
+			actRecords := s.getAllNAVRecords()
+			assertEqualSlice(s, tc.expRecords, actRecords, s.getGenStateNAVRecordStr, "nav records in state")
+
+			// Make sure there's an index entry for each record.
+			var indexCount int
+			iter := storetypes.KVStorePrefixIterator(s.getStore(), keeper.GetIndexKeyPrefixHeightToNAVRecord())
+			for ; iter.Valid(); iter.Next() {
+				indexCount++
+			}
+			iter.Close()
+			s.Assert().Equal(len(tc.expRecords), indexCount, "number of height to nav record index entries")
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_PruneNAVRecords() {
+	defaultSetup := func(historyBlocks uint64) func() {
+		return func() {
+			s.k.SetParams(s.ctx, &exchange.Params{NavHistoryBlocks: historyBlocks})
+			s.requireSetNAVRecords(
+				s.navRecord(1, 5, "1apple", "2plum"),
+				s.navRecord(2, 5, "1apple", "3plum"),
+				s.navRecord(1, 8, "1apple", "4plum"),
+				s.navRecord(2, 4, "1apple", "5pear"),
+				s.navRecord(2, 9, "1apple", "6pear"),
+			)
+		}
+	}
+
+	tests := []struct {
+		name        string
+		setup       func()
+		height      int64
+		maxToDelete int
+		expCount    int
+		expRecords  []exchange.NAVRecord
+	}{
+		{
+			name:        "no records",
+			setup:       func() { s.k.SetParams(s.ctx, &exchange.Params{NavHistoryBlocks: 3}) },
+			height:      10,
+			maxToDelete: 100,
+			expCount:    0,
+		},
+		{
+			name:        "history longer than the chain",
+			setup:       defaultSetup(10),
+			height:      10,
+			maxToDelete: 100,
+			expCount:    0,
+			expRecords: []exchange.NAVRecord{
+				s.navRecord(2, 4, "1apple", "5pear"),
+				s.navRecord(2, 9, "1apple", "6pear"),
+				s.navRecord(1, 5, "1apple", "2plum"),
+				s.navRecord(2, 5, "1apple", "3plum"),
+				s.navRecord(1, 8, "1apple", "4plum"),
+			},
+		},
+		{
+			name:        "records at the cutoff are deleted",
+			setup:       defaultSetup(5),
+			height:      10,
+			maxToDelete: 100,
+			expCount:    3,
+			expRecords: []exchange.NAVRecord{
+				s.navRecord(2, 9, "1apple", "6pear"),
+				s.navRecord(1, 8, "1apple", "4plum"),
+			},
+		},
+		{
+			name:        "limited by max to delete",
+			setup:       defaultSetup(5),
+			height:      10,
+			maxToDelete: 2,
+			expCount:    2,
+			expRecords: []exchange.NAVRecord{
+				s.navRecord(2, 9, "1apple", "6pear"),
+				s.navRecord(2, 5, "1apple", "3plum"),
+				s.navRecord(1, 8, "1apple", "4plum"),
+			},
+		},
+		{
+			name:        "history not kept: all deleted",
+			setup:       defaultSetup(0),
+			height:      10,
+			maxToDelete: 100,
+			expCount:    5,
+		},
+		{
+			name: "bad index entry",
+			setup: func() {
+				defaultSetup(5)()
+				s.getStore().Set(append(keeper.GetIndexKeyPrefixHeightToNAVRecordAt(3), 1, 'a'), []byte{})
+			},
+			height:      10,
+			maxToDelete: 100,
+			expCount:    4,
+			expRecords: []exchange.NAVRecord{
+				s.navRecord(2, 9, "1apple", "6pear"),
+				s.navRecord(1, 8, "1apple", "4plum"),
+			},
+		},
+		{
+			name: "max records: only pairs recorded at this height are pruned",
+			setup: func() {
+				s.k.SetParams(s.ctx, &exchange.Params{NavHistoryBlocks: 100, NavHistoryMaxRecords: 2})
+				s.requireSetNAVRecords(
+					s.navRecord(1, 7, "1apple", "2plum"),
+					s.navRecord(2, 8, "1apple", "3plum"),
+					s.navRecord(1, 9, "1apple", "4plum"),
+					s.navRecord(1, 10, "1apple", "5plum"),
+					s.navRecord(1, 4, "1apple", "2pear"),
+					s.navRecord(1, 5, "1apple", "3pear"),
+					s.navRecord(1, 6, "1apple", "4pear"),
+				)
+			},
+			height:      10,
+			maxToDelete: 100,
+			expCount:    2,
+			expRecords: []exchange.NAVRecord{
+				s.navRecord(1, 4, "1apple", "2pear"),
+				s.navRecord(1, 5, "1apple", "3pear"),
+				s.navRecord(1, 6, "1apple", "4pear"),
+				s.navRecord(1, 9, "1apple", "4plum"),
+				s.navRecord(1, 10, "1apple", "5plum"),
+			},
+		},
+		{
+			name: "max records and old records",
+			setup: func() {
+				s.k.SetParams(s.ctx, &exchange.Params{NavHistoryBlocks: 5, NavHistoryMaxRecords: 2})
+				s.requireSetNAVRecords(
+					s.navRecord(1, 4, "1apple", "2plum"),
+					s.navRecord(1, 7, "1apple", "3plum"),
+					s.navRecord(2, 9, "1apple", "4plum"),
+					s.navRecord(1, 10, "1apple", "5plum"),
+					s.navRecord(2, 10, "1apple", "6plum"),
+				)
+			},
+			height:      10,
+			maxToDelete: 100,
+			expCount:    3,
+			expRecords: []exchange.NAVRecord{
+				s.navRecord(1, 10, "1apple", "5plum"),
+				s.navRecord(2, 10, "1apple", "6plum"),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			ctx := s.ctx.WithBlockHeight(tc.height)
+			var count int
+			testFunc := func() {
+				count = s.k.PruneNAVRecords(ctx, tc.maxToDelete)
+			}
+			s.Require().NotPanics(testFunc, "PruneNAVRecords")
+			s.Assert().Equal(tc.expCount, count, "PruneNAVRecords result")
+			actRecords := s.getAllNAVRecords()
+			assertEqualSlice(s, tc.expRecords, actRecords, s.getGenStateNAVRecordStr, "nav records in state")
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_GetLatestNAVRecord() {
+	defaultSetup := func() {
+		s.requireSetNAVRecords(
+			s.navRecord(1, 5, "1apple", "2plum"),
+			s.navRecord(1, 8, "1apple", "4plum"),
+			s.navRecord(2, 8, "1apple", "5plum"),
+			s.navRecord(1, 3, "1apple", "7plum"),
+			s.navRecord(1, 9, "1apple", "6pear"),
+		)
+	}
+
+	tests := []struct {
+		name       string
+		setup      func()
+		assetDenom string
+		priceDenom string
+		expRecord  *exchange.NAVRecord
+		expErr     string
+	}{
+		{
+			name:       "no records",
+			assetDenom: "apple",
+			priceDenom: "plum",
+		},
+		{
+			name:       "unknown pair",
+			setup:      defaultSetup,
+			assetDenom: "plum",
+			priceDenom: "apple",
+		},
+		{
+			name:       "one record for pair",
+			setup:      defaultSetup,
+			assetDenom: "apple",
+			priceDenom: "pear",
+			expRecord:  s.navRecordP(1, 9, "1apple", "6pear"),
+		},
+		{
+			name:       "tie at latest height: largest market id",
+			setup:      defaultSetup,
+			assetDenom: "apple",
+			priceDenom: "plum",
+			expRecord:  s.navRecordP(2, 8, "1apple", "5plum"),
+		},
+		{
+			name: "bad latest value",
+			setup: func() {
+				defaultSetup()
+				s.getStore().Set(keeper.MakeKeyNAVRecord("apple", "pear", 10, 1), []byte("x"))
+			},
+			assetDenom: "apple",
+			priceDenom: "pear",
+			expErr:     "failed to unmarshal nav record: unexpected EOF",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var record *exchange.NAVRecord
+			var err error
+			testFunc := func() {
+				record, err = s.k.GetLatestNAVRecord(s.ctx, tc.assetDenom, tc.priceDenom)
+			}
+			s.Require().NotPanics(testFunc, "GetLatestNAVRecord(%q, %q)", tc.assetDenom, tc.priceDenom)
+			s.assertErrorValue(err, tc.expErr, "GetLatestNAVRecord(%q, %q) error", tc.assetDenom, tc.priceDenom)
+			s.Assert().Equal(tc.expRecord, record, "GetLatestNAVRecord(%q, %q) record", tc.assetDenom, tc.priceDenom)
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_IterateNAVRecords() {
+	var records []exchange.NAVRecord
+	getAll := func(record *exchange.NAVRecord) bool {
+		records = append(records, *record)
+		return false
+	}
+	stopAfter := func(n int) func(record *exchange.NAVRecord) bool {
+		return func(record *exchange.NAVRecord) bool {
+			records = append(records, *record)
+			return len(records) >= n
+		}
+	}
+	defaultSetup := func() {
+		s.requireSetNAVRecords(
+			s.navRecord(2, 3, "1apple", "2plum"),
+			s.navRecord(1, 7, "1apple", "3plum"),
+			s.navRecord(1, 3, "1apple", "4pear"),
+		)
+	}
+
+	tests := []struct {
+		name       string
+		setup      func()
+		cb         func(record *exchange.NAVRecord) bool
+		expRecords []exchange.NAVRecord
+		expErr     string
+	}{
+		{
+			name: "no records",
+			cb:   getAll,
+		},
+		{
+			name:  "three records",
+			setup: defaultSetup,
+			cb:    getAll,
+			expRecords: []exchange.NAVRecord{
+				s.navRecord(1, 3, "1apple", "4pear"),
+				s.navRecord(2, 3, "1apple", "2plum"),
+				s.navRecord(1, 7, "1apple", "3plum"),
+			},
+		},
+		{
+			name:  "stop after two",
+			setup: defaultSetup,
+			cb:    stopAfter(2),
+			expRecords: []exchange.NAVRecord{
+				s.navRecord(1, 3, "1apple", "4pear"),
+				s.navRecord(2, 3, "1apple", "2plum"),
+			},
+		},
+		{
+			name: "bad value",
+			setup: func() {
+				defaultSetup()
+				s.getStore().Set(keeper.MakeKeyNAVRecord("apple", "plum", 5, 1), []byte("x"))
+			},
+			cb: getAll,
+			expRecords: []exchange.NAVRecord{
+				s.navRecord(1, 3, "1apple", "4pear"),
+				s.navRecord(2, 3, "1apple", "2plum"),
+				s.navRecord(1, 7, "1apple", "3plum"),
+			},
+			expErr: "failed to unmarshal nav record: unexpected EOF",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			records = nil
+			var err error
+			testFunc := func() {
+				err = s.k.IterateNAVRecords(s.ctx, tc.cb)
+			}
+			s.Require().NotPanics(testFunc, "IterateNAVRecords")
+			s.assertErrorValue(err, tc.expErr, "IterateNAVRecords error")
+			assertEqualSlice(s, tc.expRecords, records, s.getGenStateNAVRecordStr, "records provided to callback")
+		})
+	}
+}
+
+// navRecordP creates a reference to a NAV record.
+func (s *TestSuite) navRecordP(marketID uint32, height int64, assets, price string) *exchange.NAVRecord {
+	rv := s.navRecord(marketID, height, assets, price)
+	return &rv
+}
@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"bytes"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+// getBookOrders gets the orders in a market that have the provided assets and price denoms and have not expired.
+// Basket orders are not included since their price is not just for their assets. Any order that cannot be read is skipped.
+func (k Keeper) getBookOrders(ctx sdk.Context, store storetypes.KVStore, marketID uint32, assetDenom, priceDenom string) (askOrders, bidOrders []*exchange.Order) {
+	var orderIDs []uint64
+	iterate(store, GetIndexKeyPrefixPriceToOrder(marketID, assetDenom, priceDenom), func(keySuffix, _ []byte) bool {
+		if orderID, ok := ParseIndexKeySuffixOrderID(keySuffix); ok {
+			orderIDs = append(orderIDs, orderID)
+		}
+		return false
+	})
+
+	blockTime := ctx.BlockTime().Unix()
+	for _, orderID := range orderIDs {
+		order, err := k.getOrderFromStore(store, orderID)
+		if err != nil || order == nil {
+			continue
+		}
+		if exp := order.GetExpiration(); exp != nil && exp.Unix() <= blockTime {
+			continue
+		}
+		if order.IsBasketOrder() {
+			continue
+		}
+		if order.IsAskOrder() {
+			askOrders = append(askOrders, order)
+		} else {
+			bidOrders = append(bidOrders, order)
+		}
+	}
+	return askOrders, bidOrders
+}
+
+// getBestPriceLevel gets the price level with the best unit price for one side of a market's order book
+// (i.e. the highest bids or the lowest asks). Only the orders at the start of the price to order index are read.
+// Basket orders are skipped. Returns nil if there are no unexpired orders of the provided type.
+func (k Keeper) getBestPriceLevel(ctx sdk.Context, store storetypes.KVStore, marketID uint32, assetDenom, priceDenom string, orderTypeByte byte) *exchange.PriceLevel {
+	// Using an open iterator on a prefixed store so that iter.Key() doesn't contain the prefix.
+	pStore := prefix.NewStore(store, GetIndexKeyPrefixPriceToOrderType(marketID, assetDenom, priceDenom, orderTypeByte))
+	var iter storetypes.Iterator
+	if orderTypeByte == exchange.OrderTypeByteBid {
+		iter = pStore.ReverseIterator(nil, nil)
+	} else {
+		iter = pStore.Iterator(nil, nil)
+	}
+	defer iter.Close()
+
+	blockTime := ctx.BlockTime().Unix()
+	var bestUnitPrice []byte
+	var orders []*exchange.Order
+	for ; iter.Valid(); iter.Next() {
+		// The unit price in the index is truncated, so all orders with the first one are read,
+		// and the exact best one is identified afterwards.
+		unitPrice, orderID, err := ParseIndexKeySuffixPriceToOrder(iter.Key())
+		if err != nil {
+			continue
+		}
+		if bestUnitPrice != nil && !bytes.Equal(bestUnitPrice, unitPrice) {
+			break
+		}
+		order, err := k.getOrderFromStore(store, orderID)
+		if err != nil || order == nil {
+			continue
+		}
+		if exp := order.GetExpiration(); exp != nil && exp.Unix() <= blockTime {
+			continue
+		}
+		if order.IsBasketOrder() {
+			continue
+		}
+		bestUnitPrice = unitPrice
+		orders = append(orders, order)
+	}
+
+	levels := exchange.BuildPriceLevels(orders, 1)
+	if len(levels) == 0 {
+		return nil
+	}
+	return &levels[0]
+}
+
+// GetOrderBookDepth gets the orders in a market with the provided assets and price denoms, aggregated by unit price.
+// Bids are ordered from highest unit price to lowest, and asks from lowest to highest.
+// If depth is positive, at most that many levels are returned for each side.
+func (k Keeper) GetOrderBookDepth(ctx sdk.Context, marketID uint32, assetDenom, priceDenom string, depth uint32) (bids, asks []exchange.PriceLevel, err error) {
+	store := k.getStore(ctx)
+	if err = validateMarketExists(store, marketID); err != nil {
+		return nil, nil, err
+	}
+	askOrders, bidOrders := k.getBookOrders(ctx, store, marketID, assetDenom, priceDenom)
+	return exchange.BuildPriceLevels(bidOrders, depth), exchange.BuildPriceLevels(askOrders, depth), nil
+}
+
+// GetTopOfBook gets the best bid and ask price levels in a market with the provided assets and price denoms.
+// Either (or both) will be nil if there are no orders of that type.
+func (k Keeper) GetTopOfBook(ctx sdk.Context, marketID uint32, assetDenom, priceDenom string) (bestBid, bestAsk *exchange.PriceLevel, err error) {
+	store := k.getStore(ctx)
+	if err = validateMarketExists(store, marketID); err != nil {
+		return nil, nil, err
+	}
+	bestBid = k.getBestPriceLevel(ctx, store, marketID, assetDenom, priceDenom, exchange.OrderTypeByteBid)
+	bestAsk = k.getBestPriceLevel(ctx, store, marketID, assetDenom, priceDenom, exchange.OrderTypeByteAsk)
+	return bestBid, bestAsk, nil
+}
+
+// IndexOrderPrices makes sure every order has an entry in the price to order index.
+// This is only needed for orders that were created before that index existed.
+// Returns the number of orders indexed and any problems encountered reading the orders.
+func (k Keeper) IndexOrderPrices(ctx sdk.Context) (int, error) {
+	var keys [][]byte
+	err := k.IterateOrders(ctx, func(order *exchange.Order) bool {
+		keys = append(keys, MakeIndexKeyPriceToOrder(order))
+		return false
+	})
+
+	store := k.getStore(ctx)
+	for _, key := range keys {
+		store.Set(key, []byte{})
+	}
+	return len(keys), err
+}
@@ -0,0 +1,169 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+// getOrderLink gets the id of the order linked to the given order.
+// The returned boolean is false if the order is not linked.
+func getOrderLink(store storetypes.KVStore, marketID uint32, orderID uint64) (uint64, bool) {
+	return uint64FromBz(store.Get(MakeKeyOrderLink(marketID, orderID)))
+}
+
+// setOrderLink writes an order link to the store in both directions.
+func setOrderLink(store storetypes.KVStore, link exchange.OrderLink) {
+	store.Set(MakeKeyOrderLink(link.MarketId, link.OrderId), uint64Bz(link.LinkedOrderId))
+	store.Set(MakeKeyOrderLink(link.MarketId, link.LinkedOrderId), uint64Bz(link.OrderId))
+}
+
+// deleteOrderLink deletes both directions of an order link from the store.
+func deleteOrderLink(store storetypes.KVStore, link exchange.OrderLink) {
+	store.Delete(MakeKeyOrderLink(link.MarketId, link.OrderId))
+	store.Delete(MakeKeyOrderLink(link.MarketId, link.LinkedOrderId))
+}
+
+// getLinkableOrderFromStore gets an order from the store, looking at both the order book and
+// the trigger orders that are still waiting. Returns nil, nil if neither has the order.
+func (k Keeper) getLinkableOrderFromStore(store storetypes.KVStore, orderID uint64) (*exchange.Order, error) {
+	order, err := k.getOrderFromStore(store, orderID)
+	if err != nil || order != nil {
+		return order, err
+	}
+	triggerOrder, err := k.getTriggerOrderFromStore(store, orderID)
+	if err != nil || triggerOrder == nil {
+		return nil, err
+	}
+	return &triggerOrder.Order, nil
+}
+
+// LinkOrders links two orders so that when either is filled (fully or partially) or cancelled,
+// the other is cancelled. Both orders must be in the requested market, be owned by the requestor,
+// and not already be linked. Orders can be in the order book or be trigger orders that haven't been activated yet.
+func (k Keeper) LinkOrders(ctx sdk.Context, msg *exchange.MsgLinkOrdersRequest) error {
+	store := k.getStore(ctx)
+	for _, orderID := range []uint64{msg.OrderId, msg.LinkedOrderId} {
+		order, err := k.getLinkableOrderFromStore(store, orderID)
+		if err != nil {
+			return err
+		}
+		if order == nil {
+			return fmt.Errorf("order %d not found", orderID)
+		}
+		if orderMarketID := order.GetMarketID(); orderMarketID != msg.MarketId {
+			return fmt.Errorf("order %d has market id %d, expected %d", orderID, orderMarketID, msg.MarketId)
+		}
+		if orderOwner := order.GetOwner(); orderOwner != msg.Owner {
+			return fmt.Errorf("account %s is not the owner of order %d", msg.Owner, orderID)
+		}
+		if linkedOrderID, isLinked := getOrderLink(store, msg.MarketId, orderID); isLinked {
+			return fmt.Errorf("order %d is already linked to order %d", orderID, linkedOrderID)
+		}
+	}
+
+	link := exchange.NewOrderLink(msg.MarketId, msg.OrderId, msg.LinkedOrderId)
+	setOrderLink(store, *link)
+	k.emitEvent(ctx, exchange.NewEventOrdersLinked(link))
+	return nil
+}
+
+// GetOrderLink gets the link for the given order. Returns nil, nil if the order does not exist or is not linked.
+func (k Keeper) GetOrderLink(ctx sdk.Context, orderID uint64) (*exchange.OrderLink, error) {
+	store := k.getStore(ctx)
+	order, err := k.getLinkableOrderFromStore(store, orderID)
+	if err != nil || order == nil {
+		return nil, err
+	}
+	marketID := order.GetMarketID()
+	linkedOrderID, isLinked := getOrderLink(store, marketID, orderID)
+	if !isLinked {
+		return nil, nil
+	}
+	return exchange.NewOrderLink(marketID, orderID, linkedOrderID), nil
+}
+
+// IterateOrderLinks iterates over all order links. Each link is provided only once, with the lower order id first.
+// An error is returned if there was a problem reading an entry along the way.
+// Such a problem does not interrupt iteration.
+// The callback takes in the order link and should return whether to stop iterating.
+func (k Keeper) IterateOrderLinks(ctx sdk.Context, cb func(link *exchange.OrderLink) bool) error {
+	var errs []error
+	k.iterate(ctx, GetKeyPrefixOrderLink(), func(key, value []byte) bool {
+		marketID, orderID, err := ParseKeyOrderLink(key)
+		if err != nil {
+			errs = append(errs, err)
+			return false
+		}
+		linkedOrderID, ok := uint64FromBz(value)
+		if !ok {
+			errs = append(errs, fmt.Errorf("invalid linked order id value %v for order %d", value, orderID))
+			return false
+		}
+		if orderID > linkedOrderID {
+			return false
+		}
+		return cb(exchange.NewOrderLink(marketID, orderID, linkedOrderID))
+	})
+	return errors.Join(errs...)
+}
+
+// cancelLinkedOrder cancels the order linked to the provided one (if there is one) and removes the link.
+// It should be called when the provided order is filled (fully or partially) or cancelled.
+// If the linked order no longer exists, the link is just removed.
+func (k Keeper) cancelLinkedOrder(ctx sdk.Context, order exchange.OrderI) error {
+	store := k.getStore(ctx)
+	orderID := order.GetOrderID()
+	marketID := order.GetMarketID()
+	linkedOrderID, isLinked := getOrderLink(store, marketID, orderID)
+	if !isLinked {
+		return nil
+	}
+
+	linkedOrder, err := k.getOrderFromStore(store, linkedOrderID)
+	if err != nil {
+		return err
+	}
+	var linkedTriggerOrder *exchange.TriggerOrder
+	if linkedOrder == nil {
+		linkedTriggerOrder, err = k.getTriggerOrderFromStore(store, linkedOrderID)
+		if err != nil {
+			return err
+		}
+		if linkedTriggerOrder != nil {
+			linkedOrder = &linkedTriggerOrder.Order
+		}
+	}
+
+	if linkedOrder != nil {
+		linkedOwnerAddr := sdk.MustAccAddressFromBech32(linkedOrder.GetOwner())
+		err = k.holdKeeper.ReleaseHold(ctx, linkedOwnerAddr, linkedOrder.GetHoldAmount())
+		if err != nil {
+			return fmt.Errorf("unable to release hold on linked order %d funds: %w", linkedOrderID, err)
+		}
+	}
+
+	deleteOrderLink(store, *exchange.NewOrderLink(marketID, orderID, linkedOrderID))
+	if linkedOrder == nil {
+		return nil
+	}
+
+	if linkedTriggerOrder != nil {
+		deleteAndDeIndexTriggerOrder(store, *linkedTriggerOrder)
+		k.emitEvent(ctx, exchange.NewEventLinkedOrderCancelled(linkedOrder, orderID))
+		return k.Hooks().OnOrderCancelled(ctx, linkedOrder, "")
+	}
+
+	deleteAndDeIndexOrder(store, *linkedOrder)
+	k.emitEvent(ctx, exchange.NewEventLinkedOrderCancelled(linkedOrder, orderID))
+	if err = k.Hooks().OnOrderCancelled(ctx, linkedOrder, ""); err != nil {
+		return err
+	}
+	incOrderActionCounter(linkedOrder, exchange.TelemetryActionCancelled)
+	return nil
+}
@@ -0,0 +1,553 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	provtestutil "github.com/provenance-io/provenance/testutil"
+	"github.com/provenance-io/provenance/x/exchange"
+	"github.com/provenance-io/provenance/x/exchange/keeper"
+)
+
+// requireSetOrderLink stores the provided order link.
+func (s *TestSuite) requireSetOrderLink(marketID uint32, orderID, linkedOrderID uint64) {
+	s.Require().NotPanics(func() {
+		keeper.SetOrderLink(s.getStore(), *exchange.NewOrderLink(marketID, orderID, linkedOrderID))
+	}, "SetOrderLink(%d, %d, %d)", marketID, orderID, linkedOrderID)
+}
+
+// assertNotLinked asserts that the given order does not have a link entry in the given market.
+func (s *TestSuite) assertNotLinked(marketID uint32, orderID uint64, msg string, args ...interface{}) bool {
+	s.T().Helper()
+	has := s.getStore().Has(keeper.MakeKeyOrderLink(marketID, orderID))
+	return s.Assert().Falsef(has, msg+": order %d has a link entry", append(args, orderID)...)
+}
+
+func (s *TestSuite) TestKeeper_LinkOrders() {
+	askOrder := func(orderID uint64, marketID uint32, seller sdk.AccAddress) *exchange.Order {
+		return exchange.NewOrder(orderID).WithAsk(&exchange.AskOrder{
+			MarketId: marketID,
+			Seller:   seller.String(),
+			Assets:   s.coin("10apple"),
+			Price:    s.coin("20plum"),
+		})
+	}
+	bidOrder := func(orderID uint64, marketID uint32, buyer sdk.AccAddress) *exchange.Order {
+		return exchange.NewOrder(orderID).WithBid(&exchange.BidOrder{
+			MarketId: marketID,
+			Buyer:    buyer.String(),
+			Assets:   s.coin("10apple"),
+			Price:    s.coin("15plum"),
+		})
+	}
+	defaultSetup := func() {
+		s.requireSetOrdersInStore(s.getStore(),
+			askOrder(1, 3, s.addr1),
+			bidOrder(2, 3, s.addr1),
+			askOrder(3, 3, s.addr2),
+			askOrder(4, 5, s.addr1),
+			askOrder(5, 3, s.addr1),
+		)
+		s.requireSetTriggerOrderInStore(exchange.NewTriggerOrder(*askOrder(6, 3, s.addr1), s.coin("12plum")))
+	}
+
+	tests := []struct {
+		name   string
+		setup  func()
+		msg    exchange.MsgLinkOrdersRequest
+		expErr string
+	}{
+		{
+			name: "error getting order",
+			setup: func() {
+				key, value, err := s.k.GetOrderStoreKeyValue(*askOrder(1, 3, s.addr1))
+				s.Require().NoError(err, "GetOrderStoreKeyValue")
+				value[0] = 9
+				s.getStore().Set(key, value)
+			},
+			msg:    exchange.MsgLinkOrdersRequest{Owner: s.addr1.String(), MarketId: 3, OrderId: 1, LinkedOrderId: 2},
+			expErr: "failed to read order 1: unknown type byte 0x9",
+		},
+		{
+			name:   "order not found",
+			setup:  defaultSetup,
+			msg:    exchange.MsgLinkOrdersRequest{Owner: s.addr1.String(), MarketId: 3, OrderId: 7, LinkedOrderId: 2},
+			expErr: "order 7 not found",
+		},
+		{
+			name:   "linked order not found",
+			setup:  defaultSetup,
+			msg:    exchange.MsgLinkOrdersRequest{Owner: s.addr1.String(), MarketId: 3, OrderId: 1, LinkedOrderId: 7},
+			expErr: "order 7 not found",
+		},
+		{
+			name:   "linked order in other market",
+			setup:  defaultSetup,
+			msg:    exchange.MsgLinkOrdersRequest{Owner: s.addr1.String(), MarketId: 3, OrderId: 1, LinkedOrderId: 4},
+			expErr: "order 4 has market id 5, expected 3",
+		},
+		{
+			name:   "linked order owned by someone else",
+			setup:  defaultSetup,
+			msg:    exchange.MsgLinkOrdersRequest{Owner: s.addr1.String(), MarketId: 3, OrderId: 1, LinkedOrderId: 3},
+			expErr: "account " + s.addr1.String() + " is not the owner of order 3",
+		},
+		{
+			name: "order already linked",
+			setup: func() {
+				defaultSetup()
+				s.requireSetOrderLink(3, 1, 5)
+			},
+			msg:    exchange.MsgLinkOrdersRequest{Owner: s.addr1.String(), MarketId: 3, OrderId: 1, LinkedOrderId: 2},
+			expErr: "order 1 is already linked to order 5",
+		},
+		{
+			name: "linked order already linked",
+			setup: func() {
+				defaultSetup()
+				s.requireSetOrderLink(3, 2, 5)
+			},
+			msg:    exchange.MsgLinkOrdersRequest{Owner: s.addr1.String(), MarketId: 3, OrderId: 1, LinkedOrderId: 2},
+			expErr: "order 2 is already linked to order 5",
+		},
+		{
+			name:  "ask and bid",
+			setup: defaultSetup,
+			msg:   exchange.MsgLinkOrdersRequest{Owner: s.addr1.String(), MarketId: 3, OrderId: 1, LinkedOrderId: 2},
+		},
+		{
+			name:  "order and trigger order",
+			setup: defaultSetup,
+			msg:   exchange.MsgLinkOrdersRequest{Owner: s.addr1.String(), MarketId: 3, OrderId: 5, LinkedOrderId: 6},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var expEvents sdk.Events
+			if len(tc.expErr) == 0 {
+				link := exchange.NewOrderLink(tc.msg.MarketId, tc.msg.OrderId, tc.msg.LinkedOrderId)
+				expEvents = append(expEvents, s.untypeEvent(exchange.NewEventOrdersLinked(link)))
+			}
+
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			var err error
+			testFunc := func() {
+				err = s.k.LinkOrders(ctx, &tc.msg)
+			}
+			s.Require().NotPanics(testFunc, "LinkOrders")
+			s.assertErrorValue(err, tc.expErr, "LinkOrders error")
+			s.assertEqualEvents(expEvents, em.Events(), "LinkOrders events")
+
+			if len(tc.expErr) > 0 {
+				return
+			}
+
+			expLinks := []*exchange.OrderLink{
+				exchange.NewOrderLink(tc.msg.MarketId, tc.msg.OrderId, tc.msg.LinkedOrderId),
+				exchange.NewOrderLink(tc.msg.MarketId, tc.msg.LinkedOrderId, tc.msg.OrderId),
+			}
+			for _, exp := range expLinks {
+				link, lerr := s.k.GetOrderLink(s.ctx, exp.OrderId)
+				if s.Assert().NoError(lerr, "GetOrderLink(%d) error", exp.OrderId) {
+					s.Assert().Equal(exp, link, "GetOrderLink(%d)", exp.OrderId)
+				}
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_GetOrderLink() {
+	askOrder := exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+		MarketId: 2,
+		Seller:   s.addr1.String(),
+		Assets:   s.coin("10apple"),
+		Price:    s.coin("20plum"),
+	})
+	bidOrder := exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+		MarketId: 2,
+		Buyer:    s.addr1.String(),
+		Assets:   s.coin("10apple"),
+		Price:    s.coin("15plum"),
+	})
+	unlinked := exchange.NewOrder(3).WithAsk(&exchange.AskOrder{
+		MarketId: 2,
+		Seller:   s.addr1.String(),
+		Assets:   s.coin("10apple"),
+		Price:    s.coin("20plum"),
+	})
+	s.clearExchangeState()
+	s.requireSetOrdersInStore(s.getStore(), askOrder, unlinked)
+	s.requireSetTriggerOrderInStore(exchange.NewTriggerOrder(*bidOrder, s.coin("18plum")))
+	s.requireSetOrderLink(2, 1, 2)
+
+	tests := []struct {
+		name    string
+		orderID uint64
+		expLink *exchange.OrderLink
+	}{
+		{name: "unknown order", orderID: 4},
+		{name: "unlinked order", orderID: 3},
+		{name: "order linked to trigger order", orderID: 1, expLink: exchange.NewOrderLink(2, 1, 2)},
+		{name: "trigger order linked to order", orderID: 2, expLink: exchange.NewOrderLink(2, 2, 1)},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			var link *exchange.OrderLink
+			var err error
+			testFunc := func() {
+				link, err = s.k.GetOrderLink(s.ctx, tc.orderID)
+			}
+			s.Require().NotPanics(testFunc, "GetOrderLink(%d)", tc.orderID)
+			s.Assert().NoError(err, "GetOrderLink(%d) error", tc.orderID)
+			s.Assert().Equal(tc.expLink, link, "GetOrderLink(%d) result", tc.orderID)
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_IterateOrderLinks() {
+	var links []*exchange.OrderLink
+	getAll := func(link *exchange.OrderLink) bool {
+		links = append(links, link)
+		return false
+	}
+	stopAfter := func(n int) func(link *exchange.OrderLink) bool {
+		return func(link *exchange.OrderLink) bool {
+			links = append(links, link)
+			return len(links) >= n
+		}
+	}
+
+	tests := []struct {
+		name     string
+		setup    func()
+		cb       func(link *exchange.OrderLink) bool
+		expLinks []*exchange.OrderLink
+		expErr   string
+	}{
+		{
+			name:     "no links",
+			cb:       getAll,
+			expLinks: nil,
+		},
+		{
+			name: "three links",
+			setup: func() {
+				s.requireSetOrderLink(2, 8, 3)
+				s.requireSetOrderLink(1, 4, 5)
+				s.requireSetOrderLink(1, 1, 9)
+			},
+			cb: getAll,
+			expLinks: []*exchange.OrderLink{
+				exchange.NewOrderLink(1, 1, 9),
+				exchange.NewOrderLink(1, 4, 5),
+				exchange.NewOrderLink(2, 3, 8),
+			},
+		},
+		{
+			name: "stop after two",
+			setup: func() {
+				s.requireSetOrderLink(2, 8, 3)
+				s.requireSetOrderLink(1, 4, 5)
+				s.requireSetOrderLink(1, 1, 9)
+			},
+			cb: stopAfter(2),
+			expLinks: []*exchange.OrderLink{
+				exchange.NewOrderLink(1, 1, 9),
+				exchange.NewOrderLink(1, 4, 5),
+			},
+		},
+		{
+			name: "bad value",
+			setup: func() {
+				s.requireSetOrderLink(1, 4, 5)
+				s.getStore().Set(keeper.MakeKeyOrderLink(1, 6), []byte{1, 2})
+			},
+			cb:       getAll,
+			expLinks: []*exchange.OrderLink{exchange.NewOrderLink(1, 4, 5)},
+			expErr:   "invalid linked order id value [1 2] for order 6",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			links = nil
+			var err error
+			testFunc := func() {
+				err = s.k.IterateOrderLinks(s.ctx, tc.cb)
+			}
+			s.Require().NotPanics(testFunc, "IterateOrderLinks")
+			s.assertErrorValue(err, tc.expErr, "IterateOrderLinks error")
+			s.Assert().Equal(tc.expLinks, links, "IterateOrderLinks links")
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_CancelOrder_LinkedOrders() {
+	askOrder := exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+		MarketId:   2,
+		Seller:     s.addr1.String(),
+		Assets:     s.coin("10apple"),
+		Price:      s.coin("20plum"),
+		ExternalId: "take-profit",
+	})
+	stopOrder := exchange.NewOrder(2).WithAsk(&exchange.AskOrder{
+		MarketId:   2,
+		Seller:     s.addr1.String(),
+		Assets:     s.coin("10apple"),
+		Price:      s.coin("8plum"),
+		ExternalId: "stop-loss",
+	})
+	bidOrder := exchange.NewOrder(3).WithBid(&exchange.BidOrder{
+		MarketId: 2,
+		Buyer:    s.addr1.String(),
+		Assets:   s.coin("10apple"),
+		Price:    s.coin("15plum"),
+	})
+
+	tests := []struct {
+		name         string
+		holdKeeper   *MockHoldKeeper
+		setup        func()
+		orderID      uint64
+		expErr       string
+		expEvents    []proto.Message
+		expHoldCalls HoldCalls
+		expGone      []uint64
+		expRemain    []uint64
+	}{
+		{
+			name: "order linked to order",
+			setup: func() {
+				s.requireSetOrdersInStore(s.getStore(), askOrder, bidOrder)
+				s.requireSetOrderLink(2, 1, 3)
+			},
+			orderID: 1,
+			expEvents: []proto.Message{
+				exchange.NewEventLinkedOrderCancelled(bidOrder, 1),
+				exchange.NewEventOrderCancelled(askOrder, s.addr1.String()),
+			},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{
+				{addr: s.addr1, funds: s.coins("15plum")},
+				{addr: s.addr1, funds: s.coins("10apple")},
+			}},
+			expGone: []uint64{1, 3},
+		},
+		{
+			name: "order linked to trigger order",
+			setup: func() {
+				s.requireSetOrdersInStore(s.getStore(), askOrder, bidOrder)
+				s.requireSetTriggerOrderInStore(exchange.NewTriggerOrder(*stopOrder, s.coin("9plum")))
+				s.requireSetOrderLink(2, 1, 2)
+			},
+			orderID: 1,
+			expEvents: []proto.Message{
+				exchange.NewEventLinkedOrderCancelled(stopOrder, 1),
+				exchange.NewEventOrderCancelled(askOrder, s.addr1.String()),
+			},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{
+				{addr: s.addr1, funds: s.coins("10apple")},
+				{addr: s.addr1, funds: s.coins("10apple")},
+			}},
+			expGone:   []uint64{1, 2},
+			expRemain: []uint64{3},
+		},
+		{
+			name: "trigger order linked to order",
+			setup: func() {
+				s.requireSetOrdersInStore(s.getStore(), askOrder)
+				s.requireSetTriggerOrderInStore(exchange.NewTriggerOrder(*stopOrder, s.coin("9plum")))
+				s.requireSetOrderLink(2, 1, 2)
+			},
+			orderID: 2,
+			expEvents: []proto.Message{
+				exchange.NewEventLinkedOrderCancelled(askOrder, 2),
+				exchange.NewEventOrderCancelled(stopOrder, s.addr1.String()),
+			},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{
+				{addr: s.addr1, funds: s.coins("10apple")},
+				{addr: s.addr1, funds: s.coins("10apple")},
+			}},
+			expGone: []uint64{1, 2},
+		},
+		{
+			name: "linked order no longer exists",
+			setup: func() {
+				s.requireSetOrdersInStore(s.getStore(), askOrder)
+				s.requireSetOrderLink(2, 1, 3)
+			},
+			orderID:      1,
+			expEvents:    []proto.Message{exchange.NewEventOrderCancelled(askOrder, s.addr1.String())},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr1, funds: s.coins("10apple")}}},
+			expGone:      []uint64{1, 3},
+		},
+		{
+			name:       "error releasing hold on linked order",
+			holdKeeper: NewMockHoldKeeper().WithReleaseHoldResults("not enough plum"),
+			setup: func() {
+				s.requireSetOrdersInStore(s.getStore(), askOrder, bidOrder)
+				s.requireSetOrderLink(2, 1, 3)
+			},
+			orderID:      1,
+			expErr:       "unable to release hold on linked order 3 funds: not enough plum",
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr1, funds: s.coins("15plum")}}},
+			expRemain:    []uint64{1, 3},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var expEvents sdk.Events
+			for _, tev := range tc.expEvents {
+				expEvents = append(expEvents, s.untypeEvent(tev))
+			}
+
+			if tc.holdKeeper == nil {
+				tc.holdKeeper = NewMockHoldKeeper()
+			}
+			kpr := s.k.WithHoldKeeper(tc.holdKeeper)
+
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			var err error
+			testFunc := func() {
+				err = kpr.CancelOrder(ctx, tc.orderID, s.addr1.String())
+			}
+			s.Require().NotPanics(testFunc, "CancelOrder(%d)", tc.orderID)
+			s.assertErrorValue(err, tc.expErr, "CancelOrder(%d) error", tc.orderID)
+			s.assertEqualEvents(expEvents, em.Events(), "CancelOrder(%d) events", tc.orderID)
+			s.assertHoldKeeperCalls(tc.holdKeeper, tc.expHoldCalls, "CancelOrder(%d)", tc.orderID)
+
+			for _, orderID := range tc.expGone {
+				order, oerr := s.k.GetOrder(s.ctx, orderID)
+				s.Assert().NoError(oerr, "GetOrder(%d) error", orderID)
+				s.Assert().Nil(order, "GetOrder(%d)", orderID)
+				triggerOrder, terr := s.k.GetTriggerOrder(s.ctx, orderID)
+				s.Assert().NoError(terr, "GetTriggerOrder(%d) error", orderID)
+				s.Assert().Nil(triggerOrder, "GetTriggerOrder(%d)", orderID)
+				s.assertNotLinked(2, orderID, "after CancelOrder(%d)", tc.orderID)
+			}
+			for _, orderID := range tc.expRemain {
+				order, oerr := s.k.GetOrder(s.ctx, orderID)
+				s.Assert().NoError(oerr, "GetOrder(%d) error", orderID)
+				s.Assert().NotNil(order, "GetOrder(%d)", orderID)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_ExpireOrders_LinkedOrders() {
+	blockTime := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	expiration := blockTime.Add(-1 * time.Minute)
+	expiring := exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+		MarketId:   2,
+		Seller:     s.addr1.String(),
+		Assets:     s.coin("10apple"),
+		Price:      s.coin("20plum"),
+		Expiration: &expiration,
+	})
+	linked := exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+		MarketId:   2,
+		Buyer:      s.addr1.String(),
+		Assets:     s.coin("10apple"),
+		Price:      s.coin("15plum"),
+		ExternalId: "linked",
+	})
+
+	s.clearExchangeState()
+	s.requireSetOrdersInStore(s.getStore(), expiring, linked)
+	s.requireSetOrderLink(2, 1, 2)
+
+	expEvents := sdk.Events{
+		s.untypeEvent(exchange.NewEventOrderExpired(expiring)),
+		s.untypeEvent(exchange.NewEventLinkedOrderCancelled(linked, 1)),
+	}
+	expHoldCalls := HoldCalls{ReleaseHold: []*ReleaseHoldArgs{
+		{addr: s.addr1, funds: s.coins("10apple")},
+		{addr: s.addr1, funds: s.coins("15plum")},
+	}}
+
+	holdKeeper := NewMockHoldKeeper()
+	kpr := s.k.WithHoldKeeper(holdKeeper)
+	sink := provtestutil.EnableTelemetry(s.T())
+	em := sdk.NewEventManager()
+	ctx := s.ctx.WithEventManager(em).WithBlockTime(blockTime)
+	var count int
+	testFunc := func() {
+		count = kpr.ExpireOrders(ctx, 0)
+	}
+	s.Require().NotPanics(testFunc, "ExpireOrders")
+	s.Assert().Equal(1, count, "ExpireOrders result")
+	s.assertEqualEvents(expEvents, em.Events(), "ExpireOrders events")
+	s.assertHoldKeeperCalls(holdKeeper, expHoldCalls, "ExpireOrders")
+	s.assertOrderActionCount(sink, linked, exchange.TelemetryActionCancelled, 1, "ExpireOrders")
+
+	for _, orderID := range []uint64{1, 2} {
+		order, err := s.k.GetOrder(s.ctx, orderID)
+		s.Assert().NoError(err, "GetOrder(%d) error", orderID)
+		s.Assert().Nil(order, "GetOrder(%d)", orderID)
+		s.assertNotLinked(2, orderID, "after ExpireOrders")
+	}
+}
+
+func (s *TestSuite) TestKeeper_CancelAllOrdersForMarket_LinkedOrders() {
+	orders := []*exchange.Order{
+		exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+			MarketId: 4, Seller: s.addr1.String(), Assets: s.coin("10apple"), Price: s.coin("20plum"),
+		}),
+		exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+			MarketId: 4, Buyer: s.addr1.String(), Assets: s.coin("10apple"), Price: s.coin("15plum"),
+		}),
+		exchange.NewOrder(3).WithAsk(&exchange.AskOrder{
+			MarketId: 4, Seller: s.addr2.String(), Assets: s.coin("5apple"), Price: s.coin("12plum"),
+		}),
+	}
+
+	s.clearExchangeState()
+	s.requireSetOrdersInStore(s.getStore(), orders...)
+	s.requireSetOrderLink(4, 1, 2)
+
+	expEvents := sdk.Events{
+		s.untypeEvent(exchange.NewEventLinkedOrderCancelled(orders[1], 1)),
+		s.untypeEvent(exchange.NewEventOrderCancelled(orders[0], s.k.GetAuthority())),
+		s.untypeEvent(exchange.NewEventOrderCancelled(orders[2], s.k.GetAuthority())),
+	}
+
+	kpr := s.k.WithHoldKeeper(NewMockHoldKeeper())
+	em := sdk.NewEventManager()
+	ctx := s.ctx.WithEventManager(em)
+	s.logBuffer.Reset()
+	testFunc := func() {
+		kpr.CancelAllOrdersForMarket(ctx, 4, s.k.GetAuthority())
+	}
+	s.Require().NotPanics(testFunc, "CancelAllOrdersForMarket")
+	s.assertEqualEvents(expEvents, em.Events(), "CancelAllOrdersForMarket events")
+	s.Assert().Empty(s.getLogOutput("CancelAllOrdersForMarket"), "CancelAllOrdersForMarket log output")
+
+	for _, order := range orders {
+		actual, err := s.k.GetOrder(s.ctx, order.OrderId)
+		s.Assert().NoError(err, "GetOrder(%d) error", order.OrderId)
+		s.Assert().Nil(actual, "GetOrder(%d)", order.OrderId)
+		s.assertNotLinked(4, order.OrderId, "after CancelAllOrdersForMarket")
+	}
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RecordOrderCreation counts an order being created by an account in a market.
+// If the market has an order rate limit and the account has already created the max number
+// of orders in the market during the limit's window, an error is returned and nothing is counted.
+// Counts from before the start of the window are deleted.
+func (k Keeper) RecordOrderCreation(ctx sdk.Context, marketID uint32, owner string) error {
+	store := k.getStore(ctx)
+	limit := getOrderRateLimit(store, marketID)
+	if limit == nil {
+		return nil
+	}
+
+	addr, err := sdk.AccAddressFromBech32(owner)
+	if err != nil {
+		return fmt.Errorf("invalid owner %q: %w", owner, err)
+	}
+
+	height := ctx.BlockHeight()
+	windowStart := height - int64(limit.WindowBlocks) + 1
+	var total uint64
+	var current uint32
+	var staleHeights []int64
+	iterate(store, GetKeyPrefixOrderCreationCountsForAddr(marketID, addr), func(keySuffix, value []byte) bool {
+		entryHeightU, ok := uint64FromBz(keySuffix)
+		if !ok {
+			return false
+		}
+		entryHeight := int64(entryHeightU)
+		if entryHeight < windowStart {
+			staleHeights = append(staleHeights, entryHeight)
+			return false
+		}
+		count, _ := uint32FromBz(value)
+		total += uint64(count)
+		if entryHeight == height {
+			current = count
+		}
+		return false
+	})
+
+	for _, staleHeight := range staleHeights {
+		store.Delete(MakeKeyOrderCreationCount(marketID, addr, staleHeight))
+	}
+
+	if total >= uint64(limit.MaxOrders) {
+		return fmt.Errorf("account %s cannot create more than %d orders in market %d every %d blocks",
+			owner, limit.MaxOrders, marketID, limit.WindowBlocks)
+	}
+
+	store.Set(MakeKeyOrderCreationCount(marketID, addr, height), uint32Bz(current+1))
+	return nil
+}
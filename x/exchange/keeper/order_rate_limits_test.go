@@ -0,0 +1,189 @@
+package keeper_test
+
+import (
+	"encoding/binary"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+	"github.com/provenance-io/provenance/x/exchange/keeper"
+)
+
+func (s *TestSuite) TestKeeper_RecordOrderCreation() {
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+
+	setCount := func(marketID uint32, addr sdk.AccAddress, height int64, count uint32) {
+		s.getStore().Set(keeper.MakeKeyOrderCreationCount(marketID, addr, height), binary.BigEndian.AppendUint32(nil, count))
+	}
+	getCounts := func(marketID uint32, addr sdk.AccAddress) map[int64]uint32 {
+		var rv map[int64]uint32
+		prefix := keeper.GetKeyPrefixOrderCreationCountsForAddr(marketID, addr)
+		iter := storetypes.KVStorePrefixIterator(s.getStore(), prefix)
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			if rv == nil {
+				rv = make(map[int64]uint32)
+			}
+			height := int64(binary.BigEndian.Uint64(iter.Key()[len(prefix):]))
+			rv[height] = binary.BigEndian.Uint32(iter.Value())
+		}
+		return rv
+	}
+	limit := func(maxOrders, windowBlocks uint32) func() {
+		return func() {
+			keeper.SetOrderRateLimit(s.getStore(), 3, &exchange.OrderRateLimit{MaxOrders: maxOrders, WindowBlocks: windowBlocks})
+		}
+	}
+
+	tests := []struct {
+		name      string
+		setup     func()
+		height    int64
+		marketID  uint32
+		owner     string
+		expErr    string
+		expCounts map[int64]uint32
+	}{
+		{
+			name:     "no limit",
+			height:   10,
+			marketID: 3,
+			owner:    addr1.String(),
+		},
+		{
+			name:     "no limit: invalid owner is not checked",
+			height:   10,
+			marketID: 3,
+			owner:    "notanaddress",
+		},
+		{
+			name:     "invalid owner",
+			setup:    limit(5, 1),
+			height:   10,
+			marketID: 3,
+			owner:    "notanaddress",
+			expErr:   "invalid owner \"notanaddress\": decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name:      "first order",
+			setup:     limit(5, 1),
+			height:    10,
+			marketID:  3,
+			owner:     addr1.String(),
+			expCounts: map[int64]uint32{10: 1},
+		},
+		{
+			name: "per block: another order in the same block",
+			setup: func() {
+				limit(5, 1)()
+				setCount(3, addr1, 10, 4)
+			},
+			height:    10,
+			marketID:  3,
+			owner:     addr1.String(),
+			expCounts: map[int64]uint32{10: 5},
+		},
+		{
+			name: "per block: limit reached",
+			setup: func() {
+				limit(5, 1)()
+				setCount(3, addr1, 10, 5)
+			},
+			height:    10,
+			marketID:  3,
+			owner:     addr1.String(),
+			expErr:    "account " + addr1.String() + " cannot create more than 5 orders in market 3 every 1 blocks",
+			expCounts: map[int64]uint32{10: 5},
+		},
+		{
+			name: "per block: previous block is pruned",
+			setup: func() {
+				limit(5, 1)()
+				setCount(3, addr1, 9, 5)
+			},
+			height:    10,
+			marketID:  3,
+			owner:     addr1.String(),
+			expCounts: map[int64]uint32{10: 1},
+		},
+		{
+			name: "window: counts from all blocks in the window",
+			setup: func() {
+				limit(6, 3)()
+				setCount(3, addr1, 7, 9)
+				setCount(3, addr1, 8, 2)
+				setCount(3, addr1, 9, 3)
+			},
+			height:    10,
+			marketID:  3,
+			owner:     addr1.String(),
+			expCounts: map[int64]uint32{8: 2, 9: 3, 10: 1},
+		},
+		{
+			name: "window: limit reached",
+			setup: func() {
+				limit(6, 3)()
+				setCount(3, addr1, 8, 2)
+				setCount(3, addr1, 9, 3)
+				setCount(3, addr1, 10, 1)
+			},
+			height:    10,
+			marketID:  3,
+			owner:     addr1.String(),
+			expErr:    "account " + addr1.String() + " cannot create more than 6 orders in market 3 every 3 blocks",
+			expCounts: map[int64]uint32{8: 2, 9: 3, 10: 1},
+		},
+		{
+			name: "window: limit reached but stale entries still pruned",
+			setup: func() {
+				limit(6, 3)()
+				setCount(3, addr1, 2, 1)
+				setCount(3, addr1, 8, 6)
+			},
+			height:    10,
+			marketID:  3,
+			owner:     addr1.String(),
+			expErr:    "account " + addr1.String() + " cannot create more than 6 orders in market 3 every 3 blocks",
+			expCounts: map[int64]uint32{8: 6},
+		},
+		{
+			name: "other accounts and markets are not counted",
+			setup: func() {
+				limit(2, 5)()
+				keeper.SetOrderRateLimit(s.getStore(), 4, &exchange.OrderRateLimit{MaxOrders: 2, WindowBlocks: 5})
+				setCount(3, addr2, 10, 2)
+				setCount(4, addr1, 10, 2)
+				setCount(3, addr1, 9, 1)
+			},
+			height:    10,
+			marketID:  3,
+			owner:     addr1.String(),
+			expCounts: map[int64]uint32{9: 1, 10: 1},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			ctx := s.ctx.WithBlockHeight(tc.height)
+			var err error
+			testFunc := func() {
+				err = s.k.RecordOrderCreation(ctx, tc.marketID, tc.owner)
+			}
+			s.Require().NotPanics(testFunc, "RecordOrderCreation(%d, %q)", tc.marketID, tc.owner)
+			s.assertErrorValue(err, tc.expErr, "RecordOrderCreation(%d, %q)", tc.marketID, tc.owner)
+
+			if addr, aerr := sdk.AccAddressFromBech32(tc.owner); aerr == nil {
+				counts := getCounts(tc.marketID, addr)
+				s.Assert().Equal(tc.expCounts, counts, "order creation counts after RecordOrderCreation")
+			}
+		})
+	}
+}
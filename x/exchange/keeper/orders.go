@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	dbm "github.com/cometbft/cometbft-db"
 
@@ -127,7 +128,7 @@ func createConstantIndexEntries(order exchange.Order) []kv.Pair {
 	addr := sdk.MustAccAddressFromBech32(owner)
 	assets := order.GetAssets()
 
-	return []kv.Pair{
+	rv := []kv.Pair{
 		{
 			Key:   MakeIndexKeyMarketToOrder(marketID, orderID),
 			Value: []byte{orderTypeByte},
@@ -141,6 +142,15 @@ func createConstantIndexEntries(order exchange.Order) []kv.Pair {
 			Value: []byte{orderTypeByte},
 		},
 	}
+
+	if expiration := order.GetExpiration(); expiration != nil {
+		rv = append(rv, kv.Pair{
+			Key:   MakeIndexKeyExpirationToOrder(*expiration, orderID),
+			Value: []byte{orderTypeByte},
+		})
+	}
+
+	return rv
 }
 
 // createMarketExternalIDToOrderEntry creates the market external id to order store entry.
@@ -156,6 +166,20 @@ func createMarketExternalIDToOrderEntry(order exchange.OrderI) *kv.Pair {
 	}
 }
 
+// createAddressExternalIDToOrderEntry creates the address and external id to order store entry.
+// See also createConstantIndexEntries
+func createAddressExternalIDToOrderEntry(order exchange.OrderI) *kv.Pair {
+	externalID := order.GetExternalID()
+	if len(externalID) == 0 {
+		return nil
+	}
+	addr := sdk.MustAccAddressFromBech32(order.GetOwner())
+	return &kv.Pair{
+		Key:   MakeIndexKeyAddressExternalIDToOrder(addr, externalID, order.GetOrderID()),
+		Value: uint32Bz(order.GetMarketID()),
+	}
+}
+
 // getOrderFromStore looks up an order from the store. Returns nil, nil if the order does not exist.
 func (k Keeper) getOrderFromStore(store storetypes.KVStore, orderID uint64) (*exchange.Order, error) {
 	key := MakeKeyOrder(orderID)
@@ -187,7 +211,18 @@ func (k Keeper) setOrderInStore(store storetypes.KVStore, order exchange.Order)
 		}
 	}
 
-	isUpdate := store.Has(key)
+	addrExternalIDEntry := createAddressExternalIDToOrderEntry(order)
+	if addrExternalIDEntry != nil && !store.Has(addrExternalIDEntry.Key) {
+		scope := getExternalIDScope(store, order.GetMarketID())
+		owner := sdk.MustAccAddressFromBech32(order.GetOwner())
+		err = validateAccountExternalIDIsAvailable(store, scope, owner, order.GetExternalID(), order.GetOrderID())
+		if err != nil {
+			return err
+		}
+	}
+
+	oldValue := store.Get(key)
+	isUpdate := len(oldValue) > 0
 	store.Set(key, value)
 
 	if !isUpdate {
@@ -195,11 +230,18 @@ func (k Keeper) setOrderInStore(store storetypes.KVStore, order exchange.Order)
 		for _, entry := range indexEntries {
 			store.Set(entry.Key, entry.Value)
 		}
+	} else if oldOrder, err := k.parseOrderStoreValue(order.GetOrderID(), oldValue); err == nil {
+		// A partial fill can change an order's unit price (due to rounding), so its old price entry is replaced.
+		store.Delete(MakeIndexKeyPriceToOrder(oldOrder))
 	}
+	store.Set(MakeIndexKeyPriceToOrder(order), []byte{})
 
 	if externalIDEntry != nil {
 		store.Set(externalIDEntry.Key, externalIDEntry.Value)
 	}
+	if addrExternalIDEntry != nil {
+		store.Set(addrExternalIDEntry.Key, addrExternalIDEntry.Value)
+	}
 	return nil
 }
 
@@ -211,10 +253,15 @@ func deleteAndDeIndexOrder(store storetypes.KVStore, order exchange.Order) {
 	for _, entry := range indexEntries {
 		store.Delete(entry.Key)
 	}
+	store.Delete(MakeIndexKeyPriceToOrder(order))
 	externalIDEntry := createMarketExternalIDToOrderEntry(order)
 	if externalIDEntry != nil {
 		store.Delete(externalIDEntry.Key)
 	}
+	addrExternalIDEntry := createAddressExternalIDToOrderEntry(order)
+	if addrExternalIDEntry != nil {
+		store.Delete(addrExternalIDEntry.Key)
+	}
 }
 
 // iterateOrderIndex iterates over a <something>-to-order index with keys that have the provided prefixBz.
@@ -229,6 +276,27 @@ func (k Keeper) iterateOrderIndex(ctx sdk.Context, prefixBz []byte, cb func(orde
 	})
 }
 
+// parseOrderTypeFilter converts the provided order type into an order type byte.
+// The returned bool is false if the provided order type is empty (i.e. orders shouldn't be filtered by type).
+func parseOrderTypeFilter(orderType string) (byte, bool, error) {
+	if len(orderType) == 0 {
+		return 0, false, nil
+	}
+	ot := strings.ToLower(orderType)
+	// only look at the first 3 chars to handle stuff like "asks" or "bidOrders" too.
+	if len(ot) > 3 {
+		ot = ot[:3]
+	}
+	switch ot {
+	case exchange.OrderTypeAsk:
+		return OrderKeyTypeAsk, true, nil
+	case exchange.OrderTypeBid:
+		return OrderKeyTypeBid, true, nil
+	default:
+		return 0, false, fmt.Errorf("unknown order type %q", orderType)
+	}
+}
+
 // getPageOfOrdersFromIndex gets a page of orders using a <something>-to-order index.
 func (k Keeper) getPageOfOrdersFromIndex(
 	ctx sdk.Context,
@@ -237,23 +305,9 @@ func (k Keeper) getPageOfOrdersFromIndex(
 	orderType string,
 	afterOrderID uint64,
 ) (*query.PageResponse, []*exchange.Order, error) {
-	var orderTypeByte byte
-	filterByType := false
-	if len(orderType) > 0 {
-		ot := strings.ToLower(orderType)
-		// only look at the first 3 chars to handle stuff like "asks" or "bidOrders" too.
-		if len(ot) > 3 {
-			ot = ot[:3]
-		}
-		switch ot {
-		case exchange.OrderTypeAsk:
-			orderTypeByte = OrderKeyTypeAsk
-		case exchange.OrderTypeBid:
-			orderTypeByte = OrderKeyTypeBid
-		default:
-			return nil, nil, fmt.Errorf("unknown order type %q", orderType)
-		}
-		filterByType = true
+	orderTypeByte, filterByType, err := parseOrderTypeFilter(orderType)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	rootStore := k.getStore(ctx)
@@ -405,6 +459,51 @@ func filteredPaginateAfterOrder(
 	return res, nil
 }
 
+// getPageOfOrdersByPrice gets a page of orders in a market with the provided denoms using the price to order index.
+// Results are ordered by unit price (lowest first). If no order type is provided, all the
+// ask orders come before the bid orders. Orders with ids not greater than afterOrderID are skipped.
+func (k Keeper) getPageOfOrdersByPrice(
+	ctx sdk.Context,
+	marketID uint32,
+	assetDenom, priceDenom string,
+	pageReq *query.PageRequest,
+	orderType string,
+	afterOrderID uint64,
+) (*query.PageResponse, []*exchange.Order, error) {
+	orderTypeByte, filterByType, err := parseOrderTypeFilter(orderType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	prefixBz := GetIndexKeyPrefixPriceToOrder(marketID, assetDenom, priceDenom)
+	if filterByType {
+		prefixBz = GetIndexKeyPrefixPriceToOrderType(marketID, assetDenom, priceDenom, orderTypeByte)
+	}
+
+	rootStore := k.getStore(ctx)
+	var orders []*exchange.Order
+	accumulator := func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		// If we can't get the order id from the key, or it's not after the one provided, this entry doesn't count.
+		orderID, ok := ParseIndexKeySuffixOrderID(key)
+		if !ok || orderID <= afterOrderID {
+			return false, nil
+		}
+		if accumulate {
+			// Only add it to the result if we can read it. This might result in fewer results than the limit,
+			// but at least one bad entry won't block others by causing the whole thing to return an error.
+			order, oerr := k.getOrderFromStore(rootStore, orderID)
+			if oerr == nil && order != nil {
+				orders = append(orders, order)
+			}
+		}
+		return true, nil
+	}
+	prefixStore := prefix.NewStore(rootStore, prefixBz)
+	pageResp, err := query.FilteredPaginate(prefixStore, pageReq, accumulator)
+
+	return pageResp, orders, err
+}
+
 // getOrderIterator is similar to query.getIterator but allows limiting it to only entries after a certain order id.
 func getOrderIterator(prefixStore storetypes.KVStore, start []byte, reverse bool, afterOrderID uint64) dbm.Iterator {
 	if reverse {
@@ -561,6 +660,30 @@ func (k Keeper) getBidOrders(store storetypes.KVStore, marketID uint32, orderIDs
 	return orders, errors.Join(errs...)
 }
 
+// validateNoBasketOrders returns an error if any of the provided orders are basket orders.
+// Basket orders can only be settled by the market, so they cannot be filled by a user.
+func validateNoBasketOrders(orders []*exchange.Order) error {
+	var errs []error
+	for _, order := range orders {
+		if order.IsBasketOrder() {
+			errs = append(errs, fmt.Errorf("order %d is a basket order: basket orders can only be settled by the market", order.OrderId))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// validateNoExpiredOrders returns an error if any of the provided orders have expired as of the provided block time.
+// Expired orders are cancelled at the end of a block, but until then, they cannot be filled.
+func validateNoExpiredOrders(orders []*exchange.Order, blockTime time.Time) error {
+	var errs []error
+	for _, order := range orders {
+		if exp := order.GetExpiration(); exp != nil && exp.Unix() <= blockTime.Unix() {
+			errs = append(errs, fmt.Errorf("order %d expired at %s", order.OrderId, exp.UTC().Format(time.RFC3339)))
+		}
+	}
+	return errors.Join(errs...)
+}
+
 // placeHoldOnOrder places a hold on an order's funds in the owner's account.
 func (k Keeper) placeHoldOnOrder(ctx sdk.Context, order exchange.OrderI) error {
 	orderID := order.GetOrderID()
@@ -620,31 +743,109 @@ func (k Keeper) GetOrderByExternalID(ctx sdk.Context, marketID uint32, externalI
 	return k.getOrderFromStore(store, orderID)
 }
 
-// CreateAskOrder creates an ask order, collects the creation fee, and places all needed holds.
-func (k Keeper) CreateAskOrder(ctx sdk.Context, askOrder exchange.AskOrder, creationFee *sdk.Coin) (uint64, error) {
+// validateOrderExpiration returns an error if the provided expiration is set, but isn't after the block time.
+func validateOrderExpiration(ctx sdk.Context, expiration *time.Time) error {
+	if expiration == nil {
+		return nil
+	}
+	blockTime := ctx.BlockTime()
+	if expiration.Unix() <= blockTime.Unix() {
+		return fmt.Errorf("invalid expiration %s: must be after the current block time %s",
+			expiration.UTC().Format(time.RFC3339), blockTime.UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+// validateNewOrderDisplayAssets returns an error if the order has display assets that aren't less than its assets.
+// Once an iceberg order is partially filled, its display assets can be more than its (remaining) assets.
+// But when it's being created, that would mean none of the assets would be hidden.
+func validateNewOrderDisplayAssets(order exchange.SubOrderI) error {
+	displayAssets := order.GetDisplayAssets()
+	if displayAssets != nil && displayAssets.Amount.GTE(order.GetAssets().Amount) {
+		return fmt.Errorf("invalid display assets %q: must be less than the order assets %q", displayAssets, order.GetAssets())
+	}
+	return nil
+}
+
+// validateNewAskOrder makes sure a new ask order can be created, returning the seller's address.
+func (k Keeper) validateNewAskOrder(ctx sdk.Context, store storetypes.KVStore, askOrder exchange.AskOrder, creationFee *sdk.Coin) (sdk.AccAddress, error) {
 	if err := askOrder.Validate(); err != nil {
-		return 0, err
+		return nil, err
 	}
 
-	store := k.getStore(ctx)
 	marketID := askOrder.MarketId
-
 	if err := validateMarketIsAcceptingOrders(store, marketID); err != nil {
-		return 0, err
+		return nil, err
+	}
+	if err := validateOrderDenoms(store, marketID, askOrder); err != nil {
+		return nil, err
+	}
+	if err := validateOrderExpiration(ctx, askOrder.Expiration); err != nil {
+		return nil, err
+	}
+	if err := validateNewOrderDisplayAssets(askOrder); err != nil {
+		return nil, err
 	}
 	seller := sdk.MustAccAddressFromBech32(askOrder.Seller)
 	if err := k.validateUserCanCreateAsk(ctx, marketID, seller); err != nil {
-		return 0, err
+		return nil, err
+	}
+	if err := k.validateExposure(ctx, store, marketID, seller, sdk.Coins{askOrder.Price}, nil); err != nil {
+		return nil, err
 	}
 	if err := validateCreateAskFees(store, marketID, creationFee, askOrder.SellerSettlementFlatFee); err != nil {
-		return 0, err
+		return nil, err
 	}
 	if err := validateAskPrice(store, marketID, askOrder.Price, askOrder.SellerSettlementFlatFee); err != nil {
+		return nil, err
+	}
+	return seller, nil
+}
+
+// validateNewBidOrder makes sure a new bid order can be created, returning the buyer's address.
+func (k Keeper) validateNewBidOrder(ctx sdk.Context, store storetypes.KVStore, bidOrder exchange.BidOrder, creationFee *sdk.Coin) (sdk.AccAddress, error) {
+	if err := bidOrder.Validate(); err != nil {
+		return nil, err
+	}
+
+	marketID := bidOrder.MarketId
+	if err := validateMarketIsAcceptingOrders(store, marketID); err != nil {
+		return nil, err
+	}
+	if err := validateOrderDenoms(store, marketID, bidOrder); err != nil {
+		return nil, err
+	}
+	if err := validateOrderExpiration(ctx, bidOrder.Expiration); err != nil {
+		return nil, err
+	}
+	if err := validateNewOrderDisplayAssets(bidOrder); err != nil {
+		return nil, err
+	}
+	buyer := sdk.MustAccAddressFromBech32(bidOrder.Buyer)
+	if err := k.validateUserCanCreateBid(ctx, marketID, buyer); err != nil {
+		return nil, err
+	}
+	if err := k.validateExposure(ctx, store, marketID, buyer, sdk.Coins{bidOrder.Price}, nil); err != nil {
+		return nil, err
+	}
+	if err := validateCreateBidFees(store, marketID, creationFee, bidOrder.Price, bidOrder.BuyerSettlementFees); err != nil {
+		return nil, err
+	}
+	return buyer, nil
+}
+
+// CreateAskOrder creates an ask order, collects the creation fee, and places all needed holds.
+// If the market has continuous matching enabled, the new order is then matched against the order book.
+func (k Keeper) CreateAskOrder(ctx sdk.Context, askOrder exchange.AskOrder, creationFee *sdk.Coin) (uint64, error) {
+	store := k.getStore(ctx)
+	seller, err := k.validateNewAskOrder(ctx, store, askOrder, creationFee)
+	if err != nil {
 		return 0, err
 	}
 
+	marketID := askOrder.MarketId
 	if creationFee != nil {
-		err := k.CollectFee(ctx, marketID, seller, sdk.Coins{*creationFee})
+		err = k.CollectFee(ctx, marketID, seller, sdk.Coins{*creationFee})
 		if err != nil {
 			return 0, fmt.Errorf("error collecting ask order creation fee: %w", err)
 		}
@@ -661,31 +862,27 @@ func (k Keeper) CreateAskOrder(ctx sdk.Context, askOrder exchange.AskOrder, crea
 	}
 
 	k.emitEvent(ctx, exchange.NewEventOrderCreated(order))
+	if err := k.Hooks().OnOrderCreated(ctx, order); err != nil {
+		return 0, err
+	}
+	incOrderActionCounter(order, exchange.TelemetryActionCreated)
+
+	k.matchOrder(ctx, order)
 	return orderID, nil
 }
 
 // CreateBidOrder creates a bid order, collects the creation fee, and places all needed holds.
+// If the market has continuous matching enabled, the new order is then matched against the order book.
 func (k Keeper) CreateBidOrder(ctx sdk.Context, bidOrder exchange.BidOrder, creationFee *sdk.Coin) (uint64, error) {
-	if err := bidOrder.Validate(); err != nil {
-		return 0, err
-	}
-
 	store := k.getStore(ctx)
-	marketID := bidOrder.MarketId
-
-	if err := validateMarketIsAcceptingOrders(store, marketID); err != nil {
-		return 0, err
-	}
-	buyer := sdk.MustAccAddressFromBech32(bidOrder.Buyer)
-	if err := k.validateUserCanCreateBid(ctx, marketID, buyer); err != nil {
-		return 0, err
-	}
-	if err := validateCreateBidFees(store, marketID, creationFee, bidOrder.Price, bidOrder.BuyerSettlementFees); err != nil {
+	buyer, err := k.validateNewBidOrder(ctx, store, bidOrder, creationFee)
+	if err != nil {
 		return 0, err
 	}
 
+	marketID := bidOrder.MarketId
 	if creationFee != nil {
-		err := k.CollectFee(ctx, marketID, buyer, sdk.Coins{*creationFee})
+		err = k.CollectFee(ctx, marketID, buyer, sdk.Coins{*creationFee})
 		if err != nil {
 			return 0, fmt.Errorf("error collecting bid order creation fee: %w", err)
 		}
@@ -702,16 +899,55 @@ func (k Keeper) CreateBidOrder(ctx sdk.Context, bidOrder exchange.BidOrder, crea
 	}
 
 	k.emitEvent(ctx, exchange.NewEventOrderCreated(order))
+	if err := k.Hooks().OnOrderCreated(ctx, order); err != nil {
+		return 0, err
+	}
+	incOrderActionCounter(order, exchange.TelemetryActionCreated)
+
+	k.matchOrder(ctx, order)
 	return orderID, nil
 }
 
+// CreateOrdersBatch creates several ask and bid orders, collecting the creation fee and placing the needed holds for each.
+// The ask orders are created first (in the order provided), then the bid orders.
+// Each order is validated and (if applicable) matched the same way as if it were created on its own.
+func (k Keeper) CreateOrdersBatch(ctx sdk.Context, askOrders []exchange.AskOrder, bidOrders []exchange.BidOrder,
+	askCreationFee, bidCreationFee *sdk.Coin,
+) (askOrderIDs, bidOrderIDs []uint64, err error) {
+	askOrderIDs = make([]uint64, len(askOrders))
+	for i, askOrder := range askOrders {
+		askOrderIDs[i], err = k.CreateAskOrder(ctx, askOrder, askCreationFee)
+		if err != nil {
+			return nil, nil, fmt.Errorf("ask order [%d]: %w", i, err)
+		}
+	}
+
+	bidOrderIDs = make([]uint64, len(bidOrders))
+	for i, bidOrder := range bidOrders {
+		bidOrderIDs[i], err = k.CreateBidOrder(ctx, bidOrder, bidCreationFee)
+		if err != nil {
+			return nil, nil, fmt.Errorf("bid order [%d]: %w", i, err)
+		}
+	}
+
+	return askOrderIDs, bidOrderIDs, nil
+}
+
 // CancelOrder releases an order's held funds and deletes it.
+// Trigger orders that have not yet been activated can also be cancelled this way.
 func (k Keeper) CancelOrder(ctx sdk.Context, orderID uint64, signer string) error {
 	order, err := k.GetOrder(ctx, orderID)
 	if err != nil {
 		return err
 	}
 	if order == nil {
+		triggerOrder, terr := k.GetTriggerOrder(ctx, orderID)
+		if terr != nil {
+			return terr
+		}
+		if triggerOrder != nil {
+			return k.cancelTriggerOrder(ctx, triggerOrder, signer)
+		}
 		return fmt.Errorf("order %d does not exist", orderID)
 	}
 
@@ -720,6 +956,10 @@ func (k Keeper) CancelOrder(ctx sdk.Context, orderID uint64, signer string) erro
 		return fmt.Errorf("account %s does not have permission to cancel order %d", signer, orderID)
 	}
 
+	if err = k.cancelLinkedOrder(ctx, order); err != nil {
+		return err
+	}
+
 	orderOwnerAddr := sdk.MustAccAddressFromBech32(orderOwner)
 	heldAmount := order.GetHoldAmount()
 	err = k.holdKeeper.ReleaseHold(ctx, orderOwnerAddr, heldAmount)
@@ -729,10 +969,182 @@ func (k Keeper) CancelOrder(ctx sdk.Context, orderID uint64, signer string) erro
 
 	deleteAndDeIndexOrder(k.getStore(ctx), *order)
 	k.emitEvent(ctx, exchange.NewEventOrderCancelled(order, signer))
+	if err = k.Hooks().OnOrderCancelled(ctx, order, signer); err != nil {
+		return err
+	}
+	incOrderActionCounter(order, exchange.TelemetryActionCancelled)
 
 	return nil
 }
 
+// AmendOrder changes the assets, price, partial-fill flag, and settlement fees of an existing order.
+// The order keeps its id and external id, and the hold on its funds is updated to match the amended order.
+// No order creation fee is charged. If the market has continuous matching enabled, the amended
+// order is then matched against the order book.
+func (k Keeper) AmendOrder(ctx sdk.Context, msg *exchange.MsgAmendOrderRequest) error {
+	store := k.getStore(ctx)
+	order, err := k.getOrderFromStore(store, msg.OrderId)
+	if err != nil {
+		return err
+	}
+	if order == nil {
+		return fmt.Errorf("order %d not found", msg.OrderId)
+	}
+
+	orderMarketID := order.GetMarketID()
+	if orderMarketID != msg.MarketId {
+		return fmt.Errorf("order %d has market id %d, expected %d", msg.OrderId, orderMarketID, msg.MarketId)
+	}
+	if orderOwner := order.GetOwner(); orderOwner != msg.Owner {
+		return fmt.Errorf("account %s is not the owner of order %d", msg.Owner, msg.OrderId)
+	}
+	if err = validateMarketIsAcceptingOrders(store, orderMarketID); err != nil {
+		return err
+	}
+
+	owner := sdk.MustAccAddressFromBech32(msg.Owner)
+	amended := exchange.NewOrder(msg.OrderId)
+	switch {
+	case order.IsAskOrder():
+		if len(msg.BuyerSettlementFees) > 0 {
+			return fmt.Errorf("order %d is an ask order: buyer settlement fees cannot be provided", msg.OrderId)
+		}
+		askOrder := order.GetAskOrder().CopyChange(msg.Assets, msg.Price, msg.SellerSettlementFlatFee)
+		askOrder.AllowPartial = msg.AllowPartial
+		if err = askOrder.Validate(); err != nil {
+			return err
+		}
+		if err = validateOrderDenoms(store, orderMarketID, askOrder); err != nil {
+			return err
+		}
+		if err = k.validateUserCanCreateAsk(ctx, orderMarketID, owner); err != nil {
+			return err
+		}
+		if err = validateSellerSettlementFlatFee(store, orderMarketID, askOrder.SellerSettlementFlatFee); err != nil {
+			return err
+		}
+		if err = validateAskPrice(store, orderMarketID, askOrder.Price, askOrder.SellerSettlementFlatFee); err != nil {
+			return err
+		}
+		amended.WithAsk(askOrder)
+	case order.IsBidOrder():
+		if msg.SellerSettlementFlatFee != nil {
+			return fmt.Errorf("order %d is a bid order: a seller settlement flat fee cannot be provided", msg.OrderId)
+		}
+		bidOrder := order.GetBidOrder().CopyChange(msg.Assets, msg.Price, msg.BuyerSettlementFees)
+		bidOrder.AllowPartial = msg.AllowPartial
+		if err = bidOrder.Validate(); err != nil {
+			return err
+		}
+		if err = validateOrderDenoms(store, orderMarketID, bidOrder); err != nil {
+			return err
+		}
+		if err = k.validateUserCanCreateBid(ctx, orderMarketID, owner); err != nil {
+			return err
+		}
+		if err = validateBuyerSettlementFee(store, orderMarketID, bidOrder.Price, bidOrder.BuyerSettlementFees); err != nil {
+			return err
+		}
+		amended.WithBid(bidOrder)
+	default:
+		return fmt.Errorf("order %d has unexpected type %s", msg.OrderId, order.GetOrderType())
+	}
+
+	if err = k.validateExposure(ctx, store, orderMarketID, owner, sdk.Coins{amended.GetPrice()}, sdk.Coins{order.GetPrice()}); err != nil {
+		return err
+	}
+
+	if err = k.releaseHoldOnOrder(ctx, order); err != nil {
+		return err
+	}
+
+	// The assets denom might be changing, so the old index entries are removed before the amended order is stored.
+	deleteAndDeIndexOrder(store, *order)
+	if err = k.setOrderInStore(store, *amended); err != nil {
+		return fmt.Errorf("error storing amended order: %w", err)
+	}
+
+	if err = k.placeHoldOnOrder(ctx, amended); err != nil {
+		return err
+	}
+
+	k.emitEvent(ctx, exchange.NewEventOrderAmended(amended))
+	incOrderActionCounter(amended, exchange.TelemetryActionAmended)
+
+	k.matchOrder(ctx, amended)
+	return nil
+}
+
+// ExpireOrders cancels all orders with an expiration at or before the block time, releasing their holds.
+// At most limit orders are expired (0 = no limit); any others are picked up by a later call.
+// Returns the number of orders that were expired.
+func (k Keeper) ExpireOrders(ctx sdk.Context, limit int) int {
+	store := k.getStore(ctx)
+	start := GetIndexKeyPrefixExpirationToOrder()
+	end := storetypes.PrefixEndBytes(GetIndexKeyPrefixExpirationToOrderAt(ctx.BlockTime()))
+
+	// Gather the keys first so we aren't writing to the store while iterating it.
+	var keys [][]byte
+	iter := store.Iterator(start, end)
+	for ; iter.Valid() && (limit == 0 || len(keys) < limit); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	count := 0
+	for _, key := range keys {
+		_, orderID, err := ParseIndexKeyExpirationToOrder(key)
+		if err != nil {
+			k.logErrorf(ctx, "invalid expiration index entry %v (deleted): %v", key, err)
+			store.Delete(key)
+			continue
+		}
+
+		order, err := k.getOrderFromStore(store, orderID)
+		if err != nil || order == nil {
+			k.logErrorf(ctx, "could not get expired order %d (index entry deleted): %v", orderID, err)
+			store.Delete(key)
+			continue
+		}
+
+		// If it can't be expired, delete the index entry anyway so that it doesn't get retried every block
+		// and take up room that other expirations need. The order stays on the book until it's cancelled.
+		if err = k.expireOrder(ctx, order); err != nil {
+			k.logErrorf(ctx, "could not expire order %d (index entry deleted): %v", orderID, err)
+			store.Delete(key)
+			continue
+		}
+		count++
+	}
+
+	return count
+}
+
+// expireOrder releases an order's held funds and deletes it.
+// Nothing is changed if there's an error.
+func (k Keeper) expireOrder(ctx sdk.Context, order *exchange.Order) error {
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	orderOwnerAddr := sdk.MustAccAddressFromBech32(order.GetOwner())
+	err := k.holdKeeper.ReleaseHold(cacheCtx, orderOwnerAddr, order.GetHoldAmount())
+	if err != nil {
+		return fmt.Errorf("unable to release hold on order %d funds: %w", order.OrderId, err)
+	}
+
+	deleteAndDeIndexOrder(k.getStore(cacheCtx), *order)
+	k.emitEvent(cacheCtx, exchange.NewEventOrderExpired(order))
+	if err = k.Hooks().OnOrderCancelled(cacheCtx, order, ""); err != nil {
+		return err
+	}
+	if err = k.cancelLinkedOrder(cacheCtx, order); err != nil {
+		return err
+	}
+	writeCache()
+
+	incOrderActionCounter(order, exchange.TelemetryActionExpired)
+	return nil
+}
+
 // SetOrderExternalID updates an order's external id.
 // The caller is responsible for making sure this update should be allowed (e.g. by calling CanSetIDs first).
 func (k Keeper) SetOrderExternalID(ctx sdk.Context, marketID uint32, orderID uint64, newExternalID string) error {
@@ -771,6 +1183,8 @@ func (k Keeper) SetOrderExternalID(ctx sdk.Context, marketID uint32, orderID uin
 	if len(orderExternalID) > 0 {
 		oldIDIndex := MakeIndexKeyMarketExternalIDToOrder(orderMarketID, orderExternalID)
 		store.Delete(oldIDIndex)
+		owner := sdk.MustAccAddressFromBech32(order.GetOwner())
+		store.Delete(MakeIndexKeyAddressExternalIDToOrder(owner, orderExternalID, orderID))
 	}
 
 	err = k.setOrderInStore(store, *order)
@@ -824,8 +1238,13 @@ func (k Keeper) CancelAllOrdersForMarket(ctx sdk.Context, marketID uint32, signe
 		return false
 	})
 
+	store := k.getStore(ctx)
 	var errs []error
 	for _, orderID := range orderIDs {
+		// An order might have already been cancelled because it was linked to one cancelled earlier in this loop.
+		if !store.Has(MakeKeyOrder(orderID)) {
+			continue
+		}
 		err := k.CancelOrder(ctx, orderID, signer)
 		if err != nil {
 			errs = append(errs, err)
@@ -837,3 +1256,61 @@ func (k Keeper) CancelAllOrdersForMarket(ctx sdk.Context, marketID uint32, signe
 			len(errs), marketID, errors.Join(errs...))
 	}
 }
+
+// MarketBulkCancel cancels the orders in a market that match the provided filters, up to the provided limit.
+// Returns the ids of the orders that were cancelled, and whether there are more orders that match the filters.
+// It is assumed that the msg has already been validated and that the admin has permission to cancel orders.
+func (k Keeper) MarketBulkCancel(ctx sdk.Context, msg *exchange.MsgMarketBulkCancelRequest) ([]uint64, bool, error) {
+	orderTypeByte, filterByType, err := parseOrderTypeFilter(msg.OrderType)
+	if err != nil {
+		return nil, false, err
+	}
+
+	limit := int(msg.Limit)
+	if limit == 0 {
+		limit = int(exchange.DefaultMarketBulkCancelLimit)
+	}
+
+	store := k.getStore(ctx)
+	var toCancel []uint64
+	hasMore := false
+	k.IterateMarketOrders(ctx, msg.MarketId, func(orderID uint64, typeByte byte) bool {
+		if filterByType && typeByte != orderTypeByte {
+			return false
+		}
+		if len(msg.Owner) > 0 || len(msg.AssetDenom) > 0 {
+			// If we can't read the order, skip it here so that one bad entry doesn't block the rest.
+			order, oerr := k.getOrderFromStore(store, orderID)
+			if oerr != nil || order == nil {
+				return false
+			}
+			if len(msg.Owner) > 0 && order.GetOwner() != msg.Owner {
+				return false
+			}
+			if len(msg.AssetDenom) > 0 && order.GetAssets().Denom != msg.AssetDenom {
+				return false
+			}
+		}
+		if len(toCancel) >= limit {
+			hasMore = true
+			return true
+		}
+		toCancel = append(toCancel, orderID)
+		return false
+	})
+
+	cancelled := make([]uint64, 0, len(toCancel))
+	for _, orderID := range toCancel {
+		// An order might have already been cancelled because it was linked to one cancelled earlier in this loop.
+		if !store.Has(MakeKeyOrder(orderID)) {
+			continue
+		}
+		if err = k.CancelOrder(ctx, orderID, msg.Admin); err != nil {
+			return nil, false, fmt.Errorf("could not cancel order %d: %w", orderID, err)
+		}
+		cancelled = append(cancelled, orderID)
+	}
+
+	k.emitEvent(ctx, exchange.NewEventMarketOrdersBulkCancelled(msg.MarketId, msg.Admin, uint32(len(cancelled)), hasMore))
+	return cancelled, hasMore, nil
+}
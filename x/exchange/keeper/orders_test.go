@@ -5,16 +5,36 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
+
+	"github.com/hashicorp/go-metrics"
 
 	sdkmath "cosmossdk.io/math"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
+	provtestutil "github.com/provenance-io/provenance/testutil"
 	"github.com/provenance-io/provenance/x/exchange"
 	"github.com/provenance-io/provenance/x/exchange/keeper"
 )
 
+// assertOrderActionCount asserts that the order action telemetry counter for the provided order and action has the expected value.
+func (s *TestSuite) assertOrderActionCount(sink *metrics.InmemSink, order exchange.OrderI, action string, exp float64, msg string, args ...interface{}) bool {
+	s.T().Helper()
+	keys := []string{exchange.ModuleName, exchange.TelemetryKeyOrderAction}
+	labels := []metrics.Label{
+		telemetry.NewLabel(exchange.TelemetryLabelMarketID, fmt.Sprintf("%d", order.GetMarketID())),
+		telemetry.NewLabel(exchange.TelemetryLabelOrderType, order.GetOrderType()),
+		telemetry.NewLabel(exchange.TelemetryLabelAction, action),
+	}
+	act := provtestutil.GetCounterSum(sink, keys, labels...)
+	msgAndArgs := append([]interface{}{msg + " %s %s counter"}, args...)
+	msgAndArgs = append(msgAndArgs, order.GetOrderType(), action)
+	return s.Assert().Equal(exp, act, msgAndArgs...)
+}
+
 func (s *TestSuite) TestKeeper_GetOrder() {
 	tests := []struct {
 		name     string
@@ -459,6 +479,8 @@ func (s *TestSuite) TestKeeper_CreateAskOrder() {
 	reason := func(orderID uint64) string {
 		return fmt.Sprintf("x/exchange: order %d", orderID)
 	}
+	blockTime := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	expiration := blockTime.Add(time.Hour)
 
 	tests := []struct {
 		name         string
@@ -466,6 +488,7 @@ func (s *TestSuite) TestKeeper_CreateAskOrder() {
 		bankKeeper   *MockBankKeeper
 		holdKeeper   *MockHoldKeeper
 		setup        func()
+		blockTime    time.Time
 		askOrder     exchange.AskOrder
 		creationFee  *sdk.Coin
 		expOrderID   uint64
@@ -510,6 +533,77 @@ func (s *TestSuite) TestKeeper_CreateAskOrder() {
 			},
 			expErr: "market 2 is not accepting orders",
 		},
+		{
+			name: "asset denom not accepted",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:            2,
+					AcceptingOrders:     true,
+					AcceptedAssetDenoms: []string{"acorn", "banana"},
+				})
+			},
+			askOrder: exchange.AskOrder{
+				MarketId: 2,
+				Seller:   s.addr3.String(),
+				Assets:   s.coin("35apple"),
+				Price:    s.coin("10peach"),
+			},
+			expErr: "market 2 does not accept asset denom \"apple\"",
+		},
+		{
+			name: "price denom not accepted",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:            2,
+					AcceptingOrders:     true,
+					AcceptedAssetDenoms: []string{"apple"},
+					AcceptedPriceDenoms: []string{"plum"},
+				})
+			},
+			askOrder: exchange.AskOrder{
+				MarketId: 2,
+				Seller:   s.addr3.String(),
+				Assets:   s.coin("35apple"),
+				Price:    s.coin("10peach"),
+			},
+			expErr: "market 2 does not accept price denom \"peach\"",
+		},
+		{
+			name: "expiration not after block time",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:        2,
+					AcceptingOrders: true,
+				})
+			},
+			blockTime: blockTime,
+			askOrder: exchange.AskOrder{
+				MarketId:   2,
+				Seller:     s.addr3.String(),
+				Assets:     s.coin("35apple"),
+				Price:      s.coin("10peach"),
+				Expiration: &blockTime,
+			},
+			expErr: "invalid expiration 2030-01-01T12:00:00Z: must be after the current block time 2030-01-01T12:00:00Z",
+		},
+		{
+			name: "display assets not less than assets",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:        2,
+					AcceptingOrders: true,
+				})
+			},
+			askOrder: exchange.AskOrder{
+				MarketId:      2,
+				Seller:        s.addr3.String(),
+				Assets:        s.coin("35apple"),
+				Price:         s.coin("10peach"),
+				AllowPartial:  true,
+				DisplayAssets: s.coinP("35apple"),
+			},
+			expErr: "invalid display assets \"35apple\": must be less than the order assets \"35apple\"",
+		},
 		{
 			name: "attrs required: does not have",
 			attrKeeper: NewMockAttributeKeeper().
@@ -872,6 +966,46 @@ func (s *TestSuite) TestKeeper_CreateAskOrder() {
 			expOrderID:   66,
 			expHoldCalls: HoldCalls{AddHold: []*AddHoldArgs{{addr: s.addr1, funds: s.coins("11acorn"), reason: reason(66)}}},
 		},
+		{
+			name: "with expiration",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:        2,
+					AcceptingOrders: true,
+				})
+				keeper.SetLastOrderID(s.getStore(), 70)
+			},
+			blockTime: blockTime,
+			askOrder: exchange.AskOrder{
+				MarketId:   2,
+				Seller:     s.addr1.String(),
+				Assets:     s.coin("11acorn"),
+				Price:      s.coin("55plum"),
+				Expiration: &expiration,
+			},
+			expOrderID:   71,
+			expHoldCalls: HoldCalls{AddHold: []*AddHoldArgs{{addr: s.addr1, funds: s.coins("11acorn"), reason: reason(71)}}},
+		},
+		{
+			name: "with display assets",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:        2,
+					AcceptingOrders: true,
+				})
+				keeper.SetLastOrderID(s.getStore(), 75)
+			},
+			askOrder: exchange.AskOrder{
+				MarketId:      2,
+				Seller:        s.addr1.String(),
+				Assets:        s.coin("11acorn"),
+				Price:         s.coin("55plum"),
+				AllowPartial:  true,
+				DisplayAssets: s.coinP("3acorn"),
+			},
+			expOrderID:   76,
+			expHoldCalls: HoldCalls{AddHold: []*AddHoldArgs{{addr: s.addr1, funds: s.coins("11acorn"), reason: reason(76)}}},
+		},
 	}
 
 	for _, tc := range tests {
@@ -900,8 +1034,9 @@ func (s *TestSuite) TestKeeper_CreateAskOrder() {
 			}
 			kpr := s.k.WithAttributeKeeper(tc.attrKeeper).WithBankKeeper(tc.bankKeeper).WithHoldKeeper(tc.holdKeeper)
 
+			sink := provtestutil.EnableTelemetry(s.T())
 			em := sdk.NewEventManager()
-			ctx := s.ctx.WithEventManager(em)
+			ctx := s.ctx.WithEventManager(em).WithBlockTime(tc.blockTime)
 			var orderID uint64
 			var err error
 			testFunc := func() {
@@ -919,6 +1054,8 @@ func (s *TestSuite) TestKeeper_CreateAskOrder() {
 				return
 			}
 
+			s.assertOrderActionCount(sink, expOrder, exchange.TelemetryActionCreated, 1, "CreateAskOrder")
+
 			order, err := s.k.GetOrder(s.ctx, orderID)
 			s.Require().NoError(err, "GetOrder(%d) error (the one just created)", orderID)
 			s.Assert().Equal(expOrder, order, "GetOrder(%d) (the one just created)", orderID)
@@ -932,6 +1069,8 @@ func (s *TestSuite) TestKeeper_CreateBidOrder() {
 	reason := func(orderID uint64) string {
 		return fmt.Sprintf("x/exchange: order %d", orderID)
 	}
+	blockTime := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	expiration := blockTime.Add(time.Hour)
 
 	tests := []struct {
 		name         string
@@ -939,6 +1078,7 @@ func (s *TestSuite) TestKeeper_CreateBidOrder() {
 		bankKeeper   *MockBankKeeper
 		holdKeeper   *MockHoldKeeper
 		setup        func()
+		blockTime    time.Time
 		bidOrder     exchange.BidOrder
 		creationFee  *sdk.Coin
 		expOrderID   uint64
@@ -983,6 +1123,77 @@ func (s *TestSuite) TestKeeper_CreateBidOrder() {
 			},
 			expErr: "market 2 is not accepting orders",
 		},
+		{
+			name: "asset denom not accepted",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:            2,
+					AcceptingOrders:     true,
+					AcceptedAssetDenoms: []string{"acorn", "banana"},
+				})
+			},
+			bidOrder: exchange.BidOrder{
+				MarketId: 2,
+				Buyer:    s.addr3.String(),
+				Assets:   s.coin("35apple"),
+				Price:    s.coin("10peach"),
+			},
+			expErr: "market 2 does not accept asset denom \"apple\"",
+		},
+		{
+			name: "price denom not accepted",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:            2,
+					AcceptingOrders:     true,
+					AcceptedAssetDenoms: []string{"apple"},
+					AcceptedPriceDenoms: []string{"plum"},
+				})
+			},
+			bidOrder: exchange.BidOrder{
+				MarketId: 2,
+				Buyer:    s.addr3.String(),
+				Assets:   s.coin("35apple"),
+				Price:    s.coin("10peach"),
+			},
+			expErr: "market 2 does not accept price denom \"peach\"",
+		},
+		{
+			name: "expiration not after block time",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:        2,
+					AcceptingOrders: true,
+				})
+			},
+			blockTime: blockTime,
+			bidOrder: exchange.BidOrder{
+				MarketId:   2,
+				Buyer:      s.addr3.String(),
+				Assets:     s.coin("35apple"),
+				Price:      s.coin("10peach"),
+				Expiration: &blockTime,
+			},
+			expErr: "invalid expiration 2030-01-01T12:00:00Z: must be after the current block time 2030-01-01T12:00:00Z",
+		},
+		{
+			name: "display assets not less than assets",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:        2,
+					AcceptingOrders: true,
+				})
+			},
+			bidOrder: exchange.BidOrder{
+				MarketId:      2,
+				Buyer:         s.addr3.String(),
+				Assets:        s.coin("35apple"),
+				Price:         s.coin("10peach"),
+				AllowPartial:  true,
+				DisplayAssets: s.coinP("35apple"),
+			},
+			expErr: "invalid display assets \"35apple\": must be less than the order assets \"35apple\"",
+		},
 		{
 			name: "attrs required: does not have",
 			attrKeeper: NewMockAttributeKeeper().
@@ -1373,6 +1584,46 @@ func (s *TestSuite) TestKeeper_CreateBidOrder() {
 			expOrderID:   66,
 			expHoldCalls: HoldCalls{AddHold: []*AddHoldArgs{{addr: s.addr1, funds: s.coins("55plum"), reason: reason(66)}}},
 		},
+		{
+			name: "with expiration",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:        2,
+					AcceptingOrders: true,
+				})
+				keeper.SetLastOrderID(s.getStore(), 70)
+			},
+			blockTime: blockTime,
+			bidOrder: exchange.BidOrder{
+				MarketId:   2,
+				Buyer:      s.addr1.String(),
+				Assets:     s.coin("11acorn"),
+				Price:      s.coin("55plum"),
+				Expiration: &expiration,
+			},
+			expOrderID:   71,
+			expHoldCalls: HoldCalls{AddHold: []*AddHoldArgs{{addr: s.addr1, funds: s.coins("55plum"), reason: reason(71)}}},
+		},
+		{
+			name: "with display assets",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:        2,
+					AcceptingOrders: true,
+				})
+				keeper.SetLastOrderID(s.getStore(), 75)
+			},
+			bidOrder: exchange.BidOrder{
+				MarketId:      2,
+				Buyer:         s.addr1.String(),
+				Assets:        s.coin("11acorn"),
+				Price:         s.coin("55plum"),
+				AllowPartial:  true,
+				DisplayAssets: s.coinP("3acorn"),
+			},
+			expOrderID:   76,
+			expHoldCalls: HoldCalls{AddHold: []*AddHoldArgs{{addr: s.addr1, funds: s.coins("55plum"), reason: reason(76)}}},
+		},
 	}
 
 	for _, tc := range tests {
@@ -1401,8 +1652,9 @@ func (s *TestSuite) TestKeeper_CreateBidOrder() {
 			}
 			kpr := s.k.WithAttributeKeeper(tc.attrKeeper).WithBankKeeper(tc.bankKeeper).WithHoldKeeper(tc.holdKeeper)
 
+			sink := provtestutil.EnableTelemetry(s.T())
 			em := sdk.NewEventManager()
-			ctx := s.ctx.WithEventManager(em)
+			ctx := s.ctx.WithEventManager(em).WithBlockTime(tc.blockTime)
 			var orderID uint64
 			var err error
 			testFunc := func() {
@@ -1420,6 +1672,8 @@ func (s *TestSuite) TestKeeper_CreateBidOrder() {
 				return
 			}
 
+			s.assertOrderActionCount(sink, expOrder, exchange.TelemetryActionCreated, 1, "CreateBidOrder")
+
 			order, err := s.k.GetOrder(s.ctx, orderID)
 			s.Require().NoError(err, "error from GetOrder(%d) (the one just created)", orderID)
 			s.Assert().Equal(expOrder, order, "GetOrder(%d) (the one just created)", orderID)
@@ -1429,10 +1683,160 @@ func (s *TestSuite) TestKeeper_CreateBidOrder() {
 	}
 }
 
+func (s *TestSuite) TestKeeper_CreateOrdersBatch() {
+	reason := func(orderID uint64) string {
+		return fmt.Sprintf("x/exchange: order %d", orderID)
+	}
+	askOrder := func(assets, price string) exchange.AskOrder {
+		return exchange.AskOrder{MarketId: 2, Seller: s.addr1.String(), Assets: s.coin(assets), Price: s.coin(price)}
+	}
+	bidOrder := func(assets, price string) exchange.BidOrder {
+		return exchange.BidOrder{MarketId: 2, Buyer: s.addr1.String(), Assets: s.coin(assets), Price: s.coin(price)}
+	}
+
+	tests := []struct {
+		name           string
+		setup          func()
+		askOrders      []exchange.AskOrder
+		bidOrders      []exchange.BidOrder
+		askCreationFee *sdk.Coin
+		bidCreationFee *sdk.Coin
+		expAskIDs      []uint64
+		expBidIDs      []uint64
+		expErr         string
+		expBankCalls   BankCalls
+		expHoldCalls   HoldCalls
+	}{
+		{
+			name: "error creating an ask order",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{MarketId: 2, AcceptingOrders: true})
+				keeper.SetLastOrderID(s.getStore(), 10)
+			},
+			askOrders: []exchange.AskOrder{
+				askOrder("10apple", "20plum"),
+				{MarketId: 3, Seller: s.addr1.String(), Assets: s.coin("10apple"), Price: s.coin("20plum")},
+			},
+			bidOrders: []exchange.BidOrder{bidOrder("10apple", "18plum")},
+			expErr:    "ask order [1]: market 3 does not exist",
+			expHoldCalls: HoldCalls{AddHold: []*AddHoldArgs{
+				{addr: s.addr1, funds: s.coins("10apple"), reason: reason(11)},
+			}},
+		},
+		{
+			name: "error creating a bid order",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId: 2, AcceptingOrders: true,
+					FeeCreateBidFlat: s.coins("5fig"),
+				})
+				keeper.SetLastOrderID(s.getStore(), 10)
+			},
+			askOrders: []exchange.AskOrder{askOrder("10apple", "20plum")},
+			bidOrders: []exchange.BidOrder{bidOrder("10apple", "18plum")},
+			expErr:    "bid order [0]: no bid order creation fee provided, must be one of: 5fig",
+			expHoldCalls: HoldCalls{AddHold: []*AddHoldArgs{
+				{addr: s.addr1, funds: s.coins("10apple"), reason: reason(11)},
+			}},
+		},
+		{
+			name: "only bids",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{MarketId: 2, AcceptingOrders: true})
+				keeper.SetLastOrderID(s.getStore(), 4)
+			},
+			bidOrders: []exchange.BidOrder{bidOrder("10apple", "18plum"), bidOrder("5apple", "8plum")},
+			expAskIDs: []uint64{},
+			expBidIDs: []uint64{5, 6},
+			expHoldCalls: HoldCalls{AddHold: []*AddHoldArgs{
+				{addr: s.addr1, funds: s.coins("18plum"), reason: reason(5)},
+				{addr: s.addr1, funds: s.coins("8plum"), reason: reason(6)},
+			}},
+		},
+		{
+			name: "asks and bids with creation fees",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId: 2, AcceptingOrders: true,
+					FeeCreateAskFlat: s.coins("3fig"),
+					FeeCreateBidFlat: s.coins("4fig"),
+				})
+				keeper.SetLastOrderID(s.getStore(), 20)
+			},
+			askOrders:      []exchange.AskOrder{askOrder("10apple", "30plum"), askOrder("12apple", "40plum")},
+			bidOrders:      []exchange.BidOrder{bidOrder("10apple", "18plum")},
+			askCreationFee: s.coinP("3fig"),
+			bidCreationFee: s.coinP("4fig"),
+			expAskIDs:      []uint64{21, 22},
+			expBidIDs:      []uint64{23},
+			expBankCalls: BankCalls{
+				SendCoins: []*SendCoinsArgs{
+					{ctxHasQuarantineBypass: false, fromAddr: s.addr1, toAddr: s.marketAddr2, amt: s.coins("3fig")},
+					{ctxHasQuarantineBypass: false, fromAddr: s.addr1, toAddr: s.marketAddr2, amt: s.coins("3fig")},
+					{ctxHasQuarantineBypass: false, fromAddr: s.addr1, toAddr: s.marketAddr2, amt: s.coins("4fig")},
+				},
+				SendCoinsFromAccountToModule: []*SendCoinsFromAccountToModuleArgs{
+					{ctxHasQuarantineBypass: false, senderAddr: s.marketAddr2, recipientModule: s.feeCollector, amt: s.coins("1fig")},
+					{ctxHasQuarantineBypass: false, senderAddr: s.marketAddr2, recipientModule: s.feeCollector, amt: s.coins("1fig")},
+					{ctxHasQuarantineBypass: false, senderAddr: s.marketAddr2, recipientModule: s.feeCollector, amt: s.coins("1fig")},
+				},
+			},
+			expHoldCalls: HoldCalls{AddHold: []*AddHoldArgs{
+				{addr: s.addr1, funds: s.coins("10apple"), reason: reason(21)},
+				{addr: s.addr1, funds: s.coins("12apple"), reason: reason(22)},
+				{addr: s.addr1, funds: s.coins("18plum"), reason: reason(23)},
+			}},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var expEvents sdk.Events
+			if len(tc.expErr) == 0 {
+				for i, orderID := range tc.expAskIDs {
+					expEvents = append(expEvents, s.untypeEvent(exchange.NewEventOrderCreated(
+						exchange.NewOrder(orderID).WithAsk(&tc.askOrders[i]))))
+				}
+				for i, orderID := range tc.expBidIDs {
+					expEvents = append(expEvents, s.untypeEvent(exchange.NewEventOrderCreated(
+						exchange.NewOrder(orderID).WithBid(&tc.bidOrders[i]))))
+				}
+			}
+
+			bankKeeper := NewMockBankKeeper()
+			holdKeeper := NewMockHoldKeeper()
+			kpr := s.k.WithAttributeKeeper(NewMockAttributeKeeper()).WithBankKeeper(bankKeeper).WithHoldKeeper(holdKeeper)
+
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			var askIDs, bidIDs []uint64
+			var err error
+			testFunc := func() {
+				askIDs, bidIDs, err = kpr.CreateOrdersBatch(ctx, tc.askOrders, tc.bidOrders, tc.askCreationFee, tc.bidCreationFee)
+			}
+			s.Require().NotPanics(testFunc, "CreateOrdersBatch")
+			s.assertErrorValue(err, tc.expErr, "CreateOrdersBatch error")
+			s.Assert().Equal(tc.expAskIDs, askIDs, "CreateOrdersBatch ask order ids")
+			s.Assert().Equal(tc.expBidIDs, bidIDs, "CreateOrdersBatch bid order ids")
+			s.assertBankKeeperCalls(bankKeeper, tc.expBankCalls, "CreateOrdersBatch")
+			s.assertHoldKeeperCalls(holdKeeper, tc.expHoldCalls, "CreateOrdersBatch")
+			if len(tc.expErr) == 0 {
+				s.assertEqualEvents(expEvents, em.Events(), "CreateOrdersBatch events")
+			}
+		})
+	}
+}
+
 func (s *TestSuite) TestKeeper_CancelOrder() {
 	tests := []struct {
 		name         string
 		holdKeeper   *MockHoldKeeper
+		hooks        *MockExchangeHooks
 		setup        func() *exchange.Order // should return the order expected to be cancelled.
 		orderID      uint64
 		signer       string
@@ -1496,6 +1900,24 @@ func (s *TestSuite) TestKeeper_CancelOrder() {
 			expErr:       "unable to release hold on order 7 funds: there's not enough here",
 			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr3, funds: s.coins("333prune")}}},
 		},
+		{
+			name:  "error from hook",
+			hooks: NewMockExchangeHooks().WithErrResult("no cancelling allowed"),
+			setup: func() *exchange.Order {
+				orderToCancel := exchange.NewOrder(7).WithBid(&exchange.BidOrder{
+					MarketId: 1,
+					Buyer:    s.addr3.String(),
+					Assets:   s.coin("50apricot"),
+					Price:    s.coin("333prune"),
+				})
+				s.requireSetOrderInStore(s.getStore(), orderToCancel)
+				return orderToCancel
+			},
+			orderID:      7,
+			signer:       s.addr3.String(),
+			expErr:       "no cancelling allowed",
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr3, funds: s.coins("333prune")}}},
+		},
 		{
 			name: "signer can cancel in other market but not this one",
 			setup: func() *exchange.Order {
@@ -1659,7 +2081,9 @@ func (s *TestSuite) TestKeeper_CancelOrder() {
 
 			var expEvents sdk.Events
 			var expDelKVs []kv.Pair
+			var expHooksCalls ExchangeHooksCalls
 			if cancelledOrder != nil {
+				expHooksCalls.OnOrderCancelled = []*OnOrderCancelledArgs{{orderID: cancelledOrder.OrderId, cancelledBy: tc.signer}}
 				event := exchange.NewEventOrderCancelled(cancelledOrder, tc.signer)
 				expEvents = append(expEvents, s.untypeEvent(event))
 
@@ -1673,8 +2097,12 @@ func (s *TestSuite) TestKeeper_CancelOrder() {
 			if tc.holdKeeper == nil {
 				tc.holdKeeper = NewMockHoldKeeper()
 			}
-			kpr := s.k.WithHoldKeeper(tc.holdKeeper)
+			if tc.hooks == nil {
+				tc.hooks = NewMockExchangeHooks()
+			}
+			kpr := s.k.WithHoldKeeper(tc.holdKeeper).WithHooks(tc.hooks)
 
+			sink := provtestutil.EnableTelemetry(s.T())
 			em := sdk.NewEventManager()
 			ctx := s.ctx.WithEventManager(em)
 			var err error
@@ -1686,11 +2114,13 @@ func (s *TestSuite) TestKeeper_CancelOrder() {
 			actEvents := em.Events()
 			s.assertEqualEvents(expEvents, actEvents, "CancelOrder(%d, %q) events", tc.orderID, tc.signer)
 			s.assertHoldKeeperCalls(tc.holdKeeper, tc.expHoldCalls, "CancelOrder(%d, %q)", tc.orderID, tc.signer)
+			s.assertExchangeHooksCalls(tc.hooks, expHooksCalls, "CancelOrder(%d, %q)", tc.orderID, tc.signer)
 
 			if err != nil || len(tc.expErr) > 0 {
 				return
 			}
 
+			s.assertOrderActionCount(sink, cancelledOrder, exchange.TelemetryActionCancelled, 1, "CancelOrder(%d, %q)", tc.orderID, tc.signer)
 			order, err := s.k.GetOrder(s.ctx, tc.orderID)
 			s.Assert().NoError(err, "GetOrder(%d) error after cancel")
 			s.Assert().Nil(order, "GetOrder(%d) order after cancel")
@@ -1703,25 +2133,568 @@ func (s *TestSuite) TestKeeper_CancelOrder() {
 	}
 }
 
-func (s *TestSuite) TestKeeper_SetOrderExternalID() {
+func (s *TestSuite) TestKeeper_AmendOrder() {
+	reason := func(orderID uint64) string {
+		return fmt.Sprintf("x/exchange: order %d", orderID)
+	}
+	askOrder := exchange.NewOrder(5).WithAsk(&exchange.AskOrder{
+		MarketId:                1,
+		Seller:                  s.addr1.String(),
+		Assets:                  s.coin("10apple"),
+		Price:                   s.coin("20plum"),
+		SellerSettlementFlatFee: s.coinP("5fig"),
+		ExternalId:              "ask five",
+	})
+	bidOrder := exchange.NewOrder(6).WithBid(&exchange.BidOrder{
+		MarketId:            1,
+		Buyer:               s.addr2.String(),
+		Assets:              s.coin("10apple"),
+		Price:               s.coin("18plum"),
+		BuyerSettlementFees: s.coins("2fig"),
+		ExternalId:          "bid six",
+	})
+	defaultSetup := func() {
+		s.requireCreateMarket(exchange.Market{
+			MarketId:                1,
+			AcceptingOrders:         true,
+			FeeSellerSettlementFlat: s.coins("5fig"),
+		})
+		s.requireSetOrderInStore(s.getStore(), askOrder)
+		s.requireSetOrderInStore(s.getStore(), bidOrder)
+	}
+
 	tests := []struct {
-		name          string
-		setup         func() string // should return the original externalID
-		marketID      uint32
-		orderID       uint64
-		newExternalID string
-		expErr        string
+		name         string
+		holdKeeper   *MockHoldKeeper
+		setup        func()
+		msg          exchange.MsgAmendOrderRequest
+		expOrder     *exchange.Order
+		expErr       string
+		expHoldCalls HoldCalls
 	}{
 		{
-			name:          "new external id too long",
-			marketID:      1,
-			orderID:       1,
-			newExternalID: strings.Repeat("I", exchange.MaxExternalIDLength+1),
-			expErr: fmt.Sprintf("invalid external id %q (length %d): max length %d",
-				"IIIII...IIIII", exchange.MaxExternalIDLength+1, exchange.MaxExternalIDLength),
+			name: "error getting order",
+			setup: func() {
+				key, value, err := s.k.GetOrderStoreKeyValue(*askOrder)
+				s.Require().NoError(err, "GetOrderStoreKeyValue")
+				value[0] = 9
+				s.getStore().Set(key, value)
+			},
+			msg:    exchange.MsgAmendOrderRequest{Owner: s.addr1.String(), MarketId: 1, OrderId: 5},
+			expErr: "failed to read order 5: unknown type byte 0x9",
 		},
 		{
-			name: "error getting order",
+			name:   "order not found",
+			setup:  defaultSetup,
+			msg:    exchange.MsgAmendOrderRequest{Owner: s.addr1.String(), MarketId: 1, OrderId: 7},
+			expErr: "order 7 not found",
+		},
+		{
+			name:   "wrong market",
+			setup:  defaultSetup,
+			msg:    exchange.MsgAmendOrderRequest{Owner: s.addr1.String(), MarketId: 2, OrderId: 5},
+			expErr: "order 5 has market id 1, expected 2",
+		},
+		{
+			name:   "not the owner",
+			setup:  defaultSetup,
+			msg:    exchange.MsgAmendOrderRequest{Owner: s.addr2.String(), MarketId: 1, OrderId: 5},
+			expErr: "account " + s.addr2.String() + " is not the owner of order 5",
+		},
+		{
+			name: "market not accepting orders",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{MarketId: 1, AcceptingOrders: false})
+				s.requireSetOrderInStore(s.getStore(), askOrder)
+			},
+			msg:    exchange.MsgAmendOrderRequest{Owner: s.addr1.String(), MarketId: 1, OrderId: 5},
+			expErr: "market 1 is not accepting orders",
+		},
+		{
+			name:  "ask order with buyer settlement fees",
+			setup: defaultSetup,
+			msg: exchange.MsgAmendOrderRequest{
+				Owner:               s.addr1.String(),
+				MarketId:            1,
+				OrderId:             5,
+				Assets:              s.coin("10apple"),
+				Price:               s.coin("20plum"),
+				BuyerSettlementFees: s.coins("5fig"),
+			},
+			expErr: "order 5 is an ask order: buyer settlement fees cannot be provided",
+		},
+		{
+			name:  "bid order with seller settlement flat fee",
+			setup: defaultSetup,
+			msg: exchange.MsgAmendOrderRequest{
+				Owner:                   s.addr2.String(),
+				MarketId:                1,
+				OrderId:                 6,
+				Assets:                  s.coin("10apple"),
+				Price:                   s.coin("18plum"),
+				SellerSettlementFlatFee: s.coinP("5fig"),
+			},
+			expErr: "order 6 is a bid order: a seller settlement flat fee cannot be provided",
+		},
+		{
+			name:  "amended ask order invalid",
+			setup: defaultSetup,
+			msg: exchange.MsgAmendOrderRequest{
+				Owner:                   s.addr1.String(),
+				MarketId:                1,
+				OrderId:                 5,
+				Assets:                  s.coin("10plum"),
+				Price:                   s.coin("20plum"),
+				SellerSettlementFlatFee: s.coinP("5fig"),
+			},
+			expErr: "invalid assets: price denom plum cannot also be the assets denom",
+		},
+		{
+			name:  "insufficient seller settlement flat fee",
+			setup: defaultSetup,
+			msg: exchange.MsgAmendOrderRequest{
+				Owner:                   s.addr1.String(),
+				MarketId:                1,
+				OrderId:                 5,
+				Assets:                  s.coin("10apple"),
+				Price:                   s.coin("30plum"),
+				SellerSettlementFlatFee: s.coinP("2fig"),
+			},
+			expErr: "insufficient seller settlement flat fee: \"2fig\" is less than required amount \"5fig\"",
+		},
+		{
+			name: "amended past the max exposure",
+			setup: func() {
+				defaultSetup()
+				keeper.SetMaxExposure(s.getStore(), 1, s.coinP("30plum"))
+				keeper.SetCommitmentAmount(s.getStore(), 1, s.addr2, s.coins("10plum"), s.ctx.BlockTime())
+			},
+			msg: exchange.MsgAmendOrderRequest{
+				Owner:               s.addr2.String(),
+				MarketId:            1,
+				OrderId:             6,
+				Assets:              s.coin("10apple"),
+				Price:               s.coin("21plum"),
+				BuyerSettlementFees: s.coins("2fig"),
+			},
+			expErr: "account " + s.addr2.String() + " exposure in market 1 would be 31plum, which exceeds the max of 30plum",
+		},
+		{
+			name: "amended up to the max exposure",
+			setup: func() {
+				defaultSetup()
+				keeper.SetMaxExposure(s.getStore(), 1, s.coinP("30plum"))
+				keeper.SetCommitmentAmount(s.getStore(), 1, s.addr2, s.coins("10plum"), s.ctx.BlockTime())
+			},
+			msg: exchange.MsgAmendOrderRequest{
+				Owner:               s.addr2.String(),
+				MarketId:            1,
+				OrderId:             6,
+				Assets:              s.coin("10apple"),
+				Price:               s.coin("20plum"),
+				BuyerSettlementFees: s.coins("2fig"),
+			},
+			expOrder: exchange.NewOrder(6).WithBid(&exchange.BidOrder{
+				MarketId:            1,
+				Buyer:               s.addr2.String(),
+				Assets:              s.coin("10apple"),
+				Price:               s.coin("20plum"),
+				BuyerSettlementFees: s.coins("2fig"),
+				ExternalId:          "bid six",
+			}),
+			expHoldCalls: HoldCalls{
+				ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr2, funds: s.coins("2fig,18plum")}},
+				AddHold:     []*AddHoldArgs{{addr: s.addr2, funds: s.coins("2fig,20plum"), reason: reason(6)}},
+			},
+		},
+		{
+			name:       "error releasing hold",
+			holdKeeper: NewMockHoldKeeper().WithReleaseHoldResults("not enough held"),
+			setup:      defaultSetup,
+			msg: exchange.MsgAmendOrderRequest{
+				Owner:               s.addr2.String(),
+				MarketId:            1,
+				OrderId:             6,
+				Assets:              s.coin("10apple"),
+				Price:               s.coin("25plum"),
+				BuyerSettlementFees: s.coins("2fig"),
+			},
+			expErr:       "error releasing hold for bid order 6: not enough held",
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr2, funds: s.coins("2fig,18plum")}}},
+		},
+		{
+			name:       "error placing hold",
+			holdKeeper: NewMockHoldKeeper().WithAddHoldResults("insufficient funds"),
+			setup:      defaultSetup,
+			msg: exchange.MsgAmendOrderRequest{
+				Owner:               s.addr2.String(),
+				MarketId:            1,
+				OrderId:             6,
+				Assets:              s.coin("10apple"),
+				Price:               s.coin("25plum"),
+				BuyerSettlementFees: s.coins("2fig"),
+			},
+			expErr: "error placing hold for bid order 6: insufficient funds",
+			expHoldCalls: HoldCalls{
+				ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr2, funds: s.coins("2fig,18plum")}},
+				AddHold:     []*AddHoldArgs{{addr: s.addr2, funds: s.coins("2fig,25plum"), reason: reason(6)}},
+			},
+		},
+		{
+			name:  "ask order amended",
+			setup: defaultSetup,
+			msg: exchange.MsgAmendOrderRequest{
+				Owner:                   s.addr1.String(),
+				MarketId:                1,
+				OrderId:                 5,
+				Assets:                  s.coin("15apricot"),
+				Price:                   s.coin("33plum"),
+				AllowPartial:            true,
+				SellerSettlementFlatFee: s.coinP("6fig"),
+			},
+			expOrder: exchange.NewOrder(5).WithAsk(&exchange.AskOrder{
+				MarketId:                1,
+				Seller:                  s.addr1.String(),
+				Assets:                  s.coin("15apricot"),
+				Price:                   s.coin("33plum"),
+				SellerSettlementFlatFee: s.coinP("6fig"),
+				AllowPartial:            true,
+				ExternalId:              "ask five",
+			}),
+			expHoldCalls: HoldCalls{
+				ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr1, funds: s.coins("10apple,5fig")}},
+				AddHold:     []*AddHoldArgs{{addr: s.addr1, funds: s.coins("15apricot,6fig"), reason: reason(5)}},
+			},
+		},
+		{
+			name:  "bid order amended",
+			setup: defaultSetup,
+			msg: exchange.MsgAmendOrderRequest{
+				Owner:    s.addr2.String(),
+				MarketId: 1,
+				OrderId:  6,
+				Assets:   s.coin("8apple"),
+				Price:    s.coin("19plum"),
+			},
+			expOrder: exchange.NewOrder(6).WithBid(&exchange.BidOrder{
+				MarketId:   1,
+				Buyer:      s.addr2.String(),
+				Assets:     s.coin("8apple"),
+				Price:      s.coin("19plum"),
+				ExternalId: "bid six",
+			}),
+			expHoldCalls: HoldCalls{
+				ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr2, funds: s.coins("2fig,18plum")}},
+				AddHold:     []*AddHoldArgs{{addr: s.addr2, funds: s.coins("19plum"), reason: reason(6)}},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			var origOrder *exchange.Order
+			if tc.setup != nil {
+				tc.setup()
+				origOrder, _ = s.k.GetOrder(s.ctx, tc.msg.OrderId)
+			}
+
+			var expEvents sdk.Events
+			if tc.expOrder != nil {
+				expEvents = append(expEvents, s.untypeEvent(exchange.NewEventOrderAmended(tc.expOrder)))
+			}
+
+			if tc.holdKeeper == nil {
+				tc.holdKeeper = NewMockHoldKeeper()
+			}
+			kpr := s.k.WithHoldKeeper(tc.holdKeeper)
+
+			sink := provtestutil.EnableTelemetry(s.T())
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			var err error
+			testFunc := func() {
+				err = kpr.AmendOrder(ctx, &tc.msg)
+			}
+			s.Require().NotPanics(testFunc, "AmendOrder")
+			s.assertErrorValue(err, tc.expErr, "AmendOrder error")
+			if len(tc.expErr) > 0 {
+				expEvents = nil
+			}
+			s.assertEqualEvents(expEvents, em.Events(), "AmendOrder events")
+			s.assertHoldKeeperCalls(tc.holdKeeper, tc.expHoldCalls, "AmendOrder")
+
+			if err != nil || len(tc.expErr) > 0 {
+				return
+			}
+
+			s.assertOrderActionCount(sink, tc.expOrder, exchange.TelemetryActionAmended, 1, "AmendOrder")
+			order, err := s.k.GetOrder(s.ctx, tc.msg.OrderId)
+			if s.Assert().NoError(err, "GetOrder(%d) error after amend", tc.msg.OrderId) {
+				s.Assert().Equal(tc.expOrder, order, "GetOrder(%d) after amend", tc.msg.OrderId)
+			}
+			order, err = s.k.GetOrderByExternalID(s.ctx, tc.expOrder.GetMarketID(), tc.expOrder.GetExternalID())
+			if s.Assert().NoError(err, "GetOrderByExternalID error after amend") {
+				s.Assert().Equal(tc.expOrder, order, "GetOrderByExternalID after amend")
+			}
+
+			store := s.getStore()
+			if origOrder.GetAssets().Denom != tc.expOrder.GetAssets().Denom {
+				oldKey := keeper.MakeIndexKeyAssetToOrder(origOrder.GetAssets().Denom, tc.msg.OrderId)
+				s.Assert().False(store.Has(oldKey), "store.Has(old asset index entry) after amend")
+			}
+			newKey := keeper.MakeIndexKeyAssetToOrder(tc.expOrder.GetAssets().Denom, tc.msg.OrderId)
+			s.Assert().True(store.Has(newKey), "store.Has(new asset index entry) after amend")
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_ExpireOrders() {
+	blockTime := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	timeP := func(offset time.Duration) *time.Time {
+		rv := blockTime.Add(offset)
+		return &rv
+	}
+	askOrder := func(orderID uint64, expiration *time.Time) *exchange.Order {
+		return exchange.NewOrder(orderID).WithAsk(&exchange.AskOrder{
+			MarketId:   1,
+			Seller:     s.addr1.String(),
+			Assets:     s.coin("10apple"),
+			Price:      s.coin("20plum"),
+			ExternalId: fmt.Sprintf("ask %d", orderID),
+			Expiration: expiration,
+		})
+	}
+	bidOrder := func(orderID uint64, expiration *time.Time) *exchange.Order {
+		return exchange.NewOrder(orderID).WithBid(&exchange.BidOrder{
+			MarketId:   2,
+			Buyer:      s.addr2.String(),
+			Assets:     s.coin("30apple"),
+			Price:      s.coin("40plum"),
+			Expiration: expiration,
+		})
+	}
+
+	tests := []struct {
+		name         string
+		holdKeeper   *MockHoldKeeper
+		orders       []*exchange.Order
+		setup        func()
+		limit        int
+		expCount     int
+		expExpired   []*exchange.Order
+		expRemaining []uint64
+		expDelKeys   [][]byte
+		expHoldCalls HoldCalls
+	}{
+		{
+			name:     "no orders",
+			limit:    10,
+			expCount: 0,
+		},
+		{
+			name: "no orders with expirations",
+			orders: []*exchange.Order{
+				askOrder(1, nil),
+				bidOrder(2, nil),
+			},
+			limit:        10,
+			expCount:     0,
+			expRemaining: []uint64{1, 2},
+		},
+		{
+			name: "nothing expired yet",
+			orders: []*exchange.Order{
+				askOrder(1, timeP(time.Second)),
+				bidOrder(2, timeP(time.Hour)),
+			},
+			limit:        10,
+			expCount:     0,
+			expRemaining: []uint64{1, 2},
+		},
+		{
+			name: "some expired",
+			orders: []*exchange.Order{
+				askOrder(1, timeP(-1*time.Hour)),
+				bidOrder(2, timeP(time.Hour)),
+				askOrder(3, nil),
+				bidOrder(4, timeP(0)),
+				askOrder(5, timeP(time.Second)),
+			},
+			limit:        10,
+			expCount:     2,
+			expExpired:   []*exchange.Order{askOrder(1, timeP(-1*time.Hour)), bidOrder(4, timeP(0))},
+			expRemaining: []uint64{2, 3, 5},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{
+				{addr: s.addr1, funds: s.coins("10apple")},
+				{addr: s.addr2, funds: s.coins("40plum")},
+			}},
+		},
+		{
+			name: "more expired than the limit",
+			orders: []*exchange.Order{
+				askOrder(1, timeP(-1*time.Minute)),
+				bidOrder(2, timeP(-3*time.Minute)),
+				askOrder(3, timeP(-2*time.Minute)),
+			},
+			limit:        2,
+			expCount:     2,
+			expExpired:   []*exchange.Order{bidOrder(2, timeP(-3*time.Minute)), askOrder(3, timeP(-2*time.Minute))},
+			expRemaining: []uint64{1},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{
+				{addr: s.addr2, funds: s.coins("40plum")},
+				{addr: s.addr1, funds: s.coins("10apple")},
+			}},
+		},
+		{
+			name: "no limit",
+			orders: []*exchange.Order{
+				askOrder(1, timeP(-1*time.Minute)),
+				bidOrder(2, timeP(-3*time.Minute)),
+			},
+			limit:      0,
+			expCount:   2,
+			expExpired: []*exchange.Order{bidOrder(2, timeP(-3*time.Minute)), askOrder(1, timeP(-1*time.Minute))},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{
+				{addr: s.addr2, funds: s.coins("40plum")},
+				{addr: s.addr1, funds: s.coins("10apple")},
+			}},
+		},
+		{
+			name:       "error releasing hold",
+			holdKeeper: NewMockHoldKeeper().WithReleaseHoldResults("", "injected error for 2"),
+			orders: []*exchange.Order{
+				askOrder(1, timeP(-2*time.Minute)),
+				bidOrder(2, timeP(-1*time.Minute)),
+			},
+			limit:        10,
+			expCount:     1,
+			expExpired:   []*exchange.Order{askOrder(1, timeP(-2*time.Minute))},
+			expRemaining: []uint64{2},
+			expDelKeys:   [][]byte{keeper.MakeIndexKeyExpirationToOrder(*timeP(-1 * time.Minute), 2)},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{
+				{addr: s.addr1, funds: s.coins("10apple")},
+				{addr: s.addr2, funds: s.coins("40plum")},
+			}},
+		},
+		{
+			name: "error releasing hold does not block later expirations",
+			orders: []*exchange.Order{
+				askOrder(1, timeP(-2*time.Minute)),
+				bidOrder(2, timeP(-1*time.Minute)),
+			},
+			setup: func() {
+				// The first call can't expire order 1, but it shouldn't be looked at again.
+				s.k.WithHoldKeeper(NewMockHoldKeeper().WithReleaseHoldResults("injected error for 1")).ExpireOrders(s.ctx.WithBlockTime(blockTime), 1)
+			},
+			limit:        1,
+			expCount:     1,
+			expExpired:   []*exchange.Order{bidOrder(2, timeP(-1*time.Minute))},
+			expRemaining: []uint64{1},
+			expDelKeys:   [][]byte{keeper.MakeIndexKeyExpirationToOrder(*timeP(-2 * time.Minute), 1)},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{
+				{addr: s.addr2, funds: s.coins("40plum")},
+			}},
+		},
+		{
+			name:   "index entry for unknown order",
+			orders: []*exchange.Order{askOrder(1, timeP(-1*time.Minute))},
+			setup: func() {
+				s.getStore().Set(keeper.MakeIndexKeyExpirationToOrder(*timeP(-2 * time.Minute), 7), []byte{keeper.OrderKeyTypeAsk})
+			},
+			limit:      10,
+			expCount:   1,
+			expExpired: []*exchange.Order{askOrder(1, timeP(-1*time.Minute))},
+			expDelKeys: [][]byte{keeper.MakeIndexKeyExpirationToOrder(*timeP(-2 * time.Minute), 7)},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{
+				{addr: s.addr1, funds: s.coins("10apple")},
+			}},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			store := s.getStore()
+			s.requireSetOrdersInStore(store, tc.orders...)
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var expEvents sdk.Events
+			var expDelKVs []kv.Pair
+			for _, order := range tc.expExpired {
+				event := exchange.NewEventOrderExpired(order)
+				expEvents = append(expEvents, s.untypeEvent(event))
+
+				expDelKVs = append(expDelKVs, keeper.CreateConstantIndexEntries(*order)...)
+				extIDKV := keeper.CreateMarketExternalIDToOrderEntry(order)
+				if extIDKV != nil {
+					expDelKVs = append(expDelKVs, *extIDKV)
+				}
+			}
+
+			if tc.holdKeeper == nil {
+				tc.holdKeeper = NewMockHoldKeeper()
+			}
+			kpr := s.k.WithHoldKeeper(tc.holdKeeper)
+
+			sink := provtestutil.EnableTelemetry(s.T())
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em).WithBlockTime(blockTime)
+			var count int
+			testFunc := func() {
+				count = kpr.ExpireOrders(ctx, tc.limit)
+			}
+			s.Require().NotPanics(testFunc, "ExpireOrders(%d)", tc.limit)
+			s.Assert().Equal(tc.expCount, count, "ExpireOrders(%d) result", tc.limit)
+			actEvents := em.Events()
+			s.assertEqualEvents(expEvents, actEvents, "ExpireOrders(%d) events", tc.limit)
+			s.assertHoldKeeperCalls(tc.holdKeeper, tc.expHoldCalls, "ExpireOrders(%d)", tc.limit)
+
+			for _, expired := range tc.expExpired {
+				s.assertOrderActionCount(sink, expired, exchange.TelemetryActionExpired, 1, "ExpireOrders(%d): order %d", tc.limit, expired.OrderId)
+				order, err := s.k.GetOrder(s.ctx, expired.OrderId)
+				s.Assert().NoError(err, "GetOrder(%d) error after expire", expired.OrderId)
+				s.Assert().Nil(order, "GetOrder(%d) order after expire", expired.OrderId)
+			}
+			for i, pair := range expDelKVs {
+				has := store.Has(pair.Key)
+				s.Assert().False(has, "[%d]: store.Has(%q) (index entry) after expire", i, pair.Key)
+			}
+			for i, key := range tc.expDelKeys {
+				has := store.Has(key)
+				s.Assert().False(has, "[%d]: store.Has(%q) (bad index entry) after expire", i, key)
+			}
+			for _, orderID := range tc.expRemaining {
+				order, err := s.k.GetOrder(s.ctx, orderID)
+				s.Assert().NoError(err, "GetOrder(%d) error after expire", orderID)
+				s.Assert().NotNil(order, "GetOrder(%d) order after expire", orderID)
+			}
+
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_SetOrderExternalID() {
+	tests := []struct {
+		name          string
+		setup         func() string // should return the original externalID
+		marketID      uint32
+		orderID       uint64
+		newExternalID string
+		expErr        string
+	}{
+		{
+			name:          "new external id too long",
+			marketID:      1,
+			orderID:       1,
+			newExternalID: strings.Repeat("I", exchange.MaxExternalIDLength+1),
+			expErr: fmt.Sprintf("invalid external id %q (length %d): max length %d",
+				"IIIII...IIIII", exchange.MaxExternalIDLength+1, exchange.MaxExternalIDLength),
+		},
+		{
+			name: "error getting order",
 			setup: func() string {
 				key, value, err := s.k.GetOrderStoreKeyValue(*exchange.NewOrder(5).WithAsk(&exchange.AskOrder{
 					MarketId: 1,
@@ -2256,6 +3229,43 @@ func (s *TestSuite) TestKeeper_IterateOrders() {
 	}
 }
 
+func (s *TestSuite) TestKeeper_IndexOrderPrices() {
+	s.clearExchangeState()
+	store := s.getStore()
+	orders := s.requireSetOrdersInStore(store,
+		exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+			MarketId: 3, Seller: s.addr1.String(),
+			Assets: sdk.NewInt64Coin("apple", 10), Price: sdk.NewInt64Coin("plum", 25),
+		}),
+		exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+			MarketId: 3, Buyer: s.addr2.String(),
+			Assets: sdk.NewInt64Coin("apple", 4), Price: sdk.NewInt64Coin("plum", 8),
+		}),
+		exchange.NewOrder(3).WithAsk(&exchange.AskOrder{
+			MarketId: 5, Seller: s.addr3.String(),
+			Assets: sdk.NewInt64Coin("apple", 7), Price: sdk.NewInt64Coin("papaya", 50),
+		}),
+	)
+	// Remove the index entries to mimic orders created before the price index existed,
+	// except for the last one, which shouldn't cause any problems.
+	for _, order := range orders[:2] {
+		store.Delete(keeper.MakeIndexKeyPriceToOrder(order))
+	}
+
+	var count int
+	var err error
+	testFunc := func() {
+		count, err = s.k.IndexOrderPrices(s.ctx)
+	}
+	s.Require().NotPanics(testFunc, "IndexOrderPrices")
+	s.Assert().NoError(err, "IndexOrderPrices error")
+	s.Assert().Equal(3, count, "IndexOrderPrices count")
+	for _, order := range orders {
+		key := keeper.MakeIndexKeyPriceToOrder(order)
+		s.Assert().True(store.Has(key), "store.Has(MakeIndexKeyPriceToOrder(%d))", order.OrderId)
+	}
+}
+
 // orderIterCBArgs are the args provided to an order index iterator.
 type orderIterCBArgs struct {
 	orderID       uint64
@@ -3237,3 +4247,190 @@ func (s *TestSuite) TestKeeper_CancelAllOrdersForMarket() {
 		})
 	}
 }
+
+func (s *TestSuite) TestKeeper_MarketBulkCancel() {
+	market3 := exchange.Market{
+		MarketId: 3,
+		AccessGrants: []exchange.AccessGrant{
+			{Address: s.addr1.String(), Permissions: []exchange.Permission{exchange.Permission_cancel}},
+		},
+	}
+	newOrder := func(marketID uint32, orderID uint64, isAsk bool, owner sdk.AccAddress, assetDenom string) *exchange.Order {
+		assets := sdk.Coin{Denom: assetDenom, Amount: sdkmath.NewInt(500 + int64(orderID))}
+		price := sdk.Coin{Denom: "prune", Amount: sdkmath.NewInt(1000 + int64(orderID))}
+		if isAsk {
+			return exchange.NewOrder(orderID).WithAsk(&exchange.AskOrder{
+				MarketId: marketID, Seller: owner.String(), Assets: assets, Price: price,
+			})
+		}
+		return exchange.NewOrder(orderID).WithBid(&exchange.BidOrder{
+			MarketId: marketID, Buyer: owner.String(), Assets: assets, Price: price,
+		})
+	}
+	// Orders 1-6 are in market 3, 7 and 8 are in market 1.
+	setupOrders := func() {
+		s.requireCreateMarket(exchange.Market{MarketId: 1})
+		s.requireCreateMarket(market3)
+		s.requireSetOrdersInStore(s.getStore(),
+			newOrder(3, 1, true, s.addr2, "apple"),
+			newOrder(3, 2, false, s.addr3, "apple"),
+			newOrder(3, 3, true, s.addr3, "banana"),
+			newOrder(3, 4, false, s.addr2, "apple"),
+			newOrder(3, 5, true, s.addr2, "apple"),
+			newOrder(3, 6, false, s.addr4, "banana"),
+			newOrder(1, 7, true, s.addr2, "apple"),
+			newOrder(1, 8, false, s.addr3, "apple"),
+		)
+	}
+
+	tests := []struct {
+		name       string
+		setup      func()
+		holdKeeper *MockHoldKeeper
+		msg        exchange.MsgMarketBulkCancelRequest
+		expIDs     []uint64
+		expHasMore bool
+		expErr     string
+		expNoEvent bool
+	}{
+		{
+			name:       "unknown order type",
+			setup:      setupOrders,
+			msg:        exchange.MsgMarketBulkCancelRequest{Admin: s.addr1.String(), MarketId: 3, OrderType: "other"},
+			expErr:     "unknown order type \"other\"",
+			expNoEvent: true,
+		},
+		{
+			name:   "no orders in market",
+			setup:  setupOrders,
+			msg:    exchange.MsgMarketBulkCancelRequest{Admin: s.addr1.String(), MarketId: 2},
+			expIDs: []uint64{},
+		},
+		{
+			name:   "no filters: default limit",
+			setup:  setupOrders,
+			msg:    exchange.MsgMarketBulkCancelRequest{Admin: s.addr1.String(), MarketId: 3},
+			expIDs: []uint64{1, 2, 3, 4, 5, 6},
+		},
+		{
+			name:       "no filters: limit 4",
+			setup:      setupOrders,
+			msg:        exchange.MsgMarketBulkCancelRequest{Admin: s.addr1.String(), MarketId: 3, Limit: 4},
+			expIDs:     []uint64{1, 2, 3, 4},
+			expHasMore: true,
+		},
+		{
+			name:   "no filters: limit equals order count",
+			setup:  setupOrders,
+			msg:    exchange.MsgMarketBulkCancelRequest{Admin: s.addr1.String(), MarketId: 3, Limit: 6},
+			expIDs: []uint64{1, 2, 3, 4, 5, 6},
+		},
+		{
+			name:   "asks only",
+			setup:  setupOrders,
+			msg:    exchange.MsgMarketBulkCancelRequest{Admin: s.addr1.String(), MarketId: 3, OrderType: "asks"},
+			expIDs: []uint64{1, 3, 5},
+		},
+		{
+			name:       "bids only: limit 2",
+			setup:      setupOrders,
+			msg:        exchange.MsgMarketBulkCancelRequest{Admin: s.addr1.String(), MarketId: 3, OrderType: "bid", Limit: 2},
+			expIDs:     []uint64{2, 4},
+			expHasMore: true,
+		},
+		{
+			name:   "by owner",
+			setup:  setupOrders,
+			msg:    exchange.MsgMarketBulkCancelRequest{Admin: s.addr1.String(), MarketId: 3, Owner: s.addr2.String()},
+			expIDs: []uint64{1, 4, 5},
+		},
+		{
+			name:   "by asset denom",
+			setup:  setupOrders,
+			msg:    exchange.MsgMarketBulkCancelRequest{Admin: s.addr1.String(), MarketId: 3, AssetDenom: "banana"},
+			expIDs: []uint64{3, 6},
+		},
+		{
+			name:  "all filters",
+			setup: setupOrders,
+			msg: exchange.MsgMarketBulkCancelRequest{
+				Admin: s.k.GetAuthority(), MarketId: 3, OrderType: "ask", Owner: s.addr2.String(), AssetDenom: "apple",
+			},
+			expIDs: []uint64{1, 5},
+		},
+		{
+			name:       "nothing matches",
+			setup:      setupOrders,
+			msg:        exchange.MsgMarketBulkCancelRequest{Admin: s.addr1.String(), MarketId: 3, AssetDenom: "cherry"},
+			expIDs:     []uint64{},
+			expHasMore: false,
+		},
+		{
+			name:       "error releasing hold",
+			setup:      setupOrders,
+			holdKeeper: NewMockHoldKeeper().WithReleaseHoldResults("", "injected error for 2"),
+			msg:        exchange.MsgMarketBulkCancelRequest{Admin: s.addr1.String(), MarketId: 3},
+			expErr:     "could not cancel order 2: unable to release hold on order 2 funds: injected error for 2",
+			expNoEvent: true,
+		},
+		{
+			name:       "admin does not have permission",
+			setup:      setupOrders,
+			msg:        exchange.MsgMarketBulkCancelRequest{Admin: s.addr5.String(), MarketId: 3},
+			expErr:     "could not cancel order 1: account " + s.addr5.String() + " does not have permission to cancel order 1",
+			expNoEvent: true,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var expOrdersCancelled []*exchange.Order
+			for _, orderID := range tc.expIDs {
+				order, err := s.k.GetOrder(s.ctx, orderID)
+				s.Require().NoError(err, "GetOrder(%d)", orderID)
+				s.Require().NotNil(order, "GetOrder(%d)", orderID)
+				expOrdersCancelled = append(expOrdersCancelled, order)
+			}
+			var expEvents sdk.Events
+			if !tc.expNoEvent {
+				for _, order := range expOrdersCancelled {
+					expEvents = append(expEvents, s.untypeEvent(exchange.NewEventOrderCancelled(order, tc.msg.Admin)))
+				}
+				expEvents = append(expEvents, s.untypeEvent(exchange.NewEventMarketOrdersBulkCancelled(
+					tc.msg.MarketId, tc.msg.Admin, uint32(len(tc.expIDs)), tc.expHasMore)))
+			}
+
+			if tc.holdKeeper == nil {
+				tc.holdKeeper = NewMockHoldKeeper()
+			}
+			kpr := s.k.WithHoldKeeper(tc.holdKeeper)
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			var ids []uint64
+			var hasMore bool
+			var err error
+			testFunc := func() {
+				ids, hasMore, err = kpr.MarketBulkCancel(ctx, &tc.msg)
+			}
+			s.Require().NotPanics(testFunc, "MarketBulkCancel")
+			s.assertErrorValue(err, tc.expErr, "MarketBulkCancel error")
+			if len(tc.expErr) > 0 {
+				return
+			}
+			s.Assert().Equal(tc.expIDs, ids, "MarketBulkCancel cancelled order ids")
+			s.Assert().Equal(tc.expHasMore, hasMore, "MarketBulkCancel has more")
+			s.assertEqualEvents(expEvents, em.Events(), "events emitted during MarketBulkCancel")
+
+			for _, orderID := range tc.expIDs {
+				order, oerr := s.k.GetOrder(s.ctx, orderID)
+				s.Assert().NoError(oerr, "GetOrder(%d) after MarketBulkCancel", orderID)
+				s.Assert().Nil(order, "GetOrder(%d) after MarketBulkCancel", orderID)
+			}
+		})
+	}
+}
@@ -112,6 +112,54 @@ func getParamsFeeAcceptPaymentFlat(store storetypes.KVStore) []sdk.Coin {
 	return getParamsPaymentFlatFee(store, MakeKeyParamsFeeAcceptPaymentFlat())
 }
 
+// setParamsSettlementHistoryBlocks sets the params entry for the number of blocks to keep settlement records.
+func setParamsSettlementHistoryBlocks(store storetypes.KVStore, blocks uint64) {
+	key := MakeKeyParamsSettlementHistoryBlocks()
+	if blocks == 0 {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, uint64Bz(blocks))
+}
+
+// getParamsSettlementHistoryBlocks gets the params entry for the number of blocks to keep settlement records.
+func getParamsSettlementHistoryBlocks(store storetypes.KVStore) uint64 {
+	rv, _ := uint64FromBz(store.Get(MakeKeyParamsSettlementHistoryBlocks()))
+	return rv
+}
+
+// setParamsNAVHistoryBlocks sets the params entry for the number of blocks to keep NAV records.
+func setParamsNAVHistoryBlocks(store storetypes.KVStore, blocks uint64) {
+	key := MakeKeyParamsNAVHistoryBlocks()
+	if blocks == 0 {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, uint64Bz(blocks))
+}
+
+// getParamsNAVHistoryBlocks gets the params entry for the number of blocks to keep NAV records.
+func getParamsNAVHistoryBlocks(store storetypes.KVStore) uint64 {
+	rv, _ := uint64FromBz(store.Get(MakeKeyParamsNAVHistoryBlocks()))
+	return rv
+}
+
+// setParamsNAVHistoryMaxRecords sets the params entry for the max number of NAV records to keep for a denom pair.
+func setParamsNAVHistoryMaxRecords(store storetypes.KVStore, maxRecords uint32) {
+	key := MakeKeyParamsNAVHistoryMaxRecords()
+	if maxRecords == 0 {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, uint32Bz(maxRecords))
+}
+
+// getParamsNAVHistoryMaxRecords gets the params entry for the max number of NAV records to keep for a denom pair.
+func getParamsNAVHistoryMaxRecords(store storetypes.KVStore) uint32 {
+	rv, _ := uint32FromBz(store.Get(MakeKeyParamsNAVHistoryMaxRecords()))
+	return rv
+}
+
 // SetParams updates the params to match those provided.
 // If nil is provided, all params are deleted.
 func (k Keeper) SetParams(ctx sdk.Context, params *exchange.Params) {
@@ -119,6 +167,8 @@ func (k Keeper) SetParams(ctx sdk.Context, params *exchange.Params) {
 
 	deleteAllParamsSplits(store)
 	var feeCreate, feeAccept []sdk.Coin
+	var historyBlocks, navBlocks uint64
+	var navMaxRecords uint32
 	if params != nil {
 		setParamsSplit(store, "", uint16(params.DefaultSplit)) //nolint:gosec // G115: Validated elsewhere to be 10,000 max.
 		for _, split := range params.DenomSplits {
@@ -126,10 +176,16 @@ func (k Keeper) SetParams(ctx sdk.Context, params *exchange.Params) {
 		}
 		feeCreate = params.FeeCreatePaymentFlat
 		feeAccept = params.FeeAcceptPaymentFlat
+		historyBlocks = params.SettlementHistoryBlocks
+		navBlocks = params.NavHistoryBlocks
+		navMaxRecords = params.NavHistoryMaxRecords
 	}
 
 	setParamsFeeCreatePaymentFlat(store, feeCreate)
 	setParamsFeeAcceptPaymentFlat(store, feeAccept)
+	setParamsSettlementHistoryBlocks(store, historyBlocks)
+	setParamsNAVHistoryBlocks(store, navBlocks)
+	setParamsNAVHistoryMaxRecords(store, navMaxRecords)
 }
 
 // GetParams gets the exchange module params.
@@ -159,6 +215,27 @@ func (k Keeper) GetParams(ctx sdk.Context) *exchange.Params {
 		rv.FeeAcceptPaymentFlat = opts
 	}
 
+	if blocks := getParamsSettlementHistoryBlocks(store); blocks > 0 {
+		if rv == nil {
+			rv = &exchange.Params{}
+		}
+		rv.SettlementHistoryBlocks = blocks
+	}
+
+	if blocks := getParamsNAVHistoryBlocks(store); blocks > 0 {
+		if rv == nil {
+			rv = &exchange.Params{}
+		}
+		rv.NavHistoryBlocks = blocks
+	}
+
+	if maxRecords := getParamsNAVHistoryMaxRecords(store); maxRecords > 0 {
+		if rv == nil {
+			rv = &exchange.Params{}
+		}
+		rv.NavHistoryMaxRecords = maxRecords
+	}
+
 	return rv
 }
 
@@ -202,3 +279,13 @@ func (k Keeper) GetExchangeSplit(ctx sdk.Context, denom string) uint16 {
 	// Lastly, use the default from the defaults.
 	return uint16(defaults.DefaultSplit) //nolint:gosec // G115: Validated elsewhere to be 10,000 max.
 }
+
+// GetMarketExchangeSplit gets the split amount for the provided denom in the provided market.
+// If the market has its own split for the denom, that is returned.
+// Otherwise, the result of GetExchangeSplit is returned.
+func (k Keeper) GetMarketExchangeSplit(ctx sdk.Context, marketID uint32, denom string) uint16 {
+	if split, found := getExchangeSplit(k.getStore(ctx), marketID, denom); found {
+		return split
+	}
+	return k.GetExchangeSplit(ctx, denom)
+}
@@ -21,6 +21,18 @@ func (s *TestSuite) TestKeeper_SetParams() {
 		keyBz := keeper.MakeKeyParamsFeeCreatePaymentFlat()
 		return s.stateEntryString(keyBz, []byte(value))
 	}
+	expHistoryEntry := func(value uint64) string {
+		keyBz := keeper.MakeKeyParamsSettlementHistoryBlocks()
+		return s.stateEntryString(keyBz, keeper.Uint64Bz(value))
+	}
+	expNAVBlocksEntry := func(value uint64) string {
+		keyBz := keeper.MakeKeyParamsNAVHistoryBlocks()
+		return s.stateEntryString(keyBz, keeper.Uint64Bz(value))
+	}
+	expNAVMaxEntry := func(value uint32) string {
+		keyBz := keeper.MakeKeyParamsNAVHistoryMaxRecords()
+		return s.stateEntryString(keyBz, keeper.Uint32Bz(value))
+	}
 
 	tests := []struct {
 		name     string
@@ -38,6 +50,9 @@ func (s *TestSuite) TestKeeper_SetParams() {
 			expState: []string{
 				expAcceptEntry("8000000000nhash"),
 				expCreateEntry("10000000000nhash"),
+				expNAVBlocksEntry(exchange.DefaultNAVHistoryBlocks),
+				expNAVMaxEntry(exchange.DefaultNAVHistoryMaxRecords),
+				expHistoryEntry(exchange.DefaultSettlementHistoryBlocks),
 				expEntry("", uint16(exchange.DefaultDefaultSplit)),
 			},
 		},
@@ -107,6 +122,23 @@ func (s *TestSuite) TestKeeper_SetParams() {
 				expEntry("", 0),
 			},
 		},
+		{
+			name:   "just settlement history blocks",
+			params: &exchange.Params{SettlementHistoryBlocks: 12_345},
+			expState: []string{
+				expHistoryEntry(12_345),
+				expEntry("", 0),
+			},
+		},
+		{
+			name:   "just nav history",
+			params: &exchange.Params{NavHistoryBlocks: 54_321, NavHistoryMaxRecords: 77},
+			expState: []string{
+				expNAVBlocksEntry(54_321),
+				expNAVMaxEntry(77),
+				expEntry("", 0),
+			},
+		},
 		{
 			name: "one split",
 			params: &exchange.Params{
@@ -151,6 +183,9 @@ func (s *TestSuite) TestKeeper_GetParams() {
 		splits            []exchange.DenomSplit
 		createPaymentFlat []sdk.Coin
 		acceptPaymentFlat []sdk.Coin
+		historyBlocks     uint64
+		navBlocks         uint64
+		navMaxRecords     uint32
 		exp               *exchange.Params
 	}{
 		{
@@ -177,6 +212,21 @@ func (s *TestSuite) TestKeeper_GetParams() {
 			acceptPaymentFlat: coins("57apple"),
 			exp:               &exchange.Params{FeeAcceptPaymentFlat: coins("57apple")},
 		},
+		{
+			name:          "just settlement history blocks",
+			historyBlocks: 800,
+			exp:           &exchange.Params{SettlementHistoryBlocks: 800},
+		},
+		{
+			name:      "just nav history blocks",
+			navBlocks: 900,
+			exp:       &exchange.Params{NavHistoryBlocks: 900},
+		},
+		{
+			name:          "just nav history max records",
+			navMaxRecords: 15,
+			exp:           &exchange.Params{NavHistoryMaxRecords: 15},
+		},
 		{
 			name: "a little of everything",
 			splits: []exchange.DenomSplit{
@@ -187,6 +237,9 @@ func (s *TestSuite) TestKeeper_GetParams() {
 			},
 			createPaymentFlat: coins("72cactus"),
 			acceptPaymentFlat: coins("21apricot"),
+			historyBlocks:     5_000,
+			navBlocks:         6_000,
+			navMaxRecords:     250,
 			exp: &exchange.Params{
 				DefaultSplit: 432,
 				DenomSplits: []exchange.DenomSplit{
@@ -196,6 +249,10 @@ func (s *TestSuite) TestKeeper_GetParams() {
 				},
 				FeeCreatePaymentFlat: coins("72cactus"),
 				FeeAcceptPaymentFlat: coins("21apricot"),
+
+				SettlementHistoryBlocks: 5_000,
+				NavHistoryBlocks:        6_000,
+				NavHistoryMaxRecords:    250,
 			},
 		},
 	}
@@ -209,6 +266,9 @@ func (s *TestSuite) TestKeeper_GetParams() {
 			}
 			keeper.SetParamsFeeCreatePaymentFlat(store, tc.createPaymentFlat)
 			keeper.SetParamsFeeAcceptPaymentFlat(store, tc.acceptPaymentFlat)
+			keeper.SetParamsSettlementHistoryBlocks(store, tc.historyBlocks)
+			keeper.SetParamsNAVHistoryBlocks(store, tc.navBlocks)
+			keeper.SetParamsNAVHistoryMaxRecords(store, tc.navMaxRecords)
 
 			var actual *exchange.Params
 			testFunc := func() {
@@ -281,6 +341,14 @@ func (s *TestSuite) TestKeeper_GetParamsOrDefaults() {
 				FeeAcceptPaymentFlat: coins("73apple"),
 			},
 		},
+		{
+			name:   "just settlement history blocks",
+			params: &exchange.Params{SettlementHistoryBlocks: 99},
+		},
+		{
+			name:   "just nav history",
+			params: &exchange.Params{NavHistoryBlocks: 98, NavHistoryMaxRecords: 3},
+		},
 		{
 			name: "a little bit of everything",
 			params: &exchange.Params{
@@ -291,6 +359,10 @@ func (s *TestSuite) TestKeeper_GetParamsOrDefaults() {
 				},
 				FeeCreatePaymentFlat: coins("91cactus"),
 				FeeAcceptPaymentFlat: coins("5acai"),
+
+				SettlementHistoryBlocks: 1_000,
+				NavHistoryBlocks:        2_000,
+				NavHistoryMaxRecords:    30,
 			},
 		},
 	}
@@ -421,3 +493,48 @@ func (s *TestSuite) TestKeeper_GetExchangeSplit() {
 		})
 	}
 }
+
+func (s *TestSuite) TestKeeper_GetMarketExchangeSplit() {
+	params := &exchange.Params{
+		DefaultSplit: 200,
+		DenomSplits: []exchange.DenomSplit{
+			{Denom: "chickens", Split: 300},
+			{Denom: "cows", Split: 400},
+		},
+	}
+	market2Splits := []exchange.DenomSplit{
+		{Denom: "cows", Split: 40},
+		{Denom: "pigs", Split: 0},
+	}
+
+	tests := []struct {
+		name     string
+		marketID uint32
+		denom    string
+		exp      uint16
+	}{
+		{name: "market without splits, params denom split", marketID: 1, denom: "cows", exp: 400},
+		{name: "market without splits, params default split", marketID: 1, denom: "pigs", exp: 200},
+		{name: "market with splits, overridden denom", marketID: 2, denom: "cows", exp: 40},
+		{name: "market with splits, overridden denom to zero", marketID: 2, denom: "pigs", exp: 0},
+		{name: "market with splits, params denom split", marketID: 2, denom: "chickens", exp: 300},
+		{name: "market with splits, params default split", marketID: 2, denom: "emus", exp: 200},
+		{name: "unknown market", marketID: 3, denom: "cows", exp: 400},
+	}
+
+	s.clearExchangeState()
+	s.k.SetParams(s.ctx, params)
+	keeper.StoreMarket(s.getStore(), exchange.Market{MarketId: 1})
+	keeper.StoreMarket(s.getStore(), exchange.Market{MarketId: 2, ExchangeSplits: market2Splits})
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			var actual uint16
+			testFunc := func() {
+				actual = s.k.GetMarketExchangeSplit(s.ctx, tc.marketID, tc.denom)
+			}
+			s.Require().NotPanics(testFunc, "GetMarketExchangeSplit(%d, %q)", tc.marketID, tc.denom)
+			s.Assert().Equal(tc.exp, actual, "GetMarketExchangeSplit(%d, %q) result", tc.marketID, tc.denom)
+		})
+	}
+}
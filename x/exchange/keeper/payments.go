@@ -1,8 +1,10 @@
 package keeper
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"time"
 
 	storetypes "cosmossdk.io/store/types"
 
@@ -76,6 +78,50 @@ func (k Keeper) requirePaymentFromStore(store storetypes.KVStore, source sdk.Acc
 	return payment, nil
 }
 
+// makePaymentFilterIndexKeys creates the keys of all the account denom to payment and
+// account target amount to payment index entries that the provided payment should have.
+func makePaymentFilterIndexKeys(payment *exchange.Payment) [][]byte {
+	source, err := sdk.AccAddressFromBech32(payment.Source)
+	if err != nil || len(source) == 0 {
+		return nil
+	}
+	accounts := []sdk.AccAddress{source}
+	roles := []byte{PaymentRoleSource}
+	if len(payment.Target) > 0 {
+		target, tErr := sdk.AccAddressFromBech32(payment.Target)
+		if tErr == nil && len(target) > 0 {
+			accounts = append(accounts, target)
+			roles = append(roles, PaymentRoleTarget)
+		}
+	}
+
+	hasTargetAmount := !payment.TargetAmount.IsZero()
+	rv := make([][]byte, 0, len(accounts)*(1+len(payment.SourceAmount)))
+	for i, account := range accounts {
+		rv = append(rv, MakeIndexKeyAccountTargetAmountToPayment(account, roles[i], hasTargetAmount, source, payment.ExternalId))
+		for _, coin := range payment.SourceAmount {
+			rv = append(rv, MakeIndexKeyAccountDenomToPayment(account, roles[i], coin.Denom, coin.Amount, source, payment.ExternalId))
+		}
+	}
+	return rv
+}
+
+// updateIndexEntries deletes the old index keys that are not in newKeys and sets all the new ones.
+func updateIndexEntries(store storetypes.KVStore, oldKeys, newKeys [][]byte) {
+	isNew := make(map[string]bool, len(newKeys))
+	for _, key := range newKeys {
+		isNew[string(key)] = true
+	}
+	for _, key := range oldKeys {
+		if !isNew[string(key)] {
+			store.Delete(key)
+		}
+	}
+	for _, key := range newKeys {
+		store.Set(key, []byte{})
+	}
+}
+
 // setPaymentInStore sets a payment in the store making sure the index entry stays up to date.
 func (k Keeper) setPaymentInStore(store storetypes.KVStore, payment *exchange.Payment) error {
 	source, err := sdk.AccAddressFromBech32(payment.Source)
@@ -98,8 +144,23 @@ func (k Keeper) setPaymentInStore(store storetypes.KVStore, payment *exchange.Pa
 		iKey = MakeIndexKeyTargetToPayment(target, source, payment.ExternalId)
 	}
 
-	var oldIKey []byte
+	var expKey []byte
+	if payment.Expiration != nil {
+		expKey = MakeIndexKeyExpirationToPayment(*payment.Expiration, source, payment.ExternalId)
+	}
+
+	var oldIKey, oldExpKey []byte
+	var oldFilterKeys [][]byte
 	if existing, _ := k.getPaymentFromStore(store, source, payment.ExternalId); existing != nil {
+		oldFilterKeys = makePaymentFilterIndexKeys(existing)
+		if existing.Expiration != nil {
+			oldExpKey = MakeIndexKeyExpirationToPayment(*existing.Expiration, source, payment.ExternalId)
+			if bytes.Equal(oldExpKey, expKey) {
+				// Same expiration index entry, so there's nothing to delete and nothing new to write.
+				oldExpKey, expKey = nil, nil
+			}
+		}
+
 		switch existing.Target {
 		case "":
 			// There isn't an entry yet, so there's nothing to delete.
@@ -123,6 +184,13 @@ func (k Keeper) setPaymentInStore(store storetypes.KVStore, payment *exchange.Pa
 	if len(iKey) > 0 {
 		store.Set(iKey, []byte{})
 	}
+	if len(oldExpKey) > 0 {
+		store.Delete(oldExpKey)
+	}
+	if len(expKey) > 0 {
+		store.Set(expKey, []byte{})
+	}
+	updateIndexEntries(store, oldFilterKeys, makePaymentFilterIndexKeys(payment))
 
 	return nil
 }
@@ -133,10 +201,17 @@ func (k Keeper) createPaymentInStore(store storetypes.KVStore, payment *exchange
 		return fmt.Errorf("a payment already exists with source %s and external id %q",
 			payment.Source, payment.ExternalId)
 	}
+	if escrowedPaymentExists(store, payment.Source, payment.ExternalId) {
+		return fmt.Errorf("an escrowed payment already exists with source %s and external id %q",
+			payment.Source, payment.ExternalId)
+	}
+	if err := validateExternalIDNotUsedByAccountScopedOrder(store, payment.Source, payment.ExternalId); err != nil {
+		return err
+	}
 	return k.setPaymentInStore(store, payment)
 }
 
-// deletePaymentFromStore deletes a payment (and its index) from the state store.
+// deletePaymentFromStore deletes a payment (and its index entries) from the state store.
 func deletePaymentFromStore(store storetypes.KVStore, payment *exchange.Payment) error {
 	if payment == nil {
 		return errors.New("cannot delete nil payment")
@@ -162,6 +237,12 @@ func deletePaymentFromStore(store storetypes.KVStore, payment *exchange.Payment)
 	if len(iKey) > 0 {
 		store.Delete(iKey)
 	}
+	if payment.Expiration != nil {
+		store.Delete(MakeIndexKeyExpirationToPayment(*payment.Expiration, source, payment.ExternalId))
+	}
+	for _, key := range makePaymentFilterIndexKeys(payment) {
+		store.Delete(key)
+	}
 
 	return nil
 }
@@ -209,6 +290,9 @@ func (k Keeper) CreatePayment(ctx sdk.Context, payment *exchange.Payment) error
 	if err := payment.Validate(); err != nil {
 		return fmt.Errorf("cannot create invalid payment: %w", err)
 	}
+	if err := validateOrderExpiration(ctx, payment.Expiration); err != nil {
+		return fmt.Errorf("cannot create payment: %w", err)
+	}
 
 	err := k.createPaymentInStore(k.getStore(ctx), payment)
 	if err != nil {
@@ -222,11 +306,13 @@ func (k Keeper) CreatePayment(ctx sdk.Context, payment *exchange.Payment) error
 	}
 
 	k.emitEvent(ctx, exchange.NewEventPaymentCreated(payment))
+	incPaymentActionCounter(1, exchange.TelemetryActionCreated)
 	return nil
 }
 
 // AcceptPayment verifies that all the payment data matches what's in state, then deletes the payment and
 // sends the source funds to the target and target funds to the source.
+// If the payment has a dispute window, the funds are instead sent to escrow until that window ends.
 func (k Keeper) AcceptPayment(ctx sdk.Context, payment *exchange.Payment) error {
 	if payment == nil {
 		return errors.New("cannot accept nil payment")
@@ -266,6 +352,10 @@ func (k Keeper) AcceptPayment(ctx sdk.Context, payment *exchange.Payment) error
 		return fmt.Errorf("provided external id %q does not equal existing external id %q",
 			payment.ExternalId, existing.ExternalId)
 	}
+	if isPaymentExpired(ctx, existing) {
+		return fmt.Errorf("payment with source %s and external id %q expired at %s",
+			existing.Source, existing.ExternalId, existing.Expiration.UTC().Format(time.RFC3339))
+	}
 
 	err = k.deletePaymentAndReleaseHold(ctx, store, existing)
 	if err != nil {
@@ -273,6 +363,18 @@ func (k Keeper) AcceptPayment(ctx sdk.Context, payment *exchange.Payment) error
 	}
 
 	ctx = quarantine.WithBypass(ctx)
+	if existing.DisputeWindowSeconds > 0 {
+		var ep *exchange.EscrowedPayment
+		ep, err = k.escrowPayment(ctx, existing)
+		if err != nil {
+			return err
+		}
+		k.emitEvent(ctx, exchange.NewEventPaymentAccepted(payment))
+		k.emitEvent(ctx, exchange.NewEventPaymentEscrowed(ep))
+		incPaymentActionCounter(1, exchange.TelemetryActionAccepted)
+		return nil
+	}
+
 	if !existing.SourceAmount.IsZero() {
 		err = k.bankKeeper.SendCoins(ctx, source, target, existing.SourceAmount)
 		if err != nil {
@@ -289,9 +391,114 @@ func (k Keeper) AcceptPayment(ctx sdk.Context, payment *exchange.Payment) error
 	}
 
 	k.emitEvent(ctx, exchange.NewEventPaymentAccepted(payment))
+	incPaymentActionCounter(1, exchange.TelemetryActionAccepted)
 	return nil
 }
 
+// AcceptPayments accepts several payments for a target. The payments to accept are identified by id, and by
+// source (i.e. all payments to the target from each of the sources). At most MaxAcceptPaymentsPerMsg payments
+// are processed. A payment is not accepted if it requires the target to send more than the maxTargetAmount.
+// Each payment is accepted on its own, so one that cannot be accepted does not stop the others.
+// A result is returned for each payment that was processed, along with the total amount sent by the target.
+func (k Keeper) AcceptPayments(ctx sdk.Context, target sdk.AccAddress, paymentIDs []exchange.PaymentID,
+	sources []sdk.AccAddress, maxTargetAmount sdk.Coins,
+) ([]exchange.AcceptPaymentResult, sdk.Coins, error) {
+	if len(target) == 0 {
+		return nil, nil, errors.New("a target is required in order to accept payments")
+	}
+	if len(paymentIDs) == 0 && len(sources) == 0 {
+		return nil, nil, errors.New("at least one payment id or source is required")
+	}
+
+	store := k.getStore(ctx)
+	var results []exchange.AcceptPaymentResult
+	var totalSent sdk.Coins
+	seen := make(map[exchange.PaymentID]bool)
+	for _, paymentID := range paymentIDs {
+		if len(results) >= exchange.MaxAcceptPaymentsPerMsg {
+			return results, totalSent, nil
+		}
+		if seen[paymentID] {
+			continue
+		}
+		seen[paymentID] = true
+
+		result := exchange.AcceptPaymentResult{Source: paymentID.Source, ExternalId: paymentID.ExternalId}
+		source, err := sdk.AccAddressFromBech32(paymentID.Source)
+		if err == nil {
+			var payment *exchange.Payment
+			payment, err = k.requirePaymentFromStore(store, source, paymentID.ExternalId)
+			if err == nil {
+				result = k.acceptPaymentForBatch(ctx, target, payment, maxTargetAmount)
+				if result.Accepted {
+					totalSent = totalSent.Add(payment.TargetAmount...)
+				}
+			}
+		}
+		if err != nil {
+			result.Error = err.Error()
+		}
+		results = append(results, result)
+	}
+
+	seenSources := make(map[string]bool)
+	for _, source := range sources {
+		if seenSources[string(source)] {
+			continue
+		}
+		seenSources[string(source)] = true
+
+		for _, payment := range k.getPaymentsForTargetAndSourceFromStore(store, target, source) {
+			if len(results) >= exchange.MaxAcceptPaymentsPerMsg {
+				return results, totalSent, nil
+			}
+			paymentID := exchange.PaymentID{Source: payment.Source, ExternalId: payment.ExternalId}
+			if seen[paymentID] {
+				continue
+			}
+			seen[paymentID] = true
+			result := k.acceptPaymentForBatch(ctx, target, payment, maxTargetAmount)
+			if result.Accepted {
+				totalSent = totalSent.Add(payment.TargetAmount...)
+			}
+			results = append(results, result)
+		}
+	}
+
+	return results, totalSent, nil
+}
+
+// acceptPaymentForBatch accepts a payment for the target as part of AcceptPayments.
+// Nothing is changed if the payment cannot be accepted, in which case the result has the error.
+func (k Keeper) acceptPaymentForBatch(ctx sdk.Context, target sdk.AccAddress, payment *exchange.Payment, maxTargetAmount sdk.Coins) exchange.AcceptPaymentResult {
+	rv := exchange.AcceptPaymentResult{Source: payment.Source, ExternalId: payment.ExternalId}
+	err := func() error {
+		if len(payment.Target) == 0 {
+			return errors.New("cannot accept a payment that does not have a target")
+		}
+		if payment.Target != target.String() {
+			return fmt.Errorf("target %s cannot accept payment with target %s", target, payment.Target)
+		}
+		if !payment.TargetAmount.IsZero() && !payment.TargetAmount.IsAllLTE(maxTargetAmount) {
+			return fmt.Errorf("target amount %q is more than the max target amount %q", payment.TargetAmount, maxTargetAmount)
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.AcceptPayment(cacheCtx, payment); err != nil {
+			return err
+		}
+		writeCache()
+		return nil
+	}()
+
+	if err != nil {
+		rv.Error = err.Error()
+	} else {
+		rv.Accepted = true
+	}
+	return rv
+}
+
 // RejectPayment deletes a payment and releases the hold on it.
 // An error is returned if a payment can't be found for the source + external id,
 // or if that payment has a different target than the one provided.
@@ -322,6 +529,7 @@ func (k Keeper) RejectPayment(ctx sdk.Context, target, source sdk.AccAddress, ex
 	}
 
 	k.emitEvent(ctx, exchange.NewEventPaymentRejected(payment))
+	incPaymentActionCounter(1, exchange.TelemetryActionRejected)
 	return nil
 }
 
@@ -356,6 +564,7 @@ func (k Keeper) RejectPayments(ctx sdk.Context, target sdk.AccAddress, sources [
 	}
 
 	emitEvents(k, ctx, exchange.NewEventsPaymentsRejected(payments))
+	incPaymentActionCounter(len(payments), exchange.TelemetryActionRejected)
 	return nil
 }
 
@@ -390,6 +599,7 @@ func (k Keeper) CancelPayments(ctx sdk.Context, source sdk.AccAddress, externalI
 	}
 
 	emitEvents(k, ctx, exchange.NewEventsPaymentsCancelled(payments))
+	incPaymentActionCounter(len(payments), exchange.TelemetryActionCancelled)
 	return nil
 }
 
@@ -422,6 +632,69 @@ func (k Keeper) UpdatePaymentTarget(ctx sdk.Context, source sdk.AccAddress, exte
 	return nil
 }
 
+// isPaymentExpired returns true if the payment has an expiration at or before the block time.
+// Expired payments are cancelled at the end of a block, but there might be more than can be handled in one block.
+func isPaymentExpired(ctx sdk.Context, payment *exchange.Payment) bool {
+	return payment.Expiration != nil && payment.Expiration.Unix() <= ctx.BlockTime().Unix()
+}
+
+// ExpirePayments cancels all payments with an expiration at or before the block time, releasing their holds.
+// At most limit payments are cancelled (0 = no limit); any others are picked up by a later call.
+// Returns the number of payments that were cancelled.
+func (k Keeper) ExpirePayments(ctx sdk.Context, limit int) int {
+	store := k.getStore(ctx)
+	start := GetIndexKeyPrefixExpirationToPayment()
+	end := storetypes.PrefixEndBytes(GetIndexKeyPrefixExpirationToPaymentAt(ctx.BlockTime()))
+
+	// Gather the keys first so we aren't writing to the store while iterating it.
+	var keys [][]byte
+	iter := store.Iterator(start, end)
+	for ; iter.Valid() && (limit == 0 || len(keys) < limit); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	count := 0
+	for _, key := range keys {
+		_, source, externalID, err := ParseIndexKeyExpirationToPayment(key)
+		if err != nil {
+			k.logErrorf(ctx, "invalid payment expiration index entry %v (deleted): %v", key, err)
+			store.Delete(key)
+			continue
+		}
+
+		payment, err := k.getPaymentFromStore(store, source, externalID)
+		if err != nil || payment == nil {
+			k.logErrorf(ctx, "could not get expired payment with source %s and external id %q (index entry deleted): %v",
+				source, externalID, err)
+			store.Delete(key)
+			continue
+		}
+
+		if err = k.expirePayment(ctx, payment); err != nil {
+			k.logErrorf(ctx, "could not expire payment with source %s and external id %q: %v", source, externalID, err)
+			continue
+		}
+		count++
+	}
+
+	return count
+}
+
+// expirePayment releases a payment's held funds and deletes it.
+// Nothing is changed if there's an error.
+func (k Keeper) expirePayment(ctx sdk.Context, payment *exchange.Payment) error {
+	cacheCtx, writeCache := ctx.CacheContext()
+	err := k.deletePaymentAndReleaseHold(cacheCtx, k.getStore(cacheCtx), payment)
+	if err != nil {
+		return err
+	}
+	k.emitEvent(cacheCtx, exchange.NewEventPaymentExpired(payment))
+	writeCache()
+	incPaymentActionCounter(1, exchange.TelemetryActionExpired)
+	return nil
+}
+
 // GetPaymentsForTargetAndSource gets all the payments with the given target and source.
 // Returns nil if either the target or source is empty.
 // I.e. this can't be used to find payments from a source that don't have a target.
@@ -456,6 +729,25 @@ func (k Keeper) IteratePaymentsForSource(ctx sdk.Context, source sdk.AccAddress,
 	})
 }
 
+// IndexPaymentFilters makes sure every payment has its entries in the indexes used to filter payments.
+// This is only needed for payments that were created before those indexes existed.
+// Returns the number of payments indexed.
+func (k Keeper) IndexPaymentFilters(ctx sdk.Context) int {
+	var keys [][]byte
+	count := 0
+	k.IteratePayments(ctx, func(payment *exchange.Payment) bool {
+		keys = append(keys, makePaymentFilterIndexKeys(payment)...)
+		count++
+		return false
+	})
+
+	store := k.getStore(ctx)
+	for _, key := range keys {
+		store.Set(key, []byte{})
+	}
+	return count
+}
+
 // CalculatePaymentFees calculates the fees required for the provided payment.
 func (k Keeper) CalculatePaymentFees(ctx sdk.Context, payment *exchange.Payment) *exchange.QueryPaymentFeeCalcResponse {
 	resp := &exchange.QueryPaymentFeeCalcResponse{}
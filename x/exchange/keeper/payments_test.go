@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -24,6 +25,18 @@ func (s *TestSuite) newTestPayment(source sdk.AccAddress, sourceAmount string, t
 	}
 }
 
+// withPaymentExpiration sets the expiration of the provided payment and returns it.
+func withPaymentExpiration(payment *exchange.Payment, expiration time.Time) *exchange.Payment {
+	payment.Expiration = &expiration
+	return payment
+}
+
+// withDisputeWindow sets the dispute window of the provided payment and returns it.
+func withDisputeWindow(payment *exchange.Payment, seconds uint64) *exchange.Payment {
+	payment.DisputeWindowSeconds = seconds
+	return payment
+}
+
 // getAllPayments gets all the payments currently in state.
 func (s *TestSuite) getAllPayments() []*exchange.Payment {
 	var rv []*exchange.Payment
@@ -80,6 +93,91 @@ func (s *TestSuite) assertTargetToPaymentIndexEntriesMatchPayments() bool {
 	return assertEqualSlice(s, expKeys, actKeys, keyStringer, "target to payment index entries")
 }
 
+// assertExpirationToPaymentIndexEntriesMatchPayments gets all the payments and expiration to payment index entries
+// from state and makes sure that they're all as they should be.
+func (s *TestSuite) assertExpirationToPaymentIndexEntriesMatchPayments() bool {
+	s.T().Helper()
+	var expKeys [][]byte
+	for _, payment := range s.getAllPayments() {
+		source, _ := sdk.AccAddressFromBech32(payment.Source)
+		if payment.Expiration != nil && len(source) > 0 {
+			expKeys = append(expKeys, keeper.MakeIndexKeyExpirationToPayment(*payment.Expiration, source, payment.ExternalId))
+		}
+	}
+	sort.Slice(expKeys, func(i, j int) bool {
+		return bytes.Compare(expKeys[i], expKeys[j]) < 0
+	})
+
+	var actKeys [][]byte
+	keyPrefix := keeper.GetIndexKeyPrefixExpirationToPayment()
+	keeper.Iterate(s.getStore(), keyPrefix, func(keySuffix, _ []byte) bool {
+		actKeys = append(actKeys, concatBz(keyPrefix, keySuffix))
+		return false
+	})
+
+	keyStringer := func(key []byte) string {
+		expiration, source, externalID, err := keeper.ParseIndexKeyExpirationToPayment(key)
+		if err != nil {
+			return fmt.Sprintf("%v", key)
+		}
+		return fmt.Sprintf("%s %s %q", expiration.Format(time.RFC3339), s.getAddrName(source), externalID)
+	}
+
+	return assertEqualSlice(s, expKeys, actKeys, keyStringer, "expiration to payment index entries")
+}
+
+// assertPaymentFilterIndexEntriesMatchPayments gets all the payments and the entries in the indexes used to
+// filter payments (account denom and account target amount) from state and makes sure that they're all as they should be.
+func (s *TestSuite) assertPaymentFilterIndexEntriesMatchPayments() bool {
+	s.T().Helper()
+	var expDenomKeys, expTgtAmtKeys [][]byte
+	for _, payment := range s.getAllPayments() {
+		source, _ := sdk.AccAddressFromBech32(payment.Source)
+		if len(source) == 0 {
+			continue
+		}
+		accounts := []sdk.AccAddress{source}
+		roles := []byte{keeper.PaymentRoleSource}
+		if target, _ := sdk.AccAddressFromBech32(payment.Target); len(target) > 0 {
+			accounts = append(accounts, target)
+			roles = append(roles, keeper.PaymentRoleTarget)
+		}
+		for i, account := range accounts {
+			expTgtAmtKeys = append(expTgtAmtKeys, keeper.MakeIndexKeyAccountTargetAmountToPayment(account, roles[i],
+				!payment.TargetAmount.IsZero(), source, payment.ExternalId))
+			for _, coin := range payment.SourceAmount {
+				expDenomKeys = append(expDenomKeys, keeper.MakeIndexKeyAccountDenomToPayment(account, roles[i],
+					coin.Denom, coin.Amount, source, payment.ExternalId))
+			}
+		}
+	}
+
+	getActKeys := func(keyType byte) [][]byte {
+		var rv [][]byte
+		keyPrefix := []byte{keyType}
+		keeper.Iterate(s.getStore(), keyPrefix, func(keySuffix, _ []byte) bool {
+			rv = append(rv, concatBz(keyPrefix, keySuffix))
+			return false
+		})
+		return rv
+	}
+	sortKeys := func(keys [][]byte) {
+		sort.Slice(keys, func(i, j int) bool {
+			return bytes.Compare(keys[i], keys[j]) < 0
+		})
+	}
+	sortKeys(expDenomKeys)
+	sortKeys(expTgtAmtKeys)
+	keyStringer := func(key []byte) string {
+		return fmt.Sprintf("%v", key)
+	}
+
+	ok := assertEqualSlice(s, expDenomKeys, getActKeys(keeper.KeyTypeAccountDenomToPaymentIndex),
+		keyStringer, "account denom to payment index entries")
+	return assertEqualSlice(s, expTgtAmtKeys, getActKeys(keeper.KeyTypeAccountTargetAmountToPaymentIndex),
+		keyStringer, "account target amount to payment index entries") && ok
+}
+
 func (s *TestSuite) TestKeeper_GetPayment() {
 	sourceHasTwoPayments1 := s.newTestPayment(s.longAddr2, "22strawberry", s.addr3, "12tomato", "l2-3-2")
 	sourceHasTwoPayments2 := s.newTestPayment(s.longAddr2, "44strawberry", s.addr3, "14tomato", "l2-3-4")
@@ -226,6 +324,8 @@ func (s *TestSuite) TestKeeper_GetPayment() {
 }
 
 func (s *TestSuite) TestKeeper_CreatePayment() {
+	blockTime := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+
 	tests := []struct {
 		name       string
 		setup      func()
@@ -260,6 +360,52 @@ func (s *TestSuite) TestKeeper_CreatePayment() {
 			expErr: "failed to create payment: a payment already exists with source " +
 				s.addr2.String() + " and external id \"do-not-reuse-this\"",
 		},
+		{
+			name: "escrowed payment already exists",
+			setup: func() {
+				s.requireSetEscrowedPaymentsInStore(&exchange.EscrowedPayment{
+					Payment:     *withDisputeWindow(s.newTestPayment(s.addr2, "10strawberry", s.longAddr3, "", "in-escrow"), 60),
+					ReleaseTime: blockTime.Add(time.Minute),
+				})
+			},
+			payment: s.newTestPayment(s.addr2, "10strawberry", s.addr5, "", "in-escrow"),
+			expErr: "failed to create payment: an escrowed payment already exists with source " +
+				s.addr2.String() + " and external id \"in-escrow\"",
+		},
+		{
+			name: "external id used by an order in an account-scoped market",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetExternalIDScope(store, 7, exchange.ExternalIDScope_account)
+				s.requireSetOrderInStore(store, exchange.NewOrder(12).WithAsk(&exchange.AskOrder{
+					MarketId:   7,
+					Seller:     s.addr2.String(),
+					Assets:     s.coin("10strawberry"),
+					Price:      s.coin("3tomato"),
+					ExternalId: "also-an-order",
+				}))
+			},
+			payment: s.newTestPayment(s.addr2, "10strawberry", s.addr5, "", "also-an-order"),
+			expErr: "failed to create payment: external id \"also-an-order\" is already in use by order 12 from " +
+				s.addr2.String() + " in market 7",
+		},
+		{
+			name: "external id used by an order in a market-scoped market",
+			setup: func() {
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(12).WithAsk(&exchange.AskOrder{
+					MarketId:   7,
+					Seller:     s.longAddr3.String(),
+					Assets:     s.coin("10strawberry"),
+					Price:      s.coin("3tomato"),
+					ExternalId: "also-an-order",
+				}))
+			},
+			payment:    s.newTestPayment(s.longAddr3, "3starfruit", s.longAddr2, "1tangerine", "also-an-order"),
+			expStored:  true,
+			expIndex:   true,
+			expAddHold: true,
+			expEvent:   true,
+		},
 		{
 			name:       "error adding hold",
 			holdKeeper: NewMockHoldKeeper().WithAddHoldResults("you know you can't do that"),
@@ -293,6 +439,20 @@ func (s *TestSuite) TestKeeper_CreatePayment() {
 			expAddHold: true,
 			expEvent:   true,
 		},
+		{
+			name:    "expiration at block time",
+			payment: withPaymentExpiration(s.newTestPayment(s.addr1, "5strawberry", s.addr2, "", "too-late"), blockTime),
+			expErr: "cannot create payment: invalid expiration 2030-01-01T12:00:00Z: " +
+				"must be after the current block time 2030-01-01T12:00:00Z",
+		},
+		{
+			name:       "expiration after block time",
+			payment:    withPaymentExpiration(s.newTestPayment(s.addr1, "5strawberry", s.addr2, "", "in-time"), blockTime.Add(time.Second)),
+			expStored:  true,
+			expIndex:   true,
+			expAddHold: true,
+			expEvent:   true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -340,7 +500,7 @@ func (s *TestSuite) TestKeeper_CreatePayment() {
 
 			kpr := s.k.WithHoldKeeper(tc.holdKeeper)
 			em := sdk.NewEventManager()
-			ctx := s.ctx.WithEventManager(em)
+			ctx := s.ctx.WithEventManager(em).WithBlockTime(blockTime)
 			var err error
 			testFunc := func() {
 				err = kpr.CreatePayment(ctx, tc.payment)
@@ -371,6 +531,8 @@ func (s *TestSuite) TestKeeper_CreatePayment() {
 			}
 
 			s.assertTargetToPaymentIndexEntriesMatchPayments()
+			s.assertExpirationToPaymentIndexEntriesMatchPayments()
+			s.assertPaymentFilterIndexEntriesMatchPayments()
 		})
 	}
 }
@@ -393,6 +555,7 @@ func (s *TestSuite) TestKeeper_AcceptPayment() {
 	fullPaymentTarget := s.addr2
 	fullPayment := s.newTestPayment(fullPaymentSource, "2starfruit,33strawberry", fullPaymentTarget, "8tangerine,3tomato", "just-some-id")
 	fullPaymentKey := keeper.MakeKeyPayment(fullPaymentSource, fullPayment.ExternalId)
+	blockTime := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
 
 	tests := []struct {
 		name           string
@@ -405,6 +568,7 @@ func (s *TestSuite) TestKeeper_AcceptPayment() {
 		expReleaseHold bool
 		expBankCalls   BankCalls
 		expEvent       bool
+		expEscrowed    *exchange.EscrowedPayment
 		skipIndCheck   bool
 	}{
 		{
@@ -527,6 +691,15 @@ func (s *TestSuite) TestKeeper_AcceptPayment() {
 			expErr:       "provided external id \"" + fullPayment.ExternalId + "\" does not equal existing external id \"noway\"",
 			skipIndCheck: true,
 		},
+		{
+			name: "payment has expired",
+			setup: func() {
+				s.requireSetPaymentsInStore(withPaymentExpiration(s.newTestPayment(s.addr4, "3strawberry", s.addr3, "", "stale"), blockTime))
+			},
+			payment: s.newTestPayment(s.addr4, "3strawberry", s.addr3, "", "stale"),
+			expErr: "payment with source " + s.addr4.String() + " and external id \"stale\" " +
+				"expired at 2030-01-01T12:00:00Z",
+		},
 		{
 			name: "error releasing hold",
 			setup: func() {
@@ -631,6 +804,50 @@ func (s *TestSuite) TestKeeper_AcceptPayment() {
 			}},
 			expEvent: true,
 		},
+		{
+			name: "payment has not expired yet",
+			setup: func() {
+				s.requireSetPaymentsInStore(withPaymentExpiration(s.newTestPayment(s.addr4, "3strawberry", s.addr3, "", "fresh"), blockTime.Add(time.Second)))
+			},
+			payment:        s.newTestPayment(s.addr4, "3strawberry", s.addr3, "", "fresh"),
+			expDeleted:     true,
+			expReleaseHold: true,
+			expBankCalls: BankCalls{SendCoins: []*SendCoinsArgs{
+				{fromAddr: s.addr4, toAddr: s.addr3, amt: s.coins("3strawberry")},
+			}},
+			expEvent: true,
+		},
+		{
+			name: "with dispute window: funds go to escrow",
+			setup: func() {
+				s.requireSetPaymentsInStore(withDisputeWindow(s.newTestPayment(s.addr1, "5strawberry", s.addr2, "2tomato", "disputable"), 3600))
+			},
+			payment:        s.newTestPayment(s.addr1, "5strawberry", s.addr2, "2tomato", "disputable"),
+			expDeleted:     true,
+			expReleaseHold: true,
+			expBankCalls: BankCalls{SendCoins: []*SendCoinsArgs{
+				{fromAddr: s.addr1, toAddr: exchange.GetPaymentEscrowAddress(), amt: s.coins("5strawberry")},
+				{fromAddr: s.addr2, toAddr: exchange.GetPaymentEscrowAddress(), amt: s.coins("2tomato")},
+			}},
+			expEvent: true,
+			expEscrowed: &exchange.EscrowedPayment{
+				Payment:     *withDisputeWindow(s.newTestPayment(s.addr1, "5strawberry", s.addr2, "2tomato", "disputable"), 3600),
+				ReleaseTime: blockTime.Add(time.Hour),
+			},
+		},
+		{
+			name: "with dispute window: error sending to escrow",
+			setup: func() {
+				s.requireSetPaymentsInStore(withDisputeWindow(s.newTestPayment(s.addr1, "5strawberry", s.addr2, "", "disputable"), 3600))
+			},
+			bankKeeper:     NewMockBankKeeper().WithSendCoinsResults("no strawberries for you"),
+			payment:        s.newTestPayment(s.addr1, "5strawberry", s.addr2, "", "disputable"),
+			expErr:         "error sending \"5strawberry\" from source " + s.addr1.String() + " to escrow: no strawberries for you",
+			expReleaseHold: true,
+			expBankCalls: BankCalls{SendCoins: []*SendCoinsArgs{
+				{fromAddr: s.addr1, toAddr: exchange.GetPaymentEscrowAddress(), amt: s.coins("5strawberry")},
+			}},
+		},
 	}
 
 	for _, tc := range tests {
@@ -662,6 +879,9 @@ func (s *TestSuite) TestKeeper_AcceptPayment() {
 				s.Require().NotNil(tc.payment, "tc.payment cannot be nil when tc.expEvent = true")
 				expEvents = sdk.Events{s.untypeEvent(exchange.NewEventPaymentAccepted(tc.payment))}
 			}
+			if tc.expEscrowed != nil {
+				expEvents = append(expEvents, s.untypeEvent(exchange.NewEventPaymentEscrowed(tc.expEscrowed)))
+			}
 
 			if tc.setup != nil {
 				tc.setup()
@@ -669,7 +889,7 @@ func (s *TestSuite) TestKeeper_AcceptPayment() {
 
 			kpr := s.k.WithHoldKeeper(tc.holdKeeper).WithBankKeeper(tc.bankKeeper)
 			em := sdk.NewEventManager()
-			ctx := s.ctx.WithEventManager(em)
+			ctx := s.ctx.WithEventManager(em).WithBlockTime(blockTime)
 			var err error
 			testFunc := func() {
 				err = kpr.AcceptPayment(ctx, tc.payment)
@@ -700,13 +920,313 @@ func (s *TestSuite) TestKeeper_AcceptPayment() {
 				s.Assert().False(hasIndex, "store.Has(target to payment index key) after AcceptPayment(%s)", tc.payment)
 			}
 
+			var expEPs []*exchange.EscrowedPayment
+			if tc.expEscrowed != nil {
+				expEPs = append(expEPs, tc.expEscrowed)
+			}
+			s.Assert().Equal(expEPs, s.getAllEscrowedPayments(), "escrowed payments after AcceptPayment(%s)", tc.payment)
+
 			if !tc.skipIndCheck {
 				s.assertTargetToPaymentIndexEntriesMatchPayments()
+				s.assertExpirationToPaymentIndexEntriesMatchPayments()
+				s.assertPaymentFilterIndexEntriesMatchPayments()
+				s.assertReleaseTimeToEscrowedPaymentIndexEntriesMatch()
 			}
 		})
 	}
 }
 
+func (s *TestSuite) TestKeeper_AcceptPayments() {
+	type paymentKey struct {
+		source     sdk.AccAddress
+		externalID string
+	}
+	newPKey := func(source sdk.AccAddress, externalID string) paymentKey {
+		return paymentKey{source: source, externalID: externalID}
+	}
+	newPID := func(source sdk.AccAddress, externalID string) exchange.PaymentID {
+		return exchange.PaymentID{Source: source.String(), ExternalId: externalID}
+	}
+	accepted := func(source sdk.AccAddress, externalID string) exchange.AcceptPaymentResult {
+		return exchange.AcceptPaymentResult{Source: source.String(), ExternalId: externalID, Accepted: true}
+	}
+	failed := func(source sdk.AccAddress, externalID string, err string) exchange.AcceptPaymentResult {
+		return exchange.AcceptPaymentResult{Source: source.String(), ExternalId: externalID, Error: err}
+	}
+	blockTime := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	manyPayments := make([]*exchange.Payment, exchange.MaxAcceptPaymentsPerMsg+1)
+	for i := range manyPayments {
+		manyPayments[i] = s.newTestPayment(s.addr2, "1strawberry", s.addr1, "", fmt.Sprintf("many%03d", i))
+	}
+	var manyResults []exchange.AcceptPaymentResult
+	var manyHoldCalls HoldCalls
+	var manyBankCalls BankCalls
+	var manyEvents []*exchange.EventPaymentAccepted
+	for _, payment := range manyPayments[:exchange.MaxAcceptPaymentsPerMsg] {
+		manyResults = append(manyResults, accepted(s.addr2, payment.ExternalId))
+		manyHoldCalls.ReleaseHold = append(manyHoldCalls.ReleaseHold, &ReleaseHoldArgs{addr: s.addr2, funds: s.coins("1strawberry")})
+		manyBankCalls.SendCoins = append(manyBankCalls.SendCoins, &SendCoinsArgs{fromAddr: s.addr2, toAddr: s.addr1, amt: s.coins("1strawberry")})
+		manyEvents = append(manyEvents, exchange.NewEventPaymentAccepted(payment))
+	}
+
+	tests := []struct {
+		name         string
+		setup        func()
+		holdKeeper   *MockHoldKeeper
+		bankKeeper   *MockBankKeeper
+		target       sdk.AccAddress
+		paymentIDs   []exchange.PaymentID
+		sources      []sdk.AccAddress
+		maxTarget    string
+		expErr       string
+		expResults   []exchange.AcceptPaymentResult
+		expSent      string
+		expHoldCalls HoldCalls
+		expBankCalls BankCalls
+		expEvents    []*exchange.EventPaymentAccepted
+		expRemain    []paymentKey
+	}{
+		{
+			name:    "nil target",
+			target:  nil,
+			sources: []sdk.AccAddress{s.addr1},
+			expErr:  "a target is required in order to accept payments",
+		},
+		{
+			name:   "no payment ids or sources",
+			target: s.addr1,
+			expErr: "at least one payment id or source is required",
+		},
+		{
+			name: "payment ids: unknown and invalid",
+			setup: func() {
+				s.requireSetPaymentsInStore(s.newTestPayment(s.addr2, "2strawberry", s.addr1, "", "known"))
+			},
+			target: s.addr1,
+			paymentIDs: []exchange.PaymentID{
+				newPID(s.addr2, "unknown"),
+				{Source: "badsource", ExternalId: "known"},
+			},
+			expResults: []exchange.AcceptPaymentResult{
+				failed(s.addr2, "unknown", "no payment found with source "+s.addr2.String()+" and external id \"unknown\""),
+				{Source: "badsource", ExternalId: "known", Error: "decoding bech32 failed: invalid separator index -1"},
+			},
+			expRemain: []paymentKey{newPKey(s.addr2, "known")},
+		},
+		{
+			name: "payment ids: wrong target and over the max target amount",
+			setup: func() {
+				s.requireSetPaymentsInStore(
+					s.newTestPayment(s.addr2, "2strawberry", s.addr3, "", "other-target"),
+					s.newTestPayment(s.addr2, "", s.addr1, "5tomato", "too-much"),
+					s.newTestPayment(s.addr2, "", s.addr1, "3tomato,1tangerine", "wrong-denom"),
+				)
+			},
+			target:     s.addr1,
+			paymentIDs: []exchange.PaymentID{newPID(s.addr2, "other-target"), newPID(s.addr2, "too-much"), newPID(s.addr2, "wrong-denom")},
+			maxTarget:  "4tomato",
+			expResults: []exchange.AcceptPaymentResult{
+				failed(s.addr2, "other-target", "target "+s.addr1.String()+" cannot accept payment with target "+s.addr3.String()),
+				failed(s.addr2, "too-much", "target amount \"5tomato\" is more than the max target amount \"4tomato\""),
+				failed(s.addr2, "wrong-denom", "target amount \"1tangerine,3tomato\" is more than the max target amount \"4tomato\""),
+			},
+			expRemain: []paymentKey{newPKey(s.addr2, "other-target"), newPKey(s.addr2, "too-much"), newPKey(s.addr2, "wrong-denom")},
+		},
+		{
+			name: "payment ids: one fails, others accepted",
+			setup: func() {
+				s.requireSetPaymentsInStore(
+					s.newTestPayment(s.addr2, "2strawberry", s.addr1, "", "first"),
+					s.newTestPayment(s.addr3, "3strawberry", s.addr1, "3tomato", "second"),
+					withPaymentExpiration(s.newTestPayment(s.addr4, "4strawberry", s.addr1, "", "stale"), blockTime),
+					s.newTestPayment(s.addr5, "5strawberry", s.addr1, "", "third"),
+				)
+			},
+			holdKeeper: NewMockHoldKeeper().WithReleaseHoldResults("", "not today"),
+			target:     s.addr1,
+			paymentIDs: []exchange.PaymentID{
+				newPID(s.addr2, "first"), newPID(s.addr3, "second"), newPID(s.addr4, "stale"),
+				newPID(s.addr5, "third"), newPID(s.addr2, "first"),
+			},
+			maxTarget: "5tomato",
+			expResults: []exchange.AcceptPaymentResult{
+				accepted(s.addr2, "first"),
+				failed(s.addr3, "second", "error releasing hold on payment source: not today"),
+				failed(s.addr4, "stale", "payment with source "+s.addr4.String()+" and external id \"stale\" expired at 2030-01-01T12:00:00Z"),
+				accepted(s.addr5, "third"),
+			},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{
+				{addr: s.addr2, funds: s.coins("2strawberry")},
+				{addr: s.addr3, funds: s.coins("3strawberry")},
+				{addr: s.addr5, funds: s.coins("5strawberry")},
+			}},
+			expBankCalls: BankCalls{SendCoins: []*SendCoinsArgs{
+				{fromAddr: s.addr2, toAddr: s.addr1, amt: s.coins("2strawberry")},
+				{fromAddr: s.addr5, toAddr: s.addr1, amt: s.coins("5strawberry")},
+			}},
+			expEvents: []*exchange.EventPaymentAccepted{
+				exchange.NewEventPaymentAccepted(s.newTestPayment(s.addr2, "2strawberry", s.addr1, "", "first")),
+				exchange.NewEventPaymentAccepted(s.newTestPayment(s.addr5, "5strawberry", s.addr1, "", "third")),
+			},
+			expRemain: []paymentKey{newPKey(s.addr3, "second"), newPKey(s.addr4, "stale")},
+		},
+		{
+			name: "error sending funds",
+			setup: func() {
+				s.requireSetPaymentsInStore(
+					s.newTestPayment(s.addr2, "2strawberry", s.addr1, "2tomato", "first"),
+					s.newTestPayment(s.addr2, "3strawberry", s.addr1, "3tomato", "second"),
+				)
+			},
+			bankKeeper: NewMockBankKeeper().WithSendCoinsResults("", "insufficient funds"),
+			target:     s.addr1,
+			sources:    []sdk.AccAddress{s.addr2},
+			maxTarget:  "5tomato",
+			expResults: []exchange.AcceptPaymentResult{
+				failed(s.addr2, "first", "error sending \"2tomato\" from target "+s.addr1.String()+" to source "+s.addr2.String()+": insufficient funds"),
+				accepted(s.addr2, "second"),
+			},
+			expSent: "3tomato",
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{
+				{addr: s.addr2, funds: s.coins("2strawberry")},
+				{addr: s.addr2, funds: s.coins("3strawberry")},
+			}},
+			expBankCalls: BankCalls{SendCoins: []*SendCoinsArgs{
+				{fromAddr: s.addr2, toAddr: s.addr1, amt: s.coins("2strawberry")},
+				{fromAddr: s.addr1, toAddr: s.addr2, amt: s.coins("2tomato")},
+				{fromAddr: s.addr2, toAddr: s.addr1, amt: s.coins("3strawberry")},
+				{fromAddr: s.addr1, toAddr: s.addr2, amt: s.coins("3tomato")},
+			}},
+			expEvents: []*exchange.EventPaymentAccepted{
+				exchange.NewEventPaymentAccepted(s.newTestPayment(s.addr2, "3strawberry", s.addr1, "3tomato", "second")),
+			},
+			expRemain: []paymentKey{newPKey(s.addr2, "first")},
+		},
+		{
+			name: "payment ids and sources",
+			setup: func() {
+				s.requireSetPaymentsInStore(
+					s.newTestPayment(s.addr2, "21strawberry", s.addr1, "", "one"),
+					s.newTestPayment(s.addr2, "22strawberry", s.addr1, "2tomato", "two"),
+					s.newTestPayment(s.addr2, "23strawberry", s.addr5, "", "three"),
+					s.newTestPayment(s.addr3, "31strawberry", s.addr1, "", "one"),
+					s.newTestPayment(s.addr3, "32strawberry", s.addr1, "9tomato", "two"),
+					s.newTestPayment(s.addr4, "41strawberry", s.addr1, "1tangerine", "one"),
+				)
+			},
+			target:     s.addr1,
+			paymentIDs: []exchange.PaymentID{newPID(s.addr4, "one"), newPID(s.addr2, "two")},
+			sources:    []sdk.AccAddress{s.addr2, s.addr3, s.addr2, s.addr5},
+			maxTarget:  "1tangerine,2tomato",
+			expResults: []exchange.AcceptPaymentResult{
+				accepted(s.addr4, "one"),
+				accepted(s.addr2, "two"),
+				accepted(s.addr2, "one"),
+				accepted(s.addr3, "one"),
+				failed(s.addr3, "two", "target amount \"9tomato\" is more than the max target amount \"1tangerine,2tomato\""),
+			},
+			expSent: "1tangerine,2tomato",
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{
+				{addr: s.addr4, funds: s.coins("41strawberry")},
+				{addr: s.addr2, funds: s.coins("22strawberry")},
+				{addr: s.addr2, funds: s.coins("21strawberry")},
+				{addr: s.addr3, funds: s.coins("31strawberry")},
+			}},
+			expBankCalls: BankCalls{SendCoins: []*SendCoinsArgs{
+				{fromAddr: s.addr4, toAddr: s.addr1, amt: s.coins("41strawberry")},
+				{fromAddr: s.addr1, toAddr: s.addr4, amt: s.coins("1tangerine")},
+				{fromAddr: s.addr2, toAddr: s.addr1, amt: s.coins("22strawberry")},
+				{fromAddr: s.addr1, toAddr: s.addr2, amt: s.coins("2tomato")},
+				{fromAddr: s.addr2, toAddr: s.addr1, amt: s.coins("21strawberry")},
+				{fromAddr: s.addr3, toAddr: s.addr1, amt: s.coins("31strawberry")},
+			}},
+			expEvents: []*exchange.EventPaymentAccepted{
+				exchange.NewEventPaymentAccepted(s.newTestPayment(s.addr4, "41strawberry", s.addr1, "1tangerine", "one")),
+				exchange.NewEventPaymentAccepted(s.newTestPayment(s.addr2, "22strawberry", s.addr1, "2tomato", "two")),
+				exchange.NewEventPaymentAccepted(s.newTestPayment(s.addr2, "21strawberry", s.addr1, "", "one")),
+				exchange.NewEventPaymentAccepted(s.newTestPayment(s.addr3, "31strawberry", s.addr1, "", "one")),
+			},
+			expRemain: []paymentKey{newPKey(s.addr2, "three"), newPKey(s.addr3, "two")},
+		},
+		{
+			name: "more payments than can be accepted at once",
+			setup: func() {
+				s.requireSetPaymentsInStore(manyPayments...)
+			},
+			target:       s.addr1,
+			sources:      []sdk.AccAddress{s.addr2},
+			expResults:   manyResults,
+			expHoldCalls: manyHoldCalls,
+			expBankCalls: manyBankCalls,
+			expEvents:    manyEvents,
+			expRemain:    []paymentKey{newPKey(s.addr2, manyPayments[exchange.MaxAcceptPaymentsPerMsg].ExternalId)},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+
+			if tc.holdKeeper == nil {
+				tc.holdKeeper = NewMockHoldKeeper()
+			}
+			if tc.bankKeeper == nil {
+				tc.bankKeeper = NewMockBankKeeper()
+			}
+			for i := range tc.expBankCalls.SendCoins {
+				tc.expBankCalls.SendCoins[i].ctxHasQuarantineBypass = true
+			}
+
+			var expEvents sdk.Events
+			if len(tc.expEvents) > 0 {
+				expEvents = make(sdk.Events, len(tc.expEvents))
+				for i, event := range tc.expEvents {
+					expEvents[i] = s.untypeEvent(event)
+				}
+			}
+
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			maxTarget := s.coins(tc.maxTarget)
+			expSent := s.coins(tc.expSent)
+			targetName := s.getAddrName(tc.target)
+
+			kpr := s.k.WithHoldKeeper(tc.holdKeeper).WithBankKeeper(tc.bankKeeper)
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em).WithBlockTime(blockTime)
+			var results []exchange.AcceptPaymentResult
+			var sent sdk.Coins
+			var err error
+			testFunc := func() {
+				results, sent, err = kpr.AcceptPayments(ctx, tc.target, tc.paymentIDs, tc.sources, maxTarget)
+			}
+			s.Require().NotPanics(testFunc, "AcceptPayments(%s)", targetName)
+			s.assertErrorValue(err, tc.expErr, "AcceptPayments(%s) error", targetName)
+			s.Assert().Equal(tc.expResults, results, "AcceptPayments(%s) results", targetName)
+			s.Assert().Equal(expSent.String(), sent.String(), "AcceptPayments(%s) total sent", targetName)
+			s.assertHoldKeeperCalls(tc.holdKeeper, tc.expHoldCalls, "AcceptPayments(%s) hold calls", targetName)
+			s.assertBankKeeperCalls(tc.bankKeeper, tc.expBankCalls, "AcceptPayments(%s) bank calls", targetName)
+
+			actEvents := em.Events()
+			s.assertEqualEvents(expEvents, actEvents, "AcceptPayments(%s) events", targetName)
+
+			payments := s.getAllPayments()
+			var actRemain []paymentKey
+			for _, payment := range payments {
+				actRemain = append(actRemain, newPKey(s.requireAccAddressFromBech32(payment.Source, "payment source"), payment.ExternalId))
+			}
+			s.Assert().ElementsMatch(tc.expRemain, actRemain, "payments remaining after AcceptPayments(%s)", targetName)
+
+			s.assertTargetToPaymentIndexEntriesMatchPayments()
+			s.assertExpirationToPaymentIndexEntriesMatchPayments()
+			s.assertPaymentFilterIndexEntriesMatchPayments()
+		})
+	}
+}
+
 func (s *TestSuite) TestKeeper_RejectPayment() {
 	tests := []struct {
 		name         string
@@ -902,6 +1422,8 @@ func (s *TestSuite) TestKeeper_RejectPayment() {
 			}
 
 			s.assertTargetToPaymentIndexEntriesMatchPayments()
+			s.assertExpirationToPaymentIndexEntriesMatchPayments()
+			s.assertPaymentFilterIndexEntriesMatchPayments()
 		})
 	}
 }
@@ -1167,6 +1689,8 @@ func (s *TestSuite) TestKeeper_RejectPayments() {
 			}
 
 			s.assertTargetToPaymentIndexEntriesMatchPayments()
+			s.assertExpirationToPaymentIndexEntriesMatchPayments()
+			s.assertPaymentFilterIndexEntriesMatchPayments()
 		})
 	}
 }
@@ -1439,6 +1963,8 @@ func (s *TestSuite) TestKeeper_CancelPayments() {
 			}
 
 			s.assertTargetToPaymentIndexEntriesMatchPayments()
+			s.assertExpirationToPaymentIndexEntriesMatchPayments()
+			s.assertPaymentFilterIndexEntriesMatchPayments()
 		})
 	}
 }
@@ -1564,6 +2090,19 @@ func (s *TestSuite) TestKeeper_UpdatePaymentTarget() {
 			expOldTarget: s.longAddr1,
 			expPayment:   s.newTestPayment(s.addr2, "6strawberry", s.addr2, "2tangerine", ""),
 		},
+		{
+			name: "with expiration",
+			setup: func() {
+				s.requireSetPaymentsInStore(withPaymentExpiration(
+					s.newTestPayment(s.addr3, "4strawberry", s.addr1, "", "exp"), time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)))
+			},
+			source:       s.addr3,
+			externalID:   "exp",
+			newTarget:    s.addr4,
+			expOldTarget: s.addr1,
+			expPayment: withPaymentExpiration(
+				s.newTestPayment(s.addr3, "4strawberry", s.addr4, "", "exp"), time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)),
+		},
 	}
 
 	for _, tc := range tests {
@@ -1619,6 +2158,196 @@ func (s *TestSuite) TestKeeper_UpdatePaymentTarget() {
 			}
 
 			s.assertTargetToPaymentIndexEntriesMatchPayments()
+			s.assertExpirationToPaymentIndexEntriesMatchPayments()
+			s.assertPaymentFilterIndexEntriesMatchPayments()
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_ExpirePayments() {
+	blockTime := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	newPayment := func(source sdk.AccAddress, sourceAmount string, externalID string, offset *time.Duration) *exchange.Payment {
+		rv := s.newTestPayment(source, sourceAmount, s.addr5, "1tomato", externalID)
+		if offset != nil {
+			rv = withPaymentExpiration(rv, blockTime.Add(*offset))
+		}
+		return rv
+	}
+	offsetP := func(offset time.Duration) *time.Duration {
+		return &offset
+	}
+
+	tests := []struct {
+		name         string
+		payments     []*exchange.Payment
+		setup        func()
+		holdKeeper   *MockHoldKeeper
+		limit        int
+		expCount     int
+		expExpired   []*exchange.Payment
+		expRemaining []*exchange.Payment
+		expDelKeys   [][]byte
+	}{
+		{
+			name:     "no payments",
+			limit:    10,
+			expCount: 0,
+		},
+		{
+			name: "nothing expired yet",
+			payments: []*exchange.Payment{
+				newPayment(s.addr1, "1strawberry", "a", offsetP(time.Second)),
+				newPayment(s.addr2, "2strawberry", "b", nil),
+			},
+			limit:    10,
+			expCount: 0,
+			expRemaining: []*exchange.Payment{
+				newPayment(s.addr1, "1strawberry", "a", offsetP(time.Second)),
+				newPayment(s.addr2, "2strawberry", "b", nil),
+			},
+		},
+		{
+			name: "some expired",
+			payments: []*exchange.Payment{
+				newPayment(s.addr1, "1strawberry", "a", offsetP(-1*time.Hour)),
+				newPayment(s.addr1, "2strawberry", "b", offsetP(time.Hour)),
+				newPayment(s.addr2, "3strawberry", "a", nil),
+				newPayment(s.addr3, "4strawberry", "", offsetP(0)),
+			},
+			limit:    10,
+			expCount: 2,
+			expExpired: []*exchange.Payment{
+				newPayment(s.addr1, "1strawberry", "a", offsetP(-1*time.Hour)),
+				newPayment(s.addr3, "4strawberry", "", offsetP(0)),
+			},
+			expRemaining: []*exchange.Payment{
+				newPayment(s.addr1, "2strawberry", "b", offsetP(time.Hour)),
+				newPayment(s.addr2, "3strawberry", "a", nil),
+			},
+		},
+		{
+			name: "more expired than the limit",
+			payments: []*exchange.Payment{
+				newPayment(s.addr1, "1strawberry", "a", offsetP(-1*time.Minute)),
+				newPayment(s.addr2, "2strawberry", "b", offsetP(-3*time.Minute)),
+				newPayment(s.addr3, "3strawberry", "c", offsetP(-2*time.Minute)),
+			},
+			limit:    2,
+			expCount: 2,
+			expExpired: []*exchange.Payment{
+				newPayment(s.addr2, "2strawberry", "b", offsetP(-3*time.Minute)),
+				newPayment(s.addr3, "3strawberry", "c", offsetP(-2*time.Minute)),
+			},
+			expRemaining: []*exchange.Payment{
+				newPayment(s.addr1, "1strawberry", "a", offsetP(-1*time.Minute)),
+			},
+		},
+		{
+			name: "no limit",
+			payments: []*exchange.Payment{
+				newPayment(s.addr1, "1strawberry", "a", offsetP(-1*time.Minute)),
+				newPayment(s.addr2, "2strawberry", "b", offsetP(-3*time.Minute)),
+			},
+			limit:    0,
+			expCount: 2,
+			expExpired: []*exchange.Payment{
+				newPayment(s.addr2, "2strawberry", "b", offsetP(-3*time.Minute)),
+				newPayment(s.addr1, "1strawberry", "a", offsetP(-1*time.Minute)),
+			},
+		},
+		{
+			name: "invalid index entry",
+			payments: []*exchange.Payment{
+				newPayment(s.addr1, "1strawberry", "a", offsetP(-1*time.Minute)),
+			},
+			setup: func() {
+				key := keeper.GetIndexKeyPrefixExpirationToPaymentAt(blockTime.Add(-2 * time.Minute))
+				s.getStore().Set(append(key, 20, 'x'), []byte{})
+			},
+			limit:    10,
+			expCount: 1,
+			expExpired: []*exchange.Payment{
+				newPayment(s.addr1, "1strawberry", "a", offsetP(-1*time.Minute)),
+			},
+			expDelKeys: [][]byte{append(keeper.GetIndexKeyPrefixExpirationToPaymentAt(blockTime.Add(-2*time.Minute)), 20, 'x')},
+		},
+		{
+			name: "index entry without a payment",
+			payments: []*exchange.Payment{
+				newPayment(s.addr1, "1strawberry", "a", offsetP(-1*time.Minute)),
+			},
+			setup: func() {
+				key := keeper.MakeIndexKeyExpirationToPayment(blockTime.Add(-2*time.Minute), s.addr2, "gone")
+				s.getStore().Set(key, []byte{})
+			},
+			limit:    10,
+			expCount: 1,
+			expExpired: []*exchange.Payment{
+				newPayment(s.addr1, "1strawberry", "a", offsetP(-1*time.Minute)),
+			},
+			expDelKeys: [][]byte{keeper.MakeIndexKeyExpirationToPayment(blockTime.Add(-2*time.Minute), s.addr2, "gone")},
+		},
+		{
+			name: "error releasing hold",
+			payments: []*exchange.Payment{
+				newPayment(s.addr1, "1strawberry", "a", offsetP(-2*time.Minute)),
+				newPayment(s.addr2, "2strawberry", "b", offsetP(-1*time.Minute)),
+			},
+			holdKeeper: NewMockHoldKeeper().WithReleaseHoldResults("not gonna let it go"),
+			limit:      10,
+			expCount:   1,
+			expExpired: []*exchange.Payment{
+				newPayment(s.addr2, "2strawberry", "b", offsetP(-1*time.Minute)),
+			},
+			expRemaining: []*exchange.Payment{
+				newPayment(s.addr1, "1strawberry", "a", offsetP(-2*time.Minute)),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			s.requireSetPaymentsInStore(tc.payments...)
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			if tc.holdKeeper == nil {
+				tc.holdKeeper = NewMockHoldKeeper()
+			}
+
+			var expEvents sdk.Events
+			expDelKeys := tc.expDelKeys
+			for _, payment := range tc.expExpired {
+				expEvents = append(expEvents, s.untypeEvent(exchange.NewEventPaymentExpired(payment)))
+				source := s.requireAccAddressFromBech32(payment.Source, "expired payment source")
+				expDelKeys = append(expDelKeys,
+					keeper.MakeKeyPayment(source, payment.ExternalId),
+					keeper.MakeIndexKeyExpirationToPayment(*payment.Expiration, source, payment.ExternalId),
+				)
+			}
+
+			kpr := s.k.WithHoldKeeper(tc.holdKeeper)
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em).WithBlockTime(blockTime)
+			var count int
+			testFunc := func() {
+				count = kpr.ExpirePayments(ctx, tc.limit)
+			}
+			s.Require().NotPanics(testFunc, "ExpirePayments(%d)", tc.limit)
+			s.Assert().Equal(tc.expCount, count, "ExpirePayments(%d) result", tc.limit)
+			actEvents := em.Events()
+			s.assertEqualEvents(expEvents, actEvents, "ExpirePayments(%d) events", tc.limit)
+
+			store := s.getStore()
+			for i, key := range expDelKeys {
+				s.Assert().False(store.Has(key), "[%d]: store.Has(%v) after expire", i, key)
+			}
+			s.assertEqualPayments(tc.expRemaining, s.getAllPayments(), "payments remaining after ExpirePayments(%d)", tc.limit)
+			s.assertTargetToPaymentIndexEntriesMatchPayments()
+			s.assertExpirationToPaymentIndexEntriesMatchPayments()
+			s.assertPaymentFilterIndexEntriesMatchPayments()
 		})
 	}
 }
@@ -1965,6 +2694,66 @@ func (s *TestSuite) TestKeeper_IteratePaymentsForSource() {
 	}
 }
 
+func (s *TestSuite) TestKeeper_IndexPaymentFilters() {
+	deleteFilterIndexEntries := func() {
+		store := s.getStore()
+		for _, keyType := range []byte{keeper.KeyTypeAccountDenomToPaymentIndex, keeper.KeyTypeAccountTargetAmountToPaymentIndex} {
+			var keys [][]byte
+			keeper.Iterate(store, []byte{keyType}, func(keySuffix, _ []byte) bool {
+				keys = append(keys, concatBz([]byte{keyType}, keySuffix))
+				return false
+			})
+			for _, key := range keys {
+				store.Delete(key)
+			}
+		}
+	}
+
+	tests := []struct {
+		name     string
+		payments []*exchange.Payment
+		expCount int
+	}{
+		{
+			name:     "no payments",
+			expCount: 0,
+		},
+		{
+			name: "one payment",
+			payments: []*exchange.Payment{
+				s.newTestPayment(s.addr1, "5strawberry", s.addr2, "3tomato", "one"),
+			},
+			expCount: 1,
+		},
+		{
+			name: "several payments",
+			payments: []*exchange.Payment{
+				s.newTestPayment(s.addr1, "5strawberry,8starfruit", s.addr2, "", "p1"),
+				s.newTestPayment(s.addr1, "", s.addr3, "3tomato", "p2"),
+				s.newTestPayment(s.addr2, "1strawberry", nil, "", ""),
+				s.newTestPayment(s.longAddr1, "12starfruit", s.longAddr2, "7tangerine,2tomato", "p4"),
+			},
+			expCount: 4,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			s.requireSetPaymentsInStore(tc.payments...)
+			deleteFilterIndexEntries()
+
+			var count int
+			testFunc := func() {
+				count = s.k.IndexPaymentFilters(s.ctx)
+			}
+			s.Require().NotPanics(testFunc, "IndexPaymentFilters")
+			s.Assert().Equal(tc.expCount, count, "IndexPaymentFilters result")
+			s.assertPaymentFilterIndexEntriesMatchPayments()
+		})
+	}
+}
+
 func (s *TestSuite) TestKeeper_CalculatePaymentFees() {
 	tests := []struct {
 		name     string
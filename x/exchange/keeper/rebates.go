@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+// rebate is an amount to pay to a seller out of a market's account.
+type rebate struct {
+	recipient string
+	amount    sdk.Coins
+}
+
+// getFilledOrders gets all the orders (fully and partially) filled in a settlement.
+func getFilledOrders(settlement *exchange.Settlement) []*exchange.FilledOrder {
+	partials := settlement.GetPartialOrders()
+	rv := make([]*exchange.FilledOrder, 0, len(settlement.FullyFilledOrders)+len(partials))
+	rv = append(rv, settlement.FullyFilledOrders...)
+	for _, partial := range partials {
+		rv = append(rv, partial.Filled)
+	}
+	return rv
+}
+
+// isRebateEligible returns true if the seller of the provided ask order should get a rebate.
+// If there are rebate addresses, only those sellers are eligible (maker or taker).
+// Otherwise, only makers are eligible. An ask order is a maker if it is older than the newest
+// bid order in the settlement, or if there aren't any bid orders in the settlement (i.e. it's being filled by a taker).
+func isRebateEligible(askOrder *exchange.FilledOrder, rebateAddrs []string, newestBidID uint64) bool {
+	if len(rebateAddrs) > 0 {
+		return exchange.ContainsString(rebateAddrs, askOrder.GetOwner())
+	}
+	return newestBidID == 0 || askOrder.GetOrderID() < newestBidID
+}
+
+// calculateRebates figures out the rebates owed to the sellers in a settlement.
+// Rebates are combined by recipient and returned in the order the recipients first appear in the settlement.
+func calculateRebates(store storetypes.KVStore, marketID uint32, settlement *exchange.Settlement) ([]*rebate, error) {
+	orders := getFilledOrders(settlement)
+	var newestBidID uint64
+	hasAsk := false
+	for _, order := range orders {
+		if order.IsBidOrder() && order.GetOrderID() > newestBidID {
+			newestBidID = order.GetOrderID()
+		}
+		hasAsk = hasAsk || order.IsAskOrder()
+	}
+	if !hasAsk {
+		return nil, nil
+	}
+
+	rebateRatios := getSellerSettlementRebateRatios(store, marketID)
+	if len(rebateRatios) == 0 {
+		return nil, nil
+	}
+	rebateAddrs := getRebateAddresses(store, marketID)
+
+	var rv []*rebate
+	byRecipient := make(map[string]*rebate)
+	for _, order := range orders {
+		if !order.IsAskOrder() || !isRebateEligible(order, rebateAddrs, newestBidID) {
+			continue
+		}
+		price := order.GetPrice()
+		ratio := getSellerSettlementRebateRatio(store, marketID, price.Denom)
+		if ratio == nil {
+			continue
+		}
+		amount, err := ratio.ApplyToRoundingDown(price)
+		if err != nil {
+			return nil, fmt.Errorf("failed to calculate rebate for %s order %d: %w",
+				order.GetOrderType(), order.GetOrderID(), err)
+		}
+		if amount.IsZero() {
+			continue
+		}
+
+		seller := order.GetOwner()
+		if entry, known := byRecipient[seller]; known {
+			entry.amount = entry.amount.Add(amount)
+			continue
+		}
+		entry := &rebate{recipient: seller, amount: sdk.NewCoins(amount)}
+		byRecipient[seller] = entry
+		rv = append(rv, entry)
+	}
+	return rv, nil
+}
+
+// payRebates pays out the rebates owed to the sellers in a settlement from the market's account.
+// An error is returned if the market account does not have enough funds to cover all of them.
+func (k Keeper) payRebates(ctx sdk.Context, store storetypes.KVStore, marketID uint32, settlement *exchange.Settlement) error {
+	rebates, err := calculateRebates(store, marketID, settlement)
+	if err != nil || len(rebates) == 0 {
+		return err
+	}
+
+	var total sdk.Coins
+	outputs := make([]banktypes.Output, len(rebates))
+	for i, entry := range rebates {
+		total = total.Add(entry.amount...)
+		outputs[i] = banktypes.Output{Address: entry.recipient, Coins: entry.amount}
+	}
+
+	marketAddr := exchange.GetMarketAddress(marketID)
+	spendable := k.bankKeeper.SpendableCoins(ctx, marketAddr)
+	if !spendable.IsAllGTE(total) {
+		return fmt.Errorf("market %d account has insufficient funds to pay rebates %s: spendable balance %q",
+			marketID, total, spendable)
+	}
+
+	inputs := []banktypes.Input{{Address: marketAddr.String(), Coins: total}}
+	if err = k.DoTransfer(ctx, inputs, outputs); err != nil {
+		return fmt.Errorf("error paying rebates from market %d: %w", marketID, err)
+	}
+
+	for _, entry := range rebates {
+		k.emitEvent(ctx, exchange.NewEventRebatePaid(marketID, entry.recipient, entry.amount))
+	}
+	return nil
+}
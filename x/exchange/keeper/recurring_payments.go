@@ -0,0 +1,263 @@
+package keeper
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+// parseRecurringPaymentStoreValue converts a recurring payment store value into the RecurringPayment object.
+// If the value is empty then nil, nil is returned.
+func (k Keeper) parseRecurringPaymentStoreValue(value []byte) (*exchange.RecurringPayment, error) {
+	if len(value) == 0 {
+		return nil, nil
+	}
+
+	var rp exchange.RecurringPayment
+	err := k.cdc.Unmarshal(value, &rp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal recurring payment: %w", err)
+	}
+	return &rp, nil
+}
+
+// getRecurringPaymentFromStore gets a RecurringPayment from the store.
+func (k Keeper) getRecurringPaymentFromStore(store storetypes.KVStore, source sdk.AccAddress, externalID string) (*exchange.RecurringPayment, error) {
+	key := MakeKeyRecurringPayment(source, externalID)
+	value := store.Get(key)
+	return k.parseRecurringPaymentStoreValue(value)
+}
+
+// requireRecurringPaymentFromStore is like getRecurringPaymentFromStore but returns with an error if it does not exist.
+// This will always return either a recurring payment or error. It will never return both or nil, nil.
+func (k Keeper) requireRecurringPaymentFromStore(store storetypes.KVStore, source sdk.AccAddress, externalID string) (*exchange.RecurringPayment, error) {
+	rp, err := k.getRecurringPaymentFromStore(store, source, externalID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting existing recurring payment with source %s and external id %q: %w",
+			source, externalID, err)
+	}
+	if rp == nil {
+		return nil, fmt.Errorf("no recurring payment found with source %s and external id %q", source, externalID)
+	}
+	return rp, nil
+}
+
+// setRecurringPaymentInStore sets a recurring payment in the store making sure its index entry stays up to date.
+func (k Keeper) setRecurringPaymentInStore(store storetypes.KVStore, rp *exchange.RecurringPayment) error {
+	source, err := sdk.AccAddressFromBech32(rp.Source)
+	if err != nil {
+		return fmt.Errorf("invalid source %q: %w", rp.Source, err)
+	}
+	key := MakeKeyRecurringPayment(source, rp.ExternalId)
+	value, err := k.cdc.Marshal(rp)
+	if err != nil {
+		return fmt.Errorf("error marshaling recurring payment: %w", err)
+	}
+
+	iKey := MakeIndexKeyTransferTimeToRecurringPayment(rp.NextTransferTime, source, rp.ExternalId)
+	var oldIKey []byte
+	if existing, _ := k.getRecurringPaymentFromStore(store, source, rp.ExternalId); existing != nil {
+		oldIKey = MakeIndexKeyTransferTimeToRecurringPayment(existing.NextTransferTime, source, rp.ExternalId)
+		if bytes.Equal(oldIKey, iKey) {
+			oldIKey = nil
+		}
+	}
+
+	store.Set(key, value)
+	if len(oldIKey) > 0 {
+		store.Delete(oldIKey)
+	}
+	store.Set(iKey, []byte{})
+	return nil
+}
+
+// deleteRecurringPaymentFromStore deletes a recurring payment (and its index entry) from the state store.
+func deleteRecurringPaymentFromStore(store storetypes.KVStore, rp *exchange.RecurringPayment) error {
+	if rp == nil {
+		return errors.New("cannot delete nil recurring payment")
+	}
+	source, err := sdk.AccAddressFromBech32(rp.Source)
+	if err != nil {
+		return fmt.Errorf("invalid source %q: %w", rp.Source, err)
+	}
+	store.Delete(MakeKeyRecurringPayment(source, rp.ExternalId))
+	store.Delete(MakeIndexKeyTransferTimeToRecurringPayment(rp.NextTransferTime, source, rp.ExternalId))
+	return nil
+}
+
+// GetRecurringPayment gets a recurring payment from the state store. If it doesn't exist, nil, nil is returned.
+func (k Keeper) GetRecurringPayment(ctx sdk.Context, source sdk.AccAddress, externalID string) (*exchange.RecurringPayment, error) {
+	return k.getRecurringPaymentFromStore(k.getStore(ctx), source, externalID)
+}
+
+// CreateRecurringPayment stores the provided recurring payment in the state store.
+// Its next_transfer_time is when the first transfer will be made; it cannot be before the current block time.
+func (k Keeper) CreateRecurringPayment(ctx sdk.Context, rp *exchange.RecurringPayment) error {
+	if rp == nil {
+		return errors.New("cannot create nil recurring payment")
+	}
+	if err := rp.Validate(); err != nil {
+		return fmt.Errorf("cannot create invalid recurring payment: %w", err)
+	}
+	blockTime := ctx.BlockTime()
+	if rp.NextTransferTime.Unix() < blockTime.Unix() {
+		return fmt.Errorf("invalid next transfer time %s: cannot be before the current block time %s",
+			rp.NextTransferTime.UTC().Format(time.RFC3339), blockTime.UTC().Format(time.RFC3339))
+	}
+
+	store := k.getStore(ctx)
+	source, _ := sdk.AccAddressFromBech32(rp.Source)
+	if store.Has(MakeKeyRecurringPayment(source, rp.ExternalId)) {
+		return fmt.Errorf("a recurring payment already exists with source %s and external id %q", rp.Source, rp.ExternalId)
+	}
+
+	if err := k.setRecurringPaymentInStore(store, rp); err != nil {
+		return fmt.Errorf("failed to create recurring payment: %w", err)
+	}
+
+	k.emitEvent(ctx, exchange.NewEventRecurringPaymentCreated(rp))
+	return nil
+}
+
+// CancelRecurringPayments deletes the recurring payments for a source and set of external ids.
+// There must be at least one external id and there must be a recurring payment for each external id (and source).
+func (k Keeper) CancelRecurringPayments(ctx sdk.Context, source sdk.AccAddress, externalIDs []string) error {
+	if len(source) == 0 {
+		return errors.New("a source is required in order to cancel recurring payments")
+	}
+	if len(externalIDs) == 0 {
+		return errors.New("at least one external id is required")
+	}
+
+	store := k.getStore(ctx)
+	rps := make([]*exchange.RecurringPayment, 0, len(externalIDs))
+	seen := make(map[string]bool)
+	for _, externalID := range externalIDs {
+		if seen[externalID] {
+			continue
+		}
+		seen[externalID] = true
+		rp, err := k.requireRecurringPaymentFromStore(store, source, externalID)
+		if err != nil {
+			return err
+		}
+		rps = append(rps, rp)
+	}
+
+	for _, rp := range rps {
+		if err := deleteRecurringPaymentFromStore(store, rp); err != nil {
+			return fmt.Errorf("error deleting recurring payment with source %s and external id %q: %w",
+				rp.Source, rp.ExternalId, err)
+		}
+	}
+
+	emitEvents(k, ctx, exchange.NewEventsRecurringPaymentsCancelled(rps))
+	return nil
+}
+
+// ProcessRecurringPayments makes the transfers of all recurring payments with a next transfer time at or before the
+// block time. At most limit transfers are attempted (0 = no limit); any others are picked up by a later call.
+// Each recurring payment has at most one transfer made per call, even if it is more than one interval behind.
+// Returns the number of transfers that were successfully made.
+func (k Keeper) ProcessRecurringPayments(ctx sdk.Context, limit int) int {
+	store := k.getStore(ctx)
+	start := GetIndexKeyPrefixTransferTimeToRecurringPayment()
+	end := storetypes.PrefixEndBytes(GetIndexKeyPrefixTransferTimeToRecurringPaymentAt(ctx.BlockTime()))
+
+	// Gather the keys first so we aren't writing to the store while iterating it.
+	var keys [][]byte
+	iter := store.Iterator(start, end)
+	for ; iter.Valid() && (limit == 0 || len(keys) < limit); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	count := 0
+	for _, key := range keys {
+		transferTime, source, externalID, err := ParseIndexKeyTransferTimeToRecurringPayment(key)
+		if err != nil {
+			k.logErrorf(ctx, "invalid recurring payment transfer time index entry %v (deleted): %v", key, err)
+			store.Delete(key)
+			continue
+		}
+
+		rp, err := k.getRecurringPaymentFromStore(store, source, externalID)
+		if err != nil || rp == nil {
+			k.logErrorf(ctx, "could not get recurring payment with source %s and external id %q (index entry deleted): %v",
+				source, externalID, err)
+			store.Delete(key)
+			continue
+		}
+		if rp.NextTransferTime.Unix() != transferTime.Unix() {
+			k.logErrorf(ctx, "stale recurring payment transfer time index entry %v (deleted)", key)
+			store.Delete(key)
+			continue
+		}
+
+		if k.processRecurringPayment(ctx, rp) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// processRecurringPayment attempts the next transfer of a recurring payment and updates (or deletes) it accordingly.
+// Returns true if the transfer was made.
+func (k Keeper) processRecurringPayment(ctx sdk.Context, rp *exchange.RecurringPayment) bool {
+	source, _ := sdk.AccAddressFromBech32(rp.Source)
+	target, _ := sdk.AccAddressFromBech32(rp.Target)
+	store := k.getStore(ctx)
+	// The index entry is keyed on the current next transfer time, so delete it before we change that.
+	err := deleteRecurringPaymentFromStore(store, rp)
+	if err != nil {
+		k.logErrorf(ctx, "could not process recurring payment with source %s and external id %q: %v", source, rp.ExternalId, err)
+		return false
+	}
+
+	rp.NextTransferTime = rp.NextTransferTime.Add(time.Duration(rp.IntervalSeconds) * time.Second) //nolint:gosec // G115: Overflow just results in a weird time.
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	err = k.bankKeeper.SendCoins(cacheCtx, source, target, rp.Amount)
+	sent := err == nil
+	if sent {
+		writeCache()
+		rp.TransfersRemaining--
+		rp.Failures = 0
+		k.emitEvent(ctx, exchange.NewEventRecurringPaymentTransferred(rp))
+	} else {
+		rp.Failures++
+		k.emitEvent(ctx, exchange.NewEventRecurringPaymentFailed(rp, err))
+		if rp.Failures >= exchange.MaxRecurringPaymentFailures {
+			k.emitEvent(ctx, exchange.NewEventRecurringPaymentCancelled(rp))
+			return false
+		}
+	}
+
+	if rp.TransfersRemaining > 0 {
+		if err = k.setRecurringPaymentInStore(store, rp); err != nil {
+			k.logErrorf(ctx, "could not update recurring payment with source %s and external id %q: %v", source, rp.ExternalId, err)
+		}
+	}
+
+	return sent
+}
+
+// IterateRecurringPayments iterates over all recurring payments.
+// The callback takes in the recurring payment and should return whether to stop iterating.
+func (k Keeper) IterateRecurringPayments(ctx sdk.Context, cb func(rp *exchange.RecurringPayment) bool) {
+	k.iterate(ctx, GetKeyPrefixAllRecurringPayments(), func(_, value []byte) bool {
+		rp, err := k.parseRecurringPaymentStoreValue(value)
+		if err != nil || rp == nil {
+			return false
+		}
+		return cb(rp)
+	})
+}
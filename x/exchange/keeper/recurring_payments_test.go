@@ -0,0 +1,425 @@
+package keeper_test
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+	"github.com/provenance-io/provenance/x/exchange"
+	"github.com/provenance-io/provenance/x/exchange/keeper"
+)
+
+// newTestRecurringPayment creates a new RecurringPayment using the provided info and a one hour interval.
+func (s *TestSuite) newTestRecurringPayment(source, target sdk.AccAddress, amount string, externalID string,
+	transfers uint32, nextTransferTime time.Time,
+) *exchange.RecurringPayment {
+	s.T().Helper()
+	return &exchange.RecurringPayment{
+		Source:             source.String(),
+		Target:             target.String(),
+		Amount:             s.coins(amount),
+		ExternalId:         externalID,
+		IntervalSeconds:    3600,
+		TransfersRemaining: transfers,
+		NextTransferTime:   nextTransferTime,
+	}
+}
+
+// requireSetRecurringPaymentsInStore calls setRecurringPaymentInStore on each recurring payment,
+// making sure it doesn't panic or return an error.
+func (s *TestSuite) requireSetRecurringPaymentsInStore(rps ...*exchange.RecurringPayment) {
+	for i, rp := range rps {
+		assertions.RequireNotPanicsNoErrorf(s.T(), func() error {
+			return s.k.SetRecurringPaymentInStore(s.getStore(), rp)
+		}, "[%d]: SetRecurringPaymentInStore(%s %q)", i, rp.Source, rp.ExternalId)
+	}
+}
+
+// getAllRecurringPayments gets all the recurring payments currently in state.
+func (s *TestSuite) getAllRecurringPayments() []*exchange.RecurringPayment {
+	var rv []*exchange.RecurringPayment
+	s.k.IterateRecurringPayments(s.ctx, func(rp *exchange.RecurringPayment) bool {
+		rv = append(rv, rp)
+		return false
+	})
+	return rv
+}
+
+// assertTransferTimeToRecurringPaymentIndexEntriesMatch gets all the recurring payments and transfer time
+// index entries from state and makes sure that they're all as they should be.
+func (s *TestSuite) assertTransferTimeToRecurringPaymentIndexEntriesMatch() bool {
+	s.T().Helper()
+	var expKeys [][]byte
+	for _, rp := range s.getAllRecurringPayments() {
+		source, _ := sdk.AccAddressFromBech32(rp.Source)
+		if len(source) > 0 {
+			expKeys = append(expKeys, keeper.MakeIndexKeyTransferTimeToRecurringPayment(rp.NextTransferTime, source, rp.ExternalId))
+		}
+	}
+	sort.Slice(expKeys, func(i, j int) bool {
+		return bytes.Compare(expKeys[i], expKeys[j]) < 0
+	})
+
+	var actKeys [][]byte
+	keyPrefix := keeper.GetIndexKeyPrefixTransferTimeToRecurringPayment()
+	keeper.Iterate(s.getStore(), keyPrefix, func(keySuffix, _ []byte) bool {
+		actKeys = append(actKeys, concatBz(keyPrefix, keySuffix))
+		return false
+	})
+
+	keyStringer := func(key []byte) string {
+		transferTime, source, externalID, err := keeper.ParseIndexKeyTransferTimeToRecurringPayment(key)
+		if err != nil {
+			return fmt.Sprintf("%v", key)
+		}
+		return fmt.Sprintf("%s %s %q", transferTime.Format(time.RFC3339), s.getAddrName(source), externalID)
+	}
+	return assertEqualSlice(s, expKeys, actKeys, keyStringer, "transfer time to recurring payment index entries")
+}
+
+func (s *TestSuite) TestKeeper_CreateRecurringPayment() {
+	blockTime := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	existing := s.newTestRecurringPayment(s.addr1, s.addr2, "5strawberry", "dup", 3, blockTime)
+
+	tests := []struct {
+		name   string
+		rp     *exchange.RecurringPayment
+		expErr string
+	}{
+		{
+			name:   "nil recurring payment",
+			rp:     nil,
+			expErr: "cannot create nil recurring payment",
+		},
+		{
+			name: "invalid recurring payment",
+			rp:   s.newTestRecurringPayment(s.addr1, s.addr2, "5strawberry", "x", 0, blockTime),
+			expErr: "cannot create invalid recurring payment: " +
+				"invalid transfers remaining: cannot be zero",
+		},
+		{
+			name: "next transfer time before block time",
+			rp:   s.newTestRecurringPayment(s.addr1, s.addr2, "5strawberry", "x", 3, blockTime.Add(-1*time.Second)),
+			expErr: "invalid next transfer time 2030-01-01T11:59:59Z: " +
+				"cannot be before the current block time 2030-01-01T12:00:00Z",
+		},
+		{
+			name: "already exists",
+			rp:   s.newTestRecurringPayment(s.addr1, s.addr3, "7strawberry", "dup", 5, blockTime.Add(time.Hour)),
+			expErr: "a recurring payment already exists with source " + s.addr1.String() +
+				" and external id \"dup\"",
+		},
+		{
+			name: "starts now",
+			rp:   s.newTestRecurringPayment(s.addr1, s.addr3, "7strawberry", "now", 5, blockTime),
+		},
+		{
+			name: "starts later, same external id as another source",
+			rp:   s.newTestRecurringPayment(s.addr2, s.addr3, "1tangerine", "dup", 12, blockTime.Add(24*time.Hour)),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			s.requireSetRecurringPaymentsInStore(existing)
+
+			var expEvents sdk.Events
+			expRPs := []*exchange.RecurringPayment{existing}
+			if len(tc.expErr) == 0 {
+				expEvents = append(expEvents, s.untypeEvent(exchange.NewEventRecurringPaymentCreated(tc.rp)))
+				expRPs = append(expRPs, tc.rp)
+				sort.Slice(expRPs, func(i, j int) bool {
+					return expRPs[i].Source < expRPs[j].Source
+				})
+			}
+
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em).WithBlockTime(blockTime)
+			var err error
+			testFunc := func() {
+				err = s.k.CreateRecurringPayment(ctx, tc.rp)
+			}
+			s.Require().NotPanics(testFunc, "CreateRecurringPayment")
+			s.assertErrorValue(err, tc.expErr, "CreateRecurringPayment error")
+			s.assertEqualEvents(expEvents, em.Events(), "CreateRecurringPayment events")
+			s.Assert().ElementsMatch(expRPs, s.getAllRecurringPayments(), "recurring payments in state")
+			s.assertTransferTimeToRecurringPaymentIndexEntriesMatch()
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_CancelRecurringPayments() {
+	nextTime := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	rp1a := s.newTestRecurringPayment(s.addr1, s.addr2, "5strawberry", "a", 3, nextTime)
+	rp1b := s.newTestRecurringPayment(s.addr1, s.addr3, "6strawberry", "b", 4, nextTime.Add(time.Hour))
+	rp2a := s.newTestRecurringPayment(s.addr2, s.addr3, "7strawberry", "a", 5, nextTime)
+	all := []*exchange.RecurringPayment{rp1a, rp1b, rp2a}
+
+	tests := []struct {
+		name        string
+		source      sdk.AccAddress
+		externalIDs []string
+		expErr      string
+		expCanc     []*exchange.RecurringPayment
+	}{
+		{
+			name:        "nil source",
+			source:      nil,
+			externalIDs: []string{"a"},
+			expErr:      "a source is required in order to cancel recurring payments",
+		},
+		{
+			name:        "no external ids",
+			source:      s.addr1,
+			externalIDs: nil,
+			expErr:      "at least one external id is required",
+		},
+		{
+			name:        "unknown external id",
+			source:      s.addr1,
+			externalIDs: []string{"a", "c"},
+			expErr:      "no recurring payment found with source " + s.addr1.String() + " and external id \"c\"",
+		},
+		{
+			name:        "other source's recurring payment",
+			source:      s.addr3,
+			externalIDs: []string{"a"},
+			expErr:      "no recurring payment found with source " + s.addr3.String() + " and external id \"a\"",
+		},
+		{
+			name:        "one",
+			source:      s.addr2,
+			externalIDs: []string{"a"},
+			expCanc:     []*exchange.RecurringPayment{rp2a},
+		},
+		{
+			name:        "two with a duplicate",
+			source:      s.addr1,
+			externalIDs: []string{"b", "a", "b"},
+			expCanc:     []*exchange.RecurringPayment{rp1b, rp1a},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			s.requireSetRecurringPaymentsInStore(all...)
+
+			var expEvents sdk.Events
+			expRemain := all
+			if len(tc.expErr) == 0 {
+				expRemain = nil
+				for _, rp := range tc.expCanc {
+					expEvents = append(expEvents, s.untypeEvent(exchange.NewEventRecurringPaymentCancelled(rp)))
+				}
+				for _, rp := range all {
+					cancelled := false
+					for _, canc := range tc.expCanc {
+						if rp == canc {
+							cancelled = true
+						}
+					}
+					if !cancelled {
+						expRemain = append(expRemain, rp)
+					}
+				}
+			}
+
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			var err error
+			testFunc := func() {
+				err = s.k.CancelRecurringPayments(ctx, tc.source, tc.externalIDs)
+			}
+			s.Require().NotPanics(testFunc, "CancelRecurringPayments")
+			s.assertErrorValue(err, tc.expErr, "CancelRecurringPayments error")
+			s.assertEqualEvents(expEvents, em.Events(), "CancelRecurringPayments events")
+			s.Assert().Equal(expRemain, s.getAllRecurringPayments(), "recurring payments in state")
+			s.assertTransferTimeToRecurringPaymentIndexEntriesMatch()
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_ProcessRecurringPayments() {
+	blockTime := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	newRP := func(source sdk.AccAddress, externalID string, transfers, failures uint32, offset time.Duration) *exchange.RecurringPayment {
+		rv := s.newTestRecurringPayment(source, s.addr5, "3strawberry", externalID, transfers, blockTime.Add(offset))
+		rv.Failures = failures
+		return rv
+	}
+	// advanced returns a copy of the provided recurring payment after an interval has passed.
+	advanced := func(rp *exchange.RecurringPayment, transfers, failures uint32) *exchange.RecurringPayment {
+		rv := *rp
+		rv.NextTransferTime = rv.NextTransferTime.Add(time.Hour)
+		rv.TransfersRemaining = transfers
+		rv.Failures = failures
+		return &rv
+	}
+	sendArgs := func(source sdk.AccAddress) *SendCoinsArgs {
+		return &SendCoinsArgs{fromAddr: source, toAddr: s.addr5, amt: s.coins("3strawberry")}
+	}
+
+	notDue := newRP(s.addr1, "later", 3, 0, time.Minute)
+	due := newRP(s.addr1, "due", 3, 0, -1*time.Minute)
+	dueNow := newRP(s.addr2, "now", 2, 1, 0)
+	lastOne := newRP(s.addr3, "last", 1, 0, -1*time.Hour)
+	failedTwice := newRP(s.addr4, "failing", 5, 2, -2*time.Minute)
+
+	tests := []struct {
+		name       string
+		rps        []*exchange.RecurringPayment
+		setup      func()
+		bankKeeper *MockBankKeeper
+		limit      int
+		expCount   int
+		expSends   []*SendCoinsArgs
+		expEvents  []proto.Message
+		expRPs     []*exchange.RecurringPayment
+		expDelKeys [][]byte
+	}{
+		{
+			name:     "no recurring payments",
+			limit:    10,
+			expCount: 0,
+		},
+		{
+			name:     "nothing due yet",
+			rps:      []*exchange.RecurringPayment{notDue},
+			limit:    10,
+			expCount: 0,
+			expRPs:   []*exchange.RecurringPayment{notDue},
+		},
+		{
+			name:     "some due",
+			rps:      []*exchange.RecurringPayment{notDue, due, dueNow, lastOne},
+			limit:    10,
+			expCount: 3,
+			expSends: []*SendCoinsArgs{sendArgs(s.addr3), sendArgs(s.addr1), sendArgs(s.addr2)},
+			expEvents: []proto.Message{
+				exchange.NewEventRecurringPaymentTransferred(advanced(lastOne, 0, 0)),
+				exchange.NewEventRecurringPaymentTransferred(advanced(due, 2, 0)),
+				exchange.NewEventRecurringPaymentTransferred(advanced(dueNow, 1, 0)),
+			},
+			expRPs: []*exchange.RecurringPayment{notDue, advanced(due, 2, 0), advanced(dueNow, 1, 0)},
+		},
+		{
+			name:     "more due than the limit",
+			rps:      []*exchange.RecurringPayment{due, dueNow, lastOne},
+			limit:    2,
+			expCount: 2,
+			expSends: []*SendCoinsArgs{sendArgs(s.addr3), sendArgs(s.addr1)},
+			expEvents: []proto.Message{
+				exchange.NewEventRecurringPaymentTransferred(advanced(lastOne, 0, 0)),
+				exchange.NewEventRecurringPaymentTransferred(advanced(due, 2, 0)),
+			},
+			expRPs: []*exchange.RecurringPayment{advanced(due, 2, 0), dueNow},
+		},
+		{
+			name:       "transfer fails",
+			rps:        []*exchange.RecurringPayment{due},
+			bankKeeper: NewMockBankKeeper().WithSendCoinsResults("insufficient funds"),
+			limit:      10,
+			expCount:   0,
+			expSends:   []*SendCoinsArgs{sendArgs(s.addr1)},
+			expEvents: []proto.Message{
+				exchange.NewEventRecurringPaymentFailed(advanced(due, 3, 1), fmt.Errorf("insufficient funds")),
+			},
+			expRPs: []*exchange.RecurringPayment{advanced(due, 3, 1)},
+		},
+		{
+			name:       "transfer fails too many times",
+			rps:        []*exchange.RecurringPayment{failedTwice, dueNow},
+			bankKeeper: NewMockBankKeeper().WithSendCoinsResults("insufficient funds", ""),
+			limit:      10,
+			expCount:   1,
+			expSends:   []*SendCoinsArgs{sendArgs(s.addr4), sendArgs(s.addr2)},
+			expEvents: []proto.Message{
+				exchange.NewEventRecurringPaymentFailed(advanced(failedTwice, 5, 3), fmt.Errorf("insufficient funds")),
+				exchange.NewEventRecurringPaymentCancelled(advanced(failedTwice, 5, 3)),
+				exchange.NewEventRecurringPaymentTransferred(advanced(dueNow, 1, 0)),
+			},
+			expRPs: []*exchange.RecurringPayment{advanced(dueNow, 1, 0)},
+		},
+		{
+			name: "invalid index entry",
+			rps:  []*exchange.RecurringPayment{due},
+			setup: func() {
+				key := keeper.GetIndexKeyPrefixTransferTimeToRecurringPaymentAt(blockTime.Add(-2 * time.Minute))
+				s.getStore().Set(append(key, 20, 'x'), []byte{})
+			},
+			limit:     10,
+			expCount:  1,
+			expSends:  []*SendCoinsArgs{sendArgs(s.addr1)},
+			expEvents: []proto.Message{exchange.NewEventRecurringPaymentTransferred(advanced(due, 2, 0))},
+			expRPs:    []*exchange.RecurringPayment{advanced(due, 2, 0)},
+			expDelKeys: [][]byte{
+				append(keeper.GetIndexKeyPrefixTransferTimeToRecurringPaymentAt(blockTime.Add(-2*time.Minute)), 20, 'x'),
+			},
+		},
+		{
+			name: "index entry without a recurring payment",
+			setup: func() {
+				key := keeper.MakeIndexKeyTransferTimeToRecurringPayment(blockTime.Add(-2*time.Minute), s.addr2, "gone")
+				s.getStore().Set(key, []byte{})
+			},
+			limit:      10,
+			expCount:   0,
+			expDelKeys: [][]byte{keeper.MakeIndexKeyTransferTimeToRecurringPayment(blockTime.Add(-2*time.Minute), s.addr2, "gone")},
+		},
+		{
+			name: "stale index entry",
+			rps:  []*exchange.RecurringPayment{notDue},
+			setup: func() {
+				key := keeper.MakeIndexKeyTransferTimeToRecurringPayment(blockTime.Add(-2*time.Minute), s.addr1, "later")
+				s.getStore().Set(key, []byte{})
+			},
+			limit:      10,
+			expCount:   0,
+			expRPs:     []*exchange.RecurringPayment{notDue},
+			expDelKeys: [][]byte{keeper.MakeIndexKeyTransferTimeToRecurringPayment(blockTime.Add(-2*time.Minute), s.addr1, "later")},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			s.requireSetRecurringPaymentsInStore(tc.rps...)
+			if tc.setup != nil {
+				tc.setup()
+			}
+			if tc.bankKeeper == nil {
+				tc.bankKeeper = NewMockBankKeeper()
+			}
+
+			expEvents := make(sdk.Events, len(tc.expEvents))
+			for i, tev := range tc.expEvents {
+				expEvents[i] = s.untypeEvent(tev)
+			}
+
+			kpr := s.k.WithBankKeeper(tc.bankKeeper)
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em).WithBlockTime(blockTime)
+			var count int
+			testFunc := func() {
+				count = kpr.ProcessRecurringPayments(ctx, tc.limit)
+			}
+			s.Require().NotPanics(testFunc, "ProcessRecurringPayments(%d)", tc.limit)
+			s.Assert().Equal(tc.expCount, count, "ProcessRecurringPayments(%d) result", tc.limit)
+			s.assertEqualEvents(expEvents, em.Events(), "ProcessRecurringPayments(%d) events", tc.limit)
+			s.assertBankKeeperCalls(tc.bankKeeper, BankCalls{SendCoins: tc.expSends}, "ProcessRecurringPayments(%d)", tc.limit)
+
+			store := s.getStore()
+			for i, key := range tc.expDelKeys {
+				s.Assert().False(store.Has(key), "[%d]: store.Has(%v) after process", i, key)
+			}
+			s.Assert().ElementsMatch(tc.expRPs, s.getAllRecurringPayments(), "recurring payments after ProcessRecurringPayments(%d)", tc.limit)
+			s.assertTransferTimeToRecurringPaymentIndexEntriesMatch()
+		})
+	}
+}
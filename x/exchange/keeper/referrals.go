@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+// referral is an amount to pay to an order's referrer out of a market's account.
+type referral struct {
+	order  *exchange.FilledOrder
+	amount sdk.Coins
+}
+
+// calculateReferralAmount figures out how much should be paid to the referrer of an order that paid the provided fees.
+// The referrer gets the bips portion of what's left of the fees after the exchange takes its split (rounded down),
+// limited by the market's referral cap.
+func (k Keeper) calculateReferralAmount(ctx sdk.Context, store storetypes.KVStore, marketID uint32, bips uint32, fees sdk.Coins) sdk.Coins {
+	marketShare := fees.Sub(k.CalculateExchangeSplit(ctx, marketID, fees)...)
+	bipsInt := sdkmath.NewIntFromUint64(uint64(bips))
+	var rv sdk.Coins
+	for _, coin := range marketShare {
+		amt := coin.Amount.Mul(bipsInt).Quo(TenKInt)
+		if capAmt := getReferralCapAmount(store, marketID, coin.Denom); capAmt != nil && amt.GT(capAmt.Amount) {
+			amt = capAmt.Amount
+		}
+		if amt.IsPositive() {
+			rv = rv.Add(sdk.NewCoin(coin.Denom, amt))
+		}
+	}
+	return rv
+}
+
+// calculateReferrals figures out the referral fees owed for the orders in a settlement.
+func (k Keeper) calculateReferrals(ctx sdk.Context, store storetypes.KVStore, marketID uint32, settlement *exchange.Settlement) []*referral {
+	bips := getReferralBips(store, marketID)
+	if bips == 0 {
+		return nil
+	}
+
+	var rv []*referral
+	for _, order := range getFilledOrders(settlement) {
+		if len(order.GetReferrer()) == 0 {
+			continue
+		}
+		amount := k.calculateReferralAmount(ctx, store, marketID, bips, order.GetSettlementFees())
+		if !amount.IsZero() {
+			rv = append(rv, &referral{order: order, amount: amount})
+		}
+	}
+	return rv
+}
+
+// payReferrals pays the referrers of the orders in a settlement their portion of the market's settlement fees.
+// This should only be called after the settlement fees have been collected.
+// Referrals are limited to what the market account can spend. Once those funds run out, the remaining
+// referrals are reduced or skipped (and logged) rather than failing the settlement.
+func (k Keeper) payReferrals(ctx sdk.Context, store storetypes.KVStore, marketID uint32, settlement *exchange.Settlement) error {
+	referrals := k.calculateReferrals(ctx, store, marketID, settlement)
+	if len(referrals) == 0 {
+		return nil
+	}
+
+	marketAddr := exchange.GetMarketAddress(marketID)
+	available := k.bankKeeper.SpendableCoins(ctx, marketAddr)
+
+	var paid []*referral
+	var total sdk.Coins
+	var outputs []banktypes.Output
+	outputIndexes := make(map[string]int)
+	for _, entry := range referrals {
+		amount := entry.amount.Min(available)
+		if !amount.Equal(entry.amount) {
+			k.logErrorf(ctx, "market %d account has insufficient funds to pay referral of %s for order %d: paying %q instead",
+				marketID, entry.amount, entry.order.GetOrderID(), amount)
+		}
+		if amount.IsZero() {
+			continue
+		}
+		available = available.Sub(amount...)
+		entry.amount = amount
+		paid = append(paid, entry)
+
+		total = total.Add(amount...)
+		referrer := entry.order.GetReferrer()
+		if i, known := outputIndexes[referrer]; known {
+			outputs[i].Coins = outputs[i].Coins.Add(amount...)
+			continue
+		}
+		outputIndexes[referrer] = len(outputs)
+		outputs = append(outputs, banktypes.Output{Address: referrer, Coins: amount})
+	}
+	if len(paid) == 0 {
+		return nil
+	}
+
+	inputs := []banktypes.Input{{Address: marketAddr.String(), Coins: total}}
+	if err := k.DoTransfer(ctx, inputs, outputs); err != nil {
+		return fmt.Errorf("error paying referral fees from market %d: %w", marketID, err)
+	}
+
+	for _, entry := range paid {
+		k.emitEvent(ctx, exchange.NewEventReferralPaid(entry.order, entry.amount))
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+// preventSelfTrade applies the provided self-trade prevention mode to two crossing orders that have the same owner.
+// The orders are cancelled or reduced as needed, and what's left of each is returned (nil = no longer in the book).
+func (k Keeper) preventSelfTrade(ctx sdk.Context, store storetypes.KVStore, mode exchange.SelfTradePrevention,
+	newer, older *exchange.Order,
+) (*exchange.Order, *exchange.Order, error) {
+	newerLeft, olderLeft, err := exchange.ResolveSelfTrade(mode, newer, older)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = k.applySelfTradeResult(ctx, store, newer, newerLeft, older.OrderId); err != nil {
+		return nil, nil, err
+	}
+	if err = k.applySelfTradeResult(ctx, store, older, olderLeft, newer.OrderId); err != nil {
+		return nil, nil, err
+	}
+
+	// Cancelling one of the orders also cancels its linked order, which might be the other one.
+	if newerLeft != nil && !store.Has(MakeKeyOrder(newer.OrderId)) {
+		newerLeft = nil
+	}
+	if olderLeft != nil && !store.Has(MakeKeyOrder(older.OrderId)) {
+		olderLeft = nil
+	}
+	return newerLeft, olderLeft, nil
+}
+
+// applySelfTradeResult updates the book for what's left of an order after self-trade prevention.
+// If nothing is left, the order is cancelled. If what's left is smaller, the order is reduced.
+func (k Keeper) applySelfTradeResult(ctx sdk.Context, store storetypes.KVStore, order, left *exchange.Order, opposingOrderID uint64) error {
+	if left == order || !store.Has(MakeKeyOrder(order.OrderId)) {
+		return nil
+	}
+
+	ownerAddr, err := sdk.AccAddressFromBech32(order.GetOwner())
+	if err != nil {
+		return fmt.Errorf("invalid %s order %d owner %q: %w", order.GetOrderType(), order.OrderId, order.GetOwner(), err)
+	}
+
+	if left == nil {
+		if err = k.holdKeeper.ReleaseHold(ctx, ownerAddr, order.GetHoldAmount()); err != nil {
+			return fmt.Errorf("unable to release hold on order %d funds: %w", order.OrderId, err)
+		}
+		deleteAndDeIndexOrder(store, *order)
+		k.emitEvent(ctx, exchange.NewEventSelfTradePrevented(order, opposingOrderID, order.GetAssets()))
+		if err = k.Hooks().OnOrderCancelled(ctx, order, ""); err != nil {
+			return err
+		}
+		if err = k.cancelLinkedOrder(ctx, order); err != nil {
+			return err
+		}
+		incOrderActionCounter(order, exchange.TelemetryActionCancelled)
+		return nil
+	}
+
+	toRelease, hasNeg := order.GetHoldAmount().SafeSub(left.GetHoldAmount()...)
+	if hasNeg {
+		return fmt.Errorf("order %d hold %q is less than the %q needed after self-trade prevention",
+			order.OrderId, order.GetHoldAmount(), left.GetHoldAmount())
+	}
+	if !toRelease.IsZero() {
+		if err = k.holdKeeper.ReleaseHold(ctx, ownerAddr, toRelease); err != nil {
+			return fmt.Errorf("unable to release hold on order %d funds: %w", order.OrderId, err)
+		}
+	}
+	if err = k.setOrderInStore(store, *left); err != nil {
+		return err
+	}
+	removed := order.GetAssets().Sub(left.GetAssets())
+	k.emitEvent(ctx, exchange.NewEventSelfTradePrevented(order, opposingOrderID, removed))
+	return nil
+}
+
+// preventBatchAuctionSelfTrades applies the provided self-trade prevention mode to each crossing ask and bid
+// order that have the same owner. Pairs are handled in order id order, with the newer order of each pair taking
+// the place of the order that would take liquidity. What's left of the provided orders is returned.
+func (k Keeper) preventBatchAuctionSelfTrades(ctx sdk.Context, store storetypes.KVStore, mode exchange.SelfTradePrevention,
+	askOrders, bidOrders []*exchange.Order,
+) ([]*exchange.Order, []*exchange.Order, error) {
+	orders := make([]*exchange.Order, 0, len(askOrders)+len(bidOrders))
+	orders = append(orders, askOrders...)
+	orders = append(orders, bidOrders...)
+	sort.Slice(orders, func(i, j int) bool {
+		return orders[i].OrderId < orders[j].OrderId
+	})
+
+	var err error
+	for i := range orders {
+		for j := 0; j < i && orders[i] != nil; j++ {
+			older := orders[j]
+			if older == nil || older.IsAskOrder() == orders[i].IsAskOrder() || older.GetOwner() != orders[i].GetOwner() {
+				continue
+			}
+			askOrder, bidOrder := older, orders[i]
+			if bidOrder.IsAskOrder() {
+				askOrder, bidOrder = bidOrder, askOrder
+			}
+			if !isOrderCrossedBy(askOrder, bidOrder) {
+				continue
+			}
+			orders[i], orders[j], err = k.preventSelfTrade(ctx, store, mode, orders[i], older)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	var asksLeft, bidsLeft []*exchange.Order
+	for _, order := range orders {
+		// An order might have been cancelled because an order linked to it was.
+		if order == nil || !store.Has(MakeKeyOrder(order.OrderId)) {
+			continue
+		}
+		if order.IsAskOrder() {
+			asksLeft = append(asksLeft, order)
+		} else {
+			bidsLeft = append(bidsLeft, order)
+		}
+	}
+	return asksLeft, bidsLeft, nil
+}
+
+// validateNoSelfTrades returns an error if any of the provided ask orders has the same owner as any of the bid orders.
+func validateNoSelfTrades(askOrders, bidOrders []*exchange.Order) error {
+	askByOwner := make(map[string]uint64, len(askOrders))
+	for _, askOrder := range askOrders {
+		if _, known := askByOwner[askOrder.GetOwner()]; !known {
+			askByOwner[askOrder.GetOwner()] = askOrder.OrderId
+		}
+	}
+	for _, bidOrder := range bidOrders {
+		if askOrderID, found := askByOwner[bidOrder.GetOwner()]; found {
+			return fmt.Errorf("ask order %d and bid order %d have the same owner %s",
+				askOrderID, bidOrder.OrderId, bidOrder.GetOwner())
+		}
+	}
+	return nil
+}
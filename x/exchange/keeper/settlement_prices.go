@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+// parseSettlementPriceStoreValue converts a settlement price store value into a SettlementPrice.
+func (k Keeper) parseSettlementPriceStoreValue(value []byte) (*exchange.SettlementPrice, error) {
+	var price exchange.SettlementPrice
+	if err := k.cdc.Unmarshal(value, &price); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal settlement price: %w", err)
+	}
+	return &price, nil
+}
+
+// getSettlementPriceFromStore gets a settlement price from the store. Returns nil, nil if it does not exist.
+func (k Keeper) getSettlementPriceFromStore(store storetypes.KVStore, key []byte) (*exchange.SettlementPrice, error) {
+	value := store.Get(key)
+	if len(value) == 0 {
+		return nil, nil
+	}
+	return k.parseSettlementPriceStoreValue(value)
+}
+
+// setSettlementPriceInStore writes a settlement price to the store.
+func (k Keeper) setSettlementPriceInStore(store storetypes.KVStore, price exchange.SettlementPrice) error {
+	value, err := k.cdc.Marshal(&price)
+	if err != nil {
+		return fmt.Errorf("failed to marshal settlement price: %w", err)
+	}
+	store.Set(MakeKeySettlementPrice(price.MarketId, price.Assets.Denom, price.Price.Denom, price.Time), value)
+	return nil
+}
+
+// recordSettlementPrices adds the provided navs to the settlement prices of the market at the current block time.
+// Old settlement prices for those denom pairs are also deleted.
+// Problems are logged, but otherwise ignored so that they don't prevent the settlement.
+func (k Keeper) recordSettlementPrices(ctx sdk.Context, store storetypes.KVStore, marketID uint32, navs []exchange.NetAssetPrice) {
+	blockTime := ctx.BlockTime()
+	for _, nav := range navs {
+		if !nav.Assets.Amount.IsPositive() || !nav.Price.Amount.IsPositive() {
+			continue
+		}
+
+		price := exchange.NewSettlementPrice(marketID, blockTime, nav)
+		key := MakeKeySettlementPrice(marketID, nav.Assets.Denom, nav.Price.Denom, blockTime)
+		existing, err := k.getSettlementPriceFromStore(store, key)
+		if err != nil {
+			k.logErrorf(ctx, "error reading existing settlement price of %q for %q in market %d: %v",
+				nav.Assets.Denom, nav.Price.Denom, marketID, err)
+		}
+		if existing != nil {
+			price.Assets = price.Assets.Add(existing.Assets)
+			price.Price = price.Price.Add(existing.Price)
+		}
+
+		if err = k.setSettlementPriceInStore(store, *price); err != nil {
+			k.logErrorf(ctx, "error recording settlement price of %q for %q in market %d: %v",
+				nav.Assets, nav.Price, marketID, err)
+			continue
+		}
+		pruneSettlementPrices(store, marketID, nav.Assets.Denom, nav.Price.Denom, blockTime.Add(-exchange.SettlementPriceMaxAge))
+	}
+}
+
+// pruneSettlementPrices deletes the settlement prices of a denom pair in a market that are from before the cutoff.
+// The most recent of those is kept though, since it's still needed to calculate a time-weighted average from the cutoff.
+func pruneSettlementPrices(store storetypes.KVStore, marketID uint32, assetDenom, priceDenom string, cutoff time.Time) {
+	var keys [][]byte
+	iter := store.Iterator(GetKeyPrefixSettlementPriceDenoms(marketID, assetDenom, priceDenom),
+		MakeKeySettlementPrice(marketID, assetDenom, priceDenom, cutoff))
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for i := 0; i < len(keys)-1; i++ {
+		store.Delete(keys[i])
+	}
+}
+
+// GetSettlementPrices gets the settlement prices of a denom pair in a market from start to end (inclusive,
+// with second precision). The most recent settlement price from before start is also returned (if there is one).
+// Any entry that cannot be read is skipped.
+func (k Keeper) GetSettlementPrices(ctx sdk.Context, marketID uint32, assetDenom, priceDenom string, start, end time.Time) (*exchange.SettlementPrice, []exchange.SettlementPrice, error) {
+	store := k.getStore(ctx)
+	if err := validateMarketExists(store, marketID); err != nil {
+		return nil, nil, err
+	}
+
+	var prior *exchange.SettlementPrice
+	priorIter := store.ReverseIterator(GetKeyPrefixSettlementPriceDenoms(marketID, assetDenom, priceDenom),
+		MakeKeySettlementPrice(marketID, assetDenom, priceDenom, start))
+	for ; prior == nil && priorIter.Valid(); priorIter.Next() {
+		prior, _ = k.parseSettlementPriceStoreValue(priorIter.Value())
+	}
+	priorIter.Close()
+
+	var prices []exchange.SettlementPrice
+	iter := store.Iterator(MakeKeySettlementPrice(marketID, assetDenom, priceDenom, start),
+		MakeKeySettlementPrice(marketID, assetDenom, priceDenom, end.Add(time.Second)))
+	for ; iter.Valid(); iter.Next() {
+		if price, err := k.parseSettlementPriceStoreValue(iter.Value()); err == nil {
+			prices = append(prices, *price)
+		}
+	}
+	iter.Close()
+
+	return prior, prices, nil
+}
+
+// IterateSettlementPrices iterates over all settlement prices. An error is returned if there was a problem
+// reading an entry along the way. Such a problem does not interrupt iteration.
+// The callback takes in the settlement price and should return whether to stop iterating.
+func (k Keeper) IterateSettlementPrices(ctx sdk.Context, cb func(price *exchange.SettlementPrice) bool) error {
+	var errs []error
+	k.iterate(ctx, GetKeyPrefixSettlementPrice(), func(_, value []byte) bool {
+		price, err := k.parseSettlementPriceStoreValue(value)
+		if err != nil {
+			errs = append(errs, err)
+			return false
+		}
+		return cb(price)
+	})
+	return errors.Join(errs...)
+}
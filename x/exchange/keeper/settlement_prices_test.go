@@ -0,0 +1,423 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+	"github.com/provenance-io/provenance/x/exchange"
+	"github.com/provenance-io/provenance/x/exchange/keeper"
+)
+
+// requireSetSettlementPrices stores the provided settlement prices.
+func (s *TestSuite) requireSetSettlementPrices(prices ...exchange.SettlementPrice) {
+	for _, price := range prices {
+		assertions.RequireNotPanicsNoErrorf(s.T(), func() error {
+			return s.k.SetSettlementPriceInStore(s.getStore(), price)
+		}, "SetSettlementPriceInStore(%s)", s.getGenStateSettlementPriceStr(price))
+	}
+}
+
+// getAllSettlementPrices gets all the settlement prices in state, requiring there to not be any errors.
+func (s *TestSuite) getAllSettlementPrices() []exchange.SettlementPrice {
+	var rv []exchange.SettlementPrice
+	err := s.k.IterateSettlementPrices(s.ctx, func(price *exchange.SettlementPrice) bool {
+		rv = append(rv, *price)
+		return false
+	})
+	s.Require().NoError(err, "IterateSettlementPrices")
+	return rv
+}
+
+func (s *TestSuite) TestKeeper_RecordSettlementPrices() {
+	blockTime := time.Unix(1_700_000_000, 500_000_000).UTC()
+	daysAgo := func(days int64) int64 {
+		return blockTime.Unix() - days*24*60*60
+	}
+	price := func(marketID uint32, unixSecs int64, assets, priceAmt string) exchange.SettlementPrice {
+		return exchange.SettlementPrice{
+			MarketId: marketID,
+			Time:     time.Unix(unixSecs, 0).UTC(),
+			Assets:   s.coin(assets),
+			Price:    s.coin(priceAmt),
+		}
+	}
+	nav := func(assets, price string) exchange.NetAssetPrice {
+		return exchange.NetAssetPrice{Assets: s.coin(assets), Price: s.coin(price)}
+	}
+	now := blockTime.Unix()
+
+	tests := []struct {
+		name      string
+		setup     func()
+		marketID  uint32
+		navs      []exchange.NetAssetPrice
+		expPrices []exchange.SettlementPrice
+		expLog    []string
+	}{
+		{
+			name:     "no navs",
+			marketID: 1,
+			navs:     nil,
+		},
+		{
+			name:      "one nav",
+			marketID:  1,
+			navs:      []exchange.NetAssetPrice{nav("8apple", "20plum")},
+			expPrices: []exchange.SettlementPrice{price(1, now, "8apple", "20plum")},
+		},
+		{
+			name:     "two navs with different denoms",
+			marketID: 3,
+			navs:     []exchange.NetAssetPrice{nav("8apple", "20plum"), nav("5banana", "7plum")},
+			expPrices: []exchange.SettlementPrice{
+				price(3, now, "8apple", "20plum"),
+				price(3, now, "5banana", "7plum"),
+			},
+		},
+		{
+			name:      "zero amounts are skipped",
+			marketID:  1,
+			navs:      []exchange.NetAssetPrice{nav("0apple", "20plum"), nav("8apple", "0plum"), nav("3banana", "4plum")},
+			expPrices: []exchange.SettlementPrice{price(1, now, "3banana", "4plum")},
+		},
+		{
+			name:      "two navs with the same denoms",
+			marketID:  1,
+			navs:      []exchange.NetAssetPrice{nav("8apple", "20plum"), nav("2apple", "6plum")},
+			expPrices: []exchange.SettlementPrice{price(1, now, "10apple", "26plum")},
+		},
+		{
+			name: "already recorded this second",
+			setup: func() {
+				s.requireSetSettlementPrices(price(1, now, "1apple", "3plum"), price(2, now, "6apple", "6plum"))
+			},
+			marketID: 1,
+			navs:     []exchange.NetAssetPrice{nav("8apple", "20plum")},
+			expPrices: []exchange.SettlementPrice{
+				price(1, now, "9apple", "23plum"),
+				price(2, now, "6apple", "6plum"),
+			},
+		},
+		{
+			name: "bad existing entry",
+			setup: func() {
+				s.getStore().Set(keeper.MakeKeySettlementPrice(1, "apple", "plum", blockTime), []byte("x"))
+			},
+			marketID:  1,
+			navs:      []exchange.NetAssetPrice{nav("8apple", "20plum")},
+			expPrices: []exchange.SettlementPrice{price(1, now, "8apple", "20plum")},
+			expLog: []string{
+				"ERR error reading existing settlement price of \"apple\" for \"plum\" in market 1: " +
+					"failed to unmarshal settlement price: unexpected EOF module=x/exchange",
+			},
+		},
+		{
+			name: "old entries are pruned",
+			setup: func() {
+				s.requireSetSettlementPrices(
+					price(1, daysAgo(33), "1apple", "1plum"),
+					price(1, daysAgo(32), "2apple", "2plum"),
+					price(1, daysAgo(31), "3apple", "3plum"),
+					price(1, daysAgo(29), "4apple", "4plum"),
+					price(1, daysAgo(33), "1banana", "1plum"),
+					price(1, daysAgo(32), "2banana", "2plum"),
+					price(2, daysAgo(33), "1apple", "1plum"),
+					price(2, daysAgo(32), "2apple", "2plum"),
+				)
+			},
+			marketID: 1,
+			navs:     []exchange.NetAssetPrice{nav("8apple", "20plum")},
+			expPrices: []exchange.SettlementPrice{
+				price(1, daysAgo(31), "3apple", "3plum"),
+				price(1, daysAgo(29), "4apple", "4plum"),
+				price(1, now, "8apple", "20plum"),
+				price(1, daysAgo(33), "1banana", "1plum"),
+				price(1, daysAgo(32), "2banana", "2plum"),
+				price(2, daysAgo(33), "1apple", "1plum"),
+				price(2, daysAgo(32), "2apple", "2plum"),
+			},
+		},
+		{
+			name: "only old entries",
+			setup: func() {
+				s.requireSetSettlementPrices(
+					price(1, daysAgo(40), "1apple", "1plum"),
+					price(1, daysAgo(35), "2apple", "2plum"),
+				)
+			},
+			marketID: 1,
+			navs:     []exchange.NetAssetPrice{nav("8apple", "20plum")},
+			expPrices: []exchange.SettlementPrice{
+				price(1, daysAgo(35), "2apple", "2plum"),
+				price(1, now, "8apple", "20plum"),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			s.logBuffer.Reset()
+			ctx := s.ctx.WithBlockTime(blockTime)
+			testFunc := func() {
+				s.k.RecordSettlementPrices(ctx, tc.marketID, tc.navs)
+			}
+			s.Require().NotPanics(testFunc, "RecordSettlementPrices")
+			actLog := s.splitOutputLog(s.getLogOutput("RecordSettlementPrices"))
+			s.Assert().Equal(tc.expLog, actLog, "log messages during RecordSettlementPrices")
+			actPrices := s.getAllSettlementPrices()
+			assertEqualSlice(s, tc.expPrices, actPrices, s.getGenStateSettlementPriceStr, "settlement prices in state")
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_GetSettlementPrices() {
+	price := func(marketID uint32, unixSecs int64, assets, priceAmt string) exchange.SettlementPrice {
+		return exchange.SettlementPrice{
+			MarketId: marketID,
+			Time:     time.Unix(unixSecs, 0).UTC(),
+			Assets:   s.coin(assets),
+			Price:    s.coin(priceAmt),
+		}
+	}
+	priceP := func(marketID uint32, unixSecs int64, assets, priceAmt string) *exchange.SettlementPrice {
+		rv := price(marketID, unixSecs, assets, priceAmt)
+		return &rv
+	}
+	at := func(unixSecs int64) time.Time {
+		return time.Unix(unixSecs, 0)
+	}
+
+	s.clearExchangeState()
+	keeper.SetMarketKnown(s.getStore(), 1)
+	keeper.SetMarketKnown(s.getStore(), 2)
+	s.requireSetSettlementPrices(
+		price(1, 100, "1apple", "5plum"),
+		price(1, 200, "2apple", "8plum"),
+		price(1, 300, "3apple", "9plum"),
+		price(1, 400, "4apple", "8plum"),
+		price(1, 200, "2banana", "8plum"),
+		price(1, 200, "2apple", "8pear"),
+		price(2, 200, "7apple", "7plum"),
+	)
+	s.getStore().Set(keeper.MakeKeySettlementPrice(1, "apple", "plum", at(250)), []byte("x"))
+	s.getStore().Set(keeper.MakeKeySettlementPrice(1, "apple", "plum", at(150)), []byte("x"))
+
+	tests := []struct {
+		name       string
+		marketID   uint32
+		assetDenom string
+		priceDenom string
+		start      int64
+		end        int64
+		expPrior   *exchange.SettlementPrice
+		expPrices  []exchange.SettlementPrice
+		expErr     string
+	}{
+		{
+			name:       "unknown market",
+			marketID:   3,
+			assetDenom: "apple",
+			priceDenom: "plum",
+			start:      100,
+			end:        400,
+			expErr:     "market 3 does not exist",
+		},
+		{
+			name:       "unknown denoms",
+			marketID:   1,
+			assetDenom: "cherry",
+			priceDenom: "plum",
+			start:      100,
+			end:        400,
+		},
+		{
+			name:       "everything",
+			marketID:   1,
+			assetDenom: "apple",
+			priceDenom: "plum",
+			start:      100,
+			end:        400,
+			expPrices: []exchange.SettlementPrice{
+				price(1, 100, "1apple", "5plum"),
+				price(1, 200, "2apple", "8plum"),
+				price(1, 300, "3apple", "9plum"),
+				price(1, 400, "4apple", "8plum"),
+			},
+		},
+		{
+			name:       "window between prices",
+			marketID:   1,
+			assetDenom: "apple",
+			priceDenom: "plum",
+			start:      301,
+			end:        399,
+			expPrior:   priceP(1, 300, "3apple", "9plum"),
+		},
+		{
+			name:       "after all prices",
+			marketID:   1,
+			assetDenom: "apple",
+			priceDenom: "plum",
+			start:      401,
+			end:        500,
+			expPrior:   priceP(1, 400, "4apple", "8plum"),
+		},
+		{
+			name:       "prior entry is bad",
+			marketID:   1,
+			assetDenom: "apple",
+			priceDenom: "plum",
+			start:      251,
+			end:        400,
+			expPrior:   priceP(1, 200, "2apple", "8plum"),
+			expPrices: []exchange.SettlementPrice{
+				price(1, 300, "3apple", "9plum"),
+				price(1, 400, "4apple", "8plum"),
+			},
+		},
+		{
+			name:       "bad entry in window",
+			marketID:   1,
+			assetDenom: "apple",
+			priceDenom: "plum",
+			start:      101,
+			end:        300,
+			expPrior:   priceP(1, 100, "1apple", "5plum"),
+			expPrices: []exchange.SettlementPrice{
+				price(1, 200, "2apple", "8plum"),
+				price(1, 300, "3apple", "9plum"),
+			},
+		},
+		{
+			name:       "other price denom",
+			marketID:   1,
+			assetDenom: "apple",
+			priceDenom: "pear",
+			start:      201,
+			end:        400,
+			expPrior:   priceP(1, 200, "2apple", "8pear"),
+		},
+		{
+			name:       "other market",
+			marketID:   2,
+			assetDenom: "apple",
+			priceDenom: "plum",
+			start:      1,
+			end:        200,
+			expPrices:  []exchange.SettlementPrice{price(2, 200, "7apple", "7plum")},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			var prior *exchange.SettlementPrice
+			var prices []exchange.SettlementPrice
+			var err error
+			testFunc := func() {
+				prior, prices, err = s.k.GetSettlementPrices(s.ctx, tc.marketID, tc.assetDenom, tc.priceDenom, at(tc.start), at(tc.end))
+			}
+			s.Require().NotPanics(testFunc, "GetSettlementPrices")
+			s.assertErrorValue(err, tc.expErr, "GetSettlementPrices error")
+			s.Assert().Equal(tc.expPrior, prior, "GetSettlementPrices prior")
+			assertEqualSlice(s, tc.expPrices, prices, s.getGenStateSettlementPriceStr, "GetSettlementPrices prices")
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_IterateSettlementPrices() {
+	price := func(marketID uint32, unixSecs int64, assets, priceAmt string) exchange.SettlementPrice {
+		return exchange.SettlementPrice{
+			MarketId: marketID,
+			Time:     time.Unix(unixSecs, 0).UTC(),
+			Assets:   s.coin(assets),
+			Price:    s.coin(priceAmt),
+		}
+	}
+	var prices []exchange.SettlementPrice
+	getAll := func(price *exchange.SettlementPrice) bool {
+		prices = append(prices, *price)
+		return false
+	}
+	stopAfter := func(n int) func(price *exchange.SettlementPrice) bool {
+		return func(price *exchange.SettlementPrice) bool {
+			prices = append(prices, *price)
+			return len(prices) >= n
+		}
+	}
+	defaultSetup := func() {
+		s.requireSetSettlementPrices(
+			price(2, 100, "1apple", "5plum"),
+			price(1, 200, "2apple", "8plum"),
+			price(1, 100, "3apple", "9plum"),
+		)
+	}
+
+	tests := []struct {
+		name      string
+		setup     func()
+		cb        func(price *exchange.SettlementPrice) bool
+		expPrices []exchange.SettlementPrice
+		expErr    string
+	}{
+		{
+			name: "no prices",
+			cb:   getAll,
+		},
+		{
+			name:  "three prices",
+			setup: defaultSetup,
+			cb:    getAll,
+			expPrices: []exchange.SettlementPrice{
+				price(1, 100, "3apple", "9plum"),
+				price(1, 200, "2apple", "8plum"),
+				price(2, 100, "1apple", "5plum"),
+			},
+		},
+		{
+			name:  "stop after two",
+			setup: defaultSetup,
+			cb:    stopAfter(2),
+			expPrices: []exchange.SettlementPrice{
+				price(1, 100, "3apple", "9plum"),
+				price(1, 200, "2apple", "8plum"),
+			},
+		},
+		{
+			name: "bad value",
+			setup: func() {
+				defaultSetup()
+				s.getStore().Set(keeper.MakeKeySettlementPrice(1, "apple", "plum", time.Unix(150, 0)), []byte("x"))
+			},
+			cb: getAll,
+			expPrices: []exchange.SettlementPrice{
+				price(1, 100, "3apple", "9plum"),
+				price(1, 200, "2apple", "8plum"),
+				price(2, 100, "1apple", "5plum"),
+			},
+			expErr: "failed to unmarshal settlement price: unexpected EOF",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			prices = nil
+			var err error
+			testFunc := func() {
+				err = s.k.IterateSettlementPrices(s.ctx, tc.cb)
+			}
+			s.Require().NotPanics(testFunc, "IterateSettlementPrices")
+			s.assertErrorValue(err, tc.expErr, "IterateSettlementPrices error")
+			assertEqualSlice(s, tc.expPrices, prices, s.getGenStateSettlementPriceStr, "IterateSettlementPrices prices")
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+// getLastSettlementID gets the id of the last settlement receipt recorded.
+func getLastSettlementID(store storetypes.KVStore) uint64 {
+	rv, _ := uint64FromBz(store.Get(MakeKeyLastSettlementID()))
+	return rv
+}
+
+// setLastSettlementID sets the id of the last settlement receipt recorded.
+func setLastSettlementID(store storetypes.KVStore, settlementID uint64) {
+	store.Set(MakeKeyLastSettlementID(), uint64Bz(settlementID))
+}
+
+// nextSettlementID finds the next available settlement id, updates the last settlement id
+// store entry, and returns the unused id it found. If settlement history is not being kept,
+// no id is used, and zero is returned.
+func nextSettlementID(store storetypes.KVStore) uint64 {
+	if getParamsSettlementHistoryBlocks(store) == 0 {
+		return 0
+	}
+	settlementID := getLastSettlementID(store) + 1
+	setLastSettlementID(store, settlementID)
+	return settlementID
+}
+
+// parseSettlementReceiptStoreValue converts a settlement receipt store value into a SettlementReceipt.
+func (k Keeper) parseSettlementReceiptStoreValue(value []byte) (*exchange.SettlementReceipt, error) {
+	var receipt exchange.SettlementReceipt
+	if err := k.cdc.Unmarshal(value, &receipt); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal settlement receipt: %w", err)
+	}
+	return &receipt, nil
+}
+
+// setSettlementReceiptInStore writes a settlement receipt to the store.
+func (k Keeper) setSettlementReceiptInStore(store storetypes.KVStore, receipt exchange.SettlementReceipt) error {
+	value, err := k.cdc.Marshal(&receipt)
+	if err != nil {
+		return fmt.Errorf("failed to marshal settlement receipt: %w", err)
+	}
+	store.Set(MakeKeySettlementReceipt(receipt.SettlementId), value)
+	return nil
+}
+
+// recordSettlementReceipt stores a receipt for the provided settlement. Nothing is stored if the settlementID is zero.
+// Problems are logged, but otherwise ignored so that they don't prevent the settlement.
+func (k Keeper) recordSettlementReceipt(
+	ctx sdk.Context,
+	store storetypes.KVStore,
+	settlementID uint64,
+	marketID uint32,
+	settlement *exchange.Settlement,
+	navs []exchange.NetAssetPrice,
+) {
+	if settlementID == 0 {
+		return
+	}
+
+	receipt := exchange.NewSettlementReceipt(settlementID, marketID, ctx.BlockHeight(), ctx.BlockTime(), settlement, navs)
+	if err := k.setSettlementReceiptInStore(store, *receipt); err != nil {
+		k.logErrorf(ctx, "error recording receipt for settlement %d in market %d: %v", settlementID, marketID, err)
+	}
+}
+
+// GetSettlementReceipt gets the receipt for a settlement. Returns nil (without an error) if it doesn't exist.
+func (k Keeper) GetSettlementReceipt(ctx sdk.Context, settlementID uint64) (*exchange.SettlementReceipt, error) {
+	value := k.getStore(ctx).Get(MakeKeySettlementReceipt(settlementID))
+	if value == nil {
+		return nil, nil
+	}
+	return k.parseSettlementReceiptStoreValue(value)
+}
+
+// PruneSettlementReceipts deletes the settlement receipts that are older than the settlement_history_blocks param.
+// If that param is zero, all settlement receipts are deleted. At most maxToDelete receipts are deleted.
+// Returns the number of receipts deleted.
+func (k Keeper) PruneSettlementReceipts(ctx sdk.Context, maxToDelete int) int {
+	store := k.getStore(ctx)
+	height := ctx.BlockHeight()
+	blocks := getParamsSettlementHistoryBlocks(store)
+	if height <= 0 || blocks >= uint64(height) {
+		return 0
+	}
+	// Receipts at this height (and before) are deleted.
+	cutoff := height - int64(blocks) //nolint:gosec // G115: The blocks are less than the height (an int64).
+
+	// Settlement ids increase with height, so we can stop at the first receipt that's new enough to keep.
+	var keys [][]byte
+	iter := storetypes.KVStorePrefixIterator(store, GetKeyPrefixSettlementReceipt())
+	for ; iter.Valid() && len(keys) < maxToDelete; iter.Next() {
+		receipt, err := k.parseSettlementReceiptStoreValue(iter.Value())
+		if err != nil {
+			k.logErrorf(ctx, "invalid settlement receipt %v (deleted): %v", iter.Key(), err)
+		} else if receipt.Height > cutoff {
+			break
+		}
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return len(keys)
+}
+
+// IterateSettlementReceipts iterates over all settlement receipts. An error is returned if there was a problem
+// reading an entry along the way. Such a problem does not interrupt iteration.
+// The callback takes in the settlement receipt and should return whether to stop iterating.
+func (k Keeper) IterateSettlementReceipts(ctx sdk.Context, cb func(receipt *exchange.SettlementReceipt) bool) error {
+	var errs []error
+	k.iterate(ctx, GetKeyPrefixSettlementReceipt(), func(_, value []byte) bool {
+		receipt, err := k.parseSettlementReceiptStoreValue(value)
+		if err != nil {
+			errs = append(errs, err)
+			return false
+		}
+		return cb(receipt)
+	})
+	return errors.Join(errs...)
+}
@@ -0,0 +1,468 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+	"github.com/provenance-io/provenance/x/exchange"
+	"github.com/provenance-io/provenance/x/exchange/keeper"
+)
+
+// requireSetSettlementReceipts stores the provided settlement receipts.
+func (s *TestSuite) requireSetSettlementReceipts(receipts ...exchange.SettlementReceipt) {
+	for _, receipt := range receipts {
+		assertions.RequireNotPanicsNoErrorf(s.T(), func() error {
+			return s.k.SetSettlementReceiptInStore(s.getStore(), receipt)
+		}, "SetSettlementReceiptInStore(%s)", s.getGenStateSettlementReceiptStr(receipt))
+	}
+}
+
+// getAllSettlementReceipts gets all the settlement receipts in state, requiring there to not be any errors.
+func (s *TestSuite) getAllSettlementReceipts() []exchange.SettlementReceipt {
+	var rv []exchange.SettlementReceipt
+	err := s.k.IterateSettlementReceipts(s.ctx, func(receipt *exchange.SettlementReceipt) bool {
+		rv = append(rv, *receipt)
+		return false
+	})
+	s.Require().NoError(err, "IterateSettlementReceipts")
+	return rv
+}
+
+// settlementReceipt creates a settlement receipt with a fill for each of the provided order ids.
+func (s *TestSuite) settlementReceipt(settlementID uint64, marketID uint32, height int64, orderIDs ...uint64) exchange.SettlementReceipt {
+	rv := exchange.SettlementReceipt{
+		SettlementId: settlementID,
+		MarketId:     marketID,
+		Height:       height,
+		Time:         time.Unix(1_700_000_000+height, 0).UTC(),
+	}
+	for _, orderID := range orderIDs {
+		rv.Fills = append(rv.Fills, exchange.SettlementFill{
+			OrderId:   orderID,
+			OrderType: exchange.OrderTypeBid,
+			Owner:     s.addr2.String(),
+			Assets:    s.coin(fmt.Sprintf("%dapple", orderID)),
+			Price:     s.coin(fmt.Sprintf("%dplum", orderID*2)),
+		})
+		rv.Transfers = append(rv.Transfers, exchange.SettlementTransfer{
+			Inputs:  []exchange.AccountAmount{{Account: s.addr2.String(), Amount: s.coins(fmt.Sprintf("%dplum", orderID*2))}},
+			Outputs: []exchange.AccountAmount{{Account: s.addr1.String(), Amount: s.coins(fmt.Sprintf("%dplum", orderID*2))}},
+		})
+	}
+	return rv
+}
+
+func (s *TestSuite) TestKeeper_NextSettlementID() {
+	tests := []struct {
+		name          string
+		historyBlocks uint64
+		lastID        uint64
+		expID         uint64
+		expLastID     uint64
+	}{
+		{name: "history not kept, no last id", historyBlocks: 0, lastID: 0, expID: 0, expLastID: 0},
+		{name: "history not kept, with last id", historyBlocks: 0, lastID: 7, expID: 0, expLastID: 7},
+		{name: "history kept, no last id", historyBlocks: 10, lastID: 0, expID: 1, expLastID: 1},
+		{name: "history kept, with last id", historyBlocks: 10, lastID: 7, expID: 8, expLastID: 8},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			store := s.getStore()
+			keeper.SetParamsSettlementHistoryBlocks(store, tc.historyBlocks)
+			keeper.SetLastSettlementID(store, tc.lastID)
+
+			var id uint64
+			testFunc := func() {
+				id = keeper.NextSettlementID(store)
+			}
+			s.Require().NotPanics(testFunc, "NextSettlementID")
+			s.Assert().Equal(tc.expID, id, "NextSettlementID result")
+			lastID := keeper.GetLastSettlementID(store)
+			s.Assert().Equal(tc.expLastID, lastID, "last settlement id after NextSettlementID")
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_RecordSettlementReceipt() {
+	blockTime := time.Unix(1_700_000_000, 500_000_000).UTC()
+	askOrder := exchange.NewOrder(3).WithAsk(&exchange.AskOrder{
+		MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("10apple"), Price: s.coin("20plum"),
+	})
+	bidOrder := exchange.NewOrder(4).WithBid(&exchange.BidOrder{
+		MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("25plum"),
+		BuyerSettlementFees: s.coins("1plum"), ExternalId: "bid4",
+	})
+	settlement := &exchange.Settlement{
+		Transfers: []*exchange.Transfer{
+			{
+				Inputs:  []banktypes.Input{{Address: s.addr1.String(), Coins: s.coins("10apple")}},
+				Outputs: []banktypes.Output{{Address: s.addr2.String(), Coins: s.coins("10apple")}},
+			},
+			{
+				Inputs:  []banktypes.Input{{Address: s.addr2.String(), Coins: s.coins("25plum")}},
+				Outputs: []banktypes.Output{{Address: s.addr1.String(), Coins: s.coins("25plum")}},
+			},
+		},
+		FeeInputs: []banktypes.Input{{Address: s.addr2.String(), Coins: s.coins("1plum")}},
+		FullyFilledOrders: []*exchange.FilledOrder{
+			exchange.NewFilledOrder(askOrder, s.coin("25plum"), nil),
+			exchange.NewFilledOrder(bidOrder, s.coin("25plum"), s.coins("1plum")),
+		},
+	}
+	navs := []exchange.NetAssetPrice{{Assets: s.coin("10apple"), Price: s.coin("25plum")}}
+	receipt := exchange.SettlementReceipt{
+		SettlementId: 5,
+		MarketId:     1,
+		Height:       10,
+		Time:         blockTime,
+		Fills: []exchange.SettlementFill{
+			{
+				OrderId: 3, OrderType: exchange.OrderTypeAsk, Owner: s.addr1.String(),
+				Assets: s.coin("10apple"), Price: s.coin("25plum"),
+			},
+			{
+				OrderId: 4, OrderType: exchange.OrderTypeBid, Owner: s.addr2.String(),
+				Assets: s.coin("10apple"), Price: s.coin("25plum"), Fees: s.coins("1plum"), ExternalId: "bid4",
+			},
+		},
+		Transfers: []exchange.SettlementTransfer{
+			{
+				Inputs:  []exchange.AccountAmount{{Account: s.addr1.String(), Amount: s.coins("10apple")}},
+				Outputs: []exchange.AccountAmount{{Account: s.addr2.String(), Amount: s.coins("10apple")}},
+			},
+			{
+				Inputs:  []exchange.AccountAmount{{Account: s.addr2.String(), Amount: s.coins("25plum")}},
+				Outputs: []exchange.AccountAmount{{Account: s.addr1.String(), Amount: s.coins("25plum")}},
+			},
+		},
+		FeeInputs: []exchange.AccountAmount{{Account: s.addr2.String(), Amount: s.coins("1plum")}},
+		Navs:      []exchange.NetAssetPrice{{Assets: s.coin("10apple"), Price: s.coin("25plum")}},
+	}
+
+	tests := []struct {
+		name         string
+		setup        func()
+		settlementID uint64
+		expReceipts  []exchange.SettlementReceipt
+	}{
+		{
+			name:         "zero settlement id",
+			settlementID: 0,
+		},
+		{
+			name:         "first receipt",
+			settlementID: 5,
+			expReceipts:  []exchange.SettlementReceipt{receipt},
+		},
+		{
+			name: "other receipts already exist",
+			setup: func() {
+				s.requireSetSettlementReceipts(
+					s.settlementReceipt(3, 2, 9, 1),
+					s.settlementReceipt(4, 1, 10, 2),
+					s.settlementReceipt(6, 1, 11, 7),
+				)
+			},
+			settlementID: 5,
+			expReceipts: []exchange.SettlementReceipt{
+				s.settlementReceipt(3, 2, 9, 1),
+				s.settlementReceipt(4, 1, 10, 2),
+				receipt,
+				s.settlementReceipt(6, 1, 11, 7),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			ctx := s.ctx.WithBlockTime(blockTime).WithBlockHeight(10)
+			testFunc := func() {
+				s.k.RecordSettlementReceipt(ctx, tc.settlementID, 1, settlement, navs)
+			}
+			s.Require().NotPanics(testFunc, "RecordSettlementReceipt")
+			actReceipts := s.getAllSettlementReceipts()
+			assertEqualSlice(s, tc.expReceipts, actReceipts, s.getGenStateSettlementReceiptStr, "settlement receipts in state")
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_GetSettlementReceipt() {
+	receipt3 := s.settlementReceipt(3, 1, 6, 2)
+
+	tests := []struct {
+		name         string
+		setup        func()
+		settlementID uint64
+		expReceipt   *exchange.SettlementReceipt
+		expErr       string
+	}{
+		{
+			name:         "no receipts",
+			settlementID: 1,
+		},
+		{
+			name: "unknown settlement id",
+			setup: func() {
+				s.requireSetSettlementReceipts(s.settlementReceipt(1, 1, 5, 1), receipt3)
+			},
+			settlementID: 2,
+		},
+		{
+			name: "known settlement id",
+			setup: func() {
+				s.requireSetSettlementReceipts(s.settlementReceipt(1, 1, 5, 1), receipt3)
+			},
+			settlementID: 3,
+			expReceipt:   &receipt3,
+		},
+		{
+			name: "bad value",
+			setup: func() {
+				s.getStore().Set(keeper.MakeKeySettlementReceipt(2), []byte("x"))
+			},
+			settlementID: 2,
+			expErr:       "failed to unmarshal settlement receipt: unexpected EOF",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var receipt *exchange.SettlementReceipt
+			var err error
+			testFunc := func() {
+				receipt, err = s.k.GetSettlementReceipt(s.ctx, tc.settlementID)
+			}
+			s.Require().NotPanics(testFunc, "GetSettlementReceipt(%d)", tc.settlementID)
+			s.assertErrorValue(err, tc.expErr, "GetSettlementReceipt(%d) error", tc.settlementID)
+			s.Assert().Equal(tc.expReceipt, receipt, "GetSettlementReceipt(%d) receipt", tc.settlementID)
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_PruneSettlementReceipts() {
+	defaultSetup := func(historyBlocks uint64) func() {
+		return func() {
+			s.k.SetParams(s.ctx, &exchange.Params{SettlementHistoryBlocks: historyBlocks})
+			s.requireSetSettlementReceipts(
+				s.settlementReceipt(1, 1, 4, 1),
+				s.settlementReceipt(2, 2, 5, 2),
+				s.settlementReceipt(3, 1, 5, 3),
+				s.settlementReceipt(4, 1, 8, 4),
+				s.settlementReceipt(5, 2, 9, 5),
+			)
+		}
+	}
+
+	tests := []struct {
+		name        string
+		setup       func()
+		height      int64
+		maxToDelete int
+		expCount    int
+		expReceipts []exchange.SettlementReceipt
+		expLog      string
+	}{
+		{
+			name:        "no receipts",
+			setup:       func() { s.k.SetParams(s.ctx, &exchange.Params{SettlementHistoryBlocks: 3}) },
+			height:      10,
+			maxToDelete: 100,
+			expCount:    0,
+		},
+		{
+			name:        "history longer than the chain",
+			setup:       defaultSetup(10),
+			height:      10,
+			maxToDelete: 100,
+			expCount:    0,
+			expReceipts: []exchange.SettlementReceipt{
+				s.settlementReceipt(1, 1, 4, 1),
+				s.settlementReceipt(2, 2, 5, 2),
+				s.settlementReceipt(3, 1, 5, 3),
+				s.settlementReceipt(4, 1, 8, 4),
+				s.settlementReceipt(5, 2, 9, 5),
+			},
+		},
+		{
+			name:        "nothing old enough",
+			setup:       defaultSetup(7),
+			height:      10,
+			maxToDelete: 100,
+			expCount:    0,
+			expReceipts: []exchange.SettlementReceipt{
+				s.settlementReceipt(1, 1, 4, 1),
+				s.settlementReceipt(2, 2, 5, 2),
+				s.settlementReceipt(3, 1, 5, 3),
+				s.settlementReceipt(4, 1, 8, 4),
+				s.settlementReceipt(5, 2, 9, 5),
+			},
+		},
+		{
+			name:        "receipts at the cutoff are deleted",
+			setup:       defaultSetup(5),
+			height:      10,
+			maxToDelete: 100,
+			expCount:    3,
+			expReceipts: []exchange.SettlementReceipt{
+				s.settlementReceipt(4, 1, 8, 4),
+				s.settlementReceipt(5, 2, 9, 5),
+			},
+		},
+		{
+			name:        "limited by max to delete",
+			setup:       defaultSetup(5),
+			height:      10,
+			maxToDelete: 2,
+			expCount:    2,
+			expReceipts: []exchange.SettlementReceipt{
+				s.settlementReceipt(3, 1, 5, 3),
+				s.settlementReceipt(4, 1, 8, 4),
+				s.settlementReceipt(5, 2, 9, 5),
+			},
+		},
+		{
+			name:        "history not kept: all deleted",
+			setup:       defaultSetup(0),
+			height:      10,
+			maxToDelete: 100,
+			expCount:    5,
+		},
+		{
+			name: "bad receipt entry",
+			setup: func() {
+				defaultSetup(5)()
+				s.getStore().Set(keeper.MakeKeySettlementReceipt(2), []byte("x"))
+			},
+			height:      10,
+			maxToDelete: 100,
+			expCount:    3,
+			expReceipts: []exchange.SettlementReceipt{
+				s.settlementReceipt(4, 1, 8, 4),
+				s.settlementReceipt(5, 2, 9, 5),
+			},
+			expLog: "ERR invalid settlement receipt [40 0 0 0 0 0 0 0 2] (deleted): " +
+				"failed to unmarshal settlement receipt: unexpected EOF module=x/exchange\n",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			s.logBuffer.Reset()
+			ctx := s.ctx.WithBlockHeight(tc.height)
+			var count int
+			testFunc := func() {
+				count = s.k.PruneSettlementReceipts(ctx, tc.maxToDelete)
+			}
+			s.Require().NotPanics(testFunc, "PruneSettlementReceipts")
+			s.Assert().Equal(tc.expCount, count, "PruneSettlementReceipts result")
+			s.Assert().Equal(tc.expLog, s.getLogOutput("PruneSettlementReceipts"), "log output")
+			actReceipts := s.getAllSettlementReceipts()
+			assertEqualSlice(s, tc.expReceipts, actReceipts, s.getGenStateSettlementReceiptStr, "settlement receipts in state")
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_IterateSettlementReceipts() {
+	var receipts []exchange.SettlementReceipt
+	getAll := func(receipt *exchange.SettlementReceipt) bool {
+		receipts = append(receipts, *receipt)
+		return false
+	}
+	stopAfter := func(n int) func(receipt *exchange.SettlementReceipt) bool {
+		return func(receipt *exchange.SettlementReceipt) bool {
+			receipts = append(receipts, *receipt)
+			return len(receipts) >= n
+		}
+	}
+	defaultSetup := func() {
+		s.requireSetSettlementReceipts(
+			s.settlementReceipt(3, 2, 7, 1),
+			s.settlementReceipt(1, 1, 3, 2),
+			s.settlementReceipt(2, 1, 5, 3),
+		)
+	}
+
+	tests := []struct {
+		name        string
+		setup       func()
+		cb          func(receipt *exchange.SettlementReceipt) bool
+		expReceipts []exchange.SettlementReceipt
+		expErr      string
+	}{
+		{
+			name: "no receipts",
+			cb:   getAll,
+		},
+		{
+			name:  "three receipts",
+			setup: defaultSetup,
+			cb:    getAll,
+			expReceipts: []exchange.SettlementReceipt{
+				s.settlementReceipt(1, 1, 3, 2),
+				s.settlementReceipt(2, 1, 5, 3),
+				s.settlementReceipt(3, 2, 7, 1),
+			},
+		},
+		{
+			name:  "stop after two",
+			setup: defaultSetup,
+			cb:    stopAfter(2),
+			expReceipts: []exchange.SettlementReceipt{
+				s.settlementReceipt(1, 1, 3, 2),
+				s.settlementReceipt(2, 1, 5, 3),
+			},
+		},
+		{
+			name: "bad value",
+			setup: func() {
+				defaultSetup()
+				s.getStore().Set(keeper.MakeKeySettlementReceipt(4), []byte("x"))
+			},
+			cb: getAll,
+			expReceipts: []exchange.SettlementReceipt{
+				s.settlementReceipt(1, 1, 3, 2),
+				s.settlementReceipt(2, 1, 5, 3),
+				s.settlementReceipt(3, 2, 7, 1),
+			},
+			expErr: "failed to unmarshal settlement receipt: unexpected EOF",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			receipts = nil
+			var err error
+			testFunc := func() {
+				err = s.k.IterateSettlementReceipts(s.ctx, tc.cb)
+			}
+			s.Require().NotPanics(testFunc, "IterateSettlementReceipts")
+			s.assertErrorValue(err, tc.expErr, "IterateSettlementReceipts error")
+			assertEqualSlice(s, tc.expReceipts, receipts, s.getGenStateSettlementReceiptStr, "IterateSettlementReceipts receipts")
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+// parseSettlementRecordStoreValue converts a settlement record store value into a SettlementRecord.
+func (k Keeper) parseSettlementRecordStoreValue(value []byte) (*exchange.SettlementRecord, error) {
+	var record exchange.SettlementRecord
+	if err := k.cdc.Unmarshal(value, &record); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal settlement record: %w", err)
+	}
+	return &record, nil
+}
+
+// setSettlementRecordInStore writes a settlement record to the store.
+func (k Keeper) setSettlementRecordInStore(store storetypes.KVStore, record exchange.SettlementRecord) error {
+	value, err := k.cdc.Marshal(&record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal settlement record: %w", err)
+	}
+	store.Set(MakeKeySettlementRecord(record.MarketId, record.Height, record.Sequence), value)
+	return nil
+}
+
+// getNextSettlementSequence gets the sequence to use for the next settlement record in a market at the given height.
+func getNextSettlementSequence(store storetypes.KVStore, marketID uint32, height int64) uint32 {
+	iter := storetypes.KVStoreReversePrefixIterator(store, GetKeyPrefixMarketSettlementRecordHeight(marketID, height))
+	defer iter.Close()
+	if !iter.Valid() {
+		return 0
+	}
+	_, sequence, _ := ParseKeySuffixSettlementRecord(iter.Key())
+	return sequence + 1
+}
+
+// recordSettlement stores a record of the provided settlement (if settlement records are being kept).
+// Problems are logged, but otherwise ignored so that they don't prevent the settlement.
+func (k Keeper) recordSettlement(ctx sdk.Context, store storetypes.KVStore, marketID uint32, settlement *exchange.Settlement) {
+	if getParamsSettlementHistoryBlocks(store) == 0 {
+		return
+	}
+
+	height := ctx.BlockHeight()
+	sequence := getNextSettlementSequence(store, marketID, height)
+	record := exchange.NewSettlementRecord(marketID, height, sequence, ctx.BlockTime(), settlement)
+	if err := k.setSettlementRecordInStore(store, *record); err != nil {
+		k.logErrorf(ctx, "error recording settlement %d at height %d in market %d: %v", sequence, height, marketID, err)
+	}
+}
+
+// PruneSettlementRecords deletes the settlement records that are older than the settlement_history_blocks param.
+// If that param is zero, all settlement records are deleted. At most maxToDelete records are deleted.
+// Returns the number of records deleted.
+func (k Keeper) PruneSettlementRecords(ctx sdk.Context, maxToDelete int) int {
+	store := k.getStore(ctx)
+	height := ctx.BlockHeight()
+	blocks := getParamsSettlementHistoryBlocks(store)
+	if height <= 0 || blocks >= uint64(height) {
+		return 0
+	}
+	// Records at this height (and before) are deleted.
+	cutoff := height - int64(blocks) //nolint:gosec // G115: The blocks are less than the height (an int64).
+
+	var marketIDs []uint32
+	k.IterateKnownMarketIDs(ctx, func(marketID uint32) bool {
+		marketIDs = append(marketIDs, marketID)
+		return false
+	})
+
+	var keys [][]byte
+	for _, marketID := range marketIDs {
+		iter := store.Iterator(GetKeyPrefixMarketSettlementRecord(marketID),
+			GetKeyPrefixMarketSettlementRecordHeight(marketID, cutoff+1))
+		for ; iter.Valid() && len(keys) < maxToDelete; iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		iter.Close()
+		if len(keys) >= maxToDelete {
+			break
+		}
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return len(keys)
+}
+
+// IterateSettlementRecords iterates over all settlement records. An error is returned if there was a problem
+// reading an entry along the way. Such a problem does not interrupt iteration.
+// The callback takes in the settlement record and should return whether to stop iterating.
+func (k Keeper) IterateSettlementRecords(ctx sdk.Context, cb func(record *exchange.SettlementRecord) bool) error {
+	var errs []error
+	k.iterate(ctx, GetKeyPrefixSettlementRecord(), func(_, value []byte) bool {
+		record, err := k.parseSettlementRecordStoreValue(value)
+		if err != nil {
+			errs = append(errs, err)
+			return false
+		}
+		return cb(record)
+	})
+	return errors.Join(errs...)
+}
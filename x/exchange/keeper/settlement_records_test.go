@@ -0,0 +1,345 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+	"github.com/provenance-io/provenance/x/exchange"
+	"github.com/provenance-io/provenance/x/exchange/keeper"
+)
+
+// requireSetSettlementRecords stores the provided settlement records.
+func (s *TestSuite) requireSetSettlementRecords(records ...exchange.SettlementRecord) {
+	for _, record := range records {
+		assertions.RequireNotPanicsNoErrorf(s.T(), func() error {
+			return s.k.SetSettlementRecordInStore(s.getStore(), record)
+		}, "SetSettlementRecordInStore(%s)", s.getGenStateSettlementRecordStr(record))
+	}
+}
+
+// getAllSettlementRecords gets all the settlement records in state, requiring there to not be any errors.
+func (s *TestSuite) getAllSettlementRecords() []exchange.SettlementRecord {
+	var rv []exchange.SettlementRecord
+	err := s.k.IterateSettlementRecords(s.ctx, func(record *exchange.SettlementRecord) bool {
+		rv = append(rv, *record)
+		return false
+	})
+	s.Require().NoError(err, "IterateSettlementRecords")
+	return rv
+}
+
+// settlementRecord creates a settlement record with a fill for each of the provided order ids.
+func (s *TestSuite) settlementRecord(marketID uint32, height int64, sequence uint32, orderIDs ...uint64) exchange.SettlementRecord {
+	rv := exchange.SettlementRecord{
+		MarketId: marketID,
+		Height:   height,
+		Sequence: sequence,
+		Time:     time.Unix(1_700_000_000+height, 0).UTC(),
+	}
+	for _, orderID := range orderIDs {
+		rv.Fills = append(rv.Fills, exchange.SettlementFill{
+			OrderId:   orderID,
+			OrderType: exchange.OrderTypeBid,
+			Owner:     s.addr2.String(),
+			Assets:    s.coin(fmt.Sprintf("%dapple", orderID)),
+			Price:     s.coin(fmt.Sprintf("%dplum", orderID*2)),
+		})
+	}
+	return rv
+}
+
+func (s *TestSuite) TestKeeper_RecordSettlement() {
+	blockTime := time.Unix(1_700_000_000, 500_000_000).UTC()
+	askOrder := exchange.NewOrder(3).WithAsk(&exchange.AskOrder{
+		MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("10apple"), Price: s.coin("20plum"),
+	})
+	bidOrder := exchange.NewOrder(4).WithBid(&exchange.BidOrder{
+		MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("10apple"), Price: s.coin("25plum"),
+		BuyerSettlementFees: s.coins("1plum"), ExternalId: "bid4",
+	})
+	settlement := &exchange.Settlement{
+		FullyFilledOrders: []*exchange.FilledOrder{
+			exchange.NewFilledOrder(askOrder, s.coin("25plum"), nil),
+			exchange.NewFilledOrder(bidOrder, s.coin("25plum"), s.coins("1plum")),
+		},
+	}
+	record := func(marketID uint32, height int64, sequence uint32) exchange.SettlementRecord {
+		return exchange.SettlementRecord{
+			MarketId: marketID,
+			Height:   height,
+			Sequence: sequence,
+			Time:     blockTime,
+			Fills: []exchange.SettlementFill{
+				{
+					OrderId: 3, OrderType: exchange.OrderTypeAsk, Owner: s.addr1.String(),
+					Assets: s.coin("10apple"), Price: s.coin("25plum"),
+				},
+				{
+					OrderId: 4, OrderType: exchange.OrderTypeBid, Owner: s.addr2.String(),
+					Assets: s.coin("10apple"), Price: s.coin("25plum"), Fees: s.coins("1plum"), ExternalId: "bid4",
+				},
+			},
+		}
+	}
+	historyOn := func() {
+		s.k.SetParams(s.ctx, &exchange.Params{SettlementHistoryBlocks: 100})
+	}
+
+	tests := []struct {
+		name       string
+		setup      func()
+		marketID   uint32
+		height     int64
+		expRecords []exchange.SettlementRecord
+	}{
+		{
+			name:     "settlement history not kept",
+			marketID: 1,
+			height:   10,
+		},
+		{
+			name:       "first settlement in a block",
+			setup:      historyOn,
+			marketID:   1,
+			height:     10,
+			expRecords: []exchange.SettlementRecord{record(1, 10, 0)},
+		},
+		{
+			name: "second settlement in a block",
+			setup: func() {
+				historyOn()
+				s.requireSetSettlementRecords(
+					s.settlementRecord(1, 9, 0, 1),
+					s.settlementRecord(1, 9, 1, 2),
+					s.settlementRecord(1, 10, 0, 5),
+					s.settlementRecord(2, 10, 0, 6),
+					s.settlementRecord(2, 10, 1, 7),
+				)
+			},
+			marketID: 1,
+			height:   10,
+			expRecords: []exchange.SettlementRecord{
+				s.settlementRecord(1, 9, 0, 1),
+				s.settlementRecord(1, 9, 1, 2),
+				s.settlementRecord(1, 10, 0, 5),
+				record(1, 10, 1),
+				s.settlementRecord(2, 10, 0, 6),
+				s.settlementRecord(2, 10, 1, 7),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			ctx := s.ctx.WithBlockTime(blockTime).WithBlockHeight(tc.height)
+			testFunc := func() {
+				s.k.RecordSettlement(ctx, tc.marketID, settlement)
+			}
+			s.Require().NotPanics(testFunc, "RecordSettlement")
+			actRecords := s.getAllSettlementRecords()
+			assertEqualSlice(s, tc.expRecords, actRecords, s.getGenStateSettlementRecordStr, "settlement records in state")
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_PruneSettlementRecords() {
+	defaultSetup := func(historyBlocks uint64) func() {
+		return func() {
+			s.k.SetParams(s.ctx, &exchange.Params{SettlementHistoryBlocks: historyBlocks})
+			keeper.SetMarketKnown(s.getStore(), 1)
+			keeper.SetMarketKnown(s.getStore(), 2)
+			s.requireSetSettlementRecords(
+				s.settlementRecord(1, 5, 0, 1),
+				s.settlementRecord(1, 5, 1, 2),
+				s.settlementRecord(1, 8, 0, 3),
+				s.settlementRecord(2, 4, 0, 4),
+				s.settlementRecord(2, 9, 0, 5),
+			)
+		}
+	}
+
+	tests := []struct {
+		name        string
+		setup       func()
+		height      int64
+		maxToDelete int
+		expCount    int
+		expRecords  []exchange.SettlementRecord
+	}{
+		{
+			name:        "no records",
+			setup:       func() { s.k.SetParams(s.ctx, &exchange.Params{SettlementHistoryBlocks: 3}) },
+			height:      10,
+			maxToDelete: 100,
+			expCount:    0,
+		},
+		{
+			name:        "history longer than the chain",
+			setup:       defaultSetup(10),
+			height:      10,
+			maxToDelete: 100,
+			expCount:    0,
+			expRecords: []exchange.SettlementRecord{
+				s.settlementRecord(1, 5, 0, 1),
+				s.settlementRecord(1, 5, 1, 2),
+				s.settlementRecord(1, 8, 0, 3),
+				s.settlementRecord(2, 4, 0, 4),
+				s.settlementRecord(2, 9, 0, 5),
+			},
+		},
+		{
+			name:        "nothing old enough",
+			setup:       defaultSetup(7),
+			height:      10,
+			maxToDelete: 100,
+			expCount:    0,
+			expRecords: []exchange.SettlementRecord{
+				s.settlementRecord(1, 5, 0, 1),
+				s.settlementRecord(1, 5, 1, 2),
+				s.settlementRecord(1, 8, 0, 3),
+				s.settlementRecord(2, 4, 0, 4),
+				s.settlementRecord(2, 9, 0, 5),
+			},
+		},
+		{
+			name:        "records at the cutoff are deleted",
+			setup:       defaultSetup(5),
+			height:      10,
+			maxToDelete: 100,
+			expCount:    3,
+			expRecords: []exchange.SettlementRecord{
+				s.settlementRecord(1, 8, 0, 3),
+				s.settlementRecord(2, 9, 0, 5),
+			},
+		},
+		{
+			name:        "limited by max to delete",
+			setup:       defaultSetup(5),
+			height:      10,
+			maxToDelete: 2,
+			expCount:    2,
+			expRecords: []exchange.SettlementRecord{
+				s.settlementRecord(1, 8, 0, 3),
+				s.settlementRecord(2, 4, 0, 4),
+				s.settlementRecord(2, 9, 0, 5),
+			},
+		},
+		{
+			name:        "history not kept: all deleted",
+			setup:       defaultSetup(0),
+			height:      10,
+			maxToDelete: 100,
+			expCount:    5,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			ctx := s.ctx.WithBlockHeight(tc.height)
+			var count int
+			testFunc := func() {
+				count = s.k.PruneSettlementRecords(ctx, tc.maxToDelete)
+			}
+			s.Require().NotPanics(testFunc, "PruneSettlementRecords")
+			s.Assert().Equal(tc.expCount, count, "PruneSettlementRecords result")
+			actRecords := s.getAllSettlementRecords()
+			assertEqualSlice(s, tc.expRecords, actRecords, s.getGenStateSettlementRecordStr, "settlement records in state")
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_IterateSettlementRecords() {
+	var records []exchange.SettlementRecord
+	getAll := func(record *exchange.SettlementRecord) bool {
+		records = append(records, *record)
+		return false
+	}
+	stopAfter := func(n int) func(record *exchange.SettlementRecord) bool {
+		return func(record *exchange.SettlementRecord) bool {
+			records = append(records, *record)
+			return len(records) >= n
+		}
+	}
+	defaultSetup := func() {
+		s.requireSetSettlementRecords(
+			s.settlementRecord(2, 3, 0, 1),
+			s.settlementRecord(1, 7, 0, 2),
+			s.settlementRecord(1, 3, 0, 3),
+		)
+	}
+
+	tests := []struct {
+		name       string
+		setup      func()
+		cb         func(record *exchange.SettlementRecord) bool
+		expRecords []exchange.SettlementRecord
+		expErr     string
+	}{
+		{
+			name: "no records",
+			cb:   getAll,
+		},
+		{
+			name:  "three records",
+			setup: defaultSetup,
+			cb:    getAll,
+			expRecords: []exchange.SettlementRecord{
+				s.settlementRecord(1, 3, 0, 3),
+				s.settlementRecord(1, 7, 0, 2),
+				s.settlementRecord(2, 3, 0, 1),
+			},
+		},
+		{
+			name:  "stop after two",
+			setup: defaultSetup,
+			cb:    stopAfter(2),
+			expRecords: []exchange.SettlementRecord{
+				s.settlementRecord(1, 3, 0, 3),
+				s.settlementRecord(1, 7, 0, 2),
+			},
+		},
+		{
+			name: "bad value",
+			setup: func() {
+				defaultSetup()
+				s.getStore().Set(keeper.MakeKeySettlementRecord(1, 5, 0), []byte("x"))
+			},
+			cb: getAll,
+			expRecords: []exchange.SettlementRecord{
+				s.settlementRecord(1, 3, 0, 3),
+				s.settlementRecord(1, 7, 0, 2),
+				s.settlementRecord(2, 3, 0, 1),
+			},
+			expErr: "failed to unmarshal settlement record: unexpected EOF",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			records = nil
+			var err error
+			testFunc := func() {
+				err = s.k.IterateSettlementRecords(s.ctx, tc.cb)
+			}
+			s.Require().NotPanics(testFunc, "IterateSettlementRecords")
+			s.assertErrorValue(err, tc.expErr, "IterateSettlementRecords error")
+			assertEqualSlice(s, tc.expRecords, records, s.getGenStateSettlementRecordStr, "IterateSettlementRecords records")
+		})
+	}
+}
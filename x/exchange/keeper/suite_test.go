@@ -20,6 +20,7 @@ import (
 
 	"github.com/provenance-io/provenance/app"
 	"github.com/provenance-io/provenance/testutil/assertions"
+	"github.com/provenance-io/provenance/testutil/fixtures"
 	"github.com/provenance-io/provenance/x/exchange"
 	"github.com/provenance-io/provenance/x/exchange/keeper"
 	markertypes "github.com/provenance-io/provenance/x/marker/types"
@@ -133,19 +134,12 @@ func sliceString[T any](vals []T, stringer func(T) string) string {
 
 // copySlice returns a copy of a slice using the provided copier for each value.
 func copySlice[T any](vals []T, copier func(T) T) []T {
-	if vals == nil {
-		return nil
-	}
-	rv := make([]T, len(vals))
-	for i, v := range vals {
-		rv[i] = copier(v)
-	}
-	return rv
+	return fixtures.CopySlice(vals, copier)
 }
 
 // noOpCopier is a passthrough "copier" function that just returns the exact same thing that was provided.
 func noOpCopier[T any](val T) T {
-	return val
+	return fixtures.NoOpCopier(val)
 }
 
 // reverseSlice returns a new slice with the entries reversed.
@@ -261,167 +255,122 @@ func (s *TestSuite) joinErrs(errs ...string) string {
 
 // copyCoin creates a copy of a coin (as best as possible).
 func (s *TestSuite) copyCoin(orig sdk.Coin) sdk.Coin {
-	return sdk.NewCoin(orig.Denom, orig.Amount.AddRaw(0))
+	return fixtures.CopyCoin(orig)
 }
 
 // copyCoinP copies a coin that's a reference.
 func (s *TestSuite) copyCoinP(orig *sdk.Coin) *sdk.Coin {
-	if orig == nil {
-		return nil
-	}
-	rv := s.copyCoin(*orig)
-	return &rv
+	return fixtures.CopyCoinP(orig)
 }
 
 // copyCoins creates a copy of coins (as best as possible).
 func (s *TestSuite) copyCoins(orig []sdk.Coin) []sdk.Coin {
-	return copySlice(orig, s.copyCoin)
+	return fixtures.CopyCoins(orig)
 }
 
 // copyRatio creates a copy of a FeeRatio.
 func (s *TestSuite) copyRatio(orig exchange.FeeRatio) exchange.FeeRatio {
-	return exchange.FeeRatio{
-		Price: s.copyCoin(orig.Price),
-		Fee:   s.copyCoin(orig.Fee),
-	}
+	return fixtures.CopyRatio(orig)
 }
 
 // copyRatios creates a copy of a slice of FeeRatios.
 func (s *TestSuite) copyRatios(orig []exchange.FeeRatio) []exchange.FeeRatio {
-	return copySlice(orig, s.copyRatio)
+	return fixtures.CopyRatios(orig)
 }
 
 // copyAccessGrant creates a copy of an AccessGrant.
 func (s *TestSuite) copyAccessGrant(orig exchange.AccessGrant) exchange.AccessGrant {
-	return exchange.AccessGrant{
-		Address:     orig.Address,
-		Permissions: copySlice(orig.Permissions, noOpCopier[exchange.Permission]),
-	}
+	return fixtures.CopyAccessGrant(orig)
 }
 
 // copyAccessGrants creates a copy of a slice of AccessGrants.
 func (s *TestSuite) copyAccessGrants(orig []exchange.AccessGrant) []exchange.AccessGrant {
-	return copySlice(orig, s.copyAccessGrant)
+	return fixtures.CopyAccessGrants(orig)
 }
 
 // copyStrings creates a copy of a slice of strings.
 func (s *TestSuite) copyStrings(orig []string) []string {
-	return copySlice(orig, noOpCopier[string])
+	return fixtures.CopyStrings(orig)
 }
 
 // copyMarket creates a deep copy of a market.
 func (s *TestSuite) copyMarket(orig exchange.Market) exchange.Market {
-	return exchange.Market{
-		MarketId: orig.MarketId,
-		MarketDetails: exchange.MarketDetails{
-			Name:        orig.MarketDetails.Name,
-			Description: orig.MarketDetails.Description,
-			WebsiteUrl:  orig.MarketDetails.WebsiteUrl,
-			IconUri:     orig.MarketDetails.IconUri,
-		},
-		FeeCreateAskFlat:          s.copyCoins(orig.FeeCreateAskFlat),
-		FeeCreateBidFlat:          s.copyCoins(orig.FeeCreateBidFlat),
-		FeeSellerSettlementFlat:   s.copyCoins(orig.FeeSellerSettlementFlat),
-		FeeSellerSettlementRatios: s.copyRatios(orig.FeeSellerSettlementRatios),
-		FeeBuyerSettlementFlat:    s.copyCoins(orig.FeeBuyerSettlementFlat),
-		FeeBuyerSettlementRatios:  s.copyRatios(orig.FeeBuyerSettlementRatios),
-		AcceptingOrders:           orig.AcceptingOrders,
-		AllowUserSettlement:       orig.AllowUserSettlement,
-		AccessGrants:              s.copyAccessGrants(orig.AccessGrants),
-		ReqAttrCreateAsk:          s.copyStrings(orig.ReqAttrCreateAsk),
-		ReqAttrCreateBid:          s.copyStrings(orig.ReqAttrCreateBid),
-		AcceptingCommitments:      orig.AcceptingCommitments,
-		FeeCreateCommitmentFlat:   s.copyCoins(orig.FeeCreateCommitmentFlat),
-		CommitmentSettlementBips:  orig.CommitmentSettlementBips,
-		IntermediaryDenom:         orig.IntermediaryDenom,
-		ReqAttrCreateCommitment:   s.copyStrings(orig.ReqAttrCreateCommitment),
-	}
+	return fixtures.CopyMarket(orig)
 }
 
 // copyMarkets creates a copy of a slice of markets.
 func (s *TestSuite) copyMarkets(orig []exchange.Market) []exchange.Market {
-	return copySlice(orig, s.copyMarket)
+	return fixtures.CopyMarkets(orig)
 }
 
 // copyOrder creates a copy of an order.
 func (s *TestSuite) copyOrder(orig exchange.Order) exchange.Order {
-	rv := exchange.NewOrder(orig.OrderId)
-	switch {
-	case orig.IsAskOrder():
-		rv.WithAsk(s.copyAskOrder(orig.GetAskOrder()))
-	case orig.IsBidOrder():
-		rv.WithBid(s.copyBidOrder(orig.GetBidOrder()))
-	default:
-		rv.Order = orig.Order
-	}
-	return *rv
+	return fixtures.CopyOrder(orig)
 }
 
 // copyOrders creates a copy of a slice of orders.
 func (s *TestSuite) copyOrders(orig []exchange.Order) []exchange.Order {
-	return copySlice(orig, s.copyOrder)
+	return fixtures.CopyOrders(orig)
+}
+
+// copyTriggerOrders creates a copy of a slice of trigger orders.
+func (s *TestSuite) copyTriggerOrders(orig []exchange.TriggerOrder) []exchange.TriggerOrder {
+	return fixtures.CopyTriggerOrders(orig)
+}
+
+// copyOrderLinks creates a copy of a slice of order links.
+func (s *TestSuite) copyOrderLinks(orig []exchange.OrderLink) []exchange.OrderLink {
+	return fixtures.CopyOrderLinks(orig)
+}
+
+// copySettlementPrices creates a copy of a slice of settlement prices.
+func (s *TestSuite) copySettlementPrices(orig []exchange.SettlementPrice) []exchange.SettlementPrice {
+	return fixtures.CopySettlementPrices(orig)
+}
+
+// copySettlementRecords creates a copy of a slice of settlement records.
+func (s *TestSuite) copySettlementRecords(orig []exchange.SettlementRecord) []exchange.SettlementRecord {
+	return fixtures.CopySettlementRecords(orig)
 }
 
 // copyAskOrder creates a copy of an AskOrder.
 func (s *TestSuite) copyAskOrder(orig *exchange.AskOrder) *exchange.AskOrder {
-	if orig == nil {
-		return nil
-	}
-	return &exchange.AskOrder{
-		MarketId:                orig.MarketId,
-		Seller:                  orig.Seller,
-		Assets:                  s.copyCoin(orig.Assets),
-		Price:                   s.copyCoin(orig.Price),
-		SellerSettlementFlatFee: s.copyCoinP(orig.SellerSettlementFlatFee),
-		AllowPartial:            orig.AllowPartial,
-		ExternalId:              orig.ExternalId,
-	}
+	return fixtures.CopyAskOrder(orig)
 }
 
 // copyBidOrder creates a copy of a BidOrder.
 func (s *TestSuite) copyBidOrder(orig *exchange.BidOrder) *exchange.BidOrder {
-	if orig == nil {
-		return nil
-	}
-	return &exchange.BidOrder{
-		MarketId:            orig.MarketId,
-		Buyer:               orig.Buyer,
-		Assets:              s.copyCoin(orig.Assets),
-		Price:               s.copyCoin(orig.Price),
-		BuyerSettlementFees: s.copyCoins(orig.BuyerSettlementFees),
-		AllowPartial:        orig.AllowPartial,
-		ExternalId:          orig.ExternalId,
-	}
+	return fixtures.CopyBidOrder(orig)
 }
 
 // copyCommitment creates a copy of a commitment.
 func (s *TestSuite) copyCommitment(orig exchange.Commitment) exchange.Commitment {
-	return exchange.Commitment{
-		Account:  orig.Account,
-		MarketId: orig.MarketId,
-		Amount:   s.copyCoins(orig.Amount),
-	}
+	return fixtures.CopyCommitment(orig)
 }
 
 // copyCommitments creates a copy of a slice of commitments.
 func (s *TestSuite) copyCommitments(orig []exchange.Commitment) []exchange.Commitment {
-	return copySlice(orig, s.copyCommitment)
+	return fixtures.CopyCommitments(orig)
+}
+
+// copyMarketAmounts creates a copy of a slice of market amounts.
+func (s *TestSuite) copyMarketAmounts(orig []exchange.MarketAmount) []exchange.MarketAmount {
+	return fixtures.CopyMarketAmounts(orig)
+}
+
+// copyCommitmentRewards creates a copy of a slice of commitment rewards.
+func (s *TestSuite) copyCommitmentRewards(orig []exchange.CommitmentReward) []exchange.CommitmentReward {
+	return fixtures.CopyCommitmentRewards(orig)
 }
 
 // copyPayment creates a copy of a payment.
 func (s *TestSuite) copyPayment(orig exchange.Payment) exchange.Payment {
-	return exchange.Payment{
-		Source:       orig.Source,
-		SourceAmount: s.copyCoins(orig.SourceAmount),
-		Target:       orig.Target,
-		TargetAmount: s.copyCoins(orig.TargetAmount),
-		ExternalId:   orig.ExternalId,
-	}
+	return fixtures.CopyPayment(orig)
 }
 
 // copyPayments creates a coy of a slice of payments.
 func (s *TestSuite) copyPayments(orig []exchange.Payment) []exchange.Payment {
-	return copySlice(orig, s.copyPayment)
+	return fixtures.CopyPayments(orig)
 }
 
 // untypeEvent applies sdk.TypedEventToEvent(tev) requiring it to not error.
@@ -444,28 +393,17 @@ func untypeEvents[P proto.Message](s *TestSuite, tevs []P) sdk.Events {
 
 // creates a copy of a DenomSplit.
 func (s *TestSuite) copyDenomSplit(orig exchange.DenomSplit) exchange.DenomSplit {
-	return exchange.DenomSplit{
-		Denom: orig.Denom,
-		Split: orig.Split,
-	}
+	return fixtures.CopyDenomSplit(orig)
 }
 
 // copyDenomSplits creates a copy of a slice of DenomSplits.
 func (s *TestSuite) copyDenomSplits(orig []exchange.DenomSplit) []exchange.DenomSplit {
-	return copySlice(orig, s.copyDenomSplit)
+	return fixtures.CopyDenomSplits(orig)
 }
 
 // copyParams creates a copy of exchange Params.
 func (s *TestSuite) copyParams(orig *exchange.Params) *exchange.Params {
-	if orig == nil {
-		return nil
-	}
-	return &exchange.Params{
-		DefaultSplit:         orig.DefaultSplit,
-		DenomSplits:          s.copyDenomSplits(orig.DenomSplits),
-		FeeCreatePaymentFlat: s.copyCoins(orig.FeeCreatePaymentFlat),
-		FeeAcceptPaymentFlat: s.copyCoins(orig.FeeAcceptPaymentFlat),
-	}
+	return fixtures.CopyParams(orig)
 }
 
 // copyGenState creates a copy of a GenesisState.
@@ -474,13 +412,30 @@ func (s *TestSuite) copyGenState(genState *exchange.GenesisState) *exchange.Gene
 		return nil
 	}
 	return &exchange.GenesisState{
-		Params:       s.copyParams(genState.Params),
-		Markets:      s.copyMarkets(genState.Markets),
-		Orders:       s.copyOrders(genState.Orders),
-		LastMarketId: genState.LastMarketId,
-		LastOrderId:  genState.LastOrderId,
-		Commitments:  s.copyCommitments(genState.Commitments),
-		Payments:     s.copyPayments(genState.Payments),
+		Params:              s.copyParams(genState.Params),
+		Markets:             s.copyMarkets(genState.Markets),
+		Orders:              s.copyOrders(genState.Orders),
+		LastMarketId:        genState.LastMarketId,
+		LastOrderId:         genState.LastOrderId,
+		Commitments:         s.copyCommitments(genState.Commitments),
+		Payments:            s.copyPayments(genState.Payments),
+		RecurringPayments:   fixtures.CopyRecurringPayments(genState.RecurringPayments),
+		MultiPartyPayments:  fixtures.CopyMultiPartyPayments(genState.MultiPartyPayments),
+		EscrowedPayments:    fixtures.CopyEscrowedPayments(genState.EscrowedPayments),
+		TriggerOrders:       s.copyTriggerOrders(genState.TriggerOrders),
+		OrderLinks:          s.copyOrderLinks(genState.OrderLinks),
+		SettlementPrices:    s.copySettlementPrices(genState.SettlementPrices),
+		SettlementRecords:   s.copySettlementRecords(genState.SettlementRecords),
+		NavRecords:          fixtures.CopyNAVRecords(genState.NavRecords),
+		RewardPools:         s.copyMarketAmounts(genState.RewardPools),
+		CommitmentRewards:   s.copyCommitmentRewards(genState.CommitmentRewards),
+		ScheduledFeeChanges: fixtures.CopyMsgGovManageFeesRequests(genState.ScheduledFeeChanges),
+		AuthorityTransfers:  fixtures.CopyMarketAuthorityTransfers(genState.AuthorityTransfers),
+		IdempotencyKeys:     fixtures.CopyIdempotencyKeyRecords(genState.IdempotencyKeys),
+		LastSettlementId:    genState.LastSettlementId,
+		SettlementReceipts:  fixtures.CopySettlementReceipts(genState.SettlementReceipts),
+		DeadManSwitches:     fixtures.CopyDeadManSwitches(genState.DeadManSwitches),
+		CommitmentAccruals:  fixtures.CopyCommitmentAccruals(genState.CommitmentAccruals),
 	}
 }
 
@@ -553,6 +508,93 @@ func (s *TestSuite) sortGenState(genState *exchange.GenesisState) *exchange.Gene
 		})
 	}
 
+	if len(genState.TriggerOrders) > 0 {
+		sort.Slice(genState.TriggerOrders, func(i, j int) bool {
+			return genState.TriggerOrders[i].GetOrderID() < genState.TriggerOrders[j].GetOrderID()
+		})
+	}
+
+	if len(genState.OrderLinks) > 0 {
+		sort.Slice(genState.OrderLinks, func(i, j int) bool {
+			if genState.OrderLinks[i].MarketId != genState.OrderLinks[j].MarketId {
+				return genState.OrderLinks[i].MarketId < genState.OrderLinks[j].MarketId
+			}
+			return genState.OrderLinks[i].OrderId < genState.OrderLinks[j].OrderId
+		})
+	}
+
+	if len(genState.SettlementPrices) > 0 {
+		sort.Slice(genState.SettlementPrices, func(i, j int) bool {
+			pi, pj := genState.SettlementPrices[i], genState.SettlementPrices[j]
+			keyi := keeper.MakeKeySettlementPrice(pi.MarketId, pi.Assets.Denom, pi.Price.Denom, pi.Time)
+			keyj := keeper.MakeKeySettlementPrice(pj.MarketId, pj.Assets.Denom, pj.Price.Denom, pj.Time)
+			return bytes.Compare(keyi, keyj) < 0
+		})
+	}
+
+	if len(genState.SettlementRecords) > 0 {
+		sort.Slice(genState.SettlementRecords, func(i, j int) bool {
+			ri, rj := genState.SettlementRecords[i], genState.SettlementRecords[j]
+			keyi := keeper.MakeKeySettlementRecord(ri.MarketId, ri.Height, ri.Sequence)
+			keyj := keeper.MakeKeySettlementRecord(rj.MarketId, rj.Height, rj.Sequence)
+			return bytes.Compare(keyi, keyj) < 0
+		})
+	}
+
+	if len(genState.NavRecords) > 0 {
+		sort.Slice(genState.NavRecords, func(i, j int) bool {
+			ri, rj := genState.NavRecords[i], genState.NavRecords[j]
+			keyi := keeper.MakeKeyNAVRecord(ri.Assets.Denom, ri.Price.Denom, ri.Height, ri.MarketId)
+			keyj := keeper.MakeKeyNAVRecord(rj.Assets.Denom, rj.Price.Denom, rj.Height, rj.MarketId)
+			return bytes.Compare(keyi, keyj) < 0
+		})
+	}
+
+	if len(genState.ScheduledFeeChanges) > 0 {
+		// Scheduled fee changes are ordered by market, but otherwise keep the order they were scheduled in.
+		sort.SliceStable(genState.ScheduledFeeChanges, func(i, j int) bool {
+			return genState.ScheduledFeeChanges[i].MarketId < genState.ScheduledFeeChanges[j].MarketId
+		})
+	}
+
+	if len(genState.AuthorityTransfers) > 0 {
+		sort.Slice(genState.AuthorityTransfers, func(i, j int) bool {
+			return genState.AuthorityTransfers[i].MarketId < genState.AuthorityTransfers[j].MarketId
+		})
+	}
+
+	if len(genState.IdempotencyKeys) > 0 {
+		sort.Slice(genState.IdempotencyKeys, func(i, j int) bool {
+			ri, rj := genState.IdempotencyKeys[i], genState.IdempotencyKeys[j]
+			keyi := keeper.MakeKeyIdempotencyKey(sdk.MustAccAddressFromBech32(ri.Account), ri.IdempotencyKey)
+			keyj := keeper.MakeKeyIdempotencyKey(sdk.MustAccAddressFromBech32(rj.Account), rj.IdempotencyKey)
+			return bytes.Compare(keyi, keyj) < 0
+		})
+	}
+
+	if len(genState.SettlementReceipts) > 0 {
+		sort.Slice(genState.SettlementReceipts, func(i, j int) bool {
+			return genState.SettlementReceipts[i].SettlementId < genState.SettlementReceipts[j].SettlementId
+		})
+	}
+
+	if len(genState.DeadManSwitches) > 0 {
+		sort.Slice(genState.DeadManSwitches, func(i, j int) bool {
+			keyi := keeper.MakeKeyDeadManSwitch(sdk.MustAccAddressFromBech32(genState.DeadManSwitches[i].Account))
+			keyj := keeper.MakeKeyDeadManSwitch(sdk.MustAccAddressFromBech32(genState.DeadManSwitches[j].Account))
+			return bytes.Compare(keyi, keyj) < 0
+		})
+	}
+
+	if len(genState.CommitmentAccruals) > 0 {
+		sort.Slice(genState.CommitmentAccruals, func(i, j int) bool {
+			ai, aj := genState.CommitmentAccruals[i], genState.CommitmentAccruals[j]
+			keyi := keeper.MakeKeyCommitmentAccrual(ai.MarketId, sdk.MustAccAddressFromBech32(ai.Account))
+			keyj := keeper.MakeKeyCommitmentAccrual(aj.MarketId, sdk.MustAccAddressFromBech32(aj.Account))
+			return bytes.Compare(keyi, keyj) < 0
+		})
+	}
+
 	if len(genState.Commitments) > 0 {
 		sort.Slice(genState.Commitments, func(i, j int) bool {
 			// compare market ids first
@@ -655,31 +697,17 @@ func (s *TestSuite) getPaymentPString(payment *exchange.Payment) string {
 
 // agCanOnly creates an AccessGrant for the given address with only the provided permission.
 func (s *TestSuite) agCanOnly(addr sdk.AccAddress, perm exchange.Permission) exchange.AccessGrant {
-	return exchange.AccessGrant{
-		Address:     addr.String(),
-		Permissions: []exchange.Permission{perm},
-	}
+	return fixtures.AgCanOnly(addr, perm)
 }
 
 // agCanAllBut creates an AccessGrant for the given address with all permissions except the provided one.
 func (s *TestSuite) agCanAllBut(addr sdk.AccAddress, perm exchange.Permission) exchange.AccessGrant {
-	rv := exchange.AccessGrant{
-		Address: addr.String(),
-	}
-	for _, p := range exchange.AllPermissions() {
-		if p != perm {
-			rv.Permissions = append(rv.Permissions, p)
-		}
-	}
-	return rv
+	return fixtures.AgCanAllBut(addr, perm)
 }
 
 // agCanEverything creates an AccessGrant for the given address with all permissions available.
 func (s *TestSuite) agCanEverything(addr sdk.AccAddress) exchange.AccessGrant {
-	return exchange.AccessGrant{
-		Address:     addr.String(),
-		Permissions: exchange.AllPermissions(),
-	}
+	return fixtures.AgCanEverything(addr)
 }
 
 // addAddrLookup adds an entry to the addrLookupMap (for use in getAddrName).
@@ -1000,37 +1028,19 @@ func (s *TestSuite) markerAddr(denom string) sdk.AccAddress {
 
 // markerAccount returns a new marker account with the given supply.
 func (s *TestSuite) markerAccount(supplyCoinStr string) markertypes.MarkerAccountI {
-	supply := s.coin(supplyCoinStr)
-	return &markertypes.MarkerAccount{
-		BaseAccount: &authtypes.BaseAccount{Address: s.markerAddr(supply.Denom).String()},
-		Status:      markertypes.StatusActive,
-		Denom:       supply.Denom,
-		Supply:      supply.Amount,
-		MarkerType:  markertypes.MarkerType_RestrictedCoin,
-		SupplyFixed: true,
-	}
+	rv := fixtures.NewMarkerAccount(supplyCoinStr)
+	s.addAddrLookup(rv.GetAddress(), rv.Denom+"MarkerAddr")
+	return rv
 }
 
 // markerNavSetEvent returns a new marke module EventSetNetAssetValue converted to sdk.Event.
 func (s *TestSuite) markerNavSetEvent(assetsStr, priceStr string, marketID uint32) sdk.Event {
-	assets := s.coin(assetsStr)
-	event := &markertypes.EventSetNetAssetValue{
-		Denom:  assets.Denom,
-		Price:  priceStr,
-		Volume: assets.Amount.String(),
-		Source: fmt.Sprintf("x/exchange market %d", marketID),
-	}
-	return s.untypeEvent(event)
+	return fixtures.MarkerNAVSetEvent(assetsStr, priceStr, fixtures.ExchangeNAVSource(marketID))
 }
 
 // metadataNavSetEvent returns a new metadata module EventSetNetAssetValue converted to sdk.Event.
 func (s *TestSuite) metadataNavSetEvent(scopeID, priceStr string, marketID uint32) sdk.Event {
-	event := &metadatatypes.EventSetNetAssetValue{
-		ScopeId: scopeID,
-		Price:   priceStr,
-		Source:  fmt.Sprintf("x/exchange market %d", marketID),
-	}
-	return s.untypeEvent(event)
+	return fixtures.MetadataNAVSetEvent(scopeID, priceStr, fixtures.ExchangeNAVSource(marketID))
 }
 
 func (s *TestSuite) scopeID(base string) metadatatypes.MetadataAddress {
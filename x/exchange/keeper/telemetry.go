@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/hashicorp/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+// newMarketIDLabel creates a telemetry label for the provided market id.
+func newMarketIDLabel(marketID uint32) metrics.Label {
+	return telemetry.NewLabel(exchange.TelemetryLabelMarketID, strconv.FormatUint(uint64(marketID), 10))
+}
+
+// incOrderActionCounter increments the telemetry counter for the provided action on an order.
+func incOrderActionCounter(order exchange.OrderI, action string) {
+	telemetry.IncrCounterWithLabels(
+		[]string{exchange.ModuleName, exchange.TelemetryKeyOrderAction},
+		1,
+		[]metrics.Label{
+			newMarketIDLabel(order.GetMarketID()),
+			telemetry.NewLabel(exchange.TelemetryLabelOrderType, order.GetOrderType()),
+			telemetry.NewLabel(exchange.TelemetryLabelAction, action),
+		},
+	)
+}
+
+// incCommitmentActionCounter increments the telemetry counter for the provided action on a commitment.
+func incCommitmentActionCounter(marketID uint32, action string) {
+	telemetry.IncrCounterWithLabels(
+		[]string{exchange.ModuleName, exchange.TelemetryKeyCommitmentAction},
+		1,
+		[]metrics.Label{
+			newMarketIDLabel(marketID),
+			telemetry.NewLabel(exchange.TelemetryLabelAction, action),
+		},
+	)
+}
+
+// incPaymentActionCounter increments the telemetry counter for the provided action on some payments.
+func incPaymentActionCounter(count int, action string) {
+	if count == 0 {
+		return
+	}
+	telemetry.IncrCounterWithLabels(
+		[]string{exchange.ModuleName, exchange.TelemetryKeyPaymentAction},
+		float32(count),
+		[]metrics.Label{telemetry.NewLabel(exchange.TelemetryLabelAction, action)},
+	)
+}
+
+// incSettlementCounter increments the telemetry counter for settlements in a market.
+func incSettlementCounter(marketID uint32) {
+	telemetry.IncrCounterWithLabels(
+		[]string{exchange.ModuleName, exchange.TelemetryKeySettlement},
+		1,
+		[]metrics.Label{newMarketIDLabel(marketID)},
+	)
+}
+
+// incFeesCollectedCounter increments the telemetry counter for fees collected by a market by each of the fee amounts.
+// Amounts too large for an int64 are skipped.
+func incFeesCollectedCounter(marketID uint32, fees sdk.Coins) {
+	for _, fee := range fees {
+		if !fee.Amount.IsInt64() {
+			continue
+		}
+		telemetry.IncrCounterWithLabels(
+			[]string{exchange.ModuleName, exchange.TelemetryKeyFeesCollected},
+			float32(fee.Amount.Int64()),
+			[]metrics.Label{
+				newMarketIDLabel(marketID),
+				telemetry.NewLabel(exchange.TelemetryLabelDenom, fee.Denom),
+			},
+		)
+	}
+}
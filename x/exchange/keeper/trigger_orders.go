@@ -0,0 +1,284 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+// parseTriggerOrderStoreValue converts a trigger order store value into a TriggerOrder.
+// If the value is empty then nil, nil is returned.
+func (k Keeper) parseTriggerOrderStoreValue(orderID uint64, value []byte) (*exchange.TriggerOrder, error) {
+	if len(value) == 0 {
+		return nil, nil
+	}
+
+	var triggerOrder exchange.TriggerOrder
+	if err := k.cdc.Unmarshal(value, &triggerOrder); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal trigger order %d: %w", orderID, err)
+	}
+	return &triggerOrder, nil
+}
+
+// getTriggerOrderFromStore gets a trigger order from the store. Returns nil, nil if it does not exist.
+func (k Keeper) getTriggerOrderFromStore(store storetypes.KVStore, orderID uint64) (*exchange.TriggerOrder, error) {
+	value := store.Get(MakeKeyTriggerOrder(orderID))
+	return k.parseTriggerOrderStoreValue(orderID, value)
+}
+
+// setTriggerOrderInStore writes a trigger order to the store along with its index entries.
+func (k Keeper) setTriggerOrderInStore(store storetypes.KVStore, triggerOrder exchange.TriggerOrder) error {
+	orderID := triggerOrder.GetOrderID()
+	owner := triggerOrder.Order.GetOwner()
+	ownerAddr, err := sdk.AccAddressFromBech32(owner)
+	if err != nil {
+		return fmt.Errorf("invalid trigger order %d owner %q: %w", orderID, owner, err)
+	}
+	value, err := k.cdc.Marshal(&triggerOrder)
+	if err != nil {
+		return fmt.Errorf("failed to marshal trigger order %d: %w", orderID, err)
+	}
+	indexValue := []byte{triggerOrder.Order.GetOrderTypeByte()}
+	store.Set(MakeKeyTriggerOrder(orderID), value)
+	store.Set(MakeIndexKeyMarketToTriggerOrder(triggerOrder.GetMarketID(), orderID), indexValue)
+	store.Set(MakeIndexKeyAddressToTriggerOrder(ownerAddr, orderID), indexValue)
+	return nil
+}
+
+// deleteAndDeIndexTriggerOrder deletes a trigger order from the store along with its index entries.
+func deleteAndDeIndexTriggerOrder(store storetypes.KVStore, triggerOrder exchange.TriggerOrder) {
+	orderID := triggerOrder.GetOrderID()
+	store.Delete(MakeKeyTriggerOrder(orderID))
+	store.Delete(MakeIndexKeyMarketToTriggerOrder(triggerOrder.GetMarketID(), orderID))
+	if ownerAddr, err := sdk.AccAddressFromBech32(triggerOrder.Order.GetOwner()); err == nil {
+		store.Delete(MakeIndexKeyAddressToTriggerOrder(ownerAddr, orderID))
+	}
+}
+
+// getMarketTriggerOrdersFromStore gets all the trigger orders in a market.
+// Entries that cannot be read are skipped and their errors returned.
+func (k Keeper) getMarketTriggerOrdersFromStore(store storetypes.KVStore, marketID uint32) ([]*exchange.TriggerOrder, error) {
+	var rv []*exchange.TriggerOrder
+	var errs []error
+	iterate(store, GetIndexKeyPrefixMarketToTriggerOrder(marketID), func(keySuffix, _ []byte) bool {
+		orderID, ok := ParseIndexKeySuffixOrderID(keySuffix)
+		if !ok {
+			return false
+		}
+		triggerOrder, err := k.getTriggerOrderFromStore(store, orderID)
+		switch {
+		case err != nil:
+			errs = append(errs, err)
+		case triggerOrder == nil:
+			errs = append(errs, fmt.Errorf("trigger order %d not found", orderID))
+		default:
+			rv = append(rv, triggerOrder)
+		}
+		return false
+	})
+	return rv, errors.Join(errs...)
+}
+
+// validateExternalIDIsAvailable returns an error if the external id is already used by an order or trigger order in the market.
+func (k Keeper) validateExternalIDIsAvailable(store storetypes.KVStore, marketID uint32, externalID string) error {
+	if len(externalID) == 0 {
+		return nil
+	}
+	if store.Has(MakeIndexKeyMarketExternalIDToOrder(marketID, externalID)) {
+		return fmt.Errorf("external id %q is already in use in market %d", externalID, marketID)
+	}
+	triggerOrders, _ := k.getMarketTriggerOrdersFromStore(store, marketID)
+	for _, triggerOrder := range triggerOrders {
+		if triggerOrder.Order.GetExternalID() == externalID {
+			return fmt.Errorf("external id %q is already in use by trigger order %d", externalID, triggerOrder.GetOrderID())
+		}
+	}
+	return nil
+}
+
+// createTriggerOrder collects the creation fee, stores a new trigger order, and places all needed holds.
+func (k Keeper) createTriggerOrder(
+	ctx sdk.Context,
+	store storetypes.KVStore,
+	order *exchange.Order,
+	owner sdk.AccAddress,
+	triggerPrice sdk.Coin,
+	creationFee *sdk.Coin,
+) (uint64, error) {
+	marketID := order.GetMarketID()
+	orderType := order.GetOrderType()
+	if err := exchange.ValidateTriggerPrice(triggerPrice, order.GetPrice()); err != nil {
+		return 0, err
+	}
+	if err := k.validateExternalIDIsAvailable(store, marketID, order.GetExternalID()); err != nil {
+		return 0, err
+	}
+	// Trigger orders aren't in the address external id index, so this only checks against the owner's
+	// existing orders and payments. That check is redone when the trigger order is activated.
+	scope := getExternalIDScope(store, marketID)
+	if err := validateAccountExternalIDIsAvailable(store, scope, owner, order.GetExternalID(), getLastOrderID(store)+1); err != nil {
+		return 0, err
+	}
+
+	if creationFee != nil {
+		err := k.CollectFee(ctx, marketID, owner, sdk.Coins{*creationFee})
+		if err != nil {
+			return 0, fmt.Errorf("error collecting %s order creation fee: %w", orderType, err)
+		}
+	}
+
+	order.OrderId = nextOrderID(store)
+	triggerOrder := exchange.NewTriggerOrder(*order, triggerPrice)
+	if err := k.setTriggerOrderInStore(store, *triggerOrder); err != nil {
+		return 0, fmt.Errorf("error storing trigger %s order: %w", orderType, err)
+	}
+
+	if err := k.placeHoldOnOrder(ctx, order); err != nil {
+		return 0, err
+	}
+
+	k.emitEvent(ctx, exchange.NewEventTriggerOrderCreated(triggerOrder))
+	return order.OrderId, nil
+}
+
+// CreateTriggerAskOrder creates a trigger order for an ask order, collects the creation fee, and places all needed holds.
+// The ask order is activated once a settlement in its market is at or below the trigger price.
+func (k Keeper) CreateTriggerAskOrder(ctx sdk.Context, askOrder exchange.AskOrder, triggerPrice sdk.Coin, creationFee *sdk.Coin) (uint64, error) {
+	store := k.getStore(ctx)
+	seller, err := k.validateNewAskOrder(ctx, store, askOrder, creationFee)
+	if err != nil {
+		return 0, err
+	}
+	return k.createTriggerOrder(ctx, store, exchange.NewOrder(0).WithAsk(&askOrder), seller, triggerPrice, creationFee)
+}
+
+// CreateTriggerBidOrder creates a trigger order for a bid order, collects the creation fee, and places all needed holds.
+// The bid order is activated once a settlement in its market is at or above the trigger price.
+func (k Keeper) CreateTriggerBidOrder(ctx sdk.Context, bidOrder exchange.BidOrder, triggerPrice sdk.Coin, creationFee *sdk.Coin) (uint64, error) {
+	store := k.getStore(ctx)
+	buyer, err := k.validateNewBidOrder(ctx, store, bidOrder, creationFee)
+	if err != nil {
+		return 0, err
+	}
+	return k.createTriggerOrder(ctx, store, exchange.NewOrder(0).WithBid(&bidOrder), buyer, triggerPrice, creationFee)
+}
+
+// GetTriggerOrder gets a trigger order. Returns nil, nil if the trigger order does not exist.
+func (k Keeper) GetTriggerOrder(ctx sdk.Context, orderID uint64) (*exchange.TriggerOrder, error) {
+	return k.getTriggerOrderFromStore(k.getStore(ctx), orderID)
+}
+
+// cancelTriggerOrder releases a trigger order's held funds and deletes it.
+func (k Keeper) cancelTriggerOrder(ctx sdk.Context, triggerOrder *exchange.TriggerOrder, signer string) error {
+	order := &triggerOrder.Order
+	orderID := order.GetOrderID()
+	orderOwner := order.GetOwner()
+	if signer != orderOwner && !k.CanCancelOrdersForMarket(ctx, order.GetMarketID(), signer) {
+		return fmt.Errorf("account %s does not have permission to cancel order %d", signer, orderID)
+	}
+
+	if err := k.cancelLinkedOrder(ctx, order); err != nil {
+		return err
+	}
+
+	orderOwnerAddr := sdk.MustAccAddressFromBech32(orderOwner)
+	if err := k.holdKeeper.ReleaseHold(ctx, orderOwnerAddr, order.GetHoldAmount()); err != nil {
+		return fmt.Errorf("unable to release hold on order %d funds: %w", orderID, err)
+	}
+
+	deleteAndDeIndexTriggerOrder(k.getStore(ctx), *triggerOrder)
+	k.emitEvent(ctx, exchange.NewEventOrderCancelled(order, signer))
+	return k.Hooks().OnOrderCancelled(ctx, order, signer)
+}
+
+// IterateTriggerOrders iterates over all trigger orders. An error is returned if there was a problem
+// reading an entry along the way. Such a problem does not interrupt iteration.
+// The callback takes in the trigger order and should return whether to stop iterating.
+func (k Keeper) IterateTriggerOrders(ctx sdk.Context, cb func(triggerOrder *exchange.TriggerOrder) bool) error {
+	var errs []error
+	k.iterate(ctx, GetKeyPrefixTriggerOrder(), func(key, value []byte) bool {
+		orderID, _ := uint64FromBz(key)
+		triggerOrder, err := k.parseTriggerOrderStoreValue(orderID, value)
+		if err != nil {
+			errs = append(errs, err)
+			return false
+		}
+		return cb(triggerOrder)
+	})
+	return errors.Join(errs...)
+}
+
+// IterateAddressTriggerOrders iterates over all trigger orders for an address.
+// The callback takes in the order id and order type byte and should return whether to stop iterating.
+func (k Keeper) IterateAddressTriggerOrders(ctx sdk.Context, addr sdk.AccAddress, cb func(orderID uint64, orderTypeByte byte) bool) {
+	k.iterateOrderIndex(ctx, GetIndexKeyPrefixAddressToTriggerOrder(addr), cb)
+}
+
+// activateTriggerOrders activates all trigger orders in a market that are triggered by any of the provided navs.
+// An activated trigger order is moved into the order book, keeping its order id and hold.
+// If a trigger order cannot be activated, the error is logged and it stays waiting.
+func (k Keeper) activateTriggerOrders(ctx sdk.Context, marketID uint32, navs []exchange.NetAssetPrice) {
+	if len(navs) == 0 {
+		return
+	}
+
+	store := k.getStore(ctx)
+	triggerOrders, err := k.getMarketTriggerOrdersFromStore(store, marketID)
+	if err != nil {
+		k.logErrorf(ctx, "error reading trigger orders for market %d: %v", marketID, err)
+	}
+
+	for _, triggerOrder := range triggerOrders {
+		// Activating an order can lead to a settlement that activates other trigger orders,
+		// so make sure this one is still waiting before doing anything with it.
+		if !store.Has(MakeKeyTriggerOrder(triggerOrder.GetOrderID())) {
+			continue
+		}
+		for _, nav := range navs {
+			if !triggerOrder.IsTriggeredBy(nav) {
+				continue
+			}
+			// Scale the nav price to the order's assets so that it's comparable to the trigger price.
+			assets := triggerOrder.Order.GetAssets()
+			price := sdk.NewCoin(nav.Price.Denom, nav.Price.Amount.Mul(assets.Amount).Quo(nav.Assets.Amount))
+			if err = k.activateTriggerOrder(ctx, triggerOrder, price); err != nil {
+				k.logErrorf(ctx, "could not activate trigger order %d: %v", triggerOrder.GetOrderID(), err)
+			}
+			break
+		}
+	}
+}
+
+// activateTriggerOrder moves a trigger order into the order book.
+// If the market has continuous matching enabled, the order is then matched against the order book.
+// Nothing is changed if there's an error.
+func (k Keeper) activateTriggerOrder(ctx sdk.Context, triggerOrder *exchange.TriggerOrder, price sdk.Coin) error {
+	cacheCtx, writeCache := ctx.CacheContext()
+	store := k.getStore(cacheCtx)
+
+	order := &triggerOrder.Order
+	deleteAndDeIndexTriggerOrder(store, *triggerOrder)
+	if err := k.setOrderInStore(store, *order); err != nil {
+		return err
+	}
+
+	k.emitEvents(cacheCtx, []proto.Message{
+		exchange.NewEventTriggerOrderActivated(order, price),
+		exchange.NewEventOrderCreated(order),
+	})
+	if err := k.Hooks().OnOrderCreated(cacheCtx, order); err != nil {
+		return err
+	}
+	writeCache()
+
+	incOrderActionCounter(order, exchange.TelemetryActionCreated)
+
+	k.matchOrder(ctx, order)
+	return nil
+}
@@ -0,0 +1,542 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	provtestutil "github.com/provenance-io/provenance/testutil"
+	"github.com/provenance-io/provenance/testutil/assertions"
+	"github.com/provenance-io/provenance/x/exchange"
+	"github.com/provenance-io/provenance/x/exchange/keeper"
+)
+
+// requireSetTriggerOrderInStore calls SetTriggerOrderInStore making sure it doesn't panic or return an error.
+func (s *TestSuite) requireSetTriggerOrderInStore(triggerOrder *exchange.TriggerOrder) {
+	assertions.RequireNotPanicsNoErrorf(s.T(), func() error {
+		return s.k.SetTriggerOrderInStore(s.getStore(), *triggerOrder)
+	}, "SetTriggerOrderInStore(%d)", triggerOrder.GetOrderID())
+}
+
+func (s *TestSuite) TestKeeper_CreateTriggerAskOrder() {
+	reason := func(orderID uint64) string {
+		return fmt.Sprintf("x/exchange: order %d", orderID)
+	}
+
+	tests := []struct {
+		name         string
+		bankKeeper   *MockBankKeeper
+		holdKeeper   *MockHoldKeeper
+		setup        func()
+		askOrder     exchange.AskOrder
+		triggerPrice sdk.Coin
+		creationFee  *sdk.Coin
+		expOrderID   uint64
+		expErr       string
+		expBankCalls BankCalls
+		expHoldCalls HoldCalls
+	}{
+		{
+			name: "invalid order",
+			askOrder: exchange.AskOrder{
+				MarketId: 0,
+				Seller:   s.addr1.String(),
+				Assets:   s.coin("35apple"),
+				Price:    s.coin("10peach"),
+			},
+			triggerPrice: s.coin("8peach"),
+			expErr:       "invalid market id: cannot be zero",
+		},
+		{
+			name: "market not accepting orders",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{MarketId: 2, AcceptingOrders: false})
+			},
+			askOrder: exchange.AskOrder{
+				MarketId: 2,
+				Seller:   s.addr1.String(),
+				Assets:   s.coin("35apple"),
+				Price:    s.coin("10peach"),
+			},
+			triggerPrice: s.coin("8peach"),
+			expErr:       "market 2 is not accepting orders",
+		},
+		{
+			name: "trigger price has wrong denom",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{MarketId: 2, AcceptingOrders: true})
+			},
+			askOrder: exchange.AskOrder{
+				MarketId: 2,
+				Seller:   s.addr1.String(),
+				Assets:   s.coin("35apple"),
+				Price:    s.coin("10peach"),
+			},
+			triggerPrice: s.coin("8plum"),
+			expErr:       "invalid trigger price \"8plum\": denom must match order price denom \"peach\"",
+		},
+		{
+			name: "external id in use by an order",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{MarketId: 2, AcceptingOrders: true})
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(4).WithBid(&exchange.BidOrder{
+					MarketId:   2,
+					Buyer:      s.addr2.String(),
+					Assets:     s.coin("35apple"),
+					Price:      s.coin("10peach"),
+					ExternalId: "taken",
+				}))
+			},
+			askOrder: exchange.AskOrder{
+				MarketId:   2,
+				Seller:     s.addr1.String(),
+				Assets:     s.coin("35apple"),
+				Price:      s.coin("10peach"),
+				ExternalId: "taken",
+			},
+			triggerPrice: s.coin("8peach"),
+			expErr:       "external id \"taken\" is already in use in market 2",
+		},
+		{
+			name: "external id in use by a trigger order",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{MarketId: 2, AcceptingOrders: true})
+				s.requireSetTriggerOrderInStore(exchange.NewTriggerOrder(*exchange.NewOrder(4).WithBid(&exchange.BidOrder{
+					MarketId:   2,
+					Buyer:      s.addr2.String(),
+					Assets:     s.coin("35apple"),
+					Price:      s.coin("10peach"),
+					ExternalId: "taken",
+				}), s.coin("12peach")))
+			},
+			askOrder: exchange.AskOrder{
+				MarketId:   2,
+				Seller:     s.addr1.String(),
+				Assets:     s.coin("35apple"),
+				Price:      s.coin("10peach"),
+				ExternalId: "taken",
+			},
+			triggerPrice: s.coin("8peach"),
+			expErr:       "external id \"taken\" is already in use by trigger order 4",
+		},
+		{
+			name:       "error placing hold",
+			holdKeeper: NewMockHoldKeeper().WithAddHoldResults("not enough apples"),
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{MarketId: 2, AcceptingOrders: true})
+			},
+			askOrder: exchange.AskOrder{
+				MarketId: 2,
+				Seller:   s.addr1.String(),
+				Assets:   s.coin("35apple"),
+				Price:    s.coin("10peach"),
+			},
+			triggerPrice: s.coin("8peach"),
+			expErr:       "error placing hold for ask order 1: not enough apples",
+			expHoldCalls: HoldCalls{AddHold: []*AddHoldArgs{{addr: s.addr1, funds: s.coins("35apple"), reason: reason(1)}}},
+		},
+		{
+			name: "okay: with creation fee",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:         3,
+					AcceptingOrders:  true,
+					FeeCreateAskFlat: s.coins("10fig"),
+				})
+				keeper.SetLastOrderID(s.getStore(), 50_000)
+			},
+			askOrder: exchange.AskOrder{
+				MarketId:   3,
+				Seller:     s.addr3.String(),
+				Assets:     s.coin("100apple"),
+				Price:      s.coin("3pineapple"),
+				ExternalId: "stop-loss",
+			},
+			triggerPrice: s.coin("5pineapple"),
+			creationFee:  s.coinP("12fig"),
+			expOrderID:   50_001,
+			expBankCalls: BankCalls{
+				SendCoins: []*SendCoinsArgs{
+					{fromAddr: s.addr3, toAddr: s.marketAddr3, amt: s.coins("12fig")},
+				},
+				SendCoinsFromAccountToModule: []*SendCoinsFromAccountToModuleArgs{
+					{senderAddr: s.marketAddr3, recipientModule: s.feeCollector, amt: s.coins("1fig")},
+				},
+			},
+			expHoldCalls: HoldCalls{AddHold: []*AddHoldArgs{{addr: s.addr3, funds: s.coins("100apple"), reason: reason(50_001)}}},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var expTriggerOrder *exchange.TriggerOrder
+			var expEvents sdk.Events
+			if len(tc.expErr) == 0 {
+				expTriggerOrder = exchange.NewTriggerOrder(*exchange.NewOrder(tc.expOrderID).WithAsk(&tc.askOrder), tc.triggerPrice)
+				expEvents = append(expEvents, s.untypeEvent(exchange.NewEventTriggerOrderCreated(expTriggerOrder)))
+			}
+
+			if tc.bankKeeper == nil {
+				tc.bankKeeper = NewMockBankKeeper()
+			}
+			if tc.holdKeeper == nil {
+				tc.holdKeeper = NewMockHoldKeeper()
+			}
+			kpr := s.k.WithBankKeeper(tc.bankKeeper).WithHoldKeeper(tc.holdKeeper)
+
+			sink := provtestutil.EnableTelemetry(s.T())
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			var orderID uint64
+			var err error
+			testFunc := func() {
+				orderID, err = kpr.CreateTriggerAskOrder(ctx, tc.askOrder, tc.triggerPrice, tc.creationFee)
+			}
+			s.Require().NotPanics(testFunc, "CreateTriggerAskOrder")
+			s.assertErrorValue(err, tc.expErr, "CreateTriggerAskOrder error")
+			s.assertEqualOrderID(tc.expOrderID, orderID, "CreateTriggerAskOrder order id")
+			s.assertEqualEvents(expEvents, em.Events(), "CreateTriggerAskOrder events")
+			s.assertBankKeeperCalls(tc.bankKeeper, tc.expBankCalls, "CreateTriggerAskOrder")
+			s.assertHoldKeeperCalls(tc.holdKeeper, tc.expHoldCalls, "CreateTriggerAskOrder")
+
+			if len(tc.expErr) > 0 || err != nil {
+				return
+			}
+
+			// Trigger orders aren't counted as created until they're activated.
+			s.assertOrderActionCount(sink, expTriggerOrder.Order, exchange.TelemetryActionCreated, 0, "CreateTriggerAskOrder")
+
+			triggerOrder, err := s.k.GetTriggerOrder(s.ctx, orderID)
+			s.Require().NoError(err, "GetTriggerOrder(%d) error (the one just created)", orderID)
+			s.Assert().Equal(expTriggerOrder, triggerOrder, "GetTriggerOrder(%d) (the one just created)", orderID)
+			order, err := s.k.GetOrder(s.ctx, orderID)
+			s.Require().NoError(err, "GetOrder(%d) error (the trigger order just created)", orderID)
+			s.Assert().Nil(order, "GetOrder(%d) (the trigger order just created)", orderID)
+			lastOrderID := keeper.GetLastOrderID(s.getStore())
+			s.assertEqualOrderID(tc.expOrderID, lastOrderID, "last order id")
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_CreateTriggerBidOrder() {
+	reason := func(orderID uint64) string {
+		return fmt.Sprintf("x/exchange: order %d", orderID)
+	}
+
+	tests := []struct {
+		name         string
+		bankKeeper   *MockBankKeeper
+		setup        func()
+		bidOrder     exchange.BidOrder
+		triggerPrice sdk.Coin
+		creationFee  *sdk.Coin
+		expOrderID   uint64
+		expErr       string
+		expBankCalls BankCalls
+		expHoldCalls HoldCalls
+	}{
+		{
+			name: "invalid order",
+			bidOrder: exchange.BidOrder{
+				MarketId: 1,
+				Buyer:    "",
+				Assets:   s.coin("35apple"),
+				Price:    s.coin("10peach"),
+			},
+			triggerPrice: s.coin("12peach"),
+			expErr:       "invalid buyer: empty address string is not allowed",
+		},
+		{
+			name: "zero trigger price",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{MarketId: 2, AcceptingOrders: true})
+			},
+			bidOrder: exchange.BidOrder{
+				MarketId: 2,
+				Buyer:    s.addr1.String(),
+				Assets:   s.coin("35apple"),
+				Price:    s.coin("10peach"),
+			},
+			triggerPrice: s.coin("0peach"),
+			expErr:       "invalid trigger price: cannot be zero",
+		},
+		{
+			name: "okay: with creation fee",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:         3,
+					AcceptingOrders:  true,
+					FeeCreateBidFlat: s.coins("10fig"),
+				})
+				keeper.SetLastOrderID(s.getStore(), 7)
+			},
+			bidOrder: exchange.BidOrder{
+				MarketId: 3,
+				Buyer:    s.addr2.String(),
+				Assets:   s.coin("100apple"),
+				Price:    s.coin("300pineapple"),
+			},
+			triggerPrice: s.coin("250pineapple"),
+			creationFee:  s.coinP("12fig"),
+			expOrderID:   8,
+			expBankCalls: BankCalls{
+				SendCoins: []*SendCoinsArgs{
+					{fromAddr: s.addr2, toAddr: s.marketAddr3, amt: s.coins("12fig")},
+				},
+				SendCoinsFromAccountToModule: []*SendCoinsFromAccountToModuleArgs{
+					{senderAddr: s.marketAddr3, recipientModule: s.feeCollector, amt: s.coins("1fig")},
+				},
+			},
+			expHoldCalls: HoldCalls{AddHold: []*AddHoldArgs{{addr: s.addr2, funds: s.coins("300pineapple"), reason: reason(8)}}},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var expTriggerOrder *exchange.TriggerOrder
+			var expEvents sdk.Events
+			if len(tc.expErr) == 0 {
+				expTriggerOrder = exchange.NewTriggerOrder(*exchange.NewOrder(tc.expOrderID).WithBid(&tc.bidOrder), tc.triggerPrice)
+				expEvents = append(expEvents, s.untypeEvent(exchange.NewEventTriggerOrderCreated(expTriggerOrder)))
+			}
+
+			if tc.bankKeeper == nil {
+				tc.bankKeeper = NewMockBankKeeper()
+			}
+			holdKeeper := NewMockHoldKeeper()
+			kpr := s.k.WithBankKeeper(tc.bankKeeper).WithHoldKeeper(holdKeeper)
+
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			var orderID uint64
+			var err error
+			testFunc := func() {
+				orderID, err = kpr.CreateTriggerBidOrder(ctx, tc.bidOrder, tc.triggerPrice, tc.creationFee)
+			}
+			s.Require().NotPanics(testFunc, "CreateTriggerBidOrder")
+			s.assertErrorValue(err, tc.expErr, "CreateTriggerBidOrder error")
+			s.assertEqualOrderID(tc.expOrderID, orderID, "CreateTriggerBidOrder order id")
+			s.assertEqualEvents(expEvents, em.Events(), "CreateTriggerBidOrder events")
+			s.assertBankKeeperCalls(tc.bankKeeper, tc.expBankCalls, "CreateTriggerBidOrder")
+			s.assertHoldKeeperCalls(holdKeeper, tc.expHoldCalls, "CreateTriggerBidOrder")
+
+			if len(tc.expErr) > 0 || err != nil {
+				return
+			}
+
+			triggerOrder, err := s.k.GetTriggerOrder(s.ctx, orderID)
+			s.Require().NoError(err, "GetTriggerOrder(%d) error (the one just created)", orderID)
+			s.Assert().Equal(expTriggerOrder, triggerOrder, "GetTriggerOrder(%d) (the one just created)", orderID)
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_CancelOrder_TriggerOrder() {
+	triggerOrder := exchange.NewTriggerOrder(*exchange.NewOrder(5).WithAsk(&exchange.AskOrder{
+		MarketId: 1,
+		Seller:   s.addr1.String(),
+		Assets:   s.coin("20apple"),
+		Price:    s.coin("100peach"),
+	}), s.coin("90peach"))
+
+	tests := []struct {
+		name         string
+		holdKeeper   *MockHoldKeeper
+		signer       string
+		expErr       string
+		expHoldCalls HoldCalls
+	}{
+		{
+			name:   "signer not allowed",
+			signer: s.addr2.String(),
+			expErr: "account " + s.addr2.String() + " does not have permission to cancel order 5",
+		},
+		{
+			name:         "error releasing hold",
+			holdKeeper:   NewMockHoldKeeper().WithReleaseHoldResults("not enough held"),
+			signer:       s.addr1.String(),
+			expErr:       "unable to release hold on order 5 funds: not enough held",
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr1, funds: s.coins("20apple")}}},
+		},
+		{
+			name:         "cancelled by owner",
+			signer:       s.addr1.String(),
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr1, funds: s.coins("20apple")}}},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			s.requireSetTriggerOrderInStore(triggerOrder)
+
+			var expEvents sdk.Events
+			if len(tc.expErr) == 0 {
+				expEvents = append(expEvents, s.untypeEvent(exchange.NewEventOrderCancelled(&triggerOrder.Order, tc.signer)))
+			}
+
+			if tc.holdKeeper == nil {
+				tc.holdKeeper = NewMockHoldKeeper()
+			}
+			kpr := s.k.WithHoldKeeper(tc.holdKeeper)
+
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			var err error
+			testFunc := func() {
+				err = kpr.CancelOrder(ctx, 5, tc.signer)
+			}
+			s.Require().NotPanics(testFunc, "CancelOrder(5, %q)", tc.signer)
+			s.assertErrorValue(err, tc.expErr, "CancelOrder(5, %q) error", tc.signer)
+			s.assertEqualEvents(expEvents, em.Events(), "CancelOrder(5, %q) events", tc.signer)
+			s.assertHoldKeeperCalls(tc.holdKeeper, tc.expHoldCalls, "CancelOrder(5, %q)", tc.signer)
+
+			actTriggerOrder, err := s.k.GetTriggerOrder(s.ctx, 5)
+			s.Require().NoError(err, "GetTriggerOrder(5) after CancelOrder")
+			if len(tc.expErr) > 0 {
+				s.Assert().Equal(triggerOrder, actTriggerOrder, "GetTriggerOrder(5) after failed CancelOrder")
+				has := s.getStore().Has(keeper.MakeIndexKeyAddressToTriggerOrder(s.addr1, 5))
+				s.Assert().True(has, "address to trigger order index entry exists after failed CancelOrder")
+				return
+			}
+			s.Assert().Nil(actTriggerOrder, "GetTriggerOrder(5) after CancelOrder")
+			has := s.getStore().Has(keeper.MakeIndexKeyMarketToTriggerOrder(1, 5))
+			s.Assert().False(has, "market to trigger order index entry exists after CancelOrder")
+			has = s.getStore().Has(keeper.MakeIndexKeyAddressToTriggerOrder(s.addr1, 5))
+			s.Assert().False(has, "address to trigger order index entry exists after CancelOrder")
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_ActivateTriggerOrders() {
+	stopLoss := exchange.NewTriggerOrder(*exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+		MarketId: 1,
+		Seller:   s.addr1.String(),
+		Assets:   s.coin("10apple"),
+		Price:    s.coin("40peach"),
+	}), s.coin("50peach"))
+	stopBuy := exchange.NewTriggerOrder(*exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+		MarketId:   1,
+		Buyer:      s.addr2.String(),
+		Assets:     s.coin("10apple"),
+		Price:      s.coin("70peach"),
+		ExternalId: "buy-the-breakout",
+	}), s.coin("60peach"))
+	otherMarket := exchange.NewTriggerOrder(*exchange.NewOrder(3).WithAsk(&exchange.AskOrder{
+		MarketId: 2,
+		Seller:   s.addr3.String(),
+		Assets:   s.coin("10apple"),
+		Price:    s.coin("40peach"),
+	}), s.coin("50peach"))
+	nav := func(assets, price string) exchange.NetAssetPrice {
+		return exchange.NetAssetPrice{Assets: s.coin(assets), Price: s.coin(price)}
+	}
+
+	tests := []struct {
+		name         string
+		setup        func()
+		navs         []exchange.NetAssetPrice
+		expActivated []*exchange.TriggerOrder
+		expPrices    []sdk.Coin
+		expLog       string
+	}{
+		{
+			name: "no navs",
+		},
+		{
+			name: "price between triggers",
+			navs: []exchange.NetAssetPrice{nav("2apple", "11peach")},
+		},
+		{
+			name:         "price at or below ask trigger",
+			navs:         []exchange.NetAssetPrice{nav("2apple", "10peach")},
+			expActivated: []*exchange.TriggerOrder{stopLoss},
+			expPrices:    []sdk.Coin{s.coin("50peach")},
+		},
+		{
+			name:         "price above bid trigger",
+			navs:         []exchange.NetAssetPrice{nav("2apple", "13peach"), nav("5banana", "1peach")},
+			expActivated: []*exchange.TriggerOrder{stopBuy},
+			expPrices:    []sdk.Coin{s.coin("65peach")},
+		},
+		{
+			name: "external id now in use",
+			setup: func() {
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(4).WithAsk(&exchange.AskOrder{
+					MarketId:   1,
+					Seller:     s.addr4.String(),
+					Assets:     s.coin("1apple"),
+					Price:      s.coin("1peach"),
+					ExternalId: "buy-the-breakout",
+				}))
+			},
+			navs: []exchange.NetAssetPrice{nav("2apple", "13peach")},
+			expLog: "ERR could not activate trigger order 2: external id \"buy-the-breakout\" is already in use by order 4: " +
+				"cannot be used for order 2 module=x/exchange\n",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			for _, triggerOrder := range []*exchange.TriggerOrder{stopLoss, stopBuy, otherMarket} {
+				s.requireSetTriggerOrderInStore(triggerOrder)
+			}
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var expEvents sdk.Events
+			for i, triggerOrder := range tc.expActivated {
+				expEvents = append(expEvents,
+					s.untypeEvent(exchange.NewEventTriggerOrderActivated(&triggerOrder.Order, tc.expPrices[i])),
+					s.untypeEvent(exchange.NewEventOrderCreated(&triggerOrder.Order)),
+				)
+			}
+
+			s.logBuffer.Reset()
+			sink := provtestutil.EnableTelemetry(s.T())
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			testFunc := func() {
+				s.k.ActivateTriggerOrders(ctx, 1, tc.navs)
+			}
+			s.Require().NotPanics(testFunc, "ActivateTriggerOrders")
+			s.assertEqualEvents(expEvents, em.Events(), "ActivateTriggerOrders events")
+			actLog := s.getLogOutput("ActivateTriggerOrders")
+			s.Assert().Equal(tc.expLog, actLog, "ActivateTriggerOrders log output")
+
+			activated := make(map[uint64]bool)
+			for _, triggerOrder := range tc.expActivated {
+				orderID := triggerOrder.GetOrderID()
+				activated[orderID] = true
+				s.assertOrderActionCount(sink, &triggerOrder.Order, exchange.TelemetryActionCreated, 1, "order %d", orderID)
+				order, err := s.k.GetOrder(s.ctx, orderID)
+				if s.Assert().NoError(err, "GetOrder(%d) error", orderID) {
+					s.Assert().Equal(&triggerOrder.Order, order, "GetOrder(%d)", orderID)
+				}
+			}
+
+			for _, triggerOrder := range []*exchange.TriggerOrder{stopLoss, stopBuy, otherMarket} {
+				orderID := triggerOrder.GetOrderID()
+				actTriggerOrder, err := s.k.GetTriggerOrder(s.ctx, orderID)
+				s.Require().NoError(err, "GetTriggerOrder(%d)", orderID)
+				if activated[orderID] {
+					s.Assert().Nil(actTriggerOrder, "GetTriggerOrder(%d) for activated trigger order", orderID)
+				} else {
+					s.Assert().Equal(triggerOrder, actTriggerOrder, "GetTriggerOrder(%d) for waiting trigger order", orderID)
+				}
+			}
+		})
+	}
+}
@@ -20,3 +20,14 @@ const (
 func GetMarketAddress(marketID uint32) sdk.AccAddress {
 	return sdk.AccAddress(crypto.AddressHash([]byte(fmt.Sprintf("%s/%d", ModuleName, marketID))))
 }
+
+// GetCommitmentRewardsAddress returns the address that holds the funds of all the markets' commitment reward pools.
+// How much of it belongs to each market is tracked in state.
+func GetCommitmentRewardsAddress() sdk.AccAddress {
+	return sdk.AccAddress(crypto.AddressHash([]byte(fmt.Sprintf("%s/commitment-rewards", ModuleName))))
+}
+
+// GetPaymentEscrowAddress returns the address that holds the funds of accepted payments during their dispute windows.
+func GetPaymentEscrowAddress() sdk.AccAddress {
+	return sdk.AccAddress(crypto.AddressHash([]byte(fmt.Sprintf("%s/payment-escrow", ModuleName))))
+}
@@ -26,6 +26,13 @@ const (
 
 	// MaxBips is the maximum bips value. 10,000 basis points = 100%.
 	MaxBips = uint32(10_000)
+
+	// MaxOrderRateLimitWindow is the largest number of blocks that an OrderRateLimit can count orders over.
+	MaxOrderRateLimitWindow = uint32(100_000)
+
+	// MaxExpiredAccessGrantsPerBlock is the maximum number of expired access grants that are revoked at the end of a block.
+	// Any others are revoked at the end of a later block.
+	MaxExpiredAccessGrantsPerBlock = 1_000
 )
 
 var (
@@ -45,6 +52,9 @@ func (m Market) Validate() error {
 		ValidateFeeOptions("seller settlement flat fee", m.FeeSellerSettlementFlat),
 		ValidateFeeOptions("buyer settlement flat fee", m.FeeBuyerSettlementFlat),
 		ValidateFeeRatios(m.FeeSellerSettlementRatios, m.FeeBuyerSettlementRatios),
+		ValidateTakerFeeRatios(m.FeeSellerSettlementRatios, m.FeeSellerSettlementTakerRatios),
+		ValidateRebateRatios(m.FeeSellerSettlementRebateRatios),
+		ValidateRebateAddresses(m.RebateAddresses),
 		ValidateAccessGrantsField("", m.AccessGrants),
 		// Nothing to check for with the AcceptingOrders and AllowUserSettlement booleans.
 		ValidateReqAttrs("create-ask", m.ReqAttrCreateAsk),
@@ -54,6 +64,17 @@ func (m Market) Validate() error {
 		ValidateBips("commitment settlement", m.CommitmentSettlementBips),
 		ValidateIntermediaryDenom(m.IntermediaryDenom),
 		ValidateReqAttrs("create-commitment", m.ReqAttrCreateCommitment),
+		ValidateMatchingModes(m.ContinuousMatching, m.BatchAuctionInterval),
+		m.SelfTradePrevention.Validate(),
+		ValidateAcceptedDenoms("asset", m.AcceptedAssetDenoms),
+		ValidateAcceptedDenoms("price", m.AcceptedPriceDenoms),
+		ValidateBips("referral", m.ReferralBips),
+		ValidateFeeOptions("referral cap", m.ReferralCap),
+		m.ExternalIdScope.Validate(),
+		m.OrderRateLimit.Validate(),
+		ValidateMaxExposure(m.MaxExposure),
+		m.FillAlgorithm.Validate(),
+		ValidateExchangeSplits("", m.ExchangeSplits),
 	)
 }
 
@@ -147,6 +168,75 @@ func ValidateFeeRatios(sellerRatios, buyerRatios []FeeRatio) error {
 	return errors.Join(errs...)
 }
 
+// ValidateTakerFeeRatios makes sure that the provided seller settlement taker fee ratios are valid
+// and that each has a price denom that is also in the seller settlement fee ratios (if there are any).
+func ValidateTakerFeeRatios(sellerRatios, takerRatios []FeeRatio) error {
+	if len(takerRatios) == 0 {
+		return nil
+	}
+	if err := ValidateSellerFeeRatios(takerRatios); err != nil {
+		return fmt.Errorf("invalid taker ratios: %w", err)
+	}
+	return errors.Join(ValidateTakerRatioDenoms(sellerRatios, takerRatios)...)
+}
+
+// ValidateRebateRatios returns an error if the provided seller settlement rebate ratios contains an invalid entry.
+func ValidateRebateRatios(rebateRatios []FeeRatio) error {
+	if err := ValidateSellerFeeRatios(rebateRatios); err != nil {
+		return fmt.Errorf("invalid rebate ratios: %w", err)
+	}
+	return nil
+}
+
+// ValidateRebateAddresses returns an error if any of the provided rebate addresses are invalid or duplicated.
+func ValidateRebateAddresses(addrs []string) error {
+	var errs []error
+	seen := make(map[string]bool, len(addrs))
+	for _, addr := range addrs {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			errs = append(errs, fmt.Errorf("invalid rebate address %q: %w", addr, err))
+			continue
+		}
+		if seen[addr] {
+			errs = append(errs, fmt.Errorf("duplicate rebate address %q", addr))
+		}
+		seen[addr] = true
+	}
+	return errors.Join(errs...)
+}
+
+// ValidateAddRemoveRebateAddresses returns an error if the rebate addresses to add are invalid,
+// or there's an address in both lists.
+func ValidateAddRemoveRebateAddresses(toAdd, toRemove []string) error {
+	var errs []error
+	if err := ValidateRebateAddresses(toAdd); err != nil {
+		errs = append(errs, err)
+	}
+	for _, addr := range toRemove {
+		if ContainsString(toAdd, addr) {
+			errs = append(errs, fmt.Errorf("cannot add and remove the same rebate address %q", addr))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ValidateAddRemoveRebateAddressesWithExisting returns errors for entries in toAdd that are
+// already in existing, and entries in toRemove that are not in existing.
+func ValidateAddRemoveRebateAddressesWithExisting(existing, toAdd, toRemove []string) []error {
+	var errs []error
+	for _, addr := range toRemove {
+		if !ContainsString(existing, addr) {
+			errs = append(errs, fmt.Errorf("cannot remove rebate address %q: address not currently present", addr))
+		}
+	}
+	for _, addr := range toAdd {
+		if ContainsString(existing, addr) && !ContainsString(toRemove, addr) {
+			errs = append(errs, fmt.Errorf("cannot add rebate address %q: address already present", addr))
+		}
+	}
+	return errs
+}
+
 // ValidateSellerFeeRatios returns an error if the provided seller fee ratios contains an invalid entry.
 func ValidateSellerFeeRatios(ratios []FeeRatio) error {
 	if len(ratios) == 0 {
@@ -327,6 +417,22 @@ func (r FeeRatio) ApplyToLoosely(price sdk.Coin) (sdk.Coin, error) {
 	return rv, nil
 }
 
+// ApplyToRoundingDown calculates the amount that results from applying this ratio to the provided price,
+// rounding down if the ratio does not evenly apply to the price.
+func (r FeeRatio) ApplyToRoundingDown(price sdk.Coin) (sdk.Coin, error) {
+	rv := sdk.Coin{Denom: "", Amount: sdkmath.ZeroInt()}
+	amt, wasRounded, err := r.applyLooselyTo(price)
+	if err != nil {
+		return rv, err
+	}
+	if wasRounded {
+		amt = amt.Sub(sdkmath.OneInt())
+	}
+	rv.Denom = r.Fee.Denom
+	rv.Amount = amt
+	return rv, nil
+}
+
 // IntersectionOfFeeRatios returns each FeeRatio entry that is in both lists.
 func IntersectionOfFeeRatios(list1, list2 []FeeRatio) []FeeRatio {
 	return intersection(list1, list2, FeeRatio.Equals)
@@ -416,6 +522,28 @@ func ValidateRatioDenoms(sellerRatios, buyerRatios []FeeRatio) []error {
 	return errs
 }
 
+// ValidateTakerRatioDenoms checks that each of the seller settlement taker ratios has a
+// price denom that is also in the seller settlement ratios (if there are any).
+func ValidateTakerRatioDenoms(sellerRatios, takerRatios []FeeRatio) []error {
+	if len(sellerRatios) == 0 {
+		return nil
+	}
+
+	sellerPriceDenomsKnown := make(map[string]bool, len(sellerRatios))
+	for _, ratio := range sellerRatios {
+		sellerPriceDenomsKnown[ratio.Price.Denom] = true
+	}
+
+	var errs []error
+	for _, ratio := range takerRatios {
+		if !sellerPriceDenomsKnown[ratio.Price.Denom] {
+			errs = append(errs, fmt.Errorf("seller settlement taker fee ratios have price denom %q "+
+				"but there is not a seller settlement fee ratio with that price denom", ratio.Price.Denom))
+		}
+	}
+	return errs
+}
+
 // ValidateAddRemoveFeeOptions returns an error if the toAdd list has an invalid
 // entry or if the two lists have one or more common entries.
 func ValidateAddRemoveFeeOptions(field string, toAdd, toRemove []sdk.Coin) error {
@@ -502,6 +630,9 @@ func (a AccessGrant) ValidateInField(field string) error {
 			return fmt.Errorf("invalid %saccess grant: %w for %s", field, err, a.Address)
 		}
 	}
+	if err = validateExpiration(a.Expiration); err != nil {
+		return fmt.Errorf("invalid %saccess grant: %w for %s", field, err, a.Address)
+	}
 	return nil
 }
 
@@ -515,6 +646,34 @@ func (a AccessGrant) Contains(perm Permission) bool {
 	return false
 }
 
+// Validate returns an error if there is anything wrong with this MarketAuthorityTransfer.
+func (t MarketAuthorityTransfer) Validate() error {
+	var errs []error
+	if t.MarketId == 0 {
+		errs = append(errs, errors.New("invalid market id: cannot be zero"))
+	}
+	if _, err := sdk.AccAddressFromBech32(t.ProposedBy); err != nil {
+		errs = append(errs, fmt.Errorf("invalid proposed by %q: %w", t.ProposedBy, err))
+	}
+	if _, err := sdk.AccAddressFromBech32(t.NewAuthority); err != nil {
+		errs = append(errs, fmt.Errorf("invalid new authority %q: %w", t.NewAuthority, err))
+	}
+	if err := ValidateAccessGrantsField("", t.AccessGrants); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// GrantsPermissionsTo returns true if this transfer's access grants give the provided address "permissions" permission.
+func (t MarketAuthorityTransfer) GrantsPermissionsTo(addr string) bool {
+	for _, ag := range t.AccessGrants {
+		if ag.Address == addr && ag.Contains(Permission_permissions) {
+			return true
+		}
+	}
+	return false
+}
+
 // SimpleString returns a lower-cased version of the permission.String() without the leading "permission_"
 // E.g. "settle", or "update".
 func (p Permission) SimpleString() string {
@@ -584,13 +743,132 @@ func ParsePermissions(permissions ...string) ([]Permission, error) {
 	return rv, errors.Join(errs...)
 }
 
+// SimpleString returns a lower-cased version of the SelfTradePrevention.String() without the leading
+// "self_trade_prevention_". E.g. "cancel_newest", or "decrement_both".
+func (s SelfTradePrevention) SimpleString() string {
+	return strings.ToLower(strings.TrimPrefix(s.String(), "SELF_TRADE_PREVENTION_"))
+}
+
+// Validate returns an error if this SelfTradePrevention is an unknown value.
+func (s SelfTradePrevention) Validate() error {
+	_, exists := SelfTradePrevention_name[int32(s)]
+	if !exists {
+		return fmt.Errorf("self-trade prevention %d does not exist", s)
+	}
+	return nil
+}
+
+// ParseSelfTradePrevention converts the provided string into a SelfTradePrevention value.
+// Dashes can be used in place of underscores, and "none" is the same as "unspecified".
+// Example inputs: "cancel_newest", "Cancel-Oldest", "SELF_TRADE_PREVENTION_DECREMENT_BOTH", "none"
+func ParseSelfTradePrevention(selfTradePrevention string) (SelfTradePrevention, error) {
+	valUC := strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(selfTradePrevention), "-", "_"))
+	if valUC == "NONE" {
+		return SelfTradePrevention_unspecified, nil
+	}
+	if !strings.HasPrefix(valUC, "SELF_TRADE_PREVENTION_") {
+		valUC = "SELF_TRADE_PREVENTION_" + valUC
+	}
+	if val, found := SelfTradePrevention_value[valUC]; found {
+		return SelfTradePrevention(val), nil
+	}
+	return SelfTradePrevention_unspecified, fmt.Errorf("invalid self-trade prevention: %q", selfTradePrevention)
+}
+
+// SimpleString returns a lower-cased version of the ExternalIDScope.String() without the leading
+// "external_id_scope_". E.g. "unspecified", or "account".
+func (s ExternalIDScope) SimpleString() string {
+	return strings.ToLower(strings.TrimPrefix(s.String(), "EXTERNAL_ID_SCOPE_"))
+}
+
+// Validate returns an error if this ExternalIDScope is an unknown value.
+func (s ExternalIDScope) Validate() error {
+	_, exists := ExternalIDScope_name[int32(s)]
+	if !exists {
+		return fmt.Errorf("external id scope %d does not exist", s)
+	}
+	return nil
+}
+
+// ParseExternalIDScope converts the provided string into an ExternalIDScope value.
+// Case is ignored, and "market" is the same as "unspecified".
+// Example inputs: "account", "Market", "EXTERNAL_ID_SCOPE_ACCOUNT", "unspecified"
+func ParseExternalIDScope(externalIDScope string) (ExternalIDScope, error) {
+	valUC := strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(externalIDScope), "-", "_"))
+	if valUC == "MARKET" {
+		return ExternalIDScope_unspecified, nil
+	}
+	if !strings.HasPrefix(valUC, "EXTERNAL_ID_SCOPE_") {
+		valUC = "EXTERNAL_ID_SCOPE_" + valUC
+	}
+	if val, found := ExternalIDScope_value[valUC]; found {
+		return ExternalIDScope(val), nil
+	}
+	return ExternalIDScope_unspecified, fmt.Errorf("invalid external id scope: %q", externalIDScope)
+}
+
+// SimpleString returns a lower-cased version of the FillAlgorithm.String() without the leading
+// "fill_algorithm_". E.g. "unspecified", or "pro_rata".
+func (a FillAlgorithm) SimpleString() string {
+	return strings.ToLower(strings.TrimPrefix(a.String(), "FILL_ALGORITHM_"))
+}
+
+// Validate returns an error if this FillAlgorithm is an unknown value.
+func (a FillAlgorithm) Validate() error {
+	_, exists := FillAlgorithm_name[int32(a)]
+	if !exists {
+		return fmt.Errorf("fill algorithm %d does not exist", a)
+	}
+	return nil
+}
+
+// ParseFillAlgorithm converts the provided string into a FillAlgorithm value.
+// Case is ignored, and dashes are treated as underscores.
+// Example inputs: "pro-rata", "Price_Time", "FILL_ALGORITHM_PRO_RATA", "unspecified"
+func ParseFillAlgorithm(fillAlgorithm string) (FillAlgorithm, error) {
+	valUC := strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(fillAlgorithm), "-", "_"))
+	if !strings.HasPrefix(valUC, "FILL_ALGORITHM_") {
+		valUC = "FILL_ALGORITHM_" + valUC
+	}
+	if val, found := FillAlgorithm_value[valUC]; found {
+		return FillAlgorithm(val), nil
+	}
+	return FillAlgorithm_unspecified, fmt.Errorf("invalid fill algorithm: %q", fillAlgorithm)
+}
+
+// ReqAttrOrSeparator is the operator used in a required attribute to indicate that any one of several attributes
+// will satisfy it, e.g. "kyc.a.com || kyc.b.com".
+const ReqAttrOrSeparator = "||"
+
+// reqAttrOrJoiner is what's between the options of a normalized required attribute that has more than one option.
+const reqAttrOrJoiner = " " + ReqAttrOrSeparator + " "
+
+// NormalizeReqAttr normalizes the provided required attribute.
+// If it has more than one option (i.e. it has the ReqAttrOrSeparator), each is normalized.
+func NormalizeReqAttr(reqAttr string) string {
+	if !strings.Contains(reqAttr, ReqAttrOrSeparator) {
+		return nametypes.NormalizeName(reqAttr)
+	}
+	options := strings.Split(reqAttr, ReqAttrOrSeparator)
+	for i, option := range options {
+		options[i] = nametypes.NormalizeName(option)
+	}
+	return strings.Join(options, reqAttrOrJoiner)
+}
+
+// GetReqAttrOptions returns the attributes that can satisfy the provided required attribute.
+// This assumes that the reqAttr has already been normalized.
+func GetReqAttrOptions(reqAttr string) []string {
+	return strings.Split(reqAttr, reqAttrOrJoiner)
+}
+
 // NormalizeReqAttrs normalizes/validates each of the provided require attributes.
 // The normalized versions of the attributes are returned regardless of whether an error is also returned.
 func NormalizeReqAttrs(reqAttrs []string) ([]string, error) {
 	rv := make([]string, len(reqAttrs))
 	var errs []error
 	for i, attr := range reqAttrs {
-		rv[i] = nametypes.NormalizeName(attr)
+		rv[i] = NormalizeReqAttr(attr)
 		if !IsValidReqAttr(rv[i]) {
 			errs = append(errs, fmt.Errorf("invalid attribute %q", attr))
 		}
@@ -602,7 +880,7 @@ func NormalizeReqAttrs(reqAttrs []string) ([]string, error) {
 func ValidateReqAttrsAreNormalized(field string, attrs []string) error {
 	var errs []error
 	for _, attr := range attrs {
-		norm := nametypes.NormalizeName(attr)
+		norm := NormalizeReqAttr(attr)
 		if attr != norm {
 			errs = append(errs, fmt.Errorf("%s required attribute %q is not normalized, expected %q", field, attr, norm))
 		}
@@ -616,7 +894,7 @@ func ValidateReqAttrs(field string, attrs []string) error {
 	seen := make(map[string]bool, len(attrs))
 	bad := make(map[string]bool)
 	for _, attr := range attrs {
-		normalized := nametypes.NormalizeName(attr)
+		normalized := NormalizeReqAttr(attr)
 		if seen[normalized] {
 			if !bad[normalized] {
 				errs = append(errs, fmt.Errorf("duplicate %s required attribute %q",
@@ -656,8 +934,20 @@ func ValidateAddRemoveReqAttrs(field string, toAdd, toRemove []string) error {
 }
 
 // IsValidReqAttr returns true if the provided string is a valid required attribute entry.
+// If it has more than one option, each must be valid.
 // Assumes that the provided reqAttr has already been normalized.
 func IsValidReqAttr(reqAttr string) bool {
+	for _, option := range GetReqAttrOptions(reqAttr) {
+		if !isValidReqAttrOption(option) {
+			return false
+		}
+	}
+	return true
+}
+
+// isValidReqAttrOption returns true if the provided string is a valid single option of a required attribute entry.
+// Assumes that the provided reqAttr has already been normalized.
+func isValidReqAttrOption(reqAttr string) bool {
 	// A leading wildcard segment is valid for us, but not the name module. So, remove it if it's there.
 	reqAttr = strings.TrimPrefix(reqAttr, "*.")
 
@@ -693,8 +983,20 @@ func HasReqAttrMatch(reqAttr string, accAttrs []string) bool {
 }
 
 // IsReqAttrMatch returns true if the provide account attribute is a match for the given required attribute.
+// If the required attribute has more than one option, the account attribute only needs to match one of them.
 // This assumes that reqAttr and accAttr have both been normalized.
 func IsReqAttrMatch(reqAttr, accAttr string) bool {
+	for _, option := range GetReqAttrOptions(reqAttr) {
+		if isReqAttrOptionMatch(option, accAttr) {
+			return true
+		}
+	}
+	return false
+}
+
+// isReqAttrOptionMatch returns true if the provide account attribute is a match for the given required attribute option.
+// This assumes that reqAttr and accAttr have both been normalized.
+func isReqAttrOptionMatch(reqAttr, accAttr string) bool {
 	if len(reqAttr) == 0 || len(accAttr) == 0 {
 		return false
 	}
@@ -724,3 +1026,132 @@ func ValidateIntermediaryDenom(denom string) error {
 	}
 	return nil
 }
+
+// ValidateAcceptedDenoms returns an error if any of the provided denoms are invalid or duplicated.
+func ValidateAcceptedDenoms(field string, denoms []string) error {
+	var errs []error
+	seen := make(map[string]bool, len(denoms))
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			errs = append(errs, fmt.Errorf("invalid accepted %s denom: %w", field, err))
+			continue
+		}
+		if seen[denom] {
+			errs = append(errs, fmt.Errorf("duplicate accepted %s denom %q", field, denom))
+		}
+		seen[denom] = true
+	}
+	return errors.Join(errs...)
+}
+
+// ValidateAddRemoveAcceptedDenoms returns an error if the denoms to add are invalid,
+// or there's a denom in both lists.
+func ValidateAddRemoveAcceptedDenoms(field string, toAdd, toRemove []string) error {
+	var errs []error
+	if err := ValidateAcceptedDenoms(field+" to add", toAdd); err != nil {
+		errs = append(errs, err)
+	}
+	for _, denom := range toRemove {
+		if ContainsString(toAdd, denom) {
+			errs = append(errs, fmt.Errorf("cannot add and remove the same accepted %s denom %q", field, denom))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ValidateMatchingModes returns an error if both continuous matching and batch auctions are enabled.
+func ValidateMatchingModes(continuousMatching bool, batchAuctionInterval uint32) error {
+	if continuousMatching && batchAuctionInterval != 0 {
+		return fmt.Errorf("cannot have continuous matching with batch auction interval %d", batchAuctionInterval)
+	}
+	return nil
+}
+
+// Validate returns an error if this OrderRateLimit is not valid. A nil OrderRateLimit is valid (it means no limit).
+func (r *OrderRateLimit) Validate() error {
+	if r == nil {
+		return nil
+	}
+	var errs []error
+	if r.MaxOrders == 0 {
+		errs = append(errs, errors.New("invalid order rate limit max orders: cannot be zero"))
+	}
+	if r.WindowBlocks == 0 {
+		errs = append(errs, errors.New("invalid order rate limit window blocks: cannot be zero"))
+	}
+	if r.WindowBlocks > MaxOrderRateLimitWindow {
+		errs = append(errs, fmt.Errorf("invalid order rate limit window blocks %d: exceeds max of %d",
+			r.WindowBlocks, MaxOrderRateLimitWindow))
+	}
+	return errors.Join(errs...)
+}
+
+// Equals returns true if this OrderRateLimit has the same values as the other one. Two nils are equal.
+func (r *OrderRateLimit) Equals(other *OrderRateLimit) bool {
+	if r == nil || other == nil {
+		return r == nil && other == nil
+	}
+	return r.MaxOrders == other.MaxOrders && r.WindowBlocks == other.WindowBlocks
+}
+
+// ValidateMaxExposure returns an error if the provided max exposure is not valid. A nil max exposure is valid (it means no limit).
+func ValidateMaxExposure(maxExposure *sdk.Coin) error {
+	if maxExposure == nil {
+		return nil
+	}
+	if err := maxExposure.Validate(); err != nil {
+		return fmt.Errorf("invalid max exposure %q: %w", maxExposure, err)
+	}
+	if !maxExposure.IsPositive() {
+		return fmt.Errorf("invalid max exposure %q: amount must be positive", maxExposure)
+	}
+	return nil
+}
+
+// ValidateExchangeSplits returns an error if any of the provided exchange splits are invalid or have the same denom.
+func ValidateExchangeSplits(field string, splits []DenomSplit) error {
+	if len(field) > 0 && !strings.HasPrefix(field, " ") {
+		field = " " + field
+	}
+	var errs []error
+	seen := make(map[string]bool, len(splits))
+	for _, split := range splits {
+		if err := split.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid exchange split%s: %w", field, err))
+			continue
+		}
+		if seen[split.Denom] {
+			errs = append(errs, fmt.Errorf("duplicate exchange split denom%s %q", field, split.Denom))
+		}
+		seen[split.Denom] = true
+	}
+	return errors.Join(errs...)
+}
+
+// ValidateAddRemoveExchangeSplits returns an error if the exchange splits to set are invalid,
+// the denoms to remove are invalid or duplicated, or there's a denom in both lists.
+func ValidateAddRemoveExchangeSplits(toSet []DenomSplit, toRemove []string) error {
+	var errs []error
+	if err := ValidateExchangeSplits("to set", toSet); err != nil {
+		errs = append(errs, err)
+	}
+	seen := make(map[string]bool, len(toRemove))
+	for _, denom := range toRemove {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			errs = append(errs, fmt.Errorf("invalid exchange split denom to remove: %w", err))
+			continue
+		}
+		if seen[denom] {
+			errs = append(errs, fmt.Errorf("duplicate exchange split denom to remove %q", denom))
+			continue
+		}
+		seen[denom] = true
+		for _, split := range toSet {
+			if split.Denom == denom {
+				errs = append(errs, fmt.Errorf("cannot set and remove the same exchange split denom %q", denom))
+				break
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -10,15 +10,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/x/auth/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -78,6 +82,108 @@ func (Permission) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_d5cf198f1dd7e167, []int{0}
 }
 
+// SelfTradePrevention defines what a market does when two orders from the same account would trade with each other.
+type SelfTradePrevention int32
+
+const (
+	// SELF_TRADE_PREVENTION_UNSPECIFIED indicates that the market does not use self-trade prevention.
+	SelfTradePrevention_unspecified SelfTradePrevention = 0
+	// SELF_TRADE_PREVENTION_CANCEL_NEWEST cancels the newer of the two orders.
+	SelfTradePrevention_cancel_newest SelfTradePrevention = 1
+	// SELF_TRADE_PREVENTION_CANCEL_OLDEST cancels the older of the two orders.
+	SelfTradePrevention_cancel_oldest SelfTradePrevention = 2
+	// SELF_TRADE_PREVENTION_DECREMENT_BOTH reduces the assets of both orders by the smaller amount of the two.
+	// An order that is reduced to zero, or that cannot be partially reduced, is cancelled.
+	SelfTradePrevention_decrement_both SelfTradePrevention = 3
+)
+
+var SelfTradePrevention_name = map[int32]string{
+	0: "SELF_TRADE_PREVENTION_UNSPECIFIED",
+	1: "SELF_TRADE_PREVENTION_CANCEL_NEWEST",
+	2: "SELF_TRADE_PREVENTION_CANCEL_OLDEST",
+	3: "SELF_TRADE_PREVENTION_DECREMENT_BOTH",
+}
+
+var SelfTradePrevention_value = map[string]int32{
+	"SELF_TRADE_PREVENTION_UNSPECIFIED":    0,
+	"SELF_TRADE_PREVENTION_CANCEL_NEWEST":  1,
+	"SELF_TRADE_PREVENTION_CANCEL_OLDEST":  2,
+	"SELF_TRADE_PREVENTION_DECREMENT_BOTH": 3,
+}
+
+func (x SelfTradePrevention) String() string {
+	return proto.EnumName(SelfTradePrevention_name, int32(x))
+}
+
+func (SelfTradePrevention) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d5cf198f1dd7e167, []int{1}
+}
+
+// ExternalIDScope defines how unique the external id of an order in a market must be.
+type ExternalIDScope int32
+
+const (
+	// EXTERNAL_ID_SCOPE_UNSPECIFIED indicates that an order's external id must be unique among the orders in its market.
+	ExternalIDScope_unspecified ExternalIDScope = 0
+	// EXTERNAL_ID_SCOPE_ACCOUNT indicates that an order's external id must also be unique among all of
+	// the owner's other orders (in any market) and the payments that the owner is the source of.
+	ExternalIDScope_account ExternalIDScope = 1
+)
+
+var ExternalIDScope_name = map[int32]string{
+	0: "EXTERNAL_ID_SCOPE_UNSPECIFIED",
+	1: "EXTERNAL_ID_SCOPE_ACCOUNT",
+}
+
+var ExternalIDScope_value = map[string]int32{
+	"EXTERNAL_ID_SCOPE_UNSPECIFIED": 0,
+	"EXTERNAL_ID_SCOPE_ACCOUNT":     1,
+}
+
+func (x ExternalIDScope) String() string {
+	return proto.EnumName(ExternalIDScope_name, int32(x))
+}
+
+func (ExternalIDScope) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d5cf198f1dd7e167, []int{2}
+}
+
+// FillAlgorithm defines how the resting orders in a market are chosen to fill a newly booked order.
+type FillAlgorithm int32
+
+const (
+	// FILL_ALGORITHM_UNSPECIFIED indicates that resting orders are taken by price-time priority, skipping any that
+	// are too large to fill and do not allow partial fills. At most one resting order is partially filled.
+	FillAlgorithm_unspecified FillAlgorithm = 0
+	// FILL_ALGORITHM_PRICE_TIME indicates that resting orders are taken by strict price-time priority.
+	// No resting orders are taken after one that is too large to fill and does not allow partial fills.
+	FillAlgorithm_price_time FillAlgorithm = 1
+	// FILL_ALGORITHM_PRO_RATA indicates that better-priced resting orders are filled in full, and the assets left are
+	// allocated among the resting orders at the next price in proportion to their size (rounding down), with
+	// any remainder given out one at a time by time priority. Orders that do not allow partial fills are skipped.
+	FillAlgorithm_pro_rata FillAlgorithm = 2
+)
+
+var FillAlgorithm_name = map[int32]string{
+	0: "FILL_ALGORITHM_UNSPECIFIED",
+	1: "FILL_ALGORITHM_PRICE_TIME",
+	2: "FILL_ALGORITHM_PRO_RATA",
+}
+
+var FillAlgorithm_value = map[string]int32{
+	"FILL_ALGORITHM_UNSPECIFIED": 0,
+	"FILL_ALGORITHM_PRICE_TIME":  1,
+	"FILL_ALGORITHM_PRO_RATA":    2,
+}
+
+func (x FillAlgorithm) String() string {
+	return proto.EnumName(FillAlgorithm_name, int32(x))
+}
+
+func (FillAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d5cf198f1dd7e167, []int{3}
+}
+
 // MarketAccount is an account type for use with the accounts module to hold some basic information about a market.
 type MarketAccount struct {
 	// base_account is the base cosmos account information.
@@ -300,6 +406,9 @@ type Market struct {
 	//
 	// An entry that starts with "*." will match any attributes that end with the rest of it.
 	// E.g. "*.b.a" will match all of "c.b.a", "x.b.a", and "e.d.c.b.a"; but not "b.a", "xb.a", "b.x.a", or "c.b.a.x".
+	//
+	// An entry can have several options separated by "||" and will be satisfied by an account that has any of them.
+	// E.g. "kyc.a.com || *.kyc.b.com" will match "kyc.a.com" or "seller.kyc.b.com".
 	ReqAttrCreateAsk []string `protobuf:"bytes,12,rep,name=req_attr_create_ask,json=reqAttrCreateAsk,proto3" json:"req_attr_create_ask,omitempty"`
 	// req_attr_create_ask is a list of attributes required on an account for it to be allowed to create a bid order.
 	// An account must have all of these attributes in order to create a bid order in this market.
@@ -307,6 +416,9 @@ type Market struct {
 	//
 	// An entry that starts with "*." will match any attributes that end with the rest of it.
 	// E.g. "*.b.a" will match all of "c.b.a", "x.b.a", and "e.d.c.b.a"; but not "b.a", "xb.a", "c.b.x.a", or "c.b.a.x".
+	//
+	// An entry can have several options separated by "||" and will be satisfied by an account that has any of them.
+	// E.g. "kyc.a.com || *.kyc.b.com" will match "kyc.a.com" or "seller.kyc.b.com".
 	ReqAttrCreateBid []string `protobuf:"bytes,13,rep,name=req_attr_create_bid,json=reqAttrCreateBid,proto3" json:"req_attr_create_bid,omitempty"`
 	// accepting_commitments is whether the market is allowing users to commit funds to it.
 	AcceptingCommitments bool `protobuf:"varint,14,opt,name=accepting_commitments,json=acceptingCommitments,proto3" json:"accepting_commitments,omitempty"`
@@ -336,7 +448,72 @@ type Market struct {
 	//
 	// An entry that starts with "*." will match any attributes that end with the rest of it.
 	// E.g. "*.b.a" will match all of "c.b.a", "x.b.a", and "e.d.c.b.a"; but not "b.a", "xb.a", "c.b.x.a", or "c.b.a.x".
+	//
+	// An entry can have several options separated by "||" and will be satisfied by an account that has any of them.
+	// E.g. "kyc.a.com || *.kyc.b.com" will match "kyc.a.com" or "seller.kyc.b.com".
 	ReqAttrCreateCommitment []string `protobuf:"bytes,18,rep,name=req_attr_create_commitment,json=reqAttrCreateCommitment,proto3" json:"req_attr_create_commitment,omitempty"`
+	// continuous_matching is whether this market automatically matches new orders against its order book.
+	// When true, a new order that crosses the book is immediately settled against the resting orders
+	// using price-time priority. Orders (or parts of orders) that cannot be matched remain in the order book.
+	ContinuousMatching bool `protobuf:"varint,19,opt,name=continuous_matching,json=continuousMatching,proto3" json:"continuous_matching,omitempty"`
+	// batch_auction_interval is the number of blocks between this market's batch auctions.
+	// When non-zero, the market's orders are accumulated, then settled together at a single clearing price
+	// at the end of every batch_auction_interval blocks. When zero, batch auctions are disabled.
+	// A market cannot have both batch auctions and continuous_matching enabled.
+	BatchAuctionInterval uint32 `protobuf:"varint,20,opt,name=batch_auction_interval,json=batchAuctionInterval,proto3" json:"batch_auction_interval,omitempty"`
+	// self_trade_prevention is what this market does when two orders from the same account would be settled against
+	// each other during continuous matching or a batch auction. It also prevents this market from settling orders
+	// that have the same owner on both sides.
+	SelfTradePrevention SelfTradePrevention `protobuf:"varint,21,opt,name=self_trade_prevention,json=selfTradePrevention,proto3,enum=provenance.exchange.v1.SelfTradePrevention" json:"self_trade_prevention,omitempty"`
+	// accepted_asset_denoms are the denoms that can be used for the assets of orders in this market.
+	// If empty, orders can have assets of any denom.
+	AcceptedAssetDenoms []string `protobuf:"bytes,22,rep,name=accepted_asset_denoms,json=acceptedAssetDenoms,proto3" json:"accepted_asset_denoms,omitempty"`
+	// accepted_price_denoms are the denoms that can be used for the price of orders in this market.
+	// If empty, orders can have a price of any denom.
+	AcceptedPriceDenoms []string `protobuf:"bytes,23,rep,name=accepted_price_denoms,json=acceptedPriceDenoms,proto3" json:"accepted_price_denoms,omitempty"`
+	// fee_seller_settlement_taker_ratios is the fee to charge a seller during settlement when their ask order is the
+	// taker, i.e. it is newer than all of the bid orders it is being settled with. The price and fee denoms must be equal
+	// for each entry, and only one entry for any given denom is allowed. If there are fee_seller_settlement_ratios, each
+	// entry must have a price denom that is also in those. When a taker ask has a price denom without an entry here,
+	// the fee_seller_settlement_ratios are used. Ask orders that are makers always use the fee_seller_settlement_ratios.
+	FeeSellerSettlementTakerRatios []FeeRatio `protobuf:"bytes,24,rep,name=fee_seller_settlement_taker_ratios,json=feeSellerSettlementTakerRatios,proto3" json:"fee_seller_settlement_taker_ratios"`
+	// fee_seller_settlement_rebate_ratios are the rebates that this market pays to sellers during settlement.
+	// A rebate is calculated from the price a seller receives, rounded down, and is paid out of the market's account.
+	// The price and fee denoms must be equal for each entry, and only one entry for any given denom is allowed.
+	// If there are no rebate_addresses, the rebates are paid to sellers whose ask orders are makers.
+	FeeSellerSettlementRebateRatios []FeeRatio `protobuf:"bytes,25,rep,name=fee_seller_settlement_rebate_ratios,json=feeSellerSettlementRebateRatios,proto3" json:"fee_seller_settlement_rebate_ratios"`
+	// rebate_addresses are the accounts that are designated to receive rebates.
+	// If not empty, rebates are paid to these accounts (as sellers) regardless of whether their ask order was the
+	// maker or taker, and no other accounts receive rebates.
+	RebateAddresses []string `protobuf:"bytes,26,rep,name=rebate_addresses,json=rebateAddresses,proto3" json:"rebate_addresses,omitempty"`
+	// referral_bips is the fraction of this market's share of an order's settlement fees that is paid to the order's
+	// referrer. It is represented in basis points (1/100th of 1%, e.g. 0.0001) and is limited to 0 to 10,000 inclusive.
+	// The market's share is the settlement fees that are left after the exchange's split is taken out.
+	// If zero, referrers are not paid anything.
+	ReferralBips uint32 `protobuf:"varint,27,opt,name=referral_bips,json=referralBips,proto3" json:"referral_bips,omitempty"`
+	// referral_cap is the most (in each denom) that will be paid to a referrer for a single order's settlement.
+	// Only one entry for any given denom is allowed. Denoms without an entry are not capped.
+	ReferralCap []types1.Coin `protobuf:"bytes,28,rep,name=referral_cap,json=referralCap,proto3" json:"referral_cap"`
+	// publish_prices is whether this market's settlement prices are published to the x/oracle price feed.
+	// When true, the net-asset-values from each settlement are published (replacing any previously published
+	// price of the same denoms) so that other chains can get them using interchain queries.
+	PublishPrices bool `protobuf:"varint,29,opt,name=publish_prices,json=publishPrices,proto3" json:"publish_prices,omitempty"`
+	// external_id_scope is how unique the external ids of orders in this market must be.
+	// By default, an order's external id only needs to be unique among the orders in this market.
+	ExternalIdScope ExternalIDScope `protobuf:"varint,30,opt,name=external_id_scope,json=externalIdScope,proto3,enum=provenance.exchange.v1.ExternalIDScope" json:"external_id_scope,omitempty"`
+	// order_rate_limit is the most orders that a single account can create in this market over a window of blocks.
+	// If not set, accounts can create any number of orders in this market.
+	OrderRateLimit *OrderRateLimit `protobuf:"bytes,31,opt,name=order_rate_limit,json=orderRateLimit,proto3" json:"order_rate_limit,omitempty"`
+	// max_exposure is the most that a single account can have in this market's open orders and commitments.
+	// The price of each order (including trigger orders) and each committed coin are converted to this denom
+	// using recorded net-asset-values. If not set, there is no limit on an account's exposure in this market.
+	MaxExposure *types1.Coin `protobuf:"bytes,32,opt,name=max_exposure,json=maxExposure,proto3" json:"max_exposure,omitempty"`
+	// fill_algorithm is how resting orders are chosen to fill a newly booked order during continuous matching.
+	FillAlgorithm FillAlgorithm `protobuf:"varint,33,opt,name=fill_algorithm,json=fillAlgorithm,proto3,enum=provenance.exchange.v1.FillAlgorithm" json:"fill_algorithm,omitempty"`
+	// exchange_splits are market-specific overrides of the exchange's split in basis points.
+	// For fees paid to this market in one of these denoms, the split here is used instead of the one in the params.
+	// These can only be changed through governance.
+	ExchangeSplits []DenomSplit `protobuf:"bytes,34,rep,name=exchange_splits,json=exchangeSplits,proto3" json:"exchange_splits"`
 }
 
 func (m *Market) Reset()         { *m = Market{} }
@@ -498,6 +675,118 @@ func (m *Market) GetReqAttrCreateCommitment() []string {
 	return nil
 }
 
+func (m *Market) GetContinuousMatching() bool {
+	if m != nil {
+		return m.ContinuousMatching
+	}
+	return false
+}
+
+func (m *Market) GetBatchAuctionInterval() uint32 {
+	if m != nil {
+		return m.BatchAuctionInterval
+	}
+	return 0
+}
+
+func (m *Market) GetSelfTradePrevention() SelfTradePrevention {
+	if m != nil {
+		return m.SelfTradePrevention
+	}
+	return SelfTradePrevention_unspecified
+}
+
+func (m *Market) GetAcceptedAssetDenoms() []string {
+	if m != nil {
+		return m.AcceptedAssetDenoms
+	}
+	return nil
+}
+
+func (m *Market) GetAcceptedPriceDenoms() []string {
+	if m != nil {
+		return m.AcceptedPriceDenoms
+	}
+	return nil
+}
+
+func (m *Market) GetFeeSellerSettlementTakerRatios() []FeeRatio {
+	if m != nil {
+		return m.FeeSellerSettlementTakerRatios
+	}
+	return nil
+}
+
+func (m *Market) GetFeeSellerSettlementRebateRatios() []FeeRatio {
+	if m != nil {
+		return m.FeeSellerSettlementRebateRatios
+	}
+	return nil
+}
+
+func (m *Market) GetRebateAddresses() []string {
+	if m != nil {
+		return m.RebateAddresses
+	}
+	return nil
+}
+
+func (m *Market) GetReferralBips() uint32 {
+	if m != nil {
+		return m.ReferralBips
+	}
+	return 0
+}
+
+func (m *Market) GetReferralCap() []types1.Coin {
+	if m != nil {
+		return m.ReferralCap
+	}
+	return nil
+}
+
+func (m *Market) GetPublishPrices() bool {
+	if m != nil {
+		return m.PublishPrices
+	}
+	return false
+}
+
+func (m *Market) GetExternalIdScope() ExternalIDScope {
+	if m != nil {
+		return m.ExternalIdScope
+	}
+	return ExternalIDScope_unspecified
+}
+
+func (m *Market) GetOrderRateLimit() *OrderRateLimit {
+	if m != nil {
+		return m.OrderRateLimit
+	}
+	return nil
+}
+
+func (m *Market) GetMaxExposure() *types1.Coin {
+	if m != nil {
+		return m.MaxExposure
+	}
+	return nil
+}
+
+func (m *Market) GetFillAlgorithm() FillAlgorithm {
+	if m != nil {
+		return m.FillAlgorithm
+	}
+	return FillAlgorithm_unspecified
+}
+
+func (m *Market) GetExchangeSplits() []DenomSplit {
+	if m != nil {
+		return m.ExchangeSplits
+	}
+	return nil
+}
+
 // FeeRatio defines a ratio of price amount to fee amount.
 // For an order to be valid, its price must be evenly divisible by a FeeRatio's price.
 type FeeRatio struct {
@@ -553,19 +842,77 @@ func (m *FeeRatio) GetFee() types1.Coin {
 	return types1.Coin{}
 }
 
+// OrderRateLimit defines the most orders an account can create in a market over a sliding window of blocks.
+type OrderRateLimit struct {
+	// max_orders is the most orders (including trigger orders) that an account can create during the window.
+	MaxOrders uint32 `protobuf:"varint,1,opt,name=max_orders,json=maxOrders,proto3" json:"max_orders,omitempty"`
+	// window_blocks is the number of blocks (including the current one) that orders are counted over.
+	// A value of 1 limits the number of orders an account can create in a single block.
+	WindowBlocks uint32 `protobuf:"varint,2,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty"`
+}
+
+func (m *OrderRateLimit) Reset()         { *m = OrderRateLimit{} }
+func (m *OrderRateLimit) String() string { return proto.CompactTextString(m) }
+func (*OrderRateLimit) ProtoMessage()    {}
+func (*OrderRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5cf198f1dd7e167, []int{5}
+}
+func (m *OrderRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderRateLimit.Merge(m, src)
+}
+func (m *OrderRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderRateLimit proto.InternalMessageInfo
+
+func (m *OrderRateLimit) GetMaxOrders() uint32 {
+	if m != nil {
+		return m.MaxOrders
+	}
+	return 0
+}
+
+func (m *OrderRateLimit) GetWindowBlocks() uint32 {
+	if m != nil {
+		return m.WindowBlocks
+	}
+	return 0
+}
+
 // AddrPermissions associates an address with a list of permissions available for that address.
 type AccessGrant struct {
 	// address is the address that these permissions apply to.
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	// allowed is the list of permissions available for the address.
 	Permissions []Permission `protobuf:"varint,2,rep,packed,name=permissions,proto3,enum=provenance.exchange.v1.Permission" json:"permissions,omitempty"`
+	// expiration is an optional time after which the address no longer has any of these permissions.
+	Expiration *time.Time `protobuf:"bytes,3,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
 }
 
 func (m *AccessGrant) Reset()         { *m = AccessGrant{} }
 func (m *AccessGrant) String() string { return proto.CompactTextString(m) }
 func (*AccessGrant) ProtoMessage()    {}
 func (*AccessGrant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d5cf198f1dd7e167, []int{5}
+	return fileDescriptor_d5cf198f1dd7e167, []int{6}
 }
 func (m *AccessGrant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -608,14 +955,101 @@ func (m *AccessGrant) GetPermissions() []Permission {
 	return nil
 }
 
+func (m *AccessGrant) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+// MarketAuthorityTransfer is a proposed replacement of all of a market's access grants.
+// It is applied once the new authority accepts it.
+type MarketAuthorityTransfer struct {
+	// market_id is the numerical identifier of the market being transferred.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// proposed_by is the account that proposed this transfer.
+	ProposedBy string `protobuf:"bytes,2,opt,name=proposed_by,json=proposedBy,proto3" json:"proposed_by,omitempty"`
+	// new_authority is the account that must accept this transfer.
+	// It must either be the exchange module's authority or be given "permissions" permission by the access_grants.
+	NewAuthority string `protobuf:"bytes,3,opt,name=new_authority,json=newAuthority,proto3" json:"new_authority,omitempty"`
+	// access_grants are the access grants that will replace all of the market's existing ones.
+	AccessGrants []AccessGrant `protobuf:"bytes,4,rep,name=access_grants,json=accessGrants,proto3" json:"access_grants"`
+}
+
+func (m *MarketAuthorityTransfer) Reset()         { *m = MarketAuthorityTransfer{} }
+func (m *MarketAuthorityTransfer) String() string { return proto.CompactTextString(m) }
+func (*MarketAuthorityTransfer) ProtoMessage()    {}
+func (*MarketAuthorityTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5cf198f1dd7e167, []int{7}
+}
+func (m *MarketAuthorityTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketAuthorityTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketAuthorityTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketAuthorityTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketAuthorityTransfer.Merge(m, src)
+}
+func (m *MarketAuthorityTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketAuthorityTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketAuthorityTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketAuthorityTransfer proto.InternalMessageInfo
+
+func (m *MarketAuthorityTransfer) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *MarketAuthorityTransfer) GetProposedBy() string {
+	if m != nil {
+		return m.ProposedBy
+	}
+	return ""
+}
+
+func (m *MarketAuthorityTransfer) GetNewAuthority() string {
+	if m != nil {
+		return m.NewAuthority
+	}
+	return ""
+}
+
+func (m *MarketAuthorityTransfer) GetAccessGrants() []AccessGrant {
+	if m != nil {
+		return m.AccessGrants
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("provenance.exchange.v1.Permission", Permission_name, Permission_value)
+	proto.RegisterEnum("provenance.exchange.v1.SelfTradePrevention", SelfTradePrevention_name, SelfTradePrevention_value)
+	proto.RegisterEnum("provenance.exchange.v1.ExternalIDScope", ExternalIDScope_name, ExternalIDScope_value)
+	proto.RegisterEnum("provenance.exchange.v1.FillAlgorithm", FillAlgorithm_name, FillAlgorithm_value)
 	proto.RegisterType((*MarketAccount)(nil), "provenance.exchange.v1.MarketAccount")
 	proto.RegisterType((*MarketDetails)(nil), "provenance.exchange.v1.MarketDetails")
 	proto.RegisterType((*MarketBrief)(nil), "provenance.exchange.v1.MarketBrief")
 	proto.RegisterType((*Market)(nil), "provenance.exchange.v1.Market")
 	proto.RegisterType((*FeeRatio)(nil), "provenance.exchange.v1.FeeRatio")
+	proto.RegisterType((*OrderRateLimit)(nil), "provenance.exchange.v1.OrderRateLimit")
 	proto.RegisterType((*AccessGrant)(nil), "provenance.exchange.v1.AccessGrant")
+	proto.RegisterType((*MarketAuthorityTransfer)(nil), "provenance.exchange.v1.MarketAuthorityTransfer")
 }
 
 func init() {
@@ -623,78 +1057,128 @@ func init() {
 }
 
 var fileDescriptor_d5cf198f1dd7e167 = []byte{
-	// 1127 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6b, 0x1b, 0xc7,
-	0x1b, 0xd6, 0x5a, 0x8a, 0x2d, 0x8d, 0x6c, 0x67, 0x33, 0xfe, 0x93, 0xb5, 0xf2, 0x43, 0xda, 0x9f,
-	0x4c, 0x40, 0x69, 0xb1, 0x84, 0x1d, 0x7a, 0x71, 0x0b, 0x45, 0xff, 0xdc, 0x0a, 0x12, 0xc7, 0xac,
-	0x24, 0x02, 0xa1, 0xb0, 0x8c, 0x76, 0x5f, 0xc9, 0x83, 0xa5, 0x5d, 0x65, 0x66, 0x64, 0x27, 0xfd,
-	0x02, 0x2d, 0x3e, 0xf5, 0xd8, 0x8b, 0xc1, 0x1f, 0xa2, 0xf7, 0xde, 0x4a, 0x8e, 0xa6, 0x50, 0xe8,
-	0x29, 0x14, 0xfb, 0xd2, 0x4b, 0xbf, 0x43, 0xd9, 0xd9, 0x95, 0x76, 0xad, 0xc8, 0xb5, 0x43, 0xe9,
-	0x6d, 0xe7, 0x7d, 0x9e, 0x79, 0xde, 0xf7, 0x79, 0xe7, 0x65, 0x66, 0xd1, 0xe6, 0x90, 0xb9, 0xc7,
-	0xe0, 0x10, 0xc7, 0x82, 0x12, 0xbc, 0xb1, 0x0e, 0x89, 0xd3, 0x83, 0xd2, 0xf1, 0x76, 0x69, 0x40,
-	0xd8, 0x11, 0x88, 0xe2, 0x90, 0xb9, 0xc2, 0xc5, 0xeb, 0x21, 0xa9, 0x38, 0x26, 0x15, 0x8f, 0xb7,
-	0x33, 0x59, 0xcb, 0xe5, 0x03, 0x97, 0x97, 0xc8, 0x48, 0x1c, 0x96, 0x8e, 0xb7, 0x3b, 0x20, 0xc8,
-	0xb6, 0x5c, 0xf8, 0xfb, 0x26, 0x78, 0x87, 0x70, 0x98, 0xe0, 0x96, 0x4b, 0x9d, 0x00, 0xdf, 0xf0,
-	0x71, 0x53, 0xae, 0x4a, 0xfe, 0x22, 0x80, 0x56, 0x7b, 0x6e, 0xcf, 0xf5, 0xe3, 0xde, 0x97, 0x1f,
-	0xcd, 0xff, 0xa6, 0xa0, 0xa5, 0xe7, 0xb2, 0xb2, 0xb2, 0x65, 0xb9, 0x23, 0x47, 0xe0, 0x06, 0x5a,
-	0xf4, 0xd4, 0x4d, 0xe2, 0xaf, 0x35, 0x45, 0x57, 0x0a, 0xe9, 0x1d, 0xbd, 0x18, 0x88, 0xc9, 0x62,
-	0x82, 0xcc, 0xc5, 0x0a, 0xe1, 0x10, 0xec, 0xab, 0x24, 0x2e, 0xde, 0xe7, 0x14, 0x23, 0xdd, 0x09,
-	0x43, 0xf8, 0x11, 0x4a, 0xf9, 0xae, 0x4d, 0x6a, 0x6b, 0x73, 0xba, 0x52, 0x58, 0x32, 0x92, 0x7e,
-	0xa0, 0x61, 0x63, 0x03, 0x2d, 0x07, 0xa0, 0x0d, 0x82, 0xd0, 0x3e, 0xd7, 0xe2, 0x32, 0xd3, 0xe3,
-	0xe2, 0xec, 0xde, 0x14, 0xfd, 0x32, 0x6b, 0x3e, 0xb9, 0x92, 0x78, 0xf7, 0x3e, 0x17, 0x33, 0x96,
-	0x06, 0xd1, 0xe0, 0x6e, 0xf2, 0xfb, 0xf3, 0x5c, 0xec, 0xc7, 0xf3, 0x5c, 0x2c, 0xff, 0xdd, 0xc4,
-	0x57, 0x80, 0x61, 0x8c, 0x12, 0x0e, 0x19, 0x80, 0xf4, 0x93, 0x32, 0xe4, 0x37, 0xd6, 0x51, 0xda,
-	0x06, 0x6e, 0x31, 0x3a, 0x14, 0xd4, 0x75, 0x64, 0x89, 0x29, 0x23, 0x1a, 0xc2, 0x39, 0x94, 0x3e,
-	0x81, 0x0e, 0xa7, 0x02, 0xcc, 0x11, 0xeb, 0xcb, 0x12, 0x53, 0x06, 0x0a, 0x42, 0x6d, 0xd6, 0xc7,
-	0x1b, 0x28, 0x49, 0x2d, 0xd7, 0x31, 0x47, 0x8c, 0x6a, 0x09, 0x89, 0x2e, 0x78, 0xeb, 0x36, 0xa3,
-	0xbb, 0x89, 0x3f, 0xcf, 0x73, 0x4a, 0xfe, 0x67, 0x05, 0xa5, 0xfd, 0x4a, 0x2a, 0x8c, 0x42, 0xf7,
-	0x7a, 0x53, 0x94, 0xa9, 0xa6, 0x7c, 0x39, 0x69, 0x0a, 0xb1, 0x6d, 0x06, 0x9c, 0xfb, 0x35, 0x55,
-	0xb4, 0x5f, 0x7f, 0xda, 0x5a, 0x0d, 0x4e, 0xa0, 0xec, 0x23, 0x4d, 0xc1, 0xa8, 0xd3, 0x1b, 0x77,
-	0x20, 0x08, 0xfe, 0x17, 0x5d, 0xcd, 0xff, 0x95, 0x42, 0xf3, 0x3e, 0xed, 0x9f, 0x8b, 0xff, 0x30,
-	0xf7, 0xdc, 0xbf, 0xcd, 0x8d, 0xf7, 0xd1, 0x4a, 0x17, 0xc0, 0xb4, 0x18, 0x10, 0x01, 0x26, 0xe1,
-	0x47, 0x66, 0xb7, 0x4f, 0x84, 0x16, 0xd7, 0xe3, 0x85, 0xf4, 0xce, 0xc6, 0x78, 0x28, 0xbd, 0xa1,
-	0x9b, 0x0c, 0x65, 0xd5, 0xa5, 0x4e, 0x20, 0xa6, 0x76, 0x01, 0xaa, 0x72, 0x6b, 0x99, 0x1f, 0xed,
-	0xf5, 0x89, 0x98, 0xd2, 0xeb, 0x50, 0xdb, 0xd7, 0x4b, 0x7c, 0xac, 0x5e, 0x85, 0xda, 0x52, 0xef,
-	0x1b, 0x94, 0xf1, 0xf4, 0x38, 0xf4, 0xfb, 0xc0, 0x4c, 0x0e, 0x42, 0xf4, 0x61, 0x00, 0x8e, 0xf0,
-	0x65, 0xef, 0xdd, 0x4d, 0xf6, 0x61, 0x17, 0xa0, 0x29, 0x15, 0x9a, 0x13, 0x01, 0xa9, 0xde, 0x43,
-	0xff, 0x9b, 0xad, 0xce, 0x88, 0xa0, 0x2e, 0xd7, 0xe6, 0xa5, 0xbe, 0x7e, 0x53, 0x7f, 0xf7, 0x00,
-	0x0c, 0x8f, 0x18, 0xa4, 0xd9, 0x98, 0x91, 0x46, 0xe2, 0x1c, 0xbf, 0x42, 0x1e, 0x68, 0x76, 0x46,
-	0x6f, 0x67, 0xb8, 0x58, 0xb8, 0x9b, 0x8b, 0xf5, 0x2e, 0x40, 0xc5, 0x13, 0x98, 0x32, 0x01, 0xe8,
-	0xd1, 0x4c, 0xed, 0xc0, 0x43, 0xf2, 0xa3, 0x3c, 0x68, 0x1f, 0x26, 0x09, 0x2c, 0x3c, 0x41, 0x2a,
-	0xb1, 0x2c, 0x18, 0x0a, 0xea, 0xf4, 0x4c, 0x97, 0xd9, 0xc0, 0xb8, 0x96, 0xd2, 0x95, 0x42, 0xd2,
-	0xb8, 0x3f, 0x89, 0xbf, 0x90, 0x61, 0xbc, 0x83, 0xd6, 0x48, 0xbf, 0xef, 0x9e, 0x98, 0x23, 0x7e,
-	0xad, 0x24, 0x0d, 0x49, 0xfe, 0x8a, 0x04, 0xdb, 0x3c, 0x9a, 0x04, 0xef, 0xa3, 0x25, 0x4f, 0x86,
-	0x73, 0xb3, 0xc7, 0x88, 0x23, 0xb8, 0x96, 0x96, 0x75, 0x6f, 0xde, 0x54, 0x77, 0x59, 0x92, 0xbf,
-	0xf2, 0xb8, 0x41, 0xe9, 0x8b, 0x24, 0x0c, 0x71, 0xbc, 0x85, 0x56, 0x18, 0xbc, 0x36, 0x89, 0x10,
-	0x2c, 0x32, 0xdd, 0xda, 0xa2, 0x1e, 0x2f, 0xa4, 0x0c, 0x95, 0xc1, 0xeb, 0xb2, 0x10, 0x6c, 0x32,
-	0xbb, 0xb3, 0xe8, 0x1d, 0x6a, 0x6b, 0x4b, 0x33, 0xe8, 0x15, 0x6a, 0xe3, 0xa7, 0x68, 0x2d, 0x6c,
-	0x86, 0xe5, 0x0e, 0x06, 0x54, 0x78, 0x2e, 0xb8, 0xb6, 0x2c, 0x1d, 0xae, 0x4e, 0xc0, 0x6a, 0x88,
-	0x8d, 0x67, 0x39, 0x90, 0x0f, 0x77, 0xf9, 0x53, 0x70, 0xff, 0xee, 0xb3, 0xec, 0xd7, 0x11, 0x4a,
-	0xcb, 0x31, 0xf8, 0x02, 0x65, 0x22, 0x92, 0x91, 0x39, 0xe8, 0xd0, 0x21, 0xd7, 0x54, 0x79, 0x97,
-	0x68, 0x21, 0x23, 0x6c, 0x7d, 0x85, 0x0e, 0xbd, 0x76, 0x61, 0xea, 0x08, 0x60, 0x03, 0xb0, 0x29,
-	0x61, 0x6f, 0x4d, 0x1b, 0x1c, 0x77, 0xa0, 0x3d, 0x90, 0x17, 0xee, 0x83, 0x28, 0x52, 0xf3, 0x00,
-	0xfc, 0x39, 0xca, 0x4c, 0xb7, 0x2b, 0x94, 0xd6, 0xb0, 0xec, 0xda, 0xc3, 0x6b, 0x5d, 0x0b, 0xab,
-	0xcd, 0x7f, 0x8b, 0x92, 0xe3, 0xa9, 0xc3, 0x9f, 0xa1, 0x7b, 0x43, 0x46, 0x2d, 0x08, 0x9e, 0xc1,
-	0x5b, 0xed, 0xfb, 0x6c, 0xbc, 0x8d, 0xe2, 0x5d, 0x80, 0xe0, 0xfe, 0xbb, 0x75, 0x93, 0xc7, 0xdd,
-	0x4d, 0x8c, 0xdf, 0xad, 0x74, 0x64, 0x74, 0xf0, 0x0e, 0x5a, 0x18, 0xbf, 0x04, 0xca, 0x2d, 0x2f,
-	0xc1, 0x98, 0x88, 0x6b, 0x28, 0x3d, 0x04, 0x36, 0xa0, 0x9c, 0x53, 0xd7, 0xf1, 0x2e, 0xe1, 0x78,
-	0x61, 0x79, 0x27, 0x7f, 0xd3, 0xa0, 0x1e, 0x4c, 0xa8, 0x46, 0x74, 0xdb, 0x27, 0xbf, 0xcc, 0x21,
-	0x14, 0x62, 0xf8, 0x53, 0xb4, 0x7e, 0x50, 0x37, 0x9e, 0x37, 0x9a, 0xcd, 0xc6, 0x8b, 0x7d, 0xb3,
-	0xbd, 0xdf, 0x3c, 0xa8, 0x57, 0x1b, 0x7b, 0x8d, 0x7a, 0x4d, 0x8d, 0x65, 0xee, 0x9f, 0x9e, 0xe9,
-	0xe9, 0x91, 0xc3, 0x87, 0x60, 0xd1, 0x2e, 0x05, 0x1b, 0xff, 0x1f, 0x3d, 0x88, 0x90, 0x9b, 0xf5,
-	0x56, 0xeb, 0x59, 0x5d, 0x55, 0x32, 0xe8, 0xf4, 0x4c, 0x9f, 0xf7, 0x4f, 0x1e, 0x6f, 0x22, 0x7c,
-	0x9d, 0x62, 0x36, 0x6a, 0x4d, 0x75, 0x2e, 0x93, 0x3e, 0x3d, 0xd3, 0x17, 0xb8, 0x7c, 0x60, 0xf8,
-	0x94, 0x4e, 0xb5, 0xbc, 0x5f, 0xad, 0x3f, 0x53, 0xe3, 0xbe, 0x8e, 0xe5, 0x39, 0xe9, 0xe3, 0xc7,
-	0x68, 0x25, 0x42, 0x79, 0xd9, 0x68, 0x7d, 0x5d, 0x33, 0xca, 0x2f, 0xd5, 0x44, 0x66, 0xf1, 0xf4,
-	0x4c, 0x4f, 0x9e, 0x50, 0x71, 0x68, 0x33, 0x72, 0x32, 0xa5, 0xd4, 0x3e, 0xa8, 0x95, 0x5b, 0x75,
-	0xf5, 0x9e, 0xaf, 0x34, 0x1a, 0xda, 0x44, 0xc0, 0x94, 0xc3, 0xf0, 0xb3, 0xa9, 0xce, 0xfb, 0x0e,
-	0x23, 0xdd, 0xc1, 0x4f, 0xd0, 0x5a, 0x84, 0x5c, 0x6e, 0xb5, 0x8c, 0x46, 0xa5, 0xdd, 0xaa, 0x37,
-	0xd5, 0x85, 0xcc, 0xf2, 0xe9, 0x99, 0x8e, 0xbc, 0xc9, 0xa3, 0x9d, 0x91, 0x00, 0x5e, 0x81, 0x77,
-	0x97, 0x59, 0xe5, 0xe2, 0x32, 0xab, 0xfc, 0x71, 0x99, 0x55, 0x7e, 0xb8, 0xca, 0xc6, 0x2e, 0xae,
-	0xb2, 0xb1, 0xdf, 0xaf, 0xb2, 0x31, 0xb4, 0x41, 0xdd, 0x1b, 0x4e, 0xe5, 0x40, 0x79, 0x55, 0xec,
-	0x51, 0x71, 0x38, 0xea, 0x14, 0x2d, 0x77, 0x50, 0x0a, 0x49, 0x5b, 0xd4, 0x8d, 0xac, 0x4a, 0x6f,
-	0x26, 0xbf, 0x98, 0x9d, 0x79, 0xf9, 0x43, 0xf7, 0xf4, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd5,
-	0x10, 0x90, 0x0d, 0x80, 0x0a, 0x00, 0x00,
+	// 1930 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4b, 0x6f, 0x1b, 0xc9,
+	0x11, 0xd6, 0x88, 0xb2, 0x2d, 0x35, 0x45, 0x8a, 0x6e, 0x59, 0xd2, 0x88, 0x5e, 0x8b, 0x34, 0x15,
+	0x27, 0xb2, 0x16, 0x26, 0x21, 0x6d, 0x12, 0x20, 0x8e, 0x83, 0x80, 0x8f, 0xd1, 0x9a, 0x00, 0x45,
+	0x31, 0xc3, 0x51, 0x1c, 0x2c, 0x02, 0x34, 0x9a, 0x33, 0x4d, 0xaa, 0xa1, 0x79, 0xb9, 0xbb, 0xa9,
+	0x47, 0x4e, 0x01, 0x72, 0x48, 0xa0, 0xd3, 0x1e, 0x83, 0x00, 0x02, 0xfc, 0x23, 0x72, 0xcf, 0x2d,
+	0xf0, 0x25, 0x80, 0x11, 0x20, 0x40, 0x4e, 0x4e, 0x60, 0x5f, 0xf2, 0x33, 0x82, 0xe9, 0x99, 0xe1,
+	0x43, 0x22, 0x2d, 0x19, 0x8b, 0xbd, 0xb1, 0xab, 0xbe, 0xfa, 0xba, 0xaa, 0xba, 0xba, 0xba, 0x86,
+	0x60, 0xd3, 0x67, 0xde, 0x09, 0x71, 0xb1, 0x6b, 0x92, 0x12, 0x39, 0x33, 0x8f, 0xb0, 0xdb, 0x23,
+	0xa5, 0x93, 0x9d, 0x92, 0x83, 0xd9, 0x31, 0x11, 0x45, 0x9f, 0x79, 0xc2, 0x83, 0xab, 0x43, 0x50,
+	0x31, 0x06, 0x15, 0x4f, 0x76, 0xb2, 0x1b, 0xa6, 0xc7, 0x1d, 0x8f, 0x97, 0x70, 0x5f, 0x1c, 0x95,
+	0x4e, 0x76, 0x3a, 0x44, 0xe0, 0x1d, 0xb9, 0x08, 0xed, 0x06, 0xfa, 0x0e, 0xe6, 0x64, 0xa0, 0x37,
+	0x3d, 0xea, 0x46, 0xfa, 0xf5, 0x50, 0x8f, 0xe4, 0xaa, 0x14, 0x2e, 0x22, 0xd5, 0x83, 0x9e, 0xd7,
+	0xf3, 0x42, 0x79, 0xf0, 0x2b, 0x92, 0xe6, 0x7a, 0x9e, 0xd7, 0xb3, 0x49, 0x49, 0xae, 0x3a, 0xfd,
+	0x6e, 0x49, 0x50, 0x87, 0x70, 0x81, 0x1d, 0x3f, 0x02, 0x4c, 0x0b, 0xc7, 0xc7, 0x0c, 0x3b, 0x11,
+	0x77, 0xe1, 0x5f, 0x0a, 0x48, 0xed, 0xcb, 0xf8, 0xca, 0xa6, 0xe9, 0xf5, 0x5d, 0x01, 0xeb, 0x60,
+	0x31, 0xf0, 0x11, 0xe1, 0x70, 0xad, 0x2a, 0x79, 0x65, 0x2b, 0xb9, 0x9b, 0x2f, 0x46, 0x2e, 0xc9,
+	0x90, 0x22, 0xff, 0x8b, 0x15, 0xcc, 0x49, 0x64, 0x57, 0x99, 0x7b, 0xf7, 0x3e, 0xa7, 0xe8, 0xc9,
+	0xce, 0x50, 0x04, 0x1f, 0x82, 0x85, 0x30, 0x77, 0x88, 0x5a, 0xea, 0x6c, 0x5e, 0xd9, 0x4a, 0xe9,
+	0xf3, 0xa1, 0xa0, 0x6e, 0x41, 0x1d, 0xa4, 0x23, 0xa5, 0x45, 0x04, 0xa6, 0x36, 0x57, 0x13, 0x72,
+	0xa7, 0x27, 0xc5, 0xc9, 0x19, 0x2e, 0x86, 0x6e, 0xd6, 0x42, 0x70, 0x65, 0xee, 0xed, 0xfb, 0xdc,
+	0x8c, 0x9e, 0x72, 0x46, 0x85, 0xcf, 0xe7, 0xff, 0xf4, 0x26, 0x37, 0xf3, 0xe7, 0x37, 0xb9, 0x99,
+	0xc2, 0x1f, 0x07, 0x71, 0x45, 0x3a, 0x08, 0xc1, 0x9c, 0x8b, 0x1d, 0x22, 0xe3, 0x59, 0xd0, 0xe5,
+	0x6f, 0x98, 0x07, 0x49, 0x8b, 0x70, 0x93, 0x51, 0x5f, 0x50, 0xcf, 0x95, 0x2e, 0x2e, 0xe8, 0xa3,
+	0x22, 0x98, 0x03, 0xc9, 0x53, 0xd2, 0xe1, 0x54, 0x10, 0xd4, 0x67, 0xb6, 0x74, 0x71, 0x41, 0x07,
+	0x91, 0xe8, 0x90, 0xd9, 0x70, 0x1d, 0xcc, 0x53, 0xd3, 0x73, 0x51, 0x9f, 0x51, 0x75, 0x4e, 0x6a,
+	0xef, 0x05, 0xeb, 0x43, 0x46, 0x9f, 0xcf, 0xfd, 0xef, 0x4d, 0x4e, 0x29, 0xfc, 0x4d, 0x01, 0xc9,
+	0xd0, 0x93, 0x0a, 0xa3, 0xa4, 0x3b, 0x9e, 0x14, 0xe5, 0x4a, 0x52, 0x7e, 0x39, 0x48, 0x0a, 0xb6,
+	0x2c, 0x46, 0x38, 0x0f, 0x7d, 0xaa, 0xa8, 0xff, 0xfc, 0xeb, 0xb3, 0x07, 0xd1, 0x09, 0x94, 0x43,
+	0x4d, 0x5b, 0x30, 0xea, 0xf6, 0xe2, 0x0c, 0x44, 0xc2, 0xef, 0x23, 0xab, 0x85, 0x0f, 0x10, 0xdc,
+	0x0d, 0x61, 0x9f, 0x76, 0xfe, 0xfa, 0xde, 0xb3, 0xdf, 0x75, 0x6f, 0xd8, 0x04, 0xcb, 0x5d, 0x42,
+	0x90, 0xc9, 0x08, 0x16, 0x04, 0x61, 0x7e, 0x8c, 0xba, 0x36, 0x16, 0x6a, 0x22, 0x9f, 0xd8, 0x4a,
+	0xee, 0xae, 0xc7, 0x45, 0x19, 0x14, 0xdd, 0xa0, 0x28, 0xab, 0x1e, 0x75, 0x23, 0xb2, 0x4c, 0x97,
+	0x90, 0xaa, 0x34, 0x2d, 0xf3, 0xe3, 0x3d, 0x1b, 0x8b, 0x2b, 0x7c, 0x1d, 0x6a, 0x85, 0x7c, 0x73,
+	0x9f, 0xcb, 0x57, 0xa1, 0x96, 0xe4, 0xfb, 0x2d, 0xc8, 0x06, 0x7c, 0x9c, 0xd8, 0x36, 0x61, 0x88,
+	0x13, 0x21, 0x6c, 0xe2, 0x10, 0x57, 0x84, 0xb4, 0x77, 0x6e, 0x47, 0xbb, 0xd6, 0x25, 0xa4, 0x2d,
+	0x19, 0xda, 0x03, 0x02, 0xc9, 0xde, 0x03, 0x5f, 0x4c, 0x66, 0x67, 0x58, 0x50, 0x8f, 0xab, 0x77,
+	0x25, 0x7f, 0x7e, 0x5a, 0x7e, 0xf7, 0x08, 0xd1, 0x03, 0x60, 0xb4, 0xcd, 0xfa, 0x84, 0x6d, 0xa4,
+	0x9e, 0xc3, 0x6f, 0x40, 0xa0, 0x44, 0x9d, 0xfe, 0xf9, 0x84, 0x28, 0xee, 0xdd, 0x2e, 0x8a, 0xd5,
+	0x2e, 0x21, 0x95, 0xfe, 0xf9, 0x28, 0xbb, 0x0c, 0x82, 0x80, 0x87, 0x13, 0xb9, 0xa3, 0x18, 0xe6,
+	0x3f, 0x2b, 0x06, 0xf5, 0xfa, 0x26, 0x51, 0x08, 0x4f, 0x41, 0x06, 0x9b, 0x26, 0xf1, 0x05, 0x75,
+	0x7b, 0xc8, 0x63, 0x16, 0x61, 0x5c, 0x5d, 0xc8, 0x2b, 0x5b, 0xf3, 0xfa, 0xd2, 0x40, 0x7e, 0x20,
+	0xc5, 0x70, 0x17, 0xac, 0x60, 0xdb, 0xf6, 0x4e, 0x51, 0x9f, 0x8f, 0xb9, 0xa4, 0x02, 0x89, 0x5f,
+	0x96, 0xca, 0x43, 0x3e, 0xba, 0x09, 0x6c, 0x82, 0x54, 0x40, 0xc3, 0x39, 0xea, 0x31, 0xec, 0x0a,
+	0xae, 0x26, 0xa5, 0xdf, 0x9b, 0xd3, 0xfc, 0x2e, 0x4b, 0xf0, 0xd7, 0x01, 0x36, 0x72, 0x7d, 0x11,
+	0x0f, 0x45, 0x1c, 0x3e, 0x03, 0xcb, 0x8c, 0xbc, 0x46, 0x58, 0x08, 0x36, 0x52, 0xdd, 0xea, 0x62,
+	0x3e, 0xb1, 0xb5, 0xa0, 0x67, 0x18, 0x79, 0x5d, 0x16, 0x82, 0x0d, 0x6a, 0x77, 0x12, 0xbc, 0x43,
+	0x2d, 0x35, 0x35, 0x01, 0x5e, 0xa1, 0x16, 0xfc, 0x0a, 0xac, 0x0c, 0x93, 0x61, 0x7a, 0x8e, 0x43,
+	0x45, 0x10, 0x05, 0x57, 0xd3, 0x32, 0xc2, 0x07, 0x03, 0x65, 0x75, 0xa8, 0x8b, 0x6b, 0x39, 0xa2,
+	0x1f, 0x5a, 0x85, 0x55, 0xb0, 0x74, 0xfb, 0x5a, 0x0e, 0xfd, 0x18, 0x52, 0xcb, 0x32, 0x78, 0x01,
+	0xb2, 0x23, 0x94, 0x23, 0x75, 0xd0, 0xa1, 0x3e, 0x57, 0x33, 0xb2, 0x97, 0xa8, 0x43, 0xc4, 0x30,
+	0xf5, 0x15, 0xea, 0x07, 0xe9, 0x82, 0xd4, 0x15, 0x84, 0x39, 0xc4, 0xa2, 0x98, 0x9d, 0x23, 0x8b,
+	0xb8, 0x9e, 0xa3, 0xde, 0x97, 0x0d, 0xf7, 0xfe, 0xa8, 0xa6, 0x16, 0x28, 0xe0, 0xcf, 0x41, 0xf6,
+	0x6a, 0xba, 0x86, 0xd4, 0x2a, 0x94, 0x59, 0x5b, 0x1b, 0xcb, 0xda, 0xd0, 0x5b, 0x58, 0x02, 0xcb,
+	0xa6, 0xe7, 0x0a, 0xea, 0xf6, 0xbd, 0x3e, 0x47, 0x0e, 0x16, 0xe6, 0x11, 0x75, 0x7b, 0xea, 0xb2,
+	0x4c, 0x1d, 0x1c, 0xaa, 0xf6, 0x23, 0x0d, 0xfc, 0x31, 0x58, 0xed, 0x04, 0xbf, 0x11, 0xee, 0x9b,
+	0xc1, 0xab, 0x81, 0xa4, 0x43, 0x27, 0xd8, 0x56, 0x1f, 0xc8, 0xb0, 0x1e, 0x48, 0x6d, 0x39, 0x54,
+	0xd6, 0x23, 0x1d, 0x44, 0x60, 0x85, 0x13, 0xbb, 0x8b, 0x04, 0xc3, 0x16, 0x41, 0x3e, 0x23, 0x27,
+	0xc4, 0x95, 0xcf, 0xd0, 0x4a, 0x5e, 0xd9, 0x4a, 0xef, 0x7e, 0x39, 0xad, 0xb2, 0xda, 0xc4, 0xee,
+	0x1a, 0x81, 0x4d, 0x6b, 0x60, 0xa2, 0x2f, 0xf3, 0xeb, 0x42, 0x59, 0xe6, 0xf2, 0x9c, 0x89, 0x85,
+	0x30, 0xe7, 0xb2, 0x2f, 0xbb, 0x9e, 0xc3, 0xd5, 0x55, 0x19, 0xff, 0x72, 0xac, 0x2c, 0x07, 0x3a,
+	0x99, 0x37, 0x3e, 0x66, 0xe3, 0x33, 0x6a, 0x92, 0xd8, 0x66, 0x6d, 0xdc, 0xa6, 0x15, 0xe8, 0x22,
+	0x1b, 0x06, 0x0a, 0x93, 0xbb, 0x94, 0xc0, 0xc7, 0x84, 0xc5, 0xf7, 0x5c, 0xfd, 0xac, 0x7b, 0xbe,
+	0x31, 0xa1, 0x57, 0x19, 0x01, 0x5d, 0x74, 0xdb, 0x05, 0xd8, 0x9c, 0xd2, 0x19, 0x49, 0x27, 0x38,
+	0xed, 0x68, 0xd3, 0xf5, 0xcf, 0xda, 0x34, 0x37, 0xa9, 0x41, 0x4a, 0xbe, 0x68, 0xd7, 0x2a, 0xc8,
+	0x44, 0xfc, 0xd1, 0xf3, 0x4c, 0xb8, 0x9a, 0xcd, 0x27, 0x3e, 0xf9, 0x40, 0x2f, 0x85, 0x16, 0xe5,
+	0xd8, 0x00, 0x6e, 0x82, 0x14, 0x23, 0x5d, 0xc2, 0x18, 0xb6, 0xc3, 0xda, 0x7f, 0x28, 0x8b, 0x64,
+	0x31, 0x16, 0xca, 0x7a, 0xaf, 0x80, 0xc1, 0x1a, 0x99, 0xd8, 0x57, 0xbf, 0xb8, 0xdd, 0xed, 0x4b,
+	0xc6, 0x46, 0x55, 0xec, 0xc3, 0x27, 0x20, 0xed, 0xf7, 0x3b, 0x36, 0xe5, 0x47, 0xe1, 0x51, 0x72,
+	0xf5, 0x91, 0x2c, 0xe1, 0x54, 0x24, 0x95, 0x67, 0xc8, 0x61, 0x1b, 0xdc, 0x27, 0x67, 0x82, 0x30,
+	0x17, 0xdb, 0x88, 0x5a, 0x88, 0x9b, 0x9e, 0x4f, 0xd4, 0x0d, 0x59, 0x83, 0x3f, 0x9a, 0x96, 0x38,
+	0x2d, 0x32, 0xa8, 0xd7, 0xda, 0x01, 0x5c, 0x5f, 0x8a, 0x19, 0xea, 0x96, 0x14, 0xc0, 0x16, 0xc8,
+	0xc8, 0x1e, 0x1c, 0x1c, 0x04, 0x41, 0x36, 0x75, 0xa8, 0x50, 0x73, 0x72, 0x1a, 0xf8, 0xe1, 0x34,
+	0x4e, 0xd9, 0x9c, 0x75, 0x2c, 0x48, 0x23, 0x40, 0xeb, 0x69, 0x6f, 0x6c, 0x0d, 0x5f, 0x80, 0x45,
+	0x07, 0x9f, 0x21, 0x72, 0xe6, 0x7b, 0xbc, 0xcf, 0x88, 0x9a, 0xcf, 0x2b, 0x9f, 0xcc, 0x88, 0x9e,
+	0x74, 0xf0, 0x99, 0x16, 0xa1, 0x61, 0x03, 0xa4, 0xbb, 0xd4, 0xb6, 0x11, 0xb6, 0x7b, 0x1e, 0xa3,
+	0xe2, 0xc8, 0x51, 0x1f, 0xcb, 0x08, 0xa7, 0xce, 0x26, 0x7b, 0xd4, 0xb6, 0xcb, 0x31, 0x58, 0x4f,
+	0x75, 0x47, 0x97, 0xf0, 0x57, 0x60, 0x29, 0xc6, 0x22, 0xee, 0xdb, 0x54, 0x70, 0xb5, 0x20, 0x0f,
+	0xa8, 0x30, 0x8d, 0x4e, 0x5e, 0x95, 0x76, 0x00, 0x8d, 0x4e, 0x2a, 0x1d, 0x6b, 0xa5, 0x90, 0x17,
+	0x7e, 0x07, 0xe6, 0xe3, 0x6a, 0x84, 0x3f, 0x01, 0x77, 0xe4, 0x81, 0xa9, 0xca, 0x0d, 0x31, 0x46,
+	0x5c, 0x21, 0x1a, 0xee, 0x80, 0x44, 0x97, 0x10, 0x75, 0xf6, 0x76, 0x46, 0x01, 0xf6, 0xf9, 0x9c,
+	0x1c, 0x96, 0x0d, 0x90, 0x1e, 0x4f, 0x3e, 0x7c, 0x04, 0x40, 0x90, 0xec, 0xe8, 0x19, 0x0d, 0x07,
+	0xbd, 0x05, 0x07, 0x9f, 0x45, 0x0f, 0xe8, 0x26, 0x48, 0x9d, 0x52, 0xd7, 0xf2, 0x4e, 0x51, 0xc7,
+	0xf6, 0xcc, 0x63, 0x1e, 0x0d, 0xf7, 0x8b, 0xa1, 0xb0, 0x22, 0x65, 0x85, 0x7f, 0x28, 0x20, 0x39,
+	0xf2, 0x0a, 0xc2, 0x5d, 0x70, 0x2f, 0x1e, 0x6a, 0x95, 0x1b, 0x86, 0xda, 0x18, 0x08, 0x6b, 0x20,
+	0xe9, 0x13, 0xe6, 0x50, 0xce, 0xa9, 0xe7, 0x06, 0xdb, 0x24, 0xb6, 0xd2, 0xd3, 0x93, 0xdc, 0x1a,
+	0x40, 0xf5, 0x51, 0x33, 0x58, 0x03, 0x80, 0x9c, 0xf9, 0x54, 0xf6, 0x04, 0x37, 0x1a, 0x88, 0xb3,
+	0xc5, 0xf0, 0xfb, 0xa9, 0x18, 0x7f, 0x3f, 0x15, 0x8d, 0xf8, 0xfb, 0xa9, 0x32, 0xff, 0xf6, 0x7d,
+	0x4e, 0xf9, 0xf6, 0x3f, 0x39, 0x45, 0x1f, 0xb1, 0x2b, 0xfc, 0x7e, 0x16, 0xac, 0x45, 0x9f, 0x4a,
+	0x7d, 0x71, 0x14, 0x54, 0xc2, 0xb9, 0xc1, 0xb0, 0xcb, 0xbb, 0x84, 0x7d, 0x7a, 0x2e, 0xfe, 0x19,
+	0x48, 0xfa, 0xcc, 0xf3, 0x3d, 0x4e, 0x2c, 0xd4, 0x39, 0xbf, 0x71, 0xa2, 0x07, 0x31, 0xb8, 0x72,
+	0x0e, 0x7f, 0x01, 0x52, 0x2e, 0x39, 0x45, 0x38, 0xde, 0x50, 0x4d, 0xdc, 0x60, 0xbc, 0xe8, 0x92,
+	0xd3, 0x81, 0x7b, 0xd7, 0x87, 0x96, 0xb9, 0xef, 0x34, 0xb4, 0x6c, 0xff, 0x7d, 0x16, 0x80, 0x61,
+	0x92, 0xe1, 0x97, 0x60, 0xb5, 0xa5, 0xe9, 0xfb, 0xf5, 0x76, 0xbb, 0x7e, 0xd0, 0x44, 0x87, 0xcd,
+	0x76, 0x4b, 0xab, 0xd6, 0xf7, 0xea, 0x5a, 0x2d, 0x33, 0x93, 0x5d, 0xba, 0xb8, 0xcc, 0x27, 0xfb,
+	0x2e, 0xf7, 0x89, 0x49, 0xbb, 0x94, 0x58, 0xf0, 0x31, 0xb8, 0x3f, 0x02, 0x6e, 0x6b, 0x86, 0xd1,
+	0xd0, 0x32, 0x4a, 0x16, 0x5c, 0x5c, 0xe6, 0xef, 0x86, 0xfd, 0x1b, 0x6e, 0x02, 0x38, 0x0e, 0x41,
+	0xf5, 0x5a, 0x3b, 0x33, 0x9b, 0x4d, 0x5e, 0x5c, 0xe6, 0xef, 0x71, 0x99, 0x5c, 0x7e, 0x85, 0xa7,
+	0x5a, 0x6e, 0x56, 0xb5, 0x46, 0x26, 0x11, 0xf2, 0x98, 0x41, 0x44, 0x36, 0x7c, 0x02, 0x96, 0x47,
+	0x20, 0xaf, 0xea, 0xc6, 0xcb, 0x9a, 0x5e, 0x7e, 0x95, 0x99, 0xcb, 0x2e, 0x5e, 0x5c, 0xe6, 0xe7,
+	0x4f, 0xa9, 0x38, 0xb2, 0x18, 0x3e, 0xbd, 0xc2, 0x74, 0xd8, 0xaa, 0x95, 0x0d, 0x2d, 0x73, 0x27,
+	0x64, 0xea, 0xfb, 0x16, 0x16, 0xe4, 0x4a, 0x84, 0xc3, 0x9f, 0xed, 0xcc, 0xdd, 0x30, 0xc2, 0xd1,
+	0x32, 0x7b, 0x0a, 0x56, 0x46, 0xc0, 0x65, 0xc3, 0xd0, 0xeb, 0x95, 0x43, 0x43, 0x6b, 0x67, 0xee,
+	0x65, 0xd3, 0x17, 0x97, 0x79, 0x80, 0x85, 0x60, 0xb4, 0xd3, 0x17, 0x84, 0x6f, 0xff, 0x61, 0x16,
+	0x2c, 0x4f, 0x78, 0xc7, 0xe1, 0x4f, 0xc1, 0xe3, 0xb6, 0xd6, 0xd8, 0x43, 0x86, 0x5e, 0xae, 0x69,
+	0xa8, 0xa5, 0x6b, 0xbf, 0xd6, 0x9a, 0xc6, 0x2d, 0x92, 0xfb, 0x1c, 0x6c, 0x4e, 0xb6, 0x0b, 0xf3,
+	0x83, 0x9a, 0xda, 0x2b, 0xad, 0x6d, 0x64, 0x94, 0xec, 0xfd, 0x8b, 0xcb, 0x7c, 0x2a, 0x4c, 0x13,
+	0x72, 0xc9, 0x29, 0xe1, 0xe2, 0x46, 0xdb, 0x83, 0x46, 0x2d, 0xb0, 0x9d, 0x1d, 0xb3, 0xf5, 0x6c,
+	0x2b, 0xb0, 0x7d, 0x01, 0x7e, 0x30, 0xd9, 0xb6, 0xa6, 0x55, 0x75, 0x6d, 0x5f, 0x6b, 0x1a, 0xa8,
+	0x72, 0x60, 0xbc, 0xcc, 0x24, 0xb2, 0xf0, 0xe2, 0x32, 0x9f, 0xb6, 0x88, 0xc9, 0xa2, 0xa1, 0xcf,
+	0x13, 0x47, 0xdb, 0xaf, 0xc1, 0xd2, 0x95, 0x87, 0x04, 0xee, 0x82, 0x47, 0xda, 0x6f, 0x0c, 0x4d,
+	0x6f, 0x96, 0x1b, 0xa8, 0x5e, 0x43, 0xed, 0xea, 0x41, 0x4b, 0xbb, 0x29, 0xf8, 0x6d, 0xb0, 0x7e,
+	0xdd, 0xa6, 0x5c, 0xad, 0x1e, 0x1c, 0x36, 0x83, 0x90, 0x65, 0xf5, 0x44, 0xff, 0x66, 0x6c, 0xff,
+	0x45, 0x01, 0xa9, 0xb1, 0xd6, 0x0e, 0x4b, 0x20, 0xbb, 0x57, 0x6f, 0x34, 0x50, 0xb9, 0xf1, 0xf5,
+	0x81, 0x5e, 0x37, 0x5e, 0xee, 0xdf, 0xb4, 0xdd, 0x33, 0xb0, 0x7e, 0xc5, 0xa0, 0xa5, 0xd7, 0xab,
+	0x1a, 0x32, 0xea, 0xfb, 0x41, 0x41, 0xcb, 0xa3, 0x0e, 0x47, 0x27, 0x41, 0x1d, 0x02, 0x9f, 0x82,
+	0xb5, 0x6b, 0xf0, 0x03, 0xa4, 0x97, 0x8d, 0x72, 0x66, 0x36, 0x2c, 0x48, 0x9f, 0x79, 0xc1, 0x33,
+	0x89, 0x2b, 0xe4, 0xed, 0x87, 0x0d, 0xe5, 0xdd, 0x87, 0x0d, 0xe5, 0xbf, 0x1f, 0x36, 0x94, 0x6f,
+	0x3f, 0x6e, 0xcc, 0xbc, 0xfb, 0xb8, 0x31, 0xf3, 0xef, 0x8f, 0x1b, 0x33, 0x60, 0x9d, 0x7a, 0x53,
+	0xee, 0x6c, 0x4b, 0xf9, 0xa6, 0xd8, 0xa3, 0xe2, 0xa8, 0xdf, 0x29, 0x9a, 0x9e, 0x53, 0x1a, 0x82,
+	0x9e, 0x51, 0x6f, 0x64, 0x55, 0x3a, 0x1b, 0xfc, 0x07, 0xd4, 0xb9, 0x2b, 0x5b, 0xde, 0x57, 0xff,
+	0x1f, 0x00, 0x0c, 0x84, 0x67, 0xd9, 0xf0, 0x12, 0x00, 0x00,
 }
 
 func (this *MarketDetails) Equal(that interface{}) bool {
@@ -896,37 +1380,80 @@ func (m *Market) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.ReqAttrCreateCommitment) > 0 {
-		for iNdEx := len(m.ReqAttrCreateCommitment) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ReqAttrCreateCommitment[iNdEx])
-			copy(dAtA[i:], m.ReqAttrCreateCommitment[iNdEx])
-			i = encodeVarintMarket(dAtA, i, uint64(len(m.ReqAttrCreateCommitment[iNdEx])))
+	if len(m.ExchangeSplits) > 0 {
+		for iNdEx := len(m.ExchangeSplits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeSplits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x2
 			i--
 			dAtA[i] = 0x92
 		}
 	}
-	if len(m.IntermediaryDenom) > 0 {
-		i -= len(m.IntermediaryDenom)
-		copy(dAtA[i:], m.IntermediaryDenom)
-		i = encodeVarintMarket(dAtA, i, uint64(len(m.IntermediaryDenom)))
+	if m.FillAlgorithm != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.FillAlgorithm))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.MaxExposure != nil {
+		{
+			size, err := m.MaxExposure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMarket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.OrderRateLimit != nil {
+		{
+			size, err := m.OrderRateLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMarket(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x8a
+		dAtA[i] = 0xfa
 	}
-	if m.CommitmentSettlementBips != 0 {
-		i = encodeVarintMarket(dAtA, i, uint64(m.CommitmentSettlementBips))
+	if m.ExternalIdScope != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.ExternalIdScope))
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x80
+		dAtA[i] = 0xf0
 	}
-	if len(m.FeeCreateCommitmentFlat) > 0 {
-		for iNdEx := len(m.FeeCreateCommitmentFlat) - 1; iNdEx >= 0; iNdEx-- {
+	if m.PublishPrices {
+		i--
+		if m.PublishPrices {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
+	}
+	if len(m.ReferralCap) > 0 {
+		for iNdEx := len(m.ReferralCap) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.FeeCreateCommitmentFlat[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ReferralCap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -934,7 +1461,148 @@ func (m *Market) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintMarket(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x7a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if m.ReferralBips != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.ReferralBips))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if len(m.RebateAddresses) > 0 {
+		for iNdEx := len(m.RebateAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RebateAddresses[iNdEx])
+			copy(dAtA[i:], m.RebateAddresses[iNdEx])
+			i = encodeVarintMarket(dAtA, i, uint64(len(m.RebateAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.FeeSellerSettlementRebateRatios) > 0 {
+		for iNdEx := len(m.FeeSellerSettlementRebateRatios) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeSellerSettlementRebateRatios[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.FeeSellerSettlementTakerRatios) > 0 {
+		for iNdEx := len(m.FeeSellerSettlementTakerRatios) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeSellerSettlementTakerRatios[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.AcceptedPriceDenoms) > 0 {
+		for iNdEx := len(m.AcceptedPriceDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AcceptedPriceDenoms[iNdEx])
+			copy(dAtA[i:], m.AcceptedPriceDenoms[iNdEx])
+			i = encodeVarintMarket(dAtA, i, uint64(len(m.AcceptedPriceDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.AcceptedAssetDenoms) > 0 {
+		for iNdEx := len(m.AcceptedAssetDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AcceptedAssetDenoms[iNdEx])
+			copy(dAtA[i:], m.AcceptedAssetDenoms[iNdEx])
+			i = encodeVarintMarket(dAtA, i, uint64(len(m.AcceptedAssetDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.SelfTradePrevention))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.BatchAuctionInterval != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.BatchAuctionInterval))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.ContinuousMatching {
+		i--
+		if m.ContinuousMatching {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.ReqAttrCreateCommitment) > 0 {
+		for iNdEx := len(m.ReqAttrCreateCommitment) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReqAttrCreateCommitment[iNdEx])
+			copy(dAtA[i:], m.ReqAttrCreateCommitment[iNdEx])
+			i = encodeVarintMarket(dAtA, i, uint64(len(m.ReqAttrCreateCommitment[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.IntermediaryDenom) > 0 {
+		i -= len(m.IntermediaryDenom)
+		copy(dAtA[i:], m.IntermediaryDenom)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.IntermediaryDenom)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.CommitmentSettlementBips != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.CommitmentSettlementBips))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.FeeCreateCommitmentFlat) > 0 {
+		for iNdEx := len(m.FeeCreateCommitmentFlat) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeCreateCommitmentFlat[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
 		}
 	}
 	if m.AcceptingCommitments {
@@ -1144,6 +1812,39 @@ func (m *FeeRatio) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OrderRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowBlocks != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxOrders != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.MaxOrders))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AccessGrant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1164,21 +1865,31 @@ func (m *AccessGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Expiration != nil {
+		n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintMarket(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Permissions) > 0 {
-		dAtA8 := make([]byte, len(m.Permissions)*10)
-		var j7 int
+		dAtA11 := make([]byte, len(m.Permissions)*10)
+		var j10 int
 		for _, num := range m.Permissions {
 			for num >= 1<<7 {
-				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j7++
+				j10++
 			}
-			dAtA8[j7] = uint8(num)
-			j7++
+			dAtA11[j10] = uint8(num)
+			j10++
 		}
-		i -= j7
-		copy(dAtA[i:], dAtA8[:j7])
-		i = encodeVarintMarket(dAtA, i, uint64(j7))
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintMarket(dAtA, i, uint64(j10))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1192,6 +1903,62 @@ func (m *AccessGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MarketAuthorityTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketAuthorityTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketAuthorityTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AccessGrants) > 0 {
+		for iNdEx := len(m.AccessGrants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccessGrants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.NewAuthority) > 0 {
+		i -= len(m.NewAuthority)
+		copy(dAtA[i:], m.NewAuthority)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.NewAuthority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ProposedBy) > 0 {
+		i -= len(m.ProposedBy)
+		copy(dAtA[i:], m.ProposedBy)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.ProposedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMarket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMarket(v)
 	base := offset
@@ -1357,6 +2124,77 @@ func (m *Market) Size() (n int) {
 			n += 2 + l + sovMarket(uint64(l))
 		}
 	}
+	if m.ContinuousMatching {
+		n += 3
+	}
+	if m.BatchAuctionInterval != 0 {
+		n += 2 + sovMarket(uint64(m.BatchAuctionInterval))
+	}
+	if m.SelfTradePrevention != 0 {
+		n += 2 + sovMarket(uint64(m.SelfTradePrevention))
+	}
+	if len(m.AcceptedAssetDenoms) > 0 {
+		for _, s := range m.AcceptedAssetDenoms {
+			l = len(s)
+			n += 2 + l + sovMarket(uint64(l))
+		}
+	}
+	if len(m.AcceptedPriceDenoms) > 0 {
+		for _, s := range m.AcceptedPriceDenoms {
+			l = len(s)
+			n += 2 + l + sovMarket(uint64(l))
+		}
+	}
+	if len(m.FeeSellerSettlementTakerRatios) > 0 {
+		for _, e := range m.FeeSellerSettlementTakerRatios {
+			l = e.Size()
+			n += 2 + l + sovMarket(uint64(l))
+		}
+	}
+	if len(m.FeeSellerSettlementRebateRatios) > 0 {
+		for _, e := range m.FeeSellerSettlementRebateRatios {
+			l = e.Size()
+			n += 2 + l + sovMarket(uint64(l))
+		}
+	}
+	if len(m.RebateAddresses) > 0 {
+		for _, s := range m.RebateAddresses {
+			l = len(s)
+			n += 2 + l + sovMarket(uint64(l))
+		}
+	}
+	if m.ReferralBips != 0 {
+		n += 2 + sovMarket(uint64(m.ReferralBips))
+	}
+	if len(m.ReferralCap) > 0 {
+		for _, e := range m.ReferralCap {
+			l = e.Size()
+			n += 2 + l + sovMarket(uint64(l))
+		}
+	}
+	if m.PublishPrices {
+		n += 3
+	}
+	if m.ExternalIdScope != 0 {
+		n += 2 + sovMarket(uint64(m.ExternalIdScope))
+	}
+	if m.OrderRateLimit != nil {
+		l = m.OrderRateLimit.Size()
+		n += 2 + l + sovMarket(uint64(l))
+	}
+	if m.MaxExposure != nil {
+		l = m.MaxExposure.Size()
+		n += 2 + l + sovMarket(uint64(l))
+	}
+	if m.FillAlgorithm != 0 {
+		n += 2 + sovMarket(uint64(m.FillAlgorithm))
+	}
+	if len(m.ExchangeSplits) > 0 {
+		for _, e := range m.ExchangeSplits {
+			l = e.Size()
+			n += 2 + l + sovMarket(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1373,6 +2211,21 @@ func (m *FeeRatio) Size() (n int) {
 	return n
 }
 
+func (m *OrderRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxOrders != 0 {
+		n += 1 + sovMarket(uint64(m.MaxOrders))
+	}
+	if m.WindowBlocks != 0 {
+		n += 1 + sovMarket(uint64(m.WindowBlocks))
+	}
+	return n
+}
+
 func (m *AccessGrant) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1390,6 +2243,36 @@ func (m *AccessGrant) Size() (n int) {
 		}
 		n += 1 + sovMarket(uint64(l)) + l
 	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	return n
+}
+
+func (m *MarketAuthorityTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovMarket(uint64(m.MarketId))
+	}
+	l = len(m.ProposedBy)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.NewAuthority)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	if len(m.AccessGrants) > 0 {
+		for _, e := range m.AccessGrants {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2409,10 +3292,565 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			}
 			m.ReqAttrCreateCommitment = append(m.ReqAttrCreateCommitment, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMarket(dAtA[iNdEx:])
-			if err != nil {
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinuousMatching", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ContinuousMatching = bool(v != 0)
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchAuctionInterval", wireType)
+			}
+			m.BatchAuctionInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchAuctionInterval |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
+			}
+			m.SelfTradePrevention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfTradePrevention |= SelfTradePrevention(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedAssetDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcceptedAssetDenoms = append(m.AcceptedAssetDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedPriceDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcceptedPriceDenoms = append(m.AcceptedPriceDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSellerSettlementTakerRatios", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSellerSettlementTakerRatios = append(m.FeeSellerSettlementTakerRatios, FeeRatio{})
+			if err := m.FeeSellerSettlementTakerRatios[len(m.FeeSellerSettlementTakerRatios)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSellerSettlementRebateRatios", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSellerSettlementRebateRatios = append(m.FeeSellerSettlementRebateRatios, FeeRatio{})
+			if err := m.FeeSellerSettlementRebateRatios[len(m.FeeSellerSettlementRebateRatios)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebateAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RebateAddresses = append(m.RebateAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferralBips", wireType)
+			}
+			m.ReferralBips = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReferralBips |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferralCap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferralCap = append(m.ReferralCap, types1.Coin{})
+			if err := m.ReferralCap[len(m.ReferralCap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublishPrices", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PublishPrices = bool(v != 0)
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalIdScope", wireType)
+			}
+			m.ExternalIdScope = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExternalIdScope |= ExternalIDScope(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderRateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OrderRateLimit == nil {
+				m.OrderRateLimit = &OrderRateLimit{}
+			}
+			if err := m.OrderRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExposure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxExposure == nil {
+				m.MaxExposure = &types1.Coin{}
+			}
+			if err := m.MaxExposure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FillAlgorithm", wireType)
+			}
+			m.FillAlgorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FillAlgorithm |= FillAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeSplits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeSplits = append(m.ExchangeSplits, DenomSplit{})
+			if err := m.ExchangeSplits[len(m.ExchangeSplits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeRatio) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeRatio: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeRatio: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
@@ -2430,7 +3868,7 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FeeRatio) Unmarshal(dAtA []byte) error {
+func (m *OrderRateLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2453,17 +3891,17 @@ func (m *FeeRatio) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FeeRatio: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrderRateLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeeRatio: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrderRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOrders", wireType)
 			}
-			var msglen int
+			m.MaxOrders = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarket
@@ -2473,30 +3911,16 @@ func (m *FeeRatio) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxOrders |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMarket
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMarket
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
 			}
-			var msglen int
+			m.WindowBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarket
@@ -2506,25 +3930,11 @@ func (m *FeeRatio) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.WindowBlocks |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMarket
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMarket
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMarket(dAtA[iNdEx:])
@@ -2676,6 +4086,209 @@ func (m *AccessGrant) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketAuthorityTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketAuthorityTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketAuthorityTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAuthority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAuthority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessGrants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessGrants = append(m.AccessGrants, AccessGrant{})
+			if err := m.AccessGrants[len(m.AccessGrants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMarket(dAtA[iNdEx:])
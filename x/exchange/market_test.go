@@ -8,6 +8,7 @@ import (
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -136,6 +137,62 @@ func TestMarket_Validate(t *testing.T) {
 				`denom "leela" is defined in the buyer settlement fee ratios but not seller`,
 			},
 		},
+		{
+			name: "with taker ratios",
+			market: Market{
+				FeeSellerSettlementRatios:      []FeeRatio{{Price: coin(100, "fry"), Fee: coin(1, "fry")}},
+				FeeBuyerSettlementRatios:       []FeeRatio{{Price: coin(100, "fry"), Fee: coin(1, "fry")}},
+				FeeSellerSettlementTakerRatios: []FeeRatio{{Price: coin(100, "fry"), Fee: coin(3, "fry")}},
+			},
+			expErr: nil,
+		},
+		{
+			name: "invalid taker ratios",
+			market: Market{
+				FeeSellerSettlementRatios:      []FeeRatio{{Price: coin(100, "fry"), Fee: coin(1, "fry")}},
+				FeeBuyerSettlementRatios:       []FeeRatio{{Price: coin(100, "fry"), Fee: coin(1, "fry")}},
+				FeeSellerSettlementTakerRatios: []FeeRatio{{Price: coin(100, "leela"), Fee: coin(3, "leela")}},
+			},
+			expErr: []string{`seller settlement taker fee ratios have price denom "leela" but there is not a seller settlement fee ratio with that price denom`},
+		},
+		{
+			name: "with rebates",
+			market: Market{
+				FeeSellerSettlementRebateRatios: []FeeRatio{{Price: coin(100, "fry"), Fee: coin(1, "fry")}},
+				RebateAddresses:                 []string{sdk.AccAddress("rebate_addr_________").String()},
+			},
+			expErr: nil,
+		},
+		{
+			name: "invalid rebates",
+			market: Market{
+				FeeSellerSettlementRebateRatios: []FeeRatio{{Price: coin(100, "fry"), Fee: coin(1, "leela")}},
+				RebateAddresses:                 []string{"bad_addr"},
+			},
+			expErr: []string{
+				`invalid rebate ratios: seller fee ratio price denom "fry" does not equal fee denom "leela"`,
+				`invalid rebate address "bad_addr": decoding bech32 failed: invalid separator index -1`,
+			},
+		},
+		{
+			name: "with referral bips and cap",
+			market: Market{
+				ReferralBips: 2_500,
+				ReferralCap:  []sdk.Coin{coin(100, "fry")},
+			},
+			expErr: nil,
+		},
+		{
+			name: "invalid referral bips and cap",
+			market: Market{
+				ReferralBips: 10_001,
+				ReferralCap:  []sdk.Coin{coin(0, "fry")},
+			},
+			expErr: []string{
+				"invalid referral bips 10001: exceeds max of 10000",
+				`invalid referral cap option "0fry": amount cannot be zero`,
+			},
+		},
 		{
 			name:   "invalid access grants",
 			market: Market{AccessGrants: []AccessGrant{{Address: "bad_addr", Permissions: AllPermissions()}}},
@@ -171,6 +228,92 @@ func TestMarket_Validate(t *testing.T) {
 			market: Market{ReqAttrCreateCommitment: []string{"this-attr-waaaaaah"}},
 			expErr: []string{`invalid create-commitment required attribute "this-attr-waaaaaah"`},
 		},
+		{
+			name:   "batch auctions without continuous matching",
+			market: Market{BatchAuctionInterval: 10},
+			expErr: nil,
+		},
+		{
+			name:   "batch auctions with continuous matching",
+			market: Market{ContinuousMatching: true, BatchAuctionInterval: 10},
+			expErr: []string{"cannot have continuous matching with batch auction interval 10"},
+		},
+		{
+			name:   "with self-trade prevention",
+			market: Market{SelfTradePrevention: SelfTradePrevention_cancel_oldest},
+			expErr: nil,
+		},
+		{
+			name:   "unknown self-trade prevention",
+			market: Market{SelfTradePrevention: 12},
+			expErr: []string{"self-trade prevention 12 does not exist"},
+		},
+		{
+			name:   "with account external id scope",
+			market: Market{ExternalIdScope: ExternalIDScope_account},
+			expErr: nil,
+		},
+		{
+			name:   "unknown external id scope",
+			market: Market{ExternalIdScope: 3},
+			expErr: []string{"external id scope 3 does not exist"},
+		},
+		{
+			name:   "with order rate limit",
+			market: Market{OrderRateLimit: &OrderRateLimit{MaxOrders: 20, WindowBlocks: 10}},
+			expErr: nil,
+		},
+		{
+			name:   "invalid order rate limit",
+			market: Market{OrderRateLimit: &OrderRateLimit{MaxOrders: 20}},
+			expErr: []string{"invalid order rate limit window blocks: cannot be zero"},
+		},
+		{
+			name:   "with max exposure",
+			market: Market{MaxExposure: &sdk.Coin{Denom: "nhash", Amount: sdkmath.NewInt(5_000)}},
+			expErr: nil,
+		},
+		{
+			name:   "invalid max exposure",
+			market: Market{MaxExposure: &sdk.Coin{Denom: "nhash", Amount: sdkmath.ZeroInt()}},
+			expErr: []string{"invalid max exposure \"0nhash\": amount must be positive"},
+		},
+		{
+			name:   "with exchange splits",
+			market: Market{ExchangeSplits: []DenomSplit{{Denom: "apple", Split: 250}, {Denom: "nhash", Split: 0}}},
+			expErr: nil,
+		},
+		{
+			name:   "invalid exchange splits",
+			market: Market{ExchangeSplits: []DenomSplit{{Denom: "apple", Split: 10_001}, {Denom: "nhash", Split: 1}, {Denom: "nhash", Split: 2}}},
+			expErr: []string{
+				"invalid exchange split: apple split 10001 cannot be greater than 10000",
+				`duplicate exchange split denom "nhash"`,
+			},
+		},
+		{
+			name:   "with pro-rata fill algorithm",
+			market: Market{FillAlgorithm: FillAlgorithm_pro_rata},
+			expErr: nil,
+		},
+		{
+			name:   "unknown fill algorithm",
+			market: Market{FillAlgorithm: 3},
+			expErr: []string{"fill algorithm 3 does not exist"},
+		},
+		{
+			name:   "with accepted denoms",
+			market: Market{AcceptedAssetDenoms: []string{"apple", "banana"}, AcceptedPriceDenoms: []string{"nhash"}},
+			expErr: nil,
+		},
+		{
+			name:   "invalid accepted denoms",
+			market: Market{AcceptedAssetDenoms: []string{"apple", "apple"}, AcceptedPriceDenoms: []string{"x"}},
+			expErr: []string{
+				`duplicate accepted asset denom "apple"`,
+				"invalid accepted price denom: invalid denom: x",
+			},
+		},
 		{
 			name: "multiple errors",
 			market: Market{
@@ -188,6 +331,9 @@ func TestMarket_Validate(t *testing.T) {
 				CommitmentSettlementBips:  10_001,
 				IntermediaryDenom:         "123bad",
 				ReqAttrCreateCommitment:   []string{"this-attr-waaaaaah"},
+				ContinuousMatching:        true,
+				BatchAuctionInterval:      3,
+				SelfTradePrevention:       -1,
 			},
 			expErr: []string{
 				fmt.Sprintf("name length %d exceeds maximum length of %d", MaxName+1, MaxName),
@@ -203,6 +349,8 @@ func TestMarket_Validate(t *testing.T) {
 				`invalid create-commitment flat fee option "-1leela": negative coin amount: -1`,
 				"invalid commitment settlement bips 10001: exceeds max of 10000",
 				`invalid create-commitment required attribute "this-attr-waaaaaah"`,
+				"cannot have continuous matching with batch auction interval 3",
+				"self-trade prevention -1 does not exist",
 			},
 		},
 	}
@@ -664,6 +812,264 @@ func TestValidateSellerFeeRatios(t *testing.T) {
 	}
 }
 
+func TestValidateTakerFeeRatios(t *testing.T) {
+	coin := func(amount int64, denom string) sdk.Coin {
+		return sdk.Coin{Denom: denom, Amount: sdkmath.NewInt(amount)}
+	}
+
+	tests := []struct {
+		name   string
+		seller []FeeRatio
+		taker  []FeeRatio
+		exp    string
+	}{
+		{
+			name:   "nil taker ratios",
+			seller: []FeeRatio{{Price: coin(3, "mom"), Fee: coin(1, "mom")}},
+			taker:  nil,
+			exp:    "",
+		},
+		{
+			name:   "no seller ratios",
+			seller: nil,
+			taker:  []FeeRatio{{Price: coin(3, "mom"), Fee: coin(2, "mom")}},
+			exp:    "",
+		},
+		{
+			name:   "same denoms as seller",
+			seller: []FeeRatio{{Price: coin(3, "mom"), Fee: coin(1, "mom")}, {Price: coin(5, "fry"), Fee: coin(1, "fry")}},
+			taker:  []FeeRatio{{Price: coin(3, "mom"), Fee: coin(2, "mom")}},
+			exp:    "",
+		},
+		{
+			name:   "invalid taker ratio",
+			seller: nil,
+			taker:  []FeeRatio{{Price: coin(3, "hermes"), Fee: coin(2, "mom")}},
+			exp:    `invalid taker ratios: seller fee ratio price denom "hermes" does not equal fee denom "mom"`,
+		},
+		{
+			name:   "denom not in seller ratios",
+			seller: []FeeRatio{{Price: coin(3, "mom"), Fee: coin(1, "mom")}},
+			taker:  []FeeRatio{{Price: coin(3, "mom"), Fee: coin(2, "mom")}, {Price: coin(5, "fry"), Fee: coin(2, "fry")}},
+			exp: `seller settlement taker fee ratios have price denom "fry" ` +
+				"but there is not a seller settlement fee ratio with that price denom",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = ValidateTakerFeeRatios(tc.seller, tc.taker)
+			}
+			require.NotPanics(t, testFunc, "ValidateTakerFeeRatios")
+
+			assertions.AssertErrorValue(t, err, tc.exp, "ValidateTakerFeeRatios")
+		})
+	}
+}
+
+func TestValidateRebateRatios(t *testing.T) {
+	coin := func(amount int64, denom string) sdk.Coin {
+		return sdk.Coin{Denom: denom, Amount: sdkmath.NewInt(amount)}
+	}
+
+	tests := []struct {
+		name   string
+		ratios []FeeRatio
+		exp    string
+	}{
+		{
+			name:   "nil ratios",
+			ratios: nil,
+			exp:    "",
+		},
+		{
+			name:   "two good ratios",
+			ratios: []FeeRatio{{Price: coin(100, "mom"), Fee: coin(1, "mom")}, {Price: coin(5, "fry"), Fee: coin(0, "fry")}},
+			exp:    "",
+		},
+		{
+			name:   "different denoms",
+			ratios: []FeeRatio{{Price: coin(3, "hermes"), Fee: coin(2, "mom")}},
+			exp:    `invalid rebate ratios: seller fee ratio price denom "hermes" does not equal fee denom "mom"`,
+		},
+		{
+			name:   "duplicate denom",
+			ratios: []FeeRatio{{Price: coin(3, "mom"), Fee: coin(1, "mom")}, {Price: coin(5, "mom"), Fee: coin(2, "mom")}},
+			exp:    `invalid rebate ratios: seller fee ratio denom "mom" appears in multiple ratios`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = ValidateRebateRatios(tc.ratios)
+			}
+			require.NotPanics(t, testFunc, "ValidateRebateRatios")
+			assertions.AssertErrorValue(t, err, tc.exp, "ValidateRebateRatios")
+		})
+	}
+}
+
+func TestValidateRebateAddresses(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________").String()
+	addr2 := sdk.AccAddress("addr2_______________").String()
+
+	tests := []struct {
+		name   string
+		addrs  []string
+		expErr string
+	}{
+		{
+			name:   "nil addresses",
+			addrs:  nil,
+			expErr: "",
+		},
+		{
+			name:   "two good addresses",
+			addrs:  []string{addr1, addr2},
+			expErr: "",
+		},
+		{
+			name:   "empty address",
+			addrs:  []string{addr1, ""},
+			expErr: "invalid rebate address \"\": empty address string is not allowed",
+		},
+		{
+			name:   "duplicate address",
+			addrs:  []string{addr1, addr2, addr1},
+			expErr: "duplicate rebate address \"" + addr1 + "\"",
+		},
+		{
+			name:  "multiple errors",
+			addrs: []string{"bad", addr2, addr2},
+			expErr: "invalid rebate address \"bad\": decoding bech32 failed: invalid bech32 string length 3\n" +
+				"duplicate rebate address \"" + addr2 + "\"",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = ValidateRebateAddresses(tc.addrs)
+			}
+			require.NotPanics(t, testFunc, "ValidateRebateAddresses(%q)", tc.addrs)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ValidateRebateAddresses(%q) result", tc.addrs)
+		})
+	}
+}
+
+func TestValidateAddRemoveRebateAddresses(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________").String()
+	addr2 := sdk.AccAddress("addr2_______________").String()
+	addr3 := sdk.AccAddress("addr3_______________").String()
+
+	tests := []struct {
+		name     string
+		toAdd    []string
+		toRemove []string
+		expErr   string
+	}{
+		{
+			name:   "nothing",
+			expErr: "",
+		},
+		{
+			name:     "add and remove different addresses",
+			toAdd:    []string{addr1, addr2},
+			toRemove: []string{addr3},
+			expErr:   "",
+		},
+		{
+			name:     "invalid address to remove",
+			toRemove: []string{"bad"},
+			expErr:   "",
+		},
+		{
+			name:   "invalid address to add",
+			toAdd:  []string{"bad"},
+			expErr: "invalid rebate address \"bad\": decoding bech32 failed: invalid bech32 string length 3",
+		},
+		{
+			name:     "same address in both",
+			toAdd:    []string{addr1, addr2},
+			toRemove: []string{addr3, addr2},
+			expErr:   "cannot add and remove the same rebate address \"" + addr2 + "\"",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = ValidateAddRemoveRebateAddresses(tc.toAdd, tc.toRemove)
+			}
+			require.NotPanics(t, testFunc, "ValidateAddRemoveRebateAddresses(%q, %q)", tc.toAdd, tc.toRemove)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ValidateAddRemoveRebateAddresses(%q, %q) result",
+				tc.toAdd, tc.toRemove)
+		})
+	}
+}
+
+func TestValidateAddRemoveRebateAddressesWithExisting(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________").String()
+	addr2 := sdk.AccAddress("addr2_______________").String()
+	addr3 := sdk.AccAddress("addr3_______________").String()
+
+	tests := []struct {
+		name     string
+		existing []string
+		toAdd    []string
+		toRemove []string
+		expErr   string
+	}{
+		{
+			name:   "nothing",
+			expErr: "",
+		},
+		{
+			name:     "add new, remove existing",
+			existing: []string{addr1, addr2},
+			toAdd:    []string{addr3},
+			toRemove: []string{addr1},
+			expErr:   "",
+		},
+		{
+			name:     "add existing that is also being removed",
+			existing: []string{addr1},
+			toAdd:    []string{addr1},
+			toRemove: []string{addr1},
+			expErr:   "",
+		},
+		{
+			name:     "remove unknown",
+			existing: []string{addr1},
+			toRemove: []string{addr2},
+			expErr:   "cannot remove rebate address \"" + addr2 + "\": address not currently present",
+		},
+		{
+			name:     "add existing",
+			existing: []string{addr1, addr2},
+			toAdd:    []string{addr3, addr2},
+			expErr:   "cannot add rebate address \"" + addr2 + "\": address already present",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var errs []error
+			testFunc := func() {
+				errs = ValidateAddRemoveRebateAddressesWithExisting(tc.existing, tc.toAdd, tc.toRemove)
+			}
+			require.NotPanics(t, testFunc, "ValidateAddRemoveRebateAddressesWithExisting")
+			assertions.AssertErrorValue(t, errors.Join(errs...), tc.expErr, "ValidateAddRemoveRebateAddressesWithExisting result")
+		})
+	}
+}
+
 func TestValidateBuyerFeeRatios(t *testing.T) {
 	coin := func(amount int64, denom string) sdk.Coin {
 		return sdk.Coin{Denom: denom, Amount: sdkmath.NewInt(amount)}
@@ -1430,22 +1836,95 @@ func TestFeeRatio_ApplyToLoosely(t *testing.T) {
 	}
 }
 
-func TestIntersectionOfFeeRatios(t *testing.T) {
+func TestFeeRatio_ApplyToRoundingDown(t *testing.T) {
+	coin := func(amount int64, denom string) sdk.Coin {
+		return sdk.Coin{Denom: denom, Amount: sdkmath.NewInt(amount)}
+	}
 	feeRatio := func(priceAmount int64, priceDenom string, feeAmount int64, feeDenom string) FeeRatio {
 		return FeeRatio{
-			Price: sdk.Coin{Denom: priceDenom, Amount: sdkmath.NewInt(priceAmount)},
-			Fee:   sdk.Coin{Denom: feeDenom, Amount: sdkmath.NewInt(feeAmount)},
+			Price: coin(priceAmount, priceDenom),
+			Fee:   coin(feeAmount, feeDenom),
 		}
 	}
 
 	tests := []struct {
-		name     string
-		options1 []FeeRatio
-		options2 []FeeRatio
-		expected []FeeRatio
-	}{
-		{name: "nil nil", options1: nil, options2: nil, expected: nil},
-		{name: "nil empty", options1: nil, options2: []FeeRatio{}, expected: nil},
+		name   string
+		ratio  FeeRatio
+		price  sdk.Coin
+		exp    sdk.Coin
+		expErr string
+	}{
+		{
+			name:   "wrong denom",
+			ratio:  feeRatio(1, "pdenom", 1, "fdenom"),
+			price:  coin(1, "fdenom"),
+			expErr: "cannot apply ratio 1pdenom:1fdenom to price 1fdenom: incorrect price denom",
+		},
+		{
+			name:   "ratio price amount is zero",
+			ratio:  feeRatio(0, "pdenom", 1, "fdenom"),
+			price:  coin(1, "pdenom"),
+			expErr: "cannot apply ratio 0pdenom:1fdenom to price 1pdenom: division by zero",
+		},
+		{
+			name:  "price amount is less than ratio price amount",
+			ratio: feeRatio(14, "pdenom", 3, "fdenom"),
+			price: coin(7, "pdenom"),
+			exp:   coin(1, "fdenom"), // 7 * 3 / 14 = 1.5 => 1
+		},
+		{
+			name:  "result is less than one",
+			ratio: feeRatio(100, "pdenom", 1, "fdenom"),
+			price: coin(99, "pdenom"),
+			exp:   coin(0, "fdenom"), // 99 * 1 / 100 = 0.99 => 0
+		},
+		{
+			name:  "price amount is not evenly divisible by ratio",
+			ratio: feeRatio(7, "pdenom", 3, "fdenom"),
+			price: coin(71, "pdenom"),
+			exp:   coin(30, "fdenom"), // 71 * 3 / 7 = 30.4 => 30
+		},
+		{
+			name:  "three times ratio price",
+			ratio: feeRatio(13, "pdenom", 17, "fdenom"),
+			price: coin(39, "pdenom"),
+			exp:   coin(51, "fdenom"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if len(tc.expErr) > 0 {
+				tc.exp = coin(0, "")
+			}
+			var actual sdk.Coin
+			var err error
+			testFunc := func() {
+				actual, err = tc.ratio.ApplyToRoundingDown(tc.price)
+			}
+			require.NotPanics(t, testFunc, "%s.ApplyToRoundingDown(%s)", tc.ratio, tc.price)
+			assertions.AssertErrorValue(t, err, tc.expErr, "%s.ApplyToRoundingDown(%s) error", tc.ratio, tc.price)
+			assert.Equal(t, tc.exp.String(), actual.String(), "%s.ApplyToRoundingDown(%s) result", tc.ratio, tc.price)
+		})
+	}
+}
+
+func TestIntersectionOfFeeRatios(t *testing.T) {
+	feeRatio := func(priceAmount int64, priceDenom string, feeAmount int64, feeDenom string) FeeRatio {
+		return FeeRatio{
+			Price: sdk.Coin{Denom: priceDenom, Amount: sdkmath.NewInt(priceAmount)},
+			Fee:   sdk.Coin{Denom: feeDenom, Amount: sdkmath.NewInt(feeAmount)},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		options1 []FeeRatio
+		options2 []FeeRatio
+		expected []FeeRatio
+	}{
+		{name: "nil nil", options1: nil, options2: nil, expected: nil},
+		{name: "nil empty", options1: nil, options2: []FeeRatio{}, expected: nil},
 		{name: "empty nil", options1: []FeeRatio{}, options2: nil, expected: nil},
 		{name: "empty empty", options1: []FeeRatio{}, options2: []FeeRatio{}, expected: nil},
 		{
@@ -2222,6 +2701,59 @@ func TestValidateRatioDenoms(t *testing.T) {
 	}
 }
 
+func TestValidateTakerRatioDenoms(t *testing.T) {
+	ratio := func(price, fee string) FeeRatio {
+		return FeeRatio{Price: sdk.NewInt64Coin(price, 10), Fee: sdk.NewInt64Coin(fee, 1)}
+	}
+	noSellerDenomErr := func(denom string) string {
+		return fmt.Sprintf("seller settlement taker fee ratios have price denom %q but there "+
+			"is not a seller settlement fee ratio with that price denom", denom)
+	}
+
+	tests := []struct {
+		name   string
+		seller []FeeRatio
+		taker  []FeeRatio
+		expErr string
+	}{
+		{name: "nil seller, nil taker", seller: nil, taker: nil, expErr: ""},
+		{name: "nil seller, 1 taker", seller: nil, taker: []FeeRatio{ratio("apple", "apple")}, expErr: ""},
+		{name: "1 seller, nil taker", seller: []FeeRatio{ratio("apple", "apple")}, taker: nil, expErr: ""},
+		{
+			name:   "2 seller, 1 taker: in seller",
+			seller: []FeeRatio{ratio("apple", "apple"), ratio("banana", "banana")},
+			taker:  []FeeRatio{ratio("banana", "banana")},
+			expErr: "",
+		},
+		{
+			name:   "1 seller, 2 taker: one not in seller",
+			seller: []FeeRatio{ratio("apple", "apple")},
+			taker:  []FeeRatio{ratio("apple", "apple"), ratio("banana", "banana")},
+			expErr: noSellerDenomErr("banana"),
+		},
+		{
+			name:   "1 seller, 2 taker: neither in seller",
+			seller: []FeeRatio{ratio("apple", "apple")},
+			taker:  []FeeRatio{ratio("banana", "banana"), ratio("cherry", "cherry")},
+			expErr: noSellerDenomErr("banana") + "\n" + noSellerDenomErr("cherry"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var errs []error
+			testFunc := func() {
+				errs = ValidateTakerRatioDenoms(tc.seller, tc.taker)
+			}
+			require.NotPanics(t, testFunc, "ValidateTakerRatioDenoms(%q, %q)",
+				FeeRatiosString(tc.seller), FeeRatiosString(tc.taker))
+			err := errors.Join(errs...)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ValidateTakerRatioDenoms(%q, %q)",
+				FeeRatiosString(tc.seller), FeeRatiosString(tc.taker))
+		})
+	}
+}
+
 func TestValidateAddRemoveFeeOptions(t *testing.T) {
 	coin := func(amount int64, denom string) sdk.Coin {
 		return sdk.Coin{Denom: denom, Amount: sdkmath.NewInt(amount)}
@@ -2712,6 +3244,8 @@ func TestValidateAccessGrantsField(t *testing.T) {
 
 func TestAccessGrant_Validate(t *testing.T) {
 	addr := sdk.AccAddress("addr________________").String()
+	goodExp := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	epoch := time.Unix(0, 0)
 	tests := []struct {
 		name string
 		a    AccessGrant
@@ -2761,6 +3295,17 @@ func TestAccessGrant_Validate(t *testing.T) {
 			},
 			exp: "invalid access grant: permission -1 does not exist for " + addr,
 		},
+		{
+			name: "with expiration",
+			a:    AccessGrant{Address: addr, Permissions: AllPermissions(), Expiration: &goodExp},
+			exp:  "",
+		},
+		{
+			name: "expiration at epoch",
+			a:    AccessGrant{Address: addr, Permissions: AllPermissions(), Expiration: &epoch},
+			exp: "invalid access grant: invalid expiration 1970-01-01T00:00:00Z: " +
+				"must be after 1970-01-01T00:00:00Z for " + addr,
+		},
 	}
 
 	for _, tc := range tests {
@@ -3026,6 +3571,145 @@ func TestAccessGrant_Contains(t *testing.T) {
 	}
 }
 
+func TestMarketAuthorityTransfer_Validate(t *testing.T) {
+	proposedBy := sdk.AccAddress("proposedBy__________").String()
+	newAuth := sdk.AccAddress("newAuthority________").String()
+
+	tests := []struct {
+		name     string
+		transfer MarketAuthorityTransfer
+		expErr   []string
+	}{
+		{
+			name: "control",
+			transfer: MarketAuthorityTransfer{
+				MarketId:     1,
+				ProposedBy:   proposedBy,
+				NewAuthority: newAuth,
+				AccessGrants: []AccessGrant{{Address: newAuth, Permissions: AllPermissions()}},
+			},
+		},
+		{
+			name: "no access grants",
+			transfer: MarketAuthorityTransfer{
+				MarketId:     1,
+				ProposedBy:   proposedBy,
+				NewAuthority: newAuth,
+			},
+		},
+		{
+			name: "market id zero",
+			transfer: MarketAuthorityTransfer{
+				MarketId:     0,
+				ProposedBy:   proposedBy,
+				NewAuthority: newAuth,
+			},
+			expErr: []string{"invalid market id: cannot be zero"},
+		},
+		{
+			name: "invalid proposed by",
+			transfer: MarketAuthorityTransfer{
+				MarketId:     1,
+				ProposedBy:   "notanaddr",
+				NewAuthority: newAuth,
+			},
+			expErr: []string{`invalid proposed by "notanaddr"`},
+		},
+		{
+			name: "invalid new authority",
+			transfer: MarketAuthorityTransfer{
+				MarketId:     1,
+				ProposedBy:   proposedBy,
+				NewAuthority: "",
+			},
+			expErr: []string{`invalid new authority ""`},
+		},
+		{
+			name: "invalid access grant",
+			transfer: MarketAuthorityTransfer{
+				MarketId:     1,
+				ProposedBy:   proposedBy,
+				NewAuthority: newAuth,
+				AccessGrants: []AccessGrant{{Address: newAuth}},
+			},
+			expErr: []string{"invalid access grant: no permissions provided for " + newAuth},
+		},
+		{
+			name:     "multiple errors",
+			transfer: MarketAuthorityTransfer{},
+			expErr: []string{
+				"invalid market id: cannot be zero",
+				`invalid proposed by ""`,
+				`invalid new authority ""`,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = tc.transfer.Validate()
+			}
+			require.NotPanics(t, testFunc, "MarketAuthorityTransfer.Validate")
+			assertions.AssertErrorContents(t, err, tc.expErr, "MarketAuthorityTransfer.Validate")
+		})
+	}
+}
+
+func TestMarketAuthorityTransfer_GrantsPermissionsTo(t *testing.T) {
+	tests := []struct {
+		name     string
+		transfer MarketAuthorityTransfer
+		addr     string
+		exp      bool
+	}{
+		{
+			name:     "no access grants",
+			transfer: MarketAuthorityTransfer{},
+			addr:     "addr",
+			exp:      false,
+		},
+		{
+			name: "addr not in access grants",
+			transfer: MarketAuthorityTransfer{AccessGrants: []AccessGrant{
+				{Address: "other", Permissions: AllPermissions()},
+			}},
+			addr: "addr",
+			exp:  false,
+		},
+		{
+			name: "addr without permissions permission",
+			transfer: MarketAuthorityTransfer{AccessGrants: []AccessGrant{
+				{Address: "other", Permissions: AllPermissions()},
+				{Address: "addr", Permissions: []Permission{Permission_settle, Permission_update}},
+			}},
+			addr: "addr",
+			exp:  false,
+		},
+		{
+			name: "addr with permissions permission",
+			transfer: MarketAuthorityTransfer{AccessGrants: []AccessGrant{
+				{Address: "other", Permissions: []Permission{Permission_settle}},
+				{Address: "addr", Permissions: []Permission{Permission_permissions}},
+			}},
+			addr: "addr",
+			exp:  true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual bool
+			testFunc := func() {
+				actual = tc.transfer.GrantsPermissionsTo(tc.addr)
+			}
+			require.NotPanics(t, testFunc, "GrantsPermissionsTo(%q)", tc.addr)
+			assert.Equal(t, tc.exp, actual, "GrantsPermissionsTo(%q)", tc.addr)
+		})
+	}
+}
+
 func TestPermission_SimpleString(t *testing.T) {
 	tests := []struct {
 		name string
@@ -3188,78 +3872,348 @@ func TestAllPermissions(t *testing.T) {
 	assert.Equal(t, expected, actual, "AllPermissions()")
 }
 
-func TestParsePermission(t *testing.T) {
+func TestSelfTradePrevention_SimpleString(t *testing.T) {
 	tests := []struct {
-		permission string
-		expected   Permission
-		expErr     string
+		stp SelfTradePrevention
+		exp string
 	}{
-		// Permission_settle
-		{permission: "settle", expected: Permission_settle},
-		{permission: " settle", expected: Permission_settle},
-		{permission: "settle ", expected: Permission_settle},
-		{permission: "SETTLE", expected: Permission_settle},
-		{permission: "SeTTle", expected: Permission_settle},
-		{permission: "permission_settle", expected: Permission_settle},
-		{permission: "PERMISSION_SETTLE", expected: Permission_settle},
-		{permission: "pERmiSSion_seTTle", expected: Permission_settle},
+		{stp: SelfTradePrevention_unspecified, exp: "unspecified"},
+		{stp: SelfTradePrevention_cancel_newest, exp: "cancel_newest"},
+		{stp: SelfTradePrevention_cancel_oldest, exp: "cancel_oldest"},
+		{stp: SelfTradePrevention_decrement_both, exp: "decrement_both"},
+		{stp: 55, exp: "55"},
+	}
 
-		// Permission_set_ids
-		{permission: "set_ids", expected: Permission_set_ids},
-		{permission: " set_ids", expected: Permission_set_ids},
-		{permission: "set_ids ", expected: Permission_set_ids},
-		{permission: "SET_IDS", expected: Permission_set_ids},
-		{permission: "Set_Ids", expected: Permission_set_ids},
-		{permission: "setids", expected: Permission_set_ids},
-		{permission: "setids ", expected: Permission_set_ids},
-		{permission: " setids", expected: Permission_set_ids},
-		{permission: "setIds", expected: Permission_set_ids},
-		{permission: "SetIds", expected: Permission_set_ids},
-		{permission: "SETIDS", expected: Permission_set_ids},
-		{permission: "permission_set_ids", expected: Permission_set_ids},
-		{permission: "PERMISSION_SET_IDS", expected: Permission_set_ids},
-		{permission: "peRMissiOn_sEt_iDs", expected: Permission_set_ids},
-		{permission: "permission_setids", expected: Permission_set_ids},
-		{permission: "PERMISSION_SETIDS", expected: Permission_set_ids},
-		{permission: "peRMissiOn_sEtiDs", expected: Permission_set_ids},
+	for _, tc := range tests {
+		t.Run(tc.stp.String(), func(t *testing.T) {
+			actual := tc.stp.SimpleString()
+			assert.Equal(t, tc.exp, actual, "SimpleString()")
+		})
+	}
+}
 
-		// Permission_cancel
-		{permission: "cancel", expected: Permission_cancel},
-		{permission: " cancel", expected: Permission_cancel},
-		{permission: "cancel ", expected: Permission_cancel},
-		{permission: "CANCEL", expected: Permission_cancel},
-		{permission: "caNCel", expected: Permission_cancel},
-		{permission: "permission_cancel", expected: Permission_cancel},
-		{permission: "PERMISSION_CANCEL", expected: Permission_cancel},
-		{permission: "pERmiSSion_CanCEl", expected: Permission_cancel},
+func TestSelfTradePrevention_Validate(t *testing.T) {
+	tests := []struct {
+		name string
+		stp  SelfTradePrevention
+		exp  string
+	}{
+		{name: "unspecified", stp: SelfTradePrevention_unspecified, exp: ""},
+		{name: "cancel_newest", stp: SelfTradePrevention_cancel_newest, exp: ""},
+		{name: "cancel_oldest", stp: SelfTradePrevention_cancel_oldest, exp: ""},
+		{name: "decrement_both", stp: SelfTradePrevention_decrement_both, exp: ""},
+		{name: "negative 1", stp: -1, exp: "self-trade prevention -1 does not exist"},
+		{name: "unknown value", stp: 4, exp: "self-trade prevention 4 does not exist"},
+	}
 
-		// Permission_withdraw
-		{permission: "withdraw", expected: Permission_withdraw},
-		{permission: " withdraw", expected: Permission_withdraw},
-		{permission: "withdraw ", expected: Permission_withdraw},
-		{permission: "WITHDRAW", expected: Permission_withdraw},
-		{permission: "wiTHdRaw", expected: Permission_withdraw},
-		{permission: "permission_withdraw", expected: Permission_withdraw},
-		{permission: "PERMISSION_WITHDRAW", expected: Permission_withdraw},
-		{permission: "pERmiSSion_wIThdrAw", expected: Permission_withdraw},
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.stp.Validate()
+			assertions.AssertErrorValue(t, err, tc.exp, "Validate()")
+		})
+	}
 
-		// Permission_update
-		{permission: "update", expected: Permission_update},
-		{permission: " update", expected: Permission_update},
-		{permission: "update ", expected: Permission_update},
-		{permission: "UPDATE", expected: Permission_update},
-		{permission: "uPDaTe", expected: Permission_update},
-		{permission: "permission_update", expected: Permission_update},
-		{permission: "PERMISSION_UPDATE", expected: Permission_update},
-		{permission: "pERmiSSion_UpdAtE", expected: Permission_update},
+	t.Run("all values have a test case", func(t *testing.T) {
+		for val := range SelfTradePrevention_name {
+			stp := SelfTradePrevention(val)
+			hasTest := false
+			for _, tc := range tests {
+				if tc.stp == stp {
+					hasTest = true
+					break
+				}
+			}
+			assert.True(t, hasTest, "No test case found that expects the %s self-trade prevention", stp)
+		}
+	})
+}
 
-		// Permission_permissions
-		{permission: "permissions", expected: Permission_permissions},
-		{permission: " permissions", expected: Permission_permissions},
-		{permission: "permissions ", expected: Permission_permissions},
-		{permission: "PERMISSIONS", expected: Permission_permissions},
-		{permission: "pErmiSSions", expected: Permission_permissions},
-		{permission: "permission_permissions", expected: Permission_permissions},
+func TestParseSelfTradePrevention(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected SelfTradePrevention
+		expErr   string
+	}{
+		{input: "unspecified", expected: SelfTradePrevention_unspecified},
+		{input: "none", expected: SelfTradePrevention_unspecified},
+		{input: " NONE ", expected: SelfTradePrevention_unspecified},
+		{input: "SELF_TRADE_PREVENTION_UNSPECIFIED", expected: SelfTradePrevention_unspecified},
+		{input: "cancel_newest", expected: SelfTradePrevention_cancel_newest},
+		{input: "cancel-newest", expected: SelfTradePrevention_cancel_newest},
+		{input: "Cancel-Newest", expected: SelfTradePrevention_cancel_newest},
+		{input: "self_trade_prevention_cancel_newest", expected: SelfTradePrevention_cancel_newest},
+		{input: "cancel_oldest", expected: SelfTradePrevention_cancel_oldest},
+		{input: " CANCEL-OLDEST", expected: SelfTradePrevention_cancel_oldest},
+		{input: "self-trade-prevention-cancel-oldest", expected: SelfTradePrevention_cancel_oldest},
+		{input: "decrement_both", expected: SelfTradePrevention_decrement_both},
+		{input: "decrement-both ", expected: SelfTradePrevention_decrement_both},
+		{input: "SELF_TRADE_PREVENTION_DECREMENT_BOTH", expected: SelfTradePrevention_decrement_both},
+		{input: "", expErr: `invalid self-trade prevention: ""`},
+		{input: "cancel", expErr: `invalid self-trade prevention: "cancel"`},
+		{input: "cancelnewest", expErr: `invalid self-trade prevention: "cancelnewest"`},
+		{input: "1", expErr: `invalid self-trade prevention: "1"`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			var actual SelfTradePrevention
+			var err error
+			testFunc := func() {
+				actual, err = ParseSelfTradePrevention(tc.input)
+			}
+			require.NotPanics(t, testFunc, "ParseSelfTradePrevention(%q)", tc.input)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseSelfTradePrevention(%q) error", tc.input)
+			assert.Equal(t, tc.expected, actual, "ParseSelfTradePrevention(%q) result", tc.input)
+		})
+	}
+}
+
+func TestExternalIDScope_SimpleString(t *testing.T) {
+	tests := []struct {
+		scope ExternalIDScope
+		exp   string
+	}{
+		{scope: ExternalIDScope_unspecified, exp: "unspecified"},
+		{scope: ExternalIDScope_account, exp: "account"},
+		{scope: 8, exp: "8"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.scope.String(), func(t *testing.T) {
+			actual := tc.scope.SimpleString()
+			assert.Equal(t, tc.exp, actual, "SimpleString()")
+		})
+	}
+}
+
+func TestExternalIDScope_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		scope ExternalIDScope
+		exp   string
+	}{
+		{name: "unspecified", scope: ExternalIDScope_unspecified, exp: ""},
+		{name: "account", scope: ExternalIDScope_account, exp: ""},
+		{name: "negative 1", scope: -1, exp: "external id scope -1 does not exist"},
+		{name: "unknown value", scope: 2, exp: "external id scope 2 does not exist"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.scope.Validate()
+			assertions.AssertErrorValue(t, err, tc.exp, "Validate()")
+		})
+	}
+
+	t.Run("all values have a test case", func(t *testing.T) {
+		for val := range ExternalIDScope_name {
+			scope := ExternalIDScope(val)
+			hasTest := false
+			for _, tc := range tests {
+				if tc.scope == scope {
+					hasTest = true
+					break
+				}
+			}
+			assert.True(t, hasTest, "No test case found that expects the %s external id scope", scope)
+		}
+	})
+}
+
+func TestParseExternalIDScope(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected ExternalIDScope
+		expErr   string
+	}{
+		{input: "unspecified", expected: ExternalIDScope_unspecified},
+		{input: "market", expected: ExternalIDScope_unspecified},
+		{input: " Market ", expected: ExternalIDScope_unspecified},
+		{input: "EXTERNAL_ID_SCOPE_UNSPECIFIED", expected: ExternalIDScope_unspecified},
+		{input: "account", expected: ExternalIDScope_account},
+		{input: "ACCOUNT ", expected: ExternalIDScope_account},
+		{input: "external-id-scope-account", expected: ExternalIDScope_account},
+		{input: "EXTERNAL_ID_SCOPE_ACCOUNT", expected: ExternalIDScope_account},
+		{input: "", expErr: `invalid external id scope: ""`},
+		{input: "accounts", expErr: `invalid external id scope: "accounts"`},
+		{input: "external_id_scope_market", expErr: `invalid external id scope: "external_id_scope_market"`},
+		{input: "1", expErr: `invalid external id scope: "1"`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			var actual ExternalIDScope
+			var err error
+			testFunc := func() {
+				actual, err = ParseExternalIDScope(tc.input)
+			}
+			require.NotPanics(t, testFunc, "ParseExternalIDScope(%q)", tc.input)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseExternalIDScope(%q) error", tc.input)
+			assert.Equal(t, tc.expected, actual, "ParseExternalIDScope(%q) result", tc.input)
+		})
+	}
+}
+
+func TestFillAlgorithm_SimpleString(t *testing.T) {
+	tests := []struct {
+		algorithm FillAlgorithm
+		exp       string
+	}{
+		{algorithm: FillAlgorithm_unspecified, exp: "unspecified"},
+		{algorithm: FillAlgorithm_price_time, exp: "price_time"},
+		{algorithm: FillAlgorithm_pro_rata, exp: "pro_rata"},
+		{algorithm: 8, exp: "8"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.algorithm.String(), func(t *testing.T) {
+			actual := tc.algorithm.SimpleString()
+			assert.Equal(t, tc.exp, actual, "SimpleString()")
+		})
+	}
+}
+
+func TestFillAlgorithm_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		algorithm FillAlgorithm
+		exp       string
+	}{
+		{name: "unspecified", algorithm: FillAlgorithm_unspecified, exp: ""},
+		{name: "price time", algorithm: FillAlgorithm_price_time, exp: ""},
+		{name: "pro rata", algorithm: FillAlgorithm_pro_rata, exp: ""},
+		{name: "negative 1", algorithm: -1, exp: "fill algorithm -1 does not exist"},
+		{name: "unknown value", algorithm: 3, exp: "fill algorithm 3 does not exist"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.algorithm.Validate()
+			assertions.AssertErrorValue(t, err, tc.exp, "Validate()")
+		})
+	}
+
+	t.Run("all values have a test case", func(t *testing.T) {
+		for val := range FillAlgorithm_name {
+			algorithm := FillAlgorithm(val)
+			hasTest := false
+			for _, tc := range tests {
+				if tc.algorithm == algorithm {
+					hasTest = true
+					break
+				}
+			}
+			assert.True(t, hasTest, "No test case found that expects the %s fill algorithm", algorithm)
+		}
+	})
+}
+
+func TestParseFillAlgorithm(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected FillAlgorithm
+		expErr   string
+	}{
+		{input: "unspecified", expected: FillAlgorithm_unspecified},
+		{input: "FILL_ALGORITHM_UNSPECIFIED", expected: FillAlgorithm_unspecified},
+		{input: "price-time", expected: FillAlgorithm_price_time},
+		{input: " Price_Time ", expected: FillAlgorithm_price_time},
+		{input: "FILL_ALGORITHM_PRICE_TIME", expected: FillAlgorithm_price_time},
+		{input: "pro-rata", expected: FillAlgorithm_pro_rata},
+		{input: "PRO_RATA", expected: FillAlgorithm_pro_rata},
+		{input: "fill-algorithm-pro-rata", expected: FillAlgorithm_pro_rata},
+		{input: "", expErr: `invalid fill algorithm: ""`},
+		{input: "prorata", expErr: `invalid fill algorithm: "prorata"`},
+		{input: "fifo", expErr: `invalid fill algorithm: "fifo"`},
+		{input: "2", expErr: `invalid fill algorithm: "2"`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			var actual FillAlgorithm
+			var err error
+			testFunc := func() {
+				actual, err = ParseFillAlgorithm(tc.input)
+			}
+			require.NotPanics(t, testFunc, "ParseFillAlgorithm(%q)", tc.input)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseFillAlgorithm(%q) error", tc.input)
+			assert.Equal(t, tc.expected, actual, "ParseFillAlgorithm(%q) result", tc.input)
+		})
+	}
+}
+
+func TestParsePermission(t *testing.T) {
+	tests := []struct {
+		permission string
+		expected   Permission
+		expErr     string
+	}{
+		// Permission_settle
+		{permission: "settle", expected: Permission_settle},
+		{permission: " settle", expected: Permission_settle},
+		{permission: "settle ", expected: Permission_settle},
+		{permission: "SETTLE", expected: Permission_settle},
+		{permission: "SeTTle", expected: Permission_settle},
+		{permission: "permission_settle", expected: Permission_settle},
+		{permission: "PERMISSION_SETTLE", expected: Permission_settle},
+		{permission: "pERmiSSion_seTTle", expected: Permission_settle},
+
+		// Permission_set_ids
+		{permission: "set_ids", expected: Permission_set_ids},
+		{permission: " set_ids", expected: Permission_set_ids},
+		{permission: "set_ids ", expected: Permission_set_ids},
+		{permission: "SET_IDS", expected: Permission_set_ids},
+		{permission: "Set_Ids", expected: Permission_set_ids},
+		{permission: "setids", expected: Permission_set_ids},
+		{permission: "setids ", expected: Permission_set_ids},
+		{permission: " setids", expected: Permission_set_ids},
+		{permission: "setIds", expected: Permission_set_ids},
+		{permission: "SetIds", expected: Permission_set_ids},
+		{permission: "SETIDS", expected: Permission_set_ids},
+		{permission: "permission_set_ids", expected: Permission_set_ids},
+		{permission: "PERMISSION_SET_IDS", expected: Permission_set_ids},
+		{permission: "peRMissiOn_sEt_iDs", expected: Permission_set_ids},
+		{permission: "permission_setids", expected: Permission_set_ids},
+		{permission: "PERMISSION_SETIDS", expected: Permission_set_ids},
+		{permission: "peRMissiOn_sEtiDs", expected: Permission_set_ids},
+
+		// Permission_cancel
+		{permission: "cancel", expected: Permission_cancel},
+		{permission: " cancel", expected: Permission_cancel},
+		{permission: "cancel ", expected: Permission_cancel},
+		{permission: "CANCEL", expected: Permission_cancel},
+		{permission: "caNCel", expected: Permission_cancel},
+		{permission: "permission_cancel", expected: Permission_cancel},
+		{permission: "PERMISSION_CANCEL", expected: Permission_cancel},
+		{permission: "pERmiSSion_CanCEl", expected: Permission_cancel},
+
+		// Permission_withdraw
+		{permission: "withdraw", expected: Permission_withdraw},
+		{permission: " withdraw", expected: Permission_withdraw},
+		{permission: "withdraw ", expected: Permission_withdraw},
+		{permission: "WITHDRAW", expected: Permission_withdraw},
+		{permission: "wiTHdRaw", expected: Permission_withdraw},
+		{permission: "permission_withdraw", expected: Permission_withdraw},
+		{permission: "PERMISSION_WITHDRAW", expected: Permission_withdraw},
+		{permission: "pERmiSSion_wIThdrAw", expected: Permission_withdraw},
+
+		// Permission_update
+		{permission: "update", expected: Permission_update},
+		{permission: " update", expected: Permission_update},
+		{permission: "update ", expected: Permission_update},
+		{permission: "UPDATE", expected: Permission_update},
+		{permission: "uPDaTe", expected: Permission_update},
+		{permission: "permission_update", expected: Permission_update},
+		{permission: "PERMISSION_UPDATE", expected: Permission_update},
+		{permission: "pERmiSSion_UpdAtE", expected: Permission_update},
+
+		// Permission_permissions
+		{permission: "permissions", expected: Permission_permissions},
+		{permission: " permissions", expected: Permission_permissions},
+		{permission: "permissions ", expected: Permission_permissions},
+		{permission: "PERMISSIONS", expected: Permission_permissions},
+		{permission: "pErmiSSions", expected: Permission_permissions},
+		{permission: "permission_permissions", expected: Permission_permissions},
 		{permission: "PERMISSION_PERMISSIONS", expected: Permission_permissions},
 		{permission: "pERmiSSion_perMIssIons", expected: Permission_permissions},
 
@@ -3414,6 +4368,61 @@ func TestParsePermissions(t *testing.T) {
 	}
 }
 
+func TestNormalizeReqAttr(t *testing.T) {
+	tests := []struct {
+		name    string
+		reqAttr string
+		exp     string
+	}{
+		{name: "empty", reqAttr: "", exp: ""},
+		{name: "already normalized", reqAttr: "ab.cd", exp: "ab.cd"},
+		{name: "whitespace and casing", reqAttr: "  AB  .  cd ", exp: "ab.cd"},
+		{name: "wildcard", reqAttr: " * . Jk ", exp: "*.jk"},
+		{name: "two options: already normalized", reqAttr: "kyc.a.com || kyc.b.com", exp: "kyc.a.com || kyc.b.com"},
+		{name: "two options: no spaces", reqAttr: "kyc.a.com||kyc.b.com", exp: "kyc.a.com || kyc.b.com"},
+		{name: "two options: extra spaces and casing", reqAttr: "  KYC . a.com   ||   *.kyc.B.com ", exp: "kyc.a.com || *.kyc.b.com"},
+		{name: "three options", reqAttr: "a||B ||c", exp: "a || b || c"},
+		{name: "empty option", reqAttr: "a || ", exp: "a || "},
+		{name: "single pipe", reqAttr: "a | b", exp: "a | b"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var act string
+			testFunc := func() {
+				act = NormalizeReqAttr(tc.reqAttr)
+			}
+			require.NotPanics(t, testFunc, "NormalizeReqAttr(%q)", tc.reqAttr)
+			assert.Equal(t, tc.exp, act, "NormalizeReqAttr(%q)", tc.reqAttr)
+		})
+	}
+}
+
+func TestGetReqAttrOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		reqAttr string
+		exp     []string
+	}{
+		{name: "empty", reqAttr: "", exp: []string{""}},
+		{name: "one option", reqAttr: "ab.cd", exp: []string{"ab.cd"}},
+		{name: "two options", reqAttr: "kyc.a.com || *.kyc.b.com", exp: []string{"kyc.a.com", "*.kyc.b.com"}},
+		{name: "three options", reqAttr: "a || b || c", exp: []string{"a", "b", "c"}},
+		{name: "not normalized", reqAttr: "a||b", exp: []string{"a||b"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var act []string
+			testFunc := func() {
+				act = GetReqAttrOptions(tc.reqAttr)
+			}
+			require.NotPanics(t, testFunc, "GetReqAttrOptions(%q)", tc.reqAttr)
+			assert.Equal(t, tc.exp, act, "GetReqAttrOptions(%q)", tc.reqAttr)
+		})
+	}
+}
+
 func TestNormalizeReqAttrs(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -3445,6 +4454,18 @@ func TestNormalizeReqAttrs(t *testing.T) {
 			expAttrs: []string{"ab.cd", "l,m.n.o,p", "*.x.y.z"},
 			expErr:   `invalid attribute "l,M.n.o,p"`,
 		},
+		{
+			name:     "with options",
+			reqAttrs: []string{"KYC.a.com||kyc.b.com", "ab.cd", " *.x || y "},
+			expAttrs: []string{"kyc.a.com || kyc.b.com", "ab.cd", "*.x || y"},
+		},
+		{
+			name:     "with a bad option",
+			reqAttrs: []string{"kyc.a.com || kyc.b.com", "ab.cd ||", "x.*.y || z"},
+			expAttrs: []string{"kyc.a.com || kyc.b.com", "ab.cd || ", "x.*.y || z"},
+			expErr: `invalid attribute "ab.cd ||"` + "\n" +
+				`invalid attribute "x.*.y || z"`,
+		},
 		{
 			// Unlike ValidateReqAttrs, this one doesn't care about dups or duplicated errors.
 			name:     "duplicated entries",
@@ -3512,6 +4533,18 @@ func TestValidateReqAttrsAreNormalized(t *testing.T) {
 			attrs:  []string{"*.abc. def"},
 			expErr: notNormErr("AirFOILD", "*.abc. def", "*.abc.def"),
 		},
+		{
+			name:   "one attr with options: normalized",
+			field:  "OrFOILD",
+			attrs:  []string{"kyc.a.com || *.kyc.b.com"},
+			expErr: "",
+		},
+		{
+			name:   "one attr with options: not normalized",
+			field:  "OrFOILD",
+			attrs:  []string{"kyc.a.com||*.kyc.B.com"},
+			expErr: notNormErr("OrFOILD", "kyc.a.com||*.kyc.B.com", "kyc.a.com || *.kyc.b.com"),
+		},
 		{
 			name:   "three attrs: all okay",
 			field:  "WhaFoild",
@@ -3636,6 +4669,30 @@ func TestValidateReqAttrs(t *testing.T) {
 			attrs: []string{"*.wildcard", "penny.nickel.dime", "*.ex-am-ple.pb"},
 			exp:   `invalid fee-yelled required attribute "*.ex-am-ple.pb"`,
 		},
+		{
+			name:  "entries with options: valid",
+			field: "FEYULD",
+			attrs: []string{"kyc.a.com || kyc.b.com", "*.example.pb", " * . wildcard|| penny "},
+			exp:   "",
+		},
+		{
+			name:  "entry with options: one option invalid",
+			field: "FEYULD",
+			attrs: []string{"kyc.a.com || kyc.b.com", "*.example.pb || x.*.wildcard"},
+			exp:   `invalid FEYULD required attribute "*.example.pb || x.*.wildcard"`,
+		},
+		{
+			name:  "entry with options: empty option",
+			field: "FEYULD",
+			attrs: []string{"|| kyc.b.com"},
+			exp:   `invalid FEYULD required attribute "|| kyc.b.com"`,
+		},
+		{
+			name:  "entries with options: duplicated",
+			field: "FEYULD",
+			attrs: []string{"kyc.a.com || kyc.b.com", "KYC.a.com||kyc.b.com"},
+			exp:   `duplicate FEYULD required attribute "KYC.a.com||kyc.b.com"`,
+		},
 		{
 			name:  "duplicate entries",
 			field: "just some field name thingy",
@@ -3923,6 +4980,13 @@ func TestIsValidReqAttr(t *testing.T) {
 		{name: "star dot invalid", reqAttr: "*.x._y.z", exp: false},
 		{name: "empty string", reqAttr: "", exp: false},
 		{name: "wildcard in middle", reqAttr: "x.*.y.z", exp: false},
+		{name: "two valid options", reqAttr: "x.y.z || *.a.b", exp: true},
+		{name: "three valid options", reqAttr: "x || y || z", exp: true},
+		{name: "two options not normalized", reqAttr: "x.y.z||*.a.b", exp: false},
+		{name: "two options: first invalid", reqAttr: "x._y.z || *.a.b", exp: false},
+		{name: "two options: second invalid", reqAttr: "x.y.z || a.*.b", exp: false},
+		{name: "two options: first empty", reqAttr: " || x.y.z", exp: false},
+		{name: "two options: second empty", reqAttr: "x.y.z || ", exp: false},
 	}
 
 	for _, tc := range tests {
@@ -4012,6 +5076,18 @@ func TestFindUnmatchedReqAttrs(t *testing.T) {
 			accAttrs: []string{"lamp.corner.desk", "nickel.dime.quarter"},
 			exp:      []string{"*.x.y.z"},
 		},
+		{
+			name:     "req attr with options: has one option",
+			reqAttrs: []string{"kyc.a.com || kyc.b.com", "nickel.dime.quarter"},
+			accAttrs: []string{"nickel.dime.quarter", "kyc.b.com"},
+			exp:      nil,
+		},
+		{
+			name:     "req attr with options: has no options",
+			reqAttrs: []string{"kyc.a.com || kyc.b.com", "nickel.dime.quarter"},
+			accAttrs: []string{"nickel.dime.quarter", "kyc.c.com"},
+			exp:      []string{"kyc.a.com || kyc.b.com"},
+		},
 		{
 			name:     "three req attrs: has all",
 			reqAttrs: []string{"*.desk", "nickel.dime.quarter", "*.x.y.z"},
@@ -4453,6 +5529,42 @@ func TestIsReqAttrMatch(t *testing.T) {
 			accAttr: "penny.dime.quarter",
 			exp:     false,
 		},
+		{
+			name:    "two options: matches first",
+			reqAttr: "kyc.a.com || kyc.b.com",
+			accAttr: "kyc.a.com",
+			exp:     true,
+		},
+		{
+			name:    "two options: matches second",
+			reqAttr: "kyc.a.com || kyc.b.com",
+			accAttr: "kyc.b.com",
+			exp:     true,
+		},
+		{
+			name:    "two options: matches neither",
+			reqAttr: "kyc.a.com || kyc.b.com",
+			accAttr: "kyc.c.com",
+			exp:     false,
+		},
+		{
+			name:    "two options: matches wildcard option",
+			reqAttr: "kyc.a.com || *.kyc.b.com",
+			accAttr: "buyer.kyc.b.com",
+			exp:     true,
+		},
+		{
+			name:    "two options: acc attr is the whole req attr",
+			reqAttr: "kyc.a.com || kyc.b.com",
+			accAttr: "kyc.a.com || kyc.b.com",
+			exp:     false,
+		},
+		{
+			name:    "two options: empty second option",
+			reqAttr: "kyc.a.com || ",
+			accAttr: "",
+			exp:     false,
+		},
 	}
 
 	for _, tc := range tests {
@@ -4528,3 +5640,383 @@ func TestValidateIntermediaryDenom(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateAcceptedDenoms(t *testing.T) {
+	tests := []struct {
+		name   string
+		field  string
+		denoms []string
+		expErr string
+	}{
+		{
+			name:   "nil denoms",
+			field:  "asset",
+			denoms: nil,
+			expErr: "",
+		},
+		{
+			name:   "one good denom",
+			field:  "asset",
+			denoms: []string{"apple"},
+			expErr: "",
+		},
+		{
+			name:   "three good denoms",
+			field:  "price",
+			denoms: []string{"nhash", "usd", "euro"},
+			expErr: "",
+		},
+		{
+			name:   "one bad denom",
+			field:  "price",
+			denoms: []string{"nhash", "x", "usd"},
+			expErr: "invalid accepted price denom: invalid denom: x",
+		},
+		{
+			name:   "duplicate denom",
+			field:  "asset",
+			denoms: []string{"apple", "banana", "apple"},
+			expErr: "duplicate accepted asset denom \"apple\"",
+		},
+		{
+			name:   "multiple errors",
+			field:  "thing",
+			denoms: []string{"y", "apple", "apple", "z"},
+			expErr: "invalid accepted thing denom: invalid denom: y\n" +
+				"duplicate accepted thing denom \"apple\"\n" +
+				"invalid accepted thing denom: invalid denom: z",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = ValidateAcceptedDenoms(tc.field, tc.denoms)
+			}
+			require.NotPanics(t, testFunc, "ValidateAcceptedDenoms(%q, %q)", tc.field, tc.denoms)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ValidateAcceptedDenoms(%q, %q) result", tc.field, tc.denoms)
+		})
+	}
+}
+
+func TestValidateAddRemoveAcceptedDenoms(t *testing.T) {
+	tests := []struct {
+		name     string
+		field    string
+		toAdd    []string
+		toRemove []string
+		expErr   string
+	}{
+		{
+			name:   "nothing",
+			field:  "asset",
+			expErr: "",
+		},
+		{
+			name:     "add and remove different denoms",
+			field:    "asset",
+			toAdd:    []string{"apple", "banana"},
+			toRemove: []string{"cherry"},
+			expErr:   "",
+		},
+		{
+			name:     "invalid denom to remove",
+			field:    "asset",
+			toRemove: []string{"x"},
+			expErr:   "",
+		},
+		{
+			name:   "invalid denom to add",
+			field:  "price",
+			toAdd:  []string{"x"},
+			expErr: "invalid accepted price to add denom: invalid denom: x",
+		},
+		{
+			name:     "same denom in both",
+			field:    "price",
+			toAdd:    []string{"nhash", "usd"},
+			toRemove: []string{"euro", "usd"},
+			expErr:   "cannot add and remove the same accepted price denom \"usd\"",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = ValidateAddRemoveAcceptedDenoms(tc.field, tc.toAdd, tc.toRemove)
+			}
+			require.NotPanics(t, testFunc, "ValidateAddRemoveAcceptedDenoms(%q, %q, %q)", tc.field, tc.toAdd, tc.toRemove)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ValidateAddRemoveAcceptedDenoms(%q, %q, %q) result",
+				tc.field, tc.toAdd, tc.toRemove)
+		})
+	}
+}
+
+func TestValidateMatchingModes(t *testing.T) {
+	tests := []struct {
+		name                 string
+		continuousMatching   bool
+		batchAuctionInterval uint32
+		expErr               string
+	}{
+		{
+			name:                 "neither",
+			continuousMatching:   false,
+			batchAuctionInterval: 0,
+			expErr:               "",
+		},
+		{
+			name:                 "continuous matching",
+			continuousMatching:   true,
+			batchAuctionInterval: 0,
+			expErr:               "",
+		},
+		{
+			name:                 "batch auctions",
+			continuousMatching:   false,
+			batchAuctionInterval: 1,
+			expErr:               "",
+		},
+		{
+			name:                 "both",
+			continuousMatching:   true,
+			batchAuctionInterval: 100,
+			expErr:               "cannot have continuous matching with batch auction interval 100",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = ValidateMatchingModes(tc.continuousMatching, tc.batchAuctionInterval)
+			}
+			require.NotPanics(t, testFunc, "ValidateMatchingModes(%t, %d)", tc.continuousMatching, tc.batchAuctionInterval)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ValidateMatchingModes(%t, %d) result",
+				tc.continuousMatching, tc.batchAuctionInterval)
+		})
+	}
+}
+
+func TestOrderRateLimit_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		limit  *OrderRateLimit
+		expErr []string
+	}{
+		{name: "nil", limit: nil},
+		{name: "one per block", limit: &OrderRateLimit{MaxOrders: 1, WindowBlocks: 1}},
+		{name: "max window", limit: &OrderRateLimit{MaxOrders: 500, WindowBlocks: MaxOrderRateLimitWindow}},
+		{
+			name:   "zero max orders",
+			limit:  &OrderRateLimit{MaxOrders: 0, WindowBlocks: 3},
+			expErr: []string{"invalid order rate limit max orders: cannot be zero"},
+		},
+		{
+			name:   "zero window blocks",
+			limit:  &OrderRateLimit{MaxOrders: 3, WindowBlocks: 0},
+			expErr: []string{"invalid order rate limit window blocks: cannot be zero"},
+		},
+		{
+			name:   "window too large",
+			limit:  &OrderRateLimit{MaxOrders: 3, WindowBlocks: MaxOrderRateLimitWindow + 1},
+			expErr: []string{"invalid order rate limit window blocks 100001: exceeds max of 100000"},
+		},
+		{
+			name:  "empty",
+			limit: &OrderRateLimit{},
+			expErr: []string{
+				"invalid order rate limit max orders: cannot be zero",
+				"invalid order rate limit window blocks: cannot be zero",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = tc.limit.Validate()
+			}
+			require.NotPanics(t, testFunc, "Validate()")
+			assertions.AssertErrorContents(t, err, tc.expErr, "Validate()")
+		})
+	}
+}
+
+func TestOrderRateLimit_Equals(t *testing.T) {
+	tests := []struct {
+		name  string
+		r     *OrderRateLimit
+		other *OrderRateLimit
+		exp   bool
+	}{
+		{name: "both nil", r: nil, other: nil, exp: true},
+		{name: "nil and not", r: nil, other: &OrderRateLimit{MaxOrders: 1, WindowBlocks: 1}, exp: false},
+		{name: "not and nil", r: &OrderRateLimit{MaxOrders: 1, WindowBlocks: 1}, other: nil, exp: false},
+		{
+			name:  "same",
+			r:     &OrderRateLimit{MaxOrders: 5, WindowBlocks: 2},
+			other: &OrderRateLimit{MaxOrders: 5, WindowBlocks: 2},
+			exp:   true,
+		},
+		{
+			name:  "different max orders",
+			r:     &OrderRateLimit{MaxOrders: 5, WindowBlocks: 2},
+			other: &OrderRateLimit{MaxOrders: 6, WindowBlocks: 2},
+			exp:   false,
+		},
+		{
+			name:  "different window blocks",
+			r:     &OrderRateLimit{MaxOrders: 5, WindowBlocks: 2},
+			other: &OrderRateLimit{MaxOrders: 5, WindowBlocks: 3},
+			exp:   false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := tc.r.Equals(tc.other)
+			assert.Equal(t, tc.exp, actual, "Equals")
+		})
+	}
+}
+
+func TestValidateMaxExposure(t *testing.T) {
+	tests := []struct {
+		name        string
+		maxExposure *sdk.Coin
+		expErr      string
+	}{
+		{name: "nil", maxExposure: nil},
+		{name: "one", maxExposure: &sdk.Coin{Denom: "nhash", Amount: sdkmath.OneInt()}},
+		{name: "big", maxExposure: &sdk.Coin{Denom: "usd", Amount: newInt(t, "1000000000000000000000000")}},
+		{
+			name:        "zero",
+			maxExposure: &sdk.Coin{Denom: "nhash", Amount: sdkmath.ZeroInt()},
+			expErr:      "invalid max exposure \"0nhash\": amount must be positive",
+		},
+		{
+			name:        "negative",
+			maxExposure: &sdk.Coin{Denom: "nhash", Amount: sdkmath.NewInt(-3)},
+			expErr:      "invalid max exposure \"-3nhash\": negative coin amount: -3",
+		},
+		{
+			name:        "invalid denom",
+			maxExposure: &sdk.Coin{Denom: "x", Amount: sdkmath.NewInt(3)},
+			expErr:      "invalid max exposure \"3x\": invalid denom: x",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = ValidateMaxExposure(tc.maxExposure)
+			}
+			require.NotPanics(t, testFunc, "ValidateMaxExposure")
+			assertions.AssertErrorValue(t, err, tc.expErr, "ValidateMaxExposure")
+		})
+	}
+}
+
+func TestValidateExchangeSplits(t *testing.T) {
+	tests := []struct {
+		name   string
+		field  string
+		splits []DenomSplit
+		expErr []string
+	}{
+		{name: "nil", splits: nil},
+		{name: "empty", splits: []DenomSplit{}},
+		{name: "one", splits: []DenomSplit{{Denom: "apple", Split: 100}}},
+		{
+			name:   "three, including zero and max",
+			splits: []DenomSplit{{Denom: "apple", Split: 0}, {Denom: "banana", Split: 10_000}, {Denom: "cherry", Split: 3}},
+		},
+		{
+			name:   "invalid denom",
+			splits: []DenomSplit{{Denom: "x", Split: 100}},
+			expErr: []string{"invalid exchange split: invalid denom: x"},
+		},
+		{
+			name:   "split too large",
+			field:  "to set",
+			splits: []DenomSplit{{Denom: "apple", Split: 10_001}},
+			expErr: []string{"invalid exchange split to set: apple split 10001 cannot be greater than 10000"},
+		},
+		{
+			name:   "duplicate denom",
+			splits: []DenomSplit{{Denom: "apple", Split: 1}, {Denom: "banana", Split: 2}, {Denom: "apple", Split: 3}},
+			expErr: []string{"duplicate exchange split denom \"apple\""},
+		},
+		{
+			name:   "duplicate denom with field",
+			field:  "to set",
+			splits: []DenomSplit{{Denom: "apple", Split: 1}, {Denom: "apple", Split: 3}},
+			expErr: []string{"duplicate exchange split denom to set \"apple\""},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = ValidateExchangeSplits(tc.field, tc.splits)
+			}
+			require.NotPanics(t, testFunc, "ValidateExchangeSplits")
+			assertions.AssertErrorContents(t, err, tc.expErr, "ValidateExchangeSplits")
+		})
+	}
+}
+
+func TestValidateAddRemoveExchangeSplits(t *testing.T) {
+	tests := []struct {
+		name     string
+		toSet    []DenomSplit
+		toRemove []string
+		expErr   []string
+	}{
+		{name: "nil nil"},
+		{
+			name:     "no overlap",
+			toSet:    []DenomSplit{{Denom: "apple", Split: 1}},
+			toRemove: []string{"banana", "cherry"},
+		},
+		{
+			name:     "invalid split to set",
+			toSet:    []DenomSplit{{Denom: "apple", Split: 10_001}},
+			toRemove: []string{"banana"},
+			expErr:   []string{"invalid exchange split to set: apple split 10001 cannot be greater than 10000"},
+		},
+		{
+			name:     "invalid denom to remove",
+			toRemove: []string{"y"},
+			expErr:   []string{"invalid exchange split denom to remove: invalid denom: y"},
+		},
+		{
+			name:     "duplicate denom to remove",
+			toRemove: []string{"apple", "banana", "apple"},
+			expErr:   []string{"duplicate exchange split denom to remove \"apple\""},
+		},
+		{
+			name:     "denom in both",
+			toSet:    []DenomSplit{{Denom: "apple", Split: 1}, {Denom: "banana", Split: 2}},
+			toRemove: []string{"cherry", "banana"},
+			expErr:   []string{"cannot set and remove the same exchange split denom \"banana\""},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = ValidateAddRemoveExchangeSplits(tc.toSet, tc.toRemove)
+			}
+			require.NotPanics(t, testFunc, "ValidateAddRemoveExchangeSplits")
+			assertions.AssertErrorContents(t, err, tc.expErr, "ValidateAddRemoveExchangeSplits")
+		})
+	}
+}
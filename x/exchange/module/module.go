@@ -16,6 +16,7 @@ import (
 	sdkclient "github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
@@ -24,13 +25,15 @@ import (
 	"github.com/provenance-io/provenance/x/exchange/client/cli"
 	"github.com/provenance-io/provenance/x/exchange/keeper"
 	"github.com/provenance-io/provenance/x/exchange/simulation"
+	exchangev2 "github.com/provenance-io/provenance/x/exchange/v2"
 )
 
 var (
 	_ module.AppModuleBasic      = (*AppModule)(nil)
 	_ module.AppModuleSimulation = (*AppModule)(nil)
 
-	_ appmodule.AppModule = (*AppModule)(nil)
+	_ appmodule.AppModule     = (*AppModule)(nil)
+	_ appmodule.HasEndBlocker = (*AppModule)(nil)
 )
 
 type AppModuleBasic struct {
@@ -70,6 +73,9 @@ func (a AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx sdkclient.Context, m
 	if err := exchange.RegisterQueryHandlerClient(context.Background(), mux, exchange.NewQueryClient(clientCtx)); err != nil {
 		panic(err)
 	}
+	if err := exchangev2.RegisterQueryHandlerClient(context.Background(), mux, exchangev2.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
 }
 
 // RegisterInterfaces registers the exchange module's interface types
@@ -99,7 +105,9 @@ func (AppModule) IsOnePerModuleType() {}
 func (AppModule) IsAppModule() {}
 
 // RegisterInvariants registers the invariants for the exchange module.
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // InitGenesis performs genesis initialization for the exchange module. It returns
 // no validator updates.
@@ -120,11 +128,37 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	exchange.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServer(am.keeper))
 	exchange.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServer(am.keeper))
+	exchangev2.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServerV2(am.keeper))
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return 1 }
 
+// EndBlock applies any scheduled fee changes that are due. Then it cancels orders that have expired,
+// cancels the orders of accounts that missed a dead-man switch heartbeat, releases commitments that have expired, revokes access grants that have expired, and cancels
+// payments that have expired. Then it releases escrowed payments whose dispute windows have ended,
+// and makes any recurring payment transfers that are due. Then it runs the batch auctions of any markets that are due for one.
+// Lastly, it deletes old settlement records, settlement receipts, NAV records (including any beyond the max kept
+// for a denom pair), and idempotency keys.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	defer telemetry.ModuleMeasureSince(exchange.ModuleName, telemetry.Now(), telemetry.MetricKeyEndBlocker)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	am.keeper.ApplyScheduledFeeChanges(sdkCtx)
+	am.keeper.ExpireOrders(sdkCtx, exchange.MaxExpiredOrdersPerBlock)
+	am.keeper.TriggerDeadManSwitches(sdkCtx, exchange.MaxDeadManSwitchesTriggeredPerBlock, exchange.MaxDeadManSwitchCancellationsPerBlock)
+	am.keeper.ExpireCommitments(sdkCtx, exchange.MaxExpiredCommitmentsPerBlock)
+	am.keeper.ExpireAccessGrants(sdkCtx, exchange.MaxExpiredAccessGrantsPerBlock)
+	am.keeper.ExpirePayments(sdkCtx, exchange.MaxExpiredPaymentsPerBlock)
+	am.keeper.ReleaseEscrowedPayments(sdkCtx, exchange.MaxEscrowedPaymentReleasesPerBlock)
+	am.keeper.ProcessRecurringPayments(sdkCtx, exchange.MaxRecurringPaymentTransfersPerBlock)
+	am.keeper.RunBatchAuctions(sdkCtx)
+	am.keeper.PruneSettlementRecords(sdkCtx, exchange.MaxSettlementRecordsPrunedPerBlock)
+	am.keeper.PruneSettlementReceipts(sdkCtx, exchange.MaxSettlementReceiptsPrunedPerBlock)
+	am.keeper.PruneNAVRecords(sdkCtx, exchange.MaxNAVRecordsPrunedPerBlock)
+	am.keeper.PruneIdempotencyKeys(sdkCtx, exchange.MaxIdempotencyKeysPrunedPerBlock)
+	return nil
+}
+
 // ____________________________________________________________________________
 
 // AppModuleSimulation functions
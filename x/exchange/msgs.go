@@ -3,6 +3,7 @@ package exchange
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	protov2 "google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/protoadapt"
@@ -17,14 +18,26 @@ import (
 var AllRequestMsgs = []sdk.Msg{
 	(*MsgCreateAskRequest)(nil),
 	(*MsgCreateBidRequest)(nil),
+	(*MsgCreateTriggerAskRequest)(nil),
+	(*MsgCreateTriggerBidRequest)(nil),
+	(*MsgCreateOrdersBatchRequest)(nil),
 	(*MsgCommitFundsRequest)(nil),
+	(*MsgTransferCommitmentRequest)(nil),
+	(*MsgFundCommitmentRewardsRequest)(nil),
+	(*MsgClaimCommitmentRewardsRequest)(nil),
 	(*MsgCancelOrderRequest)(nil),
+	(*MsgAmendOrderRequest)(nil),
+	(*MsgLinkOrdersRequest)(nil),
+	(*MsgSetDeadManSwitchRequest)(nil),
+	(*MsgHeartbeatRequest)(nil),
 	(*MsgFillBidsRequest)(nil),
 	(*MsgFillAsksRequest)(nil),
 	(*MsgMarketSettleRequest)(nil),
 	(*MsgMarketCommitmentSettleRequest)(nil),
 	(*MsgMarketReleaseCommitmentsRequest)(nil),
 	(*MsgMarketTransferCommitmentRequest)(nil),
+	(*MsgMarketDistributeCommitmentRewardsRequest)(nil),
+	(*MsgMarketBulkCancelRequest)(nil),
 	(*MsgMarketSetOrderExternalIDRequest)(nil),
 	(*MsgMarketWithdrawRequest)(nil),
 	(*MsgMarketUpdateDetailsRequest)(nil),
@@ -32,18 +45,38 @@ var AllRequestMsgs = []sdk.Msg{
 	(*MsgMarketUpdateAcceptingOrdersRequest)(nil),
 	(*MsgMarketUpdateUserSettleRequest)(nil),
 	(*MsgMarketUpdateAcceptingCommitmentsRequest)(nil),
+	(*MsgMarketUpdateContinuousMatchingRequest)(nil),
+	(*MsgMarketUpdateBatchAuctionRequest)(nil),
+	(*MsgMarketUpdateSelfTradePreventionRequest)(nil),
+	(*MsgMarketUpdatePublishPricesRequest)(nil),
+	(*MsgMarketUpdateExternalIDScopeRequest)(nil),
+	(*MsgMarketUpdateOrderRateLimitRequest)(nil),
+	(*MsgMarketUpdateMaxExposureRequest)(nil),
+	(*MsgMarketUpdateFillAlgorithmRequest)(nil),
 	(*MsgMarketUpdateIntermediaryDenomRequest)(nil),
 	(*MsgMarketManagePermissionsRequest)(nil),
+	(*MsgMarketTransferAuthorityRequest)(nil),
+	(*MsgMarketAcceptAuthorityRequest)(nil),
 	(*MsgMarketManageReqAttrsRequest)(nil),
+	(*MsgMarketManageAcceptedDenomsRequest)(nil),
 	(*MsgCreatePaymentRequest)(nil),
 	(*MsgAcceptPaymentRequest)(nil),
+	(*MsgAcceptPaymentsRequest)(nil),
 	(*MsgRejectPaymentRequest)(nil),
 	(*MsgRejectPaymentsRequest)(nil),
+	(*MsgDisputePaymentRequest)(nil),
 	(*MsgCancelPaymentsRequest)(nil),
 	(*MsgChangePaymentTargetRequest)(nil),
+	(*MsgCreateRecurringPaymentRequest)(nil),
+	(*MsgCancelRecurringPaymentsRequest)(nil),
+	(*MsgCreateMultiPartyPaymentRequest)(nil),
+	(*MsgAcceptMultiPartyPaymentRequest)(nil),
+	(*MsgCancelMultiPartyPaymentRequest)(nil),
 	(*MsgGovCreateMarketRequest)(nil),
 	(*MsgGovManageFeesRequest)(nil),
+	(*MsgGovManageExchangeSplitsRequest)(nil),
 	(*MsgGovCloseMarketRequest)(nil),
+	(*MsgGovWindDownMarketRequest)(nil),
 	(*MsgGovUpdateParamsRequest)(nil),
 	(*MsgUpdateParamsRequest)(nil),
 }
@@ -100,7 +133,7 @@ func (m MsgCreateAskRequest) ValidateBasic() error {
 			return fmt.Errorf("invalid order creation fee: %w", err)
 		}
 	}
-	return nil
+	return ValidateIdempotencyKey(m.IdempotencyKey)
 }
 
 func (m MsgCreateBidRequest) ValidateBasic() error {
@@ -112,9 +145,101 @@ func (m MsgCreateBidRequest) ValidateBasic() error {
 			return fmt.Errorf("invalid order creation fee: %w", err)
 		}
 	}
+	return ValidateIdempotencyKey(m.IdempotencyKey)
+}
+
+func (m MsgCreateTriggerAskRequest) ValidateBasic() error {
+	if err := m.AskOrder.Validate(); err != nil {
+		return err
+	}
+	if err := ValidateTriggerPrice(m.TriggerPrice, m.AskOrder.Price); err != nil {
+		return err
+	}
+	if m.OrderCreationFee != nil {
+		if err := m.OrderCreationFee.Validate(); err != nil {
+			return fmt.Errorf("invalid order creation fee: %w", err)
+		}
+	}
+	return nil
+}
+
+func (m MsgCreateTriggerBidRequest) ValidateBasic() error {
+	if err := m.BidOrder.Validate(); err != nil {
+		return err
+	}
+	if err := ValidateTriggerPrice(m.TriggerPrice, m.BidOrder.Price); err != nil {
+		return err
+	}
+	if m.OrderCreationFee != nil {
+		if err := m.OrderCreationFee.Validate(); err != nil {
+			return fmt.Errorf("invalid order creation fee: %w", err)
+		}
+	}
 	return nil
 }
 
+func (m MsgCreateOrdersBatchRequest) ValidateBasic() error {
+	var errs []error
+
+	_, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("invalid owner: %w", err))
+	}
+	ownerOK := err == nil
+
+	if m.MarketId == 0 {
+		errs = append(errs, errors.New("invalid market id: cannot be zero"))
+	}
+
+	if len(m.AskOrders) == 0 && len(m.BidOrders) == 0 {
+		errs = append(errs, errors.New("no orders provided"))
+	}
+
+	for i, askOrder := range m.AskOrders {
+		if err = askOrder.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid ask order [%d]: %w", i, err))
+			continue
+		}
+		if ownerOK && askOrder.Seller != m.Owner {
+			errs = append(errs, fmt.Errorf("ask order [%d] seller %q does not equal owner %q", i, askOrder.Seller, m.Owner))
+		}
+		if m.MarketId != 0 && askOrder.MarketId != m.MarketId {
+			errs = append(errs, fmt.Errorf("ask order [%d] market id %d does not equal market id %d", i, askOrder.MarketId, m.MarketId))
+		}
+	}
+
+	for i, bidOrder := range m.BidOrders {
+		if err = bidOrder.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid bid order [%d]: %w", i, err))
+			continue
+		}
+		if ownerOK && bidOrder.Buyer != m.Owner {
+			errs = append(errs, fmt.Errorf("bid order [%d] buyer %q does not equal owner %q", i, bidOrder.Buyer, m.Owner))
+		}
+		if m.MarketId != 0 && bidOrder.MarketId != m.MarketId {
+			errs = append(errs, fmt.Errorf("bid order [%d] market id %d does not equal market id %d", i, bidOrder.MarketId, m.MarketId))
+		}
+	}
+
+	if m.AskOrderCreationFee != nil {
+		if err = m.AskOrderCreationFee.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid ask order creation fee: %w", err))
+		} else if m.AskOrderCreationFee.IsZero() {
+			errs = append(errs, fmt.Errorf("invalid ask order creation fee: %s amount cannot be zero", m.AskOrderCreationFee.Denom))
+		}
+	}
+
+	if m.BidOrderCreationFee != nil {
+		if err = m.BidOrderCreationFee.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid bid order creation fee: %w", err))
+		} else if m.BidOrderCreationFee.IsZero() {
+			errs = append(errs, fmt.Errorf("invalid bid order creation fee: %s amount cannot be zero", m.BidOrderCreationFee.Denom))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 func (m MsgCommitFundsRequest) ValidateBasic() error {
 	var errs []error
 
@@ -142,6 +267,76 @@ func (m MsgCommitFundsRequest) ValidateBasic() error {
 		errs = append(errs, err)
 	}
 
+	if err := validateExpiration(m.Expiration); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+func (m MsgTransferCommitmentRequest) ValidateBasic() error {
+	var errs []error
+
+	if _, err := sdk.AccAddressFromBech32(m.Account); err != nil {
+		errs = append(errs, fmt.Errorf("invalid account %q: %w", m.Account, err))
+	}
+
+	if m.CurrentMarketId == 0 {
+		errs = append(errs, errors.New("invalid current market id: cannot be zero"))
+	}
+
+	if m.NewMarketId == 0 {
+		errs = append(errs, errors.New("invalid new market id: cannot be zero"))
+	}
+
+	if m.CurrentMarketId != 0 && m.CurrentMarketId == m.NewMarketId {
+		errs = append(errs, errors.New("invalid new market id: cannot be the same as current market id"))
+	}
+
+	if m.Amount.IsZero() {
+		errs = append(errs, fmt.Errorf("invalid amount %q: cannot be zero", m.Amount))
+	} else if err := m.Amount.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("invalid amount %q: %w", m.Amount, err))
+	}
+
+	if err := ValidateEventTag(m.EventTag); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+func (m MsgFundCommitmentRewardsRequest) ValidateBasic() error {
+	var errs []error
+
+	if _, err := sdk.AccAddressFromBech32(m.Funder); err != nil {
+		errs = append(errs, fmt.Errorf("invalid funder %q: %w", m.Funder, err))
+	}
+
+	if m.MarketId == 0 {
+		errs = append(errs, errors.New("invalid market id: cannot be zero"))
+	}
+
+	if m.Amount.IsZero() {
+		errs = append(errs, fmt.Errorf("invalid amount %q: cannot be zero", m.Amount))
+	} else if err := m.Amount.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("invalid amount %q: %w", m.Amount, err))
+	}
+
+	return errors.Join(errs...)
+}
+
+func (m MsgClaimCommitmentRewardsRequest) ValidateBasic() error {
+	var errs []error
+
+	if _, err := sdk.AccAddressFromBech32(m.Account); err != nil {
+		errs = append(errs, fmt.Errorf("invalid account %q: %w", m.Account, err))
+	}
+
+	if m.MarketId == 0 {
+		errs = append(errs, errors.New("invalid market id: cannot be zero"))
+	}
+
 	return errors.Join(errs...)
 }
 
@@ -155,6 +350,83 @@ func (m MsgCancelOrderRequest) ValidateBasic() error {
 	return nil
 }
 
+func (m MsgAmendOrderRequest) ValidateBasic() error {
+	var errs []error
+
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		errs = append(errs, fmt.Errorf("invalid owner: %w", err))
+	}
+
+	if m.MarketId == 0 {
+		errs = append(errs, errors.New("invalid market id: cannot be zero"))
+	}
+
+	if m.OrderId == 0 {
+		errs = append(errs, errors.New("invalid order id: cannot be zero"))
+	}
+
+	var priceDenom string
+	if err := validateCoin("price", m.Price); err != nil {
+		errs = append(errs, err)
+	} else {
+		priceDenom = m.Price.Denom
+	}
+
+	if err := validateCoin("assets", m.Assets); err != nil {
+		errs = append(errs, err)
+	} else if len(priceDenom) > 0 && m.Assets.Denom == priceDenom {
+		errs = append(errs, fmt.Errorf("invalid assets: price denom %s cannot also be the assets denom", priceDenom))
+	}
+
+	if m.SellerSettlementFlatFee != nil {
+		if err := m.SellerSettlementFlatFee.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid seller settlement flat fee: %w", err))
+		} else if m.SellerSettlementFlatFee.IsZero() {
+			errs = append(errs, fmt.Errorf("invalid seller settlement flat fee: %s amount cannot be zero", m.SellerSettlementFlatFee.Denom))
+		}
+	}
+
+	if len(m.BuyerSettlementFees) > 0 {
+		if err := m.BuyerSettlementFees.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid buyer settlement fees: %w", err))
+		}
+		if m.SellerSettlementFlatFee != nil {
+			errs = append(errs, errors.New("cannot provide both a seller settlement flat fee and buyer settlement fees"))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (m MsgLinkOrdersRequest) ValidateBasic() error {
+	var errs []error
+
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		errs = append(errs, fmt.Errorf("invalid owner: %w", err))
+	}
+
+	link := OrderLink{MarketId: m.MarketId, OrderId: m.OrderId, LinkedOrderId: m.LinkedOrderId}
+	if err := link.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+func (m MsgSetDeadManSwitchRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Account); err != nil {
+		return fmt.Errorf("invalid account: %w", err)
+	}
+	return nil
+}
+
+func (m MsgHeartbeatRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Account); err != nil {
+		return fmt.Errorf("invalid account: %w", err)
+	}
+	return nil
+}
+
 func (m MsgFillBidsRequest) ValidateBasic() error {
 	var errs []error
 
@@ -397,6 +669,66 @@ func (m MsgMarketTransferCommitmentRequest) ValidateBasic() error {
 	return errors.Join(errs...)
 }
 
+func (m MsgMarketDistributeCommitmentRewardsRequest) ValidateBasic() error {
+	var errs []error
+
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		errs = append(errs, fmt.Errorf("invalid administrator %q: %w", m.Admin, err))
+	}
+
+	if m.MarketId == 0 {
+		errs = append(errs, errors.New("invalid market id: cannot be zero"))
+	}
+
+	if m.Amount.IsZero() {
+		errs = append(errs, fmt.Errorf("invalid amount %q: cannot be zero", m.Amount))
+	} else if err := m.Amount.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("invalid amount %q: %w", m.Amount, err))
+	}
+
+	if err := sdk.ValidateDenom(m.BasisDenom); err != nil {
+		errs = append(errs, fmt.Errorf("invalid basis denom: %w", err))
+	}
+
+	return errors.Join(errs...)
+}
+
+func (m MsgMarketBulkCancelRequest) ValidateBasic() error {
+	var errs []error
+
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		errs = append(errs, fmt.Errorf("invalid administrator %q: %w", m.Admin, err))
+	}
+
+	if m.MarketId == 0 {
+		errs = append(errs, errors.New("invalid market id: cannot be zero"))
+	}
+
+	switch m.OrderType {
+	case "", OrderTypeAsk, OrderTypeBid:
+	default:
+		errs = append(errs, fmt.Errorf("invalid order type %q: must be %q, %q, or empty", m.OrderType, OrderTypeAsk, OrderTypeBid))
+	}
+
+	if len(m.Owner) > 0 {
+		if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+			errs = append(errs, fmt.Errorf("invalid owner %q: %w", m.Owner, err))
+		}
+	}
+
+	if len(m.AssetDenom) > 0 {
+		if err := sdk.ValidateDenom(m.AssetDenom); err != nil {
+			errs = append(errs, fmt.Errorf("invalid asset denom: %w", err))
+		}
+	}
+
+	if m.Limit > MaxMarketBulkCancelLimit {
+		errs = append(errs, fmt.Errorf("invalid limit %d: cannot be more than %d", m.Limit, MaxMarketBulkCancelLimit))
+	}
+
+	return errors.Join(errs...)
+}
+
 func (m MsgMarketSetOrderExternalIDRequest) ValidateBasic() error {
 	var errs []error
 
@@ -508,7 +840,7 @@ func (m MsgMarketUpdateAcceptingCommitmentsRequest) ValidateBasic() error {
 	return errors.Join(errs...)
 }
 
-func (m MsgMarketUpdateIntermediaryDenomRequest) ValidateBasic() error {
+func (m MsgMarketUpdateContinuousMatchingRequest) ValidateBasic() error {
 	var errs []error
 	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
 		errs = append(errs, fmt.Errorf("invalid administrator %q: %w", m.Admin, err))
@@ -516,29 +848,132 @@ func (m MsgMarketUpdateIntermediaryDenomRequest) ValidateBasic() error {
 	if m.MarketId == 0 {
 		errs = append(errs, errors.New("invalid market id: cannot be zero"))
 	}
-	if err := ValidateIntermediaryDenom(m.IntermediaryDenom); err != nil {
-		errs = append(errs, err)
-	}
 	return errors.Join(errs...)
 }
 
-func (m MsgMarketManagePermissionsRequest) ValidateBasic() error {
+func (m MsgMarketUpdateBatchAuctionRequest) ValidateBasic() error {
 	var errs []error
-
 	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
 		errs = append(errs, fmt.Errorf("invalid administrator %q: %w", m.Admin, err))
 	}
+	if m.MarketId == 0 {
+		errs = append(errs, errors.New("invalid market id: cannot be zero"))
+	}
+	return errors.Join(errs...)
+}
 
+func (m MsgMarketUpdateSelfTradePreventionRequest) ValidateBasic() error {
+	var errs []error
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		errs = append(errs, fmt.Errorf("invalid administrator %q: %w", m.Admin, err))
+	}
 	if m.MarketId == 0 {
-		errs = append(errs, fmt.Errorf("invalid market id: cannot be zero"))
+		errs = append(errs, errors.New("invalid market id: cannot be zero"))
+	}
+	if err := m.SelfTradePrevention.Validate(); err != nil {
+		errs = append(errs, err)
 	}
+	return errors.Join(errs...)
+}
 
-	if m.HasUpdates() {
-		for _, addrStr := range m.RevokeAll {
-			if _, err := sdk.AccAddressFromBech32(addrStr); err != nil {
-				errs = append(errs, fmt.Errorf("invalid revoke-all address %q: %w", addrStr, err))
-			}
-		}
+func (m MsgMarketUpdatePublishPricesRequest) ValidateBasic() error {
+	var errs []error
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		errs = append(errs, fmt.Errorf("invalid administrator %q: %w", m.Admin, err))
+	}
+	if m.MarketId == 0 {
+		errs = append(errs, errors.New("invalid market id: cannot be zero"))
+	}
+	return errors.Join(errs...)
+}
+
+func (m MsgMarketUpdateExternalIDScopeRequest) ValidateBasic() error {
+	var errs []error
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		errs = append(errs, fmt.Errorf("invalid administrator %q: %w", m.Admin, err))
+	}
+	if m.MarketId == 0 {
+		errs = append(errs, errors.New("invalid market id: cannot be zero"))
+	}
+	if err := m.ExternalIdScope.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+func (m MsgMarketUpdateOrderRateLimitRequest) ValidateBasic() error {
+	var errs []error
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		errs = append(errs, fmt.Errorf("invalid administrator %q: %w", m.Admin, err))
+	}
+	if m.MarketId == 0 {
+		errs = append(errs, errors.New("invalid market id: cannot be zero"))
+	}
+	if err := m.OrderRateLimit.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+func (m MsgMarketUpdateMaxExposureRequest) ValidateBasic() error {
+	var errs []error
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		errs = append(errs, fmt.Errorf("invalid administrator %q: %w", m.Admin, err))
+	}
+	if m.MarketId == 0 {
+		errs = append(errs, errors.New("invalid market id: cannot be zero"))
+	}
+	if err := ValidateMaxExposure(m.MaxExposure); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+func (m MsgMarketUpdateFillAlgorithmRequest) ValidateBasic() error {
+	var errs []error
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		errs = append(errs, fmt.Errorf("invalid administrator %q: %w", m.Admin, err))
+	}
+	if m.MarketId == 0 {
+		errs = append(errs, errors.New("invalid market id: cannot be zero"))
+	}
+	if err := m.FillAlgorithm.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+func (m MsgMarketUpdateIntermediaryDenomRequest) ValidateBasic() error {
+	var errs []error
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		errs = append(errs, fmt.Errorf("invalid administrator %q: %w", m.Admin, err))
+	}
+	if m.MarketId == 0 {
+		errs = append(errs, errors.New("invalid market id: cannot be zero"))
+	}
+	if err := ValidateIntermediaryDenom(m.IntermediaryDenom); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+func (m MsgMarketManagePermissionsRequest) ValidateBasic() error {
+	var errs []error
+
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		errs = append(errs, fmt.Errorf("invalid administrator %q: %w", m.Admin, err))
+	}
+
+	if m.MarketId == 0 {
+		errs = append(errs, fmt.Errorf("invalid market id: cannot be zero"))
+	}
+
+	if m.HasUpdates() {
+		for _, addrStr := range m.RevokeAll {
+			if _, err := sdk.AccAddressFromBech32(addrStr); err != nil {
+				errs = append(errs, fmt.Errorf("invalid revoke-all address %q: %w", addrStr, err))
+			}
+		}
 
 		if err := ValidateAccessGrantsField("to-revoke", m.ToRevoke); err != nil {
 			errs = append(errs, err)
@@ -549,6 +984,9 @@ func (m MsgMarketManagePermissionsRequest) ValidateBasic() error {
 			if ContainsString(m.RevokeAll, ag.Address) {
 				errs = append(errs, fmt.Errorf("address %s appears in both the revoke-all and to-revoke fields", ag.Address))
 			}
+			if ag.Expiration != nil {
+				errs = append(errs, fmt.Errorf("address %s to-revoke entry cannot have an expiration", ag.Address))
+			}
 			toRevokeByAddr[ag.Address] = ag
 		}
 
@@ -578,6 +1016,52 @@ func (m MsgMarketManagePermissionsRequest) HasUpdates() bool {
 	return len(m.RevokeAll) > 0 || len(m.ToRevoke) > 0 || len(m.ToGrant) > 0
 }
 
+func (m MsgMarketTransferAuthorityRequest) ValidateBasic() error {
+	var errs []error
+
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		errs = append(errs, fmt.Errorf("invalid administrator %q: %w", m.Admin, err))
+	}
+
+	if m.MarketId == 0 {
+		errs = append(errs, fmt.Errorf("invalid market id: cannot be zero"))
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.NewAuthority); err != nil {
+		errs = append(errs, fmt.Errorf("invalid new authority %q: %w", m.NewAuthority, err))
+	}
+
+	if err := ValidateAccessGrantsField("", m.AccessGrants); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+// ToMarketAuthorityTransfer creates the MarketAuthorityTransfer requested by this message.
+func (m MsgMarketTransferAuthorityRequest) ToMarketAuthorityTransfer() *MarketAuthorityTransfer {
+	return &MarketAuthorityTransfer{
+		MarketId:     m.MarketId,
+		ProposedBy:   m.Admin,
+		NewAuthority: m.NewAuthority,
+		AccessGrants: m.AccessGrants,
+	}
+}
+
+func (m MsgMarketAcceptAuthorityRequest) ValidateBasic() error {
+	var errs []error
+
+	if _, err := sdk.AccAddressFromBech32(m.NewAuthority); err != nil {
+		errs = append(errs, fmt.Errorf("invalid new authority %q: %w", m.NewAuthority, err))
+	}
+
+	if m.MarketId == 0 {
+		errs = append(errs, fmt.Errorf("invalid market id: cannot be zero"))
+	}
+
+	return errors.Join(errs...)
+}
+
 func (m MsgMarketManageReqAttrsRequest) ValidateBasic() error {
 	var errs []error
 
@@ -609,6 +1093,35 @@ func (m MsgMarketManageReqAttrsRequest) HasUpdates() bool {
 		len(m.CreateCommitmentToAdd) > 0 || len(m.CreateCommitmentToRemove) > 0
 }
 
+func (m MsgMarketManageAcceptedDenomsRequest) ValidateBasic() error {
+	var errs []error
+
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		errs = append(errs, fmt.Errorf("invalid administrator %q: %w", m.Admin, err))
+	}
+
+	if m.MarketId == 0 {
+		errs = append(errs, fmt.Errorf("invalid market id: cannot be zero"))
+	}
+
+	if m.HasUpdates() {
+		errs = append(errs,
+			ValidateAddRemoveAcceptedDenoms("asset", m.AssetDenomsToAdd, m.AssetDenomsToRemove),
+			ValidateAddRemoveAcceptedDenoms("price", m.PriceDenomsToAdd, m.PriceDenomsToRemove),
+		)
+	} else {
+		errs = append(errs, errors.New("no updates"))
+	}
+
+	return errors.Join(errs...)
+}
+
+// HasUpdates returns true if this has at least one accepted denom change, false if devoid of updates.
+func (m MsgMarketManageAcceptedDenomsRequest) HasUpdates() bool {
+	return len(m.AssetDenomsToAdd) > 0 || len(m.AssetDenomsToRemove) > 0 ||
+		len(m.PriceDenomsToAdd) > 0 || len(m.PriceDenomsToRemove) > 0
+}
+
 func (m MsgCreatePaymentRequest) ValidateBasic() error {
 	return m.Payment.Validate()
 }
@@ -624,6 +1137,51 @@ func (m MsgAcceptPaymentRequest) ValidateBasic() error {
 	return errors.Join(errs...)
 }
 
+func (m MsgAcceptPaymentsRequest) ValidateBasic() error {
+	var errs []error
+	if _, err := sdk.AccAddressFromBech32(m.Target); err != nil {
+		errs = append(errs, fmt.Errorf("invalid target %q: %w", m.Target, err))
+	}
+	if len(m.PaymentIds) == 0 && len(m.Sources) == 0 {
+		errs = append(errs, errors.New("at least one payment id or source is required"))
+	}
+	if len(m.PaymentIds) > MaxAcceptPaymentsPerMsg {
+		errs = append(errs, fmt.Errorf("too many payment ids %d: cannot have more than %d", len(m.PaymentIds), MaxAcceptPaymentsPerMsg))
+	}
+	knownIDs := make(map[PaymentID]bool)
+	for i, paymentID := range m.PaymentIds {
+		if knownIDs[paymentID] {
+			errs = append(errs, fmt.Errorf("invalid payment ids: duplicate entry %s %q", paymentID.Source, paymentID.ExternalId))
+			continue
+		}
+		knownIDs[paymentID] = true
+		if err := paymentID.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid payment ids[%d]: %w", i, err))
+		}
+	}
+	known := make(map[string]bool)
+	bad := make(map[string]bool)
+	for i, source := range m.Sources {
+		if bad[source] {
+			continue
+		}
+		if known[source] {
+			errs = append(errs, fmt.Errorf("invalid sources: duplicate entry %s", source))
+			bad[source] = true
+			continue
+		}
+		known[source] = true
+		if _, err := sdk.AccAddressFromBech32(source); err != nil {
+			errs = append(errs, fmt.Errorf("invalid sources[%d] %q: %w", i, source, err))
+			bad[source] = true
+		}
+	}
+	if err := m.MaxTargetAmount.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("invalid max target amount %q: %w", m.MaxTargetAmount, err))
+	}
+	return errors.Join(errs...)
+}
+
 func (m MsgRejectPaymentRequest) ValidateBasic() error {
 	var errs []error
 	if _, err := sdk.AccAddressFromBech32(m.Target); err != nil {
@@ -666,6 +1224,20 @@ func (m MsgRejectPaymentsRequest) ValidateBasic() error {
 	return errors.Join(errs...)
 }
 
+func (m MsgDisputePaymentRequest) ValidateBasic() error {
+	var errs []error
+	if _, err := sdk.AccAddressFromBech32(m.Target); err != nil {
+		errs = append(errs, fmt.Errorf("invalid target %q: %w", m.Target, err))
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Source); err != nil {
+		errs = append(errs, fmt.Errorf("invalid source %q: %w", m.Source, err))
+	}
+	if err := ValidateExternalID(m.ExternalId); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
 func (m MsgCancelPaymentsRequest) ValidateBasic() error {
 	var errs []error
 	if _, err := sdk.AccAddressFromBech32(m.Source); err != nil {
@@ -710,6 +1282,95 @@ func (m MsgChangePaymentTargetRequest) ValidateBasic() error {
 	return errors.Join(errs...)
 }
 
+func (m MsgCreateRecurringPaymentRequest) ValidateBasic() error {
+	var errs []error
+	if _, err := sdk.AccAddressFromBech32(m.Source); err != nil {
+		errs = append(errs, fmt.Errorf("invalid source %q: %w", m.Source, err))
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Target); err != nil {
+		errs = append(errs, fmt.Errorf("invalid target %q: %w", m.Target, err))
+	}
+	if err := validateRecurringPaymentAmount(m.Amount); err != nil {
+		errs = append(errs, err)
+	}
+	if err := ValidateExternalID(m.ExternalId); err != nil {
+		errs = append(errs, err)
+	}
+	if m.IntervalSeconds == 0 {
+		errs = append(errs, errors.New("invalid interval: cannot be zero"))
+	}
+	if m.Transfers == 0 {
+		errs = append(errs, errors.New("invalid transfers: cannot be zero"))
+	}
+	if m.StartTime != nil && m.StartTime.Unix() <= 0 {
+		errs = append(errs, fmt.Errorf("invalid start time %s: must be after %s",
+			m.StartTime.UTC().Format(time.RFC3339), time.Unix(0, 0).UTC().Format(time.RFC3339)))
+	}
+	return errors.Join(errs...)
+}
+
+func (m MsgCancelRecurringPaymentsRequest) ValidateBasic() error {
+	var errs []error
+	if _, err := sdk.AccAddressFromBech32(m.Source); err != nil {
+		errs = append(errs, fmt.Errorf("invalid source %q: %w", m.Source, err))
+	}
+	if len(m.ExternalIds) == 0 {
+		errs = append(errs, errors.New("at least one external id is required"))
+	}
+	known := make(map[string]bool)
+	bad := make(map[string]bool)
+	for i, externalID := range m.ExternalIds {
+		if bad[externalID] {
+			continue
+		}
+		if known[externalID] {
+			errs = append(errs, fmt.Errorf("invalid external ids: duplicate entry %q", externalID))
+			bad[externalID] = true
+			continue
+		}
+		known[externalID] = true
+		if err := ValidateExternalID(externalID); err != nil {
+			errs = append(errs, fmt.Errorf("invalid external ids[%d]: %w", i, err))
+			bad[externalID] = true
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (m MsgCreateMultiPartyPaymentRequest) ValidateBasic() error {
+	mpp := MultiPartyPayment{Creator: m.Creator, ExternalId: m.ExternalId, Parties: m.Parties}
+	return mpp.Validate()
+}
+
+func (m MsgAcceptMultiPartyPaymentRequest) ValidateBasic() error {
+	var errs []error
+	if _, err := sdk.AccAddressFromBech32(m.Party); err != nil {
+		errs = append(errs, fmt.Errorf("invalid party %q: %w", m.Party, err))
+	}
+	mpp := MultiPartyPayment{Creator: m.Creator, ExternalId: m.ExternalId, Parties: m.Parties}
+	if err := mpp.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if len(m.Party) > 0 && mpp.GetParty(m.Party) == nil {
+		errs = append(errs, fmt.Errorf("party %s is not one of the parties", m.Party))
+	}
+	return errors.Join(errs...)
+}
+
+func (m MsgCancelMultiPartyPaymentRequest) ValidateBasic() error {
+	var errs []error
+	if _, err := sdk.AccAddressFromBech32(m.Party); err != nil {
+		errs = append(errs, fmt.Errorf("invalid party %q: %w", m.Party, err))
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		errs = append(errs, fmt.Errorf("invalid creator %q: %w", m.Creator, err))
+	}
+	if err := ValidateExternalID(m.ExternalId); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
 func (m MsgGovCreateMarketRequest) ValidateBasic() error {
 	errs := make([]error, 0, 2)
 	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
@@ -737,10 +1398,17 @@ func (m MsgGovManageFeesRequest) ValidateBasic() error {
 			ValidateAddRemoveFeeOptions("seller settlement flat fee", m.AddFeeSellerSettlementFlat, m.RemoveFeeSellerSettlementFlat),
 			ValidateSellerFeeRatios(m.AddFeeSellerSettlementRatios),
 			ValidateDisjointFeeRatios("seller settlement fee", m.AddFeeSellerSettlementRatios, m.RemoveFeeSellerSettlementRatios),
+			ValidateSellerFeeRatios(m.AddFeeSellerSettlementTakerRatios),
+			ValidateDisjointFeeRatios("seller settlement taker fee", m.AddFeeSellerSettlementTakerRatios, m.RemoveFeeSellerSettlementTakerRatios),
+			ValidateRebateRatios(m.AddFeeSellerSettlementRebateRatios),
+			ValidateDisjointFeeRatios("seller settlement rebate fee", m.AddFeeSellerSettlementRebateRatios, m.RemoveFeeSellerSettlementRebateRatios),
+			ValidateAddRemoveRebateAddresses(m.AddRebateAddresses, m.RemoveRebateAddresses),
 			ValidateAddRemoveFeeOptions("buyer settlement flat fee", m.AddFeeBuyerSettlementFlat, m.RemoveFeeBuyerSettlementFlat),
 			ValidateBuyerFeeRatios(m.AddFeeBuyerSettlementRatios),
 			ValidateDisjointFeeRatios("buyer settlement fee", m.AddFeeBuyerSettlementRatios, m.RemoveFeeBuyerSettlementRatios),
 			ValidateBips("commitment settlement", m.SetFeeCommitmentSettlementBips),
+			ValidateBips("referral", m.SetReferralBips),
+			ValidateAddRemoveFeeOptions("referral cap", m.AddReferralCap, m.RemoveReferralCap),
 		)
 
 		if m.UnsetFeeCommitmentSettlementBips && m.SetFeeCommitmentSettlementBips > 0 {
@@ -748,23 +1416,68 @@ func (m MsgGovManageFeesRequest) ValidateBasic() error {
 				"invalid commitment settlement bips %d: must be zero when unset_fee_commitment_settlement_bips is true",
 				m.SetFeeCommitmentSettlementBips))
 		}
+		if m.UnsetReferralBips && m.SetReferralBips > 0 {
+			errs = append(errs, fmt.Errorf(
+				"invalid referral bips %d: must be zero when unset_referral_bips is true", m.SetReferralBips))
+		}
 	} else {
 		errs = append(errs, errors.New("no updates"))
 	}
 
+	if m.EffectiveHeight < 0 {
+		errs = append(errs, fmt.Errorf("invalid effective height %d: cannot be negative", m.EffectiveHeight))
+	}
+	if m.EffectiveHeight != 0 && m.EffectiveTime != nil {
+		errs = append(errs, errors.New("cannot provide both an effective height and an effective time"))
+	}
+
 	return errors.Join(errs...)
 }
 
+// IsScheduled returns true if these fee changes should be applied later instead of immediately.
+func (m MsgGovManageFeesRequest) IsScheduled() bool {
+	return m.EffectiveHeight != 0 || m.EffectiveTime != nil
+}
+
+// IsDue returns true if these fee changes should be applied at the provided block height and time.
+func (m MsgGovManageFeesRequest) IsDue(height int64, blockTime time.Time) bool {
+	if m.EffectiveTime != nil {
+		return !blockTime.Before(*m.EffectiveTime)
+	}
+	return height >= m.EffectiveHeight
+}
+
 // HasUpdates returns true if this has at least one fee change, false if devoid of updates.
 func (m MsgGovManageFeesRequest) HasUpdates() bool {
 	return len(m.AddFeeCreateAskFlat) > 0 || len(m.RemoveFeeCreateAskFlat) > 0 ||
 		len(m.AddFeeCreateBidFlat) > 0 || len(m.RemoveFeeCreateBidFlat) > 0 ||
 		len(m.AddFeeSellerSettlementFlat) > 0 || len(m.RemoveFeeSellerSettlementFlat) > 0 ||
 		len(m.AddFeeSellerSettlementRatios) > 0 || len(m.RemoveFeeSellerSettlementRatios) > 0 ||
+		len(m.AddFeeSellerSettlementTakerRatios) > 0 || len(m.RemoveFeeSellerSettlementTakerRatios) > 0 ||
+		len(m.AddFeeSellerSettlementRebateRatios) > 0 || len(m.RemoveFeeSellerSettlementRebateRatios) > 0 ||
+		len(m.AddRebateAddresses) > 0 || len(m.RemoveRebateAddresses) > 0 ||
 		len(m.AddFeeBuyerSettlementFlat) > 0 || len(m.RemoveFeeBuyerSettlementFlat) > 0 ||
 		len(m.AddFeeBuyerSettlementRatios) > 0 || len(m.RemoveFeeBuyerSettlementRatios) > 0 ||
 		len(m.AddFeeCreateCommitmentFlat) > 0 || len(m.RemoveFeeCreateCommitmentFlat) > 0 ||
-		m.SetFeeCommitmentSettlementBips != 0 || m.UnsetFeeCommitmentSettlementBips
+		m.SetFeeCommitmentSettlementBips != 0 || m.UnsetFeeCommitmentSettlementBips ||
+		m.SetReferralBips != 0 || m.UnsetReferralBips ||
+		len(m.AddReferralCap) > 0 || len(m.RemoveReferralCap) > 0
+}
+
+func (m MsgGovManageExchangeSplitsRequest) ValidateBasic() error {
+	var errs []error
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		errs = append(errs, fmt.Errorf("invalid authority %q: %w", m.Authority, err))
+	}
+	if m.MarketId == 0 {
+		errs = append(errs, errors.New("invalid market id: cannot be zero"))
+	}
+	if len(m.SetExchangeSplits) == 0 && len(m.RemoveExchangeSplits) == 0 {
+		errs = append(errs, errors.New("no updates"))
+	} else if err := ValidateAddRemoveExchangeSplits(m.SetExchangeSplits, m.RemoveExchangeSplits); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
 }
 
 func (m MsgGovCloseMarketRequest) ValidateBasic() error {
@@ -778,6 +1491,17 @@ func (m MsgGovCloseMarketRequest) ValidateBasic() error {
 	return errors.Join(errs...)
 }
 
+func (m MsgGovWindDownMarketRequest) ValidateBasic() error {
+	var errs []error
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		errs = append(errs, fmt.Errorf("invalid authority %q: %w", m.Authority, err))
+	}
+	if m.MarketId == 0 {
+		errs = append(errs, errors.New("invalid market id: cannot be zero"))
+	}
+	return errors.Join(errs...)
+}
+
 func (m MsgGovUpdateParamsRequest) ValidateBasic() error {
 	return errors.New("deprecated and unusable")
 }
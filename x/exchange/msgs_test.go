@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -33,14 +34,26 @@ func TestAllMsgsGetSigners(t *testing.T) {
 	msgMakers := []testutil.MsgMaker{
 		func(signer string) sdk.Msg { return &MsgCreateAskRequest{AskOrder: AskOrder{Seller: signer}} },
 		func(signer string) sdk.Msg { return &MsgCreateBidRequest{BidOrder: BidOrder{Buyer: signer}} },
+		func(signer string) sdk.Msg { return &MsgCreateTriggerAskRequest{AskOrder: AskOrder{Seller: signer}} },
+		func(signer string) sdk.Msg { return &MsgCreateTriggerBidRequest{BidOrder: BidOrder{Buyer: signer}} },
+		func(signer string) sdk.Msg { return &MsgCreateOrdersBatchRequest{Owner: signer} },
 		func(signer string) sdk.Msg { return &MsgCommitFundsRequest{Account: signer} },
+		func(signer string) sdk.Msg { return &MsgTransferCommitmentRequest{Account: signer} },
+		func(signer string) sdk.Msg { return &MsgFundCommitmentRewardsRequest{Funder: signer} },
+		func(signer string) sdk.Msg { return &MsgClaimCommitmentRewardsRequest{Account: signer} },
 		func(signer string) sdk.Msg { return &MsgCancelOrderRequest{Signer: signer} },
+		func(signer string) sdk.Msg { return &MsgAmendOrderRequest{Owner: signer} },
+		func(signer string) sdk.Msg { return &MsgLinkOrdersRequest{Owner: signer} },
+		func(signer string) sdk.Msg { return &MsgSetDeadManSwitchRequest{Account: signer} },
+		func(signer string) sdk.Msg { return &MsgHeartbeatRequest{Account: signer} },
 		func(signer string) sdk.Msg { return &MsgFillBidsRequest{Seller: signer} },
 		func(signer string) sdk.Msg { return &MsgFillAsksRequest{Buyer: signer} },
 		func(signer string) sdk.Msg { return &MsgMarketSettleRequest{Admin: signer} },
 		func(signer string) sdk.Msg { return &MsgMarketCommitmentSettleRequest{Admin: signer} },
 		func(signer string) sdk.Msg { return &MsgMarketReleaseCommitmentsRequest{Admin: signer} },
 		func(signer string) sdk.Msg { return &MsgMarketTransferCommitmentRequest{Admin: signer} },
+		func(signer string) sdk.Msg { return &MsgMarketDistributeCommitmentRewardsRequest{Admin: signer} },
+		func(signer string) sdk.Msg { return &MsgMarketBulkCancelRequest{Admin: signer} },
 		func(signer string) sdk.Msg { return &MsgMarketSetOrderExternalIDRequest{Admin: signer} },
 		func(signer string) sdk.Msg { return &MsgMarketWithdrawRequest{Admin: signer} },
 		func(signer string) sdk.Msg { return &MsgMarketUpdateDetailsRequest{Admin: signer} },
@@ -48,18 +61,38 @@ func TestAllMsgsGetSigners(t *testing.T) {
 		func(signer string) sdk.Msg { return &MsgMarketUpdateAcceptingOrdersRequest{Admin: signer} },
 		func(signer string) sdk.Msg { return &MsgMarketUpdateUserSettleRequest{Admin: signer} },
 		func(signer string) sdk.Msg { return &MsgMarketUpdateAcceptingCommitmentsRequest{Admin: signer} },
+		func(signer string) sdk.Msg { return &MsgMarketUpdateContinuousMatchingRequest{Admin: signer} },
+		func(signer string) sdk.Msg { return &MsgMarketUpdateBatchAuctionRequest{Admin: signer} },
+		func(signer string) sdk.Msg { return &MsgMarketUpdateSelfTradePreventionRequest{Admin: signer} },
+		func(signer string) sdk.Msg { return &MsgMarketUpdatePublishPricesRequest{Admin: signer} },
+		func(signer string) sdk.Msg { return &MsgMarketUpdateExternalIDScopeRequest{Admin: signer} },
+		func(signer string) sdk.Msg { return &MsgMarketUpdateOrderRateLimitRequest{Admin: signer} },
+		func(signer string) sdk.Msg { return &MsgMarketUpdateMaxExposureRequest{Admin: signer} },
+		func(signer string) sdk.Msg { return &MsgMarketUpdateFillAlgorithmRequest{Admin: signer} },
 		func(signer string) sdk.Msg { return &MsgMarketUpdateIntermediaryDenomRequest{Admin: signer} },
 		func(signer string) sdk.Msg { return &MsgMarketManagePermissionsRequest{Admin: signer} },
+		func(signer string) sdk.Msg { return &MsgMarketTransferAuthorityRequest{Admin: signer} },
+		func(signer string) sdk.Msg { return &MsgMarketAcceptAuthorityRequest{NewAuthority: signer} },
 		func(signer string) sdk.Msg { return &MsgMarketManageReqAttrsRequest{Admin: signer} },
+		func(signer string) sdk.Msg { return &MsgMarketManageAcceptedDenomsRequest{Admin: signer} },
 		func(signer string) sdk.Msg { return &MsgCreatePaymentRequest{Payment: Payment{Source: signer}} },
 		func(signer string) sdk.Msg { return &MsgAcceptPaymentRequest{Payment: Payment{Target: signer}} },
+		func(signer string) sdk.Msg { return &MsgAcceptPaymentsRequest{Target: signer} },
 		func(signer string) sdk.Msg { return &MsgRejectPaymentRequest{Target: signer} },
 		func(signer string) sdk.Msg { return &MsgRejectPaymentsRequest{Target: signer} },
+		func(signer string) sdk.Msg { return &MsgDisputePaymentRequest{Target: signer} },
 		func(signer string) sdk.Msg { return &MsgCancelPaymentsRequest{Source: signer} },
 		func(signer string) sdk.Msg { return &MsgChangePaymentTargetRequest{Source: signer} },
+		func(signer string) sdk.Msg { return &MsgCreateRecurringPaymentRequest{Source: signer} },
+		func(signer string) sdk.Msg { return &MsgCancelRecurringPaymentsRequest{Source: signer} },
+		func(signer string) sdk.Msg { return &MsgCreateMultiPartyPaymentRequest{Creator: signer} },
+		func(signer string) sdk.Msg { return &MsgAcceptMultiPartyPaymentRequest{Party: signer} },
+		func(signer string) sdk.Msg { return &MsgCancelMultiPartyPaymentRequest{Party: signer} },
 		func(signer string) sdk.Msg { return &MsgGovCreateMarketRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgGovManageFeesRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgGovManageExchangeSplitsRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgGovCloseMarketRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgGovWindDownMarketRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgGovUpdateParamsRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgUpdateParamsRequest{Authority: signer} },
 	}
@@ -255,6 +288,33 @@ func TestMsgCreateAskRequest_ValidateBasic(t *testing.T) {
 			},
 			expErr: []string{"invalid order creation fee: negative coin amount: -3"},
 		},
+		{
+			name: "max length idempotency key",
+			msg: MsgCreateAskRequest{
+				AskOrder: AskOrder{
+					MarketId: 1,
+					Seller:   sdk.AccAddress("seller______________").String(),
+					Assets:   sdk.NewInt64Coin("banana", 99),
+					Price:    sdk.NewInt64Coin("acorn", 12),
+				},
+				IdempotencyKey: strings.Repeat("k", MaxIdempotencyKeyLength),
+			},
+			expErr: nil,
+		},
+		{
+			name: "idempotency key too long",
+			msg: MsgCreateAskRequest{
+				AskOrder: AskOrder{
+					MarketId: 1,
+					Seller:   sdk.AccAddress("seller______________").String(),
+					Assets:   sdk.NewInt64Coin("banana", 99),
+					Price:    sdk.NewInt64Coin("acorn", 12),
+				},
+				IdempotencyKey: strings.Repeat("k", MaxIdempotencyKeyLength+1),
+			},
+			expErr: []string{fmt.Sprintf("invalid idempotency key %q (length %d): max length %d",
+				"kkkkk...kkkkk", MaxIdempotencyKeyLength+1, MaxIdempotencyKeyLength)},
+		},
 	}
 
 	for _, tc := range tests {
@@ -293,6 +353,279 @@ func TestMsgCreateBidRequest_ValidateBasic(t *testing.T) {
 			},
 			expErr: []string{"invalid order creation fee: negative coin amount: -3"},
 		},
+		{
+			name: "max length idempotency key",
+			msg: MsgCreateBidRequest{
+				BidOrder: BidOrder{
+					MarketId: 1,
+					Buyer:    sdk.AccAddress("buyer_______________").String(),
+					Assets:   sdk.NewInt64Coin("banana", 99),
+					Price:    sdk.NewInt64Coin("acorn", 12),
+				},
+				IdempotencyKey: strings.Repeat("k", MaxIdempotencyKeyLength),
+			},
+			expErr: nil,
+		},
+		{
+			name: "idempotency key too long",
+			msg: MsgCreateBidRequest{
+				BidOrder: BidOrder{
+					MarketId: 1,
+					Buyer:    sdk.AccAddress("buyer_______________").String(),
+					Assets:   sdk.NewInt64Coin("banana", 99),
+					Price:    sdk.NewInt64Coin("acorn", 12),
+				},
+				IdempotencyKey: strings.Repeat("k", MaxIdempotencyKeyLength+1),
+			},
+			expErr: []string{fmt.Sprintf("invalid idempotency key %q (length %d): max length %d",
+				"kkkkk...kkkkk", MaxIdempotencyKeyLength+1, MaxIdempotencyKeyLength)},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
+func TestMsgCreateTriggerAskRequest_ValidateBasic(t *testing.T) {
+	askOrder := AskOrder{
+		MarketId: 1,
+		Seller:   sdk.AccAddress("seller______________").String(),
+		Assets:   sdk.NewInt64Coin("banana", 99),
+		Price:    sdk.NewInt64Coin("acorn", 12),
+	}
+
+	tests := []struct {
+		name   string
+		msg    MsgCreateTriggerAskRequest
+		expErr []string
+	}{
+		{
+			name: "okay",
+			msg: MsgCreateTriggerAskRequest{
+				AskOrder:         askOrder,
+				TriggerPrice:     sdk.NewInt64Coin("acorn", 10),
+				OrderCreationFee: &sdk.Coin{Denom: "cactus", Amount: sdkmath.NewInt(3)},
+			},
+		},
+		{
+			name: "empty ask order",
+			msg:  MsgCreateTriggerAskRequest{TriggerPrice: sdk.NewInt64Coin("acorn", 10)},
+			expErr: []string{
+				"invalid market id: ",
+				"invalid seller: ",
+				"invalid price: ",
+				"invalid assets: ",
+			},
+		},
+		{
+			name:   "no trigger price",
+			msg:    MsgCreateTriggerAskRequest{AskOrder: askOrder},
+			expErr: []string{"invalid trigger price: invalid denom: "},
+		},
+		{
+			name: "zero trigger price",
+			msg: MsgCreateTriggerAskRequest{
+				AskOrder:     askOrder,
+				TriggerPrice: sdk.NewInt64Coin("acorn", 0),
+			},
+			expErr: []string{"invalid trigger price: cannot be zero"},
+		},
+		{
+			name: "trigger price denom different from price denom",
+			msg: MsgCreateTriggerAskRequest{
+				AskOrder:     askOrder,
+				TriggerPrice: sdk.NewInt64Coin("banana", 10),
+			},
+			expErr: []string{"invalid trigger price \"10banana\": denom must match order price denom \"acorn\""},
+		},
+		{
+			name: "invalid fees",
+			msg: MsgCreateTriggerAskRequest{
+				AskOrder:         askOrder,
+				TriggerPrice:     sdk.NewInt64Coin("acorn", 10),
+				OrderCreationFee: &sdk.Coin{Denom: "cactus", Amount: sdkmath.NewInt(-3)},
+			},
+			expErr: []string{"invalid order creation fee: negative coin amount: -3"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
+func TestMsgCreateTriggerBidRequest_ValidateBasic(t *testing.T) {
+	bidOrder := BidOrder{
+		MarketId: 1,
+		Buyer:    sdk.AccAddress("buyer_______________").String(),
+		Assets:   sdk.NewInt64Coin("banana", 99),
+		Price:    sdk.NewInt64Coin("acorn", 12),
+	}
+
+	tests := []struct {
+		name   string
+		msg    MsgCreateTriggerBidRequest
+		expErr []string
+	}{
+		{
+			name: "okay",
+			msg: MsgCreateTriggerBidRequest{
+				BidOrder:         bidOrder,
+				TriggerPrice:     sdk.NewInt64Coin("acorn", 15),
+				OrderCreationFee: &sdk.Coin{Denom: "cactus", Amount: sdkmath.NewInt(3)},
+			},
+		},
+		{
+			name: "empty bid order",
+			msg:  MsgCreateTriggerBidRequest{TriggerPrice: sdk.NewInt64Coin("acorn", 10)},
+			expErr: []string{
+				"invalid market id: ",
+				"invalid buyer: ",
+				"invalid price: ",
+				"invalid assets: ",
+			},
+		},
+		{
+			name: "zero trigger price",
+			msg: MsgCreateTriggerBidRequest{
+				BidOrder:     bidOrder,
+				TriggerPrice: sdk.NewInt64Coin("acorn", 0),
+			},
+			expErr: []string{"invalid trigger price: cannot be zero"},
+		},
+		{
+			name: "trigger price denom different from price denom",
+			msg: MsgCreateTriggerBidRequest{
+				BidOrder:     bidOrder,
+				TriggerPrice: sdk.NewInt64Coin("cherry", 10),
+			},
+			expErr: []string{"invalid trigger price \"10cherry\": denom must match order price denom \"acorn\""},
+		},
+		{
+			name: "invalid fees",
+			msg: MsgCreateTriggerBidRequest{
+				BidOrder:         bidOrder,
+				TriggerPrice:     sdk.NewInt64Coin("acorn", 15),
+				OrderCreationFee: &sdk.Coin{Denom: "cactus", Amount: sdkmath.NewInt(-3)},
+			},
+			expErr: []string{"invalid order creation fee: negative coin amount: -3"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
+func TestMsgCreateOrdersBatchRequest_ValidateBasic(t *testing.T) {
+	owner := sdk.AccAddress("owner_______________").String()
+	askOrder := AskOrder{
+		MarketId: 3,
+		Seller:   owner,
+		Assets:   sdk.NewInt64Coin("apple", 10),
+		Price:    sdk.NewInt64Coin("plum", 20),
+	}
+	bidOrder := BidOrder{
+		MarketId: 3,
+		Buyer:    owner,
+		Assets:   sdk.NewInt64Coin("apple", 10),
+		Price:    sdk.NewInt64Coin("plum", 18),
+	}
+	other := sdk.AccAddress("other_______________").String()
+
+	tests := []struct {
+		name   string
+		msg    MsgCreateOrdersBatchRequest
+		expErr []string
+	}{
+		{
+			name: "okay: asks and bids",
+			msg: MsgCreateOrdersBatchRequest{
+				Owner:               owner,
+				MarketId:            3,
+				AskOrders:           []AskOrder{askOrder, askOrder},
+				BidOrders:           []BidOrder{bidOrder},
+				AskOrderCreationFee: &sdk.Coin{Denom: "cactus", Amount: sdkmath.NewInt(3)},
+				BidOrderCreationFee: &sdk.Coin{Denom: "cactus", Amount: sdkmath.NewInt(4)},
+			},
+		},
+		{
+			name: "okay: only asks",
+			msg:  MsgCreateOrdersBatchRequest{Owner: owner, MarketId: 3, AskOrders: []AskOrder{askOrder}},
+		},
+		{
+			name: "okay: only bids",
+			msg:  MsgCreateOrdersBatchRequest{Owner: owner, MarketId: 3, BidOrders: []BidOrder{bidOrder}},
+		},
+		{
+			name: "empty",
+			msg:  MsgCreateOrdersBatchRequest{},
+			expErr: []string{
+				"invalid owner: ",
+				"invalid market id: cannot be zero",
+				"no orders provided",
+			},
+		},
+		{
+			name: "invalid orders",
+			msg: MsgCreateOrdersBatchRequest{
+				Owner:     owner,
+				MarketId:  3,
+				AskOrders: []AskOrder{askOrder, {MarketId: 3, Seller: owner}},
+				BidOrders: []BidOrder{{MarketId: 3, Buyer: owner}},
+			},
+			expErr: []string{
+				"invalid ask order [1]: ",
+				"invalid bid order [0]: ",
+			},
+		},
+		{
+			name: "orders for other owner",
+			msg: MsgCreateOrdersBatchRequest{
+				Owner:     owner,
+				MarketId:  3,
+				AskOrders: []AskOrder{{MarketId: 3, Seller: other, Assets: askOrder.Assets, Price: askOrder.Price}},
+				BidOrders: []BidOrder{bidOrder, {MarketId: 3, Buyer: other, Assets: bidOrder.Assets, Price: bidOrder.Price}},
+			},
+			expErr: []string{
+				"ask order [0] seller \"" + other + "\" does not equal owner \"" + owner + "\"",
+				"bid order [1] buyer \"" + other + "\" does not equal owner \"" + owner + "\"",
+			},
+		},
+		{
+			name: "orders for other market",
+			msg: MsgCreateOrdersBatchRequest{
+				Owner:     owner,
+				MarketId:  4,
+				AskOrders: []AskOrder{askOrder},
+				BidOrders: []BidOrder{bidOrder},
+			},
+			expErr: []string{
+				"ask order [0] market id 3 does not equal market id 4",
+				"bid order [0] market id 3 does not equal market id 4",
+			},
+		},
+		{
+			name: "invalid fees",
+			msg: MsgCreateOrdersBatchRequest{
+				Owner:               owner,
+				MarketId:            3,
+				AskOrders:           []AskOrder{askOrder},
+				AskOrderCreationFee: &sdk.Coin{Denom: "cactus", Amount: sdkmath.NewInt(-3)},
+				BidOrderCreationFee: &sdk.Coin{Denom: "cactus", Amount: sdkmath.NewInt(0)},
+			},
+			expErr: []string{
+				"invalid ask order creation fee: negative coin amount: -3",
+				"invalid bid order creation fee: cactus amount cannot be zero",
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -303,6 +636,9 @@ func TestMsgCreateBidRequest_ValidateBasic(t *testing.T) {
 }
 
 func TestMsgCommitFundsRequest_ValidateBasic(t *testing.T) {
+	epoch := time.Unix(0, 0).UTC()
+	future := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+
 	tests := []struct {
 		name   string
 		msg    MsgCommitFundsRequest
@@ -325,6 +661,7 @@ func TestMsgCommitFundsRequest_ValidateBasic(t *testing.T) {
 				Amount:      sdk.Coins{sdk.NewInt64Coin("cherry", 52)},
 				CreationFee: &sdk.Coin{Denom: "fig", Amount: sdkmath.NewInt(8)},
 				EventTag:    "just-some-tag",
+				Expiration:  &future,
 			},
 			expErr: nil,
 		},
@@ -382,6 +719,16 @@ func TestMsgCommitFundsRequest_ValidateBasic(t *testing.T) {
 			},
 			expErr: []string{"invalid amount \"-3cherry\": coin -3cherry amount is not positive"},
 		},
+		{
+			name: "expiration at epoch",
+			msg: MsgCommitFundsRequest{
+				Account:    sdk.AccAddress("account_____________").String(),
+				MarketId:   1,
+				Amount:     sdk.Coins{sdk.NewInt64Coin("cherry", 52)},
+				Expiration: &epoch,
+			},
+			expErr: []string{"invalid expiration 1970-01-01T00:00:00Z: must be after 1970-01-01T00:00:00Z"},
+		},
 		{
 			name: "bad creation fee",
 			msg: MsgCommitFundsRequest{
@@ -425,227 +772,205 @@ func TestMsgCommitFundsRequest_ValidateBasic(t *testing.T) {
 	}
 }
 
-func TestMsgCancelOrderRequest_ValidateBasic(t *testing.T) {
+func TestMsgTransferCommitmentRequest_ValidateBasic(t *testing.T) {
+	account := sdk.AccAddress("account_____________").String()
 	tests := []struct {
 		name   string
-		msg    MsgCancelOrderRequest
+		msg    MsgTransferCommitmentRequest
 		expErr []string
 	}{
 		{
-			name: "control",
-			msg: MsgCancelOrderRequest{
-				Signer:  sdk.AccAddress("signer______________").String(),
-				OrderId: 1,
+			name: "okay",
+			msg: MsgTransferCommitmentRequest{
+				Account:         account,
+				Amount:          sdk.Coins{sdk.NewInt64Coin("cherry", 52)},
+				CurrentMarketId: 1,
+				NewMarketId:     2,
 			},
 			expErr: nil,
 		},
 		{
-			name: "missing owner",
-			msg: MsgCancelOrderRequest{
-				Signer:  "",
-				OrderId: 1,
+			name: "okay with event tag",
+			msg: MsgTransferCommitmentRequest{
+				Account:         account,
+				Amount:          sdk.Coins{sdk.NewInt64Coin("cherry", 52)},
+				CurrentMarketId: 2,
+				NewMarketId:     1,
+				EventTag:        "just-some-tag",
 			},
-			expErr: []string{"invalid signer: ", emptyAddrErr},
+			expErr: nil,
 		},
 		{
-			name: "invalid owner",
-			msg: MsgCancelOrderRequest{
-				Signer:  "notgonnawork",
-				OrderId: 1,
+			name: "no account",
+			msg: MsgTransferCommitmentRequest{
+				Account:         "",
+				Amount:          sdk.Coins{sdk.NewInt64Coin("cherry", 52)},
+				CurrentMarketId: 1,
+				NewMarketId:     2,
 			},
-			expErr: []string{"invalid signer: ", bech32Err + "invalid separator index -1"},
+			expErr: []string{"invalid account \"\": " + emptyAddrErr},
 		},
 		{
-			name: "order 0",
-			msg: MsgCancelOrderRequest{
-				Signer:  sdk.AccAddress("valid_signer________").String(),
-				OrderId: 0,
+			name: "bad account",
+			msg: MsgTransferCommitmentRequest{
+				Account:         "badaccountstring",
+				Amount:          sdk.Coins{sdk.NewInt64Coin("cherry", 52)},
+				CurrentMarketId: 1,
+				NewMarketId:     2,
 			},
-			expErr: []string{"invalid order id: cannot be zero"},
+			expErr: []string{"invalid account \"badaccountstring\": " + bech32Err},
 		},
-	}
-
-	for _, tc := range tests {
-		t.Run(tc.name, func(t *testing.T) {
-			testValidateBasic(t, &tc.msg, tc.expErr)
-		})
-	}
-}
-
-func TestMsgFillBidsRequest_ValidateBasic(t *testing.T) {
-	coin := func(amount int64, denom string) *sdk.Coin {
-		return &sdk.Coin{Denom: denom, Amount: sdkmath.NewInt(amount)}
-	}
-	seller := sdk.AccAddress("seller______________").String()
-
-	tests := []struct {
-		name   string
-		msg    MsgFillBidsRequest
-		expErr []string
-	}{
 		{
-			name: "control",
-			msg: MsgFillBidsRequest{
-				Seller:                  seller,
-				MarketId:                1,
-				TotalAssets:             sdk.Coins{*coin(3, "acorn")},
-				BidOrderIds:             []uint64{1, 2, 3},
-				SellerSettlementFlatFee: coin(2, "banana"),
-				AskOrderCreationFee:     coin(8, "cactus"),
+			name: "current market zero",
+			msg: MsgTransferCommitmentRequest{
+				Account:         account,
+				Amount:          sdk.Coins{sdk.NewInt64Coin("cherry", 52)},
+				CurrentMarketId: 0,
+				NewMarketId:     2,
 			},
-			expErr: nil,
+			expErr: []string{"invalid current market id: cannot be zero"},
 		},
 		{
-			name: "empty seller",
-			msg: MsgFillBidsRequest{
-				Seller:      "",
-				MarketId:    1,
-				TotalAssets: sdk.Coins{*coin(3, "acorn")},
-				BidOrderIds: []uint64{1},
-			},
-			expErr: []string{"invalid seller", emptyAddrErr},
-		},
-		{
-			name: "bad seller",
-			msg: MsgFillBidsRequest{
-				Seller:      "not-an-address",
-				MarketId:    1,
-				TotalAssets: sdk.Coins{*coin(3, "acorn")},
-				BidOrderIds: []uint64{1},
+			name: "new market zero",
+			msg: MsgTransferCommitmentRequest{
+				Account:         account,
+				Amount:          sdk.Coins{sdk.NewInt64Coin("cherry", 52)},
+				CurrentMarketId: 1,
+				NewMarketId:     0,
 			},
-			expErr: []string{"invalid seller", bech32Err},
+			expErr: []string{"invalid new market id: cannot be zero"},
 		},
 		{
-			name: "market id zero",
-			msg: MsgFillBidsRequest{
-				Seller:      seller,
-				MarketId:    0,
-				TotalAssets: sdk.Coins{*coin(3, "acorn")},
-				BidOrderIds: []uint64{1},
+			name: "same markets",
+			msg: MsgTransferCommitmentRequest{
+				Account:         account,
+				Amount:          sdk.Coins{sdk.NewInt64Coin("cherry", 52)},
+				CurrentMarketId: 3,
+				NewMarketId:     3,
 			},
-			expErr: []string{"invalid market id", "cannot be zero"},
+			expErr: []string{"invalid new market id: cannot be the same as current market id"},
 		},
 		{
-			name: "nil total assets",
-			msg: MsgFillBidsRequest{
-				Seller:      seller,
-				MarketId:    1,
-				TotalAssets: nil,
-				BidOrderIds: []uint64{1},
+			name: "empty amount",
+			msg: MsgTransferCommitmentRequest{
+				Account:         account,
+				Amount:          sdk.Coins{},
+				CurrentMarketId: 1,
+				NewMarketId:     2,
 			},
-			expErr: []string{"invalid total assets", "cannot be zero"},
+			expErr: []string{"invalid amount \"\": cannot be zero"},
 		},
 		{
-			name: "empty total assets",
-			msg: MsgFillBidsRequest{
-				Seller:      seller,
-				MarketId:    1,
-				TotalAssets: sdk.Coins{},
-				BidOrderIds: []uint64{1},
+			name: "bad amount",
+			msg: MsgTransferCommitmentRequest{
+				Account:         account,
+				Amount:          sdk.Coins{sdk.Coin{Denom: "cherry", Amount: sdkmath.NewInt(-3)}},
+				CurrentMarketId: 1,
+				NewMarketId:     2,
 			},
-			expErr: []string{"invalid total assets", "cannot be zero"},
+			expErr: []string{"invalid amount \"-3cherry\": coin -3cherry amount is not positive"},
 		},
 		{
-			name: "invalid total assets",
-			msg: MsgFillBidsRequest{
-				Seller:      seller,
-				MarketId:    1,
-				TotalAssets: sdk.Coins{*coin(-1, "acorn")},
-				BidOrderIds: []uint64{1},
+			name: "event tag too long",
+			msg: MsgTransferCommitmentRequest{
+				Account:         account,
+				Amount:          sdk.Coins{sdk.NewInt64Coin("cherry", 52)},
+				CurrentMarketId: 1,
+				NewMarketId:     2,
+				EventTag:        strings.Repeat("p", 100) + "x",
 			},
-			expErr: []string{"invalid total assets", "coin -1acorn amount is not positive"},
+			expErr: []string{"invalid event tag \"ppppp...ppppx\" (length 101): exceeds max length 100"},
 		},
 		{
-			name: "nil order ids",
-			msg: MsgFillBidsRequest{
-				Seller:      seller,
-				MarketId:    1,
-				TotalAssets: sdk.Coins{*coin(1, "acorn")},
-				BidOrderIds: nil,
+			name: "multiple errors",
+			msg: MsgTransferCommitmentRequest{
+				EventTag: strings.Repeat("p", 100) + "x",
+			},
+			expErr: []string{
+				"invalid account \"\": " + emptyAddrErr,
+				"invalid current market id: cannot be zero",
+				"invalid new market id: cannot be zero",
+				"invalid amount \"\": cannot be zero",
+				"invalid event tag \"ppppp...ppppx\" (length 101): exceeds max length 100",
 			},
-			expErr: []string{"no bid order ids provided"},
 		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
+func TestMsgFundCommitmentRewardsRequest_ValidateBasic(t *testing.T) {
+	funder := sdk.AccAddress("funder______________").String()
+	tests := []struct {
+		name   string
+		msg    MsgFundCommitmentRewardsRequest
+		expErr []string
+	}{
 		{
-			name: "order id zero",
-			msg: MsgFillBidsRequest{
-				Seller:      seller,
-				MarketId:    1,
-				TotalAssets: sdk.Coins{*coin(1, "acorn")},
-				BidOrderIds: []uint64{0},
+			name: "okay",
+			msg: MsgFundCommitmentRewardsRequest{
+				Funder:   funder,
+				MarketId: 1,
+				Amount:   sdk.Coins{sdk.NewInt64Coin("cherry", 52)},
 			},
-			expErr: []string{"invalid bid order ids: cannot contain order id zero"},
+			expErr: nil,
 		},
 		{
-			name: "duplicate order ids",
-			msg: MsgFillBidsRequest{
-				Seller:      seller,
-				MarketId:    1,
-				TotalAssets: sdk.Coins{*coin(1, "acorn")},
-				BidOrderIds: []uint64{1, 2, 1},
+			name: "no funder",
+			msg: MsgFundCommitmentRewardsRequest{
+				Funder:   "",
+				MarketId: 1,
+				Amount:   sdk.Coins{sdk.NewInt64Coin("cherry", 52)},
 			},
-			expErr: []string{"duplicate bid order ids provided: [1]"},
+			expErr: []string{"invalid funder \"\": " + emptyAddrErr},
 		},
 		{
-			name: "invalid seller settlement flat fee",
-			msg: MsgFillBidsRequest{
-				Seller:                  seller,
-				MarketId:                1,
-				TotalAssets:             sdk.Coins{*coin(1, "acorn")},
-				BidOrderIds:             []uint64{1},
-				SellerSettlementFlatFee: coin(-1, "catan"),
+			name: "bad funder",
+			msg: MsgFundCommitmentRewardsRequest{
+				Funder:   "badfunderstring",
+				MarketId: 1,
+				Amount:   sdk.Coins{sdk.NewInt64Coin("cherry", 52)},
 			},
-			expErr: []string{"invalid seller settlement flat fee", "negative coin amount: -1"},
+			expErr: []string{"invalid funder \"badfunderstring\": " + bech32Err},
 		},
 		{
-			name: "seller settlement flat fee with zero amount",
-			msg: MsgFillBidsRequest{
-				Seller:                  seller,
-				MarketId:                1,
-				TotalAssets:             sdk.Coins{*coin(1, "acorn")},
-				BidOrderIds:             []uint64{1},
-				SellerSettlementFlatFee: coin(0, "catan"),
+			name: "market zero",
+			msg: MsgFundCommitmentRewardsRequest{
+				Funder:   funder,
+				MarketId: 0,
+				Amount:   sdk.Coins{sdk.NewInt64Coin("cherry", 52)},
 			},
-			expErr: []string{"invalid seller settlement flat fee", "catan amount cannot be zero"},
+			expErr: []string{"invalid market id: cannot be zero"},
 		},
 		{
-			name: "invalid order creation fee",
-			msg: MsgFillBidsRequest{
-				Seller:              seller,
-				MarketId:            1,
-				TotalAssets:         sdk.Coins{*coin(1, "acorn")},
-				BidOrderIds:         []uint64{1},
-				AskOrderCreationFee: coin(-1, "catan"),
+			name: "empty amount",
+			msg: MsgFundCommitmentRewardsRequest{
+				Funder:   funder,
+				MarketId: 1,
+				Amount:   sdk.Coins{},
 			},
-			expErr: []string{"invalid ask order creation fee", "negative coin amount: -1"},
+			expErr: []string{"invalid amount \"\": cannot be zero"},
 		},
 		{
-			name: "order creation fee with zero amount",
-			msg: MsgFillBidsRequest{
-				Seller:              seller,
-				MarketId:            1,
-				TotalAssets:         sdk.Coins{*coin(1, "acorn")},
-				BidOrderIds:         []uint64{1},
-				AskOrderCreationFee: coin(0, "catan"),
+			name: "bad amount",
+			msg: MsgFundCommitmentRewardsRequest{
+				Funder:   funder,
+				MarketId: 1,
+				Amount:   sdk.Coins{sdk.Coin{Denom: "cherry", Amount: sdkmath.NewInt(-3)}},
 			},
-			expErr: []string{"invalid ask order creation fee", "catan amount cannot be zero"},
+			expErr: []string{"invalid amount \"-3cherry\": coin -3cherry amount is not positive"},
 		},
 		{
 			name: "multiple errors",
-			msg: MsgFillBidsRequest{
-				Seller:                  "",
-				MarketId:                0,
-				TotalAssets:             nil,
-				BidOrderIds:             nil,
-				SellerSettlementFlatFee: coin(0, "catan"),
-				AskOrderCreationFee:     coin(-1, "catan"),
-			},
+			msg:  MsgFundCommitmentRewardsRequest{},
 			expErr: []string{
-				"invalid seller",
-				"invalid market id",
-				"invalid total assets",
-				"no bid order ids provided",
-				"invalid seller settlement flat fee",
-				"invalid ask order creation fee",
+				"invalid funder \"\": " + emptyAddrErr,
+				"invalid market id: cannot be zero",
+				"invalid amount \"\": cannot be zero",
 			},
 		},
 	}
@@ -657,161 +982,87 @@ func TestMsgFillBidsRequest_ValidateBasic(t *testing.T) {
 	}
 }
 
-func TestMsgFillAsksRequest_ValidateBasic(t *testing.T) {
-	coin := func(amount int64, denom string) *sdk.Coin {
-		return &sdk.Coin{Denom: denom, Amount: sdkmath.NewInt(amount)}
-	}
-	buyer := sdk.AccAddress("buyer_______________").String()
-
+func TestMsgClaimCommitmentRewardsRequest_ValidateBasic(t *testing.T) {
+	account := sdk.AccAddress("account_____________").String()
 	tests := []struct {
 		name   string
-		msg    MsgFillAsksRequest
+		msg    MsgClaimCommitmentRewardsRequest
 		expErr []string
 	}{
 		{
-			name: "control",
-			msg: MsgFillAsksRequest{
-				Buyer:               buyer,
-				MarketId:            1,
-				TotalPrice:          *coin(3, "acorn"),
-				AskOrderIds:         []uint64{1, 2, 3},
-				BuyerSettlementFees: sdk.Coins{*coin(2, "banana")},
-				BidOrderCreationFee: coin(8, "cactus"),
-			},
+			name:   "okay",
+			msg:    MsgClaimCommitmentRewardsRequest{Account: account, MarketId: 1},
 			expErr: nil,
 		},
 		{
-			name: "empty buyer",
-			msg: MsgFillAsksRequest{
-				Buyer:       "",
-				MarketId:    1,
-				TotalPrice:  *coin(3, "acorn"),
-				AskOrderIds: []uint64{1},
-			},
-			expErr: []string{"invalid buyer", emptyAddrErr},
+			name:   "no account",
+			msg:    MsgClaimCommitmentRewardsRequest{Account: "", MarketId: 1},
+			expErr: []string{"invalid account \"\": " + emptyAddrErr},
 		},
 		{
-			name: "bad buyer",
-			msg: MsgFillAsksRequest{
-				Buyer:       "not-an-address",
-				MarketId:    1,
-				TotalPrice:  *coin(3, "acorn"),
-				AskOrderIds: []uint64{1},
-			},
-			expErr: []string{"invalid buyer", bech32Err},
+			name:   "bad account",
+			msg:    MsgClaimCommitmentRewardsRequest{Account: "badaccountstring", MarketId: 1},
+			expErr: []string{"invalid account \"badaccountstring\": " + bech32Err},
 		},
 		{
-			name: "market id zero",
-			msg: MsgFillAsksRequest{
-				Buyer:       buyer,
-				MarketId:    0,
-				TotalPrice:  *coin(3, "acorn"),
-				AskOrderIds: []uint64{1},
-			},
-			expErr: []string{"invalid market id", "cannot be zero"},
+			name:   "market zero",
+			msg:    MsgClaimCommitmentRewardsRequest{Account: account, MarketId: 0},
+			expErr: []string{"invalid market id: cannot be zero"},
 		},
 		{
-			name: "invalid total price",
-			msg: MsgFillAsksRequest{
-				Buyer:       buyer,
-				MarketId:    1,
-				TotalPrice:  *coin(-1, "acorn"),
-				AskOrderIds: []uint64{1},
+			name: "multiple errors",
+			msg:  MsgClaimCommitmentRewardsRequest{},
+			expErr: []string{
+				"invalid account \"\": " + emptyAddrErr,
+				"invalid market id: cannot be zero",
 			},
-			expErr: []string{"invalid total price", "negative coin amount: -1"},
 		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
+func TestMsgCancelOrderRequest_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name   string
+		msg    MsgCancelOrderRequest
+		expErr []string
+	}{
 		{
-			name: "nil order ids",
-			msg: MsgFillAsksRequest{
-				Buyer:       buyer,
-				MarketId:    1,
-				TotalPrice:  *coin(1, "acorn"),
-				AskOrderIds: nil,
+			name: "control",
+			msg: MsgCancelOrderRequest{
+				Signer:  sdk.AccAddress("signer______________").String(),
+				OrderId: 1,
 			},
-			expErr: []string{"no ask order ids provided"},
+			expErr: nil,
 		},
 		{
-			name: "order id zero",
-			msg: MsgFillAsksRequest{
-				Buyer:       buyer,
-				MarketId:    1,
-				TotalPrice:  *coin(1, "acorn"),
-				AskOrderIds: []uint64{0},
+			name: "missing owner",
+			msg: MsgCancelOrderRequest{
+				Signer:  "",
+				OrderId: 1,
 			},
-			expErr: []string{"invalid ask order ids: cannot contain order id zero"},
+			expErr: []string{"invalid signer: ", emptyAddrErr},
 		},
 		{
-			name: "duplicate order ids",
-			msg: MsgFillAsksRequest{
-				Buyer:       buyer,
-				MarketId:    1,
-				TotalPrice:  *coin(1, "acorn"),
-				AskOrderIds: []uint64{1, 2, 1},
-			},
-			expErr: []string{"duplicate ask order ids provided: [1]"},
-		},
-		{
-			name: "invalid buyer settlement flat fee",
-			msg: MsgFillAsksRequest{
-				Buyer:               buyer,
-				MarketId:            1,
-				TotalPrice:          *coin(1, "acorn"),
-				AskOrderIds:         []uint64{1},
-				BuyerSettlementFees: sdk.Coins{*coin(-1, "catan")},
-			},
-			expErr: []string{"invalid buyer settlement fees", "coin -1catan amount is not positive"},
-		},
-		{
-			name: "buyer settlement flat fee with zero amount",
-			msg: MsgFillAsksRequest{
-				Buyer:               buyer,
-				MarketId:            1,
-				TotalPrice:          *coin(1, "acorn"),
-				AskOrderIds:         []uint64{1},
-				BuyerSettlementFees: sdk.Coins{*coin(0, "catan")},
-			},
-			expErr: []string{"invalid buyer settlement fees", "coin 0catan amount is not positive"},
-		},
-		{
-			name: "invalid order creation fee",
-			msg: MsgFillAsksRequest{
-				Buyer:               buyer,
-				MarketId:            1,
-				TotalPrice:          *coin(1, "acorn"),
-				AskOrderIds:         []uint64{1},
-				BidOrderCreationFee: coin(-1, "catan"),
-			},
-			expErr: []string{"invalid bid order creation fee", "negative coin amount: -1"},
-		},
-		{
-			name: "order creation fee with zero amount",
-			msg: MsgFillAsksRequest{
-				Buyer:               buyer,
-				MarketId:            1,
-				TotalPrice:          *coin(1, "acorn"),
-				AskOrderIds:         []uint64{1},
-				BidOrderCreationFee: coin(0, "catan"),
+			name: "invalid owner",
+			msg: MsgCancelOrderRequest{
+				Signer:  "notgonnawork",
+				OrderId: 1,
 			},
-			expErr: []string{"invalid bid order creation fee", "catan amount cannot be zero"},
+			expErr: []string{"invalid signer: ", bech32Err + "invalid separator index -1"},
 		},
 		{
-			name: "multiple errors",
-			msg: MsgFillAsksRequest{
-				Buyer:               "",
-				MarketId:            0,
-				TotalPrice:          sdk.Coin{},
-				AskOrderIds:         nil,
-				BuyerSettlementFees: sdk.Coins{*coin(0, "catan")},
-				BidOrderCreationFee: coin(-1, "catan"),
-			},
-			expErr: []string{
-				"invalid buyer",
-				"invalid market id",
-				"invalid total price",
-				"no ask order ids provided",
-				"invalid buyer settlement fees",
-				"invalid bid order creation fee",
+			name: "order 0",
+			msg: MsgCancelOrderRequest{
+				Signer:  sdk.AccAddress("valid_signer________").String(),
+				OrderId: 0,
 			},
+			expErr: []string{"invalid order id: cannot be zero"},
 		},
 	}
 
@@ -822,138 +1073,169 @@ func TestMsgFillAsksRequest_ValidateBasic(t *testing.T) {
 	}
 }
 
-func TestMsgMarketSettleRequest_ValidateBasic(t *testing.T) {
-	admin := sdk.AccAddress("admin_address_______").String()
+func TestMsgAmendOrderRequest_ValidateBasic(t *testing.T) {
+	coin := func(amount int64, denom string) sdk.Coin {
+		return sdk.Coin{Denom: denom, Amount: sdkmath.NewInt(amount)}
+	}
+	coinP := func(amount int64, denom string) *sdk.Coin {
+		rv := coin(amount, denom)
+		return &rv
+	}
+	owner := sdk.AccAddress("owner_______________").String()
 
 	tests := []struct {
 		name   string
-		msg    MsgMarketSettleRequest
+		msg    MsgAmendOrderRequest
 		expErr []string
 	}{
 		{
 			name: "control",
-			msg: MsgMarketSettleRequest{
-				Admin:         admin,
-				MarketId:      1,
-				AskOrderIds:   []uint64{1, 3, 5},
-				BidOrderIds:   []uint64{2, 4, 6},
-				ExpectPartial: true,
+			msg: MsgAmendOrderRequest{
+				Owner:    owner,
+				MarketId: 1,
+				OrderId:  1,
+				Assets:   coin(10, "apple"),
+				Price:    coin(20, "plum"),
 			},
 			expErr: nil,
 		},
 		{
-			name: "no admin",
-			msg: MsgMarketSettleRequest{
-				Admin:       "",
-				MarketId:    1,
-				AskOrderIds: []uint64{1},
-				BidOrderIds: []uint64{2},
+			name: "with seller settlement flat fee",
+			msg: MsgAmendOrderRequest{
+				Owner:                   owner,
+				MarketId:                1,
+				OrderId:                 1,
+				Assets:                  coin(10, "apple"),
+				Price:                   coin(20, "plum"),
+				AllowPartial:            true,
+				SellerSettlementFlatFee: coinP(3, "fig"),
 			},
-			expErr: []string{`invalid administrator ""`, emptyAddrErr},
+			expErr: nil,
 		},
 		{
-			name: "bad admin",
-			msg: MsgMarketSettleRequest{
-				Admin:       "badbadadmin",
-				MarketId:    1,
-				AskOrderIds: []uint64{1},
-				BidOrderIds: []uint64{2},
+			name: "with buyer settlement fees",
+			msg: MsgAmendOrderRequest{
+				Owner:               owner,
+				MarketId:            1,
+				OrderId:             1,
+				Assets:              coin(10, "apple"),
+				Price:               coin(20, "plum"),
+				BuyerSettlementFees: sdk.Coins{coin(3, "fig"), coin(1, "plum")},
 			},
-			expErr: []string{`invalid administrator "badbadadmin"`, bech32Err},
+			expErr: nil,
 		},
 		{
-			name: "market id zero",
-			msg: MsgMarketSettleRequest{
-				Admin:       admin,
-				MarketId:    0,
-				AskOrderIds: []uint64{1},
-				BidOrderIds: []uint64{2},
+			name: "invalid owner",
+			msg: MsgAmendOrderRequest{
+				Owner:    "notgonnawork",
+				MarketId: 1,
+				OrderId:  1,
+				Assets:   coin(10, "apple"),
+				Price:    coin(20, "plum"),
 			},
-			expErr: []string{"invalid market id", "cannot be zero"},
+			expErr: []string{"invalid owner: ", bech32Err + "invalid separator index -1"},
 		},
 		{
-			name: "nil ask orders",
-			msg: MsgMarketSettleRequest{
-				Admin:       admin,
-				MarketId:    1,
-				AskOrderIds: nil,
-				BidOrderIds: []uint64{2},
+			name: "market 0",
+			msg: MsgAmendOrderRequest{
+				Owner:   owner,
+				OrderId: 1,
+				Assets:  coin(10, "apple"),
+				Price:   coin(20, "plum"),
 			},
-			expErr: []string{"no ask order ids provided"},
+			expErr: []string{"invalid market id: cannot be zero"},
 		},
 		{
-			name: "ask orders has a zero",
-			msg: MsgMarketSettleRequest{
-				Admin:       admin,
-				MarketId:    1,
-				AskOrderIds: []uint64{1, 3, 0, 5},
-				BidOrderIds: []uint64{2},
+			name: "order 0",
+			msg: MsgAmendOrderRequest{
+				Owner:    owner,
+				MarketId: 1,
+				Assets:   coin(10, "apple"),
+				Price:    coin(20, "plum"),
 			},
-			expErr: []string{"invalid ask order ids", "cannot contain order id zero"},
+			expErr: []string{"invalid order id: cannot be zero"},
 		},
 		{
-			name: "duplicate ask orders ids",
-			msg: MsgMarketSettleRequest{
-				Admin:       admin,
-				MarketId:    1,
-				AskOrderIds: []uint64{1, 3, 1, 5, 5},
-				BidOrderIds: []uint64{2},
+			name: "zero price",
+			msg: MsgAmendOrderRequest{
+				Owner:    owner,
+				MarketId: 1,
+				OrderId:  1,
+				Assets:   coin(10, "apple"),
+				Price:    coin(0, "plum"),
 			},
-			expErr: []string{"duplicate ask order ids provided: [1 5]"},
+			expErr: []string{"invalid price: cannot be zero"},
 		},
 		{
-			name: "nil bid orders",
-			msg: MsgMarketSettleRequest{
-				Admin:       admin,
-				MarketId:    1,
-				AskOrderIds: []uint64{1},
-				BidOrderIds: nil,
+			name: "zero assets",
+			msg: MsgAmendOrderRequest{
+				Owner:    owner,
+				MarketId: 1,
+				OrderId:  1,
+				Assets:   coin(0, "apple"),
+				Price:    coin(20, "plum"),
 			},
-			expErr: []string{"no bid order ids provided"},
+			expErr: []string{"invalid assets: cannot be zero"},
 		},
 		{
-			name: "bid orders has a zero",
-			msg: MsgMarketSettleRequest{
-				Admin:       admin,
-				MarketId:    1,
-				AskOrderIds: []uint64{1},
-				BidOrderIds: []uint64{2, 0, 4, 6},
+			name: "same assets and price denoms",
+			msg: MsgAmendOrderRequest{
+				Owner:    owner,
+				MarketId: 1,
+				OrderId:  1,
+				Assets:   coin(10, "plum"),
+				Price:    coin(20, "plum"),
 			},
-			expErr: []string{"invalid bid order ids", "cannot contain order id zero"},
+			expErr: []string{"invalid assets: price denom plum cannot also be the assets denom"},
 		},
 		{
-			name: "duplicate bid orders ids",
-			msg: MsgMarketSettleRequest{
-				Admin:       admin,
-				MarketId:    1,
-				AskOrderIds: []uint64{1},
-				BidOrderIds: []uint64{2, 4, 2, 6, 6},
+			name: "zero seller settlement flat fee",
+			msg: MsgAmendOrderRequest{
+				Owner:                   owner,
+				MarketId:                1,
+				OrderId:                 1,
+				Assets:                  coin(10, "apple"),
+				Price:                   coin(20, "plum"),
+				SellerSettlementFlatFee: coinP(0, "fig"),
 			},
-			expErr: []string{"duplicate bid order ids provided: [2 6]"},
+			expErr: []string{"invalid seller settlement flat fee: fig amount cannot be zero"},
 		},
 		{
-			name: "same orders in both lists",
-			msg: MsgMarketSettleRequest{
-				Admin:       admin,
-				MarketId:    1,
-				AskOrderIds: []uint64{1, 3, 5, 6},
-				BidOrderIds: []uint64{2, 4, 6, 3},
+			name: "invalid buyer settlement fees",
+			msg: MsgAmendOrderRequest{
+				Owner:               owner,
+				MarketId:            1,
+				OrderId:             1,
+				Assets:              coin(10, "apple"),
+				Price:               coin(20, "plum"),
+				BuyerSettlementFees: sdk.Coins{coin(-3, "fig")},
 			},
-			expErr: []string{"order ids duplicated as both bid and ask: [3 6]"},
+			expErr: []string{"invalid buyer settlement fees: ", "coin -3fig amount is not positive"},
+		},
+		{
+			name: "both settlement fee types",
+			msg: MsgAmendOrderRequest{
+				Owner:                   owner,
+				MarketId:                1,
+				OrderId:                 1,
+				Assets:                  coin(10, "apple"),
+				Price:                   coin(20, "plum"),
+				SellerSettlementFlatFee: coinP(3, "fig"),
+				BuyerSettlementFees:     sdk.Coins{coin(3, "fig")},
+			},
+			expErr: []string{"cannot provide both a seller settlement flat fee and buyer settlement fees"},
 		},
 		{
 			name: "multiple errors",
-			msg: MsgMarketSettleRequest{
-				Admin:       "",
-				MarketId:    0,
-				AskOrderIds: nil,
-				BidOrderIds: nil,
+			msg: MsgAmendOrderRequest{
+				Assets: coin(0, "apple"),
 			},
 			expErr: []string{
-				"invalid administrator",
-				"invalid market id",
-				"no ask order ids provided",
-				"no bid order ids provided",
+				"invalid owner: ", emptyAddrErr,
+				"invalid market id: cannot be zero",
+				"invalid order id: cannot be zero",
+				"invalid price: ",
+				"invalid assets: cannot be zero",
 			},
 		},
 	}
@@ -965,370 +1247,1823 @@ func TestMsgMarketSettleRequest_ValidateBasic(t *testing.T) {
 	}
 }
 
-func TestMsgMarketCommitmentSettleRequest_ValidateBasic(t *testing.T) {
-	type testCase struct {
-		name         string
-		msg          MsgMarketCommitmentSettleRequest
-		expErrInReq  []string // for errors that only happen when requireInputs = true.
-		expErrInOpt  []string // for errors that only happen when requireInputs = false.
-		expErrAlways []string // for errors that happen regardless of the requireInputs value.
-	}
-	getExpErr := func(tc testCase, requireInputs bool) []string {
-		if requireInputs {
-			return append(tc.expErrAlways, tc.expErrInReq...)
-		}
-		return append(tc.expErrAlways, tc.expErrInOpt...)
-	}
-	toAccAddr := func(str string) string {
-		return sdk.AccAddress(str + strings.Repeat("_", 20-len(str))).String()
-	}
-	goodAA := func(account, amount string) AccountAmount {
-		addr := toAccAddr(account)
-		amt, err := sdk.ParseCoinsNormalized(amount)
-		require.NoError(t, err, "ParseCoinsNormalized(%q)", amount)
-		return AccountAmount{Account: addr, Amount: amt}
-	}
-	goodNAV := func(assets, price string) NetAssetPrice {
-		rv := NetAssetPrice{}
-		var err error
-		rv.Assets, err = sdk.ParseCoinNormalized(assets)
-		require.NoError(t, err, "ParseCoinNormalized(%q) (assets)", assets)
-		rv.Price, err = sdk.ParseCoinNormalized(price)
-		require.NoError(t, err, "ParseCoinNormalized(%q) (price)", price)
-		return rv
-	}
-	coin := func(amount int64, denom string) sdk.Coin {
-		return sdk.Coin{Denom: denom, Amount: sdkmath.NewInt(amount)}
-	}
-	coins := func(amount int64, denom string) sdk.Coins {
-		return sdk.Coins{{Denom: denom, Amount: sdkmath.NewInt(amount)}}
-	}
+func TestMsgLinkOrdersRequest_ValidateBasic(t *testing.T) {
+	owner := sdk.AccAddress("owner_______________").String()
 
-	tests := []testCase{
+	tests := []struct {
+		name   string
+		msg    MsgLinkOrdersRequest
+		expErr []string
+	}{
 		{
-			name: "control",
-			msg: MsgMarketCommitmentSettleRequest{
-				Admin:    toAccAddr("admin"),
-				MarketId: 1,
-				Inputs:   []AccountAmount{goodAA("input0", "13cherry")},
-				Outputs:  []AccountAmount{goodAA("output0", "13cherry")},
-			},
+			name:   "control",
+			msg:    MsgLinkOrdersRequest{Owner: owner, MarketId: 1, OrderId: 2, LinkedOrderId: 3},
+			expErr: nil,
 		},
 		{
-			name: "okay: with all optional fields",
-			msg: MsgMarketCommitmentSettleRequest{
-				Admin:    toAccAddr("admin"),
-				MarketId: 1,
-				Inputs:   []AccountAmount{goodAA("input0", "13cherry")},
-				Outputs:  []AccountAmount{goodAA("output0", "13cherry")},
-				Fees:     []AccountAmount{goodAA("fee0", "7fig")},
-				Navs:     []NetAssetPrice{goodNAV("13cherry", "1990musd")},
-				EventTag: "you're it",
-			},
+			name:   "invalid owner",
+			msg:    MsgLinkOrdersRequest{Owner: "notgonnawork", MarketId: 1, OrderId: 2, LinkedOrderId: 3},
+			expErr: []string{"invalid owner: ", bech32Err},
 		},
 		{
-			name: "no admin",
-			msg: MsgMarketCommitmentSettleRequest{
-				Admin:    "",
+			name:   "zero market id",
+			msg:    MsgLinkOrdersRequest{Owner: owner, MarketId: 0, OrderId: 2, LinkedOrderId: 3},
+			expErr: []string{"invalid market id: cannot be zero"},
+		},
+		{
+			name:   "zero order id",
+			msg:    MsgLinkOrdersRequest{Owner: owner, MarketId: 1, OrderId: 0, LinkedOrderId: 3},
+			expErr: []string{"invalid order id: cannot be zero"},
+		},
+		{
+			name:   "zero linked order id",
+			msg:    MsgLinkOrdersRequest{Owner: owner, MarketId: 1, OrderId: 2, LinkedOrderId: 0},
+			expErr: []string{"invalid linked order id: cannot be zero"},
+		},
+		{
+			name:   "same order ids",
+			msg:    MsgLinkOrdersRequest{Owner: owner, MarketId: 1, OrderId: 2, LinkedOrderId: 2},
+			expErr: []string{"cannot link order 2 to itself"},
+		},
+		{
+			name: "multiple errors",
+			msg:  MsgLinkOrdersRequest{},
+			expErr: []string{
+				"invalid owner: ", emptyAddrErr,
+				"invalid market id: cannot be zero",
+				"invalid order id: cannot be zero",
+				"invalid linked order id: cannot be zero",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
+func TestMsgSetDeadManSwitchRequest_ValidateBasic(t *testing.T) {
+	account := sdk.AccAddress("account_____________").String()
+
+	tests := []struct {
+		name   string
+		msg    MsgSetDeadManSwitchRequest
+		expErr []string
+	}{
+		{
+			name:   "control",
+			msg:    MsgSetDeadManSwitchRequest{Account: account, IntervalSeconds: 30},
+			expErr: nil,
+		},
+		{
+			name:   "zero interval",
+			msg:    MsgSetDeadManSwitchRequest{Account: account, IntervalSeconds: 0},
+			expErr: nil,
+		},
+		{
+			name:   "invalid account",
+			msg:    MsgSetDeadManSwitchRequest{Account: "notgonnawork", IntervalSeconds: 30},
+			expErr: []string{"invalid account: ", bech32Err},
+		},
+		{
+			name:   "no account",
+			msg:    MsgSetDeadManSwitchRequest{IntervalSeconds: 30},
+			expErr: []string{"invalid account: ", emptyAddrErr},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
+func TestMsgHeartbeatRequest_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name   string
+		msg    MsgHeartbeatRequest
+		expErr []string
+	}{
+		{
+			name:   "control",
+			msg:    MsgHeartbeatRequest{Account: sdk.AccAddress("account_____________").String()},
+			expErr: nil,
+		},
+		{
+			name:   "invalid account",
+			msg:    MsgHeartbeatRequest{Account: "notgonnawork"},
+			expErr: []string{"invalid account: ", bech32Err},
+		},
+		{
+			name:   "no account",
+			msg:    MsgHeartbeatRequest{},
+			expErr: []string{"invalid account: ", emptyAddrErr},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
+func TestMsgFillBidsRequest_ValidateBasic(t *testing.T) {
+	coin := func(amount int64, denom string) *sdk.Coin {
+		return &sdk.Coin{Denom: denom, Amount: sdkmath.NewInt(amount)}
+	}
+	seller := sdk.AccAddress("seller______________").String()
+
+	tests := []struct {
+		name   string
+		msg    MsgFillBidsRequest
+		expErr []string
+	}{
+		{
+			name: "control",
+			msg: MsgFillBidsRequest{
+				Seller:                  seller,
+				MarketId:                1,
+				TotalAssets:             sdk.Coins{*coin(3, "acorn")},
+				BidOrderIds:             []uint64{1, 2, 3},
+				SellerSettlementFlatFee: coin(2, "banana"),
+				AskOrderCreationFee:     coin(8, "cactus"),
+			},
+			expErr: nil,
+		},
+		{
+			name: "empty seller",
+			msg: MsgFillBidsRequest{
+				Seller:      "",
+				MarketId:    1,
+				TotalAssets: sdk.Coins{*coin(3, "acorn")},
+				BidOrderIds: []uint64{1},
+			},
+			expErr: []string{"invalid seller", emptyAddrErr},
+		},
+		{
+			name: "bad seller",
+			msg: MsgFillBidsRequest{
+				Seller:      "not-an-address",
+				MarketId:    1,
+				TotalAssets: sdk.Coins{*coin(3, "acorn")},
+				BidOrderIds: []uint64{1},
+			},
+			expErr: []string{"invalid seller", bech32Err},
+		},
+		{
+			name: "market id zero",
+			msg: MsgFillBidsRequest{
+				Seller:      seller,
+				MarketId:    0,
+				TotalAssets: sdk.Coins{*coin(3, "acorn")},
+				BidOrderIds: []uint64{1},
+			},
+			expErr: []string{"invalid market id", "cannot be zero"},
+		},
+		{
+			name: "nil total assets",
+			msg: MsgFillBidsRequest{
+				Seller:      seller,
+				MarketId:    1,
+				TotalAssets: nil,
+				BidOrderIds: []uint64{1},
+			},
+			expErr: []string{"invalid total assets", "cannot be zero"},
+		},
+		{
+			name: "empty total assets",
+			msg: MsgFillBidsRequest{
+				Seller:      seller,
+				MarketId:    1,
+				TotalAssets: sdk.Coins{},
+				BidOrderIds: []uint64{1},
+			},
+			expErr: []string{"invalid total assets", "cannot be zero"},
+		},
+		{
+			name: "invalid total assets",
+			msg: MsgFillBidsRequest{
+				Seller:      seller,
+				MarketId:    1,
+				TotalAssets: sdk.Coins{*coin(-1, "acorn")},
+				BidOrderIds: []uint64{1},
+			},
+			expErr: []string{"invalid total assets", "coin -1acorn amount is not positive"},
+		},
+		{
+			name: "nil order ids",
+			msg: MsgFillBidsRequest{
+				Seller:      seller,
+				MarketId:    1,
+				TotalAssets: sdk.Coins{*coin(1, "acorn")},
+				BidOrderIds: nil,
+			},
+			expErr: []string{"no bid order ids provided"},
+		},
+		{
+			name: "order id zero",
+			msg: MsgFillBidsRequest{
+				Seller:      seller,
+				MarketId:    1,
+				TotalAssets: sdk.Coins{*coin(1, "acorn")},
+				BidOrderIds: []uint64{0},
+			},
+			expErr: []string{"invalid bid order ids: cannot contain order id zero"},
+		},
+		{
+			name: "duplicate order ids",
+			msg: MsgFillBidsRequest{
+				Seller:      seller,
+				MarketId:    1,
+				TotalAssets: sdk.Coins{*coin(1, "acorn")},
+				BidOrderIds: []uint64{1, 2, 1},
+			},
+			expErr: []string{"duplicate bid order ids provided: [1]"},
+		},
+		{
+			name: "invalid seller settlement flat fee",
+			msg: MsgFillBidsRequest{
+				Seller:                  seller,
+				MarketId:                1,
+				TotalAssets:             sdk.Coins{*coin(1, "acorn")},
+				BidOrderIds:             []uint64{1},
+				SellerSettlementFlatFee: coin(-1, "catan"),
+			},
+			expErr: []string{"invalid seller settlement flat fee", "negative coin amount: -1"},
+		},
+		{
+			name: "seller settlement flat fee with zero amount",
+			msg: MsgFillBidsRequest{
+				Seller:                  seller,
+				MarketId:                1,
+				TotalAssets:             sdk.Coins{*coin(1, "acorn")},
+				BidOrderIds:             []uint64{1},
+				SellerSettlementFlatFee: coin(0, "catan"),
+			},
+			expErr: []string{"invalid seller settlement flat fee", "catan amount cannot be zero"},
+		},
+		{
+			name: "invalid order creation fee",
+			msg: MsgFillBidsRequest{
+				Seller:              seller,
+				MarketId:            1,
+				TotalAssets:         sdk.Coins{*coin(1, "acorn")},
+				BidOrderIds:         []uint64{1},
+				AskOrderCreationFee: coin(-1, "catan"),
+			},
+			expErr: []string{"invalid ask order creation fee", "negative coin amount: -1"},
+		},
+		{
+			name: "order creation fee with zero amount",
+			msg: MsgFillBidsRequest{
+				Seller:              seller,
+				MarketId:            1,
+				TotalAssets:         sdk.Coins{*coin(1, "acorn")},
+				BidOrderIds:         []uint64{1},
+				AskOrderCreationFee: coin(0, "catan"),
+			},
+			expErr: []string{"invalid ask order creation fee", "catan amount cannot be zero"},
+		},
+		{
+			name: "multiple errors",
+			msg: MsgFillBidsRequest{
+				Seller:                  "",
+				MarketId:                0,
+				TotalAssets:             nil,
+				BidOrderIds:             nil,
+				SellerSettlementFlatFee: coin(0, "catan"),
+				AskOrderCreationFee:     coin(-1, "catan"),
+			},
+			expErr: []string{
+				"invalid seller",
+				"invalid market id",
+				"invalid total assets",
+				"no bid order ids provided",
+				"invalid seller settlement flat fee",
+				"invalid ask order creation fee",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
+func TestMsgFillAsksRequest_ValidateBasic(t *testing.T) {
+	coin := func(amount int64, denom string) *sdk.Coin {
+		return &sdk.Coin{Denom: denom, Amount: sdkmath.NewInt(amount)}
+	}
+	buyer := sdk.AccAddress("buyer_______________").String()
+
+	tests := []struct {
+		name   string
+		msg    MsgFillAsksRequest
+		expErr []string
+	}{
+		{
+			name: "control",
+			msg: MsgFillAsksRequest{
+				Buyer:               buyer,
+				MarketId:            1,
+				TotalPrice:          *coin(3, "acorn"),
+				AskOrderIds:         []uint64{1, 2, 3},
+				BuyerSettlementFees: sdk.Coins{*coin(2, "banana")},
+				BidOrderCreationFee: coin(8, "cactus"),
+			},
+			expErr: nil,
+		},
+		{
+			name: "empty buyer",
+			msg: MsgFillAsksRequest{
+				Buyer:       "",
+				MarketId:    1,
+				TotalPrice:  *coin(3, "acorn"),
+				AskOrderIds: []uint64{1},
+			},
+			expErr: []string{"invalid buyer", emptyAddrErr},
+		},
+		{
+			name: "bad buyer",
+			msg: MsgFillAsksRequest{
+				Buyer:       "not-an-address",
+				MarketId:    1,
+				TotalPrice:  *coin(3, "acorn"),
+				AskOrderIds: []uint64{1},
+			},
+			expErr: []string{"invalid buyer", bech32Err},
+		},
+		{
+			name: "market id zero",
+			msg: MsgFillAsksRequest{
+				Buyer:       buyer,
+				MarketId:    0,
+				TotalPrice:  *coin(3, "acorn"),
+				AskOrderIds: []uint64{1},
+			},
+			expErr: []string{"invalid market id", "cannot be zero"},
+		},
+		{
+			name: "invalid total price",
+			msg: MsgFillAsksRequest{
+				Buyer:       buyer,
+				MarketId:    1,
+				TotalPrice:  *coin(-1, "acorn"),
+				AskOrderIds: []uint64{1},
+			},
+			expErr: []string{"invalid total price", "negative coin amount: -1"},
+		},
+		{
+			name: "nil order ids",
+			msg: MsgFillAsksRequest{
+				Buyer:       buyer,
+				MarketId:    1,
+				TotalPrice:  *coin(1, "acorn"),
+				AskOrderIds: nil,
+			},
+			expErr: []string{"no ask order ids provided"},
+		},
+		{
+			name: "order id zero",
+			msg: MsgFillAsksRequest{
+				Buyer:       buyer,
+				MarketId:    1,
+				TotalPrice:  *coin(1, "acorn"),
+				AskOrderIds: []uint64{0},
+			},
+			expErr: []string{"invalid ask order ids: cannot contain order id zero"},
+		},
+		{
+			name: "duplicate order ids",
+			msg: MsgFillAsksRequest{
+				Buyer:       buyer,
+				MarketId:    1,
+				TotalPrice:  *coin(1, "acorn"),
+				AskOrderIds: []uint64{1, 2, 1},
+			},
+			expErr: []string{"duplicate ask order ids provided: [1]"},
+		},
+		{
+			name: "invalid buyer settlement flat fee",
+			msg: MsgFillAsksRequest{
+				Buyer:               buyer,
+				MarketId:            1,
+				TotalPrice:          *coin(1, "acorn"),
+				AskOrderIds:         []uint64{1},
+				BuyerSettlementFees: sdk.Coins{*coin(-1, "catan")},
+			},
+			expErr: []string{"invalid buyer settlement fees", "coin -1catan amount is not positive"},
+		},
+		{
+			name: "buyer settlement flat fee with zero amount",
+			msg: MsgFillAsksRequest{
+				Buyer:               buyer,
+				MarketId:            1,
+				TotalPrice:          *coin(1, "acorn"),
+				AskOrderIds:         []uint64{1},
+				BuyerSettlementFees: sdk.Coins{*coin(0, "catan")},
+			},
+			expErr: []string{"invalid buyer settlement fees", "coin 0catan amount is not positive"},
+		},
+		{
+			name: "invalid order creation fee",
+			msg: MsgFillAsksRequest{
+				Buyer:               buyer,
+				MarketId:            1,
+				TotalPrice:          *coin(1, "acorn"),
+				AskOrderIds:         []uint64{1},
+				BidOrderCreationFee: coin(-1, "catan"),
+			},
+			expErr: []string{"invalid bid order creation fee", "negative coin amount: -1"},
+		},
+		{
+			name: "order creation fee with zero amount",
+			msg: MsgFillAsksRequest{
+				Buyer:               buyer,
+				MarketId:            1,
+				TotalPrice:          *coin(1, "acorn"),
+				AskOrderIds:         []uint64{1},
+				BidOrderCreationFee: coin(0, "catan"),
+			},
+			expErr: []string{"invalid bid order creation fee", "catan amount cannot be zero"},
+		},
+		{
+			name: "multiple errors",
+			msg: MsgFillAsksRequest{
+				Buyer:               "",
+				MarketId:            0,
+				TotalPrice:          sdk.Coin{},
+				AskOrderIds:         nil,
+				BuyerSettlementFees: sdk.Coins{*coin(0, "catan")},
+				BidOrderCreationFee: coin(-1, "catan"),
+			},
+			expErr: []string{
+				"invalid buyer",
+				"invalid market id",
+				"invalid total price",
+				"no ask order ids provided",
+				"invalid buyer settlement fees",
+				"invalid bid order creation fee",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
+func TestMsgMarketSettleRequest_ValidateBasic(t *testing.T) {
+	admin := sdk.AccAddress("admin_address_______").String()
+
+	tests := []struct {
+		name   string
+		msg    MsgMarketSettleRequest
+		expErr []string
+	}{
+		{
+			name: "control",
+			msg: MsgMarketSettleRequest{
+				Admin:         admin,
+				MarketId:      1,
+				AskOrderIds:   []uint64{1, 3, 5},
+				BidOrderIds:   []uint64{2, 4, 6},
+				ExpectPartial: true,
+			},
+			expErr: nil,
+		},
+		{
+			name: "no admin",
+			msg: MsgMarketSettleRequest{
+				Admin:       "",
+				MarketId:    1,
+				AskOrderIds: []uint64{1},
+				BidOrderIds: []uint64{2},
+			},
+			expErr: []string{`invalid administrator ""`, emptyAddrErr},
+		},
+		{
+			name: "bad admin",
+			msg: MsgMarketSettleRequest{
+				Admin:       "badbadadmin",
+				MarketId:    1,
+				AskOrderIds: []uint64{1},
+				BidOrderIds: []uint64{2},
+			},
+			expErr: []string{`invalid administrator "badbadadmin"`, bech32Err},
+		},
+		{
+			name: "market id zero",
+			msg: MsgMarketSettleRequest{
+				Admin:       admin,
+				MarketId:    0,
+				AskOrderIds: []uint64{1},
+				BidOrderIds: []uint64{2},
+			},
+			expErr: []string{"invalid market id", "cannot be zero"},
+		},
+		{
+			name: "nil ask orders",
+			msg: MsgMarketSettleRequest{
+				Admin:       admin,
+				MarketId:    1,
+				AskOrderIds: nil,
+				BidOrderIds: []uint64{2},
+			},
+			expErr: []string{"no ask order ids provided"},
+		},
+		{
+			name: "ask orders has a zero",
+			msg: MsgMarketSettleRequest{
+				Admin:       admin,
+				MarketId:    1,
+				AskOrderIds: []uint64{1, 3, 0, 5},
+				BidOrderIds: []uint64{2},
+			},
+			expErr: []string{"invalid ask order ids", "cannot contain order id zero"},
+		},
+		{
+			name: "duplicate ask orders ids",
+			msg: MsgMarketSettleRequest{
+				Admin:       admin,
+				MarketId:    1,
+				AskOrderIds: []uint64{1, 3, 1, 5, 5},
+				BidOrderIds: []uint64{2},
+			},
+			expErr: []string{"duplicate ask order ids provided: [1 5]"},
+		},
+		{
+			name: "nil bid orders",
+			msg: MsgMarketSettleRequest{
+				Admin:       admin,
+				MarketId:    1,
+				AskOrderIds: []uint64{1},
+				BidOrderIds: nil,
+			},
+			expErr: []string{"no bid order ids provided"},
+		},
+		{
+			name: "bid orders has a zero",
+			msg: MsgMarketSettleRequest{
+				Admin:       admin,
+				MarketId:    1,
+				AskOrderIds: []uint64{1},
+				BidOrderIds: []uint64{2, 0, 4, 6},
+			},
+			expErr: []string{"invalid bid order ids", "cannot contain order id zero"},
+		},
+		{
+			name: "duplicate bid orders ids",
+			msg: MsgMarketSettleRequest{
+				Admin:       admin,
+				MarketId:    1,
+				AskOrderIds: []uint64{1},
+				BidOrderIds: []uint64{2, 4, 2, 6, 6},
+			},
+			expErr: []string{"duplicate bid order ids provided: [2 6]"},
+		},
+		{
+			name: "same orders in both lists",
+			msg: MsgMarketSettleRequest{
+				Admin:       admin,
+				MarketId:    1,
+				AskOrderIds: []uint64{1, 3, 5, 6},
+				BidOrderIds: []uint64{2, 4, 6, 3},
+			},
+			expErr: []string{"order ids duplicated as both bid and ask: [3 6]"},
+		},
+		{
+			name: "multiple errors",
+			msg: MsgMarketSettleRequest{
+				Admin:       "",
+				MarketId:    0,
+				AskOrderIds: nil,
+				BidOrderIds: nil,
+			},
+			expErr: []string{
+				"invalid administrator",
+				"invalid market id",
+				"no ask order ids provided",
+				"no bid order ids provided",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
+func TestMsgMarketCommitmentSettleRequest_ValidateBasic(t *testing.T) {
+	type testCase struct {
+		name         string
+		msg          MsgMarketCommitmentSettleRequest
+		expErrInReq  []string // for errors that only happen when requireInputs = true.
+		expErrInOpt  []string // for errors that only happen when requireInputs = false.
+		expErrAlways []string // for errors that happen regardless of the requireInputs value.
+	}
+	getExpErr := func(tc testCase, requireInputs bool) []string {
+		if requireInputs {
+			return append(tc.expErrAlways, tc.expErrInReq...)
+		}
+		return append(tc.expErrAlways, tc.expErrInOpt...)
+	}
+	toAccAddr := func(str string) string {
+		return sdk.AccAddress(str + strings.Repeat("_", 20-len(str))).String()
+	}
+	goodAA := func(account, amount string) AccountAmount {
+		addr := toAccAddr(account)
+		amt, err := sdk.ParseCoinsNormalized(amount)
+		require.NoError(t, err, "ParseCoinsNormalized(%q)", amount)
+		return AccountAmount{Account: addr, Amount: amt}
+	}
+	goodNAV := func(assets, price string) NetAssetPrice {
+		rv := NetAssetPrice{}
+		var err error
+		rv.Assets, err = sdk.ParseCoinNormalized(assets)
+		require.NoError(t, err, "ParseCoinNormalized(%q) (assets)", assets)
+		rv.Price, err = sdk.ParseCoinNormalized(price)
+		require.NoError(t, err, "ParseCoinNormalized(%q) (price)", price)
+		return rv
+	}
+	coin := func(amount int64, denom string) sdk.Coin {
+		return sdk.Coin{Denom: denom, Amount: sdkmath.NewInt(amount)}
+	}
+	coins := func(amount int64, denom string) sdk.Coins {
+		return sdk.Coins{{Denom: denom, Amount: sdkmath.NewInt(amount)}}
+	}
+
+	tests := []testCase{
+		{
+			name: "control",
+			msg: MsgMarketCommitmentSettleRequest{
+				Admin:    toAccAddr("admin"),
+				MarketId: 1,
+				Inputs:   []AccountAmount{goodAA("input0", "13cherry")},
+				Outputs:  []AccountAmount{goodAA("output0", "13cherry")},
+			},
+		},
+		{
+			name: "okay: with all optional fields",
+			msg: MsgMarketCommitmentSettleRequest{
+				Admin:    toAccAddr("admin"),
+				MarketId: 1,
+				Inputs:   []AccountAmount{goodAA("input0", "13cherry")},
+				Outputs:  []AccountAmount{goodAA("output0", "13cherry")},
+				Fees:     []AccountAmount{goodAA("fee0", "7fig")},
+				Navs:     []NetAssetPrice{goodNAV("13cherry", "1990musd")},
+				EventTag: "you're it",
+			},
+		},
+		{
+			name: "no admin",
+			msg: MsgMarketCommitmentSettleRequest{
+				Admin:    "",
+				MarketId: 1,
+				Inputs:   []AccountAmount{goodAA("input0", "13cherry")},
+				Outputs:  []AccountAmount{goodAA("output0", "13cherry")},
+			},
+			expErrInReq: []string{"invalid administrator \"\": " + emptyAddrErr},
+		},
+		{
+			name: "bad admin",
+			msg: MsgMarketCommitmentSettleRequest{
+				Admin:    "badbadadmin",
+				MarketId: 1,
+				Inputs:   []AccountAmount{goodAA("input0", "13cherry")},
+				Outputs:  []AccountAmount{goodAA("output0", "13cherry")},
+			},
+			expErrInReq: []string{"invalid administrator \"badbadadmin\": " + bech32Err},
+		},
+		{
+			name: "market zero",
+			msg: MsgMarketCommitmentSettleRequest{
+				Admin:    toAccAddr("admin"),
+				MarketId: 0,
+				Inputs:   []AccountAmount{goodAA("input0", "13cherry")},
+				Outputs:  []AccountAmount{goodAA("output0", "13cherry")},
+			},
+			expErrAlways: []string{"invalid market id: cannot be zero"},
+		},
+		{
+			name: "no inputs",
+			msg: MsgMarketCommitmentSettleRequest{
+				Admin:    toAccAddr("admin"),
+				MarketId: 1,
+				Outputs:  []AccountAmount{goodAA("output0", "13cherry")},
+			},
+			expErrInReq: []string{"no inputs provided"},
+			expErrInOpt: []string{"input total \"\" does not equal output total \"13cherry\""},
+		},
+		{
+			name: "bad inputs",
+			msg: MsgMarketCommitmentSettleRequest{
+				Admin:    toAccAddr("admin"),
+				MarketId: 1,
+				Inputs: []AccountAmount{
+					{Account: toAccAddr("input0"), Amount: coins(-3, "cherry")},
+					goodAA("input1", "8cherry"),
+					{Account: "badinput2addr", Amount: coins(4, "cherry")},
+					{Account: toAccAddr("input3"), Amount: nil},
+				},
+			},
+			expErrInReq: []string{"no outputs provided"},
+			expErrAlways: []string{
+				"inputs[0]: invalid amount \"-3cherry\": coin -3cherry amount is not positive",
+				"inputs[2]: invalid account \"badinput2addr\": " + bech32Err,
+				"inputs[3]: invalid amount \"\": cannot be zero",
+			},
+		},
+		{
+			name: "no outputs",
+			msg: MsgMarketCommitmentSettleRequest{
+				Admin:    toAccAddr("admin"),
+				MarketId: 1,
+				Inputs:   []AccountAmount{goodAA("input0", "13cherry")},
+			},
+			expErrInReq: []string{"no outputs provided"},
+			expErrInOpt: []string{"input total \"13cherry\" does not equal output total \"\""},
+		},
+		{
+			name: "bad outputs",
+			msg: MsgMarketCommitmentSettleRequest{
+				Admin:    toAccAddr("admin"),
+				MarketId: 1,
+				Outputs: []AccountAmount{
+					{Account: toAccAddr("output0"), Amount: coins(-3, "cherry")},
+					goodAA("output1", "8cherry"),
+					{Account: "badoutput2addr", Amount: coins(4, "cherry")},
+					{Account: toAccAddr("output3"), Amount: sdk.Coins{}},
+				},
+			},
+			expErrInReq: []string{"no inputs provided"},
+			expErrAlways: []string{
+				"outputs[0]: invalid amount \"-3cherry\": coin -3cherry amount is not positive",
+				"outputs[2]: invalid account \"badoutput2addr\": " + bech32Err,
+				"outputs[3]: invalid amount \"\": cannot be zero",
+			},
+		},
+		{
+			name: "input output amounts not equal",
+			msg: MsgMarketCommitmentSettleRequest{
+				Admin:    toAccAddr("admin"),
+				MarketId: 1,
+				Inputs:   []AccountAmount{goodAA("input0", "13cherry"), goodAA("input1", "36cherry")},
+				Outputs:  []AccountAmount{goodAA("output0", "25cherry"), goodAA("output1", "23cherry")},
+			},
+			expErrAlways: []string{"input total \"49cherry\" does not equal output total \"48cherry\""},
+		},
+		{
+			name: "bad fees",
+			msg: MsgMarketCommitmentSettleRequest{
+				Admin:    toAccAddr("admin"),
+				MarketId: 1,
+				Inputs:   []AccountAmount{goodAA("input0", "13cherry")},
+				Outputs:  []AccountAmount{goodAA("output0", "13cherry")},
+				Fees: []AccountAmount{
+					{Account: "badfee0addr", Amount: coins(4, "cherry")},
+					goodAA("fee1", "8cherry"),
+					{Account: toAccAddr("fee2"), Amount: coins(-3, "cherry")},
+					{Account: toAccAddr("fee3"), Amount: nil},
+				},
+			},
+			expErrAlways: []string{
+				"fees[0]: invalid account \"badfee0addr\": " + bech32Err,
+				"fees[2]: invalid amount \"-3cherry\": coin -3cherry amount is not positive",
+				"fees[3]: invalid amount \"\": cannot be zero",
+			},
+		},
+		{
+			name: "bad navs",
+			msg: MsgMarketCommitmentSettleRequest{
+				Admin:    toAccAddr("admin"),
+				MarketId: 1,
+				Inputs:   []AccountAmount{goodAA("input0", "13cherry")},
+				Outputs:  []AccountAmount{goodAA("output0", "13cherry")},
+				Navs: []NetAssetPrice{
+					{Assets: coin(-2, "cherry"), Price: coin(87, "nhash")},
+					goodNAV("13cherry", "1990musd"),
+					{Assets: coin(57, "cherry"), Price: coin(52, "x")},
+				},
+			},
+			expErrAlways: []string{
+				"navs[0]: invalid assets \"-2cherry\": negative coin amount: -2",
+				"navs[2]: invalid price \"52x\": invalid denom: x",
+			},
+		},
+		{
+			name: "bad event tag",
+			msg: MsgMarketCommitmentSettleRequest{
+				Admin:    toAccAddr("admin"),
+				MarketId: 1,
+				Inputs:   []AccountAmount{goodAA("input0", "13cherry")},
+				Outputs:  []AccountAmount{goodAA("output0", "13cherry")},
+				EventTag: "p" + strings.Repeat("b", 99) + "f",
+			},
+			expErrAlways: []string{"invalid event tag \"pbbbb...bbbbf\" (length 101): exceeds max length 100"},
+		},
+		{
+			name: "multiple errors",
+			msg: MsgMarketCommitmentSettleRequest{
+				Admin:    "",
+				MarketId: 0,
+				Inputs:   []AccountAmount{{Account: toAccAddr("input0"), Amount: coins(-3, "cherry")}},
+				Outputs:  []AccountAmount{{Account: toAccAddr("output0"), Amount: coins(-3, "cherry")}},
+				Fees:     []AccountAmount{{Account: "badfee2addr", Amount: coins(4, "cherry")}},
+				Navs:     []NetAssetPrice{{Assets: coin(-2, "cherry"), Price: coin(87, "nhash")}},
+				EventTag: "p" + strings.Repeat("b", 99) + "f",
+			},
+			expErrInReq: []string{
+				"invalid administrator \"\": " + emptyAddrErr,
+			},
+			expErrAlways: []string{
+				"invalid market id: cannot be zero",
+				"inputs[0]: invalid amount \"-3cherry\": coin -3cherry amount is not positive",
+				"outputs[0]: invalid amount \"-3cherry\": coin -3cherry amount is not positive",
+				"fees[0]: invalid account \"badfee2addr\": " + bech32Err,
+				"navs[0]: invalid assets \"-2cherry\": negative coin amount: -2",
+				"invalid event tag \"pbbbb...bbbbf\" (length 101): exceeds max length 100",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		for _, requireInputs := range []bool{false, true} {
+			t.Run(fmt.Sprintf("%s: Validate(%t)", tc.name, requireInputs), func(t *testing.T) {
+				expErr := getExpErr(tc, requireInputs)
+				var err error
+				testFunc := func() {
+					err = tc.msg.Validate(requireInputs)
+				}
+				require.NotPanics(t, testFunc, "%T.Validate(%t)", tc.msg, requireInputs)
+				assertions.AssertErrorContents(t, err, expErr, "%T.Validate(%t) error", tc.msg, requireInputs)
+			})
+		}
+
+		t.Run(fmt.Sprintf("%s: ValidateBasic()", tc.name), func(t *testing.T) {
+			expErr := getExpErr(tc, true)
+			testValidateBasic(t, &tc.msg, expErr)
+		})
+	}
+}
+
+func TestMsgMarketReleaseCommitmentsRequest_ValidateBasic(t *testing.T) {
+	toAccAddr := func(str string) string {
+		return sdk.AccAddress(str + strings.Repeat("_", 20-len(str))).String()
+	}
+
+	tests := []struct {
+		name   string
+		msg    MsgMarketReleaseCommitmentsRequest
+		expErr []string
+	}{
+		{
+			name: "control",
+			msg: MsgMarketReleaseCommitmentsRequest{
+				Admin:     toAccAddr("admin"),
+				MarketId:  1,
+				ToRelease: []AccountAmount{{Account: toAccAddr("torelease0")}},
+			},
+		},
+		{
+			name: "control with optional fields",
+			msg: MsgMarketReleaseCommitmentsRequest{
+				Admin:     toAccAddr("admin"),
+				MarketId:  1,
+				ToRelease: []AccountAmount{{Account: toAccAddr("torelease0")}},
+				EventTag:  "tagtagtag",
+			},
+		},
+		{
+			name: "no admin",
+			msg: MsgMarketReleaseCommitmentsRequest{
+				Admin:     "",
+				MarketId:  1,
+				ToRelease: []AccountAmount{{Account: toAccAddr("torelease0")}},
+			},
+			expErr: []string{"invalid administrator \"\": " + emptyAddrErr},
+		},
+		{
+			name: "bad admin",
+			msg: MsgMarketReleaseCommitmentsRequest{
+				Admin:     "badbadadmin",
+				MarketId:  1,
+				ToRelease: []AccountAmount{{Account: toAccAddr("torelease0")}},
+			},
+			expErr: []string{"invalid administrator \"badbadadmin\": " + bech32Err},
+		},
+		{
+			name: "market zero",
+			msg: MsgMarketReleaseCommitmentsRequest{
+				Admin:     toAccAddr("admin"),
+				MarketId:  0,
+				ToRelease: []AccountAmount{{Account: toAccAddr("torelease0")}},
+			},
+			expErr: []string{"invalid market id: cannot be zero"},
+		},
+		{
+			name: "nil to release",
+			msg: MsgMarketReleaseCommitmentsRequest{
+				Admin:     toAccAddr("admin"),
+				MarketId:  1,
+				ToRelease: nil,
+			},
+			expErr: []string{"nothing to release"},
+		},
+		{
+			name: "empty to release",
+			msg: MsgMarketReleaseCommitmentsRequest{
+				Admin:     toAccAddr("admin"),
+				MarketId:  1,
+				ToRelease: []AccountAmount{},
+			},
+			expErr: []string{"nothing to release"},
+		},
+		{
+			name: "bad to release entries",
+			msg: MsgMarketReleaseCommitmentsRequest{
+				Admin:    toAccAddr("admin"),
 				MarketId: 1,
-				Inputs:   []AccountAmount{goodAA("input0", "13cherry")},
-				Outputs:  []AccountAmount{goodAA("output0", "13cherry")},
+				ToRelease: []AccountAmount{
+					{Account: "torelease0"},
+					{Account: toAccAddr("torelease1")},
+					{Account: toAccAddr("torelease2"), Amount: sdk.Coins{sdk.Coin{Denom: "cherry", Amount: sdkmath.NewInt(-1)}}},
+				},
+			},
+			expErr: []string{
+				"to release[0]: invalid account \"torelease0\": " + bech32Err,
+				"to release[2]: invalid amount \"-1cherry\": coin -1cherry amount is not positive",
+			},
+		},
+		{
+			name: "bad event tag",
+			msg: MsgMarketReleaseCommitmentsRequest{
+				Admin:     toAccAddr("admin"),
+				MarketId:  1,
+				ToRelease: []AccountAmount{{Account: toAccAddr("torelease0")}},
+				EventTag:  "abcd" + strings.Repeat("M", 93) + "wxyz",
+			},
+			expErr: []string{"invalid event tag \"abcdM...Mwxyz\" (length 101): exceeds max length 100"},
+		},
+		{
+			name: "multiple errors",
+			msg: MsgMarketReleaseCommitmentsRequest{
+				Admin:     "",
+				MarketId:  0,
+				ToRelease: nil,
+				EventTag:  "abcd" + strings.Repeat("M", 93) + "wxyz",
+			},
+			expErr: []string{
+				"invalid administrator \"\": " + emptyAddrErr,
+				"invalid market id: cannot be zero",
+				"nothing to release",
+				"invalid event tag \"abcdM...Mwxyz\" (length 101): exceeds max length 100",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
+func TestMsgMarketTransferCommitmentRequest_ValidateBasic(t *testing.T) {
+	toAccAddr := func(str string) string {
+		return sdk.AccAddress(str + strings.Repeat("_", 20-len(str))).String()
+	}
+
+	tests := []struct {
+		name   string
+		msg    MsgMarketTransferCommitmentRequest
+		expErr []string
+	}{
+		{
+			name: "valid message",
+			msg: MsgMarketTransferCommitmentRequest{
+				Admin:           toAccAddr("admin"),
+				Account:         toAccAddr("to_new_market"),
+				Amount:          sdk.NewCoins(sdk.NewInt64Coin("banana", 12)),
+				CurrentMarketId: 1,
+				NewMarketId:     2,
+				EventTag:        "test",
+			},
+		},
+
+		{
+			name: "no admin",
+			msg: MsgMarketTransferCommitmentRequest{
+				Admin:           "",
+				Account:         toAccAddr("to_new_market"),
+				Amount:          sdk.NewCoins(sdk.NewInt64Coin("banana", 12)),
+				CurrentMarketId: 1,
+				NewMarketId:     2,
+				EventTag:        "test",
+			},
+			expErr: []string{"invalid administrator \"\": " + emptyAddrErr},
+		},
+		{
+			name: "bad admin",
+			msg: MsgMarketTransferCommitmentRequest{
+				Admin:           "badbadadmin",
+				Account:         toAccAddr("to_new_market"),
+				Amount:          sdk.NewCoins(sdk.NewInt64Coin("banana", 12)),
+				CurrentMarketId: 1,
+				NewMarketId:     2,
+			},
+			expErr: []string{"invalid administrator \"badbadadmin\": " + bech32Err},
+		},
+		{
+			name: "current market zero",
+			msg: MsgMarketTransferCommitmentRequest{
+				Admin:           toAccAddr("admin"),
+				Account:         toAccAddr("to_new_market"),
+				Amount:          sdk.NewCoins(sdk.NewInt64Coin("banana", 12)),
+				CurrentMarketId: 0,
+				NewMarketId:     2,
+			},
+			expErr: []string{"invalid current market id: cannot be zero"},
+		},
+		{
+			name: "new market is zero",
+			msg: MsgMarketTransferCommitmentRequest{
+				Admin:           toAccAddr("admin"),
+				Account:         toAccAddr("to_new_market"),
+				Amount:          sdk.NewCoins(sdk.NewInt64Coin("banana", 12)),
+				CurrentMarketId: 1,
+				NewMarketId:     0,
+			},
+			expErr: []string{"invalid new market id: cannot be zero"},
+		},
+		{
+			name: "same current_market and new_market id",
+			msg: MsgMarketTransferCommitmentRequest{
+				Admin:           toAccAddr("admin"),
+				Account:         toAccAddr("to_new_market"),
+				Amount:          sdk.NewCoins(sdk.NewInt64Coin("banana", 12)),
+				CurrentMarketId: 1,
+				NewMarketId:     1,
+			},
+			expErr: []string{"invalid new market id: cannot be the same as current market id"},
+		},
+		{
+			name: "empty account",
+			msg: MsgMarketTransferCommitmentRequest{
+				Admin:           toAccAddr("admin"),
+				Account:         "",
+				Amount:          sdk.NewCoins(sdk.NewInt64Coin("banana", 12)),
+				CurrentMarketId: 1,
+				NewMarketId:     1,
+			},
+			expErr: []string{"empty address string is not allowed"},
+		},
+		{
+			name: "invalid account",
+			msg: MsgMarketTransferCommitmentRequest{
+				Admin:           toAccAddr("admin"),
+				Account:         "invalid_address",
+				Amount:          sdk.NewCoins(sdk.NewInt64Coin("banana", 12)),
+				CurrentMarketId: 1,
+				NewMarketId:     1,
+			},
+			expErr: []string{"decoding bech32 failed: invalid separator index -1"},
+		},
+		{
+			name: "amount is zero",
+			msg: MsgMarketTransferCommitmentRequest{
+				Admin:           toAccAddr("admin"),
+				Account:         "invalid_address",
+				Amount:          sdk.NewCoins(sdk.NewInt64Coin("banana", 0)),
+				CurrentMarketId: 1,
+				NewMarketId:     1,
+			},
+			expErr: []string{"cannot be zero"},
+		},
+		{
+			name: "bad event tag",
+			msg: MsgMarketTransferCommitmentRequest{
+				Admin:           toAccAddr("admin"),
+				Account:         "invalid_address",
+				Amount:          sdk.NewCoins(sdk.NewInt64Coin("banana", 0)),
+				CurrentMarketId: 1,
+				NewMarketId:     2,
+				EventTag:        "abcd" + strings.Repeat("M", 93) + "wxyz",
+			},
+			expErr: []string{"invalid event tag \"abcdM...Mwxyz\" (length 101): exceeds max length 100"},
+		},
+		{
+			name: "multiple errors",
+			msg: MsgMarketTransferCommitmentRequest{
+				Admin:           "",
+				Account:         "invalid_address",
+				Amount:          sdk.NewCoins(sdk.NewInt64Coin("banana", 0)),
+				CurrentMarketId: 1,
+				NewMarketId:     4,
+				EventTag:        "abcd" + strings.Repeat("M", 93) + "wxyz",
+			},
+			expErr: []string{
+				"invalid administrator \"\": " + emptyAddrErr,
+				"empty address string is not allowed",
+				"invalid to address",
+				"decoding bech32 failed: invalid separator index",
+				"cannot be zero",
+				"invalid event tag \"abcdM...Mwxyz\" (length 101): exceeds max length 100",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
+func TestMsgMarketDistributeCommitmentRewardsRequest_ValidateBasic(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________").String()
+	tests := []struct {
+		name   string
+		msg    MsgMarketDistributeCommitmentRewardsRequest
+		expErr []string
+	}{
+		{
+			name: "okay",
+			msg: MsgMarketDistributeCommitmentRewardsRequest{
+				Admin:      admin,
+				MarketId:   1,
+				Amount:     sdk.Coins{sdk.NewInt64Coin("cherry", 52)},
+				BasisDenom: "apple",
+			},
+			expErr: nil,
+		},
+		{
+			name: "no admin",
+			msg: MsgMarketDistributeCommitmentRewardsRequest{
+				Admin:      "",
+				MarketId:   1,
+				Amount:     sdk.Coins{sdk.NewInt64Coin("cherry", 52)},
+				BasisDenom: "apple",
+			},
+			expErr: []string{"invalid administrator \"\": " + emptyAddrErr},
+		},
+		{
+			name: "bad admin",
+			msg: MsgMarketDistributeCommitmentRewardsRequest{
+				Admin:      "badadminstring",
+				MarketId:   1,
+				Amount:     sdk.Coins{sdk.NewInt64Coin("cherry", 52)},
+				BasisDenom: "apple",
+			},
+			expErr: []string{"invalid administrator \"badadminstring\": " + bech32Err},
+		},
+		{
+			name: "market zero",
+			msg: MsgMarketDistributeCommitmentRewardsRequest{
+				Admin:      admin,
+				MarketId:   0,
+				Amount:     sdk.Coins{sdk.NewInt64Coin("cherry", 52)},
+				BasisDenom: "apple",
+			},
+			expErr: []string{"invalid market id: cannot be zero"},
+		},
+		{
+			name: "empty amount",
+			msg: MsgMarketDistributeCommitmentRewardsRequest{
+				Admin:      admin,
+				MarketId:   1,
+				Amount:     sdk.Coins{},
+				BasisDenom: "apple",
+			},
+			expErr: []string{"invalid amount \"\": cannot be zero"},
+		},
+		{
+			name: "bad amount",
+			msg: MsgMarketDistributeCommitmentRewardsRequest{
+				Admin:      admin,
+				MarketId:   1,
+				Amount:     sdk.Coins{sdk.Coin{Denom: "cherry", Amount: sdkmath.NewInt(-3)}},
+				BasisDenom: "apple",
+			},
+			expErr: []string{"invalid amount \"-3cherry\": coin -3cherry amount is not positive"},
+		},
+		{
+			name: "bad basis denom",
+			msg: MsgMarketDistributeCommitmentRewardsRequest{
+				Admin:      admin,
+				MarketId:   1,
+				Amount:     sdk.Coins{sdk.NewInt64Coin("cherry", 52)},
+				BasisDenom: "x",
+			},
+			expErr: []string{"invalid basis denom: invalid denom: x"},
+		},
+		{
+			name: "multiple errors",
+			msg:  MsgMarketDistributeCommitmentRewardsRequest{},
+			expErr: []string{
+				"invalid administrator \"\": " + emptyAddrErr,
+				"invalid market id: cannot be zero",
+				"invalid amount \"\": cannot be zero",
+				"invalid basis denom: invalid denom: ",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
+func TestMsgMarketBulkCancelRequest_ValidateBasic(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________").String()
+	owner := sdk.AccAddress("owner_______________").String()
+	tests := []struct {
+		name   string
+		msg    MsgMarketBulkCancelRequest
+		expErr []string
+	}{
+		{
+			name:   "okay: no filters",
+			msg:    MsgMarketBulkCancelRequest{Admin: admin, MarketId: 1},
+			expErr: nil,
+		},
+		{
+			name: "okay: all filters",
+			msg: MsgMarketBulkCancelRequest{
+				Admin:      admin,
+				MarketId:   1,
+				OrderType:  OrderTypeBid,
+				Owner:      owner,
+				AssetDenom: "apple",
+				Limit:      MaxMarketBulkCancelLimit,
+			},
+			expErr: nil,
+		},
+		{
+			name:   "no admin",
+			msg:    MsgMarketBulkCancelRequest{Admin: "", MarketId: 1},
+			expErr: []string{"invalid administrator \"\": " + emptyAddrErr},
+		},
+		{
+			name:   "bad admin",
+			msg:    MsgMarketBulkCancelRequest{Admin: "badadminstring", MarketId: 1},
+			expErr: []string{"invalid administrator \"badadminstring\": " + bech32Err},
+		},
+		{
+			name:   "market zero",
+			msg:    MsgMarketBulkCancelRequest{Admin: admin, MarketId: 0},
+			expErr: []string{"invalid market id: cannot be zero"},
+		},
+		{
+			name:   "unknown order type",
+			msg:    MsgMarketBulkCancelRequest{Admin: admin, MarketId: 1, OrderType: "asks"},
+			expErr: []string{"invalid order type \"asks\": must be \"ask\", \"bid\", or empty"},
+		},
+		{
+			name:   "bad owner",
+			msg:    MsgMarketBulkCancelRequest{Admin: admin, MarketId: 1, Owner: "badownerstring"},
+			expErr: []string{"invalid owner \"badownerstring\": " + bech32Err},
+		},
+		{
+			name:   "bad asset denom",
+			msg:    MsgMarketBulkCancelRequest{Admin: admin, MarketId: 1, AssetDenom: "x"},
+			expErr: []string{"invalid asset denom: invalid denom: x"},
+		},
+		{
+			name:   "limit too large",
+			msg:    MsgMarketBulkCancelRequest{Admin: admin, MarketId: 1, Limit: MaxMarketBulkCancelLimit + 1},
+			expErr: []string{"invalid limit 1001: cannot be more than 1000"},
+		},
+		{
+			name: "multiple errors",
+			msg:  MsgMarketBulkCancelRequest{OrderType: "other", Limit: 5000},
+			expErr: []string{
+				"invalid administrator \"\": " + emptyAddrErr,
+				"invalid market id: cannot be zero",
+				"invalid order type \"other\": must be \"ask\", \"bid\", or empty",
+				"invalid limit 5000: cannot be more than 1000",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
+func TestMsgMarketSetOrderExternalIDRequest_ValidateBasic(t *testing.T) {
+	admin := sdk.AccAddress("admin_address_______").String()
+
+	tests := []struct {
+		name   string
+		msg    MsgMarketSetOrderExternalIDRequest
+		expErr []string
+	}{
+		{
+			name: "control",
+			msg: MsgMarketSetOrderExternalIDRequest{
+				Admin:      admin,
+				MarketId:   5,
+				OrderId:    12,
+				ExternalId: "twenty-eight",
+			},
+			expErr: nil,
+		},
+		{
+			name: "no admin",
+			msg: MsgMarketSetOrderExternalIDRequest{
+				Admin:      "",
+				MarketId:   5,
+				OrderId:    12,
+				ExternalId: "twenty-eight",
+			},
+			expErr: []string{"invalid administrator \"\"", emptyAddrErr},
+		},
+		{
+			name: "bad admin",
+			msg: MsgMarketSetOrderExternalIDRequest{
+				Admin:      "badadmin",
+				MarketId:   5,
+				OrderId:    12,
+				ExternalId: "twenty-eight",
+			},
+			expErr: []string{"invalid administrator \"badadmin\"", bech32Err},
+		},
+		{
+			name: "market zero",
+			msg: MsgMarketSetOrderExternalIDRequest{
+				Admin:      admin,
+				MarketId:   0,
+				OrderId:    12,
+				ExternalId: "twenty-eight",
+			},
+			expErr: []string{"invalid market id: cannot be zero"},
+		},
+		{
+			name: "empty external id",
+			msg: MsgMarketSetOrderExternalIDRequest{
+				Admin:      admin,
+				MarketId:   5,
+				OrderId:    12,
+				ExternalId: "",
+			},
+			expErr: nil,
+		},
+		{
+			name: "external id at max length",
+			msg: MsgMarketSetOrderExternalIDRequest{
+				Admin:      admin,
+				MarketId:   5,
+				OrderId:    12,
+				ExternalId: strings.Repeat("y", MaxExternalIDLength),
+			},
+			expErr: nil,
+		},
+		{
+			name: "external id more than max length",
+			msg: MsgMarketSetOrderExternalIDRequest{
+				Admin:      admin,
+				MarketId:   5,
+				OrderId:    12,
+				ExternalId: strings.Repeat("r", MaxExternalIDLength+1),
+			},
+			expErr: []string{
+				fmt.Sprintf("invalid external id %q (length %d): max length %d",
+					"rrrrr...rrrrr", MaxExternalIDLength+1, MaxExternalIDLength),
+			},
+		},
+		{
+			name: "order zero",
+			msg: MsgMarketSetOrderExternalIDRequest{
+				Admin:      admin,
+				MarketId:   5,
+				OrderId:    0,
+				ExternalId: "twenty-eight",
 			},
-			expErrInReq: []string{"invalid administrator \"\": " + emptyAddrErr},
+			expErr: []string{"invalid order id: cannot be zero"},
 		},
 		{
-			name: "bad admin",
-			msg: MsgMarketCommitmentSettleRequest{
-				Admin:    "badbadadmin",
-				MarketId: 1,
-				Inputs:   []AccountAmount{goodAA("input0", "13cherry")},
-				Outputs:  []AccountAmount{goodAA("output0", "13cherry")},
+			name: "multiple errors",
+			msg: MsgMarketSetOrderExternalIDRequest{
+				Admin:      "",
+				MarketId:   0,
+				OrderId:    0,
+				ExternalId: strings.Repeat("V", MaxExternalIDLength+1),
+			},
+			expErr: []string{
+				"invalid administrator \"\"", emptyAddrErr,
+				"invalid market id: cannot be zero",
+				fmt.Sprintf("invalid external id %q (length %d): max length %d",
+					"VVVVV...VVVVV", MaxExternalIDLength+1, MaxExternalIDLength),
+				"invalid order id: cannot be zero",
 			},
-			expErrInReq: []string{"invalid administrator \"badbadadmin\": " + bech32Err},
 		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
+func TestMsgMarketWithdrawRequest_ValidateBasic(t *testing.T) {
+	coin := func(amount int64, denom string) sdk.Coin {
+		return sdk.Coin{Denom: denom, Amount: sdkmath.NewInt(amount)}
+	}
+	goodAdminAddr := sdk.AccAddress("Administrator_______").String()
+	goodToAddr := sdk.AccAddress("ToAddress___________").String()
+	goodCoins := sdk.NewCoins(coin(3, "acorns"))
+
+	tests := []struct {
+		name   string
+		msg    MsgMarketWithdrawRequest
+		expErr []string
+	}{
 		{
-			name: "market zero",
-			msg: MsgMarketCommitmentSettleRequest{
-				Admin:    toAccAddr("admin"),
-				MarketId: 0,
-				Inputs:   []AccountAmount{goodAA("input0", "13cherry")},
-				Outputs:  []AccountAmount{goodAA("output0", "13cherry")},
+			name: "control",
+			msg: MsgMarketWithdrawRequest{
+				Admin:     goodAdminAddr,
+				MarketId:  1,
+				ToAddress: goodToAddr,
+				Amount:    goodCoins,
 			},
-			expErrAlways: []string{"invalid market id: cannot be zero"},
+			expErr: nil,
 		},
 		{
-			name: "no inputs",
-			msg: MsgMarketCommitmentSettleRequest{
-				Admin:    toAccAddr("admin"),
-				MarketId: 1,
-				Outputs:  []AccountAmount{goodAA("output0", "13cherry")},
+			name: "no administrator",
+			msg: MsgMarketWithdrawRequest{
+				Admin:     "",
+				MarketId:  1,
+				ToAddress: goodToAddr,
+				Amount:    goodCoins,
 			},
-			expErrInReq: []string{"no inputs provided"},
-			expErrInOpt: []string{"input total \"\" does not equal output total \"13cherry\""},
+			expErr: []string{`invalid administrator ""`, emptyAddrErr},
 		},
 		{
-			name: "bad inputs",
-			msg: MsgMarketCommitmentSettleRequest{
-				Admin:    toAccAddr("admin"),
-				MarketId: 1,
-				Inputs: []AccountAmount{
-					{Account: toAccAddr("input0"), Amount: coins(-3, "cherry")},
-					goodAA("input1", "8cherry"),
-					{Account: "badinput2addr", Amount: coins(4, "cherry")},
-					{Account: toAccAddr("input3"), Amount: nil},
-				},
+			name: "bad administrator",
+			msg: MsgMarketWithdrawRequest{
+				Admin:     "notright",
+				MarketId:  1,
+				ToAddress: goodToAddr,
+				Amount:    goodCoins,
 			},
-			expErrInReq: []string{"no outputs provided"},
-			expErrAlways: []string{
-				"inputs[0]: invalid amount \"-3cherry\": coin -3cherry amount is not positive",
-				"inputs[2]: invalid account \"badinput2addr\": " + bech32Err,
-				"inputs[3]: invalid amount \"\": cannot be zero",
+			expErr: []string{`invalid administrator "notright"`, bech32Err},
+		},
+		{
+			name: "market id zero",
+			msg: MsgMarketWithdrawRequest{
+				Admin:     goodAdminAddr,
+				MarketId:  0,
+				ToAddress: goodToAddr,
+				Amount:    goodCoins,
 			},
+			expErr: []string{"invalid market id", "cannot be zero"},
 		},
 		{
-			name: "no outputs",
-			msg: MsgMarketCommitmentSettleRequest{
-				Admin:    toAccAddr("admin"),
-				MarketId: 1,
-				Inputs:   []AccountAmount{goodAA("input0", "13cherry")},
+			name: "no to address",
+			msg: MsgMarketWithdrawRequest{
+				Admin:     goodAdminAddr,
+				MarketId:  1,
+				ToAddress: "",
+				Amount:    goodCoins,
 			},
-			expErrInReq: []string{"no outputs provided"},
-			expErrInOpt: []string{"input total \"13cherry\" does not equal output total \"\""},
+			expErr: []string{`invalid to address ""`, emptyAddrErr},
 		},
 		{
-			name: "bad outputs",
-			msg: MsgMarketCommitmentSettleRequest{
-				Admin:    toAccAddr("admin"),
+			name: "bad to address",
+			msg: MsgMarketWithdrawRequest{
+				Admin:     goodAdminAddr,
+				MarketId:  1,
+				ToAddress: "notright",
+				Amount:    goodCoins,
+			},
+			expErr: []string{`invalid to address "notright"`, bech32Err},
+		},
+		{
+			name: "invalid denom in amount",
+			msg: MsgMarketWithdrawRequest{
+				Admin:     goodAdminAddr,
+				MarketId:  1,
+				ToAddress: goodToAddr,
+				Amount:    sdk.Coins{coin(3, "x")},
+			},
+			expErr: []string{`invalid amount "3x"`, "invalid denom: x"},
+		},
+		{
+			name: "negative amount",
+			msg: MsgMarketWithdrawRequest{
+				Admin:     goodAdminAddr,
+				MarketId:  1,
+				ToAddress: goodToAddr,
+				Amount:    sdk.Coins{coin(-1, "negcoin")},
+			},
+			expErr: []string{`invalid amount "-1negcoin"`, "coin -1negcoin amount is not positive"},
+		},
+		{
+			name: "empty amount",
+			msg: MsgMarketWithdrawRequest{
+				Admin:     goodAdminAddr,
+				MarketId:  1,
+				ToAddress: goodToAddr,
+				Amount:    sdk.Coins{},
+			},
+			expErr: []string{`invalid amount ""`, "cannot be zero"},
+		},
+		{
+			name: "zero coin amount",
+			msg: MsgMarketWithdrawRequest{
+				Admin:     goodAdminAddr,
+				MarketId:  1,
+				ToAddress: goodToAddr,
+				Amount:    sdk.Coins{coin(0, "acorn"), coin(0, "banana")},
+			},
+			expErr: []string{`invalid amount "0acorn,0banana"`, "coin 0acorn amount is not positive"},
+		},
+		{
+			name: "multiple errors",
+			msg:  MsgMarketWithdrawRequest{},
+			expErr: []string{
+				"invalid administrator",
+				"invalid market id",
+				"invalid to address",
+				"invalid amount",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
+func TestMsgMarketUpdateDetailsRequest_ValidateBasic(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________").String()
+	tooLongErr := func(field string, max int) string {
+		return fmt.Sprintf("%s length %d exceeds maximum length of %d", field, max+1, max)
+	}
+
+	tests := []struct {
+		name   string
+		msg    MsgMarketUpdateDetailsRequest
+		expErr []string
+	}{
+		{
+			name: "control",
+			msg: MsgMarketUpdateDetailsRequest{
+				Admin:    admin,
 				MarketId: 1,
-				Outputs: []AccountAmount{
-					{Account: toAccAddr("output0"), Amount: coins(-3, "cherry")},
-					goodAA("output1", "8cherry"),
-					{Account: "badoutput2addr", Amount: coins(4, "cherry")},
-					{Account: toAccAddr("output3"), Amount: sdk.Coins{}},
+				MarketDetails: MarketDetails{
+					Name:        "MyMarket",
+					Description: "This is my own market only for me.",
+					WebsiteUrl:  "https://example.com",
+					IconUri:     "https://example.com/icon",
 				},
 			},
-			expErrInReq: []string{"no inputs provided"},
-			expErrAlways: []string{
-				"outputs[0]: invalid amount \"-3cherry\": coin -3cherry amount is not positive",
-				"outputs[2]: invalid account \"badoutput2addr\": " + bech32Err,
-				"outputs[3]: invalid amount \"\": cannot be zero",
+			expErr: nil,
+		},
+		{
+			name: "empty admin",
+			msg: MsgMarketUpdateDetailsRequest{
+				Admin:         "",
+				MarketId:      1,
+				MarketDetails: MarketDetails{},
 			},
+			expErr: []string{`invalid administrator ""`, emptyAddrErr},
 		},
 		{
-			name: "input output amounts not equal",
-			msg: MsgMarketCommitmentSettleRequest{
-				Admin:    toAccAddr("admin"),
+			name: "invalid admin",
+			msg: MsgMarketUpdateDetailsRequest{
+				Admin:         "notvalidadmin",
+				MarketId:      1,
+				MarketDetails: MarketDetails{},
+			},
+			expErr: []string{`invalid administrator "notvalidadmin"`, bech32Err},
+		},
+		{
+			name: "market id zero",
+			msg: MsgMarketUpdateDetailsRequest{
+				Admin:         admin,
+				MarketId:      0,
+				MarketDetails: MarketDetails{},
+			},
+			expErr: []string{"invalid market id", "cannot be zero"},
+		},
+		{
+			name: "name too long",
+			msg: MsgMarketUpdateDetailsRequest{
+				Admin:    admin,
 				MarketId: 1,
-				Inputs:   []AccountAmount{goodAA("input0", "13cherry"), goodAA("input1", "36cherry")},
-				Outputs:  []AccountAmount{goodAA("output0", "25cherry"), goodAA("output1", "23cherry")},
+				MarketDetails: MarketDetails{
+					Name: strings.Repeat("p", MaxName+1),
+				},
 			},
-			expErrAlways: []string{"input total \"49cherry\" does not equal output total \"48cherry\""},
+			expErr: []string{tooLongErr("name", MaxName)},
 		},
 		{
-			name: "bad fees",
-			msg: MsgMarketCommitmentSettleRequest{
-				Admin:    toAccAddr("admin"),
+			name: "description too long",
+			msg: MsgMarketUpdateDetailsRequest{
+				Admin:    admin,
 				MarketId: 1,
-				Inputs:   []AccountAmount{goodAA("input0", "13cherry")},
-				Outputs:  []AccountAmount{goodAA("output0", "13cherry")},
-				Fees: []AccountAmount{
-					{Account: "badfee0addr", Amount: coins(4, "cherry")},
-					goodAA("fee1", "8cherry"),
-					{Account: toAccAddr("fee2"), Amount: coins(-3, "cherry")},
-					{Account: toAccAddr("fee3"), Amount: nil},
+				MarketDetails: MarketDetails{
+					Description: strings.Repeat("d", MaxDescription+1),
 				},
 			},
-			expErrAlways: []string{
-				"fees[0]: invalid account \"badfee0addr\": " + bech32Err,
-				"fees[2]: invalid amount \"-3cherry\": coin -3cherry amount is not positive",
-				"fees[3]: invalid amount \"\": cannot be zero",
-			},
+			expErr: []string{tooLongErr("description", MaxDescription)},
 		},
 		{
-			name: "bad navs",
-			msg: MsgMarketCommitmentSettleRequest{
-				Admin:    toAccAddr("admin"),
+			name: "website_url too long",
+			msg: MsgMarketUpdateDetailsRequest{
+				Admin:    admin,
 				MarketId: 1,
-				Inputs:   []AccountAmount{goodAA("input0", "13cherry")},
-				Outputs:  []AccountAmount{goodAA("output0", "13cherry")},
-				Navs: []NetAssetPrice{
-					{Assets: coin(-2, "cherry"), Price: coin(87, "nhash")},
-					goodNAV("13cherry", "1990musd"),
-					{Assets: coin(57, "cherry"), Price: coin(52, "x")},
+				MarketDetails: MarketDetails{
+					WebsiteUrl: strings.Repeat("w", MaxWebsiteURL+1),
 				},
 			},
-			expErrAlways: []string{
-				"navs[0]: invalid assets \"-2cherry\": negative coin amount: -2",
-				"navs[2]: invalid price \"52x\": invalid denom: x",
-			},
+			expErr: []string{tooLongErr("website_url", MaxWebsiteURL)},
 		},
 		{
-			name: "bad event tag",
-			msg: MsgMarketCommitmentSettleRequest{
-				Admin:    toAccAddr("admin"),
+			name: "icon_uri too long",
+			msg: MsgMarketUpdateDetailsRequest{
+				Admin:    admin,
 				MarketId: 1,
-				Inputs:   []AccountAmount{goodAA("input0", "13cherry")},
-				Outputs:  []AccountAmount{goodAA("output0", "13cherry")},
-				EventTag: "p" + strings.Repeat("b", 99) + "f",
+				MarketDetails: MarketDetails{
+					IconUri: strings.Repeat("i", MaxIconURI+1),
+				},
 			},
-			expErrAlways: []string{"invalid event tag \"pbbbb...bbbbf\" (length 101): exceeds max length 100"},
+			expErr: []string{tooLongErr("icon_uri", MaxIconURI)},
 		},
 		{
 			name: "multiple errors",
-			msg: MsgMarketCommitmentSettleRequest{
+			msg: MsgMarketUpdateDetailsRequest{
 				Admin:    "",
 				MarketId: 0,
-				Inputs:   []AccountAmount{{Account: toAccAddr("input0"), Amount: coins(-3, "cherry")}},
-				Outputs:  []AccountAmount{{Account: toAccAddr("output0"), Amount: coins(-3, "cherry")}},
-				Fees:     []AccountAmount{{Account: "badfee2addr", Amount: coins(4, "cherry")}},
-				Navs:     []NetAssetPrice{{Assets: coin(-2, "cherry"), Price: coin(87, "nhash")}},
-				EventTag: "p" + strings.Repeat("b", 99) + "f",
-			},
-			expErrInReq: []string{
-				"invalid administrator \"\": " + emptyAddrErr,
+				MarketDetails: MarketDetails{
+					Name:        strings.Repeat("p", MaxName+1),
+					Description: strings.Repeat("d", MaxDescription+1),
+					WebsiteUrl:  strings.Repeat("w", MaxWebsiteURL+1),
+					IconUri:     strings.Repeat("i", MaxIconURI+1),
+				},
 			},
-			expErrAlways: []string{
-				"invalid market id: cannot be zero",
-				"inputs[0]: invalid amount \"-3cherry\": coin -3cherry amount is not positive",
-				"outputs[0]: invalid amount \"-3cherry\": coin -3cherry amount is not positive",
-				"fees[0]: invalid account \"badfee2addr\": " + bech32Err,
-				"navs[0]: invalid assets \"-2cherry\": negative coin amount: -2",
-				"invalid event tag \"pbbbb...bbbbf\" (length 101): exceeds max length 100",
+			expErr: []string{
+				"invalid administrator",
+				"invalid market id",
+				tooLongErr("name", MaxName),
+				tooLongErr("description", MaxDescription),
+				tooLongErr("website_url", MaxWebsiteURL),
+				tooLongErr("icon_uri", MaxIconURI),
 			},
 		},
 	}
 
 	for _, tc := range tests {
-		for _, requireInputs := range []bool{false, true} {
-			t.Run(fmt.Sprintf("%s: Validate(%t)", tc.name, requireInputs), func(t *testing.T) {
-				expErr := getExpErr(tc, requireInputs)
-				var err error
-				testFunc := func() {
-					err = tc.msg.Validate(requireInputs)
-				}
-				require.NotPanics(t, testFunc, "%T.Validate(%t)", tc.msg, requireInputs)
-				assertions.AssertErrorContents(t, err, expErr, "%T.Validate(%t) error", tc.msg, requireInputs)
-			})
-		}
-
-		t.Run(fmt.Sprintf("%s: ValidateBasic()", tc.name), func(t *testing.T) {
-			expErr := getExpErr(tc, true)
-			testValidateBasic(t, &tc.msg, expErr)
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
 		})
 	}
 }
 
-func TestMsgMarketReleaseCommitmentsRequest_ValidateBasic(t *testing.T) {
-	toAccAddr := func(str string) string {
-		return sdk.AccAddress(str + strings.Repeat("_", 20-len(str))).String()
-	}
+func TestMsgMarketUpdateEnabledRequest_ValidateBasic(t *testing.T) {
+	msg := MsgMarketUpdateEnabledRequest{}
+	expErr := []string{"the MarketUpdateEnabled endpoint has been replaced by the MarketUpdateAcceptingOrders endpoint"}
+	testValidateBasic(t, &msg, expErr)
+}
+
+func TestMsgMarketUpdateAcceptingOrdersRequest_ValidateBasic(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________").String()
 
 	tests := []struct {
 		name   string
-		msg    MsgMarketReleaseCommitmentsRequest
+		msg    MsgMarketUpdateAcceptingOrdersRequest
 		expErr []string
 	}{
 		{
-			name: "control",
-			msg: MsgMarketReleaseCommitmentsRequest{
-				Admin:     toAccAddr("admin"),
-				MarketId:  1,
-				ToRelease: []AccountAmount{{Account: toAccAddr("torelease0")}},
+			name: "control: true",
+			msg: MsgMarketUpdateAcceptingOrdersRequest{
+				Admin:           admin,
+				MarketId:        1,
+				AcceptingOrders: true,
 			},
+			expErr: nil,
 		},
 		{
-			name: "control with optional fields",
-			msg: MsgMarketReleaseCommitmentsRequest{
-				Admin:     toAccAddr("admin"),
-				MarketId:  1,
-				ToRelease: []AccountAmount{{Account: toAccAddr("torelease0")}},
-				EventTag:  "tagtagtag",
+			name: "control: false",
+			msg: MsgMarketUpdateAcceptingOrdersRequest{
+				Admin:           admin,
+				MarketId:        1,
+				AcceptingOrders: true,
 			},
+			expErr: nil,
 		},
 		{
-			name: "no admin",
-			msg: MsgMarketReleaseCommitmentsRequest{
-				Admin:     "",
-				MarketId:  1,
-				ToRelease: []AccountAmount{{Account: toAccAddr("torelease0")}},
+			name: "empty admin",
+			msg: MsgMarketUpdateAcceptingOrdersRequest{
+				Admin:    "",
+				MarketId: 1,
+			},
+			expErr: []string{
+				`invalid administrator ""`, emptyAddrErr,
 			},
-			expErr: []string{"invalid administrator \"\": " + emptyAddrErr},
 		},
 		{
 			name: "bad admin",
-			msg: MsgMarketReleaseCommitmentsRequest{
-				Admin:     "badbadadmin",
-				MarketId:  1,
-				ToRelease: []AccountAmount{{Account: toAccAddr("torelease0")}},
+			msg: MsgMarketUpdateAcceptingOrdersRequest{
+				Admin:    "badadmin",
+				MarketId: 1,
+			},
+			expErr: []string{
+				`invalid administrator "badadmin"`, bech32Err,
 			},
-			expErr: []string{"invalid administrator \"badbadadmin\": " + bech32Err},
 		},
 		{
-			name: "market zero",
-			msg: MsgMarketReleaseCommitmentsRequest{
-				Admin:     toAccAddr("admin"),
-				MarketId:  0,
-				ToRelease: []AccountAmount{{Account: toAccAddr("torelease0")}},
+			name: "market id zero",
+			msg: MsgMarketUpdateAcceptingOrdersRequest{
+				Admin:    admin,
+				MarketId: 0,
+			},
+			expErr: []string{
+				"invalid market id", "cannot be zero",
 			},
-			expErr: []string{"invalid market id: cannot be zero"},
 		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
+func TestMsgMarketUpdateUserSettleRequest_ValidateBasic(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________").String()
+
+	tests := []struct {
+		name   string
+		msg    MsgMarketUpdateUserSettleRequest
+		expErr []string
+	}{
 		{
-			name: "nil to release",
-			msg: MsgMarketReleaseCommitmentsRequest{
-				Admin:     toAccAddr("admin"),
-				MarketId:  1,
-				ToRelease: nil,
+			name: "control: true",
+			msg: MsgMarketUpdateUserSettleRequest{
+				Admin:               admin,
+				MarketId:            1,
+				AllowUserSettlement: true,
 			},
-			expErr: []string{"nothing to release"},
+			expErr: nil,
 		},
 		{
-			name: "empty to release",
-			msg: MsgMarketReleaseCommitmentsRequest{
-				Admin:     toAccAddr("admin"),
-				MarketId:  1,
-				ToRelease: []AccountAmount{},
+			name: "control: false",
+			msg: MsgMarketUpdateUserSettleRequest{
+				Admin:               admin,
+				MarketId:            1,
+				AllowUserSettlement: true,
 			},
-			expErr: []string{"nothing to release"},
+			expErr: nil,
 		},
 		{
-			name: "bad to release entries",
-			msg: MsgMarketReleaseCommitmentsRequest{
-				Admin:    toAccAddr("admin"),
+			name: "empty admin",
+			msg: MsgMarketUpdateUserSettleRequest{
+				Admin:    "",
 				MarketId: 1,
-				ToRelease: []AccountAmount{
-					{Account: "torelease0"},
-					{Account: toAccAddr("torelease1")},
-					{Account: toAccAddr("torelease2"), Amount: sdk.Coins{sdk.Coin{Denom: "cherry", Amount: sdkmath.NewInt(-1)}}},
-				},
 			},
 			expErr: []string{
-				"to release[0]: invalid account \"torelease0\": " + bech32Err,
-				"to release[2]: invalid amount \"-1cherry\": coin -1cherry amount is not positive",
+				`invalid administrator ""`, emptyAddrErr,
 			},
 		},
 		{
-			name: "bad event tag",
-			msg: MsgMarketReleaseCommitmentsRequest{
-				Admin:     toAccAddr("admin"),
-				MarketId:  1,
-				ToRelease: []AccountAmount{{Account: toAccAddr("torelease0")}},
-				EventTag:  "abcd" + strings.Repeat("M", 93) + "wxyz",
+			name: "bad admin",
+			msg: MsgMarketUpdateUserSettleRequest{
+				Admin:    "badadmin",
+				MarketId: 1,
 			},
-			expErr: []string{"invalid event tag \"abcdM...Mwxyz\" (length 101): exceeds max length 100"},
-		},
-		{
-			name: "multiple errors",
-			msg: MsgMarketReleaseCommitmentsRequest{
-				Admin:     "",
-				MarketId:  0,
-				ToRelease: nil,
-				EventTag:  "abcd" + strings.Repeat("M", 93) + "wxyz",
+			expErr: []string{
+				`invalid administrator "badadmin"`, bech32Err,
+			},
+		},
+		{
+			name: "market id zero",
+			msg: MsgMarketUpdateUserSettleRequest{
+				Admin:    admin,
+				MarketId: 0,
 			},
 			expErr: []string{
-				"invalid administrator \"\": " + emptyAddrErr,
-				"invalid market id: cannot be zero",
-				"nothing to release",
-				"invalid event tag \"abcdM...Mwxyz\" (length 101): exceeds max length 100",
+				"invalid market id", "cannot be zero",
 			},
 		},
 	}
@@ -1340,146 +3075,121 @@ func TestMsgMarketReleaseCommitmentsRequest_ValidateBasic(t *testing.T) {
 	}
 }
 
-func TestMsgMarketTransferCommitmentRequest_ValidateBasic(t *testing.T) {
-	toAccAddr := func(str string) string {
-		return sdk.AccAddress(str + strings.Repeat("_", 20-len(str))).String()
-	}
-
+func TestMsgMarketUpdateAcceptingCommitmentsRequest_ValidateBasic(t *testing.T) {
 	tests := []struct {
 		name   string
-		msg    MsgMarketTransferCommitmentRequest
+		msg    MsgMarketUpdateAcceptingCommitmentsRequest
 		expErr []string
 	}{
 		{
-			name: "valid message",
-			msg: MsgMarketTransferCommitmentRequest{
-				Admin:           toAccAddr("admin"),
-				Account:         toAccAddr("to_new_market"),
-				Amount:          sdk.NewCoins(sdk.NewInt64Coin("banana", 12)),
-				CurrentMarketId: 1,
-				NewMarketId:     2,
-				EventTag:        "test",
+			name: "control: false",
+			msg: MsgMarketUpdateAcceptingCommitmentsRequest{
+				Admin:                sdk.AccAddress("admin_______________").String(),
+				MarketId:             1,
+				AcceptingCommitments: false,
+			},
+		},
+		{
+			name: "control: true",
+			msg: MsgMarketUpdateAcceptingCommitmentsRequest{
+				Admin:                sdk.AccAddress("admin_______________").String(),
+				MarketId:             1,
+				AcceptingCommitments: true,
 			},
 		},
-
 		{
 			name: "no admin",
-			msg: MsgMarketTransferCommitmentRequest{
-				Admin:           "",
-				Account:         toAccAddr("to_new_market"),
-				Amount:          sdk.NewCoins(sdk.NewInt64Coin("banana", 12)),
-				CurrentMarketId: 1,
-				NewMarketId:     2,
-				EventTag:        "test",
+			msg: MsgMarketUpdateAcceptingCommitmentsRequest{
+				Admin:    "",
+				MarketId: 1,
 			},
 			expErr: []string{"invalid administrator \"\": " + emptyAddrErr},
 		},
 		{
 			name: "bad admin",
-			msg: MsgMarketTransferCommitmentRequest{
-				Admin:           "badbadadmin",
-				Account:         toAccAddr("to_new_market"),
-				Amount:          sdk.NewCoins(sdk.NewInt64Coin("banana", 12)),
-				CurrentMarketId: 1,
-				NewMarketId:     2,
+			msg: MsgMarketUpdateAcceptingCommitmentsRequest{
+				Admin:    "notanadminaddr",
+				MarketId: 1,
 			},
-			expErr: []string{"invalid administrator \"badbadadmin\": " + bech32Err},
+			expErr: []string{"invalid administrator \"notanadminaddr\": " + bech32Err},
 		},
 		{
-			name: "current market zero",
-			msg: MsgMarketTransferCommitmentRequest{
-				Admin:           toAccAddr("admin"),
-				Account:         toAccAddr("to_new_market"),
-				Amount:          sdk.NewCoins(sdk.NewInt64Coin("banana", 12)),
-				CurrentMarketId: 0,
-				NewMarketId:     2,
+			name: "market zero",
+			msg: MsgMarketUpdateAcceptingCommitmentsRequest{
+				Admin:    sdk.AccAddress("admin_______________").String(),
+				MarketId: 0,
 			},
-			expErr: []string{"invalid current market id: cannot be zero"},
+			expErr: []string{"invalid market id: cannot be zero"},
 		},
 		{
-			name: "new market is zero",
-			msg: MsgMarketTransferCommitmentRequest{
-				Admin:           toAccAddr("admin"),
-				Account:         toAccAddr("to_new_market"),
-				Amount:          sdk.NewCoins(sdk.NewInt64Coin("banana", 12)),
-				CurrentMarketId: 1,
-				NewMarketId:     0,
+			name: "multiple errors",
+			msg:  MsgMarketUpdateAcceptingCommitmentsRequest{},
+			expErr: []string{
+				"invalid administrator \"\": " + emptyAddrErr,
+				"invalid market id: cannot be zero",
 			},
-			expErr: []string{"invalid new market id: cannot be zero"},
 		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
+func TestMsgMarketUpdateContinuousMatchingRequest_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name   string
+		msg    MsgMarketUpdateContinuousMatchingRequest
+		expErr []string
+	}{
 		{
-			name: "same current_market and new_market id",
-			msg: MsgMarketTransferCommitmentRequest{
-				Admin:           toAccAddr("admin"),
-				Account:         toAccAddr("to_new_market"),
-				Amount:          sdk.NewCoins(sdk.NewInt64Coin("banana", 12)),
-				CurrentMarketId: 1,
-				NewMarketId:     1,
+			name: "control: false",
+			msg: MsgMarketUpdateContinuousMatchingRequest{
+				Admin:              sdk.AccAddress("admin_______________").String(),
+				MarketId:           1,
+				ContinuousMatching: false,
 			},
-			expErr: []string{"invalid new market id: cannot be the same as current market id"},
 		},
 		{
-			name: "empty account",
-			msg: MsgMarketTransferCommitmentRequest{
-				Admin:           toAccAddr("admin"),
-				Account:         "",
-				Amount:          sdk.NewCoins(sdk.NewInt64Coin("banana", 12)),
-				CurrentMarketId: 1,
-				NewMarketId:     1,
+			name: "control: true",
+			msg: MsgMarketUpdateContinuousMatchingRequest{
+				Admin:              sdk.AccAddress("admin_______________").String(),
+				MarketId:           1,
+				ContinuousMatching: true,
 			},
-			expErr: []string{"empty address string is not allowed"},
 		},
 		{
-			name: "invalid account",
-			msg: MsgMarketTransferCommitmentRequest{
-				Admin:           toAccAddr("admin"),
-				Account:         "invalid_address",
-				Amount:          sdk.NewCoins(sdk.NewInt64Coin("banana", 12)),
-				CurrentMarketId: 1,
-				NewMarketId:     1,
+			name: "no admin",
+			msg: MsgMarketUpdateContinuousMatchingRequest{
+				Admin:    "",
+				MarketId: 1,
 			},
-			expErr: []string{"decoding bech32 failed: invalid separator index -1"},
+			expErr: []string{"invalid administrator \"\": " + emptyAddrErr},
 		},
 		{
-			name: "amount is zero",
-			msg: MsgMarketTransferCommitmentRequest{
-				Admin:           toAccAddr("admin"),
-				Account:         "invalid_address",
-				Amount:          sdk.NewCoins(sdk.NewInt64Coin("banana", 0)),
-				CurrentMarketId: 1,
-				NewMarketId:     1,
+			name: "bad admin",
+			msg: MsgMarketUpdateContinuousMatchingRequest{
+				Admin:    "notanadminaddr",
+				MarketId: 1,
 			},
-			expErr: []string{"cannot be zero"},
+			expErr: []string{"invalid administrator \"notanadminaddr\": " + bech32Err},
 		},
 		{
-			name: "bad event tag",
-			msg: MsgMarketTransferCommitmentRequest{
-				Admin:           toAccAddr("admin"),
-				Account:         "invalid_address",
-				Amount:          sdk.NewCoins(sdk.NewInt64Coin("banana", 0)),
-				CurrentMarketId: 1,
-				NewMarketId:     2,
-				EventTag:        "abcd" + strings.Repeat("M", 93) + "wxyz",
+			name: "market zero",
+			msg: MsgMarketUpdateContinuousMatchingRequest{
+				Admin:    sdk.AccAddress("admin_______________").String(),
+				MarketId: 0,
 			},
-			expErr: []string{"invalid event tag \"abcdM...Mwxyz\" (length 101): exceeds max length 100"},
+			expErr: []string{"invalid market id: cannot be zero"},
 		},
 		{
 			name: "multiple errors",
-			msg: MsgMarketTransferCommitmentRequest{
-				Admin:           "",
-				Account:         "invalid_address",
-				Amount:          sdk.NewCoins(sdk.NewInt64Coin("banana", 0)),
-				CurrentMarketId: 1,
-				NewMarketId:     4,
-				EventTag:        "abcd" + strings.Repeat("M", 93) + "wxyz",
-			},
+			msg:  MsgMarketUpdateContinuousMatchingRequest{},
 			expErr: []string{
 				"invalid administrator \"\": " + emptyAddrErr,
-				"empty address string is not allowed",
-				"invalid to address",
-				"decoding bech32 failed: invalid separator index",
-				"cannot be zero",
-				"invalid event tag \"abcdM...Mwxyz\" (length 101): exceeds max length 100",
+				"invalid market id: cannot be zero",
 			},
 		},
 	}
@@ -1491,111 +3201,58 @@ func TestMsgMarketTransferCommitmentRequest_ValidateBasic(t *testing.T) {
 	}
 }
 
-func TestMsgMarketSetOrderExternalIDRequest_ValidateBasic(t *testing.T) {
-	admin := sdk.AccAddress("admin_address_______").String()
-
+func TestMsgMarketUpdateBatchAuctionRequest_ValidateBasic(t *testing.T) {
 	tests := []struct {
 		name   string
-		msg    MsgMarketSetOrderExternalIDRequest
+		msg    MsgMarketUpdateBatchAuctionRequest
 		expErr []string
 	}{
 		{
-			name: "control",
-			msg: MsgMarketSetOrderExternalIDRequest{
-				Admin:      admin,
-				MarketId:   5,
-				OrderId:    12,
-				ExternalId: "twenty-eight",
-			},
-			expErr: nil,
-		},
-		{
-			name: "no admin",
-			msg: MsgMarketSetOrderExternalIDRequest{
-				Admin:      "",
-				MarketId:   5,
-				OrderId:    12,
-				ExternalId: "twenty-eight",
-			},
-			expErr: []string{"invalid administrator \"\"", emptyAddrErr},
-		},
-		{
-			name: "bad admin",
-			msg: MsgMarketSetOrderExternalIDRequest{
-				Admin:      "badadmin",
-				MarketId:   5,
-				OrderId:    12,
-				ExternalId: "twenty-eight",
-			},
-			expErr: []string{"invalid administrator \"badadmin\"", bech32Err},
-		},
-		{
-			name: "market zero",
-			msg: MsgMarketSetOrderExternalIDRequest{
-				Admin:      admin,
-				MarketId:   0,
-				OrderId:    12,
-				ExternalId: "twenty-eight",
+			name: "control: zero",
+			msg: MsgMarketUpdateBatchAuctionRequest{
+				Admin:                sdk.AccAddress("admin_______________").String(),
+				MarketId:             1,
+				BatchAuctionInterval: 0,
 			},
-			expErr: []string{"invalid market id: cannot be zero"},
 		},
 		{
-			name: "empty external id",
-			msg: MsgMarketSetOrderExternalIDRequest{
-				Admin:      admin,
-				MarketId:   5,
-				OrderId:    12,
-				ExternalId: "",
+			name: "control: non-zero",
+			msg: MsgMarketUpdateBatchAuctionRequest{
+				Admin:                sdk.AccAddress("admin_______________").String(),
+				MarketId:             1,
+				BatchAuctionInterval: 10,
 			},
-			expErr: nil,
 		},
 		{
-			name: "external id at max length",
-			msg: MsgMarketSetOrderExternalIDRequest{
-				Admin:      admin,
-				MarketId:   5,
-				OrderId:    12,
-				ExternalId: strings.Repeat("y", MaxExternalIDLength),
+			name: "no admin",
+			msg: MsgMarketUpdateBatchAuctionRequest{
+				Admin:    "",
+				MarketId: 1,
 			},
-			expErr: nil,
+			expErr: []string{"invalid administrator \"\": " + emptyAddrErr},
 		},
 		{
-			name: "external id more than max length",
-			msg: MsgMarketSetOrderExternalIDRequest{
-				Admin:      admin,
-				MarketId:   5,
-				OrderId:    12,
-				ExternalId: strings.Repeat("r", MaxExternalIDLength+1),
-			},
-			expErr: []string{
-				fmt.Sprintf("invalid external id %q (length %d): max length %d",
-					"rrrrr...rrrrr", MaxExternalIDLength+1, MaxExternalIDLength),
+			name: "bad admin",
+			msg: MsgMarketUpdateBatchAuctionRequest{
+				Admin:    "notanadminaddr",
+				MarketId: 1,
 			},
-		},
-		{
-			name: "order zero",
-			msg: MsgMarketSetOrderExternalIDRequest{
-				Admin:      admin,
-				MarketId:   5,
-				OrderId:    0,
-				ExternalId: "twenty-eight",
+			expErr: []string{"invalid administrator \"notanadminaddr\": " + bech32Err},
+		},
+		{
+			name: "market zero",
+			msg: MsgMarketUpdateBatchAuctionRequest{
+				Admin:    sdk.AccAddress("admin_______________").String(),
+				MarketId: 0,
 			},
-			expErr: []string{"invalid order id: cannot be zero"},
+			expErr: []string{"invalid market id: cannot be zero"},
 		},
 		{
 			name: "multiple errors",
-			msg: MsgMarketSetOrderExternalIDRequest{
-				Admin:      "",
-				MarketId:   0,
-				OrderId:    0,
-				ExternalId: strings.Repeat("V", MaxExternalIDLength+1),
-			},
+			msg:  MsgMarketUpdateBatchAuctionRequest{},
 			expErr: []string{
-				"invalid administrator \"\"", emptyAddrErr,
+				"invalid administrator \"\": " + emptyAddrErr,
 				"invalid market id: cannot be zero",
-				fmt.Sprintf("invalid external id %q (length %d): max length %d",
-					"VVVVV...VVVVV", MaxExternalIDLength+1, MaxExternalIDLength),
-				"invalid order id: cannot be zero",
 			},
 		},
 	}
@@ -1607,127 +3264,131 @@ func TestMsgMarketSetOrderExternalIDRequest_ValidateBasic(t *testing.T) {
 	}
 }
 
-func TestMsgMarketWithdrawRequest_ValidateBasic(t *testing.T) {
-	coin := func(amount int64, denom string) sdk.Coin {
-		return sdk.Coin{Denom: denom, Amount: sdkmath.NewInt(amount)}
-	}
-	goodAdminAddr := sdk.AccAddress("Administrator_______").String()
-	goodToAddr := sdk.AccAddress("ToAddress___________").String()
-	goodCoins := sdk.NewCoins(coin(3, "acorns"))
-
+func TestMsgMarketUpdateSelfTradePreventionRequest_ValidateBasic(t *testing.T) {
 	tests := []struct {
 		name   string
-		msg    MsgMarketWithdrawRequest
+		msg    MsgMarketUpdateSelfTradePreventionRequest
 		expErr []string
 	}{
 		{
-			name: "control",
-			msg: MsgMarketWithdrawRequest{
-				Admin:     goodAdminAddr,
-				MarketId:  1,
-				ToAddress: goodToAddr,
-				Amount:    goodCoins,
+			name: "control: unspecified",
+			msg: MsgMarketUpdateSelfTradePreventionRequest{
+				Admin:               sdk.AccAddress("admin_______________").String(),
+				MarketId:            1,
+				SelfTradePrevention: SelfTradePrevention_unspecified,
 			},
-			expErr: nil,
 		},
 		{
-			name: "no administrator",
-			msg: MsgMarketWithdrawRequest{
-				Admin:     "",
-				MarketId:  1,
-				ToAddress: goodToAddr,
-				Amount:    goodCoins,
+			name: "control: decrement both",
+			msg: MsgMarketUpdateSelfTradePreventionRequest{
+				Admin:               sdk.AccAddress("admin_______________").String(),
+				MarketId:            1,
+				SelfTradePrevention: SelfTradePrevention_decrement_both,
 			},
-			expErr: []string{`invalid administrator ""`, emptyAddrErr},
 		},
 		{
-			name: "bad administrator",
-			msg: MsgMarketWithdrawRequest{
-				Admin:     "notright",
-				MarketId:  1,
-				ToAddress: goodToAddr,
-				Amount:    goodCoins,
+			name: "no admin",
+			msg: MsgMarketUpdateSelfTradePreventionRequest{
+				Admin:    "",
+				MarketId: 1,
 			},
-			expErr: []string{`invalid administrator "notright"`, bech32Err},
+			expErr: []string{"invalid administrator \"\": " + emptyAddrErr},
 		},
 		{
-			name: "market id zero",
-			msg: MsgMarketWithdrawRequest{
-				Admin:     goodAdminAddr,
-				MarketId:  0,
-				ToAddress: goodToAddr,
-				Amount:    goodCoins,
+			name: "bad admin",
+			msg: MsgMarketUpdateSelfTradePreventionRequest{
+				Admin:    "notanadminaddr",
+				MarketId: 1,
 			},
-			expErr: []string{"invalid market id", "cannot be zero"},
+			expErr: []string{"invalid administrator \"notanadminaddr\": " + bech32Err},
 		},
 		{
-			name: "no to address",
-			msg: MsgMarketWithdrawRequest{
-				Admin:     goodAdminAddr,
-				MarketId:  1,
-				ToAddress: "",
-				Amount:    goodCoins,
+			name: "market zero",
+			msg: MsgMarketUpdateSelfTradePreventionRequest{
+				Admin:    sdk.AccAddress("admin_______________").String(),
+				MarketId: 0,
 			},
-			expErr: []string{`invalid to address ""`, emptyAddrErr},
+			expErr: []string{"invalid market id: cannot be zero"},
 		},
 		{
-			name: "bad to address",
-			msg: MsgMarketWithdrawRequest{
-				Admin:     goodAdminAddr,
-				MarketId:  1,
-				ToAddress: "notright",
-				Amount:    goodCoins,
+			name: "unknown self-trade prevention",
+			msg: MsgMarketUpdateSelfTradePreventionRequest{
+				Admin:               sdk.AccAddress("admin_______________").String(),
+				MarketId:            1,
+				SelfTradePrevention: 7,
 			},
-			expErr: []string{`invalid to address "notright"`, bech32Err},
+			expErr: []string{"self-trade prevention 7 does not exist"},
 		},
 		{
-			name: "invalid denom in amount",
-			msg: MsgMarketWithdrawRequest{
-				Admin:     goodAdminAddr,
-				MarketId:  1,
-				ToAddress: goodToAddr,
-				Amount:    sdk.Coins{coin(3, "x")},
+			name: "multiple errors",
+			msg:  MsgMarketUpdateSelfTradePreventionRequest{SelfTradePrevention: -3},
+			expErr: []string{
+				"invalid administrator \"\": " + emptyAddrErr,
+				"invalid market id: cannot be zero",
+				"self-trade prevention -3 does not exist",
 			},
-			expErr: []string{`invalid amount "3x"`, "invalid denom: x"},
 		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
+func TestMsgMarketUpdatePublishPricesRequest_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name   string
+		msg    MsgMarketUpdatePublishPricesRequest
+		expErr []string
+	}{
 		{
-			name: "negative amount",
-			msg: MsgMarketWithdrawRequest{
-				Admin:     goodAdminAddr,
-				MarketId:  1,
-				ToAddress: goodToAddr,
-				Amount:    sdk.Coins{coin(-1, "negcoin")},
+			name: "control: false",
+			msg: MsgMarketUpdatePublishPricesRequest{
+				Admin:         sdk.AccAddress("admin_______________").String(),
+				MarketId:      1,
+				PublishPrices: false,
 			},
-			expErr: []string{`invalid amount "-1negcoin"`, "coin -1negcoin amount is not positive"},
 		},
 		{
-			name: "empty amount",
-			msg: MsgMarketWithdrawRequest{
-				Admin:     goodAdminAddr,
-				MarketId:  1,
-				ToAddress: goodToAddr,
-				Amount:    sdk.Coins{},
+			name: "control: true",
+			msg: MsgMarketUpdatePublishPricesRequest{
+				Admin:         sdk.AccAddress("admin_______________").String(),
+				MarketId:      1,
+				PublishPrices: true,
 			},
-			expErr: []string{`invalid amount ""`, "cannot be zero"},
 		},
 		{
-			name: "zero coin amount",
-			msg: MsgMarketWithdrawRequest{
-				Admin:     goodAdminAddr,
-				MarketId:  1,
-				ToAddress: goodToAddr,
-				Amount:    sdk.Coins{coin(0, "acorn"), coin(0, "banana")},
+			name: "no admin",
+			msg: MsgMarketUpdatePublishPricesRequest{
+				Admin:    "",
+				MarketId: 1,
 			},
-			expErr: []string{`invalid amount "0acorn,0banana"`, "coin 0acorn amount is not positive"},
+			expErr: []string{"invalid administrator \"\": " + emptyAddrErr},
+		},
+		{
+			name: "bad admin",
+			msg: MsgMarketUpdatePublishPricesRequest{
+				Admin:    "notanadminaddr",
+				MarketId: 1,
+			},
+			expErr: []string{"invalid administrator \"notanadminaddr\": " + bech32Err},
+		},
+		{
+			name: "market zero",
+			msg: MsgMarketUpdatePublishPricesRequest{
+				Admin:    sdk.AccAddress("admin_______________").String(),
+				MarketId: 0,
+			},
+			expErr: []string{"invalid market id: cannot be zero"},
 		},
 		{
 			name: "multiple errors",
-			msg:  MsgMarketWithdrawRequest{},
+			msg:  MsgMarketUpdatePublishPricesRequest{},
 			expErr: []string{
-				"invalid administrator",
-				"invalid market id",
-				"invalid to address",
-				"invalid amount",
+				"invalid administrator \"\": " + emptyAddrErr,
+				"invalid market id: cannot be zero",
 			},
 		},
 	}
@@ -1739,121 +3400,68 @@ func TestMsgMarketWithdrawRequest_ValidateBasic(t *testing.T) {
 	}
 }
 
-func TestMsgMarketUpdateDetailsRequest_ValidateBasic(t *testing.T) {
-	admin := sdk.AccAddress("admin_______________").String()
-	tooLongErr := func(field string, max int) string {
-		return fmt.Sprintf("%s length %d exceeds maximum length of %d", field, max+1, max)
-	}
-
+func TestMsgMarketUpdateExternalIDScopeRequest_ValidateBasic(t *testing.T) {
 	tests := []struct {
 		name   string
-		msg    MsgMarketUpdateDetailsRequest
+		msg    MsgMarketUpdateExternalIDScopeRequest
 		expErr []string
 	}{
 		{
-			name: "control",
-			msg: MsgMarketUpdateDetailsRequest{
-				Admin:    admin,
-				MarketId: 1,
-				MarketDetails: MarketDetails{
-					Name:        "MyMarket",
-					Description: "This is my own market only for me.",
-					WebsiteUrl:  "https://example.com",
-					IconUri:     "https://example.com/icon",
-				},
-			},
-			expErr: nil,
-		},
-		{
-			name: "empty admin",
-			msg: MsgMarketUpdateDetailsRequest{
-				Admin:         "",
-				MarketId:      1,
-				MarketDetails: MarketDetails{},
-			},
-			expErr: []string{`invalid administrator ""`, emptyAddrErr},
-		},
-		{
-			name: "invalid admin",
-			msg: MsgMarketUpdateDetailsRequest{
-				Admin:         "notvalidadmin",
-				MarketId:      1,
-				MarketDetails: MarketDetails{},
-			},
-			expErr: []string{`invalid administrator "notvalidadmin"`, bech32Err},
-		},
-		{
-			name: "market id zero",
-			msg: MsgMarketUpdateDetailsRequest{
-				Admin:         admin,
-				MarketId:      0,
-				MarketDetails: MarketDetails{},
+			name: "control: unspecified",
+			msg: MsgMarketUpdateExternalIDScopeRequest{
+				Admin:           sdk.AccAddress("admin_______________").String(),
+				MarketId:        1,
+				ExternalIdScope: ExternalIDScope_unspecified,
 			},
-			expErr: []string{"invalid market id", "cannot be zero"},
 		},
 		{
-			name: "name too long",
-			msg: MsgMarketUpdateDetailsRequest{
-				Admin:    admin,
-				MarketId: 1,
-				MarketDetails: MarketDetails{
-					Name: strings.Repeat("p", MaxName+1),
-				},
+			name: "control: account",
+			msg: MsgMarketUpdateExternalIDScopeRequest{
+				Admin:           sdk.AccAddress("admin_______________").String(),
+				MarketId:        1,
+				ExternalIdScope: ExternalIDScope_account,
 			},
-			expErr: []string{tooLongErr("name", MaxName)},
 		},
 		{
-			name: "description too long",
-			msg: MsgMarketUpdateDetailsRequest{
-				Admin:    admin,
+			name: "no admin",
+			msg: MsgMarketUpdateExternalIDScopeRequest{
+				Admin:    "",
 				MarketId: 1,
-				MarketDetails: MarketDetails{
-					Description: strings.Repeat("d", MaxDescription+1),
-				},
 			},
-			expErr: []string{tooLongErr("description", MaxDescription)},
+			expErr: []string{"invalid administrator \"\": " + emptyAddrErr},
 		},
 		{
-			name: "website_url too long",
-			msg: MsgMarketUpdateDetailsRequest{
-				Admin:    admin,
+			name: "bad admin",
+			msg: MsgMarketUpdateExternalIDScopeRequest{
+				Admin:    "notanadminaddr",
 				MarketId: 1,
-				MarketDetails: MarketDetails{
-					WebsiteUrl: strings.Repeat("w", MaxWebsiteURL+1),
-				},
 			},
-			expErr: []string{tooLongErr("website_url", MaxWebsiteURL)},
+			expErr: []string{"invalid administrator \"notanadminaddr\": " + bech32Err},
 		},
 		{
-			name: "icon_uri too long",
-			msg: MsgMarketUpdateDetailsRequest{
-				Admin:    admin,
-				MarketId: 1,
-				MarketDetails: MarketDetails{
-					IconUri: strings.Repeat("i", MaxIconURI+1),
-				},
+			name: "market zero",
+			msg: MsgMarketUpdateExternalIDScopeRequest{
+				Admin:    sdk.AccAddress("admin_______________").String(),
+				MarketId: 0,
 			},
-			expErr: []string{tooLongErr("icon_uri", MaxIconURI)},
+			expErr: []string{"invalid market id: cannot be zero"},
 		},
 		{
-			name: "multiple errors",
-			msg: MsgMarketUpdateDetailsRequest{
-				Admin:    "",
-				MarketId: 0,
-				MarketDetails: MarketDetails{
-					Name:        strings.Repeat("p", MaxName+1),
-					Description: strings.Repeat("d", MaxDescription+1),
-					WebsiteUrl:  strings.Repeat("w", MaxWebsiteURL+1),
-					IconUri:     strings.Repeat("i", MaxIconURI+1),
-				},
+			name: "unknown external id scope",
+			msg: MsgMarketUpdateExternalIDScopeRequest{
+				Admin:           sdk.AccAddress("admin_______________").String(),
+				MarketId:        1,
+				ExternalIdScope: 4,
 			},
+			expErr: []string{"external id scope 4 does not exist"},
+		},
+		{
+			name: "multiple errors",
+			msg:  MsgMarketUpdateExternalIDScopeRequest{ExternalIdScope: -1},
 			expErr: []string{
-				"invalid administrator",
-				"invalid market id",
-				tooLongErr("name", MaxName),
-				tooLongErr("description", MaxDescription),
-				tooLongErr("website_url", MaxWebsiteURL),
-				tooLongErr("icon_uri", MaxIconURI),
+				"invalid administrator \"\": " + emptyAddrErr,
+				"invalid market id: cannot be zero",
+				"external id scope -1 does not exist",
 			},
 		},
 	}
@@ -1865,66 +3473,68 @@ func TestMsgMarketUpdateDetailsRequest_ValidateBasic(t *testing.T) {
 	}
 }
 
-func TestMsgMarketUpdateEnabledRequest_ValidateBasic(t *testing.T) {
-	msg := MsgMarketUpdateEnabledRequest{}
-	expErr := []string{"the MarketUpdateEnabled endpoint has been replaced by the MarketUpdateAcceptingOrders endpoint"}
-	testValidateBasic(t, &msg, expErr)
-}
-
-func TestMsgMarketUpdateAcceptingOrdersRequest_ValidateBasic(t *testing.T) {
-	admin := sdk.AccAddress("admin_______________").String()
-
+func TestMsgMarketUpdateOrderRateLimitRequest_ValidateBasic(t *testing.T) {
 	tests := []struct {
 		name   string
-		msg    MsgMarketUpdateAcceptingOrdersRequest
+		msg    MsgMarketUpdateOrderRateLimitRequest
 		expErr []string
 	}{
 		{
-			name: "control: true",
-			msg: MsgMarketUpdateAcceptingOrdersRequest{
-				Admin:           admin,
-				MarketId:        1,
-				AcceptingOrders: true,
+			name: "control: no limit",
+			msg: MsgMarketUpdateOrderRateLimitRequest{
+				Admin:    sdk.AccAddress("admin_______________").String(),
+				MarketId: 1,
 			},
-			expErr: nil,
 		},
 		{
-			name: "control: false",
-			msg: MsgMarketUpdateAcceptingOrdersRequest{
-				Admin:           admin,
-				MarketId:        1,
-				AcceptingOrders: true,
+			name: "control: with limit",
+			msg: MsgMarketUpdateOrderRateLimitRequest{
+				Admin:          sdk.AccAddress("admin_______________").String(),
+				MarketId:       1,
+				OrderRateLimit: &OrderRateLimit{MaxOrders: 10, WindowBlocks: 5},
 			},
-			expErr: nil,
 		},
 		{
-			name: "empty admin",
-			msg: MsgMarketUpdateAcceptingOrdersRequest{
+			name: "no admin",
+			msg: MsgMarketUpdateOrderRateLimitRequest{
 				Admin:    "",
 				MarketId: 1,
 			},
-			expErr: []string{
-				`invalid administrator ""`, emptyAddrErr,
-			},
+			expErr: []string{"invalid administrator \"\": " + emptyAddrErr},
 		},
 		{
 			name: "bad admin",
-			msg: MsgMarketUpdateAcceptingOrdersRequest{
-				Admin:    "badadmin",
+			msg: MsgMarketUpdateOrderRateLimitRequest{
+				Admin:    "notanadminaddr",
 				MarketId: 1,
 			},
-			expErr: []string{
-				`invalid administrator "badadmin"`, bech32Err,
-			},
+			expErr: []string{"invalid administrator \"notanadminaddr\": " + bech32Err},
 		},
 		{
-			name: "market id zero",
-			msg: MsgMarketUpdateAcceptingOrdersRequest{
-				Admin:    admin,
+			name: "market zero",
+			msg: MsgMarketUpdateOrderRateLimitRequest{
+				Admin:    sdk.AccAddress("admin_______________").String(),
 				MarketId: 0,
 			},
+			expErr: []string{"invalid market id: cannot be zero"},
+		},
+		{
+			name: "invalid limit",
+			msg: MsgMarketUpdateOrderRateLimitRequest{
+				Admin:          sdk.AccAddress("admin_______________").String(),
+				MarketId:       1,
+				OrderRateLimit: &OrderRateLimit{MaxOrders: 10},
+			},
+			expErr: []string{"invalid order rate limit window blocks: cannot be zero"},
+		},
+		{
+			name: "multiple errors",
+			msg:  MsgMarketUpdateOrderRateLimitRequest{OrderRateLimit: &OrderRateLimit{}},
 			expErr: []string{
-				"invalid market id", "cannot be zero",
+				"invalid administrator \"\": " + emptyAddrErr,
+				"invalid market id: cannot be zero",
+				"invalid order rate limit max orders: cannot be zero",
+				"invalid order rate limit window blocks: cannot be zero",
 			},
 		},
 	}
@@ -1936,60 +3546,67 @@ func TestMsgMarketUpdateAcceptingOrdersRequest_ValidateBasic(t *testing.T) {
 	}
 }
 
-func TestMsgMarketUpdateUserSettleRequest_ValidateBasic(t *testing.T) {
-	admin := sdk.AccAddress("admin_______________").String()
-
+func TestMsgMarketUpdateMaxExposureRequest_ValidateBasic(t *testing.T) {
 	tests := []struct {
 		name   string
-		msg    MsgMarketUpdateUserSettleRequest
+		msg    MsgMarketUpdateMaxExposureRequest
 		expErr []string
 	}{
 		{
-			name: "control: true",
-			msg: MsgMarketUpdateUserSettleRequest{
-				Admin:               admin,
-				MarketId:            1,
-				AllowUserSettlement: true,
+			name: "control: no max exposure",
+			msg: MsgMarketUpdateMaxExposureRequest{
+				Admin:    sdk.AccAddress("admin_______________").String(),
+				MarketId: 1,
 			},
-			expErr: nil,
 		},
 		{
-			name: "control: false",
-			msg: MsgMarketUpdateUserSettleRequest{
-				Admin:               admin,
-				MarketId:            1,
-				AllowUserSettlement: true,
+			name: "control: with max exposure",
+			msg: MsgMarketUpdateMaxExposureRequest{
+				Admin:       sdk.AccAddress("admin_______________").String(),
+				MarketId:    1,
+				MaxExposure: &sdk.Coin{Denom: "nhash", Amount: sdkmath.NewInt(1_000_000)},
 			},
-			expErr: nil,
 		},
 		{
-			name: "empty admin",
-			msg: MsgMarketUpdateUserSettleRequest{
+			name: "no admin",
+			msg: MsgMarketUpdateMaxExposureRequest{
 				Admin:    "",
 				MarketId: 1,
 			},
-			expErr: []string{
-				`invalid administrator ""`, emptyAddrErr,
-			},
+			expErr: []string{"invalid administrator \"\": " + emptyAddrErr},
 		},
 		{
 			name: "bad admin",
-			msg: MsgMarketUpdateUserSettleRequest{
-				Admin:    "badadmin",
+			msg: MsgMarketUpdateMaxExposureRequest{
+				Admin:    "notanadminaddr",
 				MarketId: 1,
 			},
-			expErr: []string{
-				`invalid administrator "badadmin"`, bech32Err,
-			},
+			expErr: []string{"invalid administrator \"notanadminaddr\": " + bech32Err},
 		},
 		{
-			name: "market id zero",
-			msg: MsgMarketUpdateUserSettleRequest{
-				Admin:    admin,
+			name: "market zero",
+			msg: MsgMarketUpdateMaxExposureRequest{
+				Admin:    sdk.AccAddress("admin_______________").String(),
 				MarketId: 0,
 			},
+			expErr: []string{"invalid market id: cannot be zero"},
+		},
+		{
+			name: "zero max exposure",
+			msg: MsgMarketUpdateMaxExposureRequest{
+				Admin:       sdk.AccAddress("admin_______________").String(),
+				MarketId:    1,
+				MaxExposure: &sdk.Coin{Denom: "nhash", Amount: sdkmath.ZeroInt()},
+			},
+			expErr: []string{"invalid max exposure \"0nhash\": amount must be positive"},
+		},
+		{
+			name: "multiple errors",
+			msg:  MsgMarketUpdateMaxExposureRequest{MaxExposure: &sdk.Coin{Denom: "x", Amount: sdkmath.OneInt()}},
 			expErr: []string{
-				"invalid market id", "cannot be zero",
+				"invalid administrator \"\": " + emptyAddrErr,
+				"invalid market id: cannot be zero",
+				"invalid max exposure \"1x\": invalid denom: x",
 			},
 		},
 	}
@@ -2001,31 +3618,31 @@ func TestMsgMarketUpdateUserSettleRequest_ValidateBasic(t *testing.T) {
 	}
 }
 
-func TestMsgMarketUpdateAcceptingCommitmentsRequest_ValidateBasic(t *testing.T) {
+func TestMsgMarketUpdateFillAlgorithmRequest_ValidateBasic(t *testing.T) {
 	tests := []struct {
 		name   string
-		msg    MsgMarketUpdateAcceptingCommitmentsRequest
+		msg    MsgMarketUpdateFillAlgorithmRequest
 		expErr []string
 	}{
 		{
-			name: "control: false",
-			msg: MsgMarketUpdateAcceptingCommitmentsRequest{
-				Admin:                sdk.AccAddress("admin_______________").String(),
-				MarketId:             1,
-				AcceptingCommitments: false,
+			name: "control: unspecified",
+			msg: MsgMarketUpdateFillAlgorithmRequest{
+				Admin:         sdk.AccAddress("admin_______________").String(),
+				MarketId:      1,
+				FillAlgorithm: FillAlgorithm_unspecified,
 			},
 		},
 		{
-			name: "control: true",
-			msg: MsgMarketUpdateAcceptingCommitmentsRequest{
-				Admin:                sdk.AccAddress("admin_______________").String(),
-				MarketId:             1,
-				AcceptingCommitments: true,
+			name: "control: pro rata",
+			msg: MsgMarketUpdateFillAlgorithmRequest{
+				Admin:         sdk.AccAddress("admin_______________").String(),
+				MarketId:      1,
+				FillAlgorithm: FillAlgorithm_pro_rata,
 			},
 		},
 		{
 			name: "no admin",
-			msg: MsgMarketUpdateAcceptingCommitmentsRequest{
+			msg: MsgMarketUpdateFillAlgorithmRequest{
 				Admin:    "",
 				MarketId: 1,
 			},
@@ -2033,7 +3650,7 @@ func TestMsgMarketUpdateAcceptingCommitmentsRequest_ValidateBasic(t *testing.T)
 		},
 		{
 			name: "bad admin",
-			msg: MsgMarketUpdateAcceptingCommitmentsRequest{
+			msg: MsgMarketUpdateFillAlgorithmRequest{
 				Admin:    "notanadminaddr",
 				MarketId: 1,
 			},
@@ -2041,18 +3658,28 @@ func TestMsgMarketUpdateAcceptingCommitmentsRequest_ValidateBasic(t *testing.T)
 		},
 		{
 			name: "market zero",
-			msg: MsgMarketUpdateAcceptingCommitmentsRequest{
+			msg: MsgMarketUpdateFillAlgorithmRequest{
 				Admin:    sdk.AccAddress("admin_______________").String(),
 				MarketId: 0,
 			},
 			expErr: []string{"invalid market id: cannot be zero"},
 		},
+		{
+			name: "unknown fill algorithm",
+			msg: MsgMarketUpdateFillAlgorithmRequest{
+				Admin:         sdk.AccAddress("admin_______________").String(),
+				MarketId:      1,
+				FillAlgorithm: 5,
+			},
+			expErr: []string{"fill algorithm 5 does not exist"},
+		},
 		{
 			name: "multiple errors",
-			msg:  MsgMarketUpdateAcceptingCommitmentsRequest{},
+			msg:  MsgMarketUpdateFillAlgorithmRequest{FillAlgorithm: -1},
 			expErr: []string{
 				"invalid administrator \"\": " + emptyAddrErr,
 				"invalid market id: cannot be zero",
+				"fill algorithm -1 does not exist",
 			},
 		},
 	}
@@ -2149,6 +3776,7 @@ func TestMsgMarketManagePermissionsRequest_ValidateBasic(t *testing.T) {
 	goodAddr1 := sdk.AccAddress("goodAddr1___________").String()
 	goodAddr2 := sdk.AccAddress("goodAddr2___________").String()
 	goodAddr3 := sdk.AccAddress("goodAddr3___________").String()
+	expiration := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
 
 	tests := []struct {
 		name   string
@@ -2274,6 +3902,32 @@ func TestMsgMarketManagePermissionsRequest_ValidateBasic(t *testing.T) {
 			},
 			expErr: nil,
 		},
+		{
+			name: "to-revoke with expiration",
+			msg: MsgMarketManagePermissionsRequest{
+				Admin:    goodAdminAddr,
+				MarketId: 1,
+				ToRevoke: []AccessGrant{{
+					Address:     goodAddr1,
+					Permissions: []Permission{Permission_settle},
+					Expiration:  &expiration,
+				}},
+			},
+			expErr: []string{"address " + goodAddr1 + " to-revoke entry cannot have an expiration"},
+		},
+		{
+			name: "to-grant with expiration",
+			msg: MsgMarketManagePermissionsRequest{
+				Admin:    goodAdminAddr,
+				MarketId: 1,
+				ToGrant: []AccessGrant{{
+					Address:     goodAddr1,
+					Permissions: []Permission{Permission_settle},
+					Expiration:  &expiration,
+				}},
+			},
+			expErr: nil,
+		},
 		{
 			name: "revoke and grant the same permission for two addresses",
 			msg: MsgMarketManagePermissionsRequest{
@@ -2392,22 +4046,220 @@ func TestMsgMarketManagePermissionsRequest_HasUpdates(t *testing.T) {
 			exp: true,
 		},
 		{
-			name: "one to grant",
-			msg: MsgMarketManagePermissionsRequest{
-				ToGrant: []AccessGrant{{Address: "to_grant"}},
+			name: "one to grant",
+			msg: MsgMarketManagePermissionsRequest{
+				ToGrant: []AccessGrant{{Address: "to_grant"}},
+			},
+			exp: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual bool
+			testFunc := func() {
+				actual = tc.msg.HasUpdates()
+			}
+			require.NotPanics(t, testFunc, "%T.HasUpdates()", tc.msg)
+			assert.Equal(t, tc.exp, actual, "%T.HasUpdates()", tc.msg)
+		})
+	}
+}
+
+func TestMsgMarketTransferAuthorityRequest_ValidateBasic(t *testing.T) {
+	adminAddr := sdk.AccAddress("adminAddr___________").String()
+	newAuthAddr := sdk.AccAddress("newAuthAddr_________").String()
+	otherAddr := sdk.AccAddress("otherAddr___________").String()
+
+	tests := []struct {
+		name   string
+		msg    MsgMarketTransferAuthorityRequest
+		expErr []string
+	}{
+		{
+			name: "control",
+			msg: MsgMarketTransferAuthorityRequest{
+				Admin:        adminAddr,
+				MarketId:     1,
+				NewAuthority: newAuthAddr,
+				AccessGrants: []AccessGrant{
+					{Address: newAuthAddr, Permissions: AllPermissions()},
+					{Address: otherAddr, Permissions: []Permission{Permission_settle}},
+				},
+			},
+		},
+		{
+			name: "no access grants",
+			msg: MsgMarketTransferAuthorityRequest{
+				Admin:        adminAddr,
+				MarketId:     1,
+				NewAuthority: newAuthAddr,
+			},
+		},
+		{
+			name: "empty admin",
+			msg: MsgMarketTransferAuthorityRequest{
+				Admin:        "",
+				MarketId:     1,
+				NewAuthority: newAuthAddr,
+			},
+			expErr: []string{`invalid administrator ""`, emptyAddrErr},
+		},
+		{
+			name: "invalid admin",
+			msg: MsgMarketTransferAuthorityRequest{
+				Admin:        "bad1admin",
+				MarketId:     1,
+				NewAuthority: newAuthAddr,
+			},
+			expErr: []string{`invalid administrator "bad1admin"`, bech32Err},
+		},
+		{
+			name: "market id zero",
+			msg: MsgMarketTransferAuthorityRequest{
+				Admin:        adminAddr,
+				MarketId:     0,
+				NewAuthority: newAuthAddr,
+			},
+			expErr: []string{"invalid market id: cannot be zero"},
+		},
+		{
+			name: "empty new authority",
+			msg: MsgMarketTransferAuthorityRequest{
+				Admin:        adminAddr,
+				MarketId:     1,
+				NewAuthority: "",
+			},
+			expErr: []string{`invalid new authority ""`, emptyAddrErr},
+		},
+		{
+			name: "invalid new authority",
+			msg: MsgMarketTransferAuthorityRequest{
+				Admin:        adminAddr,
+				MarketId:     1,
+				NewAuthority: "bad1auth",
+			},
+			expErr: []string{`invalid new authority "bad1auth"`, bech32Err},
+		},
+		{
+			name: "two invalid access grants",
+			msg: MsgMarketTransferAuthorityRequest{
+				Admin:        adminAddr,
+				MarketId:     1,
+				NewAuthority: newAuthAddr,
+				AccessGrants: []AccessGrant{
+					{Address: "badaddr", Permissions: []Permission{Permission_withdraw}},
+					{Address: otherAddr, Permissions: []Permission{Permission_unspecified}},
+				},
+			},
+			expErr: []string{
+				`invalid access grant: invalid address "badaddr"`,
+				"invalid access grant: permission is unspecified for " + otherAddr,
+			},
+		},
+		{
+			name: "duplicate access grant address",
+			msg: MsgMarketTransferAuthorityRequest{
+				Admin:        adminAddr,
+				MarketId:     1,
+				NewAuthority: newAuthAddr,
+				AccessGrants: []AccessGrant{
+					{Address: newAuthAddr, Permissions: []Permission{Permission_permissions}},
+					{Address: newAuthAddr, Permissions: []Permission{Permission_settle}},
+				},
+			},
+			expErr: []string{newAuthAddr + " appears in multiple access grant entries"},
+		},
+		{
+			name: "multiple errors",
+			msg:  MsgMarketTransferAuthorityRequest{},
+			expErr: []string{
+				`invalid administrator ""`,
+				"invalid market id: cannot be zero",
+				`invalid new authority ""`,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
+func TestMsgMarketTransferAuthorityRequest_ToMarketAuthorityTransfer(t *testing.T) {
+	msg := MsgMarketTransferAuthorityRequest{
+		Admin:        "admin",
+		MarketId:     3,
+		NewAuthority: "new_authority",
+		AccessGrants: []AccessGrant{{Address: "new_authority", Permissions: []Permission{Permission_permissions}}},
+	}
+	expected := &MarketAuthorityTransfer{
+		MarketId:     3,
+		ProposedBy:   "admin",
+		NewAuthority: "new_authority",
+		AccessGrants: []AccessGrant{{Address: "new_authority", Permissions: []Permission{Permission_permissions}}},
+	}
+
+	var actual *MarketAuthorityTransfer
+	testFunc := func() {
+		actual = msg.ToMarketAuthorityTransfer()
+	}
+	require.NotPanics(t, testFunc, "ToMarketAuthorityTransfer()")
+	assert.Equal(t, expected, actual, "ToMarketAuthorityTransfer()")
+}
+
+func TestMsgMarketAcceptAuthorityRequest_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name   string
+		msg    MsgMarketAcceptAuthorityRequest
+		expErr []string
+	}{
+		{
+			name: "control",
+			msg: MsgMarketAcceptAuthorityRequest{
+				NewAuthority: sdk.AccAddress("newAuthAddr_________").String(),
+				MarketId:     1,
+			},
+		},
+		{
+			name: "empty new authority",
+			msg: MsgMarketAcceptAuthorityRequest{
+				NewAuthority: "",
+				MarketId:     1,
+			},
+			expErr: []string{`invalid new authority ""`, emptyAddrErr},
+		},
+		{
+			name: "invalid new authority",
+			msg: MsgMarketAcceptAuthorityRequest{
+				NewAuthority: "bad1auth",
+				MarketId:     1,
+			},
+			expErr: []string{`invalid new authority "bad1auth"`, bech32Err},
+		},
+		{
+			name: "market id zero",
+			msg: MsgMarketAcceptAuthorityRequest{
+				NewAuthority: sdk.AccAddress("newAuthAddr_________").String(),
+				MarketId:     0,
+			},
+			expErr: []string{"invalid market id: cannot be zero"},
+		},
+		{
+			name: "multiple errors",
+			msg:  MsgMarketAcceptAuthorityRequest{},
+			expErr: []string{
+				`invalid new authority ""`,
+				"invalid market id: cannot be zero",
 			},
-			exp: true,
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			var actual bool
-			testFunc := func() {
-				actual = tc.msg.HasUpdates()
-			}
-			require.NotPanics(t, testFunc, "%T.HasUpdates()", tc.msg)
-			assert.Equal(t, tc.exp, actual, "%T.HasUpdates()", tc.msg)
+			testValidateBasic(t, &tc.msg, tc.expErr)
 		})
 	}
 }
@@ -2681,6 +4533,148 @@ func TestMsgMarketManageReqAttrsRequest_HasUpdates(t *testing.T) {
 	}
 }
 
+func TestMsgMarketManageAcceptedDenomsRequest_ValidateBasic(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________").String()
+
+	tests := []struct {
+		name   string
+		msg    MsgMarketManageAcceptedDenomsRequest
+		expErr []string
+	}{
+		{
+			name: "control",
+			msg: MsgMarketManageAcceptedDenomsRequest{
+				Admin:               admin,
+				MarketId:            1,
+				AssetDenomsToAdd:    []string{"apple", "banana"},
+				AssetDenomsToRemove: []string{"cherry"},
+				PriceDenomsToAdd:    []string{"nhash"},
+				PriceDenomsToRemove: []string{"usd"},
+			},
+		},
+		{
+			name: "no admin",
+			msg: MsgMarketManageAcceptedDenomsRequest{
+				MarketId:         1,
+				AssetDenomsToAdd: []string{"apple"},
+			},
+			expErr: []string{"invalid administrator \"\": " + emptyAddrErr},
+		},
+		{
+			name: "market zero",
+			msg: MsgMarketManageAcceptedDenomsRequest{
+				Admin:            admin,
+				AssetDenomsToAdd: []string{"apple"},
+			},
+			expErr: []string{"invalid market id: cannot be zero"},
+		},
+		{
+			name: "no updates",
+			msg: MsgMarketManageAcceptedDenomsRequest{
+				Admin:    admin,
+				MarketId: 1,
+			},
+			expErr: []string{"no updates"},
+		},
+		{
+			name: "invalid asset denom to add",
+			msg: MsgMarketManageAcceptedDenomsRequest{
+				Admin:            admin,
+				MarketId:         1,
+				AssetDenomsToAdd: []string{"x"},
+			},
+			expErr: []string{"invalid accepted asset to add denom: invalid denom: x"},
+		},
+		{
+			name: "duplicate price denom to add",
+			msg: MsgMarketManageAcceptedDenomsRequest{
+				Admin:            admin,
+				MarketId:         1,
+				PriceDenomsToAdd: []string{"nhash", "nhash"},
+			},
+			expErr: []string{"duplicate accepted price to add denom \"nhash\""},
+		},
+		{
+			name: "invalid denom to remove",
+			msg: MsgMarketManageAcceptedDenomsRequest{
+				Admin:               admin,
+				MarketId:            1,
+				PriceDenomsToRemove: []string{"x"},
+			},
+		},
+		{
+			name: "multiple errors",
+			msg: MsgMarketManageAcceptedDenomsRequest{
+				AssetDenomsToAdd:    []string{"apple"},
+				AssetDenomsToRemove: []string{"apple"},
+				PriceDenomsToAdd:    []string{"y"},
+			},
+			expErr: []string{
+				"invalid administrator \"\": " + emptyAddrErr,
+				"invalid market id: cannot be zero",
+				"cannot add and remove the same accepted asset denom \"apple\"",
+				"invalid accepted price to add denom: invalid denom: y",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
+func TestMsgMarketManageAcceptedDenomsRequest_HasUpdates(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgMarketManageAcceptedDenomsRequest
+		exp  bool
+	}{
+		{
+			name: "empty",
+			msg:  MsgMarketManageAcceptedDenomsRequest{},
+			exp:  false,
+		},
+		{
+			name: "empty except for admin",
+			msg:  MsgMarketManageAcceptedDenomsRequest{Admin: "admin"},
+			exp:  false,
+		},
+		{
+			name: "one asset denom to add",
+			msg:  MsgMarketManageAcceptedDenomsRequest{AssetDenomsToAdd: []string{"apple"}},
+			exp:  true,
+		},
+		{
+			name: "one asset denom to remove",
+			msg:  MsgMarketManageAcceptedDenomsRequest{AssetDenomsToRemove: []string{"apple"}},
+			exp:  true,
+		},
+		{
+			name: "one price denom to add",
+			msg:  MsgMarketManageAcceptedDenomsRequest{PriceDenomsToAdd: []string{"nhash"}},
+			exp:  true,
+		},
+		{
+			name: "one price denom to remove",
+			msg:  MsgMarketManageAcceptedDenomsRequest{PriceDenomsToRemove: []string{"nhash"}},
+			exp:  true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual bool
+			testFunc := func() {
+				actual = tc.msg.HasUpdates()
+			}
+			require.NotPanics(t, testFunc, "%T.HasUpdates()", tc.msg)
+			assert.Equal(t, tc.exp, actual, "%T.HasUpdates()", tc.msg)
+		})
+	}
+}
+
 func TestMsgCreatePaymentRequest_ValidateBasic(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -2932,6 +4926,128 @@ func TestMsgRejectPaymentRequest_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgAcceptPaymentsRequest_ValidateBasic(t *testing.T) {
+	target := sdk.AccAddress("target______________").String()
+	source0 := sdk.AccAddress("source0_____________").String()
+	source1 := sdk.AccAddress("source1_____________").String()
+	id := func(source, externalID string) PaymentID {
+		return PaymentID{Source: source, ExternalId: externalID}
+	}
+	tooManyIDs := make([]PaymentID, MaxAcceptPaymentsPerMsg+1)
+	for i := range tooManyIDs {
+		tooManyIDs[i] = id(source0, fmt.Sprintf("id%d", i))
+	}
+
+	tests := []struct {
+		name   string
+		msg    MsgAcceptPaymentsRequest
+		expErr []string
+	}{
+		{
+			name:   "valid: one payment id",
+			msg:    MsgAcceptPaymentsRequest{Target: target, PaymentIds: []PaymentID{id(source0, "one")}},
+			expErr: nil,
+		},
+		{
+			name:   "valid: one source",
+			msg:    MsgAcceptPaymentsRequest{Target: target, Sources: []string{source0}},
+			expErr: nil,
+		},
+		{
+			name: "valid: everything",
+			msg: MsgAcceptPaymentsRequest{
+				Target:          target,
+				PaymentIds:      []PaymentID{id(source0, "one"), id(source0, ""), id(source1, "one")},
+				Sources:         []string{source0, source1},
+				MaxTargetAmount: sdk.NewCoins(sdk.NewInt64Coin("cherry", 5)),
+			},
+			expErr: nil,
+		},
+		{
+			name:   "valid: max payment ids",
+			msg:    MsgAcceptPaymentsRequest{Target: target, PaymentIds: tooManyIDs[1:]},
+			expErr: nil,
+		},
+		{
+			name:   "no target",
+			msg:    MsgAcceptPaymentsRequest{Target: "", Sources: []string{source0}},
+			expErr: []string{"invalid target \"\": empty address string is not allowed"},
+		},
+		{
+			name:   "invalid target",
+			msg:    MsgAcceptPaymentsRequest{Target: "oopsnotgonnawork", Sources: []string{source0}},
+			expErr: []string{"invalid target \"oopsnotgonnawork\": decoding bech32 failed: invalid separator index -1"},
+		},
+		{
+			name:   "no payment ids or sources",
+			msg:    MsgAcceptPaymentsRequest{Target: target},
+			expErr: []string{"at least one payment id or source is required"},
+		},
+		{
+			name:   "too many payment ids",
+			msg:    MsgAcceptPaymentsRequest{Target: target, PaymentIds: tooManyIDs},
+			expErr: []string{fmt.Sprintf("too many payment ids %d: cannot have more than %d", MaxAcceptPaymentsPerMsg+1, MaxAcceptPaymentsPerMsg)},
+		},
+		{
+			name:   "payment id: invalid source",
+			msg:    MsgAcceptPaymentsRequest{Target: target, PaymentIds: []PaymentID{id(source0, "one"), id("badsource", "two")}},
+			expErr: []string{"invalid payment ids[1]: invalid source \"badsource\": decoding bech32 failed: invalid separator index -1"},
+		},
+		{
+			name: "payment id: invalid external id",
+			msg: MsgAcceptPaymentsRequest{
+				Target:     target,
+				PaymentIds: []PaymentID{id(source0, strings.Repeat("v", MaxExternalIDLength+1))},
+			},
+			expErr: []string{fmt.Sprintf("invalid payment ids[0]: invalid external id \"vvvvv...vvvvv\" (length %d): max length %d",
+				MaxExternalIDLength+1, MaxExternalIDLength)},
+		},
+		{
+			name:   "payment id: duplicate",
+			msg:    MsgAcceptPaymentsRequest{Target: target, PaymentIds: []PaymentID{id(source0, "one"), id(source1, "one"), id(source0, "one")}},
+			expErr: []string{"invalid payment ids: duplicate entry " + source0 + " \"one\""},
+		},
+		{
+			name:   "invalid source",
+			msg:    MsgAcceptPaymentsRequest{Target: target, Sources: []string{source0, "badsource"}},
+			expErr: []string{"invalid sources[1] \"badsource\": decoding bech32 failed: invalid separator index -1"},
+		},
+		{
+			name:   "duplicate source",
+			msg:    MsgAcceptPaymentsRequest{Target: target, Sources: []string{source0, source1, source0}},
+			expErr: []string{"invalid sources: duplicate entry " + source0},
+		},
+		{
+			name: "invalid max target amount",
+			msg: MsgAcceptPaymentsRequest{
+				Target:          target,
+				Sources:         []string{source0},
+				MaxTargetAmount: sdk.Coins{sdk.Coin{Denom: "cherry", Amount: sdkmath.NewInt(-1)}},
+			},
+			expErr: []string{"invalid max target amount \"-1cherry\": coin -1cherry amount is not positive"},
+		},
+		{
+			name: "multiple errors",
+			msg: MsgAcceptPaymentsRequest{
+				Target:     "",
+				PaymentIds: []PaymentID{id("badsource", "one")},
+				Sources:    []string{"thebadone"},
+			},
+			expErr: []string{
+				"invalid target \"\": empty address string is not allowed",
+				"invalid payment ids[0]: invalid source \"badsource\": decoding bech32 failed: invalid separator index -1",
+				"invalid sources[0] \"thebadone\": decoding bech32 failed: invalid separator index -1",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
 func TestMsgRejectPaymentsRequest_ValidateBasic(t *testing.T) {
 	target := sdk.AccAddress("target______________").String()
 	source0 := sdk.AccAddress("source0_____________").String()
@@ -3037,26 +5153,121 @@ func TestMsgRejectPaymentsRequest_ValidateBasic(t *testing.T) {
 				Target:  target,
 				Sources: []string{source0, "thebadone", source2},
 			},
-			expErr: []string{"invalid sources[1] \"thebadone\": decoding bech32 failed: invalid separator index -1"},
+			expErr: []string{"invalid sources[1] \"thebadone\": decoding bech32 failed: invalid separator index -1"},
+		},
+		{
+			name: "three sources: invalid third",
+			msg: MsgRejectPaymentsRequest{
+				Target:  target,
+				Sources: []string{source0, source1, "thebadtwo"},
+			},
+			expErr: []string{"invalid sources[2] \"thebadtwo\": decoding bech32 failed: invalid separator index -1"},
+		},
+		{
+			name: "multiple errors",
+			msg: MsgRejectPaymentsRequest{
+				Target:  "",
+				Sources: []string{"thebadzero", source1, "thebadtwo"},
+			},
+			expErr: []string{
+				"invalid target \"\": empty address string is not allowed",
+				"invalid sources[0] \"thebadzero\": decoding bech32 failed: invalid separator index -1",
+				"invalid sources[2] \"thebadtwo\": decoding bech32 failed: invalid separator index -1",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
+func TestMsgDisputePaymentRequest_ValidateBasic(t *testing.T) {
+	validMsg := MsgDisputePaymentRequest{
+		Target:     sdk.AccAddress("Target______________").String(),
+		Source:     sdk.AccAddress("Source______________").String(),
+		ExternalId: "just-some-id:1D250135-D735-42E7-9DC3-FDB374DE2604",
+	}
+
+	tests := []struct {
+		name   string
+		msg    MsgDisputePaymentRequest
+		expErr []string
+	}{
+		{
+			name:   "valid message",
+			msg:    validMsg,
+			expErr: nil,
+		},
+		{
+			name: "no target",
+			msg: MsgDisputePaymentRequest{
+				Target:     "",
+				Source:     validMsg.Source,
+				ExternalId: validMsg.ExternalId,
+			},
+			expErr: []string{"invalid target \"\": empty address string is not allowed"},
+		},
+		{
+			name: "invalid target",
+			msg: MsgDisputePaymentRequest{
+				Target:     "reallybad",
+				Source:     validMsg.Source,
+				ExternalId: validMsg.ExternalId,
+			},
+			expErr: []string{"invalid target \"reallybad\": decoding bech32 failed: invalid separator index -1"},
+		},
+		{
+			name: "no source",
+			msg: MsgDisputePaymentRequest{
+				Target:     validMsg.Target,
+				Source:     "",
+				ExternalId: validMsg.ExternalId,
+			},
+			expErr: []string{"invalid source \"\": empty address string is not allowed"},
+		},
+		{
+			name: "invalid source",
+			msg: MsgDisputePaymentRequest{
+				Target:     validMsg.Target,
+				Source:     "alsoverybad",
+				ExternalId: validMsg.ExternalId,
+			},
+			expErr: []string{"invalid source \"alsoverybad\": decoding bech32 failed: invalid separator index -1"},
+		},
+		{
+			name: "no external id",
+			msg: MsgDisputePaymentRequest{
+				Target:     validMsg.Target,
+				Source:     validMsg.Source,
+				ExternalId: "",
+			},
+			expErr: nil,
 		},
 		{
-			name: "three sources: invalid third",
-			msg: MsgRejectPaymentsRequest{
-				Target:  target,
-				Sources: []string{source0, source1, "thebadtwo"},
+			name: "invalid external id",
+			msg: MsgDisputePaymentRequest{
+				Target:     validMsg.Target,
+				Source:     validMsg.Source,
+				ExternalId: "w" + strings.Repeat("o", MaxExternalIDLength) + "w",
 			},
-			expErr: []string{"invalid sources[2] \"thebadtwo\": decoding bech32 failed: invalid separator index -1"},
+			expErr: []string{fmt.Sprintf("invalid external id %q (length %d): max length %d",
+				"woooo...oooow", MaxExternalIDLength+2, MaxExternalIDLength)},
 		},
 		{
 			name: "multiple errors",
-			msg: MsgRejectPaymentsRequest{
-				Target:  "",
-				Sources: []string{"thebadzero", source1, "thebadtwo"},
+			msg: MsgDisputePaymentRequest{
+				Target:     "reallybad",
+				Source:     "",
+				ExternalId: "w" + strings.Repeat("o", MaxExternalIDLength) + "w",
 			},
 			expErr: []string{
-				"invalid target \"\": empty address string is not allowed",
-				"invalid sources[0] \"thebadzero\": decoding bech32 failed: invalid separator index -1",
-				"invalid sources[2] \"thebadtwo\": decoding bech32 failed: invalid separator index -1",
+				"invalid target \"reallybad\": decoding bech32 failed: invalid separator index -1",
+				"invalid source \"\": empty address string is not allowed",
+				fmt.Sprintf("invalid external id %q (length %d): max length %d",
+					"woooo...oooow", MaxExternalIDLength+2, MaxExternalIDLength),
 			},
 		},
 	}
@@ -3312,6 +5523,329 @@ func TestMsgChangePaymentTargetRequest_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgCreateRecurringPaymentRequest_ValidateBasic(t *testing.T) {
+	source := sdk.AccAddress("source______________").String()
+	target := sdk.AccAddress("target______________").String()
+	startTime := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	epoch := time.Unix(0, 0)
+
+	tests := []struct {
+		name   string
+		msg    MsgCreateRecurringPaymentRequest
+		expErr []string
+	}{
+		{
+			name: "valid without start time",
+			msg: MsgCreateRecurringPaymentRequest{
+				Source: source, Target: target, Amount: sdk.NewCoins(sdk.NewInt64Coin("plum", 5)),
+				ExternalId: "sub-1", IntervalSeconds: 86400, Transfers: 12,
+			},
+		},
+		{
+			name: "valid with start time",
+			msg: MsgCreateRecurringPaymentRequest{
+				Source: source, Target: target, Amount: sdk.NewCoins(sdk.NewInt64Coin("plum", 5)),
+				ExternalId: "", IntervalSeconds: 1, Transfers: 1, StartTime: &startTime,
+			},
+		},
+		{
+			name: "invalid source",
+			msg: MsgCreateRecurringPaymentRequest{
+				Source: "nope", Target: target, Amount: sdk.NewCoins(sdk.NewInt64Coin("plum", 5)),
+				IntervalSeconds: 86400, Transfers: 12,
+			},
+			expErr: []string{"invalid source \"nope\": decoding bech32 failed: invalid bech32 string length 4"},
+		},
+		{
+			name: "no target",
+			msg: MsgCreateRecurringPaymentRequest{
+				Source: source, Target: "", Amount: sdk.NewCoins(sdk.NewInt64Coin("plum", 5)),
+				IntervalSeconds: 86400, Transfers: 12,
+			},
+			expErr: []string{"invalid target \"\": empty address string is not allowed"},
+		},
+		{
+			name: "zero amount",
+			msg: MsgCreateRecurringPaymentRequest{
+				Source: source, Target: target, Amount: nil,
+				IntervalSeconds: 86400, Transfers: 12,
+			},
+			expErr: []string{"invalid amount \"\": cannot be zero"},
+		},
+		{
+			name: "invalid amount",
+			msg: MsgCreateRecurringPaymentRequest{
+				Source: source, Target: target, Amount: sdk.Coins{sdk.Coin{Denom: "p", Amount: sdkmath.NewInt(5)}},
+				IntervalSeconds: 86400, Transfers: 12,
+			},
+			expErr: []string{"invalid amount \"5p\": invalid denom: p"},
+		},
+		{
+			name: "invalid external id",
+			msg: MsgCreateRecurringPaymentRequest{
+				Source: source, Target: target, Amount: sdk.NewCoins(sdk.NewInt64Coin("plum", 5)),
+				ExternalId: strings.Repeat("e", MaxExternalIDLength+1), IntervalSeconds: 86400, Transfers: 12,
+			},
+			expErr: []string{fmt.Sprintf("invalid external id %q (length %d): max length %d",
+				"eeeee...eeeee", MaxExternalIDLength+1, MaxExternalIDLength)},
+		},
+		{
+			name: "zero interval",
+			msg: MsgCreateRecurringPaymentRequest{
+				Source: source, Target: target, Amount: sdk.NewCoins(sdk.NewInt64Coin("plum", 5)),
+				IntervalSeconds: 0, Transfers: 12,
+			},
+			expErr: []string{"invalid interval: cannot be zero"},
+		},
+		{
+			name: "zero transfers",
+			msg: MsgCreateRecurringPaymentRequest{
+				Source: source, Target: target, Amount: sdk.NewCoins(sdk.NewInt64Coin("plum", 5)),
+				IntervalSeconds: 86400, Transfers: 0,
+			},
+			expErr: []string{"invalid transfers: cannot be zero"},
+		},
+		{
+			name: "start time at epoch",
+			msg: MsgCreateRecurringPaymentRequest{
+				Source: source, Target: target, Amount: sdk.NewCoins(sdk.NewInt64Coin("plum", 5)),
+				IntervalSeconds: 86400, Transfers: 12, StartTime: &epoch,
+			},
+			expErr: []string{"invalid start time 1970-01-01T00:00:00Z: must be after 1970-01-01T00:00:00Z"},
+		},
+		{
+			name: "multiple errors",
+			msg:  MsgCreateRecurringPaymentRequest{},
+			expErr: []string{
+				"invalid source \"\": empty address string is not allowed",
+				"invalid target \"\": empty address string is not allowed",
+				"invalid amount \"\": cannot be zero",
+				"invalid interval: cannot be zero",
+				"invalid transfers: cannot be zero",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
+func TestMsgCancelRecurringPaymentsRequest_ValidateBasic(t *testing.T) {
+	source := sdk.AccAddress("source______________").String()
+
+	tests := []struct {
+		name   string
+		msg    MsgCancelRecurringPaymentsRequest
+		expErr []string
+	}{
+		{
+			name:   "valid",
+			msg:    MsgCancelRecurringPaymentsRequest{Source: source, ExternalIds: []string{"a", ""}},
+			expErr: nil,
+		},
+		{
+			name:   "no source",
+			msg:    MsgCancelRecurringPaymentsRequest{Source: "", ExternalIds: []string{"a"}},
+			expErr: []string{"invalid source \"\": empty address string is not allowed"},
+		},
+		{
+			name:   "no external ids",
+			msg:    MsgCancelRecurringPaymentsRequest{Source: source, ExternalIds: nil},
+			expErr: []string{"at least one external id is required"},
+		},
+		{
+			name:   "duplicate external ids",
+			msg:    MsgCancelRecurringPaymentsRequest{Source: source, ExternalIds: []string{"twin", "other", "twin"}},
+			expErr: []string{"invalid external ids: duplicate entry \"twin\""},
+		},
+		{
+			name: "invalid external id",
+			msg: MsgCancelRecurringPaymentsRequest{
+				Source:      source,
+				ExternalIds: []string{"a", strings.Repeat("1", MaxExternalIDLength+1)},
+			},
+			expErr: []string{fmt.Sprintf("invalid external ids[1]: invalid external id %q (length %d): max length %d",
+				"11111...11111", MaxExternalIDLength+1, MaxExternalIDLength)},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
+func TestMsgCreateMultiPartyPaymentRequest_ValidateBasic(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________").String()
+	addr2 := sdk.AccAddress("addr2_______________").String()
+	addr3 := sdk.AccAddress("addr3_______________").String()
+	parties := func() []PaymentParty {
+		return []PaymentParty{
+			{Address: addr1, SendAmount: sdk.NewCoins(sdk.NewInt64Coin("apple", 10))},
+			{Address: addr2, SendAmount: sdk.NewCoins(sdk.NewInt64Coin("banana", 5)), ReceiveAmount: sdk.NewCoins(sdk.NewInt64Coin("apple", 10))},
+			{Address: addr3, ReceiveAmount: sdk.NewCoins(sdk.NewInt64Coin("banana", 5))},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		msg    MsgCreateMultiPartyPaymentRequest
+		expErr []string
+	}{
+		{
+			name: "valid",
+			msg:  MsgCreateMultiPartyPaymentRequest{Creator: addr1, ExternalId: "otc", Parties: parties()},
+		},
+		{
+			name: "creator is a receiver",
+			msg:  MsgCreateMultiPartyPaymentRequest{Creator: addr3, Parties: parties()},
+		},
+		{
+			name:   "no creator",
+			msg:    MsgCreateMultiPartyPaymentRequest{Creator: "", Parties: parties()},
+			expErr: []string{"invalid creator \"\": empty address string is not allowed"},
+		},
+		{
+			name: "creator not a party",
+			msg: MsgCreateMultiPartyPaymentRequest{
+				Creator: sdk.AccAddress("other_______________").String(),
+				Parties: parties(),
+			},
+			expErr: []string{"creator " + sdk.AccAddress("other_______________").String() + " is not one of the parties"},
+		},
+		{
+			name: "invalid external id",
+			msg: MsgCreateMultiPartyPaymentRequest{
+				Creator:    addr1,
+				ExternalId: strings.Repeat("x", MaxExternalIDLength+1),
+				Parties:    parties(),
+			},
+			expErr: []string{fmt.Sprintf("invalid external id %q (length %d): max length %d",
+				"xxxxx...xxxxx", MaxExternalIDLength+1, MaxExternalIDLength)},
+		},
+		{
+			name:   "not enough parties",
+			msg:    MsgCreateMultiPartyPaymentRequest{Creator: addr1, Parties: parties()[:1]},
+			expErr: []string{"at least 2 parties are required, found 1"},
+		},
+		{
+			name:   "amounts do not balance",
+			msg:    MsgCreateMultiPartyPaymentRequest{Creator: addr1, Parties: parties()[:2]},
+			expErr: []string{"total send amount \"10apple,5banana\" does not equal total receive amount \"10apple\""},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
+func TestMsgAcceptMultiPartyPaymentRequest_ValidateBasic(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________").String()
+	addr2 := sdk.AccAddress("addr2_______________").String()
+	addr3 := sdk.AccAddress("addr3_______________").String()
+	parties := func() []PaymentParty {
+		return []PaymentParty{
+			{Address: addr1, SendAmount: sdk.NewCoins(sdk.NewInt64Coin("apple", 10))},
+			{Address: addr2, SendAmount: sdk.NewCoins(sdk.NewInt64Coin("banana", 5)), ReceiveAmount: sdk.NewCoins(sdk.NewInt64Coin("apple", 10))},
+			{Address: addr3, ReceiveAmount: sdk.NewCoins(sdk.NewInt64Coin("banana", 5))},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		msg    MsgAcceptMultiPartyPaymentRequest
+		expErr []string
+	}{
+		{
+			name: "valid",
+			msg:  MsgAcceptMultiPartyPaymentRequest{Party: addr2, Creator: addr1, ExternalId: "otc", Parties: parties()},
+		},
+		{
+			name:   "no party",
+			msg:    MsgAcceptMultiPartyPaymentRequest{Party: "", Creator: addr1, Parties: parties()},
+			expErr: []string{"invalid party \"\": empty address string is not allowed"},
+		},
+		{
+			name: "party not a party",
+			msg: MsgAcceptMultiPartyPaymentRequest{
+				Party:   sdk.AccAddress("other_______________").String(),
+				Creator: addr1,
+				Parties: parties(),
+			},
+			expErr: []string{"party " + sdk.AccAddress("other_______________").String() + " is not one of the parties"},
+		},
+		{
+			name:   "no creator",
+			msg:    MsgAcceptMultiPartyPaymentRequest{Party: addr2, Creator: "", Parties: parties()},
+			expErr: []string{"invalid creator \"\": empty address string is not allowed"},
+		},
+		{
+			name:   "no parties",
+			msg:    MsgAcceptMultiPartyPaymentRequest{Party: addr2, Creator: addr1},
+			expErr: []string{"at least 2 parties are required, found 0"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
+func TestMsgCancelMultiPartyPaymentRequest_ValidateBasic(t *testing.T) {
+	party := sdk.AccAddress("party_______________").String()
+	creator := sdk.AccAddress("creator_____________").String()
+
+	tests := []struct {
+		name   string
+		msg    MsgCancelMultiPartyPaymentRequest
+		expErr []string
+	}{
+		{
+			name: "valid",
+			msg:  MsgCancelMultiPartyPaymentRequest{Party: party, Creator: creator, ExternalId: "otc"},
+		},
+		{
+			name: "valid: party is creator",
+			msg:  MsgCancelMultiPartyPaymentRequest{Party: creator, Creator: creator},
+		},
+		{
+			name:   "no party",
+			msg:    MsgCancelMultiPartyPaymentRequest{Party: "", Creator: creator},
+			expErr: []string{"invalid party \"\": empty address string is not allowed"},
+		},
+		{
+			name:   "no creator",
+			msg:    MsgCancelMultiPartyPaymentRequest{Party: party, Creator: ""},
+			expErr: []string{"invalid creator \"\": empty address string is not allowed"},
+		},
+		{
+			name: "invalid external id",
+			msg: MsgCancelMultiPartyPaymentRequest{
+				Party:      party,
+				Creator:    creator,
+				ExternalId: strings.Repeat("x", MaxExternalIDLength+1),
+			},
+			expErr: []string{fmt.Sprintf("invalid external id %q (length %d): max length %d",
+				"xxxxx...xxxxx", MaxExternalIDLength+1, MaxExternalIDLength)},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
 func TestMsgGovCreateMarketRequest_ValidateBasic(t *testing.T) {
 	authority := sdk.AccAddress("authority___________").String()
 
@@ -3417,6 +5951,7 @@ func TestMsgGovManageFeesRequest_ValidateBasic(t *testing.T) {
 	ratio := func(priceAmount int64, priceDenom string, feeAmount int64, feeDenom string) FeeRatio {
 		return FeeRatio{Price: coin(priceAmount, priceDenom), Fee: coin(feeAmount, feeDenom)}
 	}
+	effTime := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
 
 	tests := []struct {
 		name   string
@@ -3501,41 +6036,92 @@ func TestMsgGovManageFeesRequest_ValidateBasic(t *testing.T) {
 				AddFeeCreateBidFlat:    []sdk.Coin{coin(1, "nhash")},
 				RemoveFeeCreateBidFlat: []sdk.Coin{coin(1, "nhash")},
 			},
-			expErr: []string{"cannot add and remove the same create-bid flat fee options 1nhash"},
+			expErr: []string{"cannot add and remove the same create-bid flat fee options 1nhash"},
+		},
+		{
+			name: "invalid add seller settlement flat",
+			msg: MsgGovManageFeesRequest{
+				Authority:                  authority,
+				AddFeeSellerSettlementFlat: []sdk.Coin{coin(0, "nhash")},
+			},
+			expErr: []string{`invalid seller settlement flat fee to add option "0nhash": amount cannot be zero`},
+		},
+		{
+			name: "same add and remove seller settlement flat",
+			msg: MsgGovManageFeesRequest{
+				Authority:                     authority,
+				AddFeeSellerSettlementFlat:    []sdk.Coin{coin(1, "nhash")},
+				RemoveFeeSellerSettlementFlat: []sdk.Coin{coin(1, "nhash")},
+			},
+			expErr: []string{"cannot add and remove the same seller settlement flat fee options 1nhash"},
+		},
+		{
+			name: "invalid add seller settlement ratio",
+			msg: MsgGovManageFeesRequest{
+				Authority:                    authority,
+				AddFeeSellerSettlementRatios: []FeeRatio{ratio(1, "nhash", 2, "nhash")},
+			},
+			expErr: []string{`seller fee ratio fee amount "2nhash" cannot be greater than price amount "1nhash"`},
+		},
+		{
+			name: "same add and remove seller settlement ratio",
+			msg: MsgGovManageFeesRequest{
+				Authority:                       authority,
+				AddFeeSellerSettlementRatios:    []FeeRatio{ratio(2, "nhash", 1, "nhash")},
+				RemoveFeeSellerSettlementRatios: []FeeRatio{ratio(2, "nhash", 1, "nhash")},
+			},
+			expErr: []string{"cannot add and remove the same seller settlement fee ratios 2nhash:1nhash"},
+		},
+		{
+			name: "invalid add seller settlement taker ratio",
+			msg: MsgGovManageFeesRequest{
+				Authority:                         authority,
+				AddFeeSellerSettlementTakerRatios: []FeeRatio{ratio(1, "nhash", 2, "nhash")},
+			},
+			expErr: []string{`seller fee ratio fee amount "2nhash" cannot be greater than price amount "1nhash"`},
+		},
+		{
+			name: "same add and remove seller settlement taker ratio",
+			msg: MsgGovManageFeesRequest{
+				Authority:                            authority,
+				AddFeeSellerSettlementTakerRatios:    []FeeRatio{ratio(2, "nhash", 1, "nhash")},
+				RemoveFeeSellerSettlementTakerRatios: []FeeRatio{ratio(2, "nhash", 1, "nhash")},
+			},
+			expErr: []string{"cannot add and remove the same seller settlement taker fee ratios 2nhash:1nhash"},
 		},
 		{
-			name: "invalid add seller settlement flat",
+			name: "invalid add seller settlement rebate ratio",
 			msg: MsgGovManageFeesRequest{
-				Authority:                  authority,
-				AddFeeSellerSettlementFlat: []sdk.Coin{coin(0, "nhash")},
+				Authority:                          authority,
+				AddFeeSellerSettlementRebateRatios: []FeeRatio{ratio(1, "nhash", 2, "nhash")},
 			},
-			expErr: []string{`invalid seller settlement flat fee to add option "0nhash": amount cannot be zero`},
+			expErr: []string{`invalid rebate ratios: seller fee ratio fee amount "2nhash" cannot be greater than price amount "1nhash"`},
 		},
 		{
-			name: "same add and remove seller settlement flat",
+			name: "same add and remove seller settlement rebate ratio",
 			msg: MsgGovManageFeesRequest{
-				Authority:                     authority,
-				AddFeeSellerSettlementFlat:    []sdk.Coin{coin(1, "nhash")},
-				RemoveFeeSellerSettlementFlat: []sdk.Coin{coin(1, "nhash")},
+				Authority:                             authority,
+				AddFeeSellerSettlementRebateRatios:    []FeeRatio{ratio(2, "nhash", 1, "nhash")},
+				RemoveFeeSellerSettlementRebateRatios: []FeeRatio{ratio(2, "nhash", 1, "nhash")},
 			},
-			expErr: []string{"cannot add and remove the same seller settlement flat fee options 1nhash"},
+			expErr: []string{"cannot add and remove the same seller settlement rebate fee ratios 2nhash:1nhash"},
 		},
 		{
-			name: "invalid add seller settlement ratio",
+			name: "invalid add rebate address",
 			msg: MsgGovManageFeesRequest{
-				Authority:                    authority,
-				AddFeeSellerSettlementRatios: []FeeRatio{ratio(1, "nhash", 2, "nhash")},
+				Authority:          authority,
+				AddRebateAddresses: []string{"bad"},
 			},
-			expErr: []string{`seller fee ratio fee amount "2nhash" cannot be greater than price amount "1nhash"`},
+			expErr: []string{`invalid rebate address "bad": decoding bech32 failed: invalid bech32 string length 3`},
 		},
 		{
-			name: "same add and remove seller settlement ratio",
+			name: "same add and remove rebate address",
 			msg: MsgGovManageFeesRequest{
-				Authority:                       authority,
-				AddFeeSellerSettlementRatios:    []FeeRatio{ratio(2, "nhash", 1, "nhash")},
-				RemoveFeeSellerSettlementRatios: []FeeRatio{ratio(2, "nhash", 1, "nhash")},
+				Authority:             authority,
+				AddRebateAddresses:    []string{authority},
+				RemoveRebateAddresses: []string{authority},
 			},
-			expErr: []string{"cannot add and remove the same seller settlement fee ratios 2nhash:1nhash"},
+			expErr: []string{`cannot add and remove the same rebate address "` + authority + `"`},
 		},
 		{
 			name: "invalid add buyer settlement flat",
@@ -3588,6 +6174,81 @@ func TestMsgGovManageFeesRequest_ValidateBasic(t *testing.T) {
 			},
 			expErr: []string{"invalid commitment settlement bips 1: must be zero when unset_fee_commitment_settlement_bips is true"},
 		},
+		{
+			name: "set referral bips too high",
+			msg: MsgGovManageFeesRequest{
+				Authority:       authority,
+				SetReferralBips: 10_001,
+			},
+			expErr: []string{"invalid referral bips 10001: exceeds max of 10000"},
+		},
+		{
+			name: "set referral bips with unset",
+			msg: MsgGovManageFeesRequest{
+				Authority:         authority,
+				SetReferralBips:   1,
+				UnsetReferralBips: true,
+			},
+			expErr: []string{"invalid referral bips 1: must be zero when unset_referral_bips is true"},
+		},
+		{
+			name: "invalid add referral cap",
+			msg: MsgGovManageFeesRequest{
+				Authority:      authority,
+				AddReferralCap: []sdk.Coin{coin(0, "nhash")},
+			},
+			expErr: []string{`invalid referral cap to add option "0nhash": amount cannot be zero`},
+		},
+		{
+			name: "same add and remove referral cap",
+			msg: MsgGovManageFeesRequest{
+				Authority:         authority,
+				AddReferralCap:    []sdk.Coin{coin(1, "nhash")},
+				RemoveReferralCap: []sdk.Coin{coin(1, "nhash")},
+			},
+			expErr: []string{"cannot add and remove the same referral cap options 1nhash"},
+		},
+		{
+			name: "with effective height",
+			msg: MsgGovManageFeesRequest{
+				Authority:           authority,
+				MarketId:            1,
+				AddFeeCreateAskFlat: []sdk.Coin{coin(1, "nhash")},
+				EffectiveHeight:     1000,
+			},
+			expErr: nil,
+		},
+		{
+			name: "with effective time",
+			msg: MsgGovManageFeesRequest{
+				Authority:           authority,
+				MarketId:            1,
+				AddFeeCreateAskFlat: []sdk.Coin{coin(1, "nhash")},
+				EffectiveTime:       &effTime,
+			},
+			expErr: nil,
+		},
+		{
+			name: "negative effective height",
+			msg: MsgGovManageFeesRequest{
+				Authority:           authority,
+				MarketId:            1,
+				AddFeeCreateAskFlat: []sdk.Coin{coin(1, "nhash")},
+				EffectiveHeight:     -3,
+			},
+			expErr: []string{"invalid effective height -3: cannot be negative"},
+		},
+		{
+			name: "both effective height and time",
+			msg: MsgGovManageFeesRequest{
+				Authority:           authority,
+				MarketId:            1,
+				AddFeeCreateAskFlat: []sdk.Coin{coin(1, "nhash")},
+				EffectiveHeight:     1000,
+				EffectiveTime:       &effTime,
+			},
+			expErr: []string{"cannot provide both an effective height and an effective time"},
+		},
 		{
 			name: "multiple errors",
 			msg: MsgGovManageFeesRequest{
@@ -3700,6 +6361,36 @@ func TestMsgGovManageFeesRequest_HasUpdates(t *testing.T) {
 			msg:  MsgGovManageFeesRequest{RemoveFeeSellerSettlementRatios: oneRatio},
 			exp:  true,
 		},
+		{
+			name: "one add fee seller settlement taker ratio",
+			msg:  MsgGovManageFeesRequest{AddFeeSellerSettlementTakerRatios: oneRatio},
+			exp:  true,
+		},
+		{
+			name: "one remove fee seller settlement taker ratio",
+			msg:  MsgGovManageFeesRequest{RemoveFeeSellerSettlementTakerRatios: oneRatio},
+			exp:  true,
+		},
+		{
+			name: "one add fee seller settlement rebate ratio",
+			msg:  MsgGovManageFeesRequest{AddFeeSellerSettlementRebateRatios: oneRatio},
+			exp:  true,
+		},
+		{
+			name: "one remove fee seller settlement rebate ratio",
+			msg:  MsgGovManageFeesRequest{RemoveFeeSellerSettlementRebateRatios: oneRatio},
+			exp:  true,
+		},
+		{
+			name: "one add rebate address",
+			msg:  MsgGovManageFeesRequest{AddRebateAddresses: []string{"addr"}},
+			exp:  true,
+		},
+		{
+			name: "one remove rebate address",
+			msg:  MsgGovManageFeesRequest{RemoveRebateAddresses: []string{"addr"}},
+			exp:  true,
+		},
 		{
 			name: "one add fee buyer settlement flat",
 			msg:  MsgGovManageFeesRequest{AddFeeBuyerSettlementFlat: oneCoin},
@@ -3740,6 +6431,26 @@ func TestMsgGovManageFeesRequest_HasUpdates(t *testing.T) {
 			msg:  MsgGovManageFeesRequest{UnsetFeeCommitmentSettlementBips: true},
 			exp:  true,
 		},
+		{
+			name: "set referral bips",
+			msg:  MsgGovManageFeesRequest{SetReferralBips: 1},
+			exp:  true,
+		},
+		{
+			name: "unset referral bips",
+			msg:  MsgGovManageFeesRequest{UnsetReferralBips: true},
+			exp:  true,
+		},
+		{
+			name: "one add referral cap",
+			msg:  MsgGovManageFeesRequest{AddReferralCap: oneCoin},
+			exp:  true,
+		},
+		{
+			name: "one remove referral cap",
+			msg:  MsgGovManageFeesRequest{RemoveReferralCap: oneCoin},
+			exp:  true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -3754,6 +6465,187 @@ func TestMsgGovManageFeesRequest_HasUpdates(t *testing.T) {
 	}
 }
 
+func TestMsgGovManageFeesRequest_IsScheduled(t *testing.T) {
+	effTime := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		msg  MsgGovManageFeesRequest
+		exp  bool
+	}{
+		{name: "empty", msg: MsgGovManageFeesRequest{}, exp: false},
+		{name: "with updates", msg: MsgGovManageFeesRequest{SetFeeCommitmentSettlementBips: 5}, exp: false},
+		{name: "effective height", msg: MsgGovManageFeesRequest{EffectiveHeight: 5}, exp: true},
+		{name: "effective time", msg: MsgGovManageFeesRequest{EffectiveTime: &effTime}, exp: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual bool
+			testFunc := func() {
+				actual = tc.msg.IsScheduled()
+			}
+			require.NotPanics(t, testFunc, "%T.IsScheduled()", tc.msg)
+			assert.Equal(t, tc.exp, actual, "%T.IsScheduled() result", tc.msg)
+		})
+	}
+}
+
+func TestMsgGovManageFeesRequest_IsDue(t *testing.T) {
+	effTime := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		msg       MsgGovManageFeesRequest
+		height    int64
+		blockTime time.Time
+		exp       bool
+	}{
+		{
+			name:   "height: before",
+			msg:    MsgGovManageFeesRequest{EffectiveHeight: 50},
+			height: 49,
+			exp:    false,
+		},
+		{
+			name:   "height: at",
+			msg:    MsgGovManageFeesRequest{EffectiveHeight: 50},
+			height: 50,
+			exp:    true,
+		},
+		{
+			name:   "height: after",
+			msg:    MsgGovManageFeesRequest{EffectiveHeight: 50},
+			height: 51,
+			exp:    true,
+		},
+		{
+			name:      "time: before",
+			msg:       MsgGovManageFeesRequest{EffectiveTime: &effTime},
+			height:    1_000_000,
+			blockTime: effTime.Add(-1 * time.Second),
+			exp:       false,
+		},
+		{
+			name:      "time: at",
+			msg:       MsgGovManageFeesRequest{EffectiveTime: &effTime},
+			blockTime: effTime,
+			exp:       true,
+		},
+		{
+			name:      "time: after",
+			msg:       MsgGovManageFeesRequest{EffectiveTime: &effTime},
+			blockTime: effTime.Add(time.Second),
+			exp:       true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual bool
+			testFunc := func() {
+				actual = tc.msg.IsDue(tc.height, tc.blockTime)
+			}
+			require.NotPanics(t, testFunc, "%T.IsDue(%d, %s)", tc.msg, tc.height, tc.blockTime)
+			assert.Equal(t, tc.exp, actual, "%T.IsDue(%d, %s) result", tc.msg, tc.height, tc.blockTime)
+		})
+	}
+}
+
+func TestMsgGovManageExchangeSplitsRequest_ValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("authority___________").String()
+	tests := []struct {
+		name   string
+		msg    MsgGovManageExchangeSplitsRequest
+		expErr []string
+	}{
+		{
+			name: "control",
+			msg: MsgGovManageExchangeSplitsRequest{
+				Authority:            authority,
+				MarketId:             1,
+				SetExchangeSplits:    []DenomSplit{{Denom: "apple", Split: 100}, {Denom: "banana", Split: 0}},
+				RemoveExchangeSplits: []string{"cherry"},
+			},
+		},
+		{
+			name: "only set",
+			msg: MsgGovManageExchangeSplitsRequest{
+				Authority:         authority,
+				MarketId:          1,
+				SetExchangeSplits: []DenomSplit{{Denom: "apple", Split: 10_000}},
+			},
+		},
+		{
+			name: "only remove",
+			msg: MsgGovManageExchangeSplitsRequest{
+				Authority:            authority,
+				MarketId:             1,
+				RemoveExchangeSplits: []string{"apple"},
+			},
+		},
+		{
+			name: "bad authority",
+			msg: MsgGovManageExchangeSplitsRequest{
+				Authority:            "notanauthorityaddr",
+				MarketId:             1,
+				RemoveExchangeSplits: []string{"apple"},
+			},
+			expErr: []string{"invalid authority \"notanauthorityaddr\": " + bech32Err},
+		},
+		{
+			name: "market zero",
+			msg: MsgGovManageExchangeSplitsRequest{
+				Authority:            authority,
+				MarketId:             0,
+				RemoveExchangeSplits: []string{"apple"},
+			},
+			expErr: []string{"invalid market id: cannot be zero"},
+		},
+		{
+			name: "no updates",
+			msg: MsgGovManageExchangeSplitsRequest{
+				Authority: authority,
+				MarketId:  1,
+			},
+			expErr: []string{"no updates"},
+		},
+		{
+			name: "invalid splits",
+			msg: MsgGovManageExchangeSplitsRequest{
+				Authority:            authority,
+				MarketId:             1,
+				SetExchangeSplits:    []DenomSplit{{Denom: "x", Split: 1}, {Denom: "apple", Split: 10_001}},
+				RemoveExchangeSplits: []string{"y", "apple"},
+			},
+			expErr: []string{
+				"invalid exchange split to set: invalid denom: x",
+				"invalid exchange split to set: apple split 10001 cannot be greater than 10000",
+				"invalid exchange split denom to remove: invalid denom: y",
+				"cannot set and remove the same exchange split denom \"apple\"",
+			},
+		},
+		{
+			name: "multiple errors",
+			msg: MsgGovManageExchangeSplitsRequest{
+				Authority: "",
+				MarketId:  0,
+			},
+			expErr: []string{
+				"invalid authority \"\": " + emptyAddrErr,
+				"invalid market id: cannot be zero",
+				"no updates",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
 func TestMsgGovCloseMarketRequest_ValidateBasic(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -3811,6 +6703,71 @@ func TestMsgGovCloseMarketRequest_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgGovWindDownMarketRequest_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name   string
+		msg    MsgGovWindDownMarketRequest
+		expErr []string
+	}{
+		{
+			name: "control",
+			msg: MsgGovWindDownMarketRequest{
+				Authority: sdk.AccAddress("authority___________").String(),
+				MarketId:  1,
+			},
+		},
+		{
+			name: "control: delete market",
+			msg: MsgGovWindDownMarketRequest{
+				Authority:    sdk.AccAddress("authority___________").String(),
+				MarketId:     1,
+				DeleteMarket: true,
+			},
+		},
+		{
+			name: "no authority",
+			msg: MsgGovWindDownMarketRequest{
+				Authority: "",
+				MarketId:  1,
+			},
+			expErr: []string{"invalid authority \"\": " + emptyAddrErr},
+		},
+		{
+			name: "bad authority",
+			msg: MsgGovWindDownMarketRequest{
+				Authority: "notanauthorityaddr",
+				MarketId:  1,
+			},
+			expErr: []string{"invalid authority \"notanauthorityaddr\": " + bech32Err},
+		},
+		{
+			name: "market zero",
+			msg: MsgGovWindDownMarketRequest{
+				Authority: sdk.AccAddress("authority___________").String(),
+				MarketId:  0,
+			},
+			expErr: []string{"invalid market id: cannot be zero"},
+		},
+		{
+			name: "multiple errors",
+			msg: MsgGovWindDownMarketRequest{
+				Authority: "",
+				MarketId:  0,
+			},
+			expErr: []string{
+				"invalid authority \"\": " + emptyAddrErr,
+				"invalid market id: cannot be zero",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
 func TestMsgUpdateParamsRequest_ValidateBasic(t *testing.T) {
 	pioconfig.SetProvenanceConfig("", 0)
 	authority := sdk.AccAddress("authority___________").String()
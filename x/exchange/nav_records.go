@@ -0,0 +1,41 @@
+package exchange
+
+import (
+	"errors"
+	"fmt"
+)
+
+// MaxNAVRecordsPrunedPerBlock is the maximum number of old NAV records that are deleted at the end of a block.
+const MaxNAVRecordsPrunedPerBlock = 1_000
+
+// NewNAVRecord creates a new NAVRecord for a settlement in a market at the given height.
+func NewNAVRecord(marketID uint32, height int64, nav NetAssetPrice) *NAVRecord {
+	return &NAVRecord{
+		Assets:   nav.Assets,
+		Price:    nav.Price,
+		Height:   height,
+		MarketId: marketID,
+	}
+}
+
+// Validate returns an error if anything in this NAV record is invalid.
+func (r NAVRecord) Validate() error {
+	var errs []error
+	if err := r.Assets.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("invalid assets: %w", err))
+	} else if !r.Assets.Amount.IsPositive() {
+		errs = append(errs, fmt.Errorf("invalid assets %q: amount must be positive", r.Assets))
+	}
+	if err := r.Price.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("invalid price: %w", err))
+	} else if !r.Price.Amount.IsPositive() {
+		errs = append(errs, fmt.Errorf("invalid price %q: amount must be positive", r.Price))
+	}
+	if r.Height <= 0 {
+		errs = append(errs, fmt.Errorf("invalid height %d: must be positive", r.Height))
+	}
+	if r.MarketId == 0 {
+		errs = append(errs, errors.New("invalid market id: cannot be zero"))
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,116 @@
+package exchange
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+func TestNewNAVRecord(t *testing.T) {
+	nav := NetAssetPrice{Assets: sdk.NewInt64Coin("apple", 8), Price: sdk.NewInt64Coin("plum", 20)}
+	exp := &NAVRecord{
+		Assets:   sdk.NewInt64Coin("apple", 8),
+		Price:    sdk.NewInt64Coin("plum", 20),
+		Height:   12,
+		MarketId: 3,
+	}
+
+	var actual *NAVRecord
+	testFunc := func() {
+		actual = NewNAVRecord(3, 12, nav)
+	}
+	require.NotPanics(t, testFunc, "NewNAVRecord")
+	assert.Equal(t, exp, actual, "NewNAVRecord result")
+}
+
+func TestNAVRecord_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		record NAVRecord
+		expErr string
+	}{
+		{
+			name: "okay",
+			record: NAVRecord{
+				Assets: sdk.NewInt64Coin("apple", 8), Price: sdk.NewInt64Coin("plum", 20),
+				Height: 5, MarketId: 1,
+			},
+		},
+		{
+			name: "invalid assets denom",
+			record: NAVRecord{
+				Assets: sdk.Coin{Denom: "x", Amount: sdkmath.NewInt(8)}, Price: sdk.NewInt64Coin("plum", 20),
+				Height: 5, MarketId: 1,
+			},
+			expErr: "invalid assets: invalid denom: x",
+		},
+		{
+			name: "zero assets",
+			record: NAVRecord{
+				Assets: sdk.NewInt64Coin("apple", 0), Price: sdk.NewInt64Coin("plum", 20),
+				Height: 5, MarketId: 1,
+			},
+			expErr: "invalid assets \"0apple\": amount must be positive",
+		},
+		{
+			name: "invalid price denom",
+			record: NAVRecord{
+				Assets: sdk.NewInt64Coin("apple", 8), Price: sdk.Coin{Denom: "y", Amount: sdkmath.NewInt(20)},
+				Height: 5, MarketId: 1,
+			},
+			expErr: "invalid price: invalid denom: y",
+		},
+		{
+			name: "zero price",
+			record: NAVRecord{
+				Assets: sdk.NewInt64Coin("apple", 8), Price: sdk.NewInt64Coin("plum", 0),
+				Height: 5, MarketId: 1,
+			},
+			expErr: "invalid price \"0plum\": amount must be positive",
+		},
+		{
+			name: "negative height",
+			record: NAVRecord{
+				Assets: sdk.NewInt64Coin("apple", 8), Price: sdk.NewInt64Coin("plum", 20),
+				Height: -1, MarketId: 1,
+			},
+			expErr: "invalid height -1: must be positive",
+		},
+		{
+			name: "zero market id",
+			record: NAVRecord{
+				Assets: sdk.NewInt64Coin("apple", 8), Price: sdk.NewInt64Coin("plum", 20),
+				Height: 5, MarketId: 0,
+			},
+			expErr: "invalid market id: cannot be zero",
+		},
+		{
+			name:   "multiple problems",
+			record: NAVRecord{},
+			expErr: joinErrs(
+				"invalid assets: invalid denom: ",
+				"invalid price: invalid denom: ",
+				"invalid height 0: must be positive",
+				"invalid market id: cannot be zero",
+			),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = tc.record.Validate()
+			}
+			require.NotPanics(t, testFunc, "Validate")
+			assertions.AssertErrorValue(t, err, tc.expErr, "Validate error")
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package exchange
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewPriceLevel creates a new price level with the unit price of the provided order and its displayed assets.
+func NewPriceLevel(order *Order) *PriceLevel {
+	price := order.GetPrice()
+	assets := order.GetAssets()
+	return &PriceLevel{
+		Price:      sdk.NewDecCoinFromDec(price.Denom, price.Amount.ToLegacyDec().QuoInt(assets.Amount)),
+		Assets:     order.GetDisplayedAssets(),
+		OrderCount: 1,
+	}
+}
+
+// BuildPriceLevels aggregates the provided orders by unit price. All orders must be the same type
+// (i.e. all asks or all bids) and have the same assets and price denoms.
+// Bid levels are ordered from highest unit price to lowest, and ask levels from lowest to highest.
+// If depth is positive, at most that many levels are returned.
+//
+// Only the displayed assets of each order are included, so the hidden portion of an iceberg order is not revealed.
+func BuildPriceLevels(orders []*Order, depth uint32) []PriceLevel {
+	if len(orders) == 0 {
+		return nil
+	}
+
+	sorted := make([]*Order, len(orders))
+	copy(sorted, orders)
+	isBid := sorted[0].IsBidOrder()
+	sort.SliceStable(sorted, func(i, j int) bool {
+		cmp := CompareUnitPrices(sorted[i], sorted[j])
+		return cmp != 0 && (cmp > 0) == isBid
+	})
+
+	var rv []PriceLevel
+	var last *Order
+	for _, order := range sorted {
+		if last != nil && CompareUnitPrices(order, last) == 0 {
+			level := &rv[len(rv)-1]
+			level.Assets = level.Assets.Add(order.GetDisplayedAssets())
+			level.OrderCount++
+			continue
+		}
+		if depth > 0 && len(rv) >= int(depth) {
+			break
+		}
+		rv = append(rv, *NewPriceLevel(order))
+		last = order
+	}
+	return rv
+}
@@ -0,0 +1,140 @@
+package exchange
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestNewPriceLevel(t *testing.T) {
+	order := NewOrder(3).WithBid(&BidOrder{
+		Assets:        sdk.NewInt64Coin("apple", 8),
+		Price:         sdk.NewInt64Coin("plum", 20),
+		DisplayAssets: &sdk.Coin{Denom: "apple", Amount: sdkmath.NewInt(3)},
+	})
+
+	var actual *PriceLevel
+	testFunc := func() {
+		actual = NewPriceLevel(order)
+	}
+	require.NotPanics(t, testFunc, "NewPriceLevel")
+	require.NotNil(t, actual, "NewPriceLevel result")
+	assert.Equal(t, "2.500000000000000000plum", actual.Price.String(), "Price")
+	assert.Equal(t, "3apple", actual.Assets.String(), "Assets")
+	assert.Equal(t, 1, int(actual.OrderCount), "OrderCount")
+}
+
+func TestBuildPriceLevels(t *testing.T) {
+	askOrder := func(orderID uint64, assets, price int64) *Order {
+		return NewOrder(orderID).WithAsk(&AskOrder{Assets: sdk.NewInt64Coin("apple", assets), Price: sdk.NewInt64Coin("plum", price)})
+	}
+	bidOrder := func(orderID uint64, assets, price int64) *Order {
+		return NewOrder(orderID).WithBid(&BidOrder{Assets: sdk.NewInt64Coin("apple", assets), Price: sdk.NewInt64Coin("plum", price)})
+	}
+	iceberg := func(order *Order, display int64) *Order {
+		coin := sdk.NewInt64Coin("apple", display)
+		if order.IsAskOrder() {
+			order.GetAskOrder().DisplayAssets = &coin
+		} else {
+			order.GetBidOrder().DisplayAssets = &coin
+		}
+		return order
+	}
+	levelStrings := func(levels []PriceLevel) []string {
+		if levels == nil {
+			return nil
+		}
+		rv := make([]string, len(levels))
+		for i, level := range levels {
+			rv[i] = fmt.Sprintf("%s: %s in %d", level.Price, level.Assets, level.OrderCount)
+		}
+		return rv
+	}
+
+	tests := []struct {
+		name   string
+		orders []*Order
+		depth  uint32
+		exp    []string
+	}{
+		{
+			name:   "nil orders",
+			orders: nil,
+			exp:    nil,
+		},
+		{
+			name:   "one ask",
+			orders: []*Order{askOrder(1, 10, 25)},
+			exp:    []string{"2.500000000000000000plum: 10apple in 1"},
+		},
+		{
+			name: "asks: lowest price first",
+			orders: []*Order{
+				askOrder(1, 10, 30), askOrder(2, 4, 8), askOrder(3, 5, 15),
+				askOrder(4, 6, 12), askOrder(5, 1, 3),
+			},
+			exp: []string{
+				"2.000000000000000000plum: 10apple in 2",
+				"3.000000000000000000plum: 16apple in 3",
+			},
+		},
+		{
+			name: "bids: highest price first",
+			orders: []*Order{
+				bidOrder(1, 10, 30), bidOrder(2, 4, 8), bidOrder(3, 5, 15),
+				bidOrder(4, 6, 12), bidOrder(5, 2, 7),
+			},
+			exp: []string{
+				"3.500000000000000000plum: 2apple in 1",
+				"3.000000000000000000plum: 15apple in 2",
+				"2.000000000000000000plum: 10apple in 2",
+			},
+		},
+		{
+			name: "depth limits levels",
+			orders: []*Order{
+				bidOrder(1, 10, 30), bidOrder(2, 4, 8), bidOrder(3, 5, 15),
+				bidOrder(4, 6, 12), bidOrder(5, 2, 7),
+			},
+			depth: 2,
+			exp: []string{
+				"3.500000000000000000plum: 2apple in 1",
+				"3.000000000000000000plum: 15apple in 2",
+			},
+		},
+		{
+			name:   "depth more than levels",
+			orders: []*Order{askOrder(1, 10, 30), askOrder(2, 4, 8)},
+			depth:  5,
+			exp: []string{
+				"2.000000000000000000plum: 4apple in 1",
+				"3.000000000000000000plum: 10apple in 1",
+			},
+		},
+		{
+			name:   "iceberg orders only include displayed assets",
+			orders: []*Order{iceberg(askOrder(1, 100, 300), 10), askOrder(2, 4, 12), iceberg(askOrder(3, 7, 7), 2)},
+			exp: []string{
+				"1.000000000000000000plum: 2apple in 1",
+				"3.000000000000000000plum: 14apple in 2",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual []PriceLevel
+			testFunc := func() {
+				actual = BuildPriceLevels(tc.orders, tc.depth)
+			}
+			require.NotPanics(t, testFunc, "BuildPriceLevels")
+			assert.Equal(t, tc.exp, levelStrings(actual), "BuildPriceLevels result")
+		})
+	}
+}
@@ -3,10 +3,14 @@ package exchange
 import (
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
 )
 
 // Define the type strings and bytes to use for each order type.
@@ -26,6 +30,17 @@ const (
 // to allow most of those while still limiting the length of keys that use these external ids.
 const MaxExternalIDLength = 100
 
+// MaxExpiredOrdersPerBlock is the maximum number of expired orders that are cancelled at the end of a block.
+// Any others are cancelled at the end of a later block.
+const MaxExpiredOrdersPerBlock = 1_000
+
+const (
+	// DefaultMarketBulkCancelLimit is the number of orders cancelled by a MarketBulkCancel when no limit is provided.
+	DefaultMarketBulkCancelLimit = uint32(100)
+	// MaxMarketBulkCancelLimit is the most orders that can be cancelled with a single MarketBulkCancel.
+	MaxMarketBulkCancelLimit = uint32(1_000)
+)
+
 // SubOrderI is an interface with getters for the fields in a sub-order (i.e. AskOrder or BidOrder).
 type SubOrderI interface {
 	GetMarketID() uint32
@@ -35,6 +50,10 @@ type SubOrderI interface {
 	GetSettlementFees() sdk.Coins
 	PartialFillAllowed() bool
 	GetExternalID() string
+	GetExpiration() *time.Time
+	GetDisplayAssets() *sdk.Coin
+	GetReferrer() string
+	GetBasketAssets() sdk.Coins
 	GetOrderType() string
 	GetOrderTypeByte() byte
 	GetHoldAmount() sdk.Coins
@@ -56,6 +75,22 @@ type OrderI interface {
 
 var _ OrderI = (*Order)(nil)
 
+// CompareUnitPrices returns -1 if order1's unit price is less than order2's, 0 if they're equal, or 1 if it's more.
+// Both orders are assumed to have the same assets and price denoms.
+func CompareUnitPrices(order1, order2 OrderI) int {
+	// price1 / assets1 <=> price2 / assets2  <=>  price1 * assets2 <=> price2 * assets1
+	side1 := order1.GetPrice().Amount.Mul(order2.GetAssets().Amount)
+	side2 := order2.GetPrice().Amount.Mul(order1.GetAssets().Amount)
+	switch {
+	case side1.LT(side2):
+		return -1
+	case side1.GT(side2):
+		return 1
+	default:
+		return 0
+	}
+}
+
 // findDuplicateIDs returns all order ids that appear two or more times in the provided slice.
 func findDuplicateIDs(orderIDs []uint64) []uint64 {
 	var rv []uint64
@@ -82,6 +117,28 @@ func validateCoin(field string, coin sdk.Coin) error {
 	return nil
 }
 
+// validateAssets makes sure the provided coin is valid for an order's assets.
+// A metadata denom is only allowed if it's for a scope, and then the amount must be one.
+func validateAssets(assets sdk.Coin) error {
+	if err := validateCoin("assets", assets); err != nil {
+		return err
+	}
+	if !strings.HasPrefix(assets.Denom, metadatatypes.DenomPrefix) {
+		return nil
+	}
+	scopeID, err := metadatatypes.MetadataAddressFromDenom(assets.Denom)
+	if err != nil {
+		return fmt.Errorf("invalid assets: %w", err)
+	}
+	if !scopeID.IsScopeAddress() {
+		return fmt.Errorf("invalid assets: denom %q is not for a scope", assets.Denom)
+	}
+	if !assets.Amount.Equal(sdkmath.OneInt()) {
+		return fmt.Errorf("invalid assets %q: scope amount must be 1", assets)
+	}
+	return nil
+}
+
 // ValidateOrderIDs makes sure that one or more order ids are provided,
 // none of them are zero, and there aren't any duplicates.
 func ValidateOrderIDs(field string, orderIDs []uint64) error {
@@ -107,6 +164,74 @@ func ValidateExternalID(externalID string) error {
 	return nil
 }
 
+// validateExpiration returns an error if the provided expiration is set but not after the unix epoch.
+// Whether it is in the future can only be checked against a block time, so that's done in the keeper.
+func validateExpiration(expiration *time.Time) error {
+	if expiration != nil && expiration.Unix() <= 0 {
+		return fmt.Errorf("invalid expiration %s: must be after %s",
+			expiration.UTC().Format(time.RFC3339), time.Unix(0, 0).UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+// validateDisplayAssets returns an error if the provided display assets are set but are not usable with the order.
+// The display assets are allowed to be more than the assets since that can happen once an iceberg order is partially filled.
+func validateDisplayAssets(displayAssets *sdk.Coin, assets sdk.Coin, allowPartial bool) error {
+	if displayAssets == nil {
+		return nil
+	}
+	if err := validateCoin("display assets", *displayAssets); err != nil {
+		return err
+	}
+	if displayAssets.Denom != assets.Denom {
+		return fmt.Errorf("invalid display assets %q: denom must be the same as the assets denom %q", displayAssets, assets.Denom)
+	}
+	if !allowPartial {
+		return fmt.Errorf("invalid display assets %q: not allowed unless partial fulfillment is allowed", displayAssets)
+	}
+	return nil
+}
+
+// validateBasketAssets returns an error if the provided basket assets are not valid for an order
+// with the given assets, price, and partial-fill flag.
+func validateBasketAssets(basketAssets sdk.Coins, assets, price sdk.Coin, allowPartial bool) error {
+	if len(basketAssets) == 0 {
+		return nil
+	}
+	if err := basketAssets.Validate(); err != nil {
+		return fmt.Errorf("invalid basket assets %q: %w", basketAssets, err)
+	}
+	for _, coin := range basketAssets {
+		if err := validateAssets(coin); err != nil {
+			return fmt.Errorf("invalid basket assets %q: %w", basketAssets, err)
+		}
+		if coin.Denom == assets.Denom {
+			return fmt.Errorf("invalid basket assets %q: cannot contain the assets denom %q", basketAssets, assets.Denom)
+		}
+		if coin.Denom == price.Denom {
+			return fmt.Errorf("invalid basket assets %q: cannot contain the price denom %q", basketAssets, price.Denom)
+		}
+	}
+	if allowPartial {
+		return fmt.Errorf("invalid basket assets %q: not allowed when partial fulfillment is allowed", basketAssets)
+	}
+	return nil
+}
+
+// validateReferrer returns an error if the provided referrer is set but is not a valid address or is the order's owner.
+func validateReferrer(referrer, owner string) error {
+	if len(referrer) == 0 {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(referrer); err != nil {
+		return fmt.Errorf("invalid referrer %q: %w", referrer, err)
+	}
+	if referrer == owner {
+		return fmt.Errorf("invalid referrer %q: cannot be the order's owner", referrer)
+	}
+	return nil
+}
+
 // NewOrder creates a new empty Order with the provided order id.
 // The order details are set using one of: WithAsk, WithBid.
 func NewOrder(orderID uint64) *Order {
@@ -204,6 +329,84 @@ func (o Order) GetExternalID() string {
 	return o.MustGetSubOrder().GetExternalID()
 }
 
+// GetExpiration returns this order's expiration (or nil if it doesn't have one).
+func (o Order) GetExpiration() *time.Time {
+	return o.MustGetSubOrder().GetExpiration()
+}
+
+// GetDisplayAssets returns this order's display assets (or nil if it isn't an iceberg order).
+func (o Order) GetDisplayAssets() *sdk.Coin {
+	return o.MustGetSubOrder().GetDisplayAssets()
+}
+
+// GetReferrer returns this order's referrer (or an empty string if it doesn't have one).
+func (o Order) GetReferrer() string {
+	return o.MustGetSubOrder().GetReferrer()
+}
+
+// GetBasketAssets returns this order's basket assets (or nil if it isn't a basket order).
+func (o Order) GetBasketAssets() sdk.Coins {
+	return o.MustGetSubOrder().GetBasketAssets()
+}
+
+// IsBasketOrder returns true if this order has basket assets.
+func (o Order) IsBasketOrder() bool {
+	return len(o.GetBasketAssets()) > 0
+}
+
+// GetDisplayedAssets returns the portion of this order's assets that are shown in queries.
+// For iceberg orders, that's the lesser of the display assets and the order's (remaining) assets.
+// For all other orders, it's all of the order's assets.
+func (o Order) GetDisplayedAssets() sdk.Coin {
+	assets := o.GetAssets()
+	displayAssets := o.GetDisplayAssets()
+	if displayAssets == nil || displayAssets.Amount.GTE(assets.Amount) {
+		return assets
+	}
+	return sdk.Coin{Denom: assets.Denom, Amount: displayAssets.Amount}
+}
+
+// GetHiddenAssets returns the portion of this order's assets that are held in reserve and not shown in queries.
+// As an iceberg order is filled, its displayed assets are replenished from these.
+func (o Order) GetHiddenAssets() sdk.Coin {
+	return o.GetAssets().Sub(o.GetDisplayedAssets())
+}
+
+// WithoutHiddenAssets returns a copy of this order that only has its displayed assets. The price and settlement
+// fees are reduced proportionally (rounding down). If none of this order's assets are hidden, this order is returned.
+func (o *Order) WithoutHiddenAssets() *Order {
+	hiddenAssets := o.GetHiddenAssets()
+	if hiddenAssets.IsZero() {
+		return o
+	}
+
+	orderAssetsAmt := o.GetAssets().Amount
+	displayed := o.GetDisplayedAssets()
+	prorate := func(coin sdk.Coin) sdk.Coin {
+		return sdk.Coin{Denom: coin.Denom, Amount: coin.Amount.Mul(displayed.Amount).Quo(orderAssetsAmt)}
+	}
+
+	price := prorate(o.GetPrice())
+	var fees sdk.Coins
+	for _, fee := range o.GetSettlementFees() {
+		fees = fees.Add(prorate(fee))
+	}
+
+	switch v := o.Order.(type) {
+	case *Order_AskOrder:
+		var fee *sdk.Coin
+		if !fees.IsZero() {
+			fee = &fees[0]
+		}
+		return NewOrder(o.OrderId).WithAsk(v.AskOrder.CopyChange(displayed, price, fee))
+	case *Order_BidOrder:
+		return NewOrder(o.OrderId).WithBid(v.BidOrder.CopyChange(displayed, price, fees))
+	default:
+		// This is here in case a new order type is added (that implements OrderI), but a case isn't added here.
+		panic(fmt.Errorf("cannot hide assets of %s order %d: unknown order type", o.GetOrderType(), o.OrderId))
+	}
+}
+
 // GetOrderType returns a string indicating what type this order is.
 // E.g: OrderTypeAsk or OrderTypeBid
 func (o Order) GetOrderType() string {
@@ -354,6 +557,26 @@ func (a AskOrder) GetExternalID() string {
 	return a.ExternalId
 }
 
+// GetExpiration returns this ask order's expiration (or nil if it doesn't have one).
+func (a AskOrder) GetExpiration() *time.Time {
+	return a.Expiration
+}
+
+// GetDisplayAssets returns this ask order's display assets (or nil if it isn't an iceberg order).
+func (a AskOrder) GetDisplayAssets() *sdk.Coin {
+	return a.DisplayAssets
+}
+
+// GetReferrer returns this ask order's referrer (or an empty string if it doesn't have one).
+func (a AskOrder) GetReferrer() string {
+	return a.Referrer
+}
+
+// GetBasketAssets returns this ask order's basket assets (or nil if it isn't a basket order).
+func (a AskOrder) GetBasketAssets() sdk.Coins {
+	return a.BasketAssets
+}
+
 // GetOrderType returns the order type string for this ask order: "ask".
 func (a AskOrder) GetOrderType() string {
 	return OrderTypeAsk
@@ -366,7 +589,7 @@ func (a AskOrder) GetOrderTypeByte() byte {
 
 // GetHoldAmount returns the amount that should be on hold for this ask order.
 func (a AskOrder) GetHoldAmount() sdk.Coins {
-	rv := sdk.Coins{a.Assets}
+	rv := sdk.Coins{a.Assets}.Add(a.BasketAssets...)
 	if a.SellerSettlementFlatFee != nil && a.SellerSettlementFlatFee.Denom != a.Price.Denom {
 		rv = rv.Add(*a.SellerSettlementFlatFee)
 	}
@@ -394,7 +617,7 @@ func (a AskOrder) Validate() error {
 		priceDenom = a.Price.Denom
 	}
 
-	if err := validateCoin("assets", a.Assets); err != nil {
+	if err := validateAssets(a.Assets); err != nil {
 		errs = append(errs, err)
 	} else if len(priceDenom) > 0 && a.Assets.Denom == priceDenom {
 		errs = append(errs, fmt.Errorf("invalid assets: price denom %s cannot also be the assets denom", priceDenom))
@@ -417,6 +640,22 @@ func (a AskOrder) Validate() error {
 		errs = append(errs, err)
 	}
 
+	if err := validateExpiration(a.Expiration); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := validateDisplayAssets(a.DisplayAssets, a.Assets, a.AllowPartial); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := validateReferrer(a.Referrer, a.Seller); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := validateBasketAssets(a.BasketAssets, a.Assets, a.Price, a.AllowPartial); err != nil {
+		errs = append(errs, err)
+	}
+
 	return errors.Join(errs...)
 }
 
@@ -430,6 +669,10 @@ func (a AskOrder) CopyChange(newAssets, newPrice sdk.Coin, newFee *sdk.Coin) *As
 		SellerSettlementFlatFee: newFee,
 		AllowPartial:            a.AllowPartial,
 		ExternalId:              a.ExternalId,
+		Expiration:              a.Expiration,
+		DisplayAssets:           a.DisplayAssets,
+		Referrer:                a.Referrer,
+		BasketAssets:            a.BasketAssets,
 	}
 }
 
@@ -468,6 +711,26 @@ func (b BidOrder) GetExternalID() string {
 	return b.ExternalId
 }
 
+// GetExpiration returns this bid order's expiration (or nil if it doesn't have one).
+func (b BidOrder) GetExpiration() *time.Time {
+	return b.Expiration
+}
+
+// GetDisplayAssets returns this bid order's display assets (or nil if it isn't an iceberg order).
+func (b BidOrder) GetDisplayAssets() *sdk.Coin {
+	return b.DisplayAssets
+}
+
+// GetReferrer returns this bid order's referrer (or an empty string if it doesn't have one).
+func (b BidOrder) GetReferrer() string {
+	return b.Referrer
+}
+
+// GetBasketAssets returns this bid order's basket assets (or nil if it isn't a basket order).
+func (b BidOrder) GetBasketAssets() sdk.Coins {
+	return b.BasketAssets
+}
+
 // GetOrderType returns the order type string for this bid order: "bid".
 func (b BidOrder) GetOrderType() string {
 	return OrderTypeBid
@@ -504,7 +767,7 @@ func (b BidOrder) Validate() error {
 		priceDenom = b.Price.Denom
 	}
 
-	if err := validateCoin("assets", b.Assets); err != nil {
+	if err := validateAssets(b.Assets); err != nil {
 		errs = append(errs, err)
 	} else if len(priceDenom) > 0 && b.Assets.Denom == priceDenom {
 		errs = append(errs, fmt.Errorf("invalid assets: price denom %s cannot also be the assets denom", priceDenom))
@@ -523,6 +786,22 @@ func (b BidOrder) Validate() error {
 		errs = append(errs, err)
 	}
 
+	if err := validateExpiration(b.Expiration); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := validateDisplayAssets(b.DisplayAssets, b.Assets, b.AllowPartial); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := validateReferrer(b.Referrer, b.Buyer); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := validateBasketAssets(b.BasketAssets, b.Assets, b.Price, b.AllowPartial); err != nil {
+		errs = append(errs, err)
+	}
+
 	return errors.Join(errs...)
 }
 
@@ -536,6 +815,10 @@ func (b BidOrder) CopyChange(newAssets, newPrice sdk.Coin, newFees sdk.Coins) *B
 		BuyerSettlementFees: newFees,
 		AllowPartial:        b.AllowPartial,
 		ExternalId:          b.ExternalId,
+		Expiration:          b.Expiration,
+		DisplayAssets:       b.DisplayAssets,
+		Referrer:            b.Referrer,
+		BasketAssets:        b.BasketAssets,
 	}
 }
 
@@ -623,6 +906,26 @@ func (o FilledOrder) GetExternalID() string {
 	return o.order.GetExternalID()
 }
 
+// GetExpiration returns this order's expiration.
+func (o FilledOrder) GetExpiration() *time.Time {
+	return o.order.GetExpiration()
+}
+
+// GetDisplayAssets returns this order's display assets.
+func (o FilledOrder) GetDisplayAssets() *sdk.Coin {
+	return o.order.GetDisplayAssets()
+}
+
+// GetReferrer returns this order's referrer.
+func (o FilledOrder) GetReferrer() string {
+	return o.order.GetReferrer()
+}
+
+// GetBasketAssets returns this order's basket assets.
+func (o FilledOrder) GetBasketAssets() sdk.Coins {
+	return o.order.GetBasketAssets()
+}
+
 // GetOrderType returns a string indicating what type this order is.
 // E.g: OrderTypeAsk or OrderTypeBid
 func (o FilledOrder) GetOrderType() string {
@@ -645,3 +948,97 @@ func (o FilledOrder) GetHoldAmount() sdk.Coins {
 func (o FilledOrder) Validate() error {
 	return nil
 }
+
+// NewTriggerOrder creates a new TriggerOrder for the provided order and trigger price.
+func NewTriggerOrder(order Order, triggerPrice sdk.Coin) *TriggerOrder {
+	return &TriggerOrder{Order: order, TriggerPrice: triggerPrice}
+}
+
+// GetOrderID gets the numerical identifier for this trigger order.
+func (t TriggerOrder) GetOrderID() uint64 {
+	return t.Order.GetOrderID()
+}
+
+// GetMarketID returns the market id for this trigger order.
+// Panics if the sub-order is not set or is something unexpected.
+func (t TriggerOrder) GetMarketID() uint32 {
+	return t.Order.GetMarketID()
+}
+
+// Validate returns an error if anything in this trigger order is invalid.
+func (t TriggerOrder) Validate() error {
+	if err := t.Order.Validate(); err != nil {
+		return err
+	}
+	return ValidateTriggerPrice(t.TriggerPrice, t.Order.GetPrice())
+}
+
+// WithoutHiddenAssets returns a copy of this trigger order that only has its order's displayed assets.
+// The trigger price is reduced proportionally too (rounding down) so that it's still for all of the order's assets.
+// If none of the order's assets are hidden, this trigger order is returned.
+func (t *TriggerOrder) WithoutHiddenAssets() *TriggerOrder {
+	if t.Order.GetHiddenAssets().IsZero() {
+		return t
+	}
+	order := t.Order.WithoutHiddenAssets()
+	triggerPriceAmt := t.TriggerPrice.Amount.Mul(order.GetAssets().Amount).Quo(t.Order.GetAssets().Amount)
+	return NewTriggerOrder(*order, sdk.Coin{Denom: t.TriggerPrice.Denom, Amount: triggerPriceAmt})
+}
+
+// ValidateTriggerPrice makes sure that a trigger price is positive and has the same denom as the order's price.
+func ValidateTriggerPrice(triggerPrice, orderPrice sdk.Coin) error {
+	if err := validateCoin("trigger price", triggerPrice); err != nil {
+		return err
+	}
+	if triggerPrice.Denom != orderPrice.Denom {
+		return fmt.Errorf("invalid trigger price %q: denom must match order price denom %q",
+			triggerPrice, orderPrice.Denom)
+	}
+	return nil
+}
+
+// IsTriggeredBy returns true if the provided net-asset-price should activate this trigger order.
+// An ask order is triggered when the nav price is at or below the trigger price (stop-loss).
+// A bid order is triggered when the nav price is at or above the trigger price (stop-buy).
+// A nav for a different asset or price denom never triggers an order.
+func (t TriggerOrder) IsTriggeredBy(nav NetAssetPrice) bool {
+	orderAssets := t.Order.GetAssets()
+	if nav.Assets.Denom != orderAssets.Denom || nav.Price.Denom != t.TriggerPrice.Denom {
+		return false
+	}
+	if !nav.Assets.Amount.IsPositive() || !orderAssets.Amount.IsPositive() {
+		return false
+	}
+	// Compare the per-asset prices without dividing:
+	// nav.Price / nav.Assets <?> trigger / order assets
+	// => nav.Price * order assets <?> trigger * nav.Assets
+	navVal := nav.Price.Amount.Mul(orderAssets.Amount)
+	triggerVal := t.TriggerPrice.Amount.Mul(nav.Assets.Amount)
+	if t.Order.IsAskOrder() {
+		return navVal.LTE(triggerVal)
+	}
+	return navVal.GTE(triggerVal)
+}
+
+// NewOrderLink creates a new OrderLink between the two provided orders in the given market.
+func NewOrderLink(marketID uint32, orderID, linkedOrderID uint64) *OrderLink {
+	return &OrderLink{MarketId: marketID, OrderId: orderID, LinkedOrderId: linkedOrderID}
+}
+
+// Validate returns an error if anything in this order link is invalid.
+func (l OrderLink) Validate() error {
+	var errs []error
+	if l.MarketId == 0 {
+		errs = append(errs, errors.New("invalid market id: cannot be zero"))
+	}
+	if l.OrderId == 0 {
+		errs = append(errs, errors.New("invalid order id: cannot be zero"))
+	}
+	if l.LinkedOrderId == 0 {
+		errs = append(errs, errors.New("invalid linked order id: cannot be zero"))
+	}
+	if l.OrderId != 0 && l.OrderId == l.LinkedOrderId {
+		errs = append(errs, fmt.Errorf("cannot link order %d to itself", l.OrderId))
+	}
+	return errors.Join(errs...)
+}
@@ -12,15 +12,19 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -141,6 +145,21 @@ type AskOrder struct {
 	// external_id is an optional string used to externally identify this order. Max length is 100 characters.
 	// If an order in this market with this external id already exists, this order will be rejected.
 	ExternalId string `protobuf:"bytes,7,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// expiration is an optional time after which this order is no longer valid. If provided, it must be after the
+	// current block time. Once a block time reaches it, the order is automatically cancelled and its hold released.
+	Expiration *time.Time `protobuf:"bytes,8,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+	// display_assets is an optional amount of the assets to show in order queries (i.e. an iceberg order). The rest of
+	// the assets are hidden from queries, and the displayed amount is replenished from them as the order is filled.
+	// If provided, it must have the same denom as the assets, and allow_partial must be true.
+	DisplayAssets *types.Coin `protobuf:"bytes,9,opt,name=display_assets,json=displayAssets,proto3" json:"display_assets,omitempty"`
+	// referrer is the optional bech32 address string of the account that referred this order to the market.
+	// During settlement, the referrer is paid part of the market's share of this order's settlement fees.
+	// It cannot be the same as the order's owner.
+	Referrer string `protobuf:"bytes,10,opt,name=referrer,proto3" json:"referrer,omitempty"`
+	// basket_assets are other assets being sold along with the assets (i.e. a basket order).
+	// They are always sold in full with the assets, so allow_partial must be false.
+	// A hold is placed on these until the order is filled or cancelled.
+	BasketAssets github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=basket_assets,json=basketAssets,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"basket_assets"`
 }
 
 func (m *AskOrder) Reset()         { *m = AskOrder{} }
@@ -196,6 +215,20 @@ type BidOrder struct {
 	// external_id is an optional string used to externally identify this order. Max length is 100 characters.
 	// If an order in this market with this external id already exists, this order will be rejected.
 	ExternalId string `protobuf:"bytes,7,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// expiration is an optional time after which this order is no longer valid. If provided, it must be after the
+	// current block time. Once a block time reaches it, the order is automatically cancelled and its hold released.
+	Expiration *time.Time `protobuf:"bytes,8,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+	// display_assets is an optional amount of the assets to show in order queries (i.e. an iceberg order). The rest of
+	// the assets are hidden from queries, and the displayed amount is replenished from them as the order is filled.
+	// If provided, it must have the same denom as the assets, and allow_partial must be true.
+	DisplayAssets *types.Coin `protobuf:"bytes,9,opt,name=display_assets,json=displayAssets,proto3" json:"display_assets,omitempty"`
+	// referrer is the optional bech32 address string of the account that referred this order to the market.
+	// During settlement, the referrer is paid part of the market's share of this order's settlement fees.
+	// It cannot be the same as the order's owner.
+	Referrer string `protobuf:"bytes,10,opt,name=referrer,proto3" json:"referrer,omitempty"`
+	// basket_assets are other assets being bought along with the assets (i.e. a basket order).
+	// They are always bought in full with the assets, so allow_partial must be false.
+	BasketAssets github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=basket_assets,json=basketAssets,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"basket_assets"`
 }
 
 func (m *BidOrder) Reset()         { *m = BidOrder{} }
@@ -231,428 +264,4702 @@ func (m *BidOrder) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BidOrder proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*Order)(nil), "provenance.exchange.v1.Order")
-	proto.RegisterType((*AskOrder)(nil), "provenance.exchange.v1.AskOrder")
-	proto.RegisterType((*BidOrder)(nil), "provenance.exchange.v1.BidOrder")
+// TriggerOrder is an ask or bid order that is not active until the price of its assets reaches a trigger price.
+// A triggered ask order is activated once the price is at or below the trigger price (i.e. a stop-loss order).
+// A triggered bid order is activated once the price is at or above the trigger price (i.e. a stop-buy order).
+// The price is checked against the net-asset-values that result from each settlement in the order's market.
+type TriggerOrder struct {
+	// order is the ask or bid order that becomes active once triggered. It keeps its order id when activated.
+	Order Order `protobuf:"bytes,1,opt,name=order,proto3" json:"order"`
+	// trigger_price is the price for the order's assets that will cause this order to be activated.
+	// It must have the same denom as the order's price.
+	TriggerPrice types.Coin `protobuf:"bytes,2,opt,name=trigger_price,json=triggerPrice,proto3" json:"trigger_price"`
 }
 
-func init() {
-	proto.RegisterFile("provenance/exchange/v1/orders.proto", fileDescriptor_dab7cbe63f582471)
+func (m *TriggerOrder) Reset()         { *m = TriggerOrder{} }
+func (m *TriggerOrder) String() string { return proto.CompactTextString(m) }
+func (*TriggerOrder) ProtoMessage()    {}
+func (*TriggerOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dab7cbe63f582471, []int{3}
 }
-
-var fileDescriptor_dab7cbe63f582471 = []byte{
-	// 606 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xb1, 0x6f, 0xd3, 0x4e,
-	0x14, 0xb6, 0xdb, 0x38, 0x75, 0xaf, 0xed, 0xef, 0x27, 0x4c, 0xa1, 0x4e, 0x91, 0x9c, 0xa8, 0x5d,
-	0xa2, 0x4a, 0xb5, 0x29, 0x08, 0x21, 0x75, 0x41, 0x0d, 0x52, 0x45, 0x27, 0x2a, 0x57, 0x62, 0x60,
-	0xb1, 0xce, 0xf6, 0xab, 0x7b, 0x8a, 0xed, 0x8b, 0x7c, 0xd7, 0xd2, 0xae, 0x4c, 0x8c, 0x2c, 0x2c,
-	0x4c, 0x8c, 0x88, 0x29, 0x12, 0xf0, 0x3f, 0x74, 0xac, 0x98, 0x98, 0x00, 0x25, 0x43, 0xfe, 0x05,
-	0x46, 0xe4, 0xbb, 0x73, 0x1a, 0x24, 0x08, 0x1d, 0x10, 0x8b, 0x7d, 0xef, 0xbd, 0xef, 0x7d, 0xef,
-	0xdd, 0xf7, 0xf4, 0x0e, 0xad, 0xf7, 0x0a, 0x7a, 0x02, 0x39, 0xce, 0x23, 0xf0, 0xe0, 0x34, 0x3a,
-	0xc2, 0x79, 0x02, 0xde, 0xc9, 0x96, 0x47, 0x8b, 0x18, 0x0a, 0xe6, 0xf6, 0x0a, 0xca, 0xa9, 0x75,
-	0xf3, 0x12, 0xe4, 0x56, 0x20, 0xf7, 0x64, 0x6b, 0xf5, 0x1a, 0xce, 0x48, 0x4e, 0x3d, 0xf1, 0x95,
-	0xd0, 0x55, 0x27, 0xa2, 0x2c, 0xa3, 0xcc, 0x0b, 0x31, 0x2b, 0x79, 0x42, 0xe0, 0x78, 0xcb, 0x8b,
-	0x28, 0xc9, 0x55, 0x7c, 0x45, 0xc5, 0x33, 0x96, 0x94, 0x65, 0x32, 0x96, 0xa8, 0x40, 0x43, 0x06,
-	0x02, 0x61, 0x79, 0xd2, 0x50, 0xa1, 0xe5, 0x84, 0x26, 0x54, 0xfa, 0xcb, 0x93, 0xf4, 0xae, 0xbd,
-	0xd7, 0x91, 0xf1, 0xb8, 0xec, 0xd2, 0x6a, 0x20, 0x53, 0xb4, 0x1b, 0x90, 0xd8, 0xd6, 0x5b, 0x7a,
-	0xbb, 0xe6, 0xcf, 0x09, 0x7b, 0x2f, 0xb6, 0x1e, 0xa0, 0x79, 0xcc, 0xba, 0x81, 0x30, 0xed, 0x99,
-	0x96, 0xde, 0x5e, 0xb8, 0xd3, 0x72, 0x7f, 0x7d, 0x1b, 0x77, 0x87, 0x75, 0x05, 0xdf, 0x23, 0xcd,
-	0x37, 0xb1, 0x3a, 0x97, 0x04, 0x21, 0x89, 0x15, 0xc1, 0xec, 0x74, 0x82, 0x0e, 0x89, 0xc7, 0x04,
-	0xa1, 0x3a, 0x6f, 0xd7, 0x5e, 0xbc, 0x69, 0x6a, 0x9d, 0x39, 0x64, 0x08, 0x8a, 0xb5, 0xef, 0x33,
-	0xc8, 0xac, 0x0a, 0x59, 0xb7, 0xd0, 0x7c, 0x86, 0x8b, 0x2e, 0xf0, 0xaa, 0xf3, 0x25, 0xdf, 0x94,
-	0x8e, 0xbd, 0xd8, 0xba, 0x8d, 0xea, 0x0c, 0xd2, 0x54, 0xf5, 0x3d, 0xdf, 0xb1, 0x3f, 0x7d, 0xd8,
-	0x5c, 0x56, 0xba, 0xec, 0xc4, 0x71, 0x01, 0x8c, 0x1d, 0xf0, 0x82, 0xe4, 0x89, 0xaf, 0x70, 0xd6,
-	0x7d, 0x54, 0xc7, 0x8c, 0x01, 0x67, 0xaa, 0xd1, 0x86, 0xab, 0xe0, 0xe5, 0x30, 0x5c, 0x35, 0x0c,
-	0xf7, 0x21, 0x25, 0x79, 0xa7, 0x76, 0xfe, 0xa5, 0xa9, 0xf9, 0x0a, 0x6e, 0xdd, 0x43, 0x46, 0xaf,
-	0x20, 0x11, 0xd8, 0xb5, 0xab, 0xe5, 0x49, 0xb4, 0xf5, 0x04, 0xad, 0xca, 0xca, 0x01, 0x03, 0xce,
-	0x53, 0xc8, 0x20, 0xe7, 0xc1, 0x61, 0x8a, 0x79, 0x70, 0x08, 0x60, 0x1b, 0x7f, 0xe0, 0xf2, 0x57,
-	0x64, 0xf2, 0xc1, 0x38, 0x77, 0x37, 0xc5, 0x7c, 0x17, 0xc0, 0x5a, 0x47, 0x4b, 0x38, 0x4d, 0xe9,
-	0xb3, 0xa0, 0x87, 0x0b, 0x4e, 0x70, 0x6a, 0xd7, 0x5b, 0x7a, 0xdb, 0xf4, 0x17, 0x85, 0x73, 0x5f,
-	0xfa, 0xac, 0x26, 0x5a, 0x80, 0x53, 0x0e, 0x45, 0x8e, 0xd3, 0x52, 0xbd, 0xb9, 0x52, 0x23, 0x1f,
-	0x55, 0xae, 0xbd, 0x78, 0xfb, 0xff, 0x52, 0xf8, 0xe7, 0xa3, 0xfe, 0x86, 0x92, 0x67, 0xed, 0xe3,
-	0x2c, 0x32, 0xab, 0x11, 0x4d, 0x97, 0xde, 0x45, 0x46, 0x78, 0x7c, 0x76, 0x05, 0xe5, 0x25, 0xec,
-	0x9f, 0x0b, 0xff, 0x4a, 0x47, 0x37, 0x44, 0xe5, 0x9f, 0x84, 0x07, 0x60, 0xb6, 0xd1, 0x9a, 0x9d,
-	0xce, 0xb3, 0x5b, 0xf2, 0xbc, 0xfb, 0xda, 0x6c, 0x27, 0x84, 0x1f, 0x1d, 0x87, 0x6e, 0x44, 0x33,
-	0xb5, 0x6c, 0xea, 0xb7, 0xc9, 0xe2, 0xae, 0xc7, 0xcf, 0x7a, 0xc0, 0x44, 0x02, 0x7b, 0x3d, 0xea,
-	0x6f, 0x2c, 0xa6, 0x90, 0xe0, 0xe8, 0x2c, 0x28, 0xf7, 0x98, 0xbd, 0x1d, 0xf5, 0x37, 0x74, 0xff,
-	0xba, 0xa8, 0x3f, 0x31, 0x3b, 0x00, 0xf6, 0x97, 0x06, 0xf7, 0x5f, 0x35, 0x38, 0xa9, 0x6e, 0x07,
-	0xce, 0x07, 0x8e, 0x7e, 0x31, 0x70, 0xf4, 0x6f, 0x03, 0x47, 0x7f, 0x39, 0x74, 0xb4, 0x8b, 0xa1,
-	0xa3, 0x7d, 0x1e, 0x3a, 0x1a, 0x6a, 0x10, 0xfa, 0x9b, 0x5d, 0xdc, 0xd7, 0x9f, 0xba, 0x13, 0x37,
-	0xbc, 0x04, 0x6d, 0x12, 0x3a, 0x61, 0x79, 0xa7, 0xe3, 0x47, 0x2f, 0xac, 0x8b, 0x67, 0xe5, 0xee,
-	0x8f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x71, 0x15, 0x76, 0x98, 0x12, 0x05, 0x00, 0x00,
+func (m *TriggerOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Order) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TriggerOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TriggerOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *TriggerOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerOrder.Merge(m, src)
+}
+func (m *TriggerOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *TriggerOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerOrder.DiscardUnknown(m)
 }
 
-func (m *Order) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_TriggerOrder proto.InternalMessageInfo
+
+// OrderLink is a one-cancels-other link between two orders in the same market.
+// When either order is filled (in full or in part), cancelled, or expires, the other order is cancelled.
+type OrderLink struct {
+	// market_id is the numerical identifier of the market that both orders are in.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// order_id is the id of one of the linked orders.
+	OrderId uint64 `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// linked_order_id is the id of the other linked order.
+	LinkedOrderId uint64 `protobuf:"varint,3,opt,name=linked_order_id,json=linkedOrderId,proto3" json:"linked_order_id,omitempty"`
 }
 
-func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Order != nil {
-		{
-			size := m.Order.Size()
-			i -= size
-			if _, err := m.Order.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+func (m *OrderLink) Reset()         { *m = OrderLink{} }
+func (m *OrderLink) String() string { return proto.CompactTextString(m) }
+func (*OrderLink) ProtoMessage()    {}
+func (*OrderLink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dab7cbe63f582471, []int{4}
+}
+func (m *OrderLink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderLink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderLink.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.OrderId != 0 {
-		i = encodeVarintOrders(dAtA, i, uint64(m.OrderId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *OrderLink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderLink.Merge(m, src)
+}
+func (m *OrderLink) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderLink) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderLink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderLink proto.InternalMessageInfo
+
+func (m *OrderLink) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Order_AskOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *OrderLink) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
+	}
+	return 0
 }
 
-func (m *Order_AskOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.AskOrder != nil {
-		{
-			size, err := m.AskOrder.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintOrders(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *OrderLink) GetLinkedOrderId() uint64 {
+	if m != nil {
+		return m.LinkedOrderId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
-func (m *Order_BidOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+// PriceLevel is the total of the orders on one side of an order book that have the same unit price.
+type PriceLevel struct {
+	// price is the price of one of the assets (i.e. the unit price) of every order at this level.
+	Price types.DecCoin `protobuf:"bytes,1,opt,name=price,proto3" json:"price"`
+	// assets is the total amount of assets in the orders at this level.
+	Assets types.Coin `protobuf:"bytes,2,opt,name=assets,proto3" json:"assets"`
+	// order_count is the number of orders at this level.
+	OrderCount uint32 `protobuf:"varint,3,opt,name=order_count,json=orderCount,proto3" json:"order_count,omitempty"`
 }
 
-func (m *Order_BidOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.BidOrder != nil {
-		{
-			size, err := m.BidOrder.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintOrders(dAtA, i, uint64(size))
+func (m *PriceLevel) Reset()         { *m = PriceLevel{} }
+func (m *PriceLevel) String() string { return proto.CompactTextString(m) }
+func (*PriceLevel) ProtoMessage()    {}
+func (*PriceLevel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dab7cbe63f582471, []int{5}
+}
+func (m *PriceLevel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceLevel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceLevel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *AskOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *PriceLevel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceLevel.Merge(m, src)
 }
-
-func (m *AskOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PriceLevel) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceLevel) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceLevel.DiscardUnknown(m)
 }
 
-func (m *AskOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExternalId) > 0 {
-		i -= len(m.ExternalId)
-		copy(dAtA[i:], m.ExternalId)
-		i = encodeVarintOrders(dAtA, i, uint64(len(m.ExternalId)))
-		i--
-		dAtA[i] = 0x3a
+var xxx_messageInfo_PriceLevel proto.InternalMessageInfo
+
+func (m *PriceLevel) GetPrice() types.DecCoin {
+	if m != nil {
+		return m.Price
 	}
-	if m.AllowPartial {
-		i--
-		if m.AllowPartial {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
+	return types.DecCoin{}
+}
+
+func (m *PriceLevel) GetAssets() types.Coin {
+	if m != nil {
+		return m.Assets
 	}
-	if m.SellerSettlementFlatFee != nil {
-		{
-			size, err := m.SellerSettlementFlatFee.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintOrders(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+	return types.Coin{}
+}
+
+func (m *PriceLevel) GetOrderCount() uint32 {
+	if m != nil {
+		return m.OrderCount
 	}
-	{
-		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+	return 0
+}
+
+// MarketOrdersSummary is the total of an account's orders in a single market.
+// Only the displayed portion of an iceberg order is included.
+type MarketOrdersSummary struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// ask_order_count is the number of ask orders the account has in the market.
+	AskOrderCount uint32 `protobuf:"varint,2,opt,name=ask_order_count,json=askOrderCount,proto3" json:"ask_order_count,omitempty"`
+	// ask_assets is the total assets of the account's ask orders in the market.
+	AskAssets github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=ask_assets,json=askAssets,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"ask_assets"`
+	// ask_price is the total price of the account's ask orders in the market.
+	AskPrice github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=ask_price,json=askPrice,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"ask_price"`
+	// bid_order_count is the number of bid orders the account has in the market.
+	BidOrderCount uint32 `protobuf:"varint,5,opt,name=bid_order_count,json=bidOrderCount,proto3" json:"bid_order_count,omitempty"`
+	// bid_assets is the total assets of the account's bid orders in the market.
+	BidAssets github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=bid_assets,json=bidAssets,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"bid_assets"`
+	// bid_price is the total price of the account's bid orders in the market.
+	BidPrice github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=bid_price,json=bidPrice,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"bid_price"`
+}
+
+func (m *MarketOrdersSummary) Reset()         { *m = MarketOrdersSummary{} }
+func (m *MarketOrdersSummary) String() string { return proto.CompactTextString(m) }
+func (*MarketOrdersSummary) ProtoMessage()    {}
+func (*MarketOrdersSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dab7cbe63f582471, []int{6}
+}
+func (m *MarketOrdersSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketOrdersSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketOrdersSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintOrders(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.Assets.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintOrders(dAtA, i, uint64(size))
+}
+func (m *MarketOrdersSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketOrdersSummary.Merge(m, src)
+}
+func (m *MarketOrdersSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketOrdersSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketOrdersSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketOrdersSummary proto.InternalMessageInfo
+
+func (m *MarketOrdersSummary) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Seller) > 0 {
-		i -= len(m.Seller)
-		copy(dAtA[i:], m.Seller)
-		i = encodeVarintOrders(dAtA, i, uint64(len(m.Seller)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *MarketOrdersSummary) GetAskOrderCount() uint32 {
+	if m != nil {
+		return m.AskOrderCount
 	}
-	if m.MarketId != 0 {
-		i = encodeVarintOrders(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *MarketOrdersSummary) GetAskAssets() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AskAssets
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *BidOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MarketOrdersSummary) GetAskPrice() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AskPrice
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *BidOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MarketOrdersSummary) GetBidOrderCount() uint32 {
+	if m != nil {
+		return m.BidOrderCount
+	}
+	return 0
 }
 
-func (m *BidOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExternalId) > 0 {
-		i -= len(m.ExternalId)
-		copy(dAtA[i:], m.ExternalId)
-		i = encodeVarintOrders(dAtA, i, uint64(len(m.ExternalId)))
-		i--
-		dAtA[i] = 0x3a
+func (m *MarketOrdersSummary) GetBidAssets() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BidAssets
 	}
-	if m.AllowPartial {
-		i--
-		if m.AllowPartial {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.BuyerSettlementFees) > 0 {
-		for iNdEx := len(m.BuyerSettlementFees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BuyerSettlementFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOrders(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
+	return nil
+}
+
+func (m *MarketOrdersSummary) GetBidPrice() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BidPrice
 	}
-	{
-		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+	return nil
+}
+
+// SettlementPrice is the total assets and price of the orders settled in a market for one
+// asset and price denom pair during a single second.
+type SettlementPrice struct {
+	// market_id is the numerical identifier of the market where the orders were settled.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// time is the block time of the settlement(s). Only the seconds are used.
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// assets is the total amount of assets that were settled.
+	Assets types.Coin `protobuf:"bytes,3,opt,name=assets,proto3" json:"assets"`
+	// price is the total price paid for those assets.
+	Price types.Coin `protobuf:"bytes,4,opt,name=price,proto3" json:"price"`
+}
+
+func (m *SettlementPrice) Reset()         { *m = SettlementPrice{} }
+func (m *SettlementPrice) String() string { return proto.CompactTextString(m) }
+func (*SettlementPrice) ProtoMessage()    {}
+func (*SettlementPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dab7cbe63f582471, []int{7}
+}
+func (m *SettlementPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SettlementPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SettlementPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintOrders(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.Assets.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *SettlementPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettlementPrice.Merge(m, src)
+}
+func (m *SettlementPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *SettlementPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettlementPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettlementPrice proto.InternalMessageInfo
+
+func (m *SettlementPrice) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *SettlementPrice) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *SettlementPrice) GetAssets() types.Coin {
+	if m != nil {
+		return m.Assets
+	}
+	return types.Coin{}
+}
+
+func (m *SettlementPrice) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
+}
+
+// SettlementRecord is a record of a settlement of orders in a market.
+type SettlementRecord struct {
+	// market_id is the numerical identifier of the market where the orders were settled.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// height is the block height of the settlement.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// sequence is the order of this settlement among the ones in the same market and block, starting at zero.
+	Sequence uint32 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// time is the block time of the settlement.
+	Time time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+	// fills are the orders involved in the settlement, and what was filled for each.
+	Fills []SettlementFill `protobuf:"bytes,5,rep,name=fills,proto3" json:"fills"`
+}
+
+func (m *SettlementRecord) Reset()         { *m = SettlementRecord{} }
+func (m *SettlementRecord) String() string { return proto.CompactTextString(m) }
+func (*SettlementRecord) ProtoMessage()    {}
+func (*SettlementRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dab7cbe63f582471, []int{8}
+}
+func (m *SettlementRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SettlementRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SettlementRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintOrders(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Buyer) > 0 {
-		i -= len(m.Buyer)
-		copy(dAtA[i:], m.Buyer)
-		i = encodeVarintOrders(dAtA, i, uint64(len(m.Buyer)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *SettlementRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettlementRecord.Merge(m, src)
+}
+func (m *SettlementRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SettlementRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettlementRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettlementRecord proto.InternalMessageInfo
+
+func (m *SettlementRecord) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	if m.MarketId != 0 {
-		i = encodeVarintOrders(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *SettlementRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintOrders(dAtA []byte, offset int, v uint64) int {
-	offset -= sovOrders(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SettlementRecord) GetSequence() uint32 {
+	if m != nil {
+		return m.Sequence
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *Order) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *SettlementRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	var l int
-	_ = l
-	if m.OrderId != 0 {
-		n += 1 + sovOrders(uint64(m.OrderId))
+	return time.Time{}
+}
+
+func (m *SettlementRecord) GetFills() []SettlementFill {
+	if m != nil {
+		return m.Fills
 	}
-	if m.Order != nil {
-		n += m.Order.Size()
+	return nil
+}
+
+// SettlementFill is what was filled for a single order in a settlement.
+type SettlementFill struct {
+	// order_id is the numerical identifier of the order.
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// order_type is the type of the order, either "ask" or "bid".
+	OrderType string `protobuf:"bytes,2,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
+	// owner is the bech32 address string of the seller (for ask orders) or buyer (for bid orders).
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	// assets are the assets that were filled.
+	Assets types.Coin `protobuf:"bytes,4,opt,name=assets,proto3" json:"assets"`
+	// price is the price paid or received for those assets.
+	Price types.Coin `protobuf:"bytes,5,opt,name=price,proto3" json:"price"`
+	// fees are the settlement fees paid with this order.
+	Fees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+	// partial is whether the order was only partially filled.
+	Partial bool `protobuf:"varint,7,opt,name=partial,proto3" json:"partial,omitempty"`
+	// external_id is the order's external id.
+	ExternalId string `protobuf:"bytes,8,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+}
+
+func (m *SettlementFill) Reset()         { *m = SettlementFill{} }
+func (m *SettlementFill) String() string { return proto.CompactTextString(m) }
+func (*SettlementFill) ProtoMessage()    {}
+func (*SettlementFill) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dab7cbe63f582471, []int{9}
+}
+func (m *SettlementFill) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SettlementFill) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SettlementFill.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *SettlementFill) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettlementFill.Merge(m, src)
+}
+func (m *SettlementFill) XXX_Size() int {
+	return m.Size()
+}
+func (m *SettlementFill) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettlementFill.DiscardUnknown(m)
 }
 
-func (m *Order_AskOrder) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_SettlementFill proto.InternalMessageInfo
+
+func (m *SettlementFill) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
 	}
-	var l int
-	_ = l
-	if m.AskOrder != nil {
-		l = m.AskOrder.Size()
-		n += 1 + l + sovOrders(uint64(l))
+	return 0
+}
+
+func (m *SettlementFill) GetOrderType() string {
+	if m != nil {
+		return m.OrderType
 	}
-	return n
+	return ""
 }
-func (m *Order_BidOrder) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *SettlementFill) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	var l int
-	_ = l
-	if m.BidOrder != nil {
-		l = m.BidOrder.Size()
-		n += 1 + l + sovOrders(uint64(l))
+	return ""
+}
+
+func (m *SettlementFill) GetAssets() types.Coin {
+	if m != nil {
+		return m.Assets
 	}
-	return n
+	return types.Coin{}
 }
-func (m *AskOrder) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *SettlementFill) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
 	}
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovOrders(uint64(m.MarketId))
+	return types.Coin{}
+}
+
+func (m *SettlementFill) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fees
 	}
-	l = len(m.Seller)
-	if l > 0 {
-		n += 1 + l + sovOrders(uint64(l))
+	return nil
+}
+
+func (m *SettlementFill) GetPartial() bool {
+	if m != nil {
+		return m.Partial
 	}
-	l = m.Assets.Size()
-	n += 1 + l + sovOrders(uint64(l))
-	l = m.Price.Size()
-	n += 1 + l + sovOrders(uint64(l))
-	if m.SellerSettlementFlatFee != nil {
-		l = m.SellerSettlementFlatFee.Size()
-		n += 1 + l + sovOrders(uint64(l))
+	return false
+}
+
+func (m *SettlementFill) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
 	}
-	if m.AllowPartial {
-		n += 2
+	return ""
+}
+
+// SettlementReceipt is a record of everything involved in a single settlement, identified by a unique settlement id.
+type SettlementReceipt struct {
+	// settlement_id is the unique numerical identifier of the settlement.
+	SettlementId uint64 `protobuf:"varint,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	// market_id is the numerical identifier of the market where the orders were settled.
+	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// height is the block height of the settlement.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time of the settlement.
+	Time time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+	// fills are the orders involved in the settlement (with their owners), and what was filled for each.
+	Fills []SettlementFill `protobuf:"bytes,5,rep,name=fills,proto3" json:"fills"`
+	// transfers are the asset and price transfers that were made.
+	Transfers []SettlementTransfer `protobuf:"bytes,6,rep,name=transfers,proto3" json:"transfers"`
+	// fee_inputs are the settlement fees that were collected, by account.
+	FeeInputs []AccountAmount `protobuf:"bytes,7,rep,name=fee_inputs,json=feeInputs,proto3" json:"fee_inputs"`
+	// navs are the net asset prices of the settlement.
+	Navs []NetAssetPrice `protobuf:"bytes,8,rep,name=navs,proto3" json:"navs"`
+}
+
+func (m *SettlementReceipt) Reset()         { *m = SettlementReceipt{} }
+func (m *SettlementReceipt) String() string { return proto.CompactTextString(m) }
+func (*SettlementReceipt) ProtoMessage()    {}
+func (*SettlementReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dab7cbe63f582471, []int{10}
+}
+func (m *SettlementReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SettlementReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SettlementReceipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.ExternalId)
-	if l > 0 {
-		n += 1 + l + sovOrders(uint64(l))
+}
+func (m *SettlementReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettlementReceipt.Merge(m, src)
+}
+func (m *SettlementReceipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *SettlementReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettlementReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettlementReceipt proto.InternalMessageInfo
+
+func (m *SettlementReceipt) GetSettlementId() uint64 {
+	if m != nil {
+		return m.SettlementId
 	}
-	return n
+	return 0
 }
 
-func (m *BidOrder) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SettlementReceipt) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovOrders(uint64(m.MarketId))
+	return 0
+}
+
+func (m *SettlementReceipt) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	l = len(m.Buyer)
-	if l > 0 {
-		n += 1 + l + sovOrders(uint64(l))
+	return 0
+}
+
+func (m *SettlementReceipt) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *SettlementReceipt) GetFills() []SettlementFill {
+	if m != nil {
+		return m.Fills
+	}
+	return nil
+}
+
+func (m *SettlementReceipt) GetTransfers() []SettlementTransfer {
+	if m != nil {
+		return m.Transfers
+	}
+	return nil
+}
+
+func (m *SettlementReceipt) GetFeeInputs() []AccountAmount {
+	if m != nil {
+		return m.FeeInputs
+	}
+	return nil
+}
+
+func (m *SettlementReceipt) GetNavs() []NetAssetPrice {
+	if m != nil {
+		return m.Navs
+	}
+	return nil
+}
+
+// SettlementTransfer is a set of funds that move from some accounts to others in a settlement.
+type SettlementTransfer struct {
+	// inputs are the accounts (and amounts) the funds come from.
+	Inputs []AccountAmount `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs"`
+	// outputs are the accounts (and amounts) the funds go to.
+	Outputs []AccountAmount `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs"`
+}
+
+func (m *SettlementTransfer) Reset()         { *m = SettlementTransfer{} }
+func (m *SettlementTransfer) String() string { return proto.CompactTextString(m) }
+func (*SettlementTransfer) ProtoMessage()    {}
+func (*SettlementTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dab7cbe63f582471, []int{11}
+}
+func (m *SettlementTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SettlementTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SettlementTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SettlementTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettlementTransfer.Merge(m, src)
+}
+func (m *SettlementTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *SettlementTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettlementTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettlementTransfer proto.InternalMessageInfo
+
+func (m *SettlementTransfer) GetInputs() []AccountAmount {
+	if m != nil {
+		return m.Inputs
+	}
+	return nil
+}
+
+func (m *SettlementTransfer) GetOutputs() []AccountAmount {
+	if m != nil {
+		return m.Outputs
+	}
+	return nil
+}
+
+// NAVRecord is a net asset value recorded from a settlement, kept as part of the NAV history of an
+// asset and price denom pair.
+type NAVRecord struct {
+	// assets is the volume of assets that were settled.
+	Assets types.Coin `protobuf:"bytes,1,opt,name=assets,proto3" json:"assets"`
+	// price is the total price paid for those assets.
+	Price types.Coin `protobuf:"bytes,2,opt,name=price,proto3" json:"price"`
+	// height is the block height of the settlement.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// market_id is the numerical identifier of the market where the settlement took place.
+	MarketId uint32 `protobuf:"varint,4,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+}
+
+func (m *NAVRecord) Reset()         { *m = NAVRecord{} }
+func (m *NAVRecord) String() string { return proto.CompactTextString(m) }
+func (*NAVRecord) ProtoMessage()    {}
+func (*NAVRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dab7cbe63f582471, []int{12}
+}
+func (m *NAVRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NAVRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NAVRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NAVRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NAVRecord.Merge(m, src)
+}
+func (m *NAVRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *NAVRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_NAVRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NAVRecord proto.InternalMessageInfo
+
+func (m *NAVRecord) GetAssets() types.Coin {
+	if m != nil {
+		return m.Assets
+	}
+	return types.Coin{}
+}
+
+func (m *NAVRecord) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
+}
+
+func (m *NAVRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *NAVRecord) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+// IdempotencyKeyRecord is a record of an order that was created using an idempotency key.
+type IdempotencyKeyRecord struct {
+	// account is the bech32 address string of the account that created the order.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// idempotency_key is the client-supplied key that was provided with the order.
+	IdempotencyKey string `protobuf:"bytes,2,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// order_id is the numerical identifier of the order that was created.
+	OrderId uint64 `protobuf:"varint,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// height is the block height at which the order was created.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *IdempotencyKeyRecord) Reset()         { *m = IdempotencyKeyRecord{} }
+func (m *IdempotencyKeyRecord) String() string { return proto.CompactTextString(m) }
+func (*IdempotencyKeyRecord) ProtoMessage()    {}
+func (*IdempotencyKeyRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dab7cbe63f582471, []int{13}
+}
+func (m *IdempotencyKeyRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IdempotencyKeyRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IdempotencyKeyRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IdempotencyKeyRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdempotencyKeyRecord.Merge(m, src)
+}
+func (m *IdempotencyKeyRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *IdempotencyKeyRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdempotencyKeyRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IdempotencyKeyRecord proto.InternalMessageInfo
+
+func (m *IdempotencyKeyRecord) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *IdempotencyKeyRecord) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return ""
+}
+
+func (m *IdempotencyKeyRecord) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
+	}
+	return 0
+}
+
+func (m *IdempotencyKeyRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// DeadManSwitch is an account's registration to have all of its orders cancelled if it stops sending heartbeats.
+type DeadManSwitch struct {
+	// account is the bech32 address string of the account whose orders are cancelled if a heartbeat is missed.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// interval_seconds is the maximum number of seconds allowed between heartbeats.
+	IntervalSeconds uint64 `protobuf:"varint,2,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	// deadline is the time at (or after) which the account's orders are cancelled if another heartbeat hasn't been sent.
+	Deadline time.Time `protobuf:"bytes,3,opt,name=deadline,proto3,stdtime" json:"deadline"`
+}
+
+func (m *DeadManSwitch) Reset()         { *m = DeadManSwitch{} }
+func (m *DeadManSwitch) String() string { return proto.CompactTextString(m) }
+func (*DeadManSwitch) ProtoMessage()    {}
+func (*DeadManSwitch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dab7cbe63f582471, []int{14}
+}
+func (m *DeadManSwitch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeadManSwitch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeadManSwitch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeadManSwitch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeadManSwitch.Merge(m, src)
+}
+func (m *DeadManSwitch) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeadManSwitch) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeadManSwitch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeadManSwitch proto.InternalMessageInfo
+
+func (m *DeadManSwitch) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *DeadManSwitch) GetIntervalSeconds() uint64 {
+	if m != nil {
+		return m.IntervalSeconds
+	}
+	return 0
+}
+
+func (m *DeadManSwitch) GetDeadline() time.Time {
+	if m != nil {
+		return m.Deadline
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*Order)(nil), "provenance.exchange.v1.Order")
+	proto.RegisterType((*AskOrder)(nil), "provenance.exchange.v1.AskOrder")
+	proto.RegisterType((*BidOrder)(nil), "provenance.exchange.v1.BidOrder")
+	proto.RegisterType((*TriggerOrder)(nil), "provenance.exchange.v1.TriggerOrder")
+	proto.RegisterType((*OrderLink)(nil), "provenance.exchange.v1.OrderLink")
+	proto.RegisterType((*PriceLevel)(nil), "provenance.exchange.v1.PriceLevel")
+	proto.RegisterType((*MarketOrdersSummary)(nil), "provenance.exchange.v1.MarketOrdersSummary")
+	proto.RegisterType((*SettlementPrice)(nil), "provenance.exchange.v1.SettlementPrice")
+	proto.RegisterType((*SettlementRecord)(nil), "provenance.exchange.v1.SettlementRecord")
+	proto.RegisterType((*SettlementFill)(nil), "provenance.exchange.v1.SettlementFill")
+	proto.RegisterType((*SettlementReceipt)(nil), "provenance.exchange.v1.SettlementReceipt")
+	proto.RegisterType((*SettlementTransfer)(nil), "provenance.exchange.v1.SettlementTransfer")
+	proto.RegisterType((*NAVRecord)(nil), "provenance.exchange.v1.NAVRecord")
+	proto.RegisterType((*IdempotencyKeyRecord)(nil), "provenance.exchange.v1.IdempotencyKeyRecord")
+	proto.RegisterType((*DeadManSwitch)(nil), "provenance.exchange.v1.DeadManSwitch")
+}
+
+func init() {
+	proto.RegisterFile("provenance/exchange/v1/orders.proto", fileDescriptor_dab7cbe63f582471)
+}
+
+var fileDescriptor_dab7cbe63f582471 = []byte{
+	// 1424 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xbd, 0x6f, 0x1b, 0xc7,
+	0x12, 0xd7, 0xf1, 0x43, 0x24, 0x47, 0xa2, 0x64, 0x9f, 0xfd, 0xec, 0x93, 0xde, 0x33, 0x29, 0xd0,
+	0x78, 0x7e, 0x7a, 0x02, 0x7c, 0x8c, 0x9d, 0x18, 0x71, 0xdc, 0xd8, 0xa2, 0x15, 0x23, 0x4a, 0xfc,
+	0x85, 0x93, 0xe0, 0x22, 0xcd, 0x61, 0x79, 0x37, 0xa4, 0x16, 0xbc, 0x0f, 0xe6, 0xf6, 0x28, 0x8b,
+	0x4d, 0x10, 0xa4, 0x08, 0x0c, 0xa4, 0x71, 0xe3, 0x26, 0x95, 0xab, 0x20, 0x48, 0x25, 0x20, 0xe9,
+	0xd3, 0xaa, 0x34, 0x52, 0xb9, 0xb2, 0x13, 0xbb, 0xf0, 0x3f, 0x91, 0x22, 0xd8, 0x8f, 0xe3, 0x87,
+	0x6d, 0x52, 0xa2, 0x0b, 0x26, 0x45, 0x1a, 0x8a, 0x3b, 0x3b, 0x33, 0x3b, 0xfb, 0x9b, 0xdf, 0xcc,
+	0x0e, 0x05, 0x67, 0xdb, 0x51, 0xb8, 0x8b, 0x01, 0x09, 0x1c, 0xac, 0xe2, 0x9e, 0xb3, 0x43, 0x82,
+	0x26, 0x56, 0x77, 0x2f, 0x54, 0xc3, 0xc8, 0xc5, 0x88, 0x99, 0xed, 0x28, 0x8c, 0x43, 0xfd, 0x54,
+	0x5f, 0xc9, 0x4c, 0x94, 0xcc, 0xdd, 0x0b, 0xcb, 0xc7, 0x89, 0x4f, 0x83, 0xb0, 0x2a, 0x3e, 0xa5,
+	0xea, 0x72, 0xc9, 0x09, 0x99, 0x1f, 0xb2, 0x6a, 0x9d, 0x30, 0xee, 0xa7, 0x8e, 0x31, 0xb9, 0x50,
+	0x75, 0x42, 0x1a, 0xa8, 0xfd, 0xd3, 0x6a, 0xdf, 0x67, 0x4d, 0x7e, 0x8c, 0xcf, 0x9a, 0x6a, 0x63,
+	0x49, 0x6e, 0xd8, 0x62, 0x55, 0x95, 0x0b, 0xb5, 0x75, 0xb2, 0x19, 0x36, 0x43, 0x29, 0xe7, 0xdf,
+	0x94, 0xb4, 0xdc, 0x0c, 0xc3, 0xa6, 0x87, 0x55, 0xb1, 0xaa, 0x77, 0x1a, 0xd5, 0x98, 0xfa, 0xc8,
+	0x62, 0xe2, 0xb7, 0x95, 0xc2, 0xea, 0x88, 0xab, 0x39, 0xa1, 0xef, 0xd3, 0xd8, 0xc7, 0x20, 0x56,
+	0x07, 0x54, 0x7e, 0xd2, 0x20, 0x7b, 0x87, 0x5f, 0x58, 0x5f, 0x82, 0xbc, 0xb8, 0xb9, 0x4d, 0x5d,
+	0x43, 0x5b, 0xd1, 0x56, 0x33, 0x56, 0x4e, 0xac, 0x37, 0x5d, 0xfd, 0x2a, 0x14, 0x08, 0x6b, 0xd9,
+	0x62, 0x69, 0xa4, 0x56, 0xb4, 0xd5, 0xb9, 0x8b, 0x2b, 0xe6, 0xdb, 0x81, 0x31, 0xd7, 0x59, 0x4b,
+	0xf8, 0xfb, 0x64, 0xc6, 0xca, 0x13, 0xf5, 0x9d, 0x3b, 0xa8, 0x53, 0x57, 0x39, 0x48, 0x8f, 0x77,
+	0x50, 0xa3, 0x6e, 0xcf, 0x41, 0x5d, 0x7d, 0xbf, 0x92, 0x79, 0xf0, 0xb8, 0x3c, 0x53, 0xcb, 0x41,
+	0x56, 0xb8, 0xa8, 0xfc, 0x92, 0x85, 0x7c, 0x72, 0x90, 0xfe, 0x6f, 0x28, 0xf8, 0x24, 0x6a, 0x61,
+	0x9c, 0x44, 0x5e, 0xb4, 0xf2, 0x52, 0xb0, 0xe9, 0xea, 0xef, 0xc1, 0x2c, 0x43, 0xcf, 0x53, 0x71,
+	0x17, 0x6a, 0xc6, 0xaf, 0x3f, 0x9f, 0x3f, 0xa9, 0x20, 0x5e, 0x77, 0xdd, 0x08, 0x19, 0xdb, 0x8a,
+	0x23, 0x1a, 0x34, 0x2d, 0xa5, 0xa7, 0x7f, 0x08, 0xb3, 0x84, 0x31, 0x8c, 0x99, 0x0a, 0x74, 0xc9,
+	0x54, 0xea, 0x3c, 0xaf, 0xa6, 0xca, 0xab, 0x79, 0x3d, 0xa4, 0x41, 0x2d, 0x73, 0xf0, 0xac, 0x3c,
+	0x63, 0x29, 0x75, 0xfd, 0x12, 0x64, 0xdb, 0x11, 0x75, 0xd0, 0xc8, 0x1c, 0xcd, 0x4e, 0x6a, 0xeb,
+	0xf7, 0x60, 0x59, 0x9e, 0x6c, 0x33, 0x8c, 0x63, 0x0f, 0x79, 0x76, 0xec, 0x86, 0x47, 0x62, 0xbb,
+	0x81, 0x68, 0x64, 0x0f, 0xf1, 0x65, 0x9d, 0x96, 0xc6, 0x5b, 0x3d, 0xdb, 0x1b, 0x1e, 0x89, 0x6f,
+	0x20, 0xea, 0x67, 0xa1, 0x48, 0x3c, 0x2f, 0xbc, 0x6f, 0xb7, 0x49, 0x14, 0x53, 0xe2, 0x19, 0xb3,
+	0x2b, 0xda, 0x6a, 0xde, 0x9a, 0x17, 0xc2, 0xbb, 0x52, 0xa6, 0x97, 0x61, 0x0e, 0xf7, 0x62, 0x8c,
+	0x02, 0xe2, 0x71, 0xf4, 0x72, 0x1c, 0x23, 0x0b, 0x12, 0xd1, 0xa6, 0xab, 0x6f, 0x00, 0xe0, 0x5e,
+	0x9b, 0x46, 0x24, 0xa6, 0x61, 0x60, 0xe4, 0x45, 0x34, 0xcb, 0xa6, 0xe4, 0x9f, 0x99, 0xf0, 0xcf,
+	0xdc, 0x4e, 0xf8, 0x57, 0xcb, 0x1f, 0x3c, 0x2b, 0x6b, 0x0f, 0x9f, 0x97, 0x35, 0x6b, 0xc0, 0x4e,
+	0xbf, 0x06, 0x0b, 0x2e, 0x65, 0x6d, 0x8f, 0x74, 0x6d, 0x85, 0x6d, 0xe1, 0xb0, 0x7b, 0x15, 0x95,
+	0xc1, 0xba, 0x04, 0xf7, 0x03, 0xc8, 0x47, 0xd8, 0xc0, 0x28, 0xc2, 0xc8, 0x80, 0x43, 0x32, 0xd9,
+	0xd3, 0xd4, 0xbf, 0xd1, 0xa0, 0x58, 0x27, 0x8c, 0x73, 0x43, 0x9d, 0x3b, 0xb7, 0x92, 0x1e, 0x9f,
+	0x9b, 0x1b, 0x3c, 0x37, 0x3f, 0x3e, 0x2f, 0xaf, 0x36, 0x69, 0xbc, 0xd3, 0xa9, 0x9b, 0x4e, 0xe8,
+	0xab, 0x92, 0x54, 0x7f, 0xce, 0x33, 0xb7, 0x55, 0x8d, 0xbb, 0x6d, 0x64, 0xc2, 0x80, 0x7d, 0xf7,
+	0x6a, 0x7f, 0x6d, 0xde, 0xc3, 0x26, 0x71, 0xba, 0x36, 0xaf, 0x76, 0xf6, 0xc3, 0xab, 0xfd, 0x35,
+	0xcd, 0x9a, 0x97, 0xe7, 0xca, 0xf0, 0xaf, 0x2c, 0x72, 0xfe, 0x7e, 0xfd, 0x6a, 0x7f, 0x4d, 0xb1,
+	0xac, 0xf2, 0x47, 0x16, 0xf2, 0x09, 0xd3, 0xc7, 0x33, 0xd8, 0x84, 0x6c, 0xbd, 0xd3, 0x3d, 0x02,
+	0x81, 0xa5, 0xda, 0xd4, 0xf9, 0xfb, 0x48, 0x83, 0x7f, 0x89, 0x93, 0x87, 0xf8, 0x8b, 0xc8, 0x8c,
+	0xec, 0xb4, 0xb0, 0x3e, 0x21, 0xce, 0x1f, 0x28, 0x01, 0x44, 0xf6, 0x0f, 0xff, 0xff, 0x4e, 0xfc,
+	0x5f, 0x48, 0xf8, 0x2f, 0x49, 0x5a, 0x79, 0xa4, 0xc1, 0xfc, 0x76, 0x44, 0x9b, 0x4d, 0x8c, 0x64,
+	0x09, 0x7c, 0xa4, 0x5a, 0xbb, 0xa0, 0xff, 0xdc, 0xc5, 0x33, 0xa3, 0x5e, 0x07, 0xa1, 0x9d, 0x10,
+	0x50, 0x58, 0xe8, 0x1b, 0x50, 0x8c, 0xa5, 0x2b, 0x5b, 0xf2, 0x37, 0x75, 0x34, 0xfe, 0xce, 0x2b,
+	0xab, 0xbb, 0xdc, 0x48, 0xbe, 0x30, 0x95, 0x16, 0x14, 0xc4, 0x09, 0x37, 0x69, 0xd0, 0x1a, 0x5f,
+	0x96, 0x83, 0xcf, 0x65, 0x6a, 0xf8, 0xb9, 0x3c, 0x07, 0x8b, 0x1e, 0x0d, 0x5a, 0xe8, 0xda, 0x3d,
+	0x8d, 0xb4, 0xd0, 0x28, 0x4a, 0xf1, 0x1d, 0xa9, 0x57, 0x79, 0xac, 0x01, 0x88, 0xc3, 0x6f, 0xe2,
+	0x2e, 0x7a, 0xfa, 0xe5, 0xa4, 0xfe, 0x24, 0x04, 0xff, 0x79, 0x6b, 0xfc, 0x1b, 0xe8, 0xbc, 0x59,
+	0x82, 0xfd, 0x92, 0x4f, 0x4d, 0x56, 0xf2, 0x65, 0x98, 0x93, 0x21, 0x3a, 0x61, 0x27, 0x88, 0x45,
+	0x94, 0x45, 0x0b, 0x84, 0xe8, 0x3a, 0x97, 0x54, 0x1e, 0x64, 0xe1, 0xc4, 0x2d, 0x71, 0x65, 0x11,
+	0x34, 0xdb, 0xea, 0xf8, 0x3e, 0x89, 0xba, 0xe3, 0xa1, 0x39, 0x07, 0x8b, 0xbd, 0x71, 0x41, 0x79,
+	0x4e, 0x09, 0x95, 0x62, 0x32, 0x10, 0x08, 0xe7, 0xfa, 0x57, 0x1a, 0x00, 0x57, 0xec, 0xb5, 0xab,
+	0x29, 0x51, 0x93, 0x0f, 0x33, 0xaa, 0xac, 0xbe, 0x94, 0x93, 0x4d, 0xd2, 0xf7, 0xa6, 0x14, 0x00,
+	0x1f, 0x8c, 0x44, 0xe2, 0x39, 0x54, 0xbd, 0xc1, 0x48, 0x41, 0x95, 0x95, 0x50, 0x25, 0xa3, 0x4f,
+	0x1f, 0x2a, 0xae, 0xa8, 0xa0, 0x9a, 0x9d, 0x1a, 0x54, 0x75, 0xea, 0xf6, 0xa1, 0xe2, 0x11, 0x48,
+	0xa8, 0x72, 0x53, 0x83, 0xaa, 0x4e, 0x5d, 0x01, 0x55, 0xe5, 0xa9, 0x06, 0x8b, 0xfd, 0x16, 0x2f,
+	0xe1, 0x1b, 0x4b, 0xc3, 0xcb, 0x90, 0xe1, 0x73, 0xb1, 0x91, 0x3a, 0x52, 0xd3, 0x9e, 0x11, 0x4d,
+	0x5b, 0x58, 0x4c, 0xfb, 0x09, 0xad, 0xfc, 0xae, 0xc1, 0xb1, 0xfe, 0xd5, 0x2c, 0x74, 0xc2, 0xc8,
+	0x1d, 0x7f, 0xb7, 0x53, 0x30, 0xbb, 0x83, 0xb4, 0xb9, 0x23, 0x2b, 0x2b, 0x6d, 0xa9, 0x95, 0xbe,
+	0x0c, 0x79, 0x86, 0x5f, 0x74, 0x30, 0x70, 0x50, 0x55, 0x73, 0x6f, 0xdd, 0xc3, 0x23, 0x33, 0x31,
+	0x1e, 0x35, 0xc8, 0x36, 0xa8, 0xe7, 0x25, 0x2f, 0xfa, 0xb9, 0x51, 0xcd, 0x79, 0xe0, 0x05, 0xa6,
+	0x9e, 0x97, 0xdc, 0x51, 0x98, 0x56, 0xbe, 0x4d, 0xc3, 0xc2, 0xf0, 0xfe, 0xb8, 0x5f, 0x1c, 0x67,
+	0x40, 0x76, 0x21, 0x9b, 0x33, 0x44, 0x4e, 0x3e, 0x56, 0x41, 0x48, 0xb6, 0xbb, 0x6d, 0xe4, 0x33,
+	0x51, 0x78, 0x3f, 0x50, 0xbf, 0x25, 0xc6, 0xce, 0x44, 0x42, 0x6d, 0x20, 0xa1, 0x99, 0x77, 0x4c,
+	0x68, 0x76, 0xa2, 0x99, 0xc8, 0x85, 0x8c, 0x98, 0x80, 0x0e, 0xad, 0xd3, 0x4b, 0x93, 0x96, 0x89,
+	0xac, 0x0a, 0xe1, 0x5d, 0x37, 0x20, 0x97, 0xcc, 0x36, 0x39, 0x31, 0xdb, 0x24, 0xcb, 0xd7, 0xc7,
+	0x9a, 0xfc, 0xeb, 0x63, 0x4d, 0xe5, 0x20, 0x0d, 0xc7, 0x87, 0x18, 0x87, 0xb4, 0x1d, 0xf3, 0x91,
+	0x69, 0x60, 0x86, 0xeb, 0x65, 0x65, 0xbe, 0x2f, 0xdc, 0x7c, 0x8d, 0x97, 0xa9, 0x91, 0xbc, 0x4c,
+	0x0f, 0xf1, 0xf2, 0x2f, 0xe5, 0x9e, 0x7e, 0x1b, 0x0a, 0x71, 0x44, 0x02, 0xd6, 0xc0, 0x28, 0xc9,
+	0xc9, 0xda, 0xe1, 0x7e, 0xb6, 0x95, 0x89, 0xf2, 0xd5, 0x77, 0xa1, 0x7f, 0x0a, 0xd0, 0x40, 0xb4,
+	0x69, 0xd0, 0xee, 0xc4, 0x4c, 0xf5, 0xc2, 0xff, 0x8e, 0xfc, 0x41, 0xec, 0x88, 0xbe, 0xbe, 0xee,
+	0xf3, 0xcf, 0xc4, 0x57, 0x03, 0x71, 0x53, 0x58, 0xeb, 0x57, 0x21, 0x13, 0x90, 0x5d, 0x66, 0xe4,
+	0xc7, 0x7b, 0xb9, 0xad, 0x46, 0x29, 0xd1, 0xf7, 0x94, 0x17, 0x61, 0xc8, 0xa7, 0x08, 0xfd, 0xcd,
+	0xa0, 0xf5, 0xeb, 0x30, 0xab, 0xe2, 0xd3, 0x26, 0x8f, 0x4f, 0x99, 0xea, 0x1f, 0x43, 0x2e, 0xec,
+	0xc4, 0xc2, 0x4b, 0x6a, 0x72, 0x2f, 0x89, 0x6d, 0x65, 0x5f, 0x83, 0xc2, 0xed, 0xf5, 0x7b, 0xaa,
+	0xb1, 0xf5, 0x8b, 0x51, 0x7b, 0xc7, 0x62, 0x4c, 0x4d, 0x54, 0x8c, 0xa3, 0x38, 0x39, 0x44, 0xe4,
+	0xcc, 0x30, 0x91, 0x2b, 0xdf, 0x6b, 0x70, 0x72, 0xd3, 0x45, 0xbf, 0x1d, 0xc6, 0x18, 0x38, 0xdd,
+	0xcf, 0xb0, 0xab, 0xa2, 0xbf, 0x08, 0x39, 0x22, 0xef, 0x6a, 0x68, 0x87, 0x34, 0x9f, 0x44, 0x51,
+	0xff, 0x1f, 0x2c, 0xd2, 0xbe, 0x2f, 0xbb, 0x85, 0x5d, 0xd5, 0xd2, 0x16, 0xe8, 0xd0, 0x11, 0x43,
+	0x1d, 0x31, 0x3d, 0xdc, 0x11, 0xfb, 0xb7, 0xc8, 0x0c, 0xde, 0x82, 0x63, 0x5b, 0xdc, 0x40, 0xe2,
+	0xde, 0x22, 0xc1, 0xd6, 0x7d, 0x1a, 0x3b, 0x3b, 0xef, 0x14, 0xe1, 0xff, 0xe1, 0x18, 0x0d, 0x62,
+	0x8c, 0x76, 0x89, 0x67, 0x33, 0x74, 0xc2, 0xc0, 0x65, 0x6a, 0xaa, 0x5d, 0x4c, 0xe4, 0x5b, 0x52,
+	0xac, 0x5f, 0x83, 0xbc, 0x8b, 0xc4, 0xf5, 0x68, 0x80, 0x46, 0x7a, 0x82, 0x72, 0xee, 0x59, 0xd5,
+	0xf0, 0xe0, 0x45, 0x49, 0x7b, 0xf2, 0xa2, 0xa4, 0xfd, 0xf6, 0xa2, 0xa4, 0x3d, 0x7c, 0x59, 0x9a,
+	0x79, 0xf2, 0xb2, 0x34, 0xf3, 0xf4, 0x65, 0x69, 0x06, 0x96, 0x68, 0x38, 0x82, 0x60, 0x77, 0xb5,
+	0xcf, 0xcd, 0x81, 0x1e, 0xd9, 0x57, 0x3a, 0x4f, 0xc3, 0x81, 0x55, 0x75, 0xaf, 0xf7, 0xff, 0xae,
+	0xfa, 0xac, 0x08, 0xe7, 0xfd, 0x3f, 0x07, 0x00, 0xc9, 0x79, 0x28, 0x23, 0xe8, 0x13, 0x00, 0x00,
+}
+
+func (m *Order) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Order) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Order != nil {
+		{
+			size := m.Order.Size()
+			i -= size
+			if _, err := m.Order.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Order_AskOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Order_AskOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AskOrder != nil {
+		{
+			size, err := m.AskOrder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOrders(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Order_BidOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Order_BidOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BidOrder != nil {
+		{
+			size, err := m.BidOrder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOrders(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AskOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AskOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AskOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BasketAssets) > 0 {
+		for iNdEx := len(m.BasketAssets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BasketAssets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOrders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Referrer) > 0 {
+		i -= len(m.Referrer)
+		copy(dAtA[i:], m.Referrer)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Referrer)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.DisplayAssets != nil {
+		{
+			size, err := m.DisplayAssets.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOrders(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Expiration != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintOrders(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.AllowPartial {
+		i--
+		if m.AllowPartial {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SellerSettlementFlatFee != nil {
+		{
+			size, err := m.SellerSettlementFlatFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOrders(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Assets.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BidOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BidOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BidOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BasketAssets) > 0 {
+		for iNdEx := len(m.BasketAssets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BasketAssets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOrders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Referrer) > 0 {
+		i -= len(m.Referrer)
+		copy(dAtA[i:], m.Referrer)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Referrer)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.DisplayAssets != nil {
+		{
+			size, err := m.DisplayAssets.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOrders(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Expiration != nil {
+		n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintOrders(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.AllowPartial {
+		i--
+		if m.AllowPartial {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.BuyerSettlementFees) > 0 {
+		for iNdEx := len(m.BuyerSettlementFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BuyerSettlementFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOrders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Assets.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TriggerOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TriggerOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TriggerOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TriggerPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderLink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderLink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LinkedOrderId != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.LinkedOrderId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceLevel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceLevel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceLevel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrderCount != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.OrderCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Assets.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketOrdersSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketOrdersSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketOrdersSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BidPrice) > 0 {
+		for iNdEx := len(m.BidPrice) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BidPrice[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOrders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.BidAssets) > 0 {
+		for iNdEx := len(m.BidAssets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BidAssets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOrders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.BidOrderCount != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.BidOrderCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.AskPrice) > 0 {
+		for iNdEx := len(m.AskPrice) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AskPrice[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOrders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AskAssets) > 0 {
+		for iNdEx := len(m.AskAssets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AskAssets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOrders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.AskOrderCount != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.AskOrderCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SettlementPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SettlementPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SettlementPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Assets.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n18, err18 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err18 != nil {
+		return 0, err18
+	}
+	i -= n18
+	i = encodeVarintOrders(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0x12
+	if m.MarketId != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SettlementRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SettlementRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SettlementRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fills) > 0 {
+		for iNdEx := len(m.Fills) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fills[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOrders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n19, err19 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintOrders(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0x22
+	if m.Sequence != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SettlementFill) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SettlementFill) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SettlementFill) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Partial {
+		i--
+		if m.Partial {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOrders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Assets.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OrderType) > 0 {
+		i -= len(m.OrderType)
+		copy(dAtA[i:], m.OrderType)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.OrderType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SettlementReceipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SettlementReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SettlementReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Navs) > 0 {
+		for iNdEx := len(m.Navs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Navs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOrders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.FeeInputs) > 0 {
+		for iNdEx := len(m.FeeInputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeInputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOrders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Transfers) > 0 {
+		for iNdEx := len(m.Transfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOrders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Fills) > 0 {
+		for iNdEx := len(m.Fills) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fills[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOrders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n22, err22 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err22 != nil {
+		return 0, err22
+	}
+	i -= n22
+	i = encodeVarintOrders(dAtA, i, uint64(n22))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SettlementId != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.SettlementId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SettlementTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SettlementTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SettlementTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Outputs) > 0 {
+		for iNdEx := len(m.Outputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Outputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOrders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Inputs) > 0 {
+		for iNdEx := len(m.Inputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Inputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOrders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NAVRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NAVRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NAVRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Assets.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *IdempotencyKeyRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IdempotencyKeyRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IdempotencyKeyRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.IdempotencyKey) > 0 {
+		i -= len(m.IdempotencyKey)
+		copy(dAtA[i:], m.IdempotencyKey)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.IdempotencyKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeadManSwitch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeadManSwitch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeadManSwitch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n25, err25 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline):])
+	if err25 != nil {
+		return 0, err25
+	}
+	i -= n25
+	i = encodeVarintOrders(dAtA, i, uint64(n25))
+	i--
+	dAtA[i] = 0x1a
+	if m.IntervalSeconds != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.IntervalSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOrders(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOrders(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Order) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovOrders(uint64(m.OrderId))
+	}
+	if m.Order != nil {
+		n += m.Order.Size()
+	}
+	return n
+}
+
+func (m *Order_AskOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AskOrder != nil {
+		l = m.AskOrder.Size()
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	return n
+}
+func (m *Order_BidOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BidOrder != nil {
+		l = m.BidOrder.Size()
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	return n
+}
+func (m *AskOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovOrders(uint64(m.MarketId))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = m.Assets.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	if m.SellerSettlementFlatFee != nil {
+		l = m.SellerSettlementFlatFee.Size()
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	if m.AllowPartial {
+		n += 2
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	if m.DisplayAssets != nil {
+		l = m.DisplayAssets.Size()
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.Referrer)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	if len(m.BasketAssets) > 0 {
+		for _, e := range m.BasketAssets {
+			l = e.Size()
+			n += 1 + l + sovOrders(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BidOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovOrders(uint64(m.MarketId))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = m.Assets.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	if len(m.BuyerSettlementFees) > 0 {
+		for _, e := range m.BuyerSettlementFees {
+			l = e.Size()
+			n += 1 + l + sovOrders(uint64(l))
+		}
+	}
+	if m.AllowPartial {
+		n += 2
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	if m.DisplayAssets != nil {
+		l = m.DisplayAssets.Size()
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.Referrer)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	if len(m.BasketAssets) > 0 {
+		for _, e := range m.BasketAssets {
+			l = e.Size()
+			n += 1 + l + sovOrders(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TriggerOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Order.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	l = m.TriggerPrice.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	return n
+}
+
+func (m *OrderLink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovOrders(uint64(m.MarketId))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovOrders(uint64(m.OrderId))
+	}
+	if m.LinkedOrderId != 0 {
+		n += 1 + sovOrders(uint64(m.LinkedOrderId))
+	}
+	return n
+}
+
+func (m *PriceLevel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	l = m.Assets.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	if m.OrderCount != 0 {
+		n += 1 + sovOrders(uint64(m.OrderCount))
+	}
+	return n
+}
+
+func (m *MarketOrdersSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovOrders(uint64(m.MarketId))
+	}
+	if m.AskOrderCount != 0 {
+		n += 1 + sovOrders(uint64(m.AskOrderCount))
+	}
+	if len(m.AskAssets) > 0 {
+		for _, e := range m.AskAssets {
+			l = e.Size()
+			n += 1 + l + sovOrders(uint64(l))
+		}
+	}
+	if len(m.AskPrice) > 0 {
+		for _, e := range m.AskPrice {
+			l = e.Size()
+			n += 1 + l + sovOrders(uint64(l))
+		}
+	}
+	if m.BidOrderCount != 0 {
+		n += 1 + sovOrders(uint64(m.BidOrderCount))
+	}
+	if len(m.BidAssets) > 0 {
+		for _, e := range m.BidAssets {
+			l = e.Size()
+			n += 1 + l + sovOrders(uint64(l))
+		}
+	}
+	if len(m.BidPrice) > 0 {
+		for _, e := range m.BidPrice {
+			l = e.Size()
+			n += 1 + l + sovOrders(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SettlementPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovOrders(uint64(m.MarketId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovOrders(uint64(l))
+	l = m.Assets.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	return n
+}
+
+func (m *SettlementRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovOrders(uint64(m.MarketId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovOrders(uint64(m.Height))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovOrders(uint64(m.Sequence))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovOrders(uint64(l))
+	if len(m.Fills) > 0 {
+		for _, e := range m.Fills {
+			l = e.Size()
+			n += 1 + l + sovOrders(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SettlementFill) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovOrders(uint64(m.OrderId))
+	}
+	l = len(m.OrderType)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = m.Assets.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovOrders(uint64(l))
+		}
+	}
+	if m.Partial {
+		n += 2
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	return n
+}
+
+func (m *SettlementReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SettlementId != 0 {
+		n += 1 + sovOrders(uint64(m.SettlementId))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovOrders(uint64(m.MarketId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovOrders(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovOrders(uint64(l))
+	if len(m.Fills) > 0 {
+		for _, e := range m.Fills {
+			l = e.Size()
+			n += 1 + l + sovOrders(uint64(l))
+		}
+	}
+	if len(m.Transfers) > 0 {
+		for _, e := range m.Transfers {
+			l = e.Size()
+			n += 1 + l + sovOrders(uint64(l))
+		}
+	}
+	if len(m.FeeInputs) > 0 {
+		for _, e := range m.FeeInputs {
+			l = e.Size()
+			n += 1 + l + sovOrders(uint64(l))
+		}
+	}
+	if len(m.Navs) > 0 {
+		for _, e := range m.Navs {
+			l = e.Size()
+			n += 1 + l + sovOrders(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SettlementTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Inputs) > 0 {
+		for _, e := range m.Inputs {
+			l = e.Size()
+			n += 1 + l + sovOrders(uint64(l))
+		}
+	}
+	if len(m.Outputs) > 0 {
+		for _, e := range m.Outputs {
+			l = e.Size()
+			n += 1 + l + sovOrders(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NAVRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Assets.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovOrders(uint64(m.Height))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovOrders(uint64(m.MarketId))
+	}
+	return n
+}
+
+func (m *IdempotencyKeyRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.IdempotencyKey)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovOrders(uint64(m.OrderId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovOrders(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *DeadManSwitch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	if m.IntervalSeconds != 0 {
+		n += 1 + sovOrders(uint64(m.IntervalSeconds))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline)
+	n += 1 + l + sovOrders(uint64(l))
+	return n
+}
+
+func sovOrders(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOrders(x uint64) (n int) {
+	return sovOrders(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Order) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Order: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AskOrder{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Order = &Order_AskOrder{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BidOrder{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Order = &Order_BidOrder{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AskOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AskOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AskOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Assets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellerSettlementFlatFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SellerSettlementFlatFee == nil {
+				m.SellerSettlementFlatFee = &types.Coin{}
+			}
+			if err := m.SellerSettlementFlatFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowPartial", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowPartial = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayAssets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DisplayAssets == nil {
+				m.DisplayAssets = &types.Coin{}
+			}
+			if err := m.DisplayAssets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referrer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketAssets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BasketAssets = append(m.BasketAssets, types.Coin{})
+			if err := m.BasketAssets[len(m.BasketAssets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BidOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BidOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BidOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Assets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyerSettlementFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuyerSettlementFees = append(m.BuyerSettlementFees, types.Coin{})
+			if err := m.BuyerSettlementFees[len(m.BuyerSettlementFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowPartial", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowPartial = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayAssets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DisplayAssets == nil {
+				m.DisplayAssets = &types.Coin{}
+			}
+			if err := m.DisplayAssets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referrer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketAssets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BasketAssets = append(m.BasketAssets, types.Coin{})
+			if err := m.BasketAssets[len(m.BasketAssets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TriggerOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TriggerOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TriggerOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderLink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderLink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderLink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkedOrderId", wireType)
+			}
+			m.LinkedOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LinkedOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceLevel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceLevel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceLevel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Assets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderCount", wireType)
+			}
+			m.OrderCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketOrdersSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketOrdersSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketOrdersSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskOrderCount", wireType)
+			}
+			m.AskOrderCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AskOrderCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskAssets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AskAssets = append(m.AskAssets, types.Coin{})
+			if err := m.AskAssets[len(m.AskAssets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AskPrice = append(m.AskPrice, types.Coin{})
+			if err := m.AskPrice[len(m.AskPrice)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidOrderCount", wireType)
+			}
+			m.BidOrderCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BidOrderCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidAssets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidAssets = append(m.BidAssets, types.Coin{})
+			if err := m.BidAssets[len(m.BidAssets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidPrice = append(m.BidPrice, types.Coin{})
+			if err := m.BidPrice[len(m.BidPrice)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SettlementPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SettlementPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SettlementPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Assets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SettlementRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SettlementRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SettlementRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fills", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fills = append(m.Fills, SettlementFill{})
+			if err := m.Fills[len(m.Fills)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Assets.Size()
-	n += 1 + l + sovOrders(uint64(l))
-	l = m.Price.Size()
-	n += 1 + l + sovOrders(uint64(l))
-	if len(m.BuyerSettlementFees) > 0 {
-		for _, e := range m.BuyerSettlementFees {
-			l = e.Size()
-			n += 1 + l + sovOrders(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SettlementFill) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SettlementFill: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SettlementFill: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Assets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partial", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Partial = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.AllowPartial {
-		n += 2
-	}
-	l = len(m.ExternalId)
-	if l > 0 {
-		n += 1 + l + sovOrders(uint64(l))
-	}
-	return n
-}
 
-func sovOrders(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozOrders(x uint64) (n int) {
-	return sovOrders(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Order) Unmarshal(dAtA []byte) error {
+func (m *SettlementReceipt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -675,17 +4982,17 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Order: wiretype end group for non-group")
+			return fmt.Errorf("proto: SettlementReceipt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SettlementReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementId", wireType)
 			}
-			m.OrderId = 0
+			m.SettlementId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -695,14 +5002,52 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderId |= uint64(b&0x7F) << shift
+				m.SettlementId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AskOrder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -729,15 +5074,13 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &AskOrder{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Order = &Order_AskOrder{v}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BidOrder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fills", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -764,11 +5107,112 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &BidOrder{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Fills = append(m.Fills, SettlementFill{})
+			if err := m.Fills[len(m.Fills)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transfers = append(m.Transfers, SettlementTransfer{})
+			if err := m.Transfers[len(m.Transfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeInputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeInputs = append(m.FeeInputs, AccountAmount{})
+			if err := m.FeeInputs[len(m.FeeInputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Navs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Navs = append(m.Navs, NetAssetPrice{})
+			if err := m.Navs[len(m.Navs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Order = &Order_BidOrder{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -791,7 +5235,7 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AskOrder) Unmarshal(dAtA []byte) error {
+func (m *SettlementTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -808,42 +5252,23 @@ func (m *AskOrder) Unmarshal(dAtA []byte) error {
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AskOrder: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AskOrder: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
-			}
-			m.MarketId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOrders
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				break
 			}
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SettlementTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SettlementTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -853,27 +5278,29 @@ func (m *AskOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOrders
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOrders
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Seller = string(dAtA[iNdEx:postIndex])
+			m.Inputs = append(m.Inputs, AccountAmount{})
+			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -900,13 +5327,64 @@ func (m *AskOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Assets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Outputs = append(m.Outputs, AccountAmount{})
+			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NAVRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NAVRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NAVRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -933,13 +5411,13 @@ func (m *AskOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Assets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SellerSettlementFlatFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -966,18 +5444,15 @@ func (m *AskOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SellerSettlementFlatFee == nil {
-				m.SellerSettlementFlatFee = &types.Coin{}
-			}
-			if err := m.SellerSettlementFlatFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowPartial", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var v int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -987,17 +5462,16 @@ func (m *AskOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllowPartial = bool(v != 0)
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			var stringLen uint64
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -1007,24 +5481,11 @@ func (m *AskOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MarketId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOrders
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOrders
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ExternalId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrders(dAtA[iNdEx:])
@@ -1046,7 +5507,7 @@ func (m *AskOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BidOrder) Unmarshal(dAtA []byte) error {
+func (m *IdempotencyKeyRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1069,17 +5530,17 @@ func (m *BidOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BidOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: IdempotencyKeyRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BidOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IdempotencyKeyRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			m.MarketId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -1089,14 +5550,27 @@ func (m *BidOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IdempotencyKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1124,13 +5598,13 @@ func (m *BidOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Buyer = string(dAtA[iNdEx:postIndex])
+			m.IdempotencyKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
-			var msglen int
+			m.OrderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -1140,30 +5614,16 @@ func (m *BidOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.OrderId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOrders
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOrders
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Assets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -1173,30 +5633,66 @@ func (m *BidOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOrders
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrders(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthOrders
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeadManSwitch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrders
 			}
-			iNdEx = postIndex
-		case 5:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeadManSwitch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeadManSwitch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BuyerSettlementFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -1206,31 +5702,29 @@ func (m *BidOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOrders
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOrders
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BuyerSettlementFees = append(m.BuyerSettlementFees, types.Coin{})
-			if err := m.BuyerSettlementFees[len(m.BuyerSettlementFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowPartial", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalSeconds", wireType)
 			}
-			var v int
+			m.IntervalSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -1240,17 +5734,16 @@ func (m *BidOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.IntervalSeconds |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllowPartial = bool(v != 0)
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -1260,23 +5753,24 @@ func (m *BidOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOrders
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOrders
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
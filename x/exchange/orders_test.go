@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -13,6 +15,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/testutil/assertions"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
 )
 
 // copyOrder creates a copy of the provided order.
@@ -53,6 +56,8 @@ func copyAskOrder(askOrder *AskOrder) *AskOrder {
 		SellerSettlementFlatFee: copyCoinP(askOrder.SellerSettlementFlatFee),
 		AllowPartial:            askOrder.AllowPartial,
 		ExternalId:              askOrder.ExternalId,
+		Expiration:              copyTimeP(askOrder.Expiration),
+		BasketAssets:            copyCoins(askOrder.BasketAssets),
 	}
 }
 
@@ -69,9 +74,20 @@ func copyBidOrder(bidOrder *BidOrder) *BidOrder {
 		BuyerSettlementFees: copyCoins(bidOrder.BuyerSettlementFees),
 		AllowPartial:        bidOrder.AllowPartial,
 		ExternalId:          bidOrder.ExternalId,
+		Expiration:          copyTimeP(bidOrder.Expiration),
+		BasketAssets:        copyCoins(bidOrder.BasketAssets),
 	}
 }
 
+// copyTimeP creates a copy of the provided time pointer.
+func copyTimeP(tm *time.Time) *time.Time {
+	if tm == nil {
+		return nil
+	}
+	rv := *tm
+	return &rv
+}
+
 // orderString is similar to %v except with easier to understand Coin and Int entries.
 func orderString(order *Order) string {
 	if order == nil {
@@ -110,6 +126,7 @@ func askOrderString(askOrder *AskOrder) string {
 		fmt.Sprintf("SellerSettlementFlatFee:%s", coinPString(askOrder.SellerSettlementFlatFee)),
 		fmt.Sprintf("AllowPartial:%t", askOrder.AllowPartial),
 		fmt.Sprintf("ExternalID:%s", askOrder.ExternalId),
+		fmt.Sprintf("Expiration:%v", askOrder.Expiration),
 	}
 	return fmt.Sprintf("{%s}", strings.Join(fields, ", "))
 }
@@ -128,6 +145,7 @@ func bidOrderString(bidOrder *BidOrder) string {
 		fmt.Sprintf("BuyerSettlementFees:%s", coinsString(bidOrder.BuyerSettlementFees)),
 		fmt.Sprintf("AllowPartial:%t", bidOrder.AllowPartial),
 		fmt.Sprintf("ExternalID:%s", bidOrder.ExternalId),
+		fmt.Sprintf("Expiration:%v", bidOrder.Expiration),
 	}
 	return fmt.Sprintf("{%s}", strings.Join(fields, ", "))
 }
@@ -205,6 +223,42 @@ func TestOrderTypesAndBytes(t *testing.T) {
 	assert.NoError(t, err, "checking for duplicate values")
 }
 
+func TestCompareUnitPrices(t *testing.T) {
+	askOrder := func(assets, price int64) *Order {
+		return NewOrder(1).WithAsk(&AskOrder{Assets: sdk.NewInt64Coin("apple", assets), Price: sdk.NewInt64Coin("plum", price)})
+	}
+	bidOrder := func(assets, price int64) *Order {
+		return NewOrder(2).WithBid(&BidOrder{Assets: sdk.NewInt64Coin("apple", assets), Price: sdk.NewInt64Coin("plum", price)})
+	}
+
+	tests := []struct {
+		name   string
+		order1 OrderI
+		order2 OrderI
+		exp    int
+	}{
+		{name: "same assets, less price", order1: askOrder(10, 19), order2: bidOrder(10, 20), exp: -1},
+		{name: "same assets, same price", order1: askOrder(10, 20), order2: bidOrder(10, 20), exp: 0},
+		{name: "same assets, more price", order1: askOrder(10, 21), order2: bidOrder(10, 20), exp: 1},
+		{name: "diff assets, less unit price", order1: bidOrder(3, 5), order2: askOrder(2, 4), exp: -1},
+		{name: "diff assets, same unit price", order1: bidOrder(3, 6), order2: askOrder(2, 4), exp: 0},
+		{name: "diff assets, more unit price", order1: bidOrder(3, 7), order2: askOrder(2, 4), exp: 1},
+		{name: "two asks", order1: askOrder(7, 15), order2: askOrder(5, 11), exp: -1},
+		{name: "two bids", order1: bidOrder(7, 16), order2: bidOrder(5, 11), exp: 1},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual int
+			testFunc := func() {
+				actual = CompareUnitPrices(tc.order1, tc.order2)
+			}
+			require.NotPanics(t, testFunc, "CompareUnitPrices")
+			assert.Equal(t, tc.exp, actual, "CompareUnitPrices result")
+		})
+	}
+}
+
 func TestValidateOrderIDs(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -1026,6 +1080,408 @@ func TestOrder_GetExternalID(t *testing.T) {
 	}
 }
 
+func TestOrder_GetExpiration(t *testing.T) {
+	askExp := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	bidExp := time.Date(2031, 2, 3, 4, 5, 6, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		order    *Order
+		expected *time.Time
+		expPanic string
+	}{
+		{
+			name:     "AskOrder",
+			order:    NewOrder(1).WithAsk(&AskOrder{Expiration: &askExp}),
+			expected: &askExp,
+		},
+		{
+			name:     "BidOrder",
+			order:    NewOrder(2).WithBid(&BidOrder{Expiration: &bidExp}),
+			expected: &bidExp,
+		},
+		{
+			name:     "AskOrder without expiration",
+			order:    NewOrder(3).WithAsk(&AskOrder{}),
+			expected: nil,
+		},
+		{
+			name:     "nil inside order",
+			order:    NewOrder(4),
+			expPanic: nilSubTypeErr(4),
+		},
+		{
+			name:     "unknown order type",
+			order:    newUnknownOrder(5),
+			expPanic: unknownSubTypeErr(5),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual *time.Time
+			testFunc := func() {
+				actual = tc.order.GetExpiration()
+			}
+			assertions.RequirePanicEquals(t, testFunc, tc.expPanic, "GetExpiration()")
+			assert.Equal(t, tc.expected, actual, "GetExpiration() result")
+		})
+	}
+}
+
+func TestOrder_GetDisplayAssets(t *testing.T) {
+	askDisplay := sdk.NewInt64Coin("apple", 3)
+	bidDisplay := sdk.NewInt64Coin("banana", 4)
+
+	tests := []struct {
+		name     string
+		order    *Order
+		expected *sdk.Coin
+		expPanic string
+	}{
+		{
+			name:     "AskOrder",
+			order:    NewOrder(1).WithAsk(&AskOrder{DisplayAssets: &askDisplay}),
+			expected: &askDisplay,
+		},
+		{
+			name:     "BidOrder",
+			order:    NewOrder(2).WithBid(&BidOrder{DisplayAssets: &bidDisplay}),
+			expected: &bidDisplay,
+		},
+		{
+			name:     "AskOrder without display assets",
+			order:    NewOrder(3).WithAsk(&AskOrder{}),
+			expected: nil,
+		},
+		{
+			name:     "nil inside order",
+			order:    NewOrder(4),
+			expPanic: nilSubTypeErr(4),
+		},
+		{
+			name:     "unknown order type",
+			order:    newUnknownOrder(5),
+			expPanic: unknownSubTypeErr(5),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual *sdk.Coin
+			testFunc := func() {
+				actual = tc.order.GetDisplayAssets()
+			}
+			assertions.RequirePanicEquals(t, testFunc, tc.expPanic, "GetDisplayAssets()")
+			assert.Equal(t, tc.expected, actual, "GetDisplayAssets() result")
+		})
+	}
+}
+
+func TestOrder_GetReferrer(t *testing.T) {
+	tests := []struct {
+		name     string
+		order    *Order
+		expected string
+		expPanic string
+	}{
+		{
+			name:     "AskOrder",
+			order:    NewOrder(1).WithAsk(&AskOrder{Referrer: "askreferrer"}),
+			expected: "askreferrer",
+		},
+		{
+			name:     "BidOrder",
+			order:    NewOrder(2).WithBid(&BidOrder{Referrer: "bidreferrer"}),
+			expected: "bidreferrer",
+		},
+		{
+			name:     "AskOrder without referrer",
+			order:    NewOrder(3).WithAsk(&AskOrder{}),
+			expected: "",
+		},
+		{
+			name:     "nil inside order",
+			order:    NewOrder(4),
+			expPanic: nilSubTypeErr(4),
+		},
+		{
+			name:     "unknown order type",
+			order:    newUnknownOrder(5),
+			expPanic: unknownSubTypeErr(5),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual string
+			testFunc := func() {
+				actual = tc.order.GetReferrer()
+			}
+			assertions.RequirePanicEquals(t, testFunc, tc.expPanic, "GetReferrer()")
+			assert.Equal(t, tc.expected, actual, "GetReferrer() result")
+		})
+	}
+}
+
+func TestOrder_GetBasketAssets(t *testing.T) {
+	basket := sdk.NewCoins(sdk.NewInt64Coin("banana", 2), sdk.NewInt64Coin("cherry", 3))
+
+	tests := []struct {
+		name     string
+		order    *Order
+		expected sdk.Coins
+		expPanic string
+	}{
+		{
+			name:     "AskOrder",
+			order:    NewOrder(1).WithAsk(&AskOrder{BasketAssets: basket}),
+			expected: basket,
+		},
+		{
+			name:     "BidOrder",
+			order:    NewOrder(2).WithBid(&BidOrder{BasketAssets: basket}),
+			expected: basket,
+		},
+		{
+			name:     "AskOrder without basket",
+			order:    NewOrder(3).WithAsk(&AskOrder{}),
+			expected: nil,
+		},
+		{
+			name:     "nil inside order",
+			order:    NewOrder(4),
+			expPanic: nilSubTypeErr(4),
+		},
+		{
+			name:     "unknown order type",
+			order:    newUnknownOrder(5),
+			expPanic: unknownSubTypeErr(5),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual sdk.Coins
+			testFunc := func() {
+				actual = tc.order.GetBasketAssets()
+			}
+			assertions.RequirePanicEquals(t, testFunc, tc.expPanic, "GetBasketAssets()")
+			assert.Equal(t, tc.expected, actual, "GetBasketAssets() result")
+		})
+	}
+}
+
+func TestOrder_IsBasketOrder(t *testing.T) {
+	basket := sdk.NewCoins(sdk.NewInt64Coin("banana", 2))
+
+	tests := []struct {
+		name     string
+		order    *Order
+		expected bool
+		expPanic string
+	}{
+		{
+			name:     "AskOrder with basket",
+			order:    NewOrder(1).WithAsk(&AskOrder{BasketAssets: basket}),
+			expected: true,
+		},
+		{
+			name:     "AskOrder without basket",
+			order:    NewOrder(2).WithAsk(&AskOrder{}),
+			expected: false,
+		},
+		{
+			name:     "BidOrder with basket",
+			order:    NewOrder(3).WithBid(&BidOrder{BasketAssets: basket}),
+			expected: true,
+		},
+		{
+			name:     "BidOrder with empty basket",
+			order:    NewOrder(4).WithBid(&BidOrder{BasketAssets: sdk.Coins{}}),
+			expected: false,
+		},
+		{
+			name:     "nil inside order",
+			order:    NewOrder(5),
+			expPanic: nilSubTypeErr(5),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual bool
+			testFunc := func() {
+				actual = tc.order.IsBasketOrder()
+			}
+			assertions.RequirePanicEquals(t, testFunc, tc.expPanic, "IsBasketOrder()")
+			assert.Equal(t, tc.expected, actual, "IsBasketOrder() result")
+		})
+	}
+}
+
+func TestOrder_GetDisplayedAndHiddenAssets(t *testing.T) {
+	coinP := func(amount int64, denom string) *sdk.Coin {
+		rv := sdk.NewInt64Coin(denom, amount)
+		return &rv
+	}
+
+	tests := []struct {
+		name       string
+		order      *Order
+		expDisplay sdk.Coin
+		expHidden  sdk.Coin
+	}{
+		{
+			name:       "ask without display assets",
+			order:      NewOrder(1).WithAsk(&AskOrder{Assets: sdk.NewInt64Coin("apple", 10)}),
+			expDisplay: sdk.NewInt64Coin("apple", 10),
+			expHidden:  sdk.NewInt64Coin("apple", 0),
+		},
+		{
+			name:       "bid without display assets",
+			order:      NewOrder(2).WithBid(&BidOrder{Assets: sdk.NewInt64Coin("apple", 10)}),
+			expDisplay: sdk.NewInt64Coin("apple", 10),
+			expHidden:  sdk.NewInt64Coin("apple", 0),
+		},
+		{
+			name: "ask with display assets less than assets",
+			order: NewOrder(3).WithAsk(&AskOrder{
+				Assets: sdk.NewInt64Coin("apple", 10), DisplayAssets: coinP(3, "apple"),
+			}),
+			expDisplay: sdk.NewInt64Coin("apple", 3),
+			expHidden:  sdk.NewInt64Coin("apple", 7),
+		},
+		{
+			name: "bid with display assets less than assets",
+			order: NewOrder(4).WithBid(&BidOrder{
+				Assets: sdk.NewInt64Coin("apple", 10), DisplayAssets: coinP(4, "apple"),
+			}),
+			expDisplay: sdk.NewInt64Coin("apple", 4),
+			expHidden:  sdk.NewInt64Coin("apple", 6),
+		},
+		{
+			name: "display assets equal to assets",
+			order: NewOrder(5).WithAsk(&AskOrder{
+				Assets: sdk.NewInt64Coin("apple", 10), DisplayAssets: coinP(10, "apple"),
+			}),
+			expDisplay: sdk.NewInt64Coin("apple", 10),
+			expHidden:  sdk.NewInt64Coin("apple", 0),
+		},
+		{
+			name: "display assets more than remaining assets",
+			order: NewOrder(6).WithBid(&BidOrder{
+				Assets: sdk.NewInt64Coin("apple", 2), DisplayAssets: coinP(3, "apple"),
+			}),
+			expDisplay: sdk.NewInt64Coin("apple", 2),
+			expHidden:  sdk.NewInt64Coin("apple", 0),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var display, hidden sdk.Coin
+			testFunc := func() {
+				display = tc.order.GetDisplayedAssets()
+				hidden = tc.order.GetHiddenAssets()
+			}
+			require.NotPanics(t, testFunc, "GetDisplayedAssets() and GetHiddenAssets()")
+			assert.Equal(t, tc.expDisplay.String(), display.String(), "GetDisplayedAssets() result")
+			assert.Equal(t, tc.expHidden.String(), hidden.String(), "GetHiddenAssets() result")
+		})
+	}
+}
+
+func TestOrder_WithoutHiddenAssets(t *testing.T) {
+	coinP := func(amount int64, denom string) *sdk.Coin {
+		rv := sdk.NewInt64Coin(denom, amount)
+		return &rv
+	}
+	seller := sdk.AccAddress("seller______________").String()
+	buyer := sdk.AccAddress("buyer_______________").String()
+
+	tests := []struct {
+		name     string
+		order    *Order
+		expected *Order
+		expPanic string
+	}{
+		{
+			name: "ask without display assets",
+			order: NewOrder(1).WithAsk(&AskOrder{
+				MarketId: 1, Seller: seller, Assets: sdk.NewInt64Coin("apple", 10), Price: sdk.NewInt64Coin("peach", 50),
+			}),
+			expected: NewOrder(1).WithAsk(&AskOrder{
+				MarketId: 1, Seller: seller, Assets: sdk.NewInt64Coin("apple", 10), Price: sdk.NewInt64Coin("peach", 50),
+			}),
+		},
+		{
+			name: "ask with nothing hidden",
+			order: NewOrder(2).WithAsk(&AskOrder{
+				MarketId: 1, Seller: seller, Assets: sdk.NewInt64Coin("apple", 10), Price: sdk.NewInt64Coin("peach", 50),
+				AllowPartial: true, DisplayAssets: coinP(10, "apple"),
+			}),
+			expected: NewOrder(2).WithAsk(&AskOrder{
+				MarketId: 1, Seller: seller, Assets: sdk.NewInt64Coin("apple", 10), Price: sdk.NewInt64Coin("peach", 50),
+				AllowPartial: true, DisplayAssets: coinP(10, "apple"),
+			}),
+		},
+		{
+			name: "ask with some hidden",
+			order: NewOrder(3).WithAsk(&AskOrder{
+				MarketId: 1, Seller: seller, Assets: sdk.NewInt64Coin("apple", 10), Price: sdk.NewInt64Coin("peach", 55),
+				SellerSettlementFlatFee: coinP(7, "fig"), AllowPartial: true, ExternalId: "ice",
+				DisplayAssets: coinP(3, "apple"),
+			}),
+			expected: NewOrder(3).WithAsk(&AskOrder{
+				MarketId: 1, Seller: seller, Assets: sdk.NewInt64Coin("apple", 3), Price: sdk.NewInt64Coin("peach", 16),
+				SellerSettlementFlatFee: coinP(2, "fig"), AllowPartial: true, ExternalId: "ice",
+				DisplayAssets: coinP(3, "apple"),
+			}),
+		},
+		{
+			name: "ask with fee that rounds to zero",
+			order: NewOrder(4).WithAsk(&AskOrder{
+				MarketId: 1, Seller: seller, Assets: sdk.NewInt64Coin("apple", 10), Price: sdk.NewInt64Coin("peach", 50),
+				SellerSettlementFlatFee: coinP(2, "fig"), AllowPartial: true, DisplayAssets: coinP(1, "apple"),
+			}),
+			expected: NewOrder(4).WithAsk(&AskOrder{
+				MarketId: 1, Seller: seller, Assets: sdk.NewInt64Coin("apple", 1), Price: sdk.NewInt64Coin("peach", 5),
+				AllowPartial: true, DisplayAssets: coinP(1, "apple"),
+			}),
+		},
+		{
+			name: "bid with some hidden",
+			order: NewOrder(5).WithBid(&BidOrder{
+				MarketId: 2, Buyer: buyer, Assets: sdk.NewInt64Coin("apple", 10), Price: sdk.NewInt64Coin("peach", 55),
+				BuyerSettlementFees: sdk.NewCoins(sdk.NewInt64Coin("fig", 21), sdk.NewInt64Coin("grape", 9)),
+				AllowPartial:        true, DisplayAssets: coinP(4, "apple"),
+			}),
+			expected: NewOrder(5).WithBid(&BidOrder{
+				MarketId: 2, Buyer: buyer, Assets: sdk.NewInt64Coin("apple", 4), Price: sdk.NewInt64Coin("peach", 22),
+				BuyerSettlementFees: sdk.NewCoins(sdk.NewInt64Coin("fig", 8), sdk.NewInt64Coin("grape", 3)),
+				AllowPartial:        true, DisplayAssets: coinP(4, "apple"),
+			}),
+		},
+		{
+			name:     "unknown order type",
+			order:    newUnknownOrder(6),
+			expPanic: unknownSubTypeErr(6),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual *Order
+			testFunc := func() {
+				actual = tc.order.WithoutHiddenAssets()
+			}
+			assertions.RequirePanicEquals(t, testFunc, tc.expPanic, "WithoutHiddenAssets()")
+			assert.Equal(t, tc.expected, actual, "WithoutHiddenAssets() result")
+		})
+	}
+}
+
 func TestOrder_GetOrderType(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -1585,9 +2041,33 @@ func TestAskOrder_GetExternalID(t *testing.T) {
 	}
 }
 
-func TestAskOrder_GetOrderType(t *testing.T) {
-	expected := OrderTypeAsk
-	order := AskOrder{}
+func TestAskOrder_GetExpiration(t *testing.T) {
+	expiration := time.Unix(1_900_000_000, 0)
+
+	tests := []struct {
+		name  string
+		order AskOrder
+		exp   *time.Time
+	}{
+		{name: "not set", order: AskOrder{}, exp: nil},
+		{name: "set", order: AskOrder{Expiration: &expiration}, exp: &expiration},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual *time.Time
+			testFunc := func() {
+				actual = tc.order.GetExpiration()
+			}
+			require.NotPanics(t, testFunc, "GetExpiration()")
+			assert.Equal(t, tc.exp, actual, "GetExpiration() result")
+		})
+	}
+}
+
+func TestAskOrder_GetOrderType(t *testing.T) {
+	expected := OrderTypeAsk
+	order := AskOrder{}
 	var actual string
 	testFunc := func() {
 		actual = order.GetOrderType()
@@ -1641,6 +2121,21 @@ func TestAskOrder_GetHoldAmount(t *testing.T) {
 			},
 			exp: sdk.NewCoins(sdk.NewInt64Coin("acorn", 12)),
 		},
+		{
+			name: "with basket assets",
+			order: AskOrder{
+				Assets:                  sdk.NewInt64Coin("acorn", 12),
+				Price:                   sdk.NewInt64Coin("cucumber", 8),
+				SellerSettlementFlatFee: &sdk.Coin{Denom: "durian", Amount: sdkmath.NewInt(52)},
+				BasketAssets:            sdk.NewCoins(sdk.NewInt64Coin("banana", 3), sdk.NewInt64Coin("eggplant", 4)),
+			},
+			exp: sdk.NewCoins(
+				sdk.NewInt64Coin("acorn", 12),
+				sdk.NewInt64Coin("banana", 3),
+				sdk.NewInt64Coin("durian", 52),
+				sdk.NewInt64Coin("eggplant", 4),
+			),
+		},
 	}
 
 	for _, tc := range tests {
@@ -1656,9 +2151,13 @@ func TestAskOrder_GetHoldAmount(t *testing.T) {
 }
 
 func TestAskOrder_Validate(t *testing.T) {
+	scopeID := metadatatypes.ScopeMetadataAddress(uuid.MustParse("c9f1b3d4-5a6e-4f7a-8b9c-0d1e2f3a4b5c"))
+	sessionID := metadatatypes.SessionMetadataAddress(uuid.MustParse("c9f1b3d4-5a6e-4f7a-8b9c-0d1e2f3a4b5c"), uuid.MustParse("0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d"))
 	coin := func(amount int64, denom string) *sdk.Coin {
 		return &sdk.Coin{Denom: denom, Amount: sdkmath.NewInt(amount)}
 	}
+	future := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	epoch := time.Unix(0, 0)
 
 	tests := []struct {
 		name  string
@@ -1821,6 +2320,46 @@ func TestAskOrder_Validate(t *testing.T) {
 			},
 			exp: []string{"invalid assets", "price denom farnsworth cannot also be the assets denom"},
 		},
+		{
+			name: "scope assets",
+			order: AskOrder{
+				MarketId: 1,
+				Seller:   sdk.AccAddress("another_address_____").String(),
+				Assets:   *coin(1, scopeID.Denom()),
+				Price:    *coin(42, "farnsworth"),
+			},
+			exp: nil,
+		},
+		{
+			name: "scope assets with amount more than one",
+			order: AskOrder{
+				MarketId: 1,
+				Seller:   sdk.AccAddress("another_address_____").String(),
+				Assets:   *coin(2, scopeID.Denom()),
+				Price:    *coin(42, "farnsworth"),
+			},
+			exp: []string{"invalid assets \"2" + scopeID.Denom() + "\": scope amount must be 1"},
+		},
+		{
+			name: "session assets",
+			order: AskOrder{
+				MarketId: 1,
+				Seller:   sdk.AccAddress("another_address_____").String(),
+				Assets:   *coin(1, sessionID.Denom()),
+				Price:    *coin(42, "farnsworth"),
+			},
+			exp: []string{"invalid assets: denom \"" + sessionID.Denom() + "\" is not for a scope"},
+		},
+		{
+			name: "invalid metadata denom assets",
+			order: AskOrder{
+				MarketId: 1,
+				Seller:   sdk.AccAddress("another_address_____").String(),
+				Assets:   *coin(1, "nft/scope1bogus"),
+				Price:    *coin(42, "farnsworth"),
+			},
+			exp: []string{"invalid assets: invalid metadata address in denom \"nft/scope1bogus\""},
+		},
 		{
 			name: "invalid seller settlement flat fee denom",
 			order: AskOrder{
@@ -1854,6 +2393,176 @@ func TestAskOrder_Validate(t *testing.T) {
 			},
 			exp: []string{"invalid seller settlement flat fee", "negative coin amount: -3"},
 		},
+		{
+			name: "with expiration",
+			order: AskOrder{
+				MarketId:     1,
+				Seller:       sdk.AccAddress("control_address_____").String(),
+				Assets:       *coin(99, "bender"),
+				Price:        *coin(42, "farnsworth"),
+				AllowPartial: true,
+				Expiration:   &future,
+			},
+			exp: nil,
+		},
+		{
+			name: "expiration at epoch",
+			order: AskOrder{
+				MarketId:   1,
+				Seller:     sdk.AccAddress("control_address_____").String(),
+				Assets:     *coin(99, "bender"),
+				Price:      *coin(42, "farnsworth"),
+				Expiration: &epoch,
+			},
+			exp: []string{"invalid expiration 1970-01-01T00:00:00Z: must be after 1970-01-01T00:00:00Z"},
+		},
+		{
+			name: "with display assets",
+			order: AskOrder{
+				MarketId:      1,
+				Seller:        sdk.AccAddress("control_address_____").String(),
+				Assets:        *coin(99, "bender"),
+				Price:         *coin(42, "farnsworth"),
+				AllowPartial:  true,
+				DisplayAssets: coin(10, "bender"),
+			},
+			exp: nil,
+		},
+		{
+			name: "zero display assets",
+			order: AskOrder{
+				MarketId:      1,
+				Seller:        sdk.AccAddress("control_address_____").String(),
+				Assets:        *coin(99, "bender"),
+				Price:         *coin(42, "farnsworth"),
+				AllowPartial:  true,
+				DisplayAssets: coin(0, "bender"),
+			},
+			exp: []string{"invalid display assets: cannot be zero"},
+		},
+		{
+			name: "display assets with different denom",
+			order: AskOrder{
+				MarketId:      1,
+				Seller:        sdk.AccAddress("control_address_____").String(),
+				Assets:        *coin(99, "bender"),
+				Price:         *coin(42, "farnsworth"),
+				AllowPartial:  true,
+				DisplayAssets: coin(10, "leela"),
+			},
+			exp: []string{"invalid display assets \"10leela\": denom must be the same as the assets denom \"bender\""},
+		},
+		{
+			name: "display assets without allow partial",
+			order: AskOrder{
+				MarketId:      1,
+				Seller:        sdk.AccAddress("control_address_____").String(),
+				Assets:        *coin(99, "bender"),
+				Price:         *coin(42, "farnsworth"),
+				DisplayAssets: coin(10, "bender"),
+			},
+			exp: []string{"invalid display assets \"10bender\": not allowed unless partial fulfillment is allowed"},
+		},
+		{
+			name: "with referrer",
+			order: AskOrder{
+				MarketId: 1,
+				Seller:   sdk.AccAddress("control_address_____").String(),
+				Assets:   *coin(99, "bender"),
+				Price:    *coin(42, "farnsworth"),
+				Referrer: sdk.AccAddress("referrer_address____").String(),
+			},
+			exp: nil,
+		},
+		{
+			name: "invalid referrer",
+			order: AskOrder{
+				MarketId: 1,
+				Seller:   sdk.AccAddress("control_address_____").String(),
+				Assets:   *coin(99, "bender"),
+				Price:    *coin(42, "farnsworth"),
+				Referrer: "bad",
+			},
+			exp: []string{"invalid referrer \"bad\": decoding bech32 failed: invalid bech32 string length 3"},
+		},
+		{
+			name: "referrer is seller",
+			order: AskOrder{
+				MarketId: 1,
+				Seller:   sdk.AccAddress("control_address_____").String(),
+				Assets:   *coin(99, "bender"),
+				Price:    *coin(42, "farnsworth"),
+				Referrer: sdk.AccAddress("control_address_____").String(),
+			},
+			exp: []string{"invalid referrer \"" + sdk.AccAddress("control_address_____").String() + "\": cannot be the order's owner"},
+		},
+		{
+			name: "basket assets",
+			order: AskOrder{
+				MarketId:     1,
+				Seller:       sdk.AccAddress("control_address_____").String(),
+				Assets:       *coin(99, "bender"),
+				Price:        *coin(42, "farnsworth"),
+				BasketAssets: sdk.NewCoins(*coin(2, "fry"), *coin(3, "leela")),
+			},
+			exp: nil,
+		},
+		{
+			name: "basket assets with allow partial",
+			order: AskOrder{
+				MarketId:     1,
+				Seller:       sdk.AccAddress("control_address_____").String(),
+				Assets:       *coin(99, "bender"),
+				Price:        *coin(42, "farnsworth"),
+				BasketAssets: sdk.Coins{*coin(2, "fry")},
+				AllowPartial: true,
+			},
+			exp: []string{"invalid basket assets \"2fry\": not allowed when partial fulfillment is allowed"},
+		},
+		{
+			name: "basket assets with assets denom",
+			order: AskOrder{
+				MarketId:     1,
+				Seller:       sdk.AccAddress("control_address_____").String(),
+				Assets:       *coin(99, "bender"),
+				Price:        *coin(42, "farnsworth"),
+				BasketAssets: sdk.Coins{*coin(2, "bender")},
+			},
+			exp: []string{"invalid basket assets \"2bender\": cannot contain the assets denom \"bender\""},
+		},
+		{
+			name: "basket assets with price denom",
+			order: AskOrder{
+				MarketId:     1,
+				Seller:       sdk.AccAddress("control_address_____").String(),
+				Assets:       *coin(99, "bender"),
+				Price:        *coin(42, "farnsworth"),
+				BasketAssets: sdk.Coins{*coin(2, "farnsworth")},
+			},
+			exp: []string{"invalid basket assets \"2farnsworth\": cannot contain the price denom \"farnsworth\""},
+		},
+		{
+			name: "basket assets with zero amount",
+			order: AskOrder{
+				MarketId:     1,
+				Seller:       sdk.AccAddress("control_address_____").String(),
+				Assets:       *coin(99, "bender"),
+				Price:        *coin(42, "farnsworth"),
+				BasketAssets: sdk.Coins{*coin(0, "fry")},
+			},
+			exp: []string{"invalid basket assets \"0fry\": coin 0fry amount is not positive"},
+		},
+		{
+			name: "basket assets not sorted",
+			order: AskOrder{
+				MarketId:     1,
+				Seller:       sdk.AccAddress("control_address_____").String(),
+				Assets:       *coin(99, "bender"),
+				Price:        *coin(42, "farnsworth"),
+				BasketAssets: sdk.Coins{*coin(3, "leela"), *coin(2, "fry")},
+			},
+			exp: []string{"invalid basket assets \"3leela,2fry\": denomination fry is not sorted"},
+		},
 		{
 			name: "multiple problems",
 			order: AskOrder{
@@ -1887,6 +2596,9 @@ func TestAskOrder_CopyChange(t *testing.T) {
 		return &rv
 	}
 
+	expiration := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	display := coin(3, "apple")
+
 	tests := []struct {
 		name      string
 		order     AskOrder
@@ -1895,6 +2607,86 @@ func TestAskOrder_CopyChange(t *testing.T) {
 		newFee    *sdk.Coin
 		expected  *AskOrder
 	}{
+		{
+			name: "with expiration",
+			order: AskOrder{
+				MarketId:   3,
+				Seller:     "sseelleerr",
+				Assets:     coin(8, "apple"),
+				Price:      coin(56, "peach"),
+				ExternalId: "extid",
+				Expiration: &expiration,
+			},
+			newAssets: coin(2, "apple"),
+			newPrice:  coin(14, "peach"),
+			expected: &AskOrder{
+				MarketId:   3,
+				Seller:     "sseelleerr",
+				Assets:     coin(2, "apple"),
+				Price:      coin(14, "peach"),
+				ExternalId: "extid",
+				Expiration: &expiration,
+			},
+		},
+		{
+			name: "with display assets",
+			order: AskOrder{
+				MarketId:      3,
+				Seller:        "sseelleerr",
+				Assets:        coin(8, "apple"),
+				Price:         coin(56, "peach"),
+				AllowPartial:  true,
+				DisplayAssets: &display,
+			},
+			newAssets: coin(2, "apple"),
+			newPrice:  coin(14, "peach"),
+			expected: &AskOrder{
+				MarketId:      3,
+				Seller:        "sseelleerr",
+				Assets:        coin(2, "apple"),
+				Price:         coin(14, "peach"),
+				AllowPartial:  true,
+				DisplayAssets: &display,
+			},
+		},
+		{
+			name: "with referrer",
+			order: AskOrder{
+				MarketId: 3,
+				Seller:   "sseelleerr",
+				Assets:   coin(8, "apple"),
+				Price:    coin(56, "peach"),
+				Referrer: "rreeffeerrrreerr",
+			},
+			newAssets: coin(2, "apple"),
+			newPrice:  coin(14, "peach"),
+			expected: &AskOrder{
+				MarketId: 3,
+				Seller:   "sseelleerr",
+				Assets:   coin(2, "apple"),
+				Price:    coin(14, "peach"),
+				Referrer: "rreeffeerrrreerr",
+			},
+		},
+		{
+			name: "with basket assets",
+			order: AskOrder{
+				MarketId:     3,
+				Seller:       "sseelleerr",
+				Assets:       coin(8, "apple"),
+				Price:        coin(56, "peach"),
+				BasketAssets: sdk.NewCoins(coin(2, "banana"), coin(3, "cherry")),
+			},
+			newAssets: coin(8, "apple"),
+			newPrice:  coin(60, "peach"),
+			expected: &AskOrder{
+				MarketId:     3,
+				Seller:       "sseelleerr",
+				Assets:       coin(8, "apple"),
+				Price:        coin(60, "peach"),
+				BasketAssets: sdk.NewCoins(coin(2, "banana"), coin(3, "cherry")),
+			},
+		},
 		{
 			name: "new assets",
 			order: AskOrder{
@@ -2195,6 +2987,30 @@ func TestBidOrder_GetExternalID(t *testing.T) {
 	}
 }
 
+func TestBidOrder_GetExpiration(t *testing.T) {
+	expiration := time.Unix(1_900_000_000, 0)
+
+	tests := []struct {
+		name  string
+		order BidOrder
+		exp   *time.Time
+	}{
+		{name: "not set", order: BidOrder{}, exp: nil},
+		{name: "set", order: BidOrder{Expiration: &expiration}, exp: &expiration},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual *time.Time
+			testFunc := func() {
+				actual = tc.order.GetExpiration()
+			}
+			require.NotPanics(t, testFunc, "GetExpiration()")
+			assert.Equal(t, tc.exp, actual, "GetExpiration() result")
+		})
+	}
+}
+
 func TestBidOrder_GetOrderType(t *testing.T) {
 	expected := OrderTypeBid
 	order := BidOrder{}
@@ -2269,9 +3085,13 @@ func TestBidOrder_GetHoldAmount(t *testing.T) {
 }
 
 func TestBidOrder_Validate(t *testing.T) {
+	scopeID := metadatatypes.ScopeMetadataAddress(uuid.MustParse("c9f1b3d4-5a6e-4f7a-8b9c-0d1e2f3a4b5c"))
+	sessionID := metadatatypes.SessionMetadataAddress(uuid.MustParse("c9f1b3d4-5a6e-4f7a-8b9c-0d1e2f3a4b5c"), uuid.MustParse("0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d"))
 	coin := func(amount int64, denom string) sdk.Coin {
 		return sdk.Coin{Denom: denom, Amount: sdkmath.NewInt(amount)}
 	}
+	future := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	epoch := time.Unix(0, 0)
 	coins := func(coins string) sdk.Coins {
 		rv, err := sdk.ParseCoinsNormalized(coins)
 		require.NoError(t, err, "sdk.ParseCoinsNormalized(%q)", coins)
@@ -2451,6 +3271,46 @@ func TestBidOrder_Validate(t *testing.T) {
 			},
 			exp: []string{"invalid assets", "price denom farnsworth cannot also be the assets denom"},
 		},
+		{
+			name: "scope assets",
+			order: BidOrder{
+				MarketId: 1,
+				Buyer:    sdk.AccAddress("another_address_____").String(),
+				Assets:   coin(1, scopeID.Denom()),
+				Price:    coin(42, "farnsworth"),
+			},
+			exp: nil,
+		},
+		{
+			name: "scope assets with amount more than one",
+			order: BidOrder{
+				MarketId: 1,
+				Buyer:    sdk.AccAddress("another_address_____").String(),
+				Assets:   coin(2, scopeID.Denom()),
+				Price:    coin(42, "farnsworth"),
+			},
+			exp: []string{"invalid assets \"2" + scopeID.Denom() + "\": scope amount must be 1"},
+		},
+		{
+			name: "session assets",
+			order: BidOrder{
+				MarketId: 1,
+				Buyer:    sdk.AccAddress("another_address_____").String(),
+				Assets:   coin(1, sessionID.Denom()),
+				Price:    coin(42, "farnsworth"),
+			},
+			exp: []string{"invalid assets: denom \"" + sessionID.Denom() + "\" is not for a scope"},
+		},
+		{
+			name: "invalid metadata denom assets",
+			order: BidOrder{
+				MarketId: 1,
+				Buyer:    sdk.AccAddress("another_address_____").String(),
+				Assets:   coin(1, "nft/scope1bogus"),
+				Price:    coin(42, "farnsworth"),
+			},
+			exp: []string{"invalid assets: invalid metadata address in denom \"nft/scope1bogus\""},
+		},
 		{
 			name: "invalid denom in buyer settlement fees",
 			order: BidOrder{
@@ -2473,6 +3333,176 @@ func TestBidOrder_Validate(t *testing.T) {
 			},
 			exp: []string{"invalid buyer settlement fees", "coin nibbler amount is not positive"},
 		},
+		{
+			name: "with expiration",
+			order: BidOrder{
+				MarketId:     1,
+				Buyer:        sdk.AccAddress("control_address_____").String(),
+				Assets:       coin(99, "bender"),
+				Price:        coin(42, "farnsworth"),
+				AllowPartial: true,
+				Expiration:   &future,
+			},
+			exp: nil,
+		},
+		{
+			name: "expiration at epoch",
+			order: BidOrder{
+				MarketId:   1,
+				Buyer:      sdk.AccAddress("control_address_____").String(),
+				Assets:     coin(99, "bender"),
+				Price:      coin(42, "farnsworth"),
+				Expiration: &epoch,
+			},
+			exp: []string{"invalid expiration 1970-01-01T00:00:00Z: must be after 1970-01-01T00:00:00Z"},
+		},
+		{
+			name: "with display assets",
+			order: BidOrder{
+				MarketId:      1,
+				Buyer:         sdk.AccAddress("control_address_____").String(),
+				Assets:        coin(99, "bender"),
+				Price:         coin(42, "farnsworth"),
+				AllowPartial:  true,
+				DisplayAssets: &sdk.Coin{Denom: "bender", Amount: sdkmath.NewInt(10)},
+			},
+			exp: nil,
+		},
+		{
+			name: "zero display assets",
+			order: BidOrder{
+				MarketId:      1,
+				Buyer:         sdk.AccAddress("control_address_____").String(),
+				Assets:        coin(99, "bender"),
+				Price:         coin(42, "farnsworth"),
+				AllowPartial:  true,
+				DisplayAssets: &sdk.Coin{Denom: "bender", Amount: sdkmath.NewInt(0)},
+			},
+			exp: []string{"invalid display assets: cannot be zero"},
+		},
+		{
+			name: "display assets with different denom",
+			order: BidOrder{
+				MarketId:      1,
+				Buyer:         sdk.AccAddress("control_address_____").String(),
+				Assets:        coin(99, "bender"),
+				Price:         coin(42, "farnsworth"),
+				AllowPartial:  true,
+				DisplayAssets: &sdk.Coin{Denom: "leela", Amount: sdkmath.NewInt(10)},
+			},
+			exp: []string{"invalid display assets \"10leela\": denom must be the same as the assets denom \"bender\""},
+		},
+		{
+			name: "display assets without allow partial",
+			order: BidOrder{
+				MarketId:      1,
+				Buyer:         sdk.AccAddress("control_address_____").String(),
+				Assets:        coin(99, "bender"),
+				Price:         coin(42, "farnsworth"),
+				DisplayAssets: &sdk.Coin{Denom: "bender", Amount: sdkmath.NewInt(10)},
+			},
+			exp: []string{"invalid display assets \"10bender\": not allowed unless partial fulfillment is allowed"},
+		},
+		{
+			name: "with referrer",
+			order: BidOrder{
+				MarketId: 1,
+				Buyer:    sdk.AccAddress("control_address_____").String(),
+				Assets:   coin(99, "bender"),
+				Price:    coin(42, "farnsworth"),
+				Referrer: sdk.AccAddress("referrer_address____").String(),
+			},
+			exp: nil,
+		},
+		{
+			name: "invalid referrer",
+			order: BidOrder{
+				MarketId: 1,
+				Buyer:    sdk.AccAddress("control_address_____").String(),
+				Assets:   coin(99, "bender"),
+				Price:    coin(42, "farnsworth"),
+				Referrer: "bad",
+			},
+			exp: []string{"invalid referrer \"bad\": decoding bech32 failed: invalid bech32 string length 3"},
+		},
+		{
+			name: "referrer is buyer",
+			order: BidOrder{
+				MarketId: 1,
+				Buyer:    sdk.AccAddress("control_address_____").String(),
+				Assets:   coin(99, "bender"),
+				Price:    coin(42, "farnsworth"),
+				Referrer: sdk.AccAddress("control_address_____").String(),
+			},
+			exp: []string{"invalid referrer \"" + sdk.AccAddress("control_address_____").String() + "\": cannot be the order's owner"},
+		},
+		{
+			name: "basket assets",
+			order: BidOrder{
+				MarketId:     1,
+				Buyer:        sdk.AccAddress("control_address_____").String(),
+				Assets:       coin(99, "bender"),
+				Price:        coin(42, "farnsworth"),
+				BasketAssets: sdk.NewCoins(coin(2, "fry"), coin(3, "leela")),
+			},
+			exp: nil,
+		},
+		{
+			name: "basket assets with allow partial",
+			order: BidOrder{
+				MarketId:     1,
+				Buyer:        sdk.AccAddress("control_address_____").String(),
+				Assets:       coin(99, "bender"),
+				Price:        coin(42, "farnsworth"),
+				BasketAssets: sdk.Coins{coin(2, "fry")},
+				AllowPartial: true,
+			},
+			exp: []string{"invalid basket assets \"2fry\": not allowed when partial fulfillment is allowed"},
+		},
+		{
+			name: "basket assets with assets denom",
+			order: BidOrder{
+				MarketId:     1,
+				Buyer:        sdk.AccAddress("control_address_____").String(),
+				Assets:       coin(99, "bender"),
+				Price:        coin(42, "farnsworth"),
+				BasketAssets: sdk.Coins{coin(2, "bender")},
+			},
+			exp: []string{"invalid basket assets \"2bender\": cannot contain the assets denom \"bender\""},
+		},
+		{
+			name: "basket assets with price denom",
+			order: BidOrder{
+				MarketId:     1,
+				Buyer:        sdk.AccAddress("control_address_____").String(),
+				Assets:       coin(99, "bender"),
+				Price:        coin(42, "farnsworth"),
+				BasketAssets: sdk.Coins{coin(2, "farnsworth")},
+			},
+			exp: []string{"invalid basket assets \"2farnsworth\": cannot contain the price denom \"farnsworth\""},
+		},
+		{
+			name: "basket assets with zero amount",
+			order: BidOrder{
+				MarketId:     1,
+				Buyer:        sdk.AccAddress("control_address_____").String(),
+				Assets:       coin(99, "bender"),
+				Price:        coin(42, "farnsworth"),
+				BasketAssets: sdk.Coins{coin(0, "fry")},
+			},
+			exp: []string{"invalid basket assets \"0fry\": coin 0fry amount is not positive"},
+		},
+		{
+			name: "basket assets not sorted",
+			order: BidOrder{
+				MarketId:     1,
+				Buyer:        sdk.AccAddress("control_address_____").String(),
+				Assets:       coin(99, "bender"),
+				Price:        coin(42, "farnsworth"),
+				BasketAssets: sdk.Coins{coin(3, "leela"), coin(2, "fry")},
+			},
+			exp: []string{"invalid basket assets \"3leela,2fry\": denomination fry is not sorted"},
+		},
 		{
 			name: "multiple problems",
 			order: BidOrder{
@@ -2502,6 +3532,9 @@ func TestBidOrder_CopyChange(t *testing.T) {
 		return sdk.Coin{Denom: denom, Amount: sdkmath.NewInt(amount)}
 	}
 
+	expiration := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	display := coin(3, "apple")
+
 	tests := []struct {
 		name      string
 		order     BidOrder
@@ -2510,6 +3543,86 @@ func TestBidOrder_CopyChange(t *testing.T) {
 		newFees   sdk.Coins
 		expected  *BidOrder
 	}{
+		{
+			name: "with expiration",
+			order: BidOrder{
+				MarketId:   3,
+				Buyer:      "bbuuyyeerr",
+				Assets:     coin(8, "apple"),
+				Price:      coin(56, "peach"),
+				ExternalId: "extid",
+				Expiration: &expiration,
+			},
+			newAssets: coin(2, "apple"),
+			newPrice:  coin(14, "peach"),
+			expected: &BidOrder{
+				MarketId:   3,
+				Buyer:      "bbuuyyeerr",
+				Assets:     coin(2, "apple"),
+				Price:      coin(14, "peach"),
+				ExternalId: "extid",
+				Expiration: &expiration,
+			},
+		},
+		{
+			name: "with display assets",
+			order: BidOrder{
+				MarketId:      3,
+				Buyer:         "bbuuyyeerr",
+				Assets:        coin(8, "apple"),
+				Price:         coin(56, "peach"),
+				AllowPartial:  true,
+				DisplayAssets: &display,
+			},
+			newAssets: coin(2, "apple"),
+			newPrice:  coin(14, "peach"),
+			expected: &BidOrder{
+				MarketId:      3,
+				Buyer:         "bbuuyyeerr",
+				Assets:        coin(2, "apple"),
+				Price:         coin(14, "peach"),
+				AllowPartial:  true,
+				DisplayAssets: &display,
+			},
+		},
+		{
+			name: "with referrer",
+			order: BidOrder{
+				MarketId: 3,
+				Buyer:    "bbuuyyeerr",
+				Assets:   coin(8, "apple"),
+				Price:    coin(56, "peach"),
+				Referrer: "rreeffeerrrreerr",
+			},
+			newAssets: coin(2, "apple"),
+			newPrice:  coin(14, "peach"),
+			expected: &BidOrder{
+				MarketId: 3,
+				Buyer:    "bbuuyyeerr",
+				Assets:   coin(2, "apple"),
+				Price:    coin(14, "peach"),
+				Referrer: "rreeffeerrrreerr",
+			},
+		},
+		{
+			name: "with basket assets",
+			order: BidOrder{
+				MarketId:     3,
+				Buyer:        "bbuuyyeerr",
+				Assets:       coin(8, "apple"),
+				Price:        coin(56, "peach"),
+				BasketAssets: sdk.NewCoins(coin(2, "banana"), coin(3, "cherry")),
+			},
+			newAssets: coin(8, "apple"),
+			newPrice:  coin(60, "peach"),
+			expected: &BidOrder{
+				MarketId:     3,
+				Buyer:        "bbuuyyeerr",
+				Assets:       coin(8, "apple"),
+				Price:        coin(60, "peach"),
+				BasketAssets: sdk.NewCoins(coin(2, "banana"), coin(3, "cherry")),
+			},
+		},
 		{
 			name: "new assets",
 			order: BidOrder{
@@ -2630,6 +3743,8 @@ func TestNewFilledOrder(t *testing.T) {
 }
 
 func TestFilledOrderGetters(t *testing.T) {
+	askExp := time.Date(2030, 3, 4, 5, 6, 7, 0, time.UTC)
+	bidExp := time.Date(2031, 3, 4, 5, 6, 7, 0, time.UTC)
 	askOrder := &AskOrder{
 		MarketId:                333,
 		Seller:                  "SEllER",
@@ -2638,6 +3753,8 @@ func TestFilledOrderGetters(t *testing.T) {
 		SellerSettlementFlatFee: &sdk.Coin{Denom: "fig", Amount: sdkmath.NewInt(8)},
 		AllowPartial:            true,
 		ExternalId:              "ask order abc",
+		Expiration:              &askExp,
+		Referrer:                "ASkREFERRER",
 	}
 	ask := NewOrder(51).WithAsk(askOrder)
 	askActualPrice := sdk.NewInt64Coin("peach", 123)
@@ -2652,6 +3769,8 @@ func TestFilledOrderGetters(t *testing.T) {
 		BuyerSettlementFees: sdk.NewCoins(sdk.NewInt64Coin("fig", 9)),
 		AllowPartial:        true,
 		ExternalId:          "bid order def",
+		Expiration:          &bidExp,
+		Referrer:            "BIdREFERRER",
 	}
 	bid := NewOrder(52).WithBid(bidOrder)
 	bidActualPrice := sdk.NewInt64Coin("peach", 124)
@@ -2742,6 +3861,24 @@ func TestFilledOrderGetters(t *testing.T) {
 			expAsk: askOrder.ExternalId,
 			expBid: bidOrder.ExternalId,
 		},
+		{
+			name:   "GetExpiration",
+			getter: func(of *FilledOrder) interface{} { return of.GetExpiration() },
+			expAsk: askOrder.Expiration,
+			expBid: bidOrder.Expiration,
+		},
+		{
+			name:   "GetDisplayAssets",
+			getter: func(of *FilledOrder) interface{} { return of.GetDisplayAssets() },
+			expAsk: askOrder.DisplayAssets,
+			expBid: bidOrder.DisplayAssets,
+		},
+		{
+			name:   "GetReferrer",
+			getter: func(of *FilledOrder) interface{} { return of.GetReferrer() },
+			expAsk: askOrder.Referrer,
+			expBid: bidOrder.Referrer,
+		},
 		{
 			name:   "GetOrderType",
 			getter: func(of *FilledOrder) interface{} { return of.GetOrderType() },
@@ -2784,3 +3921,208 @@ func TestFilledOrderGetters(t *testing.T) {
 		t.Run(tc.name+": bid", tester(tc.name, filledBid, tc.getter, tc.expBid))
 	}
 }
+
+func TestTriggerOrder_Validate(t *testing.T) {
+	seller := sdk.AccAddress("seller______________").String()
+	askOrder := NewOrder(3).WithAsk(&AskOrder{
+		MarketId: 1,
+		Seller:   seller,
+		Assets:   sdk.NewInt64Coin("apple", 10),
+		Price:    sdk.NewInt64Coin("nhash", 50),
+	})
+
+	tests := []struct {
+		name         string
+		triggerOrder *TriggerOrder
+		expErr       string
+	}{
+		{
+			name:         "okay",
+			triggerOrder: NewTriggerOrder(*askOrder, sdk.NewInt64Coin("nhash", 45)),
+		},
+		{
+			name:         "invalid order",
+			triggerOrder: NewTriggerOrder(*NewOrder(0).WithAsk(askOrder.GetAskOrder()), sdk.NewInt64Coin("nhash", 45)),
+			expErr:       "invalid order id: cannot be zero",
+		},
+		{
+			name:         "zero trigger price",
+			triggerOrder: NewTriggerOrder(*askOrder, sdk.NewInt64Coin("nhash", 0)),
+			expErr:       "invalid trigger price: cannot be zero",
+		},
+		{
+			name:         "trigger price with wrong denom",
+			triggerOrder: NewTriggerOrder(*askOrder, sdk.NewInt64Coin("apple", 45)),
+			expErr:       "invalid trigger price \"45apple\": denom must match order price denom \"nhash\"",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = tc.triggerOrder.Validate()
+			}
+			require.NotPanics(t, testFunc, "Validate")
+			assertions.AssertErrorValue(t, err, tc.expErr, "Validate error")
+		})
+	}
+}
+
+func TestTriggerOrder_IsTriggeredBy(t *testing.T) {
+	seller := sdk.AccAddress("seller______________").String()
+	buyer := sdk.AccAddress("buyer_______________").String()
+	// Both orders are for 10apple with a trigger price of 50nhash (i.e. 5nhash per apple).
+	askTrigger := NewTriggerOrder(*NewOrder(1).WithAsk(&AskOrder{
+		MarketId: 1, Seller: seller, Assets: sdk.NewInt64Coin("apple", 10), Price: sdk.NewInt64Coin("nhash", 40),
+	}), sdk.NewInt64Coin("nhash", 50))
+	bidTrigger := NewTriggerOrder(*NewOrder(2).WithBid(&BidOrder{
+		MarketId: 1, Buyer: buyer, Assets: sdk.NewInt64Coin("apple", 10), Price: sdk.NewInt64Coin("nhash", 60),
+	}), sdk.NewInt64Coin("nhash", 50))
+	coin := func(str string) sdk.Coin {
+		rv, err := sdk.ParseCoinNormalized(str)
+		require.NoError(t, err, "ParseCoinNormalized(%q)", str)
+		return rv
+	}
+	nav := func(assets, price string) NetAssetPrice {
+		return NetAssetPrice{Assets: coin(assets), Price: coin(price)}
+	}
+
+	tests := []struct {
+		name   string
+		nav    NetAssetPrice
+		expAsk bool
+		expBid bool
+	}{
+		{name: "different assets denom", nav: nav("3banana", "15nhash")},
+		{name: "different price denom", nav: nav("3apple", "15plum")},
+		{name: "zero assets", nav: NetAssetPrice{Assets: sdk.NewInt64Coin("apple", 0), Price: sdk.NewInt64Coin("nhash", 5)}},
+		{name: "below trigger", nav: nav("3apple", "14nhash"), expAsk: true},
+		{name: "at trigger", nav: nav("3apple", "15nhash"), expAsk: true, expBid: true},
+		{name: "above trigger", nav: nav("3apple", "16nhash"), expBid: true},
+		{name: "large volume at trigger", nav: nav("1000apple", "5000nhash"), expAsk: true, expBid: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actAsk, actBid bool
+			testFunc := func() {
+				actAsk = askTrigger.IsTriggeredBy(tc.nav)
+				actBid = bidTrigger.IsTriggeredBy(tc.nav)
+			}
+			require.NotPanics(t, testFunc, "IsTriggeredBy")
+			assert.Equal(t, tc.expAsk, actAsk, "ask IsTriggeredBy(%s for %s)", tc.nav.Price, tc.nav.Assets)
+			assert.Equal(t, tc.expBid, actBid, "bid IsTriggeredBy(%s for %s)", tc.nav.Price, tc.nav.Assets)
+		})
+	}
+}
+
+func TestTriggerOrder_WithoutHiddenAssets(t *testing.T) {
+	seller := sdk.AccAddress("seller______________").String()
+	display := sdk.NewInt64Coin("apple", 4)
+	askOrder := NewOrder(1).WithAsk(&AskOrder{
+		MarketId: 1, Seller: seller, Assets: sdk.NewInt64Coin("apple", 10), Price: sdk.NewInt64Coin("nhash", 40),
+	})
+	icebergOrder := NewOrder(2).WithAsk(&AskOrder{
+		MarketId: 1, Seller: seller, Assets: sdk.NewInt64Coin("apple", 10), Price: sdk.NewInt64Coin("nhash", 40),
+		AllowPartial: true, DisplayAssets: &display,
+	})
+
+	tests := []struct {
+		name     string
+		trigger  *TriggerOrder
+		expected *TriggerOrder
+	}{
+		{
+			name:     "nothing hidden",
+			trigger:  NewTriggerOrder(*askOrder, sdk.NewInt64Coin("nhash", 55)),
+			expected: NewTriggerOrder(*askOrder, sdk.NewInt64Coin("nhash", 55)),
+		},
+		{
+			name:    "some hidden",
+			trigger: NewTriggerOrder(*icebergOrder, sdk.NewInt64Coin("nhash", 55)),
+			expected: NewTriggerOrder(*NewOrder(2).WithAsk(&AskOrder{
+				MarketId: 1, Seller: seller, Assets: sdk.NewInt64Coin("apple", 4), Price: sdk.NewInt64Coin("nhash", 16),
+				AllowPartial: true, DisplayAssets: &display,
+			}), sdk.NewInt64Coin("nhash", 22)),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual *TriggerOrder
+			testFunc := func() {
+				actual = tc.trigger.WithoutHiddenAssets()
+			}
+			require.NotPanics(t, testFunc, "WithoutHiddenAssets()")
+			assert.Equal(t, tc.expected, actual, "WithoutHiddenAssets() result")
+		})
+	}
+}
+
+func TestNewOrderLink(t *testing.T) {
+	expected := &OrderLink{MarketId: 4, OrderId: 12, LinkedOrderId: 99}
+	var actual *OrderLink
+	testFunc := func() {
+		actual = NewOrderLink(4, 12, 99)
+	}
+	require.NotPanics(t, testFunc, "NewOrderLink")
+	assert.Equal(t, expected, actual, "NewOrderLink result")
+}
+
+func TestOrderLink_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		link   OrderLink
+		expErr string
+	}{
+		{
+			name: "okay",
+			link: OrderLink{MarketId: 1, OrderId: 2, LinkedOrderId: 3},
+		},
+		{
+			name: "okay: linked order id lower",
+			link: OrderLink{MarketId: 1, OrderId: 3, LinkedOrderId: 2},
+		},
+		{
+			name:   "zero market id",
+			link:   OrderLink{MarketId: 0, OrderId: 2, LinkedOrderId: 3},
+			expErr: "invalid market id: cannot be zero",
+		},
+		{
+			name:   "zero order id",
+			link:   OrderLink{MarketId: 1, OrderId: 0, LinkedOrderId: 3},
+			expErr: "invalid order id: cannot be zero",
+		},
+		{
+			name:   "zero linked order id",
+			link:   OrderLink{MarketId: 1, OrderId: 2, LinkedOrderId: 0},
+			expErr: "invalid linked order id: cannot be zero",
+		},
+		{
+			name:   "linked to itself",
+			link:   OrderLink{MarketId: 1, OrderId: 5, LinkedOrderId: 5},
+			expErr: "cannot link order 5 to itself",
+		},
+		{
+			name: "everything zero",
+			link: OrderLink{},
+			expErr: joinErrs(
+				"invalid market id: cannot be zero",
+				"invalid order id: cannot be zero",
+				"invalid linked order id: cannot be zero",
+			),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = tc.link.Validate()
+			}
+			require.NotPanics(t, testFunc, "Validate")
+			assertions.AssertErrorValue(t, err, tc.expErr, "Validate error")
+		})
+	}
+}
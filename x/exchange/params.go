@@ -16,6 +16,12 @@ const (
 	DefaultFeeCreatePaymentFlatAmount = int64(10_000_000_000)
 	// DefaultFeeAcceptPaymentFlatAmount is the default amount for accepting a payment. The denom is the chain's FeeDenom.
 	DefaultFeeAcceptPaymentFlatAmount = int64(8_000_000_000)
+	// DefaultSettlementHistoryBlocks is the default value used for the SettlementHistoryBlocks parameter.
+	DefaultSettlementHistoryBlocks = uint64(500_000)
+	// DefaultNAVHistoryBlocks is the default value used for the NAVHistoryBlocks parameter.
+	DefaultNAVHistoryBlocks = uint64(500_000)
+	// DefaultNAVHistoryMaxRecords is the default value used for the NAVHistoryMaxRecords parameter.
+	DefaultNAVHistoryMaxRecords = uint32(1_000)
 
 	// MaxSplit is the maximum split value. 10,000 basis points = 100%.
 	MaxSplit = uint32(10_000)
@@ -25,10 +31,13 @@ const (
 func DefaultParams() *Params {
 	feeDenom := pioconfig.GetProvenanceConfig().FeeDenom
 	return &Params{
-		DefaultSplit:         DefaultDefaultSplit,
-		DenomSplits:          nil,
-		FeeCreatePaymentFlat: []sdk.Coin{sdk.NewInt64Coin(feeDenom, DefaultFeeCreatePaymentFlatAmount)},
-		FeeAcceptPaymentFlat: []sdk.Coin{sdk.NewInt64Coin(feeDenom, DefaultFeeAcceptPaymentFlatAmount)},
+		DefaultSplit:            DefaultDefaultSplit,
+		DenomSplits:             nil,
+		FeeCreatePaymentFlat:    []sdk.Coin{sdk.NewInt64Coin(feeDenom, DefaultFeeCreatePaymentFlatAmount)},
+		FeeAcceptPaymentFlat:    []sdk.Coin{sdk.NewInt64Coin(feeDenom, DefaultFeeAcceptPaymentFlatAmount)},
+		SettlementHistoryBlocks: DefaultSettlementHistoryBlocks,
+		NavHistoryBlocks:        DefaultNAVHistoryBlocks,
+		NavHistoryMaxRecords:    DefaultNAVHistoryMaxRecords,
 	}
 }
 
@@ -41,6 +41,15 @@ type Params struct {
 	// If the target amount is not zero then one of these fee entries is required to accept the payment.
 	// This field is currently limited to zero or one entries.
 	FeeAcceptPaymentFlat []types.Coin `protobuf:"bytes,4,rep,name=fee_accept_payment_flat,json=feeAcceptPaymentFlat,proto3" json:"fee_accept_payment_flat"`
+	// settlement_history_blocks is the number of blocks that settlement records are kept for.
+	// If zero, settlement records are not kept.
+	SettlementHistoryBlocks uint64 `protobuf:"varint,5,opt,name=settlement_history_blocks,json=settlementHistoryBlocks,proto3" json:"settlement_history_blocks,omitempty"`
+	// nav_history_blocks is the number of blocks that NAV records are kept for.
+	// If zero, NAV records are not kept.
+	NavHistoryBlocks uint64 `protobuf:"varint,6,opt,name=nav_history_blocks,json=navHistoryBlocks,proto3" json:"nav_history_blocks,omitempty"`
+	// nav_history_max_records is the maximum number of NAV records kept for each asset and price denom pair.
+	// When a new one is recorded, the oldest ones beyond this limit are deleted. If zero, there is no limit.
+	NavHistoryMaxRecords uint32 `protobuf:"varint,7,opt,name=nav_history_max_records,json=navHistoryMaxRecords,proto3" json:"nav_history_max_records,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -104,6 +113,27 @@ func (m *Params) GetFeeAcceptPaymentFlat() []types.Coin {
 	return nil
 }
 
+func (m *Params) GetSettlementHistoryBlocks() uint64 {
+	if m != nil {
+		return m.SettlementHistoryBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetNavHistoryBlocks() uint64 {
+	if m != nil {
+		return m.NavHistoryBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetNavHistoryMaxRecords() uint32 {
+	if m != nil {
+		return m.NavHistoryMaxRecords
+	}
+	return 0
+}
+
 // DenomSplit associates a coin denomination with an amount the exchange receives for that denom.
 type DenomSplit struct {
 	// denom is the coin denomination this split applies to.
@@ -170,31 +200,35 @@ func init() {
 }
 
 var fileDescriptor_5d689cfc7a7422f1 = []byte{
-	// 372 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x3f, 0x4f, 0xfa, 0x40,
-	0x1c, 0xc6, 0x5b, 0xf8, 0xfd, 0x48, 0x38, 0x60, 0xb0, 0x21, 0x0a, 0x0c, 0x95, 0xc0, 0x42, 0x4c,
-	0xbc, 0x0b, 0xba, 0xb8, 0x0a, 0xc6, 0xb9, 0xc1, 0x4d, 0x87, 0xe6, 0x7a, 0x7c, 0x5b, 0x9a, 0xb4,
-	0x77, 0x4d, 0x7b, 0x10, 0x7c, 0x17, 0xbe, 0x0c, 0x47, 0x57, 0xdf, 0x01, 0x23, 0xa3, 0x93, 0x31,
-	0x30, 0xf8, 0x36, 0x4c, 0xef, 0xf8, 0x67, 0xa2, 0x83, 0x4b, 0xf3, 0xfd, 0x3e, 0x7d, 0xfa, 0x69,
-	0x9f, 0xa7, 0x87, 0xba, 0x49, 0x2a, 0x66, 0xc0, 0x29, 0x67, 0x40, 0x60, 0xce, 0x26, 0x94, 0x07,
-	0x40, 0x66, 0x7d, 0x92, 0xd0, 0x94, 0xc6, 0x19, 0x4e, 0x52, 0x21, 0x85, 0x75, 0xbc, 0x37, 0xe1,
-	0xad, 0x09, 0xcf, 0xfa, 0xad, 0x23, 0x1a, 0x87, 0x5c, 0x10, 0x75, 0xd5, 0xd6, 0x56, 0x3d, 0x10,
-	0x81, 0x50, 0x23, 0xc9, 0xa7, 0x8d, 0x6a, 0x33, 0x91, 0xc5, 0x22, 0x23, 0x1e, 0xcd, 0x72, 0xba,
-	0x07, 0x92, 0xf6, 0x09, 0x13, 0x21, 0xd7, 0xf7, 0x3b, 0xaf, 0x05, 0x54, 0x72, 0xd4, 0x1b, 0xad,
-	0x2e, 0xaa, 0x8d, 0xc1, 0xa7, 0xd3, 0x48, 0xba, 0x59, 0x12, 0x85, 0xb2, 0x61, 0xb6, 0xcd, 0x5e,
-	0x6d, 0x54, 0xdd, 0x88, 0x77, 0xb9, 0x66, 0x39, 0xa8, 0x3a, 0x06, 0x2e, 0x62, 0x6d, 0xc9, 0x1a,
-	0x85, 0x76, 0xb1, 0x57, 0xb9, 0xe8, 0xe0, 0x9f, 0xbf, 0x13, 0xdf, 0xe4, 0x5e, 0xf5, 0xe4, 0xa0,
-	0xbc, 0x78, 0x3f, 0x35, 0x9e, 0x3f, 0x5f, 0xce, 0xcc, 0x51, 0x65, 0xbc, 0x93, 0x33, 0xeb, 0x01,
-	0x9d, 0xf8, 0x00, 0x2e, 0x4b, 0x81, 0x4a, 0x70, 0x13, 0xfa, 0x18, 0x03, 0x97, 0xae, 0x1f, 0x51,
-	0xd9, 0x28, 0x2a, 0x78, 0x13, 0xeb, 0x0c, 0x38, 0xcf, 0x80, 0x37, 0x19, 0xf0, 0x50, 0x84, 0xfc,
-	0x90, 0x59, 0xf7, 0x01, 0x86, 0x8a, 0xe1, 0x68, 0xc4, 0x6d, 0x44, 0xe5, 0x16, 0x4e, 0x19, 0x83,
-	0x44, 0x7e, 0x87, 0xff, 0xfb, 0x23, 0xfc, 0x5a, 0x31, 0x0e, 0xe0, 0x9d, 0x2b, 0x84, 0xf6, 0xf9,
-	0xac, 0x3a, 0xfa, 0xaf, 0x62, 0xa9, 0xda, 0xca, 0x23, 0xbd, 0xe4, 0xaa, 0x2e, 0xb3, 0xa0, 0xca,
-	0xd4, 0xcb, 0x00, 0x16, 0x2b, 0xdb, 0x5c, 0xae, 0x6c, 0xf3, 0x63, 0x65, 0x9b, 0x4f, 0x6b, 0xdb,
-	0x58, 0xae, 0x6d, 0xe3, 0x6d, 0x6d, 0x1b, 0xa8, 0x19, 0x8a, 0x5f, 0xba, 0x74, 0xcc, 0x7b, 0x1c,
-	0x84, 0x72, 0x32, 0xf5, 0x30, 0x13, 0x31, 0xd9, 0x9b, 0xce, 0x43, 0x71, 0xb0, 0x91, 0xf9, 0xee,
-	0x34, 0x79, 0x25, 0xf5, 0x8f, 0x2f, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x9c, 0x9c, 0x91, 0x75,
-	0x6b, 0x02, 0x00, 0x00,
+	// 448 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xc1, 0x6e, 0xd3, 0x4e,
+	0x10, 0xc6, 0xe3, 0xa6, 0xcd, 0x5f, 0xdd, 0xb6, 0xd2, 0x1f, 0x2b, 0x22, 0x4e, 0x0f, 0x26, 0x4a,
+	0x2f, 0x11, 0x82, 0x5d, 0x05, 0x84, 0x84, 0xb8, 0x91, 0x22, 0xc4, 0x05, 0x29, 0x32, 0x37, 0x38,
+	0x58, 0xe3, 0xcd, 0x24, 0xb1, 0xb0, 0x77, 0x2c, 0xef, 0xd6, 0x4a, 0xdf, 0x82, 0xc7, 0xe0, 0xc8,
+	0x63, 0xf4, 0xd8, 0x23, 0xe2, 0x80, 0x50, 0x72, 0xe0, 0x35, 0x90, 0x77, 0xd3, 0x3a, 0x45, 0x70,
+	0xe0, 0x62, 0xed, 0x7c, 0xf3, 0xcd, 0xcf, 0x1e, 0x7f, 0xcb, 0xce, 0x8a, 0x92, 0x2a, 0x54, 0xa0,
+	0x24, 0x0a, 0x5c, 0xc9, 0x25, 0xa8, 0x05, 0x8a, 0x6a, 0x2c, 0x0a, 0x28, 0x21, 0xd7, 0xbc, 0x28,
+	0xc9, 0x90, 0x7f, 0xbf, 0x31, 0xf1, 0x1b, 0x13, 0xaf, 0xc6, 0xa7, 0xf7, 0x20, 0x4f, 0x15, 0x09,
+	0xfb, 0x74, 0xd6, 0xd3, 0xee, 0x82, 0x16, 0x64, 0x8f, 0xa2, 0x3e, 0x6d, 0xd5, 0x50, 0x92, 0xce,
+	0x49, 0x8b, 0x04, 0x74, 0x4d, 0x4f, 0xd0, 0xc0, 0x58, 0x48, 0x4a, 0x95, 0xeb, 0x0f, 0xbf, 0xb5,
+	0x59, 0x67, 0x6a, 0xdf, 0xe8, 0x9f, 0xb1, 0x93, 0x19, 0xce, 0xe1, 0x22, 0x33, 0xb1, 0x2e, 0xb2,
+	0xd4, 0x04, 0xde, 0xc0, 0x1b, 0x9d, 0x44, 0xc7, 0x5b, 0xf1, 0x5d, 0xad, 0xf9, 0x53, 0x76, 0x3c,
+	0x43, 0x45, 0xb9, 0xb3, 0xe8, 0x60, 0x6f, 0xd0, 0x1e, 0x1d, 0x3d, 0x19, 0xf2, 0x3f, 0x7f, 0x27,
+	0x7f, 0x55, 0x7b, 0xed, 0xe4, 0xe4, 0xf0, 0xea, 0xfb, 0x83, 0xd6, 0xe7, 0x9f, 0x5f, 0x1e, 0x7a,
+	0xd1, 0xd1, 0xec, 0x56, 0xd6, 0xfe, 0x07, 0xd6, 0x9b, 0x23, 0xc6, 0xb2, 0x44, 0x30, 0x18, 0x17,
+	0x70, 0x99, 0xa3, 0x32, 0xf1, 0x3c, 0x03, 0x13, 0xb4, 0x2d, 0xbc, 0xcf, 0xdd, 0x0e, 0xbc, 0xde,
+	0x81, 0x6f, 0x77, 0xe0, 0xe7, 0x94, 0xaa, 0x5d, 0x66, 0x77, 0x8e, 0x78, 0x6e, 0x19, 0x53, 0x87,
+	0x78, 0x9d, 0x81, 0xb9, 0x81, 0x83, 0x94, 0x58, 0x98, 0xbb, 0xf0, 0xfd, 0x7f, 0x84, 0xbf, 0xb4,
+	0x8c, 0x5d, 0xf8, 0x0b, 0xd6, 0xd7, 0x68, 0x4c, 0x86, 0x16, 0xba, 0x4c, 0xb5, 0xa1, 0xf2, 0x32,
+	0x4e, 0x32, 0x92, 0x1f, 0x75, 0x70, 0x30, 0xf0, 0x46, 0xfb, 0x51, 0xaf, 0x31, 0xbc, 0x71, 0xfd,
+	0x89, 0x6d, 0xfb, 0x8f, 0x98, 0xaf, 0xa0, 0xfa, 0x7d, 0xa8, 0x63, 0x87, 0xfe, 0x57, 0x50, 0xdd,
+	0x75, 0x3f, 0x63, 0xbd, 0x5d, 0x77, 0x0e, 0xab, 0xb8, 0x44, 0x49, 0xe5, 0x4c, 0x07, 0xff, 0xd9,
+	0x90, 0xba, 0xcd, 0xc8, 0x5b, 0x58, 0x45, 0xae, 0x37, 0x7c, 0xce, 0x58, 0x13, 0x80, 0xdf, 0x65,
+	0x07, 0xf6, 0xbf, 0xdb, 0x5c, 0x0f, 0x23, 0x57, 0xd4, 0xaa, 0x4b, 0x7b, 0xcf, 0x82, 0x5c, 0x31,
+	0xc1, 0xab, 0x75, 0xe8, 0x5d, 0xaf, 0x43, 0xef, 0xc7, 0x3a, 0xf4, 0x3e, 0x6d, 0xc2, 0xd6, 0xf5,
+	0x26, 0x6c, 0x7d, 0xdd, 0x84, 0x2d, 0xd6, 0x4f, 0xe9, 0x2f, 0x61, 0x4f, 0xbd, 0xf7, 0x7c, 0x91,
+	0x9a, 0xe5, 0x45, 0xc2, 0x25, 0xe5, 0xa2, 0x31, 0x3d, 0x4e, 0x69, 0xa7, 0x12, 0xab, 0xdb, 0xeb,
+	0x9e, 0x74, 0xec, 0x25, 0x7c, 0xfa, 0x6b, 0x00, 0xa5, 0xf0, 0xa9, 0xef, 0x0c, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -217,6 +251,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NavHistoryMaxRecords != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.NavHistoryMaxRecords))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.NavHistoryBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.NavHistoryBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SettlementHistoryBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SettlementHistoryBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.FeeAcceptPaymentFlat) > 0 {
 		for iNdEx := len(m.FeeAcceptPaymentFlat) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -340,6 +389,15 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if m.SettlementHistoryBlocks != 0 {
+		n += 1 + sovParams(uint64(m.SettlementHistoryBlocks))
+	}
+	if m.NavHistoryBlocks != 0 {
+		n += 1 + sovParams(uint64(m.NavHistoryBlocks))
+	}
+	if m.NavHistoryMaxRecords != 0 {
+		n += 1 + sovParams(uint64(m.NavHistoryMaxRecords))
+	}
 	return n
 }
 
@@ -515,6 +573,63 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementHistoryBlocks", wireType)
+			}
+			m.SettlementHistoryBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementHistoryBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NavHistoryBlocks", wireType)
+			}
+			m.NavHistoryBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NavHistoryBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NavHistoryMaxRecords", wireType)
+			}
+			m.NavHistoryMaxRecords = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NavHistoryMaxRecords |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
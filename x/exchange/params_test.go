@@ -37,6 +37,9 @@ func TestDefaultParams(t *testing.T) {
 	if assert.Len(t, actual.FeeAcceptPaymentFlat, 1, "FeeAcceptPaymentFlat") {
 		assert.Equal(t, expAccept, actual.FeeAcceptPaymentFlat[0].String(), "FeeAcceptPaymentFlat[0]")
 	}
+	assert.Equal(t, DefaultSettlementHistoryBlocks, actual.SettlementHistoryBlocks, "SettlementHistoryBlocks")
+	assert.Equal(t, DefaultNAVHistoryBlocks, actual.NavHistoryBlocks, "NavHistoryBlocks")
+	assert.Equal(t, DefaultNAVHistoryMaxRecords, actual.NavHistoryMaxRecords, "NavHistoryMaxRecords")
 }
 
 func TestParams_Validate(t *testing.T) {
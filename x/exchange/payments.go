@@ -3,8 +3,37 @@ package exchange
 import (
 	"errors"
 	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	// MaxExpiredPaymentsPerBlock is the maximum number of expired payments that are cancelled at the end of a block.
+	// Any others are cancelled at the end of a later block.
+	MaxExpiredPaymentsPerBlock = 1_000
+	// MaxRecurringPaymentTransfersPerBlock is the maximum number of recurring payment transfers that are
+	// attempted at the end of a block. Any others are attempted at the end of a later block.
+	MaxRecurringPaymentTransfersPerBlock = 1_000
+	// MaxRecurringPaymentFailures is the number of consecutive failed transfers after which a recurring payment is cancelled.
+	MaxRecurringPaymentFailures = 3
+	// MaxMultiPartyPaymentParties is the maximum number of parties that a multi-party payment can have.
+	MaxMultiPartyPaymentParties = 20
+	// MaxAcceptPaymentsPerMsg is the maximum number of payments that can be accepted using a single MsgAcceptPaymentsRequest.
+	MaxAcceptPaymentsPerMsg = 100
+	// MaxPaymentDisputeWindowSeconds is the maximum dispute window that a payment can have (one year).
+	MaxPaymentDisputeWindowSeconds = 365 * 24 * 60 * 60
+	// MaxEscrowedPaymentReleasesPerBlock is the maximum number of escrowed payments that are released at the end of a block.
+	// Any others are released at the end of a later block.
+	MaxEscrowedPaymentReleasesPerBlock = 1_000
+	// EscrowedPaymentReleaseRetryDelay is how long to wait before retrying the release of an escrowed payment
+	// that failed to be released once. The wait doubles after each subsequent failure.
+	EscrowedPaymentReleaseRetryDelay = time.Hour
+	// MaxEscrowedPaymentReleaseRetryDelay is the longest wait between attempts to release an escrowed payment.
+	MaxEscrowedPaymentReleaseRetryDelay = 7 * 24 * time.Hour
 )
 
 // Validate returns an error if any of this Payment's info is invalid.
@@ -36,6 +65,15 @@ func (p Payment) Validate() error {
 		errs = append(errs, err)
 	}
 
+	if err := validateExpiration(p.Expiration); err != nil {
+		errs = append(errs, err)
+	}
+
+	if p.DisputeWindowSeconds > MaxPaymentDisputeWindowSeconds {
+		errs = append(errs, fmt.Errorf("invalid dispute window %d seconds: cannot be more than %d",
+			p.DisputeWindowSeconds, MaxPaymentDisputeWindowSeconds))
+	}
+
 	return errors.Join(errs...)
 }
 
@@ -66,3 +104,365 @@ func (p Payment) String() string {
 
 	return source + l + m + r + target
 }
+
+// Validate returns an error if any of this RecurringPayment's info is invalid.
+func (p RecurringPayment) Validate() error {
+	var errs []error
+	if _, err := sdk.AccAddressFromBech32(p.Source); err != nil {
+		errs = append(errs, fmt.Errorf("invalid source %q: %w", p.Source, err))
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Target); err != nil {
+		errs = append(errs, fmt.Errorf("invalid target %q: %w", p.Target, err))
+	}
+
+	if err := validateRecurringPaymentAmount(p.Amount); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := ValidateExternalID(p.ExternalId); err != nil {
+		errs = append(errs, err)
+	}
+
+	if p.IntervalSeconds == 0 {
+		errs = append(errs, errors.New("invalid interval: cannot be zero"))
+	}
+	if p.TransfersRemaining == 0 {
+		errs = append(errs, errors.New("invalid transfers remaining: cannot be zero"))
+	}
+	if p.NextTransferTime.Unix() <= 0 {
+		errs = append(errs, fmt.Errorf("invalid next transfer time %s: must be after %s",
+			p.NextTransferTime.UTC().Format(time.RFC3339), time.Unix(0, 0).UTC().Format(time.RFC3339)))
+	}
+	if p.Failures >= MaxRecurringPaymentFailures {
+		errs = append(errs, fmt.Errorf("invalid failures %d: must be less than %d", p.Failures, MaxRecurringPaymentFailures))
+	}
+
+	return errors.Join(errs...)
+}
+
+// Validate returns an error if any of this EscrowedPayment's info is invalid.
+func (p EscrowedPayment) Validate() error {
+	var errs []error
+	if err := p.Payment.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if len(p.Payment.Target) == 0 {
+		errs = append(errs, errors.New("invalid target: cannot be empty"))
+	}
+	if p.ReleaseTime.Unix() <= 0 {
+		errs = append(errs, fmt.Errorf("invalid release time %s: must be after %s",
+			p.ReleaseTime.UTC().Format(time.RFC3339), time.Unix(0, 0).UTC().Format(time.RFC3339)))
+	}
+	return errors.Join(errs...)
+}
+
+// GetNextReleaseAttempt returns the time at (or after) which the next attempt to release this escrowed payment is made.
+// That's the release time plus the waits that followed each failed release.
+func (p EscrowedPayment) GetNextReleaseAttempt() time.Time {
+	rv := p.ReleaseTime
+	wait := EscrowedPaymentReleaseRetryDelay
+	for i := uint32(0); i < p.FailedReleases; i++ {
+		rv = rv.Add(wait)
+		wait = min(2*wait, MaxEscrowedPaymentReleaseRetryDelay)
+	}
+	return rv
+}
+
+// validateRecurringPaymentAmount returns an error if the amount of a recurring payment is invalid.
+func validateRecurringPaymentAmount(amount sdk.Coins) error {
+	if amount.IsZero() {
+		return fmt.Errorf("invalid amount %q: cannot be zero", amount)
+	}
+	if err := amount.Validate(); err != nil {
+		return fmt.Errorf("invalid amount %q: %w", amount, err)
+	}
+	return nil
+}
+
+// Validate returns an error if any of this PaymentID's info is invalid.
+func (p PaymentID) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(p.Source); err != nil {
+		return fmt.Errorf("invalid source %q: %w", p.Source, err)
+	}
+	return ValidateExternalID(p.ExternalId)
+}
+
+// IsEmpty returns true if this filter does not have any criteria.
+func (f *PaymentFilter) IsEmpty() bool {
+	return f == nil || (len(f.Denom) == 0 && len(f.MinAmount) == 0 && len(f.MaxAmount) == 0 &&
+		f.TargetAmount == TargetAmountFilter_unspecified)
+}
+
+// GetAmountLimits parses this filter's min and max amounts. The result is nil for any that are not provided.
+func (f PaymentFilter) GetAmountLimits() (minAmount, maxAmount *sdkmath.Int, err error) {
+	parse := func(name, val string) (*sdkmath.Int, error) {
+		if len(val) == 0 {
+			return nil, nil
+		}
+		rv, ok := sdkmath.NewIntFromString(val)
+		if !ok {
+			return nil, fmt.Errorf("invalid %s amount %q: must be an integer", name, val)
+		}
+		if rv.IsNegative() {
+			return nil, fmt.Errorf("invalid %s amount %q: cannot be negative", name, val)
+		}
+		return &rv, nil
+	}
+
+	minAmount, err = parse("min", f.MinAmount)
+	if err != nil {
+		return nil, nil, err
+	}
+	maxAmount, err = parse("max", f.MaxAmount)
+	if err != nil {
+		return nil, nil, err
+	}
+	return minAmount, maxAmount, nil
+}
+
+// Validate returns an error if this filter has invalid criteria.
+func (f PaymentFilter) Validate() error {
+	var errs []error
+	if len(f.Denom) > 0 {
+		if err := sdk.ValidateDenom(f.Denom); err != nil {
+			errs = append(errs, fmt.Errorf("invalid denom %q: %w", f.Denom, err))
+		}
+	}
+
+	minAmount, maxAmount, err := f.GetAmountLimits()
+	switch {
+	case err != nil:
+		errs = append(errs, err)
+	case (minAmount != nil || maxAmount != nil) && len(f.Denom) == 0:
+		errs = append(errs, errors.New("a denom is required in order to filter by amount"))
+	case minAmount != nil && maxAmount != nil && minAmount.GT(*maxAmount):
+		errs = append(errs, fmt.Errorf("min amount %s cannot be more than max amount %s", minAmount, maxAmount))
+	}
+
+	if _, known := TargetAmountFilter_name[int32(f.TargetAmount)]; !known {
+		errs = append(errs, fmt.Errorf("unknown target amount filter %d", f.TargetAmount))
+	}
+
+	return errors.Join(errs...)
+}
+
+// Matches returns true if the provided payment meets all of this filter's criteria.
+// This assumes that the filter is valid.
+func (f PaymentFilter) Matches(payment *Payment) bool {
+	if payment == nil {
+		return false
+	}
+
+	switch f.TargetAmount {
+	case TargetAmountFilter_present:
+		if payment.TargetAmount.IsZero() {
+			return false
+		}
+	case TargetAmountFilter_absent:
+		if !payment.TargetAmount.IsZero() {
+			return false
+		}
+	}
+
+	if len(f.Denom) == 0 {
+		return true
+	}
+	found, coin := payment.SourceAmount.Find(f.Denom)
+	if !found {
+		return false
+	}
+	minAmount, maxAmount, _ := f.GetAmountLimits()
+	if minAmount != nil && coin.Amount.LT(*minAmount) {
+		return false
+	}
+	if maxAmount != nil && coin.Amount.GT(*maxAmount) {
+		return false
+	}
+	return true
+}
+
+// Validate returns an error if any of this MultiPartyPayment's info is invalid.
+func (p MultiPartyPayment) Validate() error {
+	var errs []error
+	if _, err := sdk.AccAddressFromBech32(p.Creator); err != nil {
+		errs = append(errs, fmt.Errorf("invalid creator %q: %w", p.Creator, err))
+	}
+	if err := ValidateExternalID(p.ExternalId); err != nil {
+		errs = append(errs, err)
+	}
+	if err := ValidatePaymentParties(p.Parties); err != nil {
+		errs = append(errs, err)
+	}
+	if len(p.Creator) > 0 && p.GetParty(p.Creator) == nil {
+		errs = append(errs, fmt.Errorf("creator %s is not one of the parties", p.Creator))
+	}
+	return errors.Join(errs...)
+}
+
+// GetParty returns the party with the provided address, or nil if there isn't one.
+func (p MultiPartyPayment) GetParty(addr string) *PaymentParty {
+	for i := range p.Parties {
+		if p.Parties[i].Address == addr {
+			return &p.Parties[i]
+		}
+	}
+	return nil
+}
+
+// AllAccepted returns true if every party has accepted this MultiPartyPayment.
+func (p MultiPartyPayment) AllAccepted() bool {
+	for _, party := range p.Parties {
+		if !party.Accepted {
+			return false
+		}
+	}
+	return true
+}
+
+// AllAcceptedExcept returns true if every party other than the one with the provided address has accepted this MultiPartyPayment.
+func (p MultiPartyPayment) AllAcceptedExcept(addr string) bool {
+	for _, party := range p.Parties {
+		if party.Address != addr && !party.Accepted {
+			return false
+		}
+	}
+	return true
+}
+
+// GetPartyAddrs gets the address of each party in this MultiPartyPayment.
+func (p MultiPartyPayment) GetPartyAddrs() []string {
+	rv := make([]string, len(p.Parties))
+	for i, party := range p.Parties {
+		rv[i] = party.Address
+	}
+	return rv
+}
+
+// GetTransfers gets the transfers needed to settle this MultiPartyPayment.
+// The bank module doesn't allow a transfer to have both multiple inputs and multiple outputs, so the send
+// amounts are matched up with the receive amounts (by denom, in party order) and there's one transfer per
+// party that sends funds. This assumes that the total send amount equals the total receive amount.
+func (p MultiPartyPayment) GetTransfers() []*Transfer {
+	// portion is an amount of a denom that a party is either sending or receiving.
+	type portion struct {
+		party int
+		amt   sdkmath.Int
+	}
+
+	var denoms []string
+	known := make(map[string]bool)
+	for _, party := range p.Parties {
+		for _, coin := range party.SendAmount {
+			if !known[coin.Denom] {
+				known[coin.Denom] = true
+				denoms = append(denoms, coin.Denom)
+			}
+		}
+	}
+
+	dists := make([]*IndexedAddrAmts, len(p.Parties))
+	for _, denom := range denoms {
+		var sends, receives []*portion
+		for i, party := range p.Parties {
+			if amt := party.SendAmount.AmountOf(denom); amt.IsPositive() {
+				sends = append(sends, &portion{party: i, amt: amt})
+			}
+			if amt := party.ReceiveAmount.AmountOf(denom); amt.IsPositive() {
+				receives = append(receives, &portion{party: i, amt: amt})
+			}
+		}
+
+		r := 0
+		for _, send := range sends {
+			for send.amt.IsPositive() && r < len(receives) {
+				amt := sdkmath.MinInt(send.amt, receives[r].amt)
+				if dists[send.party] == nil {
+					dists[send.party] = NewIndexedAddrAmts()
+				}
+				dists[send.party].Add(p.Parties[receives[r].party].Address, sdk.NewCoin(denom, amt))
+				send.amt = send.amt.Sub(amt)
+				receives[r].amt = receives[r].amt.Sub(amt)
+				if !receives[r].amt.IsPositive() {
+					r++
+				}
+			}
+		}
+	}
+
+	var rv []*Transfer
+	for i, party := range p.Parties {
+		if dists[i] == nil {
+			continue
+		}
+		rv = append(rv, &Transfer{
+			Inputs:  []banktypes.Input{{Address: party.Address, Coins: party.SendAmount}},
+			Outputs: dists[i].GetAsOutputs(),
+		})
+	}
+	return rv
+}
+
+// ValidatePaymentParties returns an error if the provided parties do not make up a valid multi-party payment.
+// The accepted field of each party is not considered.
+func ValidatePaymentParties(parties []PaymentParty) error {
+	if len(parties) < 2 {
+		return fmt.Errorf("at least 2 parties are required, found %d", len(parties))
+	}
+	if len(parties) > MaxMultiPartyPaymentParties {
+		return fmt.Errorf("too many parties %d: cannot have more than %d", len(parties), MaxMultiPartyPaymentParties)
+	}
+
+	var errs []error
+	amountsOK := true
+	var totalSend, totalReceive sdk.Coins
+	known := make(map[string]int)
+	for i, party := range parties {
+		if j, seen := known[party.Address]; seen {
+			errs = append(errs, fmt.Errorf("invalid parties[%d]: duplicate address %s seen at [%d]", i, party.Address, j))
+			continue
+		}
+		known[party.Address] = i
+		if err := party.Validate(); err != nil {
+			amountsOK = false
+			errs = append(errs, fmt.Errorf("invalid parties[%d]: %w", i, err))
+			continue
+		}
+		totalSend = totalSend.Add(party.SendAmount...)
+		totalReceive = totalReceive.Add(party.ReceiveAmount...)
+	}
+
+	if amountsOK && !totalSend.Equal(totalReceive) {
+		errs = append(errs, fmt.Errorf("total send amount %q does not equal total receive amount %q", totalSend, totalReceive))
+	}
+
+	return errors.Join(errs...)
+}
+
+// Validate returns an error if any of this PaymentParty's info is invalid.
+func (p PaymentParty) Validate() error {
+	var errs []error
+	if _, err := sdk.AccAddressFromBech32(p.Address); err != nil {
+		errs = append(errs, fmt.Errorf("invalid address %q: %w", p.Address, err))
+	}
+
+	amountsOK := true
+	if err := p.SendAmount.Validate(); err != nil {
+		amountsOK = false
+		errs = append(errs, fmt.Errorf("invalid send amount %q: %w", p.SendAmount, err))
+	}
+	if err := p.ReceiveAmount.Validate(); err != nil {
+		amountsOK = false
+		errs = append(errs, fmt.Errorf("invalid receive amount %q: %w", p.ReceiveAmount, err))
+	}
+	if amountsOK && p.SendAmount.IsZero() && p.ReceiveAmount.IsZero() {
+		errs = append(errs, errors.New("send amount and receive amount cannot both be zero"))
+	}
+
+	return errors.Join(errs...)
+}
+
+// EqualTerms returns true if this PaymentParty has the same address and amounts as the other one.
+// The accepted field is not considered.
+func (p PaymentParty) EqualTerms(other PaymentParty) bool {
+	return p.Address == other.Address && p.SendAmount.Equal(other.SendAmount) && p.ReceiveAmount.Equal(other.ReceiveAmount)
+}
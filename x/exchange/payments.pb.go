@@ -11,15 +11,19 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -27,6 +31,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TargetAmountFilter defines the ways that payments can be filtered based on their target amount.
+type TargetAmountFilter int32
+
+const (
+	// TARGET_AMOUNT_FILTER_UNSPECIFIED indicates that payments should not be filtered by their target amount.
+	TargetAmountFilter_unspecified TargetAmountFilter = 0
+	// TARGET_AMOUNT_FILTER_PRESENT limits the results to payments that have a target amount.
+	TargetAmountFilter_present TargetAmountFilter = 1
+	// TARGET_AMOUNT_FILTER_ABSENT limits the results to payments that do not have a target amount.
+	TargetAmountFilter_absent TargetAmountFilter = 2
+)
+
+var TargetAmountFilter_name = map[int32]string{
+	0: "TARGET_AMOUNT_FILTER_UNSPECIFIED",
+	1: "TARGET_AMOUNT_FILTER_PRESENT",
+	2: "TARGET_AMOUNT_FILTER_ABSENT",
+}
+
+var TargetAmountFilter_value = map[string]int32{
+	"TARGET_AMOUNT_FILTER_UNSPECIFIED": 0,
+	"TARGET_AMOUNT_FILTER_PRESENT":     1,
+	"TARGET_AMOUNT_FILTER_ABSENT":      2,
+}
+
+func (x TargetAmountFilter) String() string {
+	return proto.EnumName(TargetAmountFilter_name, int32(x))
+}
+
+func (TargetAmountFilter) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d21a428fd9374bb6, []int{0}
+}
+
 // Payment represents one account's desire to trade funds with another account.
 type Payment struct {
 	// source is the account that created this Payment. It is considered the owner of the payment.
@@ -53,6 +89,14 @@ type Payment struct {
 	//
 	// The external id is limited to 100 bytes. An empty string is a valid external id.
 	ExternalId string `protobuf:"bytes,5,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// expiration is an optional time after which this Payment is automatically cancelled, releasing the hold on the
+	// source_amount. If provided, it must be after the current block time when the Payment is created.
+	Expiration *time.Time `protobuf:"bytes,6,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+	// dispute_window_seconds is an optional number of seconds that the funds of this Payment are held in escrow
+	// after it is accepted. During that time, the target can dispute the payment to have all funds refunded.
+	// Once it passes, the source_amount is released to the target and the target_amount is released to the source.
+	// If zero, the funds are transferred as soon as the Payment is accepted.
+	DisputeWindowSeconds uint64 `protobuf:"varint,7,opt,name=dispute_window_seconds,json=disputeWindowSeconds,proto3" json:"dispute_window_seconds,omitempty"`
 }
 
 func (m *Payment) Reset()      { *m = Payment{} }
@@ -122,166 +166,2751 @@ func (m *Payment) GetExternalId() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*Payment)(nil), "provenance.exchange.v1.Payment")
+func (m *Payment) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("provenance/exchange/v1/payments.proto", fileDescriptor_d21a428fd9374bb6)
+func (m *Payment) GetDisputeWindowSeconds() uint64 {
+	if m != nil {
+		return m.DisputeWindowSeconds
+	}
+	return 0
 }
 
-var fileDescriptor_d21a428fd9374bb6 = []byte{
-	// 398 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x92, 0xbf, 0x6b, 0x22, 0x41,
-	0x14, 0xc7, 0x77, 0x4e, 0xcf, 0xe3, 0x56, 0xaf, 0x38, 0x91, 0x63, 0xb5, 0xd8, 0x95, 0x83, 0x80,
-	0x08, 0xce, 0xc4, 0xa4, 0x4b, 0xa7, 0x81, 0x40, 0x3a, 0x31, 0x5d, 0x9a, 0x65, 0x76, 0x77, 0x58,
-	0x87, 0xb8, 0x33, 0xcb, 0xce, 0x28, 0xfa, 0x0f, 0xa4, 0x4e, 0x19, 0x52, 0xa5, 0x0a, 0x21, 0x95,
-	0x45, 0xfe, 0x08, 0x4b, 0x49, 0x95, 0x2a, 0x09, 0x5a, 0xf8, 0x6f, 0x84, 0xdd, 0x19, 0xa3, 0x45,
-	0x20, 0x5d, 0x9a, 0x99, 0xf7, 0xe3, 0xfb, 0xde, 0xfb, 0x0c, 0xf3, 0xcc, 0xbd, 0x38, 0xe1, 0x63,
-	0xc2, 0x30, 0xf3, 0x09, 0x22, 0x13, 0x7f, 0x80, 0x59, 0x48, 0xd0, 0xb8, 0x8d, 0x62, 0x3c, 0x8d,
-	0x08, 0x93, 0x02, 0xc6, 0x09, 0x97, 0xbc, 0xfc, 0x6f, 0x2b, 0x83, 0x1b, 0x19, 0x1c, 0xb7, 0x6b,
-	0x7f, 0x71, 0x44, 0x19, 0x47, 0xd9, 0xa9, 0xa4, 0x35, 0xdb, 0xe7, 0x22, 0xe2, 0x02, 0x79, 0x58,
-	0xa4, 0x9d, 0x3c, 0x22, 0x71, 0x1b, 0xf9, 0x9c, 0x32, 0x9d, 0xaf, 0xaa, 0xbc, 0x9b, 0x79, 0x48,
-	0x39, 0x3a, 0x55, 0x09, 0x79, 0xc8, 0x55, 0x3c, 0xb5, 0x54, 0xf4, 0xff, 0x5d, 0xce, 0xfc, 0xd5,
-	0x53, 0x38, 0xe5, 0x7d, 0xb3, 0x20, 0xf8, 0x28, 0xf1, 0x89, 0x05, 0xea, 0xa0, 0xf1, 0xbb, 0x6b,
-	0x3d, 0x3d, 0xb6, 0x2a, 0xba, 0x47, 0x27, 0x08, 0x12, 0x22, 0xc4, 0x99, 0x4c, 0x28, 0x0b, 0xfb,
-	0x5a, 0x57, 0xbe, 0x04, 0xe6, 0x1f, 0x65, 0xba, 0x38, 0xe2, 0x23, 0x26, 0xad, 0x1f, 0xf5, 0x5c,
-	0xa3, 0x78, 0x50, 0x85, 0xba, 0x2c, 0xe5, 0x84, 0x9a, 0x13, 0x1e, 0x73, 0xca, 0xba, 0x27, 0xf3,
-	0x17, 0xc7, 0x78, 0x78, 0x75, 0x1a, 0x21, 0x95, 0x83, 0x91, 0x07, 0x7d, 0x1e, 0x69, 0x4e, 0x7d,
-	0xb5, 0x44, 0x70, 0x81, 0xe4, 0x34, 0x26, 0x22, 0x2b, 0x10, 0x37, 0xeb, 0x59, 0xb3, 0x34, 0x24,
-	0x21, 0xf6, 0xa7, 0x6e, 0xfa, 0x52, 0x71, 0xbf, 0x9e, 0x35, 0x41, 0xbf, 0xa4, 0xe6, 0x76, 0xb2,
-	0xb1, 0x29, 0xba, 0xc4, 0x49, 0x48, 0xa4, 0x95, 0xfb, 0x0a, 0x5d, 0xe9, 0x32, 0x74, 0x65, 0x6e,
-	0xd0, 0xf3, 0xdf, 0x86, 0xae, 0xe6, 0x6a, 0x74, 0xc7, 0x2c, 0x92, 0x89, 0x24, 0x09, 0xc3, 0x43,
-	0x97, 0x06, 0xd6, 0xcf, 0x94, 0xbf, 0x6f, 0x6e, 0x42, 0xa7, 0xc1, 0x51, 0xfe, 0xfa, 0xd6, 0x31,
-	0xba, 0x64, 0xbe, 0xb4, 0xc1, 0x62, 0x69, 0x83, 0xb7, 0xa5, 0x0d, 0xae, 0x56, 0xb6, 0xb1, 0x58,
-	0xd9, 0xc6, 0xf3, 0xca, 0x36, 0xcc, 0x2a, 0xcd, 0x3e, 0xf3, 0x93, 0x0d, 0xea, 0x81, 0x73, 0xb8,
-	0xc3, 0xba, 0x15, 0xb5, 0x28, 0xdf, 0xf1, 0xd0, 0xe4, 0x63, 0x3b, 0xbd, 0x42, 0xb6, 0x16, 0x87,
-	0xef, 0x01, 0x00, 0x00, 0xff, 0xff, 0x91, 0xa5, 0x2f, 0xe7, 0xbb, 0x02, 0x00, 0x00,
+// EscrowedPayment is an accepted Payment whose funds are held in escrow until its dispute window ends.
+type EscrowedPayment struct {
+	// payment is the Payment that was accepted.
+	Payment Payment `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment"`
+	// release_time is when the dispute window ends and the escrowed funds are released.
+	ReleaseTime time.Time `protobuf:"bytes,2,opt,name=release_time,json=releaseTime,proto3,stdtime" json:"release_time"`
+	// failed_releases is the number of times that releasing the escrowed funds has failed.
+	// After each failure, the release is retried later, with the wait doubling each time (up to a week).
+	FailedReleases uint32 `protobuf:"varint,3,opt,name=failed_releases,json=failedReleases,proto3" json:"failed_releases,omitempty"`
 }
 
-func (m *Payment) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EscrowedPayment) Reset()         { *m = EscrowedPayment{} }
+func (m *EscrowedPayment) String() string { return proto.CompactTextString(m) }
+func (*EscrowedPayment) ProtoMessage()    {}
+func (*EscrowedPayment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21a428fd9374bb6, []int{1}
+}
+func (m *EscrowedPayment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EscrowedPayment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EscrowedPayment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *EscrowedPayment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EscrowedPayment.Merge(m, src)
+}
+func (m *EscrowedPayment) XXX_Size() int {
+	return m.Size()
+}
+func (m *EscrowedPayment) XXX_DiscardUnknown() {
+	xxx_messageInfo_EscrowedPayment.DiscardUnknown(m)
 }
 
-func (m *Payment) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_EscrowedPayment proto.InternalMessageInfo
+
+func (m *EscrowedPayment) GetPayment() Payment {
+	if m != nil {
+		return m.Payment
+	}
+	return Payment{}
 }
 
-func (m *Payment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExternalId) > 0 {
-		i -= len(m.ExternalId)
-		copy(dAtA[i:], m.ExternalId)
-		i = encodeVarintPayments(dAtA, i, uint64(len(m.ExternalId)))
-		i--
-		dAtA[i] = 0x2a
+func (m *EscrowedPayment) GetReleaseTime() time.Time {
+	if m != nil {
+		return m.ReleaseTime
 	}
-	if len(m.TargetAmount) > 0 {
-		for iNdEx := len(m.TargetAmount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TargetAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+	return time.Time{}
+}
+
+func (m *EscrowedPayment) GetFailedReleases() uint32 {
+	if m != nil {
+		return m.FailedReleases
+	}
+	return 0
+}
+
+// RecurringPayment represents one account's authorization for a series of periodic transfers of funds to another account.
+type RecurringPayment struct {
+	// source is the account that created this RecurringPayment and that provides the funds for each transfer.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// target is the account that receives the funds of each transfer.
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// amount is the funds sent from the source to the target in each transfer.
+	// No hold is placed on these funds; they must be available in the source account when each transfer is made.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// external_id is used along with the source to uniquely identify this RecurringPayment.
+	//
+	// Recurring payment external ids are separate from Payment external ids.
+	// The external id is limited to 100 bytes. An empty string is a valid external id.
+	ExternalId string `protobuf:"bytes,4,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// interval_seconds is the number of seconds between transfers.
+	IntervalSeconds uint64 `protobuf:"varint,5,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	// transfers_remaining is the number of transfers that have yet to be made.
+	// Once it reaches zero, this RecurringPayment is deleted.
+	TransfersRemaining uint32 `protobuf:"varint,6,opt,name=transfers_remaining,json=transfersRemaining,proto3" json:"transfers_remaining,omitempty"`
+	// next_transfer_time is the time at (or after) which the next transfer will be made.
+	NextTransferTime time.Time `protobuf:"bytes,7,opt,name=next_transfer_time,json=nextTransferTime,proto3,stdtime" json:"next_transfer_time"`
+	// failures is the number of consecutive transfers that have failed.
+	// A failed transfer is retried one interval later. It is reset to zero after each successful transfer.
+	Failures uint32 `protobuf:"varint,8,opt,name=failures,proto3" json:"failures,omitempty"`
+}
+
+func (m *RecurringPayment) Reset()         { *m = RecurringPayment{} }
+func (m *RecurringPayment) String() string { return proto.CompactTextString(m) }
+func (*RecurringPayment) ProtoMessage()    {}
+func (*RecurringPayment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21a428fd9374bb6, []int{2}
+}
+func (m *RecurringPayment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecurringPayment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecurringPayment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecurringPayment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecurringPayment.Merge(m, src)
+}
+func (m *RecurringPayment) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecurringPayment) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecurringPayment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecurringPayment proto.InternalMessageInfo
+
+func (m *RecurringPayment) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *RecurringPayment) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *RecurringPayment) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *RecurringPayment) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
+func (m *RecurringPayment) GetIntervalSeconds() uint64 {
+	if m != nil {
+		return m.IntervalSeconds
+	}
+	return 0
+}
+
+func (m *RecurringPayment) GetTransfersRemaining() uint32 {
+	if m != nil {
+		return m.TransfersRemaining
+	}
+	return 0
+}
+
+func (m *RecurringPayment) GetNextTransferTime() time.Time {
+	if m != nil {
+		return m.NextTransferTime
+	}
+	return time.Time{}
+}
+
+func (m *RecurringPayment) GetFailures() uint32 {
+	if m != nil {
+		return m.Failures
+	}
+	return 0
+}
+
+// MultiPartyPayment represents a trade of funds between several accounts that is settled once all of them accept it.
+type MultiPartyPayment struct {
+	// creator is the account that created this MultiPartyPayment. It must be one of the parties.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// external_id is used along with the creator to uniquely identify this MultiPartyPayment.
+	//
+	// Multi-party payment external ids are separate from Payment external ids.
+	// The external id is limited to 100 bytes. An empty string is a valid external id.
+	ExternalId string `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// parties are the accounts involved in this MultiPartyPayment and what each will send and receive.
+	// The total of all send amounts must equal the total of all receive amounts.
+	Parties []PaymentParty `protobuf:"bytes,3,rep,name=parties,proto3" json:"parties"`
+}
+
+func (m *MultiPartyPayment) Reset()         { *m = MultiPartyPayment{} }
+func (m *MultiPartyPayment) String() string { return proto.CompactTextString(m) }
+func (*MultiPartyPayment) ProtoMessage()    {}
+func (*MultiPartyPayment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21a428fd9374bb6, []int{3}
+}
+func (m *MultiPartyPayment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiPartyPayment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiPartyPayment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultiPartyPayment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiPartyPayment.Merge(m, src)
+}
+func (m *MultiPartyPayment) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiPartyPayment) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiPartyPayment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiPartyPayment proto.InternalMessageInfo
+
+func (m *MultiPartyPayment) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MultiPartyPayment) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
+func (m *MultiPartyPayment) GetParties() []PaymentParty {
+	if m != nil {
+		return m.Parties
+	}
+	return nil
+}
+
+// PaymentParty is one account's part in a MultiPartyPayment.
+type PaymentParty struct {
+	// address is the account of this party.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// send_amount is the funds that this party will send as part of the payment.
+	// A hold is placed on this amount once this party accepts, until the payment is settled or cancelled.
+	SendAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=send_amount,json=sendAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"send_amount"`
+	// receive_amount is the funds that this party will receive as part of the payment.
+	ReceiveAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=receive_amount,json=receiveAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"receive_amount"`
+	// accepted is whether this party has accepted the payment.
+	Accepted bool `protobuf:"varint,4,opt,name=accepted,proto3" json:"accepted,omitempty"`
+}
+
+func (m *PaymentParty) Reset()         { *m = PaymentParty{} }
+func (m *PaymentParty) String() string { return proto.CompactTextString(m) }
+func (*PaymentParty) ProtoMessage()    {}
+func (*PaymentParty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21a428fd9374bb6, []int{4}
+}
+func (m *PaymentParty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PaymentParty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PaymentParty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PaymentParty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentParty.Merge(m, src)
+}
+func (m *PaymentParty) XXX_Size() int {
+	return m.Size()
+}
+func (m *PaymentParty) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentParty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaymentParty proto.InternalMessageInfo
+
+func (m *PaymentParty) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PaymentParty) GetSendAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SendAmount
+	}
+	return nil
+}
+
+func (m *PaymentParty) GetReceiveAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ReceiveAmount
+	}
+	return nil
+}
+
+func (m *PaymentParty) GetAccepted() bool {
+	if m != nil {
+		return m.Accepted
+	}
+	return false
+}
+
+// PaymentID identifies a payment.
+type PaymentID struct {
+	// source is the source account of the payment.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// external_id is the external id of the payment.
+	ExternalId string `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+}
+
+func (m *PaymentID) Reset()         { *m = PaymentID{} }
+func (m *PaymentID) String() string { return proto.CompactTextString(m) }
+func (*PaymentID) ProtoMessage()    {}
+func (*PaymentID) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21a428fd9374bb6, []int{5}
+}
+func (m *PaymentID) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PaymentID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PaymentID.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PaymentID) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentID.Merge(m, src)
+}
+func (m *PaymentID) XXX_Size() int {
+	return m.Size()
+}
+func (m *PaymentID) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentID.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaymentID proto.InternalMessageInfo
+
+func (m *PaymentID) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *PaymentID) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
+// AcceptPaymentResult is the outcome of an attempt to accept one payment as part of a batch.
+type AcceptPaymentResult struct {
+	// source is the source account of the payment.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// external_id is the external id of the payment.
+	ExternalId string `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// accepted is whether the payment was accepted.
+	Accepted bool `protobuf:"varint,3,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	// error is the reason the payment was not accepted. It is empty if the payment was accepted.
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *AcceptPaymentResult) Reset()         { *m = AcceptPaymentResult{} }
+func (m *AcceptPaymentResult) String() string { return proto.CompactTextString(m) }
+func (*AcceptPaymentResult) ProtoMessage()    {}
+func (*AcceptPaymentResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21a428fd9374bb6, []int{6}
+}
+func (m *AcceptPaymentResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AcceptPaymentResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AcceptPaymentResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AcceptPaymentResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcceptPaymentResult.Merge(m, src)
+}
+func (m *AcceptPaymentResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *AcceptPaymentResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcceptPaymentResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcceptPaymentResult proto.InternalMessageInfo
+
+func (m *AcceptPaymentResult) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *AcceptPaymentResult) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
+func (m *AcceptPaymentResult) GetAccepted() bool {
+	if m != nil {
+		return m.Accepted
+	}
+	return false
+}
+
+func (m *AcceptPaymentResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// PaymentFilter defines optional criteria that payments must meet in order to be included in query results.
+type PaymentFilter struct {
+	// denom, if provided, limits the results to payments with this denom in their source amount.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// min_amount, if provided, limits the results to payments with at least this much of the denom in their source amount.
+	// A denom is required in order to use this.
+	MinAmount string `protobuf:"bytes,2,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	// max_amount, if provided, limits the results to payments with at most this much of the denom in their source amount.
+	// A denom is required in order to use this.
+	MaxAmount string `protobuf:"bytes,3,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`
+	// target_amount limits the results based on whether the payments have a target amount.
+	TargetAmount TargetAmountFilter `protobuf:"varint,4,opt,name=target_amount,json=targetAmount,proto3,enum=provenance.exchange.v1.TargetAmountFilter" json:"target_amount,omitempty"`
+}
+
+func (m *PaymentFilter) Reset()         { *m = PaymentFilter{} }
+func (m *PaymentFilter) String() string { return proto.CompactTextString(m) }
+func (*PaymentFilter) ProtoMessage()    {}
+func (*PaymentFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21a428fd9374bb6, []int{7}
+}
+func (m *PaymentFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PaymentFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PaymentFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PaymentFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentFilter.Merge(m, src)
+}
+func (m *PaymentFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *PaymentFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaymentFilter proto.InternalMessageInfo
+
+func (m *PaymentFilter) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *PaymentFilter) GetMinAmount() string {
+	if m != nil {
+		return m.MinAmount
+	}
+	return ""
+}
+
+func (m *PaymentFilter) GetMaxAmount() string {
+	if m != nil {
+		return m.MaxAmount
+	}
+	return ""
+}
+
+func (m *PaymentFilter) GetTargetAmount() TargetAmountFilter {
+	if m != nil {
+		return m.TargetAmount
+	}
+	return TargetAmountFilter_unspecified
+}
+
+// PaymentsSummary is the total of a set of payments.
+type PaymentsSummary struct {
+	// payment_count is the number of payments.
+	PaymentCount uint32 `protobuf:"varint,1,opt,name=payment_count,json=paymentCount,proto3" json:"payment_count,omitempty"`
+	// source_amount is the total source amount of the payments.
+	SourceAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=source_amount,json=sourceAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"source_amount"`
+	// target_amount is the total target amount of the payments.
+	TargetAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=target_amount,json=targetAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"target_amount"`
+}
+
+func (m *PaymentsSummary) Reset()         { *m = PaymentsSummary{} }
+func (m *PaymentsSummary) String() string { return proto.CompactTextString(m) }
+func (*PaymentsSummary) ProtoMessage()    {}
+func (*PaymentsSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21a428fd9374bb6, []int{8}
+}
+func (m *PaymentsSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PaymentsSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PaymentsSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PaymentsSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentsSummary.Merge(m, src)
+}
+func (m *PaymentsSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *PaymentsSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentsSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaymentsSummary proto.InternalMessageInfo
+
+func (m *PaymentsSummary) GetPaymentCount() uint32 {
+	if m != nil {
+		return m.PaymentCount
+	}
+	return 0
+}
+
+func (m *PaymentsSummary) GetSourceAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SourceAmount
+	}
+	return nil
+}
+
+func (m *PaymentsSummary) GetTargetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TargetAmount
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("provenance.exchange.v1.TargetAmountFilter", TargetAmountFilter_name, TargetAmountFilter_value)
+	proto.RegisterType((*Payment)(nil), "provenance.exchange.v1.Payment")
+	proto.RegisterType((*EscrowedPayment)(nil), "provenance.exchange.v1.EscrowedPayment")
+	proto.RegisterType((*RecurringPayment)(nil), "provenance.exchange.v1.RecurringPayment")
+	proto.RegisterType((*MultiPartyPayment)(nil), "provenance.exchange.v1.MultiPartyPayment")
+	proto.RegisterType((*PaymentParty)(nil), "provenance.exchange.v1.PaymentParty")
+	proto.RegisterType((*PaymentID)(nil), "provenance.exchange.v1.PaymentID")
+	proto.RegisterType((*AcceptPaymentResult)(nil), "provenance.exchange.v1.AcceptPaymentResult")
+	proto.RegisterType((*PaymentFilter)(nil), "provenance.exchange.v1.PaymentFilter")
+	proto.RegisterType((*PaymentsSummary)(nil), "provenance.exchange.v1.PaymentsSummary")
+}
+
+func init() {
+	proto.RegisterFile("provenance/exchange/v1/payments.proto", fileDescriptor_d21a428fd9374bb6)
+}
+
+var fileDescriptor_d21a428fd9374bb6 = []byte{
+	// 1073 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xda, 0xae, 0x9d, 0x8e, 0xe3, 0x24, 0xdd, 0x46, 0x95, 0x6b, 0x90, 0x6d, 0x19, 0x10,
+	0x26, 0xc8, 0xbb, 0x24, 0xc0, 0x85, 0x0b, 0xb2, 0x13, 0xa7, 0xb2, 0x44, 0xd3, 0x68, 0xe3, 0x0a,
+	0x89, 0x03, 0xab, 0xf1, 0xee, 0x8b, 0x3b, 0xc2, 0x3b, 0x63, 0xcd, 0x8c, 0x13, 0xfb, 0xca, 0x01,
+	0xa1, 0x9c, 0x7a, 0x44, 0x95, 0x22, 0x21, 0x2e, 0x20, 0x0e, 0xa8, 0x87, 0xfe, 0x09, 0x1c, 0x72,
+	0xac, 0x2a, 0x0e, 0x9c, 0x52, 0x94, 0x1c, 0x7a, 0xe3, 0x6f, 0x40, 0x3b, 0x3b, 0x6b, 0x27, 0xc4,
+	0x55, 0x4a, 0x25, 0x02, 0x97, 0x64, 0xdf, 0x7b, 0xdf, 0xf3, 0xfb, 0xde, 0xbc, 0x6f, 0x7e, 0xa0,
+	0x77, 0x06, 0x9c, 0xed, 0x01, 0xc5, 0xd4, 0x03, 0x1b, 0x46, 0xde, 0x03, 0x4c, 0x7b, 0x60, 0xef,
+	0xad, 0xda, 0x03, 0x3c, 0x0e, 0x80, 0x4a, 0x61, 0x0d, 0x38, 0x93, 0xcc, 0xbc, 0x35, 0x85, 0x59,
+	0x31, 0xcc, 0xda, 0x5b, 0x2d, 0xde, 0xc0, 0x01, 0xa1, 0xcc, 0x56, 0x7f, 0x23, 0x68, 0xb1, 0xe4,
+	0x31, 0x11, 0x30, 0x61, 0x77, 0xb1, 0x08, 0x7f, 0xa9, 0x0b, 0x12, 0xaf, 0xda, 0x1e, 0x23, 0x54,
+	0xc7, 0x6f, 0x47, 0x71, 0x57, 0x59, 0x76, 0x64, 0xe8, 0xd0, 0x72, 0x8f, 0xf5, 0x58, 0xe4, 0x0f,
+	0xbf, 0xb4, 0xb7, 0xdc, 0x63, 0xac, 0xd7, 0x07, 0x5b, 0x59, 0xdd, 0xe1, 0xae, 0x2d, 0x49, 0x00,
+	0x42, 0xe2, 0x60, 0x10, 0x01, 0xaa, 0x3f, 0xa4, 0x51, 0x76, 0x3b, 0xe2, 0x6b, 0x7e, 0x80, 0x32,
+	0x82, 0x0d, 0xb9, 0x07, 0x05, 0xa3, 0x62, 0xd4, 0xae, 0x37, 0x0b, 0xcf, 0x9e, 0xd4, 0x97, 0x75,
+	0x91, 0x86, 0xef, 0x73, 0x10, 0x62, 0x47, 0x72, 0x42, 0x7b, 0x8e, 0xc6, 0x99, 0xdf, 0x18, 0x28,
+	0x1f, 0x7d, 0xba, 0x38, 0x60, 0x43, 0x2a, 0x0b, 0xc9, 0x4a, 0xaa, 0x96, 0x5b, 0xbb, 0x6d, 0xe9,
+	0xb4, 0xb0, 0x11, 0x4b, 0x37, 0x62, 0xad, 0x33, 0x42, 0x9b, 0x9b, 0x47, 0xc7, 0xe5, 0xc4, 0xcf,
+	0xcf, 0xcb, 0xb5, 0x1e, 0x91, 0x0f, 0x86, 0x5d, 0xcb, 0x63, 0x81, 0x6e, 0x44, 0xff, 0xab, 0x0b,
+	0xff, 0x2b, 0x5b, 0x8e, 0x07, 0x20, 0x54, 0x82, 0x78, 0xf4, 0xe2, 0xf1, 0xca, 0x7c, 0x1f, 0x7a,
+	0xd8, 0x1b, 0xbb, 0xe1, 0x52, 0x88, 0x9f, 0x5e, 0x3c, 0x5e, 0x31, 0x9c, 0xf9, 0xa8, 0x6e, 0x43,
+	0x95, 0x0d, 0xa9, 0x4b, 0xcc, 0x7b, 0x20, 0x0b, 0xa9, 0xcb, 0xa8, 0x47, 0x38, 0x45, 0x3d, 0xfa,
+	0x8c, 0xa9, 0xa7, 0xaf, 0x8c, 0x7a, 0x54, 0x57, 0x53, 0x2f, 0xa3, 0x1c, 0x8c, 0x24, 0x70, 0x8a,
+	0xfb, 0x2e, 0xf1, 0x0b, 0xd7, 0x42, 0xfe, 0x0e, 0x8a, 0x5d, 0x6d, 0xdf, 0xdc, 0x40, 0x08, 0x46,
+	0x03, 0xc2, 0xb1, 0x24, 0x8c, 0x16, 0x32, 0x15, 0xa3, 0x96, 0x5b, 0x2b, 0x5a, 0xd1, 0x60, 0xad,
+	0x78, 0xb0, 0x56, 0x27, 0x1e, 0x6c, 0x73, 0xee, 0xe8, 0xb8, 0x6c, 0x3c, 0x7c, 0x5e, 0x36, 0x9c,
+	0x33, 0x79, 0xe6, 0x47, 0xe8, 0x96, 0x4f, 0xc4, 0x60, 0x28, 0xc1, 0xdd, 0x27, 0xd4, 0x67, 0xfb,
+	0xae, 0x00, 0x8f, 0x51, 0x5f, 0x14, 0xb2, 0x15, 0xa3, 0x96, 0x76, 0x96, 0x75, 0xf4, 0x73, 0x15,
+	0xdc, 0x89, 0x62, 0x9f, 0xa4, 0xbf, 0xfb, 0xbe, 0x9c, 0xa8, 0xfe, 0x6a, 0xa0, 0xc5, 0x96, 0xf0,
+	0x38, 0xdb, 0x07, 0x3f, 0x16, 0xcb, 0xa7, 0x28, 0xab, 0x75, 0xae, 0xd4, 0x92, 0x5b, 0x2b, 0x5b,
+	0xb3, 0x75, 0x6e, 0xe9, 0x8c, 0x66, 0x3a, 0x5c, 0x3e, 0x27, 0xce, 0x32, 0xef, 0xa0, 0x79, 0x0e,
+	0x7d, 0xc0, 0x02, 0xdc, 0x50, 0x94, 0x85, 0xe4, 0x2b, 0x35, 0x96, 0x50, 0x8d, 0xe5, 0x74, 0x66,
+	0x18, 0x33, 0xdf, 0x45, 0x8b, 0xbb, 0x98, 0xf4, 0xc1, 0x77, 0xb5, 0x57, 0x28, 0x11, 0xe4, 0x9d,
+	0x85, 0xc8, 0xed, 0x68, 0x6f, 0xf5, 0x38, 0x85, 0x96, 0x1c, 0xf0, 0x86, 0x3c, 0x14, 0xc2, 0xeb,
+	0x8b, 0x7e, 0xaa, 0xb5, 0xe4, 0x2b, 0x6a, 0x6d, 0x8c, 0x32, 0x5a, 0x63, 0xa9, 0xab, 0xd2, 0x58,
+	0x06, 0xcf, 0x54, 0x57, 0xfa, 0x82, 0xba, 0xde, 0x43, 0x4b, 0x84, 0x4a, 0xe0, 0x7b, 0xb8, 0x3f,
+	0x51, 0xc4, 0x35, 0xa5, 0x88, 0xc5, 0xd8, 0xaf, 0xc5, 0x60, 0xda, 0xe8, 0xa6, 0xe4, 0x98, 0x8a,
+	0x5d, 0xe0, 0xc2, 0xe5, 0x10, 0x60, 0x42, 0x09, 0xed, 0x29, 0x45, 0xe6, 0x1d, 0x73, 0x12, 0x72,
+	0xe2, 0x88, 0xe9, 0x20, 0x93, 0xc2, 0x48, 0xba, 0x71, 0x28, 0x1a, 0x74, 0xf6, 0x1f, 0x0c, 0x7a,
+	0x29, 0xcc, 0xef, 0xe8, 0x74, 0x35, 0xed, 0x22, 0x9a, 0x0b, 0xc7, 0x3a, 0xe4, 0x20, 0x0a, 0x73,
+	0xaa, 0xf2, 0xc4, 0xae, 0xfe, 0x62, 0xa0, 0x1b, 0x77, 0x87, 0x7d, 0x49, 0xb6, 0x31, 0x97, 0xe3,
+	0x78, 0xc2, 0x6b, 0x28, 0xeb, 0x71, 0xc0, 0x92, 0xf1, 0x4b, 0x47, 0x1c, 0x03, 0xff, 0xbe, 0x6c,
+	0xc9, 0x19, 0x9b, 0x32, 0x3b, 0xc0, 0x5c, 0x12, 0x10, 0x7a, 0xa6, 0x6f, 0x5f, 0x22, 0x7f, 0x45,
+	0x69, 0xba, 0x07, 0x54, 0x6a, 0xf5, 0xcf, 0x24, 0x9a, 0x3f, 0x1b, 0x0f, 0xb9, 0xe2, 0x88, 0xd1,
+	0xe5, 0x5c, 0x35, 0xd0, 0xfc, 0xda, 0x40, 0x39, 0x01, 0xd4, 0xbf, 0xf2, 0x23, 0x18, 0x85, 0x55,
+	0xf5, 0x29, 0xf6, 0xad, 0x81, 0x16, 0x38, 0x78, 0x40, 0xf6, 0x26, 0x57, 0xc1, 0x95, 0x69, 0x3d,
+	0xaf, 0x0b, 0x6b, 0x2a, 0x45, 0x34, 0x87, 0x3d, 0x0f, 0x06, 0x12, 0x22, 0xbd, 0xcf, 0x39, 0x13,
+	0xbb, 0xfa, 0x25, 0xba, 0xae, 0xd7, 0xbb, 0xbd, 0xf1, 0x1a, 0x5b, 0xff, 0x32, 0x59, 0x54, 0x1f,
+	0x19, 0xe8, 0x66, 0x43, 0x15, 0xd3, 0x65, 0x1c, 0x10, 0xc3, 0xbe, 0xfc, 0x17, 0x4a, 0x9d, 0x6b,
+	0x33, 0x75, 0xbe, 0x4d, 0x73, 0x19, 0x5d, 0x03, 0xce, 0x19, 0xd7, 0xfb, 0x3d, 0x32, 0xaa, 0xbf,
+	0x19, 0x28, 0xaf, 0x69, 0x6d, 0x92, 0xbe, 0x04, 0x1e, 0xe2, 0x7c, 0xa0, 0x2c, 0x88, 0x58, 0x39,
+	0x91, 0x61, 0xd6, 0x11, 0x0a, 0x08, 0x9d, 0xca, 0x29, 0x24, 0xbc, 0xf0, 0xec, 0x49, 0x1d, 0x69,
+	0xc2, 0x6d, 0x2a, 0x9d, 0xeb, 0x01, 0xa1, 0x7a, 0xbd, 0x43, 0x38, 0x1e, 0x4d, 0xa7, 0x3e, 0x1b,
+	0x8e, 0x47, 0x1a, 0x7e, 0xef, 0xe2, 0xbd, 0x6b, 0xd4, 0x16, 0xd6, 0x56, 0x5e, 0xb6, 0x7f, 0x3a,
+	0x67, 0x2e, 0xcb, 0x88, 0xf6, 0xf9, 0x0b, 0xb4, 0x7a, 0x94, 0x44, 0x8b, 0xba, 0x2d, 0xb1, 0x33,
+	0x0c, 0x02, 0xcc, 0xc7, 0xe6, 0x5b, 0x28, 0xaf, 0xef, 0x19, 0xd7, 0x53, 0x45, 0x0c, 0x75, 0x54,
+	0xcc, 0x6b, 0xe7, 0xba, 0x62, 0xf2, 0xbf, 0x79, 0xbd, 0x5c, 0x7c, 0x8b, 0xa4, 0xfe, 0x93, 0xb7,
+	0xc8, 0xca, 0x8f, 0x06, 0x32, 0x2f, 0xae, 0xb7, 0xf9, 0x31, 0xaa, 0x74, 0x1a, 0xce, 0x9d, 0x56,
+	0xc7, 0x6d, 0xdc, 0xbd, 0x77, 0x7f, 0xab, 0xe3, 0x6e, 0xb6, 0x3f, 0xeb, 0xb4, 0x1c, 0xf7, 0xfe,
+	0xd6, 0xce, 0x76, 0x6b, 0xbd, 0xbd, 0xd9, 0x6e, 0x6d, 0x2c, 0x25, 0x8a, 0x8b, 0x07, 0x87, 0x95,
+	0xdc, 0x90, 0x8a, 0x01, 0x78, 0x64, 0x97, 0x80, 0x6f, 0xd6, 0xd1, 0x9b, 0x33, 0xd3, 0xb6, 0x9d,
+	0xd6, 0x4e, 0x6b, 0xab, 0xb3, 0x64, 0x14, 0x73, 0x07, 0x87, 0x95, 0xec, 0x80, 0x83, 0x08, 0xcf,
+	0xe9, 0xf7, 0xd1, 0x1b, 0x33, 0xe1, 0x8d, 0xa6, 0x42, 0x27, 0x8b, 0xe8, 0xe0, 0xb0, 0x92, 0xc1,
+	0xdd, 0x10, 0xdc, 0x84, 0xa3, 0x93, 0x92, 0xf1, 0xf4, 0xa4, 0x64, 0xfc, 0x71, 0x52, 0x32, 0x1e,
+	0x9e, 0x96, 0x12, 0x4f, 0x4f, 0x4b, 0x89, 0xdf, 0x4f, 0x4b, 0x09, 0x74, 0x9b, 0xb0, 0x97, 0x48,
+	0x69, 0xdb, 0xf8, 0xc2, 0x3a, 0xb3, 0x5c, 0x53, 0x50, 0x9d, 0xb0, 0x33, 0x96, 0x3d, 0x9a, 0xbc,
+	0xe6, 0xbb, 0x19, 0x75, 0x3b, 0x7d, 0xf8, 0xd7, 0x00, 0x9a, 0xcc, 0x29, 0x58, 0xeb, 0x0b, 0x00,
+	0x00,
+}
+
+func (m *Payment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Payment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Payment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DisputeWindowSeconds != 0 {
+		i = encodeVarintPayments(dAtA, i, uint64(m.DisputeWindowSeconds))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Expiration != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintPayments(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintPayments(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TargetAmount) > 0 {
+		for iNdEx := len(m.TargetAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TargetAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPayments(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintPayments(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourceAmount) > 0 {
+		for iNdEx := len(m.SourceAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SourceAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPayments(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintPayments(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EscrowedPayment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EscrowedPayment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EscrowedPayment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FailedReleases != 0 {
+		i = encodeVarintPayments(dAtA, i, uint64(m.FailedReleases))
+		i--
+		dAtA[i] = 0x18
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ReleaseTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReleaseTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintPayments(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Payment.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPayments(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RecurringPayment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecurringPayment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecurringPayment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Failures != 0 {
+		i = encodeVarintPayments(dAtA, i, uint64(m.Failures))
+		i--
+		dAtA[i] = 0x40
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.NextTransferTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextTransferTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintPayments(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x3a
+	if m.TransfersRemaining != 0 {
+		i = encodeVarintPayments(dAtA, i, uint64(m.TransfersRemaining))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.IntervalSeconds != 0 {
+		i = encodeVarintPayments(dAtA, i, uint64(m.IntervalSeconds))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintPayments(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPayments(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintPayments(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintPayments(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultiPartyPayment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiPartyPayment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiPartyPayment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Parties) > 0 {
+		for iNdEx := len(m.Parties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Parties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPayments(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintPayments(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintPayments(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PaymentParty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PaymentParty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PaymentParty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Accepted {
+		i--
+		if m.Accepted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ReceiveAmount) > 0 {
+		for iNdEx := len(m.ReceiveAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReceiveAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPayments(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SendAmount) > 0 {
+		for iNdEx := len(m.SendAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPayments(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPayments(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PaymentID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PaymentID) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PaymentID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintPayments(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintPayments(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AcceptPaymentResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AcceptPaymentResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AcceptPaymentResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintPayments(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Accepted {
+		i--
+		if m.Accepted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintPayments(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintPayments(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PaymentFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PaymentFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PaymentFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TargetAmount != 0 {
+		i = encodeVarintPayments(dAtA, i, uint64(m.TargetAmount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MaxAmount) > 0 {
+		i -= len(m.MaxAmount)
+		copy(dAtA[i:], m.MaxAmount)
+		i = encodeVarintPayments(dAtA, i, uint64(len(m.MaxAmount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MinAmount) > 0 {
+		i -= len(m.MinAmount)
+		copy(dAtA[i:], m.MinAmount)
+		i = encodeVarintPayments(dAtA, i, uint64(len(m.MinAmount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintPayments(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PaymentsSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PaymentsSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PaymentsSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TargetAmount) > 0 {
+		for iNdEx := len(m.TargetAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TargetAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPayments(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SourceAmount) > 0 {
+		for iNdEx := len(m.SourceAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SourceAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPayments(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PaymentCount != 0 {
+		i = encodeVarintPayments(dAtA, i, uint64(m.PaymentCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPayments(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPayments(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Payment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovPayments(uint64(l))
+	}
+	if len(m.SourceAmount) > 0 {
+		for _, e := range m.SourceAmount {
+			l = e.Size()
+			n += 1 + l + sovPayments(uint64(l))
+		}
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovPayments(uint64(l))
+	}
+	if len(m.TargetAmount) > 0 {
+		for _, e := range m.TargetAmount {
+			l = e.Size()
+			n += 1 + l + sovPayments(uint64(l))
+		}
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovPayments(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovPayments(uint64(l))
+	}
+	if m.DisputeWindowSeconds != 0 {
+		n += 1 + sovPayments(uint64(m.DisputeWindowSeconds))
+	}
+	return n
+}
+
+func (m *EscrowedPayment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Payment.Size()
+	n += 1 + l + sovPayments(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReleaseTime)
+	n += 1 + l + sovPayments(uint64(l))
+	if m.FailedReleases != 0 {
+		n += 1 + sovPayments(uint64(m.FailedReleases))
+	}
+	return n
+}
+
+func (m *RecurringPayment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovPayments(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovPayments(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovPayments(uint64(l))
+		}
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovPayments(uint64(l))
+	}
+	if m.IntervalSeconds != 0 {
+		n += 1 + sovPayments(uint64(m.IntervalSeconds))
+	}
+	if m.TransfersRemaining != 0 {
+		n += 1 + sovPayments(uint64(m.TransfersRemaining))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextTransferTime)
+	n += 1 + l + sovPayments(uint64(l))
+	if m.Failures != 0 {
+		n += 1 + sovPayments(uint64(m.Failures))
+	}
+	return n
+}
+
+func (m *MultiPartyPayment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovPayments(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovPayments(uint64(l))
+	}
+	if len(m.Parties) > 0 {
+		for _, e := range m.Parties {
+			l = e.Size()
+			n += 1 + l + sovPayments(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PaymentParty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPayments(uint64(l))
+	}
+	if len(m.SendAmount) > 0 {
+		for _, e := range m.SendAmount {
+			l = e.Size()
+			n += 1 + l + sovPayments(uint64(l))
+		}
+	}
+	if len(m.ReceiveAmount) > 0 {
+		for _, e := range m.ReceiveAmount {
+			l = e.Size()
+			n += 1 + l + sovPayments(uint64(l))
+		}
+	}
+	if m.Accepted {
+		n += 2
+	}
+	return n
+}
+
+func (m *PaymentID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovPayments(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovPayments(uint64(l))
+	}
+	return n
+}
+
+func (m *AcceptPaymentResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovPayments(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovPayments(uint64(l))
+	}
+	if m.Accepted {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovPayments(uint64(l))
+	}
+	return n
+}
+
+func (m *PaymentFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovPayments(uint64(l))
+	}
+	l = len(m.MinAmount)
+	if l > 0 {
+		n += 1 + l + sovPayments(uint64(l))
+	}
+	l = len(m.MaxAmount)
+	if l > 0 {
+		n += 1 + l + sovPayments(uint64(l))
+	}
+	if m.TargetAmount != 0 {
+		n += 1 + sovPayments(uint64(m.TargetAmount))
+	}
+	return n
+}
+
+func (m *PaymentsSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PaymentCount != 0 {
+		n += 1 + sovPayments(uint64(m.PaymentCount))
+	}
+	if len(m.SourceAmount) > 0 {
+		for _, e := range m.SourceAmount {
+			l = e.Size()
+			n += 1 + l + sovPayments(uint64(l))
+		}
+	}
+	if len(m.TargetAmount) > 0 {
+		for _, e := range m.TargetAmount {
+			l = e.Size()
+			n += 1 + l + sovPayments(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovPayments(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPayments(x uint64) (n int) {
+	return sovPayments(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Payment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayments
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Payment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Payment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayments
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceAmount = append(m.SourceAmount, types.Coin{})
+			if err := m.SourceAmount[len(m.SourceAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayments
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetAmount = append(m.TargetAmount, types.Coin{})
+			if err := m.TargetAmount[len(m.TargetAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayments
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeWindowSeconds", wireType)
+			}
+			m.DisputeWindowSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeWindowSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayments(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPayments
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EscrowedPayment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayments
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EscrowedPayment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EscrowedPayment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayments
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Payment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayments
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ReleaseTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedReleases", wireType)
+			}
+			m.FailedReleases = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedReleases |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayments(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPayments
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecurringPayment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayments
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecurringPayment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecurringPayment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayments
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalSeconds", wireType)
+			}
+			m.IntervalSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransfersRemaining", wireType)
+			}
+			m.TransfersRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransfersRemaining |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextTransferTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayments
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.NextTransferTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
+			}
+			m.Failures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failures |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayments(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPayments
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiPartyPayment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayments
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiPartyPayment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiPartyPayment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayments
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parties = append(m.Parties, PaymentParty{})
+			if err := m.Parties[len(m.Parties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayments(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPayments
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PaymentParty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayments
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PaymentParty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PaymentParty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayments
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendAmount = append(m.SendAmount, types.Coin{})
+			if err := m.SendAmount[len(m.SendAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayments
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiveAmount = append(m.ReceiveAmount, types.Coin{})
+			if err := m.ReceiveAmount[len(m.ReceiveAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Accepted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayments(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPayments
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PaymentID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayments
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PaymentID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PaymentID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayments(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPayments
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AcceptPaymentResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayments
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AcceptPaymentResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AcceptPaymentResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintPayments(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.Target) > 0 {
-		i -= len(m.Target)
-		copy(dAtA[i:], m.Target)
-		i = encodeVarintPayments(dAtA, i, uint64(len(m.Target)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.SourceAmount) > 0 {
-		for iNdEx := len(m.SourceAmount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SourceAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+			m.Accepted = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintPayments(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x12
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayments(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPayments
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintPayments(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintPayments(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPayments(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Payment) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovPayments(uint64(l))
-	}
-	if len(m.SourceAmount) > 0 {
-		for _, e := range m.SourceAmount {
-			l = e.Size()
-			n += 1 + l + sovPayments(uint64(l))
-		}
-	}
-	l = len(m.Target)
-	if l > 0 {
-		n += 1 + l + sovPayments(uint64(l))
-	}
-	if len(m.TargetAmount) > 0 {
-		for _, e := range m.TargetAmount {
-			l = e.Size()
-			n += 1 + l + sovPayments(uint64(l))
-		}
-	}
-	l = len(m.ExternalId)
-	if l > 0 {
-		n += 1 + l + sovPayments(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovPayments(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozPayments(x uint64) (n int) {
-	return sovPayments(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Payment) Unmarshal(dAtA []byte) error {
+func (m *PaymentFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -304,15 +2933,15 @@ func (m *Payment) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Payment: wiretype end group for non-group")
+			return fmt.Errorf("proto: PaymentFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Payment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PaymentFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -340,13 +2969,13 @@ func (m *Payment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayments
@@ -356,29 +2985,27 @@ func (m *Payment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPayments
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPayments
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceAmount = append(m.SourceAmount, types.Coin{})
-			if err := m.SourceAmount[len(m.SourceAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MinAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -406,12 +3033,100 @@ func (m *Payment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Target = string(dAtA[iNdEx:postIndex])
+			m.MaxAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
+			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TargetAmount", wireType)
 			}
+			m.TargetAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetAmount |= TargetAmountFilter(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayments(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPayments
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PaymentsSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayments
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PaymentsSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PaymentsSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentCount", wireType)
+			}
+			m.PaymentCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PaymentCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAmount", wireType)
+			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
@@ -437,16 +3152,16 @@ func (m *Payment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetAmount = append(m.TargetAmount, types.Coin{})
-			if err := m.TargetAmount[len(m.TargetAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SourceAmount = append(m.SourceAmount, types.Coin{})
+			if err := m.SourceAmount[len(m.SourceAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAmount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayments
@@ -456,23 +3171,25 @@ func (m *Payment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPayments
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPayments
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			m.TargetAmount = append(m.TargetAmount, types.Coin{})
+			if err := m.TargetAmount[len(m.TargetAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
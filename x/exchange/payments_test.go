@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -11,6 +12,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/provenance-io/provenance/testutil/assertions"
 )
@@ -24,6 +26,9 @@ var ValidPayment = Payment{
 }
 
 func TestPayment_Validate(t *testing.T) {
+	expiration := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	epoch := time.Unix(0, 0)
+
 	tests := []struct {
 		name    string
 		payment Payment
@@ -156,6 +161,55 @@ func TestPayment_Validate(t *testing.T) {
 			expErr: []string{fmt.Sprintf("invalid external id %q (length %d): max length %d",
 				"piiii...iiiio", MaxExternalIDLength+2, MaxExternalIDLength)},
 		},
+		{
+			name: "with expiration",
+			payment: Payment{
+				Source:       ValidPayment.Source,
+				SourceAmount: ValidPayment.SourceAmount,
+				Target:       ValidPayment.Target,
+				TargetAmount: ValidPayment.TargetAmount,
+				ExternalId:   ValidPayment.ExternalId,
+				Expiration:   &expiration,
+			},
+			expErr: nil,
+		},
+		{
+			name: "expiration at epoch",
+			payment: Payment{
+				Source:       ValidPayment.Source,
+				SourceAmount: ValidPayment.SourceAmount,
+				Target:       ValidPayment.Target,
+				TargetAmount: ValidPayment.TargetAmount,
+				ExternalId:   ValidPayment.ExternalId,
+				Expiration:   &epoch,
+			},
+			expErr: []string{"invalid expiration 1970-01-01T00:00:00Z: must be after 1970-01-01T00:00:00Z"},
+		},
+		{
+			name: "max dispute window",
+			payment: Payment{
+				Source:               ValidPayment.Source,
+				SourceAmount:         ValidPayment.SourceAmount,
+				Target:               ValidPayment.Target,
+				TargetAmount:         ValidPayment.TargetAmount,
+				ExternalId:           ValidPayment.ExternalId,
+				DisputeWindowSeconds: MaxPaymentDisputeWindowSeconds,
+			},
+			expErr: nil,
+		},
+		{
+			name: "dispute window too long",
+			payment: Payment{
+				Source:               ValidPayment.Source,
+				SourceAmount:         ValidPayment.SourceAmount,
+				Target:               ValidPayment.Target,
+				TargetAmount:         ValidPayment.TargetAmount,
+				ExternalId:           ValidPayment.ExternalId,
+				DisputeWindowSeconds: MaxPaymentDisputeWindowSeconds + 1,
+			},
+			expErr: []string{fmt.Sprintf("invalid dispute window %d seconds: cannot be more than %d",
+				MaxPaymentDisputeWindowSeconds+1, MaxPaymentDisputeWindowSeconds)},
+		},
 		{
 			name: "multiple errors",
 			payment: Payment{
@@ -188,6 +242,215 @@ func TestPayment_Validate(t *testing.T) {
 	}
 }
 
+func TestRecurringPayment_Validate(t *testing.T) {
+	nextTime := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	validRP := func() RecurringPayment {
+		return RecurringPayment{
+			Source:             sdk.AccAddress("Source______________").String(),
+			Target:             sdk.AccAddress("Target______________").String(),
+			Amount:             sdk.NewCoins(sdk.NewInt64Coin("plum", 5)),
+			ExternalId:         "sub-1",
+			IntervalSeconds:    86400,
+			TransfersRemaining: 12,
+			NextTransferTime:   nextTime,
+			Failures:           0,
+		}
+	}
+	with := func(modifier func(rp *RecurringPayment)) RecurringPayment {
+		rv := validRP()
+		modifier(&rv)
+		return rv
+	}
+
+	tests := []struct {
+		name   string
+		rp     RecurringPayment
+		expErr []string
+	}{
+		{
+			name: "valid",
+			rp:   validRP(),
+		},
+		{
+			name: "valid with failures",
+			rp:   with(func(rp *RecurringPayment) { rp.Failures = MaxRecurringPaymentFailures - 1 }),
+		},
+		{
+			name:   "invalid source",
+			rp:     with(func(rp *RecurringPayment) { rp.Source = "notgonnahappen" }),
+			expErr: []string{"invalid source \"notgonnahappen\": decoding bech32 failed: invalid separator index -1"},
+		},
+		{
+			name:   "no target",
+			rp:     with(func(rp *RecurringPayment) { rp.Target = "" }),
+			expErr: []string{"invalid target \"\": empty address string is not allowed"},
+		},
+		{
+			name:   "zero amount",
+			rp:     with(func(rp *RecurringPayment) { rp.Amount = nil }),
+			expErr: []string{"invalid amount \"\": cannot be zero"},
+		},
+		{
+			name: "invalid amount",
+			rp: with(func(rp *RecurringPayment) {
+				rp.Amount = sdk.Coins{sdk.Coin{Denom: "plum", Amount: sdkmath.NewInt(-1)}}
+			}),
+			expErr: []string{"invalid amount \"-1plum\": coin -1plum amount is not positive"},
+		},
+		{
+			name: "invalid external id",
+			rp: with(func(rp *RecurringPayment) {
+				rp.ExternalId = strings.Repeat("x", MaxExternalIDLength+1)
+			}),
+			expErr: []string{fmt.Sprintf("invalid external id %q (length %d): max length %d",
+				"xxxxx...xxxxx", MaxExternalIDLength+1, MaxExternalIDLength)},
+		},
+		{
+			name:   "zero interval",
+			rp:     with(func(rp *RecurringPayment) { rp.IntervalSeconds = 0 }),
+			expErr: []string{"invalid interval: cannot be zero"},
+		},
+		{
+			name:   "zero transfers remaining",
+			rp:     with(func(rp *RecurringPayment) { rp.TransfersRemaining = 0 }),
+			expErr: []string{"invalid transfers remaining: cannot be zero"},
+		},
+		{
+			name:   "next transfer time at epoch",
+			rp:     with(func(rp *RecurringPayment) { rp.NextTransferTime = time.Unix(0, 0) }),
+			expErr: []string{"invalid next transfer time 1970-01-01T00:00:00Z: must be after 1970-01-01T00:00:00Z"},
+		},
+		{
+			name: "too many failures",
+			rp:   with(func(rp *RecurringPayment) { rp.Failures = MaxRecurringPaymentFailures }),
+			expErr: []string{fmt.Sprintf("invalid failures %d: must be less than %d",
+				MaxRecurringPaymentFailures, MaxRecurringPaymentFailures)},
+		},
+		{
+			name: "multiple errors",
+			rp:   RecurringPayment{},
+			expErr: []string{
+				"invalid source \"\": empty address string is not allowed",
+				"invalid target \"\": empty address string is not allowed",
+				"invalid amount \"\": cannot be zero",
+				"invalid interval: cannot be zero",
+				"invalid transfers remaining: cannot be zero",
+				"invalid next transfer time ",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = tc.rp.Validate()
+			}
+			require.NotPanics(t, testFunc, "Validate()")
+			assertions.AssertErrorContents(t, err, tc.expErr, "Validate() error")
+		})
+	}
+}
+
+func TestEscrowedPayment_Validate(t *testing.T) {
+	releaseTime := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	validPayment := ValidPayment
+	validPayment.DisputeWindowSeconds = 3600
+
+	tests := []struct {
+		name   string
+		ep     EscrowedPayment
+		expErr []string
+	}{
+		{
+			name: "valid",
+			ep:   EscrowedPayment{Payment: validPayment, ReleaseTime: releaseTime},
+		},
+		{
+			name: "invalid payment",
+			ep: EscrowedPayment{
+				Payment: Payment{
+					Source:       "notgonnahappen",
+					SourceAmount: validPayment.SourceAmount,
+					Target:       validPayment.Target,
+				},
+				ReleaseTime: releaseTime,
+			},
+			expErr: []string{"invalid source \"notgonnahappen\": decoding bech32 failed: invalid separator index -1"},
+		},
+		{
+			name: "no target",
+			ep: EscrowedPayment{
+				Payment: Payment{
+					Source:       validPayment.Source,
+					SourceAmount: validPayment.SourceAmount,
+				},
+				ReleaseTime: releaseTime,
+			},
+			expErr: []string{"invalid target: cannot be empty"},
+		},
+		{
+			name:   "release time at epoch",
+			ep:     EscrowedPayment{Payment: validPayment, ReleaseTime: time.Unix(0, 0)},
+			expErr: []string{"invalid release time 1970-01-01T00:00:00Z: must be after 1970-01-01T00:00:00Z"},
+		},
+		{
+			name: "multiple errors",
+			ep:   EscrowedPayment{},
+			expErr: []string{
+				"invalid source \"\": empty address string is not allowed",
+				"source amount and target amount cannot both be zero",
+				"invalid target: cannot be empty",
+				"invalid release time 0001-01-01T00:00:00Z: must be after 1970-01-01T00:00:00Z",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = tc.ep.Validate()
+			}
+			require.NotPanics(t, testFunc, "Validate()")
+			assertions.AssertErrorContents(t, err, tc.expErr, "Validate() error")
+		})
+	}
+}
+
+func TestEscrowedPayment_GetNextReleaseAttempt(t *testing.T) {
+	releaseTime := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		failedReleases uint32
+		exp            time.Time
+	}{
+		{name: "no failures", failedReleases: 0, exp: releaseTime},
+		{name: "one failure", failedReleases: 1, exp: releaseTime.Add(time.Hour)},
+		{name: "two failures", failedReleases: 2, exp: releaseTime.Add(3 * time.Hour)},
+		{name: "three failures", failedReleases: 3, exp: releaseTime.Add(7 * time.Hour)},
+		{
+			// 1+2+4+8+16+32+64+128 = 255 hours, then a week (168 hours) for each failure after that.
+			name:           "wait capped at a week",
+			failedReleases: 10,
+			exp:            releaseTime.Add((255 + 2*168) * time.Hour),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ep := EscrowedPayment{ReleaseTime: releaseTime, FailedReleases: tc.failedReleases}
+			var act time.Time
+			testFunc := func() {
+				act = ep.GetNextReleaseAttempt()
+			}
+			require.NotPanics(t, testFunc, "GetNextReleaseAttempt()")
+			assert.Equal(t, tc.exp.Format(time.RFC3339), act.Format(time.RFC3339), "GetNextReleaseAttempt()")
+		})
+	}
+}
+
 func TestPayment_String(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -289,3 +552,503 @@ func TestPayment_String(t *testing.T) {
 		})
 	}
 }
+
+func TestPaymentFilter_IsEmpty(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter *PaymentFilter
+		exp    bool
+	}{
+		{name: "nil", filter: nil, exp: true},
+		{name: "zero value", filter: &PaymentFilter{}, exp: true},
+		{name: "denom", filter: &PaymentFilter{Denom: "banana"}, exp: false},
+		{name: "min amount", filter: &PaymentFilter{MinAmount: "1"}, exp: false},
+		{name: "max amount", filter: &PaymentFilter{MaxAmount: "1"}, exp: false},
+		{name: "target amount", filter: &PaymentFilter{TargetAmount: TargetAmountFilter_absent}, exp: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var act bool
+			testFunc := func() {
+				act = tc.filter.IsEmpty()
+			}
+			require.NotPanics(t, testFunc, "IsEmpty()")
+			assert.Equal(t, tc.exp, act, "IsEmpty() result")
+		})
+	}
+}
+
+func TestPaymentFilter_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter PaymentFilter
+		expErr string
+	}{
+		{name: "empty", filter: PaymentFilter{}},
+		{name: "just denom", filter: PaymentFilter{Denom: "banana"}},
+		{
+			name:   "invalid denom",
+			filter: PaymentFilter{Denom: "x"},
+			expErr: "invalid denom \"x\": invalid denom: x",
+		},
+		{name: "denom with min", filter: PaymentFilter{Denom: "banana", MinAmount: "0"}},
+		{name: "denom with max", filter: PaymentFilter{Denom: "banana", MaxAmount: "12"}},
+		{name: "denom with equal min and max", filter: PaymentFilter{Denom: "banana", MinAmount: "12", MaxAmount: "12"}},
+		{
+			name:   "min more than max",
+			filter: PaymentFilter{Denom: "banana", MinAmount: "13", MaxAmount: "12"},
+			expErr: "min amount 13 cannot be more than max amount 12",
+		},
+		{
+			name:   "min without denom",
+			filter: PaymentFilter{MinAmount: "5"},
+			expErr: "a denom is required in order to filter by amount",
+		},
+		{
+			name:   "max without denom",
+			filter: PaymentFilter{MaxAmount: "5"},
+			expErr: "a denom is required in order to filter by amount",
+		},
+		{
+			name:   "min not an integer",
+			filter: PaymentFilter{Denom: "banana", MinAmount: "1.5"},
+			expErr: "invalid min amount \"1.5\": must be an integer",
+		},
+		{
+			name:   "max negative",
+			filter: PaymentFilter{Denom: "banana", MaxAmount: "-3"},
+			expErr: "invalid max amount \"-3\": cannot be negative",
+		},
+		{name: "target amount present", filter: PaymentFilter{TargetAmount: TargetAmountFilter_present}},
+		{name: "target amount absent", filter: PaymentFilter{TargetAmount: TargetAmountFilter_absent}},
+		{
+			name:   "unknown target amount filter",
+			filter: PaymentFilter{TargetAmount: 3},
+			expErr: "unknown target amount filter 3",
+		},
+		{
+			name:   "multiple errors",
+			filter: PaymentFilter{Denom: "x", MinAmount: "y", TargetAmount: -1},
+			expErr: joinErrs(
+				"invalid denom \"x\": invalid denom: x",
+				"invalid min amount \"y\": must be an integer",
+				"unknown target amount filter -1",
+			),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = tc.filter.Validate()
+			}
+			require.NotPanics(t, testFunc, "Validate()")
+			assertions.AssertErrorValue(t, err, tc.expErr, "Validate() error")
+		})
+	}
+}
+
+func TestPaymentFilter_Matches(t *testing.T) {
+	coins := func(str string) sdk.Coins {
+		rv, err := sdk.ParseCoinsNormalized(str)
+		require.NoError(t, err, "ParseCoinsNormalized(%q)", str)
+		return rv
+	}
+	withTgt := &Payment{SourceAmount: coins("10apple,3banana"), TargetAmount: coins("5cherry")}
+	withoutTgt := &Payment{SourceAmount: coins("10apple,3banana")}
+
+	tests := []struct {
+		name    string
+		filter  PaymentFilter
+		payment *Payment
+		exp     bool
+	}{
+		{name: "nil payment", filter: PaymentFilter{}, payment: nil, exp: false},
+		{name: "empty filter", filter: PaymentFilter{}, payment: withTgt, exp: true},
+		{name: "present: with target amount", filter: PaymentFilter{TargetAmount: TargetAmountFilter_present}, payment: withTgt, exp: true},
+		{name: "present: without target amount", filter: PaymentFilter{TargetAmount: TargetAmountFilter_present}, payment: withoutTgt, exp: false},
+		{name: "absent: with target amount", filter: PaymentFilter{TargetAmount: TargetAmountFilter_absent}, payment: withTgt, exp: false},
+		{name: "absent: without target amount", filter: PaymentFilter{TargetAmount: TargetAmountFilter_absent}, payment: withoutTgt, exp: true},
+		{name: "denom in source amount", filter: PaymentFilter{Denom: "banana"}, payment: withoutTgt, exp: true},
+		{name: "denom only in target amount", filter: PaymentFilter{Denom: "cherry"}, payment: withTgt, exp: false},
+		{name: "amount less than min", filter: PaymentFilter{Denom: "apple", MinAmount: "11"}, payment: withTgt, exp: false},
+		{name: "amount equals min", filter: PaymentFilter{Denom: "apple", MinAmount: "10"}, payment: withTgt, exp: true},
+		{name: "amount equals max", filter: PaymentFilter{Denom: "apple", MaxAmount: "10"}, payment: withTgt, exp: true},
+		{name: "amount more than max", filter: PaymentFilter{Denom: "apple", MaxAmount: "9"}, payment: withTgt, exp: false},
+		{
+			name:    "everything matches",
+			filter:  PaymentFilter{Denom: "banana", MinAmount: "2", MaxAmount: "4", TargetAmount: TargetAmountFilter_absent},
+			payment: withoutTgt,
+			exp:     true,
+		},
+		{
+			name:    "everything but target amount matches",
+			filter:  PaymentFilter{Denom: "banana", MinAmount: "2", MaxAmount: "4", TargetAmount: TargetAmountFilter_absent},
+			payment: withTgt,
+			exp:     false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var act bool
+			testFunc := func() {
+				act = tc.filter.Matches(tc.payment)
+			}
+			require.NotPanics(t, testFunc, "Matches")
+			assert.Equal(t, tc.exp, act, "Matches result")
+		})
+	}
+}
+
+func TestMultiPartyPayment_Validate(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________").String()
+	addr2 := sdk.AccAddress("addr2_______________").String()
+	addr3 := sdk.AccAddress("addr3_______________").String()
+	coins := func(str string) sdk.Coins {
+		rv, err := sdk.ParseCoinsNormalized(str)
+		require.NoError(t, err, "ParseCoinsNormalized(%q)", str)
+		return rv
+	}
+	validMPP := func() MultiPartyPayment {
+		return MultiPartyPayment{
+			Creator:    addr1,
+			ExternalId: "otc-1",
+			Parties: []PaymentParty{
+				{Address: addr1, SendAmount: coins("10apple"), ReceiveAmount: coins("5cherry"), Accepted: true},
+				{Address: addr2, SendAmount: coins("5banana"), ReceiveAmount: coins("10apple")},
+				{Address: addr3, SendAmount: coins("5cherry"), ReceiveAmount: coins("5banana")},
+			},
+		}
+	}
+	with := func(modifier func(mpp *MultiPartyPayment)) MultiPartyPayment {
+		rv := validMPP()
+		modifier(&rv)
+		return rv
+	}
+
+	tests := []struct {
+		name   string
+		mpp    MultiPartyPayment
+		expErr []string
+	}{
+		{
+			name: "valid",
+			mpp:  validMPP(),
+		},
+		{
+			name: "valid: two parties",
+			mpp: with(func(mpp *MultiPartyPayment) {
+				mpp.Parties = []PaymentParty{
+					{Address: addr1, SendAmount: coins("10apple")},
+					{Address: addr2, ReceiveAmount: coins("10apple")},
+				}
+			}),
+		},
+		{
+			name:   "invalid creator",
+			mpp:    with(func(mpp *MultiPartyPayment) { mpp.Creator = "notgonnahappen" }),
+			expErr: []string{"invalid creator \"notgonnahappen\": decoding bech32 failed: invalid separator index -1"},
+		},
+		{
+			name: "creator not a party",
+			mpp: with(func(mpp *MultiPartyPayment) {
+				mpp.Creator = sdk.AccAddress("other_______________").String()
+			}),
+			expErr: []string{"creator " + sdk.AccAddress("other_______________").String() + " is not one of the parties"},
+		},
+		{
+			name: "invalid external id",
+			mpp: with(func(mpp *MultiPartyPayment) {
+				mpp.ExternalId = strings.Repeat("x", MaxExternalIDLength+1)
+			}),
+			expErr: []string{fmt.Sprintf("invalid external id %q (length %d): max length %d",
+				"xxxxx...xxxxx", MaxExternalIDLength+1, MaxExternalIDLength)},
+		},
+		{
+			name: "one party",
+			mpp: with(func(mpp *MultiPartyPayment) {
+				mpp.Parties = mpp.Parties[:1]
+			}),
+			expErr: []string{"at least 2 parties are required, found 1"},
+		},
+		{
+			name: "too many parties",
+			mpp: with(func(mpp *MultiPartyPayment) {
+				for i := len(mpp.Parties); i <= MaxMultiPartyPaymentParties; i++ {
+					mpp.Parties = append(mpp.Parties, PaymentParty{
+						Address:       sdk.AccAddress(fmt.Sprintf("party%02d_____________", i)).String(),
+						SendAmount:    coins("1date"),
+						ReceiveAmount: coins("1date"),
+					})
+				}
+			}),
+			expErr: []string{fmt.Sprintf("too many parties %d: cannot have more than %d",
+				MaxMultiPartyPaymentParties+1, MaxMultiPartyPaymentParties)},
+		},
+		{
+			name: "duplicate party",
+			mpp: with(func(mpp *MultiPartyPayment) {
+				mpp.Parties[2].Address = addr2
+			}),
+			expErr: []string{"invalid parties[2]: duplicate address " + addr2 + " seen at [1]"},
+		},
+		{
+			name: "invalid party address",
+			mpp: with(func(mpp *MultiPartyPayment) {
+				mpp.Parties[1].Address = ""
+			}),
+			expErr: []string{"invalid parties[1]: invalid address \"\": empty address string is not allowed"},
+		},
+		{
+			name: "party with nothing to send or receive",
+			mpp: with(func(mpp *MultiPartyPayment) {
+				mpp.Parties = append(mpp.Parties, PaymentParty{Address: sdk.AccAddress("idle________________").String()})
+			}),
+			expErr: []string{"invalid parties[3]: send amount and receive amount cannot both be zero"},
+		},
+		{
+			name: "negative send amount",
+			mpp: with(func(mpp *MultiPartyPayment) {
+				mpp.Parties[0].SendAmount = sdk.Coins{sdk.Coin{Denom: "apple", Amount: sdkmath.NewInt(-1)}}
+			}),
+			expErr: []string{"invalid parties[0]: invalid send amount \"-1apple\": coin -1apple amount is not positive"},
+		},
+		{
+			name: "negative receive amount",
+			mpp: with(func(mpp *MultiPartyPayment) {
+				mpp.Parties[0].ReceiveAmount = sdk.Coins{sdk.Coin{Denom: "cherry", Amount: sdkmath.NewInt(-1)}}
+			}),
+			expErr: []string{"invalid parties[0]: invalid receive amount \"-1cherry\": coin -1cherry amount is not positive"},
+		},
+		{
+			name: "totals do not balance",
+			mpp: with(func(mpp *MultiPartyPayment) {
+				mpp.Parties[2].SendAmount = coins("4cherry")
+			}),
+			expErr: []string{"total send amount \"10apple,5banana,4cherry\" does not equal total receive amount \"10apple,5banana,5cherry\""},
+		},
+		{
+			name: "multiple errors",
+			mpp:  MultiPartyPayment{},
+			expErr: []string{
+				"invalid creator \"\": empty address string is not allowed",
+				"at least 2 parties are required, found 0",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = tc.mpp.Validate()
+			}
+			require.NotPanics(t, testFunc, "Validate()")
+			assertions.AssertErrorContents(t, err, tc.expErr, "Validate() error")
+		})
+	}
+}
+
+func TestMultiPartyPayment_AllAccepted(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________").String()
+	addr2 := sdk.AccAddress("addr2_______________").String()
+	addr3 := sdk.AccAddress("addr3_______________").String()
+
+	tests := []struct {
+		name      string
+		accepted  []bool
+		expAll    bool
+		expExcept bool // The result of AllAcceptedExcept(addr2).
+	}{
+		{name: "none accepted", accepted: []bool{false, false, false}, expAll: false, expExcept: false},
+		{name: "only except addr accepted", accepted: []bool{false, true, false}, expAll: false, expExcept: false},
+		{name: "all but except addr accepted", accepted: []bool{true, false, true}, expAll: false, expExcept: true},
+		{name: "all but other addr accepted", accepted: []bool{true, true, false}, expAll: false, expExcept: false},
+		{name: "all accepted", accepted: []bool{true, true, true}, expAll: true, expExcept: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mpp := MultiPartyPayment{
+				Parties: []PaymentParty{
+					{Address: addr1, Accepted: tc.accepted[0]},
+					{Address: addr2, Accepted: tc.accepted[1]},
+					{Address: addr3, Accepted: tc.accepted[2]},
+				},
+			}
+			assert.Equal(t, tc.expAll, mpp.AllAccepted(), "AllAccepted()")
+			assert.Equal(t, tc.expExcept, mpp.AllAcceptedExcept(addr2), "AllAcceptedExcept(addr2)")
+			assert.Equal(t, []string{addr1, addr2, addr3}, mpp.GetPartyAddrs(), "GetPartyAddrs()")
+		})
+	}
+}
+
+func TestMultiPartyPayment_GetParty(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________").String()
+	addr2 := sdk.AccAddress("addr2_______________").String()
+	mpp := MultiPartyPayment{
+		Parties: []PaymentParty{
+			{Address: addr1, SendAmount: sdk.NewCoins(sdk.NewInt64Coin("apple", 1))},
+			{Address: addr2, ReceiveAmount: sdk.NewCoins(sdk.NewInt64Coin("apple", 1))},
+		},
+	}
+
+	party := mpp.GetParty(addr2)
+	if assert.NotNil(t, party, "GetParty(addr2)") {
+		assert.Equal(t, mpp.Parties[1], *party, "GetParty(addr2)")
+		party.Accepted = true
+		assert.True(t, mpp.Parties[1].Accepted, "Parties[1].Accepted after updating result of GetParty(addr2)")
+	}
+	assert.Nil(t, mpp.GetParty(sdk.AccAddress("other_______________").String()), "GetParty(other)")
+	assert.Nil(t, mpp.GetParty(""), "GetParty(\"\")")
+}
+
+func TestMultiPartyPayment_GetTransfers(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________").String()
+	addr2 := sdk.AccAddress("addr2_______________").String()
+	addr3 := sdk.AccAddress("addr3_______________").String()
+	addr4 := sdk.AccAddress("addr4_______________").String()
+	coins := func(str string) sdk.Coins {
+		rv, err := sdk.ParseCoinsNormalized(str)
+		require.NoError(t, err, "ParseCoinsNormalized(%q)", str)
+		return rv
+	}
+	party := func(addr, send, receive string) PaymentParty {
+		return PaymentParty{Address: addr, SendAmount: coins(send), ReceiveAmount: coins(receive)}
+	}
+	transfer := func(from, sent string, outputs ...banktypes.Output) *Transfer {
+		return &Transfer{Inputs: []banktypes.Input{{Address: from, Coins: coins(sent)}}, Outputs: outputs}
+	}
+	output := func(to, amt string) banktypes.Output {
+		return banktypes.Output{Address: to, Coins: coins(amt)}
+	}
+
+	tests := []struct {
+		name    string
+		parties []PaymentParty
+		exp     []*Transfer
+	}{
+		{
+			name:    "no parties",
+			parties: nil,
+			exp:     nil,
+		},
+		{
+			name:    "two party swap",
+			parties: []PaymentParty{party(addr1, "10apple", "5banana"), party(addr2, "5banana", "10apple")},
+			exp: []*Transfer{
+				transfer(addr1, "10apple", output(addr2, "10apple")),
+				transfer(addr2, "5banana", output(addr1, "5banana")),
+			},
+		},
+		{
+			name: "three way swap",
+			parties: []PaymentParty{
+				party(addr1, "10apple", "5cherry"),
+				party(addr2, "5banana", "10apple"),
+				party(addr3, "5cherry", "5banana"),
+			},
+			exp: []*Transfer{
+				transfer(addr1, "10apple", output(addr2, "10apple")),
+				transfer(addr2, "5banana", output(addr3, "5banana")),
+				transfer(addr3, "5cherry", output(addr1, "5cherry")),
+			},
+		},
+		{
+			name:    "one sender to two receivers",
+			parties: []PaymentParty{party(addr1, "10apple", ""), party(addr2, "", "4apple"), party(addr3, "", "6apple")},
+			exp: []*Transfer{
+				transfer(addr1, "10apple", output(addr2, "4apple"), output(addr3, "6apple")),
+			},
+		},
+		{
+			name:    "two senders to one receiver",
+			parties: []PaymentParty{party(addr1, "4apple", ""), party(addr2, "6apple", ""), party(addr3, "", "10apple")},
+			exp: []*Transfer{
+				transfer(addr1, "4apple", output(addr3, "4apple")),
+				transfer(addr2, "6apple", output(addr3, "6apple")),
+			},
+		},
+		{
+			name: "sends split across receivers",
+			parties: []PaymentParty{
+				party(addr1, "10apple", ""),
+				party(addr2, "5apple", ""),
+				party(addr3, "", "8apple"),
+				party(addr4, "", "7apple"),
+			},
+			exp: []*Transfer{
+				transfer(addr1, "10apple", output(addr3, "8apple"), output(addr4, "2apple")),
+				transfer(addr2, "5apple", output(addr4, "5apple")),
+			},
+		},
+		{
+			name: "multiple denoms",
+			parties: []PaymentParty{
+				party(addr1, "10apple,4banana", "3cherry"),
+				party(addr2, "3cherry", "10apple,1banana"),
+				party(addr3, "", "3banana"),
+			},
+			exp: []*Transfer{
+				transfer(addr1, "10apple,4banana", output(addr2, "10apple,1banana"), output(addr3, "3banana")),
+				transfer(addr2, "3cherry", output(addr1, "3cherry")),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mpp := MultiPartyPayment{Parties: tc.parties}
+			var actual []*Transfer
+			testFunc := func() {
+				actual = mpp.GetTransfers()
+			}
+			require.NotPanics(t, testFunc, "GetTransfers")
+			assert.Equal(t, tc.exp, actual, "GetTransfers")
+		})
+	}
+}
+
+func TestPaymentParty_EqualTerms(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________").String()
+	addr2 := sdk.AccAddress("addr2_______________").String()
+	base := PaymentParty{
+		Address:       addr1,
+		SendAmount:    sdk.NewCoins(sdk.NewInt64Coin("apple", 3)),
+		ReceiveAmount: sdk.NewCoins(sdk.NewInt64Coin("banana", 4)),
+	}
+	with := func(modifier func(p *PaymentParty)) PaymentParty {
+		rv := base
+		modifier(&rv)
+		return rv
+	}
+
+	tests := []struct {
+		name  string
+		other PaymentParty
+		exp   bool
+	}{
+		{name: "same", other: base, exp: true},
+		{name: "different accepted", other: with(func(p *PaymentParty) { p.Accepted = true }), exp: true},
+		{name: "different address", other: with(func(p *PaymentParty) { p.Address = addr2 }), exp: false},
+		{
+			name:  "different send amount",
+			other: with(func(p *PaymentParty) { p.SendAmount = sdk.NewCoins(sdk.NewInt64Coin("apple", 2)) }),
+			exp:   false,
+		},
+		{
+			name:  "different receive amount",
+			other: with(func(p *PaymentParty) { p.ReceiveAmount = nil }),
+			exp:   false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.exp, base.EqualTerms(tc.other), "EqualTerms")
+		})
+	}
+}
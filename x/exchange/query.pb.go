@@ -108,7 +108,14 @@ type QueryOrderFeeCalcResponse struct {
 	//
 	// If the provided order was an ask order, these are purely informational and represent how much will be removed
 	// from your price if it settles at that price. If it settles for more, the actual amount will probably be larger.
+	// For ask orders, this is the ratio fee applied when the order settles as a maker.
 	SettlementRatioFeeOptions []types.Coin `protobuf:"bytes,3,rep,name=settlement_ratio_fee_options,json=settlementRatioFeeOptions,proto3" json:"settlement_ratio_fee_options"`
+	// settlement_taker_ratio_fee_options are the settlement ratio fee options that apply when the provided order settles
+	// as a taker (i.e. it is newer than the orders it is matched with).
+	//
+	// This is only populated for ask orders. If the market has no taker ratio for the price denom, this will be the same
+	// as the settlement_ratio_fee_options. Like those, these are purely informational.
+	SettlementTakerRatioFeeOptions []types.Coin `protobuf:"bytes,4,rep,name=settlement_taker_ratio_fee_options,json=settlementTakerRatioFeeOptions,proto3" json:"settlement_taker_ratio_fee_options"`
 }
 
 func (m *QueryOrderFeeCalcResponse) Reset()         { *m = QueryOrderFeeCalcResponse{} }
@@ -165,6 +172,13 @@ func (m *QueryOrderFeeCalcResponse) GetSettlementRatioFeeOptions() []types.Coin
 	return nil
 }
 
+func (m *QueryOrderFeeCalcResponse) GetSettlementTakerRatioFeeOptions() []types.Coin {
+	if m != nil {
+		return m.SettlementTakerRatioFeeOptions
+	}
+	return nil
+}
+
 // QueryGetOrderRequest is a request message for the GetOrder query.
 type QueryGetOrderRequest struct {
 	// order_id is the id of the order to look up.
@@ -358,6 +372,129 @@ func (m *QueryGetOrderByExternalIDResponse) GetOrder() *Order {
 	return nil
 }
 
+// QueryGetOrdersByExternalIDPrefixRequest is a request message for the GetOrdersByExternalIDPrefix query.
+type QueryGetOrdersByExternalIDPrefixRequest struct {
+	// market_id is the id of the market to look for orders in.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// external_id_prefix is the start of the external ids to look for.
+	ExternalIdPrefix string `protobuf:"bytes,2,opt,name=external_id_prefix,json=externalIdPrefix,proto3" json:"external_id_prefix,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGetOrdersByExternalIDPrefixRequest) Reset() {
+	*m = QueryGetOrdersByExternalIDPrefixRequest{}
+}
+func (m *QueryGetOrdersByExternalIDPrefixRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetOrdersByExternalIDPrefixRequest) ProtoMessage()    {}
+func (*QueryGetOrdersByExternalIDPrefixRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{6}
+}
+func (m *QueryGetOrdersByExternalIDPrefixRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetOrdersByExternalIDPrefixRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetOrdersByExternalIDPrefixRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetOrdersByExternalIDPrefixRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetOrdersByExternalIDPrefixRequest.Merge(m, src)
+}
+func (m *QueryGetOrdersByExternalIDPrefixRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetOrdersByExternalIDPrefixRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetOrdersByExternalIDPrefixRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetOrdersByExternalIDPrefixRequest proto.InternalMessageInfo
+
+func (m *QueryGetOrdersByExternalIDPrefixRequest) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *QueryGetOrdersByExternalIDPrefixRequest) GetExternalIdPrefix() string {
+	if m != nil {
+		return m.ExternalIdPrefix
+	}
+	return ""
+}
+
+func (m *QueryGetOrdersByExternalIDPrefixRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGetOrdersByExternalIDPrefixResponse is a response message for the GetOrdersByExternalIDPrefix query.
+type QueryGetOrdersByExternalIDPrefixResponse struct {
+	// orders are a page of the orders with an external id that starts with the provided prefix.
+	Orders []*Order `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	// pagination is the resulting pagination parameters.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGetOrdersByExternalIDPrefixResponse) Reset() {
+	*m = QueryGetOrdersByExternalIDPrefixResponse{}
+}
+func (m *QueryGetOrdersByExternalIDPrefixResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetOrdersByExternalIDPrefixResponse) ProtoMessage()    {}
+func (*QueryGetOrdersByExternalIDPrefixResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{7}
+}
+func (m *QueryGetOrdersByExternalIDPrefixResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetOrdersByExternalIDPrefixResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetOrdersByExternalIDPrefixResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetOrdersByExternalIDPrefixResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetOrdersByExternalIDPrefixResponse.Merge(m, src)
+}
+func (m *QueryGetOrdersByExternalIDPrefixResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetOrdersByExternalIDPrefixResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetOrdersByExternalIDPrefixResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetOrdersByExternalIDPrefixResponse proto.InternalMessageInfo
+
+func (m *QueryGetOrdersByExternalIDPrefixResponse) GetOrders() []*Order {
+	if m != nil {
+		return m.Orders
+	}
+	return nil
+}
+
+func (m *QueryGetOrdersByExternalIDPrefixResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryGetMarketOrdersRequest is a request message for the GetMarketOrders query.
 type QueryGetMarketOrdersRequest struct {
 	// market_id is the id of the market to get all the orders for.
@@ -366,6 +503,12 @@ type QueryGetMarketOrdersRequest struct {
 	OrderType string `protobuf:"bytes,2,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
 	// after_order_id is a minimum (exclusive) order id. All results will be strictly greater than this.
 	AfterOrderId uint64 `protobuf:"varint,3,opt,name=after_order_id,json=afterOrderId,proto3" json:"after_order_id,omitempty"`
+	// asset_denom is optional, but if provided, price_denom must also be provided. When both are provided,
+	// results are limited to orders with those denoms and are ordered by unit price (lowest first) instead of order id.
+	// Without an order_type, all the ask orders are returned before the bid orders.
+	AssetDenom string `protobuf:"bytes,4,opt,name=asset_denom,json=assetDenom,proto3" json:"asset_denom,omitempty"`
+	// price_denom is optional, but if provided, asset_denom must also be provided.
+	PriceDenom string `protobuf:"bytes,5,opt,name=price_denom,json=priceDenom,proto3" json:"price_denom,omitempty"`
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
@@ -374,7 +517,7 @@ func (m *QueryGetMarketOrdersRequest) Reset()         { *m = QueryGetMarketOrder
 func (m *QueryGetMarketOrdersRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetMarketOrdersRequest) ProtoMessage()    {}
 func (*QueryGetMarketOrdersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{6}
+	return fileDescriptor_00949b75b1c10bfe, []int{8}
 }
 func (m *QueryGetMarketOrdersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -424,6 +567,20 @@ func (m *QueryGetMarketOrdersRequest) GetAfterOrderId() uint64 {
 	return 0
 }
 
+func (m *QueryGetMarketOrdersRequest) GetAssetDenom() string {
+	if m != nil {
+		return m.AssetDenom
+	}
+	return ""
+}
+
+func (m *QueryGetMarketOrdersRequest) GetPriceDenom() string {
+	if m != nil {
+		return m.PriceDenom
+	}
+	return ""
+}
+
 func (m *QueryGetMarketOrdersRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
@@ -443,7 +600,7 @@ func (m *QueryGetMarketOrdersResponse) Reset()         { *m = QueryGetMarketOrde
 func (m *QueryGetMarketOrdersResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetMarketOrdersResponse) ProtoMessage()    {}
 func (*QueryGetMarketOrdersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{7}
+	return fileDescriptor_00949b75b1c10bfe, []int{9}
 }
 func (m *QueryGetMarketOrdersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -502,7 +659,7 @@ func (m *QueryGetOwnerOrdersRequest) Reset()         { *m = QueryGetOwnerOrdersR
 func (m *QueryGetOwnerOrdersRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetOwnerOrdersRequest) ProtoMessage()    {}
 func (*QueryGetOwnerOrdersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{8}
+	return fileDescriptor_00949b75b1c10bfe, []int{10}
 }
 func (m *QueryGetOwnerOrdersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -571,7 +728,7 @@ func (m *QueryGetOwnerOrdersResponse) Reset()         { *m = QueryGetOwnerOrders
 func (m *QueryGetOwnerOrdersResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetOwnerOrdersResponse) ProtoMessage()    {}
 func (*QueryGetOwnerOrdersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{9}
+	return fileDescriptor_00949b75b1c10bfe, []int{11}
 }
 func (m *QueryGetOwnerOrdersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -630,7 +787,7 @@ func (m *QueryGetAssetOrdersRequest) Reset()         { *m = QueryGetAssetOrdersR
 func (m *QueryGetAssetOrdersRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetAssetOrdersRequest) ProtoMessage()    {}
 func (*QueryGetAssetOrdersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{10}
+	return fileDescriptor_00949b75b1c10bfe, []int{12}
 }
 func (m *QueryGetAssetOrdersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -699,7 +856,7 @@ func (m *QueryGetAssetOrdersResponse) Reset()         { *m = QueryGetAssetOrders
 func (m *QueryGetAssetOrdersResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetAssetOrdersResponse) ProtoMessage()    {}
 func (*QueryGetAssetOrdersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{11}
+	return fileDescriptor_00949b75b1c10bfe, []int{13}
 }
 func (m *QueryGetAssetOrdersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -752,7 +909,7 @@ func (m *QueryGetAllOrdersRequest) Reset()         { *m = QueryGetAllOrdersReque
 func (m *QueryGetAllOrdersRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetAllOrdersRequest) ProtoMessage()    {}
 func (*QueryGetAllOrdersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{12}
+	return fileDescriptor_00949b75b1c10bfe, []int{14}
 }
 func (m *QueryGetAllOrdersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -800,7 +957,7 @@ func (m *QueryGetAllOrdersResponse) Reset()         { *m = QueryGetAllOrdersResp
 func (m *QueryGetAllOrdersResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetAllOrdersResponse) ProtoMessage()    {}
 func (*QueryGetAllOrdersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{13}
+	return fileDescriptor_00949b75b1c10bfe, []int{15}
 }
 func (m *QueryGetAllOrdersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -843,26 +1000,24 @@ func (m *QueryGetAllOrdersResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-// QueryGetCommitmentRequest is a request message for the GetCommitment query.
-type QueryGetCommitmentRequest struct {
-	// account is the bech32 address string of the account in the commitment.
-	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	// market_id is the numeric identifier of the market in the commitment.
-	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+// QueryGetTriggerOrderRequest is a request message for the GetTriggerOrder query.
+type QueryGetTriggerOrderRequest struct {
+	// order_id is the id of the trigger order to look up.
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 }
 
-func (m *QueryGetCommitmentRequest) Reset()         { *m = QueryGetCommitmentRequest{} }
-func (m *QueryGetCommitmentRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetCommitmentRequest) ProtoMessage()    {}
-func (*QueryGetCommitmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{14}
+func (m *QueryGetTriggerOrderRequest) Reset()         { *m = QueryGetTriggerOrderRequest{} }
+func (m *QueryGetTriggerOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetTriggerOrderRequest) ProtoMessage()    {}
+func (*QueryGetTriggerOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{16}
 }
-func (m *QueryGetCommitmentRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetTriggerOrderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetCommitmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetTriggerOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetCommitmentRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetTriggerOrderRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -872,50 +1027,43 @@ func (m *QueryGetCommitmentRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *QueryGetCommitmentRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetCommitmentRequest.Merge(m, src)
+func (m *QueryGetTriggerOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetTriggerOrderRequest.Merge(m, src)
 }
-func (m *QueryGetCommitmentRequest) XXX_Size() int {
+func (m *QueryGetTriggerOrderRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetCommitmentRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetCommitmentRequest.DiscardUnknown(m)
+func (m *QueryGetTriggerOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetTriggerOrderRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetCommitmentRequest proto.InternalMessageInfo
-
-func (m *QueryGetCommitmentRequest) GetAccount() string {
-	if m != nil {
-		return m.Account
-	}
-	return ""
-}
+var xxx_messageInfo_QueryGetTriggerOrderRequest proto.InternalMessageInfo
 
-func (m *QueryGetCommitmentRequest) GetMarketId() uint32 {
+func (m *QueryGetTriggerOrderRequest) GetOrderId() uint64 {
 	if m != nil {
-		return m.MarketId
+		return m.OrderId
 	}
 	return 0
 }
 
-// QueryGetCommitmentResponse is a response message for the GetCommitment query.
-type QueryGetCommitmentResponse struct {
-	// amount is the total funds committed to the market by the account.
-	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+// QueryGetTriggerOrderResponse is a response message for the GetTriggerOrder query.
+type QueryGetTriggerOrderResponse struct {
+	// trigger_order is the requested trigger order.
+	TriggerOrder *TriggerOrder `protobuf:"bytes,1,opt,name=trigger_order,json=triggerOrder,proto3" json:"trigger_order,omitempty"`
 }
 
-func (m *QueryGetCommitmentResponse) Reset()         { *m = QueryGetCommitmentResponse{} }
-func (m *QueryGetCommitmentResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetCommitmentResponse) ProtoMessage()    {}
-func (*QueryGetCommitmentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{15}
+func (m *QueryGetTriggerOrderResponse) Reset()         { *m = QueryGetTriggerOrderResponse{} }
+func (m *QueryGetTriggerOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetTriggerOrderResponse) ProtoMessage()    {}
+func (*QueryGetTriggerOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{17}
 }
-func (m *QueryGetCommitmentResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetTriggerOrderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetCommitmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetTriggerOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetCommitmentResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetTriggerOrderResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -925,45 +1073,45 @@ func (m *QueryGetCommitmentResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryGetCommitmentResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetCommitmentResponse.Merge(m, src)
+func (m *QueryGetTriggerOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetTriggerOrderResponse.Merge(m, src)
 }
-func (m *QueryGetCommitmentResponse) XXX_Size() int {
+func (m *QueryGetTriggerOrderResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetCommitmentResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetCommitmentResponse.DiscardUnknown(m)
+func (m *QueryGetTriggerOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetTriggerOrderResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetCommitmentResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetTriggerOrderResponse proto.InternalMessageInfo
 
-func (m *QueryGetCommitmentResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *QueryGetTriggerOrderResponse) GetTriggerOrder() *TriggerOrder {
 	if m != nil {
-		return m.Amount
+		return m.TriggerOrder
 	}
 	return nil
 }
 
-// QueryGetAccountCommitmentsRequest is a request message for the GetAccountCommitments query.
-type QueryGetAccountCommitmentsRequest struct {
-	// account is the bech32 address string of the account with the commitments.
-	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	// denom is an optional denom that, if provided, limits the results to just that denom.
-	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+// QueryGetMarketTriggerOrdersRequest is a request message for the GetMarketTriggerOrders query.
+type QueryGetMarketTriggerOrdersRequest struct {
+	// market_id is the id of the market to get the pending trigger orders for.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetAccountCommitmentsRequest) Reset()         { *m = QueryGetAccountCommitmentsRequest{} }
-func (m *QueryGetAccountCommitmentsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetAccountCommitmentsRequest) ProtoMessage()    {}
-func (*QueryGetAccountCommitmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{16}
+func (m *QueryGetMarketTriggerOrdersRequest) Reset()         { *m = QueryGetMarketTriggerOrdersRequest{} }
+func (m *QueryGetMarketTriggerOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMarketTriggerOrdersRequest) ProtoMessage()    {}
+func (*QueryGetMarketTriggerOrdersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{18}
 }
-func (m *QueryGetAccountCommitmentsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetMarketTriggerOrdersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetAccountCommitmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetMarketTriggerOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetAccountCommitmentsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetMarketTriggerOrdersRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -973,50 +1121,52 @@ func (m *QueryGetAccountCommitmentsRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryGetAccountCommitmentsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetAccountCommitmentsRequest.Merge(m, src)
+func (m *QueryGetMarketTriggerOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMarketTriggerOrdersRequest.Merge(m, src)
 }
-func (m *QueryGetAccountCommitmentsRequest) XXX_Size() int {
+func (m *QueryGetMarketTriggerOrdersRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetAccountCommitmentsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetAccountCommitmentsRequest.DiscardUnknown(m)
+func (m *QueryGetMarketTriggerOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMarketTriggerOrdersRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetAccountCommitmentsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetMarketTriggerOrdersRequest proto.InternalMessageInfo
 
-func (m *QueryGetAccountCommitmentsRequest) GetAccount() string {
+func (m *QueryGetMarketTriggerOrdersRequest) GetMarketId() uint32 {
 	if m != nil {
-		return m.Account
+		return m.MarketId
 	}
-	return ""
+	return 0
 }
 
-func (m *QueryGetAccountCommitmentsRequest) GetDenom() string {
+func (m *QueryGetMarketTriggerOrdersRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.Denom
+		return m.Pagination
 	}
-	return ""
+	return nil
 }
 
-// QueryGetAccountCommitmentsResponse is a response message for the GetAccountCommitments query.
-type QueryGetAccountCommitmentsResponse struct {
-	// commitments is the amounts committed from the account to the any market.
-	Commitments []*MarketAmount `protobuf:"bytes,1,rep,name=commitments,proto3" json:"commitments,omitempty"`
+// QueryGetMarketTriggerOrdersResponse is a response message for the GetMarketTriggerOrders query.
+type QueryGetMarketTriggerOrdersResponse struct {
+	// trigger_orders are a page of the pending trigger orders in the provided market.
+	TriggerOrders []TriggerOrder `protobuf:"bytes,1,rep,name=trigger_orders,json=triggerOrders,proto3" json:"trigger_orders"`
+	// pagination is the resulting pagination parameters.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetAccountCommitmentsResponse) Reset()         { *m = QueryGetAccountCommitmentsResponse{} }
-func (m *QueryGetAccountCommitmentsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetAccountCommitmentsResponse) ProtoMessage()    {}
-func (*QueryGetAccountCommitmentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{17}
+func (m *QueryGetMarketTriggerOrdersResponse) Reset()         { *m = QueryGetMarketTriggerOrdersResponse{} }
+func (m *QueryGetMarketTriggerOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMarketTriggerOrdersResponse) ProtoMessage()    {}
+func (*QueryGetMarketTriggerOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{19}
 }
-func (m *QueryGetAccountCommitmentsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetMarketTriggerOrdersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetAccountCommitmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetMarketTriggerOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetAccountCommitmentsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetMarketTriggerOrdersResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1026,45 +1176,50 @@ func (m *QueryGetAccountCommitmentsResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryGetAccountCommitmentsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetAccountCommitmentsResponse.Merge(m, src)
+func (m *QueryGetMarketTriggerOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMarketTriggerOrdersResponse.Merge(m, src)
 }
-func (m *QueryGetAccountCommitmentsResponse) XXX_Size() int {
+func (m *QueryGetMarketTriggerOrdersResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetAccountCommitmentsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetAccountCommitmentsResponse.DiscardUnknown(m)
+func (m *QueryGetMarketTriggerOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMarketTriggerOrdersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetAccountCommitmentsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetMarketTriggerOrdersResponse proto.InternalMessageInfo
 
-func (m *QueryGetAccountCommitmentsResponse) GetCommitments() []*MarketAmount {
+func (m *QueryGetMarketTriggerOrdersResponse) GetTriggerOrders() []TriggerOrder {
 	if m != nil {
-		return m.Commitments
+		return m.TriggerOrders
 	}
 	return nil
 }
 
-// QueryGetMarketCommitmentsRequest is a request message for the GetMarketCommitments query.
-type QueryGetMarketCommitmentsRequest struct {
-	// market_id is the numeric identifier of the market with the commitment.
-	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	// pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryGetMarketTriggerOrdersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryGetMarketCommitmentsRequest) Reset()         { *m = QueryGetMarketCommitmentsRequest{} }
-func (m *QueryGetMarketCommitmentsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetMarketCommitmentsRequest) ProtoMessage()    {}
-func (*QueryGetMarketCommitmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{18}
+// QueryGetOrderLinkRequest is a request message for the GetOrderLink query.
+type QueryGetOrderLinkRequest struct {
+	// order_id is the id of the order to look up the link for.
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 }
-func (m *QueryGetMarketCommitmentsRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryGetOrderLinkRequest) Reset()         { *m = QueryGetOrderLinkRequest{} }
+func (m *QueryGetOrderLinkRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetOrderLinkRequest) ProtoMessage()    {}
+func (*QueryGetOrderLinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{20}
+}
+func (m *QueryGetOrderLinkRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetMarketCommitmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetOrderLinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetMarketCommitmentsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetOrderLinkRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1074,52 +1229,43 @@ func (m *QueryGetMarketCommitmentsRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *QueryGetMarketCommitmentsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetMarketCommitmentsRequest.Merge(m, src)
+func (m *QueryGetOrderLinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetOrderLinkRequest.Merge(m, src)
 }
-func (m *QueryGetMarketCommitmentsRequest) XXX_Size() int {
+func (m *QueryGetOrderLinkRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetMarketCommitmentsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetMarketCommitmentsRequest.DiscardUnknown(m)
+func (m *QueryGetOrderLinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetOrderLinkRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetMarketCommitmentsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetOrderLinkRequest proto.InternalMessageInfo
 
-func (m *QueryGetMarketCommitmentsRequest) GetMarketId() uint32 {
+func (m *QueryGetOrderLinkRequest) GetOrderId() uint64 {
 	if m != nil {
-		return m.MarketId
+		return m.OrderId
 	}
 	return 0
 }
 
-func (m *QueryGetMarketCommitmentsRequest) GetPagination() *query.PageRequest {
-	if m != nil {
-		return m.Pagination
-	}
-	return nil
-}
-
-// QueryGetMarketCommitmentsResponse is a response message for the GetMarketCommitments query.
-type QueryGetMarketCommitmentsResponse struct {
-	// commitments is the amounts committed to the market from any account.
-	Commitments []*AccountAmount `protobuf:"bytes,1,rep,name=commitments,proto3" json:"commitments,omitempty"`
-	// pagination is the resulting pagination parameters.
-	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryGetOrderLinkResponse is a response message for the GetOrderLink query.
+type QueryGetOrderLinkResponse struct {
+	// order_link is the link that the requested order has.
+	OrderLink *OrderLink `protobuf:"bytes,1,opt,name=order_link,json=orderLink,proto3" json:"order_link,omitempty"`
 }
 
-func (m *QueryGetMarketCommitmentsResponse) Reset()         { *m = QueryGetMarketCommitmentsResponse{} }
-func (m *QueryGetMarketCommitmentsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetMarketCommitmentsResponse) ProtoMessage()    {}
-func (*QueryGetMarketCommitmentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{19}
+func (m *QueryGetOrderLinkResponse) Reset()         { *m = QueryGetOrderLinkResponse{} }
+func (m *QueryGetOrderLinkResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetOrderLinkResponse) ProtoMessage()    {}
+func (*QueryGetOrderLinkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{21}
 }
-func (m *QueryGetMarketCommitmentsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetOrderLinkResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetMarketCommitmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetOrderLinkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetMarketCommitmentsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetOrderLinkResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1129,50 +1275,45 @@ func (m *QueryGetMarketCommitmentsResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryGetMarketCommitmentsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetMarketCommitmentsResponse.Merge(m, src)
+func (m *QueryGetOrderLinkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetOrderLinkResponse.Merge(m, src)
 }
-func (m *QueryGetMarketCommitmentsResponse) XXX_Size() int {
+func (m *QueryGetOrderLinkResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetMarketCommitmentsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetMarketCommitmentsResponse.DiscardUnknown(m)
+func (m *QueryGetOrderLinkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetOrderLinkResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetMarketCommitmentsResponse proto.InternalMessageInfo
-
-func (m *QueryGetMarketCommitmentsResponse) GetCommitments() []*AccountAmount {
-	if m != nil {
-		return m.Commitments
-	}
-	return nil
-}
+var xxx_messageInfo_QueryGetOrderLinkResponse proto.InternalMessageInfo
 
-func (m *QueryGetMarketCommitmentsResponse) GetPagination() *query.PageResponse {
+func (m *QueryGetOrderLinkResponse) GetOrderLink() *OrderLink {
 	if m != nil {
-		return m.Pagination
+		return m.OrderLink
 	}
 	return nil
 }
 
-// QueryGetAllCommitmentsRequest is a request message for the GetAllCommitments query.
-type QueryGetAllCommitmentsRequest struct {
+// QueryGetMarketOrderLinksRequest is a request message for the GetMarketOrderLinks query.
+type QueryGetMarketOrderLinksRequest struct {
+	// market_id is the id of the market to get the order links for.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetAllCommitmentsRequest) Reset()         { *m = QueryGetAllCommitmentsRequest{} }
-func (m *QueryGetAllCommitmentsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetAllCommitmentsRequest) ProtoMessage()    {}
-func (*QueryGetAllCommitmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{20}
+func (m *QueryGetMarketOrderLinksRequest) Reset()         { *m = QueryGetMarketOrderLinksRequest{} }
+func (m *QueryGetMarketOrderLinksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMarketOrderLinksRequest) ProtoMessage()    {}
+func (*QueryGetMarketOrderLinksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{22}
 }
-func (m *QueryGetAllCommitmentsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetMarketOrderLinksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetAllCommitmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetMarketOrderLinksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetAllCommitmentsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetMarketOrderLinksRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1182,45 +1323,52 @@ func (m *QueryGetAllCommitmentsRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryGetAllCommitmentsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetAllCommitmentsRequest.Merge(m, src)
+func (m *QueryGetMarketOrderLinksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMarketOrderLinksRequest.Merge(m, src)
 }
-func (m *QueryGetAllCommitmentsRequest) XXX_Size() int {
+func (m *QueryGetMarketOrderLinksRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetAllCommitmentsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetAllCommitmentsRequest.DiscardUnknown(m)
+func (m *QueryGetMarketOrderLinksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMarketOrderLinksRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetAllCommitmentsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetMarketOrderLinksRequest proto.InternalMessageInfo
 
-func (m *QueryGetAllCommitmentsRequest) GetPagination() *query.PageRequest {
+func (m *QueryGetMarketOrderLinksRequest) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *QueryGetMarketOrderLinksRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryGetAllCommitmentsResponse is a response message for the GetAllCommitments query.
-type QueryGetAllCommitmentsResponse struct {
-	// commitments is the requested commitment information.
-	Commitments []*Commitment `protobuf:"bytes,1,rep,name=commitments,proto3" json:"commitments,omitempty"`
+// QueryGetMarketOrderLinksResponse is a response message for the GetMarketOrderLinks query.
+type QueryGetMarketOrderLinksResponse struct {
+	// order_links are a page of the order links in the provided market. Each pair of linked orders is only listed once.
+	OrderLinks []OrderLink `protobuf:"bytes,1,rep,name=order_links,json=orderLinks,proto3" json:"order_links"`
 	// pagination is the resulting pagination parameters.
 	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetAllCommitmentsResponse) Reset()         { *m = QueryGetAllCommitmentsResponse{} }
-func (m *QueryGetAllCommitmentsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetAllCommitmentsResponse) ProtoMessage()    {}
-func (*QueryGetAllCommitmentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{21}
+func (m *QueryGetMarketOrderLinksResponse) Reset()         { *m = QueryGetMarketOrderLinksResponse{} }
+func (m *QueryGetMarketOrderLinksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMarketOrderLinksResponse) ProtoMessage()    {}
+func (*QueryGetMarketOrderLinksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{23}
 }
-func (m *QueryGetAllCommitmentsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetMarketOrderLinksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetAllCommitmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetMarketOrderLinksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetAllCommitmentsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetMarketOrderLinksResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1230,50 +1378,50 @@ func (m *QueryGetAllCommitmentsResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryGetAllCommitmentsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetAllCommitmentsResponse.Merge(m, src)
+func (m *QueryGetMarketOrderLinksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMarketOrderLinksResponse.Merge(m, src)
 }
-func (m *QueryGetAllCommitmentsResponse) XXX_Size() int {
+func (m *QueryGetMarketOrderLinksResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetAllCommitmentsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetAllCommitmentsResponse.DiscardUnknown(m)
+func (m *QueryGetMarketOrderLinksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMarketOrderLinksResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetAllCommitmentsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetMarketOrderLinksResponse proto.InternalMessageInfo
 
-func (m *QueryGetAllCommitmentsResponse) GetCommitments() []*Commitment {
+func (m *QueryGetMarketOrderLinksResponse) GetOrderLinks() []OrderLink {
 	if m != nil {
-		return m.Commitments
+		return m.OrderLinks
 	}
 	return nil
 }
 
-func (m *QueryGetAllCommitmentsResponse) GetPagination() *query.PageResponse {
+func (m *QueryGetMarketOrderLinksResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryGetMarketRequest is a request message for the GetMarket query.
-type QueryGetMarketRequest struct {
-	// market_id is the id of the market to look up.
-	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+// QueryGetDeadManSwitchRequest is a request message for the GetDeadManSwitch query.
+type QueryGetDeadManSwitchRequest struct {
+	// account is the bech32 address string of the account to look up.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func (m *QueryGetMarketRequest) Reset()         { *m = QueryGetMarketRequest{} }
-func (m *QueryGetMarketRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetMarketRequest) ProtoMessage()    {}
-func (*QueryGetMarketRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{22}
+func (m *QueryGetDeadManSwitchRequest) Reset()         { *m = QueryGetDeadManSwitchRequest{} }
+func (m *QueryGetDeadManSwitchRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDeadManSwitchRequest) ProtoMessage()    {}
+func (*QueryGetDeadManSwitchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{24}
 }
-func (m *QueryGetMarketRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetDeadManSwitchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetMarketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetDeadManSwitchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetMarketRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetDeadManSwitchRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1283,45 +1431,43 @@ func (m *QueryGetMarketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *QueryGetMarketRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetMarketRequest.Merge(m, src)
+func (m *QueryGetDeadManSwitchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDeadManSwitchRequest.Merge(m, src)
 }
-func (m *QueryGetMarketRequest) XXX_Size() int {
+func (m *QueryGetDeadManSwitchRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetMarketRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetMarketRequest.DiscardUnknown(m)
+func (m *QueryGetDeadManSwitchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDeadManSwitchRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetMarketRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetDeadManSwitchRequest proto.InternalMessageInfo
 
-func (m *QueryGetMarketRequest) GetMarketId() uint32 {
+func (m *QueryGetDeadManSwitchRequest) GetAccount() string {
 	if m != nil {
-		return m.MarketId
+		return m.Account
 	}
-	return 0
+	return ""
 }
 
-// QueryGetMarketResponse is a response message for the GetMarket query.
-type QueryGetMarketResponse struct {
-	// address is the bech32 address string of this market's account.
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	// market is all information and details of the market.
-	Market *Market `protobuf:"bytes,2,opt,name=market,proto3" json:"market,omitempty"`
+// QueryGetDeadManSwitchResponse is a response message for the GetDeadManSwitch query.
+type QueryGetDeadManSwitchResponse struct {
+	// dead_man_switch is the requested dead-man switch.
+	DeadManSwitch *DeadManSwitch `protobuf:"bytes,1,opt,name=dead_man_switch,json=deadManSwitch,proto3" json:"dead_man_switch,omitempty"`
 }
 
-func (m *QueryGetMarketResponse) Reset()         { *m = QueryGetMarketResponse{} }
-func (m *QueryGetMarketResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetMarketResponse) ProtoMessage()    {}
-func (*QueryGetMarketResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{23}
+func (m *QueryGetDeadManSwitchResponse) Reset()         { *m = QueryGetDeadManSwitchResponse{} }
+func (m *QueryGetDeadManSwitchResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDeadManSwitchResponse) ProtoMessage()    {}
+func (*QueryGetDeadManSwitchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{25}
 }
-func (m *QueryGetMarketResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetDeadManSwitchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetMarketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetDeadManSwitchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetMarketResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetDeadManSwitchResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1331,50 +1477,49 @@ func (m *QueryGetMarketResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryGetMarketResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetMarketResponse.Merge(m, src)
+func (m *QueryGetDeadManSwitchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDeadManSwitchResponse.Merge(m, src)
 }
-func (m *QueryGetMarketResponse) XXX_Size() int {
+func (m *QueryGetDeadManSwitchResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetMarketResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetMarketResponse.DiscardUnknown(m)
+func (m *QueryGetDeadManSwitchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDeadManSwitchResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetMarketResponse proto.InternalMessageInfo
-
-func (m *QueryGetMarketResponse) GetAddress() string {
-	if m != nil {
-		return m.Address
-	}
-	return ""
-}
+var xxx_messageInfo_QueryGetDeadManSwitchResponse proto.InternalMessageInfo
 
-func (m *QueryGetMarketResponse) GetMarket() *Market {
+func (m *QueryGetDeadManSwitchResponse) GetDeadManSwitch() *DeadManSwitch {
 	if m != nil {
-		return m.Market
+		return m.DeadManSwitch
 	}
 	return nil
 }
 
-// QueryGetAllMarketsRequest is a request message for the GetAllMarkets query.
-type QueryGetAllMarketsRequest struct {
-	// pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
-}
-
-func (m *QueryGetAllMarketsRequest) Reset()         { *m = QueryGetAllMarketsRequest{} }
-func (m *QueryGetAllMarketsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetAllMarketsRequest) ProtoMessage()    {}
-func (*QueryGetAllMarketsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{24}
+// QueryOrderBookDepthRequest is a request message for the OrderBookDepth query.
+type QueryOrderBookDepthRequest struct {
+	// market_id is the id of the market to get the order book depth of.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// asset_denom is the denom of the assets of the orders to include.
+	AssetDenom string `protobuf:"bytes,2,opt,name=asset_denom,json=assetDenom,proto3" json:"asset_denom,omitempty"`
+	// price_denom is the denom of the price of the orders to include.
+	PriceDenom string `protobuf:"bytes,3,opt,name=price_denom,json=priceDenom,proto3" json:"price_denom,omitempty"`
+	// depth is the maximum number of price levels to return for each side of the book. Zero means all of them.
+	Depth uint32 `protobuf:"varint,4,opt,name=depth,proto3" json:"depth,omitempty"`
+}
+
+func (m *QueryOrderBookDepthRequest) Reset()         { *m = QueryOrderBookDepthRequest{} }
+func (m *QueryOrderBookDepthRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOrderBookDepthRequest) ProtoMessage()    {}
+func (*QueryOrderBookDepthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{26}
 }
-func (m *QueryGetAllMarketsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryOrderBookDepthRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetAllMarketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryOrderBookDepthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetAllMarketsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryOrderBookDepthRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1384,45 +1529,66 @@ func (m *QueryGetAllMarketsRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *QueryGetAllMarketsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetAllMarketsRequest.Merge(m, src)
+func (m *QueryOrderBookDepthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOrderBookDepthRequest.Merge(m, src)
 }
-func (m *QueryGetAllMarketsRequest) XXX_Size() int {
+func (m *QueryOrderBookDepthRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetAllMarketsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetAllMarketsRequest.DiscardUnknown(m)
+func (m *QueryOrderBookDepthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOrderBookDepthRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetAllMarketsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryOrderBookDepthRequest proto.InternalMessageInfo
 
-func (m *QueryGetAllMarketsRequest) GetPagination() *query.PageRequest {
+func (m *QueryOrderBookDepthRequest) GetMarketId() uint32 {
 	if m != nil {
-		return m.Pagination
+		return m.MarketId
 	}
-	return nil
+	return 0
 }
 
-// QueryGetAllMarketsResponse is a response message for the GetAllMarkets query.
-type QueryGetAllMarketsResponse struct {
-	// markets are a page of the briefs for all markets.
-	Markets []*MarketBrief `protobuf:"bytes,1,rep,name=markets,proto3" json:"markets,omitempty"`
-	// pagination is the resulting pagination parameters.
-	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryOrderBookDepthRequest) GetAssetDenom() string {
+	if m != nil {
+		return m.AssetDenom
+	}
+	return ""
 }
 
-func (m *QueryGetAllMarketsResponse) Reset()         { *m = QueryGetAllMarketsResponse{} }
-func (m *QueryGetAllMarketsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetAllMarketsResponse) ProtoMessage()    {}
-func (*QueryGetAllMarketsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{25}
+func (m *QueryOrderBookDepthRequest) GetPriceDenom() string {
+	if m != nil {
+		return m.PriceDenom
+	}
+	return ""
 }
-func (m *QueryGetAllMarketsResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryOrderBookDepthRequest) GetDepth() uint32 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+// QueryOrderBookDepthResponse is a response message for the OrderBookDepth query.
+type QueryOrderBookDepthResponse struct {
+	// bids are the bid order price levels, highest price first.
+	Bids []PriceLevel `protobuf:"bytes,1,rep,name=bids,proto3" json:"bids"`
+	// asks are the ask order price levels, lowest price first.
+	Asks []PriceLevel `protobuf:"bytes,2,rep,name=asks,proto3" json:"asks"`
+}
+
+func (m *QueryOrderBookDepthResponse) Reset()         { *m = QueryOrderBookDepthResponse{} }
+func (m *QueryOrderBookDepthResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOrderBookDepthResponse) ProtoMessage()    {}
+func (*QueryOrderBookDepthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{27}
+}
+func (m *QueryOrderBookDepthResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetAllMarketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryOrderBookDepthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetAllMarketsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryOrderBookDepthResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1432,48 +1598,54 @@ func (m *QueryGetAllMarketsResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryGetAllMarketsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetAllMarketsResponse.Merge(m, src)
+func (m *QueryOrderBookDepthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOrderBookDepthResponse.Merge(m, src)
 }
-func (m *QueryGetAllMarketsResponse) XXX_Size() int {
+func (m *QueryOrderBookDepthResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetAllMarketsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetAllMarketsResponse.DiscardUnknown(m)
+func (m *QueryOrderBookDepthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOrderBookDepthResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetAllMarketsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryOrderBookDepthResponse proto.InternalMessageInfo
 
-func (m *QueryGetAllMarketsResponse) GetMarkets() []*MarketBrief {
+func (m *QueryOrderBookDepthResponse) GetBids() []PriceLevel {
 	if m != nil {
-		return m.Markets
+		return m.Bids
 	}
 	return nil
 }
 
-func (m *QueryGetAllMarketsResponse) GetPagination() *query.PageResponse {
+func (m *QueryOrderBookDepthResponse) GetAsks() []PriceLevel {
 	if m != nil {
-		return m.Pagination
+		return m.Asks
 	}
 	return nil
 }
 
-// QueryParamsRequest is a request message for the Params query.
-type QueryParamsRequest struct {
+// QueryTopOfBookRequest is a request message for the TopOfBook query.
+type QueryTopOfBookRequest struct {
+	// market_id is the id of the market to get the top of the book of.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// asset_denom is the denom of the assets of the orders to include.
+	AssetDenom string `protobuf:"bytes,2,opt,name=asset_denom,json=assetDenom,proto3" json:"asset_denom,omitempty"`
+	// price_denom is the denom of the price of the orders to include.
+	PriceDenom string `protobuf:"bytes,3,opt,name=price_denom,json=priceDenom,proto3" json:"price_denom,omitempty"`
 }
 
-func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
-func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsRequest) ProtoMessage()    {}
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{26}
+func (m *QueryTopOfBookRequest) Reset()         { *m = QueryTopOfBookRequest{} }
+func (m *QueryTopOfBookRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTopOfBookRequest) ProtoMessage()    {}
+func (*QueryTopOfBookRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{28}
 }
-func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryTopOfBookRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTopOfBookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTopOfBookRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1483,36 +1655,62 @@ func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+func (m *QueryTopOfBookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopOfBookRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) XXX_Size() int {
+func (m *QueryTopOfBookRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+func (m *QueryTopOfBookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopOfBookRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryTopOfBookRequest proto.InternalMessageInfo
 
-// QueryParamsResponse is a response message for the Params query.
-type QueryParamsResponse struct {
-	// params are the exchange module parameter values.
-	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+func (m *QueryTopOfBookRequest) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
 }
 
-func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
-func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsResponse) ProtoMessage()    {}
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{27}
+func (m *QueryTopOfBookRequest) GetAssetDenom() string {
+	if m != nil {
+		return m.AssetDenom
+	}
+	return ""
 }
-func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryTopOfBookRequest) GetPriceDenom() string {
+	if m != nil {
+		return m.PriceDenom
+	}
+	return ""
+}
+
+// QueryTopOfBookResponse is a response message for the TopOfBook query.
+type QueryTopOfBookResponse struct {
+	// best_bid is the bid order price level with the highest price. It is nil if there are no bid orders.
+	BestBid *PriceLevel `protobuf:"bytes,1,opt,name=best_bid,json=bestBid,proto3" json:"best_bid,omitempty"`
+	// best_ask is the ask order price level with the lowest price. It is nil if there are no ask orders.
+	BestAsk *PriceLevel `protobuf:"bytes,2,opt,name=best_ask,json=bestAsk,proto3" json:"best_ask,omitempty"`
+	// spread is the best ask price minus the best bid price (as a decimal string of the price denom).
+	// It is empty if there are no bid orders or no ask orders. It is negative if the book is crossed.
+	Spread string `protobuf:"bytes,3,opt,name=spread,proto3" json:"spread,omitempty"`
+}
+
+func (m *QueryTopOfBookResponse) Reset()         { *m = QueryTopOfBookResponse{} }
+func (m *QueryTopOfBookResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTopOfBookResponse) ProtoMessage()    {}
+func (*QueryTopOfBookResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{29}
+}
+func (m *QueryTopOfBookResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTopOfBookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTopOfBookResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1522,51 +1720,64 @@ func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+func (m *QueryTopOfBookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopOfBookResponse.Merge(m, src)
 }
-func (m *QueryParamsResponse) XXX_Size() int {
+func (m *QueryTopOfBookResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+func (m *QueryTopOfBookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopOfBookResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryTopOfBookResponse proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) GetParams() *Params {
+func (m *QueryTopOfBookResponse) GetBestBid() *PriceLevel {
 	if m != nil {
-		return m.Params
+		return m.BestBid
 	}
 	return nil
 }
 
-// QueryCommitmentSettlementFeeCalcRequest is a request message for the CommitmentSettlementFeeCalc query.
-type QueryCommitmentSettlementFeeCalcRequest struct {
-	// settlement is a market's commitment settlement request message.
-	// If no inputs are provided, only the to_fee_nav field will be populated in the response.
-	Settlement *MsgMarketCommitmentSettleRequest `protobuf:"bytes,1,opt,name=settlement,proto3" json:"settlement,omitempty"`
-	// include_breakdown_fields controls the fields that are populated in the response.
-	// If false, only the exchange_fees field is populated.
-	// If true, all of the fields are populated as possible.
-	// If the settlement does not have any inputs, this field defaults to true.
-	IncludeBreakdownFields bool `protobuf:"varint,2,opt,name=include_breakdown_fields,json=includeBreakdownFields,proto3" json:"include_breakdown_fields,omitempty"`
+func (m *QueryTopOfBookResponse) GetBestAsk() *PriceLevel {
+	if m != nil {
+		return m.BestAsk
+	}
+	return nil
 }
 
-func (m *QueryCommitmentSettlementFeeCalcRequest) Reset() {
-	*m = QueryCommitmentSettlementFeeCalcRequest{}
+func (m *QueryTopOfBookResponse) GetSpread() string {
+	if m != nil {
+		return m.Spread
+	}
+	return ""
 }
-func (m *QueryCommitmentSettlementFeeCalcRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryCommitmentSettlementFeeCalcRequest) ProtoMessage()    {}
-func (*QueryCommitmentSettlementFeeCalcRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{28}
+
+// QueryPriceAveragesRequest is a request message for the PriceAverages query.
+type QueryPriceAveragesRequest struct {
+	// market_id is the id of the market to get the price averages of.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// asset_denom is the denom of the assets of the settlements to include.
+	AssetDenom string `protobuf:"bytes,2,opt,name=asset_denom,json=assetDenom,proto3" json:"asset_denom,omitempty"`
+	// price_denom is the denom of the price of the settlements to include.
+	PriceDenom string `protobuf:"bytes,3,opt,name=price_denom,json=priceDenom,proto3" json:"price_denom,omitempty"`
+	// window_seconds is the number of seconds (up to the current block time) to get the averages over.
+	// It must be positive and at most 30 days (2,592,000 seconds).
+	WindowSeconds uint64 `protobuf:"varint,4,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+}
+
+func (m *QueryPriceAveragesRequest) Reset()         { *m = QueryPriceAveragesRequest{} }
+func (m *QueryPriceAveragesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceAveragesRequest) ProtoMessage()    {}
+func (*QueryPriceAveragesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{30}
 }
-func (m *QueryCommitmentSettlementFeeCalcRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryPriceAveragesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryCommitmentSettlementFeeCalcRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPriceAveragesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryCommitmentSettlementFeeCalcRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPriceAveragesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1576,60 +1787,73 @@ func (m *QueryCommitmentSettlementFeeCalcRequest) XXX_Marshal(b []byte, determin
 		return b[:n], nil
 	}
 }
-func (m *QueryCommitmentSettlementFeeCalcRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryCommitmentSettlementFeeCalcRequest.Merge(m, src)
+func (m *QueryPriceAveragesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceAveragesRequest.Merge(m, src)
 }
-func (m *QueryCommitmentSettlementFeeCalcRequest) XXX_Size() int {
+func (m *QueryPriceAveragesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryCommitmentSettlementFeeCalcRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryCommitmentSettlementFeeCalcRequest.DiscardUnknown(m)
+func (m *QueryPriceAveragesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceAveragesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryCommitmentSettlementFeeCalcRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryPriceAveragesRequest proto.InternalMessageInfo
 
-func (m *QueryCommitmentSettlementFeeCalcRequest) GetSettlement() *MsgMarketCommitmentSettleRequest {
+func (m *QueryPriceAveragesRequest) GetMarketId() uint32 {
 	if m != nil {
-		return m.Settlement
+		return m.MarketId
 	}
-	return nil
+	return 0
 }
 
-func (m *QueryCommitmentSettlementFeeCalcRequest) GetIncludeBreakdownFields() bool {
+func (m *QueryPriceAveragesRequest) GetAssetDenom() string {
 	if m != nil {
-		return m.IncludeBreakdownFields
+		return m.AssetDenom
 	}
-	return false
+	return ""
 }
 
-// QueryCommitmentSettlementFeeCalcResponse is a response message for the CommitmentSettlementFeeCalc query.
-type QueryCommitmentSettlementFeeCalcResponse struct {
-	// exchange_fees is the total that the exchange would currently pay for the provided settlement.
-	ExchangeFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=exchange_fees,json=exchangeFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"exchange_fees"`
-	// input_total is the sum of all the inputs in the provided settlement.
-	InputTotal github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=input_total,json=inputTotal,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"input_total"`
-	// converted_total is the input_total converted to a single intermediary denom or left as the fee denom.
-	ConvertedTotal github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=converted_total,json=convertedTotal,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"converted_total"`
-	// conversion_navs are the NAVs used to convert the input_total to the converted_total.
-	ConversionNavs []NetAssetPrice `protobuf:"bytes,4,rep,name=conversion_navs,json=conversionNavs,proto3" json:"conversion_navs"`
-	// to_fee_nav is the NAV used to convert the converted_total into the fee denom.
-	ToFeeNav *NetAssetPrice `protobuf:"bytes,5,opt,name=to_fee_nav,json=toFeeNav,proto3" json:"to_fee_nav,omitempty"`
+func (m *QueryPriceAveragesRequest) GetPriceDenom() string {
+	if m != nil {
+		return m.PriceDenom
+	}
+	return ""
 }
 
-func (m *QueryCommitmentSettlementFeeCalcResponse) Reset() {
-	*m = QueryCommitmentSettlementFeeCalcResponse{}
+func (m *QueryPriceAveragesRequest) GetWindowSeconds() uint64 {
+	if m != nil {
+		return m.WindowSeconds
+	}
+	return 0
 }
-func (m *QueryCommitmentSettlementFeeCalcResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryCommitmentSettlementFeeCalcResponse) ProtoMessage()    {}
-func (*QueryCommitmentSettlementFeeCalcResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{29}
+
+// QueryPriceAveragesResponse is a response message for the PriceAverages query.
+type QueryPriceAveragesResponse struct {
+	// twap is the time-weighted average unit price (as a decimal string of the price denom).
+	// The price from the last settlement before the window is used until the first settlement in the window.
+	// It is empty if there haven't been any settlements.
+	Twap string `protobuf:"bytes,1,opt,name=twap,proto3" json:"twap,omitempty"`
+	// vwap is the volume-weighted average unit price (as a decimal string of the price denom) of the
+	// settlements in the window. It is empty if there weren't any settlements in the window.
+	Vwap string `protobuf:"bytes,2,opt,name=vwap,proto3" json:"vwap,omitempty"`
+	// volume is the total amount of assets settled in the window.
+	Volume types.Coin `protobuf:"bytes,3,opt,name=volume,proto3" json:"volume"`
+	// total_price is the total price paid in the settlements in the window.
+	TotalPrice types.Coin `protobuf:"bytes,4,opt,name=total_price,json=totalPrice,proto3" json:"total_price"`
+}
+
+func (m *QueryPriceAveragesResponse) Reset()         { *m = QueryPriceAveragesResponse{} }
+func (m *QueryPriceAveragesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceAveragesResponse) ProtoMessage()    {}
+func (*QueryPriceAveragesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{31}
 }
-func (m *QueryCommitmentSettlementFeeCalcResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryPriceAveragesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryCommitmentSettlementFeeCalcResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPriceAveragesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryCommitmentSettlementFeeCalcResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPriceAveragesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1639,71 +1863,68 @@ func (m *QueryCommitmentSettlementFeeCalcResponse) XXX_Marshal(b []byte, determi
 		return b[:n], nil
 	}
 }
-func (m *QueryCommitmentSettlementFeeCalcResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryCommitmentSettlementFeeCalcResponse.Merge(m, src)
+func (m *QueryPriceAveragesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceAveragesResponse.Merge(m, src)
 }
-func (m *QueryCommitmentSettlementFeeCalcResponse) XXX_Size() int {
+func (m *QueryPriceAveragesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryCommitmentSettlementFeeCalcResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryCommitmentSettlementFeeCalcResponse.DiscardUnknown(m)
+func (m *QueryPriceAveragesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceAveragesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryCommitmentSettlementFeeCalcResponse proto.InternalMessageInfo
-
-func (m *QueryCommitmentSettlementFeeCalcResponse) GetExchangeFees() github_com_cosmos_cosmos_sdk_types.Coins {
-	if m != nil {
-		return m.ExchangeFees
-	}
-	return nil
-}
+var xxx_messageInfo_QueryPriceAveragesResponse proto.InternalMessageInfo
 
-func (m *QueryCommitmentSettlementFeeCalcResponse) GetInputTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *QueryPriceAveragesResponse) GetTwap() string {
 	if m != nil {
-		return m.InputTotal
+		return m.Twap
 	}
-	return nil
+	return ""
 }
 
-func (m *QueryCommitmentSettlementFeeCalcResponse) GetConvertedTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *QueryPriceAveragesResponse) GetVwap() string {
 	if m != nil {
-		return m.ConvertedTotal
+		return m.Vwap
 	}
-	return nil
+	return ""
 }
 
-func (m *QueryCommitmentSettlementFeeCalcResponse) GetConversionNavs() []NetAssetPrice {
+func (m *QueryPriceAveragesResponse) GetVolume() types.Coin {
 	if m != nil {
-		return m.ConversionNavs
+		return m.Volume
 	}
-	return nil
+	return types.Coin{}
 }
 
-func (m *QueryCommitmentSettlementFeeCalcResponse) GetToFeeNav() *NetAssetPrice {
+func (m *QueryPriceAveragesResponse) GetTotalPrice() types.Coin {
 	if m != nil {
-		return m.ToFeeNav
+		return m.TotalPrice
 	}
-	return nil
+	return types.Coin{}
 }
 
-// QueryValidateCreateMarketRequest is a request message for the ValidateCreateMarket query.
-type QueryValidateCreateMarketRequest struct {
-	// create_market_request is the request to run validation on.
-	CreateMarketRequest *MsgGovCreateMarketRequest `protobuf:"bytes,1,opt,name=create_market_request,json=createMarketRequest,proto3" json:"create_market_request,omitempty"`
+// QueryGetMarketSettlementsRequest is a request message for the GetMarketSettlements query.
+type QueryGetMarketSettlementsRequest struct {
+	// market_id is the id of the market to get the settlements of.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// after_height is a minimum (exclusive) block height. All results will be from strictly later blocks.
+	AfterHeight int64 `protobuf:"varint,2,opt,name=after_height,json=afterHeight,proto3" json:"after_height,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryValidateCreateMarketRequest) Reset()         { *m = QueryValidateCreateMarketRequest{} }
-func (m *QueryValidateCreateMarketRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryValidateCreateMarketRequest) ProtoMessage()    {}
-func (*QueryValidateCreateMarketRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{30}
+func (m *QueryGetMarketSettlementsRequest) Reset()         { *m = QueryGetMarketSettlementsRequest{} }
+func (m *QueryGetMarketSettlementsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMarketSettlementsRequest) ProtoMessage()    {}
+func (*QueryGetMarketSettlementsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{32}
 }
-func (m *QueryValidateCreateMarketRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetMarketSettlementsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryValidateCreateMarketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetMarketSettlementsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryValidateCreateMarketRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetMarketSettlementsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1713,49 +1934,59 @@ func (m *QueryValidateCreateMarketRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *QueryValidateCreateMarketRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryValidateCreateMarketRequest.Merge(m, src)
+func (m *QueryGetMarketSettlementsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMarketSettlementsRequest.Merge(m, src)
 }
-func (m *QueryValidateCreateMarketRequest) XXX_Size() int {
+func (m *QueryGetMarketSettlementsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryValidateCreateMarketRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryValidateCreateMarketRequest.DiscardUnknown(m)
+func (m *QueryGetMarketSettlementsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMarketSettlementsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryValidateCreateMarketRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetMarketSettlementsRequest proto.InternalMessageInfo
 
-func (m *QueryValidateCreateMarketRequest) GetCreateMarketRequest() *MsgGovCreateMarketRequest {
+func (m *QueryGetMarketSettlementsRequest) GetMarketId() uint32 {
 	if m != nil {
-		return m.CreateMarketRequest
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *QueryGetMarketSettlementsRequest) GetAfterHeight() int64 {
+	if m != nil {
+		return m.AfterHeight
+	}
+	return 0
+}
+
+func (m *QueryGetMarketSettlementsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
 	return nil
 }
 
-// QueryValidateCreateMarketResponse is a response message for the ValidateCreateMarket query.
-type QueryValidateCreateMarketResponse struct {
-	// error is any problems or inconsistencies in the provided gov prop msg.
-	// This goes above and beyond the validation done when actually processing the governance proposal.
-	// If an error is returned, and gov_prop_will_pass is true, it means the error is more of an
-	// inconsistency that might cause certain aspects of the market to behave unexpectedly.
-	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
-	// gov_prop_will_pass will be true if the the provided msg will be successfully processed at the end of it's voting
-	// period (assuming it passes).
-	GovPropWillPass bool `protobuf:"varint,2,opt,name=gov_prop_will_pass,json=govPropWillPass,proto3" json:"gov_prop_will_pass,omitempty"`
+// QueryGetMarketSettlementsResponse is a response message for the GetMarketSettlements query.
+type QueryGetMarketSettlementsResponse struct {
+	// settlements are a page of the settlement records of the provided market, oldest first.
+	Settlements []SettlementRecord `protobuf:"bytes,1,rep,name=settlements,proto3" json:"settlements"`
+	// pagination is the resulting pagination parameters.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryValidateCreateMarketResponse) Reset()         { *m = QueryValidateCreateMarketResponse{} }
-func (m *QueryValidateCreateMarketResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryValidateCreateMarketResponse) ProtoMessage()    {}
-func (*QueryValidateCreateMarketResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{31}
+func (m *QueryGetMarketSettlementsResponse) Reset()         { *m = QueryGetMarketSettlementsResponse{} }
+func (m *QueryGetMarketSettlementsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMarketSettlementsResponse) ProtoMessage()    {}
+func (*QueryGetMarketSettlementsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{33}
 }
-func (m *QueryValidateCreateMarketResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetMarketSettlementsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryValidateCreateMarketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetMarketSettlementsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryValidateCreateMarketResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetMarketSettlementsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1765,50 +1996,50 @@ func (m *QueryValidateCreateMarketResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryValidateCreateMarketResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryValidateCreateMarketResponse.Merge(m, src)
+func (m *QueryGetMarketSettlementsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMarketSettlementsResponse.Merge(m, src)
 }
-func (m *QueryValidateCreateMarketResponse) XXX_Size() int {
+func (m *QueryGetMarketSettlementsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryValidateCreateMarketResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryValidateCreateMarketResponse.DiscardUnknown(m)
+func (m *QueryGetMarketSettlementsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMarketSettlementsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryValidateCreateMarketResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetMarketSettlementsResponse proto.InternalMessageInfo
 
-func (m *QueryValidateCreateMarketResponse) GetError() string {
+func (m *QueryGetMarketSettlementsResponse) GetSettlements() []SettlementRecord {
 	if m != nil {
-		return m.Error
+		return m.Settlements
 	}
-	return ""
+	return nil
 }
 
-func (m *QueryValidateCreateMarketResponse) GetGovPropWillPass() bool {
+func (m *QueryGetMarketSettlementsResponse) GetPagination() *query.PageResponse {
 	if m != nil {
-		return m.GovPropWillPass
+		return m.Pagination
 	}
-	return false
+	return nil
 }
 
-// QueryValidateMarketRequest is a request message for the ValidateMarket query.
-type QueryValidateMarketRequest struct {
-	// market_id is the id of the market to check.
-	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+// QueryGetAllSettlementsRequest is a request message for the GetAllSettlements query.
+type QueryGetAllSettlementsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryValidateMarketRequest) Reset()         { *m = QueryValidateMarketRequest{} }
-func (m *QueryValidateMarketRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryValidateMarketRequest) ProtoMessage()    {}
-func (*QueryValidateMarketRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{32}
+func (m *QueryGetAllSettlementsRequest) Reset()         { *m = QueryGetAllSettlementsRequest{} }
+func (m *QueryGetAllSettlementsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAllSettlementsRequest) ProtoMessage()    {}
+func (*QueryGetAllSettlementsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{34}
 }
-func (m *QueryValidateMarketRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetAllSettlementsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryValidateMarketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetAllSettlementsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryValidateMarketRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetAllSettlementsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1818,43 +2049,45 @@ func (m *QueryValidateMarketRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryValidateMarketRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryValidateMarketRequest.Merge(m, src)
+func (m *QueryGetAllSettlementsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAllSettlementsRequest.Merge(m, src)
 }
-func (m *QueryValidateMarketRequest) XXX_Size() int {
+func (m *QueryGetAllSettlementsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryValidateMarketRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryValidateMarketRequest.DiscardUnknown(m)
+func (m *QueryGetAllSettlementsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAllSettlementsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryValidateMarketRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetAllSettlementsRequest proto.InternalMessageInfo
 
-func (m *QueryValidateMarketRequest) GetMarketId() uint32 {
+func (m *QueryGetAllSettlementsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.MarketId
+		return m.Pagination
 	}
-	return 0
+	return nil
 }
 
-// QueryValidateMarketResponse is a response message for the ValidateMarket query.
-type QueryValidateMarketResponse struct {
-	// error is any problems or inconsistencies in the provided market.
-	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+// QueryGetAllSettlementsResponse is a response message for the GetAllSettlements query.
+type QueryGetAllSettlementsResponse struct {
+	// settlements are a page of all the settlement records.
+	Settlements []SettlementRecord `protobuf:"bytes,1,rep,name=settlements,proto3" json:"settlements"`
+	// pagination is the resulting pagination parameters.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryValidateMarketResponse) Reset()         { *m = QueryValidateMarketResponse{} }
-func (m *QueryValidateMarketResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryValidateMarketResponse) ProtoMessage()    {}
-func (*QueryValidateMarketResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{33}
+func (m *QueryGetAllSettlementsResponse) Reset()         { *m = QueryGetAllSettlementsResponse{} }
+func (m *QueryGetAllSettlementsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAllSettlementsResponse) ProtoMessage()    {}
+func (*QueryGetAllSettlementsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{35}
 }
-func (m *QueryValidateMarketResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetAllSettlementsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryValidateMarketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetAllSettlementsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryValidateMarketResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetAllSettlementsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1864,43 +2097,50 @@ func (m *QueryValidateMarketResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryValidateMarketResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryValidateMarketResponse.Merge(m, src)
+func (m *QueryGetAllSettlementsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAllSettlementsResponse.Merge(m, src)
 }
-func (m *QueryValidateMarketResponse) XXX_Size() int {
+func (m *QueryGetAllSettlementsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryValidateMarketResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryValidateMarketResponse.DiscardUnknown(m)
+func (m *QueryGetAllSettlementsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAllSettlementsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryValidateMarketResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetAllSettlementsResponse proto.InternalMessageInfo
 
-func (m *QueryValidateMarketResponse) GetError() string {
+func (m *QueryGetAllSettlementsResponse) GetSettlements() []SettlementRecord {
 	if m != nil {
-		return m.Error
+		return m.Settlements
 	}
-	return ""
+	return nil
 }
 
-// QueryValidateManageFeesRequest is a request message for the ValidateManageFees query.
-type QueryValidateManageFeesRequest struct {
-	// manage_fees_request is the request to run validation on.
-	ManageFeesRequest *MsgGovManageFeesRequest `protobuf:"bytes,1,opt,name=manage_fees_request,json=manageFeesRequest,proto3" json:"manage_fees_request,omitempty"`
+func (m *QueryGetAllSettlementsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryValidateManageFeesRequest) Reset()         { *m = QueryValidateManageFeesRequest{} }
-func (m *QueryValidateManageFeesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryValidateManageFeesRequest) ProtoMessage()    {}
-func (*QueryValidateManageFeesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{34}
+// QueryGetSettlementReceiptRequest is a request message for the GetSettlementReceipt query.
+type QueryGetSettlementReceiptRequest struct {
+	// settlement_id is the id of the settlement to get the receipt of.
+	SettlementId uint64 `protobuf:"varint,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
 }
-func (m *QueryValidateManageFeesRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryGetSettlementReceiptRequest) Reset()         { *m = QueryGetSettlementReceiptRequest{} }
+func (m *QueryGetSettlementReceiptRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSettlementReceiptRequest) ProtoMessage()    {}
+func (*QueryGetSettlementReceiptRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{36}
+}
+func (m *QueryGetSettlementReceiptRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryValidateManageFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetSettlementReceiptRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryValidateManageFeesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetSettlementReceiptRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1910,49 +2150,43 @@ func (m *QueryValidateManageFeesRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryValidateManageFeesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryValidateManageFeesRequest.Merge(m, src)
+func (m *QueryGetSettlementReceiptRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSettlementReceiptRequest.Merge(m, src)
 }
-func (m *QueryValidateManageFeesRequest) XXX_Size() int {
+func (m *QueryGetSettlementReceiptRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryValidateManageFeesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryValidateManageFeesRequest.DiscardUnknown(m)
+func (m *QueryGetSettlementReceiptRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSettlementReceiptRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryValidateManageFeesRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetSettlementReceiptRequest proto.InternalMessageInfo
 
-func (m *QueryValidateManageFeesRequest) GetManageFeesRequest() *MsgGovManageFeesRequest {
+func (m *QueryGetSettlementReceiptRequest) GetSettlementId() uint64 {
 	if m != nil {
-		return m.ManageFeesRequest
+		return m.SettlementId
 	}
-	return nil
+	return 0
 }
 
-// QueryValidateManageFeesResponse is a response message for the ValidateManageFees query.
-type QueryValidateManageFeesResponse struct {
-	// error is any problems or inconsistencies in the provided gov prop msg.
-	// This goes above and beyond the validation done when actually processing the governance proposal.
-	// If an error is returned, and gov_prop_will_pass is true, it means the error is more of an
-	// inconsistency that might cause certain aspects of the market to behave unexpectedly.
-	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
-	// gov_prop_will_pass will be true if the the provided msg will be successfully processed at the end of it's voting
-	// period (assuming it passes).
-	GovPropWillPass bool `protobuf:"varint,2,opt,name=gov_prop_will_pass,json=govPropWillPass,proto3" json:"gov_prop_will_pass,omitempty"`
+// QueryGetSettlementReceiptResponse is a response message for the GetSettlementReceipt query.
+type QueryGetSettlementReceiptResponse struct {
+	// receipt is the requested settlement receipt.
+	Receipt *SettlementReceipt `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
 }
 
-func (m *QueryValidateManageFeesResponse) Reset()         { *m = QueryValidateManageFeesResponse{} }
-func (m *QueryValidateManageFeesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryValidateManageFeesResponse) ProtoMessage()    {}
-func (*QueryValidateManageFeesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{35}
+func (m *QueryGetSettlementReceiptResponse) Reset()         { *m = QueryGetSettlementReceiptResponse{} }
+func (m *QueryGetSettlementReceiptResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSettlementReceiptResponse) ProtoMessage()    {}
+func (*QueryGetSettlementReceiptResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{37}
 }
-func (m *QueryValidateManageFeesResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetSettlementReceiptResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryValidateManageFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetSettlementReceiptResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryValidateManageFeesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetSettlementReceiptResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1962,52 +2196,45 @@ func (m *QueryValidateManageFeesResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QueryValidateManageFeesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryValidateManageFeesResponse.Merge(m, src)
+func (m *QueryGetSettlementReceiptResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSettlementReceiptResponse.Merge(m, src)
 }
-func (m *QueryValidateManageFeesResponse) XXX_Size() int {
+func (m *QueryGetSettlementReceiptResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryValidateManageFeesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryValidateManageFeesResponse.DiscardUnknown(m)
+func (m *QueryGetSettlementReceiptResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSettlementReceiptResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryValidateManageFeesResponse proto.InternalMessageInfo
-
-func (m *QueryValidateManageFeesResponse) GetError() string {
-	if m != nil {
-		return m.Error
-	}
-	return ""
-}
+var xxx_messageInfo_QueryGetSettlementReceiptResponse proto.InternalMessageInfo
 
-func (m *QueryValidateManageFeesResponse) GetGovPropWillPass() bool {
+func (m *QueryGetSettlementReceiptResponse) GetReceipt() *SettlementReceipt {
 	if m != nil {
-		return m.GovPropWillPass
+		return m.Receipt
 	}
-	return false
+	return nil
 }
 
-// QueryGetPaymentRequest is a request message for the GetPayment query.
-type QueryGetPaymentRequest struct {
-	// source is the source account of the payment to get.
-	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
-	// external_id is the external id of the payment to get.
-	ExternalId string `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+// QueryGetLatestNAVRequest is a request message for the GetLatestNAV query.
+type QueryGetLatestNAVRequest struct {
+	// asset_denom is the denom of the assets of the NAV.
+	AssetDenom string `protobuf:"bytes,1,opt,name=asset_denom,json=assetDenom,proto3" json:"asset_denom,omitempty"`
+	// price_denom is the denom of the price of the NAV.
+	PriceDenom string `protobuf:"bytes,2,opt,name=price_denom,json=priceDenom,proto3" json:"price_denom,omitempty"`
 }
 
-func (m *QueryGetPaymentRequest) Reset()         { *m = QueryGetPaymentRequest{} }
-func (m *QueryGetPaymentRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetPaymentRequest) ProtoMessage()    {}
-func (*QueryGetPaymentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{36}
+func (m *QueryGetLatestNAVRequest) Reset()         { *m = QueryGetLatestNAVRequest{} }
+func (m *QueryGetLatestNAVRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetLatestNAVRequest) ProtoMessage()    {}
+func (*QueryGetLatestNAVRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{38}
 }
-func (m *QueryGetPaymentRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetLatestNAVRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetPaymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetLatestNAVRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetPaymentRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetLatestNAVRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2017,50 +2244,51 @@ func (m *QueryGetPaymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryGetPaymentRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetPaymentRequest.Merge(m, src)
+func (m *QueryGetLatestNAVRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetLatestNAVRequest.Merge(m, src)
 }
-func (m *QueryGetPaymentRequest) XXX_Size() int {
+func (m *QueryGetLatestNAVRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetPaymentRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetPaymentRequest.DiscardUnknown(m)
+func (m *QueryGetLatestNAVRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetLatestNAVRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetPaymentRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetLatestNAVRequest proto.InternalMessageInfo
 
-func (m *QueryGetPaymentRequest) GetSource() string {
+func (m *QueryGetLatestNAVRequest) GetAssetDenom() string {
 	if m != nil {
-		return m.Source
+		return m.AssetDenom
 	}
 	return ""
 }
 
-func (m *QueryGetPaymentRequest) GetExternalId() string {
+func (m *QueryGetLatestNAVRequest) GetPriceDenom() string {
 	if m != nil {
-		return m.ExternalId
+		return m.PriceDenom
 	}
 	return ""
 }
 
-// QueryGetPaymentResponse is a response message for the GetPayment query.
-type QueryGetPaymentResponse struct {
-	// payment is the info on the requested payment.
-	Payment *Payment `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+// QueryGetLatestNAVResponse is a response message for the GetLatestNAV query.
+type QueryGetLatestNAVResponse struct {
+	// nav is the most recent NAV record for the requested denoms.
+	// If several markets had a settlement of the denoms in that block, this is the one from the largest market id.
+	Nav *NAVRecord `protobuf:"bytes,1,opt,name=nav,proto3" json:"nav,omitempty"`
 }
 
-func (m *QueryGetPaymentResponse) Reset()         { *m = QueryGetPaymentResponse{} }
-func (m *QueryGetPaymentResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetPaymentResponse) ProtoMessage()    {}
-func (*QueryGetPaymentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{37}
+func (m *QueryGetLatestNAVResponse) Reset()         { *m = QueryGetLatestNAVResponse{} }
+func (m *QueryGetLatestNAVResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetLatestNAVResponse) ProtoMessage()    {}
+func (*QueryGetLatestNAVResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{39}
 }
-func (m *QueryGetPaymentResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetLatestNAVResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetPaymentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetLatestNAVResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetPaymentResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetLatestNAVResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2070,45 +2298,51 @@ func (m *QueryGetPaymentResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryGetPaymentResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetPaymentResponse.Merge(m, src)
+func (m *QueryGetLatestNAVResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetLatestNAVResponse.Merge(m, src)
 }
-func (m *QueryGetPaymentResponse) XXX_Size() int {
+func (m *QueryGetLatestNAVResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetPaymentResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetPaymentResponse.DiscardUnknown(m)
+func (m *QueryGetLatestNAVResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetLatestNAVResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetPaymentResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetLatestNAVResponse proto.InternalMessageInfo
 
-func (m *QueryGetPaymentResponse) GetPayment() *Payment {
+func (m *QueryGetLatestNAVResponse) GetNav() *NAVRecord {
 	if m != nil {
-		return m.Payment
+		return m.Nav
 	}
 	return nil
 }
 
-// QueryGetPaymentsWithSourceRequest is a request message for the GetPaymentsWithSource query.
-type QueryGetPaymentsWithSourceRequest struct {
-	// source is the source account of the payments to get.
-	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+// QueryGetNAVHistoryRequest is a request message for the GetNAVHistory query.
+type QueryGetNAVHistoryRequest struct {
+	// asset_denom is the denom of the assets of the NAVs.
+	AssetDenom string `protobuf:"bytes,1,opt,name=asset_denom,json=assetDenom,proto3" json:"asset_denom,omitempty"`
+	// price_denom is the denom of the price of the NAVs.
+	PriceDenom string `protobuf:"bytes,2,opt,name=price_denom,json=priceDenom,proto3" json:"price_denom,omitempty"`
+	// min_height is an optional minimum (inclusive) block height of the NAV records to get.
+	MinHeight int64 `protobuf:"varint,3,opt,name=min_height,json=minHeight,proto3" json:"min_height,omitempty"`
+	// max_height is an optional maximum (inclusive) block height of the NAV records to get.
+	MaxHeight int64 `protobuf:"varint,4,opt,name=max_height,json=maxHeight,proto3" json:"max_height,omitempty"`
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetPaymentsWithSourceRequest) Reset()         { *m = QueryGetPaymentsWithSourceRequest{} }
-func (m *QueryGetPaymentsWithSourceRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetPaymentsWithSourceRequest) ProtoMessage()    {}
-func (*QueryGetPaymentsWithSourceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{38}
+func (m *QueryGetNAVHistoryRequest) Reset()         { *m = QueryGetNAVHistoryRequest{} }
+func (m *QueryGetNAVHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetNAVHistoryRequest) ProtoMessage()    {}
+func (*QueryGetNAVHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{40}
 }
-func (m *QueryGetPaymentsWithSourceRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetNAVHistoryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetPaymentsWithSourceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetNAVHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetPaymentsWithSourceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetNAVHistoryRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2118,52 +2352,73 @@ func (m *QueryGetPaymentsWithSourceRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryGetPaymentsWithSourceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetPaymentsWithSourceRequest.Merge(m, src)
+func (m *QueryGetNAVHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetNAVHistoryRequest.Merge(m, src)
 }
-func (m *QueryGetPaymentsWithSourceRequest) XXX_Size() int {
+func (m *QueryGetNAVHistoryRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetPaymentsWithSourceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetPaymentsWithSourceRequest.DiscardUnknown(m)
+func (m *QueryGetNAVHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetNAVHistoryRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetPaymentsWithSourceRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetNAVHistoryRequest proto.InternalMessageInfo
 
-func (m *QueryGetPaymentsWithSourceRequest) GetSource() string {
+func (m *QueryGetNAVHistoryRequest) GetAssetDenom() string {
 	if m != nil {
-		return m.Source
+		return m.AssetDenom
 	}
 	return ""
 }
 
-func (m *QueryGetPaymentsWithSourceRequest) GetPagination() *query.PageRequest {
+func (m *QueryGetNAVHistoryRequest) GetPriceDenom() string {
+	if m != nil {
+		return m.PriceDenom
+	}
+	return ""
+}
+
+func (m *QueryGetNAVHistoryRequest) GetMinHeight() int64 {
+	if m != nil {
+		return m.MinHeight
+	}
+	return 0
+}
+
+func (m *QueryGetNAVHistoryRequest) GetMaxHeight() int64 {
+	if m != nil {
+		return m.MaxHeight
+	}
+	return 0
+}
+
+func (m *QueryGetNAVHistoryRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryGetPaymentsWithSourceResponse is a response message for the GetPaymentsWithSource query.
-type QueryGetPaymentsWithSourceResponse struct {
-	// payments is all the payments with the requested source.
-	Payments []*Payment `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
+// QueryGetNAVHistoryResponse is a response message for the GetNAVHistory query.
+type QueryGetNAVHistoryResponse struct {
+	// navs are a page of the NAV records for the requested denoms, oldest first.
+	Navs []NAVRecord `protobuf:"bytes,1,rep,name=navs,proto3" json:"navs"`
 	// pagination is the resulting pagination parameters.
 	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetPaymentsWithSourceResponse) Reset()         { *m = QueryGetPaymentsWithSourceResponse{} }
-func (m *QueryGetPaymentsWithSourceResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetPaymentsWithSourceResponse) ProtoMessage()    {}
-func (*QueryGetPaymentsWithSourceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{39}
+func (m *QueryGetNAVHistoryResponse) Reset()         { *m = QueryGetNAVHistoryResponse{} }
+func (m *QueryGetNAVHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetNAVHistoryResponse) ProtoMessage()    {}
+func (*QueryGetNAVHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{41}
 }
-func (m *QueryGetPaymentsWithSourceResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetNAVHistoryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetPaymentsWithSourceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetNAVHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetPaymentsWithSourceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetNAVHistoryResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2173,52 +2428,55 @@ func (m *QueryGetPaymentsWithSourceResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryGetPaymentsWithSourceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetPaymentsWithSourceResponse.Merge(m, src)
+func (m *QueryGetNAVHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetNAVHistoryResponse.Merge(m, src)
 }
-func (m *QueryGetPaymentsWithSourceResponse) XXX_Size() int {
+func (m *QueryGetNAVHistoryResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetPaymentsWithSourceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetPaymentsWithSourceResponse.DiscardUnknown(m)
+func (m *QueryGetNAVHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetNAVHistoryResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetPaymentsWithSourceResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetNAVHistoryResponse proto.InternalMessageInfo
 
-func (m *QueryGetPaymentsWithSourceResponse) GetPayments() []*Payment {
+func (m *QueryGetNAVHistoryResponse) GetNavs() []NAVRecord {
 	if m != nil {
-		return m.Payments
+		return m.Navs
 	}
 	return nil
 }
 
-func (m *QueryGetPaymentsWithSourceResponse) GetPagination() *query.PageResponse {
+func (m *QueryGetNAVHistoryResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryGetPaymentsWithTargetRequest is a request message for the GetPaymentsWithTarget query.
-type QueryGetPaymentsWithTargetRequest struct {
-	// target is the target account of the payments to get.
-	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
-	// pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QuerySimulateSettlementRequest is a request message for the SimulateSettlement query.
+// Exactly one of the requests must be provided.
+type QuerySimulateSettlementRequest struct {
+	// market_settle_request is a market settle request to simulate.
+	MarketSettleRequest *MsgMarketSettleRequest `protobuf:"bytes,1,opt,name=market_settle_request,json=marketSettleRequest,proto3" json:"market_settle_request,omitempty"`
+	// fill_bids_request is a fill bids request to simulate.
+	FillBidsRequest *MsgFillBidsRequest `protobuf:"bytes,2,opt,name=fill_bids_request,json=fillBidsRequest,proto3" json:"fill_bids_request,omitempty"`
+	// fill_asks_request is a fill asks request to simulate.
+	FillAsksRequest *MsgFillAsksRequest `protobuf:"bytes,3,opt,name=fill_asks_request,json=fillAsksRequest,proto3" json:"fill_asks_request,omitempty"`
 }
 
-func (m *QueryGetPaymentsWithTargetRequest) Reset()         { *m = QueryGetPaymentsWithTargetRequest{} }
-func (m *QueryGetPaymentsWithTargetRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetPaymentsWithTargetRequest) ProtoMessage()    {}
-func (*QueryGetPaymentsWithTargetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{40}
+func (m *QuerySimulateSettlementRequest) Reset()         { *m = QuerySimulateSettlementRequest{} }
+func (m *QuerySimulateSettlementRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateSettlementRequest) ProtoMessage()    {}
+func (*QuerySimulateSettlementRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{42}
 }
-func (m *QueryGetPaymentsWithTargetRequest) XXX_Unmarshal(b []byte) error {
+func (m *QuerySimulateSettlementRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetPaymentsWithTargetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySimulateSettlementRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetPaymentsWithTargetRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySimulateSettlementRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2228,52 +2486,67 @@ func (m *QueryGetPaymentsWithTargetRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryGetPaymentsWithTargetRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetPaymentsWithTargetRequest.Merge(m, src)
+func (m *QuerySimulateSettlementRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateSettlementRequest.Merge(m, src)
 }
-func (m *QueryGetPaymentsWithTargetRequest) XXX_Size() int {
+func (m *QuerySimulateSettlementRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetPaymentsWithTargetRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetPaymentsWithTargetRequest.DiscardUnknown(m)
+func (m *QuerySimulateSettlementRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateSettlementRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetPaymentsWithTargetRequest proto.InternalMessageInfo
+var xxx_messageInfo_QuerySimulateSettlementRequest proto.InternalMessageInfo
 
-func (m *QueryGetPaymentsWithTargetRequest) GetTarget() string {
+func (m *QuerySimulateSettlementRequest) GetMarketSettleRequest() *MsgMarketSettleRequest {
 	if m != nil {
-		return m.Target
+		return m.MarketSettleRequest
 	}
-	return ""
+	return nil
 }
 
-func (m *QueryGetPaymentsWithTargetRequest) GetPagination() *query.PageRequest {
+func (m *QuerySimulateSettlementRequest) GetFillBidsRequest() *MsgFillBidsRequest {
 	if m != nil {
-		return m.Pagination
+		return m.FillBidsRequest
 	}
 	return nil
 }
 
-// QueryGetPaymentsWithTargetResponse is a response message for the GetPaymentsWithTarget query.
-type QueryGetPaymentsWithTargetResponse struct {
-	// payments is all the payments with the requested target.
-	Payments []*Payment `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
-	// pagination is the resulting pagination parameters.
-	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QuerySimulateSettlementRequest) GetFillAsksRequest() *MsgFillAsksRequest {
+	if m != nil {
+		return m.FillAsksRequest
+	}
+	return nil
 }
 
-func (m *QueryGetPaymentsWithTargetResponse) Reset()         { *m = QueryGetPaymentsWithTargetResponse{} }
-func (m *QueryGetPaymentsWithTargetResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetPaymentsWithTargetResponse) ProtoMessage()    {}
-func (*QueryGetPaymentsWithTargetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{41}
+// QuerySimulateSettlementResponse is a response message for the SimulateSettlement query.
+type QuerySimulateSettlementResponse struct {
+	// error is any problem that would prevent the provided request from being processed.
+	// If there is an error, none of the other fields are populated.
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	// fills are the orders that would be filled, and what would be filled for each.
+	Fills []SettlementFill `protobuf:"bytes,2,rep,name=fills,proto3" json:"fills"`
+	// transfers are the asset and price transfers that would be made.
+	Transfers []SettlementTransfer `protobuf:"bytes,3,rep,name=transfers,proto3" json:"transfers"`
+	// fee_inputs are the fees that would be collected, by account.
+	// The order creation fee of a fill bids or fill asks request is its own entry at the end.
+	FeeInputs []AccountAmount `protobuf:"bytes,4,rep,name=fee_inputs,json=feeInputs,proto3" json:"fee_inputs"`
+	// navs are the net asset prices that would be recorded.
+	Navs []NetAssetPrice `protobuf:"bytes,5,rep,name=navs,proto3" json:"navs"`
+}
+
+func (m *QuerySimulateSettlementResponse) Reset()         { *m = QuerySimulateSettlementResponse{} }
+func (m *QuerySimulateSettlementResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateSettlementResponse) ProtoMessage()    {}
+func (*QuerySimulateSettlementResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{43}
 }
-func (m *QueryGetPaymentsWithTargetResponse) XXX_Unmarshal(b []byte) error {
+func (m *QuerySimulateSettlementResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetPaymentsWithTargetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySimulateSettlementResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetPaymentsWithTargetResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySimulateSettlementResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2283,50 +2556,73 @@ func (m *QueryGetPaymentsWithTargetResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryGetPaymentsWithTargetResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetPaymentsWithTargetResponse.Merge(m, src)
+func (m *QuerySimulateSettlementResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateSettlementResponse.Merge(m, src)
 }
-func (m *QueryGetPaymentsWithTargetResponse) XXX_Size() int {
+func (m *QuerySimulateSettlementResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetPaymentsWithTargetResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetPaymentsWithTargetResponse.DiscardUnknown(m)
+func (m *QuerySimulateSettlementResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateSettlementResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetPaymentsWithTargetResponse proto.InternalMessageInfo
+var xxx_messageInfo_QuerySimulateSettlementResponse proto.InternalMessageInfo
 
-func (m *QueryGetPaymentsWithTargetResponse) GetPayments() []*Payment {
+func (m *QuerySimulateSettlementResponse) GetError() string {
 	if m != nil {
-		return m.Payments
+		return m.Error
+	}
+	return ""
+}
+
+func (m *QuerySimulateSettlementResponse) GetFills() []SettlementFill {
+	if m != nil {
+		return m.Fills
 	}
 	return nil
 }
 
-func (m *QueryGetPaymentsWithTargetResponse) GetPagination() *query.PageResponse {
+func (m *QuerySimulateSettlementResponse) GetTransfers() []SettlementTransfer {
 	if m != nil {
-		return m.Pagination
+		return m.Transfers
 	}
 	return nil
 }
 
-// QueryGetAllPaymentsRequest is a request message for the GetAllPayments query.
-type QueryGetAllPaymentsRequest struct {
-	// pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QuerySimulateSettlementResponse) GetFeeInputs() []AccountAmount {
+	if m != nil {
+		return m.FeeInputs
+	}
+	return nil
 }
 
-func (m *QueryGetAllPaymentsRequest) Reset()         { *m = QueryGetAllPaymentsRequest{} }
-func (m *QueryGetAllPaymentsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetAllPaymentsRequest) ProtoMessage()    {}
-func (*QueryGetAllPaymentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{42}
+func (m *QuerySimulateSettlementResponse) GetNavs() []NetAssetPrice {
+	if m != nil {
+		return m.Navs
+	}
+	return nil
 }
-func (m *QueryGetAllPaymentsRequest) XXX_Unmarshal(b []byte) error {
+
+// QueryGetCommitmentRequest is a request message for the GetCommitment query.
+type QueryGetCommitmentRequest struct {
+	// account is the bech32 address string of the account in the commitment.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// market_id is the numeric identifier of the market in the commitment.
+	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+}
+
+func (m *QueryGetCommitmentRequest) Reset()         { *m = QueryGetCommitmentRequest{} }
+func (m *QueryGetCommitmentRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCommitmentRequest) ProtoMessage()    {}
+func (*QueryGetCommitmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{44}
+}
+func (m *QueryGetCommitmentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetAllPaymentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetCommitmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetAllPaymentsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetCommitmentRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2336,45 +2632,50 @@ func (m *QueryGetAllPaymentsRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryGetAllPaymentsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetAllPaymentsRequest.Merge(m, src)
+func (m *QueryGetCommitmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCommitmentRequest.Merge(m, src)
 }
-func (m *QueryGetAllPaymentsRequest) XXX_Size() int {
+func (m *QueryGetCommitmentRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetAllPaymentsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetAllPaymentsRequest.DiscardUnknown(m)
+func (m *QueryGetCommitmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCommitmentRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetAllPaymentsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetCommitmentRequest proto.InternalMessageInfo
 
-func (m *QueryGetAllPaymentsRequest) GetPagination() *query.PageRequest {
+func (m *QueryGetCommitmentRequest) GetAccount() string {
 	if m != nil {
-		return m.Pagination
+		return m.Account
 	}
-	return nil
+	return ""
 }
 
-// QueryGetAllPaymentsResponse is a response message for the GetAllPayments query.
-type QueryGetAllPaymentsResponse struct {
-	// payments is all the payments on this page of results.
-	Payments []*Payment `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
-	// pagination is the resulting pagination parameters.
-	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryGetCommitmentRequest) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
 }
 
-func (m *QueryGetAllPaymentsResponse) Reset()         { *m = QueryGetAllPaymentsResponse{} }
-func (m *QueryGetAllPaymentsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetAllPaymentsResponse) ProtoMessage()    {}
-func (*QueryGetAllPaymentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{43}
+// QueryGetCommitmentResponse is a response message for the GetCommitment query.
+type QueryGetCommitmentResponse struct {
+	// amount is the total funds committed to the market by the account.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
-func (m *QueryGetAllPaymentsResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryGetCommitmentResponse) Reset()         { *m = QueryGetCommitmentResponse{} }
+func (m *QueryGetCommitmentResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCommitmentResponse) ProtoMessage()    {}
+func (*QueryGetCommitmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{45}
+}
+func (m *QueryGetCommitmentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetAllPaymentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetCommitmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetAllPaymentsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetCommitmentResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2384,50 +2685,98 @@ func (m *QueryGetAllPaymentsResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryGetAllPaymentsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetAllPaymentsResponse.Merge(m, src)
+func (m *QueryGetCommitmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCommitmentResponse.Merge(m, src)
 }
-func (m *QueryGetAllPaymentsResponse) XXX_Size() int {
+func (m *QueryGetCommitmentResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetAllPaymentsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetAllPaymentsResponse.DiscardUnknown(m)
+func (m *QueryGetCommitmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCommitmentResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetAllPaymentsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetCommitmentResponse proto.InternalMessageInfo
 
-func (m *QueryGetAllPaymentsResponse) GetPayments() []*Payment {
+func (m *QueryGetCommitmentResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.Payments
+		return m.Amount
 	}
 	return nil
 }
 
-func (m *QueryGetAllPaymentsResponse) GetPagination() *query.PageResponse {
+// QueryGetAccountCommitmentsRequest is a request message for the GetAccountCommitments query.
+type QueryGetAccountCommitmentsRequest struct {
+	// account is the bech32 address string of the account with the commitments.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// denom is an optional denom that, if provided, limits the results to just that denom.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryGetAccountCommitmentsRequest) Reset()         { *m = QueryGetAccountCommitmentsRequest{} }
+func (m *QueryGetAccountCommitmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAccountCommitmentsRequest) ProtoMessage()    {}
+func (*QueryGetAccountCommitmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{46}
+}
+func (m *QueryGetAccountCommitmentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAccountCommitmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAccountCommitmentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetAccountCommitmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAccountCommitmentsRequest.Merge(m, src)
+}
+func (m *QueryGetAccountCommitmentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAccountCommitmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAccountCommitmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetAccountCommitmentsRequest proto.InternalMessageInfo
+
+func (m *QueryGetAccountCommitmentsRequest) GetAccount() string {
 	if m != nil {
-		return m.Pagination
+		return m.Account
 	}
-	return nil
+	return ""
 }
 
-// QueryPaymentFeeCalcRequest is a request message for the PaymentFeeCalc query.
-type QueryPaymentFeeCalcRequest struct {
-	// payment is the details of the payment to create or accept.
-	Payment Payment `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment"`
+func (m *QueryGetAccountCommitmentsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *QueryPaymentFeeCalcRequest) Reset()         { *m = QueryPaymentFeeCalcRequest{} }
-func (m *QueryPaymentFeeCalcRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryPaymentFeeCalcRequest) ProtoMessage()    {}
-func (*QueryPaymentFeeCalcRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{44}
+// QueryGetAccountCommitmentsResponse is a response message for the GetAccountCommitments query.
+type QueryGetAccountCommitmentsResponse struct {
+	// commitments is the amounts committed from the account to the any market.
+	Commitments []*MarketAmount `protobuf:"bytes,1,rep,name=commitments,proto3" json:"commitments,omitempty"`
 }
-func (m *QueryPaymentFeeCalcRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryGetAccountCommitmentsResponse) Reset()         { *m = QueryGetAccountCommitmentsResponse{} }
+func (m *QueryGetAccountCommitmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAccountCommitmentsResponse) ProtoMessage()    {}
+func (*QueryGetAccountCommitmentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{47}
+}
+func (m *QueryGetAccountCommitmentsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryPaymentFeeCalcRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetAccountCommitmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryPaymentFeeCalcRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetAccountCommitmentsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2437,45 +2786,45 @@ func (m *QueryPaymentFeeCalcRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryPaymentFeeCalcRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryPaymentFeeCalcRequest.Merge(m, src)
+func (m *QueryGetAccountCommitmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAccountCommitmentsResponse.Merge(m, src)
 }
-func (m *QueryPaymentFeeCalcRequest) XXX_Size() int {
+func (m *QueryGetAccountCommitmentsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryPaymentFeeCalcRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryPaymentFeeCalcRequest.DiscardUnknown(m)
+func (m *QueryGetAccountCommitmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAccountCommitmentsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryPaymentFeeCalcRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetAccountCommitmentsResponse proto.InternalMessageInfo
 
-func (m *QueryPaymentFeeCalcRequest) GetPayment() Payment {
+func (m *QueryGetAccountCommitmentsResponse) GetCommitments() []*MarketAmount {
 	if m != nil {
-		return m.Payment
+		return m.Commitments
 	}
-	return Payment{}
+	return nil
 }
 
-// QueryPaymentFeeCalcResponse is a response message for the PaymentFeeCalc query.
-type QueryPaymentFeeCalcResponse struct {
-	// fee_create is the fee required to create the provided payment.
-	FeeCreate github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=fee_create,json=feeCreate,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_create"`
-	// fee_accept is the fee required to accept the provided payment.
-	FeeAccept github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fee_accept,json=feeAccept,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_accept"`
+// QueryGetMarketCommitmentsRequest is a request message for the GetMarketCommitments query.
+type QueryGetMarketCommitmentsRequest struct {
+	// market_id is the numeric identifier of the market with the commitment.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryPaymentFeeCalcResponse) Reset()         { *m = QueryPaymentFeeCalcResponse{} }
-func (m *QueryPaymentFeeCalcResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryPaymentFeeCalcResponse) ProtoMessage()    {}
-func (*QueryPaymentFeeCalcResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{45}
+func (m *QueryGetMarketCommitmentsRequest) Reset()         { *m = QueryGetMarketCommitmentsRequest{} }
+func (m *QueryGetMarketCommitmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMarketCommitmentsRequest) ProtoMessage()    {}
+func (*QueryGetMarketCommitmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{48}
 }
-func (m *QueryPaymentFeeCalcResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetMarketCommitmentsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryPaymentFeeCalcResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetMarketCommitmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryPaymentFeeCalcResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetMarketCommitmentsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2485,3926 +2834,14589 @@ func (m *QueryPaymentFeeCalcResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryPaymentFeeCalcResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryPaymentFeeCalcResponse.Merge(m, src)
+func (m *QueryGetMarketCommitmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMarketCommitmentsRequest.Merge(m, src)
 }
-func (m *QueryPaymentFeeCalcResponse) XXX_Size() int {
+func (m *QueryGetMarketCommitmentsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryPaymentFeeCalcResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryPaymentFeeCalcResponse.DiscardUnknown(m)
+func (m *QueryGetMarketCommitmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMarketCommitmentsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryPaymentFeeCalcResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetMarketCommitmentsRequest proto.InternalMessageInfo
 
-func (m *QueryPaymentFeeCalcResponse) GetFeeCreate() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *QueryGetMarketCommitmentsRequest) GetMarketId() uint32 {
 	if m != nil {
-		return m.FeeCreate
+		return m.MarketId
 	}
-	return nil
+	return 0
 }
 
-func (m *QueryPaymentFeeCalcResponse) GetFeeAccept() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *QueryGetMarketCommitmentsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.FeeAccept
+		return m.Pagination
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryOrderFeeCalcRequest)(nil), "provenance.exchange.v1.QueryOrderFeeCalcRequest")
-	proto.RegisterType((*QueryOrderFeeCalcResponse)(nil), "provenance.exchange.v1.QueryOrderFeeCalcResponse")
-	proto.RegisterType((*QueryGetOrderRequest)(nil), "provenance.exchange.v1.QueryGetOrderRequest")
-	proto.RegisterType((*QueryGetOrderResponse)(nil), "provenance.exchange.v1.QueryGetOrderResponse")
-	proto.RegisterType((*QueryGetOrderByExternalIDRequest)(nil), "provenance.exchange.v1.QueryGetOrderByExternalIDRequest")
-	proto.RegisterType((*QueryGetOrderByExternalIDResponse)(nil), "provenance.exchange.v1.QueryGetOrderByExternalIDResponse")
-	proto.RegisterType((*QueryGetMarketOrdersRequest)(nil), "provenance.exchange.v1.QueryGetMarketOrdersRequest")
-	proto.RegisterType((*QueryGetMarketOrdersResponse)(nil), "provenance.exchange.v1.QueryGetMarketOrdersResponse")
-	proto.RegisterType((*QueryGetOwnerOrdersRequest)(nil), "provenance.exchange.v1.QueryGetOwnerOrdersRequest")
-	proto.RegisterType((*QueryGetOwnerOrdersResponse)(nil), "provenance.exchange.v1.QueryGetOwnerOrdersResponse")
-	proto.RegisterType((*QueryGetAssetOrdersRequest)(nil), "provenance.exchange.v1.QueryGetAssetOrdersRequest")
-	proto.RegisterType((*QueryGetAssetOrdersResponse)(nil), "provenance.exchange.v1.QueryGetAssetOrdersResponse")
-	proto.RegisterType((*QueryGetAllOrdersRequest)(nil), "provenance.exchange.v1.QueryGetAllOrdersRequest")
-	proto.RegisterType((*QueryGetAllOrdersResponse)(nil), "provenance.exchange.v1.QueryGetAllOrdersResponse")
-	proto.RegisterType((*QueryGetCommitmentRequest)(nil), "provenance.exchange.v1.QueryGetCommitmentRequest")
-	proto.RegisterType((*QueryGetCommitmentResponse)(nil), "provenance.exchange.v1.QueryGetCommitmentResponse")
-	proto.RegisterType((*QueryGetAccountCommitmentsRequest)(nil), "provenance.exchange.v1.QueryGetAccountCommitmentsRequest")
-	proto.RegisterType((*QueryGetAccountCommitmentsResponse)(nil), "provenance.exchange.v1.QueryGetAccountCommitmentsResponse")
-	proto.RegisterType((*QueryGetMarketCommitmentsRequest)(nil), "provenance.exchange.v1.QueryGetMarketCommitmentsRequest")
-	proto.RegisterType((*QueryGetMarketCommitmentsResponse)(nil), "provenance.exchange.v1.QueryGetMarketCommitmentsResponse")
-	proto.RegisterType((*QueryGetAllCommitmentsRequest)(nil), "provenance.exchange.v1.QueryGetAllCommitmentsRequest")
-	proto.RegisterType((*QueryGetAllCommitmentsResponse)(nil), "provenance.exchange.v1.QueryGetAllCommitmentsResponse")
-	proto.RegisterType((*QueryGetMarketRequest)(nil), "provenance.exchange.v1.QueryGetMarketRequest")
-	proto.RegisterType((*QueryGetMarketResponse)(nil), "provenance.exchange.v1.QueryGetMarketResponse")
-	proto.RegisterType((*QueryGetAllMarketsRequest)(nil), "provenance.exchange.v1.QueryGetAllMarketsRequest")
-	proto.RegisterType((*QueryGetAllMarketsResponse)(nil), "provenance.exchange.v1.QueryGetAllMarketsResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "provenance.exchange.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.exchange.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryCommitmentSettlementFeeCalcRequest)(nil), "provenance.exchange.v1.QueryCommitmentSettlementFeeCalcRequest")
-	proto.RegisterType((*QueryCommitmentSettlementFeeCalcResponse)(nil), "provenance.exchange.v1.QueryCommitmentSettlementFeeCalcResponse")
-	proto.RegisterType((*QueryValidateCreateMarketRequest)(nil), "provenance.exchange.v1.QueryValidateCreateMarketRequest")
-	proto.RegisterType((*QueryValidateCreateMarketResponse)(nil), "provenance.exchange.v1.QueryValidateCreateMarketResponse")
-	proto.RegisterType((*QueryValidateMarketRequest)(nil), "provenance.exchange.v1.QueryValidateMarketRequest")
-	proto.RegisterType((*QueryValidateMarketResponse)(nil), "provenance.exchange.v1.QueryValidateMarketResponse")
-	proto.RegisterType((*QueryValidateManageFeesRequest)(nil), "provenance.exchange.v1.QueryValidateManageFeesRequest")
-	proto.RegisterType((*QueryValidateManageFeesResponse)(nil), "provenance.exchange.v1.QueryValidateManageFeesResponse")
-	proto.RegisterType((*QueryGetPaymentRequest)(nil), "provenance.exchange.v1.QueryGetPaymentRequest")
-	proto.RegisterType((*QueryGetPaymentResponse)(nil), "provenance.exchange.v1.QueryGetPaymentResponse")
-	proto.RegisterType((*QueryGetPaymentsWithSourceRequest)(nil), "provenance.exchange.v1.QueryGetPaymentsWithSourceRequest")
-	proto.RegisterType((*QueryGetPaymentsWithSourceResponse)(nil), "provenance.exchange.v1.QueryGetPaymentsWithSourceResponse")
-	proto.RegisterType((*QueryGetPaymentsWithTargetRequest)(nil), "provenance.exchange.v1.QueryGetPaymentsWithTargetRequest")
-	proto.RegisterType((*QueryGetPaymentsWithTargetResponse)(nil), "provenance.exchange.v1.QueryGetPaymentsWithTargetResponse")
-	proto.RegisterType((*QueryGetAllPaymentsRequest)(nil), "provenance.exchange.v1.QueryGetAllPaymentsRequest")
-	proto.RegisterType((*QueryGetAllPaymentsResponse)(nil), "provenance.exchange.v1.QueryGetAllPaymentsResponse")
-	proto.RegisterType((*QueryPaymentFeeCalcRequest)(nil), "provenance.exchange.v1.QueryPaymentFeeCalcRequest")
-	proto.RegisterType((*QueryPaymentFeeCalcResponse)(nil), "provenance.exchange.v1.QueryPaymentFeeCalcResponse")
+// QueryGetMarketCommitmentsResponse is a response message for the GetMarketCommitments query.
+type QueryGetMarketCommitmentsResponse struct {
+	// commitments is the amounts committed to the market from any account.
+	Commitments []*AccountAmount `protobuf:"bytes,1,rep,name=commitments,proto3" json:"commitments,omitempty"`
+	// pagination is the resulting pagination parameters.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("provenance/exchange/v1/query.proto", fileDescriptor_00949b75b1c10bfe)
+func (m *QueryGetMarketCommitmentsResponse) Reset()         { *m = QueryGetMarketCommitmentsResponse{} }
+func (m *QueryGetMarketCommitmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMarketCommitmentsResponse) ProtoMessage()    {}
+func (*QueryGetMarketCommitmentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{49}
 }
-
-var fileDescriptor_00949b75b1c10bfe = []byte{
-	// 2432 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xdd, 0x6f, 0x14, 0xd7,
-	0x15, 0xe7, 0x1a, 0x6c, 0xec, 0x03, 0x18, 0x71, 0x59, 0xe8, 0x7a, 0x81, 0xb5, 0x19, 0xbe, 0x2c,
-	0x03, 0x3b, 0xd8, 0x06, 0x07, 0x52, 0x51, 0x62, 0x93, 0xda, 0x42, 0x6a, 0xc0, 0x59, 0xac, 0x26,
-	0xb2, 0xd4, 0x6e, 0xc6, 0xb3, 0xd7, 0xcb, 0xc8, 0xb3, 0x33, 0x9b, 0x99, 0xf1, 0x62, 0xcb, 0xb2,
-	0xd4, 0xa6, 0x1f, 0x51, 0xf2, 0x50, 0x55, 0xea, 0x43, 0xd3, 0x46, 0x4d, 0x1f, 0xa8, 0xda, 0x2a,
-	0x2f, 0xe1, 0xa1, 0x7d, 0xaa, 0xaa, 0x3c, 0xf4, 0xa1, 0xbc, 0x54, 0x8a, 0xda, 0x97, 0x56, 0xaa,
-	0xda, 0x08, 0x2a, 0xe5, 0xa5, 0xfd, 0x17, 0xaa, 0x68, 0xee, 0x3d, 0xb3, 0x33, 0xb3, 0x3b, 0x5f,
-	0xeb, 0x6c, 0x90, 0x5f, 0x58, 0xcf, 0xcc, 0x39, 0xf7, 0xfc, 0xce, 0xef, 0x7e, 0x9c, 0x7b, 0x7f,
-	0x17, 0x90, 0x1a, 0x96, 0xd9, 0x64, 0x86, 0x62, 0xa8, 0x4c, 0x66, 0x1b, 0xea, 0x03, 0xc5, 0xa8,
-	0x31, 0xb9, 0x39, 0x29, 0xbf, 0xb9, 0xce, 0xac, 0xcd, 0x52, 0xc3, 0x32, 0x1d, 0x93, 0x1e, 0xf7,
-	0x6d, 0x4a, 0x9e, 0x4d, 0xa9, 0x39, 0x59, 0x38, 0xa2, 0xd4, 0x35, 0xc3, 0x94, 0xf9, 0xbf, 0xc2,
-	0xb4, 0x30, 0xa2, 0x9a, 0x76, 0xdd, 0xb4, 0x2b, 0xfc, 0x49, 0x16, 0x0f, 0xf8, 0x69, 0x42, 0x3c,
-	0xc9, 0x2b, 0x8a, 0xcd, 0x44, 0xf3, 0x72, 0x73, 0x72, 0x85, 0x39, 0xca, 0xa4, 0xdc, 0x50, 0x6a,
-	0x9a, 0xa1, 0x38, 0x9a, 0x69, 0xa0, 0x6d, 0x31, 0x68, 0xeb, 0x59, 0xa9, 0xa6, 0xe6, 0x7d, 0x3f,
-	0x59, 0x33, 0xcd, 0x9a, 0xce, 0x64, 0xa5, 0xa1, 0xc9, 0x8a, 0x61, 0x98, 0x0e, 0x77, 0xf6, 0x22,
-	0xe5, 0x6a, 0x66, 0xcd, 0x14, 0x08, 0xdc, 0xbf, 0xf0, 0xed, 0x78, 0x4c, 0xa6, 0xaa, 0x59, 0xaf,
-	0x6b, 0x4e, 0x9d, 0x19, 0x8e, 0xe7, 0x7f, 0x26, 0xc6, 0xb2, 0xae, 0x58, 0x6b, 0xcc, 0x49, 0x31,
-	0x32, 0xad, 0x2a, 0xb3, 0xd2, 0x5a, 0x6a, 0x28, 0x96, 0x52, 0xf7, 0x8c, 0xce, 0xc5, 0x1a, 0x6d,
-	0x06, 0x51, 0x8d, 0xc6, 0x98, 0x39, 0x1b, 0xc2, 0x40, 0x7a, 0x8f, 0x40, 0xfe, 0x55, 0x97, 0xd7,
-	0x7b, 0x2e, 0x84, 0x79, 0xc6, 0x6e, 0x2b, 0xba, 0x5a, 0x66, 0x6f, 0xae, 0x33, 0xdb, 0xa1, 0x37,
-	0x61, 0x48, 0xb1, 0xd7, 0x2a, 0x1c, 0x5d, 0xbe, 0x6f, 0x8c, 0x8c, 0x1f, 0x98, 0x1a, 0x2b, 0x45,
-	0xf7, 0x6b, 0x69, 0xd6, 0x5e, 0xe3, 0x4d, 0x94, 0x07, 0x15, 0xfc, 0xcb, 0x75, 0x5f, 0xd1, 0xaa,
-	0xe8, 0xbe, 0x37, 0xd9, 0x7d, 0x4e, 0xab, 0xa2, 0xfb, 0x0a, 0xfe, 0x25, 0x3d, 0xee, 0x83, 0x91,
-	0x08, 0x68, 0x76, 0xc3, 0x34, 0x6c, 0x46, 0x5f, 0x85, 0x9c, 0x6a, 0x31, 0xde, 0x85, 0x95, 0x55,
-	0xc6, 0x2a, 0x66, 0x83, 0xf7, 0x66, 0x9e, 0x8c, 0xed, 0x1d, 0x3f, 0x30, 0x35, 0x52, 0xc2, 0x61,
-	0xe4, 0x0e, 0x86, 0x12, 0x0e, 0x86, 0xd2, 0x6d, 0x53, 0x33, 0xe6, 0xf6, 0x3d, 0xf9, 0xd7, 0xe8,
-	0x9e, 0x32, 0xf5, 0x9c, 0xe7, 0x19, 0xbb, 0x27, 0x5c, 0xe9, 0xb7, 0xe1, 0x84, 0xcd, 0x1c, 0x47,
-	0x67, 0x2e, 0x83, 0x95, 0x55, 0x5d, 0x71, 0x42, 0x2d, 0xf7, 0x65, 0x6b, 0x39, 0xef, 0xb7, 0x31,
-	0xaf, 0x2b, 0x4e, 0xa0, 0xfd, 0x37, 0xe0, 0x64, 0xa0, 0x7d, 0xcb, 0x0d, 0x1f, 0x0a, 0xb0, 0x37,
-	0x5b, 0x80, 0x11, 0xbf, 0x91, 0xb2, 0xdb, 0x86, 0x1f, 0x41, 0x9a, 0x84, 0x1c, 0x67, 0x6c, 0x81,
-	0x39, 0x82, 0x4d, 0xec, 0xc8, 0x11, 0x18, 0xe4, 0xbd, 0x50, 0xd1, 0xaa, 0x79, 0x32, 0x46, 0xc6,
-	0xf7, 0x95, 0xf7, 0xf3, 0xe7, 0x3b, 0x55, 0xe9, 0x1b, 0x70, 0xac, 0xcd, 0x05, 0x09, 0x9e, 0x86,
-	0x7e, 0xd1, 0x73, 0x84, 0xf7, 0xdc, 0xa9, 0xb8, 0x9e, 0x13, 0x5e, 0xc2, 0x56, 0x7a, 0x03, 0xc6,
-	0x42, 0xad, 0xcd, 0x6d, 0x7e, 0x7d, 0xc3, 0x61, 0x96, 0xa1, 0xe8, 0x77, 0x5e, 0xf6, 0xc0, 0x9c,
-	0x80, 0x21, 0x31, 0x29, 0x3c, 0x34, 0x87, 0xca, 0x83, 0xe2, 0xc5, 0x9d, 0x2a, 0x1d, 0x85, 0x03,
-	0x0c, 0x3d, 0xdc, 0xcf, 0xee, 0xa0, 0x1b, 0x2a, 0x83, 0xf7, 0xea, 0x4e, 0x55, 0x7a, 0x1d, 0x4e,
-	0x27, 0x44, 0xf8, 0x22, 0xd8, 0xff, 0x4c, 0xe0, 0x84, 0xd7, 0xf4, 0x2b, 0x1c, 0x0f, 0xff, 0x6c,
-	0x67, 0xc2, 0x7d, 0x0a, 0x40, 0x30, 0xec, 0x6c, 0x36, 0x18, 0xc2, 0x1e, 0xe2, 0x6f, 0x96, 0x36,
-	0x1b, 0x8c, 0x9e, 0x85, 0x61, 0x65, 0xd5, 0x61, 0x56, 0xa5, 0xd5, 0x0d, 0x7b, 0x79, 0x37, 0x1c,
-	0xe4, 0x6f, 0xef, 0x89, 0xbe, 0xa0, 0xf3, 0x00, 0xfe, 0xaa, 0x96, 0x57, 0x39, 0xf6, 0xf3, 0xa1,
-	0xe1, 0x20, 0x56, 0x58, 0x6f, 0x50, 0x2c, 0x2a, 0x35, 0x86, 0xe8, 0xca, 0x01, 0x4f, 0xe9, 0x03,
-	0x02, 0x27, 0xa3, 0x33, 0x41, 0x7e, 0xae, 0xc1, 0x80, 0x58, 0x72, 0x70, 0xba, 0xa4, 0x10, 0x84,
-	0xc6, 0x74, 0x21, 0x02, 0xdf, 0x85, 0x54, 0x7c, 0x22, 0x66, 0x08, 0xe0, 0x3f, 0x08, 0x14, 0x5a,
-	0xbd, 0xf8, 0xd0, 0x40, 0x06, 0x5a, 0x4c, 0x97, 0xa0, 0xdf, 0x74, 0xdf, 0x72, 0x96, 0x87, 0xe6,
-	0xf2, 0x7f, 0xfd, 0xdd, 0xe5, 0x1c, 0x46, 0x99, 0xad, 0x56, 0x2d, 0x66, 0xdb, 0xf7, 0x1d, 0x4b,
-	0x33, 0x6a, 0x65, 0x61, 0xb6, 0xbb, 0xc8, 0xff, 0x45, 0x60, 0x18, 0x85, 0x72, 0xdb, 0x25, 0xdc,
-	0x7f, 0x1c, 0xe0, 0x7e, 0xd6, 0xb6, 0xdb, 0x47, 0x79, 0x0e, 0xfa, 0x15, 0xf7, 0xad, 0xe0, 0xbe,
-	0x2c, 0x1e, 0x76, 0x2f, 0xc3, 0xa1, 0x0c, 0x76, 0x09, 0xc3, 0x2b, 0x58, 0x52, 0x5d, 0x78, 0xba,
-	0x1e, 0xa6, 0xb7, 0x57, 0x1c, 0xbc, 0x4f, 0xb0, 0x38, 0x86, 0x83, 0xec, 0x12, 0x06, 0x74, 0x1f,
-	0xdc, 0xed, 0xd6, 0x4e, 0xc9, 0xa3, 0x60, 0x0a, 0xf6, 0x2b, 0xaa, 0x6a, 0xae, 0x1b, 0x4e, 0xea,
-	0xfc, 0xf6, 0x0c, 0xc3, 0x6b, 0x6f, 0x5f, 0x78, 0xed, 0x95, 0x7e, 0x1a, 0x18, 0xd1, 0xc1, 0x70,
-	0x48, 0xc6, 0x26, 0x0c, 0x28, 0x75, 0x0c, 0x97, 0x52, 0x60, 0xe7, 0xdd, 0x02, 0xfb, 0xe1, 0xbf,
-	0x47, 0xc7, 0x6b, 0x9a, 0xf3, 0x60, 0x7d, 0xa5, 0xa4, 0x9a, 0x75, 0xdc, 0x8f, 0xe2, 0xcf, 0x65,
-	0xbb, 0xba, 0x26, 0xbb, 0x73, 0xc0, 0xe6, 0x0e, 0xf6, 0xcf, 0x3f, 0x7b, 0x3c, 0x71, 0x50, 0x67,
-	0x35, 0x45, 0xdd, 0xac, 0xb8, 0x5b, 0x4d, 0xfb, 0xb7, 0x9f, 0x3d, 0x9e, 0x20, 0x65, 0x0c, 0x28,
-	0xd5, 0xfd, 0x62, 0x35, 0x2b, 0x32, 0xf1, 0xf1, 0xd9, 0x5f, 0x84, 0x8f, 0x1c, 0xf4, 0x57, 0x99,
-	0x61, 0xd6, 0x71, 0x2a, 0x8a, 0x07, 0x49, 0x07, 0x29, 0x29, 0x1c, 0xf2, 0x31, 0x0f, 0x07, 0x02,
-	0xdb, 0x57, 0x24, 0xe5, 0x6c, 0xdc, 0x08, 0x11, 0xf5, 0x63, 0x96, 0xe7, 0x53, 0x0e, 0x3a, 0x4a,
-	0x6f, 0x13, 0xbf, 0xd8, 0x0b, 0xab, 0x88, 0xe4, 0x12, 0x8b, 0x66, 0xaf, 0x26, 0xc3, 0xef, 0x89,
-	0xcf, 0x73, 0x04, 0x12, 0xcc, 0x7b, 0x21, 0x2a, 0xef, 0x73, 0xb1, 0xfb, 0x59, 0x41, 0x60, 0x44,
-	0xe2, 0xbd, 0x9b, 0x26, 0x35, 0x38, 0x15, 0x98, 0xc3, 0x11, 0xec, 0xf5, 0x8a, 0xa0, 0x8f, 0x08,
-	0x14, 0xe3, 0x22, 0x21, 0x3b, 0x2f, 0x47, 0xb1, 0x23, 0xc5, 0xb1, 0x13, 0x98, 0x66, 0x5f, 0x0e,
-	0x35, 0x57, 0xfd, 0x6d, 0xa9, 0xe8, 0xd1, 0x2c, 0x03, 0x4a, 0xfa, 0x3e, 0x81, 0xe3, 0xed, 0x6e,
-	0x98, 0x9f, 0x3b, 0xcb, 0xc4, 0x5c, 0xca, 0x30, 0xcb, 0xc4, 0x23, 0x9d, 0x81, 0x01, 0xd1, 0x34,
-	0x1e, 0x7e, 0x8a, 0xc9, 0x93, 0xa4, 0x8c, 0xd6, 0x92, 0x1a, 0x5a, 0x9b, 0xc5, 0xc7, 0x9e, 0xf7,
-	0xe9, 0xaf, 0x82, 0x75, 0x3c, 0x10, 0x05, 0xf3, 0xbd, 0x09, 0xfb, 0x05, 0x1a, 0xaf, 0x2f, 0xcf,
-	0x24, 0x83, 0x9f, 0xb3, 0x34, 0xb6, 0x5a, 0xf6, 0x7c, 0x7a, 0xd7, 0x91, 0x39, 0xa0, 0x1c, 0xe5,
-	0x22, 0x3f, 0xbd, 0x62, 0x22, 0xd2, 0x2b, 0x70, 0x34, 0xf4, 0x16, 0x41, 0xcf, 0xc0, 0x80, 0x38,
-	0xe5, 0xe2, 0xc6, 0x3d, 0x96, 0x70, 0xf4, 0x43, 0x6b, 0xe9, 0x8f, 0x04, 0x2e, 0xf0, 0xf6, 0xfc,
-	0x71, 0x79, 0xdf, 0x3f, 0x85, 0x85, 0x0f, 0xb5, 0xaf, 0x03, 0xf8, 0x07, 0x28, 0x8c, 0x73, 0x3d,
-	0x96, 0x1b, 0xbb, 0xd6, 0xbe, 0xa0, 0x88, 0x86, 0x5b, 0x3d, 0xe2, 0xb7, 0x45, 0xaf, 0x43, 0x5e,
-	0x33, 0x54, 0x7d, 0xbd, 0xca, 0x2a, 0x2b, 0x16, 0x53, 0xd6, 0xaa, 0xe6, 0x43, 0xa3, 0xb2, 0xaa,
-	0x31, 0xbd, 0x6a, 0xf3, 0x01, 0x34, 0x58, 0x3e, 0x8e, 0xdf, 0xe7, 0xbc, 0xcf, 0xf3, 0xfc, 0xab,
-	0xf4, 0xe9, 0x3e, 0x18, 0x4f, 0xc7, 0x8f, 0x24, 0xfd, 0x90, 0xc0, 0x21, 0x0f, 0xa3, 0x7b, 0x7e,
-	0xb4, 0x9f, 0x5f, 0x5d, 0x3b, 0xe8, 0xc5, 0x9d, 0x67, 0xcc, 0xa6, 0x6f, 0x11, 0x38, 0xa0, 0x19,
-	0x8d, 0x75, 0xa7, 0xe2, 0x98, 0x8e, 0xa2, 0xa7, 0x1f, 0x90, 0x7b, 0x05, 0x03, 0x78, 0xd4, 0x25,
-	0x37, 0x28, 0x7d, 0x97, 0xc0, 0x61, 0xd5, 0x34, 0x9a, 0xcc, 0x72, 0x58, 0x15, 0x81, 0xec, 0x7d,
-	0x5e, 0x40, 0x86, 0x5b, 0x91, 0x05, 0x98, 0x25, 0x0f, 0x8b, 0xad, 0x99, 0x46, 0xc5, 0x50, 0x9a,
-	0x76, 0x7e, 0x5f, 0x72, 0x99, 0xb9, 0x8b, 0x5b, 0xd8, 0x45, 0x4b, 0x53, 0x19, 0x1e, 0xf0, 0x87,
-	0xfd, 0x36, 0xee, 0x2a, 0x4d, 0x9b, 0xde, 0x06, 0x70, 0x84, 0x52, 0x60, 0x28, 0xcd, 0x7c, 0x3f,
-	0x1f, 0xb1, 0xd9, 0x1a, 0x2c, 0x0f, 0x3a, 0xe6, 0x3c, 0x63, 0x77, 0x95, 0xa6, 0xf4, 0x8e, 0x57,
-	0xad, 0xbf, 0xa9, 0xe8, 0x5a, 0x55, 0x71, 0xd8, 0x6d, 0x8b, 0x29, 0x0e, 0x0b, 0x2f, 0xae, 0x0c,
-	0x8e, 0x71, 0x5d, 0x84, 0x55, 0x70, 0x8d, 0xb5, 0xc4, 0x07, 0x9c, 0x26, 0x93, 0x09, 0xd3, 0x64,
-	0xc1, 0x6c, 0x46, 0xb4, 0x58, 0x3e, 0xaa, 0x76, 0xbe, 0x94, 0x56, 0xb1, 0x5c, 0x47, 0x43, 0xc1,
-	0x61, 0x9e, 0x83, 0x7e, 0x66, 0x59, 0xa6, 0xe5, 0x1d, 0x44, 0xf8, 0x03, 0xbd, 0x08, 0xb4, 0x66,
-	0x36, 0x2b, 0x0d, 0xcb, 0x6c, 0x54, 0x1e, 0x6a, 0xba, 0x5e, 0x69, 0x28, 0xb6, 0x37, 0xbb, 0x0e,
-	0xd7, 0xcc, 0xe6, 0xa2, 0x65, 0x36, 0x5e, 0xd3, 0x74, 0x7d, 0x51, 0xb1, 0x6d, 0xe9, 0x06, 0xae,
-	0x90, 0x5e, 0x9c, 0x2e, 0x2a, 0xc9, 0x34, 0x1e, 0x31, 0xda, 0x5d, 0x93, 0xc0, 0x49, 0xdf, 0xf5,
-	0xca, 0xac, 0xef, 0x65, 0x28, 0x62, 0xb2, 0x78, 0x41, 0x2b, 0x70, 0xb4, 0xce, 0x5f, 0xf2, 0x99,
-	0xdb, 0xc6, 0xaf, 0x9c, 0xcc, 0x6f, 0x47, 0x6b, 0xe5, 0x23, 0xf5, 0xf6, 0x57, 0x52, 0x15, 0x46,
-	0x63, 0x21, 0xf4, 0x8e, 0xd9, 0x35, 0xbf, 0xce, 0x2e, 0x0a, 0xc5, 0xd1, 0x4b, 0xf0, 0x0a, 0x0c,
-	0xd8, 0xe6, 0xba, 0xa5, 0xb2, 0xd4, 0x32, 0x8b, 0x76, 0xe9, 0x92, 0xcf, 0x12, 0x7c, 0xa5, 0x23,
-	0x18, 0xa6, 0x72, 0x03, 0xf6, 0xa3, 0xe2, 0x89, 0x14, 0x8e, 0xc6, 0x57, 0x0c, 0xe1, 0xe9, 0xd9,
-	0xbb, 0xa7, 0xc8, 0xd3, 0x6d, 0xcd, 0xda, 0xaf, 0x69, 0xce, 0x83, 0xfb, 0x1c, 0xd5, 0xce, 0xd3,
-	0xe9, 0x55, 0x7d, 0xff, 0x90, 0xf8, 0xbb, 0xf9, 0x28, 0x7c, 0xc8, 0xc0, 0x57, 0x61, 0xd0, 0xd3,
-	0x7c, 0xb1, 0x0e, 0xa4, 0x52, 0xd0, 0x72, 0xe8, 0x5d, 0x95, 0x8f, 0x23, 0x73, 0x49, 0xb1, 0x6a,
-	0x2c, 0x38, 0x36, 0x1c, 0xfe, 0x22, 0x9d, 0x4c, 0x61, 0xf7, 0xa5, 0x93, 0xe9, 0xe1, 0xdb, 0x55,
-	0x64, 0x56, 0x43, 0x1b, 0x3b, 0x0f, 0x6e, 0xaf, 0xf7, 0x8f, 0x8f, 0x82, 0x2a, 0x4a, 0x30, 0xcc,
-	0xae, 0xe2, 0xe2, 0x5b, 0xc8, 0x05, 0x86, 0x68, 0xdb, 0xcb, 0xdd, 0xea, 0x76, 0xfa, 0x63, 0x85,
-	0x6d, 0x2d, 0x02, 0x8f, 0xfa, 0x90, 0x84, 0xf6, 0xf6, 0x91, 0x84, 0xef, 0x10, 0x00, 0xb7, 0xf0,
-	0x8a, 0x2a, 0xf6, 0xfc, 0x36, 0x5a, 0x43, 0xab, 0x0c, 0xab, 0x62, 0x0b, 0x82, 0xa2, 0xaa, 0xac,
-	0xe1, 0x3c, 0xbf, 0x4d, 0x96, 0x0b, 0x61, 0x96, 0xc7, 0x9c, 0xfa, 0xf5, 0x19, 0xe8, 0xe7, 0x2c,
-	0xd1, 0x5f, 0x12, 0x38, 0x18, 0xbc, 0x8e, 0xa1, 0x57, 0xe2, 0x08, 0x8f, 0xbb, 0x54, 0x2a, 0x4c,
-	0x76, 0xe1, 0x21, 0x7a, 0x41, 0x9a, 0x78, 0xeb, 0x6f, 0xff, 0xf9, 0x49, 0xdf, 0x59, 0x2a, 0xc9,
-	0x31, 0xd7, 0x59, 0x6e, 0x2d, 0x15, 0x97, 0x68, 0xf4, 0x67, 0x04, 0x06, 0xbd, 0xbb, 0x01, 0x7a,
-	0x29, 0x31, 0x56, 0xdb, 0x2d, 0x49, 0xe1, 0x72, 0x46, 0x6b, 0x44, 0x75, 0x85, 0xa3, 0x9a, 0xa0,
-	0xe3, 0x72, 0xd2, 0xad, 0x9e, 0xbc, 0xe5, 0x69, 0xa2, 0xdb, 0xf4, 0xbd, 0x3e, 0xc8, 0x45, 0xdd,
-	0x5b, 0xd0, 0xeb, 0x99, 0x22, 0x47, 0x5c, 0xa6, 0x14, 0x6e, 0xec, 0xc0, 0x13, 0xf1, 0xbf, 0x4b,
-	0x78, 0x02, 0xdf, 0x23, 0xcb, 0x2f, 0xd1, 0xaf, 0xc9, 0x89, 0xd7, 0x97, 0xf2, 0x56, 0x6b, 0xa7,
-	0xb4, 0xed, 0xa5, 0x15, 0xa8, 0xd9, 0xdb, 0xf4, 0x56, 0x22, 0x07, 0x76, 0x54, 0x33, 0xe1, 0x06,
-	0xfe, 0x4b, 0xe0, 0x70, 0xdb, 0x6d, 0x05, 0x9d, 0x4e, 0xcb, 0x2d, 0xe2, 0x96, 0xa6, 0x70, 0xb5,
-	0x3b, 0x27, 0xe4, 0xc2, 0xe0, 0x54, 0x3c, 0x58, 0x9e, 0xa6, 0x93, 0xdd, 0x32, 0x61, 0xc7, 0xbb,
-	0xc4, 0x26, 0x4f, 0x3f, 0x22, 0x30, 0x1c, 0xbe, 0x1f, 0xa0, 0x53, 0xa9, 0x3d, 0xd9, 0x71, 0x51,
-	0x52, 0x98, 0xee, 0xca, 0x07, 0x73, 0xbd, 0xca, 0x73, 0x2d, 0xd1, 0x4b, 0x29, 0xb0, 0xf9, 0xdd,
-	0x8a, 0xbc, 0xc5, 0x7f, 0x5a, 0x88, 0x03, 0x7a, 0x7b, 0x3a, 0xe2, 0xce, 0xeb, 0x85, 0x74, 0xc4,
-	0x11, 0x82, 0x7e, 0x66, 0xc4, 0xfc, 0xae, 0x42, 0xde, 0xe2, 0x3f, 0xdb, 0xf4, 0x7d, 0x02, 0x07,
-	0x83, 0xea, 0x78, 0xca, 0x5a, 0x15, 0xa1, 0xd6, 0xa7, 0xac, 0x55, 0x51, 0xd2, 0xbb, 0x74, 0x9e,
-	0x63, 0x1d, 0xa3, 0xc5, 0x64, 0xac, 0xf4, 0x63, 0x02, 0x87, 0x42, 0x7a, 0x35, 0x4d, 0x0d, 0xd6,
-	0x21, 0xa5, 0x17, 0xa6, 0xba, 0x71, 0x41, 0x80, 0x0b, 0x1c, 0xe0, 0x6c, 0xfc, 0x94, 0x8d, 0x18,
-	0xe8, 0xbe, 0xc4, 0x27, 0x6f, 0xa1, 0x04, 0xbd, 0x4d, 0xff, 0x42, 0xe0, 0x58, 0xa4, 0xd2, 0x4c,
-	0x53, 0x17, 0xa5, 0x58, 0x31, 0xbc, 0xf0, 0xe2, 0x4e, 0x5c, 0x31, 0xb3, 0x9b, 0x3c, 0xb3, 0x17,
-	0xe8, 0x35, 0x39, 0xfd, 0x7f, 0x6d, 0xc8, 0x98, 0x46, 0x20, 0x9f, 0x1f, 0x88, 0xd5, 0xb9, 0x43,
-	0x40, 0x4e, 0x5f, 0x9d, 0xe3, 0xd4, 0xef, 0xf4, 0xd5, 0x39, 0x56, 0xad, 0x96, 0x36, 0x78, 0x32,
-	0xd6, 0xf2, 0x75, 0x3a, 0xb3, 0xa3, 0x8e, 0xb2, 0xe3, 0xfd, 0x82, 0x34, 0x44, 0xaf, 0x4d, 0x47,
-	0x3a, 0x74, 0x62, 0x7a, 0x2d, 0xc3, 0x54, 0x88, 0x60, 0x60, 0xa6, 0x5b, 0x37, 0x4c, 0xff, 0x22,
-	0x4f, 0xff, 0x1c, 0x3d, 0x93, 0x21, 0x09, 0xfa, 0x01, 0x81, 0xa1, 0x16, 0x99, 0xf4, 0x72, 0x36,
-	0xd2, 0x3d, 0x84, 0xa5, 0xac, 0xe6, 0x88, 0x6c, 0x8a, 0x23, 0xbb, 0x44, 0x27, 0xb2, 0x77, 0x8b,
-	0xbb, 0x6d, 0x3a, 0x14, 0x92, 0x69, 0x69, 0x96, 0x95, 0x25, 0x2c, 0x1c, 0xa7, 0x4f, 0xf6, 0x4e,
-	0x15, 0x58, 0xba, 0xc0, 0xc1, 0x9e, 0xa6, 0xa3, 0xc9, 0x60, 0x6d, 0xfa, 0x0e, 0x81, 0x01, 0x21,
-	0xaa, 0xd2, 0x89, 0xc4, 0x38, 0x21, 0x1d, 0xb7, 0x70, 0x31, 0x93, 0x6d, 0xd6, 0xa5, 0x51, 0xa8,
-	0xb9, 0xf4, 0x9f, 0x04, 0x4e, 0x24, 0x08, 0xa1, 0xf4, 0x56, 0x62, 0xd0, 0x74, 0x09, 0xb8, 0xf0,
-	0xd2, 0xce, 0x1b, 0xc0, 0x54, 0x5e, 0xe4, 0xa9, 0x5c, 0xa5, 0x53, 0x89, 0x3b, 0x52, 0x7f, 0x8c,
-	0x56, 0x02, 0x32, 0xf1, 0x9f, 0x08, 0xe4, 0xa2, 0x94, 0xaf, 0x94, 0x75, 0x26, 0x41, 0xb7, 0x4b,
-	0x59, 0x67, 0x92, 0x64, 0x36, 0x69, 0x86, 0x67, 0x72, 0x85, 0x96, 0xe2, 0x32, 0x69, 0xa2, 0xb7,
-	0x1c, 0x52, 0x06, 0xe9, 0xff, 0x08, 0x0c, 0x87, 0xc5, 0xb1, 0x94, 0xfd, 0x40, 0xa4, 0x08, 0x97,
-	0xb2, 0x1f, 0x88, 0x56, 0xdf, 0x24, 0x8b, 0x63, 0xd6, 0x97, 0xaf, 0xd1, 0xe9, 0x2e, 0xd6, 0x46,
-	0x2f, 0x91, 0x78, 0xa7, 0x56, 0xaa, 0x11, 0x53, 0xf8, 0x0f, 0x04, 0x68, 0xa7, 0xa6, 0x46, 0x67,
-	0x32, 0xe2, 0x6f, 0x93, 0xe9, 0x0a, 0x2f, 0x74, 0xed, 0x97, 0x75, 0x2f, 0x14, 0x48, 0xa2, 0xa5,
-	0x33, 0xd2, 0xff, 0x13, 0x00, 0x5f, 0xfa, 0xa0, 0xa9, 0x6b, 0x5e, 0x58, 0xd4, 0x2b, 0xc8, 0x99,
-	0xed, 0x11, 0xe5, 0x8f, 0xc4, 0xd9, 0xe2, 0x6d, 0xb2, 0x9c, 0x70, 0x3e, 0xc2, 0x43, 0xb8, 0xbc,
-	0x25, 0x94, 0xb3, 0xed, 0xa4, 0x5a, 0xd7, 0x6e, 0xdb, 0x76, 0x7c, 0x18, 0x4d, 0xf1, 0xa3, 0x4f,
-	0xc4, 0x66, 0xa5, 0x53, 0x48, 0x4b, 0xdf, 0xac, 0xc4, 0x8a, 0x83, 0xe9, 0x9b, 0x95, 0x78, 0xdd,
-	0x4e, 0xba, 0xce, 0x09, 0x9a, 0xa2, 0x57, 0x52, 0x90, 0xdb, 0xb2, 0xc8, 0xb8, 0x95, 0x79, 0x54,
-	0x2a, 0x42, 0xc6, 0xea, 0x2e, 0x95, 0x90, 0x34, 0xd7, 0x5d, 0x2a, 0x61, 0xd5, 0xac, 0x8b, 0x54,
-	0x84, 0xaa, 0x27, 0x6f, 0x89, 0xdf, 0x6d, 0xfa, 0x08, 0x0f, 0x15, 0xbe, 0xfc, 0x44, 0xb3, 0x54,
-	0xb9, 0x36, 0x49, 0x2c, 0xc3, 0xa1, 0xa2, 0x53, 0xdf, 0x92, 0xc6, 0x39, 0x6a, 0x89, 0x8e, 0xa5,
-	0xa1, 0xa6, 0xbf, 0x21, 0x30, 0x1c, 0xd6, 0x87, 0x52, 0x50, 0x46, 0x8a, 0x55, 0x29, 0x28, 0xa3,
-	0x05, 0x28, 0xe9, 0x12, 0x47, 0x79, 0x9e, 0x9e, 0x4d, 0x2c, 0x34, 0x08, 0x75, 0x8e, 0x3d, 0x79,
-	0x5a, 0x24, 0x9f, 0x3c, 0x2d, 0x92, 0x4f, 0x9f, 0x16, 0xc9, 0x8f, 0x9f, 0x15, 0xf7, 0x7c, 0xf2,
-	0xac, 0xb8, 0xe7, 0xef, 0xcf, 0x8a, 0x7b, 0x60, 0x44, 0x33, 0x63, 0xc2, 0x2f, 0x92, 0xe5, 0x52,
-	0x40, 0x2a, 0xf2, 0x8d, 0x2e, 0x6b, 0x66, 0x30, 0xe8, 0x46, 0x2b, 0xec, 0xca, 0x00, 0xff, 0xbf,
-	0xc3, 0xd3, 0x9f, 0x07, 0x00, 0x00, 0xff, 0xff, 0xaf, 0xa2, 0x08, 0x84, 0x08, 0x2e, 0x00, 0x00,
+func (m *QueryGetMarketCommitmentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetMarketCommitmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetMarketCommitmentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetMarketCommitmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMarketCommitmentsResponse.Merge(m, src)
+}
+func (m *QueryGetMarketCommitmentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetMarketCommitmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMarketCommitmentsResponse.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryGetMarketCommitmentsResponse proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// OrderFeeCalc calculates the fees that will be associated with the provided order.
-	OrderFeeCalc(ctx context.Context, in *QueryOrderFeeCalcRequest, opts ...grpc.CallOption) (*QueryOrderFeeCalcResponse, error)
-	// GetOrder looks up an order by id.
-	GetOrder(ctx context.Context, in *QueryGetOrderRequest, opts ...grpc.CallOption) (*QueryGetOrderResponse, error)
-	// GetOrderByExternalID looks up an order by market id and external id.
-	GetOrderByExternalID(ctx context.Context, in *QueryGetOrderByExternalIDRequest, opts ...grpc.CallOption) (*QueryGetOrderByExternalIDResponse, error)
-	// GetMarketOrders looks up the orders in a market.
-	GetMarketOrders(ctx context.Context, in *QueryGetMarketOrdersRequest, opts ...grpc.CallOption) (*QueryGetMarketOrdersResponse, error)
-	// GetOwnerOrders looks up the orders from the provided owner address.
-	GetOwnerOrders(ctx context.Context, in *QueryGetOwnerOrdersRequest, opts ...grpc.CallOption) (*QueryGetOwnerOrdersResponse, error)
-	// GetAssetOrders looks up the orders for a specific asset denom.
-	GetAssetOrders(ctx context.Context, in *QueryGetAssetOrdersRequest, opts ...grpc.CallOption) (*QueryGetAssetOrdersResponse, error)
-	// GetAllOrders gets all orders in the exchange module.
-	GetAllOrders(ctx context.Context, in *QueryGetAllOrdersRequest, opts ...grpc.CallOption) (*QueryGetAllOrdersResponse, error)
-	// GetCommitment gets the funds in an account that are committed to the market.
-	GetCommitment(ctx context.Context, in *QueryGetCommitmentRequest, opts ...grpc.CallOption) (*QueryGetCommitmentResponse, error)
-	// GetAccountCommitments gets all the funds in an account that are committed to any market.
-	// Optionally, you can filter the results for a specific denomination using the `denom` query parameter.
-	GetAccountCommitments(ctx context.Context, in *QueryGetAccountCommitmentsRequest, opts ...grpc.CallOption) (*QueryGetAccountCommitmentsResponse, error)
-	// GetMarketCommitments gets all the funds committed to a market from any account.
-	GetMarketCommitments(ctx context.Context, in *QueryGetMarketCommitmentsRequest, opts ...grpc.CallOption) (*QueryGetMarketCommitmentsResponse, error)
-	// GetAllCommitments gets all fund committed to any market from any account.
-	GetAllCommitments(ctx context.Context, in *QueryGetAllCommitmentsRequest, opts ...grpc.CallOption) (*QueryGetAllCommitmentsResponse, error)
-	// GetMarket returns all the information and details about a market.
-	GetMarket(ctx context.Context, in *QueryGetMarketRequest, opts ...grpc.CallOption) (*QueryGetMarketResponse, error)
-	// GetAllMarkets returns brief information about each market.
-	GetAllMarkets(ctx context.Context, in *QueryGetAllMarketsRequest, opts ...grpc.CallOption) (*QueryGetAllMarketsResponse, error)
-	// Params returns the exchange module parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// CommitmentSettlementFeeCalc calculates the fees a market will pay for a commitment settlement using current NAVs.
-	CommitmentSettlementFeeCalc(ctx context.Context, in *QueryCommitmentSettlementFeeCalcRequest, opts ...grpc.CallOption) (*QueryCommitmentSettlementFeeCalcResponse, error)
-	// ValidateCreateMarket checks the provided MsgGovCreateMarketResponse and returns any errors it might have.
-	ValidateCreateMarket(ctx context.Context, in *QueryValidateCreateMarketRequest, opts ...grpc.CallOption) (*QueryValidateCreateMarketResponse, error)
-	// ValidateMarket checks for any problems with a market's setup.
-	ValidateMarket(ctx context.Context, in *QueryValidateMarketRequest, opts ...grpc.CallOption) (*QueryValidateMarketResponse, error)
-	// ValidateManageFees checks the provided MsgGovManageFeesRequest and returns any errors that it might have.
-	ValidateManageFees(ctx context.Context, in *QueryValidateManageFeesRequest, opts ...grpc.CallOption) (*QueryValidateManageFeesResponse, error)
-	// GetPayment gets a single specific payment.
-	GetPayment(ctx context.Context, in *QueryGetPaymentRequest, opts ...grpc.CallOption) (*QueryGetPaymentResponse, error)
-	// GetPaymentsWithSource gets all payments with a specific source account.
-	GetPaymentsWithSource(ctx context.Context, in *QueryGetPaymentsWithSourceRequest, opts ...grpc.CallOption) (*QueryGetPaymentsWithSourceResponse, error)
-	// GetPaymentsWithTarget gets all payments with a specific target account.
-	GetPaymentsWithTarget(ctx context.Context, in *QueryGetPaymentsWithTargetRequest, opts ...grpc.CallOption) (*QueryGetPaymentsWithTargetResponse, error)
-	// GetAllPayments gets all payments.
-	GetAllPayments(ctx context.Context, in *QueryGetAllPaymentsRequest, opts ...grpc.CallOption) (*QueryGetAllPaymentsResponse, error)
-	// PaymentFeeCalc calculates the fees that must be paid for creating or accepting a specific payment.
-	PaymentFeeCalc(ctx context.Context, in *QueryPaymentFeeCalcRequest, opts ...grpc.CallOption) (*QueryPaymentFeeCalcResponse, error)
+func (m *QueryGetMarketCommitmentsResponse) GetCommitments() []*AccountAmount {
+	if m != nil {
+		return m.Commitments
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryGetMarketCommitmentsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryGetAllCommitmentsRequest is a request message for the GetAllCommitments query.
+type QueryGetAllCommitmentsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) OrderFeeCalc(ctx context.Context, in *QueryOrderFeeCalcRequest, opts ...grpc.CallOption) (*QueryOrderFeeCalcResponse, error) {
-	out := new(QueryOrderFeeCalcResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/OrderFeeCalc", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetAllCommitmentsRequest) Reset()         { *m = QueryGetAllCommitmentsRequest{} }
+func (m *QueryGetAllCommitmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAllCommitmentsRequest) ProtoMessage()    {}
+func (*QueryGetAllCommitmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{50}
+}
+func (m *QueryGetAllCommitmentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAllCommitmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAllCommitmentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetAllCommitmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAllCommitmentsRequest.Merge(m, src)
+}
+func (m *QueryGetAllCommitmentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAllCommitmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAllCommitmentsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetOrder(ctx context.Context, in *QueryGetOrderRequest, opts ...grpc.CallOption) (*QueryGetOrderResponse, error) {
-	out := new(QueryGetOrderResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetAllCommitmentsRequest proto.InternalMessageInfo
+
+func (m *QueryGetAllCommitmentsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetOrderByExternalID(ctx context.Context, in *QueryGetOrderByExternalIDRequest, opts ...grpc.CallOption) (*QueryGetOrderByExternalIDResponse, error) {
-	out := new(QueryGetOrderByExternalIDResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetOrderByExternalID", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryGetAllCommitmentsResponse is a response message for the GetAllCommitments query.
+type QueryGetAllCommitmentsResponse struct {
+	// commitments is the requested commitment information.
+	Commitments []*Commitment `protobuf:"bytes,1,rep,name=commitments,proto3" json:"commitments,omitempty"`
+	// pagination is the resulting pagination parameters.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) GetMarketOrders(ctx context.Context, in *QueryGetMarketOrdersRequest, opts ...grpc.CallOption) (*QueryGetMarketOrdersResponse, error) {
-	out := new(QueryGetMarketOrdersResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetMarketOrders", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetAllCommitmentsResponse) Reset()         { *m = QueryGetAllCommitmentsResponse{} }
+func (m *QueryGetAllCommitmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAllCommitmentsResponse) ProtoMessage()    {}
+func (*QueryGetAllCommitmentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{51}
+}
+func (m *QueryGetAllCommitmentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAllCommitmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAllCommitmentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetAllCommitmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAllCommitmentsResponse.Merge(m, src)
+}
+func (m *QueryGetAllCommitmentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAllCommitmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAllCommitmentsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetOwnerOrders(ctx context.Context, in *QueryGetOwnerOrdersRequest, opts ...grpc.CallOption) (*QueryGetOwnerOrdersResponse, error) {
-	out := new(QueryGetOwnerOrdersResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetOwnerOrders", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetAllCommitmentsResponse proto.InternalMessageInfo
+
+func (m *QueryGetAllCommitmentsResponse) GetCommitments() []*Commitment {
+	if m != nil {
+		return m.Commitments
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetAssetOrders(ctx context.Context, in *QueryGetAssetOrdersRequest, opts ...grpc.CallOption) (*QueryGetAssetOrdersResponse, error) {
-	out := new(QueryGetAssetOrdersResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetAssetOrders", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetAllCommitmentsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetAllOrders(ctx context.Context, in *QueryGetAllOrdersRequest, opts ...grpc.CallOption) (*QueryGetAllOrdersResponse, error) {
-	out := new(QueryGetAllOrdersResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetAllOrders", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryGetCommitmentRewardPoolRequest is a request message for the GetCommitmentRewardPool query.
+type QueryGetCommitmentRewardPoolRequest struct {
+	// market_id is the numeric identifier of the market with the reward pool.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
 }
 
-func (c *queryClient) GetCommitment(ctx context.Context, in *QueryGetCommitmentRequest, opts ...grpc.CallOption) (*QueryGetCommitmentResponse, error) {
-	out := new(QueryGetCommitmentResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetCommitment", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetCommitmentRewardPoolRequest) Reset()         { *m = QueryGetCommitmentRewardPoolRequest{} }
+func (m *QueryGetCommitmentRewardPoolRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCommitmentRewardPoolRequest) ProtoMessage()    {}
+func (*QueryGetCommitmentRewardPoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{52}
+}
+func (m *QueryGetCommitmentRewardPoolRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetCommitmentRewardPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCommitmentRewardPoolRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetCommitmentRewardPoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCommitmentRewardPoolRequest.Merge(m, src)
+}
+func (m *QueryGetCommitmentRewardPoolRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCommitmentRewardPoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCommitmentRewardPoolRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetAccountCommitments(ctx context.Context, in *QueryGetAccountCommitmentsRequest, opts ...grpc.CallOption) (*QueryGetAccountCommitmentsResponse, error) {
-	out := new(QueryGetAccountCommitmentsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetAccountCommitments", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetCommitmentRewardPoolRequest proto.InternalMessageInfo
+
+func (m *QueryGetCommitmentRewardPoolRequest) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) GetMarketCommitments(ctx context.Context, in *QueryGetMarketCommitmentsRequest, opts ...grpc.CallOption) (*QueryGetMarketCommitmentsResponse, error) {
-	out := new(QueryGetMarketCommitmentsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetMarketCommitments", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryGetCommitmentRewardPoolResponse is a response message for the GetCommitmentRewardPool query.
+type QueryGetCommitmentRewardPoolResponse struct {
+	// amount is the funds in the reward pool that have not yet been distributed.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
-func (c *queryClient) GetAllCommitments(ctx context.Context, in *QueryGetAllCommitmentsRequest, opts ...grpc.CallOption) (*QueryGetAllCommitmentsResponse, error) {
-	out := new(QueryGetAllCommitmentsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetAllCommitments", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetCommitmentRewardPoolResponse) Reset()         { *m = QueryGetCommitmentRewardPoolResponse{} }
+func (m *QueryGetCommitmentRewardPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCommitmentRewardPoolResponse) ProtoMessage()    {}
+func (*QueryGetCommitmentRewardPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{53}
 }
-
-func (c *queryClient) GetMarket(ctx context.Context, in *QueryGetMarketRequest, opts ...grpc.CallOption) (*QueryGetMarketResponse, error) {
-	out := new(QueryGetMarketResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetMarket", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetCommitmentRewardPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) GetAllMarkets(ctx context.Context, in *QueryGetAllMarketsRequest, opts ...grpc.CallOption) (*QueryGetAllMarketsResponse, error) {
-	out := new(QueryGetAllMarketsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetAllMarkets", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetCommitmentRewardPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCommitmentRewardPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetCommitmentRewardPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCommitmentRewardPoolResponse.Merge(m, src)
 }
-
-func (c *queryClient) CommitmentSettlementFeeCalc(ctx context.Context, in *QueryCommitmentSettlementFeeCalcRequest, opts ...grpc.CallOption) (*QueryCommitmentSettlementFeeCalcResponse, error) {
-	out := new(QueryCommitmentSettlementFeeCalcResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/CommitmentSettlementFeeCalc", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetCommitmentRewardPoolResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) ValidateCreateMarket(ctx context.Context, in *QueryValidateCreateMarketRequest, opts ...grpc.CallOption) (*QueryValidateCreateMarketResponse, error) {
-	out := new(QueryValidateCreateMarketResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/ValidateCreateMarket", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetCommitmentRewardPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCommitmentRewardPoolResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ValidateMarket(ctx context.Context, in *QueryValidateMarketRequest, opts ...grpc.CallOption) (*QueryValidateMarketResponse, error) {
-	out := new(QueryValidateMarketResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/ValidateMarket", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_QueryGetCommitmentRewardPoolResponse proto.InternalMessageInfo
 
-func (c *queryClient) ValidateManageFees(ctx context.Context, in *QueryValidateManageFeesRequest, opts ...grpc.CallOption) (*QueryValidateManageFeesResponse, error) {
-	out := new(QueryValidateManageFeesResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/ValidateManageFees", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetCommitmentRewardPoolResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetPayment(ctx context.Context, in *QueryGetPaymentRequest, opts ...grpc.CallOption) (*QueryGetPaymentResponse, error) {
-	out := new(QueryGetPaymentResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetPayment", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryGetAccountCommitmentRewardsRequest is a request message for the GetAccountCommitmentRewards query.
+type QueryGetAccountCommitmentRewardsRequest struct {
+	// account is the bech32 address string of the account with the rewards.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func (c *queryClient) GetPaymentsWithSource(ctx context.Context, in *QueryGetPaymentsWithSourceRequest, opts ...grpc.CallOption) (*QueryGetPaymentsWithSourceResponse, error) {
-	out := new(QueryGetPaymentsWithSourceResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetPaymentsWithSource", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetAccountCommitmentRewardsRequest) Reset() {
+	*m = QueryGetAccountCommitmentRewardsRequest{}
 }
-
-func (c *queryClient) GetPaymentsWithTarget(ctx context.Context, in *QueryGetPaymentsWithTargetRequest, opts ...grpc.CallOption) (*QueryGetPaymentsWithTargetResponse, error) {
-	out := new(QueryGetPaymentsWithTargetResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetPaymentsWithTarget", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetAccountCommitmentRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAccountCommitmentRewardsRequest) ProtoMessage()    {}
+func (*QueryGetAccountCommitmentRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{54}
 }
-
-func (c *queryClient) GetAllPayments(ctx context.Context, in *QueryGetAllPaymentsRequest, opts ...grpc.CallOption) (*QueryGetAllPaymentsResponse, error) {
-	out := new(QueryGetAllPaymentsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetAllPayments", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetAccountCommitmentRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) PaymentFeeCalc(ctx context.Context, in *QueryPaymentFeeCalcRequest, opts ...grpc.CallOption) (*QueryPaymentFeeCalcResponse, error) {
-	out := new(QueryPaymentFeeCalcResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/PaymentFeeCalc", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetAccountCommitmentRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAccountCommitmentRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetAccountCommitmentRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAccountCommitmentRewardsRequest.Merge(m, src)
+}
+func (m *QueryGetAccountCommitmentRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAccountCommitmentRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAccountCommitmentRewardsRequest.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// OrderFeeCalc calculates the fees that will be associated with the provided order.
-	OrderFeeCalc(context.Context, *QueryOrderFeeCalcRequest) (*QueryOrderFeeCalcResponse, error)
-	// GetOrder looks up an order by id.
-	GetOrder(context.Context, *QueryGetOrderRequest) (*QueryGetOrderResponse, error)
-	// GetOrderByExternalID looks up an order by market id and external id.
-	GetOrderByExternalID(context.Context, *QueryGetOrderByExternalIDRequest) (*QueryGetOrderByExternalIDResponse, error)
-	// GetMarketOrders looks up the orders in a market.
-	GetMarketOrders(context.Context, *QueryGetMarketOrdersRequest) (*QueryGetMarketOrdersResponse, error)
-	// GetOwnerOrders looks up the orders from the provided owner address.
-	GetOwnerOrders(context.Context, *QueryGetOwnerOrdersRequest) (*QueryGetOwnerOrdersResponse, error)
-	// GetAssetOrders looks up the orders for a specific asset denom.
-	GetAssetOrders(context.Context, *QueryGetAssetOrdersRequest) (*QueryGetAssetOrdersResponse, error)
-	// GetAllOrders gets all orders in the exchange module.
-	GetAllOrders(context.Context, *QueryGetAllOrdersRequest) (*QueryGetAllOrdersResponse, error)
-	// GetCommitment gets the funds in an account that are committed to the market.
-	GetCommitment(context.Context, *QueryGetCommitmentRequest) (*QueryGetCommitmentResponse, error)
-	// GetAccountCommitments gets all the funds in an account that are committed to any market.
-	// Optionally, you can filter the results for a specific denomination using the `denom` query parameter.
-	GetAccountCommitments(context.Context, *QueryGetAccountCommitmentsRequest) (*QueryGetAccountCommitmentsResponse, error)
-	// GetMarketCommitments gets all the funds committed to a market from any account.
-	GetMarketCommitments(context.Context, *QueryGetMarketCommitmentsRequest) (*QueryGetMarketCommitmentsResponse, error)
-	// GetAllCommitments gets all fund committed to any market from any account.
-	GetAllCommitments(context.Context, *QueryGetAllCommitmentsRequest) (*QueryGetAllCommitmentsResponse, error)
-	// GetMarket returns all the information and details about a market.
-	GetMarket(context.Context, *QueryGetMarketRequest) (*QueryGetMarketResponse, error)
-	// GetAllMarkets returns brief information about each market.
-	GetAllMarkets(context.Context, *QueryGetAllMarketsRequest) (*QueryGetAllMarketsResponse, error)
-	// Params returns the exchange module parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// CommitmentSettlementFeeCalc calculates the fees a market will pay for a commitment settlement using current NAVs.
-	CommitmentSettlementFeeCalc(context.Context, *QueryCommitmentSettlementFeeCalcRequest) (*QueryCommitmentSettlementFeeCalcResponse, error)
-	// ValidateCreateMarket checks the provided MsgGovCreateMarketResponse and returns any errors it might have.
-	ValidateCreateMarket(context.Context, *QueryValidateCreateMarketRequest) (*QueryValidateCreateMarketResponse, error)
-	// ValidateMarket checks for any problems with a market's setup.
-	ValidateMarket(context.Context, *QueryValidateMarketRequest) (*QueryValidateMarketResponse, error)
-	// ValidateManageFees checks the provided MsgGovManageFeesRequest and returns any errors that it might have.
-	ValidateManageFees(context.Context, *QueryValidateManageFeesRequest) (*QueryValidateManageFeesResponse, error)
-	// GetPayment gets a single specific payment.
-	GetPayment(context.Context, *QueryGetPaymentRequest) (*QueryGetPaymentResponse, error)
-	// GetPaymentsWithSource gets all payments with a specific source account.
-	GetPaymentsWithSource(context.Context, *QueryGetPaymentsWithSourceRequest) (*QueryGetPaymentsWithSourceResponse, error)
-	// GetPaymentsWithTarget gets all payments with a specific target account.
-	GetPaymentsWithTarget(context.Context, *QueryGetPaymentsWithTargetRequest) (*QueryGetPaymentsWithTargetResponse, error)
-	// GetAllPayments gets all payments.
-	GetAllPayments(context.Context, *QueryGetAllPaymentsRequest) (*QueryGetAllPaymentsResponse, error)
-	// PaymentFeeCalc calculates the fees that must be paid for creating or accepting a specific payment.
-	PaymentFeeCalc(context.Context, *QueryPaymentFeeCalcRequest) (*QueryPaymentFeeCalcResponse, error)
+var xxx_messageInfo_QueryGetAccountCommitmentRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryGetAccountCommitmentRewardsRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryGetAccountCommitmentRewardsResponse is a response message for the GetAccountCommitmentRewards query.
+type QueryGetAccountCommitmentRewardsResponse struct {
+	// rewards is the amounts the account can claim from each market.
+	Rewards []*MarketAmount `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
 }
 
-func (*UnimplementedQueryServer) OrderFeeCalc(ctx context.Context, req *QueryOrderFeeCalcRequest) (*QueryOrderFeeCalcResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OrderFeeCalc not implemented")
+func (m *QueryGetAccountCommitmentRewardsResponse) Reset() {
+	*m = QueryGetAccountCommitmentRewardsResponse{}
 }
-func (*UnimplementedQueryServer) GetOrder(ctx context.Context, req *QueryGetOrderRequest) (*QueryGetOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
+func (m *QueryGetAccountCommitmentRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAccountCommitmentRewardsResponse) ProtoMessage()    {}
+func (*QueryGetAccountCommitmentRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{55}
 }
-func (*UnimplementedQueryServer) GetOrderByExternalID(ctx context.Context, req *QueryGetOrderByExternalIDRequest) (*QueryGetOrderByExternalIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrderByExternalID not implemented")
+func (m *QueryGetAccountCommitmentRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetMarketOrders(ctx context.Context, req *QueryGetMarketOrdersRequest) (*QueryGetMarketOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMarketOrders not implemented")
+func (m *QueryGetAccountCommitmentRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAccountCommitmentRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetOwnerOrders(ctx context.Context, req *QueryGetOwnerOrdersRequest) (*QueryGetOwnerOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOwnerOrders not implemented")
+func (m *QueryGetAccountCommitmentRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAccountCommitmentRewardsResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GetAssetOrders(ctx context.Context, req *QueryGetAssetOrdersRequest) (*QueryGetAssetOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAssetOrders not implemented")
+func (m *QueryGetAccountCommitmentRewardsResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) GetAllOrders(ctx context.Context, req *QueryGetAllOrdersRequest) (*QueryGetAllOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllOrders not implemented")
+func (m *QueryGetAccountCommitmentRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAccountCommitmentRewardsResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) GetCommitment(ctx context.Context, req *QueryGetCommitmentRequest) (*QueryGetCommitmentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCommitment not implemented")
+
+var xxx_messageInfo_QueryGetAccountCommitmentRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryGetAccountCommitmentRewardsResponse) GetRewards() []*MarketAmount {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) GetAccountCommitments(ctx context.Context, req *QueryGetAccountCommitmentsRequest) (*QueryGetAccountCommitmentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAccountCommitments not implemented")
+
+// QueryGetMarketRequest is a request message for the GetMarket query.
+type QueryGetMarketRequest struct {
+	// market_id is the id of the market to look up.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
 }
-func (*UnimplementedQueryServer) GetMarketCommitments(ctx context.Context, req *QueryGetMarketCommitmentsRequest) (*QueryGetMarketCommitmentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMarketCommitments not implemented")
+
+func (m *QueryGetMarketRequest) Reset()         { *m = QueryGetMarketRequest{} }
+func (m *QueryGetMarketRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMarketRequest) ProtoMessage()    {}
+func (*QueryGetMarketRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{56}
 }
-func (*UnimplementedQueryServer) GetAllCommitments(ctx context.Context, req *QueryGetAllCommitmentsRequest) (*QueryGetAllCommitmentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllCommitments not implemented")
+func (m *QueryGetMarketRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetMarket(ctx context.Context, req *QueryGetMarketRequest) (*QueryGetMarketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMarket not implemented")
+func (m *QueryGetMarketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetMarketRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetAllMarkets(ctx context.Context, req *QueryGetAllMarketsRequest) (*QueryGetAllMarketsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllMarkets not implemented")
+func (m *QueryGetMarketRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMarketRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryGetMarketRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) CommitmentSettlementFeeCalc(ctx context.Context, req *QueryCommitmentSettlementFeeCalcRequest) (*QueryCommitmentSettlementFeeCalcResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CommitmentSettlementFeeCalc not implemented")
+func (m *QueryGetMarketRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMarketRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) ValidateCreateMarket(ctx context.Context, req *QueryValidateCreateMarketRequest) (*QueryValidateCreateMarketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidateCreateMarket not implemented")
+
+var xxx_messageInfo_QueryGetMarketRequest proto.InternalMessageInfo
+
+func (m *QueryGetMarketRequest) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) ValidateMarket(ctx context.Context, req *QueryValidateMarketRequest) (*QueryValidateMarketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidateMarket not implemented")
+
+// QueryGetMarketResponse is a response message for the GetMarket query.
+type QueryGetMarketResponse struct {
+	// address is the bech32 address string of this market's account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// market is all information and details of the market.
+	Market *Market `protobuf:"bytes,2,opt,name=market,proto3" json:"market,omitempty"`
 }
-func (*UnimplementedQueryServer) ValidateManageFees(ctx context.Context, req *QueryValidateManageFeesRequest) (*QueryValidateManageFeesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidateManageFees not implemented")
+
+func (m *QueryGetMarketResponse) Reset()         { *m = QueryGetMarketResponse{} }
+func (m *QueryGetMarketResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMarketResponse) ProtoMessage()    {}
+func (*QueryGetMarketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{57}
 }
-func (*UnimplementedQueryServer) GetPayment(ctx context.Context, req *QueryGetPaymentRequest) (*QueryGetPaymentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPayment not implemented")
+func (m *QueryGetMarketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetPaymentsWithSource(ctx context.Context, req *QueryGetPaymentsWithSourceRequest) (*QueryGetPaymentsWithSourceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPaymentsWithSource not implemented")
+func (m *QueryGetMarketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetMarketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetPaymentsWithTarget(ctx context.Context, req *QueryGetPaymentsWithTargetRequest) (*QueryGetPaymentsWithTargetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPaymentsWithTarget not implemented")
+func (m *QueryGetMarketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMarketResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GetAllPayments(ctx context.Context, req *QueryGetAllPaymentsRequest) (*QueryGetAllPaymentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllPayments not implemented")
+func (m *QueryGetMarketResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) PaymentFeeCalc(ctx context.Context, req *QueryPaymentFeeCalcRequest) (*QueryPaymentFeeCalcResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PaymentFeeCalc not implemented")
+func (m *QueryGetMarketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMarketResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryGetMarketResponse proto.InternalMessageInfo
 
-func _Query_OrderFeeCalc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOrderFeeCalcRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OrderFeeCalc(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/OrderFeeCalc",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OrderFeeCalc(ctx, req.(*QueryOrderFeeCalcRequest))
+func (m *QueryGetMarketResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetOrderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/GetOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetOrder(ctx, req.(*QueryGetOrderRequest))
+func (m *QueryGetMarketResponse) GetMarket() *Market {
+	if m != nil {
+		return m.Market
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetOrderByExternalID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetOrderByExternalIDRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetOrderByExternalID(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/GetOrderByExternalID",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetOrderByExternalID(ctx, req.(*QueryGetOrderByExternalIDRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryGetScheduledFeeChangesRequest is a request message for the GetScheduledFeeChanges query.
+type QueryGetScheduledFeeChangesRequest struct {
+	// market_id is the numeric identifier of the market to look up.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
 }
 
-func _Query_GetMarketOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetMarketOrdersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetMarketOrders(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/GetMarketOrders",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetMarketOrders(ctx, req.(*QueryGetMarketOrdersRequest))
+func (m *QueryGetScheduledFeeChangesRequest) Reset()         { *m = QueryGetScheduledFeeChangesRequest{} }
+func (m *QueryGetScheduledFeeChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetScheduledFeeChangesRequest) ProtoMessage()    {}
+func (*QueryGetScheduledFeeChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{58}
+}
+func (m *QueryGetScheduledFeeChangesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetScheduledFeeChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetScheduledFeeChangesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetScheduledFeeChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetScheduledFeeChangesRequest.Merge(m, src)
+}
+func (m *QueryGetScheduledFeeChangesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetScheduledFeeChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetScheduledFeeChangesRequest.DiscardUnknown(m)
 }
 
-func _Query_GetOwnerOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetOwnerOrdersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetOwnerOrders(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/GetOwnerOrders",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetOwnerOrders(ctx, req.(*QueryGetOwnerOrdersRequest))
+var xxx_messageInfo_QueryGetScheduledFeeChangesRequest proto.InternalMessageInfo
+
+func (m *QueryGetScheduledFeeChangesRequest) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetAssetOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetAssetOrdersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetAssetOrders(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/GetAssetOrders",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetAssetOrders(ctx, req.(*QueryGetAssetOrdersRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryGetScheduledFeeChangesResponse is a response message for the GetScheduledFeeChanges query.
+type QueryGetScheduledFeeChangesResponse struct {
+	// scheduled_fee_changes are the market's pending fee changes in the order they will be applied.
+	ScheduledFeeChanges []MsgGovManageFeesRequest `protobuf:"bytes,1,rep,name=scheduled_fee_changes,json=scheduledFeeChanges,proto3" json:"scheduled_fee_changes"`
 }
 
-func _Query_GetAllOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetAllOrdersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetAllOrders(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/GetAllOrders",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetAllOrders(ctx, req.(*QueryGetAllOrdersRequest))
+func (m *QueryGetScheduledFeeChangesResponse) Reset()         { *m = QueryGetScheduledFeeChangesResponse{} }
+func (m *QueryGetScheduledFeeChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetScheduledFeeChangesResponse) ProtoMessage()    {}
+func (*QueryGetScheduledFeeChangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{59}
+}
+func (m *QueryGetScheduledFeeChangesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetScheduledFeeChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetScheduledFeeChangesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetScheduledFeeChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetScheduledFeeChangesResponse.Merge(m, src)
+}
+func (m *QueryGetScheduledFeeChangesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetScheduledFeeChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetScheduledFeeChangesResponse.DiscardUnknown(m)
 }
 
-func _Query_GetCommitment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetCommitmentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetCommitment(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/GetCommitment",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetCommitment(ctx, req.(*QueryGetCommitmentRequest))
+var xxx_messageInfo_QueryGetScheduledFeeChangesResponse proto.InternalMessageInfo
+
+func (m *QueryGetScheduledFeeChangesResponse) GetScheduledFeeChanges() []MsgGovManageFeesRequest {
+	if m != nil {
+		return m.ScheduledFeeChanges
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetAccountCommitments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetAccountCommitmentsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetAccountCommitments(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/GetAccountCommitments",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetAccountCommitments(ctx, req.(*QueryGetAccountCommitmentsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryGetMarketAuthorityTransferRequest is a request message for the GetMarketAuthorityTransfer query.
+type QueryGetMarketAuthorityTransferRequest struct {
+	// market_id is the numeric identifier of the market to look up.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
 }
 
-func _Query_GetMarketCommitments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetMarketCommitmentsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetMarketCommitments(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/GetMarketCommitments",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetMarketCommitments(ctx, req.(*QueryGetMarketCommitmentsRequest))
+func (m *QueryGetMarketAuthorityTransferRequest) Reset() {
+	*m = QueryGetMarketAuthorityTransferRequest{}
+}
+func (m *QueryGetMarketAuthorityTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMarketAuthorityTransferRequest) ProtoMessage()    {}
+func (*QueryGetMarketAuthorityTransferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{60}
+}
+func (m *QueryGetMarketAuthorityTransferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetMarketAuthorityTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetMarketAuthorityTransferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetMarketAuthorityTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMarketAuthorityTransferRequest.Merge(m, src)
+}
+func (m *QueryGetMarketAuthorityTransferRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetMarketAuthorityTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMarketAuthorityTransferRequest.DiscardUnknown(m)
 }
 
-func _Query_GetAllCommitments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetAllCommitmentsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetAllCommitments(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/GetAllCommitments",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetAllCommitments(ctx, req.(*QueryGetAllCommitmentsRequest))
+var xxx_messageInfo_QueryGetMarketAuthorityTransferRequest proto.InternalMessageInfo
+
+func (m *QueryGetMarketAuthorityTransferRequest) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetMarketRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetMarket(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/GetMarket",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetMarket(ctx, req.(*QueryGetMarketRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryGetMarketAuthorityTransferResponse is a response message for the GetMarketAuthorityTransfer query.
+type QueryGetMarketAuthorityTransferResponse struct {
+	// authority_transfer is the market's pending authority transfer.
+	AuthorityTransfer *MarketAuthorityTransfer `protobuf:"bytes,1,opt,name=authority_transfer,json=authorityTransfer,proto3" json:"authority_transfer,omitempty"`
 }
 
-func _Query_GetAllMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetAllMarketsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetAllMarkets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/GetAllMarkets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetAllMarkets(ctx, req.(*QueryGetAllMarketsRequest))
+func (m *QueryGetMarketAuthorityTransferResponse) Reset() {
+	*m = QueryGetMarketAuthorityTransferResponse{}
+}
+func (m *QueryGetMarketAuthorityTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMarketAuthorityTransferResponse) ProtoMessage()    {}
+func (*QueryGetMarketAuthorityTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{61}
+}
+func (m *QueryGetMarketAuthorityTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetMarketAuthorityTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetMarketAuthorityTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetMarketAuthorityTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMarketAuthorityTransferResponse.Merge(m, src)
+}
+func (m *QueryGetMarketAuthorityTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetMarketAuthorityTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMarketAuthorityTransferResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryGetMarketAuthorityTransferResponse proto.InternalMessageInfo
+
+func (m *QueryGetMarketAuthorityTransferResponse) GetAuthorityTransfer() *MarketAuthorityTransfer {
+	if m != nil {
+		return m.AuthorityTransfer
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_CommitmentSettlementFeeCalc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCommitmentSettlementFeeCalcRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CommitmentSettlementFeeCalc(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/CommitmentSettlementFeeCalc",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CommitmentSettlementFeeCalc(ctx, req.(*QueryCommitmentSettlementFeeCalcRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryExportMarketRequest is a request message for the ExportMarket query.
+type QueryExportMarketRequest struct {
+	// market_id is the numeric identifier of the market to export.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
 }
 
-func _Query_ValidateCreateMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidateCreateMarketRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValidateCreateMarket(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/ValidateCreateMarket",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValidateCreateMarket(ctx, req.(*QueryValidateCreateMarketRequest))
+func (m *QueryExportMarketRequest) Reset()         { *m = QueryExportMarketRequest{} }
+func (m *QueryExportMarketRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExportMarketRequest) ProtoMessage()    {}
+func (*QueryExportMarketRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{62}
+}
+func (m *QueryExportMarketRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExportMarketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExportMarketRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryExportMarketRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExportMarketRequest.Merge(m, src)
+}
+func (m *QueryExportMarketRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExportMarketRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExportMarketRequest.DiscardUnknown(m)
 }
 
-func _Query_ValidateMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidateMarketRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValidateMarket(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/ValidateMarket",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValidateMarket(ctx, req.(*QueryValidateMarketRequest))
+var xxx_messageInfo_QueryExportMarketRequest proto.InternalMessageInfo
+
+func (m *QueryExportMarketRequest) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_ValidateManageFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidateManageFeesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValidateManageFees(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/ValidateManageFees",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValidateManageFees(ctx, req.(*QueryValidateManageFeesRequest))
+// QueryExportMarketResponse is a response message for the ExportMarket query.
+type QueryExportMarketResponse struct {
+	// height is the block height of the state that this snapshot was taken from.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// address is the bech32 address string of this market's account.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// market is all information and details of the market, including its fees.
+	Market *Market `protobuf:"bytes,3,opt,name=market,proto3" json:"market,omitempty"`
+	// scheduled_fee_changes are the market's pending fee changes in the order they will be applied.
+	ScheduledFeeChanges []MsgGovManageFeesRequest `protobuf:"bytes,4,rep,name=scheduled_fee_changes,json=scheduledFeeChanges,proto3" json:"scheduled_fee_changes"`
+	// orders are all of the market's orders, ordered by order id.
+	Orders []*Order `protobuf:"bytes,5,rep,name=orders,proto3" json:"orders,omitempty"`
+	// commitments are all of the funds committed to the market, ordered by account.
+	Commitments []*AccountAmount `protobuf:"bytes,6,rep,name=commitments,proto3" json:"commitments,omitempty"`
+}
+
+func (m *QueryExportMarketResponse) Reset()         { *m = QueryExportMarketResponse{} }
+func (m *QueryExportMarketResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExportMarketResponse) ProtoMessage()    {}
+func (*QueryExportMarketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{63}
+}
+func (m *QueryExportMarketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExportMarketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExportMarketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryExportMarketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExportMarketResponse.Merge(m, src)
+}
+func (m *QueryExportMarketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExportMarketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExportMarketResponse.DiscardUnknown(m)
 }
 
-func _Query_GetPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetPaymentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetPayment(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/GetPayment",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetPayment(ctx, req.(*QueryGetPaymentRequest))
+var xxx_messageInfo_QueryExportMarketResponse proto.InternalMessageInfo
+
+func (m *QueryExportMarketResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetPaymentsWithSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetPaymentsWithSourceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetPaymentsWithSource(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/GetPaymentsWithSource",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetPaymentsWithSource(ctx, req.(*QueryGetPaymentsWithSourceRequest))
+func (m *QueryExportMarketResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetPaymentsWithTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetPaymentsWithTargetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetPaymentsWithTarget(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/GetPaymentsWithTarget",
+func (m *QueryExportMarketResponse) GetMarket() *Market {
+	if m != nil {
+		return m.Market
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetPaymentsWithTarget(ctx, req.(*QueryGetPaymentsWithTargetRequest))
+	return nil
+}
+
+func (m *QueryExportMarketResponse) GetScheduledFeeChanges() []MsgGovManageFeesRequest {
+	if m != nil {
+		return m.ScheduledFeeChanges
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetAllPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetAllPaymentsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetAllPayments(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/GetAllPayments",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetAllPayments(ctx, req.(*QueryGetAllPaymentsRequest))
+func (m *QueryExportMarketResponse) GetOrders() []*Order {
+	if m != nil {
+		return m.Orders
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_PaymentFeeCalc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPaymentFeeCalcRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PaymentFeeCalc(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/PaymentFeeCalc",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PaymentFeeCalc(ctx, req.(*QueryPaymentFeeCalcRequest))
+func (m *QueryExportMarketResponse) GetCommitments() []*AccountAmount {
+	if m != nil {
+		return m.Commitments
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "provenance.exchange.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "OrderFeeCalc",
-			Handler:    _Query_OrderFeeCalc_Handler,
-		},
-		{
-			MethodName: "GetOrder",
-			Handler:    _Query_GetOrder_Handler,
-		},
-		{
-			MethodName: "GetOrderByExternalID",
-			Handler:    _Query_GetOrderByExternalID_Handler,
-		},
-		{
-			MethodName: "GetMarketOrders",
-			Handler:    _Query_GetMarketOrders_Handler,
-		},
-		{
-			MethodName: "GetOwnerOrders",
-			Handler:    _Query_GetOwnerOrders_Handler,
-		},
-		{
-			MethodName: "GetAssetOrders",
-			Handler:    _Query_GetAssetOrders_Handler,
-		},
-		{
-			MethodName: "GetAllOrders",
-			Handler:    _Query_GetAllOrders_Handler,
-		},
-		{
-			MethodName: "GetCommitment",
-			Handler:    _Query_GetCommitment_Handler,
-		},
-		{
-			MethodName: "GetAccountCommitments",
-			Handler:    _Query_GetAccountCommitments_Handler,
-		},
-		{
-			MethodName: "GetMarketCommitments",
-			Handler:    _Query_GetMarketCommitments_Handler,
-		},
-		{
-			MethodName: "GetAllCommitments",
-			Handler:    _Query_GetAllCommitments_Handler,
-		},
-		{
-			MethodName: "GetMarket",
-			Handler:    _Query_GetMarket_Handler,
-		},
-		{
-			MethodName: "GetAllMarkets",
-			Handler:    _Query_GetAllMarkets_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "CommitmentSettlementFeeCalc",
-			Handler:    _Query_CommitmentSettlementFeeCalc_Handler,
-		},
-		{
-			MethodName: "ValidateCreateMarket",
-			Handler:    _Query_ValidateCreateMarket_Handler,
-		},
-		{
-			MethodName: "ValidateMarket",
-			Handler:    _Query_ValidateMarket_Handler,
-		},
-		{
-			MethodName: "ValidateManageFees",
-			Handler:    _Query_ValidateManageFees_Handler,
-		},
-		{
-			MethodName: "GetPayment",
-			Handler:    _Query_GetPayment_Handler,
-		},
-		{
-			MethodName: "GetPaymentsWithSource",
-			Handler:    _Query_GetPaymentsWithSource_Handler,
-		},
-		{
-			MethodName: "GetPaymentsWithTarget",
-			Handler:    _Query_GetPaymentsWithTarget_Handler,
-		},
-		{
-			MethodName: "GetAllPayments",
-			Handler:    _Query_GetAllPayments_Handler,
-		},
-		{
-			MethodName: "PaymentFeeCalc",
-			Handler:    _Query_PaymentFeeCalc_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "provenance/exchange/v1/query.proto",
+// QueryGetAllMarketsRequest is a request message for the GetAllMarkets query.
+type QueryGetAllMarketsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryOrderFeeCalcRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetAllMarketsRequest) Reset()         { *m = QueryGetAllMarketsRequest{} }
+func (m *QueryGetAllMarketsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAllMarketsRequest) ProtoMessage()    {}
+func (*QueryGetAllMarketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{64}
 }
-
-func (m *QueryOrderFeeCalcRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetAllMarketsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryOrderFeeCalcRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BidOrder != nil {
-		{
-			size, err := m.BidOrder.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.AskOrder != nil {
-		{
-			size, err := m.AskOrder.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryGetAllMarketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAllMarketsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetAllMarketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAllMarketsRequest.Merge(m, src)
+}
+func (m *QueryGetAllMarketsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAllMarketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAllMarketsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryOrderFeeCalcResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetAllMarketsRequest proto.InternalMessageInfo
+
+func (m *QueryGetAllMarketsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryOrderFeeCalcResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetAllMarketsResponse is a response message for the GetAllMarkets query.
+type QueryGetAllMarketsResponse struct {
+	// markets are a page of the briefs for all markets.
+	Markets []*MarketBrief `protobuf:"bytes,1,rep,name=markets,proto3" json:"markets,omitempty"`
+	// pagination is the resulting pagination parameters.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryOrderFeeCalcResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SettlementRatioFeeOptions) > 0 {
-		for iNdEx := len(m.SettlementRatioFeeOptions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SettlementRatioFeeOptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.SettlementFlatFeeOptions) > 0 {
-		for iNdEx := len(m.SettlementFlatFeeOptions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SettlementFlatFeeOptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.CreationFeeOptions) > 0 {
-		for iNdEx := len(m.CreationFeeOptions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CreationFeeOptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryGetAllMarketsResponse) Reset()         { *m = QueryGetAllMarketsResponse{} }
+func (m *QueryGetAllMarketsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAllMarketsResponse) ProtoMessage()    {}
+func (*QueryGetAllMarketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{65}
+}
+func (m *QueryGetAllMarketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAllMarketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAllMarketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetOrderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetAllMarketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAllMarketsResponse.Merge(m, src)
 }
-
-func (m *QueryGetOrderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetAllMarketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAllMarketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAllMarketsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OrderId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.OrderId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryGetAllMarketsResponse proto.InternalMessageInfo
+
+func (m *QueryGetAllMarketsResponse) GetMarkets() []*MarketBrief {
+	if m != nil {
+		return m.Markets
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetAllMarketsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryParamsRequest is a request message for the Params query.
+type QueryParamsRequest struct {
 }
 
-func (m *QueryGetOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Order != nil {
-		{
-			size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{66}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetOrderByExternalIDRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
 }
-
-func (m *QueryGetOrderByExternalIDRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryGetOrderByExternalIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExternalId) > 0 {
-		i -= len(m.ExternalId)
-		copy(dAtA[i:], m.ExternalId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExternalId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetOrderByExternalIDResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
 
-func (m *QueryGetOrderByExternalIDResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryParamsResponse is a response message for the Params query.
+type QueryParamsResponse struct {
+	// params are the exchange module parameter values.
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
 }
 
-func (m *QueryGetOrderByExternalIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Order != nil {
-		{
-			size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{67}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetMarketOrdersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() *Params {
+	if m != nil {
+		return m.Params
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetMarketOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCommitmentSettlementFeeCalcRequest is a request message for the CommitmentSettlementFeeCalc query.
+type QueryCommitmentSettlementFeeCalcRequest struct {
+	// settlement is a market's commitment settlement request message.
+	// If no inputs are provided, only the to_fee_nav field will be populated in the response.
+	Settlement *MsgMarketCommitmentSettleRequest `protobuf:"bytes,1,opt,name=settlement,proto3" json:"settlement,omitempty"`
+	// include_breakdown_fields controls the fields that are populated in the response.
+	// If false, only the exchange_fees field is populated.
+	// If true, all of the fields are populated as possible.
+	// If the settlement does not have any inputs, this field defaults to true.
+	IncludeBreakdownFields bool `protobuf:"varint,2,opt,name=include_breakdown_fields,json=includeBreakdownFields,proto3" json:"include_breakdown_fields,omitempty"`
 }
 
-func (m *QueryGetMarketOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
-	}
-	if m.AfterOrderId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AfterOrderId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.OrderType) > 0 {
-		i -= len(m.OrderType)
-		copy(dAtA[i:], m.OrderType)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrderType)))
-		i--
-		dAtA[i] = 0x12
+func (m *QueryCommitmentSettlementFeeCalcRequest) Reset() {
+	*m = QueryCommitmentSettlementFeeCalcRequest{}
+}
+func (m *QueryCommitmentSettlementFeeCalcRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCommitmentSettlementFeeCalcRequest) ProtoMessage()    {}
+func (*QueryCommitmentSettlementFeeCalcRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{68}
+}
+func (m *QueryCommitmentSettlementFeeCalcRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommitmentSettlementFeeCalcRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommitmentSettlementFeeCalcRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.MarketId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *QueryCommitmentSettlementFeeCalcRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommitmentSettlementFeeCalcRequest.Merge(m, src)
+}
+func (m *QueryCommitmentSettlementFeeCalcRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommitmentSettlementFeeCalcRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommitmentSettlementFeeCalcRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCommitmentSettlementFeeCalcRequest proto.InternalMessageInfo
+
+func (m *QueryCommitmentSettlementFeeCalcRequest) GetSettlement() *MsgMarketCommitmentSettleRequest {
+	if m != nil {
+		return m.Settlement
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetMarketOrdersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCommitmentSettlementFeeCalcRequest) GetIncludeBreakdownFields() bool {
+	if m != nil {
+		return m.IncludeBreakdownFields
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryGetMarketOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCommitmentSettlementFeeCalcResponse is a response message for the CommitmentSettlementFeeCalc query.
+type QueryCommitmentSettlementFeeCalcResponse struct {
+	// exchange_fees is the total that the exchange would currently pay for the provided settlement.
+	ExchangeFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=exchange_fees,json=exchangeFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"exchange_fees"`
+	// input_total is the sum of all the inputs in the provided settlement.
+	InputTotal github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=input_total,json=inputTotal,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"input_total"`
+	// converted_total is the input_total converted to a single intermediary denom or left as the fee denom.
+	ConvertedTotal github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=converted_total,json=convertedTotal,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"converted_total"`
+	// conversion_navs are the NAVs used to convert the input_total to the converted_total.
+	ConversionNavs []NetAssetPrice `protobuf:"bytes,4,rep,name=conversion_navs,json=conversionNavs,proto3" json:"conversion_navs"`
+	// to_fee_nav is the NAV used to convert the converted_total into the fee denom.
+	ToFeeNav *NetAssetPrice `protobuf:"bytes,5,opt,name=to_fee_nav,json=toFeeNav,proto3" json:"to_fee_nav,omitempty"`
 }
 
-func (m *QueryGetMarketOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
-	}
-	if len(m.Orders) > 0 {
-		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryCommitmentSettlementFeeCalcResponse) Reset() {
+	*m = QueryCommitmentSettlementFeeCalcResponse{}
+}
+func (m *QueryCommitmentSettlementFeeCalcResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCommitmentSettlementFeeCalcResponse) ProtoMessage()    {}
+func (*QueryCommitmentSettlementFeeCalcResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{69}
+}
+func (m *QueryCommitmentSettlementFeeCalcResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommitmentSettlementFeeCalcResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommitmentSettlementFeeCalcResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCommitmentSettlementFeeCalcResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommitmentSettlementFeeCalcResponse.Merge(m, src)
+}
+func (m *QueryCommitmentSettlementFeeCalcResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommitmentSettlementFeeCalcResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommitmentSettlementFeeCalcResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetOwnerOrdersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCommitmentSettlementFeeCalcResponse proto.InternalMessageInfo
+
+func (m *QueryCommitmentSettlementFeeCalcResponse) GetExchangeFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ExchangeFees
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetOwnerOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCommitmentSettlementFeeCalcResponse) GetInputTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.InputTotal
+	}
+	return nil
 }
 
-func (m *QueryGetOwnerOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
-	}
-	if m.AfterOrderId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AfterOrderId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.OrderType) > 0 {
-		i -= len(m.OrderType)
-		copy(dAtA[i:], m.OrderType)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrderType)))
-		i--
-		dAtA[i] = 0x12
+func (m *QueryCommitmentSettlementFeeCalcResponse) GetConvertedTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ConvertedTotal
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *QueryCommitmentSettlementFeeCalcResponse) GetConversionNavs() []NetAssetPrice {
+	if m != nil {
+		return m.ConversionNavs
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetOwnerOrdersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCommitmentSettlementFeeCalcResponse) GetToFeeNav() *NetAssetPrice {
+	if m != nil {
+		return m.ToFeeNav
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetOwnerOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryValidateCreateMarketRequest is a request message for the ValidateCreateMarket query.
+type QueryValidateCreateMarketRequest struct {
+	// create_market_request is the request to run validation on.
+	CreateMarketRequest *MsgGovCreateMarketRequest `protobuf:"bytes,1,opt,name=create_market_request,json=createMarketRequest,proto3" json:"create_market_request,omitempty"`
 }
 
-func (m *QueryGetOwnerOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
-	}
-	if len(m.Orders) > 0 {
-		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryValidateCreateMarketRequest) Reset()         { *m = QueryValidateCreateMarketRequest{} }
+func (m *QueryValidateCreateMarketRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidateCreateMarketRequest) ProtoMessage()    {}
+func (*QueryValidateCreateMarketRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{70}
+}
+func (m *QueryValidateCreateMarketRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidateCreateMarketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidateCreateMarketRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryValidateCreateMarketRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidateCreateMarketRequest.Merge(m, src)
+}
+func (m *QueryValidateCreateMarketRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidateCreateMarketRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidateCreateMarketRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetAssetOrdersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidateCreateMarketRequest proto.InternalMessageInfo
+
+func (m *QueryValidateCreateMarketRequest) GetCreateMarketRequest() *MsgGovCreateMarketRequest {
+	if m != nil {
+		return m.CreateMarketRequest
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetAssetOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryValidateCreateMarketResponse is a response message for the ValidateCreateMarket query.
+type QueryValidateCreateMarketResponse struct {
+	// error is any problems or inconsistencies in the provided gov prop msg.
+	// This goes above and beyond the validation done when actually processing the governance proposal.
+	// If an error is returned, and gov_prop_will_pass is true, it means the error is more of an
+	// inconsistency that might cause certain aspects of the market to behave unexpectedly.
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	// gov_prop_will_pass will be true if the the provided msg will be successfully processed at the end of it's voting
+	// period (assuming it passes).
+	GovPropWillPass bool `protobuf:"varint,2,opt,name=gov_prop_will_pass,json=govPropWillPass,proto3" json:"gov_prop_will_pass,omitempty"`
 }
 
-func (m *QueryGetAssetOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryValidateCreateMarketResponse) Reset()         { *m = QueryValidateCreateMarketResponse{} }
+func (m *QueryValidateCreateMarketResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidateCreateMarketResponse) ProtoMessage()    {}
+func (*QueryValidateCreateMarketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{71}
+}
+func (m *QueryValidateCreateMarketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidateCreateMarketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidateCreateMarketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
-	}
-	if m.AfterOrderId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AfterOrderId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.OrderType) > 0 {
-		i -= len(m.OrderType)
-		copy(dAtA[i:], m.OrderType)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrderType)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Asset) > 0 {
-		i -= len(m.Asset)
-		copy(dAtA[i:], m.Asset)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Asset)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetAssetOrdersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryValidateCreateMarketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidateCreateMarketResponse.Merge(m, src)
 }
-
-func (m *QueryGetAssetOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryValidateCreateMarketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidateCreateMarketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidateCreateMarketResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetAssetOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
-	}
-	if len(m.Orders) > 0 {
-		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_QueryValidateCreateMarketResponse proto.InternalMessageInfo
+
+func (m *QueryValidateCreateMarketResponse) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryGetAllOrdersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryValidateCreateMarketResponse) GetGovPropWillPass() bool {
+	if m != nil {
+		return m.GovPropWillPass
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryGetAllOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryValidateMarketRequest is a request message for the ValidateMarket query.
+type QueryValidateMarketRequest struct {
+	// market_id is the id of the market to check.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
 }
 
-func (m *QueryGetAllOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryValidateMarketRequest) Reset()         { *m = QueryValidateMarketRequest{} }
+func (m *QueryValidateMarketRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidateMarketRequest) ProtoMessage()    {}
+func (*QueryValidateMarketRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{72}
+}
+func (m *QueryValidateMarketRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidateMarketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidateMarketRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryValidateMarketRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidateMarketRequest.Merge(m, src)
+}
+func (m *QueryValidateMarketRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidateMarketRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidateMarketRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetAllOrdersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidateMarketRequest proto.InternalMessageInfo
+
+func (m *QueryValidateMarketRequest) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryGetAllOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryValidateMarketResponse is a response message for the ValidateMarket query.
+type QueryValidateMarketResponse struct {
+	// error is any problems or inconsistencies in the provided market.
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (m *QueryGetAllOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
-	}
-	if len(m.Orders) > 0 {
-		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryValidateMarketResponse) Reset()         { *m = QueryValidateMarketResponse{} }
+func (m *QueryValidateMarketResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidateMarketResponse) ProtoMessage()    {}
+func (*QueryValidateMarketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{73}
+}
+func (m *QueryValidateMarketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidateMarketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidateMarketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryValidateMarketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidateMarketResponse.Merge(m, src)
+}
+func (m *QueryValidateMarketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidateMarketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidateMarketResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetCommitmentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidateMarketResponse proto.InternalMessageInfo
+
+func (m *QueryValidateMarketResponse) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetCommitmentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryValidateManageFeesRequest is a request message for the ValidateManageFees query.
+type QueryValidateManageFeesRequest struct {
+	// manage_fees_request is the request to run validation on.
+	ManageFeesRequest *MsgGovManageFeesRequest `protobuf:"bytes,1,opt,name=manage_fees_request,json=manageFeesRequest,proto3" json:"manage_fees_request,omitempty"`
 }
 
-func (m *QueryGetCommitmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryValidateManageFeesRequest) Reset()         { *m = QueryValidateManageFeesRequest{} }
+func (m *QueryValidateManageFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidateManageFeesRequest) ProtoMessage()    {}
+func (*QueryValidateManageFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{74}
+}
+func (m *QueryValidateManageFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidateManageFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidateManageFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryValidateManageFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidateManageFeesRequest.Merge(m, src)
+}
+func (m *QueryValidateManageFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidateManageFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidateManageFeesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetCommitmentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidateManageFeesRequest proto.InternalMessageInfo
+
+func (m *QueryValidateManageFeesRequest) GetManageFeesRequest() *MsgGovManageFeesRequest {
+	if m != nil {
+		return m.ManageFeesRequest
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetCommitmentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryValidateManageFeesResponse is a response message for the ValidateManageFees query.
+type QueryValidateManageFeesResponse struct {
+	// error is any problems or inconsistencies in the provided gov prop msg.
+	// This goes above and beyond the validation done when actually processing the governance proposal.
+	// If an error is returned, and gov_prop_will_pass is true, it means the error is more of an
+	// inconsistency that might cause certain aspects of the market to behave unexpectedly.
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	// gov_prop_will_pass will be true if the the provided msg will be successfully processed at the end of it's voting
+	// period (assuming it passes).
+	GovPropWillPass bool `protobuf:"varint,2,opt,name=gov_prop_will_pass,json=govPropWillPass,proto3" json:"gov_prop_will_pass,omitempty"`
 }
 
-func (m *QueryGetCommitmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryValidateManageFeesResponse) Reset()         { *m = QueryValidateManageFeesResponse{} }
+func (m *QueryValidateManageFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidateManageFeesResponse) ProtoMessage()    {}
+func (*QueryValidateManageFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{75}
+}
+func (m *QueryValidateManageFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidateManageFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidateManageFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetAccountCommitmentsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryValidateManageFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidateManageFeesResponse.Merge(m, src)
 }
-
-func (m *QueryGetAccountCommitmentsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryValidateManageFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidateManageFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidateManageFeesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetAccountCommitmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryValidateManageFeesResponse proto.InternalMessageInfo
+
+func (m *QueryValidateManageFeesResponse) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryGetAccountCommitmentsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryValidateManageFeesResponse) GetGovPropWillPass() bool {
+	if m != nil {
+		return m.GovPropWillPass
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryGetAccountCommitmentsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetPaymentRequest is a request message for the GetPayment query.
+type QueryGetPaymentRequest struct {
+	// source is the source account of the payment to get.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// external_id is the external id of the payment to get.
+	ExternalId string `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
 }
 
-func (m *QueryGetAccountCommitmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Commitments) > 0 {
-		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Commitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryGetPaymentRequest) Reset()         { *m = QueryGetPaymentRequest{} }
+func (m *QueryGetPaymentRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPaymentRequest) ProtoMessage()    {}
+func (*QueryGetPaymentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{76}
+}
+func (m *QueryGetPaymentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPaymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPaymentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetMarketCommitmentsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetPaymentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPaymentRequest.Merge(m, src)
 }
-
-func (m *QueryGetMarketCommitmentsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetPaymentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPaymentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPaymentRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetMarketCommitmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryGetPaymentRequest proto.InternalMessageInfo
+
+func (m *QueryGetPaymentRequest) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryGetMarketCommitmentsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetPaymentRequest) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetMarketCommitmentsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetPaymentResponse is a response message for the GetPayment query.
+type QueryGetPaymentResponse struct {
+	// payment is the info on the requested payment.
+	Payment *Payment `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
 }
 
-func (m *QueryGetMarketCommitmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
-	}
-	if len(m.Commitments) > 0 {
-		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Commitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryGetPaymentResponse) Reset()         { *m = QueryGetPaymentResponse{} }
+func (m *QueryGetPaymentResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPaymentResponse) ProtoMessage()    {}
+func (*QueryGetPaymentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{77}
+}
+func (m *QueryGetPaymentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPaymentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPaymentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetPaymentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPaymentResponse.Merge(m, src)
+}
+func (m *QueryGetPaymentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPaymentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPaymentResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetAllCommitmentsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetPaymentResponse proto.InternalMessageInfo
+
+func (m *QueryGetPaymentResponse) GetPayment() *Payment {
+	if m != nil {
+		return m.Payment
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetAllCommitmentsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetPaymentsWithSourceRequest is a request message for the GetPaymentsWithSource query.
+type QueryGetPaymentsWithSourceRequest struct {
+	// source is the source account of the payments to get.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// filter, if provided, limits the results to payments that meet its criteria.
+	Filter *PaymentFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetAllCommitmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryGetPaymentsWithSourceRequest) Reset()         { *m = QueryGetPaymentsWithSourceRequest{} }
+func (m *QueryGetPaymentsWithSourceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPaymentsWithSourceRequest) ProtoMessage()    {}
+func (*QueryGetPaymentsWithSourceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{78}
+}
+func (m *QueryGetPaymentsWithSourceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPaymentsWithSourceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPaymentsWithSourceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetPaymentsWithSourceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPaymentsWithSourceRequest.Merge(m, src)
+}
+func (m *QueryGetPaymentsWithSourceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPaymentsWithSourceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPaymentsWithSourceRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetAllCommitmentsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetPaymentsWithSourceRequest proto.InternalMessageInfo
+
+func (m *QueryGetPaymentsWithSourceRequest) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetAllCommitmentsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetPaymentsWithSourceRequest) GetFilter() *PaymentFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
 }
 
-func (m *QueryGetAllCommitmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+func (m *QueryGetPaymentsWithSourceRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	if len(m.Commitments) > 0 {
-		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Commitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetMarketRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// QueryGetPaymentsWithSourceResponse is a response message for the GetPaymentsWithSource query.
+type QueryGetPaymentsWithSourceResponse struct {
+	// payments is all the payments with the requested source.
+	Payments []*Payment `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
+	// pagination is the resulting pagination parameters.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetMarketRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetPaymentsWithSourceResponse) Reset()         { *m = QueryGetPaymentsWithSourceResponse{} }
+func (m *QueryGetPaymentsWithSourceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPaymentsWithSourceResponse) ProtoMessage()    {}
+func (*QueryGetPaymentsWithSourceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{79}
 }
-
-func (m *QueryGetMarketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QueryGetPaymentsWithSourceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryGetMarketResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetPaymentsWithSourceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPaymentsWithSourceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryGetMarketResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetPaymentsWithSourceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPaymentsWithSourceResponse.Merge(m, src)
+}
+func (m *QueryGetPaymentsWithSourceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPaymentsWithSourceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPaymentsWithSourceResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Market != nil {
-		{
-			size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryGetPaymentsWithSourceResponse proto.InternalMessageInfo
+
+func (m *QueryGetPaymentsWithSourceResponse) GetPayments() []*Payment {
+	if m != nil {
+		return m.Payments
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetAllMarketsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetPaymentsWithSourceResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetAllMarketsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetPaymentsWithTargetRequest is a request message for the GetPaymentsWithTarget query.
+type QueryGetPaymentsWithTargetRequest struct {
+	// target is the target account of the payments to get.
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// filter, if provided, limits the results to payments that meet its criteria.
+	Filter *PaymentFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetAllMarketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryGetPaymentsWithTargetRequest) Reset()         { *m = QueryGetPaymentsWithTargetRequest{} }
+func (m *QueryGetPaymentsWithTargetRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPaymentsWithTargetRequest) ProtoMessage()    {}
+func (*QueryGetPaymentsWithTargetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{80}
+}
+func (m *QueryGetPaymentsWithTargetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPaymentsWithTargetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPaymentsWithTargetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetAllMarketsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetPaymentsWithTargetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPaymentsWithTargetRequest.Merge(m, src)
 }
-
-func (m *QueryGetAllMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetPaymentsWithTargetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPaymentsWithTargetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPaymentsWithTargetRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetAllMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
-	}
-	if len(m.Markets) > 0 {
-		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_QueryGetPaymentsWithTargetRequest proto.InternalMessageInfo
+
+func (m *QueryGetPaymentsWithTargetRequest) GetTarget() string {
+	if m != nil {
+		return m.Target
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetPaymentsWithTargetRequest) GetFilter() *PaymentFilter {
+	if m != nil {
+		return m.Filter
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetPaymentsWithTargetRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryGetPaymentsWithTargetResponse is a response message for the GetPaymentsWithTarget query.
+type QueryGetPaymentsWithTargetResponse struct {
+	// payments is all the payments with the requested target.
+	Payments []*Payment `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
+	// pagination is the resulting pagination parameters.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetPaymentsWithTargetResponse) Reset()         { *m = QueryGetPaymentsWithTargetResponse{} }
+func (m *QueryGetPaymentsWithTargetResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPaymentsWithTargetResponse) ProtoMessage()    {}
+func (*QueryGetPaymentsWithTargetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{81}
+}
+func (m *QueryGetPaymentsWithTargetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPaymentsWithTargetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPaymentsWithTargetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryGetPaymentsWithTargetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPaymentsWithTargetResponse.Merge(m, src)
+}
+func (m *QueryGetPaymentsWithTargetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPaymentsWithTargetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPaymentsWithTargetResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryGetPaymentsWithTargetResponse proto.InternalMessageInfo
+
+func (m *QueryGetPaymentsWithTargetResponse) GetPayments() []*Payment {
+	if m != nil {
+		return m.Payments
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryGetPaymentsWithTargetResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGetAllPaymentsRequest is a request message for the GetAllPayments query.
+type QueryGetAllPaymentsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGetAllPaymentsRequest) Reset()         { *m = QueryGetAllPaymentsRequest{} }
+func (m *QueryGetAllPaymentsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAllPaymentsRequest) ProtoMessage()    {}
+func (*QueryGetAllPaymentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{82}
+}
+func (m *QueryGetAllPaymentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAllPaymentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAllPaymentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetAllPaymentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAllPaymentsRequest.Merge(m, src)
+}
+func (m *QueryGetAllPaymentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAllPaymentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAllPaymentsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryCommitmentSettlementFeeCalcRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetAllPaymentsRequest proto.InternalMessageInfo
+
+func (m *QueryGetAllPaymentsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryCommitmentSettlementFeeCalcRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetAllPaymentsResponse is a response message for the GetAllPayments query.
+type QueryGetAllPaymentsResponse struct {
+	// payments is all the payments on this page of results.
+	Payments []*Payment `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
+	// pagination is the resulting pagination parameters.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryCommitmentSettlementFeeCalcRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IncludeBreakdownFields {
-		i--
-		if m.IncludeBreakdownFields {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *QueryGetAllPaymentsResponse) Reset()         { *m = QueryGetAllPaymentsResponse{} }
+func (m *QueryGetAllPaymentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAllPaymentsResponse) ProtoMessage()    {}
+func (*QueryGetAllPaymentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{83}
+}
+func (m *QueryGetAllPaymentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAllPaymentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAllPaymentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x10
+		return b[:n], nil
 	}
-	if m.Settlement != nil {
-		{
-			size, err := m.Settlement.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryGetAllPaymentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAllPaymentsResponse.Merge(m, src)
+}
+func (m *QueryGetAllPaymentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAllPaymentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAllPaymentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetAllPaymentsResponse proto.InternalMessageInfo
+
+func (m *QueryGetAllPaymentsResponse) GetPayments() []*Payment {
+	if m != nil {
+		return m.Payments
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryCommitmentSettlementFeeCalcResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetAllPaymentsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryCommitmentSettlementFeeCalcResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryPaymentFeeCalcRequest is a request message for the PaymentFeeCalc query.
+type QueryPaymentFeeCalcRequest struct {
+	// payment is the details of the payment to create or accept.
+	Payment Payment `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment"`
 }
 
-func (m *QueryCommitmentSettlementFeeCalcResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ToFeeNav != nil {
-		{
-			size, err := m.ToFeeNav.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryPaymentFeeCalcRequest) Reset()         { *m = QueryPaymentFeeCalcRequest{} }
+func (m *QueryPaymentFeeCalcRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentFeeCalcRequest) ProtoMessage()    {}
+func (*QueryPaymentFeeCalcRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{84}
+}
+func (m *QueryPaymentFeeCalcRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymentFeeCalcRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentFeeCalcRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x2a
+		return b[:n], nil
 	}
-	if len(m.ConversionNavs) > 0 {
-		for iNdEx := len(m.ConversionNavs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ConversionNavs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+}
+func (m *QueryPaymentFeeCalcRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentFeeCalcRequest.Merge(m, src)
+}
+func (m *QueryPaymentFeeCalcRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymentFeeCalcRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentFeeCalcRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPaymentFeeCalcRequest proto.InternalMessageInfo
+
+func (m *QueryPaymentFeeCalcRequest) GetPayment() Payment {
+	if m != nil {
+		return m.Payment
 	}
-	if len(m.ConvertedTotal) > 0 {
-		for iNdEx := len(m.ConvertedTotal) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ConvertedTotal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.InputTotal) > 0 {
-		for iNdEx := len(m.InputTotal) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.InputTotal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.ExchangeFees) > 0 {
-		for iNdEx := len(m.ExchangeFees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ExchangeFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+	return Payment{}
 }
 
-func (m *QueryValidateCreateMarketRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// QueryPaymentFeeCalcResponse is a response message for the PaymentFeeCalc query.
+type QueryPaymentFeeCalcResponse struct {
+	// fee_create is the fee required to create the provided payment.
+	FeeCreate github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=fee_create,json=feeCreate,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_create"`
+	// fee_accept is the fee required to accept the provided payment.
+	FeeAccept github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fee_accept,json=feeAccept,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_accept"`
 }
 
-func (m *QueryValidateCreateMarketRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPaymentFeeCalcResponse) Reset()         { *m = QueryPaymentFeeCalcResponse{} }
+func (m *QueryPaymentFeeCalcResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentFeeCalcResponse) ProtoMessage()    {}
+func (*QueryPaymentFeeCalcResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{85}
 }
-
-func (m *QueryValidateCreateMarketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CreateMarketRequest != nil {
-		{
-			size, err := m.CreateMarketRequest.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryPaymentFeeCalcResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymentFeeCalcResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentFeeCalcResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryPaymentFeeCalcResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentFeeCalcResponse.Merge(m, src)
+}
+func (m *QueryPaymentFeeCalcResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymentFeeCalcResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentFeeCalcResponse.DiscardUnknown(m)
 }
 
-func (m *QueryValidateCreateMarketResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPaymentFeeCalcResponse proto.InternalMessageInfo
+
+func (m *QueryPaymentFeeCalcResponse) GetFeeCreate() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeeCreate
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryValidateCreateMarketResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPaymentFeeCalcResponse) GetFeeAccept() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeeAccept
+	}
+	return nil
 }
 
-func (m *QueryValidateCreateMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GovPropWillPass {
-		i--
-		if m.GovPropWillPass {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+// QueryGetAccountActivitySummaryRequest is a request message for the GetAccountActivitySummary query.
+type QueryGetAccountActivitySummaryRequest struct {
+	// account is the bech32 address string of the account to summarize.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (m *QueryGetAccountActivitySummaryRequest) Reset()         { *m = QueryGetAccountActivitySummaryRequest{} }
+func (m *QueryGetAccountActivitySummaryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAccountActivitySummaryRequest) ProtoMessage()    {}
+func (*QueryGetAccountActivitySummaryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{86}
+}
+func (m *QueryGetAccountActivitySummaryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAccountActivitySummaryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAccountActivitySummaryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetAccountActivitySummaryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAccountActivitySummaryRequest.Merge(m, src)
+}
+func (m *QueryGetAccountActivitySummaryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAccountActivitySummaryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAccountActivitySummaryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryValidateMarketRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetAccountActivitySummaryRequest proto.InternalMessageInfo
+
+func (m *QueryGetAccountActivitySummaryRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryValidateMarketRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetAccountActivitySummaryResponse is a response message for the GetAccountActivitySummary query.
+type QueryGetAccountActivitySummaryResponse struct {
+	// orders are the totals of the account's orders in each market that it has orders in.
+	Orders []MarketOrdersSummary `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders"`
+	// commitments are the amounts committed by the account to each market.
+	Commitments []*MarketAmount `protobuf:"bytes,2,rep,name=commitments,proto3" json:"commitments,omitempty"`
+	// outgoing_payments is the total of the payments with the account as the source.
+	OutgoingPayments PaymentsSummary `protobuf:"bytes,3,opt,name=outgoing_payments,json=outgoingPayments,proto3" json:"outgoing_payments"`
+	// incoming_payments is the total of the payments with the account as the target.
+	IncomingPayments PaymentsSummary `protobuf:"bytes,4,opt,name=incoming_payments,json=incomingPayments,proto3" json:"incoming_payments"`
+	// on_hold is the total amount of the account's funds on hold for its orders, commitments, and outgoing payments.
+	OnHold github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=on_hold,json=onHold,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"on_hold"`
 }
 
-func (m *QueryValidateMarketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryGetAccountActivitySummaryResponse) Reset() {
+	*m = QueryGetAccountActivitySummaryResponse{}
+}
+func (m *QueryGetAccountActivitySummaryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAccountActivitySummaryResponse) ProtoMessage()    {}
+func (*QueryGetAccountActivitySummaryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{87}
+}
+func (m *QueryGetAccountActivitySummaryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAccountActivitySummaryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAccountActivitySummaryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetAccountActivitySummaryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAccountActivitySummaryResponse.Merge(m, src)
+}
+func (m *QueryGetAccountActivitySummaryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAccountActivitySummaryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAccountActivitySummaryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryValidateMarketResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetAccountActivitySummaryResponse proto.InternalMessageInfo
+
+func (m *QueryGetAccountActivitySummaryResponse) GetOrders() []MarketOrdersSummary {
+	if m != nil {
+		return m.Orders
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryValidateMarketResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetAccountActivitySummaryResponse) GetCommitments() []*MarketAmount {
+	if m != nil {
+		return m.Commitments
+	}
+	return nil
 }
 
-func (m *QueryValidateMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryGetAccountActivitySummaryResponse) GetOutgoingPayments() PaymentsSummary {
+	if m != nil {
+		return m.OutgoingPayments
 	}
-	return len(dAtA) - i, nil
+	return PaymentsSummary{}
 }
 
-func (m *QueryValidateManageFeesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetAccountActivitySummaryResponse) GetIncomingPayments() PaymentsSummary {
+	if m != nil {
+		return m.IncomingPayments
 	}
-	return dAtA[:n], nil
+	return PaymentsSummary{}
 }
 
-func (m *QueryValidateManageFeesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetAccountActivitySummaryResponse) GetOnHold() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.OnHold
+	}
+	return nil
 }
 
-func (m *QueryValidateManageFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ManageFeesRequest != nil {
-		{
-			size, err := m.ManageFeesRequest.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterType((*QueryOrderFeeCalcRequest)(nil), "provenance.exchange.v1.QueryOrderFeeCalcRequest")
+	proto.RegisterType((*QueryOrderFeeCalcResponse)(nil), "provenance.exchange.v1.QueryOrderFeeCalcResponse")
+	proto.RegisterType((*QueryGetOrderRequest)(nil), "provenance.exchange.v1.QueryGetOrderRequest")
+	proto.RegisterType((*QueryGetOrderResponse)(nil), "provenance.exchange.v1.QueryGetOrderResponse")
+	proto.RegisterType((*QueryGetOrderByExternalIDRequest)(nil), "provenance.exchange.v1.QueryGetOrderByExternalIDRequest")
+	proto.RegisterType((*QueryGetOrderByExternalIDResponse)(nil), "provenance.exchange.v1.QueryGetOrderByExternalIDResponse")
+	proto.RegisterType((*QueryGetOrdersByExternalIDPrefixRequest)(nil), "provenance.exchange.v1.QueryGetOrdersByExternalIDPrefixRequest")
+	proto.RegisterType((*QueryGetOrdersByExternalIDPrefixResponse)(nil), "provenance.exchange.v1.QueryGetOrdersByExternalIDPrefixResponse")
+	proto.RegisterType((*QueryGetMarketOrdersRequest)(nil), "provenance.exchange.v1.QueryGetMarketOrdersRequest")
+	proto.RegisterType((*QueryGetMarketOrdersResponse)(nil), "provenance.exchange.v1.QueryGetMarketOrdersResponse")
+	proto.RegisterType((*QueryGetOwnerOrdersRequest)(nil), "provenance.exchange.v1.QueryGetOwnerOrdersRequest")
+	proto.RegisterType((*QueryGetOwnerOrdersResponse)(nil), "provenance.exchange.v1.QueryGetOwnerOrdersResponse")
+	proto.RegisterType((*QueryGetAssetOrdersRequest)(nil), "provenance.exchange.v1.QueryGetAssetOrdersRequest")
+	proto.RegisterType((*QueryGetAssetOrdersResponse)(nil), "provenance.exchange.v1.QueryGetAssetOrdersResponse")
+	proto.RegisterType((*QueryGetAllOrdersRequest)(nil), "provenance.exchange.v1.QueryGetAllOrdersRequest")
+	proto.RegisterType((*QueryGetAllOrdersResponse)(nil), "provenance.exchange.v1.QueryGetAllOrdersResponse")
+	proto.RegisterType((*QueryGetTriggerOrderRequest)(nil), "provenance.exchange.v1.QueryGetTriggerOrderRequest")
+	proto.RegisterType((*QueryGetTriggerOrderResponse)(nil), "provenance.exchange.v1.QueryGetTriggerOrderResponse")
+	proto.RegisterType((*QueryGetMarketTriggerOrdersRequest)(nil), "provenance.exchange.v1.QueryGetMarketTriggerOrdersRequest")
+	proto.RegisterType((*QueryGetMarketTriggerOrdersResponse)(nil), "provenance.exchange.v1.QueryGetMarketTriggerOrdersResponse")
+	proto.RegisterType((*QueryGetOrderLinkRequest)(nil), "provenance.exchange.v1.QueryGetOrderLinkRequest")
+	proto.RegisterType((*QueryGetOrderLinkResponse)(nil), "provenance.exchange.v1.QueryGetOrderLinkResponse")
+	proto.RegisterType((*QueryGetMarketOrderLinksRequest)(nil), "provenance.exchange.v1.QueryGetMarketOrderLinksRequest")
+	proto.RegisterType((*QueryGetMarketOrderLinksResponse)(nil), "provenance.exchange.v1.QueryGetMarketOrderLinksResponse")
+	proto.RegisterType((*QueryGetDeadManSwitchRequest)(nil), "provenance.exchange.v1.QueryGetDeadManSwitchRequest")
+	proto.RegisterType((*QueryGetDeadManSwitchResponse)(nil), "provenance.exchange.v1.QueryGetDeadManSwitchResponse")
+	proto.RegisterType((*QueryOrderBookDepthRequest)(nil), "provenance.exchange.v1.QueryOrderBookDepthRequest")
+	proto.RegisterType((*QueryOrderBookDepthResponse)(nil), "provenance.exchange.v1.QueryOrderBookDepthResponse")
+	proto.RegisterType((*QueryTopOfBookRequest)(nil), "provenance.exchange.v1.QueryTopOfBookRequest")
+	proto.RegisterType((*QueryTopOfBookResponse)(nil), "provenance.exchange.v1.QueryTopOfBookResponse")
+	proto.RegisterType((*QueryPriceAveragesRequest)(nil), "provenance.exchange.v1.QueryPriceAveragesRequest")
+	proto.RegisterType((*QueryPriceAveragesResponse)(nil), "provenance.exchange.v1.QueryPriceAveragesResponse")
+	proto.RegisterType((*QueryGetMarketSettlementsRequest)(nil), "provenance.exchange.v1.QueryGetMarketSettlementsRequest")
+	proto.RegisterType((*QueryGetMarketSettlementsResponse)(nil), "provenance.exchange.v1.QueryGetMarketSettlementsResponse")
+	proto.RegisterType((*QueryGetAllSettlementsRequest)(nil), "provenance.exchange.v1.QueryGetAllSettlementsRequest")
+	proto.RegisterType((*QueryGetAllSettlementsResponse)(nil), "provenance.exchange.v1.QueryGetAllSettlementsResponse")
+	proto.RegisterType((*QueryGetSettlementReceiptRequest)(nil), "provenance.exchange.v1.QueryGetSettlementReceiptRequest")
+	proto.RegisterType((*QueryGetSettlementReceiptResponse)(nil), "provenance.exchange.v1.QueryGetSettlementReceiptResponse")
+	proto.RegisterType((*QueryGetLatestNAVRequest)(nil), "provenance.exchange.v1.QueryGetLatestNAVRequest")
+	proto.RegisterType((*QueryGetLatestNAVResponse)(nil), "provenance.exchange.v1.QueryGetLatestNAVResponse")
+	proto.RegisterType((*QueryGetNAVHistoryRequest)(nil), "provenance.exchange.v1.QueryGetNAVHistoryRequest")
+	proto.RegisterType((*QueryGetNAVHistoryResponse)(nil), "provenance.exchange.v1.QueryGetNAVHistoryResponse")
+	proto.RegisterType((*QuerySimulateSettlementRequest)(nil), "provenance.exchange.v1.QuerySimulateSettlementRequest")
+	proto.RegisterType((*QuerySimulateSettlementResponse)(nil), "provenance.exchange.v1.QuerySimulateSettlementResponse")
+	proto.RegisterType((*QueryGetCommitmentRequest)(nil), "provenance.exchange.v1.QueryGetCommitmentRequest")
+	proto.RegisterType((*QueryGetCommitmentResponse)(nil), "provenance.exchange.v1.QueryGetCommitmentResponse")
+	proto.RegisterType((*QueryGetAccountCommitmentsRequest)(nil), "provenance.exchange.v1.QueryGetAccountCommitmentsRequest")
+	proto.RegisterType((*QueryGetAccountCommitmentsResponse)(nil), "provenance.exchange.v1.QueryGetAccountCommitmentsResponse")
+	proto.RegisterType((*QueryGetMarketCommitmentsRequest)(nil), "provenance.exchange.v1.QueryGetMarketCommitmentsRequest")
+	proto.RegisterType((*QueryGetMarketCommitmentsResponse)(nil), "provenance.exchange.v1.QueryGetMarketCommitmentsResponse")
+	proto.RegisterType((*QueryGetAllCommitmentsRequest)(nil), "provenance.exchange.v1.QueryGetAllCommitmentsRequest")
+	proto.RegisterType((*QueryGetAllCommitmentsResponse)(nil), "provenance.exchange.v1.QueryGetAllCommitmentsResponse")
+	proto.RegisterType((*QueryGetCommitmentRewardPoolRequest)(nil), "provenance.exchange.v1.QueryGetCommitmentRewardPoolRequest")
+	proto.RegisterType((*QueryGetCommitmentRewardPoolResponse)(nil), "provenance.exchange.v1.QueryGetCommitmentRewardPoolResponse")
+	proto.RegisterType((*QueryGetAccountCommitmentRewardsRequest)(nil), "provenance.exchange.v1.QueryGetAccountCommitmentRewardsRequest")
+	proto.RegisterType((*QueryGetAccountCommitmentRewardsResponse)(nil), "provenance.exchange.v1.QueryGetAccountCommitmentRewardsResponse")
+	proto.RegisterType((*QueryGetMarketRequest)(nil), "provenance.exchange.v1.QueryGetMarketRequest")
+	proto.RegisterType((*QueryGetMarketResponse)(nil), "provenance.exchange.v1.QueryGetMarketResponse")
+	proto.RegisterType((*QueryGetScheduledFeeChangesRequest)(nil), "provenance.exchange.v1.QueryGetScheduledFeeChangesRequest")
+	proto.RegisterType((*QueryGetScheduledFeeChangesResponse)(nil), "provenance.exchange.v1.QueryGetScheduledFeeChangesResponse")
+	proto.RegisterType((*QueryGetMarketAuthorityTransferRequest)(nil), "provenance.exchange.v1.QueryGetMarketAuthorityTransferRequest")
+	proto.RegisterType((*QueryGetMarketAuthorityTransferResponse)(nil), "provenance.exchange.v1.QueryGetMarketAuthorityTransferResponse")
+	proto.RegisterType((*QueryExportMarketRequest)(nil), "provenance.exchange.v1.QueryExportMarketRequest")
+	proto.RegisterType((*QueryExportMarketResponse)(nil), "provenance.exchange.v1.QueryExportMarketResponse")
+	proto.RegisterType((*QueryGetAllMarketsRequest)(nil), "provenance.exchange.v1.QueryGetAllMarketsRequest")
+	proto.RegisterType((*QueryGetAllMarketsResponse)(nil), "provenance.exchange.v1.QueryGetAllMarketsResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "provenance.exchange.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.exchange.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryCommitmentSettlementFeeCalcRequest)(nil), "provenance.exchange.v1.QueryCommitmentSettlementFeeCalcRequest")
+	proto.RegisterType((*QueryCommitmentSettlementFeeCalcResponse)(nil), "provenance.exchange.v1.QueryCommitmentSettlementFeeCalcResponse")
+	proto.RegisterType((*QueryValidateCreateMarketRequest)(nil), "provenance.exchange.v1.QueryValidateCreateMarketRequest")
+	proto.RegisterType((*QueryValidateCreateMarketResponse)(nil), "provenance.exchange.v1.QueryValidateCreateMarketResponse")
+	proto.RegisterType((*QueryValidateMarketRequest)(nil), "provenance.exchange.v1.QueryValidateMarketRequest")
+	proto.RegisterType((*QueryValidateMarketResponse)(nil), "provenance.exchange.v1.QueryValidateMarketResponse")
+	proto.RegisterType((*QueryValidateManageFeesRequest)(nil), "provenance.exchange.v1.QueryValidateManageFeesRequest")
+	proto.RegisterType((*QueryValidateManageFeesResponse)(nil), "provenance.exchange.v1.QueryValidateManageFeesResponse")
+	proto.RegisterType((*QueryGetPaymentRequest)(nil), "provenance.exchange.v1.QueryGetPaymentRequest")
+	proto.RegisterType((*QueryGetPaymentResponse)(nil), "provenance.exchange.v1.QueryGetPaymentResponse")
+	proto.RegisterType((*QueryGetPaymentsWithSourceRequest)(nil), "provenance.exchange.v1.QueryGetPaymentsWithSourceRequest")
+	proto.RegisterType((*QueryGetPaymentsWithSourceResponse)(nil), "provenance.exchange.v1.QueryGetPaymentsWithSourceResponse")
+	proto.RegisterType((*QueryGetPaymentsWithTargetRequest)(nil), "provenance.exchange.v1.QueryGetPaymentsWithTargetRequest")
+	proto.RegisterType((*QueryGetPaymentsWithTargetResponse)(nil), "provenance.exchange.v1.QueryGetPaymentsWithTargetResponse")
+	proto.RegisterType((*QueryGetAllPaymentsRequest)(nil), "provenance.exchange.v1.QueryGetAllPaymentsRequest")
+	proto.RegisterType((*QueryGetAllPaymentsResponse)(nil), "provenance.exchange.v1.QueryGetAllPaymentsResponse")
+	proto.RegisterType((*QueryPaymentFeeCalcRequest)(nil), "provenance.exchange.v1.QueryPaymentFeeCalcRequest")
+	proto.RegisterType((*QueryPaymentFeeCalcResponse)(nil), "provenance.exchange.v1.QueryPaymentFeeCalcResponse")
+	proto.RegisterType((*QueryGetAccountActivitySummaryRequest)(nil), "provenance.exchange.v1.QueryGetAccountActivitySummaryRequest")
+	proto.RegisterType((*QueryGetAccountActivitySummaryResponse)(nil), "provenance.exchange.v1.QueryGetAccountActivitySummaryResponse")
 }
 
-func (m *QueryValidateManageFeesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() {
+	proto.RegisterFile("provenance/exchange/v1/query.proto", fileDescriptor_00949b75b1c10bfe)
 }
 
-func (m *QueryValidateManageFeesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var fileDescriptor_00949b75b1c10bfe = []byte{
+	// 4323 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5c, 0x4b, 0x6c, 0x1c, 0xc9,
+	0x79, 0xde, 0x1e, 0x3e, 0x24, 0xfe, 0x14, 0xa9, 0x55, 0x89, 0x92, 0xc9, 0xd6, 0x2e, 0x49, 0xb5,
+	0x1e, 0xcb, 0x50, 0x22, 0x5b, 0x24, 0xf5, 0xa2, 0x64, 0x3d, 0x48, 0x69, 0xa9, 0x55, 0xb0, 0x92,
+	0xe8, 0x11, 0xb3, 0x36, 0x94, 0xac, 0xc7, 0xcd, 0xe9, 0xe2, 0xb0, 0xc3, 0x99, 0xee, 0x71, 0x77,
+	0x73, 0x44, 0x86, 0x60, 0x90, 0x6c, 0x12, 0x1b, 0xda, 0x43, 0x60, 0x23, 0x87, 0xd8, 0x31, 0x6c,
+	0x23, 0xde, 0x00, 0x09, 0xf6, 0xe0, 0x35, 0x10, 0x27, 0x07, 0x3b, 0x89, 0x11, 0xe4, 0x90, 0x05,
+	0x8c, 0x00, 0x46, 0x1e, 0x80, 0x83, 0x18, 0x89, 0xb1, 0x32, 0xb0, 0x97, 0x04, 0x39, 0xe6, 0x16,
+	0x04, 0x5d, 0x8f, 0xee, 0xea, 0x99, 0x7e, 0x8e, 0x66, 0x19, 0x5e, 0xc4, 0xe9, 0xea, 0xfa, 0xff,
+	0xfa, 0xfe, 0xbf, 0xfe, 0xaa, 0xfa, 0xab, 0xfa, 0x2b, 0x81, 0x52, 0xb7, 0xad, 0x06, 0x36, 0x35,
+	0xb3, 0x8c, 0x55, 0xbc, 0x55, 0x5e, 0xd7, 0xcc, 0x0a, 0x56, 0x1b, 0x33, 0xea, 0x17, 0x37, 0xb1,
+	0xbd, 0x3d, 0x5d, 0xb7, 0x2d, 0xd7, 0x42, 0xc7, 0x83, 0x3a, 0xd3, 0xbc, 0xce, 0x74, 0x63, 0x46,
+	0x3e, 0xa2, 0xd5, 0x0c, 0xd3, 0x52, 0xc9, 0xbf, 0xb4, 0xaa, 0x3c, 0x52, 0xb6, 0x9c, 0x9a, 0xe5,
+	0x94, 0xc8, 0x93, 0x4a, 0x1f, 0xd8, 0xab, 0x49, 0xfa, 0xa4, 0xae, 0x6a, 0x0e, 0xa6, 0xea, 0xd5,
+	0xc6, 0xcc, 0x2a, 0x76, 0xb5, 0x19, 0xb5, 0xae, 0x55, 0x0c, 0x53, 0x73, 0x0d, 0xcb, 0x64, 0x75,
+	0x47, 0xc5, 0xba, 0xbc, 0x56, 0xd9, 0x32, 0xf8, 0xfb, 0x57, 0x2a, 0x96, 0x55, 0xa9, 0x62, 0x55,
+	0xab, 0x1b, 0xaa, 0x66, 0x9a, 0x96, 0x4b, 0x84, 0x79, 0x4b, 0x43, 0x15, 0xab, 0x62, 0x51, 0x04,
+	0xde, 0x2f, 0x56, 0x3a, 0x11, 0x63, 0x69, 0xd9, 0xaa, 0xd5, 0x0c, 0xb7, 0x86, 0x4d, 0x97, 0xcb,
+	0x9f, 0x8a, 0xa9, 0x59, 0xd3, 0xec, 0x0d, 0xec, 0xa6, 0x54, 0xb2, 0x6c, 0x1d, 0xdb, 0x69, 0x9a,
+	0xea, 0x9a, 0xad, 0xd5, 0x78, 0xa5, 0x33, 0xb1, 0x95, 0xb6, 0x45, 0x54, 0x63, 0x31, 0xd5, 0xdc,
+	0x2d, 0x5a, 0x41, 0xf9, 0x9a, 0x04, 0xc3, 0x9f, 0xf1, 0xfc, 0xfa, 0xc8, 0x83, 0xb0, 0x84, 0xf1,
+	0x1d, 0xad, 0x5a, 0x2e, 0xe2, 0x2f, 0x6e, 0x62, 0xc7, 0x45, 0x37, 0xa0, 0x4f, 0x73, 0x36, 0x4a,
+	0x04, 0xdd, 0x70, 0x61, 0x5c, 0x9a, 0xe8, 0x9f, 0x1d, 0x9f, 0x8e, 0xee, 0xd7, 0xe9, 0x05, 0x67,
+	0x83, 0xa8, 0x28, 0x1e, 0xd4, 0xd8, 0x2f, 0x4f, 0x7c, 0xd5, 0xd0, 0x99, 0x78, 0x57, 0xb2, 0xf8,
+	0xa2, 0xa1, 0x33, 0xf1, 0x55, 0xf6, 0x4b, 0xf9, 0x66, 0x17, 0x8c, 0x44, 0x40, 0x73, 0xea, 0x96,
+	0xe9, 0x60, 0xf4, 0x19, 0x18, 0x2a, 0xdb, 0x98, 0x74, 0x61, 0x69, 0x0d, 0xe3, 0x92, 0x55, 0xf7,
+	0x7e, 0x3a, 0xc3, 0xd2, 0x78, 0xd7, 0x44, 0xff, 0xec, 0xc8, 0x34, 0x0b, 0x23, 0x2f, 0x18, 0xa6,
+	0x59, 0x30, 0x4c, 0xdf, 0xb1, 0x0c, 0x73, 0xb1, 0xfb, 0xc3, 0x7f, 0x1f, 0x7b, 0xa9, 0x88, 0xb8,
+	0xf0, 0x12, 0xc6, 0x8f, 0xa8, 0x28, 0xfa, 0x3c, 0x9c, 0x70, 0xb0, 0xeb, 0x56, 0xb1, 0xe7, 0xc1,
+	0xd2, 0x5a, 0x55, 0x73, 0x43, 0x9a, 0x0b, 0xd9, 0x34, 0x0f, 0x07, 0x3a, 0x96, 0xaa, 0x9a, 0x2b,
+	0xe8, 0xff, 0x02, 0xbc, 0x22, 0xe8, 0xb7, 0xbd, 0xe6, 0x43, 0x0d, 0x74, 0x65, 0x6b, 0x60, 0x24,
+	0x50, 0x52, 0xf4, 0x74, 0x08, 0x2d, 0x6c, 0x80, 0x22, 0xb4, 0xe0, 0x6a, 0x1b, 0xd8, 0x8e, 0x68,
+	0xa7, 0x3b, 0x5b, 0x3b, 0xa3, 0x81, 0xaa, 0x15, 0x4f, 0x53, 0x53, 0x63, 0xca, 0x0c, 0x0c, 0x91,
+	0xee, 0xb9, 0x87, 0x5d, 0xda, 0x75, 0x2c, 0x6a, 0x46, 0xe0, 0x20, 0xe9, 0xf2, 0x92, 0xa1, 0x0f,
+	0x4b, 0xe3, 0xd2, 0x44, 0x77, 0xf1, 0x00, 0x79, 0xbe, 0xaf, 0x2b, 0x6f, 0xc2, 0xb1, 0x26, 0x11,
+	0xd6, 0x9b, 0x73, 0xd0, 0x43, 0xc3, 0x44, 0x22, 0x61, 0xf2, 0x6a, 0x5c, 0x98, 0x50, 0x29, 0x5a,
+	0x57, 0xf9, 0x02, 0x8c, 0x87, 0xb4, 0x2d, 0x6e, 0xbf, 0xbe, 0xe5, 0x62, 0xdb, 0xd4, 0xaa, 0xf7,
+	0xef, 0x72, 0x30, 0x27, 0xa0, 0x8f, 0x8e, 0x40, 0x8e, 0x66, 0xa0, 0x78, 0x90, 0x16, 0xdc, 0xd7,
+	0xd1, 0x18, 0xf4, 0x63, 0x26, 0xe1, 0xbd, 0xf6, 0x22, 0xbc, 0xaf, 0x08, 0xbc, 0xe8, 0xbe, 0xae,
+	0x7c, 0x0e, 0x4e, 0x26, 0xb4, 0xf0, 0x22, 0xd8, 0xff, 0x4a, 0x82, 0xd7, 0x42, 0xaa, 0x1d, 0x51,
+	0xf7, 0xb2, 0x8d, 0xd7, 0x8c, 0xad, 0x4c, 0x36, 0x9c, 0x07, 0x24, 0xd8, 0x50, 0xaa, 0x13, 0x49,
+	0x66, 0xca, 0xcb, 0x81, 0x29, 0x54, 0x23, 0x5a, 0x02, 0x08, 0xe6, 0xcd, 0xe1, 0x32, 0x01, 0x7c,
+	0x36, 0x14, 0x08, 0x74, 0x0e, 0xe7, 0xe1, 0xb0, 0xac, 0x55, 0x30, 0x83, 0x51, 0x14, 0x24, 0x95,
+	0xf7, 0x25, 0x98, 0x48, 0x87, 0xcf, 0x1c, 0x74, 0x09, 0x7a, 0xe9, 0x04, 0xc7, 0x06, 0x67, 0x8a,
+	0x87, 0x58, 0x65, 0x74, 0x2f, 0x02, 0xeb, 0x6b, 0xa9, 0x58, 0x69, 0x9b, 0x21, 0xb0, 0xcf, 0x0a,
+	0x70, 0x82, 0x83, 0x7d, 0x40, 0xfc, 0x46, 0x21, 0x67, 0xf2, 0xef, 0xab, 0x00, 0x34, 0x9a, 0xdd,
+	0xed, 0x3a, 0x66, 0x7e, 0xed, 0x23, 0x25, 0x2b, 0xdb, 0x75, 0x8c, 0x4e, 0xc3, 0xa0, 0xb6, 0xe6,
+	0x62, 0xbb, 0xe4, 0x87, 0x7c, 0x17, 0x09, 0xf9, 0x43, 0xa4, 0xf4, 0x11, 0x8d, 0x7b, 0x2f, 0xd0,
+	0x34, 0xc7, 0xc1, 0x6e, 0x49, 0xc7, 0xa6, 0x55, 0x1b, 0xee, 0xa6, 0x81, 0x46, 0x8a, 0xee, 0x7a,
+	0x25, 0x5e, 0x85, 0xba, 0x6d, 0x94, 0x31, 0xab, 0xd0, 0x43, 0x2b, 0x90, 0x22, 0x5a, 0xa1, 0x53,
+	0x1d, 0xf7, 0x2d, 0x09, 0x5e, 0x89, 0xf6, 0xc5, 0x3e, 0xe9, 0xac, 0x7f, 0x95, 0x40, 0xf6, 0x23,
+	0xeb, 0xa9, 0x89, 0xed, 0x70, 0x5f, 0x4d, 0x43, 0x8f, 0xe5, 0x95, 0x92, 0x7e, 0xea, 0x5b, 0x1c,
+	0xfe, 0xc7, 0xef, 0x4f, 0x0d, 0xb1, 0x56, 0x16, 0x74, 0xdd, 0xc6, 0x8e, 0xf3, 0xd8, 0xb5, 0x0d,
+	0xb3, 0x52, 0xa4, 0xd5, 0x3a, 0xd3, 0x7d, 0x9d, 0x72, 0xfe, 0x37, 0x25, 0x38, 0x11, 0x69, 0xdb,
+	0x3e, 0xf1, 0xfd, 0x8f, 0x04, 0xdf, 0x2f, 0x78, 0xc1, 0x19, 0xf6, 0xfd, 0x10, 0xf4, 0x90, 0x90,
+	0xa5, 0xbe, 0x2f, 0xd2, 0x87, 0xfd, 0xeb, 0xe1, 0x90, 0x05, 0xfb, 0xc4, 0xc3, 0xab, 0x30, 0xec,
+	0xc3, 0xab, 0x56, 0xc3, 0xee, 0xed, 0x94, 0x0f, 0xbe, 0x21, 0xc1, 0x48, 0x44, 0x23, 0xfb, 0xc4,
+	0x03, 0x57, 0x83, 0x0e, 0x5a, 0xb1, 0x8d, 0x4a, 0x85, 0xc5, 0x40, 0x86, 0xe4, 0xc1, 0x80, 0x57,
+	0xa2, 0x25, 0x99, 0x65, 0xf7, 0x61, 0xc0, 0xa5, 0xe5, 0x25, 0x71, 0x3d, 0x3e, 0x1d, 0x67, 0x60,
+	0x48, 0xc9, 0x21, 0x57, 0x78, 0x52, 0x9e, 0x49, 0xa0, 0x84, 0x67, 0x49, 0xb1, 0x72, 0xb6, 0x85,
+	0xa3, 0x53, 0xdd, 0xf9, 0xb7, 0x12, 0x9c, 0x4a, 0xc4, 0xe2, 0x27, 0xc4, 0x83, 0x21, 0xf3, 0x79,
+	0x07, 0x67, 0xb2, 0x9f, 0xa5, 0x7c, 0x03, 0xa2, 0x17, 0x3a, 0xd8, 0xe9, 0x97, 0x82, 0xb0, 0x27,
+	0xaa, 0xdf, 0x34, 0xcc, 0x8d, 0x0c, 0x3d, 0xfe, 0x36, 0x8c, 0x44, 0x88, 0x31, 0x7b, 0x6f, 0xf3,
+	0x79, 0xa7, 0x6a, 0x98, 0x1b, 0xac, 0xaf, 0x4f, 0x26, 0x06, 0x33, 0x11, 0xef, 0xb3, 0xf8, 0x4f,
+	0xe5, 0x4b, 0x12, 0x8c, 0x45, 0xac, 0x85, 0xde, 0xbb, 0xbd, 0xed, 0xe2, 0xbf, 0x90, 0x60, 0x3c,
+	0x1e, 0x08, 0xb3, 0xf7, 0x0d, 0xe8, 0x0f, 0xec, 0xe5, 0x9d, 0x9b, 0x6e, 0x30, 0xeb, 0x59, 0xf0,
+	0xcd, 0xee, 0xe8, 0x58, 0xf6, 0x47, 0xe4, 0x5d, 0xac, 0xe9, 0x0f, 0x34, 0xf3, 0xf1, 0x53, 0xc3,
+	0x2d, 0xaf, 0x73, 0xe7, 0x0d, 0xc3, 0x01, 0xad, 0x5c, 0xb6, 0x36, 0x4d, 0xbe, 0x64, 0xf0, 0x47,
+	0xc5, 0x84, 0x57, 0x63, 0x24, 0x99, 0xb5, 0x0f, 0xe0, 0xb0, 0x8e, 0x35, 0xbd, 0x54, 0xd3, 0xcc,
+	0x92, 0x43, 0x5e, 0xb1, 0x2e, 0x3e, 0x13, 0x67, 0x71, 0x58, 0xcf, 0x80, 0x2e, 0x3e, 0x2a, 0x5f,
+	0xe5, 0x2b, 0x1b, 0x8d, 0x76, 0xcb, 0xda, 0xb8, 0x8b, 0xeb, 0xee, 0x7a, 0xd6, 0x5d, 0x82, 0x98,
+	0xbc, 0x15, 0xd2, 0x92, 0xb7, 0xae, 0x96, 0xe4, 0x6d, 0x08, 0x7a, 0x74, 0xaf, 0x39, 0x92, 0xf8,
+	0x0d, 0x14, 0xe9, 0x83, 0xf2, 0x75, 0xbe, 0x56, 0x35, 0x63, 0x62, 0x2e, 0xf8, 0x34, 0x74, 0xaf,
+	0x1a, 0x3a, 0xef, 0x69, 0x25, 0xce, 0xee, 0x65, 0xaf, 0x9d, 0x37, 0x71, 0x03, 0x57, 0x59, 0x57,
+	0x13, 0x29, 0x4f, 0x5a, 0x73, 0x36, 0xf8, 0xae, 0x35, 0x87, 0xb4, 0x27, 0xa5, 0x34, 0xd8, 0x46,
+	0x6d, 0xc5, 0xaa, 0x3f, 0x5a, 0xf3, 0xa0, 0xed, 0x8d, 0xa7, 0x94, 0xef, 0x4a, 0x70, 0xbc, 0xb9,
+	0x61, 0xe6, 0x8e, 0x1b, 0x70, 0x70, 0x15, 0x3b, 0x6e, 0x69, 0x95, 0x35, 0x9c, 0xc9, 0xa8, 0xe2,
+	0x01, 0x4f, 0x66, 0xd1, 0xd0, 0x7d, 0x71, 0xcd, 0xd9, 0x18, 0x2e, 0xe4, 0x13, 0x5f, 0x70, 0x36,
+	0xd0, 0x71, 0xe8, 0x75, 0xea, 0x36, 0xd6, 0x74, 0x06, 0x9a, 0x3d, 0x29, 0x7f, 0xcc, 0x17, 0x5b,
+	0x22, 0xb4, 0xd0, 0xc0, 0xb6, 0x56, 0xc1, 0xce, 0x1e, 0xc5, 0xd5, 0x19, 0x18, 0x7c, 0x6a, 0x98,
+	0xba, 0xf5, 0xb4, 0xe4, 0xe0, 0xb2, 0x65, 0xea, 0x0e, 0x09, 0xb0, 0xee, 0xe2, 0x00, 0x2d, 0x7d,
+	0x4c, 0x0b, 0x83, 0xb4, 0xae, 0x09, 0x23, 0x73, 0x2c, 0x82, 0x6e, 0xf7, 0xa9, 0x56, 0x67, 0x43,
+	0x94, 0xfc, 0xf6, 0xca, 0x1a, 0x5e, 0x19, 0x05, 0x45, 0x7e, 0xa3, 0x2b, 0xd0, 0xdb, 0xb0, 0xaa,
+	0x9b, 0x35, 0xcc, 0xce, 0x72, 0x52, 0x0f, 0x10, 0x58, 0x75, 0x74, 0x1b, 0xfa, 0x5d, 0xcb, 0xd5,
+	0xaa, 0x25, 0x02, 0x7d, 0xb8, 0x3b, 0x9b, 0x34, 0x10, 0x19, 0x02, 0x59, 0x79, 0xbf, 0x65, 0x82,
+	0x7c, 0xec, 0x9f, 0x4d, 0x64, 0x73, 0xf6, 0x49, 0xa0, 0x09, 0x67, 0x69, 0x1d, 0x1b, 0x95, 0x75,
+	0x97, 0x18, 0xd6, 0x55, 0xec, 0x27, 0x65, 0x6f, 0x90, 0xa2, 0x8e, 0xcd, 0xe6, 0x7f, 0x23, 0xc1,
+	0xc9, 0x04, 0xb0, 0xcc, 0xeb, 0xcb, 0xd0, 0x1f, 0x9c, 0xaf, 0xf0, 0x41, 0x3e, 0x11, 0x17, 0x92,
+	0x81, 0x86, 0x22, 0x2e, 0x5b, 0xb6, 0xce, 0x7c, 0x24, 0xaa, 0xe8, 0xdc, 0xb4, 0x5e, 0x09, 0x26,
+	0xe7, 0x85, 0x6a, 0x35, 0xc2, 0xd3, 0x9d, 0xf2, 0xd4, 0x0f, 0x25, 0x18, 0x8d, 0x6b, 0x69, 0xff,
+	0xbb, 0xe9, 0x5e, 0x10, 0x93, 0xa1, 0x76, 0xb1, 0x51, 0x77, 0xb9, 0xa7, 0x4e, 0xc1, 0x40, 0xd0,
+	0x76, 0x90, 0xe1, 0x1c, 0x0a, 0x0a, 0xef, 0xeb, 0xca, 0x3a, 0x9c, 0x4c, 0x50, 0xc4, 0x1c, 0x71,
+	0x07, 0x0e, 0xd8, 0xb4, 0x88, 0xcd, 0x7e, 0xbf, 0x94, 0xc9, 0x09, 0x44, 0x07, 0x97, 0x54, 0x7e,
+	0x2d, 0xc8, 0xc3, 0xde, 0xd4, 0x5c, 0xec, 0xb8, 0x0f, 0x17, 0xde, 0xe2, 0x50, 0x9b, 0xa6, 0x23,
+	0x29, 0x6d, 0x3a, 0x2a, 0xb4, 0x4c, 0xde, 0xcb, 0x30, 0x12, 0xa1, 0xdd, 0x3f, 0x25, 0xeb, 0x32,
+	0xb5, 0x46, 0x5a, 0x9e, 0x46, 0x24, 0xbc, 0x9e, 0x2b, 0x7a, 0xb5, 0x95, 0xe7, 0xc2, 0x56, 0xe6,
+	0xe1, 0xc2, 0x5b, 0x6f, 0x18, 0x8e, 0x6b, 0xd9, 0xdb, 0x1d, 0x43, 0xec, 0xed, 0x5d, 0x6b, 0x86,
+	0xc9, 0xe7, 0x84, 0x2e, 0x32, 0x27, 0xf4, 0xd5, 0x0c, 0x93, 0xcd, 0x08, 0xde, 0x6b, 0x6d, 0x8b,
+	0xbf, 0xee, 0x66, 0xaf, 0xb5, 0xad, 0x0e, 0x4f, 0x18, 0xdf, 0x11, 0xb6, 0xdd, 0xa2, 0x95, 0xcc,
+	0x73, 0xd7, 0xa1, 0xdb, 0xd4, 0x1a, 0xa9, 0x19, 0x9f, 0xef, 0x3a, 0xbe, 0x90, 0x7b, 0x42, 0x9d,
+	0x8b, 0xf6, 0xef, 0x17, 0xd8, 0x58, 0x7d, 0x6c, 0xd4, 0x36, 0xab, 0x9a, 0x8b, 0xc5, 0x30, 0xa3,
+	0xfd, 0xb1, 0x0a, 0xc7, 0xd8, 0x04, 0x4c, 0xc3, 0xbb, 0x64, 0xd3, 0x17, 0xac, 0xd3, 0xa7, 0xe3,
+	0x90, 0x3f, 0x70, 0x2a, 0xe2, 0x3c, 0xc9, 0x5d, 0x74, 0xb4, 0xd6, 0x5a, 0x88, 0xde, 0x82, 0x23,
+	0x6b, 0x46, 0xb5, 0xea, 0x65, 0x01, 0x8e, 0xaf, 0x9f, 0xae, 0xe7, 0x93, 0x09, 0xfa, 0x97, 0x8c,
+	0x6a, 0x75, 0xd1, 0xd0, 0xf9, 0x0c, 0x56, 0x3c, 0xbc, 0x16, 0x2e, 0xf0, 0xf5, 0x7a, 0xd9, 0x8f,
+	0xaf, 0xb7, 0x2b, 0x93, 0xde, 0x05, 0x67, 0x23, 0xac, 0x57, 0x28, 0x50, 0x7e, 0x5a, 0x80, 0xb1,
+	0x58, 0xb7, 0xb1, 0x0e, 0x1e, 0x82, 0x1e, 0x6c, 0xdb, 0x96, 0xcd, 0xcf, 0x55, 0xc8, 0x03, 0x5a,
+	0x84, 0x1e, 0x4f, 0x19, 0xcf, 0xe0, 0xce, 0xa6, 0x0f, 0x77, 0x62, 0x24, 0xed, 0x7c, 0x2a, 0x8a,
+	0x1e, 0x42, 0x9f, 0x6b, 0x6b, 0xa6, 0xb3, 0x86, 0x6d, 0xfe, 0x79, 0x61, 0x32, 0x5d, 0xcf, 0x0a,
+	0x13, 0x61, 0xba, 0x02, 0x15, 0xe8, 0x97, 0x01, 0xbc, 0x0f, 0x09, 0x86, 0x59, 0xdf, 0x74, 0xf9,
+	0x77, 0x84, 0xd8, 0x84, 0x7c, 0x81, 0xe6, 0xfa, 0x0b, 0x35, 0xef, 0x5f, 0xae, 0x6b, 0x0d, 0xe3,
+	0xfb, 0x44, 0x1a, 0xdd, 0x62, 0x61, 0xdd, 0x93, 0xac, 0xe5, 0x21, 0x3b, 0xc8, 0x21, 0x89, 0x80,
+	0x18, 0xda, 0x4a, 0x35, 0x98, 0x1b, 0xee, 0xf8, 0x9f, 0xe3, 0x78, 0x7f, 0xce, 0x36, 0x6d, 0x3d,
+	0x12, 0x4e, 0x0a, 0x79, 0xc5, 0x70, 0x02, 0x51, 0x08, 0x27, 0x10, 0xca, 0x1f, 0x0a, 0x83, 0x54,
+	0x6c, 0x8e, 0xf5, 0xe1, 0x36, 0xf4, 0x6a, 0x35, 0xd6, 0x5c, 0xca, 0xd7, 0x95, 0x25, 0xcf, 0x86,
+	0xf7, 0xff, 0x63, 0x6c, 0xa2, 0x62, 0xb8, 0xeb, 0x9b, 0xab, 0xd3, 0x65, 0xab, 0xc6, 0x3e, 0x7a,
+	0xb2, 0x3f, 0x53, 0x8e, 0xbe, 0xa1, 0x7a, 0xa7, 0x69, 0x0e, 0x11, 0x70, 0xfe, 0xe8, 0xe3, 0xef,
+	0x4d, 0x1e, 0xaa, 0xe2, 0x8a, 0x56, 0xde, 0x2e, 0x79, 0xdf, 0x33, 0x9d, 0x3f, 0xfb, 0xf8, 0x7b,
+	0x93, 0x52, 0x91, 0x35, 0xa8, 0xd4, 0x82, 0xe5, 0x83, 0xb9, 0x3c, 0xc0, 0xe7, 0xbc, 0x88, 0x3f,
+	0xc8, 0xb6, 0x25, 0x98, 0x38, 0xe9, 0x83, 0x52, 0x05, 0x25, 0xa9, 0x39, 0xe6, 0x8f, 0x25, 0xe8,
+	0x17, 0xbe, 0x91, 0xa6, 0x1d, 0x45, 0xd0, 0xe1, 0x4f, 0x23, 0xa5, 0x28, 0x0a, 0x2a, 0x5f, 0x6e,
+	0xc9, 0xfc, 0x22, 0x8c, 0xdb, 0xab, 0x4d, 0xfa, 0xc9, 0x04, 0x24, 0xcc, 0xee, 0x7b, 0x51, 0x76,
+	0x67, 0x1b, 0x22, 0x21, 0xc3, 0x3f, 0xa9, 0x6c, 0x2e, 0xc2, 0x7b, 0x9d, 0x72, 0xd0, 0x07, 0xe1,
+	0x6c, 0x2e, 0xca, 0x3b, 0x77, 0xa3, 0xbc, 0x13, 0xbb, 0x0f, 0x13, 0x86, 0xd9, 0x27, 0xe3, 0x9a,
+	0xc5, 0xe0, 0x64, 0x4d, 0x68, 0x0b, 0x3f, 0xd5, 0x6c, 0x7d, 0xd9, 0xb2, 0xaa, 0x59, 0xc2, 0xcb,
+	0xdb, 0x00, 0x9e, 0x4e, 0x56, 0xf2, 0xff, 0x3f, 0x43, 0xbc, 0x1d, 0x7c, 0x6b, 0x6c, 0x19, 0xb2,
+	0x14, 0xe9, 0x8b, 0xcc, 0x13, 0xca, 0xaf, 0xc3, 0x44, 0xba, 0x7a, 0xe6, 0x85, 0x9b, 0x5e, 0x1a,
+	0x4b, 0x8a, 0x72, 0xcd, 0x09, 0x5c, 0x48, 0xb9, 0x18, 0x7c, 0x41, 0xa6, 0x15, 0x32, 0x75, 0xd2,
+	0xef, 0xf2, 0x63, 0x05, 0x41, 0x8c, 0x01, 0xf2, 0x0c, 0xa6, 0x66, 0x65, 0x30, 0x98, 0x3e, 0xa2,
+	0xcb, 0xd0, 0x4b, 0x55, 0xb3, 0xcc, 0x63, 0x34, 0xd9, 0x86, 0x22, 0xab, 0xad, 0x2c, 0x04, 0x53,
+	0xe7, 0xe3, 0xf2, 0x3a, 0xd6, 0x37, 0xab, 0x58, 0xf7, 0x78, 0x0d, 0xa4, 0x7e, 0xa6, 0xd9, 0x4c,
+	0xf9, 0x8a, 0x70, 0x1a, 0x1c, 0xa9, 0x83, 0x99, 0x65, 0xc0, 0x31, 0x87, 0xbf, 0x26, 0x1f, 0xff,
+	0x29, 0x28, 0xee, 0x75, 0x35, 0x21, 0xa7, 0xb9, 0x67, 0x35, 0x1e, 0x68, 0xa6, 0x56, 0xc1, 0x4b,
+	0xd8, 0x07, 0xc5, 0x16, 0xde, 0xa3, 0x4e, 0x6b, 0x93, 0xca, 0xeb, 0x70, 0x36, 0xec, 0xdb, 0x85,
+	0x4d, 0x77, 0xdd, 0xb2, 0x0d, 0x77, 0x9b, 0x27, 0x12, 0x99, 0x2c, 0x7b, 0x26, 0x7c, 0x11, 0x8f,
+	0xd5, 0xc3, 0xac, 0xfb, 0x3c, 0x20, 0x8d, 0xbf, 0x2c, 0xf1, 0xf4, 0x84, 0xa5, 0x99, 0x6a, 0x4a,
+	0x40, 0xb5, 0x28, 0x3d, 0xa2, 0x35, 0x17, 0x29, 0x57, 0xd8, 0x3e, 0xe9, 0xf5, 0xad, 0xba, 0x65,
+	0xe7, 0x09, 0xb4, 0x77, 0x39, 0x67, 0x25, 0x2c, 0xc9, 0x60, 0x1f, 0x87, 0x5e, 0xb6, 0x97, 0x90,
+	0xc8, 0x5e, 0x82, 0x3d, 0x89, 0x31, 0x58, 0xc8, 0x1f, 0x83, 0x5d, 0x79, 0x62, 0x30, 0x3e, 0x30,
+	0xba, 0x3b, 0x1d, 0x18, 0xc2, 0xa7, 0xa6, 0x9e, 0x7c, 0x9f, 0x9a, 0x42, 0x8b, 0x44, 0x6f, 0xbb,
+	0x4b, 0xa8, 0x52, 0x0e, 0x7d, 0x07, 0xa3, 0x7e, 0xe8, 0xf8, 0xaa, 0xf7, 0x27, 0xe2, 0x37, 0x53,
+	0xa1, 0x15, 0xff, 0xd4, 0xf2, 0x00, 0x75, 0x3c, 0x1f, 0x79, 0xa7, 0x92, 0xfb, 0x69, 0xd1, 0x36,
+	0xf0, 0x5a, 0x91, 0xcb, 0x74, 0x6e, 0xa9, 0x1b, 0x02, 0x44, 0x8f, 0x00, 0x09, 0x89, 0x8c, 0xef,
+	0x4e, 0x1e, 0xc0, 0xd1, 0x50, 0x29, 0x03, 0x7d, 0x19, 0x7a, 0x29, 0xd9, 0x6c, 0x58, 0x4a, 0x8e,
+	0x2d, 0x26, 0xc7, 0x6a, 0x2b, 0x7f, 0xcd, 0x87, 0x70, 0x30, 0xff, 0x0b, 0xbb, 0x93, 0x30, 0xb7,
+	0xec, 0x73, 0x00, 0xc1, 0x21, 0x08, 0x6b, 0xe7, 0x6a, 0xea, 0x0e, 0xb1, 0x59, 0xb1, 0xdf, 0x23,
+	0x81, 0x2e, 0x74, 0x15, 0x86, 0x0d, 0xb3, 0x5c, 0xdd, 0xd4, 0x71, 0x69, 0xd5, 0xc6, 0xda, 0x86,
+	0x6e, 0x3d, 0x35, 0x4b, 0x6b, 0x06, 0xae, 0xea, 0x74, 0x78, 0x1d, 0x2c, 0x1e, 0x67, 0xef, 0x17,
+	0xf9, 0xeb, 0x25, 0xf2, 0x56, 0xf9, 0x79, 0x37, 0x5b, 0xc9, 0x12, 0xf1, 0x33, 0x27, 0x7d, 0x49,
+	0x82, 0x01, 0x8e, 0xd1, 0x1b, 0x48, 0xce, 0xde, 0xad, 0xeb, 0x87, 0x78, 0xbb, 0xde, 0x40, 0x44,
+	0xef, 0x48, 0xd0, 0x4f, 0x76, 0x64, 0x25, 0x72, 0x62, 0x3a, 0x5c, 0xd8, 0x2b, 0x18, 0x40, 0x5a,
+	0x5d, 0xf1, 0x1a, 0x45, 0xef, 0x4a, 0x70, 0xb8, 0x6c, 0x99, 0x0d, 0x6c, 0xbb, 0x58, 0x67, 0x40,
+	0xba, 0xf6, 0x0a, 0xc8, 0xa0, 0xdf, 0x32, 0x05, 0xb3, 0xc2, 0xb1, 0x38, 0x1e, 0x3b, 0x90, 0xec,
+	0x32, 0xbb, 0xf3, 0xef, 0x32, 0x07, 0x03, 0x1d, 0x0f, 0xbd, 0xa3, 0x94, 0x3b, 0x00, 0x2e, 0x25,
+	0xd2, 0x79, 0x07, 0x59, 0x3d, 0xe3, 0x52, 0x66, 0x85, 0xc5, 0x83, 0xae, 0xb5, 0x84, 0xf1, 0x43,
+	0xad, 0xa1, 0x3c, 0xe3, 0xfb, 0x99, 0xb7, 0xb4, 0xaa, 0xa1, 0x6b, 0x2e, 0xbe, 0x63, 0x63, 0xcd,
+	0xc5, 0xe1, 0x25, 0x06, 0xc3, 0x31, 0x42, 0x4f, 0xc4, 0x25, 0xb6, 0xd2, 0x84, 0x0f, 0x52, 0x66,
+	0x92, 0xe7, 0xe8, 0x08, 0x8d, 0xc5, 0xa3, 0xe5, 0xd6, 0x42, 0x65, 0x0d, 0x4e, 0x26, 0x40, 0x49,
+	0x3c, 0x9c, 0x38, 0x07, 0xa8, 0x62, 0x35, 0x3c, 0xc6, 0x6e, 0xbd, 0xf4, 0xd4, 0x3b, 0x37, 0xa9,
+	0x6b, 0x0e, 0x1f, 0x5d, 0x87, 0x2b, 0x56, 0x63, 0xd9, 0xb6, 0xea, 0x9f, 0x35, 0xaa, 0xd5, 0x65,
+	0xcd, 0x71, 0x94, 0x79, 0x90, 0x43, 0xed, 0xe4, 0x58, 0x4f, 0xe7, 0xe0, 0x44, 0xa4, 0x68, 0x12,
+	0x38, 0xe5, 0xb7, 0xf9, 0x46, 0x24, 0x90, 0x6a, 0x5a, 0xb5, 0x50, 0x09, 0x8e, 0xd6, 0x48, 0x21,
+	0x19, 0xb9, 0x4d, 0xfe, 0xcd, 0xbb, 0x06, 0x16, 0x8f, 0xd4, 0x9a, 0x8b, 0x14, 0x1d, 0xc6, 0x62,
+	0x21, 0x74, 0xce, 0xb3, 0x1b, 0x41, 0x5a, 0xbb, 0x4c, 0x89, 0xbf, 0xdc, 0xc0, 0x0b, 0xd0, 0xeb,
+	0x58, 0x9b, 0x76, 0x19, 0xa7, 0x66, 0xb5, 0xac, 0x5e, 0x3a, 0x19, 0x72, 0x05, 0x3e, 0xd5, 0xd2,
+	0x18, 0x33, 0x65, 0x1e, 0x0e, 0x30, 0xe2, 0x31, 0x73, 0xe1, 0x58, 0xfc, 0x8a, 0x41, 0x25, 0x79,
+	0x7d, 0xe5, 0x67, 0xc2, 0xb6, 0x9a, 0xbd, 0x74, 0x3e, 0x6b, 0xb8, 0xeb, 0x8f, 0x09, 0xaa, 0xf6,
+	0xcd, 0xb9, 0x01, 0xbd, 0x6b, 0x46, 0xd5, 0xf5, 0x89, 0xcb, 0x67, 0x52, 0x10, 0x2d, 0x91, 0xca,
+	0x45, 0x26, 0xd4, 0x49, 0xa2, 0xa4, 0x92, 0x64, 0x9e, 0x7f, 0xc6, 0x7b, 0x90, 0x39, 0x84, 0x2f,
+	0x23, 0xa9, 0x1e, 0xf4, 0x05, 0x3a, 0x97, 0x24, 0xc4, 0xf5, 0xc5, 0x8a, 0x66, 0x57, 0xb0, 0x18,
+	0x5a, 0x2e, 0x29, 0x48, 0xef, 0x0b, 0x5a, 0x6f, 0xbf, 0xf7, 0x05, 0x37, 0x6f, 0x5f, 0xf5, 0x85,
+	0x1e, 0x4a, 0x2b, 0x39, 0xdc, 0x4e, 0x67, 0xaf, 0xef, 0x89, 0x7c, 0x39, 0xb1, 0x99, 0x7d, 0xe5,
+	0x8b, 0xb7, 0xf9, 0xf7, 0x6b, 0x6d, 0x3b, 0x94, 0x89, 0x51, 0x5f, 0xdc, 0xca, 0x3b, 0xf9, 0xb0,
+	0xf5, 0xdd, 0x9f, 0x82, 0xde, 0xe3, 0xfc, 0xe0, 0x66, 0xfd, 0xcc, 0x09, 0xbf, 0x25, 0xd1, 0x63,
+	0x6f, 0xba, 0x86, 0xee, 0x5d, 0x9a, 0xe7, 0x1d, 0x96, 0xd3, 0x35, 0xd9, 0x87, 0xa0, 0x95, 0xcb,
+	0xb8, 0xee, 0x0e, 0x17, 0xf6, 0x12, 0xc2, 0x02, 0x69, 0x53, 0xf9, 0x55, 0x38, 0xd3, 0x74, 0xca,
+	0xb3, 0x50, 0x76, 0x8d, 0x86, 0xe1, 0x6e, 0x3f, 0xde, 0xac, 0xd5, 0xb4, 0xe0, 0xb3, 0x5c, 0x3b,
+	0x47, 0x48, 0xff, 0xdd, 0x05, 0x67, 0xd3, 0xb4, 0xfb, 0x34, 0xbf, 0x30, 0x81, 0xf1, 0x5c, 0xf2,
+	0x86, 0x8a, 0x52, 0xd9, 0x98, 0x12, 0x4e, 0x4c, 0x60, 0x3b, 0xcd, 0xa6, 0x43, 0xea, 0x42, 0x9b,
+	0x87, 0xd4, 0xe8, 0x09, 0x1c, 0xb1, 0x36, 0xdd, 0x8a, 0x65, 0x98, 0x95, 0x92, 0x3f, 0x5c, 0xba,
+	0x58, 0xbc, 0x27, 0xc7, 0x62, 0x13, 0xb2, 0x97, 0xb9, 0x1e, 0xfe, 0xda, 0xd3, 0x6d, 0x98, 0x65,
+	0xab, 0x16, 0xd2, 0xdd, 0xdd, 0x96, 0x6e, 0xae, 0xc7, 0xd7, 0xfd, 0x1b, 0x70, 0xc0, 0x32, 0x4b,
+	0xeb, 0x56, 0x55, 0x1f, 0xee, 0xd9, 0xab, 0x88, 0xea, 0xb5, 0xcc, 0x37, 0xac, 0xaa, 0x3e, 0xfb,
+	0xe3, 0xbb, 0xd0, 0x43, 0x7a, 0x1c, 0x7d, 0x5b, 0x82, 0x43, 0xe2, 0x15, 0x1f, 0x74, 0x21, 0xce,
+	0xae, 0xb8, 0x8b, 0x4a, 0xf2, 0x4c, 0x0e, 0x09, 0x1a, 0x46, 0xca, 0xe4, 0x3b, 0xff, 0xf4, 0x8b,
+	0x3f, 0x28, 0x9c, 0x46, 0x8a, 0x1a, 0x73, 0x45, 0xca, 0x4b, 0x0c, 0xe9, 0xc5, 0x2c, 0xf4, 0x75,
+	0x09, 0x0e, 0x72, 0x0e, 0x22, 0x3a, 0x9f, 0xd8, 0x56, 0xd3, 0x65, 0x18, 0x79, 0x2a, 0x63, 0x6d,
+	0x86, 0xea, 0x02, 0x41, 0x35, 0x89, 0x26, 0xd4, 0xa4, 0x9b, 0x62, 0xea, 0x0e, 0x67, 0x4c, 0xee,
+	0xa2, 0xaf, 0x15, 0x60, 0x28, 0xea, 0x7a, 0x0a, 0xba, 0x9a, 0xa9, 0xe5, 0x88, 0x3b, 0x33, 0xf2,
+	0x7c, 0x1b, 0x92, 0x0c, 0xff, 0xbb, 0x12, 0x31, 0xe0, 0x77, 0x24, 0x74, 0x2b, 0xd1, 0x02, 0x87,
+	0xdd, 0x8b, 0x53, 0x77, 0xfc, 0xdc, 0x7f, 0x57, 0xdd, 0x11, 0xf2, 0xcf, 0xdd, 0x27, 0xb7, 0xd1,
+	0x4d, 0x35, 0xf1, 0x4e, 0x5d, 0x48, 0x96, 0xf9, 0x45, 0xd4, 0x80, 0xfe, 0x47, 0x82, 0x13, 0x09,
+	0xf7, 0x53, 0xd0, 0xad, 0x4c, 0x76, 0xc6, 0x5f, 0xcc, 0x91, 0x6f, 0xb7, 0xaf, 0x80, 0xf9, 0xeb,
+	0x57, 0x88, 0xbb, 0x1e, 0xa1, 0x07, 0xf9, 0xbd, 0x45, 0x6f, 0xfa, 0xa8, 0x3b, 0xad, 0xb7, 0x7f,
+	0x76, 0xd1, 0x7f, 0x4a, 0x70, 0xb8, 0xe9, 0x82, 0x07, 0x9a, 0x4b, 0x03, 0x1b, 0x71, 0x35, 0x46,
+	0xbe, 0x98, 0x4f, 0x88, 0x59, 0x65, 0x12, 0xab, 0xd6, 0xd1, 0x4c, 0x6e, 0xab, 0x9e, 0xcc, 0xc5,
+	0x0b, 0xc5, 0xf5, 0xba, 0x83, 0x3e, 0x90, 0x60, 0x30, 0x7c, 0xa5, 0x02, 0xcd, 0xa6, 0x76, 0x4d,
+	0xcb, 0xdd, 0x12, 0x79, 0x2e, 0x97, 0x0c, 0xb3, 0xf5, 0x22, 0xb1, 0x75, 0x1a, 0x9d, 0x4f, 0xb1,
+	0x95, 0x5c, 0x47, 0x51, 0x77, 0xc8, 0x9f, 0x5d, 0x8e, 0x58, 0xb8, 0xa2, 0x90, 0x8e, 0xb8, 0xf5,
+	0x46, 0x86, 0x3c, 0x97, 0x4b, 0x26, 0x27, 0x62, 0x42, 0xa4, 0x51, 0x77, 0xc8, 0x9f, 0x5d, 0xf4,
+	0x0d, 0x09, 0x0e, 0x89, 0x17, 0x0a, 0x52, 0x66, 0xe9, 0x88, 0x0b, 0x0e, 0xf2, 0x4c, 0x0e, 0x09,
+	0x86, 0xf5, 0x2c, 0xc1, 0x3a, 0x8e, 0x46, 0x93, 0xb1, 0xa2, 0xbf, 0xa4, 0x01, 0x2f, 0x52, 0xda,
+	0xd3, 0x03, 0x3e, 0xe2, 0xfe, 0x81, 0x7c, 0x31, 0x9f, 0x10, 0x83, 0x79, 0x95, 0xc0, 0x9c, 0x45,
+	0x17, 0xe2, 0x60, 0x32, 0x5e, 0xfd, 0x54, 0xcb, 0xf4, 0xfd, 0xd5, 0x02, 0x1c, 0x8f, 0x26, 0xf6,
+	0xa3, 0x6b, 0xd9, 0xc6, 0x5e, 0xd4, 0xcd, 0x04, 0xf9, 0x7a, 0x5b, 0xb2, 0xcc, 0x9a, 0xdf, 0x24,
+	0xd6, 0x6c, 0xa1, 0xf9, 0x4c, 0xd6, 0x44, 0x0e, 0xe3, 0xeb, 0xf1, 0xc2, 0x11, 0xc3, 0x38, 0xac,
+	0x0f, 0xbd, 0x4f, 0x43, 0xcd, 0xa7, 0xb0, 0xa7, 0x87, 0x5a, 0xf3, 0xa5, 0x02, 0x79, 0x26, 0x87,
+	0x04, 0xb3, 0xfa, 0x12, 0xb1, 0x5a, 0x45, 0x53, 0x59, 0x97, 0x5e, 0xd5, 0x23, 0xe2, 0xa3, 0x77,
+	0x0a, 0x70, 0x34, 0x82, 0xb6, 0x8f, 0xae, 0xe4, 0x98, 0x39, 0xc5, 0x1b, 0x07, 0xf2, 0xd5, 0xfc,
+	0x82, 0xcc, 0x82, 0x2d, 0x62, 0x81, 0x8d, 0x2e, 0x27, 0x5a, 0x30, 0xe5, 0xc1, 0x8e, 0xec, 0xb4,
+	0xab, 0xf1, 0x92, 0x71, 0x73, 0x2f, 0x55, 0x86, 0x7e, 0x20, 0xc1, 0xcb, 0xcd, 0x54, 0x7e, 0x94,
+	0x3a, 0x94, 0xa2, 0xee, 0x0c, 0xc8, 0x97, 0x72, 0x4a, 0x31, 0xdb, 0xe7, 0x89, 0xed, 0x09, 0xab,
+	0x87, 0x8e, 0x35, 0x7d, 0xaa, 0xa6, 0x99, 0x53, 0xf4, 0x36, 0x81, 0xba, 0xc3, 0xb6, 0x1e, 0xbb,
+	0xe8, 0xcf, 0x25, 0x18, 0x0c, 0x53, 0xf0, 0x53, 0xe6, 0xe2, 0xc8, 0x3b, 0x04, 0xf2, 0x5c, 0x2e,
+	0x99, 0xac, 0x13, 0x47, 0x84, 0xe3, 0xc9, 0xed, 0x01, 0xf4, 0x1d, 0x09, 0xfa, 0x7c, 0x92, 0x3c,
+	0x4a, 0x4e, 0x33, 0x9b, 0x59, 0xfc, 0xf2, 0x74, 0xd6, 0xea, 0x0c, 0xe6, 0x65, 0x02, 0xf3, 0x02,
+	0x9a, 0xce, 0x33, 0xa8, 0xad, 0xba, 0xe7, 0xda, 0x81, 0x10, 0xe9, 0x1c, 0x25, 0x0f, 0xcc, 0x28,
+	0x12, 0xbd, 0x3c, 0x9b, 0x47, 0x84, 0x01, 0xbe, 0x4e, 0x00, 0x5f, 0x42, 0x73, 0x39, 0x00, 0x6b,
+	0x1c, 0xe3, 0x8f, 0x25, 0x18, 0xf2, 0xc7, 0x99, 0x40, 0x4a, 0x46, 0x19, 0x87, 0x66, 0x2b, 0x63,
+	0x5a, 0x9e, 0x6f, 0x43, 0x92, 0x99, 0x72, 0x93, 0x98, 0x92, 0x6f, 0x6c, 0x8a, 0x7c, 0xe7, 0x0f,
+	0x24, 0x38, 0xd2, 0xc2, 0xaf, 0x46, 0x97, 0x32, 0xac, 0xc5, 0x11, 0x76, 0x5c, 0xce, 0x2b, 0xc6,
+	0x8c, 0x38, 0x47, 0x8c, 0x38, 0x83, 0x4e, 0xc5, 0x19, 0x21, 0x22, 0xfe, 0x7b, 0xea, 0xff, 0x16,
+	0x1e, 0x73, 0xba, 0xff, 0xe3, 0x78, 0xd8, 0xf2, 0x7c, 0x1b, 0x92, 0x0c, 0xfa, 0x35, 0x02, 0xfd,
+	0x22, 0x9a, 0x4d, 0x87, 0xae, 0xee, 0x84, 0xc8, 0xde, 0xbb, 0x64, 0x6b, 0x2b, 0xb2, 0xa1, 0xd3,
+	0x57, 0xb2, 0x66, 0x5a, 0xb6, 0x3c, 0x93, 0x43, 0x22, 0xeb, 0xd6, 0xd6, 0xd4, 0x1a, 0x6a, 0x95,
+	0x88, 0xa1, 0xf7, 0x24, 0x18, 0x08, 0xd1, 0x8e, 0x51, 0x6a, 0x83, 0x2d, 0x44, 0x6c, 0x79, 0x36,
+	0x8f, 0x48, 0xd6, 0x88, 0xf0, 0x40, 0xae, 0x33, 0x4c, 0x3f, 0x90, 0x00, 0xb5, 0x12, 0x68, 0x51,
+	0x72, 0x34, 0xc6, 0x12, 0x95, 0xe5, 0x2b, 0xb9, 0xe5, 0x18, 0xe8, 0x39, 0x02, 0x7a, 0x0a, 0x9d,
+	0x8b, 0x8d, 0x05, 0x26, 0x2b, 0x04, 0x05, 0xfa, 0x11, 0x75, 0x71, 0xf0, 0x4d, 0x39, 0xdd, 0xc5,
+	0x2d, 0x7c, 0x56, 0x79, 0x36, 0x8f, 0x08, 0x43, 0x7b, 0x8f, 0xa0, 0x5d, 0x88, 0xdf, 0x8a, 0x47,
+	0xcc, 0x1c, 0xc1, 0xb1, 0x96, 0xb0, 0x42, 0xfe, 0x83, 0x04, 0xc7, 0x22, 0xe9, 0x9e, 0x28, 0x75,
+	0x5c, 0xc5, 0x32, 0x52, 0xe5, 0x6b, 0xed, 0x88, 0x32, 0xcb, 0x6e, 0x10, 0xcb, 0xae, 0xa0, 0x4b,
+	0x6a, 0xfa, 0xff, 0xcf, 0xa3, 0x32, 0x33, 0x04, 0x7b, 0x7e, 0xaf, 0x20, 0x4c, 0xf0, 0xa2, 0x39,
+	0x19, 0x27, 0xf8, 0x08, 0x6b, 0xe6, 0xdb, 0x90, 0xcc, 0x9a, 0xb6, 0x89, 0xc6, 0xbc, 0x70, 0xda,
+	0x26, 0x28, 0x13, 0x96, 0x06, 0xd1, 0x09, 0x59, 0x96, 0x86, 0x08, 0x0f, 0x5c, 0xce, 0x2b, 0x96,
+	0x75, 0x22, 0x10, 0x11, 0xff, 0x9b, 0x04, 0x9f, 0x8a, 0x21, 0x5a, 0xa2, 0xeb, 0x79, 0x86, 0x48,
+	0x13, 0xc7, 0x53, 0xfe, 0x74, 0x7b, 0xc2, 0xcc, 0x86, 0xd7, 0x89, 0x0d, 0xb7, 0xd0, 0x8d, 0xb6,
+	0x3a, 0x62, 0x8a, 0x91, 0x1b, 0xd1, 0xc7, 0xf4, 0xc0, 0x2a, 0x8e, 0x44, 0x99, 0x7e, 0x60, 0x95,
+	0xc2, 0xee, 0x94, 0x6f, 0xb7, 0xaf, 0x20, 0xab, 0xa5, 0x89, 0x23, 0x4f, 0xe5, 0x96, 0x7e, 0x4b,
+	0x82, 0x3e, 0x7f, 0x50, 0xa0, 0xa9, 0x6c, 0x83, 0x27, 0x5b, 0xf6, 0xda, 0x42, 0xf1, 0x54, 0x66,
+	0x09, 0xe6, 0xf3, 0x68, 0x32, 0x7b, 0xef, 0xa0, 0x7f, 0x96, 0xe0, 0x78, 0x34, 0xc5, 0x32, 0x7d,
+	0x5f, 0x1e, 0xcf, 0xed, 0x94, 0xaf, 0xb7, 0x25, 0xcb, 0xec, 0x58, 0x20, 0x76, 0xe4, 0xdb, 0x5a,
+	0xfb, 0xbc, 0xbc, 0x29, 0xef, 0x3c, 0x1b, 0xfd, 0x42, 0x02, 0x39, 0x9e, 0x5f, 0x89, 0x6e, 0x66,
+	0xf3, 0x6c, 0x1c, 0xc1, 0x53, 0xbe, 0xd5, 0xb6, 0xfc, 0x0b, 0x0c, 0x24, 0x9f, 0xbe, 0x39, 0xc5,
+	0x99, 0xa0, 0xe8, 0xbb, 0x12, 0x1c, 0x12, 0x19, 0x98, 0x29, 0x79, 0x57, 0x04, 0xcd, 0x53, 0x9e,
+	0xc9, 0x21, 0x91, 0x75, 0x0f, 0x1a, 0x01, 0x1e, 0x13, 0x45, 0xe8, 0xdb, 0x34, 0x47, 0x08, 0x08,
+	0x84, 0x28, 0xcb, 0x61, 0x59, 0x98, 0xd2, 0x28, 0xcf, 0xe6, 0x11, 0x61, 0x98, 0x5f, 0x23, 0x98,
+	0x4f, 0xa2, 0xb1, 0x64, 0xcc, 0x0e, 0x7a, 0x26, 0x41, 0x2f, 0xa5, 0xfb, 0xa1, 0xc9, 0xe4, 0x0d,
+	0x99, 0xc8, 0x30, 0x94, 0xcf, 0x65, 0xaa, 0x9b, 0xf5, 0xb4, 0x8f, 0xf2, 0x0c, 0xd1, 0xcf, 0x24,
+	0x38, 0x91, 0x40, 0xd1, 0x4b, 0x99, 0x27, 0xd3, 0xc9, 0x89, 0xf2, 0xed, 0xf6, 0x15, 0x64, 0xdd,
+	0x35, 0x90, 0xcf, 0x4b, 0xc1, 0x64, 0x59, 0x12, 0x12, 0xc6, 0xbf, 0x93, 0x60, 0x28, 0x8a, 0x93,
+	0x95, 0x92, 0x9e, 0x24, 0x30, 0xca, 0xe4, 0xf9, 0x36, 0x24, 0xb3, 0xee, 0xfd, 0x1b, 0x4c, 0x5a,
+	0x0d, 0x71, 0xd6, 0xd0, 0x7f, 0x49, 0x30, 0x18, 0xa6, 0x6d, 0xa5, 0x1c, 0xab, 0x44, 0xd2, 0xc3,
+	0xe4, 0xb9, 0x5c, 0x32, 0x0c, 0xb3, 0x4d, 0x30, 0x57, 0xd1, 0x5c, 0x2a, 0xe6, 0x88, 0x7c, 0x2a,
+	0xdf, 0xa9, 0x01, 0xd7, 0x84, 0x7e, 0x28, 0x01, 0x6a, 0x65, 0x7b, 0xa5, 0xec, 0x51, 0x62, 0x19,
+	0x6a, 0xf2, 0x95, 0xdc, 0x72, 0x59, 0x8f, 0xf7, 0x05, 0xdb, 0x7d, 0x06, 0x1c, 0xfa, 0x5f, 0x09,
+	0x20, 0xa0, 0xc5, 0xa0, 0xd4, 0x25, 0x36, 0x4c, 0x37, 0x93, 0xd5, 0xcc, 0xf5, 0x19, 0xca, 0xdf,
+	0xa7, 0x1f, 0x0a, 0xbf, 0x2c, 0x3d, 0x49, 0xf8, 0xd8, 0xc9, 0xbe, 0x77, 0xab, 0x3b, 0x94, 0xd3,
+	0x95, 0x98, 0xea, 0x36, 0xd7, 0x6d, 0xfa, 0x16, 0x38, 0x96, 0x22, 0x87, 0x3e, 0xa4, 0x7b, 0x9c,
+	0x56, 0x8e, 0x56, 0xfa, 0x1e, 0x27, 0x96, 0xb6, 0x26, 0x5f, 0x6b, 0x47, 0x34, 0xeb, 0xd1, 0x20,
+	0x43, 0xee, 0xa8, 0xd4, 0x62, 0xdf, 0xf2, 0x28, 0x53, 0x28, 0xc5, 0x29, 0x9f, 0x29, 0x21, 0xd6,
+	0x97, 0x7c, 0xad, 0x1d, 0xd1, 0xdc, 0xa6, 0x50, 0xc2, 0x98, 0xba, 0x43, 0xff, 0xee, 0xa2, 0xf7,
+	0xd8, 0x77, 0xb2, 0x80, 0x9a, 0x84, 0xb2, 0xac, 0x72, 0x4d, 0x74, 0x29, 0x79, 0x2e, 0x97, 0x0c,
+	0x43, 0x3d, 0x41, 0x50, 0x2b, 0x68, 0x3c, 0x0d, 0x35, 0xfa, 0x53, 0x09, 0x06, 0xc3, 0xdc, 0xa1,
+	0x14, 0x94, 0x91, 0x44, 0x26, 0x79, 0x2e, 0x97, 0x0c, 0x43, 0x79, 0x9e, 0xa0, 0x3c, 0x8b, 0x4e,
+	0x27, 0x2e, 0x34, 0x3c, 0xca, 0xff, 0x45, 0x82, 0x91, 0x58, 0x8a, 0x0d, 0xba, 0x91, 0x71, 0x7b,
+	0x10, 0x4d, 0xfc, 0x91, 0x6f, 0xb6, 0x2b, 0x9e, 0x35, 0x7f, 0x6a, 0xdd, 0x4f, 0x38, 0x8c, 0xe1,
+	0x82, 0x3f, 0xfc, 0x68, 0x54, 0xfa, 0xc9, 0x47, 0xa3, 0xd2, 0xcf, 0x3f, 0x1a, 0x95, 0xbe, 0xf2,
+	0x7c, 0xf4, 0xa5, 0x9f, 0x3c, 0x1f, 0x7d, 0xe9, 0xa7, 0xcf, 0x47, 0x5f, 0x82, 0x11, 0xc3, 0x8a,
+	0x81, 0xb5, 0x2c, 0x3d, 0x99, 0x16, 0xc8, 0x2c, 0x41, 0xa5, 0x29, 0xc3, 0x12, 0x11, 0x6c, 0xf9,
+	0x18, 0x56, 0x7b, 0xc9, 0x7f, 0x9a, 0x3b, 0xf7, 0x7f, 0x03, 0x00, 0xed, 0x11, 0xb5, 0x11, 0x01,
+	0x59, 0x00, 0x00,
 }
 
-func (m *QueryValidateManageFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GovPropWillPass {
-		i--
-		if m.GovPropWillPass {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// OrderFeeCalc calculates the fees that will be associated with the provided order.
+	OrderFeeCalc(ctx context.Context, in *QueryOrderFeeCalcRequest, opts ...grpc.CallOption) (*QueryOrderFeeCalcResponse, error)
+	// GetOrder looks up an order by id.
+	GetOrder(ctx context.Context, in *QueryGetOrderRequest, opts ...grpc.CallOption) (*QueryGetOrderResponse, error)
+	// GetOrderByExternalID looks up an order by market id and external id.
+	GetOrderByExternalID(ctx context.Context, in *QueryGetOrderByExternalIDRequest, opts ...grpc.CallOption) (*QueryGetOrderByExternalIDResponse, error)
+	// GetOrdersByExternalIDPrefix looks up the orders in a market that have an external id starting with a given prefix.
+	// Results are ordered by external id.
+	GetOrdersByExternalIDPrefix(ctx context.Context, in *QueryGetOrdersByExternalIDPrefixRequest, opts ...grpc.CallOption) (*QueryGetOrdersByExternalIDPrefixResponse, error)
+	// GetMarketOrders looks up the orders in a market.
+	GetMarketOrders(ctx context.Context, in *QueryGetMarketOrdersRequest, opts ...grpc.CallOption) (*QueryGetMarketOrdersResponse, error)
+	// GetOwnerOrders looks up the orders from the provided owner address.
+	GetOwnerOrders(ctx context.Context, in *QueryGetOwnerOrdersRequest, opts ...grpc.CallOption) (*QueryGetOwnerOrdersResponse, error)
+	// GetAssetOrders looks up the orders for a specific asset denom.
+	GetAssetOrders(ctx context.Context, in *QueryGetAssetOrdersRequest, opts ...grpc.CallOption) (*QueryGetAssetOrdersResponse, error)
+	// GetAllOrders gets all orders in the exchange module.
+	GetAllOrders(ctx context.Context, in *QueryGetAllOrdersRequest, opts ...grpc.CallOption) (*QueryGetAllOrdersResponse, error)
+	// GetTriggerOrder looks up a pending trigger order by id.
+	GetTriggerOrder(ctx context.Context, in *QueryGetTriggerOrderRequest, opts ...grpc.CallOption) (*QueryGetTriggerOrderResponse, error)
+	// GetMarketTriggerOrders looks up the pending trigger orders in a market.
+	GetMarketTriggerOrders(ctx context.Context, in *QueryGetMarketTriggerOrdersRequest, opts ...grpc.CallOption) (*QueryGetMarketTriggerOrdersResponse, error)
+	// GetOrderLink looks up the order that an order is linked to.
+	GetOrderLink(ctx context.Context, in *QueryGetOrderLinkRequest, opts ...grpc.CallOption) (*QueryGetOrderLinkResponse, error)
+	// GetMarketOrderLinks looks up the linked order pairs in a market.
+	GetMarketOrderLinks(ctx context.Context, in *QueryGetMarketOrderLinksRequest, opts ...grpc.CallOption) (*QueryGetMarketOrderLinksResponse, error)
+	// GetDeadManSwitch looks up an account's dead-man switch.
+	GetDeadManSwitch(ctx context.Context, in *QueryGetDeadManSwitchRequest, opts ...grpc.CallOption) (*QueryGetDeadManSwitchResponse, error)
+	// OrderBookDepth gets the orders for an asset and price denom pair in a market, aggregated by unit price.
+	OrderBookDepth(ctx context.Context, in *QueryOrderBookDepthRequest, opts ...grpc.CallOption) (*QueryOrderBookDepthResponse, error)
+	// TopOfBook gets the best bid and ask prices for an asset and price denom pair in a market.
+	TopOfBook(ctx context.Context, in *QueryTopOfBookRequest, opts ...grpc.CallOption) (*QueryTopOfBookResponse, error)
+	// PriceAverages gets the time-weighted and volume-weighted average prices of recent settlements
+	// for an asset and price denom pair in a market.
+	PriceAverages(ctx context.Context, in *QueryPriceAveragesRequest, opts ...grpc.CallOption) (*QueryPriceAveragesResponse, error)
+	// GetMarketSettlements gets the recorded settlements in a market.
+	GetMarketSettlements(ctx context.Context, in *QueryGetMarketSettlementsRequest, opts ...grpc.CallOption) (*QueryGetMarketSettlementsResponse, error)
+	// GetAllSettlements gets all the recorded settlements.
+	GetAllSettlements(ctx context.Context, in *QueryGetAllSettlementsRequest, opts ...grpc.CallOption) (*QueryGetAllSettlementsResponse, error)
+	// GetSettlementReceipt gets the receipt of a settlement.
+	GetSettlementReceipt(ctx context.Context, in *QueryGetSettlementReceiptRequest, opts ...grpc.CallOption) (*QueryGetSettlementReceiptResponse, error)
+	// GetLatestNAV gets the most recent NAV record for an asset and price denom pair.
+	GetLatestNAV(ctx context.Context, in *QueryGetLatestNAVRequest, opts ...grpc.CallOption) (*QueryGetLatestNAVResponse, error)
+	// GetNAVHistory gets the NAV records for an asset and price denom pair, optionally limited to a range of heights.
+	GetNAVHistory(ctx context.Context, in *QueryGetNAVHistoryRequest, opts ...grpc.CallOption) (*QueryGetNAVHistoryResponse, error)
+	// SimulateSettlement runs a market settle, fill bids, or fill asks request without committing any of the changes.
+	// It returns the transfers, fees, and net asset prices that would result from the request.
+	SimulateSettlement(ctx context.Context, in *QuerySimulateSettlementRequest, opts ...grpc.CallOption) (*QuerySimulateSettlementResponse, error)
+	// GetCommitment gets the funds in an account that are committed to the market.
+	GetCommitment(ctx context.Context, in *QueryGetCommitmentRequest, opts ...grpc.CallOption) (*QueryGetCommitmentResponse, error)
+	// GetAccountCommitments gets all the funds in an account that are committed to any market.
+	// Optionally, you can filter the results for a specific denomination using the `denom` query parameter.
+	GetAccountCommitments(ctx context.Context, in *QueryGetAccountCommitmentsRequest, opts ...grpc.CallOption) (*QueryGetAccountCommitmentsResponse, error)
+	// GetMarketCommitments gets all the funds committed to a market from any account.
+	GetMarketCommitments(ctx context.Context, in *QueryGetMarketCommitmentsRequest, opts ...grpc.CallOption) (*QueryGetMarketCommitmentsResponse, error)
+	// GetAllCommitments gets all fund committed to any market from any account.
+	GetAllCommitments(ctx context.Context, in *QueryGetAllCommitmentsRequest, opts ...grpc.CallOption) (*QueryGetAllCommitmentsResponse, error)
+	// GetCommitmentRewardPool gets the undistributed funds in a market's commitment reward pool.
+	GetCommitmentRewardPool(ctx context.Context, in *QueryGetCommitmentRewardPoolRequest, opts ...grpc.CallOption) (*QueryGetCommitmentRewardPoolResponse, error)
+	// GetAccountCommitmentRewards gets the commitment rewards that an account can claim from each market.
+	GetAccountCommitmentRewards(ctx context.Context, in *QueryGetAccountCommitmentRewardsRequest, opts ...grpc.CallOption) (*QueryGetAccountCommitmentRewardsResponse, error)
+	// GetMarket returns all the information and details about a market.
+	GetMarket(ctx context.Context, in *QueryGetMarketRequest, opts ...grpc.CallOption) (*QueryGetMarketResponse, error)
+	// GetScheduledFeeChanges returns the fee changes that are waiting to be applied to a market.
+	GetScheduledFeeChanges(ctx context.Context, in *QueryGetScheduledFeeChangesRequest, opts ...grpc.CallOption) (*QueryGetScheduledFeeChangesResponse, error)
+	// GetMarketAuthorityTransfer returns the authority transfer waiting to be accepted for a market.
+	GetMarketAuthorityTransfer(ctx context.Context, in *QueryGetMarketAuthorityTransferRequest, opts ...grpc.CallOption) (*QueryGetMarketAuthorityTransferResponse, error)
+	// ExportMarket returns a snapshot of a market's setup, fees, orders, and commitments.
+	ExportMarket(ctx context.Context, in *QueryExportMarketRequest, opts ...grpc.CallOption) (*QueryExportMarketResponse, error)
+	// GetAllMarkets returns brief information about each market.
+	GetAllMarkets(ctx context.Context, in *QueryGetAllMarketsRequest, opts ...grpc.CallOption) (*QueryGetAllMarketsResponse, error)
+	// Params returns the exchange module parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// CommitmentSettlementFeeCalc calculates the fees a market will pay for a commitment settlement using current NAVs.
+	CommitmentSettlementFeeCalc(ctx context.Context, in *QueryCommitmentSettlementFeeCalcRequest, opts ...grpc.CallOption) (*QueryCommitmentSettlementFeeCalcResponse, error)
+	// ValidateCreateMarket checks the provided MsgGovCreateMarketResponse and returns any errors it might have.
+	ValidateCreateMarket(ctx context.Context, in *QueryValidateCreateMarketRequest, opts ...grpc.CallOption) (*QueryValidateCreateMarketResponse, error)
+	// ValidateMarket checks for any problems with a market's setup.
+	ValidateMarket(ctx context.Context, in *QueryValidateMarketRequest, opts ...grpc.CallOption) (*QueryValidateMarketResponse, error)
+	// ValidateManageFees checks the provided MsgGovManageFeesRequest and returns any errors that it might have.
+	ValidateManageFees(ctx context.Context, in *QueryValidateManageFeesRequest, opts ...grpc.CallOption) (*QueryValidateManageFeesResponse, error)
+	// GetPayment gets a single specific payment.
+	GetPayment(ctx context.Context, in *QueryGetPaymentRequest, opts ...grpc.CallOption) (*QueryGetPaymentResponse, error)
+	// GetPaymentsWithSource gets all payments with a specific source account.
+	GetPaymentsWithSource(ctx context.Context, in *QueryGetPaymentsWithSourceRequest, opts ...grpc.CallOption) (*QueryGetPaymentsWithSourceResponse, error)
+	// GetPaymentsWithTarget gets all payments with a specific target account.
+	GetPaymentsWithTarget(ctx context.Context, in *QueryGetPaymentsWithTargetRequest, opts ...grpc.CallOption) (*QueryGetPaymentsWithTargetResponse, error)
+	// GetAllPayments gets all payments.
+	GetAllPayments(ctx context.Context, in *QueryGetAllPaymentsRequest, opts ...grpc.CallOption) (*QueryGetAllPaymentsResponse, error)
+	// PaymentFeeCalc calculates the fees that must be paid for creating or accepting a specific payment.
+	PaymentFeeCalc(ctx context.Context, in *QueryPaymentFeeCalcRequest, opts ...grpc.CallOption) (*QueryPaymentFeeCalcResponse, error)
+	// GetAccountActivitySummary gets the totals of an account's open orders, commitments, and pending payments,
+	// as well as the funds the exchange has on hold for them.
+	GetAccountActivitySummary(ctx context.Context, in *QueryGetAccountActivitySummaryRequest, opts ...grpc.CallOption) (*QueryGetAccountActivitySummaryResponse, error)
 }
 
-func (m *QueryGetPaymentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) OrderFeeCalc(ctx context.Context, in *QueryOrderFeeCalcRequest, opts ...grpc.CallOption) (*QueryOrderFeeCalcResponse, error) {
+	out := new(QueryOrderFeeCalcResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/OrderFeeCalc", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetPaymentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetOrder(ctx context.Context, in *QueryGetOrderRequest, opts ...grpc.CallOption) (*QueryGetOrderResponse, error) {
+	out := new(QueryGetOrderResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetPaymentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExternalId) > 0 {
-		i -= len(m.ExternalId)
-		copy(dAtA[i:], m.ExternalId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExternalId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetOrderByExternalID(ctx context.Context, in *QueryGetOrderByExternalIDRequest, opts ...grpc.CallOption) (*QueryGetOrderByExternalIDResponse, error) {
+	out := new(QueryGetOrderByExternalIDResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetOrderByExternalID", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetPaymentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetOrdersByExternalIDPrefix(ctx context.Context, in *QueryGetOrdersByExternalIDPrefixRequest, opts ...grpc.CallOption) (*QueryGetOrdersByExternalIDPrefixResponse, error) {
+	out := new(QueryGetOrdersByExternalIDPrefixResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetOrdersByExternalIDPrefix", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetPaymentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetMarketOrders(ctx context.Context, in *QueryGetMarketOrdersRequest, opts ...grpc.CallOption) (*QueryGetMarketOrdersResponse, error) {
+	out := new(QueryGetMarketOrdersResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetMarketOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetPaymentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Payment != nil {
-		{
-			size, err := m.Payment.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetOwnerOrders(ctx context.Context, in *QueryGetOwnerOrdersRequest, opts ...grpc.CallOption) (*QueryGetOwnerOrdersResponse, error) {
+	out := new(QueryGetOwnerOrdersResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetOwnerOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetPaymentsWithSourceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetAssetOrders(ctx context.Context, in *QueryGetAssetOrdersRequest, opts ...grpc.CallOption) (*QueryGetAssetOrdersResponse, error) {
+	out := new(QueryGetAssetOrdersResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetAssetOrders", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetPaymentsWithSourceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+func (c *queryClient) GetAllOrders(ctx context.Context, in *QueryGetAllOrdersRequest, opts ...grpc.CallOption) (*QueryGetAllOrdersResponse, error) {
+	out := new(QueryGetAllOrdersResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetAllOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (m *QueryGetPaymentsWithSourceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+func (c *queryClient) GetTriggerOrder(ctx context.Context, in *QueryGetTriggerOrderRequest, opts ...grpc.CallOption) (*QueryGetTriggerOrderResponse, error) {
+	out := new(QueryGetTriggerOrderResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetTriggerOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *queryClient) GetMarketTriggerOrders(ctx context.Context, in *QueryGetMarketTriggerOrdersRequest, opts ...grpc.CallOption) (*QueryGetMarketTriggerOrdersResponse, error) {
+	out := new(QueryGetMarketTriggerOrdersResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetMarketTriggerOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetPaymentsWithSourceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetOrderLink(ctx context.Context, in *QueryGetOrderLinkRequest, opts ...grpc.CallOption) (*QueryGetOrderLinkResponse, error) {
+	out := new(QueryGetOrderLinkResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetOrderLink", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetPaymentsWithSourceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetMarketOrderLinks(ctx context.Context, in *QueryGetMarketOrderLinksRequest, opts ...grpc.CallOption) (*QueryGetMarketOrderLinksResponse, error) {
+	out := new(QueryGetMarketOrderLinksResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetMarketOrderLinks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetPaymentsWithSourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+func (c *queryClient) GetDeadManSwitch(ctx context.Context, in *QueryGetDeadManSwitchRequest, opts ...grpc.CallOption) (*QueryGetDeadManSwitchResponse, error) {
+	out := new(QueryGetDeadManSwitchResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetDeadManSwitch", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Payments) > 0 {
-		for iNdEx := len(m.Payments) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Payments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return out, nil
+}
+
+func (c *queryClient) OrderBookDepth(ctx context.Context, in *QueryOrderBookDepthRequest, opts ...grpc.CallOption) (*QueryOrderBookDepthResponse, error) {
+	out := new(QueryOrderBookDepthResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/OrderBookDepth", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetPaymentsWithTargetRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TopOfBook(ctx context.Context, in *QueryTopOfBookRequest, opts ...grpc.CallOption) (*QueryTopOfBookResponse, error) {
+	out := new(QueryTopOfBookResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/TopOfBook", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetPaymentsWithTargetRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PriceAverages(ctx context.Context, in *QueryPriceAveragesRequest, opts ...grpc.CallOption) (*QueryPriceAveragesResponse, error) {
+	out := new(QueryPriceAveragesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/PriceAverages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetPaymentsWithTargetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+func (c *queryClient) GetMarketSettlements(ctx context.Context, in *QueryGetMarketSettlementsRequest, opts ...grpc.CallOption) (*QueryGetMarketSettlementsResponse, error) {
+	out := new(QueryGetMarketSettlementsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetMarketSettlements", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Target) > 0 {
-		i -= len(m.Target)
-		copy(dAtA[i:], m.Target)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Target)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *queryClient) GetAllSettlements(ctx context.Context, in *QueryGetAllSettlementsRequest, opts ...grpc.CallOption) (*QueryGetAllSettlementsResponse, error) {
+	out := new(QueryGetAllSettlementsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetAllSettlements", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetPaymentsWithTargetResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetSettlementReceipt(ctx context.Context, in *QueryGetSettlementReceiptRequest, opts ...grpc.CallOption) (*QueryGetSettlementReceiptResponse, error) {
+	out := new(QueryGetSettlementReceiptResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetSettlementReceipt", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetPaymentsWithTargetResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetLatestNAV(ctx context.Context, in *QueryGetLatestNAVRequest, opts ...grpc.CallOption) (*QueryGetLatestNAVResponse, error) {
+	out := new(QueryGetLatestNAVResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetLatestNAV", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetPaymentsWithTargetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+func (c *queryClient) GetNAVHistory(ctx context.Context, in *QueryGetNAVHistoryRequest, opts ...grpc.CallOption) (*QueryGetNAVHistoryResponse, error) {
+	out := new(QueryGetNAVHistoryResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetNAVHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Payments) > 0 {
-		for iNdEx := len(m.Payments) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Payments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return out, nil
+}
+
+func (c *queryClient) SimulateSettlement(ctx context.Context, in *QuerySimulateSettlementRequest, opts ...grpc.CallOption) (*QuerySimulateSettlementResponse, error) {
+	out := new(QuerySimulateSettlementResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/SimulateSettlement", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetAllPaymentsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetCommitment(ctx context.Context, in *QueryGetCommitmentRequest, opts ...grpc.CallOption) (*QueryGetCommitmentResponse, error) {
+	out := new(QueryGetCommitmentResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetCommitment", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetAllPaymentsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetAccountCommitments(ctx context.Context, in *QueryGetAccountCommitmentsRequest, opts ...grpc.CallOption) (*QueryGetAccountCommitmentsResponse, error) {
+	out := new(QueryGetAccountCommitmentsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetAccountCommitments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetAllPaymentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+func (c *queryClient) GetMarketCommitments(ctx context.Context, in *QueryGetMarketCommitmentsRequest, opts ...grpc.CallOption) (*QueryGetMarketCommitmentsResponse, error) {
+	out := new(QueryGetMarketCommitmentsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetMarketCommitments", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetAllPaymentsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetAllCommitments(ctx context.Context, in *QueryGetAllCommitmentsRequest, opts ...grpc.CallOption) (*QueryGetAllCommitmentsResponse, error) {
+	out := new(QueryGetAllCommitmentsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetAllCommitments", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetAllPaymentsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetCommitmentRewardPool(ctx context.Context, in *QueryGetCommitmentRewardPoolRequest, opts ...grpc.CallOption) (*QueryGetCommitmentRewardPoolResponse, error) {
+	out := new(QueryGetCommitmentRewardPoolResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetCommitmentRewardPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetAllPaymentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
-	}
-	if len(m.Payments) > 0 {
-		for iNdEx := len(m.Payments) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Payments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) GetAccountCommitmentRewards(ctx context.Context, in *QueryGetAccountCommitmentRewardsRequest, opts ...grpc.CallOption) (*QueryGetAccountCommitmentRewardsResponse, error) {
+	out := new(QueryGetAccountCommitmentRewardsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetAccountCommitmentRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPaymentFeeCalcRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetMarket(ctx context.Context, in *QueryGetMarketRequest, opts ...grpc.CallOption) (*QueryGetMarketResponse, error) {
+	out := new(QueryGetMarketResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetMarket", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPaymentFeeCalcRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetScheduledFeeChanges(ctx context.Context, in *QueryGetScheduledFeeChangesRequest, opts ...grpc.CallOption) (*QueryGetScheduledFeeChangesResponse, error) {
+	out := new(QueryGetScheduledFeeChangesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetScheduledFeeChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryPaymentFeeCalcRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Payment.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) GetMarketAuthorityTransfer(ctx context.Context, in *QueryGetMarketAuthorityTransferRequest, opts ...grpc.CallOption) (*QueryGetMarketAuthorityTransferResponse, error) {
+	out := new(QueryGetMarketAuthorityTransferResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetMarketAuthorityTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPaymentFeeCalcResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ExportMarket(ctx context.Context, in *QueryExportMarketRequest, opts ...grpc.CallOption) (*QueryExportMarketResponse, error) {
+	out := new(QueryExportMarketResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/ExportMarket", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPaymentFeeCalcResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetAllMarkets(ctx context.Context, in *QueryGetAllMarketsRequest, opts ...grpc.CallOption) (*QueryGetAllMarketsResponse, error) {
+	out := new(QueryGetAllMarketsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetAllMarkets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryPaymentFeeCalcResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FeeAccept) > 0 {
-		for iNdEx := len(m.FeeAccept) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FeeAccept[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.FeeCreate) > 0 {
-		for iNdEx := len(m.FeeCreate) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FeeCreate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) CommitmentSettlementFeeCalc(ctx context.Context, in *QueryCommitmentSettlementFeeCalcRequest, opts ...grpc.CallOption) (*QueryCommitmentSettlementFeeCalcResponse, error) {
+	out := new(QueryCommitmentSettlementFeeCalcResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/CommitmentSettlementFeeCalc", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *QueryOrderFeeCalcRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.AskOrder != nil {
-		l = m.AskOrder.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.BidOrder != nil {
-		l = m.BidOrder.Size()
-		n += 1 + l + sovQuery(uint64(l))
+
+func (c *queryClient) ValidateCreateMarket(ctx context.Context, in *QueryValidateCreateMarketRequest, opts ...grpc.CallOption) (*QueryValidateCreateMarketResponse, error) {
+	out := new(QueryValidateCreateMarketResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/ValidateCreateMarket", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryOrderFeeCalcResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.CreationFeeOptions) > 0 {
-		for _, e := range m.CreationFeeOptions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.SettlementFlatFeeOptions) > 0 {
-		for _, e := range m.SettlementFlatFeeOptions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.SettlementRatioFeeOptions) > 0 {
-		for _, e := range m.SettlementRatioFeeOptions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (c *queryClient) ValidateMarket(ctx context.Context, in *QueryValidateMarketRequest, opts ...grpc.CallOption) (*QueryValidateMarketResponse, error) {
+	out := new(QueryValidateMarketResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/ValidateMarket", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryGetOrderRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OrderId != 0 {
-		n += 1 + sovQuery(uint64(m.OrderId))
+func (c *queryClient) ValidateManageFees(ctx context.Context, in *QueryValidateManageFeesRequest, opts ...grpc.CallOption) (*QueryValidateManageFeesResponse, error) {
+	out := new(QueryValidateManageFeesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/ValidateManageFees", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryGetOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Order != nil {
-		l = m.Order.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) GetPayment(ctx context.Context, in *QueryGetPaymentRequest, opts ...grpc.CallOption) (*QueryGetPaymentResponse, error) {
+	out := new(QueryGetPaymentResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetPayment", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryGetOrderByExternalIDRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovQuery(uint64(m.MarketId))
-	}
-	l = len(m.ExternalId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) GetPaymentsWithSource(ctx context.Context, in *QueryGetPaymentsWithSourceRequest, opts ...grpc.CallOption) (*QueryGetPaymentsWithSourceResponse, error) {
+	out := new(QueryGetPaymentsWithSourceResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetPaymentsWithSource", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryGetOrderByExternalIDResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Order != nil {
-		l = m.Order.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) GetPaymentsWithTarget(ctx context.Context, in *QueryGetPaymentsWithTargetRequest, opts ...grpc.CallOption) (*QueryGetPaymentsWithTargetResponse, error) {
+	out := new(QueryGetPaymentsWithTargetResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetPaymentsWithTarget", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryGetMarketOrdersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovQuery(uint64(m.MarketId))
-	}
-	l = len(m.OrderType)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.AfterOrderId != 0 {
-		n += 1 + sovQuery(uint64(m.AfterOrderId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+func (c *queryClient) GetAllPayments(ctx context.Context, in *QueryGetAllPaymentsRequest, opts ...grpc.CallOption) (*QueryGetAllPaymentsResponse, error) {
+	out := new(QueryGetAllPaymentsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetAllPayments", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryGetMarketOrdersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Orders) > 0 {
-		for _, e := range m.Orders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+func (c *queryClient) PaymentFeeCalc(ctx context.Context, in *QueryPaymentFeeCalcRequest, opts ...grpc.CallOption) (*QueryPaymentFeeCalcResponse, error) {
+	out := new(QueryPaymentFeeCalcResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/PaymentFeeCalc", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryGetOwnerOrdersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.OrderType)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.AfterOrderId != 0 {
-		n += 1 + sovQuery(uint64(m.AfterOrderId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+func (c *queryClient) GetAccountActivitySummary(ctx context.Context, in *QueryGetAccountActivitySummaryRequest, opts ...grpc.CallOption) (*QueryGetAccountActivitySummaryResponse, error) {
+	out := new(QueryGetAccountActivitySummaryResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetAccountActivitySummary", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryGetOwnerOrdersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Orders) > 0 {
-		for _, e := range m.Orders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// OrderFeeCalc calculates the fees that will be associated with the provided order.
+	OrderFeeCalc(context.Context, *QueryOrderFeeCalcRequest) (*QueryOrderFeeCalcResponse, error)
+	// GetOrder looks up an order by id.
+	GetOrder(context.Context, *QueryGetOrderRequest) (*QueryGetOrderResponse, error)
+	// GetOrderByExternalID looks up an order by market id and external id.
+	GetOrderByExternalID(context.Context, *QueryGetOrderByExternalIDRequest) (*QueryGetOrderByExternalIDResponse, error)
+	// GetOrdersByExternalIDPrefix looks up the orders in a market that have an external id starting with a given prefix.
+	// Results are ordered by external id.
+	GetOrdersByExternalIDPrefix(context.Context, *QueryGetOrdersByExternalIDPrefixRequest) (*QueryGetOrdersByExternalIDPrefixResponse, error)
+	// GetMarketOrders looks up the orders in a market.
+	GetMarketOrders(context.Context, *QueryGetMarketOrdersRequest) (*QueryGetMarketOrdersResponse, error)
+	// GetOwnerOrders looks up the orders from the provided owner address.
+	GetOwnerOrders(context.Context, *QueryGetOwnerOrdersRequest) (*QueryGetOwnerOrdersResponse, error)
+	// GetAssetOrders looks up the orders for a specific asset denom.
+	GetAssetOrders(context.Context, *QueryGetAssetOrdersRequest) (*QueryGetAssetOrdersResponse, error)
+	// GetAllOrders gets all orders in the exchange module.
+	GetAllOrders(context.Context, *QueryGetAllOrdersRequest) (*QueryGetAllOrdersResponse, error)
+	// GetTriggerOrder looks up a pending trigger order by id.
+	GetTriggerOrder(context.Context, *QueryGetTriggerOrderRequest) (*QueryGetTriggerOrderResponse, error)
+	// GetMarketTriggerOrders looks up the pending trigger orders in a market.
+	GetMarketTriggerOrders(context.Context, *QueryGetMarketTriggerOrdersRequest) (*QueryGetMarketTriggerOrdersResponse, error)
+	// GetOrderLink looks up the order that an order is linked to.
+	GetOrderLink(context.Context, *QueryGetOrderLinkRequest) (*QueryGetOrderLinkResponse, error)
+	// GetMarketOrderLinks looks up the linked order pairs in a market.
+	GetMarketOrderLinks(context.Context, *QueryGetMarketOrderLinksRequest) (*QueryGetMarketOrderLinksResponse, error)
+	// GetDeadManSwitch looks up an account's dead-man switch.
+	GetDeadManSwitch(context.Context, *QueryGetDeadManSwitchRequest) (*QueryGetDeadManSwitchResponse, error)
+	// OrderBookDepth gets the orders for an asset and price denom pair in a market, aggregated by unit price.
+	OrderBookDepth(context.Context, *QueryOrderBookDepthRequest) (*QueryOrderBookDepthResponse, error)
+	// TopOfBook gets the best bid and ask prices for an asset and price denom pair in a market.
+	TopOfBook(context.Context, *QueryTopOfBookRequest) (*QueryTopOfBookResponse, error)
+	// PriceAverages gets the time-weighted and volume-weighted average prices of recent settlements
+	// for an asset and price denom pair in a market.
+	PriceAverages(context.Context, *QueryPriceAveragesRequest) (*QueryPriceAveragesResponse, error)
+	// GetMarketSettlements gets the recorded settlements in a market.
+	GetMarketSettlements(context.Context, *QueryGetMarketSettlementsRequest) (*QueryGetMarketSettlementsResponse, error)
+	// GetAllSettlements gets all the recorded settlements.
+	GetAllSettlements(context.Context, *QueryGetAllSettlementsRequest) (*QueryGetAllSettlementsResponse, error)
+	// GetSettlementReceipt gets the receipt of a settlement.
+	GetSettlementReceipt(context.Context, *QueryGetSettlementReceiptRequest) (*QueryGetSettlementReceiptResponse, error)
+	// GetLatestNAV gets the most recent NAV record for an asset and price denom pair.
+	GetLatestNAV(context.Context, *QueryGetLatestNAVRequest) (*QueryGetLatestNAVResponse, error)
+	// GetNAVHistory gets the NAV records for an asset and price denom pair, optionally limited to a range of heights.
+	GetNAVHistory(context.Context, *QueryGetNAVHistoryRequest) (*QueryGetNAVHistoryResponse, error)
+	// SimulateSettlement runs a market settle, fill bids, or fill asks request without committing any of the changes.
+	// It returns the transfers, fees, and net asset prices that would result from the request.
+	SimulateSettlement(context.Context, *QuerySimulateSettlementRequest) (*QuerySimulateSettlementResponse, error)
+	// GetCommitment gets the funds in an account that are committed to the market.
+	GetCommitment(context.Context, *QueryGetCommitmentRequest) (*QueryGetCommitmentResponse, error)
+	// GetAccountCommitments gets all the funds in an account that are committed to any market.
+	// Optionally, you can filter the results for a specific denomination using the `denom` query parameter.
+	GetAccountCommitments(context.Context, *QueryGetAccountCommitmentsRequest) (*QueryGetAccountCommitmentsResponse, error)
+	// GetMarketCommitments gets all the funds committed to a market from any account.
+	GetMarketCommitments(context.Context, *QueryGetMarketCommitmentsRequest) (*QueryGetMarketCommitmentsResponse, error)
+	// GetAllCommitments gets all fund committed to any market from any account.
+	GetAllCommitments(context.Context, *QueryGetAllCommitmentsRequest) (*QueryGetAllCommitmentsResponse, error)
+	// GetCommitmentRewardPool gets the undistributed funds in a market's commitment reward pool.
+	GetCommitmentRewardPool(context.Context, *QueryGetCommitmentRewardPoolRequest) (*QueryGetCommitmentRewardPoolResponse, error)
+	// GetAccountCommitmentRewards gets the commitment rewards that an account can claim from each market.
+	GetAccountCommitmentRewards(context.Context, *QueryGetAccountCommitmentRewardsRequest) (*QueryGetAccountCommitmentRewardsResponse, error)
+	// GetMarket returns all the information and details about a market.
+	GetMarket(context.Context, *QueryGetMarketRequest) (*QueryGetMarketResponse, error)
+	// GetScheduledFeeChanges returns the fee changes that are waiting to be applied to a market.
+	GetScheduledFeeChanges(context.Context, *QueryGetScheduledFeeChangesRequest) (*QueryGetScheduledFeeChangesResponse, error)
+	// GetMarketAuthorityTransfer returns the authority transfer waiting to be accepted for a market.
+	GetMarketAuthorityTransfer(context.Context, *QueryGetMarketAuthorityTransferRequest) (*QueryGetMarketAuthorityTransferResponse, error)
+	// ExportMarket returns a snapshot of a market's setup, fees, orders, and commitments.
+	ExportMarket(context.Context, *QueryExportMarketRequest) (*QueryExportMarketResponse, error)
+	// GetAllMarkets returns brief information about each market.
+	GetAllMarkets(context.Context, *QueryGetAllMarketsRequest) (*QueryGetAllMarketsResponse, error)
+	// Params returns the exchange module parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// CommitmentSettlementFeeCalc calculates the fees a market will pay for a commitment settlement using current NAVs.
+	CommitmentSettlementFeeCalc(context.Context, *QueryCommitmentSettlementFeeCalcRequest) (*QueryCommitmentSettlementFeeCalcResponse, error)
+	// ValidateCreateMarket checks the provided MsgGovCreateMarketResponse and returns any errors it might have.
+	ValidateCreateMarket(context.Context, *QueryValidateCreateMarketRequest) (*QueryValidateCreateMarketResponse, error)
+	// ValidateMarket checks for any problems with a market's setup.
+	ValidateMarket(context.Context, *QueryValidateMarketRequest) (*QueryValidateMarketResponse, error)
+	// ValidateManageFees checks the provided MsgGovManageFeesRequest and returns any errors that it might have.
+	ValidateManageFees(context.Context, *QueryValidateManageFeesRequest) (*QueryValidateManageFeesResponse, error)
+	// GetPayment gets a single specific payment.
+	GetPayment(context.Context, *QueryGetPaymentRequest) (*QueryGetPaymentResponse, error)
+	// GetPaymentsWithSource gets all payments with a specific source account.
+	GetPaymentsWithSource(context.Context, *QueryGetPaymentsWithSourceRequest) (*QueryGetPaymentsWithSourceResponse, error)
+	// GetPaymentsWithTarget gets all payments with a specific target account.
+	GetPaymentsWithTarget(context.Context, *QueryGetPaymentsWithTargetRequest) (*QueryGetPaymentsWithTargetResponse, error)
+	// GetAllPayments gets all payments.
+	GetAllPayments(context.Context, *QueryGetAllPaymentsRequest) (*QueryGetAllPaymentsResponse, error)
+	// PaymentFeeCalc calculates the fees that must be paid for creating or accepting a specific payment.
+	PaymentFeeCalc(context.Context, *QueryPaymentFeeCalcRequest) (*QueryPaymentFeeCalcResponse, error)
+	// GetAccountActivitySummary gets the totals of an account's open orders, commitments, and pending payments,
+	// as well as the funds the exchange has on hold for them.
+	GetAccountActivitySummary(context.Context, *QueryGetAccountActivitySummaryRequest) (*QueryGetAccountActivitySummaryResponse, error)
 }
 
-func (m *QueryGetAssetOrdersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Asset)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.OrderType)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.AfterOrderId != 0 {
-		n += 1 + sovQuery(uint64(m.AfterOrderId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryGetAssetOrdersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Orders) > 0 {
-		for _, e := range m.Orders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+func (*UnimplementedQueryServer) OrderFeeCalc(ctx context.Context, req *QueryOrderFeeCalcRequest) (*QueryOrderFeeCalcResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderFeeCalc not implemented")
 }
-
-func (m *QueryGetAllOrdersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+func (*UnimplementedQueryServer) GetOrder(ctx context.Context, req *QueryGetOrderRequest) (*QueryGetOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
 }
-
-func (m *QueryGetAllOrdersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Orders) > 0 {
-		for _, e := range m.Orders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+func (*UnimplementedQueryServer) GetOrderByExternalID(ctx context.Context, req *QueryGetOrderByExternalIDRequest) (*QueryGetOrderByExternalIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderByExternalID not implemented")
 }
-
-func (m *QueryGetCommitmentRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.MarketId != 0 {
-		n += 1 + sovQuery(uint64(m.MarketId))
-	}
-	return n
+func (*UnimplementedQueryServer) GetOrdersByExternalIDPrefix(ctx context.Context, req *QueryGetOrdersByExternalIDPrefixRequest) (*QueryGetOrdersByExternalIDPrefixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrdersByExternalIDPrefix not implemented")
 }
-
-func (m *QueryGetCommitmentResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (*UnimplementedQueryServer) GetMarketOrders(ctx context.Context, req *QueryGetMarketOrdersRequest) (*QueryGetMarketOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarketOrders not implemented")
 }
-
-func (m *QueryGetAccountCommitmentsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (*UnimplementedQueryServer) GetOwnerOrders(ctx context.Context, req *QueryGetOwnerOrdersRequest) (*QueryGetOwnerOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOwnerOrders not implemented")
+}
+func (*UnimplementedQueryServer) GetAssetOrders(ctx context.Context, req *QueryGetAssetOrdersRequest) (*QueryGetAssetOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssetOrders not implemented")
+}
+func (*UnimplementedQueryServer) GetAllOrders(ctx context.Context, req *QueryGetAllOrdersRequest) (*QueryGetAllOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllOrders not implemented")
+}
+func (*UnimplementedQueryServer) GetTriggerOrder(ctx context.Context, req *QueryGetTriggerOrderRequest) (*QueryGetTriggerOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTriggerOrder not implemented")
+}
+func (*UnimplementedQueryServer) GetMarketTriggerOrders(ctx context.Context, req *QueryGetMarketTriggerOrdersRequest) (*QueryGetMarketTriggerOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarketTriggerOrders not implemented")
+}
+func (*UnimplementedQueryServer) GetOrderLink(ctx context.Context, req *QueryGetOrderLinkRequest) (*QueryGetOrderLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderLink not implemented")
+}
+func (*UnimplementedQueryServer) GetMarketOrderLinks(ctx context.Context, req *QueryGetMarketOrderLinksRequest) (*QueryGetMarketOrderLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarketOrderLinks not implemented")
+}
+func (*UnimplementedQueryServer) GetDeadManSwitch(ctx context.Context, req *QueryGetDeadManSwitchRequest) (*QueryGetDeadManSwitchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeadManSwitch not implemented")
+}
+func (*UnimplementedQueryServer) OrderBookDepth(ctx context.Context, req *QueryOrderBookDepthRequest) (*QueryOrderBookDepthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderBookDepth not implemented")
+}
+func (*UnimplementedQueryServer) TopOfBook(ctx context.Context, req *QueryTopOfBookRequest) (*QueryTopOfBookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopOfBook not implemented")
+}
+func (*UnimplementedQueryServer) PriceAverages(ctx context.Context, req *QueryPriceAveragesRequest) (*QueryPriceAveragesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceAverages not implemented")
+}
+func (*UnimplementedQueryServer) GetMarketSettlements(ctx context.Context, req *QueryGetMarketSettlementsRequest) (*QueryGetMarketSettlementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarketSettlements not implemented")
+}
+func (*UnimplementedQueryServer) GetAllSettlements(ctx context.Context, req *QueryGetAllSettlementsRequest) (*QueryGetAllSettlementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllSettlements not implemented")
+}
+func (*UnimplementedQueryServer) GetSettlementReceipt(ctx context.Context, req *QueryGetSettlementReceiptRequest) (*QueryGetSettlementReceiptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSettlementReceipt not implemented")
+}
+func (*UnimplementedQueryServer) GetLatestNAV(ctx context.Context, req *QueryGetLatestNAVRequest) (*QueryGetLatestNAVResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLatestNAV not implemented")
+}
+func (*UnimplementedQueryServer) GetNAVHistory(ctx context.Context, req *QueryGetNAVHistoryRequest) (*QueryGetNAVHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNAVHistory not implemented")
+}
+func (*UnimplementedQueryServer) SimulateSettlement(ctx context.Context, req *QuerySimulateSettlementRequest) (*QuerySimulateSettlementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateSettlement not implemented")
+}
+func (*UnimplementedQueryServer) GetCommitment(ctx context.Context, req *QueryGetCommitmentRequest) (*QueryGetCommitmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommitment not implemented")
+}
+func (*UnimplementedQueryServer) GetAccountCommitments(ctx context.Context, req *QueryGetAccountCommitmentsRequest) (*QueryGetAccountCommitmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountCommitments not implemented")
+}
+func (*UnimplementedQueryServer) GetMarketCommitments(ctx context.Context, req *QueryGetMarketCommitmentsRequest) (*QueryGetMarketCommitmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarketCommitments not implemented")
+}
+func (*UnimplementedQueryServer) GetAllCommitments(ctx context.Context, req *QueryGetAllCommitmentsRequest) (*QueryGetAllCommitmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllCommitments not implemented")
+}
+func (*UnimplementedQueryServer) GetCommitmentRewardPool(ctx context.Context, req *QueryGetCommitmentRewardPoolRequest) (*QueryGetCommitmentRewardPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommitmentRewardPool not implemented")
+}
+func (*UnimplementedQueryServer) GetAccountCommitmentRewards(ctx context.Context, req *QueryGetAccountCommitmentRewardsRequest) (*QueryGetAccountCommitmentRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountCommitmentRewards not implemented")
+}
+func (*UnimplementedQueryServer) GetMarket(ctx context.Context, req *QueryGetMarketRequest) (*QueryGetMarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarket not implemented")
+}
+func (*UnimplementedQueryServer) GetScheduledFeeChanges(ctx context.Context, req *QueryGetScheduledFeeChangesRequest) (*QueryGetScheduledFeeChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScheduledFeeChanges not implemented")
+}
+func (*UnimplementedQueryServer) GetMarketAuthorityTransfer(ctx context.Context, req *QueryGetMarketAuthorityTransferRequest) (*QueryGetMarketAuthorityTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarketAuthorityTransfer not implemented")
+}
+func (*UnimplementedQueryServer) ExportMarket(ctx context.Context, req *QueryExportMarketRequest) (*QueryExportMarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportMarket not implemented")
+}
+func (*UnimplementedQueryServer) GetAllMarkets(ctx context.Context, req *QueryGetAllMarketsRequest) (*QueryGetAllMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllMarkets not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) CommitmentSettlementFeeCalc(ctx context.Context, req *QueryCommitmentSettlementFeeCalcRequest) (*QueryCommitmentSettlementFeeCalcResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitmentSettlementFeeCalc not implemented")
+}
+func (*UnimplementedQueryServer) ValidateCreateMarket(ctx context.Context, req *QueryValidateCreateMarketRequest) (*QueryValidateCreateMarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateCreateMarket not implemented")
+}
+func (*UnimplementedQueryServer) ValidateMarket(ctx context.Context, req *QueryValidateMarketRequest) (*QueryValidateMarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateMarket not implemented")
+}
+func (*UnimplementedQueryServer) ValidateManageFees(ctx context.Context, req *QueryValidateManageFeesRequest) (*QueryValidateManageFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateManageFees not implemented")
+}
+func (*UnimplementedQueryServer) GetPayment(ctx context.Context, req *QueryGetPaymentRequest) (*QueryGetPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPayment not implemented")
+}
+func (*UnimplementedQueryServer) GetPaymentsWithSource(ctx context.Context, req *QueryGetPaymentsWithSourceRequest) (*QueryGetPaymentsWithSourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPaymentsWithSource not implemented")
+}
+func (*UnimplementedQueryServer) GetPaymentsWithTarget(ctx context.Context, req *QueryGetPaymentsWithTargetRequest) (*QueryGetPaymentsWithTargetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPaymentsWithTarget not implemented")
+}
+func (*UnimplementedQueryServer) GetAllPayments(ctx context.Context, req *QueryGetAllPaymentsRequest) (*QueryGetAllPaymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllPayments not implemented")
+}
+func (*UnimplementedQueryServer) PaymentFeeCalc(ctx context.Context, req *QueryPaymentFeeCalcRequest) (*QueryPaymentFeeCalcResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentFeeCalc not implemented")
+}
+func (*UnimplementedQueryServer) GetAccountActivitySummary(ctx context.Context, req *QueryGetAccountActivitySummaryRequest) (*QueryGetAccountActivitySummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountActivitySummary not implemented")
 }
 
-func (m *QueryGetAccountCommitmentsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Commitments) > 0 {
-		for _, e := range m.Commitments {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryGetMarketCommitmentsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_OrderFeeCalc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOrderFeeCalcRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovQuery(uint64(m.MarketId))
+	if interceptor == nil {
+		return srv.(QueryServer).OrderFeeCalc(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/OrderFeeCalc",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OrderFeeCalc(ctx, req.(*QueryOrderFeeCalcRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetMarketCommitmentsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Commitments) > 0 {
-		for _, e := range m.Commitments {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func _Query_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetOrder(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryGetAllCommitmentsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetOrder",
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetOrder(ctx, req.(*QueryGetOrderRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetAllCommitmentsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetOrderByExternalID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetOrderByExternalIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Commitments) > 0 {
-		for _, e := range m.Commitments {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).GetOrderByExternalID(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetOrderByExternalID",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetOrderByExternalID(ctx, req.(*QueryGetOrderByExternalIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetMarketRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetOrdersByExternalIDPrefix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetOrdersByExternalIDPrefixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovQuery(uint64(m.MarketId))
+	if interceptor == nil {
+		return srv.(QueryServer).GetOrdersByExternalIDPrefix(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetOrdersByExternalIDPrefix",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetOrdersByExternalIDPrefix(ctx, req.(*QueryGetOrdersByExternalIDPrefixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetMarketResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetMarketOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMarketOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetMarketOrders(ctx, in)
 	}
-	if m.Market != nil {
-		l = m.Market.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetMarketOrders",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetMarketOrders(ctx, req.(*QueryGetMarketOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetAllMarketsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetOwnerOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetOwnerOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetOwnerOrders(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetOwnerOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetOwnerOrders(ctx, req.(*QueryGetOwnerOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetAllMarketsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetAssetOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAssetOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Markets) > 0 {
-		for _, e := range m.Markets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAssetOrders(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetAssetOrders",
 	}
-	return n
-}
-
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAssetOrders(ctx, req.(*QueryGetAssetOrdersRequest))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetAllOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAllOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetAllOrders(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetAllOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAllOrders(ctx, req.(*QueryGetAllOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCommitmentSettlementFeeCalcRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetTriggerOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTriggerOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Settlement != nil {
-		l = m.Settlement.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetTriggerOrder(ctx, in)
 	}
-	if m.IncludeBreakdownFields {
-		n += 2
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetTriggerOrder",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTriggerOrder(ctx, req.(*QueryGetTriggerOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCommitmentSettlementFeeCalcResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ExchangeFees) > 0 {
-		for _, e := range m.ExchangeFees {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.InputTotal) > 0 {
-		for _, e := range m.InputTotal {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func _Query_GetMarketTriggerOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMarketTriggerOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ConvertedTotal) > 0 {
-		for _, e := range m.ConvertedTotal {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).GetMarketTriggerOrders(ctx, in)
 	}
-	if len(m.ConversionNavs) > 0 {
-		for _, e := range m.ConversionNavs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetMarketTriggerOrders",
 	}
-	if m.ToFeeNav != nil {
-		l = m.ToFeeNav.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetMarketTriggerOrders(ctx, req.(*QueryGetMarketTriggerOrdersRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryValidateCreateMarketRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetOrderLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetOrderLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.CreateMarketRequest != nil {
-		l = m.CreateMarketRequest.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetOrderLink(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetOrderLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetOrderLink(ctx, req.(*QueryGetOrderLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryValidateCreateMarketResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetMarketOrderLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMarketOrderLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetMarketOrderLinks(ctx, in)
 	}
-	if m.GovPropWillPass {
-		n += 2
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetMarketOrderLinks",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetMarketOrderLinks(ctx, req.(*QueryGetMarketOrderLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryValidateMarketRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetDeadManSwitch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDeadManSwitchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovQuery(uint64(m.MarketId))
+	if interceptor == nil {
+		return srv.(QueryServer).GetDeadManSwitch(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryValidateMarketResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetDeadManSwitch",
 	}
-	var l int
-	_ = l
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDeadManSwitch(ctx, req.(*QueryGetDeadManSwitchRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryValidateManageFeesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_OrderBookDepth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOrderBookDepthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.ManageFeesRequest != nil {
-		l = m.ManageFeesRequest.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).OrderBookDepth(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/OrderBookDepth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OrderBookDepth(ctx, req.(*QueryOrderBookDepthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryValidateManageFeesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_TopOfBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopOfBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).TopOfBook(ctx, in)
 	}
-	if m.GovPropWillPass {
-		n += 2
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/TopOfBook",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TopOfBook(ctx, req.(*QueryTopOfBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetPaymentRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PriceAverages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceAveragesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).PriceAverages(ctx, in)
 	}
-	l = len(m.ExternalId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/PriceAverages",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceAverages(ctx, req.(*QueryPriceAveragesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetPaymentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetMarketSettlements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMarketSettlementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Payment != nil {
-		l = m.Payment.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetMarketSettlements(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetMarketSettlements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetMarketSettlements(ctx, req.(*QueryGetMarketSettlementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetPaymentsWithSourceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetAllSettlements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAllSettlementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetAllSettlements(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetAllSettlements",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAllSettlements(ctx, req.(*QueryGetAllSettlementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetPaymentsWithSourceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetSettlementReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetSettlementReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Payments) > 0 {
-		for _, e := range m.Payments {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).GetSettlementReceipt(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetSettlementReceipt",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetSettlementReceipt(ctx, req.(*QueryGetSettlementReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetPaymentsWithTargetRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetLatestNAV_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetLatestNAVRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Target)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetLatestNAV(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetLatestNAV",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetLatestNAV(ctx, req.(*QueryGetLatestNAVRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetPaymentsWithTargetResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetNAVHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetNAVHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Payments) > 0 {
-		for _, e := range m.Payments {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).GetNAVHistory(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetNAVHistory",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetNAVHistory(ctx, req.(*QueryGetNAVHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetAllPaymentsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_SimulateSettlement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateSettlementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateSettlement(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/SimulateSettlement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateSettlement(ctx, req.(*QuerySimulateSettlementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetAllPaymentsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetCommitment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCommitmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Payments) > 0 {
-		for _, e := range m.Payments {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).GetCommitment(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetCommitment",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCommitment(ctx, req.(*QueryGetCommitmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPaymentFeeCalcRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetAccountCommitments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAccountCommitmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Payment.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).GetAccountCommitments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetAccountCommitments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAccountCommitments(ctx, req.(*QueryGetAccountCommitmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPaymentFeeCalcResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetMarketCommitments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMarketCommitmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.FeeCreate) > 0 {
-		for _, e := range m.FeeCreate {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).GetMarketCommitments(ctx, in)
 	}
-	if len(m.FeeAccept) > 0 {
-		for _, e := range m.FeeAccept {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetMarketCommitments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetMarketCommitments(ctx, req.(*QueryGetMarketCommitmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetAllCommitments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAllCommitmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAllCommitments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetAllCommitments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAllCommitments(ctx, req.(*QueryGetAllCommitmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetCommitmentRewardPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCommitmentRewardPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetCommitmentRewardPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetCommitmentRewardPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCommitmentRewardPool(ctx, req.(*QueryGetCommitmentRewardPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetAccountCommitmentRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAccountCommitmentRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAccountCommitmentRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetAccountCommitmentRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAccountCommitmentRewards(ctx, req.(*QueryGetAccountCommitmentRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMarketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetMarket(ctx, req.(*QueryGetMarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetScheduledFeeChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetScheduledFeeChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetScheduledFeeChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetScheduledFeeChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetScheduledFeeChanges(ctx, req.(*QueryGetScheduledFeeChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetMarketAuthorityTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMarketAuthorityTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetMarketAuthorityTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetMarketAuthorityTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetMarketAuthorityTransfer(ctx, req.(*QueryGetMarketAuthorityTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExportMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExportMarketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExportMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/ExportMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExportMarket(ctx, req.(*QueryExportMarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetAllMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAllMarketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAllMarkets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetAllMarkets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAllMarkets(ctx, req.(*QueryGetAllMarketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CommitmentSettlementFeeCalc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCommitmentSettlementFeeCalcRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CommitmentSettlementFeeCalc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/CommitmentSettlementFeeCalc",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CommitmentSettlementFeeCalc(ctx, req.(*QueryCommitmentSettlementFeeCalcRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidateCreateMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidateCreateMarketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidateCreateMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/ValidateCreateMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidateCreateMarket(ctx, req.(*QueryValidateCreateMarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidateMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidateMarketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidateMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/ValidateMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidateMarket(ctx, req.(*QueryValidateMarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidateManageFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidateManageFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidateManageFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/ValidateManageFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidateManageFees(ctx, req.(*QueryValidateManageFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetPayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPayment(ctx, req.(*QueryGetPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetPaymentsWithSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPaymentsWithSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPaymentsWithSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetPaymentsWithSource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPaymentsWithSource(ctx, req.(*QueryGetPaymentsWithSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetPaymentsWithTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPaymentsWithTargetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPaymentsWithTarget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetPaymentsWithTarget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPaymentsWithTarget(ctx, req.(*QueryGetPaymentsWithTargetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetAllPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAllPaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAllPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetAllPayments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAllPayments(ctx, req.(*QueryGetAllPaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PaymentFeeCalc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentFeeCalcRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentFeeCalc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/PaymentFeeCalc",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentFeeCalc(ctx, req.(*QueryPaymentFeeCalcRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetAccountActivitySummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAccountActivitySummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAccountActivitySummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetAccountActivitySummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAccountActivitySummary(ctx, req.(*QueryGetAccountActivitySummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.exchange.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "OrderFeeCalc",
+			Handler:    _Query_OrderFeeCalc_Handler,
+		},
+		{
+			MethodName: "GetOrder",
+			Handler:    _Query_GetOrder_Handler,
+		},
+		{
+			MethodName: "GetOrderByExternalID",
+			Handler:    _Query_GetOrderByExternalID_Handler,
+		},
+		{
+			MethodName: "GetOrdersByExternalIDPrefix",
+			Handler:    _Query_GetOrdersByExternalIDPrefix_Handler,
+		},
+		{
+			MethodName: "GetMarketOrders",
+			Handler:    _Query_GetMarketOrders_Handler,
+		},
+		{
+			MethodName: "GetOwnerOrders",
+			Handler:    _Query_GetOwnerOrders_Handler,
+		},
+		{
+			MethodName: "GetAssetOrders",
+			Handler:    _Query_GetAssetOrders_Handler,
+		},
+		{
+			MethodName: "GetAllOrders",
+			Handler:    _Query_GetAllOrders_Handler,
+		},
+		{
+			MethodName: "GetTriggerOrder",
+			Handler:    _Query_GetTriggerOrder_Handler,
+		},
+		{
+			MethodName: "GetMarketTriggerOrders",
+			Handler:    _Query_GetMarketTriggerOrders_Handler,
+		},
+		{
+			MethodName: "GetOrderLink",
+			Handler:    _Query_GetOrderLink_Handler,
+		},
+		{
+			MethodName: "GetMarketOrderLinks",
+			Handler:    _Query_GetMarketOrderLinks_Handler,
+		},
+		{
+			MethodName: "GetDeadManSwitch",
+			Handler:    _Query_GetDeadManSwitch_Handler,
+		},
+		{
+			MethodName: "OrderBookDepth",
+			Handler:    _Query_OrderBookDepth_Handler,
+		},
+		{
+			MethodName: "TopOfBook",
+			Handler:    _Query_TopOfBook_Handler,
+		},
+		{
+			MethodName: "PriceAverages",
+			Handler:    _Query_PriceAverages_Handler,
+		},
+		{
+			MethodName: "GetMarketSettlements",
+			Handler:    _Query_GetMarketSettlements_Handler,
+		},
+		{
+			MethodName: "GetAllSettlements",
+			Handler:    _Query_GetAllSettlements_Handler,
+		},
+		{
+			MethodName: "GetSettlementReceipt",
+			Handler:    _Query_GetSettlementReceipt_Handler,
+		},
+		{
+			MethodName: "GetLatestNAV",
+			Handler:    _Query_GetLatestNAV_Handler,
+		},
+		{
+			MethodName: "GetNAVHistory",
+			Handler:    _Query_GetNAVHistory_Handler,
+		},
+		{
+			MethodName: "SimulateSettlement",
+			Handler:    _Query_SimulateSettlement_Handler,
+		},
+		{
+			MethodName: "GetCommitment",
+			Handler:    _Query_GetCommitment_Handler,
+		},
+		{
+			MethodName: "GetAccountCommitments",
+			Handler:    _Query_GetAccountCommitments_Handler,
+		},
+		{
+			MethodName: "GetMarketCommitments",
+			Handler:    _Query_GetMarketCommitments_Handler,
+		},
+		{
+			MethodName: "GetAllCommitments",
+			Handler:    _Query_GetAllCommitments_Handler,
+		},
+		{
+			MethodName: "GetCommitmentRewardPool",
+			Handler:    _Query_GetCommitmentRewardPool_Handler,
+		},
+		{
+			MethodName: "GetAccountCommitmentRewards",
+			Handler:    _Query_GetAccountCommitmentRewards_Handler,
+		},
+		{
+			MethodName: "GetMarket",
+			Handler:    _Query_GetMarket_Handler,
+		},
+		{
+			MethodName: "GetScheduledFeeChanges",
+			Handler:    _Query_GetScheduledFeeChanges_Handler,
+		},
+		{
+			MethodName: "GetMarketAuthorityTransfer",
+			Handler:    _Query_GetMarketAuthorityTransfer_Handler,
+		},
+		{
+			MethodName: "ExportMarket",
+			Handler:    _Query_ExportMarket_Handler,
+		},
+		{
+			MethodName: "GetAllMarkets",
+			Handler:    _Query_GetAllMarkets_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "CommitmentSettlementFeeCalc",
+			Handler:    _Query_CommitmentSettlementFeeCalc_Handler,
+		},
+		{
+			MethodName: "ValidateCreateMarket",
+			Handler:    _Query_ValidateCreateMarket_Handler,
+		},
+		{
+			MethodName: "ValidateMarket",
+			Handler:    _Query_ValidateMarket_Handler,
+		},
+		{
+			MethodName: "ValidateManageFees",
+			Handler:    _Query_ValidateManageFees_Handler,
+		},
+		{
+			MethodName: "GetPayment",
+			Handler:    _Query_GetPayment_Handler,
+		},
+		{
+			MethodName: "GetPaymentsWithSource",
+			Handler:    _Query_GetPaymentsWithSource_Handler,
+		},
+		{
+			MethodName: "GetPaymentsWithTarget",
+			Handler:    _Query_GetPaymentsWithTarget_Handler,
+		},
+		{
+			MethodName: "GetAllPayments",
+			Handler:    _Query_GetAllPayments_Handler,
+		},
+		{
+			MethodName: "PaymentFeeCalc",
+			Handler:    _Query_PaymentFeeCalc_Handler,
+		},
+		{
+			MethodName: "GetAccountActivitySummary",
+			Handler:    _Query_GetAccountActivitySummary_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provenance/exchange/v1/query.proto",
+}
+
+func (m *QueryOrderFeeCalcRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderFeeCalcRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderFeeCalcRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BidOrder != nil {
+		{
+			size, err := m.BidOrder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AskOrder != nil {
+		{
+			size, err := m.AskOrder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderFeeCalcResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderFeeCalcResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderFeeCalcResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SettlementTakerRatioFeeOptions) > 0 {
+		for iNdEx := len(m.SettlementTakerRatioFeeOptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SettlementTakerRatioFeeOptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.SettlementRatioFeeOptions) > 0 {
+		for iNdEx := len(m.SettlementRatioFeeOptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SettlementRatioFeeOptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SettlementFlatFeeOptions) > 0 {
+		for iNdEx := len(m.SettlementFlatFeeOptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SettlementFlatFeeOptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.CreationFeeOptions) > 0 {
+		for iNdEx := len(m.CreationFeeOptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreationFeeOptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Order != nil {
+		{
+			size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetOrderByExternalIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetOrderByExternalIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetOrderByExternalIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetOrderByExternalIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetOrderByExternalIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetOrderByExternalIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Order != nil {
+		{
+			size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetOrdersByExternalIDPrefixRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetOrdersByExternalIDPrefixRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetOrdersByExternalIDPrefixRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.ExternalIdPrefix) > 0 {
+		i -= len(m.ExternalIdPrefix)
+		copy(dAtA[i:], m.ExternalIdPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExternalIdPrefix)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetOrdersByExternalIDPrefixResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetOrdersByExternalIDPrefixResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetOrdersByExternalIDPrefixResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMarketOrdersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMarketOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMarketOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AssetDenom) > 0 {
+		i -= len(m.AssetDenom)
+		copy(dAtA[i:], m.AssetDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssetDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.AfterOrderId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AfterOrderId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OrderType) > 0 {
+		i -= len(m.OrderType)
+		copy(dAtA[i:], m.OrderType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrderType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMarketOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMarketOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMarketOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetOwnerOrdersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetOwnerOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetOwnerOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.AfterOrderId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AfterOrderId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OrderType) > 0 {
+		i -= len(m.OrderType)
+		copy(dAtA[i:], m.OrderType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrderType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetOwnerOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetOwnerOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetOwnerOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAssetOrdersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAssetOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAssetOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.AfterOrderId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AfterOrderId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OrderType) > 0 {
+		i -= len(m.OrderType)
+		copy(dAtA[i:], m.OrderType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrderType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAssetOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAssetOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAssetOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAllOrdersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAllOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAllOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAllOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAllOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAllOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTriggerOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTriggerOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTriggerOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTriggerOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTriggerOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTriggerOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TriggerOrder != nil {
+		{
+			size, err := m.TriggerOrder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMarketTriggerOrdersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMarketTriggerOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMarketTriggerOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMarketTriggerOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMarketTriggerOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMarketTriggerOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.TriggerOrders) > 0 {
+		for iNdEx := len(m.TriggerOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TriggerOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetOrderLinkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetOrderLinkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetOrderLinkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetOrderLinkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetOrderLinkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetOrderLinkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrderLink != nil {
+		{
+			size, err := m.OrderLink.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMarketOrderLinksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMarketOrderLinksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMarketOrderLinksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMarketOrderLinksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMarketOrderLinksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMarketOrderLinksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.OrderLinks) > 0 {
+		for iNdEx := len(m.OrderLinks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrderLinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDeadManSwitchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDeadManSwitchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDeadManSwitchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDeadManSwitchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDeadManSwitchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDeadManSwitchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeadManSwitch != nil {
+		{
+			size, err := m.DeadManSwitch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderBookDepthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderBookDepthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderBookDepthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Depth != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AssetDenom) > 0 {
+		i -= len(m.AssetDenom)
+		copy(dAtA[i:], m.AssetDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssetDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderBookDepthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderBookDepthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderBookDepthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Asks) > 0 {
+		for iNdEx := len(m.Asks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Asks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Bids) > 0 {
+		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopOfBookRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopOfBookRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopOfBookRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AssetDenom) > 0 {
+		i -= len(m.AssetDenom)
+		copy(dAtA[i:], m.AssetDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssetDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopOfBookResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopOfBookResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopOfBookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spread) > 0 {
+		i -= len(m.Spread)
+		copy(dAtA[i:], m.Spread)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Spread)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BestAsk != nil {
+		{
+			size, err := m.BestAsk.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BestBid != nil {
+		{
+			size, err := m.BestBid.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceAveragesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceAveragesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceAveragesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WindowSeconds))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AssetDenom) > 0 {
+		i -= len(m.AssetDenom)
+		copy(dAtA[i:], m.AssetDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssetDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceAveragesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceAveragesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceAveragesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TotalPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Volume.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Vwap) > 0 {
+		i -= len(m.Vwap)
+		copy(dAtA[i:], m.Vwap)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Vwap)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Twap) > 0 {
+		i -= len(m.Twap)
+		copy(dAtA[i:], m.Twap)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Twap)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMarketSettlementsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMarketSettlementsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMarketSettlementsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.AfterHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AfterHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMarketSettlementsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMarketSettlementsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMarketSettlementsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Settlements) > 0 {
+		for iNdEx := len(m.Settlements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Settlements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAllSettlementsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAllSettlementsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAllSettlementsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAllSettlementsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAllSettlementsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAllSettlementsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Settlements) > 0 {
+		for iNdEx := len(m.Settlements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Settlements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSettlementReceiptRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSettlementReceiptRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSettlementReceiptRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SettlementId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SettlementId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSettlementReceiptResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSettlementReceiptResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSettlementReceiptResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Receipt != nil {
+		{
+			size, err := m.Receipt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetLatestNAVRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetLatestNAVRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetLatestNAVRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AssetDenom) > 0 {
+		i -= len(m.AssetDenom)
+		copy(dAtA[i:], m.AssetDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssetDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetLatestNAVResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetLatestNAVResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetLatestNAVResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nav != nil {
+		{
+			size, err := m.Nav.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetNAVHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetNAVHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetNAVHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.MaxHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MinHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AssetDenom) > 0 {
+		i -= len(m.AssetDenom)
+		copy(dAtA[i:], m.AssetDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssetDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetNAVHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetNAVHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetNAVHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Navs) > 0 {
+		for iNdEx := len(m.Navs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Navs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateSettlementRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateSettlementRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateSettlementRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FillAsksRequest != nil {
+		{
+			size, err := m.FillAsksRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.FillBidsRequest != nil {
+		{
+			size, err := m.FillBidsRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketSettleRequest != nil {
+		{
+			size, err := m.MarketSettleRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateSettlementResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateSettlementResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateSettlementResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Navs) > 0 {
+		for iNdEx := len(m.Navs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Navs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.FeeInputs) > 0 {
+		for iNdEx := len(m.FeeInputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeInputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Transfers) > 0 {
+		for iNdEx := len(m.Transfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Fills) > 0 {
+		for iNdEx := len(m.Fills) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fills[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCommitmentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCommitmentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCommitmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCommitmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCommitmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCommitmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAccountCommitmentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAccountCommitmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAccountCommitmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAccountCommitmentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAccountCommitmentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAccountCommitmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commitments) > 0 {
+		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMarketCommitmentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMarketCommitmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMarketCommitmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMarketCommitmentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMarketCommitmentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMarketCommitmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Commitments) > 0 {
+		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAllCommitmentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAllCommitmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAllCommitmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAllCommitmentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAllCommitmentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAllCommitmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Commitments) > 0 {
+		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCommitmentRewardPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCommitmentRewardPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCommitmentRewardPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCommitmentRewardPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCommitmentRewardPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCommitmentRewardPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAccountCommitmentRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAccountCommitmentRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAccountCommitmentRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAccountCommitmentRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAccountCommitmentRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAccountCommitmentRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMarketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMarketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMarketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMarketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMarketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Market != nil {
+		{
+			size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetScheduledFeeChangesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetScheduledFeeChangesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetScheduledFeeChangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetScheduledFeeChangesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetScheduledFeeChangesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetScheduledFeeChangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScheduledFeeChanges) > 0 {
+		for iNdEx := len(m.ScheduledFeeChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledFeeChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMarketAuthorityTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMarketAuthorityTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMarketAuthorityTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMarketAuthorityTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMarketAuthorityTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMarketAuthorityTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuthorityTransfer != nil {
+		{
+			size, err := m.AuthorityTransfer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExportMarketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExportMarketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExportMarketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExportMarketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExportMarketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExportMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commitments) > 0 {
+		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ScheduledFeeChanges) > 0 {
+		for iNdEx := len(m.ScheduledFeeChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledFeeChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Market != nil {
+		{
+			size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAllMarketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAllMarketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAllMarketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAllMarketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAllMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAllMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommitmentSettlementFeeCalcRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommitmentSettlementFeeCalcRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommitmentSettlementFeeCalcRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeBreakdownFields {
+		i--
+		if m.IncludeBreakdownFields {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Settlement != nil {
+		{
+			size, err := m.Settlement.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommitmentSettlementFeeCalcResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommitmentSettlementFeeCalcResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommitmentSettlementFeeCalcResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToFeeNav != nil {
+		{
+			size, err := m.ToFeeNav.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ConversionNavs) > 0 {
+		for iNdEx := len(m.ConversionNavs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConversionNavs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ConvertedTotal) > 0 {
+		for iNdEx := len(m.ConvertedTotal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConvertedTotal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.InputTotal) > 0 {
+		for iNdEx := len(m.InputTotal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InputTotal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ExchangeFees) > 0 {
+		for iNdEx := len(m.ExchangeFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidateCreateMarketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidateCreateMarketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidateCreateMarketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreateMarketRequest != nil {
+		{
+			size, err := m.CreateMarketRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidateCreateMarketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidateCreateMarketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidateCreateMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GovPropWillPass {
+		i--
+		if m.GovPropWillPass {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidateMarketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidateMarketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidateMarketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidateMarketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidateMarketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidateMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidateManageFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidateManageFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidateManageFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ManageFeesRequest != nil {
+		{
+			size, err := m.ManageFeesRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidateManageFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidateManageFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidateManageFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GovPropWillPass {
+		i--
+		if m.GovPropWillPass {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPaymentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPaymentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPaymentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPaymentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPaymentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPaymentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Payment != nil {
+		{
+			size, err := m.Payment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPaymentsWithSourceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPaymentsWithSourceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPaymentsWithSourceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPaymentsWithSourceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPaymentsWithSourceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPaymentsWithSourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Payments) > 0 {
+		for iNdEx := len(m.Payments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPaymentsWithTargetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPaymentsWithTargetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPaymentsWithTargetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPaymentsWithTargetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPaymentsWithTargetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPaymentsWithTargetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Payments) > 0 {
+		for iNdEx := len(m.Payments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAllPaymentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAllPaymentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAllPaymentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAllPaymentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAllPaymentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAllPaymentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Payments) > 0 {
+		for iNdEx := len(m.Payments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymentFeeCalcRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentFeeCalcRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentFeeCalcRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Payment.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymentFeeCalcResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentFeeCalcResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentFeeCalcResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeAccept) > 0 {
+		for iNdEx := len(m.FeeAccept) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeAccept[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.FeeCreate) > 0 {
+		for iNdEx := len(m.FeeCreate) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeCreate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAccountActivitySummaryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAccountActivitySummaryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAccountActivitySummaryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAccountActivitySummaryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAccountActivitySummaryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAccountActivitySummaryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OnHold) > 0 {
+		for iNdEx := len(m.OnHold) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OnHold[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.IncomingPayments.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.OutgoingPayments.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Commitments) > 0 {
+		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryOrderFeeCalcRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AskOrder != nil {
+		l = m.AskOrder.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BidOrder != nil {
+		l = m.BidOrder.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOrderFeeCalcResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CreationFeeOptions) > 0 {
+		for _, e := range m.CreationFeeOptions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.SettlementFlatFeeOptions) > 0 {
+		for _, e := range m.SettlementFlatFeeOptions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.SettlementRatioFeeOptions) > 0 {
+		for _, e := range m.SettlementRatioFeeOptions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.SettlementTakerRatioFeeOptions) > 0 {
+		for _, e := range m.SettlementTakerRatioFeeOptions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovQuery(uint64(m.OrderId))
+	}
+	return n
+}
+
+func (m *QueryGetOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Order != nil {
+		l = m.Order.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetOrderByExternalIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetOrderByExternalIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Order != nil {
+		l = m.Order.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetOrdersByExternalIDPrefixRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	l = len(m.ExternalIdPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetOrdersByExternalIDPrefixResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMarketOrdersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	l = len(m.OrderType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AfterOrderId != 0 {
+		n += 1 + sovQuery(uint64(m.AfterOrderId))
+	}
+	l = len(m.AssetDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMarketOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetOwnerOrdersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OrderType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AfterOrderId != 0 {
+		n += 1 + sovQuery(uint64(m.AfterOrderId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetOwnerOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAssetOrdersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OrderType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AfterOrderId != 0 {
+		n += 1 + sovQuery(uint64(m.AfterOrderId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAssetOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAllOrdersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAllOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetTriggerOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovQuery(uint64(m.OrderId))
+	}
+	return n
+}
+
+func (m *QueryGetTriggerOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TriggerOrder != nil {
+		l = m.TriggerOrder.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMarketTriggerOrdersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMarketTriggerOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TriggerOrders) > 0 {
+		for _, e := range m.TriggerOrders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetOrderLinkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovQuery(uint64(m.OrderId))
+	}
+	return n
+}
+
+func (m *QueryGetOrderLinkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderLink != nil {
+		l = m.OrderLink.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMarketOrderLinksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMarketOrderLinksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OrderLinks) > 0 {
+		for _, e := range m.OrderLinks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDeadManSwitchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDeadManSwitchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DeadManSwitch != nil {
+		l = m.DeadManSwitch.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOrderBookDepthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	l = len(m.AssetDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovQuery(uint64(m.Depth))
+	}
+	return n
+}
+
+func (m *QueryOrderBookDepthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bids) > 0 {
+		for _, e := range m.Bids {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Asks) > 0 {
+		for _, e := range m.Asks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTopOfBookRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	l = len(m.AssetDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTopOfBookResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BestBid != nil {
+		l = m.BestBid.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BestAsk != nil {
+		l = m.BestAsk.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Spread)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPriceAveragesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	l = len(m.AssetDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.WindowSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.WindowSeconds))
+	}
+	return n
+}
+
+func (m *QueryPriceAveragesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Twap)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Vwap)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Volume.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetMarketSettlementsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	if m.AfterHeight != 0 {
+		n += 1 + sovQuery(uint64(m.AfterHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMarketSettlementsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Settlements) > 0 {
+		for _, e := range m.Settlements {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAllSettlementsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAllSettlementsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Settlements) > 0 {
+		for _, e := range m.Settlements {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetSettlementReceiptRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SettlementId != 0 {
+		n += 1 + sovQuery(uint64(m.SettlementId))
+	}
+	return n
+}
+
+func (m *QueryGetSettlementReceiptResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Receipt != nil {
+		l = m.Receipt.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetLatestNAVRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AssetDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetLatestNAVResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nav != nil {
+		l = m.Nav.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetNAVHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AssetDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MinHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MinHeight))
+	}
+	if m.MaxHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MaxHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetNAVHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Navs) > 0 {
+		for _, e := range m.Navs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateSettlementRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketSettleRequest != nil {
+		l = m.MarketSettleRequest.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FillBidsRequest != nil {
+		l = m.FillBidsRequest.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FillAsksRequest != nil {
+		l = m.FillAsksRequest.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateSettlementResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Fills) > 0 {
+		for _, e := range m.Fills {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Transfers) > 0 {
+		for _, e := range m.Transfers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.FeeInputs) > 0 {
+		for _, e := range m.FeeInputs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Navs) > 0 {
+		for _, e := range m.Navs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetCommitmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	return n
+}
+
+func (m *QueryGetCommitmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetAccountCommitmentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAccountCommitmentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Commitments) > 0 {
+		for _, e := range m.Commitments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetMarketCommitmentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMarketCommitmentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Commitments) > 0 {
+		for _, e := range m.Commitments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAllCommitmentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAllCommitmentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Commitments) > 0 {
+		for _, e := range m.Commitments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetCommitmentRewardPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	return n
+}
+
+func (m *QueryGetCommitmentRewardPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetAccountCommitmentRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAccountCommitmentRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetMarketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	return n
+}
+
+func (m *QueryGetMarketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Market != nil {
+		l = m.Market.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetScheduledFeeChangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	return n
+}
+
+func (m *QueryGetScheduledFeeChangesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScheduledFeeChanges) > 0 {
+		for _, e := range m.ScheduledFeeChanges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetMarketAuthorityTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	return n
+}
+
+func (m *QueryGetMarketAuthorityTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuthorityTransfer != nil {
+		l = m.AuthorityTransfer.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExportMarketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	return n
+}
+
+func (m *QueryExportMarketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Market != nil {
+		l = m.Market.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.ScheduledFeeChanges) > 0 {
+		for _, e := range m.ScheduledFeeChanges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Commitments) > 0 {
+		for _, e := range m.Commitments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetAllMarketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAllMarketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for _, e := range m.Markets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCommitmentSettlementFeeCalcRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Settlement != nil {
+		l = m.Settlement.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeBreakdownFields {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryCommitmentSettlementFeeCalcResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExchangeFees) > 0 {
+		for _, e := range m.ExchangeFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.InputTotal) > 0 {
+		for _, e := range m.InputTotal {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ConvertedTotal) > 0 {
+		for _, e := range m.ConvertedTotal {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ConversionNavs) > 0 {
+		for _, e := range m.ConversionNavs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ToFeeNav != nil {
+		l = m.ToFeeNav.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidateCreateMarketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreateMarketRequest != nil {
+		l = m.CreateMarketRequest.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidateCreateMarketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GovPropWillPass {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryValidateMarketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	return n
+}
+
+func (m *QueryValidateMarketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidateManageFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ManageFeesRequest != nil {
+		l = m.ManageFeesRequest.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidateManageFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GovPropWillPass {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryGetPaymentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPaymentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Payment != nil {
+		l = m.Payment.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPaymentsWithSourceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPaymentsWithSourceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Payments) > 0 {
+		for _, e := range m.Payments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPaymentsWithTargetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPaymentsWithTargetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Payments) > 0 {
+		for _, e := range m.Payments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAllPaymentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAllPaymentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Payments) > 0 {
+		for _, e := range m.Payments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentFeeCalcRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Payment.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPaymentFeeCalcResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeeCreate) > 0 {
+		for _, e := range m.FeeCreate {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.FeeAccept) > 0 {
+		for _, e := range m.FeeAccept {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetAccountActivitySummaryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAccountActivitySummaryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Commitments) > 0 {
+		for _, e := range m.Commitments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.OutgoingPayments.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.IncomingPayments.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.OnHold) > 0 {
+		for _, e := range m.OnHold {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryOrderFeeCalcRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrderFeeCalcRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrderFeeCalcRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AskOrder == nil {
+				m.AskOrder = &AskOrder{}
+			}
+			if err := m.AskOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BidOrder == nil {
+				m.BidOrder = &BidOrder{}
+			}
+			if err := m.BidOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrderFeeCalcResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrderFeeCalcResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrderFeeCalcResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationFeeOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreationFeeOptions = append(m.CreationFeeOptions, types.Coin{})
+			if err := m.CreationFeeOptions[len(m.CreationFeeOptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementFlatFeeOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettlementFlatFeeOptions = append(m.SettlementFlatFeeOptions, types.Coin{})
+			if err := m.SettlementFlatFeeOptions[len(m.SettlementFlatFeeOptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementRatioFeeOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettlementRatioFeeOptions = append(m.SettlementRatioFeeOptions, types.Coin{})
+			if err := m.SettlementRatioFeeOptions[len(m.SettlementRatioFeeOptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementTakerRatioFeeOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettlementTakerRatioFeeOptions = append(m.SettlementTakerRatioFeeOptions, types.Coin{})
+			if err := m.SettlementTakerRatioFeeOptions[len(m.SettlementTakerRatioFeeOptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOrderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOrderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Order == nil {
+				m.Order = &Order{}
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOrderByExternalIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOrderByExternalIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOrderByExternalIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOrderByExternalIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOrderByExternalIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOrderByExternalIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Order == nil {
+				m.Order = &Order{}
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOrdersByExternalIDPrefixRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOrdersByExternalIDPrefixRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOrdersByExternalIDPrefixRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalIdPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalIdPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOrdersByExternalIDPrefixResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOrdersByExternalIDPrefixResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOrdersByExternalIDPrefixResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, &Order{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMarketOrdersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMarketOrdersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMarketOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterOrderId", wireType)
+			}
+			m.AfterOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AfterOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMarketOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMarketOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMarketOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, &Order{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOwnerOrdersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOwnerOrdersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOwnerOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterOrderId", wireType)
+			}
+			m.AfterOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AfterOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOwnerOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOwnerOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOwnerOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, &Order{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAssetOrdersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAssetOrdersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAssetOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterOrderId", wireType)
+			}
+			m.AfterOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AfterOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAssetOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAssetOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAssetOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, &Order{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAllOrdersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAllOrdersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAllOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAllOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAllOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAllOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, &Order{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTriggerOrderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTriggerOrderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTriggerOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTriggerOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTriggerOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTriggerOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TriggerOrder == nil {
+				m.TriggerOrder = &TriggerOrder{}
+			}
+			if err := m.TriggerOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMarketTriggerOrdersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMarketTriggerOrdersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMarketTriggerOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMarketTriggerOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMarketTriggerOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMarketTriggerOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TriggerOrders = append(m.TriggerOrders, TriggerOrder{})
+			if err := m.TriggerOrders[len(m.TriggerOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOrderLinkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOrderLinkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOrderLinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOrderLinkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOrderLinkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOrderLinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderLink", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OrderLink == nil {
+				m.OrderLink = &OrderLink{}
+			}
+			if err := m.OrderLink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMarketOrderLinksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMarketOrderLinksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMarketOrderLinksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMarketOrderLinksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMarketOrderLinksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMarketOrderLinksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderLinks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderLinks = append(m.OrderLinks, OrderLink{})
+			if err := m.OrderLinks[len(m.OrderLinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDeadManSwitchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDeadManSwitchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDeadManSwitchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDeadManSwitchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDeadManSwitchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDeadManSwitchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadManSwitch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeadManSwitch == nil {
+				m.DeadManSwitch = &DeadManSwitch{}
+			}
+			if err := m.DeadManSwitch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrderBookDepthRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrderBookDepthRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrderBookDepthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrderBookDepthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrderBookDepthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrderBookDepthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bids = append(m.Bids, PriceLevel{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asks = append(m.Asks, PriceLevel{})
+			if err := m.Asks[len(m.Asks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTopOfBookRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTopOfBookRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTopOfBookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTopOfBookResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTopOfBookResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTopOfBookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestBid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BestBid == nil {
+				m.BestBid = &PriceLevel{}
+			}
+			if err := m.BestBid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestAsk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BestAsk == nil {
+				m.BestAsk = &PriceLevel{}
+			}
+			if err := m.BestAsk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spread", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spread = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceAveragesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceAveragesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceAveragesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSeconds", wireType)
+			}
+			m.WindowSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceAveragesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceAveragesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceAveragesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Twap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Twap = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vwap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vwap = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMarketSettlementsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMarketSettlementsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMarketSettlementsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterHeight", wireType)
+			}
+			m.AfterHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AfterHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMarketSettlementsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMarketSettlementsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMarketSettlementsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settlements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Settlements = append(m.Settlements, SettlementRecord{})
+			if err := m.Settlements[len(m.Settlements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAllSettlementsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAllSettlementsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAllSettlementsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAllSettlementsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAllSettlementsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAllSettlementsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settlements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Settlements = append(m.Settlements, SettlementRecord{})
+			if err := m.Settlements[len(m.Settlements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSettlementReceiptRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSettlementReceiptRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSettlementReceiptRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementId", wireType)
+			}
+			m.SettlementId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSettlementReceiptResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSettlementReceiptResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSettlementReceiptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Receipt == nil {
+				m.Receipt = &SettlementReceipt{}
+			}
+			if err := m.Receipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetLatestNAVRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetLatestNAVRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetLatestNAVRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetLatestNAVResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetLatestNAVResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetLatestNAVResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nav", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Nav == nil {
+				m.Nav = &NAVRecord{}
+			}
+			if err := m.Nav.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetNAVHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetNAVHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetNAVHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinHeight", wireType)
+			}
+			m.MinHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHeight", wireType)
+			}
+			m.MaxHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetNAVHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetNAVHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetNAVHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Navs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Navs = append(m.Navs, NAVRecord{})
+			if err := m.Navs[len(m.Navs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryOrderFeeCalcRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateSettlementRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6427,15 +17439,51 @@ func (m *QueryOrderFeeCalcRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOrderFeeCalcRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateSettlementRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOrderFeeCalcRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateSettlementRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketSettleRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MarketSettleRequest == nil {
+				m.MarketSettleRequest = &MsgMarketSettleRequest{}
+			}
+			if err := m.MarketSettleRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AskOrder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FillBidsRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6462,16 +17510,16 @@ func (m *QueryOrderFeeCalcRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AskOrder == nil {
-				m.AskOrder = &AskOrder{}
+			if m.FillBidsRequest == nil {
+				m.FillBidsRequest = &MsgFillBidsRequest{}
 			}
-			if err := m.AskOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FillBidsRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BidOrder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FillAsksRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6498,10 +17546,10 @@ func (m *QueryOrderFeeCalcRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BidOrder == nil {
-				m.BidOrder = &BidOrder{}
+			if m.FillAsksRequest == nil {
+				m.FillAsksRequest = &MsgFillAsksRequest{}
 			}
-			if err := m.BidOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FillAsksRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6526,7 +17574,7 @@ func (m *QueryOrderFeeCalcRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOrderFeeCalcResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateSettlementResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6549,15 +17597,47 @@ func (m *QueryOrderFeeCalcResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOrderFeeCalcResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateSettlementResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOrderFeeCalcResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateSettlementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationFeeOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fills", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6584,14 +17664,14 @@ func (m *QueryOrderFeeCalcResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreationFeeOptions = append(m.CreationFeeOptions, types.Coin{})
-			if err := m.CreationFeeOptions[len(m.CreationFeeOptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Fills = append(m.Fills, SettlementFill{})
+			if err := m.Fills[len(m.Fills)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementFlatFeeOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6618,14 +17698,14 @@ func (m *QueryOrderFeeCalcResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SettlementFlatFeeOptions = append(m.SettlementFlatFeeOptions, types.Coin{})
-			if err := m.SettlementFlatFeeOptions[len(m.SettlementFlatFeeOptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Transfers = append(m.Transfers, SettlementTransfer{})
+			if err := m.Transfers[len(m.Transfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementRatioFeeOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeInputs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6652,8 +17732,42 @@ func (m *QueryOrderFeeCalcResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SettlementRatioFeeOptions = append(m.SettlementRatioFeeOptions, types.Coin{})
-			if err := m.SettlementRatioFeeOptions[len(m.SettlementRatioFeeOptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FeeInputs = append(m.FeeInputs, AccountAmount{})
+			if err := m.FeeInputs[len(m.FeeInputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Navs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Navs = append(m.Navs, NetAssetPrice{})
+			if err := m.Navs[len(m.Navs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6678,7 +17792,7 @@ func (m *QueryOrderFeeCalcResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetOrderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetCommitmentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6701,17 +17815,49 @@ func (m *QueryGetOrderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetOrderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetCommitmentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetCommitmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			m.OrderId = 0
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6721,7 +17867,7 @@ func (m *QueryGetOrderRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderId |= uint64(b&0x7F) << shift
+				m.MarketId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6747,7 +17893,7 @@ func (m *QueryGetOrderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetCommitmentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6770,15 +17916,15 @@ func (m *QueryGetOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetCommitmentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetCommitmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6805,10 +17951,8 @@ func (m *QueryGetOrderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Order == nil {
-				m.Order = &Order{}
-			}
-			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6833,7 +17977,7 @@ func (m *QueryGetOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetOrderByExternalIDRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAccountCommitmentsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6856,17 +18000,17 @@ func (m *QueryGetOrderByExternalIDRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetOrderByExternalIDRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAccountCommitmentsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetOrderByExternalIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAccountCommitmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			m.MarketId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6876,14 +18020,27 @@ func (m *QueryGetOrderByExternalIDRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6911,7 +18068,7 @@ func (m *QueryGetOrderByExternalIDRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6934,7 +18091,7 @@ func (m *QueryGetOrderByExternalIDRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetOrderByExternalIDResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAccountCommitmentsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6957,15 +18114,15 @@ func (m *QueryGetOrderByExternalIDResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetOrderByExternalIDResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAccountCommitmentsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetOrderByExternalIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAccountCommitmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6992,10 +18149,8 @@ func (m *QueryGetOrderByExternalIDResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Order == nil {
-				m.Order = &Order{}
-			}
-			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Commitments = append(m.Commitments, &MarketAmount{})
+			if err := m.Commitments[len(m.Commitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7020,7 +18175,7 @@ func (m *QueryGetOrderByExternalIDResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMarketOrdersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetMarketCommitmentsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7043,10 +18198,10 @@ func (m *QueryGetMarketOrdersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMarketOrdersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetMarketCommitmentsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMarketOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetMarketCommitmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7068,57 +18223,6 @@ func (m *QueryGetMarketOrdersRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OrderType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AfterOrderId", wireType)
-			}
-			m.AfterOrderId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AfterOrderId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		case 99:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -7176,7 +18280,7 @@ func (m *QueryGetMarketOrdersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMarketOrdersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetMarketCommitmentsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7199,15 +18303,15 @@ func (m *QueryGetMarketOrdersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMarketOrdersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetMarketCommitmentsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMarketOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetMarketCommitmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7234,8 +18338,8 @@ func (m *QueryGetMarketOrdersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orders = append(m.Orders, &Order{})
-			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Commitments = append(m.Commitments, &AccountAmount{})
+			if err := m.Commitments[len(m.Commitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7296,7 +18400,7 @@ func (m *QueryGetMarketOrdersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetOwnerOrdersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAllCommitmentsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7319,17 +18423,17 @@ func (m *QueryGetOwnerOrdersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetOwnerOrdersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAllCommitmentsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetOwnerOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAllCommitmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7339,29 +18443,83 @@ func (m *QueryGetOwnerOrdersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAllCommitmentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAllCommitmentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAllCommitmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7371,43 +18529,26 @@ func (m *QueryGetOwnerOrdersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AfterOrderId", wireType)
-			}
-			m.AfterOrderId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AfterOrderId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Commitments = append(m.Commitments, &Commitment{})
+			if err := m.Commitments[len(m.Commitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		case 99:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -7438,7 +18579,7 @@ func (m *QueryGetOwnerOrdersRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -7465,7 +18606,7 @@ func (m *QueryGetOwnerOrdersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetOwnerOrdersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetCommitmentRewardPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7488,17 +18629,17 @@ func (m *QueryGetOwnerOrdersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetOwnerOrdersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetCommitmentRewardPoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetOwnerOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetCommitmentRewardPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			var msglen int
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7508,29 +18649,64 @@ func (m *QueryGetOwnerOrdersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MarketId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orders = append(m.Orders, &Order{})
-			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetCommitmentRewardPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			iNdEx = postIndex
-		case 99:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCommitmentRewardPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCommitmentRewardPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7557,10 +18733,8 @@ func (m *QueryGetOwnerOrdersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7585,7 +18759,7 @@ func (m *QueryGetOwnerOrdersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAssetOrdersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAccountCommitmentRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7608,47 +18782,15 @@ func (m *QueryGetAssetOrdersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAssetOrdersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAccountCommitmentRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAssetOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAccountCommitmentRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Asset = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7676,62 +18818,7 @@ func (m *QueryGetAssetOrdersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AfterOrderId", wireType)
-			}
-			m.AfterOrderId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AfterOrderId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 99:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7754,7 +18841,7 @@ func (m *QueryGetAssetOrdersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAssetOrdersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAccountCommitmentRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7777,49 +18864,15 @@ func (m *QueryGetAssetOrdersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAssetOrdersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAccountCommitmentRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAssetOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAccountCommitmentRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Orders = append(m.Orders, &Order{})
-			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 99:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7846,10 +18899,8 @@ func (m *QueryGetAssetOrdersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, &MarketAmount{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7874,7 +18925,7 @@ func (m *QueryGetAssetOrdersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAllOrdersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetMarketRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7897,17 +18948,17 @@ func (m *QueryGetAllOrdersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAllOrdersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetMarketRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAllOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetMarketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 99:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			var msglen int
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7917,28 +18968,11 @@ func (m *QueryGetAllOrdersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MarketId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7960,7 +18994,7 @@ func (m *QueryGetAllOrdersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAllOrdersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetMarketResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7983,17 +19017,17 @@ func (m *QueryGetAllOrdersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAllOrdersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetMarketResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAllOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetMarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8003,29 +19037,27 @@ func (m *QueryGetAllOrdersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orders = append(m.Orders, &Order{})
-			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 99:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8052,10 +19084,10 @@ func (m *QueryGetAllOrdersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Market == nil {
+				m.Market = &Market{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8080,7 +19112,7 @@ func (m *QueryGetAllOrdersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCommitmentRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetScheduledFeeChangesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8096,52 +19128,20 @@ func (m *QueryGetCommitmentRequest) Unmarshal(dAtA []byte) error {
 			b := dAtA[iNdEx]
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCommitmentRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCommitmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if b < 0x80 {
+				break
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetScheduledFeeChangesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetScheduledFeeChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
@@ -8181,7 +19181,7 @@ func (m *QueryGetCommitmentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCommitmentResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetScheduledFeeChangesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8204,15 +19204,15 @@ func (m *QueryGetCommitmentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCommitmentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetScheduledFeeChangesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCommitmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetScheduledFeeChangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledFeeChanges", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8239,8 +19239,8 @@ func (m *QueryGetCommitmentResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ScheduledFeeChanges = append(m.ScheduledFeeChanges, MsgGovManageFeesRequest{})
+			if err := m.ScheduledFeeChanges[len(m.ScheduledFeeChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8265,7 +19265,7 @@ func (m *QueryGetCommitmentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAccountCommitmentsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetMarketAuthorityTransferRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8288,49 +19288,17 @@ func (m *QueryGetAccountCommitmentsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAccountCommitmentsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetMarketAuthorityTransferRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAccountCommitmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetMarketAuthorityTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Account = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			var stringLen uint64
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8340,24 +19308,11 @@ func (m *QueryGetAccountCommitmentsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MarketId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8379,7 +19334,7 @@ func (m *QueryGetAccountCommitmentsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAccountCommitmentsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetMarketAuthorityTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8402,15 +19357,15 @@ func (m *QueryGetAccountCommitmentsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAccountCommitmentsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetMarketAuthorityTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAccountCommitmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetMarketAuthorityTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorityTransfer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8437,8 +19392,10 @@ func (m *QueryGetAccountCommitmentsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commitments = append(m.Commitments, &MarketAmount{})
-			if err := m.Commitments[len(m.Commitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.AuthorityTransfer == nil {
+				m.AuthorityTransfer = &MarketAuthorityTransfer{}
+			}
+			if err := m.AuthorityTransfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8463,7 +19420,7 @@ func (m *QueryGetAccountCommitmentsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMarketCommitmentsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryExportMarketRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8486,10 +19443,10 @@ func (m *QueryGetMarketCommitmentsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMarketCommitmentsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExportMarketRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMarketCommitmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExportMarketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8511,42 +19468,6 @@ func (m *QueryGetMarketCommitmentsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 99:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8568,7 +19489,7 @@ func (m *QueryGetMarketCommitmentsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMarketCommitmentsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryExportMarketResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8591,15 +19512,66 @@ func (m *QueryGetMarketCommitmentsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMarketCommitmentsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExportMarketResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMarketCommitmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExportMarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8626,14 +19598,16 @@ func (m *QueryGetMarketCommitmentsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commitments = append(m.Commitments, &AccountAmount{})
-			if err := m.Commitments[len(m.Commitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Market == nil {
+				m.Market = &Market{}
+			}
+			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 99:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledFeeChanges", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8660,10 +19634,76 @@ func (m *QueryGetMarketCommitmentsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			m.ScheduledFeeChanges = append(m.ScheduledFeeChanges, MsgGovManageFeesRequest{})
+			if err := m.ScheduledFeeChanges[len(m.ScheduledFeeChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, &Order{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitments = append(m.Commitments, &AccountAmount{})
+			if err := m.Commitments[len(m.Commitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8688,7 +19728,7 @@ func (m *QueryGetMarketCommitmentsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAllCommitmentsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAllMarketsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8711,10 +19751,10 @@ func (m *QueryGetAllCommitmentsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAllCommitmentsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAllMarketsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAllCommitmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAllMarketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 99:
@@ -8774,7 +19814,7 @@ func (m *QueryGetAllCommitmentsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAllCommitmentsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAllMarketsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8797,15 +19837,15 @@ func (m *QueryGetAllCommitmentsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAllCommitmentsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAllMarketsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAllCommitmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAllMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8832,8 +19872,8 @@ func (m *QueryGetAllCommitmentsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commitments = append(m.Commitments, &Commitment{})
-			if err := m.Commitments[len(m.Commitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Markets = append(m.Markets, &MarketBrief{})
+			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8894,7 +19934,7 @@ func (m *QueryGetAllCommitmentsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMarketRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8917,31 +19957,12 @@ func (m *QueryGetMarketRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMarketRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMarketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
-			}
-			m.MarketId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8963,7 +19984,7 @@ func (m *QueryGetMarketRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMarketResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8986,47 +20007,15 @@ func (m *QueryGetMarketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMarketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9053,10 +20042,10 @@ func (m *QueryGetMarketResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Market == nil {
-				m.Market = &Market{}
+			if m.Params == nil {
+				m.Params = &Params{}
 			}
-			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9081,7 +20070,7 @@ func (m *QueryGetMarketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAllMarketsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCommitmentSettlementFeeCalcRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9104,15 +20093,15 @@ func (m *QueryGetAllMarketsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAllMarketsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCommitmentSettlementFeeCalcRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAllMarketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCommitmentSettlementFeeCalcRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 99:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Settlement", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9139,13 +20128,33 @@ func (m *QueryGetAllMarketsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.Settlement == nil {
+				m.Settlement = &MsgMarketCommitmentSettleRequest{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Settlement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeBreakdownFields", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeBreakdownFields = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9167,7 +20176,7 @@ func (m *QueryGetAllMarketsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAllMarketsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCommitmentSettlementFeeCalcResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9190,15 +20199,15 @@ func (m *QueryGetAllMarketsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAllMarketsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCommitmentSettlementFeeCalcResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAllMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCommitmentSettlementFeeCalcResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9225,14 +20234,14 @@ func (m *QueryGetAllMarketsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Markets = append(m.Markets, &MarketBrief{})
-			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ExchangeFees = append(m.ExchangeFees, types.Coin{})
+			if err := m.ExchangeFees[len(m.ExchangeFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 99:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InputTotal", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9259,63 +20268,115 @@ func (m *QueryGetAllMarketsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.InputTotal = append(m.InputTotal, types.Coin{})
+			if err := m.InputTotal[len(m.InputTotal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConvertedTotal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConvertedTotal = append(m.ConvertedTotal, types.Coin{})
+			if err := m.ConvertedTotal[len(m.ConvertedTotal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionNavs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.ConversionNavs = append(m.ConversionNavs, NetAssetPrice{})
+			if err := m.ConversionNavs[len(m.ConversionNavs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToFeeNav", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.ToFeeNav == nil {
+				m.ToFeeNav = &NetAssetPrice{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.ToFeeNav.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9337,7 +20398,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidateCreateMarketRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9360,15 +20421,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidateCreateMarketRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidateCreateMarketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateMarketRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9395,10 +20456,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
+			if m.CreateMarketRequest == nil {
+				m.CreateMarketRequest = &MsgGovCreateMarketRequest{}
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CreateMarketRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9423,7 +20484,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCommitmentSettlementFeeCalcRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidateCreateMarketResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9446,17 +20507,17 @@ func (m *QueryCommitmentSettlementFeeCalcRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCommitmentSettlementFeeCalcRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidateCreateMarketResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCommitmentSettlementFeeCalcRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidateCreateMarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Settlement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9466,31 +20527,27 @@ func (m *QueryCommitmentSettlementFeeCalcRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Settlement == nil {
-				m.Settlement = &MsgMarketCommitmentSettleRequest{}
-			}
-			if err := m.Settlement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeBreakdownFields", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovPropWillPass", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -9507,7 +20564,7 @@ func (m *QueryCommitmentSettlementFeeCalcRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.IncludeBreakdownFields = bool(v != 0)
+			m.GovPropWillPass = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9529,7 +20586,7 @@ func (m *QueryCommitmentSettlementFeeCalcRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCommitmentSettlementFeeCalcResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidateMarketRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9552,51 +20609,17 @@ func (m *QueryCommitmentSettlementFeeCalcResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCommitmentSettlementFeeCalcResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidateMarketRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCommitmentSettlementFeeCalcResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidateMarketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeFees", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ExchangeFees = append(m.ExchangeFees, types.Coin{})
-			if err := m.ExchangeFees[len(m.ExchangeFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InputTotal", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			var msglen int
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9606,99 +20629,66 @@ func (m *QueryCommitmentSettlementFeeCalcResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MarketId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InputTotal = append(m.InputTotal, types.Coin{})
-			if err := m.InputTotal[len(m.InputTotal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConvertedTotal", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConvertedTotal = append(m.ConvertedTotal, types.Coin{})
-			if err := m.ConvertedTotal[len(m.ConvertedTotal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConversionNavs", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidateMarketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConversionNavs = append(m.ConversionNavs, NetAssetPrice{})
-			if err := m.ConversionNavs[len(m.ConversionNavs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidateMarketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidateMarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToFeeNav", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9708,27 +20698,23 @@ func (m *QueryCommitmentSettlementFeeCalcResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ToFeeNav == nil {
-				m.ToFeeNav = &NetAssetPrice{}
-			}
-			if err := m.ToFeeNav.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9751,7 +20737,7 @@ func (m *QueryCommitmentSettlementFeeCalcResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryValidateCreateMarketRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidateManageFeesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9774,15 +20760,15 @@ func (m *QueryValidateCreateMarketRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidateCreateMarketRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidateManageFeesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidateCreateMarketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidateManageFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateMarketRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ManageFeesRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9809,10 +20795,10 @@ func (m *QueryValidateCreateMarketRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreateMarketRequest == nil {
-				m.CreateMarketRequest = &MsgGovCreateMarketRequest{}
+			if m.ManageFeesRequest == nil {
+				m.ManageFeesRequest = &MsgGovManageFeesRequest{}
 			}
-			if err := m.CreateMarketRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ManageFeesRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9837,7 +20823,7 @@ func (m *QueryValidateCreateMarketRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidateCreateMarketResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidateManageFeesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9860,10 +20846,10 @@ func (m *QueryValidateCreateMarketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidateCreateMarketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidateManageFeesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidateCreateMarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidateManageFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9939,7 +20925,7 @@ func (m *QueryValidateCreateMarketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidateMarketRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetPaymentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9962,17 +20948,17 @@ func (m *QueryValidateMarketRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidateMarketRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetPaymentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidateMarketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetPaymentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			m.MarketId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9982,64 +20968,27 @@ func (m *QueryValidateMarketRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryValidateMarketResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidateMarketResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidateMarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10067,7 +21016,7 @@ func (m *QueryValidateMarketResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10090,7 +21039,7 @@ func (m *QueryValidateMarketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidateManageFeesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetPaymentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10113,15 +21062,15 @@ func (m *QueryValidateManageFeesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidateManageFeesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetPaymentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidateManageFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetPaymentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManageFeesRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payment", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10148,10 +21097,10 @@ func (m *QueryValidateManageFeesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ManageFeesRequest == nil {
-				m.ManageFeesRequest = &MsgGovManageFeesRequest{}
+			if m.Payment == nil {
+				m.Payment = &Payment{}
 			}
-			if err := m.ManageFeesRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Payment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10176,7 +21125,7 @@ func (m *QueryValidateManageFeesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidateManageFeesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetPaymentsWithSourceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10199,15 +21148,15 @@ func (m *QueryValidateManageFeesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidateManageFeesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetPaymentsWithSourceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidateManageFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetPaymentsWithSourceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10235,83 +21184,13 @@ func (m *QueryValidateManageFeesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GovPropWillPass", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.GovPropWillPass = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetPaymentRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPaymentRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPaymentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10321,29 +21200,33 @@ func (m *QueryGetPaymentRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			if m.Filter == nil {
+				m.Filter = &PaymentFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10353,23 +21236,27 @@ func (m *QueryGetPaymentRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10392,7 +21279,7 @@ func (m *QueryGetPaymentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPaymentResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetPaymentsWithSourceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10415,15 +21302,15 @@ func (m *QueryGetPaymentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPaymentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetPaymentsWithSourceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPaymentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetPaymentsWithSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10450,10 +21337,44 @@ func (m *QueryGetPaymentResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Payment == nil {
-				m.Payment = &Payment{}
+			m.Payments = append(m.Payments, &Payment{})
+			if err := m.Payments[len(m.Payments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.Payment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10478,7 +21399,7 @@ func (m *QueryGetPaymentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPaymentsWithSourceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetPaymentsWithTargetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10501,15 +21422,15 @@ func (m *QueryGetPaymentsWithSourceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPaymentsWithSourceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetPaymentsWithTargetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPaymentsWithSourceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetPaymentsWithTargetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10537,7 +21458,43 @@ func (m *QueryGetPaymentsWithSourceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &PaymentFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 99:
 			if wireType != 2 {
@@ -10596,7 +21553,7 @@ func (m *QueryGetPaymentsWithSourceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPaymentsWithSourceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetPaymentsWithTargetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10619,10 +21576,10 @@ func (m *QueryGetPaymentsWithSourceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPaymentsWithSourceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetPaymentsWithTargetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPaymentsWithSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetPaymentsWithTargetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10716,7 +21673,7 @@ func (m *QueryGetPaymentsWithSourceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPaymentsWithTargetRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAllPaymentsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10739,44 +21696,12 @@ func (m *QueryGetPaymentsWithTargetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPaymentsWithTargetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAllPaymentsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPaymentsWithTargetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAllPaymentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Target = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 99:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -10834,7 +21759,7 @@ func (m *QueryGetPaymentsWithTargetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPaymentsWithTargetResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAllPaymentsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10857,10 +21782,10 @@ func (m *QueryGetPaymentsWithTargetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPaymentsWithTargetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAllPaymentsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPaymentsWithTargetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAllPaymentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10954,7 +21879,7 @@ func (m *QueryGetPaymentsWithTargetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAllPaymentsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentFeeCalcRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10977,15 +21902,15 @@ func (m *QueryGetAllPaymentsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAllPaymentsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentFeeCalcRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAllPaymentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentFeeCalcRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 99:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payment", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11012,10 +21937,7 @@ func (m *QueryGetAllPaymentsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Payment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11040,7 +21962,7 @@ func (m *QueryGetAllPaymentsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAllPaymentsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentFeeCalcResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11063,15 +21985,15 @@ func (m *QueryGetAllPaymentsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAllPaymentsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentFeeCalcResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAllPaymentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentFeeCalcResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCreate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11098,14 +22020,14 @@ func (m *QueryGetAllPaymentsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payments = append(m.Payments, &Payment{})
-			if err := m.Payments[len(m.Payments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FeeCreate = append(m.FeeCreate, types.Coin{})
+			if err := m.FeeCreate[len(m.FeeCreate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 99:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAccept", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11132,10 +22054,8 @@ func (m *QueryGetAllPaymentsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FeeAccept = append(m.FeeAccept, types.Coin{})
+			if err := m.FeeAccept[len(m.FeeAccept)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11160,7 +22080,7 @@ func (m *QueryGetAllPaymentsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPaymentFeeCalcRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAccountActivitySummaryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11183,17 +22103,17 @@ func (m *QueryPaymentFeeCalcRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPaymentFeeCalcRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAccountActivitySummaryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPaymentFeeCalcRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAccountActivitySummaryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11203,24 +22123,23 @@ func (m *QueryPaymentFeeCalcRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Payment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11243,7 +22162,7 @@ func (m *QueryPaymentFeeCalcRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPaymentFeeCalcResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAccountActivitySummaryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11266,15 +22185,15 @@ func (m *QueryPaymentFeeCalcResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPaymentFeeCalcResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAccountActivitySummaryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPaymentFeeCalcResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAccountActivitySummaryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeCreate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11301,14 +22220,14 @@ func (m *QueryPaymentFeeCalcResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeCreate = append(m.FeeCreate, types.Coin{})
-			if err := m.FeeCreate[len(m.FeeCreate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Orders = append(m.Orders, MarketOrdersSummary{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeAccept", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11335,8 +22254,108 @@ func (m *QueryPaymentFeeCalcResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeAccept = append(m.FeeAccept, types.Coin{})
-			if err := m.FeeAccept[len(m.FeeAccept)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Commitments = append(m.Commitments, &MarketAmount{})
+			if err := m.Commitments[len(m.Commitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingPayments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutgoingPayments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncomingPayments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IncomingPayments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnHold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OnHold = append(m.OnHold, types.Coin{})
+			if err := m.OnHold[len(m.OnHold)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,49 @@
+package exchange
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// ResolveSelfTrade applies a self-trade prevention mode to two crossing orders that have the same owner.
+// The newer order is the one that would take liquidity, and the older one is the one resting in the book.
+//
+// The returned orders are what's left of each of the provided ones. A nil order should be cancelled.
+// An order that isn't changed is returned as provided (i.e. the same pointer).
+// If the mode is unspecified, both orders are returned unchanged.
+func ResolveSelfTrade(mode SelfTradePrevention, newer, older *Order) (newerLeft, olderLeft *Order, err error) {
+	if newer == nil || older == nil {
+		return nil, nil, fmt.Errorf("cannot resolve self-trade of nil order")
+	}
+	if newer.GetOwner() != older.GetOwner() {
+		return nil, nil, fmt.Errorf("cannot resolve self-trade between order %d owned by %s and order %d owned by %s",
+			newer.OrderId, newer.GetOwner(), older.OrderId, older.GetOwner())
+	}
+
+	switch mode {
+	case SelfTradePrevention_unspecified:
+		return newer, older, nil
+	case SelfTradePrevention_cancel_newest:
+		return nil, older, nil
+	case SelfTradePrevention_cancel_oldest:
+		return newer, nil, nil
+	case SelfTradePrevention_decrement_both:
+		amt := sdkmath.MinInt(newer.GetAssets().Amount, older.GetAssets().Amount)
+		return decrementOrder(newer, amt), decrementOrder(older, amt), nil
+	}
+	return nil, nil, mode.Validate()
+}
+
+// decrementOrder returns what's left of the provided order after reducing its assets by the provided amount.
+// Nil is returned if nothing would be left, or if the order cannot be partially reduced by that amount.
+func decrementOrder(order *Order, amt sdkmath.Int) *Order {
+	if !order.GetAssets().Amount.GT(amt) {
+		return nil
+	}
+	_, unfilled, err := order.Split(amt)
+	if err != nil {
+		return nil
+	}
+	return unfilled
+}
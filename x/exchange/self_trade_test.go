@@ -0,0 +1,184 @@
+package exchange
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+func TestResolveSelfTrade(t *testing.T) {
+	coin := func(coinStr string) sdk.Coin {
+		rv, err := ParseCoin(coinStr)
+		require.NoError(t, err, "ParseCoin(%q)", coinStr)
+		return rv
+	}
+	askOrder := func(orderID uint64, owner, assets, price string, allowPartial bool) *Order {
+		return NewOrder(orderID).WithAsk(&AskOrder{
+			MarketId:     1,
+			Seller:       owner,
+			Assets:       coin(assets),
+			Price:        coin(price),
+			AllowPartial: allowPartial,
+		})
+	}
+	bidOrder := func(orderID uint64, owner, assets, price string, allowPartial bool) *Order {
+		return NewOrder(orderID).WithBid(&BidOrder{
+			MarketId:     1,
+			Buyer:        owner,
+			Assets:       coin(assets),
+			Price:        coin(price),
+			AllowPartial: allowPartial,
+		})
+	}
+	orderString := func(order *Order) string {
+		if order == nil {
+			return "nil"
+		}
+		return fmt.Sprintf("%s order %d: %s at %s", order.GetOrderType(), order.OrderId, order.GetAssets(), order.GetPrice())
+	}
+
+	// same indicates that the order is expected to be returned unchanged (i.e. the same pointer).
+	const same = "same"
+
+	tests := []struct {
+		name        string
+		mode        SelfTradePrevention
+		newer       *Order
+		older       *Order
+		expNewer    string
+		expOlder    string
+		expErr      string
+		expNilNewer bool
+		expNilOlder bool
+	}{
+		{
+			name:   "nil newer",
+			mode:   SelfTradePrevention_cancel_newest,
+			older:  askOrder(1, "owner", "10apple", "20plum", true),
+			expErr: "cannot resolve self-trade of nil order",
+		},
+		{
+			name:   "nil older",
+			mode:   SelfTradePrevention_cancel_newest,
+			newer:  bidOrder(2, "owner", "10apple", "20plum", true),
+			expErr: "cannot resolve self-trade of nil order",
+		},
+		{
+			name:   "different owners",
+			mode:   SelfTradePrevention_cancel_newest,
+			newer:  bidOrder(2, "buyer", "10apple", "20plum", true),
+			older:  askOrder(1, "seller", "10apple", "20plum", true),
+			expErr: "cannot resolve self-trade between order 2 owned by buyer and order 1 owned by seller",
+		},
+		{
+			name:   "unknown mode",
+			mode:   7,
+			newer:  bidOrder(2, "owner", "10apple", "20plum", true),
+			older:  askOrder(1, "owner", "10apple", "20plum", true),
+			expErr: "self-trade prevention 7 does not exist",
+		},
+		{
+			name:     "unspecified",
+			mode:     SelfTradePrevention_unspecified,
+			newer:    bidOrder(2, "owner", "10apple", "20plum", true),
+			older:    askOrder(1, "owner", "10apple", "20plum", true),
+			expNewer: same,
+			expOlder: same,
+		},
+		{
+			name:        "cancel newest",
+			mode:        SelfTradePrevention_cancel_newest,
+			newer:       bidOrder(2, "owner", "10apple", "20plum", true),
+			older:       askOrder(1, "owner", "5apple", "10plum", true),
+			expNilNewer: true,
+			expOlder:    same,
+		},
+		{
+			name:        "cancel oldest",
+			mode:        SelfTradePrevention_cancel_oldest,
+			newer:       askOrder(2, "owner", "10apple", "20plum", true),
+			older:       bidOrder(1, "owner", "5apple", "10plum", true),
+			expNewer:    same,
+			expNilOlder: true,
+		},
+		{
+			name:        "decrement both: same amounts",
+			mode:        SelfTradePrevention_decrement_both,
+			newer:       bidOrder(2, "owner", "10apple", "20plum", true),
+			older:       askOrder(1, "owner", "10apple", "15plum", true),
+			expNilNewer: true,
+			expNilOlder: true,
+		},
+		{
+			name:        "decrement both: newer is smaller",
+			mode:        SelfTradePrevention_decrement_both,
+			newer:       askOrder(2, "owner", "4apple", "8plum", false),
+			older:       bidOrder(1, "owner", "10apple", "30plum", true),
+			expNilNewer: true,
+			expOlder:    "bid order 1: 6apple at 18plum",
+		},
+		{
+			name:        "decrement both: older is smaller",
+			mode:        SelfTradePrevention_decrement_both,
+			newer:       bidOrder(2, "owner", "10apple", "30plum", true),
+			older:       askOrder(1, "owner", "4apple", "8plum", false),
+			expNewer:    "bid order 2: 6apple at 18plum",
+			expNilOlder: true,
+		},
+		{
+			name:        "decrement both: larger does not allow partial",
+			mode:        SelfTradePrevention_decrement_both,
+			newer:       bidOrder(2, "owner", "10apple", "30plum", false),
+			older:       askOrder(1, "owner", "4apple", "8plum", true),
+			expNilNewer: true,
+			expNilOlder: true,
+		},
+		{
+			name:        "decrement both: larger price not divisible",
+			mode:        SelfTradePrevention_decrement_both,
+			newer:       askOrder(2, "owner", "3apple", "6plum", true),
+			older:       bidOrder(1, "owner", "10apple", "31plum", true),
+			expNilNewer: true,
+			expNilOlder: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var newerLeft, olderLeft *Order
+			var err error
+			testFunc := func() {
+				newerLeft, olderLeft, err = ResolveSelfTrade(tc.mode, tc.newer, tc.older)
+			}
+			require.NotPanics(t, testFunc, "ResolveSelfTrade")
+			assertions.AssertErrorValue(t, err, tc.expErr, "ResolveSelfTrade error")
+			if len(tc.expErr) > 0 {
+				return
+			}
+
+			switch {
+			case tc.expNilNewer:
+				assert.Nil(t, newerLeft, "newer order left")
+			case tc.expNewer == same:
+				assert.Same(t, tc.newer, newerLeft, "newer order left")
+			default:
+				assert.Equal(t, tc.expNewer, orderString(newerLeft), "newer order left")
+			}
+
+			switch {
+			case tc.expNilOlder:
+				assert.Nil(t, olderLeft, "older order left")
+			case tc.expOlder == same:
+				assert.Same(t, tc.older, olderLeft, "older order left")
+			default:
+				assert.Equal(t, tc.expOlder, orderString(olderLeft), "older order left")
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package exchange
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// SettlementPriceMaxAge is how long settlement prices are kept in state.
+// It is also the longest window that price averages can be calculated over.
+const SettlementPriceMaxAge = 30 * 24 * time.Hour
+
+// NewSettlementPrice creates a new SettlementPrice for a settlement in a market at the given time.
+// The time is truncated to the second.
+func NewSettlementPrice(marketID uint32, blockTime time.Time, nav NetAssetPrice) *SettlementPrice {
+	return &SettlementPrice{
+		MarketId: marketID,
+		Time:     time.Unix(blockTime.Unix(), 0).UTC(),
+		Assets:   nav.Assets,
+		Price:    nav.Price,
+	}
+}
+
+// Validate returns an error if anything in this settlement price is invalid.
+func (p SettlementPrice) Validate() error {
+	var errs []error
+	if p.MarketId == 0 {
+		errs = append(errs, errors.New("invalid market id: cannot be zero"))
+	}
+	if p.Time.Unix() <= 0 {
+		errs = append(errs, fmt.Errorf("invalid time %s: must be after the epoch", p.Time.UTC().Format(time.RFC3339)))
+	}
+	if err := p.Assets.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("invalid assets: %w", err))
+	} else if !p.Assets.Amount.IsPositive() {
+		errs = append(errs, fmt.Errorf("invalid assets %q: amount must be positive", p.Assets))
+	}
+	if err := p.Price.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("invalid price: %w", err))
+	} else if !p.Price.Amount.IsPositive() {
+		errs = append(errs, fmt.Errorf("invalid price %q: amount must be positive", p.Price))
+	}
+	return errors.Join(errs...)
+}
+
+// GetUnitPrice gets the price of one of the assets in this settlement price.
+func (p SettlementPrice) GetUnitPrice() sdkmath.LegacyDec {
+	return p.Price.Amount.ToLegacyDec().QuoInt(p.Assets.Amount)
+}
+
+// PriceAverages are the average unit prices of some settlements.
+type PriceAverages struct {
+	// TWAP is the time-weighted average unit price. It is nil if there weren't any settlements to use.
+	TWAP *sdkmath.LegacyDec
+	// VWAP is the volume-weighted average unit price. It is nil if there weren't any settlements in the window.
+	VWAP *sdkmath.LegacyDec
+	// Volume is the total amount of assets settled in the window.
+	Volume sdkmath.Int
+	// TotalPrice is the total price of the settlements in the window.
+	TotalPrice sdkmath.Int
+}
+
+// CalculatePriceAverages calculates the time-weighted and volume-weighted average unit prices from start to end.
+// The prices must be in chronological order, and should be the ones from start to end (inclusive).
+// The prior price is the last one before start (or nil if there isn't one), and is only used for the TWAP.
+//
+// For the TWAP, each unit price is in effect until the time of the next price (or end).
+// If there isn't a prior price, the TWAP only covers the time from the first price to end.
+func CalculatePriceAverages(prior *SettlementPrice, prices []SettlementPrice, start, end time.Time) PriceAverages {
+	rv := PriceAverages{Volume: sdkmath.ZeroInt(), TotalPrice: sdkmath.ZeroInt()}
+	for _, price := range prices {
+		rv.Volume = rv.Volume.Add(price.Assets.Amount)
+		rv.TotalPrice = rv.TotalPrice.Add(price.Price.Amount)
+	}
+	if rv.Volume.IsPositive() {
+		vwap := rv.TotalPrice.ToLegacyDec().QuoInt(rv.Volume)
+		rv.VWAP = &vwap
+	}
+
+	var cur *sdkmath.LegacyDec
+	var curStart int64
+	if prior != nil {
+		unitPrice := prior.GetUnitPrice()
+		cur = &unitPrice
+		curStart = start.Unix()
+	}
+	weighted := sdkmath.LegacyZeroDec()
+	var duration int64
+	for _, price := range prices {
+		priceTime := price.Time.Unix()
+		if cur != nil && priceTime > curStart {
+			weighted = weighted.Add(cur.MulInt64(priceTime - curStart))
+			duration += priceTime - curStart
+		}
+		unitPrice := price.GetUnitPrice()
+		cur = &unitPrice
+		curStart = priceTime
+	}
+	if cur == nil {
+		return rv
+	}
+	if endTime := end.Unix(); endTime > curStart {
+		weighted = weighted.Add(cur.MulInt64(endTime - curStart))
+		duration += endTime - curStart
+	}
+
+	// If there's no duration, the only price in effect was the last one (at the end).
+	twap := *cur
+	if duration > 0 {
+		twap = weighted.QuoInt64(duration)
+	}
+	rv.TWAP = &twap
+	return rv
+}
@@ -0,0 +1,245 @@
+package exchange
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+func TestNewSettlementPrice(t *testing.T) {
+	blockTime := time.Date(2024, 3, 7, 15, 4, 5, 999_999_999, time.FixedZone("other", 3600))
+	nav := NetAssetPrice{Assets: sdk.NewInt64Coin("apple", 8), Price: sdk.NewInt64Coin("plum", 20)}
+	exp := &SettlementPrice{
+		MarketId: 3,
+		Time:     time.Date(2024, 3, 7, 14, 4, 5, 0, time.UTC),
+		Assets:   sdk.NewInt64Coin("apple", 8),
+		Price:    sdk.NewInt64Coin("plum", 20),
+	}
+
+	var actual *SettlementPrice
+	testFunc := func() {
+		actual = NewSettlementPrice(3, blockTime, nav)
+	}
+	require.NotPanics(t, testFunc, "NewSettlementPrice")
+	assert.Equal(t, exp, actual, "NewSettlementPrice result")
+}
+
+func TestSettlementPrice_Validate(t *testing.T) {
+	okTime := time.Unix(1_700_000_000, 0).UTC()
+	tests := []struct {
+		name   string
+		price  SettlementPrice
+		expErr string
+	}{
+		{
+			name: "okay",
+			price: SettlementPrice{
+				MarketId: 1, Time: okTime,
+				Assets: sdk.NewInt64Coin("apple", 8), Price: sdk.NewInt64Coin("plum", 20),
+			},
+		},
+		{
+			name: "zero market id",
+			price: SettlementPrice{
+				MarketId: 0, Time: okTime,
+				Assets: sdk.NewInt64Coin("apple", 8), Price: sdk.NewInt64Coin("plum", 20),
+			},
+			expErr: "invalid market id: cannot be zero",
+		},
+		{
+			name: "time at epoch",
+			price: SettlementPrice{
+				MarketId: 1, Time: time.Unix(0, 0),
+				Assets: sdk.NewInt64Coin("apple", 8), Price: sdk.NewInt64Coin("plum", 20),
+			},
+			expErr: "invalid time 1970-01-01T00:00:00Z: must be after the epoch",
+		},
+		{
+			name: "invalid assets denom",
+			price: SettlementPrice{
+				MarketId: 1, Time: okTime,
+				Assets: sdk.Coin{Denom: "x", Amount: sdkmath.NewInt(8)}, Price: sdk.NewInt64Coin("plum", 20),
+			},
+			expErr: "invalid assets: invalid denom: x",
+		},
+		{
+			name: "zero assets",
+			price: SettlementPrice{
+				MarketId: 1, Time: okTime,
+				Assets: sdk.NewInt64Coin("apple", 0), Price: sdk.NewInt64Coin("plum", 20),
+			},
+			expErr: "invalid assets \"0apple\": amount must be positive",
+		},
+		{
+			name: "invalid price denom",
+			price: SettlementPrice{
+				MarketId: 1, Time: okTime,
+				Assets: sdk.NewInt64Coin("apple", 8), Price: sdk.Coin{Denom: "y", Amount: sdkmath.NewInt(20)},
+			},
+			expErr: "invalid price: invalid denom: y",
+		},
+		{
+			name: "zero price",
+			price: SettlementPrice{
+				MarketId: 1, Time: okTime,
+				Assets: sdk.NewInt64Coin("apple", 8), Price: sdk.NewInt64Coin("plum", 0),
+			},
+			expErr: "invalid price \"0plum\": amount must be positive",
+		},
+		{
+			name: "multiple problems",
+			price: SettlementPrice{
+				Time:   time.Unix(-5, 0),
+				Assets: sdk.NewInt64Coin("apple", 0),
+				Price:  sdk.NewInt64Coin("plum", 0),
+			},
+			expErr: joinErrs(
+				"invalid market id: cannot be zero",
+				"invalid time 1969-12-31T23:59:55Z: must be after the epoch",
+				"invalid assets \"0apple\": amount must be positive",
+				"invalid price \"0plum\": amount must be positive",
+			),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = tc.price.Validate()
+			}
+			require.NotPanics(t, testFunc, "Validate")
+			assertions.AssertErrorValue(t, err, tc.expErr, "Validate error")
+		})
+	}
+}
+
+func TestSettlementPrice_GetUnitPrice(t *testing.T) {
+	price := SettlementPrice{Assets: sdk.NewInt64Coin("apple", 8), Price: sdk.NewInt64Coin("plum", 20)}
+	var actual sdkmath.LegacyDec
+	testFunc := func() {
+		actual = price.GetUnitPrice()
+	}
+	require.NotPanics(t, testFunc, "GetUnitPrice")
+	assert.Equal(t, "2.500000000000000000", actual.String(), "GetUnitPrice result")
+}
+
+func TestCalculatePriceAverages(t *testing.T) {
+	start := time.Unix(1_700_000_000, 0).UTC()
+	at := func(secs int64, assets, price int64) SettlementPrice {
+		return SettlementPrice{
+			MarketId: 1,
+			Time:     start.Add(time.Duration(secs) * time.Second),
+			Assets:   sdk.NewInt64Coin("apple", assets),
+			Price:    sdk.NewInt64Coin("plum", price),
+		}
+	}
+	atP := func(secs int64, assets, price int64) *SettlementPrice {
+		rv := at(secs, assets, price)
+		return &rv
+	}
+	decStr := func(dec *sdkmath.LegacyDec) string {
+		if dec == nil {
+			return "<nil>"
+		}
+		return dec.String()
+	}
+
+	tests := []struct {
+		name      string
+		prior     *SettlementPrice
+		prices    []SettlementPrice
+		end       int64
+		expTWAP   string
+		expVWAP   string
+		expVolume int64
+		expTotal  int64
+	}{
+		{
+			name:    "no prices",
+			end:     100,
+			expTWAP: "<nil>",
+			expVWAP: "<nil>",
+		},
+		{
+			name:    "only a prior price",
+			prior:   atP(-50, 4, 10),
+			end:     100,
+			expTWAP: "2.500000000000000000",
+			expVWAP: "<nil>",
+		},
+		{
+			name:      "one price at the start",
+			prices:    []SettlementPrice{at(0, 2, 6)},
+			end:       100,
+			expTWAP:   "3.000000000000000000",
+			expVWAP:   "3.000000000000000000",
+			expVolume: 2,
+			expTotal:  6,
+		},
+		{
+			name:      "one price at the end",
+			prices:    []SettlementPrice{at(100, 2, 6)},
+			end:       100,
+			expTWAP:   "3.000000000000000000",
+			expVWAP:   "3.000000000000000000",
+			expVolume: 2,
+			expTotal:  6,
+		},
+		{
+			name: "two prices without a prior",
+			// 1.0 for 30 seconds, then 2.0 for 10 seconds = 50 / 40 = 1.25.
+			prices:    []SettlementPrice{at(60, 10, 10), at(90, 30, 60)},
+			end:       100,
+			expTWAP:   "1.250000000000000000",
+			expVWAP:   "1.750000000000000000",
+			expVolume: 40,
+			expTotal:  70,
+		},
+		{
+			name:  "two prices with a prior",
+			prior: atP(-10, 1, 4),
+			// 4.0 for 60 seconds, 1.0 for 30 seconds, then 2.0 for 10 seconds = 290 / 100 = 2.9.
+			prices:    []SettlementPrice{at(60, 10, 10), at(90, 30, 60)},
+			end:       100,
+			expTWAP:   "2.900000000000000000",
+			expVWAP:   "1.750000000000000000",
+			expVolume: 40,
+			expTotal:  70,
+		},
+		{
+			name:  "repeating decimal",
+			prior: atP(-10, 3, 1),
+			// 1/3 for 20 seconds, then 1.0 for 10 seconds = 6.666666666666666660 + 10 = 16.666666666666666660 / 30.
+			prices:    []SettlementPrice{at(20, 5, 5)},
+			end:       30,
+			expTWAP:   "0.555555555555555555",
+			expVWAP:   "1.000000000000000000",
+			expVolume: 5,
+			expTotal:  5,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			end := start.Add(time.Duration(tc.end) * time.Second)
+			var actual PriceAverages
+			testFunc := func() {
+				actual = CalculatePriceAverages(tc.prior, tc.prices, start, end)
+			}
+			require.NotPanics(t, testFunc, "CalculatePriceAverages")
+			assert.Equal(t, tc.expTWAP, decStr(actual.TWAP), "TWAP")
+			assert.Equal(t, tc.expVWAP, decStr(actual.VWAP), "VWAP")
+			assert.Equal(t, tc.expVolume, actual.Volume.Int64(), "Volume")
+			assert.Equal(t, tc.expTotal, actual.TotalPrice.Int64(), "TotalPrice")
+		})
+	}
+}
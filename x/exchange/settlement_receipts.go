@@ -0,0 +1,103 @@
+package exchange
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// MaxSettlementReceiptsPrunedPerBlock is the maximum number of old settlement receipts that are deleted at the end of a block.
+const MaxSettlementReceiptsPrunedPerBlock = 1_000
+
+// NewSettlementReceipt creates a new SettlementReceipt for a settlement in a market.
+func NewSettlementReceipt(
+	settlementID uint64,
+	marketID uint32,
+	height int64,
+	blockTime time.Time,
+	settlement *Settlement,
+	navs []NetAssetPrice,
+) *SettlementReceipt {
+	rv := &SettlementReceipt{
+		SettlementId: settlementID,
+		MarketId:     marketID,
+		Height:       height,
+		Time:         blockTime.UTC(),
+		Fills:        NewSettlementFills(settlement),
+	}
+	if settlement != nil {
+		if len(settlement.Transfers) > 0 {
+			rv.Transfers = make([]SettlementTransfer, len(settlement.Transfers))
+			for i, transfer := range settlement.Transfers {
+				rv.Transfers[i] = NewSettlementTransfer(transfer)
+			}
+		}
+		if len(settlement.FeeInputs) > 0 {
+			rv.FeeInputs = BankInputsToAccountAmounts(settlement.FeeInputs...)
+		}
+	}
+	if len(navs) > 0 {
+		rv.Navs = navs
+	}
+	return rv
+}
+
+// Validate returns an error if anything in this settlement receipt is invalid.
+func (r SettlementReceipt) Validate() error {
+	var errs []error
+	if r.SettlementId == 0 {
+		errs = append(errs, errors.New("invalid settlement id: cannot be zero"))
+	}
+	if r.MarketId == 0 {
+		errs = append(errs, errors.New("invalid market id: cannot be zero"))
+	}
+	if r.Height <= 0 {
+		errs = append(errs, fmt.Errorf("invalid height %d: must be positive", r.Height))
+	}
+	if len(r.Fills) == 0 {
+		errs = append(errs, errors.New("no fills"))
+	}
+	for i, fill := range r.Fills {
+		if err := fill.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid fill[%d]: %w", i, err))
+		}
+	}
+	for i, transfer := range r.Transfers {
+		if err := transfer.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid transfer[%d]: %w", i, err))
+		}
+	}
+	for i, feeInput := range r.FeeInputs {
+		if err := feeInput.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid fee input[%d]: %w", i, err))
+		}
+	}
+	for i, nav := range r.Navs {
+		if err := nav.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid nav[%d]: %w", i, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Validate returns an error if anything in this settlement transfer is invalid.
+func (t SettlementTransfer) Validate() error {
+	var errs []error
+	if len(t.Inputs) == 0 {
+		errs = append(errs, errors.New("no inputs"))
+	}
+	for i, input := range t.Inputs {
+		if err := input.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid input[%d]: %w", i, err))
+		}
+	}
+	if len(t.Outputs) == 0 {
+		errs = append(errs, errors.New("no outputs"))
+	}
+	for i, output := range t.Outputs {
+		if err := output.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid output[%d]: %w", i, err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,252 @@
+package exchange
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+func TestNewSettlementReceipt(t *testing.T) {
+	seller := sdk.AccAddress("seller______________").String()
+	buyer := sdk.AccAddress("buyer_______________").String()
+	askOrder := NewOrder(3).WithAsk(&AskOrder{
+		MarketId: 5, Seller: seller, Assets: sdk.NewInt64Coin("apple", 6), Price: sdk.NewInt64Coin("plum", 12),
+	})
+	bidOrder := NewOrder(4).WithBid(&BidOrder{
+		MarketId: 5, Buyer: buyer, Assets: sdk.NewInt64Coin("apple", 6), Price: sdk.NewInt64Coin("plum", 15),
+		BuyerSettlementFees: sdk.NewCoins(sdk.NewInt64Coin("plum", 1)),
+	})
+	blockTime := time.Date(2024, 3, 7, 15, 4, 5, 123_456_789, time.FixedZone("other", 3600))
+	expTime := time.Date(2024, 3, 7, 14, 4, 5, 123_456_789, time.UTC)
+
+	tests := []struct {
+		name       string
+		settlement *Settlement
+		navs       []NetAssetPrice
+		exp        *SettlementReceipt
+	}{
+		{
+			name:       "nil settlement",
+			settlement: nil,
+			exp:        &SettlementReceipt{SettlementId: 8, MarketId: 5, Height: 12, Time: expTime},
+		},
+		{
+			name: "full settlement",
+			settlement: &Settlement{
+				Transfers: []*Transfer{
+					{
+						Inputs:  []banktypes.Input{{Address: seller, Coins: sdk.NewCoins(sdk.NewInt64Coin("apple", 6))}},
+						Outputs: []banktypes.Output{{Address: buyer, Coins: sdk.NewCoins(sdk.NewInt64Coin("apple", 6))}},
+					},
+					{
+						Inputs:  []banktypes.Input{{Address: buyer, Coins: sdk.NewCoins(sdk.NewInt64Coin("plum", 15))}},
+						Outputs: []banktypes.Output{{Address: seller, Coins: sdk.NewCoins(sdk.NewInt64Coin("plum", 15))}},
+					},
+				},
+				FeeInputs: []banktypes.Input{{Address: buyer, Coins: sdk.NewCoins(sdk.NewInt64Coin("plum", 1))}},
+				FullyFilledOrders: []*FilledOrder{
+					NewFilledOrder(askOrder, sdk.NewInt64Coin("plum", 15), nil),
+					NewFilledOrder(bidOrder, sdk.NewInt64Coin("plum", 15), sdk.NewCoins(sdk.NewInt64Coin("plum", 1))),
+				},
+			},
+			navs: []NetAssetPrice{{Assets: sdk.NewInt64Coin("apple", 6), Price: sdk.NewInt64Coin("plum", 15)}},
+			exp: &SettlementReceipt{
+				SettlementId: 8, MarketId: 5, Height: 12, Time: expTime,
+				Fills: []SettlementFill{
+					{
+						OrderId: 3, OrderType: OrderTypeAsk, Owner: seller,
+						Assets: sdk.NewInt64Coin("apple", 6), Price: sdk.NewInt64Coin("plum", 15),
+					},
+					{
+						OrderId: 4, OrderType: OrderTypeBid, Owner: buyer,
+						Assets: sdk.NewInt64Coin("apple", 6), Price: sdk.NewInt64Coin("plum", 15),
+						Fees: sdk.NewCoins(sdk.NewInt64Coin("plum", 1)),
+					},
+				},
+				Transfers: []SettlementTransfer{
+					{
+						Inputs:  []AccountAmount{{Account: seller, Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 6))}},
+						Outputs: []AccountAmount{{Account: buyer, Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 6))}},
+					},
+					{
+						Inputs:  []AccountAmount{{Account: buyer, Amount: sdk.NewCoins(sdk.NewInt64Coin("plum", 15))}},
+						Outputs: []AccountAmount{{Account: seller, Amount: sdk.NewCoins(sdk.NewInt64Coin("plum", 15))}},
+					},
+				},
+				FeeInputs: []AccountAmount{{Account: buyer, Amount: sdk.NewCoins(sdk.NewInt64Coin("plum", 1))}},
+				Navs:      []NetAssetPrice{{Assets: sdk.NewInt64Coin("apple", 6), Price: sdk.NewInt64Coin("plum", 15)}},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual *SettlementReceipt
+			testFunc := func() {
+				actual = NewSettlementReceipt(8, 5, 12, blockTime, tc.settlement, tc.navs)
+			}
+			require.NotPanics(t, testFunc, "NewSettlementReceipt")
+			assert.Equal(t, tc.exp, actual, "NewSettlementReceipt result")
+		})
+	}
+}
+
+func TestSettlementReceipt_Validate(t *testing.T) {
+	owner := sdk.AccAddress("owner_______________").String()
+	fill := SettlementFill{
+		OrderId: 1, OrderType: OrderTypeAsk, Owner: owner,
+		Assets: sdk.NewInt64Coin("apple", 3), Price: sdk.NewInt64Coin("plum", 5),
+	}
+	transfer := SettlementTransfer{
+		Inputs:  []AccountAmount{{Account: owner, Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 3))}},
+		Outputs: []AccountAmount{{Account: owner, Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 3))}},
+	}
+	feeInput := AccountAmount{Account: owner, Amount: sdk.NewCoins(sdk.NewInt64Coin("fig", 1))}
+	nav := NetAssetPrice{Assets: sdk.NewInt64Coin("apple", 3), Price: sdk.NewInt64Coin("plum", 5)}
+
+	tests := []struct {
+		name    string
+		receipt SettlementReceipt
+		expErr  string
+	}{
+		{
+			name: "okay",
+			receipt: SettlementReceipt{
+				SettlementId: 1, MarketId: 2, Height: 3, Fills: []SettlementFill{fill},
+				Transfers: []SettlementTransfer{transfer}, FeeInputs: []AccountAmount{feeInput}, Navs: []NetAssetPrice{nav},
+			},
+		},
+		{
+			name:    "only fills",
+			receipt: SettlementReceipt{SettlementId: 1, MarketId: 2, Height: 3, Fills: []SettlementFill{fill}},
+		},
+		{
+			name:    "zero settlement id",
+			receipt: SettlementReceipt{SettlementId: 0, MarketId: 2, Height: 3, Fills: []SettlementFill{fill}},
+			expErr:  "invalid settlement id: cannot be zero",
+		},
+		{
+			name:    "zero market id",
+			receipt: SettlementReceipt{SettlementId: 1, MarketId: 0, Height: 3, Fills: []SettlementFill{fill}},
+			expErr:  "invalid market id: cannot be zero",
+		},
+		{
+			name:    "negative height",
+			receipt: SettlementReceipt{SettlementId: 1, MarketId: 2, Height: -3, Fills: []SettlementFill{fill}},
+			expErr:  "invalid height -3: must be positive",
+		},
+		{
+			name:    "no fills",
+			receipt: SettlementReceipt{SettlementId: 1, MarketId: 2, Height: 3},
+			expErr:  "no fills",
+		},
+		{
+			name: "bad fill",
+			receipt: SettlementReceipt{
+				SettlementId: 1, MarketId: 2, Height: 3,
+				Fills: []SettlementFill{fill, {OrderType: OrderTypeAsk, Owner: owner, Assets: fill.Assets, Price: fill.Price}},
+			},
+			expErr: "invalid fill[1]: invalid order id: cannot be zero",
+		},
+		{
+			name: "transfer without inputs",
+			receipt: SettlementReceipt{
+				SettlementId: 1, MarketId: 2, Height: 3, Fills: []SettlementFill{fill},
+				Transfers: []SettlementTransfer{transfer, {Outputs: transfer.Outputs}},
+			},
+			expErr: "invalid transfer[1]: no inputs",
+		},
+		{
+			name: "transfer with bad output",
+			receipt: SettlementReceipt{
+				SettlementId: 1, MarketId: 2, Height: 3, Fills: []SettlementFill{fill},
+				Transfers: []SettlementTransfer{{Inputs: transfer.Inputs, Outputs: []AccountAmount{{Account: "bad"}}}},
+			},
+			expErr: "invalid transfer[0]: invalid output[0]: invalid account \"bad\": decoding bech32 failed: invalid bech32 string length 3",
+		},
+		{
+			name: "zero fee input",
+			receipt: SettlementReceipt{
+				SettlementId: 1, MarketId: 2, Height: 3, Fills: []SettlementFill{fill},
+				FeeInputs: []AccountAmount{feeInput, {Account: owner}},
+			},
+			expErr: "invalid fee input[1]: invalid amount \"\": cannot be zero",
+		},
+		{
+			name: "zero nav price",
+			receipt: SettlementReceipt{
+				SettlementId: 1, MarketId: 2, Height: 3, Fills: []SettlementFill{fill},
+				Navs: []NetAssetPrice{{Assets: nav.Assets, Price: sdk.NewInt64Coin("plum", 0)}},
+			},
+			expErr: "invalid nav[0]: invalid price \"0plum\": cannot be zero",
+		},
+		{
+			name:    "multiple errors",
+			receipt: SettlementReceipt{},
+			expErr: "invalid settlement id: cannot be zero\n" +
+				"invalid market id: cannot be zero\n" +
+				"invalid height 0: must be positive\n" +
+				"no fills",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = tc.receipt.Validate()
+			}
+			require.NotPanics(t, testFunc, "Validate")
+			assertions.AssertErrorValue(t, err, tc.expErr, "Validate error")
+		})
+	}
+}
+
+func TestSettlementTransfer_Validate(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+	amount := AccountAmount{Account: addr, Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 3))}
+
+	tests := []struct {
+		name     string
+		transfer SettlementTransfer
+		expErr   string
+	}{
+		{
+			name:     "okay",
+			transfer: SettlementTransfer{Inputs: []AccountAmount{amount}, Outputs: []AccountAmount{amount, amount}},
+		},
+		{
+			name:     "no inputs or outputs",
+			transfer: SettlementTransfer{},
+			expErr:   "no inputs\nno outputs",
+		},
+		{
+			name:     "bad input",
+			transfer: SettlementTransfer{Inputs: []AccountAmount{amount, {Account: addr}}, Outputs: []AccountAmount{amount}},
+			expErr:   "invalid input[1]: invalid amount \"\": cannot be zero",
+		},
+		{
+			name:     "bad output",
+			transfer: SettlementTransfer{Inputs: []AccountAmount{amount}, Outputs: []AccountAmount{{Account: "", Amount: amount.Amount}}},
+			expErr:   "invalid output[0]: invalid account \"\": empty address string is not allowed",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = tc.transfer.Validate()
+			}
+			require.NotPanics(t, testFunc, "Validate")
+			assertions.AssertErrorValue(t, err, tc.expErr, "Validate error")
+		})
+	}
+}
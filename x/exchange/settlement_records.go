@@ -0,0 +1,102 @@
+package exchange
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxSettlementRecordsPrunedPerBlock is the maximum number of old settlement records that are deleted at the end of a block.
+const MaxSettlementRecordsPrunedPerBlock = 1_000
+
+// NewSettlementRecord creates a new SettlementRecord for a settlement in a market.
+func NewSettlementRecord(marketID uint32, height int64, sequence uint32, blockTime time.Time, settlement *Settlement) *SettlementRecord {
+	rv := &SettlementRecord{
+		MarketId: marketID,
+		Height:   height,
+		Sequence: sequence,
+		Time:     blockTime.UTC(),
+	}
+	rv.Fills = NewSettlementFills(settlement)
+	return rv
+}
+
+// NewSettlementFills creates a SettlementFill for each order filled in a settlement.
+// The partially filled orders (if there are any) are last.
+func NewSettlementFills(settlement *Settlement) []SettlementFill {
+	if settlement == nil {
+		return nil
+	}
+
+	partials := settlement.GetPartialOrders()
+	rv := make([]SettlementFill, 0, len(settlement.FullyFilledOrders)+len(partials))
+	for _, order := range settlement.FullyFilledOrders {
+		rv = append(rv, *NewSettlementFill(order, false))
+	}
+	for _, partial := range partials {
+		rv = append(rv, *NewSettlementFill(partial.Filled, true))
+	}
+	return rv
+}
+
+// NewSettlementFill creates a new SettlementFill for an order that was filled.
+func NewSettlementFill(order *FilledOrder, partial bool) *SettlementFill {
+	return &SettlementFill{
+		OrderId:    order.GetOrderID(),
+		OrderType:  order.GetOrderType(),
+		Owner:      order.GetOwner(),
+		Assets:     order.GetAssets(),
+		Price:      order.GetPrice(),
+		Fees:       order.GetSettlementFees(),
+		Partial:    partial,
+		ExternalId: order.GetExternalID(),
+	}
+}
+
+// Validate returns an error if anything in this settlement record is invalid.
+func (r SettlementRecord) Validate() error {
+	var errs []error
+	if r.MarketId == 0 {
+		errs = append(errs, errors.New("invalid market id: cannot be zero"))
+	}
+	if r.Height <= 0 {
+		errs = append(errs, fmt.Errorf("invalid height %d: must be positive", r.Height))
+	}
+	if len(r.Fills) == 0 {
+		errs = append(errs, errors.New("no fills"))
+	}
+	for i, fill := range r.Fills {
+		if err := fill.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid fill[%d]: %w", i, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Validate returns an error if anything in this settlement fill is invalid.
+func (f SettlementFill) Validate() error {
+	var errs []error
+	if f.OrderId == 0 {
+		errs = append(errs, errors.New("invalid order id: cannot be zero"))
+	}
+	if f.OrderType != OrderTypeAsk && f.OrderType != OrderTypeBid {
+		errs = append(errs, fmt.Errorf("invalid order type %q: must be %q or %q", f.OrderType, OrderTypeAsk, OrderTypeBid))
+	}
+	if _, err := sdk.AccAddressFromBech32(f.Owner); err != nil {
+		errs = append(errs, fmt.Errorf("invalid owner %q: %w", f.Owner, err))
+	}
+	if err := f.Assets.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("invalid assets: %w", err))
+	} else if !f.Assets.Amount.IsPositive() {
+		errs = append(errs, fmt.Errorf("invalid assets %q: amount must be positive", f.Assets))
+	}
+	if err := f.Price.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("invalid price: %w", err))
+	}
+	if err := f.Fees.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("invalid fees %q: %w", f.Fees, err))
+	}
+	return errors.Join(errs...)
+}
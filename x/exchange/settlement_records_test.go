@@ -0,0 +1,313 @@
+package exchange
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+func TestNewSettlementRecord(t *testing.T) {
+	seller := sdk.AccAddress("seller______________").String()
+	buyer := sdk.AccAddress("buyer_______________").String()
+	askOrder := NewOrder(3).WithAsk(&AskOrder{
+		MarketId: 5, Seller: seller, Assets: sdk.NewInt64Coin("apple", 10), Price: sdk.NewInt64Coin("plum", 20),
+		ExternalId: "ask3",
+	})
+	bidOrder := NewOrder(4).WithBid(&BidOrder{
+		MarketId: 5, Buyer: buyer, Assets: sdk.NewInt64Coin("apple", 6), Price: sdk.NewInt64Coin("plum", 15),
+	})
+	blockTime := time.Date(2024, 3, 7, 15, 4, 5, 123_456_789, time.FixedZone("other", 3600))
+
+	tests := []struct {
+		name       string
+		settlement *Settlement
+		exp        *SettlementRecord
+	}{
+		{
+			name:       "nil settlement",
+			settlement: nil,
+			exp: &SettlementRecord{
+				MarketId: 5, Height: 12, Sequence: 1,
+				Time: time.Date(2024, 3, 7, 14, 4, 5, 123_456_789, time.UTC),
+			},
+		},
+		{
+			name: "one full and one partial",
+			settlement: &Settlement{
+				FullyFilledOrders: []*FilledOrder{
+					NewFilledOrder(bidOrder, sdk.NewInt64Coin("plum", 15), sdk.NewCoins(sdk.NewInt64Coin("plum", 1))),
+				},
+				PartialOrderFilled: NewFilledOrder(NewOrder(3).WithAsk(&AskOrder{
+					MarketId: 5, Seller: seller, Assets: sdk.NewInt64Coin("apple", 6), Price: sdk.NewInt64Coin("plum", 12),
+					ExternalId: "ask3",
+				}), sdk.NewInt64Coin("plum", 15), nil),
+				PartialOrderLeft: askOrder,
+			},
+			exp: &SettlementRecord{
+				MarketId: 5, Height: 12, Sequence: 1,
+				Time: time.Date(2024, 3, 7, 14, 4, 5, 123_456_789, time.UTC),
+				Fills: []SettlementFill{
+					{
+						OrderId: 4, OrderType: OrderTypeBid, Owner: buyer,
+						Assets: sdk.NewInt64Coin("apple", 6), Price: sdk.NewInt64Coin("plum", 15),
+						Fees: sdk.NewCoins(sdk.NewInt64Coin("plum", 1)),
+					},
+					{
+						OrderId: 3, OrderType: OrderTypeAsk, Owner: seller,
+						Assets: sdk.NewInt64Coin("apple", 6), Price: sdk.NewInt64Coin("plum", 15),
+						Partial: true, ExternalId: "ask3",
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual *SettlementRecord
+			testFunc := func() {
+				actual = NewSettlementRecord(5, 12, 1, blockTime, tc.settlement)
+			}
+			require.NotPanics(t, testFunc, "NewSettlementRecord")
+			assert.Equal(t, tc.exp, actual, "NewSettlementRecord result")
+		})
+	}
+}
+
+func TestNewSettlementFills(t *testing.T) {
+	seller := sdk.AccAddress("seller______________").String()
+	buyer := sdk.AccAddress("buyer_______________").String()
+	askOrder := NewOrder(3).WithAsk(&AskOrder{
+		MarketId: 5, Seller: seller, Assets: sdk.NewInt64Coin("apple", 6), Price: sdk.NewInt64Coin("plum", 12),
+	})
+	bidOrder := NewOrder(4).WithBid(&BidOrder{
+		MarketId: 5, Buyer: buyer, Assets: sdk.NewInt64Coin("apple", 6), Price: sdk.NewInt64Coin("plum", 15),
+	})
+
+	tests := []struct {
+		name       string
+		settlement *Settlement
+		exp        []SettlementFill
+	}{
+		{
+			name:       "nil settlement",
+			settlement: nil,
+			exp:        nil,
+		},
+		{
+			name:       "empty settlement",
+			settlement: &Settlement{},
+			exp:        []SettlementFill{},
+		},
+		{
+			name: "partial is last",
+			settlement: &Settlement{
+				FullyFilledOrders:  []*FilledOrder{NewFilledOrder(bidOrder, sdk.NewInt64Coin("plum", 15), nil)},
+				PartialOrderFilled: NewFilledOrder(askOrder, sdk.NewInt64Coin("plum", 15), nil),
+			},
+			exp: []SettlementFill{
+				{
+					OrderId: 4, OrderType: OrderTypeBid, Owner: buyer,
+					Assets: sdk.NewInt64Coin("apple", 6), Price: sdk.NewInt64Coin("plum", 15),
+				},
+				{
+					OrderId: 3, OrderType: OrderTypeAsk, Owner: seller,
+					Assets: sdk.NewInt64Coin("apple", 6), Price: sdk.NewInt64Coin("plum", 15), Partial: true,
+				},
+			},
+		},
+		{
+			name: "other partials are last",
+			settlement: &Settlement{
+				FullyFilledOrders: []*FilledOrder{NewFilledOrder(bidOrder, sdk.NewInt64Coin("plum", 15), nil)},
+				OtherPartialOrders: []*PartialOrder{
+					{Filled: NewFilledOrder(askOrder, sdk.NewInt64Coin("plum", 12), nil)},
+					{Filled: NewFilledOrder(NewOrder(5).WithAsk(&AskOrder{
+						MarketId: 5, Seller: buyer, Assets: sdk.NewInt64Coin("apple", 1), Price: sdk.NewInt64Coin("plum", 3),
+					}), sdk.NewInt64Coin("plum", 3), nil)},
+				},
+			},
+			exp: []SettlementFill{
+				{
+					OrderId: 4, OrderType: OrderTypeBid, Owner: buyer,
+					Assets: sdk.NewInt64Coin("apple", 6), Price: sdk.NewInt64Coin("plum", 15),
+				},
+				{
+					OrderId: 3, OrderType: OrderTypeAsk, Owner: seller,
+					Assets: sdk.NewInt64Coin("apple", 6), Price: sdk.NewInt64Coin("plum", 12), Partial: true,
+				},
+				{
+					OrderId: 5, OrderType: OrderTypeAsk, Owner: buyer,
+					Assets: sdk.NewInt64Coin("apple", 1), Price: sdk.NewInt64Coin("plum", 3), Partial: true,
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual []SettlementFill
+			testFunc := func() {
+				actual = NewSettlementFills(tc.settlement)
+			}
+			require.NotPanics(t, testFunc, "NewSettlementFills")
+			assert.Equal(t, tc.exp, actual, "NewSettlementFills result")
+		})
+	}
+}
+
+func TestSettlementRecord_Validate(t *testing.T) {
+	okFill := SettlementFill{
+		OrderId: 1, OrderType: OrderTypeAsk, Owner: sdk.AccAddress("owner_______________").String(),
+		Assets: sdk.NewInt64Coin("apple", 8), Price: sdk.NewInt64Coin("plum", 20),
+	}
+	okTime := time.Unix(1_700_000_000, 0).UTC()
+
+	tests := []struct {
+		name   string
+		record SettlementRecord
+		expErr string
+	}{
+		{
+			name:   "okay",
+			record: SettlementRecord{MarketId: 1, Height: 5, Time: okTime, Fills: []SettlementFill{okFill}},
+		},
+		{
+			name:   "zero market id",
+			record: SettlementRecord{MarketId: 0, Height: 5, Time: okTime, Fills: []SettlementFill{okFill}},
+			expErr: "invalid market id: cannot be zero",
+		},
+		{
+			name:   "negative height",
+			record: SettlementRecord{MarketId: 1, Height: -1, Time: okTime, Fills: []SettlementFill{okFill}},
+			expErr: "invalid height -1: must be positive",
+		},
+		{
+			name:   "no fills",
+			record: SettlementRecord{MarketId: 1, Height: 5, Time: okTime},
+			expErr: "no fills",
+		},
+		{
+			name: "invalid second fill",
+			record: SettlementRecord{MarketId: 1, Height: 5, Time: okTime, Fills: []SettlementFill{
+				okFill,
+				{OrderId: 2, OrderType: OrderTypeBid, Owner: okFill.Owner, Assets: okFill.Assets},
+			}},
+			expErr: "invalid fill[1]: invalid price: invalid denom: ",
+		},
+		{
+			name:   "multiple problems",
+			record: SettlementRecord{},
+			expErr: joinErrs(
+				"invalid market id: cannot be zero",
+				"invalid height 0: must be positive",
+				"no fills",
+			),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = tc.record.Validate()
+			}
+			require.NotPanics(t, testFunc, "Validate")
+			assertions.AssertErrorValue(t, err, tc.expErr, "Validate error")
+		})
+	}
+}
+
+func TestSettlementFill_Validate(t *testing.T) {
+	owner := sdk.AccAddress("owner_______________").String()
+
+	tests := []struct {
+		name   string
+		fill   SettlementFill
+		expErr string
+	}{
+		{
+			name: "okay ask",
+			fill: SettlementFill{
+				OrderId: 1, OrderType: OrderTypeAsk, Owner: owner,
+				Assets: sdk.NewInt64Coin("apple", 8), Price: sdk.NewInt64Coin("plum", 20),
+				Fees: sdk.NewCoins(sdk.NewInt64Coin("plum", 1)), Partial: true, ExternalId: "abc",
+			},
+		},
+		{
+			name: "okay bid with zero price",
+			fill: SettlementFill{
+				OrderId: 1, OrderType: OrderTypeBid, Owner: owner,
+				Assets: sdk.NewInt64Coin("apple", 8), Price: sdk.NewInt64Coin("plum", 0),
+			},
+		},
+		{
+			name: "zero order id",
+			fill: SettlementFill{
+				OrderId: 0, OrderType: OrderTypeAsk, Owner: owner,
+				Assets: sdk.NewInt64Coin("apple", 8), Price: sdk.NewInt64Coin("plum", 20),
+			},
+			expErr: "invalid order id: cannot be zero",
+		},
+		{
+			name: "unknown order type",
+			fill: SettlementFill{
+				OrderId: 1, OrderType: "other", Owner: owner,
+				Assets: sdk.NewInt64Coin("apple", 8), Price: sdk.NewInt64Coin("plum", 20),
+			},
+			expErr: "invalid order type \"other\": must be \"ask\" or \"bid\"",
+		},
+		{
+			name: "bad owner",
+			fill: SettlementFill{
+				OrderId: 1, OrderType: OrderTypeAsk, Owner: "bad",
+				Assets: sdk.NewInt64Coin("apple", 8), Price: sdk.NewInt64Coin("plum", 20),
+			},
+			expErr: "invalid owner \"bad\": decoding bech32 failed: invalid bech32 string length 3",
+		},
+		{
+			name: "zero assets",
+			fill: SettlementFill{
+				OrderId: 1, OrderType: OrderTypeAsk, Owner: owner,
+				Assets: sdk.NewInt64Coin("apple", 0), Price: sdk.NewInt64Coin("plum", 20),
+			},
+			expErr: "invalid assets \"0apple\": amount must be positive",
+		},
+		{
+			name: "invalid assets denom",
+			fill: SettlementFill{
+				OrderId: 1, OrderType: OrderTypeAsk, Owner: owner,
+				Assets: sdk.Coin{Denom: "x", Amount: sdkmath.NewInt(8)}, Price: sdk.NewInt64Coin("plum", 20),
+			},
+			expErr: "invalid assets: invalid denom: x",
+		},
+		{
+			name: "invalid fees",
+			fill: SettlementFill{
+				OrderId: 1, OrderType: OrderTypeAsk, Owner: owner,
+				Assets: sdk.NewInt64Coin("apple", 8), Price: sdk.NewInt64Coin("plum", 20),
+				Fees: sdk.Coins{sdk.Coin{Denom: "plum", Amount: sdkmath.NewInt(-1)}},
+			},
+			expErr: "invalid fees \"-1plum\": coin -1plum amount is not positive",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = tc.fill.Validate()
+			}
+			require.NotPanics(t, testFunc, "Validate")
+			assertions.AssertErrorValue(t, err, tc.expErr, "Validate error")
+		})
+	}
+}
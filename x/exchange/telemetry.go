@@ -0,0 +1,46 @@
+package exchange
+
+const (
+	// TelemetryKeyOrderAction is the telemetry counter key for actions taken on orders.
+	TelemetryKeyOrderAction = "order-action"
+	// TelemetryKeyCommitmentAction is the telemetry counter key for actions taken on commitments.
+	TelemetryKeyCommitmentAction = "commitment-action"
+	// TelemetryKeyPaymentAction is the telemetry counter key for actions taken on payments.
+	TelemetryKeyPaymentAction = "payment-action"
+	// TelemetryKeySettlement is the telemetry counter key for completed settlements.
+	TelemetryKeySettlement = "settlement"
+	// TelemetryKeySettlementTime is the telemetry timer key for the time it takes to complete a settlement.
+	TelemetryKeySettlementTime = "settlement-time"
+	// TelemetryKeyFeesCollected is the telemetry counter key for the amount of fees collected by markets.
+	TelemetryKeyFeesCollected = "fees-collected"
+
+	// TelemetryLabelMarketID is the telemetry label for a market id.
+	TelemetryLabelMarketID = "market-id"
+	// TelemetryLabelOrderType is the telemetry label for an order type, e.g. "ask" or "bid".
+	TelemetryLabelOrderType = "order-type"
+	// TelemetryLabelAction is the telemetry label for the action taken on an order, commitment, or payment.
+	TelemetryLabelAction = "action"
+	// TelemetryLabelDenom is the telemetry label for the denom of an amount.
+	TelemetryLabelDenom = "denom"
+
+	// TelemetryActionCreated is the action label value used when an order or payment is created.
+	TelemetryActionCreated = "created"
+	// TelemetryActionCancelled is the action label value used when an order or payment is cancelled.
+	TelemetryActionCancelled = "cancelled"
+	// TelemetryActionExpired is the action label value used when an order, commitment, or payment expires.
+	TelemetryActionExpired = "expired"
+	// TelemetryActionAmended is the order action label value used when an order is amended.
+	TelemetryActionAmended = "amended"
+	// TelemetryActionFilled is the order action label value used when an order is fully filled.
+	TelemetryActionFilled = "filled"
+	// TelemetryActionPartiallyFilled is the order action label value used when an order is partially filled.
+	TelemetryActionPartiallyFilled = "partially-filled"
+	// TelemetryActionCommitted is the commitment action label value used when funds are committed to a market.
+	TelemetryActionCommitted = "committed"
+	// TelemetryActionReleased is the commitment action label value used when committed funds are released.
+	TelemetryActionReleased = "released"
+	// TelemetryActionAccepted is the payment action label value used when a payment is accepted.
+	TelemetryActionAccepted = "accepted"
+	// TelemetryActionRejected is the payment action label value used when a payment is rejected.
+	TelemetryActionRejected = "rejected"
+)
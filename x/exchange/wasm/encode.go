@@ -0,0 +1,349 @@
+// Package wasm supports smart contract integration with the provenance exchange module.
+package wasm
+
+import (
+	"encoding/json"
+	"fmt"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/internal/provwasm"
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+// ExchangeMsgParams are the params for exchange module messages sent from a smart contract.
+// Exactly one field must be set.
+type ExchangeMsgParams struct {
+	// Create an ask order.
+	CreateAsk *CreateAskParams `json:"create_ask,omitempty"`
+	// Create a bid order.
+	CreateBid *CreateBidParams `json:"create_bid,omitempty"`
+	// Cancel an order.
+	CancelOrder *CancelOrderParams `json:"cancel_order,omitempty"`
+	// Fill one or more bid orders.
+	FillBids *FillBidsParams `json:"fill_bids,omitempty"`
+	// Fill one or more ask orders.
+	FillAsks *FillAsksParams `json:"fill_asks,omitempty"`
+	// Settle orders in a market.
+	MarketSettle *MarketSettleParams `json:"market_settle,omitempty"`
+	// Commit funds to a market.
+	CommitFunds *CommitFundsParams `json:"commit_funds,omitempty"`
+	// Settle committed funds in a market.
+	MarketCommitmentSettle *MarketCommitmentSettleParams `json:"market_commitment_settle,omitempty"`
+	// Create a payment.
+	CreatePayment *CreatePaymentParams `json:"create_payment,omitempty"`
+	// Accept a payment.
+	AcceptPayment *AcceptPaymentParams `json:"accept_payment,omitempty"`
+	// Reject a payment.
+	RejectPayment *RejectPaymentParams `json:"reject_payment,omitempty"`
+	// Cancel one or more payments.
+	CancelPayments *CancelPaymentsParams `json:"cancel_payments,omitempty"`
+}
+
+// count returns the number of message fields that are set.
+func (p ExchangeMsgParams) count() int {
+	rv := 0
+	for _, isSet := range []bool{
+		p.CreateAsk != nil, p.CreateBid != nil, p.CancelOrder != nil, p.FillBids != nil, p.FillAsks != nil,
+		p.MarketSettle != nil, p.CommitFunds != nil, p.MarketCommitmentSettle != nil,
+		p.CreatePayment != nil, p.AcceptPayment != nil, p.RejectPayment != nil, p.CancelPayments != nil,
+	} {
+		if isSet {
+			rv++
+		}
+	}
+	return rv
+}
+
+// CreateAskParams are the params for creating an ask order. The contract is the seller.
+type CreateAskParams struct {
+	// MarketID is the id of the market to create the order in.
+	MarketID uint32 `json:"market_id"`
+	// Assets are the funds being sold.
+	Assets sdk.Coin `json:"assets"`
+	// Price is the minimum amount the contract is willing to receive for the assets.
+	Price sdk.Coin `json:"price"`
+	// SellerSettlementFlatFee is an optional flat fee the contract will pay during settlement.
+	SellerSettlementFlatFee *sdk.Coin `json:"seller_settlement_flat_fee,omitempty"`
+	// AllowPartial is whether this order can be partially filled.
+	AllowPartial bool `json:"allow_partial,omitempty"`
+	// ExternalID is an optional id to associate with the order.
+	ExternalID string `json:"external_id,omitempty"`
+	// OrderCreationFee is the fee to pay for creating the order.
+	OrderCreationFee *sdk.Coin `json:"order_creation_fee,omitempty"`
+}
+
+// CreateBidParams are the params for creating a bid order. The contract is the buyer.
+type CreateBidParams struct {
+	// MarketID is the id of the market to create the order in.
+	MarketID uint32 `json:"market_id"`
+	// Assets are the funds being bought.
+	Assets sdk.Coin `json:"assets"`
+	// Price is the amount the contract will pay for the assets.
+	Price sdk.Coin `json:"price"`
+	// BuyerSettlementFees are the fees the contract will pay during settlement.
+	BuyerSettlementFees sdk.Coins `json:"buyer_settlement_fees,omitempty"`
+	// AllowPartial is whether this order can be partially filled.
+	AllowPartial bool `json:"allow_partial,omitempty"`
+	// ExternalID is an optional id to associate with the order.
+	ExternalID string `json:"external_id,omitempty"`
+	// OrderCreationFee is the fee to pay for creating the order.
+	OrderCreationFee *sdk.Coin `json:"order_creation_fee,omitempty"`
+}
+
+// CancelOrderParams are the params for cancelling an order.
+// The contract must own the order or have permission to cancel orders in its market.
+type CancelOrderParams struct {
+	// OrderID is the id of the order to cancel.
+	OrderID uint64 `json:"order_id"`
+}
+
+// FillBidsParams are the params for filling bid orders. The contract is the seller.
+type FillBidsParams struct {
+	// MarketID is the id of the market with the bid orders.
+	MarketID uint32 `json:"market_id"`
+	// TotalAssets are the funds being sold (must equal the total of the bid orders' assets).
+	TotalAssets sdk.Coins `json:"total_assets"`
+	// BidOrderIDs are the ids of the bid orders to fill.
+	BidOrderIDs []uint64 `json:"bid_order_ids"`
+	// SellerSettlementFlatFee is an optional flat fee the contract will pay during settlement.
+	SellerSettlementFlatFee *sdk.Coin `json:"seller_settlement_flat_fee,omitempty"`
+	// AskOrderCreationFee is the fee to pay for creating the ask order that is used to fill the bids.
+	AskOrderCreationFee *sdk.Coin `json:"ask_order_creation_fee,omitempty"`
+}
+
+// FillAsksParams are the params for filling ask orders. The contract is the buyer.
+type FillAsksParams struct {
+	// MarketID is the id of the market with the ask orders.
+	MarketID uint32 `json:"market_id"`
+	// TotalPrice is the amount being paid (must equal the total of the ask orders' prices).
+	TotalPrice sdk.Coin `json:"total_price"`
+	// AskOrderIDs are the ids of the ask orders to fill.
+	AskOrderIDs []uint64 `json:"ask_order_ids"`
+	// BuyerSettlementFees are the fees the contract will pay during settlement.
+	BuyerSettlementFees sdk.Coins `json:"buyer_settlement_fees,omitempty"`
+	// BidOrderCreationFee is the fee to pay for creating the bid order that is used to fill the asks.
+	BidOrderCreationFee *sdk.Coin `json:"bid_order_creation_fee,omitempty"`
+}
+
+// MarketSettleParams are the params for settling orders in a market.
+// The contract must have permission to settle orders in the market.
+type MarketSettleParams struct {
+	// MarketID is the id of the market with the orders.
+	MarketID uint32 `json:"market_id"`
+	// AskOrderIDs are the ids of the ask orders to settle.
+	AskOrderIDs []uint64 `json:"ask_order_ids"`
+	// BidOrderIDs are the ids of the bid orders to settle.
+	BidOrderIDs []uint64 `json:"bid_order_ids"`
+	// ExpectPartial is whether one of the orders is expected to be partially filled.
+	ExpectPartial bool `json:"expect_partial,omitempty"`
+}
+
+// CommitFundsParams are the params for committing funds to a market. The contract is the account with the funds.
+type CommitFundsParams struct {
+	// MarketID is the id of the market to commit the funds to.
+	MarketID uint32 `json:"market_id"`
+	// Amount is the funds to commit.
+	Amount sdk.Coins `json:"amount"`
+	// CreationFee is the fee to pay for the commitment.
+	CreationFee *sdk.Coin `json:"creation_fee,omitempty"`
+	// EventTag is an optional string to include in the events.
+	EventTag string `json:"event_tag,omitempty"`
+}
+
+// MarketCommitmentSettleParams are the params for settling committed funds in a market.
+// The contract must have permission to settle commitments in the market.
+type MarketCommitmentSettleParams struct {
+	// MarketID is the id of the market with the commitments.
+	MarketID uint32 `json:"market_id"`
+	// Inputs are the committed funds being taken from each account.
+	Inputs []exchange.AccountAmount `json:"inputs"`
+	// Outputs are the funds being given (and committed) to each account.
+	Outputs []exchange.AccountAmount `json:"outputs"`
+	// Fees are the committed funds being taken from each account to pay the market.
+	Fees []exchange.AccountAmount `json:"fees,omitempty"`
+	// Navs are the net-asset-values to record.
+	Navs []exchange.NetAssetPrice `json:"navs,omitempty"`
+	// EventTag is an optional string to include in the events.
+	EventTag string `json:"event_tag,omitempty"`
+}
+
+// CreatePaymentParams are the params for creating a payment. The contract is the source.
+type CreatePaymentParams struct {
+	// SourceAmount is the funds the contract will send to the target.
+	SourceAmount sdk.Coins `json:"source_amount,omitempty"`
+	// Target is the bech32 address of the account that can accept the payment.
+	Target string `json:"target,omitempty"`
+	// TargetAmount is the funds the target will send to the contract.
+	TargetAmount sdk.Coins `json:"target_amount,omitempty"`
+	// ExternalID is an id that identifies this payment for the contract.
+	ExternalID string `json:"external_id,omitempty"`
+}
+
+// AcceptPaymentParams are the params for accepting a payment. The contract is the target.
+// The amounts must match the payment exactly.
+type AcceptPaymentParams struct {
+	// Source is the bech32 address of the account that created the payment.
+	Source string `json:"source"`
+	// SourceAmount is the funds the source will send to the contract.
+	SourceAmount sdk.Coins `json:"source_amount,omitempty"`
+	// TargetAmount is the funds the contract will send to the source.
+	TargetAmount sdk.Coins `json:"target_amount,omitempty"`
+	// ExternalID is the id of the payment.
+	ExternalID string `json:"external_id,omitempty"`
+}
+
+// RejectPaymentParams are the params for rejecting a payment. The contract is the target.
+type RejectPaymentParams struct {
+	// Source is the bech32 address of the account that created the payment.
+	Source string `json:"source"`
+	// ExternalID is the id of the payment.
+	ExternalID string `json:"external_id,omitempty"`
+}
+
+// CancelPaymentsParams are the params for cancelling payments. The contract is the source.
+type CancelPaymentsParams struct {
+	// ExternalIDs are the ids of the payments to cancel.
+	ExternalIDs []string `json:"external_ids"`
+}
+
+// Encoder returns a smart contract message encoder for the exchange module.
+func Encoder(contract sdk.AccAddress, msg json.RawMessage, _ string) ([]sdk.Msg, error) {
+	var params ExchangeMsgParams
+	if err := json.Unmarshal(msg, &params); err != nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: fmt.Sprintf("invalid exchange msg params: %v", err), Request: msg}
+	}
+
+	if params.count() > 1 {
+		return nil, wasmvmtypes.InvalidRequest{Err: "invalid exchange msg params: only one message can be provided", Request: msg}
+	}
+
+	addr := contract.String()
+	var encoded sdk.Msg
+	switch {
+	case params.CreateAsk != nil:
+		p := params.CreateAsk
+		encoded = &exchange.MsgCreateAskRequest{
+			AskOrder: exchange.AskOrder{
+				MarketId:                p.MarketID,
+				Seller:                  addr,
+				Assets:                  p.Assets,
+				Price:                   p.Price,
+				SellerSettlementFlatFee: p.SellerSettlementFlatFee,
+				AllowPartial:            p.AllowPartial,
+				ExternalId:              p.ExternalID,
+			},
+			OrderCreationFee: p.OrderCreationFee,
+		}
+	case params.CreateBid != nil:
+		p := params.CreateBid
+		encoded = &exchange.MsgCreateBidRequest{
+			BidOrder: exchange.BidOrder{
+				MarketId:            p.MarketID,
+				Buyer:               addr,
+				Assets:              p.Assets,
+				Price:               p.Price,
+				BuyerSettlementFees: p.BuyerSettlementFees,
+				AllowPartial:        p.AllowPartial,
+				ExternalId:          p.ExternalID,
+			},
+			OrderCreationFee: p.OrderCreationFee,
+		}
+	case params.CancelOrder != nil:
+		encoded = &exchange.MsgCancelOrderRequest{
+			Signer:  addr,
+			OrderId: params.CancelOrder.OrderID,
+		}
+	case params.FillBids != nil:
+		p := params.FillBids
+		encoded = &exchange.MsgFillBidsRequest{
+			Seller:                  addr,
+			MarketId:                p.MarketID,
+			TotalAssets:             p.TotalAssets,
+			BidOrderIds:             p.BidOrderIDs,
+			SellerSettlementFlatFee: p.SellerSettlementFlatFee,
+			AskOrderCreationFee:     p.AskOrderCreationFee,
+		}
+	case params.FillAsks != nil:
+		p := params.FillAsks
+		encoded = &exchange.MsgFillAsksRequest{
+			Buyer:               addr,
+			MarketId:            p.MarketID,
+			TotalPrice:          p.TotalPrice,
+			AskOrderIds:         p.AskOrderIDs,
+			BuyerSettlementFees: p.BuyerSettlementFees,
+			BidOrderCreationFee: p.BidOrderCreationFee,
+		}
+	case params.MarketSettle != nil:
+		p := params.MarketSettle
+		encoded = &exchange.MsgMarketSettleRequest{
+			Admin:         addr,
+			MarketId:      p.MarketID,
+			AskOrderIds:   p.AskOrderIDs,
+			BidOrderIds:   p.BidOrderIDs,
+			ExpectPartial: p.ExpectPartial,
+		}
+	case params.CommitFunds != nil:
+		p := params.CommitFunds
+		encoded = &exchange.MsgCommitFundsRequest{
+			Account:     addr,
+			MarketId:    p.MarketID,
+			Amount:      p.Amount,
+			CreationFee: p.CreationFee,
+			EventTag:    p.EventTag,
+		}
+	case params.MarketCommitmentSettle != nil:
+		p := params.MarketCommitmentSettle
+		encoded = &exchange.MsgMarketCommitmentSettleRequest{
+			Admin:    addr,
+			MarketId: p.MarketID,
+			Inputs:   p.Inputs,
+			Outputs:  p.Outputs,
+			Fees:     p.Fees,
+			Navs:     p.Navs,
+			EventTag: p.EventTag,
+		}
+	case params.CreatePayment != nil:
+		p := params.CreatePayment
+		encoded = &exchange.MsgCreatePaymentRequest{Payment: exchange.Payment{
+			Source:       addr,
+			SourceAmount: p.SourceAmount,
+			Target:       p.Target,
+			TargetAmount: p.TargetAmount,
+			ExternalId:   p.ExternalID,
+		}}
+	case params.AcceptPayment != nil:
+		p := params.AcceptPayment
+		encoded = &exchange.MsgAcceptPaymentRequest{Payment: exchange.Payment{
+			Source:       p.Source,
+			SourceAmount: p.SourceAmount,
+			Target:       addr,
+			TargetAmount: p.TargetAmount,
+			ExternalId:   p.ExternalID,
+		}}
+	case params.RejectPayment != nil:
+		encoded = &exchange.MsgRejectPaymentRequest{
+			Target:     addr,
+			Source:     params.RejectPayment.Source,
+			ExternalId: params.RejectPayment.ExternalID,
+		}
+	case params.CancelPayments != nil:
+		encoded = &exchange.MsgCancelPaymentsRequest{
+			Source:      addr,
+			ExternalIds: params.CancelPayments.ExternalIDs,
+		}
+	default:
+		return nil, wasmvmtypes.InvalidRequest{Err: "invalid exchange msg params: no message provided", Request: msg}
+	}
+
+	if vb, ok := encoded.(interface{ ValidateBasic() error }); ok {
+		if err := vb.ValidateBasic(); err != nil {
+			return nil, wasmvmtypes.InvalidRequest{Err: fmt.Sprintf("invalid exchange msg: %v", err), Request: msg}
+		}
+	}
+	return []sdk.Msg{encoded}, nil
+}
+
+var _ provwasm.Encoder = Encoder
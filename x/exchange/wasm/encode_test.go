@@ -0,0 +1,184 @@
+package wasm
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+func TestEncoder(t *testing.T) {
+	contract := sdk.AccAddress("contract____________")
+	addr := sdk.AccAddress("addr________________").String()
+	coin := func(amount int64, denom string) sdk.Coin {
+		return sdk.NewInt64Coin(denom, amount)
+	}
+	coinP := func(amount int64, denom string) *sdk.Coin {
+		rv := coin(amount, denom)
+		return &rv
+	}
+
+	tests := []struct {
+		name   string
+		msg    string
+		exp    []sdk.Msg
+		expErr []string
+	}{
+		{
+			name: "create ask",
+			msg:  `{"create_ask":{"market_id":3,"assets":{"denom":"apple","amount":"10"},"price":{"denom":"nhash","amount":"50"},"allow_partial":true,"external_id":"abc","order_creation_fee":{"denom":"nhash","amount":"1"}}}`,
+			exp: []sdk.Msg{&exchange.MsgCreateAskRequest{
+				AskOrder: exchange.AskOrder{
+					MarketId:     3,
+					Seller:       contract.String(),
+					Assets:       coin(10, "apple"),
+					Price:        coin(50, "nhash"),
+					AllowPartial: true,
+					ExternalId:   "abc",
+				},
+				OrderCreationFee: coinP(1, "nhash"),
+			}},
+		},
+		{
+			name: "create bid",
+			msg:  `{"create_bid":{"market_id":3,"assets":{"denom":"apple","amount":"10"},"price":{"denom":"nhash","amount":"50"},"buyer_settlement_fees":[{"denom":"nhash","amount":"2"}]}}`,
+			exp: []sdk.Msg{&exchange.MsgCreateBidRequest{
+				BidOrder: exchange.BidOrder{
+					MarketId:            3,
+					Buyer:               contract.String(),
+					Assets:              coin(10, "apple"),
+					Price:               coin(50, "nhash"),
+					BuyerSettlementFees: sdk.Coins{coin(2, "nhash")},
+				},
+			}},
+		},
+		{
+			name: "cancel order",
+			msg:  `{"cancel_order":{"order_id":12}}`,
+			exp:  []sdk.Msg{&exchange.MsgCancelOrderRequest{Signer: contract.String(), OrderId: 12}},
+		},
+		{
+			name: "fill bids",
+			msg:  `{"fill_bids":{"market_id":3,"total_assets":[{"denom":"apple","amount":"10"}],"bid_order_ids":[4,5]}}`,
+			exp: []sdk.Msg{&exchange.MsgFillBidsRequest{
+				Seller:      contract.String(),
+				MarketId:    3,
+				TotalAssets: sdk.Coins{coin(10, "apple")},
+				BidOrderIds: []uint64{4, 5},
+			}},
+		},
+		{
+			name: "fill asks",
+			msg:  `{"fill_asks":{"market_id":3,"total_price":{"denom":"nhash","amount":"50"},"ask_order_ids":[6]}}`,
+			exp: []sdk.Msg{&exchange.MsgFillAsksRequest{
+				Buyer:       contract.String(),
+				MarketId:    3,
+				TotalPrice:  coin(50, "nhash"),
+				AskOrderIds: []uint64{6},
+			}},
+		},
+		{
+			name: "market settle",
+			msg:  `{"market_settle":{"market_id":3,"ask_order_ids":[1],"bid_order_ids":[2],"expect_partial":true}}`,
+			exp: []sdk.Msg{&exchange.MsgMarketSettleRequest{
+				Admin:         contract.String(),
+				MarketId:      3,
+				AskOrderIds:   []uint64{1},
+				BidOrderIds:   []uint64{2},
+				ExpectPartial: true,
+			}},
+		},
+		{
+			name: "commit funds",
+			msg:  `{"commit_funds":{"market_id":3,"amount":[{"denom":"nhash","amount":"100"}],"event_tag":"tag"}}`,
+			exp: []sdk.Msg{&exchange.MsgCommitFundsRequest{
+				Account:  contract.String(),
+				MarketId: 3,
+				Amount:   sdk.Coins{coin(100, "nhash")},
+				EventTag: "tag",
+			}},
+		},
+		{
+			name: "market commitment settle",
+			msg: `{"market_commitment_settle":{"market_id":3,` +
+				`"inputs":[{"account":"` + addr + `","amount":[{"denom":"nhash","amount":"100"}]}],` +
+				`"outputs":[{"account":"` + contract.String() + `","amount":[{"denom":"nhash","amount":"100"}]}]}}`,
+			exp: []sdk.Msg{&exchange.MsgMarketCommitmentSettleRequest{
+				Admin:    contract.String(),
+				MarketId: 3,
+				Inputs:   []exchange.AccountAmount{{Account: addr, Amount: sdk.Coins{coin(100, "nhash")}}},
+				Outputs:  []exchange.AccountAmount{{Account: contract.String(), Amount: sdk.Coins{coin(100, "nhash")}}},
+			}},
+		},
+		{
+			name: "create payment",
+			msg:  `{"create_payment":{"source_amount":[{"denom":"nhash","amount":"7"}],"target":"` + addr + `","external_id":"pay1"}}`,
+			exp: []sdk.Msg{&exchange.MsgCreatePaymentRequest{Payment: exchange.Payment{
+				Source:       contract.String(),
+				SourceAmount: sdk.Coins{coin(7, "nhash")},
+				Target:       addr,
+				ExternalId:   "pay1",
+			}}},
+		},
+		{
+			name: "accept payment",
+			msg:  `{"accept_payment":{"source":"` + addr + `","target_amount":[{"denom":"apple","amount":"3"}],"external_id":"pay2"}}`,
+			exp: []sdk.Msg{&exchange.MsgAcceptPaymentRequest{Payment: exchange.Payment{
+				Source:       addr,
+				Target:       contract.String(),
+				TargetAmount: sdk.Coins{coin(3, "apple")},
+				ExternalId:   "pay2",
+			}}},
+		},
+		{
+			name: "reject payment",
+			msg:  `{"reject_payment":{"source":"` + addr + `","external_id":"pay3"}}`,
+			exp: []sdk.Msg{&exchange.MsgRejectPaymentRequest{
+				Target:     contract.String(),
+				Source:     addr,
+				ExternalId: "pay3",
+			}},
+		},
+		{
+			name: "cancel payments",
+			msg:  `{"cancel_payments":{"external_ids":["pay4","pay5"]}}`,
+			exp: []sdk.Msg{&exchange.MsgCancelPaymentsRequest{
+				Source:      contract.String(),
+				ExternalIds: []string{"pay4", "pay5"},
+			}},
+		},
+		{
+			name:   "invalid msg",
+			msg:    `{"cancel_order":{"order_id":0}}`,
+			expErr: []string{"invalid exchange msg", "invalid order id"},
+		},
+		{
+			name:   "empty",
+			msg:    `{}`,
+			expErr: []string{"no message provided"},
+		},
+		{
+			name:   "two messages",
+			msg:    `{"cancel_order":{"order_id":1},"cancel_payments":{"external_ids":["a"]}}`,
+			expErr: []string{"only one message can be provided"},
+		},
+		{
+			name:   "not json",
+			msg:    `[`,
+			expErr: []string{"invalid exchange msg params"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msgs, err := Encoder(contract, json.RawMessage(tc.msg), "")
+			assertions.AssertErrorContents(t, err, tc.expErr, "Encoder error")
+			assert.Equal(t, tc.exp, msgs, "Encoder msgs")
+		})
+	}
+}
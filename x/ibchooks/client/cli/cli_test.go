@@ -98,6 +98,23 @@ func (s *IntegrationTestSuite) TestUpdateParamsCmd() {
 			args:         []string{fmt.Sprintf("%v,%v", s.accountAddr.String(), sdk.AccAddress("input111111111111111").String())},
 			expectedCode: 0,
 		},
+		{
+			name: "success - update packet forwarding params",
+			args: []string{
+				s.accountAddr.String(),
+				"--" + ibchookscli.FlagForwardingEnabled + "=false",
+				"--" + ibchookscli.FlagMaxForwardHops, "2",
+			},
+			expectedCode: 0,
+		},
+		{
+			name: "success - disable ibc marker auto-registration",
+			args: []string{
+				s.accountAddr.String(),
+				"--" + ibchookscli.FlagAutoRegisterMarkers + "=false",
+			},
+			expectedCode: 0,
+		},
 		{
 			name:         "failure - invalid args",
 			args:         []string{"contract1"},
@@ -31,14 +31,33 @@ func NewTxCmd() *cobra.Command {
 	return txCmd
 }
 
+const (
+	// FlagForwardingEnabled is the flag for the packet_forwarding_enabled param.
+	FlagForwardingEnabled = "forwarding-enabled"
+	// FlagMaxForwardHops is the flag for the max_forward_hops param.
+	FlagMaxForwardHops = "max-forward-hops"
+	// FlagAutoRegisterMarkers is the flag for the auto_register_ibc_markers param.
+	FlagAutoRegisterMarkers = "auto-register-markers"
+)
+
 // NewUpdateParamsCmd creates a command to update the ibchooks module's params via governance proposal.
 func NewUpdateParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "update-params <allowed-async-ack-contracts>",
-		Short:   "Update the ibchooks module's params via governance proposal",
-		Long:    "Submit an update params via governance proposal along with an initial deposit.",
-		Args:    cobra.ExactArgs(1),
-		Example: fmt.Sprintf(`%[1]s tx ibchooks update-params contract1,contract2 --deposit 50000nhash`, version.AppName),
+		Use:   "update-params <allowed-async-ack-contracts>",
+		Short: "Update the ibchooks module's params via governance proposal",
+		Long: fmt.Sprintf(`Submit an update params via governance proposal along with an initial deposit.
+
+The --%[1]s flag controls whether incoming transfers may be forwarded to other chains (default %[3]t).
+The --%[2]s flag is the maximum number of hops a forward memo may define, 0 = no limit (default %[4]d).
+The --%[5]s flag controls whether markers are created for newly received ibc denoms (default %[6]t).`,
+			FlagForwardingEnabled, FlagMaxForwardHops, types.DefaultPacketForwardingEnabled, types.DefaultMaxForwardHops,
+			FlagAutoRegisterMarkers, types.DefaultAutoRegisterIBCMarkers),
+		Args: cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%[1]s tx ibchooks update-params contract1,contract2 --deposit 50000nhash
+%[1]s tx ibchooks update-params contract1 --%[2]s=false --deposit 50000nhash
+%[1]s tx ibchooks update-params contract1 --%[3]s 2 --deposit 50000nhash
+%[1]s tx ibchooks update-params contract1 --%[4]s=false --deposit 50000nhash`,
+			version.AppName, FlagForwardingEnabled, FlagMaxForwardHops, FlagAutoRegisterMarkers),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -48,8 +67,20 @@ func NewUpdateParamsCmd() *cobra.Command {
 			flagSet := cmd.Flags()
 			authority := provcli.GetAuthority(flagSet)
 			allowedAsyncAckContracts := strings.Split(args[0], ",")
+			forwardingEnabled, err := flagSet.GetBool(FlagForwardingEnabled)
+			if err != nil {
+				return err
+			}
+			maxForwardHops, err := flagSet.GetUint32(FlagMaxForwardHops)
+			if err != nil {
+				return err
+			}
+			autoRegisterMarkers, err := flagSet.GetBool(FlagAutoRegisterMarkers)
+			if err != nil {
+				return err
+			}
 
-			msg := types.NewMsgUpdateParamsRequest(allowedAsyncAckContracts, authority)
+			msg := types.NewMsgUpdateParamsRequest(allowedAsyncAckContracts, forwardingEnabled, maxForwardHops, autoRegisterMarkers, authority)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -57,6 +88,9 @@ func NewUpdateParamsCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Bool(FlagForwardingEnabled, types.DefaultPacketForwardingEnabled, "Whether incoming transfers may be forwarded to other chains")
+	cmd.Flags().Uint32(FlagMaxForwardHops, types.DefaultMaxForwardHops, "The maximum number of hops a forward memo may define (0 = no limit)")
+	cmd.Flags().Bool(FlagAutoRegisterMarkers, types.DefaultAutoRegisterIBCMarkers, "Whether markers are created for newly received ibc denoms")
 	govcli.AddGovPropFlagsToCmd(cmd)
 	provcli.AddAuthorityFlagToCmd(cmd)
 	flags.AddTxFlagsToCmd(cmd)
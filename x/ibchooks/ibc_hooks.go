@@ -43,18 +43,24 @@ func (h IbcHooks) GetSendPacketPreProcessors() []types.PreSendPacketDataProcessi
 	return h.SendPacketPreProcessors
 }
 
-// OnRecvPacketOverride executes wasm or marker hooks for Ics20 packets, if not ics20 packet it will continue to process packet with no override
+// OnRecvPacketOverride executes wasm or marker hooks for Ics20 packets, if not ics20 packet it will continue to process packet with no override.
+// Packets with a forward memo are rejected if packet forwarding is disabled or the memo defines too many hops.
 func (h IbcHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdktypes.Context, packet channeltypes.Packet, relayer sdktypes.AccAddress) ibcexported.Acknowledgement {
 	if !h.ProperlyConfigured() {
 		return im.App.OnRecvPacket(ctx, packet, relayer)
 	}
 
-	isIcs20, _ := isIcs20Packet(packet.GetData())
+	isIcs20, data := isIcs20Packet(packet.GetData())
 	if !isIcs20 {
 		return im.App.OnRecvPacket(ctx, packet, relayer)
 	}
 
-	if err := h.markerHooks.AddUpdateMarker(ctx, packet, h.ibcKeeper); err != nil {
+	if err := h.ibcHooksKeeper.ValidatePacketForward(ctx, data.GetMemo()); err != nil {
+		return NewEmitErrorAcknowledgement(ctx, err)
+	}
+
+	autoRegister := h.ibcHooksKeeper.IsIBCMarkerAutoRegistrationEnabled(ctx)
+	if err := h.markerHooks.AddUpdateMarker(ctx, packet, h.ibcKeeper, autoRegister); err != nil {
 		return NewEmitErrorAcknowledgement(ctx, types.ErrMarkerError, err.Error())
 	}
 	return h.wasmHooks.OnRecvPacketOverride(im, ctx, packet, relayer)
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/provenance-io/provenance/x/ibchooks/types"
+)
+
+// RegisterModuleCallback sets the handler to invoke when a packet sent with a
+// src_callback address of the given module's account is acknowledged or times out.
+// This should only be called during app setup. It panics if the module already has a handler.
+func (k Keeper) RegisterModuleCallback(moduleName string, handler types.PacketCallbackHandler) {
+	addr := authtypes.NewModuleAddress(moduleName).String()
+	if _, exists := k.moduleCallbacks[addr]; exists {
+		panic(fmt.Errorf("packet callback handler already registered for module %q", moduleName))
+	}
+	k.moduleCallbacks[addr] = handler
+}
+
+// GetModuleCallback returns the packet callback handler registered for the provided address.
+func (k Keeper) GetModuleCallback(addr string) (types.PacketCallbackHandler, bool) {
+	handler, found := k.moduleCallbacks[addr]
+	return handler, found
+}
+
+// ValidateSrcCallback returns an error if the provided src_callback address cannot be
+// used for a packet sent by the given sender. As defined in ADR-8, only the sender of a
+// packet is allowed to register itself for the packet's callbacks.
+func (k Keeper) ValidateSrcCallback(_ sdk.Context, sender, callbackAddr string) error {
+	if _, err := sdk.AccAddressFromBech32(callbackAddr); err != nil {
+		return types.ErrInvalidCallback.Wrapf("invalid callback address %q: %v", callbackAddr, err)
+	}
+	if callbackAddr != sender {
+		return types.ErrInvalidCallback.Wrapf("callback address %q does not equal packet sender %q", callbackAddr, sender)
+	}
+	return nil
+}
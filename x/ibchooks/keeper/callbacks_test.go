@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+	"github.com/provenance-io/provenance/x/ibchooks/keeper"
+)
+
+type testCallbackHandler struct{}
+
+func (testCallbackHandler) OnPacketAcknowledged(_ sdk.Context, _ channeltypes.Packet, _ []byte, _ bool) error {
+	return nil
+}
+
+func (testCallbackHandler) OnPacketTimeout(_ sdk.Context, _ channeltypes.Packet) error {
+	return nil
+}
+
+func TestRegisterModuleCallback(t *testing.T) {
+	k := keeper.NewKeeper(nil, nil, nil, nil)
+	moduleAddr := authtypes.NewModuleAddress("testmodule").String()
+
+	_, found := k.GetModuleCallback(moduleAddr)
+	assert.False(t, found, "GetModuleCallback before registration")
+
+	require.NotPanics(t, func() {
+		k.RegisterModuleCallback("testmodule", testCallbackHandler{})
+	}, "RegisterModuleCallback")
+	handler, found := k.GetModuleCallback(moduleAddr)
+	assert.True(t, found, "GetModuleCallback after registration")
+	assert.Equal(t, testCallbackHandler{}, handler, "GetModuleCallback handler")
+
+	_, found = k.GetModuleCallback(authtypes.NewModuleAddress("othermodule").String())
+	assert.False(t, found, "GetModuleCallback for other module")
+
+	assert.PanicsWithError(t, `packet callback handler already registered for module "testmodule"`, func() {
+		k.RegisterModuleCallback("testmodule", testCallbackHandler{})
+	}, "RegisterModuleCallback again")
+}
+
+func TestValidateSrcCallback(t *testing.T) {
+	sender := sdk.AccAddress("sender______________").String()
+	other := sdk.AccAddress("other_______________").String()
+
+	tests := []struct {
+		name     string
+		sender   string
+		callback string
+		expErr   string
+	}{
+		{
+			name:     "invalid callback address",
+			sender:   sender,
+			callback: "notabech32",
+			expErr:   `invalid callback address "notabech32": decoding bech32 failed: invalid separator index -1: invalid packet callback`,
+		},
+		{
+			name:     "callback is not sender",
+			sender:   sender,
+			callback: other,
+			expErr:   `callback address "` + other + `" does not equal packet sender "` + sender + `": invalid packet callback`,
+		},
+		{
+			name:     "callback is sender",
+			sender:   sender,
+			callback: sender,
+		},
+	}
+
+	k := keeper.NewKeeper(nil, nil, nil, nil)
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := k.ValidateSrcCallback(sdk.Context{}, tc.sender, tc.callback)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ValidateSrcCallback")
+		})
+	}
+}
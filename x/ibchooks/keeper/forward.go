@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/ibchooks/types"
+)
+
+// IsPacketForwardingEnabled returns true if incoming packets are allowed to be forwarded to other chains.
+func (k Keeper) IsPacketForwardingEnabled(ctx sdk.Context) bool {
+	return k.GetParams(ctx).PacketForwardingEnabled
+}
+
+// GetMaxForwardHops returns the maximum number of hops a forward memo may define (zero = no limit).
+func (k Keeper) GetMaxForwardHops(ctx sdk.Context) uint32 {
+	return k.GetParams(ctx).MaxForwardHops
+}
+
+// ValidatePacketForward returns an error if the provided ICS-20 memo requests a packet
+// forward that isn't currently allowed. Memos without a "forward" entry are always allowed.
+func (k Keeper) ValidatePacketForward(ctx sdk.Context, memo string) error {
+	hops := CountForwardHops(memo)
+	if hops == 0 {
+		return nil
+	}
+
+	params := k.GetParams(ctx)
+	if !params.PacketForwardingEnabled {
+		return types.ErrPacketForwardDisabled
+	}
+	if params.MaxForwardHops > 0 && hops > params.MaxForwardHops {
+		return types.ErrTooManyForwardHops.Wrapf("memo defines %d hops, max is %d", hops, params.MaxForwardHops)
+	}
+	return nil
+}
+
+// CountForwardHops returns the number of hops defined in the provided memo.
+// Each "forward" object counts as a hop; nested ones are found in the "next" field.
+// A memo without a "forward" entry (or that isn't a JSON object) has zero hops.
+func CountForwardHops(memo string) uint32 {
+	var count uint32
+	next := []byte(memo)
+	for len(next) > 0 {
+		var entry map[string]json.RawMessage
+		if err := json.Unmarshal(next, &entry); err != nil {
+			// The "next" field is allowed to be a string containing escaped json.
+			var str string
+			if err = json.Unmarshal(next, &str); err != nil {
+				break
+			}
+			next = []byte(str)
+			continue
+		}
+
+		forwardBz, ok := entry["forward"]
+		if !ok {
+			break
+		}
+		count++
+
+		var forward map[string]json.RawMessage
+		if err := json.Unmarshal(forwardBz, &forward); err != nil {
+			break
+		}
+		next = forward["next"]
+	}
+	return count
+}
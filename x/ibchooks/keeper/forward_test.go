@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/testutil/assertions"
+	"github.com/provenance-io/provenance/x/ibchooks/keeper"
+	"github.com/provenance-io/provenance/x/ibchooks/types"
+)
+
+type ForwardTestSuite struct {
+	suite.Suite
+
+	app *app.App
+	ctx sdk.Context
+}
+
+func (s *ForwardTestSuite) SetupTest() {
+	s.app = app.Setup(s.T())
+	s.ctx = s.app.BaseApp.NewContextLegacy(false, cmtproto.Header{Time: time.Now()})
+}
+
+func TestForwardTestSuite(t *testing.T) {
+	suite.Run(t, new(ForwardTestSuite))
+}
+
+func (s *ForwardTestSuite) TestValidatePacketForward() {
+	oneHop := `{"forward":{"receiver":"addr","port":"transfer","channel":"channel-1"}}`
+	threeHops := `{"forward":{"receiver":"addr","port":"transfer","channel":"channel-1","next":` +
+		`{"forward":{"receiver":"addr","port":"transfer","channel":"channel-2","next":` +
+		`{"forward":{"receiver":"addr","port":"transfer","channel":"channel-3"}}}}}}`
+
+	tests := []struct {
+		name   string
+		params types.Params
+		memo   string
+		expErr string
+	}{
+		{
+			name:   "disabled: no forward",
+			params: types.NewParams(nil, false, 0, true),
+			memo:   `{"wasm":{"contract":"addr","msg":{}}}`,
+		},
+		{
+			name:   "disabled: one hop",
+			params: types.NewParams(nil, false, 0, true),
+			memo:   oneHop,
+			expErr: "packet forwarding is disabled",
+		},
+		{
+			name:   "enabled: one hop",
+			params: types.NewParams(nil, true, 1, true),
+			memo:   oneHop,
+		},
+		{
+			name:   "enabled: three hops, max two",
+			params: types.NewParams(nil, true, 2, true),
+			memo:   threeHops,
+			expErr: "memo defines 3 hops, max is 2: too many packet forward hops",
+		},
+		{
+			name:   "enabled: three hops, max three",
+			params: types.NewParams(nil, true, 3, true),
+			memo:   threeHops,
+		},
+		{
+			name:   "enabled: three hops, no max",
+			params: types.NewParams(nil, true, 0, true),
+			memo:   threeHops,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.app.IBCHooksKeeper.SetParams(s.ctx, tc.params)
+			err := s.app.IBCHooksKeeper.ValidatePacketForward(s.ctx, tc.memo)
+			assertions.AssertErrorValue(s.T(), err, tc.expErr, "ValidatePacketForward")
+		})
+	}
+}
+
+func TestCountForwardHops(t *testing.T) {
+	tests := []struct {
+		name string
+		memo string
+		exp  uint32
+	}{
+		{name: "empty memo", memo: "", exp: 0},
+		{name: "not json", memo: "just some text", exp: 0},
+		{name: "json without forward", memo: `{"wasm":{"contract":"addr"}}`, exp: 0},
+		{name: "forward not an object", memo: `{"forward":"nope"}`, exp: 1},
+		{name: "one hop", memo: `{"forward":{"receiver":"addr","port":"transfer","channel":"channel-1"}}`, exp: 1},
+		{
+			name: "two hops, next is object",
+			memo: `{"forward":{"channel":"channel-1","next":{"forward":{"channel":"channel-2"}}}}`,
+			exp:  2,
+		},
+		{
+			name: "two hops, next is escaped string",
+			memo: `{"forward":{"channel":"channel-1","next":"{\"forward\":{\"channel\":\"channel-2\"}}"}}`,
+			exp:  2,
+		},
+		{
+			name: "three hops, next has a wasm entry at the end",
+			memo: `{"forward":{"channel":"channel-1","next":{"forward":{"channel":"channel-2","next":` +
+				`{"forward":{"channel":"channel-3","next":{"wasm":{"contract":"addr"}}}}}}}}`,
+			exp: 3,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var act uint32
+			testFunc := func() {
+				act = keeper.CountForwardHops(tc.memo)
+			}
+			if assert.NotPanics(t, testFunc, "CountForwardHops") {
+				assert.Equal(t, tc.exp, act, "CountForwardHops result")
+			}
+		})
+	}
+}
@@ -32,6 +32,9 @@ type (
 		channelKeeper  types.ChannelKeeper
 		ContractKeeper *wasmkeeper.PermissionedKeeper
 		authority      string
+
+		// moduleCallbacks maps module account addresses to the handlers for their packet callbacks.
+		moduleCallbacks map[string]types.PacketCallbackHandler
 	}
 )
 
@@ -48,6 +51,8 @@ func NewKeeper(
 		channelKeeper:  channelKeeper,
 		ContractKeeper: contractKeeper,
 		authority:      authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+
+		moduleCallbacks: make(map[string]types.PacketCallbackHandler),
 	}
 	return keeper
 }
@@ -52,6 +52,9 @@ func (m msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 	m.SetParams(ctx, msg.Params)
 	if err := ctx.EventManager().EmitTypedEvent(&types.EventIBCHooksParamsUpdated{
 		AllowedAsyncAckContracts: msg.Params.AllowedAsyncAckContracts,
+		PacketForwardingEnabled:  msg.Params.PacketForwardingEnabled,
+		MaxForwardHops:           msg.Params.MaxForwardHops,
+		AutoRegisterIbcMarkers:   msg.Params.AutoRegisterIbcMarkers,
 	}); err != nil {
 		return nil, err
 	}
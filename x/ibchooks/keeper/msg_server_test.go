@@ -75,16 +75,21 @@ func (s *MsgServerTestSuite) TestUpdateParams() {
 			name: "valid authority with valid params",
 			msg: types.NewMsgUpdateParamsRequest(
 				[]string{"cosmos1vh3htvc46rshps02w0p5hchdkrjvc4d8nxkw5t"},
+				true, 3, true,
 				authority,
 			),
 			expectedEvent: &types.EventIBCHooksParamsUpdated{
 				AllowedAsyncAckContracts: []string{"cosmos1vh3htvc46rshps02w0p5hchdkrjvc4d8nxkw5t"},
+				PacketForwardingEnabled:  true,
+				MaxForwardHops:           3,
+				AutoRegisterIbcMarkers:   true,
 			},
 		},
 		{
 			name: "invalid authority",
 			msg: types.NewMsgUpdateParamsRequest(
 				[]string{"cosmos1vh3htvc46rshps02w0p5hchdkrjvc4d8nxkw5t"},
+				false, 0, false,
 				"invalid-authority",
 			),
 			expectedError: `expected "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn" got "invalid-authority": expected gov account as only signer for proposal message`,
@@ -23,3 +23,8 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	bz := k.cdc.MustMarshal(&params)
 	store.Set(types.IbcHooksParamStoreKey, bz)
 }
+
+// IsIBCMarkerAutoRegistrationEnabled returns true if markers should be created for newly received ibc denoms.
+func (k Keeper) IsIBCMarkerAutoRegistrationEnabled(ctx sdk.Context) bool {
+	return k.GetParams(ctx).AutoRegisterIbcMarkers
+}
@@ -35,8 +35,9 @@ func (h MarkerHooks) ProperlyConfigured() bool {
 	return h.MarkerKeeper != nil
 }
 
-// AddUpdateMarker will add or update ibc Marker with transfer authorities
-func (h MarkerHooks) AddUpdateMarker(ctx sdktypes.Context, packet exported.PacketI, ibcKeeper *ibckeeper.Keeper) error {
+// AddUpdateMarker will add or update ibc Marker with transfer authorities.
+// A marker is only created for a newly received ibc denom when autoRegister is true.
+func (h MarkerHooks) AddUpdateMarker(ctx sdktypes.Context, packet exported.PacketI, ibcKeeper *ibckeeper.Keeper, autoRegister bool) error {
 	var data transfertypes.FungibleTokenPacketData
 	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
 		return err
@@ -63,6 +64,9 @@ func (h MarkerHooks) AddUpdateMarker(ctx sdktypes.Context, packet exported.Packe
 	if marker != nil {
 		return h.updateMarkerProperties(ctx, transferAuthAddrs, marker, allowForceTransfer)
 	}
+	if !autoRegister {
+		return nil
+	}
 	return h.createNewIbcMarker(ctx, data, ibcDenom, coinType, transferAuthAddrs, allowForceTransfer, packet, ibcKeeper)
 }
 
@@ -143,7 +143,7 @@ func (suite *MarkerHooksTestSuite) TestAddUpdateMarker() {
 	for _, tc := range testCases {
 		suite.T().Run(tc.name, func(t *testing.T) {
 			packet := suite.makeMockPacket(tc.denom, "", tc.memo, 0)
-			err := markerHooks.AddUpdateMarker(suite.chainA.GetContext(), packet, suite.chainA.GetProvenanceApp().IBCKeeper)
+			err := markerHooks.AddUpdateMarker(suite.chainA.GetContext(), packet, suite.chainA.GetProvenanceApp().IBCKeeper, true)
 			if len(tc.expErr) > 0 {
 				assert.EqualError(t, err, tc.expErr, "ProcessMarkerMemo() error")
 			} else {
@@ -169,6 +169,32 @@ func (suite *MarkerHooksTestSuite) TestAddUpdateMarker() {
 	}
 }
 
+func (suite *MarkerHooksTestSuite) TestAddUpdateMarkerAutoRegisterDisabled() {
+	ctx := suite.chainA.GetContext()
+	provApp := suite.chainA.GetProvenanceApp()
+	markerHooks := ibchooks.NewMarkerHooks(&provApp.MarkerKeeper)
+	packet := suite.makeMockPacket("unregistereddenom", "", "", 0)
+	ibcDenom := ibchooks.MustExtractDenomFromPacketOnRecv(packet)
+
+	err := markerHooks.AddUpdateMarker(ctx, packet, provApp.IBCKeeper, false)
+	suite.Require().NoError(err, "AddUpdateMarker with auto-registration disabled")
+	_, err = provApp.MarkerKeeper.GetMarkerByDenom(ctx, ibcDenom)
+	suite.Assert().Error(err, "GetMarkerByDenom(%q) after receive with auto-registration disabled", ibcDenom)
+	_, found := provApp.BankKeeper.GetDenomMetaData(ctx, ibcDenom)
+	suite.Assert().False(found, "GetDenomMetaData(%q) found after receive with auto-registration disabled", ibcDenom)
+
+	// Markers that already exist are still updated when auto-registration is disabled.
+	memo := `{"marker":{"transfer-auths":["` + sdk.AccAddress("address1").String() + `"]}}`
+	packet = suite.makeMockPacket("unregistereddenom", "", memo, 1)
+	err = markerHooks.AddUpdateMarker(ctx, packet, provApp.IBCKeeper, true)
+	suite.Require().NoError(err, "AddUpdateMarker with auto-registration enabled")
+	err = markerHooks.AddUpdateMarker(ctx, suite.makeMockPacket("unregistereddenom", "", "", 2), provApp.IBCKeeper, false)
+	suite.Require().NoError(err, "AddUpdateMarker of existing marker with auto-registration disabled")
+	marker, err := provApp.MarkerKeeper.GetMarkerByDenom(ctx, ibcDenom)
+	suite.Require().NoError(err, "GetMarkerByDenom(%q)", ibcDenom)
+	suite.Assert().Empty(marker.GetAccessList(), "marker access list after update without transfer auths")
+}
+
 func (suite *MarkerHooksTestSuite) TestProcessMarkerMemo() {
 	address1 := sdk.AccAddress("address1")
 	address2 := sdk.AccAddress("address2")
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// PacketCallbackHandler is implemented by modules that want to be told about the
+// acknowledgement or timeout of the packets they send with an ADR-8 src_callback.
+type PacketCallbackHandler interface {
+	// OnPacketAcknowledged is called after the acknowledgement of a packet has been processed.
+	OnPacketAcknowledged(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, success bool) error
+	// OnPacketTimeout is called after the timeout of a packet has been processed.
+	OnPacketTimeout(ctx sdk.Context, packet channeltypes.Packet) error
+}
+
+// SrcCallbackMemo is the ADR-8 source callback entry of an ICS-20 memo.
+type SrcCallbackMemo struct {
+	SrcCallback SrcCallbackData `json:"src_callback"`
+}
+
+// SrcCallbackData contains the address of the actor to invoke when a sent packet is acknowledged or times out.
+type SrcCallbackData struct {
+	Address string `json:"address"`
+}
+
+// ParseSrcCallback extracts the ADR-8 src_callback address from a memo.
+// If the memo does not have a src_callback entry, found will be false.
+// An error is returned if the entry exists, but is not properly formatted.
+func ParseSrcCallback(memo string) (address string, found bool, err error) {
+	if len(memo) == 0 {
+		return "", false, nil
+	}
+
+	var entries map[string]json.RawMessage
+	if err = json.Unmarshal([]byte(memo), &entries); err != nil {
+		return "", false, nil
+	}
+	if _, found = entries[SrcCallbackKey]; !found {
+		return "", false, nil
+	}
+
+	var cb SrcCallbackMemo
+	if err = json.Unmarshal([]byte(memo), &cb); err != nil {
+		return "", true, fmt.Errorf("invalid %s: %w", SrcCallbackKey, err)
+	}
+	if len(cb.SrcCallback.Address) == 0 {
+		return "", true, fmt.Errorf("invalid %s: address cannot be empty", SrcCallbackKey)
+	}
+	return cb.SrcCallback.Address, true, nil
+}
@@ -19,4 +19,9 @@ var (
 	ErrAckPacketMismatch   = errorsmod.Register("wasm-hooks", 10, "packet does not match the expected packet")
 	ErrInvalidContractAddr = errorsmod.Register("wasm-hooks", 11, "invalid contract address")
 	ErrMarkerError         = errorsmod.Register("marker-hooks", 12, "marker error")
+
+	ErrPacketForwardDisabled = errorsmod.Register("forward-hooks", 13, "packet forwarding is disabled")
+	ErrTooManyForwardHops    = errorsmod.Register("forward-hooks", 14, "too many packet forward hops")
+
+	ErrInvalidCallback = errorsmod.Register("wasm-hooks", 15, "invalid packet callback")
 )
@@ -25,6 +25,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // EventIBCHooksParamsUpdated defines the event emitted after updating ibchooks parameters.
 type EventIBCHooksParamsUpdated struct {
 	AllowedAsyncAckContracts []string `protobuf:"bytes,1,rep,name=allowed_async_ack_contracts,json=allowedAsyncAckContracts,proto3" json:"allowed_async_ack_contracts,omitempty"`
+	PacketForwardingEnabled  bool     `protobuf:"varint,2,opt,name=packet_forwarding_enabled,json=packetForwardingEnabled,proto3" json:"packet_forwarding_enabled,omitempty"`
+	MaxForwardHops           uint32   `protobuf:"varint,3,opt,name=max_forward_hops,json=maxForwardHops,proto3" json:"max_forward_hops,omitempty"`
+	AutoRegisterIbcMarkers   bool     `protobuf:"varint,4,opt,name=auto_register_ibc_markers,json=autoRegisterIbcMarkers,proto3" json:"auto_register_ibc_markers,omitempty"`
 }
 
 func (m *EventIBCHooksParamsUpdated) Reset()         { *m = EventIBCHooksParamsUpdated{} }
@@ -67,6 +70,27 @@ func (m *EventIBCHooksParamsUpdated) GetAllowedAsyncAckContracts() []string {
 	return nil
 }
 
+func (m *EventIBCHooksParamsUpdated) GetPacketForwardingEnabled() bool {
+	if m != nil {
+		return m.PacketForwardingEnabled
+	}
+	return false
+}
+
+func (m *EventIBCHooksParamsUpdated) GetMaxForwardHops() uint32 {
+	if m != nil {
+		return m.MaxForwardHops
+	}
+	return 0
+}
+
+func (m *EventIBCHooksParamsUpdated) GetAutoRegisterIbcMarkers() bool {
+	if m != nil {
+		return m.AutoRegisterIbcMarkers
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*EventIBCHooksParamsUpdated)(nil), "provenance.ibchooks.v1.EventIBCHooksParamsUpdated")
 }
@@ -76,21 +100,28 @@ func init() {
 }
 
 var fileDescriptor_21721d39ea27ad02 = []byte{
-	// 217 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2a, 0x28, 0xca, 0x2f,
-	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0xcf, 0x4c, 0x4a, 0xce, 0xc8, 0xcf, 0xcf, 0x2e, 0xd6,
-	0x2f, 0x33, 0xd4, 0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x43, 0xa8, 0xd1, 0x83, 0xa9, 0xd1, 0x2b, 0x33, 0x54, 0x8a, 0xe6, 0x92, 0x72, 0x05, 0x29, 0xf3,
-	0x74, 0x72, 0xf6, 0x00, 0x89, 0x05, 0x24, 0x16, 0x25, 0xe6, 0x16, 0x87, 0x16, 0xa4, 0x24, 0x96,
-	0xa4, 0xa6, 0x08, 0xd9, 0x72, 0x49, 0x27, 0xe6, 0xe4, 0xe4, 0x97, 0xa7, 0xa6, 0xc4, 0x27, 0x16,
-	0x57, 0xe6, 0x25, 0xc7, 0x27, 0x26, 0x67, 0xc7, 0x27, 0xe7, 0xe7, 0x95, 0x14, 0x25, 0x26, 0x97,
-	0x14, 0x4b, 0x30, 0x2a, 0x30, 0x6b, 0x70, 0x06, 0x49, 0x40, 0x95, 0x38, 0x82, 0x54, 0x38, 0x26,
-	0x67, 0x3b, 0xc3, 0xe4, 0x9d, 0xb2, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1,
-	0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x81,
-	0x4b, 0x32, 0x33, 0x5f, 0x0f, 0xbb, 0x8b, 0x02, 0x18, 0xa3, 0x4c, 0xd2, 0x33, 0x4b, 0x32, 0x4a,
-	0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x11, 0x8a, 0x74, 0x33, 0xf3, 0x91, 0x78, 0xfa, 0x15, 0x08,
-	0xaf, 0x96, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x3d, 0x6a, 0x0c, 0x08, 0x00, 0x00, 0xff,
-	0xff, 0x14, 0xe2, 0x7a, 0x26, 0x0e, 0x01, 0x00, 0x00,
+	// 324 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0xd1, 0x3f, 0x4b, 0xfb, 0x40,
+	0x18, 0xc0, 0xf1, 0xde, 0xaf, 0x3f, 0x44, 0x03, 0x8a, 0x64, 0xa8, 0xa9, 0x42, 0x28, 0x9d, 0xb2,
+	0x98, 0x50, 0x74, 0x51, 0x70, 0x68, 0x4b, 0xa5, 0x1d, 0x84, 0x12, 0x70, 0x71, 0x39, 0x9e, 0x5c,
+	0xce, 0xf6, 0x48, 0x73, 0xcf, 0x71, 0x77, 0x4d, 0xdb, 0x77, 0xe1, 0xcb, 0x72, 0xec, 0xe8, 0x28,
+	0xed, 0xdb, 0x70, 0x90, 0xf4, 0x8f, 0x75, 0x70, 0xbc, 0xfb, 0x7e, 0x8e, 0x83, 0xe7, 0x71, 0x9a,
+	0x4a, 0x63, 0xc1, 0x25, 0x48, 0xc6, 0x23, 0x91, 0xb0, 0x31, 0x62, 0x66, 0xa2, 0xa2, 0x15, 0xf1,
+	0x82, 0x4b, 0x1b, 0x2a, 0x8d, 0x16, 0xdd, 0xda, 0xc1, 0x84, 0x7b, 0x13, 0x16, 0xad, 0xe6, 0x17,
+	0x71, 0x2e, 0x7b, 0xa5, 0x1b, 0x74, 0xba, 0xfd, 0xf2, 0x72, 0x08, 0x1a, 0x72, 0xf3, 0xac, 0x52,
+	0xb0, 0x3c, 0x75, 0x1f, 0x9c, 0x2b, 0x98, 0x4c, 0x70, 0xc6, 0x53, 0x0a, 0x66, 0x21, 0x19, 0x05,
+	0x96, 0x51, 0x86, 0xd2, 0x6a, 0x60, 0xd6, 0x78, 0xa4, 0x51, 0x0d, 0x4e, 0x62, 0x6f, 0x47, 0xda,
+	0xa5, 0x68, 0xb3, 0xac, 0xbb, 0xef, 0xee, 0xbd, 0x53, 0x57, 0xc0, 0x32, 0x6e, 0xe9, 0x2b, 0xea,
+	0x19, 0xe8, 0x54, 0xc8, 0x11, 0xe5, 0x12, 0x92, 0x09, 0x4f, 0xbd, 0x7f, 0x0d, 0x12, 0x1c, 0xc7,
+	0x17, 0x5b, 0xf0, 0xf8, 0xd3, 0x7b, 0xdb, 0xec, 0x06, 0xce, 0x79, 0x0e, 0xf3, 0xfd, 0x43, 0x3a,
+	0x46, 0x65, 0xbc, 0x6a, 0x83, 0x04, 0xa7, 0xf1, 0x59, 0x0e, 0xf3, 0x9d, 0xef, 0xa3, 0x32, 0xee,
+	0x9d, 0x53, 0x87, 0xa9, 0x45, 0xaa, 0xf9, 0x48, 0x18, 0xcb, 0x35, 0x15, 0x09, 0xa3, 0x39, 0xe8,
+	0x8c, 0x6b, 0xe3, 0xfd, 0xdf, 0xfc, 0x52, 0x2b, 0x41, 0xbc, 0xeb, 0x83, 0x84, 0x3d, 0x6d, 0x6b,
+	0x27, 0x7b, 0x5f, 0xf9, 0x64, 0xb9, 0xf2, 0xc9, 0xe7, 0xca, 0x27, 0x6f, 0x6b, 0xbf, 0xb2, 0x5c,
+	0xfb, 0x95, 0x8f, 0xb5, 0x5f, 0x71, 0xea, 0x02, 0xc3, 0xbf, 0x67, 0x36, 0x24, 0x2f, 0xb7, 0x23,
+	0x61, 0xc7, 0xd3, 0x24, 0x64, 0x98, 0x47, 0x07, 0x74, 0x2d, 0xf0, 0xd7, 0x29, 0x9a, 0x1f, 0x96,
+	0x61, 0x17, 0x8a, 0x9b, 0xe4, 0x68, 0xb3, 0x8a, 0x9b, 0xef, 0x01, 0x00, 0x03, 0xe0, 0x04, 0xe3,
+	0xb0, 0x01, 0x00, 0x00,
 }
 
 func (m *EventIBCHooksParamsUpdated) Marshal() (dAtA []byte, err error) {
@@ -113,6 +144,31 @@ func (m *EventIBCHooksParamsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if m.AutoRegisterIbcMarkers {
+		i--
+		if m.AutoRegisterIbcMarkers {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxForwardHops != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.MaxForwardHops))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PacketForwardingEnabled {
+		i--
+		if m.PacketForwardingEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.AllowedAsyncAckContracts) > 0 {
 		for iNdEx := len(m.AllowedAsyncAckContracts) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowedAsyncAckContracts[iNdEx])
@@ -148,6 +204,15 @@ func (m *EventIBCHooksParamsUpdated) Size() (n int) {
 			n += 1 + l + sovEvent(uint64(l))
 		}
 	}
+	if m.PacketForwardingEnabled {
+		n += 2
+	}
+	if m.MaxForwardHops != 0 {
+		n += 1 + sovEvent(uint64(m.MaxForwardHops))
+	}
+	if m.AutoRegisterIbcMarkers {
+		n += 2
+	}
 	return n
 }
 
@@ -218,6 +283,65 @@ func (m *EventIBCHooksParamsUpdated) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowedAsyncAckContracts = append(m.AllowedAsyncAckContracts, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketForwardingEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PacketForwardingEnabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxForwardHops", wireType)
+			}
+			m.MaxForwardHops = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxForwardHops |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRegisterIbcMarkers", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRegisterIbcMarkers = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
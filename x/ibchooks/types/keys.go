@@ -6,6 +6,7 @@ const (
 
 	IBCCallbackKey = "ibc_callback"
 	IBCAsyncAckKey = "ibc_async_ack"
+	SrcCallbackKey = "src_callback"
 
 	MsgEmitAckKey           = "emit_ack"
 	AttributeSender         = "sender"
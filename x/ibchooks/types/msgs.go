@@ -18,11 +18,9 @@ func (m MsgEmitIBCAck) ValidateBasic() error {
 }
 
 // NewMsgUpdateParamsRequest creates a new MsgUpdateParamsRequest instance
-func NewMsgUpdateParamsRequest(allowedAsyncAckContracts []string, authority string) *MsgUpdateParamsRequest {
+func NewMsgUpdateParamsRequest(allowedAsyncAckContracts []string, packetForwardingEnabled bool, maxForwardHops uint32, autoRegisterIBCMarkers bool, authority string) *MsgUpdateParamsRequest {
 	return &MsgUpdateParamsRequest{
-		Params: Params{
-			AllowedAsyncAckContracts: allowedAsyncAckContracts,
-		},
+		Params:    NewParams(allowedAsyncAckContracts, packetForwardingEnabled, maxForwardHops, autoRegisterIBCMarkers),
 		Authority: authority,
 	}
 }
@@ -52,7 +52,7 @@ func TestNewMsgUpdateParamsRequest(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			msg := types.NewMsgUpdateParamsRequest(tc.contracts, tc.authority)
+			msg := types.NewMsgUpdateParamsRequest(tc.contracts, true, 2, true, tc.authority)
 			err := msg.ValidateBasic()
 			if tc.expErr != "" {
 				require.EqualError(t, err, tc.expErr, "MsgUpdateParamsRequest.ValidateBasic expected error message: %s, but got: %s", tc.expErr, err)
@@ -4,9 +4,21 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-func NewParams(allowedAsyncAckContracts []string) Params {
+const (
+	// DefaultPacketForwardingEnabled is the default value for the packet_forwarding_enabled param.
+	DefaultPacketForwardingEnabled = true
+	// DefaultMaxForwardHops is the default value for the max_forward_hops param.
+	DefaultMaxForwardHops = uint32(4)
+	// DefaultAutoRegisterIBCMarkers is the default value for the auto_register_ibc_markers param.
+	DefaultAutoRegisterIBCMarkers = true
+)
+
+func NewParams(allowedAsyncAckContracts []string, packetForwardingEnabled bool, maxForwardHops uint32, autoRegisterIBCMarkers bool) Params {
 	return Params{
 		AllowedAsyncAckContracts: allowedAsyncAckContracts,
+		PacketForwardingEnabled:  packetForwardingEnabled,
+		MaxForwardHops:           maxForwardHops,
+		AutoRegisterIbcMarkers:   autoRegisterIBCMarkers,
 	}
 }
 
@@ -14,6 +26,9 @@ func NewParams(allowedAsyncAckContracts []string) Params {
 func DefaultParams() Params {
 	return Params{
 		AllowedAsyncAckContracts: []string{},
+		PacketForwardingEnabled:  DefaultPacketForwardingEnabled,
+		MaxForwardHops:           DefaultMaxForwardHops,
+		AutoRegisterIbcMarkers:   DefaultAutoRegisterIBCMarkers,
 	}
 }
 
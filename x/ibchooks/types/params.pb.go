@@ -22,9 +22,19 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// Params defines the allowed async ack contracts
+// Params defines the allowed async ack contracts, the packet forwarding settings and the
+// ibc marker auto-registration setting.
 type Params struct {
 	AllowedAsyncAckContracts []string `protobuf:"bytes,1,rep,name=allowed_async_ack_contracts,json=allowedAsyncAckContracts,proto3" json:"allowed_async_ack_contracts,omitempty"`
+	// packet_forwarding_enabled indicates whether incoming ICS-20 packets may be forwarded
+	// to another chain using a "forward" memo (packet-forward-middleware).
+	PacketForwardingEnabled bool `protobuf:"varint,2,opt,name=packet_forwarding_enabled,json=packetForwardingEnabled,proto3" json:"packet_forwarding_enabled,omitempty"`
+	// max_forward_hops is the maximum number of hops a "forward" memo may define (including
+	// the hop out of this chain). Zero means there is no limit.
+	MaxForwardHops uint32 `protobuf:"varint,3,opt,name=max_forward_hops,json=maxForwardHops,proto3" json:"max_forward_hops,omitempty"`
+	// auto_register_ibc_markers indicates whether the first receipt of an unknown ibc/ denom
+	// creates a marker (and denom metadata) for it. Existing ibc markers are always updated.
+	AutoRegisterIbcMarkers bool `protobuf:"varint,4,opt,name=auto_register_ibc_markers,json=autoRegisterIbcMarkers,proto3" json:"auto_register_ibc_markers,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -67,6 +77,27 @@ func (m *Params) GetAllowedAsyncAckContracts() []string {
 	return nil
 }
 
+func (m *Params) GetPacketForwardingEnabled() bool {
+	if m != nil {
+		return m.PacketForwardingEnabled
+	}
+	return false
+}
+
+func (m *Params) GetMaxForwardHops() uint32 {
+	if m != nil {
+		return m.MaxForwardHops
+	}
+	return 0
+}
+
+func (m *Params) GetAutoRegisterIbcMarkers() bool {
+	if m != nil {
+		return m.AutoRegisterIbcMarkers
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "provenance.ibchooks.v1.Params")
 }
@@ -76,20 +107,27 @@ func init() {
 }
 
 var fileDescriptor_61d9bd623dd1e2fd = []byte{
-	// 199 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2e, 0x28, 0xca, 0x2f,
-	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0xcf, 0x4c, 0x4a, 0xce, 0xc8, 0xcf, 0xcf, 0x2e, 0xd6,
-	0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x12, 0x43, 0x28, 0xd2, 0x83, 0x29, 0xd2, 0x2b, 0x33, 0x54, 0x72, 0xe7, 0x62, 0x0b, 0x00, 0xab,
-	0x13, 0xb2, 0xe5, 0x92, 0x4e, 0xcc, 0xc9, 0xc9, 0x2f, 0x4f, 0x4d, 0x89, 0x4f, 0x2c, 0xae, 0xcc,
-	0x4b, 0x8e, 0x4f, 0x4c, 0xce, 0x8e, 0x4f, 0xce, 0xcf, 0x2b, 0x29, 0x4a, 0x4c, 0x2e, 0x29, 0x96,
-	0x60, 0x54, 0x60, 0xd6, 0xe0, 0x0c, 0x92, 0x80, 0x2a, 0x71, 0x04, 0xa9, 0x70, 0x4c, 0xce, 0x76,
-	0x86, 0xc9, 0x3b, 0x65, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72,
-	0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x03, 0x97, 0x64,
-	0x66, 0xbe, 0x1e, 0x76, 0xdb, 0x03, 0x18, 0xa3, 0x4c, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4,
-	0x92, 0xf3, 0x73, 0xf5, 0x11, 0x8a, 0x74, 0x33, 0xf3, 0x91, 0x78, 0xfa, 0x15, 0x08, 0x7f, 0x95,
-	0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x3d, 0x65, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x2b,
-	0x3f, 0xf1, 0x7a, 0xfb, 0x00, 0x00, 0x00,
+	// 306 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0xd0, 0x3f, 0x4b, 0x3b, 0x31,
+	0x1c, 0xc7, 0xf1, 0xe6, 0xd7, 0x1f, 0x45, 0x03, 0x8a, 0xdc, 0x50, 0xaf, 0x08, 0x47, 0xd1, 0xe5,
+	0x16, 0xef, 0x28, 0xba, 0x28, 0x38, 0x54, 0x51, 0x74, 0x10, 0xca, 0x8d, 0x2e, 0xe1, 0x9b, 0x34,
+	0xb6, 0x21, 0xbd, 0x7c, 0x43, 0x92, 0xfe, 0x7b, 0x16, 0x3e, 0x2c, 0xc7, 0x8e, 0x8e, 0xd2, 0x2e,
+	0x3e, 0x0c, 0xe9, 0x3f, 0xeb, 0xe0, 0x18, 0xde, 0xaf, 0xf0, 0x85, 0x0f, 0x3d, 0xb3, 0x0e, 0x47,
+	0xd2, 0x80, 0x11, 0x32, 0x57, 0x5c, 0xf4, 0x11, 0xb5, 0xcf, 0x47, 0xad, 0xdc, 0x82, 0x83, 0xd2,
+	0x67, 0xd6, 0x61, 0xc0, 0xa8, 0xbe, 0x43, 0xd9, 0x16, 0x65, 0xa3, 0xd6, 0xe9, 0x17, 0xa1, 0xb5,
+	0xce, 0x0a, 0x46, 0x37, 0xf4, 0x04, 0x06, 0x03, 0x1c, 0xcb, 0x2e, 0x03, 0x3f, 0x35, 0x82, 0x81,
+	0xd0, 0x4c, 0xa0, 0x09, 0x0e, 0x44, 0xf0, 0x31, 0x69, 0x56, 0xd3, 0xfd, 0x22, 0xde, 0x90, 0xf6,
+	0x52, 0xb4, 0x85, 0xbe, 0xdb, 0xf6, 0xe8, 0x9a, 0x36, 0x2c, 0x08, 0x2d, 0x03, 0x7b, 0x45, 0x37,
+	0x06, 0xd7, 0x55, 0xa6, 0xc7, 0xa4, 0x01, 0x3e, 0x90, 0xdd, 0xf8, 0x5f, 0x93, 0xa4, 0x7b, 0xc5,
+	0xf1, 0x1a, 0x3c, 0xfc, 0xf4, 0xfb, 0x75, 0x8e, 0x52, 0x7a, 0x54, 0xc2, 0x64, 0xfb, 0x91, 0xf5,
+	0xd1, 0xfa, 0xb8, 0xda, 0x24, 0xe9, 0x41, 0x71, 0x58, 0xc2, 0x64, 0xe3, 0x1f, 0xd1, 0xfa, 0xe8,
+	0x8a, 0x36, 0x60, 0x18, 0x90, 0x39, 0xd9, 0x53, 0x3e, 0x48, 0xc7, 0x14, 0x17, 0xac, 0x04, 0xa7,
+	0xa5, 0xf3, 0xf1, 0xff, 0xd5, 0x95, 0xfa, 0x12, 0x14, 0x9b, 0xfe, 0xc4, 0xc5, 0xf3, 0xba, 0xde,
+	0xea, 0xf7, 0x79, 0x42, 0x66, 0xf3, 0x84, 0x7c, 0xce, 0x13, 0xf2, 0xb6, 0x48, 0x2a, 0xb3, 0x45,
+	0x52, 0xf9, 0x58, 0x24, 0x15, 0xda, 0x50, 0x98, 0xfd, 0xbd, 0x4f, 0x87, 0xbc, 0x5c, 0xf6, 0x54,
+	0xe8, 0x0f, 0x79, 0x26, 0xb0, 0xcc, 0x77, 0xe8, 0x5c, 0xe1, 0xaf, 0x57, 0x3e, 0xd9, 0x2d, 0x1f,
+	0xa6, 0x56, 0x7a, 0x5e, 0x5b, 0xcd, 0x7e, 0xf1, 0x3d, 0x00, 0x9f, 0x1a, 0x07, 0xad, 0x9d, 0x01,
+	0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -112,6 +150,31 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoRegisterIbcMarkers {
+		i--
+		if m.AutoRegisterIbcMarkers {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxForwardHops != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxForwardHops))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PacketForwardingEnabled {
+		i--
+		if m.PacketForwardingEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.AllowedAsyncAckContracts) > 0 {
 		for iNdEx := len(m.AllowedAsyncAckContracts) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowedAsyncAckContracts[iNdEx])
@@ -147,6 +210,15 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if m.PacketForwardingEnabled {
+		n += 2
+	}
+	if m.MaxForwardHops != 0 {
+		n += 1 + sovParams(uint64(m.MaxForwardHops))
+	}
+	if m.AutoRegisterIbcMarkers {
+		n += 2
+	}
 	return n
 }
 
@@ -217,6 +289,65 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowedAsyncAckContracts = append(m.AllowedAsyncAckContracts, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketForwardingEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PacketForwardingEnabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxForwardHops", wireType)
+			}
+			m.MaxForwardHops = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxForwardHops |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRegisterIbcMarkers", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRegisterIbcMarkers = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
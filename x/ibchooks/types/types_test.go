@@ -73,3 +73,54 @@ func (s *IbcHooksTypesTestSuite) TestNewMarkerPayloadSerialization() {
 		})
 	}
 }
+
+func (s *IbcHooksTypesTestSuite) TestParseSrcCallback() {
+	tests := []struct {
+		name     string
+		memo     string
+		expAddr  string
+		expFound bool
+		expErr   string
+	}{
+		{name: "empty memo"},
+		{name: "not json", memo: "just some text"},
+		{name: "no src_callback", memo: `{"ibc_callback":"addr"}`},
+		{
+			name:     "src_callback not an object",
+			memo:     `{"src_callback":"addr"}`,
+			expFound: true,
+			expErr:   "invalid src_callback: json: cannot unmarshal string into Go struct field SrcCallbackMemo.src_callback of type types.SrcCallbackData",
+		},
+		{
+			name:     "src_callback without address",
+			memo:     `{"src_callback":{"gas_limit":"100"}}`,
+			expFound: true,
+			expErr:   "invalid src_callback: address cannot be empty",
+		},
+		{
+			name:     "src_callback with address",
+			memo:     `{"src_callback":{"address":"addr"}}`,
+			expAddr:  "addr",
+			expFound: true,
+		},
+		{
+			name:     "src_callback with other entries",
+			memo:     `{"wasm":{"contract":"other","msg":{}},"src_callback":{"address":"addr","gas_limit":"100"}}`,
+			expAddr:  "addr",
+			expFound: true,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			addr, found, err := ParseSrcCallback(tc.memo)
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "ParseSrcCallback error")
+			} else {
+				s.Assert().NoError(err, "ParseSrcCallback error")
+			}
+			s.Assert().Equal(tc.expAddr, addr, "ParseSrcCallback address")
+			s.Assert().Equal(tc.expFound, found, "ParseSrcCallback found")
+		})
+	}
+}
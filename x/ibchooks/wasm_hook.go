@@ -299,8 +299,12 @@ func (h WasmHooks) SendPacketOverride(
 	return seq, nil
 }
 
+// GetWasmSendPacketPreProcessor checks an outbound ICS-20 packet's memo for a callback request.
+// An "ibc_callback" entry is removed from the memo before the packet is sent. An ADR-8
+// "src_callback" entry is left in the memo, but its address must be the packet's sender.
+// The callback address is put in processData so that SendPacketAfterHook can store it.
 func (h WasmHooks) GetWasmSendPacketPreProcessor(
-	_ sdktypes.Context,
+	ctx sdktypes.Context,
 	data []byte,
 	processData map[string]interface{},
 ) ([]byte, error) {
@@ -310,6 +314,22 @@ func (h WasmHooks) GetWasmSendPacketPreProcessor(
 	}
 
 	isCallbackRouted, metadata := jsonStringHasKey(ics20Packet.GetMemo(), types.IBCCallbackKey)
+
+	srcCallback, hasSrcCallback, err := types.ParseSrcCallback(ics20Packet.GetMemo())
+	if err != nil {
+		return nil, types.ErrInvalidCallback.Wrap(err.Error())
+	}
+	if hasSrcCallback {
+		if isCallbackRouted {
+			return nil, types.ErrInvalidCallback.Wrapf("memo cannot have both %s and %s", types.IBCCallbackKey, types.SrcCallbackKey)
+		}
+		if err = h.ibcHooksKeeper.ValidateSrcCallback(ctx, ics20Packet.Sender, srcCallback); err != nil {
+			return nil, err
+		}
+		processData[types.IBCCallbackKey] = srcCallback
+		return data, nil
+	}
+
 	if !isCallbackRouted {
 		return data, nil
 	}
@@ -401,13 +421,21 @@ func (h WasmHooks) OnAcknowledgementPacketOverride(im IBCMiddleware, ctx sdktype
 		return nil
 	}
 
+	success := !IsJSONAckError(acknowledgement)
+
+	if handler, isModule := h.ibcHooksKeeper.GetModuleCallback(contract); isModule {
+		if err = handler.OnPacketAcknowledged(ctx, packet, acknowledgement, success); err != nil {
+			return sdkerrors.Wrap(err, "Ack callback error")
+		}
+		h.ibcHooksKeeper.DeletePacketCallback(ctx, packet.GetSourceChannel(), packet.GetSequence())
+		return nil
+	}
+
 	contractAddr, err := sdktypes.AccAddressFromBech32(contract)
 	if err != nil {
 		return sdkerrors.Wrap(err, "Ack callback error")
 	}
 
-	success := !IsJSONAckError(acknowledgement)
-
 	// Notify the sender that the ack has been received
 	ackAsJSON, err := json.Marshal(acknowledgement)
 	if err != nil {
@@ -444,6 +472,24 @@ func (h WasmHooks) OnTimeoutPacketOverride(im IBCMiddleware, ctx sdktypes.Contex
 		return nil
 	}
 
+	if handler, isModule := h.ibcHooksKeeper.GetModuleCallback(contract); isModule {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err = handler.OnPacketTimeout(cacheCtx, packet); err == nil {
+			writeCache()
+		} else {
+			// Same as with contracts: retrying won't help, so emit the error and delete the callback.
+			ctx.EventManager().EmitEvents(sdktypes.Events{
+				sdktypes.NewEvent(
+					"ibc-timeout-callback-error",
+					sdktypes.NewAttribute("module", contract),
+					sdktypes.NewAttribute("error", err.Error()),
+				),
+			})
+		}
+		h.ibcHooksKeeper.DeletePacketCallback(ctx, packet.GetSourceChannel(), packet.GetSequence())
+		return nil
+	}
+
 	contractAddr, err := sdktypes.AccAddressFromBech32(contract)
 	if err != nil {
 		return sdkerrors.Wrap(err, "Timeout callback error")
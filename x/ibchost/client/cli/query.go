@@ -0,0 +1,90 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/provenance-io/provenance/x/ibchost"
+)
+
+// GetQueryCmd returns the cli query commands for this module.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        ibchost.ModuleName,
+		Short:                      "Querying commands for the ibchost module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		GetICAHostAllowlistCmd(),
+		GetICQHostAllowlistCmd(),
+	)
+
+	return queryCmd
+}
+
+// GetICAHostAllowlistCmd returns the command handler for querying the interchain accounts host allowlist.
+func GetICAHostAllowlistCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "ica-allowlist",
+		Aliases: []string{"ica"},
+		Short:   "Query the msg type urls that interchain accounts are allowed to execute",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf(`$ %s query ibchost ica-allowlist`, version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := ibchost.NewQueryClient(clientCtx)
+			res, err := queryClient.ICAHostAllowlist(context.Background(), &ibchost.ICAHostAllowlistRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetICQHostAllowlistCmd returns the command handler for querying the interchain queries host allowlist.
+func GetICQHostAllowlistCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "icq-allowlist",
+		Aliases: []string{"icq"},
+		Short:   "Query the query paths that counterparty chains are allowed to query using interchain queries",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf(`$ %s query ibchost icq-allowlist`, version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := ibchost.NewQueryClient(clientCtx)
+			res, err := queryClient.ICQHostAllowlist(context.Background(), &ibchost.ICQHostAllowlistRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,127 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+
+	"github.com/provenance-io/provenance/internal/provcli"
+	"github.com/provenance-io/provenance/x/ibchost"
+)
+
+const (
+	// FlagAdd is the flag for entries to add to an allowlist.
+	FlagAdd = "add"
+	// FlagRemove is the flag for entries to remove from an allowlist.
+	FlagRemove = "remove"
+)
+
+// NewTxCmd is the top-level command for ibchost CLI transactions.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        ibchost.ModuleName,
+		Short:                      "Transaction commands for the ibchost module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		GetCmdUpdateICAHostAllowlist(),
+		GetCmdUpdateICQHostAllowlist(),
+	)
+
+	return txCmd
+}
+
+// GetCmdUpdateICAHostAllowlist is a command to add and remove entries in the interchain accounts host allowlist.
+func GetCmdUpdateICAHostAllowlist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-ica-allowlist {--add <msg type urls>|--remove <msg type urls>}",
+		Aliases: []string{"ica"},
+		Short:   "Add and remove msg type urls in the interchain accounts host allowlist",
+		Long:    "Submit an update to the interchain accounts host allowlist via governance proposal along with an initial deposit.",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf(`%[1]s tx ibchost update-ica-allowlist --add /cosmos.bank.v1beta1.MsgSend --deposit 50000nhash
+%[1]s tx ibchost update-ica-allowlist --remove /cosmos.staking.v1beta1.MsgDelegate,/cosmos.staking.v1beta1.MsgUndelegate --deposit 50000nhash`,
+			version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			flagSet := cmd.Flags()
+			toAdd, errAdd := flagSet.GetStringSlice(FlagAdd)
+			toRemove, errRemove := flagSet.GetStringSlice(FlagRemove)
+			if err = errors.Join(errAdd, errRemove); err != nil {
+				return err
+			}
+
+			authority := provcli.GetAuthority(flagSet)
+			msg := ibchost.NewMsgUpdateICAHostAllowlistRequest(authority, toAdd, toRemove)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagAdd, nil, "The msg type urls to add to the allowlist")
+	cmd.Flags().StringSlice(FlagRemove, nil, "The msg type urls to remove from the allowlist")
+	cmd.MarkFlagsOneRequired(FlagAdd, FlagRemove)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdUpdateICQHostAllowlist is a command to add and remove entries in the interchain queries host allowlist.
+func GetCmdUpdateICQHostAllowlist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-icq-allowlist {--add <query paths>|--remove <query paths>}",
+		Aliases: []string{"icq"},
+		Short:   "Add and remove query paths in the interchain queries host allowlist",
+		Long:    "Submit an update to the interchain queries host allowlist via governance proposal along with an initial deposit.",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf(`%[1]s tx ibchost update-icq-allowlist --add /provenance.marker.v1.Query/NetAssetValues --deposit 50000nhash
+%[1]s tx ibchost update-icq-allowlist --remove /provenance.metadata.v1.Query/Ownership,/provenance.metadata.v1.Query/ValueOwnership --deposit 50000nhash`,
+			version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			flagSet := cmd.Flags()
+			toAdd, errAdd := flagSet.GetStringSlice(FlagAdd)
+			toRemove, errRemove := flagSet.GetStringSlice(FlagRemove)
+			if err = errors.Join(errAdd, errRemove); err != nil {
+				return err
+			}
+
+			authority := provcli.GetAuthority(flagSet)
+			msg := ibchost.NewMsgUpdateICQHostAllowlistRequest(authority, toAdd, toRemove)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagAdd, nil, "The query paths to add to the allowlist")
+	cmd.Flags().StringSlice(FlagRemove, nil, "The query paths to remove from the allowlist")
+	cmd.MarkFlagsOneRequired(FlagAdd, FlagRemove)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
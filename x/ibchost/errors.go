@@ -0,0 +1,15 @@
+package ibchost
+
+import (
+	cerrs "cosmossdk.io/errors"
+)
+
+var (
+	ErrAlreadyAllowed = cerrs.Register(ModuleName, 2, "msg type url already allowed")
+	ErrNotAllowed     = cerrs.Register(ModuleName, 3, "msg type url not allowed")
+	ErrUnknownMsgType = cerrs.Register(ModuleName, 4, "unknown msg type url")
+
+	ErrQueryAlreadyAllowed = cerrs.Register(ModuleName, 5, "query path already allowed")
+	ErrQueryNotAllowed     = cerrs.Register(ModuleName, 6, "query path not allowed")
+	ErrUnknownQueryPath    = cerrs.Register(ModuleName, 7, "unknown query path")
+)
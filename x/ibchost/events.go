@@ -0,0 +1,17 @@
+package ibchost
+
+// NewEventICAHostAllowlistUpdated returns a new EventICAHostAllowlistUpdated.
+func NewEventICAHostAllowlistUpdated(added, removed []string) *EventICAHostAllowlistUpdated {
+	return &EventICAHostAllowlistUpdated{
+		Added:   added,
+		Removed: removed,
+	}
+}
+
+// NewEventICQHostAllowlistUpdated returns a new EventICQHostAllowlistUpdated.
+func NewEventICQHostAllowlistUpdated(added, removed []string) *EventICQHostAllowlistUpdated {
+	return &EventICQHostAllowlistUpdated{
+		Added:   added,
+		Removed: removed,
+	}
+}
@@ -0,0 +1,31 @@
+package ibchost
+
+import (
+	icqtypes "github.com/cosmos/ibc-apps/modules/async-icq/v8/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icahosttypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
+)
+
+// ICAHostKeeper defines the functionality needed from the interchain accounts host keeper.
+type ICAHostKeeper interface {
+	GetParams(ctx sdk.Context) icahosttypes.Params
+	SetParams(ctx sdk.Context, params icahosttypes.Params)
+}
+
+// ICQHostKeeper defines the functionality needed from the interchain queries host keeper.
+type ICQHostKeeper interface {
+	GetParams(ctx sdk.Context) icqtypes.Params
+	SetParams(ctx sdk.Context, params icqtypes.Params) error
+}
+
+// MsgRouter defines the functionality needed from the msg service router.
+type MsgRouter interface {
+	HandlerByTypeURL(typeURL string) baseapp.MsgServiceHandler
+}
+
+// QueryRouter defines the functionality needed from the grpc query router.
+type QueryRouter interface {
+	Route(path string) baseapp.GRPCQueryHandler
+}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/ibchost"
+)
+
+var _ ibchost.QueryServer = Keeper{}
+
+// ICAHostAllowlist returns the msg type urls that interchain accounts are allowed to execute.
+func (k Keeper) ICAHostAllowlist(ctx context.Context, _ *ibchost.ICAHostAllowlistRequest) (*ibchost.ICAHostAllowlistResponse, error) {
+	params := k.GetICAHostParams(sdk.UnwrapSDKContext(ctx))
+	return &ibchost.ICAHostAllowlistResponse{
+		HostEnabled:   params.HostEnabled,
+		AllowMessages: params.AllowMessages,
+	}, nil
+}
+
+// ICQHostAllowlist returns the query paths that counterparty chains are allowed to query using interchain queries.
+func (k Keeper) ICQHostAllowlist(ctx context.Context, _ *ibchost.ICQHostAllowlistRequest) (*ibchost.ICQHostAllowlistResponse, error) {
+	params := k.GetICQHostParams(sdk.UnwrapSDKContext(ctx))
+	return &ibchost.ICQHostAllowlistResponse{
+		HostEnabled:  params.HostEnabled,
+		AllowQueries: params.AllowQueries,
+	}, nil
+}
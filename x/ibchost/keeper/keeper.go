@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"slices"
+
+	"cosmossdk.io/log"
+
+	icqtypes "github.com/cosmos/ibc-apps/modules/async-icq/v8/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/gogoproto/proto"
+	icahosttypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
+
+	"github.com/provenance-io/provenance/x/ibchost"
+)
+
+// Keeper for the ibchost module. It doesn't have a store of its own, it just
+// manages the governance-controlled parts of the IBC host modules.
+type Keeper struct {
+	icaHostKeeper ibchost.ICAHostKeeper
+	icqHostKeeper ibchost.ICQHostKeeper
+	router        ibchost.MsgRouter
+	queryRouter   ibchost.QueryRouter
+	authority     string
+}
+
+// NewKeeper Creates a new Keeper for the module.
+func NewKeeper(
+	icaHostKeeper ibchost.ICAHostKeeper,
+	icqHostKeeper ibchost.ICQHostKeeper,
+	router ibchost.MsgRouter,
+	queryRouter ibchost.QueryRouter,
+) Keeper {
+	return Keeper{
+		icaHostKeeper: icaHostKeeper,
+		icqHostKeeper: icqHostKeeper,
+		router:        router,
+		queryRouter:   queryRouter,
+		authority:     authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	}
+}
+
+// Logger Creates a new logger for the module.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+ibchost.ModuleName)
+}
+
+// GetAuthority gets the authority account address.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// ValidateAuthority returns an error if the provided address is not the authority.
+func (k Keeper) ValidateAuthority(addr string) error {
+	if k.authority != addr {
+		return govtypes.ErrInvalidSigner.Wrapf("expected %q got %q", k.authority, addr)
+	}
+	return nil
+}
+
+// GetICAHostParams returns the interchain accounts host params.
+func (k Keeper) GetICAHostParams(ctx sdk.Context) icahosttypes.Params {
+	return k.icaHostKeeper.GetParams(ctx)
+}
+
+// UpdateICAHostAllowlist adds and removes entries in the interchain accounts host allowlist.
+// An error is returned if an entry to add is already allowed, an entry to remove isn't allowed,
+// or an entry to add isn't a msg type that this chain can handle.
+func (k Keeper) UpdateICAHostAllowlist(ctx sdk.Context, toAdd, toRemove []string) error {
+	params := k.icaHostKeeper.GetParams(ctx)
+
+	for _, typeURL := range toRemove {
+		i := slices.Index(params.AllowMessages, typeURL)
+		if i < 0 {
+			return ibchost.ErrNotAllowed.Wrapf("cannot remove %q", typeURL)
+		}
+		params.AllowMessages = slices.Delete(params.AllowMessages, i, i+1)
+	}
+
+	for _, typeURL := range toAdd {
+		if slices.Contains(params.AllowMessages, typeURL) {
+			return ibchost.ErrAlreadyAllowed.Wrapf("cannot add %q", typeURL)
+		}
+		if typeURL != icahosttypes.AllowAllHostMsgs && k.router.HandlerByTypeURL(typeURL) == nil {
+			return ibchost.ErrUnknownMsgType.Wrapf("cannot add %q", typeURL)
+		}
+		params.AllowMessages = append(params.AllowMessages, typeURL)
+	}
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	k.icaHostKeeper.SetParams(ctx, params)
+	k.emitEvent(ctx, ibchost.NewEventICAHostAllowlistUpdated(toAdd, toRemove))
+	return nil
+}
+
+// GetICQHostParams returns the interchain queries host params.
+func (k Keeper) GetICQHostParams(ctx sdk.Context) icqtypes.Params {
+	return k.icqHostKeeper.GetParams(ctx)
+}
+
+// UpdateICQHostAllowlist adds and removes entries in the interchain queries host allowlist.
+// An error is returned if an entry to add is already allowed, an entry to remove isn't allowed,
+// or an entry to add isn't a query path that this chain can handle.
+func (k Keeper) UpdateICQHostAllowlist(ctx sdk.Context, toAdd, toRemove []string) error {
+	params := k.icqHostKeeper.GetParams(ctx)
+
+	for _, path := range toRemove {
+		i := slices.Index(params.AllowQueries, path)
+		if i < 0 {
+			return ibchost.ErrQueryNotAllowed.Wrapf("cannot remove %q", path)
+		}
+		params.AllowQueries = slices.Delete(params.AllowQueries, i, i+1)
+	}
+
+	for _, path := range toAdd {
+		if slices.Contains(params.AllowQueries, path) {
+			return ibchost.ErrQueryAlreadyAllowed.Wrapf("cannot add %q", path)
+		}
+		if k.queryRouter.Route(path) == nil {
+			return ibchost.ErrUnknownQueryPath.Wrapf("cannot add %q", path)
+		}
+		params.AllowQueries = append(params.AllowQueries, path)
+	}
+
+	if err := k.icqHostKeeper.SetParams(ctx, params); err != nil {
+		return err
+	}
+	k.emitEvent(ctx, ibchost.NewEventICQHostAllowlistUpdated(toAdd, toRemove))
+	return nil
+}
+
+// emitEvent emits the provided event and writes any error to the error log.
+func (k Keeper) emitEvent(ctx sdk.Context, event proto.Message) {
+	err := ctx.EventManager().EmitTypedEvent(event)
+	if err != nil {
+		k.Logger(ctx).Error("error emitting event", "event", event, "error", err)
+	}
+}
@@ -0,0 +1,332 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	icqtypes "github.com/cosmos/ibc-apps/modules/async-icq/v8/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icahosttypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/testutil/assertions"
+	"github.com/provenance-io/provenance/x/ibchost"
+	"github.com/provenance-io/provenance/x/ibchost/keeper"
+)
+
+const (
+	msgSend     = "/cosmos.bank.v1beta1.MsgSend"
+	msgDelegate = "/cosmos.staking.v1beta1.MsgDelegate"
+	msgVote     = "/cosmos.gov.v1.MsgVote"
+
+	queryNAVs      = "/provenance.marker.v1.Query/NetAssetValues"
+	queryAttribute = "/provenance.attribute.v1.Query/Attribute"
+	queryScope     = "/provenance.metadata.v1.Query/Scope"
+)
+
+type TestSuite struct {
+	suite.Suite
+
+	app *app.App
+	ctx sdk.Context
+
+	queryClient ibchost.QueryClient
+	msgServer   ibchost.MsgServer
+}
+
+func (s *TestSuite) SetupTest() {
+	s.app = app.Setup(s.T())
+	s.ctx = s.app.BaseApp.NewContext(false)
+
+	s.msgServer = keeper.NewMsgServer(s.app.IBCHostKeeper)
+	queryHelper := baseapp.NewQueryServerTestHelper(s.ctx, s.app.InterfaceRegistry())
+	ibchost.RegisterQueryServer(queryHelper, s.app.IBCHostKeeper)
+	s.queryClient = ibchost.NewQueryClient(queryHelper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+func (s *TestSuite) setAllowlist(allow ...string) {
+	s.app.ICAHostKeeper.SetParams(s.ctx, icahosttypes.NewParams(true, allow))
+}
+
+func (s *TestSuite) setQueryAllowlist(allow ...string) {
+	s.Require().NoError(s.app.ICQKeeper.SetParams(s.ctx, icqtypes.NewParams(true, allow)), "ICQKeeper.SetParams")
+}
+
+func (s *TestSuite) TestUpdateICAHostAllowlist() {
+	tests := []struct {
+		name     string
+		starting []string
+		toAdd    []string
+		toRemove []string
+		expErr   string
+		expAllow []string
+	}{
+		{
+			name:     "add one to empty",
+			toAdd:    []string{msgSend},
+			expAllow: []string{msgSend},
+		},
+		{
+			name:     "add two to existing",
+			starting: []string{msgSend},
+			toAdd:    []string{msgDelegate, msgVote},
+			expAllow: []string{msgSend, msgDelegate, msgVote},
+		},
+		{
+			name:     "remove the middle one",
+			starting: []string{msgSend, msgDelegate, msgVote},
+			toRemove: []string{msgDelegate},
+			expAllow: []string{msgSend, msgVote},
+		},
+		{
+			name:     "replace wildcard with specific entries",
+			starting: []string{icahosttypes.AllowAllHostMsgs},
+			toAdd:    []string{msgSend},
+			toRemove: []string{icahosttypes.AllowAllHostMsgs},
+			expAllow: []string{msgSend},
+		},
+		{
+			name:     "add wildcard",
+			starting: []string{msgSend},
+			toAdd:    []string{icahosttypes.AllowAllHostMsgs},
+			expAllow: []string{msgSend, icahosttypes.AllowAllHostMsgs},
+		},
+		{
+			name:     "add already allowed",
+			starting: []string{msgSend},
+			toAdd:    []string{msgSend},
+			expErr:   `cannot add "` + msgSend + `": msg type url already allowed`,
+			expAllow: []string{msgSend},
+		},
+		{
+			name:     "remove not allowed",
+			starting: []string{msgSend},
+			toRemove: []string{msgDelegate},
+			expErr:   `cannot remove "` + msgDelegate + `": msg type url not allowed`,
+			expAllow: []string{msgSend},
+		},
+		{
+			name:     "add unknown msg type",
+			starting: []string{msgSend},
+			toAdd:    []string{"/not.a.real.MsgType"},
+			expErr:   `cannot add "/not.a.real.MsgType": unknown msg type url`,
+			expAllow: []string{msgSend},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.setAllowlist(tc.starting...)
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+
+			expEvents := sdk.Events{}
+			if len(tc.expErr) == 0 {
+				event, err := sdk.TypedEventToEvent(ibchost.NewEventICAHostAllowlistUpdated(tc.toAdd, tc.toRemove))
+				s.Require().NoError(err, "TypedEventToEvent")
+				expEvents = sdk.Events{event}
+			}
+
+			var err error
+			testFunc := func() {
+				err = s.app.IBCHostKeeper.UpdateICAHostAllowlist(ctx, tc.toAdd, tc.toRemove)
+			}
+			s.Require().NotPanics(testFunc, "UpdateICAHostAllowlist")
+			assertions.AssertErrorValue(s.T(), err, tc.expErr, "UpdateICAHostAllowlist error")
+			s.Assert().Equal(expEvents, em.Events(), "events emitted during UpdateICAHostAllowlist")
+
+			params := s.app.ICAHostKeeper.GetParams(s.ctx)
+			s.Assert().Equal(tc.expAllow, params.AllowMessages, "AllowMessages after UpdateICAHostAllowlist")
+			s.Assert().True(params.HostEnabled, "HostEnabled after UpdateICAHostAllowlist")
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServerUpdateICAHostAllowlist() {
+	authority := s.app.IBCHostKeeper.GetAuthority()
+	other := "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma"
+
+	tests := []struct {
+		name   string
+		req    *ibchost.MsgUpdateICAHostAllowlistRequest
+		expErr string
+		expRes *ibchost.MsgUpdateICAHostAllowlistResponse
+	}{
+		{
+			name:   "wrong authority",
+			req:    ibchost.NewMsgUpdateICAHostAllowlistRequest(other, []string{msgSend}, nil),
+			expErr: `expected "` + authority + `" got "` + other + `": expected gov account as only signer for proposal message`,
+		},
+		{
+			name:   "keeper error",
+			req:    ibchost.NewMsgUpdateICAHostAllowlistRequest(authority, nil, []string{msgVote}),
+			expErr: `cannot remove "` + msgVote + `": msg type url not allowed`,
+		},
+		{
+			name:   "success",
+			req:    ibchost.NewMsgUpdateICAHostAllowlistRequest(authority, []string{msgSend}, nil),
+			expRes: &ibchost.MsgUpdateICAHostAllowlistResponse{},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.setAllowlist()
+			res, err := s.msgServer.UpdateICAHostAllowlist(s.ctx, tc.req)
+			assertions.AssertErrorValue(s.T(), err, tc.expErr, "UpdateICAHostAllowlist error")
+			s.Assert().Equal(tc.expRes, res, "UpdateICAHostAllowlist response")
+		})
+	}
+}
+
+func (s *TestSuite) TestICAHostAllowlistQuery() {
+	s.app.ICAHostKeeper.SetParams(s.ctx, icahosttypes.NewParams(false, []string{msgSend, msgVote}))
+	expected := &ibchost.ICAHostAllowlistResponse{
+		HostEnabled:   false,
+		AllowMessages: []string{msgSend, msgVote},
+	}
+
+	res, err := s.queryClient.ICAHostAllowlist(s.ctx, &ibchost.ICAHostAllowlistRequest{})
+	s.Require().NoError(err, "ICAHostAllowlist")
+	s.Assert().Equal(expected, res, "ICAHostAllowlist response")
+}
+
+func (s *TestSuite) TestUpdateICQHostAllowlist() {
+	tests := []struct {
+		name     string
+		starting []string
+		toAdd    []string
+		toRemove []string
+		expErr   string
+		expAllow []string
+	}{
+		{
+			name:     "add one to empty",
+			toAdd:    []string{queryNAVs},
+			expAllow: []string{queryNAVs},
+		},
+		{
+			name:     "add two to existing",
+			starting: []string{queryNAVs},
+			toAdd:    []string{queryAttribute, queryScope},
+			expAllow: []string{queryNAVs, queryAttribute, queryScope},
+		},
+		{
+			name:     "remove the middle one",
+			starting: []string{queryNAVs, queryAttribute, queryScope},
+			toRemove: []string{queryAttribute},
+			expAllow: []string{queryNAVs, queryScope},
+		},
+		{
+			name:     "remove and add the same one",
+			starting: []string{queryNAVs, queryAttribute},
+			toAdd:    []string{queryNAVs},
+			toRemove: []string{queryNAVs},
+			expAllow: []string{queryAttribute, queryNAVs},
+		},
+		{
+			name:     "add already allowed",
+			starting: []string{queryNAVs},
+			toAdd:    []string{queryNAVs},
+			expErr:   `cannot add "` + queryNAVs + `": query path already allowed`,
+			expAllow: []string{queryNAVs},
+		},
+		{
+			name:     "remove not allowed",
+			starting: []string{queryNAVs},
+			toRemove: []string{queryScope},
+			expErr:   `cannot remove "` + queryScope + `": query path not allowed`,
+			expAllow: []string{queryNAVs},
+		},
+		{
+			name:     "add unknown query path",
+			starting: []string{queryNAVs},
+			toAdd:    []string{"/not.a.real.Query/Thing"},
+			expErr:   `cannot add "/not.a.real.Query/Thing": unknown query path`,
+			expAllow: []string{queryNAVs},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.setQueryAllowlist(tc.starting...)
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+
+			expEvents := sdk.Events{}
+			if len(tc.expErr) == 0 {
+				event, err := sdk.TypedEventToEvent(ibchost.NewEventICQHostAllowlistUpdated(tc.toAdd, tc.toRemove))
+				s.Require().NoError(err, "TypedEventToEvent")
+				expEvents = sdk.Events{event}
+			}
+
+			var err error
+			testFunc := func() {
+				err = s.app.IBCHostKeeper.UpdateICQHostAllowlist(ctx, tc.toAdd, tc.toRemove)
+			}
+			s.Require().NotPanics(testFunc, "UpdateICQHostAllowlist")
+			assertions.AssertErrorValue(s.T(), err, tc.expErr, "UpdateICQHostAllowlist error")
+			s.Assert().Equal(expEvents, em.Events(), "events emitted during UpdateICQHostAllowlist")
+
+			params := s.app.ICQKeeper.GetParams(s.ctx)
+			s.Assert().Equal(tc.expAllow, params.AllowQueries, "AllowQueries after UpdateICQHostAllowlist")
+			s.Assert().True(params.HostEnabled, "HostEnabled after UpdateICQHostAllowlist")
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServerUpdateICQHostAllowlist() {
+	authority := s.app.IBCHostKeeper.GetAuthority()
+	other := "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma"
+
+	tests := []struct {
+		name   string
+		req    *ibchost.MsgUpdateICQHostAllowlistRequest
+		expErr string
+		expRes *ibchost.MsgUpdateICQHostAllowlistResponse
+	}{
+		{
+			name:   "wrong authority",
+			req:    ibchost.NewMsgUpdateICQHostAllowlistRequest(other, []string{queryNAVs}, nil),
+			expErr: `expected "` + authority + `" got "` + other + `": expected gov account as only signer for proposal message`,
+		},
+		{
+			name:   "keeper error",
+			req:    ibchost.NewMsgUpdateICQHostAllowlistRequest(authority, nil, []string{queryScope}),
+			expErr: `cannot remove "` + queryScope + `": query path not allowed`,
+		},
+		{
+			name:   "success",
+			req:    ibchost.NewMsgUpdateICQHostAllowlistRequest(authority, []string{queryNAVs}, nil),
+			expRes: &ibchost.MsgUpdateICQHostAllowlistResponse{},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.setQueryAllowlist()
+			res, err := s.msgServer.UpdateICQHostAllowlist(s.ctx, tc.req)
+			assertions.AssertErrorValue(s.T(), err, tc.expErr, "UpdateICQHostAllowlist error")
+			s.Assert().Equal(tc.expRes, res, "UpdateICQHostAllowlist response")
+		})
+	}
+}
+
+func (s *TestSuite) TestICQHostAllowlistQuery() {
+	s.Require().NoError(s.app.ICQKeeper.SetParams(s.ctx, icqtypes.NewParams(false, []string{queryNAVs, queryScope})), "ICQKeeper.SetParams")
+	expected := &ibchost.ICQHostAllowlistResponse{
+		HostEnabled:  false,
+		AllowQueries: []string{queryNAVs, queryScope},
+	}
+
+	res, err := s.queryClient.ICQHostAllowlist(s.ctx, &ibchost.ICQHostAllowlistRequest{})
+	s.Require().NoError(err, "ICQHostAllowlist")
+	s.Assert().Equal(expected, res, "ICQHostAllowlist response")
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/ibchost"
+)
+
+// MsgServer is an alias for a Keeper that implements the ibchost.MsgServer interface.
+type MsgServer struct {
+	Keeper
+}
+
+func NewMsgServer(k Keeper) ibchost.MsgServer {
+	return MsgServer{
+		Keeper: k,
+	}
+}
+
+var _ ibchost.MsgServer = MsgServer{}
+
+// UpdateICAHostAllowlist is a governance proposal endpoint for updating the interchain accounts host allowlist.
+func (k MsgServer) UpdateICAHostAllowlist(goCtx context.Context, msg *ibchost.MsgUpdateICAHostAllowlistRequest) (*ibchost.MsgUpdateICAHostAllowlistResponse, error) {
+	if err := k.ValidateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.UpdateICAHostAllowlist(ctx, msg.ToAdd, msg.ToRemove); err != nil {
+		return nil, err
+	}
+
+	return &ibchost.MsgUpdateICAHostAllowlistResponse{}, nil
+}
+
+// UpdateICQHostAllowlist is a governance proposal endpoint for updating the interchain queries host allowlist.
+func (k MsgServer) UpdateICQHostAllowlist(goCtx context.Context, msg *ibchost.MsgUpdateICQHostAllowlistRequest) (*ibchost.MsgUpdateICQHostAllowlistResponse, error) {
+	if err := k.ValidateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.UpdateICQHostAllowlist(ctx, msg.ToAdd, msg.ToRemove); err != nil {
+		return nil, err
+	}
+
+	return &ibchost.MsgUpdateICQHostAllowlistResponse{}, nil
+}
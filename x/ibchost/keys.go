@@ -0,0 +1,6 @@
+package ibchost
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "ibchost"
+)
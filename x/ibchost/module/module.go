@@ -0,0 +1,89 @@
+package module
+
+import (
+	"context"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/core/appmodule"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/provenance-io/provenance/x/ibchost"
+	ibchostcli "github.com/provenance-io/provenance/x/ibchost/client/cli"
+	"github.com/provenance-io/provenance/x/ibchost/keeper"
+)
+
+var (
+	_ module.AppModuleBasic = (*AppModule)(nil)
+	_ module.HasServices    = (*AppModule)(nil)
+
+	_ appmodule.AppModule = (*AppModule)(nil)
+)
+
+// AppModuleBasic defines the basic application module used by the ibchost module.
+type AppModuleBasic struct {
+	cdc codec.Codec
+}
+
+// Name returns the ibchost module's name.
+func (AppModuleBasic) Name() string { return ibchost.ModuleName }
+
+// RegisterLegacyAminoCodec registers the ibchost module's types for the given codec.
+func (AppModuleBasic) RegisterLegacyAminoCodec(_ *codec.LegacyAmino) {}
+
+// RegisterInterfaces registers interfaces and implementations of the ibchost module.
+func (AppModuleBasic) RegisterInterfaces(cdc codectypes.InterfaceRegistry) {
+	ibchost.RegisterInterfaces(cdc)
+}
+
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the ibchost module.
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	if err := ibchost.RegisterQueryHandlerClient(context.Background(), mux, ibchost.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
+
+// GetQueryCmd returns the cli query commands for the ibchost module.
+func (AppModuleBasic) GetQueryCmd() *cobra.Command {
+	return ibchostcli.GetQueryCmd()
+}
+
+// GetTxCmd returns the transaction commands for the ibchost module.
+func (AppModuleBasic) GetTxCmd() *cobra.Command {
+	return ibchostcli.NewTxCmd()
+}
+
+// AppModule implements the sdk.AppModule interface.
+// The ibchost module doesn't have any state of its own, so there's no genesis.
+type AppModule struct {
+	AppModuleBasic
+	keeper keeper.Keeper
+}
+
+// NewAppModule creates a new AppModule object
+func NewAppModule(cdc codec.Codec, keeper keeper.Keeper) AppModule {
+	return AppModule{
+		AppModuleBasic: AppModuleBasic{cdc: cdc},
+		keeper:         keeper,
+	}
+}
+
+// IsOnePerModuleType is a dummy function that satisfies the OnePerModuleType interface (needed by AppModule).
+func (AppModule) IsOnePerModuleType() {}
+
+// IsAppModule is a dummy function that satisfies the AppModule interface.
+func (AppModule) IsAppModule() {}
+
+// ConsensusVersion implements AppModule/ConsensusVersion.
+func (AppModule) ConsensusVersion() uint64 { return 1 }
+
+// RegisterServices registers the module's gRPC query and msg services.
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	ibchost.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+	ibchost.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServer(am.keeper))
+}
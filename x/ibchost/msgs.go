@@ -0,0 +1,128 @@
+package ibchost
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icahosttypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
+)
+
+// AllRequestMsgs defines all the Msg*Request messages.
+var AllRequestMsgs = []sdk.Msg{
+	(*MsgUpdateICAHostAllowlistRequest)(nil),
+	(*MsgUpdateICQHostAllowlistRequest)(nil),
+}
+
+// NewMsgUpdateICAHostAllowlistRequest creates a new UpdateICAHostAllowlist message.
+func NewMsgUpdateICAHostAllowlistRequest(authority string, toAdd, toRemove []string) *MsgUpdateICAHostAllowlistRequest {
+	return &MsgUpdateICAHostAllowlistRequest{
+		Authority: authority,
+		ToAdd:     toAdd,
+		ToRemove:  toRemove,
+	}
+}
+
+// ValidateBasic runs stateless validation checks on the message.
+func (m MsgUpdateICAHostAllowlistRequest) ValidateBasic() error {
+	var errs []error
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		errs = append(errs, fmt.Errorf("invalid authority: %w", err))
+	}
+	if len(m.ToAdd) == 0 && len(m.ToRemove) == 0 {
+		errs = append(errs, errors.New("no msg type urls to add or remove"))
+	}
+
+	seen := make(map[string]string, len(m.ToAdd)+len(m.ToRemove))
+	check := func(field, typeURL string) {
+		if err := ValidateMsgTypeURL(typeURL); err != nil {
+			errs = append(errs, fmt.Errorf("invalid %s entry: %w", field, err))
+			return
+		}
+		if prev, dup := seen[typeURL]; dup {
+			errs = append(errs, fmt.Errorf("invalid %s entry: %q already in %s", field, typeURL, prev))
+			return
+		}
+		seen[typeURL] = field
+	}
+	for _, typeURL := range m.ToAdd {
+		check("to add", typeURL)
+	}
+	for _, typeURL := range m.ToRemove {
+		check("to remove", typeURL)
+	}
+
+	return errors.Join(errs...)
+}
+
+// ValidateMsgTypeURL returns an error if the provided string can't be an allowlist entry.
+// It must be either the allow-all wildcard, "*", or a msg type url starting with a "/".
+func ValidateMsgTypeURL(typeURL string) error {
+	if typeURL == icahosttypes.AllowAllHostMsgs {
+		return nil
+	}
+	if len(strings.TrimSpace(typeURL)) == 0 {
+		return errors.New("msg type url cannot be empty")
+	}
+	if !strings.HasPrefix(typeURL, "/") || strings.ContainsAny(typeURL, " \t\r\n") {
+		return fmt.Errorf("invalid msg type url %q", typeURL)
+	}
+	return nil
+}
+
+// NewMsgUpdateICQHostAllowlistRequest creates a new UpdateICQHostAllowlist message.
+func NewMsgUpdateICQHostAllowlistRequest(authority string, toAdd, toRemove []string) *MsgUpdateICQHostAllowlistRequest {
+	return &MsgUpdateICQHostAllowlistRequest{
+		Authority: authority,
+		ToAdd:     toAdd,
+		ToRemove:  toRemove,
+	}
+}
+
+// ValidateBasic runs stateless validation checks on the message.
+func (m MsgUpdateICQHostAllowlistRequest) ValidateBasic() error {
+	var errs []error
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		errs = append(errs, fmt.Errorf("invalid authority: %w", err))
+	}
+	if len(m.ToAdd) == 0 && len(m.ToRemove) == 0 {
+		errs = append(errs, errors.New("no query paths to add or remove"))
+	}
+
+	seen := make(map[string]string, len(m.ToAdd)+len(m.ToRemove))
+	check := func(field, path string) {
+		if err := ValidateQueryPath(path); err != nil {
+			errs = append(errs, fmt.Errorf("invalid %s entry: %w", field, err))
+			return
+		}
+		if prev, dup := seen[path]; dup {
+			errs = append(errs, fmt.Errorf("invalid %s entry: %q already in %s", field, path, prev))
+			return
+		}
+		seen[path] = field
+	}
+	for _, path := range m.ToAdd {
+		check("to add", path)
+	}
+	for _, path := range m.ToRemove {
+		check("to remove", path)
+	}
+
+	return errors.Join(errs...)
+}
+
+// ValidateQueryPath returns an error if the provided string can't be an interchain queries allowlist entry.
+// It must be a full grpc method path, e.g. "/provenance.marker.v1.Query/NetAssetValues".
+// There is no wildcard entry.
+func ValidateQueryPath(path string) error {
+	if len(strings.TrimSpace(path)) == 0 {
+		return errors.New("query path cannot be empty")
+	}
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	if !strings.HasPrefix(path, "/") || len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 ||
+		strings.ContainsAny(path, " \t\r\n") {
+		return fmt.Errorf("invalid query path %q", path)
+	}
+	return nil
+}
@@ -0,0 +1,227 @@
+package ibchost_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/testutil"
+	"github.com/provenance-io/provenance/testutil/assertions"
+
+	. "github.com/provenance-io/provenance/x/ibchost"
+)
+
+func TestAllMsgsGetSigners(t *testing.T) {
+	msgMakers := []testutil.MsgMaker{
+		func(signer string) sdk.Msg { return &MsgUpdateICAHostAllowlistRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgUpdateICQHostAllowlistRequest{Authority: signer} },
+	}
+
+	testutil.RunGetSignersTests(t, AllRequestMsgs, msgMakers, nil)
+}
+
+func TestNewMsgUpdateICAHostAllowlistRequest(t *testing.T) {
+	expected := &MsgUpdateICAHostAllowlistRequest{
+		Authority: "authority",
+		ToAdd:     []string{"/add.one", "/add.two"},
+		ToRemove:  []string{"/remove.one"},
+	}
+	actual := NewMsgUpdateICAHostAllowlistRequest(expected.Authority, expected.ToAdd, expected.ToRemove)
+	assert.Equal(t, expected, actual, "NewMsgUpdateICAHostAllowlistRequest")
+}
+
+func TestMsgUpdateICAHostAllowlistRequest_ValidateBasic(t *testing.T) {
+	authority := "cosmos1qm0hhug8kszhcp9f3ryuecz5yw8s3e5v0n2ckd"
+
+	tests := []struct {
+		name   string
+		msg    MsgUpdateICAHostAllowlistRequest
+		expErr []string
+	}{
+		{
+			name: "only adding",
+			msg:  MsgUpdateICAHostAllowlistRequest{Authority: authority, ToAdd: []string{"/cosmos.bank.v1beta1.MsgSend"}},
+		},
+		{
+			name: "only removing",
+			msg:  MsgUpdateICAHostAllowlistRequest{Authority: authority, ToRemove: []string{"/cosmos.bank.v1beta1.MsgSend"}},
+		},
+		{
+			name: "adding the wildcard",
+			msg:  MsgUpdateICAHostAllowlistRequest{Authority: authority, ToAdd: []string{"*"}},
+		},
+		{
+			name: "adding and removing",
+			msg: MsgUpdateICAHostAllowlistRequest{
+				Authority: authority,
+				ToAdd:     []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.bank.v1beta1.MsgMultiSend"},
+				ToRemove:  []string{"*"},
+			},
+		},
+		{
+			name:   "invalid authority",
+			msg:    MsgUpdateICAHostAllowlistRequest{Authority: "bad", ToAdd: []string{"/cosmos.bank.v1beta1.MsgSend"}},
+			expErr: []string{"invalid authority: decoding bech32 failed"},
+		},
+		{
+			name:   "nothing to add or remove",
+			msg:    MsgUpdateICAHostAllowlistRequest{Authority: authority},
+			expErr: []string{"no msg type urls to add or remove"},
+		},
+		{
+			name:   "empty entry to add",
+			msg:    MsgUpdateICAHostAllowlistRequest{Authority: authority, ToAdd: []string{""}},
+			expErr: []string{"invalid to add entry: msg type url cannot be empty"},
+		},
+		{
+			name:   "entry to remove without leading slash",
+			msg:    MsgUpdateICAHostAllowlistRequest{Authority: authority, ToRemove: []string{"cosmos.bank.v1beta1.MsgSend"}},
+			expErr: []string{`invalid to remove entry: invalid msg type url "cosmos.bank.v1beta1.MsgSend"`},
+		},
+		{
+			name:   "entry with a space",
+			msg:    MsgUpdateICAHostAllowlistRequest{Authority: authority, ToAdd: []string{"/cosmos.bank.v1beta1.MsgSend "}},
+			expErr: []string{`invalid to add entry: invalid msg type url "/cosmos.bank.v1beta1.MsgSend "`},
+		},
+		{
+			name:   "duplicate entry to add",
+			msg:    MsgUpdateICAHostAllowlistRequest{Authority: authority, ToAdd: []string{"/a.Msg", "/b.Msg", "/a.Msg"}},
+			expErr: []string{`invalid to add entry: "/a.Msg" already in to add`},
+		},
+		{
+			name:   "same entry to add and remove",
+			msg:    MsgUpdateICAHostAllowlistRequest{Authority: authority, ToAdd: []string{"/a.Msg"}, ToRemove: []string{"/a.Msg"}},
+			expErr: []string{`invalid to remove entry: "/a.Msg" already in to add`},
+		},
+		{
+			name: "multiple errors",
+			msg:  MsgUpdateICAHostAllowlistRequest{Authority: "", ToAdd: []string{"a.Msg"}, ToRemove: []string{""}},
+			expErr: []string{
+				"invalid authority: empty address string is not allowed",
+				`invalid to add entry: invalid msg type url "a.Msg"`,
+				"invalid to remove entry: msg type url cannot be empty",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = tc.msg.ValidateBasic()
+			}
+			assert.NotPanics(t, testFunc, "ValidateBasic")
+			assertions.AssertErrorContents(t, err, tc.expErr, "ValidateBasic error")
+		})
+	}
+}
+
+func TestNewMsgUpdateICQHostAllowlistRequest(t *testing.T) {
+	expected := &MsgUpdateICQHostAllowlistRequest{
+		Authority: "authority",
+		ToAdd:     []string{"/add.Query/One", "/add.Query/Two"},
+		ToRemove:  []string{"/remove.Query/One"},
+	}
+	actual := NewMsgUpdateICQHostAllowlistRequest(expected.Authority, expected.ToAdd, expected.ToRemove)
+	assert.Equal(t, expected, actual, "NewMsgUpdateICQHostAllowlistRequest")
+}
+
+func TestMsgUpdateICQHostAllowlistRequest_ValidateBasic(t *testing.T) {
+	authority := "cosmos1qm0hhug8kszhcp9f3ryuecz5yw8s3e5v0n2ckd"
+	navs := "/provenance.marker.v1.Query/NetAssetValues"
+
+	tests := []struct {
+		name   string
+		msg    MsgUpdateICQHostAllowlistRequest
+		expErr []string
+	}{
+		{
+			name: "only adding",
+			msg:  MsgUpdateICQHostAllowlistRequest{Authority: authority, ToAdd: []string{navs}},
+		},
+		{
+			name: "only removing",
+			msg:  MsgUpdateICQHostAllowlistRequest{Authority: authority, ToRemove: []string{navs}},
+		},
+		{
+			name: "adding and removing",
+			msg: MsgUpdateICQHostAllowlistRequest{
+				Authority: authority,
+				ToAdd:     []string{navs, "/provenance.metadata.v1.Query/Scope"},
+				ToRemove:  []string{"/provenance.attribute.v1.Query/Attribute"},
+			},
+		},
+		{
+			name:   "invalid authority",
+			msg:    MsgUpdateICQHostAllowlistRequest{Authority: "bad", ToAdd: []string{navs}},
+			expErr: []string{"invalid authority: decoding bech32 failed"},
+		},
+		{
+			name:   "nothing to add or remove",
+			msg:    MsgUpdateICQHostAllowlistRequest{Authority: authority},
+			expErr: []string{"no query paths to add or remove"},
+		},
+		{
+			name:   "empty entry to add",
+			msg:    MsgUpdateICQHostAllowlistRequest{Authority: authority, ToAdd: []string{""}},
+			expErr: []string{"invalid to add entry: query path cannot be empty"},
+		},
+		{
+			name:   "wildcard entry to add",
+			msg:    MsgUpdateICQHostAllowlistRequest{Authority: authority, ToAdd: []string{"*"}},
+			expErr: []string{`invalid to add entry: invalid query path "*"`},
+		},
+		{
+			name:   "entry to remove without leading slash",
+			msg:    MsgUpdateICQHostAllowlistRequest{Authority: authority, ToRemove: []string{"provenance.marker.v1.Query/NetAssetValues"}},
+			expErr: []string{`invalid to remove entry: invalid query path "provenance.marker.v1.Query/NetAssetValues"`},
+		},
+		{
+			name:   "entry without a method",
+			msg:    MsgUpdateICQHostAllowlistRequest{Authority: authority, ToAdd: []string{"/provenance.marker.v1.Query/"}},
+			expErr: []string{`invalid to add entry: invalid query path "/provenance.marker.v1.Query/"`},
+		},
+		{
+			name:   "entry without a service",
+			msg:    MsgUpdateICQHostAllowlistRequest{Authority: authority, ToAdd: []string{"//NetAssetValues"}},
+			expErr: []string{`invalid to add entry: invalid query path "//NetAssetValues"`},
+		},
+		{
+			name:   "entry with a space",
+			msg:    MsgUpdateICQHostAllowlistRequest{Authority: authority, ToAdd: []string{navs + " "}},
+			expErr: []string{`invalid to add entry: invalid query path "` + navs + ` "`},
+		},
+		{
+			name:   "duplicate entry to add",
+			msg:    MsgUpdateICQHostAllowlistRequest{Authority: authority, ToAdd: []string{"/a.Query/A", "/b.Query/B", "/a.Query/A"}},
+			expErr: []string{`invalid to add entry: "/a.Query/A" already in to add`},
+		},
+		{
+			name:   "same entry to add and remove",
+			msg:    MsgUpdateICQHostAllowlistRequest{Authority: authority, ToAdd: []string{"/a.Query/A"}, ToRemove: []string{"/a.Query/A"}},
+			expErr: []string{`invalid to remove entry: "/a.Query/A" already in to add`},
+		},
+		{
+			name: "multiple errors",
+			msg:  MsgUpdateICQHostAllowlistRequest{Authority: "", ToAdd: []string{"a.Query/A"}, ToRemove: []string{""}},
+			expErr: []string{
+				"invalid authority: empty address string is not allowed",
+				`invalid to add entry: invalid query path "a.Query/A"`,
+				"invalid to remove entry: query path cannot be empty",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = tc.msg.ValidateBasic()
+			}
+			assert.NotPanics(t, testFunc, "ValidateBasic")
+			assertions.AssertErrorContents(t, err, tc.expErr, "ValidateBasic error")
+		})
+	}
+}
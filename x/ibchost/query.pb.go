@@ -0,0 +1,961 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/ibchost/v1/query.proto
+
+package ibchost
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ICAHostAllowlistRequest is the request type for the Query/ICAHostAllowlist RPC method.
+type ICAHostAllowlistRequest struct {
+}
+
+func (m *ICAHostAllowlistRequest) Reset()         { *m = ICAHostAllowlistRequest{} }
+func (m *ICAHostAllowlistRequest) String() string { return proto.CompactTextString(m) }
+func (*ICAHostAllowlistRequest) ProtoMessage()    {}
+func (*ICAHostAllowlistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c39cff4cfe8991f, []int{0}
+}
+func (m *ICAHostAllowlistRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ICAHostAllowlistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ICAHostAllowlistRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ICAHostAllowlistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ICAHostAllowlistRequest.Merge(m, src)
+}
+func (m *ICAHostAllowlistRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ICAHostAllowlistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ICAHostAllowlistRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ICAHostAllowlistRequest proto.InternalMessageInfo
+
+// ICAHostAllowlistResponse is the response type for the Query/ICAHostAllowlist RPC method.
+type ICAHostAllowlistResponse struct {
+	// host_enabled is whether the interchain accounts host is enabled.
+	HostEnabled bool `protobuf:"varint,1,opt,name=host_enabled,json=hostEnabled,proto3" json:"host_enabled,omitempty"`
+	// allow_messages are the msg type urls that interchain accounts are allowed to execute.
+	AllowMessages []string `protobuf:"bytes,2,rep,name=allow_messages,json=allowMessages,proto3" json:"allow_messages,omitempty"`
+}
+
+func (m *ICAHostAllowlistResponse) Reset()         { *m = ICAHostAllowlistResponse{} }
+func (m *ICAHostAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*ICAHostAllowlistResponse) ProtoMessage()    {}
+func (*ICAHostAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c39cff4cfe8991f, []int{1}
+}
+func (m *ICAHostAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ICAHostAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ICAHostAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ICAHostAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ICAHostAllowlistResponse.Merge(m, src)
+}
+func (m *ICAHostAllowlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ICAHostAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ICAHostAllowlistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ICAHostAllowlistResponse proto.InternalMessageInfo
+
+func (m *ICAHostAllowlistResponse) GetHostEnabled() bool {
+	if m != nil {
+		return m.HostEnabled
+	}
+	return false
+}
+
+func (m *ICAHostAllowlistResponse) GetAllowMessages() []string {
+	if m != nil {
+		return m.AllowMessages
+	}
+	return nil
+}
+
+// ICQHostAllowlistRequest is the request type for the Query/ICQHostAllowlist RPC method.
+type ICQHostAllowlistRequest struct {
+}
+
+func (m *ICQHostAllowlistRequest) Reset()         { *m = ICQHostAllowlistRequest{} }
+func (m *ICQHostAllowlistRequest) String() string { return proto.CompactTextString(m) }
+func (*ICQHostAllowlistRequest) ProtoMessage()    {}
+func (*ICQHostAllowlistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c39cff4cfe8991f, []int{2}
+}
+func (m *ICQHostAllowlistRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ICQHostAllowlistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ICQHostAllowlistRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ICQHostAllowlistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ICQHostAllowlistRequest.Merge(m, src)
+}
+func (m *ICQHostAllowlistRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ICQHostAllowlistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ICQHostAllowlistRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ICQHostAllowlistRequest proto.InternalMessageInfo
+
+// ICQHostAllowlistResponse is the response type for the Query/ICQHostAllowlist RPC method.
+type ICQHostAllowlistResponse struct {
+	// host_enabled is whether the interchain queries host is enabled.
+	HostEnabled bool `protobuf:"varint,1,opt,name=host_enabled,json=hostEnabled,proto3" json:"host_enabled,omitempty"`
+	// allow_queries are the query paths that counterparty chains are allowed to query.
+	AllowQueries []string `protobuf:"bytes,2,rep,name=allow_queries,json=allowQueries,proto3" json:"allow_queries,omitempty"`
+}
+
+func (m *ICQHostAllowlistResponse) Reset()         { *m = ICQHostAllowlistResponse{} }
+func (m *ICQHostAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*ICQHostAllowlistResponse) ProtoMessage()    {}
+func (*ICQHostAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c39cff4cfe8991f, []int{3}
+}
+func (m *ICQHostAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ICQHostAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ICQHostAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ICQHostAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ICQHostAllowlistResponse.Merge(m, src)
+}
+func (m *ICQHostAllowlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ICQHostAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ICQHostAllowlistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ICQHostAllowlistResponse proto.InternalMessageInfo
+
+func (m *ICQHostAllowlistResponse) GetHostEnabled() bool {
+	if m != nil {
+		return m.HostEnabled
+	}
+	return false
+}
+
+func (m *ICQHostAllowlistResponse) GetAllowQueries() []string {
+	if m != nil {
+		return m.AllowQueries
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ICAHostAllowlistRequest)(nil), "provenance.ibchost.v1.ICAHostAllowlistRequest")
+	proto.RegisterType((*ICAHostAllowlistResponse)(nil), "provenance.ibchost.v1.ICAHostAllowlistResponse")
+	proto.RegisterType((*ICQHostAllowlistRequest)(nil), "provenance.ibchost.v1.ICQHostAllowlistRequest")
+	proto.RegisterType((*ICQHostAllowlistResponse)(nil), "provenance.ibchost.v1.ICQHostAllowlistResponse")
+}
+
+func init() { proto.RegisterFile("provenance/ibchost/v1/query.proto", fileDescriptor_6c39cff4cfe8991f) }
+
+var fileDescriptor_6c39cff4cfe8991f = []byte{
+	// 350 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xdf, 0x4a, 0x02, 0x41,
+	0x14, 0xc6, 0x1d, 0xa3, 0xa8, 0xc9, 0x22, 0x16, 0xa2, 0x4d, 0x62, 0x51, 0xfb, 0x83, 0x17, 0x39,
+	0x83, 0xf5, 0x04, 0x16, 0x41, 0x5d, 0x04, 0xad, 0x97, 0xdd, 0xc8, 0xec, 0x3a, 0xac, 0x03, 0xeb,
+	0x9c, 0xd5, 0x19, 0x2d, 0x6f, 0x7b, 0x82, 0xa0, 0x27, 0xe8, 0x6d, 0xba, 0x4b, 0xe8, 0xa6, 0xcb,
+	0xd0, 0x1e, 0x24, 0xd6, 0x31, 0x95, 0xdc, 0xc0, 0xba, 0x1b, 0xbe, 0x73, 0xce, 0x7c, 0xbf, 0xf3,
+	0xcd, 0xe0, 0x7c, 0xd4, 0x86, 0x2e, 0x97, 0x4c, 0xfa, 0x9c, 0x0a, 0xcf, 0x6f, 0x80, 0xd2, 0xb4,
+	0x5b, 0xa6, 0xad, 0x0e, 0x6f, 0xf7, 0x48, 0xd4, 0x06, 0x0d, 0xd6, 0xf6, 0xb4, 0x85, 0x8c, 0x5b,
+	0x48, 0xb7, 0x9c, 0xdd, 0x0b, 0x00, 0x82, 0x90, 0x53, 0x16, 0x09, 0xca, 0xa4, 0x04, 0xcd, 0xb4,
+	0x00, 0xa9, 0xcc, 0x50, 0x61, 0x17, 0xef, 0x5c, 0x9d, 0x57, 0x2e, 0x41, 0xe9, 0x4a, 0x18, 0xc2,
+	0x5d, 0x28, 0x94, 0xae, 0xf2, 0x56, 0x87, 0x2b, 0x5d, 0xa8, 0x63, 0x7b, 0xbe, 0xa4, 0x22, 0x90,
+	0x8a, 0x5b, 0x79, 0x9c, 0x89, 0xef, 0xaf, 0x71, 0xc9, 0xbc, 0x90, 0xd7, 0x6d, 0x94, 0x43, 0xc5,
+	0xd5, 0xea, 0x7a, 0xac, 0x5d, 0x18, 0xc9, 0x3a, 0xc4, 0x9b, 0x2c, 0x9e, 0xab, 0x35, 0xb9, 0x52,
+	0x2c, 0xe0, 0xca, 0x4e, 0xe7, 0x96, 0x8a, 0x6b, 0xd5, 0x8d, 0x91, 0x7a, 0x3d, 0x16, 0x0d, 0x80,
+	0x9b, 0x08, 0xe0, 0x61, 0x7b, 0xbe, 0xb4, 0x38, 0xc0, 0x3e, 0x36, 0x56, 0xb5, 0x38, 0x24, 0x31,
+	0xf1, 0xcf, 0x8c, 0x44, 0xd7, 0x68, 0x27, 0xaf, 0x69, 0xbc, 0x1c, 0x9f, 0x7b, 0xd6, 0x33, 0xc2,
+	0x5b, 0x3f, 0xf7, 0xb5, 0x08, 0x49, 0x0c, 0x95, 0xfc, 0x92, 0x59, 0x96, 0x2e, 0xdc, 0x6f, 0xf6,
+	0x28, 0x1c, 0x3f, 0xbc, 0x7d, 0x3e, 0xa5, 0x8f, 0xac, 0x03, 0x9a, 0xfc, 0xc0, 0xc2, 0x67, 0x94,
+	0x4d, 0x70, 0x0c, 0xa3, 0xbb, 0x28, 0xa3, 0xfb, 0x47, 0x46, 0xf7, 0xbf, 0x8c, 0xad, 0x29, 0xe3,
+	0x99, 0xff, 0x32, 0x70, 0x50, 0x7f, 0xe0, 0xa0, 0x8f, 0x81, 0x83, 0x1e, 0x87, 0x4e, 0xaa, 0x3f,
+	0x74, 0x52, 0xef, 0x43, 0x27, 0x85, 0x6d, 0x01, 0xc9, 0xd6, 0x37, 0xe8, 0xb6, 0x14, 0x08, 0xdd,
+	0xe8, 0x78, 0xc4, 0x87, 0xe6, 0x8c, 0x4b, 0x49, 0xc0, 0xac, 0xe7, 0xfd, 0xb7, 0xab, 0xb7, 0x32,
+	0xfa, 0xbd, 0xa7, 0x5f, 0x03, 0x00, 0x53, 0xe5, 0xe9, 0xc1, 0x17, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// ICAHostAllowlist returns the msg type urls that interchain accounts are allowed to execute on this chain.
+	ICAHostAllowlist(ctx context.Context, in *ICAHostAllowlistRequest, opts ...grpc.CallOption) (*ICAHostAllowlistResponse, error)
+	// ICQHostAllowlist returns the query paths that counterparty chains are allowed to query on this chain
+	// using interchain queries.
+	ICQHostAllowlist(ctx context.Context, in *ICQHostAllowlistRequest, opts ...grpc.CallOption) (*ICQHostAllowlistResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) ICAHostAllowlist(ctx context.Context, in *ICAHostAllowlistRequest, opts ...grpc.CallOption) (*ICAHostAllowlistResponse, error) {
+	out := new(ICAHostAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/provenance.ibchost.v1.Query/ICAHostAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ICQHostAllowlist(ctx context.Context, in *ICQHostAllowlistRequest, opts ...grpc.CallOption) (*ICQHostAllowlistResponse, error) {
+	out := new(ICQHostAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/provenance.ibchost.v1.Query/ICQHostAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// ICAHostAllowlist returns the msg type urls that interchain accounts are allowed to execute on this chain.
+	ICAHostAllowlist(context.Context, *ICAHostAllowlistRequest) (*ICAHostAllowlistResponse, error)
+	// ICQHostAllowlist returns the query paths that counterparty chains are allowed to query on this chain
+	// using interchain queries.
+	ICQHostAllowlist(context.Context, *ICQHostAllowlistRequest) (*ICQHostAllowlistResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) ICAHostAllowlist(ctx context.Context, req *ICAHostAllowlistRequest) (*ICAHostAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ICAHostAllowlist not implemented")
+}
+func (*UnimplementedQueryServer) ICQHostAllowlist(ctx context.Context, req *ICQHostAllowlistRequest) (*ICQHostAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ICQHostAllowlist not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_ICAHostAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ICAHostAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ICAHostAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.ibchost.v1.Query/ICAHostAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ICAHostAllowlist(ctx, req.(*ICAHostAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ICQHostAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ICQHostAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ICQHostAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.ibchost.v1.Query/ICQHostAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ICQHostAllowlist(ctx, req.(*ICQHostAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.ibchost.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ICAHostAllowlist",
+			Handler:    _Query_ICAHostAllowlist_Handler,
+		},
+		{
+			MethodName: "ICQHostAllowlist",
+			Handler:    _Query_ICQHostAllowlist_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provenance/ibchost/v1/query.proto",
+}
+
+func (m *ICAHostAllowlistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ICAHostAllowlistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ICAHostAllowlistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ICAHostAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ICAHostAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ICAHostAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowMessages) > 0 {
+		for iNdEx := len(m.AllowMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowMessages[iNdEx])
+			copy(dAtA[i:], m.AllowMessages[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.AllowMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.HostEnabled {
+		i--
+		if m.HostEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ICQHostAllowlistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ICQHostAllowlistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ICQHostAllowlistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ICQHostAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ICQHostAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ICQHostAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowQueries) > 0 {
+		for iNdEx := len(m.AllowQueries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowQueries[iNdEx])
+			copy(dAtA[i:], m.AllowQueries[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.AllowQueries[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.HostEnabled {
+		i--
+		if m.HostEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ICAHostAllowlistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ICAHostAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HostEnabled {
+		n += 2
+	}
+	if len(m.AllowMessages) > 0 {
+		for _, s := range m.AllowMessages {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ICQHostAllowlistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ICQHostAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HostEnabled {
+		n += 2
+	}
+	if len(m.AllowQueries) > 0 {
+		for _, s := range m.AllowQueries {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ICAHostAllowlistRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ICAHostAllowlistRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ICAHostAllowlistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ICAHostAllowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ICAHostAllowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ICAHostAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HostEnabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowMessages = append(m.AllowMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ICQHostAllowlistRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ICQHostAllowlistRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ICQHostAllowlistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ICQHostAllowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ICQHostAllowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ICQHostAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HostEnabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowQueries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowQueries = append(m.AllowQueries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,1098 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/ibchost/v1/tx.proto
+
+package ibchost
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgUpdateICAHostAllowlistRequest is a request message for the UpdateICAHostAllowlist endpoint.
+type MsgUpdateICAHostAllowlistRequest struct {
+	// authority should be the governance module account address.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// to_add are the msg type urls to add to the allowlist, e.g. "/cosmos.bank.v1beta1.MsgSend".
+	ToAdd []string `protobuf:"bytes,2,rep,name=to_add,json=toAdd,proto3" json:"to_add,omitempty"`
+	// to_remove are the msg type urls to remove from the allowlist.
+	ToRemove []string `protobuf:"bytes,3,rep,name=to_remove,json=toRemove,proto3" json:"to_remove,omitempty"`
+}
+
+func (m *MsgUpdateICAHostAllowlistRequest) Reset()         { *m = MsgUpdateICAHostAllowlistRequest{} }
+func (m *MsgUpdateICAHostAllowlistRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateICAHostAllowlistRequest) ProtoMessage()    {}
+func (*MsgUpdateICAHostAllowlistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f27ea698b227de01, []int{0}
+}
+func (m *MsgUpdateICAHostAllowlistRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateICAHostAllowlistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateICAHostAllowlistRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateICAHostAllowlistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateICAHostAllowlistRequest.Merge(m, src)
+}
+func (m *MsgUpdateICAHostAllowlistRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateICAHostAllowlistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateICAHostAllowlistRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateICAHostAllowlistRequest proto.InternalMessageInfo
+
+func (m *MsgUpdateICAHostAllowlistRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateICAHostAllowlistRequest) GetToAdd() []string {
+	if m != nil {
+		return m.ToAdd
+	}
+	return nil
+}
+
+func (m *MsgUpdateICAHostAllowlistRequest) GetToRemove() []string {
+	if m != nil {
+		return m.ToRemove
+	}
+	return nil
+}
+
+// MsgUpdateICAHostAllowlistResponse is a response message for the UpdateICAHostAllowlist endpoint.
+type MsgUpdateICAHostAllowlistResponse struct {
+}
+
+func (m *MsgUpdateICAHostAllowlistResponse) Reset()         { *m = MsgUpdateICAHostAllowlistResponse{} }
+func (m *MsgUpdateICAHostAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateICAHostAllowlistResponse) ProtoMessage()    {}
+func (*MsgUpdateICAHostAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f27ea698b227de01, []int{1}
+}
+func (m *MsgUpdateICAHostAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateICAHostAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateICAHostAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateICAHostAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateICAHostAllowlistResponse.Merge(m, src)
+}
+func (m *MsgUpdateICAHostAllowlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateICAHostAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateICAHostAllowlistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateICAHostAllowlistResponse proto.InternalMessageInfo
+
+// MsgUpdateICQHostAllowlistRequest is a request message for the UpdateICQHostAllowlist endpoint.
+type MsgUpdateICQHostAllowlistRequest struct {
+	// authority should be the governance module account address.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// to_add are the query paths to add to the allowlist, e.g. "/provenance.marker.v1.Query/NetAssetValues".
+	ToAdd []string `protobuf:"bytes,2,rep,name=to_add,json=toAdd,proto3" json:"to_add,omitempty"`
+	// to_remove are the query paths to remove from the allowlist.
+	ToRemove []string `protobuf:"bytes,3,rep,name=to_remove,json=toRemove,proto3" json:"to_remove,omitempty"`
+}
+
+func (m *MsgUpdateICQHostAllowlistRequest) Reset()         { *m = MsgUpdateICQHostAllowlistRequest{} }
+func (m *MsgUpdateICQHostAllowlistRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateICQHostAllowlistRequest) ProtoMessage()    {}
+func (*MsgUpdateICQHostAllowlistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f27ea698b227de01, []int{2}
+}
+func (m *MsgUpdateICQHostAllowlistRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateICQHostAllowlistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateICQHostAllowlistRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateICQHostAllowlistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateICQHostAllowlistRequest.Merge(m, src)
+}
+func (m *MsgUpdateICQHostAllowlistRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateICQHostAllowlistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateICQHostAllowlistRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateICQHostAllowlistRequest proto.InternalMessageInfo
+
+func (m *MsgUpdateICQHostAllowlistRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateICQHostAllowlistRequest) GetToAdd() []string {
+	if m != nil {
+		return m.ToAdd
+	}
+	return nil
+}
+
+func (m *MsgUpdateICQHostAllowlistRequest) GetToRemove() []string {
+	if m != nil {
+		return m.ToRemove
+	}
+	return nil
+}
+
+// MsgUpdateICQHostAllowlistResponse is a response message for the UpdateICQHostAllowlist endpoint.
+type MsgUpdateICQHostAllowlistResponse struct {
+}
+
+func (m *MsgUpdateICQHostAllowlistResponse) Reset()         { *m = MsgUpdateICQHostAllowlistResponse{} }
+func (m *MsgUpdateICQHostAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateICQHostAllowlistResponse) ProtoMessage()    {}
+func (*MsgUpdateICQHostAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f27ea698b227de01, []int{3}
+}
+func (m *MsgUpdateICQHostAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateICQHostAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateICQHostAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateICQHostAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateICQHostAllowlistResponse.Merge(m, src)
+}
+func (m *MsgUpdateICQHostAllowlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateICQHostAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateICQHostAllowlistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateICQHostAllowlistResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateICAHostAllowlistRequest)(nil), "provenance.ibchost.v1.MsgUpdateICAHostAllowlistRequest")
+	proto.RegisterType((*MsgUpdateICAHostAllowlistResponse)(nil), "provenance.ibchost.v1.MsgUpdateICAHostAllowlistResponse")
+	proto.RegisterType((*MsgUpdateICQHostAllowlistRequest)(nil), "provenance.ibchost.v1.MsgUpdateICQHostAllowlistRequest")
+	proto.RegisterType((*MsgUpdateICQHostAllowlistResponse)(nil), "provenance.ibchost.v1.MsgUpdateICQHostAllowlistResponse")
+}
+
+func init() { proto.RegisterFile("provenance/ibchost/v1/tx.proto", fileDescriptor_f27ea698b227de01) }
+
+var fileDescriptor_f27ea698b227de01 = []byte{
+	// 372 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x53, 0x31, 0x4b, 0xeb, 0x50,
+	0x18, 0xed, 0x6d, 0x69, 0x79, 0xb9, 0xc3, 0x1b, 0xc2, 0xeb, 0x7b, 0x79, 0x79, 0x10, 0xfa, 0xea,
+	0x52, 0x0a, 0x4d, 0xa8, 0x82, 0x8a, 0x5b, 0xea, 0xa2, 0x43, 0xc1, 0x44, 0x5c, 0x5c, 0x4a, 0x9a,
+	0x5c, 0xd2, 0x40, 0x93, 0x2f, 0xe6, 0x7e, 0x8d, 0x75, 0x13, 0x57, 0x17, 0x7f, 0x81, 0xb3, 0x63,
+	0x07, 0x7f, 0x84, 0x63, 0x71, 0x72, 0x94, 0x76, 0xe8, 0xdf, 0x10, 0x93, 0x4a, 0x54, 0x82, 0x52,
+	0x27, 0xc7, 0xc3, 0x39, 0xdf, 0xb9, 0xe7, 0xdc, 0x8f, 0x8f, 0x2a, 0x61, 0x04, 0x31, 0x0b, 0xac,
+	0xc0, 0x66, 0x9a, 0xd7, 0xb7, 0x07, 0xc0, 0x51, 0x8b, 0xdb, 0x1a, 0x8e, 0xd5, 0x30, 0x02, 0x04,
+	0xb1, 0x9a, 0xf1, 0xea, 0x92, 0x57, 0xe3, 0xb6, 0xfc, 0xd7, 0x06, 0xee, 0x03, 0xef, 0x25, 0x22,
+	0x2d, 0x05, 0xe9, 0x84, 0xfc, 0x27, 0x45, 0x9a, 0xcf, 0xdd, 0x67, 0x27, 0x9f, 0xbb, 0x29, 0x51,
+	0xbf, 0x26, 0xb4, 0xd6, 0xe5, 0xee, 0x51, 0xe8, 0x58, 0xc8, 0xf6, 0x77, 0xf5, 0x3d, 0xe0, 0xa8,
+	0x0f, 0x87, 0x70, 0x3a, 0xf4, 0x38, 0x9a, 0xec, 0x64, 0xc4, 0x38, 0x8a, 0x9b, 0x54, 0xb0, 0x46,
+	0x38, 0x80, 0xc8, 0xc3, 0x33, 0x89, 0xd4, 0x48, 0x43, 0xe8, 0x48, 0xf7, 0xb7, 0xad, 0x5f, 0xcb,
+	0x27, 0x74, 0xc7, 0x89, 0x18, 0xe7, 0x87, 0x18, 0x79, 0x81, 0x6b, 0x66, 0x52, 0xb1, 0x4a, 0x2b,
+	0x08, 0x3d, 0xcb, 0x71, 0xa4, 0x62, 0xad, 0xd4, 0x10, 0xcc, 0x32, 0x82, 0xee, 0x38, 0xe2, 0x3f,
+	0x2a, 0x20, 0xf4, 0x22, 0xe6, 0x43, 0xcc, 0xa4, 0x52, 0xc2, 0xfc, 0x40, 0x30, 0x13, 0xbc, 0xf3,
+	0xf3, 0x62, 0x31, 0x69, 0x66, 0x1e, 0xf5, 0x35, 0xfa, 0xff, 0x83, 0x7c, 0x3c, 0x84, 0x80, 0xb3,
+	0xf7, 0x2d, 0x8c, 0x6f, 0xde, 0xc2, 0xc8, 0x6d, 0xb1, 0x7e, 0x53, 0xa4, 0xa5, 0x2e, 0x77, 0xc5,
+	0x4b, 0x42, 0x7f, 0xe7, 0x17, 0x16, 0xb7, 0xd4, 0xdc, 0xd5, 0xab, 0x9f, 0xad, 0x50, 0xde, 0x5e,
+	0x7d, 0x30, 0x4d, 0xf5, 0x26, 0x8d, 0xb1, 0x72, 0x1a, 0xe3, 0xab, 0x69, 0xf2, 0xff, 0x48, 0x2e,
+	0x9f, 0x2f, 0x26, 0x4d, 0xd2, 0xb1, 0xef, 0x66, 0x0a, 0x99, 0xce, 0x14, 0xf2, 0x38, 0x53, 0xc8,
+	0xd5, 0x5c, 0x29, 0x4c, 0xe7, 0x4a, 0xe1, 0x61, 0xae, 0x14, 0xa8, 0xe4, 0x41, 0xbe, 0xf9, 0x01,
+	0x39, 0x6e, 0xb9, 0x1e, 0x0e, 0x46, 0x7d, 0xd5, 0x06, 0x5f, 0xcb, 0x34, 0x2d, 0x0f, 0x5e, 0x21,
+	0x6d, 0xfc, 0x72, 0x72, 0xfd, 0x4a, 0x72, 0x22, 0x1b, 0x4f, 0x03, 0x00, 0xc7, 0x2b, 0xcb, 0x07,
+	0x8f, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateICAHostAllowlist is a governance proposal endpoint for adding and removing
+	// msg type urls in the interchain accounts host allowlist.
+	UpdateICAHostAllowlist(ctx context.Context, in *MsgUpdateICAHostAllowlistRequest, opts ...grpc.CallOption) (*MsgUpdateICAHostAllowlistResponse, error)
+	// UpdateICQHostAllowlist is a governance proposal endpoint for adding and removing
+	// query paths in the interchain queries host allowlist.
+	UpdateICQHostAllowlist(ctx context.Context, in *MsgUpdateICQHostAllowlistRequest, opts ...grpc.CallOption) (*MsgUpdateICQHostAllowlistResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateICAHostAllowlist(ctx context.Context, in *MsgUpdateICAHostAllowlistRequest, opts ...grpc.CallOption) (*MsgUpdateICAHostAllowlistResponse, error) {
+	out := new(MsgUpdateICAHostAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/provenance.ibchost.v1.Msg/UpdateICAHostAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateICQHostAllowlist(ctx context.Context, in *MsgUpdateICQHostAllowlistRequest, opts ...grpc.CallOption) (*MsgUpdateICQHostAllowlistResponse, error) {
+	out := new(MsgUpdateICQHostAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/provenance.ibchost.v1.Msg/UpdateICQHostAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateICAHostAllowlist is a governance proposal endpoint for adding and removing
+	// msg type urls in the interchain accounts host allowlist.
+	UpdateICAHostAllowlist(context.Context, *MsgUpdateICAHostAllowlistRequest) (*MsgUpdateICAHostAllowlistResponse, error)
+	// UpdateICQHostAllowlist is a governance proposal endpoint for adding and removing
+	// query paths in the interchain queries host allowlist.
+	UpdateICQHostAllowlist(context.Context, *MsgUpdateICQHostAllowlistRequest) (*MsgUpdateICQHostAllowlistResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateICAHostAllowlist(ctx context.Context, req *MsgUpdateICAHostAllowlistRequest) (*MsgUpdateICAHostAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateICAHostAllowlist not implemented")
+}
+func (*UnimplementedMsgServer) UpdateICQHostAllowlist(ctx context.Context, req *MsgUpdateICQHostAllowlistRequest) (*MsgUpdateICQHostAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateICQHostAllowlist not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateICAHostAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateICAHostAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateICAHostAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.ibchost.v1.Msg/UpdateICAHostAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateICAHostAllowlist(ctx, req.(*MsgUpdateICAHostAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateICQHostAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateICQHostAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateICQHostAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.ibchost.v1.Msg/UpdateICQHostAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateICQHostAllowlist(ctx, req.(*MsgUpdateICQHostAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.ibchost.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateICAHostAllowlist",
+			Handler:    _Msg_UpdateICAHostAllowlist_Handler,
+		},
+		{
+			MethodName: "UpdateICQHostAllowlist",
+			Handler:    _Msg_UpdateICQHostAllowlist_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provenance/ibchost/v1/tx.proto",
+}
+
+func (m *MsgUpdateICAHostAllowlistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateICAHostAllowlistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateICAHostAllowlistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ToRemove) > 0 {
+		for iNdEx := len(m.ToRemove) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ToRemove[iNdEx])
+			copy(dAtA[i:], m.ToRemove[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ToRemove[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ToAdd) > 0 {
+		for iNdEx := len(m.ToAdd) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ToAdd[iNdEx])
+			copy(dAtA[i:], m.ToAdd[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ToAdd[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateICAHostAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateICAHostAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateICAHostAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateICQHostAllowlistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateICQHostAllowlistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateICQHostAllowlistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ToRemove) > 0 {
+		for iNdEx := len(m.ToRemove) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ToRemove[iNdEx])
+			copy(dAtA[i:], m.ToRemove[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ToRemove[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ToAdd) > 0 {
+		for iNdEx := len(m.ToAdd) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ToAdd[iNdEx])
+			copy(dAtA[i:], m.ToAdd[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ToAdd[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateICQHostAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateICQHostAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateICQHostAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateICAHostAllowlistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ToAdd) > 0 {
+		for _, s := range m.ToAdd {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ToRemove) > 0 {
+		for _, s := range m.ToRemove {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateICAHostAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateICQHostAllowlistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ToAdd) > 0 {
+		for _, s := range m.ToAdd {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ToRemove) > 0 {
+		for _, s := range m.ToRemove {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateICQHostAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateICAHostAllowlistRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateICAHostAllowlistRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateICAHostAllowlistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAdd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAdd = append(m.ToAdd, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToRemove", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToRemove = append(m.ToRemove, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateICAHostAllowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateICAHostAllowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateICAHostAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateICQHostAllowlistRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateICQHostAllowlistRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateICQHostAllowlistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAdd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAdd = append(m.ToAdd, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToRemove", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToRemove = append(m.ToRemove, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateICQHostAllowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateICQHostAllowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateICQHostAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
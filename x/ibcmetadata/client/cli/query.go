@@ -0,0 +1,93 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/provenance-io/provenance/x/ibcmetadata/types"
+)
+
+// GetQueryCmd is the top-level command for ibcmetadata CLI queries.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the ibcmetadata module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(
+		GetQueryScopeEscrowCmd(),
+		GetQueryScopeEscrowsCmd(),
+	)
+	return queryCmd
+}
+
+// GetQueryScopeEscrowCmd queries for the escrow info of a scope.
+func GetQueryScopeEscrowCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "escrow <scope-id>",
+		Short:   "Returns the escrow info of a scope whose value owner is on another chain",
+		Args:    cobra.ExactArgs(1),
+		Aliases: []string{"e"},
+		Example: fmt.Sprintf(`%[1]s q ibcmetadata escrow scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ScopeEscrow(context.Background(), &types.QueryScopeEscrowRequest{ScopeId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetQueryScopeEscrowsCmd queries for all the scopes whose value owner is on another chain.
+func GetQueryScopeEscrowsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "escrows",
+		Short:   "Returns all the scopes whose value owner is on another chain",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf(`%[1]s q ibcmetadata escrows`, version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ScopeEscrows(context.Background(), &types.QueryScopeEscrowsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "escrows")
+
+	return cmd
+}
@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+
+	"github.com/provenance-io/provenance/x/ibcmetadata/types"
+)
+
+const (
+	FlagSourcePort             = "source-port"
+	FlagPacketTimeoutHeight    = "packet-timeout-height"
+	FlagPacketTimeoutTimestamp = "packet-timeout-timestamp"
+	FlagMemo                   = "memo"
+)
+
+// DefaultRelativePacketTimeoutTimestamp is the default packet timeout timestamp (in nanoseconds)
+// relative to the current time of the client.
+var DefaultRelativePacketTimeoutTimestamp = uint64((10 * time.Minute).Nanoseconds())
+
+// NewTxCmd is the top-level command for ibcmetadata CLI transactions.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Transaction commands for the ibcmetadata module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		GetCmdTransferValueOwner(),
+	)
+
+	return txCmd
+}
+
+// GetCmdTransferValueOwner is a command to send the value owner of a scope to another chain.
+func GetCmdTransferValueOwner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-value-owner <scope-id> <receiver> <source-channel>",
+		Short: "Send the value owner of a scope to an address on another chain via IBC",
+		Long: `Send the value owner of a scope to an address on another chain via IBC.
+The value owner role is held in escrow on this chain until it is sent back.
+The --packet-timeout-timestamp is relative to the current time (in nanoseconds).
+Set it and the --packet-timeout-height to 0 to disable them.`,
+		Args:    cobra.ExactArgs(3),
+		Aliases: []string{"tvo"},
+		Example: fmt.Sprintf(`%[1]s tx ibcmetadata transfer-value-owner scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel cosmos1... channel-1 --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			flagSet := cmd.Flags()
+			srcPort, err := flagSet.GetString(FlagSourcePort)
+			if err != nil {
+				return err
+			}
+			memo, err := flagSet.GetString(FlagMemo)
+			if err != nil {
+				return err
+			}
+			timeoutHeightStr, err := flagSet.GetString(FlagPacketTimeoutHeight)
+			if err != nil {
+				return err
+			}
+			timeoutHeight, err := clienttypes.ParseHeight(timeoutHeightStr)
+			if err != nil {
+				return err
+			}
+			timeoutTimestamp, err := flagSet.GetUint64(FlagPacketTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+			if timeoutTimestamp != 0 {
+				timeoutTimestamp += uint64(time.Now().UnixNano())
+			}
+
+			msg := types.NewMsgTransferScopeValueOwnerRequest(args[0], clientCtx.GetFromAddress().String(), args[1],
+				srcPort, args[2], timeoutHeight, timeoutTimestamp, memo)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, flagSet, msg)
+		},
+	}
+
+	cmd.Flags().String(FlagSourcePort, types.PortID, "The port to send the packet through")
+	cmd.Flags().String(FlagPacketTimeoutHeight, "0-0", "Packet timeout block height in the format {revision}-{height}")
+	cmd.Flags().Uint64(FlagPacketTimeoutTimestamp, DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds from now")
+	cmd.Flags().String(FlagMemo, "", "Memo to include with the packet")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/ibcmetadata/types"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// GetScopeEscrow returns the escrow entry for a scope, and whether it exists.
+func (k Keeper) GetScopeEscrow(ctx sdk.Context, scopeID metadatatypes.MetadataAddress) (types.ScopeEscrow, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetScopeEscrowKey(scopeID))
+	if len(bz) == 0 {
+		return types.ScopeEscrow{}, false
+	}
+	var escrow types.ScopeEscrow
+	k.cdc.MustUnmarshal(bz, &escrow)
+	return escrow, true
+}
+
+// SetScopeEscrow stores the escrow entry for a scope.
+func (k Keeper) SetScopeEscrow(ctx sdk.Context, escrow types.ScopeEscrow) error {
+	scopeID, err := types.ParseScopeID(escrow.ScopeId)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetScopeEscrowKey(scopeID), k.cdc.MustMarshal(&escrow))
+	return nil
+}
+
+// DeleteScopeEscrow removes the escrow entry for a scope.
+func (k Keeper) DeleteScopeEscrow(ctx sdk.Context, scopeID metadatatypes.MetadataAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.GetScopeEscrowKey(scopeID))
+}
+
+// IterateScopeEscrows calls the provided callback for each scope escrow entry.
+// If the callback returns true, iteration stops.
+func (k Keeper) IterateScopeEscrows(ctx sdk.Context, cb func(escrow types.ScopeEscrow) (stop bool)) {
+	iter := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ScopeEscrowKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var escrow types.ScopeEscrow
+		k.cdc.MustUnmarshal(iter.Value(), &escrow)
+		if cb(escrow) {
+			break
+		}
+	}
+}
+
+// GetAllScopeEscrows returns all the scope escrow entries.
+func (k Keeper) GetAllScopeEscrows(ctx sdk.Context) []types.ScopeEscrow {
+	var rv []types.ScopeEscrow
+	k.IterateScopeEscrows(ctx, func(escrow types.ScopeEscrow) bool {
+		rv = append(rv, escrow)
+		return false
+	})
+	return rv
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/ibcmetadata/types"
+)
+
+// ExportGenesis returns a GenesisState for a given context.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetPort(ctx), k.GetAllScopeEscrows(ctx))
+}
+
+// InitGenesis sets the port and escrows from the provided genesis state, and binds to the port.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	if err := genState.Validate(); err != nil {
+		panic(err)
+	}
+
+	k.SetPort(ctx, genState.PortId)
+	if !k.IsBound(ctx, genState.PortId) {
+		err := k.BindPort(ctx, genState.PortId)
+		if err != nil {
+			panic("could not claim port capability: " + err.Error())
+		}
+	}
+
+	for _, escrow := range genState.Escrows {
+		if err := k.SetScopeEscrow(ctx, escrow); err != nil {
+			panic(err)
+		}
+	}
+}
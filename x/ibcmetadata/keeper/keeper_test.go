@@ -0,0 +1,210 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/testutil/assertions"
+	"github.com/provenance-io/provenance/x/ibcmetadata/types"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app *app.App
+	ctx sdk.Context
+
+	owner   sdk.AccAddress
+	other   sdk.AccAddress
+	scopeID metadatatypes.MetadataAddress
+	escrow  sdk.AccAddress
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.app = app.Setup(s.T())
+	s.ctx = s.app.BaseApp.NewContextLegacy(false, cmtproto.Header{Time: time.Now()})
+
+	s.owner = sdk.AccAddress("owner_______________")
+	s.other = sdk.AccAddress("other_______________")
+	s.scopeID = metadatatypes.ScopeMetadataAddress(uuid.New())
+	s.escrow = types.GetEscrowAddress(types.PortID, "channel-0")
+
+	scope := metadatatypes.NewScope(s.scopeID, metadatatypes.ScopeSpecMetadataAddress(uuid.New()),
+		[]metadatatypes.Party{{Address: s.owner.String(), Role: metadatatypes.PartyType_PARTY_TYPE_OWNER}},
+		nil, s.owner.String(), false)
+	s.Require().NoError(s.app.MetadataKeeper.SetScope(s.ctx, *scope), "SetScope")
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// setValueOwner sets the value owner of the test scope.
+func (s *KeeperTestSuite) setValueOwner(addr sdk.AccAddress) {
+	s.Require().NoError(s.app.MetadataKeeper.SetScopeValueOwner(s.ctx, s.scopeID, addr.String()), "SetScopeValueOwner(%s)", addr)
+}
+
+// assertValueOwner asserts that the test scope's value owner is the provided address.
+func (s *KeeperTestSuite) assertValueOwner(exp sdk.AccAddress) bool {
+	act, err := s.app.MetadataKeeper.GetScopeValueOwner(s.ctx, s.scopeID)
+	if !s.Assert().NoError(err, "GetScopeValueOwner") {
+		return false
+	}
+	return s.Assert().Equal(exp.String(), act.String(), "scope value owner")
+}
+
+// newPacket creates a packet with the provided data going from channel-1 to channel-0.
+func (s *KeeperTestSuite) newPacket(data types.ScopeValueOwnerPacketData) channeltypes.Packet {
+	return channeltypes.NewPacket(data.GetBytes(), 1, types.PortID, "channel-1", types.PortID, "channel-0",
+		clienttypes.NewHeight(0, 100), 0)
+}
+
+func (s *KeeperTestSuite) TestSendScopeValueOwner() {
+	tests := []struct {
+		name   string
+		msg    *types.MsgTransferScopeValueOwnerRequest
+		expErr string
+	}{
+		{
+			name: "unknown scope",
+			msg: types.NewMsgTransferScopeValueOwnerRequest(metadatatypes.ScopeMetadataAddress(uuid.Nil).String(),
+				s.owner.String(), "receiver", types.PortID, "channel-0", clienttypes.NewHeight(0, 100), 0, ""),
+			expErr: `scope "` + metadatatypes.ScopeMetadataAddress(uuid.Nil).String() + `" not found`,
+		},
+		{
+			name: "sender is not value owner",
+			msg: types.NewMsgTransferScopeValueOwnerRequest(s.scopeID.String(), s.other.String(), "receiver",
+				types.PortID, "channel-0", clienttypes.NewHeight(0, 100), 0, ""),
+			expErr: `scope "` + s.scopeID.String() + `" value owner is "` + s.owner.String() + `", not "` +
+				s.other.String() + `": sender is not the scope value owner`,
+		},
+		{
+			name: "channel does not exist",
+			msg: types.NewMsgTransferScopeValueOwnerRequest(s.scopeID.String(), s.owner.String(), "receiver",
+				types.PortID, "channel-0", clienttypes.NewHeight(0, 100), 0, ""),
+			expErr: "module does not own channel capability for ibcmetadata/channel-0: channel capability not found",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			_, err := s.app.IBCMetadataKeeper.SendScopeValueOwner(s.ctx, tc.msg)
+			assertions.AssertErrorValue(s.T(), err, tc.expErr, "SendScopeValueOwner")
+			s.assertValueOwner(s.owner)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestOnRecvPacket() {
+	data := types.NewScopeValueOwnerPacketData(s.scopeID.String(), "remote", s.other.String(), "")
+	packet := s.newPacket(data)
+
+	s.Run("scope not escrowed", func() {
+		err := s.app.IBCMetadataKeeper.OnRecvPacket(s.ctx, packet, data)
+		assertions.AssertErrorValue(s.T(), err, `scope "`+s.scopeID.String()+
+			`", ibcmetadata/channel-0: scope value owner is not escrowed on this channel`, "OnRecvPacket")
+		s.assertValueOwner(s.owner)
+	})
+
+	s.Run("invalid receiver", func() {
+		badData := types.NewScopeValueOwnerPacketData(s.scopeID.String(), "remote", "notabech32", "")
+		err := s.app.IBCMetadataKeeper.OnRecvPacket(s.ctx, s.newPacket(badData), badData)
+		assertions.AssertErrorContents(s.T(), err, []string{`invalid receiver "notabech32"`, "invalid packet data"}, "OnRecvPacket")
+	})
+
+	s.Run("escrowed on another channel", func() {
+		s.setValueOwner(types.GetEscrowAddress(types.PortID, "channel-5"))
+		escrow := types.NewScopeEscrow(s.scopeID.String(), types.PortID, "channel-5", "remote")
+		s.Require().NoError(s.app.IBCMetadataKeeper.SetScopeEscrow(s.ctx, escrow), "SetScopeEscrow")
+
+		err := s.app.IBCMetadataKeeper.OnRecvPacket(s.ctx, packet, data)
+		assertions.AssertErrorValue(s.T(), err, `scope "`+s.scopeID.String()+
+			`", ibcmetadata/channel-0: scope value owner is not escrowed on this channel`, "OnRecvPacket")
+	})
+
+	s.Run("escrowed on this channel", func() {
+		s.setValueOwner(s.escrow)
+		escrow := types.NewScopeEscrow(s.scopeID.String(), types.PortID, "channel-0", "remote")
+		s.Require().NoError(s.app.IBCMetadataKeeper.SetScopeEscrow(s.ctx, escrow), "SetScopeEscrow")
+
+		err := s.app.IBCMetadataKeeper.OnRecvPacket(s.ctx, packet, data)
+		s.Require().NoError(err, "OnRecvPacket")
+		s.assertValueOwner(s.other)
+		_, found := s.app.IBCMetadataKeeper.GetScopeEscrow(s.ctx, s.scopeID)
+		s.Assert().False(found, "GetScopeEscrow found after receive")
+	})
+}
+
+func (s *KeeperTestSuite) TestOnAcknowledgementPacket() {
+	data := types.NewScopeValueOwnerPacketData(s.scopeID.String(), s.owner.String(), "remote", "")
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, types.PortID, "channel-0", types.PortID, "channel-1",
+		clienttypes.NewHeight(0, 100), 0)
+
+	s.Run("error ack", func() {
+		s.setValueOwner(s.escrow)
+		ack := channeltypes.NewErrorAcknowledgement(types.ErrInvalidPacketData)
+		err := s.app.IBCMetadataKeeper.OnAcknowledgementPacket(s.ctx, packet, data, ack)
+		s.Require().NoError(err, "OnAcknowledgementPacket")
+		s.assertValueOwner(s.owner)
+		_, found := s.app.IBCMetadataKeeper.GetScopeEscrow(s.ctx, s.scopeID)
+		s.Assert().False(found, "GetScopeEscrow found after error ack")
+	})
+
+	s.Run("error ack, not escrowed", func() {
+		s.setValueOwner(s.other)
+		ack := channeltypes.NewErrorAcknowledgement(types.ErrInvalidPacketData)
+		err := s.app.IBCMetadataKeeper.OnAcknowledgementPacket(s.ctx, packet, data, ack)
+		assertions.AssertErrorValue(s.T(), err, `scope "`+s.scopeID.String()+`" value owner is "`+s.other.String()+
+			`", expected "`+s.escrow.String()+`": scope value owner is not escrowed on this channel`, "OnAcknowledgementPacket")
+		s.assertValueOwner(s.other)
+	})
+
+	s.Run("success ack", func() {
+		s.setValueOwner(s.escrow)
+		ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+		err := s.app.IBCMetadataKeeper.OnAcknowledgementPacket(s.ctx, packet, data, ack)
+		s.Require().NoError(err, "OnAcknowledgementPacket")
+		s.assertValueOwner(s.escrow)
+		escrow, found := s.app.IBCMetadataKeeper.GetScopeEscrow(s.ctx, s.scopeID)
+		if s.Assert().True(found, "GetScopeEscrow found after success ack") {
+			s.Assert().Equal(types.NewScopeEscrow(s.scopeID.String(), types.PortID, "channel-0", "remote"), escrow, "escrow")
+		}
+	})
+}
+
+func (s *KeeperTestSuite) TestOnTimeoutPacket() {
+	data := types.NewScopeValueOwnerPacketData(s.scopeID.String(), s.owner.String(), "remote", "")
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, types.PortID, "channel-0", types.PortID, "channel-1",
+		clienttypes.NewHeight(0, 100), 0)
+
+	s.setValueOwner(s.escrow)
+	err := s.app.IBCMetadataKeeper.OnTimeoutPacket(s.ctx, packet, data)
+	s.Require().NoError(err, "OnTimeoutPacket")
+	s.assertValueOwner(s.owner)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	escrow := types.NewScopeEscrow(s.scopeID.String(), types.PortID, "channel-3", "remote")
+	s.Require().NoError(s.app.IBCMetadataKeeper.SetScopeEscrow(s.ctx, escrow), "SetScopeEscrow")
+
+	genState := s.app.IBCMetadataKeeper.ExportGenesis(s.ctx)
+	s.Assert().Equal(types.PortID, genState.PortId, "exported port id")
+	s.Assert().Equal([]types.ScopeEscrow{escrow}, genState.Escrows, "exported escrows")
+
+	s.app.IBCMetadataKeeper.DeleteScopeEscrow(s.ctx, s.scopeID)
+	s.Require().NotPanics(func() {
+		s.app.IBCMetadataKeeper.InitGenesis(s.ctx, genState)
+	}, "InitGenesis")
+	s.Assert().Equal([]types.ScopeEscrow{escrow}, s.app.IBCMetadataKeeper.GetAllScopeEscrows(s.ctx), "escrows after InitGenesis")
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/ibcmetadata/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the ibcmetadata MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// TransferScopeValueOwner sends the value owner role of a scope to an address on a counterparty chain.
+func (s msgServer) TransferScopeValueOwner(goCtx context.Context, msg *types.MsgTransferScopeValueOwnerRequest) (*types.MsgTransferScopeValueOwnerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	seq, err := s.SendScopeValueOwner(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTransferScopeValueOwnerResponse{Sequence: seq}, nil
+}
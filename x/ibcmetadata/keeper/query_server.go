@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/provenance-io/provenance/x/ibcmetadata/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// ScopeEscrow returns the escrow info of a scope whose value owner is controlled from a counterparty chain.
+func (k Keeper) ScopeEscrow(goCtx context.Context, req *types.QueryScopeEscrowRequest) (*types.QueryScopeEscrowResponse, error) {
+	if req == nil || len(req.ScopeId) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	scopeID, err := types.ParseScopeID(req.ScopeId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	escrow, found := k.GetScopeEscrow(ctx, scopeID)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "scope %q is not escrowed", req.ScopeId)
+	}
+	return &types.QueryScopeEscrowResponse{Escrow: escrow}, nil
+}
+
+// ScopeEscrows returns all scopes whose value owner is controlled from a counterparty chain.
+func (k Keeper) ScopeEscrows(goCtx context.Context, req *types.QueryScopeEscrowsRequest) (*types.QueryScopeEscrowsResponse, error) {
+	var pageReq *query.PageRequest
+	if req != nil {
+		pageReq = req.Pagination
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScopeEscrowKeyPrefix)
+
+	resp := &types.QueryScopeEscrowsResponse{}
+	var pageErr error
+	resp.Pagination, pageErr = query.Paginate(store, pageReq, func(_ []byte, value []byte) error {
+		var escrow types.ScopeEscrow
+		if err := k.cdc.Unmarshal(value, &escrow); err != nil {
+			return err
+		}
+		resp.Escrows = append(resp.Escrows, escrow)
+		return nil
+	})
+	if pageErr != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error iterating scope escrows: %v", pageErr)
+	}
+
+	return resp, nil
+}
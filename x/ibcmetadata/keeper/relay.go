@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	cerrs "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	"github.com/provenance-io/provenance/x/ibcmetadata/types"
+)
+
+// SendScopeValueOwner moves the value owner of a scope into the channel's escrow
+// account and sends a packet transferring the value owner role to the receiver.
+func (k Keeper) SendScopeValueOwner(ctx sdk.Context, msg *types.MsgTransferScopeValueOwnerRequest) (uint64, error) {
+	scopeID, err := types.ParseScopeID(msg.ScopeId)
+	if err != nil {
+		return 0, err
+	}
+	if _, found := k.metadataKeeper.GetScope(ctx, scopeID); !found {
+		return 0, fmt.Errorf("scope %q not found", scopeID)
+	}
+
+	valueOwner, err := k.metadataKeeper.GetScopeValueOwner(ctx, scopeID)
+	if err != nil {
+		return 0, fmt.Errorf("could not get value owner of scope %q: %w", scopeID, err)
+	}
+	if valueOwner.String() != msg.Sender {
+		return 0, types.ErrNotValueOwner.Wrapf("scope %q value owner is %q, not %q", scopeID, valueOwner.String(), msg.Sender)
+	}
+
+	chanCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(msg.SourcePort, msg.SourceChannel))
+	if !ok {
+		return 0, cerrs.Wrapf(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability for %s/%s", msg.SourcePort, msg.SourceChannel)
+	}
+
+	escrowAddr := types.GetEscrowAddress(msg.SourcePort, msg.SourceChannel)
+	if err = k.metadataKeeper.SetScopeValueOwner(ctx, scopeID, escrowAddr.String()); err != nil {
+		return 0, fmt.Errorf("could not escrow value owner of scope %q: %w", scopeID, err)
+	}
+
+	packetData := types.NewScopeValueOwnerPacketData(scopeID.String(), msg.Sender, msg.Receiver, msg.Memo)
+	sequence, err := k.ics4Wrapper.SendPacket(ctx, chanCap, msg.SourcePort, msg.SourceChannel, msg.TimeoutHeight, msg.TimeoutTimestamp, packetData.GetBytes())
+	if err != nil {
+		return 0, err
+	}
+
+	k.emitEvent(ctx, types.NewEventScopeValueOwnerSent(packetData, msg.SourceChannel, sequence))
+	return sequence, nil
+}
+
+// OnRecvPacket releases the value owner of a scope from escrow to the packet's receiver.
+// Only scopes that were previously sent through the packet's (destination) channel can be received.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.ScopeValueOwnerPacketData) error {
+	if err := data.ValidateBasic(); err != nil {
+		return types.ErrInvalidPacketData.Wrap(err.Error())
+	}
+	scopeID, _ := data.GetScopeID() // Error is checked in ValidateBasic.
+
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return types.ErrInvalidPacketData.Wrapf("invalid receiver %q: %v", data.Receiver, err)
+	}
+
+	escrow, found := k.GetScopeEscrow(ctx, scopeID)
+	if !found || escrow.PortId != packet.DestinationPort || escrow.ChannelId != packet.DestinationChannel {
+		return types.ErrScopeNotEscrowed.Wrapf("scope %q, %s/%s", scopeID, packet.DestinationPort, packet.DestinationChannel)
+	}
+
+	if err = k.releaseFromEscrow(ctx, data, packet.DestinationPort, packet.DestinationChannel, receiver.String()); err != nil {
+		return err
+	}
+	k.DeleteScopeEscrow(ctx, scopeID)
+
+	k.emitEvent(ctx, types.NewEventScopeValueOwnerReceived(data, packet.DestinationChannel, packet.Sequence))
+	return nil
+}
+
+// OnAcknowledgementPacket records the scope as escrowed if the counterparty accepted the value owner.
+// If the counterparty returned an error, the value owner is given back to the sender.
+func (k Keeper) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data types.ScopeValueOwnerPacketData,
+	ack channeltypes.Acknowledgement,
+) error {
+	switch resp := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		if err := k.releaseFromEscrow(ctx, data, packet.SourcePort, packet.SourceChannel, data.Sender); err != nil {
+			return err
+		}
+		k.emitEvent(ctx, types.NewEventScopeValueOwnerAcknowledged(data.ScopeId, packet.SourceChannel, packet.Sequence, resp.Error))
+	default:
+		escrow := types.NewScopeEscrow(data.ScopeId, packet.SourcePort, packet.SourceChannel, data.Receiver)
+		if err := k.SetScopeEscrow(ctx, escrow); err != nil {
+			return err
+		}
+		k.emitEvent(ctx, types.NewEventScopeValueOwnerAcknowledged(data.ScopeId, packet.SourceChannel, packet.Sequence, ""))
+	}
+	return nil
+}
+
+// OnTimeoutPacket gives the value owner back to the sender.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data types.ScopeValueOwnerPacketData) error {
+	if err := k.releaseFromEscrow(ctx, data, packet.SourcePort, packet.SourceChannel, data.Sender); err != nil {
+		return err
+	}
+	k.emitEvent(ctx, types.NewEventScopeValueOwnerTimeout(data.ScopeId, packet.SourceChannel, packet.Sequence))
+	return nil
+}
+
+// releaseFromEscrow sets the value owner of the packet's scope to the provided address.
+// The scope's current value owner must be the escrow address of the port and channel.
+func (k Keeper) releaseFromEscrow(ctx sdk.Context, data types.ScopeValueOwnerPacketData, portID, channelID, newValueOwner string) error {
+	scopeID, err := data.GetScopeID()
+	if err != nil {
+		return types.ErrInvalidPacketData.Wrap(err.Error())
+	}
+
+	valueOwner, err := k.metadataKeeper.GetScopeValueOwner(ctx, scopeID)
+	if err != nil {
+		return fmt.Errorf("could not get value owner of scope %q: %w", scopeID, err)
+	}
+	escrowAddr := types.GetEscrowAddress(portID, channelID)
+	if !escrowAddr.Equals(valueOwner) {
+		return types.ErrScopeNotEscrowed.Wrapf("scope %q value owner is %q, expected %q", scopeID, valueOwner.String(), escrowAddr.String())
+	}
+
+	if err = k.metadataKeeper.SetScopeValueOwner(ctx, scopeID, newValueOwner); err != nil {
+		return fmt.Errorf("could not release value owner of scope %q: %w", scopeID, err)
+	}
+	return nil
+}
+
+// emitEvent emits the provided event and writes any error to the error log.
+func (k Keeper) emitEvent(ctx sdk.Context, event proto.Message) {
+	err := ctx.EventManager().EmitTypedEvent(event)
+	if err != nil {
+		k.Logger(ctx).Error("error emitting event", "event", event, "error", err)
+	}
+}
@@ -0,0 +1,18 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// ModuleCdc is the codec used for the packet data and acknowledgements.
+var ModuleCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
+
+// RegisterInterfaces registers concrete implementations for this module.
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	messages := make([]proto.Message, len(AllRequestMsgs))
+	copy(messages, AllRequestMsgs)
+	registry.RegisterImplementations((*sdk.Msg)(nil), messages...)
+}
@@ -0,0 +1,12 @@
+package types
+
+import (
+	cerrs "cosmossdk.io/errors"
+)
+
+var (
+	ErrInvalidVersion    = cerrs.Register(ModuleName, 2, "invalid version")
+	ErrInvalidPacketData = cerrs.Register(ModuleName, 3, "invalid packet data")
+	ErrNotValueOwner     = cerrs.Register(ModuleName, 4, "sender is not the scope value owner")
+	ErrScopeNotEscrowed  = cerrs.Register(ModuleName, 5, "scope value owner is not escrowed on this channel")
+)
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"strconv"
+)
+
+// NewEventScopeValueOwnerSent creates a new EventScopeValueOwnerSent.
+func NewEventScopeValueOwnerSent(data ScopeValueOwnerPacketData, channel string, sequence uint64) *EventScopeValueOwnerSent {
+	return &EventScopeValueOwnerSent{
+		ScopeId:  data.ScopeId,
+		Sender:   data.Sender,
+		Receiver: data.Receiver,
+		Channel:  channel,
+		Sequence: strconv.FormatUint(sequence, 10),
+	}
+}
+
+// NewEventScopeValueOwnerAcknowledged creates a new EventScopeValueOwnerAcknowledged.
+func NewEventScopeValueOwnerAcknowledged(scopeID, channel string, sequence uint64, ackErr string) *EventScopeValueOwnerAcknowledged {
+	return &EventScopeValueOwnerAcknowledged{
+		ScopeId:  scopeID,
+		Channel:  channel,
+		Sequence: strconv.FormatUint(sequence, 10),
+		Success:  len(ackErr) == 0,
+		Error:    ackErr,
+	}
+}
+
+// NewEventScopeValueOwnerTimeout creates a new EventScopeValueOwnerTimeout.
+func NewEventScopeValueOwnerTimeout(scopeID, channel string, sequence uint64) *EventScopeValueOwnerTimeout {
+	return &EventScopeValueOwnerTimeout{
+		ScopeId:  scopeID,
+		Channel:  channel,
+		Sequence: strconv.FormatUint(sequence, 10),
+	}
+}
+
+// NewEventScopeValueOwnerReceived creates a new EventScopeValueOwnerReceived.
+func NewEventScopeValueOwnerReceived(data ScopeValueOwnerPacketData, channel string, sequence uint64) *EventScopeValueOwnerReceived {
+	return &EventScopeValueOwnerReceived{
+		ScopeId:  data.ScopeId,
+		Sender:   data.Sender,
+		Receiver: data.Receiver,
+		Channel:  channel,
+		Sequence: strconv.FormatUint(sequence, 10),
+	}
+}
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	cerrs "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// NewGenesisState creates a new GenesisState.
+func NewGenesisState(port string, escrows []ScopeEscrow) *GenesisState {
+	return &GenesisState{
+		PortId:  port,
+		Escrows: escrows,
+	}
+}
+
+// DefaultGenesis returns the default ibcmetadata genesis state
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState(PortID, nil)
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := host.PortIdentifierValidator(gs.PortId); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(gs.Escrows))
+	for i, escrow := range gs.Escrows {
+		if err := escrow.Validate(); err != nil {
+			return cerrs.Wrapf(err, "invalid escrows[%d]", i)
+		}
+		if seen[escrow.ScopeId] {
+			return fmt.Errorf("invalid escrows[%d]: duplicate scope id %q", i, escrow.ScopeId)
+		}
+		seen[escrow.ScopeId] = true
+	}
+
+	return nil
+}
+
+// NewScopeEscrow creates a new ScopeEscrow for a scope sent through the provided port and channel.
+func NewScopeEscrow(scopeID, portID, channelID, remoteOwner string) ScopeEscrow {
+	return ScopeEscrow{
+		ScopeId:       scopeID,
+		PortId:        portID,
+		ChannelId:     channelID,
+		RemoteOwner:   remoteOwner,
+		EscrowAddress: GetEscrowAddress(portID, channelID).String(),
+	}
+}
+
+// Validate returns an error if this ScopeEscrow is not valid.
+func (e ScopeEscrow) Validate() error {
+	if _, err := ParseScopeID(e.ScopeId); err != nil {
+		return err
+	}
+	if err := host.PortIdentifierValidator(e.PortId); err != nil {
+		return cerrs.Wrap(err, "invalid port id")
+	}
+	if err := host.ChannelIdentifierValidator(e.ChannelId); err != nil {
+		return cerrs.Wrap(err, "invalid channel id")
+	}
+	if len(e.RemoteOwner) == 0 {
+		return errors.New("remote owner cannot be empty")
+	}
+	escrowAddr, err := sdk.AccAddressFromBech32(e.EscrowAddress)
+	if err != nil {
+		return fmt.Errorf("invalid escrow address %q: %w", e.EscrowAddress, err)
+	}
+	if !escrowAddr.Equals(GetEscrowAddress(e.PortId, e.ChannelId)) {
+		return fmt.Errorf("escrow address %q is not correct for %s/%s", e.EscrowAddress, e.PortId, e.ChannelId)
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "ibcmetadata"
+
+	// StoreKey is string representation of the store key for ibcmetadata
+	StoreKey = "scopes-for-ibc" // not using the module name because of collisions with key "ibc"
+
+	// Version defines the current version the IBC module supports
+	Version = "ibcmetadata-1"
+
+	// PortID is the default port id that module binds to
+	PortID = "ibcmetadata"
+)
+
+// The ibcmetadata module's KVStore categorizes each item in the store using a single byte prefix.
+//
+//	PortStoreKey
+//	- 0x01: string
+//	  | 1 |
+//
+//	ScopeEscrowKeyPrefix
+//	- 0x02<scope id>: ScopeEscrow
+//	  | 1 |    17    |
+var (
+	// PortStoreKey defines the key to store the port ID in store
+	PortStoreKey = []byte{0x01}
+	// ScopeEscrowKeyPrefix is the prefix of the keys for the scope escrow entries.
+	ScopeEscrowKeyPrefix = []byte{0x02}
+)
+
+// GetPortStoreKey is a function to get the key for the port in store
+func GetPortStoreKey() []byte {
+	return PortStoreKey
+}
+
+// GetScopeEscrowKey returns the store key for a scope's escrow entry.
+func GetScopeEscrowKey(scopeID metadatatypes.MetadataAddress) []byte {
+	rv := make([]byte, 0, len(ScopeEscrowKeyPrefix)+len(scopeID))
+	rv = append(rv, ScopeEscrowKeyPrefix...)
+	rv = append(rv, scopeID...)
+	return rv
+}
+
+// GetEscrowAddress returns the address that holds the value owner role of the scopes
+// that have been sent through the given port and channel.
+func GetEscrowAddress(portID, channelID string) sdk.AccAddress {
+	// a slash is used to create domain separation between port and channel identifiers to
+	// prevent address collisions between escrow addresses created for different channels
+	contents := fmt.Sprintf("%s/%s", portID, channelID)
+
+	// ADR 028 AddressHash construction
+	preImage := []byte(Version)
+	preImage = append(preImage, 0)
+	preImage = append(preImage, contents...)
+	hash := sha256.Sum256(preImage)
+	return hash[:20]
+}
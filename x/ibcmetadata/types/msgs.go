@@ -0,0 +1,59 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	cerrs "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// AllRequestMsgs defines all the Msg*Request messages.
+var AllRequestMsgs = []sdk.Msg{
+	(*MsgTransferScopeValueOwnerRequest)(nil),
+}
+
+// NewMsgTransferScopeValueOwnerRequest creates a new MsgTransferScopeValueOwnerRequest.
+func NewMsgTransferScopeValueOwnerRequest(
+	scopeID, sender, receiver, sourcePort, sourceChannel string,
+	timeoutHeight clienttypes.Height, timeoutTimestamp uint64, memo string,
+) *MsgTransferScopeValueOwnerRequest {
+	return &MsgTransferScopeValueOwnerRequest{
+		ScopeId:          scopeID,
+		Sender:           sender,
+		Receiver:         receiver,
+		SourcePort:       sourcePort,
+		SourceChannel:    sourceChannel,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+		Memo:             memo,
+	}
+}
+
+// ValidateBasic runs stateless validation checks on the message.
+func (m MsgTransferScopeValueOwnerRequest) ValidateBasic() error {
+	var errs []error
+	if _, err := ParseScopeID(m.ScopeId); err != nil {
+		errs = append(errs, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		errs = append(errs, fmt.Errorf("invalid sender %q: %w", m.Sender, err))
+	}
+	if len(strings.TrimSpace(m.Receiver)) == 0 {
+		errs = append(errs, errors.New("receiver cannot be empty"))
+	}
+	if err := host.PortIdentifierValidator(m.SourcePort); err != nil {
+		errs = append(errs, cerrs.Wrap(err, "invalid source port"))
+	}
+	if err := host.ChannelIdentifierValidator(m.SourceChannel); err != nil {
+		errs = append(errs, cerrs.Wrap(err, "invalid source channel"))
+	}
+	if m.TimeoutHeight.IsZero() && m.TimeoutTimestamp == 0 {
+		errs = append(errs, errors.New("timeout height and timeout timestamp cannot both be zero"))
+	}
+	return errors.Join(errs...)
+}
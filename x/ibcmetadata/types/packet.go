@@ -0,0 +1,63 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// NewScopeValueOwnerPacketData creates a new ScopeValueOwnerPacketData.
+func NewScopeValueOwnerPacketData(scopeID, sender, receiver, memo string) ScopeValueOwnerPacketData {
+	return ScopeValueOwnerPacketData{
+		ScopeId:  scopeID,
+		Sender:   sender,
+		Receiver: receiver,
+		Memo:     memo,
+	}
+}
+
+// ValidateBasic runs stateless validation checks on the packet data.
+// The sender and receiver aren't checked for a bech32 format since one
+// of them is an address on the counterparty chain.
+func (p ScopeValueOwnerPacketData) ValidateBasic() error {
+	var errs []error
+	if _, err := ParseScopeID(p.ScopeId); err != nil {
+		errs = append(errs, err)
+	}
+	if len(strings.TrimSpace(p.Sender)) == 0 {
+		errs = append(errs, errors.New("sender cannot be empty"))
+	}
+	if len(strings.TrimSpace(p.Receiver)) == 0 {
+		errs = append(errs, errors.New("receiver cannot be empty"))
+	}
+	return errors.Join(errs...)
+}
+
+// GetBytes returns the sorted JSON encoding of the packet data.
+func (p ScopeValueOwnerPacketData) GetBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&p))
+}
+
+// GetScopeID returns the parsed scope id of this packet data.
+func (p ScopeValueOwnerPacketData) GetScopeID() (metadatatypes.MetadataAddress, error) {
+	return ParseScopeID(p.ScopeId)
+}
+
+// ParseScopeID converts the provided bech32 string into a scope's MetadataAddress.
+func ParseScopeID(scopeID string) (metadatatypes.MetadataAddress, error) {
+	if len(scopeID) == 0 {
+		return nil, errors.New("scope id cannot be empty")
+	}
+	id, err := metadatatypes.MetadataAddressFromBech32(scopeID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid scope id %q: %w", scopeID, err)
+	}
+	if err = id.ValidateIsScopeAddress(); err != nil {
+		return nil, fmt.Errorf("invalid scope id %q: %w", scopeID, err)
+	}
+	return id, nil
+}
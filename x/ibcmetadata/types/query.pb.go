@@ -0,0 +1,1068 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/ibcmetadata/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryScopeEscrowRequest is the request type for the Query/ScopeEscrow RPC method.
+type QueryScopeEscrowRequest struct {
+	// scope_id is the bech32 address of the scope, e.g. scope1...
+	ScopeId string `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+}
+
+func (m *QueryScopeEscrowRequest) Reset()         { *m = QueryScopeEscrowRequest{} }
+func (m *QueryScopeEscrowRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScopeEscrowRequest) ProtoMessage()    {}
+func (*QueryScopeEscrowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c2353a1471c62df, []int{0}
+}
+func (m *QueryScopeEscrowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScopeEscrowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScopeEscrowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScopeEscrowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScopeEscrowRequest.Merge(m, src)
+}
+func (m *QueryScopeEscrowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScopeEscrowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScopeEscrowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScopeEscrowRequest proto.InternalMessageInfo
+
+func (m *QueryScopeEscrowRequest) GetScopeId() string {
+	if m != nil {
+		return m.ScopeId
+	}
+	return ""
+}
+
+// QueryScopeEscrowResponse is the response type for the Query/ScopeEscrow RPC method.
+type QueryScopeEscrowResponse struct {
+	// escrow is the escrow info of the scope.
+	Escrow ScopeEscrow `protobuf:"bytes,1,opt,name=escrow,proto3" json:"escrow"`
+}
+
+func (m *QueryScopeEscrowResponse) Reset()         { *m = QueryScopeEscrowResponse{} }
+func (m *QueryScopeEscrowResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScopeEscrowResponse) ProtoMessage()    {}
+func (*QueryScopeEscrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c2353a1471c62df, []int{1}
+}
+func (m *QueryScopeEscrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScopeEscrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScopeEscrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScopeEscrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScopeEscrowResponse.Merge(m, src)
+}
+func (m *QueryScopeEscrowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScopeEscrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScopeEscrowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScopeEscrowResponse proto.InternalMessageInfo
+
+func (m *QueryScopeEscrowResponse) GetEscrow() ScopeEscrow {
+	if m != nil {
+		return m.Escrow
+	}
+	return ScopeEscrow{}
+}
+
+// QueryScopeEscrowsRequest is the request type for the Query/ScopeEscrows RPC method.
+type QueryScopeEscrowsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScopeEscrowsRequest) Reset()         { *m = QueryScopeEscrowsRequest{} }
+func (m *QueryScopeEscrowsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScopeEscrowsRequest) ProtoMessage()    {}
+func (*QueryScopeEscrowsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c2353a1471c62df, []int{2}
+}
+func (m *QueryScopeEscrowsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScopeEscrowsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScopeEscrowsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScopeEscrowsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScopeEscrowsRequest.Merge(m, src)
+}
+func (m *QueryScopeEscrowsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScopeEscrowsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScopeEscrowsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScopeEscrowsRequest proto.InternalMessageInfo
+
+func (m *QueryScopeEscrowsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryScopeEscrowsResponse is the response type for the Query/ScopeEscrows RPC method.
+type QueryScopeEscrowsResponse struct {
+	// escrows are the scopes with a value owner that's controlled from a counterparty chain.
+	Escrows []ScopeEscrow `protobuf:"bytes,1,rep,name=escrows,proto3" json:"escrows"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScopeEscrowsResponse) Reset()         { *m = QueryScopeEscrowsResponse{} }
+func (m *QueryScopeEscrowsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScopeEscrowsResponse) ProtoMessage()    {}
+func (*QueryScopeEscrowsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c2353a1471c62df, []int{3}
+}
+func (m *QueryScopeEscrowsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScopeEscrowsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScopeEscrowsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScopeEscrowsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScopeEscrowsResponse.Merge(m, src)
+}
+func (m *QueryScopeEscrowsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScopeEscrowsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScopeEscrowsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScopeEscrowsResponse proto.InternalMessageInfo
+
+func (m *QueryScopeEscrowsResponse) GetEscrows() []ScopeEscrow {
+	if m != nil {
+		return m.Escrows
+	}
+	return nil
+}
+
+func (m *QueryScopeEscrowsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryScopeEscrowRequest)(nil), "provenance.ibcmetadata.v1.QueryScopeEscrowRequest")
+	proto.RegisterType((*QueryScopeEscrowResponse)(nil), "provenance.ibcmetadata.v1.QueryScopeEscrowResponse")
+	proto.RegisterType((*QueryScopeEscrowsRequest)(nil), "provenance.ibcmetadata.v1.QueryScopeEscrowsRequest")
+	proto.RegisterType((*QueryScopeEscrowsResponse)(nil), "provenance.ibcmetadata.v1.QueryScopeEscrowsResponse")
+}
+
+func init() {
+	proto.RegisterFile("provenance/ibcmetadata/v1/query.proto", fileDescriptor_4c2353a1471c62df)
+}
+
+var fileDescriptor_4c2353a1471c62df = []byte{
+	// 447 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcf, 0x6e, 0xd4, 0x30,
+	0x10, 0xc6, 0xe3, 0x02, 0x2d, 0xb8, 0x9c, 0x2c, 0x24, 0xb2, 0x51, 0x15, 0xaa, 0x88, 0x7f, 0x2a,
+	0xaa, 0xad, 0x6c, 0x8b, 0xb8, 0x57, 0x50, 0xc4, 0xad, 0x84, 0x1b, 0x17, 0x70, 0xb2, 0x56, 0xb0,
+	0xc4, 0x66, 0xb2, 0xb1, 0x37, 0x50, 0x21, 0x2e, 0x3c, 0x01, 0x12, 0xcf, 0xc0, 0x0d, 0xde, 0xa3,
+	0xc7, 0x4a, 0x5c, 0x38, 0x01, 0xda, 0xe5, 0x41, 0x50, 0x1c, 0x47, 0x35, 0x6a, 0xd3, 0x2a, 0x37,
+	0x67, 0x32, 0x5f, 0xbe, 0xdf, 0x7c, 0xe3, 0xe0, 0x3b, 0x65, 0x05, 0xb5, 0x28, 0x78, 0x91, 0x09,
+	0x26, 0xd3, 0x6c, 0x2a, 0x34, 0x9f, 0x70, 0xcd, 0x59, 0x1d, 0xb3, 0xd9, 0x5c, 0x54, 0x87, 0xb4,
+	0xac, 0x40, 0x03, 0x19, 0x9d, 0xb4, 0x51, 0xa7, 0x8d, 0xd6, 0x71, 0xb0, 0x95, 0x81, 0x9a, 0x82,
+	0x62, 0x29, 0x57, 0xa2, 0xd5, 0xb0, 0x3a, 0x4e, 0x85, 0xe6, 0x31, 0x2b, 0x79, 0x2e, 0x0b, 0xae,
+	0x25, 0x14, 0xed, 0x67, 0x82, 0x1b, 0x39, 0xe4, 0x60, 0x8e, 0xac, 0x39, 0xd9, 0xea, 0x46, 0x0e,
+	0x90, 0xbf, 0x15, 0x8c, 0x97, 0x92, 0xf1, 0xa2, 0x00, 0x6d, 0x24, 0xca, 0xbe, 0x7d, 0xd0, 0x4f,
+	0xe8, 0x92, 0x98, 0xe6, 0x68, 0x17, 0xdf, 0x7c, 0xde, 0x20, 0xbc, 0xc8, 0xa0, 0x14, 0x4f, 0x54,
+	0x56, 0xc1, 0xbb, 0x44, 0xcc, 0xe6, 0x42, 0x69, 0x32, 0xc2, 0x57, 0x55, 0x53, 0x7d, 0x25, 0x27,
+	0x3e, 0xda, 0x44, 0xf7, 0xaf, 0x25, 0x6b, 0xe6, 0xf9, 0xd9, 0x24, 0x7a, 0x8d, 0xfd, 0xd3, 0x2a,
+	0x55, 0x42, 0xa1, 0x04, 0x79, 0x8c, 0x57, 0x85, 0xa9, 0x18, 0xd1, 0xfa, 0xf8, 0x2e, 0xed, 0x8d,
+	0x82, 0x3a, 0xfa, 0xbd, 0xcb, 0x47, 0xbf, 0x6e, 0x79, 0x89, 0xd5, 0x46, 0xe9, 0x69, 0x07, 0xd5,
+	0x81, 0xed, 0x63, 0x7c, 0x12, 0x94, 0x9f, 0x59, 0x97, 0x36, 0x55, 0xda, 0xa4, 0x4a, 0xdb, 0x4d,
+	0xd8, 0x54, 0xe9, 0x01, 0xcf, 0x85, 0xd5, 0x26, 0x8e, 0x32, 0xfa, 0x86, 0xf0, 0xe8, 0x0c, 0x13,
+	0x3b, 0xc7, 0x3e, 0x5e, 0x6b, 0x59, 0x94, 0x8f, 0x36, 0x2f, 0x0d, 0x1e, 0xa4, 0x13, 0x93, 0xa7,
+	0x67, 0xd0, 0xde, 0xbb, 0x90, 0xb6, 0x85, 0x70, 0x71, 0xc7, 0xbf, 0x57, 0xf0, 0x15, 0x83, 0x4b,
+	0xbe, 0x23, 0xbc, 0xee, 0x38, 0x92, 0xf1, 0x39, 0x64, 0x3d, 0xdb, 0x0d, 0x76, 0x06, 0x69, 0x5a,
+	0x9c, 0xe8, 0xe1, 0xa7, 0x1f, 0x7f, 0xbf, 0xac, 0x30, 0xb2, 0xcd, 0xfa, 0xef, 0x98, 0x9d, 0x9b,
+	0x7d, 0xe8, 0x2e, 0xcf, 0x47, 0xf2, 0x15, 0xe1, 0xeb, 0x6e, 0xc6, 0x64, 0x88, 0x79, 0xb7, 0xf6,
+	0x60, 0x77, 0x98, 0xc8, 0x22, 0x6f, 0x19, 0xe4, 0xdb, 0x24, 0xba, 0x18, 0x79, 0x6f, 0x76, 0xb4,
+	0x08, 0xd1, 0xf1, 0x22, 0x44, 0x7f, 0x16, 0x21, 0xfa, 0xbc, 0x0c, 0xbd, 0xe3, 0x65, 0xe8, 0xfd,
+	0x5c, 0x86, 0x1e, 0xde, 0x90, 0xd0, 0xef, 0x7e, 0x80, 0x5e, 0x3e, 0xca, 0xa5, 0x7e, 0x33, 0x4f,
+	0x69, 0x06, 0x53, 0xc7, 0x67, 0x5b, 0x82, 0xeb, 0xfa, 0xfe, 0x3f, 0x5f, 0x7d, 0x58, 0x0a, 0x95,
+	0xae, 0x9a, 0xdf, 0x70, 0xe7, 0xdf, 0x00, 0xd6, 0x78, 0x74, 0xec, 0x57, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// ScopeEscrow returns the escrow info of a scope whose value owner is controlled from a counterparty chain.
+	ScopeEscrow(ctx context.Context, in *QueryScopeEscrowRequest, opts ...grpc.CallOption) (*QueryScopeEscrowResponse, error)
+	// ScopeEscrows returns all scopes whose value owner is controlled from a counterparty chain.
+	ScopeEscrows(ctx context.Context, in *QueryScopeEscrowsRequest, opts ...grpc.CallOption) (*QueryScopeEscrowsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) ScopeEscrow(ctx context.Context, in *QueryScopeEscrowRequest, opts ...grpc.CallOption) (*QueryScopeEscrowResponse, error) {
+	out := new(QueryScopeEscrowResponse)
+	err := c.cc.Invoke(ctx, "/provenance.ibcmetadata.v1.Query/ScopeEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScopeEscrows(ctx context.Context, in *QueryScopeEscrowsRequest, opts ...grpc.CallOption) (*QueryScopeEscrowsResponse, error) {
+	out := new(QueryScopeEscrowsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.ibcmetadata.v1.Query/ScopeEscrows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// ScopeEscrow returns the escrow info of a scope whose value owner is controlled from a counterparty chain.
+	ScopeEscrow(context.Context, *QueryScopeEscrowRequest) (*QueryScopeEscrowResponse, error)
+	// ScopeEscrows returns all scopes whose value owner is controlled from a counterparty chain.
+	ScopeEscrows(context.Context, *QueryScopeEscrowsRequest) (*QueryScopeEscrowsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) ScopeEscrow(ctx context.Context, req *QueryScopeEscrowRequest) (*QueryScopeEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScopeEscrow not implemented")
+}
+func (*UnimplementedQueryServer) ScopeEscrows(ctx context.Context, req *QueryScopeEscrowsRequest) (*QueryScopeEscrowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScopeEscrows not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_ScopeEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScopeEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScopeEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.ibcmetadata.v1.Query/ScopeEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScopeEscrow(ctx, req.(*QueryScopeEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScopeEscrows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScopeEscrowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScopeEscrows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.ibcmetadata.v1.Query/ScopeEscrows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScopeEscrows(ctx, req.(*QueryScopeEscrowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.ibcmetadata.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ScopeEscrow",
+			Handler:    _Query_ScopeEscrow_Handler,
+		},
+		{
+			MethodName: "ScopeEscrows",
+			Handler:    _Query_ScopeEscrows_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provenance/ibcmetadata/v1/query.proto",
+}
+
+func (m *QueryScopeEscrowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScopeEscrowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScopeEscrowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScopeEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScopeEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScopeEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Escrow.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScopeEscrowsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScopeEscrowsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScopeEscrowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScopeEscrowsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScopeEscrowsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScopeEscrowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Escrows) > 0 {
+		for iNdEx := len(m.Escrows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryScopeEscrowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScopeEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Escrow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryScopeEscrowsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScopeEscrowsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Escrows) > 0 {
+		for _, e := range m.Escrows {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryScopeEscrowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScopeEscrowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScopeEscrowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScopeEscrowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScopeEscrowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScopeEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Escrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScopeEscrowsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScopeEscrowsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScopeEscrowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScopeEscrowsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScopeEscrowsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScopeEscrowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Escrows = append(m.Escrows, ScopeEscrow{})
+			if err := m.Escrows[len(m.Escrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
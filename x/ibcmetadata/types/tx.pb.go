@@ -0,0 +1,938 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/ibcmetadata/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgTransferScopeValueOwnerRequest is the request type for the Msg/TransferScopeValueOwner endpoint.
+type MsgTransferScopeValueOwnerRequest struct {
+	// scope_id is the bech32 address of the scope, e.g. scope1...
+	ScopeId string `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+	// sender is the current value owner of the scope.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// receiver is the address on the counterparty chain that will become the value owner.
+	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// source_port is the port to send the packet through.
+	SourcePort string `protobuf:"bytes,4,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty"`
+	// source_channel is the channel to send the packet through.
+	SourceChannel string `protobuf:"bytes,5,opt,name=source_channel,json=sourceChannel,proto3" json:"source_channel,omitempty"`
+	// timeout_height is the height (on the counterparty chain) after which the transfer is no longer allowed.
+	TimeoutHeight types.Height `protobuf:"bytes,6,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height"`
+	// timeout_timestamp is the time (in unix nanoseconds) after which the transfer is no longer allowed.
+	TimeoutTimestamp uint64 `protobuf:"varint,7,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	// memo is an optional note to include with the packet.
+	Memo string `protobuf:"bytes,8,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (m *MsgTransferScopeValueOwnerRequest) Reset()         { *m = MsgTransferScopeValueOwnerRequest{} }
+func (m *MsgTransferScopeValueOwnerRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferScopeValueOwnerRequest) ProtoMessage()    {}
+func (*MsgTransferScopeValueOwnerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d23d391631a8ae7a, []int{0}
+}
+func (m *MsgTransferScopeValueOwnerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferScopeValueOwnerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferScopeValueOwnerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferScopeValueOwnerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferScopeValueOwnerRequest.Merge(m, src)
+}
+func (m *MsgTransferScopeValueOwnerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferScopeValueOwnerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferScopeValueOwnerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferScopeValueOwnerRequest proto.InternalMessageInfo
+
+func (m *MsgTransferScopeValueOwnerRequest) GetScopeId() string {
+	if m != nil {
+		return m.ScopeId
+	}
+	return ""
+}
+
+func (m *MsgTransferScopeValueOwnerRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgTransferScopeValueOwnerRequest) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *MsgTransferScopeValueOwnerRequest) GetSourcePort() string {
+	if m != nil {
+		return m.SourcePort
+	}
+	return ""
+}
+
+func (m *MsgTransferScopeValueOwnerRequest) GetSourceChannel() string {
+	if m != nil {
+		return m.SourceChannel
+	}
+	return ""
+}
+
+func (m *MsgTransferScopeValueOwnerRequest) GetTimeoutHeight() types.Height {
+	if m != nil {
+		return m.TimeoutHeight
+	}
+	return types.Height{}
+}
+
+func (m *MsgTransferScopeValueOwnerRequest) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return 0
+}
+
+func (m *MsgTransferScopeValueOwnerRequest) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+// MsgTransferScopeValueOwnerResponse is the response type for the Msg/TransferScopeValueOwner endpoint.
+type MsgTransferScopeValueOwnerResponse struct {
+	// sequence is the sequence number of the packet that was sent.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *MsgTransferScopeValueOwnerResponse) Reset()         { *m = MsgTransferScopeValueOwnerResponse{} }
+func (m *MsgTransferScopeValueOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferScopeValueOwnerResponse) ProtoMessage()    {}
+func (*MsgTransferScopeValueOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d23d391631a8ae7a, []int{1}
+}
+func (m *MsgTransferScopeValueOwnerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferScopeValueOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferScopeValueOwnerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferScopeValueOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferScopeValueOwnerResponse.Merge(m, src)
+}
+func (m *MsgTransferScopeValueOwnerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferScopeValueOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferScopeValueOwnerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferScopeValueOwnerResponse proto.InternalMessageInfo
+
+func (m *MsgTransferScopeValueOwnerResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*MsgTransferScopeValueOwnerRequest)(nil), "provenance.ibcmetadata.v1.MsgTransferScopeValueOwnerRequest")
+	proto.RegisterType((*MsgTransferScopeValueOwnerResponse)(nil), "provenance.ibcmetadata.v1.MsgTransferScopeValueOwnerResponse")
+}
+
+func init() {
+	proto.RegisterFile("provenance/ibcmetadata/v1/tx.proto", fileDescriptor_d23d391631a8ae7a)
+}
+
+var fileDescriptor_d23d391631a8ae7a = []byte{
+	// 508 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0xcf, 0xb5, 0x69, 0x1a, 0x2e, 0x6a, 0x05, 0xa7, 0x4a, 0x75, 0x2c, 0xe4, 0x84, 0x48, 0x48,
+	0x51, 0x51, 0x6d, 0x52, 0x06, 0x24, 0x04, 0x12, 0x84, 0x01, 0x18, 0x2a, 0x2a, 0xb7, 0x62, 0x60,
+	0x89, 0x9c, 0xf3, 0xc3, 0x39, 0x29, 0xbe, 0x73, 0xef, 0x2e, 0xa1, 0x6c, 0x88, 0x4f, 0xc0, 0xc4,
+	0x37, 0x60, 0xef, 0xc0, 0x87, 0xe8, 0x58, 0x31, 0x31, 0x21, 0x94, 0x0c, 0x1d, 0xf8, 0x12, 0xe8,
+	0xee, 0xdc, 0x3f, 0x0c, 0x01, 0xa9, 0x93, 0xdf, 0xef, 0xcf, 0x7b, 0x7e, 0xfe, 0xf9, 0x0e, 0x77,
+	0x0a, 0x29, 0xa6, 0xc0, 0x13, 0x4e, 0x21, 0x62, 0x43, 0x9a, 0x83, 0x4e, 0xd2, 0x44, 0x27, 0xd1,
+	0xb4, 0x17, 0xe9, 0xa3, 0xb0, 0x90, 0x42, 0x0b, 0xd2, 0xbc, 0xf4, 0x84, 0x57, 0x3c, 0xe1, 0xb4,
+	0xe7, 0x6f, 0x52, 0xa1, 0x72, 0xa1, 0xa2, 0x5c, 0x65, 0xa6, 0x25, 0x57, 0x99, 0xeb, 0xf1, 0x9b,
+	0x4e, 0x18, 0x58, 0x14, 0x39, 0x50, 0x4a, 0x1b, 0x99, 0xc8, 0x84, 0xe3, 0x4d, 0x55, 0xb2, 0x2d,
+	0x36, 0xa4, 0x11, 0x15, 0x12, 0x22, 0x3a, 0x66, 0xc0, 0xb5, 0x19, 0xe7, 0x2a, 0x67, 0xe8, 0xfc,
+	0x5e, 0xc2, 0x77, 0x76, 0x55, 0x76, 0x20, 0x13, 0xae, 0xde, 0x81, 0xdc, 0xa7, 0xa2, 0x80, 0x37,
+	0xc9, 0x78, 0x02, 0xaf, 0xdf, 0x73, 0x90, 0x31, 0x1c, 0x4e, 0x40, 0x69, 0xd2, 0xc4, 0x75, 0x65,
+	0x94, 0x01, 0x4b, 0x3d, 0xd4, 0x46, 0xdd, 0x1b, 0xf1, 0xaa, 0xc5, 0xaf, 0x52, 0x72, 0x1f, 0xd7,
+	0x14, 0xf0, 0x14, 0xa4, 0xb7, 0x64, 0x84, 0xbe, 0xf7, 0xfd, 0xdb, 0xf6, 0x46, 0xb9, 0xd9, 0xb3,
+	0x34, 0x95, 0xa0, 0xd4, 0xbe, 0x96, 0x8c, 0x67, 0x71, 0xe9, 0x23, 0x3e, 0xae, 0x4b, 0xa0, 0xc0,
+	0xa6, 0x20, 0xbd, 0x65, 0x3b, 0xec, 0x02, 0x93, 0x16, 0x6e, 0x28, 0x31, 0x91, 0x14, 0x06, 0x85,
+	0x90, 0xda, 0xab, 0x5a, 0x19, 0x3b, 0x6a, 0x4f, 0x48, 0x4d, 0xee, 0xe2, 0xf5, 0xd2, 0x40, 0x47,
+	0x09, 0xe7, 0x30, 0xf6, 0x56, 0xac, 0x67, 0xcd, 0xb1, 0xcf, 0x1d, 0x49, 0x5e, 0xe0, 0x75, 0xcd,
+	0x72, 0x10, 0x13, 0x3d, 0x18, 0x01, 0xcb, 0x46, 0xda, 0xab, 0xb5, 0x51, 0xb7, 0xb1, 0xe3, 0x9b,
+	0xa8, 0x43, 0x13, 0x48, 0x58, 0xc6, 0x30, 0xed, 0x85, 0x2f, 0xad, 0xa3, 0x5f, 0x3d, 0xf9, 0xd9,
+	0xaa, 0xc4, 0x6b, 0x65, 0x9f, 0x23, 0xc9, 0x3d, 0x7c, 0xeb, 0x7c, 0x90, 0x79, 0x2a, 0x9d, 0xe4,
+	0x85, 0xb7, 0xda, 0x46, 0xdd, 0x6a, 0x7c, 0xb3, 0x14, 0x0e, 0xce, 0x79, 0x42, 0x70, 0x35, 0x87,
+	0x5c, 0x78, 0x75, 0xbb, 0x92, 0xad, 0x1f, 0x35, 0x3e, 0x9d, 0x1d, 0x6f, 0x95, 0x9f, 0xde, 0x79,
+	0x8a, 0x3b, 0xff, 0x0a, 0x5b, 0x15, 0x82, 0x2b, 0x30, 0x01, 0x29, 0x13, 0x3c, 0xa7, 0x60, 0xd3,
+	0xae, 0xc6, 0x17, 0x78, 0xe7, 0x2b, 0xc2, 0xcb, 0xbb, 0x2a, 0x23, 0x5f, 0x10, 0xde, 0x5c, 0x30,
+	0x87, 0x3c, 0x0e, 0x17, 0x1e, 0xad, 0xf0, 0xbf, 0xff, 0xda, 0x7f, 0x72, 0xcd, 0x6e, 0xb7, 0xbc,
+	0xbf, 0xf2, 0xf1, 0xec, 0x78, 0x0b, 0xf5, 0x0f, 0x4f, 0x66, 0x01, 0x3a, 0x9d, 0x05, 0xe8, 0xd7,
+	0x2c, 0x40, 0x9f, 0xe7, 0x41, 0xe5, 0x74, 0x1e, 0x54, 0x7e, 0xcc, 0x83, 0x0a, 0xbe, 0xcd, 0xc4,
+	0xe2, 0x37, 0xec, 0xa1, 0xb7, 0x0f, 0x33, 0xa6, 0x47, 0x93, 0x61, 0x48, 0x45, 0x1e, 0x5d, 0xfa,
+	0xb6, 0x99, 0xb8, 0x82, 0xa2, 0xa3, 0xbf, 0xae, 0x95, 0xfe, 0x50, 0x80, 0x1a, 0xd6, 0xec, 0x89,
+	0x7e, 0xf0, 0x67, 0x00, 0x4f, 0xd4, 0x40, 0x77, 0x7d, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// TransferScopeValueOwner sends the value owner role of a scope to an address on a counterparty chain.
+	TransferScopeValueOwner(ctx context.Context, in *MsgTransferScopeValueOwnerRequest, opts ...grpc.CallOption) (*MsgTransferScopeValueOwnerResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) TransferScopeValueOwner(ctx context.Context, in *MsgTransferScopeValueOwnerRequest, opts ...grpc.CallOption) (*MsgTransferScopeValueOwnerResponse, error) {
+	out := new(MsgTransferScopeValueOwnerResponse)
+	err := c.cc.Invoke(ctx, "/provenance.ibcmetadata.v1.Msg/TransferScopeValueOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// TransferScopeValueOwner sends the value owner role of a scope to an address on a counterparty chain.
+	TransferScopeValueOwner(context.Context, *MsgTransferScopeValueOwnerRequest) (*MsgTransferScopeValueOwnerResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) TransferScopeValueOwner(ctx context.Context, req *MsgTransferScopeValueOwnerRequest) (*MsgTransferScopeValueOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferScopeValueOwner not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_TransferScopeValueOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferScopeValueOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferScopeValueOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.ibcmetadata.v1.Msg/TransferScopeValueOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferScopeValueOwner(ctx, req.(*MsgTransferScopeValueOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.ibcmetadata.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "TransferScopeValueOwner",
+			Handler:    _Msg_TransferScopeValueOwner_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provenance/ibcmetadata/v1/tx.proto",
+}
+
+func (m *MsgTransferScopeValueOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferScopeValueOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferScopeValueOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.TimeoutHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.SourceChannel) > 0 {
+		i -= len(m.SourceChannel)
+		copy(dAtA[i:], m.SourceChannel)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceChannel)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SourcePort) > 0 {
+		i -= len(m.SourcePort)
+		copy(dAtA[i:], m.SourcePort)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourcePort)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferScopeValueOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferScopeValueOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferScopeValueOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgTransferScopeValueOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourcePort)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceChannel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TimeoutHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferScopeValueOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgTransferScopeValueOwnerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferScopeValueOwnerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferScopeValueOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourcePort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeoutHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferScopeValueOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferScopeValueOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferScopeValueOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,169 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+	"github.com/provenance-io/provenance/x/ibcmetadata/types"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+)
+
+func TestGetEscrowAddress(t *testing.T) {
+	addr0 := types.GetEscrowAddress(types.PortID, "channel-0")
+	addr1 := types.GetEscrowAddress(types.PortID, "channel-1")
+	assert.Len(t, addr0, 20, "escrow address length")
+	assert.NotEqual(t, addr0, addr1, "escrow addresses of different channels")
+	assert.Equal(t, addr0, types.GetEscrowAddress(types.PortID, "channel-0"), "escrow address of same channel")
+}
+
+func TestScopeValueOwnerPacketDataValidateBasic(t *testing.T) {
+	scopeID := metadatatypes.ScopeMetadataAddress(uuid.New()).String()
+	specID := metadatatypes.ScopeSpecMetadataAddress(uuid.New()).String()
+
+	tests := []struct {
+		name   string
+		data   types.ScopeValueOwnerPacketData
+		expErr []string
+	}{
+		{
+			name: "valid",
+			data: types.NewScopeValueOwnerPacketData(scopeID, "sender", "receiver", "memo"),
+		},
+		{
+			name:   "empty",
+			data:   types.ScopeValueOwnerPacketData{},
+			expErr: []string{"scope id cannot be empty", "sender cannot be empty", "receiver cannot be empty"},
+		},
+		{
+			name:   "not a scope id",
+			data:   types.NewScopeValueOwnerPacketData(specID, "sender", "receiver", ""),
+			expErr: []string{`invalid scope id "` + specID + `"`},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.data.ValidateBasic()
+			assertions.AssertErrorContents(t, err, tc.expErr, "ValidateBasic")
+		})
+	}
+}
+
+func TestScopeValueOwnerPacketDataGetBytes(t *testing.T) {
+	scopeID := metadatatypes.ScopeMetadataAddress(uuid.New())
+	data := types.NewScopeValueOwnerPacketData(scopeID.String(), "sender", "receiver", "memo")
+
+	var actual types.ScopeValueOwnerPacketData
+	err := types.ModuleCdc.UnmarshalJSON(data.GetBytes(), &actual)
+	if assert.NoError(t, err, "UnmarshalJSON(GetBytes())") {
+		assert.Equal(t, data, actual, "unmarshaled packet data")
+	}
+
+	id, err := actual.GetScopeID()
+	if assert.NoError(t, err, "GetScopeID") {
+		assert.Equal(t, scopeID, id, "GetScopeID")
+	}
+}
+
+func TestMsgTransferScopeValueOwnerRequestValidateBasic(t *testing.T) {
+	scopeID := metadatatypes.ScopeMetadataAddress(uuid.New()).String()
+	sender := sdk.AccAddress("sender______________").String()
+	height := clienttypes.NewHeight(0, 100)
+
+	tests := []struct {
+		name   string
+		msg    *types.MsgTransferScopeValueOwnerRequest
+		expErr []string
+	}{
+		{
+			name: "valid with timeout height",
+			msg:  types.NewMsgTransferScopeValueOwnerRequest(scopeID, sender, "receiver", types.PortID, "channel-0", height, 0, ""),
+		},
+		{
+			name: "valid with timeout timestamp",
+			msg: types.NewMsgTransferScopeValueOwnerRequest(scopeID, sender, "receiver", types.PortID, "channel-0",
+				clienttypes.ZeroHeight(), 1, "memo"),
+		},
+		{
+			name: "empty",
+			msg:  &types.MsgTransferScopeValueOwnerRequest{},
+			expErr: []string{
+				"scope id cannot be empty",
+				`invalid sender ""`,
+				"receiver cannot be empty",
+				"invalid source port",
+				"invalid source channel",
+				"timeout height and timeout timestamp cannot both be zero",
+			},
+		},
+		{
+			name:   "invalid sender",
+			msg:    types.NewMsgTransferScopeValueOwnerRequest(scopeID, "notabech32", "receiver", types.PortID, "channel-0", height, 0, ""),
+			expErr: []string{`invalid sender "notabech32"`},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			assertions.AssertErrorContents(t, err, tc.expErr, "ValidateBasic")
+		})
+	}
+}
+
+func TestGenesisStateValidate(t *testing.T) {
+	scopeID := metadatatypes.ScopeMetadataAddress(uuid.New()).String()
+	escrow := types.NewScopeEscrow(scopeID, types.PortID, "channel-0", "remote")
+	badEscrow := escrow
+	badEscrow.EscrowAddress = types.GetEscrowAddress(types.PortID, "channel-1").String()
+
+	tests := []struct {
+		name   string
+		gs     *types.GenesisState
+		expErr string
+	}{
+		{
+			name: "default",
+			gs:   types.DefaultGenesis(),
+		},
+		{
+			name: "with escrow",
+			gs:   types.NewGenesisState(types.PortID, []types.ScopeEscrow{escrow}),
+		},
+		{
+			name:   "invalid port",
+			gs:     types.NewGenesisState("", nil),
+			expErr: "identifier cannot be blank: invalid identifier",
+		},
+		{
+			name: "invalid channel",
+			gs:   types.NewGenesisState(types.PortID, []types.ScopeEscrow{types.NewScopeEscrow(scopeID, types.PortID, "x", "remote")}),
+			expErr: "invalid escrows[0]: invalid channel id: identifier x has invalid length: 1, " +
+				"must be between 8-64 characters: invalid identifier",
+		},
+		{
+			name: "wrong escrow address",
+			gs:   types.NewGenesisState(types.PortID, []types.ScopeEscrow{badEscrow}),
+			expErr: `invalid escrows[0]: escrow address "` + badEscrow.EscrowAddress +
+				`" is not correct for ibcmetadata/channel-0`,
+		},
+		{
+			name:   "duplicate scope",
+			gs:     types.NewGenesisState(types.PortID, []types.ScopeEscrow{escrow, escrow}),
+			expErr: `invalid escrows[1]: duplicate scope id "` + scopeID + `"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.gs.Validate()
+			assertions.AssertErrorValue(t, err, tc.expErr, "Validate")
+		})
+	}
+}
@@ -89,7 +89,7 @@ func (s *TestSuite) SetupSuite() {
 	genesisState[authtypes.ModuleName] = authDataBz
 
 	s.ratelimiter = "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma"
-	ratelimitData := ibcratelimit.NewGenesisState(ibcratelimit.NewParams(s.ratelimiter))
+	ratelimitData := ibcratelimit.NewGenesisState(ibcratelimit.NewParams(s.ratelimiter), nil)
 
 	ratelimitDataBz, err := s.cfg.Codec.MarshalJSON(ratelimitData)
 	s.Require().NoError(err, "should be able to marshal ibcratelimit genesis state when setting up suite")
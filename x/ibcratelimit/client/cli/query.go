@@ -26,6 +26,8 @@ func GetQueryCmd() *cobra.Command {
 
 	queryCmd.AddCommand(
 		GetParamsCmd(),
+		GetTrustedChannelsCmd(),
+		GetUsageCmd(),
 	)
 
 	return queryCmd
@@ -58,3 +60,60 @@ func GetParamsCmd() *cobra.Command {
 
 	return cmd
 }
+
+// GetTrustedChannelsCmd returns the command handler for querying the channels that are exempt from rate limiting.
+func GetTrustedChannelsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "trusted-channels",
+		Short:   "Query the channels that are exempt from rate limiting",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf(`$ %s query ibcratelimit trusted-channels`, version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := ibcratelimit.NewQueryClient(clientCtx)
+			res, err := queryClient.TrustedChannels(context.Background(), &ibcratelimit.TrustedChannelsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetUsageCmd returns the command handler for querying the current usage of the rate limit quotas of a channel and denom.
+func GetUsageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "usage <channel id> <denom>",
+		Short: "Query the current usage of the rate limit quotas of a channel and denom",
+		Args:  cobra.ExactArgs(2),
+		Example: fmt.Sprintf(`$ %[1]s query ibcratelimit usage channel-0 nhash
+$ %[1]s query ibcratelimit usage channel-0 ibc/C053D637CCA2A2BA030E2C5EE1B28A16F71CCB0E45E8BE52766DC1B241B77878`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := ibcratelimit.NewQueryClient(clientCtx)
+			res, err := queryClient.Usage(context.Background(), &ibcratelimit.UsageRequest{ChannelId: args[0], Denom: args[1]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/spf13/cobra"
@@ -14,6 +15,13 @@ import (
 	"github.com/provenance-io/provenance/x/ibcratelimit"
 )
 
+const (
+	// FlagAdd is the flag for channel ids to add to the trusted channels.
+	FlagAdd = "add"
+	// FlagRemove is the flag for channel ids to remove from the trusted channels.
+	FlagRemove = "remove"
+)
+
 // NewTxCmd is the top-level command for oracle CLI transactions.
 func NewTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -27,6 +35,7 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		GetCmdParamsUpdate(),
+		GetCmdTrustedChannelsUpdate(),
 	)
 
 	return txCmd
@@ -60,3 +69,45 @@ func GetCmdParamsUpdate() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdTrustedChannelsUpdate is a command to add and remove channels that are exempt from rate limiting.
+func GetCmdTrustedChannelsUpdate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-trusted-channels {--add <channel ids>|--remove <channel ids>}",
+		Short:   "Add and remove channels that are exempt from rate limiting",
+		Long:    "Submit an update to the trusted channels via governance proposal along with an initial deposit.",
+		Args:    cobra.NoArgs,
+		Aliases: []string{"trust"},
+		Example: fmt.Sprintf(`%[1]s tx ratelimitedibc update-trusted-channels --add channel-3 --deposit 50000nhash
+%[1]s tx ratelimitedibc update-trusted-channels --add channel-4 --remove channel-3 --deposit 50000nhash`, version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			flagSet := cmd.Flags()
+			toAdd, errAdd := flagSet.GetStringSlice(FlagAdd)
+			toRemove, errRemove := flagSet.GetStringSlice(FlagRemove)
+			if err = errors.Join(errAdd, errRemove); err != nil {
+				return err
+			}
+
+			authority := provcli.GetAuthority(flagSet)
+			msg := ibcratelimit.NewMsgUpdateTrustedChannelsRequest(authority, toAdd, toRemove)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagAdd, nil, "The channel ids to exempt from rate limiting")
+	cmd.Flags().StringSlice(FlagRemove, nil, "The channel ids that should no longer be exempt from rate limiting")
+	cmd.MarkFlagsOneRequired(FlagAdd, FlagRemove)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
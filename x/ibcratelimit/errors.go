@@ -8,4 +8,6 @@ var (
 	ErrRateLimitExceeded = cerrs.Register(ModuleName, 2, "rate limit exceeded")
 	ErrBadMessage        = cerrs.Register(ModuleName, 3, "bad message")
 	ErrContractError     = cerrs.Register(ModuleName, 4, "contract error")
+	ErrChannelTrusted    = cerrs.Register(ModuleName, 5, "channel is already trusted")
+	ErrChannelNotTrusted = cerrs.Register(ModuleName, 6, "channel is not trusted")
 )
@@ -178,10 +178,168 @@ func (m *EventParamsUpdated) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EventParamsUpdated proto.InternalMessageInfo
 
+// EventTrustedChannelsUpdated is an event emitted when the channels exempt from rate limiting have been updated.
+type EventTrustedChannelsUpdated struct {
+	// added are the ids of the channels that are now exempt from rate limiting.
+	Added []string `protobuf:"bytes,1,rep,name=added,proto3" json:"added,omitempty"`
+	// removed are the ids of the channels that are no longer exempt from rate limiting.
+	Removed []string `protobuf:"bytes,2,rep,name=removed,proto3" json:"removed,omitempty"`
+}
+
+func (m *EventTrustedChannelsUpdated) Reset()         { *m = EventTrustedChannelsUpdated{} }
+func (m *EventTrustedChannelsUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventTrustedChannelsUpdated) ProtoMessage()    {}
+func (*EventTrustedChannelsUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b9bde81a4017b0d, []int{3}
+}
+func (m *EventTrustedChannelsUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTrustedChannelsUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTrustedChannelsUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTrustedChannelsUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTrustedChannelsUpdated.Merge(m, src)
+}
+func (m *EventTrustedChannelsUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTrustedChannelsUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTrustedChannelsUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTrustedChannelsUpdated proto.InternalMessageInfo
+
+func (m *EventTrustedChannelsUpdated) GetAdded() []string {
+	if m != nil {
+		return m.Added
+	}
+	return nil
+}
+
+func (m *EventTrustedChannelsUpdated) GetRemoved() []string {
+	if m != nil {
+		return m.Removed
+	}
+	return nil
+}
+
+// EventQuotaThresholdCrossed is an event emitted when a transfer causes the usage of a rate limit quota to
+// reach a threshold percentage of its max. A transfer that was rejected for exceeding the quota crosses the 100 threshold.
+type EventQuotaThresholdCrossed struct {
+	// channel_id is the id of the channel (on this chain) the transfer used.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// denom is the denom (as known on this chain) that was transferred.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// quota is the name of the quota.
+	Quota string `protobuf:"bytes,3,opt,name=quota,proto3" json:"quota,omitempty"`
+	// direction is either "send" or "recv".
+	Direction string `protobuf:"bytes,4,opt,name=direction,proto3" json:"direction,omitempty"`
+	// threshold is the percentage of the max that was crossed, e.g. "80".
+	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// used is the net amount transferred in the direction during the current window, including this transfer.
+	Used string `protobuf:"bytes,6,opt,name=used,proto3" json:"used,omitempty"`
+	// max is the max net amount that can be transferred in the direction during a window.
+	Max string `protobuf:"bytes,7,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (m *EventQuotaThresholdCrossed) Reset()         { *m = EventQuotaThresholdCrossed{} }
+func (m *EventQuotaThresholdCrossed) String() string { return proto.CompactTextString(m) }
+func (*EventQuotaThresholdCrossed) ProtoMessage()    {}
+func (*EventQuotaThresholdCrossed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b9bde81a4017b0d, []int{4}
+}
+func (m *EventQuotaThresholdCrossed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventQuotaThresholdCrossed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventQuotaThresholdCrossed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventQuotaThresholdCrossed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventQuotaThresholdCrossed.Merge(m, src)
+}
+func (m *EventQuotaThresholdCrossed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventQuotaThresholdCrossed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventQuotaThresholdCrossed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventQuotaThresholdCrossed proto.InternalMessageInfo
+
+func (m *EventQuotaThresholdCrossed) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *EventQuotaThresholdCrossed) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventQuotaThresholdCrossed) GetQuota() string {
+	if m != nil {
+		return m.Quota
+	}
+	return ""
+}
+
+func (m *EventQuotaThresholdCrossed) GetDirection() string {
+	if m != nil {
+		return m.Direction
+	}
+	return ""
+}
+
+func (m *EventQuotaThresholdCrossed) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
+	}
+	return ""
+}
+
+func (m *EventQuotaThresholdCrossed) GetUsed() string {
+	if m != nil {
+		return m.Used
+	}
+	return ""
+}
+
+func (m *EventQuotaThresholdCrossed) GetMax() string {
+	if m != nil {
+		return m.Max
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventAckRevertFailure)(nil), "provenance.ibcratelimit.v1.EventAckRevertFailure")
 	proto.RegisterType((*EventTimeoutRevertFailure)(nil), "provenance.ibcratelimit.v1.EventTimeoutRevertFailure")
 	proto.RegisterType((*EventParamsUpdated)(nil), "provenance.ibcratelimit.v1.EventParamsUpdated")
+	proto.RegisterType((*EventTrustedChannelsUpdated)(nil), "provenance.ibcratelimit.v1.EventTrustedChannelsUpdated")
+	proto.RegisterType((*EventQuotaThresholdCrossed)(nil), "provenance.ibcratelimit.v1.EventQuotaThresholdCrossed")
 }
 
 func init() {
@@ -189,23 +347,32 @@ func init() {
 }
 
 var fileDescriptor_6b9bde81a4017b0d = []byte{
-	// 244 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2b, 0x28, 0xca, 0x2f,
-	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0xcf, 0x4c, 0x4a, 0x2e, 0x4a, 0x2c, 0x49, 0xcd, 0xc9,
-	0xcc, 0xcd, 0x2c, 0xd1, 0x2f, 0x33, 0xd4, 0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0xd1, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x92, 0x42, 0xa8, 0xd3, 0x43, 0x56, 0xa7, 0x57, 0x66, 0xa8, 0x14, 0xc9, 0x25,
-	0xea, 0x0a, 0x52, 0xea, 0x98, 0x9c, 0x1d, 0x94, 0x5a, 0x96, 0x5a, 0x54, 0xe2, 0x96, 0x98, 0x99,
-	0x53, 0x5a, 0x94, 0x2a, 0x24, 0xc6, 0xc5, 0x96, 0x9b, 0x9f, 0x52, 0x9a, 0x93, 0x2a, 0xc1, 0xa8,
-	0xc0, 0xa8, 0xc1, 0x19, 0x04, 0xe5, 0x81, 0xc4, 0x0b, 0x12, 0x93, 0xb3, 0x53, 0x4b, 0x24, 0x98,
-	0x20, 0xe2, 0x10, 0x9e, 0x90, 0x00, 0x17, 0x73, 0x62, 0x72, 0xb6, 0x04, 0x33, 0x58, 0x10, 0xc4,
-	0x54, 0xf2, 0xe6, 0x92, 0x04, 0x1b, 0x1d, 0x92, 0x99, 0x9b, 0x9a, 0x5f, 0x5a, 0x42, 0x91, 0xf1,
-	0x4a, 0x22, 0x5c, 0x42, 0x60, 0xc3, 0x02, 0x12, 0x8b, 0x12, 0x73, 0x8b, 0x43, 0x0b, 0x52, 0x12,
-	0x4b, 0x52, 0x53, 0x9c, 0x72, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23,
-	0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x81, 0x4b,
-	0x36, 0x33, 0x5f, 0x0f, 0xb7, 0xb7, 0x03, 0x18, 0xa3, 0x8c, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93,
-	0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x11, 0x0a, 0x75, 0x33, 0xf3, 0x91, 0x78, 0xfa, 0x15, 0x28, 0xe1,
-	0x9a, 0xc4, 0x06, 0x0e, 0x4f, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd2, 0x94, 0x63, 0x5e,
-	0x79, 0x01, 0x00, 0x00,
+	// 386 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xcf, 0xca, 0x13, 0x31,
+	0x14, 0xc5, 0x9b, 0xfe, 0x65, 0xb2, 0x92, 0x50, 0x25, 0x56, 0x3b, 0x94, 0x59, 0x48, 0x37, 0xce,
+	0x50, 0x7d, 0x02, 0x2d, 0x0a, 0x22, 0x42, 0x2d, 0x75, 0xa1, 0x1b, 0x49, 0x93, 0x8b, 0x13, 0x3a,
+	0x99, 0x8c, 0x99, 0xcc, 0xd0, 0xc7, 0xf0, 0xb1, 0x74, 0xd7, 0xa5, 0x4b, 0x69, 0x5f, 0x44, 0x26,
+	0x99, 0xfe, 0xf9, 0x16, 0xdf, 0xea, 0xdb, 0xe5, 0xfc, 0xee, 0xe1, 0x9c, 0x70, 0xb9, 0xf8, 0x45,
+	0x61, 0x74, 0x0d, 0x39, 0xcb, 0x39, 0x24, 0x72, 0xcb, 0x0d, 0xb3, 0x90, 0x49, 0x25, 0x6d, 0x52,
+	0x2f, 0x12, 0xa8, 0x21, 0xb7, 0x71, 0x61, 0xb4, 0xd5, 0x64, 0x72, 0xf5, 0xc5, 0xb7, 0xbe, 0xb8,
+	0x5e, 0x44, 0x5f, 0xf1, 0xe3, 0x77, 0x8d, 0xf5, 0x0d, 0xdf, 0xad, 0xa1, 0x06, 0x63, 0xdf, 0x33,
+	0x99, 0x55, 0x06, 0xc8, 0x13, 0x3c, 0x54, 0x5a, 0x54, 0x19, 0x50, 0x34, 0x43, 0xf3, 0x60, 0xdd,
+	0xaa, 0x86, 0x17, 0x8c, 0xef, 0xc0, 0xd2, 0xae, 0xe7, 0x5e, 0x91, 0x47, 0xb8, 0xc7, 0xf8, 0x8e,
+	0xf6, 0x1c, 0x6c, 0x9e, 0xd1, 0x47, 0xfc, 0xd4, 0x45, 0x6f, 0xa4, 0x02, 0x5d, 0xd9, 0x07, 0xc5,
+	0x47, 0x63, 0x4c, 0x5c, 0xd8, 0x8a, 0x19, 0xa6, 0xca, 0x2f, 0x85, 0x60, 0x16, 0x44, 0xf4, 0x09,
+	0x3f, 0xf3, 0x15, 0xa6, 0x2a, 0x2d, 0x88, 0x65, 0xca, 0xf2, 0x1c, 0xb2, 0xf3, 0x98, 0x8c, 0xf1,
+	0x80, 0x09, 0x01, 0x82, 0xa2, 0x59, 0x6f, 0x1e, 0xac, 0xbd, 0x20, 0x14, 0x8f, 0x0c, 0x28, 0x5d,
+	0x83, 0xa0, 0x5d, 0xc7, 0xcf, 0x32, 0xfa, 0x83, 0xf0, 0xc4, 0xe5, 0x7d, 0xae, 0xb4, 0x65, 0x9b,
+	0xd4, 0x40, 0x99, 0xea, 0x4c, 0x2c, 0x8d, 0x2e, 0x4b, 0x10, 0x64, 0x8a, 0x31, 0xf7, 0x0d, 0xdf,
+	0xa5, 0x68, 0xff, 0x1d, 0xb4, 0xe4, 0x83, 0x6b, 0x13, 0x90, 0x6b, 0xd5, 0xfe, 0xdc, 0x8b, 0x86,
+	0xfe, 0x6c, 0xd2, 0xda, 0xcd, 0x78, 0x41, 0x9e, 0xe3, 0x40, 0x48, 0x03, 0xdc, 0x4a, 0x9d, 0xd3,
+	0xbe, 0x4f, 0xba, 0x80, 0x66, 0x6a, 0xcf, 0xe5, 0x74, 0xe0, 0xa7, 0x17, 0x40, 0x08, 0xee, 0x57,
+	0x25, 0x08, 0x3a, 0x74, 0x03, 0xf7, 0x6e, 0xb6, 0xaf, 0xd8, 0x9e, 0x8e, 0xfc, 0xf6, 0x15, 0xdb,
+	0xbf, 0x55, 0xbf, 0x8f, 0x21, 0x3a, 0x1c, 0x43, 0xf4, 0xef, 0x18, 0xa2, 0x5f, 0xa7, 0xb0, 0x73,
+	0x38, 0x85, 0x9d, 0xbf, 0xa7, 0xb0, 0x83, 0xa7, 0x52, 0xc7, 0xf7, 0x5f, 0xc4, 0x0a, 0x7d, 0x7b,
+	0xf5, 0x43, 0xda, 0xb4, 0xda, 0xc6, 0x5c, 0xab, 0xe4, 0x6a, 0x7c, 0x29, 0xf5, 0x8d, 0x4a, 0xf6,
+	0x77, 0x4e, 0x6e, 0x3b, 0x74, 0xa7, 0xf6, 0xfa, 0xff, 0x00, 0x1f, 0x69, 0xee, 0x45, 0x94, 0x02,
+	0x00, 0x00,
 }
 
 func (m *EventAckRevertFailure) Marshal() (dAtA []byte, err error) {
@@ -312,6 +479,119 @@ func (m *EventParamsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventTrustedChannelsUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTrustedChannelsUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTrustedChannelsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Removed) > 0 {
+		for iNdEx := len(m.Removed) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Removed[iNdEx])
+			copy(dAtA[i:], m.Removed[iNdEx])
+			i = encodeVarintEvent(dAtA, i, uint64(len(m.Removed[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Added) > 0 {
+		for iNdEx := len(m.Added) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Added[iNdEx])
+			copy(dAtA[i:], m.Added[iNdEx])
+			i = encodeVarintEvent(dAtA, i, uint64(len(m.Added[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventQuotaThresholdCrossed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventQuotaThresholdCrossed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventQuotaThresholdCrossed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Max) > 0 {
+		i -= len(m.Max)
+		copy(dAtA[i:], m.Max)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Max)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Used) > 0 {
+		i -= len(m.Used)
+		copy(dAtA[i:], m.Used)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Used)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Direction) > 0 {
+		i -= len(m.Direction)
+		copy(dAtA[i:], m.Direction)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Direction)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Quota) > 0 {
+		i -= len(m.Quota)
+		copy(dAtA[i:], m.Quota)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Quota)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -370,6 +650,64 @@ func (m *EventParamsUpdated) Size() (n int) {
 	return n
 }
 
+func (m *EventTrustedChannelsUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Added) > 0 {
+		for _, s := range m.Added {
+			l = len(s)
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if len(m.Removed) > 0 {
+		for _, s := range m.Removed {
+			l = len(s)
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventQuotaThresholdCrossed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Quota)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Direction)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Used)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Max)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
 func sovEvent(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -686,6 +1024,394 @@ func (m *EventParamsUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventTrustedChannelsUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTrustedChannelsUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTrustedChannelsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Added", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Added = append(m.Added, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Removed = append(m.Removed, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventQuotaThresholdCrossed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventQuotaThresholdCrossed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventQuotaThresholdCrossed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quota = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Direction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Used = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Max = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvent(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,5 +1,7 @@
 package ibcratelimit
 
+import "fmt"
+
 // NewEventAckRevertFailure returns a new EventAckRevertFailure.
 func NewEventAckRevertFailure(module, packet, ack string) *EventAckRevertFailure {
 	return &EventAckRevertFailure{
@@ -21,3 +23,24 @@ func NewEventTimeoutRevertFailure(module, packet string) *EventTimeoutRevertFail
 func NewEventParamsUpdated() *EventParamsUpdated {
 	return &EventParamsUpdated{}
 }
+
+// NewEventTrustedChannelsUpdated returns a new EventTrustedChannelsUpdated.
+func NewEventTrustedChannelsUpdated(added, removed []string) *EventTrustedChannelsUpdated {
+	return &EventTrustedChannelsUpdated{
+		Added:   added,
+		Removed: removed,
+	}
+}
+
+// NewEventQuotaThresholdCrossed returns a new EventQuotaThresholdCrossed.
+func NewEventQuotaThresholdCrossed(channelID, denom, quota, direction string, threshold uint32, used, max string) *EventQuotaThresholdCrossed {
+	return &EventQuotaThresholdCrossed{
+		ChannelId: channelID,
+		Denom:     denom,
+		Quota:     quota,
+		Direction: direction,
+		Threshold: fmt.Sprintf("%d", threshold),
+		Used:      used,
+		Max:       max,
+	}
+}
@@ -31,3 +31,26 @@ func TestNewEventParamsUpdated(t *testing.T) {
 	event := ibcratelimit.NewEventParamsUpdated()
 	assert.Equal(t, expected, event, "should create the correct event type")
 }
+
+func TestNewEventTrustedChannelsUpdated(t *testing.T) {
+	expected := &ibcratelimit.EventTrustedChannelsUpdated{
+		Added:   []string{"channel-0"},
+		Removed: []string{"channel-1"},
+	}
+	event := ibcratelimit.NewEventTrustedChannelsUpdated(expected.Added, expected.Removed)
+	assert.Equal(t, expected, event, "should create the correct event type")
+}
+
+func TestNewEventQuotaThresholdCrossed(t *testing.T) {
+	expected := &ibcratelimit.EventQuotaThresholdCrossed{
+		ChannelId: "channel-0",
+		Denom:     "nhash",
+		Quota:     "weekly",
+		Direction: "send",
+		Threshold: "80",
+		Used:      "8",
+		Max:       "10",
+	}
+	event := ibcratelimit.NewEventQuotaThresholdCrossed(expected.ChannelId, expected.Denom, expected.Quota, expected.Direction, 80, expected.Used, expected.Max)
+	assert.Equal(t, expected, event, "should create the correct event type")
+}
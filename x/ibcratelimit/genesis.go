@@ -1,5 +1,13 @@
 package ibcratelimit
 
+import (
+	"fmt"
+
+	cerrs "cosmossdk.io/errors"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
 // DefaultGenesis creates a default GenesisState object.
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -10,12 +18,28 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(gs.TrustedChannels))
+	for i, channelID := range gs.TrustedChannels {
+		if err := host.ChannelIdentifierValidator(channelID); err != nil {
+			return cerrs.Wrapf(err, "invalid trusted_channels[%d]", i)
+		}
+		if seen[channelID] {
+			return fmt.Errorf("invalid trusted_channels[%d]: duplicate channel id %q", i, channelID)
+		}
+		seen[channelID] = true
+	}
+
+	return nil
 }
 
 // NewGenesisState returns a new instance of GenesisState object
-func NewGenesisState(params Params) *GenesisState {
+func NewGenesisState(params Params, trustedChannels []string) *GenesisState {
 	return &GenesisState{
-		Params: params,
+		Params:          params,
+		TrustedChannels: trustedChannels,
 	}
 }
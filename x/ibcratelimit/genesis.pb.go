@@ -27,6 +27,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// params are all the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// trusted_channels are the ids of the channels that are exempt from rate limiting.
+	TrustedChannels []string `protobuf:"bytes,2,rep,name=trusted_channels,json=trustedChannels,proto3" json:"trusted_channels,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,6 +71,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetTrustedChannels() []string {
+	if m != nil {
+		return m.TrustedChannels
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "provenance.ibcratelimit.v1.GenesisState")
 }
@@ -78,20 +87,22 @@ func init() {
 }
 
 var fileDescriptor_8046e03397972f41 = []byte{
-	// 208 bytes of a gzipped FileDescriptorProto
+	// 238 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x28, 0x28, 0xca, 0x2f,
 	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0xcf, 0x4c, 0x4a, 0x2e, 0x4a, 0x2c, 0x49, 0xcd, 0xc9,
 	0xcc, 0xcd, 0x2c, 0xd1, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b,
 	0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x42, 0xa8, 0xd4, 0x43, 0x56, 0xa9, 0x57, 0x66, 0x28, 0x25,
 	0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa6, 0x0f, 0x62, 0x41, 0x74, 0x48, 0xa9, 0xe3, 0x31, 0xbb,
-	0x20, 0xb1, 0x28, 0x31, 0x17, 0x6a, 0xb4, 0x52, 0x00, 0x17, 0x8f, 0x3b, 0xc4, 0xae, 0xe0, 0x92,
+	0x20, 0xb1, 0x28, 0x31, 0x17, 0x6a, 0xb4, 0x52, 0x35, 0x17, 0x8f, 0x3b, 0xc4, 0xae, 0xe0, 0x92,
 	0xc4, 0x92, 0x54, 0x21, 0x07, 0x2e, 0x36, 0x88, 0xbc, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91,
 	0x92, 0x1e, 0x6e, 0xbb, 0xf5, 0x02, 0xc0, 0x2a, 0x9d, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82,
-	0xea, 0x73, 0xca, 0x3d, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18,
-	0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x06, 0x2e, 0xd9, 0xcc,
-	0x7c, 0x3c, 0xa6, 0x05, 0x30, 0x46, 0x19, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7,
-	0xe7, 0xea, 0x23, 0x14, 0xea, 0x66, 0xe6, 0x23, 0xf1, 0xf4, 0x2b, 0x50, 0x3c, 0x94, 0xc4, 0x06,
-	0xf6, 0x87, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x58, 0x77, 0xf4, 0x5d, 0x4e, 0x01, 0x00, 0x00,
+	0xea, 0x13, 0xd2, 0xe4, 0x12, 0x28, 0x29, 0x2a, 0x2d, 0x2e, 0x49, 0x4d, 0x89, 0x4f, 0xce, 0x48,
+	0xcc, 0xcb, 0x4b, 0xcd, 0x29, 0x96, 0x60, 0x52, 0x60, 0xd6, 0xe0, 0x0c, 0xe2, 0x87, 0x8a, 0x3b,
+	0x43, 0x85, 0x9d, 0x72, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39,
+	0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x81, 0x4b, 0x36,
+	0x33, 0x1f, 0x8f, 0xc5, 0x01, 0x8c, 0x51, 0x46, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9,
+	0xf9, 0xb9, 0xfa, 0x08, 0x85, 0xba, 0x99, 0xf9, 0x48, 0x3c, 0xfd, 0x0a, 0x14, 0xbf, 0x27, 0xb1,
+	0x81, 0xbd, 0x6c, 0x0c, 0x18, 0x00, 0x43, 0x56, 0x02, 0x2d, 0x79, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -114,6 +125,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TrustedChannels) > 0 {
+		for iNdEx := len(m.TrustedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TrustedChannels[iNdEx])
+			copy(dAtA[i:], m.TrustedChannels[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.TrustedChannels[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -146,6 +166,12 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TrustedChannels) > 0 {
+		for _, s := range m.TrustedChannels {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -217,6 +243,38 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustedChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrustedChannels = append(m.TrustedChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -8,16 +8,17 @@ import (
 )
 
 func TestDefaultGenesis(t *testing.T) {
-	expected := ibcratelimit.NewGenesisState(ibcratelimit.NewParams(""))
+	expected := ibcratelimit.NewGenesisState(ibcratelimit.NewParams(""), nil)
 	genesis := ibcratelimit.DefaultGenesis()
 	assert.Equal(t, expected, genesis)
 }
 
 func TestGenesisValidate(t *testing.T) {
 	testCases := []struct {
-		name string
-		addr string
-		err  string
+		name     string
+		addr     string
+		channels []string
+		err      string
 	}{
 		{
 			name: "success - valid address",
@@ -32,12 +33,26 @@ func TestGenesisValidate(t *testing.T) {
 			addr: "cosmos1234",
 			err:  "decoding bech32 failed: invalid separator index 6",
 		},
+		{
+			name:     "success - trusted channels",
+			channels: []string{"channel-0", "channel-1"},
+		},
+		{
+			name:     "failure - invalid trusted channel",
+			channels: []string{"channel-0", "x"},
+			err:      "invalid trusted_channels[1]: identifier x has invalid length: 1, must be between 8-64 characters: invalid identifier",
+		},
+		{
+			name:     "failure - duplicate trusted channel",
+			channels: []string{"channel-0", "channel-0"},
+			err:      `invalid trusted_channels[1]: duplicate channel id "channel-0"`,
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 
-			genesis := ibcratelimit.NewGenesisState(ibcratelimit.NewParams(tc.addr))
+			genesis := ibcratelimit.NewGenesisState(ibcratelimit.NewParams(tc.addr), tc.channels)
 			err := genesis.Validate()
 
 			if len(tc.err) > 0 {
@@ -67,7 +82,7 @@ func TestNewGenesisState(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			genesis := *ibcratelimit.NewGenesisState(ibcratelimit.NewParams(tc.expected.Params.ContractAddress))
+			genesis := *ibcratelimit.NewGenesisState(ibcratelimit.NewParams(tc.expected.Params.ContractAddress), nil)
 			assert.Equal(t, tc.expected, genesis)
 		})
 	}
@@ -13,9 +13,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *ibcratelimit.GenesisState {
 		panic(err)
 	}
 
-	return &ibcratelimit.GenesisState{
-		Params: params,
-	}
+	return ibcratelimit.NewGenesisState(params, k.GetTrustedChannels(ctx))
 }
 
 // InitGenesis new ibcratelimit genesis
@@ -24,4 +22,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *ibcratelimit.GenesisState) {
 		panic(err)
 	}
 	k.SetParams(ctx, data.Params)
+	for _, channelID := range data.TrustedChannels {
+		k.setTrustedChannel(ctx, channelID)
+	}
 }
@@ -10,10 +10,11 @@ func (s *TestSuite) TestInitExportGenesis() {
 	testAddress := sdk.AccAddress([]byte("addr1_______________")).String()
 	k := s.app.RateLimitingKeeper
 
-	initialGenesis := ibcratelimit.NewGenesisState(ibcratelimit.NewParams(testAddress))
+	initialGenesis := ibcratelimit.NewGenesisState(ibcratelimit.NewParams(testAddress), []string{"channel-0", "channel-12"})
 
 	k.InitGenesis(s.ctx, initialGenesis)
 	s.Assert().Equal(testAddress, k.GetContractAddress(s.ctx))
+	s.Assert().True(k.IsTrustedChannel(s.ctx, "channel-12"), "IsTrustedChannel(channel-12)")
 	exportedGenesis := k.ExportGenesis(s.ctx)
 	s.Assert().Equal(initialGenesis, exportedGenesis)
 }
@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/x/ibcratelimit"
@@ -21,3 +24,28 @@ func (k Keeper) Params(ctx context.Context, _ *ibcratelimit.ParamsRequest) (*ibc
 
 	return &ibcratelimit.ParamsResponse{Params: params}, nil
 }
+
+// TrustedChannels returns the ids of the channels that are exempt from rate limiting.
+func (k Keeper) TrustedChannels(ctx context.Context, _ *ibcratelimit.TrustedChannelsRequest) (*ibcratelimit.TrustedChannelsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &ibcratelimit.TrustedChannelsResponse{Channels: k.GetTrustedChannels(sdkCtx)}, nil
+}
+
+// Usage returns the current consumption of each of the rate limit quotas of a channel and denom.
+func (k Keeper) Usage(ctx context.Context, req *ibcratelimit.UsageRequest) (*ibcratelimit.UsageResponse, error) {
+	if req == nil || len(req.ChannelId) == 0 || len(req.Denom) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "channel id and denom are required")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if !k.IsContractConfigured(sdkCtx) {
+		return nil, status.Error(codes.FailedPrecondition, "rate limit contract is not configured")
+	}
+
+	quotas, err := k.GetQuotaUsage(sdkCtx, req.ChannelId, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &ibcratelimit.UsageResponse{Quotas: quotas}, nil
+}
@@ -42,3 +42,40 @@ func (s *TestSuite) TestQueryParams() {
 		})
 	}
 }
+
+func (s *TestSuite) TestQueryTrustedChannels() {
+	response, err := s.queryClient.TrustedChannels(s.ctx, &ibcratelimit.TrustedChannelsRequest{})
+	s.Require().NoError(err, "TrustedChannels before any are set")
+	s.Assert().Empty(response.Channels, "channels before any are set")
+
+	err = s.app.RateLimitingKeeper.UpdateTrustedChannels(s.ctx, []string{"channel-5", "channel-1"}, nil)
+	s.Require().NoError(err, "UpdateTrustedChannels")
+	defer func() {
+		err = s.app.RateLimitingKeeper.UpdateTrustedChannels(s.ctx, nil, []string{"channel-5", "channel-1"})
+		s.Require().NoError(err, "UpdateTrustedChannels cleanup")
+	}()
+
+	response, err = s.queryClient.TrustedChannels(s.ctx, &ibcratelimit.TrustedChannelsRequest{})
+	s.Require().NoError(err, "TrustedChannels")
+	s.Assert().Equal([]string{"channel-1", "channel-5"}, response.Channels, "channels")
+}
+
+func (s *TestSuite) TestQueryUsage() {
+	contractViewer := s.app.RateLimitingKeeper.ContractViewer
+	defer func() {
+		s.app.RateLimitingKeeper.ContractViewer = contractViewer
+		s.app.RateLimitingKeeper.SetParams(s.ctx, ibcratelimit.DefaultParams())
+	}()
+
+	_, err := s.queryClient.Usage(s.ctx, &ibcratelimit.UsageRequest{ChannelId: "channel-0"})
+	s.Assert().EqualError(err, "rpc error: code = InvalidArgument desc = channel id and denom are required", "Usage without denom")
+
+	_, err = s.queryClient.Usage(s.ctx, &ibcratelimit.UsageRequest{ChannelId: "channel-0", Denom: "nhash"})
+	s.Assert().EqualError(err, "rpc error: code = FailedPrecondition desc = rate limit contract is not configured", "Usage without contract")
+
+	s.app.RateLimitingKeeper.SetParams(s.ctx, ibcratelimit.NewParams(testContract))
+	s.app.RateLimitingKeeper.ContractViewer = NewMockContractViewer([]byte("[]"), nil)
+	response, err := s.queryClient.Usage(s.ctx, &ibcratelimit.UsageRequest{ChannelId: "channel-0", Denom: "nhash"})
+	s.Require().NoError(err, "Usage")
+	s.Assert().Empty(response.Quotas, "quotas")
+}
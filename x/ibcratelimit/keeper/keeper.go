@@ -18,6 +18,7 @@ type Keeper struct {
 	storeKey           storetypes.StoreKey
 	cdc                codec.BinaryCodec
 	PermissionedKeeper ibcratelimit.PermissionedKeeper
+	ContractViewer     ibcratelimit.ContractViewer
 	authority          string
 }
 
@@ -91,6 +92,6 @@ func (k Keeper) ValidateAuthority(addr string) error {
 func (k Keeper) emitEvent(ctx sdk.Context, event proto.Message) {
 	err := ctx.EventManager().EmitTypedEvent(event)
 	if err != nil {
-		k.Logger(ctx).Error("error emitting event %#v: %v", event, err)
+		k.Logger(ctx).Error("error emitting event", "event", event, "error", err)
 	}
 }
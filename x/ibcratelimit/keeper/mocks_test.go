@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"context"
 	"encoding/json"
 	"strings"
 
@@ -117,3 +118,24 @@ func (m *MockPermissionedKeeper) Sudo(ctx sdk.Context, contractAddress sdk.AccAd
 	}
 	return []byte("success"), nil
 }
+
+// MockContractViewer is a test struct that implements the ContractViewer interface.
+type MockContractViewer struct {
+	resp    []byte
+	err     error
+	queries []string
+}
+
+// NewMockContractViewer creates a new MockContractViewer that returns the provided response or error.
+func NewMockContractViewer(resp []byte, err error) *MockContractViewer {
+	return &MockContractViewer{
+		resp: resp,
+		err:  err,
+	}
+}
+
+// QuerySmart implements the ContractViewer interface, recording the query and returning the configured response or error.
+func (m *MockContractViewer) QuerySmart(_ context.Context, _ sdk.AccAddress, req []byte) ([]byte, error) {
+	m.queries = append(m.queries, string(req))
+	return m.resp, m.err
+}
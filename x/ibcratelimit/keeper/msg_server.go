@@ -41,3 +41,17 @@ func (k MsgServer) UpdateParams(goCtx context.Context, msg *ibcratelimit.MsgUpda
 
 	return &ibcratelimit.MsgUpdateParamsResponse{}, nil
 }
+
+// UpdateTrustedChannels is a governance proposal endpoint for updating the channels that are exempt from rate limiting.
+func (k MsgServer) UpdateTrustedChannels(goCtx context.Context, msg *ibcratelimit.MsgUpdateTrustedChannelsRequest) (*ibcratelimit.MsgUpdateTrustedChannelsResponse, error) {
+	if err := k.ValidateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.UpdateTrustedChannels(ctx, msg.ToAdd, msg.ToRemove); err != nil {
+		return nil, err
+	}
+
+	return &ibcratelimit.MsgUpdateTrustedChannelsResponse{}, nil
+}
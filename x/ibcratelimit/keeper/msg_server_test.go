@@ -62,6 +62,60 @@ func (s *TestSuite) TestUpdateParams() {
 	}
 }
 
+func (s *TestSuite) TestUpdateTrustedChannelsMsg() {
+	authority := s.app.RateLimitingKeeper.GetAuthority()
+	other := "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma"
+
+	tests := []struct {
+		name  string
+		req   *ibcratelimit.MsgUpdateTrustedChannelsRequest
+		res   *ibcratelimit.MsgUpdateTrustedChannelsResponse
+		event *sdk.Event
+		err   string
+	}{
+		{
+			name: "failure - authority does not match module authority",
+			req:  ibcratelimit.NewMsgUpdateTrustedChannelsRequest(other, []string{"channel-0"}, nil),
+			err:  fmt.Sprintf("expected \"%s\" got \"%s\": expected gov account as only signer for proposal message", authority, other),
+		},
+		{
+			name: "failure - channel not trusted",
+			req:  ibcratelimit.NewMsgUpdateTrustedChannelsRequest(authority, nil, []string{"channel-0"}),
+			err:  "cannot remove \"channel-0\": channel is not trusted",
+		},
+		{
+			name:  "success - channel is trusted",
+			req:   ibcratelimit.NewMsgUpdateTrustedChannelsRequest(authority, []string{"channel-0"}, nil),
+			res:   &ibcratelimit.MsgUpdateTrustedChannelsResponse{},
+			event: typedEventToEvent(ibcratelimit.NewEventTrustedChannelsUpdated([]string{"channel-0"}, nil)),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			res, err := s.msgServer.UpdateTrustedChannels(ctx, tc.req)
+			events := ctx.EventManager().Events()
+
+			if tc.event != nil {
+				s.Assert().Equal(sdk.Events{*tc.event}, events, "should emit the correct events")
+			} else {
+				s.Assert().Empty(events, "should not emit events")
+			}
+
+			if len(tc.err) > 0 {
+				s.Assert().Nil(res, "should have nil response")
+				s.Assert().EqualError(err, tc.err, "should have correct error")
+			} else {
+				s.Assert().NoError(err, "should not have error")
+				s.Assert().Equal(tc.res, res, "should have the correct response")
+				s.Assert().True(s.app.RateLimitingKeeper.IsTrustedChannel(ctx, "channel-0"), "IsTrustedChannel")
+			}
+		})
+	}
+}
+
 func typedEventToEvent(tev proto.Message) *sdk.Event {
 	event, _ := sdk.TypedEventToEvent(tev)
 	return &event
@@ -26,6 +26,7 @@ func (k Keeper) CheckAndUpdateRateLimits(ctx sdk.Context, msgType string, packet
 	}
 
 	_, err = k.PermissionedKeeper.Sudo(ctx, contractAddr, sendPacketMsg)
+	k.emitThresholdEvents(ctx, msgType, packet, err == nil)
 	if err != nil {
 		return errorsmod.Wrap(ibcratelimit.ErrRateLimitExceeded, err.Error())
 	}
@@ -90,6 +91,7 @@ func (k Keeper) buildWasmExecMsg(msgType string, packet exported.PacketI) ([]byt
 }
 
 // RevertSentPacket Notifies the contract that a sent packet wasn't properly received.
+// Packets sent on a trusted channel were never given to the contract, so there's nothing to revert for them.
 func (k Keeper) RevertSentPacket(
 	ctx sdk.Context,
 	packet exported.PacketI,
@@ -97,6 +99,9 @@ func (k Keeper) RevertSentPacket(
 	if !k.IsContractConfigured(ctx) {
 		return nil
 	}
+	if packet != nil && k.IsTrustedChannel(ctx, packet.GetSourceChannel()) {
+		return nil
+	}
 
 	contract := k.GetContractAddress(ctx)
 	return k.UndoSendRateLimit(ctx, contract, packet)
@@ -157,10 +157,18 @@ func (s *TestSuite) TestRevertSentPacket() {
 	tests := []struct {
 		name       string
 		contract   string
+		trusted    string
 		packet     exported.PacketI
 		mockKeeper *MockPermissionedKeeper
 		err        string
 	}{
+		{
+			name:       "success - skips packets sent on a trusted channel",
+			contract:   "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma",
+			trusted:    "src-channel",
+			packet:     NewMockPacket(NewMockSerializedPacketData(), true),
+			mockKeeper: NewMockPermissionedKeeper(false),
+		},
 		{
 			name:       "success - reverts a sent packet",
 			contract:   "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma",
@@ -196,7 +204,13 @@ func (s *TestSuite) TestRevertSentPacket() {
 				s.app.RateLimitingKeeper.PermissionedKeeper = tc.mockKeeper
 			}
 
-			err := s.app.RateLimitingKeeper.RevertSentPacket(s.ctx, tc.packet)
+			ctx := s.ctx
+			if len(tc.trusted) > 0 {
+				ctx, _ = s.ctx.CacheContext()
+				s.Require().NoError(s.app.RateLimitingKeeper.UpdateTrustedChannels(ctx, []string{tc.trusted}, nil), "UpdateTrustedChannels")
+			}
+
+			err := s.app.RateLimitingKeeper.RevertSentPacket(ctx, tc.packet)
 			if len(tc.err) > 0 {
 				s.Assert().EqualError(err, tc.err, "should return the correct error")
 			} else {
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/ibcratelimit"
+)
+
+// IsTrustedChannel returns true if the provided channel is exempt from rate limiting.
+func (k Keeper) IsTrustedChannel(ctx sdk.Context, channelID string) bool {
+	return ctx.KVStore(k.storeKey).Has(ibcratelimit.GetTrustedChannelKey(channelID))
+}
+
+// GetTrustedChannels returns the ids of all the channels that are exempt from rate limiting.
+func (k Keeper) GetTrustedChannels(ctx sdk.Context) []string {
+	var rv []string
+	iter := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), ibcratelimit.TrustedChannelKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		rv = append(rv, string(iter.Key()[len(ibcratelimit.TrustedChannelKeyPrefix):]))
+	}
+	return rv
+}
+
+// setTrustedChannel marks the provided channel as exempt from rate limiting.
+func (k Keeper) setTrustedChannel(ctx sdk.Context, channelID string) {
+	ctx.KVStore(k.storeKey).Set(ibcratelimit.GetTrustedChannelKey(channelID), []byte{})
+}
+
+// deleteTrustedChannel removes the rate limiting exemption of the provided channel.
+func (k Keeper) deleteTrustedChannel(ctx sdk.Context, channelID string) {
+	ctx.KVStore(k.storeKey).Delete(ibcratelimit.GetTrustedChannelKey(channelID))
+}
+
+// UpdateTrustedChannels removes and then adds entries in the list of channels that are exempt from rate limiting.
+func (k Keeper) UpdateTrustedChannels(ctx sdk.Context, toAdd, toRemove []string) error {
+	for _, channelID := range toRemove {
+		if !k.IsTrustedChannel(ctx, channelID) {
+			return ibcratelimit.ErrChannelNotTrusted.Wrapf("cannot remove %q", channelID)
+		}
+		k.deleteTrustedChannel(ctx, channelID)
+	}
+
+	for _, channelID := range toAdd {
+		if k.IsTrustedChannel(ctx, channelID) {
+			return ibcratelimit.ErrChannelTrusted.Wrapf("cannot add %q", channelID)
+		}
+		k.setTrustedChannel(ctx, channelID)
+	}
+
+	k.emitEvent(ctx, ibcratelimit.NewEventTrustedChannelsUpdated(toAdd, toRemove))
+	return nil
+}
@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+	"github.com/provenance-io/provenance/x/ibcratelimit"
+)
+
+func (s *TestSuite) TestUpdateTrustedChannels() {
+	tests := []struct {
+		name       string
+		starting   []string
+		toAdd      []string
+		toRemove   []string
+		expErr     string
+		expTrusted []string
+	}{
+		{
+			name:       "add to empty",
+			toAdd:      []string{"channel-1", "channel-0"},
+			expTrusted: []string{"channel-0", "channel-1"},
+		},
+		{
+			name:       "remove and add",
+			starting:   []string{"channel-0", "channel-1"},
+			toAdd:      []string{"channel-2"},
+			toRemove:   []string{"channel-0"},
+			expTrusted: []string{"channel-1", "channel-2"},
+		},
+		{
+			name:       "remove all",
+			starting:   []string{"channel-0"},
+			toRemove:   []string{"channel-0"},
+			expTrusted: nil,
+		},
+		{
+			name:       "add already trusted",
+			starting:   []string{"channel-0"},
+			toAdd:      []string{"channel-0"},
+			expErr:     `cannot add "channel-0": channel is already trusted`,
+			expTrusted: []string{"channel-0"},
+		},
+		{
+			name:       "remove not trusted",
+			starting:   []string{"channel-0"},
+			toRemove:   []string{"channel-1"},
+			expErr:     `cannot remove "channel-1": channel is not trusted`,
+			expTrusted: []string{"channel-0"},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			k := s.app.RateLimitingKeeper
+			if len(tc.starting) > 0 {
+				s.Require().NoError(k.UpdateTrustedChannels(ctx, tc.starting, nil), "UpdateTrustedChannels(starting)")
+			}
+
+			em := sdk.NewEventManager()
+			ctx = ctx.WithEventManager(em)
+			expEvents := sdk.Events{}
+			if len(tc.expErr) == 0 {
+				expEvents = sdk.Events{*typedEventToEvent(ibcratelimit.NewEventTrustedChannelsUpdated(tc.toAdd, tc.toRemove))}
+			}
+
+			var err error
+			testFunc := func() {
+				err = k.UpdateTrustedChannels(ctx, tc.toAdd, tc.toRemove)
+			}
+			s.Require().NotPanics(testFunc, "UpdateTrustedChannels")
+			assertions.AssertErrorValue(s.T(), err, tc.expErr, "UpdateTrustedChannels error")
+			s.Assert().Equal(expEvents, em.Events(), "events emitted during UpdateTrustedChannels")
+			s.Assert().Equal(tc.expTrusted, k.GetTrustedChannels(ctx), "GetTrustedChannels after UpdateTrustedChannels")
+			for _, channelID := range tc.expTrusted {
+				s.Assert().True(k.IsTrustedChannel(ctx, channelID), "IsTrustedChannel(%q)", channelID)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/provenance-io/provenance/x/ibcratelimit"
+)
+
+// GetQuotaUsage returns the current usage of each of the rate limit quotas of a channel and denom.
+func (k Keeper) GetQuotaUsage(ctx sdk.Context, channelID, denom string) ([]ibcratelimit.QuotaUsage, error) {
+	if !k.IsContractConfigured(ctx) || k.ContractViewer == nil {
+		return nil, nil
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(k.GetContractAddress(ctx))
+	if err != nil {
+		return nil, errorsmod.Wrap(ibcratelimit.ErrContractError, err.Error())
+	}
+
+	query, err := json.Marshal(ibcratelimit.NewGetQuotasQuery(channelID, denom))
+	if err != nil {
+		return nil, errorsmod.Wrap(ibcratelimit.ErrContractError, err.Error())
+	}
+
+	resp, err := k.ContractViewer.QuerySmart(ctx, contractAddr, query)
+	if err != nil {
+		return nil, errorsmod.Wrap(ibcratelimit.ErrContractError, err.Error())
+	}
+
+	var rateLimits []ibcratelimit.ContractRateLimit
+	if err = json.Unmarshal(resp, &rateLimits); err != nil {
+		return nil, errorsmod.Wrapf(ibcratelimit.ErrContractError, "could not parse quotas: %v", err)
+	}
+
+	rv := make([]ibcratelimit.QuotaUsage, 0, len(rateLimits))
+	for _, rateLimit := range rateLimits {
+		usage, err := rateLimit.ToQuotaUsage(ctx.BlockTime())
+		if err != nil {
+			return nil, errorsmod.Wrap(ibcratelimit.ErrContractError, err.Error())
+		}
+		rv = append(rv, usage)
+	}
+	return rv, nil
+}
+
+// emitThresholdEvents emits an EventQuotaThresholdCrossed for each quota threshold crossed by the packet.
+// If applied is false, the packet was rejected by the contract, so the usage doesn't include it yet.
+// Any problem looking up the usage is logged, but otherwise ignored, since it shouldn't affect the transfer.
+func (k Keeper) emitThresholdEvents(ctx sdk.Context, msgType string, packet exported.PacketI, applied bool) {
+	unwrapped, err := ibcratelimit.UnwrapPacket(packet)
+	if err != nil {
+		return
+	}
+	amount, ok := sdkmath.NewIntFromString(unwrapped.Data.Amount)
+	if !ok {
+		return
+	}
+
+	direction := ibcratelimit.Direction(msgType)
+	channelID, denom := unwrapped.LocalPath(direction)
+	// The lookup uses a cache context so that any gas used or error returned doesn't affect the transfer.
+	cacheCtx, _ := ctx.CacheContext()
+	usages, err := k.GetQuotaUsage(cacheCtx, channelID, denom)
+	if err != nil {
+		k.Logger(ctx).Debug("could not get quota usage", "channel", channelID, "denom", denom, "error", err)
+		return
+	}
+
+	for _, usage := range usages {
+		used, max, err := usage.UsedAndMax(direction)
+		if err != nil {
+			k.Logger(ctx).Debug("could not get quota usage", "channel", channelID, "denom", denom, "error", err)
+			continue
+		}
+
+		before, after := used, used.Add(amount)
+		if applied {
+			before, after = sdkmath.ZeroInt(), used
+			if used.GT(amount) {
+				before = used.Sub(amount)
+			}
+		}
+
+		for _, threshold := range ibcratelimit.CrossedThresholds(before, after, max) {
+			k.emitEvent(ctx, ibcratelimit.NewEventQuotaThresholdCrossed(
+				channelID, denom, usage.Name, direction, threshold, after.String(), max.String(),
+			))
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package keeper_test
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+	"github.com/provenance-io/provenance/x/ibcratelimit"
+)
+
+const testContract = "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma"
+
+// quotasResp creates a get_quotas contract response with a single quota using the provided flows.
+func quotasResp(inflow, outflow int64, periodEnd time.Time) []byte {
+	return []byte(fmt.Sprintf(`[{"quota":{"name":"weekly","max_percentage_send":50,"max_percentage_recv":20,`+
+		`"duration":604800,"channel_value":"1000"},"flow":{"inflow":"%d","outflow":"%d","period_end":"%d"}}]`,
+		inflow, outflow, periodEnd.UnixNano()))
+}
+
+func (s *TestSuite) TestGetQuotaUsage() {
+	now := time.Unix(1_700_000_000, 0).UTC()
+	periodEnd := now.Add(time.Hour)
+
+	tests := []struct {
+		name     string
+		contract string
+		viewer   *MockContractViewer
+		expUsage []ibcratelimit.QuotaUsage
+		expErr   string
+	}{
+		{
+			name:   "contract not configured",
+			viewer: NewMockContractViewer(quotasResp(0, 100, periodEnd), nil),
+		},
+		{
+			name:     "no contract viewer",
+			contract: testContract,
+		},
+		{
+			name:     "query error",
+			contract: testContract,
+			viewer:   NewMockContractViewer(nil, errors.New("no such path")),
+			expErr:   "no such path: contract error",
+		},
+		{
+			name:     "unparsable response",
+			contract: testContract,
+			viewer:   NewMockContractViewer([]byte("not json"), nil),
+			expErr:   "could not parse quotas: invalid character 'o' in literal null (expecting 'u'): contract error",
+		},
+		{
+			name:     "no quotas",
+			contract: testContract,
+			viewer:   NewMockContractViewer([]byte("[]"), nil),
+			expUsage: []ibcratelimit.QuotaUsage{},
+		},
+		{
+			name:     "one quota",
+			contract: testContract,
+			viewer:   NewMockContractViewer(quotasResp(25, 100, periodEnd), nil),
+			expUsage: []ibcratelimit.QuotaUsage{
+				{
+					Name:         "weekly",
+					Duration:     604800,
+					PeriodEnd:    periodEnd,
+					ChannelValue: "1000",
+					SendUsed:     "75",
+					SendMax:      "500",
+					RecvUsed:     "0",
+					RecvMax:      "200",
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			ctx, _ := s.ctx.WithBlockTime(now).CacheContext()
+			k := *s.app.RateLimitingKeeper
+			k.SetParams(ctx, ibcratelimit.NewParams(tc.contract))
+			k.ContractViewer = nil
+			if tc.viewer != nil {
+				k.ContractViewer = tc.viewer
+			}
+
+			usage, err := k.GetQuotaUsage(ctx, "channel-0", "nhash")
+			assertions.AssertErrorValue(s.T(), err, tc.expErr, "GetQuotaUsage error")
+			s.Assert().Equal(tc.expUsage, usage, "GetQuotaUsage usage")
+			if len(tc.contract) > 0 && tc.viewer != nil {
+				s.Assert().Equal([]string{`{"get_quotas":{"channel_id":"channel-0","denom":"nhash"}}`}, tc.viewer.queries, "queries")
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestCheckAndUpdateRateLimitsThresholdEvents() {
+	now := time.Unix(1_700_000_000, 0).UTC()
+	event := func(threshold uint32, used string) sdk.Event {
+		return *typedEventToEvent(ibcratelimit.NewEventQuotaThresholdCrossed(
+			"src-channel", "denom", "weekly", ibcratelimit.DirectionSend, threshold, used, "500"))
+	}
+
+	// The mock packets send 500 denom, and the quota allows sending 500.
+	tests := []struct {
+		name      string
+		viewer    *MockContractViewer
+		sudoValid bool
+		expEvents sdk.Events
+	}{
+		{
+			name:      "applied, below thresholds",
+			viewer:    NewMockContractViewer(quotasResp(500, 800, now.Add(time.Hour)), nil),
+			sudoValid: true,
+			expEvents: sdk.Events{},
+		},
+		{
+			name:      "applied, crosses 80",
+			viewer:    NewMockContractViewer(quotasResp(0, 400, now.Add(time.Hour)), nil),
+			sudoValid: true,
+			expEvents: sdk.Events{event(80, "400")},
+		},
+		{
+			name:      "applied, crosses both",
+			viewer:    NewMockContractViewer(quotasResp(0, 500, now.Add(time.Hour)), nil),
+			sudoValid: true,
+			expEvents: sdk.Events{event(80, "500"), event(100, "500")},
+		},
+		{
+			name:      "rejected, already over 80",
+			viewer:    NewMockContractViewer(quotasResp(0, 450, now.Add(time.Hour)), nil),
+			expEvents: sdk.Events{event(100, "950")},
+		},
+		{
+			name:      "expired window",
+			viewer:    NewMockContractViewer(quotasResp(0, 500, now.Add(-time.Hour)), nil),
+			sudoValid: true,
+			expEvents: sdk.Events{},
+		},
+		{
+			name:      "usage lookup error",
+			viewer:    NewMockContractViewer(nil, errors.New("query failed")),
+			sudoValid: true,
+			expEvents: sdk.Events{},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			ctx, _ := s.ctx.WithBlockTime(now).CacheContext()
+			em := sdk.NewEventManager()
+			ctx = ctx.WithEventManager(em)
+			k := *s.app.RateLimitingKeeper
+			k.SetParams(ctx, ibcratelimit.NewParams(testContract))
+			k.PermissionedKeeper = NewMockPermissionedKeeper(tc.sudoValid)
+			k.ContractViewer = tc.viewer
+
+			err := k.CheckAndUpdateRateLimits(ctx, ibcratelimit.MsgSendPacket, NewMockPacket(NewMockSerializedPacketData(), true))
+			if tc.sudoValid {
+				s.Assert().NoError(err, "CheckAndUpdateRateLimits")
+			} else {
+				s.Assert().Error(err, "CheckAndUpdateRateLimits")
+			}
+			s.Assert().Equal(tc.expEvents, em.Events(), "events emitted during CheckAndUpdateRateLimits")
+		})
+	}
+}
@@ -11,4 +11,14 @@ const (
 var (
 	// ParamsKey is the key to obtain the module's params.
 	ParamsKey = []byte{0x01}
+	// TrustedChannelKeyPrefix is the prefix of the keys for the channels that are exempt from rate limiting.
+	TrustedChannelKeyPrefix = []byte{0x02}
 )
+
+// GetTrustedChannelKey returns the store key for a trusted channel entry.
+func GetTrustedChannelKey(channelID string) []byte {
+	rv := make([]byte, 0, len(TrustedChannelKeyPrefix)+len(channelID))
+	rv = append(rv, TrustedChannelKeyPrefix...)
+	rv = append(rv, channelID...)
+	return rv
+}
@@ -135,8 +135,8 @@ func (im *IBCMiddleware) OnRecvPacket(
 		return ibc.NewEmitErrorAcknowledgement(ctx, ibcratelimit.ErrBadMessage, err.Error())
 	}
 
-	if !im.keeper.IsContractConfigured(ctx) {
-		// The contract has not been configured. Continue as usual
+	if !im.keeper.IsContractConfigured(ctx) || im.keeper.IsTrustedChannel(ctx, packet.GetDestChannel()) {
+		// The contract has not been configured, or the channel is exempt. Continue as usual
 		return im.app.OnRecvPacket(ctx, packet, relayer)
 	}
 
@@ -219,8 +219,8 @@ func (im *IBCMiddleware) SendPacket(
 		return im.channel.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
 	}
 
-	if !im.keeper.IsContractConfigured(ctx) {
-		// The contract has not been configured. Continue as usual
+	if !im.keeper.IsContractConfigured(ctx) || im.keeper.IsTrustedChannel(ctx, sourceChannel) {
+		// The contract has not been configured, or the channel is exempt. Continue as usual
 		return im.channel.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
 	}
 
@@ -442,6 +442,39 @@ func (suite *MiddlewareTestSuite) TestRecvTransferWithRateLimitingNonNative() {
 	suite.fullRecvTest(false)
 }
 
+// Test no rate limiting occurs on sends through a trusted channel
+func (suite *MiddlewareTestSuite) TestSendTransferTrustedChannel() {
+	// Use up the quota of channel-0
+	suite.fullSendTest(true)
+
+	// Move chainA forward one block to account for the failed send
+	suite.chainA.NextBlock()
+	err := suite.chainA.SenderAccount.SetSequence(suite.chainA.SenderAccount.GetSequence() + 1)
+	suite.Require().NoError(err, "SetSequence")
+
+	provenanceApp := suite.chainA.GetProvenanceApp()
+	err = provenanceApp.RateLimitingKeeper.UpdateTrustedChannels(suite.chainA.GetContext(), []string{"channel-0"}, nil)
+	suite.Require().NoError(err, "UpdateTrustedChannels")
+
+	// Sending above the quota should now succeed
+	_, err = suite.AssertSend(true, suite.MessageFromAToB(sdk.DefaultBondDenom, sdkmath.NewInt(2)))
+	suite.Assert().NoError(err)
+}
+
+// Test no rate limiting occurs on receives through a trusted channel
+func (suite *MiddlewareTestSuite) TestRecvTransferTrustedChannel() {
+	// Use up the quota of channel-0
+	suite.fullRecvTest(true)
+
+	provenanceApp := suite.chainA.GetProvenanceApp()
+	err := provenanceApp.RateLimitingKeeper.UpdateTrustedChannels(suite.chainA.GetContext(), []string{"channel-0"}, nil)
+	suite.Require().NoError(err, "UpdateTrustedChannels")
+
+	// Receiving above the quota should now succeed
+	_, err = suite.AssertReceive(true, suite.MessageFromBToA(sdk.DefaultBondDenom, sdkmath.NewInt(2)))
+	suite.Assert().NoError(err)
+}
+
 // Test no rate limiting occurs when the contract is set, but not quotas are condifured for the path
 func (suite *MiddlewareTestSuite) TestSendTransferNoQuota() {
 	// Setup contract
@@ -4,13 +4,17 @@ import (
 	"errors"
 	fmt "fmt"
 
+	cerrs "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 )
 
 // AllRequestMsgs defines all the Msg*Request messages.
 var AllRequestMsgs = []sdk.Msg{
 	(*MsgGovUpdateParamsRequest)(nil),
 	(*MsgUpdateParamsRequest)(nil),
+	(*MsgUpdateTrustedChannelsRequest)(nil),
 }
 
 // ValidateBasic runs stateless validation checks on the message.
@@ -32,3 +36,44 @@ func (m MsgUpdateParamsRequest) ValidateBasic() error {
 	}
 	return m.Params.Validate()
 }
+
+// NewMsgUpdateTrustedChannelsRequest creates a new UpdateTrustedChannels message.
+func NewMsgUpdateTrustedChannelsRequest(authority string, toAdd, toRemove []string) *MsgUpdateTrustedChannelsRequest {
+	return &MsgUpdateTrustedChannelsRequest{
+		Authority: authority,
+		ToAdd:     toAdd,
+		ToRemove:  toRemove,
+	}
+}
+
+// ValidateBasic runs stateless validation checks on the message.
+func (m MsgUpdateTrustedChannelsRequest) ValidateBasic() error {
+	var errs []error
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		errs = append(errs, fmt.Errorf("invalid authority: %w", err))
+	}
+	if len(m.ToAdd) == 0 && len(m.ToRemove) == 0 {
+		errs = append(errs, errors.New("no channels to add or remove"))
+	}
+
+	seen := make(map[string]string, len(m.ToAdd)+len(m.ToRemove))
+	check := func(field, channelID string) {
+		if err := host.ChannelIdentifierValidator(channelID); err != nil {
+			errs = append(errs, cerrs.Wrapf(err, "invalid %s entry %q", field, channelID))
+			return
+		}
+		if prev, dup := seen[channelID]; dup {
+			errs = append(errs, fmt.Errorf("invalid %s entry: %q already in %s", field, channelID, prev))
+			return
+		}
+		seen[channelID] = field
+	}
+	for _, channelID := range m.ToAdd {
+		check("to add", channelID)
+	}
+	for _, channelID := range m.ToRemove {
+		check("to remove", channelID)
+	}
+
+	return errors.Join(errs...)
+}
@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/testutil"
+	"github.com/provenance-io/provenance/testutil/assertions"
 
 	. "github.com/provenance-io/provenance/x/ibcratelimit"
 )
@@ -16,6 +17,7 @@ func TestAllMsgsGetSigners(t *testing.T) {
 	msgMakers := []testutil.MsgMaker{
 		func(signer string) sdk.Msg { return &MsgGovUpdateParamsRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgUpdateParamsRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgUpdateTrustedChannelsRequest{Authority: signer} },
 	}
 
 	testutil.RunGetSignersTests(t, AllRequestMsgs, msgMakers, nil)
@@ -74,3 +76,45 @@ func TestNewMsgUpdateParamsValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgUpdateTrustedChannelsValidateBasic(t *testing.T) {
+	authority := "cosmos1qm0hhug8kszhcp9f3ryuecz5yw8s3e5v0n2ckd"
+
+	tests := []struct {
+		name   string
+		msg    *MsgUpdateTrustedChannelsRequest
+		expErr []string
+	}{
+		{
+			name: "success - add and remove",
+			msg:  NewMsgUpdateTrustedChannelsRequest(authority, []string{"channel-0"}, []string{"channel-1"}),
+		},
+		{
+			name:   "failure - invalid authority",
+			msg:    NewMsgUpdateTrustedChannelsRequest("authority", []string{"channel-0"}, nil),
+			expErr: []string{"invalid authority: decoding bech32 failed: invalid separator index -1"},
+		},
+		{
+			name:   "failure - nothing to add or remove",
+			msg:    NewMsgUpdateTrustedChannelsRequest(authority, nil, nil),
+			expErr: []string{"no channels to add or remove"},
+		},
+		{
+			name:   "failure - invalid channel",
+			msg:    NewMsgUpdateTrustedChannelsRequest(authority, []string{"bad"}, nil),
+			expErr: []string{`invalid to add entry "bad": identifier bad has invalid length`},
+		},
+		{
+			name:   "failure - added and removed",
+			msg:    NewMsgUpdateTrustedChannelsRequest(authority, []string{"channel-0"}, []string{"channel-0"}),
+			expErr: []string{`invalid to remove entry: "channel-0" already in to add`},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			assertions.AssertErrorContents(t, err, tc.expErr, "ValidateBasic")
+		})
+	}
+}
@@ -1,7 +1,10 @@
 package ibcratelimit
 
 import (
+	"crypto/sha256"
 	"encoding/json"
+	"fmt"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -16,6 +19,11 @@ const (
 	MsgSendPacket = "send_packet"
 	// MsgRecvPacket is the operation used for tracking a received packet.
 	MsgRecvPacket = "recv_packet"
+
+	// DirectionSend is the direction of packets sent from this chain.
+	DirectionSend = "send"
+	// DirectionRecv is the direction of packets received by this chain.
+	DirectionRecv = "recv"
 )
 
 // UndoSendMsg is an ibcratelimit contract message meant to undo tracked sends.
@@ -92,3 +100,42 @@ func UnwrapPacket(packet exported.PacketI) (UnwrappedPacket, error) {
 		TimeoutTimestamp:   packet.GetTimeoutTimestamp(),
 	}, nil
 }
+
+// Direction returns the direction (DirectionSend or DirectionRecv) tracked by the provided contract operation.
+func Direction(msgType string) string {
+	if msgType == MsgSendPacket {
+		return DirectionSend
+	}
+	return DirectionRecv
+}
+
+// LocalPath returns the channel and denom (both as known on this chain) that the
+// rate limit contract uses to track this packet when going in the given direction.
+// This mirrors the path logic of the contract.
+func (p UnwrappedPacket) LocalPath(direction string) (channelID, denom string) {
+	if direction == DirectionSend {
+		// For native tokens we just use what's on the packet. Otherwise we need the IBC denom.
+		if !strings.HasPrefix(p.Data.Denom, "transfer/") {
+			return p.SourceChannel, p.Data.Denom
+		}
+		return p.SourceChannel, hashDenom(p.Data.Denom)
+	}
+
+	sourcePrefix := fmt.Sprintf("transfer/%s/", p.SourceChannel)
+	if strings.HasPrefix(p.Data.Denom, sourcePrefix) {
+		// These are tokens that were sent to the counterparty and are returning.
+		unprefixed := strings.TrimPrefix(p.Data.Denom, sourcePrefix)
+		if !strings.Contains(unprefixed, "/") {
+			return p.DestinationChannel, unprefixed
+		}
+		return p.DestinationChannel, hashDenom(unprefixed)
+	}
+
+	// Tokens that come directly from the counterparty. The sender didn't prefix them, so we do it here.
+	return p.DestinationChannel, hashDenom(fmt.Sprintf("transfer/%s/%s", p.DestinationChannel, p.Data.Denom))
+}
+
+// hashDenom converts a full denom trace path into its IBC denom.
+func hashDenom(denomPath string) string {
+	return fmt.Sprintf("ibc/%X", sha256.Sum256([]byte(denomPath)))
+}
@@ -128,3 +128,63 @@ func TestUnwrapPacket(t *testing.T) {
 		})
 	}
 }
+
+func TestLocalPath(t *testing.T) {
+	newPacket := func(denom string) ibcratelimit.UnwrappedPacket {
+		rv := ibcratelimit.UnwrappedPacket{SourceChannel: "channel-1", DestinationChannel: "channel-0"}
+		rv.Data.Denom = denom
+		return rv
+	}
+
+	tests := []struct {
+		name       string
+		packet     ibcratelimit.UnwrappedPacket
+		direction  string
+		expChannel string
+		expDenom   string
+	}{
+		{
+			name:       "send native",
+			packet:     newPacket("nhash"),
+			direction:  ibcratelimit.DirectionSend,
+			expChannel: "channel-1",
+			expDenom:   "nhash",
+		},
+		{
+			name:       "send non-native",
+			packet:     newPacket("transfer/channel-0/stake"),
+			direction:  ibcratelimit.DirectionSend,
+			expChannel: "channel-1",
+			expDenom:   "ibc/C053D637CCA2A2BA030E2C5EE1B28A16F71CCB0E45E8BE52766DC1B241B77878",
+		},
+		{
+			name:       "recv returning native",
+			packet:     newPacket("transfer/channel-1/nhash"),
+			direction:  ibcratelimit.DirectionRecv,
+			expChannel: "channel-0",
+			expDenom:   "nhash",
+		},
+		{
+			name:       "recv returning non-native",
+			packet:     newPacket("transfer/channel-1/transfer/channel-0/stake"),
+			direction:  ibcratelimit.DirectionRecv,
+			expChannel: "channel-0",
+			expDenom:   "ibc/C053D637CCA2A2BA030E2C5EE1B28A16F71CCB0E45E8BE52766DC1B241B77878",
+		},
+		{
+			name:       "recv from counterparty",
+			packet:     newPacket("stake"),
+			direction:  ibcratelimit.DirectionRecv,
+			expChannel: "channel-0",
+			expDenom:   "ibc/C053D637CCA2A2BA030E2C5EE1B28A16F71CCB0E45E8BE52766DC1B241B77878",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			channel, denom := tc.packet.LocalPath(tc.direction)
+			assert.Equal(t, tc.expChannel, channel, "LocalPath channel")
+			assert.Equal(t, tc.expDenom, denom, "LocalPath denom")
+		})
+	}
+}
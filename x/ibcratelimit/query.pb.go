@@ -9,19 +9,23 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -112,9 +116,307 @@ func (m *ParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// TrustedChannelsRequest is the request type for the Query/TrustedChannels RPC method.
+type TrustedChannelsRequest struct {
+}
+
+func (m *TrustedChannelsRequest) Reset()         { *m = TrustedChannelsRequest{} }
+func (m *TrustedChannelsRequest) String() string { return proto.CompactTextString(m) }
+func (*TrustedChannelsRequest) ProtoMessage()    {}
+func (*TrustedChannelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_530d9ff030c0dc3e, []int{2}
+}
+func (m *TrustedChannelsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrustedChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrustedChannelsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrustedChannelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrustedChannelsRequest.Merge(m, src)
+}
+func (m *TrustedChannelsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrustedChannelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrustedChannelsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrustedChannelsRequest proto.InternalMessageInfo
+
+// TrustedChannelsResponse is the response type for the Query/TrustedChannels RPC method.
+type TrustedChannelsResponse struct {
+	// channels are the ids of the channels that are exempt from rate limiting.
+	Channels []string `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+}
+
+func (m *TrustedChannelsResponse) Reset()         { *m = TrustedChannelsResponse{} }
+func (m *TrustedChannelsResponse) String() string { return proto.CompactTextString(m) }
+func (*TrustedChannelsResponse) ProtoMessage()    {}
+func (*TrustedChannelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_530d9ff030c0dc3e, []int{3}
+}
+func (m *TrustedChannelsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrustedChannelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrustedChannelsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrustedChannelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrustedChannelsResponse.Merge(m, src)
+}
+func (m *TrustedChannelsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrustedChannelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrustedChannelsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrustedChannelsResponse proto.InternalMessageInfo
+
+func (m *TrustedChannelsResponse) GetChannels() []string {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+// UsageRequest is the request type for the Query/Usage RPC method.
+type UsageRequest struct {
+	// channel_id is the id of the channel (on this chain) to look up.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// denom is the denom (as known on this chain) to look up, e.g. "nhash" or "ibc/...".
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *UsageRequest) Reset()         { *m = UsageRequest{} }
+func (m *UsageRequest) String() string { return proto.CompactTextString(m) }
+func (*UsageRequest) ProtoMessage()    {}
+func (*UsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_530d9ff030c0dc3e, []int{4}
+}
+func (m *UsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsageRequest.Merge(m, src)
+}
+func (m *UsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UsageRequest proto.InternalMessageInfo
+
+func (m *UsageRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *UsageRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// UsageResponse is the response type for the Query/Usage RPC method.
+type UsageResponse struct {
+	// quotas are the current usage of each quota of the channel and denom.
+	Quotas []QuotaUsage `protobuf:"bytes,1,rep,name=quotas,proto3" json:"quotas"`
+}
+
+func (m *UsageResponse) Reset()         { *m = UsageResponse{} }
+func (m *UsageResponse) String() string { return proto.CompactTextString(m) }
+func (*UsageResponse) ProtoMessage()    {}
+func (*UsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_530d9ff030c0dc3e, []int{5}
+}
+func (m *UsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsageResponse.Merge(m, src)
+}
+func (m *UsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UsageResponse proto.InternalMessageInfo
+
+func (m *UsageResponse) GetQuotas() []QuotaUsage {
+	if m != nil {
+		return m.Quotas
+	}
+	return nil
+}
+
+// QuotaUsage is the consumption of a single rate limit quota during its current window.
+type QuotaUsage struct {
+	// name is the name of the quota, e.g. "weekly".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// duration is the length (in seconds) of the quota's window.
+	Duration uint64 `protobuf:"varint,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	// period_end is when the current window ends.
+	PeriodEnd time.Time `protobuf:"bytes,3,opt,name=period_end,json=periodEnd,proto3,stdtime" json:"period_end"`
+	// channel_value is the value of the denom in the channel, as of the start of the current window.
+	ChannelValue string `protobuf:"bytes,4,opt,name=channel_value,json=channelValue,proto3" json:"channel_value,omitempty"`
+	// send_used is the net amount sent during the current window.
+	SendUsed string `protobuf:"bytes,5,opt,name=send_used,json=sendUsed,proto3" json:"send_used,omitempty"`
+	// send_max is the max net amount that can be sent during a window.
+	SendMax string `protobuf:"bytes,6,opt,name=send_max,json=sendMax,proto3" json:"send_max,omitempty"`
+	// recv_used is the net amount received during the current window.
+	RecvUsed string `protobuf:"bytes,7,opt,name=recv_used,json=recvUsed,proto3" json:"recv_used,omitempty"`
+	// recv_max is the max net amount that can be received during a window.
+	RecvMax string `protobuf:"bytes,8,opt,name=recv_max,json=recvMax,proto3" json:"recv_max,omitempty"`
+}
+
+func (m *QuotaUsage) Reset()         { *m = QuotaUsage{} }
+func (m *QuotaUsage) String() string { return proto.CompactTextString(m) }
+func (*QuotaUsage) ProtoMessage()    {}
+func (*QuotaUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_530d9ff030c0dc3e, []int{6}
+}
+func (m *QuotaUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuotaUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuotaUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuotaUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaUsage.Merge(m, src)
+}
+func (m *QuotaUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuotaUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotaUsage proto.InternalMessageInfo
+
+func (m *QuotaUsage) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *QuotaUsage) GetDuration() uint64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *QuotaUsage) GetPeriodEnd() time.Time {
+	if m != nil {
+		return m.PeriodEnd
+	}
+	return time.Time{}
+}
+
+func (m *QuotaUsage) GetChannelValue() string {
+	if m != nil {
+		return m.ChannelValue
+	}
+	return ""
+}
+
+func (m *QuotaUsage) GetSendUsed() string {
+	if m != nil {
+		return m.SendUsed
+	}
+	return ""
+}
+
+func (m *QuotaUsage) GetSendMax() string {
+	if m != nil {
+		return m.SendMax
+	}
+	return ""
+}
+
+func (m *QuotaUsage) GetRecvUsed() string {
+	if m != nil {
+		return m.RecvUsed
+	}
+	return ""
+}
+
+func (m *QuotaUsage) GetRecvMax() string {
+	if m != nil {
+		return m.RecvMax
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ParamsRequest)(nil), "provenance.ibcratelimit.v1.ParamsRequest")
 	proto.RegisterType((*ParamsResponse)(nil), "provenance.ibcratelimit.v1.ParamsResponse")
+	proto.RegisterType((*TrustedChannelsRequest)(nil), "provenance.ibcratelimit.v1.TrustedChannelsRequest")
+	proto.RegisterType((*TrustedChannelsResponse)(nil), "provenance.ibcratelimit.v1.TrustedChannelsResponse")
+	proto.RegisterType((*UsageRequest)(nil), "provenance.ibcratelimit.v1.UsageRequest")
+	proto.RegisterType((*UsageResponse)(nil), "provenance.ibcratelimit.v1.UsageResponse")
+	proto.RegisterType((*QuotaUsage)(nil), "provenance.ibcratelimit.v1.QuotaUsage")
 }
 
 func init() {
@@ -122,25 +424,46 @@ func init() {
 }
 
 var fileDescriptor_530d9ff030c0dc3e = []byte{
-	// 285 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2b, 0x28, 0xca, 0x2f,
-	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0xcf, 0x4c, 0x4a, 0x2e, 0x4a, 0x2c, 0x49, 0xcd, 0xc9,
-	0xcc, 0xcd, 0x2c, 0xd1, 0x2f, 0x33, 0xd4, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x92, 0x42, 0xa8, 0xd3, 0x43, 0x56, 0xa7, 0x57, 0x66, 0x28, 0x25, 0x92, 0x9e,
-	0x9f, 0x9e, 0x0f, 0x56, 0xa6, 0x0f, 0x62, 0x41, 0x74, 0x48, 0xc9, 0xa4, 0xe7, 0xe7, 0xa7, 0xe7,
-	0xa4, 0xea, 0x27, 0x16, 0x64, 0xea, 0x27, 0xe6, 0xe5, 0xe5, 0x97, 0x24, 0x96, 0x64, 0xe6, 0xe7,
-	0x15, 0x43, 0x65, 0xd5, 0xf1, 0xd8, 0x5b, 0x90, 0x58, 0x94, 0x98, 0x0b, 0x55, 0xa8, 0xc4, 0xcf,
-	0xc5, 0x1b, 0x00, 0xe6, 0x07, 0xa5, 0x16, 0x96, 0xa6, 0x16, 0x97, 0x28, 0x05, 0x71, 0xf1, 0xc1,
-	0x04, 0x8a, 0x0b, 0xf2, 0xf3, 0x8a, 0x53, 0x85, 0x1c, 0xb8, 0xd8, 0x20, 0x5a, 0x24, 0x18, 0x15,
-	0x18, 0x35, 0xb8, 0x8d, 0x94, 0xf4, 0x70, 0x3b, 0x56, 0x0f, 0xa2, 0xd7, 0x89, 0xe5, 0xc4, 0x3d,
-	0x79, 0x86, 0x20, 0xa8, 0x3e, 0xa3, 0xa9, 0x8c, 0x5c, 0xac, 0x81, 0x20, 0xdf, 0x0a, 0x75, 0x33,
-	0x72, 0xb1, 0x41, 0x94, 0x08, 0x69, 0x12, 0x36, 0x06, 0xea, 0x26, 0x29, 0x2d, 0x62, 0x94, 0x42,
-	0x5c, 0xab, 0xa4, 0xd5, 0x74, 0xf9, 0xc9, 0x64, 0x26, 0x15, 0x21, 0x25, 0x7d, 0x82, 0x41, 0xe0,
-	0x94, 0x7b, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78,
-	0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x5c, 0xb2, 0x99, 0xf9, 0x78,
-	0xec, 0x0c, 0x60, 0x8c, 0x32, 0x4a, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0x45,
-	0xb2, 0x48, 0x37, 0x33, 0x1f, 0xd9, 0xda, 0x0a, 0x14, 0x8b, 0x93, 0xd8, 0xc0, 0x41, 0x6e, 0x0c,
-	0x08, 0x00, 0x00, 0xff, 0xff, 0xae, 0xf7, 0xe5, 0x5c, 0x15, 0x02, 0x00, 0x00,
+	// 618 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4f, 0x6f, 0xd3, 0x4e,
+	0x10, 0x8d, 0xdb, 0x24, 0x4d, 0xa6, 0xed, 0xaf, 0xd2, 0xaa, 0xfa, 0x61, 0x0c, 0x75, 0x8b, 0x8b,
+	0x4a, 0x5b, 0x81, 0xad, 0xa6, 0x70, 0x47, 0x2d, 0x1c, 0x38, 0x54, 0x6a, 0xad, 0x96, 0x03, 0x97,
+	0x68, 0x13, 0x2f, 0xae, 0xa5, 0x78, 0xd7, 0xb1, 0xd7, 0x51, 0xb8, 0x72, 0xe1, 0xc0, 0xa5, 0x12,
+	0x12, 0x9f, 0x83, 0x8f, 0xd1, 0x63, 0x05, 0x17, 0x4e, 0x80, 0x12, 0x3e, 0x08, 0xda, 0x3f, 0x4e,
+	0xc3, 0x3f, 0xb7, 0xdc, 0x76, 0x66, 0xde, 0xdb, 0xf7, 0x76, 0x67, 0x06, 0x36, 0x92, 0x94, 0x0d,
+	0x08, 0xc5, 0xb4, 0x4b, 0xbc, 0xa8, 0xd3, 0x4d, 0x31, 0x27, 0xbd, 0x28, 0x8e, 0xb8, 0x37, 0xd8,
+	0xf1, 0xfa, 0x39, 0x49, 0x5f, 0xb9, 0x49, 0xca, 0x38, 0x43, 0xd6, 0x25, 0xce, 0x9d, 0xc6, 0xb9,
+	0x83, 0x1d, 0x6b, 0x39, 0x64, 0x21, 0x93, 0x30, 0x4f, 0x9c, 0x14, 0xc3, 0xba, 0x1d, 0x32, 0x16,
+	0xf6, 0x88, 0x87, 0x93, 0xc8, 0xc3, 0x94, 0x32, 0x8e, 0x79, 0xc4, 0x68, 0xa6, 0xab, 0xab, 0xba,
+	0x2a, 0xa3, 0x4e, 0xfe, 0xd2, 0xe3, 0x51, 0x4c, 0x32, 0x8e, 0xe3, 0x44, 0x03, 0xee, 0x95, 0x18,
+	0x4b, 0x70, 0x8a, 0x63, 0x7d, 0x93, 0xb3, 0x04, 0x8b, 0x87, 0x32, 0xf6, 0x49, 0x3f, 0x27, 0x19,
+	0x77, 0x7c, 0xf8, 0xaf, 0x48, 0x64, 0x09, 0xa3, 0x19, 0x41, 0x8f, 0xa1, 0xae, 0x28, 0xa6, 0xb1,
+	0x66, 0x6c, 0xce, 0xb7, 0x1c, 0xf7, 0xef, 0xaf, 0x71, 0x15, 0x77, 0xaf, 0x7a, 0xfe, 0x65, 0xb5,
+	0xe2, 0x6b, 0x9e, 0x63, 0xc2, 0xff, 0xc7, 0x69, 0x9e, 0x71, 0x12, 0xec, 0x9f, 0x62, 0x4a, 0x49,
+	0x6f, 0xa2, 0xf6, 0x08, 0x6e, 0xfc, 0x56, 0xd1, 0xb2, 0x16, 0x34, 0xba, 0x3a, 0x67, 0x1a, 0x6b,
+	0xb3, 0x9b, 0x4d, 0x7f, 0x12, 0x3b, 0xfb, 0xb0, 0x70, 0x92, 0xe1, 0x90, 0xe8, 0x6b, 0xd0, 0x0a,
+	0x80, 0xae, 0xb5, 0xa3, 0x40, 0xda, 0x6c, 0xfa, 0x4d, 0x9d, 0x79, 0x16, 0xa0, 0x65, 0xa8, 0x05,
+	0x84, 0xb2, 0xd8, 0x9c, 0x91, 0x15, 0x15, 0x38, 0x27, 0xb0, 0xa8, 0x2f, 0xd1, 0x8a, 0x4f, 0xa0,
+	0xde, 0xcf, 0x19, 0xc7, 0x4a, 0x6f, 0xbe, 0xb5, 0x51, 0xf6, 0xd0, 0x23, 0x81, 0x94, 0xfc, 0xe2,
+	0xb1, 0x8a, 0xeb, 0xbc, 0x9f, 0x01, 0xb8, 0x2c, 0x22, 0x04, 0x55, 0x8a, 0x63, 0xa2, 0x4d, 0xc9,
+	0xb3, 0x78, 0x5a, 0x90, 0xa7, 0xb2, 0xa3, 0xd2, 0x52, 0xd5, 0x9f, 0xc4, 0x68, 0x1f, 0x20, 0x21,
+	0x69, 0xc4, 0x82, 0x36, 0xa1, 0x81, 0x39, 0x2b, 0x7f, 0xdc, 0x72, 0x55, 0xbf, 0xdd, 0xa2, 0xdf,
+	0xee, 0x71, 0xd1, 0xef, 0xbd, 0x86, 0x10, 0x3f, 0xfb, 0xba, 0x6a, 0xf8, 0x4d, 0xc5, 0x7b, 0x4a,
+	0x03, 0xb4, 0x0e, 0x8b, 0xc5, 0x7f, 0x0c, 0x70, 0x2f, 0x27, 0x66, 0x55, 0xaa, 0x2f, 0xe8, 0xe4,
+	0x73, 0x91, 0x43, 0xb7, 0xa0, 0x99, 0x11, 0x1a, 0xb4, 0xf3, 0x8c, 0x04, 0x66, 0x4d, 0x02, 0x1a,
+	0x22, 0x71, 0x92, 0x91, 0x00, 0xdd, 0x04, 0x79, 0x6e, 0xc7, 0x78, 0x68, 0xd6, 0x65, 0x6d, 0x4e,
+	0xc4, 0x07, 0x78, 0x28, 0x78, 0x29, 0xe9, 0x0e, 0x14, 0x6f, 0x4e, 0xf1, 0x44, 0xa2, 0xe0, 0xc9,
+	0xa2, 0xe0, 0x35, 0x14, 0x4f, 0xc4, 0x07, 0x78, 0xd8, 0xfa, 0x38, 0x0b, 0xb5, 0x23, 0xb1, 0x14,
+	0xe8, 0xad, 0x01, 0x75, 0x35, 0x28, 0x68, 0xeb, 0xea, 0x61, 0xd2, 0x4d, 0xb6, 0xb6, 0xaf, 0x03,
+	0x55, 0xad, 0x74, 0xb6, 0x5f, 0x7f, 0xfa, 0xfe, 0x6e, 0xe6, 0x2e, 0x72, 0xbc, 0x2b, 0x17, 0x01,
+	0x7d, 0x30, 0x60, 0xe9, 0x97, 0x21, 0x44, 0xad, 0x32, 0xad, 0x3f, 0xcf, 0xb2, 0xb5, 0xfb, 0x4f,
+	0x1c, 0x6d, 0xf4, 0xa1, 0x34, 0xea, 0xa2, 0xfb, 0x65, 0x46, 0xb9, 0x22, 0xb7, 0x8b, 0xf9, 0x47,
+	0x6f, 0x0c, 0xa8, 0xa9, 0xf1, 0xda, 0x2c, 0x13, 0x9d, 0xde, 0x11, 0x6b, 0xeb, 0x1a, 0x48, 0x6d,
+	0x6a, 0x4b, 0x9a, 0x5a, 0x47, 0x77, 0xca, 0x4c, 0xe5, 0x72, 0xf6, 0xe3, 0xf3, 0x91, 0x6d, 0x5c,
+	0x8c, 0x6c, 0xe3, 0xdb, 0xc8, 0x36, 0xce, 0xc6, 0x76, 0xe5, 0x62, 0x6c, 0x57, 0x3e, 0x8f, 0xed,
+	0x0a, 0xac, 0x44, 0xac, 0x44, 0xf1, 0xd0, 0x78, 0xd1, 0x0a, 0x23, 0x7e, 0x9a, 0x77, 0xdc, 0x2e,
+	0x8b, 0xa7, 0x74, 0x1e, 0x44, 0x6c, 0x5a, 0x75, 0xf8, 0x93, 0x6e, 0xa7, 0x2e, 0x37, 0x60, 0xf7,
+	0xc7, 0x00, 0x69, 0x96, 0xc4, 0x9e, 0x79, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -158,6 +481,10 @@ type QueryClient interface {
 	// Params defines a gRPC query method that returns the ibcratelimit module's
 	// parameters.
 	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// TrustedChannels returns the ids of the channels that are exempt from rate limiting.
+	TrustedChannels(ctx context.Context, in *TrustedChannelsRequest, opts ...grpc.CallOption) (*TrustedChannelsResponse, error)
+	// Usage returns the current consumption of each of the rate limit quotas of a channel and denom.
+	Usage(ctx context.Context, in *UsageRequest, opts ...grpc.CallOption) (*UsageResponse, error)
 }
 
 type queryClient struct {
@@ -177,11 +504,33 @@ func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grp
 	return out, nil
 }
 
+func (c *queryClient) TrustedChannels(ctx context.Context, in *TrustedChannelsRequest, opts ...grpc.CallOption) (*TrustedChannelsResponse, error) {
+	out := new(TrustedChannelsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.ibcratelimit.v1.Query/TrustedChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Usage(ctx context.Context, in *UsageRequest, opts ...grpc.CallOption) (*UsageResponse, error) {
+	out := new(UsageResponse)
+	err := c.cc.Invoke(ctx, "/provenance.ibcratelimit.v1.Query/Usage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params defines a gRPC query method that returns the ibcratelimit module's
 	// parameters.
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// TrustedChannels returns the ids of the channels that are exempt from rate limiting.
+	TrustedChannels(context.Context, *TrustedChannelsRequest) (*TrustedChannelsResponse, error)
+	// Usage returns the current consumption of each of the rate limit quotas of a channel and denom.
+	Usage(context.Context, *UsageRequest) (*UsageResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -191,6 +540,12 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) TrustedChannels(ctx context.Context, req *TrustedChannelsRequest) (*TrustedChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TrustedChannels not implemented")
+}
+func (*UnimplementedQueryServer) Usage(ctx context.Context, req *UsageRequest) (*UsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Usage not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -214,6 +569,42 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TrustedChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrustedChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TrustedChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.ibcratelimit.v1.Query/TrustedChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TrustedChannels(ctx, req.(*TrustedChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Usage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Usage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.ibcratelimit.v1.Query/Usage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Usage(ctx, req.(*UsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.ibcratelimit.v1.Query",
@@ -223,6 +614,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "TrustedChannels",
+			Handler:    _Query_TrustedChannels_Handler,
+		},
+		{
+			MethodName: "Usage",
+			Handler:    _Query_Usage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/ibcratelimit/v1/query.proto",
@@ -284,44 +683,345 @@ func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TrustedChannelsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *TrustedChannelsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrustedChannelsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TrustedChannelsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *TrustedChannelsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *TrustedChannelsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Channels) > 0 {
+		for iNdEx := len(m.Channels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Channels[iNdEx])
+			copy(dAtA[i:], m.Channels[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Channels[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
-func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+
+func (m *UsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Quotas) > 0 {
+		for iNdEx := len(m.Quotas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Quotas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuotaUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuotaUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuotaUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecvMax) > 0 {
+		i -= len(m.RecvMax)
+		copy(dAtA[i:], m.RecvMax)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecvMax)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.RecvUsed) > 0 {
+		i -= len(m.RecvUsed)
+		copy(dAtA[i:], m.RecvUsed)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecvUsed)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.SendMax) > 0 {
+		i -= len(m.SendMax)
+		copy(dAtA[i:], m.SendMax)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SendMax)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SendUsed) > 0 {
+		i -= len(m.SendUsed)
+		copy(dAtA[i:], m.SendUsed)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SendUsed)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ChannelValue) > 0 {
+		i -= len(m.ChannelValue)
+		copy(dAtA[i:], m.ChannelValue)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelValue)))
+		i--
+		dAtA[i] = 0x22
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PeriodEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodEnd):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintQuery(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if m.Duration != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *TrustedChannelsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *TrustedChannelsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Channels) > 0 {
+		for _, s := range m.Channels {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Quotas) > 0 {
+		for _, e := range m.Quotas {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuotaUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovQuery(uint64(m.Duration))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodEnd)
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ChannelValue)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SendUsed)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SendMax)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RecvUsed)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RecvMax)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -454,6 +1154,630 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TrustedChannelsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrustedChannelsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrustedChannelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TrustedChannelsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrustedChannelsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrustedChannelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channels = append(m.Channels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UsageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UsageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quotas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quotas = append(m.Quotas, QuotaUsage{})
+			if err := m.Quotas[len(m.Quotas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuotaUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuotaUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuotaUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodEnd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PeriodEnd, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendUsed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendUsed = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendMax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendMax = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvUsed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecvUsed = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvMax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecvMax = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -34,7 +34,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { contract = ContractFn(r, simState.Accounts) },
 	)
 
-	genesis := ibcratelimit.NewGenesisState(ibcratelimit.NewParams(contract))
+	genesis := ibcratelimit.NewGenesisState(ibcratelimit.NewParams(contract), nil)
 	simState.GenState[ibcratelimit.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
 
 	bz, err := json.MarshalIndent(simState.GenState[ibcratelimit.ModuleName], "", " ")
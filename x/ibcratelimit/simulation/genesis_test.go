@@ -66,7 +66,8 @@ func TestRandomizedGenState(t *testing.T) {
 			seed:     0,
 			accounts: nil,
 			expRateLimitGen: &ibcratelimit.GenesisState{
-				Params: ibcratelimit.NewParams(""),
+				Params:          ibcratelimit.NewParams(""),
+				TrustedChannels: []string{},
 			},
 		},
 		{
@@ -74,7 +75,8 @@ func TestRandomizedGenState(t *testing.T) {
 			seed:     1,
 			accounts: accs,
 			expRateLimitGen: &ibcratelimit.GenesisState{
-				Params: ibcratelimit.NewParams(""),
+				Params:          ibcratelimit.NewParams(""),
+				TrustedChannels: []string{},
 			},
 		},
 		{
@@ -82,7 +84,8 @@ func TestRandomizedGenState(t *testing.T) {
 			seed:     2,
 			accounts: accs,
 			expRateLimitGen: &ibcratelimit.GenesisState{
-				Params: ibcratelimit.NewParams("cosmos12jszjrc0qhjt0ugt2uh4ptwu0h55pq6qfp9ecl"),
+				Params:          ibcratelimit.NewParams("cosmos12jszjrc0qhjt0ugt2uh4ptwu0h55pq6qfp9ecl"),
+				TrustedChannels: []string{},
 			},
 		},
 	}
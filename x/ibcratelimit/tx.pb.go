@@ -220,11 +220,114 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgUpdateTrustedChannelsRequest is a request message for the UpdateTrustedChannels endpoint.
+type MsgUpdateTrustedChannelsRequest struct {
+	// authority should be the governance module account address.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// to_add are the ids of the channels to exempt from rate limiting.
+	ToAdd []string `protobuf:"bytes,2,rep,name=to_add,json=toAdd,proto3" json:"to_add,omitempty"`
+	// to_remove are the ids of the channels that should no longer be exempt from rate limiting.
+	ToRemove []string `protobuf:"bytes,3,rep,name=to_remove,json=toRemove,proto3" json:"to_remove,omitempty"`
+}
+
+func (m *MsgUpdateTrustedChannelsRequest) Reset()         { *m = MsgUpdateTrustedChannelsRequest{} }
+func (m *MsgUpdateTrustedChannelsRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTrustedChannelsRequest) ProtoMessage()    {}
+func (*MsgUpdateTrustedChannelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e09935355436fc3e, []int{4}
+}
+func (m *MsgUpdateTrustedChannelsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateTrustedChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateTrustedChannelsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateTrustedChannelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTrustedChannelsRequest.Merge(m, src)
+}
+func (m *MsgUpdateTrustedChannelsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateTrustedChannelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTrustedChannelsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateTrustedChannelsRequest proto.InternalMessageInfo
+
+func (m *MsgUpdateTrustedChannelsRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateTrustedChannelsRequest) GetToAdd() []string {
+	if m != nil {
+		return m.ToAdd
+	}
+	return nil
+}
+
+func (m *MsgUpdateTrustedChannelsRequest) GetToRemove() []string {
+	if m != nil {
+		return m.ToRemove
+	}
+	return nil
+}
+
+// MsgUpdateTrustedChannelsResponse is a response message for the UpdateTrustedChannels endpoint.
+type MsgUpdateTrustedChannelsResponse struct {
+}
+
+func (m *MsgUpdateTrustedChannelsResponse) Reset()         { *m = MsgUpdateTrustedChannelsResponse{} }
+func (m *MsgUpdateTrustedChannelsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTrustedChannelsResponse) ProtoMessage()    {}
+func (*MsgUpdateTrustedChannelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e09935355436fc3e, []int{5}
+}
+func (m *MsgUpdateTrustedChannelsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateTrustedChannelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateTrustedChannelsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateTrustedChannelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTrustedChannelsResponse.Merge(m, src)
+}
+func (m *MsgUpdateTrustedChannelsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateTrustedChannelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTrustedChannelsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateTrustedChannelsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgGovUpdateParamsRequest)(nil), "provenance.ibcratelimit.v1.MsgGovUpdateParamsRequest")
 	proto.RegisterType((*MsgGovUpdateParamsResponse)(nil), "provenance.ibcratelimit.v1.MsgGovUpdateParamsResponse")
 	proto.RegisterType((*MsgUpdateParamsRequest)(nil), "provenance.ibcratelimit.v1.MsgUpdateParamsRequest")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "provenance.ibcratelimit.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgUpdateTrustedChannelsRequest)(nil), "provenance.ibcratelimit.v1.MsgUpdateTrustedChannelsRequest")
+	proto.RegisterType((*MsgUpdateTrustedChannelsResponse)(nil), "provenance.ibcratelimit.v1.MsgUpdateTrustedChannelsResponse")
 }
 
 func init() {
@@ -232,32 +335,38 @@ func init() {
 }
 
 var fileDescriptor_e09935355436fc3e = []byte{
-	// 396 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2e, 0x28, 0xca, 0x2f,
-	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0xcf, 0x4c, 0x4a, 0x2e, 0x4a, 0x2c, 0x49, 0xcd, 0xc9,
-	0xcc, 0xcd, 0x2c, 0xd1, 0x2f, 0x33, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x92, 0x42, 0x28, 0xd2, 0x43, 0x56, 0xa4, 0x57, 0x66, 0x28, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f,
-	0x56, 0xa6, 0x0f, 0x62, 0x41, 0x74, 0x48, 0x49, 0x26, 0xe7, 0x17, 0xe7, 0xe6, 0x17, 0xc7, 0x43,
-	0x24, 0x20, 0x1c, 0xa8, 0x94, 0x38, 0x84, 0xa7, 0x9f, 0x5b, 0x9c, 0x0e, 0xb2, 0x24, 0xb7, 0x38,
-	0x1d, 0x2a, 0xa1, 0x8e, 0xc7, 0x29, 0x05, 0x89, 0x45, 0x89, 0xb9, 0x50, 0x13, 0x94, 0x96, 0x33,
-	0x72, 0x49, 0xfa, 0x16, 0xa7, 0xbb, 0xe7, 0x97, 0x85, 0x16, 0xa4, 0x24, 0x96, 0xa4, 0x06, 0x80,
-	0x25, 0x83, 0x52, 0x0b, 0x4b, 0x53, 0x8b, 0x4b, 0x84, 0xcc, 0xb8, 0x38, 0x13, 0x4b, 0x4b, 0x32,
-	0xf2, 0x8b, 0x32, 0x4b, 0x2a, 0x25, 0x18, 0x15, 0x18, 0x35, 0x38, 0x9d, 0x24, 0x2e, 0x6d, 0xd1,
-	0x15, 0x81, 0x3a, 0xc2, 0x31, 0x25, 0xa5, 0x28, 0xb5, 0xb8, 0x38, 0xb8, 0xa4, 0x28, 0x33, 0x2f,
-	0x3d, 0x08, 0xa1, 0x54, 0xc8, 0x81, 0x8b, 0x0d, 0x62, 0x8b, 0x04, 0x93, 0x02, 0xa3, 0x06, 0xb7,
-	0x91, 0x92, 0x1e, 0x6e, 0x5f, 0xeb, 0x41, 0xac, 0x74, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21, 0x08,
-	0xaa, 0xcf, 0x4a, 0xa0, 0xe9, 0xf9, 0x06, 0x2d, 0x64, 0xcb, 0x95, 0x14, 0xb8, 0xa4, 0xb0, 0x39,
-	0xb4, 0xb8, 0x20, 0x3f, 0xaf, 0x38, 0xd5, 0x8a, 0x49, 0x82, 0x51, 0x69, 0x11, 0x23, 0x97, 0x98,
-	0x6f, 0x71, 0xfa, 0xe0, 0xf2, 0x08, 0x1f, 0xaa, 0x47, 0x94, 0x24, 0xb9, 0xc4, 0x31, 0xdc, 0x08,
-	0xf1, 0x83, 0x51, 0x2f, 0x13, 0x17, 0xb3, 0x6f, 0x71, 0xba, 0x50, 0x2b, 0x23, 0x17, 0x3f, 0x9a,
-	0x3f, 0x85, 0x4c, 0xf1, 0x59, 0x8c, 0x33, 0x02, 0xa5, 0xcc, 0x48, 0xd5, 0x06, 0x71, 0x8a, 0x12,
-	0x73, 0x07, 0x13, 0xa3, 0x50, 0x39, 0x17, 0x0f, 0x8a, 0x1b, 0x8c, 0x08, 0x18, 0x86, 0xcd, 0x01,
-	0xc6, 0x24, 0xe9, 0x81, 0xd8, 0x2e, 0xc5, 0xda, 0xf0, 0x7c, 0x83, 0x16, 0xa3, 0x53, 0xee, 0x89,
-	0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3,
-	0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x70, 0xc9, 0x66, 0xe6, 0xe3, 0x31, 0x37, 0x80,
-	0x31, 0xca, 0x28, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0xa1, 0x50,
-	0x37, 0x33, 0x1f, 0x89, 0xa7, 0x5f, 0x81, 0x92, 0x35, 0x92, 0xd8, 0xc0, 0x39, 0xc2, 0x18, 0x10,
-	0x00, 0x00, 0xff, 0xff, 0xd9, 0x8d, 0x1a, 0xad, 0xc7, 0x03, 0x00, 0x00,
+	// 489 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0x31, 0x6f, 0xd3, 0x40,
+	0x18, 0xcd, 0xc5, 0x34, 0x22, 0x07, 0x02, 0x74, 0x6a, 0xa9, 0x63, 0x84, 0x6b, 0x99, 0x81, 0xa8,
+	0x52, 0x6d, 0x35, 0x15, 0x1d, 0x0a, 0x03, 0x0d, 0x03, 0x53, 0xa4, 0xca, 0xc0, 0xc2, 0x12, 0x39,
+	0xf1, 0xe9, 0x62, 0xa9, 0xe7, 0xcf, 0xdc, 0x5d, 0x4c, 0xd9, 0x10, 0x12, 0x12, 0x23, 0x62, 0x67,
+	0xe9, 0xc2, 0xda, 0x81, 0x1f, 0xd1, 0xb1, 0x62, 0x62, 0x42, 0x28, 0x19, 0xfa, 0x37, 0x50, 0x7d,
+	0x16, 0x49, 0xa8, 0x13, 0x28, 0x2c, 0x6c, 0xfe, 0xf4, 0xde, 0xbb, 0xf7, 0xde, 0x77, 0xd6, 0xe1,
+	0x3b, 0xa9, 0x80, 0x8c, 0x26, 0x61, 0xd2, 0xa7, 0x7e, 0xdc, 0xeb, 0x8b, 0x50, 0xd1, 0xfd, 0x98,
+	0xc7, 0xca, 0xcf, 0x36, 0x7d, 0x75, 0xe0, 0xa5, 0x02, 0x14, 0x10, 0x6b, 0x42, 0xf2, 0xa6, 0x49,
+	0x5e, 0xb6, 0x69, 0x2d, 0x33, 0x60, 0x90, 0xd3, 0xfc, 0xb3, 0x2f, 0xad, 0xb0, 0x1a, 0x7d, 0x90,
+	0x1c, 0x64, 0x57, 0x03, 0x7a, 0x28, 0xa0, 0x55, 0x3d, 0xf9, 0x5c, 0xb2, 0x33, 0x13, 0x2e, 0x59,
+	0x01, 0xdc, 0x5d, 0x10, 0x25, 0x0d, 0x45, 0xc8, 0x8b, 0x13, 0xdc, 0x4f, 0x08, 0x37, 0x3a, 0x92,
+	0x3d, 0x86, 0xec, 0x59, 0x1a, 0x85, 0x8a, 0xee, 0xe5, 0x60, 0x40, 0x5f, 0x0c, 0xa9, 0x54, 0x64,
+	0x1b, 0xd7, 0xc3, 0xa1, 0x1a, 0x80, 0x88, 0xd5, 0x2b, 0x13, 0x39, 0xa8, 0x59, 0x6f, 0x9b, 0x5f,
+	0x3e, 0x6f, 0x2c, 0x17, 0x21, 0x76, 0xa3, 0x48, 0x50, 0x29, 0x9f, 0x28, 0x11, 0x27, 0x2c, 0x98,
+	0x50, 0xc9, 0x43, 0x5c, 0xd3, 0x2e, 0x66, 0xd5, 0x41, 0xcd, 0x2b, 0x2d, 0xd7, 0x9b, 0xdf, 0xda,
+	0xd3, 0x96, 0xed, 0x4b, 0xc7, 0xdf, 0xd6, 0x2a, 0x41, 0xa1, 0xdb, 0xb9, 0xf1, 0xe6, 0xf4, 0x68,
+	0x7d, 0xda, 0xdc, 0x75, 0xb0, 0x55, 0x16, 0x54, 0xa6, 0x90, 0x48, 0xba, 0x53, 0x35, 0x91, 0x7b,
+	0x88, 0xf0, 0xcd, 0x8e, 0x64, 0xff, 0x57, 0x91, 0x6b, 0xb3, 0x45, 0xdc, 0x06, 0x5e, 0x3d, 0x97,
+	0x51, 0x77, 0x70, 0x3f, 0x22, 0xbc, 0xf6, 0x13, 0x7b, 0x2a, 0x86, 0x52, 0xd1, 0xe8, 0xd1, 0x20,
+	0x4c, 0x12, 0xba, 0xff, 0xcf, 0x45, 0x56, 0x70, 0x4d, 0x41, 0x37, 0x8c, 0x22, 0xb3, 0xea, 0x18,
+	0xcd, 0x7a, 0xb0, 0xa4, 0x60, 0x37, 0x8a, 0xc8, 0x2d, 0x5c, 0x57, 0xd0, 0x15, 0x94, 0x43, 0x46,
+	0x4d, 0x23, 0x47, 0x2e, 0x2b, 0x08, 0xf2, 0xf9, 0x5c, 0x74, 0x17, 0x3b, 0xf3, 0xe3, 0xe9, 0x0e,
+	0xad, 0x43, 0x03, 0x1b, 0x1d, 0xc9, 0xc8, 0x5b, 0x84, 0xaf, 0xff, 0x72, 0x57, 0xe4, 0xde, 0xa2,
+	0xe5, 0xcd, 0xfd, 0x09, 0xad, 0xed, 0x8b, 0xca, 0x8a, 0x75, 0x1a, 0xef, 0xaa, 0x88, 0xbc, 0xc4,
+	0x57, 0x67, 0x32, 0xb4, 0x7e, 0x73, 0x58, 0x59, 0x80, 0xad, 0x0b, 0x69, 0xb4, 0x3b, 0xf9, 0x80,
+	0xf0, 0x4a, 0xe9, 0xaa, 0xc8, 0xfd, 0x3f, 0x3a, 0xae, 0xfc, 0xfe, 0xad, 0x07, 0x7f, 0x27, 0xd6,
+	0xa1, 0xac, 0xa5, 0xd7, 0xa7, 0x47, 0xeb, 0xa8, 0xcd, 0x8f, 0x47, 0x36, 0x3a, 0x19, 0xd9, 0xe8,
+	0xfb, 0xc8, 0x46, 0xef, 0xc7, 0x76, 0xe5, 0x64, 0x6c, 0x57, 0xbe, 0x8e, 0xed, 0x0a, 0xbe, 0x1d,
+	0xc3, 0x02, 0x83, 0x3d, 0xf4, 0xbc, 0xc5, 0x62, 0x35, 0x18, 0xf6, 0xbc, 0x3e, 0x70, 0x7f, 0x42,
+	0xdc, 0x88, 0x61, 0x6a, 0xf2, 0x0f, 0x66, 0xde, 0x9c, 0x5e, 0x2d, 0x7f, 0x6a, 0xb6, 0x7e, 0x0c,
+	0x00, 0x76, 0x4f, 0x96, 0x30, 0x20, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -277,6 +386,9 @@ type MsgClient interface {
 	GovUpdateParams(ctx context.Context, in *MsgGovUpdateParamsRequest, opts ...grpc.CallOption) (*MsgGovUpdateParamsResponse, error)
 	// UpdateParams is a governance proposal endpoint for updating the ibcratelimit module's params.
 	UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateTrustedChannels is a governance proposal endpoint for adding and removing channels
+	// that are exempt from rate limiting.
+	UpdateTrustedChannels(ctx context.Context, in *MsgUpdateTrustedChannelsRequest, opts ...grpc.CallOption) (*MsgUpdateTrustedChannelsResponse, error)
 }
 
 type msgClient struct {
@@ -306,6 +418,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest
 	return out, nil
 }
 
+func (c *msgClient) UpdateTrustedChannels(ctx context.Context, in *MsgUpdateTrustedChannelsRequest, opts ...grpc.CallOption) (*MsgUpdateTrustedChannelsResponse, error) {
+	out := new(MsgUpdateTrustedChannelsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.ibcratelimit.v1.Msg/UpdateTrustedChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// GovUpdateParams is a governance proposal endpoint for updating the exchange module's params.
@@ -313,6 +434,9 @@ type MsgServer interface {
 	GovUpdateParams(context.Context, *MsgGovUpdateParamsRequest) (*MsgGovUpdateParamsResponse, error)
 	// UpdateParams is a governance proposal endpoint for updating the ibcratelimit module's params.
 	UpdateParams(context.Context, *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error)
+	// UpdateTrustedChannels is a governance proposal endpoint for adding and removing channels
+	// that are exempt from rate limiting.
+	UpdateTrustedChannels(context.Context, *MsgUpdateTrustedChannelsRequest) (*MsgUpdateTrustedChannelsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -325,6 +449,9 @@ func (*UnimplementedMsgServer) GovUpdateParams(ctx context.Context, req *MsgGovU
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) UpdateTrustedChannels(ctx context.Context, req *MsgUpdateTrustedChannelsRequest) (*MsgUpdateTrustedChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTrustedChannels not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -366,6 +493,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateTrustedChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTrustedChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTrustedChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.ibcratelimit.v1.Msg/UpdateTrustedChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTrustedChannels(ctx, req.(*MsgUpdateTrustedChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.ibcratelimit.v1.Msg",
@@ -379,6 +524,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "UpdateTrustedChannels",
+			Handler:    _Msg_UpdateTrustedChannels_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/ibcratelimit/v1/tx.proto",
@@ -510,6 +659,77 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateTrustedChannelsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTrustedChannelsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTrustedChannelsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ToRemove) > 0 {
+		for iNdEx := len(m.ToRemove) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ToRemove[iNdEx])
+			copy(dAtA[i:], m.ToRemove[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ToRemove[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ToAdd) > 0 {
+		for iNdEx := len(m.ToAdd) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ToAdd[iNdEx])
+			copy(dAtA[i:], m.ToAdd[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ToAdd[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTrustedChannelsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTrustedChannelsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTrustedChannelsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -569,6 +789,40 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateTrustedChannelsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ToAdd) > 0 {
+		for _, s := range m.ToAdd {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ToRemove) > 0 {
+		for _, s := range m.ToRemove {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateTrustedChannelsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -905,6 +1159,202 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateTrustedChannelsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateTrustedChannelsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateTrustedChannelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAdd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAdd = append(m.ToAdd, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToRemove", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToRemove = append(m.ToRemove, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateTrustedChannelsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateTrustedChannelsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateTrustedChannelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
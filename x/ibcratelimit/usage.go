@@ -0,0 +1,119 @@
+package ibcratelimit
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// UsageThresholds are the percentages of a quota's max that trigger an EventQuotaThresholdCrossed.
+var UsageThresholds = []uint32{80, 100}
+
+// GetQuotasQuery is an ibcratelimit contract query for the rate limits of a path.
+type GetQuotasQuery struct {
+	GetQuotas GetQuotasRequest `json:"get_quotas"`
+}
+
+// GetQuotasRequest identifies the path being queried by a GetQuotasQuery.
+type GetQuotasRequest struct {
+	ChannelID string `json:"channel_id"`
+	Denom     string `json:"denom"`
+}
+
+// NewGetQuotasQuery creates a new GetQuotasQuery for the provided channel and denom.
+func NewGetQuotasQuery(channelID, denom string) GetQuotasQuery {
+	return GetQuotasQuery{GetQuotas: GetQuotasRequest{ChannelID: channelID, Denom: denom}}
+}
+
+// ContractRateLimit is a rate limit as returned by the ibcratelimit contract.
+type ContractRateLimit struct {
+	Quota ContractQuota `json:"quota"`
+	Flow  ContractFlow  `json:"flow"`
+}
+
+// ContractQuota is the configuration of a rate limit as returned by the ibcratelimit contract.
+type ContractQuota struct {
+	Name              string       `json:"name"`
+	MaxPercentageSend uint32       `json:"max_percentage_send"`
+	MaxPercentageRecv uint32       `json:"max_percentage_recv"`
+	Duration          uint64       `json:"duration"`
+	ChannelValue      *sdkmath.Int `json:"channel_value,omitempty"`
+}
+
+// ContractFlow is the value moved during a rate limit's current window as returned by the ibcratelimit contract.
+type ContractFlow struct {
+	Inflow    sdkmath.Int `json:"inflow"`
+	Outflow   sdkmath.Int `json:"outflow"`
+	PeriodEnd string      `json:"period_end"`
+}
+
+// ToQuotaUsage converts this rate limit into a QuotaUsage as of the provided time.
+// If the window has ended, the used amounts are zero since the contract resets them on the next transfer.
+func (r ContractRateLimit) ToQuotaUsage(now time.Time) (QuotaUsage, error) {
+	periodEndNanos, err := strconv.ParseInt(r.Flow.PeriodEnd, 10, 64)
+	if err != nil {
+		return QuotaUsage{}, fmt.Errorf("invalid period_end %q of quota %q: %w", r.Flow.PeriodEnd, r.Quota.Name, err)
+	}
+	periodEnd := time.Unix(0, periodEndNanos).UTC()
+
+	channelValue := sdkmath.ZeroInt()
+	if r.Quota.ChannelValue != nil {
+		channelValue = *r.Quota.ChannelValue
+	}
+
+	sendUsed, recvUsed := sdkmath.ZeroInt(), sdkmath.ZeroInt()
+	if !now.After(periodEnd) {
+		if r.Flow.Outflow.GT(r.Flow.Inflow) {
+			sendUsed = r.Flow.Outflow.Sub(r.Flow.Inflow)
+		}
+		if r.Flow.Inflow.GT(r.Flow.Outflow) {
+			recvUsed = r.Flow.Inflow.Sub(r.Flow.Outflow)
+		}
+	}
+
+	return QuotaUsage{
+		Name:         r.Quota.Name,
+		Duration:     r.Quota.Duration,
+		PeriodEnd:    periodEnd,
+		ChannelValue: channelValue.String(),
+		SendUsed:     sendUsed.String(),
+		SendMax:      percentOf(channelValue, r.Quota.MaxPercentageSend).String(),
+		RecvUsed:     recvUsed.String(),
+		RecvMax:      percentOf(channelValue, r.Quota.MaxPercentageRecv).String(),
+	}, nil
+}
+
+// UsedAndMax returns the used and max amounts of this quota in the provided direction.
+func (u QuotaUsage) UsedAndMax(direction string) (used, max sdkmath.Int, err error) {
+	usedStr, maxStr := u.RecvUsed, u.RecvMax
+	if direction == DirectionSend {
+		usedStr, maxStr = u.SendUsed, u.SendMax
+	}
+	var ok bool
+	if used, ok = sdkmath.NewIntFromString(usedStr); !ok {
+		return used, max, fmt.Errorf("invalid %s used amount %q of quota %q", direction, usedStr, u.Name)
+	}
+	if max, ok = sdkmath.NewIntFromString(maxStr); !ok {
+		return used, max, fmt.Errorf("invalid %s max amount %q of quota %q", direction, maxStr, u.Name)
+	}
+	return used, max, nil
+}
+
+// CrossedThresholds returns the UsageThresholds that are crossed when usage goes from before to after.
+func CrossedThresholds(before, after, max sdkmath.Int) []uint32 {
+	var rv []uint32
+	for _, threshold := range UsageThresholds {
+		limit := percentOf(max, threshold)
+		if before.LT(limit) && after.GTE(limit) {
+			rv = append(rv, threshold)
+		}
+	}
+	return rv
+}
+
+// percentOf returns the provided percentage of an amount (truncated), the same way the contract does.
+func percentOf(amount sdkmath.Int, percent uint32) sdkmath.Int {
+	return amount.MulRaw(int64(percent)).QuoRaw(100)
+}
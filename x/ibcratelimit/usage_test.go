@@ -0,0 +1,125 @@
+package ibcratelimit_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+	"github.com/provenance-io/provenance/x/ibcratelimit"
+)
+
+func TestCrossedThresholds(t *testing.T) {
+	tests := []struct {
+		name   string
+		before int64
+		after  int64
+		max    int64
+		exp    []uint32
+	}{
+		{name: "below 80", before: 10, after: 79, max: 100, exp: nil},
+		{name: "crosses 80", before: 79, after: 80, max: 100, exp: []uint32{80}},
+		{name: "already above 80", before: 80, after: 99, max: 100, exp: nil},
+		{name: "crosses 100", before: 99, after: 100, max: 100, exp: []uint32{100}},
+		{name: "crosses both", before: 10, after: 150, max: 100, exp: []uint32{80, 100}},
+		{name: "already above 100", before: 100, after: 150, max: 100, exp: nil},
+		{name: "truncated limit", before: 6, after: 7, max: 9, exp: []uint32{80}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			act := ibcratelimit.CrossedThresholds(sdkmath.NewInt(tc.before), sdkmath.NewInt(tc.after), sdkmath.NewInt(tc.max))
+			assert.Equal(t, tc.exp, act, "CrossedThresholds(%d, %d, %d)", tc.before, tc.after, tc.max)
+		})
+	}
+}
+
+func TestContractRateLimitToQuotaUsage(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0).UTC()
+	periodEnd := now.Add(time.Hour)
+
+	tests := []struct {
+		name   string
+		json   string
+		now    time.Time
+		exp    ibcratelimit.QuotaUsage
+		expErr string
+	}{
+		{
+			name: "net outflow",
+			json: `{"quota":{"name":"weekly","max_percentage_send":5,"max_percentage_recv":4,"duration":604800,"channel_value":"1000"},` +
+				`"flow":{"inflow":"10","outflow":"40","period_end":"1700003600000000000"}}`,
+			now: now,
+			exp: ibcratelimit.QuotaUsage{
+				Name: "weekly", Duration: 604800, PeriodEnd: periodEnd, ChannelValue: "1000",
+				SendUsed: "30", SendMax: "50", RecvUsed: "0", RecvMax: "40",
+			},
+		},
+		{
+			name: "net inflow",
+			json: `{"quota":{"name":"daily","max_percentage_send":10,"max_percentage_recv":10,"duration":86400,"channel_value":"999"},` +
+				`"flow":{"inflow":"25","outflow":"5","period_end":"1700003600000000000"}}`,
+			now: now,
+			exp: ibcratelimit.QuotaUsage{
+				Name: "daily", Duration: 86400, PeriodEnd: periodEnd, ChannelValue: "999",
+				SendUsed: "0", SendMax: "99", RecvUsed: "20", RecvMax: "99",
+			},
+		},
+		{
+			name: "window ended",
+			json: `{"quota":{"name":"weekly","max_percentage_send":5,"max_percentage_recv":5,"duration":604800,"channel_value":"1000"},` +
+				`"flow":{"inflow":"0","outflow":"40","period_end":"1700003600000000000"}}`,
+			now: periodEnd.Add(time.Second),
+			exp: ibcratelimit.QuotaUsage{
+				Name: "weekly", Duration: 604800, PeriodEnd: periodEnd, ChannelValue: "1000",
+				SendUsed: "0", SendMax: "50", RecvUsed: "0", RecvMax: "50",
+			},
+		},
+		{
+			name: "no channel value",
+			json: `{"quota":{"name":"weekly","max_percentage_send":5,"max_percentage_recv":5,"duration":604800},` +
+				`"flow":{"inflow":"0","outflow":"0","period_end":"1700003600000000000"}}`,
+			now: now,
+			exp: ibcratelimit.QuotaUsage{
+				Name: "weekly", Duration: 604800, PeriodEnd: periodEnd, ChannelValue: "0",
+				SendUsed: "0", SendMax: "0", RecvUsed: "0", RecvMax: "0",
+			},
+		},
+		{
+			name: "bad period end",
+			json: `{"quota":{"name":"weekly","max_percentage_send":5,"max_percentage_recv":5,"duration":604800},` +
+				`"flow":{"inflow":"0","outflow":"0","period_end":"soon"}}`,
+			now:    now,
+			expErr: `invalid period_end "soon" of quota "weekly": strconv.ParseInt: parsing "soon": invalid syntax`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var rateLimit ibcratelimit.ContractRateLimit
+			require.NoError(t, json.Unmarshal([]byte(tc.json), &rateLimit), "Unmarshal")
+
+			act, err := rateLimit.ToQuotaUsage(tc.now)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ToQuotaUsage")
+			assert.Equal(t, tc.exp, act, "ToQuotaUsage result")
+		})
+	}
+}
+
+func TestQuotaUsageUsedAndMax(t *testing.T) {
+	usage := ibcratelimit.QuotaUsage{Name: "weekly", SendUsed: "1", SendMax: "2", RecvUsed: "3", RecvMax: "bad"}
+
+	used, max, err := usage.UsedAndMax(ibcratelimit.DirectionSend)
+	if assert.NoError(t, err, "UsedAndMax(send)") {
+		assert.Equal(t, "1", used.String(), "send used")
+		assert.Equal(t, "2", max.String(), "send max")
+	}
+
+	_, _, err = usage.UsedAndMax(ibcratelimit.DirectionRecv)
+	assert.EqualError(t, err, `invalid recv max amount "bad" of quota "weekly"`, "UsedAndMax(recv)")
+}
@@ -49,4 +49,6 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper, bk bankkeeper.Keeper) {
 	if err != nil {
 		panic(err)
 	}
+	// Execute any supply schedule steps that are now due.
+	k.ProcessSupplySchedules(ctx)
 }
@@ -279,6 +279,10 @@ func (s *IntegrationTestSuite) SetupSuite() {
 			markerData.NetAssetValues = append(markerData.NetAssetValues, mNav)
 		}
 
+		// Give propcoin a supply schedule step that won't come due during the tests.
+		markerData.SupplySchedules = append(markerData.SupplySchedules, markertypes.NewSupplySchedule("propcoin",
+			markertypes.SupplyScheduleStep{Action: markertypes.SupplyScheduleAction_Mint, Amount: sdkmath.NewInt(10), Height: 1_000_000_000}))
+
 		// Now create more markers (and their navs) until we have s.markerCount of them.
 		for i := len(markerData.Markers); i < s.markerCount; i++ {
 			denom := toWritten(i + 1)
@@ -478,7 +482,7 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 			[]string{
 				fmt.Sprintf("--%s=json", cmtcli.OutputFlag),
 			},
-			`{"max_total_supply":"1000000","enable_governance":true,"unrestricted_denom_regex":"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}","max_supply":"1000000"}`,
+			`{"max_total_supply":"1000000","enable_governance":true,"unrestricted_denom_regex":"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}","max_supply":"1000000","enable_nav_attestations":false,"nav_attestation_max_deviation_bps":500}`,
 		},
 		{
 			"get testcoin marker json",
@@ -592,6 +596,18 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 			args:           []string{"testcoin"},
 			expectedOutput: "net_asset_values:\n- price:\n    amount: \"100\"\n    denom: usd\n  updated_block_height: \"0\"\n  volume: \"100\"",
 		},
+		{
+			name:           "marker supply schedule query",
+			cmd:            markercli.SupplyScheduleCmd(),
+			args:           []string{"propcoin", fmt.Sprintf("--%s=json", cmtcli.OutputFlag)},
+			expectedOutput: `{"supply_schedule":{"denom":"propcoin","steps":[{"action":"SUPPLY_SCHEDULE_ACTION_MINT","amount":"10","height":"1000000000","time":null,"recipient":""}]}}`,
+		},
+		{
+			name:           "marker supply schedule query no schedule",
+			cmd:            markercli.SupplyScheduleCmd(),
+			args:           []string{"testcoin", fmt.Sprintf("--%s=json", cmtcli.OutputFlag)},
+			expectedOutput: `{"supply_schedule":{"denom":"testcoin","steps":[]}}`,
+		},
 	}
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
@@ -2102,6 +2118,74 @@ func TestParseNewMarkerFlags(t *testing.T) {
 	}
 }
 
+func TestParseSupplyScheduleSteps(t *testing.T) {
+	when := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	recipient := sdk.AccAddress("recipient___________").String()
+
+	tests := []struct {
+		name   string
+		input  string
+		exp    []types.SupplyScheduleStep
+		expErr string
+	}{
+		{
+			name:  "empty",
+			input: "",
+			exp:   nil,
+		},
+		{
+			name:  "mint at height",
+			input: "mint,100,5000",
+			exp:   []types.SupplyScheduleStep{{Action: types.SupplyScheduleAction_Mint, Amount: sdkmath.NewInt(100), Height: 5000}},
+		},
+		{
+			name:  "multiple steps",
+			input: "MINT,100,5000," + recipient + ";burn,7,2030-01-02T03:04:05Z",
+			exp: []types.SupplyScheduleStep{
+				{Action: types.SupplyScheduleAction_Mint, Amount: sdkmath.NewInt(100), Height: 5000, Recipient: recipient},
+				{Action: types.SupplyScheduleAction_Burn, Amount: sdkmath.NewInt(7), Time: &when},
+			},
+		},
+		{
+			name:   "too few fields",
+			input:  "mint,100",
+			expErr: `invalid supply schedule step "mint,100", expected <mint|burn>,<amount>,<height|time>[,<recipient>]`,
+		},
+		{
+			name:   "too many fields",
+			input:  "mint,100,5,addr,extra",
+			expErr: `invalid supply schedule step "mint,100,5,addr,extra", expected <mint|burn>,<amount>,<height|time>[,<recipient>]`,
+		},
+		{
+			name:   "unknown action",
+			input:  "print,100,5",
+			expErr: `invalid supply schedule action "print", expected mint or burn`,
+		},
+		{
+			name:   "invalid amount",
+			input:  "mint,lots,5",
+			expErr: `invalid supply schedule amount "lots"`,
+		},
+		{
+			name:   "invalid height or time",
+			input:  "mint,100,tomorrow",
+			expErr: `invalid supply schedule height or time "tomorrow"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			steps, err := markercli.ParseSupplyScheduleSteps(tc.input)
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ParseSupplyScheduleSteps error")
+			} else {
+				require.NoError(t, err, "ParseSupplyScheduleSteps error")
+			}
+			assert.Equal(t, tc.exp, steps, "ParseSupplyScheduleSteps result")
+		})
+	}
+}
+
 func TestParseBoolStrict(t *testing.T) {
 	trueCases := []string{
 		"true", "TRUE", "True", "tRuE",
@@ -2285,6 +2369,56 @@ func (s *IntegrationTestSuite) TestSupplyIncreaseProposal() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestSetSupplyScheduleProposal() {
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErrMsg string
+		expectedCode uint32
+		signer       string
+	}{
+		{
+			name:         "success - submit set supply schedule proposal",
+			args:         []string{"propcoin", "mint,100,500000;burn,10,2030-01-01T00:00:00Z"},
+			expectedCode: 0,
+			signer:       s.testnet.Validators[0].Address.String(),
+		},
+		{
+			name:         "success - submit remove supply schedule proposal",
+			args:         []string{"propcoin"},
+			expectedCode: 0,
+			signer:       s.testnet.Validators[0].Address.String(),
+		},
+		{
+			name:         "failure - invalid step",
+			args:         []string{"propcoin", "mint,100"},
+			expectErrMsg: `invalid supply schedule step "mint,100", expected <mint|burn>,<amount>,<height|time>[,<recipient>]`,
+			signer:       s.testnet.Validators[0].Address.String(),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := markercli.GetCmdSetSupplyScheduleProposal()
+			tc.args = append(tc.args,
+				"--title", fmt.Sprintf("title: %v", tc.name),
+				"--summary", fmt.Sprintf("summary: %v", tc.name),
+				"--deposit=1000000stake",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, tc.signer),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+				fmt.Sprintf("--%s=json", cmtcli.OutputFlag),
+			)
+
+			testcli.NewTxExecutor(cmd, tc.args).
+				WithExpErrMsg(tc.expectErrMsg).
+				WithExpCode(tc.expectedCode).
+				Execute(s.T(), s.testnet)
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestSetAdministratorProposal() {
 	testCases := []struct {
 		name         string
@@ -2616,6 +2750,29 @@ func (s *IntegrationTestSuite) TestUpdateMarkerParamsCmd() {
 			},
 			expectedCode: 0,
 		},
+		{
+			name: "update marker params with nav attestations, should succeed",
+			cmd:  markercli.GetUpdateMarkerParamsCmd(),
+			args: []string{
+				"true",
+				"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}",
+				"1000000",
+				"--" + markercli.FlagEnableNAVAttestations,
+				"--" + markercli.FlagNAVMaxDeviationBps, "250",
+			},
+			expectedCode: 0,
+		},
+		{
+			name: "update marker params, should fail nav max deviation too large",
+			cmd:  markercli.GetUpdateMarkerParamsCmd(),
+			args: []string{
+				"true",
+				"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}",
+				"1000000",
+				"--" + markercli.FlagNAVMaxDeviationBps, "10001",
+			},
+			expectErr: "invalid nav attestation max deviation 10001: cannot be more than 10000 basis points",
+		},
 		{
 			name: "update marker params, should fail incorrect governance flag",
 			cmd:  markercli.GetUpdateMarkerParamsCmd(),
@@ -2743,3 +2900,364 @@ func (s *IntegrationTestSuite) TestRevokeFeegrant() {
 	})
 
 }
+
+func (s *IntegrationTestSuite) TestSendAllowListCommands() {
+	denom := "allowlistcoin"
+	s.Run("add a new marker for this", func() {
+		cmd := markercli.GetCmdAddFinalizeActivateMarker()
+		args := []string{
+			"1000" + denom,
+			s.testnet.Validators[0].Address.String() + ",mint,burn,deposit,withdraw,delete,admin,transfer",
+			fmt.Sprintf("--%s=%s", markercli.FlagType, "RESTRICTED"),
+			"--" + markercli.FlagSupplyFixed,
+			fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+			fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+			fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+			fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+		}
+		testcli.NewTxExecutor(cmd, args).Execute(s.T(), s.testnet)
+	})
+	if s.T().Failed() {
+		s.FailNow("Stopping due to setup error")
+	}
+
+	argsWStdFlags := func(args ...string) []string {
+		return append(args,
+			fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+			fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+			fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+			fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+		)
+	}
+
+	txTests := []struct {
+		name   string
+		cmd    *cobra.Command
+		args   []string
+		expErr string
+	}{
+		{
+			name:   "update allow list, both add and remove lists are empty",
+			cmd:    markercli.GetCmdUpdateSendAllowListRequest(),
+			args:   argsWStdFlags(denom),
+			expErr: "both add and remove lists cannot be empty",
+		},
+		{
+			name: "update allow list, add addresses",
+			cmd:  markercli.GetCmdUpdateSendAllowListRequest(),
+			args: argsWStdFlags(denom, fmt.Sprintf("--%s=%s,%s", markercli.FlagAdd, s.accountAddresses[0], s.accountAddresses[1])),
+		},
+		{
+			name: "update allow list, remove address",
+			cmd:  markercli.GetCmdUpdateSendAllowListRequest(),
+			args: argsWStdFlags(denom, fmt.Sprintf("--%s=%s", markercli.FlagRemove, s.accountAddresses[1])),
+		},
+		{
+			name:   "set allow list mode, invalid bool",
+			cmd:    markercli.GetCmdSetSendAllowListModeRequest(),
+			args:   argsWStdFlags(denom, "farse"),
+			expErr: "invalid allowlist mode \"farse\": strconv.ParseBool: parsing \"farse\": invalid syntax",
+		},
+		{
+			name: "set allow list mode, on",
+			cmd:  markercli.GetCmdSetSendAllowListModeRequest(),
+			args: argsWStdFlags(denom, "true"),
+		},
+	}
+
+	for _, tc := range txTests {
+		s.Run(tc.name, func() {
+			testcli.NewTxExecutor(tc.cmd, tc.args).
+				WithExpErrMsg(tc.expErr).
+				Execute(s.T(), s.testnet)
+		})
+	}
+
+	s.Run("query send allow list", func() {
+		clientCtx := s.testnet.Validators[0].ClientCtx
+		args := []string{denom, fmt.Sprintf("--%s=json", cmtcli.OutputFlag)}
+		out, err := clitestutil.ExecTestCLICmd(clientCtx, markercli.SendAllowListCmd(), args)
+		s.Require().NoError(err, "SendAllowListCmd")
+
+		var resp markertypes.QuerySendAllowListResponse
+		s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), "UnmarshalJSON(%q)", out.String())
+		s.Assert().True(resp.Enabled, "enabled")
+		s.Assert().Equal([]string{s.accountAddresses[0].String()}, resp.AllowedAddresses, "allowed addresses")
+	})
+}
+
+func (s *IntegrationTestSuite) TestPauseCommands() {
+	denom := "pausecoin"
+	s.Run("add a new marker for this", func() {
+		cmd := markercli.GetCmdAddFinalizeActivateMarker()
+		args := []string{
+			"1000" + denom,
+			s.testnet.Validators[0].Address.String() + ",mint,burn,deposit,withdraw,delete,admin",
+			fmt.Sprintf("--%s=%s", markercli.FlagType, "COIN"),
+			"--" + markercli.FlagSupplyFixed,
+			fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+			fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+			fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+			fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+		}
+		testcli.NewTxExecutor(cmd, args).Execute(s.T(), s.testnet)
+	})
+	if s.T().Failed() {
+		s.FailNow("Stopping due to setup error")
+	}
+
+	argsWStdFlags := func(args ...string) []string {
+		return append(args,
+			fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+			fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+			fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+			fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+		)
+	}
+	queryPaused := func() bool {
+		clientCtx := s.testnet.Validators[0].ClientCtx
+		args := []string{denom, fmt.Sprintf("--%s=json", cmtcli.OutputFlag)}
+		out, err := clitestutil.ExecTestCLICmd(clientCtx, markercli.PauseStatusCmd(), args)
+		s.Require().NoError(err, "PauseStatusCmd")
+
+		var resp markertypes.QueryPauseStatusResponse
+		s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), "UnmarshalJSON(%q)", out.String())
+		return resp.Paused
+	}
+
+	s.Run("pause marker", func() {
+		testcli.NewTxExecutor(markercli.GetCmdPauseMarkerRequest(), argsWStdFlags(denom)).Execute(s.T(), s.testnet)
+	})
+	s.Run("query pause status after pause", func() {
+		s.Assert().True(queryPaused(), "paused")
+	})
+	s.Run("unpause marker", func() {
+		testcli.NewTxExecutor(markercli.GetCmdUnpauseMarkerRequest(), argsWStdFlags(denom)).Execute(s.T(), s.testnet)
+	})
+	s.Run("query pause status after unpause", func() {
+		s.Assert().False(queryPaused(), "paused")
+	})
+}
+
+func (s *IntegrationTestSuite) TestTransferFeeCommands() {
+	denom := "transferfeecoin"
+	s.Run("add a new marker for this", func() {
+		cmd := markercli.GetCmdAddFinalizeActivateMarker()
+		args := []string{
+			"1000" + denom,
+			s.testnet.Validators[0].Address.String() + ",mint,burn,deposit,withdraw,delete,admin",
+			fmt.Sprintf("--%s=%s", markercli.FlagType, "COIN"),
+			"--" + markercli.FlagSupplyFixed,
+			fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+			fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+			fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+			fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+		}
+		testcli.NewTxExecutor(cmd, args).Execute(s.T(), s.testnet)
+	})
+	if s.T().Failed() {
+		s.FailNow("Stopping due to setup error")
+	}
+
+	argsWStdFlags := func(args ...string) []string {
+		return append(args,
+			fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+			fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+			fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+			fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+		)
+	}
+
+	txTests := []struct {
+		name   string
+		args   []string
+		expErr string
+	}{
+		{
+			name:   "invalid basis points",
+			args:   argsWStdFlags(denom, "many"),
+			expErr: "invalid basis points \"many\": strconv.ParseUint: parsing \"many\": invalid syntax",
+		},
+		{
+			name: "set transfer fee",
+			args: argsWStdFlags(denom, "25", s.accountAddresses[0].String()),
+		},
+	}
+
+	for _, tc := range txTests {
+		s.Run(tc.name, func() {
+			testcli.NewTxExecutor(markercli.GetCmdSetTransferFeeRequest(), tc.args).
+				WithExpErrMsg(tc.expErr).
+				Execute(s.T(), s.testnet)
+		})
+	}
+
+	s.Run("query transfer fee", func() {
+		clientCtx := s.testnet.Validators[0].ClientCtx
+		args := []string{denom, fmt.Sprintf("--%s=json", cmtcli.OutputFlag)}
+		out, err := clitestutil.ExecTestCLICmd(clientCtx, markercli.TransferFeeCmd(), args)
+		s.Require().NoError(err, "TransferFeeCmd")
+
+		var resp markertypes.QueryTransferFeeResponse
+		s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), "UnmarshalJSON(%q)", out.String())
+		expFee := markertypes.NewTransferFee(denom, 25, s.accountAddresses[0].String())
+		s.Assert().Equal(&expFee, resp.TransferFee, "transfer fee")
+	})
+}
+
+func (s *IntegrationTestSuite) TestVelocityLimitCommands() {
+	denom := "velocitylimitcoin"
+	s.Run("add a new marker for this", func() {
+		cmd := markercli.GetCmdAddFinalizeActivateMarker()
+		args := []string{
+			"1000" + denom,
+			s.testnet.Validators[0].Address.String() + ",mint,burn,deposit,withdraw,delete,admin",
+			fmt.Sprintf("--%s=%s", markercli.FlagType, "COIN"),
+			"--" + markercli.FlagSupplyFixed,
+			fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+			fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+			fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+			fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+		}
+		testcli.NewTxExecutor(cmd, args).Execute(s.T(), s.testnet)
+	})
+	if s.T().Failed() {
+		s.FailNow("Stopping due to setup error")
+	}
+
+	argsWStdFlags := func(args ...string) []string {
+		return append(args,
+			fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+			fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+			fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+			fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+		)
+	}
+
+	txTests := []struct {
+		name   string
+		args   []string
+		expErr string
+	}{
+		{
+			name:   "wrong number of args",
+			args:   argsWStdFlags(denom, "500", "3600"),
+			expErr: "accepts 2 or 4 arg(s), received 3",
+		},
+		{
+			name:   "invalid window seconds",
+			args:   argsWStdFlags(denom, "500", "hour", "60"),
+			expErr: "invalid window seconds \"hour\": strconv.ParseUint: parsing \"hour\": invalid syntax",
+		},
+		{
+			name:   "invalid bucket seconds",
+			args:   argsWStdFlags(denom, "500", "3600", "1m"),
+			expErr: "invalid bucket seconds \"1m\": strconv.ParseUint: parsing \"1m\": invalid syntax",
+		},
+		{
+			name:   "invalid max amount",
+			args:   argsWStdFlags(denom, "lots", "3600", "60"),
+			expErr: "invalid max amount \"lots\": must be an integer",
+		},
+		{
+			name: "set velocity limit",
+			args: argsWStdFlags(denom, "500", "3600", "60"),
+		},
+	}
+
+	for _, tc := range txTests {
+		s.Run(tc.name, func() {
+			testcli.NewTxExecutor(markercli.GetCmdSetVelocityLimitRequest(), tc.args).
+				WithExpErrMsg(tc.expErr).
+				Execute(s.T(), s.testnet)
+		})
+	}
+
+	s.Run("query velocity limit", func() {
+		clientCtx := s.testnet.Validators[0].ClientCtx
+		args := []string{denom, s.accountAddresses[0].String(), fmt.Sprintf("--%s=json", cmtcli.OutputFlag)}
+		out, err := clitestutil.ExecTestCLICmd(clientCtx, markercli.VelocityLimitCmd(), args)
+		s.Require().NoError(err, "VelocityLimitCmd")
+
+		var resp markertypes.QueryVelocityLimitResponse
+		s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), "UnmarshalJSON(%q)", out.String())
+		expLimit := markertypes.NewVelocityLimit(denom, sdkmath.NewInt(500), 3600, 60)
+		s.Assert().Equal(&expLimit, resp.VelocityLimit, "velocity limit")
+		s.Assert().Equal("0", resp.SentInWindow.String(), "sent in window")
+	})
+}
+
+func (s *IntegrationTestSuite) TestHolderSnapshotCommands() {
+	denom := "holdersnapshotcoin"
+	s.Run("add a new marker for this", func() {
+		cmd := markercli.GetCmdAddFinalizeActivateMarker()
+		args := []string{
+			"1000" + denom,
+			s.testnet.Validators[0].Address.String() + ",mint,burn,deposit,withdraw,delete,admin",
+			fmt.Sprintf("--%s=%s", markercli.FlagType, "COIN"),
+			"--" + markercli.FlagSupplyFixed,
+			fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+			fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+			fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+			fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+		}
+		testcli.NewTxExecutor(cmd, args).Execute(s.T(), s.testnet)
+	})
+	if s.T().Failed() {
+		s.FailNow("Stopping due to setup error")
+	}
+
+	s.Run("take holder snapshot", func() {
+		args := []string{
+			denom,
+			fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+			fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+			fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+			fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+		}
+		testcli.NewTxExecutor(markercli.GetCmdTakeHolderSnapshotRequest(), args).Execute(s.T(), s.testnet)
+	})
+	if s.T().Failed() {
+		s.FailNow("Stopping due to snapshot error")
+	}
+
+	clientCtx := s.testnet.Validators[0].ClientCtx
+	var snapshot markertypes.HolderSnapshot
+	s.Run("query holder snapshots", func() {
+		args := []string{denom, fmt.Sprintf("--%s=json", cmtcli.OutputFlag)}
+		out, err := clitestutil.ExecTestCLICmd(clientCtx, markercli.HolderSnapshotsCmd(), args)
+		s.Require().NoError(err, "HolderSnapshotsCmd")
+
+		var resp markertypes.QueryHolderSnapshotsResponse
+		s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), "UnmarshalJSON(%q)", out.String())
+		s.Require().Len(resp.Snapshots, 1, "snapshots")
+		snapshot = resp.Snapshots[0]
+		s.Assert().Equal(denom, snapshot.Denom, "snapshot denom")
+		s.Assert().Equal(uint64(1), snapshot.HolderCount, "snapshot holder count")
+		s.Assert().Equal("1000", snapshot.Total.String(), "snapshot total")
+	})
+	if s.T().Failed() {
+		s.FailNow("Stopping due to snapshots query error")
+	}
+
+	s.Run("query holder snapshot", func() {
+		args := []string{denom, fmt.Sprintf("%d", snapshot.Height), fmt.Sprintf("--%s=json", cmtcli.OutputFlag)}
+		out, err := clitestutil.ExecTestCLICmd(clientCtx, markercli.HolderSnapshotCmd(), args)
+		s.Require().NoError(err, "HolderSnapshotCmd")
+
+		var resp markertypes.QueryHolderSnapshotResponse
+		s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), "UnmarshalJSON(%q)", out.String())
+		s.Assert().Equal(snapshot, resp.Snapshot, "snapshot")
+		expBalances := []markertypes.Balance{{
+			Address: markertypes.MustGetMarkerAddress(denom).String(),
+			Coins:   sdk.NewCoins(sdk.NewInt64Coin(denom, 1000)),
+		}}
+		s.Assert().Equal(expBalances, resp.Balances, "balances")
+	})
+
+	s.Run("query holder snapshot with invalid height", func() {
+		args := []string{denom, "tall", fmt.Sprintf("--%s=json", cmtcli.OutputFlag)}
+		_, err := clitestutil.ExecTestCLICmd(clientCtx, markercli.HolderSnapshotCmd(), args)
+		s.Assert().EqualError(err, "invalid height \"tall\": strconv.ParseInt: parsing \"tall\": invalid syntax", "HolderSnapshotCmd error")
+	})
+}
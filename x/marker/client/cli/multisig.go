@@ -0,0 +1,611 @@
+package cli
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// MultisigBundle is an unsigned marker admin tx along with everything a multisig member needs to sign it offline.
+type MultisigBundle struct {
+	// ChainID is the chain id the tx will be signed for.
+	ChainID string `json:"chain_id"`
+	// Multisig is the bech32 address of the multisig account that is the marker administrator.
+	Multisig string `json:"multisig"`
+	// AccountNumber is the account number of the multisig account.
+	AccountNumber uint64 `json:"account_number,string"`
+	// Sequence is the sequence (nonce) of the multisig account that the tx will be signed with.
+	Sequence uint64 `json:"sequence,string"`
+	// Tx is the JSON encoded unsigned tx.
+	Tx json.RawMessage `json:"tx"`
+}
+
+// GetCmdMultisig returns the command group for offline multisig marker administration.
+func GetCmdMultisig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multisig",
+		Short: "Offline multisig workflow helpers for marker administration",
+		Long: strings.TrimSpace(`Offline multisig workflow helpers for marker administration.
+
+The workflow is:
+1. Generate an unsigned marker admin tx using the multisig as the --from and the --generate-only flag.
+2. Create a bundle from that tx using the generate command. The bundle pins the chain id, account number,
+   and sequence that everyone will sign with. Distribute the bundle file to the multisig members.
+3. Each member reviews the bundle using the review command, then signs it using the sign command, and
+   sends their signature file back.
+4. Once enough signatures are collected, assemble them into a signed tx using the assemble command,
+   then broadcast it using the tx broadcast command.
+
+Only mint, burn, transfer, grant, and revoke marker msgs can be put into a bundle.`),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(
+		GetCmdMultisigGenerate(),
+		GetCmdMultisigReview(),
+		GetCmdMultisigSign(),
+		GetCmdMultisigAssemble(),
+	)
+	return cmd
+}
+
+// GetCmdMultisigGenerate returns the command that creates a multisig bundle from an unsigned tx.
+func GetCmdMultisigGenerate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "generate <multisig> <unsigned-tx-file>",
+		Args:  cobra.ExactArgs(2),
+		Short: "Create a multisig signing bundle from an unsigned marker admin tx",
+		Long: strings.TrimSpace(`Create a multisig signing bundle from an unsigned marker admin tx.
+
+The <multisig> can be a key name or a bech32 address. It must be the only signer of every msg in the tx.
+
+The account number and sequence are looked up from the chain. Provide --sequence to create a bundle for a
+later sequence, e.g. when several bundles are being signed at the same time. When --offline is used, both
+--account-number and --sequence must be provided.`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker mint 1000hotdogcoin --from pb1... --generate-only > mint.json
+$ %[1]s tx marker multisig generate pb1... mint.json --output-document mint-bundle.json`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			if len(clientCtx.ChainID) == 0 {
+				return errors.New("set the chain id with either the --chain-id flag or config file")
+			}
+
+			multisigAddr, err := getMultisigAddress(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			unsignedTx, err := authclient.ReadTxFromFile(clientCtx, args[1])
+			if err != nil {
+				return err
+			}
+
+			accNum, seq, err := getMultisigAccountNumberSequence(cmd, clientCtx, multisigAddr)
+			if err != nil {
+				return err
+			}
+
+			bundle, err := NewMultisigBundle(clientCtx.Codec, clientCtx.TxConfig, unsignedTx, multisigAddr, clientCtx.ChainID, accNum, seq)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(bundle, "", "  ")
+			if err != nil {
+				return err
+			}
+			return writeMultisigOutput(cmd, bz)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The bundle is written to the given file instead of STDOUT")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdMultisigReview returns the command that renders a multisig bundle for review.
+func GetCmdMultisigReview() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "review <bundle-file>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Render a multisig signing bundle for review",
+		Long: strings.TrimSpace(`Render a multisig signing bundle for review.
+
+The output includes a fingerprint of the bytes that will be signed. All members should confirm
+(out-of-band) that they see the same fingerprint before signing.`),
+		Example: fmt.Sprintf(`$ %s tx marker multisig review mint-bundle.json`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bundle, err := ReadMultisigBundle(args[0])
+			if err != nil {
+				return err
+			}
+
+			review, err := RenderMultisigBundle(cmd.Context(), clientCtx.TxConfig, bundle)
+			if err != nil {
+				return err
+			}
+			cmd.Print(review)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdMultisigSign returns the command that signs a multisig bundle as one of the multisig members.
+func GetCmdMultisigSign() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign <bundle-file>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Sign a multisig signing bundle as a member of the multisig",
+		Long: strings.TrimSpace(`Sign a multisig signing bundle as a member of the multisig.
+
+The --from key must be a member of the multisig. The chain id, account number, and sequence are taken
+from the bundle, so no connection to a node is needed. The review of the bundle is printed to STDERR
+and the signature is written to STDOUT (or --output-document).`),
+		Example: fmt.Sprintf(`$ %s tx marker multisig sign mint-bundle.json --from member1 --output-document member1-sig.json`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bundle, err := ReadMultisigBundle(args[0])
+			if err != nil {
+				return err
+			}
+
+			review, err := RenderMultisigBundle(cmd.Context(), clientCtx.TxConfig, bundle)
+			if err != nil {
+				return err
+			}
+			cmd.PrintErr(review)
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			sigs, err := SignMultisigBundle(clientCtx, txf, bundle, clientCtx.GetFromName())
+			if err != nil {
+				return err
+			}
+
+			bz, err := clientCtx.TxConfig.MarshalSignatureJSON(sigs)
+			if err != nil {
+				return err
+			}
+			return writeMultisigOutput(cmd, bz)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The signature is written to the given file instead of STDOUT")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdMultisigAssemble returns the command that combines member signatures into a signed multisig tx.
+func GetCmdMultisigAssemble() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "assemble <bundle-file> <signature-file> [<signature-file> ...]",
+		Args:  cobra.MinimumNArgs(2),
+		Short: "Assemble member signatures into a signed multisig tx",
+		Long: strings.TrimSpace(`Assemble member signatures into a signed multisig tx.
+
+The multisig key (with its public keys) must be in the keyring. Each signature is verified against the
+bundle, and there must be enough of them to meet the multisig threshold.
+
+Unless --offline is used, the multisig account's sequence is checked to make sure the bundle isn't stale.`),
+		Example: fmt.Sprintf(`$ %s tx marker multisig assemble mint-bundle.json member1-sig.json member3-sig.json --output-document mint-signed.json`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bundle, err := ReadMultisigBundle(args[0])
+			if err != nil {
+				return err
+			}
+
+			multisigAddr, err := sdk.AccAddressFromBech32(bundle.Multisig)
+			if err != nil {
+				return fmt.Errorf("invalid bundle multisig %q: %w", bundle.Multisig, err)
+			}
+			record, err := clientCtx.Keyring.KeyByAddress(multisigAddr)
+			if err != nil {
+				return fmt.Errorf("multisig key %s not found in keyring: %w", bundle.Multisig, err)
+			}
+			pubKey, err := record.GetPubKey()
+			if err != nil {
+				return err
+			}
+
+			if !clientCtx.Offline {
+				_, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, multisigAddr)
+				if err != nil {
+					return err
+				}
+				if seq != bundle.Sequence {
+					return fmt.Errorf("bundle is for sequence %d but the multisig account is at sequence %d", bundle.Sequence, seq)
+				}
+			}
+
+			var sigs []signingtypes.SignatureV2
+			for _, filename := range args[1:] {
+				bz, err := os.ReadFile(filename)
+				if err != nil {
+					return fmt.Errorf("could not read signature file %q: %w", filename, err)
+				}
+				fileSigs, err := clientCtx.TxConfig.UnmarshalSignatureJSON(bz)
+				if err != nil {
+					return fmt.Errorf("could not parse signature file %q: %w", filename, err)
+				}
+				sigs = append(sigs, fileSigs...)
+			}
+
+			signedTx, err := AssembleMultisigBundle(cmd.Context(), clientCtx.TxConfig, bundle, pubKey, sigs)
+			if err != nil {
+				return err
+			}
+
+			bz, err := clientCtx.TxConfig.TxJSONEncoder()(signedTx)
+			if err != nil {
+				return err
+			}
+			return writeMultisigOutput(cmd, bz)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The signed tx is written to the given file instead of STDOUT")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// IsMultisigMarkerMsg returns true if the provided msg is one that can be put into a multisig bundle.
+func IsMultisigMarkerMsg(msg sdk.Msg) bool {
+	switch msg.(type) {
+	case *types.MsgMintRequest, *types.MsgBurnRequest, *types.MsgTransferRequest,
+		*types.MsgAddAccessRequest, *types.MsgDeleteAccessRequest:
+		return true
+	}
+	return false
+}
+
+// DescribeMultisigMarkerMsg returns a human-readable one-line description of a marker admin msg.
+func DescribeMultisigMarkerMsg(msg sdk.Msg) string {
+	switch m := msg.(type) {
+	case *types.MsgMintRequest:
+		if len(m.Recipient) > 0 {
+			return fmt.Sprintf("mint %s to %s", m.Amount, m.Recipient)
+		}
+		return fmt.Sprintf("mint %s into the marker account", m.Amount)
+	case *types.MsgBurnRequest:
+		return fmt.Sprintf("burn %s from the marker account", m.Amount)
+	case *types.MsgTransferRequest:
+		return fmt.Sprintf("transfer %s from %s to %s", m.Amount, m.FromAddress, m.ToAddress)
+	case *types.MsgAddAccessRequest:
+		grants := make([]string, len(m.Access))
+		for i, grant := range m.Access {
+			perms := make([]string, len(grant.Permissions))
+			for j, perm := range grant.Permissions {
+				perms[j] = perm.String()
+			}
+			grants[i] = fmt.Sprintf("%s: %s", grant.Address, strings.Join(perms, ","))
+		}
+		return fmt.Sprintf("grant access on %s to %s", m.Denom, strings.Join(grants, "; "))
+	case *types.MsgDeleteAccessRequest:
+		return fmt.Sprintf("revoke all access on %s from %s", m.Denom, m.RemovedAddress)
+	}
+	return sdk.MsgTypeURL(msg)
+}
+
+// NewMultisigBundle creates a bundle for the provided unsigned tx. Every msg in the tx must be a marker
+// admin msg (see IsMultisigMarkerMsg), and the multisig must be its only signer.
+func NewMultisigBundle(cdc codec.Codec, txCfg client.TxConfig, unsignedTx sdk.Tx, multisigAddr sdk.AccAddress, chainID string, accNum, seq uint64) (*MultisigBundle, error) {
+	if len(chainID) == 0 {
+		return nil, errors.New("chain id cannot be empty")
+	}
+	msgs := unsignedTx.GetMsgs()
+	if len(msgs) == 0 {
+		return nil, errors.New("tx does not have any msgs")
+	}
+	for i, msg := range msgs {
+		if !IsMultisigMarkerMsg(msg) {
+			return nil, fmt.Errorf("msg %d: %s is not a supported marker admin msg", i, sdk.MsgTypeURL(msg))
+		}
+		if vmsg, ok := msg.(sdk.HasValidateBasic); ok {
+			if err := vmsg.ValidateBasic(); err != nil {
+				return nil, fmt.Errorf("msg %d: %w", i, err)
+			}
+		}
+		signers, _, err := cdc.GetMsgV1Signers(msg)
+		if err != nil {
+			return nil, fmt.Errorf("msg %d: could not get signers: %w", i, err)
+		}
+		for _, signer := range signers {
+			if !multisigAddr.Equals(sdk.AccAddress(signer)) {
+				return nil, fmt.Errorf("msg %d: signer %s is not the multisig %s", i, sdk.AccAddress(signer), multisigAddr)
+			}
+		}
+	}
+
+	txBz, err := txCfg.TxJSONEncoder()(unsignedTx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MultisigBundle{
+		ChainID:       chainID,
+		Multisig:      multisigAddr.String(),
+		AccountNumber: accNum,
+		Sequence:      seq,
+		Tx:            txBz,
+	}, nil
+}
+
+// ReadMultisigBundle reads a multisig bundle from the provided file.
+func ReadMultisigBundle(filename string) (*MultisigBundle, error) {
+	bz, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("could not read bundle file %q: %w", filename, err)
+	}
+	var bundle MultisigBundle
+	if err = json.Unmarshal(bz, &bundle); err != nil {
+		return nil, fmt.Errorf("could not parse bundle file %q: %w", filename, err)
+	}
+	if len(bundle.ChainID) == 0 || len(bundle.Multisig) == 0 || len(bundle.Tx) == 0 {
+		return nil, fmt.Errorf("bundle file %q must have a chain_id, multisig, and tx", filename)
+	}
+	return &bundle, nil
+}
+
+// DecodeTx decodes the unsigned tx in this bundle.
+func (b MultisigBundle) DecodeTx(txCfg client.TxConfig) (sdk.Tx, error) {
+	rv, err := txCfg.TxJSONDecoder()(b.Tx)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode bundle tx: %w", err)
+	}
+	return rv, nil
+}
+
+// SignBytesFingerprint returns the hex encoded sha256 hash of the LEGACY_AMINO_JSON bytes that each member signs.
+func (b MultisigBundle) SignBytesFingerprint(ctx context.Context, txCfg client.TxConfig) (string, error) {
+	unsignedTx, err := b.DecodeTx(txCfg)
+	if err != nil {
+		return "", err
+	}
+	signerData := authsigning.SignerData{
+		Address:       b.Multisig,
+		ChainID:       b.ChainID,
+		AccountNumber: b.AccountNumber,
+		Sequence:      b.Sequence,
+	}
+	signBz, err := authsigning.GetSignBytesAdapter(ctx, txCfg.SignModeHandler(), signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signerData, unsignedTx)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(signBz)
+	return strings.ToUpper(hex.EncodeToString(hash[:])), nil
+}
+
+// RenderMultisigBundle returns a human-readable rendering of the provided bundle for members to review.
+func RenderMultisigBundle(ctx context.Context, txCfg client.TxConfig, bundle *MultisigBundle) (string, error) {
+	unsignedTx, err := bundle.DecodeTx(txCfg)
+	if err != nil {
+		return "", err
+	}
+	fingerprint, err := bundle.SignBytesFingerprint(ctx, txCfg)
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Chain ID:       %s\n", bundle.ChainID)
+	fmt.Fprintf(&sb, "Multisig:       %s\n", bundle.Multisig)
+	fmt.Fprintf(&sb, "Account Number: %d\n", bundle.AccountNumber)
+	fmt.Fprintf(&sb, "Sequence:       %d\n", bundle.Sequence)
+	if feeTx, ok := unsignedTx.(sdk.FeeTx); ok {
+		fmt.Fprintf(&sb, "Fee:            %s\n", feeTx.GetFee())
+		fmt.Fprintf(&sb, "Gas Limit:      %d\n", feeTx.GetGas())
+	}
+	if memoTx, ok := unsignedTx.(sdk.TxWithMemo); ok && len(memoTx.GetMemo()) > 0 {
+		fmt.Fprintf(&sb, "Memo:           %s\n", memoTx.GetMemo())
+	}
+	fmt.Fprintf(&sb, "Fingerprint:    %s\n", fingerprint)
+	sb.WriteString("Messages:\n")
+	for i, msg := range unsignedTx.GetMsgs() {
+		fmt.Fprintf(&sb, "  [%d] %s\n", i, sdk.MsgTypeURL(msg))
+		fmt.Fprintf(&sb, "      %s\n", DescribeMultisigMarkerMsg(msg))
+	}
+	return sb.String(), nil
+}
+
+// SignMultisigBundle signs the bundle's tx with the named key using the bundle's chain id, account number,
+// and sequence, and returns the resulting signatures.
+func SignMultisigBundle(clientCtx client.Context, txf tx.Factory, bundle *MultisigBundle, name string) ([]signingtypes.SignatureV2, error) {
+	multisigAddr, err := sdk.AccAddressFromBech32(bundle.Multisig)
+	if err != nil {
+		return nil, fmt.Errorf("invalid bundle multisig %q: %w", bundle.Multisig, err)
+	}
+	unsignedTx, err := bundle.DecodeTx(clientCtx.TxConfig)
+	if err != nil {
+		return nil, err
+	}
+	txBuilder, err := clientCtx.TxConfig.WrapTxBuilder(unsignedTx)
+	if err != nil {
+		return nil, err
+	}
+
+	txf = txf.WithChainID(bundle.ChainID).
+		WithAccountNumber(bundle.AccountNumber).
+		WithSequence(bundle.Sequence).
+		WithSignMode(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	if err = authclient.SignTxWithSignerAddress(txf, clientCtx, multisigAddr, name, txBuilder, true, true); err != nil {
+		return nil, err
+	}
+	return txBuilder.GetTx().GetSignaturesV2()
+}
+
+// AssembleMultisigBundle verifies the provided member signatures against the bundle and combines them
+// into a tx signed by the multisig. An error is returned if there are not enough valid signatures to
+// meet the multisig threshold.
+func AssembleMultisigBundle(ctx context.Context, txCfg client.TxConfig, bundle *MultisigBundle, pubKey cryptotypes.PubKey, sigs []signingtypes.SignatureV2) (sdk.Tx, error) {
+	multisigPub, ok := pubKey.(*kmultisig.LegacyAminoPubKey)
+	if !ok {
+		return nil, fmt.Errorf("key for %s is not a multisig key", bundle.Multisig)
+	}
+	if addr := sdk.AccAddress(multisigPub.Address()).String(); addr != bundle.Multisig {
+		return nil, fmt.Errorf("multisig key address %s does not match bundle multisig %s", addr, bundle.Multisig)
+	}
+
+	unsignedTx, err := bundle.DecodeTx(txCfg)
+	if err != nil {
+		return nil, err
+	}
+	txBuilder, err := txCfg.WrapTxBuilder(unsignedTx)
+	if err != nil {
+		return nil, err
+	}
+	adaptableTx, ok := txBuilder.GetTx().(authsigning.V2AdaptableTx)
+	if !ok {
+		return nil, fmt.Errorf("expected tx to be signing.V2AdaptableTx, got %T", txBuilder.GetTx())
+	}
+	txData := adaptableTx.GetSigningTxData()
+
+	multisigSig := multisig.NewMultisig(len(multisigPub.PubKeys))
+	seen := make(map[string]bool)
+	for _, sig := range sigs {
+		signer := sdk.AccAddress(sig.PubKey.Address()).String()
+		if seen[signer] {
+			return nil, fmt.Errorf("duplicate signature from %s", signer)
+		}
+		seen[signer] = true
+
+		if sig.Sequence != bundle.Sequence {
+			return nil, fmt.Errorf("signature from %s is for sequence %d, expected %d", signer, sig.Sequence, bundle.Sequence)
+		}
+
+		anyPk, err := codectypes.NewAnyWithValue(sig.PubKey)
+		if err != nil {
+			return nil, err
+		}
+		signerData := txsigning.SignerData{
+			ChainID:       bundle.ChainID,
+			AccountNumber: bundle.AccountNumber,
+			Sequence:      bundle.Sequence,
+			Address:       signer,
+			PubKey:        &anypb.Any{TypeUrl: anyPk.TypeUrl, Value: anyPk.Value},
+		}
+		if err = authsigning.VerifySignature(ctx, sig.PubKey, signerData, sig.Data, txCfg.SignModeHandler(), txData); err != nil {
+			return nil, fmt.Errorf("could not verify signature from %s: %w", signer, err)
+		}
+
+		if err = multisig.AddSignatureV2(multisigSig, sig, multisigPub.GetPubKeys()); err != nil {
+			return nil, fmt.Errorf("signature from %s: %w", signer, err)
+		}
+	}
+
+	if len(seen) < int(multisigPub.Threshold) {
+		return nil, fmt.Errorf("not enough signatures: have %d, need %d", len(seen), multisigPub.Threshold)
+	}
+
+	err = txBuilder.SetSignatures(signingtypes.SignatureV2{
+		PubKey:   multisigPub,
+		Data:     multisigSig,
+		Sequence: bundle.Sequence,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return txBuilder.GetTx(), nil
+}
+
+// getMultisigAddress gets the address of the provided key name or bech32 address.
+func getMultisigAddress(clientCtx client.Context, multisigArg string) (sdk.AccAddress, error) {
+	if addr, err := sdk.AccAddressFromBech32(multisigArg); err == nil {
+		return addr, nil
+	}
+	record, err := clientCtx.Keyring.Key(multisigArg)
+	if err != nil {
+		return nil, fmt.Errorf("multisig %q is neither a bech32 address nor a key name: %w", multisigArg, err)
+	}
+	return record.GetAddress()
+}
+
+// getMultisigAccountNumberSequence gets the account number and sequence to use for a new bundle.
+// When offline, they must both be provided via flags. Otherwise, they are looked up and the
+// sequence can be overridden by the --sequence flag as long as it isn't already used.
+func getMultisigAccountNumberSequence(cmd *cobra.Command, clientCtx client.Context, addr sdk.AccAddress) (uint64, uint64, error) {
+	seqFlag := cmd.Flags().Lookup(flags.FlagSequence)
+	if clientCtx.Offline {
+		accNumFlag := cmd.Flags().Lookup(flags.FlagAccountNumber)
+		if !accNumFlag.Changed || !seqFlag.Changed {
+			return 0, 0, fmt.Errorf("--%s and --%s are required with --%s", flags.FlagAccountNumber, flags.FlagSequence, flags.FlagOffline)
+		}
+		accNum, _ := cmd.Flags().GetUint64(flags.FlagAccountNumber)
+		seq, _ := cmd.Flags().GetUint64(flags.FlagSequence)
+		return accNum, seq, nil
+	}
+
+	accNum, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, addr)
+	if err != nil {
+		return 0, 0, err
+	}
+	if seqFlag.Changed {
+		flagSeq, _ := cmd.Flags().GetUint64(flags.FlagSequence)
+		if flagSeq < seq {
+			return 0, 0, fmt.Errorf("sequence %d has already been used, the multisig account is at sequence %d", flagSeq, seq)
+		}
+		seq = flagSeq
+	}
+	return accNum, seq, nil
+}
+
+// writeMultisigOutput writes the provided bytes to the --output-document file, or the cmd's output if not provided.
+func writeMultisigOutput(cmd *cobra.Command, bz []byte) error {
+	outputDoc, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+	if len(outputDoc) == 0 {
+		cmd.Println(string(bz))
+		return nil
+	}
+	if err := os.WriteFile(outputDoc, append(bz, '\n'), 0o644); err != nil {
+		return fmt.Errorf("could not write %q: %w", outputDoc, err)
+	}
+	return nil
+}
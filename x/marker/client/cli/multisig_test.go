@@ -0,0 +1,139 @@
+package cli_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/marker/client/cli"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestMultisigBundleWorkflow(t *testing.T) {
+	encCfg := app.MakeTestEncodingConfig(t)
+	kr := keyring.NewInMemory(encCfg.Marshaler)
+
+	memberNames := []string{"member1", "member2", "member3"}
+	memberPubs := make([]cryptotypes.PubKey, len(memberNames))
+	for i, name := range memberNames {
+		record, _, err := kr.NewMnemonic(name, keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		require.NoError(t, err, "NewMnemonic(%q)", name)
+		memberPubs[i], err = record.GetPubKey()
+		require.NoError(t, err, "GetPubKey(%q)", name)
+	}
+	multisigPub := kmultisig.NewLegacyAminoPubKey(2, memberPubs)
+	_, err := kr.SaveMultisig("admins", multisigPub)
+	require.NoError(t, err, "SaveMultisig")
+	multisigAddr := sdk.AccAddress(multisigPub.Address())
+	other := sdk.AccAddress("other_______________")
+
+	clientCtx := client.Context{}.
+		WithCodec(encCfg.Marshaler).
+		WithTxConfig(encCfg.TxConfig).
+		WithKeyring(kr).
+		WithCmdContext(context.Background())
+	txf := tx.Factory{}.WithTxConfig(encCfg.TxConfig).WithKeybase(kr)
+
+	newTx := func(msgs ...sdk.Msg) sdk.Tx {
+		txBuilder := encCfg.TxConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(msgs...), "SetMsgs")
+		txBuilder.SetGasLimit(200000)
+		txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("nhash", 380000000)))
+		return txBuilder.GetTx()
+	}
+	mintMsg := types.NewMsgMintRequest(multisigAddr, sdk.NewCoin("hotdog", sdkmath.NewInt(1000)), nil)
+
+	t.Run("bundle creation", func(t *testing.T) {
+		_, err = cli.NewMultisigBundle(encCfg.Marshaler, encCfg.TxConfig, newTx(mintMsg), multisigAddr, "", 5, 3)
+		assert.EqualError(t, err, "chain id cannot be empty", "no chain id")
+
+		sendMsg := banktypes.NewMsgSend(multisigAddr, other, sdk.NewCoins(sdk.NewInt64Coin("nhash", 1)))
+		_, err = cli.NewMultisigBundle(encCfg.Marshaler, encCfg.TxConfig, newTx(mintMsg, sendMsg), multisigAddr, "testchain", 5, 3)
+		assert.EqualError(t, err, "msg 1: /cosmos.bank.v1beta1.MsgSend is not a supported marker admin msg", "bank send")
+
+		otherMint := types.NewMsgMintRequest(other, sdk.NewCoin("hotdog", sdkmath.NewInt(1000)), nil)
+		_, err = cli.NewMultisigBundle(encCfg.Marshaler, encCfg.TxConfig, newTx(otherMint), multisigAddr, "testchain", 5, 3)
+		assert.EqualError(t, err, "msg 0: signer "+other.String()+" is not the multisig "+multisigAddr.String(), "wrong signer")
+	})
+
+	transferMsg := types.NewMsgTransferRequest(multisigAddr, other, multisigAddr, sdk.NewCoin("hotdog", sdkmath.NewInt(5)))
+	accessMsg := types.NewMsgAddAccessRequest("hotdog", multisigAddr, types.AccessGrant{
+		Address:     other.String(),
+		Permissions: types.AccessList{types.Access_Mint, types.Access_Burn},
+	})
+	bundle, err := cli.NewMultisigBundle(encCfg.Marshaler, encCfg.TxConfig, newTx(mintMsg, transferMsg, accessMsg), multisigAddr, "testchain", 5, 3)
+	require.NoError(t, err, "NewMultisigBundle")
+
+	bundleFile := filepath.Join(t.TempDir(), "bundle.json")
+	bundleBz, err := json.Marshal(bundle)
+	require.NoError(t, err, "json.Marshal(bundle)")
+	require.NoError(t, os.WriteFile(bundleFile, bundleBz, 0o644), "WriteFile(bundle)")
+	bundle, err = cli.ReadMultisigBundle(bundleFile)
+	require.NoError(t, err, "ReadMultisigBundle")
+	assert.Equal(t, uint64(5), bundle.AccountNumber, "bundle account number")
+	assert.Equal(t, uint64(3), bundle.Sequence, "bundle sequence")
+
+	t.Run("review", func(t *testing.T) {
+		review, err := cli.RenderMultisigBundle(context.Background(), encCfg.TxConfig, bundle)
+		require.NoError(t, err, "RenderMultisigBundle")
+		fingerprint, err := bundle.SignBytesFingerprint(context.Background(), encCfg.TxConfig)
+		require.NoError(t, err, "SignBytesFingerprint")
+		assert.Len(t, fingerprint, 64, "fingerprint")
+		assert.Contains(t, review, "Chain ID:       testchain\n", "review")
+		assert.Contains(t, review, "Sequence:       3\n", "review")
+		assert.Contains(t, review, "Fingerprint:    "+fingerprint+"\n", "review")
+		assert.Contains(t, review, "mint 1000hotdog into the marker account\n", "review")
+		assert.Contains(t, review, "transfer 5hotdog from "+other.String()+" to "+multisigAddr.String()+"\n", "review")
+		assert.Contains(t, review, "grant access on hotdog to "+other.String()+": ACCESS_MINT,ACCESS_BURN\n", "review")
+	})
+
+	var sigs []signingtypes.SignatureV2
+	for _, name := range memberNames {
+		memberSigs, err := cli.SignMultisigBundle(clientCtx, txf, bundle, name)
+		require.NoError(t, err, "SignMultisigBundle(%q)", name)
+		require.Len(t, memberSigs, 1, "SignMultisigBundle(%q) signatures", name)
+		assert.Equal(t, bundle.Sequence, memberSigs[0].Sequence, "SignMultisigBundle(%q) signature sequence", name)
+		sigs = append(sigs, memberSigs...)
+	}
+
+	t.Run("assemble", func(t *testing.T) {
+		_, err = cli.AssembleMultisigBundle(context.Background(), encCfg.TxConfig, bundle, multisigPub, sigs[:1])
+		assert.EqualError(t, err, "not enough signatures: have 1, need 2", "one signature")
+
+		_, err = cli.AssembleMultisigBundle(context.Background(), encCfg.TxConfig, bundle, multisigPub, []signingtypes.SignatureV2{sigs[0], sigs[0]})
+		assert.ErrorContains(t, err, "duplicate signature from", "duplicate signature")
+
+		staleBundle := *bundle
+		staleBundle.Sequence = 4
+		_, err = cli.AssembleMultisigBundle(context.Background(), encCfg.TxConfig, &staleBundle, multisigPub, sigs[:2])
+		assert.ErrorContains(t, err, "is for sequence 3, expected 4", "different sequence")
+
+		signedTx, err := cli.AssembleMultisigBundle(context.Background(), encCfg.TxConfig, bundle, multisigPub, []signingtypes.SignatureV2{sigs[0], sigs[2]})
+		require.NoError(t, err, "AssembleMultisigBundle")
+		txSigs, err := signedTx.(interface {
+			GetSignaturesV2() ([]signingtypes.SignatureV2, error)
+		}).GetSignaturesV2()
+		require.NoError(t, err, "GetSignaturesV2")
+		require.Len(t, txSigs, 1, "signed tx signatures")
+		assert.True(t, multisigPub.Equals(txSigs[0].PubKey), "signed tx signature pub key is the multisig")
+		assert.Equal(t, bundle.Sequence, txSigs[0].Sequence, "signed tx signature sequence")
+	})
+}
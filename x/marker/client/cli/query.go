@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -33,6 +34,13 @@ func GetQueryCmd() *cobra.Command {
 		MarkerSupplyCmd(),
 		AccountDataCmd(),
 		NetAssetValuesCmd(),
+		SupplyScheduleCmd(),
+		SendAllowListCmd(),
+		PauseStatusCmd(),
+		TransferFeeCmd(),
+		VelocityLimitCmd(),
+		HolderSnapshotsCmd(),
+		HolderSnapshotCmd(),
 	)
 	return queryCmd
 }
@@ -334,3 +342,239 @@ func NetAssetValuesCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// SupplyScheduleCmd is the CLI command for querying a marker's pending supply schedule.
+func SupplyScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "supply-schedule <address|denom>",
+		Aliases: []string{"supplyschedule", "ss"},
+		Short:   "Get a marker's pending supply schedule",
+		Long:    `Note: the address is for the base_account of the denom should you choose to use the address rather than the denom name`,
+		Example: fmt.Sprintf(`$ %s query marker supply-schedule "nhash"`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+
+			req := &types.QuerySupplyScheduleRequest{Id: id}
+			resp, err := queryClient.SupplySchedule(context.Background(), req)
+			if err != nil {
+				return fmt.Errorf("failed to query supply schedule for marker %q: %w", id, err)
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// SendAllowListCmd is the CLI command for querying a marker's send allow list.
+func SendAllowListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "send-allow-list <address|denom>",
+		Aliases: []string{"sendallowlist", "allow-list", "sal"},
+		Short:   "Get whether allowlist mode is on for a restricted marker and the addresses on its send allow list",
+		Long:    `Note: the address is for the base_account of the denom should you choose to use the address rather than the denom name`,
+		Example: fmt.Sprintf(`$ %s query marker send-allow-list "hotdogcoin"`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QuerySendAllowListRequest{Id: id, Pagination: pageReq}
+			resp, err := queryClient.SendAllowList(context.Background(), req)
+			if err != nil {
+				return fmt.Errorf("failed to query send allow list for marker %q: %w", id, err)
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "allowed addresses")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// PauseStatusCmd is the CLI command for querying whether a marker is paused.
+func PauseStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pause-status <address|denom>",
+		Aliases: []string{"pausestatus", "paused"},
+		Short:   "Get whether sends of a marker's denom are paused",
+		Long:    `Note: the address is for the base_account of the denom should you choose to use the address rather than the denom name`,
+		Example: fmt.Sprintf(`$ %s query marker pause-status "hotdogcoin"`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+
+			resp, err := queryClient.PauseStatus(context.Background(), &types.QueryPauseStatusRequest{Id: id})
+			if err != nil {
+				return fmt.Errorf("failed to query pause status for marker %q: %w", id, err)
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// TransferFeeCmd is the CLI command for querying the fee charged on sends of a marker's denom.
+func TransferFeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "transfer-fee <address|denom>",
+		Aliases: []string{"transferfee", "tf"},
+		Short:   "Get the fee charged on sends of a marker's denom",
+		Long:    `Note: the address is for the base_account of the denom should you choose to use the address rather than the denom name`,
+		Example: fmt.Sprintf(`$ %s query marker transfer-fee "hotdogcoin"`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+
+			resp, err := queryClient.TransferFee(context.Background(), &types.QueryTransferFeeRequest{Id: id})
+			if err != nil {
+				return fmt.Errorf("failed to query transfer fee for marker %q: %w", id, err)
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// VelocityLimitCmd is the CLI command for querying the limit on how much of a marker's denom an address can send.
+func VelocityLimitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "velocity-limit <address|denom> [<sender>]",
+		Aliases: []string{"velocitylimit", "vl"},
+		Short:   "Get the limit on how much of a marker's denom an address can send in a rolling window",
+		Long: strings.TrimSpace(`Get the limit on how much of a marker's denom an address can send in a rolling window.
+If a sender is provided, the amount they have sent during the current window is also returned.
+Note: the address is for the base_account of the denom should you choose to use the address rather than the denom name`),
+		Example: fmt.Sprintf(`$ %[1]s query marker velocity-limit "hotdogcoin"
+$ %[1]s query marker velocity-limit "hotdogcoin" pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk`, version.AppName),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryVelocityLimitRequest{Id: strings.TrimSpace(args[0])}
+			if len(args) > 1 {
+				req.Address = strings.TrimSpace(args[1])
+			}
+
+			resp, err := queryClient.VelocityLimit(context.Background(), req)
+			if err != nil {
+				return fmt.Errorf("failed to query velocity limit for marker %q: %w", req.Id, err)
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// HolderSnapshotsCmd is the CLI command for querying the snapshots that have been taken of a marker's holders.
+func HolderSnapshotsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "holder-snapshots <address|denom>",
+		Aliases: []string{"holdersnapshots", "snapshots"},
+		Short:   "Get the snapshots that have been taken of a marker's holders",
+		Long:    `Note: the address is for the base_account of the denom should you choose to use the address rather than the denom name`,
+		Example: fmt.Sprintf(`$ %s query marker holder-snapshots "hotdogcoin"`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryHolderSnapshotsRequest{Id: id, Pagination: pageReq}
+			resp, err := queryClient.HolderSnapshots(context.Background(), req)
+			if err != nil {
+				return fmt.Errorf("failed to query holder snapshots for marker %q: %w", id, err)
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "snapshots")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// HolderSnapshotCmd is the CLI command for querying the balances recorded in a snapshot of a marker's holders.
+func HolderSnapshotCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "holder-snapshot <address|denom> <height>",
+		Aliases: []string{"holdersnapshot", "snapshot"},
+		Short:   "Get the balances recorded in a snapshot of a marker's holders",
+		Long:    `Note: the address is for the base_account of the denom should you choose to use the address rather than the denom name`,
+		Example: fmt.Sprintf(`$ %s query marker holder-snapshot "hotdogcoin" 1234`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+
+			height, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %q: %w", args[1], err)
+			}
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryHolderSnapshotRequest{Id: id, Height: height, Pagination: pageReq}
+			resp, err := queryClient.HolderSnapshot(context.Background(), req)
+			if err != nil {
+				return fmt.Errorf("failed to query holder snapshot for marker %q at height %d: %w", id, height, err)
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "balances")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
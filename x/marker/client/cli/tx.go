@@ -56,6 +56,8 @@ const (
 	FlagUsdMills               = "usd-mills"
 	FlagVolume                 = "volume"
 	FlagTargetAddress          = "target-address"
+	FlagEnableNAVAttestations  = "enable-nav-attestations"
+	FlagNAVMaxDeviationBps     = "nav-max-deviation-bps"
 )
 
 // NewTxCmd returns the top-level command for marker CLI transactions.
@@ -90,6 +92,13 @@ func NewTxCmd() *cobra.Command {
 		GetCmdUpdateForcedTransfer(),
 		GetCmdSetAccountData(),
 		GetCmdUpdateSendDenyListRequest(),
+		GetCmdUpdateSendAllowListRequest(),
+		GetCmdSetSendAllowListModeRequest(),
+		GetCmdPauseMarkerRequest(),
+		GetCmdUnpauseMarkerRequest(),
+		GetCmdSetTransferFeeRequest(),
+		GetCmdSetVelocityLimitRequest(),
+		GetCmdTakeHolderSnapshotRequest(),
 		GetCmdAddNetAssetValues(),
 		GetCmdSupplyDecreaseProposal(),
 		GetCmdSupplyIncreaseProposal(),
@@ -98,6 +107,8 @@ func NewTxCmd() *cobra.Command {
 		GetCmdChangeStatusProposal(),
 		GetCmdWithdrawEscrowProposal(),
 		GetUpdateMarkerParamsCmd(),
+		GetCmdSetSupplyScheduleProposal(),
+		GetCmdMultisig(),
 	)
 	return txCmd
 }
@@ -1052,6 +1063,276 @@ func GetCmdUpdateSendDenyListRequest() *cobra.Command {
 	return cmd
 }
 
+// GetCmdUpdateSendAllowListRequest implements the update allow list command
+func GetCmdUpdateSendAllowListRequest() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-allow-list <denom>",
+		Aliases: []string{"ual", "allow-list", "allow"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Update list of addresses that can receive a restricted marker while it is in allowlist mode",
+		Long: strings.TrimSpace(`Update list of addresses that can receive a restricted marker while it is in allowlist mode.
+Allowlist mode is turned on and off using the set-allow-list-mode command.
+`),
+		Example: fmt.Sprintf(`$ %s tx marker update-allow-list hotdogcoin --%s=bech32addr1,bech32addrs2,... --%s=bech32addr1,bech32addrs2,...`,
+			version.AppName,
+			FlagAdd,
+			FlagRemove,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+
+			msg := &types.MsgUpdateSendAllowListRequest{Denom: args[0]}
+
+			msg.AddAllowedAddresses, err = flagSet.GetStringSlice(FlagAdd)
+			if err != nil {
+				return fmt.Errorf("incorrect value for %s flag.  Accepted: comma delimited list of bech32 addresses Error: %w", FlagAdd, err)
+			}
+
+			msg.RemoveAllowedAddresses, err = flagSet.GetStringSlice(FlagRemove)
+			if err != nil {
+				return fmt.Errorf("incorrect value for %s flag.  Accepted: comma delimited list of bech32 addresses Error: %w", FlagRemove, err)
+			}
+
+			authSetter := func(authority string) {
+				msg.Authority = authority
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, flagSet, authSetter, msg)
+		},
+	}
+	cmd.Flags().StringSlice(FlagAdd, []string{}, "comma delimited list of bech32 addresses to be added to restricted marker allow list")
+	cmd.Flags().StringSlice(FlagRemove, []string{}, "comma delimited list of bech32 addresses to be removed from restricted marker allow list")
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetSendAllowListModeRequest implements the command to turn allowlist mode on or off for a restricted marker.
+func GetCmdSetSendAllowListModeRequest() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-allow-list-mode <denom> <true|false>",
+		Aliases: []string{"salm", "allow-list-mode"},
+		Args:    cobra.ExactArgs(2),
+		Short:   "Turn allowlist mode on or off for a restricted marker",
+		Long: strings.TrimSpace(`Turn allowlist mode on or off for a restricted marker.
+While allowlist mode is on, only addresses on the marker's send allow list can receive the denom.
+`),
+		Example: fmt.Sprintf(`$ %s tx marker set-allow-list-mode hotdogcoin true`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid allowlist mode %q: %w", args[1], err)
+			}
+			msg := &types.MsgSetSendAllowListModeRequest{Denom: args[0], Enabled: enabled}
+
+			authSetter := func(authority string) {
+				msg.Authority = authority
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, flagSet, authSetter, msg)
+		},
+	}
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdPauseMarkerRequest implements the command to pause all sends of a marker's denom.
+func GetCmdPauseMarkerRequest() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause <denom>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Pause all sends of a marker's denom",
+		Long: strings.TrimSpace(`Pause all sends of a marker's denom.
+While a marker is paused, only forced transfers and module operations (e.g. withdrawals) can move its denom.
+`),
+		Example: fmt.Sprintf(`$ %s tx marker pause hotdogcoin`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+
+			msg := &types.MsgPauseMarkerRequest{Denom: args[0]}
+
+			authSetter := func(authority string) {
+				msg.Authority = authority
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, flagSet, authSetter, msg)
+		},
+	}
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdUnpauseMarkerRequest implements the command to allow sends of a paused marker's denom again.
+func GetCmdUnpauseMarkerRequest() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "unpause <denom>",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Allow sends of a paused marker's denom again",
+		Example: fmt.Sprintf(`$ %s tx marker unpause hotdogcoin`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+
+			msg := &types.MsgUnpauseMarkerRequest{Denom: args[0]}
+
+			authSetter := func(authority string) {
+				msg.Authority = authority
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, flagSet, authSetter, msg)
+		},
+	}
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetTransferFeeRequest implements the command to set or remove the fee charged on sends of a marker's denom.
+func GetCmdSetTransferFeeRequest() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-transfer-fee <denom> <basis points> [<recipient>]",
+		Aliases: []string{"stf"},
+		Args:    cobra.RangeArgs(2, 3),
+		Short:   "Set or remove the fee charged on sends of a marker's denom",
+		Long: strings.TrimSpace(`Set or remove the fee charged on sends of a marker's denom.
+The fee is charged to the sender, in addition to the amount being sent, and is paid to the recipient.
+A basis point is one hundredth of a percent, e.g. 25 = 0.25%.
+To remove a marker's transfer fee, provide 0 basis points and no recipient.
+`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker set-transfer-fee hotdogcoin 25 pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+$ %[1]s tx marker set-transfer-fee hotdogcoin 0`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+
+			basisPoints, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid basis points %q: %w", args[1], err)
+			}
+			msg := &types.MsgSetTransferFeeRequest{Denom: args[0], BasisPoints: uint32(basisPoints)}
+			if len(args) > 2 {
+				msg.Recipient = args[2]
+			}
+
+			authSetter := func(authority string) {
+				msg.Authority = authority
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, flagSet, authSetter, msg)
+		},
+	}
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetVelocityLimitRequest implements the command to set or remove the limit on how much of a marker's denom
+// any single address can send in a rolling window.
+func GetCmdSetVelocityLimitRequest() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-velocity-limit <denom> <max amount> [<window seconds> <bucket seconds>]",
+		Aliases: []string{"svl"},
+		Args: func(_ *cobra.Command, args []string) error {
+			if len(args) != 2 && len(args) != 4 {
+				return fmt.Errorf("accepts 2 or 4 arg(s), received %d", len(args))
+			}
+			return nil
+		},
+		Short: "Set or remove the limit on how much of a marker's denom an address can send in a rolling window",
+		Long: strings.TrimSpace(`Set or remove the limit on how much of a marker's denom an address can send in a rolling window.
+The window is split into buckets, so the window seconds must be a multiple of the bucket seconds.
+Sends are recorded in the bucket for the block they're in, and a bucket leaves the window once it's older than the window.
+Changing the bucket seconds of an existing limit resets the amounts that have been recorded.
+To remove a marker's velocity limit, provide a max amount of 0 and no window or bucket seconds.
+`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker set-velocity-limit hotdogcoin 1000000 86400 3600
+$ %[1]s tx marker set-velocity-limit hotdogcoin 0`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+
+			msg := &types.MsgSetVelocityLimitRequest{Denom: args[0], MaxAmount: args[1]}
+			if len(args) > 2 {
+				msg.WindowSeconds, err = strconv.ParseUint(args[2], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid window seconds %q: %w", args[2], err)
+				}
+				msg.BucketSeconds, err = strconv.ParseUint(args[3], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid bucket seconds %q: %w", args[3], err)
+				}
+			}
+
+			authSetter := func(authority string) {
+				msg.Authority = authority
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, flagSet, authSetter, msg)
+		},
+	}
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdTakeHolderSnapshotRequest implements the command to record the balances of all holders of a marker's denom.
+func GetCmdTakeHolderSnapshotRequest() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "take-holder-snapshot <denom>",
+		Aliases: []string{"ths"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Record the balances of all holders of a marker's denom at the current block height",
+		Long: strings.TrimSpace(`Record the balances of all holders of a marker's denom at the current block height.
+Only one snapshot of a marker can be taken at each height.
+The snapshot can then be looked up by the height it was taken at, e.g. to pay dividends or count votes.
+`),
+		Example: fmt.Sprintf(`$ %s tx marker take-holder-snapshot hotdogcoin`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+
+			msg := &types.MsgTakeHolderSnapshotRequest{Denom: args[0]}
+
+			authSetter := func(authority string) {
+				msg.Authority = authority
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, flagSet, authSetter, msg)
+		},
+	}
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdSetAccountData returns a CLI command for setting a marker's account data.
 func GetCmdSetAccountData() *cobra.Command {
 	cmd := &cobra.Command{
@@ -1487,6 +1768,92 @@ func GetCmdSetDenomMetadataProposal() *cobra.Command {
 	return cmd
 }
 
+// GetCmdSetSupplyScheduleProposal returns a CLI command for submitting a set supply schedule proposal.
+func GetCmdSetSupplyScheduleProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-supply-schedule-proposal <denom> [<step>[;<step>...]]",
+		Aliases: []string{"set-supply-schedule", "sssp"},
+		Args:    cobra.RangeArgs(1, 2),
+		Short:   "Submit a governance proposal to set or remove a marker's supply schedule",
+		Long: strings.TrimSpace(`Submit a governance proposal to set or remove a marker's supply schedule.
+The provided steps replace any existing steps. If no steps are provided, the marker's supply schedule is removed.
+
+Each step has the format <mint|burn>,<amount>,<height|time>[,<recipient>].
+The time must be in RFC 3339 format. The recipient is optional and only allowed on mint steps.`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker set-supply-schedule-proposal mycoin "mint,1000,500000;burn,250,2030-01-01T00:00:00Z" --title "My Title" --summary "My summary" --deposit 1000000000nhash
+$ %[1]s tx marker sssp mycoin "mint,1000,500000,pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj" --title "My Title" --summary "My summary" --deposit 1000000000nhash
+$ %[1]s tx marker sssp mycoin --title "Remove schedule" --summary "My summary" --deposit 1000000000nhash
+`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+			authority := provcli.GetAuthority(flagSet)
+			var steps []types.SupplyScheduleStep
+			if len(args) > 1 {
+				steps, err = ParseSupplyScheduleSteps(args[1])
+				if err != nil {
+					return err
+				}
+			}
+			msg := types.NewMsgSetSupplyScheduleRequest(strings.TrimSpace(args[0]), steps, authority)
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	return cmd
+}
+
+// ParseSupplyScheduleSteps splits a string (example mint,100,5000;burn,10,2030-01-01T00:00:00Z) into a list of SupplyScheduleSteps.
+func ParseSupplyScheduleSteps(stepsString string) ([]types.SupplyScheduleStep, error) {
+	if len(strings.TrimSpace(stepsString)) == 0 {
+		return nil, nil
+	}
+	parts := strings.Split(stepsString, ";")
+	steps := make([]types.SupplyScheduleStep, len(parts))
+	for i, part := range parts {
+		fields := strings.Split(strings.TrimSpace(part), ",")
+		if len(fields) < 3 || len(fields) > 4 {
+			return nil, fmt.Errorf("invalid supply schedule step %q, expected <mint|burn>,<amount>,<height|time>[,<recipient>]", part)
+		}
+
+		switch strings.ToLower(fields[0]) {
+		case "mint":
+			steps[i].Action = types.SupplyScheduleAction_Mint
+		case "burn":
+			steps[i].Action = types.SupplyScheduleAction_Burn
+		default:
+			return nil, fmt.Errorf("invalid supply schedule action %q, expected mint or burn", fields[0])
+		}
+
+		amount, ok := sdkmath.NewIntFromString(fields[1])
+		if !ok {
+			return nil, fmt.Errorf("invalid supply schedule amount %q", fields[1])
+		}
+		steps[i].Amount = amount
+
+		if height, err := strconv.ParseInt(fields[2], 10, 64); err == nil {
+			steps[i].Height = height
+		} else {
+			when, err := time.Parse(time.RFC3339, fields[2])
+			if err != nil {
+				return nil, fmt.Errorf("invalid supply schedule height or time %q", fields[2])
+			}
+			when = when.UTC()
+			steps[i].Time = &when
+		}
+
+		if len(fields) == 4 {
+			steps[i].Recipient = fields[3]
+		}
+	}
+	return steps, nil
+}
+
 // ParseNetAssetValueString splits string (example 1hotdog,1;2jackthecat100,...) to list of NetAssetValue's
 func ParseNetAssetValueString(netAssetValuesString string) ([]types.NetAssetValue, error) {
 	navs := strings.Split(netAssetValuesString, ";")
@@ -1634,11 +2001,17 @@ func generateOrBroadcastOptGovProp(clientCtx client.Context, flagSet *pflag.Flag
 // GetUpdateMarkerParamsCmd creates a command to update the marker module's params via governance proposal.
 func GetUpdateMarkerParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "update-marker-params <enable-governance> <unrestricted-denom-regex> <max-supply>",
-		Short:   "Update the marker module's params via governance proposal",
-		Long:    "Submit an update marker params via governance proposal along with an initial deposit.",
-		Args:    cobra.ExactArgs(3),
-		Example: fmt.Sprintf(`%[1]s tx marker update-marker-params true "[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}" 1000000000000 --deposit 50000nhash`, version.AppName),
+		Use:   "update-marker-params <enable-governance> <unrestricted-denom-regex> <max-supply>",
+		Short: "Update the marker module's params via governance proposal",
+		Long: fmt.Sprintf(`Submit an update marker params via governance proposal along with an initial deposit.
+
+The --%[1]s flag controls whether net asset values attested to in validator vote extensions are recorded (default %[3]t).
+The --%[2]s flag is the max distance (in basis points) an attestation can be from the median, 0 = no limit (default %[4]d).`,
+			FlagEnableNAVAttestations, FlagNAVMaxDeviationBps, types.DefaultEnableNAVAttestations, types.DefaultNAVAttestationMaxDeviationBps),
+		Args: cobra.ExactArgs(3),
+		Example: fmt.Sprintf(`%[1]s tx marker update-marker-params true "[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}" 1000000000000 --deposit 50000nhash
+%[1]s tx marker update-marker-params true "[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}" 1000000000000 --%[2]s --%[3]s 250 --deposit 50000nhash`,
+			version.AppName, FlagEnableNAVAttestations, FlagNAVMaxDeviationBps),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -1660,16 +2033,33 @@ func GetUpdateMarkerParamsCmd() *cobra.Command {
 				return fmt.Errorf("invalid max supply: %q", args[2])
 			}
 
+			enableNAVAttestations, err := flagSet.GetBool(FlagEnableNAVAttestations)
+			if err != nil {
+				return err
+			}
+			navMaxDeviationBps, err := flagSet.GetUint32(FlagNAVMaxDeviationBps)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgUpdateParamsRequest(
 				enableGovernance,
 				unrestrictedDenomRegex,
 				maxSupply,
+				enableNAVAttestations,
+				navMaxDeviationBps,
 				authority,
 			)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
 			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
 		},
 	}
 
+	cmd.Flags().Bool(FlagEnableNAVAttestations, types.DefaultEnableNAVAttestations, "Whether net asset values attested to in vote extensions are recorded")
+	cmd.Flags().Uint32(FlagNAVMaxDeviationBps, types.DefaultNAVAttestationMaxDeviationBps, "The max distance (in basis points) an attestation can be from the median (0 = no limit)")
+
 	govcli.AddGovPropFlagsToCmd(cmd)
 	provcli.AddAuthorityFlagToCmd(cmd)
 	flags.AddTxFlagsToCmd(cmd)
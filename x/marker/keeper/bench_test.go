@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// BenchmarkSendRestrictionFn measures the marker send restriction for the different kinds of sends it has to check.
+// Run with: go test -run='^$' -bench=BenchmarkSendRestrictionFn -benchmem ./x/marker/keeper
+func BenchmarkSendRestrictionFn(b *testing.B) {
+	app := simapp.Setup(b)
+	ctx := app.BaseApp.NewContext(false)
+
+	owner := sdk.AccAddress("owner_address_______")
+	transferAgent := sdk.AccAddress("transfer_agent______")
+	fromAddr := sdk.AccAddress("from_address________")
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, owner))
+
+	const reqAttr = "required.kyc.pb"
+	require.NoError(b, app.NameKeeper.SetNameRecord(ctx, "kyc.pb", owner, false), "SetNameRecord kyc.pb")
+	require.NoError(b, app.NameKeeper.SetNameRecord(ctx, reqAttr, owner, false), "SetNameRecord %s", reqAttr)
+
+	createMarker := func(denom string, markerType types.MarkerType, reqAttrs []string) {
+		addr, err := types.MarkerAddress(denom)
+		require.NoError(b, err, "MarkerAddress(%q)", denom)
+		marker := &types.MarkerAccount{
+			BaseAccount:            authtypes.NewBaseAccountWithAddress(addr),
+			Manager:                owner.String(),
+			Status:                 types.StatusProposed,
+			Denom:                  denom,
+			Supply:                 sdkmath.NewInt(1000),
+			MarkerType:             markerType,
+			SupplyFixed:            true,
+			AllowGovernanceControl: true,
+			RequiredAttributes:     reqAttrs,
+		}
+		if markerType == types.MarkerType_RestrictedCoin {
+			marker.AccessControl = []types.AccessGrant{
+				{Address: transferAgent.String(), Permissions: types.AccessList{types.Access_Transfer}},
+			}
+		}
+		nav := []types.NetAssetValue{types.NewNetAssetValue(sdk.NewInt64Coin(types.UsdDenom, 1), 1)}
+		require.NoError(b, app.MarkerKeeper.AddSetNetAssetValues(ctx, marker, nav, "bench"), "AddSetNetAssetValues(%s)", denom)
+		require.NoError(b, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker(%s)", denom)
+	}
+	createMarker("benchcoin", types.MarkerType_Coin, nil)
+	createMarker("benchrestricted", types.MarkerType_RestrictedCoin, nil)
+	createMarker("benchreqattr", types.MarkerType_RestrictedCoin, []string{reqAttr})
+
+	// newAddrWithAttrs creates a new address that has the required attribute and numOther other attributes.
+	newAddrWithAttrs := func(numOther int) sdk.AccAddress {
+		addr := sdk.AccAddress(fmt.Sprintf("to_addr_%012d", numOther))
+		setAttr := func(name string) {
+			attr := attrtypes.NewAttribute(name, addr.String(), attrtypes.AttributeType_String, []byte("value"), nil)
+			require.NoError(b, app.AttributeKeeper.SetAttribute(ctx, attr, owner), "SetAttribute %s", name)
+		}
+		for i := 0; i < numOther; i++ {
+			name := fmt.Sprintf("other%d.kyc.pb", i)
+			if !app.NameKeeper.NameExists(ctx, name) {
+				require.NoError(b, app.NameKeeper.SetNameRecord(ctx, name, owner, false), "SetNameRecord %s", name)
+			}
+			setAttr(name)
+		}
+		setAttr(reqAttr)
+		return addr
+	}
+
+	type benchCase struct {
+		name   string
+		ctx    sdk.Context
+		toAddr sdk.AccAddress
+		amt    sdk.Coins
+	}
+	tests := []benchCase{
+		{
+			name:   "not a marker",
+			ctx:    ctx,
+			toAddr: sdk.AccAddress("to_address__________"),
+			amt:    sdk.NewCoins(sdk.NewInt64Coin("banana", 10)),
+		},
+		{
+			name:   "unrestricted marker",
+			ctx:    ctx,
+			toAddr: sdk.AccAddress("to_address__________"),
+			amt:    sdk.NewCoins(sdk.NewInt64Coin("benchcoin", 10)),
+		},
+		{
+			name:   "restricted marker with transfer agent",
+			ctx:    types.WithTransferAgents(ctx, transferAgent),
+			toAddr: sdk.AccAddress("to_address__________"),
+			amt:    sdk.NewCoins(sdk.NewInt64Coin("benchrestricted", 10)),
+		},
+	}
+	for _, numOther := range []int{0, 10, 100} {
+		tests = append(tests, benchCase{
+			name:   fmt.Sprintf("required attributes with %d other attributes", numOther),
+			ctx:    ctx,
+			toAddr: newAddrWithAttrs(numOther),
+			amt:    sdk.NewCoins(sdk.NewInt64Coin("benchreqattr", 10)),
+		})
+	}
+
+	for _, tc := range tests {
+		b.Run(tc.name, func(b *testing.B) {
+			_, err := app.MarkerKeeper.SendRestrictionFn(tc.ctx, fromAddr, tc.toAddr, tc.amt)
+			require.NoError(b, err, "SendRestrictionFn")
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_, _ = app.MarkerKeeper.SendRestrictionFn(tc.ctx, fromAddr, tc.toAddr, tc.amt)
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
@@ -49,6 +51,51 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 		denyAddress := sdk.MustAccAddressFromBech32(denyAddress.DenyAddress)
 		k.AddSendDeny(ctx, markerAddr, denyAddress)
 	}
+	for _, markerAddress := range data.SendAllowListMarkers {
+		k.SetSendAllowListEnabled(ctx, sdk.MustAccAddressFromBech32(markerAddress), true)
+	}
+	for _, allowAddress := range data.SendAllowAddresses {
+		markerAddr := sdk.MustAccAddressFromBech32(allowAddress.MarkerAddress)
+		allowAddr := sdk.MustAccAddressFromBech32(allowAddress.AllowAddress)
+		k.AddSendAllow(ctx, markerAddr, allowAddr)
+	}
+	for _, markerAddress := range data.PausedMarkers {
+		k.SetMarkerPaused(ctx, sdk.MustAccAddressFromBech32(markerAddress), true)
+	}
+	for _, schedule := range data.SupplySchedules {
+		if err := k.SetSupplySchedule(ctx, types.MustGetMarkerAddress(schedule.Denom), schedule); err != nil {
+			panic(err)
+		}
+	}
+	for _, fee := range data.TransferFees {
+		if err := k.SetTransferFee(ctx, types.MustGetMarkerAddress(fee.Denom), fee); err != nil {
+			panic(err)
+		}
+	}
+	for _, limit := range data.VelocityLimits {
+		if err := k.SetVelocityLimit(ctx, types.MustGetMarkerAddress(limit.Denom), limit); err != nil {
+			panic(err)
+		}
+	}
+	for _, bucket := range data.VelocityBuckets {
+		markerAddr := sdk.MustAccAddressFromBech32(bucket.MarkerAddress)
+		addr := sdk.MustAccAddressFromBech32(bucket.Address)
+		if err := k.SetVelocityBucketAmount(ctx, markerAddr, addr, bucket.Bucket, bucket.Amount); err != nil {
+			panic(err)
+		}
+	}
+	for _, snapshot := range data.HolderSnapshots {
+		if err := k.SetHolderSnapshot(ctx, types.MustGetMarkerAddress(snapshot.Denom), snapshot); err != nil {
+			panic(err)
+		}
+	}
+	for _, balance := range data.HolderSnapshotBalances {
+		markerAddr := types.MustGetMarkerAddress(balance.Denom)
+		addr := sdk.MustAccAddressFromBech32(balance.Address)
+		if err := k.SetHolderSnapshotBalance(ctx, markerAddr, balance.Height, addr, balance.Amount); err != nil {
+			panic(err)
+		}
+	}
 	for _, mNavs := range data.NetAssetValues {
 		for _, nav := range mNavs.NetAssetValues {
 			navCopy := nav
@@ -98,6 +145,25 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 	}
 	k.IterateSendDeny(ctx, handleDenyList)
 
+	var allowListMarkers []string
+	k.IterateSendAllowListEnabled(ctx, func(markerAddr sdk.AccAddress) bool {
+		allowListMarkers = append(allowListMarkers, markerAddr.String())
+		return false
+	})
+
+	var pausedMarkers []string
+	k.IteratePausedMarkers(ctx, func(markerAddr sdk.AccAddress) bool {
+		pausedMarkers = append(pausedMarkers, markerAddr.String())
+		return false
+	})
+
+	var allowAddresses []types.SendAllowAddress
+	k.IterateSendAllow(ctx, func(key []byte) bool {
+		markerAddr, allowAddr := types.GetAllowSendAddresses(key)
+		allowAddresses = append(allowAddresses, types.SendAllowAddress{MarkerAddress: markerAddr.String(), AllowAddress: allowAddr.String()})
+		return false
+	})
+
 	markerNetAssetValues := make([]types.MarkerNetAssetValues, len(markers))
 	for i := range markers {
 		var markerNavs types.MarkerNetAssetValues
@@ -114,5 +180,73 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		markerNetAssetValues[i] = markerNavs
 	}
 
-	return types.NewGenesisState(params, markers, denyAddresses, markerNetAssetValues)
+	var supplySchedules []types.SupplySchedule
+	err := k.IterateSupplySchedules(ctx, func(schedule types.SupplySchedule) bool {
+		supplySchedules = append(supplySchedules, schedule)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var transferFees []types.TransferFee
+	err = k.IterateTransferFees(ctx, func(fee types.TransferFee) bool {
+		transferFees = append(transferFees, fee)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var velocityLimits []types.VelocityLimit
+	err = k.IterateVelocityLimits(ctx, func(limit types.VelocityLimit) bool {
+		velocityLimits = append(velocityLimits, limit)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var velocityBuckets []types.VelocityBucket
+	err = k.IterateVelocityBuckets(ctx, func(markerAddr, addr sdk.AccAddress, bucket uint64, amount sdkmath.Int) bool {
+		velocityBuckets = append(velocityBuckets, types.VelocityBucket{
+			MarkerAddress: markerAddr.String(),
+			Address:       addr.String(),
+			Bucket:        bucket,
+			Amount:        amount,
+		})
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	// The balances only have the marker address, so get the denoms from the snapshots.
+	var holderSnapshots []types.HolderSnapshot
+	snapshotDenoms := make(map[string]string)
+	err = k.IterateHolderSnapshots(ctx, func(snapshot types.HolderSnapshot) bool {
+		holderSnapshots = append(holderSnapshots, snapshot)
+		snapshotDenoms[types.MustGetMarkerAddress(snapshot.Denom).String()] = snapshot.Denom
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var holderSnapshotBalances []types.HolderSnapshotBalance
+	err = k.IterateHolderSnapshotBalances(ctx, func(markerAddr sdk.AccAddress, height int64, addr sdk.AccAddress, amount sdkmath.Int) bool {
+		holderSnapshotBalances = append(holderSnapshotBalances, types.HolderSnapshotBalance{
+			Denom:   snapshotDenoms[markerAddr.String()],
+			Height:  height,
+			Address: addr.String(),
+			Amount:  amount,
+		})
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.NewGenesisState(params, markers, denyAddresses, markerNetAssetValues, supplySchedules, allowListMarkers, allowAddresses, pausedMarkers, transferFees,
+		velocityLimits, velocityBuckets, holderSnapshots, holderSnapshotBalances)
 }
@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// holderSnapshotPageSize is the number of holders read from the bank module at a time while taking a snapshot.
+const holderSnapshotPageSize = 1000
+
+// TakeHolderSnapshot records the balance of every holder of a marker's denom at the current block height.
+// Only one snapshot of a marker can be taken at each height.
+func (k Keeper) TakeHolderSnapshot(ctx sdk.Context, marker types.MarkerAccountI) (*types.HolderSnapshot, error) {
+	markerAddr := marker.GetAddress()
+	denom := marker.GetDenom()
+	height := ctx.BlockHeight()
+
+	existing, err := k.GetHolderSnapshot(ctx, markerAddr, height)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, fmt.Errorf("a holder snapshot of %s has already been taken at height %d", denom, height)
+	}
+
+	var holderCount uint64
+	total := sdkmath.ZeroInt()
+	pageReq := &query.PageRequest{Limit: holderSnapshotPageSize}
+	for {
+		resp, err := k.bankKeeper.DenomOwners(ctx, &banktypes.QueryDenomOwnersRequest{Denom: denom, Pagination: pageReq})
+		if err != nil {
+			return nil, fmt.Errorf("could not get holders of %s: %w", denom, err)
+		}
+
+		for _, owner := range resp.DenomOwners {
+			if !owner.Balance.Amount.IsPositive() {
+				continue
+			}
+			addr, err := sdk.AccAddressFromBech32(owner.Address)
+			if err != nil {
+				return nil, fmt.Errorf("invalid holder address %q: %w", owner.Address, err)
+			}
+			if err = k.SetHolderSnapshotBalance(ctx, markerAddr, height, addr, owner.Balance.Amount); err != nil {
+				return nil, err
+			}
+			holderCount++
+			total = total.Add(owner.Balance.Amount)
+		}
+
+		if resp.Pagination == nil || len(resp.Pagination.NextKey) == 0 {
+			break
+		}
+		pageReq = &query.PageRequest{Key: resp.Pagination.NextKey, Limit: holderSnapshotPageSize}
+	}
+
+	snapshot := types.NewHolderSnapshot(denom, height, ctx.BlockTime(), holderCount, total)
+	if err = k.SetHolderSnapshot(ctx, markerAddr, snapshot); err != nil {
+		return nil, err
+	}
+	return &snapshot, nil
+}
+
+// GetHolderSnapshot gets the snapshot of a marker's holders taken at a height. Returns nil if there isn't one.
+func (k Keeper) GetHolderSnapshot(ctx sdk.Context, markerAddr sdk.AccAddress, height int64) (*types.HolderSnapshot, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.HolderSnapshotKey(markerAddr, height))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+
+	var snapshot types.HolderSnapshot
+	if err := k.cdc.Unmarshal(bz, &snapshot); err != nil {
+		return nil, fmt.Errorf("could not read holder snapshot for marker %s at height %d: %w", markerAddr, height, err)
+	}
+	return &snapshot, nil
+}
+
+// SetHolderSnapshot stores the record of a snapshot of a marker's holders.
+// The balances recorded in the snapshot are stored using SetHolderSnapshotBalance.
+func (k Keeper) SetHolderSnapshot(ctx sdk.Context, markerAddr sdk.AccAddress, snapshot types.HolderSnapshot) error {
+	if err := snapshot.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&snapshot)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.HolderSnapshotKey(markerAddr, snapshot.Height), bz)
+	return nil
+}
+
+// SetHolderSnapshotBalance stores the balance an address held in the snapshot of a marker's holders taken at a height.
+func (k Keeper) SetHolderSnapshotBalance(ctx sdk.Context, markerAddr sdk.AccAddress, height int64, addr sdk.AccAddress, amount sdkmath.Int) error {
+	bz, err := amount.Marshal()
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.HolderSnapshotBalanceKey(markerAddr, height, addr), bz)
+	return nil
+}
+
+// RemoveHolderSnapshots removes all of the snapshots of a marker's holders along with their balances.
+func (k Keeper) RemoveHolderSnapshots(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	var keys [][]byte
+	for _, pre := range [][]byte{types.HolderSnapshotMarkerPrefix(markerAddr), types.HolderSnapshotBalanceMarkerPrefix(markerAddr)} {
+		it := storetypes.KVStorePrefixIterator(store, pre)
+		for ; it.Valid(); it.Next() {
+			keys = append(keys, it.Key())
+		}
+		it.Close()
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// IterateHolderSnapshots iterates all snapshots of marker holders.
+func (k Keeper) IterateHolderSnapshots(ctx sdk.Context, handler func(snapshot types.HolderSnapshot) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.HolderSnapshotPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var snapshot types.HolderSnapshot
+		err := k.cdc.Unmarshal(it.Value(), &snapshot)
+		if err != nil {
+			return err
+		} else if handler(snapshot) {
+			break
+		}
+	}
+	return nil
+}
+
+// IterateHolderSnapshotBalances iterates all balances recorded in snapshots of marker holders.
+func (k Keeper) IterateHolderSnapshotBalances(ctx sdk.Context, handler func(markerAddr sdk.AccAddress, height int64, addr sdk.AccAddress, amount sdkmath.Int) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.HolderSnapshotBalancePrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var amount sdkmath.Int
+		if err := amount.Unmarshal(it.Value()); err != nil {
+			return err
+		}
+		markerAddr, height, addr := types.ParseHolderSnapshotBalanceKey(it.Key())
+		if handler(markerAddr, height, addr, amount) {
+			break
+		}
+	}
+	return nil
+}
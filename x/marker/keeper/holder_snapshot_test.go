@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestHolderSnapshots(t *testing.T) {
+	app := simapp.Setup(t)
+	blockTime := time.Unix(1_700_000_000, 0).UTC()
+	ctx := app.BaseApp.NewContext(false).WithBlockHeight(10).WithBlockTime(blockTime)
+
+	denom := "snapshotcoin"
+	admin := testUserAddress("snapshotAdmin")
+	holder1 := testUserAddress("snapshotHolder1")
+	holder2 := testUserAddress("snapshotHolder2")
+	for _, addr := range []sdk.AccAddress{admin, holder1, holder2} {
+		app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr))
+	}
+
+	markerAddr := types.MustGetMarkerAddress(denom)
+	mac := types.NewMarkerAccount(
+		authtypes.NewBaseAccount(markerAddr, nil, 0, 0),
+		sdk.NewInt64Coin(denom, 1000),
+		admin,
+		[]types.AccessGrant{{Address: admin.String(), Permissions: []types.Access{types.Access_Mint, types.Access_Withdraw, types.Access_Admin}}},
+		types.StatusProposed,
+		types.MarkerType_Coin,
+		true,
+		true,
+		false,
+		[]string{},
+	)
+	require.NoError(t, app.MarkerKeeper.SetNetAssetValue(ctx, mac, types.NewNetAssetValue(sdk.NewInt64Coin(types.UsdDenom, 1), 1), "test"), "SetNetAssetValue")
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, mac), "AddFinalizeAndActivateMarker")
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, admin, holder1, denom, sdk.NewCoins(sdk.NewInt64Coin(denom, 300))), "WithdrawCoins(holder1)")
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, admin, holder2, denom, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))), "WithdrawCoins(holder2)")
+	marker, err := app.MarkerKeeper.GetMarker(ctx, markerAddr)
+	require.NoError(t, err, "GetMarker")
+
+	snapshot, err := app.MarkerKeeper.TakeHolderSnapshot(ctx, marker)
+	require.NoError(t, err, "TakeHolderSnapshot at height 10")
+	// The 600 left in the marker account is part of the snapshot too.
+	expSnapshot10 := types.NewHolderSnapshot(denom, 10, blockTime, 3, sdkmath.NewInt(1000))
+	assert.Equal(t, &expSnapshot10, snapshot, "TakeHolderSnapshot at height 10")
+
+	_, err = app.MarkerKeeper.TakeHolderSnapshot(ctx, marker)
+	assert.EqualError(t, err, "a holder snapshot of "+denom+" has already been taken at height 10", "TakeHolderSnapshot again at height 10")
+
+	// Balances that change after the snapshot don't change what was recorded.
+	ctx = ctx.WithBlockHeight(11).WithBlockTime(blockTime.Add(5 * time.Second))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, holder2, holder1, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))), "SendCoins(holder2, holder1)")
+	snapshot, err = app.MarkerKeeper.TakeHolderSnapshot(ctx, marker)
+	require.NoError(t, err, "TakeHolderSnapshot at height 11")
+	expSnapshot11 := types.NewHolderSnapshot(denom, 11, blockTime.Add(5*time.Second), 2, sdkmath.NewInt(1000))
+	assert.Equal(t, &expSnapshot11, snapshot, "TakeHolderSnapshot at height 11")
+
+	snapshot, err = app.MarkerKeeper.GetHolderSnapshot(ctx, markerAddr, 10)
+	require.NoError(t, err, "GetHolderSnapshot(10)")
+	assert.Equal(t, &expSnapshot10, snapshot, "GetHolderSnapshot(10)")
+	snapshot, err = app.MarkerKeeper.GetHolderSnapshot(ctx, markerAddr, 12)
+	require.NoError(t, err, "GetHolderSnapshot(12)")
+	assert.Nil(t, snapshot, "GetHolderSnapshot(12)")
+
+	getBalances := func(height int64) map[string]int64 {
+		rv := make(map[string]int64)
+		require.NoError(t, app.MarkerKeeper.IterateHolderSnapshotBalances(ctx, func(mAddr sdk.AccAddress, h int64, addr sdk.AccAddress, amount sdkmath.Int) bool {
+			if mAddr.Equals(markerAddr) && h == height {
+				rv[addr.String()] = amount.Int64()
+			}
+			return false
+		}), "IterateHolderSnapshotBalances")
+		return rv
+	}
+	assert.Equal(t, map[string]int64{markerAddr.String(): 600, holder1.String(): 300, holder2.String(): 100}, getBalances(10), "balances at height 10")
+	assert.Equal(t, map[string]int64{markerAddr.String(): 600, holder1.String(): 400}, getBalances(11), "balances at height 11")
+
+	t.Run("query snapshots", func(t *testing.T) {
+		resp, err := app.MarkerKeeper.HolderSnapshots(ctx, &types.QueryHolderSnapshotsRequest{Id: denom})
+		require.NoError(t, err, "HolderSnapshots")
+		assert.Equal(t, []types.HolderSnapshot{expSnapshot10, expSnapshot11}, resp.Snapshots, "HolderSnapshots")
+	})
+
+	t.Run("query snapshot pages", func(t *testing.T) {
+		var balances []types.Balance
+		var nextKey []byte
+		for page := 1; page <= 3; page++ {
+			resp, err := app.MarkerKeeper.HolderSnapshot(ctx, &types.QueryHolderSnapshotRequest{
+				Id:         markerAddr.String(),
+				Height:     10,
+				Pagination: &query.PageRequest{Key: nextKey, Limit: 1},
+			})
+			require.NoError(t, err, "HolderSnapshot page %d", page)
+			assert.Equal(t, expSnapshot10, resp.Snapshot, "HolderSnapshot page %d snapshot", page)
+			require.Len(t, resp.Balances, 1, "HolderSnapshot page %d balances", page)
+			balances = append(balances, resp.Balances...)
+			nextKey = resp.Pagination.NextKey
+		}
+		assert.Empty(t, nextKey, "next key after last page")
+		assert.ElementsMatch(t, []types.Balance{
+			{Address: markerAddr.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(denom, 600))},
+			{Address: holder1.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(denom, 300))},
+			{Address: holder2.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(denom, 100))},
+		}, balances, "all balances from HolderSnapshot pages")
+	})
+
+	t.Run("query unknown snapshot", func(t *testing.T) {
+		_, err := app.MarkerKeeper.HolderSnapshot(ctx, &types.QueryHolderSnapshotRequest{Id: denom, Height: 12})
+		assert.EqualError(t, err, "rpc error: code = NotFound desc = no holder snapshot of "+denom+" found at height 12", "HolderSnapshot(12)")
+	})
+
+	app.MarkerKeeper.RemoveHolderSnapshots(ctx, markerAddr)
+	var remaining int
+	require.NoError(t, app.MarkerKeeper.IterateHolderSnapshots(ctx, func(types.HolderSnapshot) bool {
+		remaining++
+		return false
+	}), "IterateHolderSnapshots")
+	assert.Zero(t, remaining, "snapshots after RemoveHolderSnapshots")
+	assert.Empty(t, getBalances(10), "balances at height 10 after RemoveHolderSnapshots")
+	assert.Empty(t, getBalances(11), "balances at height 11 after RemoveHolderSnapshots")
+}
@@ -5,11 +5,14 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/go-metrics"
+
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -174,6 +177,12 @@ func (k Keeper) RemoveMarker(ctx sdk.Context, marker types.MarkerAccountI) {
 
 	k.RemoveNetAssetValues(ctx, marker.GetAddress())
 	k.ClearSendDeny(ctx, marker.GetAddress())
+	k.ClearSendAllow(ctx, marker.GetAddress())
+	k.SetMarkerPaused(ctx, marker.GetAddress(), false)
+	k.RemoveTransferFee(ctx, marker.GetAddress())
+	k.RemoveVelocityLimit(ctx, marker.GetAddress())
+	k.RemoveHolderSnapshots(ctx, marker.GetAddress())
+	k.RemoveSupplySchedule(ctx, marker.GetAddress())
 	store.Delete(types.MarkerStoreKey(marker.GetAddress()))
 }
 
@@ -319,6 +328,7 @@ func (k Keeper) SetNetAssetValue(ctx sdk.Context, marker types.MarkerAccountI, n
 	}
 	store := ctx.KVStore(k.storeKey)
 	store.Set(key, bz)
+	setNetAssetValueGauge(marker.GetDenom(), netAssetValue)
 
 	return nil
 }
@@ -342,10 +352,26 @@ func (k Keeper) SetNetAssetValueWithBlockHeight(ctx sdk.Context, marker types.Ma
 	}
 	store := ctx.KVStore(k.storeKey)
 	store.Set(key, bz)
+	setNetAssetValueGauge(marker.GetDenom(), netAssetValue)
 
 	return nil
 }
 
+// setNetAssetValueGauge sets the telemetry gauge with the price of a single unit of a marker's denom.
+func setNetAssetValueGauge(denom string, netAssetValue types.NetAssetValue) {
+	if !netAssetValue.Price.Amount.IsInt64() || netAssetValue.Volume == 0 {
+		return
+	}
+	telemetry.SetGaugeWithLabels(
+		[]string{types.ModuleName, types.EventTelemetryKeyNetAssetValue},
+		float32(netAssetValue.Price.Amount.Int64())/float32(netAssetValue.Volume),
+		[]metrics.Label{
+			telemetry.NewLabel(types.EventTelemetryLabelDenom, denom),
+			telemetry.NewLabel(types.EventTelemetryLabelPriceDenom, netAssetValue.Price.Denom),
+		},
+	)
+}
+
 // GetNetAssetValue gets the NetAssetValue for a marker denom with a specific price denom.
 func (k Keeper) GetNetAssetValue(ctx sdk.Context, markerDenom, priceDenom string) (*types.NetAssetValue, error) {
 	store := ctx.KVStore(k.storeKey)
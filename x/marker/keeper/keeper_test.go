@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-metrics"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -18,6 +19,7 @@ import (
 
 	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -28,6 +30,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/group"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	simapp "github.com/provenance-io/provenance/app"
+	provtestutil "github.com/provenance-io/provenance/testutil"
 	"github.com/provenance-io/provenance/testutil/assertions"
 	"github.com/provenance-io/provenance/x/exchange"
 	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
@@ -2814,6 +2817,45 @@ func TestGetNetAssetValue(t *testing.T) {
 	}
 }
 
+func TestSetNetAssetValueGauge(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.NewContext(false)
+
+	admin := sdk.AccAddress("admin_account_______")
+	markerAcc := types.NewEmptyMarkerAccount("navgauge", admin.String(), nil)
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, markerAcc), "AddMarkerAccount")
+
+	sink := provtestutil.EnableTelemetry(t)
+	keys := []string{types.ModuleName, types.EventTelemetryKeyNetAssetValue}
+	labels := func(priceDenom string) []metrics.Label {
+		return []metrics.Label{
+			telemetry.NewLabel(types.EventTelemetryLabelDenom, "navgauge"),
+			telemetry.NewLabel(types.EventTelemetryLabelPriceDenom, priceDenom),
+		}
+	}
+
+	usdNav := types.NewNetAssetValue(sdk.NewInt64Coin(types.UsdDenom, 50_000), 1_000_000)
+	require.NoError(t, app.MarkerKeeper.SetNetAssetValue(ctx, markerAcc, usdNav, "test"), "SetNetAssetValue usd")
+	usdGauge, found := provtestutil.GetGaugeValue(sink, keys, labels(types.UsdDenom)...)
+	if assert.True(t, found, "usd gauge found") {
+		assert.Equal(t, float32(0.05), usdGauge, "usd gauge value")
+	}
+
+	cherryNav := types.NewNetAssetValue(sdk.NewInt64Coin("cherry", 57), 3)
+	require.NoError(t, app.MarkerKeeper.SetNetAssetValueWithBlockHeight(ctx, markerAcc, cherryNav, "test", 5), "SetNetAssetValueWithBlockHeight cherry")
+	cherryGauge, found := provtestutil.GetGaugeValue(sink, keys, labels("cherry")...)
+	if assert.True(t, found, "cherry gauge found") {
+		assert.Equal(t, float32(19), cherryGauge, "cherry gauge value")
+	}
+
+	usdNav = types.NewNetAssetValue(sdk.NewInt64Coin(types.UsdDenom, 1_000), 10)
+	require.NoError(t, app.MarkerKeeper.SetNetAssetValue(ctx, markerAcc, usdNav, "test"), "SetNetAssetValue usd update")
+	usdGauge, found = provtestutil.GetGaugeValue(sink, keys, labels(types.UsdDenom)...)
+	if assert.True(t, found, "usd gauge found after update") {
+		assert.Equal(t, float32(100), usdGauge, "usd gauge value after update")
+	}
+}
+
 func TestIterateAllNetAssetValues(t *testing.T) {
 	app := simapp.Setup(t)
 	ctx := app.BaseApp.NewContext(false)
@@ -2918,6 +2960,14 @@ func TestReqAttrBypassAddrs(t *testing.T) {
 		stakingtypes.BondedPoolName,
 		stakingtypes.NotBondedPoolName,
 	}
+	// otherExpected are the expected entries that are not module accounts.
+	otherExpected := []struct {
+		name string
+		addr sdk.AccAddress
+	}{
+		{name: "exchange payment escrow", addr: exchange.GetPaymentEscrowAddress()},
+		{name: "exchange commitment rewards", addr: exchange.GetCommitmentRewardsAddress()},
+	}
 
 	incByte := func(b byte) byte {
 		if b == 0xFF {
@@ -2935,12 +2985,18 @@ func TestReqAttrBypassAddrs(t *testing.T) {
 			assert.Contains(t, actual, expAddr, "GetReqAttrBypassAddrs()")
 		})
 	}
+	for _, other := range otherExpected {
+		t.Run(fmt.Sprintf("get: contains %s", other.name), func(t *testing.T) {
+			actual := app.MarkerKeeper.GetReqAttrBypassAddrs()
+			assert.Contains(t, actual, other.addr, "GetReqAttrBypassAddrs()")
+		})
+	}
 	t.Run("get: only has expected entries", func(t *testing.T) {
 		// This assumes each expectedNames test passed. This is designed to fail if a new entry
-		// is added to the list (in app.go). When that happens, update the expectedNames with
-		// the new entry so it's harder for it to accidentally go missing.
+		// is added to the list (in app.go). When that happens, update the expectedNames (or otherExpected)
+		// with the new entry so it's harder for it to accidentally go missing.
 		actual := app.MarkerKeeper.GetReqAttrBypassAddrs()
-		assert.Len(t, actual, len(expectedNames), "GetReqAttrBypassAddrs()")
+		assert.Len(t, actual, len(expectedNames)+len(otherExpected), "GetReqAttrBypassAddrs()")
 	})
 
 	t.Run("get: called twice equal but not same", func(t *testing.T) {
@@ -2971,6 +3027,12 @@ func TestReqAttrBypassAddrs(t *testing.T) {
 			assert.True(t, actual, "IsReqAttrBypassAddr(NewModuleAddress(%q))", name)
 		})
 	}
+	for _, other := range otherExpected {
+		t.Run(fmt.Sprintf("is: %s", other.name), func(t *testing.T) {
+			actual := app.MarkerKeeper.IsReqAttrBypassAddr(other.addr)
+			assert.True(t, actual, "IsReqAttrBypassAddr(%s)", other.name)
+		})
+	}
 
 	almostName0 := authtypes.NewModuleAddress(expectedNames[0])
 	almostName0[0] = incByte(almostName0[0])
@@ -310,9 +310,7 @@ func (k Keeper) AdjustCirculation(ctx sdk.Context, marker types.MarkerAccountI,
 	ctx = types.WithBypass(ctx)
 	if desiredSupply.Amount.GT(currentSupply) { // not enough coin in circulation, mint more.
 		offset := sdk.NewCoin(marker.GetDenom(), desiredSupply.Amount.Sub(currentSupply))
-		ctx.Logger().Info(
-			fmt.Sprintf("Adjusting %s circulation: increasing supply by %s",
-				marker.GetDenom(), offset))
+		k.Logger(ctx).Info("adjusting marker circulation: increasing supply", "marker", marker.GetDenom(), "amount", offset.String())
 		if err := k.bankKeeper.MintCoins(ctx, types.CoinPoolName, sdk.NewCoins(offset)); err != nil {
 			return err
 		}
@@ -323,9 +321,7 @@ func (k Keeper) AdjustCirculation(ctx sdk.Context, marker types.MarkerAccountI,
 		}
 	} else if desiredSupply.Amount.LT(currentSupply) { // too much coin in circulation, attempt to burn from marker account.
 		offset := sdk.NewCoin(marker.GetDenom(), currentSupply.Sub(desiredSupply.Amount))
-		ctx.Logger().Info(
-			fmt.Sprintf("Adjusting %s circulation: decreasing supply by %s",
-				marker.GetDenom(), offset))
+		k.Logger(ctx).Info("adjusting marker circulation: decreasing supply", "marker", marker.GetDenom(), "amount", offset.String())
 		if err := k.bankKeeper.SendCoinsFromAccountToModule(
 			ctx, marker.GetAddress(), types.CoinPoolName, sdk.NewCoins(offset),
 		); err != nil {
@@ -647,6 +643,12 @@ func (k Keeper) TransferCoin(ctx sdk.Context, from, to, admin sdk.AccAddress, am
 		return err
 	}
 
+	// While paused, the only transfers allowed are forced transfers.
+	isForced := !admin.Equals(from) && m.AllowsForcedTransfer() && adminCanForceTransfer
+	if !isForced && k.IsMarkerPaused(ctx, m.GetAddress()) {
+		return fmt.Errorf("%s marker is paused, only forced transfers are allowed", amount.Denom)
+	}
+
 	if !admin.Equals(from) {
 		switch {
 		case !m.AllowsForcedTransfer() || !adminCanForceTransfer:
@@ -742,6 +744,9 @@ func (k Keeper) IbcTransferCoin(
 	if m.GetMarkerType() != types.MarkerType_RestrictedCoin {
 		return fmt.Errorf("marker type is not restricted_coin, brokered transfer not supported")
 	}
+	if k.IsMarkerPaused(ctx, m.GetAddress()) {
+		return fmt.Errorf("%s marker is paused", token.Denom)
+	}
 	if err = m.ValidateAddressHasAccess(admin, types.Access_Transfer); err != nil {
 		return err
 	}
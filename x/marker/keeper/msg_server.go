@@ -63,7 +63,7 @@ func (k msgServer) GrantAllowance(goCtx context.Context, msg *types.MsgGrantAllo
 func (k msgServer) AddMarker(goCtx context.Context, msg *types.MsgAddMarkerRequest) (*types.MsgAddMarkerResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	isGovProp := msg.FromAddress == k.GetAuthority()
+	isGovProp := k.IsAuthority(msg.FromAddress)
 
 	var err error
 	// If this isn't from a gov prop, there's some added restrictions to check.
@@ -113,7 +113,7 @@ func (k msgServer) AddMarker(goCtx context.Context, msg *types.MsgAddMarkerReque
 	)
 
 	if err = k.Keeper.AddMarkerAccount(ctx, ma); err != nil {
-		ctx.Logger().Error("unable to add marker", "err", err)
+		k.Logger(ctx).Error("unable to add marker", "error", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
@@ -153,7 +153,7 @@ func (k msgServer) AddAccess(goCtx context.Context, msg *types.MsgAddAccessReque
 	for i := range msg.Access {
 		access := msg.Access[i]
 		if err := k.Keeper.AddAccess(ctx, admin, msg.Denom, &access); err != nil {
-			ctx.Logger().Error("unable to add access grant to marker", "err", err)
+			k.Logger(ctx).Error("unable to add access grant to marker", "error", err)
 			return nil, sdkerrors.ErrUnauthorized.Wrap(err.Error())
 		}
 	}
@@ -174,7 +174,7 @@ func (k msgServer) DeleteAccess(goCtx context.Context, msg *types.MsgDeleteAcces
 	addr := sdk.MustAccAddressFromBech32(msg.RemovedAddress)
 
 	if err := k.Keeper.RemoveAccess(ctx, admin, msg.Denom, addr); err != nil {
-		ctx.Logger().Error("unable to remove access grant from marker", "err", err)
+		k.Logger(ctx).Error("unable to remove access grant from marker", "error", err)
 		return nil, sdkerrors.ErrUnauthorized.Wrap(err.Error())
 	}
 
@@ -193,7 +193,7 @@ func (k msgServer) Finalize(goCtx context.Context, msg *types.MsgFinalizeRequest
 	admin := sdk.MustAccAddressFromBech32(msg.Administrator)
 
 	if err := k.Keeper.FinalizeMarker(ctx, admin, msg.Denom); err != nil {
-		ctx.Logger().Error("unable to finalize marker", "err", err)
+		k.Logger(ctx).Error("unable to finalize marker", "error", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
@@ -212,7 +212,7 @@ func (k msgServer) Activate(goCtx context.Context, msg *types.MsgActivateRequest
 	admin := sdk.MustAccAddressFromBech32(msg.Administrator)
 
 	if err := k.Keeper.ActivateMarker(ctx, admin, msg.Denom); err != nil {
-		ctx.Logger().Error("unable to activate marker", "err", err)
+		k.Logger(ctx).Error("unable to activate marker", "error", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
@@ -231,7 +231,7 @@ func (k msgServer) Cancel(goCtx context.Context, msg *types.MsgCancelRequest) (*
 	admin := sdk.MustAccAddressFromBech32(msg.Administrator)
 
 	if err := k.Keeper.CancelMarker(ctx, admin, msg.Denom); err != nil {
-		ctx.Logger().Error("unable to cancel marker", "err", err)
+		k.Logger(ctx).Error("unable to cancel marker", "error", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
@@ -250,7 +250,7 @@ func (k msgServer) Delete(goCtx context.Context, msg *types.MsgDeleteRequest) (*
 	admin := sdk.MustAccAddressFromBech32(msg.Administrator)
 
 	if err := k.Keeper.DeleteMarker(ctx, admin, msg.Denom); err != nil {
-		ctx.Logger().Error("unable to delete marker", "err", err)
+		k.Logger(ctx).Error("unable to delete marker", "error", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
@@ -269,7 +269,7 @@ func (k msgServer) Mint(goCtx context.Context, msg *types.MsgMintRequest) (*type
 	admin := sdk.MustAccAddressFromBech32(msg.Administrator)
 
 	if err := k.Keeper.MintCoin(ctx, admin, msg.Amount); err != nil {
-		ctx.Logger().Error("unable to mint coin for marker", "err", err)
+		k.Logger(ctx).Error("unable to mint coin for marker", "error", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
@@ -279,7 +279,7 @@ func (k msgServer) Mint(goCtx context.Context, msg *types.MsgMintRequest) (*type
 			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient: %s", msg.Recipient)
 		}
 		if err := k.Keeper.WithdrawCoins(ctx, admin, recipient, msg.Amount.Denom, sdk.NewCoins(msg.Amount)); err != nil {
-			ctx.Logger().Error("unable to withdraw coins", "err", err)
+			k.Logger(ctx).Error("unable to withdraw coins", "error", err)
 			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 		}
 	}
@@ -317,7 +317,7 @@ func (k msgServer) Burn(goCtx context.Context, msg *types.MsgBurnRequest) (*type
 	admin := sdk.MustAccAddressFromBech32(msg.Administrator)
 
 	if err := k.Keeper.BurnCoin(ctx, admin, msg.Amount); err != nil {
-		ctx.Logger().Error("unable to burn coin from marker", "err", err)
+		k.Logger(ctx).Error("unable to burn coin from marker", "error", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
@@ -355,7 +355,7 @@ func (k msgServer) Withdraw(goCtx context.Context, msg *types.MsgWithdrawRequest
 	to := sdk.MustAccAddressFromBech32(msg.ToAddress)
 
 	if err := k.Keeper.WithdrawCoins(ctx, admin, to, msg.Denom, msg.Amount); err != nil {
-		ctx.Logger().Error("unable to withdraw coins from marker", "err", err)
+		k.Logger(ctx).Error("unable to withdraw coins from marker", "error", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
@@ -547,7 +547,7 @@ func (k msgServer) AddFinalizeActivateMarker(goCtx context.Context, msg *types.M
 	}
 
 	if err := k.Keeper.AddFinalizeAndActivateMarker(ctx, ma); err != nil {
-		ctx.Logger().Error("unable to add, finalize and activate marker", "err", err)
+		k.Logger(ctx).Error("unable to add, finalize and activate marker", "error", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
@@ -558,7 +558,7 @@ func (k msgServer) AddFinalizeActivateMarker(goCtx context.Context, msg *types.M
 func (k msgServer) SupplyIncreaseProposal(goCtx context.Context, msg *types.MsgSupplyIncreaseProposalRequest) (*types.MsgSupplyIncreaseProposalResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if k.GetAuthority() != msg.Authority {
+	if !k.IsAuthority(msg.Authority) {
 		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", k.GetAuthority(), msg.Authority)
 	}
 
@@ -574,7 +574,7 @@ func (k msgServer) SupplyIncreaseProposal(goCtx context.Context, msg *types.MsgS
 func (k msgServer) SupplyDecreaseProposal(goCtx context.Context, msg *types.MsgSupplyDecreaseProposalRequest) (*types.MsgSupplyDecreaseProposalResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if k.GetAuthority() != msg.Authority {
+	if !k.IsAuthority(msg.Authority) {
 		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", k.GetAuthority(), msg.Authority)
 	}
 
@@ -604,7 +604,7 @@ func (k msgServer) UpdateRequiredAttributes(goCtx context.Context, msg *types.Ms
 	}
 
 	switch {
-	case msg.TransferAuthority == k.GetAuthority():
+	case k.IsAuthority(msg.TransferAuthority):
 		if !m.HasGovernanceEnabled() {
 			return nil, fmt.Errorf("%s marker does not allow governance control", msg.Denom)
 		}
@@ -638,7 +638,7 @@ func (k msgServer) UpdateRequiredAttributes(goCtx context.Context, msg *types.Ms
 
 // UpdateForcedTransfer updates the allow_forced_transfer field of a marker via governance proposal.
 func (k msgServer) UpdateForcedTransfer(goCtx context.Context, msg *types.MsgUpdateForcedTransferRequest) (*types.MsgUpdateForcedTransferResponse, error) {
-	if msg.Authority != k.GetAuthority() {
+	if !k.IsAuthority(msg.Authority) {
 		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", k.GetAuthority(), msg.Authority)
 	}
 
@@ -675,7 +675,7 @@ func (k msgServer) SetAccountData(goCtx context.Context, msg *types.MsgSetAccoun
 		return nil, fmt.Errorf("could not get %s marker: %w", msg.Denom, err)
 	}
 
-	if msg.Signer == k.GetAuthority() {
+	if k.IsAuthority(msg.Signer) {
 		if !marker.HasGovernanceEnabled() {
 			return nil, fmt.Errorf("%s marker does not allow governance control", msg.Denom)
 		}
@@ -706,7 +706,7 @@ func (k msgServer) UpdateSendDenyList(goCtx context.Context, msg *types.MsgUpdat
 		return nil, fmt.Errorf("marker %s is not a restricted marker", msg.Denom)
 	}
 
-	if msg.Authority == k.GetAuthority() {
+	if k.IsAuthority(msg.Authority) {
 		if !marker.HasGovernanceEnabled() {
 			return nil, fmt.Errorf("%s marker does not allow governance control", msg.Denom)
 		}
@@ -749,7 +749,7 @@ func (k msgServer) AddNetAssetValues(goCtx context.Context, msg *types.MsgAddNet
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
-	isGovProp := marker.HasGovernanceEnabled() && msg.Administrator == k.GetAuthority()
+	isGovProp := marker.HasGovernanceEnabled() && k.IsAuthority(msg.Administrator)
 
 	if !isGovProp {
 		admin := sdk.MustAccAddressFromBech32(msg.Administrator)
@@ -771,7 +771,7 @@ func (k msgServer) AddNetAssetValues(goCtx context.Context, msg *types.MsgAddNet
 func (k msgServer) SetAdministratorProposal(goCtx context.Context, msg *types.MsgSetAdministratorProposalRequest) (*types.MsgSetAdministratorProposalResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if k.GetAuthority() != msg.Authority {
+	if !k.IsAuthority(msg.Authority) {
 		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", k.GetAuthority(), msg.Authority)
 	}
 
@@ -787,7 +787,7 @@ func (k msgServer) SetAdministratorProposal(goCtx context.Context, msg *types.Ms
 func (k msgServer) RemoveAdministratorProposal(goCtx context.Context, msg *types.MsgRemoveAdministratorProposalRequest) (*types.MsgRemoveAdministratorProposalResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if k.GetAuthority() != msg.Authority {
+	if !k.IsAuthority(msg.Authority) {
 		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", k.GetAuthority(), msg.Authority)
 	}
 
@@ -803,7 +803,7 @@ func (k msgServer) RemoveAdministratorProposal(goCtx context.Context, msg *types
 func (k msgServer) ChangeStatusProposal(goCtx context.Context, msg *types.MsgChangeStatusProposalRequest) (*types.MsgChangeStatusProposalResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if k.GetAuthority() != msg.Authority {
+	if !k.IsAuthority(msg.Authority) {
 		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", k.GetAuthority(), msg.Authority)
 	}
 
@@ -819,7 +819,7 @@ func (k msgServer) ChangeStatusProposal(goCtx context.Context, msg *types.MsgCha
 func (k msgServer) WithdrawEscrowProposal(goCtx context.Context, msg *types.MsgWithdrawEscrowProposalRequest) (*types.MsgWithdrawEscrowProposalResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if k.GetAuthority() != msg.Authority {
+	if !k.IsAuthority(msg.Authority) {
 		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", k.GetAuthority(), msg.Authority)
 	}
 
@@ -835,7 +835,7 @@ func (k msgServer) WithdrawEscrowProposal(goCtx context.Context, msg *types.MsgW
 func (k msgServer) SetDenomMetadataProposal(goCtx context.Context, msg *types.MsgSetDenomMetadataProposalRequest) (*types.MsgSetDenomMetadataProposalResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if k.GetAuthority() != msg.Authority {
+	if !k.IsAuthority(msg.Authority) {
 		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", k.GetAuthority(), msg.Authority)
 	}
 
@@ -856,7 +856,8 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 	}
 
 	k.SetParams(ctx, msg.Params)
-	if err := ctx.EventManager().EmitTypedEvent(types.NewEventMarkerParamsUpdated(msg.Params.EnableGovernance, msg.Params.GetUnrestrictedDenomRegex(), msg.Params.MaxSupply)); err != nil {
+	if err := ctx.EventManager().EmitTypedEvent(types.NewEventMarkerParamsUpdated(msg.Params.EnableGovernance, msg.Params.GetUnrestrictedDenomRegex(), msg.Params.MaxSupply,
+		msg.Params.EnableNavAttestations, msg.Params.NavAttestationMaxDeviationBps)); err != nil {
 		return nil, err
 	}
 
@@ -908,3 +909,250 @@ func (k msgServer) RevokeGrantAllowance(goCtx context.Context, msg *types.MsgRev
 
 	return &types.MsgRevokeGrantAllowanceResponse{}, nil
 }
+
+// SetSupplySchedule sets or removes a marker's supply schedule. Can only be called via gov proposal.
+func (k msgServer) SetSupplySchedule(goCtx context.Context, msg *types.MsgSetSupplyScheduleRequest) (*types.MsgSetSupplyScheduleResponse, error) {
+	if !k.IsAuthority(msg.Authority) {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, fmt.Errorf("could not get marker for %s: %w", msg.Denom, err)
+	}
+
+	if !marker.HasGovernanceEnabled() {
+		return nil, fmt.Errorf("%s marker does not allow governance control", msg.Denom)
+	}
+
+	schedule := types.NewSupplySchedule(msg.Denom, msg.Steps...)
+	if err = k.Keeper.SetSupplySchedule(ctx, marker.GetAddress(), schedule); err != nil {
+		return nil, err
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventSupplyScheduleUpdated(msg.Denom, len(msg.Steps))); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetSupplyScheduleResponse{}, nil
+}
+
+// UpdateSendAllowList updates the send allow list for a restricted marker. Signer must have transfer access or be gov proposal.
+func (k msgServer) UpdateSendAllowList(goCtx context.Context, msg *types.MsgUpdateSendAllowListRequest) (*types.MsgUpdateSendAllowListResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.getRestrictedMarkerForAllowList(ctx, msg.Denom, msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	markerAddr := marker.GetAddress()
+	for _, addr := range msg.RemoveAllowedAddresses {
+		allowAddr, err := sdk.AccAddressFromBech32(addr)
+		if err != nil {
+			return nil, err
+		}
+		if !k.IsSendAllowed(ctx, markerAddr, allowAddr) {
+			return nil, fmt.Errorf("%s is not on allow list cannot remove address", addr)
+		}
+		k.RemoveSendAllow(ctx, markerAddr, allowAddr)
+	}
+
+	for _, addr := range msg.AddAllowedAddresses {
+		allowAddr, err := sdk.AccAddressFromBech32(addr)
+		if err != nil {
+			return nil, err
+		}
+		if k.IsSendAllowed(ctx, markerAddr, allowAddr) {
+			return nil, fmt.Errorf("%s is already on allow list cannot add address", addr)
+		}
+		k.AddSendAllow(ctx, markerAddr, allowAddr)
+	}
+
+	return &types.MsgUpdateSendAllowListResponse{}, nil
+}
+
+// SetSendAllowListMode turns send allowlist mode on or off for a restricted marker. Signer must have transfer access or be gov proposal.
+func (k msgServer) SetSendAllowListMode(goCtx context.Context, msg *types.MsgSetSendAllowListModeRequest) (*types.MsgSetSendAllowListModeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.getRestrictedMarkerForAllowList(ctx, msg.Denom, msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	k.SetSendAllowListEnabled(ctx, marker.GetAddress(), msg.Enabled)
+
+	return &types.MsgSetSendAllowListModeResponse{}, nil
+}
+
+// getRestrictedMarkerForAllowList gets the restricted marker with the given denom, making sure
+// the authority is allowed to manage its send allow list.
+func (k msgServer) getRestrictedMarkerForAllowList(ctx sdk.Context, denom, authority string) (types.MarkerAccountI, error) {
+	marker, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return nil, fmt.Errorf("marker not found for %s: %w", denom, err)
+	}
+
+	if marker.GetMarkerType() != types.MarkerType_RestrictedCoin {
+		return nil, fmt.Errorf("marker %s is not a restricted marker", denom)
+	}
+
+	if k.IsAuthority(authority) {
+		if !marker.HasGovernanceEnabled() {
+			return nil, fmt.Errorf("%s marker does not allow governance control", denom)
+		}
+	} else if err = marker.ValidateHasAccess(authority, types.Access_Transfer); err != nil {
+		return nil, err
+	}
+
+	return marker, nil
+}
+
+// PauseMarker pauses all sends of a marker's denom except forced transfers and module operations.
+// Signer must have admin access or be gov proposal.
+func (k msgServer) PauseMarker(goCtx context.Context, msg *types.MsgPauseMarkerRequest) (*types.MsgPauseMarkerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.getMarkerForAdminOrGov(ctx, msg.Denom, msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+	if k.IsMarkerPaused(ctx, marker.GetAddress()) {
+		return nil, fmt.Errorf("%s marker is already paused", msg.Denom)
+	}
+
+	k.SetMarkerPaused(ctx, marker.GetAddress(), true)
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventMarkerPaused(msg.Denom, msg.Authority)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPauseMarkerResponse{}, nil
+}
+
+// UnpauseMarker allows sends of a paused marker's denom again. Signer must have admin access or be gov proposal.
+func (k msgServer) UnpauseMarker(goCtx context.Context, msg *types.MsgUnpauseMarkerRequest) (*types.MsgUnpauseMarkerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.getMarkerForAdminOrGov(ctx, msg.Denom, msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+	if !k.IsMarkerPaused(ctx, marker.GetAddress()) {
+		return nil, fmt.Errorf("%s marker is not paused", msg.Denom)
+	}
+
+	k.SetMarkerPaused(ctx, marker.GetAddress(), false)
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventMarkerUnpaused(msg.Denom, msg.Authority)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnpauseMarkerResponse{}, nil
+}
+
+// getMarkerForAdminOrGov gets the marker with the given denom, making sure the authority either has admin access
+// on it or is the governance module account (and the marker allows governance control).
+func (k msgServer) getMarkerForAdminOrGov(ctx sdk.Context, denom, authority string) (types.MarkerAccountI, error) {
+	marker, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return nil, fmt.Errorf("marker not found for %s: %w", denom, err)
+	}
+
+	if k.IsAuthority(authority) {
+		if !marker.HasGovernanceEnabled() {
+			return nil, fmt.Errorf("%s marker does not allow governance control", denom)
+		}
+	} else if err = marker.ValidateHasAccess(authority, types.Access_Admin); err != nil {
+		return nil, err
+	}
+
+	return marker, nil
+}
+
+// SetTransferFee sets or removes the fee charged on sends of a marker's denom. Signer must have admin access or be gov proposal.
+func (k msgServer) SetTransferFee(goCtx context.Context, msg *types.MsgSetTransferFeeRequest) (*types.MsgSetTransferFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.getMarkerForAdminOrGov(ctx, msg.Denom, msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.BasisPoints == 0 {
+		k.RemoveTransferFee(ctx, marker.GetAddress())
+		return &types.MsgSetTransferFeeResponse{}, nil
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+	if k.bankKeeper.BlockedAddr(recipient) {
+		return nil, fmt.Errorf("%s is not allowed to receive transfer fees", msg.Recipient)
+	}
+
+	fee := types.NewTransferFee(msg.Denom, msg.BasisPoints, msg.Recipient)
+	if err = k.Keeper.SetTransferFee(ctx, marker.GetAddress(), fee); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetTransferFeeResponse{}, nil
+}
+
+// SetVelocityLimit sets or removes the velocity limit of a marker's denom. Signer must have admin access or be gov proposal.
+func (k msgServer) SetVelocityLimit(goCtx context.Context, msg *types.MsgSetVelocityLimitRequest) (*types.MsgSetVelocityLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.getMarkerForAdminOrGov(ctx, msg.Denom, msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+	markerAddr := marker.GetAddress()
+
+	maxAmount, err := msg.ParseMaxAmount()
+	if err != nil {
+		return nil, err
+	}
+	if maxAmount.IsZero() {
+		k.RemoveVelocityLimit(ctx, markerAddr)
+		return &types.MsgSetVelocityLimitResponse{}, nil
+	}
+
+	// The amounts already recorded only line up with the new limit if the bucket length is unchanged.
+	existing, err := k.GetVelocityLimit(ctx, markerAddr)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil && existing.BucketSeconds != msg.BucketSeconds {
+		k.ClearVelocityBuckets(ctx, markerAddr)
+	}
+
+	limit := types.NewVelocityLimit(msg.Denom, maxAmount, msg.WindowSeconds, msg.BucketSeconds)
+	if err = k.Keeper.SetVelocityLimit(ctx, markerAddr, limit); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetVelocityLimitResponse{}, nil
+}
+
+// TakeHolderSnapshot records the balances of all holders of a marker's denom at the current block height.
+// Signer must have admin access or be gov proposal.
+func (k msgServer) TakeHolderSnapshot(goCtx context.Context, msg *types.MsgTakeHolderSnapshotRequest) (*types.MsgTakeHolderSnapshotResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.getMarkerForAdminOrGov(ctx, msg.Denom, msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, err := k.Keeper.TakeHolderSnapshot(ctx, marker)
+	if err != nil {
+		return nil, err
+	}
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventMarkerHolderSnapshotTaken(*snapshot, msg.Authority)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTakeHolderSnapshotResponse{Snapshot: *snapshot}, nil
+}
@@ -17,6 +17,7 @@ import (
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/gogoproto/proto"
@@ -1860,6 +1861,8 @@ func (s *MsgServerTestSuite) TestMsgUpdateParamsRequest() {
 					true,
 					"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}",
 					sdkmath.NewInt(1000000000000),
+					false,
+					0,
 				),
 			},
 		},
@@ -1871,6 +1874,8 @@ func (s *MsgServerTestSuite) TestMsgUpdateParamsRequest() {
 					true,
 					"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}",
 					sdkmath.NewInt(1000000000000),
+					false,
+					0,
 				),
 			},
 			expErr: `expected "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn" got "invalidAuthority": expected gov account as only signer for proposal message`,
@@ -1891,3 +1896,587 @@ func (s *MsgServerTestSuite) TestMsgUpdateParamsRequest() {
 		})
 	}
 }
+
+func (s *MsgServerTestSuite) TestSetSupplySchedule() {
+	authority := s.app.MarkerKeeper.GetAuthority()
+	otherAddr := sdk.AccAddress("otherAccAddr________").String()
+
+	newMarker := func(denom string, allowGov bool) *types.MarkerAccount {
+		rv := types.NewMarkerAccount(
+			authtypes.NewBaseAccountWithAddress(types.MustGetMarkerAddress(denom)),
+			sdk.NewInt64Coin(denom, 1000),
+			s.owner1Addr,
+			[]types.AccessGrant{{Address: s.owner1, Permissions: types.AccessList{types.Access_Admin}}},
+			types.StatusActive,
+			types.MarkerType_Coin,
+			true,
+			allowGov,
+			false,
+			[]string{},
+		)
+		s.Require().NoError(s.app.MarkerKeeper.AddMarkerAccount(s.ctx, rv), "AddMarkerAccount(%s)", denom)
+		return rv
+	}
+	newMarker("schedcoin", true)
+	newMarker("nogovschedcoin", false)
+
+	step := types.SupplyScheduleStep{Action: types.SupplyScheduleAction_Mint, Amount: sdkmath.NewInt(100), Height: 1000}
+
+	tests := []struct {
+		name     string
+		msg      *types.MsgSetSupplyScheduleRequest
+		expErr   string
+		expSteps []types.SupplyScheduleStep
+	}{
+		{
+			name:   "wrong authority",
+			msg:    types.NewMsgSetSupplyScheduleRequest("schedcoin", []types.SupplyScheduleStep{step}, otherAddr),
+			expErr: "expected " + authority + " got " + otherAddr + ": expected gov account as only signer for proposal message",
+		},
+		{
+			name:   "marker does not exist",
+			msg:    types.NewMsgSetSupplyScheduleRequest("nosuchmarker", []types.SupplyScheduleStep{step}, authority),
+			expErr: "could not get marker for nosuchmarker: marker nosuchmarker not found for address: " + types.MustGetMarkerAddress("nosuchmarker").String(),
+		},
+		{
+			name:   "gov not enabled",
+			msg:    types.NewMsgSetSupplyScheduleRequest("nogovschedcoin", []types.SupplyScheduleStep{step}, authority),
+			expErr: "nogovschedcoin marker does not allow governance control",
+		},
+		{
+			name:     "set schedule",
+			msg:      types.NewMsgSetSupplyScheduleRequest("schedcoin", []types.SupplyScheduleStep{step, step}, authority),
+			expSteps: []types.SupplyScheduleStep{step, step},
+		},
+		{
+			name:     "replace schedule",
+			msg:      types.NewMsgSetSupplyScheduleRequest("schedcoin", []types.SupplyScheduleStep{step}, authority),
+			expSteps: []types.SupplyScheduleStep{step},
+		},
+		{
+			name: "remove schedule",
+			msg:  types.NewMsgSetSupplyScheduleRequest("schedcoin", nil, authority),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			res, err := s.msgServer.SetSupplySchedule(ctx, tc.msg)
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "SetSupplySchedule error")
+				s.Assert().Nil(res, "SetSupplySchedule response")
+				s.Assert().Empty(em.Events(), "events emitted during failed SetSupplySchedule")
+				return
+			}
+
+			s.Require().NoError(err, "SetSupplySchedule error")
+			s.Assert().Equal(&types.MsgSetSupplyScheduleResponse{}, res, "SetSupplySchedule response")
+			s.Assert().True(s.containsMessage(em.ABCIEvents(), types.NewEventSupplyScheduleUpdated(tc.msg.Denom, len(tc.expSteps))),
+				"EventSupplyScheduleUpdated emitted")
+
+			schedule, err := s.app.MarkerKeeper.GetSupplySchedule(s.ctx, types.MustGetMarkerAddress(tc.msg.Denom))
+			s.Require().NoError(err, "GetSupplySchedule(%s)", tc.msg.Denom)
+			if len(tc.expSteps) == 0 {
+				s.Assert().Nil(schedule, "GetSupplySchedule(%s)", tc.msg.Denom)
+			} else if s.Assert().NotNil(schedule, "GetSupplySchedule(%s)", tc.msg.Denom) {
+				s.Assert().Equal(tc.expSteps, schedule.Steps, "supply schedule steps")
+			}
+		})
+	}
+}
+
+func (s *MsgServerTestSuite) TestUpdateSendAllowList() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	authUser := testUserAddress("test")
+	notAuthUser := testUserAddress("test1")
+
+	notRestrictedMarker := types.NewEmptyMarkerAccount(
+		"not-restricted-allow-marker",
+		authUser.String(),
+		[]types.AccessGrant{})
+	s.Require().NoError(s.app.MarkerKeeper.AddMarkerAccount(s.ctx, notRestrictedMarker), "AddMarkerAccount")
+
+	rMarkerDenom := "restricted-allow-marker"
+	rMarkerAcct := authtypes.NewBaseAccount(types.MustGetMarkerAddress(rMarkerDenom), nil, 0, 0)
+	s.app.MarkerKeeper.SetNewMarker(s.ctx, types.NewMarkerAccount(rMarkerAcct, sdk.NewInt64Coin(rMarkerDenom, 1000), authUser, []types.AccessGrant{{Address: authUser.String(), Permissions: []types.Access{types.Access_Transfer}}}, types.StatusFinalized, types.MarkerType_RestrictedCoin, true, false, false, []string{}))
+
+	rMarkerGovDenom := "restricted-allow-marker-gov"
+	rMarkerGovAcct := authtypes.NewBaseAccount(types.MustGetMarkerAddress(rMarkerGovDenom), nil, 0, 0)
+	s.app.MarkerKeeper.SetNewMarker(s.ctx, types.NewMarkerAccount(rMarkerGovAcct, sdk.NewInt64Coin(rMarkerGovDenom, 1000), authUser, []types.AccessGrant{{Address: authUser.String(), Permissions: []types.Access{}}}, types.StatusFinalized, types.MarkerType_RestrictedCoin, true, true, false, []string{}))
+
+	allowAddrToRemove := testUserAddress("allowAddrToRemove")
+	s.app.MarkerKeeper.AddSendAllow(s.ctx, rMarkerAcct.GetAddress(), allowAddrToRemove)
+	allowAddrToAdd := testUserAddress("allowAddrToAdd")
+	allowAddrToAdd2 := testUserAddress("allowAddrToAdd2")
+	allowAddrToAddGov := testUserAddress("allowAddrToAddGov")
+
+	testCases := []struct {
+		name     string
+		msg      types.MsgUpdateSendAllowListRequest
+		expErr   string
+		expAllow []sdk.AccAddress
+	}{
+		{
+			name:   "should fail, cannot find marker",
+			msg:    types.MsgUpdateSendAllowListRequest{Denom: "blah", Authority: authUser.String()},
+			expErr: "marker not found for blah: marker blah not found for address: cosmos1psw3a97ywtr595qa4295lw07cz9665hynnfpee",
+		},
+		{
+			name:   "should fail, not a restricted marker",
+			msg:    types.MsgUpdateSendAllowListRequest{Denom: notRestrictedMarker.Denom, Authority: authUser.String()},
+			expErr: "marker not-restricted-allow-marker is not a restricted marker",
+		},
+		{
+			name:   "should fail, signer does not have transfer access",
+			msg:    types.MsgUpdateSendAllowListRequest{Denom: rMarkerDenom, Authority: notAuthUser.String()},
+			expErr: fmt.Sprintf("%s does not have %s on %s marker (%s)", notAuthUser, types.Access_Transfer, rMarkerDenom, rMarkerAcct.Address),
+		},
+		{
+			name:   "should fail, gov not enabled for restricted marker",
+			msg:    types.MsgUpdateSendAllowListRequest{Denom: rMarkerDenom, Authority: authority.String()},
+			expErr: "restricted-allow-marker marker does not allow governance control",
+		},
+		{
+			name:   "should fail, address is already on allow list",
+			msg:    types.MsgUpdateSendAllowListRequest{Denom: rMarkerDenom, Authority: authUser.String(), AddAllowedAddresses: []string{allowAddrToRemove.String()}},
+			expErr: allowAddrToRemove.String() + " is already on allow list cannot add address",
+		},
+		{
+			name:   "should fail, address can not be removed not in allow list",
+			msg:    types.MsgUpdateSendAllowListRequest{Denom: rMarkerDenom, Authority: authUser.String(), RemoveAllowedAddresses: []string{allowAddrToAdd.String()}},
+			expErr: allowAddrToAdd.String() + " is not on allow list cannot remove address",
+		},
+		{
+			name:     "should succeed to bulk add to allow list",
+			msg:      types.MsgUpdateSendAllowListRequest{Denom: rMarkerDenom, Authority: authUser.String(), AddAllowedAddresses: []string{allowAddrToAdd.String(), allowAddrToAdd2.String()}},
+			expAllow: []sdk.AccAddress{allowAddrToRemove, allowAddrToAdd, allowAddrToAdd2},
+		},
+		{
+			name:     "should succeed to remove from allow list",
+			msg:      types.MsgUpdateSendAllowListRequest{Denom: rMarkerDenom, Authority: authUser.String(), RemoveAllowedAddresses: []string{allowAddrToRemove.String()}},
+			expAllow: []sdk.AccAddress{allowAddrToAdd, allowAddrToAdd2},
+		},
+		{
+			name:     "should succeed gov allowed for marker",
+			msg:      types.MsgUpdateSendAllowListRequest{Denom: rMarkerGovDenom, Authority: authority.String(), AddAllowedAddresses: []string{allowAddrToAddGov.String()}},
+			expAllow: []sdk.AccAddress{allowAddrToAddGov},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res, err := s.msgServer.UpdateSendAllowList(s.ctx, &tc.msg)
+			if len(tc.expErr) > 0 {
+				s.Assert().Nil(res, "UpdateSendAllowList response")
+				s.Assert().EqualError(err, tc.expErr, "UpdateSendAllowList error")
+				return
+			}
+
+			s.Require().NoError(err, "UpdateSendAllowList error")
+			s.Assert().Equal(&types.MsgUpdateSendAllowListResponse{}, res, "UpdateSendAllowList response")
+			allowList := s.app.MarkerKeeper.GetSendAllowList(s.ctx, types.MustGetMarkerAddress(tc.msg.Denom))
+			s.Assert().ElementsMatch(tc.expAllow, allowList, "GetSendAllowList(%s)", tc.msg.Denom)
+		})
+	}
+}
+
+func (s *MsgServerTestSuite) TestSetSendAllowListMode() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	authUser := testUserAddress("test")
+	notAuthUser := testUserAddress("test1")
+
+	rMarkerDenom := "restricted-allow-mode"
+	rMarkerAcct := authtypes.NewBaseAccount(types.MustGetMarkerAddress(rMarkerDenom), nil, 0, 0)
+	s.app.MarkerKeeper.SetNewMarker(s.ctx, types.NewMarkerAccount(rMarkerAcct, sdk.NewInt64Coin(rMarkerDenom, 1000), authUser, []types.AccessGrant{{Address: authUser.String(), Permissions: []types.Access{types.Access_Transfer}}}, types.StatusFinalized, types.MarkerType_RestrictedCoin, true, true, false, []string{}))
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgSetSendAllowListModeRequest
+		expErr string
+	}{
+		{
+			name:   "should fail, cannot find marker",
+			msg:    types.NewMsgSetSendAllowListModeRequest("blah", true, authUser),
+			expErr: "marker not found for blah: marker blah not found for address: cosmos1psw3a97ywtr595qa4295lw07cz9665hynnfpee",
+		},
+		{
+			name:   "should fail, signer does not have transfer access",
+			msg:    types.NewMsgSetSendAllowListModeRequest(rMarkerDenom, true, notAuthUser),
+			expErr: fmt.Sprintf("%s does not have %s on %s marker (%s)", notAuthUser, types.Access_Transfer, rMarkerDenom, rMarkerAcct.Address),
+		},
+		{
+			name: "should succeed to enable with transfer access",
+			msg:  types.NewMsgSetSendAllowListModeRequest(rMarkerDenom, true, authUser),
+		},
+		{
+			name: "should succeed to disable via gov",
+			msg:  types.NewMsgSetSendAllowListModeRequest(rMarkerDenom, false, authority),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res, err := s.msgServer.SetSendAllowListMode(s.ctx, tc.msg)
+			if len(tc.expErr) > 0 {
+				s.Assert().Nil(res, "SetSendAllowListMode response")
+				s.Assert().EqualError(err, tc.expErr, "SetSendAllowListMode error")
+				return
+			}
+
+			s.Require().NoError(err, "SetSendAllowListMode error")
+			s.Assert().Equal(&types.MsgSetSendAllowListModeResponse{}, res, "SetSendAllowListMode response")
+			enabled := s.app.MarkerKeeper.IsSendAllowListEnabled(s.ctx, rMarkerAcct.GetAddress())
+			s.Assert().Equal(tc.msg.Enabled, enabled, "IsSendAllowListEnabled")
+		})
+	}
+}
+
+func (s *MsgServerTestSuite) TestPauseMarker() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	authUser := testUserAddress("test")
+	notAuthUser := testUserAddress("test1")
+
+	markerDenom := "pause-marker"
+	markerAcct := authtypes.NewBaseAccount(types.MustGetMarkerAddress(markerDenom), nil, 0, 0)
+	s.app.MarkerKeeper.SetNewMarker(s.ctx, types.NewMarkerAccount(markerAcct, sdk.NewInt64Coin(markerDenom, 1000), authUser, []types.AccessGrant{{Address: authUser.String(), Permissions: []types.Access{types.Access_Admin}}}, types.StatusActive, types.MarkerType_Coin, true, false, false, []string{}))
+
+	noGovDenom := "pause-marker-nogov"
+	noGovAcct := authtypes.NewBaseAccount(types.MustGetMarkerAddress(noGovDenom), nil, 0, 0)
+	s.app.MarkerKeeper.SetNewMarker(s.ctx, types.NewMarkerAccount(noGovAcct, sdk.NewInt64Coin(noGovDenom, 1000), authUser, []types.AccessGrant{{Address: authUser.String(), Permissions: []types.Access{types.Access_Admin}}}, types.StatusActive, types.MarkerType_Coin, true, false, false, []string{}))
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgPauseMarkerRequest
+		expErr string
+	}{
+		{
+			name:   "should fail, cannot find marker",
+			msg:    types.NewMsgPauseMarkerRequest("blah", authUser),
+			expErr: "marker not found for blah: marker blah not found for address: cosmos1psw3a97ywtr595qa4295lw07cz9665hynnfpee",
+		},
+		{
+			name:   "should fail, signer does not have admin access",
+			msg:    types.NewMsgPauseMarkerRequest(markerDenom, notAuthUser),
+			expErr: fmt.Sprintf("%s does not have %s on %s marker (%s)", notAuthUser, types.Access_Admin, markerDenom, markerAcct.Address),
+		},
+		{
+			name:   "should fail, gov not enabled",
+			msg:    types.NewMsgPauseMarkerRequest(noGovDenom, authority),
+			expErr: noGovDenom + " marker does not allow governance control",
+		},
+		{
+			name: "should succeed with admin access",
+			msg:  types.NewMsgPauseMarkerRequest(markerDenom, authUser),
+		},
+		{
+			name:   "should fail, already paused",
+			msg:    types.NewMsgPauseMarkerRequest(markerDenom, authUser),
+			expErr: markerDenom + " marker is already paused",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+			res, err := s.msgServer.PauseMarker(s.ctx, tc.msg)
+			if len(tc.expErr) > 0 {
+				s.Assert().Nil(res, "PauseMarker response")
+				s.Assert().EqualError(err, tc.expErr, "PauseMarker error")
+				return
+			}
+
+			s.Require().NoError(err, "PauseMarker error")
+			s.Assert().Equal(&types.MsgPauseMarkerResponse{}, res, "PauseMarker response")
+			s.Assert().True(s.app.MarkerKeeper.IsMarkerPaused(s.ctx, markerAcct.GetAddress()), "IsMarkerPaused")
+			expEvent := types.NewEventMarkerPaused(tc.msg.Denom, tc.msg.Authority)
+			s.Assert().True(s.containsMessage(s.ctx.EventManager().ABCIEvents(), expEvent), "PauseMarker missing expected event %T", expEvent)
+		})
+	}
+}
+
+func (s *MsgServerTestSuite) TestUnpauseMarker() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	authUser := testUserAddress("test")
+	notAuthUser := testUserAddress("test1")
+
+	markerDenom := "unpause-marker"
+	markerAcct := authtypes.NewBaseAccount(types.MustGetMarkerAddress(markerDenom), nil, 0, 0)
+	s.app.MarkerKeeper.SetNewMarker(s.ctx, types.NewMarkerAccount(markerAcct, sdk.NewInt64Coin(markerDenom, 1000), authUser, []types.AccessGrant{{Address: authUser.String(), Permissions: []types.Access{types.Access_Admin}}}, types.StatusActive, types.MarkerType_Coin, true, true, false, []string{}))
+	s.app.MarkerKeeper.SetMarkerPaused(s.ctx, markerAcct.GetAddress(), true)
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgUnpauseMarkerRequest
+		expErr string
+	}{
+		{
+			name:   "should fail, cannot find marker",
+			msg:    types.NewMsgUnpauseMarkerRequest("blah", authUser),
+			expErr: "marker not found for blah: marker blah not found for address: cosmos1psw3a97ywtr595qa4295lw07cz9665hynnfpee",
+		},
+		{
+			name:   "should fail, signer does not have admin access",
+			msg:    types.NewMsgUnpauseMarkerRequest(markerDenom, notAuthUser),
+			expErr: fmt.Sprintf("%s does not have %s on %s marker (%s)", notAuthUser, types.Access_Admin, markerDenom, markerAcct.Address),
+		},
+		{
+			name: "should succeed via gov",
+			msg:  types.NewMsgUnpauseMarkerRequest(markerDenom, authority),
+		},
+		{
+			name:   "should fail, not paused",
+			msg:    types.NewMsgUnpauseMarkerRequest(markerDenom, authUser),
+			expErr: markerDenom + " marker is not paused",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+			res, err := s.msgServer.UnpauseMarker(s.ctx, tc.msg)
+			if len(tc.expErr) > 0 {
+				s.Assert().Nil(res, "UnpauseMarker response")
+				s.Assert().EqualError(err, tc.expErr, "UnpauseMarker error")
+				return
+			}
+
+			s.Require().NoError(err, "UnpauseMarker error")
+			s.Assert().Equal(&types.MsgUnpauseMarkerResponse{}, res, "UnpauseMarker response")
+			s.Assert().False(s.app.MarkerKeeper.IsMarkerPaused(s.ctx, markerAcct.GetAddress()), "IsMarkerPaused")
+			expEvent := types.NewEventMarkerUnpaused(tc.msg.Denom, tc.msg.Authority)
+			s.Assert().True(s.containsMessage(s.ctx.EventManager().ABCIEvents(), expEvent), "UnpauseMarker missing expected event %T", expEvent)
+		})
+	}
+}
+
+func (s *MsgServerTestSuite) TestSetTransferFee() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	authUser := testUserAddress("test")
+	notAuthUser := testUserAddress("test1")
+	treasury := testUserAddress("treasury")
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+	markerDenom := "transfer-fee-marker"
+	markerAcct := authtypes.NewBaseAccount(types.MustGetMarkerAddress(markerDenom), nil, 0, 0)
+	s.app.MarkerKeeper.SetNewMarker(s.ctx, types.NewMarkerAccount(markerAcct, sdk.NewInt64Coin(markerDenom, 1000), authUser, []types.AccessGrant{{Address: authUser.String(), Permissions: []types.Access{types.Access_Admin}}}, types.StatusActive, types.MarkerType_Coin, true, true, false, []string{}))
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgSetTransferFeeRequest
+		expFee *types.TransferFee
+		expErr string
+	}{
+		{
+			name:   "should fail, cannot find marker",
+			msg:    types.NewMsgSetTransferFeeRequest("blah", 25, treasury.String(), authUser),
+			expErr: "marker not found for blah: marker blah not found for address: cosmos1psw3a97ywtr595qa4295lw07cz9665hynnfpee",
+		},
+		{
+			name:   "should fail, signer does not have admin access",
+			msg:    types.NewMsgSetTransferFeeRequest(markerDenom, 25, treasury.String(), notAuthUser),
+			expErr: fmt.Sprintf("%s does not have %s on %s marker (%s)", notAuthUser, types.Access_Admin, markerDenom, markerAcct.Address),
+		},
+		{
+			name:   "should fail, recipient is blocked",
+			msg:    types.NewMsgSetTransferFeeRequest(markerDenom, 25, feeCollector.String(), authUser),
+			expErr: feeCollector.String() + " is not allowed to receive transfer fees",
+		},
+		{
+			name:   "should succeed with admin access",
+			msg:    types.NewMsgSetTransferFeeRequest(markerDenom, 25, treasury.String(), authUser),
+			expFee: &types.TransferFee{Denom: markerDenom, BasisPoints: 25, Recipient: treasury.String()},
+		},
+		{
+			name:   "should succeed to update via gov",
+			msg:    types.NewMsgSetTransferFeeRequest(markerDenom, 50, authUser.String(), authority),
+			expFee: &types.TransferFee{Denom: markerDenom, BasisPoints: 50, Recipient: authUser.String()},
+		},
+		{
+			name: "should succeed to remove",
+			msg:  types.NewMsgSetTransferFeeRequest(markerDenom, 0, "", authUser),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res, err := s.msgServer.SetTransferFee(s.ctx, tc.msg)
+			if len(tc.expErr) > 0 {
+				s.Assert().Nil(res, "SetTransferFee response")
+				s.Assert().EqualError(err, tc.expErr, "SetTransferFee error")
+				return
+			}
+
+			s.Require().NoError(err, "SetTransferFee error")
+			s.Assert().Equal(&types.MsgSetTransferFeeResponse{}, res, "SetTransferFee response")
+			fee, err := s.app.MarkerKeeper.GetTransferFee(s.ctx, markerAcct.GetAddress())
+			s.Require().NoError(err, "GetTransferFee")
+			s.Assert().Equal(tc.expFee, fee, "GetTransferFee")
+		})
+	}
+}
+
+func (s *MsgServerTestSuite) TestSetVelocityLimit() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	authUser := testUserAddress("test")
+	notAuthUser := testUserAddress("test1")
+
+	markerDenom := "velocity-limit-marker"
+	markerAcct := authtypes.NewBaseAccount(types.MustGetMarkerAddress(markerDenom), nil, 0, 0)
+	s.app.MarkerKeeper.SetNewMarker(s.ctx, types.NewMarkerAccount(markerAcct, sdk.NewInt64Coin(markerDenom, 1000), authUser, []types.AccessGrant{{Address: authUser.String(), Permissions: []types.Access{types.Access_Admin}}}, types.StatusActive, types.MarkerType_Coin, true, true, false, []string{}))
+
+	countBuckets := func() int {
+		count := 0
+		s.Require().NoError(s.app.MarkerKeeper.IterateVelocityBuckets(s.ctx, func(markerAddr, _ sdk.AccAddress, _ uint64, _ sdkmath.Int) bool {
+			if markerAddr.Equals(markerAcct.GetAddress()) {
+				count++
+			}
+			return false
+		}), "IterateVelocityBuckets")
+		return count
+	}
+
+	testCases := []struct {
+		name       string
+		msg        *types.MsgSetVelocityLimitRequest
+		expLimit   *types.VelocityLimit
+		expBuckets int
+		expErr     string
+	}{
+		{
+			name:       "should fail, cannot find marker",
+			msg:        types.NewMsgSetVelocityLimitRequest("blah", sdkmath.NewInt(100), 3600, 60, authUser),
+			expBuckets: 1,
+			expErr:     "marker not found for blah: marker blah not found for address: cosmos1psw3a97ywtr595qa4295lw07cz9665hynnfpee",
+		},
+		{
+			name:       "should fail, signer does not have admin access",
+			msg:        types.NewMsgSetVelocityLimitRequest(markerDenom, sdkmath.NewInt(100), 3600, 60, notAuthUser),
+			expBuckets: 1,
+			expErr:     fmt.Sprintf("%s does not have %s on %s marker (%s)", notAuthUser, types.Access_Admin, markerDenom, markerAcct.Address),
+		},
+		{
+			name:       "should succeed with admin access",
+			msg:        types.NewMsgSetVelocityLimitRequest(markerDenom, sdkmath.NewInt(100), 3600, 60, authUser),
+			expLimit:   &types.VelocityLimit{Denom: markerDenom, MaxAmount: sdkmath.NewInt(100), WindowSeconds: 3600, BucketSeconds: 60},
+			expBuckets: 1,
+		},
+		{
+			name:       "should succeed to update via gov and keep buckets",
+			msg:        types.NewMsgSetVelocityLimitRequest(markerDenom, sdkmath.NewInt(500), 7200, 60, authority),
+			expLimit:   &types.VelocityLimit{Denom: markerDenom, MaxAmount: sdkmath.NewInt(500), WindowSeconds: 7200, BucketSeconds: 60},
+			expBuckets: 1,
+		},
+		{
+			name:       "should succeed to change bucket size and clear buckets",
+			msg:        types.NewMsgSetVelocityLimitRequest(markerDenom, sdkmath.NewInt(500), 7200, 600, authUser),
+			expLimit:   &types.VelocityLimit{Denom: markerDenom, MaxAmount: sdkmath.NewInt(500), WindowSeconds: 7200, BucketSeconds: 600},
+			expBuckets: 0,
+		},
+		{
+			name: "should succeed to remove",
+			msg:  types.NewMsgSetVelocityLimitRequest(markerDenom, sdkmath.ZeroInt(), 0, 0, authUser),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Make sure there's always some recorded usage to possibly be cleared.
+			err := s.app.MarkerKeeper.SetVelocityBucketAmount(s.ctx, markerAcct.GetAddress(), authUser, 1, sdkmath.NewInt(5))
+			s.Require().NoError(err, "SetVelocityBucketAmount")
+
+			res, err := s.msgServer.SetVelocityLimit(s.ctx, tc.msg)
+			if len(tc.expErr) > 0 {
+				s.Assert().Nil(res, "SetVelocityLimit response")
+				s.Assert().EqualError(err, tc.expErr, "SetVelocityLimit error")
+			} else {
+				s.Require().NoError(err, "SetVelocityLimit error")
+				s.Assert().Equal(&types.MsgSetVelocityLimitResponse{}, res, "SetVelocityLimit response")
+				limit, err := s.app.MarkerKeeper.GetVelocityLimit(s.ctx, markerAcct.GetAddress())
+				s.Require().NoError(err, "GetVelocityLimit")
+				s.Assert().Equal(tc.expLimit, limit, "GetVelocityLimit")
+			}
+			s.Assert().Equal(tc.expBuckets, countBuckets(), "number of velocity buckets")
+		})
+	}
+}
+
+func (s *MsgServerTestSuite) TestTakeHolderSnapshot() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	authUser := testUserAddress("test")
+	notAuthUser := testUserAddress("test1")
+	holder := testUserAddress("holder")
+	blockTime := time.Unix(1_700_000_000, 0).UTC()
+
+	markerDenom := "holder-snapshot-marker"
+	markerAcct := authtypes.NewBaseAccount(types.MustGetMarkerAddress(markerDenom), nil, 0, 0)
+	s.app.MarkerKeeper.SetNewMarker(s.ctx, types.NewMarkerAccount(markerAcct, sdk.NewInt64Coin(markerDenom, 1000), authUser, []types.AccessGrant{{Address: authUser.String(), Permissions: []types.Access{types.Access_Admin}}}, types.StatusActive, types.MarkerType_Coin, true, true, false, []string{}))
+	s.Require().NoError(banktestutil.FundAccount(s.ctx, s.app.BankKeeper, holder, sdk.NewCoins(sdk.NewInt64Coin(markerDenom, 250))), "FundAccount")
+
+	testCases := []struct {
+		name        string
+		height      int64
+		msg         *types.MsgTakeHolderSnapshotRequest
+		expSnapshot types.HolderSnapshot
+		expErr      string
+	}{
+		{
+			name:   "should fail, cannot find marker",
+			height: 100,
+			msg:    types.NewMsgTakeHolderSnapshotRequest("blah", authUser),
+			expErr: "marker not found for blah: marker blah not found for address: cosmos1psw3a97ywtr595qa4295lw07cz9665hynnfpee",
+		},
+		{
+			name:   "should fail, signer does not have admin access",
+			height: 100,
+			msg:    types.NewMsgTakeHolderSnapshotRequest(markerDenom, notAuthUser),
+			expErr: fmt.Sprintf("%s does not have %s on %s marker (%s)", notAuthUser, types.Access_Admin, markerDenom, markerAcct.Address),
+		},
+		{
+			name:        "should succeed with admin access",
+			height:      100,
+			msg:         types.NewMsgTakeHolderSnapshotRequest(markerDenom, authUser),
+			expSnapshot: types.NewHolderSnapshot(markerDenom, 100, blockTime, 1, sdkmath.NewInt(250)),
+		},
+		{
+			name:   "should fail, already taken at height",
+			height: 100,
+			msg:    types.NewMsgTakeHolderSnapshotRequest(markerDenom, authUser),
+			expErr: "a holder snapshot of " + markerDenom + " has already been taken at height 100",
+		},
+		{
+			name:        "should succeed via gov at next height",
+			height:      101,
+			msg:         types.NewMsgTakeHolderSnapshotRequest(markerDenom, authority),
+			expSnapshot: types.NewHolderSnapshot(markerDenom, 101, blockTime, 1, sdkmath.NewInt(250)),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			ctx := s.ctx.WithBlockHeight(tc.height).WithBlockTime(blockTime).WithEventManager(sdk.NewEventManager())
+			res, err := s.msgServer.TakeHolderSnapshot(ctx, tc.msg)
+			if len(tc.expErr) > 0 {
+				s.Assert().Nil(res, "TakeHolderSnapshot response")
+				s.Assert().EqualError(err, tc.expErr, "TakeHolderSnapshot error")
+				return
+			}
+
+			s.Require().NoError(err, "TakeHolderSnapshot error")
+			s.Assert().Equal(&types.MsgTakeHolderSnapshotResponse{Snapshot: tc.expSnapshot}, res, "TakeHolderSnapshot response")
+			snapshot, err := s.app.MarkerKeeper.GetHolderSnapshot(ctx, markerAcct.GetAddress(), tc.height)
+			s.Require().NoError(err, "GetHolderSnapshot")
+			s.Assert().Equal(&tc.expSnapshot, snapshot, "GetHolderSnapshot")
+			expEvent := types.NewEventMarkerHolderSnapshotTaken(tc.expSnapshot, tc.msg.Authority)
+			s.Assert().True(s.containsMessage(ctx.EventManager().ABCIEvents(), expEvent), "TakeHolderSnapshot missing expected event %T", expEvent)
+		})
+	}
+}
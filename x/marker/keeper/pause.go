@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// IsMarkerPaused returns true if sends of the marker's denom are paused.
+func (k Keeper) IsMarkerPaused(ctx sdk.Context, markerAddr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.PausedMarkerKey(markerAddr))
+}
+
+// SetMarkerPaused pauses or unpauses sends of the marker's denom.
+func (k Keeper) SetMarkerPaused(ctx sdk.Context, markerAddr sdk.AccAddress, paused bool) {
+	store := ctx.KVStore(k.storeKey)
+	if paused {
+		store.Set(types.PausedMarkerKey(markerAddr), []byte{})
+	} else {
+		store.Delete(types.PausedMarkerKey(markerAddr))
+	}
+}
+
+// IteratePausedMarkers iterates the addresses of all paused markers.
+func (k Keeper) IteratePausedMarkers(ctx sdk.Context, handler func(markerAddr sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.PausedMarkerPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if handler(types.SplitMarkerStoreKey(iterator.Key())) {
+			break
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestMarkerPaused(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	marker1 := types.MustGetMarkerAddress("pausecoin1")
+	marker2 := types.MustGetMarkerAddress("pausecoin2")
+
+	assert.False(t, app.MarkerKeeper.IsMarkerPaused(ctx, marker1), "IsMarkerPaused before pause")
+	app.MarkerKeeper.SetMarkerPaused(ctx, marker1, true)
+	assert.True(t, app.MarkerKeeper.IsMarkerPaused(ctx, marker1), "IsMarkerPaused after pause")
+	assert.False(t, app.MarkerKeeper.IsMarkerPaused(ctx, marker2), "IsMarkerPaused other marker")
+
+	var paused []sdk.AccAddress
+	app.MarkerKeeper.IteratePausedMarkers(ctx, func(markerAddr sdk.AccAddress) bool {
+		paused = append(paused, markerAddr)
+		return false
+	})
+	assert.Equal(t, []sdk.AccAddress{marker1}, paused, "IteratePausedMarkers")
+
+	app.MarkerKeeper.SetMarkerPaused(ctx, marker1, false)
+	assert.False(t, app.MarkerKeeper.IsMarkerPaused(ctx, marker1), "IsMarkerPaused after unpause")
+}
+
+func TestPausedMarkerSends(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	admin := sdk.AccAddress("admin_account_______")
+	holder := sdk.AccAddress("holder_account______")
+	other := sdk.AccAddress("other_account_______")
+	for _, addr := range []sdk.AccAddress{admin, holder, other} {
+		acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+		require.NoError(t, acc.SetSequence(1), "%s.SetSequence(1)", string(addr))
+		app.AccountKeeper.SetAccount(ctx, acc)
+	}
+
+	addMarker := func(denom string, markerType types.MarkerType) {
+		perms := []types.Access{types.Access_Mint, types.Access_Burn, types.Access_Deposit,
+			types.Access_Withdraw, types.Access_Delete, types.Access_Admin}
+		restricted := markerType == types.MarkerType_RestrictedCoin
+		if restricted {
+			perms = append(perms, types.Access_Transfer, types.Access_ForceTransfer)
+		}
+		mac := types.NewMarkerAccount(
+			authtypes.NewBaseAccount(types.MustGetMarkerAddress(denom), nil, 0, 0),
+			sdk.NewInt64Coin(denom, 1000),
+			admin,
+			[]types.AccessGrant{{Address: admin.String(), Permissions: perms}},
+			types.StatusProposed,
+			markerType,
+			true,
+			true,
+			restricted,
+			[]string{},
+		)
+		require.NoError(t, app.MarkerKeeper.SetNetAssetValue(ctx, mac, types.NewNetAssetValue(sdk.NewInt64Coin(types.UsdDenom, 1), 1), "test"),
+			"SetNetAssetValue(%s)", denom)
+		require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, mac), "AddFinalizeAndActivateMarker(%s)", denom)
+	}
+
+	rDenom := "pausedrestricted"
+	cDenom := "pausedcoin"
+	addMarker(rDenom, types.MarkerType_RestrictedCoin)
+	addMarker(cDenom, types.MarkerType_Coin)
+	app.MarkerKeeper.SetMarkerPaused(ctx, types.MustGetMarkerAddress(rDenom), true)
+	app.MarkerKeeper.SetMarkerPaused(ctx, types.MustGetMarkerAddress(cDenom), true)
+
+	// Withdrawals are module operations, so they're still allowed while paused.
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, admin, holder, rDenom, sdk.NewCoins(sdk.NewInt64Coin(rDenom, 100))),
+		"WithdrawCoins(%s) while paused", rDenom)
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, admin, holder, cDenom, sdk.NewCoins(sdk.NewInt64Coin(cDenom, 100))),
+		"WithdrawCoins(%s) while paused", cDenom)
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, admin, admin, rDenom, sdk.NewCoins(sdk.NewInt64Coin(rDenom, 100))),
+		"WithdrawCoins(%s) to admin while paused", rDenom)
+
+	err := app.BankKeeper.SendCoins(ctx, holder, other, sdk.NewCoins(sdk.NewInt64Coin(cDenom, 10)))
+	assert.EqualError(t, err, "cannot send pausedcoin coins: marker is paused", "bank send of unrestricted coin while paused")
+	err = app.BankKeeper.SendCoins(ctx, admin, other, sdk.NewCoins(sdk.NewInt64Coin(rDenom, 10)))
+	assert.EqualError(t, err, "cannot send pausedrestricted coins: marker is paused", "bank send of restricted coin while paused")
+
+	err = app.MarkerKeeper.TransferCoin(ctx, admin, other, admin, sdk.NewInt64Coin(rDenom, 10))
+	assert.EqualError(t, err, "pausedrestricted marker is paused, only forced transfers are allowed", "transfer of own coins while paused")
+	err = app.MarkerKeeper.TransferCoin(ctx, holder, other, admin, sdk.NewInt64Coin(rDenom, 10))
+	assert.NoError(t, err, "forced transfer while paused")
+	assert.Equal(t, "10", app.BankKeeper.GetBalance(ctx, other, rDenom).Amount.String(), "other balance after forced transfer")
+
+	app.MarkerKeeper.SetMarkerPaused(ctx, types.MustGetMarkerAddress(rDenom), false)
+	app.MarkerKeeper.SetMarkerPaused(ctx, types.MustGetMarkerAddress(cDenom), false)
+
+	err = app.BankKeeper.SendCoins(ctx, holder, other, sdk.NewCoins(sdk.NewInt64Coin(cDenom, 10)))
+	assert.NoError(t, err, "bank send of unrestricted coin after unpause")
+	err = app.MarkerKeeper.TransferCoin(ctx, admin, other, admin, sdk.NewInt64Coin(rDenom, 10))
+	assert.NoError(t, err, "transfer of own coins after unpause")
+	assert.Equal(t, "20", app.BankKeeper.GetBalance(ctx, other, rDenom).Amount.String(), "other balance after unpause transfer")
+}
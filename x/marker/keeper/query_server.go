@@ -6,6 +6,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -203,6 +204,199 @@ func (k Keeper) NetAssetValues(c context.Context, req *types.QueryNetAssetValues
 	return &types.QueryNetAssetValuesResponse{NetAssetValues: navs}, nil
 }
 
+// SupplySchedule returns the pending supply schedule for a marker
+func (k Keeper) SupplySchedule(c context.Context, req *types.QuerySupplyScheduleRequest) (*types.QuerySupplyScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := k.GetSupplySchedule(ctx, marker.GetAddress())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if schedule == nil {
+		schedule = &types.SupplySchedule{Denom: marker.GetDenom()}
+	}
+
+	return &types.QuerySupplyScheduleResponse{SupplySchedule: *schedule}, nil
+}
+
+// SendAllowList returns whether allowlist mode is on for a marker and the addresses on its send allow list.
+func (k Keeper) SendAllowList(c context.Context, req *types.QuerySendAllowListRequest) (*types.QuerySendAllowListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var addresses []string
+	store := ctx.KVStore(k.storeKey)
+	allowStore := prefix.NewStore(store, types.AllowSendMarkerPrefix(marker.GetAddress()))
+	pageRes, err := query.Paginate(allowStore, req.Pagination, func(key []byte, _ []byte) error {
+		// The remaining key is the length-prefixed allowed address.
+		addresses = append(addresses, sdk.AccAddress(key[1:key[0]+1]).String())
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QuerySendAllowListResponse{
+		Enabled:          k.IsSendAllowListEnabled(ctx, marker.GetAddress()),
+		AllowedAddresses: addresses,
+		Pagination:       pageRes,
+	}, nil
+}
+
+// PauseStatus returns whether sends of a marker's denom are paused.
+func (k Keeper) PauseStatus(c context.Context, req *types.QueryPauseStatusRequest) (*types.QueryPauseStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPauseStatusResponse{Paused: k.IsMarkerPaused(ctx, marker.GetAddress())}, nil
+}
+
+// TransferFee returns the fee charged on sends of a marker's denom.
+func (k Keeper) TransferFee(c context.Context, req *types.QueryTransferFeeRequest) (*types.QueryTransferFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	fee, err := k.GetTransferFee(ctx, marker.GetAddress())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTransferFeeResponse{TransferFee: fee}, nil
+}
+
+// VelocityLimit returns the velocity limit of a marker's denom, and optionally how much an address has sent in the current window.
+func (k Keeper) VelocityLimit(c context.Context, req *types.QueryVelocityLimitRequest) (*types.QueryVelocityLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, err := k.GetVelocityLimit(ctx, marker.GetAddress())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &types.QueryVelocityLimitResponse{VelocityLimit: limit, SentInWindow: sdkmath.ZeroInt()}
+	if limit != nil && len(req.Address) > 0 {
+		addr, err := sdk.AccAddressFromBech32(req.Address)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		resp.SentInWindow, err = k.GetAmountSentInWindow(ctx, marker.GetAddress(), addr, *limit)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return resp, nil
+}
+
+// HolderSnapshots returns the snapshots that have been taken of a marker's holders.
+func (k Keeper) HolderSnapshots(c context.Context, req *types.QueryHolderSnapshotsRequest) (*types.QueryHolderSnapshotsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := []types.HolderSnapshot{}
+	store := ctx.KVStore(k.storeKey)
+	snapshotStore := prefix.NewStore(store, types.HolderSnapshotMarkerPrefix(marker.GetAddress()))
+	pageRes, err := query.Paginate(snapshotStore, req.Pagination, func(_ []byte, value []byte) error {
+		var snapshot types.HolderSnapshot
+		if err := k.cdc.Unmarshal(value, &snapshot); err != nil {
+			return err
+		}
+		snapshots = append(snapshots, snapshot)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryHolderSnapshotsResponse{Snapshots: snapshots, Pagination: pageRes}, nil
+}
+
+// HolderSnapshot returns a snapshot of a marker's holders along with a page of the balances recorded in it.
+func (k Keeper) HolderSnapshot(c context.Context, req *types.QueryHolderSnapshotRequest) (*types.QueryHolderSnapshotResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, err := k.GetHolderSnapshot(ctx, marker.GetAddress(), req.Height)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if snapshot == nil {
+		return nil, status.Errorf(codes.NotFound, "no holder snapshot of %s found at height %d", marker.GetDenom(), req.Height)
+	}
+
+	balances := []types.Balance{}
+	store := ctx.KVStore(k.storeKey)
+	balanceStore := prefix.NewStore(store, types.HolderSnapshotBalancesPrefix(marker.GetAddress(), req.Height))
+	pageRes, err := query.Paginate(balanceStore, req.Pagination, func(key []byte, value []byte) error {
+		var amount sdkmath.Int
+		if err := amount.Unmarshal(value); err != nil {
+			return err
+		}
+		// The remaining key is the length-prefixed holder address.
+		balances = append(balances, types.Balance{
+			Address: sdk.AccAddress(key[1 : key[0]+1]).String(),
+			Coins:   sdk.NewCoins(sdk.NewCoin(snapshot.Denom, amount)),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryHolderSnapshotResponse{Snapshot: *snapshot, Balances: balances, Pagination: pageRes}, nil
+}
+
 // accountForDenomOrAddress attempts to first get a marker by account address and then by denom.
 func accountForDenomOrAddress(ctx sdk.Context, keeper Keeper, lookup string) (types.MarkerAccountI, error) {
 	var addrErr, err error
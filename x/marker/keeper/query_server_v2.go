@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/internal/fieldmask"
+	"github.com/provenance-io/provenance/internal/pagination"
+	"github.com/provenance-io/provenance/x/marker/types"
+	markerv2 "github.com/provenance-io/provenance/x/marker/types/v2"
+)
+
+// QueryServerV2 is an alias for a Keeper that implements the markerv2.QueryServer interface.
+type QueryServerV2 struct {
+	Keeper
+}
+
+// NewQueryServerV2 returns an implementation of the marker v2 QueryServer interface for the provided Keeper.
+func NewQueryServerV2(k Keeper) markerv2.QueryServer {
+	return QueryServerV2{Keeper: k}
+}
+
+var _ markerv2.QueryServer = QueryServerV2{}
+
+// Markers returns all the markers, optionally limited to the ones with a specific status.
+func (k QueryServerV2) Markers(c context.Context, req *markerv2.QueryMarkersRequest) (*markerv2.QueryMarkersResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "v2", "Markers")
+	if req == nil {
+		req = &markerv2.QueryMarkersRequest{}
+	}
+	mask, err := fieldmask.Parse(req.FieldMask, &types.MarkerAccount{})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid field mask: %v", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	markerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.MarkerStoreKeyPrefix)
+	resp := &markerv2.QueryMarkersResponse{}
+	resp.Pagination, err = pagination.Paginate(markerStore, req.Pagination, req.Order == markerv2.Order_ORDER_DESCENDING,
+		func(_ []byte, value []byte) (bool, error) {
+			marker, merr := k.GetMarker(ctx, sdk.AccAddress(value))
+			if merr != nil {
+				return false, merr
+			}
+			acct, ok := marker.(*types.MarkerAccount)
+			if !ok || (req.Status != types.StatusUndefined && acct.Status != req.Status) {
+				return false, nil
+			}
+			mask.Apply(acct)
+			resp.Markers = append(resp.Markers, acct)
+			return true, nil
+		})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error iterating all markers: %v", err)
+	}
+
+	return resp, nil
+}
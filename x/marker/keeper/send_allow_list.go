@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// IsSendAllowListEnabled returns true if the marker has send allowlist mode on.
+func (k Keeper) IsSendAllowListEnabled(ctx sdk.Context, markerAddr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.SendAllowListModeKey(markerAddr))
+}
+
+// SetSendAllowListEnabled turns send allowlist mode on or off for the marker.
+func (k Keeper) SetSendAllowListEnabled(ctx sdk.Context, markerAddr sdk.AccAddress, enabled bool) {
+	store := ctx.KVStore(k.storeKey)
+	if enabled {
+		store.Set(types.SendAllowListModeKey(markerAddr), []byte{})
+	} else {
+		store.Delete(types.SendAllowListModeKey(markerAddr))
+	}
+}
+
+// IterateSendAllowListEnabled iterates the addresses of all markers that have send allowlist mode on.
+func (k Keeper) IterateSendAllowListEnabled(ctx sdk.Context, handler func(markerAddr sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.SendAllowListModePrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if handler(types.SplitMarkerStoreKey(iterator.Key())) {
+			break
+		}
+	}
+}
+
+// IsSendAllowed returns true if the address is on the marker's send allow list.
+func (k Keeper) IsSendAllowed(ctx sdk.Context, markerAddr, addr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.AllowSendKey(markerAddr, addr))
+}
+
+// AddSendAllow adds an address to the marker's send allow list.
+func (k Keeper) AddSendAllow(ctx sdk.Context, markerAddr, addr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.AllowSendKey(markerAddr, addr), []byte{})
+}
+
+// RemoveSendAllow removes an address from the marker's send allow list.
+func (k Keeper) RemoveSendAllow(ctx sdk.Context, markerAddr, addr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.AllowSendKey(markerAddr, addr))
+}
+
+// ClearSendAllow turns off send allowlist mode and removes all entries from the marker's send allow list.
+func (k Keeper) ClearSendAllow(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	k.SetSendAllowListEnabled(ctx, markerAddr, false)
+	for _, addr := range k.GetSendAllowList(ctx, markerAddr) {
+		k.RemoveSendAllow(ctx, markerAddr, addr)
+	}
+}
+
+// IterateSendAllow iterates the keys of all send allow list entries.
+func (k Keeper) IterateSendAllow(ctx sdk.Context, handler func(key []byte) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.AllowSendKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if handler(iterator.Key()) {
+			break
+		}
+	}
+}
+
+// GetSendAllowList gets the list of addresses on the marker's send allow list.
+func (k Keeper) GetSendAllowList(ctx sdk.Context, markerAddr sdk.AccAddress) []sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.AllowSendMarkerPrefix(markerAddr))
+	list := []sdk.AccAddress{}
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		_, allowed := types.GetAllowSendAddresses(iterator.Key())
+		list = append(list, allowed)
+	}
+
+	return list
+}
@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestSendAllowList(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	marker1 := types.MustGetMarkerAddress("allowcoin1")
+	marker2 := types.MustGetMarkerAddress("allowcoin2")
+	addr1 := testUserAddress("allow1")
+	addr2 := testUserAddress("allow2")
+
+	assert.False(t, app.MarkerKeeper.IsSendAllowListEnabled(ctx, marker1), "IsSendAllowListEnabled before set")
+	app.MarkerKeeper.SetSendAllowListEnabled(ctx, marker1, true)
+	assert.True(t, app.MarkerKeeper.IsSendAllowListEnabled(ctx, marker1), "IsSendAllowListEnabled after enable")
+	assert.False(t, app.MarkerKeeper.IsSendAllowListEnabled(ctx, marker2), "IsSendAllowListEnabled other marker")
+
+	app.MarkerKeeper.AddSendAllow(ctx, marker1, addr1)
+	app.MarkerKeeper.AddSendAllow(ctx, marker1, addr2)
+	app.MarkerKeeper.AddSendAllow(ctx, marker2, addr2)
+	assert.True(t, app.MarkerKeeper.IsSendAllowed(ctx, marker1, addr1), "IsSendAllowed(marker1, addr1)")
+	assert.False(t, app.MarkerKeeper.IsSendAllowed(ctx, marker2, addr1), "IsSendAllowed(marker2, addr1)")
+	assert.ElementsMatch(t, []sdk.AccAddress{addr1, addr2}, app.MarkerKeeper.GetSendAllowList(ctx, marker1), "GetSendAllowList(marker1)")
+
+	var enabled []sdk.AccAddress
+	app.MarkerKeeper.IterateSendAllowListEnabled(ctx, func(markerAddr sdk.AccAddress) bool {
+		enabled = append(enabled, markerAddr)
+		return false
+	})
+	assert.Equal(t, []sdk.AccAddress{marker1}, enabled, "IterateSendAllowListEnabled")
+
+	app.MarkerKeeper.RemoveSendAllow(ctx, marker1, addr2)
+	assert.Equal(t, []sdk.AccAddress{addr1}, app.MarkerKeeper.GetSendAllowList(ctx, marker1), "GetSendAllowList(marker1) after remove")
+
+	app.MarkerKeeper.ClearSendAllow(ctx, marker1)
+	assert.False(t, app.MarkerKeeper.IsSendAllowListEnabled(ctx, marker1), "IsSendAllowListEnabled after clear")
+	assert.Empty(t, app.MarkerKeeper.GetSendAllowList(ctx, marker1), "GetSendAllowList(marker1) after clear")
+	assert.Equal(t, []sdk.AccAddress{addr2}, app.MarkerKeeper.GetSendAllowList(ctx, marker2), "GetSendAllowList(marker2) after clearing marker1")
+}
+
+func TestSendRestrictionFnAllowList(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	denom := "allowlistcoin"
+	transferUser := testUserAddress("transferUser")
+	holder := testUserAddress("holder")
+	allowed := testUserAddress("allowed")
+	notAllowed := testUserAddress("notAllowed")
+
+	markerAddr := types.MustGetMarkerAddress(denom)
+	app.MarkerKeeper.SetNewMarker(ctx, types.NewMarkerAccount(
+		authtypes.NewBaseAccount(markerAddr, nil, 0, 0),
+		sdk.NewInt64Coin(denom, 1000),
+		transferUser,
+		[]types.AccessGrant{{Address: transferUser.String(), Permissions: types.AccessList{types.Access_Transfer}}},
+		types.StatusActive,
+		types.MarkerType_RestrictedCoin,
+		true,
+		false,
+		false,
+		[]string{},
+	))
+	amt := sdk.NewCoins(sdk.NewInt64Coin(denom, 10))
+
+	// Allowlist mode is off, so anyone can receive it from someone with transfer access.
+	_, err := app.MarkerKeeper.SendRestrictionFn(ctx, transferUser, notAllowed, amt)
+	require.NoError(t, err, "SendRestrictionFn allowlist mode off")
+
+	app.MarkerKeeper.SetSendAllowListEnabled(ctx, markerAddr, true)
+	app.MarkerKeeper.AddSendAllow(ctx, markerAddr, allowed)
+
+	tests := []struct {
+		name   string
+		ctx    sdk.Context
+		from   sdk.AccAddress
+		to     sdk.AccAddress
+		expErr string
+	}{
+		{
+			name: "to allowed address",
+			ctx:  ctx,
+			from: transferUser,
+			to:   allowed,
+		},
+		{
+			name:   "to address not on allow list",
+			ctx:    ctx,
+			from:   transferUser,
+			to:     notAllowed,
+			expErr: notAllowed.String() + " is not on allow list for receiving restricted marker " + denom,
+		},
+		{
+			name:   "to allowed address without transfer access",
+			ctx:    ctx,
+			from:   holder,
+			to:     allowed,
+			expErr: holder.String() + " does not have transfer permissions for " + denom,
+		},
+		{
+			name: "with bypass",
+			ctx:  types.WithBypass(ctx),
+			from: transferUser,
+			to:   notAllowed,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := app.MarkerKeeper.SendRestrictionFn(tc.ctx, tc.from, tc.to, amt)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "SendRestrictionFn error")
+			} else {
+				assert.NoError(t, err, "SendRestrictionFn error")
+			}
+		})
+	}
+}
@@ -38,7 +38,8 @@ func (k Keeper) SendRestrictionFn(goCtx context.Context, fromAddr, toAddr sdk.Ac
 
 	// If it's coming from a marker, make sure the withdraw is allowed.
 	admins := types.GetTransferAgents(ctx)
-	if fromMarker, _ := k.GetMarker(ctx, fromAddr); fromMarker != nil {
+	fromMarker, _ := k.GetMarker(ctx, fromAddr)
+	if fromMarker != nil {
 		// The only ways to legitimately send from a marker account is to have a transfer agent with
 		// withdraw permissions, or through a feegrant. The only way to have a feegrant from
 		// a marker account is if an admin creates one using the marker module's GrantAllowance endpoint.
@@ -91,6 +92,16 @@ func (k Keeper) SendRestrictionFn(goCtx context.Context, fromAddr, toAddr sdk.Ac
 		}
 	}
 
+	// Make sure the send doesn't exceed any velocity limits, and record it against them.
+	if err := k.checkVelocityLimits(ctx, fromAddr, amt, fromMarker); err != nil {
+		return nil, err
+	}
+
+	// The send is allowed, so collect any transfer fees owed on it.
+	if err := k.collectTransferFees(ctx, fromAddr, toAddr, amt, fromMarker, toMarker); err != nil {
+		return nil, err
+	}
+
 	return toAddr, nil
 }
 
@@ -108,6 +119,11 @@ func (k Keeper) validateSendDenom(ctx sdk.Context, fromAddr, toAddr sdk.AccAddre
 		return fmt.Errorf("cannot send %s coins: marker status (%s) is not %s", denom, marker.GetStatus(), types.StatusActive)
 	}
 
+	// While a marker is paused, its coins can only be moved using a bypass (e.g. forced transfers and module operations).
+	if marker != nil && k.IsMarkerPaused(ctx, markerAddr) {
+		return fmt.Errorf("cannot send %s coins: marker is paused", denom)
+	}
+
 	// If there's no marker for the denom, or it's not a restricted marker, there's nothing more to do here.
 	if marker == nil || marker.GetMarkerType() != types.MarkerType_RestrictedCoin {
 		return nil
@@ -131,6 +147,13 @@ func (k Keeper) validateSendDenom(ctx sdk.Context, fromAddr, toAddr sdk.AccAddre
 		return fmt.Errorf("%s is on deny list for sending restricted marker", fromAddr.String())
 	}
 
+	// If the marker is in allowlist mode, only addresses on its allow list can receive it. Like the deny list, this
+	// is enforced even if the fromAddr has transfer access; the transfer endpoint can be used to get around it.
+	// Deposits into a marker account are governed by the access checks below instead.
+	if toMarker == nil && k.IsSendAllowListEnabled(ctx, markerAddr) && !k.IsSendAllowed(ctx, markerAddr, toAddr) {
+		return fmt.Errorf("%s is not on allow list for receiving restricted marker %s", toAddr.String(), denom)
+	}
+
 	// If the fromAddr has transfer access, there's nothing left to check.
 	if marker.AddressHasAccess(fromAddr, types.Access_Transfer) {
 		return nil
@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetSupplySchedule gets the pending supply schedule for a marker. Returns nil if the marker does not have one.
+func (k Keeper) GetSupplySchedule(ctx sdk.Context, markerAddr sdk.AccAddress) (*types.SupplySchedule, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.SupplyScheduleKey(markerAddr))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+
+	var schedule types.SupplySchedule
+	if err := k.cdc.Unmarshal(bz, &schedule); err != nil {
+		return nil, fmt.Errorf("could not read supply schedule for marker %s: %w", markerAddr, err)
+	}
+	return &schedule, nil
+}
+
+// SetSupplySchedule stores the supply schedule for a marker. If the schedule has no steps, it is removed instead.
+func (k Keeper) SetSupplySchedule(ctx sdk.Context, markerAddr sdk.AccAddress, schedule types.SupplySchedule) error {
+	if len(schedule.Steps) == 0 {
+		k.RemoveSupplySchedule(ctx, markerAddr)
+		return nil
+	}
+	if err := schedule.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&schedule)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.SupplyScheduleKey(markerAddr), bz)
+	return nil
+}
+
+// RemoveSupplySchedule removes the supply schedule for a marker
+func (k Keeper) RemoveSupplySchedule(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.SupplyScheduleKey(markerAddr))
+}
+
+// IterateSupplySchedules iterates all marker supply schedules
+func (k Keeper) IterateSupplySchedules(ctx sdk.Context, handler func(schedule types.SupplySchedule) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.SupplySchedulePrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var schedule types.SupplySchedule
+		err := k.cdc.Unmarshal(it.Value(), &schedule)
+		if err != nil {
+			return err
+		} else if handler(schedule) {
+			break
+		}
+	}
+	return nil
+}
+
+// ProcessSupplySchedules executes all supply schedule steps that are due as of the current block.
+// Each executed step is removed from its schedule. A step that fails is also removed (so that it
+// isn't retried every block) and an EventSupplyScheduleStepFailed is emitted for it.
+func (k Keeper) ProcessSupplySchedules(ctx sdk.Context) {
+	var schedules []types.SupplySchedule
+	err := k.IterateSupplySchedules(ctx, func(schedule types.SupplySchedule) bool {
+		schedules = append(schedules, schedule)
+		return false
+	})
+	if err != nil {
+		k.Logger(ctx).Error("could not read supply schedules", "error", err)
+		return
+	}
+
+	for _, schedule := range schedules {
+		var remaining []types.SupplyScheduleStep
+		for _, step := range schedule.Steps {
+			if !step.IsDue(ctx.BlockHeight(), ctx.BlockTime()) {
+				remaining = append(remaining, step)
+				continue
+			}
+			k.executeSupplyScheduleStep(ctx, schedule.Denom, step)
+		}
+
+		if len(remaining) == len(schedule.Steps) {
+			continue
+		}
+		markerAddr := types.MustGetMarkerAddress(schedule.Denom)
+		schedule.Steps = remaining
+		if err = k.SetSupplySchedule(ctx, markerAddr, schedule); err != nil {
+			k.Logger(ctx).Error("could not update supply schedule", "denom", schedule.Denom, "error", err)
+		}
+	}
+}
+
+// executeSupplyScheduleStep applies a single supply schedule step to a marker, emitting either an
+// executed or failed event. State changes are only kept if the step is successful.
+func (k Keeper) executeSupplyScheduleStep(ctx sdk.Context, denom string, step types.SupplyScheduleStep) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	err := k.applySupplyScheduleStep(cacheCtx, denom, step)
+	if err != nil {
+		k.Logger(ctx).Error("supply schedule step failed",
+			"denom", denom, "action", step.Action.String(), "amount", step.Amount.String(), "error", err)
+		if err = ctx.EventManager().EmitTypedEvent(types.NewEventSupplyScheduleStepFailed(denom, step, err)); err != nil {
+			k.Logger(ctx).Error("could not emit supply schedule step failed event", "denom", denom, "error", err)
+		}
+		return
+	}
+
+	writeCache()
+	k.Logger(ctx).Info("supply schedule step executed",
+		"denom", denom, "action", step.Action.String(), "amount", step.Amount.String())
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventSupplyScheduleStepExecuted(denom, step)); err != nil {
+		k.Logger(ctx).Error("could not emit supply schedule step executed event", "denom", denom, "error", err)
+	}
+}
+
+// applySupplyScheduleStep mints or burns the coins defined in a supply schedule step.
+func (k Keeper) applySupplyScheduleStep(ctx sdk.Context, denom string, step types.SupplyScheduleStep) error {
+	marker, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return err
+	}
+	if marker.GetStatus() != types.StatusActive {
+		return fmt.Errorf("marker %s is not active", denom)
+	}
+
+	coin := sdk.NewCoin(denom, step.Amount)
+	switch step.Action {
+	case types.SupplyScheduleAction_Mint:
+		if err = k.IncreaseSupply(ctx, marker, coin); err != nil {
+			return err
+		}
+		if len(step.Recipient) > 0 {
+			recipient, err := sdk.AccAddressFromBech32(step.Recipient)
+			if err != nil {
+				return err
+			}
+			return k.bankKeeper.SendCoins(types.WithBypass(ctx), marker.GetAddress(), recipient, sdk.NewCoins(coin))
+		}
+		return nil
+	case types.SupplyScheduleAction_Burn:
+		return k.DecreaseSupply(ctx, marker, coin)
+	default:
+		return fmt.Errorf("unknown supply schedule action: %s", step.Action)
+	}
+}
@@ -0,0 +1,149 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// newSupplyScheduleMarker creates and stores a governance controlled marker for supply schedule tests.
+// Active markers are given a supply of 1000 held in escrow.
+func newSupplyScheduleMarker(t *testing.T, app *simapp.App, ctx sdk.Context, denom string, status types.MarkerStatus) *types.MarkerAccount {
+	marker := types.NewMarkerAccount(
+		authtypes.NewBaseAccountWithAddress(types.MustGetMarkerAddress(denom)),
+		sdk.NewInt64Coin(denom, 1000),
+		testUserAddress("manager"),
+		[]types.AccessGrant{{Address: testUserAddress("manager").String(), Permissions: types.AccessList{types.Access_Admin}}},
+		status,
+		types.MarkerType_Coin,
+		false,
+		true,
+		false,
+		[]string{},
+	)
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, marker), "AddMarkerAccount(%s)", denom)
+	if status == types.StatusActive {
+		require.NoError(t, app.MarkerKeeper.IncreaseSupply(ctx, marker, sdk.NewInt64Coin(denom, 1000)), "IncreaseSupply(%s)", denom)
+	}
+	return marker
+}
+
+func TestSupplyScheduleGetSetRemove(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	addr1 := types.MustGetMarkerAddress("sched1")
+	addr2 := types.MustGetMarkerAddress("sched2")
+	when := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	schedule1 := types.NewSupplySchedule("sched1",
+		types.SupplyScheduleStep{Action: types.SupplyScheduleAction_Mint, Amount: sdkmath.NewInt(10), Height: 100},
+		types.SupplyScheduleStep{Action: types.SupplyScheduleAction_Burn, Amount: sdkmath.NewInt(5), Time: &when},
+	)
+	schedule2 := types.NewSupplySchedule("sched2",
+		types.SupplyScheduleStep{Action: types.SupplyScheduleAction_Mint, Amount: sdkmath.NewInt(7), Height: 200},
+	)
+
+	schedule, err := app.MarkerKeeper.GetSupplySchedule(ctx, addr1)
+	require.NoError(t, err, "GetSupplySchedule before set")
+	assert.Nil(t, schedule, "GetSupplySchedule before set")
+
+	err = app.MarkerKeeper.SetSupplySchedule(ctx, addr1, types.NewSupplySchedule("sched1",
+		types.SupplyScheduleStep{Action: types.SupplyScheduleAction_Mint, Amount: sdkmath.NewInt(10)}))
+	require.EqualError(t, err, "invalid supply schedule step 0 for sched1: either height or time must be set", "SetSupplySchedule invalid")
+
+	require.NoError(t, app.MarkerKeeper.SetSupplySchedule(ctx, addr1, schedule1), "SetSupplySchedule(sched1)")
+	require.NoError(t, app.MarkerKeeper.SetSupplySchedule(ctx, addr2, schedule2), "SetSupplySchedule(sched2)")
+
+	schedule, err = app.MarkerKeeper.GetSupplySchedule(ctx, addr1)
+	require.NoError(t, err, "GetSupplySchedule(sched1)")
+	assert.Equal(t, &schedule1, schedule, "GetSupplySchedule(sched1)")
+
+	var all []types.SupplySchedule
+	require.NoError(t, app.MarkerKeeper.IterateSupplySchedules(ctx, func(s types.SupplySchedule) bool {
+		all = append(all, s)
+		return false
+	}), "IterateSupplySchedules")
+	assert.Len(t, all, 2, "IterateSupplySchedules")
+
+	// Setting a schedule without any steps removes it.
+	require.NoError(t, app.MarkerKeeper.SetSupplySchedule(ctx, addr1, types.NewSupplySchedule("sched1")), "SetSupplySchedule(sched1) no steps")
+	schedule, err = app.MarkerKeeper.GetSupplySchedule(ctx, addr1)
+	require.NoError(t, err, "GetSupplySchedule(sched1) after removal")
+	assert.Nil(t, schedule, "GetSupplySchedule(sched1) after removal")
+
+	app.MarkerKeeper.RemoveSupplySchedule(ctx, addr2)
+	schedule, err = app.MarkerKeeper.GetSupplySchedule(ctx, addr2)
+	require.NoError(t, err, "GetSupplySchedule(sched2) after RemoveSupplySchedule")
+	assert.Nil(t, schedule, "GetSupplySchedule(sched2) after RemoveSupplySchedule")
+}
+
+func TestProcessSupplySchedules(t *testing.T) {
+	app := simapp.Setup(t)
+	blockTime := time.Date(2030, 6, 1, 12, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContextLegacy(false, cmtproto.Header{Height: 50, Time: blockTime})
+
+	recipient := testUserAddress("recipient")
+	newSupplyScheduleMarker(t, app, ctx, "emitcoin", types.StatusActive)
+	newSupplyScheduleMarker(t, app, ctx, "pendingcoin", types.StatusFinalized)
+	emitAddr := types.MustGetMarkerAddress("emitcoin")
+	pendingAddr := types.MustGetMarkerAddress("pendingcoin")
+
+	past := blockTime.Add(-time.Hour)
+	future := blockTime.Add(time.Hour)
+	mintStep := types.SupplyScheduleStep{Action: types.SupplyScheduleAction_Mint, Amount: sdkmath.NewInt(300), Height: 50, Recipient: recipient.String()}
+	burnStep := types.SupplyScheduleStep{Action: types.SupplyScheduleAction_Burn, Amount: sdkmath.NewInt(100), Time: &past}
+	badBurnStep := types.SupplyScheduleStep{Action: types.SupplyScheduleAction_Burn, Amount: sdkmath.NewInt(5000), Height: 10}
+	laterHeightStep := types.SupplyScheduleStep{Action: types.SupplyScheduleAction_Mint, Amount: sdkmath.NewInt(1), Height: 51}
+	laterTimeStep := types.SupplyScheduleStep{Action: types.SupplyScheduleAction_Burn, Amount: sdkmath.NewInt(1), Time: &future}
+	pendingStep := types.SupplyScheduleStep{Action: types.SupplyScheduleAction_Mint, Amount: sdkmath.NewInt(1), Height: 1}
+
+	require.NoError(t, app.MarkerKeeper.SetSupplySchedule(ctx, emitAddr,
+		types.NewSupplySchedule("emitcoin", laterHeightStep, mintStep, burnStep, badBurnStep, laterTimeStep)), "SetSupplySchedule(emitcoin)")
+	require.NoError(t, app.MarkerKeeper.SetSupplySchedule(ctx, pendingAddr,
+		types.NewSupplySchedule("pendingcoin", pendingStep)), "SetSupplySchedule(pendingcoin)")
+
+	em := sdk.NewEventManager()
+	ctx = ctx.WithEventManager(em)
+	require.NotPanics(t, func() { app.MarkerKeeper.ProcessSupplySchedules(ctx) }, "ProcessSupplySchedules")
+
+	// The mint adds 300 that go to the recipient, the burn removes 100 from escrow, and the bad burn does nothing.
+	assert.Equal(t, "1200", app.BankKeeper.GetSupply(ctx, "emitcoin").Amount.String(), "emitcoin supply")
+	assert.Equal(t, "900", app.BankKeeper.GetBalance(ctx, emitAddr, "emitcoin").Amount.String(), "emitcoin escrow")
+	assert.Equal(t, "300", app.BankKeeper.GetBalance(ctx, recipient, "emitcoin").Amount.String(), "recipient balance")
+
+	schedule, err := app.MarkerKeeper.GetSupplySchedule(ctx, emitAddr)
+	require.NoError(t, err, "GetSupplySchedule(emitcoin)")
+	if assert.NotNil(t, schedule, "GetSupplySchedule(emitcoin)") {
+		assert.Equal(t, []types.SupplyScheduleStep{laterHeightStep, laterTimeStep}, schedule.Steps, "emitcoin remaining steps")
+	}
+	// The pendingcoin step fails because the marker isn't active. It is still removed, leaving no schedule.
+	schedule, err = app.MarkerKeeper.GetSupplySchedule(ctx, pendingAddr)
+	require.NoError(t, err, "GetSupplySchedule(pendingcoin)")
+	assert.Nil(t, schedule, "GetSupplySchedule(pendingcoin)")
+
+	expEvents := []proto.Message{
+		types.NewEventSupplyScheduleStepExecuted("emitcoin", mintStep),
+		types.NewEventSupplyScheduleStepExecuted("emitcoin", burnStep),
+		types.NewEventSupplyScheduleStepFailed("emitcoin", badBurnStep, errors.New("cannot reduce marker total supply below zero emitcoin, 5000")),
+		types.NewEventSupplyScheduleStepFailed("pendingcoin", pendingStep, errors.New("marker pendingcoin is not active")),
+	}
+	for _, exp := range expEvents {
+		expEvent, err := sdk.TypedEventToEvent(exp)
+		require.NoError(t, err, "TypedEventToEvent(%T)", exp)
+		assert.Contains(t, em.Events(), expEvent, "emitted events")
+	}
+}
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetTransferFee gets the transfer fee for a marker. Returns nil if the marker does not have one.
+func (k Keeper) GetTransferFee(ctx sdk.Context, markerAddr sdk.AccAddress) (*types.TransferFee, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TransferFeeKey(markerAddr))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+
+	var fee types.TransferFee
+	if err := k.cdc.Unmarshal(bz, &fee); err != nil {
+		return nil, fmt.Errorf("could not read transfer fee for marker %s: %w", markerAddr, err)
+	}
+	return &fee, nil
+}
+
+// SetTransferFee stores the transfer fee for a marker.
+func (k Keeper) SetTransferFee(ctx sdk.Context, markerAddr sdk.AccAddress, fee types.TransferFee) error {
+	if err := fee.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&fee)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.TransferFeeKey(markerAddr), bz)
+	return nil
+}
+
+// RemoveTransferFee removes the transfer fee for a marker
+func (k Keeper) RemoveTransferFee(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.TransferFeeKey(markerAddr))
+}
+
+// IterateTransferFees iterates all marker transfer fees
+func (k Keeper) IterateTransferFees(ctx sdk.Context, handler func(fee types.TransferFee) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.TransferFeePrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var fee types.TransferFee
+		err := k.cdc.Unmarshal(it.Value(), &fee)
+		if err != nil {
+			return err
+		} else if handler(fee) {
+			break
+		}
+	}
+	return nil
+}
+
+// collectTransferFees sends the transfer fee owed on each coin of a send from the fromAddr to the fee's recipient.
+// Sends to or from the recipient and sends to or from a marker account (deposits and withdrawals) are not charged.
+// Sends to or from a required-attribute bypass address (e.g. the exchange's payment escrow) are also not charged,
+// since those accounts only hold funds on someone else's behalf.
+func (k Keeper) collectTransferFees(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins, fromMarker, toMarker types.MarkerAccountI) error {
+	if fromMarker != nil || toMarker != nil {
+		return nil
+	}
+	if k.IsReqAttrBypassAddr(fromAddr) || k.IsReqAttrBypassAddr(toAddr) {
+		return nil
+	}
+
+	for _, coin := range amt {
+		fee, err := k.GetTransferFee(ctx, types.MustGetMarkerAddress(coin.Denom))
+		if err != nil {
+			return err
+		}
+		if fee == nil || fee.Recipient == fromAddr.String() || fee.Recipient == toAddr.String() {
+			continue
+		}
+
+		feeAmt := fee.CalculateFee(coin.Amount)
+		if !feeAmt.IsPositive() {
+			continue
+		}
+
+		recipient, err := sdk.AccAddressFromBech32(fee.Recipient)
+		if err != nil {
+			return fmt.Errorf("invalid transfer fee recipient for %s: %w", coin.Denom, err)
+		}
+		feeCoins := sdk.NewCoins(sdk.NewCoin(coin.Denom, feeAmt))
+		if err = k.bankKeeper.SendCoins(types.WithBypass(ctx), fromAddr, recipient, feeCoins); err != nil {
+			return fmt.Errorf("could not collect %s transfer fee from %s: %w", feeCoins, fromAddr, err)
+		}
+		if err = ctx.EventManager().EmitTypedEvent(types.NewEventMarkerTransferFeeCollected(coin.Denom, feeAmt.String(), fromAddr.String(), fee.Recipient)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,182 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/exchange"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestTransferFeeGetSetRemove(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	addr1 := types.MustGetMarkerAddress("feecoin1")
+	addr2 := types.MustGetMarkerAddress("feecoin2")
+	recipient := testUserAddress("treasury").String()
+	fee1 := types.NewTransferFee("feecoin1", 25, recipient)
+	fee2 := types.NewTransferFee("feecoin2", 100, recipient)
+
+	fee, err := app.MarkerKeeper.GetTransferFee(ctx, addr1)
+	require.NoError(t, err, "GetTransferFee before set")
+	assert.Nil(t, fee, "GetTransferFee before set")
+
+	err = app.MarkerKeeper.SetTransferFee(ctx, addr1, types.NewTransferFee("feecoin1", 25, ""))
+	require.EqualError(t, err, "invalid transfer fee recipient: empty address string is not allowed", "SetTransferFee invalid")
+
+	require.NoError(t, app.MarkerKeeper.SetTransferFee(ctx, addr1, fee1), "SetTransferFee(feecoin1)")
+	require.NoError(t, app.MarkerKeeper.SetTransferFee(ctx, addr2, fee2), "SetTransferFee(feecoin2)")
+
+	fee, err = app.MarkerKeeper.GetTransferFee(ctx, addr1)
+	require.NoError(t, err, "GetTransferFee(feecoin1)")
+	assert.Equal(t, &fee1, fee, "GetTransferFee(feecoin1)")
+
+	var all []types.TransferFee
+	require.NoError(t, app.MarkerKeeper.IterateTransferFees(ctx, func(f types.TransferFee) bool {
+		all = append(all, f)
+		return false
+	}), "IterateTransferFees")
+	assert.ElementsMatch(t, []types.TransferFee{fee1, fee2}, all, "IterateTransferFees")
+
+	app.MarkerKeeper.RemoveTransferFee(ctx, addr1)
+	fee, err = app.MarkerKeeper.GetTransferFee(ctx, addr1)
+	require.NoError(t, err, "GetTransferFee(feecoin1) after remove")
+	assert.Nil(t, fee, "GetTransferFee(feecoin1) after remove")
+}
+
+func TestTransferFeeCollection(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	denom := "taxedcoin"
+	admin := testUserAddress("feeAdmin")
+	holder := testUserAddress("feeHolder")
+	other := testUserAddress("feeOther")
+	treasury := testUserAddress("feeTreasury")
+	escrow := exchange.GetPaymentEscrowAddress()
+	for _, addr := range []sdk.AccAddress{admin, holder, other, treasury} {
+		app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr))
+	}
+
+	markerAddr := types.MustGetMarkerAddress(denom)
+	mac := types.NewMarkerAccount(
+		authtypes.NewBaseAccount(markerAddr, nil, 0, 0),
+		sdk.NewInt64Coin(denom, 10_000),
+		admin,
+		[]types.AccessGrant{{Address: admin.String(), Permissions: []types.Access{types.Access_Mint, types.Access_Withdraw, types.Access_Admin}}},
+		types.StatusProposed,
+		types.MarkerType_Coin,
+		true,
+		true,
+		false,
+		[]string{},
+	)
+	require.NoError(t, app.MarkerKeeper.SetNetAssetValue(ctx, mac, types.NewNetAssetValue(sdk.NewInt64Coin(types.UsdDenom, 1), 1), "test"), "SetNetAssetValue")
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, mac), "AddFinalizeAndActivateMarker")
+	require.NoError(t, app.MarkerKeeper.SetTransferFee(ctx, markerAddr, types.NewTransferFee(denom, 100, treasury.String())), "SetTransferFee")
+
+	// Withdrawals from the marker account are not charged a fee.
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, admin, holder, denom, sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))), "WithdrawCoins")
+
+	balances := func() []int64 {
+		var rv []int64
+		for _, addr := range []sdk.AccAddress{holder, other, treasury} {
+			rv = append(rv, app.BankKeeper.GetBalance(ctx, addr, denom).Amount.Int64())
+		}
+		return rv
+	}
+	assert.Equal(t, []int64{1000, 0, 0}, balances(), "holder, other, treasury balances after withdraw")
+
+	tests := []struct {
+		name        string
+		from        sdk.AccAddress
+		to          sdk.AccAddress
+		amount      int64
+		expErr      string
+		expBalances []int64
+		expFee      int64
+	}{
+		{
+			name:        "fee collected from sender",
+			from:        holder,
+			to:          other,
+			amount:      500,
+			expBalances: []int64{495, 500, 5},
+			expFee:      5,
+		},
+		{
+			name:        "fee rounds down to zero",
+			from:        other,
+			to:          holder,
+			amount:      99,
+			expBalances: []int64{594, 401, 5},
+		},
+		{
+			name:        "no fee when sending to recipient",
+			from:        holder,
+			to:          treasury,
+			amount:      100,
+			expBalances: []int64{494, 401, 105},
+		},
+		{
+			name:        "no fee when sending from recipient",
+			from:        treasury,
+			to:          other,
+			amount:      100,
+			expBalances: []int64{494, 501, 5},
+		},
+		{
+			name:        "no fee when sending to a bypass address",
+			from:        holder,
+			to:          escrow,
+			amount:      100,
+			expBalances: []int64{394, 501, 5},
+		},
+		{
+			name:        "no fee when sending from a bypass address",
+			from:        escrow,
+			to:          other,
+			amount:      100,
+			expBalances: []int64{394, 601, 5},
+		},
+		{
+			name:        "insufficient funds for fee",
+			from:        holder,
+			to:          other,
+			amount:      394,
+			expErr:      "could not collect 3" + denom + " transfer fee from " + holder.String() + ": spendable balance 0" + denom + " is smaller than 3" + denom + ": insufficient funds",
+			expBalances: []int64{394, 601, 5},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			em := sdk.NewEventManager()
+			cacheCtx, writeCache := ctx.WithEventManager(em).CacheContext()
+			err := app.BankKeeper.SendCoins(cacheCtx, tc.from, tc.to, sdk.NewCoins(sdk.NewInt64Coin(denom, tc.amount)))
+			if len(tc.expErr) > 0 {
+				assert.ErrorContains(t, err, tc.expErr, "SendCoins error")
+			} else if assert.NoError(t, err, "SendCoins error") {
+				writeCache()
+			}
+			assert.Equal(t, tc.expBalances, balances(), "holder, other, treasury balances")
+
+			expEvent, err := sdk.TypedEventToEvent(types.NewEventMarkerTransferFeeCollected(denom, sdkmath.NewInt(tc.expFee).String(), tc.from.String(), treasury.String()))
+			require.NoError(t, err, "TypedEventToEvent")
+			if tc.expFee > 0 {
+				assert.Contains(t, em.Events(), expEvent, "emitted events")
+			} else {
+				assert.NotContains(t, em.Events(), expEvent, "emitted events")
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetVelocityLimit gets the velocity limit for a marker. Returns nil if the marker does not have one.
+func (k Keeper) GetVelocityLimit(ctx sdk.Context, markerAddr sdk.AccAddress) (*types.VelocityLimit, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.VelocityLimitKey(markerAddr))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+
+	var limit types.VelocityLimit
+	if err := k.cdc.Unmarshal(bz, &limit); err != nil {
+		return nil, fmt.Errorf("could not read velocity limit for marker %s: %w", markerAddr, err)
+	}
+	return &limit, nil
+}
+
+// SetVelocityLimit stores the velocity limit for a marker.
+func (k Keeper) SetVelocityLimit(ctx sdk.Context, markerAddr sdk.AccAddress, limit types.VelocityLimit) error {
+	if err := limit.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&limit)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.VelocityLimitKey(markerAddr), bz)
+	return nil
+}
+
+// RemoveVelocityLimit removes the velocity limit for a marker along with all of its buckets.
+func (k Keeper) RemoveVelocityLimit(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.VelocityLimitKey(markerAddr))
+	k.ClearVelocityBuckets(ctx, markerAddr)
+}
+
+// IterateVelocityLimits iterates all marker velocity limits
+func (k Keeper) IterateVelocityLimits(ctx sdk.Context, handler func(limit types.VelocityLimit) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.VelocityLimitPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var limit types.VelocityLimit
+		err := k.cdc.Unmarshal(it.Value(), &limit)
+		if err != nil {
+			return err
+		} else if handler(limit) {
+			break
+		}
+	}
+	return nil
+}
+
+// SetVelocityBucketAmount stores the amount an address sent during a velocity limit bucket.
+// If the amount is zero, the bucket is removed instead.
+func (k Keeper) SetVelocityBucketAmount(ctx sdk.Context, markerAddr, addr sdk.AccAddress, bucket uint64, amount sdkmath.Int) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.VelocityBucketKey(markerAddr, addr, bucket)
+	if amount.IsNil() || amount.IsZero() {
+		store.Delete(key)
+		return nil
+	}
+
+	bz, err := amount.Marshal()
+	if err != nil {
+		return err
+	}
+	store.Set(key, bz)
+	return nil
+}
+
+// ClearVelocityBuckets removes all of a marker's velocity limit buckets.
+func (k Keeper) ClearVelocityBuckets(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.VelocityBucketMarkerPrefix(markerAddr))
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// IterateVelocityBuckets iterates all velocity limit buckets.
+func (k Keeper) IterateVelocityBuckets(ctx sdk.Context, handler func(markerAddr, addr sdk.AccAddress, bucket uint64, amount sdkmath.Int) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.VelocityBucketPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var amount sdkmath.Int
+		if err := amount.Unmarshal(it.Value()); err != nil {
+			return err
+		}
+		markerAddr, addr, bucket := types.ParseVelocityBucketKey(it.Key())
+		if handler(markerAddr, addr, bucket, amount) {
+			break
+		}
+	}
+	return nil
+}
+
+// GetAmountSentInWindow returns the total amount an address has sent during the velocity limit window that
+// ends with the current block's bucket.
+func (k Keeper) GetAmountSentInWindow(ctx sdk.Context, markerAddr, addr sdk.AccAddress, limit types.VelocityLimit) (sdkmath.Int, error) {
+	bucket := limit.GetBucket(ctx.BlockTime())
+	start := types.VelocityBucketKey(markerAddr, addr, limit.GetWindowStart(bucket))
+	end := types.VelocityBucketKey(markerAddr, addr, bucket+1)
+
+	store := ctx.KVStore(k.storeKey)
+	it := store.Iterator(start, end)
+	defer it.Close()
+
+	total := sdkmath.ZeroInt()
+	for ; it.Valid(); it.Next() {
+		var amount sdkmath.Int
+		if err := amount.Unmarshal(it.Value()); err != nil {
+			return sdkmath.Int{}, fmt.Errorf("could not read velocity bucket amount: %w", err)
+		}
+		total = total.Add(amount)
+	}
+	return total, nil
+}
+
+// pruneVelocityBuckets removes an address's velocity limit buckets that are older than the current window.
+func (k Keeper) pruneVelocityBuckets(ctx sdk.Context, markerAddr, addr sdk.AccAddress, limit types.VelocityLimit) {
+	store := ctx.KVStore(k.storeKey)
+	windowStart := limit.GetWindowStart(limit.GetBucket(ctx.BlockTime()))
+	it := store.Iterator(types.VelocityBucketAddressPrefix(markerAddr, addr), types.VelocityBucketKey(markerAddr, addr, windowStart))
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// checkVelocityLimits makes sure a send does not put the fromAddr over the velocity limit of any of the coins
+// being sent, then records the send in the current bucket. Sends from marker accounts are not limited.
+func (k Keeper) checkVelocityLimits(ctx sdk.Context, fromAddr sdk.AccAddress, amt sdk.Coins, fromMarker types.MarkerAccountI) error {
+	if fromMarker != nil {
+		return nil
+	}
+
+	for _, coin := range amt {
+		markerAddr := types.MustGetMarkerAddress(coin.Denom)
+		limit, err := k.GetVelocityLimit(ctx, markerAddr)
+		if err != nil {
+			return err
+		}
+		if limit == nil || !coin.Amount.IsPositive() {
+			continue
+		}
+
+		k.pruneVelocityBuckets(ctx, markerAddr, fromAddr, *limit)
+		sent, err := k.GetAmountSentInWindow(ctx, markerAddr, fromAddr, *limit)
+		if err != nil {
+			return err
+		}
+		if sent.Add(coin.Amount).GT(limit.MaxAmount) {
+			return fmt.Errorf("cannot send %s: %s has already sent %s%s of the %s%s allowed every %d seconds",
+				coin, fromAddr, sent, coin.Denom, limit.MaxAmount, coin.Denom, limit.WindowSeconds)
+		}
+
+		bucket := limit.GetBucket(ctx.BlockTime())
+		bucketKey := types.VelocityBucketKey(markerAddr, fromAddr, bucket)
+		bucketAmt := sdkmath.ZeroInt()
+		if bz := ctx.KVStore(k.storeKey).Get(bucketKey); len(bz) > 0 {
+			if err = bucketAmt.Unmarshal(bz); err != nil {
+				return fmt.Errorf("could not read velocity bucket amount: %w", err)
+			}
+		}
+		if err = k.SetVelocityBucketAmount(ctx, markerAddr, fromAddr, bucket, bucketAmt.Add(coin.Amount)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
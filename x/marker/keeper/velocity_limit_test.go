@@ -0,0 +1,206 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestVelocityLimitGetSetRemove(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	addr1 := types.MustGetMarkerAddress("velocitycoin1")
+	addr2 := types.MustGetMarkerAddress("velocitycoin2")
+	holder := testUserAddress("velocityHolder")
+	limit1 := types.NewVelocityLimit("velocitycoin1", sdkmath.NewInt(100), 3600, 60)
+	limit2 := types.NewVelocityLimit("velocitycoin2", sdkmath.NewInt(5000), 86400, 3600)
+
+	limit, err := app.MarkerKeeper.GetVelocityLimit(ctx, addr1)
+	require.NoError(t, err, "GetVelocityLimit before set")
+	assert.Nil(t, limit, "GetVelocityLimit before set")
+
+	err = app.MarkerKeeper.SetVelocityLimit(ctx, addr1, types.NewVelocityLimit("velocitycoin1", sdkmath.NewInt(100), 90, 60))
+	require.EqualError(t, err, "velocity limit window seconds 90 must be a positive multiple of bucket seconds 60", "SetVelocityLimit invalid")
+
+	require.NoError(t, app.MarkerKeeper.SetVelocityLimit(ctx, addr1, limit1), "SetVelocityLimit(velocitycoin1)")
+	require.NoError(t, app.MarkerKeeper.SetVelocityLimit(ctx, addr2, limit2), "SetVelocityLimit(velocitycoin2)")
+
+	limit, err = app.MarkerKeeper.GetVelocityLimit(ctx, addr1)
+	require.NoError(t, err, "GetVelocityLimit(velocitycoin1)")
+	assert.Equal(t, &limit1, limit, "GetVelocityLimit(velocitycoin1)")
+
+	var all []types.VelocityLimit
+	require.NoError(t, app.MarkerKeeper.IterateVelocityLimits(ctx, func(l types.VelocityLimit) bool {
+		all = append(all, l)
+		return false
+	}), "IterateVelocityLimits")
+	assert.ElementsMatch(t, []types.VelocityLimit{limit1, limit2}, all, "IterateVelocityLimits")
+
+	require.NoError(t, app.MarkerKeeper.SetVelocityBucketAmount(ctx, addr1, holder, 5, sdkmath.NewInt(10)), "SetVelocityBucketAmount(addr1)")
+	require.NoError(t, app.MarkerKeeper.SetVelocityBucketAmount(ctx, addr2, holder, 5, sdkmath.NewInt(20)), "SetVelocityBucketAmount(addr2)")
+	getBuckets := func() []types.VelocityBucket {
+		var rv []types.VelocityBucket
+		require.NoError(t, app.MarkerKeeper.IterateVelocityBuckets(ctx, func(markerAddr, addr sdk.AccAddress, bucket uint64, amount sdkmath.Int) bool {
+			rv = append(rv, types.VelocityBucket{MarkerAddress: markerAddr.String(), Address: addr.String(), Bucket: bucket, Amount: amount})
+			return false
+		}), "IterateVelocityBuckets")
+		return rv
+	}
+	assert.Len(t, getBuckets(), 2, "velocity buckets after set")
+
+	app.MarkerKeeper.RemoveVelocityLimit(ctx, addr1)
+	limit, err = app.MarkerKeeper.GetVelocityLimit(ctx, addr1)
+	require.NoError(t, err, "GetVelocityLimit(velocitycoin1) after remove")
+	assert.Nil(t, limit, "GetVelocityLimit(velocitycoin1) after remove")
+	expBuckets := []types.VelocityBucket{{MarkerAddress: addr2.String(), Address: holder.String(), Bucket: 5, Amount: sdkmath.NewInt(20)}}
+	assert.Equal(t, expBuckets, getBuckets(), "velocity buckets after remove")
+
+	require.NoError(t, app.MarkerKeeper.SetVelocityBucketAmount(ctx, addr2, holder, 5, sdkmath.ZeroInt()), "SetVelocityBucketAmount zero")
+	assert.Empty(t, getBuckets(), "velocity buckets after setting zero")
+}
+
+func TestVelocityLimitSends(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	denom := "limitedcoin"
+	admin := testUserAddress("velocityAdmin")
+	holder := testUserAddress("velocitySender")
+	other := testUserAddress("velocityOther")
+	for _, addr := range []sdk.AccAddress{admin, holder, other} {
+		app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr))
+	}
+
+	markerAddr := types.MustGetMarkerAddress(denom)
+	mac := types.NewMarkerAccount(
+		authtypes.NewBaseAccount(markerAddr, nil, 0, 0),
+		sdk.NewInt64Coin(denom, 10_000),
+		admin,
+		[]types.AccessGrant{{Address: admin.String(), Permissions: []types.Access{types.Access_Mint, types.Access_Withdraw, types.Access_Admin}}},
+		types.StatusProposed,
+		types.MarkerType_Coin,
+		true,
+		true,
+		false,
+		[]string{},
+	)
+	require.NoError(t, app.MarkerKeeper.SetNetAssetValue(ctx, mac, types.NewNetAssetValue(sdk.NewInt64Coin(types.UsdDenom, 1), 1), "test"), "SetNetAssetValue")
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, mac), "AddFinalizeAndActivateMarker")
+	// A window of 3 minutes split into 1-minute buckets.
+	limit := types.NewVelocityLimit(denom, sdkmath.NewInt(100), 180, 60)
+	require.NoError(t, app.MarkerKeeper.SetVelocityLimit(ctx, markerAddr, limit), "SetVelocityLimit")
+
+	// Withdrawals from the marker account are not limited.
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, admin, holder, denom, sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))), "WithdrawCoins(holder)")
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, admin, other, denom, sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))), "WithdrawCoins(other)")
+
+	start := time.Unix(1_700_000_040, 0) // Start of bucket 28,333,334.
+	tests := []struct {
+		name    string
+		offset  time.Duration
+		from    sdk.AccAddress
+		amount  int64
+		expErr  string
+		expSent int64
+	}{
+		{
+			name:    "first send",
+			from:    holder,
+			amount:  60,
+			expSent: 60,
+		},
+		{
+			name:    "second send in same bucket",
+			offset:  30 * time.Second,
+			from:    holder,
+			amount:  30,
+			expSent: 90,
+		},
+		{
+			name:    "over limit in next bucket",
+			offset:  time.Minute,
+			from:    holder,
+			amount:  11,
+			expErr:  "cannot send 11" + denom + ": " + holder.String() + " has already sent 90" + denom + " of the 100" + denom + " allowed every 180 seconds",
+			expSent: 90,
+		},
+		{
+			name:    "up to limit in next bucket",
+			offset:  time.Minute,
+			from:    holder,
+			amount:  10,
+			expSent: 100,
+		},
+		{
+			name:    "other address has its own limit",
+			offset:  time.Minute,
+			from:    other,
+			amount:  100,
+			expSent: 100,
+		},
+		{
+			name:    "still over limit in last bucket of window",
+			offset:  2*time.Minute + 59*time.Second,
+			from:    holder,
+			amount:  1,
+			expErr:  "cannot send 1" + denom + ": " + holder.String() + " has already sent 100" + denom + " of the 100" + denom + " allowed every 180 seconds",
+			expSent: 100,
+		},
+		{
+			name:    "first bucket rolls out of window",
+			offset:  3 * time.Minute,
+			from:    holder,
+			amount:  90,
+			expSent: 100,
+		},
+		{
+			name:    "all previous buckets roll out of window",
+			offset:  10 * time.Minute,
+			from:    holder,
+			amount:  100,
+			expSent: 100,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			sendCtx := ctx.WithBlockTime(start.Add(tc.offset))
+			cacheCtx, writeCache := sendCtx.CacheContext()
+			err := app.BankKeeper.SendCoins(cacheCtx, tc.from, admin, sdk.NewCoins(sdk.NewInt64Coin(denom, tc.amount)))
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "SendCoins error")
+			} else if assert.NoError(t, err, "SendCoins error") {
+				writeCache()
+			}
+
+			sent, err := app.MarkerKeeper.GetAmountSentInWindow(sendCtx, markerAddr, tc.from, limit)
+			require.NoError(t, err, "GetAmountSentInWindow")
+			assert.Equal(t, tc.expSent, sent.Int64(), "GetAmountSentInWindow")
+		})
+	}
+
+	// Old buckets are pruned as sends are made, so the holder only has the most recent one left.
+	var buckets []uint64
+	require.NoError(t, app.MarkerKeeper.IterateVelocityBuckets(ctx, func(_, addr sdk.AccAddress, bucket uint64, _ sdkmath.Int) bool {
+		if addr.Equals(holder) {
+			buckets = append(buckets, bucket)
+		}
+		return false
+	}), "IterateVelocityBuckets")
+	assert.Equal(t, []uint64{limit.GetBucket(start.Add(10 * time.Minute))}, buckets, "holder's velocity buckets")
+
+	// Bypass sends are not limited or recorded.
+	err := app.BankKeeper.SendCoins(types.WithBypass(ctx.WithBlockTime(start.Add(10*time.Minute))), holder, other, sdk.NewCoins(sdk.NewInt64Coin(denom, 500)))
+	require.NoError(t, err, "SendCoins with bypass")
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/provenance-io/provenance/x/marker/keeper"
 	"github.com/provenance-io/provenance/x/marker/simulation"
 	"github.com/provenance-io/provenance/x/marker/types"
+	markerv2 "github.com/provenance-io/provenance/x/marker/types/v2"
 )
 
 // type check to ensure the interface is properly implemented
@@ -71,6 +72,9 @@ func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *r
 	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
 		panic(err)
 	}
+	if err := markerv2.RegisterQueryHandlerClient(context.Background(), mux, markerv2.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
 }
 
 // GetTxCmd returns the root tx command for the distribution module.
@@ -144,6 +148,7 @@ func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+	markerv2.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServerV2(am.keeper))
 }
 
 // InitGenesis performs genesis initialization for the account module. It returns no validator updates.
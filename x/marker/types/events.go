@@ -31,6 +31,8 @@ const (
 	EventTelemetryLabelManager string = "manager"
 	// EventTelemetryLabelAdministrator administrator label for telemetry metrics
 	EventTelemetryLabelAdministrator string = "administrator"
+	// EventTelemetryLabelPriceDenom price denom label for telemetry metrics
+	EventTelemetryLabelPriceDenom string = "price_denom"
 	// EventTelemetryKeyBurn burn telemetry metrics key
 	EventTelemetryKeyBurn string = "burn"
 	// EventTelemetryKeyMint mint telemetry metrics key
@@ -41,6 +43,8 @@ const (
 	EventTelemetryKeyIbcTransfer string = "ibctransfer"
 	// EventTelemetryKeyWithdraw withdraw telemetry metrics key
 	EventTelemetryKeyWithdraw string = "withdraw"
+	// EventTelemetryKeyNetAssetValue net asset value telemetry metrics key
+	EventTelemetryKeyNetAssetValue string = "net_asset_value"
 )
 
 func NewEventMarkerAdd(denom string, address string, amount string, status string, manager string, markerType string) *EventMarkerAdd {
@@ -185,10 +189,73 @@ func NewEventSetNetAssetValue(denom string, price sdk.Coin, volume uint64, sourc
 }
 
 // NewEventMarkerParamsUpdated returns a new instance of EventMarkerParamsUpdated
-func NewEventMarkerParamsUpdated(allowGovControl bool, denomRegex string, maxSupply sdkmath.Int) *EventMarkerParamsUpdated {
+func NewEventMarkerParamsUpdated(allowGovControl bool, denomRegex string, maxSupply sdkmath.Int, enableNAVAttestations bool, navMaxDeviationBps uint32) *EventMarkerParamsUpdated {
 	return &EventMarkerParamsUpdated{
-		EnableGovernance:       strconv.FormatBool(allowGovControl),
-		UnrestrictedDenomRegex: denomRegex,
-		MaxSupply:              maxSupply.String(),
+		EnableGovernance:              strconv.FormatBool(allowGovControl),
+		UnrestrictedDenomRegex:        denomRegex,
+		MaxSupply:                     maxSupply.String(),
+		EnableNavAttestations:         strconv.FormatBool(enableNAVAttestations),
+		NavAttestationMaxDeviationBps: strconv.FormatUint(uint64(navMaxDeviationBps), 10),
+	}
+}
+
+// NewEventSupplyScheduleUpdated returns a new instance of EventSupplyScheduleUpdated
+func NewEventSupplyScheduleUpdated(denom string, stepCount int) *EventSupplyScheduleUpdated {
+	return &EventSupplyScheduleUpdated{
+		Denom:     denom,
+		StepCount: strconv.Itoa(stepCount),
+	}
+}
+
+// NewEventSupplyScheduleStepExecuted returns a new instance of EventSupplyScheduleStepExecuted
+func NewEventSupplyScheduleStepExecuted(denom string, step SupplyScheduleStep) *EventSupplyScheduleStepExecuted {
+	return &EventSupplyScheduleStepExecuted{
+		Denom:     denom,
+		Action:    step.Action.String(),
+		Amount:    step.Amount.String(),
+		Recipient: step.Recipient,
+	}
+}
+
+// NewEventSupplyScheduleStepFailed returns a new instance of EventSupplyScheduleStepFailed
+func NewEventSupplyScheduleStepFailed(denom string, step SupplyScheduleStep, err error) *EventSupplyScheduleStepFailed {
+	return &EventSupplyScheduleStepFailed{
+		Denom:  denom,
+		Action: step.Action.String(),
+		Amount: step.Amount.String(),
+		Error:  err.Error(),
+	}
+}
+
+func NewEventMarkerPaused(denom string, administrator string) *EventMarkerPaused {
+	return &EventMarkerPaused{
+		Denom:         denom,
+		Administrator: administrator,
+	}
+}
+
+func NewEventMarkerUnpaused(denom string, administrator string) *EventMarkerUnpaused {
+	return &EventMarkerUnpaused{
+		Denom:         denom,
+		Administrator: administrator,
+	}
+}
+
+func NewEventMarkerTransferFeeCollected(denom string, amount string, fromAddress string, recipient string) *EventMarkerTransferFeeCollected {
+	return &EventMarkerTransferFeeCollected{
+		Denom:       denom,
+		Amount:      amount,
+		FromAddress: fromAddress,
+		Recipient:   recipient,
+	}
+}
+
+func NewEventMarkerHolderSnapshotTaken(snapshot HolderSnapshot, administrator string) *EventMarkerHolderSnapshotTaken {
+	return &EventMarkerHolderSnapshotTaken{
+		Denom:         snapshot.Denom,
+		Height:        snapshot.Height,
+		HolderCount:   snapshot.HolderCount,
+		Total:         snapshot.Total.String(),
+		Administrator: administrator,
 	}
 }
@@ -2,17 +2,42 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(params Params, markers []MarkerAccount, denySendAddresses []DenySendAddress, netAssetValues []MarkerNetAssetValues) *GenesisState {
+func NewGenesisState(
+	params Params,
+	markers []MarkerAccount,
+	denySendAddresses []DenySendAddress,
+	netAssetValues []MarkerNetAssetValues,
+	supplySchedules []SupplySchedule,
+	sendAllowListMarkers []string,
+	sendAllowAddresses []SendAllowAddress,
+	pausedMarkers []string,
+	transferFees []TransferFee,
+	velocityLimits []VelocityLimit,
+	velocityBuckets []VelocityBucket,
+	holderSnapshots []HolderSnapshot,
+	holderSnapshotBalances []HolderSnapshotBalance,
+) *GenesisState {
 	return &GenesisState{
-		Params:            params,
-		Markers:           markers,
-		DenySendAddresses: denySendAddresses,
-		NetAssetValues:    netAssetValues,
+		Params:                 params,
+		Markers:                markers,
+		DenySendAddresses:      denySendAddresses,
+		NetAssetValues:         netAssetValues,
+		SupplySchedules:        supplySchedules,
+		SendAllowListMarkers:   sendAllowListMarkers,
+		SendAllowAddresses:     sendAllowAddresses,
+		PausedMarkers:          pausedMarkers,
+		TransferFees:           transferFees,
+		VelocityLimits:         velocityLimits,
+		VelocityBuckets:        velocityBuckets,
+		HolderSnapshots:        holderSnapshots,
+		HolderSnapshotBalances: holderSnapshotBalances,
 	}
 }
 
@@ -30,13 +55,92 @@ func (state GenesisState) Validate() error {
 			}
 		}
 	}
+	seen := make(map[string]bool)
+	for _, schedule := range state.SupplySchedules {
+		if err := schedule.Validate(); err != nil {
+			return err
+		}
+		if seen[schedule.Denom] {
+			return fmt.Errorf("duplicate supply schedule for %s", schedule.Denom)
+		}
+		seen[schedule.Denom] = true
+	}
+	for _, markerAddress := range state.SendAllowListMarkers {
+		if _, err := sdk.AccAddressFromBech32(markerAddress); err != nil {
+			return fmt.Errorf("invalid send allow list mode marker address %q: %w", markerAddress, err)
+		}
+	}
+	for _, allow := range state.SendAllowAddresses {
+		if _, err := sdk.AccAddressFromBech32(allow.MarkerAddress); err != nil {
+			return fmt.Errorf("invalid send allow list marker address %q: %w", allow.MarkerAddress, err)
+		}
+		if _, err := sdk.AccAddressFromBech32(allow.AllowAddress); err != nil {
+			return fmt.Errorf("invalid send allow list address %q: %w", allow.AllowAddress, err)
+		}
+	}
+	for _, markerAddress := range state.PausedMarkers {
+		if _, err := sdk.AccAddressFromBech32(markerAddress); err != nil {
+			return fmt.Errorf("invalid paused marker address %q: %w", markerAddress, err)
+		}
+	}
+	seen = make(map[string]bool)
+	for _, fee := range state.TransferFees {
+		if err := fee.Validate(); err != nil {
+			return err
+		}
+		if seen[fee.Denom] {
+			return fmt.Errorf("duplicate transfer fee for %s", fee.Denom)
+		}
+		seen[fee.Denom] = true
+	}
+	seen = make(map[string]bool)
+	for _, limit := range state.VelocityLimits {
+		if err := limit.Validate(); err != nil {
+			return err
+		}
+		if seen[limit.Denom] {
+			return fmt.Errorf("duplicate velocity limit for %s", limit.Denom)
+		}
+		seen[limit.Denom] = true
+	}
+	for _, bucket := range state.VelocityBuckets {
+		if _, err := sdk.AccAddressFromBech32(bucket.MarkerAddress); err != nil {
+			return fmt.Errorf("invalid velocity bucket marker address %q: %w", bucket.MarkerAddress, err)
+		}
+		if _, err := sdk.AccAddressFromBech32(bucket.Address); err != nil {
+			return fmt.Errorf("invalid velocity bucket address %q: %w", bucket.Address, err)
+		}
+		if bucket.Amount.IsNil() || !bucket.Amount.IsPositive() {
+			return fmt.Errorf("invalid velocity bucket amount %s: must be positive", bucket.Amount)
+		}
+	}
+	seen = make(map[string]bool)
+	for _, snapshot := range state.HolderSnapshots {
+		if err := snapshot.Validate(); err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%s/%d", snapshot.Denom, snapshot.Height)
+		if seen[key] {
+			return fmt.Errorf("duplicate holder snapshot for %s at height %d", snapshot.Denom, snapshot.Height)
+		}
+		seen[key] = true
+	}
+	for _, balance := range state.HolderSnapshotBalances {
+		if err := balance.Validate(); err != nil {
+			return err
+		}
+		if !seen[fmt.Sprintf("%s/%d", balance.Denom, balance.Height)] {
+			return fmt.Errorf("no holder snapshot for %s at height %d", balance.Denom, balance.Height)
+		}
+	}
 
 	return nil
 }
 
 // DefaultGenesisState returns the initial module genesis state.
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState(DefaultParams(), []MarkerAccount{}, []DenySendAddress{}, []MarkerNetAssetValues{})
+	return NewGenesisState(DefaultParams(), []MarkerAccount{}, []DenySendAddress{}, []MarkerNetAssetValues{}, []SupplySchedule{}, []string{}, []SendAllowAddress{}, []string{}, []TransferFee{}, []VelocityLimit{}, []VelocityBucket{},
+		[]HolderSnapshot{}, []HolderSnapshotBalance{})
 }
 
 // GetGenesisStateFromAppState returns x/marker GenesisState given raw application
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -33,6 +34,24 @@ type GenesisState struct {
 	NetAssetValues []MarkerNetAssetValues `protobuf:"bytes,3,rep,name=net_asset_values,json=netAssetValues,proto3" json:"net_asset_values"`
 	// list of denom based denied send addresses
 	DenySendAddresses []DenySendAddress `protobuf:"bytes,4,rep,name=deny_send_addresses,json=denySendAddresses,proto3" json:"deny_send_addresses"`
+	// list of marker supply schedules
+	SupplySchedules []SupplySchedule `protobuf:"bytes,5,rep,name=supply_schedules,json=supplySchedules,proto3" json:"supply_schedules"`
+	// list of marker addresses that have send allowlist mode on
+	SendAllowListMarkers []string `protobuf:"bytes,6,rep,name=send_allow_list_markers,json=sendAllowListMarkers,proto3" json:"send_allow_list_markers,omitempty"`
+	// list of denom based allowed send addresses
+	SendAllowAddresses []SendAllowAddress `protobuf:"bytes,7,rep,name=send_allow_addresses,json=sendAllowAddresses,proto3" json:"send_allow_addresses"`
+	// list of marker addresses that are paused
+	PausedMarkers []string `protobuf:"bytes,8,rep,name=paused_markers,json=pausedMarkers,proto3" json:"paused_markers,omitempty"`
+	// list of marker transfer fees
+	TransferFees []TransferFee `protobuf:"bytes,9,rep,name=transfer_fees,json=transferFees,proto3" json:"transfer_fees"`
+	// list of marker velocity limits
+	VelocityLimits []VelocityLimit `protobuf:"bytes,10,rep,name=velocity_limits,json=velocityLimits,proto3" json:"velocity_limits"`
+	// list of amounts sent by addresses within the current velocity limit windows
+	VelocityBuckets []VelocityBucket `protobuf:"bytes,11,rep,name=velocity_buckets,json=velocityBuckets,proto3" json:"velocity_buckets"`
+	// list of snapshots that have been taken of marker holders
+	HolderSnapshots []HolderSnapshot `protobuf:"bytes,12,rep,name=holder_snapshots,json=holderSnapshots,proto3" json:"holder_snapshots"`
+	// list of balances recorded in the holder snapshots
+	HolderSnapshotBalances []HolderSnapshotBalance `protobuf:"bytes,13,rep,name=holder_snapshot_balances,json=holderSnapshotBalances,proto3" json:"holder_snapshot_balances"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -109,6 +128,137 @@ func (m *DenySendAddress) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DenySendAddress proto.InternalMessageInfo
 
+// SendAllowAddress defines addresses that are allowed to receive a marker denom while allowlist mode is on
+type SendAllowAddress struct {
+	// marker_address is the marker's address for allowed address
+	MarkerAddress string `protobuf:"bytes,1,opt,name=marker_address,json=markerAddress,proto3" json:"marker_address,omitempty"`
+	// allow_address is a wallet address that is allowed to receive the marker's denom
+	AllowAddress string `protobuf:"bytes,2,opt,name=allow_address,json=allowAddress,proto3" json:"allow_address,omitempty"`
+}
+
+func (m *SendAllowAddress) Reset()         { *m = SendAllowAddress{} }
+func (m *SendAllowAddress) String() string { return proto.CompactTextString(m) }
+func (*SendAllowAddress) ProtoMessage()    {}
+func (*SendAllowAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5dcc4ab7c9d2f78f, []int{2}
+}
+func (m *SendAllowAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SendAllowAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SendAllowAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SendAllowAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendAllowAddress.Merge(m, src)
+}
+func (m *SendAllowAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *SendAllowAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendAllowAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendAllowAddress proto.InternalMessageInfo
+
+// VelocityBucket defines the amount of a marker denom an address sent during a velocity limit bucket
+type VelocityBucket struct {
+	// marker_address is the marker's address for the bucket
+	MarkerAddress string `protobuf:"bytes,1,opt,name=marker_address,json=markerAddress,proto3" json:"marker_address,omitempty"`
+	// address is the address that sent the amount
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// bucket is the index of the bucket, i.e. the bucket's start time (in unix seconds) divided by its length
+	Bucket uint64 `protobuf:"varint,3,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// amount is the amount of the marker's denom sent by the address during the bucket
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *VelocityBucket) Reset()         { *m = VelocityBucket{} }
+func (m *VelocityBucket) String() string { return proto.CompactTextString(m) }
+func (*VelocityBucket) ProtoMessage()    {}
+func (*VelocityBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5dcc4ab7c9d2f78f, []int{3}
+}
+func (m *VelocityBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VelocityBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VelocityBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VelocityBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VelocityBucket.Merge(m, src)
+}
+func (m *VelocityBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *VelocityBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_VelocityBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VelocityBucket proto.InternalMessageInfo
+
+// HolderSnapshotBalance defines the balance of an address recorded in a holder snapshot
+type HolderSnapshotBalance struct {
+	// denom is the marker denom the snapshot was taken of
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// height is the block height the snapshot was taken at
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// address is the address that held the denom
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// amount is the balance of the marker's denom that the address held
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *HolderSnapshotBalance) Reset()         { *m = HolderSnapshotBalance{} }
+func (m *HolderSnapshotBalance) String() string { return proto.CompactTextString(m) }
+func (*HolderSnapshotBalance) ProtoMessage()    {}
+func (*HolderSnapshotBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5dcc4ab7c9d2f78f, []int{4}
+}
+func (m *HolderSnapshotBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HolderSnapshotBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HolderSnapshotBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HolderSnapshotBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HolderSnapshotBalance.Merge(m, src)
+}
+func (m *HolderSnapshotBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *HolderSnapshotBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_HolderSnapshotBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HolderSnapshotBalance proto.InternalMessageInfo
+
 // MarkerNetAssetValues defines the net asset values for a marker
 type MarkerNetAssetValues struct {
 	// address defines the marker address
@@ -121,7 +271,7 @@ func (m *MarkerNetAssetValues) Reset()         { *m = MarkerNetAssetValues{} }
 func (m *MarkerNetAssetValues) String() string { return proto.CompactTextString(m) }
 func (*MarkerNetAssetValues) ProtoMessage()    {}
 func (*MarkerNetAssetValues) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5dcc4ab7c9d2f78f, []int{2}
+	return fileDescriptor_5dcc4ab7c9d2f78f, []int{5}
 }
 func (m *MarkerNetAssetValues) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -153,6 +303,9 @@ var xxx_messageInfo_MarkerNetAssetValues proto.InternalMessageInfo
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "provenance.marker.v1.GenesisState")
 	proto.RegisterType((*DenySendAddress)(nil), "provenance.marker.v1.DenySendAddress")
+	proto.RegisterType((*SendAllowAddress)(nil), "provenance.marker.v1.SendAllowAddress")
+	proto.RegisterType((*VelocityBucket)(nil), "provenance.marker.v1.VelocityBucket")
+	proto.RegisterType((*HolderSnapshotBalance)(nil), "provenance.marker.v1.HolderSnapshotBalance")
 	proto.RegisterType((*MarkerNetAssetValues)(nil), "provenance.marker.v1.MarkerNetAssetValues")
 }
 
@@ -161,33 +314,56 @@ func init() {
 }
 
 var fileDescriptor_5dcc4ab7c9d2f78f = []byte{
-	// 406 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcd, 0xaa, 0xda, 0x40,
-	0x18, 0x86, 0x13, 0x15, 0x6d, 0x47, 0x6b, 0xdb, 0xa9, 0xd0, 0x20, 0x25, 0xfe, 0x14, 0x41, 0x0a,
-	0x4d, 0xd0, 0xee, 0xdc, 0x69, 0x0b, 0x5d, 0xb5, 0x88, 0x42, 0x17, 0x76, 0x11, 0xc6, 0xe4, 0x23,
-	0x0d, 0xad, 0x33, 0x21, 0x33, 0x86, 0x7a, 0x07, 0xdd, 0xb5, 0x97, 0xe0, 0xe5, 0xb8, 0x94, 0xae,
-	0xce, 0xea, 0x70, 0xd0, 0xcd, 0xb9, 0x8c, 0x83, 0x93, 0x04, 0xcd, 0x61, 0x70, 0x37, 0xf3, 0xf1,
-	0xbc, 0xcf, 0x3b, 0x99, 0x0c, 0xea, 0x86, 0x11, 0x8b, 0x81, 0x12, 0xea, 0x82, 0xbd, 0x22, 0xd1,
-	0x4f, 0x88, 0xec, 0x78, 0x60, 0xfb, 0x40, 0x81, 0x07, 0xdc, 0x0a, 0x23, 0x26, 0x18, 0x6e, 0x9c,
-	0x19, 0x2b, 0x61, 0xac, 0x78, 0xd0, 0x6c, 0xf8, 0xcc, 0x67, 0x12, 0xb0, 0x4f, 0xab, 0x84, 0x6d,
-	0x76, 0x94, 0xbe, 0x34, 0x25, 0x91, 0xee, 0xff, 0x02, 0xaa, 0x7d, 0x4e, 0x0a, 0xe6, 0x82, 0x08,
-	0xc0, 0x23, 0x54, 0x0e, 0x49, 0x44, 0x56, 0xdc, 0xd0, 0xdb, 0x7a, 0xbf, 0x3a, 0x7c, 0x63, 0xa9,
-	0x0a, 0xad, 0xa9, 0x64, 0x26, 0xa5, 0xdd, 0x6d, 0x4b, 0x9b, 0xa5, 0x09, 0xfc, 0x11, 0x55, 0x12,
-	0x82, 0x1b, 0x85, 0x76, 0xb1, 0x5f, 0x1d, 0xbe, 0x55, 0x87, 0xbf, 0xc8, 0xd5, 0xd8, 0x75, 0xd9,
-	0x9a, 0x8a, 0xd4, 0x91, 0x25, 0xf1, 0x02, 0xbd, 0xa0, 0x20, 0x1c, 0xc2, 0x39, 0x08, 0x27, 0x26,
-	0xbf, 0xd6, 0xc0, 0x8d, 0xa2, 0xb4, 0xbd, 0xbb, 0x66, 0xfb, 0x0a, 0x62, 0x7c, 0x8a, 0x7c, 0x93,
-	0x89, 0x54, 0x5a, 0xa7, 0xb9, 0x29, 0xfe, 0x8e, 0x5e, 0x79, 0x40, 0x37, 0x0e, 0x07, 0xea, 0x39,
-	0xc4, 0xf3, 0x22, 0xe0, 0x1c, 0xb8, 0x51, 0x92, 0xfa, 0x9e, 0x5a, 0xff, 0x09, 0xe8, 0x66, 0x0e,
-	0xd4, 0x1b, 0x27, 0x78, 0x6a, 0x7e, 0xe9, 0xe5, 0xc7, 0xc0, 0x47, 0x4f, 0xfe, 0x6c, 0x5b, 0xda,
-	0xfd, 0xb6, 0xa5, 0x75, 0x01, 0x3d, 0x7f, 0x94, 0xc2, 0x3d, 0x54, 0x4f, 0x94, 0x59, 0xad, 0xbc,
-	0xde, 0xa7, 0xb3, 0x67, 0xc9, 0x34, 0xc3, 0x3a, 0xa8, 0x26, 0x0f, 0x98, 0x41, 0x05, 0x09, 0x55,
-	0x4f, 0xb3, 0x14, 0xb9, 0xa8, 0xf9, 0xab, 0xa3, 0x86, 0xea, 0xe3, 0xb1, 0x81, 0x2a, 0xf9, 0x96,
-	0x6c, 0x8b, 0xe7, 0x8a, 0xcb, 0xbd, 0xfa, 0xab, 0x72, 0x66, 0xf5, 0xad, 0x9e, 0x4f, 0x34, 0xf1,
-	0x77, 0x07, 0x53, 0xdf, 0x1f, 0x4c, 0xfd, 0xee, 0x60, 0xea, 0xff, 0x8e, 0xa6, 0xb6, 0x3f, 0x9a,
-	0xda, 0xcd, 0xd1, 0xd4, 0xd0, 0xeb, 0x80, 0x29, 0x0b, 0xa6, 0xfa, 0x62, 0xe8, 0x07, 0xe2, 0xc7,
-	0x7a, 0x69, 0xb9, 0x6c, 0x65, 0x9f, 0x91, 0xf7, 0x01, 0xbb, 0xd8, 0xd9, 0xbf, 0xb3, 0x07, 0x2c,
-	0x36, 0x21, 0xf0, 0x65, 0x59, 0xbe, 0xde, 0x0f, 0x0f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc6, 0x2d,
-	0x05, 0xd5, 0x32, 0x03, 0x00, 0x00,
+	// 774 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0x41, 0x4f, 0xdb, 0x48,
+	0x14, 0xc7, 0x63, 0x12, 0x02, 0x4c, 0x12, 0x60, 0xbd, 0x01, 0x2c, 0xb4, 0x9b, 0x04, 0x58, 0x56,
+	0xd1, 0xae, 0xd6, 0x11, 0xac, 0xb8, 0x70, 0x4b, 0x76, 0xb5, 0xdb, 0x4a, 0x50, 0xa1, 0xa4, 0xe5,
+	0x40, 0xa5, 0x5a, 0x8e, 0xfd, 0x88, 0xad, 0xd8, 0x1e, 0xcb, 0x6f, 0x92, 0x36, 0xdf, 0xa0, 0xb7,
+	0xf6, 0x23, 0x70, 0xe8, 0xa9, 0x9f, 0x84, 0x23, 0xc7, 0xaa, 0x07, 0x54, 0xc1, 0xa5, 0x1f, 0xa3,
+	0xf2, 0x78, 0x4c, 0xec, 0xc8, 0x8d, 0x90, 0x7a, 0xcb, 0x3c, 0xff, 0xdf, 0xef, 0xff, 0xcf, 0xcc,
+	0xf3, 0x98, 0xec, 0xfa, 0x01, 0x1d, 0x83, 0xa7, 0x7b, 0x06, 0xb4, 0x5c, 0x3d, 0x18, 0x42, 0xd0,
+	0x1a, 0x1f, 0xb4, 0x06, 0xe0, 0x01, 0xda, 0xa8, 0xfa, 0x01, 0x65, 0x54, 0xae, 0x4e, 0x35, 0x6a,
+	0xa4, 0x51, 0xc7, 0x07, 0xdb, 0xd5, 0x01, 0x1d, 0x50, 0x2e, 0x68, 0x85, 0xbf, 0x22, 0xed, 0xf6,
+	0x4e, 0x26, 0x4f, 0x74, 0x71, 0xc9, 0xee, 0x87, 0x65, 0x52, 0xfe, 0x3f, 0x32, 0xe8, 0x31, 0x9d,
+	0x81, 0x7c, 0x4c, 0x8a, 0xbe, 0x1e, 0xe8, 0x2e, 0x2a, 0x52, 0x43, 0x6a, 0x96, 0x0e, 0x7f, 0x51,
+	0xb3, 0x0c, 0xd5, 0x33, 0xae, 0xe9, 0x14, 0xae, 0x6f, 0xeb, 0xb9, 0xae, 0xe8, 0x90, 0xff, 0x21,
+	0x4b, 0x91, 0x02, 0x95, 0x85, 0x46, 0xbe, 0x59, 0x3a, 0xdc, 0xcb, 0x6e, 0x3e, 0xe5, 0xbf, 0xda,
+	0x86, 0x41, 0x47, 0x1e, 0x13, 0x8c, 0xb8, 0x53, 0xbe, 0x20, 0xeb, 0x1e, 0x30, 0x4d, 0x47, 0x04,
+	0xa6, 0x8d, 0x75, 0x67, 0x04, 0xa8, 0xe4, 0x39, 0xed, 0x8f, 0x79, 0xb4, 0x67, 0xc0, 0xda, 0x61,
+	0xcb, 0x39, 0xef, 0x10, 0xd0, 0x55, 0x2f, 0x55, 0x95, 0x5f, 0x92, 0x9f, 0x4d, 0xf0, 0x26, 0x1a,
+	0x82, 0x67, 0x6a, 0xba, 0x69, 0x06, 0x80, 0x08, 0xa8, 0x14, 0x38, 0x7e, 0x3f, 0x1b, 0xff, 0x2f,
+	0x78, 0x93, 0x1e, 0x78, 0x66, 0x3b, 0x92, 0x0b, 0xf2, 0x4f, 0x66, 0xba, 0x0c, 0x28, 0xbf, 0x20,
+	0xeb, 0x38, 0xf2, 0x7d, 0x67, 0xa2, 0xa1, 0x61, 0x81, 0x39, 0x72, 0x00, 0x95, 0x45, 0x4e, 0xfe,
+	0x2d, 0x9b, 0xdc, 0xe3, 0xea, 0x9e, 0x10, 0x0b, 0xf0, 0x1a, 0xa6, 0xaa, 0x28, 0x1f, 0x91, 0xad,
+	0x28, 0xae, 0xe3, 0xd0, 0xd7, 0x9a, 0x63, 0x23, 0xd3, 0xe2, 0x4d, 0x2e, 0x36, 0xf2, 0xcd, 0x95,
+	0x6e, 0x35, 0x7c, 0xdc, 0x0e, 0x9f, 0x9e, 0xd8, 0xc8, 0x4e, 0xc5, 0x36, 0xbe, 0x22, 0xd5, 0x44,
+	0xdb, 0xf4, 0xbf, 0x2e, 0xf1, 0x44, 0xbf, 0x7f, 0x27, 0x51, 0x4c, 0x4a, 0xff, 0x59, 0x19, 0x67,
+	0xea, 0x80, 0xf2, 0x3e, 0x59, 0xf5, 0xf5, 0x11, 0x82, 0xf9, 0x90, 0x66, 0x99, 0xa7, 0xa9, 0x44,
+	0xd5, 0x38, 0xc6, 0x09, 0xa9, 0xb0, 0x40, 0xf7, 0xf0, 0x12, 0x02, 0xed, 0x12, 0x00, 0x95, 0x15,
+	0xee, 0xbf, 0x93, 0xed, 0xff, 0x5c, 0x48, 0xff, 0x83, 0x78, 0x3b, 0xca, 0x6c, 0x5a, 0x42, 0xb9,
+	0x4b, 0xd6, 0xc6, 0xe0, 0x50, 0xc3, 0x66, 0x13, 0xcd, 0xb1, 0x5d, 0x9b, 0xa1, 0x42, 0xe6, 0x0d,
+	0xda, 0xb9, 0x10, 0x9f, 0x84, 0xda, 0x78, 0x26, 0xc6, 0xc9, 0x22, 0x3f, 0xb6, 0x07, 0x66, 0x7f,
+	0x64, 0x0c, 0x81, 0xa1, 0x52, 0x9a, 0x77, 0x6c, 0x31, 0xb4, 0xc3, 0xc5, 0xf1, 0xb1, 0x8d, 0x53,
+	0x55, 0x8e, 0xb5, 0xa8, 0x63, 0x42, 0xa0, 0xa1, 0xa7, 0xfb, 0x68, 0x51, 0x86, 0x4a, 0x79, 0x1e,
+	0xf6, 0x09, 0x57, 0xf7, 0x84, 0x38, 0xc6, 0x5a, 0xa9, 0x2a, 0xca, 0x43, 0xa2, 0xcc, 0x60, 0xb5,
+	0xbe, 0xee, 0x84, 0x28, 0x54, 0x2a, 0x1c, 0xff, 0xe7, 0xa3, 0xf0, 0x51, 0x8f, 0x70, 0xd9, 0xb4,
+	0xb2, 0x1e, 0xe2, 0xf1, 0xf2, 0xdb, 0xab, 0x7a, 0xee, 0xeb, 0x55, 0x3d, 0xb7, 0x0b, 0x64, 0x6d,
+	0xe6, 0x3d, 0x08, 0x07, 0x20, 0xa2, 0xc7, 0xc3, 0xc5, 0x2f, 0x8c, 0x95, 0x6e, 0x25, 0xaa, 0xc6,
+	0xb2, 0x1d, 0x52, 0xe6, 0xaf, 0x5c, 0x2c, 0x5a, 0xe0, 0xa2, 0x52, 0x58, 0x13, 0x92, 0x84, 0x8d,
+	0x45, 0xd6, 0x67, 0x47, 0xf0, 0xb1, 0x3e, 0x7b, 0xa4, 0x92, 0x1a, 0x75, 0x61, 0x54, 0xd6, 0x13,
+	0xac, 0x84, 0xd3, 0x47, 0x89, 0xac, 0xa6, 0x0f, 0xf2, 0xb1, 0x46, 0x0a, 0x59, 0x4a, 0x5b, 0xc4,
+	0x4b, 0x79, 0x93, 0x14, 0xa3, 0x01, 0x52, 0xf2, 0x0d, 0xa9, 0x59, 0xe8, 0x8a, 0x95, 0x7c, 0x44,
+	0x8a, 0xba, 0x1b, 0x5e, 0x75, 0x4a, 0x21, 0x6c, 0xe8, 0xfc, 0x1a, 0x6e, 0xfa, 0xe7, 0xdb, 0xfa,
+	0x86, 0x41, 0xd1, 0xa5, 0x88, 0xe6, 0x50, 0xb5, 0x69, 0xcb, 0xd5, 0x99, 0xa5, 0x3e, 0xf5, 0x58,
+	0x57, 0x88, 0x13, 0x61, 0xaf, 0x24, 0xb2, 0x91, 0x79, 0x7e, 0x72, 0x95, 0x2c, 0x9a, 0xe0, 0x51,
+	0x57, 0x44, 0x8d, 0x16, 0x61, 0x10, 0x0b, 0xec, 0x81, 0xc5, 0x78, 0xc2, 0x7c, 0x57, 0xac, 0x92,
+	0xd1, 0xf3, 0xe9, 0xe8, 0x3f, 0x1c, 0xf1, 0x9d, 0x44, 0xaa, 0x59, 0x17, 0x71, 0xd2, 0x53, 0x4a,
+	0x7b, 0xf6, 0x32, 0x2e, 0xfa, 0xb9, 0x9f, 0x8d, 0x14, 0x39, 0xfb, 0x86, 0x9f, 0x26, 0xea, 0x0c,
+	0xae, 0xef, 0x6a, 0xd2, 0xcd, 0x5d, 0x4d, 0xfa, 0x72, 0x57, 0x93, 0xde, 0xdf, 0xd7, 0x72, 0x37,
+	0xf7, 0xb5, 0xdc, 0xa7, 0xfb, 0x5a, 0x8e, 0x6c, 0xd9, 0x34, 0xd3, 0xe0, 0x4c, 0xba, 0x38, 0x1c,
+	0xd8, 0xcc, 0x1a, 0xf5, 0x55, 0x83, 0xba, 0xad, 0xa9, 0xe4, 0x2f, 0x9b, 0x26, 0x56, 0xad, 0x37,
+	0xf1, 0xc7, 0x94, 0x4d, 0x7c, 0xc0, 0x7e, 0x91, 0x7f, 0x49, 0xff, 0xfe, 0x36, 0x00, 0x62, 0x40,
+	0xc2, 0x9a, 0xbe, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -210,6 +386,122 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.HolderSnapshotBalances) > 0 {
+		for iNdEx := len(m.HolderSnapshotBalances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HolderSnapshotBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.HolderSnapshots) > 0 {
+		for iNdEx := len(m.HolderSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HolderSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.VelocityBuckets) > 0 {
+		for iNdEx := len(m.VelocityBuckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VelocityBuckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.VelocityLimits) > 0 {
+		for iNdEx := len(m.VelocityLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VelocityLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.TransferFees) > 0 {
+		for iNdEx := len(m.TransferFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.PausedMarkers) > 0 {
+		for iNdEx := len(m.PausedMarkers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PausedMarkers[iNdEx])
+			copy(dAtA[i:], m.PausedMarkers[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.PausedMarkers[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.SendAllowAddresses) > 0 {
+		for iNdEx := len(m.SendAllowAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendAllowAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.SendAllowListMarkers) > 0 {
+		for iNdEx := len(m.SendAllowListMarkers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SendAllowListMarkers[iNdEx])
+			copy(dAtA[i:], m.SendAllowListMarkers[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.SendAllowListMarkers[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SupplySchedules) > 0 {
+		for iNdEx := len(m.SupplySchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SupplySchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.DenySendAddresses) > 0 {
 		for iNdEx := len(m.DenySendAddresses) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -302,6 +594,147 @@ func (m *DenySendAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SendAllowAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendAllowAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendAllowAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowAddress) > 0 {
+		i -= len(m.AllowAddress)
+		copy(dAtA[i:], m.AllowAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.AllowAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarkerAddress) > 0 {
+		i -= len(m.MarkerAddress)
+		copy(dAtA[i:], m.MarkerAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MarkerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VelocityBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VelocityBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VelocityBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Bucket != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Bucket))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarkerAddress) > 0 {
+		i -= len(m.MarkerAddress)
+		copy(dAtA[i:], m.MarkerAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MarkerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HolderSnapshotBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HolderSnapshotBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HolderSnapshotBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MarkerNetAssetValues) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -383,8 +816,62 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
-	return n
-}
+	if len(m.SupplySchedules) > 0 {
+		for _, e := range m.SupplySchedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SendAllowListMarkers) > 0 {
+		for _, s := range m.SendAllowListMarkers {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SendAllowAddresses) > 0 {
+		for _, e := range m.SendAllowAddresses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PausedMarkers) > 0 {
+		for _, s := range m.PausedMarkers {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TransferFees) > 0 {
+		for _, e := range m.TransferFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VelocityLimits) > 0 {
+		for _, e := range m.VelocityLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VelocityBuckets) > 0 {
+		for _, e := range m.VelocityBuckets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HolderSnapshots) > 0 {
+		for _, e := range m.HolderSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HolderSnapshotBalances) > 0 {
+		for _, e := range m.HolderSnapshotBalances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
 
 func (m *DenySendAddress) Size() (n int) {
 	if m == nil {
@@ -403,6 +890,67 @@ func (m *DenySendAddress) Size() (n int) {
 	return n
 }
 
+func (m *SendAllowAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarkerAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.AllowAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *VelocityBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarkerAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Bucket != 0 {
+		n += 1 + sovGenesis(uint64(m.Bucket))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *HolderSnapshotBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func (m *MarkerNetAssetValues) Size() (n int) {
 	if m == nil {
 		return 0
@@ -592,59 +1140,43 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplySchedules", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DenySendAddress) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.SupplySchedules = append(m.SupplySchedules, SupplySchedule{})
+			if err := m.SupplySchedules[len(m.SupplySchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DenySendAddress: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DenySendAddress: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SendAllowListMarkers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -672,11 +1204,45 @@ func (m *DenySendAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarkerAddress = string(dAtA[iNdEx:postIndex])
+			m.SendAllowListMarkers = append(m.SendAllowListMarkers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenyAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SendAllowAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendAllowAddresses = append(m.SendAllowAddresses, SendAllowAddress{})
+			if err := m.SendAllowAddresses[len(m.SendAllowAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedMarkers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -704,7 +1270,739 @@ func (m *DenySendAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DenyAddress = string(dAtA[iNdEx:postIndex])
+			m.PausedMarkers = append(m.PausedMarkers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferFees = append(m.TransferFees, TransferFee{})
+			if err := m.TransferFees[len(m.TransferFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VelocityLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VelocityLimits = append(m.VelocityLimits, VelocityLimit{})
+			if err := m.VelocityLimits[len(m.VelocityLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VelocityBuckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VelocityBuckets = append(m.VelocityBuckets, VelocityBucket{})
+			if err := m.VelocityBuckets[len(m.VelocityBuckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HolderSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HolderSnapshots = append(m.HolderSnapshots, HolderSnapshot{})
+			if err := m.HolderSnapshots[len(m.HolderSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HolderSnapshotBalances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HolderSnapshotBalances = append(m.HolderSnapshotBalances, HolderSnapshotBalance{})
+			if err := m.HolderSnapshotBalances[len(m.HolderSnapshotBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenySendAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenySendAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenySendAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarkerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenyAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendAllowAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendAllowAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendAllowAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarkerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VelocityBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VelocityBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VelocityBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarkerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+			}
+			m.Bucket = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bucket |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HolderSnapshotBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HolderSnapshotBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HolderSnapshotBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewHolderSnapshot returns a new instance of HolderSnapshot
+func NewHolderSnapshot(denom string, height int64, blockTime time.Time, holderCount uint64, total sdkmath.Int) HolderSnapshot {
+	return HolderSnapshot{
+		Denom:       denom,
+		Height:      height,
+		Time:        blockTime,
+		HolderCount: holderCount,
+		Total:       total,
+	}
+}
+
+// Validate returns an error if this holder snapshot is not valid.
+func (s HolderSnapshot) Validate() error {
+	if err := sdk.ValidateDenom(s.Denom); err != nil {
+		return err
+	}
+	if s.Height <= 0 {
+		return fmt.Errorf("holder snapshot height must be positive, got %d", s.Height)
+	}
+	if s.Total.IsNil() || s.Total.IsNegative() {
+		return fmt.Errorf("holder snapshot total cannot be negative, got %s", s.Total)
+	}
+	return nil
+}
+
+// Validate returns an error if this holder snapshot balance is not valid.
+func (b HolderSnapshotBalance) Validate() error {
+	if err := sdk.ValidateDenom(b.Denom); err != nil {
+		return err
+	}
+	if b.Height <= 0 {
+		return fmt.Errorf("holder snapshot height must be positive, got %d", b.Height)
+	}
+	if _, err := sdk.AccAddressFromBech32(b.Address); err != nil {
+		return fmt.Errorf("invalid holder snapshot address %q: %w", b.Address, err)
+	}
+	if b.Amount.IsNil() || !b.Amount.IsPositive() {
+		return fmt.Errorf("invalid holder snapshot balance %s: must be positive", b.Amount)
+	}
+	return nil
+}
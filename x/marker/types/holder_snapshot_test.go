@@ -0,0 +1,108 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestHolderSnapshotValidate(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0).UTC()
+
+	tests := []struct {
+		name     string
+		snapshot HolderSnapshot
+		expErr   string
+	}{
+		{
+			name:     "valid",
+			snapshot: NewHolderSnapshot("hotdog", 10, now, 3, sdkmath.NewInt(1000)),
+		},
+		{
+			name:     "valid without holders",
+			snapshot: NewHolderSnapshot("hotdog", 10, now, 0, sdkmath.ZeroInt()),
+		},
+		{
+			name:     "invalid denom",
+			snapshot: NewHolderSnapshot("x", 10, now, 3, sdkmath.NewInt(1000)),
+			expErr:   "invalid denom: x",
+		},
+		{
+			name:     "zero height",
+			snapshot: NewHolderSnapshot("hotdog", 0, now, 3, sdkmath.NewInt(1000)),
+			expErr:   "holder snapshot height must be positive, got 0",
+		},
+		{
+			name:     "negative total",
+			snapshot: NewHolderSnapshot("hotdog", 10, now, 3, sdkmath.NewInt(-1)),
+			expErr:   "holder snapshot total cannot be negative, got -1",
+		},
+		{
+			name:     "nil total",
+			snapshot: HolderSnapshot{Denom: "hotdog", Height: 10, Time: now},
+			expErr:   "holder snapshot total cannot be negative, got <nil>",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.snapshot.Validate()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "Validate")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
+
+func TestHolderSnapshotBalanceValidate(t *testing.T) {
+	addr := sdk.AccAddress("holder______________").String()
+
+	tests := []struct {
+		name    string
+		balance HolderSnapshotBalance
+		expErr  string
+	}{
+		{
+			name:    "valid",
+			balance: HolderSnapshotBalance{Denom: "hotdog", Height: 10, Address: addr, Amount: sdkmath.NewInt(5)},
+		},
+		{
+			name:    "invalid denom",
+			balance: HolderSnapshotBalance{Denom: "x", Height: 10, Address: addr, Amount: sdkmath.NewInt(5)},
+			expErr:  "invalid denom: x",
+		},
+		{
+			name:    "negative height",
+			balance: HolderSnapshotBalance{Denom: "hotdog", Height: -1, Address: addr, Amount: sdkmath.NewInt(5)},
+			expErr:  "holder snapshot height must be positive, got -1",
+		},
+		{
+			name:    "invalid address",
+			balance: HolderSnapshotBalance{Denom: "hotdog", Height: 10, Address: "invalid", Amount: sdkmath.NewInt(5)},
+			expErr:  "invalid holder snapshot address \"invalid\": decoding bech32 failed: invalid bech32 string length 7",
+		},
+		{
+			name:    "zero amount",
+			balance: HolderSnapshotBalance{Denom: "hotdog", Height: 10, Address: addr, Amount: sdkmath.ZeroInt()},
+			expErr:  "invalid holder snapshot balance 0: must be positive",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.balance.Validate()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "Validate")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/binary"
 	"fmt"
 
 	"github.com/cometbft/cometbft/crypto"
@@ -35,6 +36,33 @@ var (
 
 	// MarkerParamStoreKey key for marker module's params
 	MarkerParamStoreKey = []byte{0x05}
+
+	// SupplySchedulePrefix prefix for the pending supply schedules of markers
+	SupplySchedulePrefix = []byte{0x06}
+
+	// SendAllowListModePrefix prefix for restricted markers that have send allowlist mode on
+	SendAllowListModePrefix = []byte{0x07}
+
+	// AllowSendKeyPrefix prefix for addresses that are allowed to receive restricted markers in allowlist mode
+	AllowSendKeyPrefix = []byte{0x08}
+
+	// PausedMarkerPrefix prefix for markers whose sends are paused
+	PausedMarkerPrefix = []byte{0x09}
+
+	// TransferFeePrefix prefix for the transfer fees of markers
+	TransferFeePrefix = []byte{0x0A}
+
+	// VelocityLimitPrefix prefix for the velocity limits of markers
+	VelocityLimitPrefix = []byte{0x0B}
+
+	// VelocityBucketPrefix prefix for the amounts sent by addresses in each velocity limit bucket
+	VelocityBucketPrefix = []byte{0x0C}
+
+	// HolderSnapshotPrefix prefix for the snapshots taken of marker holders
+	HolderSnapshotPrefix = []byte{0x0D}
+
+	// HolderSnapshotBalancePrefix prefix for the balances recorded in holder snapshots
+	HolderSnapshotBalancePrefix = []byte{0x0E}
 )
 
 // MarkerAddress returns the module account address for the given denomination
@@ -103,3 +131,109 @@ func GetMarkerFromNetAssetValueKey(key []byte) sdk.AccAddress {
 	markerAddr := sdk.AccAddress(key[2 : markerKeyLen+2])
 	return markerAddr
 }
+
+// SupplyScheduleKey returns key [prefix][marker address] for a marker's supply schedule
+func SupplyScheduleKey(markerAddr sdk.AccAddress) []byte {
+	return append(SupplySchedulePrefix, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// SendAllowListModeKey returns key [prefix][marker address] for whether a marker has send allowlist mode on
+func SendAllowListModeKey(markerAddr sdk.AccAddress) []byte {
+	return append(SendAllowListModePrefix, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// AllowSendKey returns a key [prefix][denom addr][allow addr] for the send allow list for restricted markers
+func AllowSendKey(markerAddr sdk.AccAddress, allowAddr sdk.AccAddress) []byte {
+	key := AllowSendMarkerPrefix(markerAddr)
+	return append(key, address.MustLengthPrefix(allowAddr.Bytes())...)
+}
+
+// GetAllowSendAddresses returns marker and allowed sdk.AccAddress's from AllowSendKey
+func GetAllowSendAddresses(key []byte) (markerAddr sdk.AccAddress, allowAddr sdk.AccAddress) {
+	markerKeyLen := key[1]
+	allowKeyLen := key[markerKeyLen+2]
+	markerAddr = sdk.AccAddress(key[2 : markerKeyLen+2])
+	allowAddr = sdk.AccAddress(key[markerKeyLen+3 : markerKeyLen+3+allowKeyLen])
+	return
+}
+
+// AllowSendMarkerPrefix returns an extended prefix [prefix][denom addr] for the send allow list for restricted markers
+func AllowSendMarkerPrefix(markerAddr sdk.AccAddress) []byte {
+	return append(AllowSendKeyPrefix, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// PausedMarkerKey returns key [prefix][marker address] for whether a marker is paused
+func PausedMarkerKey(markerAddr sdk.AccAddress) []byte {
+	return append(PausedMarkerPrefix, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// TransferFeeKey returns key [prefix][marker address] for a marker's transfer fee
+func TransferFeeKey(markerAddr sdk.AccAddress) []byte {
+	return append(TransferFeePrefix, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// VelocityLimitKey returns key [prefix][marker address] for a marker's velocity limit
+func VelocityLimitKey(markerAddr sdk.AccAddress) []byte {
+	return append(VelocityLimitPrefix, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// VelocityBucketKey returns key [prefix][marker address][address][bucket] for the amount an address sent during a velocity limit bucket
+func VelocityBucketKey(markerAddr, addr sdk.AccAddress, bucket uint64) []byte {
+	return binary.BigEndian.AppendUint64(VelocityBucketAddressPrefix(markerAddr, addr), bucket)
+}
+
+// VelocityBucketAddressPrefix returns an extended prefix [prefix][marker address][address] for an address's velocity limit buckets
+func VelocityBucketAddressPrefix(markerAddr, addr sdk.AccAddress) []byte {
+	key := VelocityBucketMarkerPrefix(markerAddr)
+	return append(key, address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// VelocityBucketMarkerPrefix returns an extended prefix [prefix][marker address] for a marker's velocity limit buckets
+func VelocityBucketMarkerPrefix(markerAddr sdk.AccAddress) []byte {
+	return append(VelocityBucketPrefix, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// ParseVelocityBucketKey returns the marker address, address, and bucket from a VelocityBucketKey
+func ParseVelocityBucketKey(key []byte) (markerAddr, addr sdk.AccAddress, bucket uint64) {
+	markerKeyLen := key[1]
+	addrKeyLen := key[markerKeyLen+2]
+	markerAddr = sdk.AccAddress(key[2 : markerKeyLen+2])
+	addr = sdk.AccAddress(key[markerKeyLen+3 : markerKeyLen+3+addrKeyLen])
+	bucket = binary.BigEndian.Uint64(key[markerKeyLen+3+addrKeyLen:])
+	return
+}
+
+// HolderSnapshotKey returns key [prefix][marker address][height] for a snapshot of a marker's holders
+func HolderSnapshotKey(markerAddr sdk.AccAddress, height int64) []byte {
+	return binary.BigEndian.AppendUint64(HolderSnapshotMarkerPrefix(markerAddr), uint64(height))
+}
+
+// HolderSnapshotMarkerPrefix returns an extended prefix [prefix][marker address] for a marker's holder snapshots
+func HolderSnapshotMarkerPrefix(markerAddr sdk.AccAddress) []byte {
+	return append(HolderSnapshotPrefix, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// HolderSnapshotBalanceKey returns key [prefix][marker address][height][address] for a balance recorded in a holder snapshot
+func HolderSnapshotBalanceKey(markerAddr sdk.AccAddress, height int64, addr sdk.AccAddress) []byte {
+	return append(HolderSnapshotBalancesPrefix(markerAddr, height), address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// HolderSnapshotBalancesPrefix returns an extended prefix [prefix][marker address][height] for the balances of a holder snapshot
+func HolderSnapshotBalancesPrefix(markerAddr sdk.AccAddress, height int64) []byte {
+	return binary.BigEndian.AppendUint64(HolderSnapshotBalanceMarkerPrefix(markerAddr), uint64(height))
+}
+
+// HolderSnapshotBalanceMarkerPrefix returns an extended prefix [prefix][marker address] for the balances of all of a marker's holder snapshots
+func HolderSnapshotBalanceMarkerPrefix(markerAddr sdk.AccAddress) []byte {
+	return append(HolderSnapshotBalancePrefix, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// ParseHolderSnapshotBalanceKey returns the marker address, height, and address from a HolderSnapshotBalanceKey
+func ParseHolderSnapshotBalanceKey(key []byte) (markerAddr sdk.AccAddress, height int64, addr sdk.AccAddress) {
+	markerKeyLen := key[1]
+	markerAddr = sdk.AccAddress(key[2 : markerKeyLen+2])
+	height = int64(binary.BigEndian.Uint64(key[markerKeyLen+2 : markerKeyLen+10]))
+	addrKeyLen := key[markerKeyLen+10]
+	addr = sdk.AccAddress(key[markerKeyLen+11 : markerKeyLen+11+addrKeyLen])
+	return
+}
@@ -71,3 +71,111 @@ func TestDenySendMarkerPrefix(t *testing.T) {
 	assert.Equal(t, uint8(3), denyKey[0], "should have correct prefix for send deny")
 	assert.Equal(t, denyKey[2:], addr.Bytes(), "should have marker address in iterable prefix")
 }
+
+func TestSupplyScheduleKey(t *testing.T) {
+	addr, err := MarkerAddress("nhash")
+	require.NoError(t, err, "MarkerAddress(nhash)")
+	key := SupplyScheduleKey(addr)
+
+	assert.Equal(t, uint8(6), key[0], "should have correct prefix for supply schedule key")
+	assert.Equal(t, uint8(len(addr)), key[1], "should have marker address length")
+	assert.Equal(t, addr.Bytes(), []byte(key[2:]), "should have marker address")
+}
+
+func TestSendAllowListModeKey(t *testing.T) {
+	addr, err := MarkerAddress("nhash")
+	require.NoError(t, err, "MarkerAddress(nhash)")
+	key := SendAllowListModeKey(addr)
+
+	assert.Equal(t, uint8(7), key[0], "should have correct prefix for send allowlist mode key")
+	assert.Equal(t, uint8(len(addr)), key[1], "should have marker address length")
+	assert.Equal(t, addr.Bytes(), key[2:], "should have marker address")
+}
+
+func TestAllowSendKey(t *testing.T) {
+	addr, err := MarkerAddress("nhash")
+	require.NoError(t, err, "MarkerAddress(nhash)")
+	allowAddr := sdk.AccAddress("cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h")
+	allowKey := AllowSendKey(addr, allowAddr)
+
+	assert.Equal(t, uint8(8), allowKey[0], "should have correct prefix for allow send key")
+	assert.Equal(t, AllowSendMarkerPrefix(addr), allowKey[:len(addr)+2], "should start with the marker prefix")
+	mAddr, aAddr := GetAllowSendAddresses(allowKey)
+	assert.Equal(t, addr, mAddr, "module address")
+	assert.Equal(t, allowAddr, aAddr, "allow address")
+}
+
+func TestPausedMarkerKey(t *testing.T) {
+	addr, err := MarkerAddress("nhash")
+	require.NoError(t, err, "MarkerAddress(nhash)")
+	key := PausedMarkerKey(addr)
+
+	assert.Equal(t, uint8(9), key[0], "should have correct prefix for paused marker key")
+	assert.Equal(t, uint8(len(addr)), key[1], "should have marker address length")
+	assert.Equal(t, addr.Bytes(), key[2:], "should have marker address")
+	assert.Equal(t, addr, SplitMarkerStoreKey(key), "should be able to get marker address back out of key")
+}
+
+func TestTransferFeeKey(t *testing.T) {
+	addr, err := MarkerAddress("nhash")
+	require.NoError(t, err, "MarkerAddress(nhash)")
+	key := TransferFeeKey(addr)
+
+	assert.Equal(t, uint8(10), key[0], "should have correct prefix for transfer fee key")
+	assert.Equal(t, uint8(len(addr)), key[1], "should have marker address length")
+	assert.Equal(t, addr.Bytes(), key[2:], "should have marker address")
+	assert.Equal(t, addr, SplitMarkerStoreKey(key), "should be able to get marker address back out of key")
+}
+
+func TestVelocityLimitKey(t *testing.T) {
+	addr, err := MarkerAddress("nhash")
+	require.NoError(t, err, "MarkerAddress(nhash)")
+	key := VelocityLimitKey(addr)
+
+	assert.Equal(t, uint8(11), key[0], "should have correct prefix for velocity limit key")
+	assert.Equal(t, uint8(len(addr)), key[1], "should have marker address length")
+	assert.Equal(t, addr.Bytes(), key[2:], "should have marker address")
+	assert.Equal(t, addr, SplitMarkerStoreKey(key), "should be able to get marker address back out of key")
+}
+
+func TestVelocityBucketKey(t *testing.T) {
+	markerAddr, err := MarkerAddress("nhash")
+	require.NoError(t, err, "MarkerAddress(nhash)")
+	addr := sdk.AccAddress("sender______________")
+	key := VelocityBucketKey(markerAddr, addr, 1_000_001)
+
+	assert.Equal(t, uint8(12), key[0], "should have correct prefix for velocity bucket key")
+	assert.Equal(t, VelocityBucketMarkerPrefix(markerAddr), key[:len(markerAddr)+2], "should start with marker prefix")
+	assert.Equal(t, VelocityBucketAddressPrefix(markerAddr, addr), key[:len(markerAddr)+len(addr)+3], "should start with address prefix")
+	assert.Len(t, key, len(markerAddr)+len(addr)+3+8, "key length")
+
+	actualMarkerAddr, actualAddr, actualBucket := ParseVelocityBucketKey(key)
+	assert.Equal(t, markerAddr, actualMarkerAddr, "parsed marker address")
+	assert.Equal(t, addr, actualAddr, "parsed address")
+	assert.Equal(t, uint64(1_000_001), actualBucket, "parsed bucket")
+
+	assert.Less(t, string(VelocityBucketKey(markerAddr, addr, 255)), string(VelocityBucketKey(markerAddr, addr, 256)), "bucket keys should sort by bucket")
+}
+
+func TestHolderSnapshotKeys(t *testing.T) {
+	markerAddr, err := MarkerAddress("nhash")
+	require.NoError(t, err, "MarkerAddress(nhash)")
+	addr := sdk.AccAddress("holder______________")
+
+	key := HolderSnapshotKey(markerAddr, 1_000_001)
+	assert.Equal(t, uint8(13), key[0], "should have correct prefix for holder snapshot key")
+	assert.Equal(t, HolderSnapshotMarkerPrefix(markerAddr), key[:len(markerAddr)+2], "should start with marker prefix")
+	assert.Len(t, key, len(markerAddr)+2+8, "holder snapshot key length")
+	assert.Less(t, string(HolderSnapshotKey(markerAddr, 255)), string(HolderSnapshotKey(markerAddr, 256)), "snapshot keys should sort by height")
+
+	key = HolderSnapshotBalanceKey(markerAddr, 1_000_001, addr)
+	assert.Equal(t, uint8(14), key[0], "should have correct prefix for holder snapshot balance key")
+	assert.Equal(t, HolderSnapshotBalanceMarkerPrefix(markerAddr), key[:len(markerAddr)+2], "should start with marker prefix")
+	assert.Equal(t, HolderSnapshotBalancesPrefix(markerAddr, 1_000_001), key[:len(markerAddr)+2+8], "should start with snapshot prefix")
+	assert.Len(t, key, len(markerAddr)+len(addr)+3+8, "holder snapshot balance key length")
+
+	actualMarkerAddr, actualHeight, actualAddr := ParseHolderSnapshotBalanceKey(key)
+	assert.Equal(t, markerAddr, actualMarkerAddr, "parsed marker address")
+	assert.Equal(t, int64(1_000_001), actualHeight, "parsed height")
+	assert.Equal(t, addr, actualAddr, "parsed address")
+}
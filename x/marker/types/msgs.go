@@ -47,6 +47,14 @@ var AllRequestMsgs = []sdk.Msg{
 	(*MsgWithdrawEscrowProposalRequest)(nil),
 	(*MsgSetDenomMetadataProposalRequest)(nil),
 	(*MsgUpdateParamsRequest)(nil),
+	(*MsgSetSupplyScheduleRequest)(nil),
+	(*MsgUpdateSendAllowListRequest)(nil),
+	(*MsgSetSendAllowListModeRequest)(nil),
+	(*MsgPauseMarkerRequest)(nil),
+	(*MsgUnpauseMarkerRequest)(nil),
+	(*MsgSetTransferFeeRequest)(nil),
+	(*MsgSetVelocityLimitRequest)(nil),
+	(*MsgTakeHolderSnapshotRequest)(nil),
 }
 
 func NewMsgFinalizeRequest(denom string, admin sdk.AccAddress) *MsgFinalizeRequest {
@@ -760,6 +768,8 @@ func NewMsgUpdateParamsRequest(
 	enableGovernance bool,
 	unrestrictedDenomRegex string,
 	maxSupply sdkmath.Int,
+	enableNAVAttestations bool,
+	navAttestationMaxDeviationBps uint32,
 	authority string,
 ) *MsgUpdateParamsRequest {
 	return &MsgUpdateParamsRequest{
@@ -768,6 +778,8 @@ func NewMsgUpdateParamsRequest(
 			enableGovernance,
 			unrestrictedDenomRegex,
 			maxSupply,
+			enableNAVAttestations,
+			navAttestationMaxDeviationBps,
 		),
 	}
 }
@@ -779,3 +791,185 @@ func (msg MsgUpdateParamsRequest) ValidateBasic() error {
 	_, err := sdk.AccAddressFromBech32(msg.Authority)
 	return err
 }
+
+func NewMsgSetSupplyScheduleRequest(denom string, steps []SupplyScheduleStep, authority string) *MsgSetSupplyScheduleRequest {
+	return &MsgSetSupplyScheduleRequest{
+		Denom:     denom,
+		Steps:     steps,
+		Authority: authority,
+	}
+}
+
+func (msg MsgSetSupplyScheduleRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	for i, step := range msg.Steps {
+		if err := step.Validate(); err != nil {
+			return fmt.Errorf("invalid supply schedule step %d: %w", i, err)
+		}
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	return err
+}
+
+func NewMsgUpdateSendAllowListRequest(denom string, authority sdk.AccAddress, removeAllowAddresses, addAllowAddresses []string) *MsgUpdateSendAllowListRequest {
+	return &MsgUpdateSendAllowListRequest{
+		Denom:                  denom,
+		Authority:              authority.String(),
+		RemoveAllowedAddresses: removeAllowAddresses,
+		AddAllowedAddresses:    addAllowAddresses,
+	}
+}
+
+func (msg MsgUpdateSendAllowListRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if len(msg.AddAllowedAddresses) == 0 && len(msg.RemoveAllowedAddresses) == 0 {
+		return fmt.Errorf("both add and remove lists cannot be empty")
+	}
+
+	combined := []string{}
+	combined = append(combined, msg.AddAllowedAddresses...)
+	combined = append(combined, msg.RemoveAllowedAddresses...)
+	seen := make(map[string]bool)
+	for _, addr := range combined {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return err
+		}
+		if seen[addr] {
+			return fmt.Errorf("allowed address lists contain duplicate entries")
+		}
+		seen[addr] = true
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	return err
+}
+
+func NewMsgSetSendAllowListModeRequest(denom string, enabled bool, authority sdk.AccAddress) *MsgSetSendAllowListModeRequest {
+	return &MsgSetSendAllowListModeRequest{
+		Denom:     denom,
+		Enabled:   enabled,
+		Authority: authority.String(),
+	}
+}
+
+func (msg MsgSetSendAllowListModeRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	return err
+}
+
+func NewMsgPauseMarkerRequest(denom string, authority sdk.AccAddress) *MsgPauseMarkerRequest {
+	return &MsgPauseMarkerRequest{
+		Denom:     denom,
+		Authority: authority.String(),
+	}
+}
+
+func (msg MsgPauseMarkerRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	return err
+}
+
+func NewMsgUnpauseMarkerRequest(denom string, authority sdk.AccAddress) *MsgUnpauseMarkerRequest {
+	return &MsgUnpauseMarkerRequest{
+		Denom:     denom,
+		Authority: authority.String(),
+	}
+}
+
+func (msg MsgUnpauseMarkerRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	return err
+}
+
+func NewMsgSetTransferFeeRequest(denom string, basisPoints uint32, recipient string, authority sdk.AccAddress) *MsgSetTransferFeeRequest {
+	return &MsgSetTransferFeeRequest{
+		Denom:       denom,
+		BasisPoints: basisPoints,
+		Recipient:   recipient,
+		Authority:   authority.String(),
+	}
+}
+
+func (msg MsgSetTransferFeeRequest) ValidateBasic() error {
+	if msg.BasisPoints == 0 {
+		if err := sdk.ValidateDenom(msg.Denom); err != nil {
+			return err
+		}
+		if len(msg.Recipient) > 0 {
+			return errors.New("recipient must be empty when removing a transfer fee")
+		}
+	} else if err := NewTransferFee(msg.Denom, msg.BasisPoints, msg.Recipient).Validate(); err != nil {
+		return err
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	return err
+}
+
+func NewMsgSetVelocityLimitRequest(denom string, maxAmount sdkmath.Int, windowSeconds, bucketSeconds uint64, authority sdk.AccAddress) *MsgSetVelocityLimitRequest {
+	return &MsgSetVelocityLimitRequest{
+		Denom:         denom,
+		MaxAmount:     maxAmount.String(),
+		WindowSeconds: windowSeconds,
+		BucketSeconds: bucketSeconds,
+		Authority:     authority.String(),
+	}
+}
+
+// ParseMaxAmount returns the max amount of this request as an Int. An empty max amount is returned as zero.
+func (msg MsgSetVelocityLimitRequest) ParseMaxAmount() (sdkmath.Int, error) {
+	if len(msg.MaxAmount) == 0 {
+		return sdkmath.ZeroInt(), nil
+	}
+	maxAmount, ok := sdkmath.NewIntFromString(msg.MaxAmount)
+	if !ok {
+		return sdkmath.Int{}, fmt.Errorf("invalid max amount %q: must be an integer", msg.MaxAmount)
+	}
+	return maxAmount, nil
+}
+
+func (msg MsgSetVelocityLimitRequest) ValidateBasic() error {
+	maxAmount, err := msg.ParseMaxAmount()
+	if err != nil {
+		return err
+	}
+	if maxAmount.IsZero() {
+		if err := sdk.ValidateDenom(msg.Denom); err != nil {
+			return err
+		}
+		if msg.WindowSeconds != 0 || msg.BucketSeconds != 0 {
+			return errors.New("window and bucket seconds must be zero when removing a velocity limit")
+		}
+	} else if err = NewVelocityLimit(msg.Denom, maxAmount, msg.WindowSeconds, msg.BucketSeconds).Validate(); err != nil {
+		return err
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Authority)
+	return err
+}
+
+func NewMsgTakeHolderSnapshotRequest(denom string, authority sdk.AccAddress) *MsgTakeHolderSnapshotRequest {
+	return &MsgTakeHolderSnapshotRequest{
+		Denom:     denom,
+		Authority: authority.String(),
+	}
+}
+
+func (msg MsgTakeHolderSnapshotRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	return err
+}
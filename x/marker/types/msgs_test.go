@@ -53,6 +53,14 @@ func TestAllMsgsGetSigners(t *testing.T) {
 		func(signer string) sdk.Msg { return &MsgWithdrawEscrowProposalRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgSetDenomMetadataProposalRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgUpdateParamsRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgSetSupplyScheduleRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgUpdateSendAllowListRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgSetSendAllowListModeRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgPauseMarkerRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgUnpauseMarkerRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgSetTransferFeeRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgSetVelocityLimitRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgTakeHolderSnapshotRequest{Authority: signer} },
 	}
 
 	testutil.RunGetSignersTests(t, AllRequestMsgs, msgMakers, nil)
@@ -1310,6 +1318,8 @@ func TestMsgUpdateParamsRequestValidateBasic(t *testing.T) {
 					true,
 					"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}",
 					sdkmath.NewInt(1000000000000),
+					false,
+					0,
 				),
 			},
 			expectError: false,
@@ -1322,6 +1332,8 @@ func TestMsgUpdateParamsRequestValidateBasic(t *testing.T) {
 					true,
 					"^invalidregex$",
 					sdkmath.NewInt(1000000000000),
+					false,
+					0,
 				),
 			},
 			expectError:   true,
@@ -1335,6 +1347,8 @@ func TestMsgUpdateParamsRequestValidateBasic(t *testing.T) {
 					true,
 					"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}",
 					sdkmath.NewInt(1000000000000),
+					false,
+					0,
 				),
 			},
 			expectError:   true,
@@ -1355,3 +1369,390 @@ func TestMsgUpdateParamsRequestValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgSetSupplyScheduleRequestValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("authority___________").String()
+	mintStep := SupplyScheduleStep{Action: SupplyScheduleAction_Mint, Amount: sdkmath.NewInt(100), Height: 10}
+
+	testCases := []struct {
+		name   string
+		msg    MsgSetSupplyScheduleRequest
+		expErr string
+	}{
+		{
+			name: "valid with steps",
+			msg:  MsgSetSupplyScheduleRequest{Denom: "hotdog", Steps: []SupplyScheduleStep{mintStep}, Authority: authority},
+		},
+		{
+			name: "valid without steps",
+			msg:  MsgSetSupplyScheduleRequest{Denom: "hotdog", Authority: authority},
+		},
+		{
+			name:   "invalid denom",
+			msg:    MsgSetSupplyScheduleRequest{Denom: "x", Steps: []SupplyScheduleStep{mintStep}, Authority: authority},
+			expErr: "invalid denom: x",
+		},
+		{
+			name: "invalid step",
+			msg: MsgSetSupplyScheduleRequest{
+				Denom:     "hotdog",
+				Steps:     []SupplyScheduleStep{mintStep, {Action: SupplyScheduleAction_Burn, Amount: sdkmath.NewInt(5)}},
+				Authority: authority,
+			},
+			expErr: "invalid supply schedule step 1: either height or time must be set",
+		},
+		{
+			name:   "invalid authority",
+			msg:    MsgSetSupplyScheduleRequest{Denom: "hotdog", Steps: []SupplyScheduleStep{mintStep}, Authority: "invalid"},
+			expErr: "decoding bech32 failed: invalid bech32 string length 7",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgUpdateSendAllowListRequestValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+	denom := "somedenom"
+	addAddr := sdk.AccAddress("addAddr________________").String()
+	removeAddr := sdk.AccAddress("removeAddr________________").String()
+
+	tests := []struct {
+		name   string
+		msg    MsgUpdateSendAllowListRequest
+		expErr string
+	}{
+		{
+			name: "should succeed",
+			msg:  MsgUpdateSendAllowListRequest{Denom: denom, RemoveAllowedAddresses: []string{removeAddr}, AddAllowedAddresses: []string{addAddr}, Authority: addr},
+		},
+		{
+			name:   "invalid authority address",
+			msg:    MsgUpdateSendAllowListRequest{Denom: denom, RemoveAllowedAddresses: []string{removeAddr}, AddAllowedAddresses: []string{addAddr}, Authority: "invalid-address"},
+			expErr: "decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name:   "both add and remove list are empty",
+			msg:    MsgUpdateSendAllowListRequest{Denom: denom, Authority: addr},
+			expErr: "both add and remove lists cannot be empty",
+		},
+		{
+			name:   "invalid remove address",
+			msg:    MsgUpdateSendAllowListRequest{Denom: denom, RemoveAllowedAddresses: []string{"invalid-address"}, Authority: addr},
+			expErr: "decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name:   "invalid add address",
+			msg:    MsgUpdateSendAllowListRequest{Denom: denom, AddAllowedAddresses: []string{"invalid-addrs"}, Authority: addr},
+			expErr: "decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name:   "duplicate entries across lists",
+			msg:    MsgUpdateSendAllowListRequest{Denom: denom, RemoveAllowedAddresses: []string{addAddr}, AddAllowedAddresses: []string{addAddr}, Authority: addr},
+			expErr: "allowed address lists contain duplicate entries",
+		},
+		{
+			name:   "invalid denom",
+			msg:    MsgUpdateSendAllowListRequest{Denom: "1", AddAllowedAddresses: []string{addAddr}, Authority: addr},
+			expErr: "invalid denom: 1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgSetSendAllowListModeRequestValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+
+	tests := []struct {
+		name   string
+		msg    MsgSetSendAllowListModeRequest
+		expErr string
+	}{
+		{
+			name: "enable",
+			msg:  MsgSetSendAllowListModeRequest{Denom: "somedenom", Enabled: true, Authority: addr},
+		},
+		{
+			name: "disable",
+			msg:  MsgSetSendAllowListModeRequest{Denom: "somedenom", Enabled: false, Authority: addr},
+		},
+		{
+			name:   "invalid denom",
+			msg:    MsgSetSendAllowListModeRequest{Denom: "1", Enabled: true, Authority: addr},
+			expErr: "invalid denom: 1",
+		},
+		{
+			name:   "invalid authority address",
+			msg:    MsgSetSendAllowListModeRequest{Denom: "somedenom", Enabled: true, Authority: "invalid-address"},
+			expErr: "decoding bech32 failed: invalid separator index -1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgPauseMarkerRequestValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+
+	tests := []struct {
+		name   string
+		msg    MsgPauseMarkerRequest
+		expErr string
+	}{
+		{
+			name: "valid",
+			msg:  MsgPauseMarkerRequest{Denom: "somedenom", Authority: addr},
+		},
+		{
+			name:   "invalid denom",
+			msg:    MsgPauseMarkerRequest{Denom: "1", Authority: addr},
+			expErr: "invalid denom: 1",
+		},
+		{
+			name:   "invalid authority address",
+			msg:    MsgPauseMarkerRequest{Denom: "somedenom", Authority: "invalid-address"},
+			expErr: "decoding bech32 failed: invalid separator index -1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgUnpauseMarkerRequestValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+
+	tests := []struct {
+		name   string
+		msg    MsgUnpauseMarkerRequest
+		expErr string
+	}{
+		{
+			name: "valid",
+			msg:  MsgUnpauseMarkerRequest{Denom: "somedenom", Authority: addr},
+		},
+		{
+			name:   "invalid denom",
+			msg:    MsgUnpauseMarkerRequest{Denom: "1", Authority: addr},
+			expErr: "invalid denom: 1",
+		},
+		{
+			name:   "invalid authority address",
+			msg:    MsgUnpauseMarkerRequest{Denom: "somedenom", Authority: "invalid-address"},
+			expErr: "decoding bech32 failed: invalid separator index -1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgSetTransferFeeRequestValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+	recipient := sdk.AccAddress("recipient___________").String()
+
+	tests := []struct {
+		name   string
+		msg    MsgSetTransferFeeRequest
+		expErr string
+	}{
+		{
+			name: "valid set",
+			msg:  MsgSetTransferFeeRequest{Denom: "somedenom", BasisPoints: 25, Recipient: recipient, Authority: addr},
+		},
+		{
+			name: "valid remove",
+			msg:  MsgSetTransferFeeRequest{Denom: "somedenom", Authority: addr},
+		},
+		{
+			name:   "invalid denom",
+			msg:    MsgSetTransferFeeRequest{Denom: "1", BasisPoints: 25, Recipient: recipient, Authority: addr},
+			expErr: "invalid denom: 1",
+		},
+		{
+			name:   "invalid denom on remove",
+			msg:    MsgSetTransferFeeRequest{Denom: "1", Authority: addr},
+			expErr: "invalid denom: 1",
+		},
+		{
+			name:   "recipient on remove",
+			msg:    MsgSetTransferFeeRequest{Denom: "somedenom", Recipient: recipient, Authority: addr},
+			expErr: "recipient must be empty when removing a transfer fee",
+		},
+		{
+			name:   "too many basis points",
+			msg:    MsgSetTransferFeeRequest{Denom: "somedenom", BasisPoints: 10_001, Recipient: recipient, Authority: addr},
+			expErr: "transfer fee basis points cannot exceed 10000, got 10001",
+		},
+		{
+			name:   "invalid recipient",
+			msg:    MsgSetTransferFeeRequest{Denom: "somedenom", BasisPoints: 25, Recipient: "invalid-address", Authority: addr},
+			expErr: "invalid transfer fee recipient: decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name:   "invalid authority address",
+			msg:    MsgSetTransferFeeRequest{Denom: "somedenom", BasisPoints: 25, Recipient: recipient, Authority: "invalid-address"},
+			expErr: "decoding bech32 failed: invalid separator index -1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgSetVelocityLimitRequestValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+
+	tests := []struct {
+		name   string
+		msg    MsgSetVelocityLimitRequest
+		expErr string
+	}{
+		{
+			name: "valid set",
+			msg:  MsgSetVelocityLimitRequest{Denom: "somedenom", MaxAmount: "100", WindowSeconds: 3600, BucketSeconds: 60, Authority: addr},
+		},
+		{
+			name: "valid remove",
+			msg:  MsgSetVelocityLimitRequest{Denom: "somedenom", MaxAmount: "0", Authority: addr},
+		},
+		{
+			name: "valid remove empty amount",
+			msg:  MsgSetVelocityLimitRequest{Denom: "somedenom", Authority: addr},
+		},
+		{
+			name:   "invalid denom",
+			msg:    MsgSetVelocityLimitRequest{Denom: "1", MaxAmount: "100", WindowSeconds: 3600, BucketSeconds: 60, Authority: addr},
+			expErr: "invalid denom: 1",
+		},
+		{
+			name:   "invalid denom on remove",
+			msg:    MsgSetVelocityLimitRequest{Denom: "1", Authority: addr},
+			expErr: "invalid denom: 1",
+		},
+		{
+			name:   "window on remove",
+			msg:    MsgSetVelocityLimitRequest{Denom: "somedenom", WindowSeconds: 3600, Authority: addr},
+			expErr: "window and bucket seconds must be zero when removing a velocity limit",
+		},
+		{
+			name:   "invalid max amount",
+			msg:    MsgSetVelocityLimitRequest{Denom: "somedenom", MaxAmount: "lots", WindowSeconds: 3600, BucketSeconds: 60, Authority: addr},
+			expErr: "invalid max amount \"lots\": must be an integer",
+		},
+		{
+			name:   "negative max amount",
+			msg:    MsgSetVelocityLimitRequest{Denom: "somedenom", MaxAmount: "-1", WindowSeconds: 3600, BucketSeconds: 60, Authority: addr},
+			expErr: "velocity limit max amount must be positive, got -1",
+		},
+		{
+			name:   "window not a multiple of bucket",
+			msg:    MsgSetVelocityLimitRequest{Denom: "somedenom", MaxAmount: "100", WindowSeconds: 3601, BucketSeconds: 60, Authority: addr},
+			expErr: "velocity limit window seconds 3601 must be a positive multiple of bucket seconds 60",
+		},
+		{
+			name:   "invalid authority address",
+			msg:    MsgSetVelocityLimitRequest{Denom: "somedenom", MaxAmount: "100", WindowSeconds: 3600, BucketSeconds: 60, Authority: "invalid-address"},
+			expErr: "decoding bech32 failed: invalid separator index -1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgTakeHolderSnapshotRequestValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+
+	tests := []struct {
+		name   string
+		msg    MsgTakeHolderSnapshotRequest
+		expErr string
+	}{
+		{
+			name: "valid",
+			msg:  MsgTakeHolderSnapshotRequest{Denom: "somedenom", Authority: addr},
+		},
+		{
+			name:   "invalid denom",
+			msg:    MsgTakeHolderSnapshotRequest{Denom: "1", Authority: addr},
+			expErr: "invalid denom: 1",
+		},
+		{
+			name:   "invalid authority address",
+			msg:    MsgTakeHolderSnapshotRequest{Denom: "somedenom", Authority: "invalid-address"},
+			expErr: "decoding bech32 failed: invalid separator index -1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
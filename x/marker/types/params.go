@@ -14,6 +14,12 @@ const (
 	DefaultMaxSupply = "100000000000000000000"
 	// DefaultUnrestrictedDenomRegex is a regex that denoms created by normal requests must pass.
 	DefaultUnrestrictedDenomRegex = `[a-zA-Z][a-zA-Z0-9\-\.]{2,83}`
+	// DefaultEnableNAVAttestations (false) indicates that net asset values from vote extensions are not recorded.
+	DefaultEnableNAVAttestations = false
+	// DefaultNAVAttestationMaxDeviationBps is the default max distance (in basis points) an attestation can be from the median.
+	DefaultNAVAttestationMaxDeviationBps = uint32(500)
+	// MaxBasisPoints is the number of basis points in 100%.
+	MaxBasisPoints = uint32(10_000)
 )
 
 // NewParams creates a new parameter object
@@ -21,11 +27,15 @@ func NewParams(
 	enableGovernance bool,
 	unrestrictedDenomRegex string,
 	maxSupply sdkmath.Int,
+	enableNAVAttestations bool,
+	navAttestationMaxDeviationBps uint32,
 ) Params {
 	return Params{
-		EnableGovernance:       enableGovernance,
-		UnrestrictedDenomRegex: unrestrictedDenomRegex,
-		MaxSupply:              maxSupply,
+		EnableGovernance:              enableGovernance,
+		UnrestrictedDenomRegex:        unrestrictedDenomRegex,
+		MaxSupply:                     maxSupply,
+		EnableNavAttestations:         enableNAVAttestations,
+		NavAttestationMaxDeviationBps: navAttestationMaxDeviationBps,
 	}
 }
 
@@ -35,6 +45,8 @@ func DefaultParams() Params {
 		DefaultEnableGovernance,
 		DefaultUnrestrictedDenomRegex,
 		StringToBigInt(DefaultMaxSupply),
+		DefaultEnableNAVAttestations,
+		DefaultNAVAttestationMaxDeviationBps,
 	)
 }
 
@@ -43,8 +55,14 @@ func (p Params) Validate() error {
 	if len(exp) > 0 && (exp[0:1] == "^" || exp[len(exp)-1:] == "$") {
 		return fmt.Errorf("invalid parameter, validation regex must not contain anchors ^,$")
 	}
-	_, err := regexp.Compile(fmt.Sprintf(`^%s$`, exp))
-	return err
+	if _, err := regexp.Compile(fmt.Sprintf(`^%s$`, exp)); err != nil {
+		return err
+	}
+	if p.NavAttestationMaxDeviationBps > MaxBasisPoints {
+		return fmt.Errorf("invalid nav attestation max deviation %d: cannot be more than %d basis points",
+			p.NavAttestationMaxDeviationBps, MaxBasisPoints)
+	}
+	return nil
 }
 
 func StringToBigInt(val string) sdkmath.Int {
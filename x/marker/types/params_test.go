@@ -19,10 +19,16 @@ func TestDefaultParams(t *testing.T) {
 	require.Equal(t, DefaultEnableGovernance, p.EnableGovernance)
 	require.Equal(t, DefaultMaxSupply, p.MaxSupply.String())
 
-	require.True(t, p.Equal(NewParams(DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, StringToBigInt(DefaultMaxSupply))))
-	require.False(t, p.Equal(NewParams(false, DefaultUnrestrictedDenomRegex, StringToBigInt(DefaultMaxSupply))))
-	require.False(t, p.Equal(NewParams(DefaultEnableGovernance, "a-z", StringToBigInt(DefaultMaxSupply))))
-	require.False(t, p.Equal(NewParams(DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, StringToBigInt("1000"))))
+	require.Equal(t, DefaultEnableNAVAttestations, p.EnableNavAttestations)
+	require.Equal(t, DefaultNAVAttestationMaxDeviationBps, p.NavAttestationMaxDeviationBps)
+
+	maxSupply := StringToBigInt(DefaultMaxSupply)
+	require.True(t, p.Equal(NewParams(DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, maxSupply, DefaultEnableNAVAttestations, DefaultNAVAttestationMaxDeviationBps)))
+	require.False(t, p.Equal(NewParams(false, DefaultUnrestrictedDenomRegex, maxSupply, DefaultEnableNAVAttestations, DefaultNAVAttestationMaxDeviationBps)))
+	require.False(t, p.Equal(NewParams(DefaultEnableGovernance, "a-z", maxSupply, DefaultEnableNAVAttestations, DefaultNAVAttestationMaxDeviationBps)))
+	require.False(t, p.Equal(NewParams(DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, StringToBigInt("1000"), DefaultEnableNAVAttestations, DefaultNAVAttestationMaxDeviationBps)))
+	require.False(t, p.Equal(NewParams(DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, maxSupply, true, DefaultNAVAttestationMaxDeviationBps)))
+	require.False(t, p.Equal(NewParams(DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, maxSupply, DefaultEnableNAVAttestations, 100)))
 	require.False(t, p.Equal(nil))
 
 	var p2 *Params
@@ -39,7 +45,8 @@ func TestDefaultParams(t *testing.T) {
 func TestParamString(t *testing.T) {
 	expected := `enable_governance:true ` +
 		`unrestricted_denom_regex:"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}" ` +
-		`max_supply:"100000000000000000000" `
+		`max_supply:"100000000000000000000" ` +
+		`nav_attestation_max_deviation_bps:500 `
 	p := DefaultParams()
 	actual := p.String()
 	require.Equal(t, expected, actual)
@@ -97,6 +104,19 @@ func TestParamsValidate(t *testing.T) {
 			},
 			expectedErr: "error parsing regexp: missing closing ):",
 		},
+		{
+			name: "nav attestation max deviation of 100%",
+			params: Params{
+				NavAttestationMaxDeviationBps: 10_000,
+			},
+		},
+		{
+			name: "nav attestation max deviation more than 100%",
+			params: Params{
+				NavAttestationMaxDeviationBps: 10_001,
+			},
+			expectedErr: "invalid nav attestation max deviation 10001: cannot be more than 10000 basis points",
+		},
 	}
 
 	for _, tc := range testCases {
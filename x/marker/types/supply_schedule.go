@@ -0,0 +1,76 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewSupplySchedule returns a new instance of SupplySchedule
+func NewSupplySchedule(denom string, steps ...SupplyScheduleStep) SupplySchedule {
+	return SupplySchedule{
+		Denom: denom,
+		Steps: steps,
+	}
+}
+
+// Validate returns an error if this supply schedule is not valid.
+func (s SupplySchedule) Validate() error {
+	if err := sdk.ValidateDenom(s.Denom); err != nil {
+		return err
+	}
+	if len(s.Steps) == 0 {
+		return fmt.Errorf("supply schedule for %s must have at least one step", s.Denom)
+	}
+	for i, step := range s.Steps {
+		if err := step.Validate(); err != nil {
+			return fmt.Errorf("invalid supply schedule step %d for %s: %w", i, s.Denom, err)
+		}
+	}
+	return nil
+}
+
+// Validate returns an error if this supply schedule step is not valid.
+func (s SupplyScheduleStep) Validate() error {
+	switch s.Action {
+	case SupplyScheduleAction_Mint, SupplyScheduleAction_Burn:
+	default:
+		return fmt.Errorf("unknown supply schedule action: %s", s.Action)
+	}
+
+	if s.Amount.IsNil() || !s.Amount.IsPositive() {
+		return fmt.Errorf("amount must be positive, got %s", s.Amount)
+	}
+
+	switch {
+	case s.Height == 0 && s.Time == nil:
+		return errors.New("either height or time must be set")
+	case s.Height != 0 && s.Time != nil:
+		return errors.New("height and time cannot both be set")
+	case s.Height < 0:
+		return fmt.Errorf("height cannot be negative, got %d", s.Height)
+	case s.Time != nil && s.Time.IsZero():
+		return errors.New("time cannot be zero")
+	}
+
+	if len(s.Recipient) > 0 {
+		if s.Action != SupplyScheduleAction_Mint {
+			return errors.New("recipient is only allowed on mint steps")
+		}
+		if _, err := sdk.AccAddressFromBech32(s.Recipient); err != nil {
+			return fmt.Errorf("invalid recipient: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// IsDue returns true if this step should be executed at the given block height and time.
+func (s SupplyScheduleStep) IsDue(height int64, blockTime time.Time) bool {
+	if s.Time != nil {
+		return !blockTime.Before(*s.Time)
+	}
+	return height >= s.Height
+}
@@ -0,0 +1,148 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestSupplyScheduleValidate(t *testing.T) {
+	step := SupplyScheduleStep{Action: SupplyScheduleAction_Mint, Amount: sdkmath.NewInt(100), Height: 10}
+
+	tests := []struct {
+		name     string
+		schedule SupplySchedule
+		expErr   string
+	}{
+		{
+			name:     "valid",
+			schedule: NewSupplySchedule("hotdog", step, step),
+		},
+		{
+			name:     "invalid denom",
+			schedule: NewSupplySchedule("x", step),
+			expErr:   "invalid denom: x",
+		},
+		{
+			name:     "no steps",
+			schedule: NewSupplySchedule("hotdog"),
+			expErr:   "supply schedule for hotdog must have at least one step",
+		},
+		{
+			name:     "invalid step",
+			schedule: NewSupplySchedule("hotdog", step, SupplyScheduleStep{Amount: sdkmath.NewInt(1), Height: 1}),
+			expErr:   "invalid supply schedule step 1 for hotdog: unknown supply schedule action: SUPPLY_SCHEDULE_ACTION_UNSPECIFIED",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.schedule.Validate()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "Validate")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
+
+func TestSupplyScheduleStepValidate(t *testing.T) {
+	when := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	zeroTime := time.Time{}
+	recipient := sdk.AccAddress("recipient___________").String()
+
+	tests := []struct {
+		name   string
+		step   SupplyScheduleStep
+		expErr string
+	}{
+		{
+			name: "mint at height",
+			step: SupplyScheduleStep{Action: SupplyScheduleAction_Mint, Amount: sdkmath.NewInt(1), Height: 5},
+		},
+		{
+			name: "burn at time",
+			step: SupplyScheduleStep{Action: SupplyScheduleAction_Burn, Amount: sdkmath.NewInt(1), Time: &when},
+		},
+		{
+			name: "mint with recipient",
+			step: SupplyScheduleStep{Action: SupplyScheduleAction_Mint, Amount: sdkmath.NewInt(1), Height: 5, Recipient: recipient},
+		},
+		{
+			name:   "unspecified action",
+			step:   SupplyScheduleStep{Amount: sdkmath.NewInt(1), Height: 5},
+			expErr: "unknown supply schedule action: SUPPLY_SCHEDULE_ACTION_UNSPECIFIED",
+		},
+		{
+			name:   "nil amount",
+			step:   SupplyScheduleStep{Action: SupplyScheduleAction_Mint, Height: 5},
+			expErr: "amount must be positive, got <nil>",
+		},
+		{
+			name:   "zero amount",
+			step:   SupplyScheduleStep{Action: SupplyScheduleAction_Mint, Amount: sdkmath.ZeroInt(), Height: 5},
+			expErr: "amount must be positive, got 0",
+		},
+		{
+			name:   "neither height nor time",
+			step:   SupplyScheduleStep{Action: SupplyScheduleAction_Mint, Amount: sdkmath.NewInt(1)},
+			expErr: "either height or time must be set",
+		},
+		{
+			name:   "both height and time",
+			step:   SupplyScheduleStep{Action: SupplyScheduleAction_Mint, Amount: sdkmath.NewInt(1), Height: 5, Time: &when},
+			expErr: "height and time cannot both be set",
+		},
+		{
+			name:   "negative height",
+			step:   SupplyScheduleStep{Action: SupplyScheduleAction_Mint, Amount: sdkmath.NewInt(1), Height: -5},
+			expErr: "height cannot be negative, got -5",
+		},
+		{
+			name:   "zero time",
+			step:   SupplyScheduleStep{Action: SupplyScheduleAction_Mint, Amount: sdkmath.NewInt(1), Time: &zeroTime},
+			expErr: "time cannot be zero",
+		},
+		{
+			name:   "burn with recipient",
+			step:   SupplyScheduleStep{Action: SupplyScheduleAction_Burn, Amount: sdkmath.NewInt(1), Height: 5, Recipient: recipient},
+			expErr: "recipient is only allowed on mint steps",
+		},
+		{
+			name:   "invalid recipient",
+			step:   SupplyScheduleStep{Action: SupplyScheduleAction_Mint, Amount: sdkmath.NewInt(1), Height: 5, Recipient: "bad"},
+			expErr: "invalid recipient: decoding bech32 failed: invalid bech32 string length 3",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.step.Validate()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "Validate")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
+
+func TestSupplyScheduleStepIsDue(t *testing.T) {
+	when := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	heightStep := SupplyScheduleStep{Height: 10}
+	timeStep := SupplyScheduleStep{Time: &when}
+
+	require.False(t, heightStep.IsDue(9, when), "height step before height")
+	require.True(t, heightStep.IsDue(10, when), "height step at height")
+	require.True(t, heightStep.IsDue(11, when), "height step after height")
+	require.False(t, timeStep.IsDue(100, when.Add(-time.Second)), "time step before time")
+	require.True(t, timeStep.IsDue(1, when), "time step at time")
+	require.True(t, timeStep.IsDue(1, when.Add(time.Second)), "time step after time")
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxTransferFeeBasisPoints is the largest allowed transfer fee rate, i.e. 100%.
+const MaxTransferFeeBasisPoints uint32 = 10_000
+
+// NewTransferFee returns a new instance of TransferFee
+func NewTransferFee(denom string, basisPoints uint32, recipient string) TransferFee {
+	return TransferFee{
+		Denom:       denom,
+		BasisPoints: basisPoints,
+		Recipient:   recipient,
+	}
+}
+
+// Validate returns an error if this transfer fee is not valid.
+func (f TransferFee) Validate() error {
+	if err := sdk.ValidateDenom(f.Denom); err != nil {
+		return err
+	}
+	if f.BasisPoints == 0 {
+		return errors.New("transfer fee basis points must be positive")
+	}
+	if f.BasisPoints > MaxTransferFeeBasisPoints {
+		return fmt.Errorf("transfer fee basis points cannot exceed %d, got %d", MaxTransferFeeBasisPoints, f.BasisPoints)
+	}
+	if _, err := sdk.AccAddressFromBech32(f.Recipient); err != nil {
+		return fmt.Errorf("invalid transfer fee recipient: %w", err)
+	}
+	return nil
+}
+
+// CalculateFee returns the fee owed for sending the provided amount, rounded down.
+func (f TransferFee) CalculateFee(amount sdkmath.Int) sdkmath.Int {
+	return amount.MulRaw(int64(f.BasisPoints)).QuoRaw(int64(MaxTransferFeeBasisPoints))
+}
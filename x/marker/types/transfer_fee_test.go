@@ -0,0 +1,83 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestTransferFeeValidate(t *testing.T) {
+	recipient := sdk.AccAddress("recipient___________").String()
+
+	tests := []struct {
+		name   string
+		fee    TransferFee
+		expErr string
+	}{
+		{
+			name: "valid",
+			fee:  NewTransferFee("hotdog", 25, recipient),
+		},
+		{
+			name: "valid max basis points",
+			fee:  NewTransferFee("hotdog", MaxTransferFeeBasisPoints, recipient),
+		},
+		{
+			name:   "invalid denom",
+			fee:    NewTransferFee("x", 25, recipient),
+			expErr: "invalid denom: x",
+		},
+		{
+			name:   "zero basis points",
+			fee:    NewTransferFee("hotdog", 0, recipient),
+			expErr: "transfer fee basis points must be positive",
+		},
+		{
+			name:   "too many basis points",
+			fee:    NewTransferFee("hotdog", MaxTransferFeeBasisPoints+1, recipient),
+			expErr: "transfer fee basis points cannot exceed 10000, got 10001",
+		},
+		{
+			name:   "no recipient",
+			fee:    NewTransferFee("hotdog", 25, ""),
+			expErr: "invalid transfer fee recipient: empty address string is not allowed",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.fee.Validate()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "Validate")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
+
+func TestTransferFeeCalculateFee(t *testing.T) {
+	tests := []struct {
+		name        string
+		basisPoints uint32
+		amount      int64
+		exp         int64
+	}{
+		{name: "quarter percent", basisPoints: 25, amount: 10_000, exp: 25},
+		{name: "rounds down", basisPoints: 25, amount: 399, exp: 0},
+		{name: "one percent of 1001", basisPoints: 100, amount: 1001, exp: 10},
+		{name: "full amount", basisPoints: MaxTransferFeeBasisPoints, amount: 123, exp: 123},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fee := NewTransferFee("hotdog", tc.basisPoints, "")
+			actual := fee.CalculateFee(sdkmath.NewInt(tc.amount))
+			assert.Equal(t, sdkmath.NewInt(tc.exp).String(), actual.String(), "CalculateFee(%d)", tc.amount)
+		})
+	}
+}
@@ -0,0 +1,854 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/marker/v2/query.proto
+
+package markerv2
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	types1 "github.com/cosmos/gogoproto/types"
+	types "github.com/provenance-io/provenance/x/marker/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Order defines the order in which the results of a query are returned.
+type Order int32
+
+const (
+	// ORDER_UNSPECIFIED is the zero-value Order; it is treated the same as ORDER_ASCENDING.
+	Order_ORDER_UNSPECIFIED Order = 0
+	// ORDER_ASCENDING returns the results with the smallest keys first.
+	Order_ORDER_ASCENDING Order = 1
+	// ORDER_DESCENDING returns the results with the largest keys first.
+	Order_ORDER_DESCENDING Order = 2
+)
+
+var Order_name = map[int32]string{
+	0: "ORDER_UNSPECIFIED",
+	1: "ORDER_ASCENDING",
+	2: "ORDER_DESCENDING",
+}
+
+var Order_value = map[string]int32{
+	"ORDER_UNSPECIFIED": 0,
+	"ORDER_ASCENDING":   1,
+	"ORDER_DESCENDING":  2,
+}
+
+func (x Order) String() string {
+	return proto.EnumName(Order_name, int32(x))
+}
+
+func (Order) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_41e3ffd8a421b1c7, []int{0}
+}
+
+// QueryMarkersRequest is a request message for the Markers query.
+type QueryMarkersRequest struct {
+	// status is an optional marker status to limit the results to. If unspecified, markers of all statuses are returned.
+	Status types.MarkerStatus `protobuf:"varint,1,opt,name=status,proto3,enum=provenance.marker.v1.MarkerStatus" json:"status,omitempty"`
+	// order is the order (by marker address) in which the markers are returned.
+	Order Order `protobuf:"varint,2,opt,name=order,proto3,enum=provenance.marker.v2.Order" json:"order,omitempty"`
+	// field_mask identifies the fields of each marker to return, e.g. "denom" or "base_account.address".
+	// If not provided, all fields are returned.
+	FieldMask *types1.FieldMask `protobuf:"bytes,3,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	// pagination defines an optional key-based pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMarkersRequest) Reset()         { *m = QueryMarkersRequest{} }
+func (m *QueryMarkersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMarkersRequest) ProtoMessage()    {}
+func (*QueryMarkersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41e3ffd8a421b1c7, []int{0}
+}
+func (m *QueryMarkersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarkersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarkersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMarkersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarkersRequest.Merge(m, src)
+}
+func (m *QueryMarkersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarkersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarkersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMarkersRequest proto.InternalMessageInfo
+
+func (m *QueryMarkersRequest) GetStatus() types.MarkerStatus {
+	if m != nil {
+		return m.Status
+	}
+	return types.StatusUndefined
+}
+
+func (m *QueryMarkersRequest) GetOrder() Order {
+	if m != nil {
+		return m.Order
+	}
+	return Order_ORDER_UNSPECIFIED
+}
+
+func (m *QueryMarkersRequest) GetFieldMask() *types1.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return nil
+}
+
+func (m *QueryMarkersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMarkersResponse is a response message for the Markers query.
+type QueryMarkersResponse struct {
+	// markers are a page of the markers.
+	Markers []*types.MarkerAccount `protobuf:"bytes,1,rep,name=markers,proto3" json:"markers,omitempty"`
+	// pagination is the resulting pagination parameters.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMarkersResponse) Reset()         { *m = QueryMarkersResponse{} }
+func (m *QueryMarkersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMarkersResponse) ProtoMessage()    {}
+func (*QueryMarkersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41e3ffd8a421b1c7, []int{1}
+}
+func (m *QueryMarkersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarkersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarkersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMarkersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarkersResponse.Merge(m, src)
+}
+func (m *QueryMarkersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarkersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarkersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMarkersResponse proto.InternalMessageInfo
+
+func (m *QueryMarkersResponse) GetMarkers() []*types.MarkerAccount {
+	if m != nil {
+		return m.Markers
+	}
+	return nil
+}
+
+func (m *QueryMarkersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("provenance.marker.v2.Order", Order_name, Order_value)
+	proto.RegisterType((*QueryMarkersRequest)(nil), "provenance.marker.v2.QueryMarkersRequest")
+	proto.RegisterType((*QueryMarkersResponse)(nil), "provenance.marker.v2.QueryMarkersResponse")
+}
+
+func init() { proto.RegisterFile("provenance/marker/v2/query.proto", fileDescriptor_41e3ffd8a421b1c7) }
+
+var fileDescriptor_41e3ffd8a421b1c7 = []byte{
+	// 506 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x41, 0x6f, 0xd3, 0x30,
+	0x18, 0xad, 0x3b, 0x75, 0x13, 0x9e, 0x04, 0xc5, 0x2b, 0xa2, 0x2a, 0x10, 0x4a, 0x11, 0x50, 0x2a,
+	0x61, 0xab, 0xe6, 0x04, 0x08, 0xa4, 0xb1, 0xa6, 0x53, 0x0f, 0xeb, 0x4a, 0x2a, 0x2e, 0x5c, 0x26,
+	0x27, 0x73, 0x43, 0xd4, 0x35, 0xce, 0x62, 0x27, 0x62, 0x57, 0x2e, 0xbb, 0x82, 0xb8, 0xf3, 0x7b,
+	0x38, 0x4e, 0xe2, 0xc2, 0x11, 0xb5, 0xfc, 0x10, 0x14, 0x3b, 0xa5, 0x45, 0x8a, 0x60, 0xb7, 0x2f,
+	0xdf, 0xf7, 0x9e, 0xbf, 0xf7, 0x9e, 0x1d, 0xd8, 0x8c, 0x62, 0x91, 0xf2, 0x90, 0x85, 0x1e, 0x27,
+	0x33, 0x16, 0x4f, 0x79, 0x4c, 0x52, 0x4a, 0x4e, 0x13, 0x1e, 0x9f, 0xe1, 0x28, 0x16, 0x4a, 0xa0,
+	0xda, 0x0a, 0x81, 0x0d, 0x02, 0xa7, 0xb4, 0xd1, 0xf1, 0x84, 0x9c, 0x09, 0x49, 0x5c, 0x26, 0xb9,
+	0x81, 0x93, 0xb4, 0xeb, 0x72, 0xc5, 0xba, 0x24, 0x62, 0x7e, 0x10, 0x32, 0x15, 0x88, 0xd0, 0x9c,
+	0xd0, 0xb8, 0xed, 0x0b, 0xe1, 0x9f, 0x70, 0xc2, 0xa2, 0x80, 0xb0, 0x30, 0x14, 0x4a, 0x0f, 0x65,
+	0x3e, 0x6d, 0xe6, 0x53, 0xfd, 0xe5, 0x26, 0x13, 0x32, 0x09, 0xf8, 0xc9, 0xf1, 0xd1, 0x8c, 0xc9,
+	0x69, 0x8e, 0xb8, 0x57, 0xa0, 0xb1, 0x9b, 0x57, 0x06, 0xd2, 0x3a, 0x2f, 0xc3, 0x9d, 0x37, 0x99,
+	0x8a, 0x03, 0xdd, 0x95, 0x0e, 0x3f, 0x4d, 0xb8, 0x54, 0xe8, 0x39, 0xdc, 0x94, 0x8a, 0xa9, 0x44,
+	0xd6, 0x41, 0x13, 0xb4, 0xaf, 0xd2, 0x16, 0x2e, 0x70, 0xd3, 0xc5, 0x86, 0x35, 0xd6, 0x48, 0x27,
+	0x67, 0xa0, 0x2e, 0xac, 0x88, 0xf8, 0x98, 0xc7, 0xf5, 0xb2, 0xa6, 0xde, 0x2a, 0xa2, 0x52, 0x7c,
+	0x98, 0x41, 0x1c, 0x83, 0x44, 0xcf, 0x20, 0x5c, 0xa9, 0xaf, 0x6f, 0x34, 0x41, 0x7b, 0x9b, 0x36,
+	0xb0, 0x31, 0x88, 0x97, 0x06, 0x71, 0x3f, 0x83, 0x1c, 0x30, 0x39, 0x75, 0xae, 0x4c, 0x96, 0x25,
+	0xea, 0x43, 0xb8, 0x0a, 0xae, 0xee, 0x69, 0xea, 0x43, 0x6c, 0x52, 0xc6, 0x59, 0xca, 0xd8, 0x5c,
+	0x4a, 0x9e, 0x32, 0x1e, 0x31, 0x9f, 0xe7, 0x2e, 0x9d, 0x35, 0x66, 0xeb, 0x2b, 0x80, 0xb5, 0xbf,
+	0x93, 0x90, 0x91, 0x08, 0x25, 0x47, 0x2f, 0xe1, 0x96, 0x51, 0x9d, 0x65, 0xb1, 0xd1, 0xde, 0xa6,
+	0xf7, 0xff, 0x95, 0xc5, 0xae, 0xe7, 0x89, 0x24, 0x54, 0xce, 0x92, 0x83, 0xf6, 0x0b, 0xf4, 0x3d,
+	0xfa, 0xaf, 0x3e, 0xb3, 0x7b, 0x5d, 0x60, 0x67, 0x00, 0x2b, 0x3a, 0x33, 0x74, 0x03, 0x5e, 0x3f,
+	0x74, 0x7a, 0xb6, 0x73, 0xf4, 0x76, 0x38, 0x1e, 0xd9, 0x7b, 0x83, 0xfe, 0xc0, 0xee, 0x55, 0x4b,
+	0x68, 0x07, 0x5e, 0x33, 0xed, 0xdd, 0xf1, 0x9e, 0x3d, 0xec, 0x0d, 0x86, 0xfb, 0x55, 0x80, 0x6a,
+	0xb0, 0x6a, 0x9a, 0x3d, 0xfb, 0x4f, 0xb7, 0x4c, 0x3f, 0x03, 0x58, 0xd1, 0x5e, 0xd1, 0x39, 0x80,
+	0x5b, 0xb9, 0x61, 0xf4, 0xb8, 0xf8, 0xa2, 0x0a, 0x9e, 0x47, 0xa3, 0x73, 0x19, 0xa8, 0xf1, 0xd0,
+	0x7a, 0xf0, 0xf1, 0xfb, 0xaf, 0x2f, 0xe5, 0xbb, 0xe8, 0x0e, 0x29, 0xfc, 0x65, 0x4c, 0x25, 0x5f,
+	0xcb, 0x6f, 0x73, 0x0b, 0x5c, 0xcc, 0x2d, 0xf0, 0x73, 0x6e, 0x81, 0x4f, 0x0b, 0xab, 0x74, 0xb1,
+	0xb0, 0x4a, 0x3f, 0x16, 0x56, 0x09, 0xde, 0x0c, 0x44, 0xe1, 0xba, 0x11, 0x78, 0xf7, 0xca, 0x0f,
+	0xd4, 0xfb, 0xc4, 0xc5, 0x9e, 0x98, 0xad, 0x9d, 0xfe, 0x24, 0x10, 0xeb, 0xbb, 0x3e, 0x2c, 0xb7,
+	0xa9, 0xb3, 0x88, 0x4b, 0x92, 0xd2, 0x17, 0xe6, 0x3b, 0xa5, 0xee, 0xa6, 0x7e, 0x5b, 0x4f, 0x7f,
+	0x0f, 0x00, 0xf8, 0x9b, 0x47, 0x13, 0xce, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Markers gets all the markers, optionally limited to ones with a specific status.
+	Markers(ctx context.Context, in *QueryMarkersRequest, opts ...grpc.CallOption) (*QueryMarkersResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Markers(ctx context.Context, in *QueryMarkersRequest, opts ...grpc.CallOption) (*QueryMarkersResponse, error) {
+	out := new(QueryMarkersResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v2.Query/Markers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Markers gets all the markers, optionally limited to ones with a specific status.
+	Markers(context.Context, *QueryMarkersRequest) (*QueryMarkersResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Markers(ctx context.Context, req *QueryMarkersRequest) (*QueryMarkersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Markers not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Markers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMarkersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Markers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v2.Query/Markers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Markers(ctx, req.(*QueryMarkersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.marker.v2.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Markers",
+			Handler:    _Query_Markers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provenance/marker/v2/query.proto",
+}
+
+func (m *QueryMarkersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarkersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarkersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.FieldMask != nil {
+		{
+			size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Order != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Order))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarkersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarkersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarkersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Markers) > 0 {
+		for iNdEx := len(m.Markers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Markers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryMarkersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Order != 0 {
+		n += 1 + sovQuery(uint64(m.Order))
+	}
+	if m.FieldMask != nil {
+		l = m.FieldMask.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMarkersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Markers) > 0 {
+		for _, e := range m.Markers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryMarkersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarkersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarkersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= types.MarkerStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			m.Order = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Order |= Order(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FieldMask == nil {
+				m.FieldMask = &types1.FieldMask{}
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarkersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarkersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarkersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markers = append(m.Markers, &types.MarkerAccount{})
+			if err := m.Markers[len(m.Markers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
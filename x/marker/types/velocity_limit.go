@@ -0,0 +1,63 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxVelocityLimitBuckets is the most buckets a velocity limit's window can be split into.
+// It bounds the number of buckets that have to be read when checking a send.
+const MaxVelocityLimitBuckets = 1000
+
+// NewVelocityLimit returns a new instance of VelocityLimit
+func NewVelocityLimit(denom string, maxAmount sdkmath.Int, windowSeconds, bucketSeconds uint64) VelocityLimit {
+	return VelocityLimit{
+		Denom:         denom,
+		MaxAmount:     maxAmount,
+		WindowSeconds: windowSeconds,
+		BucketSeconds: bucketSeconds,
+	}
+}
+
+// Validate returns an error if this velocity limit is not valid.
+func (v VelocityLimit) Validate() error {
+	if err := sdk.ValidateDenom(v.Denom); err != nil {
+		return err
+	}
+	if v.MaxAmount.IsNil() || !v.MaxAmount.IsPositive() {
+		return fmt.Errorf("velocity limit max amount must be positive, got %s", v.MaxAmount)
+	}
+	if v.BucketSeconds == 0 {
+		return errors.New("velocity limit bucket seconds must be positive")
+	}
+	if v.WindowSeconds == 0 || v.WindowSeconds%v.BucketSeconds != 0 {
+		return fmt.Errorf("velocity limit window seconds %d must be a positive multiple of bucket seconds %d", v.WindowSeconds, v.BucketSeconds)
+	}
+	if v.WindowSeconds/v.BucketSeconds > MaxVelocityLimitBuckets {
+		return fmt.Errorf("velocity limit window cannot have more than %d buckets, got %d", MaxVelocityLimitBuckets, v.WindowSeconds/v.BucketSeconds)
+	}
+	return nil
+}
+
+// GetBucket returns the index of the bucket that the provided block time falls in.
+func (v VelocityLimit) GetBucket(blockTime time.Time) uint64 {
+	unix := blockTime.Unix()
+	if unix < 0 {
+		return 0
+	}
+	return uint64(unix) / v.BucketSeconds
+}
+
+// GetWindowStart returns the index of the oldest bucket in the window that ends with the provided bucket.
+func (v VelocityLimit) GetWindowStart(bucket uint64) uint64 {
+	count := v.WindowSeconds / v.BucketSeconds
+	if bucket < count {
+		return 0
+	}
+	return bucket - count + 1
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+func TestVelocityLimitValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		limit  VelocityLimit
+		expErr string
+	}{
+		{
+			name:  "valid",
+			limit: NewVelocityLimit("hotdog", sdkmath.NewInt(100), 86_400, 3_600),
+		},
+		{
+			name:  "valid single bucket",
+			limit: NewVelocityLimit("hotdog", sdkmath.NewInt(100), 60, 60),
+		},
+		{
+			name:   "invalid denom",
+			limit:  NewVelocityLimit("x", sdkmath.NewInt(100), 60, 60),
+			expErr: "invalid denom: x",
+		},
+		{
+			name:   "nil max amount",
+			limit:  VelocityLimit{Denom: "hotdog", WindowSeconds: 60, BucketSeconds: 60},
+			expErr: "velocity limit max amount must be positive, got <nil>",
+		},
+		{
+			name:   "zero max amount",
+			limit:  NewVelocityLimit("hotdog", sdkmath.ZeroInt(), 60, 60),
+			expErr: "velocity limit max amount must be positive, got 0",
+		},
+		{
+			name:   "zero bucket seconds",
+			limit:  NewVelocityLimit("hotdog", sdkmath.NewInt(100), 60, 0),
+			expErr: "velocity limit bucket seconds must be positive",
+		},
+		{
+			name:   "zero window seconds",
+			limit:  NewVelocityLimit("hotdog", sdkmath.NewInt(100), 0, 60),
+			expErr: "velocity limit window seconds 0 must be a positive multiple of bucket seconds 60",
+		},
+		{
+			name:   "window not a multiple of bucket",
+			limit:  NewVelocityLimit("hotdog", sdkmath.NewInt(100), 90, 60),
+			expErr: "velocity limit window seconds 90 must be a positive multiple of bucket seconds 60",
+		},
+		{
+			name:   "too many buckets",
+			limit:  NewVelocityLimit("hotdog", sdkmath.NewInt(100), 1001, 1),
+			expErr: "velocity limit window cannot have more than 1000 buckets, got 1001",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.limit.Validate()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "Validate")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
+
+func TestVelocityLimitBuckets(t *testing.T) {
+	limit := NewVelocityLimit("hotdog", sdkmath.NewInt(100), 300, 60)
+
+	assert.Equal(t, uint64(0), limit.GetBucket(time.Unix(59, 0)), "GetBucket(59)")
+	assert.Equal(t, uint64(1), limit.GetBucket(time.Unix(60, 0)), "GetBucket(60)")
+	assert.Equal(t, uint64(16_666_666), limit.GetBucket(time.Unix(1_000_000_000, 0)), "GetBucket(1_000_000_000)")
+	assert.Equal(t, uint64(0), limit.GetBucket(time.Unix(-5, 0)), "GetBucket(-5)")
+
+	assert.Equal(t, uint64(6), limit.GetWindowStart(10), "GetWindowStart(10)")
+	assert.Equal(t, uint64(0), limit.GetWindowStart(4), "GetWindowStart(4)")
+	assert.Equal(t, uint64(0), limit.GetWindowStart(2), "GetWindowStart(2)")
+}
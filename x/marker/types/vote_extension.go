@@ -0,0 +1,78 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxNAVAttestations is the maximum number of attestations allowed in a single vote extension.
+	MaxNAVAttestations = 200
+	// NAVAttestationSource is the source used when recording a net asset value that was attested to by the validators.
+	NAVAttestationSource = "nav-attestation"
+)
+
+// NewNAVAttestation creates a new NAVAttestation.
+func NewNAVAttestation(denom string, price sdk.Coin, volume uint64) NAVAttestation {
+	return NAVAttestation{
+		Denom:  denom,
+		Price:  price,
+		Volume: volume,
+	}
+}
+
+// Validate returns an error if this NAVAttestation is not valid.
+func (a NAVAttestation) Validate() error {
+	if err := sdk.ValidateDenom(a.Denom); err != nil {
+		return fmt.Errorf("invalid denom: %w", err)
+	}
+	if err := a.Price.Validate(); err != nil {
+		return fmt.Errorf("invalid price: %w", err)
+	}
+	if !a.Price.IsPositive() {
+		return fmt.Errorf("invalid price %q: must be positive", a.Price)
+	}
+	if a.Price.Denom == a.Denom {
+		return fmt.Errorf("invalid price %q: price denom cannot match marker denom", a.Price)
+	}
+	if a.Volume == 0 {
+		return errors.New("invalid volume: must be positive")
+	}
+	return nil
+}
+
+// GetNetAssetValue returns the NetAssetValue being attested to.
+func (a NAVAttestation) GetNetAssetValue() NetAssetValue {
+	return NewNetAssetValue(a.Price, a.Volume)
+}
+
+// NewNAVVoteExtension creates a new NAVVoteExtension with the provided attestations.
+func NewNAVVoteExtension(attestations ...NAVAttestation) *NAVVoteExtension {
+	return &NAVVoteExtension{Attestations: attestations}
+}
+
+// Validate returns an error if this NAVVoteExtension has too many attestations, or any are invalid or duplicated.
+func (e NAVVoteExtension) Validate() error {
+	if len(e.Attestations) > MaxNAVAttestations {
+		return fmt.Errorf("too many attestations %d: max is %d", len(e.Attestations), MaxNAVAttestations)
+	}
+
+	var errs []error
+	seen := make(map[string]int, len(e.Attestations))
+	for i, attestation := range e.Attestations {
+		if err := attestation.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid attestations[%d]: %w", i, err))
+			continue
+		}
+		key := attestation.Denom + " " + attestation.Price.Denom
+		if j, dup := seen[key]; dup {
+			errs = append(errs, fmt.Errorf("invalid attestations[%d]: duplicate of attestations[%d]: %s price of %s",
+				i, j, attestation.Price.Denom, attestation.Denom))
+			continue
+		}
+		seen[key] = i
+	}
+	return errors.Join(errs...)
+}
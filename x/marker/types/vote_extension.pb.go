@@ -0,0 +1,604 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/marker/v1/vote_extension.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// NAVVoteExtension is the vote extension a validator includes with its pre-commit vote
+// to attest to the net asset values of markers.
+type NAVVoteExtension struct {
+	// attestations are the net asset values this validator is attesting to.
+	Attestations []NAVAttestation `protobuf:"bytes,1,rep,name=attestations,proto3" json:"attestations"`
+}
+
+func (m *NAVVoteExtension) Reset()         { *m = NAVVoteExtension{} }
+func (m *NAVVoteExtension) String() string { return proto.CompactTextString(m) }
+func (*NAVVoteExtension) ProtoMessage()    {}
+func (*NAVVoteExtension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b45c93d8358cc27, []int{0}
+}
+func (m *NAVVoteExtension) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NAVVoteExtension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NAVVoteExtension.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NAVVoteExtension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NAVVoteExtension.Merge(m, src)
+}
+func (m *NAVVoteExtension) XXX_Size() int {
+	return m.Size()
+}
+func (m *NAVVoteExtension) XXX_DiscardUnknown() {
+	xxx_messageInfo_NAVVoteExtension.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NAVVoteExtension proto.InternalMessageInfo
+
+func (m *NAVVoteExtension) GetAttestations() []NAVAttestation {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
+}
+
+// NAVAttestation is a validator's attestation of the net asset value of a marker.
+type NAVAttestation struct {
+	// denom is the marker's denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// price is the complete value of the volume of the marker.
+	Price types.Coin `protobuf:"bytes,2,opt,name=price,proto3" json:"price"`
+	// volume is the number of tokens of the marker that the price is for.
+	Volume uint64 `protobuf:"varint,3,opt,name=volume,proto3" json:"volume,omitempty"`
+}
+
+func (m *NAVAttestation) Reset()         { *m = NAVAttestation{} }
+func (m *NAVAttestation) String() string { return proto.CompactTextString(m) }
+func (*NAVAttestation) ProtoMessage()    {}
+func (*NAVAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b45c93d8358cc27, []int{1}
+}
+func (m *NAVAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NAVAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NAVAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NAVAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NAVAttestation.Merge(m, src)
+}
+func (m *NAVAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *NAVAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_NAVAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NAVAttestation proto.InternalMessageInfo
+
+func (m *NAVAttestation) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *NAVAttestation) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
+}
+
+func (m *NAVAttestation) GetVolume() uint64 {
+	if m != nil {
+		return m.Volume
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*NAVVoteExtension)(nil), "provenance.marker.v1.NAVVoteExtension")
+	proto.RegisterType((*NAVAttestation)(nil), "provenance.marker.v1.NAVAttestation")
+}
+
+func init() {
+	proto.RegisterFile("provenance/marker/v1/vote_extension.proto", fileDescriptor_1b45c93d8358cc27)
+}
+
+var fileDescriptor_1b45c93d8358cc27 = []byte{
+	// 309 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcf, 0x4a, 0xc3, 0x40,
+	0x10, 0xc6, 0xb3, 0xf6, 0x0f, 0xb8, 0x15, 0x91, 0x50, 0x34, 0xf6, 0xb0, 0x86, 0xe2, 0x21, 0x1e,
+	0xdc, 0x25, 0x15, 0x1f, 0xa0, 0x15, 0xaf, 0x45, 0x7a, 0xe8, 0xc1, 0x8b, 0x6c, 0xe2, 0x10, 0x17,
+	0xcd, 0x4e, 0xc8, 0x6e, 0x97, 0xfa, 0x16, 0x3e, 0x56, 0x8f, 0x3d, 0x7a, 0x12, 0x69, 0x5f, 0x44,
+	0xda, 0xb4, 0xd4, 0x42, 0x6f, 0xf3, 0xc1, 0x6f, 0xe6, 0xfb, 0x66, 0x86, 0xde, 0x14, 0x25, 0x3a,
+	0xd0, 0x52, 0xa7, 0x20, 0x72, 0x59, 0xbe, 0x43, 0x29, 0x5c, 0x2c, 0x1c, 0x5a, 0x78, 0x81, 0xa9,
+	0x05, 0x6d, 0x14, 0x6a, 0x5e, 0x94, 0x68, 0xd1, 0x6f, 0xef, 0x50, 0x5e, 0xa1, 0xdc, 0xc5, 0x9d,
+	0x76, 0x86, 0x19, 0xae, 0x01, 0xb1, 0xaa, 0x2a, 0xb6, 0xc3, 0x52, 0x34, 0x39, 0x1a, 0x91, 0x48,
+	0x03, 0xc2, 0xc5, 0x09, 0x58, 0x19, 0x8b, 0x14, 0xd5, 0x66, 0x56, 0x37, 0xa1, 0x67, 0xc3, 0xfe,
+	0x78, 0x8c, 0x16, 0x1e, 0xb7, 0x2e, 0xfe, 0x90, 0x9e, 0x48, 0x6b, 0xc1, 0x58, 0x69, 0x15, 0x6a,
+	0x13, 0x90, 0xb0, 0x16, 0xb5, 0x7a, 0xd7, 0xfc, 0x90, 0x2d, 0x1f, 0xf6, 0xc7, 0xfd, 0x1d, 0x3c,
+	0xa8, 0xcf, 0x7e, 0xae, 0xbc, 0xd1, 0x5e, 0x7f, 0x77, 0x42, 0x4f, 0xf7, 0x29, 0xbf, 0x4d, 0x1b,
+	0xaf, 0xa0, 0x31, 0x0f, 0x48, 0x48, 0xa2, 0xe3, 0x51, 0x25, 0xfc, 0x7b, 0xda, 0x28, 0x4a, 0x95,
+	0x42, 0x70, 0x14, 0x92, 0xa8, 0xd5, 0xbb, 0xe4, 0x55, 0x76, 0xbe, 0xca, 0xce, 0x37, 0xd9, 0xf9,
+	0x03, 0xaa, 0xad, 0x4b, 0x45, 0xfb, 0xe7, 0xb4, 0xe9, 0xf0, 0x63, 0x92, 0x43, 0x50, 0x0b, 0x49,
+	0x54, 0x1f, 0x6d, 0xd4, 0x20, 0x9b, 0x2d, 0x18, 0x99, 0x2f, 0x18, 0xf9, 0x5d, 0x30, 0xf2, 0xb5,
+	0x64, 0xde, 0x7c, 0xc9, 0xbc, 0xef, 0x25, 0xf3, 0xe8, 0x85, 0xc2, 0x83, 0xcb, 0x3c, 0x91, 0xe7,
+	0x5e, 0xa6, 0xec, 0xdb, 0x24, 0xe1, 0x29, 0xe6, 0x62, 0x87, 0xdc, 0x2a, 0xfc, 0xa7, 0xc4, 0x74,
+	0xfb, 0x21, 0xfb, 0x59, 0x80, 0x49, 0x9a, 0xeb, 0x53, 0xde, 0xfd, 0x0d, 0x00, 0x01, 0x25, 0x8d,
+	0xb4, 0xc3, 0x01, 0x00, 0x00,
+}
+
+func (m *NAVVoteExtension) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NAVVoteExtension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NAVVoteExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVoteExtension(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NAVAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NAVAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NAVAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Volume != 0 {
+		i = encodeVarintVoteExtension(dAtA, i, uint64(m.Volume))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVoteExtension(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintVoteExtension(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVoteExtension(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVoteExtension(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *NAVVoteExtension) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovVoteExtension(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NAVAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovVoteExtension(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovVoteExtension(uint64(l))
+	if m.Volume != 0 {
+		n += 1 + sovVoteExtension(uint64(m.Volume))
+	}
+	return n
+}
+
+func sovVoteExtension(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVoteExtension(x uint64) (n int) {
+	return sovVoteExtension(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *NAVVoteExtension) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoteExtension
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NAVVoteExtension: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NAVVoteExtension: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, NAVAttestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoteExtension(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NAVAttestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoteExtension
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NAVAttestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NAVAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			m.Volume = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Volume |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoteExtension(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipVoteExtension(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowVoteExtension
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthVoteExtension
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupVoteExtension
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthVoteExtension
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthVoteExtension        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowVoteExtension          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupVoteExtension = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+func TestNAVAttestationValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		attestation NAVAttestation
+		expErr      string
+	}{
+		{
+			name:        "valid",
+			attestation: NewNAVAttestation("navcoin", sdk.NewInt64Coin(UsdDenom, 1250), 1),
+		},
+		{
+			name:        "invalid denom",
+			attestation: NewNAVAttestation("x", sdk.NewInt64Coin(UsdDenom, 1250), 1),
+			expErr:      "invalid denom: invalid denom: x",
+		},
+		{
+			name:        "invalid price",
+			attestation: NewNAVAttestation("navcoin", sdk.Coin{Denom: "x", Amount: sdkmath.NewInt(1)}, 1),
+			expErr:      "invalid price: invalid denom: x",
+		},
+		{
+			name:        "zero price",
+			attestation: NewNAVAttestation("navcoin", sdk.NewInt64Coin(UsdDenom, 0), 1),
+			expErr:      `invalid price "0usd": must be positive`,
+		},
+		{
+			name:        "price denom is marker denom",
+			attestation: NewNAVAttestation("navcoin", sdk.NewInt64Coin("navcoin", 3), 1),
+			expErr:      `invalid price "3navcoin": price denom cannot match marker denom`,
+		},
+		{
+			name:        "zero volume",
+			attestation: NewNAVAttestation("navcoin", sdk.NewInt64Coin(UsdDenom, 1250), 0),
+			expErr:      "invalid volume: must be positive",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.attestation.Validate()
+			assertions.AssertErrorValue(t, err, tc.expErr, "Validate")
+		})
+	}
+}
+
+func TestNAVVoteExtensionValidate(t *testing.T) {
+	usd := NewNAVAttestation("navcoin", sdk.NewInt64Coin(UsdDenom, 1250), 1)
+	nhash := NewNAVAttestation("navcoin", sdk.NewInt64Coin("nhash", 8), 10)
+	tooMany := make([]NAVAttestation, MaxNAVAttestations+1)
+
+	tests := []struct {
+		name   string
+		ext    *NAVVoteExtension
+		expErr string
+	}{
+		{
+			name: "empty",
+			ext:  NewNAVVoteExtension(),
+		},
+		{
+			name: "two price denoms for same marker",
+			ext:  NewNAVVoteExtension(usd, nhash),
+		},
+		{
+			name:   "too many",
+			ext:    NewNAVVoteExtension(tooMany...),
+			expErr: "too many attestations 201: max is 200",
+		},
+		{
+			name:   "invalid attestation",
+			ext:    NewNAVVoteExtension(usd, NewNAVAttestation("navcoin", sdk.NewInt64Coin("nhash", 8), 0)),
+			expErr: "invalid attestations[1]: invalid volume: must be positive",
+		},
+		{
+			name:   "duplicate",
+			ext:    NewNAVVoteExtension(usd, nhash, usd),
+			expErr: "invalid attestations[2]: duplicate of attestations[0]: usd price of navcoin",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.ext.Validate()
+			assertions.AssertErrorValue(t, err, tc.expErr, "Validate")
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package voteext
+
+import (
+	"math/big"
+	"sort"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// attestationVote is a single validator's attestation along with that validator's voting power.
+type attestationVote struct {
+	attestation types.NAVAttestation
+	price       *big.Int
+	volume      *big.Int
+	power       int64
+}
+
+// newAttestationVote creates a new attestationVote.
+func newAttestationVote(attestation types.NAVAttestation, power int64) attestationVote {
+	return attestationVote{
+		attestation: attestation,
+		price:       attestation.Price.Amount.BigInt(),
+		volume:      new(big.Int).SetUint64(attestation.Volume),
+		power:       power,
+	}
+}
+
+// lessThan returns true if the unit price of this vote is less than the unit price of the other vote.
+func (v attestationVote) lessThan(other attestationVote) bool {
+	// a/b < c/d <=> a*d < c*b (since b and d are both positive).
+	return new(big.Int).Mul(v.price, other.volume).Cmp(new(big.Int).Mul(other.price, v.volume)) < 0
+}
+
+// isWithin returns true if the unit price of this vote is within the provided basis points of the median's unit price.
+func (v attestationVote) isWithin(median attestationVote, bps uint32) bool {
+	// |a/b - c/d| <= c/d * bps / 10000 <=> |a*d - c*b| * 10000 <= c*b * bps (since b and d are both positive).
+	diff := new(big.Int).Sub(new(big.Int).Mul(v.price, median.volume), new(big.Int).Mul(median.price, v.volume))
+	diff.Abs(diff).Mul(diff, big.NewInt(int64(types.MaxBasisPoints)))
+	limit := new(big.Int).Mul(median.price, v.volume)
+	limit.Mul(limit, big.NewInt(int64(bps)))
+	return diff.Cmp(limit) <= 0
+}
+
+// AggregateAttestations combines the attestations in the provided extended commit info into a single attestation
+// for each marker denom and price denom pair.
+//
+// The result for each pair is the stake-weighted median of the attestations. If maxDeviationBps is not zero,
+// attestations with a unit price more than that many basis points from the median are discarded and the median
+// is then recalculated from the rest. A result is only provided for a pair if the validators with non-discarded
+// attestations have more than half of the total voting power.
+//
+// The results are sorted by marker denom then price denom.
+func AggregateAttestations(extCommit abci.ExtendedCommitInfo, maxDeviationBps uint32) []types.NAVAttestation {
+	var totalPower int64
+	var keys []string
+	groups := make(map[string][]attestationVote)
+	for _, vote := range extCommit.Votes {
+		totalPower += vote.Validator.Power
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit {
+			continue
+		}
+		ext, err := DecodeVoteExtension(vote.VoteExtension)
+		if err != nil {
+			continue
+		}
+		for _, attestation := range ext.Attestations {
+			key := attestation.Denom + " " + attestation.Price.Denom
+			if _, known := groups[key]; !known {
+				keys = append(keys, key)
+			}
+			groups[key] = append(groups[key], newAttestationVote(attestation, vote.Validator.Power))
+		}
+	}
+	sort.Strings(keys)
+
+	var rv []types.NAVAttestation
+	for _, key := range keys {
+		votes := groups[key]
+		sort.SliceStable(votes, func(i, j int) bool {
+			return votes[i].lessThan(votes[j])
+		})
+
+		if maxDeviationBps > 0 {
+			median := weightedMedian(votes)
+			kept := make([]attestationVote, 0, len(votes))
+			for _, vote := range votes {
+				if vote.isWithin(median, maxDeviationBps) {
+					kept = append(kept, vote)
+				}
+			}
+			votes = kept
+		}
+
+		if sumPower(votes)*2 <= totalPower {
+			continue
+		}
+		rv = append(rv, weightedMedian(votes).attestation)
+	}
+	return rv
+}
+
+// weightedMedian returns the vote at the stake-weighted median of the provided (sorted) votes.
+func weightedMedian(votes []attestationVote) attestationVote {
+	total := sumPower(votes)
+	var cumulative int64
+	for _, vote := range votes {
+		cumulative += vote.power
+		if cumulative*2 >= total {
+			return vote
+		}
+	}
+	return votes[len(votes)-1]
+}
+
+// sumPower returns the total voting power of the provided votes.
+func sumPower(votes []attestationVote) int64 {
+	var rv int64
+	for _, vote := range votes {
+		rv += vote.power
+	}
+	return rv
+}
@@ -0,0 +1,190 @@
+package voteext_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+	"github.com/provenance-io/provenance/x/marker/voteext"
+)
+
+// usdNAV creates an attestation of the provided denom with a usd price.
+func usdNAV(denom string, mills int64, volume uint64) types.NAVAttestation {
+	return types.NewNAVAttestation(denom, sdk.NewInt64Coin(types.UsdDenom, mills), volume)
+}
+
+// newVote creates a commit vote from a validator with the provided power and attestations.
+func newVote(t *testing.T, power int64, attestations ...types.NAVAttestation) abci.ExtendedVoteInfo {
+	var bz []byte
+	if len(attestations) > 0 {
+		var err error
+		bz, err = types.NewNAVVoteExtension(attestations...).Marshal()
+		require.NoError(t, err, "Marshal vote extension")
+	}
+	return abci.ExtendedVoteInfo{
+		Validator:     abci.Validator{Address: []byte("validator"), Power: power},
+		VoteExtension: bz,
+		BlockIdFlag:   cmtproto.BlockIDFlagCommit,
+	}
+}
+
+// absentVote creates a vote from a validator with the provided power that didn't sign the last block.
+func absentVote(power int64) abci.ExtendedVoteInfo {
+	return abci.ExtendedVoteInfo{
+		Validator:   abci.Validator{Address: []byte("absent"), Power: power},
+		BlockIdFlag: cmtproto.BlockIDFlagAbsent,
+	}
+}
+
+func TestAggregateAttestations(t *testing.T) {
+	tests := []struct {
+		name            string
+		votes           func(t *testing.T) []abci.ExtendedVoteInfo
+		maxDeviationBps uint32
+		exp             []types.NAVAttestation
+	}{
+		{
+			name: "no votes",
+			votes: func(_ *testing.T) []abci.ExtendedVoteInfo {
+				return nil
+			},
+		},
+		{
+			name: "no attestations",
+			votes: func(t *testing.T) []abci.ExtendedVoteInfo {
+				return []abci.ExtendedVoteInfo{newVote(t, 10), newVote(t, 10)}
+			},
+		},
+		{
+			name: "one validator with all the power",
+			votes: func(t *testing.T) []abci.ExtendedVoteInfo {
+				return []abci.ExtendedVoteInfo{newVote(t, 10, usdNAV("navcoin", 1250, 1))}
+			},
+			exp: []types.NAVAttestation{usdNAV("navcoin", 1250, 1)},
+		},
+		{
+			name: "stake-weighted median",
+			votes: func(t *testing.T) []abci.ExtendedVoteInfo {
+				return []abci.ExtendedVoteInfo{
+					newVote(t, 10, usdNAV("navcoin", 1000, 1)),
+					newVote(t, 35, usdNAV("navcoin", 1200, 1)),
+					newVote(t, 20, usdNAV("navcoin", 1100, 1)),
+				}
+			},
+			exp: []types.NAVAttestation{usdNAV("navcoin", 1200, 1)},
+		},
+		{
+			name: "unit prices compared across volumes",
+			votes: func(t *testing.T) []abci.ExtendedVoteInfo {
+				return []abci.ExtendedVoteInfo{
+					newVote(t, 10, usdNAV("navcoin", 1000, 1)),
+					newVote(t, 10, usdNAV("navcoin", 10500, 10)),
+					newVote(t, 10, usdNAV("navcoin", 330, 3)),
+				}
+			},
+			exp: []types.NAVAttestation{usdNAV("navcoin", 1000, 1)},
+		},
+		{
+			name: "outlier discarded, others agree",
+			votes: func(t *testing.T) []abci.ExtendedVoteInfo {
+				return []abci.ExtendedVoteInfo{
+					newVote(t, 10, usdNAV("navcoin", 1000, 1)),
+					newVote(t, 10, usdNAV("navcoin", 1010, 1)),
+					newVote(t, 10, usdNAV("navcoin", 1020, 1)),
+					newVote(t, 15, usdNAV("navcoin", 9000, 1)),
+				}
+			},
+			maxDeviationBps: 500,
+			exp:             []types.NAVAttestation{usdNAV("navcoin", 1010, 1)},
+		},
+		{
+			name: "outlier kept without max deviation",
+			votes: func(t *testing.T) []abci.ExtendedVoteInfo {
+				return []abci.ExtendedVoteInfo{
+					newVote(t, 10, usdNAV("navcoin", 1000, 1)),
+					newVote(t, 10, usdNAV("navcoin", 1010, 1)),
+					newVote(t, 10, usdNAV("navcoin", 1020, 1)),
+					newVote(t, 15, usdNAV("navcoin", 9000, 1)),
+				}
+			},
+			exp: []types.NAVAttestation{usdNAV("navcoin", 1020, 1)},
+		},
+		{
+			name: "attestations too spread out",
+			votes: func(t *testing.T) []abci.ExtendedVoteInfo {
+				return []abci.ExtendedVoteInfo{
+					newVote(t, 10, usdNAV("navcoin", 1000, 1)),
+					newVote(t, 10, usdNAV("navcoin", 2000, 1)),
+					newVote(t, 10, usdNAV("navcoin", 3000, 1)),
+				}
+			},
+			maxDeviationBps: 500,
+		},
+		{
+			name: "half the power is not enough",
+			votes: func(t *testing.T) []abci.ExtendedVoteInfo {
+				return []abci.ExtendedVoteInfo{
+					newVote(t, 10, usdNAV("navcoin", 1000, 1)),
+					newVote(t, 5),
+					absentVote(5),
+				}
+			},
+		},
+		{
+			name: "absent validators count towards total power",
+			votes: func(t *testing.T) []abci.ExtendedVoteInfo {
+				return []abci.ExtendedVoteInfo{
+					newVote(t, 11, usdNAV("navcoin", 1000, 1)),
+					absentVote(10),
+				}
+			},
+			exp: []types.NAVAttestation{usdNAV("navcoin", 1000, 1)},
+		},
+		{
+			name: "invalid vote extension ignored",
+			votes: func(t *testing.T) []abci.ExtendedVoteInfo {
+				bad := newVote(t, 10)
+				bad.VoteExtension = []byte("not a vote extension")
+				return []abci.ExtendedVoteInfo{newVote(t, 11, usdNAV("navcoin", 1000, 1)), bad}
+			},
+			exp: []types.NAVAttestation{usdNAV("navcoin", 1000, 1)},
+		},
+		{
+			name: "multiple markers and price denoms",
+			votes: func(t *testing.T) []abci.ExtendedVoteInfo {
+				nhash := func(amt int64) types.NAVAttestation {
+					return types.NewNAVAttestation("bcoin", sdk.NewInt64Coin("nhash", amt), 1)
+				}
+				return []abci.ExtendedVoteInfo{
+					newVote(t, 10, usdNAV("bcoin", 50, 1), nhash(7), usdNAV("acoin", 5, 1)),
+					newVote(t, 10, usdNAV("bcoin", 51, 1), nhash(8), usdNAV("acoin", 6, 1)),
+					newVote(t, 10, usdNAV("bcoin", 52, 1), nhash(9)),
+				}
+			},
+			exp: []types.NAVAttestation{
+				usdNAV("acoin", 5, 1),
+				types.NewNAVAttestation("bcoin", sdk.NewInt64Coin("nhash", 8), 1),
+				usdNAV("bcoin", 51, 1),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			extCommit := abci.ExtendedCommitInfo{Votes: tc.votes(t)}
+			var act []types.NAVAttestation
+			testFunc := func() {
+				act = voteext.AggregateAttestations(extCommit, tc.maxDeviationBps)
+			}
+			require.NotPanics(t, testFunc, "AggregateAttestations")
+			assert.Equal(t, tc.exp, act, "AggregateAttestations result")
+		})
+	}
+}
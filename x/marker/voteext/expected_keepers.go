@@ -0,0 +1,14 @@
+package voteext
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// MarkerKeeper defines the marker functionality needed by the vote extension handlers.
+type MarkerKeeper interface {
+	GetParams(ctx sdk.Context) types.Params
+	GetMarkerByDenom(ctx sdk.Context, denom string) (types.MarkerAccountI, error)
+	AddSetNetAssetValues(ctx sdk.Context, marker types.MarkerAccountI, netAssetValues []types.NetAssetValue, source string) error
+}
@@ -0,0 +1,190 @@
+package voteext
+
+import (
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// Handler provides the ABCI++ handlers that let validators attest to marker net asset values in their vote extensions.
+//
+// Each validator includes the prices from its PriceProvider in its vote extension. The proposer of the next block
+// injects the extended commit info as the first tx of its proposal. The other validators verify that injected commit
+// info in ProcessProposal. Then, in the PreBlocker, the attestations are aggregated and the results are recorded as
+// marker net asset values.
+type Handler struct {
+	markerKeeper MarkerKeeper
+	valStore     baseapp.ValidatorStore
+	provider     PriceProvider
+}
+
+// NewHandler creates a new vote extension Handler. The provider can be nil if this node doesn't attest to any prices.
+func NewHandler(markerKeeper MarkerKeeper, valStore baseapp.ValidatorStore, provider PriceProvider) *Handler {
+	return &Handler{
+		markerKeeper: markerKeeper,
+		valStore:     valStore,
+		provider:     provider,
+	}
+}
+
+// ExtendVoteHandler returns the handler that adds this validator's attestations to its pre-commit vote.
+// Problems getting the attestations are logged and result in an empty vote extension.
+func (h *Handler) ExtendVoteHandler() sdk.ExtendVoteHandler {
+	return func(ctx sdk.Context, _ *abci.RequestExtendVote) (*abci.ResponseExtendVote, error) {
+		if h.provider == nil || !h.markerKeeper.GetParams(ctx).EnableNavAttestations {
+			return &abci.ResponseExtendVote{}, nil
+		}
+
+		attestations, err := h.provider.GetNAVAttestations(ctx)
+		if err != nil {
+			ctx.Logger().Error("Could not get net asset value attestations.", "error", err)
+			return &abci.ResponseExtendVote{}, nil
+		}
+
+		ext := types.NewNAVVoteExtension(attestations...)
+		if err = ext.Validate(); err != nil {
+			ctx.Logger().Error("Invalid net asset value attestations.", "error", err)
+			return &abci.ResponseExtendVote{}, nil
+		}
+
+		bz, err := ext.Marshal()
+		if err != nil {
+			return nil, fmt.Errorf("could not marshal net asset value vote extension: %w", err)
+		}
+		return &abci.ResponseExtendVote{VoteExtension: bz}, nil
+	}
+}
+
+// VerifyVoteExtensionHandler returns the handler that checks the vote extensions of the other validators.
+// Empty vote extensions are always accepted.
+func (h *Handler) VerifyVoteExtensionHandler() sdk.VerifyVoteExtensionHandler {
+	return func(ctx sdk.Context, req *abci.RequestVerifyVoteExtension) (*abci.ResponseVerifyVoteExtension, error) {
+		if _, err := DecodeVoteExtension(req.VoteExtension); err != nil {
+			ctx.Logger().Error("Rejecting vote extension.", "validator", fmt.Sprintf("%X", req.ValidatorAddress), "error", err)
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+		}
+		return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+	}
+}
+
+// PrepareProposalHandler wraps the provided handler so that the extended commit info is injected as the first tx.
+func (h *Handler) PrepareProposalHandler(next sdk.PrepareProposalHandler) sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		if !h.attestationsEnabled(ctx, req.Height) {
+			return next(ctx, req)
+		}
+
+		bz, err := req.LocalLastCommit.Marshal()
+		if err != nil {
+			return nil, fmt.Errorf("could not marshal extended commit info: %w", err)
+		}
+
+		nextReq := *req
+		nextReq.MaxTxBytes -= int64(len(bz))
+		resp, err := next(ctx, &nextReq)
+		if err != nil {
+			return nil, err
+		}
+		resp.Txs = append([][]byte{bz}, resp.Txs...)
+		return resp, nil
+	}
+}
+
+// ProcessProposalHandler wraps the provided handler so that the injected extended commit info is verified.
+// The provided handler is given the rest of the txs.
+func (h *Handler) ProcessProposalHandler(next sdk.ProcessProposalHandler) sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		if !h.attestationsEnabled(ctx, req.Height) {
+			return next(ctx, req)
+		}
+
+		if err := h.validateInjectedCommit(ctx, req.Height, req.Txs); err != nil {
+			ctx.Logger().Error("Rejecting proposal.", "height", req.Height, "error", err)
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+		}
+
+		nextReq := *req
+		nextReq.Txs = req.Txs[1:]
+		return next(ctx, &nextReq)
+	}
+}
+
+// PreBlocker aggregates the attestations in the injected extended commit info and records the resulting net asset values.
+// Problems are logged instead of being returned so that they don't halt the chain.
+func (h *Handler) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock) {
+	if !h.attestationsEnabled(ctx, req.Height) || len(req.Txs) == 0 {
+		return
+	}
+
+	var extCommit abci.ExtendedCommitInfo
+	if err := extCommit.Unmarshal(req.Txs[0]); err != nil {
+		ctx.Logger().Error("Could not decode injected extended commit info.", "error", err)
+		return
+	}
+
+	maxDeviationBps := h.markerKeeper.GetParams(ctx).NavAttestationMaxDeviationBps
+	for _, attestation := range AggregateAttestations(extCommit, maxDeviationBps) {
+		marker, err := h.markerKeeper.GetMarkerByDenom(ctx, attestation.Denom)
+		if err != nil {
+			ctx.Logger().Debug("Ignoring net asset value attestation of unknown marker.", "denom", attestation.Denom, "error", err)
+			continue
+		}
+		navs := []types.NetAssetValue{attestation.GetNetAssetValue()}
+		if err = h.markerKeeper.AddSetNetAssetValues(ctx, marker, navs, types.NAVAttestationSource); err != nil {
+			ctx.Logger().Error("Could not record attested net asset value.", "denom", attestation.Denom, "error", err)
+		}
+	}
+}
+
+// attestationsEnabled returns true if vote extensions are enabled for the provided height, and the
+// marker module is configured to use the net asset value attestations.
+func (h *Handler) attestationsEnabled(ctx sdk.Context, height int64) bool {
+	cp := ctx.ConsensusParams()
+	// Vote extensions are first available at the height after the one they're enabled at.
+	if cp.Abci == nil || cp.Abci.VoteExtensionsEnableHeight == 0 || height <= cp.Abci.VoteExtensionsEnableHeight {
+		return false
+	}
+	return h.markerKeeper.GetParams(ctx).EnableNavAttestations
+}
+
+// validateInjectedCommit returns an error if the first of the provided txs isn't a valid extended commit info.
+func (h *Handler) validateInjectedCommit(ctx sdk.Context, height int64, txs [][]byte) error {
+	if len(txs) == 0 {
+		return fmt.Errorf("missing injected extended commit info")
+	}
+
+	var extCommit abci.ExtendedCommitInfo
+	if err := extCommit.Unmarshal(txs[0]); err != nil {
+		return fmt.Errorf("could not decode injected extended commit info: %w", err)
+	}
+	if err := baseapp.ValidateVoteExtensions(ctx, h.valStore, height, ctx.ChainID(), extCommit); err != nil {
+		return fmt.Errorf("invalid injected extended commit info: %w", err)
+	}
+	for _, vote := range extCommit.Votes {
+		if _, err := DecodeVoteExtension(vote.VoteExtension); err != nil {
+			return fmt.Errorf("invalid vote extension from validator %X: %w", vote.Validator.Address, err)
+		}
+	}
+	return nil
+}
+
+// DecodeVoteExtension unmarshals and validates the provided vote extension.
+// An empty vote extension is returned if the provided bytes are empty.
+func DecodeVoteExtension(bz []byte) (*types.NAVVoteExtension, error) {
+	ext := &types.NAVVoteExtension{}
+	if len(bz) == 0 {
+		return ext, nil
+	}
+	if err := ext.Unmarshal(bz); err != nil {
+		return nil, fmt.Errorf("could not decode vote extension: %w", err)
+	}
+	if err := ext.Validate(); err != nil {
+		return nil, err
+	}
+	return ext, nil
+}
@@ -0,0 +1,292 @@
+package voteext_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/marker/types"
+	"github.com/provenance-io/provenance/x/marker/voteext"
+)
+
+// mockPriceProvider is a PriceProvider that returns pre-defined results.
+type mockPriceProvider struct {
+	attestations []types.NAVAttestation
+	err          error
+}
+
+func (p mockPriceProvider) GetNAVAttestations(_ sdk.Context) ([]types.NAVAttestation, error) {
+	return p.attestations, p.err
+}
+
+type HandlerTestSuite struct {
+	suite.Suite
+
+	app *app.App
+	ctx sdk.Context
+}
+
+func (s *HandlerTestSuite) SetupTest() {
+	s.app = app.Setup(s.T())
+	s.ctx = s.app.BaseApp.NewContextLegacy(false, cmtproto.Header{Time: time.Now(), Height: 10}).
+		WithConsensusParams(cmtproto.ConsensusParams{Abci: &cmtproto.ABCIParams{VoteExtensionsEnableHeight: 5}})
+	s.setNAVAttestationsEnabled(true)
+
+	marker := &types.MarkerAccount{
+		BaseAccount: authtypes.NewBaseAccountWithAddress(types.MustGetMarkerAddress("navcoin")),
+		Manager:     sdk.AccAddress("manager_____________").String(),
+		Status:      types.StatusActive,
+		Denom:       "navcoin",
+		Supply:      sdkmath.NewInt(1000),
+		MarkerType:  types.MarkerType_Coin,
+	}
+	s.Require().NoError(s.app.MarkerKeeper.AddMarkerAccount(s.ctx, marker), "AddMarkerAccount")
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+// setNAVAttestationsEnabled sets the marker module's enable_nav_attestations param.
+func (s *HandlerTestSuite) setNAVAttestationsEnabled(enabled bool) {
+	params := s.app.MarkerKeeper.GetParams(s.ctx)
+	params.EnableNavAttestations = enabled
+	s.app.MarkerKeeper.SetParams(s.ctx, params)
+}
+
+// newHandler creates a new vote extension handler with the provided price provider.
+func (s *HandlerTestSuite) newHandler(provider voteext.PriceProvider) *voteext.Handler {
+	return voteext.NewHandler(s.app.MarkerKeeper, s.app.StakingKeeper, provider)
+}
+
+func (s *HandlerTestSuite) TestExtendVote() {
+	attestation := usdNAV("navcoin", 1250, 1)
+	expExt, err := types.NewNAVVoteExtension(attestation).Marshal()
+	s.Require().NoError(err, "Marshal vote extension")
+
+	tests := []struct {
+		name     string
+		provider voteext.PriceProvider
+		disabled bool
+		exp      []byte
+	}{
+		{
+			name:     "no provider",
+			provider: nil,
+		},
+		{
+			name:     "disabled",
+			provider: mockPriceProvider{attestations: []types.NAVAttestation{attestation}},
+			disabled: true,
+		},
+		{
+			name:     "provider error",
+			provider: mockPriceProvider{err: errors.New("injected error")},
+		},
+		{
+			name:     "invalid attestation",
+			provider: mockPriceProvider{attestations: []types.NAVAttestation{usdNAV("navcoin", 1250, 0)}},
+		},
+		{
+			name:     "valid attestation",
+			provider: mockPriceProvider{attestations: []types.NAVAttestation{attestation}},
+			exp:      expExt,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.setNAVAttestationsEnabled(!tc.disabled)
+			defer s.setNAVAttestationsEnabled(true)
+
+			resp, err := s.newHandler(tc.provider).ExtendVoteHandler()(s.ctx, &abci.RequestExtendVote{Height: 10})
+			s.Require().NoError(err, "ExtendVoteHandler")
+			s.Assert().Equal(tc.exp, resp.VoteExtension, "VoteExtension")
+		})
+	}
+}
+
+func (s *HandlerTestSuite) TestVerifyVoteExtension() {
+	valid, err := types.NewNAVVoteExtension(usdNAV("navcoin", 1250, 1)).Marshal()
+	s.Require().NoError(err, "Marshal valid vote extension")
+	invalid, err := types.NewNAVVoteExtension(usdNAV("navcoin", 1250, 0)).Marshal()
+	s.Require().NoError(err, "Marshal invalid vote extension")
+
+	tests := []struct {
+		name string
+		ext  []byte
+		exp  abci.ResponseVerifyVoteExtension_VerifyStatus
+	}{
+		{name: "empty", ext: nil, exp: abci.ResponseVerifyVoteExtension_ACCEPT},
+		{name: "valid", ext: valid, exp: abci.ResponseVerifyVoteExtension_ACCEPT},
+		{name: "invalid attestation", ext: invalid, exp: abci.ResponseVerifyVoteExtension_REJECT},
+		{name: "not a vote extension", ext: []byte("not a vote extension"), exp: abci.ResponseVerifyVoteExtension_REJECT},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			req := &abci.RequestVerifyVoteExtension{Height: 10, VoteExtension: tc.ext}
+			resp, err := s.newHandler(nil).VerifyVoteExtensionHandler()(s.ctx, req)
+			s.Require().NoError(err, "VerifyVoteExtensionHandler")
+			s.Assert().Equal(tc.exp.String(), resp.Status.String(), "Status")
+		})
+	}
+}
+
+func (s *HandlerTestSuite) TestPrepareProposal() {
+	localLastCommit := abci.ExtendedCommitInfo{Votes: []abci.ExtendedVoteInfo{newVote(s.T(), 10, usdNAV("navcoin", 1250, 1))}}
+	injected, err := localLastCommit.Marshal()
+	s.Require().NoError(err, "Marshal extended commit info")
+
+	var nextReq *abci.RequestPrepareProposal
+	next := func(_ sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		nextReq = req
+		return &abci.ResponsePrepareProposal{Txs: req.Txs}, nil
+	}
+	handler := s.newHandler(nil).PrepareProposalHandler(next)
+
+	tests := []struct {
+		name          string
+		height        int64
+		disabled      bool
+		expTxs        [][]byte
+		expMaxTxBytes int64
+	}{
+		{
+			name:          "vote extensions not enabled yet",
+			height:        5,
+			expTxs:        [][]byte{[]byte("tx1")},
+			expMaxTxBytes: 1000,
+		},
+		{
+			name:          "attestations disabled",
+			height:        10,
+			disabled:      true,
+			expTxs:        [][]byte{[]byte("tx1")},
+			expMaxTxBytes: 1000,
+		},
+		{
+			name:          "commit info injected",
+			height:        10,
+			expTxs:        [][]byte{injected, []byte("tx1")},
+			expMaxTxBytes: 1000 - int64(len(injected)),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.setNAVAttestationsEnabled(!tc.disabled)
+			defer s.setNAVAttestationsEnabled(true)
+
+			nextReq = nil
+			req := &abci.RequestPrepareProposal{
+				Height:          tc.height,
+				MaxTxBytes:      1000,
+				Txs:             [][]byte{[]byte("tx1")},
+				LocalLastCommit: localLastCommit,
+			}
+			resp, err := handler(s.ctx, req)
+			s.Require().NoError(err, "PrepareProposalHandler")
+			s.Assert().Equal(tc.expTxs, resp.Txs, "Txs")
+			if s.Assert().NotNil(nextReq, "request given to next handler") {
+				s.Assert().Equal(tc.expMaxTxBytes, nextReq.MaxTxBytes, "MaxTxBytes given to next handler")
+			}
+		})
+	}
+}
+
+func (s *HandlerTestSuite) TestProcessProposal() {
+	var nextTxs [][]byte
+	next := func(_ sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		nextTxs = req.Txs
+		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+	}
+	handler := s.newHandler(nil).ProcessProposalHandler(next)
+
+	tests := []struct {
+		name       string
+		height     int64
+		txs        [][]byte
+		expStatus  abci.ResponseProcessProposal_ProposalStatus
+		expNextTxs [][]byte
+	}{
+		{
+			name:       "vote extensions not enabled yet",
+			height:     5,
+			txs:        [][]byte{[]byte("tx1")},
+			expStatus:  abci.ResponseProcessProposal_ACCEPT,
+			expNextTxs: [][]byte{[]byte("tx1")},
+		},
+		{
+			name:      "no txs",
+			height:    10,
+			expStatus: abci.ResponseProcessProposal_REJECT,
+		},
+		{
+			name:      "first tx is not a commit info",
+			height:    10,
+			txs:       [][]byte{[]byte("not a commit info")},
+			expStatus: abci.ResponseProcessProposal_REJECT,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			nextTxs = nil
+			req := &abci.RequestProcessProposal{Height: tc.height, Txs: tc.txs}
+			resp, err := handler(s.ctx, req)
+			s.Require().NoError(err, "ProcessProposalHandler")
+			s.Assert().Equal(tc.expStatus.String(), resp.Status.String(), "Status")
+			s.Assert().Equal(tc.expNextTxs, nextTxs, "txs given to next handler")
+		})
+	}
+}
+
+func (s *HandlerTestSuite) TestPreBlocker() {
+	extCommit := abci.ExtendedCommitInfo{Votes: []abci.ExtendedVoteInfo{
+		newVote(s.T(), 10, usdNAV("navcoin", 1250, 1), usdNAV("unknowncoin", 5, 1)),
+		newVote(s.T(), 10, usdNAV("navcoin", 1300, 1)),
+		newVote(s.T(), 10, usdNAV("navcoin", 1200, 1)),
+	}}
+	injected, err := extCommit.Marshal()
+	s.Require().NoError(err, "Marshal extended commit info")
+	req := &abci.RequestFinalizeBlock{Height: 10, Txs: [][]byte{injected, []byte("tx1")}}
+
+	s.Run("attestations disabled", func() {
+		s.setNAVAttestationsEnabled(false)
+		defer s.setNAVAttestationsEnabled(true)
+
+		s.newHandler(nil).PreBlocker(s.ctx, req)
+		nav, err := s.app.MarkerKeeper.GetNetAssetValue(s.ctx, "navcoin", types.UsdDenom)
+		s.Require().NoError(err, "GetNetAssetValue")
+		s.Assert().Nil(nav, "navcoin usd net asset value")
+	})
+
+	s.Run("attestations recorded", func() {
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		s.newHandler(nil).PreBlocker(ctx, req)
+		nav, err := s.app.MarkerKeeper.GetNetAssetValue(ctx, "navcoin", types.UsdDenom)
+		s.Require().NoError(err, "GetNetAssetValue")
+		if s.Assert().NotNil(nav, "navcoin usd net asset value") {
+			s.Assert().Equal(sdk.NewInt64Coin(types.UsdDenom, 1250).String(), nav.Price.String(), "price")
+			s.Assert().Equal(1, int(nav.Volume), "volume")
+			s.Assert().Equal(10, int(nav.UpdatedBlockHeight), "updated block height")
+		}
+
+		expEvent, err := sdk.TypedEventToEvent(types.NewEventSetNetAssetValue("navcoin",
+			sdk.NewInt64Coin(types.UsdDenom, 1250), 1, types.NAVAttestationSource))
+		s.Require().NoError(err, "TypedEventToEvent")
+		s.Assert().Equal(sdk.Events{expEvent}, ctx.EventManager().Events(), "events")
+	})
+}
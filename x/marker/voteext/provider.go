@@ -0,0 +1,81 @@
+package voteext
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+const (
+	// FlagNAVPriceFile is the app option with the path to the file of net asset values this validator attests to.
+	FlagNAVPriceFile = "nav-price-file"
+	// MaxPriceFileAge is how long the price file can go without being updated before it is considered stale.
+	MaxPriceFileAge = 10 * time.Minute
+)
+
+// PriceProvider provides the off-chain reference prices that a validator attests to in its vote extensions.
+type PriceProvider interface {
+	GetNAVAttestations(ctx sdk.Context) ([]types.NAVAttestation, error)
+}
+
+// FilePriceProvider is a PriceProvider that reads the attestations from a json file.
+// The file has the same format as a NAVVoteExtension, e.g.
+// {"attestations":[{"denom":"mycoin","price":{"denom":"usd","amount":"1250"},"volume":"1"}]}.
+// It's expected that some other process on the validator keeps this file up to date.
+type FilePriceProvider struct {
+	cdc  codec.Codec
+	path string
+}
+
+var _ PriceProvider = (*FilePriceProvider)(nil)
+
+// NewFilePriceProvider creates a new FilePriceProvider that reads the provided file.
+func NewFilePriceProvider(cdc codec.Codec, path string) *FilePriceProvider {
+	return &FilePriceProvider{cdc: cdc, path: path}
+}
+
+// GetNAVAttestations reads the attestations from this provider's file.
+// An error is returned if the file hasn't been updated in the last MaxPriceFileAge.
+func (p FilePriceProvider) GetNAVAttestations(_ sdk.Context) ([]types.NAVAttestation, error) {
+	info, err := os.Stat(p.path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read nav price file: %w", err)
+	}
+	if age := time.Since(info.ModTime()); age > MaxPriceFileAge {
+		return nil, fmt.Errorf("nav price file %q is stale: last updated %s ago", p.path, age.Truncate(time.Second))
+	}
+
+	bz, err := os.ReadFile(p.path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read nav price file: %w", err)
+	}
+	var ext types.NAVVoteExtension
+	if err = p.cdc.UnmarshalJSON(bz, &ext); err != nil {
+		return nil, fmt.Errorf("could not parse nav price file %q: %w", p.path, err)
+	}
+	return ext.Attestations, nil
+}
+
+// NewPriceProviderFromAppOpts returns the PriceProvider defined by the provided app options.
+// Returns nil if this node isn't configured to attest to any prices.
+func NewPriceProviderFromAppOpts(cdc codec.Codec, appOpts servertypes.AppOptions) PriceProvider {
+	path := cast.ToString(appOpts.Get(FlagNAVPriceFile))
+	if len(path) == 0 {
+		return nil
+	}
+	return NewFilePriceProvider(cdc, path)
+}
+
+// AddModuleInitFlags adds the vote extension flags to the start command.
+func AddModuleInitFlags(startCmd *cobra.Command) {
+	startCmd.Flags().String(FlagNAVPriceFile, "", "A json file with the net asset values this validator attests to in its vote extensions")
+}
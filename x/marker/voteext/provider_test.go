@@ -0,0 +1,88 @@
+package voteext_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/testutil/assertions"
+	"github.com/provenance-io/provenance/x/marker/types"
+	"github.com/provenance-io/provenance/x/marker/voteext"
+)
+
+func TestFilePriceProvider(t *testing.T) {
+	cdc := app.MakeTestEncodingConfig(t).Marshaler
+	dir := t.TempDir()
+	writeFile := func(name, contents string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(contents), 0o644), "WriteFile(%q)", name)
+		return path
+	}
+
+	valid := writeFile("valid.json", `{"attestations":[`+
+		`{"denom":"navcoin","price":{"denom":"usd","amount":"1250"},"volume":"1"},`+
+		`{"denom":"navcoin","price":{"denom":"nhash","amount":"80"},"volume":"10"}]}`)
+	stale := writeFile("stale.json", `{"attestations":[]}`)
+	staleTime := time.Now().Add(-1 * (voteext.MaxPriceFileAge + time.Minute))
+	require.NoError(t, os.Chtimes(stale, staleTime, staleTime), "Chtimes(stale.json)")
+	invalid := writeFile("invalid.json", `not json`)
+
+	tests := []struct {
+		name   string
+		path   string
+		exp    []types.NAVAttestation
+		expErr []string
+	}{
+		{
+			name: "valid",
+			path: valid,
+			exp: []types.NAVAttestation{
+				usdNAV("navcoin", 1250, 1),
+				types.NewNAVAttestation("navcoin", sdk.NewInt64Coin("nhash", 80), 10),
+			},
+		},
+		{
+			name:   "missing",
+			path:   filepath.Join(dir, "missing.json"),
+			expErr: []string{"could not read nav price file: stat " + filepath.Join(dir, "missing.json") + ": no such file or directory"},
+		},
+		{
+			name:   "stale",
+			path:   stale,
+			expErr: []string{`nav price file "` + stale + `" is stale`},
+		},
+		{
+			name:   "invalid",
+			path:   invalid,
+			expErr: []string{`could not parse nav price file "` + invalid + `"`},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			provider := voteext.NewFilePriceProvider(cdc, tc.path)
+			act, err := provider.GetNAVAttestations(sdk.Context{})
+			assertions.AssertErrorContents(t, err, tc.expErr, "GetNAVAttestations error")
+			assert.Equal(t, tc.exp, act, "GetNAVAttestations result")
+		})
+	}
+}
+
+func TestNewPriceProviderFromAppOpts(t *testing.T) {
+	cdc := app.MakeTestEncodingConfig(t).Marshaler
+
+	provider := voteext.NewPriceProviderFromAppOpts(cdc, simtestutil.EmptyAppOptions{})
+	assert.Nil(t, provider, "NewPriceProviderFromAppOpts without a price file")
+
+	appOpts := simtestutil.AppOptionsMap{voteext.FlagNAVPriceFile: "prices.json"}
+	provider = voteext.NewPriceProviderFromAppOpts(cdc, appOpts)
+	assert.Equal(t, voteext.NewFilePriceProvider(cdc, "prices.json"), provider, "NewPriceProviderFromAppOpts with a price file")
+}
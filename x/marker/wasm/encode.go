@@ -0,0 +1,121 @@
+// Package wasm supports smart contract integration with the provenance marker module.
+package wasm
+
+import (
+	"encoding/json"
+	"fmt"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/internal/provwasm"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// MarkerMsgParams are the params for marker module messages sent from a smart contract.
+// Exactly one field must be set.
+type MarkerMsgParams struct {
+	// Mint new coin into a marker's supply.
+	Mint *MintCoinParams `json:"mint_marker_supply,omitempty"`
+	// Burn coin from a marker's supply.
+	Burn *BurnCoinParams `json:"burn_marker_supply,omitempty"`
+	// Withdraw coin from a marker account.
+	Withdraw *WithdrawParams `json:"withdraw_coins,omitempty"`
+	// Transfer restricted coin between accounts.
+	Transfer *TransferParams `json:"transfer_marker_coins,omitempty"`
+}
+
+// count returns the number of message fields that are set.
+func (p MarkerMsgParams) count() int {
+	rv := 0
+	for _, isSet := range []bool{p.Mint != nil, p.Burn != nil, p.Withdraw != nil, p.Transfer != nil} {
+		if isSet {
+			rv++
+		}
+	}
+	return rv
+}
+
+// MintCoinParams are the params for minting coin into a marker's supply.
+type MintCoinParams struct {
+	// Coin is the amount to mint.
+	Coin sdk.Coin `json:"coin"`
+	// Recipient is an optional account to receive the minted coin. If empty, it stays in the marker account.
+	Recipient string `json:"recipient,omitempty"`
+}
+
+// BurnCoinParams are the params for burning coin from a marker's supply.
+type BurnCoinParams struct {
+	// Coin is the amount to burn.
+	Coin sdk.Coin `json:"coin"`
+}
+
+// WithdrawParams are the params for withdrawing coin from a marker account.
+type WithdrawParams struct {
+	// MarkerDenom is the denom of the marker to withdraw from.
+	MarkerDenom string `json:"marker_denom"`
+	// Coin is the amount to withdraw.
+	Coin sdk.Coin `json:"coin"`
+	// Recipient is the account to receive the coin.
+	Recipient string `json:"recipient"`
+}
+
+// TransferParams are the params for transferring restricted coin between accounts.
+type TransferParams struct {
+	// Coin is the amount to transfer.
+	Coin sdk.Coin `json:"coin"`
+	// From is the account to transfer the coin from.
+	From string `json:"from"`
+	// To is the account to transfer the coin to.
+	To string `json:"to"`
+}
+
+// Encoder returns a smart contract message encoder for the marker module.
+func Encoder(contract sdk.AccAddress, msg json.RawMessage, _ string) ([]sdk.Msg, error) {
+	var params MarkerMsgParams
+	if err := json.Unmarshal(msg, &params); err != nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: fmt.Sprintf("invalid marker msg params: %v", err), Request: msg}
+	}
+
+	if params.count() > 1 {
+		return nil, wasmvmtypes.InvalidRequest{Err: "invalid marker msg params: only one message can be provided", Request: msg}
+	}
+
+	var encoded sdk.Msg
+	switch {
+	case params.Mint != nil:
+		encoded = &types.MsgMintRequest{
+			Amount:        params.Mint.Coin,
+			Administrator: contract.String(),
+			Recipient:     params.Mint.Recipient,
+		}
+	case params.Burn != nil:
+		encoded = types.NewMsgBurnRequest(contract, params.Burn.Coin)
+	case params.Withdraw != nil:
+		encoded = &types.MsgWithdrawRequest{
+			Denom:         params.Withdraw.MarkerDenom,
+			Administrator: contract.String(),
+			ToAddress:     params.Withdraw.Recipient,
+			Amount:        sdk.NewCoins(params.Withdraw.Coin),
+		}
+	case params.Transfer != nil:
+		encoded = &types.MsgTransferRequest{
+			Amount:        params.Transfer.Coin,
+			Administrator: contract.String(),
+			FromAddress:   params.Transfer.From,
+			ToAddress:     params.Transfer.To,
+		}
+	default:
+		return nil, wasmvmtypes.InvalidRequest{Err: "invalid marker msg params: no message provided", Request: msg}
+	}
+
+	if vb, ok := encoded.(interface{ ValidateBasic() error }); ok {
+		if err := vb.ValidateBasic(); err != nil {
+			return nil, wasmvmtypes.InvalidRequest{Err: fmt.Sprintf("invalid marker msg: %v", err), Request: msg}
+		}
+	}
+	return []sdk.Msg{encoded}, nil
+}
+
+var _ provwasm.Encoder = Encoder
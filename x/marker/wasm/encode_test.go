@@ -0,0 +1,90 @@
+package wasm
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+	"github.com/provenance-io/provenance/testutil/fixtures"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestEncoder(t *testing.T) {
+	contract := sdk.AccAddress("contract____________")
+	addr1 := sdk.AccAddress("addr1_______________").String()
+	addr2 := sdk.AccAddress("addr2_______________").String()
+
+	tests := []struct {
+		name   string
+		msg    string
+		exp    []sdk.Msg
+		expErr []string
+	}{
+		{
+			name: "mint",
+			msg:  `{"mint_marker_supply":{"coin":{"denom":"apple","amount":"10"},"recipient":"` + addr1 + `"}}`,
+			exp: []sdk.Msg{&types.MsgMintRequest{
+				Amount:        fixtures.Coin("10apple"),
+				Administrator: contract.String(),
+				Recipient:     addr1,
+			}},
+		},
+		{
+			name: "burn",
+			msg:  `{"burn_marker_supply":{"coin":{"denom":"apple","amount":"3"}}}`,
+			exp:  []sdk.Msg{types.NewMsgBurnRequest(contract, fixtures.Coin("3apple"))},
+		},
+		{
+			name: "withdraw",
+			msg:  `{"withdraw_coins":{"marker_denom":"apple","coin":{"denom":"apple","amount":"4"},"recipient":"` + addr1 + `"}}`,
+			exp: []sdk.Msg{&types.MsgWithdrawRequest{
+				Denom:         "apple",
+				Administrator: contract.String(),
+				ToAddress:     addr1,
+				Amount:        fixtures.Coins("4apple"),
+			}},
+		},
+		{
+			name: "transfer",
+			msg:  `{"transfer_marker_coins":{"coin":{"denom":"apple","amount":"5"},"from":"` + addr1 + `","to":"` + addr2 + `"}}`,
+			exp: []sdk.Msg{&types.MsgTransferRequest{
+				Amount:        fixtures.Coin("5apple"),
+				Administrator: contract.String(),
+				FromAddress:   addr1,
+				ToAddress:     addr2,
+			}},
+		},
+		{
+			name:   "not json",
+			msg:    `not json`,
+			expErr: []string{"invalid marker msg params"},
+		},
+		{
+			name:   "empty",
+			msg:    `{}`,
+			expErr: []string{"no message provided"},
+		},
+		{
+			name:   "two messages",
+			msg:    `{"burn_marker_supply":{"coin":{"denom":"apple","amount":"3"}},"mint_marker_supply":{"coin":{"denom":"apple","amount":"3"}}}`,
+			expErr: []string{"only one message can be provided"},
+		},
+		{
+			name:   "invalid mint",
+			msg:    `{"mint_marker_supply":{"coin":{"denom":"apple","amount":"3"},"recipient":"bad"}}`,
+			expErr: []string{"invalid marker msg"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msgs, err := Encoder(contract, json.RawMessage(tc.msg), "")
+			assertions.AssertErrorContents(t, err, tc.expErr, "Encoder error")
+			assert.Equal(t, tc.exp, msgs, "Encoder msgs")
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package wasm
+
+import (
+	"encoding/json"
+	"fmt"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/internal/provwasm"
+	"github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GasCostGetMarker is the fixed amount of gas consumed by a smart contract marker query.
+const GasCostGetMarker uint64 = 10_000
+
+// MarkerQueryParams are the params for marker module queries sent from a smart contract.
+// Exactly one field must be set.
+type MarkerQueryParams struct {
+	// Get a marker by its bech32 address.
+	GetMarkerByAddress *GetMarkerByAddressParams `json:"get_marker_by_address,omitempty"`
+	// Get a marker by its denom.
+	GetMarkerByDenom *GetMarkerByDenomParams `json:"get_marker_by_denom,omitempty"`
+}
+
+// GetMarkerByAddressParams are the params for getting a marker by its address.
+type GetMarkerByAddressParams struct {
+	// Address is the bech32 address of the marker.
+	Address string `json:"address"`
+}
+
+// GetMarkerByDenomParams are the params for getting a marker by its denom.
+type GetMarkerByDenomParams struct {
+	// Denom is the denom of the marker.
+	Denom string `json:"denom"`
+}
+
+// Marker is the marker info returned to smart contracts.
+type Marker struct {
+	Address                string        `json:"address"`
+	Denom                  string        `json:"denom"`
+	Manager                string        `json:"manager,omitempty"`
+	MarkerType             string        `json:"marker_type"`
+	Status                 string        `json:"status"`
+	TotalSupply            string        `json:"total_supply"`
+	SupplyFixed            bool          `json:"supply_fixed"`
+	AllowGovernanceControl bool          `json:"allow_governance_control"`
+	AllowForcedTransfer    bool          `json:"allow_forced_transfer"`
+	RequiredAttributes     []string      `json:"required_attributes,omitempty"`
+	Permissions            []AccessGrant `json:"permissions"`
+}
+
+// AccessGrant is the set of permissions an address has on a marker.
+type AccessGrant struct {
+	Address     string   `json:"address"`
+	Permissions []string `json:"permissions"`
+}
+
+// Querier returns a smart contract querier for the marker module.
+func Querier(k keeper.Keeper) provwasm.Querier {
+	return func(ctx sdk.Context, query json.RawMessage, _ string) ([]byte, error) {
+		var params MarkerQueryParams
+		if err := json.Unmarshal(query, &params); err != nil {
+			return nil, wasmvmtypes.InvalidRequest{Err: fmt.Sprintf("invalid marker query params: %v", err), Request: query}
+		}
+
+		provwasm.ConsumeGas(ctx, GasCostGetMarker, "marker query")
+
+		var marker types.MarkerAccountI
+		var err error
+		switch {
+		case params.GetMarkerByAddress != nil && params.GetMarkerByDenom != nil:
+			return nil, wasmvmtypes.InvalidRequest{Err: "invalid marker query params: only one query can be provided", Request: query}
+		case params.GetMarkerByAddress != nil:
+			var addr sdk.AccAddress
+			addr, err = sdk.AccAddressFromBech32(params.GetMarkerByAddress.Address)
+			if err != nil {
+				return nil, wasmvmtypes.InvalidRequest{Err: fmt.Sprintf("invalid marker address: %v", err), Request: query}
+			}
+			marker, err = k.GetMarker(ctx, addr)
+			if err == nil && marker == nil {
+				err = fmt.Errorf("marker not found for address: %s", addr)
+			}
+		case params.GetMarkerByDenom != nil:
+			marker, err = k.GetMarkerByDenom(ctx, params.GetMarkerByDenom.Denom)
+		default:
+			return nil, wasmvmtypes.InvalidRequest{Err: "invalid marker query params: no query provided", Request: query}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not get marker: %w", err)
+		}
+
+		return json.Marshal(NewMarker(marker))
+	}
+}
+
+// NewMarker converts a marker account into the info returned to smart contracts.
+func NewMarker(marker types.MarkerAccountI) Marker {
+	rv := Marker{
+		Address:                marker.GetAddress().String(),
+		Denom:                  marker.GetDenom(),
+		MarkerType:             marker.GetMarkerType().String(),
+		Status:                 marker.GetStatus().String(),
+		TotalSupply:            marker.GetSupply().Amount.String(),
+		SupplyFixed:            marker.HasFixedSupply(),
+		AllowGovernanceControl: marker.HasGovernanceEnabled(),
+		AllowForcedTransfer:    marker.AllowsForcedTransfer(),
+		RequiredAttributes:     marker.GetRequiredAttributes(),
+		Permissions:            []AccessGrant{},
+	}
+	if manager := marker.GetManager(); !manager.Empty() {
+		rv.Manager = manager.String()
+	}
+	for _, grant := range marker.GetAccessList() {
+		perms := make([]string, len(grant.Permissions))
+		for i, perm := range grant.Permissions {
+			perms[i] = perm.String()
+		}
+		rv.Permissions = append(rv.Permissions, AccessGrant{Address: grant.Address, Permissions: perms})
+	}
+	return rv
+}
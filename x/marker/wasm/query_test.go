@@ -0,0 +1,29 @@
+package wasm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/testutil/fixtures"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestNewMarker(t *testing.T) {
+	marker := fixtures.NewMarkerAccount("1000apple")
+	admin := sdk.AccAddress("admin_______________")
+	marker.AccessControl = []types.AccessGrant{*types.NewAccessGrant(admin, []types.Access{types.Access_Mint, types.Access_Burn})}
+
+	exp := Marker{
+		Address:     fixtures.MarkerAddr("apple").String(),
+		Denom:       "apple",
+		MarkerType:  "MARKER_TYPE_RESTRICTED",
+		Status:      "active",
+		TotalSupply: "1000",
+		SupplyFixed: true,
+		Permissions: []AccessGrant{{Address: admin.String(), Permissions: []string{"ACCESS_MINT", "ACCESS_BURN"}}},
+	}
+	assert.Equal(t, exp, NewMarker(marker), "NewMarker")
+}
@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/metadata/keeper"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// BenchmarkWriteScope measures writing new scopes, and updating existing ones, through the WriteScope endpoint.
+// Run with: go test -run='^$' -bench=BenchmarkWriteScope -benchmem ./x/metadata/keeper
+func BenchmarkWriteScope(b *testing.B) {
+	app := simapp.Setup(b)
+	ctx := FreshCtx(app)
+	msgServer := keeper.NewMsgServerImpl(app.MetadataKeeper)
+
+	owners := make([]string, 5)
+	for i := range owners {
+		addr := sdk.AccAddress(fmt.Sprintf("scope_owner_%08d", i))
+		app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr))
+		owners[i] = addr.String()
+	}
+
+	scopeSpecID := types.ScopeSpecMetadataAddress(uuid.New())
+	scopeSpec := types.NewScopeSpecification(scopeSpecID, nil, owners[:1],
+		[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, []types.MetadataAddress{})
+	app.MetadataKeeper.SetScopeSpecification(ctx, *scopeSpec)
+
+	for _, numOwners := range []int{1, 5} {
+		scopeOwners := owners[:numOwners]
+
+		b.Run(fmt.Sprintf("new scope with %d owners", numOwners), func(b *testing.B) {
+			msgs := make([]*types.MsgWriteScopeRequest, b.N)
+			for i := range msgs {
+				scope := types.NewScope(types.ScopeMetadataAddress(uuid.New()), scopeSpecID,
+					ownerPartyList(scopeOwners...), nil, scopeOwners[0], false)
+				msgs[i] = types.NewMsgWriteScopeRequest(*scope, scopeOwners, 0)
+			}
+			cacheCtx, _ := ctx.CacheContext()
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := msgServer.WriteScope(cacheCtx, msgs[i]); err != nil {
+					b.Fatalf("WriteScope: %v", err)
+				}
+			}
+		})
+
+		b.Run(fmt.Sprintf("update scope with %d owners", numOwners), func(b *testing.B) {
+			cacheCtx, _ := ctx.CacheContext()
+			scopeID := types.ScopeMetadataAddress(uuid.New())
+			scope := types.NewScope(scopeID, scopeSpecID, ownerPartyList(scopeOwners...), nil, scopeOwners[0], false)
+			_, err := msgServer.WriteScope(cacheCtx, types.NewMsgWriteScopeRequest(*scope, scopeOwners, 0))
+			require.NoError(b, err, "WriteScope to create the scope")
+
+			// Alternate the data access so that every write actually changes the scope.
+			msgs := make([]*types.MsgWriteScopeRequest, 2)
+			for i := range msgs {
+				updated := *scope
+				updated.DataAccess = owners[i : i+1]
+				msgs[i] = types.NewMsgWriteScopeRequest(updated, scopeOwners, 0)
+			}
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err = msgServer.WriteScope(cacheCtx, msgs[i%2]); err != nil {
+					b.Fatalf("WriteScope: %v", err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+const referenceInvariant = "Referential-Integrity"
+
+// RegisterInvariants registers all metadata invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
+	ir.RegisterRoute(types.ModuleName, referenceInvariant, ReferentialIntegrityInvariant(keeper))
+}
+
+// ReferentialIntegrityInvariant checks that the scopes, sessions, records, and scope specifications
+// only reference entries that exist.
+func ReferentialIntegrityInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg, broken := referentialIntegrityInvariantHelper(ctx, keeper)
+		return sdk.FormatInvariant(types.ModuleName, referenceInvariant, msg), broken
+	}
+}
+
+// referentialIntegrityInvariantHelper does all the heavy lifting for ReferentialIntegrityInvariant.
+func referentialIntegrityInvariantHelper(ctx sdk.Context, keeper Keeper) (string, bool) {
+	var errs []error
+	addErr := func(format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+
+	// The existence checks are cached since many entries tend to reference the same few specs.
+	scopeSpecExists := make(map[string]bool)
+	hasScopeSpec := func(id types.MetadataAddress) bool {
+		key := string(id)
+		if exists, known := scopeSpecExists[key]; known {
+			return exists
+		}
+		_, found := keeper.GetScopeSpecification(ctx, id)
+		scopeSpecExists[key] = found
+		return found
+	}
+	contractSpecExists := make(map[string]bool)
+	hasContractSpec := func(id types.MetadataAddress) bool {
+		key := string(id)
+		if exists, known := contractSpecExists[key]; known {
+			return exists
+		}
+		_, found := keeper.GetContractSpecification(ctx, id)
+		contractSpecExists[key] = found
+		return found
+	}
+	hasScope := func(id types.MetadataAddress) bool {
+		_, found := keeper.GetScope(ctx, id)
+		return found
+	}
+
+	var scopeCount, sessionCount, recordCount, scopeSpecCount int
+	err := keeper.IterateScopes(ctx, func(scope types.Scope) bool {
+		scopeCount++
+		if !hasScopeSpec(scope.SpecificationId) {
+			addErr("scope %s references scope specification %s, which does not exist",
+				scope.ScopeId, scope.SpecificationId)
+		}
+		return false
+	})
+	if err != nil {
+		errs = append(errs, fmt.Errorf("could not iterate scopes: %w", err))
+	}
+
+	err = keeper.IterateSessions(ctx, types.MetadataAddress{}, func(session types.Session) bool {
+		sessionCount++
+		scopeID, aerr := session.SessionId.AsScopeAddress()
+		switch {
+		case aerr != nil:
+			addErr("session %s has an invalid id: %v", session.SessionId, aerr)
+		case !hasScope(scopeID):
+			addErr("session %s belongs to scope %s, which does not exist", session.SessionId, scopeID)
+		}
+		if !hasContractSpec(session.SpecificationId) {
+			addErr("session %s references contract specification %s, which does not exist",
+				session.SessionId, session.SpecificationId)
+		}
+		return false
+	})
+	if err != nil {
+		errs = append(errs, fmt.Errorf("could not iterate sessions: %w", err))
+	}
+
+	err = keeper.IterateRecords(ctx, types.MetadataAddress{}, func(record types.Record) bool {
+		recordCount++
+		recordID := record.GetRecordAddress()
+		scopeID, aerr := record.SessionId.AsScopeAddress()
+		switch {
+		case aerr != nil:
+			addErr("record %s has an invalid session id %s: %v", recordID, record.SessionId, aerr)
+		case !hasScope(scopeID):
+			addErr("record %s belongs to scope %s, which does not exist", recordID, scopeID)
+		}
+		if _, found := keeper.GetSession(ctx, record.SessionId); !found {
+			addErr("record %s references session %s, which does not exist", recordID, record.SessionId)
+		}
+		// Records written before record specifications were required might not have one.
+		if !record.SpecificationId.Empty() {
+			if _, found := keeper.GetRecordSpecification(ctx, record.SpecificationId); !found {
+				addErr("record %s references record specification %s, which does not exist",
+					recordID, record.SpecificationId)
+			}
+		}
+		return false
+	})
+	if err != nil {
+		errs = append(errs, fmt.Errorf("could not iterate records: %w", err))
+	}
+
+	err = keeper.IterateScopeSpecs(ctx, func(spec types.ScopeSpecification) bool {
+		scopeSpecCount++
+		for _, contractSpecID := range spec.ContractSpecIds {
+			if !hasContractSpec(contractSpecID) {
+				addErr("scope specification %s references contract specification %s, which does not exist",
+					spec.SpecificationId, contractSpecID)
+			}
+		}
+		return false
+	})
+	if err != nil {
+		errs = append(errs, fmt.Errorf("could not iterate scope specifications: %w", err))
+	}
+
+	var msg strings.Builder
+	msg.WriteString(fmt.Sprintf("Checked %d scopes, %d sessions, %d records, and %d scope specifications.",
+		scopeCount, sessionCount, recordCount, scopeSpecCount))
+
+	msg.WriteByte(' ')
+	errCount := len(errs)
+	broken := errCount != 0
+	switch errCount {
+	case 0:
+		msg.WriteString("No problems detected.")
+	case 1:
+		msg.WriteString(fmt.Sprintf("1 problem detected: %v", errs[0]))
+	default:
+		msg.WriteString(fmt.Sprintf("%d problems detected:", errCount))
+		for i, er := range errs {
+			msg.WriteString(fmt.Sprintf("\n%d: %v", i+1, er))
+		}
+	}
+
+	return msg.String(), broken
+}
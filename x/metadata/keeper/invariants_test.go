@@ -0,0 +1,162 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/metadata/keeper"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+func TestReferentialIntegrityInvariant(t *testing.T) {
+	app := simapp.Setup(t)
+	owner := sdk.AccAddress("owner_______________").String()
+
+	scopeUUID := uuid.MustParse("11111111-1111-1111-1111-111111111111")
+	sessionUUID := uuid.MustParse("22222222-2222-2222-2222-222222222222")
+	scopeSpecID := types.ScopeSpecMetadataAddress(uuid.MustParse("33333333-3333-3333-3333-333333333333"))
+	contractSpecUUID := uuid.MustParse("44444444-4444-4444-4444-444444444444")
+	contractSpecID := types.ContractSpecMetadataAddress(contractSpecUUID)
+	recordSpecID := types.RecordSpecMetadataAddress(contractSpecUUID, "recname")
+	scopeID := types.ScopeMetadataAddress(scopeUUID)
+	sessionID := types.SessionMetadataAddress(scopeUUID, sessionUUID)
+	recordID := types.RecordMetadataAddress(scopeUUID, "recname")
+
+	scopeSpec := types.ScopeSpecification{
+		SpecificationId: scopeSpecID,
+		OwnerAddresses:  []string{owner},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		ContractSpecIds: []types.MetadataAddress{contractSpecID},
+	}
+	contractSpec := types.ContractSpecification{
+		SpecificationId: contractSpecID,
+		OwnerAddresses:  []string{owner},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		Source:          types.NewContractSpecificationSourceHash("somehash"),
+		ClassName:       "someclass",
+	}
+	recordSpec := types.RecordSpecification{
+		SpecificationId: recordSpecID,
+		Name:            "recname",
+		TypeName:        "string",
+		ResultType:      types.DefinitionType_DEFINITION_TYPE_RECORD,
+	}
+	scope := types.Scope{
+		ScopeId:         scopeID,
+		SpecificationId: scopeSpecID,
+		Owners:          ownerPartyList(owner),
+	}
+	session := types.Session{
+		SessionId:       sessionID,
+		SpecificationId: contractSpecID,
+		Parties:         ownerPartyList(owner),
+	}
+	record := types.Record{
+		Name:            "recname",
+		SessionId:       sessionID,
+		Process:         types.Process{ProcessId: &types.Process_Hash{Hash: "processhash"}, Name: "process"},
+		SpecificationId: recordSpecID,
+	}
+
+	tests := []struct {
+		name      string
+		skip      []string
+		expInMsg  []string
+		expBroken bool
+	}{
+		{
+			name:     "everything exists",
+			expInMsg: []string{"Checked 1 scopes, 1 sessions, 1 records, and 1 scope specifications. No problems detected."},
+		},
+		{
+			name: "no scope spec",
+			skip: []string{"scope spec"},
+			expInMsg: []string{
+				"Checked 1 scopes, 1 sessions, 1 records, and 0 scope specifications. 1 problem detected: " +
+					"scope " + scopeID.String() + " references scope specification " + scopeSpecID.String() + ", which does not exist",
+			},
+			expBroken: true,
+		},
+		{
+			name: "no contract spec",
+			skip: []string{"contract spec"},
+			expInMsg: []string{
+				"2 problems detected:",
+				"\n1: session " + sessionID.String() + " references contract specification " + contractSpecID.String() + ", which does not exist",
+				"\n2: scope specification " + scopeSpecID.String() + " references contract specification " + contractSpecID.String() + ", which does not exist",
+			},
+			expBroken: true,
+		},
+		{
+			name: "no record spec",
+			skip: []string{"record spec"},
+			expInMsg: []string{
+				"1 problem detected: record " + recordID.String() + " references record specification " + recordSpecID.String() + ", which does not exist",
+			},
+			expBroken: true,
+		},
+		{
+			name: "no scope",
+			skip: []string{"scope"},
+			expInMsg: []string{
+				"Checked 0 scopes, 1 sessions, 1 records, and 1 scope specifications. 2 problems detected:",
+				"\n1: session " + sessionID.String() + " belongs to scope " + scopeID.String() + ", which does not exist",
+				"\n2: record " + recordID.String() + " belongs to scope " + scopeID.String() + ", which does not exist",
+			},
+			expBroken: true,
+		},
+		{
+			name: "no session",
+			skip: []string{"session"},
+			expInMsg: []string{
+				"1 problem detected: record " + recordID.String() + " references session " + sessionID.String() + ", which does not exist",
+			},
+			expBroken: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, _ := FreshCtx(app).CacheContext()
+			skip := make(map[string]bool)
+			for _, name := range tc.skip {
+				skip[name] = true
+			}
+			if !skip["scope spec"] {
+				app.MetadataKeeper.SetScopeSpecification(ctx, scopeSpec)
+			}
+			if !skip["contract spec"] {
+				app.MetadataKeeper.SetContractSpecification(ctx, contractSpec)
+			}
+			if !skip["record spec"] {
+				app.MetadataKeeper.SetRecordSpecification(ctx, recordSpec)
+			}
+			if !skip["scope"] {
+				require.NoError(t, app.MetadataKeeper.SetScope(ctx, scope), "SetScope")
+			}
+			if !skip["session"] {
+				app.MetadataKeeper.SetSession(ctx, session)
+			}
+			app.MetadataKeeper.SetRecord(ctx, record)
+
+			invariant := keeper.ReferentialIntegrityInvariant(app.MetadataKeeper)
+			var msg string
+			var broken bool
+			testFunc := func() {
+				msg, broken = invariant(ctx)
+			}
+			require.NotPanics(t, testFunc, "ReferentialIntegrityInvariant")
+			assert.Contains(t, msg, "metadata: Referential-Integrity invariant", "invariant message")
+			for _, exp := range tc.expInMsg {
+				assert.Contains(t, msg, exp, "invariant message")
+			}
+			assert.Equal(t, tc.expBroken, broken, "invariant broken")
+		})
+	}
+}
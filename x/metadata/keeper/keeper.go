@@ -74,7 +74,7 @@ func (k Keeper) VerifyCorrectOwner(ctx sdk.Context, ownerAddr sdk.AccAddress) bo
 func (k Keeper) EmitEvent(ctx sdk.Context, event proto.Message) {
 	err := ctx.EventManager().EmitTypedEvent(event)
 	if err != nil {
-		ctx.Logger().Error("unable to emit event", "error", err, "event", event)
+		k.Logger(ctx).Error("unable to emit event", "error", err, "event", event)
 	}
 }
 
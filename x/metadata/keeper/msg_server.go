@@ -642,7 +642,7 @@ func (k msgServer) BindOSLocator(
 	ctx := UnwrapMetadataContext(goCtx)
 	// Validate
 	if err := msg.ValidateBasic(); err != nil {
-		ctx.Logger().Error("unable to validate message", "err", err)
+		k.Logger(ctx).Error("unable to validate message", "error", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
@@ -653,13 +653,13 @@ func (k msgServer) BindOSLocator(
 		encryptionKey, _ = sdk.AccAddressFromBech32(msg.Locator.EncryptionKey)
 	}
 	if k.Keeper.OSLocatorExists(ctx, ownerAddress) {
-		ctx.Logger().Error("Address already bound to an URI", "owner", msg.Locator.Owner)
+		k.Logger(ctx).Error("Address already bound to an URI", "owner", msg.Locator.Owner)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(types.ErrOSLocatorAlreadyBound.Error())
 	}
 
 	// Bind owner to URI
 	if err := k.Keeper.SetOSLocator(ctx, ownerAddress, encryptionKey, msg.Locator.LocatorUri); err != nil {
-		ctx.Logger().Error("unable to bind name", "err", err)
+		k.Logger(ctx).Error("unable to bind name", "error", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
@@ -676,7 +676,7 @@ func (k msgServer) DeleteOSLocator(
 	ctx := UnwrapMetadataContext(goCtx)
 	// Validate
 	if err := msg.ValidateBasic(); err != nil {
-		ctx.Logger().Error("unable to validate message", "err", err)
+		k.Logger(ctx).Error("unable to validate message", "error", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
@@ -684,18 +684,18 @@ func (k msgServer) DeleteOSLocator(
 	ownerAddr, _ := sdk.AccAddressFromBech32(msg.Locator.Owner)
 
 	if !k.Keeper.OSLocatorExists(ctx, ownerAddr) {
-		ctx.Logger().Error("Address not already bound to an URI", "owner", msg.Locator.Owner)
+		k.Logger(ctx).Error("Address not already bound to an URI", "owner", msg.Locator.Owner)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(types.ErrOSLocatorAlreadyBound.Error())
 	}
 
 	if !k.Keeper.VerifyCorrectOwner(ctx, ownerAddr) {
-		ctx.Logger().Error("msg sender cannot delete os locator", "owner", ownerAddr)
+		k.Logger(ctx).Error("msg sender cannot delete os locator", "owner", ownerAddr)
 		return nil, sdkerrors.ErrUnauthorized.Wrap("msg sender cannot delete os locator.")
 	}
 
 	// Delete
 	if err := k.Keeper.RemoveOSLocator(ctx, ownerAddr); err != nil {
-		ctx.Logger().Error("error deleting name", "err", err)
+		k.Logger(ctx).Error("error deleting name", "error", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
@@ -712,7 +712,7 @@ func (k msgServer) ModifyOSLocator(
 	ctx := UnwrapMetadataContext(goCtx)
 	// Validate
 	if err := msg.ValidateBasic(); err != nil {
-		ctx.Logger().Error("unable to validate message", "err", err)
+		k.Logger(ctx).Error("unable to validate message", "error", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
@@ -724,17 +724,17 @@ func (k msgServer) ModifyOSLocator(
 	}
 
 	if !k.Keeper.OSLocatorExists(ctx, ownerAddr) {
-		ctx.Logger().Error("Address not already bound to an URI", "owner", msg.Locator.Owner)
+		k.Logger(ctx).Error("Address not already bound to an URI", "owner", msg.Locator.Owner)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(types.ErrOSLocatorAlreadyBound.Error())
 	}
 
 	if !k.Keeper.VerifyCorrectOwner(ctx, ownerAddr) {
-		ctx.Logger().Error("msg sender cannot modify os locator", "owner", ownerAddr)
+		k.Logger(ctx).Error("msg sender cannot modify os locator", "owner", ownerAddr)
 		return nil, sdkerrors.ErrUnauthorized.Wrap("msg sender cannot delete os locator.")
 	}
 	// Modify
 	if err := k.Keeper.ModifyOSLocator(ctx, ownerAddr, encryptionKey, msg.Locator.LocatorUri); err != nil {
-		ctx.Logger().Error("error deleting name", "err", err)
+		k.Logger(ctx).Error("error deleting name", "error", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
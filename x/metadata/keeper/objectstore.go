@@ -20,7 +20,7 @@ func (k Keeper) GetOsLocatorRecord(ctx sdk.Context, ownerAddr sdk.AccAddress) (o
 	}
 	err := k.cdc.Unmarshal(b, &osLocator)
 	if err != nil {
-		ctx.Logger().Error("failed to unmarshal locator", "err", err)
+		k.Logger(ctx).Error("failed to unmarshal locator", "error", err)
 		return types.ObjectStoreLocator{}, false
 	}
 	return osLocator, true
@@ -58,6 +58,7 @@ func (k Keeper) SetOSLocator(ctx sdk.Context, ownerAddr, encryptionKey sdk.AccAd
 	store.Set(key, bz)
 
 	k.EmitEvent(ctx, types.NewEventOSLocatorCreated(record.Owner))
+	types.IncObjectActionCounter(types.TLType_OSLocator, types.TLAction_Created)
 	return nil
 }
 
@@ -125,6 +126,7 @@ func (k Keeper) RemoveOSLocator(ctx sdk.Context, ownerAddr sdk.AccAddress) error
 	}
 	store.Delete(key)
 	k.EmitEvent(ctx, types.NewEventOSLocatorDeleted(ownerAddr.String()))
+	types.IncObjectActionCounter(types.TLType_OSLocator, types.TLAction_Deleted)
 	return nil
 }
 
@@ -147,6 +149,7 @@ func (k Keeper) ModifyOSLocator(ctx sdk.Context, ownerAddr, encryptionKey sdk.Ac
 	}
 	store.Set(key, bz)
 	k.EmitEvent(ctx, types.NewEventOSLocatorUpdated(record.Owner))
+	types.IncObjectActionCounter(types.TLType_OSLocator, types.TLAction_Updated)
 	return nil
 }
 
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/provenance-io/provenance/internal/fieldmask"
+	"github.com/provenance-io/provenance/internal/pagination"
+	"github.com/provenance-io/provenance/x/metadata/types"
+	metadatav2 "github.com/provenance-io/provenance/x/metadata/types/v2"
+)
+
+// QueryServerV2 is an alias for a Keeper that implements the metadatav2.QueryServer interface.
+type QueryServerV2 struct {
+	Keeper
+}
+
+// NewQueryServerV2 returns an implementation of the metadata v2 QueryServer interface for the provided Keeper.
+func NewQueryServerV2(k Keeper) metadatav2.QueryServer {
+	return QueryServerV2{Keeper: k}
+}
+
+var _ metadatav2.QueryServer = QueryServerV2{}
+
+// Scopes returns all scopes (limited by pagination).
+func (k QueryServerV2) Scopes(c context.Context, req *metadatav2.QueryScopesRequest) (*metadatav2.QueryScopesResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "v2", "Scopes")
+	if req == nil {
+		req = &metadatav2.QueryScopesRequest{}
+	}
+	mask, err := fieldmask.Parse(req.FieldMask, &types.Scope{})
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid field mask: %v", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScopeKeyPrefix)
+	resp := &metadatav2.QueryScopesResponse{}
+	resp.Pagination, err = pagination.Paginate(prefixStore, req.Pagination, req.Order == metadatav2.Order_ORDER_DESCENDING,
+		func(_ []byte, value []byte) (bool, error) {
+			scope, vErr := k.readScopeBz(value)
+			if vErr != nil {
+				// Skip it so that one bad entry doesn't block all the others.
+				k.Logger(ctx).Error("failed to unmarshal scope", "error", vErr)
+				return false, nil
+			}
+			k.PopulateScopeValueOwner(ctx, &scope)
+			mask.Apply(&scope)
+			resp.Scopes = append(resp.Scopes, &scope)
+			return true, nil
+		})
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return resp, nil
+}
@@ -64,12 +64,15 @@ func (k Keeper) SetRecord(ctx sdk.Context, record types.Record) {
 	recordID := record.SessionId.MustGetAsRecordAddress(record.Name)
 
 	var event proto.Message = types.NewEventRecordCreated(recordID, record.SessionId)
+	action := types.TLAction_Created
 	if store.Has(recordID) {
 		event = types.NewEventRecordUpdated(recordID, record.SessionId)
+		action = types.TLAction_Updated
 	}
 
 	store.Set(recordID, b)
 	k.EmitEvent(ctx, event)
+	types.IncObjectActionCounter(types.TLType_Record, action)
 }
 
 // RemoveRecord removes a record from the module kv store.
@@ -84,6 +87,7 @@ func (k Keeper) RemoveRecord(ctx sdk.Context, id types.MetadataAddress) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(id)
 	k.EmitEvent(ctx, types.NewEventRecordDeleted(id))
+	types.IncObjectActionCounter(types.TLType_Record, types.TLAction_Deleted)
 
 	// Remove the session too if there are no more records in it.
 	k.RemoveSession(ctx, record.SessionId)
@@ -32,12 +32,15 @@ func (k Keeper) SetSession(ctx sdk.Context, session types.Session) {
 	b := k.cdc.MustMarshal(&session)
 
 	var event proto.Message = types.NewEventSessionCreated(session.SessionId)
+	action := types.TLAction_Created
 	if store.Has(session.SessionId) {
 		event = types.NewEventSessionUpdated(session.SessionId)
+		action = types.TLAction_Updated
 	}
 
 	store.Set(session.SessionId, b)
 	k.EmitEvent(ctx, event)
+	types.IncObjectActionCounter(types.TLType_Session, action)
 }
 
 // RemoveSession removes a session from the module kv store if there are no records associated with it.
@@ -53,6 +56,7 @@ func (k Keeper) RemoveSession(ctx sdk.Context, id types.MetadataAddress) {
 
 	store.Delete(id)
 	k.EmitEvent(ctx, types.NewEventSessionDeleted(id))
+	types.IncObjectActionCounter(types.TLType_Session, types.TLAction_Deleted)
 }
 
 func (k Keeper) sessionHasRecords(ctx sdk.Context, id types.MetadataAddress) bool {
@@ -82,7 +82,7 @@ func (k Keeper) GetRecordSpecificationsForContractSpecificationID(ctx sdk.Contex
 		if found {
 			retval = append(retval, &recordSpec)
 		} else {
-			k.Logger(ctx).Error(fmt.Sprintf("iterator found record spec id %s but no record spec was found with that id", recordSpecID))
+			k.Logger(ctx).Error("iterator found record spec id but no record spec was found with that id", "spec_id", recordSpecID.String())
 		}
 		return false
 	})
@@ -109,12 +109,15 @@ func (k Keeper) SetRecordSpecification(ctx sdk.Context, spec types.RecordSpecifi
 	b := k.cdc.MustMarshal(&spec)
 
 	var event proto.Message = types.NewEventRecordSpecificationCreated(spec.SpecificationId)
+	action := types.TLAction_Created
 	if store.Has(spec.SpecificationId) {
 		event = types.NewEventRecordSpecificationUpdated(spec.SpecificationId)
+		action = types.TLAction_Updated
 	}
 
 	store.Set(spec.SpecificationId, b)
 	k.EmitEvent(ctx, event)
+	types.IncObjectActionCounter(types.TLType_RecordSpecification, action)
 }
 
 // RemoveRecordSpecification removes a record specification from the module kv store.
@@ -131,6 +134,7 @@ func (k Keeper) RemoveRecordSpecification(ctx sdk.Context, recordSpecID types.Me
 
 	store.Delete(recordSpecID)
 	k.EmitEvent(ctx, types.NewEventRecordSpecificationDeleted(recordSpecID))
+	types.IncObjectActionCounter(types.TLType_RecordSpecification, types.TLAction_Deleted)
 	return nil
 }
 
@@ -215,8 +219,10 @@ func (k Keeper) SetContractSpecification(ctx sdk.Context, spec types.ContractSpe
 
 	var oldSpec *types.ContractSpecification
 	var event proto.Message = types.NewEventContractSpecificationCreated(spec.SpecificationId)
+	action := types.TLAction_Created
 	if store.Has(spec.SpecificationId) {
 		event = types.NewEventContractSpecificationUpdated(spec.SpecificationId)
+		action = types.TLAction_Updated
 		if oldBytes := store.Get(spec.SpecificationId); oldBytes != nil {
 			oldSpec = &types.ContractSpecification{}
 			if err := k.cdc.Unmarshal(oldBytes, oldSpec); err != nil {
@@ -229,6 +235,7 @@ func (k Keeper) SetContractSpecification(ctx sdk.Context, spec types.ContractSpe
 	store.Set(spec.SpecificationId, b)
 	k.indexContractSpecification(ctx, &spec, oldSpec)
 	k.EmitEvent(ctx, event)
+	types.IncObjectActionCounter(types.TLType_ContractSpecification, action)
 }
 
 // RemoveContractSpecification removes a contract specification from the module kv store.
@@ -247,6 +254,7 @@ func (k Keeper) RemoveContractSpecification(ctx sdk.Context, contractSpecID type
 	k.indexContractSpecification(ctx, nil, &contractSpec)
 	store.Delete(contractSpecID)
 	k.EmitEvent(ctx, types.NewEventContractSpecificationDeleted(contractSpecID))
+	types.IncObjectActionCounter(types.TLType_ContractSpecification, types.TLAction_Deleted)
 	return nil
 }
 
@@ -437,8 +445,10 @@ func (k Keeper) SetScopeSpecification(ctx sdk.Context, spec types.ScopeSpecifica
 
 	var oldSpec *types.ScopeSpecification
 	var event proto.Message = types.NewEventScopeSpecificationCreated(spec.SpecificationId)
+	action := types.TLAction_Created
 	if store.Has(spec.SpecificationId) {
 		event = types.NewEventScopeSpecificationUpdated(spec.SpecificationId)
+		action = types.TLAction_Updated
 		if oldBytes := store.Get(spec.SpecificationId); oldBytes != nil {
 			oldSpec = &types.ScopeSpecification{}
 			if err := k.cdc.Unmarshal(oldBytes, oldSpec); err != nil {
@@ -451,6 +461,7 @@ func (k Keeper) SetScopeSpecification(ctx sdk.Context, spec types.ScopeSpecifica
 	store.Set(spec.SpecificationId, b)
 	k.indexScopeSpecification(ctx, &spec, oldSpec)
 	k.EmitEvent(ctx, event)
+	types.IncObjectActionCounter(types.TLType_ScopeSpecification, action)
 }
 
 // RemoveScopeSpecification removes a scope specification from the module kv store.
@@ -469,6 +480,7 @@ func (k Keeper) RemoveScopeSpecification(ctx sdk.Context, scopeSpecID types.Meta
 	k.indexScopeSpecification(ctx, nil, &scopeSpec)
 	store.Delete(scopeSpecID)
 	k.EmitEvent(ctx, types.NewEventScopeSpecificationDeleted(scopeSpecID))
+	types.IncObjectActionCounter(types.TLType_ScopeSpecification, types.TLAction_Deleted)
 	return nil
 }
 
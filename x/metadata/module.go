@@ -25,6 +25,7 @@ import (
 	"github.com/provenance-io/provenance/x/metadata/keeper"
 	"github.com/provenance-io/provenance/x/metadata/simulation"
 	"github.com/provenance-io/provenance/x/metadata/types"
+	metadatav2 "github.com/provenance-io/provenance/x/metadata/types/v2"
 )
 
 // type check to ensure the interface is properly implemented
@@ -67,6 +68,9 @@ func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *r
 	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
 		panic(err)
 	}
+	if err := metadatav2.RegisterQueryHandlerClient(context.Background(), mux, metadatav2.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
 }
 
 // GetTxCmd returns the root tx command for the metadata module.
@@ -112,14 +116,16 @@ func (AppModule) Name() string {
 	return types.ModuleName
 }
 
-// RegisterInvariants does not register any invariants for the metadata module
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {
+// RegisterInvariants registers the invariants for the metadata module.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
 }
 
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+	metadatav2.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServerV2(am.keeper))
 }
 
 // InitGenesis performs genesis initialization for the metadata module. It returns no validator updates.
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"github.com/hashicorp/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+const (
+	// TelemetryKeyStoredObject is the telemetry counter key for the number of objects stored on the chain.
+	TelemetryKeyStoredObject = "stored-object"
+	// TelemetryKeyObjectAction is the telemetry counter key for actions taken on stored objects.
+	TelemetryKeyObjectAction = "object-action"
+
+	// TelemetryLabelCategory is the telemetry label for the general category of an object.
+	TelemetryLabelCategory = "category"
+	// TelemetryLabelObjectType is the telemetry label for the specific type of an object.
+	TelemetryLabelObjectType = "object-type"
+	// TelemetryLabelAction is the telemetry label for the action taken on an object.
+	TelemetryLabelAction = "action"
+)
+
+// TelemetryCategory is an enum for the general categories of stored objects.
+type TelemetryCategory string
+
+const (
+	TLCategory_Entry         TelemetryCategory = "entry"
+	TLCategory_Specification TelemetryCategory = "specification"
+	TLCategory_OSLocator     TelemetryCategory = "object-store-locator"
+)
+
+// TelemetryObjectType is an enum for the types of stored objects.
+type TelemetryObjectType string
+
+const (
+	TLType_Scope                 TelemetryObjectType = "scope"
+	TLType_Session               TelemetryObjectType = "session"
+	TLType_Record                TelemetryObjectType = "record"
+	TLType_ScopeSpecification    TelemetryObjectType = "scope-specification"
+	TLType_ContractSpecification TelemetryObjectType = "contract-specification"
+	TLType_RecordSpecification   TelemetryObjectType = "record-specification"
+	TLType_OSLocator             TelemetryObjectType = "object-store-locator"
+)
+
+// Category gets the category that this object type belongs to.
+func (t TelemetryObjectType) Category() TelemetryCategory {
+	switch t {
+	case TLType_Scope, TLType_Session, TLType_Record:
+		return TLCategory_Entry
+	case TLType_ScopeSpecification, TLType_ContractSpecification, TLType_RecordSpecification:
+		return TLCategory_Specification
+	case TLType_OSLocator:
+		return TLCategory_OSLocator
+	}
+	return ""
+}
+
+// TelemetryAction is an enum for the actions that can be taken on stored objects.
+type TelemetryAction string
+
+const (
+	TLAction_Created TelemetryAction = "created"
+	TLAction_Updated TelemetryAction = "updated"
+	TLAction_Deleted TelemetryAction = "deleted"
+)
+
+// IncObjectActionCounter increments the telemetry counter for the provided action on an object type.
+// It also updates the stored object counter if the action was a creation or deletion.
+func IncObjectActionCounter(objType TelemetryObjectType, action TelemetryAction) {
+	category := telemetry.NewLabel(TelemetryLabelCategory, string(objType.Category()))
+	objTypeLabel := telemetry.NewLabel(TelemetryLabelObjectType, string(objType))
+	telemetry.IncrCounterWithLabels(
+		[]string{ModuleName, TelemetryKeyObjectAction},
+		1,
+		[]metrics.Label{category, objTypeLabel, telemetry.NewLabel(TelemetryLabelAction, string(action))},
+	)
+
+	var delta float32
+	switch action {
+	case TLAction_Created:
+		delta = 1
+	case TLAction_Deleted:
+		delta = -1
+	default:
+		return
+	}
+	telemetry.IncrCounterWithLabels(
+		[]string{ModuleName, TelemetryKeyStoredObject},
+		delta,
+		[]metrics.Label{category, objTypeLabel},
+	)
+}
@@ -0,0 +1,67 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-metrics"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+
+	"github.com/provenance-io/provenance/testutil"
+	. "github.com/provenance-io/provenance/x/metadata/types"
+)
+
+func TestTelemetryObjectTypeCategory(t *testing.T) {
+	tests := []struct {
+		objType TelemetryObjectType
+		exp     TelemetryCategory
+	}{
+		{objType: TLType_Scope, exp: TLCategory_Entry},
+		{objType: TLType_Session, exp: TLCategory_Entry},
+		{objType: TLType_Record, exp: TLCategory_Entry},
+		{objType: TLType_ScopeSpecification, exp: TLCategory_Specification},
+		{objType: TLType_ContractSpecification, exp: TLCategory_Specification},
+		{objType: TLType_RecordSpecification, exp: TLCategory_Specification},
+		{objType: TLType_OSLocator, exp: TLCategory_OSLocator},
+		{objType: "unknown", exp: ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(string(tc.objType), func(t *testing.T) {
+			assert.Equal(t, tc.exp, tc.objType.Category(), "Category()")
+		})
+	}
+}
+
+func TestIncObjectActionCounter(t *testing.T) {
+	sink := testutil.EnableTelemetry(t)
+	IncObjectActionCounter(TLType_Scope, TLAction_Created)
+	IncObjectActionCounter(TLType_Scope, TLAction_Created)
+	IncObjectActionCounter(TLType_Scope, TLAction_Deleted)
+	IncObjectActionCounter(TLType_RecordSpecification, TLAction_Updated)
+
+	keys := []string{ModuleName, TelemetryKeyObjectAction}
+	labels := func(category TelemetryCategory, objType TelemetryObjectType, action TelemetryAction) []metrics.Label {
+		return []metrics.Label{
+			telemetry.NewLabel(TelemetryLabelCategory, string(category)),
+			telemetry.NewLabel(TelemetryLabelObjectType, string(objType)),
+			telemetry.NewLabel(TelemetryLabelAction, string(action)),
+		}
+	}
+
+	assert.Equal(t, 2.0, testutil.GetCounterSum(sink, keys, labels(TLCategory_Entry, TLType_Scope, TLAction_Created)...), "scopes created")
+	assert.Equal(t, 1.0, testutil.GetCounterSum(sink, keys, labels(TLCategory_Entry, TLType_Scope, TLAction_Deleted)...), "scopes deleted")
+	assert.Equal(t, 0.0, testutil.GetCounterSum(sink, keys, labels(TLCategory_Entry, TLType_Scope, TLAction_Updated)...), "scopes updated")
+	assert.Equal(t, 1.0, testutil.GetCounterSum(sink, keys, labels(TLCategory_Specification, TLType_RecordSpecification, TLAction_Updated)...), "record specs updated")
+
+	storedKeys := []string{ModuleName, TelemetryKeyStoredObject}
+	storedLabels := func(category TelemetryCategory, objType TelemetryObjectType) []metrics.Label {
+		return []metrics.Label{
+			telemetry.NewLabel(TelemetryLabelCategory, string(category)),
+			telemetry.NewLabel(TelemetryLabelObjectType, string(objType)),
+		}
+	}
+	assert.Equal(t, 1.0, testutil.GetCounterSum(sink, storedKeys, storedLabels(TLCategory_Entry, TLType_Scope)...), "stored scopes")
+	assert.Equal(t, 0.0, testutil.GetCounterSum(sink, storedKeys, storedLabels(TLCategory_Specification, TLType_RecordSpecification)...), "stored record specs")
+}
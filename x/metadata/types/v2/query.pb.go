@@ -0,0 +1,818 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/metadata/v2/query.proto
+
+package metadatav2
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/cosmos/gogoproto/types"
+	types1 "github.com/provenance-io/provenance/x/metadata/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Order defines the order in which the results of a query are returned.
+type Order int32
+
+const (
+	// ORDER_UNSPECIFIED is the zero-value Order; it is treated the same as ORDER_ASCENDING.
+	Order_ORDER_UNSPECIFIED Order = 0
+	// ORDER_ASCENDING returns the results with the smallest keys first.
+	Order_ORDER_ASCENDING Order = 1
+	// ORDER_DESCENDING returns the results with the largest keys first.
+	Order_ORDER_DESCENDING Order = 2
+)
+
+var Order_name = map[int32]string{
+	0: "ORDER_UNSPECIFIED",
+	1: "ORDER_ASCENDING",
+	2: "ORDER_DESCENDING",
+}
+
+var Order_value = map[string]int32{
+	"ORDER_UNSPECIFIED": 0,
+	"ORDER_ASCENDING":   1,
+	"ORDER_DESCENDING":  2,
+}
+
+func (x Order) String() string {
+	return proto.EnumName(Order_name, int32(x))
+}
+
+func (Order) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1f5e4e6059353693, []int{0}
+}
+
+// QueryScopesRequest is a request message for the Scopes query.
+type QueryScopesRequest struct {
+	// order is the order (by scope id) in which the scopes are returned.
+	Order Order `protobuf:"varint,1,opt,name=order,proto3,enum=provenance.metadata.v2.Order" json:"order,omitempty"`
+	// field_mask identifies the fields of each scope to return, e.g. "scope_id" or "value_owner_address".
+	// If not provided, all fields are returned.
+	FieldMask *types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	// pagination defines an optional key-based pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScopesRequest) Reset()         { *m = QueryScopesRequest{} }
+func (m *QueryScopesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScopesRequest) ProtoMessage()    {}
+func (*QueryScopesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f5e4e6059353693, []int{0}
+}
+func (m *QueryScopesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScopesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScopesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScopesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScopesRequest.Merge(m, src)
+}
+func (m *QueryScopesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScopesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScopesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScopesRequest proto.InternalMessageInfo
+
+func (m *QueryScopesRequest) GetOrder() Order {
+	if m != nil {
+		return m.Order
+	}
+	return Order_ORDER_UNSPECIFIED
+}
+
+func (m *QueryScopesRequest) GetFieldMask() *types.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return nil
+}
+
+func (m *QueryScopesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryScopesResponse is a response message for the Scopes query.
+type QueryScopesResponse struct {
+	// scopes are a page of all the scopes.
+	Scopes []*types1.Scope `protobuf:"bytes,1,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// pagination is the resulting pagination parameters.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScopesResponse) Reset()         { *m = QueryScopesResponse{} }
+func (m *QueryScopesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScopesResponse) ProtoMessage()    {}
+func (*QueryScopesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f5e4e6059353693, []int{1}
+}
+func (m *QueryScopesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScopesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScopesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScopesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScopesResponse.Merge(m, src)
+}
+func (m *QueryScopesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScopesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScopesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScopesResponse proto.InternalMessageInfo
+
+func (m *QueryScopesResponse) GetScopes() []*types1.Scope {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+func (m *QueryScopesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("provenance.metadata.v2.Order", Order_name, Order_value)
+	proto.RegisterType((*QueryScopesRequest)(nil), "provenance.metadata.v2.QueryScopesRequest")
+	proto.RegisterType((*QueryScopesResponse)(nil), "provenance.metadata.v2.QueryScopesResponse")
+}
+
+func init() {
+	proto.RegisterFile("provenance/metadata/v2/query.proto", fileDescriptor_1f5e4e6059353693)
+}
+
+var fileDescriptor_1f5e4e6059353693 = []byte{
+	// 480 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xcf, 0x8f, 0xd2, 0x40,
+	0x14, 0x66, 0x30, 0x90, 0xf8, 0x36, 0x51, 0x9c, 0x55, 0x83, 0x44, 0x9b, 0x86, 0xc3, 0x4a, 0x30,
+	0xce, 0x84, 0x6e, 0x3c, 0x18, 0x4f, 0xee, 0x52, 0x36, 0x1c, 0x64, 0xb1, 0xc4, 0x8b, 0x97, 0xcd,
+	0xb4, 0x0c, 0xb5, 0xd9, 0xa5, 0xd3, 0xed, 0x0c, 0x8d, 0x5c, 0xbd, 0x79, 0x33, 0xd9, 0xf8, 0x3f,
+	0x79, 0xf0, 0xb0, 0x89, 0x17, 0x8f, 0x06, 0xfc, 0x43, 0x4c, 0x67, 0x8a, 0x60, 0x84, 0xe8, 0xad,
+	0xfd, 0xde, 0xf7, 0xe6, 0xfb, 0x31, 0x03, 0xcd, 0x24, 0x15, 0x19, 0x8f, 0x59, 0x1c, 0x70, 0x3a,
+	0xe5, 0x8a, 0x8d, 0x99, 0x62, 0x34, 0x73, 0xe8, 0xe5, 0x8c, 0xa7, 0x73, 0x92, 0xa4, 0x42, 0x09,
+	0x7c, 0x7f, 0xcd, 0x21, 0x2b, 0x0e, 0xc9, 0x9c, 0x46, 0x3b, 0x10, 0x72, 0x2a, 0x24, 0xf5, 0x99,
+	0xe4, 0x66, 0x81, 0x66, 0x1d, 0x9f, 0x2b, 0xd6, 0xa1, 0x09, 0x0b, 0xa3, 0x98, 0xa9, 0x48, 0xc4,
+	0xe6, 0x8c, 0xc6, 0xc3, 0x50, 0x88, 0xf0, 0x82, 0x53, 0x96, 0x44, 0x94, 0xc5, 0xb1, 0x50, 0x7a,
+	0x28, 0x8b, 0xa9, 0x5d, 0x4c, 0xf5, 0x9f, 0x3f, 0x9b, 0xd0, 0x49, 0xc4, 0x2f, 0xc6, 0x67, 0x53,
+	0x26, 0xcf, 0x0b, 0xc6, 0x76, 0x9f, 0x1d, 0x2a, 0x03, 0x91, 0x70, 0xc3, 0x69, 0x7e, 0x45, 0x80,
+	0x5f, 0xe7, 0x36, 0x46, 0x39, 0x28, 0x3d, 0x7e, 0x39, 0xe3, 0x52, 0xe1, 0x43, 0xa8, 0x88, 0x74,
+	0xcc, 0xd3, 0x3a, 0xb2, 0x51, 0xeb, 0x96, 0xf3, 0x88, 0x6c, 0x8f, 0x43, 0x4e, 0x73, 0x92, 0x67,
+	0xb8, 0xf8, 0x39, 0xc0, 0xda, 0x43, 0xbd, 0x6c, 0xa3, 0xd6, 0x9e, 0xd3, 0x20, 0xc6, 0x26, 0x59,
+	0xd9, 0x24, 0xbd, 0x9c, 0xf2, 0x8a, 0xc9, 0x73, 0xef, 0xe6, 0x64, 0xf5, 0x89, 0x7b, 0x00, 0xeb,
+	0xf8, 0xf5, 0x40, 0xaf, 0x1e, 0x10, 0xd3, 0x15, 0xc9, 0xbb, 0x22, 0xa6, 0xdc, 0xa2, 0x2b, 0x32,
+	0x64, 0x21, 0x2f, 0xbc, 0x7a, 0x1b, 0x9b, 0xcd, 0xcf, 0x08, 0xf6, 0xff, 0x88, 0x23, 0x13, 0x11,
+	0x4b, 0x8e, 0x9f, 0x41, 0x55, 0xa7, 0x96, 0x75, 0x64, 0xdf, 0x68, 0xed, 0xed, 0x0a, 0xd4, 0x21,
+	0x7a, 0xcf, 0x2b, 0xc8, 0xf8, 0x64, 0x8b, 0xad, 0xc7, 0xff, 0xb4, 0x65, 0x34, 0x37, 0x7d, 0xb5,
+	0xfb, 0x50, 0xd1, 0x55, 0xe1, 0x7b, 0x70, 0xe7, 0xd4, 0xeb, 0xba, 0xde, 0xd9, 0x9b, 0xc1, 0x68,
+	0xe8, 0x1e, 0xf7, 0x7b, 0x7d, 0xb7, 0x5b, 0x2b, 0xe1, 0x7d, 0xb8, 0x6d, 0xe0, 0x97, 0xa3, 0x63,
+	0x77, 0xd0, 0xed, 0x0f, 0x4e, 0x6a, 0x08, 0xdf, 0x85, 0x9a, 0x01, 0xbb, 0xee, 0x6f, 0xb4, 0xec,
+	0x5c, 0x21, 0xa8, 0xe8, 0x88, 0xf8, 0x23, 0x82, 0xaa, 0xc9, 0x89, 0xdb, 0xbb, 0x2e, 0xe8, 0xef,
+	0xbb, 0x6d, 0x3c, 0xf9, 0x2f, 0xae, 0x09, 0xd1, 0x3c, 0xf8, 0xf0, 0xed, 0xe7, 0x55, 0xd9, 0xc6,
+	0x16, 0xdd, 0xf1, 0xe8, 0x4d, 0x53, 0x47, 0xf3, 0x2f, 0x0b, 0x0b, 0x5d, 0x2f, 0x2c, 0xf4, 0x63,
+	0x61, 0xa1, 0x4f, 0x4b, 0xab, 0x74, 0xbd, 0xb4, 0x4a, 0xdf, 0x97, 0x56, 0x09, 0x1e, 0x44, 0x62,
+	0x87, 0xe0, 0x10, 0xbd, 0x3d, 0x0a, 0x23, 0xf5, 0x6e, 0xe6, 0x93, 0x40, 0x4c, 0x37, 0x04, 0x9e,
+	0x46, 0x62, 0x53, 0xee, 0xfd, 0x5a, 0x50, 0xcd, 0x13, 0x2e, 0x69, 0xe6, 0xbc, 0x58, 0x21, 0x99,
+	0xe3, 0x57, 0xf5, 0xd3, 0x3a, 0xfc, 0x35, 0x00, 0x63, 0xd1, 0xfe, 0xb6, 0x97, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Scopes gets all the scopes.
+	Scopes(ctx context.Context, in *QueryScopesRequest, opts ...grpc.CallOption) (*QueryScopesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Scopes(ctx context.Context, in *QueryScopesRequest, opts ...grpc.CallOption) (*QueryScopesResponse, error) {
+	out := new(QueryScopesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v2.Query/Scopes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Scopes gets all the scopes.
+	Scopes(context.Context, *QueryScopesRequest) (*QueryScopesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Scopes(ctx context.Context, req *QueryScopesRequest) (*QueryScopesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Scopes not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Scopes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScopesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Scopes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v2.Query/Scopes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Scopes(ctx, req.(*QueryScopesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.metadata.v2.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Scopes",
+			Handler:    _Query_Scopes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provenance/metadata/v2/query.proto",
+}
+
+func (m *QueryScopesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScopesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScopesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.FieldMask != nil {
+		{
+			size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Order != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Order))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScopesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScopesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScopesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Scopes) > 0 {
+		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Scopes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryScopesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Order != 0 {
+		n += 1 + sovQuery(uint64(m.Order))
+	}
+	if m.FieldMask != nil {
+		l = m.FieldMask.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScopesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Scopes) > 0 {
+		for _, e := range m.Scopes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryScopesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScopesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScopesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			m.Order = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Order |= Order(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FieldMask == nil {
+				m.FieldMask = &types.FieldMask{}
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScopesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScopesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScopesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scopes = append(m.Scopes, &types1.Scope{})
+			if err := m.Scopes[len(m.Scopes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -345,4 +345,106 @@ func (s *IntegrationTestSuite) TestUpdateConversionFeeDenomProposal() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestUpdateWasmGasCostsProposal() {
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErrMsg string
+		expectedCode uint32
+		signer       string
+	}{
+		{
+			name:         "success - set and remove",
+			args:         []string{"/provenance.marker.v1.MsgMintRequest=50000", "--remove", "custom/marker"},
+			expectedCode: 0,
+			signer:       s.accountAddresses[0].String(),
+		},
+		{
+			name:         "failure - missing gas",
+			args:         []string{"/provenance.marker.v1.MsgMintRequest"},
+			expectErrMsg: `invalid wasm gas cost "/provenance.marker.v1.MsgMintRequest": expected format <operation>=<gas>`,
+			signer:       s.accountAddresses[0].String(),
+		},
+		{
+			name:         "failure - invalid gas",
+			args:         []string{"custom/marker=lots"},
+			expectErrMsg: `invalid wasm gas cost "custom/marker=lots": strconv.ParseUint: parsing "lots": invalid syntax`,
+			signer:       s.accountAddresses[0].String(),
+		},
+		{
+			name:         "failure - nothing to update",
+			args:         []string{},
+			expectErrMsg: "at least one wasm gas cost to set or remove is required",
+			signer:       s.accountAddresses[0].String(),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.GetUpdateWasmGasCostsProposal()
+			tc.args = append(tc.args,
+				"--title", "Update wasm gas costs proposal", "--summary", "Updates the wasm gas costs.",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, tc.signer),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+				fmt.Sprintf("--%s=json", cmtcli.OutputFlag),
+			)
+
+			testcli.NewTxExecutor(cmd, tc.args).
+				WithExpErrMsg(tc.expectErrMsg).
+				WithExpCode(tc.expectedCode).
+				Execute(s.T(), s.testnet)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestUpdateAlternateFeeDenomsProposal() {
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErrMsg string
+		expectedCode uint32
+		signer       string
+	}{
+		{
+			name:         "success - add and remove",
+			args:         []string{"uusdc.figure", "--remove", "uylds.fcc"},
+			expectedCode: 0,
+			signer:       s.accountAddresses[0].String(),
+		},
+		{
+			name:         "failure - invalid denom",
+			args:         []string{"x"},
+			expectErrMsg: "invalid alternate fee denom: invalid denom: x",
+			signer:       s.accountAddresses[0].String(),
+		},
+		{
+			name:         "failure - nothing to update",
+			args:         []string{},
+			expectErrMsg: "at least one alternate fee denom to add or remove is required",
+			signer:       s.accountAddresses[0].String(),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.GetUpdateAlternateFeeDenomsProposal()
+			tc.args = append(tc.args,
+				"--title", "Update alternate fee denoms proposal", "--summary", "Updates the alternate fee denoms.",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, tc.signer),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+				fmt.Sprintf("--%s=json", cmtcli.OutputFlag),
+			)
+
+			testcli.NewTxExecutor(cmd, tc.args).
+				WithExpErrMsg(tc.expectErrMsg).
+				WithExpCode(tc.expectedCode).
+				Execute(s.T(), s.testnet)
+		})
+	}
+}
+
 // TODO: Add query tests
@@ -23,6 +23,7 @@ const (
 	FlagMsgType   = "msg-type"
 	FlagRecipient = "recipient"
 	FlagBips      = "bips"
+	FlagRemove    = "remove"
 )
 
 func NewTxCmd() *cobra.Command {
@@ -39,6 +40,8 @@ func NewTxCmd() *cobra.Command {
 		GetCmdMsgFeesProposal(),
 		GetUpdateNhashPerUsdMilProposal(),
 		GetUpdateConversionFeeDenomProposal(),
+		GetUpdateWasmGasCostsProposal(),
+		GetUpdateAlternateFeeDenomsProposal(),
 	)
 
 	return txCmd
@@ -194,3 +197,93 @@ $ %[1]s tx msgfees cfd customcoin --deposit 1000000000nhash
 	provcli.AddAuthorityFlagToCmd(cmd)
 	return cmd
 }
+
+func GetUpdateWasmGasCostsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "wasm-gas-costs [<operation>=<gas> ...] [--remove <operation>]",
+		Aliases: []string{"wgc", "w-g-c"},
+		Short:   "Submit a wasm gas costs update proposal along with an initial deposit",
+		Long: strings.TrimSpace(`Submit a wasm gas costs update proposal along with an initial deposit.
+The wasm gas costs are the fixed amounts of gas charged when a smart contract invokes an operation.
+An operation is a msg type url, a query path, or "custom/<route>" for a custom provwasm query.
+Each argument sets the gas for an operation. The --remove flag can be provided multiple times.`),
+		Example: fmt.Sprintf(`$ %[1]s tx msgfees wasm-gas-costs /provenance.marker.v1.MsgMintRequest=50000 custom/marker=10000 --deposit 1000000000nhash
+$ %[1]s tx msgfees wgc --remove /provenance.metadata.v1.Query/Scope --deposit 1000000000nhash
+`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+			authority := provcli.GetAuthority(flagSet)
+
+			toSet := make([]types.WasmGasCost, len(args))
+			for i, arg := range args {
+				operation, gasStr, found := strings.Cut(arg, "=")
+				if !found {
+					return fmt.Errorf("invalid wasm gas cost %q: expected format <operation>=<gas>", arg)
+				}
+				gas, err := strconv.ParseUint(gasStr, 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid wasm gas cost %q: %w", arg, err)
+				}
+				toSet[i] = types.NewWasmGasCost(operation, gas)
+			}
+			toRemove, err := flagSet.GetStringSlice(FlagRemove)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateWasmGasCostsProposalRequest(toSet, toRemove, authority)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+	cmd.Flags().StringSlice(FlagRemove, nil, "operations that should no longer have a fixed gas cost")
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	return cmd
+}
+
+func GetUpdateAlternateFeeDenomsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "alternate-fee-denoms [<denom> ...] [--remove <denom>]",
+		Aliases: []string{"afd", "a-f-d"},
+		Short:   "Submit an alternate fee denoms update proposal along with an initial deposit",
+		Long: strings.TrimSpace(`Submit an alternate fee denoms update proposal along with an initial deposit.
+The alternate fee denoms are the marker denoms that the base fee can be paid in instead of the floor gas price denom.
+The base fee is converted to an alternate fee denom using the marker's net asset value.
+Each argument is a denom to add. The --remove flag can be provided multiple times.`),
+		Example: fmt.Sprintf(`$ %[1]s tx msgfees alternate-fee-denoms uusdc.figure --deposit 1000000000nhash
+$ %[1]s tx msgfees afd --remove uusdc.figure --deposit 1000000000nhash
+`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+			authority := provcli.GetAuthority(flagSet)
+
+			toRemove, err := flagSet.GetStringSlice(FlagRemove)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateAlternateFeeDenomsProposalRequest(args, toRemove, authority)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+	cmd.Flags().StringSlice(FlagRemove, nil, "denoms that the base fee can no longer be paid in")
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	return cmd
+}
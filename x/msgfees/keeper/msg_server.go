@@ -103,3 +103,31 @@ func (m msgServer) UpdateConversionFeeDenomProposal(goCtx context.Context, req *
 
 	return &types.MsgUpdateConversionFeeDenomProposalResponse{}, nil
 }
+
+func (m msgServer) UpdateWasmGasCostsProposal(goCtx context.Context, req *types.MsgUpdateWasmGasCostsProposalRequest) (*types.MsgUpdateWasmGasCostsProposalResponse, error) {
+	if m.GetAuthority() != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", m.GetAuthority(), req.Authority)
+	}
+
+	m.Keeper.UpdateWasmGasCostsParam(sdk.UnwrapSDKContext(goCtx), req.ToSet, req.ToRemove)
+
+	return &types.MsgUpdateWasmGasCostsProposalResponse{}, nil
+}
+
+func (m msgServer) UpdateAlternateFeeDenomsProposal(goCtx context.Context, req *types.MsgUpdateAlternateFeeDenomsProposalRequest) (*types.MsgUpdateAlternateFeeDenomsProposalResponse, error) {
+	if m.GetAuthority() != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", m.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	floorDenom := m.Keeper.GetFloorGasPrice(ctx).Denom
+	for _, denom := range req.ToAdd {
+		if denom == floorDenom {
+			return nil, fmt.Errorf("invalid alternate fee denom %q: cannot be the floor gas price denom", denom)
+		}
+	}
+
+	m.Keeper.UpdateAlternateFeeDenomsParam(ctx, req.ToAdd, req.ToRemove)
+
+	return &types.MsgUpdateAlternateFeeDenomsProposalResponse{}, nil
+}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -270,3 +271,114 @@ func (s *MsgServerTestSuite) TestUpdateConversionFeeDenomProposal() {
 		})
 	}
 }
+
+func (s *MsgServerTestSuite) TestUpdateWasmGasCostsProposal() {
+	tests := []struct {
+		name     string
+		msg      types.MsgUpdateWasmGasCostsProposalRequest
+		errorMsg string
+		expCosts []types.WasmGasCost
+	}{
+		{
+			name: "expected gov account for signer",
+			msg: types.MsgUpdateWasmGasCostsProposalRequest{
+				ToSet:     []types.WasmGasCost{types.NewWasmGasCost("/provenance.marker.v1.MsgMintRequest", 50_000)},
+				Authority: "",
+			},
+			errorMsg: `expected cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn got : expected gov account as only signer for proposal message`,
+		},
+		{
+			name: "set two costs",
+			msg: types.MsgUpdateWasmGasCostsProposalRequest{
+				ToSet: []types.WasmGasCost{
+					types.NewWasmGasCost("/provenance.marker.v1.MsgMintRequest", 50_000),
+					types.NewWasmGasCost("custom/marker", 10_000),
+				},
+				Authority: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+			},
+			expCosts: []types.WasmGasCost{
+				types.NewWasmGasCost("/provenance.marker.v1.MsgMintRequest", 50_000),
+				types.NewWasmGasCost("custom/marker", 10_000),
+			},
+		},
+		{
+			name: "update one cost and remove another",
+			msg: types.MsgUpdateWasmGasCostsProposalRequest{
+				ToSet:     []types.WasmGasCost{types.NewWasmGasCost("custom/marker", 20_000)},
+				ToRemove:  []string{"/provenance.marker.v1.MsgMintRequest"},
+				Authority: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+			},
+			expCosts: []types.WasmGasCost{types.NewWasmGasCost("custom/marker", 20_000)},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			response, err := s.msgServer.UpdateWasmGasCostsProposal(s.ctx, &tt.msg)
+			if len(tt.errorMsg) > 0 {
+				s.Assert().Error(err)
+				s.Assert().Equal(tt.errorMsg, err.Error())
+				s.Assert().Nil(response)
+			} else {
+				s.Assert().NoError(err)
+				s.Assert().NotNil(response)
+				s.Assert().Equal(tt.expCosts, s.app.MsgFeesKeeper.GetParams(s.ctx).WasmGasCosts, "WasmGasCosts")
+			}
+		})
+	}
+}
+
+func (s *MsgServerTestSuite) TestUpdateAlternateFeeDenomsProposal() {
+	tests := []struct {
+		name      string
+		msg       types.MsgUpdateAlternateFeeDenomsProposalRequest
+		errorMsg  string
+		expDenoms []string
+	}{
+		{
+			name: "expected gov account for signer",
+			msg: types.MsgUpdateAlternateFeeDenomsProposalRequest{
+				ToAdd:     []string{"uusdc.figure"},
+				Authority: "",
+			},
+			errorMsg: `expected cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn got : expected gov account as only signer for proposal message`,
+		},
+		{
+			name: "floor gas price denom",
+			msg: types.MsgUpdateAlternateFeeDenomsProposalRequest{
+				ToAdd:     []string{s.app.MsgFeesKeeper.GetFloorGasPrice(s.ctx).Denom},
+				Authority: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+			},
+			errorMsg: fmt.Sprintf("invalid alternate fee denom %q: cannot be the floor gas price denom", s.app.MsgFeesKeeper.GetFloorGasPrice(s.ctx).Denom),
+		},
+		{
+			name: "add two denoms",
+			msg: types.MsgUpdateAlternateFeeDenomsProposalRequest{
+				ToAdd:     []string{"uylds.fcc", "uusdc.figure"},
+				Authority: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+			},
+			expDenoms: []string{"uusdc.figure", "uylds.fcc"},
+		},
+		{
+			name: "remove one denom",
+			msg: types.MsgUpdateAlternateFeeDenomsProposalRequest{
+				ToRemove:  []string{"uylds.fcc"},
+				Authority: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+			},
+			expDenoms: []string{"uusdc.figure"},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			response, err := s.msgServer.UpdateAlternateFeeDenomsProposal(s.ctx, &tt.msg)
+			if len(tt.errorMsg) > 0 {
+				s.Assert().Error(err)
+				s.Assert().Equal(tt.errorMsg, err.Error())
+				s.Assert().Nil(response)
+			} else {
+				s.Assert().NoError(err)
+				s.Assert().NotNil(response)
+				s.Assert().Equal(tt.expDenoms, s.app.MsgFeesKeeper.GetAlternateFeeDenoms(s.ctx), "AlternateFeeDenoms")
+			}
+		})
+	}
+}
@@ -1,6 +1,10 @@
 package keeper
 
 import (
+	"sort"
+
+	storetypes "cosmossdk.io/store/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/x/msgfees/types"
@@ -64,3 +68,69 @@ func (k Keeper) UpdateNhashPerUsdMilParam(ctx sdk.Context, nhashPerUsdMil uint64
 	params.NhashPerUsdMil = nhashPerUsdMil
 	k.SetParams(ctx, params)
 }
+
+// GetWasmGasCost returns the fixed gas cost for an operation invoked by a smart contract, and whether there is one.
+// The params are read using an infinite gas meter so that the lookup itself does not cost anything.
+func (k Keeper) GetWasmGasCost(ctx sdk.Context, operation string) (uint64, bool) {
+	params := k.GetParams(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()))
+	for _, cost := range params.WasmGasCosts {
+		if cost.Operation == operation {
+			return cost.Gas, true
+		}
+	}
+	return 0, false
+}
+
+// UpdateWasmGasCostsParam sets and removes entries in the wasm gas costs param.
+// The resulting entries are sorted by operation.
+func (k Keeper) UpdateWasmGasCostsParam(ctx sdk.Context, toSet []types.WasmGasCost, toRemove []string) {
+	params := k.GetParams(ctx)
+	costs := make(map[string]uint64, len(params.WasmGasCosts)+len(toSet))
+	for _, cost := range params.WasmGasCosts {
+		costs[cost.Operation] = cost.Gas
+	}
+	for _, operation := range toRemove {
+		delete(costs, operation)
+	}
+	for _, cost := range toSet {
+		costs[cost.Operation] = cost.Gas
+	}
+
+	params.WasmGasCosts = make([]types.WasmGasCost, 0, len(costs))
+	for operation, gas := range costs {
+		params.WasmGasCosts = append(params.WasmGasCosts, types.NewWasmGasCost(operation, gas))
+	}
+	sort.Slice(params.WasmGasCosts, func(i, j int) bool {
+		return params.WasmGasCosts[i].Operation < params.WasmGasCosts[j].Operation
+	})
+	k.SetParams(ctx, params)
+}
+
+// GetAlternateFeeDenoms returns the marker denoms that the base fee can be paid in instead of the floor gas price denom.
+func (k Keeper) GetAlternateFeeDenoms(ctx sdk.Context) []string {
+	params := k.GetParams(ctx)
+	return params.AlternateFeeDenoms
+}
+
+// UpdateAlternateFeeDenomsParam adds and removes entries in the alternate fee denoms param.
+// The resulting denoms are sorted.
+func (k Keeper) UpdateAlternateFeeDenomsParam(ctx sdk.Context, toAdd []string, toRemove []string) {
+	params := k.GetParams(ctx)
+	denoms := make(map[string]bool, len(params.AlternateFeeDenoms)+len(toAdd))
+	for _, denom := range params.AlternateFeeDenoms {
+		denoms[denom] = true
+	}
+	for _, denom := range toRemove {
+		delete(denoms, denom)
+	}
+	for _, denom := range toAdd {
+		denoms[denom] = true
+	}
+
+	params.AlternateFeeDenoms = make([]string, 0, len(denoms))
+	for denom := range denoms {
+		params.AlternateFeeDenoms = append(params.AlternateFeeDenoms, denom)
+	}
+	sort.Strings(params.AlternateFeeDenoms)
+	k.SetParams(ctx, params)
+}
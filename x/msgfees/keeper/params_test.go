@@ -51,3 +51,52 @@ func (s *MsgFeesParamTestSuite) TestGetSetParams() {
 	s.Require().Equal(newNhashPerUsdMil, updatedParams.NhashPerUsdMil, "Updated NhashPerUsdMil should match")
 	s.Require().Equal(newConversionFeeDenom, updatedParams.ConversionFeeDenom, "Updated ConversionFeeDenom should match")
 }
+
+func (s *MsgFeesParamTestSuite) TestWasmGasCosts() {
+	keeper := s.app.MsgFeesKeeper
+	s.Require().Empty(keeper.GetParams(s.ctx).WasmGasCosts, "Default WasmGasCosts")
+
+	_, found := keeper.GetWasmGasCost(s.ctx, "custom/marker")
+	s.Require().False(found, "GetWasmGasCost(custom/marker) found before it was set")
+
+	keeper.UpdateWasmGasCostsParam(s.ctx, []types.WasmGasCost{
+		types.NewWasmGasCost("custom/marker", 10_000),
+		types.NewWasmGasCost("/provenance.metadata.v1.Query/Scope", 15_000),
+		types.NewWasmGasCost("/provenance.exchange.v1.MsgFillBidsRequest", 100_000),
+	}, nil)
+	expCosts := []types.WasmGasCost{
+		types.NewWasmGasCost("/provenance.exchange.v1.MsgFillBidsRequest", 100_000),
+		types.NewWasmGasCost("/provenance.metadata.v1.Query/Scope", 15_000),
+		types.NewWasmGasCost("custom/marker", 10_000),
+	}
+	s.Require().Equal(expCosts, keeper.GetParams(s.ctx).WasmGasCosts, "WasmGasCosts after first update")
+
+	gasBefore := s.ctx.GasMeter().GasConsumed()
+	gas, found := keeper.GetWasmGasCost(s.ctx, "custom/marker")
+	s.Require().True(found, "GetWasmGasCost(custom/marker) found")
+	s.Require().Equal(uint64(10_000), gas, "GetWasmGasCost(custom/marker) gas")
+	s.Require().Equal(gasBefore, s.ctx.GasMeter().GasConsumed(), "gas consumed by GetWasmGasCost")
+
+	keeper.UpdateWasmGasCostsParam(s.ctx,
+		[]types.WasmGasCost{types.NewWasmGasCost("custom/marker", 12_000)},
+		[]string{"/provenance.metadata.v1.Query/Scope", "/not.set.Msg"})
+	expCosts = []types.WasmGasCost{
+		types.NewWasmGasCost("/provenance.exchange.v1.MsgFillBidsRequest", 100_000),
+		types.NewWasmGasCost("custom/marker", 12_000),
+	}
+	s.Require().Equal(expCosts, keeper.GetParams(s.ctx).WasmGasCosts, "WasmGasCosts after second update")
+
+	_, found = keeper.GetWasmGasCost(s.ctx, "/provenance.metadata.v1.Query/Scope")
+	s.Require().False(found, "GetWasmGasCost(Query/Scope) found after it was removed")
+}
+
+func (s *MsgFeesParamTestSuite) TestAlternateFeeDenoms() {
+	keeper := s.app.MsgFeesKeeper
+	s.Require().Empty(keeper.GetAlternateFeeDenoms(s.ctx), "Default AlternateFeeDenoms")
+
+	keeper.UpdateAlternateFeeDenomsParam(s.ctx, []string{"uylds.fcc", "uusdc.figure"}, nil)
+	s.Require().Equal([]string{"uusdc.figure", "uylds.fcc"}, keeper.GetAlternateFeeDenoms(s.ctx), "AlternateFeeDenoms after first update")
+
+	keeper.UpdateAlternateFeeDenomsParam(s.ctx, []string{"ausd", "uusdc.figure"}, []string{"uylds.fcc", "notset"})
+	s.Require().Equal([]string{"ausd", "uusdc.figure"}, keeper.GetAlternateFeeDenoms(s.ctx), "AlternateFeeDenoms after second update")
+}
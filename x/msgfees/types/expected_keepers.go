@@ -7,6 +7,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
 )
 
 // AccountKeeper defines the expected account keeper (noalias)
@@ -26,6 +28,7 @@ type MsgFeesKeeper interface {
 	GetNhashPerUsdMil(ctx sdk.Context) uint64
 	ConvertDenomToHash(ctx sdk.Context, coin sdk.Coin) (sdk.Coin, error)
 	CalculateAdditionalFeesToBePaid(ctx sdk.Context, msgs ...sdk.Msg) (MsgFeesDistribution, error)
+	GetAlternateFeeDenoms(ctx sdk.Context) []string
 }
 
 // FeegrantKeeper defines the expected feegrant keeper.
@@ -33,3 +36,8 @@ type FeegrantKeeper interface {
 	GetAllowance(ctx context.Context, granter sdk.AccAddress, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error)
 	UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
 }
+
+// MarkerKeeper defines the expected marker keeper used to get the net asset values of alternate fee denoms.
+type MarkerKeeper interface {
+	GetNetAssetValue(ctx sdk.Context, markerDenom, priceDenom string) (*markertypes.NetAssetValue, error)
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 )
@@ -16,6 +17,17 @@ func NewGenesisState(params Params, entries []MsgFee) *GenesisState {
 
 // Validate ensures all grants in the genesis state are valid
 func (state GenesisState) Validate() error {
+	if err := ValidateWasmGasCosts(state.Params.WasmGasCosts); err != nil {
+		return err
+	}
+	if err := ValidateAlternateFeeDenoms(state.Params.AlternateFeeDenoms); err != nil {
+		return err
+	}
+	for _, denom := range state.Params.AlternateFeeDenoms {
+		if denom == state.Params.FloorGasPrice.Denom {
+			return fmt.Errorf("invalid alternate fee denom %q: cannot be the floor gas price denom", denom)
+		}
+	}
 	for _, a := range state.MsgFees {
 		if err := a.Validate(); err != nil {
 			return err
@@ -1,7 +1,9 @@
 package types
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -46,3 +48,72 @@ func (msg *MsgFee) Validate() error {
 
 	return nil
 }
+
+// WasmGasCostCustomPrefix is the prefix of a WasmGasCost operation that identifies a custom provwasm query route.
+const WasmGasCostCustomPrefix = "custom/"
+
+func NewWasmGasCost(operation string, gas uint64) WasmGasCost {
+	return WasmGasCost{
+		Operation: operation,
+		Gas:       gas,
+	}
+}
+
+// Validate makes sure the operation is a msg type url, query path, or custom query route, and that the gas is positive.
+func (c WasmGasCost) Validate() error {
+	if err := ValidateWasmGasCostOperation(c.Operation); err != nil {
+		return err
+	}
+	if c.Gas == 0 {
+		return fmt.Errorf("invalid wasm gas cost for %q: gas must be greater than 0", c.Operation)
+	}
+	return nil
+}
+
+// ValidateWasmGasCostOperation makes sure the provided operation is a msg type url, query path, or custom query route.
+func ValidateWasmGasCostOperation(operation string) error {
+	switch {
+	case len(operation) == 0:
+		return errors.New("invalid wasm gas cost operation: cannot be empty")
+	case strings.HasPrefix(operation, WasmGasCostCustomPrefix):
+		if len(operation) == len(WasmGasCostCustomPrefix) {
+			return fmt.Errorf("invalid wasm gas cost operation %q: custom route cannot be empty", operation)
+		}
+	case !strings.HasPrefix(operation, "/"):
+		return fmt.Errorf("invalid wasm gas cost operation %q: must start with \"/\" or %q", operation, WasmGasCostCustomPrefix)
+	}
+	return nil
+}
+
+// ValidateWasmGasCosts makes sure each of the provided gas costs is valid and that no operation is listed twice.
+func ValidateWasmGasCosts(costs []WasmGasCost) error {
+	seen := make(map[string]bool, len(costs))
+	for _, cost := range costs {
+		if err := cost.Validate(); err != nil {
+			return err
+		}
+		if seen[cost.Operation] {
+			return fmt.Errorf("duplicate wasm gas cost operation %q", cost.Operation)
+		}
+		seen[cost.Operation] = true
+	}
+	return nil
+}
+
+// ValidateAlternateFeeDenoms makes sure each of the provided alternate fee denoms is valid and not listed twice.
+func ValidateAlternateFeeDenoms(denoms []string) error {
+	seen := make(map[string]bool, len(denoms))
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid alternate fee denom: %w", err)
+		}
+		if denom == UsdDenom {
+			return fmt.Errorf("invalid alternate fee denom %q: not a marker denom", denom)
+		}
+		if seen[denom] {
+			return fmt.Errorf("duplicate alternate fee denom %q", denom)
+		}
+		seen[denom] = true
+	}
+	return nil
+}
@@ -40,6 +40,12 @@ type Params struct {
 	NhashPerUsdMil uint64 `protobuf:"varint,3,opt,name=nhash_per_usd_mil,json=nhashPerUsdMil,proto3" json:"nhash_per_usd_mil,omitempty"`
 	// conversion_fee_denom is the denom usd is converted to.
 	ConversionFeeDenom string `protobuf:"bytes,4,opt,name=conversion_fee_denom,json=conversionFeeDenom,proto3" json:"conversion_fee_denom,omitempty"`
+	// wasm_gas_costs are the fixed gas costs charged for operations invoked by smart contracts.
+	// Operations without an entry are charged the gas they actually use.
+	WasmGasCosts []WasmGasCost `protobuf:"bytes,5,rep,name=wasm_gas_costs,json=wasmGasCosts,proto3" json:"wasm_gas_costs"`
+	// alternate_fee_denoms are the marker denoms that the base fee can be paid in instead of the floor gas price denom.
+	// The base fee is converted to one of these denoms using the marker's net asset value.
+	AlternateFeeDenoms []string `protobuf:"bytes,6,rep,name=alternate_fee_denoms,json=alternateFeeDenoms,proto3" json:"alternate_fee_denoms,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -96,6 +102,79 @@ func (m *Params) GetConversionFeeDenom() string {
 	return ""
 }
 
+func (m *Params) GetWasmGasCosts() []WasmGasCost {
+	if m != nil {
+		return m.WasmGasCosts
+	}
+	return nil
+}
+
+func (m *Params) GetAlternateFeeDenoms() []string {
+	if m != nil {
+		return m.AlternateFeeDenoms
+	}
+	return nil
+}
+
+// WasmGasCost is the fixed amount of gas charged when a smart contract invokes an operation.
+// This amount is charged up front, and the operation fails if it needs more gas than this.
+type WasmGasCost struct {
+	// operation identifies what is being priced. It can be one of:
+	//   - A msg type url, e.g. "/provenance.exchange.v1.MsgFillBidsRequest".
+	//   - A whitelisted query path, e.g. "/provenance.metadata.v1.Query/Scope".
+	//   - A custom provwasm query route prefixed with "custom/", e.g. "custom/marker".
+	Operation string `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	// gas is the fixed amount of gas charged for the operation.
+	Gas uint64 `protobuf:"varint,2,opt,name=gas,proto3" json:"gas,omitempty"`
+}
+
+func (m *WasmGasCost) Reset()         { *m = WasmGasCost{} }
+func (m *WasmGasCost) String() string { return proto.CompactTextString(m) }
+func (*WasmGasCost) ProtoMessage()    {}
+func (*WasmGasCost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6265859d114362, []int{1}
+}
+func (m *WasmGasCost) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WasmGasCost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WasmGasCost.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WasmGasCost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WasmGasCost.Merge(m, src)
+}
+func (m *WasmGasCost) XXX_Size() int {
+	return m.Size()
+}
+func (m *WasmGasCost) XXX_DiscardUnknown() {
+	xxx_messageInfo_WasmGasCost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WasmGasCost proto.InternalMessageInfo
+
+func (m *WasmGasCost) GetOperation() string {
+	if m != nil {
+		return m.Operation
+	}
+	return ""
+}
+
+func (m *WasmGasCost) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
 // MsgFee is the core of what gets stored on the blockchain to define a msg-based fee.
 type MsgFee struct {
 	// msg_type_url is the type-url of the message with the added fee, e.g. "/cosmos.bank.v1beta1.MsgSend".
@@ -119,7 +198,7 @@ func (m *MsgFee) Reset()         { *m = MsgFee{} }
 func (m *MsgFee) String() string { return proto.CompactTextString(m) }
 func (*MsgFee) ProtoMessage()    {}
 func (*MsgFee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6265859d114362, []int{1}
+	return fileDescriptor_0c6265859d114362, []int{2}
 }
 func (m *MsgFee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -188,7 +267,7 @@ func (m *EventMsgFee) Reset()         { *m = EventMsgFee{} }
 func (m *EventMsgFee) String() string { return proto.CompactTextString(m) }
 func (*EventMsgFee) ProtoMessage()    {}
 func (*EventMsgFee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6265859d114362, []int{2}
+	return fileDescriptor_0c6265859d114362, []int{3}
 }
 func (m *EventMsgFee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -254,7 +333,7 @@ func (m *EventMsgFees) Reset()         { *m = EventMsgFees{} }
 func (m *EventMsgFees) String() string { return proto.CompactTextString(m) }
 func (*EventMsgFees) ProtoMessage()    {}
 func (*EventMsgFees) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6265859d114362, []int{3}
+	return fileDescriptor_0c6265859d114362, []int{4}
 }
 func (m *EventMsgFees) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -290,11 +369,95 @@ func (m *EventMsgFees) GetMsgFees() []EventMsgFee {
 	return nil
 }
 
+// EventBaseFeeConverted is emitted when the base fee of a tx is paid in an alternate fee denom.
+type EventBaseFeeConverted struct {
+	// base_fee is the base fee in the floor gas price denom.
+	BaseFee string `protobuf:"bytes,1,opt,name=base_fee,json=baseFee,proto3" json:"base_fee,omitempty"`
+	// converted_fee is the amount of the alternate fee denom that was charged instead of the base fee.
+	ConvertedFee string `protobuf:"bytes,2,opt,name=converted_fee,json=convertedFee,proto3" json:"converted_fee,omitempty"`
+	// price is the net asset value price used for the conversion.
+	Price string `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+	// volume is the amount of the alternate fee denom that the price is for.
+	Volume string `protobuf:"bytes,4,opt,name=volume,proto3" json:"volume,omitempty"`
+	// fee_payer is the bech32 address of the account that paid the converted fee.
+	FeePayer string `protobuf:"bytes,5,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+}
+
+func (m *EventBaseFeeConverted) Reset()         { *m = EventBaseFeeConverted{} }
+func (m *EventBaseFeeConverted) String() string { return proto.CompactTextString(m) }
+func (*EventBaseFeeConverted) ProtoMessage()    {}
+func (*EventBaseFeeConverted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6265859d114362, []int{5}
+}
+func (m *EventBaseFeeConverted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBaseFeeConverted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBaseFeeConverted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBaseFeeConverted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBaseFeeConverted.Merge(m, src)
+}
+func (m *EventBaseFeeConverted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBaseFeeConverted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBaseFeeConverted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBaseFeeConverted proto.InternalMessageInfo
+
+func (m *EventBaseFeeConverted) GetBaseFee() string {
+	if m != nil {
+		return m.BaseFee
+	}
+	return ""
+}
+
+func (m *EventBaseFeeConverted) GetConvertedFee() string {
+	if m != nil {
+		return m.ConvertedFee
+	}
+	return ""
+}
+
+func (m *EventBaseFeeConverted) GetPrice() string {
+	if m != nil {
+		return m.Price
+	}
+	return ""
+}
+
+func (m *EventBaseFeeConverted) GetVolume() string {
+	if m != nil {
+		return m.Volume
+	}
+	return ""
+}
+
+func (m *EventBaseFeeConverted) GetFeePayer() string {
+	if m != nil {
+		return m.FeePayer
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "provenance.msgfees.v1.Params")
+	proto.RegisterType((*WasmGasCost)(nil), "provenance.msgfees.v1.WasmGasCost")
 	proto.RegisterType((*MsgFee)(nil), "provenance.msgfees.v1.MsgFee")
 	proto.RegisterType((*EventMsgFee)(nil), "provenance.msgfees.v1.EventMsgFee")
 	proto.RegisterType((*EventMsgFees)(nil), "provenance.msgfees.v1.EventMsgFees")
+	proto.RegisterType((*EventBaseFeeConverted)(nil), "provenance.msgfees.v1.EventBaseFeeConverted")
 }
 
 func init() {
@@ -302,38 +465,48 @@ func init() {
 }
 
 var fileDescriptor_0c6265859d114362 = []byte{
-	// 495 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xcd, 0xd2, 0x10, 0xc8, 0xa6, 0x2d, 0xc2, 0x0a, 0xc8, 0xad, 0x90, 0x1b, 0x85, 0x4b, 0x38,
-	0x60, 0x93, 0x96, 0x13, 0xc7, 0x14, 0xd2, 0x53, 0xa5, 0xc8, 0xd0, 0x0b, 0x97, 0xd5, 0xc6, 0x99,
-	0x38, 0x2b, 0xd9, 0x3b, 0xd6, 0xee, 0xc6, 0xa2, 0x7f, 0xc1, 0x27, 0xf0, 0x11, 0x7c, 0x03, 0xea,
-	0xb1, 0x47, 0x4e, 0x08, 0x25, 0x17, 0x3e, 0x03, 0xad, 0xed, 0xc4, 0x01, 0xf5, 0xd0, 0xdb, 0xce,
-	0xbe, 0x79, 0x6f, 0xde, 0x5b, 0xcd, 0xd2, 0x97, 0x99, 0xc2, 0x1c, 0x24, 0x97, 0x11, 0x04, 0xa9,
-	0x8e, 0xe7, 0x00, 0x3a, 0xc8, 0x87, 0x9b, 0xa3, 0x9f, 0x29, 0x34, 0xe8, 0x3c, 0xab, 0x9b, 0xfc,
-	0x0d, 0x92, 0x0f, 0x8f, 0xbb, 0x31, 0xc6, 0x58, 0x74, 0x04, 0xf6, 0x54, 0x36, 0x1f, 0x7b, 0x11,
-	0xea, 0x14, 0x75, 0x30, 0xe5, 0x1a, 0x82, 0x7c, 0x38, 0x05, 0xc3, 0x87, 0x41, 0x84, 0x42, 0x96,
-	0x78, 0xff, 0x3b, 0xa1, 0xad, 0x09, 0x57, 0x3c, 0xd5, 0xce, 0x05, 0x7d, 0x32, 0x4f, 0x10, 0x15,
-	0x8b, 0xb9, 0x66, 0x99, 0x12, 0x11, 0xb8, 0x0f, 0x7a, 0x64, 0xd0, 0x39, 0x3d, 0xf2, 0x4b, 0x11,
-	0xdf, 0x8a, 0xf8, 0x95, 0x88, 0x7f, 0x8e, 0x42, 0x8e, 0x9a, 0x37, 0xbf, 0x4e, 0x1a, 0xe1, 0x41,
-	0xc1, 0xbb, 0xe0, 0x7a, 0x62, 0x59, 0xce, 0x2b, 0xfa, 0x54, 0x2e, 0xb8, 0x5e, 0xb0, 0x0c, 0x14,
-	0x5b, 0xea, 0x19, 0x4b, 0x45, 0xe2, 0xee, 0xf5, 0xc8, 0xa0, 0x19, 0x1e, 0x16, 0xc0, 0x04, 0xd4,
-	0x95, 0x9e, 0x5d, 0x8a, 0xc4, 0x79, 0x43, 0xbb, 0x11, 0xca, 0x1c, 0x94, 0x16, 0x28, 0xd9, 0x1c,
-	0x80, 0xcd, 0x40, 0x62, 0xea, 0x36, 0x7b, 0x64, 0xd0, 0x0e, 0x9d, 0x1a, 0x1b, 0x03, 0xbc, 0xb7,
-	0xc8, 0xbb, 0xe6, 0x9f, 0x6f, 0x27, 0x8d, 0xfe, 0x0f, 0x42, 0x5b, 0x97, 0x3a, 0x1e, 0x03, 0x38,
-	0x3d, 0xba, 0x9f, 0xea, 0x98, 0x99, 0xeb, 0x0c, 0xd8, 0x52, 0x25, 0x2e, 0x29, 0xa8, 0x34, 0xd5,
-	0xf1, 0xa7, 0xeb, 0x0c, 0xae, 0x54, 0xe2, 0x8c, 0xe9, 0x21, 0x9f, 0xcd, 0x84, 0x11, 0x28, 0x79,
-	0x62, 0x87, 0xdc, 0x3b, 0x57, 0x4d, 0xb3, 0x93, 0x5e, 0xd0, 0xb6, 0x82, 0x48, 0x64, 0x02, 0xa4,
-	0x29, 0xf2, 0xb4, 0xc3, 0xfa, 0xc2, 0x79, 0x4b, 0x9f, 0x6f, 0x0b, 0x36, 0xe5, 0x5a, 0x68, 0x96,
-	0xa1, 0x90, 0x46, 0x17, 0x61, 0x0e, 0xc2, 0xee, 0x16, 0x1d, 0x59, 0x70, 0x52, 0x60, 0x7d, 0x45,
-	0x3b, 0x1f, 0x72, 0x90, 0xa6, 0x0a, 0x73, 0x44, 0x1f, 0x6f, 0xc2, 0x54, 0x41, 0x1e, 0x55, 0x41,
-	0x9c, 0x2e, 0x7d, 0x18, 0xe1, 0x52, 0x9a, 0xc2, 0x7c, 0x3b, 0x2c, 0x0b, 0x7b, 0x6b, 0xd0, 0xf0,
-	0xa4, 0xf2, 0x53, 0x16, 0xff, 0x3a, 0x6d, 0xfe, 0xe7, 0xb4, 0xff, 0x91, 0xee, 0xef, 0xcc, 0xd4,
-	0xce, 0x79, 0x39, 0xd4, 0x2e, 0x92, 0x4b, 0x7a, 0x7b, 0x83, 0xce, 0x69, 0xdf, 0xbf, 0x73, 0xc7,
-	0xfc, 0x1d, 0x5a, 0xf5, 0x44, 0xd6, 0x9e, 0x15, 0x19, 0x89, 0x9b, 0x95, 0x47, 0x6e, 0x57, 0x1e,
-	0xf9, 0xbd, 0xf2, 0xc8, 0xd7, 0xb5, 0xd7, 0xb8, 0x5d, 0x7b, 0x8d, 0x9f, 0x6b, 0xaf, 0x41, 0x5d,
-	0x81, 0x77, 0xcb, 0x4d, 0xc8, 0xe7, 0xb3, 0x58, 0x98, 0xc5, 0x72, 0xea, 0x47, 0x98, 0x06, 0x75,
-	0xcf, 0x6b, 0x81, 0x3b, 0x55, 0xf0, 0x65, 0xfb, 0x17, 0xec, 0xbb, 0xe8, 0x69, 0xab, 0x58, 0xdd,
-	0xb3, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf6, 0xf6, 0x22, 0x0d, 0x2e, 0x03, 0x00, 0x00,
+	// 651 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x8e, 0x9b, 0x9f, 0x36, 0x9b, 0xb4, 0xc0, 0x2a, 0xad, 0xdc, 0x52, 0xa5, 0x51, 0x7a, 0x09,
+	0x07, 0x6c, 0xd2, 0x72, 0x42, 0xe2, 0x92, 0x40, 0x7b, 0x2a, 0x8a, 0x0c, 0x15, 0x12, 0x17, 0x6b,
+	0xe3, 0x4c, 0xdc, 0x95, 0x6c, 0xaf, 0xb5, 0xb3, 0x49, 0xe9, 0x5b, 0xf0, 0x08, 0x88, 0x87, 0xe0,
+	0x11, 0x50, 0x8f, 0x3d, 0x72, 0x42, 0xa8, 0xbd, 0xf0, 0x18, 0x68, 0xd7, 0x4e, 0x1c, 0x50, 0x85,
+	0xb8, 0xed, 0xcc, 0x37, 0x33, 0xfb, 0x7d, 0xf3, 0xad, 0x4d, 0x0e, 0x53, 0x29, 0xe6, 0x90, 0xb0,
+	0x24, 0x00, 0x37, 0xc6, 0x70, 0x0a, 0x80, 0xee, 0xbc, 0xbf, 0x38, 0x3a, 0xa9, 0x14, 0x4a, 0xd0,
+	0xed, 0xa2, 0xc8, 0x59, 0x20, 0xf3, 0xfe, 0x5e, 0x2b, 0x14, 0xa1, 0x30, 0x15, 0xae, 0x3e, 0x65,
+	0xc5, 0x7b, 0xed, 0x40, 0x60, 0x2c, 0xd0, 0x1d, 0x33, 0x04, 0x77, 0xde, 0x1f, 0x83, 0x62, 0x7d,
+	0x37, 0x10, 0x3c, 0xc9, 0xf0, 0xee, 0xd7, 0x35, 0x52, 0x1b, 0x31, 0xc9, 0x62, 0xa4, 0xa7, 0xe4,
+	0xc1, 0x34, 0x12, 0x42, 0xfa, 0x21, 0x43, 0x3f, 0x95, 0x3c, 0x00, 0x7b, 0xad, 0x63, 0xf5, 0x1a,
+	0x47, 0xbb, 0x4e, 0x36, 0xc4, 0xd1, 0x43, 0x9c, 0x7c, 0x88, 0x33, 0x14, 0x3c, 0x19, 0x54, 0xae,
+	0x7f, 0x1c, 0x94, 0xbc, 0x4d, 0xd3, 0x77, 0xca, 0x70, 0xa4, 0xbb, 0xe8, 0x13, 0xf2, 0x28, 0xb9,
+	0x60, 0x78, 0xe1, 0xa7, 0x20, 0xfd, 0x19, 0x4e, 0xfc, 0x98, 0x47, 0x76, 0xb9, 0x63, 0xf5, 0x2a,
+	0xde, 0x96, 0x01, 0x46, 0x20, 0xcf, 0x71, 0x72, 0xc6, 0x23, 0xfa, 0x8c, 0xb4, 0x02, 0x91, 0xcc,
+	0x41, 0x22, 0x17, 0x89, 0x3f, 0x05, 0xf0, 0x27, 0x90, 0x88, 0xd8, 0xae, 0x74, 0xac, 0x5e, 0xdd,
+	0xa3, 0x05, 0x76, 0x02, 0xf0, 0x4a, 0x23, 0xf4, 0x0d, 0xd9, 0xba, 0x64, 0x18, 0x1b, 0x92, 0x81,
+	0x40, 0x85, 0x76, 0xb5, 0x53, 0xee, 0x35, 0x8e, 0xba, 0xce, 0xbd, 0x6b, 0x71, 0xde, 0x33, 0x8c,
+	0x4f, 0x19, 0x0e, 0x05, 0xaa, 0x9c, 0x6d, 0xf3, 0xb2, 0x48, 0xa1, 0x66, 0xc0, 0x22, 0x05, 0x32,
+	0x61, 0x0a, 0x0a, 0x02, 0x68, 0xd7, 0x3a, 0x65, 0xcd, 0x60, 0x89, 0x2d, 0x08, 0xe0, 0x8b, 0xca,
+	0xaf, 0xcf, 0x07, 0xa5, 0xee, 0x4b, 0xd2, 0x58, 0x19, 0x4d, 0xf7, 0x49, 0x5d, 0xa4, 0x20, 0x99,
+	0xe2, 0x22, 0xb1, 0x2d, 0xc3, 0xbe, 0x48, 0xd0, 0x87, 0xa4, 0x1c, 0x32, 0x34, 0xeb, 0xac, 0x78,
+	0xfa, 0xd8, 0xfd, 0x66, 0x91, 0xda, 0x19, 0x86, 0x27, 0x00, 0xb4, 0x43, 0x9a, 0x31, 0x86, 0xbe,
+	0xba, 0x4a, 0xc1, 0x9f, 0xc9, 0x28, 0xef, 0x26, 0x31, 0x86, 0xef, 0xae, 0x52, 0x38, 0x97, 0x11,
+	0x3d, 0x21, 0x5b, 0x6c, 0x32, 0xe1, 0x7a, 0x14, 0x8b, 0x34, 0xc9, 0xff, 0x36, 0xa6, 0x68, 0xd3,
+	0x37, 0xed, 0x93, 0xba, 0x84, 0x80, 0xa7, 0x1c, 0x12, 0x65, 0x0c, 0xa9, 0x7b, 0x45, 0x82, 0x3e,
+	0x27, 0x3b, 0xcb, 0xc0, 0x1f, 0x33, 0xe4, 0xe8, 0xa7, 0x82, 0x27, 0x0a, 0x8d, 0x1b, 0x9b, 0x5e,
+	0x6b, 0x89, 0x0e, 0x34, 0x38, 0x32, 0x58, 0x57, 0x92, 0xc6, 0xeb, 0x39, 0x24, 0x2a, 0x17, 0xb3,
+	0x4b, 0x36, 0x16, 0x62, 0x72, 0x21, 0xeb, 0xb9, 0x10, 0xda, 0x22, 0xd5, 0x40, 0xcc, 0x12, 0x65,
+	0xc8, 0xd7, 0xbd, 0x2c, 0xd0, 0x59, 0x25, 0x14, 0x8b, 0x72, 0x3e, 0x59, 0xf0, 0x27, 0xd3, 0xca,
+	0x5f, 0x4c, 0xbb, 0x6f, 0x49, 0x73, 0xe5, 0x4e, 0xa4, 0xc3, 0xec, 0x52, 0x6d, 0xb9, 0x6d, 0xfd,
+	0xf3, 0x35, 0xac, 0xb4, 0xe5, 0x2b, 0x5a, 0x8f, 0xb3, 0x21, 0xdd, 0x2f, 0x16, 0xd9, 0x36, 0xf0,
+	0x80, 0xa1, 0x76, 0x7b, 0x68, 0xde, 0x9e, 0x82, 0x89, 0xd6, 0xa4, 0x17, 0x6c, 0x16, 0x9f, 0x6b,
+	0x1a, 0x67, 0x35, 0xf4, 0x90, 0x6c, 0x06, 0x8b, 0xba, 0xa5, 0x31, 0x75, 0xaf, 0xb9, 0x4c, 0xea,
+	0xa2, 0x16, 0xa9, 0x66, 0x9f, 0x53, 0x2e, 0xd1, 0x04, 0x74, 0x87, 0xd4, 0xe6, 0x22, 0x9a, 0xc5,
+	0x90, 0xeb, 0xcb, 0x23, 0xfa, 0x98, 0xd4, 0xf5, 0x33, 0x4c, 0xd9, 0x15, 0x48, 0xbb, 0x6a, 0xa0,
+	0x8d, 0x29, 0xc0, 0x48, 0xc7, 0x03, 0x7e, 0x7d, 0xdb, 0xb6, 0x6e, 0x6e, 0xdb, 0xd6, 0xcf, 0xdb,
+	0xb6, 0xf5, 0xe9, 0xae, 0x5d, 0xba, 0xb9, 0x6b, 0x97, 0xbe, 0xdf, 0xb5, 0x4b, 0xc4, 0xe6, 0xe2,
+	0x7e, 0xcd, 0x23, 0xeb, 0xc3, 0x71, 0xc8, 0xd5, 0xc5, 0x6c, 0xec, 0x04, 0x22, 0x76, 0x8b, 0x9a,
+	0xa7, 0x5c, 0xac, 0x44, 0xee, 0xc7, 0xe5, 0x1f, 0x47, 0x9b, 0x87, 0xe3, 0x9a, 0xf9, 0x41, 0x1c,
+	0xff, 0x1e, 0x00, 0xaf, 0xc1, 0x55, 0xe7, 0x94, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -356,6 +529,29 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AlternateFeeDenoms) > 0 {
+		for iNdEx := len(m.AlternateFeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AlternateFeeDenoms[iNdEx])
+			copy(dAtA[i:], m.AlternateFeeDenoms[iNdEx])
+			i = encodeVarintMsgfees(dAtA, i, uint64(len(m.AlternateFeeDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.WasmGasCosts) > 0 {
+		for iNdEx := len(m.WasmGasCosts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WasmGasCosts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgfees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.ConversionFeeDenom) > 0 {
 		i -= len(m.ConversionFeeDenom)
 		copy(dAtA[i:], m.ConversionFeeDenom)
@@ -381,6 +577,41 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WasmGasCost) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WasmGasCost) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WasmGasCost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		i = encodeVarintMsgfees(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Operation) > 0 {
+		i -= len(m.Operation)
+		copy(dAtA[i:], m.Operation)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Operation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgFee) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -521,6 +752,64 @@ func (m *EventMsgFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventBaseFeeConverted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBaseFeeConverted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBaseFeeConverted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Volume) > 0 {
+		i -= len(m.Volume)
+		copy(dAtA[i:], m.Volume)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Volume)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConvertedFee) > 0 {
+		i -= len(m.ConvertedFee)
+		copy(dAtA[i:], m.ConvertedFee)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.ConvertedFee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseFee) > 0 {
+		i -= len(m.BaseFee)
+		copy(dAtA[i:], m.BaseFee)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.BaseFee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMsgfees(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMsgfees(v)
 	base := offset
@@ -547,6 +836,34 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgfees(uint64(l))
 	}
+	if len(m.WasmGasCosts) > 0 {
+		for _, e := range m.WasmGasCosts {
+			l = e.Size()
+			n += 1 + l + sovMsgfees(uint64(l))
+		}
+	}
+	if len(m.AlternateFeeDenoms) > 0 {
+		for _, s := range m.AlternateFeeDenoms {
+			l = len(s)
+			n += 1 + l + sovMsgfees(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WasmGasCost) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operation)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovMsgfees(uint64(m.Gas))
+	}
 	return n
 }
 
@@ -612,6 +929,35 @@ func (m *EventMsgFees) Size() (n int) {
 	return n
 }
 
+func (m *EventBaseFeeConverted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseFee)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	l = len(m.ConvertedFee)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	l = len(m.Volume)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	return n
+}
+
 func sovMsgfees(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -731,36 +1077,203 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.ConversionFeeDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMsgfees(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WasmGasCosts", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthMsgfees
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgFee) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMsgfees
+			m.WasmGasCosts = append(m.WasmGasCosts, WasmGasCost{})
+			if err := m.WasmGasCosts[len(m.WasmGasCosts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlternateFeeDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AlternateFeeDenoms = append(m.AlternateFeeDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WasmGasCost) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WasmGasCost: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WasmGasCost: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgfees
 			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
@@ -1180,6 +1693,216 @@ func (m *EventMsgFees) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventBaseFeeConverted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBaseFeeConverted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBaseFeeConverted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConvertedFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConvertedFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Volume = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMsgfees(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
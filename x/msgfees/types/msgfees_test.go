@@ -60,3 +60,80 @@ func TestMsgFeeValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestWasmGasCostValidate(t *testing.T) {
+	cases := []struct {
+		name     string
+		cost     WasmGasCost
+		errorMsg string
+	}{
+		{
+			name: "msg type url",
+			cost: NewWasmGasCost(sdk.MsgTypeURL(&MsgAssessCustomMsgFeeRequest{}), 1_000),
+		},
+		{
+			name: "query path",
+			cost: NewWasmGasCost("/provenance.metadata.v1.Query/Scope", 1_000),
+		},
+		{
+			name: "custom query route",
+			cost: NewWasmGasCost("custom/marker", 1_000),
+		},
+		{
+			name:     "empty operation",
+			cost:     NewWasmGasCost("", 1_000),
+			errorMsg: "invalid wasm gas cost operation: cannot be empty",
+		},
+		{
+			name:     "empty custom route",
+			cost:     NewWasmGasCost("custom/", 1_000),
+			errorMsg: `invalid wasm gas cost operation "custom/": custom route cannot be empty`,
+		},
+		{
+			name:     "unknown operation format",
+			cost:     NewWasmGasCost("marker", 1_000),
+			errorMsg: `invalid wasm gas cost operation "marker": must start with "/" or "custom/"`,
+		},
+		{
+			name:     "zero gas",
+			cost:     NewWasmGasCost("custom/marker", 0),
+			errorMsg: `invalid wasm gas cost for "custom/marker": gas must be greater than 0`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.cost.Validate()
+			if len(tc.errorMsg) > 0 {
+				require.EqualError(t, err, tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateWasmGasCosts(t *testing.T) {
+	require.NoError(t, ValidateWasmGasCosts(nil), "nil costs")
+	require.NoError(t, ValidateWasmGasCosts([]WasmGasCost{
+		NewWasmGasCost("custom/marker", 1),
+		NewWasmGasCost("custom/attribute", 1),
+	}), "two different costs")
+	require.EqualError(t, ValidateWasmGasCosts([]WasmGasCost{
+		NewWasmGasCost("custom/marker", 1),
+		NewWasmGasCost("custom/marker", 2),
+	}), `duplicate wasm gas cost operation "custom/marker"`, "duplicate costs")
+	require.EqualError(t, ValidateWasmGasCosts([]WasmGasCost{NewWasmGasCost("custom/marker", 0)}),
+		`invalid wasm gas cost for "custom/marker": gas must be greater than 0`, "invalid cost")
+}
+
+func TestValidateAlternateFeeDenoms(t *testing.T) {
+	require.NoError(t, ValidateAlternateFeeDenoms(nil), "nil denoms")
+	require.NoError(t, ValidateAlternateFeeDenoms([]string{"uusdc.figure", "uylds.fcc"}), "two different denoms")
+	require.EqualError(t, ValidateAlternateFeeDenoms([]string{"uusdc.figure", "uusdc.figure"}),
+		`duplicate alternate fee denom "uusdc.figure"`, "duplicate denoms")
+	require.EqualError(t, ValidateAlternateFeeDenoms([]string{""}),
+		"invalid alternate fee denom: invalid denom: ", "empty denom")
+	require.EqualError(t, ValidateAlternateFeeDenoms([]string{UsdDenom}),
+		`invalid alternate fee denom "usd": not a marker denom`, "usd denom")
+}
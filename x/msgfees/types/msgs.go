@@ -25,6 +25,8 @@ var AllRequestMsgs = []sdk.Msg{
 	(*MsgRemoveMsgFeeProposalRequest)(nil),
 	(*MsgUpdateConversionFeeDenomProposalRequest)(nil),
 	(*MsgUpdateNhashPerUsdMilProposalRequest)(nil),
+	(*MsgUpdateWasmGasCostsProposalRequest)(nil),
+	(*MsgUpdateAlternateFeeDenomsProposalRequest)(nil),
 }
 
 func NewMsgAssessCustomMsgFeeRequest(
@@ -223,3 +225,89 @@ func (msg *MsgUpdateNhashPerUsdMilProposalRequest) ValidateBasic() error {
 
 	return nil
 }
+
+func NewMsgUpdateWasmGasCostsProposalRequest(toSet []WasmGasCost, toRemove []string, authority string) *MsgUpdateWasmGasCostsProposalRequest {
+	return &MsgUpdateWasmGasCostsProposalRequest{
+		ToSet:     toSet,
+		ToRemove:  toRemove,
+		Authority: authority,
+	}
+}
+
+func (msg *MsgUpdateWasmGasCostsProposalRequest) ValidateBasic() error {
+	if len(msg.ToSet) == 0 && len(msg.ToRemove) == 0 {
+		return errors.New("at least one wasm gas cost to set or remove is required")
+	}
+
+	if err := ValidateWasmGasCosts(msg.ToSet); err != nil {
+		return err
+	}
+
+	toSet := make(map[string]bool, len(msg.ToSet))
+	for _, cost := range msg.ToSet {
+		toSet[cost.Operation] = true
+	}
+	toRemove := make(map[string]bool, len(msg.ToRemove))
+	for _, operation := range msg.ToRemove {
+		if err := ValidateWasmGasCostOperation(operation); err != nil {
+			return err
+		}
+		if toSet[operation] {
+			return fmt.Errorf("wasm gas cost operation %q cannot be both set and removed", operation)
+		}
+		if toRemove[operation] {
+			return fmt.Errorf("duplicate wasm gas cost operation %q to remove", operation)
+		}
+		toRemove[operation] = true
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewMsgUpdateAlternateFeeDenomsProposalRequest(toAdd []string, toRemove []string, authority string) *MsgUpdateAlternateFeeDenomsProposalRequest {
+	return &MsgUpdateAlternateFeeDenomsProposalRequest{
+		ToAdd:     toAdd,
+		ToRemove:  toRemove,
+		Authority: authority,
+	}
+}
+
+func (msg *MsgUpdateAlternateFeeDenomsProposalRequest) ValidateBasic() error {
+	if len(msg.ToAdd) == 0 && len(msg.ToRemove) == 0 {
+		return errors.New("at least one alternate fee denom to add or remove is required")
+	}
+
+	if err := ValidateAlternateFeeDenoms(msg.ToAdd); err != nil {
+		return err
+	}
+
+	toAdd := make(map[string]bool, len(msg.ToAdd))
+	for _, denom := range msg.ToAdd {
+		toAdd[denom] = true
+	}
+	toRemove := make(map[string]bool, len(msg.ToRemove))
+	for _, denom := range msg.ToRemove {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid alternate fee denom to remove: %w", err)
+		}
+		if toAdd[denom] {
+			return fmt.Errorf("alternate fee denom %q cannot be both added and removed", denom)
+		}
+		if toRemove[denom] {
+			return fmt.Errorf("duplicate alternate fee denom %q to remove", denom)
+		}
+		toRemove[denom] = true
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
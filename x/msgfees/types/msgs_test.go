@@ -21,6 +21,8 @@ func TestAllMsgsGetSigners(t *testing.T) {
 		func(signer string) sdk.Msg { return &MsgRemoveMsgFeeProposalRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgUpdateConversionFeeDenomProposalRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgUpdateNhashPerUsdMilProposalRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgUpdateWasmGasCostsProposalRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgUpdateAlternateFeeDenomsProposalRequest{Authority: signer} },
 	}
 
 	testutil.RunGetSignersTests(t, AllRequestMsgs, msgMakers, nil)
@@ -520,6 +522,147 @@ func TestUpdateConversionFeeDenomProposalRequestValidateBasic(t *testing.T) {
 
 }
 
+func TestMsgUpdateWasmGasCostsProposalRequestValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("input111111111111111").String()
+	mintURL := "/provenance.marker.v1.MsgMintRequest"
+
+	cases := []struct {
+		name     string
+		msg      *MsgUpdateWasmGasCostsProposalRequest
+		errorMsg string
+	}{
+		{
+			name:     "nothing to set or remove",
+			msg:      NewMsgUpdateWasmGasCostsProposalRequest(nil, nil, authority),
+			errorMsg: "at least one wasm gas cost to set or remove is required",
+		},
+		{
+			name:     "valid set",
+			msg:      NewMsgUpdateWasmGasCostsProposalRequest([]WasmGasCost{NewWasmGasCost(mintURL, 1)}, nil, authority),
+			errorMsg: "",
+		},
+		{
+			name:     "valid remove",
+			msg:      NewMsgUpdateWasmGasCostsProposalRequest(nil, []string{"custom/marker"}, authority),
+			errorMsg: "",
+		},
+		{
+			name:     "invalid cost to set",
+			msg:      NewMsgUpdateWasmGasCostsProposalRequest([]WasmGasCost{NewWasmGasCost(mintURL, 0)}, nil, authority),
+			errorMsg: `invalid wasm gas cost for "/provenance.marker.v1.MsgMintRequest": gas must be greater than 0`,
+		},
+		{
+			name: "duplicate cost to set",
+			msg: NewMsgUpdateWasmGasCostsProposalRequest(
+				[]WasmGasCost{NewWasmGasCost(mintURL, 1), NewWasmGasCost(mintURL, 2)}, nil, authority),
+			errorMsg: `duplicate wasm gas cost operation "/provenance.marker.v1.MsgMintRequest"`,
+		},
+		{
+			name:     "invalid operation to remove",
+			msg:      NewMsgUpdateWasmGasCostsProposalRequest(nil, []string{"custom/"}, authority),
+			errorMsg: `invalid wasm gas cost operation "custom/": custom route cannot be empty`,
+		},
+		{
+			name:     "duplicate operation to remove",
+			msg:      NewMsgUpdateWasmGasCostsProposalRequest(nil, []string{mintURL, mintURL}, authority),
+			errorMsg: `duplicate wasm gas cost operation "/provenance.marker.v1.MsgMintRequest" to remove`,
+		},
+		{
+			name:     "operation both set and removed",
+			msg:      NewMsgUpdateWasmGasCostsProposalRequest([]WasmGasCost{NewWasmGasCost(mintURL, 1)}, []string{mintURL}, authority),
+			errorMsg: `wasm gas cost operation "/provenance.marker.v1.MsgMintRequest" cannot be both set and removed`,
+		},
+		{
+			name:     "invalid authority",
+			msg:      NewMsgUpdateWasmGasCostsProposalRequest([]WasmGasCost{NewWasmGasCost(mintURL, 1)}, nil, ""),
+			errorMsg: "empty address string is not allowed",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.errorMsg) > 0 {
+				require.EqualError(t, err, tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgUpdateAlternateFeeDenomsProposalRequestValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("input111111111111111").String()
+
+	cases := []struct {
+		name     string
+		msg      *MsgUpdateAlternateFeeDenomsProposalRequest
+		errorMsg string
+	}{
+		{
+			name:     "nothing to add or remove",
+			msg:      NewMsgUpdateAlternateFeeDenomsProposalRequest(nil, nil, authority),
+			errorMsg: "at least one alternate fee denom to add or remove is required",
+		},
+		{
+			name: "valid add",
+			msg:  NewMsgUpdateAlternateFeeDenomsProposalRequest([]string{"uusdc.figure"}, nil, authority),
+		},
+		{
+			name: "valid remove",
+			msg:  NewMsgUpdateAlternateFeeDenomsProposalRequest(nil, []string{"uusdc.figure"}, authority),
+		},
+		{
+			name:     "invalid denom to add",
+			msg:      NewMsgUpdateAlternateFeeDenomsProposalRequest([]string{"x"}, nil, authority),
+			errorMsg: "invalid alternate fee denom: invalid denom: x",
+		},
+		{
+			name:     "usd to add",
+			msg:      NewMsgUpdateAlternateFeeDenomsProposalRequest([]string{UsdDenom}, nil, authority),
+			errorMsg: `invalid alternate fee denom "usd": not a marker denom`,
+		},
+		{
+			name:     "duplicate denom to add",
+			msg:      NewMsgUpdateAlternateFeeDenomsProposalRequest([]string{"uusdc.figure", "uusdc.figure"}, nil, authority),
+			errorMsg: `duplicate alternate fee denom "uusdc.figure"`,
+		},
+		{
+			name:     "invalid denom to remove",
+			msg:      NewMsgUpdateAlternateFeeDenomsProposalRequest(nil, []string{"x"}, authority),
+			errorMsg: "invalid alternate fee denom to remove: invalid denom: x",
+		},
+		{
+			name:     "duplicate denom to remove",
+			msg:      NewMsgUpdateAlternateFeeDenomsProposalRequest(nil, []string{"uusdc.figure", "uusdc.figure"}, authority),
+			errorMsg: `duplicate alternate fee denom "uusdc.figure" to remove`,
+		},
+		{
+			name:     "denom both added and removed",
+			msg:      NewMsgUpdateAlternateFeeDenomsProposalRequest([]string{"uusdc.figure"}, []string{"uusdc.figure"}, authority),
+			errorMsg: `alternate fee denom "uusdc.figure" cannot be both added and removed`,
+		},
+		{
+			name:     "invalid authority",
+			msg:      NewMsgUpdateAlternateFeeDenomsProposalRequest([]string{"uusdc.figure"}, nil, ""),
+			errorMsg: "empty address string is not allowed",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.errorMsg) > 0 {
+				require.EqualError(t, err, tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestValidateBips(t *testing.T) {
 	cases := []struct {
 		name                 string
@@ -637,6 +637,216 @@ func (m *MsgUpdateConversionFeeDenomProposalResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateConversionFeeDenomProposalResponse proto.InternalMessageInfo
 
+// MsgUpdateWasmGasCostsProposalRequest defines a governance proposal to update the wasm gas costs param.
+type MsgUpdateWasmGasCostsProposalRequest struct {
+	// to_set are the gas costs to add or update.
+	ToSet []WasmGasCost `protobuf:"bytes,1,rep,name=to_set,json=toSet,proto3" json:"to_set"`
+	// to_remove are the operations that should no longer have a fixed gas cost.
+	ToRemove []string `protobuf:"bytes,2,rep,name=to_remove,json=toRemove,proto3" json:"to_remove,omitempty"`
+	// the signing authority for the proposal
+	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgUpdateWasmGasCostsProposalRequest) Reset()         { *m = MsgUpdateWasmGasCostsProposalRequest{} }
+func (m *MsgUpdateWasmGasCostsProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateWasmGasCostsProposalRequest) ProtoMessage()    {}
+func (*MsgUpdateWasmGasCostsProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c6bb65eaf858b5f, []int{12}
+}
+func (m *MsgUpdateWasmGasCostsProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateWasmGasCostsProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateWasmGasCostsProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateWasmGasCostsProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateWasmGasCostsProposalRequest.Merge(m, src)
+}
+func (m *MsgUpdateWasmGasCostsProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateWasmGasCostsProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateWasmGasCostsProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateWasmGasCostsProposalRequest proto.InternalMessageInfo
+
+func (m *MsgUpdateWasmGasCostsProposalRequest) GetToSet() []WasmGasCost {
+	if m != nil {
+		return m.ToSet
+	}
+	return nil
+}
+
+func (m *MsgUpdateWasmGasCostsProposalRequest) GetToRemove() []string {
+	if m != nil {
+		return m.ToRemove
+	}
+	return nil
+}
+
+func (m *MsgUpdateWasmGasCostsProposalRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgUpdateWasmGasCostsProposalResponse defines the Msg/UpdateWasmGasCostsProposal response type
+type MsgUpdateWasmGasCostsProposalResponse struct {
+}
+
+func (m *MsgUpdateWasmGasCostsProposalResponse) Reset()         { *m = MsgUpdateWasmGasCostsProposalResponse{} }
+func (m *MsgUpdateWasmGasCostsProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateWasmGasCostsProposalResponse) ProtoMessage()    {}
+func (*MsgUpdateWasmGasCostsProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c6bb65eaf858b5f, []int{13}
+}
+func (m *MsgUpdateWasmGasCostsProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateWasmGasCostsProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateWasmGasCostsProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateWasmGasCostsProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateWasmGasCostsProposalResponse.Merge(m, src)
+}
+func (m *MsgUpdateWasmGasCostsProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateWasmGasCostsProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateWasmGasCostsProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateWasmGasCostsProposalResponse proto.InternalMessageInfo
+
+// MsgUpdateAlternateFeeDenomsProposalRequest defines a governance proposal to update the alternate fee denoms param.
+type MsgUpdateAlternateFeeDenomsProposalRequest struct {
+	// to_add are the marker denoms that the base fee can now be paid in.
+	ToAdd []string `protobuf:"bytes,1,rep,name=to_add,json=toAdd,proto3" json:"to_add,omitempty"`
+	// to_remove are the denoms that the base fee can no longer be paid in.
+	ToRemove []string `protobuf:"bytes,2,rep,name=to_remove,json=toRemove,proto3" json:"to_remove,omitempty"`
+	// the signing authority for the proposal
+	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgUpdateAlternateFeeDenomsProposalRequest) Reset() {
+	*m = MsgUpdateAlternateFeeDenomsProposalRequest{}
+}
+func (m *MsgUpdateAlternateFeeDenomsProposalRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgUpdateAlternateFeeDenomsProposalRequest) ProtoMessage() {}
+func (*MsgUpdateAlternateFeeDenomsProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c6bb65eaf858b5f, []int{14}
+}
+func (m *MsgUpdateAlternateFeeDenomsProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAlternateFeeDenomsProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAlternateFeeDenomsProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateAlternateFeeDenomsProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAlternateFeeDenomsProposalRequest.Merge(m, src)
+}
+func (m *MsgUpdateAlternateFeeDenomsProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAlternateFeeDenomsProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAlternateFeeDenomsProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateAlternateFeeDenomsProposalRequest proto.InternalMessageInfo
+
+func (m *MsgUpdateAlternateFeeDenomsProposalRequest) GetToAdd() []string {
+	if m != nil {
+		return m.ToAdd
+	}
+	return nil
+}
+
+func (m *MsgUpdateAlternateFeeDenomsProposalRequest) GetToRemove() []string {
+	if m != nil {
+		return m.ToRemove
+	}
+	return nil
+}
+
+func (m *MsgUpdateAlternateFeeDenomsProposalRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgUpdateAlternateFeeDenomsProposalResponse defines the Msg/UpdateAlternateFeeDenomsProposal response type
+type MsgUpdateAlternateFeeDenomsProposalResponse struct {
+}
+
+func (m *MsgUpdateAlternateFeeDenomsProposalResponse) Reset() {
+	*m = MsgUpdateAlternateFeeDenomsProposalResponse{}
+}
+func (m *MsgUpdateAlternateFeeDenomsProposalResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgUpdateAlternateFeeDenomsProposalResponse) ProtoMessage() {}
+func (*MsgUpdateAlternateFeeDenomsProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c6bb65eaf858b5f, []int{15}
+}
+func (m *MsgUpdateAlternateFeeDenomsProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAlternateFeeDenomsProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAlternateFeeDenomsProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateAlternateFeeDenomsProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAlternateFeeDenomsProposalResponse.Merge(m, src)
+}
+func (m *MsgUpdateAlternateFeeDenomsProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAlternateFeeDenomsProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAlternateFeeDenomsProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateAlternateFeeDenomsProposalResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgAssessCustomMsgFeeRequest)(nil), "provenance.msgfees.v1.MsgAssessCustomMsgFeeRequest")
 	proto.RegisterType((*MsgAssessCustomMsgFeeResponse)(nil), "provenance.msgfees.v1.MsgAssessCustomMsgFeeResponse")
@@ -650,64 +860,78 @@ func init() {
 	proto.RegisterType((*MsgUpdateNhashPerUsdMilProposalResponse)(nil), "provenance.msgfees.v1.MsgUpdateNhashPerUsdMilProposalResponse")
 	proto.RegisterType((*MsgUpdateConversionFeeDenomProposalRequest)(nil), "provenance.msgfees.v1.MsgUpdateConversionFeeDenomProposalRequest")
 	proto.RegisterType((*MsgUpdateConversionFeeDenomProposalResponse)(nil), "provenance.msgfees.v1.MsgUpdateConversionFeeDenomProposalResponse")
+	proto.RegisterType((*MsgUpdateWasmGasCostsProposalRequest)(nil), "provenance.msgfees.v1.MsgUpdateWasmGasCostsProposalRequest")
+	proto.RegisterType((*MsgUpdateWasmGasCostsProposalResponse)(nil), "provenance.msgfees.v1.MsgUpdateWasmGasCostsProposalResponse")
+	proto.RegisterType((*MsgUpdateAlternateFeeDenomsProposalRequest)(nil), "provenance.msgfees.v1.MsgUpdateAlternateFeeDenomsProposalRequest")
+	proto.RegisterType((*MsgUpdateAlternateFeeDenomsProposalResponse)(nil), "provenance.msgfees.v1.MsgUpdateAlternateFeeDenomsProposalResponse")
 }
 
 func init() { proto.RegisterFile("provenance/msgfees/v1/tx.proto", fileDescriptor_4c6bb65eaf858b5f) }
 
 var fileDescriptor_4c6bb65eaf858b5f = []byte{
-	// 828 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcf, 0x6b, 0x3b, 0x45,
-	0x14, 0xcf, 0xe4, 0x9b, 0x16, 0x3a, 0xd6, 0x42, 0x86, 0xa8, 0xe9, 0x5a, 0x37, 0x31, 0x07, 0x6d,
-	0x23, 0xd9, 0x35, 0x4d, 0xad, 0x50, 0x50, 0x68, 0x2a, 0xb9, 0x45, 0x42, 0xb4, 0x17, 0x2f, 0xcb,
-	0x66, 0x77, 0xba, 0x19, 0xcc, 0xce, 0xac, 0xfb, 0x36, 0xa1, 0x01, 0x41, 0x11, 0x84, 0xe2, 0xc9,
-	0xb3, 0x22, 0xf4, 0x24, 0xea, 0xa9, 0x07, 0xff, 0x88, 0x1e, 0x8b, 0x27, 0x4f, 0x2a, 0x2d, 0x34,
-	0xfa, 0x5f, 0xc8, 0xee, 0x8e, 0x49, 0xdb, 0x24, 0x1b, 0xfb, 0xc3, 0x9b, 0x97, 0x64, 0x66, 0x3f,
-	0x9f, 0x79, 0xef, 0xf3, 0xde, 0xdb, 0x37, 0x6f, 0xb1, 0xea, 0xf9, 0x62, 0x40, 0xb9, 0xc9, 0x2d,
-	0xaa, 0xbb, 0xe0, 0x1c, 0x51, 0x0a, 0xfa, 0xa0, 0xaa, 0x07, 0xc7, 0x9a, 0xe7, 0x8b, 0x40, 0x90,
-	0x17, 0x26, 0xb8, 0x26, 0x71, 0x6d, 0x50, 0x55, 0xb2, 0xa6, 0xcb, 0xb8, 0xd0, 0xa3, 0xdf, 0x98,
-	0xa9, 0xe4, 0x1c, 0xe1, 0x88, 0x68, 0xa9, 0x87, 0x2b, 0xf9, 0x74, 0xdd, 0x12, 0xe0, 0x0a, 0x30,
-	0x62, 0x20, 0xde, 0x48, 0x48, 0x8d, 0x77, 0x7a, 0xc7, 0x04, 0xaa, 0x0f, 0xaa, 0x1d, 0x1a, 0x98,
-	0x55, 0xdd, 0x12, 0x8c, 0x4b, 0xfc, 0x25, 0x89, 0xbb, 0xe0, 0x84, 0x92, 0x5c, 0x70, 0x62, 0xa0,
-	0x74, 0x8d, 0xf0, 0x46, 0x13, 0x9c, 0x7d, 0x00, 0x0a, 0x70, 0xd0, 0x87, 0x40, 0xb8, 0x4d, 0x70,
-	0x1a, 0x94, 0xb6, 0xe9, 0x27, 0x7d, 0x0a, 0x01, 0x21, 0x38, 0xc3, 0x4d, 0x97, 0xe6, 0x51, 0x11,
-	0x6d, 0xae, 0xb4, 0xa3, 0x35, 0x79, 0x1b, 0x2f, 0x9b, 0xae, 0xe8, 0xf3, 0x20, 0x9f, 0x2e, 0xa2,
-	0xcd, 0xe7, 0xb6, 0xd7, 0x35, 0x29, 0x26, 0x74, 0xaf, 0x49, 0xf7, 0xda, 0x81, 0x60, 0xbc, 0x9e,
-	0x39, 0xff, 0xad, 0x90, 0x6a, 0x4b, 0x3a, 0xd9, 0xc0, 0x2b, 0x3e, 0xb5, 0x98, 0xc7, 0x28, 0x0f,
-	0xf2, 0xcf, 0x22, 0x8b, 0x93, 0x07, 0xa1, 0xab, 0x23, 0x5f, 0xb8, 0xf9, 0x4c, 0xec, 0x2a, 0x5c,
-	0x93, 0x1d, 0xfc, 0xe2, 0x98, 0x60, 0x74, 0x4c, 0x60, 0x60, 0x78, 0x82, 0xf1, 0x00, 0xf2, 0x4b,
-	0x11, 0x2b, 0x37, 0x46, 0xeb, 0x21, 0xd8, 0x8a, 0xb0, 0xbd, 0xec, 0xc9, 0x69, 0x21, 0xf5, 0xe7,
-	0x69, 0x21, 0xf5, 0xc5, 0xe8, 0xac, 0x1c, 0x19, 0x2a, 0x15, 0xf0, 0x2b, 0x73, 0xe2, 0x04, 0x4f,
-	0x70, 0xa0, 0xa5, 0xeb, 0x34, 0x7e, 0x39, 0x64, 0xd8, 0x76, 0x0c, 0xb4, 0x7c, 0xe1, 0x09, 0x30,
-	0x7b, 0xff, 0x24, 0xa2, 0x88, 0x57, 0x5d, 0x70, 0x8c, 0x60, 0xe8, 0x51, 0xa3, 0xef, 0xf7, 0x64,
-	0x42, 0xb0, 0x0b, 0xce, 0x87, 0x43, 0x8f, 0x1e, 0xfa, 0x3d, 0x72, 0x82, 0xf0, 0x9a, 0x69, 0xdb,
-	0x2c, 0x60, 0x82, 0x9b, 0x3d, 0xe3, 0x88, 0xd2, 0xc5, 0xf9, 0x69, 0x84, 0xf9, 0xf9, 0xe9, 0xf7,
-	0xc2, 0xa6, 0xc3, 0x82, 0x6e, 0xbf, 0xa3, 0x59, 0xc2, 0x95, 0x95, 0x95, 0x7f, 0x15, 0xb0, 0x3f,
-	0xd6, 0x43, 0xa7, 0x10, 0x1d, 0x80, 0x6f, 0x46, 0x67, 0xe5, 0xd5, 0x1e, 0x75, 0x4c, 0x6b, 0x68,
-	0x84, 0x05, 0x86, 0x1f, 0x46, 0x67, 0x65, 0xd4, 0x7e, 0x7e, 0xe2, 0xb8, 0x41, 0xe9, 0x82, 0x44,
-	0xcf, 0x4f, 0x6a, 0x66, 0x7e, 0x52, 0xc9, 0x2e, 0x5e, 0x31, 0xfb, 0x41, 0x57, 0xf8, 0x2c, 0x18,
-	0xc6, 0xd9, 0xaf, 0xe7, 0x7f, 0xf9, 0xb9, 0x92, 0x93, 0xb1, 0xed, 0xdb, 0xb6, 0x4f, 0x01, 0x3e,
-	0x08, 0x7c, 0xc6, 0x9d, 0xf6, 0x84, 0xba, 0xb7, 0x16, 0x16, 0x61, 0xb2, 0x2f, 0xa9, 0xf1, 0x1b,
-	0x37, 0x9d, 0x67, 0x59, 0x88, 0xbf, 0xd2, 0x58, 0x6d, 0x82, 0x73, 0xe8, 0xd9, 0x66, 0x40, 0xff,
-	0xaf, 0xc5, 0x7f, 0x5a, 0x8b, 0x57, 0x71, 0x61, 0x6e, 0xaa, 0x65, 0x39, 0xbe, 0x42, 0x51, 0x39,
-	0xda, 0xd4, 0x15, 0x83, 0x07, 0x97, 0xe3, 0x96, 0xde, 0xf4, 0x63, 0xf5, 0xce, 0xd6, 0x22, 0xf5,
-	0x7e, 0x8b, 0xf0, 0x6b, 0xe3, 0x98, 0xde, 0xef, 0x9a, 0xd0, 0x6d, 0x51, 0xff, 0x10, 0xec, 0x26,
-	0xeb, 0xdd, 0xd5, 0xbd, 0x85, 0xb3, 0x3c, 0x24, 0x18, 0x1e, 0xf5, 0x8d, 0x3e, 0xd8, 0x86, 0xcb,
-	0x62, 0xf1, 0x99, 0xf6, 0x1a, 0xbf, 0x75, 0xf2, 0xc9, 0x02, 0xd8, 0xc2, 0xaf, 0x2f, 0x14, 0x27,
-	0x03, 0xf9, 0x1e, 0xe1, 0xf2, 0x98, 0x7b, 0x20, 0xf8, 0x80, 0xfa, 0xc0, 0x04, 0x6f, 0x50, 0xfa,
-	0x1e, 0xe5, 0xc2, 0xbd, 0x1b, 0xcc, 0x9b, 0x38, 0x67, 0x8d, 0x49, 0xe1, 0x0b, 0x6f, 0xd8, 0x21,
-	0x4d, 0x16, 0x83, 0x58, 0x53, 0x06, 0x9e, 0x2c, 0xa6, 0x0a, 0x7e, 0xe3, 0x5f, 0xe9, 0x8c, 0xe3,
-	0xda, 0x1e, 0x2d, 0xe3, 0x67, 0x4d, 0x70, 0xc8, 0x67, 0x98, 0x4c, 0x5f, 0xc7, 0xa4, 0xa6, 0xcd,
-	0x9c, 0x92, 0x5a, 0xd2, 0x90, 0x52, 0x76, 0xee, 0x77, 0x28, 0x16, 0x42, 0x3e, 0xc5, 0xd9, 0xa9,
-	0x5b, 0x88, 0x6c, 0x27, 0x98, 0x9a, 0x33, 0x1a, 0x94, 0xda, 0xbd, 0xce, 0x48, 0xef, 0x5f, 0x22,
-	0x9c, 0x9b, 0xd5, 0x78, 0xe4, 0xad, 0xf9, 0xd6, 0x12, 0xee, 0x44, 0x65, 0xf7, 0xbe, 0xc7, 0x6e,
-	0xe8, 0x98, 0xd5, 0x50, 0x49, 0x3a, 0x12, 0x2e, 0x83, 0x24, 0x1d, 0x49, 0x7d, 0x4b, 0xbe, 0x43,
-	0x78, 0x23, 0xa9, 0x2f, 0xc8, 0x3b, 0x8b, 0x02, 0x4c, 0x6c, 0x76, 0xe5, 0xdd, 0x87, 0x1e, 0x97,
-	0xfa, 0x7e, 0x44, 0xb8, 0xb8, 0xe8, 0x1d, 0x27, 0xfb, 0x8b, 0x9c, 0x2c, 0xec, 0x63, 0xa5, 0xfe,
-	0x18, 0x13, 0xb1, 0x56, 0x65, 0xe9, 0xf3, 0x70, 0x10, 0xd5, 0xd9, 0xf9, 0xa5, 0x8a, 0x2e, 0x2e,
-	0x55, 0xf4, 0xc7, 0xa5, 0x8a, 0xbe, 0xbe, 0x52, 0x53, 0x17, 0x57, 0x6a, 0xea, 0xd7, 0x2b, 0x35,
-	0x85, 0xf3, 0x4c, 0xcc, 0x76, 0xd3, 0x42, 0x1f, 0xd5, 0x6e, 0x8c, 0xbf, 0x09, 0xa7, 0xc2, 0xc4,
-	0x8d, 0x9d, 0x7e, 0x3c, 0xfe, 0xc2, 0x8d, 0xe6, 0x61, 0x67, 0x39, 0xfa, 0x9c, 0xac, 0xfd, 0x1d,
-	0x00, 0x00, 0xff, 0xff, 0x92, 0xa9, 0x86, 0xe4, 0x04, 0x0b, 0x00, 0x00,
+	// 987 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xdf, 0x6b, 0x23, 0x45,
+	0x1c, 0xcf, 0x34, 0x69, 0x31, 0x73, 0x67, 0xa1, 0x43, 0x4e, 0x73, 0x7b, 0x75, 0x13, 0xe3, 0x8f,
+	0xcb, 0x55, 0x9a, 0xb5, 0xed, 0x59, 0xe1, 0xfc, 0x45, 0x52, 0xa9, 0x4f, 0x91, 0x92, 0xb3, 0x08,
+	0xbe, 0x2c, 0x93, 0xec, 0x74, 0x3b, 0x98, 0x9d, 0x59, 0xf7, 0x3b, 0x09, 0x57, 0x10, 0x14, 0x41,
+	0x38, 0x7c, 0xf2, 0x4d, 0x50, 0x84, 0x7b, 0x92, 0x53, 0x10, 0xfa, 0xe0, 0x1f, 0x71, 0x0f, 0x3e,
+	0x1c, 0x3e, 0xf9, 0xa4, 0xd2, 0xc2, 0x55, 0xff, 0x0b, 0xd9, 0xdd, 0x69, 0x92, 0x36, 0xc9, 0xae,
+	0x6d, 0x4f, 0x9f, 0x7c, 0x69, 0x67, 0xf2, 0xfd, 0xf5, 0xf9, 0x7c, 0xbe, 0xc3, 0x77, 0x66, 0xb1,
+	0xe9, 0x07, 0xb2, 0xcf, 0x04, 0x15, 0x1d, 0x66, 0x79, 0xe0, 0xee, 0x30, 0x06, 0x56, 0x7f, 0xc5,
+	0x52, 0x77, 0x6a, 0x7e, 0x20, 0x95, 0x24, 0x57, 0x86, 0xf6, 0x9a, 0xb6, 0xd7, 0xfa, 0x2b, 0xc6,
+	0x02, 0xf5, 0xb8, 0x90, 0x56, 0xf4, 0x37, 0xf6, 0x34, 0x0a, 0xae, 0x74, 0x65, 0xb4, 0xb4, 0xc2,
+	0x95, 0xfe, 0xf5, 0x6a, 0x47, 0x82, 0x27, 0xc1, 0x8e, 0x0d, 0xf1, 0x46, 0x9b, 0xcc, 0x78, 0x67,
+	0xb5, 0x29, 0x30, 0xab, 0xbf, 0xd2, 0x66, 0x8a, 0xae, 0x58, 0x1d, 0xc9, 0x85, 0xb6, 0x3f, 0xad,
+	0xed, 0x1e, 0xb8, 0x21, 0x24, 0x0f, 0x5c, 0x6d, 0x78, 0x6e, 0x32, 0xe6, 0x63, 0x78, 0x91, 0x53,
+	0xe5, 0x11, 0xc2, 0x8b, 0x4d, 0x70, 0xeb, 0x00, 0x0c, 0x60, 0xa3, 0x07, 0x4a, 0x7a, 0x4d, 0x70,
+	0x37, 0x19, 0x6b, 0xb1, 0x8f, 0x7a, 0x0c, 0x14, 0x21, 0x38, 0x27, 0xa8, 0xc7, 0x8a, 0xa8, 0x8c,
+	0xaa, 0xf9, 0x56, 0xb4, 0x26, 0xaf, 0xe2, 0x39, 0xea, 0xc9, 0x9e, 0x50, 0xc5, 0x99, 0x32, 0xaa,
+	0x5e, 0x5a, 0xbd, 0x5a, 0xd3, 0x88, 0x43, 0x8c, 0x35, 0x8d, 0xb1, 0xb6, 0x21, 0xb9, 0x68, 0xe4,
+	0x1e, 0xfc, 0x56, 0xca, 0xb4, 0xb4, 0x3b, 0x59, 0xc4, 0xf9, 0x80, 0x75, 0xb8, 0xcf, 0x99, 0x50,
+	0xc5, 0x6c, 0x94, 0x71, 0xf8, 0x43, 0x58, 0x6a, 0x27, 0x90, 0x5e, 0x31, 0x17, 0x97, 0x0a, 0xd7,
+	0xe4, 0x26, 0x7e, 0x6a, 0xe0, 0x60, 0xb7, 0x29, 0x70, 0xb0, 0x7d, 0xc9, 0x85, 0x82, 0xe2, 0x6c,
+	0xe4, 0x55, 0x18, 0x58, 0x1b, 0xa1, 0x71, 0x2b, 0xb2, 0xdd, 0x5a, 0xb8, 0x7b, 0xaf, 0x94, 0xf9,
+	0xf3, 0x5e, 0x29, 0xf3, 0xd9, 0xd1, 0xfe, 0x52, 0x94, 0xa8, 0x52, 0xc2, 0xcf, 0x4c, 0xe1, 0x09,
+	0xbe, 0x14, 0xc0, 0x2a, 0x8f, 0x66, 0xf0, 0xb5, 0xd0, 0xc3, 0x71, 0x62, 0xc3, 0x56, 0x20, 0x7d,
+	0x09, 0xb4, 0x7b, 0x2c, 0x44, 0x19, 0x5f, 0xf6, 0xc0, 0xb5, 0xd5, 0x9e, 0xcf, 0xec, 0x5e, 0xd0,
+	0xd5, 0x82, 0x60, 0x0f, 0xdc, 0xf7, 0xf6, 0x7c, 0xb6, 0x1d, 0x74, 0xc9, 0x5d, 0x84, 0xe7, 0xa9,
+	0xe3, 0x70, 0xc5, 0xa5, 0xa0, 0x5d, 0x7b, 0x87, 0xb1, 0x74, 0x7d, 0x36, 0x43, 0x7d, 0x7e, 0xf8,
+	0xbd, 0x54, 0x75, 0xb9, 0xda, 0xed, 0xb5, 0x6b, 0x1d, 0xe9, 0xe9, 0xf6, 0xeb, 0x7f, 0xcb, 0xe0,
+	0x7c, 0x68, 0x85, 0x45, 0x21, 0x0a, 0x80, 0xaf, 0x8f, 0xf6, 0x97, 0x2e, 0x77, 0x99, 0x4b, 0x3b,
+	0x7b, 0x76, 0x78, 0x0a, 0xe0, 0xfe, 0xd1, 0xfe, 0x12, 0x6a, 0x3d, 0x39, 0x2c, 0xbc, 0xc9, 0x58,
+	0x8a, 0xd0, 0xd3, 0x45, 0xcd, 0x4d, 0x17, 0x95, 0xac, 0xe3, 0x3c, 0xed, 0xa9, 0x5d, 0x19, 0x70,
+	0xb5, 0x17, 0xab, 0xdf, 0x28, 0xfe, 0xf2, 0xd3, 0x72, 0x41, 0x73, 0xab, 0x3b, 0x4e, 0xc0, 0x00,
+	0x6e, 0xab, 0x80, 0x0b, 0xb7, 0x35, 0x74, 0xbd, 0x35, 0x1f, 0x36, 0x61, 0xb8, 0xaf, 0x98, 0x78,
+	0x71, 0xb2, 0xce, 0xba, 0x11, 0x7f, 0xcd, 0x60, 0xb3, 0x09, 0xee, 0xb6, 0xef, 0x50, 0xc5, 0xfe,
+	0xef, 0xc5, 0xbf, 0xda, 0x8b, 0x67, 0x71, 0x69, 0xaa, 0xd4, 0xba, 0x1d, 0x5f, 0xa0, 0xa8, 0x1d,
+	0x2d, 0xe6, 0xc9, 0xfe, 0xb9, 0xdb, 0x71, 0x02, 0xef, 0xcc, 0x45, 0xf1, 0x4e, 0xc6, 0xa2, 0xf1,
+	0x7e, 0x83, 0xf0, 0x8b, 0x03, 0x4e, 0xef, 0xee, 0x52, 0xd8, 0xdd, 0x62, 0xc1, 0x36, 0x38, 0x4d,
+	0xde, 0x3d, 0x8d, 0xfb, 0x06, 0x5e, 0x10, 0xa1, 0x83, 0xed, 0xb3, 0xc0, 0xee, 0x81, 0x63, 0x7b,
+	0x3c, 0x06, 0x9f, 0x6b, 0xcd, 0x8b, 0x13, 0x91, 0x8f, 0x8d, 0xc0, 0x0d, 0x7c, 0x3d, 0x15, 0x9c,
+	0x26, 0xf2, 0x1d, 0xc2, 0x4b, 0x03, 0xdf, 0x0d, 0x29, 0xfa, 0x2c, 0x00, 0x2e, 0xc5, 0x26, 0x63,
+	0x6f, 0x33, 0x21, 0xbd, 0xd3, 0x64, 0x5e, 0xc6, 0x85, 0xce, 0xc0, 0x29, 0x3c, 0xf0, 0xb6, 0x13,
+	0xba, 0xe9, 0x66, 0x90, 0xce, 0x58, 0x82, 0xc7, 0xc6, 0x69, 0x19, 0xbf, 0xf4, 0x8f, 0x70, 0x6a,
+	0x5e, 0x3f, 0x23, 0xfc, 0xfc, 0xc0, 0xff, 0x7d, 0x0a, 0xde, 0x3b, 0x14, 0x36, 0x24, 0x28, 0x38,
+	0xcd, 0xe8, 0x2d, 0x3c, 0xa7, 0xa4, 0x0d, 0x4c, 0x15, 0x51, 0x39, 0x5b, 0xbd, 0xb4, 0x5a, 0xa9,
+	0x4d, 0xbc, 0x65, 0x6b, 0x23, 0x39, 0xf4, 0x7d, 0x33, 0xab, 0xe4, 0x6d, 0xa6, 0xc8, 0x35, 0x9c,
+	0x57, 0xd2, 0x0e, 0xa2, 0xd3, 0x52, 0x9c, 0x29, 0x67, 0xab, 0xf9, 0xd6, 0x13, 0x4a, 0xc6, 0xa7,
+	0xe7, 0x24, 0xfb, 0xec, 0xf9, 0xd9, 0x5f, 0xc7, 0x2f, 0xa4, 0xb0, 0xd1, 0xbc, 0xef, 0x8f, 0xf6,
+	0xb3, 0xde, 0x55, 0x2c, 0x10, 0x54, 0xb1, 0x63, 0x99, 0xc6, 0xd8, 0x5f, 0x89, 0xd8, 0x53, 0xc7,
+	0x89, 0xd8, 0xe7, 0x43, 0x4e, 0x75, 0xc7, 0xf9, 0x6f, 0x38, 0x8d, 0x76, 0x34, 0x09, 0x69, 0xcc,
+	0x6c, 0xf5, 0xc7, 0x3c, 0xce, 0x36, 0xc1, 0x25, 0x9f, 0x60, 0x32, 0x7e, 0xc1, 0x92, 0xb5, 0x29,
+	0x6d, 0x4b, 0x7a, 0x76, 0x18, 0x37, 0xcf, 0x16, 0x14, 0x03, 0x21, 0x1f, 0xe3, 0x85, 0xb1, 0x7b,
+	0x85, 0xac, 0x26, 0xa4, 0x9a, 0x72, 0xd9, 0x1b, 0x6b, 0x67, 0x8a, 0xd1, 0xd5, 0x3f, 0x47, 0xb8,
+	0x30, 0x69, 0x94, 0x92, 0x57, 0xa6, 0x67, 0x4b, 0xb8, 0xe5, 0x8c, 0xf5, 0xb3, 0x86, 0x8d, 0xe0,
+	0x98, 0x34, 0x22, 0x93, 0x70, 0x24, 0x8c, 0x77, 0x63, 0xfd, 0xac, 0x61, 0x1a, 0xc7, 0xb7, 0x08,
+	0x2f, 0x26, 0x4d, 0x3a, 0xf2, 0x46, 0x1a, 0xc1, 0xc4, 0xf1, 0x6d, 0xbc, 0x79, 0xde, 0x70, 0x8d,
+	0xef, 0x7b, 0x84, 0xcb, 0x69, 0x53, 0x8b, 0xd4, 0xd3, 0x8a, 0xa4, 0x4e, 0x66, 0xa3, 0x71, 0x91,
+	0x14, 0x1a, 0xeb, 0x57, 0x08, 0x1b, 0xd3, 0x67, 0x0c, 0x79, 0x2d, 0xad, 0x44, 0xc2, 0x9c, 0x35,
+	0x5e, 0x3f, 0x5f, 0xf0, 0x98, 0x8a, 0xd3, 0x27, 0x45, 0xba, 0x8a, 0xa9, 0xf3, 0xd0, 0x68, 0x5c,
+	0x24, 0x45, 0x8c, 0xd5, 0x98, 0xfd, 0x34, 0x7c, 0xa0, 0x35, 0xf8, 0x83, 0x03, 0x13, 0x3d, 0x3c,
+	0x30, 0xd1, 0x1f, 0x07, 0x26, 0xfa, 0xf2, 0xd0, 0xcc, 0x3c, 0x3c, 0x34, 0x33, 0xbf, 0x1e, 0x9a,
+	0x19, 0x5c, 0xe4, 0x72, 0x72, 0x99, 0x2d, 0xf4, 0xc1, 0xda, 0xc8, 0xb3, 0x70, 0xe8, 0xb3, 0xcc,
+	0xe5, 0xc8, 0xce, 0xba, 0x33, 0xf8, 0xd4, 0x8a, 0xde, 0x89, 0xed, 0xb9, 0xe8, 0x33, 0x6b, 0xed,
+	0xef, 0x01, 0x00, 0x77, 0xd4, 0x4e, 0xab, 0x41, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -737,6 +961,12 @@ type MsgClient interface {
 	UpdateNhashPerUsdMilProposal(ctx context.Context, in *MsgUpdateNhashPerUsdMilProposalRequest, opts ...grpc.CallOption) (*MsgUpdateNhashPerUsdMilProposalResponse, error)
 	// UpdateConversionFeeDenomProposal defines a governance proposal to update the msg fee conversion denom
 	UpdateConversionFeeDenomProposal(ctx context.Context, in *MsgUpdateConversionFeeDenomProposalRequest, opts ...grpc.CallOption) (*MsgUpdateConversionFeeDenomProposalResponse, error)
+	// UpdateWasmGasCostsProposal defines a governance proposal to set or remove fixed gas costs for operations
+	// invoked by smart contracts.
+	UpdateWasmGasCostsProposal(ctx context.Context, in *MsgUpdateWasmGasCostsProposalRequest, opts ...grpc.CallOption) (*MsgUpdateWasmGasCostsProposalResponse, error)
+	// UpdateAlternateFeeDenomsProposal defines a governance proposal to add or remove denoms that the base fee can be
+	// paid in.
+	UpdateAlternateFeeDenomsProposal(ctx context.Context, in *MsgUpdateAlternateFeeDenomsProposalRequest, opts ...grpc.CallOption) (*MsgUpdateAlternateFeeDenomsProposalResponse, error)
 }
 
 type msgClient struct {
@@ -801,6 +1031,24 @@ func (c *msgClient) UpdateConversionFeeDenomProposal(ctx context.Context, in *Ms
 	return out, nil
 }
 
+func (c *msgClient) UpdateWasmGasCostsProposal(ctx context.Context, in *MsgUpdateWasmGasCostsProposalRequest, opts ...grpc.CallOption) (*MsgUpdateWasmGasCostsProposalResponse, error) {
+	out := new(MsgUpdateWasmGasCostsProposalResponse)
+	err := c.cc.Invoke(ctx, "/provenance.msgfees.v1.Msg/UpdateWasmGasCostsProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateAlternateFeeDenomsProposal(ctx context.Context, in *MsgUpdateAlternateFeeDenomsProposalRequest, opts ...grpc.CallOption) (*MsgUpdateAlternateFeeDenomsProposalResponse, error) {
+	out := new(MsgUpdateAlternateFeeDenomsProposalResponse)
+	err := c.cc.Invoke(ctx, "/provenance.msgfees.v1.Msg/UpdateAlternateFeeDenomsProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// AssessCustomMsgFee endpoint executes the additional fee charges.
@@ -818,6 +1066,12 @@ type MsgServer interface {
 	UpdateNhashPerUsdMilProposal(context.Context, *MsgUpdateNhashPerUsdMilProposalRequest) (*MsgUpdateNhashPerUsdMilProposalResponse, error)
 	// UpdateConversionFeeDenomProposal defines a governance proposal to update the msg fee conversion denom
 	UpdateConversionFeeDenomProposal(context.Context, *MsgUpdateConversionFeeDenomProposalRequest) (*MsgUpdateConversionFeeDenomProposalResponse, error)
+	// UpdateWasmGasCostsProposal defines a governance proposal to set or remove fixed gas costs for operations
+	// invoked by smart contracts.
+	UpdateWasmGasCostsProposal(context.Context, *MsgUpdateWasmGasCostsProposalRequest) (*MsgUpdateWasmGasCostsProposalResponse, error)
+	// UpdateAlternateFeeDenomsProposal defines a governance proposal to add or remove denoms that the base fee can be
+	// paid in.
+	UpdateAlternateFeeDenomsProposal(context.Context, *MsgUpdateAlternateFeeDenomsProposalRequest) (*MsgUpdateAlternateFeeDenomsProposalResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -842,6 +1096,12 @@ func (*UnimplementedMsgServer) UpdateNhashPerUsdMilProposal(ctx context.Context,
 func (*UnimplementedMsgServer) UpdateConversionFeeDenomProposal(ctx context.Context, req *MsgUpdateConversionFeeDenomProposalRequest) (*MsgUpdateConversionFeeDenomProposalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateConversionFeeDenomProposal not implemented")
 }
+func (*UnimplementedMsgServer) UpdateWasmGasCostsProposal(ctx context.Context, req *MsgUpdateWasmGasCostsProposalRequest) (*MsgUpdateWasmGasCostsProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWasmGasCostsProposal not implemented")
+}
+func (*UnimplementedMsgServer) UpdateAlternateFeeDenomsProposal(ctx context.Context, req *MsgUpdateAlternateFeeDenomsProposalRequest) (*MsgUpdateAlternateFeeDenomsProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAlternateFeeDenomsProposal not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -955,6 +1215,42 @@ func _Msg_UpdateConversionFeeDenomProposal_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateWasmGasCostsProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateWasmGasCostsProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateWasmGasCostsProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.msgfees.v1.Msg/UpdateWasmGasCostsProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateWasmGasCostsProposal(ctx, req.(*MsgUpdateWasmGasCostsProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateAlternateFeeDenomsProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAlternateFeeDenomsProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAlternateFeeDenomsProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.msgfees.v1.Msg/UpdateAlternateFeeDenomsProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAlternateFeeDenomsProposal(ctx, req.(*MsgUpdateAlternateFeeDenomsProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.msgfees.v1.Msg",
@@ -984,6 +1280,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateConversionFeeDenomProposal",
 			Handler:    _Msg_UpdateConversionFeeDenomProposal_Handler,
 		},
+		{
+			MethodName: "UpdateWasmGasCostsProposal",
+			Handler:    _Msg_UpdateWasmGasCostsProposal_Handler,
+		},
+		{
+			MethodName: "UpdateAlternateFeeDenomsProposal",
+			Handler:    _Msg_UpdateAlternateFeeDenomsProposal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/msgfees/v1/tx.proto",
@@ -1419,65 +1723,212 @@ func (m *MsgUpdateConversionFeeDenomProposalResponse) MarshalToSizedBuffer(dAtA
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateWasmGasCostsProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgAssessCustomMsgFeeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgUpdateWasmGasCostsProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateWasmGasCostsProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ToRemove) > 0 {
+		for iNdEx := len(m.ToRemove) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ToRemove[iNdEx])
+			copy(dAtA[i:], m.ToRemove[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ToRemove[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.RecipientBasisPoints)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ToSet) > 0 {
+		for iNdEx := len(m.ToSet) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ToSet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAssessCustomMsgFeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateWasmGasCostsProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAddMsgFeeProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateWasmGasCostsProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateWasmGasCostsProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MsgTypeUrl)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAlternateFeeDenomsProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.AdditionalFee.Size()
-	n += 1 + l + sovTx(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAlternateFeeDenomsProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAlternateFeeDenomsProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ToRemove) > 0 {
+		for iNdEx := len(m.ToRemove) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ToRemove[iNdEx])
+			copy(dAtA[i:], m.ToRemove[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ToRemove[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ToAdd) > 0 {
+		for iNdEx := len(m.ToAdd) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ToAdd[iNdEx])
+			copy(dAtA[i:], m.ToAdd[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ToAdd[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAlternateFeeDenomsProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAlternateFeeDenomsProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAlternateFeeDenomsProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAssessCustomMsgFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RecipientBasisPoints)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAssessCustomMsgFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddMsgFeeProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.AdditionalFee.Size()
+	n += 1 + l + sovTx(uint64(l))
 	l = len(m.Recipient)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
@@ -1615,6 +2066,74 @@ func (m *MsgUpdateConversionFeeDenomProposalResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateWasmGasCostsProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ToSet) > 0 {
+		for _, e := range m.ToSet {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ToRemove) > 0 {
+		for _, s := range m.ToRemove {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateWasmGasCostsProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateAlternateFeeDenomsProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ToAdd) > 0 {
+		for _, s := range m.ToAdd {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ToRemove) > 0 {
+		for _, s := range m.ToRemove {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateAlternateFeeDenomsProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2883,6 +3402,400 @@ func (m *MsgUpdateConversionFeeDenomProposalResponse) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
+func (m *MsgUpdateWasmGasCostsProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateWasmGasCostsProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateWasmGasCostsProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToSet = append(m.ToSet, WasmGasCost{})
+			if err := m.ToSet[len(m.ToSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToRemove", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToRemove = append(m.ToRemove, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateWasmGasCostsProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateWasmGasCostsProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateWasmGasCostsProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAlternateFeeDenomsProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAlternateFeeDenomsProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAlternateFeeDenomsProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAdd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAdd = append(m.ToAdd, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToRemove", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToRemove = append(m.ToRemove, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAlternateFeeDenomsProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAlternateFeeDenomsProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAlternateFeeDenomsProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -397,9 +397,9 @@ func (k Keeper) CreateRootName(ctx sdk.Context, name, owner string, restricted b
 			if err = k.SetNameRecord(ctx, n, addr, restricted); err != nil {
 				return err
 			}
-			logger.Info(fmt.Sprintf("create root name proposal: created %s and set the owner as %s", n, owner))
+			logger.Info("create root name proposal: created name", "name", n, "owner", owner)
 		} else {
-			logger.Info(fmt.Sprintf("create root name proposal: intermediate domain %s exists, skipping", n))
+			logger.Info("create root name proposal: intermediate domain exists, skipping", "name", n)
 		}
 	}
 
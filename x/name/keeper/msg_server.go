@@ -33,20 +33,20 @@ func (s msgServer) BindName(goCtx context.Context, msg *types.MsgBindNameRequest
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	// Validate
 	if err := msg.ValidateBasic(); err != nil {
-		ctx.Logger().Error("unable to validate message", "err", err)
+		s.Logger(ctx).Error("unable to validate message", "error", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 	// Fetch the parent name record from the keeper.
 	record, err := s.Keeper.GetRecordByName(ctx, msg.Parent.Name)
 	if err != nil {
-		ctx.Logger().Error("unable to find parent name record", "err", err)
+		s.Logger(ctx).Error("unable to find parent name record", "error", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 	// Ensure that if the parent name is restricted, it resolves to the given parent address (message signer).
 	if record.Restricted {
 		parentAddress, addrErr := sdk.AccAddressFromBech32(msg.Parent.Address)
 		if addrErr != nil {
-			ctx.Logger().Error("unable to parse parent address", "err", addrErr)
+			s.Logger(ctx).Error("unable to parse parent address", "error", addrErr)
 			return nil, sdkerrors.ErrInvalidRequest.Wrap(addrErr.Error())
 		}
 		if !s.Keeper.ResolvesTo(ctx, msg.Parent.Name, parentAddress) {
@@ -57,21 +57,21 @@ func (s msgServer) BindName(goCtx context.Context, msg *types.MsgBindNameRequest
 	n := fmt.Sprintf("%s.%s", msg.Record.Name, msg.Parent.Name)
 	name, err := s.Keeper.Normalize(ctx, n)
 	if err != nil {
-		ctx.Logger().Error("invalid name", "name", name)
+		s.Logger(ctx).Error("invalid name", "name", name)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 	if s.Keeper.NameExists(ctx, name) {
-		ctx.Logger().Error("name already bound", "name", name)
+		s.Logger(ctx).Error("name already bound", "name", name)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(types.ErrNameAlreadyBound.Error())
 	}
 	// Bind name to address
 	address, err := sdk.AccAddressFromBech32(msg.Record.Address)
 	if err != nil {
-		ctx.Logger().Error("invalid address", "err", err)
+		s.Logger(ctx).Error("invalid address", "error", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 	if err := s.Keeper.SetNameRecord(ctx, name, address, msg.Record.Restricted); err != nil {
-		ctx.Logger().Error("unable to bind name", "err", err)
+		s.Logger(ctx).Error("unable to bind name", "error", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
@@ -100,35 +100,35 @@ func (s msgServer) DeleteName(goCtx context.Context, msg *types.MsgDeleteNameReq
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	// Validate
 	if err := msg.ValidateBasic(); err != nil {
-		ctx.Logger().Error("unable to validate message", "err", err)
+		s.Logger(ctx).Error("unable to validate message", "error", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 	// Normalize
 	name, err := s.Keeper.Normalize(ctx, msg.Record.Name)
 	if err != nil {
-		ctx.Logger().Error("invalid name", "err", err)
+		s.Logger(ctx).Error("invalid name", "error", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 	// Parse address
 	address, err := sdk.AccAddressFromBech32(msg.Record.Address)
 	if err != nil {
-		ctx.Logger().Error("invalid address", "err", err)
+		s.Logger(ctx).Error("invalid address", "error", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 	// Ensure the name exists
 	if !s.Keeper.NameExists(ctx, name) {
-		ctx.Logger().Error("invalid name", "name", name)
+		s.Logger(ctx).Error("invalid name", "name", name)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap("name does not exist")
 	}
 	// Ensure permission
 	if !s.Keeper.ResolvesTo(ctx, name, address) {
-		ctx.Logger().Error("msg sender cannot delete name", "name", name)
+		s.Logger(ctx).Error("msg sender cannot delete name", "name", name)
 		return nil, sdkerrors.ErrUnauthorized.Wrap("msg sender cannot delete name")
 	}
 	// Delete
 	err = s.Keeper.DeleteRecord(ctx, name)
 	if err != nil {
-		ctx.Logger().Error("error deleting name", "err", err)
+		s.Logger(ctx).Error("error deleting name", "error", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
@@ -167,7 +167,7 @@ func (s msgServer) ModifyName(goCtx context.Context, msg *types.MsgModifyNameReq
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(types.ErrNameNotBound.Error())
 	}
 
-	if msg.GetAuthority() != s.Keeper.GetAuthority() && msg.GetAuthority() != existing.Address {
+	if !s.Keeper.IsAuthority(msg.GetAuthority()) && msg.GetAuthority() != existing.Address {
 		return nil, sdkerrors.ErrUnauthorized.Wrapf("expected %s or %s got %s", s.Keeper.GetAuthority(), existing.Address, msg.GetAuthority())
 	}
 
@@ -187,7 +187,7 @@ func (s msgServer) ModifyName(goCtx context.Context, msg *types.MsgModifyNameReq
 func (s msgServer) CreateRootName(goCtx context.Context, msg *types.MsgCreateRootNameRequest) (*types.MsgCreateRootNameResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if s.Keeper.GetAuthority() != msg.Authority {
+	if !s.Keeper.IsAuthority(msg.Authority) {
 		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", s.Keeper.GetAuthority(), msg.Authority)
 	}
 
@@ -91,6 +91,10 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	testutil.MutateGenesisState(s.T(), &s.cfg, oracletypes.ModuleName, &oracletypes.GenesisState{}, func(oracleData *oracletypes.GenesisState) *oracletypes.GenesisState {
 		oracleData.PortId = s.port
 		oracleData.Oracle = s.oracle
+		oracleData.Prices = []oracletypes.Price{
+			{Assets: sdk.NewInt64Coin("apple", 10), Price: sdk.NewInt64Coin("peach", 55), Source: "x/exchange market 3", UpdatedBlockHeight: 1},
+			{Assets: sdk.NewInt64Coin("apple", 3), Price: sdk.NewInt64Coin("plum", 7), Source: "x/exchange market 5", UpdatedBlockHeight: 1},
+		}
 		return oracleData
 	})
 
@@ -146,6 +150,102 @@ func (s *IntegrationTestSuite) TestQueryOracleAddress() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestQueryPrice() {
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErrMsg string
+		expectedStr  string
+	}{
+		{
+			name:        "success - query for price",
+			args:        []string{"apple", "peach"},
+			expectedStr: "10apple=55peach",
+		},
+		{
+			name:         "failure - unknown price",
+			args:         []string{"peach", "apple"},
+			expectErrMsg: "rpc error: code = NotFound desc = rpc error: code = NotFound desc = no price found for \"peach\" in \"apple\": key not found",
+		},
+		{
+			name:         "failure - too few args",
+			args:         []string{"apple"},
+			expectErrMsg: "accepts 2 arg(s), received 1",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			clientCtx := s.network.Validators[0].ClientCtx
+			args := append(tc.args, fmt.Sprintf("--%s=json", cmtcli.OutputFlag))
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, oraclecli.GetQueryPriceCmd(), args)
+			if len(tc.expectErrMsg) > 0 {
+				s.EqualError(err, tc.expectErrMsg, "should have correct error message for invalid QueryPrice")
+			} else {
+				var response types.QueryPriceResponse
+				s.NoError(err, "should have no error message for valid QueryPrice")
+				err = s.cfg.Codec.UnmarshalJSON(out.Bytes(), &response)
+				s.NoError(err, "should have no error message when unmarshalling response to QueryPrice")
+				s.Equal(tc.expectedStr, response.Price.Assets.String()+"="+response.Price.Price.String(), "should have the correct price")
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestQueryPrices() {
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErrMsg string
+		expectedStrs []string
+	}{
+		{
+			name:         "success - all prices",
+			expectedStrs: []string{"10apple=55peach", "3apple=7plum"},
+		},
+		{
+			name:         "success - prices of apple with limit",
+			args:         []string{"apple", "--limit", "1"},
+			expectedStrs: []string{"10apple=55peach"},
+		},
+		{
+			name:         "success - prices of plum",
+			args:         []string{"plum"},
+			expectedStrs: nil,
+		},
+		{
+			name:         "failure - invalid asset denom",
+			args:         []string{"x"},
+			expectErrMsg: "rpc error: code = InvalidArgument desc = rpc error: code = InvalidArgument desc = invalid asset denom: invalid denom: x: invalid request",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			clientCtx := s.network.Validators[0].ClientCtx
+			args := append(tc.args, fmt.Sprintf("--%s=json", cmtcli.OutputFlag))
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, oraclecli.GetQueryPricesCmd(), args)
+			if len(tc.expectErrMsg) > 0 {
+				s.EqualError(err, tc.expectErrMsg, "should have correct error message for invalid QueryPrices")
+			} else {
+				var response types.QueryPricesResponse
+				s.NoError(err, "should have no error message for valid QueryPrices")
+				err = s.cfg.Codec.UnmarshalJSON(out.Bytes(), &response)
+				s.NoError(err, "should have no error message when unmarshalling response to QueryPrices")
+				var actual []string
+				for _, price := range response.Prices {
+					actual = append(actual, price.Assets.String()+"="+price.Price.String())
+				}
+				s.Equal(tc.expectedStrs, actual, "should have the correct prices")
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestOracleUpdate() {
 	testCases := []struct {
 		name         string
@@ -24,6 +24,8 @@ func GetQueryCmd() *cobra.Command {
 	}
 	queryCmd.AddCommand(
 		GetQueryOracleAddressCmd(),
+		GetQueryPriceCmd(),
+		GetQueryPricesCmd(),
 	)
 	return queryCmd
 }
@@ -59,3 +61,78 @@ func GetQueryOracleAddressCmd() *cobra.Command {
 
 	return cmd
 }
+
+// GetQueryPriceCmd queries for the most recently published price of an asset denom in a price denom
+func GetQueryPriceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "price <asset denom> <price denom>",
+		Short:   "Returns the most recently published price of an asset denom in a price denom",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf(`%[1]s q oracle price nhash uusd.trading`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryPriceRequest{
+				AssetDenom: args[0],
+				PriceDenom: args[1],
+			}
+
+			res, err := queryClient.Price(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetQueryPricesCmd queries for the prices in the module's price feed
+func GetQueryPricesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prices [asset denom]",
+		Short: "Returns the prices in the price feed, optionally limited to an asset denom",
+		Args:  cobra.MaximumNArgs(1),
+		Example: fmt.Sprintf(`%[1]s q oracle prices
+%[1]s q oracle prices nhash`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryPricesRequest{Pagination: pageReq}
+			if len(args) > 0 {
+				params.AssetDenom = args[0]
+			}
+
+			res, err := queryClient.Prices(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "prices")
+
+	return cmd
+}
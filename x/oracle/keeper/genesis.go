@@ -12,6 +12,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
 		PortId: k.GetPort(ctx),
 		Oracle: oracle.String(),
+		Prices: k.GetAllPrices(ctx),
 	}
 }
 
@@ -36,4 +37,10 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 		oracle = sdk.MustAccAddressFromBech32(genState.Oracle)
 	}
 	k.SetOracle(ctx, oracle)
+
+	for _, price := range genState.Prices {
+		if err := k.SetPrice(ctx, price); err != nil {
+			panic(err)
+		}
+	}
 }
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/provenance-io/provenance/x/oracle/types"
 )
 
@@ -8,6 +10,18 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 	genesis := s.app.OracleKeeper.ExportGenesis(s.ctx)
 	s.Assert().Equal("oracle", genesis.PortId, "should export the correct port")
 	s.Assert().Equal("", genesis.Oracle, "should export the correct oracle address")
+	s.Assert().Empty(genesis.Prices, "should export no prices")
+
+	s.Require().NoError(s.app.OracleKeeper.PublishPrice(s.ctx, sdk.NewInt64Coin("apple", 10), sdk.NewInt64Coin("peach", 55), "x/exchange market 3"), "PublishPrice")
+	genesis = s.app.OracleKeeper.ExportGenesis(s.ctx)
+	expPrices := []types.Price{{
+		Assets:             sdk.NewInt64Coin("apple", 10),
+		Price:              sdk.NewInt64Coin("peach", 55),
+		Source:             "x/exchange market 3",
+		UpdatedBlockHeight: 100,
+		UpdatedTime:        s.ctx.BlockTime().UTC(),
+	}}
+	s.Assert().Equal(expPrices, genesis.Prices, "should export the prices")
 }
 
 func (s *KeeperTestSuite) TestInitGenesis() {
@@ -35,6 +49,24 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 			genesis: types.NewGenesisState("jackthecat", "abc"),
 			err:     "decoding bech32 failed: invalid bech32 string length 3",
 		},
+		{
+			name: "success - valid genesis state with prices",
+			genesis: &types.GenesisState{
+				PortId: "jackthecat",
+				Prices: []types.Price{
+					{Assets: sdk.NewInt64Coin("apple", 10), Price: sdk.NewInt64Coin("peach", 55)},
+					{Assets: sdk.NewInt64Coin("plum", 1), Price: sdk.NewInt64Coin("peach", 2)},
+				},
+			},
+		},
+		{
+			name: "failure - invalid price",
+			genesis: &types.GenesisState{
+				PortId: "jackthecat",
+				Prices: []types.Price{{Assets: sdk.NewInt64Coin("apple", 10), Price: sdk.NewInt64Coin("apple", 55)}},
+			},
+			err: "invalid prices[0]: invalid price \"55apple\": cannot have the same denom as the assets \"10apple\"",
+		},
 		{
 			name:    "success - works with existing port",
 			genesis: types.NewGenesisState("oracle", ""),
@@ -56,6 +88,11 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 				s.Assert().Equal(tc.genesis.PortId, s.app.OracleKeeper.GetPort(s.ctx), "should correctly set the port")
 				s.Assert().True(s.app.OracleKeeper.IsBound(s.ctx, tc.genesis.PortId), "should bind the port")
 				s.Assert().Equal(tc.genesis.Oracle, oracle.String(), "should get the correct oracle address")
+				for _, exp := range tc.genesis.Prices {
+					price, err := s.app.OracleKeeper.GetPrice(s.ctx, exp.Assets.Denom, exp.Price.Denom)
+					s.Assert().NoError(err, "GetPrice(%q, %q) error", exp.Assets.Denom, exp.Price.Denom)
+					s.Assert().Equal(&exp, price, "GetPrice(%q, %q) result", exp.Assets.Denom, exp.Price.Denom)
+				}
 			}
 		})
 	}
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/oracle/types"
+)
+
+// PublishPrice adds (or replaces) the price feed entry for the provided assets and price
+// using the current block height and time, and emits an EventPricePublished.
+func (k Keeper) PublishPrice(ctx sdk.Context, assets, price sdk.Coin, source string) error {
+	entry := types.Price{
+		Assets:             assets,
+		Price:              price,
+		Source:             source,
+		UpdatedBlockHeight: uint64(ctx.BlockHeight()),
+		UpdatedTime:        ctx.BlockTime().UTC(),
+	}
+	if err := k.SetPrice(ctx, entry); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventPricePublished{
+		Assets: assets.String(),
+		Price:  price.String(),
+		Source: source,
+	})
+}
+
+// SetPrice writes the provided entry to the price feed, replacing any existing entry for the same denoms.
+func (k Keeper) SetPrice(ctx sdk.Context, price types.Price) error {
+	if err := price.Validate(); err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&price)
+	if err != nil {
+		return fmt.Errorf("could not marshal price of %q in %q: %w", price.Assets.Denom, price.Price.Denom, err)
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPriceStoreKey(price.Assets.Denom, price.Price.Denom), bz)
+	return nil
+}
+
+// GetPrice gets the price feed entry for an asset denom in a price denom.
+// Returns nil (without an error) if there isn't one.
+func (k Keeper) GetPrice(ctx sdk.Context, assetDenom, priceDenom string) (*types.Price, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPriceStoreKey(assetDenom, priceDenom))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var price types.Price
+	if err := k.cdc.Unmarshal(bz, &price); err != nil {
+		return nil, fmt.Errorf("could not unmarshal price of %q in %q: %w", assetDenom, priceDenom, err)
+	}
+	return &price, nil
+}
+
+// IteratePrices iterates over all the price feed entries, stopping early if the callback returns true.
+// Entries that cannot be read are skipped.
+func (k Keeper) IteratePrices(ctx sdk.Context, cb func(price types.Price) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PriceStoreKeyPrefix)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var price types.Price
+		if err := k.cdc.Unmarshal(iter.Value(), &price); err != nil {
+			continue
+		}
+		if cb(price) {
+			break
+		}
+	}
+}
+
+// GetAllPrices gets all the price feed entries.
+func (k Keeper) GetAllPrices(ctx sdk.Context) []types.Price {
+	var rv []types.Price
+	k.IteratePrices(ctx, func(price types.Price) bool {
+		rv = append(rv, price)
+		return false
+	})
+	return rv
+}
+
+// getPriceStore gets a prefixed store for the price feed entries of an asset denom (or all of them if it's empty).
+func (k Keeper) getPriceStore(ctx sdk.Context, assetDenom string) storetypes.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.GetPriceStoreKeyPrefix(assetDenom))
+}
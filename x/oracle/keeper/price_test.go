@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/oracle/types"
+)
+
+func (s *KeeperTestSuite) TestPublishPrice() {
+	tests := []struct {
+		name   string
+		assets sdk.Coin
+		price  sdk.Coin
+		source string
+		err    string
+	}{
+		{
+			name:   "failure - invalid assets",
+			assets: sdk.NewInt64Coin("apple", 0),
+			price:  sdk.NewInt64Coin("peach", 55),
+			err:    "invalid assets \"0apple\": amount must be positive",
+		},
+		{
+			name:   "failure - same denoms",
+			assets: sdk.NewInt64Coin("apple", 10),
+			price:  sdk.NewInt64Coin("apple", 55),
+			err:    "invalid price \"55apple\": cannot have the same denom as the assets \"10apple\"",
+		},
+		{
+			name:   "success - new price",
+			assets: sdk.NewInt64Coin("apple", 10),
+			price:  sdk.NewInt64Coin("peach", 55),
+			source: "x/exchange market 3",
+		},
+		{
+			name:   "success - replaces existing price",
+			assets: sdk.NewInt64Coin("apple", 4),
+			price:  sdk.NewInt64Coin("peach", 20),
+			source: "x/exchange market 5",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			err := s.app.OracleKeeper.PublishPrice(ctx, tc.assets, tc.price, tc.source)
+			if len(tc.err) > 0 {
+				s.Assert().EqualError(err, tc.err, "PublishPrice error")
+				s.Assert().Empty(em.Events(), "events emitted")
+				return
+			}
+			s.Require().NoError(err, "PublishPrice error")
+
+			expEvent, err := sdk.TypedEventToEvent(&types.EventPricePublished{
+				Assets: tc.assets.String(),
+				Price:  tc.price.String(),
+				Source: tc.source,
+			})
+			s.Require().NoError(err, "TypedEventToEvent")
+			s.Assert().Equal(sdk.Events{expEvent}, em.Events(), "events emitted")
+
+			expPrice := &types.Price{
+				Assets:             tc.assets,
+				Price:              tc.price,
+				Source:             tc.source,
+				UpdatedBlockHeight: 100,
+				UpdatedTime:        s.ctx.BlockTime().UTC(),
+			}
+			price, err := s.app.OracleKeeper.GetPrice(s.ctx, tc.assets.Denom, tc.price.Denom)
+			s.Require().NoError(err, "GetPrice error")
+			s.Assert().Equal(expPrice, price, "GetPrice result")
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGetPrice() {
+	price, err := s.app.OracleKeeper.GetPrice(s.ctx, "apple", "peach")
+	s.Assert().NoError(err, "GetPrice error for unknown price")
+	s.Assert().Nil(price, "GetPrice result for unknown price")
+
+	s.Require().NoError(s.app.OracleKeeper.PublishPrice(s.ctx, sdk.NewInt64Coin("apple", 10), sdk.NewInt64Coin("peach", 55), ""), "PublishPrice")
+	price, err = s.app.OracleKeeper.GetPrice(s.ctx, "apple", "plum")
+	s.Assert().NoError(err, "GetPrice error for other price denom")
+	s.Assert().Nil(price, "GetPrice result for other price denom")
+	price, err = s.app.OracleKeeper.GetPrice(s.ctx, "peach", "apple")
+	s.Assert().NoError(err, "GetPrice error for swapped denoms")
+	s.Assert().Nil(price, "GetPrice result for swapped denoms")
+	price, err = s.app.OracleKeeper.GetPrice(s.ctx, "apple", "peach")
+	s.Assert().NoError(err, "GetPrice error for known price")
+	if s.Assert().NotNil(price, "GetPrice result for known price") {
+		s.Assert().Equal("10apple", price.Assets.String(), "price assets")
+		s.Assert().Equal("55peach", price.Price.String(), "price price")
+	}
+}
+
+func (s *KeeperTestSuite) TestGetAllPrices() {
+	s.Assert().Empty(s.app.OracleKeeper.GetAllPrices(s.ctx), "GetAllPrices with nothing in state")
+
+	s.Require().NoError(s.app.OracleKeeper.PublishPrice(s.ctx, sdk.NewInt64Coin("plum", 1), sdk.NewInt64Coin("peach", 2), ""), "PublishPrice plum/peach")
+	s.Require().NoError(s.app.OracleKeeper.PublishPrice(s.ctx, sdk.NewInt64Coin("apple", 3), sdk.NewInt64Coin("plum", 7), ""), "PublishPrice apple/plum")
+	s.Require().NoError(s.app.OracleKeeper.PublishPrice(s.ctx, sdk.NewInt64Coin("apple", 10), sdk.NewInt64Coin("peach", 55), ""), "PublishPrice apple/peach")
+
+	var actual []string
+	for _, price := range s.app.OracleKeeper.GetAllPrices(s.ctx) {
+		actual = append(actual, price.Assets.String()+"="+price.Price.String())
+	}
+	expected := []string{"1plum=2peach", "10apple=55peach", "3apple=7plum"}
+	s.Assert().Equal(expected, actual, "GetAllPrices")
+}
@@ -8,6 +8,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/provenance-io/provenance/x/oracle/types"
 )
@@ -45,3 +46,55 @@ func (k Keeper) Oracle(goCtx context.Context, req *types.QueryOracleRequest) (*t
 	}
 	return &types.QueryOracleResponse{Data: resp.Data}, nil
 }
+
+// Price returns the most recently published price for an asset denom in a price denom.
+func (k Keeper) Price(goCtx context.Context, req *types.QueryPriceRequest) (*types.QueryPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := sdk.ValidateDenom(req.AssetDenom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid asset denom: %v", err)
+	}
+	if err := sdk.ValidateDenom(req.PriceDenom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid price denom: %v", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	price, err := k.GetPrice(ctx, req.AssetDenom, req.PriceDenom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if price == nil {
+		return nil, status.Errorf(codes.NotFound, "no price found for %q in %q", req.AssetDenom, req.PriceDenom)
+	}
+	return &types.QueryPriceResponse{Price: *price}, nil
+}
+
+// Prices returns the prices in the price feed, optionally limited to a single asset denom.
+func (k Keeper) Prices(goCtx context.Context, req *types.QueryPricesRequest) (*types.QueryPricesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if len(req.AssetDenom) > 0 {
+		if err := sdk.ValidateDenom(req.AssetDenom); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid asset denom: %v", err)
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := k.getPriceStore(ctx, req.AssetDenom)
+	resp := &types.QueryPricesResponse{}
+	var pageErr error
+	resp.Pagination, pageErr = query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var price types.Price
+		if err := k.cdc.Unmarshal(value, &price); err != nil {
+			return err
+		}
+		resp.Prices = append(resp.Prices, price)
+		return nil
+	})
+	if pageErr != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error iterating prices: %v", pageErr)
+	}
+	return resp, nil
+}
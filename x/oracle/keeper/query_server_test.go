@@ -2,6 +2,8 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
 	"github.com/provenance-io/provenance/x/oracle/keeper"
 	"github.com/provenance-io/provenance/x/oracle/types"
 )
@@ -126,3 +128,125 @@ func (s *KeeperTestSuite) TestOracle() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestPrice() {
+	s.Require().NoError(s.app.OracleKeeper.PublishPrice(s.ctx, sdk.NewInt64Coin("apple", 10), sdk.NewInt64Coin("peach", 55), "x/exchange market 3"), "PublishPrice")
+
+	tests := []struct {
+		name     string
+		req      *types.QueryPriceRequest
+		expected *types.QueryPriceResponse
+		err      string
+	}{
+		{
+			name: "failure - should handle nil request",
+			req:  nil,
+			err:  "rpc error: code = InvalidArgument desc = invalid request",
+		},
+		{
+			name: "failure - should handle invalid asset denom",
+			req:  &types.QueryPriceRequest{AssetDenom: "x", PriceDenom: "peach"},
+			err:  "rpc error: code = InvalidArgument desc = invalid asset denom: invalid denom: x",
+		},
+		{
+			name: "failure - should handle invalid price denom",
+			req:  &types.QueryPriceRequest{AssetDenom: "apple", PriceDenom: ""},
+			err:  "rpc error: code = InvalidArgument desc = invalid price denom: invalid denom: ",
+		},
+		{
+			name: "failure - should handle unknown price",
+			req:  &types.QueryPriceRequest{AssetDenom: "apple", PriceDenom: "plum"},
+			err:  "rpc error: code = NotFound desc = no price found for \"apple\" in \"plum\"",
+		},
+		{
+			name: "success - should return the price",
+			req:  &types.QueryPriceRequest{AssetDenom: "apple", PriceDenom: "peach"},
+			expected: &types.QueryPriceResponse{Price: types.Price{
+				Assets:             sdk.NewInt64Coin("apple", 10),
+				Price:              sdk.NewInt64Coin("peach", 55),
+				Source:             "x/exchange market 3",
+				UpdatedBlockHeight: 100,
+				UpdatedTime:        s.ctx.BlockTime().UTC(),
+			}},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := s.app.OracleKeeper.Price(s.ctx, tc.req)
+			if len(tc.err) > 0 {
+				s.Assert().EqualError(err, tc.err, "should return the correct error")
+				s.Assert().Nil(resp, "response should be nil")
+			} else {
+				s.Assert().NoError(err, "should not return an error")
+				s.Assert().Equal(tc.expected, resp, "should return the correct response")
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestPrices() {
+	s.Require().NoError(s.app.OracleKeeper.PublishPrice(s.ctx, sdk.NewInt64Coin("plum", 1), sdk.NewInt64Coin("peach", 2), ""), "PublishPrice plum/peach")
+	s.Require().NoError(s.app.OracleKeeper.PublishPrice(s.ctx, sdk.NewInt64Coin("apple", 3), sdk.NewInt64Coin("plum", 7), ""), "PublishPrice apple/plum")
+	s.Require().NoError(s.app.OracleKeeper.PublishPrice(s.ctx, sdk.NewInt64Coin("apple", 10), sdk.NewInt64Coin("peach", 55), ""), "PublishPrice apple/peach")
+
+	tests := []struct {
+		name     string
+		req      *types.QueryPricesRequest
+		expected []string
+		err      string
+	}{
+		{
+			name: "failure - should handle nil request",
+			req:  nil,
+			err:  "rpc error: code = InvalidArgument desc = invalid request",
+		},
+		{
+			name: "failure - should handle invalid asset denom",
+			req:  &types.QueryPricesRequest{AssetDenom: "x"},
+			err:  "rpc error: code = InvalidArgument desc = invalid asset denom: invalid denom: x",
+		},
+		{
+			name:     "success - all prices",
+			req:      &types.QueryPricesRequest{},
+			expected: []string{"1plum=2peach", "10apple=55peach", "3apple=7plum"},
+		},
+		{
+			name:     "success - prices of apple",
+			req:      &types.QueryPricesRequest{AssetDenom: "apple"},
+			expected: []string{"10apple=55peach", "3apple=7plum"},
+		},
+		{
+			name:     "success - prices of an unknown denom",
+			req:      &types.QueryPricesRequest{AssetDenom: "peach"},
+			expected: nil,
+		},
+		{
+			name:     "success - limited",
+			req:      &types.QueryPricesRequest{Pagination: &query.PageRequest{Limit: 2}},
+			expected: []string{"1plum=2peach", "10apple=55peach"},
+		},
+		{
+			name:     "success - offset",
+			req:      &types.QueryPricesRequest{Pagination: &query.PageRequest{Offset: 2}},
+			expected: []string{"3apple=7plum"},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := s.app.OracleKeeper.Prices(s.ctx, tc.req)
+			if len(tc.err) > 0 {
+				s.Assert().EqualError(err, tc.err, "should return the correct error")
+				s.Assert().Nil(resp, "response should be nil")
+				return
+			}
+			s.Require().NoError(err, "should not return an error")
+			var actual []string
+			for _, price := range resp.Prices {
+				actual = append(actual, price.Assets.String()+"="+price.Price.String())
+			}
+			s.Assert().Equal(tc.expected, actual, "should return the correct prices")
+		})
+	}
+}
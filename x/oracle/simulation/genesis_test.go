@@ -94,6 +94,7 @@ func TestRandomizedGenState(t *testing.T) {
 			expOracleGen: &types.GenesisState{
 				PortId: "vipxlpbshz",
 				Oracle: "",
+				Prices: []types.Price{},
 			},
 		},
 		{
@@ -103,6 +104,7 @@ func TestRandomizedGenState(t *testing.T) {
 			expOracleGen: &types.GenesisState{
 				PortId: "oracle",
 				Oracle: "",
+				Prices: []types.Price{},
 			},
 		},
 		{
@@ -112,6 +114,7 @@ func TestRandomizedGenState(t *testing.T) {
 			expOracleGen: &types.GenesisState{
 				PortId: "knxndtw",
 				Oracle: "cosmos10gqqppkly524p6v7hypvvl8sn7wky85jajrph0",
+				Prices: []types.Price{},
 			},
 		},
 	}
@@ -205,33 +205,100 @@ func (m *EventOracleQueryTimeout) GetSequenceId() string {
 	return ""
 }
 
+// EventPricePublished is an event for when a price is published to the module's price feed
+type EventPricePublished struct {
+	// assets is the amount of assets (as a coin string) that the price is for
+	Assets string `protobuf:"bytes,1,opt,name=assets,proto3" json:"assets,omitempty"`
+	// price is the total price (as a coin string) of the assets
+	Price string `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	// source identifies where the price came from
+	Source string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+}
+
+func (m *EventPricePublished) Reset()         { *m = EventPricePublished{} }
+func (m *EventPricePublished) String() string { return proto.CompactTextString(m) }
+func (*EventPricePublished) ProtoMessage()    {}
+func (*EventPricePublished) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e98d10c8454ad24d, []int{3}
+}
+func (m *EventPricePublished) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPricePublished) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPricePublished.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPricePublished) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPricePublished.Merge(m, src)
+}
+func (m *EventPricePublished) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPricePublished) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPricePublished.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPricePublished proto.InternalMessageInfo
+
+func (m *EventPricePublished) GetAssets() string {
+	if m != nil {
+		return m.Assets
+	}
+	return ""
+}
+
+func (m *EventPricePublished) GetPrice() string {
+	if m != nil {
+		return m.Price
+	}
+	return ""
+}
+
+func (m *EventPricePublished) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventOracleQuerySuccess)(nil), "provenance.oracle.v1.EventOracleQuerySuccess")
 	proto.RegisterType((*EventOracleQueryError)(nil), "provenance.oracle.v1.EventOracleQueryError")
 	proto.RegisterType((*EventOracleQueryTimeout)(nil), "provenance.oracle.v1.EventOracleQueryTimeout")
+	proto.RegisterType((*EventPricePublished)(nil), "provenance.oracle.v1.EventPricePublished")
 }
 
 func init() { proto.RegisterFile("provenance/oracle/v1/event.proto", fileDescriptor_e98d10c8454ad24d) }
 
 var fileDescriptor_e98d10c8454ad24d = []byte{
-	// 258 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x28, 0x28, 0xca, 0x2f,
-	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0xcf, 0x2f, 0x4a, 0x4c, 0xce, 0x49, 0xd5, 0x2f, 0x33,
-	0xd4, 0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x41, 0xa8,
-	0xd0, 0x83, 0xa8, 0xd0, 0x2b, 0x33, 0x54, 0xca, 0xe1, 0x12, 0x77, 0x05, 0x29, 0xf2, 0x07, 0x8b,
-	0x04, 0x96, 0xa6, 0x16, 0x55, 0x06, 0x97, 0x26, 0x27, 0xa7, 0x16, 0x17, 0x0b, 0x49, 0x70, 0xb1,
-	0x27, 0x67, 0x24, 0xe6, 0xe5, 0xa5, 0xe6, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x06, 0xc1, 0xb8,
-	0x42, 0xf2, 0x5c, 0xdc, 0xc5, 0xa9, 0x85, 0xa5, 0xa9, 0x79, 0xc9, 0xa9, 0xf1, 0x99, 0x29, 0x12,
-	0x4c, 0x60, 0x59, 0x2e, 0x98, 0x90, 0x67, 0x8a, 0x90, 0x18, 0x17, 0x5b, 0x51, 0x6a, 0x71, 0x69,
-	0x4e, 0x89, 0x04, 0x33, 0x58, 0x0e, 0xca, 0x53, 0xca, 0xe0, 0x12, 0x45, 0xb7, 0xcd, 0xb5, 0xa8,
-	0x28, 0xbf, 0x88, 0x12, 0xbb, 0x44, 0xb8, 0x58, 0x53, 0x41, 0x66, 0x40, 0xad, 0x82, 0x70, 0x94,
-	0x42, 0x30, 0xfd, 0x15, 0x92, 0x99, 0x9b, 0x9a, 0x5f, 0x5a, 0x42, 0x81, 0x5d, 0x4e, 0xe9, 0x27,
-	0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c,
-	0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0xc0, 0x25, 0x9e, 0x99, 0xaf, 0x87, 0x2d, 0x80,
-	0x03, 0x18, 0xa3, 0x8c, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x11,
-	0x4a, 0x74, 0x33, 0xf3, 0x91, 0x78, 0xfa, 0x15, 0xb0, 0x58, 0x2b, 0xa9, 0x2c, 0x48, 0x2d, 0x4e,
-	0x62, 0x03, 0xc7, 0x99, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x36, 0xdb, 0x1f, 0x32, 0xd7, 0x01,
-	0x00, 0x00,
+	// 297 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xb1, 0x4e, 0xc3, 0x30,
+	0x10, 0x86, 0x6b, 0x10, 0x45, 0x98, 0x2d, 0x14, 0x9a, 0xc9, 0x54, 0x99, 0x58, 0x48, 0x54, 0x78,
+	0x03, 0xa4, 0x0e, 0x4c, 0x04, 0xe8, 0x04, 0x03, 0x4a, 0xdc, 0x53, 0x63, 0x29, 0xb5, 0x83, 0xcf,
+	0x8e, 0xe8, 0x5b, 0xf0, 0x58, 0x8c, 0x1d, 0x19, 0x51, 0xf2, 0x22, 0x28, 0x4e, 0xa2, 0x22, 0x60,
+	0xeb, 0xf8, 0xdd, 0xfd, 0xfa, 0x7f, 0x9f, 0xef, 0xe8, 0xa4, 0xd0, 0xaa, 0x04, 0x99, 0x48, 0x0e,
+	0x91, 0xd2, 0x09, 0xcf, 0x21, 0x2a, 0xa7, 0x11, 0x94, 0x20, 0x4d, 0x58, 0x68, 0x65, 0x94, 0x37,
+	0xda, 0x2a, 0xc2, 0x56, 0x11, 0x96, 0xd3, 0x20, 0xa7, 0xe3, 0x59, 0x23, 0xba, 0x73, 0x95, 0x7b,
+	0x0b, 0x7a, 0xfd, 0x68, 0x39, 0x07, 0x44, 0xcf, 0xa7, 0x87, 0x3c, 0x4b, 0xa4, 0x84, 0xdc, 0x27,
+	0x13, 0x72, 0x71, 0xf4, 0xd0, 0xa3, 0x77, 0x4e, 0x8f, 0x11, 0x5e, 0x2d, 0x48, 0x0e, 0x2f, 0x62,
+	0xe1, 0xef, 0xb9, 0x2e, 0xed, 0x4b, 0xb7, 0x0b, 0xef, 0x8c, 0x0e, 0x35, 0xa0, 0xcd, 0x8d, 0xbf,
+	0xef, 0x7a, 0x1d, 0x05, 0x19, 0x3d, 0xfd, 0x9d, 0x36, 0xd3, 0x5a, 0xe9, 0x5d, 0xb2, 0x46, 0xf4,
+	0x00, 0x1a, 0x8f, 0x2e, 0xaa, 0x85, 0x60, 0xfe, 0x77, 0xae, 0xb9, 0x58, 0x81, 0xb2, 0x66, 0x87,
+	0xac, 0xe0, 0x99, 0x9e, 0x38, 0xd7, 0x58, 0x0b, 0x0e, 0xb1, 0x4d, 0x73, 0x81, 0x19, 0xb8, 0x71,
+	0x13, 0x44, 0x30, 0xd8, 0x19, 0x76, 0xd4, 0x3c, 0xad, 0x68, 0x94, 0x9d, 0x53, 0x0b, 0x8d, 0x1a,
+	0x95, 0xd5, 0x1c, 0xfa, 0xcf, 0x69, 0xe9, 0x66, 0xf9, 0x51, 0x31, 0xb2, 0xa9, 0x18, 0xf9, 0xaa,
+	0x18, 0x79, 0xaf, 0xd9, 0x60, 0x53, 0xb3, 0xc1, 0x67, 0xcd, 0x06, 0x74, 0x2c, 0x54, 0xf8, 0xdf,
+	0xf6, 0x62, 0xf2, 0x74, 0xb5, 0x14, 0x26, 0xb3, 0x69, 0xc8, 0xd5, 0x2a, 0xda, 0x4a, 0x2e, 0x85,
+	0xfa, 0x41, 0xd1, 0x5b, 0x7f, 0x12, 0x66, 0x5d, 0x00, 0xa6, 0x43, 0x77, 0x10, 0xd7, 0xdf, 0x03,
+	0x00, 0x62, 0xaa, 0x40, 0xd3, 0x34, 0x02, 0x00, 0x00,
 }
 
 func (m *EventOracleQuerySuccess) Marshal() (dAtA []byte, err error) {
@@ -359,6 +426,50 @@ func (m *EventOracleQueryTimeout) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *EventPricePublished) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPricePublished) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPricePublished) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Assets) > 0 {
+		i -= len(m.Assets)
+		copy(dAtA[i:], m.Assets)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Assets)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -429,6 +540,27 @@ func (m *EventOracleQueryTimeout) Size() (n int) {
 	return n
 }
 
+func (m *EventPricePublished) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Assets)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
 func sovEvent(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -841,6 +973,152 @@ func (m *EventOracleQueryTimeout) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventPricePublished) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPricePublished: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPricePublished: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assets = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvent(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 )
@@ -29,5 +31,17 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	seen := make(map[string]bool, len(gs.Prices))
+	for i, price := range gs.Prices {
+		if err = price.Validate(); err != nil {
+			return fmt.Errorf("invalid prices[%d]: %w", i, err)
+		}
+		key := string(GetPriceStoreKey(price.Assets.Denom, price.Price.Denom))
+		if seen[key] {
+			return fmt.Errorf("invalid prices[%d]: duplicate price of %q in %q", i, price.Assets.Denom, price.Price.Denom)
+		}
+		seen[key] = true
+	}
+
 	return nil
 }
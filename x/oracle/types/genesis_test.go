@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestNewGenesisState(t *testing.T) {
@@ -46,6 +48,39 @@ func TestGenesisValidate(t *testing.T) {
 			state: NewGenesisState(PortID, "abc"),
 			err:   "decoding bech32 failed: invalid bech32 string length 3",
 		},
+		{
+			name: "success - with prices",
+			state: &GenesisState{
+				PortId: PortID,
+				Prices: []Price{
+					{Assets: sdk.NewInt64Coin("apple", 10), Price: sdk.NewInt64Coin("peach", 55)},
+					{Assets: sdk.NewInt64Coin("apple", 3), Price: sdk.NewInt64Coin("plum", 7)},
+					{Assets: sdk.NewInt64Coin("plum", 1), Price: sdk.NewInt64Coin("peach", 2)},
+				},
+			},
+		},
+		{
+			name: "failure - invalid price",
+			state: &GenesisState{
+				PortId: PortID,
+				Prices: []Price{
+					{Assets: sdk.NewInt64Coin("apple", 10), Price: sdk.NewInt64Coin("peach", 55)},
+					{Assets: sdk.NewInt64Coin("apple", 0), Price: sdk.NewInt64Coin("plum", 7)},
+				},
+			},
+			err: "invalid prices[1]: invalid assets \"0apple\": amount must be positive",
+		},
+		{
+			name: "failure - duplicate price",
+			state: &GenesisState{
+				PortId: PortID,
+				Prices: []Price{
+					{Assets: sdk.NewInt64Coin("apple", 10), Price: sdk.NewInt64Coin("peach", 55)},
+					{Assets: sdk.NewInt64Coin("apple", 3), Price: sdk.NewInt64Coin("peach", 17)},
+				},
+			},
+			err: "invalid prices[1]: duplicate price of \"apple\" in \"peach\"",
+		},
 	}
 
 	for _, tc := range tests {
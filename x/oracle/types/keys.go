@@ -31,11 +31,18 @@ const (
 //	PortStoreKey
 //	- 0x02: string
 //	  | 1 |
+//
+//
+//	PriceStoreKey
+//	- 0x03<asset_denom_len><asset_denom><price_denom>: Price
+//	  | 1 |   1   |  asset_denom_len  | price_denom_len |
 var (
 	// OracleStoreKey is the key for the module's oracle address
 	OracleStoreKey = []byte{0x01}
 	// PortStoreKey defines the key to store the port ID in store
 	PortStoreKey = []byte{0x02}
+	// PriceStoreKeyPrefix is the key prefix for the price feed entries in store
+	PriceStoreKeyPrefix = []byte{0x03}
 )
 
 // GetOracleStoreKey is a function to get the key for the oracle's address in store
@@ -47,3 +54,21 @@ func GetOracleStoreKey() []byte {
 func GetPortStoreKey() []byte {
 	return PortStoreKey
 }
+
+// GetPriceStoreKeyPrefix is a function to get the key prefix for all price feed entries with the given asset denom.
+// If the asset denom is empty, the prefix for all price feed entries is returned.
+func GetPriceStoreKeyPrefix(assetDenom string) []byte {
+	if len(assetDenom) == 0 {
+		return PriceStoreKeyPrefix
+	}
+	rv := make([]byte, 0, len(PriceStoreKeyPrefix)+1+len(assetDenom))
+	rv = append(rv, PriceStoreKeyPrefix...)
+	rv = append(rv, byte(len(assetDenom)))
+	rv = append(rv, assetDenom...)
+	return rv
+}
+
+// GetPriceStoreKey is a function to get the key for the price feed entry of an asset denom in a price denom
+func GetPriceStoreKey(assetDenom, priceDenom string) []byte {
+	return append(GetPriceStoreKeyPrefix(assetDenom), priceDenom...)
+}
@@ -15,3 +15,14 @@ func TestGetPortStoreKey(t *testing.T) {
 	key := GetPortStoreKey()
 	assert.EqualValues(t, PortStoreKey, key[0:1], "must return correct port key")
 }
+
+func TestGetPriceStoreKeyPrefix(t *testing.T) {
+	assert.Equal(t, PriceStoreKeyPrefix, GetPriceStoreKeyPrefix(""), "empty asset denom")
+	assert.Equal(t, []byte{0x03, 5, 'a', 'p', 'p', 'l', 'e'}, GetPriceStoreKeyPrefix("apple"), "apple asset denom")
+}
+
+func TestGetPriceStoreKey(t *testing.T) {
+	key := GetPriceStoreKey("apple", "peach")
+	assert.EqualValues(t, PriceStoreKeyPrefix, key[0:1], "must start with the price key prefix")
+	assert.Equal(t, []byte{0x03, 5, 'a', 'p', 'p', 'l', 'e', 'p', 'e', 'a', 'c', 'h'}, key, "price key")
+}
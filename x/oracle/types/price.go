@@ -0,0 +1,20 @@
+package types
+
+import "fmt"
+
+// Validate returns an error if there is something wrong with this price feed entry.
+func (p Price) Validate() error {
+	if err := p.Assets.Validate(); err != nil {
+		return fmt.Errorf("invalid assets %q: %w", p.Assets, err)
+	}
+	if !p.Assets.IsPositive() {
+		return fmt.Errorf("invalid assets %q: amount must be positive", p.Assets)
+	}
+	if err := p.Price.Validate(); err != nil {
+		return fmt.Errorf("invalid price %q: %w", p.Price, err)
+	}
+	if p.Assets.Denom == p.Price.Denom {
+		return fmt.Errorf("invalid price %q: cannot have the same denom as the assets %q", p.Price, p.Assets)
+	}
+	return nil
+}
@@ -0,0 +1,531 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/oracle/v1/price.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Price is an entry in the oracle module's price feed.
+// Only the most recently published price is kept for each asset and price denom pair.
+type Price struct {
+	// assets is the amount of the asset denom that the price is for.
+	Assets types.Coin `protobuf:"bytes,1,opt,name=assets,proto3" json:"assets"`
+	// price is the total price paid for the assets.
+	Price types.Coin `protobuf:"bytes,2,opt,name=price,proto3" json:"price"`
+	// source identifies where the price came from, e.g. "x/exchange market 3".
+	Source string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	// updated_block_height is the block height at which the price was published.
+	UpdatedBlockHeight uint64 `protobuf:"varint,4,opt,name=updated_block_height,json=updatedBlockHeight,proto3" json:"updated_block_height,omitempty"`
+	// updated_time is the block time at which the price was published.
+	UpdatedTime time.Time `protobuf:"bytes,5,opt,name=updated_time,json=updatedTime,proto3,stdtime" json:"updated_time"`
+}
+
+func (m *Price) Reset()         { *m = Price{} }
+func (m *Price) String() string { return proto.CompactTextString(m) }
+func (*Price) ProtoMessage()    {}
+func (*Price) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3303562b190f4cfe, []int{0}
+}
+func (m *Price) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Price) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Price.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Price) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Price.Merge(m, src)
+}
+func (m *Price) XXX_Size() int {
+	return m.Size()
+}
+func (m *Price) XXX_DiscardUnknown() {
+	xxx_messageInfo_Price.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Price proto.InternalMessageInfo
+
+func (m *Price) GetAssets() types.Coin {
+	if m != nil {
+		return m.Assets
+	}
+	return types.Coin{}
+}
+
+func (m *Price) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
+}
+
+func (m *Price) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *Price) GetUpdatedBlockHeight() uint64 {
+	if m != nil {
+		return m.UpdatedBlockHeight
+	}
+	return 0
+}
+
+func (m *Price) GetUpdatedTime() time.Time {
+	if m != nil {
+		return m.UpdatedTime
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*Price)(nil), "provenance.oracle.v1.Price")
+}
+
+func init() { proto.RegisterFile("provenance/oracle/v1/price.proto", fileDescriptor_3303562b190f4cfe) }
+
+var fileDescriptor_3303562b190f4cfe = []byte{
+	// 342 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xbf, 0x4e, 0xf3, 0x30,
+	0x14, 0xc5, 0xe3, 0x7e, 0x6d, 0xf5, 0xe1, 0x32, 0x45, 0x15, 0x84, 0x0e, 0x69, 0xc4, 0xd4, 0x05,
+	0x9b, 0x16, 0x21, 0xf6, 0x30, 0xc0, 0x58, 0x45, 0x4c, 0x2c, 0x95, 0xe3, 0x1a, 0xd7, 0xa2, 0xc9,
+	0x8d, 0x62, 0xa7, 0x82, 0x57, 0x60, 0xea, 0x63, 0x75, 0xec, 0xc8, 0x04, 0xa8, 0x7d, 0x11, 0xe4,
+	0xfc, 0x51, 0x19, 0x18, 0xd8, 0x7c, 0x75, 0x7e, 0xf7, 0xf8, 0x1c, 0x5d, 0x1c, 0x64, 0x39, 0xac,
+	0x44, 0xca, 0x52, 0x2e, 0x28, 0xe4, 0x8c, 0x2f, 0x05, 0x5d, 0x8d, 0x69, 0x96, 0x2b, 0x2e, 0x48,
+	0x96, 0x83, 0x01, 0xb7, 0x7f, 0x20, 0x48, 0x45, 0x90, 0xd5, 0x78, 0xd0, 0x97, 0x20, 0xa1, 0x04,
+	0xa8, 0x7d, 0x55, 0xec, 0xc0, 0xe7, 0xa0, 0x13, 0xd0, 0x34, 0x66, 0xda, 0xfa, 0xc4, 0xc2, 0xb0,
+	0x31, 0xe5, 0xa0, 0xd2, 0x5a, 0x1f, 0x4a, 0x00, 0xb9, 0x14, 0xb4, 0x9c, 0xe2, 0xe2, 0x89, 0x1a,
+	0x95, 0x08, 0x6d, 0x58, 0x92, 0x55, 0xc0, 0xf9, 0x5b, 0x0b, 0x77, 0xa6, 0xf6, 0x73, 0xf7, 0x06,
+	0x77, 0x99, 0xd6, 0xc2, 0x68, 0x0f, 0x05, 0x68, 0xd4, 0x9b, 0x9c, 0x91, 0xca, 0x9b, 0x58, 0x6f,
+	0x52, 0x7b, 0x93, 0x5b, 0x50, 0x69, 0xd8, 0xde, 0x7c, 0x0c, 0x9d, 0xa8, 0xc6, 0xdd, 0x6b, 0xdc,
+	0x29, 0xe3, 0x7b, 0xad, 0xbf, 0xed, 0x55, 0xb4, 0x7b, 0x82, 0xbb, 0x1a, 0x8a, 0x9c, 0x0b, 0xef,
+	0x5f, 0x80, 0x46, 0x47, 0x51, 0x3d, 0xb9, 0x97, 0xb8, 0x5f, 0x64, 0x73, 0x66, 0xc4, 0x7c, 0x16,
+	0x2f, 0x81, 0x3f, 0xcf, 0x16, 0x42, 0xc9, 0x85, 0xf1, 0xda, 0x01, 0x1a, 0xb5, 0x23, 0xb7, 0xd6,
+	0x42, 0x2b, 0xdd, 0x97, 0x8a, 0x7b, 0x87, 0x8f, 0x9b, 0x0d, 0x5b, 0xcf, 0xeb, 0x94, 0x39, 0x06,
+	0xa4, 0xea, 0x4e, 0x9a, 0xee, 0xe4, 0xa1, 0xe9, 0x1e, 0xfe, 0xb7, 0x41, 0xd6, 0x9f, 0x43, 0x14,
+	0xf5, 0xea, 0x4d, 0xab, 0x85, 0x72, 0xb3, 0xf3, 0xd1, 0x76, 0xe7, 0xa3, 0xaf, 0x9d, 0x8f, 0xd6,
+	0x7b, 0xdf, 0xd9, 0xee, 0x7d, 0xe7, 0x7d, 0xef, 0x3b, 0xf8, 0x54, 0x01, 0xf9, 0xed, 0x2c, 0x53,
+	0xf4, 0x38, 0x91, 0xca, 0x2c, 0x8a, 0x98, 0x70, 0x48, 0xe8, 0x01, 0xb9, 0x50, 0xf0, 0x63, 0xa2,
+	0x2f, 0xcd, 0xad, 0xcd, 0x6b, 0x26, 0x74, 0xdc, 0x2d, 0x33, 0x5d, 0x7d, 0x0f, 0x00, 0x30, 0x1e,
+	0xa1, 0xd1, 0x0d, 0x02, 0x00, 0x00,
+}
+
+func (m *Price) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Price) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Price) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdatedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintPrice(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if m.UpdatedBlockHeight != 0 {
+		i = encodeVarintPrice(dAtA, i, uint64(m.UpdatedBlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintPrice(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPrice(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Assets.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPrice(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPrice(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPrice(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Price) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Assets.Size()
+	n += 1 + l + sovPrice(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovPrice(uint64(l))
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovPrice(uint64(l))
+	}
+	if m.UpdatedBlockHeight != 0 {
+		n += 1 + sovPrice(uint64(m.UpdatedBlockHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedTime)
+	n += 1 + l + sovPrice(uint64(l))
+	return n
+}
+
+func sovPrice(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPrice(x uint64) (n int) {
+	return sovPrice(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Price) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPrice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Price: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Price: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPrice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Assets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPrice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBlockHeight", wireType)
+			}
+			m.UpdatedBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPrice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdatedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPrice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPrice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPrice(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPrice
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPrice
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPrice
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPrice
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPrice
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPrice
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPrice        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPrice          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPrice = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestPriceValidate(t *testing.T) {
+	coin := func(amount int64, denom string) sdk.Coin {
+		return sdk.Coin{Denom: denom, Amount: sdkmath.NewInt(amount)}
+	}
+
+	tests := []struct {
+		name  string
+		price Price
+		err   string
+	}{
+		{
+			name:  "success - all fields are valid",
+			price: Price{Assets: coin(10, "apple"), Price: coin(55, "peach"), Source: "x/exchange market 3"},
+		},
+		{
+			name:  "success - zero price",
+			price: Price{Assets: coin(10, "apple"), Price: coin(0, "peach")},
+		},
+		{
+			name:  "failure - invalid assets denom",
+			price: Price{Assets: coin(10, "x"), Price: coin(55, "peach")},
+			err:   "invalid assets \"10x\": invalid denom: x",
+		},
+		{
+			name:  "failure - zero assets",
+			price: Price{Assets: coin(0, "apple"), Price: coin(55, "peach")},
+			err:   "invalid assets \"0apple\": amount must be positive",
+		},
+		{
+			name:  "failure - negative price",
+			price: Price{Assets: coin(10, "apple"), Price: coin(-1, "peach")},
+			err:   "invalid price \"-1peach\": negative coin amount: -1",
+		},
+		{
+			name:  "failure - same denoms",
+			price: Price{Assets: coin(10, "apple"), Price: coin(55, "apple")},
+			err:   "invalid price \"55apple\": cannot have the same denom as the assets \"10apple\"",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.price.Validate()
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "Price.Validate")
+			} else {
+				assert.NoError(t, err, "Price.Validate")
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -15,7 +16,7 @@ func (k Keeper) DetectBlockEvents(ctx sdk.Context) {
 	triggers = append(triggers, k.detectTimeEvents(ctx)...)
 
 	for _, trigger := range triggers {
-		k.Logger(ctx).Debug(fmt.Sprintf("Trigger %d added to queue", trigger.Id))
+		k.Logger(ctx).Debug("trigger added to queue", "trigger_id", trigger.Id)
 		k.emitTriggerDetected(ctx, trigger)
 		k.UnregisterTrigger(ctx, trigger)
 		k.QueueTrigger(ctx, trigger)
@@ -81,12 +82,25 @@ func (k Keeper) detectTimeEvents(ctx sdk.Context) (triggers []types.Trigger) {
 	return
 }
 
+// QueueTimeTriggersUntil Queues all the triggers with a block time event at or before the provided time.
+// This is used to fast-forward the time-based triggers (e.g. when turning a node's state into a testnet).
+func (k Keeper) QueueTimeTriggersUntil(ctx sdk.Context, until time.Time) int {
+	triggers := k.detectTimeEvents(ctx.WithBlockTime(until))
+	for _, trigger := range triggers {
+		k.Logger(ctx).Debug("trigger fast-forwarded to queue", "trigger_id", trigger.Id)
+		k.emitTriggerDetected(ctx, trigger)
+		k.UnregisterTrigger(ctx, trigger)
+		k.QueueTrigger(ctx, trigger)
+	}
+	return len(triggers)
+}
+
 // getMatchingTriggersUntil Gets the triggers with a specified prefix that are ready to be activated and fulfill the given condition until a specific ending condition is reached.
 func (k Keeper) getMatchingTriggersUntil(ctx sdk.Context, prefix string, match func(types.Trigger, types.TriggerEventI) bool, terminator func(types.Trigger, types.TriggerEventI) bool) (triggers []types.Trigger) {
 	err := k.IterateEventListeners(ctx, prefix, func(trigger types.Trigger) (stop bool, err error) {
 		event, _ := trigger.GetTriggerEventI()
 		if match(trigger, event) {
-			k.Logger(ctx).Debug(fmt.Sprintf("Event detected for trigger %d", trigger.Id))
+			k.Logger(ctx).Debug("event detected for trigger", "trigger_id", trigger.Id)
 			triggers = append(triggers, trigger)
 		}
 		return terminator(trigger, event), nil
@@ -103,6 +117,6 @@ func (k Keeper) emitTriggerDetected(ctx sdk.Context, trigger types.Trigger) {
 		TriggerId: fmt.Sprintf("%d", trigger.GetId()),
 	})
 	if err != nil {
-		ctx.Logger().Error("unable to emit EventTriggerDetected", "err", err)
+		k.Logger(ctx).Error("unable to emit EventTriggerDetected", "trigger_id", trigger.Id, "error", err)
 	}
 }
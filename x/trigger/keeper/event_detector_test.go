@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -298,3 +299,34 @@ func (s *KeeperTestSuite) TestDetectBlockEvents() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestQueueTimeTriggersUntil() {
+	now := s.ctx.BlockTime()
+	registered := []types.Trigger{}
+	for _, offset := range []time.Duration{time.Hour, 2 * time.Hour, 3 * time.Hour} {
+		actions, _ := sdktx.SetMsgs([]sdk.Msg{&types.MsgDestroyTriggerRequest{Id: 1, Authority: s.accountAddresses[0].String()}})
+		anyMsg, _ := codectypes.NewAnyWithValue(&types.BlockTimeEvent{Time: now.Add(offset)})
+		trigger := s.app.TriggerKeeper.NewTriggerWithID(s.ctx, s.accountAddresses[0].String(), anyMsg, actions)
+		s.app.TriggerKeeper.RegisterTrigger(s.ctx, trigger)
+		registered = append(registered, trigger)
+	}
+
+	count := s.app.TriggerKeeper.QueueTimeTriggersUntil(s.ctx, now.Add(2*time.Hour))
+	s.Assert().Equal(2, count, "QueueTimeTriggersUntil result")
+
+	triggers, err := s.app.TriggerKeeper.GetAllTriggers(s.ctx)
+	s.Require().NoError(err, "GetAllTriggers")
+	s.Assert().Equal(registered[2:], triggers, "remaining triggers")
+
+	items, err := s.app.TriggerKeeper.GetAllQueueItems(s.ctx)
+	s.Require().NoError(err, "GetAllQueueItems")
+	s.Require().Len(items, 2, "queue items")
+	s.Assert().Equal(registered[0], items[0].Trigger, "items[0].Trigger")
+	s.Assert().Equal(registered[1], items[1].Trigger, "items[1].Trigger")
+	s.Assert().Equal(now, items[0].Time, "items[0].Time")
+
+	for !s.app.TriggerKeeper.QueueIsEmpty(s.ctx) {
+		s.app.TriggerKeeper.Dequeue(s.ctx)
+	}
+	s.app.TriggerKeeper.UnregisterTrigger(s.ctx, registered[2])
+}
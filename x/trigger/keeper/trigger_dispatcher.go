@@ -27,10 +27,10 @@ func (k Keeper) ProcessTriggers(ctx sdk.Context) {
 		item := k.QueuePeek(ctx)
 		triggerID := item.GetTrigger().Id
 		gasLimit := k.GetGasLimit(ctx, triggerID)
-		k.Logger(ctx).Debug(fmt.Sprintf("Processing trigger %d with gas limit %d", triggerID, gasLimit))
+		k.Logger(ctx).Debug("processing trigger", "trigger_id", triggerID, "gas_limit", gasLimit)
 
 		if gasLimit+gasConsumed > MaximumQueueGas {
-			k.Logger(ctx).Debug(fmt.Sprintf("Exceeded MaximumQueueGas %d/%d skipping...", gasLimit+gasConsumed, MaximumQueueGas))
+			k.Logger(ctx).Debug("exceeded MaximumQueueGas, skipping", "gas", gasLimit+gasConsumed, "max_gas", MaximumQueueGas)
 			return
 		}
 		actionsProcessed++
@@ -87,7 +87,7 @@ func (k Keeper) handleMsgs(ctx sdk.Context, msgs []sdk.Msg) ([]sdk.Result, error
 		if handler == nil {
 			return nil, fmt.Errorf("no message handler found for message %s at position %d", sdk.MsgTypeURL(msg), i)
 		}
-		k.Logger(ctx).Debug(fmt.Sprintf("Executing %s at position %d", sdk.MsgTypeURL(msg), i))
+		k.Logger(ctx).Debug("executing msg", "msg_type", sdk.MsgTypeURL(msg), "position", i)
 		r, err := k.safeHandle(ctx, msg, handler)
 		if err != nil {
 			return nil, fmt.Errorf("error processing message %s at position %d: %w", sdk.MsgTypeURL(msg), i, err)
@@ -96,7 +96,7 @@ func (k Keeper) handleMsgs(ctx sdk.Context, msgs []sdk.Msg) ([]sdk.Result, error
 		if r == nil {
 			return nil, fmt.Errorf("got nil sdk.Result for message %s at position %d", sdk.MsgTypeURL(msg), i)
 		}
-		k.Logger(ctx).Debug(fmt.Sprintf("Successfully executed %s at position %d", sdk.MsgTypeURL(msg), i))
+		k.Logger(ctx).Debug("successfully executed msg", "msg_type", sdk.MsgTypeURL(msg), "position", i)
 
 		results[i] = *r
 	}
@@ -135,6 +135,6 @@ func (k Keeper) emitTriggerExecuted(ctx sdk.Context, trigger types.Trigger, succ
 		Success:   success,
 	})
 	if eventErr != nil {
-		ctx.Logger().Error("unable to emit EventTriggerExecuted", "err", eventErr)
+		k.Logger(ctx).Error("unable to emit EventTriggerExecuted", "trigger_id", trigger.GetId(), "error", eventErr)
 	}
 }